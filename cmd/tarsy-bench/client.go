@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+)
+
+// benchClient talks to the TARSy HTTP API.
+type benchClient struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+func newBenchClient(baseURL, token string) *benchClient {
+	return &benchClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// submitAlert posts a synthetic alert and returns the created session ID.
+func (c *benchClient) submitAlert(ctx context.Context, alertType, data string) (string, error) {
+	body, err := json.Marshal(map[string]string{"alert_type": alertType, "data": data})
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		SessionID string `json:"session_id"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/v1/alerts", body, http.StatusAccepted, &resp); err != nil {
+		return "", err
+	}
+	return resp.SessionID, nil
+}
+
+// sessionTiming is the subset of the session detail response the bench reads.
+type sessionTiming struct {
+	Status      alertsession.Status `json:"status"`
+	CreatedAt   time.Time           `json:"created_at"`
+	StartedAt   *time.Time          `json:"started_at"`
+	CompletedAt *time.Time          `json:"completed_at"`
+}
+
+// getSession fetches a session's status and lifecycle timestamps.
+func (c *benchClient) getSession(ctx context.Context, id string) (*sessionTiming, error) {
+	var s sessionTiming
+	if err := c.do(ctx, http.MethodGet, "/api/v1/sessions/"+id, nil, http.StatusOK, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (c *benchClient) do(ctx context.Context, method, path string, body []byte, wantStatus int, out any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != wantStatus {
+		return fmt.Errorf("%s %s: HTTP %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, out)
+}
+
+// sessionTracker records the sessions created by this run and which of them
+// have reached a terminal status.
+type sessionTracker struct {
+	mu       sync.Mutex
+	sessions map[string]bool // session ID → terminal
+	terminal int
+}
+
+func newSessionTracker() *sessionTracker {
+	return &sessionTracker{sessions: make(map[string]bool)}
+}
+
+// add registers a newly submitted session. Terminal events that arrive before
+// add are dropped; the drain phase polls such sessions instead.
+func (t *sessionTracker) add(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.sessions[id]; !ok {
+		t.sessions[id] = false
+	}
+}
+
+// markTerminal records that a session finished. Sessions not started by this
+// run are ignored.
+func (t *sessionTracker) markTerminal(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if done, ok := t.sessions[id]; ok && !done {
+		t.sessions[id] = true
+		t.terminal++
+	}
+}
+
+// counts returns the number of tracked and terminal sessions.
+func (t *sessionTracker) counts() (total, terminal int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.sessions), t.terminal
+}
+
+// ids returns tracked session IDs, optionally only those not yet terminal.
+func (t *sessionTracker) ids(pendingOnly bool) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	out := make([]string, 0, len(t.sessions))
+	for id, done := range t.sessions {
+		if !pendingOnly || !done {
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // Register pgx driver for database/sql
+)
+
+// dbStats is a snapshot of the cumulative write counters PostgreSQL keeps for
+// the TARSy database in pg_stat_database.
+type dbStats struct {
+	At       time.Time
+	Commits  int64
+	Inserted int64
+	Updated  int64
+	Deleted  int64
+}
+
+// dbWriteRates is the per-second delta between two dbStats snapshots.
+type dbWriteRates struct {
+	Commits  float64
+	Inserted float64
+	Updated  float64
+	Deleted  float64
+}
+
+// sampleDBStats reads the current counters for the connected database.
+// PostgreSQL flushes these at most once a second, so short runs undercount.
+func sampleDBStats(ctx context.Context, db *stdsql.DB) (dbStats, error) {
+	s := dbStats{At: time.Now()}
+	err := db.QueryRowContext(ctx,
+		`SELECT xact_commit, tup_inserted, tup_updated, tup_deleted
+		 FROM pg_stat_database WHERE datname = current_database()`,
+	).Scan(&s.Commits, &s.Inserted, &s.Updated, &s.Deleted)
+	if err != nil {
+		return dbStats{}, fmt.Errorf("failed to read pg_stat_database: %w", err)
+	}
+	return s, nil
+}
+
+// rates returns the per-second write rates between before and s.
+func (s dbStats) rates(before dbStats) dbWriteRates {
+	elapsed := s.At.Sub(before.At)
+	return dbWriteRates{
+		Commits:  perSecond(float64(s.Commits-before.Commits), elapsed),
+		Inserted: perSecond(float64(s.Inserted-before.Inserted), elapsed),
+		Updated:  perSecond(float64(s.Updated-before.Updated), elapsed),
+		Deleted:  perSecond(float64(s.Deleted-before.Deleted), elapsed),
+	}
+}
@@ -0,0 +1,438 @@
+// tarsy-bench drives synthetic alert load against a running TARSy instance
+// and reports throughput, queue latency, database write rates, and WebSocket
+// fan-out latency. It can serve a scripted LLM backend so the server under
+// test never calls a real provider.
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/database"
+	"github.com/joho/godotenv"
+)
+
+// benchOptions holds the parsed command-line flags.
+type benchOptions struct {
+	url          string
+	token        string
+	alertType    string
+	rate         float64
+	duration     time.Duration
+	drainTimeout time.Duration
+	wsClients    int
+	llmListen    string
+	llmLatency   time.Duration
+	llmChunks    int
+	sampleDB     bool
+	envFile      string
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdout))
+}
+
+// run parses args, executes the benchmark, writes the report to out, and
+// returns the process exit code. Cancelling ctx (Ctrl-C) stops submitting
+// and skips straight to the report.
+func run(ctx context.Context, args []string, out io.Writer) int {
+	opts, err := parseOptions(args)
+	if err != nil {
+		return 2
+	}
+
+	if opts.envFile != "" {
+		if err := godotenv.Load(opts.envFile); err != nil {
+			slog.Error("Failed to load env file", "path", opts.envFile, "error", err)
+			return 1
+		}
+	}
+
+	var llm *scriptedLLM
+	if opts.llmListen != "" {
+		llm = &scriptedLLM{latency: opts.llmLatency, response: defaultScriptedResponse, chunks: opts.llmChunks}
+		srv, addr, err := serveScriptedLLM(opts.llmListen, llm)
+		if err != nil {
+			slog.Error("Failed to start scripted LLM", "error", err)
+			return 1
+		}
+		defer srv.Stop()
+		slog.Info("Scripted LLM listening — start TARSy with LLM_SERVICE_ADDR pointing here", "addr", addr.String())
+	}
+
+	var db *stdsql.DB
+	if opts.sampleDB {
+		dbConfig, err := database.LoadConfigFromEnv()
+		if err != nil {
+			slog.Error("Failed to load database config", "error", err)
+			return 1
+		}
+		db, err = stdsql.Open("pgx", dbConfig.DSN())
+		if err != nil {
+			slog.Error("Failed to open database", "error", err)
+			return 1
+		}
+		defer func() { _ = db.Close() }()
+	}
+
+	result, err := runBenchmark(ctx, opts, llm, db)
+	if err != nil {
+		slog.Error("Benchmark failed", "error", err)
+		return 1
+	}
+	writeReport(out, opts, result)
+	return 0
+}
+
+func parseOptions(args []string) (benchOptions, error) {
+	var o benchOptions
+	fs := flag.NewFlagSet("tarsy-bench", flag.ContinueOnError)
+	fs.StringVar(&o.url, "url", getEnv("TARSY_URL", "http://localhost:8080"),
+		"Base URL of the TARSy instance under test")
+	fs.StringVar(&o.token, "token", os.Getenv("TARSY_TOKEN"),
+		"Bearer token sent with API requests (when the API is behind kube-rbac-proxy)")
+	fs.StringVar(&o.alertType, "alert-type", "",
+		"Alert type to submit (empty = server default)")
+	fs.Float64Var(&o.rate, "rate", 1,
+		"Alerts submitted per second")
+	fs.DurationVar(&o.duration, "duration", time.Minute,
+		"How long to keep submitting alerts")
+	fs.DurationVar(&o.drainTimeout, "drain-timeout", 5*time.Minute,
+		"How long to wait for submitted sessions to finish after submission stops")
+	fs.IntVar(&o.wsClients, "ws-clients", 5,
+		"WebSocket subscribers on the global sessions channel")
+	fs.StringVar(&o.llmListen, "llm-listen", ":50051",
+		"Address for the scripted LLM gRPC backend (empty = don't serve one)")
+	fs.DurationVar(&o.llmLatency, "llm-latency", 500*time.Millisecond,
+		"Simulated duration of each scripted LLM call")
+	fs.IntVar(&o.llmChunks, "llm-chunks", 8,
+		"Text deltas streamed per scripted LLM call")
+	fs.BoolVar(&o.sampleDB, "db", false,
+		"Sample PostgreSQL write counters (uses DB_* environment variables)")
+	fs.StringVar(&o.envFile, "env-file", "",
+		"Optional .env file to load before reading DB_* variables")
+	if err := fs.Parse(args); err != nil {
+		return o, err
+	}
+	if o.rate <= 0 {
+		fmt.Fprintln(fs.Output(), "-rate must be positive")
+		return o, flag.ErrHelp
+	}
+	if o.duration <= 0 {
+		fmt.Fprintln(fs.Output(), "-duration must be positive")
+		return o, flag.ErrHelp
+	}
+	if o.wsClients < 0 || o.llmChunks < 1 {
+		fmt.Fprintln(fs.Output(), "-ws-clients must be >= 0 and -llm-chunks >= 1")
+		return o, flag.ErrHelp
+	}
+	return o, nil
+}
+
+// benchResult aggregates everything measured during one run.
+type benchResult struct {
+	submitted   int64
+	rejected    int64
+	submitErrs  map[string]int
+	statuses    map[string]int
+	unfinished  int
+	elapsed     time.Duration // first session created → last session finished
+	submitLat   latencySummary
+	queueWait   latencySummary
+	processing  latencySummary
+	endToEnd    latencySummary
+	wsFanout    latencySummary
+	dbRates     *dbWriteRates
+	llmCalls    int64
+	llmEnabled  bool
+	subscribers int
+}
+
+func runBenchmark(ctx context.Context, opts benchOptions, llm *scriptedLLM, db *stdsql.DB) (*benchResult, error) {
+	client := newBenchClient(opts.url, opts.token)
+	tracker := newSessionTracker()
+	fanout := &sampleSet{}
+
+	// WebSocket subscribers outlive the submission phase so terminal events
+	// keep arriving while the queue drains.
+	wsCtx, stopWS := context.WithCancel(context.Background())
+	defer stopWS()
+	var wsWG sync.WaitGroup
+	for i := range opts.wsClients {
+		var t *sessionTracker
+		if i == 0 {
+			t = tracker
+		}
+		sub, err := connectSubscriber(ctx, wsEndpoint(opts.url), fanout, t)
+		if err != nil {
+			return nil, fmt.Errorf("subscriber %d: %w", i, err)
+		}
+		wsWG.Add(1)
+		go func() {
+			defer wsWG.Done()
+			sub.run(wsCtx)
+		}()
+	}
+
+	var dbBefore dbStats
+	if db != nil {
+		var err error
+		if dbBefore, err = sampleDBStats(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &benchResult{
+		submitErrs:  make(map[string]int),
+		statuses:    make(map[string]int),
+		llmEnabled:  llm != nil,
+		subscribers: opts.wsClients,
+	}
+	submitLat := &sampleSet{}
+	var errMu sync.Mutex
+	var submitted, rejected atomic.Int64
+
+	slog.Info("Submitting alerts", "rate", opts.rate, "duration", opts.duration, "url", opts.url)
+	interval := time.Duration(float64(time.Second) / opts.rate)
+	ticker := time.NewTicker(interval)
+	deadline := time.NewTimer(opts.duration)
+	var submitWG sync.WaitGroup
+	seq := 0
+submit:
+	for {
+		select {
+		case <-ctx.Done():
+			break submit
+		case <-deadline.C:
+			break submit
+		case <-ticker.C:
+			seq++
+			n := seq
+			submitWG.Add(1)
+			go func() {
+				defer submitWG.Done()
+				submitted.Add(1)
+				start := time.Now()
+				id, err := client.submitAlert(context.Background(), opts.alertType, syntheticAlert(n))
+				if err != nil {
+					rejected.Add(1)
+					errMu.Lock()
+					res.submitErrs[err.Error()]++
+					errMu.Unlock()
+					return
+				}
+				submitLat.add(time.Since(start))
+				tracker.add(id)
+			}()
+		}
+	}
+	ticker.Stop()
+	deadline.Stop()
+	submitWG.Wait()
+	res.submitted, res.rejected = submitted.Load(), rejected.Load()
+
+	drain(ctx, client, tracker, opts.drainTimeout)
+
+	if db != nil {
+		dbAfter, err := sampleDBStats(context.Background(), db)
+		if err != nil {
+			return nil, err
+		}
+		rates := dbAfter.rates(dbBefore)
+		res.dbRates = &rates
+	}
+
+	stopWS()
+	wsWG.Wait()
+	res.wsFanout = fanout.summary()
+	res.submitLat = submitLat.summary()
+	if llm != nil {
+		res.llmCalls = llm.calls.Load()
+	}
+
+	collectTimings(client, tracker.ids(false), res)
+	return res, nil
+}
+
+// drain waits until every tracked session is terminal, ctx is cancelled, or
+// timeout passes. WebSocket events usually mark sessions done; sessions whose
+// event was missed are polled every few seconds.
+func drain(ctx context.Context, client *benchClient, tracker *sessionTracker, timeout time.Duration) {
+	total, terminal := tracker.counts()
+	if terminal == total {
+		return
+	}
+	slog.Info("Submission finished, waiting for sessions to complete", "sessions", total, "timeout", timeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		forEach(tracker.ids(true), 8, func(id string) {
+			s, err := client.getSession(ctx, id)
+			if err == nil && isTerminal(s.Status) {
+				tracker.markTerminal(id)
+			}
+		})
+		total, terminal = tracker.counts()
+		slog.Info("Draining", "terminal", terminal, "total", total)
+		if terminal == total {
+			return
+		}
+	}
+}
+
+// collectTimings fetches the server-side lifecycle timestamps of every session
+// so queue and processing latency are free of client clock skew.
+func collectTimings(client *benchClient, ids []string, res *benchResult) {
+	var mu sync.Mutex
+	var queueWait, processing, endToEnd []time.Duration
+	var first, last time.Time
+
+	forEach(ids, 8, func(id string) {
+		s, err := client.getSession(context.Background(), id)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil || !isTerminal(s.Status) {
+			res.unfinished++
+			return
+		}
+		res.statuses[string(s.Status)]++
+		if first.IsZero() || s.CreatedAt.Before(first) {
+			first = s.CreatedAt
+		}
+		if s.StartedAt != nil {
+			queueWait = append(queueWait, s.StartedAt.Sub(s.CreatedAt))
+		}
+		if s.CompletedAt != nil {
+			endToEnd = append(endToEnd, s.CompletedAt.Sub(s.CreatedAt))
+			if s.StartedAt != nil {
+				processing = append(processing, s.CompletedAt.Sub(*s.StartedAt))
+			}
+			if s.CompletedAt.After(last) {
+				last = *s.CompletedAt
+			}
+		}
+	})
+
+	res.queueWait = summarize(queueWait)
+	res.processing = summarize(processing)
+	res.endToEnd = summarize(endToEnd)
+	if !first.IsZero() && last.After(first) {
+		res.elapsed = last.Sub(first)
+	}
+}
+
+// forEach calls fn for every id using up to workers goroutines.
+func forEach(ids []string, workers int, fn func(id string)) {
+	ch := make(chan string)
+	var wg sync.WaitGroup
+	for range min(workers, len(ids)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ch {
+				fn(id)
+			}
+		}()
+	}
+	for _, id := range ids {
+		ch <- id
+	}
+	close(ch)
+	wg.Wait()
+}
+
+// syntheticAlert builds the alert payload for the n-th submission.
+func syntheticAlert(n int) string {
+	return fmt.Sprintf("tarsy-bench synthetic alert #%d at %s: pod bench-%d in namespace bench is CrashLoopBackOff",
+		n, time.Now().UTC().Format(time.RFC3339), n)
+}
+
+func writeReport(out io.Writer, opts benchOptions, r *benchResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintf(w, "TARSy benchmark: %s at %.2f alerts/s against %s\n\n", opts.duration, opts.rate, opts.url)
+
+	completed := 0
+	for _, n := range r.statuses {
+		completed += n
+	}
+	_, _ = fmt.Fprintf(w, "Alerts submitted\t%d\t(%d rejected)\n", r.submitted, r.rejected)
+	_, _ = fmt.Fprintf(w, "Sessions finished\t%d\t(%d unfinished)\n", completed, r.unfinished)
+	statuses := make([]string, 0, len(r.statuses))
+	for s := range r.statuses {
+		statuses = append(statuses, s)
+	}
+	sort.Strings(statuses)
+	for _, s := range statuses {
+		_, _ = fmt.Fprintf(w, "  %s\t%d\t\n", s, r.statuses[s])
+	}
+	_, _ = fmt.Fprintf(w, "Throughput\t%.2f sessions/s\t(over %s)\n\n",
+		perSecond(float64(completed), r.elapsed), r.elapsed.Round(time.Millisecond))
+
+	_, _ = fmt.Fprintln(w, "Latency\tcount\tmean\tp50\tp95\tp99\tmax")
+	writeLatency(w, "submit (HTTP)", r.submitLat)
+	writeLatency(w, "queue wait", r.queueWait)
+	writeLatency(w, "processing", r.processing)
+	writeLatency(w, "end to end", r.endToEnd)
+	writeLatency(w, fmt.Sprintf("WS fan-out (%d subs)", r.subscribers), r.wsFanout)
+	_, _ = fmt.Fprintln(w)
+
+	if r.dbRates != nil {
+		_, _ = fmt.Fprintf(w, "DB writes/s\tcommits %.1f\tinserted %.1f\tupdated %.1f\tdeleted %.1f\n",
+			r.dbRates.Commits, r.dbRates.Inserted, r.dbRates.Updated, r.dbRates.Deleted)
+	}
+	if r.llmEnabled {
+		_, _ = fmt.Fprintf(w, "Scripted LLM calls\t%d\n", r.llmCalls)
+	}
+	if len(r.submitErrs) > 0 {
+		_, _ = fmt.Fprintln(w, "\nSubmission errors:")
+		for msg, n := range r.submitErrs {
+			_, _ = fmt.Fprintf(w, "  %d×\t%s\n", n, msg)
+		}
+	}
+}
+
+func writeLatency(w io.Writer, name string, s latencySummary) {
+	if s.Count == 0 {
+		_, _ = fmt.Fprintf(w, "%s\t0\t-\t-\t-\t-\t-\n", name)
+		return
+	}
+	r := func(d time.Duration) time.Duration {
+		if d < 10*time.Millisecond {
+			return d.Round(10 * time.Microsecond)
+		}
+		return d.Round(time.Millisecond)
+	}
+	_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\n",
+		name, s.Count, r(s.Mean), r(s.P50), r(s.P95), r(s.P99), r(s.Max))
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
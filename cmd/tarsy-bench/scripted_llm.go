@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	llmv1 "github.com/codeready-toolchain/tarsy/proto"
+	"google.golang.org/grpc"
+)
+
+// defaultScriptedResponse is returned for every Generate call. The
+// "Final Answer:" marker lets ReAct agents finish in one iteration; native
+// thinking agents treat the whole text as the final answer.
+const defaultScriptedResponse = "Thought: Synthetic benchmark alert, no investigation needed.\n" +
+	"Final Answer: Benchmark run — no action required."
+
+// scriptedLLM implements the LLM gRPC service with a fixed response and a
+// configurable per-call latency so TARSy can be load-tested without a real
+// provider. Point the server under test at it via LLM_SERVICE_ADDR.
+type scriptedLLM struct {
+	llmv1.UnimplementedLLMServiceServer
+
+	latency  time.Duration
+	response string
+	chunks   int
+
+	calls atomic.Int64
+}
+
+// Generate streams the scripted response as text deltas followed by a usage
+// chunk, spreading the configured latency across the deltas.
+func (s *scriptedLLM) Generate(req *llmv1.GenerateRequest, stream llmv1.LLMService_GenerateServer) error {
+	s.calls.Add(1)
+	ctx := stream.Context()
+
+	parts := splitChunks(s.response, s.chunks)
+	delay := s.latency / time.Duration(len(parts))
+	for _, part := range parts {
+		if delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+		if err := stream.Send(&llmv1.GenerateResponse{
+			Content: &llmv1.GenerateResponse_Text{Text: &llmv1.TextDelta{Content: part}},
+		}); err != nil {
+			return err
+		}
+	}
+
+	inputTokens := int32(0)
+	for _, m := range req.GetMessages() {
+		inputTokens += int32(len(m.GetContent()) / 4)
+	}
+	outputTokens := int32(len(s.response) / 4)
+	return stream.Send(&llmv1.GenerateResponse{
+		Content: &llmv1.GenerateResponse_Usage{Usage: &llmv1.UsageInfo{
+			InputTokens:  inputTokens,
+			OutputTokens: outputTokens,
+			TotalTokens:  inputTokens + outputTokens,
+		}},
+		IsFinal: true,
+	})
+}
+
+// serveScriptedLLM starts the scripted LLM on addr and returns the running
+// gRPC server and its bound address.
+func serveScriptedLLM(addr string, llm *scriptedLLM) (*grpc.Server, net.Addr, error) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+	srv := grpc.NewServer()
+	llmv1.RegisterLLMServiceServer(srv, llm)
+	go func() { _ = srv.Serve(lis) }()
+	return srv, lis.Addr(), nil
+}
+
+// splitChunks splits s into at most n roughly equal parts, never splitting a
+// multi-byte rune. Always returns at least one part.
+func splitChunks(s string, n int) []string {
+	runes := []rune(s)
+	if n <= 1 || len(runes) <= 1 {
+		return []string{s}
+	}
+	if n > len(runes) {
+		n = len(runes)
+	}
+	size := (len(runes) + n - 1) / n
+	parts := make([]string, 0, n)
+	for start := 0; start < len(runes); start += size {
+		end := min(start+size, len(runes))
+		parts = append(parts, string(runes[start:end]))
+	}
+	return parts
+}
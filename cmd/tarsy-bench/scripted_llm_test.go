@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScriptedLLM_GRPCRoundTrip checks the scripted backend through TARSy's
+// real gRPC client so the bench stays compatible with the wire protocol.
+func TestScriptedLLM_GRPCRoundTrip(t *testing.T) {
+	llm := &scriptedLLM{latency: 20 * time.Millisecond, response: defaultScriptedResponse, chunks: 4}
+	srv, addr, err := serveScriptedLLM("127.0.0.1:0", llm)
+	require.NoError(t, err)
+	t.Cleanup(srv.Stop)
+
+	client, err := agent.NewGRPCLLMClient(addr.String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ch, err := client.Generate(ctx, &agent.GenerateInput{
+		SessionID: "bench-session",
+		Messages:  []agent.ConversationMessage{{Role: agent.RoleUser, Content: "alert data for the benchmark"}},
+	})
+	require.NoError(t, err)
+
+	var text strings.Builder
+	var usage *agent.UsageChunk
+	for chunk := range ch {
+		switch c := chunk.(type) {
+		case *agent.TextChunk:
+			text.WriteString(c.Content)
+		case *agent.UsageChunk:
+			usage = c
+		case *agent.ErrorChunk:
+			t.Fatalf("unexpected error chunk: %s", c.Message)
+		}
+	}
+
+	assert.Equal(t, defaultScriptedResponse, text.String())
+	require.NotNil(t, usage)
+	assert.Positive(t, usage.InputTokens)
+	assert.Equal(t, usage.InputTokens+usage.OutputTokens, usage.TotalTokens)
+	assert.Equal(t, int64(1), llm.calls.Load())
+}
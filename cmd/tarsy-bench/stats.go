@@ -0,0 +1,73 @@
+package main
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// latencySummary is a percentile digest of a set of latency samples.
+type latencySummary struct {
+	Count int
+	Mean  time.Duration
+	P50   time.Duration
+	P95   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// summarize computes a latencySummary over samples. Returns the zero value
+// for an empty input. samples is not modified.
+func summarize(samples []time.Duration) latencySummary {
+	if len(samples) == 0 {
+		return latencySummary{}
+	}
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	return latencySummary{
+		Count: len(sorted),
+		Mean:  total / time.Duration(len(sorted)),
+		P50:   percentile(sorted, 0.50),
+		P95:   percentile(sorted, 0.95),
+		P99:   percentile(sorted, 0.99),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile p (0..1] of sorted.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(p*float64(len(sorted))+0.999999) - 1
+	rank = max(0, min(rank, len(sorted)-1))
+	return sorted[rank]
+}
+
+// sampleSet collects latency samples from concurrent goroutines.
+type sampleSet struct {
+	mu      sync.Mutex
+	samples []time.Duration
+}
+
+func (s *sampleSet) add(d time.Duration) {
+	s.mu.Lock()
+	s.samples = append(s.samples, d)
+	s.mu.Unlock()
+}
+
+func (s *sampleSet) summary() latencySummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return summarize(s.samples)
+}
+
+// perSecond returns n divided by elapsed seconds, or 0 when elapsed is zero.
+func perSecond(n float64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return n / elapsed.Seconds()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, latencySummary{}, summarize(nil))
+	})
+
+	t.Run("percentiles", func(t *testing.T) {
+		samples := make([]time.Duration, 0, 100)
+		for i := 100; i >= 1; i-- {
+			samples = append(samples, time.Duration(i)*time.Millisecond)
+		}
+		s := summarize(samples)
+		assert.Equal(t, 100, s.Count)
+		assert.Equal(t, 50500*time.Microsecond, s.Mean)
+		assert.Equal(t, 50*time.Millisecond, s.P50)
+		assert.Equal(t, 95*time.Millisecond, s.P95)
+		assert.Equal(t, 99*time.Millisecond, s.P99)
+		assert.Equal(t, 100*time.Millisecond, s.Max)
+		assert.Equal(t, 100*time.Millisecond, samples[0], "input must not be reordered")
+	})
+
+	t.Run("single sample", func(t *testing.T) {
+		s := summarize([]time.Duration{7 * time.Second})
+		assert.Equal(t, 7*time.Second, s.P50)
+		assert.Equal(t, 7*time.Second, s.P99)
+	})
+}
+
+func TestPerSecond(t *testing.T) {
+	assert.InDelta(t, 2.5, perSecond(5, 2*time.Second), 1e-9)
+	assert.Zero(t, perSecond(5, 0))
+}
+
+func TestDBStatsRates(t *testing.T) {
+	start := time.Now()
+	before := dbStats{At: start, Commits: 100, Inserted: 1000, Updated: 50}
+	after := dbStats{At: start.Add(10 * time.Second), Commits: 300, Inserted: 1500, Updated: 150, Deleted: 10}
+	assert.Equal(t, dbWriteRates{Commits: 20, Inserted: 50, Updated: 10, Deleted: 1}, after.rates(before))
+}
+
+func TestSplitChunks(t *testing.T) {
+	assert.Equal(t, []string{"hello"}, splitChunks("hello", 1))
+	assert.Equal(t, []string{"he", "ll", "o"}, splitChunks("hello", 3))
+	assert.Equal(t, []string{"a", "b"}, splitChunks("ab", 8))
+	assert.Equal(t, []string{""}, splitChunks("", 4))
+	assert.Equal(t, []string{"—é", "ü"}, splitChunks("—éü", 2), "multi-byte runes stay intact")
+}
+
+func TestWSEndpoint(t *testing.T) {
+	assert.Equal(t, "ws://localhost:8080/api/v1/ws", wsEndpoint("http://localhost:8080/"))
+	assert.Equal(t, "wss://tarsy.example.com/api/v1/ws", wsEndpoint("https://tarsy.example.com"))
+}
+
+func TestSessionTracker(t *testing.T) {
+	tr := newSessionTracker()
+	tr.add("a")
+	tr.add("b")
+	tr.markTerminal("a")
+	tr.markTerminal("a")
+	tr.markTerminal("not-ours")
+
+	total, terminal := tr.counts()
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 1, terminal)
+	assert.Equal(t, []string{"b"}, tr.ids(true))
+	assert.ElementsMatch(t, []string{"a", "b"}, tr.ids(false))
+}
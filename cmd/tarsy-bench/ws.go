@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+)
+
+// wsSubscriber is one benchmark WebSocket client subscribed to the global
+// sessions channel. Every subscriber records fan-out latency; the tracker
+// (if set) is told about terminal session status events.
+type wsSubscriber struct {
+	conn    *websocket.Conn
+	fanout  *sampleSet
+	tracker *sessionTracker
+}
+
+// statusEvent is the subset of events.SessionStatusPayload the bench reads.
+type statusEvent struct {
+	Type      string              `json:"type"`
+	SessionID string              `json:"session_id"`
+	Timestamp string              `json:"timestamp"`
+	Status    alertsession.Status `json:"status"`
+	Channel   string              `json:"channel"`
+}
+
+// connectSubscriber dials the TARSy WebSocket endpoint and subscribes to the
+// global sessions channel, waiting for the server's confirmation.
+func connectSubscriber(ctx context.Context, wsURL string, fanout *sampleSet, tracker *sessionTracker) (*wsSubscriber, error) {
+	conn, _, err := websocket.Dial(ctx, wsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("WebSocket dial: %w", err)
+	}
+	conn.SetReadLimit(1 << 20)
+
+	msg, _ := json.Marshal(map[string]string{"action": "subscribe", "channel": events.GlobalSessionsChannel})
+	if err := conn.Write(ctx, websocket.MessageText, msg); err != nil {
+		_ = conn.CloseNow()
+		return nil, fmt.Errorf("WebSocket subscribe: %w", err)
+	}
+
+	confirmCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	for {
+		_, data, err := conn.Read(confirmCtx)
+		if err != nil {
+			_ = conn.CloseNow()
+			return nil, fmt.Errorf("waiting for subscription.confirmed: %w", err)
+		}
+		var evt statusEvent
+		if json.Unmarshal(data, &evt) == nil &&
+			evt.Type == "subscription.confirmed" && evt.Channel == events.GlobalSessionsChannel {
+			break
+		}
+	}
+
+	return &wsSubscriber{conn: conn, fanout: fanout, tracker: tracker}, nil
+}
+
+// run reads events until ctx is cancelled or the connection drops.
+func (s *wsSubscriber) run(ctx context.Context) {
+	defer func() { _ = s.conn.CloseNow() }()
+	for {
+		_, data, err := s.conn.Read(ctx)
+		if err != nil {
+			return
+		}
+		received := time.Now()
+
+		var evt statusEvent
+		if err := json.Unmarshal(data, &evt); err != nil || evt.Type != events.EventTypeSessionStatus {
+			continue
+		}
+		if ts, err := time.Parse(time.RFC3339Nano, evt.Timestamp); err == nil {
+			s.fanout.add(max(0, received.Sub(ts)))
+		}
+		if s.tracker != nil && isTerminal(evt.Status) {
+			s.tracker.markTerminal(evt.SessionID)
+		}
+	}
+}
+
+// wsEndpoint converts the TARSy base URL into its WebSocket endpoint URL.
+func wsEndpoint(baseURL string) string {
+	u := strings.TrimSuffix(baseURL, "/")
+	switch {
+	case strings.HasPrefix(u, "https://"):
+		u = "wss://" + strings.TrimPrefix(u, "https://")
+	case strings.HasPrefix(u, "http://"):
+		u = "ws://" + strings.TrimPrefix(u, "http://")
+	}
+	return u + "/api/v1/ws"
+}
+
+// isTerminal reports whether a session status is final.
+func isTerminal(status alertsession.Status) bool {
+	switch status {
+	case alertsession.StatusCompleted, alertsession.StatusFailed, alertsession.StatusCancelled,
+		alertsession.StatusTimedOut, alertsession.StatusAutoCancelled:
+		return true
+	default:
+		return false
+	}
+}
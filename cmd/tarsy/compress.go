@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/database"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// runCompress implements "tarsy compress": it zstd-compresses timeline
+// contents and interaction payloads stored before system.compression was
+// enabled, using the configured threshold. Prints the number of rows
+// compressed per column and the space saved, and returns the process exit
+// code.
+func runCompress(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("compress", flag.ContinueOnError)
+	configDir := fs.String("config-dir",
+		getEnv("CONFIG_DIR", "./deploy/config"),
+		"Path to configuration directory")
+	dryRun := fs.Bool("dry-run", false,
+		"Report what would be compressed without writing")
+	batchSize := fs.Int("batch-size", 0,
+		"Rows loaded per page (0 = default)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	loadEnvFile(*configDir)
+
+	cfg, err := config.Initialize(ctx, *configDir)
+	if err != nil {
+		slog.Error("Failed to initialize configuration", "error", err)
+		return 1
+	}
+	applyLoggingConfig(cfg.Logging)
+	services.SetCompression(cfg.Compression)
+
+	dbConfig, err := database.LoadConfigFromEnv()
+	if err != nil {
+		slog.Error("Failed to load database config", "error", err)
+		return 1
+	}
+	dbClient, err := database.NewClient(ctx, dbConfig)
+	if err != nil {
+		slog.Error("Failed to connect to database", "error", err)
+		return 1
+	}
+	defer func() { _ = dbClient.Close() }()
+
+	slog.Info("Compressing stored payloads",
+		"dry_run", *dryRun,
+		"threshold_bytes", cfg.Compression.ThresholdBytes)
+
+	result, err := services.NewPayloadCompressionService(dbClient.Client).Run(ctx, services.CompressionOptions{
+		DryRun:    *dryRun,
+		BatchSize: *batchSize,
+	})
+	if result != nil {
+		printCompressionResult(os.Stdout, result)
+	}
+	if err != nil {
+		slog.Error("Compress failed; pages already processed were committed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+func printCompressionResult(out *os.File, r *services.CompressionResult) {
+	mode := "compressed"
+	if r.DryRun {
+		mode = "would be compressed (dry run)"
+	}
+	fmt.Fprintf(out, "Rows %s: %d\n", mode, r.TotalRows())
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, col := range r.Columns() {
+		fmt.Fprintf(w, "  %s\t%d\n", col, r.Rows[col])
+	}
+	_ = w.Flush()
+
+	if r.BytesBefore > 0 {
+		fmt.Fprintf(out, "Payload bytes: %d -> %d (%.0f%% saved)\n",
+			r.BytesBefore, r.BytesAfter, 100*(1-float64(r.BytesAfter)/float64(r.BytesBefore)))
+	}
+}
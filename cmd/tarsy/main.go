@@ -4,6 +4,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
@@ -11,25 +12,53 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
 	"github.com/codeready-toolchain/tarsy/pkg/api"
+	"github.com/codeready-toolchain/tarsy/pkg/blobstore"
+	"github.com/codeready-toolchain/tarsy/pkg/callback"
 	"github.com/codeready-toolchain/tarsy/pkg/cleanup"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/configdrift"
+	"github.com/codeready-toolchain/tarsy/pkg/coordination"
 	"github.com/codeready-toolchain/tarsy/pkg/cost"
 	"github.com/codeready-toolchain/tarsy/pkg/database"
+	"github.com/codeready-toolchain/tarsy/pkg/diagnostics"
+	"github.com/codeready-toolchain/tarsy/pkg/email"
+	"github.com/codeready-toolchain/tarsy/pkg/enrichment"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/eventstream"
+	"github.com/codeready-toolchain/tarsy/pkg/fanout"
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
+	"github.com/codeready-toolchain/tarsy/pkg/featureflag"
+	"github.com/codeready-toolchain/tarsy/pkg/federation"
+	"github.com/codeready-toolchain/tarsy/pkg/followup"
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
 	"github.com/codeready-toolchain/tarsy/pkg/masking"
 	"github.com/codeready-toolchain/tarsy/pkg/mcp"
 	"github.com/codeready-toolchain/tarsy/pkg/memory"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/codeready-toolchain/tarsy/pkg/milestone"
+	"github.com/codeready-toolchain/tarsy/pkg/oncall"
+	"github.com/codeready-toolchain/tarsy/pkg/outcome"
+	"github.com/codeready-toolchain/tarsy/pkg/pagerduty"
 	"github.com/codeready-toolchain/tarsy/pkg/queue"
+	"github.com/codeready-toolchain/tarsy/pkg/recurrence"
+	"github.com/codeready-toolchain/tarsy/pkg/registration"
+	"github.com/codeready-toolchain/tarsy/pkg/report"
+	"github.com/codeready-toolchain/tarsy/pkg/resultexport"
 	"github.com/codeready-toolchain/tarsy/pkg/runbook"
+	"github.com/codeready-toolchain/tarsy/pkg/savedview"
 	"github.com/codeready-toolchain/tarsy/pkg/services"
 	tarsyslack "github.com/codeready-toolchain/tarsy/pkg/slack"
+	"github.com/codeready-toolchain/tarsy/pkg/tokenizer"
+	"github.com/codeready-toolchain/tarsy/pkg/transcription"
+	"github.com/codeready-toolchain/tarsy/pkg/version"
 	"github.com/joho/godotenv"
 )
 
@@ -40,6 +69,16 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvBool parses a boolean environment variable, returning defaultValue
+// when it is unset or not a valid boolean.
+func getEnvBool(key string, defaultValue bool) bool {
+	b, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return b
+}
+
 // resolvePodID determines the pod identifier for multi-replica coordination.
 // Priority: POD_ID env > HOSTNAME env > "local"
 func resolvePodID() string {
@@ -52,35 +91,100 @@ func resolvePodID() string {
 	return "local"
 }
 
+// loadEnvFile loads <configDir>/.env into the process environment, if present.
+func loadEnvFile(configDir string) {
+	envPath := filepath.Join(configDir, ".env")
+	if err := godotenv.Load(envPath); err != nil {
+		slog.Warn("Could not load .env file, continuing with existing environment",
+			"path", envPath, "error", err)
+	} else {
+		slog.Info("Loaded environment", "path", envPath)
+	}
+}
+
 func configureLogging() {
-	level := parseLogLevel(getEnv("LOG_LEVEL", "info"))
-	var handler slog.Handler
-	opts := &slog.HandlerOptions{Level: level}
-	switch getEnv("LOG_FORMAT", "text") {
-	case "json":
-		handler = slog.NewJSONHandler(os.Stderr, opts)
-	default:
-		handler = slog.NewTextHandler(os.Stderr, opts)
-	}
-	slog.SetDefault(slog.New(handler))
+	level, err := logging.ParseLevel(getEnv("LOG_LEVEL", "info"))
+	logging.Setup(os.Stderr, getEnv("LOG_FORMAT", "text"), level)
+	if err != nil {
+		slog.Warn("Invalid LOG_LEVEL, using info", "error", err)
+	}
+}
+
+// applyBlobStoreConfig creates the system.blob_store backend and hands it to
+// the services that offload payloads to it.
+func applyBlobStoreConfig(cfg *config.BlobStoreConfig, dbClient *database.Client) error {
+	store, err := blobstore.New(cfg, dbClient.DB())
+	if err != nil {
+		return err
+	}
+	services.SetBlobStore(store, cfg)
+	slog.Info("Blob store configured",
+		"backend", cfg.Backend,
+		"tool_result_threshold_bytes", cfg.ToolResultThresholdBytes)
+	return nil
 }
 
-func parseLogLevel(s string) slog.Level {
-	switch strings.ToLower(s) {
-	case "debug":
-		return slog.LevelDebug
-	case "warn", "warning":
-		return slog.LevelWarn
-	case "error":
-		return slog.LevelError
-	default:
-		return slog.LevelInfo
+// applyLoggingConfig applies system.logging subsystem levels and redaction
+// on top of the LOG_LEVEL base. Levels were validated at config load.
+func applyLoggingConfig(cfg *config.LoggingConfig) {
+	if cfg == nil {
+		return
+	}
+	logging.SetRedactSensitive(cfg.RedactSensitive)
+	for sub, name := range cfg.Levels {
+		if level, err := logging.ParseLevel(name); err == nil {
+			logging.Levels().Set(logging.Subsystem(sub), level)
+		}
+	}
+	if len(cfg.Levels) > 0 {
+		slog.Info("Subsystem log levels configured", "levels", cfg.Levels)
+	}
+}
+
+// runMigrateOnly applies pending migrations and returns the process exit
+// code. It needs only the DB_* environment, not the TARSy configuration, so
+// it can run as a Kubernetes Job or init container before new pods roll out.
+func runMigrateOnly(ctx context.Context) int {
+	dbConfig, err := database.LoadConfigFromEnv()
+	if err != nil {
+		slog.Error("Failed to load database config", "error", err)
+		return 1
+	}
+	dbConfig.AutoMigrate = true
+
+	dbClient, err := database.NewClient(ctx, dbConfig)
+	if err != nil {
+		slog.Error("Database migration failed", "error", err)
+		return 1
 	}
+	defer func() { _ = dbClient.Close() }()
+
+	status, err := database.ReadSchemaStatus(ctx, dbClient.DB())
+	if err != nil {
+		slog.Error("Failed to read schema status after migration", "error", err)
+		return 1
+	}
+	slog.Info("Database migrations complete",
+		"schema_version", status.Version,
+		"binary_latest", status.Latest,
+		"min_compatible_version", status.MinVersion)
+	return 0
 }
 
 func main() {
 	configureLogging()
 
+	// Admin subcommands (run to completion and exit)
+	if len(os.Args) > 1 && os.Args[1] == "remask" {
+		os.Exit(runRemask(context.Background(), os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "compress" {
+		os.Exit(runCompress(context.Background(), os.Args[2:]))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "validate-config" {
+		os.Exit(runValidateConfig(context.Background(), os.Args[2:]))
+	}
+
 	// Parse command-line flags
 	configDir := flag.String("config-dir",
 		getEnv("CONFIG_DIR", "./deploy/config"),
@@ -88,16 +192,14 @@ func main() {
 	dashboardDir := flag.String("dashboard-dir",
 		getEnv("DASHBOARD_DIR", ""),
 		"Path to dashboard build directory (e.g. web/dashboard/dist). Empty = no static serving")
+	migrateOnly := flag.Bool("migrate-only", false,
+		"Apply pending database migrations and exit (for a pre-deploy migration job)")
+	strictStartup := flag.Bool("strict-startup", getEnvBool("STRICT_STARTUP", false),
+		"Fail startup instead of starting degraded (unreachable MCP servers, missing optional credentials)")
 	flag.Parse()
 
 	// Load .env file from config directory
-	envPath := filepath.Join(*configDir, ".env")
-	if err := godotenv.Load(envPath); err != nil {
-		slog.Warn("Could not load .env file, continuing with existing environment",
-			"path", envPath, "error", err)
-	} else {
-		slog.Info("Loaded environment", "path", envPath)
-	}
+	loadEnvFile(*configDir)
 
 	httpPort := getEnv("HTTP_PORT", "8080")
 	podID := resolvePodID()
@@ -109,12 +211,31 @@ func main() {
 
 	ctx := context.Background()
 
+	if *migrateOnly {
+		os.Exit(runMigrateOnly(ctx))
+	}
+
 	// 1. Initialize configuration
 	cfg, err := config.Initialize(ctx, *configDir)
 	if err != nil {
 		slog.Error("Failed to initialize configuration", "error", err)
 		os.Exit(1)
 	}
+	checks := &startupChecks{strict: *strictStartup}
+	if *strictStartup {
+		slog.Info("Strict startup enabled: degraded-start conditions are fatal")
+	}
+	for _, missing := range config.NewValidator(cfg).MissingOptionalEnv() {
+		slog.Warn("Optional credentials not configured", "problem", missing)
+		checks.degraded(missing)
+	}
+	applyLoggingConfig(cfg.Logging)
+	services.SetCompression(cfg.Compression)
+	services.SetRedactionReview(cfg.RedactionReview)
+	if err := tokenizer.Preload(cfg.LLMProviderRegistry.GetAll()); err != nil {
+		slog.Error("Failed to load tokenizers", "error", err)
+		os.Exit(1)
+	}
 
 	// 2. Initialize database
 	dbConfig, err := database.LoadConfigFromEnv()
@@ -124,6 +245,10 @@ func main() {
 	}
 
 	dbClient, err := database.NewClient(ctx, dbConfig)
+	if errors.Is(err, database.ErrIncompatibleSchema) {
+		slog.Error("Database schema is incompatible with this binary", "error", err)
+		os.Exit(1)
+	}
 	if err != nil {
 		slog.Error("Failed to connect to database", "error", err)
 		os.Exit(1)
@@ -133,14 +258,19 @@ func main() {
 			slog.Error("Error closing database client", "error", err)
 		}
 	}()
-	slog.Info("Connected to PostgreSQL database")
+	slog.Info("Connected to PostgreSQL database", "api_pool", dbConfig.APIMaxOpenConns > 0)
+	for name, pool := range dbClient.Pools() {
+		metrics.RegisterDBPool(name, pool)
+	}
 
-	// 3. One-time startup orphan cleanup
-	if err := queue.CleanupStartupOrphans(ctx, dbClient.Client, podID); err != nil {
-		slog.Error("Failed to cleanup startup orphans", "error", err)
-		// Non-fatal — continue
+	if err := applyBlobStoreConfig(cfg.BlobStore, dbClient); err != nil {
+		slog.Error("Failed to initialize blob store", "error", err)
+		os.Exit(1)
 	}
 
+	// 3. Startup orphan cleanup runs in step 6, once the worker pool and its
+	// notification wiring exist.
+
 	// 4. Initialize masking service and domain services
 	maskingService := masking.NewService(
 		cfg.MCPServerRegistry,
@@ -152,37 +282,101 @@ func main() {
 
 	alertService := services.NewAlertService(dbClient.Client, cfg.ChainRegistry, cfg.Defaults, maskingService)
 	sessionService := services.NewSessionService(dbClient.Client, cfg.ChainRegistry, cfg.MCPServerRegistry)
+	// Dashboard and API reads run on the API pool (the main pool unless
+	// DB_API_MAX_OPEN_CONNS is set), away from executor writes.
+	apiSessionService := services.NewSessionService(dbClient.API(), cfg.ChainRegistry, cfg.MCPServerRegistry)
 	if cfg.CostEstimation != nil {
 		sessionService.SetCostEstimationEnabled(cfg.CostEstimation.Enabled)
+		apiSessionService.SetCostEstimationEnabled(cfg.CostEstimation.Enabled)
 	}
+	// Chain budget forecasts (dry runs, budget checks at submission, and the
+	// estimate published when a session starts)
+	forecastService := services.NewForecastService(dbClient.Client, cfg.ChainRegistry,
+		cfg.CostEstimation == nil || cfg.CostEstimation.Enabled)
+	alertService.SetForecaster(forecastService)
 	slog.Info("Services initialized")
 
-	// 4a. Start cleanup service (retention + event TTL)
+	// 4a. Leader election for singleton background jobs. Jobs registered here
+	// run on exactly one pod; the elector is started once all are registered.
+	jobLeaderService := services.NewJobLeaderService(dbClient.Client)
+	elector := coordination.NewElector(podID, dbClient.DB(), jobLeaderService)
+
+	// 4b. Cleanup service (retention + event TTL), leader-elected
 	eventService := services.NewEventService(dbClient.Client)
-	cleanupService := cleanup.NewService(cfg.Retention, sessionService, eventService)
-	cleanupService.Start(ctx)
-	defer cleanupService.Stop()
+	elector.Register("retention-cleanup", cleanup.NewService(cfg.Retention, sessionService, eventService))
+
+	// 4c. Feature flags: configured rollouts plus runtime overrides shared
+	// through the database (reloaded by every replica)
+	featureFlags := featureflag.NewManager(cfg.FeatureFlags, cfg.ChainRegistry,
+		services.NewFeatureFlagService(dbClient.Client))
+	featureFlags.Start(ctx)
+	defer featureFlags.Stop()
+
+	// Fingerprint the loaded configuration before registered agents and
+	// chains are applied: drift detection compares the config files, while
+	// registrations are shared through the database.
+	configHash, configHashErr := cfg.Fingerprint()
+
+	// Agents and chains registered at runtime through the admin API
+	registrations := registration.NewManager(cfg, services.NewConfigRegistrationService(dbClient.Client))
+	registrations.Start(ctx)
+	defer registrations.Stop()
+	alertService.SetFeatureFlags(featureFlags)
 
 	// 5. Create LLM client and session executor
 	// Note: grpc.NewClient uses lazy dialing; actual connection happens on first RPC call
 	llmAddr := getEnv("LLM_SERVICE_ADDR", "localhost:50051")
-	llmClient, err := agent.NewGRPCLLMClient(llmAddr)
+	grpcLLMClient, err := agent.NewGRPCLLMClient(llmAddr)
 	if err != nil {
 		slog.Error("Failed to initialize LLM client", "addr", llmAddr, "error", err)
 		os.Exit(1)
 	}
 	defer func() {
-		if err := llmClient.Close(); err != nil {
+		if err := grpcLLMClient.Close(); err != nil {
 			slog.Error("Error closing LLM client", "error", err)
 		}
 	}()
 	slog.Info("LLM client initialized", "addr", llmAddr)
 
+	// Simulated MCP and LLM failures (test/staging only; nil unless
+	// system.fault_injection.enabled)
+	faults := faultinject.New(cfg.FaultInjection, cfg.MCPServerRegistry)
+	llmClient := agent.WithFaultInjection(grpcLLMClient, faults)
+
+	// Price book for LLM usage cost estimation (async catalog fetch + snapshot
+	// fallback), shared by session executions and side calls
+	costBook, costErr := cost.NewBook(costConfigFrom(cfg.CostEstimation))
+	if costErr != nil {
+		slog.Error("Failed to initialize cost estimation price book", "error", costErr)
+		os.Exit(1)
+	}
+	costBook.Start(ctx)
+	defer costBook.Stop()
+	overrideCount := 0
+	if cfg.CostEstimation != nil {
+		overrideCount = len(cfg.CostEstimation.ModelRates)
+	}
+	slog.Info("Cost estimation price book initialized",
+		"enabled", costBook.Enabled(),
+		"overrides", overrideCount)
+
+	// Single-shot LLM calls made outside agent executions, recorded as
+	// session interactions charged to the chain owner
+	sideCalls := controller.NewSideCaller(llmClient, services.NewInteractionService(dbClient.Client, nil, costBook))
+
 	// 5a. Initialize streaming infrastructure
 	eventPublisher := events.NewEventPublisher(dbClient.DB())
 	catchupQuerier := events.NewEventServiceAdapter(eventService)
 	connManager := events.NewConnectionManager(catchupQuerier, 10*time.Second)
 
+	// Lifecycle events for external consumers (nil unless
+	// system.event_stream.enabled). Session and stage status transitions are
+	// picked up by wrapping the publisher handed to executors and the API.
+	eventStream := eventstream.NewPublisher(dbClient.Client, cfg.EventStream, cfg.DashboardURL)
+	eventStream.Start()
+	defer eventStream.Stop()
+	lifecyclePublisher := eventStream.Wrap(eventPublisher)
+
 	// Start NotifyListener (dedicated pgx connection for LISTEN)
 	notifyListener := events.NewNotifyListener(dbConfig.DSN(), connManager)
 	if err := notifyListener.Start(ctx); err != nil {
@@ -206,6 +400,36 @@ func main() {
 	// 5b. Initialize MCP infrastructure
 	warningsService := services.NewSystemWarningsService()
 	mcpFactory := mcp.NewClientFactory(cfg.MCPServerRegistry, maskingService)
+	mcpFactory.SetFaultInjector(faults)
+	// Health checks and startup validation run against the default target
+	defaultTarget, err := cfg.Targets.Resolve("", "", "")
+	if err != nil {
+		slog.Error("Failed to resolve default target", "error", err)
+		os.Exit(1)
+	}
+	mcpFactory.SetDefaultTarget(defaultTarget)
+	if faults != nil {
+		rates := faults.Rates()
+		slog.Warn("Fault injection enabled: MCP and LLM calls will fail at configured rates",
+			"mcp_timeout_rate", rates.MCPTimeoutRate,
+			"mcp_malformed_rate", rates.MCPMalformedRate,
+			"llm_rate_limit_rate", rates.LLMRateLimitRate)
+		warningsService.AddWarning(services.WarningCategoryFaultInjection,
+			"Fault injection is enabled: MCP and LLM calls fail at configured rates",
+			"Test/staging only. Rates: GET /api/v1/system/fault-injection", "")
+	}
+
+	// Surface unused and shadowed configuration (same checks as "tarsy validate-config")
+	if lint := config.NewValidator(cfg).Lint(); len(lint) > 0 {
+		details := make([]string, len(lint))
+		for i, w := range lint {
+			slog.Warn("Configuration lint", "check", w.Check, "ref", w.Ref, "message", w.Message)
+			details[i] = w.String()
+		}
+		warningsService.AddWarning(services.WarningCategoryConfigLint,
+			fmt.Sprintf("Configuration has %d unused or shadowed definitions", len(lint)),
+			strings.Join(details, "\n"), "")
+	}
 
 	// MCP startup validation: attempt to connect to all configured servers.
 	// Failures are non-fatal — TARSy starts degraded with warnings visible
@@ -215,6 +439,7 @@ func main() {
 		validationClient, err := mcpFactory.CreateClient(ctx, mcpServerIDs)
 		if err != nil {
 			slog.Warn("MCP client creation failed — starting degraded", "error", err)
+			checks.degraded(fmt.Sprintf("MCP client creation failed: %v", err))
 		} else {
 			failed := validationClient.FailedServers()
 			if len(failed) > 0 {
@@ -223,6 +448,7 @@ func main() {
 					warningsService.AddWarning("mcp_health",
 						fmt.Sprintf("MCP server %q unreachable at startup: %s", serverID, errMsg),
 						"Server will be retried by the health monitor.", serverID)
+					checks.degraded(fmt.Sprintf("MCP server %q unreachable: %s", serverID, errMsg))
 				}
 			} else {
 				slog.Info("MCP servers validated", "count", len(mcpServerIDs))
@@ -231,7 +457,8 @@ func main() {
 		}
 	}
 
-	// Start HealthMonitor (background goroutine)
+	// Start HealthMonitor (background goroutine). Runs on every pod, not
+	// leader-elected: MCP connectivity and its warnings are pod-local.
 	var healthMonitor *mcp.HealthMonitor
 	if len(mcpServerIDs) > 0 {
 		healthMonitor = mcp.NewHealthMonitor(mcpFactory, cfg.MCPServerRegistry, warningsService)
@@ -240,6 +467,24 @@ func main() {
 		slog.Info("MCP health monitor started")
 	}
 
+	// 5b-2. Config drift detection (compare config fingerprints across replicas)
+	if configHashErr != nil {
+		slog.Warn("Failed to fingerprint configuration, config drift detection disabled", "error", configHashErr)
+	} else {
+		driftService := configdrift.NewService(podID, configHash, version.GitCommit,
+			services.NewPodHeartbeatService(dbClient.Client), warningsService)
+		driftService.Start(ctx)
+		defer driftService.Stop()
+	}
+
+	// 5b-3. Automatic heap-profile capture when RSS crosses the configured
+	// threshold (pod-local; disabled unless rss_threshold_mb is set).
+	heapCapture := diagnostics.NewHeapCapture(cfg.Profiling.HeapCapture, podID)
+	go heapCapture.Run(ctx)
+	if len(cfg.Admins) > 0 {
+		slog.Info("Admin endpoints enabled", "admins", len(cfg.Admins))
+	}
+
 	// 5c. Create RunbookService
 	tokenEnv := "GITHUB_TOKEN"
 	if cfg.GitHub != nil && cfg.GitHub.TokenEnv != "" {
@@ -251,16 +496,28 @@ func main() {
 	if githubToken == "" && cfg.Runbooks != nil && cfg.Runbooks.RepoURL != "" {
 		warningsService.AddWarning("runbook", "GitHub token not configured",
 			"Set "+tokenEnv+" to access private repos. URL-based runbooks will fall back to default.", "")
+		checks.degraded("GitHub token not configured: " + tokenEnv + " is not set")
 	}
+	checks.exitOnFailure()
 
 	// 5d. Create Slack notification service (optional)
 	var slackService *tarsyslack.Service
 	if cfg.Slack != nil && cfg.Slack.Enabled {
 		slackToken := os.Getenv(cfg.Slack.TokenEnv)
+		slackTemplates := make(map[string]*config.SlackTemplateConfig)
+		for chainID, chain := range cfg.ChainRegistry.GetAll() {
+			if chain.Slack != nil {
+				slackTemplates[chainID] = chain.Slack
+			}
+		}
 		slackService = tarsyslack.NewService(tarsyslack.ServiceConfig{
-			Token:        slackToken,
-			Channel:      cfg.Slack.Channel,
-			DashboardURL: cfg.DashboardURL,
+			Token:               slackToken,
+			Channel:             cfg.Slack.Channel,
+			DashboardURL:        cfg.DashboardURL,
+			ThreadStatusUpdates: cfg.Slack.ThreadStatusUpdates,
+			RateLimitPerMinute:  cfg.Slack.RateLimitPerMinute,
+			DedupWindow:         cfg.Slack.DedupWindow,
+			Templates:           slackTemplates,
 		})
 		if slackToken == "" {
 			warningsService.AddWarning("slack", "Slack bot token not configured",
@@ -272,6 +529,88 @@ func main() {
 		slog.Info("Slack notifications disabled")
 	}
 
+	// 5d-2. Create PagerDuty notification service (optional; used by severity routing)
+	var pagerDutyService *pagerduty.Service
+	if cfg.PagerDuty != nil && cfg.PagerDuty.Enabled {
+		pagerDutyService = pagerduty.NewService(pagerduty.ServiceConfig{
+			RoutingKey:   os.Getenv(cfg.PagerDuty.RoutingKeyEnv),
+			DashboardURL: cfg.DashboardURL,
+		})
+		slog.Info("PagerDuty notifications enabled")
+	}
+	if cfg.NotificationRouting != nil && len(cfg.NotificationRouting.Rules) > 0 {
+		slog.Info("Severity-based notification routing enabled", "rules", len(cfg.NotificationRouting.Rules))
+	}
+
+	// 5d-3. Create email notification service (optional; recipients are per chain)
+	var emailClient *email.Client
+	var emailService *email.Service
+	if cfg.Email != nil && cfg.Email.Enabled {
+		emailChains := make(map[string]*config.ChainEmailConfig)
+		for chainID, chain := range cfg.ChainRegistry.GetAll() {
+			if chain.Email != nil {
+				emailChains[chainID] = chain.Email
+			}
+		}
+		emailClient = email.NewClient(email.ClientConfig{
+			Host:     cfg.Email.SMTPHost,
+			Port:     cfg.Email.SMTPPort,
+			Username: cfg.Email.Username,
+			Password: os.Getenv(cfg.Email.PasswordEnv),
+			From:     cfg.Email.From,
+			StartTLS: cfg.Email.StartTLS,
+		})
+		emailService = email.NewService(emailClient, email.ServiceConfig{
+			DashboardURL: cfg.DashboardURL,
+			DigestHour:   cfg.Email.DigestHour,
+			Chains:       emailChains,
+		})
+		if emailService == nil {
+			slog.Info("Email enabled but no chain has email recipients")
+		} else {
+			emailService.Start(ctx)
+			defer emailService.Stop()
+			slog.Info("Email notifications enabled", "smtp_host", cfg.Email.SMTPHost, "chains", len(emailChains))
+		}
+	}
+
+	// 5d-4. Create activity report service (optional; daily/weekly digests)
+	reportService := services.NewReportService(dbClient.Client)
+	if reporter := report.NewService(cfg.Reports, cfg.DashboardURL, sessionService, reportService); reporter != nil {
+		if cfg.Reports.Narrative {
+			if llmCfg, err := agent.ResolveSideCallConfig(cfg, nil, cfg.Reports.LLMProvider); err != nil {
+				slog.Warn("Report narrative disabled: LLM provider not found", "error", err)
+			} else {
+				// Narratives belong to no session, so they are not recorded
+				reporter.SetNarrator(controller.NewSideCaller(llmClient, nil), llmCfg)
+			}
+		}
+		reporter.SetSlackService(slackService)
+		if emailClient != nil {
+			reporter.SetEmailSender(emailClient)
+		}
+		elector.Register("activity-reports", reporter)
+		slog.Info("Activity reports enabled", "periods", cfg.Reports.Periods)
+	}
+
+	// 5d-5. Fan-out synthesis: workers trigger it as siblings finish; the
+	// leader sweeps groups whose last sibling ended without a worker
+	fanOutSynthesizer := fanout.NewSynthesizer(dbClient.Client, cfg, sideCalls)
+	elector.Register("fan-out-synthesis", fanOutSynthesizer)
+
+	// 5d-6. Backlog preemption of low-priority in-flight sessions (optional),
+	// leader-elected so one pod picks the sessions to preempt
+	if preemptor := queue.NewPreemptor(dbClient.Client, cfg.Queue, eventPublisher); preemptor != nil {
+		elector.Register("session-preemption", preemptor)
+		slog.Info("Session preemption enabled",
+			"backlog_threshold", cfg.Queue.Preemption.BacklogThreshold,
+			"deadline", cfg.Queue.Preemption.Deadline)
+	}
+
+	// 5d-7. Start leader election (stops led jobs and releases locks on shutdown)
+	elector.Start(ctx)
+	defer elector.Stop()
+
 	// Initialize memory service if memory is enabled (used by session, chat, and scoring executors)
 	var memoryService *memory.Service
 	var memCfg *config.MemoryConfig
@@ -292,33 +631,74 @@ func main() {
 		}
 	}
 
-	// 5e. Price book for LLM usage cost estimation (async catalog fetch + snapshot fallback)
-	costBook, costErr := cost.NewBook(costConfigFrom(cfg.CostEstimation))
-	if costErr != nil {
-		slog.Error("Failed to initialize cost estimation price book", "error", costErr)
+	// 5e. IP / asset inventory lookup tools (CSV tables are read at startup)
+	enrichmentSources, err := enrichment.NewSources(cfg.Enrichment)
+	if err != nil {
+		slog.Error("Failed to load enrichment sources", "error", err)
 		os.Exit(1)
 	}
-	costBook.Start(ctx)
-	defer costBook.Stop()
-	overrideCount := 0
-	if cfg.CostEstimation != nil {
-		overrideCount = len(cfg.CostEstimation.ModelRates)
+	if enrichmentSources != nil {
+		slog.Info("Enrichment lookup tools enabled",
+			"ip_lookup", enrichmentSources.IP != nil, "host_lookup", enrichmentSources.Host != nil)
 	}
-	slog.Info("Cost estimation price book initialized",
-		"enabled", costBook.Enabled(),
-		"overrides", overrideCount)
 
-	executor := queue.NewRealSessionExecutor(cfg, dbClient.Client, llmClient, eventPublisher, mcpFactory, runbookService, memoryService, memCfg)
+	executor := queue.NewRealSessionExecutor(cfg, dbClient.Client, llmClient, lifecyclePublisher, mcpFactory, runbookService, memoryService, memCfg)
 	executor.SetCostBook(costBook)
-	scoringExecutor := queue.NewScoringExecutor(cfg, dbClient.Client, llmClient, eventPublisher, runbookService, memoryService)
+	executor.SetWarningsService(warningsService)
+	executor.SetFeatureFlags(featureFlags)
+	executor.SetForecaster(forecastService)
+	executor.SetEnrichment(enrichmentSources)
+	if federationClient := federation.NewClient(cfg.Federation, cfg.DashboardURL); federationClient != nil {
+		executor.SetFederation(federationClient)
+		slog.Info("Federation enabled", "instances", len(cfg.Federation.Instances))
+	}
+	scoringExecutor := queue.NewScoringExecutor(cfg, dbClient.Client, llmClient, lifecyclePublisher, runbookService, memoryService)
 	scoringExecutor.SetCostBook(costBook)
 
 	// 6. Start worker pool (before HTTP server)
-	workerPool := queue.NewWorkerPool(podID, dbClient.Client, cfg.Queue, executor, scoringExecutor, eventPublisher, slackService)
+	workerPool := queue.NewWorkerPool(podID, dbClient.Client, cfg.Queue, executor, scoringExecutor, lifecyclePublisher, slackService)
+	workerPool.SetNotificationRouting(cfg.NotificationRouting, pagerDutyService)
+	workerPool.SetEmailService(emailService)
+	savedViewService := services.NewSavedViewService(dbClient.Client)
+	redactionReviewService := services.NewRedactionReviewService(dbClient.API())
+	workerPool.SetSavedViewNotifier(savedview.NewNotifier(savedViewService, eventPublisher, slackService))
+	workerPool.SetMilestoneNotifier(milestone.NewNotifier(cfg.ChainRegistry, slackService, cfg.DashboardURL))
+	workerPool.SetFanOutSynthesizer(fanOutSynthesizer)
+	callbackDeliverer := callback.NewDeliverer(dbClient.Client, cfg.Callbacks, cfg.DashboardURL)
+	defer callbackDeliverer.Stop()
+	workerPool.SetCallbackDeliverer(callbackDeliverer)
+	resultExporter, err := resultexport.NewExporter(cfg.ChainRegistry, sessionService, cfg.DashboardURL)
+	if err != nil {
+		slog.Error("Failed to initialize result export", "error", err)
+		os.Exit(1)
+	}
+	if resultExporter != nil {
+		defer resultExporter.Wait()
+		slog.Info("Result export enabled", "chains", resultExporter.Chains())
+	}
+	workerPool.SetResultExporter(resultExporter)
+	recurrenceComparer := recurrence.NewComparer(dbClient.Client, cfg, sideCalls)
+	workerPool.SetRecurrenceComparer(recurrenceComparer)
+	outcomeClassifier := outcome.NewClassifier(dbClient.Client, cfg, sideCalls)
+	workerPool.SetOutcomeClassifier(outcomeClassifier)
+	actionItemExtractor := followup.NewExtractor(dbClient.Client, cfg, sideCalls)
+	workerPool.SetActionItemExtractor(actionItemExtractor)
+	if cfg.OnCall.Enabled() {
+		workerPool.SetOnCallService(oncall.NewService(dbClient.Client, cfg, os.Getenv(cfg.OnCall.APITokenEnv)))
+		slog.Info("On-call lookup enabled", "provider", cfg.OnCall.Provider, "services", len(cfg.OnCall.Services))
+	}
+	workerPool.SetPauseStore(services.NewQueuePauseService(dbClient.Client), warningsService)
+	// One-time startup orphan cleanup (before workers begin claiming)
+	if err := workerPool.CleanupStartupOrphans(ctx); err != nil {
+		slog.Error("Failed to cleanup startup orphans", "error", err)
+		// Non-fatal — continue
+	}
 	if err := workerPool.Start(ctx); err != nil {
 		slog.Error("Failed to start worker pool", "error", err)
 		os.Exit(1)
 	}
+	// Resume completion callbacks interrupted by a previous shutdown
+	callbackDeliverer.Start(ctx)
 
 	// 6. Metrics: set worker gauge and start DB-polled session gauges
 	metrics.WorkersTotal.Set(float64(cfg.Queue.WorkerCount))
@@ -328,50 +708,82 @@ func main() {
 
 	// 6a. Create chat message executor (for follow-up chat processing)
 	chatService := services.NewChatService(dbClient.Client)
+	if cfg.Transcription.Enabled {
+		transcriber, trErr := transcription.New(cfg.Transcription)
+		if trErr != nil {
+			slog.Error("Failed to create transcriber — voice notes disabled", "error", trErr)
+		} else {
+			chatService.SetTranscriber(transcriber)
+			slog.Info("Voice note transcription enabled",
+				"provider", cfg.Transcription.Provider, "model", cfg.Transcription.Model)
+		}
+	}
 	chatExecutor := queue.NewChatMessageExecutor(
-		cfg, dbClient.Client, llmClient, mcpFactory, eventPublisher,
+		cfg, dbClient.Client, llmClient, mcpFactory, lifecyclePublisher,
 		queue.ChatMessageExecutorConfig{
 			SessionTimeout:    cfg.Queue.SessionTimeout,
 			HeartbeatInterval: cfg.Queue.HeartbeatInterval,
+			Limits:            cfg.ChatLimits,
 		},
 		runbookService, memoryService, memCfg,
 	)
 	chatExecutor.SetCostBook(costBook)
+	chatExecutor.SetQueuePublisher(eventPublisher)
+	chatExecutor.SetEnrichment(enrichmentSources)
 	slog.Info("Chat message executor initialized")
 
 	// 6b. Register cross-pod cancellation handler.
 	// When any pod publishes a cancel NOTIFY, every pod (including the sender)
 	// attempts a local cancel. The owning pod will find the session and cancel it.
 	notifyListener.RegisterHandler(events.CancellationsChannel, func(payload []byte) {
-		sessionID := string(payload)
-		workerPool.CancelSession(sessionID)
-		chatExecutor.CancelBySessionID(context.Background(), sessionID)
+		req := events.ParseCancellationPayload(payload)
+		if workerPool.CancelSession(req.SessionID) {
+			slog.Info("Cancelled session on this pod",
+				"session_id", req.SessionID,
+				"initiator", req.Initiator,
+				"cancelled_by", req.CancelledBy,
+				"reason", req.Reason)
+		}
+		chatExecutor.CancelBySessionID(context.Background(), req.SessionID)
 	})
 	slog.Info("Cross-pod cancellation handler registered")
 
 	// 7. Create HTTP server
-	httpServer := api.NewServer(cfg, dbClient, alertService, sessionService, workerPool, connManager)
+	httpServer := api.NewServer(cfg, dbClient, alertService, apiSessionService, workerPool, connManager)
 	if healthMonitor != nil {
 		httpServer.SetHealthMonitor(healthMonitor)
 	}
 	httpServer.SetWarningsService(warningsService)
 	httpServer.SetChatService(chatService)
 	httpServer.SetChatExecutor(chatExecutor)
-	httpServer.SetEventPublisher(eventPublisher)
+	httpServer.SetEventPublisher(lifecyclePublisher)
 	httpServer.SetCancelNotifier(eventPublisher)
 	httpServer.SetRunbookService(runbookService)
 	httpServer.SetScoringExecutor(scoringExecutor)
-	httpServer.SetScoringService(services.NewScoringService(dbClient.Client))
+	httpServer.SetScoringService(services.NewScoringService(dbClient.API()))
+	httpServer.SetReportService(reportService)
+	httpServer.SetUserProfileService(services.NewUserProfileService(dbClient.API()))
+	httpServer.SetSavedViewService(savedViewService)
+	httpServer.SetActionItemService(services.NewActionItemService(dbClient.Client))
+	httpServer.SetRedactionReviewService(redactionReviewService)
+	httpServer.SetCallbackDeliverer(callbackDeliverer)
+	httpServer.SetEventStream(eventStream)
+	httpServer.SetJobLeaderService(jobLeaderService)
 	if memoryService != nil {
 		httpServer.SetMemoryService(memoryService)
 	}
 	httpServer.SetCostBook(costBook)
+	httpServer.SetFeatureFlags(featureFlags)
+	httpServer.SetRegistrations(registrations)
+	httpServer.SetSandboxRunner(queue.NewSandboxRunner(dbClient.Client, executor, cfg.Queue, podID, workerPool, maskingService))
+	httpServer.SetFaultInjector(faults)
+	httpServer.SetHeapCapture(heapCapture)
 
 	// 7a. Wire trace and timeline endpoints.
-	messageService := services.NewMessageService(dbClient.Client)
-	interactionService := services.NewInteractionService(dbClient.Client, messageService, costBook)
-	stageService := services.NewStageService(dbClient.Client)
-	timelineService := services.NewTimelineService(dbClient.Client)
+	messageService := services.NewMessageService(dbClient.API())
+	interactionService := services.NewInteractionService(dbClient.API(), messageService, costBook)
+	stageService := services.NewStageService(dbClient.API())
+	timelineService := services.NewTimelineService(dbClient.API())
 	httpServer.SetInteractionService(interactionService)
 	httpServer.SetStageService(stageService)
 	httpServer.SetTimelineService(timelineService)
@@ -414,6 +826,12 @@ func main() {
 	}
 
 	// 10. Graceful shutdown
+	// Send WebSocket clients to the other pods first: events of sessions still
+	// finishing here reach them through LISTEN/NOTIFY.
+	wsDrainCtx, wsDrainCancel := context.WithTimeout(ctx, 10*time.Second)
+	connManager.Drain(wsDrainCtx, events.DefaultDrainRetryAfter)
+	wsDrainCancel()
+
 	workerShutdownCtx, workerCancel := context.WithTimeout(ctx, cfg.Queue.GracefulShutdownTimeout)
 	defer workerCancel()
 
@@ -445,6 +863,16 @@ func main() {
 		slog.Warn("Shutdown timeout exceeded — incomplete sessions will be orphan-recovered")
 	}
 
+	// Post-completion jobs spawned by finished sessions drain alongside scoring
+	sessionJobsDone := make(chan struct{})
+	go func() {
+		recurrenceComparer.Stop()
+		outcomeClassifier.Stop()
+		actionItemExtractor.Stop()
+		fanOutSynthesizer.Drain()
+		close(sessionJobsDone)
+	}()
+
 	// Then drain scoring executor (scoring goroutines spawned by completed sessions).
 	// Stop() waits up to ScoringShutdownTimeout for natural completion before
 	// force-cancelling remaining contexts.
@@ -465,6 +893,13 @@ func main() {
 		slog.Warn("Scoring executor shutdown timeout exceeded")
 	}
 
+	select {
+	case <-sessionJobsDone:
+		slog.Info("Post-completion jobs finished")
+	case <-scoringShutdownCtx.Done():
+		slog.Warn("Post-completion jobs shutdown timeout exceeded")
+	}
+
 	// Stop HTTP server with its own timeout budget
 	httpShutdownCtx, httpCancel := context.WithTimeout(ctx, 5*time.Second)
 	defer httpCancel()
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/database"
+	"github.com/codeready-toolchain/tarsy/pkg/masking"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// runRemask implements "tarsy remask": it rewrites historical sessions
+// through the current masking configuration (e.g. after adding a pattern for
+// a newly discovered secret format) and optionally pseudonymizes author
+// fields. Prints the number of rows modified per column and returns the
+// process exit code.
+func runRemask(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("remask", flag.ContinueOnError)
+	configDir := fs.String("config-dir",
+		getEnv("CONFIG_DIR", "./deploy/config"),
+		"Path to configuration directory")
+	dryRun := fs.Bool("dry-run", false,
+		"Report what would change without writing")
+	anonymize := fs.Bool("anonymize-authors", false,
+		"Replace author, assignee, and actor fields with pseudonyms")
+	since := fs.String("since", "",
+		"Only rewrite sessions created on or after this date (YYYY-MM-DD or RFC 3339)")
+	batchSize := fs.Int("batch-size", 0,
+		"Sessions loaded per page (0 = default)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	opts := services.BackfillOptions{
+		DryRun:           *dryRun,
+		AnonymizeAuthors: *anonymize,
+		BatchSize:        *batchSize,
+	}
+	if *since != "" {
+		t, err := parseSince(*since)
+		if err != nil {
+			slog.Error("Invalid --since", "value", *since, "error", err)
+			return 2
+		}
+		opts.Since = t
+	}
+
+	loadEnvFile(*configDir)
+
+	cfg, err := config.Initialize(ctx, *configDir)
+	if err != nil {
+		slog.Error("Failed to initialize configuration", "error", err)
+		return 1
+	}
+	applyLoggingConfig(cfg.Logging)
+
+	dbConfig, err := database.LoadConfigFromEnv()
+	if err != nil {
+		slog.Error("Failed to load database config", "error", err)
+		return 1
+	}
+	dbClient, err := database.NewClient(ctx, dbConfig)
+	if err != nil {
+		slog.Error("Failed to connect to database", "error", err)
+		return 1
+	}
+	defer func() { _ = dbClient.Close() }()
+
+	// Offloaded tool results are read and rewritten in the blob store.
+	if err := applyBlobStoreConfig(cfg.BlobStore, dbClient); err != nil {
+		slog.Error("Failed to initialize blob store", "error", err)
+		return 1
+	}
+
+	maskingService := masking.NewService(
+		cfg.MCPServerRegistry,
+		masking.AlertMaskingConfig{
+			Enabled:      cfg.Defaults.AlertMasking.Enabled,
+			PatternGroup: cfg.Defaults.AlertMasking.PatternGroup,
+		},
+	)
+
+	slog.Info("Rewriting historical sessions",
+		"dry_run", opts.DryRun,
+		"anonymize_authors", opts.AnonymizeAuthors,
+		"since", *since)
+
+	result, err := services.NewSessionBackfillService(dbClient.Client, maskingService).Run(ctx, opts)
+	if result != nil {
+		printBackfillResult(os.Stdout, result)
+	}
+	if err != nil {
+		slog.Error("Remask failed; sessions already processed were committed", "error", err)
+		return 1
+	}
+	return 0
+}
+
+// parseSince accepts a date (YYYY-MM-DD, UTC) or an RFC 3339 timestamp.
+func parseSince(s string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func printBackfillResult(out *os.File, r *services.BackfillResult) {
+	mode := "modified"
+	if r.DryRun {
+		mode = "would be modified (dry run)"
+	}
+	fmt.Fprintf(out, "Sessions scanned: %d, changed: %d\n", r.SessionsScanned, r.SessionsChanged)
+	fmt.Fprintf(out, "Rows %s: %d\n", mode, r.TotalRows())
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, col := range r.Columns() {
+		fmt.Fprintf(w, "  %s\t%d\n", col, r.Rows[col])
+	}
+	_ = w.Flush()
+}
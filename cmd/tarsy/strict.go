@@ -0,0 +1,38 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+)
+
+// startupChecks collects conditions TARSy normally tolerates by starting
+// degraded (unreachable MCP servers, missing optional credentials). With
+// strict startup enabled they become fatal: all of them are logged together
+// and the process exits, so a misconfigured production rollout fails its
+// readiness instead of serving with features silently missing.
+type startupChecks struct {
+	strict   bool
+	failures []string
+}
+
+// degraded records a tolerated startup problem. It is a no-op unless strict
+// startup is enabled; callers still log and raise their own warnings.
+func (c *startupChecks) degraded(problem string) {
+	if c.strict {
+		c.failures = append(c.failures, problem)
+	}
+}
+
+// exitOnFailure terminates the process when strict startup recorded any
+// problem.
+func (c *startupChecks) exitOnFailure() {
+	if len(c.failures) == 0 {
+		return
+	}
+	for _, f := range c.failures {
+		slog.Error("Strict startup check failed", "problem", f)
+	}
+	slog.Error("Refusing to start degraded in strict mode (unset STRICT_STARTUP or pass -strict-startup=false to allow)",
+		"failures", len(c.failures))
+	os.Exit(1)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// runValidateConfig implements "tarsy validate-config": it loads and
+// validates the configuration without connecting to the database or LLM
+// service, then prints lint warnings (unused and shadowed definitions).
+// Returns 1 when the configuration is invalid, or when -strict is set and
+// there are lint warnings, so it can gate config changes in CI.
+func runValidateConfig(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("validate-config", flag.ContinueOnError)
+	configDir := fs.String("config-dir",
+		getEnv("CONFIG_DIR", "./deploy/config"),
+		"Path to configuration directory")
+	strict := fs.Bool("strict", false,
+		"Exit non-zero when there are lint warnings")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	loadEnvFile(*configDir)
+
+	cfg, err := config.Initialize(ctx, *configDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Configuration is invalid: %v\n", err)
+		return 1
+	}
+
+	lint := config.NewValidator(cfg).Lint()
+	if len(lint) == 0 {
+		fmt.Println("Configuration is valid, no lint warnings")
+		return 0
+	}
+
+	fmt.Printf("Configuration is valid, %d lint warnings:\n\n", len(lint))
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tREF\tMESSAGE")
+	for _, w := range lint {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", w.Check, w.Ref, w.Message)
+	}
+	_ = tw.Flush()
+
+	if *strict {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+)
+
+// ActionItemCreate is the builder for creating a ActionItem entity.
+type ActionItemCreate struct {
+	config
+	mutation *ActionItemMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ActionItemCreate) SetCreatedAt(v time.Time) *ActionItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableCreatedAt(v *time.Time) *ActionItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ActionItemCreate) SetUpdatedAt(v time.Time) *ActionItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableUpdatedAt(v *time.Time) *ActionItemCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ActionItemCreate) SetCreatedBy(v string) *ActionItemCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableCreatedBy(v *string) *ActionItemCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *ActionItemCreate) SetUpdatedBy(v string) *ActionItemCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableUpdatedBy(v *string) *ActionItemCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *ActionItemCreate) SetSessionID(v string) *ActionItemCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ActionItemCreate) SetTitle(v string) *ActionItemCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *ActionItemCreate) SetDetails(v string) *ActionItemCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableDetails(v *string) *ActionItemCreate {
+	if v != nil {
+		_c.SetDetails(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ActionItemCreate) SetStatus(v actionitem.Status) *ActionItemCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableStatus(v *actionitem.Status) *ActionItemCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ActionItemCreate) SetSource(v actionitem.Source) *ActionItemCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetAssignee sets the "assignee" field.
+func (_c *ActionItemCreate) SetAssignee(v string) *ActionItemCreate {
+	_c.mutation.SetAssignee(v)
+	return _c
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableAssignee(v *string) *ActionItemCreate {
+	if v != nil {
+		_c.SetAssignee(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ActionItemCreate) SetCompletedAt(v time.Time) *ActionItemCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableCompletedAt(v *time.Time) *ActionItemCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_c *ActionItemCreate) SetCompletedBy(v string) *ActionItemCreate {
+	_c.mutation.SetCompletedBy(v)
+	return _c
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_c *ActionItemCreate) SetNillableCompletedBy(v *string) *ActionItemCreate {
+	if v != nil {
+		_c.SetCompletedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ActionItemCreate) SetID(v string) *ActionItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSession sets the "session" edge to the AlertSession entity.
+func (_c *ActionItemCreate) SetSession(v *AlertSession) *ActionItemCreate {
+	return _c.SetSessionID(v.ID)
+}
+
+// Mutation returns the ActionItemMutation object of the builder.
+func (_c *ActionItemCreate) Mutation() *ActionItemMutation {
+	return _c.mutation
+}
+
+// Save creates the ActionItem in the database.
+func (_c *ActionItemCreate) Save(ctx context.Context) (*ActionItem, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ActionItemCreate) SaveX(ctx context.Context) *ActionItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActionItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActionItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ActionItemCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if actionitem.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized actionitem.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := actionitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if actionitem.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized actionitem.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := actionitem.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := actionitem.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ActionItemCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ActionItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ActionItem.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "ActionItem.session_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "ActionItem.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := actionitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ActionItem.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ActionItem.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := actionitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ActionItem.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ActionItem.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := actionitem.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "ActionItem.source": %w`, err)}
+		}
+	}
+	if len(_c.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "ActionItem.session"`)}
+	}
+	return nil
+}
+
+func (_c *ActionItemCreate) sqlSave(ctx context.Context) (*ActionItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ActionItem.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ActionItemCreate) createSpec() (*ActionItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ActionItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(actionitem.Table, sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(actionitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(actionitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(actionitem.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(actionitem.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(actionitem.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(actionitem.FieldDetails, field.TypeString, value)
+		_node.Details = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(actionitem.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(actionitem.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Assignee(); ok {
+		_spec.SetField(actionitem.FieldAssignee, field.TypeString, value)
+		_node.Assignee = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(actionitem.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedBy(); ok {
+		_spec.SetField(actionitem.FieldCompletedBy, field.TypeString, value)
+		_node.CompletedBy = &value
+	}
+	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   actionitem.SessionTable,
+			Columns: []string{actionitem.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ActionItemCreateBulk is the builder for creating many ActionItem entities in bulk.
+type ActionItemCreateBulk struct {
+	config
+	err      error
+	builders []*ActionItemCreate
+}
+
+// Save creates the ActionItem entities in the database.
+func (_c *ActionItemCreateBulk) Save(ctx context.Context) ([]*ActionItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ActionItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActionItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ActionItemCreateBulk) SaveX(ctx context.Context) []*ActionItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActionItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActionItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
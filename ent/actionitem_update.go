@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ActionItemUpdate is the builder for updating ActionItem entities.
+type ActionItemUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ActionItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ActionItemUpdate builder.
+func (_u *ActionItemUpdate) Where(ps ...predicate.ActionItem) *ActionItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ActionItemUpdate) SetUpdatedAt(v time.Time) *ActionItemUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ActionItemUpdate) SetCreatedBy(v string) *ActionItemUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ActionItemUpdate) SetNillableCreatedBy(v *string) *ActionItemUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ActionItemUpdate) ClearCreatedBy() *ActionItemUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ActionItemUpdate) SetUpdatedBy(v string) *ActionItemUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ActionItemUpdate) SetNillableUpdatedBy(v *string) *ActionItemUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ActionItemUpdate) ClearUpdatedBy() *ActionItemUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ActionItemUpdate) SetTitle(v string) *ActionItemUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ActionItemUpdate) SetNillableTitle(v *string) *ActionItemUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *ActionItemUpdate) SetDetails(v string) *ActionItemUpdate {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_u *ActionItemUpdate) SetNillableDetails(v *string) *ActionItemUpdate {
+	if v != nil {
+		_u.SetDetails(*v)
+	}
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *ActionItemUpdate) ClearDetails() *ActionItemUpdate {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ActionItemUpdate) SetStatus(v actionitem.Status) *ActionItemUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ActionItemUpdate) SetNillableStatus(v *actionitem.Status) *ActionItemUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *ActionItemUpdate) SetAssignee(v string) *ActionItemUpdate {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *ActionItemUpdate) SetNillableAssignee(v *string) *ActionItemUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *ActionItemUpdate) ClearAssignee() *ActionItemUpdate {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ActionItemUpdate) SetCompletedAt(v time.Time) *ActionItemUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ActionItemUpdate) SetNillableCompletedAt(v *time.Time) *ActionItemUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ActionItemUpdate) ClearCompletedAt() *ActionItemUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_u *ActionItemUpdate) SetCompletedBy(v string) *ActionItemUpdate {
+	_u.mutation.SetCompletedBy(v)
+	return _u
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_u *ActionItemUpdate) SetNillableCompletedBy(v *string) *ActionItemUpdate {
+	if v != nil {
+		_u.SetCompletedBy(*v)
+	}
+	return _u
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (_u *ActionItemUpdate) ClearCompletedBy() *ActionItemUpdate {
+	_u.mutation.ClearCompletedBy()
+	return _u
+}
+
+// Mutation returns the ActionItemMutation object of the builder.
+func (_u *ActionItemUpdate) Mutation() *ActionItemMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ActionItemUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActionItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ActionItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActionItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ActionItemUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if actionitem.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized actionitem.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := actionitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ActionItemUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := actionitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ActionItem.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := actionitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ActionItem.status": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ActionItem.session"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ActionItemUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ActionItemUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ActionItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(actionitem.Table, actionitem.Columns, sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(actionitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(actionitem.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(actionitem.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(actionitem.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(actionitem.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(actionitem.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(actionitem.FieldDetails, field.TypeString, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(actionitem.FieldDetails, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(actionitem.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(actionitem.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(actionitem.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(actionitem.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(actionitem.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedBy(); ok {
+		_spec.SetField(actionitem.FieldCompletedBy, field.TypeString, value)
+	}
+	if _u.mutation.CompletedByCleared() {
+		_spec.ClearField(actionitem.FieldCompletedBy, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{actionitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ActionItemUpdateOne is the builder for updating a single ActionItem entity.
+type ActionItemUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ActionItemMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ActionItemUpdateOne) SetUpdatedAt(v time.Time) *ActionItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ActionItemUpdateOne) SetCreatedBy(v string) *ActionItemUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ActionItemUpdateOne) SetNillableCreatedBy(v *string) *ActionItemUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ActionItemUpdateOne) ClearCreatedBy() *ActionItemUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ActionItemUpdateOne) SetUpdatedBy(v string) *ActionItemUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ActionItemUpdateOne) SetNillableUpdatedBy(v *string) *ActionItemUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ActionItemUpdateOne) ClearUpdatedBy() *ActionItemUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ActionItemUpdateOne) SetTitle(v string) *ActionItemUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ActionItemUpdateOne) SetNillableTitle(v *string) *ActionItemUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *ActionItemUpdateOne) SetDetails(v string) *ActionItemUpdateOne {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// SetNillableDetails sets the "details" field if the given value is not nil.
+func (_u *ActionItemUpdateOne) SetNillableDetails(v *string) *ActionItemUpdateOne {
+	if v != nil {
+		_u.SetDetails(*v)
+	}
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *ActionItemUpdateOne) ClearDetails() *ActionItemUpdateOne {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ActionItemUpdateOne) SetStatus(v actionitem.Status) *ActionItemUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ActionItemUpdateOne) SetNillableStatus(v *actionitem.Status) *ActionItemUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAssignee sets the "assignee" field.
+func (_u *ActionItemUpdateOne) SetAssignee(v string) *ActionItemUpdateOne {
+	_u.mutation.SetAssignee(v)
+	return _u
+}
+
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *ActionItemUpdateOne) SetNillableAssignee(v *string) *ActionItemUpdateOne {
+	if v != nil {
+		_u.SetAssignee(*v)
+	}
+	return _u
+}
+
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *ActionItemUpdateOne) ClearAssignee() *ActionItemUpdateOne {
+	_u.mutation.ClearAssignee()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ActionItemUpdateOne) SetCompletedAt(v time.Time) *ActionItemUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ActionItemUpdateOne) SetNillableCompletedAt(v *time.Time) *ActionItemUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ActionItemUpdateOne) ClearCompletedAt() *ActionItemUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCompletedBy sets the "completed_by" field.
+func (_u *ActionItemUpdateOne) SetCompletedBy(v string) *ActionItemUpdateOne {
+	_u.mutation.SetCompletedBy(v)
+	return _u
+}
+
+// SetNillableCompletedBy sets the "completed_by" field if the given value is not nil.
+func (_u *ActionItemUpdateOne) SetNillableCompletedBy(v *string) *ActionItemUpdateOne {
+	if v != nil {
+		_u.SetCompletedBy(*v)
+	}
+	return _u
+}
+
+// ClearCompletedBy clears the value of the "completed_by" field.
+func (_u *ActionItemUpdateOne) ClearCompletedBy() *ActionItemUpdateOne {
+	_u.mutation.ClearCompletedBy()
+	return _u
+}
+
+// Mutation returns the ActionItemMutation object of the builder.
+func (_u *ActionItemUpdateOne) Mutation() *ActionItemMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ActionItemUpdate builder.
+func (_u *ActionItemUpdateOne) Where(ps ...predicate.ActionItem) *ActionItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ActionItemUpdateOne) Select(field string, fields ...string) *ActionItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ActionItem entity.
+func (_u *ActionItemUpdateOne) Save(ctx context.Context) (*ActionItem, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActionItemUpdateOne) SaveX(ctx context.Context) *ActionItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ActionItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActionItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ActionItemUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if actionitem.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized actionitem.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := actionitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ActionItemUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := actionitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ActionItem.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := actionitem.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ActionItem.status": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ActionItem.session"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ActionItemUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ActionItemUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ActionItemUpdateOne) sqlSave(ctx context.Context) (_node *ActionItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(actionitem.Table, actionitem.Columns, sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ActionItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, actionitem.FieldID)
+		for _, f := range fields {
+			if !actionitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != actionitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(actionitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(actionitem.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(actionitem.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(actionitem.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(actionitem.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(actionitem.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(actionitem.FieldDetails, field.TypeString, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(actionitem.FieldDetails, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(actionitem.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(actionitem.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(actionitem.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(actionitem.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(actionitem.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedBy(); ok {
+		_spec.SetField(actionitem.FieldCompletedBy, field.TypeString, value)
+	}
+	if _u.mutation.CompletedByCleared() {
+		_spec.ClearField(actionitem.FieldCompletedBy, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ActionItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{actionitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// ActivityReport is the model entity for the ActivityReport schema.
+type ActivityReport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Period holds the value of the "period" field.
+	Period activityreport.Period `json:"period,omitempty"`
+	// Inclusive start of the reported window (UTC)
+	WindowStart time.Time `json:"window_start,omitempty"`
+	// Exclusive end of the reported window (UTC)
+	WindowEnd time.Time `json:"window_end,omitempty"`
+	// Aggregated activity for the window
+	Stats schema.ActivityReportStats `json:"stats,omitempty"`
+	// LLM-written summary of the window
+	Narrative *string `json:"narrative,omitempty"`
+	// NarrativeError holds the value of the "narrative_error" field.
+	NarrativeError *string `json:"narrative_error,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ActivityReport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case activityreport.FieldStats:
+			values[i] = new([]byte)
+		case activityreport.FieldID, activityreport.FieldPeriod, activityreport.FieldNarrative, activityreport.FieldNarrativeError:
+			values[i] = new(sql.NullString)
+		case activityreport.FieldCreatedAt, activityreport.FieldWindowStart, activityreport.FieldWindowEnd:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ActivityReport fields.
+func (_m *ActivityReport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case activityreport.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case activityreport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case activityreport.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				_m.Period = activityreport.Period(value.String)
+			}
+		case activityreport.FieldWindowStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_start", values[i])
+			} else if value.Valid {
+				_m.WindowStart = value.Time
+			}
+		case activityreport.FieldWindowEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field window_end", values[i])
+			} else if value.Valid {
+				_m.WindowEnd = value.Time
+			}
+		case activityreport.FieldStats:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field stats", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Stats); err != nil {
+					return fmt.Errorf("unmarshal field stats: %w", err)
+				}
+			}
+		case activityreport.FieldNarrative:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field narrative", values[i])
+			} else if value.Valid {
+				_m.Narrative = new(string)
+				*_m.Narrative = value.String
+			}
+		case activityreport.FieldNarrativeError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field narrative_error", values[i])
+			} else if value.Valid {
+				_m.NarrativeError = new(string)
+				*_m.NarrativeError = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ActivityReport.
+// This includes values selected through modifiers, order, etc.
+func (_m *ActivityReport) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ActivityReport.
+// Note that you need to call ActivityReport.Unwrap() before calling this method if this ActivityReport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ActivityReport) Update() *ActivityReportUpdateOne {
+	return NewActivityReportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ActivityReport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ActivityReport) Unwrap() *ActivityReport {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ActivityReport is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ActivityReport) String() string {
+	var builder strings.Builder
+	builder.WriteString("ActivityReport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Period))
+	builder.WriteString(", ")
+	builder.WriteString("window_start=")
+	builder.WriteString(_m.WindowStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("window_end=")
+	builder.WriteString(_m.WindowEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("stats=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Stats))
+	builder.WriteString(", ")
+	if v := _m.Narrative; v != nil {
+		builder.WriteString("narrative=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.NarrativeError; v != nil {
+		builder.WriteString("narrative_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ActivityReports is a parsable slice of ActivityReport.
+type ActivityReports []*ActivityReport
@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package activityreport
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the activityreport type in the database.
+	Label = "activity_report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "report_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldPeriod holds the string denoting the period field in the database.
+	FieldPeriod = "period"
+	// FieldWindowStart holds the string denoting the window_start field in the database.
+	FieldWindowStart = "window_start"
+	// FieldWindowEnd holds the string denoting the window_end field in the database.
+	FieldWindowEnd = "window_end"
+	// FieldStats holds the string denoting the stats field in the database.
+	FieldStats = "stats"
+	// FieldNarrative holds the string denoting the narrative field in the database.
+	FieldNarrative = "narrative"
+	// FieldNarrativeError holds the string denoting the narrative_error field in the database.
+	FieldNarrativeError = "narrative_error"
+	// Table holds the table name of the activityreport in the database.
+	Table = "activity_reports"
+)
+
+// Columns holds all SQL columns for activityreport fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldPeriod,
+	FieldWindowStart,
+	FieldWindowEnd,
+	FieldStats,
+	FieldNarrative,
+	FieldNarrativeError,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Period defines the type for the "period" enum field.
+type Period string
+
+// Period values.
+const (
+	PeriodDaily  Period = "daily"
+	PeriodWeekly Period = "weekly"
+)
+
+func (pe Period) String() string {
+	return string(pe)
+}
+
+// PeriodValidator is a validator for the "period" field enum values. It is called by the builders before save.
+func PeriodValidator(pe Period) error {
+	switch pe {
+	case PeriodDaily, PeriodWeekly:
+		return nil
+	default:
+		return fmt.Errorf("activityreport: invalid enum value for period field: %q", pe)
+	}
+}
+
+// OrderOption defines the ordering options for the ActivityReport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPeriod orders the results by the period field.
+func ByPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriod, opts...).ToFunc()
+}
+
+// ByWindowStart orders the results by the window_start field.
+func ByWindowStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowStart, opts...).ToFunc()
+}
+
+// ByWindowEnd orders the results by the window_end field.
+func ByWindowEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowEnd, opts...).ToFunc()
+}
+
+// ByNarrative orders the results by the narrative field.
+func ByNarrative(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNarrative, opts...).ToFunc()
+}
+
+// ByNarrativeError orders the results by the narrative_error field.
+func ByNarrativeError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNarrativeError, opts...).ToFunc()
+}
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package activityreport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WindowStart applies equality check predicate on the "window_start" field. It's identical to WindowStartEQ.
+func WindowStart(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowEnd applies equality check predicate on the "window_end" field. It's identical to WindowEndEQ.
+func WindowEnd(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// Narrative applies equality check predicate on the "narrative" field. It's identical to NarrativeEQ.
+func Narrative(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldNarrative, v))
+}
+
+// NarrativeError applies equality check predicate on the "narrative_error" field. It's identical to NarrativeErrorEQ.
+func NarrativeError(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldNarrativeError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v Period) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v Period) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...Period) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...Period) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// WindowStartEQ applies the EQ predicate on the "window_start" field.
+func WindowStartEQ(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldWindowStart, v))
+}
+
+// WindowStartNEQ applies the NEQ predicate on the "window_start" field.
+func WindowStartNEQ(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNEQ(FieldWindowStart, v))
+}
+
+// WindowStartIn applies the In predicate on the "window_start" field.
+func WindowStartIn(vs ...time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIn(FieldWindowStart, vs...))
+}
+
+// WindowStartNotIn applies the NotIn predicate on the "window_start" field.
+func WindowStartNotIn(vs ...time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotIn(FieldWindowStart, vs...))
+}
+
+// WindowStartGT applies the GT predicate on the "window_start" field.
+func WindowStartGT(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGT(FieldWindowStart, v))
+}
+
+// WindowStartGTE applies the GTE predicate on the "window_start" field.
+func WindowStartGTE(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGTE(FieldWindowStart, v))
+}
+
+// WindowStartLT applies the LT predicate on the "window_start" field.
+func WindowStartLT(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLT(FieldWindowStart, v))
+}
+
+// WindowStartLTE applies the LTE predicate on the "window_start" field.
+func WindowStartLTE(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLTE(FieldWindowStart, v))
+}
+
+// WindowEndEQ applies the EQ predicate on the "window_end" field.
+func WindowEndEQ(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldWindowEnd, v))
+}
+
+// WindowEndNEQ applies the NEQ predicate on the "window_end" field.
+func WindowEndNEQ(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNEQ(FieldWindowEnd, v))
+}
+
+// WindowEndIn applies the In predicate on the "window_end" field.
+func WindowEndIn(vs ...time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndNotIn applies the NotIn predicate on the "window_end" field.
+func WindowEndNotIn(vs ...time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotIn(FieldWindowEnd, vs...))
+}
+
+// WindowEndGT applies the GT predicate on the "window_end" field.
+func WindowEndGT(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGT(FieldWindowEnd, v))
+}
+
+// WindowEndGTE applies the GTE predicate on the "window_end" field.
+func WindowEndGTE(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGTE(FieldWindowEnd, v))
+}
+
+// WindowEndLT applies the LT predicate on the "window_end" field.
+func WindowEndLT(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLT(FieldWindowEnd, v))
+}
+
+// WindowEndLTE applies the LTE predicate on the "window_end" field.
+func WindowEndLTE(v time.Time) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLTE(FieldWindowEnd, v))
+}
+
+// NarrativeEQ applies the EQ predicate on the "narrative" field.
+func NarrativeEQ(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldNarrative, v))
+}
+
+// NarrativeNEQ applies the NEQ predicate on the "narrative" field.
+func NarrativeNEQ(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNEQ(FieldNarrative, v))
+}
+
+// NarrativeIn applies the In predicate on the "narrative" field.
+func NarrativeIn(vs ...string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIn(FieldNarrative, vs...))
+}
+
+// NarrativeNotIn applies the NotIn predicate on the "narrative" field.
+func NarrativeNotIn(vs ...string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotIn(FieldNarrative, vs...))
+}
+
+// NarrativeGT applies the GT predicate on the "narrative" field.
+func NarrativeGT(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGT(FieldNarrative, v))
+}
+
+// NarrativeGTE applies the GTE predicate on the "narrative" field.
+func NarrativeGTE(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGTE(FieldNarrative, v))
+}
+
+// NarrativeLT applies the LT predicate on the "narrative" field.
+func NarrativeLT(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLT(FieldNarrative, v))
+}
+
+// NarrativeLTE applies the LTE predicate on the "narrative" field.
+func NarrativeLTE(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLTE(FieldNarrative, v))
+}
+
+// NarrativeContains applies the Contains predicate on the "narrative" field.
+func NarrativeContains(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldContains(FieldNarrative, v))
+}
+
+// NarrativeHasPrefix applies the HasPrefix predicate on the "narrative" field.
+func NarrativeHasPrefix(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldHasPrefix(FieldNarrative, v))
+}
+
+// NarrativeHasSuffix applies the HasSuffix predicate on the "narrative" field.
+func NarrativeHasSuffix(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldHasSuffix(FieldNarrative, v))
+}
+
+// NarrativeIsNil applies the IsNil predicate on the "narrative" field.
+func NarrativeIsNil() predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIsNull(FieldNarrative))
+}
+
+// NarrativeNotNil applies the NotNil predicate on the "narrative" field.
+func NarrativeNotNil() predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotNull(FieldNarrative))
+}
+
+// NarrativeEqualFold applies the EqualFold predicate on the "narrative" field.
+func NarrativeEqualFold(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEqualFold(FieldNarrative, v))
+}
+
+// NarrativeContainsFold applies the ContainsFold predicate on the "narrative" field.
+func NarrativeContainsFold(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldContainsFold(FieldNarrative, v))
+}
+
+// NarrativeErrorEQ applies the EQ predicate on the "narrative_error" field.
+func NarrativeErrorEQ(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEQ(FieldNarrativeError, v))
+}
+
+// NarrativeErrorNEQ applies the NEQ predicate on the "narrative_error" field.
+func NarrativeErrorNEQ(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNEQ(FieldNarrativeError, v))
+}
+
+// NarrativeErrorIn applies the In predicate on the "narrative_error" field.
+func NarrativeErrorIn(vs ...string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIn(FieldNarrativeError, vs...))
+}
+
+// NarrativeErrorNotIn applies the NotIn predicate on the "narrative_error" field.
+func NarrativeErrorNotIn(vs ...string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotIn(FieldNarrativeError, vs...))
+}
+
+// NarrativeErrorGT applies the GT predicate on the "narrative_error" field.
+func NarrativeErrorGT(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGT(FieldNarrativeError, v))
+}
+
+// NarrativeErrorGTE applies the GTE predicate on the "narrative_error" field.
+func NarrativeErrorGTE(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldGTE(FieldNarrativeError, v))
+}
+
+// NarrativeErrorLT applies the LT predicate on the "narrative_error" field.
+func NarrativeErrorLT(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLT(FieldNarrativeError, v))
+}
+
+// NarrativeErrorLTE applies the LTE predicate on the "narrative_error" field.
+func NarrativeErrorLTE(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldLTE(FieldNarrativeError, v))
+}
+
+// NarrativeErrorContains applies the Contains predicate on the "narrative_error" field.
+func NarrativeErrorContains(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldContains(FieldNarrativeError, v))
+}
+
+// NarrativeErrorHasPrefix applies the HasPrefix predicate on the "narrative_error" field.
+func NarrativeErrorHasPrefix(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldHasPrefix(FieldNarrativeError, v))
+}
+
+// NarrativeErrorHasSuffix applies the HasSuffix predicate on the "narrative_error" field.
+func NarrativeErrorHasSuffix(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldHasSuffix(FieldNarrativeError, v))
+}
+
+// NarrativeErrorIsNil applies the IsNil predicate on the "narrative_error" field.
+func NarrativeErrorIsNil() predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldIsNull(FieldNarrativeError))
+}
+
+// NarrativeErrorNotNil applies the NotNil predicate on the "narrative_error" field.
+func NarrativeErrorNotNil() predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldNotNull(FieldNarrativeError))
+}
+
+// NarrativeErrorEqualFold applies the EqualFold predicate on the "narrative_error" field.
+func NarrativeErrorEqualFold(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldEqualFold(FieldNarrativeError, v))
+}
+
+// NarrativeErrorContainsFold applies the ContainsFold predicate on the "narrative_error" field.
+func NarrativeErrorContainsFold(v string) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.FieldContainsFold(FieldNarrativeError, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ActivityReport) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ActivityReport) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ActivityReport) predicate.ActivityReport {
+	return predicate.ActivityReport(sql.NotPredicates(p))
+}
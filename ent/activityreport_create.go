@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// ActivityReportCreate is the builder for creating a ActivityReport entity.
+type ActivityReportCreate struct {
+	config
+	mutation *ActivityReportMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ActivityReportCreate) SetCreatedAt(v time.Time) *ActivityReportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ActivityReportCreate) SetNillableCreatedAt(v *time.Time) *ActivityReportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *ActivityReportCreate) SetPeriod(v activityreport.Period) *ActivityReportCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetWindowStart sets the "window_start" field.
+func (_c *ActivityReportCreate) SetWindowStart(v time.Time) *ActivityReportCreate {
+	_c.mutation.SetWindowStart(v)
+	return _c
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (_c *ActivityReportCreate) SetWindowEnd(v time.Time) *ActivityReportCreate {
+	_c.mutation.SetWindowEnd(v)
+	return _c
+}
+
+// SetStats sets the "stats" field.
+func (_c *ActivityReportCreate) SetStats(v schema.ActivityReportStats) *ActivityReportCreate {
+	_c.mutation.SetStats(v)
+	return _c
+}
+
+// SetNarrative sets the "narrative" field.
+func (_c *ActivityReportCreate) SetNarrative(v string) *ActivityReportCreate {
+	_c.mutation.SetNarrative(v)
+	return _c
+}
+
+// SetNillableNarrative sets the "narrative" field if the given value is not nil.
+func (_c *ActivityReportCreate) SetNillableNarrative(v *string) *ActivityReportCreate {
+	if v != nil {
+		_c.SetNarrative(*v)
+	}
+	return _c
+}
+
+// SetNarrativeError sets the "narrative_error" field.
+func (_c *ActivityReportCreate) SetNarrativeError(v string) *ActivityReportCreate {
+	_c.mutation.SetNarrativeError(v)
+	return _c
+}
+
+// SetNillableNarrativeError sets the "narrative_error" field if the given value is not nil.
+func (_c *ActivityReportCreate) SetNillableNarrativeError(v *string) *ActivityReportCreate {
+	if v != nil {
+		_c.SetNarrativeError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ActivityReportCreate) SetID(v string) *ActivityReportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ActivityReportMutation object of the builder.
+func (_c *ActivityReportCreate) Mutation() *ActivityReportMutation {
+	return _c.mutation
+}
+
+// Save creates the ActivityReport in the database.
+func (_c *ActivityReportCreate) Save(ctx context.Context) (*ActivityReport, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ActivityReportCreate) SaveX(ctx context.Context) *ActivityReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActivityReportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActivityReportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ActivityReportCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := activityreport.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ActivityReportCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ActivityReport.created_at"`)}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "ActivityReport.period"`)}
+	}
+	if v, ok := _c.mutation.Period(); ok {
+		if err := activityreport.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "ActivityReport.period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.WindowStart(); !ok {
+		return &ValidationError{Name: "window_start", err: errors.New(`ent: missing required field "ActivityReport.window_start"`)}
+	}
+	if _, ok := _c.mutation.WindowEnd(); !ok {
+		return &ValidationError{Name: "window_end", err: errors.New(`ent: missing required field "ActivityReport.window_end"`)}
+	}
+	if _, ok := _c.mutation.Stats(); !ok {
+		return &ValidationError{Name: "stats", err: errors.New(`ent: missing required field "ActivityReport.stats"`)}
+	}
+	return nil
+}
+
+func (_c *ActivityReportCreate) sqlSave(ctx context.Context) (*ActivityReport, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ActivityReport.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ActivityReportCreate) createSpec() (*ActivityReport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ActivityReport{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(activityreport.Table, sqlgraph.NewFieldSpec(activityreport.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(activityreport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(activityreport.FieldPeriod, field.TypeEnum, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.WindowStart(); ok {
+		_spec.SetField(activityreport.FieldWindowStart, field.TypeTime, value)
+		_node.WindowStart = value
+	}
+	if value, ok := _c.mutation.WindowEnd(); ok {
+		_spec.SetField(activityreport.FieldWindowEnd, field.TypeTime, value)
+		_node.WindowEnd = value
+	}
+	if value, ok := _c.mutation.Stats(); ok {
+		_spec.SetField(activityreport.FieldStats, field.TypeJSON, value)
+		_node.Stats = value
+	}
+	if value, ok := _c.mutation.Narrative(); ok {
+		_spec.SetField(activityreport.FieldNarrative, field.TypeString, value)
+		_node.Narrative = &value
+	}
+	if value, ok := _c.mutation.NarrativeError(); ok {
+		_spec.SetField(activityreport.FieldNarrativeError, field.TypeString, value)
+		_node.NarrativeError = &value
+	}
+	return _node, _spec
+}
+
+// ActivityReportCreateBulk is the builder for creating many ActivityReport entities in bulk.
+type ActivityReportCreateBulk struct {
+	config
+	err      error
+	builders []*ActivityReportCreate
+}
+
+// Save creates the ActivityReport entities in the database.
+func (_c *ActivityReportCreateBulk) Save(ctx context.Context) ([]*ActivityReport, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ActivityReport, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActivityReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ActivityReportCreateBulk) SaveX(ctx context.Context) []*ActivityReport {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ActivityReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ActivityReportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// ActivityReportUpdate is the builder for updating ActivityReport entities.
+type ActivityReportUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ActivityReportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ActivityReportUpdate builder.
+func (_u *ActivityReportUpdate) Where(ps ...predicate.ActivityReport) *ActivityReportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStats sets the "stats" field.
+func (_u *ActivityReportUpdate) SetStats(v schema.ActivityReportStats) *ActivityReportUpdate {
+	_u.mutation.SetStats(v)
+	return _u
+}
+
+// SetNillableStats sets the "stats" field if the given value is not nil.
+func (_u *ActivityReportUpdate) SetNillableStats(v *schema.ActivityReportStats) *ActivityReportUpdate {
+	if v != nil {
+		_u.SetStats(*v)
+	}
+	return _u
+}
+
+// SetNarrative sets the "narrative" field.
+func (_u *ActivityReportUpdate) SetNarrative(v string) *ActivityReportUpdate {
+	_u.mutation.SetNarrative(v)
+	return _u
+}
+
+// SetNillableNarrative sets the "narrative" field if the given value is not nil.
+func (_u *ActivityReportUpdate) SetNillableNarrative(v *string) *ActivityReportUpdate {
+	if v != nil {
+		_u.SetNarrative(*v)
+	}
+	return _u
+}
+
+// ClearNarrative clears the value of the "narrative" field.
+func (_u *ActivityReportUpdate) ClearNarrative() *ActivityReportUpdate {
+	_u.mutation.ClearNarrative()
+	return _u
+}
+
+// SetNarrativeError sets the "narrative_error" field.
+func (_u *ActivityReportUpdate) SetNarrativeError(v string) *ActivityReportUpdate {
+	_u.mutation.SetNarrativeError(v)
+	return _u
+}
+
+// SetNillableNarrativeError sets the "narrative_error" field if the given value is not nil.
+func (_u *ActivityReportUpdate) SetNillableNarrativeError(v *string) *ActivityReportUpdate {
+	if v != nil {
+		_u.SetNarrativeError(*v)
+	}
+	return _u
+}
+
+// ClearNarrativeError clears the value of the "narrative_error" field.
+func (_u *ActivityReportUpdate) ClearNarrativeError() *ActivityReportUpdate {
+	_u.mutation.ClearNarrativeError()
+	return _u
+}
+
+// Mutation returns the ActivityReportMutation object of the builder.
+func (_u *ActivityReportUpdate) Mutation() *ActivityReportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ActivityReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActivityReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ActivityReportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActivityReportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ActivityReportUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ActivityReportUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ActivityReportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(activityreport.Table, activityreport.Columns, sqlgraph.NewFieldSpec(activityreport.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Stats(); ok {
+		_spec.SetField(activityreport.FieldStats, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Narrative(); ok {
+		_spec.SetField(activityreport.FieldNarrative, field.TypeString, value)
+	}
+	if _u.mutation.NarrativeCleared() {
+		_spec.ClearField(activityreport.FieldNarrative, field.TypeString)
+	}
+	if value, ok := _u.mutation.NarrativeError(); ok {
+		_spec.SetField(activityreport.FieldNarrativeError, field.TypeString, value)
+	}
+	if _u.mutation.NarrativeErrorCleared() {
+		_spec.ClearField(activityreport.FieldNarrativeError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{activityreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ActivityReportUpdateOne is the builder for updating a single ActivityReport entity.
+type ActivityReportUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ActivityReportMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStats sets the "stats" field.
+func (_u *ActivityReportUpdateOne) SetStats(v schema.ActivityReportStats) *ActivityReportUpdateOne {
+	_u.mutation.SetStats(v)
+	return _u
+}
+
+// SetNillableStats sets the "stats" field if the given value is not nil.
+func (_u *ActivityReportUpdateOne) SetNillableStats(v *schema.ActivityReportStats) *ActivityReportUpdateOne {
+	if v != nil {
+		_u.SetStats(*v)
+	}
+	return _u
+}
+
+// SetNarrative sets the "narrative" field.
+func (_u *ActivityReportUpdateOne) SetNarrative(v string) *ActivityReportUpdateOne {
+	_u.mutation.SetNarrative(v)
+	return _u
+}
+
+// SetNillableNarrative sets the "narrative" field if the given value is not nil.
+func (_u *ActivityReportUpdateOne) SetNillableNarrative(v *string) *ActivityReportUpdateOne {
+	if v != nil {
+		_u.SetNarrative(*v)
+	}
+	return _u
+}
+
+// ClearNarrative clears the value of the "narrative" field.
+func (_u *ActivityReportUpdateOne) ClearNarrative() *ActivityReportUpdateOne {
+	_u.mutation.ClearNarrative()
+	return _u
+}
+
+// SetNarrativeError sets the "narrative_error" field.
+func (_u *ActivityReportUpdateOne) SetNarrativeError(v string) *ActivityReportUpdateOne {
+	_u.mutation.SetNarrativeError(v)
+	return _u
+}
+
+// SetNillableNarrativeError sets the "narrative_error" field if the given value is not nil.
+func (_u *ActivityReportUpdateOne) SetNillableNarrativeError(v *string) *ActivityReportUpdateOne {
+	if v != nil {
+		_u.SetNarrativeError(*v)
+	}
+	return _u
+}
+
+// ClearNarrativeError clears the value of the "narrative_error" field.
+func (_u *ActivityReportUpdateOne) ClearNarrativeError() *ActivityReportUpdateOne {
+	_u.mutation.ClearNarrativeError()
+	return _u
+}
+
+// Mutation returns the ActivityReportMutation object of the builder.
+func (_u *ActivityReportUpdateOne) Mutation() *ActivityReportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ActivityReportUpdate builder.
+func (_u *ActivityReportUpdateOne) Where(ps ...predicate.ActivityReport) *ActivityReportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ActivityReportUpdateOne) Select(field string, fields ...string) *ActivityReportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ActivityReport entity.
+func (_u *ActivityReportUpdateOne) Save(ctx context.Context) (*ActivityReport, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ActivityReportUpdateOne) SaveX(ctx context.Context) *ActivityReport {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ActivityReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ActivityReportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ActivityReportUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ActivityReportUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ActivityReportUpdateOne) sqlSave(ctx context.Context) (_node *ActivityReport, err error) {
+	_spec := sqlgraph.NewUpdateSpec(activityreport.Table, activityreport.Columns, sqlgraph.NewFieldSpec(activityreport.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ActivityReport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, activityreport.FieldID)
+		for _, f := range fields {
+			if !activityreport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != activityreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Stats(); ok {
+		_spec.SetField(activityreport.FieldStats, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Narrative(); ok {
+		_spec.SetField(activityreport.FieldNarrative, field.TypeString, value)
+	}
+	if _u.mutation.NarrativeCleared() {
+		_spec.ClearField(activityreport.FieldNarrative, field.TypeString)
+	}
+	if value, ok := _u.mutation.NarrativeError(); ok {
+		_spec.SetField(activityreport.FieldNarrativeError, field.TypeString, value)
+	}
+	if _u.mutation.NarrativeErrorCleared() {
+		_spec.ClearField(activityreport.FieldNarrativeError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ActivityReport{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{activityreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
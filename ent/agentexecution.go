@@ -49,6 +49,10 @@ type AgentExecution struct {
 	ParentExecutionID *string `json:"parent_execution_id,omitempty"`
 	// Task description from orchestrator dispatch
 	Task *string `json:"task,omitempty"`
+	// Tool calls the agent made during this execution
+	ToolCallCount int `json:"tool_call_count,omitempty"`
+	// The agent repeated an identical tool call beyond max_duplicate_tool_calls
+	LoopDetected bool `json:"loop_detected,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AgentExecutionQuery when eager-loading is set.
 	Edges        AgentExecutionEdges `json:"edges"`
@@ -172,7 +176,9 @@ func (*AgentExecution) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case agentexecution.FieldAgentIndex, agentexecution.FieldDurationMs:
+		case agentexecution.FieldLoopDetected:
+			values[i] = new(sql.NullBool)
+		case agentexecution.FieldAgentIndex, agentexecution.FieldDurationMs, agentexecution.FieldToolCallCount:
 			values[i] = new(sql.NullInt64)
 		case agentexecution.FieldID, agentexecution.FieldStageID, agentexecution.FieldSessionID, agentexecution.FieldAgentName, agentexecution.FieldStatus, agentexecution.FieldErrorMessage, agentexecution.FieldLlmBackend, agentexecution.FieldLlmProvider, agentexecution.FieldOriginalLlmProvider, agentexecution.FieldOriginalLlmBackend, agentexecution.FieldParentExecutionID, agentexecution.FieldTask:
 			values[i] = new(sql.NullString)
@@ -298,6 +304,18 @@ func (_m *AgentExecution) assignValues(columns []string, values []any) error {
 				_m.Task = new(string)
 				*_m.Task = value.String
 			}
+		case agentexecution.FieldToolCallCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tool_call_count", values[i])
+			} else if value.Valid {
+				_m.ToolCallCount = int(value.Int64)
+			}
+		case agentexecution.FieldLoopDetected:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field loop_detected", values[i])
+			} else if value.Valid {
+				_m.LoopDetected = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -441,6 +459,12 @@ func (_m *AgentExecution) String() string {
 		builder.WriteString("task=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("tool_call_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToolCallCount))
+	builder.WriteString(", ")
+	builder.WriteString("loop_detected=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LoopDetected))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -44,6 +44,10 @@ const (
 	FieldParentExecutionID = "parent_execution_id"
 	// FieldTask holds the string denoting the task field in the database.
 	FieldTask = "task"
+	// FieldToolCallCount holds the string denoting the tool_call_count field in the database.
+	FieldToolCallCount = "tool_call_count"
+	// FieldLoopDetected holds the string denoting the loop_detected field in the database.
+	FieldLoopDetected = "loop_detected"
 	// EdgeStage holds the string denoting the stage edge name in mutations.
 	EdgeStage = "stage"
 	// EdgeSession holds the string denoting the session edge name in mutations.
@@ -153,6 +157,8 @@ var Columns = []string{
 	FieldOriginalLlmBackend,
 	FieldParentExecutionID,
 	FieldTask,
+	FieldToolCallCount,
+	FieldLoopDetected,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -165,6 +171,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultToolCallCount holds the default value on creation for the "tool_call_count" field.
+	DefaultToolCallCount int
+	// DefaultLoopDetected holds the default value on creation for the "loop_detected" field.
+	DefaultLoopDetected bool
+)
+
 // Status defines the type for the "status" enum field.
 type Status string
 
@@ -278,6 +291,16 @@ func ByTask(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTask, opts...).ToFunc()
 }
 
+// ByToolCallCount orders the results by the tool_call_count field.
+func ByToolCallCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToolCallCount, opts...).ToFunc()
+}
+
+// ByLoopDetected orders the results by the loop_detected field.
+func ByLoopDetected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoopDetected, opts...).ToFunc()
+}
+
 // ByStageField orders the results by stage field.
 func ByStageField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
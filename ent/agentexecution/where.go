@@ -135,6 +135,16 @@ func Task(v string) predicate.AgentExecution {
 	return predicate.AgentExecution(sql.FieldEQ(FieldTask, v))
 }
 
+// ToolCallCount applies equality check predicate on the "tool_call_count" field. It's identical to ToolCallCountEQ.
+func ToolCallCount(v int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldEQ(FieldToolCallCount, v))
+}
+
+// LoopDetected applies equality check predicate on the "loop_detected" field. It's identical to LoopDetectedEQ.
+func LoopDetected(v bool) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldEQ(FieldLoopDetected, v))
+}
+
 // StageIDEQ applies the EQ predicate on the "stage_id" field.
 func StageIDEQ(v string) predicate.AgentExecution {
 	return predicate.AgentExecution(sql.FieldEQ(FieldStageID, v))
@@ -1055,6 +1065,56 @@ func TaskContainsFold(v string) predicate.AgentExecution {
 	return predicate.AgentExecution(sql.FieldContainsFold(FieldTask, v))
 }
 
+// ToolCallCountEQ applies the EQ predicate on the "tool_call_count" field.
+func ToolCallCountEQ(v int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldEQ(FieldToolCallCount, v))
+}
+
+// ToolCallCountNEQ applies the NEQ predicate on the "tool_call_count" field.
+func ToolCallCountNEQ(v int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldNEQ(FieldToolCallCount, v))
+}
+
+// ToolCallCountIn applies the In predicate on the "tool_call_count" field.
+func ToolCallCountIn(vs ...int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldIn(FieldToolCallCount, vs...))
+}
+
+// ToolCallCountNotIn applies the NotIn predicate on the "tool_call_count" field.
+func ToolCallCountNotIn(vs ...int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldNotIn(FieldToolCallCount, vs...))
+}
+
+// ToolCallCountGT applies the GT predicate on the "tool_call_count" field.
+func ToolCallCountGT(v int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldGT(FieldToolCallCount, v))
+}
+
+// ToolCallCountGTE applies the GTE predicate on the "tool_call_count" field.
+func ToolCallCountGTE(v int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldGTE(FieldToolCallCount, v))
+}
+
+// ToolCallCountLT applies the LT predicate on the "tool_call_count" field.
+func ToolCallCountLT(v int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldLT(FieldToolCallCount, v))
+}
+
+// ToolCallCountLTE applies the LTE predicate on the "tool_call_count" field.
+func ToolCallCountLTE(v int) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldLTE(FieldToolCallCount, v))
+}
+
+// LoopDetectedEQ applies the EQ predicate on the "loop_detected" field.
+func LoopDetectedEQ(v bool) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldEQ(FieldLoopDetected, v))
+}
+
+// LoopDetectedNEQ applies the NEQ predicate on the "loop_detected" field.
+func LoopDetectedNEQ(v bool) predicate.AgentExecution {
+	return predicate.AgentExecution(sql.FieldNEQ(FieldLoopDetected, v))
+}
+
 // HasStage applies the HasEdge predicate on the "stage" edge.
 func HasStage() predicate.AgentExecution {
 	return predicate.AgentExecution(func(s *sql.Selector) {
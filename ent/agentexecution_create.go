@@ -196,6 +196,34 @@ func (_c *AgentExecutionCreate) SetNillableTask(v *string) *AgentExecutionCreate
 	return _c
 }
 
+// SetToolCallCount sets the "tool_call_count" field.
+func (_c *AgentExecutionCreate) SetToolCallCount(v int) *AgentExecutionCreate {
+	_c.mutation.SetToolCallCount(v)
+	return _c
+}
+
+// SetNillableToolCallCount sets the "tool_call_count" field if the given value is not nil.
+func (_c *AgentExecutionCreate) SetNillableToolCallCount(v *int) *AgentExecutionCreate {
+	if v != nil {
+		_c.SetToolCallCount(*v)
+	}
+	return _c
+}
+
+// SetLoopDetected sets the "loop_detected" field.
+func (_c *AgentExecutionCreate) SetLoopDetected(v bool) *AgentExecutionCreate {
+	_c.mutation.SetLoopDetected(v)
+	return _c
+}
+
+// SetNillableLoopDetected sets the "loop_detected" field if the given value is not nil.
+func (_c *AgentExecutionCreate) SetNillableLoopDetected(v *bool) *AgentExecutionCreate {
+	if v != nil {
+		_c.SetLoopDetected(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AgentExecutionCreate) SetID(v string) *AgentExecutionCreate {
 	_c.mutation.SetID(v)
@@ -360,6 +388,14 @@ func (_c *AgentExecutionCreate) defaults() {
 		v := agentexecution.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.ToolCallCount(); !ok {
+		v := agentexecution.DefaultToolCallCount
+		_c.mutation.SetToolCallCount(v)
+	}
+	if _, ok := _c.mutation.LoopDetected(); !ok {
+		v := agentexecution.DefaultLoopDetected
+		_c.mutation.SetLoopDetected(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -387,6 +423,12 @@ func (_c *AgentExecutionCreate) check() error {
 	if _, ok := _c.mutation.LlmBackend(); !ok {
 		return &ValidationError{Name: "llm_backend", err: errors.New(`ent: missing required field "AgentExecution.llm_backend"`)}
 	}
+	if _, ok := _c.mutation.ToolCallCount(); !ok {
+		return &ValidationError{Name: "tool_call_count", err: errors.New(`ent: missing required field "AgentExecution.tool_call_count"`)}
+	}
+	if _, ok := _c.mutation.LoopDetected(); !ok {
+		return &ValidationError{Name: "loop_detected", err: errors.New(`ent: missing required field "AgentExecution.loop_detected"`)}
+	}
 	if len(_c.mutation.StageIDs()) == 0 {
 		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required edge "AgentExecution.stage"`)}
 	}
@@ -476,6 +518,14 @@ func (_c *AgentExecutionCreate) createSpec() (*AgentExecution, *sqlgraph.CreateS
 		_spec.SetField(agentexecution.FieldTask, field.TypeString, value)
 		_node.Task = &value
 	}
+	if value, ok := _c.mutation.ToolCallCount(); ok {
+		_spec.SetField(agentexecution.FieldToolCallCount, field.TypeInt, value)
+		_node.ToolCallCount = value
+	}
+	if value, ok := _c.mutation.LoopDetected(); ok {
+		_spec.SetField(agentexecution.FieldLoopDetected, field.TypeBool, value)
+		_node.LoopDetected = value
+	}
 	if nodes := _c.mutation.StageIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -283,6 +283,41 @@ func (_u *AgentExecutionUpdate) ClearTask() *AgentExecutionUpdate {
 	return _u
 }
 
+// SetToolCallCount sets the "tool_call_count" field.
+func (_u *AgentExecutionUpdate) SetToolCallCount(v int) *AgentExecutionUpdate {
+	_u.mutation.ResetToolCallCount()
+	_u.mutation.SetToolCallCount(v)
+	return _u
+}
+
+// SetNillableToolCallCount sets the "tool_call_count" field if the given value is not nil.
+func (_u *AgentExecutionUpdate) SetNillableToolCallCount(v *int) *AgentExecutionUpdate {
+	if v != nil {
+		_u.SetToolCallCount(*v)
+	}
+	return _u
+}
+
+// AddToolCallCount adds value to the "tool_call_count" field.
+func (_u *AgentExecutionUpdate) AddToolCallCount(v int) *AgentExecutionUpdate {
+	_u.mutation.AddToolCallCount(v)
+	return _u
+}
+
+// SetLoopDetected sets the "loop_detected" field.
+func (_u *AgentExecutionUpdate) SetLoopDetected(v bool) *AgentExecutionUpdate {
+	_u.mutation.SetLoopDetected(v)
+	return _u
+}
+
+// SetNillableLoopDetected sets the "loop_detected" field if the given value is not nil.
+func (_u *AgentExecutionUpdate) SetNillableLoopDetected(v *bool) *AgentExecutionUpdate {
+	if v != nil {
+		_u.SetLoopDetected(*v)
+	}
+	return _u
+}
+
 // AddTimelineEventIDs adds the "timeline_events" edge to the TimelineEvent entity by IDs.
 func (_u *AgentExecutionUpdate) AddTimelineEventIDs(ids ...string) *AgentExecutionUpdate {
 	_u.mutation.AddTimelineEventIDs(ids...)
@@ -656,6 +691,15 @@ func (_u *AgentExecutionUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.TaskCleared() {
 		_spec.ClearField(agentexecution.FieldTask, field.TypeString)
 	}
+	if value, ok := _u.mutation.ToolCallCount(); ok {
+		_spec.SetField(agentexecution.FieldToolCallCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedToolCallCount(); ok {
+		_spec.AddField(agentexecution.FieldToolCallCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LoopDetected(); ok {
+		_spec.SetField(agentexecution.FieldLoopDetected, field.TypeBool, value)
+	}
 	if _u.mutation.TimelineEventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1227,6 +1271,41 @@ func (_u *AgentExecutionUpdateOne) ClearTask() *AgentExecutionUpdateOne {
 	return _u
 }
 
+// SetToolCallCount sets the "tool_call_count" field.
+func (_u *AgentExecutionUpdateOne) SetToolCallCount(v int) *AgentExecutionUpdateOne {
+	_u.mutation.ResetToolCallCount()
+	_u.mutation.SetToolCallCount(v)
+	return _u
+}
+
+// SetNillableToolCallCount sets the "tool_call_count" field if the given value is not nil.
+func (_u *AgentExecutionUpdateOne) SetNillableToolCallCount(v *int) *AgentExecutionUpdateOne {
+	if v != nil {
+		_u.SetToolCallCount(*v)
+	}
+	return _u
+}
+
+// AddToolCallCount adds value to the "tool_call_count" field.
+func (_u *AgentExecutionUpdateOne) AddToolCallCount(v int) *AgentExecutionUpdateOne {
+	_u.mutation.AddToolCallCount(v)
+	return _u
+}
+
+// SetLoopDetected sets the "loop_detected" field.
+func (_u *AgentExecutionUpdateOne) SetLoopDetected(v bool) *AgentExecutionUpdateOne {
+	_u.mutation.SetLoopDetected(v)
+	return _u
+}
+
+// SetNillableLoopDetected sets the "loop_detected" field if the given value is not nil.
+func (_u *AgentExecutionUpdateOne) SetNillableLoopDetected(v *bool) *AgentExecutionUpdateOne {
+	if v != nil {
+		_u.SetLoopDetected(*v)
+	}
+	return _u
+}
+
 // AddTimelineEventIDs adds the "timeline_events" edge to the TimelineEvent entity by IDs.
 func (_u *AgentExecutionUpdateOne) AddTimelineEventIDs(ids ...string) *AgentExecutionUpdateOne {
 	_u.mutation.AddTimelineEventIDs(ids...)
@@ -1630,6 +1709,15 @@ func (_u *AgentExecutionUpdateOne) sqlSave(ctx context.Context) (_node *AgentExe
 	if _u.mutation.TaskCleared() {
 		_spec.ClearField(agentexecution.FieldTask, field.TypeString)
 	}
+	if value, ok := _u.mutation.ToolCallCount(); ok {
+		_spec.SetField(agentexecution.FieldToolCallCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedToolCallCount(); ok {
+		_spec.AddField(agentexecution.FieldToolCallCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LoopDetected(); ok {
+		_spec.SetField(agentexecution.FieldLoopDetected, field.TypeBool, value)
+	}
 	if _u.mutation.TimelineEventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
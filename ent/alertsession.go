@@ -12,6 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
 )
 
 // AlertSession is the model entity for the AlertSession schema.
@@ -19,6 +22,10 @@ type AlertSession struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Soft delete for retention policy
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Original alert payload (full-text searchable)
 	AlertData string `json:"alert_data,omitempty"`
 	// Agent type (e.g., 'kubernetes')
@@ -41,16 +48,40 @@ type AlertSession struct {
 	ExecutiveSummary *string `json:"executive_summary,omitempty"`
 	// ExecutiveSummaryError holds the value of the "executive_summary_error" field.
 	ExecutiveSummaryError *string `json:"executive_summary_error,omitempty"`
+	// Version of the executive summary prompt that produced executive_summary
+	ExecutiveSummaryTemplate *string `json:"executive_summary_template,omitempty"`
+	// Detailed summary with next steps, for engineers (chains with technical_summary enabled)
+	TechnicalSummary *string `json:"technical_summary,omitempty"`
+	// TechnicalSummaryError holds the value of the "technical_summary_error" field.
+	TechnicalSummaryError *string `json:"technical_summary_error,omitempty"`
+	// Severity classified by the executive summary agent (drives notification routing)
+	Severity *alertsession.Severity `json:"severity,omitempty"`
+	// Outcome taxonomy label assigned after completion (system.outcome_classification)
+	Outcome *alertsession.Outcome `json:"outcome,omitempty"`
+	// One-line rationale for outcome
+	OutcomeReason *string `json:"outcome_reason,omitempty"`
+	// How outcome was decided: stated by the agent, classified by an LLM call, or taken from noise triage
+	OutcomeSource *alertsession.OutcomeSource `json:"outcome_source,omitempty"`
 	// SessionMetadata holds the value of the "session_metadata" field.
 	SessionMetadata map[string]interface{} `json:"session_metadata,omitempty"`
 	// From oauth2-proxy
 	Author *string `json:"author,omitempty"`
+	// OIDC subject of the submitter (user_profiles key)
+	AuthorSubject *string `json:"author_subject,omitempty"`
 	// RunbookURL holds the value of the "runbook_url" field.
 	RunbookURL *string `json:"runbook_url,omitempty"`
 	// MCP override config
 	McpSelection map[string]interface{} `json:"mcp_selection,omitempty"`
+	// Extra agent guidance submitted with the alert (global, per-stage, per-agent); masked before storage
+	AlertInstructions map[string]interface{} `json:"alert_instructions,omitempty"`
+	// Image attachments (dashboard screenshots) submitted with the alert; kept in the blob store
+	AlertImages []schema.ImageRef `json:"alert_images,omitempty"`
+	// Output language requested with the alert; overrides chain and defaults
+	OutputLanguage *string `json:"output_language,omitempty"`
 	// Chain identifier (live lookup, no snapshot)
 	ChainID string `json:"chain_id,omitempty"`
+	// Team charged for the session (chain owner at submission); null = unattributed
+	Owner *string `json:"owner,omitempty"`
 	// CurrentStageIndex holds the value of the "current_stage_index" field.
 	CurrentStageIndex *int `json:"current_stage_index,omitempty"`
 	// CurrentStageID holds the value of the "current_stage_id" field.
@@ -61,8 +92,56 @@ type AlertSession struct {
 	LastInteractionAt *time.Time `json:"last_interaction_at,omitempty"`
 	// For Slack threading
 	SlackMessageFingerprint *string `json:"slack_message_fingerprint,omitempty"`
-	// Soft delete for retention policy
-	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Identifier of the originating alert in the source system (e.g. Alertmanager fingerprint), matched by the resolution webhook
+	AlertKey *string `json:"alert_key,omitempty"`
+	// When the alert source reported the alert resolved while the session was queued or running
+	AlertResolvedAt *time.Time `json:"alert_resolved_at,omitempty"`
+	// Resolution reason reported by the alert source
+	AlertResolution *string `json:"alert_resolution,omitempty"`
+	// What cancelled the session: an operator via the API, the pending TTL policy, the alert resolution webhook, or the backlog preemption policy
+	CancelInitiator *alertsession.CancelInitiator `json:"cancel_initiator,omitempty"`
+	// Why the session was cancelled
+	CancelReason *string `json:"cancel_reason,omitempty"`
+	// User who requested the cancellation (X-Forwarded-User value); NULL for automatic cancellations
+	CancelledBy *string `json:"cancelled_by,omitempty"`
+	// X-Request-ID of the submitting API call (correlation across logs, events, interactions)
+	RequestID *string `json:"request_id,omitempty"`
+	// Set when the session ran the triage-only degradation profile because its preferred LLM provider was failing
+	DegradedReason *string `json:"degraded_reason,omitempty"`
+	// Provider tier chosen by the complexity router (system.model_routing)
+	ModelRouting *schema.ModelRoutingDecision `json:"model_routing,omitempty"`
+	// Verdict of the noise triage pre-chain (system.noise_triage)
+	NoiseTriage *schema.NoiseTriageDecision `json:"noise_triage,omitempty"`
+	// Submitted with force_full_investigation: bypasses the noise triage pre-chain
+	ForceFullInvestigation bool `json:"force_full_investigation,omitempty"`
+	// Delegating instance and stage when this session runs a stage for another TARSy instance (system.federation)
+	FederationOrigin *schema.FederationOrigin `json:"federation_origin,omitempty"`
+	// Cluster, region and namespace the alert targets (system.targets); rendered into MCP server transports
+	Target *schema.AlertTarget `json:"target,omitempty"`
+	// On-call engineer for the alert's service at session start (system.on_call)
+	OnCall *schema.OnCallContact `json:"on_call,omitempty"`
+	// Fan-out group of sibling sessions created for the same alert
+	GroupID *string `json:"group_id,omitempty"`
+	// Session this one was duplicated from for what-if comparison
+	DuplicatedFrom *string `json:"duplicated_from,omitempty"`
+	// Chain-level LLM provider override set when duplicating a session; skips model routing
+	LlmProvider *string `json:"llm_provider,omitempty"`
+	// Sandbox run against fixture MCP tools; never claimed by the worker pool
+	Sandbox bool `json:"sandbox,omitempty"`
+	// Alert type matched no chain; the session runs the fallback chain of its target namespace (defaults.fallback_chains)
+	FallbackChain bool `json:"fallback_chain,omitempty"`
+	// URL POSTed the final result when the session reaches a terminal state
+	CallbackURL *string `json:"callback_url,omitempty"`
+	// HMAC-SHA256 key signing callback deliveries
+	CallbackSecret *string `json:"-"`
+	// Completion callback delivery state — NULL when no callback is registered
+	CallbackStatus *alertsession.CallbackStatus `json:"callback_status,omitempty"`
+	// Completion callback delivery attempts made so far
+	CallbackAttempts int `json:"callback_attempts,omitempty"`
+	// Error of the latest failed callback delivery attempt
+	CallbackLastError *string `json:"callback_last_error,omitempty"`
+	// When the completion callback was acknowledged by the receiver
+	CallbackDeliveredAt *time.Time `json:"callback_delivered_at,omitempty"`
 	// Human review workflow state — NULL while investigation is active
 	ReviewStatus *alertsession.ReviewStatus `json:"review_status,omitempty"`
 	// User who claimed this session for review (X-Forwarded-User value)
@@ -77,6 +156,14 @@ type AlertSession struct {
 	ActionTaken *string `json:"action_taken,omitempty"`
 	// Why the investigation was good or bad
 	InvestigationFeedback *string `json:"investigation_feedback,omitempty"`
+	// Current handoff notes markdown — NULL when never written or cleared
+	HandoffNotes *string `json:"handoff_notes,omitempty"`
+	// Revision number of the current handoff notes; 0 = never edited
+	HandoffNotesRevision int `json:"handoff_notes_revision,omitempty"`
+	// Author of the current handoff notes revision
+	HandoffNotesUpdatedBy *string `json:"handoff_notes_updated_by,omitempty"`
+	// When the current handoff notes revision was saved
+	HandoffNotesUpdatedAt *time.Time `json:"handoff_notes_updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AlertSessionQuery when eager-loading is set.
 	Edges        AlertSessionEdges `json:"edges"`
@@ -105,13 +192,27 @@ type AlertSessionEdges struct {
 	SessionScores []*SessionScore `json:"session_scores,omitempty"`
 	// ReviewActivities holds the value of the review_activities edge.
 	ReviewActivities []*SessionReviewActivity `json:"review_activities,omitempty"`
+	// Claims holds the value of the claims edge.
+	Claims []*SessionClaim `json:"claims,omitempty"`
 	// Memories holds the value of the memories edge.
 	Memories []*InvestigationMemory `json:"memories,omitempty"`
 	// InjectedMemories holds the value of the injected_memories edge.
 	InjectedMemories []*InvestigationMemory `json:"injected_memories,omitempty"`
+	// RedactionReviews holds the value of the redaction_reviews edge.
+	RedactionReviews []*RedactionReview `json:"redaction_reviews,omitempty"`
+	// HandoffNoteRevisions holds the value of the handoff_note_revisions edge.
+	HandoffNoteRevisions []*HandoffNoteRevision `json:"handoff_note_revisions,omitempty"`
+	// Comparison holds the value of the comparison edge.
+	Comparison *SessionComparison `json:"comparison,omitempty"`
+	// NextComparisons holds the value of the next_comparisons edge.
+	NextComparisons []*SessionComparison `json:"next_comparisons,omitempty"`
+	// ActionItems holds the value of the action_items edge.
+	ActionItems []*ActionItem `json:"action_items,omitempty"`
+	// Group holds the value of the group edge.
+	Group *SessionGroup `json:"group,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [12]bool
+	loadedTypes [19]bool
 }
 
 // StagesOrErr returns the Stages value or an error if the edge
@@ -206,10 +307,19 @@ func (e AlertSessionEdges) ReviewActivitiesOrErr() ([]*SessionReviewActivity, er
 	return nil, &NotLoadedError{edge: "review_activities"}
 }
 
+// ClaimsOrErr returns the Claims value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlertSessionEdges) ClaimsOrErr() ([]*SessionClaim, error) {
+	if e.loadedTypes[10] {
+		return e.Claims, nil
+	}
+	return nil, &NotLoadedError{edge: "claims"}
+}
+
 // MemoriesOrErr returns the Memories value or an error if the edge
 // was not loaded in eager-loading.
 func (e AlertSessionEdges) MemoriesOrErr() ([]*InvestigationMemory, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[11] {
 		return e.Memories, nil
 	}
 	return nil, &NotLoadedError{edge: "memories"}
@@ -218,24 +328,84 @@ func (e AlertSessionEdges) MemoriesOrErr() ([]*InvestigationMemory, error) {
 // InjectedMemoriesOrErr returns the InjectedMemories value or an error if the edge
 // was not loaded in eager-loading.
 func (e AlertSessionEdges) InjectedMemoriesOrErr() ([]*InvestigationMemory, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[12] {
 		return e.InjectedMemories, nil
 	}
 	return nil, &NotLoadedError{edge: "injected_memories"}
 }
 
+// RedactionReviewsOrErr returns the RedactionReviews value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlertSessionEdges) RedactionReviewsOrErr() ([]*RedactionReview, error) {
+	if e.loadedTypes[13] {
+		return e.RedactionReviews, nil
+	}
+	return nil, &NotLoadedError{edge: "redaction_reviews"}
+}
+
+// HandoffNoteRevisionsOrErr returns the HandoffNoteRevisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlertSessionEdges) HandoffNoteRevisionsOrErr() ([]*HandoffNoteRevision, error) {
+	if e.loadedTypes[14] {
+		return e.HandoffNoteRevisions, nil
+	}
+	return nil, &NotLoadedError{edge: "handoff_note_revisions"}
+}
+
+// ComparisonOrErr returns the Comparison value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AlertSessionEdges) ComparisonOrErr() (*SessionComparison, error) {
+	if e.Comparison != nil {
+		return e.Comparison, nil
+	} else if e.loadedTypes[15] {
+		return nil, &NotFoundError{label: sessioncomparison.Label}
+	}
+	return nil, &NotLoadedError{edge: "comparison"}
+}
+
+// NextComparisonsOrErr returns the NextComparisons value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlertSessionEdges) NextComparisonsOrErr() ([]*SessionComparison, error) {
+	if e.loadedTypes[16] {
+		return e.NextComparisons, nil
+	}
+	return nil, &NotLoadedError{edge: "next_comparisons"}
+}
+
+// ActionItemsOrErr returns the ActionItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e AlertSessionEdges) ActionItemsOrErr() ([]*ActionItem, error) {
+	if e.loadedTypes[17] {
+		return e.ActionItems, nil
+	}
+	return nil, &NotLoadedError{edge: "action_items"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AlertSessionEdges) GroupOrErr() (*SessionGroup, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[18] {
+		return nil, &NotFoundError{label: sessiongroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*AlertSession) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case alertsession.FieldSessionMetadata, alertsession.FieldMcpSelection:
+		case alertsession.FieldSessionMetadata, alertsession.FieldMcpSelection, alertsession.FieldAlertInstructions, alertsession.FieldAlertImages, alertsession.FieldModelRouting, alertsession.FieldNoiseTriage, alertsession.FieldFederationOrigin, alertsession.FieldTarget, alertsession.FieldOnCall:
 			values[i] = new([]byte)
-		case alertsession.FieldCurrentStageIndex:
+		case alertsession.FieldForceFullInvestigation, alertsession.FieldSandbox, alertsession.FieldFallbackChain:
+			values[i] = new(sql.NullBool)
+		case alertsession.FieldCurrentStageIndex, alertsession.FieldCallbackAttempts, alertsession.FieldHandoffNotesRevision:
 			values[i] = new(sql.NullInt64)
-		case alertsession.FieldID, alertsession.FieldAlertData, alertsession.FieldAgentType, alertsession.FieldAlertType, alertsession.FieldStatus, alertsession.FieldErrorMessage, alertsession.FieldFinalAnalysis, alertsession.FieldExecutiveSummary, alertsession.FieldExecutiveSummaryError, alertsession.FieldAuthor, alertsession.FieldRunbookURL, alertsession.FieldChainID, alertsession.FieldCurrentStageID, alertsession.FieldPodID, alertsession.FieldSlackMessageFingerprint, alertsession.FieldReviewStatus, alertsession.FieldAssignee, alertsession.FieldQualityRating, alertsession.FieldActionTaken, alertsession.FieldInvestigationFeedback:
+		case alertsession.FieldID, alertsession.FieldAlertData, alertsession.FieldAgentType, alertsession.FieldAlertType, alertsession.FieldStatus, alertsession.FieldErrorMessage, alertsession.FieldFinalAnalysis, alertsession.FieldExecutiveSummary, alertsession.FieldExecutiveSummaryError, alertsession.FieldExecutiveSummaryTemplate, alertsession.FieldTechnicalSummary, alertsession.FieldTechnicalSummaryError, alertsession.FieldSeverity, alertsession.FieldOutcome, alertsession.FieldOutcomeReason, alertsession.FieldOutcomeSource, alertsession.FieldAuthor, alertsession.FieldAuthorSubject, alertsession.FieldRunbookURL, alertsession.FieldOutputLanguage, alertsession.FieldChainID, alertsession.FieldOwner, alertsession.FieldCurrentStageID, alertsession.FieldPodID, alertsession.FieldSlackMessageFingerprint, alertsession.FieldAlertKey, alertsession.FieldAlertResolution, alertsession.FieldCancelInitiator, alertsession.FieldCancelReason, alertsession.FieldCancelledBy, alertsession.FieldRequestID, alertsession.FieldDegradedReason, alertsession.FieldGroupID, alertsession.FieldDuplicatedFrom, alertsession.FieldLlmProvider, alertsession.FieldCallbackURL, alertsession.FieldCallbackSecret, alertsession.FieldCallbackStatus, alertsession.FieldCallbackLastError, alertsession.FieldReviewStatus, alertsession.FieldAssignee, alertsession.FieldQualityRating, alertsession.FieldActionTaken, alertsession.FieldInvestigationFeedback, alertsession.FieldHandoffNotes, alertsession.FieldHandoffNotesUpdatedBy:
 			values[i] = new(sql.NullString)
-		case alertsession.FieldCreatedAt, alertsession.FieldStartedAt, alertsession.FieldCompletedAt, alertsession.FieldLastInteractionAt, alertsession.FieldDeletedAt, alertsession.FieldAssignedAt, alertsession.FieldReviewedAt:
+		case alertsession.FieldUpdatedAt, alertsession.FieldDeletedAt, alertsession.FieldCreatedAt, alertsession.FieldStartedAt, alertsession.FieldCompletedAt, alertsession.FieldLastInteractionAt, alertsession.FieldAlertResolvedAt, alertsession.FieldCallbackDeliveredAt, alertsession.FieldAssignedAt, alertsession.FieldReviewedAt, alertsession.FieldHandoffNotesUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -258,6 +428,19 @@ func (_m *AlertSession) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case alertsession.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case alertsession.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case alertsession.FieldAlertData:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field alert_data", values[i])
@@ -330,6 +513,55 @@ func (_m *AlertSession) assignValues(columns []string, values []any) error {
 				_m.ExecutiveSummaryError = new(string)
 				*_m.ExecutiveSummaryError = value.String
 			}
+		case alertsession.FieldExecutiveSummaryTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field executive_summary_template", values[i])
+			} else if value.Valid {
+				_m.ExecutiveSummaryTemplate = new(string)
+				*_m.ExecutiveSummaryTemplate = value.String
+			}
+		case alertsession.FieldTechnicalSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field technical_summary", values[i])
+			} else if value.Valid {
+				_m.TechnicalSummary = new(string)
+				*_m.TechnicalSummary = value.String
+			}
+		case alertsession.FieldTechnicalSummaryError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field technical_summary_error", values[i])
+			} else if value.Valid {
+				_m.TechnicalSummaryError = new(string)
+				*_m.TechnicalSummaryError = value.String
+			}
+		case alertsession.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = new(alertsession.Severity)
+				*_m.Severity = alertsession.Severity(value.String)
+			}
+		case alertsession.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				_m.Outcome = new(alertsession.Outcome)
+				*_m.Outcome = alertsession.Outcome(value.String)
+			}
+		case alertsession.FieldOutcomeReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome_reason", values[i])
+			} else if value.Valid {
+				_m.OutcomeReason = new(string)
+				*_m.OutcomeReason = value.String
+			}
+		case alertsession.FieldOutcomeSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome_source", values[i])
+			} else if value.Valid {
+				_m.OutcomeSource = new(alertsession.OutcomeSource)
+				*_m.OutcomeSource = alertsession.OutcomeSource(value.String)
+			}
 		case alertsession.FieldSessionMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field session_metadata", values[i])
@@ -345,6 +577,13 @@ func (_m *AlertSession) assignValues(columns []string, values []any) error {
 				_m.Author = new(string)
 				*_m.Author = value.String
 			}
+		case alertsession.FieldAuthorSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_subject", values[i])
+			} else if value.Valid {
+				_m.AuthorSubject = new(string)
+				*_m.AuthorSubject = value.String
+			}
 		case alertsession.FieldRunbookURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field runbook_url", values[i])
@@ -360,12 +599,42 @@ func (_m *AlertSession) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field mcp_selection: %w", err)
 				}
 			}
+		case alertsession.FieldAlertInstructions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_instructions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AlertInstructions); err != nil {
+					return fmt.Errorf("unmarshal field alert_instructions: %w", err)
+				}
+			}
+		case alertsession.FieldAlertImages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_images", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AlertImages); err != nil {
+					return fmt.Errorf("unmarshal field alert_images: %w", err)
+				}
+			}
+		case alertsession.FieldOutputLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field output_language", values[i])
+			} else if value.Valid {
+				_m.OutputLanguage = new(string)
+				*_m.OutputLanguage = value.String
+			}
 		case alertsession.FieldChainID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field chain_id", values[i])
 			} else if value.Valid {
 				_m.ChainID = value.String
 			}
+		case alertsession.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = new(string)
+				*_m.Owner = value.String
+			}
 		case alertsession.FieldCurrentStageIndex:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field current_stage_index", values[i])
@@ -401,12 +670,181 @@ func (_m *AlertSession) assignValues(columns []string, values []any) error {
 				_m.SlackMessageFingerprint = new(string)
 				*_m.SlackMessageFingerprint = value.String
 			}
-		case alertsession.FieldDeletedAt:
+		case alertsession.FieldAlertKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_key", values[i])
+			} else if value.Valid {
+				_m.AlertKey = new(string)
+				*_m.AlertKey = value.String
+			}
+		case alertsession.FieldAlertResolvedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+				return fmt.Errorf("unexpected type %T for field alert_resolved_at", values[i])
 			} else if value.Valid {
-				_m.DeletedAt = new(time.Time)
-				*_m.DeletedAt = value.Time
+				_m.AlertResolvedAt = new(time.Time)
+				*_m.AlertResolvedAt = value.Time
+			}
+		case alertsession.FieldAlertResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_resolution", values[i])
+			} else if value.Valid {
+				_m.AlertResolution = new(string)
+				*_m.AlertResolution = value.String
+			}
+		case alertsession.FieldCancelInitiator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_initiator", values[i])
+			} else if value.Valid {
+				_m.CancelInitiator = new(alertsession.CancelInitiator)
+				*_m.CancelInitiator = alertsession.CancelInitiator(value.String)
+			}
+		case alertsession.FieldCancelReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_reason", values[i])
+			} else if value.Valid {
+				_m.CancelReason = new(string)
+				*_m.CancelReason = value.String
+			}
+		case alertsession.FieldCancelledBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_by", values[i])
+			} else if value.Valid {
+				_m.CancelledBy = new(string)
+				*_m.CancelledBy = value.String
+			}
+		case alertsession.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = new(string)
+				*_m.RequestID = value.String
+			}
+		case alertsession.FieldDegradedReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field degraded_reason", values[i])
+			} else if value.Valid {
+				_m.DegradedReason = new(string)
+				*_m.DegradedReason = value.String
+			}
+		case alertsession.FieldModelRouting:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field model_routing", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ModelRouting); err != nil {
+					return fmt.Errorf("unmarshal field model_routing: %w", err)
+				}
+			}
+		case alertsession.FieldNoiseTriage:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field noise_triage", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NoiseTriage); err != nil {
+					return fmt.Errorf("unmarshal field noise_triage: %w", err)
+				}
+			}
+		case alertsession.FieldForceFullInvestigation:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field force_full_investigation", values[i])
+			} else if value.Valid {
+				_m.ForceFullInvestigation = value.Bool
+			}
+		case alertsession.FieldFederationOrigin:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field federation_origin", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FederationOrigin); err != nil {
+					return fmt.Errorf("unmarshal field federation_origin: %w", err)
+				}
+			}
+		case alertsession.FieldTarget:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Target); err != nil {
+					return fmt.Errorf("unmarshal field target: %w", err)
+				}
+			}
+		case alertsession.FieldOnCall:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field on_call", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OnCall); err != nil {
+					return fmt.Errorf("unmarshal field on_call: %w", err)
+				}
+			}
+		case alertsession.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = new(string)
+				*_m.GroupID = value.String
+			}
+		case alertsession.FieldDuplicatedFrom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field duplicated_from", values[i])
+			} else if value.Valid {
+				_m.DuplicatedFrom = new(string)
+				*_m.DuplicatedFrom = value.String
+			}
+		case alertsession.FieldLlmProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field llm_provider", values[i])
+			} else if value.Valid {
+				_m.LlmProvider = new(string)
+				*_m.LlmProvider = value.String
+			}
+		case alertsession.FieldSandbox:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sandbox", values[i])
+			} else if value.Valid {
+				_m.Sandbox = value.Bool
+			}
+		case alertsession.FieldFallbackChain:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_chain", values[i])
+			} else if value.Valid {
+				_m.FallbackChain = value.Bool
+			}
+		case alertsession.FieldCallbackURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_url", values[i])
+			} else if value.Valid {
+				_m.CallbackURL = new(string)
+				*_m.CallbackURL = value.String
+			}
+		case alertsession.FieldCallbackSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_secret", values[i])
+			} else if value.Valid {
+				_m.CallbackSecret = new(string)
+				*_m.CallbackSecret = value.String
+			}
+		case alertsession.FieldCallbackStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_status", values[i])
+			} else if value.Valid {
+				_m.CallbackStatus = new(alertsession.CallbackStatus)
+				*_m.CallbackStatus = alertsession.CallbackStatus(value.String)
+			}
+		case alertsession.FieldCallbackAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_attempts", values[i])
+			} else if value.Valid {
+				_m.CallbackAttempts = int(value.Int64)
+			}
+		case alertsession.FieldCallbackLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_last_error", values[i])
+			} else if value.Valid {
+				_m.CallbackLastError = new(string)
+				*_m.CallbackLastError = value.String
+			}
+		case alertsession.FieldCallbackDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_delivered_at", values[i])
+			} else if value.Valid {
+				_m.CallbackDeliveredAt = new(time.Time)
+				*_m.CallbackDeliveredAt = value.Time
 			}
 		case alertsession.FieldReviewStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -457,6 +895,33 @@ func (_m *AlertSession) assignValues(columns []string, values []any) error {
 				_m.InvestigationFeedback = new(string)
 				*_m.InvestigationFeedback = value.String
 			}
+		case alertsession.FieldHandoffNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handoff_notes", values[i])
+			} else if value.Valid {
+				_m.HandoffNotes = new(string)
+				*_m.HandoffNotes = value.String
+			}
+		case alertsession.FieldHandoffNotesRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field handoff_notes_revision", values[i])
+			} else if value.Valid {
+				_m.HandoffNotesRevision = int(value.Int64)
+			}
+		case alertsession.FieldHandoffNotesUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field handoff_notes_updated_by", values[i])
+			} else if value.Valid {
+				_m.HandoffNotesUpdatedBy = new(string)
+				*_m.HandoffNotesUpdatedBy = value.String
+			}
+		case alertsession.FieldHandoffNotesUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field handoff_notes_updated_at", values[i])
+			} else if value.Valid {
+				_m.HandoffNotesUpdatedAt = new(time.Time)
+				*_m.HandoffNotesUpdatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -520,6 +985,11 @@ func (_m *AlertSession) QueryReviewActivities() *SessionReviewActivityQuery {
 	return NewAlertSessionClient(_m.config).QueryReviewActivities(_m)
 }
 
+// QueryClaims queries the "claims" edge of the AlertSession entity.
+func (_m *AlertSession) QueryClaims() *SessionClaimQuery {
+	return NewAlertSessionClient(_m.config).QueryClaims(_m)
+}
+
 // QueryMemories queries the "memories" edge of the AlertSession entity.
 func (_m *AlertSession) QueryMemories() *InvestigationMemoryQuery {
 	return NewAlertSessionClient(_m.config).QueryMemories(_m)
@@ -530,6 +1000,36 @@ func (_m *AlertSession) QueryInjectedMemories() *InvestigationMemoryQuery {
 	return NewAlertSessionClient(_m.config).QueryInjectedMemories(_m)
 }
 
+// QueryRedactionReviews queries the "redaction_reviews" edge of the AlertSession entity.
+func (_m *AlertSession) QueryRedactionReviews() *RedactionReviewQuery {
+	return NewAlertSessionClient(_m.config).QueryRedactionReviews(_m)
+}
+
+// QueryHandoffNoteRevisions queries the "handoff_note_revisions" edge of the AlertSession entity.
+func (_m *AlertSession) QueryHandoffNoteRevisions() *HandoffNoteRevisionQuery {
+	return NewAlertSessionClient(_m.config).QueryHandoffNoteRevisions(_m)
+}
+
+// QueryComparison queries the "comparison" edge of the AlertSession entity.
+func (_m *AlertSession) QueryComparison() *SessionComparisonQuery {
+	return NewAlertSessionClient(_m.config).QueryComparison(_m)
+}
+
+// QueryNextComparisons queries the "next_comparisons" edge of the AlertSession entity.
+func (_m *AlertSession) QueryNextComparisons() *SessionComparisonQuery {
+	return NewAlertSessionClient(_m.config).QueryNextComparisons(_m)
+}
+
+// QueryActionItems queries the "action_items" edge of the AlertSession entity.
+func (_m *AlertSession) QueryActionItems() *ActionItemQuery {
+	return NewAlertSessionClient(_m.config).QueryActionItems(_m)
+}
+
+// QueryGroup queries the "group" edge of the AlertSession entity.
+func (_m *AlertSession) QueryGroup() *SessionGroupQuery {
+	return NewAlertSessionClient(_m.config).QueryGroup(_m)
+}
+
 // Update returns a builder for updating this AlertSession.
 // Note that you need to call AlertSession.Unwrap() before calling this method if this AlertSession
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -553,6 +1053,14 @@ func (_m *AlertSession) String() string {
 	var builder strings.Builder
 	builder.WriteString("AlertSession(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("alert_data=")
 	builder.WriteString(_m.AlertData)
 	builder.WriteString(", ")
@@ -598,6 +1106,41 @@ func (_m *AlertSession) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.ExecutiveSummaryTemplate; v != nil {
+		builder.WriteString("executive_summary_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TechnicalSummary; v != nil {
+		builder.WriteString("technical_summary=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.TechnicalSummaryError; v != nil {
+		builder.WriteString("technical_summary_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Severity; v != nil {
+		builder.WriteString("severity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Outcome; v != nil {
+		builder.WriteString("outcome=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OutcomeReason; v != nil {
+		builder.WriteString("outcome_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.OutcomeSource; v != nil {
+		builder.WriteString("outcome_source=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("session_metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SessionMetadata))
 	builder.WriteString(", ")
@@ -606,6 +1149,11 @@ func (_m *AlertSession) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.AuthorSubject; v != nil {
+		builder.WriteString("author_subject=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.RunbookURL; v != nil {
 		builder.WriteString("runbook_url=")
 		builder.WriteString(*v)
@@ -614,9 +1162,25 @@ func (_m *AlertSession) String() string {
 	builder.WriteString("mcp_selection=")
 	builder.WriteString(fmt.Sprintf("%v", _m.McpSelection))
 	builder.WriteString(", ")
+	builder.WriteString("alert_instructions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlertInstructions))
+	builder.WriteString(", ")
+	builder.WriteString("alert_images=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlertImages))
+	builder.WriteString(", ")
+	if v := _m.OutputLanguage; v != nil {
+		builder.WriteString("output_language=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("chain_id=")
 	builder.WriteString(_m.ChainID)
 	builder.WriteString(", ")
+	if v := _m.Owner; v != nil {
+		builder.WriteString("owner=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := _m.CurrentStageIndex; v != nil {
 		builder.WriteString("current_stage_index=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -642,8 +1206,107 @@ func (_m *AlertSession) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	if v := _m.DeletedAt; v != nil {
-		builder.WriteString("deleted_at=")
+	if v := _m.AlertKey; v != nil {
+		builder.WriteString("alert_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AlertResolvedAt; v != nil {
+		builder.WriteString("alert_resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AlertResolution; v != nil {
+		builder.WriteString("alert_resolution=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelInitiator; v != nil {
+		builder.WriteString("cancel_initiator=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelReason; v != nil {
+		builder.WriteString("cancel_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CancelledBy; v != nil {
+		builder.WriteString("cancelled_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RequestID; v != nil {
+		builder.WriteString("request_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DegradedReason; v != nil {
+		builder.WriteString("degraded_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("model_routing=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModelRouting))
+	builder.WriteString(", ")
+	builder.WriteString("noise_triage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NoiseTriage))
+	builder.WriteString(", ")
+	builder.WriteString("force_full_investigation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ForceFullInvestigation))
+	builder.WriteString(", ")
+	builder.WriteString("federation_origin=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FederationOrigin))
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Target))
+	builder.WriteString(", ")
+	builder.WriteString("on_call=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OnCall))
+	builder.WriteString(", ")
+	if v := _m.GroupID; v != nil {
+		builder.WriteString("group_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DuplicatedFrom; v != nil {
+		builder.WriteString("duplicated_from=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LlmProvider; v != nil {
+		builder.WriteString("llm_provider=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("sandbox=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sandbox))
+	builder.WriteString(", ")
+	builder.WriteString("fallback_chain=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FallbackChain))
+	builder.WriteString(", ")
+	if v := _m.CallbackURL; v != nil {
+		builder.WriteString("callback_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("callback_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.CallbackStatus; v != nil {
+		builder.WriteString("callback_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("callback_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CallbackAttempts))
+	builder.WriteString(", ")
+	if v := _m.CallbackLastError; v != nil {
+		builder.WriteString("callback_last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CallbackDeliveredAt; v != nil {
+		builder.WriteString("callback_delivered_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
@@ -681,6 +1344,24 @@ func (_m *AlertSession) String() string {
 		builder.WriteString("investigation_feedback=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.HandoffNotes; v != nil {
+		builder.WriteString("handoff_notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("handoff_notes_revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HandoffNotesRevision))
+	builder.WriteString(", ")
+	if v := _m.HandoffNotesUpdatedBy; v != nil {
+		builder.WriteString("handoff_notes_updated_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.HandoffNotesUpdatedAt; v != nil {
+		builder.WriteString("handoff_notes_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
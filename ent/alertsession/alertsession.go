@@ -15,6 +15,10 @@ const (
 	Label = "alert_session"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "session_id"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldAlertData holds the string denoting the alert_data field in the database.
 	FieldAlertData = "alert_data"
 	// FieldAgentType holds the string denoting the agent_type field in the database.
@@ -37,16 +41,40 @@ const (
 	FieldExecutiveSummary = "executive_summary"
 	// FieldExecutiveSummaryError holds the string denoting the executive_summary_error field in the database.
 	FieldExecutiveSummaryError = "executive_summary_error"
+	// FieldExecutiveSummaryTemplate holds the string denoting the executive_summary_template field in the database.
+	FieldExecutiveSummaryTemplate = "executive_summary_template"
+	// FieldTechnicalSummary holds the string denoting the technical_summary field in the database.
+	FieldTechnicalSummary = "technical_summary"
+	// FieldTechnicalSummaryError holds the string denoting the technical_summary_error field in the database.
+	FieldTechnicalSummaryError = "technical_summary_error"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldOutcome holds the string denoting the outcome field in the database.
+	FieldOutcome = "outcome"
+	// FieldOutcomeReason holds the string denoting the outcome_reason field in the database.
+	FieldOutcomeReason = "outcome_reason"
+	// FieldOutcomeSource holds the string denoting the outcome_source field in the database.
+	FieldOutcomeSource = "outcome_source"
 	// FieldSessionMetadata holds the string denoting the session_metadata field in the database.
 	FieldSessionMetadata = "session_metadata"
 	// FieldAuthor holds the string denoting the author field in the database.
 	FieldAuthor = "author"
+	// FieldAuthorSubject holds the string denoting the author_subject field in the database.
+	FieldAuthorSubject = "author_subject"
 	// FieldRunbookURL holds the string denoting the runbook_url field in the database.
 	FieldRunbookURL = "runbook_url"
 	// FieldMcpSelection holds the string denoting the mcp_selection field in the database.
 	FieldMcpSelection = "mcp_selection"
+	// FieldAlertInstructions holds the string denoting the alert_instructions field in the database.
+	FieldAlertInstructions = "alert_instructions"
+	// FieldAlertImages holds the string denoting the alert_images field in the database.
+	FieldAlertImages = "alert_images"
+	// FieldOutputLanguage holds the string denoting the output_language field in the database.
+	FieldOutputLanguage = "output_language"
 	// FieldChainID holds the string denoting the chain_id field in the database.
 	FieldChainID = "chain_id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
 	// FieldCurrentStageIndex holds the string denoting the current_stage_index field in the database.
 	FieldCurrentStageIndex = "current_stage_index"
 	// FieldCurrentStageID holds the string denoting the current_stage_id field in the database.
@@ -57,8 +85,56 @@ const (
 	FieldLastInteractionAt = "last_interaction_at"
 	// FieldSlackMessageFingerprint holds the string denoting the slack_message_fingerprint field in the database.
 	FieldSlackMessageFingerprint = "slack_message_fingerprint"
-	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
-	FieldDeletedAt = "deleted_at"
+	// FieldAlertKey holds the string denoting the alert_key field in the database.
+	FieldAlertKey = "alert_key"
+	// FieldAlertResolvedAt holds the string denoting the alert_resolved_at field in the database.
+	FieldAlertResolvedAt = "alert_resolved_at"
+	// FieldAlertResolution holds the string denoting the alert_resolution field in the database.
+	FieldAlertResolution = "alert_resolution"
+	// FieldCancelInitiator holds the string denoting the cancel_initiator field in the database.
+	FieldCancelInitiator = "cancel_initiator"
+	// FieldCancelReason holds the string denoting the cancel_reason field in the database.
+	FieldCancelReason = "cancel_reason"
+	// FieldCancelledBy holds the string denoting the cancelled_by field in the database.
+	FieldCancelledBy = "cancelled_by"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
+	// FieldDegradedReason holds the string denoting the degraded_reason field in the database.
+	FieldDegradedReason = "degraded_reason"
+	// FieldModelRouting holds the string denoting the model_routing field in the database.
+	FieldModelRouting = "model_routing"
+	// FieldNoiseTriage holds the string denoting the noise_triage field in the database.
+	FieldNoiseTriage = "noise_triage"
+	// FieldForceFullInvestigation holds the string denoting the force_full_investigation field in the database.
+	FieldForceFullInvestigation = "force_full_investigation"
+	// FieldFederationOrigin holds the string denoting the federation_origin field in the database.
+	FieldFederationOrigin = "federation_origin"
+	// FieldTarget holds the string denoting the target field in the database.
+	FieldTarget = "target"
+	// FieldOnCall holds the string denoting the on_call field in the database.
+	FieldOnCall = "on_call"
+	// FieldGroupID holds the string denoting the group_id field in the database.
+	FieldGroupID = "group_id"
+	// FieldDuplicatedFrom holds the string denoting the duplicated_from field in the database.
+	FieldDuplicatedFrom = "duplicated_from"
+	// FieldLlmProvider holds the string denoting the llm_provider field in the database.
+	FieldLlmProvider = "llm_provider"
+	// FieldSandbox holds the string denoting the sandbox field in the database.
+	FieldSandbox = "sandbox"
+	// FieldFallbackChain holds the string denoting the fallback_chain field in the database.
+	FieldFallbackChain = "fallback_chain"
+	// FieldCallbackURL holds the string denoting the callback_url field in the database.
+	FieldCallbackURL = "callback_url"
+	// FieldCallbackSecret holds the string denoting the callback_secret field in the database.
+	FieldCallbackSecret = "callback_secret"
+	// FieldCallbackStatus holds the string denoting the callback_status field in the database.
+	FieldCallbackStatus = "callback_status"
+	// FieldCallbackAttempts holds the string denoting the callback_attempts field in the database.
+	FieldCallbackAttempts = "callback_attempts"
+	// FieldCallbackLastError holds the string denoting the callback_last_error field in the database.
+	FieldCallbackLastError = "callback_last_error"
+	// FieldCallbackDeliveredAt holds the string denoting the callback_delivered_at field in the database.
+	FieldCallbackDeliveredAt = "callback_delivered_at"
 	// FieldReviewStatus holds the string denoting the review_status field in the database.
 	FieldReviewStatus = "review_status"
 	// FieldAssignee holds the string denoting the assignee field in the database.
@@ -73,6 +149,14 @@ const (
 	FieldActionTaken = "action_taken"
 	// FieldInvestigationFeedback holds the string denoting the investigation_feedback field in the database.
 	FieldInvestigationFeedback = "investigation_feedback"
+	// FieldHandoffNotes holds the string denoting the handoff_notes field in the database.
+	FieldHandoffNotes = "handoff_notes"
+	// FieldHandoffNotesRevision holds the string denoting the handoff_notes_revision field in the database.
+	FieldHandoffNotesRevision = "handoff_notes_revision"
+	// FieldHandoffNotesUpdatedBy holds the string denoting the handoff_notes_updated_by field in the database.
+	FieldHandoffNotesUpdatedBy = "handoff_notes_updated_by"
+	// FieldHandoffNotesUpdatedAt holds the string denoting the handoff_notes_updated_at field in the database.
+	FieldHandoffNotesUpdatedAt = "handoff_notes_updated_at"
 	// EdgeStages holds the string denoting the stages edge name in mutations.
 	EdgeStages = "stages"
 	// EdgeAgentExecutions holds the string denoting the agent_executions edge name in mutations.
@@ -93,10 +177,24 @@ const (
 	EdgeSessionScores = "session_scores"
 	// EdgeReviewActivities holds the string denoting the review_activities edge name in mutations.
 	EdgeReviewActivities = "review_activities"
+	// EdgeClaims holds the string denoting the claims edge name in mutations.
+	EdgeClaims = "claims"
 	// EdgeMemories holds the string denoting the memories edge name in mutations.
 	EdgeMemories = "memories"
 	// EdgeInjectedMemories holds the string denoting the injected_memories edge name in mutations.
 	EdgeInjectedMemories = "injected_memories"
+	// EdgeRedactionReviews holds the string denoting the redaction_reviews edge name in mutations.
+	EdgeRedactionReviews = "redaction_reviews"
+	// EdgeHandoffNoteRevisions holds the string denoting the handoff_note_revisions edge name in mutations.
+	EdgeHandoffNoteRevisions = "handoff_note_revisions"
+	// EdgeComparison holds the string denoting the comparison edge name in mutations.
+	EdgeComparison = "comparison"
+	// EdgeNextComparisons holds the string denoting the next_comparisons edge name in mutations.
+	EdgeNextComparisons = "next_comparisons"
+	// EdgeActionItems holds the string denoting the action_items edge name in mutations.
+	EdgeActionItems = "action_items"
+	// EdgeGroup holds the string denoting the group edge name in mutations.
+	EdgeGroup = "group"
 	// StageFieldID holds the string denoting the ID field of the Stage.
 	StageFieldID = "stage_id"
 	// AgentExecutionFieldID holds the string denoting the ID field of the AgentExecution.
@@ -117,8 +215,20 @@ const (
 	SessionScoreFieldID = "score_id"
 	// SessionReviewActivityFieldID holds the string denoting the ID field of the SessionReviewActivity.
 	SessionReviewActivityFieldID = "activity_id"
+	// SessionClaimFieldID holds the string denoting the ID field of the SessionClaim.
+	SessionClaimFieldID = "claim_id"
 	// InvestigationMemoryFieldID holds the string denoting the ID field of the InvestigationMemory.
 	InvestigationMemoryFieldID = "memory_id"
+	// RedactionReviewFieldID holds the string denoting the ID field of the RedactionReview.
+	RedactionReviewFieldID = "review_id"
+	// HandoffNoteRevisionFieldID holds the string denoting the ID field of the HandoffNoteRevision.
+	HandoffNoteRevisionFieldID = "revision_id"
+	// SessionComparisonFieldID holds the string denoting the ID field of the SessionComparison.
+	SessionComparisonFieldID = "comparison_id"
+	// ActionItemFieldID holds the string denoting the ID field of the ActionItem.
+	ActionItemFieldID = "action_item_id"
+	// SessionGroupFieldID holds the string denoting the ID field of the SessionGroup.
+	SessionGroupFieldID = "group_id"
 	// Table holds the table name of the alertsession in the database.
 	Table = "alert_sessions"
 	// StagesTable is the table that holds the stages relation/edge.
@@ -191,6 +301,13 @@ const (
 	ReviewActivitiesInverseTable = "session_review_activities"
 	// ReviewActivitiesColumn is the table column denoting the review_activities relation/edge.
 	ReviewActivitiesColumn = "session_id"
+	// ClaimsTable is the table that holds the claims relation/edge.
+	ClaimsTable = "session_claims"
+	// ClaimsInverseTable is the table name for the SessionClaim entity.
+	// It exists in this package in order to avoid circular dependency with the "sessionclaim" package.
+	ClaimsInverseTable = "session_claims"
+	// ClaimsColumn is the table column denoting the claims relation/edge.
+	ClaimsColumn = "session_id"
 	// MemoriesTable is the table that holds the memories relation/edge.
 	MemoriesTable = "investigation_memories"
 	// MemoriesInverseTable is the table name for the InvestigationMemory entity.
@@ -203,11 +320,55 @@ const (
 	// InjectedMemoriesInverseTable is the table name for the InvestigationMemory entity.
 	// It exists in this package in order to avoid circular dependency with the "investigationmemory" package.
 	InjectedMemoriesInverseTable = "investigation_memories"
+	// RedactionReviewsTable is the table that holds the redaction_reviews relation/edge.
+	RedactionReviewsTable = "redaction_reviews"
+	// RedactionReviewsInverseTable is the table name for the RedactionReview entity.
+	// It exists in this package in order to avoid circular dependency with the "redactionreview" package.
+	RedactionReviewsInverseTable = "redaction_reviews"
+	// RedactionReviewsColumn is the table column denoting the redaction_reviews relation/edge.
+	RedactionReviewsColumn = "session_id"
+	// HandoffNoteRevisionsTable is the table that holds the handoff_note_revisions relation/edge.
+	HandoffNoteRevisionsTable = "handoff_note_revisions"
+	// HandoffNoteRevisionsInverseTable is the table name for the HandoffNoteRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "handoffnoterevision" package.
+	HandoffNoteRevisionsInverseTable = "handoff_note_revisions"
+	// HandoffNoteRevisionsColumn is the table column denoting the handoff_note_revisions relation/edge.
+	HandoffNoteRevisionsColumn = "session_id"
+	// ComparisonTable is the table that holds the comparison relation/edge.
+	ComparisonTable = "session_comparisons"
+	// ComparisonInverseTable is the table name for the SessionComparison entity.
+	// It exists in this package in order to avoid circular dependency with the "sessioncomparison" package.
+	ComparisonInverseTable = "session_comparisons"
+	// ComparisonColumn is the table column denoting the comparison relation/edge.
+	ComparisonColumn = "session_id"
+	// NextComparisonsTable is the table that holds the next_comparisons relation/edge.
+	NextComparisonsTable = "session_comparisons"
+	// NextComparisonsInverseTable is the table name for the SessionComparison entity.
+	// It exists in this package in order to avoid circular dependency with the "sessioncomparison" package.
+	NextComparisonsInverseTable = "session_comparisons"
+	// NextComparisonsColumn is the table column denoting the next_comparisons relation/edge.
+	NextComparisonsColumn = "previous_session_id"
+	// ActionItemsTable is the table that holds the action_items relation/edge.
+	ActionItemsTable = "action_items"
+	// ActionItemsInverseTable is the table name for the ActionItem entity.
+	// It exists in this package in order to avoid circular dependency with the "actionitem" package.
+	ActionItemsInverseTable = "action_items"
+	// ActionItemsColumn is the table column denoting the action_items relation/edge.
+	ActionItemsColumn = "session_id"
+	// GroupTable is the table that holds the group relation/edge.
+	GroupTable = "alert_sessions"
+	// GroupInverseTable is the table name for the SessionGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "sessiongroup" package.
+	GroupInverseTable = "session_groups"
+	// GroupColumn is the table column denoting the group relation/edge.
+	GroupColumn = "group_id"
 )
 
 // Columns holds all SQL columns for alertsession fields.
 var Columns = []string{
 	FieldID,
+	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldAlertData,
 	FieldAgentType,
 	FieldAlertType,
@@ -219,17 +380,53 @@ var Columns = []string{
 	FieldFinalAnalysis,
 	FieldExecutiveSummary,
 	FieldExecutiveSummaryError,
+	FieldExecutiveSummaryTemplate,
+	FieldTechnicalSummary,
+	FieldTechnicalSummaryError,
+	FieldSeverity,
+	FieldOutcome,
+	FieldOutcomeReason,
+	FieldOutcomeSource,
 	FieldSessionMetadata,
 	FieldAuthor,
+	FieldAuthorSubject,
 	FieldRunbookURL,
 	FieldMcpSelection,
+	FieldAlertInstructions,
+	FieldAlertImages,
+	FieldOutputLanguage,
 	FieldChainID,
+	FieldOwner,
 	FieldCurrentStageIndex,
 	FieldCurrentStageID,
 	FieldPodID,
 	FieldLastInteractionAt,
 	FieldSlackMessageFingerprint,
-	FieldDeletedAt,
+	FieldAlertKey,
+	FieldAlertResolvedAt,
+	FieldAlertResolution,
+	FieldCancelInitiator,
+	FieldCancelReason,
+	FieldCancelledBy,
+	FieldRequestID,
+	FieldDegradedReason,
+	FieldModelRouting,
+	FieldNoiseTriage,
+	FieldForceFullInvestigation,
+	FieldFederationOrigin,
+	FieldTarget,
+	FieldOnCall,
+	FieldGroupID,
+	FieldDuplicatedFrom,
+	FieldLlmProvider,
+	FieldSandbox,
+	FieldFallbackChain,
+	FieldCallbackURL,
+	FieldCallbackSecret,
+	FieldCallbackStatus,
+	FieldCallbackAttempts,
+	FieldCallbackLastError,
+	FieldCallbackDeliveredAt,
 	FieldReviewStatus,
 	FieldAssignee,
 	FieldAssignedAt,
@@ -237,6 +434,10 @@ var Columns = []string{
 	FieldQualityRating,
 	FieldActionTaken,
 	FieldInvestigationFeedback,
+	FieldHandoffNotes,
+	FieldHandoffNotesRevision,
+	FieldHandoffNotesUpdatedBy,
+	FieldHandoffNotesUpdatedAt,
 }
 
 var (
@@ -256,8 +457,22 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultForceFullInvestigation holds the default value on creation for the "force_full_investigation" field.
+	DefaultForceFullInvestigation bool
+	// DefaultSandbox holds the default value on creation for the "sandbox" field.
+	DefaultSandbox bool
+	// DefaultFallbackChain holds the default value on creation for the "fallback_chain" field.
+	DefaultFallbackChain bool
+	// DefaultCallbackAttempts holds the default value on creation for the "callback_attempts" field.
+	DefaultCallbackAttempts int
+	// DefaultHandoffNotesRevision holds the default value on creation for the "handoff_notes_revision" field.
+	DefaultHandoffNotesRevision int
 )
 
 // Status defines the type for the "status" enum field.
@@ -268,13 +483,14 @@ const DefaultStatus = StatusPending
 
 // Status values.
 const (
-	StatusPending    Status = "pending"
-	StatusInProgress Status = "in_progress"
-	StatusCancelling Status = "cancelling"
-	StatusCompleted  Status = "completed"
-	StatusFailed     Status = "failed"
-	StatusCancelled  Status = "cancelled"
-	StatusTimedOut   Status = "timed_out"
+	StatusPending       Status = "pending"
+	StatusInProgress    Status = "in_progress"
+	StatusCancelling    Status = "cancelling"
+	StatusCompleted     Status = "completed"
+	StatusFailed        Status = "failed"
+	StatusCancelled     Status = "cancelled"
+	StatusTimedOut      Status = "timed_out"
+	StatusAutoCancelled Status = "auto_cancelled"
 )
 
 func (s Status) String() string {
@@ -284,13 +500,137 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusPending, StatusInProgress, StatusCancelling, StatusCompleted, StatusFailed, StatusCancelled, StatusTimedOut:
+	case StatusPending, StatusInProgress, StatusCancelling, StatusCompleted, StatusFailed, StatusCancelled, StatusTimedOut, StatusAutoCancelled:
 		return nil
 	default:
 		return fmt.Errorf("alertsession: invalid enum value for status field: %q", s)
 	}
 }
 
+// Severity defines the type for the "severity" enum field.
+type Severity string
+
+// Severity values.
+const (
+	SeverityCritical Severity = "critical"
+	SeverityHigh     Severity = "high"
+	SeverityMedium   Severity = "medium"
+	SeverityLow      Severity = "low"
+)
+
+func (s Severity) String() string {
+	return string(s)
+}
+
+// SeverityValidator is a validator for the "severity" field enum values. It is called by the builders before save.
+func SeverityValidator(s Severity) error {
+	switch s {
+	case SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow:
+		return nil
+	default:
+		return fmt.Errorf("alertsession: invalid enum value for severity field: %q", s)
+	}
+}
+
+// Outcome defines the type for the "outcome" enum field.
+type Outcome string
+
+// Outcome values.
+const (
+	OutcomeRootCauseIdentified Outcome = "root_cause_identified"
+	OutcomeMitigated           Outcome = "mitigated"
+	OutcomeNeedsHuman          Outcome = "needs_human"
+	OutcomeFalsePositive       Outcome = "false_positive"
+	OutcomeInconclusive        Outcome = "inconclusive"
+)
+
+func (o Outcome) String() string {
+	return string(o)
+}
+
+// OutcomeValidator is a validator for the "outcome" field enum values. It is called by the builders before save.
+func OutcomeValidator(o Outcome) error {
+	switch o {
+	case OutcomeRootCauseIdentified, OutcomeMitigated, OutcomeNeedsHuman, OutcomeFalsePositive, OutcomeInconclusive:
+		return nil
+	default:
+		return fmt.Errorf("alertsession: invalid enum value for outcome field: %q", o)
+	}
+}
+
+// OutcomeSource defines the type for the "outcome_source" enum field.
+type OutcomeSource string
+
+// OutcomeSource values.
+const (
+	OutcomeSourceAgent       OutcomeSource = "agent"
+	OutcomeSourceClassifier  OutcomeSource = "classifier"
+	OutcomeSourceNoiseTriage OutcomeSource = "noise_triage"
+)
+
+func (os OutcomeSource) String() string {
+	return string(os)
+}
+
+// OutcomeSourceValidator is a validator for the "outcome_source" field enum values. It is called by the builders before save.
+func OutcomeSourceValidator(os OutcomeSource) error {
+	switch os {
+	case OutcomeSourceAgent, OutcomeSourceClassifier, OutcomeSourceNoiseTriage:
+		return nil
+	default:
+		return fmt.Errorf("alertsession: invalid enum value for outcome_source field: %q", os)
+	}
+}
+
+// CancelInitiator defines the type for the "cancel_initiator" enum field.
+type CancelInitiator string
+
+// CancelInitiator values.
+const (
+	CancelInitiatorUser          CancelInitiator = "user"
+	CancelInitiatorPendingTTL    CancelInitiator = "pending_ttl"
+	CancelInitiatorAlertResolved CancelInitiator = "alert_resolved"
+	CancelInitiatorPreemption    CancelInitiator = "preemption"
+)
+
+func (ci CancelInitiator) String() string {
+	return string(ci)
+}
+
+// CancelInitiatorValidator is a validator for the "cancel_initiator" field enum values. It is called by the builders before save.
+func CancelInitiatorValidator(ci CancelInitiator) error {
+	switch ci {
+	case CancelInitiatorUser, CancelInitiatorPendingTTL, CancelInitiatorAlertResolved, CancelInitiatorPreemption:
+		return nil
+	default:
+		return fmt.Errorf("alertsession: invalid enum value for cancel_initiator field: %q", ci)
+	}
+}
+
+// CallbackStatus defines the type for the "callback_status" enum field.
+type CallbackStatus string
+
+// CallbackStatus values.
+const (
+	CallbackStatusPending   CallbackStatus = "pending"
+	CallbackStatusDelivered CallbackStatus = "delivered"
+	CallbackStatusFailed    CallbackStatus = "failed"
+)
+
+func (cs CallbackStatus) String() string {
+	return string(cs)
+}
+
+// CallbackStatusValidator is a validator for the "callback_status" field enum values. It is called by the builders before save.
+func CallbackStatusValidator(cs CallbackStatus) error {
+	switch cs {
+	case CallbackStatusPending, CallbackStatusDelivered, CallbackStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("alertsession: invalid enum value for callback_status field: %q", cs)
+	}
+}
+
 // ReviewStatus defines the type for the "review_status" enum field.
 type ReviewStatus string
 
@@ -347,6 +687,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByAlertData orders the results by the alert_data field.
 func ByAlertData(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAlertData, opts...).ToFunc()
@@ -402,21 +752,71 @@ func ByExecutiveSummaryError(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExecutiveSummaryError, opts...).ToFunc()
 }
 
+// ByExecutiveSummaryTemplate orders the results by the executive_summary_template field.
+func ByExecutiveSummaryTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutiveSummaryTemplate, opts...).ToFunc()
+}
+
+// ByTechnicalSummary orders the results by the technical_summary field.
+func ByTechnicalSummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTechnicalSummary, opts...).ToFunc()
+}
+
+// ByTechnicalSummaryError orders the results by the technical_summary_error field.
+func ByTechnicalSummaryError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTechnicalSummaryError, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
+// ByOutcome orders the results by the outcome field.
+func ByOutcome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcome, opts...).ToFunc()
+}
+
+// ByOutcomeReason orders the results by the outcome_reason field.
+func ByOutcomeReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcomeReason, opts...).ToFunc()
+}
+
+// ByOutcomeSource orders the results by the outcome_source field.
+func ByOutcomeSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcomeSource, opts...).ToFunc()
+}
+
 // ByAuthor orders the results by the author field.
 func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
 }
 
+// ByAuthorSubject orders the results by the author_subject field.
+func ByAuthorSubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorSubject, opts...).ToFunc()
+}
+
 // ByRunbookURL orders the results by the runbook_url field.
 func ByRunbookURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRunbookURL, opts...).ToFunc()
 }
 
+// ByOutputLanguage orders the results by the output_language field.
+func ByOutputLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputLanguage, opts...).ToFunc()
+}
+
 // ByChainID orders the results by the chain_id field.
 func ByChainID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChainID, opts...).ToFunc()
 }
 
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
 // ByCurrentStageIndex orders the results by the current_stage_index field.
 func ByCurrentStageIndex(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCurrentStageIndex, opts...).ToFunc()
@@ -442,9 +842,104 @@ func BySlackMessageFingerprint(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSlackMessageFingerprint, opts...).ToFunc()
 }
 
-// ByDeletedAt orders the results by the deleted_at field.
-func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+// ByAlertKey orders the results by the alert_key field.
+func ByAlertKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertKey, opts...).ToFunc()
+}
+
+// ByAlertResolvedAt orders the results by the alert_resolved_at field.
+func ByAlertResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertResolvedAt, opts...).ToFunc()
+}
+
+// ByAlertResolution orders the results by the alert_resolution field.
+func ByAlertResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertResolution, opts...).ToFunc()
+}
+
+// ByCancelInitiator orders the results by the cancel_initiator field.
+func ByCancelInitiator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelInitiator, opts...).ToFunc()
+}
+
+// ByCancelReason orders the results by the cancel_reason field.
+func ByCancelReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelReason, opts...).ToFunc()
+}
+
+// ByCancelledBy orders the results by the cancelled_by field.
+func ByCancelledBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledBy, opts...).ToFunc()
+}
+
+// ByRequestID orders the results by the request_id field.
+func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
+}
+
+// ByDegradedReason orders the results by the degraded_reason field.
+func ByDegradedReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDegradedReason, opts...).ToFunc()
+}
+
+// ByForceFullInvestigation orders the results by the force_full_investigation field.
+func ByForceFullInvestigation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForceFullInvestigation, opts...).ToFunc()
+}
+
+// ByGroupID orders the results by the group_id field.
+func ByGroupID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupID, opts...).ToFunc()
+}
+
+// ByDuplicatedFrom orders the results by the duplicated_from field.
+func ByDuplicatedFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuplicatedFrom, opts...).ToFunc()
+}
+
+// ByLlmProvider orders the results by the llm_provider field.
+func ByLlmProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLlmProvider, opts...).ToFunc()
+}
+
+// BySandbox orders the results by the sandbox field.
+func BySandbox(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSandbox, opts...).ToFunc()
+}
+
+// ByFallbackChain orders the results by the fallback_chain field.
+func ByFallbackChain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFallbackChain, opts...).ToFunc()
+}
+
+// ByCallbackURL orders the results by the callback_url field.
+func ByCallbackURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallbackURL, opts...).ToFunc()
+}
+
+// ByCallbackSecret orders the results by the callback_secret field.
+func ByCallbackSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallbackSecret, opts...).ToFunc()
+}
+
+// ByCallbackStatus orders the results by the callback_status field.
+func ByCallbackStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallbackStatus, opts...).ToFunc()
+}
+
+// ByCallbackAttempts orders the results by the callback_attempts field.
+func ByCallbackAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallbackAttempts, opts...).ToFunc()
+}
+
+// ByCallbackLastError orders the results by the callback_last_error field.
+func ByCallbackLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallbackLastError, opts...).ToFunc()
+}
+
+// ByCallbackDeliveredAt orders the results by the callback_delivered_at field.
+func ByCallbackDeliveredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCallbackDeliveredAt, opts...).ToFunc()
 }
 
 // ByReviewStatus orders the results by the review_status field.
@@ -482,6 +977,26 @@ func ByInvestigationFeedback(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInvestigationFeedback, opts...).ToFunc()
 }
 
+// ByHandoffNotes orders the results by the handoff_notes field.
+func ByHandoffNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandoffNotes, opts...).ToFunc()
+}
+
+// ByHandoffNotesRevision orders the results by the handoff_notes_revision field.
+func ByHandoffNotesRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandoffNotesRevision, opts...).ToFunc()
+}
+
+// ByHandoffNotesUpdatedBy orders the results by the handoff_notes_updated_by field.
+func ByHandoffNotesUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandoffNotesUpdatedBy, opts...).ToFunc()
+}
+
+// ByHandoffNotesUpdatedAt orders the results by the handoff_notes_updated_at field.
+func ByHandoffNotesUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHandoffNotesUpdatedAt, opts...).ToFunc()
+}
+
 // ByStagesCount orders the results by stages count.
 func ByStagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -615,6 +1130,20 @@ func ByReviewActivities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 	}
 }
 
+// ByClaimsCount orders the results by claims count.
+func ByClaimsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClaimsStep(), opts...)
+	}
+}
+
+// ByClaims orders the results by claims terms.
+func ByClaims(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClaimsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByMemoriesCount orders the results by memories count.
 func ByMemoriesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -642,6 +1171,76 @@ func ByInjectedMemories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newInjectedMemoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRedactionReviewsCount orders the results by redaction_reviews count.
+func ByRedactionReviewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRedactionReviewsStep(), opts...)
+	}
+}
+
+// ByRedactionReviews orders the results by redaction_reviews terms.
+func ByRedactionReviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRedactionReviewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHandoffNoteRevisionsCount orders the results by handoff_note_revisions count.
+func ByHandoffNoteRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHandoffNoteRevisionsStep(), opts...)
+	}
+}
+
+// ByHandoffNoteRevisions orders the results by handoff_note_revisions terms.
+func ByHandoffNoteRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHandoffNoteRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByComparisonField orders the results by comparison field.
+func ByComparisonField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newComparisonStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByNextComparisonsCount orders the results by next_comparisons count.
+func ByNextComparisonsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNextComparisonsStep(), opts...)
+	}
+}
+
+// ByNextComparisons orders the results by next_comparisons terms.
+func ByNextComparisons(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNextComparisonsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByActionItemsCount orders the results by action_items count.
+func ByActionItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActionItemsStep(), opts...)
+	}
+}
+
+// ByActionItems orders the results by action_items terms.
+func ByActionItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActionItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupField orders the results by group field.
+func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newStagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -712,6 +1311,13 @@ func newReviewActivitiesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ReviewActivitiesTable, ReviewActivitiesColumn),
 	)
 }
+func newClaimsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClaimsInverseTable, SessionClaimFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClaimsTable, ClaimsColumn),
+	)
+}
 func newMemoriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -726,3 +1332,45 @@ func newInjectedMemoriesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, InjectedMemoriesTable, InjectedMemoriesPrimaryKey...),
 	)
 }
+func newRedactionReviewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RedactionReviewsInverseTable, RedactionReviewFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RedactionReviewsTable, RedactionReviewsColumn),
+	)
+}
+func newHandoffNoteRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HandoffNoteRevisionsInverseTable, HandoffNoteRevisionFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HandoffNoteRevisionsTable, HandoffNoteRevisionsColumn),
+	)
+}
+func newComparisonStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ComparisonInverseTable, SessionComparisonFieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ComparisonTable, ComparisonColumn),
+	)
+}
+func newNextComparisonsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NextComparisonsInverseTable, SessionComparisonFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NextComparisonsTable, NextComparisonsColumn),
+	)
+}
+func newActionItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActionItemsInverseTable, ActionItemFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ActionItemsTable, ActionItemsColumn),
+	)
+}
+func newGroupStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupInverseTable, SessionGroupFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+	)
+}
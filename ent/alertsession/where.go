@@ -65,6 +65,16 @@ func IDContainsFold(id string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldContainsFold(FieldID, id))
 }
 
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // AlertData applies equality check predicate on the "alert_data" field. It's identical to AlertDataEQ.
 func AlertData(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldAlertData, v))
@@ -115,21 +125,56 @@ func ExecutiveSummaryError(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldExecutiveSummaryError, v))
 }
 
+// ExecutiveSummaryTemplate applies equality check predicate on the "executive_summary_template" field. It's identical to ExecutiveSummaryTemplateEQ.
+func ExecutiveSummaryTemplate(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldExecutiveSummaryTemplate, v))
+}
+
+// TechnicalSummary applies equality check predicate on the "technical_summary" field. It's identical to TechnicalSummaryEQ.
+func TechnicalSummary(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldTechnicalSummary, v))
+}
+
+// TechnicalSummaryError applies equality check predicate on the "technical_summary_error" field. It's identical to TechnicalSummaryErrorEQ.
+func TechnicalSummaryError(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldTechnicalSummaryError, v))
+}
+
+// OutcomeReason applies equality check predicate on the "outcome_reason" field. It's identical to OutcomeReasonEQ.
+func OutcomeReason(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldOutcomeReason, v))
+}
+
 // Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
 func Author(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldAuthor, v))
 }
 
+// AuthorSubject applies equality check predicate on the "author_subject" field. It's identical to AuthorSubjectEQ.
+func AuthorSubject(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAuthorSubject, v))
+}
+
 // RunbookURL applies equality check predicate on the "runbook_url" field. It's identical to RunbookURLEQ.
 func RunbookURL(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldRunbookURL, v))
 }
 
+// OutputLanguage applies equality check predicate on the "output_language" field. It's identical to OutputLanguageEQ.
+func OutputLanguage(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldOutputLanguage, v))
+}
+
 // ChainID applies equality check predicate on the "chain_id" field. It's identical to ChainIDEQ.
 func ChainID(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldChainID, v))
 }
 
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldOwner, v))
+}
+
 // CurrentStageIndex applies equality check predicate on the "current_stage_index" field. It's identical to CurrentStageIndexEQ.
 func CurrentStageIndex(v int) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldCurrentStageIndex, v))
@@ -155,9 +200,94 @@ func SlackMessageFingerprint(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldSlackMessageFingerprint, v))
 }
 
-// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
-func DeletedAt(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldDeletedAt, v))
+// AlertKey applies equality check predicate on the "alert_key" field. It's identical to AlertKeyEQ.
+func AlertKey(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAlertKey, v))
+}
+
+// AlertResolvedAt applies equality check predicate on the "alert_resolved_at" field. It's identical to AlertResolvedAtEQ.
+func AlertResolvedAt(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAlertResolvedAt, v))
+}
+
+// AlertResolution applies equality check predicate on the "alert_resolution" field. It's identical to AlertResolutionEQ.
+func AlertResolution(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAlertResolution, v))
+}
+
+// CancelReason applies equality check predicate on the "cancel_reason" field. It's identical to CancelReasonEQ.
+func CancelReason(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// CancelledBy applies equality check predicate on the "cancelled_by" field. It's identical to CancelledByEQ.
+func CancelledBy(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCancelledBy, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldRequestID, v))
+}
+
+// DegradedReason applies equality check predicate on the "degraded_reason" field. It's identical to DegradedReasonEQ.
+func DegradedReason(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldDegradedReason, v))
+}
+
+// ForceFullInvestigation applies equality check predicate on the "force_full_investigation" field. It's identical to ForceFullInvestigationEQ.
+func ForceFullInvestigation(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldForceFullInvestigation, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldGroupID, v))
+}
+
+// DuplicatedFrom applies equality check predicate on the "duplicated_from" field. It's identical to DuplicatedFromEQ.
+func DuplicatedFrom(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldDuplicatedFrom, v))
+}
+
+// LlmProvider applies equality check predicate on the "llm_provider" field. It's identical to LlmProviderEQ.
+func LlmProvider(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldLlmProvider, v))
+}
+
+// Sandbox applies equality check predicate on the "sandbox" field. It's identical to SandboxEQ.
+func Sandbox(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldSandbox, v))
+}
+
+// FallbackChain applies equality check predicate on the "fallback_chain" field. It's identical to FallbackChainEQ.
+func FallbackChain(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldFallbackChain, v))
+}
+
+// CallbackURL applies equality check predicate on the "callback_url" field. It's identical to CallbackURLEQ.
+func CallbackURL(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackURL, v))
+}
+
+// CallbackSecret applies equality check predicate on the "callback_secret" field. It's identical to CallbackSecretEQ.
+func CallbackSecret(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackSecret, v))
+}
+
+// CallbackAttempts applies equality check predicate on the "callback_attempts" field. It's identical to CallbackAttemptsEQ.
+func CallbackAttempts(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackAttempts, v))
+}
+
+// CallbackLastError applies equality check predicate on the "callback_last_error" field. It's identical to CallbackLastErrorEQ.
+func CallbackLastError(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackLastError, v))
+}
+
+// CallbackDeliveredAt applies equality check predicate on the "callback_delivered_at" field. It's identical to CallbackDeliveredAtEQ.
+func CallbackDeliveredAt(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackDeliveredAt, v))
 }
 
 // Assignee applies equality check predicate on the "assignee" field. It's identical to AssigneeEQ.
@@ -185,6 +315,116 @@ func InvestigationFeedback(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldInvestigationFeedback, v))
 }
 
+// HandoffNotes applies equality check predicate on the "handoff_notes" field. It's identical to HandoffNotesEQ.
+func HandoffNotes(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldHandoffNotes, v))
+}
+
+// HandoffNotesRevision applies equality check predicate on the "handoff_notes_revision" field. It's identical to HandoffNotesRevisionEQ.
+func HandoffNotesRevision(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldHandoffNotesRevision, v))
+}
+
+// HandoffNotesUpdatedBy applies equality check predicate on the "handoff_notes_updated_by" field. It's identical to HandoffNotesUpdatedByEQ.
+func HandoffNotesUpdatedBy(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedAt applies equality check predicate on the "handoff_notes_updated_at" field. It's identical to HandoffNotesUpdatedAtEQ.
+func HandoffNotesUpdatedAt(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldHandoffNotesUpdatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // AlertDataEQ applies the EQ predicate on the "alert_data" field.
 func AlertDataEQ(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldEQ(FieldAlertData, v))
@@ -850,614 +1090,2379 @@ func ExecutiveSummaryErrorContainsFold(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldContainsFold(FieldExecutiveSummaryError, v))
 }
 
-// SessionMetadataIsNil applies the IsNil predicate on the "session_metadata" field.
-func SessionMetadataIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldSessionMetadata))
+// ExecutiveSummaryTemplateEQ applies the EQ predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldExecutiveSummaryTemplate, v))
 }
 
-// SessionMetadataNotNil applies the NotNil predicate on the "session_metadata" field.
-func SessionMetadataNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldSessionMetadata))
+// ExecutiveSummaryTemplateNEQ applies the NEQ predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorEQ applies the EQ predicate on the "author" field.
-func AuthorEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldAuthor, v))
+// ExecutiveSummaryTemplateIn applies the In predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldExecutiveSummaryTemplate, vs...))
 }
 
-// AuthorNEQ applies the NEQ predicate on the "author" field.
-func AuthorNEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldAuthor, v))
+// ExecutiveSummaryTemplateNotIn applies the NotIn predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldExecutiveSummaryTemplate, vs...))
 }
 
-// AuthorIn applies the In predicate on the "author" field.
-func AuthorIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldAuthor, vs...))
+// ExecutiveSummaryTemplateGT applies the GT predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorNotIn applies the NotIn predicate on the "author" field.
-func AuthorNotIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldAuthor, vs...))
+// ExecutiveSummaryTemplateGTE applies the GTE predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorGT applies the GT predicate on the "author" field.
-func AuthorGT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldAuthor, v))
+// ExecutiveSummaryTemplateLT applies the LT predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorGTE applies the GTE predicate on the "author" field.
-func AuthorGTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldAuthor, v))
+// ExecutiveSummaryTemplateLTE applies the LTE predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorLT applies the LT predicate on the "author" field.
-func AuthorLT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldAuthor, v))
+// ExecutiveSummaryTemplateContains applies the Contains predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorLTE applies the LTE predicate on the "author" field.
-func AuthorLTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLTE(FieldAuthor, v))
+// ExecutiveSummaryTemplateHasPrefix applies the HasPrefix predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorContains applies the Contains predicate on the "author" field.
-func AuthorContains(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContains(FieldAuthor, v))
+// ExecutiveSummaryTemplateHasSuffix applies the HasSuffix predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
-func AuthorHasPrefix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasPrefix(FieldAuthor, v))
+// ExecutiveSummaryTemplateIsNil applies the IsNil predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldExecutiveSummaryTemplate))
 }
 
-// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
-func AuthorHasSuffix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasSuffix(FieldAuthor, v))
+// ExecutiveSummaryTemplateNotNil applies the NotNil predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldExecutiveSummaryTemplate))
 }
 
-// AuthorIsNil applies the IsNil predicate on the "author" field.
-func AuthorIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldAuthor))
+// ExecutiveSummaryTemplateEqualFold applies the EqualFold predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorNotNil applies the NotNil predicate on the "author" field.
-func AuthorNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldAuthor))
+// ExecutiveSummaryTemplateContainsFold applies the ContainsFold predicate on the "executive_summary_template" field.
+func ExecutiveSummaryTemplateContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldExecutiveSummaryTemplate, v))
 }
 
-// AuthorEqualFold applies the EqualFold predicate on the "author" field.
-func AuthorEqualFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEqualFold(FieldAuthor, v))
+// TechnicalSummaryEQ applies the EQ predicate on the "technical_summary" field.
+func TechnicalSummaryEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldTechnicalSummary, v))
 }
 
-// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
-func AuthorContainsFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContainsFold(FieldAuthor, v))
+// TechnicalSummaryNEQ applies the NEQ predicate on the "technical_summary" field.
+func TechnicalSummaryNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldTechnicalSummary, v))
 }
 
-// RunbookURLEQ applies the EQ predicate on the "runbook_url" field.
-func RunbookURLEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldRunbookURL, v))
+// TechnicalSummaryIn applies the In predicate on the "technical_summary" field.
+func TechnicalSummaryIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldTechnicalSummary, vs...))
 }
 
-// RunbookURLNEQ applies the NEQ predicate on the "runbook_url" field.
-func RunbookURLNEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldRunbookURL, v))
+// TechnicalSummaryNotIn applies the NotIn predicate on the "technical_summary" field.
+func TechnicalSummaryNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldTechnicalSummary, vs...))
 }
 
-// RunbookURLIn applies the In predicate on the "runbook_url" field.
-func RunbookURLIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldRunbookURL, vs...))
+// TechnicalSummaryGT applies the GT predicate on the "technical_summary" field.
+func TechnicalSummaryGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldTechnicalSummary, v))
 }
 
-// RunbookURLNotIn applies the NotIn predicate on the "runbook_url" field.
-func RunbookURLNotIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldRunbookURL, vs...))
+// TechnicalSummaryGTE applies the GTE predicate on the "technical_summary" field.
+func TechnicalSummaryGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldTechnicalSummary, v))
 }
 
-// RunbookURLGT applies the GT predicate on the "runbook_url" field.
-func RunbookURLGT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldRunbookURL, v))
+// TechnicalSummaryLT applies the LT predicate on the "technical_summary" field.
+func TechnicalSummaryLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldTechnicalSummary, v))
 }
 
-// RunbookURLGTE applies the GTE predicate on the "runbook_url" field.
-func RunbookURLGTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldRunbookURL, v))
+// TechnicalSummaryLTE applies the LTE predicate on the "technical_summary" field.
+func TechnicalSummaryLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldTechnicalSummary, v))
 }
 
-// RunbookURLLT applies the LT predicate on the "runbook_url" field.
-func RunbookURLLT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldRunbookURL, v))
+// TechnicalSummaryContains applies the Contains predicate on the "technical_summary" field.
+func TechnicalSummaryContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldTechnicalSummary, v))
 }
 
-// RunbookURLLTE applies the LTE predicate on the "runbook_url" field.
-func RunbookURLLTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLTE(FieldRunbookURL, v))
+// TechnicalSummaryHasPrefix applies the HasPrefix predicate on the "technical_summary" field.
+func TechnicalSummaryHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldTechnicalSummary, v))
 }
 
-// RunbookURLContains applies the Contains predicate on the "runbook_url" field.
-func RunbookURLContains(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContains(FieldRunbookURL, v))
+// TechnicalSummaryHasSuffix applies the HasSuffix predicate on the "technical_summary" field.
+func TechnicalSummaryHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldTechnicalSummary, v))
 }
 
-// RunbookURLHasPrefix applies the HasPrefix predicate on the "runbook_url" field.
-func RunbookURLHasPrefix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasPrefix(FieldRunbookURL, v))
+// TechnicalSummaryIsNil applies the IsNil predicate on the "technical_summary" field.
+func TechnicalSummaryIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldTechnicalSummary))
 }
 
-// RunbookURLHasSuffix applies the HasSuffix predicate on the "runbook_url" field.
-func RunbookURLHasSuffix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasSuffix(FieldRunbookURL, v))
+// TechnicalSummaryNotNil applies the NotNil predicate on the "technical_summary" field.
+func TechnicalSummaryNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldTechnicalSummary))
 }
 
-// RunbookURLIsNil applies the IsNil predicate on the "runbook_url" field.
-func RunbookURLIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldRunbookURL))
+// TechnicalSummaryEqualFold applies the EqualFold predicate on the "technical_summary" field.
+func TechnicalSummaryEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldTechnicalSummary, v))
 }
 
-// RunbookURLNotNil applies the NotNil predicate on the "runbook_url" field.
-func RunbookURLNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldRunbookURL))
+// TechnicalSummaryContainsFold applies the ContainsFold predicate on the "technical_summary" field.
+func TechnicalSummaryContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldTechnicalSummary, v))
 }
 
-// RunbookURLEqualFold applies the EqualFold predicate on the "runbook_url" field.
-func RunbookURLEqualFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEqualFold(FieldRunbookURL, v))
+// TechnicalSummaryErrorEQ applies the EQ predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldTechnicalSummaryError, v))
 }
 
-// RunbookURLContainsFold applies the ContainsFold predicate on the "runbook_url" field.
-func RunbookURLContainsFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContainsFold(FieldRunbookURL, v))
+// TechnicalSummaryErrorNEQ applies the NEQ predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldTechnicalSummaryError, v))
 }
 
-// McpSelectionIsNil applies the IsNil predicate on the "mcp_selection" field.
-func McpSelectionIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldMcpSelection))
+// TechnicalSummaryErrorIn applies the In predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldTechnicalSummaryError, vs...))
 }
 
-// McpSelectionNotNil applies the NotNil predicate on the "mcp_selection" field.
-func McpSelectionNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldMcpSelection))
+// TechnicalSummaryErrorNotIn applies the NotIn predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldTechnicalSummaryError, vs...))
 }
 
-// ChainIDEQ applies the EQ predicate on the "chain_id" field.
-func ChainIDEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldChainID, v))
+// TechnicalSummaryErrorGT applies the GT predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldTechnicalSummaryError, v))
 }
 
-// ChainIDNEQ applies the NEQ predicate on the "chain_id" field.
-func ChainIDNEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldChainID, v))
+// TechnicalSummaryErrorGTE applies the GTE predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldTechnicalSummaryError, v))
 }
 
-// ChainIDIn applies the In predicate on the "chain_id" field.
-func ChainIDIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldChainID, vs...))
+// TechnicalSummaryErrorLT applies the LT predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldTechnicalSummaryError, v))
 }
 
-// ChainIDNotIn applies the NotIn predicate on the "chain_id" field.
-func ChainIDNotIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldChainID, vs...))
+// TechnicalSummaryErrorLTE applies the LTE predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldTechnicalSummaryError, v))
 }
 
-// ChainIDGT applies the GT predicate on the "chain_id" field.
-func ChainIDGT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldChainID, v))
+// TechnicalSummaryErrorContains applies the Contains predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldTechnicalSummaryError, v))
 }
 
-// ChainIDGTE applies the GTE predicate on the "chain_id" field.
-func ChainIDGTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldChainID, v))
+// TechnicalSummaryErrorHasPrefix applies the HasPrefix predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldTechnicalSummaryError, v))
 }
 
-// ChainIDLT applies the LT predicate on the "chain_id" field.
-func ChainIDLT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldChainID, v))
+// TechnicalSummaryErrorHasSuffix applies the HasSuffix predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldTechnicalSummaryError, v))
 }
 
-// ChainIDLTE applies the LTE predicate on the "chain_id" field.
+// TechnicalSummaryErrorIsNil applies the IsNil predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldTechnicalSummaryError))
+}
+
+// TechnicalSummaryErrorNotNil applies the NotNil predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldTechnicalSummaryError))
+}
+
+// TechnicalSummaryErrorEqualFold applies the EqualFold predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldTechnicalSummaryError, v))
+}
+
+// TechnicalSummaryErrorContainsFold applies the ContainsFold predicate on the "technical_summary_error" field.
+func TechnicalSummaryErrorContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldTechnicalSummaryError, v))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v Severity) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v Severity) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...Severity) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...Severity) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityIsNil applies the IsNil predicate on the "severity" field.
+func SeverityIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldSeverity))
+}
+
+// SeverityNotNil applies the NotNil predicate on the "severity" field.
+func SeverityNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldSeverity))
+}
+
+// OutcomeEQ applies the EQ predicate on the "outcome" field.
+func OutcomeEQ(v Outcome) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldOutcome, v))
+}
+
+// OutcomeNEQ applies the NEQ predicate on the "outcome" field.
+func OutcomeNEQ(v Outcome) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldOutcome, v))
+}
+
+// OutcomeIn applies the In predicate on the "outcome" field.
+func OutcomeIn(vs ...Outcome) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldOutcome, vs...))
+}
+
+// OutcomeNotIn applies the NotIn predicate on the "outcome" field.
+func OutcomeNotIn(vs ...Outcome) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldOutcome, vs...))
+}
+
+// OutcomeIsNil applies the IsNil predicate on the "outcome" field.
+func OutcomeIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldOutcome))
+}
+
+// OutcomeNotNil applies the NotNil predicate on the "outcome" field.
+func OutcomeNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldOutcome))
+}
+
+// OutcomeReasonEQ applies the EQ predicate on the "outcome_reason" field.
+func OutcomeReasonEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonNEQ applies the NEQ predicate on the "outcome_reason" field.
+func OutcomeReasonNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonIn applies the In predicate on the "outcome_reason" field.
+func OutcomeReasonIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldOutcomeReason, vs...))
+}
+
+// OutcomeReasonNotIn applies the NotIn predicate on the "outcome_reason" field.
+func OutcomeReasonNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldOutcomeReason, vs...))
+}
+
+// OutcomeReasonGT applies the GT predicate on the "outcome_reason" field.
+func OutcomeReasonGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonGTE applies the GTE predicate on the "outcome_reason" field.
+func OutcomeReasonGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonLT applies the LT predicate on the "outcome_reason" field.
+func OutcomeReasonLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonLTE applies the LTE predicate on the "outcome_reason" field.
+func OutcomeReasonLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonContains applies the Contains predicate on the "outcome_reason" field.
+func OutcomeReasonContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonHasPrefix applies the HasPrefix predicate on the "outcome_reason" field.
+func OutcomeReasonHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonHasSuffix applies the HasSuffix predicate on the "outcome_reason" field.
+func OutcomeReasonHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonIsNil applies the IsNil predicate on the "outcome_reason" field.
+func OutcomeReasonIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldOutcomeReason))
+}
+
+// OutcomeReasonNotNil applies the NotNil predicate on the "outcome_reason" field.
+func OutcomeReasonNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldOutcomeReason))
+}
+
+// OutcomeReasonEqualFold applies the EqualFold predicate on the "outcome_reason" field.
+func OutcomeReasonEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldOutcomeReason, v))
+}
+
+// OutcomeReasonContainsFold applies the ContainsFold predicate on the "outcome_reason" field.
+func OutcomeReasonContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldOutcomeReason, v))
+}
+
+// OutcomeSourceEQ applies the EQ predicate on the "outcome_source" field.
+func OutcomeSourceEQ(v OutcomeSource) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldOutcomeSource, v))
+}
+
+// OutcomeSourceNEQ applies the NEQ predicate on the "outcome_source" field.
+func OutcomeSourceNEQ(v OutcomeSource) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldOutcomeSource, v))
+}
+
+// OutcomeSourceIn applies the In predicate on the "outcome_source" field.
+func OutcomeSourceIn(vs ...OutcomeSource) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldOutcomeSource, vs...))
+}
+
+// OutcomeSourceNotIn applies the NotIn predicate on the "outcome_source" field.
+func OutcomeSourceNotIn(vs ...OutcomeSource) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldOutcomeSource, vs...))
+}
+
+// OutcomeSourceIsNil applies the IsNil predicate on the "outcome_source" field.
+func OutcomeSourceIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldOutcomeSource))
+}
+
+// OutcomeSourceNotNil applies the NotNil predicate on the "outcome_source" field.
+func OutcomeSourceNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldOutcomeSource))
+}
+
+// SessionMetadataIsNil applies the IsNil predicate on the "session_metadata" field.
+func SessionMetadataIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldSessionMetadata))
+}
+
+// SessionMetadataNotNil applies the NotNil predicate on the "session_metadata" field.
+func SessionMetadataNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldSessionMetadata))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorIsNil applies the IsNil predicate on the "author" field.
+func AuthorIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldAuthor))
+}
+
+// AuthorNotNil applies the NotNil predicate on the "author" field.
+func AuthorNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldAuthor))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// AuthorSubjectEQ applies the EQ predicate on the "author_subject" field.
+func AuthorSubjectEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectNEQ applies the NEQ predicate on the "author_subject" field.
+func AuthorSubjectNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectIn applies the In predicate on the "author_subject" field.
+func AuthorSubjectIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldAuthorSubject, vs...))
+}
+
+// AuthorSubjectNotIn applies the NotIn predicate on the "author_subject" field.
+func AuthorSubjectNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldAuthorSubject, vs...))
+}
+
+// AuthorSubjectGT applies the GT predicate on the "author_subject" field.
+func AuthorSubjectGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectGTE applies the GTE predicate on the "author_subject" field.
+func AuthorSubjectGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectLT applies the LT predicate on the "author_subject" field.
+func AuthorSubjectLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectLTE applies the LTE predicate on the "author_subject" field.
+func AuthorSubjectLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectContains applies the Contains predicate on the "author_subject" field.
+func AuthorSubjectContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectHasPrefix applies the HasPrefix predicate on the "author_subject" field.
+func AuthorSubjectHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectHasSuffix applies the HasSuffix predicate on the "author_subject" field.
+func AuthorSubjectHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectIsNil applies the IsNil predicate on the "author_subject" field.
+func AuthorSubjectIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldAuthorSubject))
+}
+
+// AuthorSubjectNotNil applies the NotNil predicate on the "author_subject" field.
+func AuthorSubjectNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldAuthorSubject))
+}
+
+// AuthorSubjectEqualFold applies the EqualFold predicate on the "author_subject" field.
+func AuthorSubjectEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectContainsFold applies the ContainsFold predicate on the "author_subject" field.
+func AuthorSubjectContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldAuthorSubject, v))
+}
+
+// RunbookURLEQ applies the EQ predicate on the "runbook_url" field.
+func RunbookURLEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldRunbookURL, v))
+}
+
+// RunbookURLNEQ applies the NEQ predicate on the "runbook_url" field.
+func RunbookURLNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldRunbookURL, v))
+}
+
+// RunbookURLIn applies the In predicate on the "runbook_url" field.
+func RunbookURLIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldRunbookURL, vs...))
+}
+
+// RunbookURLNotIn applies the NotIn predicate on the "runbook_url" field.
+func RunbookURLNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldRunbookURL, vs...))
+}
+
+// RunbookURLGT applies the GT predicate on the "runbook_url" field.
+func RunbookURLGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldRunbookURL, v))
+}
+
+// RunbookURLGTE applies the GTE predicate on the "runbook_url" field.
+func RunbookURLGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldRunbookURL, v))
+}
+
+// RunbookURLLT applies the LT predicate on the "runbook_url" field.
+func RunbookURLLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldRunbookURL, v))
+}
+
+// RunbookURLLTE applies the LTE predicate on the "runbook_url" field.
+func RunbookURLLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldRunbookURL, v))
+}
+
+// RunbookURLContains applies the Contains predicate on the "runbook_url" field.
+func RunbookURLContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldRunbookURL, v))
+}
+
+// RunbookURLHasPrefix applies the HasPrefix predicate on the "runbook_url" field.
+func RunbookURLHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldRunbookURL, v))
+}
+
+// RunbookURLHasSuffix applies the HasSuffix predicate on the "runbook_url" field.
+func RunbookURLHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldRunbookURL, v))
+}
+
+// RunbookURLIsNil applies the IsNil predicate on the "runbook_url" field.
+func RunbookURLIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldRunbookURL))
+}
+
+// RunbookURLNotNil applies the NotNil predicate on the "runbook_url" field.
+func RunbookURLNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldRunbookURL))
+}
+
+// RunbookURLEqualFold applies the EqualFold predicate on the "runbook_url" field.
+func RunbookURLEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldRunbookURL, v))
+}
+
+// RunbookURLContainsFold applies the ContainsFold predicate on the "runbook_url" field.
+func RunbookURLContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldRunbookURL, v))
+}
+
+// McpSelectionIsNil applies the IsNil predicate on the "mcp_selection" field.
+func McpSelectionIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldMcpSelection))
+}
+
+// McpSelectionNotNil applies the NotNil predicate on the "mcp_selection" field.
+func McpSelectionNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldMcpSelection))
+}
+
+// AlertInstructionsIsNil applies the IsNil predicate on the "alert_instructions" field.
+func AlertInstructionsIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldAlertInstructions))
+}
+
+// AlertInstructionsNotNil applies the NotNil predicate on the "alert_instructions" field.
+func AlertInstructionsNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldAlertInstructions))
+}
+
+// AlertImagesIsNil applies the IsNil predicate on the "alert_images" field.
+func AlertImagesIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldAlertImages))
+}
+
+// AlertImagesNotNil applies the NotNil predicate on the "alert_images" field.
+func AlertImagesNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldAlertImages))
+}
+
+// OutputLanguageEQ applies the EQ predicate on the "output_language" field.
+func OutputLanguageEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldOutputLanguage, v))
+}
+
+// OutputLanguageNEQ applies the NEQ predicate on the "output_language" field.
+func OutputLanguageNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldOutputLanguage, v))
+}
+
+// OutputLanguageIn applies the In predicate on the "output_language" field.
+func OutputLanguageIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldOutputLanguage, vs...))
+}
+
+// OutputLanguageNotIn applies the NotIn predicate on the "output_language" field.
+func OutputLanguageNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldOutputLanguage, vs...))
+}
+
+// OutputLanguageGT applies the GT predicate on the "output_language" field.
+func OutputLanguageGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldOutputLanguage, v))
+}
+
+// OutputLanguageGTE applies the GTE predicate on the "output_language" field.
+func OutputLanguageGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldOutputLanguage, v))
+}
+
+// OutputLanguageLT applies the LT predicate on the "output_language" field.
+func OutputLanguageLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldOutputLanguage, v))
+}
+
+// OutputLanguageLTE applies the LTE predicate on the "output_language" field.
+func OutputLanguageLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldOutputLanguage, v))
+}
+
+// OutputLanguageContains applies the Contains predicate on the "output_language" field.
+func OutputLanguageContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldOutputLanguage, v))
+}
+
+// OutputLanguageHasPrefix applies the HasPrefix predicate on the "output_language" field.
+func OutputLanguageHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldOutputLanguage, v))
+}
+
+// OutputLanguageHasSuffix applies the HasSuffix predicate on the "output_language" field.
+func OutputLanguageHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldOutputLanguage, v))
+}
+
+// OutputLanguageIsNil applies the IsNil predicate on the "output_language" field.
+func OutputLanguageIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldOutputLanguage))
+}
+
+// OutputLanguageNotNil applies the NotNil predicate on the "output_language" field.
+func OutputLanguageNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldOutputLanguage))
+}
+
+// OutputLanguageEqualFold applies the EqualFold predicate on the "output_language" field.
+func OutputLanguageEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldOutputLanguage, v))
+}
+
+// OutputLanguageContainsFold applies the ContainsFold predicate on the "output_language" field.
+func OutputLanguageContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldOutputLanguage, v))
+}
+
+// ChainIDEQ applies the EQ predicate on the "chain_id" field.
+func ChainIDEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldChainID, v))
+}
+
+// ChainIDNEQ applies the NEQ predicate on the "chain_id" field.
+func ChainIDNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldChainID, v))
+}
+
+// ChainIDIn applies the In predicate on the "chain_id" field.
+func ChainIDIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldChainID, vs...))
+}
+
+// ChainIDNotIn applies the NotIn predicate on the "chain_id" field.
+func ChainIDNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldChainID, vs...))
+}
+
+// ChainIDGT applies the GT predicate on the "chain_id" field.
+func ChainIDGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldChainID, v))
+}
+
+// ChainIDGTE applies the GTE predicate on the "chain_id" field.
+func ChainIDGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldChainID, v))
+}
+
+// ChainIDLT applies the LT predicate on the "chain_id" field.
+func ChainIDLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldChainID, v))
+}
+
+// ChainIDLTE applies the LTE predicate on the "chain_id" field.
 func ChainIDLTE(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldLTE(FieldChainID, v))
 }
 
-// ChainIDContains applies the Contains predicate on the "chain_id" field.
-func ChainIDContains(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContains(FieldChainID, v))
+// ChainIDContains applies the Contains predicate on the "chain_id" field.
+func ChainIDContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldChainID, v))
+}
+
+// ChainIDHasPrefix applies the HasPrefix predicate on the "chain_id" field.
+func ChainIDHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldChainID, v))
+}
+
+// ChainIDHasSuffix applies the HasSuffix predicate on the "chain_id" field.
+func ChainIDHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldChainID, v))
+}
+
+// ChainIDEqualFold applies the EqualFold predicate on the "chain_id" field.
+func ChainIDEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldChainID, v))
+}
+
+// ChainIDContainsFold applies the ContainsFold predicate on the "chain_id" field.
+func ChainIDContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldChainID, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// CurrentStageIndexEQ applies the EQ predicate on the "current_stage_index" field.
+func CurrentStageIndexEQ(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCurrentStageIndex, v))
+}
+
+// CurrentStageIndexNEQ applies the NEQ predicate on the "current_stage_index" field.
+func CurrentStageIndexNEQ(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCurrentStageIndex, v))
+}
+
+// CurrentStageIndexIn applies the In predicate on the "current_stage_index" field.
+func CurrentStageIndexIn(vs ...int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCurrentStageIndex, vs...))
+}
+
+// CurrentStageIndexNotIn applies the NotIn predicate on the "current_stage_index" field.
+func CurrentStageIndexNotIn(vs ...int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCurrentStageIndex, vs...))
+}
+
+// CurrentStageIndexGT applies the GT predicate on the "current_stage_index" field.
+func CurrentStageIndexGT(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCurrentStageIndex, v))
+}
+
+// CurrentStageIndexGTE applies the GTE predicate on the "current_stage_index" field.
+func CurrentStageIndexGTE(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCurrentStageIndex, v))
+}
+
+// CurrentStageIndexLT applies the LT predicate on the "current_stage_index" field.
+func CurrentStageIndexLT(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCurrentStageIndex, v))
+}
+
+// CurrentStageIndexLTE applies the LTE predicate on the "current_stage_index" field.
+func CurrentStageIndexLTE(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCurrentStageIndex, v))
+}
+
+// CurrentStageIndexIsNil applies the IsNil predicate on the "current_stage_index" field.
+func CurrentStageIndexIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCurrentStageIndex))
+}
+
+// CurrentStageIndexNotNil applies the NotNil predicate on the "current_stage_index" field.
+func CurrentStageIndexNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCurrentStageIndex))
+}
+
+// CurrentStageIDEQ applies the EQ predicate on the "current_stage_id" field.
+func CurrentStageIDEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDNEQ applies the NEQ predicate on the "current_stage_id" field.
+func CurrentStageIDNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDIn applies the In predicate on the "current_stage_id" field.
+func CurrentStageIDIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCurrentStageID, vs...))
+}
+
+// CurrentStageIDNotIn applies the NotIn predicate on the "current_stage_id" field.
+func CurrentStageIDNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCurrentStageID, vs...))
+}
+
+// CurrentStageIDGT applies the GT predicate on the "current_stage_id" field.
+func CurrentStageIDGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDGTE applies the GTE predicate on the "current_stage_id" field.
+func CurrentStageIDGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDLT applies the LT predicate on the "current_stage_id" field.
+func CurrentStageIDLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDLTE applies the LTE predicate on the "current_stage_id" field.
+func CurrentStageIDLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDContains applies the Contains predicate on the "current_stage_id" field.
+func CurrentStageIDContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDHasPrefix applies the HasPrefix predicate on the "current_stage_id" field.
+func CurrentStageIDHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDHasSuffix applies the HasSuffix predicate on the "current_stage_id" field.
+func CurrentStageIDHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDIsNil applies the IsNil predicate on the "current_stage_id" field.
+func CurrentStageIDIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCurrentStageID))
+}
+
+// CurrentStageIDNotNil applies the NotNil predicate on the "current_stage_id" field.
+func CurrentStageIDNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCurrentStageID))
+}
+
+// CurrentStageIDEqualFold applies the EqualFold predicate on the "current_stage_id" field.
+func CurrentStageIDEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldCurrentStageID, v))
+}
+
+// CurrentStageIDContainsFold applies the ContainsFold predicate on the "current_stage_id" field.
+func CurrentStageIDContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldCurrentStageID, v))
+}
+
+// PodIDEQ applies the EQ predicate on the "pod_id" field.
+func PodIDEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldPodID, v))
+}
+
+// PodIDNEQ applies the NEQ predicate on the "pod_id" field.
+func PodIDNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldPodID, v))
+}
+
+// PodIDIn applies the In predicate on the "pod_id" field.
+func PodIDIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldPodID, vs...))
+}
+
+// PodIDNotIn applies the NotIn predicate on the "pod_id" field.
+func PodIDNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldPodID, vs...))
+}
+
+// PodIDGT applies the GT predicate on the "pod_id" field.
+func PodIDGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldPodID, v))
+}
+
+// PodIDGTE applies the GTE predicate on the "pod_id" field.
+func PodIDGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldPodID, v))
+}
+
+// PodIDLT applies the LT predicate on the "pod_id" field.
+func PodIDLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldPodID, v))
+}
+
+// PodIDLTE applies the LTE predicate on the "pod_id" field.
+func PodIDLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldPodID, v))
+}
+
+// PodIDContains applies the Contains predicate on the "pod_id" field.
+func PodIDContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldPodID, v))
+}
+
+// PodIDHasPrefix applies the HasPrefix predicate on the "pod_id" field.
+func PodIDHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldPodID, v))
+}
+
+// PodIDHasSuffix applies the HasSuffix predicate on the "pod_id" field.
+func PodIDHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldPodID, v))
+}
+
+// PodIDIsNil applies the IsNil predicate on the "pod_id" field.
+func PodIDIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldPodID))
+}
+
+// PodIDNotNil applies the NotNil predicate on the "pod_id" field.
+func PodIDNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldPodID))
+}
+
+// PodIDEqualFold applies the EqualFold predicate on the "pod_id" field.
+func PodIDEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldPodID, v))
+}
+
+// PodIDContainsFold applies the ContainsFold predicate on the "pod_id" field.
+func PodIDContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldPodID, v))
+}
+
+// LastInteractionAtEQ applies the EQ predicate on the "last_interaction_at" field.
+func LastInteractionAtEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldLastInteractionAt, v))
+}
+
+// LastInteractionAtNEQ applies the NEQ predicate on the "last_interaction_at" field.
+func LastInteractionAtNEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldLastInteractionAt, v))
+}
+
+// LastInteractionAtIn applies the In predicate on the "last_interaction_at" field.
+func LastInteractionAtIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldLastInteractionAt, vs...))
+}
+
+// LastInteractionAtNotIn applies the NotIn predicate on the "last_interaction_at" field.
+func LastInteractionAtNotIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldLastInteractionAt, vs...))
+}
+
+// LastInteractionAtGT applies the GT predicate on the "last_interaction_at" field.
+func LastInteractionAtGT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldLastInteractionAt, v))
+}
+
+// LastInteractionAtGTE applies the GTE predicate on the "last_interaction_at" field.
+func LastInteractionAtGTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldLastInteractionAt, v))
+}
+
+// LastInteractionAtLT applies the LT predicate on the "last_interaction_at" field.
+func LastInteractionAtLT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldLastInteractionAt, v))
+}
+
+// LastInteractionAtLTE applies the LTE predicate on the "last_interaction_at" field.
+func LastInteractionAtLTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldLastInteractionAt, v))
+}
+
+// LastInteractionAtIsNil applies the IsNil predicate on the "last_interaction_at" field.
+func LastInteractionAtIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldLastInteractionAt))
+}
+
+// LastInteractionAtNotNil applies the NotNil predicate on the "last_interaction_at" field.
+func LastInteractionAtNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldLastInteractionAt))
+}
+
+// SlackMessageFingerprintEQ applies the EQ predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintNEQ applies the NEQ predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintIn applies the In predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldSlackMessageFingerprint, vs...))
+}
+
+// SlackMessageFingerprintNotIn applies the NotIn predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldSlackMessageFingerprint, vs...))
+}
+
+// SlackMessageFingerprintGT applies the GT predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintGTE applies the GTE predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintLT applies the LT predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintLTE applies the LTE predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintContains applies the Contains predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintHasPrefix applies the HasPrefix predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintHasSuffix applies the HasSuffix predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintIsNil applies the IsNil predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldSlackMessageFingerprint))
+}
+
+// SlackMessageFingerprintNotNil applies the NotNil predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldSlackMessageFingerprint))
+}
+
+// SlackMessageFingerprintEqualFold applies the EqualFold predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldSlackMessageFingerprint, v))
+}
+
+// SlackMessageFingerprintContainsFold applies the ContainsFold predicate on the "slack_message_fingerprint" field.
+func SlackMessageFingerprintContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldSlackMessageFingerprint, v))
+}
+
+// AlertKeyEQ applies the EQ predicate on the "alert_key" field.
+func AlertKeyEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAlertKey, v))
+}
+
+// AlertKeyNEQ applies the NEQ predicate on the "alert_key" field.
+func AlertKeyNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldAlertKey, v))
+}
+
+// AlertKeyIn applies the In predicate on the "alert_key" field.
+func AlertKeyIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldAlertKey, vs...))
+}
+
+// AlertKeyNotIn applies the NotIn predicate on the "alert_key" field.
+func AlertKeyNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldAlertKey, vs...))
+}
+
+// AlertKeyGT applies the GT predicate on the "alert_key" field.
+func AlertKeyGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldAlertKey, v))
+}
+
+// AlertKeyGTE applies the GTE predicate on the "alert_key" field.
+func AlertKeyGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldAlertKey, v))
+}
+
+// AlertKeyLT applies the LT predicate on the "alert_key" field.
+func AlertKeyLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldAlertKey, v))
+}
+
+// AlertKeyLTE applies the LTE predicate on the "alert_key" field.
+func AlertKeyLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldAlertKey, v))
+}
+
+// AlertKeyContains applies the Contains predicate on the "alert_key" field.
+func AlertKeyContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldAlertKey, v))
+}
+
+// AlertKeyHasPrefix applies the HasPrefix predicate on the "alert_key" field.
+func AlertKeyHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldAlertKey, v))
+}
+
+// AlertKeyHasSuffix applies the HasSuffix predicate on the "alert_key" field.
+func AlertKeyHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldAlertKey, v))
+}
+
+// AlertKeyIsNil applies the IsNil predicate on the "alert_key" field.
+func AlertKeyIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldAlertKey))
+}
+
+// AlertKeyNotNil applies the NotNil predicate on the "alert_key" field.
+func AlertKeyNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldAlertKey))
+}
+
+// AlertKeyEqualFold applies the EqualFold predicate on the "alert_key" field.
+func AlertKeyEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldAlertKey, v))
+}
+
+// AlertKeyContainsFold applies the ContainsFold predicate on the "alert_key" field.
+func AlertKeyContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldAlertKey, v))
+}
+
+// AlertResolvedAtEQ applies the EQ predicate on the "alert_resolved_at" field.
+func AlertResolvedAtEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAlertResolvedAt, v))
+}
+
+// AlertResolvedAtNEQ applies the NEQ predicate on the "alert_resolved_at" field.
+func AlertResolvedAtNEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldAlertResolvedAt, v))
+}
+
+// AlertResolvedAtIn applies the In predicate on the "alert_resolved_at" field.
+func AlertResolvedAtIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldAlertResolvedAt, vs...))
+}
+
+// AlertResolvedAtNotIn applies the NotIn predicate on the "alert_resolved_at" field.
+func AlertResolvedAtNotIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldAlertResolvedAt, vs...))
+}
+
+// AlertResolvedAtGT applies the GT predicate on the "alert_resolved_at" field.
+func AlertResolvedAtGT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldAlertResolvedAt, v))
+}
+
+// AlertResolvedAtGTE applies the GTE predicate on the "alert_resolved_at" field.
+func AlertResolvedAtGTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldAlertResolvedAt, v))
+}
+
+// AlertResolvedAtLT applies the LT predicate on the "alert_resolved_at" field.
+func AlertResolvedAtLT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldAlertResolvedAt, v))
+}
+
+// AlertResolvedAtLTE applies the LTE predicate on the "alert_resolved_at" field.
+func AlertResolvedAtLTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldAlertResolvedAt, v))
+}
+
+// AlertResolvedAtIsNil applies the IsNil predicate on the "alert_resolved_at" field.
+func AlertResolvedAtIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldAlertResolvedAt))
+}
+
+// AlertResolvedAtNotNil applies the NotNil predicate on the "alert_resolved_at" field.
+func AlertResolvedAtNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldAlertResolvedAt))
+}
+
+// AlertResolutionEQ applies the EQ predicate on the "alert_resolution" field.
+func AlertResolutionEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldAlertResolution, v))
+}
+
+// AlertResolutionNEQ applies the NEQ predicate on the "alert_resolution" field.
+func AlertResolutionNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldAlertResolution, v))
+}
+
+// AlertResolutionIn applies the In predicate on the "alert_resolution" field.
+func AlertResolutionIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldAlertResolution, vs...))
+}
+
+// AlertResolutionNotIn applies the NotIn predicate on the "alert_resolution" field.
+func AlertResolutionNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldAlertResolution, vs...))
+}
+
+// AlertResolutionGT applies the GT predicate on the "alert_resolution" field.
+func AlertResolutionGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldAlertResolution, v))
+}
+
+// AlertResolutionGTE applies the GTE predicate on the "alert_resolution" field.
+func AlertResolutionGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldAlertResolution, v))
+}
+
+// AlertResolutionLT applies the LT predicate on the "alert_resolution" field.
+func AlertResolutionLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldAlertResolution, v))
+}
+
+// AlertResolutionLTE applies the LTE predicate on the "alert_resolution" field.
+func AlertResolutionLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldAlertResolution, v))
+}
+
+// AlertResolutionContains applies the Contains predicate on the "alert_resolution" field.
+func AlertResolutionContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldAlertResolution, v))
+}
+
+// AlertResolutionHasPrefix applies the HasPrefix predicate on the "alert_resolution" field.
+func AlertResolutionHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldAlertResolution, v))
+}
+
+// AlertResolutionHasSuffix applies the HasSuffix predicate on the "alert_resolution" field.
+func AlertResolutionHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldAlertResolution, v))
+}
+
+// AlertResolutionIsNil applies the IsNil predicate on the "alert_resolution" field.
+func AlertResolutionIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldAlertResolution))
+}
+
+// AlertResolutionNotNil applies the NotNil predicate on the "alert_resolution" field.
+func AlertResolutionNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldAlertResolution))
+}
+
+// AlertResolutionEqualFold applies the EqualFold predicate on the "alert_resolution" field.
+func AlertResolutionEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldAlertResolution, v))
+}
+
+// AlertResolutionContainsFold applies the ContainsFold predicate on the "alert_resolution" field.
+func AlertResolutionContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldAlertResolution, v))
+}
+
+// CancelInitiatorEQ applies the EQ predicate on the "cancel_initiator" field.
+func CancelInitiatorEQ(v CancelInitiator) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCancelInitiator, v))
+}
+
+// CancelInitiatorNEQ applies the NEQ predicate on the "cancel_initiator" field.
+func CancelInitiatorNEQ(v CancelInitiator) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCancelInitiator, v))
+}
+
+// CancelInitiatorIn applies the In predicate on the "cancel_initiator" field.
+func CancelInitiatorIn(vs ...CancelInitiator) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCancelInitiator, vs...))
+}
+
+// CancelInitiatorNotIn applies the NotIn predicate on the "cancel_initiator" field.
+func CancelInitiatorNotIn(vs ...CancelInitiator) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCancelInitiator, vs...))
+}
+
+// CancelInitiatorIsNil applies the IsNil predicate on the "cancel_initiator" field.
+func CancelInitiatorIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCancelInitiator))
+}
+
+// CancelInitiatorNotNil applies the NotNil predicate on the "cancel_initiator" field.
+func CancelInitiatorNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCancelInitiator))
+}
+
+// CancelReasonEQ applies the EQ predicate on the "cancel_reason" field.
+func CancelReasonEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCancelReason, v))
+}
+
+// CancelReasonNEQ applies the NEQ predicate on the "cancel_reason" field.
+func CancelReasonNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCancelReason, v))
+}
+
+// CancelReasonIn applies the In predicate on the "cancel_reason" field.
+func CancelReasonIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonNotIn applies the NotIn predicate on the "cancel_reason" field.
+func CancelReasonNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCancelReason, vs...))
+}
+
+// CancelReasonGT applies the GT predicate on the "cancel_reason" field.
+func CancelReasonGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCancelReason, v))
+}
+
+// CancelReasonGTE applies the GTE predicate on the "cancel_reason" field.
+func CancelReasonGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCancelReason, v))
+}
+
+// CancelReasonLT applies the LT predicate on the "cancel_reason" field.
+func CancelReasonLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCancelReason, v))
+}
+
+// CancelReasonLTE applies the LTE predicate on the "cancel_reason" field.
+func CancelReasonLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCancelReason, v))
+}
+
+// CancelReasonContains applies the Contains predicate on the "cancel_reason" field.
+func CancelReasonContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldCancelReason, v))
+}
+
+// CancelReasonHasPrefix applies the HasPrefix predicate on the "cancel_reason" field.
+func CancelReasonHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldCancelReason, v))
+}
+
+// CancelReasonHasSuffix applies the HasSuffix predicate on the "cancel_reason" field.
+func CancelReasonHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldCancelReason, v))
+}
+
+// CancelReasonIsNil applies the IsNil predicate on the "cancel_reason" field.
+func CancelReasonIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCancelReason))
+}
+
+// CancelReasonNotNil applies the NotNil predicate on the "cancel_reason" field.
+func CancelReasonNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCancelReason))
+}
+
+// CancelReasonEqualFold applies the EqualFold predicate on the "cancel_reason" field.
+func CancelReasonEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldCancelReason, v))
+}
+
+// CancelReasonContainsFold applies the ContainsFold predicate on the "cancel_reason" field.
+func CancelReasonContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldCancelReason, v))
+}
+
+// CancelledByEQ applies the EQ predicate on the "cancelled_by" field.
+func CancelledByEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCancelledBy, v))
+}
+
+// CancelledByNEQ applies the NEQ predicate on the "cancelled_by" field.
+func CancelledByNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCancelledBy, v))
+}
+
+// CancelledByIn applies the In predicate on the "cancelled_by" field.
+func CancelledByIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCancelledBy, vs...))
+}
+
+// CancelledByNotIn applies the NotIn predicate on the "cancelled_by" field.
+func CancelledByNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCancelledBy, vs...))
+}
+
+// CancelledByGT applies the GT predicate on the "cancelled_by" field.
+func CancelledByGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCancelledBy, v))
+}
+
+// CancelledByGTE applies the GTE predicate on the "cancelled_by" field.
+func CancelledByGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCancelledBy, v))
+}
+
+// CancelledByLT applies the LT predicate on the "cancelled_by" field.
+func CancelledByLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCancelledBy, v))
+}
+
+// CancelledByLTE applies the LTE predicate on the "cancelled_by" field.
+func CancelledByLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCancelledBy, v))
+}
+
+// CancelledByContains applies the Contains predicate on the "cancelled_by" field.
+func CancelledByContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldCancelledBy, v))
+}
+
+// CancelledByHasPrefix applies the HasPrefix predicate on the "cancelled_by" field.
+func CancelledByHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldCancelledBy, v))
+}
+
+// CancelledByHasSuffix applies the HasSuffix predicate on the "cancelled_by" field.
+func CancelledByHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldCancelledBy, v))
+}
+
+// CancelledByIsNil applies the IsNil predicate on the "cancelled_by" field.
+func CancelledByIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCancelledBy))
+}
+
+// CancelledByNotNil applies the NotNil predicate on the "cancelled_by" field.
+func CancelledByNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCancelledBy))
+}
+
+// CancelledByEqualFold applies the EqualFold predicate on the "cancelled_by" field.
+func CancelledByEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldCancelledBy, v))
+}
+
+// CancelledByContainsFold applies the ContainsFold predicate on the "cancelled_by" field.
+func CancelledByContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldCancelledBy, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// DegradedReasonEQ applies the EQ predicate on the "degraded_reason" field.
+func DegradedReasonEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldDegradedReason, v))
+}
+
+// DegradedReasonNEQ applies the NEQ predicate on the "degraded_reason" field.
+func DegradedReasonNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldDegradedReason, v))
+}
+
+// DegradedReasonIn applies the In predicate on the "degraded_reason" field.
+func DegradedReasonIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldDegradedReason, vs...))
+}
+
+// DegradedReasonNotIn applies the NotIn predicate on the "degraded_reason" field.
+func DegradedReasonNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldDegradedReason, vs...))
+}
+
+// DegradedReasonGT applies the GT predicate on the "degraded_reason" field.
+func DegradedReasonGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldDegradedReason, v))
+}
+
+// DegradedReasonGTE applies the GTE predicate on the "degraded_reason" field.
+func DegradedReasonGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldDegradedReason, v))
+}
+
+// DegradedReasonLT applies the LT predicate on the "degraded_reason" field.
+func DegradedReasonLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldDegradedReason, v))
+}
+
+// DegradedReasonLTE applies the LTE predicate on the "degraded_reason" field.
+func DegradedReasonLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldDegradedReason, v))
+}
+
+// DegradedReasonContains applies the Contains predicate on the "degraded_reason" field.
+func DegradedReasonContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldDegradedReason, v))
+}
+
+// DegradedReasonHasPrefix applies the HasPrefix predicate on the "degraded_reason" field.
+func DegradedReasonHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldDegradedReason, v))
+}
+
+// DegradedReasonHasSuffix applies the HasSuffix predicate on the "degraded_reason" field.
+func DegradedReasonHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldDegradedReason, v))
+}
+
+// DegradedReasonIsNil applies the IsNil predicate on the "degraded_reason" field.
+func DegradedReasonIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldDegradedReason))
+}
+
+// DegradedReasonNotNil applies the NotNil predicate on the "degraded_reason" field.
+func DegradedReasonNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldDegradedReason))
+}
+
+// DegradedReasonEqualFold applies the EqualFold predicate on the "degraded_reason" field.
+func DegradedReasonEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldDegradedReason, v))
+}
+
+// DegradedReasonContainsFold applies the ContainsFold predicate on the "degraded_reason" field.
+func DegradedReasonContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldDegradedReason, v))
+}
+
+// ModelRoutingIsNil applies the IsNil predicate on the "model_routing" field.
+func ModelRoutingIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldModelRouting))
+}
+
+// ModelRoutingNotNil applies the NotNil predicate on the "model_routing" field.
+func ModelRoutingNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldModelRouting))
+}
+
+// NoiseTriageIsNil applies the IsNil predicate on the "noise_triage" field.
+func NoiseTriageIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldNoiseTriage))
+}
+
+// NoiseTriageNotNil applies the NotNil predicate on the "noise_triage" field.
+func NoiseTriageNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldNoiseTriage))
+}
+
+// ForceFullInvestigationEQ applies the EQ predicate on the "force_full_investigation" field.
+func ForceFullInvestigationEQ(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldForceFullInvestigation, v))
+}
+
+// ForceFullInvestigationNEQ applies the NEQ predicate on the "force_full_investigation" field.
+func ForceFullInvestigationNEQ(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldForceFullInvestigation, v))
+}
+
+// FederationOriginIsNil applies the IsNil predicate on the "federation_origin" field.
+func FederationOriginIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldFederationOrigin))
+}
+
+// FederationOriginNotNil applies the NotNil predicate on the "federation_origin" field.
+func FederationOriginNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldFederationOrigin))
+}
+
+// TargetIsNil applies the IsNil predicate on the "target" field.
+func TargetIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldTarget))
+}
+
+// TargetNotNil applies the NotNil predicate on the "target" field.
+func TargetNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldTarget))
+}
+
+// OnCallIsNil applies the IsNil predicate on the "on_call" field.
+func OnCallIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldOnCall))
+}
+
+// OnCallNotNil applies the NotNil predicate on the "on_call" field.
+func OnCallNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldOnCall))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldGroupID, v))
 }
 
-// ChainIDHasPrefix applies the HasPrefix predicate on the "chain_id" field.
-func ChainIDHasPrefix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasPrefix(FieldChainID, v))
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldGroupID))
 }
 
-// ChainIDHasSuffix applies the HasSuffix predicate on the "chain_id" field.
-func ChainIDHasSuffix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasSuffix(FieldChainID, v))
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldGroupID))
 }
 
-// ChainIDEqualFold applies the EqualFold predicate on the "chain_id" field.
-func ChainIDEqualFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEqualFold(FieldChainID, v))
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldGroupID, v))
 }
 
-// ChainIDContainsFold applies the ContainsFold predicate on the "chain_id" field.
-func ChainIDContainsFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContainsFold(FieldChainID, v))
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldGroupID, v))
 }
 
-// CurrentStageIndexEQ applies the EQ predicate on the "current_stage_index" field.
-func CurrentStageIndexEQ(v int) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldCurrentStageIndex, v))
+// DuplicatedFromEQ applies the EQ predicate on the "duplicated_from" field.
+func DuplicatedFromEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIndexNEQ applies the NEQ predicate on the "current_stage_index" field.
-func CurrentStageIndexNEQ(v int) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldCurrentStageIndex, v))
+// DuplicatedFromNEQ applies the NEQ predicate on the "duplicated_from" field.
+func DuplicatedFromNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIndexIn applies the In predicate on the "current_stage_index" field.
-func CurrentStageIndexIn(vs ...int) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldCurrentStageIndex, vs...))
+// DuplicatedFromIn applies the In predicate on the "duplicated_from" field.
+func DuplicatedFromIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldDuplicatedFrom, vs...))
 }
 
-// CurrentStageIndexNotIn applies the NotIn predicate on the "current_stage_index" field.
-func CurrentStageIndexNotIn(vs ...int) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldCurrentStageIndex, vs...))
+// DuplicatedFromNotIn applies the NotIn predicate on the "duplicated_from" field.
+func DuplicatedFromNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldDuplicatedFrom, vs...))
 }
 
-// CurrentStageIndexGT applies the GT predicate on the "current_stage_index" field.
-func CurrentStageIndexGT(v int) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldCurrentStageIndex, v))
+// DuplicatedFromGT applies the GT predicate on the "duplicated_from" field.
+func DuplicatedFromGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIndexGTE applies the GTE predicate on the "current_stage_index" field.
-func CurrentStageIndexGTE(v int) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldCurrentStageIndex, v))
+// DuplicatedFromGTE applies the GTE predicate on the "duplicated_from" field.
+func DuplicatedFromGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIndexLT applies the LT predicate on the "current_stage_index" field.
-func CurrentStageIndexLT(v int) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldCurrentStageIndex, v))
+// DuplicatedFromLT applies the LT predicate on the "duplicated_from" field.
+func DuplicatedFromLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIndexLTE applies the LTE predicate on the "current_stage_index" field.
-func CurrentStageIndexLTE(v int) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLTE(FieldCurrentStageIndex, v))
+// DuplicatedFromLTE applies the LTE predicate on the "duplicated_from" field.
+func DuplicatedFromLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIndexIsNil applies the IsNil predicate on the "current_stage_index" field.
-func CurrentStageIndexIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldCurrentStageIndex))
+// DuplicatedFromContains applies the Contains predicate on the "duplicated_from" field.
+func DuplicatedFromContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIndexNotNil applies the NotNil predicate on the "current_stage_index" field.
-func CurrentStageIndexNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldCurrentStageIndex))
+// DuplicatedFromHasPrefix applies the HasPrefix predicate on the "duplicated_from" field.
+func DuplicatedFromHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIDEQ applies the EQ predicate on the "current_stage_id" field.
-func CurrentStageIDEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldCurrentStageID, v))
+// DuplicatedFromHasSuffix applies the HasSuffix predicate on the "duplicated_from" field.
+func DuplicatedFromHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIDNEQ applies the NEQ predicate on the "current_stage_id" field.
-func CurrentStageIDNEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldCurrentStageID, v))
+// DuplicatedFromIsNil applies the IsNil predicate on the "duplicated_from" field.
+func DuplicatedFromIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldDuplicatedFrom))
 }
 
-// CurrentStageIDIn applies the In predicate on the "current_stage_id" field.
-func CurrentStageIDIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldCurrentStageID, vs...))
+// DuplicatedFromNotNil applies the NotNil predicate on the "duplicated_from" field.
+func DuplicatedFromNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldDuplicatedFrom))
 }
 
-// CurrentStageIDNotIn applies the NotIn predicate on the "current_stage_id" field.
-func CurrentStageIDNotIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldCurrentStageID, vs...))
+// DuplicatedFromEqualFold applies the EqualFold predicate on the "duplicated_from" field.
+func DuplicatedFromEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIDGT applies the GT predicate on the "current_stage_id" field.
-func CurrentStageIDGT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldCurrentStageID, v))
+// DuplicatedFromContainsFold applies the ContainsFold predicate on the "duplicated_from" field.
+func DuplicatedFromContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldDuplicatedFrom, v))
 }
 
-// CurrentStageIDGTE applies the GTE predicate on the "current_stage_id" field.
-func CurrentStageIDGTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldCurrentStageID, v))
+// LlmProviderEQ applies the EQ predicate on the "llm_provider" field.
+func LlmProviderEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldLlmProvider, v))
 }
 
-// CurrentStageIDLT applies the LT predicate on the "current_stage_id" field.
-func CurrentStageIDLT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldCurrentStageID, v))
+// LlmProviderNEQ applies the NEQ predicate on the "llm_provider" field.
+func LlmProviderNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldLlmProvider, v))
 }
 
-// CurrentStageIDLTE applies the LTE predicate on the "current_stage_id" field.
-func CurrentStageIDLTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLTE(FieldCurrentStageID, v))
+// LlmProviderIn applies the In predicate on the "llm_provider" field.
+func LlmProviderIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldLlmProvider, vs...))
 }
 
-// CurrentStageIDContains applies the Contains predicate on the "current_stage_id" field.
-func CurrentStageIDContains(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContains(FieldCurrentStageID, v))
+// LlmProviderNotIn applies the NotIn predicate on the "llm_provider" field.
+func LlmProviderNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldLlmProvider, vs...))
 }
 
-// CurrentStageIDHasPrefix applies the HasPrefix predicate on the "current_stage_id" field.
-func CurrentStageIDHasPrefix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasPrefix(FieldCurrentStageID, v))
+// LlmProviderGT applies the GT predicate on the "llm_provider" field.
+func LlmProviderGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldLlmProvider, v))
 }
 
-// CurrentStageIDHasSuffix applies the HasSuffix predicate on the "current_stage_id" field.
-func CurrentStageIDHasSuffix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasSuffix(FieldCurrentStageID, v))
+// LlmProviderGTE applies the GTE predicate on the "llm_provider" field.
+func LlmProviderGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldLlmProvider, v))
 }
 
-// CurrentStageIDIsNil applies the IsNil predicate on the "current_stage_id" field.
-func CurrentStageIDIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldCurrentStageID))
+// LlmProviderLT applies the LT predicate on the "llm_provider" field.
+func LlmProviderLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldLlmProvider, v))
 }
 
-// CurrentStageIDNotNil applies the NotNil predicate on the "current_stage_id" field.
-func CurrentStageIDNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldCurrentStageID))
+// LlmProviderLTE applies the LTE predicate on the "llm_provider" field.
+func LlmProviderLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldLlmProvider, v))
 }
 
-// CurrentStageIDEqualFold applies the EqualFold predicate on the "current_stage_id" field.
-func CurrentStageIDEqualFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEqualFold(FieldCurrentStageID, v))
+// LlmProviderContains applies the Contains predicate on the "llm_provider" field.
+func LlmProviderContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldLlmProvider, v))
 }
 
-// CurrentStageIDContainsFold applies the ContainsFold predicate on the "current_stage_id" field.
-func CurrentStageIDContainsFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContainsFold(FieldCurrentStageID, v))
+// LlmProviderHasPrefix applies the HasPrefix predicate on the "llm_provider" field.
+func LlmProviderHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldLlmProvider, v))
 }
 
-// PodIDEQ applies the EQ predicate on the "pod_id" field.
-func PodIDEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldPodID, v))
+// LlmProviderHasSuffix applies the HasSuffix predicate on the "llm_provider" field.
+func LlmProviderHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldLlmProvider, v))
 }
 
-// PodIDNEQ applies the NEQ predicate on the "pod_id" field.
-func PodIDNEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldPodID, v))
+// LlmProviderIsNil applies the IsNil predicate on the "llm_provider" field.
+func LlmProviderIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldLlmProvider))
 }
 
-// PodIDIn applies the In predicate on the "pod_id" field.
-func PodIDIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldPodID, vs...))
+// LlmProviderNotNil applies the NotNil predicate on the "llm_provider" field.
+func LlmProviderNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldLlmProvider))
 }
 
-// PodIDNotIn applies the NotIn predicate on the "pod_id" field.
-func PodIDNotIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldPodID, vs...))
+// LlmProviderEqualFold applies the EqualFold predicate on the "llm_provider" field.
+func LlmProviderEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldLlmProvider, v))
 }
 
-// PodIDGT applies the GT predicate on the "pod_id" field.
-func PodIDGT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldPodID, v))
+// LlmProviderContainsFold applies the ContainsFold predicate on the "llm_provider" field.
+func LlmProviderContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldLlmProvider, v))
 }
 
-// PodIDGTE applies the GTE predicate on the "pod_id" field.
-func PodIDGTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldPodID, v))
+// SandboxEQ applies the EQ predicate on the "sandbox" field.
+func SandboxEQ(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldSandbox, v))
 }
 
-// PodIDLT applies the LT predicate on the "pod_id" field.
-func PodIDLT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldPodID, v))
+// SandboxNEQ applies the NEQ predicate on the "sandbox" field.
+func SandboxNEQ(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldSandbox, v))
 }
 
-// PodIDLTE applies the LTE predicate on the "pod_id" field.
-func PodIDLTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLTE(FieldPodID, v))
+// FallbackChainEQ applies the EQ predicate on the "fallback_chain" field.
+func FallbackChainEQ(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldFallbackChain, v))
 }
 
-// PodIDContains applies the Contains predicate on the "pod_id" field.
-func PodIDContains(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContains(FieldPodID, v))
+// FallbackChainNEQ applies the NEQ predicate on the "fallback_chain" field.
+func FallbackChainNEQ(v bool) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldFallbackChain, v))
 }
 
-// PodIDHasPrefix applies the HasPrefix predicate on the "pod_id" field.
-func PodIDHasPrefix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasPrefix(FieldPodID, v))
+// CallbackURLEQ applies the EQ predicate on the "callback_url" field.
+func CallbackURLEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackURL, v))
 }
 
-// PodIDHasSuffix applies the HasSuffix predicate on the "pod_id" field.
-func PodIDHasSuffix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasSuffix(FieldPodID, v))
+// CallbackURLNEQ applies the NEQ predicate on the "callback_url" field.
+func CallbackURLNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCallbackURL, v))
 }
 
-// PodIDIsNil applies the IsNil predicate on the "pod_id" field.
-func PodIDIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldPodID))
+// CallbackURLIn applies the In predicate on the "callback_url" field.
+func CallbackURLIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCallbackURL, vs...))
 }
 
-// PodIDNotNil applies the NotNil predicate on the "pod_id" field.
-func PodIDNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldPodID))
+// CallbackURLNotIn applies the NotIn predicate on the "callback_url" field.
+func CallbackURLNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCallbackURL, vs...))
 }
 
-// PodIDEqualFold applies the EqualFold predicate on the "pod_id" field.
-func PodIDEqualFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEqualFold(FieldPodID, v))
+// CallbackURLGT applies the GT predicate on the "callback_url" field.
+func CallbackURLGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCallbackURL, v))
 }
 
-// PodIDContainsFold applies the ContainsFold predicate on the "pod_id" field.
-func PodIDContainsFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContainsFold(FieldPodID, v))
+// CallbackURLGTE applies the GTE predicate on the "callback_url" field.
+func CallbackURLGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCallbackURL, v))
 }
 
-// LastInteractionAtEQ applies the EQ predicate on the "last_interaction_at" field.
-func LastInteractionAtEQ(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldLastInteractionAt, v))
+// CallbackURLLT applies the LT predicate on the "callback_url" field.
+func CallbackURLLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCallbackURL, v))
 }
 
-// LastInteractionAtNEQ applies the NEQ predicate on the "last_interaction_at" field.
-func LastInteractionAtNEQ(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldLastInteractionAt, v))
+// CallbackURLLTE applies the LTE predicate on the "callback_url" field.
+func CallbackURLLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCallbackURL, v))
 }
 
-// LastInteractionAtIn applies the In predicate on the "last_interaction_at" field.
-func LastInteractionAtIn(vs ...time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldLastInteractionAt, vs...))
+// CallbackURLContains applies the Contains predicate on the "callback_url" field.
+func CallbackURLContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldCallbackURL, v))
 }
 
-// LastInteractionAtNotIn applies the NotIn predicate on the "last_interaction_at" field.
-func LastInteractionAtNotIn(vs ...time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldLastInteractionAt, vs...))
+// CallbackURLHasPrefix applies the HasPrefix predicate on the "callback_url" field.
+func CallbackURLHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldCallbackURL, v))
 }
 
-// LastInteractionAtGT applies the GT predicate on the "last_interaction_at" field.
-func LastInteractionAtGT(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldLastInteractionAt, v))
+// CallbackURLHasSuffix applies the HasSuffix predicate on the "callback_url" field.
+func CallbackURLHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldCallbackURL, v))
 }
 
-// LastInteractionAtGTE applies the GTE predicate on the "last_interaction_at" field.
-func LastInteractionAtGTE(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldLastInteractionAt, v))
+// CallbackURLIsNil applies the IsNil predicate on the "callback_url" field.
+func CallbackURLIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCallbackURL))
 }
 
-// LastInteractionAtLT applies the LT predicate on the "last_interaction_at" field.
-func LastInteractionAtLT(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldLastInteractionAt, v))
+// CallbackURLNotNil applies the NotNil predicate on the "callback_url" field.
+func CallbackURLNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCallbackURL))
 }
 
-// LastInteractionAtLTE applies the LTE predicate on the "last_interaction_at" field.
-func LastInteractionAtLTE(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLTE(FieldLastInteractionAt, v))
+// CallbackURLEqualFold applies the EqualFold predicate on the "callback_url" field.
+func CallbackURLEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldCallbackURL, v))
 }
 
-// LastInteractionAtIsNil applies the IsNil predicate on the "last_interaction_at" field.
-func LastInteractionAtIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldLastInteractionAt))
+// CallbackURLContainsFold applies the ContainsFold predicate on the "callback_url" field.
+func CallbackURLContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldCallbackURL, v))
 }
 
-// LastInteractionAtNotNil applies the NotNil predicate on the "last_interaction_at" field.
-func LastInteractionAtNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldLastInteractionAt))
+// CallbackSecretEQ applies the EQ predicate on the "callback_secret" field.
+func CallbackSecretEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintEQ applies the EQ predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldSlackMessageFingerprint, v))
+// CallbackSecretNEQ applies the NEQ predicate on the "callback_secret" field.
+func CallbackSecretNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintNEQ applies the NEQ predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintNEQ(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldSlackMessageFingerprint, v))
+// CallbackSecretIn applies the In predicate on the "callback_secret" field.
+func CallbackSecretIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCallbackSecret, vs...))
 }
 
-// SlackMessageFingerprintIn applies the In predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldSlackMessageFingerprint, vs...))
+// CallbackSecretNotIn applies the NotIn predicate on the "callback_secret" field.
+func CallbackSecretNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCallbackSecret, vs...))
 }
 
-// SlackMessageFingerprintNotIn applies the NotIn predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintNotIn(vs ...string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldSlackMessageFingerprint, vs...))
+// CallbackSecretGT applies the GT predicate on the "callback_secret" field.
+func CallbackSecretGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintGT applies the GT predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintGT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldSlackMessageFingerprint, v))
+// CallbackSecretGTE applies the GTE predicate on the "callback_secret" field.
+func CallbackSecretGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintGTE applies the GTE predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintGTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldSlackMessageFingerprint, v))
+// CallbackSecretLT applies the LT predicate on the "callback_secret" field.
+func CallbackSecretLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintLT applies the LT predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintLT(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldSlackMessageFingerprint, v))
+// CallbackSecretLTE applies the LTE predicate on the "callback_secret" field.
+func CallbackSecretLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintLTE applies the LTE predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintLTE(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLTE(FieldSlackMessageFingerprint, v))
+// CallbackSecretContains applies the Contains predicate on the "callback_secret" field.
+func CallbackSecretContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintContains applies the Contains predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintContains(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContains(FieldSlackMessageFingerprint, v))
+// CallbackSecretHasPrefix applies the HasPrefix predicate on the "callback_secret" field.
+func CallbackSecretHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintHasPrefix applies the HasPrefix predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintHasPrefix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasPrefix(FieldSlackMessageFingerprint, v))
+// CallbackSecretHasSuffix applies the HasSuffix predicate on the "callback_secret" field.
+func CallbackSecretHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldCallbackSecret, v))
 }
 
-// SlackMessageFingerprintHasSuffix applies the HasSuffix predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintHasSuffix(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldHasSuffix(FieldSlackMessageFingerprint, v))
+// CallbackSecretIsNil applies the IsNil predicate on the "callback_secret" field.
+func CallbackSecretIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCallbackSecret))
 }
 
-// SlackMessageFingerprintIsNil applies the IsNil predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldSlackMessageFingerprint))
+// CallbackSecretNotNil applies the NotNil predicate on the "callback_secret" field.
+func CallbackSecretNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCallbackSecret))
+}
+
+// CallbackSecretEqualFold applies the EqualFold predicate on the "callback_secret" field.
+func CallbackSecretEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldCallbackSecret, v))
+}
+
+// CallbackSecretContainsFold applies the ContainsFold predicate on the "callback_secret" field.
+func CallbackSecretContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldCallbackSecret, v))
+}
+
+// CallbackStatusEQ applies the EQ predicate on the "callback_status" field.
+func CallbackStatusEQ(v CallbackStatus) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackStatus, v))
+}
+
+// CallbackStatusNEQ applies the NEQ predicate on the "callback_status" field.
+func CallbackStatusNEQ(v CallbackStatus) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCallbackStatus, v))
+}
+
+// CallbackStatusIn applies the In predicate on the "callback_status" field.
+func CallbackStatusIn(vs ...CallbackStatus) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCallbackStatus, vs...))
+}
+
+// CallbackStatusNotIn applies the NotIn predicate on the "callback_status" field.
+func CallbackStatusNotIn(vs ...CallbackStatus) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCallbackStatus, vs...))
+}
+
+// CallbackStatusIsNil applies the IsNil predicate on the "callback_status" field.
+func CallbackStatusIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCallbackStatus))
+}
+
+// CallbackStatusNotNil applies the NotNil predicate on the "callback_status" field.
+func CallbackStatusNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCallbackStatus))
+}
+
+// CallbackAttemptsEQ applies the EQ predicate on the "callback_attempts" field.
+func CallbackAttemptsEQ(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackAttempts, v))
+}
+
+// CallbackAttemptsNEQ applies the NEQ predicate on the "callback_attempts" field.
+func CallbackAttemptsNEQ(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCallbackAttempts, v))
+}
+
+// CallbackAttemptsIn applies the In predicate on the "callback_attempts" field.
+func CallbackAttemptsIn(vs ...int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCallbackAttempts, vs...))
+}
+
+// CallbackAttemptsNotIn applies the NotIn predicate on the "callback_attempts" field.
+func CallbackAttemptsNotIn(vs ...int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCallbackAttempts, vs...))
+}
+
+// CallbackAttemptsGT applies the GT predicate on the "callback_attempts" field.
+func CallbackAttemptsGT(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCallbackAttempts, v))
+}
+
+// CallbackAttemptsGTE applies the GTE predicate on the "callback_attempts" field.
+func CallbackAttemptsGTE(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCallbackAttempts, v))
+}
+
+// CallbackAttemptsLT applies the LT predicate on the "callback_attempts" field.
+func CallbackAttemptsLT(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCallbackAttempts, v))
+}
+
+// CallbackAttemptsLTE applies the LTE predicate on the "callback_attempts" field.
+func CallbackAttemptsLTE(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCallbackAttempts, v))
+}
+
+// CallbackLastErrorEQ applies the EQ predicate on the "callback_last_error" field.
+func CallbackLastErrorEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackLastError, v))
+}
+
+// CallbackLastErrorNEQ applies the NEQ predicate on the "callback_last_error" field.
+func CallbackLastErrorNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCallbackLastError, v))
+}
+
+// CallbackLastErrorIn applies the In predicate on the "callback_last_error" field.
+func CallbackLastErrorIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCallbackLastError, vs...))
+}
+
+// CallbackLastErrorNotIn applies the NotIn predicate on the "callback_last_error" field.
+func CallbackLastErrorNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCallbackLastError, vs...))
+}
+
+// CallbackLastErrorGT applies the GT predicate on the "callback_last_error" field.
+func CallbackLastErrorGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCallbackLastError, v))
+}
+
+// CallbackLastErrorGTE applies the GTE predicate on the "callback_last_error" field.
+func CallbackLastErrorGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCallbackLastError, v))
+}
+
+// CallbackLastErrorLT applies the LT predicate on the "callback_last_error" field.
+func CallbackLastErrorLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCallbackLastError, v))
+}
+
+// CallbackLastErrorLTE applies the LTE predicate on the "callback_last_error" field.
+func CallbackLastErrorLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCallbackLastError, v))
+}
+
+// CallbackLastErrorContains applies the Contains predicate on the "callback_last_error" field.
+func CallbackLastErrorContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldCallbackLastError, v))
+}
+
+// CallbackLastErrorHasPrefix applies the HasPrefix predicate on the "callback_last_error" field.
+func CallbackLastErrorHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldCallbackLastError, v))
+}
+
+// CallbackLastErrorHasSuffix applies the HasSuffix predicate on the "callback_last_error" field.
+func CallbackLastErrorHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldCallbackLastError, v))
 }
 
-// SlackMessageFingerprintNotNil applies the NotNil predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldSlackMessageFingerprint))
+// CallbackLastErrorIsNil applies the IsNil predicate on the "callback_last_error" field.
+func CallbackLastErrorIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCallbackLastError))
 }
 
-// SlackMessageFingerprintEqualFold applies the EqualFold predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintEqualFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEqualFold(FieldSlackMessageFingerprint, v))
+// CallbackLastErrorNotNil applies the NotNil predicate on the "callback_last_error" field.
+func CallbackLastErrorNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCallbackLastError))
 }
 
-// SlackMessageFingerprintContainsFold applies the ContainsFold predicate on the "slack_message_fingerprint" field.
-func SlackMessageFingerprintContainsFold(v string) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldContainsFold(FieldSlackMessageFingerprint, v))
+// CallbackLastErrorEqualFold applies the EqualFold predicate on the "callback_last_error" field.
+func CallbackLastErrorEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldCallbackLastError, v))
 }
 
-// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
-func DeletedAtEQ(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldEQ(FieldDeletedAt, v))
+// CallbackLastErrorContainsFold applies the ContainsFold predicate on the "callback_last_error" field.
+func CallbackLastErrorContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldCallbackLastError, v))
 }
 
-// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
-func DeletedAtNEQ(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNEQ(FieldDeletedAt, v))
+// CallbackDeliveredAtEQ applies the EQ predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldCallbackDeliveredAt, v))
 }
 
-// DeletedAtIn applies the In predicate on the "deleted_at" field.
-func DeletedAtIn(vs ...time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIn(FieldDeletedAt, vs...))
+// CallbackDeliveredAtNEQ applies the NEQ predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtNEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldCallbackDeliveredAt, v))
 }
 
-// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
-func DeletedAtNotIn(vs ...time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotIn(FieldDeletedAt, vs...))
+// CallbackDeliveredAtIn applies the In predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldCallbackDeliveredAt, vs...))
 }
 
-// DeletedAtGT applies the GT predicate on the "deleted_at" field.
-func DeletedAtGT(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGT(FieldDeletedAt, v))
+// CallbackDeliveredAtNotIn applies the NotIn predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtNotIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldCallbackDeliveredAt, vs...))
 }
 
-// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
-func DeletedAtGTE(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldGTE(FieldDeletedAt, v))
+// CallbackDeliveredAtGT applies the GT predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtGT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldCallbackDeliveredAt, v))
 }
 
-// DeletedAtLT applies the LT predicate on the "deleted_at" field.
-func DeletedAtLT(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLT(FieldDeletedAt, v))
+// CallbackDeliveredAtGTE applies the GTE predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtGTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldCallbackDeliveredAt, v))
 }
 
-// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
-func DeletedAtLTE(v time.Time) predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldLTE(FieldDeletedAt, v))
+// CallbackDeliveredAtLT applies the LT predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtLT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldCallbackDeliveredAt, v))
 }
 
-// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
-func DeletedAtIsNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldIsNull(FieldDeletedAt))
+// CallbackDeliveredAtLTE applies the LTE predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtLTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldCallbackDeliveredAt, v))
 }
 
-// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
-func DeletedAtNotNil() predicate.AlertSession {
-	return predicate.AlertSession(sql.FieldNotNull(FieldDeletedAt))
+// CallbackDeliveredAtIsNil applies the IsNil predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldCallbackDeliveredAt))
+}
+
+// CallbackDeliveredAtNotNil applies the NotNil predicate on the "callback_delivered_at" field.
+func CallbackDeliveredAtNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldCallbackDeliveredAt))
 }
 
 // ReviewStatusEQ applies the EQ predicate on the "review_status" field.
@@ -1845,6 +3850,246 @@ func InvestigationFeedbackContainsFold(v string) predicate.AlertSession {
 	return predicate.AlertSession(sql.FieldContainsFold(FieldInvestigationFeedback, v))
 }
 
+// HandoffNotesEQ applies the EQ predicate on the "handoff_notes" field.
+func HandoffNotesEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldHandoffNotes, v))
+}
+
+// HandoffNotesNEQ applies the NEQ predicate on the "handoff_notes" field.
+func HandoffNotesNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldHandoffNotes, v))
+}
+
+// HandoffNotesIn applies the In predicate on the "handoff_notes" field.
+func HandoffNotesIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldHandoffNotes, vs...))
+}
+
+// HandoffNotesNotIn applies the NotIn predicate on the "handoff_notes" field.
+func HandoffNotesNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldHandoffNotes, vs...))
+}
+
+// HandoffNotesGT applies the GT predicate on the "handoff_notes" field.
+func HandoffNotesGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldHandoffNotes, v))
+}
+
+// HandoffNotesGTE applies the GTE predicate on the "handoff_notes" field.
+func HandoffNotesGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldHandoffNotes, v))
+}
+
+// HandoffNotesLT applies the LT predicate on the "handoff_notes" field.
+func HandoffNotesLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldHandoffNotes, v))
+}
+
+// HandoffNotesLTE applies the LTE predicate on the "handoff_notes" field.
+func HandoffNotesLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldHandoffNotes, v))
+}
+
+// HandoffNotesContains applies the Contains predicate on the "handoff_notes" field.
+func HandoffNotesContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldHandoffNotes, v))
+}
+
+// HandoffNotesHasPrefix applies the HasPrefix predicate on the "handoff_notes" field.
+func HandoffNotesHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldHandoffNotes, v))
+}
+
+// HandoffNotesHasSuffix applies the HasSuffix predicate on the "handoff_notes" field.
+func HandoffNotesHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldHandoffNotes, v))
+}
+
+// HandoffNotesIsNil applies the IsNil predicate on the "handoff_notes" field.
+func HandoffNotesIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldHandoffNotes))
+}
+
+// HandoffNotesNotNil applies the NotNil predicate on the "handoff_notes" field.
+func HandoffNotesNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldHandoffNotes))
+}
+
+// HandoffNotesEqualFold applies the EqualFold predicate on the "handoff_notes" field.
+func HandoffNotesEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldHandoffNotes, v))
+}
+
+// HandoffNotesContainsFold applies the ContainsFold predicate on the "handoff_notes" field.
+func HandoffNotesContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldHandoffNotes, v))
+}
+
+// HandoffNotesRevisionEQ applies the EQ predicate on the "handoff_notes_revision" field.
+func HandoffNotesRevisionEQ(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldHandoffNotesRevision, v))
+}
+
+// HandoffNotesRevisionNEQ applies the NEQ predicate on the "handoff_notes_revision" field.
+func HandoffNotesRevisionNEQ(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldHandoffNotesRevision, v))
+}
+
+// HandoffNotesRevisionIn applies the In predicate on the "handoff_notes_revision" field.
+func HandoffNotesRevisionIn(vs ...int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldHandoffNotesRevision, vs...))
+}
+
+// HandoffNotesRevisionNotIn applies the NotIn predicate on the "handoff_notes_revision" field.
+func HandoffNotesRevisionNotIn(vs ...int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldHandoffNotesRevision, vs...))
+}
+
+// HandoffNotesRevisionGT applies the GT predicate on the "handoff_notes_revision" field.
+func HandoffNotesRevisionGT(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldHandoffNotesRevision, v))
+}
+
+// HandoffNotesRevisionGTE applies the GTE predicate on the "handoff_notes_revision" field.
+func HandoffNotesRevisionGTE(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldHandoffNotesRevision, v))
+}
+
+// HandoffNotesRevisionLT applies the LT predicate on the "handoff_notes_revision" field.
+func HandoffNotesRevisionLT(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldHandoffNotesRevision, v))
+}
+
+// HandoffNotesRevisionLTE applies the LTE predicate on the "handoff_notes_revision" field.
+func HandoffNotesRevisionLTE(v int) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldHandoffNotesRevision, v))
+}
+
+// HandoffNotesUpdatedByEQ applies the EQ predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByNEQ applies the NEQ predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByNEQ(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByIn applies the In predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldHandoffNotesUpdatedBy, vs...))
+}
+
+// HandoffNotesUpdatedByNotIn applies the NotIn predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByNotIn(vs ...string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldHandoffNotesUpdatedBy, vs...))
+}
+
+// HandoffNotesUpdatedByGT applies the GT predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByGT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByGTE applies the GTE predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByGTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByLT applies the LT predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByLT(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByLTE applies the LTE predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByLTE(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByContains applies the Contains predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByContains(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContains(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByHasPrefix applies the HasPrefix predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByHasPrefix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasPrefix(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByHasSuffix applies the HasSuffix predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByHasSuffix(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldHasSuffix(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByIsNil applies the IsNil predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldHandoffNotesUpdatedBy))
+}
+
+// HandoffNotesUpdatedByNotNil applies the NotNil predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldHandoffNotesUpdatedBy))
+}
+
+// HandoffNotesUpdatedByEqualFold applies the EqualFold predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByEqualFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEqualFold(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedByContainsFold applies the ContainsFold predicate on the "handoff_notes_updated_by" field.
+func HandoffNotesUpdatedByContainsFold(v string) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldContainsFold(FieldHandoffNotesUpdatedBy, v))
+}
+
+// HandoffNotesUpdatedAtEQ applies the EQ predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldEQ(FieldHandoffNotesUpdatedAt, v))
+}
+
+// HandoffNotesUpdatedAtNEQ applies the NEQ predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtNEQ(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNEQ(FieldHandoffNotesUpdatedAt, v))
+}
+
+// HandoffNotesUpdatedAtIn applies the In predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIn(FieldHandoffNotesUpdatedAt, vs...))
+}
+
+// HandoffNotesUpdatedAtNotIn applies the NotIn predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtNotIn(vs ...time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotIn(FieldHandoffNotesUpdatedAt, vs...))
+}
+
+// HandoffNotesUpdatedAtGT applies the GT predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtGT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGT(FieldHandoffNotesUpdatedAt, v))
+}
+
+// HandoffNotesUpdatedAtGTE applies the GTE predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtGTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldGTE(FieldHandoffNotesUpdatedAt, v))
+}
+
+// HandoffNotesUpdatedAtLT applies the LT predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtLT(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLT(FieldHandoffNotesUpdatedAt, v))
+}
+
+// HandoffNotesUpdatedAtLTE applies the LTE predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtLTE(v time.Time) predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldLTE(FieldHandoffNotesUpdatedAt, v))
+}
+
+// HandoffNotesUpdatedAtIsNil applies the IsNil predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtIsNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldIsNull(FieldHandoffNotesUpdatedAt))
+}
+
+// HandoffNotesUpdatedAtNotNil applies the NotNil predicate on the "handoff_notes_updated_at" field.
+func HandoffNotesUpdatedAtNotNil() predicate.AlertSession {
+	return predicate.AlertSession(sql.FieldNotNull(FieldHandoffNotesUpdatedAt))
+}
+
 // HasStages applies the HasEdge predicate on the "stages" edge.
 func HasStages() predicate.AlertSession {
 	return predicate.AlertSession(func(s *sql.Selector) {
@@ -2075,6 +4320,29 @@ func HasReviewActivitiesWith(preds ...predicate.SessionReviewActivity) predicate
 	})
 }
 
+// HasClaims applies the HasEdge predicate on the "claims" edge.
+func HasClaims() predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ClaimsTable, ClaimsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClaimsWith applies the HasEdge predicate on the "claims" edge with a given conditions (other predicates).
+func HasClaimsWith(preds ...predicate.SessionClaim) predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := newClaimsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasMemories applies the HasEdge predicate on the "memories" edge.
 func HasMemories() predicate.AlertSession {
 	return predicate.AlertSession(func(s *sql.Selector) {
@@ -2121,6 +4389,144 @@ func HasInjectedMemoriesWith(preds ...predicate.InvestigationMemory) predicate.A
 	})
 }
 
+// HasRedactionReviews applies the HasEdge predicate on the "redaction_reviews" edge.
+func HasRedactionReviews() predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RedactionReviewsTable, RedactionReviewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRedactionReviewsWith applies the HasEdge predicate on the "redaction_reviews" edge with a given conditions (other predicates).
+func HasRedactionReviewsWith(preds ...predicate.RedactionReview) predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := newRedactionReviewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHandoffNoteRevisions applies the HasEdge predicate on the "handoff_note_revisions" edge.
+func HasHandoffNoteRevisions() predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HandoffNoteRevisionsTable, HandoffNoteRevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHandoffNoteRevisionsWith applies the HasEdge predicate on the "handoff_note_revisions" edge with a given conditions (other predicates).
+func HasHandoffNoteRevisionsWith(preds ...predicate.HandoffNoteRevision) predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := newHandoffNoteRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComparison applies the HasEdge predicate on the "comparison" edge.
+func HasComparison() predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ComparisonTable, ComparisonColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasComparisonWith applies the HasEdge predicate on the "comparison" edge with a given conditions (other predicates).
+func HasComparisonWith(preds ...predicate.SessionComparison) predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := newComparisonStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNextComparisons applies the HasEdge predicate on the "next_comparisons" edge.
+func HasNextComparisons() predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NextComparisonsTable, NextComparisonsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNextComparisonsWith applies the HasEdge predicate on the "next_comparisons" edge with a given conditions (other predicates).
+func HasNextComparisonsWith(preds ...predicate.SessionComparison) predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := newNextComparisonsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActionItems applies the HasEdge predicate on the "action_items" edge.
+func HasActionItems() predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActionItemsTable, ActionItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActionItemsWith applies the HasEdge predicate on the "action_items" edge with a given conditions (other predicates).
+func HasActionItemsWith(preds ...predicate.ActionItem) predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := newActionItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroup applies the HasEdge predicate on the "group" edge.
+func HasGroup() predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GroupTable, GroupColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupWith applies the HasEdge predicate on the "group" edge with a given conditions (other predicates).
+func HasGroupWith(preds ...predicate.SessionGroup) predicate.AlertSession {
+	return predicate.AlertSession(func(s *sql.Selector) {
+		step := newGroupStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AlertSession) predicate.AlertSession {
 	return predicate.AlertSession(sql.AndPredicates(predicates...))
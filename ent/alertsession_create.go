@@ -10,14 +10,21 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
 	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
 	"github.com/codeready-toolchain/tarsy/ent/event"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
 	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
 	"github.com/codeready-toolchain/tarsy/ent/message"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
 	"github.com/codeready-toolchain/tarsy/ent/sessionreviewactivity"
 	"github.com/codeready-toolchain/tarsy/ent/sessionscore"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
@@ -31,6 +38,34 @@ type AlertSessionCreate struct {
 	hooks    []Hook
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AlertSessionCreate) SetUpdatedAt(v time.Time) *AlertSessionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableUpdatedAt(v *time.Time) *AlertSessionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *AlertSessionCreate) SetDeletedAt(v time.Time) *AlertSessionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableDeletedAt(v *time.Time) *AlertSessionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetAlertData sets the "alert_data" field.
 func (_c *AlertSessionCreate) SetAlertData(v string) *AlertSessionCreate {
 	_c.mutation.SetAlertData(v)
@@ -169,6 +204,104 @@ func (_c *AlertSessionCreate) SetNillableExecutiveSummaryError(v *string) *Alert
 	return _c
 }
 
+// SetExecutiveSummaryTemplate sets the "executive_summary_template" field.
+func (_c *AlertSessionCreate) SetExecutiveSummaryTemplate(v string) *AlertSessionCreate {
+	_c.mutation.SetExecutiveSummaryTemplate(v)
+	return _c
+}
+
+// SetNillableExecutiveSummaryTemplate sets the "executive_summary_template" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableExecutiveSummaryTemplate(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetExecutiveSummaryTemplate(*v)
+	}
+	return _c
+}
+
+// SetTechnicalSummary sets the "technical_summary" field.
+func (_c *AlertSessionCreate) SetTechnicalSummary(v string) *AlertSessionCreate {
+	_c.mutation.SetTechnicalSummary(v)
+	return _c
+}
+
+// SetNillableTechnicalSummary sets the "technical_summary" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableTechnicalSummary(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetTechnicalSummary(*v)
+	}
+	return _c
+}
+
+// SetTechnicalSummaryError sets the "technical_summary_error" field.
+func (_c *AlertSessionCreate) SetTechnicalSummaryError(v string) *AlertSessionCreate {
+	_c.mutation.SetTechnicalSummaryError(v)
+	return _c
+}
+
+// SetNillableTechnicalSummaryError sets the "technical_summary_error" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableTechnicalSummaryError(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetTechnicalSummaryError(*v)
+	}
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *AlertSessionCreate) SetSeverity(v alertsession.Severity) *AlertSessionCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableSeverity(v *alertsession.Severity) *AlertSessionCreate {
+	if v != nil {
+		_c.SetSeverity(*v)
+	}
+	return _c
+}
+
+// SetOutcome sets the "outcome" field.
+func (_c *AlertSessionCreate) SetOutcome(v alertsession.Outcome) *AlertSessionCreate {
+	_c.mutation.SetOutcome(v)
+	return _c
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableOutcome(v *alertsession.Outcome) *AlertSessionCreate {
+	if v != nil {
+		_c.SetOutcome(*v)
+	}
+	return _c
+}
+
+// SetOutcomeReason sets the "outcome_reason" field.
+func (_c *AlertSessionCreate) SetOutcomeReason(v string) *AlertSessionCreate {
+	_c.mutation.SetOutcomeReason(v)
+	return _c
+}
+
+// SetNillableOutcomeReason sets the "outcome_reason" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableOutcomeReason(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetOutcomeReason(*v)
+	}
+	return _c
+}
+
+// SetOutcomeSource sets the "outcome_source" field.
+func (_c *AlertSessionCreate) SetOutcomeSource(v alertsession.OutcomeSource) *AlertSessionCreate {
+	_c.mutation.SetOutcomeSource(v)
+	return _c
+}
+
+// SetNillableOutcomeSource sets the "outcome_source" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableOutcomeSource(v *alertsession.OutcomeSource) *AlertSessionCreate {
+	if v != nil {
+		_c.SetOutcomeSource(*v)
+	}
+	return _c
+}
+
 // SetSessionMetadata sets the "session_metadata" field.
 func (_c *AlertSessionCreate) SetSessionMetadata(v map[string]interface{}) *AlertSessionCreate {
 	_c.mutation.SetSessionMetadata(v)
@@ -189,6 +322,20 @@ func (_c *AlertSessionCreate) SetNillableAuthor(v *string) *AlertSessionCreate {
 	return _c
 }
 
+// SetAuthorSubject sets the "author_subject" field.
+func (_c *AlertSessionCreate) SetAuthorSubject(v string) *AlertSessionCreate {
+	_c.mutation.SetAuthorSubject(v)
+	return _c
+}
+
+// SetNillableAuthorSubject sets the "author_subject" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableAuthorSubject(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetAuthorSubject(*v)
+	}
+	return _c
+}
+
 // SetRunbookURL sets the "runbook_url" field.
 func (_c *AlertSessionCreate) SetRunbookURL(v string) *AlertSessionCreate {
 	_c.mutation.SetRunbookURL(v)
@@ -209,12 +356,52 @@ func (_c *AlertSessionCreate) SetMcpSelection(v map[string]interface{}) *AlertSe
 	return _c
 }
 
+// SetAlertInstructions sets the "alert_instructions" field.
+func (_c *AlertSessionCreate) SetAlertInstructions(v map[string]interface{}) *AlertSessionCreate {
+	_c.mutation.SetAlertInstructions(v)
+	return _c
+}
+
+// SetAlertImages sets the "alert_images" field.
+func (_c *AlertSessionCreate) SetAlertImages(v []schema.ImageRef) *AlertSessionCreate {
+	_c.mutation.SetAlertImages(v)
+	return _c
+}
+
+// SetOutputLanguage sets the "output_language" field.
+func (_c *AlertSessionCreate) SetOutputLanguage(v string) *AlertSessionCreate {
+	_c.mutation.SetOutputLanguage(v)
+	return _c
+}
+
+// SetNillableOutputLanguage sets the "output_language" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableOutputLanguage(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetOutputLanguage(*v)
+	}
+	return _c
+}
+
 // SetChainID sets the "chain_id" field.
 func (_c *AlertSessionCreate) SetChainID(v string) *AlertSessionCreate {
 	_c.mutation.SetChainID(v)
 	return _c
 }
 
+// SetOwner sets the "owner" field.
+func (_c *AlertSessionCreate) SetOwner(v string) *AlertSessionCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableOwner(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetOwner(*v)
+	}
+	return _c
+}
+
 // SetCurrentStageIndex sets the "current_stage_index" field.
 func (_c *AlertSessionCreate) SetCurrentStageIndex(v int) *AlertSessionCreate {
 	_c.mutation.SetCurrentStageIndex(v)
@@ -263,38 +450,334 @@ func (_c *AlertSessionCreate) SetLastInteractionAt(v time.Time) *AlertSessionCre
 	return _c
 }
 
-// SetNillableLastInteractionAt sets the "last_interaction_at" field if the given value is not nil.
-func (_c *AlertSessionCreate) SetNillableLastInteractionAt(v *time.Time) *AlertSessionCreate {
+// SetNillableLastInteractionAt sets the "last_interaction_at" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableLastInteractionAt(v *time.Time) *AlertSessionCreate {
+	if v != nil {
+		_c.SetLastInteractionAt(*v)
+	}
+	return _c
+}
+
+// SetSlackMessageFingerprint sets the "slack_message_fingerprint" field.
+func (_c *AlertSessionCreate) SetSlackMessageFingerprint(v string) *AlertSessionCreate {
+	_c.mutation.SetSlackMessageFingerprint(v)
+	return _c
+}
+
+// SetNillableSlackMessageFingerprint sets the "slack_message_fingerprint" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableSlackMessageFingerprint(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetSlackMessageFingerprint(*v)
+	}
+	return _c
+}
+
+// SetAlertKey sets the "alert_key" field.
+func (_c *AlertSessionCreate) SetAlertKey(v string) *AlertSessionCreate {
+	_c.mutation.SetAlertKey(v)
+	return _c
+}
+
+// SetNillableAlertKey sets the "alert_key" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableAlertKey(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetAlertKey(*v)
+	}
+	return _c
+}
+
+// SetAlertResolvedAt sets the "alert_resolved_at" field.
+func (_c *AlertSessionCreate) SetAlertResolvedAt(v time.Time) *AlertSessionCreate {
+	_c.mutation.SetAlertResolvedAt(v)
+	return _c
+}
+
+// SetNillableAlertResolvedAt sets the "alert_resolved_at" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableAlertResolvedAt(v *time.Time) *AlertSessionCreate {
+	if v != nil {
+		_c.SetAlertResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetAlertResolution sets the "alert_resolution" field.
+func (_c *AlertSessionCreate) SetAlertResolution(v string) *AlertSessionCreate {
+	_c.mutation.SetAlertResolution(v)
+	return _c
+}
+
+// SetNillableAlertResolution sets the "alert_resolution" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableAlertResolution(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetAlertResolution(*v)
+	}
+	return _c
+}
+
+// SetCancelInitiator sets the "cancel_initiator" field.
+func (_c *AlertSessionCreate) SetCancelInitiator(v alertsession.CancelInitiator) *AlertSessionCreate {
+	_c.mutation.SetCancelInitiator(v)
+	return _c
+}
+
+// SetNillableCancelInitiator sets the "cancel_initiator" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCancelInitiator(v *alertsession.CancelInitiator) *AlertSessionCreate {
+	if v != nil {
+		_c.SetCancelInitiator(*v)
+	}
+	return _c
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (_c *AlertSessionCreate) SetCancelReason(v string) *AlertSessionCreate {
+	_c.mutation.SetCancelReason(v)
+	return _c
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCancelReason(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetCancelReason(*v)
+	}
+	return _c
+}
+
+// SetCancelledBy sets the "cancelled_by" field.
+func (_c *AlertSessionCreate) SetCancelledBy(v string) *AlertSessionCreate {
+	_c.mutation.SetCancelledBy(v)
+	return _c
+}
+
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCancelledBy(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetCancelledBy(*v)
+	}
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *AlertSessionCreate) SetRequestID(v string) *AlertSessionCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableRequestID(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetDegradedReason sets the "degraded_reason" field.
+func (_c *AlertSessionCreate) SetDegradedReason(v string) *AlertSessionCreate {
+	_c.mutation.SetDegradedReason(v)
+	return _c
+}
+
+// SetNillableDegradedReason sets the "degraded_reason" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableDegradedReason(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetDegradedReason(*v)
+	}
+	return _c
+}
+
+// SetModelRouting sets the "model_routing" field.
+func (_c *AlertSessionCreate) SetModelRouting(v *schema.ModelRoutingDecision) *AlertSessionCreate {
+	_c.mutation.SetModelRouting(v)
+	return _c
+}
+
+// SetNoiseTriage sets the "noise_triage" field.
+func (_c *AlertSessionCreate) SetNoiseTriage(v *schema.NoiseTriageDecision) *AlertSessionCreate {
+	_c.mutation.SetNoiseTriage(v)
+	return _c
+}
+
+// SetForceFullInvestigation sets the "force_full_investigation" field.
+func (_c *AlertSessionCreate) SetForceFullInvestigation(v bool) *AlertSessionCreate {
+	_c.mutation.SetForceFullInvestigation(v)
+	return _c
+}
+
+// SetNillableForceFullInvestigation sets the "force_full_investigation" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableForceFullInvestigation(v *bool) *AlertSessionCreate {
+	if v != nil {
+		_c.SetForceFullInvestigation(*v)
+	}
+	return _c
+}
+
+// SetFederationOrigin sets the "federation_origin" field.
+func (_c *AlertSessionCreate) SetFederationOrigin(v *schema.FederationOrigin) *AlertSessionCreate {
+	_c.mutation.SetFederationOrigin(v)
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *AlertSessionCreate) SetTarget(v *schema.AlertTarget) *AlertSessionCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetOnCall sets the "on_call" field.
+func (_c *AlertSessionCreate) SetOnCall(v *schema.OnCallContact) *AlertSessionCreate {
+	_c.mutation.SetOnCall(v)
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *AlertSessionCreate) SetGroupID(v string) *AlertSessionCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableGroupID(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetGroupID(*v)
+	}
+	return _c
+}
+
+// SetDuplicatedFrom sets the "duplicated_from" field.
+func (_c *AlertSessionCreate) SetDuplicatedFrom(v string) *AlertSessionCreate {
+	_c.mutation.SetDuplicatedFrom(v)
+	return _c
+}
+
+// SetNillableDuplicatedFrom sets the "duplicated_from" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableDuplicatedFrom(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetDuplicatedFrom(*v)
+	}
+	return _c
+}
+
+// SetLlmProvider sets the "llm_provider" field.
+func (_c *AlertSessionCreate) SetLlmProvider(v string) *AlertSessionCreate {
+	_c.mutation.SetLlmProvider(v)
+	return _c
+}
+
+// SetNillableLlmProvider sets the "llm_provider" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableLlmProvider(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetLlmProvider(*v)
+	}
+	return _c
+}
+
+// SetSandbox sets the "sandbox" field.
+func (_c *AlertSessionCreate) SetSandbox(v bool) *AlertSessionCreate {
+	_c.mutation.SetSandbox(v)
+	return _c
+}
+
+// SetNillableSandbox sets the "sandbox" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableSandbox(v *bool) *AlertSessionCreate {
+	if v != nil {
+		_c.SetSandbox(*v)
+	}
+	return _c
+}
+
+// SetFallbackChain sets the "fallback_chain" field.
+func (_c *AlertSessionCreate) SetFallbackChain(v bool) *AlertSessionCreate {
+	_c.mutation.SetFallbackChain(v)
+	return _c
+}
+
+// SetNillableFallbackChain sets the "fallback_chain" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableFallbackChain(v *bool) *AlertSessionCreate {
+	if v != nil {
+		_c.SetFallbackChain(*v)
+	}
+	return _c
+}
+
+// SetCallbackURL sets the "callback_url" field.
+func (_c *AlertSessionCreate) SetCallbackURL(v string) *AlertSessionCreate {
+	_c.mutation.SetCallbackURL(v)
+	return _c
+}
+
+// SetNillableCallbackURL sets the "callback_url" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCallbackURL(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetCallbackURL(*v)
+	}
+	return _c
+}
+
+// SetCallbackSecret sets the "callback_secret" field.
+func (_c *AlertSessionCreate) SetCallbackSecret(v string) *AlertSessionCreate {
+	_c.mutation.SetCallbackSecret(v)
+	return _c
+}
+
+// SetNillableCallbackSecret sets the "callback_secret" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCallbackSecret(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetCallbackSecret(*v)
+	}
+	return _c
+}
+
+// SetCallbackStatus sets the "callback_status" field.
+func (_c *AlertSessionCreate) SetCallbackStatus(v alertsession.CallbackStatus) *AlertSessionCreate {
+	_c.mutation.SetCallbackStatus(v)
+	return _c
+}
+
+// SetNillableCallbackStatus sets the "callback_status" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCallbackStatus(v *alertsession.CallbackStatus) *AlertSessionCreate {
+	if v != nil {
+		_c.SetCallbackStatus(*v)
+	}
+	return _c
+}
+
+// SetCallbackAttempts sets the "callback_attempts" field.
+func (_c *AlertSessionCreate) SetCallbackAttempts(v int) *AlertSessionCreate {
+	_c.mutation.SetCallbackAttempts(v)
+	return _c
+}
+
+// SetNillableCallbackAttempts sets the "callback_attempts" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCallbackAttempts(v *int) *AlertSessionCreate {
 	if v != nil {
-		_c.SetLastInteractionAt(*v)
+		_c.SetCallbackAttempts(*v)
 	}
 	return _c
 }
 
-// SetSlackMessageFingerprint sets the "slack_message_fingerprint" field.
-func (_c *AlertSessionCreate) SetSlackMessageFingerprint(v string) *AlertSessionCreate {
-	_c.mutation.SetSlackMessageFingerprint(v)
+// SetCallbackLastError sets the "callback_last_error" field.
+func (_c *AlertSessionCreate) SetCallbackLastError(v string) *AlertSessionCreate {
+	_c.mutation.SetCallbackLastError(v)
 	return _c
 }
 
-// SetNillableSlackMessageFingerprint sets the "slack_message_fingerprint" field if the given value is not nil.
-func (_c *AlertSessionCreate) SetNillableSlackMessageFingerprint(v *string) *AlertSessionCreate {
+// SetNillableCallbackLastError sets the "callback_last_error" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCallbackLastError(v *string) *AlertSessionCreate {
 	if v != nil {
-		_c.SetSlackMessageFingerprint(*v)
+		_c.SetCallbackLastError(*v)
 	}
 	return _c
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_c *AlertSessionCreate) SetDeletedAt(v time.Time) *AlertSessionCreate {
-	_c.mutation.SetDeletedAt(v)
+// SetCallbackDeliveredAt sets the "callback_delivered_at" field.
+func (_c *AlertSessionCreate) SetCallbackDeliveredAt(v time.Time) *AlertSessionCreate {
+	_c.mutation.SetCallbackDeliveredAt(v)
 	return _c
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_c *AlertSessionCreate) SetNillableDeletedAt(v *time.Time) *AlertSessionCreate {
+// SetNillableCallbackDeliveredAt sets the "callback_delivered_at" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableCallbackDeliveredAt(v *time.Time) *AlertSessionCreate {
 	if v != nil {
-		_c.SetDeletedAt(*v)
+		_c.SetCallbackDeliveredAt(*v)
 	}
 	return _c
 }
@@ -397,6 +880,62 @@ func (_c *AlertSessionCreate) SetNillableInvestigationFeedback(v *string) *Alert
 	return _c
 }
 
+// SetHandoffNotes sets the "handoff_notes" field.
+func (_c *AlertSessionCreate) SetHandoffNotes(v string) *AlertSessionCreate {
+	_c.mutation.SetHandoffNotes(v)
+	return _c
+}
+
+// SetNillableHandoffNotes sets the "handoff_notes" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableHandoffNotes(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetHandoffNotes(*v)
+	}
+	return _c
+}
+
+// SetHandoffNotesRevision sets the "handoff_notes_revision" field.
+func (_c *AlertSessionCreate) SetHandoffNotesRevision(v int) *AlertSessionCreate {
+	_c.mutation.SetHandoffNotesRevision(v)
+	return _c
+}
+
+// SetNillableHandoffNotesRevision sets the "handoff_notes_revision" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableHandoffNotesRevision(v *int) *AlertSessionCreate {
+	if v != nil {
+		_c.SetHandoffNotesRevision(*v)
+	}
+	return _c
+}
+
+// SetHandoffNotesUpdatedBy sets the "handoff_notes_updated_by" field.
+func (_c *AlertSessionCreate) SetHandoffNotesUpdatedBy(v string) *AlertSessionCreate {
+	_c.mutation.SetHandoffNotesUpdatedBy(v)
+	return _c
+}
+
+// SetNillableHandoffNotesUpdatedBy sets the "handoff_notes_updated_by" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableHandoffNotesUpdatedBy(v *string) *AlertSessionCreate {
+	if v != nil {
+		_c.SetHandoffNotesUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetHandoffNotesUpdatedAt sets the "handoff_notes_updated_at" field.
+func (_c *AlertSessionCreate) SetHandoffNotesUpdatedAt(v time.Time) *AlertSessionCreate {
+	_c.mutation.SetHandoffNotesUpdatedAt(v)
+	return _c
+}
+
+// SetNillableHandoffNotesUpdatedAt sets the "handoff_notes_updated_at" field if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableHandoffNotesUpdatedAt(v *time.Time) *AlertSessionCreate {
+	if v != nil {
+		_c.SetHandoffNotesUpdatedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AlertSessionCreate) SetID(v string) *AlertSessionCreate {
 	_c.mutation.SetID(v)
@@ -557,6 +1096,21 @@ func (_c *AlertSessionCreate) AddReviewActivities(v ...*SessionReviewActivity) *
 	return _c.AddReviewActivityIDs(ids...)
 }
 
+// AddClaimIDs adds the "claims" edge to the SessionClaim entity by IDs.
+func (_c *AlertSessionCreate) AddClaimIDs(ids ...string) *AlertSessionCreate {
+	_c.mutation.AddClaimIDs(ids...)
+	return _c
+}
+
+// AddClaims adds the "claims" edges to the SessionClaim entity.
+func (_c *AlertSessionCreate) AddClaims(v ...*SessionClaim) *AlertSessionCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddClaimIDs(ids...)
+}
+
 // AddMemoryIDs adds the "memories" edge to the InvestigationMemory entity by IDs.
 func (_c *AlertSessionCreate) AddMemoryIDs(ids ...string) *AlertSessionCreate {
 	_c.mutation.AddMemoryIDs(ids...)
@@ -587,6 +1141,90 @@ func (_c *AlertSessionCreate) AddInjectedMemories(v ...*InvestigationMemory) *Al
 	return _c.AddInjectedMemoryIDs(ids...)
 }
 
+// AddRedactionReviewIDs adds the "redaction_reviews" edge to the RedactionReview entity by IDs.
+func (_c *AlertSessionCreate) AddRedactionReviewIDs(ids ...string) *AlertSessionCreate {
+	_c.mutation.AddRedactionReviewIDs(ids...)
+	return _c
+}
+
+// AddRedactionReviews adds the "redaction_reviews" edges to the RedactionReview entity.
+func (_c *AlertSessionCreate) AddRedactionReviews(v ...*RedactionReview) *AlertSessionCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRedactionReviewIDs(ids...)
+}
+
+// AddHandoffNoteRevisionIDs adds the "handoff_note_revisions" edge to the HandoffNoteRevision entity by IDs.
+func (_c *AlertSessionCreate) AddHandoffNoteRevisionIDs(ids ...string) *AlertSessionCreate {
+	_c.mutation.AddHandoffNoteRevisionIDs(ids...)
+	return _c
+}
+
+// AddHandoffNoteRevisions adds the "handoff_note_revisions" edges to the HandoffNoteRevision entity.
+func (_c *AlertSessionCreate) AddHandoffNoteRevisions(v ...*HandoffNoteRevision) *AlertSessionCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHandoffNoteRevisionIDs(ids...)
+}
+
+// SetComparisonID sets the "comparison" edge to the SessionComparison entity by ID.
+func (_c *AlertSessionCreate) SetComparisonID(id string) *AlertSessionCreate {
+	_c.mutation.SetComparisonID(id)
+	return _c
+}
+
+// SetNillableComparisonID sets the "comparison" edge to the SessionComparison entity by ID if the given value is not nil.
+func (_c *AlertSessionCreate) SetNillableComparisonID(id *string) *AlertSessionCreate {
+	if id != nil {
+		_c = _c.SetComparisonID(*id)
+	}
+	return _c
+}
+
+// SetComparison sets the "comparison" edge to the SessionComparison entity.
+func (_c *AlertSessionCreate) SetComparison(v *SessionComparison) *AlertSessionCreate {
+	return _c.SetComparisonID(v.ID)
+}
+
+// AddNextComparisonIDs adds the "next_comparisons" edge to the SessionComparison entity by IDs.
+func (_c *AlertSessionCreate) AddNextComparisonIDs(ids ...string) *AlertSessionCreate {
+	_c.mutation.AddNextComparisonIDs(ids...)
+	return _c
+}
+
+// AddNextComparisons adds the "next_comparisons" edges to the SessionComparison entity.
+func (_c *AlertSessionCreate) AddNextComparisons(v ...*SessionComparison) *AlertSessionCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNextComparisonIDs(ids...)
+}
+
+// AddActionItemIDs adds the "action_items" edge to the ActionItem entity by IDs.
+func (_c *AlertSessionCreate) AddActionItemIDs(ids ...string) *AlertSessionCreate {
+	_c.mutation.AddActionItemIDs(ids...)
+	return _c
+}
+
+// AddActionItems adds the "action_items" edges to the ActionItem entity.
+func (_c *AlertSessionCreate) AddActionItems(v ...*ActionItem) *AlertSessionCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddActionItemIDs(ids...)
+}
+
+// SetGroup sets the "group" edge to the SessionGroup entity.
+func (_c *AlertSessionCreate) SetGroup(v *SessionGroup) *AlertSessionCreate {
+	return _c.SetGroupID(v.ID)
+}
+
 // Mutation returns the AlertSessionMutation object of the builder.
 func (_c *AlertSessionCreate) Mutation() *AlertSessionMutation {
 	return _c.mutation
@@ -622,6 +1260,10 @@ func (_c *AlertSessionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *AlertSessionCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := alertsession.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := alertsession.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -630,10 +1272,33 @@ func (_c *AlertSessionCreate) defaults() {
 		v := alertsession.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.ForceFullInvestigation(); !ok {
+		v := alertsession.DefaultForceFullInvestigation
+		_c.mutation.SetForceFullInvestigation(v)
+	}
+	if _, ok := _c.mutation.Sandbox(); !ok {
+		v := alertsession.DefaultSandbox
+		_c.mutation.SetSandbox(v)
+	}
+	if _, ok := _c.mutation.FallbackChain(); !ok {
+		v := alertsession.DefaultFallbackChain
+		_c.mutation.SetFallbackChain(v)
+	}
+	if _, ok := _c.mutation.CallbackAttempts(); !ok {
+		v := alertsession.DefaultCallbackAttempts
+		_c.mutation.SetCallbackAttempts(v)
+	}
+	if _, ok := _c.mutation.HandoffNotesRevision(); !ok {
+		v := alertsession.DefaultHandoffNotesRevision
+		_c.mutation.SetHandoffNotesRevision(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *AlertSessionCreate) check() error {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AlertSession.updated_at"`)}
+	}
 	if _, ok := _c.mutation.AlertData(); !ok {
 		return &ValidationError{Name: "alert_data", err: errors.New(`ent: missing required field "AlertSession.alert_data"`)}
 	}
@@ -651,9 +1316,46 @@ func (_c *AlertSessionCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AlertSession.created_at"`)}
 	}
+	if v, ok := _c.mutation.Severity(); ok {
+		if err := alertsession.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AlertSession.severity": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Outcome(); ok {
+		if err := alertsession.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AlertSession.outcome": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.OutcomeSource(); ok {
+		if err := alertsession.OutcomeSourceValidator(v); err != nil {
+			return &ValidationError{Name: "outcome_source", err: fmt.Errorf(`ent: validator failed for field "AlertSession.outcome_source": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.ChainID(); !ok {
 		return &ValidationError{Name: "chain_id", err: errors.New(`ent: missing required field "AlertSession.chain_id"`)}
 	}
+	if v, ok := _c.mutation.CancelInitiator(); ok {
+		if err := alertsession.CancelInitiatorValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_initiator", err: fmt.Errorf(`ent: validator failed for field "AlertSession.cancel_initiator": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ForceFullInvestigation(); !ok {
+		return &ValidationError{Name: "force_full_investigation", err: errors.New(`ent: missing required field "AlertSession.force_full_investigation"`)}
+	}
+	if _, ok := _c.mutation.Sandbox(); !ok {
+		return &ValidationError{Name: "sandbox", err: errors.New(`ent: missing required field "AlertSession.sandbox"`)}
+	}
+	if _, ok := _c.mutation.FallbackChain(); !ok {
+		return &ValidationError{Name: "fallback_chain", err: errors.New(`ent: missing required field "AlertSession.fallback_chain"`)}
+	}
+	if v, ok := _c.mutation.CallbackStatus(); ok {
+		if err := alertsession.CallbackStatusValidator(v); err != nil {
+			return &ValidationError{Name: "callback_status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.callback_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CallbackAttempts(); !ok {
+		return &ValidationError{Name: "callback_attempts", err: errors.New(`ent: missing required field "AlertSession.callback_attempts"`)}
+	}
 	if v, ok := _c.mutation.ReviewStatus(); ok {
 		if err := alertsession.ReviewStatusValidator(v); err != nil {
 			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.review_status": %w`, err)}
@@ -664,6 +1366,9 @@ func (_c *AlertSessionCreate) check() error {
 			return &ValidationError{Name: "quality_rating", err: fmt.Errorf(`ent: validator failed for field "AlertSession.quality_rating": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.HandoffNotesRevision(); !ok {
+		return &ValidationError{Name: "handoff_notes_revision", err: errors.New(`ent: missing required field "AlertSession.handoff_notes_revision"`)}
+	}
 	return nil
 }
 
@@ -699,6 +1404,14 @@ func (_c *AlertSessionCreate) createSpec() (*AlertSession, *sqlgraph.CreateSpec)
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertsession.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(alertsession.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.AlertData(); ok {
 		_spec.SetField(alertsession.FieldAlertData, field.TypeString, value)
 		_node.AlertData = value
@@ -743,6 +1456,34 @@ func (_c *AlertSessionCreate) createSpec() (*AlertSession, *sqlgraph.CreateSpec)
 		_spec.SetField(alertsession.FieldExecutiveSummaryError, field.TypeString, value)
 		_node.ExecutiveSummaryError = &value
 	}
+	if value, ok := _c.mutation.ExecutiveSummaryTemplate(); ok {
+		_spec.SetField(alertsession.FieldExecutiveSummaryTemplate, field.TypeString, value)
+		_node.ExecutiveSummaryTemplate = &value
+	}
+	if value, ok := _c.mutation.TechnicalSummary(); ok {
+		_spec.SetField(alertsession.FieldTechnicalSummary, field.TypeString, value)
+		_node.TechnicalSummary = &value
+	}
+	if value, ok := _c.mutation.TechnicalSummaryError(); ok {
+		_spec.SetField(alertsession.FieldTechnicalSummaryError, field.TypeString, value)
+		_node.TechnicalSummaryError = &value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(alertsession.FieldSeverity, field.TypeEnum, value)
+		_node.Severity = &value
+	}
+	if value, ok := _c.mutation.Outcome(); ok {
+		_spec.SetField(alertsession.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = &value
+	}
+	if value, ok := _c.mutation.OutcomeReason(); ok {
+		_spec.SetField(alertsession.FieldOutcomeReason, field.TypeString, value)
+		_node.OutcomeReason = &value
+	}
+	if value, ok := _c.mutation.OutcomeSource(); ok {
+		_spec.SetField(alertsession.FieldOutcomeSource, field.TypeEnum, value)
+		_node.OutcomeSource = &value
+	}
 	if value, ok := _c.mutation.SessionMetadata(); ok {
 		_spec.SetField(alertsession.FieldSessionMetadata, field.TypeJSON, value)
 		_node.SessionMetadata = value
@@ -751,6 +1492,10 @@ func (_c *AlertSessionCreate) createSpec() (*AlertSession, *sqlgraph.CreateSpec)
 		_spec.SetField(alertsession.FieldAuthor, field.TypeString, value)
 		_node.Author = &value
 	}
+	if value, ok := _c.mutation.AuthorSubject(); ok {
+		_spec.SetField(alertsession.FieldAuthorSubject, field.TypeString, value)
+		_node.AuthorSubject = &value
+	}
 	if value, ok := _c.mutation.RunbookURL(); ok {
 		_spec.SetField(alertsession.FieldRunbookURL, field.TypeString, value)
 		_node.RunbookURL = &value
@@ -759,10 +1504,26 @@ func (_c *AlertSessionCreate) createSpec() (*AlertSession, *sqlgraph.CreateSpec)
 		_spec.SetField(alertsession.FieldMcpSelection, field.TypeJSON, value)
 		_node.McpSelection = value
 	}
+	if value, ok := _c.mutation.AlertInstructions(); ok {
+		_spec.SetField(alertsession.FieldAlertInstructions, field.TypeJSON, value)
+		_node.AlertInstructions = value
+	}
+	if value, ok := _c.mutation.AlertImages(); ok {
+		_spec.SetField(alertsession.FieldAlertImages, field.TypeJSON, value)
+		_node.AlertImages = value
+	}
+	if value, ok := _c.mutation.OutputLanguage(); ok {
+		_spec.SetField(alertsession.FieldOutputLanguage, field.TypeString, value)
+		_node.OutputLanguage = &value
+	}
 	if value, ok := _c.mutation.ChainID(); ok {
 		_spec.SetField(alertsession.FieldChainID, field.TypeString, value)
 		_node.ChainID = value
 	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(alertsession.FieldOwner, field.TypeString, value)
+		_node.Owner = &value
+	}
 	if value, ok := _c.mutation.CurrentStageIndex(); ok {
 		_spec.SetField(alertsession.FieldCurrentStageIndex, field.TypeInt, value)
 		_node.CurrentStageIndex = &value
@@ -783,9 +1544,101 @@ func (_c *AlertSessionCreate) createSpec() (*AlertSession, *sqlgraph.CreateSpec)
 		_spec.SetField(alertsession.FieldSlackMessageFingerprint, field.TypeString, value)
 		_node.SlackMessageFingerprint = &value
 	}
-	if value, ok := _c.mutation.DeletedAt(); ok {
-		_spec.SetField(alertsession.FieldDeletedAt, field.TypeTime, value)
-		_node.DeletedAt = &value
+	if value, ok := _c.mutation.AlertKey(); ok {
+		_spec.SetField(alertsession.FieldAlertKey, field.TypeString, value)
+		_node.AlertKey = &value
+	}
+	if value, ok := _c.mutation.AlertResolvedAt(); ok {
+		_spec.SetField(alertsession.FieldAlertResolvedAt, field.TypeTime, value)
+		_node.AlertResolvedAt = &value
+	}
+	if value, ok := _c.mutation.AlertResolution(); ok {
+		_spec.SetField(alertsession.FieldAlertResolution, field.TypeString, value)
+		_node.AlertResolution = &value
+	}
+	if value, ok := _c.mutation.CancelInitiator(); ok {
+		_spec.SetField(alertsession.FieldCancelInitiator, field.TypeEnum, value)
+		_node.CancelInitiator = &value
+	}
+	if value, ok := _c.mutation.CancelReason(); ok {
+		_spec.SetField(alertsession.FieldCancelReason, field.TypeString, value)
+		_node.CancelReason = &value
+	}
+	if value, ok := _c.mutation.CancelledBy(); ok {
+		_spec.SetField(alertsession.FieldCancelledBy, field.TypeString, value)
+		_node.CancelledBy = &value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(alertsession.FieldRequestID, field.TypeString, value)
+		_node.RequestID = &value
+	}
+	if value, ok := _c.mutation.DegradedReason(); ok {
+		_spec.SetField(alertsession.FieldDegradedReason, field.TypeString, value)
+		_node.DegradedReason = &value
+	}
+	if value, ok := _c.mutation.ModelRouting(); ok {
+		_spec.SetField(alertsession.FieldModelRouting, field.TypeJSON, value)
+		_node.ModelRouting = value
+	}
+	if value, ok := _c.mutation.NoiseTriage(); ok {
+		_spec.SetField(alertsession.FieldNoiseTriage, field.TypeJSON, value)
+		_node.NoiseTriage = value
+	}
+	if value, ok := _c.mutation.ForceFullInvestigation(); ok {
+		_spec.SetField(alertsession.FieldForceFullInvestigation, field.TypeBool, value)
+		_node.ForceFullInvestigation = value
+	}
+	if value, ok := _c.mutation.FederationOrigin(); ok {
+		_spec.SetField(alertsession.FieldFederationOrigin, field.TypeJSON, value)
+		_node.FederationOrigin = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(alertsession.FieldTarget, field.TypeJSON, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.OnCall(); ok {
+		_spec.SetField(alertsession.FieldOnCall, field.TypeJSON, value)
+		_node.OnCall = value
+	}
+	if value, ok := _c.mutation.DuplicatedFrom(); ok {
+		_spec.SetField(alertsession.FieldDuplicatedFrom, field.TypeString, value)
+		_node.DuplicatedFrom = &value
+	}
+	if value, ok := _c.mutation.LlmProvider(); ok {
+		_spec.SetField(alertsession.FieldLlmProvider, field.TypeString, value)
+		_node.LlmProvider = &value
+	}
+	if value, ok := _c.mutation.Sandbox(); ok {
+		_spec.SetField(alertsession.FieldSandbox, field.TypeBool, value)
+		_node.Sandbox = value
+	}
+	if value, ok := _c.mutation.FallbackChain(); ok {
+		_spec.SetField(alertsession.FieldFallbackChain, field.TypeBool, value)
+		_node.FallbackChain = value
+	}
+	if value, ok := _c.mutation.CallbackURL(); ok {
+		_spec.SetField(alertsession.FieldCallbackURL, field.TypeString, value)
+		_node.CallbackURL = &value
+	}
+	if value, ok := _c.mutation.CallbackSecret(); ok {
+		_spec.SetField(alertsession.FieldCallbackSecret, field.TypeString, value)
+		_node.CallbackSecret = &value
+	}
+	if value, ok := _c.mutation.CallbackStatus(); ok {
+		_spec.SetField(alertsession.FieldCallbackStatus, field.TypeEnum, value)
+		_node.CallbackStatus = &value
+	}
+	if value, ok := _c.mutation.CallbackAttempts(); ok {
+		_spec.SetField(alertsession.FieldCallbackAttempts, field.TypeInt, value)
+		_node.CallbackAttempts = value
+	}
+	if value, ok := _c.mutation.CallbackLastError(); ok {
+		_spec.SetField(alertsession.FieldCallbackLastError, field.TypeString, value)
+		_node.CallbackLastError = &value
+	}
+	if value, ok := _c.mutation.CallbackDeliveredAt(); ok {
+		_spec.SetField(alertsession.FieldCallbackDeliveredAt, field.TypeTime, value)
+		_node.CallbackDeliveredAt = &value
 	}
 	if value, ok := _c.mutation.ReviewStatus(); ok {
 		_spec.SetField(alertsession.FieldReviewStatus, field.TypeEnum, value)
@@ -815,6 +1668,22 @@ func (_c *AlertSessionCreate) createSpec() (*AlertSession, *sqlgraph.CreateSpec)
 		_spec.SetField(alertsession.FieldInvestigationFeedback, field.TypeString, value)
 		_node.InvestigationFeedback = &value
 	}
+	if value, ok := _c.mutation.HandoffNotes(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotes, field.TypeString, value)
+		_node.HandoffNotes = &value
+	}
+	if value, ok := _c.mutation.HandoffNotesRevision(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesRevision, field.TypeInt, value)
+		_node.HandoffNotesRevision = value
+	}
+	if value, ok := _c.mutation.HandoffNotesUpdatedBy(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesUpdatedBy, field.TypeString, value)
+		_node.HandoffNotesUpdatedBy = &value
+	}
+	if value, ok := _c.mutation.HandoffNotesUpdatedAt(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesUpdatedAt, field.TypeTime, value)
+		_node.HandoffNotesUpdatedAt = &value
+	}
 	if nodes := _c.mutation.StagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -975,6 +1844,22 @@ func (_c *AlertSessionCreate) createSpec() (*AlertSession, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ClaimsTable,
+			Columns: []string{alertsession.ClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.MemoriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1007,6 +1892,103 @@ func (_c *AlertSessionCreate) createSpec() (*AlertSession, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RedactionReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.RedactionReviewsTable,
+			Columns: []string{alertsession.RedactionReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HandoffNoteRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.HandoffNoteRevisionsTable,
+			Columns: []string{alertsession.HandoffNoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ComparisonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   alertsession.ComparisonTable,
+			Columns: []string{alertsession.ComparisonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NextComparisonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.NextComparisonsTable,
+			Columns: []string{alertsession.NextComparisonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ActionItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ActionItemsTable,
+			Columns: []string{alertsession.ActionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   alertsession.GroupTable,
+			Columns: []string{alertsession.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessiongroup.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GroupID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
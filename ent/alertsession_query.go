@@ -13,15 +13,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
 	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
 	"github.com/codeready-toolchain/tarsy/ent/event"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
 	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
 	"github.com/codeready-toolchain/tarsy/ent/message"
 	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
 	"github.com/codeready-toolchain/tarsy/ent/sessionreviewactivity"
 	"github.com/codeready-toolchain/tarsy/ent/sessionscore"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
@@ -31,23 +37,30 @@ import (
 // AlertSessionQuery is the builder for querying AlertSession entities.
 type AlertSessionQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []alertsession.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.AlertSession
-	withStages           *StageQuery
-	withAgentExecutions  *AgentExecutionQuery
-	withTimelineEvents   *TimelineEventQuery
-	withMessages         *MessageQuery
-	withLlmInteractions  *LLMInteractionQuery
-	withMcpInteractions  *MCPInteractionQuery
-	withEvents           *EventQuery
-	withChat             *ChatQuery
-	withSessionScores    *SessionScoreQuery
-	withReviewActivities *SessionReviewActivityQuery
-	withMemories         *InvestigationMemoryQuery
-	withInjectedMemories *InvestigationMemoryQuery
-	modifiers            []func(*sql.Selector)
+	ctx                      *QueryContext
+	order                    []alertsession.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.AlertSession
+	withStages               *StageQuery
+	withAgentExecutions      *AgentExecutionQuery
+	withTimelineEvents       *TimelineEventQuery
+	withMessages             *MessageQuery
+	withLlmInteractions      *LLMInteractionQuery
+	withMcpInteractions      *MCPInteractionQuery
+	withEvents               *EventQuery
+	withChat                 *ChatQuery
+	withSessionScores        *SessionScoreQuery
+	withReviewActivities     *SessionReviewActivityQuery
+	withClaims               *SessionClaimQuery
+	withMemories             *InvestigationMemoryQuery
+	withInjectedMemories     *InvestigationMemoryQuery
+	withRedactionReviews     *RedactionReviewQuery
+	withHandoffNoteRevisions *HandoffNoteRevisionQuery
+	withComparison           *SessionComparisonQuery
+	withNextComparisons      *SessionComparisonQuery
+	withActionItems          *ActionItemQuery
+	withGroup                *SessionGroupQuery
+	modifiers                []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -304,6 +317,28 @@ func (_q *AlertSessionQuery) QueryReviewActivities() *SessionReviewActivityQuery
 	return query
 }
 
+// QueryClaims chains the current query on the "claims" edge.
+func (_q *AlertSessionQuery) QueryClaims() *SessionClaimQuery {
+	query := (&SessionClaimClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, selector),
+			sqlgraph.To(sessionclaim.Table, sessionclaim.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.ClaimsTable, alertsession.ClaimsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryMemories chains the current query on the "memories" edge.
 func (_q *AlertSessionQuery) QueryMemories() *InvestigationMemoryQuery {
 	query := (&InvestigationMemoryClient{config: _q.config}).Query()
@@ -348,6 +383,138 @@ func (_q *AlertSessionQuery) QueryInjectedMemories() *InvestigationMemoryQuery {
 	return query
 }
 
+// QueryRedactionReviews chains the current query on the "redaction_reviews" edge.
+func (_q *AlertSessionQuery) QueryRedactionReviews() *RedactionReviewQuery {
+	query := (&RedactionReviewClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, selector),
+			sqlgraph.To(redactionreview.Table, redactionreview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.RedactionReviewsTable, alertsession.RedactionReviewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHandoffNoteRevisions chains the current query on the "handoff_note_revisions" edge.
+func (_q *AlertSessionQuery) QueryHandoffNoteRevisions() *HandoffNoteRevisionQuery {
+	query := (&HandoffNoteRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, selector),
+			sqlgraph.To(handoffnoterevision.Table, handoffnoterevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.HandoffNoteRevisionsTable, alertsession.HandoffNoteRevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComparison chains the current query on the "comparison" edge.
+func (_q *AlertSessionQuery) QueryComparison() *SessionComparisonQuery {
+	query := (&SessionComparisonClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, selector),
+			sqlgraph.To(sessioncomparison.Table, sessioncomparison.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, alertsession.ComparisonTable, alertsession.ComparisonColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNextComparisons chains the current query on the "next_comparisons" edge.
+func (_q *AlertSessionQuery) QueryNextComparisons() *SessionComparisonQuery {
+	query := (&SessionComparisonClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, selector),
+			sqlgraph.To(sessioncomparison.Table, sessioncomparison.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.NextComparisonsTable, alertsession.NextComparisonsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActionItems chains the current query on the "action_items" edge.
+func (_q *AlertSessionQuery) QueryActionItems() *ActionItemQuery {
+	query := (&ActionItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, selector),
+			sqlgraph.To(actionitem.Table, actionitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.ActionItemsTable, alertsession.ActionItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (_q *AlertSessionQuery) QueryGroup() *SessionGroupQuery {
+	query := (&SessionGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, selector),
+			sqlgraph.To(sessiongroup.Table, sessiongroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, alertsession.GroupTable, alertsession.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first AlertSession entity from the query.
 // Returns a *NotFoundError when no AlertSession was found.
 func (_q *AlertSessionQuery) First(ctx context.Context) (*AlertSession, error) {
@@ -535,23 +702,30 @@ func (_q *AlertSessionQuery) Clone() *AlertSessionQuery {
 		return nil
 	}
 	return &AlertSessionQuery{
-		config:               _q.config,
-		ctx:                  _q.ctx.Clone(),
-		order:                append([]alertsession.OrderOption{}, _q.order...),
-		inters:               append([]Interceptor{}, _q.inters...),
-		predicates:           append([]predicate.AlertSession{}, _q.predicates...),
-		withStages:           _q.withStages.Clone(),
-		withAgentExecutions:  _q.withAgentExecutions.Clone(),
-		withTimelineEvents:   _q.withTimelineEvents.Clone(),
-		withMessages:         _q.withMessages.Clone(),
-		withLlmInteractions:  _q.withLlmInteractions.Clone(),
-		withMcpInteractions:  _q.withMcpInteractions.Clone(),
-		withEvents:           _q.withEvents.Clone(),
-		withChat:             _q.withChat.Clone(),
-		withSessionScores:    _q.withSessionScores.Clone(),
-		withReviewActivities: _q.withReviewActivities.Clone(),
-		withMemories:         _q.withMemories.Clone(),
-		withInjectedMemories: _q.withInjectedMemories.Clone(),
+		config:                   _q.config,
+		ctx:                      _q.ctx.Clone(),
+		order:                    append([]alertsession.OrderOption{}, _q.order...),
+		inters:                   append([]Interceptor{}, _q.inters...),
+		predicates:               append([]predicate.AlertSession{}, _q.predicates...),
+		withStages:               _q.withStages.Clone(),
+		withAgentExecutions:      _q.withAgentExecutions.Clone(),
+		withTimelineEvents:       _q.withTimelineEvents.Clone(),
+		withMessages:             _q.withMessages.Clone(),
+		withLlmInteractions:      _q.withLlmInteractions.Clone(),
+		withMcpInteractions:      _q.withMcpInteractions.Clone(),
+		withEvents:               _q.withEvents.Clone(),
+		withChat:                 _q.withChat.Clone(),
+		withSessionScores:        _q.withSessionScores.Clone(),
+		withReviewActivities:     _q.withReviewActivities.Clone(),
+		withClaims:               _q.withClaims.Clone(),
+		withMemories:             _q.withMemories.Clone(),
+		withInjectedMemories:     _q.withInjectedMemories.Clone(),
+		withRedactionReviews:     _q.withRedactionReviews.Clone(),
+		withHandoffNoteRevisions: _q.withHandoffNoteRevisions.Clone(),
+		withComparison:           _q.withComparison.Clone(),
+		withNextComparisons:      _q.withNextComparisons.Clone(),
+		withActionItems:          _q.withActionItems.Clone(),
+		withGroup:                _q.withGroup.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -669,6 +843,17 @@ func (_q *AlertSessionQuery) WithReviewActivities(opts ...func(*SessionReviewAct
 	return _q
 }
 
+// WithClaims tells the query-builder to eager-load the nodes that are connected to
+// the "claims" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AlertSessionQuery) WithClaims(opts ...func(*SessionClaimQuery)) *AlertSessionQuery {
+	query := (&SessionClaimClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withClaims = query
+	return _q
+}
+
 // WithMemories tells the query-builder to eager-load the nodes that are connected to
 // the "memories" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *AlertSessionQuery) WithMemories(opts ...func(*InvestigationMemoryQuery)) *AlertSessionQuery {
@@ -691,18 +876,84 @@ func (_q *AlertSessionQuery) WithInjectedMemories(opts ...func(*InvestigationMem
 	return _q
 }
 
+// WithRedactionReviews tells the query-builder to eager-load the nodes that are connected to
+// the "redaction_reviews" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AlertSessionQuery) WithRedactionReviews(opts ...func(*RedactionReviewQuery)) *AlertSessionQuery {
+	query := (&RedactionReviewClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRedactionReviews = query
+	return _q
+}
+
+// WithHandoffNoteRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "handoff_note_revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AlertSessionQuery) WithHandoffNoteRevisions(opts ...func(*HandoffNoteRevisionQuery)) *AlertSessionQuery {
+	query := (&HandoffNoteRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHandoffNoteRevisions = query
+	return _q
+}
+
+// WithComparison tells the query-builder to eager-load the nodes that are connected to
+// the "comparison" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AlertSessionQuery) WithComparison(opts ...func(*SessionComparisonQuery)) *AlertSessionQuery {
+	query := (&SessionComparisonClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withComparison = query
+	return _q
+}
+
+// WithNextComparisons tells the query-builder to eager-load the nodes that are connected to
+// the "next_comparisons" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AlertSessionQuery) WithNextComparisons(opts ...func(*SessionComparisonQuery)) *AlertSessionQuery {
+	query := (&SessionComparisonClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNextComparisons = query
+	return _q
+}
+
+// WithActionItems tells the query-builder to eager-load the nodes that are connected to
+// the "action_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AlertSessionQuery) WithActionItems(opts ...func(*ActionItemQuery)) *AlertSessionQuery {
+	query := (&ActionItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withActionItems = query
+	return _q
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AlertSessionQuery) WithGroup(opts ...func(*SessionGroupQuery)) *AlertSessionQuery {
+	query := (&SessionGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroup = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		AlertData string `json:"alert_data,omitempty"`
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.AlertSession.Query().
-//		GroupBy(alertsession.FieldAlertData).
+//		GroupBy(alertsession.FieldUpdatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *AlertSessionQuery) GroupBy(field string, fields ...string) *AlertSessionGroupBy {
@@ -720,11 +971,11 @@ func (_q *AlertSessionQuery) GroupBy(field string, fields ...string) *AlertSessi
 // Example:
 //
 //	var v []struct {
-//		AlertData string `json:"alert_data,omitempty"`
+//		UpdatedAt time.Time `json:"updated_at,omitempty"`
 //	}
 //
 //	client.AlertSession.Query().
-//		Select(alertsession.FieldAlertData).
+//		Select(alertsession.FieldUpdatedAt).
 //		Scan(ctx, &v)
 func (_q *AlertSessionQuery) Select(fields ...string) *AlertSessionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -769,7 +1020,7 @@ func (_q *AlertSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*AlertSession{}
 		_spec       = _q.querySpec()
-		loadedTypes = [12]bool{
+		loadedTypes = [19]bool{
 			_q.withStages != nil,
 			_q.withAgentExecutions != nil,
 			_q.withTimelineEvents != nil,
@@ -780,8 +1031,15 @@ func (_q *AlertSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			_q.withChat != nil,
 			_q.withSessionScores != nil,
 			_q.withReviewActivities != nil,
+			_q.withClaims != nil,
 			_q.withMemories != nil,
 			_q.withInjectedMemories != nil,
+			_q.withRedactionReviews != nil,
+			_q.withHandoffNoteRevisions != nil,
+			_q.withComparison != nil,
+			_q.withNextComparisons != nil,
+			_q.withActionItems != nil,
+			_q.withGroup != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -876,6 +1134,13 @@ func (_q *AlertSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withClaims; query != nil {
+		if err := _q.loadClaims(ctx, query, nodes,
+			func(n *AlertSession) { n.Edges.Claims = []*SessionClaim{} },
+			func(n *AlertSession, e *SessionClaim) { n.Edges.Claims = append(n.Edges.Claims, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withMemories; query != nil {
 		if err := _q.loadMemories(ctx, query, nodes,
 			func(n *AlertSession) { n.Edges.Memories = []*InvestigationMemory{} },
@@ -892,6 +1157,52 @@ func (_q *AlertSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withRedactionReviews; query != nil {
+		if err := _q.loadRedactionReviews(ctx, query, nodes,
+			func(n *AlertSession) { n.Edges.RedactionReviews = []*RedactionReview{} },
+			func(n *AlertSession, e *RedactionReview) {
+				n.Edges.RedactionReviews = append(n.Edges.RedactionReviews, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHandoffNoteRevisions; query != nil {
+		if err := _q.loadHandoffNoteRevisions(ctx, query, nodes,
+			func(n *AlertSession) { n.Edges.HandoffNoteRevisions = []*HandoffNoteRevision{} },
+			func(n *AlertSession, e *HandoffNoteRevision) {
+				n.Edges.HandoffNoteRevisions = append(n.Edges.HandoffNoteRevisions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withComparison; query != nil {
+		if err := _q.loadComparison(ctx, query, nodes, nil,
+			func(n *AlertSession, e *SessionComparison) { n.Edges.Comparison = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNextComparisons; query != nil {
+		if err := _q.loadNextComparisons(ctx, query, nodes,
+			func(n *AlertSession) { n.Edges.NextComparisons = []*SessionComparison{} },
+			func(n *AlertSession, e *SessionComparison) {
+				n.Edges.NextComparisons = append(n.Edges.NextComparisons, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withActionItems; query != nil {
+		if err := _q.loadActionItems(ctx, query, nodes,
+			func(n *AlertSession) { n.Edges.ActionItems = []*ActionItem{} },
+			func(n *AlertSession, e *ActionItem) { n.Edges.ActionItems = append(n.Edges.ActionItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGroup; query != nil {
+		if err := _q.loadGroup(ctx, query, nodes, nil,
+			func(n *AlertSession, e *SessionGroup) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1192,6 +1503,36 @@ func (_q *AlertSessionQuery) loadReviewActivities(ctx context.Context, query *Se
 	}
 	return nil
 }
+func (_q *AlertSessionQuery) loadClaims(ctx context.Context, query *SessionClaimQuery, nodes []*AlertSession, init func(*AlertSession), assign func(*AlertSession, *SessionClaim)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*AlertSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sessionclaim.FieldSessionID)
+	}
+	query.Where(predicate.SessionClaim(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(alertsession.ClaimsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *AlertSessionQuery) loadMemories(ctx context.Context, query *InvestigationMemoryQuery, nodes []*AlertSession, init func(*AlertSession), assign func(*AlertSession, *InvestigationMemory)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*AlertSession)
@@ -1283,6 +1624,185 @@ func (_q *AlertSessionQuery) loadInjectedMemories(ctx context.Context, query *In
 	}
 	return nil
 }
+func (_q *AlertSessionQuery) loadRedactionReviews(ctx context.Context, query *RedactionReviewQuery, nodes []*AlertSession, init func(*AlertSession), assign func(*AlertSession, *RedactionReview)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*AlertSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(redactionreview.FieldSessionID)
+	}
+	query.Where(predicate.RedactionReview(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(alertsession.RedactionReviewsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AlertSessionQuery) loadHandoffNoteRevisions(ctx context.Context, query *HandoffNoteRevisionQuery, nodes []*AlertSession, init func(*AlertSession), assign func(*AlertSession, *HandoffNoteRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*AlertSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(handoffnoterevision.FieldSessionID)
+	}
+	query.Where(predicate.HandoffNoteRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(alertsession.HandoffNoteRevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AlertSessionQuery) loadComparison(ctx context.Context, query *SessionComparisonQuery, nodes []*AlertSession, init func(*AlertSession), assign func(*AlertSession, *SessionComparison)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*AlertSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sessioncomparison.FieldSessionID)
+	}
+	query.Where(predicate.SessionComparison(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(alertsession.ComparisonColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AlertSessionQuery) loadNextComparisons(ctx context.Context, query *SessionComparisonQuery, nodes []*AlertSession, init func(*AlertSession), assign func(*AlertSession, *SessionComparison)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*AlertSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(sessioncomparison.FieldPreviousSessionID)
+	}
+	query.Where(predicate.SessionComparison(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(alertsession.NextComparisonsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PreviousSessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "previous_session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AlertSessionQuery) loadActionItems(ctx context.Context, query *ActionItemQuery, nodes []*AlertSession, init func(*AlertSession), assign func(*AlertSession, *ActionItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*AlertSession)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(actionitem.FieldSessionID)
+	}
+	query.Where(predicate.ActionItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(alertsession.ActionItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SessionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "session_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AlertSessionQuery) loadGroup(ctx context.Context, query *SessionGroupQuery, nodes []*AlertSession, init func(*AlertSession), assign func(*AlertSession, *SessionGroup)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*AlertSession)
+	for i := range nodes {
+		if nodes[i].GroupID == nil {
+			continue
+		}
+		fk := *nodes[i].GroupID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sessiongroup.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *AlertSessionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -1312,6 +1832,9 @@ func (_q *AlertSessionQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withGroup != nil {
+			_spec.Node.AddColumnOnce(alertsession.FieldGroupID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -10,16 +10,23 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
 	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
 	"github.com/codeready-toolchain/tarsy/ent/event"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
 	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
 	"github.com/codeready-toolchain/tarsy/ent/message"
 	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
 	"github.com/codeready-toolchain/tarsy/ent/sessionreviewactivity"
 	"github.com/codeready-toolchain/tarsy/ent/sessionscore"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
@@ -40,6 +47,32 @@ func (_u *AlertSessionUpdate) Where(ps ...predicate.AlertSession) *AlertSessionU
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlertSessionUpdate) SetUpdatedAt(v time.Time) *AlertSessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AlertSessionUpdate) SetDeletedAt(v time.Time) *AlertSessionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableDeletedAt(v *time.Time) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AlertSessionUpdate) ClearDeletedAt() *AlertSessionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetAlertData sets the "alert_data" field.
 func (_u *AlertSessionUpdate) SetAlertData(v string) *AlertSessionUpdate {
 	_u.mutation.SetAlertData(v)
@@ -236,6 +269,146 @@ func (_u *AlertSessionUpdate) ClearExecutiveSummaryError() *AlertSessionUpdate {
 	return _u
 }
 
+// SetExecutiveSummaryTemplate sets the "executive_summary_template" field.
+func (_u *AlertSessionUpdate) SetExecutiveSummaryTemplate(v string) *AlertSessionUpdate {
+	_u.mutation.SetExecutiveSummaryTemplate(v)
+	return _u
+}
+
+// SetNillableExecutiveSummaryTemplate sets the "executive_summary_template" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableExecutiveSummaryTemplate(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetExecutiveSummaryTemplate(*v)
+	}
+	return _u
+}
+
+// ClearExecutiveSummaryTemplate clears the value of the "executive_summary_template" field.
+func (_u *AlertSessionUpdate) ClearExecutiveSummaryTemplate() *AlertSessionUpdate {
+	_u.mutation.ClearExecutiveSummaryTemplate()
+	return _u
+}
+
+// SetTechnicalSummary sets the "technical_summary" field.
+func (_u *AlertSessionUpdate) SetTechnicalSummary(v string) *AlertSessionUpdate {
+	_u.mutation.SetTechnicalSummary(v)
+	return _u
+}
+
+// SetNillableTechnicalSummary sets the "technical_summary" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableTechnicalSummary(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetTechnicalSummary(*v)
+	}
+	return _u
+}
+
+// ClearTechnicalSummary clears the value of the "technical_summary" field.
+func (_u *AlertSessionUpdate) ClearTechnicalSummary() *AlertSessionUpdate {
+	_u.mutation.ClearTechnicalSummary()
+	return _u
+}
+
+// SetTechnicalSummaryError sets the "technical_summary_error" field.
+func (_u *AlertSessionUpdate) SetTechnicalSummaryError(v string) *AlertSessionUpdate {
+	_u.mutation.SetTechnicalSummaryError(v)
+	return _u
+}
+
+// SetNillableTechnicalSummaryError sets the "technical_summary_error" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableTechnicalSummaryError(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetTechnicalSummaryError(*v)
+	}
+	return _u
+}
+
+// ClearTechnicalSummaryError clears the value of the "technical_summary_error" field.
+func (_u *AlertSessionUpdate) ClearTechnicalSummaryError() *AlertSessionUpdate {
+	_u.mutation.ClearTechnicalSummaryError()
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *AlertSessionUpdate) SetSeverity(v alertsession.Severity) *AlertSessionUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableSeverity(v *alertsession.Severity) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (_u *AlertSessionUpdate) ClearSeverity() *AlertSessionUpdate {
+	_u.mutation.ClearSeverity()
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *AlertSessionUpdate) SetOutcome(v alertsession.Outcome) *AlertSessionUpdate {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableOutcome(v *alertsession.Outcome) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// ClearOutcome clears the value of the "outcome" field.
+func (_u *AlertSessionUpdate) ClearOutcome() *AlertSessionUpdate {
+	_u.mutation.ClearOutcome()
+	return _u
+}
+
+// SetOutcomeReason sets the "outcome_reason" field.
+func (_u *AlertSessionUpdate) SetOutcomeReason(v string) *AlertSessionUpdate {
+	_u.mutation.SetOutcomeReason(v)
+	return _u
+}
+
+// SetNillableOutcomeReason sets the "outcome_reason" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableOutcomeReason(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetOutcomeReason(*v)
+	}
+	return _u
+}
+
+// ClearOutcomeReason clears the value of the "outcome_reason" field.
+func (_u *AlertSessionUpdate) ClearOutcomeReason() *AlertSessionUpdate {
+	_u.mutation.ClearOutcomeReason()
+	return _u
+}
+
+// SetOutcomeSource sets the "outcome_source" field.
+func (_u *AlertSessionUpdate) SetOutcomeSource(v alertsession.OutcomeSource) *AlertSessionUpdate {
+	_u.mutation.SetOutcomeSource(v)
+	return _u
+}
+
+// SetNillableOutcomeSource sets the "outcome_source" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableOutcomeSource(v *alertsession.OutcomeSource) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetOutcomeSource(*v)
+	}
+	return _u
+}
+
+// ClearOutcomeSource clears the value of the "outcome_source" field.
+func (_u *AlertSessionUpdate) ClearOutcomeSource() *AlertSessionUpdate {
+	_u.mutation.ClearOutcomeSource()
+	return _u
+}
+
 // SetSessionMetadata sets the "session_metadata" field.
 func (_u *AlertSessionUpdate) SetSessionMetadata(v map[string]interface{}) *AlertSessionUpdate {
 	_u.mutation.SetSessionMetadata(v)
@@ -268,6 +441,26 @@ func (_u *AlertSessionUpdate) ClearAuthor() *AlertSessionUpdate {
 	return _u
 }
 
+// SetAuthorSubject sets the "author_subject" field.
+func (_u *AlertSessionUpdate) SetAuthorSubject(v string) *AlertSessionUpdate {
+	_u.mutation.SetAuthorSubject(v)
+	return _u
+}
+
+// SetNillableAuthorSubject sets the "author_subject" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableAuthorSubject(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetAuthorSubject(*v)
+	}
+	return _u
+}
+
+// ClearAuthorSubject clears the value of the "author_subject" field.
+func (_u *AlertSessionUpdate) ClearAuthorSubject() *AlertSessionUpdate {
+	_u.mutation.ClearAuthorSubject()
+	return _u
+}
+
 // SetRunbookURL sets the "runbook_url" field.
 func (_u *AlertSessionUpdate) SetRunbookURL(v string) *AlertSessionUpdate {
 	_u.mutation.SetRunbookURL(v)
@@ -300,6 +493,56 @@ func (_u *AlertSessionUpdate) ClearMcpSelection() *AlertSessionUpdate {
 	return _u
 }
 
+// SetAlertInstructions sets the "alert_instructions" field.
+func (_u *AlertSessionUpdate) SetAlertInstructions(v map[string]interface{}) *AlertSessionUpdate {
+	_u.mutation.SetAlertInstructions(v)
+	return _u
+}
+
+// ClearAlertInstructions clears the value of the "alert_instructions" field.
+func (_u *AlertSessionUpdate) ClearAlertInstructions() *AlertSessionUpdate {
+	_u.mutation.ClearAlertInstructions()
+	return _u
+}
+
+// SetAlertImages sets the "alert_images" field.
+func (_u *AlertSessionUpdate) SetAlertImages(v []schema.ImageRef) *AlertSessionUpdate {
+	_u.mutation.SetAlertImages(v)
+	return _u
+}
+
+// AppendAlertImages appends value to the "alert_images" field.
+func (_u *AlertSessionUpdate) AppendAlertImages(v []schema.ImageRef) *AlertSessionUpdate {
+	_u.mutation.AppendAlertImages(v)
+	return _u
+}
+
+// ClearAlertImages clears the value of the "alert_images" field.
+func (_u *AlertSessionUpdate) ClearAlertImages() *AlertSessionUpdate {
+	_u.mutation.ClearAlertImages()
+	return _u
+}
+
+// SetOutputLanguage sets the "output_language" field.
+func (_u *AlertSessionUpdate) SetOutputLanguage(v string) *AlertSessionUpdate {
+	_u.mutation.SetOutputLanguage(v)
+	return _u
+}
+
+// SetNillableOutputLanguage sets the "output_language" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableOutputLanguage(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetOutputLanguage(*v)
+	}
+	return _u
+}
+
+// ClearOutputLanguage clears the value of the "output_language" field.
+func (_u *AlertSessionUpdate) ClearOutputLanguage() *AlertSessionUpdate {
+	_u.mutation.ClearOutputLanguage()
+	return _u
+}
+
 // SetChainID sets the "chain_id" field.
 func (_u *AlertSessionUpdate) SetChainID(v string) *AlertSessionUpdate {
 	_u.mutation.SetChainID(v)
@@ -314,6 +557,26 @@ func (_u *AlertSessionUpdate) SetNillableChainID(v *string) *AlertSessionUpdate
 	return _u
 }
 
+// SetOwner sets the "owner" field.
+func (_u *AlertSessionUpdate) SetOwner(v string) *AlertSessionUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableOwner(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *AlertSessionUpdate) ClearOwner() *AlertSessionUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
 // SetCurrentStageIndex sets the "current_stage_index" field.
 func (_u *AlertSessionUpdate) SetCurrentStageIndex(v int) *AlertSessionUpdate {
 	_u.mutation.ResetCurrentStageIndex()
@@ -421,770 +684,1260 @@ func (_u *AlertSessionUpdate) ClearSlackMessageFingerprint() *AlertSessionUpdate
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *AlertSessionUpdate) SetDeletedAt(v time.Time) *AlertSessionUpdate {
-	_u.mutation.SetDeletedAt(v)
+// SetAlertKey sets the "alert_key" field.
+func (_u *AlertSessionUpdate) SetAlertKey(v string) *AlertSessionUpdate {
+	_u.mutation.SetAlertKey(v)
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableDeletedAt(v *time.Time) *AlertSessionUpdate {
+// SetNillableAlertKey sets the "alert_key" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableAlertKey(v *string) *AlertSessionUpdate {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetAlertKey(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *AlertSessionUpdate) ClearDeletedAt() *AlertSessionUpdate {
-	_u.mutation.ClearDeletedAt()
+// ClearAlertKey clears the value of the "alert_key" field.
+func (_u *AlertSessionUpdate) ClearAlertKey() *AlertSessionUpdate {
+	_u.mutation.ClearAlertKey()
 	return _u
 }
 
-// SetReviewStatus sets the "review_status" field.
-func (_u *AlertSessionUpdate) SetReviewStatus(v alertsession.ReviewStatus) *AlertSessionUpdate {
-	_u.mutation.SetReviewStatus(v)
+// SetAlertResolvedAt sets the "alert_resolved_at" field.
+func (_u *AlertSessionUpdate) SetAlertResolvedAt(v time.Time) *AlertSessionUpdate {
+	_u.mutation.SetAlertResolvedAt(v)
 	return _u
 }
 
-// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableReviewStatus(v *alertsession.ReviewStatus) *AlertSessionUpdate {
+// SetNillableAlertResolvedAt sets the "alert_resolved_at" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableAlertResolvedAt(v *time.Time) *AlertSessionUpdate {
 	if v != nil {
-		_u.SetReviewStatus(*v)
+		_u.SetAlertResolvedAt(*v)
 	}
 	return _u
 }
 
-// ClearReviewStatus clears the value of the "review_status" field.
-func (_u *AlertSessionUpdate) ClearReviewStatus() *AlertSessionUpdate {
-	_u.mutation.ClearReviewStatus()
+// ClearAlertResolvedAt clears the value of the "alert_resolved_at" field.
+func (_u *AlertSessionUpdate) ClearAlertResolvedAt() *AlertSessionUpdate {
+	_u.mutation.ClearAlertResolvedAt()
 	return _u
 }
 
-// SetAssignee sets the "assignee" field.
-func (_u *AlertSessionUpdate) SetAssignee(v string) *AlertSessionUpdate {
-	_u.mutation.SetAssignee(v)
+// SetAlertResolution sets the "alert_resolution" field.
+func (_u *AlertSessionUpdate) SetAlertResolution(v string) *AlertSessionUpdate {
+	_u.mutation.SetAlertResolution(v)
 	return _u
 }
 
-// SetNillableAssignee sets the "assignee" field if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableAssignee(v *string) *AlertSessionUpdate {
+// SetNillableAlertResolution sets the "alert_resolution" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableAlertResolution(v *string) *AlertSessionUpdate {
 	if v != nil {
-		_u.SetAssignee(*v)
+		_u.SetAlertResolution(*v)
 	}
 	return _u
 }
 
-// ClearAssignee clears the value of the "assignee" field.
-func (_u *AlertSessionUpdate) ClearAssignee() *AlertSessionUpdate {
-	_u.mutation.ClearAssignee()
+// ClearAlertResolution clears the value of the "alert_resolution" field.
+func (_u *AlertSessionUpdate) ClearAlertResolution() *AlertSessionUpdate {
+	_u.mutation.ClearAlertResolution()
 	return _u
 }
 
-// SetAssignedAt sets the "assigned_at" field.
-func (_u *AlertSessionUpdate) SetAssignedAt(v time.Time) *AlertSessionUpdate {
-	_u.mutation.SetAssignedAt(v)
+// SetCancelInitiator sets the "cancel_initiator" field.
+func (_u *AlertSessionUpdate) SetCancelInitiator(v alertsession.CancelInitiator) *AlertSessionUpdate {
+	_u.mutation.SetCancelInitiator(v)
 	return _u
 }
 
-// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableAssignedAt(v *time.Time) *AlertSessionUpdate {
+// SetNillableCancelInitiator sets the "cancel_initiator" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableCancelInitiator(v *alertsession.CancelInitiator) *AlertSessionUpdate {
 	if v != nil {
-		_u.SetAssignedAt(*v)
+		_u.SetCancelInitiator(*v)
 	}
 	return _u
 }
 
-// ClearAssignedAt clears the value of the "assigned_at" field.
-func (_u *AlertSessionUpdate) ClearAssignedAt() *AlertSessionUpdate {
-	_u.mutation.ClearAssignedAt()
+// ClearCancelInitiator clears the value of the "cancel_initiator" field.
+func (_u *AlertSessionUpdate) ClearCancelInitiator() *AlertSessionUpdate {
+	_u.mutation.ClearCancelInitiator()
 	return _u
 }
 
-// SetReviewedAt sets the "reviewed_at" field.
-func (_u *AlertSessionUpdate) SetReviewedAt(v time.Time) *AlertSessionUpdate {
-	_u.mutation.SetReviewedAt(v)
+// SetCancelReason sets the "cancel_reason" field.
+func (_u *AlertSessionUpdate) SetCancelReason(v string) *AlertSessionUpdate {
+	_u.mutation.SetCancelReason(v)
 	return _u
 }
 
-// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableReviewedAt(v *time.Time) *AlertSessionUpdate {
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableCancelReason(v *string) *AlertSessionUpdate {
 	if v != nil {
-		_u.SetReviewedAt(*v)
+		_u.SetCancelReason(*v)
 	}
 	return _u
 }
 
-// ClearReviewedAt clears the value of the "reviewed_at" field.
-func (_u *AlertSessionUpdate) ClearReviewedAt() *AlertSessionUpdate {
-	_u.mutation.ClearReviewedAt()
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (_u *AlertSessionUpdate) ClearCancelReason() *AlertSessionUpdate {
+	_u.mutation.ClearCancelReason()
 	return _u
 }
 
-// SetQualityRating sets the "quality_rating" field.
-func (_u *AlertSessionUpdate) SetQualityRating(v alertsession.QualityRating) *AlertSessionUpdate {
-	_u.mutation.SetQualityRating(v)
+// SetCancelledBy sets the "cancelled_by" field.
+func (_u *AlertSessionUpdate) SetCancelledBy(v string) *AlertSessionUpdate {
+	_u.mutation.SetCancelledBy(v)
 	return _u
 }
 
-// SetNillableQualityRating sets the "quality_rating" field if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableQualityRating(v *alertsession.QualityRating) *AlertSessionUpdate {
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableCancelledBy(v *string) *AlertSessionUpdate {
 	if v != nil {
-		_u.SetQualityRating(*v)
+		_u.SetCancelledBy(*v)
 	}
 	return _u
 }
 
-// ClearQualityRating clears the value of the "quality_rating" field.
-func (_u *AlertSessionUpdate) ClearQualityRating() *AlertSessionUpdate {
-	_u.mutation.ClearQualityRating()
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (_u *AlertSessionUpdate) ClearCancelledBy() *AlertSessionUpdate {
+	_u.mutation.ClearCancelledBy()
 	return _u
 }
 
-// SetActionTaken sets the "action_taken" field.
-func (_u *AlertSessionUpdate) SetActionTaken(v string) *AlertSessionUpdate {
-	_u.mutation.SetActionTaken(v)
+// SetRequestID sets the "request_id" field.
+func (_u *AlertSessionUpdate) SetRequestID(v string) *AlertSessionUpdate {
+	_u.mutation.SetRequestID(v)
 	return _u
 }
 
-// SetNillableActionTaken sets the "action_taken" field if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableActionTaken(v *string) *AlertSessionUpdate {
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableRequestID(v *string) *AlertSessionUpdate {
 	if v != nil {
-		_u.SetActionTaken(*v)
+		_u.SetRequestID(*v)
 	}
 	return _u
 }
 
-// ClearActionTaken clears the value of the "action_taken" field.
-func (_u *AlertSessionUpdate) ClearActionTaken() *AlertSessionUpdate {
-	_u.mutation.ClearActionTaken()
+// ClearRequestID clears the value of the "request_id" field.
+func (_u *AlertSessionUpdate) ClearRequestID() *AlertSessionUpdate {
+	_u.mutation.ClearRequestID()
 	return _u
 }
 
-// SetInvestigationFeedback sets the "investigation_feedback" field.
-func (_u *AlertSessionUpdate) SetInvestigationFeedback(v string) *AlertSessionUpdate {
-	_u.mutation.SetInvestigationFeedback(v)
+// SetDegradedReason sets the "degraded_reason" field.
+func (_u *AlertSessionUpdate) SetDegradedReason(v string) *AlertSessionUpdate {
+	_u.mutation.SetDegradedReason(v)
 	return _u
 }
 
-// SetNillableInvestigationFeedback sets the "investigation_feedback" field if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableInvestigationFeedback(v *string) *AlertSessionUpdate {
+// SetNillableDegradedReason sets the "degraded_reason" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableDegradedReason(v *string) *AlertSessionUpdate {
 	if v != nil {
-		_u.SetInvestigationFeedback(*v)
+		_u.SetDegradedReason(*v)
 	}
 	return _u
 }
 
-// ClearInvestigationFeedback clears the value of the "investigation_feedback" field.
-func (_u *AlertSessionUpdate) ClearInvestigationFeedback() *AlertSessionUpdate {
-	_u.mutation.ClearInvestigationFeedback()
+// ClearDegradedReason clears the value of the "degraded_reason" field.
+func (_u *AlertSessionUpdate) ClearDegradedReason() *AlertSessionUpdate {
+	_u.mutation.ClearDegradedReason()
 	return _u
 }
 
-// AddStageIDs adds the "stages" edge to the Stage entity by IDs.
-func (_u *AlertSessionUpdate) AddStageIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddStageIDs(ids...)
+// SetModelRouting sets the "model_routing" field.
+func (_u *AlertSessionUpdate) SetModelRouting(v *schema.ModelRoutingDecision) *AlertSessionUpdate {
+	_u.mutation.SetModelRouting(v)
 	return _u
 }
 
-// AddStages adds the "stages" edges to the Stage entity.
-func (_u *AlertSessionUpdate) AddStages(v ...*Stage) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddStageIDs(ids...)
+// ClearModelRouting clears the value of the "model_routing" field.
+func (_u *AlertSessionUpdate) ClearModelRouting() *AlertSessionUpdate {
+	_u.mutation.ClearModelRouting()
+	return _u
 }
 
-// AddAgentExecutionIDs adds the "agent_executions" edge to the AgentExecution entity by IDs.
-func (_u *AlertSessionUpdate) AddAgentExecutionIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddAgentExecutionIDs(ids...)
+// SetNoiseTriage sets the "noise_triage" field.
+func (_u *AlertSessionUpdate) SetNoiseTriage(v *schema.NoiseTriageDecision) *AlertSessionUpdate {
+	_u.mutation.SetNoiseTriage(v)
 	return _u
 }
 
-// AddAgentExecutions adds the "agent_executions" edges to the AgentExecution entity.
-func (_u *AlertSessionUpdate) AddAgentExecutions(v ...*AgentExecution) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddAgentExecutionIDs(ids...)
+// ClearNoiseTriage clears the value of the "noise_triage" field.
+func (_u *AlertSessionUpdate) ClearNoiseTriage() *AlertSessionUpdate {
+	_u.mutation.ClearNoiseTriage()
+	return _u
 }
 
-// AddTimelineEventIDs adds the "timeline_events" edge to the TimelineEvent entity by IDs.
-func (_u *AlertSessionUpdate) AddTimelineEventIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddTimelineEventIDs(ids...)
+// SetFederationOrigin sets the "federation_origin" field.
+func (_u *AlertSessionUpdate) SetFederationOrigin(v *schema.FederationOrigin) *AlertSessionUpdate {
+	_u.mutation.SetFederationOrigin(v)
 	return _u
 }
 
-// AddTimelineEvents adds the "timeline_events" edges to the TimelineEvent entity.
-func (_u *AlertSessionUpdate) AddTimelineEvents(v ...*TimelineEvent) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddTimelineEventIDs(ids...)
+// ClearFederationOrigin clears the value of the "federation_origin" field.
+func (_u *AlertSessionUpdate) ClearFederationOrigin() *AlertSessionUpdate {
+	_u.mutation.ClearFederationOrigin()
+	return _u
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
-func (_u *AlertSessionUpdate) AddMessageIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddMessageIDs(ids...)
+// SetTarget sets the "target" field.
+func (_u *AlertSessionUpdate) SetTarget(v *schema.AlertTarget) *AlertSessionUpdate {
+	_u.mutation.SetTarget(v)
 	return _u
 }
 
-// AddMessages adds the "messages" edges to the Message entity.
-func (_u *AlertSessionUpdate) AddMessages(v ...*Message) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddMessageIDs(ids...)
+// ClearTarget clears the value of the "target" field.
+func (_u *AlertSessionUpdate) ClearTarget() *AlertSessionUpdate {
+	_u.mutation.ClearTarget()
+	return _u
 }
 
-// AddLlmInteractionIDs adds the "llm_interactions" edge to the LLMInteraction entity by IDs.
-func (_u *AlertSessionUpdate) AddLlmInteractionIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddLlmInteractionIDs(ids...)
+// SetOnCall sets the "on_call" field.
+func (_u *AlertSessionUpdate) SetOnCall(v *schema.OnCallContact) *AlertSessionUpdate {
+	_u.mutation.SetOnCall(v)
 	return _u
 }
 
-// AddLlmInteractions adds the "llm_interactions" edges to the LLMInteraction entity.
-func (_u *AlertSessionUpdate) AddLlmInteractions(v ...*LLMInteraction) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddLlmInteractionIDs(ids...)
+// ClearOnCall clears the value of the "on_call" field.
+func (_u *AlertSessionUpdate) ClearOnCall() *AlertSessionUpdate {
+	_u.mutation.ClearOnCall()
+	return _u
 }
 
-// AddMcpInteractionIDs adds the "mcp_interactions" edge to the MCPInteraction entity by IDs.
-func (_u *AlertSessionUpdate) AddMcpInteractionIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddMcpInteractionIDs(ids...)
+// SetCallbackStatus sets the "callback_status" field.
+func (_u *AlertSessionUpdate) SetCallbackStatus(v alertsession.CallbackStatus) *AlertSessionUpdate {
+	_u.mutation.SetCallbackStatus(v)
 	return _u
 }
 
-// AddMcpInteractions adds the "mcp_interactions" edges to the MCPInteraction entity.
-func (_u *AlertSessionUpdate) AddMcpInteractions(v ...*MCPInteraction) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableCallbackStatus sets the "callback_status" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableCallbackStatus(v *alertsession.CallbackStatus) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetCallbackStatus(*v)
 	}
-	return _u.AddMcpInteractionIDs(ids...)
-}
-
-// AddEventIDs adds the "events" edge to the Event entity by IDs.
-func (_u *AlertSessionUpdate) AddEventIDs(ids ...int) *AlertSessionUpdate {
-	_u.mutation.AddEventIDs(ids...)
 	return _u
 }
 
-// AddEvents adds the "events" edges to the Event entity.
-func (_u *AlertSessionUpdate) AddEvents(v ...*Event) *AlertSessionUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddEventIDs(ids...)
+// ClearCallbackStatus clears the value of the "callback_status" field.
+func (_u *AlertSessionUpdate) ClearCallbackStatus() *AlertSessionUpdate {
+	_u.mutation.ClearCallbackStatus()
+	return _u
 }
 
-// SetChatID sets the "chat" edge to the Chat entity by ID.
-func (_u *AlertSessionUpdate) SetChatID(id string) *AlertSessionUpdate {
-	_u.mutation.SetChatID(id)
+// SetCallbackAttempts sets the "callback_attempts" field.
+func (_u *AlertSessionUpdate) SetCallbackAttempts(v int) *AlertSessionUpdate {
+	_u.mutation.ResetCallbackAttempts()
+	_u.mutation.SetCallbackAttempts(v)
 	return _u
 }
 
-// SetNillableChatID sets the "chat" edge to the Chat entity by ID if the given value is not nil.
-func (_u *AlertSessionUpdate) SetNillableChatID(id *string) *AlertSessionUpdate {
-	if id != nil {
-		_u = _u.SetChatID(*id)
+// SetNillableCallbackAttempts sets the "callback_attempts" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableCallbackAttempts(v *int) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetCallbackAttempts(*v)
 	}
 	return _u
 }
 
-// SetChat sets the "chat" edge to the Chat entity.
-func (_u *AlertSessionUpdate) SetChat(v *Chat) *AlertSessionUpdate {
-	return _u.SetChatID(v.ID)
+// AddCallbackAttempts adds value to the "callback_attempts" field.
+func (_u *AlertSessionUpdate) AddCallbackAttempts(v int) *AlertSessionUpdate {
+	_u.mutation.AddCallbackAttempts(v)
+	return _u
 }
 
-// AddSessionScoreIDs adds the "session_scores" edge to the SessionScore entity by IDs.
-func (_u *AlertSessionUpdate) AddSessionScoreIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddSessionScoreIDs(ids...)
+// SetCallbackLastError sets the "callback_last_error" field.
+func (_u *AlertSessionUpdate) SetCallbackLastError(v string) *AlertSessionUpdate {
+	_u.mutation.SetCallbackLastError(v)
 	return _u
 }
 
-// AddSessionScores adds the "session_scores" edges to the SessionScore entity.
-func (_u *AlertSessionUpdate) AddSessionScores(v ...*SessionScore) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableCallbackLastError sets the "callback_last_error" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableCallbackLastError(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetCallbackLastError(*v)
 	}
-	return _u.AddSessionScoreIDs(ids...)
-}
-
-// AddReviewActivityIDs adds the "review_activities" edge to the SessionReviewActivity entity by IDs.
-func (_u *AlertSessionUpdate) AddReviewActivityIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddReviewActivityIDs(ids...)
 	return _u
 }
 
-// AddReviewActivities adds the "review_activities" edges to the SessionReviewActivity entity.
-func (_u *AlertSessionUpdate) AddReviewActivities(v ...*SessionReviewActivity) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddReviewActivityIDs(ids...)
+// ClearCallbackLastError clears the value of the "callback_last_error" field.
+func (_u *AlertSessionUpdate) ClearCallbackLastError() *AlertSessionUpdate {
+	_u.mutation.ClearCallbackLastError()
+	return _u
 }
 
-// AddMemoryIDs adds the "memories" edge to the InvestigationMemory entity by IDs.
-func (_u *AlertSessionUpdate) AddMemoryIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddMemoryIDs(ids...)
+// SetCallbackDeliveredAt sets the "callback_delivered_at" field.
+func (_u *AlertSessionUpdate) SetCallbackDeliveredAt(v time.Time) *AlertSessionUpdate {
+	_u.mutation.SetCallbackDeliveredAt(v)
 	return _u
 }
 
-// AddMemories adds the "memories" edges to the InvestigationMemory entity.
-func (_u *AlertSessionUpdate) AddMemories(v ...*InvestigationMemory) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableCallbackDeliveredAt sets the "callback_delivered_at" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableCallbackDeliveredAt(v *time.Time) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetCallbackDeliveredAt(*v)
 	}
-	return _u.AddMemoryIDs(ids...)
+	return _u
 }
 
-// AddInjectedMemoryIDs adds the "injected_memories" edge to the InvestigationMemory entity by IDs.
-func (_u *AlertSessionUpdate) AddInjectedMemoryIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.AddInjectedMemoryIDs(ids...)
+// ClearCallbackDeliveredAt clears the value of the "callback_delivered_at" field.
+func (_u *AlertSessionUpdate) ClearCallbackDeliveredAt() *AlertSessionUpdate {
+	_u.mutation.ClearCallbackDeliveredAt()
 	return _u
 }
 
-// AddInjectedMemories adds the "injected_memories" edges to the InvestigationMemory entity.
-func (_u *AlertSessionUpdate) AddInjectedMemories(v ...*InvestigationMemory) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.AddInjectedMemoryIDs(ids...)
+// SetReviewStatus sets the "review_status" field.
+func (_u *AlertSessionUpdate) SetReviewStatus(v alertsession.ReviewStatus) *AlertSessionUpdate {
+	_u.mutation.SetReviewStatus(v)
+	return _u
 }
 
-// Mutation returns the AlertSessionMutation object of the builder.
-func (_u *AlertSessionUpdate) Mutation() *AlertSessionMutation {
-	return _u.mutation
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableReviewStatus(v *alertsession.ReviewStatus) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetReviewStatus(*v)
+	}
+	return _u
 }
 
-// ClearStages clears all "stages" edges to the Stage entity.
-func (_u *AlertSessionUpdate) ClearStages() *AlertSessionUpdate {
-	_u.mutation.ClearStages()
+// ClearReviewStatus clears the value of the "review_status" field.
+func (_u *AlertSessionUpdate) ClearReviewStatus() *AlertSessionUpdate {
+	_u.mutation.ClearReviewStatus()
 	return _u
 }
 
-// RemoveStageIDs removes the "stages" edge to Stage entities by IDs.
-func (_u *AlertSessionUpdate) RemoveStageIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveStageIDs(ids...)
+// SetAssignee sets the "assignee" field.
+func (_u *AlertSessionUpdate) SetAssignee(v string) *AlertSessionUpdate {
+	_u.mutation.SetAssignee(v)
 	return _u
 }
 
-// RemoveStages removes "stages" edges to Stage entities.
-func (_u *AlertSessionUpdate) RemoveStages(v ...*Stage) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableAssignee sets the "assignee" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableAssignee(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetAssignee(*v)
 	}
-	return _u.RemoveStageIDs(ids...)
+	return _u
 }
 
-// ClearAgentExecutions clears all "agent_executions" edges to the AgentExecution entity.
-func (_u *AlertSessionUpdate) ClearAgentExecutions() *AlertSessionUpdate {
-	_u.mutation.ClearAgentExecutions()
+// ClearAssignee clears the value of the "assignee" field.
+func (_u *AlertSessionUpdate) ClearAssignee() *AlertSessionUpdate {
+	_u.mutation.ClearAssignee()
 	return _u
 }
 
-// RemoveAgentExecutionIDs removes the "agent_executions" edge to AgentExecution entities by IDs.
-func (_u *AlertSessionUpdate) RemoveAgentExecutionIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveAgentExecutionIDs(ids...)
+// SetAssignedAt sets the "assigned_at" field.
+func (_u *AlertSessionUpdate) SetAssignedAt(v time.Time) *AlertSessionUpdate {
+	_u.mutation.SetAssignedAt(v)
 	return _u
 }
 
-// RemoveAgentExecutions removes "agent_executions" edges to AgentExecution entities.
-func (_u *AlertSessionUpdate) RemoveAgentExecutions(v ...*AgentExecution) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableAssignedAt(v *time.Time) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetAssignedAt(*v)
 	}
-	return _u.RemoveAgentExecutionIDs(ids...)
+	return _u
 }
 
-// ClearTimelineEvents clears all "timeline_events" edges to the TimelineEvent entity.
-func (_u *AlertSessionUpdate) ClearTimelineEvents() *AlertSessionUpdate {
-	_u.mutation.ClearTimelineEvents()
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (_u *AlertSessionUpdate) ClearAssignedAt() *AlertSessionUpdate {
+	_u.mutation.ClearAssignedAt()
 	return _u
 }
 
-// RemoveTimelineEventIDs removes the "timeline_events" edge to TimelineEvent entities by IDs.
-func (_u *AlertSessionUpdate) RemoveTimelineEventIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveTimelineEventIDs(ids...)
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *AlertSessionUpdate) SetReviewedAt(v time.Time) *AlertSessionUpdate {
+	_u.mutation.SetReviewedAt(v)
 	return _u
 }
 
-// RemoveTimelineEvents removes "timeline_events" edges to TimelineEvent entities.
-func (_u *AlertSessionUpdate) RemoveTimelineEvents(v ...*TimelineEvent) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableReviewedAt(v *time.Time) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
 	}
-	return _u.RemoveTimelineEventIDs(ids...)
+	return _u
 }
 
-// ClearMessages clears all "messages" edges to the Message entity.
-func (_u *AlertSessionUpdate) ClearMessages() *AlertSessionUpdate {
-	_u.mutation.ClearMessages()
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *AlertSessionUpdate) ClearReviewedAt() *AlertSessionUpdate {
+	_u.mutation.ClearReviewedAt()
 	return _u
 }
 
-// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
-func (_u *AlertSessionUpdate) RemoveMessageIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveMessageIDs(ids...)
+// SetQualityRating sets the "quality_rating" field.
+func (_u *AlertSessionUpdate) SetQualityRating(v alertsession.QualityRating) *AlertSessionUpdate {
+	_u.mutation.SetQualityRating(v)
 	return _u
 }
 
-// RemoveMessages removes "messages" edges to Message entities.
-func (_u *AlertSessionUpdate) RemoveMessages(v ...*Message) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableQualityRating sets the "quality_rating" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableQualityRating(v *alertsession.QualityRating) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetQualityRating(*v)
 	}
-	return _u.RemoveMessageIDs(ids...)
+	return _u
 }
 
-// ClearLlmInteractions clears all "llm_interactions" edges to the LLMInteraction entity.
-func (_u *AlertSessionUpdate) ClearLlmInteractions() *AlertSessionUpdate {
-	_u.mutation.ClearLlmInteractions()
+// ClearQualityRating clears the value of the "quality_rating" field.
+func (_u *AlertSessionUpdate) ClearQualityRating() *AlertSessionUpdate {
+	_u.mutation.ClearQualityRating()
 	return _u
 }
 
-// RemoveLlmInteractionIDs removes the "llm_interactions" edge to LLMInteraction entities by IDs.
-func (_u *AlertSessionUpdate) RemoveLlmInteractionIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveLlmInteractionIDs(ids...)
+// SetActionTaken sets the "action_taken" field.
+func (_u *AlertSessionUpdate) SetActionTaken(v string) *AlertSessionUpdate {
+	_u.mutation.SetActionTaken(v)
 	return _u
 }
 
-// RemoveLlmInteractions removes "llm_interactions" edges to LLMInteraction entities.
-func (_u *AlertSessionUpdate) RemoveLlmInteractions(v ...*LLMInteraction) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableActionTaken sets the "action_taken" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableActionTaken(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetActionTaken(*v)
 	}
-	return _u.RemoveLlmInteractionIDs(ids...)
+	return _u
 }
 
-// ClearMcpInteractions clears all "mcp_interactions" edges to the MCPInteraction entity.
-func (_u *AlertSessionUpdate) ClearMcpInteractions() *AlertSessionUpdate {
-	_u.mutation.ClearMcpInteractions()
+// ClearActionTaken clears the value of the "action_taken" field.
+func (_u *AlertSessionUpdate) ClearActionTaken() *AlertSessionUpdate {
+	_u.mutation.ClearActionTaken()
 	return _u
 }
 
-// RemoveMcpInteractionIDs removes the "mcp_interactions" edge to MCPInteraction entities by IDs.
-func (_u *AlertSessionUpdate) RemoveMcpInteractionIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveMcpInteractionIDs(ids...)
+// SetInvestigationFeedback sets the "investigation_feedback" field.
+func (_u *AlertSessionUpdate) SetInvestigationFeedback(v string) *AlertSessionUpdate {
+	_u.mutation.SetInvestigationFeedback(v)
 	return _u
 }
 
-// RemoveMcpInteractions removes "mcp_interactions" edges to MCPInteraction entities.
-func (_u *AlertSessionUpdate) RemoveMcpInteractions(v ...*MCPInteraction) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableInvestigationFeedback sets the "investigation_feedback" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableInvestigationFeedback(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetInvestigationFeedback(*v)
 	}
-	return _u.RemoveMcpInteractionIDs(ids...)
+	return _u
 }
 
-// ClearEvents clears all "events" edges to the Event entity.
-func (_u *AlertSessionUpdate) ClearEvents() *AlertSessionUpdate {
-	_u.mutation.ClearEvents()
+// ClearInvestigationFeedback clears the value of the "investigation_feedback" field.
+func (_u *AlertSessionUpdate) ClearInvestigationFeedback() *AlertSessionUpdate {
+	_u.mutation.ClearInvestigationFeedback()
 	return _u
 }
 
-// RemoveEventIDs removes the "events" edge to Event entities by IDs.
-func (_u *AlertSessionUpdate) RemoveEventIDs(ids ...int) *AlertSessionUpdate {
-	_u.mutation.RemoveEventIDs(ids...)
+// SetHandoffNotes sets the "handoff_notes" field.
+func (_u *AlertSessionUpdate) SetHandoffNotes(v string) *AlertSessionUpdate {
+	_u.mutation.SetHandoffNotes(v)
 	return _u
 }
 
-// RemoveEvents removes "events" edges to Event entities.
-func (_u *AlertSessionUpdate) RemoveEvents(v ...*Event) *AlertSessionUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableHandoffNotes sets the "handoff_notes" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableHandoffNotes(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetHandoffNotes(*v)
 	}
-	return _u.RemoveEventIDs(ids...)
+	return _u
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (_u *AlertSessionUpdate) ClearChat() *AlertSessionUpdate {
-	_u.mutation.ClearChat()
+// ClearHandoffNotes clears the value of the "handoff_notes" field.
+func (_u *AlertSessionUpdate) ClearHandoffNotes() *AlertSessionUpdate {
+	_u.mutation.ClearHandoffNotes()
 	return _u
 }
 
-// ClearSessionScores clears all "session_scores" edges to the SessionScore entity.
-func (_u *AlertSessionUpdate) ClearSessionScores() *AlertSessionUpdate {
-	_u.mutation.ClearSessionScores()
+// SetHandoffNotesRevision sets the "handoff_notes_revision" field.
+func (_u *AlertSessionUpdate) SetHandoffNotesRevision(v int) *AlertSessionUpdate {
+	_u.mutation.ResetHandoffNotesRevision()
+	_u.mutation.SetHandoffNotesRevision(v)
 	return _u
 }
 
-// RemoveSessionScoreIDs removes the "session_scores" edge to SessionScore entities by IDs.
-func (_u *AlertSessionUpdate) RemoveSessionScoreIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveSessionScoreIDs(ids...)
+// SetNillableHandoffNotesRevision sets the "handoff_notes_revision" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableHandoffNotesRevision(v *int) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetHandoffNotesRevision(*v)
+	}
 	return _u
 }
 
-// RemoveSessionScores removes "session_scores" edges to SessionScore entities.
-func (_u *AlertSessionUpdate) RemoveSessionScores(v ...*SessionScore) *AlertSessionUpdate {
-	ids := make([]string, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// AddHandoffNotesRevision adds value to the "handoff_notes_revision" field.
+func (_u *AlertSessionUpdate) AddHandoffNotesRevision(v int) *AlertSessionUpdate {
+	_u.mutation.AddHandoffNotesRevision(v)
+	return _u
+}
+
+// SetHandoffNotesUpdatedBy sets the "handoff_notes_updated_by" field.
+func (_u *AlertSessionUpdate) SetHandoffNotesUpdatedBy(v string) *AlertSessionUpdate {
+	_u.mutation.SetHandoffNotesUpdatedBy(v)
+	return _u
+}
+
+// SetNillableHandoffNotesUpdatedBy sets the "handoff_notes_updated_by" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableHandoffNotesUpdatedBy(v *string) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetHandoffNotesUpdatedBy(*v)
 	}
-	return _u.RemoveSessionScoreIDs(ids...)
+	return _u
 }
 
-// ClearReviewActivities clears all "review_activities" edges to the SessionReviewActivity entity.
-func (_u *AlertSessionUpdate) ClearReviewActivities() *AlertSessionUpdate {
-	_u.mutation.ClearReviewActivities()
+// ClearHandoffNotesUpdatedBy clears the value of the "handoff_notes_updated_by" field.
+func (_u *AlertSessionUpdate) ClearHandoffNotesUpdatedBy() *AlertSessionUpdate {
+	_u.mutation.ClearHandoffNotesUpdatedBy()
 	return _u
 }
 
-// RemoveReviewActivityIDs removes the "review_activities" edge to SessionReviewActivity entities by IDs.
-func (_u *AlertSessionUpdate) RemoveReviewActivityIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveReviewActivityIDs(ids...)
+// SetHandoffNotesUpdatedAt sets the "handoff_notes_updated_at" field.
+func (_u *AlertSessionUpdate) SetHandoffNotesUpdatedAt(v time.Time) *AlertSessionUpdate {
+	_u.mutation.SetHandoffNotesUpdatedAt(v)
 	return _u
 }
 
-// RemoveReviewActivities removes "review_activities" edges to SessionReviewActivity entities.
-func (_u *AlertSessionUpdate) RemoveReviewActivities(v ...*SessionReviewActivity) *AlertSessionUpdate {
+// SetNillableHandoffNotesUpdatedAt sets the "handoff_notes_updated_at" field if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableHandoffNotesUpdatedAt(v *time.Time) *AlertSessionUpdate {
+	if v != nil {
+		_u.SetHandoffNotesUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearHandoffNotesUpdatedAt clears the value of the "handoff_notes_updated_at" field.
+func (_u *AlertSessionUpdate) ClearHandoffNotesUpdatedAt() *AlertSessionUpdate {
+	_u.mutation.ClearHandoffNotesUpdatedAt()
+	return _u
+}
+
+// AddStageIDs adds the "stages" edge to the Stage entity by IDs.
+func (_u *AlertSessionUpdate) AddStageIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddStageIDs(ids...)
+	return _u
+}
+
+// AddStages adds the "stages" edges to the Stage entity.
+func (_u *AlertSessionUpdate) AddStages(v ...*Stage) *AlertSessionUpdate {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReviewActivityIDs(ids...)
+	return _u.AddStageIDs(ids...)
 }
 
-// ClearMemories clears all "memories" edges to the InvestigationMemory entity.
-func (_u *AlertSessionUpdate) ClearMemories() *AlertSessionUpdate {
-	_u.mutation.ClearMemories()
+// AddAgentExecutionIDs adds the "agent_executions" edge to the AgentExecution entity by IDs.
+func (_u *AlertSessionUpdate) AddAgentExecutionIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddAgentExecutionIDs(ids...)
 	return _u
 }
 
-// RemoveMemoryIDs removes the "memories" edge to InvestigationMemory entities by IDs.
-func (_u *AlertSessionUpdate) RemoveMemoryIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveMemoryIDs(ids...)
+// AddAgentExecutions adds the "agent_executions" edges to the AgentExecution entity.
+func (_u *AlertSessionUpdate) AddAgentExecutions(v ...*AgentExecution) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAgentExecutionIDs(ids...)
+}
+
+// AddTimelineEventIDs adds the "timeline_events" edge to the TimelineEvent entity by IDs.
+func (_u *AlertSessionUpdate) AddTimelineEventIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddTimelineEventIDs(ids...)
 	return _u
 }
 
-// RemoveMemories removes "memories" edges to InvestigationMemory entities.
-func (_u *AlertSessionUpdate) RemoveMemories(v ...*InvestigationMemory) *AlertSessionUpdate {
+// AddTimelineEvents adds the "timeline_events" edges to the TimelineEvent entity.
+func (_u *AlertSessionUpdate) AddTimelineEvents(v ...*TimelineEvent) *AlertSessionUpdate {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveMemoryIDs(ids...)
+	return _u.AddTimelineEventIDs(ids...)
 }
 
-// ClearInjectedMemories clears all "injected_memories" edges to the InvestigationMemory entity.
-func (_u *AlertSessionUpdate) ClearInjectedMemories() *AlertSessionUpdate {
-	_u.mutation.ClearInjectedMemories()
+// AddMessageIDs adds the "messages" edge to the Message entity by IDs.
+func (_u *AlertSessionUpdate) AddMessageIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddMessageIDs(ids...)
 	return _u
 }
 
-// RemoveInjectedMemoryIDs removes the "injected_memories" edge to InvestigationMemory entities by IDs.
-func (_u *AlertSessionUpdate) RemoveInjectedMemoryIDs(ids ...string) *AlertSessionUpdate {
-	_u.mutation.RemoveInjectedMemoryIDs(ids...)
+// AddMessages adds the "messages" edges to the Message entity.
+func (_u *AlertSessionUpdate) AddMessages(v ...*Message) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageIDs(ids...)
+}
+
+// AddLlmInteractionIDs adds the "llm_interactions" edge to the LLMInteraction entity by IDs.
+func (_u *AlertSessionUpdate) AddLlmInteractionIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddLlmInteractionIDs(ids...)
 	return _u
 }
 
-// RemoveInjectedMemories removes "injected_memories" edges to InvestigationMemory entities.
-func (_u *AlertSessionUpdate) RemoveInjectedMemories(v ...*InvestigationMemory) *AlertSessionUpdate {
+// AddLlmInteractions adds the "llm_interactions" edges to the LLMInteraction entity.
+func (_u *AlertSessionUpdate) AddLlmInteractions(v ...*LLMInteraction) *AlertSessionUpdate {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveInjectedMemoryIDs(ids...)
+	return _u.AddLlmInteractionIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *AlertSessionUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// AddMcpInteractionIDs adds the "mcp_interactions" edge to the MCPInteraction entity by IDs.
+func (_u *AlertSessionUpdate) AddMcpInteractionIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddMcpInteractionIDs(ids...)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *AlertSessionUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddMcpInteractions adds the "mcp_interactions" edges to the MCPInteraction entity.
+func (_u *AlertSessionUpdate) AddMcpInteractions(v ...*MCPInteraction) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.AddMcpInteractionIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *AlertSessionUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// AddEventIDs adds the "events" edge to the Event entity by IDs.
+func (_u *AlertSessionUpdate) AddEventIDs(ids ...int) *AlertSessionUpdate {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *AlertSessionUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// AddEvents adds the "events" edges to the Event entity.
+func (_u *AlertSessionUpdate) AddEvents(v ...*Event) *AlertSessionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddEventIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *AlertSessionUpdate) check() error {
-	if v, ok := _u.mutation.Status(); ok {
-		if err := alertsession.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.status": %w`, err)}
-		}
+// SetChatID sets the "chat" edge to the Chat entity by ID.
+func (_u *AlertSessionUpdate) SetChatID(id string) *AlertSessionUpdate {
+	_u.mutation.SetChatID(id)
+	return _u
+}
+
+// SetNillableChatID sets the "chat" edge to the Chat entity by ID if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableChatID(id *string) *AlertSessionUpdate {
+	if id != nil {
+		_u = _u.SetChatID(*id)
 	}
-	if v, ok := _u.mutation.ReviewStatus(); ok {
-		if err := alertsession.ReviewStatusValidator(v); err != nil {
-			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.review_status": %w`, err)}
-		}
+	return _u
+}
+
+// SetChat sets the "chat" edge to the Chat entity.
+func (_u *AlertSessionUpdate) SetChat(v *Chat) *AlertSessionUpdate {
+	return _u.SetChatID(v.ID)
+}
+
+// AddSessionScoreIDs adds the "session_scores" edge to the SessionScore entity by IDs.
+func (_u *AlertSessionUpdate) AddSessionScoreIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddSessionScoreIDs(ids...)
+	return _u
+}
+
+// AddSessionScores adds the "session_scores" edges to the SessionScore entity.
+func (_u *AlertSessionUpdate) AddSessionScores(v ...*SessionScore) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if v, ok := _u.mutation.QualityRating(); ok {
-		if err := alertsession.QualityRatingValidator(v); err != nil {
-			return &ValidationError{Name: "quality_rating", err: fmt.Errorf(`ent: validator failed for field "AlertSession.quality_rating": %w`, err)}
-		}
+	return _u.AddSessionScoreIDs(ids...)
+}
+
+// AddReviewActivityIDs adds the "review_activities" edge to the SessionReviewActivity entity by IDs.
+func (_u *AlertSessionUpdate) AddReviewActivityIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddReviewActivityIDs(ids...)
+	return _u
+}
+
+// AddReviewActivities adds the "review_activities" edges to the SessionReviewActivity entity.
+func (_u *AlertSessionUpdate) AddReviewActivities(v ...*SessionReviewActivity) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return nil
+	return _u.AddReviewActivityIDs(ids...)
 }
 
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *AlertSessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AlertSessionUpdate {
-	_u.modifiers = append(_u.modifiers, modifiers...)
+// AddClaimIDs adds the "claims" edge to the SessionClaim entity by IDs.
+func (_u *AlertSessionUpdate) AddClaimIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddClaimIDs(ids...)
 	return _u
 }
 
-func (_u *AlertSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// AddClaims adds the "claims" edges to the SessionClaim entity.
+func (_u *AlertSessionUpdate) AddClaims(v ...*SessionClaim) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(alertsession.Table, alertsession.Columns, sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return _u.AddClaimIDs(ids...)
+}
+
+// AddMemoryIDs adds the "memories" edge to the InvestigationMemory entity by IDs.
+func (_u *AlertSessionUpdate) AddMemoryIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddMemoryIDs(ids...)
+	return _u
+}
+
+// AddMemories adds the "memories" edges to the InvestigationMemory entity.
+func (_u *AlertSessionUpdate) AddMemories(v ...*InvestigationMemory) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.AlertData(); ok {
-		_spec.SetField(alertsession.FieldAlertData, field.TypeString, value)
+	return _u.AddMemoryIDs(ids...)
+}
+
+// AddInjectedMemoryIDs adds the "injected_memories" edge to the InvestigationMemory entity by IDs.
+func (_u *AlertSessionUpdate) AddInjectedMemoryIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddInjectedMemoryIDs(ids...)
+	return _u
+}
+
+// AddInjectedMemories adds the "injected_memories" edges to the InvestigationMemory entity.
+func (_u *AlertSessionUpdate) AddInjectedMemories(v ...*InvestigationMemory) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.AgentType(); ok {
-		_spec.SetField(alertsession.FieldAgentType, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.AlertType(); ok {
-		_spec.SetField(alertsession.FieldAlertType, field.TypeString, value)
-	}
-	if _u.mutation.AlertTypeCleared() {
-		_spec.ClearField(alertsession.FieldAlertType, field.TypeString)
-	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(alertsession.FieldStatus, field.TypeEnum, value)
-	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(alertsession.FieldCreatedAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.StartedAt(); ok {
-		_spec.SetField(alertsession.FieldStartedAt, field.TypeTime, value)
-	}
-	if _u.mutation.StartedAtCleared() {
-		_spec.ClearField(alertsession.FieldStartedAt, field.TypeTime)
+	return _u.AddInjectedMemoryIDs(ids...)
+}
+
+// AddRedactionReviewIDs adds the "redaction_reviews" edge to the RedactionReview entity by IDs.
+func (_u *AlertSessionUpdate) AddRedactionReviewIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddRedactionReviewIDs(ids...)
+	return _u
+}
+
+// AddRedactionReviews adds the "redaction_reviews" edges to the RedactionReview entity.
+func (_u *AlertSessionUpdate) AddRedactionReviews(v ...*RedactionReview) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.CompletedAt(); ok {
-		_spec.SetField(alertsession.FieldCompletedAt, field.TypeTime, value)
+	return _u.AddRedactionReviewIDs(ids...)
+}
+
+// AddHandoffNoteRevisionIDs adds the "handoff_note_revisions" edge to the HandoffNoteRevision entity by IDs.
+func (_u *AlertSessionUpdate) AddHandoffNoteRevisionIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddHandoffNoteRevisionIDs(ids...)
+	return _u
+}
+
+// AddHandoffNoteRevisions adds the "handoff_note_revisions" edges to the HandoffNoteRevision entity.
+func (_u *AlertSessionUpdate) AddHandoffNoteRevisions(v ...*HandoffNoteRevision) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.CompletedAtCleared() {
-		_spec.ClearField(alertsession.FieldCompletedAt, field.TypeTime)
+	return _u.AddHandoffNoteRevisionIDs(ids...)
+}
+
+// SetComparisonID sets the "comparison" edge to the SessionComparison entity by ID.
+func (_u *AlertSessionUpdate) SetComparisonID(id string) *AlertSessionUpdate {
+	_u.mutation.SetComparisonID(id)
+	return _u
+}
+
+// SetNillableComparisonID sets the "comparison" edge to the SessionComparison entity by ID if the given value is not nil.
+func (_u *AlertSessionUpdate) SetNillableComparisonID(id *string) *AlertSessionUpdate {
+	if id != nil {
+		_u = _u.SetComparisonID(*id)
 	}
-	if value, ok := _u.mutation.ErrorMessage(); ok {
-		_spec.SetField(alertsession.FieldErrorMessage, field.TypeString, value)
+	return _u
+}
+
+// SetComparison sets the "comparison" edge to the SessionComparison entity.
+func (_u *AlertSessionUpdate) SetComparison(v *SessionComparison) *AlertSessionUpdate {
+	return _u.SetComparisonID(v.ID)
+}
+
+// AddNextComparisonIDs adds the "next_comparisons" edge to the SessionComparison entity by IDs.
+func (_u *AlertSessionUpdate) AddNextComparisonIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddNextComparisonIDs(ids...)
+	return _u
+}
+
+// AddNextComparisons adds the "next_comparisons" edges to the SessionComparison entity.
+func (_u *AlertSessionUpdate) AddNextComparisons(v ...*SessionComparison) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.ErrorMessageCleared() {
-		_spec.ClearField(alertsession.FieldErrorMessage, field.TypeString)
+	return _u.AddNextComparisonIDs(ids...)
+}
+
+// AddActionItemIDs adds the "action_items" edge to the ActionItem entity by IDs.
+func (_u *AlertSessionUpdate) AddActionItemIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.AddActionItemIDs(ids...)
+	return _u
+}
+
+// AddActionItems adds the "action_items" edges to the ActionItem entity.
+func (_u *AlertSessionUpdate) AddActionItems(v ...*ActionItem) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.FinalAnalysis(); ok {
-		_spec.SetField(alertsession.FieldFinalAnalysis, field.TypeString, value)
+	return _u.AddActionItemIDs(ids...)
+}
+
+// Mutation returns the AlertSessionMutation object of the builder.
+func (_u *AlertSessionUpdate) Mutation() *AlertSessionMutation {
+	return _u.mutation
+}
+
+// ClearStages clears all "stages" edges to the Stage entity.
+func (_u *AlertSessionUpdate) ClearStages() *AlertSessionUpdate {
+	_u.mutation.ClearStages()
+	return _u
+}
+
+// RemoveStageIDs removes the "stages" edge to Stage entities by IDs.
+func (_u *AlertSessionUpdate) RemoveStageIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveStageIDs(ids...)
+	return _u
+}
+
+// RemoveStages removes "stages" edges to Stage entities.
+func (_u *AlertSessionUpdate) RemoveStages(v ...*Stage) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.FinalAnalysisCleared() {
-		_spec.ClearField(alertsession.FieldFinalAnalysis, field.TypeString)
+	return _u.RemoveStageIDs(ids...)
+}
+
+// ClearAgentExecutions clears all "agent_executions" edges to the AgentExecution entity.
+func (_u *AlertSessionUpdate) ClearAgentExecutions() *AlertSessionUpdate {
+	_u.mutation.ClearAgentExecutions()
+	return _u
+}
+
+// RemoveAgentExecutionIDs removes the "agent_executions" edge to AgentExecution entities by IDs.
+func (_u *AlertSessionUpdate) RemoveAgentExecutionIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveAgentExecutionIDs(ids...)
+	return _u
+}
+
+// RemoveAgentExecutions removes "agent_executions" edges to AgentExecution entities.
+func (_u *AlertSessionUpdate) RemoveAgentExecutions(v ...*AgentExecution) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.ExecutiveSummary(); ok {
-		_spec.SetField(alertsession.FieldExecutiveSummary, field.TypeString, value)
+	return _u.RemoveAgentExecutionIDs(ids...)
+}
+
+// ClearTimelineEvents clears all "timeline_events" edges to the TimelineEvent entity.
+func (_u *AlertSessionUpdate) ClearTimelineEvents() *AlertSessionUpdate {
+	_u.mutation.ClearTimelineEvents()
+	return _u
+}
+
+// RemoveTimelineEventIDs removes the "timeline_events" edge to TimelineEvent entities by IDs.
+func (_u *AlertSessionUpdate) RemoveTimelineEventIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveTimelineEventIDs(ids...)
+	return _u
+}
+
+// RemoveTimelineEvents removes "timeline_events" edges to TimelineEvent entities.
+func (_u *AlertSessionUpdate) RemoveTimelineEvents(v ...*TimelineEvent) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.ExecutiveSummaryCleared() {
-		_spec.ClearField(alertsession.FieldExecutiveSummary, field.TypeString)
+	return _u.RemoveTimelineEventIDs(ids...)
+}
+
+// ClearMessages clears all "messages" edges to the Message entity.
+func (_u *AlertSessionUpdate) ClearMessages() *AlertSessionUpdate {
+	_u.mutation.ClearMessages()
+	return _u
+}
+
+// RemoveMessageIDs removes the "messages" edge to Message entities by IDs.
+func (_u *AlertSessionUpdate) RemoveMessageIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveMessageIDs(ids...)
+	return _u
+}
+
+// RemoveMessages removes "messages" edges to Message entities.
+func (_u *AlertSessionUpdate) RemoveMessages(v ...*Message) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.ExecutiveSummaryError(); ok {
-		_spec.SetField(alertsession.FieldExecutiveSummaryError, field.TypeString, value)
+	return _u.RemoveMessageIDs(ids...)
+}
+
+// ClearLlmInteractions clears all "llm_interactions" edges to the LLMInteraction entity.
+func (_u *AlertSessionUpdate) ClearLlmInteractions() *AlertSessionUpdate {
+	_u.mutation.ClearLlmInteractions()
+	return _u
+}
+
+// RemoveLlmInteractionIDs removes the "llm_interactions" edge to LLMInteraction entities by IDs.
+func (_u *AlertSessionUpdate) RemoveLlmInteractionIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveLlmInteractionIDs(ids...)
+	return _u
+}
+
+// RemoveLlmInteractions removes "llm_interactions" edges to LLMInteraction entities.
+func (_u *AlertSessionUpdate) RemoveLlmInteractions(v ...*LLMInteraction) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.ExecutiveSummaryErrorCleared() {
-		_spec.ClearField(alertsession.FieldExecutiveSummaryError, field.TypeString)
+	return _u.RemoveLlmInteractionIDs(ids...)
+}
+
+// ClearMcpInteractions clears all "mcp_interactions" edges to the MCPInteraction entity.
+func (_u *AlertSessionUpdate) ClearMcpInteractions() *AlertSessionUpdate {
+	_u.mutation.ClearMcpInteractions()
+	return _u
+}
+
+// RemoveMcpInteractionIDs removes the "mcp_interactions" edge to MCPInteraction entities by IDs.
+func (_u *AlertSessionUpdate) RemoveMcpInteractionIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveMcpInteractionIDs(ids...)
+	return _u
+}
+
+// RemoveMcpInteractions removes "mcp_interactions" edges to MCPInteraction entities.
+func (_u *AlertSessionUpdate) RemoveMcpInteractions(v ...*MCPInteraction) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.SessionMetadata(); ok {
-		_spec.SetField(alertsession.FieldSessionMetadata, field.TypeJSON, value)
+	return _u.RemoveMcpInteractionIDs(ids...)
+}
+
+// ClearEvents clears all "events" edges to the Event entity.
+func (_u *AlertSessionUpdate) ClearEvents() *AlertSessionUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to Event entities by IDs.
+func (_u *AlertSessionUpdate) RemoveEventIDs(ids ...int) *AlertSessionUpdate {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to Event entities.
+func (_u *AlertSessionUpdate) RemoveEvents(v ...*Event) *AlertSessionUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.SessionMetadataCleared() {
-		_spec.ClearField(alertsession.FieldSessionMetadata, field.TypeJSON)
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (_u *AlertSessionUpdate) ClearChat() *AlertSessionUpdate {
+	_u.mutation.ClearChat()
+	return _u
+}
+
+// ClearSessionScores clears all "session_scores" edges to the SessionScore entity.
+func (_u *AlertSessionUpdate) ClearSessionScores() *AlertSessionUpdate {
+	_u.mutation.ClearSessionScores()
+	return _u
+}
+
+// RemoveSessionScoreIDs removes the "session_scores" edge to SessionScore entities by IDs.
+func (_u *AlertSessionUpdate) RemoveSessionScoreIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveSessionScoreIDs(ids...)
+	return _u
+}
+
+// RemoveSessionScores removes "session_scores" edges to SessionScore entities.
+func (_u *AlertSessionUpdate) RemoveSessionScores(v ...*SessionScore) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Author(); ok {
-		_spec.SetField(alertsession.FieldAuthor, field.TypeString, value)
+	return _u.RemoveSessionScoreIDs(ids...)
+}
+
+// ClearReviewActivities clears all "review_activities" edges to the SessionReviewActivity entity.
+func (_u *AlertSessionUpdate) ClearReviewActivities() *AlertSessionUpdate {
+	_u.mutation.ClearReviewActivities()
+	return _u
+}
+
+// RemoveReviewActivityIDs removes the "review_activities" edge to SessionReviewActivity entities by IDs.
+func (_u *AlertSessionUpdate) RemoveReviewActivityIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveReviewActivityIDs(ids...)
+	return _u
+}
+
+// RemoveReviewActivities removes "review_activities" edges to SessionReviewActivity entities.
+func (_u *AlertSessionUpdate) RemoveReviewActivities(v ...*SessionReviewActivity) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.AuthorCleared() {
-		_spec.ClearField(alertsession.FieldAuthor, field.TypeString)
+	return _u.RemoveReviewActivityIDs(ids...)
+}
+
+// ClearClaims clears all "claims" edges to the SessionClaim entity.
+func (_u *AlertSessionUpdate) ClearClaims() *AlertSessionUpdate {
+	_u.mutation.ClearClaims()
+	return _u
+}
+
+// RemoveClaimIDs removes the "claims" edge to SessionClaim entities by IDs.
+func (_u *AlertSessionUpdate) RemoveClaimIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveClaimIDs(ids...)
+	return _u
+}
+
+// RemoveClaims removes "claims" edges to SessionClaim entities.
+func (_u *AlertSessionUpdate) RemoveClaims(v ...*SessionClaim) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.RunbookURL(); ok {
-		_spec.SetField(alertsession.FieldRunbookURL, field.TypeString, value)
+	return _u.RemoveClaimIDs(ids...)
+}
+
+// ClearMemories clears all "memories" edges to the InvestigationMemory entity.
+func (_u *AlertSessionUpdate) ClearMemories() *AlertSessionUpdate {
+	_u.mutation.ClearMemories()
+	return _u
+}
+
+// RemoveMemoryIDs removes the "memories" edge to InvestigationMemory entities by IDs.
+func (_u *AlertSessionUpdate) RemoveMemoryIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveMemoryIDs(ids...)
+	return _u
+}
+
+// RemoveMemories removes "memories" edges to InvestigationMemory entities.
+func (_u *AlertSessionUpdate) RemoveMemories(v ...*InvestigationMemory) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.RunbookURLCleared() {
-		_spec.ClearField(alertsession.FieldRunbookURL, field.TypeString)
+	return _u.RemoveMemoryIDs(ids...)
+}
+
+// ClearInjectedMemories clears all "injected_memories" edges to the InvestigationMemory entity.
+func (_u *AlertSessionUpdate) ClearInjectedMemories() *AlertSessionUpdate {
+	_u.mutation.ClearInjectedMemories()
+	return _u
+}
+
+// RemoveInjectedMemoryIDs removes the "injected_memories" edge to InvestigationMemory entities by IDs.
+func (_u *AlertSessionUpdate) RemoveInjectedMemoryIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveInjectedMemoryIDs(ids...)
+	return _u
+}
+
+// RemoveInjectedMemories removes "injected_memories" edges to InvestigationMemory entities.
+func (_u *AlertSessionUpdate) RemoveInjectedMemories(v ...*InvestigationMemory) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.McpSelection(); ok {
-		_spec.SetField(alertsession.FieldMcpSelection, field.TypeJSON, value)
+	return _u.RemoveInjectedMemoryIDs(ids...)
+}
+
+// ClearRedactionReviews clears all "redaction_reviews" edges to the RedactionReview entity.
+func (_u *AlertSessionUpdate) ClearRedactionReviews() *AlertSessionUpdate {
+	_u.mutation.ClearRedactionReviews()
+	return _u
+}
+
+// RemoveRedactionReviewIDs removes the "redaction_reviews" edge to RedactionReview entities by IDs.
+func (_u *AlertSessionUpdate) RemoveRedactionReviewIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveRedactionReviewIDs(ids...)
+	return _u
+}
+
+// RemoveRedactionReviews removes "redaction_reviews" edges to RedactionReview entities.
+func (_u *AlertSessionUpdate) RemoveRedactionReviews(v ...*RedactionReview) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.McpSelectionCleared() {
-		_spec.ClearField(alertsession.FieldMcpSelection, field.TypeJSON)
+	return _u.RemoveRedactionReviewIDs(ids...)
+}
+
+// ClearHandoffNoteRevisions clears all "handoff_note_revisions" edges to the HandoffNoteRevision entity.
+func (_u *AlertSessionUpdate) ClearHandoffNoteRevisions() *AlertSessionUpdate {
+	_u.mutation.ClearHandoffNoteRevisions()
+	return _u
+}
+
+// RemoveHandoffNoteRevisionIDs removes the "handoff_note_revisions" edge to HandoffNoteRevision entities by IDs.
+func (_u *AlertSessionUpdate) RemoveHandoffNoteRevisionIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveHandoffNoteRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveHandoffNoteRevisions removes "handoff_note_revisions" edges to HandoffNoteRevision entities.
+func (_u *AlertSessionUpdate) RemoveHandoffNoteRevisions(v ...*HandoffNoteRevision) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.ChainID(); ok {
-		_spec.SetField(alertsession.FieldChainID, field.TypeString, value)
+	return _u.RemoveHandoffNoteRevisionIDs(ids...)
+}
+
+// ClearComparison clears the "comparison" edge to the SessionComparison entity.
+func (_u *AlertSessionUpdate) ClearComparison() *AlertSessionUpdate {
+	_u.mutation.ClearComparison()
+	return _u
+}
+
+// ClearNextComparisons clears all "next_comparisons" edges to the SessionComparison entity.
+func (_u *AlertSessionUpdate) ClearNextComparisons() *AlertSessionUpdate {
+	_u.mutation.ClearNextComparisons()
+	return _u
+}
+
+// RemoveNextComparisonIDs removes the "next_comparisons" edge to SessionComparison entities by IDs.
+func (_u *AlertSessionUpdate) RemoveNextComparisonIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveNextComparisonIDs(ids...)
+	return _u
+}
+
+// RemoveNextComparisons removes "next_comparisons" edges to SessionComparison entities.
+func (_u *AlertSessionUpdate) RemoveNextComparisons(v ...*SessionComparison) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.CurrentStageIndex(); ok {
-		_spec.SetField(alertsession.FieldCurrentStageIndex, field.TypeInt, value)
+	return _u.RemoveNextComparisonIDs(ids...)
+}
+
+// ClearActionItems clears all "action_items" edges to the ActionItem entity.
+func (_u *AlertSessionUpdate) ClearActionItems() *AlertSessionUpdate {
+	_u.mutation.ClearActionItems()
+	return _u
+}
+
+// RemoveActionItemIDs removes the "action_items" edge to ActionItem entities by IDs.
+func (_u *AlertSessionUpdate) RemoveActionItemIDs(ids ...string) *AlertSessionUpdate {
+	_u.mutation.RemoveActionItemIDs(ids...)
+	return _u
+}
+
+// RemoveActionItems removes "action_items" edges to ActionItem entities.
+func (_u *AlertSessionUpdate) RemoveActionItems(v ...*ActionItem) *AlertSessionUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.AddedCurrentStageIndex(); ok {
-		_spec.AddField(alertsession.FieldCurrentStageIndex, field.TypeInt, value)
+	return _u.RemoveActionItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AlertSessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlertSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
 	}
-	if _u.mutation.CurrentStageIndexCleared() {
-		_spec.ClearField(alertsession.FieldCurrentStageIndex, field.TypeInt)
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AlertSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlertSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
 	}
-	if value, ok := _u.mutation.CurrentStageID(); ok {
-		_spec.SetField(alertsession.FieldCurrentStageID, field.TypeString, value)
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AlertSessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := alertsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
 	}
-	if _u.mutation.CurrentStageIDCleared() {
-		_spec.ClearField(alertsession.FieldCurrentStageID, field.TypeString)
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlertSessionUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := alertsession.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.status": %w`, err)}
+		}
 	}
-	if value, ok := _u.mutation.PodID(); ok {
-		_spec.SetField(alertsession.FieldPodID, field.TypeString, value)
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := alertsession.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AlertSession.severity": %w`, err)}
+		}
 	}
-	if _u.mutation.PodIDCleared() {
-		_spec.ClearField(alertsession.FieldPodID, field.TypeString)
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := alertsession.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AlertSession.outcome": %w`, err)}
+		}
 	}
-	if value, ok := _u.mutation.LastInteractionAt(); ok {
-		_spec.SetField(alertsession.FieldLastInteractionAt, field.TypeTime, value)
+	if v, ok := _u.mutation.OutcomeSource(); ok {
+		if err := alertsession.OutcomeSourceValidator(v); err != nil {
+			return &ValidationError{Name: "outcome_source", err: fmt.Errorf(`ent: validator failed for field "AlertSession.outcome_source": %w`, err)}
+		}
 	}
-	if _u.mutation.LastInteractionAtCleared() {
-		_spec.ClearField(alertsession.FieldLastInteractionAt, field.TypeTime)
+	if v, ok := _u.mutation.CancelInitiator(); ok {
+		if err := alertsession.CancelInitiatorValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_initiator", err: fmt.Errorf(`ent: validator failed for field "AlertSession.cancel_initiator": %w`, err)}
+		}
 	}
-	if value, ok := _u.mutation.SlackMessageFingerprint(); ok {
-		_spec.SetField(alertsession.FieldSlackMessageFingerprint, field.TypeString, value)
+	if v, ok := _u.mutation.CallbackStatus(); ok {
+		if err := alertsession.CallbackStatusValidator(v); err != nil {
+			return &ValidationError{Name: "callback_status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.callback_status": %w`, err)}
+		}
 	}
-	if _u.mutation.SlackMessageFingerprintCleared() {
-		_spec.ClearField(alertsession.FieldSlackMessageFingerprint, field.TypeString)
+	if v, ok := _u.mutation.ReviewStatus(); ok {
+		if err := alertsession.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.review_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QualityRating(); ok {
+		if err := alertsession.QualityRatingValidator(v); err != nil {
+			return &ValidationError{Name: "quality_rating", err: fmt.Errorf(`ent: validator failed for field "AlertSession.quality_rating": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *AlertSessionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AlertSessionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *AlertSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertsession.Table, alertsession.Columns, sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertsession.FieldUpdatedAt, field.TypeTime, value)
 	}
 	if value, ok := _u.mutation.DeletedAt(); ok {
 		_spec.SetField(alertsession.FieldDeletedAt, field.TypeTime, value)
@@ -1192,29 +1945,334 @@ func (_u *AlertSessionUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(alertsession.FieldDeletedAt, field.TypeTime)
 	}
-	if value, ok := _u.mutation.ReviewStatus(); ok {
-		_spec.SetField(alertsession.FieldReviewStatus, field.TypeEnum, value)
+	if value, ok := _u.mutation.AlertData(); ok {
+		_spec.SetField(alertsession.FieldAlertData, field.TypeString, value)
 	}
-	if _u.mutation.ReviewStatusCleared() {
-		_spec.ClearField(alertsession.FieldReviewStatus, field.TypeEnum)
+	if value, ok := _u.mutation.AgentType(); ok {
+		_spec.SetField(alertsession.FieldAgentType, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Assignee(); ok {
-		_spec.SetField(alertsession.FieldAssignee, field.TypeString, value)
+	if value, ok := _u.mutation.AlertType(); ok {
+		_spec.SetField(alertsession.FieldAlertType, field.TypeString, value)
 	}
-	if _u.mutation.AssigneeCleared() {
-		_spec.ClearField(alertsession.FieldAssignee, field.TypeString)
+	if _u.mutation.AlertTypeCleared() {
+		_spec.ClearField(alertsession.FieldAlertType, field.TypeString)
 	}
-	if value, ok := _u.mutation.AssignedAt(); ok {
-		_spec.SetField(alertsession.FieldAssignedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(alertsession.FieldStatus, field.TypeEnum, value)
 	}
-	if _u.mutation.AssignedAtCleared() {
-		_spec.ClearField(alertsession.FieldAssignedAt, field.TypeTime)
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(alertsession.FieldCreatedAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.ReviewedAt(); ok {
-		_spec.SetField(alertsession.FieldReviewedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(alertsession.FieldStartedAt, field.TypeTime, value)
 	}
-	if _u.mutation.ReviewedAtCleared() {
-		_spec.ClearField(alertsession.FieldReviewedAt, field.TypeTime)
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(alertsession.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(alertsession.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(alertsession.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(alertsession.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(alertsession.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.FinalAnalysis(); ok {
+		_spec.SetField(alertsession.FieldFinalAnalysis, field.TypeString, value)
+	}
+	if _u.mutation.FinalAnalysisCleared() {
+		_spec.ClearField(alertsession.FieldFinalAnalysis, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExecutiveSummary(); ok {
+		_spec.SetField(alertsession.FieldExecutiveSummary, field.TypeString, value)
+	}
+	if _u.mutation.ExecutiveSummaryCleared() {
+		_spec.ClearField(alertsession.FieldExecutiveSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExecutiveSummaryError(); ok {
+		_spec.SetField(alertsession.FieldExecutiveSummaryError, field.TypeString, value)
+	}
+	if _u.mutation.ExecutiveSummaryErrorCleared() {
+		_spec.ClearField(alertsession.FieldExecutiveSummaryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExecutiveSummaryTemplate(); ok {
+		_spec.SetField(alertsession.FieldExecutiveSummaryTemplate, field.TypeString, value)
+	}
+	if _u.mutation.ExecutiveSummaryTemplateCleared() {
+		_spec.ClearField(alertsession.FieldExecutiveSummaryTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.TechnicalSummary(); ok {
+		_spec.SetField(alertsession.FieldTechnicalSummary, field.TypeString, value)
+	}
+	if _u.mutation.TechnicalSummaryCleared() {
+		_spec.ClearField(alertsession.FieldTechnicalSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.TechnicalSummaryError(); ok {
+		_spec.SetField(alertsession.FieldTechnicalSummaryError, field.TypeString, value)
+	}
+	if _u.mutation.TechnicalSummaryErrorCleared() {
+		_spec.ClearField(alertsession.FieldTechnicalSummaryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(alertsession.FieldSeverity, field.TypeEnum, value)
+	}
+	if _u.mutation.SeverityCleared() {
+		_spec.ClearField(alertsession.FieldSeverity, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(alertsession.FieldOutcome, field.TypeEnum, value)
+	}
+	if _u.mutation.OutcomeCleared() {
+		_spec.ClearField(alertsession.FieldOutcome, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.OutcomeReason(); ok {
+		_spec.SetField(alertsession.FieldOutcomeReason, field.TypeString, value)
+	}
+	if _u.mutation.OutcomeReasonCleared() {
+		_spec.ClearField(alertsession.FieldOutcomeReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.OutcomeSource(); ok {
+		_spec.SetField(alertsession.FieldOutcomeSource, field.TypeEnum, value)
+	}
+	if _u.mutation.OutcomeSourceCleared() {
+		_spec.ClearField(alertsession.FieldOutcomeSource, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.SessionMetadata(); ok {
+		_spec.SetField(alertsession.FieldSessionMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.SessionMetadataCleared() {
+		_spec.ClearField(alertsession.FieldSessionMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(alertsession.FieldAuthor, field.TypeString, value)
+	}
+	if _u.mutation.AuthorCleared() {
+		_spec.ClearField(alertsession.FieldAuthor, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorSubject(); ok {
+		_spec.SetField(alertsession.FieldAuthorSubject, field.TypeString, value)
+	}
+	if _u.mutation.AuthorSubjectCleared() {
+		_spec.ClearField(alertsession.FieldAuthorSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunbookURL(); ok {
+		_spec.SetField(alertsession.FieldRunbookURL, field.TypeString, value)
+	}
+	if _u.mutation.RunbookURLCleared() {
+		_spec.ClearField(alertsession.FieldRunbookURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.McpSelection(); ok {
+		_spec.SetField(alertsession.FieldMcpSelection, field.TypeJSON, value)
+	}
+	if _u.mutation.McpSelectionCleared() {
+		_spec.ClearField(alertsession.FieldMcpSelection, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AlertInstructions(); ok {
+		_spec.SetField(alertsession.FieldAlertInstructions, field.TypeJSON, value)
+	}
+	if _u.mutation.AlertInstructionsCleared() {
+		_spec.ClearField(alertsession.FieldAlertInstructions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AlertImages(); ok {
+		_spec.SetField(alertsession.FieldAlertImages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAlertImages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, alertsession.FieldAlertImages, value)
+		})
+	}
+	if _u.mutation.AlertImagesCleared() {
+		_spec.ClearField(alertsession.FieldAlertImages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OutputLanguage(); ok {
+		_spec.SetField(alertsession.FieldOutputLanguage, field.TypeString, value)
+	}
+	if _u.mutation.OutputLanguageCleared() {
+		_spec.ClearField(alertsession.FieldOutputLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChainID(); ok {
+		_spec.SetField(alertsession.FieldChainID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(alertsession.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(alertsession.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.CurrentStageIndex(); ok {
+		_spec.SetField(alertsession.FieldCurrentStageIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentStageIndex(); ok {
+		_spec.AddField(alertsession.FieldCurrentStageIndex, field.TypeInt, value)
+	}
+	if _u.mutation.CurrentStageIndexCleared() {
+		_spec.ClearField(alertsession.FieldCurrentStageIndex, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CurrentStageID(); ok {
+		_spec.SetField(alertsession.FieldCurrentStageID, field.TypeString, value)
+	}
+	if _u.mutation.CurrentStageIDCleared() {
+		_spec.ClearField(alertsession.FieldCurrentStageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PodID(); ok {
+		_spec.SetField(alertsession.FieldPodID, field.TypeString, value)
+	}
+	if _u.mutation.PodIDCleared() {
+		_spec.ClearField(alertsession.FieldPodID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastInteractionAt(); ok {
+		_spec.SetField(alertsession.FieldLastInteractionAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastInteractionAtCleared() {
+		_spec.ClearField(alertsession.FieldLastInteractionAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SlackMessageFingerprint(); ok {
+		_spec.SetField(alertsession.FieldSlackMessageFingerprint, field.TypeString, value)
+	}
+	if _u.mutation.SlackMessageFingerprintCleared() {
+		_spec.ClearField(alertsession.FieldSlackMessageFingerprint, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertKey(); ok {
+		_spec.SetField(alertsession.FieldAlertKey, field.TypeString, value)
+	}
+	if _u.mutation.AlertKeyCleared() {
+		_spec.ClearField(alertsession.FieldAlertKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertResolvedAt(); ok {
+		_spec.SetField(alertsession.FieldAlertResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AlertResolvedAtCleared() {
+		_spec.ClearField(alertsession.FieldAlertResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AlertResolution(); ok {
+		_spec.SetField(alertsession.FieldAlertResolution, field.TypeString, value)
+	}
+	if _u.mutation.AlertResolutionCleared() {
+		_spec.ClearField(alertsession.FieldAlertResolution, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelInitiator(); ok {
+		_spec.SetField(alertsession.FieldCancelInitiator, field.TypeEnum, value)
+	}
+	if _u.mutation.CancelInitiatorCleared() {
+		_spec.ClearField(alertsession.FieldCancelInitiator, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.CancelReason(); ok {
+		_spec.SetField(alertsession.FieldCancelReason, field.TypeString, value)
+	}
+	if _u.mutation.CancelReasonCleared() {
+		_spec.ClearField(alertsession.FieldCancelReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelledBy(); ok {
+		_spec.SetField(alertsession.FieldCancelledBy, field.TypeString, value)
+	}
+	if _u.mutation.CancelledByCleared() {
+		_spec.ClearField(alertsession.FieldCancelledBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(alertsession.FieldRequestID, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(alertsession.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DegradedReason(); ok {
+		_spec.SetField(alertsession.FieldDegradedReason, field.TypeString, value)
+	}
+	if _u.mutation.DegradedReasonCleared() {
+		_spec.ClearField(alertsession.FieldDegradedReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ModelRouting(); ok {
+		_spec.SetField(alertsession.FieldModelRouting, field.TypeJSON, value)
+	}
+	if _u.mutation.ModelRoutingCleared() {
+		_spec.ClearField(alertsession.FieldModelRouting, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NoiseTriage(); ok {
+		_spec.SetField(alertsession.FieldNoiseTriage, field.TypeJSON, value)
+	}
+	if _u.mutation.NoiseTriageCleared() {
+		_spec.ClearField(alertsession.FieldNoiseTriage, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FederationOrigin(); ok {
+		_spec.SetField(alertsession.FieldFederationOrigin, field.TypeJSON, value)
+	}
+	if _u.mutation.FederationOriginCleared() {
+		_spec.ClearField(alertsession.FieldFederationOrigin, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(alertsession.FieldTarget, field.TypeJSON, value)
+	}
+	if _u.mutation.TargetCleared() {
+		_spec.ClearField(alertsession.FieldTarget, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OnCall(); ok {
+		_spec.SetField(alertsession.FieldOnCall, field.TypeJSON, value)
+	}
+	if _u.mutation.OnCallCleared() {
+		_spec.ClearField(alertsession.FieldOnCall, field.TypeJSON)
+	}
+	if _u.mutation.DuplicatedFromCleared() {
+		_spec.ClearField(alertsession.FieldDuplicatedFrom, field.TypeString)
+	}
+	if _u.mutation.LlmProviderCleared() {
+		_spec.ClearField(alertsession.FieldLlmProvider, field.TypeString)
+	}
+	if _u.mutation.CallbackURLCleared() {
+		_spec.ClearField(alertsession.FieldCallbackURL, field.TypeString)
+	}
+	if _u.mutation.CallbackSecretCleared() {
+		_spec.ClearField(alertsession.FieldCallbackSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.CallbackStatus(); ok {
+		_spec.SetField(alertsession.FieldCallbackStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.CallbackStatusCleared() {
+		_spec.ClearField(alertsession.FieldCallbackStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.CallbackAttempts(); ok {
+		_spec.SetField(alertsession.FieldCallbackAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCallbackAttempts(); ok {
+		_spec.AddField(alertsession.FieldCallbackAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CallbackLastError(); ok {
+		_spec.SetField(alertsession.FieldCallbackLastError, field.TypeString, value)
+	}
+	if _u.mutation.CallbackLastErrorCleared() {
+		_spec.ClearField(alertsession.FieldCallbackLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CallbackDeliveredAt(); ok {
+		_spec.SetField(alertsession.FieldCallbackDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.CallbackDeliveredAtCleared() {
+		_spec.ClearField(alertsession.FieldCallbackDeliveredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewStatus(); ok {
+		_spec.SetField(alertsession.FieldReviewStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.ReviewStatusCleared() {
+		_spec.ClearField(alertsession.FieldReviewStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Assignee(); ok {
+		_spec.SetField(alertsession.FieldAssignee, field.TypeString, value)
+	}
+	if _u.mutation.AssigneeCleared() {
+		_spec.ClearField(alertsession.FieldAssignee, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssignedAt(); ok {
+		_spec.SetField(alertsession.FieldAssignedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignedAtCleared() {
+		_spec.ClearField(alertsession.FieldAssignedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(alertsession.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(alertsession.FieldReviewedAt, field.TypeTime)
 	}
 	if value, ok := _u.mutation.QualityRating(); ok {
 		_spec.SetField(alertsession.FieldQualityRating, field.TypeEnum, value)
@@ -1234,6 +2292,30 @@ func (_u *AlertSessionUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.InvestigationFeedbackCleared() {
 		_spec.ClearField(alertsession.FieldInvestigationFeedback, field.TypeString)
 	}
+	if value, ok := _u.mutation.HandoffNotes(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotes, field.TypeString, value)
+	}
+	if _u.mutation.HandoffNotesCleared() {
+		_spec.ClearField(alertsession.FieldHandoffNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandoffNotesRevision(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHandoffNotesRevision(); ok {
+		_spec.AddField(alertsession.FieldHandoffNotesRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HandoffNotesUpdatedBy(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.HandoffNotesUpdatedByCleared() {
+		_spec.ClearField(alertsession.FieldHandoffNotesUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandoffNotesUpdatedAt(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HandoffNotesUpdatedAtCleared() {
+		_spec.ClearField(alertsession.FieldHandoffNotesUpdatedAt, field.TypeTime)
+	}
 	if _u.mutation.StagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1668,6 +2750,51 @@ func (_u *AlertSessionUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ClaimsTable,
+			Columns: []string{alertsession.ClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClaimsIDs(); len(nodes) > 0 && !_u.mutation.ClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ClaimsTable,
+			Columns: []string{alertsession.ClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ClaimsTable,
+			Columns: []string{alertsession.ClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.MemoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1758,426 +2885,1172 @@ func (_u *AlertSessionUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{alertsession.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.RedactionReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.RedactionReviewsTable,
+			Columns: []string{alertsession.RedactionReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	if nodes := _u.mutation.RemovedRedactionReviewsIDs(); len(nodes) > 0 && !_u.mutation.RedactionReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.RedactionReviewsTable,
+			Columns: []string{alertsession.RedactionReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RedactionReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.RedactionReviewsTable,
+			Columns: []string{alertsession.RedactionReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HandoffNoteRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.HandoffNoteRevisionsTable,
+			Columns: []string{alertsession.HandoffNoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHandoffNoteRevisionsIDs(); len(nodes) > 0 && !_u.mutation.HandoffNoteRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.HandoffNoteRevisionsTable,
+			Columns: []string{alertsession.HandoffNoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandoffNoteRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.HandoffNoteRevisionsTable,
+			Columns: []string{alertsession.HandoffNoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ComparisonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   alertsession.ComparisonTable,
+			Columns: []string{alertsession.ComparisonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ComparisonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   alertsession.ComparisonTable,
+			Columns: []string{alertsession.ComparisonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NextComparisonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.NextComparisonsTable,
+			Columns: []string{alertsession.NextComparisonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNextComparisonsIDs(); len(nodes) > 0 && !_u.mutation.NextComparisonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.NextComparisonsTable,
+			Columns: []string{alertsession.NextComparisonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NextComparisonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.NextComparisonsTable,
+			Columns: []string{alertsession.NextComparisonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActionItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ActionItemsTable,
+			Columns: []string{alertsession.ActionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActionItemsIDs(); len(nodes) > 0 && !_u.mutation.ActionItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ActionItemsTable,
+			Columns: []string{alertsession.ActionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActionItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ActionItemsTable,
+			Columns: []string{alertsession.ActionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AlertSessionUpdateOne is the builder for updating a single AlertSession entity.
+type AlertSessionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AlertSessionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlertSessionUpdateOne) SetUpdatedAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *AlertSessionUpdateOne) SetDeletedAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableDeletedAt(v *time.Time) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *AlertSessionUpdateOne) ClearDeletedAt() *AlertSessionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetAlertData sets the "alert_data" field.
+func (_u *AlertSessionUpdateOne) SetAlertData(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetAlertData(v)
+	return _u
+}
+
+// SetNillableAlertData sets the "alert_data" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableAlertData(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetAlertData(*v)
+	}
+	return _u
+}
+
+// SetAgentType sets the "agent_type" field.
+func (_u *AlertSessionUpdateOne) SetAgentType(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetAgentType(v)
+	return _u
+}
+
+// SetNillableAgentType sets the "agent_type" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableAgentType(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetAgentType(*v)
+	}
+	return _u
+}
+
+// SetAlertType sets the "alert_type" field.
+func (_u *AlertSessionUpdateOne) SetAlertType(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetAlertType(v)
+	return _u
+}
+
+// SetNillableAlertType sets the "alert_type" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableAlertType(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetAlertType(*v)
+	}
+	return _u
+}
+
+// ClearAlertType clears the value of the "alert_type" field.
+func (_u *AlertSessionUpdateOne) ClearAlertType() *AlertSessionUpdateOne {
+	_u.mutation.ClearAlertType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AlertSessionUpdateOne) SetStatus(v alertsession.Status) *AlertSessionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableStatus(v *alertsession.Status) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AlertSessionUpdateOne) SetCreatedAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCreatedAt(v *time.Time) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *AlertSessionUpdateOne) SetStartedAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableStartedAt(v *time.Time) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *AlertSessionUpdateOne) ClearStartedAt() *AlertSessionUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *AlertSessionUpdateOne) SetCompletedAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCompletedAt(v *time.Time) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *AlertSessionUpdateOne) ClearCompletedAt() *AlertSessionUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *AlertSessionUpdateOne) SetErrorMessage(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableErrorMessage(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *AlertSessionUpdateOne) ClearErrorMessage() *AlertSessionUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetFinalAnalysis sets the "final_analysis" field.
+func (_u *AlertSessionUpdateOne) SetFinalAnalysis(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetFinalAnalysis(v)
+	return _u
+}
+
+// SetNillableFinalAnalysis sets the "final_analysis" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableFinalAnalysis(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetFinalAnalysis(*v)
+	}
+	return _u
+}
+
+// ClearFinalAnalysis clears the value of the "final_analysis" field.
+func (_u *AlertSessionUpdateOne) ClearFinalAnalysis() *AlertSessionUpdateOne {
+	_u.mutation.ClearFinalAnalysis()
+	return _u
+}
+
+// SetExecutiveSummary sets the "executive_summary" field.
+func (_u *AlertSessionUpdateOne) SetExecutiveSummary(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetExecutiveSummary(v)
+	return _u
+}
+
+// SetNillableExecutiveSummary sets the "executive_summary" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableExecutiveSummary(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetExecutiveSummary(*v)
+	}
+	return _u
+}
+
+// ClearExecutiveSummary clears the value of the "executive_summary" field.
+func (_u *AlertSessionUpdateOne) ClearExecutiveSummary() *AlertSessionUpdateOne {
+	_u.mutation.ClearExecutiveSummary()
+	return _u
+}
+
+// SetExecutiveSummaryError sets the "executive_summary_error" field.
+func (_u *AlertSessionUpdateOne) SetExecutiveSummaryError(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetExecutiveSummaryError(v)
+	return _u
+}
+
+// SetNillableExecutiveSummaryError sets the "executive_summary_error" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableExecutiveSummaryError(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetExecutiveSummaryError(*v)
+	}
+	return _u
+}
+
+// ClearExecutiveSummaryError clears the value of the "executive_summary_error" field.
+func (_u *AlertSessionUpdateOne) ClearExecutiveSummaryError() *AlertSessionUpdateOne {
+	_u.mutation.ClearExecutiveSummaryError()
+	return _u
+}
+
+// SetExecutiveSummaryTemplate sets the "executive_summary_template" field.
+func (_u *AlertSessionUpdateOne) SetExecutiveSummaryTemplate(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetExecutiveSummaryTemplate(v)
+	return _u
+}
+
+// SetNillableExecutiveSummaryTemplate sets the "executive_summary_template" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableExecutiveSummaryTemplate(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetExecutiveSummaryTemplate(*v)
+	}
+	return _u
+}
+
+// ClearExecutiveSummaryTemplate clears the value of the "executive_summary_template" field.
+func (_u *AlertSessionUpdateOne) ClearExecutiveSummaryTemplate() *AlertSessionUpdateOne {
+	_u.mutation.ClearExecutiveSummaryTemplate()
+	return _u
+}
+
+// SetTechnicalSummary sets the "technical_summary" field.
+func (_u *AlertSessionUpdateOne) SetTechnicalSummary(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetTechnicalSummary(v)
+	return _u
+}
+
+// SetNillableTechnicalSummary sets the "technical_summary" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableTechnicalSummary(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetTechnicalSummary(*v)
+	}
+	return _u
+}
+
+// ClearTechnicalSummary clears the value of the "technical_summary" field.
+func (_u *AlertSessionUpdateOne) ClearTechnicalSummary() *AlertSessionUpdateOne {
+	_u.mutation.ClearTechnicalSummary()
+	return _u
+}
+
+// SetTechnicalSummaryError sets the "technical_summary_error" field.
+func (_u *AlertSessionUpdateOne) SetTechnicalSummaryError(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetTechnicalSummaryError(v)
+	return _u
+}
+
+// SetNillableTechnicalSummaryError sets the "technical_summary_error" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableTechnicalSummaryError(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetTechnicalSummaryError(*v)
+	}
+	return _u
+}
+
+// ClearTechnicalSummaryError clears the value of the "technical_summary_error" field.
+func (_u *AlertSessionUpdateOne) ClearTechnicalSummaryError() *AlertSessionUpdateOne {
+	_u.mutation.ClearTechnicalSummaryError()
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *AlertSessionUpdateOne) SetSeverity(v alertsession.Severity) *AlertSessionUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableSeverity(v *alertsession.Severity) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// ClearSeverity clears the value of the "severity" field.
+func (_u *AlertSessionUpdateOne) ClearSeverity() *AlertSessionUpdateOne {
+	_u.mutation.ClearSeverity()
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *AlertSessionUpdateOne) SetOutcome(v alertsession.Outcome) *AlertSessionUpdateOne {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableOutcome(v *alertsession.Outcome) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// ClearOutcome clears the value of the "outcome" field.
+func (_u *AlertSessionUpdateOne) ClearOutcome() *AlertSessionUpdateOne {
+	_u.mutation.ClearOutcome()
+	return _u
+}
+
+// SetOutcomeReason sets the "outcome_reason" field.
+func (_u *AlertSessionUpdateOne) SetOutcomeReason(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetOutcomeReason(v)
+	return _u
+}
+
+// SetNillableOutcomeReason sets the "outcome_reason" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableOutcomeReason(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetOutcomeReason(*v)
+	}
+	return _u
+}
+
+// ClearOutcomeReason clears the value of the "outcome_reason" field.
+func (_u *AlertSessionUpdateOne) ClearOutcomeReason() *AlertSessionUpdateOne {
+	_u.mutation.ClearOutcomeReason()
+	return _u
+}
+
+// SetOutcomeSource sets the "outcome_source" field.
+func (_u *AlertSessionUpdateOne) SetOutcomeSource(v alertsession.OutcomeSource) *AlertSessionUpdateOne {
+	_u.mutation.SetOutcomeSource(v)
+	return _u
+}
+
+// SetNillableOutcomeSource sets the "outcome_source" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableOutcomeSource(v *alertsession.OutcomeSource) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetOutcomeSource(*v)
+	}
+	return _u
+}
+
+// ClearOutcomeSource clears the value of the "outcome_source" field.
+func (_u *AlertSessionUpdateOne) ClearOutcomeSource() *AlertSessionUpdateOne {
+	_u.mutation.ClearOutcomeSource()
+	return _u
+}
+
+// SetSessionMetadata sets the "session_metadata" field.
+func (_u *AlertSessionUpdateOne) SetSessionMetadata(v map[string]interface{}) *AlertSessionUpdateOne {
+	_u.mutation.SetSessionMetadata(v)
+	return _u
+}
+
+// ClearSessionMetadata clears the value of the "session_metadata" field.
+func (_u *AlertSessionUpdateOne) ClearSessionMetadata() *AlertSessionUpdateOne {
+	_u.mutation.ClearSessionMetadata()
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *AlertSessionUpdateOne) SetAuthor(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableAuthor(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (_u *AlertSessionUpdateOne) ClearAuthor() *AlertSessionUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// SetAuthorSubject sets the "author_subject" field.
+func (_u *AlertSessionUpdateOne) SetAuthorSubject(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetAuthorSubject(v)
+	return _u
+}
+
+// SetNillableAuthorSubject sets the "author_subject" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableAuthorSubject(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetAuthorSubject(*v)
+	}
+	return _u
+}
+
+// ClearAuthorSubject clears the value of the "author_subject" field.
+func (_u *AlertSessionUpdateOne) ClearAuthorSubject() *AlertSessionUpdateOne {
+	_u.mutation.ClearAuthorSubject()
+	return _u
+}
+
+// SetRunbookURL sets the "runbook_url" field.
+func (_u *AlertSessionUpdateOne) SetRunbookURL(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetRunbookURL(v)
+	return _u
+}
+
+// SetNillableRunbookURL sets the "runbook_url" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableRunbookURL(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetRunbookURL(*v)
+	}
+	return _u
+}
+
+// ClearRunbookURL clears the value of the "runbook_url" field.
+func (_u *AlertSessionUpdateOne) ClearRunbookURL() *AlertSessionUpdateOne {
+	_u.mutation.ClearRunbookURL()
+	return _u
 }
 
-// AlertSessionUpdateOne is the builder for updating a single AlertSession entity.
-type AlertSessionUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *AlertSessionMutation
-	modifiers []func(*sql.UpdateBuilder)
+// SetMcpSelection sets the "mcp_selection" field.
+func (_u *AlertSessionUpdateOne) SetMcpSelection(v map[string]interface{}) *AlertSessionUpdateOne {
+	_u.mutation.SetMcpSelection(v)
+	return _u
 }
 
-// SetAlertData sets the "alert_data" field.
-func (_u *AlertSessionUpdateOne) SetAlertData(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetAlertData(v)
+// ClearMcpSelection clears the value of the "mcp_selection" field.
+func (_u *AlertSessionUpdateOne) ClearMcpSelection() *AlertSessionUpdateOne {
+	_u.mutation.ClearMcpSelection()
 	return _u
 }
 
-// SetNillableAlertData sets the "alert_data" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableAlertData(v *string) *AlertSessionUpdateOne {
+// SetAlertInstructions sets the "alert_instructions" field.
+func (_u *AlertSessionUpdateOne) SetAlertInstructions(v map[string]interface{}) *AlertSessionUpdateOne {
+	_u.mutation.SetAlertInstructions(v)
+	return _u
+}
+
+// ClearAlertInstructions clears the value of the "alert_instructions" field.
+func (_u *AlertSessionUpdateOne) ClearAlertInstructions() *AlertSessionUpdateOne {
+	_u.mutation.ClearAlertInstructions()
+	return _u
+}
+
+// SetAlertImages sets the "alert_images" field.
+func (_u *AlertSessionUpdateOne) SetAlertImages(v []schema.ImageRef) *AlertSessionUpdateOne {
+	_u.mutation.SetAlertImages(v)
+	return _u
+}
+
+// AppendAlertImages appends value to the "alert_images" field.
+func (_u *AlertSessionUpdateOne) AppendAlertImages(v []schema.ImageRef) *AlertSessionUpdateOne {
+	_u.mutation.AppendAlertImages(v)
+	return _u
+}
+
+// ClearAlertImages clears the value of the "alert_images" field.
+func (_u *AlertSessionUpdateOne) ClearAlertImages() *AlertSessionUpdateOne {
+	_u.mutation.ClearAlertImages()
+	return _u
+}
+
+// SetOutputLanguage sets the "output_language" field.
+func (_u *AlertSessionUpdateOne) SetOutputLanguage(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetOutputLanguage(v)
+	return _u
+}
+
+// SetNillableOutputLanguage sets the "output_language" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableOutputLanguage(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetAlertData(*v)
+		_u.SetOutputLanguage(*v)
 	}
 	return _u
 }
 
-// SetAgentType sets the "agent_type" field.
-func (_u *AlertSessionUpdateOne) SetAgentType(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetAgentType(v)
+// ClearOutputLanguage clears the value of the "output_language" field.
+func (_u *AlertSessionUpdateOne) ClearOutputLanguage() *AlertSessionUpdateOne {
+	_u.mutation.ClearOutputLanguage()
 	return _u
 }
 
-// SetNillableAgentType sets the "agent_type" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableAgentType(v *string) *AlertSessionUpdateOne {
+// SetChainID sets the "chain_id" field.
+func (_u *AlertSessionUpdateOne) SetChainID(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetChainID(v)
+	return _u
+}
+
+// SetNillableChainID sets the "chain_id" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableChainID(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetAgentType(*v)
+		_u.SetChainID(*v)
 	}
 	return _u
 }
 
-// SetAlertType sets the "alert_type" field.
-func (_u *AlertSessionUpdateOne) SetAlertType(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetAlertType(v)
+// SetOwner sets the "owner" field.
+func (_u *AlertSessionUpdateOne) SetOwner(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetOwner(v)
 	return _u
 }
 
-// SetNillableAlertType sets the "alert_type" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableAlertType(v *string) *AlertSessionUpdateOne {
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableOwner(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetAlertType(*v)
+		_u.SetOwner(*v)
 	}
 	return _u
 }
 
-// ClearAlertType clears the value of the "alert_type" field.
-func (_u *AlertSessionUpdateOne) ClearAlertType() *AlertSessionUpdateOne {
-	_u.mutation.ClearAlertType()
+// ClearOwner clears the value of the "owner" field.
+func (_u *AlertSessionUpdateOne) ClearOwner() *AlertSessionUpdateOne {
+	_u.mutation.ClearOwner()
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *AlertSessionUpdateOne) SetStatus(v alertsession.Status) *AlertSessionUpdateOne {
-	_u.mutation.SetStatus(v)
+// SetCurrentStageIndex sets the "current_stage_index" field.
+func (_u *AlertSessionUpdateOne) SetCurrentStageIndex(v int) *AlertSessionUpdateOne {
+	_u.mutation.ResetCurrentStageIndex()
+	_u.mutation.SetCurrentStageIndex(v)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableStatus(v *alertsession.Status) *AlertSessionUpdateOne {
+// SetNillableCurrentStageIndex sets the "current_stage_index" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCurrentStageIndex(v *int) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetCurrentStageIndex(*v)
 	}
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *AlertSessionUpdateOne) SetCreatedAt(v time.Time) *AlertSessionUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// AddCurrentStageIndex adds value to the "current_stage_index" field.
+func (_u *AlertSessionUpdateOne) AddCurrentStageIndex(v int) *AlertSessionUpdateOne {
+	_u.mutation.AddCurrentStageIndex(v)
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableCreatedAt(v *time.Time) *AlertSessionUpdateOne {
-	if v != nil {
-		_u.SetCreatedAt(*v)
-	}
+// ClearCurrentStageIndex clears the value of the "current_stage_index" field.
+func (_u *AlertSessionUpdateOne) ClearCurrentStageIndex() *AlertSessionUpdateOne {
+	_u.mutation.ClearCurrentStageIndex()
 	return _u
 }
 
-// SetStartedAt sets the "started_at" field.
-func (_u *AlertSessionUpdateOne) SetStartedAt(v time.Time) *AlertSessionUpdateOne {
-	_u.mutation.SetStartedAt(v)
+// SetCurrentStageID sets the "current_stage_id" field.
+func (_u *AlertSessionUpdateOne) SetCurrentStageID(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetCurrentStageID(v)
 	return _u
 }
 
-// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableStartedAt(v *time.Time) *AlertSessionUpdateOne {
+// SetNillableCurrentStageID sets the "current_stage_id" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCurrentStageID(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetStartedAt(*v)
+		_u.SetCurrentStageID(*v)
 	}
 	return _u
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (_u *AlertSessionUpdateOne) ClearStartedAt() *AlertSessionUpdateOne {
-	_u.mutation.ClearStartedAt()
+// ClearCurrentStageID clears the value of the "current_stage_id" field.
+func (_u *AlertSessionUpdateOne) ClearCurrentStageID() *AlertSessionUpdateOne {
+	_u.mutation.ClearCurrentStageID()
 	return _u
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (_u *AlertSessionUpdateOne) SetCompletedAt(v time.Time) *AlertSessionUpdateOne {
-	_u.mutation.SetCompletedAt(v)
+// SetPodID sets the "pod_id" field.
+func (_u *AlertSessionUpdateOne) SetPodID(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetPodID(v)
 	return _u
 }
 
-// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableCompletedAt(v *time.Time) *AlertSessionUpdateOne {
+// SetNillablePodID sets the "pod_id" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillablePodID(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetCompletedAt(*v)
+		_u.SetPodID(*v)
 	}
 	return _u
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (_u *AlertSessionUpdateOne) ClearCompletedAt() *AlertSessionUpdateOne {
-	_u.mutation.ClearCompletedAt()
+// ClearPodID clears the value of the "pod_id" field.
+func (_u *AlertSessionUpdateOne) ClearPodID() *AlertSessionUpdateOne {
+	_u.mutation.ClearPodID()
 	return _u
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (_u *AlertSessionUpdateOne) SetErrorMessage(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetErrorMessage(v)
+// SetLastInteractionAt sets the "last_interaction_at" field.
+func (_u *AlertSessionUpdateOne) SetLastInteractionAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetLastInteractionAt(v)
 	return _u
 }
 
-// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableErrorMessage(v *string) *AlertSessionUpdateOne {
+// SetNillableLastInteractionAt sets the "last_interaction_at" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableLastInteractionAt(v *time.Time) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetErrorMessage(*v)
+		_u.SetLastInteractionAt(*v)
 	}
 	return _u
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (_u *AlertSessionUpdateOne) ClearErrorMessage() *AlertSessionUpdateOne {
-	_u.mutation.ClearErrorMessage()
+// ClearLastInteractionAt clears the value of the "last_interaction_at" field.
+func (_u *AlertSessionUpdateOne) ClearLastInteractionAt() *AlertSessionUpdateOne {
+	_u.mutation.ClearLastInteractionAt()
 	return _u
 }
 
-// SetFinalAnalysis sets the "final_analysis" field.
-func (_u *AlertSessionUpdateOne) SetFinalAnalysis(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetFinalAnalysis(v)
+// SetSlackMessageFingerprint sets the "slack_message_fingerprint" field.
+func (_u *AlertSessionUpdateOne) SetSlackMessageFingerprint(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetSlackMessageFingerprint(v)
 	return _u
 }
 
-// SetNillableFinalAnalysis sets the "final_analysis" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableFinalAnalysis(v *string) *AlertSessionUpdateOne {
+// SetNillableSlackMessageFingerprint sets the "slack_message_fingerprint" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableSlackMessageFingerprint(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetFinalAnalysis(*v)
+		_u.SetSlackMessageFingerprint(*v)
 	}
 	return _u
 }
 
-// ClearFinalAnalysis clears the value of the "final_analysis" field.
-func (_u *AlertSessionUpdateOne) ClearFinalAnalysis() *AlertSessionUpdateOne {
-	_u.mutation.ClearFinalAnalysis()
+// ClearSlackMessageFingerprint clears the value of the "slack_message_fingerprint" field.
+func (_u *AlertSessionUpdateOne) ClearSlackMessageFingerprint() *AlertSessionUpdateOne {
+	_u.mutation.ClearSlackMessageFingerprint()
 	return _u
 }
 
-// SetExecutiveSummary sets the "executive_summary" field.
-func (_u *AlertSessionUpdateOne) SetExecutiveSummary(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetExecutiveSummary(v)
+// SetAlertKey sets the "alert_key" field.
+func (_u *AlertSessionUpdateOne) SetAlertKey(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetAlertKey(v)
 	return _u
 }
 
-// SetNillableExecutiveSummary sets the "executive_summary" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableExecutiveSummary(v *string) *AlertSessionUpdateOne {
+// SetNillableAlertKey sets the "alert_key" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableAlertKey(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetExecutiveSummary(*v)
+		_u.SetAlertKey(*v)
 	}
 	return _u
 }
 
-// ClearExecutiveSummary clears the value of the "executive_summary" field.
-func (_u *AlertSessionUpdateOne) ClearExecutiveSummary() *AlertSessionUpdateOne {
-	_u.mutation.ClearExecutiveSummary()
+// ClearAlertKey clears the value of the "alert_key" field.
+func (_u *AlertSessionUpdateOne) ClearAlertKey() *AlertSessionUpdateOne {
+	_u.mutation.ClearAlertKey()
 	return _u
 }
 
-// SetExecutiveSummaryError sets the "executive_summary_error" field.
-func (_u *AlertSessionUpdateOne) SetExecutiveSummaryError(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetExecutiveSummaryError(v)
+// SetAlertResolvedAt sets the "alert_resolved_at" field.
+func (_u *AlertSessionUpdateOne) SetAlertResolvedAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetAlertResolvedAt(v)
 	return _u
 }
 
-// SetNillableExecutiveSummaryError sets the "executive_summary_error" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableExecutiveSummaryError(v *string) *AlertSessionUpdateOne {
+// SetNillableAlertResolvedAt sets the "alert_resolved_at" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableAlertResolvedAt(v *time.Time) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetExecutiveSummaryError(*v)
+		_u.SetAlertResolvedAt(*v)
 	}
 	return _u
 }
 
-// ClearExecutiveSummaryError clears the value of the "executive_summary_error" field.
-func (_u *AlertSessionUpdateOne) ClearExecutiveSummaryError() *AlertSessionUpdateOne {
-	_u.mutation.ClearExecutiveSummaryError()
+// ClearAlertResolvedAt clears the value of the "alert_resolved_at" field.
+func (_u *AlertSessionUpdateOne) ClearAlertResolvedAt() *AlertSessionUpdateOne {
+	_u.mutation.ClearAlertResolvedAt()
 	return _u
 }
 
-// SetSessionMetadata sets the "session_metadata" field.
-func (_u *AlertSessionUpdateOne) SetSessionMetadata(v map[string]interface{}) *AlertSessionUpdateOne {
-	_u.mutation.SetSessionMetadata(v)
+// SetAlertResolution sets the "alert_resolution" field.
+func (_u *AlertSessionUpdateOne) SetAlertResolution(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetAlertResolution(v)
 	return _u
 }
 
-// ClearSessionMetadata clears the value of the "session_metadata" field.
-func (_u *AlertSessionUpdateOne) ClearSessionMetadata() *AlertSessionUpdateOne {
-	_u.mutation.ClearSessionMetadata()
+// SetNillableAlertResolution sets the "alert_resolution" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableAlertResolution(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetAlertResolution(*v)
+	}
 	return _u
 }
 
-// SetAuthor sets the "author" field.
-func (_u *AlertSessionUpdateOne) SetAuthor(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetAuthor(v)
+// ClearAlertResolution clears the value of the "alert_resolution" field.
+func (_u *AlertSessionUpdateOne) ClearAlertResolution() *AlertSessionUpdateOne {
+	_u.mutation.ClearAlertResolution()
 	return _u
 }
 
-// SetNillableAuthor sets the "author" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableAuthor(v *string) *AlertSessionUpdateOne {
+// SetCancelInitiator sets the "cancel_initiator" field.
+func (_u *AlertSessionUpdateOne) SetCancelInitiator(v alertsession.CancelInitiator) *AlertSessionUpdateOne {
+	_u.mutation.SetCancelInitiator(v)
+	return _u
+}
+
+// SetNillableCancelInitiator sets the "cancel_initiator" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCancelInitiator(v *alertsession.CancelInitiator) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetAuthor(*v)
+		_u.SetCancelInitiator(*v)
 	}
 	return _u
 }
 
-// ClearAuthor clears the value of the "author" field.
-func (_u *AlertSessionUpdateOne) ClearAuthor() *AlertSessionUpdateOne {
-	_u.mutation.ClearAuthor()
+// ClearCancelInitiator clears the value of the "cancel_initiator" field.
+func (_u *AlertSessionUpdateOne) ClearCancelInitiator() *AlertSessionUpdateOne {
+	_u.mutation.ClearCancelInitiator()
 	return _u
 }
 
-// SetRunbookURL sets the "runbook_url" field.
-func (_u *AlertSessionUpdateOne) SetRunbookURL(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetRunbookURL(v)
+// SetCancelReason sets the "cancel_reason" field.
+func (_u *AlertSessionUpdateOne) SetCancelReason(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetCancelReason(v)
 	return _u
 }
 
-// SetNillableRunbookURL sets the "runbook_url" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableRunbookURL(v *string) *AlertSessionUpdateOne {
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCancelReason(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetRunbookURL(*v)
+		_u.SetCancelReason(*v)
 	}
 	return _u
 }
 
-// ClearRunbookURL clears the value of the "runbook_url" field.
-func (_u *AlertSessionUpdateOne) ClearRunbookURL() *AlertSessionUpdateOne {
-	_u.mutation.ClearRunbookURL()
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (_u *AlertSessionUpdateOne) ClearCancelReason() *AlertSessionUpdateOne {
+	_u.mutation.ClearCancelReason()
 	return _u
 }
 
-// SetMcpSelection sets the "mcp_selection" field.
-func (_u *AlertSessionUpdateOne) SetMcpSelection(v map[string]interface{}) *AlertSessionUpdateOne {
-	_u.mutation.SetMcpSelection(v)
+// SetCancelledBy sets the "cancelled_by" field.
+func (_u *AlertSessionUpdateOne) SetCancelledBy(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetCancelledBy(v)
 	return _u
 }
 
-// ClearMcpSelection clears the value of the "mcp_selection" field.
-func (_u *AlertSessionUpdateOne) ClearMcpSelection() *AlertSessionUpdateOne {
-	_u.mutation.ClearMcpSelection()
+// SetNillableCancelledBy sets the "cancelled_by" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCancelledBy(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetCancelledBy(*v)
+	}
 	return _u
 }
 
-// SetChainID sets the "chain_id" field.
-func (_u *AlertSessionUpdateOne) SetChainID(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetChainID(v)
+// ClearCancelledBy clears the value of the "cancelled_by" field.
+func (_u *AlertSessionUpdateOne) ClearCancelledBy() *AlertSessionUpdateOne {
+	_u.mutation.ClearCancelledBy()
 	return _u
 }
 
-// SetNillableChainID sets the "chain_id" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableChainID(v *string) *AlertSessionUpdateOne {
+// SetRequestID sets the "request_id" field.
+func (_u *AlertSessionUpdateOne) SetRequestID(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableRequestID(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetChainID(*v)
+		_u.SetRequestID(*v)
 	}
 	return _u
 }
 
-// SetCurrentStageIndex sets the "current_stage_index" field.
-func (_u *AlertSessionUpdateOne) SetCurrentStageIndex(v int) *AlertSessionUpdateOne {
-	_u.mutation.ResetCurrentStageIndex()
-	_u.mutation.SetCurrentStageIndex(v)
+// ClearRequestID clears the value of the "request_id" field.
+func (_u *AlertSessionUpdateOne) ClearRequestID() *AlertSessionUpdateOne {
+	_u.mutation.ClearRequestID()
 	return _u
 }
 
-// SetNillableCurrentStageIndex sets the "current_stage_index" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableCurrentStageIndex(v *int) *AlertSessionUpdateOne {
+// SetDegradedReason sets the "degraded_reason" field.
+func (_u *AlertSessionUpdateOne) SetDegradedReason(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetDegradedReason(v)
+	return _u
+}
+
+// SetNillableDegradedReason sets the "degraded_reason" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableDegradedReason(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetCurrentStageIndex(*v)
+		_u.SetDegradedReason(*v)
 	}
 	return _u
 }
 
-// AddCurrentStageIndex adds value to the "current_stage_index" field.
-func (_u *AlertSessionUpdateOne) AddCurrentStageIndex(v int) *AlertSessionUpdateOne {
-	_u.mutation.AddCurrentStageIndex(v)
+// ClearDegradedReason clears the value of the "degraded_reason" field.
+func (_u *AlertSessionUpdateOne) ClearDegradedReason() *AlertSessionUpdateOne {
+	_u.mutation.ClearDegradedReason()
+	return _u
+}
+
+// SetModelRouting sets the "model_routing" field.
+func (_u *AlertSessionUpdateOne) SetModelRouting(v *schema.ModelRoutingDecision) *AlertSessionUpdateOne {
+	_u.mutation.SetModelRouting(v)
+	return _u
+}
+
+// ClearModelRouting clears the value of the "model_routing" field.
+func (_u *AlertSessionUpdateOne) ClearModelRouting() *AlertSessionUpdateOne {
+	_u.mutation.ClearModelRouting()
+	return _u
+}
+
+// SetNoiseTriage sets the "noise_triage" field.
+func (_u *AlertSessionUpdateOne) SetNoiseTriage(v *schema.NoiseTriageDecision) *AlertSessionUpdateOne {
+	_u.mutation.SetNoiseTriage(v)
+	return _u
+}
+
+// ClearNoiseTriage clears the value of the "noise_triage" field.
+func (_u *AlertSessionUpdateOne) ClearNoiseTriage() *AlertSessionUpdateOne {
+	_u.mutation.ClearNoiseTriage()
+	return _u
+}
+
+// SetFederationOrigin sets the "federation_origin" field.
+func (_u *AlertSessionUpdateOne) SetFederationOrigin(v *schema.FederationOrigin) *AlertSessionUpdateOne {
+	_u.mutation.SetFederationOrigin(v)
+	return _u
+}
+
+// ClearFederationOrigin clears the value of the "federation_origin" field.
+func (_u *AlertSessionUpdateOne) ClearFederationOrigin() *AlertSessionUpdateOne {
+	_u.mutation.ClearFederationOrigin()
 	return _u
 }
 
-// ClearCurrentStageIndex clears the value of the "current_stage_index" field.
-func (_u *AlertSessionUpdateOne) ClearCurrentStageIndex() *AlertSessionUpdateOne {
-	_u.mutation.ClearCurrentStageIndex()
+// SetTarget sets the "target" field.
+func (_u *AlertSessionUpdateOne) SetTarget(v *schema.AlertTarget) *AlertSessionUpdateOne {
+	_u.mutation.SetTarget(v)
 	return _u
 }
 
-// SetCurrentStageID sets the "current_stage_id" field.
-func (_u *AlertSessionUpdateOne) SetCurrentStageID(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetCurrentStageID(v)
+// ClearTarget clears the value of the "target" field.
+func (_u *AlertSessionUpdateOne) ClearTarget() *AlertSessionUpdateOne {
+	_u.mutation.ClearTarget()
 	return _u
 }
 
-// SetNillableCurrentStageID sets the "current_stage_id" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableCurrentStageID(v *string) *AlertSessionUpdateOne {
-	if v != nil {
-		_u.SetCurrentStageID(*v)
-	}
+// SetOnCall sets the "on_call" field.
+func (_u *AlertSessionUpdateOne) SetOnCall(v *schema.OnCallContact) *AlertSessionUpdateOne {
+	_u.mutation.SetOnCall(v)
 	return _u
 }
 
-// ClearCurrentStageID clears the value of the "current_stage_id" field.
-func (_u *AlertSessionUpdateOne) ClearCurrentStageID() *AlertSessionUpdateOne {
-	_u.mutation.ClearCurrentStageID()
+// ClearOnCall clears the value of the "on_call" field.
+func (_u *AlertSessionUpdateOne) ClearOnCall() *AlertSessionUpdateOne {
+	_u.mutation.ClearOnCall()
 	return _u
 }
 
-// SetPodID sets the "pod_id" field.
-func (_u *AlertSessionUpdateOne) SetPodID(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetPodID(v)
+// SetCallbackStatus sets the "callback_status" field.
+func (_u *AlertSessionUpdateOne) SetCallbackStatus(v alertsession.CallbackStatus) *AlertSessionUpdateOne {
+	_u.mutation.SetCallbackStatus(v)
 	return _u
 }
 
-// SetNillablePodID sets the "pod_id" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillablePodID(v *string) *AlertSessionUpdateOne {
+// SetNillableCallbackStatus sets the "callback_status" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCallbackStatus(v *alertsession.CallbackStatus) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetPodID(*v)
+		_u.SetCallbackStatus(*v)
 	}
 	return _u
 }
 
-// ClearPodID clears the value of the "pod_id" field.
-func (_u *AlertSessionUpdateOne) ClearPodID() *AlertSessionUpdateOne {
-	_u.mutation.ClearPodID()
+// ClearCallbackStatus clears the value of the "callback_status" field.
+func (_u *AlertSessionUpdateOne) ClearCallbackStatus() *AlertSessionUpdateOne {
+	_u.mutation.ClearCallbackStatus()
 	return _u
 }
 
-// SetLastInteractionAt sets the "last_interaction_at" field.
-func (_u *AlertSessionUpdateOne) SetLastInteractionAt(v time.Time) *AlertSessionUpdateOne {
-	_u.mutation.SetLastInteractionAt(v)
+// SetCallbackAttempts sets the "callback_attempts" field.
+func (_u *AlertSessionUpdateOne) SetCallbackAttempts(v int) *AlertSessionUpdateOne {
+	_u.mutation.ResetCallbackAttempts()
+	_u.mutation.SetCallbackAttempts(v)
 	return _u
 }
 
-// SetNillableLastInteractionAt sets the "last_interaction_at" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableLastInteractionAt(v *time.Time) *AlertSessionUpdateOne {
+// SetNillableCallbackAttempts sets the "callback_attempts" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCallbackAttempts(v *int) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetLastInteractionAt(*v)
+		_u.SetCallbackAttempts(*v)
 	}
 	return _u
 }
 
-// ClearLastInteractionAt clears the value of the "last_interaction_at" field.
-func (_u *AlertSessionUpdateOne) ClearLastInteractionAt() *AlertSessionUpdateOne {
-	_u.mutation.ClearLastInteractionAt()
+// AddCallbackAttempts adds value to the "callback_attempts" field.
+func (_u *AlertSessionUpdateOne) AddCallbackAttempts(v int) *AlertSessionUpdateOne {
+	_u.mutation.AddCallbackAttempts(v)
 	return _u
 }
 
-// SetSlackMessageFingerprint sets the "slack_message_fingerprint" field.
-func (_u *AlertSessionUpdateOne) SetSlackMessageFingerprint(v string) *AlertSessionUpdateOne {
-	_u.mutation.SetSlackMessageFingerprint(v)
+// SetCallbackLastError sets the "callback_last_error" field.
+func (_u *AlertSessionUpdateOne) SetCallbackLastError(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetCallbackLastError(v)
 	return _u
 }
 
-// SetNillableSlackMessageFingerprint sets the "slack_message_fingerprint" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableSlackMessageFingerprint(v *string) *AlertSessionUpdateOne {
+// SetNillableCallbackLastError sets the "callback_last_error" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCallbackLastError(v *string) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetSlackMessageFingerprint(*v)
+		_u.SetCallbackLastError(*v)
 	}
 	return _u
 }
 
-// ClearSlackMessageFingerprint clears the value of the "slack_message_fingerprint" field.
-func (_u *AlertSessionUpdateOne) ClearSlackMessageFingerprint() *AlertSessionUpdateOne {
-	_u.mutation.ClearSlackMessageFingerprint()
+// ClearCallbackLastError clears the value of the "callback_last_error" field.
+func (_u *AlertSessionUpdateOne) ClearCallbackLastError() *AlertSessionUpdateOne {
+	_u.mutation.ClearCallbackLastError()
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *AlertSessionUpdateOne) SetDeletedAt(v time.Time) *AlertSessionUpdateOne {
-	_u.mutation.SetDeletedAt(v)
+// SetCallbackDeliveredAt sets the "callback_delivered_at" field.
+func (_u *AlertSessionUpdateOne) SetCallbackDeliveredAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetCallbackDeliveredAt(v)
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *AlertSessionUpdateOne) SetNillableDeletedAt(v *time.Time) *AlertSessionUpdateOne {
+// SetNillableCallbackDeliveredAt sets the "callback_delivered_at" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableCallbackDeliveredAt(v *time.Time) *AlertSessionUpdateOne {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetCallbackDeliveredAt(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *AlertSessionUpdateOne) ClearDeletedAt() *AlertSessionUpdateOne {
-	_u.mutation.ClearDeletedAt()
+// ClearCallbackDeliveredAt clears the value of the "callback_delivered_at" field.
+func (_u *AlertSessionUpdateOne) ClearCallbackDeliveredAt() *AlertSessionUpdateOne {
+	_u.mutation.ClearCallbackDeliveredAt()
 	return _u
 }
 
@@ -2321,6 +4194,87 @@ func (_u *AlertSessionUpdateOne) ClearInvestigationFeedback() *AlertSessionUpdat
 	return _u
 }
 
+// SetHandoffNotes sets the "handoff_notes" field.
+func (_u *AlertSessionUpdateOne) SetHandoffNotes(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetHandoffNotes(v)
+	return _u
+}
+
+// SetNillableHandoffNotes sets the "handoff_notes" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableHandoffNotes(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetHandoffNotes(*v)
+	}
+	return _u
+}
+
+// ClearHandoffNotes clears the value of the "handoff_notes" field.
+func (_u *AlertSessionUpdateOne) ClearHandoffNotes() *AlertSessionUpdateOne {
+	_u.mutation.ClearHandoffNotes()
+	return _u
+}
+
+// SetHandoffNotesRevision sets the "handoff_notes_revision" field.
+func (_u *AlertSessionUpdateOne) SetHandoffNotesRevision(v int) *AlertSessionUpdateOne {
+	_u.mutation.ResetHandoffNotesRevision()
+	_u.mutation.SetHandoffNotesRevision(v)
+	return _u
+}
+
+// SetNillableHandoffNotesRevision sets the "handoff_notes_revision" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableHandoffNotesRevision(v *int) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetHandoffNotesRevision(*v)
+	}
+	return _u
+}
+
+// AddHandoffNotesRevision adds value to the "handoff_notes_revision" field.
+func (_u *AlertSessionUpdateOne) AddHandoffNotesRevision(v int) *AlertSessionUpdateOne {
+	_u.mutation.AddHandoffNotesRevision(v)
+	return _u
+}
+
+// SetHandoffNotesUpdatedBy sets the "handoff_notes_updated_by" field.
+func (_u *AlertSessionUpdateOne) SetHandoffNotesUpdatedBy(v string) *AlertSessionUpdateOne {
+	_u.mutation.SetHandoffNotesUpdatedBy(v)
+	return _u
+}
+
+// SetNillableHandoffNotesUpdatedBy sets the "handoff_notes_updated_by" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableHandoffNotesUpdatedBy(v *string) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetHandoffNotesUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearHandoffNotesUpdatedBy clears the value of the "handoff_notes_updated_by" field.
+func (_u *AlertSessionUpdateOne) ClearHandoffNotesUpdatedBy() *AlertSessionUpdateOne {
+	_u.mutation.ClearHandoffNotesUpdatedBy()
+	return _u
+}
+
+// SetHandoffNotesUpdatedAt sets the "handoff_notes_updated_at" field.
+func (_u *AlertSessionUpdateOne) SetHandoffNotesUpdatedAt(v time.Time) *AlertSessionUpdateOne {
+	_u.mutation.SetHandoffNotesUpdatedAt(v)
+	return _u
+}
+
+// SetNillableHandoffNotesUpdatedAt sets the "handoff_notes_updated_at" field if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableHandoffNotesUpdatedAt(v *time.Time) *AlertSessionUpdateOne {
+	if v != nil {
+		_u.SetHandoffNotesUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearHandoffNotesUpdatedAt clears the value of the "handoff_notes_updated_at" field.
+func (_u *AlertSessionUpdateOne) ClearHandoffNotesUpdatedAt() *AlertSessionUpdateOne {
+	_u.mutation.ClearHandoffNotesUpdatedAt()
+	return _u
+}
+
 // AddStageIDs adds the "stages" edge to the Stage entity by IDs.
 func (_u *AlertSessionUpdateOne) AddStageIDs(ids ...string) *AlertSessionUpdateOne {
 	_u.mutation.AddStageIDs(ids...)
@@ -2475,6 +4429,21 @@ func (_u *AlertSessionUpdateOne) AddReviewActivities(v ...*SessionReviewActivity
 	return _u.AddReviewActivityIDs(ids...)
 }
 
+// AddClaimIDs adds the "claims" edge to the SessionClaim entity by IDs.
+func (_u *AlertSessionUpdateOne) AddClaimIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.AddClaimIDs(ids...)
+	return _u
+}
+
+// AddClaims adds the "claims" edges to the SessionClaim entity.
+func (_u *AlertSessionUpdateOne) AddClaims(v ...*SessionClaim) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddClaimIDs(ids...)
+}
+
 // AddMemoryIDs adds the "memories" edge to the InvestigationMemory entity by IDs.
 func (_u *AlertSessionUpdateOne) AddMemoryIDs(ids ...string) *AlertSessionUpdateOne {
 	_u.mutation.AddMemoryIDs(ids...)
@@ -2505,6 +4474,85 @@ func (_u *AlertSessionUpdateOne) AddInjectedMemories(v ...*InvestigationMemory)
 	return _u.AddInjectedMemoryIDs(ids...)
 }
 
+// AddRedactionReviewIDs adds the "redaction_reviews" edge to the RedactionReview entity by IDs.
+func (_u *AlertSessionUpdateOne) AddRedactionReviewIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.AddRedactionReviewIDs(ids...)
+	return _u
+}
+
+// AddRedactionReviews adds the "redaction_reviews" edges to the RedactionReview entity.
+func (_u *AlertSessionUpdateOne) AddRedactionReviews(v ...*RedactionReview) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRedactionReviewIDs(ids...)
+}
+
+// AddHandoffNoteRevisionIDs adds the "handoff_note_revisions" edge to the HandoffNoteRevision entity by IDs.
+func (_u *AlertSessionUpdateOne) AddHandoffNoteRevisionIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.AddHandoffNoteRevisionIDs(ids...)
+	return _u
+}
+
+// AddHandoffNoteRevisions adds the "handoff_note_revisions" edges to the HandoffNoteRevision entity.
+func (_u *AlertSessionUpdateOne) AddHandoffNoteRevisions(v ...*HandoffNoteRevision) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHandoffNoteRevisionIDs(ids...)
+}
+
+// SetComparisonID sets the "comparison" edge to the SessionComparison entity by ID.
+func (_u *AlertSessionUpdateOne) SetComparisonID(id string) *AlertSessionUpdateOne {
+	_u.mutation.SetComparisonID(id)
+	return _u
+}
+
+// SetNillableComparisonID sets the "comparison" edge to the SessionComparison entity by ID if the given value is not nil.
+func (_u *AlertSessionUpdateOne) SetNillableComparisonID(id *string) *AlertSessionUpdateOne {
+	if id != nil {
+		_u = _u.SetComparisonID(*id)
+	}
+	return _u
+}
+
+// SetComparison sets the "comparison" edge to the SessionComparison entity.
+func (_u *AlertSessionUpdateOne) SetComparison(v *SessionComparison) *AlertSessionUpdateOne {
+	return _u.SetComparisonID(v.ID)
+}
+
+// AddNextComparisonIDs adds the "next_comparisons" edge to the SessionComparison entity by IDs.
+func (_u *AlertSessionUpdateOne) AddNextComparisonIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.AddNextComparisonIDs(ids...)
+	return _u
+}
+
+// AddNextComparisons adds the "next_comparisons" edges to the SessionComparison entity.
+func (_u *AlertSessionUpdateOne) AddNextComparisons(v ...*SessionComparison) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNextComparisonIDs(ids...)
+}
+
+// AddActionItemIDs adds the "action_items" edge to the ActionItem entity by IDs.
+func (_u *AlertSessionUpdateOne) AddActionItemIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.AddActionItemIDs(ids...)
+	return _u
+}
+
+// AddActionItems adds the "action_items" edges to the ActionItem entity.
+func (_u *AlertSessionUpdateOne) AddActionItems(v ...*ActionItem) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddActionItemIDs(ids...)
+}
+
 // Mutation returns the AlertSessionMutation object of the builder.
 func (_u *AlertSessionUpdateOne) Mutation() *AlertSessionMutation {
 	return _u.mutation
@@ -2705,6 +4753,27 @@ func (_u *AlertSessionUpdateOne) RemoveReviewActivities(v ...*SessionReviewActiv
 	return _u.RemoveReviewActivityIDs(ids...)
 }
 
+// ClearClaims clears all "claims" edges to the SessionClaim entity.
+func (_u *AlertSessionUpdateOne) ClearClaims() *AlertSessionUpdateOne {
+	_u.mutation.ClearClaims()
+	return _u
+}
+
+// RemoveClaimIDs removes the "claims" edge to SessionClaim entities by IDs.
+func (_u *AlertSessionUpdateOne) RemoveClaimIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.RemoveClaimIDs(ids...)
+	return _u
+}
+
+// RemoveClaims removes "claims" edges to SessionClaim entities.
+func (_u *AlertSessionUpdateOne) RemoveClaims(v ...*SessionClaim) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClaimIDs(ids...)
+}
+
 // ClearMemories clears all "memories" edges to the InvestigationMemory entity.
 func (_u *AlertSessionUpdateOne) ClearMemories() *AlertSessionUpdateOne {
 	_u.mutation.ClearMemories()
@@ -2747,6 +4816,96 @@ func (_u *AlertSessionUpdateOne) RemoveInjectedMemories(v ...*InvestigationMemor
 	return _u.RemoveInjectedMemoryIDs(ids...)
 }
 
+// ClearRedactionReviews clears all "redaction_reviews" edges to the RedactionReview entity.
+func (_u *AlertSessionUpdateOne) ClearRedactionReviews() *AlertSessionUpdateOne {
+	_u.mutation.ClearRedactionReviews()
+	return _u
+}
+
+// RemoveRedactionReviewIDs removes the "redaction_reviews" edge to RedactionReview entities by IDs.
+func (_u *AlertSessionUpdateOne) RemoveRedactionReviewIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.RemoveRedactionReviewIDs(ids...)
+	return _u
+}
+
+// RemoveRedactionReviews removes "redaction_reviews" edges to RedactionReview entities.
+func (_u *AlertSessionUpdateOne) RemoveRedactionReviews(v ...*RedactionReview) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRedactionReviewIDs(ids...)
+}
+
+// ClearHandoffNoteRevisions clears all "handoff_note_revisions" edges to the HandoffNoteRevision entity.
+func (_u *AlertSessionUpdateOne) ClearHandoffNoteRevisions() *AlertSessionUpdateOne {
+	_u.mutation.ClearHandoffNoteRevisions()
+	return _u
+}
+
+// RemoveHandoffNoteRevisionIDs removes the "handoff_note_revisions" edge to HandoffNoteRevision entities by IDs.
+func (_u *AlertSessionUpdateOne) RemoveHandoffNoteRevisionIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.RemoveHandoffNoteRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveHandoffNoteRevisions removes "handoff_note_revisions" edges to HandoffNoteRevision entities.
+func (_u *AlertSessionUpdateOne) RemoveHandoffNoteRevisions(v ...*HandoffNoteRevision) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHandoffNoteRevisionIDs(ids...)
+}
+
+// ClearComparison clears the "comparison" edge to the SessionComparison entity.
+func (_u *AlertSessionUpdateOne) ClearComparison() *AlertSessionUpdateOne {
+	_u.mutation.ClearComparison()
+	return _u
+}
+
+// ClearNextComparisons clears all "next_comparisons" edges to the SessionComparison entity.
+func (_u *AlertSessionUpdateOne) ClearNextComparisons() *AlertSessionUpdateOne {
+	_u.mutation.ClearNextComparisons()
+	return _u
+}
+
+// RemoveNextComparisonIDs removes the "next_comparisons" edge to SessionComparison entities by IDs.
+func (_u *AlertSessionUpdateOne) RemoveNextComparisonIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.RemoveNextComparisonIDs(ids...)
+	return _u
+}
+
+// RemoveNextComparisons removes "next_comparisons" edges to SessionComparison entities.
+func (_u *AlertSessionUpdateOne) RemoveNextComparisons(v ...*SessionComparison) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNextComparisonIDs(ids...)
+}
+
+// ClearActionItems clears all "action_items" edges to the ActionItem entity.
+func (_u *AlertSessionUpdateOne) ClearActionItems() *AlertSessionUpdateOne {
+	_u.mutation.ClearActionItems()
+	return _u
+}
+
+// RemoveActionItemIDs removes the "action_items" edge to ActionItem entities by IDs.
+func (_u *AlertSessionUpdateOne) RemoveActionItemIDs(ids ...string) *AlertSessionUpdateOne {
+	_u.mutation.RemoveActionItemIDs(ids...)
+	return _u
+}
+
+// RemoveActionItems removes "action_items" edges to ActionItem entities.
+func (_u *AlertSessionUpdateOne) RemoveActionItems(v ...*ActionItem) *AlertSessionUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveActionItemIDs(ids...)
+}
+
 // Where appends a list predicates to the AlertSessionUpdate builder.
 func (_u *AlertSessionUpdateOne) Where(ps ...predicate.AlertSession) *AlertSessionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -2762,6 +4921,7 @@ func (_u *AlertSessionUpdateOne) Select(field string, fields ...string) *AlertSe
 
 // Save executes the query and returns the updated AlertSession entity.
 func (_u *AlertSessionUpdateOne) Save(ctx context.Context) (*AlertSession, error) {
+	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -2787,6 +4947,14 @@ func (_u *AlertSessionUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *AlertSessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := alertsession.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *AlertSessionUpdateOne) check() error {
 	if v, ok := _u.mutation.Status(); ok {
@@ -2794,6 +4962,31 @@ func (_u *AlertSessionUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Severity(); ok {
+		if err := alertsession.SeverityValidator(v); err != nil {
+			return &ValidationError{Name: "severity", err: fmt.Errorf(`ent: validator failed for field "AlertSession.severity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := alertsession.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AlertSession.outcome": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OutcomeSource(); ok {
+		if err := alertsession.OutcomeSourceValidator(v); err != nil {
+			return &ValidationError{Name: "outcome_source", err: fmt.Errorf(`ent: validator failed for field "AlertSession.outcome_source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CancelInitiator(); ok {
+		if err := alertsession.CancelInitiatorValidator(v); err != nil {
+			return &ValidationError{Name: "cancel_initiator", err: fmt.Errorf(`ent: validator failed for field "AlertSession.cancel_initiator": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CallbackStatus(); ok {
+		if err := alertsession.CallbackStatusValidator(v); err != nil {
+			return &ValidationError{Name: "callback_status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.callback_status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.ReviewStatus(); ok {
 		if err := alertsession.ReviewStatusValidator(v); err != nil {
 			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "AlertSession.review_status": %w`, err)}
@@ -2842,6 +5035,15 @@ func (_u *AlertSessionUpdateOne) sqlSave(ctx context.Context) (_node *AlertSessi
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertsession.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(alertsession.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(alertsession.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.AlertData(); ok {
 		_spec.SetField(alertsession.FieldAlertData, field.TypeString, value)
 	}
@@ -2881,20 +5083,62 @@ func (_u *AlertSessionUpdateOne) sqlSave(ctx context.Context) (_node *AlertSessi
 	if value, ok := _u.mutation.FinalAnalysis(); ok {
 		_spec.SetField(alertsession.FieldFinalAnalysis, field.TypeString, value)
 	}
-	if _u.mutation.FinalAnalysisCleared() {
-		_spec.ClearField(alertsession.FieldFinalAnalysis, field.TypeString)
+	if _u.mutation.FinalAnalysisCleared() {
+		_spec.ClearField(alertsession.FieldFinalAnalysis, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExecutiveSummary(); ok {
+		_spec.SetField(alertsession.FieldExecutiveSummary, field.TypeString, value)
+	}
+	if _u.mutation.ExecutiveSummaryCleared() {
+		_spec.ClearField(alertsession.FieldExecutiveSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExecutiveSummaryError(); ok {
+		_spec.SetField(alertsession.FieldExecutiveSummaryError, field.TypeString, value)
+	}
+	if _u.mutation.ExecutiveSummaryErrorCleared() {
+		_spec.ClearField(alertsession.FieldExecutiveSummaryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExecutiveSummaryTemplate(); ok {
+		_spec.SetField(alertsession.FieldExecutiveSummaryTemplate, field.TypeString, value)
+	}
+	if _u.mutation.ExecutiveSummaryTemplateCleared() {
+		_spec.ClearField(alertsession.FieldExecutiveSummaryTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.TechnicalSummary(); ok {
+		_spec.SetField(alertsession.FieldTechnicalSummary, field.TypeString, value)
+	}
+	if _u.mutation.TechnicalSummaryCleared() {
+		_spec.ClearField(alertsession.FieldTechnicalSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.TechnicalSummaryError(); ok {
+		_spec.SetField(alertsession.FieldTechnicalSummaryError, field.TypeString, value)
+	}
+	if _u.mutation.TechnicalSummaryErrorCleared() {
+		_spec.ClearField(alertsession.FieldTechnicalSummaryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(alertsession.FieldSeverity, field.TypeEnum, value)
+	}
+	if _u.mutation.SeverityCleared() {
+		_spec.ClearField(alertsession.FieldSeverity, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(alertsession.FieldOutcome, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.ExecutiveSummary(); ok {
-		_spec.SetField(alertsession.FieldExecutiveSummary, field.TypeString, value)
+	if _u.mutation.OutcomeCleared() {
+		_spec.ClearField(alertsession.FieldOutcome, field.TypeEnum)
 	}
-	if _u.mutation.ExecutiveSummaryCleared() {
-		_spec.ClearField(alertsession.FieldExecutiveSummary, field.TypeString)
+	if value, ok := _u.mutation.OutcomeReason(); ok {
+		_spec.SetField(alertsession.FieldOutcomeReason, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.ExecutiveSummaryError(); ok {
-		_spec.SetField(alertsession.FieldExecutiveSummaryError, field.TypeString, value)
+	if _u.mutation.OutcomeReasonCleared() {
+		_spec.ClearField(alertsession.FieldOutcomeReason, field.TypeString)
 	}
-	if _u.mutation.ExecutiveSummaryErrorCleared() {
-		_spec.ClearField(alertsession.FieldExecutiveSummaryError, field.TypeString)
+	if value, ok := _u.mutation.OutcomeSource(); ok {
+		_spec.SetField(alertsession.FieldOutcomeSource, field.TypeEnum, value)
+	}
+	if _u.mutation.OutcomeSourceCleared() {
+		_spec.ClearField(alertsession.FieldOutcomeSource, field.TypeEnum)
 	}
 	if value, ok := _u.mutation.SessionMetadata(); ok {
 		_spec.SetField(alertsession.FieldSessionMetadata, field.TypeJSON, value)
@@ -2908,6 +5152,12 @@ func (_u *AlertSessionUpdateOne) sqlSave(ctx context.Context) (_node *AlertSessi
 	if _u.mutation.AuthorCleared() {
 		_spec.ClearField(alertsession.FieldAuthor, field.TypeString)
 	}
+	if value, ok := _u.mutation.AuthorSubject(); ok {
+		_spec.SetField(alertsession.FieldAuthorSubject, field.TypeString, value)
+	}
+	if _u.mutation.AuthorSubjectCleared() {
+		_spec.ClearField(alertsession.FieldAuthorSubject, field.TypeString)
+	}
 	if value, ok := _u.mutation.RunbookURL(); ok {
 		_spec.SetField(alertsession.FieldRunbookURL, field.TypeString, value)
 	}
@@ -2920,9 +5170,38 @@ func (_u *AlertSessionUpdateOne) sqlSave(ctx context.Context) (_node *AlertSessi
 	if _u.mutation.McpSelectionCleared() {
 		_spec.ClearField(alertsession.FieldMcpSelection, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.AlertInstructions(); ok {
+		_spec.SetField(alertsession.FieldAlertInstructions, field.TypeJSON, value)
+	}
+	if _u.mutation.AlertInstructionsCleared() {
+		_spec.ClearField(alertsession.FieldAlertInstructions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AlertImages(); ok {
+		_spec.SetField(alertsession.FieldAlertImages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAlertImages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, alertsession.FieldAlertImages, value)
+		})
+	}
+	if _u.mutation.AlertImagesCleared() {
+		_spec.ClearField(alertsession.FieldAlertImages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OutputLanguage(); ok {
+		_spec.SetField(alertsession.FieldOutputLanguage, field.TypeString, value)
+	}
+	if _u.mutation.OutputLanguageCleared() {
+		_spec.ClearField(alertsession.FieldOutputLanguage, field.TypeString)
+	}
 	if value, ok := _u.mutation.ChainID(); ok {
 		_spec.SetField(alertsession.FieldChainID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(alertsession.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(alertsession.FieldOwner, field.TypeString)
+	}
 	if value, ok := _u.mutation.CurrentStageIndex(); ok {
 		_spec.SetField(alertsession.FieldCurrentStageIndex, field.TypeInt, value)
 	}
@@ -2956,11 +5235,119 @@ func (_u *AlertSessionUpdateOne) sqlSave(ctx context.Context) (_node *AlertSessi
 	if _u.mutation.SlackMessageFingerprintCleared() {
 		_spec.ClearField(alertsession.FieldSlackMessageFingerprint, field.TypeString)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(alertsession.FieldDeletedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.AlertKey(); ok {
+		_spec.SetField(alertsession.FieldAlertKey, field.TypeString, value)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(alertsession.FieldDeletedAt, field.TypeTime)
+	if _u.mutation.AlertKeyCleared() {
+		_spec.ClearField(alertsession.FieldAlertKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.AlertResolvedAt(); ok {
+		_spec.SetField(alertsession.FieldAlertResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AlertResolvedAtCleared() {
+		_spec.ClearField(alertsession.FieldAlertResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AlertResolution(); ok {
+		_spec.SetField(alertsession.FieldAlertResolution, field.TypeString, value)
+	}
+	if _u.mutation.AlertResolutionCleared() {
+		_spec.ClearField(alertsession.FieldAlertResolution, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelInitiator(); ok {
+		_spec.SetField(alertsession.FieldCancelInitiator, field.TypeEnum, value)
+	}
+	if _u.mutation.CancelInitiatorCleared() {
+		_spec.ClearField(alertsession.FieldCancelInitiator, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.CancelReason(); ok {
+		_spec.SetField(alertsession.FieldCancelReason, field.TypeString, value)
+	}
+	if _u.mutation.CancelReasonCleared() {
+		_spec.ClearField(alertsession.FieldCancelReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CancelledBy(); ok {
+		_spec.SetField(alertsession.FieldCancelledBy, field.TypeString, value)
+	}
+	if _u.mutation.CancelledByCleared() {
+		_spec.ClearField(alertsession.FieldCancelledBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(alertsession.FieldRequestID, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(alertsession.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DegradedReason(); ok {
+		_spec.SetField(alertsession.FieldDegradedReason, field.TypeString, value)
+	}
+	if _u.mutation.DegradedReasonCleared() {
+		_spec.ClearField(alertsession.FieldDegradedReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ModelRouting(); ok {
+		_spec.SetField(alertsession.FieldModelRouting, field.TypeJSON, value)
+	}
+	if _u.mutation.ModelRoutingCleared() {
+		_spec.ClearField(alertsession.FieldModelRouting, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NoiseTriage(); ok {
+		_spec.SetField(alertsession.FieldNoiseTriage, field.TypeJSON, value)
+	}
+	if _u.mutation.NoiseTriageCleared() {
+		_spec.ClearField(alertsession.FieldNoiseTriage, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FederationOrigin(); ok {
+		_spec.SetField(alertsession.FieldFederationOrigin, field.TypeJSON, value)
+	}
+	if _u.mutation.FederationOriginCleared() {
+		_spec.ClearField(alertsession.FieldFederationOrigin, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(alertsession.FieldTarget, field.TypeJSON, value)
+	}
+	if _u.mutation.TargetCleared() {
+		_spec.ClearField(alertsession.FieldTarget, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OnCall(); ok {
+		_spec.SetField(alertsession.FieldOnCall, field.TypeJSON, value)
+	}
+	if _u.mutation.OnCallCleared() {
+		_spec.ClearField(alertsession.FieldOnCall, field.TypeJSON)
+	}
+	if _u.mutation.DuplicatedFromCleared() {
+		_spec.ClearField(alertsession.FieldDuplicatedFrom, field.TypeString)
+	}
+	if _u.mutation.LlmProviderCleared() {
+		_spec.ClearField(alertsession.FieldLlmProvider, field.TypeString)
+	}
+	if _u.mutation.CallbackURLCleared() {
+		_spec.ClearField(alertsession.FieldCallbackURL, field.TypeString)
+	}
+	if _u.mutation.CallbackSecretCleared() {
+		_spec.ClearField(alertsession.FieldCallbackSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.CallbackStatus(); ok {
+		_spec.SetField(alertsession.FieldCallbackStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.CallbackStatusCleared() {
+		_spec.ClearField(alertsession.FieldCallbackStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.CallbackAttempts(); ok {
+		_spec.SetField(alertsession.FieldCallbackAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCallbackAttempts(); ok {
+		_spec.AddField(alertsession.FieldCallbackAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CallbackLastError(); ok {
+		_spec.SetField(alertsession.FieldCallbackLastError, field.TypeString, value)
+	}
+	if _u.mutation.CallbackLastErrorCleared() {
+		_spec.ClearField(alertsession.FieldCallbackLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CallbackDeliveredAt(); ok {
+		_spec.SetField(alertsession.FieldCallbackDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.CallbackDeliveredAtCleared() {
+		_spec.ClearField(alertsession.FieldCallbackDeliveredAt, field.TypeTime)
 	}
 	if value, ok := _u.mutation.ReviewStatus(); ok {
 		_spec.SetField(alertsession.FieldReviewStatus, field.TypeEnum, value)
@@ -3004,6 +5391,30 @@ func (_u *AlertSessionUpdateOne) sqlSave(ctx context.Context) (_node *AlertSessi
 	if _u.mutation.InvestigationFeedbackCleared() {
 		_spec.ClearField(alertsession.FieldInvestigationFeedback, field.TypeString)
 	}
+	if value, ok := _u.mutation.HandoffNotes(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotes, field.TypeString, value)
+	}
+	if _u.mutation.HandoffNotesCleared() {
+		_spec.ClearField(alertsession.FieldHandoffNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandoffNotesRevision(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHandoffNotesRevision(); ok {
+		_spec.AddField(alertsession.FieldHandoffNotesRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HandoffNotesUpdatedBy(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.HandoffNotesUpdatedByCleared() {
+		_spec.ClearField(alertsession.FieldHandoffNotesUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.HandoffNotesUpdatedAt(); ok {
+		_spec.SetField(alertsession.FieldHandoffNotesUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.HandoffNotesUpdatedAtCleared() {
+		_spec.ClearField(alertsession.FieldHandoffNotesUpdatedAt, field.TypeTime)
+	}
 	if _u.mutation.StagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -3438,6 +5849,51 @@ func (_u *AlertSessionUpdateOne) sqlSave(ctx context.Context) (_node *AlertSessi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ClaimsTable,
+			Columns: []string{alertsession.ClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedClaimsIDs(); len(nodes) > 0 && !_u.mutation.ClaimsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ClaimsTable,
+			Columns: []string{alertsession.ClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ClaimsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ClaimsTable,
+			Columns: []string{alertsession.ClaimsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.MemoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -3528,6 +5984,215 @@ func (_u *AlertSessionUpdateOne) sqlSave(ctx context.Context) (_node *AlertSessi
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RedactionReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.RedactionReviewsTable,
+			Columns: []string{alertsession.RedactionReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRedactionReviewsIDs(); len(nodes) > 0 && !_u.mutation.RedactionReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.RedactionReviewsTable,
+			Columns: []string{alertsession.RedactionReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RedactionReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.RedactionReviewsTable,
+			Columns: []string{alertsession.RedactionReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HandoffNoteRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.HandoffNoteRevisionsTable,
+			Columns: []string{alertsession.HandoffNoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHandoffNoteRevisionsIDs(); len(nodes) > 0 && !_u.mutation.HandoffNoteRevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.HandoffNoteRevisionsTable,
+			Columns: []string{alertsession.HandoffNoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandoffNoteRevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.HandoffNoteRevisionsTable,
+			Columns: []string{alertsession.HandoffNoteRevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ComparisonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   alertsession.ComparisonTable,
+			Columns: []string{alertsession.ComparisonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ComparisonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   alertsession.ComparisonTable,
+			Columns: []string{alertsession.ComparisonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NextComparisonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.NextComparisonsTable,
+			Columns: []string{alertsession.NextComparisonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNextComparisonsIDs(); len(nodes) > 0 && !_u.mutation.NextComparisonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.NextComparisonsTable,
+			Columns: []string{alertsession.NextComparisonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NextComparisonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.NextComparisonsTable,
+			Columns: []string{alertsession.NextComparisonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ActionItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ActionItemsTable,
+			Columns: []string{alertsession.ActionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedActionItemsIDs(); len(nodes) > 0 && !_u.mutation.ActionItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ActionItemsTable,
+			Columns: []string{alertsession.ActionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ActionItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   alertsession.ActionItemsTable,
+			Columns: []string{alertsession.ActionItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(actionitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &AlertSession{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/blob"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// BlobUpdate is the builder for updating Blob entities.
+type BlobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BlobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (_u *BlobUpdate) Where(ps ...predicate.Blob) *BlobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetObjectOid sets the "object_oid" field.
+func (_u *BlobUpdate) SetObjectOid(v uint32) *BlobUpdate {
+	_u.mutation.ResetObjectOid()
+	_u.mutation.SetObjectOid(v)
+	return _u
+}
+
+// SetNillableObjectOid sets the "object_oid" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableObjectOid(v *uint32) *BlobUpdate {
+	if v != nil {
+		_u.SetObjectOid(*v)
+	}
+	return _u
+}
+
+// AddObjectOid adds value to the "object_oid" field.
+func (_u *BlobUpdate) AddObjectOid(v int32) *BlobUpdate {
+	_u.mutation.AddObjectOid(v)
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *BlobUpdate) SetSizeBytes(v int64) *BlobUpdate {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableSizeBytes(v *int64) *BlobUpdate {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *BlobUpdate) AddSizeBytes(v int64) *BlobUpdate {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *BlobUpdate) SetContentType(v string) *BlobUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *BlobUpdate) SetNillableContentType(v *string) *BlobUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (_u *BlobUpdate) Mutation() *BlobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BlobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BlobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BlobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BlobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ObjectOid(); ok {
+		_spec.SetField(blob.FieldObjectOid, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedObjectOid(); ok {
+		_spec.AddField(blob.FieldObjectOid, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(blob.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(blob.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(blob.FieldContentType, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BlobUpdateOne is the builder for updating a single Blob entity.
+type BlobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BlobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetObjectOid sets the "object_oid" field.
+func (_u *BlobUpdateOne) SetObjectOid(v uint32) *BlobUpdateOne {
+	_u.mutation.ResetObjectOid()
+	_u.mutation.SetObjectOid(v)
+	return _u
+}
+
+// SetNillableObjectOid sets the "object_oid" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableObjectOid(v *uint32) *BlobUpdateOne {
+	if v != nil {
+		_u.SetObjectOid(*v)
+	}
+	return _u
+}
+
+// AddObjectOid adds value to the "object_oid" field.
+func (_u *BlobUpdateOne) AddObjectOid(v int32) *BlobUpdateOne {
+	_u.mutation.AddObjectOid(v)
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *BlobUpdateOne) SetSizeBytes(v int64) *BlobUpdateOne {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableSizeBytes(v *int64) *BlobUpdateOne {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *BlobUpdateOne) AddSizeBytes(v int64) *BlobUpdateOne {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *BlobUpdateOne) SetContentType(v string) *BlobUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *BlobUpdateOne) SetNillableContentType(v *string) *BlobUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// Mutation returns the BlobMutation object of the builder.
+func (_u *BlobUpdateOne) Mutation() *BlobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BlobUpdate builder.
+func (_u *BlobUpdateOne) Where(ps ...predicate.Blob) *BlobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BlobUpdateOne) Select(field string, fields ...string) *BlobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Blob entity.
+func (_u *BlobUpdateOne) Save(ctx context.Context) (*Blob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlobUpdateOne) SaveX(ctx context.Context) *Blob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BlobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BlobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BlobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error) {
+	_spec := sqlgraph.NewUpdateSpec(blob.Table, blob.Columns, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Blob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.FieldID)
+		for _, f := range fields {
+			if !blob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ObjectOid(); ok {
+		_spec.SetField(blob.FieldObjectOid, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedObjectOid(); ok {
+		_spec.AddField(blob.FieldObjectOid, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(blob.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(blob.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(blob.FieldContentType, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Blob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -23,12 +23,6 @@ type ChatCreate struct {
 	hooks    []Hook
 }
 
-// SetSessionID sets the "session_id" field.
-func (_c *ChatCreate) SetSessionID(v string) *ChatCreate {
-	_c.mutation.SetSessionID(v)
-	return _c
-}
-
 // SetCreatedAt sets the "created_at" field.
 func (_c *ChatCreate) SetCreatedAt(v time.Time) *ChatCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -43,6 +37,20 @@ func (_c *ChatCreate) SetNillableCreatedAt(v *time.Time) *ChatCreate {
 	return _c
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ChatCreate) SetUpdatedAt(v time.Time) *ChatCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableUpdatedAt(v *time.Time) *ChatCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_c *ChatCreate) SetCreatedBy(v string) *ChatCreate {
 	_c.mutation.SetCreatedBy(v)
@@ -57,6 +65,26 @@ func (_c *ChatCreate) SetNillableCreatedBy(v *string) *ChatCreate {
 	return _c
 }
 
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *ChatCreate) SetUpdatedBy(v string) *ChatCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *ChatCreate) SetNillableUpdatedBy(v *string) *ChatCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *ChatCreate) SetSessionID(v string) *ChatCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
 // SetChainID sets the "chain_id" field.
 func (_c *ChatCreate) SetChainID(v string) *ChatCreate {
 	_c.mutation.SetChainID(v)
@@ -139,7 +167,9 @@ func (_c *ChatCreate) Mutation() *ChatMutation {
 
 // Save creates the Chat in the database.
 func (_c *ChatCreate) Save(ctx context.Context) (*Chat, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -166,21 +196,35 @@ func (_c *ChatCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ChatCreate) defaults() {
+func (_c *ChatCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if chat.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized chat.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := chat.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if chat.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized chat.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := chat.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *ChatCreate) check() error {
-	if _, ok := _c.mutation.SessionID(); !ok {
-		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "Chat.session_id"`)}
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Chat.created_at"`)}
 	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Chat.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "Chat.session_id"`)}
+	}
 	if _, ok := _c.mutation.ChainID(); !ok {
 		return &ValidationError{Name: "chain_id", err: errors.New(`ent: missing required field "Chat.chain_id"`)}
 	}
@@ -226,10 +270,18 @@ func (_c *ChatCreate) createSpec() (*Chat, *sqlgraph.CreateSpec) {
 		_spec.SetField(chat.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(chat.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if value, ok := _c.mutation.CreatedBy(); ok {
 		_spec.SetField(chat.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = &value
 	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(chat.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = &value
+	}
 	if value, ok := _c.mutation.ChainID(); ok {
 		_spec.SetField(chat.FieldChainID, field.TypeString, value)
 		_node.ChainID = value
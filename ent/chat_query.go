@@ -374,12 +374,12 @@ func (_q *ChatQuery) WithStages(opts ...func(*StageQuery)) *ChatQuery {
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Chat.Query().
-//		GroupBy(chat.FieldSessionID).
+//		GroupBy(chat.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ChatQuery) GroupBy(field string, fields ...string) *ChatGroupBy {
@@ -397,11 +397,11 @@ func (_q *ChatQuery) GroupBy(field string, fields ...string) *ChatGroupBy {
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Chat.Query().
-//		Select(chat.FieldSessionID).
+//		Select(chat.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *ChatQuery) Select(fields ...string) *ChatSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -31,6 +31,12 @@ func (_u *ChatUpdate) Where(ps ...predicate.Chat) *ChatUpdate {
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatUpdate) SetUpdatedAt(v time.Time) *ChatUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_u *ChatUpdate) SetCreatedBy(v string) *ChatUpdate {
 	_u.mutation.SetCreatedBy(v)
@@ -51,6 +57,26 @@ func (_u *ChatUpdate) ClearCreatedBy() *ChatUpdate {
 	return _u
 }
 
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ChatUpdate) SetUpdatedBy(v string) *ChatUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ChatUpdate) SetNillableUpdatedBy(v *string) *ChatUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ChatUpdate) ClearUpdatedBy() *ChatUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
 // SetChainID sets the "chain_id" field.
 func (_u *ChatUpdate) SetChainID(v string) *ChatUpdate {
 	_u.mutation.SetChainID(v)
@@ -184,6 +210,9 @@ func (_u *ChatUpdate) RemoveStages(v ...*Stage) *ChatUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ChatUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -209,6 +238,18 @@ func (_u *ChatUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *ChatUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if chat.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized chat.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := chat.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *ChatUpdate) check() error {
 	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
@@ -235,12 +276,21 @@ func (_u *ChatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chat.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(chat.FieldCreatedBy, field.TypeString, value)
 	}
 	if _u.mutation.CreatedByCleared() {
 		_spec.ClearField(chat.FieldCreatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(chat.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(chat.FieldUpdatedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.ChainID(); ok {
 		_spec.SetField(chat.FieldChainID, field.TypeString, value)
 	}
@@ -368,6 +418,12 @@ type ChatUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ChatUpdateOne) SetUpdatedAt(v time.Time) *ChatUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_u *ChatUpdateOne) SetCreatedBy(v string) *ChatUpdateOne {
 	_u.mutation.SetCreatedBy(v)
@@ -388,6 +444,26 @@ func (_u *ChatUpdateOne) ClearCreatedBy() *ChatUpdateOne {
 	return _u
 }
 
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ChatUpdateOne) SetUpdatedBy(v string) *ChatUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ChatUpdateOne) SetNillableUpdatedBy(v *string) *ChatUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ChatUpdateOne) ClearUpdatedBy() *ChatUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
 // SetChainID sets the "chain_id" field.
 func (_u *ChatUpdateOne) SetChainID(v string) *ChatUpdateOne {
 	_u.mutation.SetChainID(v)
@@ -534,6 +610,9 @@ func (_u *ChatUpdateOne) Select(field string, fields ...string) *ChatUpdateOne {
 
 // Save executes the query and returns the updated Chat entity.
 func (_u *ChatUpdateOne) Save(ctx context.Context) (*Chat, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -559,6 +638,18 @@ func (_u *ChatUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *ChatUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if chat.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized chat.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := chat.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *ChatUpdateOne) check() error {
 	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
@@ -602,12 +693,21 @@ func (_u *ChatUpdateOne) sqlSave(ctx context.Context) (_node *Chat, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(chat.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(chat.FieldCreatedBy, field.TypeString, value)
 	}
 	if _u.mutation.CreatedByCleared() {
 		_spec.ClearField(chat.FieldCreatedBy, field.TypeString)
 	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(chat.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(chat.FieldUpdatedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.ChainID(); ok {
 		_spec.SetField(chat.FieldChainID, field.TypeString, value)
 	}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
 	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
 )
 
@@ -19,14 +21,20 @@ type ChatUserMessage struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// ChatID holds the value of the "chat_id" field.
 	ChatID string `json:"chat_id,omitempty"`
 	// Question text
 	Content string `json:"content,omitempty"`
 	// User email
 	Author string `json:"author,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	// OIDC subject of the author (user_profiles key)
+	AuthorSubject *string `json:"author_subject,omitempty"`
+	// Image attachments (screenshots) sent with the question
+	Images []schema.ImageRef `json:"images,omitempty"`
+	// Voice note the question was transcribed from
+	VoiceNote *schema.VoiceNote `json:"voice_note,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChatUserMessageQuery when eager-loading is set.
 	Edges        ChatUserMessageEdges `json:"edges"`
@@ -71,7 +79,9 @@ func (*ChatUserMessage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case chatusermessage.FieldID, chatusermessage.FieldChatID, chatusermessage.FieldContent, chatusermessage.FieldAuthor:
+		case chatusermessage.FieldImages, chatusermessage.FieldVoiceNote:
+			values[i] = new([]byte)
+		case chatusermessage.FieldID, chatusermessage.FieldChatID, chatusermessage.FieldContent, chatusermessage.FieldAuthor, chatusermessage.FieldAuthorSubject:
 			values[i] = new(sql.NullString)
 		case chatusermessage.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -96,6 +106,12 @@ func (_m *ChatUserMessage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case chatusermessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
 		case chatusermessage.FieldChatID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
@@ -114,11 +130,28 @@ func (_m *ChatUserMessage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Author = value.String
 			}
-		case chatusermessage.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+		case chatusermessage.FieldAuthorSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_subject", values[i])
 			} else if value.Valid {
-				_m.CreatedAt = value.Time
+				_m.AuthorSubject = new(string)
+				*_m.AuthorSubject = value.String
+			}
+		case chatusermessage.FieldImages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field images", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Images); err != nil {
+					return fmt.Errorf("unmarshal field images: %w", err)
+				}
+			}
+		case chatusermessage.FieldVoiceNote:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field voice_note", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VoiceNote); err != nil {
+					return fmt.Errorf("unmarshal field voice_note: %w", err)
+				}
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -166,6 +199,9 @@ func (_m *ChatUserMessage) String() string {
 	var builder strings.Builder
 	builder.WriteString("ChatUserMessage(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("chat_id=")
 	builder.WriteString(_m.ChatID)
 	builder.WriteString(", ")
@@ -175,8 +211,16 @@ func (_m *ChatUserMessage) String() string {
 	builder.WriteString("author=")
 	builder.WriteString(_m.Author)
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	if v := _m.AuthorSubject; v != nil {
+		builder.WriteString("author_subject=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("images=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Images))
+	builder.WriteString(", ")
+	builder.WriteString("voice_note=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VoiceNote))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -14,14 +14,20 @@ const (
 	Label = "chat_user_message"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "message_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// FieldChatID holds the string denoting the chat_id field in the database.
 	FieldChatID = "chat_id"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
 	// FieldAuthor holds the string denoting the author field in the database.
 	FieldAuthor = "author"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
+	// FieldAuthorSubject holds the string denoting the author_subject field in the database.
+	FieldAuthorSubject = "author_subject"
+	// FieldImages holds the string denoting the images field in the database.
+	FieldImages = "images"
+	// FieldVoiceNote holds the string denoting the voice_note field in the database.
+	FieldVoiceNote = "voice_note"
 	// EdgeChat holds the string denoting the chat edge name in mutations.
 	EdgeChat = "chat"
 	// EdgeStage holds the string denoting the stage edge name in mutations.
@@ -51,10 +57,13 @@ const (
 // Columns holds all SQL columns for chatusermessage fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
 	FieldChatID,
 	FieldContent,
 	FieldAuthor,
-	FieldCreatedAt,
+	FieldAuthorSubject,
+	FieldImages,
+	FieldVoiceNote,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -80,6 +89,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
 // ByChatID orders the results by the chat_id field.
 func ByChatID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChatID, opts...).ToFunc()
@@ -95,9 +109,9 @@ func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+// ByAuthorSubject orders the results by the author_subject field.
+func ByAuthorSubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorSubject, opts...).ToFunc()
 }
 
 // ByChatField orders the results by chat field.
@@ -65,6 +65,11 @@ func IDContainsFold(id string) predicate.ChatUserMessage {
 	return predicate.ChatUserMessage(sql.FieldContainsFold(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
 // ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
 func ChatID(v string) predicate.ChatUserMessage {
 	return predicate.ChatUserMessage(sql.FieldEQ(FieldChatID, v))
@@ -80,11 +85,51 @@ func Author(v string) predicate.ChatUserMessage {
 	return predicate.ChatUserMessage(sql.FieldEQ(FieldAuthor, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.ChatUserMessage {
+// AuthorSubject applies equality check predicate on the "author_subject" field. It's identical to AuthorSubjectEQ.
+func AuthorSubject(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldEQ(FieldAuthorSubject, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ChatUserMessage {
 	return predicate.ChatUserMessage(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
 // ChatIDEQ applies the EQ predicate on the "chat_id" field.
 func ChatIDEQ(v string) predicate.ChatUserMessage {
 	return predicate.ChatUserMessage(sql.FieldEQ(FieldChatID, v))
@@ -280,44 +325,99 @@ func AuthorContainsFold(v string) predicate.ChatUserMessage {
 	return predicate.ChatUserMessage(sql.FieldContainsFold(FieldAuthor, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.ChatUserMessage {
-	return predicate.ChatUserMessage(sql.FieldEQ(FieldCreatedAt, v))
+// AuthorSubjectEQ applies the EQ predicate on the "author_subject" field.
+func AuthorSubjectEQ(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldEQ(FieldAuthorSubject, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.ChatUserMessage {
-	return predicate.ChatUserMessage(sql.FieldNEQ(FieldCreatedAt, v))
+// AuthorSubjectNEQ applies the NEQ predicate on the "author_subject" field.
+func AuthorSubjectNEQ(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldNEQ(FieldAuthorSubject, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.ChatUserMessage {
-	return predicate.ChatUserMessage(sql.FieldIn(FieldCreatedAt, vs...))
+// AuthorSubjectIn applies the In predicate on the "author_subject" field.
+func AuthorSubjectIn(vs ...string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldIn(FieldAuthorSubject, vs...))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.ChatUserMessage {
-	return predicate.ChatUserMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+// AuthorSubjectNotIn applies the NotIn predicate on the "author_subject" field.
+func AuthorSubjectNotIn(vs ...string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldNotIn(FieldAuthorSubject, vs...))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.ChatUserMessage {
-	return predicate.ChatUserMessage(sql.FieldGT(FieldCreatedAt, v))
+// AuthorSubjectGT applies the GT predicate on the "author_subject" field.
+func AuthorSubjectGT(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldGT(FieldAuthorSubject, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.ChatUserMessage {
-	return predicate.ChatUserMessage(sql.FieldGTE(FieldCreatedAt, v))
+// AuthorSubjectGTE applies the GTE predicate on the "author_subject" field.
+func AuthorSubjectGTE(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldGTE(FieldAuthorSubject, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.ChatUserMessage {
-	return predicate.ChatUserMessage(sql.FieldLT(FieldCreatedAt, v))
+// AuthorSubjectLT applies the LT predicate on the "author_subject" field.
+func AuthorSubjectLT(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldLT(FieldAuthorSubject, v))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.ChatUserMessage {
-	return predicate.ChatUserMessage(sql.FieldLTE(FieldCreatedAt, v))
+// AuthorSubjectLTE applies the LTE predicate on the "author_subject" field.
+func AuthorSubjectLTE(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldLTE(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectContains applies the Contains predicate on the "author_subject" field.
+func AuthorSubjectContains(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldContains(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectHasPrefix applies the HasPrefix predicate on the "author_subject" field.
+func AuthorSubjectHasPrefix(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldHasPrefix(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectHasSuffix applies the HasSuffix predicate on the "author_subject" field.
+func AuthorSubjectHasSuffix(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldHasSuffix(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectIsNil applies the IsNil predicate on the "author_subject" field.
+func AuthorSubjectIsNil() predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldIsNull(FieldAuthorSubject))
+}
+
+// AuthorSubjectNotNil applies the NotNil predicate on the "author_subject" field.
+func AuthorSubjectNotNil() predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldNotNull(FieldAuthorSubject))
+}
+
+// AuthorSubjectEqualFold applies the EqualFold predicate on the "author_subject" field.
+func AuthorSubjectEqualFold(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldEqualFold(FieldAuthorSubject, v))
+}
+
+// AuthorSubjectContainsFold applies the ContainsFold predicate on the "author_subject" field.
+func AuthorSubjectContainsFold(v string) predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldContainsFold(FieldAuthorSubject, v))
+}
+
+// ImagesIsNil applies the IsNil predicate on the "images" field.
+func ImagesIsNil() predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldIsNull(FieldImages))
+}
+
+// ImagesNotNil applies the NotNil predicate on the "images" field.
+func ImagesNotNil() predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldNotNull(FieldImages))
+}
+
+// VoiceNoteIsNil applies the IsNil predicate on the "voice_note" field.
+func VoiceNoteIsNil() predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldIsNull(FieldVoiceNote))
+}
+
+// VoiceNoteNotNil applies the NotNil predicate on the "voice_note" field.
+func VoiceNoteNotNil() predicate.ChatUserMessage {
+	return predicate.ChatUserMessage(sql.FieldNotNull(FieldVoiceNote))
 }
 
 // HasChat applies the HasEdge predicate on the "chat" edge.
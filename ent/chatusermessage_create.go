@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
 	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
 )
 
@@ -22,6 +23,20 @@ type ChatUserMessageCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *ChatUserMessageCreate) SetCreatedAt(v time.Time) *ChatUserMessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ChatUserMessageCreate) SetNillableCreatedAt(v *time.Time) *ChatUserMessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // SetChatID sets the "chat_id" field.
 func (_c *ChatUserMessageCreate) SetChatID(v string) *ChatUserMessageCreate {
 	_c.mutation.SetChatID(v)
@@ -40,20 +55,32 @@ func (_c *ChatUserMessageCreate) SetAuthor(v string) *ChatUserMessageCreate {
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *ChatUserMessageCreate) SetCreatedAt(v time.Time) *ChatUserMessageCreate {
-	_c.mutation.SetCreatedAt(v)
+// SetAuthorSubject sets the "author_subject" field.
+func (_c *ChatUserMessageCreate) SetAuthorSubject(v string) *ChatUserMessageCreate {
+	_c.mutation.SetAuthorSubject(v)
 	return _c
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *ChatUserMessageCreate) SetNillableCreatedAt(v *time.Time) *ChatUserMessageCreate {
+// SetNillableAuthorSubject sets the "author_subject" field if the given value is not nil.
+func (_c *ChatUserMessageCreate) SetNillableAuthorSubject(v *string) *ChatUserMessageCreate {
 	if v != nil {
-		_c.SetCreatedAt(*v)
+		_c.SetAuthorSubject(*v)
 	}
 	return _c
 }
 
+// SetImages sets the "images" field.
+func (_c *ChatUserMessageCreate) SetImages(v []schema.ImageRef) *ChatUserMessageCreate {
+	_c.mutation.SetImages(v)
+	return _c
+}
+
+// SetVoiceNote sets the "voice_note" field.
+func (_c *ChatUserMessageCreate) SetVoiceNote(v *schema.VoiceNote) *ChatUserMessageCreate {
+	_c.mutation.SetVoiceNote(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ChatUserMessageCreate) SetID(v string) *ChatUserMessageCreate {
 	_c.mutation.SetID(v)
@@ -127,6 +154,9 @@ func (_c *ChatUserMessageCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *ChatUserMessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatUserMessage.created_at"`)}
+	}
 	if _, ok := _c.mutation.ChatID(); !ok {
 		return &ValidationError{Name: "chat_id", err: errors.New(`ent: missing required field "ChatUserMessage.chat_id"`)}
 	}
@@ -136,9 +166,6 @@ func (_c *ChatUserMessageCreate) check() error {
 	if _, ok := _c.mutation.Author(); !ok {
 		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "ChatUserMessage.author"`)}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChatUserMessage.created_at"`)}
-	}
 	if len(_c.mutation.ChatIDs()) == 0 {
 		return &ValidationError{Name: "chat", err: errors.New(`ent: missing required edge "ChatUserMessage.chat"`)}
 	}
@@ -177,6 +204,10 @@ func (_c *ChatUserMessageCreate) createSpec() (*ChatUserMessage, *sqlgraph.Creat
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(chatusermessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	if value, ok := _c.mutation.Content(); ok {
 		_spec.SetField(chatusermessage.FieldContent, field.TypeString, value)
 		_node.Content = value
@@ -185,9 +216,17 @@ func (_c *ChatUserMessageCreate) createSpec() (*ChatUserMessage, *sqlgraph.Creat
 		_spec.SetField(chatusermessage.FieldAuthor, field.TypeString, value)
 		_node.Author = value
 	}
-	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(chatusermessage.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
+	if value, ok := _c.mutation.AuthorSubject(); ok {
+		_spec.SetField(chatusermessage.FieldAuthorSubject, field.TypeString, value)
+		_node.AuthorSubject = &value
+	}
+	if value, ok := _c.mutation.Images(); ok {
+		_spec.SetField(chatusermessage.FieldImages, field.TypeJSON, value)
+		_node.Images = value
+	}
+	if value, ok := _c.mutation.VoiceNote(); ok {
+		_spec.SetField(chatusermessage.FieldVoiceNote, field.TypeJSON, value)
+		_node.VoiceNote = value
 	}
 	if nodes := _c.mutation.ChatIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -338,12 +338,12 @@ func (_q *ChatUserMessageQuery) WithStage(opts ...func(*StageQuery)) *ChatUserMe
 // Example:
 //
 //	var v []struct {
-//		ChatID string `json:"chat_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.ChatUserMessage.Query().
-//		GroupBy(chatusermessage.FieldChatID).
+//		GroupBy(chatusermessage.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *ChatUserMessageQuery) GroupBy(field string, fields ...string) *ChatUserMessageGroupBy {
@@ -361,11 +361,11 @@ func (_q *ChatUserMessageQuery) GroupBy(field string, fields ...string) *ChatUse
 // Example:
 //
 //	var v []struct {
-//		ChatID string `json:"chat_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.ChatUserMessage.Query().
-//		Select(chatusermessage.FieldChatID).
+//		Select(chatusermessage.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *ChatUserMessageQuery) Select(fields ...string) *ChatUserMessageSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -9,9 +9,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
 	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
 )
 
@@ -57,6 +59,56 @@ func (_u *ChatUserMessageUpdate) SetNillableAuthor(v *string) *ChatUserMessageUp
 	return _u
 }
 
+// SetAuthorSubject sets the "author_subject" field.
+func (_u *ChatUserMessageUpdate) SetAuthorSubject(v string) *ChatUserMessageUpdate {
+	_u.mutation.SetAuthorSubject(v)
+	return _u
+}
+
+// SetNillableAuthorSubject sets the "author_subject" field if the given value is not nil.
+func (_u *ChatUserMessageUpdate) SetNillableAuthorSubject(v *string) *ChatUserMessageUpdate {
+	if v != nil {
+		_u.SetAuthorSubject(*v)
+	}
+	return _u
+}
+
+// ClearAuthorSubject clears the value of the "author_subject" field.
+func (_u *ChatUserMessageUpdate) ClearAuthorSubject() *ChatUserMessageUpdate {
+	_u.mutation.ClearAuthorSubject()
+	return _u
+}
+
+// SetImages sets the "images" field.
+func (_u *ChatUserMessageUpdate) SetImages(v []schema.ImageRef) *ChatUserMessageUpdate {
+	_u.mutation.SetImages(v)
+	return _u
+}
+
+// AppendImages appends value to the "images" field.
+func (_u *ChatUserMessageUpdate) AppendImages(v []schema.ImageRef) *ChatUserMessageUpdate {
+	_u.mutation.AppendImages(v)
+	return _u
+}
+
+// ClearImages clears the value of the "images" field.
+func (_u *ChatUserMessageUpdate) ClearImages() *ChatUserMessageUpdate {
+	_u.mutation.ClearImages()
+	return _u
+}
+
+// SetVoiceNote sets the "voice_note" field.
+func (_u *ChatUserMessageUpdate) SetVoiceNote(v *schema.VoiceNote) *ChatUserMessageUpdate {
+	_u.mutation.SetVoiceNote(v)
+	return _u
+}
+
+// ClearVoiceNote clears the value of the "voice_note" field.
+func (_u *ChatUserMessageUpdate) ClearVoiceNote() *ChatUserMessageUpdate {
+	_u.mutation.ClearVoiceNote()
+	return _u
+}
+
 // SetStageID sets the "stage" edge to the Stage entity by ID.
 func (_u *ChatUserMessageUpdate) SetStageID(id string) *ChatUserMessageUpdate {
 	_u.mutation.SetStageID(id)
@@ -146,6 +198,29 @@ func (_u *ChatUserMessageUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.Author(); ok {
 		_spec.SetField(chatusermessage.FieldAuthor, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AuthorSubject(); ok {
+		_spec.SetField(chatusermessage.FieldAuthorSubject, field.TypeString, value)
+	}
+	if _u.mutation.AuthorSubjectCleared() {
+		_spec.ClearField(chatusermessage.FieldAuthorSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.Images(); ok {
+		_spec.SetField(chatusermessage.FieldImages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatusermessage.FieldImages, value)
+		})
+	}
+	if _u.mutation.ImagesCleared() {
+		_spec.ClearField(chatusermessage.FieldImages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VoiceNote(); ok {
+		_spec.SetField(chatusermessage.FieldVoiceNote, field.TypeJSON, value)
+	}
+	if _u.mutation.VoiceNoteCleared() {
+		_spec.ClearField(chatusermessage.FieldVoiceNote, field.TypeJSON)
+	}
 	if _u.mutation.StageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -225,6 +300,56 @@ func (_u *ChatUserMessageUpdateOne) SetNillableAuthor(v *string) *ChatUserMessag
 	return _u
 }
 
+// SetAuthorSubject sets the "author_subject" field.
+func (_u *ChatUserMessageUpdateOne) SetAuthorSubject(v string) *ChatUserMessageUpdateOne {
+	_u.mutation.SetAuthorSubject(v)
+	return _u
+}
+
+// SetNillableAuthorSubject sets the "author_subject" field if the given value is not nil.
+func (_u *ChatUserMessageUpdateOne) SetNillableAuthorSubject(v *string) *ChatUserMessageUpdateOne {
+	if v != nil {
+		_u.SetAuthorSubject(*v)
+	}
+	return _u
+}
+
+// ClearAuthorSubject clears the value of the "author_subject" field.
+func (_u *ChatUserMessageUpdateOne) ClearAuthorSubject() *ChatUserMessageUpdateOne {
+	_u.mutation.ClearAuthorSubject()
+	return _u
+}
+
+// SetImages sets the "images" field.
+func (_u *ChatUserMessageUpdateOne) SetImages(v []schema.ImageRef) *ChatUserMessageUpdateOne {
+	_u.mutation.SetImages(v)
+	return _u
+}
+
+// AppendImages appends value to the "images" field.
+func (_u *ChatUserMessageUpdateOne) AppendImages(v []schema.ImageRef) *ChatUserMessageUpdateOne {
+	_u.mutation.AppendImages(v)
+	return _u
+}
+
+// ClearImages clears the value of the "images" field.
+func (_u *ChatUserMessageUpdateOne) ClearImages() *ChatUserMessageUpdateOne {
+	_u.mutation.ClearImages()
+	return _u
+}
+
+// SetVoiceNote sets the "voice_note" field.
+func (_u *ChatUserMessageUpdateOne) SetVoiceNote(v *schema.VoiceNote) *ChatUserMessageUpdateOne {
+	_u.mutation.SetVoiceNote(v)
+	return _u
+}
+
+// ClearVoiceNote clears the value of the "voice_note" field.
+func (_u *ChatUserMessageUpdateOne) ClearVoiceNote() *ChatUserMessageUpdateOne {
+	_u.mutation.ClearVoiceNote()
+	return _u
+}
+
 // SetStageID sets the "stage" edge to the Stage entity by ID.
 func (_u *ChatUserMessageUpdateOne) SetStageID(id string) *ChatUserMessageUpdateOne {
 	_u.mutation.SetStageID(id)
@@ -344,6 +469,29 @@ func (_u *ChatUserMessageUpdateOne) sqlSave(ctx context.Context) (_node *ChatUse
 	if value, ok := _u.mutation.Author(); ok {
 		_spec.SetField(chatusermessage.FieldAuthor, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AuthorSubject(); ok {
+		_spec.SetField(chatusermessage.FieldAuthorSubject, field.TypeString, value)
+	}
+	if _u.mutation.AuthorSubjectCleared() {
+		_spec.ClearField(chatusermessage.FieldAuthorSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.Images(); ok {
+		_spec.SetField(chatusermessage.FieldImages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatusermessage.FieldImages, value)
+		})
+	}
+	if _u.mutation.ImagesCleared() {
+		_spec.ClearField(chatusermessage.FieldImages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VoiceNote(); ok {
+		_spec.SetField(chatusermessage.FieldVoiceNote, field.TypeJSON, value)
+	}
+	if _u.mutation.VoiceNoteCleared() {
+		_spec.ClearField(chatusermessage.FieldVoiceNote, field.TypeJSON)
+	}
 	if _u.mutation.StageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
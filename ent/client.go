@@ -15,19 +15,37 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
 	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/blob"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
 	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
 	"github.com/codeready-toolchain/tarsy/ent/event"
+	"github.com/codeready-toolchain/tarsy/ent/featureflagoverride"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
 	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
+	"github.com/codeready-toolchain/tarsy/ent/jobleader"
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
 	"github.com/codeready-toolchain/tarsy/ent/message"
+	"github.com/codeready-toolchain/tarsy/ent/podheartbeat"
+	"github.com/codeready-toolchain/tarsy/ent/queuepause"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+	"github.com/codeready-toolchain/tarsy/ent/savedview"
+	"github.com/codeready-toolchain/tarsy/ent/schemacompatibility"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
 	"github.com/codeready-toolchain/tarsy/ent/sessionreviewactivity"
 	"github.com/codeready-toolchain/tarsy/ent/sessionscore"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/ent/userprofile"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -35,24 +53,54 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ActionItem is the client for interacting with the ActionItem builders.
+	ActionItem *ActionItemClient
+	// ActivityReport is the client for interacting with the ActivityReport builders.
+	ActivityReport *ActivityReportClient
 	// AgentExecution is the client for interacting with the AgentExecution builders.
 	AgentExecution *AgentExecutionClient
 	// AlertSession is the client for interacting with the AlertSession builders.
 	AlertSession *AlertSessionClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
 	// ChatUserMessage is the client for interacting with the ChatUserMessage builders.
 	ChatUserMessage *ChatUserMessageClient
+	// ConfigRegistration is the client for interacting with the ConfigRegistration builders.
+	ConfigRegistration *ConfigRegistrationClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
+	// FeatureFlagOverride is the client for interacting with the FeatureFlagOverride builders.
+	FeatureFlagOverride *FeatureFlagOverrideClient
+	// HandoffNoteRevision is the client for interacting with the HandoffNoteRevision builders.
+	HandoffNoteRevision *HandoffNoteRevisionClient
 	// InvestigationMemory is the client for interacting with the InvestigationMemory builders.
 	InvestigationMemory *InvestigationMemoryClient
+	// JobLeader is the client for interacting with the JobLeader builders.
+	JobLeader *JobLeaderClient
 	// LLMInteraction is the client for interacting with the LLMInteraction builders.
 	LLMInteraction *LLMInteractionClient
 	// MCPInteraction is the client for interacting with the MCPInteraction builders.
 	MCPInteraction *MCPInteractionClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// PodHeartbeat is the client for interacting with the PodHeartbeat builders.
+	PodHeartbeat *PodHeartbeatClient
+	// QueuePause is the client for interacting with the QueuePause builders.
+	QueuePause *QueuePauseClient
+	// RedactionReview is the client for interacting with the RedactionReview builders.
+	RedactionReview *RedactionReviewClient
+	// SavedView is the client for interacting with the SavedView builders.
+	SavedView *SavedViewClient
+	// SchemaCompatibility is the client for interacting with the SchemaCompatibility builders.
+	SchemaCompatibility *SchemaCompatibilityClient
+	// SessionClaim is the client for interacting with the SessionClaim builders.
+	SessionClaim *SessionClaimClient
+	// SessionComparison is the client for interacting with the SessionComparison builders.
+	SessionComparison *SessionComparisonClient
+	// SessionGroup is the client for interacting with the SessionGroup builders.
+	SessionGroup *SessionGroupClient
 	// SessionReviewActivity is the client for interacting with the SessionReviewActivity builders.
 	SessionReviewActivity *SessionReviewActivityClient
 	// SessionScore is the client for interacting with the SessionScore builders.
@@ -61,6 +109,8 @@ type Client struct {
 	Stage *StageClient
 	// TimelineEvent is the client for interacting with the TimelineEvent builders.
 	TimelineEvent *TimelineEventClient
+	// UserProfile is the client for interacting with the UserProfile builders.
+	UserProfile *UserProfileClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -72,19 +122,35 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ActionItem = NewActionItemClient(c.config)
+	c.ActivityReport = NewActivityReportClient(c.config)
 	c.AgentExecution = NewAgentExecutionClient(c.config)
 	c.AlertSession = NewAlertSessionClient(c.config)
+	c.Blob = NewBlobClient(c.config)
 	c.Chat = NewChatClient(c.config)
 	c.ChatUserMessage = NewChatUserMessageClient(c.config)
+	c.ConfigRegistration = NewConfigRegistrationClient(c.config)
 	c.Event = NewEventClient(c.config)
+	c.FeatureFlagOverride = NewFeatureFlagOverrideClient(c.config)
+	c.HandoffNoteRevision = NewHandoffNoteRevisionClient(c.config)
 	c.InvestigationMemory = NewInvestigationMemoryClient(c.config)
+	c.JobLeader = NewJobLeaderClient(c.config)
 	c.LLMInteraction = NewLLMInteractionClient(c.config)
 	c.MCPInteraction = NewMCPInteractionClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.PodHeartbeat = NewPodHeartbeatClient(c.config)
+	c.QueuePause = NewQueuePauseClient(c.config)
+	c.RedactionReview = NewRedactionReviewClient(c.config)
+	c.SavedView = NewSavedViewClient(c.config)
+	c.SchemaCompatibility = NewSchemaCompatibilityClient(c.config)
+	c.SessionClaim = NewSessionClaimClient(c.config)
+	c.SessionComparison = NewSessionComparisonClient(c.config)
+	c.SessionGroup = NewSessionGroupClient(c.config)
 	c.SessionReviewActivity = NewSessionReviewActivityClient(c.config)
 	c.SessionScore = NewSessionScoreClient(c.config)
 	c.Stage = NewStageClient(c.config)
 	c.TimelineEvent = NewTimelineEventClient(c.config)
+	c.UserProfile = NewUserProfileClient(c.config)
 }
 
 type (
@@ -177,19 +243,35 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                   ctx,
 		config:                cfg,
+		ActionItem:            NewActionItemClient(cfg),
+		ActivityReport:        NewActivityReportClient(cfg),
 		AgentExecution:        NewAgentExecutionClient(cfg),
 		AlertSession:          NewAlertSessionClient(cfg),
+		Blob:                  NewBlobClient(cfg),
 		Chat:                  NewChatClient(cfg),
 		ChatUserMessage:       NewChatUserMessageClient(cfg),
+		ConfigRegistration:    NewConfigRegistrationClient(cfg),
 		Event:                 NewEventClient(cfg),
+		FeatureFlagOverride:   NewFeatureFlagOverrideClient(cfg),
+		HandoffNoteRevision:   NewHandoffNoteRevisionClient(cfg),
 		InvestigationMemory:   NewInvestigationMemoryClient(cfg),
+		JobLeader:             NewJobLeaderClient(cfg),
 		LLMInteraction:        NewLLMInteractionClient(cfg),
 		MCPInteraction:        NewMCPInteractionClient(cfg),
 		Message:               NewMessageClient(cfg),
+		PodHeartbeat:          NewPodHeartbeatClient(cfg),
+		QueuePause:            NewQueuePauseClient(cfg),
+		RedactionReview:       NewRedactionReviewClient(cfg),
+		SavedView:             NewSavedViewClient(cfg),
+		SchemaCompatibility:   NewSchemaCompatibilityClient(cfg),
+		SessionClaim:          NewSessionClaimClient(cfg),
+		SessionComparison:     NewSessionComparisonClient(cfg),
+		SessionGroup:          NewSessionGroupClient(cfg),
 		SessionReviewActivity: NewSessionReviewActivityClient(cfg),
 		SessionScore:          NewSessionScoreClient(cfg),
 		Stage:                 NewStageClient(cfg),
 		TimelineEvent:         NewTimelineEventClient(cfg),
+		UserProfile:           NewUserProfileClient(cfg),
 	}, nil
 }
 
@@ -209,26 +291,42 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                   ctx,
 		config:                cfg,
+		ActionItem:            NewActionItemClient(cfg),
+		ActivityReport:        NewActivityReportClient(cfg),
 		AgentExecution:        NewAgentExecutionClient(cfg),
 		AlertSession:          NewAlertSessionClient(cfg),
+		Blob:                  NewBlobClient(cfg),
 		Chat:                  NewChatClient(cfg),
 		ChatUserMessage:       NewChatUserMessageClient(cfg),
+		ConfigRegistration:    NewConfigRegistrationClient(cfg),
 		Event:                 NewEventClient(cfg),
+		FeatureFlagOverride:   NewFeatureFlagOverrideClient(cfg),
+		HandoffNoteRevision:   NewHandoffNoteRevisionClient(cfg),
 		InvestigationMemory:   NewInvestigationMemoryClient(cfg),
+		JobLeader:             NewJobLeaderClient(cfg),
 		LLMInteraction:        NewLLMInteractionClient(cfg),
 		MCPInteraction:        NewMCPInteractionClient(cfg),
 		Message:               NewMessageClient(cfg),
+		PodHeartbeat:          NewPodHeartbeatClient(cfg),
+		QueuePause:            NewQueuePauseClient(cfg),
+		RedactionReview:       NewRedactionReviewClient(cfg),
+		SavedView:             NewSavedViewClient(cfg),
+		SchemaCompatibility:   NewSchemaCompatibilityClient(cfg),
+		SessionClaim:          NewSessionClaimClient(cfg),
+		SessionComparison:     NewSessionComparisonClient(cfg),
+		SessionGroup:          NewSessionGroupClient(cfg),
 		SessionReviewActivity: NewSessionReviewActivityClient(cfg),
 		SessionScore:          NewSessionScoreClient(cfg),
 		Stage:                 NewStageClient(cfg),
 		TimelineEvent:         NewTimelineEventClient(cfg),
+		UserProfile:           NewUserProfileClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AgentExecution.
+//		ActionItem.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -251,9 +349,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AgentExecution, c.AlertSession, c.Chat, c.ChatUserMessage, c.Event,
-		c.InvestigationMemory, c.LLMInteraction, c.MCPInteraction, c.Message,
-		c.SessionReviewActivity, c.SessionScore, c.Stage, c.TimelineEvent,
+		c.ActionItem, c.ActivityReport, c.AgentExecution, c.AlertSession, c.Blob,
+		c.Chat, c.ChatUserMessage, c.ConfigRegistration, c.Event,
+		c.FeatureFlagOverride, c.HandoffNoteRevision, c.InvestigationMemory,
+		c.JobLeader, c.LLMInteraction, c.MCPInteraction, c.Message, c.PodHeartbeat,
+		c.QueuePause, c.RedactionReview, c.SavedView, c.SchemaCompatibility,
+		c.SessionClaim, c.SessionComparison, c.SessionGroup, c.SessionReviewActivity,
+		c.SessionScore, c.Stage, c.TimelineEvent, c.UserProfile,
 	} {
 		n.Use(hooks...)
 	}
@@ -263,9 +365,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AgentExecution, c.AlertSession, c.Chat, c.ChatUserMessage, c.Event,
-		c.InvestigationMemory, c.LLMInteraction, c.MCPInteraction, c.Message,
-		c.SessionReviewActivity, c.SessionScore, c.Stage, c.TimelineEvent,
+		c.ActionItem, c.ActivityReport, c.AgentExecution, c.AlertSession, c.Blob,
+		c.Chat, c.ChatUserMessage, c.ConfigRegistration, c.Event,
+		c.FeatureFlagOverride, c.HandoffNoteRevision, c.InvestigationMemory,
+		c.JobLeader, c.LLMInteraction, c.MCPInteraction, c.Message, c.PodHeartbeat,
+		c.QueuePause, c.RedactionReview, c.SavedView, c.SchemaCompatibility,
+		c.SessionClaim, c.SessionComparison, c.SessionGroup, c.SessionReviewActivity,
+		c.SessionScore, c.Stage, c.TimelineEvent, c.UserProfile,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -274,24 +380,54 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ActionItemMutation:
+		return c.ActionItem.mutate(ctx, m)
+	case *ActivityReportMutation:
+		return c.ActivityReport.mutate(ctx, m)
 	case *AgentExecutionMutation:
 		return c.AgentExecution.mutate(ctx, m)
 	case *AlertSessionMutation:
 		return c.AlertSession.mutate(ctx, m)
+	case *BlobMutation:
+		return c.Blob.mutate(ctx, m)
 	case *ChatMutation:
 		return c.Chat.mutate(ctx, m)
 	case *ChatUserMessageMutation:
 		return c.ChatUserMessage.mutate(ctx, m)
+	case *ConfigRegistrationMutation:
+		return c.ConfigRegistration.mutate(ctx, m)
 	case *EventMutation:
 		return c.Event.mutate(ctx, m)
+	case *FeatureFlagOverrideMutation:
+		return c.FeatureFlagOverride.mutate(ctx, m)
+	case *HandoffNoteRevisionMutation:
+		return c.HandoffNoteRevision.mutate(ctx, m)
 	case *InvestigationMemoryMutation:
 		return c.InvestigationMemory.mutate(ctx, m)
+	case *JobLeaderMutation:
+		return c.JobLeader.mutate(ctx, m)
 	case *LLMInteractionMutation:
 		return c.LLMInteraction.mutate(ctx, m)
 	case *MCPInteractionMutation:
 		return c.MCPInteraction.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *PodHeartbeatMutation:
+		return c.PodHeartbeat.mutate(ctx, m)
+	case *QueuePauseMutation:
+		return c.QueuePause.mutate(ctx, m)
+	case *RedactionReviewMutation:
+		return c.RedactionReview.mutate(ctx, m)
+	case *SavedViewMutation:
+		return c.SavedView.mutate(ctx, m)
+	case *SchemaCompatibilityMutation:
+		return c.SchemaCompatibility.mutate(ctx, m)
+	case *SessionClaimMutation:
+		return c.SessionClaim.mutate(ctx, m)
+	case *SessionComparisonMutation:
+		return c.SessionComparison.mutate(ctx, m)
+	case *SessionGroupMutation:
+		return c.SessionGroup.mutate(ctx, m)
 	case *SessionReviewActivityMutation:
 		return c.SessionReviewActivity.mutate(ctx, m)
 	case *SessionScoreMutation:
@@ -300,11 +436,296 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Stage.mutate(ctx, m)
 	case *TimelineEventMutation:
 		return c.TimelineEvent.mutate(ctx, m)
+	case *UserProfileMutation:
+		return c.UserProfile.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// ActionItemClient is a client for the ActionItem schema.
+type ActionItemClient struct {
+	config
+}
+
+// NewActionItemClient returns a client for the ActionItem from the given config.
+func NewActionItemClient(c config) *ActionItemClient {
+	return &ActionItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `actionitem.Hooks(f(g(h())))`.
+func (c *ActionItemClient) Use(hooks ...Hook) {
+	c.hooks.ActionItem = append(c.hooks.ActionItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `actionitem.Intercept(f(g(h())))`.
+func (c *ActionItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActionItem = append(c.inters.ActionItem, interceptors...)
+}
+
+// Create returns a builder for creating a ActionItem entity.
+func (c *ActionItemClient) Create() *ActionItemCreate {
+	mutation := newActionItemMutation(c.config, OpCreate)
+	return &ActionItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ActionItem entities.
+func (c *ActionItemClient) CreateBulk(builders ...*ActionItemCreate) *ActionItemCreateBulk {
+	return &ActionItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ActionItemClient) MapCreateBulk(slice any, setFunc func(*ActionItemCreate, int)) *ActionItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ActionItemCreateBulk{err: fmt.Errorf("calling to ActionItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ActionItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ActionItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ActionItem.
+func (c *ActionItemClient) Update() *ActionItemUpdate {
+	mutation := newActionItemMutation(c.config, OpUpdate)
+	return &ActionItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ActionItemClient) UpdateOne(_m *ActionItem) *ActionItemUpdateOne {
+	mutation := newActionItemMutation(c.config, OpUpdateOne, withActionItem(_m))
+	return &ActionItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ActionItemClient) UpdateOneID(id string) *ActionItemUpdateOne {
+	mutation := newActionItemMutation(c.config, OpUpdateOne, withActionItemID(id))
+	return &ActionItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ActionItem.
+func (c *ActionItemClient) Delete() *ActionItemDelete {
+	mutation := newActionItemMutation(c.config, OpDelete)
+	return &ActionItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ActionItemClient) DeleteOne(_m *ActionItem) *ActionItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ActionItemClient) DeleteOneID(id string) *ActionItemDeleteOne {
+	builder := c.Delete().Where(actionitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ActionItemDeleteOne{builder}
+}
+
+// Query returns a query builder for ActionItem.
+func (c *ActionItemClient) Query() *ActionItemQuery {
+	return &ActionItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeActionItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ActionItem entity by its id.
+func (c *ActionItemClient) Get(ctx context.Context, id string) (*ActionItem, error) {
+	return c.Query().Where(actionitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ActionItemClient) GetX(ctx context.Context, id string) *ActionItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a ActionItem.
+func (c *ActionItemClient) QuerySession(_m *ActionItem) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(actionitem.Table, actionitem.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, actionitem.SessionTable, actionitem.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ActionItemClient) Hooks() []Hook {
+	hooks := c.hooks.ActionItem
+	return append(hooks[:len(hooks):len(hooks)], actionitem.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ActionItemClient) Interceptors() []Interceptor {
+	return c.inters.ActionItem
+}
+
+func (c *ActionItemClient) mutate(ctx context.Context, m *ActionItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ActionItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ActionItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ActionItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ActionItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ActionItem mutation op: %q", m.Op())
+	}
+}
+
+// ActivityReportClient is a client for the ActivityReport schema.
+type ActivityReportClient struct {
+	config
+}
+
+// NewActivityReportClient returns a client for the ActivityReport from the given config.
+func NewActivityReportClient(c config) *ActivityReportClient {
+	return &ActivityReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `activityreport.Hooks(f(g(h())))`.
+func (c *ActivityReportClient) Use(hooks ...Hook) {
+	c.hooks.ActivityReport = append(c.hooks.ActivityReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `activityreport.Intercept(f(g(h())))`.
+func (c *ActivityReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ActivityReport = append(c.inters.ActivityReport, interceptors...)
+}
+
+// Create returns a builder for creating a ActivityReport entity.
+func (c *ActivityReportClient) Create() *ActivityReportCreate {
+	mutation := newActivityReportMutation(c.config, OpCreate)
+	return &ActivityReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ActivityReport entities.
+func (c *ActivityReportClient) CreateBulk(builders ...*ActivityReportCreate) *ActivityReportCreateBulk {
+	return &ActivityReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ActivityReportClient) MapCreateBulk(slice any, setFunc func(*ActivityReportCreate, int)) *ActivityReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ActivityReportCreateBulk{err: fmt.Errorf("calling to ActivityReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ActivityReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ActivityReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ActivityReport.
+func (c *ActivityReportClient) Update() *ActivityReportUpdate {
+	mutation := newActivityReportMutation(c.config, OpUpdate)
+	return &ActivityReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ActivityReportClient) UpdateOne(_m *ActivityReport) *ActivityReportUpdateOne {
+	mutation := newActivityReportMutation(c.config, OpUpdateOne, withActivityReport(_m))
+	return &ActivityReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ActivityReportClient) UpdateOneID(id string) *ActivityReportUpdateOne {
+	mutation := newActivityReportMutation(c.config, OpUpdateOne, withActivityReportID(id))
+	return &ActivityReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ActivityReport.
+func (c *ActivityReportClient) Delete() *ActivityReportDelete {
+	mutation := newActivityReportMutation(c.config, OpDelete)
+	return &ActivityReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ActivityReportClient) DeleteOne(_m *ActivityReport) *ActivityReportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ActivityReportClient) DeleteOneID(id string) *ActivityReportDeleteOne {
+	builder := c.Delete().Where(activityreport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ActivityReportDeleteOne{builder}
+}
+
+// Query returns a query builder for ActivityReport.
+func (c *ActivityReportClient) Query() *ActivityReportQuery {
+	return &ActivityReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeActivityReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ActivityReport entity by its id.
+func (c *ActivityReportClient) Get(ctx context.Context, id string) (*ActivityReport, error) {
+	return c.Query().Where(activityreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ActivityReportClient) GetX(ctx context.Context, id string) *ActivityReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ActivityReportClient) Hooks() []Hook {
+	return c.hooks.ActivityReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *ActivityReportClient) Interceptors() []Interceptor {
+	return c.inters.ActivityReport
+}
+
+func (c *ActivityReportClient) mutate(ctx context.Context, m *ActivityReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ActivityReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ActivityReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ActivityReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ActivityReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ActivityReport mutation op: %q", m.Op())
+	}
+}
+
 // AgentExecutionClient is a client for the AgentExecution schema.
 type AgentExecutionClient struct {
 	config
@@ -850,6 +1271,22 @@ func (c *AlertSessionClient) QueryReviewActivities(_m *AlertSession) *SessionRev
 	return query
 }
 
+// QueryClaims queries the claims edge of a AlertSession.
+func (c *AlertSessionClient) QueryClaims(_m *AlertSession) *SessionClaimQuery {
+	query := (&SessionClaimClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, id),
+			sqlgraph.To(sessionclaim.Table, sessionclaim.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.ClaimsTable, alertsession.ClaimsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryMemories queries the memories edge of a AlertSession.
 func (c *AlertSessionClient) QueryMemories(_m *AlertSession) *InvestigationMemoryQuery {
 	query := (&InvestigationMemoryClient{config: c.config}).Query()
@@ -882,6 +1319,102 @@ func (c *AlertSessionClient) QueryInjectedMemories(_m *AlertSession) *Investigat
 	return query
 }
 
+// QueryRedactionReviews queries the redaction_reviews edge of a AlertSession.
+func (c *AlertSessionClient) QueryRedactionReviews(_m *AlertSession) *RedactionReviewQuery {
+	query := (&RedactionReviewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, id),
+			sqlgraph.To(redactionreview.Table, redactionreview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.RedactionReviewsTable, alertsession.RedactionReviewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHandoffNoteRevisions queries the handoff_note_revisions edge of a AlertSession.
+func (c *AlertSessionClient) QueryHandoffNoteRevisions(_m *AlertSession) *HandoffNoteRevisionQuery {
+	query := (&HandoffNoteRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, id),
+			sqlgraph.To(handoffnoterevision.Table, handoffnoterevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.HandoffNoteRevisionsTable, alertsession.HandoffNoteRevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComparison queries the comparison edge of a AlertSession.
+func (c *AlertSessionClient) QueryComparison(_m *AlertSession) *SessionComparisonQuery {
+	query := (&SessionComparisonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, id),
+			sqlgraph.To(sessioncomparison.Table, sessioncomparison.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, alertsession.ComparisonTable, alertsession.ComparisonColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNextComparisons queries the next_comparisons edge of a AlertSession.
+func (c *AlertSessionClient) QueryNextComparisons(_m *AlertSession) *SessionComparisonQuery {
+	query := (&SessionComparisonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, id),
+			sqlgraph.To(sessioncomparison.Table, sessioncomparison.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.NextComparisonsTable, alertsession.NextComparisonsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActionItems queries the action_items edge of a AlertSession.
+func (c *AlertSessionClient) QueryActionItems(_m *AlertSession) *ActionItemQuery {
+	query := (&ActionItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, id),
+			sqlgraph.To(actionitem.Table, actionitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, alertsession.ActionItemsTable, alertsession.ActionItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroup queries the group edge of a AlertSession.
+func (c *AlertSessionClient) QueryGroup(_m *AlertSession) *SessionGroupQuery {
+	query := (&SessionGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(alertsession.Table, alertsession.FieldID, id),
+			sqlgraph.To(sessiongroup.Table, sessiongroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, alertsession.GroupTable, alertsession.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AlertSessionClient) Hooks() []Hook {
 	return c.hooks.AlertSession
@@ -907,35 +1440,168 @@ func (c *AlertSessionClient) mutate(ctx context.Context, m *AlertSessionMutation
 	}
 }
 
-// ChatClient is a client for the Chat schema.
-type ChatClient struct {
+// BlobClient is a client for the Blob schema.
+type BlobClient struct {
 	config
 }
 
-// NewChatClient returns a client for the Chat from the given config.
-func NewChatClient(c config) *ChatClient {
-	return &ChatClient{config: c}
+// NewBlobClient returns a client for the Blob from the given config.
+func NewBlobClient(c config) *BlobClient {
+	return &BlobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `chat.Hooks(f(g(h())))`.
-func (c *ChatClient) Use(hooks ...Hook) {
-	c.hooks.Chat = append(c.hooks.Chat, hooks...)
+// A call to `Use(f, g, h)` equals to `blob.Hooks(f(g(h())))`.
+func (c *BlobClient) Use(hooks ...Hook) {
+	c.hooks.Blob = append(c.hooks.Blob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `chat.Intercept(f(g(h())))`.
-func (c *ChatClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Chat = append(c.inters.Chat, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `blob.Intercept(f(g(h())))`.
+func (c *BlobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Blob = append(c.inters.Blob, interceptors...)
 }
 
-// Create returns a builder for creating a Chat entity.
-func (c *ChatClient) Create() *ChatCreate {
-	mutation := newChatMutation(c.config, OpCreate)
-	return &ChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Blob entity.
+func (c *BlobClient) Create() *BlobCreate {
+	mutation := newBlobMutation(c.config, OpCreate)
+	return &BlobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Chat entities.
+// CreateBulk returns a builder for creating a bulk of Blob entities.
+func (c *BlobClient) CreateBulk(builders ...*BlobCreate) *BlobCreateBulk {
+	return &BlobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlobClient) MapCreateBulk(slice any, setFunc func(*BlobCreate, int)) *BlobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlobCreateBulk{err: fmt.Errorf("calling to BlobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Blob.
+func (c *BlobClient) Update() *BlobUpdate {
+	mutation := newBlobMutation(c.config, OpUpdate)
+	return &BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlobClient) UpdateOne(_m *Blob) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlob(_m))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlobClient) UpdateOneID(id string) *BlobUpdateOne {
+	mutation := newBlobMutation(c.config, OpUpdateOne, withBlobID(id))
+	return &BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Blob.
+func (c *BlobClient) Delete() *BlobDelete {
+	mutation := newBlobMutation(c.config, OpDelete)
+	return &BlobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlobClient) DeleteOne(_m *Blob) *BlobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlobClient) DeleteOneID(id string) *BlobDeleteOne {
+	builder := c.Delete().Where(blob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlobDeleteOne{builder}
+}
+
+// Query returns a query builder for Blob.
+func (c *BlobClient) Query() *BlobQuery {
+	return &BlobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Blob entity by its id.
+func (c *BlobClient) Get(ctx context.Context, id string) (*Blob, error) {
+	return c.Query().Where(blob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlobClient) GetX(ctx context.Context, id string) *Blob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BlobClient) Hooks() []Hook {
+	return c.hooks.Blob
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlobClient) Interceptors() []Interceptor {
+	return c.inters.Blob
+}
+
+func (c *BlobClient) mutate(ctx context.Context, m *BlobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Blob mutation op: %q", m.Op())
+	}
+}
+
+// ChatClient is a client for the Chat schema.
+type ChatClient struct {
+	config
+}
+
+// NewChatClient returns a client for the Chat from the given config.
+func NewChatClient(c config) *ChatClient {
+	return &ChatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chat.Hooks(f(g(h())))`.
+func (c *ChatClient) Use(hooks ...Hook) {
+	c.hooks.Chat = append(c.hooks.Chat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chat.Intercept(f(g(h())))`.
+func (c *ChatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Chat = append(c.inters.Chat, interceptors...)
+}
+
+// Create returns a builder for creating a Chat entity.
+func (c *ChatClient) Create() *ChatCreate {
+	mutation := newChatMutation(c.config, OpCreate)
+	return &ChatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Chat entities.
 func (c *ChatClient) CreateBulk(builders ...*ChatCreate) *ChatCreateBulk {
 	return &ChatCreateBulk{config: c.config, builders: builders}
 }
@@ -1065,7 +1731,8 @@ func (c *ChatClient) QueryStages(_m *Chat) *StageQuery {
 
 // Hooks returns the client hooks.
 func (c *ChatClient) Hooks() []Hook {
-	return c.hooks.Chat
+	hooks := c.hooks.Chat
+	return append(hooks[:len(hooks):len(hooks)], chat.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1253,6 +1920,140 @@ func (c *ChatUserMessageClient) mutate(ctx context.Context, m *ChatUserMessageMu
 	}
 }
 
+// ConfigRegistrationClient is a client for the ConfigRegistration schema.
+type ConfigRegistrationClient struct {
+	config
+}
+
+// NewConfigRegistrationClient returns a client for the ConfigRegistration from the given config.
+func NewConfigRegistrationClient(c config) *ConfigRegistrationClient {
+	return &ConfigRegistrationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `configregistration.Hooks(f(g(h())))`.
+func (c *ConfigRegistrationClient) Use(hooks ...Hook) {
+	c.hooks.ConfigRegistration = append(c.hooks.ConfigRegistration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `configregistration.Intercept(f(g(h())))`.
+func (c *ConfigRegistrationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConfigRegistration = append(c.inters.ConfigRegistration, interceptors...)
+}
+
+// Create returns a builder for creating a ConfigRegistration entity.
+func (c *ConfigRegistrationClient) Create() *ConfigRegistrationCreate {
+	mutation := newConfigRegistrationMutation(c.config, OpCreate)
+	return &ConfigRegistrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConfigRegistration entities.
+func (c *ConfigRegistrationClient) CreateBulk(builders ...*ConfigRegistrationCreate) *ConfigRegistrationCreateBulk {
+	return &ConfigRegistrationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConfigRegistrationClient) MapCreateBulk(slice any, setFunc func(*ConfigRegistrationCreate, int)) *ConfigRegistrationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConfigRegistrationCreateBulk{err: fmt.Errorf("calling to ConfigRegistrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConfigRegistrationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConfigRegistrationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConfigRegistration.
+func (c *ConfigRegistrationClient) Update() *ConfigRegistrationUpdate {
+	mutation := newConfigRegistrationMutation(c.config, OpUpdate)
+	return &ConfigRegistrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConfigRegistrationClient) UpdateOne(_m *ConfigRegistration) *ConfigRegistrationUpdateOne {
+	mutation := newConfigRegistrationMutation(c.config, OpUpdateOne, withConfigRegistration(_m))
+	return &ConfigRegistrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConfigRegistrationClient) UpdateOneID(id string) *ConfigRegistrationUpdateOne {
+	mutation := newConfigRegistrationMutation(c.config, OpUpdateOne, withConfigRegistrationID(id))
+	return &ConfigRegistrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConfigRegistration.
+func (c *ConfigRegistrationClient) Delete() *ConfigRegistrationDelete {
+	mutation := newConfigRegistrationMutation(c.config, OpDelete)
+	return &ConfigRegistrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConfigRegistrationClient) DeleteOne(_m *ConfigRegistration) *ConfigRegistrationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConfigRegistrationClient) DeleteOneID(id string) *ConfigRegistrationDeleteOne {
+	builder := c.Delete().Where(configregistration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConfigRegistrationDeleteOne{builder}
+}
+
+// Query returns a query builder for ConfigRegistration.
+func (c *ConfigRegistrationClient) Query() *ConfigRegistrationQuery {
+	return &ConfigRegistrationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConfigRegistration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConfigRegistration entity by its id.
+func (c *ConfigRegistrationClient) Get(ctx context.Context, id string) (*ConfigRegistration, error) {
+	return c.Query().Where(configregistration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConfigRegistrationClient) GetX(ctx context.Context, id string) *ConfigRegistration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConfigRegistrationClient) Hooks() []Hook {
+	hooks := c.hooks.ConfigRegistration
+	return append(hooks[:len(hooks):len(hooks)], configregistration.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConfigRegistrationClient) Interceptors() []Interceptor {
+	return c.inters.ConfigRegistration
+}
+
+func (c *ConfigRegistrationClient) mutate(ctx context.Context, m *ConfigRegistrationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConfigRegistrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConfigRegistrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConfigRegistrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConfigRegistrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConfigRegistration mutation op: %q", m.Op())
+	}
+}
+
 // EventClient is a client for the Event schema.
 type EventClient struct {
 	config
@@ -1402,107 +2203,107 @@ func (c *EventClient) mutate(ctx context.Context, m *EventMutation) (Value, erro
 	}
 }
 
-// InvestigationMemoryClient is a client for the InvestigationMemory schema.
-type InvestigationMemoryClient struct {
+// FeatureFlagOverrideClient is a client for the FeatureFlagOverride schema.
+type FeatureFlagOverrideClient struct {
 	config
 }
 
-// NewInvestigationMemoryClient returns a client for the InvestigationMemory from the given config.
-func NewInvestigationMemoryClient(c config) *InvestigationMemoryClient {
-	return &InvestigationMemoryClient{config: c}
+// NewFeatureFlagOverrideClient returns a client for the FeatureFlagOverride from the given config.
+func NewFeatureFlagOverrideClient(c config) *FeatureFlagOverrideClient {
+	return &FeatureFlagOverrideClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `investigationmemory.Hooks(f(g(h())))`.
-func (c *InvestigationMemoryClient) Use(hooks ...Hook) {
-	c.hooks.InvestigationMemory = append(c.hooks.InvestigationMemory, hooks...)
+// A call to `Use(f, g, h)` equals to `featureflagoverride.Hooks(f(g(h())))`.
+func (c *FeatureFlagOverrideClient) Use(hooks ...Hook) {
+	c.hooks.FeatureFlagOverride = append(c.hooks.FeatureFlagOverride, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `investigationmemory.Intercept(f(g(h())))`.
-func (c *InvestigationMemoryClient) Intercept(interceptors ...Interceptor) {
-	c.inters.InvestigationMemory = append(c.inters.InvestigationMemory, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `featureflagoverride.Intercept(f(g(h())))`.
+func (c *FeatureFlagOverrideClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FeatureFlagOverride = append(c.inters.FeatureFlagOverride, interceptors...)
 }
 
-// Create returns a builder for creating a InvestigationMemory entity.
-func (c *InvestigationMemoryClient) Create() *InvestigationMemoryCreate {
-	mutation := newInvestigationMemoryMutation(c.config, OpCreate)
-	return &InvestigationMemoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FeatureFlagOverride entity.
+func (c *FeatureFlagOverrideClient) Create() *FeatureFlagOverrideCreate {
+	mutation := newFeatureFlagOverrideMutation(c.config, OpCreate)
+	return &FeatureFlagOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of InvestigationMemory entities.
-func (c *InvestigationMemoryClient) CreateBulk(builders ...*InvestigationMemoryCreate) *InvestigationMemoryCreateBulk {
-	return &InvestigationMemoryCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FeatureFlagOverride entities.
+func (c *FeatureFlagOverrideClient) CreateBulk(builders ...*FeatureFlagOverrideCreate) *FeatureFlagOverrideCreateBulk {
+	return &FeatureFlagOverrideCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvestigationMemoryClient) MapCreateBulk(slice any, setFunc func(*InvestigationMemoryCreate, int)) *InvestigationMemoryCreateBulk {
+func (c *FeatureFlagOverrideClient) MapCreateBulk(slice any, setFunc func(*FeatureFlagOverrideCreate, int)) *FeatureFlagOverrideCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvestigationMemoryCreateBulk{err: fmt.Errorf("calling to InvestigationMemoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FeatureFlagOverrideCreateBulk{err: fmt.Errorf("calling to FeatureFlagOverrideClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvestigationMemoryCreate, rv.Len())
+	builders := make([]*FeatureFlagOverrideCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvestigationMemoryCreateBulk{config: c.config, builders: builders}
+	return &FeatureFlagOverrideCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for InvestigationMemory.
-func (c *InvestigationMemoryClient) Update() *InvestigationMemoryUpdate {
-	mutation := newInvestigationMemoryMutation(c.config, OpUpdate)
-	return &InvestigationMemoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FeatureFlagOverride.
+func (c *FeatureFlagOverrideClient) Update() *FeatureFlagOverrideUpdate {
+	mutation := newFeatureFlagOverrideMutation(c.config, OpUpdate)
+	return &FeatureFlagOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvestigationMemoryClient) UpdateOne(_m *InvestigationMemory) *InvestigationMemoryUpdateOne {
-	mutation := newInvestigationMemoryMutation(c.config, OpUpdateOne, withInvestigationMemory(_m))
-	return &InvestigationMemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FeatureFlagOverrideClient) UpdateOne(_m *FeatureFlagOverride) *FeatureFlagOverrideUpdateOne {
+	mutation := newFeatureFlagOverrideMutation(c.config, OpUpdateOne, withFeatureFlagOverride(_m))
+	return &FeatureFlagOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvestigationMemoryClient) UpdateOneID(id string) *InvestigationMemoryUpdateOne {
-	mutation := newInvestigationMemoryMutation(c.config, OpUpdateOne, withInvestigationMemoryID(id))
-	return &InvestigationMemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FeatureFlagOverrideClient) UpdateOneID(id string) *FeatureFlagOverrideUpdateOne {
+	mutation := newFeatureFlagOverrideMutation(c.config, OpUpdateOne, withFeatureFlagOverrideID(id))
+	return &FeatureFlagOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for InvestigationMemory.
-func (c *InvestigationMemoryClient) Delete() *InvestigationMemoryDelete {
-	mutation := newInvestigationMemoryMutation(c.config, OpDelete)
-	return &InvestigationMemoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FeatureFlagOverride.
+func (c *FeatureFlagOverrideClient) Delete() *FeatureFlagOverrideDelete {
+	mutation := newFeatureFlagOverrideMutation(c.config, OpDelete)
+	return &FeatureFlagOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvestigationMemoryClient) DeleteOne(_m *InvestigationMemory) *InvestigationMemoryDeleteOne {
+func (c *FeatureFlagOverrideClient) DeleteOne(_m *FeatureFlagOverride) *FeatureFlagOverrideDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvestigationMemoryClient) DeleteOneID(id string) *InvestigationMemoryDeleteOne {
-	builder := c.Delete().Where(investigationmemory.ID(id))
+func (c *FeatureFlagOverrideClient) DeleteOneID(id string) *FeatureFlagOverrideDeleteOne {
+	builder := c.Delete().Where(featureflagoverride.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvestigationMemoryDeleteOne{builder}
+	return &FeatureFlagOverrideDeleteOne{builder}
 }
 
-// Query returns a query builder for InvestigationMemory.
-func (c *InvestigationMemoryClient) Query() *InvestigationMemoryQuery {
-	return &InvestigationMemoryQuery{
+// Query returns a query builder for FeatureFlagOverride.
+func (c *FeatureFlagOverrideClient) Query() *FeatureFlagOverrideQuery {
+	return &FeatureFlagOverrideQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvestigationMemory},
+		ctx:    &QueryContext{Type: TypeFeatureFlagOverride},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a InvestigationMemory entity by its id.
-func (c *InvestigationMemoryClient) Get(ctx context.Context, id string) (*InvestigationMemory, error) {
-	return c.Query().Where(investigationmemory.ID(id)).Only(ctx)
+// Get returns a FeatureFlagOverride entity by its id.
+func (c *FeatureFlagOverrideClient) Get(ctx context.Context, id string) (*FeatureFlagOverride, error) {
+	return c.Query().Where(featureflagoverride.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvestigationMemoryClient) GetX(ctx context.Context, id string) *InvestigationMemory {
+func (c *FeatureFlagOverrideClient) GetX(ctx context.Context, id string) *FeatureFlagOverride {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1510,164 +2311,1737 @@ func (c *InvestigationMemoryClient) GetX(ctx context.Context, id string) *Invest
 	return obj
 }
 
-// QuerySourceSession queries the source_session edge of a InvestigationMemory.
-func (c *InvestigationMemoryClient) QuerySourceSession(_m *InvestigationMemory) *AlertSessionQuery {
-	query := (&AlertSessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(investigationmemory.Table, investigationmemory.FieldID, id),
-			sqlgraph.To(alertsession.Table, alertsession.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, investigationmemory.SourceSessionTable, investigationmemory.SourceSessionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryInjectedIntoSessions queries the injected_into_sessions edge of a InvestigationMemory.
-func (c *InvestigationMemoryClient) QueryInjectedIntoSessions(_m *InvestigationMemory) *AlertSessionQuery {
-	query := (&AlertSessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(investigationmemory.Table, investigationmemory.FieldID, id),
-			sqlgraph.To(alertsession.Table, alertsession.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, investigationmemory.InjectedIntoSessionsTable, investigationmemory.InjectedIntoSessionsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *InvestigationMemoryClient) Hooks() []Hook {
-	return c.hooks.InvestigationMemory
+func (c *FeatureFlagOverrideClient) Hooks() []Hook {
+	hooks := c.hooks.FeatureFlagOverride
+	return append(hooks[:len(hooks):len(hooks)], featureflagoverride.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *InvestigationMemoryClient) Interceptors() []Interceptor {
-	return c.inters.InvestigationMemory
+func (c *FeatureFlagOverrideClient) Interceptors() []Interceptor {
+	return c.inters.FeatureFlagOverride
 }
 
-func (c *InvestigationMemoryClient) mutate(ctx context.Context, m *InvestigationMemoryMutation) (Value, error) {
+func (c *FeatureFlagOverrideClient) mutate(ctx context.Context, m *FeatureFlagOverrideMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InvestigationMemoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FeatureFlagOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InvestigationMemoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FeatureFlagOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InvestigationMemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FeatureFlagOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InvestigationMemoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FeatureFlagOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown InvestigationMemory mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown FeatureFlagOverride mutation op: %q", m.Op())
 	}
 }
 
-// LLMInteractionClient is a client for the LLMInteraction schema.
+// HandoffNoteRevisionClient is a client for the HandoffNoteRevision schema.
+type HandoffNoteRevisionClient struct {
+	config
+}
+
+// NewHandoffNoteRevisionClient returns a client for the HandoffNoteRevision from the given config.
+func NewHandoffNoteRevisionClient(c config) *HandoffNoteRevisionClient {
+	return &HandoffNoteRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `handoffnoterevision.Hooks(f(g(h())))`.
+func (c *HandoffNoteRevisionClient) Use(hooks ...Hook) {
+	c.hooks.HandoffNoteRevision = append(c.hooks.HandoffNoteRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `handoffnoterevision.Intercept(f(g(h())))`.
+func (c *HandoffNoteRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.HandoffNoteRevision = append(c.inters.HandoffNoteRevision, interceptors...)
+}
+
+// Create returns a builder for creating a HandoffNoteRevision entity.
+func (c *HandoffNoteRevisionClient) Create() *HandoffNoteRevisionCreate {
+	mutation := newHandoffNoteRevisionMutation(c.config, OpCreate)
+	return &HandoffNoteRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of HandoffNoteRevision entities.
+func (c *HandoffNoteRevisionClient) CreateBulk(builders ...*HandoffNoteRevisionCreate) *HandoffNoteRevisionCreateBulk {
+	return &HandoffNoteRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HandoffNoteRevisionClient) MapCreateBulk(slice any, setFunc func(*HandoffNoteRevisionCreate, int)) *HandoffNoteRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HandoffNoteRevisionCreateBulk{err: fmt.Errorf("calling to HandoffNoteRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HandoffNoteRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HandoffNoteRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for HandoffNoteRevision.
+func (c *HandoffNoteRevisionClient) Update() *HandoffNoteRevisionUpdate {
+	mutation := newHandoffNoteRevisionMutation(c.config, OpUpdate)
+	return &HandoffNoteRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HandoffNoteRevisionClient) UpdateOne(_m *HandoffNoteRevision) *HandoffNoteRevisionUpdateOne {
+	mutation := newHandoffNoteRevisionMutation(c.config, OpUpdateOne, withHandoffNoteRevision(_m))
+	return &HandoffNoteRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HandoffNoteRevisionClient) UpdateOneID(id string) *HandoffNoteRevisionUpdateOne {
+	mutation := newHandoffNoteRevisionMutation(c.config, OpUpdateOne, withHandoffNoteRevisionID(id))
+	return &HandoffNoteRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for HandoffNoteRevision.
+func (c *HandoffNoteRevisionClient) Delete() *HandoffNoteRevisionDelete {
+	mutation := newHandoffNoteRevisionMutation(c.config, OpDelete)
+	return &HandoffNoteRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HandoffNoteRevisionClient) DeleteOne(_m *HandoffNoteRevision) *HandoffNoteRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HandoffNoteRevisionClient) DeleteOneID(id string) *HandoffNoteRevisionDeleteOne {
+	builder := c.Delete().Where(handoffnoterevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HandoffNoteRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for HandoffNoteRevision.
+func (c *HandoffNoteRevisionClient) Query() *HandoffNoteRevisionQuery {
+	return &HandoffNoteRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHandoffNoteRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a HandoffNoteRevision entity by its id.
+func (c *HandoffNoteRevisionClient) Get(ctx context.Context, id string) (*HandoffNoteRevision, error) {
+	return c.Query().Where(handoffnoterevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HandoffNoteRevisionClient) GetX(ctx context.Context, id string) *HandoffNoteRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a HandoffNoteRevision.
+func (c *HandoffNoteRevisionClient) QuerySession(_m *HandoffNoteRevision) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(handoffnoterevision.Table, handoffnoterevision.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, handoffnoterevision.SessionTable, handoffnoterevision.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *HandoffNoteRevisionClient) Hooks() []Hook {
+	return c.hooks.HandoffNoteRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *HandoffNoteRevisionClient) Interceptors() []Interceptor {
+	return c.inters.HandoffNoteRevision
+}
+
+func (c *HandoffNoteRevisionClient) mutate(ctx context.Context, m *HandoffNoteRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HandoffNoteRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HandoffNoteRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HandoffNoteRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HandoffNoteRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown HandoffNoteRevision mutation op: %q", m.Op())
+	}
+}
+
+// InvestigationMemoryClient is a client for the InvestigationMemory schema.
+type InvestigationMemoryClient struct {
+	config
+}
+
+// NewInvestigationMemoryClient returns a client for the InvestigationMemory from the given config.
+func NewInvestigationMemoryClient(c config) *InvestigationMemoryClient {
+	return &InvestigationMemoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `investigationmemory.Hooks(f(g(h())))`.
+func (c *InvestigationMemoryClient) Use(hooks ...Hook) {
+	c.hooks.InvestigationMemory = append(c.hooks.InvestigationMemory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `investigationmemory.Intercept(f(g(h())))`.
+func (c *InvestigationMemoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvestigationMemory = append(c.inters.InvestigationMemory, interceptors...)
+}
+
+// Create returns a builder for creating a InvestigationMemory entity.
+func (c *InvestigationMemoryClient) Create() *InvestigationMemoryCreate {
+	mutation := newInvestigationMemoryMutation(c.config, OpCreate)
+	return &InvestigationMemoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvestigationMemory entities.
+func (c *InvestigationMemoryClient) CreateBulk(builders ...*InvestigationMemoryCreate) *InvestigationMemoryCreateBulk {
+	return &InvestigationMemoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvestigationMemoryClient) MapCreateBulk(slice any, setFunc func(*InvestigationMemoryCreate, int)) *InvestigationMemoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvestigationMemoryCreateBulk{err: fmt.Errorf("calling to InvestigationMemoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvestigationMemoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvestigationMemoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvestigationMemory.
+func (c *InvestigationMemoryClient) Update() *InvestigationMemoryUpdate {
+	mutation := newInvestigationMemoryMutation(c.config, OpUpdate)
+	return &InvestigationMemoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvestigationMemoryClient) UpdateOne(_m *InvestigationMemory) *InvestigationMemoryUpdateOne {
+	mutation := newInvestigationMemoryMutation(c.config, OpUpdateOne, withInvestigationMemory(_m))
+	return &InvestigationMemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvestigationMemoryClient) UpdateOneID(id string) *InvestigationMemoryUpdateOne {
+	mutation := newInvestigationMemoryMutation(c.config, OpUpdateOne, withInvestigationMemoryID(id))
+	return &InvestigationMemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvestigationMemory.
+func (c *InvestigationMemoryClient) Delete() *InvestigationMemoryDelete {
+	mutation := newInvestigationMemoryMutation(c.config, OpDelete)
+	return &InvestigationMemoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvestigationMemoryClient) DeleteOne(_m *InvestigationMemory) *InvestigationMemoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvestigationMemoryClient) DeleteOneID(id string) *InvestigationMemoryDeleteOne {
+	builder := c.Delete().Where(investigationmemory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvestigationMemoryDeleteOne{builder}
+}
+
+// Query returns a query builder for InvestigationMemory.
+func (c *InvestigationMemoryClient) Query() *InvestigationMemoryQuery {
+	return &InvestigationMemoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvestigationMemory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvestigationMemory entity by its id.
+func (c *InvestigationMemoryClient) Get(ctx context.Context, id string) (*InvestigationMemory, error) {
+	return c.Query().Where(investigationmemory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvestigationMemoryClient) GetX(ctx context.Context, id string) *InvestigationMemory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySourceSession queries the source_session edge of a InvestigationMemory.
+func (c *InvestigationMemoryClient) QuerySourceSession(_m *InvestigationMemory) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(investigationmemory.Table, investigationmemory.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, investigationmemory.SourceSessionTable, investigationmemory.SourceSessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInjectedIntoSessions queries the injected_into_sessions edge of a InvestigationMemory.
+func (c *InvestigationMemoryClient) QueryInjectedIntoSessions(_m *InvestigationMemory) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(investigationmemory.Table, investigationmemory.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, investigationmemory.InjectedIntoSessionsTable, investigationmemory.InjectedIntoSessionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvestigationMemoryClient) Hooks() []Hook {
+	hooks := c.hooks.InvestigationMemory
+	return append(hooks[:len(hooks):len(hooks)], investigationmemory.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvestigationMemoryClient) Interceptors() []Interceptor {
+	return c.inters.InvestigationMemory
+}
+
+func (c *InvestigationMemoryClient) mutate(ctx context.Context, m *InvestigationMemoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvestigationMemoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvestigationMemoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvestigationMemoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvestigationMemoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InvestigationMemory mutation op: %q", m.Op())
+	}
+}
+
+// JobLeaderClient is a client for the JobLeader schema.
+type JobLeaderClient struct {
+	config
+}
+
+// NewJobLeaderClient returns a client for the JobLeader from the given config.
+func NewJobLeaderClient(c config) *JobLeaderClient {
+	return &JobLeaderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jobleader.Hooks(f(g(h())))`.
+func (c *JobLeaderClient) Use(hooks ...Hook) {
+	c.hooks.JobLeader = append(c.hooks.JobLeader, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jobleader.Intercept(f(g(h())))`.
+func (c *JobLeaderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JobLeader = append(c.inters.JobLeader, interceptors...)
+}
+
+// Create returns a builder for creating a JobLeader entity.
+func (c *JobLeaderClient) Create() *JobLeaderCreate {
+	mutation := newJobLeaderMutation(c.config, OpCreate)
+	return &JobLeaderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JobLeader entities.
+func (c *JobLeaderClient) CreateBulk(builders ...*JobLeaderCreate) *JobLeaderCreateBulk {
+	return &JobLeaderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobLeaderClient) MapCreateBulk(slice any, setFunc func(*JobLeaderCreate, int)) *JobLeaderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobLeaderCreateBulk{err: fmt.Errorf("calling to JobLeaderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobLeaderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobLeaderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JobLeader.
+func (c *JobLeaderClient) Update() *JobLeaderUpdate {
+	mutation := newJobLeaderMutation(c.config, OpUpdate)
+	return &JobLeaderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobLeaderClient) UpdateOne(_m *JobLeader) *JobLeaderUpdateOne {
+	mutation := newJobLeaderMutation(c.config, OpUpdateOne, withJobLeader(_m))
+	return &JobLeaderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobLeaderClient) UpdateOneID(id string) *JobLeaderUpdateOne {
+	mutation := newJobLeaderMutation(c.config, OpUpdateOne, withJobLeaderID(id))
+	return &JobLeaderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JobLeader.
+func (c *JobLeaderClient) Delete() *JobLeaderDelete {
+	mutation := newJobLeaderMutation(c.config, OpDelete)
+	return &JobLeaderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobLeaderClient) DeleteOne(_m *JobLeader) *JobLeaderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobLeaderClient) DeleteOneID(id string) *JobLeaderDeleteOne {
+	builder := c.Delete().Where(jobleader.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobLeaderDeleteOne{builder}
+}
+
+// Query returns a query builder for JobLeader.
+func (c *JobLeaderClient) Query() *JobLeaderQuery {
+	return &JobLeaderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJobLeader},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JobLeader entity by its id.
+func (c *JobLeaderClient) Get(ctx context.Context, id string) (*JobLeader, error) {
+	return c.Query().Where(jobleader.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobLeaderClient) GetX(ctx context.Context, id string) *JobLeader {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JobLeaderClient) Hooks() []Hook {
+	return c.hooks.JobLeader
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobLeaderClient) Interceptors() []Interceptor {
+	return c.inters.JobLeader
+}
+
+func (c *JobLeaderClient) mutate(ctx context.Context, m *JobLeaderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobLeaderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobLeaderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobLeaderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobLeaderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown JobLeader mutation op: %q", m.Op())
+	}
+}
+
+// LLMInteractionClient is a client for the LLMInteraction schema.
 type LLMInteractionClient struct {
 	config
 }
 
-// NewLLMInteractionClient returns a client for the LLMInteraction from the given config.
-func NewLLMInteractionClient(c config) *LLMInteractionClient {
-	return &LLMInteractionClient{config: c}
+// NewLLMInteractionClient returns a client for the LLMInteraction from the given config.
+func NewLLMInteractionClient(c config) *LLMInteractionClient {
+	return &LLMInteractionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `llminteraction.Hooks(f(g(h())))`.
+func (c *LLMInteractionClient) Use(hooks ...Hook) {
+	c.hooks.LLMInteraction = append(c.hooks.LLMInteraction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `llminteraction.Intercept(f(g(h())))`.
+func (c *LLMInteractionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LLMInteraction = append(c.inters.LLMInteraction, interceptors...)
+}
+
+// Create returns a builder for creating a LLMInteraction entity.
+func (c *LLMInteractionClient) Create() *LLMInteractionCreate {
+	mutation := newLLMInteractionMutation(c.config, OpCreate)
+	return &LLMInteractionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LLMInteraction entities.
+func (c *LLMInteractionClient) CreateBulk(builders ...*LLMInteractionCreate) *LLMInteractionCreateBulk {
+	return &LLMInteractionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LLMInteractionClient) MapCreateBulk(slice any, setFunc func(*LLMInteractionCreate, int)) *LLMInteractionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LLMInteractionCreateBulk{err: fmt.Errorf("calling to LLMInteractionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LLMInteractionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LLMInteractionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LLMInteraction.
+func (c *LLMInteractionClient) Update() *LLMInteractionUpdate {
+	mutation := newLLMInteractionMutation(c.config, OpUpdate)
+	return &LLMInteractionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LLMInteractionClient) UpdateOne(_m *LLMInteraction) *LLMInteractionUpdateOne {
+	mutation := newLLMInteractionMutation(c.config, OpUpdateOne, withLLMInteraction(_m))
+	return &LLMInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LLMInteractionClient) UpdateOneID(id string) *LLMInteractionUpdateOne {
+	mutation := newLLMInteractionMutation(c.config, OpUpdateOne, withLLMInteractionID(id))
+	return &LLMInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LLMInteraction.
+func (c *LLMInteractionClient) Delete() *LLMInteractionDelete {
+	mutation := newLLMInteractionMutation(c.config, OpDelete)
+	return &LLMInteractionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LLMInteractionClient) DeleteOne(_m *LLMInteraction) *LLMInteractionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LLMInteractionClient) DeleteOneID(id string) *LLMInteractionDeleteOne {
+	builder := c.Delete().Where(llminteraction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LLMInteractionDeleteOne{builder}
+}
+
+// Query returns a query builder for LLMInteraction.
+func (c *LLMInteractionClient) Query() *LLMInteractionQuery {
+	return &LLMInteractionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLLMInteraction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LLMInteraction entity by its id.
+func (c *LLMInteractionClient) Get(ctx context.Context, id string) (*LLMInteraction, error) {
+	return c.Query().Where(llminteraction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LLMInteractionClient) GetX(ctx context.Context, id string) *LLMInteraction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a LLMInteraction.
+func (c *LLMInteractionClient) QuerySession(_m *LLMInteraction) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, llminteraction.SessionTable, llminteraction.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStage queries the stage edge of a LLMInteraction.
+func (c *LLMInteractionClient) QueryStage(_m *LLMInteraction) *StageQuery {
+	query := (&StageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
+			sqlgraph.To(stage.Table, stage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, llminteraction.StageTable, llminteraction.StageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAgentExecution queries the agent_execution edge of a LLMInteraction.
+func (c *LLMInteractionClient) QueryAgentExecution(_m *LLMInteraction) *AgentExecutionQuery {
+	query := (&AgentExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
+			sqlgraph.To(agentexecution.Table, agentexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, llminteraction.AgentExecutionTable, llminteraction.AgentExecutionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLastMessage queries the last_message edge of a LLMInteraction.
+func (c *LLMInteractionClient) QueryLastMessage(_m *LLMInteraction) *MessageQuery {
+	query := (&MessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
+			sqlgraph.To(message.Table, message.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, llminteraction.LastMessageTable, llminteraction.LastMessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTimelineEvents queries the timeline_events edge of a LLMInteraction.
+func (c *LLMInteractionClient) QueryTimelineEvents(_m *LLMInteraction) *TimelineEventQuery {
+	query := (&TimelineEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
+			sqlgraph.To(timelineevent.Table, timelineevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, llminteraction.TimelineEventsTable, llminteraction.TimelineEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LLMInteractionClient) Hooks() []Hook {
+	return c.hooks.LLMInteraction
+}
+
+// Interceptors returns the client interceptors.
+func (c *LLMInteractionClient) Interceptors() []Interceptor {
+	return c.inters.LLMInteraction
+}
+
+func (c *LLMInteractionClient) mutate(ctx context.Context, m *LLMInteractionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LLMInteractionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LLMInteractionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LLMInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LLMInteractionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LLMInteraction mutation op: %q", m.Op())
+	}
+}
+
+// MCPInteractionClient is a client for the MCPInteraction schema.
+type MCPInteractionClient struct {
+	config
+}
+
+// NewMCPInteractionClient returns a client for the MCPInteraction from the given config.
+func NewMCPInteractionClient(c config) *MCPInteractionClient {
+	return &MCPInteractionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mcpinteraction.Hooks(f(g(h())))`.
+func (c *MCPInteractionClient) Use(hooks ...Hook) {
+	c.hooks.MCPInteraction = append(c.hooks.MCPInteraction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mcpinteraction.Intercept(f(g(h())))`.
+func (c *MCPInteractionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MCPInteraction = append(c.inters.MCPInteraction, interceptors...)
+}
+
+// Create returns a builder for creating a MCPInteraction entity.
+func (c *MCPInteractionClient) Create() *MCPInteractionCreate {
+	mutation := newMCPInteractionMutation(c.config, OpCreate)
+	return &MCPInteractionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MCPInteraction entities.
+func (c *MCPInteractionClient) CreateBulk(builders ...*MCPInteractionCreate) *MCPInteractionCreateBulk {
+	return &MCPInteractionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MCPInteractionClient) MapCreateBulk(slice any, setFunc func(*MCPInteractionCreate, int)) *MCPInteractionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MCPInteractionCreateBulk{err: fmt.Errorf("calling to MCPInteractionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MCPInteractionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MCPInteractionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MCPInteraction.
+func (c *MCPInteractionClient) Update() *MCPInteractionUpdate {
+	mutation := newMCPInteractionMutation(c.config, OpUpdate)
+	return &MCPInteractionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MCPInteractionClient) UpdateOne(_m *MCPInteraction) *MCPInteractionUpdateOne {
+	mutation := newMCPInteractionMutation(c.config, OpUpdateOne, withMCPInteraction(_m))
+	return &MCPInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MCPInteractionClient) UpdateOneID(id string) *MCPInteractionUpdateOne {
+	mutation := newMCPInteractionMutation(c.config, OpUpdateOne, withMCPInteractionID(id))
+	return &MCPInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MCPInteraction.
+func (c *MCPInteractionClient) Delete() *MCPInteractionDelete {
+	mutation := newMCPInteractionMutation(c.config, OpDelete)
+	return &MCPInteractionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MCPInteractionClient) DeleteOne(_m *MCPInteraction) *MCPInteractionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MCPInteractionClient) DeleteOneID(id string) *MCPInteractionDeleteOne {
+	builder := c.Delete().Where(mcpinteraction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MCPInteractionDeleteOne{builder}
+}
+
+// Query returns a query builder for MCPInteraction.
+func (c *MCPInteractionClient) Query() *MCPInteractionQuery {
+	return &MCPInteractionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMCPInteraction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MCPInteraction entity by its id.
+func (c *MCPInteractionClient) Get(ctx context.Context, id string) (*MCPInteraction, error) {
+	return c.Query().Where(mcpinteraction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MCPInteractionClient) GetX(ctx context.Context, id string) *MCPInteraction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a MCPInteraction.
+func (c *MCPInteractionClient) QuerySession(_m *MCPInteraction) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mcpinteraction.Table, mcpinteraction.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mcpinteraction.SessionTable, mcpinteraction.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStage queries the stage edge of a MCPInteraction.
+func (c *MCPInteractionClient) QueryStage(_m *MCPInteraction) *StageQuery {
+	query := (&StageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mcpinteraction.Table, mcpinteraction.FieldID, id),
+			sqlgraph.To(stage.Table, stage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mcpinteraction.StageTable, mcpinteraction.StageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAgentExecution queries the agent_execution edge of a MCPInteraction.
+func (c *MCPInteractionClient) QueryAgentExecution(_m *MCPInteraction) *AgentExecutionQuery {
+	query := (&AgentExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mcpinteraction.Table, mcpinteraction.FieldID, id),
+			sqlgraph.To(agentexecution.Table, agentexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mcpinteraction.AgentExecutionTable, mcpinteraction.AgentExecutionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTimelineEvents queries the timeline_events edge of a MCPInteraction.
+func (c *MCPInteractionClient) QueryTimelineEvents(_m *MCPInteraction) *TimelineEventQuery {
+	query := (&TimelineEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mcpinteraction.Table, mcpinteraction.FieldID, id),
+			sqlgraph.To(timelineevent.Table, timelineevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, mcpinteraction.TimelineEventsTable, mcpinteraction.TimelineEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MCPInteractionClient) Hooks() []Hook {
+	return c.hooks.MCPInteraction
+}
+
+// Interceptors returns the client interceptors.
+func (c *MCPInteractionClient) Interceptors() []Interceptor {
+	return c.inters.MCPInteraction
+}
+
+func (c *MCPInteractionClient) mutate(ctx context.Context, m *MCPInteractionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MCPInteractionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MCPInteractionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MCPInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MCPInteractionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MCPInteraction mutation op: %q", m.Op())
+	}
+}
+
+// MessageClient is a client for the Message schema.
+type MessageClient struct {
+	config
+}
+
+// NewMessageClient returns a client for the Message from the given config.
+func NewMessageClient(c config) *MessageClient {
+	return &MessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
+func (c *MessageClient) Use(hooks ...Hook) {
+	c.hooks.Message = append(c.hooks.Message, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
+func (c *MessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Message = append(c.inters.Message, interceptors...)
+}
+
+// Create returns a builder for creating a Message entity.
+func (c *MessageClient) Create() *MessageCreate {
+	mutation := newMessageMutation(c.config, OpCreate)
+	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Message entities.
+func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Message.
+func (c *MessageClient) Update() *MessageUpdate {
+	mutation := newMessageMutation(c.config, OpUpdate)
+	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageClient) UpdateOneID(id string) *MessageUpdateOne {
+	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
+	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Message.
+func (c *MessageClient) Delete() *MessageDelete {
+	mutation := newMessageMutation(c.config, OpDelete)
+	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageClient) DeleteOneID(id string) *MessageDeleteOne {
+	builder := c.Delete().Where(message.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageDeleteOne{builder}
+}
+
+// Query returns a query builder for Message.
+func (c *MessageClient) Query() *MessageQuery {
+	return &MessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Message entity by its id.
+func (c *MessageClient) Get(ctx context.Context, id string) (*Message, error) {
+	return c.Query().Where(message.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageClient) GetX(ctx context.Context, id string) *Message {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a Message.
+func (c *MessageClient) QuerySession(_m *Message) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.SessionTable, message.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStage queries the stage edge of a Message.
+func (c *MessageClient) QueryStage(_m *Message) *StageQuery {
+	query := (&StageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(stage.Table, stage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.StageTable, message.StageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAgentExecution queries the agent_execution edge of a Message.
+func (c *MessageClient) QueryAgentExecution(_m *Message) *AgentExecutionQuery {
+	query := (&AgentExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(agentexecution.Table, agentexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, message.AgentExecutionTable, message.AgentExecutionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLlmInteractions queries the llm_interactions edge of a Message.
+func (c *MessageClient) QueryLlmInteractions(_m *Message) *LLMInteractionQuery {
+	query := (&LLMInteractionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.To(llminteraction.Table, llminteraction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, message.LlmInteractionsTable, message.LlmInteractionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MessageClient) Hooks() []Hook {
+	return c.hooks.Message
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageClient) Interceptors() []Interceptor {
+	return c.inters.Message
+}
+
+func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+	}
+}
+
+// PodHeartbeatClient is a client for the PodHeartbeat schema.
+type PodHeartbeatClient struct {
+	config
+}
+
+// NewPodHeartbeatClient returns a client for the PodHeartbeat from the given config.
+func NewPodHeartbeatClient(c config) *PodHeartbeatClient {
+	return &PodHeartbeatClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `podheartbeat.Hooks(f(g(h())))`.
+func (c *PodHeartbeatClient) Use(hooks ...Hook) {
+	c.hooks.PodHeartbeat = append(c.hooks.PodHeartbeat, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `podheartbeat.Intercept(f(g(h())))`.
+func (c *PodHeartbeatClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PodHeartbeat = append(c.inters.PodHeartbeat, interceptors...)
+}
+
+// Create returns a builder for creating a PodHeartbeat entity.
+func (c *PodHeartbeatClient) Create() *PodHeartbeatCreate {
+	mutation := newPodHeartbeatMutation(c.config, OpCreate)
+	return &PodHeartbeatCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PodHeartbeat entities.
+func (c *PodHeartbeatClient) CreateBulk(builders ...*PodHeartbeatCreate) *PodHeartbeatCreateBulk {
+	return &PodHeartbeatCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PodHeartbeatClient) MapCreateBulk(slice any, setFunc func(*PodHeartbeatCreate, int)) *PodHeartbeatCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PodHeartbeatCreateBulk{err: fmt.Errorf("calling to PodHeartbeatClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PodHeartbeatCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PodHeartbeatCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PodHeartbeat.
+func (c *PodHeartbeatClient) Update() *PodHeartbeatUpdate {
+	mutation := newPodHeartbeatMutation(c.config, OpUpdate)
+	return &PodHeartbeatUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PodHeartbeatClient) UpdateOne(_m *PodHeartbeat) *PodHeartbeatUpdateOne {
+	mutation := newPodHeartbeatMutation(c.config, OpUpdateOne, withPodHeartbeat(_m))
+	return &PodHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PodHeartbeatClient) UpdateOneID(id string) *PodHeartbeatUpdateOne {
+	mutation := newPodHeartbeatMutation(c.config, OpUpdateOne, withPodHeartbeatID(id))
+	return &PodHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PodHeartbeat.
+func (c *PodHeartbeatClient) Delete() *PodHeartbeatDelete {
+	mutation := newPodHeartbeatMutation(c.config, OpDelete)
+	return &PodHeartbeatDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PodHeartbeatClient) DeleteOne(_m *PodHeartbeat) *PodHeartbeatDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PodHeartbeatClient) DeleteOneID(id string) *PodHeartbeatDeleteOne {
+	builder := c.Delete().Where(podheartbeat.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PodHeartbeatDeleteOne{builder}
+}
+
+// Query returns a query builder for PodHeartbeat.
+func (c *PodHeartbeatClient) Query() *PodHeartbeatQuery {
+	return &PodHeartbeatQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePodHeartbeat},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PodHeartbeat entity by its id.
+func (c *PodHeartbeatClient) Get(ctx context.Context, id string) (*PodHeartbeat, error) {
+	return c.Query().Where(podheartbeat.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PodHeartbeatClient) GetX(ctx context.Context, id string) *PodHeartbeat {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PodHeartbeatClient) Hooks() []Hook {
+	return c.hooks.PodHeartbeat
+}
+
+// Interceptors returns the client interceptors.
+func (c *PodHeartbeatClient) Interceptors() []Interceptor {
+	return c.inters.PodHeartbeat
+}
+
+func (c *PodHeartbeatClient) mutate(ctx context.Context, m *PodHeartbeatMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PodHeartbeatCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PodHeartbeatUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PodHeartbeatUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PodHeartbeatDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PodHeartbeat mutation op: %q", m.Op())
+	}
+}
+
+// QueuePauseClient is a client for the QueuePause schema.
+type QueuePauseClient struct {
+	config
+}
+
+// NewQueuePauseClient returns a client for the QueuePause from the given config.
+func NewQueuePauseClient(c config) *QueuePauseClient {
+	return &QueuePauseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `queuepause.Hooks(f(g(h())))`.
+func (c *QueuePauseClient) Use(hooks ...Hook) {
+	c.hooks.QueuePause = append(c.hooks.QueuePause, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `queuepause.Intercept(f(g(h())))`.
+func (c *QueuePauseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QueuePause = append(c.inters.QueuePause, interceptors...)
+}
+
+// Create returns a builder for creating a QueuePause entity.
+func (c *QueuePauseClient) Create() *QueuePauseCreate {
+	mutation := newQueuePauseMutation(c.config, OpCreate)
+	return &QueuePauseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QueuePause entities.
+func (c *QueuePauseClient) CreateBulk(builders ...*QueuePauseCreate) *QueuePauseCreateBulk {
+	return &QueuePauseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QueuePauseClient) MapCreateBulk(slice any, setFunc func(*QueuePauseCreate, int)) *QueuePauseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QueuePauseCreateBulk{err: fmt.Errorf("calling to QueuePauseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QueuePauseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QueuePauseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QueuePause.
+func (c *QueuePauseClient) Update() *QueuePauseUpdate {
+	mutation := newQueuePauseMutation(c.config, OpUpdate)
+	return &QueuePauseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QueuePauseClient) UpdateOne(_m *QueuePause) *QueuePauseUpdateOne {
+	mutation := newQueuePauseMutation(c.config, OpUpdateOne, withQueuePause(_m))
+	return &QueuePauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QueuePauseClient) UpdateOneID(id string) *QueuePauseUpdateOne {
+	mutation := newQueuePauseMutation(c.config, OpUpdateOne, withQueuePauseID(id))
+	return &QueuePauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QueuePause.
+func (c *QueuePauseClient) Delete() *QueuePauseDelete {
+	mutation := newQueuePauseMutation(c.config, OpDelete)
+	return &QueuePauseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QueuePauseClient) DeleteOne(_m *QueuePause) *QueuePauseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QueuePauseClient) DeleteOneID(id string) *QueuePauseDeleteOne {
+	builder := c.Delete().Where(queuepause.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QueuePauseDeleteOne{builder}
+}
+
+// Query returns a query builder for QueuePause.
+func (c *QueuePauseClient) Query() *QueuePauseQuery {
+	return &QueuePauseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQueuePause},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QueuePause entity by its id.
+func (c *QueuePauseClient) Get(ctx context.Context, id string) (*QueuePause, error) {
+	return c.Query().Where(queuepause.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QueuePauseClient) GetX(ctx context.Context, id string) *QueuePause {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QueuePauseClient) Hooks() []Hook {
+	return c.hooks.QueuePause
+}
+
+// Interceptors returns the client interceptors.
+func (c *QueuePauseClient) Interceptors() []Interceptor {
+	return c.inters.QueuePause
+}
+
+func (c *QueuePauseClient) mutate(ctx context.Context, m *QueuePauseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QueuePauseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QueuePauseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QueuePauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QueuePauseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QueuePause mutation op: %q", m.Op())
+	}
+}
+
+// RedactionReviewClient is a client for the RedactionReview schema.
+type RedactionReviewClient struct {
+	config
+}
+
+// NewRedactionReviewClient returns a client for the RedactionReview from the given config.
+func NewRedactionReviewClient(c config) *RedactionReviewClient {
+	return &RedactionReviewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `redactionreview.Hooks(f(g(h())))`.
+func (c *RedactionReviewClient) Use(hooks ...Hook) {
+	c.hooks.RedactionReview = append(c.hooks.RedactionReview, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `redactionreview.Intercept(f(g(h())))`.
+func (c *RedactionReviewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RedactionReview = append(c.inters.RedactionReview, interceptors...)
+}
+
+// Create returns a builder for creating a RedactionReview entity.
+func (c *RedactionReviewClient) Create() *RedactionReviewCreate {
+	mutation := newRedactionReviewMutation(c.config, OpCreate)
+	return &RedactionReviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RedactionReview entities.
+func (c *RedactionReviewClient) CreateBulk(builders ...*RedactionReviewCreate) *RedactionReviewCreateBulk {
+	return &RedactionReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RedactionReviewClient) MapCreateBulk(slice any, setFunc func(*RedactionReviewCreate, int)) *RedactionReviewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RedactionReviewCreateBulk{err: fmt.Errorf("calling to RedactionReviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RedactionReviewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RedactionReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RedactionReview.
+func (c *RedactionReviewClient) Update() *RedactionReviewUpdate {
+	mutation := newRedactionReviewMutation(c.config, OpUpdate)
+	return &RedactionReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RedactionReviewClient) UpdateOne(_m *RedactionReview) *RedactionReviewUpdateOne {
+	mutation := newRedactionReviewMutation(c.config, OpUpdateOne, withRedactionReview(_m))
+	return &RedactionReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RedactionReviewClient) UpdateOneID(id string) *RedactionReviewUpdateOne {
+	mutation := newRedactionReviewMutation(c.config, OpUpdateOne, withRedactionReviewID(id))
+	return &RedactionReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RedactionReview.
+func (c *RedactionReviewClient) Delete() *RedactionReviewDelete {
+	mutation := newRedactionReviewMutation(c.config, OpDelete)
+	return &RedactionReviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RedactionReviewClient) DeleteOne(_m *RedactionReview) *RedactionReviewDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RedactionReviewClient) DeleteOneID(id string) *RedactionReviewDeleteOne {
+	builder := c.Delete().Where(redactionreview.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RedactionReviewDeleteOne{builder}
+}
+
+// Query returns a query builder for RedactionReview.
+func (c *RedactionReviewClient) Query() *RedactionReviewQuery {
+	return &RedactionReviewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRedactionReview},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RedactionReview entity by its id.
+func (c *RedactionReviewClient) Get(ctx context.Context, id string) (*RedactionReview, error) {
+	return c.Query().Where(redactionreview.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RedactionReviewClient) GetX(ctx context.Context, id string) *RedactionReview {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a RedactionReview.
+func (c *RedactionReviewClient) QuerySession(_m *RedactionReview) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(redactionreview.Table, redactionreview.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, redactionreview.SessionTable, redactionreview.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RedactionReviewClient) Hooks() []Hook {
+	return c.hooks.RedactionReview
+}
+
+// Interceptors returns the client interceptors.
+func (c *RedactionReviewClient) Interceptors() []Interceptor {
+	return c.inters.RedactionReview
+}
+
+func (c *RedactionReviewClient) mutate(ctx context.Context, m *RedactionReviewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RedactionReviewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RedactionReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RedactionReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RedactionReviewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RedactionReview mutation op: %q", m.Op())
+	}
+}
+
+// SavedViewClient is a client for the SavedView schema.
+type SavedViewClient struct {
+	config
+}
+
+// NewSavedViewClient returns a client for the SavedView from the given config.
+func NewSavedViewClient(c config) *SavedViewClient {
+	return &SavedViewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedview.Hooks(f(g(h())))`.
+func (c *SavedViewClient) Use(hooks ...Hook) {
+	c.hooks.SavedView = append(c.hooks.SavedView, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedview.Intercept(f(g(h())))`.
+func (c *SavedViewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedView = append(c.inters.SavedView, interceptors...)
+}
+
+// Create returns a builder for creating a SavedView entity.
+func (c *SavedViewClient) Create() *SavedViewCreate {
+	mutation := newSavedViewMutation(c.config, OpCreate)
+	return &SavedViewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedView entities.
+func (c *SavedViewClient) CreateBulk(builders ...*SavedViewCreate) *SavedViewCreateBulk {
+	return &SavedViewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedViewClient) MapCreateBulk(slice any, setFunc func(*SavedViewCreate, int)) *SavedViewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedViewCreateBulk{err: fmt.Errorf("calling to SavedViewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedViewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedViewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedView.
+func (c *SavedViewClient) Update() *SavedViewUpdate {
+	mutation := newSavedViewMutation(c.config, OpUpdate)
+	return &SavedViewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedViewClient) UpdateOne(_m *SavedView) *SavedViewUpdateOne {
+	mutation := newSavedViewMutation(c.config, OpUpdateOne, withSavedView(_m))
+	return &SavedViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedViewClient) UpdateOneID(id string) *SavedViewUpdateOne {
+	mutation := newSavedViewMutation(c.config, OpUpdateOne, withSavedViewID(id))
+	return &SavedViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedView.
+func (c *SavedViewClient) Delete() *SavedViewDelete {
+	mutation := newSavedViewMutation(c.config, OpDelete)
+	return &SavedViewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedViewClient) DeleteOne(_m *SavedView) *SavedViewDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedViewClient) DeleteOneID(id string) *SavedViewDeleteOne {
+	builder := c.Delete().Where(savedview.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedViewDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedView.
+func (c *SavedViewClient) Query() *SavedViewQuery {
+	return &SavedViewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedView},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedView entity by its id.
+func (c *SavedViewClient) Get(ctx context.Context, id string) (*SavedView, error) {
+	return c.Query().Where(savedview.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedViewClient) GetX(ctx context.Context, id string) *SavedView {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SavedViewClient) Hooks() []Hook {
+	hooks := c.hooks.SavedView
+	return append(hooks[:len(hooks):len(hooks)], savedview.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedViewClient) Interceptors() []Interceptor {
+	return c.inters.SavedView
+}
+
+func (c *SavedViewClient) mutate(ctx context.Context, m *SavedViewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedViewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedViewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedViewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedViewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedView mutation op: %q", m.Op())
+	}
+}
+
+// SchemaCompatibilityClient is a client for the SchemaCompatibility schema.
+type SchemaCompatibilityClient struct {
+	config
+}
+
+// NewSchemaCompatibilityClient returns a client for the SchemaCompatibility from the given config.
+func NewSchemaCompatibilityClient(c config) *SchemaCompatibilityClient {
+	return &SchemaCompatibilityClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `llminteraction.Hooks(f(g(h())))`.
-func (c *LLMInteractionClient) Use(hooks ...Hook) {
-	c.hooks.LLMInteraction = append(c.hooks.LLMInteraction, hooks...)
+// A call to `Use(f, g, h)` equals to `schemacompatibility.Hooks(f(g(h())))`.
+func (c *SchemaCompatibilityClient) Use(hooks ...Hook) {
+	c.hooks.SchemaCompatibility = append(c.hooks.SchemaCompatibility, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `llminteraction.Intercept(f(g(h())))`.
-func (c *LLMInteractionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LLMInteraction = append(c.inters.LLMInteraction, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `schemacompatibility.Intercept(f(g(h())))`.
+func (c *SchemaCompatibilityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SchemaCompatibility = append(c.inters.SchemaCompatibility, interceptors...)
 }
 
-// Create returns a builder for creating a LLMInteraction entity.
-func (c *LLMInteractionClient) Create() *LLMInteractionCreate {
-	mutation := newLLMInteractionMutation(c.config, OpCreate)
-	return &LLMInteractionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SchemaCompatibility entity.
+func (c *SchemaCompatibilityClient) Create() *SchemaCompatibilityCreate {
+	mutation := newSchemaCompatibilityMutation(c.config, OpCreate)
+	return &SchemaCompatibilityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LLMInteraction entities.
-func (c *LLMInteractionClient) CreateBulk(builders ...*LLMInteractionCreate) *LLMInteractionCreateBulk {
-	return &LLMInteractionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SchemaCompatibility entities.
+func (c *SchemaCompatibilityClient) CreateBulk(builders ...*SchemaCompatibilityCreate) *SchemaCompatibilityCreateBulk {
+	return &SchemaCompatibilityCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LLMInteractionClient) MapCreateBulk(slice any, setFunc func(*LLMInteractionCreate, int)) *LLMInteractionCreateBulk {
+func (c *SchemaCompatibilityClient) MapCreateBulk(slice any, setFunc func(*SchemaCompatibilityCreate, int)) *SchemaCompatibilityCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LLMInteractionCreateBulk{err: fmt.Errorf("calling to LLMInteractionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SchemaCompatibilityCreateBulk{err: fmt.Errorf("calling to SchemaCompatibilityClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LLMInteractionCreate, rv.Len())
+	builders := make([]*SchemaCompatibilityCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LLMInteractionCreateBulk{config: c.config, builders: builders}
+	return &SchemaCompatibilityCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LLMInteraction.
-func (c *LLMInteractionClient) Update() *LLMInteractionUpdate {
-	mutation := newLLMInteractionMutation(c.config, OpUpdate)
-	return &LLMInteractionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SchemaCompatibility.
+func (c *SchemaCompatibilityClient) Update() *SchemaCompatibilityUpdate {
+	mutation := newSchemaCompatibilityMutation(c.config, OpUpdate)
+	return &SchemaCompatibilityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LLMInteractionClient) UpdateOne(_m *LLMInteraction) *LLMInteractionUpdateOne {
-	mutation := newLLMInteractionMutation(c.config, OpUpdateOne, withLLMInteraction(_m))
-	return &LLMInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SchemaCompatibilityClient) UpdateOne(_m *SchemaCompatibility) *SchemaCompatibilityUpdateOne {
+	mutation := newSchemaCompatibilityMutation(c.config, OpUpdateOne, withSchemaCompatibility(_m))
+	return &SchemaCompatibilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LLMInteractionClient) UpdateOneID(id string) *LLMInteractionUpdateOne {
-	mutation := newLLMInteractionMutation(c.config, OpUpdateOne, withLLMInteractionID(id))
-	return &LLMInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SchemaCompatibilityClient) UpdateOneID(id int) *SchemaCompatibilityUpdateOne {
+	mutation := newSchemaCompatibilityMutation(c.config, OpUpdateOne, withSchemaCompatibilityID(id))
+	return &SchemaCompatibilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LLMInteraction.
-func (c *LLMInteractionClient) Delete() *LLMInteractionDelete {
-	mutation := newLLMInteractionMutation(c.config, OpDelete)
-	return &LLMInteractionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SchemaCompatibility.
+func (c *SchemaCompatibilityClient) Delete() *SchemaCompatibilityDelete {
+	mutation := newSchemaCompatibilityMutation(c.config, OpDelete)
+	return &SchemaCompatibilityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LLMInteractionClient) DeleteOne(_m *LLMInteraction) *LLMInteractionDeleteOne {
+func (c *SchemaCompatibilityClient) DeleteOne(_m *SchemaCompatibility) *SchemaCompatibilityDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LLMInteractionClient) DeleteOneID(id string) *LLMInteractionDeleteOne {
-	builder := c.Delete().Where(llminteraction.ID(id))
+func (c *SchemaCompatibilityClient) DeleteOneID(id int) *SchemaCompatibilityDeleteOne {
+	builder := c.Delete().Where(schemacompatibility.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LLMInteractionDeleteOne{builder}
+	return &SchemaCompatibilityDeleteOne{builder}
 }
 
-// Query returns a query builder for LLMInteraction.
-func (c *LLMInteractionClient) Query() *LLMInteractionQuery {
-	return &LLMInteractionQuery{
+// Query returns a query builder for SchemaCompatibility.
+func (c *SchemaCompatibilityClient) Query() *SchemaCompatibilityQuery {
+	return &SchemaCompatibilityQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLLMInteraction},
+		ctx:    &QueryContext{Type: TypeSchemaCompatibility},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LLMInteraction entity by its id.
-func (c *LLMInteractionClient) Get(ctx context.Context, id string) (*LLMInteraction, error) {
-	return c.Query().Where(llminteraction.ID(id)).Only(ctx)
+// Get returns a SchemaCompatibility entity by its id.
+func (c *SchemaCompatibilityClient) Get(ctx context.Context, id int) (*SchemaCompatibility, error) {
+	return c.Query().Where(schemacompatibility.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LLMInteractionClient) GetX(ctx context.Context, id string) *LLMInteraction {
+func (c *SchemaCompatibilityClient) GetX(ctx context.Context, id int) *SchemaCompatibility {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1675,79 +4049,148 @@ func (c *LLMInteractionClient) GetX(ctx context.Context, id string) *LLMInteract
 	return obj
 }
 
-// QuerySession queries the session edge of a LLMInteraction.
-func (c *LLMInteractionClient) QuerySession(_m *LLMInteraction) *AlertSessionQuery {
-	query := (&AlertSessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
-			sqlgraph.To(alertsession.Table, alertsession.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, llminteraction.SessionTable, llminteraction.SessionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *SchemaCompatibilityClient) Hooks() []Hook {
+	return c.hooks.SchemaCompatibility
+}
+
+// Interceptors returns the client interceptors.
+func (c *SchemaCompatibilityClient) Interceptors() []Interceptor {
+	return c.inters.SchemaCompatibility
+}
+
+func (c *SchemaCompatibilityClient) mutate(ctx context.Context, m *SchemaCompatibilityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SchemaCompatibilityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SchemaCompatibilityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SchemaCompatibilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SchemaCompatibilityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SchemaCompatibility mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryStage queries the stage edge of a LLMInteraction.
-func (c *LLMInteractionClient) QueryStage(_m *LLMInteraction) *StageQuery {
-	query := (&StageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
-			sqlgraph.To(stage.Table, stage.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, llminteraction.StageTable, llminteraction.StageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// SessionClaimClient is a client for the SessionClaim schema.
+type SessionClaimClient struct {
+	config
+}
+
+// NewSessionClaimClient returns a client for the SessionClaim from the given config.
+func NewSessionClaimClient(c config) *SessionClaimClient {
+	return &SessionClaimClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sessionclaim.Hooks(f(g(h())))`.
+func (c *SessionClaimClient) Use(hooks ...Hook) {
+	c.hooks.SessionClaim = append(c.hooks.SessionClaim, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sessionclaim.Intercept(f(g(h())))`.
+func (c *SessionClaimClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SessionClaim = append(c.inters.SessionClaim, interceptors...)
+}
+
+// Create returns a builder for creating a SessionClaim entity.
+func (c *SessionClaimClient) Create() *SessionClaimCreate {
+	mutation := newSessionClaimMutation(c.config, OpCreate)
+	return &SessionClaimCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SessionClaim entities.
+func (c *SessionClaimClient) CreateBulk(builders ...*SessionClaimCreate) *SessionClaimCreateBulk {
+	return &SessionClaimCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClaimClient) MapCreateBulk(slice any, setFunc func(*SessionClaimCreate, int)) *SessionClaimCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionClaimCreateBulk{err: fmt.Errorf("calling to SessionClaimClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*SessionClaimCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionClaimCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryAgentExecution queries the agent_execution edge of a LLMInteraction.
-func (c *LLMInteractionClient) QueryAgentExecution(_m *LLMInteraction) *AgentExecutionQuery {
-	query := (&AgentExecutionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
-			sqlgraph.To(agentexecution.Table, agentexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, llminteraction.AgentExecutionTable, llminteraction.AgentExecutionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for SessionClaim.
+func (c *SessionClaimClient) Update() *SessionClaimUpdate {
+	mutation := newSessionClaimMutation(c.config, OpUpdate)
+	return &SessionClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClaimClient) UpdateOne(_m *SessionClaim) *SessionClaimUpdateOne {
+	mutation := newSessionClaimMutation(c.config, OpUpdateOne, withSessionClaim(_m))
+	return &SessionClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClaimClient) UpdateOneID(id string) *SessionClaimUpdateOne {
+	mutation := newSessionClaimMutation(c.config, OpUpdateOne, withSessionClaimID(id))
+	return &SessionClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SessionClaim.
+func (c *SessionClaimClient) Delete() *SessionClaimDelete {
+	mutation := newSessionClaimMutation(c.config, OpDelete)
+	return &SessionClaimDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClaimClient) DeleteOne(_m *SessionClaim) *SessionClaimDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClaimClient) DeleteOneID(id string) *SessionClaimDeleteOne {
+	builder := c.Delete().Where(sessionclaim.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionClaimDeleteOne{builder}
+}
+
+// Query returns a query builder for SessionClaim.
+func (c *SessionClaimClient) Query() *SessionClaimQuery {
+	return &SessionClaimQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSessionClaim},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryLastMessage queries the last_message edge of a LLMInteraction.
-func (c *LLMInteractionClient) QueryLastMessage(_m *LLMInteraction) *MessageQuery {
-	query := (&MessageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
-			sqlgraph.To(message.Table, message.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, llminteraction.LastMessageTable, llminteraction.LastMessageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a SessionClaim entity by its id.
+func (c *SessionClaimClient) Get(ctx context.Context, id string) (*SessionClaim, error) {
+	return c.Query().Where(sessionclaim.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClaimClient) GetX(ctx context.Context, id string) *SessionClaim {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryTimelineEvents queries the timeline_events edge of a LLMInteraction.
-func (c *LLMInteractionClient) QueryTimelineEvents(_m *LLMInteraction) *TimelineEventQuery {
-	query := (&TimelineEventClient{config: c.config}).Query()
+// QuerySession queries the session edge of a SessionClaim.
+func (c *SessionClaimClient) QuerySession(_m *SessionClaim) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(llminteraction.Table, llminteraction.FieldID, id),
-			sqlgraph.To(timelineevent.Table, timelineevent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, llminteraction.TimelineEventsTable, llminteraction.TimelineEventsColumn),
+			sqlgraph.From(sessionclaim.Table, sessionclaim.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sessionclaim.SessionTable, sessionclaim.SessionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1756,131 +4199,131 @@ func (c *LLMInteractionClient) QueryTimelineEvents(_m *LLMInteraction) *Timeline
 }
 
 // Hooks returns the client hooks.
-func (c *LLMInteractionClient) Hooks() []Hook {
-	return c.hooks.LLMInteraction
+func (c *SessionClaimClient) Hooks() []Hook {
+	return c.hooks.SessionClaim
 }
 
 // Interceptors returns the client interceptors.
-func (c *LLMInteractionClient) Interceptors() []Interceptor {
-	return c.inters.LLMInteraction
+func (c *SessionClaimClient) Interceptors() []Interceptor {
+	return c.inters.SessionClaim
 }
 
-func (c *LLMInteractionClient) mutate(ctx context.Context, m *LLMInteractionMutation) (Value, error) {
+func (c *SessionClaimClient) mutate(ctx context.Context, m *SessionClaimMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LLMInteractionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionClaimCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LLMInteractionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionClaimUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LLMInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionClaimUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LLMInteractionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionClaimDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LLMInteraction mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SessionClaim mutation op: %q", m.Op())
 	}
 }
 
-// MCPInteractionClient is a client for the MCPInteraction schema.
-type MCPInteractionClient struct {
+// SessionComparisonClient is a client for the SessionComparison schema.
+type SessionComparisonClient struct {
 	config
 }
 
-// NewMCPInteractionClient returns a client for the MCPInteraction from the given config.
-func NewMCPInteractionClient(c config) *MCPInteractionClient {
-	return &MCPInteractionClient{config: c}
+// NewSessionComparisonClient returns a client for the SessionComparison from the given config.
+func NewSessionComparisonClient(c config) *SessionComparisonClient {
+	return &SessionComparisonClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `mcpinteraction.Hooks(f(g(h())))`.
-func (c *MCPInteractionClient) Use(hooks ...Hook) {
-	c.hooks.MCPInteraction = append(c.hooks.MCPInteraction, hooks...)
+// A call to `Use(f, g, h)` equals to `sessioncomparison.Hooks(f(g(h())))`.
+func (c *SessionComparisonClient) Use(hooks ...Hook) {
+	c.hooks.SessionComparison = append(c.hooks.SessionComparison, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `mcpinteraction.Intercept(f(g(h())))`.
-func (c *MCPInteractionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MCPInteraction = append(c.inters.MCPInteraction, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `sessioncomparison.Intercept(f(g(h())))`.
+func (c *SessionComparisonClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SessionComparison = append(c.inters.SessionComparison, interceptors...)
 }
 
-// Create returns a builder for creating a MCPInteraction entity.
-func (c *MCPInteractionClient) Create() *MCPInteractionCreate {
-	mutation := newMCPInteractionMutation(c.config, OpCreate)
-	return &MCPInteractionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SessionComparison entity.
+func (c *SessionComparisonClient) Create() *SessionComparisonCreate {
+	mutation := newSessionComparisonMutation(c.config, OpCreate)
+	return &SessionComparisonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MCPInteraction entities.
-func (c *MCPInteractionClient) CreateBulk(builders ...*MCPInteractionCreate) *MCPInteractionCreateBulk {
-	return &MCPInteractionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SessionComparison entities.
+func (c *SessionComparisonClient) CreateBulk(builders ...*SessionComparisonCreate) *SessionComparisonCreateBulk {
+	return &SessionComparisonCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MCPInteractionClient) MapCreateBulk(slice any, setFunc func(*MCPInteractionCreate, int)) *MCPInteractionCreateBulk {
+func (c *SessionComparisonClient) MapCreateBulk(slice any, setFunc func(*SessionComparisonCreate, int)) *SessionComparisonCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MCPInteractionCreateBulk{err: fmt.Errorf("calling to MCPInteractionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SessionComparisonCreateBulk{err: fmt.Errorf("calling to SessionComparisonClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MCPInteractionCreate, rv.Len())
+	builders := make([]*SessionComparisonCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MCPInteractionCreateBulk{config: c.config, builders: builders}
+	return &SessionComparisonCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MCPInteraction.
-func (c *MCPInteractionClient) Update() *MCPInteractionUpdate {
-	mutation := newMCPInteractionMutation(c.config, OpUpdate)
-	return &MCPInteractionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SessionComparison.
+func (c *SessionComparisonClient) Update() *SessionComparisonUpdate {
+	mutation := newSessionComparisonMutation(c.config, OpUpdate)
+	return &SessionComparisonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MCPInteractionClient) UpdateOne(_m *MCPInteraction) *MCPInteractionUpdateOne {
-	mutation := newMCPInteractionMutation(c.config, OpUpdateOne, withMCPInteraction(_m))
-	return &MCPInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionComparisonClient) UpdateOne(_m *SessionComparison) *SessionComparisonUpdateOne {
+	mutation := newSessionComparisonMutation(c.config, OpUpdateOne, withSessionComparison(_m))
+	return &SessionComparisonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MCPInteractionClient) UpdateOneID(id string) *MCPInteractionUpdateOne {
-	mutation := newMCPInteractionMutation(c.config, OpUpdateOne, withMCPInteractionID(id))
-	return &MCPInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionComparisonClient) UpdateOneID(id string) *SessionComparisonUpdateOne {
+	mutation := newSessionComparisonMutation(c.config, OpUpdateOne, withSessionComparisonID(id))
+	return &SessionComparisonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MCPInteraction.
-func (c *MCPInteractionClient) Delete() *MCPInteractionDelete {
-	mutation := newMCPInteractionMutation(c.config, OpDelete)
-	return &MCPInteractionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SessionComparison.
+func (c *SessionComparisonClient) Delete() *SessionComparisonDelete {
+	mutation := newSessionComparisonMutation(c.config, OpDelete)
+	return &SessionComparisonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MCPInteractionClient) DeleteOne(_m *MCPInteraction) *MCPInteractionDeleteOne {
+func (c *SessionComparisonClient) DeleteOne(_m *SessionComparison) *SessionComparisonDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MCPInteractionClient) DeleteOneID(id string) *MCPInteractionDeleteOne {
-	builder := c.Delete().Where(mcpinteraction.ID(id))
+func (c *SessionComparisonClient) DeleteOneID(id string) *SessionComparisonDeleteOne {
+	builder := c.Delete().Where(sessioncomparison.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MCPInteractionDeleteOne{builder}
+	return &SessionComparisonDeleteOne{builder}
 }
 
-// Query returns a query builder for MCPInteraction.
-func (c *MCPInteractionClient) Query() *MCPInteractionQuery {
-	return &MCPInteractionQuery{
+// Query returns a query builder for SessionComparison.
+func (c *SessionComparisonClient) Query() *SessionComparisonQuery {
+	return &SessionComparisonQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMCPInteraction},
+		ctx:    &QueryContext{Type: TypeSessionComparison},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MCPInteraction entity by its id.
-func (c *MCPInteractionClient) Get(ctx context.Context, id string) (*MCPInteraction, error) {
-	return c.Query().Where(mcpinteraction.ID(id)).Only(ctx)
+// Get returns a SessionComparison entity by its id.
+func (c *SessionComparisonClient) Get(ctx context.Context, id string) (*SessionComparison, error) {
+	return c.Query().Where(sessioncomparison.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MCPInteractionClient) GetX(ctx context.Context, id string) *MCPInteraction {
+func (c *SessionComparisonClient) GetX(ctx context.Context, id string) *SessionComparison {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1888,47 +4331,15 @@ func (c *MCPInteractionClient) GetX(ctx context.Context, id string) *MCPInteract
 	return obj
 }
 
-// QuerySession queries the session edge of a MCPInteraction.
-func (c *MCPInteractionClient) QuerySession(_m *MCPInteraction) *AlertSessionQuery {
+// QuerySession queries the session edge of a SessionComparison.
+func (c *SessionComparisonClient) QuerySession(_m *SessionComparison) *AlertSessionQuery {
 	query := (&AlertSessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(mcpinteraction.Table, mcpinteraction.FieldID, id),
+			sqlgraph.From(sessioncomparison.Table, sessioncomparison.FieldID, id),
 			sqlgraph.To(alertsession.Table, alertsession.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, mcpinteraction.SessionTable, mcpinteraction.SessionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStage queries the stage edge of a MCPInteraction.
-func (c *MCPInteractionClient) QueryStage(_m *MCPInteraction) *StageQuery {
-	query := (&StageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(mcpinteraction.Table, mcpinteraction.FieldID, id),
-			sqlgraph.To(stage.Table, stage.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, mcpinteraction.StageTable, mcpinteraction.StageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAgentExecution queries the agent_execution edge of a MCPInteraction.
-func (c *MCPInteractionClient) QueryAgentExecution(_m *MCPInteraction) *AgentExecutionQuery {
-	query := (&AgentExecutionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(mcpinteraction.Table, mcpinteraction.FieldID, id),
-			sqlgraph.To(agentexecution.Table, agentexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, mcpinteraction.AgentExecutionTable, mcpinteraction.AgentExecutionColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, sessioncomparison.SessionTable, sessioncomparison.SessionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1936,15 +4347,15 @@ func (c *MCPInteractionClient) QueryAgentExecution(_m *MCPInteraction) *AgentExe
 	return query
 }
 
-// QueryTimelineEvents queries the timeline_events edge of a MCPInteraction.
-func (c *MCPInteractionClient) QueryTimelineEvents(_m *MCPInteraction) *TimelineEventQuery {
-	query := (&TimelineEventClient{config: c.config}).Query()
+// QueryPreviousSession queries the previous_session edge of a SessionComparison.
+func (c *SessionComparisonClient) QueryPreviousSession(_m *SessionComparison) *AlertSessionQuery {
+	query := (&AlertSessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(mcpinteraction.Table, mcpinteraction.FieldID, id),
-			sqlgraph.To(timelineevent.Table, timelineevent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, mcpinteraction.TimelineEventsTable, mcpinteraction.TimelineEventsColumn),
+			sqlgraph.From(sessioncomparison.Table, sessioncomparison.FieldID, id),
+			sqlgraph.To(alertsession.Table, alertsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sessioncomparison.PreviousSessionTable, sessioncomparison.PreviousSessionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1953,131 +4364,131 @@ func (c *MCPInteractionClient) QueryTimelineEvents(_m *MCPInteraction) *Timeline
 }
 
 // Hooks returns the client hooks.
-func (c *MCPInteractionClient) Hooks() []Hook {
-	return c.hooks.MCPInteraction
+func (c *SessionComparisonClient) Hooks() []Hook {
+	return c.hooks.SessionComparison
 }
 
 // Interceptors returns the client interceptors.
-func (c *MCPInteractionClient) Interceptors() []Interceptor {
-	return c.inters.MCPInteraction
+func (c *SessionComparisonClient) Interceptors() []Interceptor {
+	return c.inters.SessionComparison
 }
 
-func (c *MCPInteractionClient) mutate(ctx context.Context, m *MCPInteractionMutation) (Value, error) {
+func (c *SessionComparisonClient) mutate(ctx context.Context, m *SessionComparisonMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MCPInteractionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionComparisonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MCPInteractionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionComparisonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MCPInteractionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionComparisonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MCPInteractionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionComparisonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MCPInteraction mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SessionComparison mutation op: %q", m.Op())
 	}
 }
 
-// MessageClient is a client for the Message schema.
-type MessageClient struct {
+// SessionGroupClient is a client for the SessionGroup schema.
+type SessionGroupClient struct {
 	config
 }
 
-// NewMessageClient returns a client for the Message from the given config.
-func NewMessageClient(c config) *MessageClient {
-	return &MessageClient{config: c}
+// NewSessionGroupClient returns a client for the SessionGroup from the given config.
+func NewSessionGroupClient(c config) *SessionGroupClient {
+	return &SessionGroupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `message.Hooks(f(g(h())))`.
-func (c *MessageClient) Use(hooks ...Hook) {
-	c.hooks.Message = append(c.hooks.Message, hooks...)
+// A call to `Use(f, g, h)` equals to `sessiongroup.Hooks(f(g(h())))`.
+func (c *SessionGroupClient) Use(hooks ...Hook) {
+	c.hooks.SessionGroup = append(c.hooks.SessionGroup, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `message.Intercept(f(g(h())))`.
-func (c *MessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Message = append(c.inters.Message, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `sessiongroup.Intercept(f(g(h())))`.
+func (c *SessionGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SessionGroup = append(c.inters.SessionGroup, interceptors...)
 }
 
-// Create returns a builder for creating a Message entity.
-func (c *MessageClient) Create() *MessageCreate {
-	mutation := newMessageMutation(c.config, OpCreate)
-	return &MessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SessionGroup entity.
+func (c *SessionGroupClient) Create() *SessionGroupCreate {
+	mutation := newSessionGroupMutation(c.config, OpCreate)
+	return &SessionGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Message entities.
-func (c *MessageClient) CreateBulk(builders ...*MessageCreate) *MessageCreateBulk {
-	return &MessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SessionGroup entities.
+func (c *SessionGroupClient) CreateBulk(builders ...*SessionGroupCreate) *SessionGroupCreateBulk {
+	return &SessionGroupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MessageClient) MapCreateBulk(slice any, setFunc func(*MessageCreate, int)) *MessageCreateBulk {
+func (c *SessionGroupClient) MapCreateBulk(slice any, setFunc func(*SessionGroupCreate, int)) *SessionGroupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MessageCreateBulk{err: fmt.Errorf("calling to MessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SessionGroupCreateBulk{err: fmt.Errorf("calling to SessionGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MessageCreate, rv.Len())
+	builders := make([]*SessionGroupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MessageCreateBulk{config: c.config, builders: builders}
+	return &SessionGroupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Message.
-func (c *MessageClient) Update() *MessageUpdate {
-	mutation := newMessageMutation(c.config, OpUpdate)
-	return &MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SessionGroup.
+func (c *SessionGroupClient) Update() *SessionGroupUpdate {
+	mutation := newSessionGroupMutation(c.config, OpUpdate)
+	return &SessionGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MessageClient) UpdateOne(_m *Message) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessage(_m))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionGroupClient) UpdateOne(_m *SessionGroup) *SessionGroupUpdateOne {
+	mutation := newSessionGroupMutation(c.config, OpUpdateOne, withSessionGroup(_m))
+	return &SessionGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MessageClient) UpdateOneID(id string) *MessageUpdateOne {
-	mutation := newMessageMutation(c.config, OpUpdateOne, withMessageID(id))
-	return &MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SessionGroupClient) UpdateOneID(id string) *SessionGroupUpdateOne {
+	mutation := newSessionGroupMutation(c.config, OpUpdateOne, withSessionGroupID(id))
+	return &SessionGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Message.
-func (c *MessageClient) Delete() *MessageDelete {
-	mutation := newMessageMutation(c.config, OpDelete)
-	return &MessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SessionGroup.
+func (c *SessionGroupClient) Delete() *SessionGroupDelete {
+	mutation := newSessionGroupMutation(c.config, OpDelete)
+	return &SessionGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MessageClient) DeleteOne(_m *Message) *MessageDeleteOne {
+func (c *SessionGroupClient) DeleteOne(_m *SessionGroup) *SessionGroupDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MessageClient) DeleteOneID(id string) *MessageDeleteOne {
-	builder := c.Delete().Where(message.ID(id))
+func (c *SessionGroupClient) DeleteOneID(id string) *SessionGroupDeleteOne {
+	builder := c.Delete().Where(sessiongroup.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MessageDeleteOne{builder}
+	return &SessionGroupDeleteOne{builder}
 }
 
-// Query returns a query builder for Message.
-func (c *MessageClient) Query() *MessageQuery {
-	return &MessageQuery{
+// Query returns a query builder for SessionGroup.
+func (c *SessionGroupClient) Query() *SessionGroupQuery {
+	return &SessionGroupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMessage},
+		ctx:    &QueryContext{Type: TypeSessionGroup},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Message entity by its id.
-func (c *MessageClient) Get(ctx context.Context, id string) (*Message, error) {
-	return c.Query().Where(message.ID(id)).Only(ctx)
+// Get returns a SessionGroup entity by its id.
+func (c *SessionGroupClient) Get(ctx context.Context, id string) (*SessionGroup, error) {
+	return c.Query().Where(sessiongroup.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MessageClient) GetX(ctx context.Context, id string) *Message {
+func (c *SessionGroupClient) GetX(ctx context.Context, id string) *SessionGroup {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2085,63 +4496,15 @@ func (c *MessageClient) GetX(ctx context.Context, id string) *Message {
 	return obj
 }
 
-// QuerySession queries the session edge of a Message.
-func (c *MessageClient) QuerySession(_m *Message) *AlertSessionQuery {
+// QuerySessions queries the sessions edge of a SessionGroup.
+func (c *SessionGroupClient) QuerySessions(_m *SessionGroup) *AlertSessionQuery {
 	query := (&AlertSessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
+			sqlgraph.From(sessiongroup.Table, sessiongroup.FieldID, id),
 			sqlgraph.To(alertsession.Table, alertsession.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, message.SessionTable, message.SessionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStage queries the stage edge of a Message.
-func (c *MessageClient) QueryStage(_m *Message) *StageQuery {
-	query := (&StageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(stage.Table, stage.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, message.StageTable, message.StageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAgentExecution queries the agent_execution edge of a Message.
-func (c *MessageClient) QueryAgentExecution(_m *Message) *AgentExecutionQuery {
-	query := (&AgentExecutionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(agentexecution.Table, agentexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, message.AgentExecutionTable, message.AgentExecutionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLlmInteractions queries the llm_interactions edge of a Message.
-func (c *MessageClient) QueryLlmInteractions(_m *Message) *LLMInteractionQuery {
-	query := (&LLMInteractionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(message.Table, message.FieldID, id),
-			sqlgraph.To(llminteraction.Table, llminteraction.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, message.LlmInteractionsTable, message.LlmInteractionsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, sessiongroup.SessionsTable, sessiongroup.SessionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2150,27 +4513,27 @@ func (c *MessageClient) QueryLlmInteractions(_m *Message) *LLMInteractionQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *MessageClient) Hooks() []Hook {
-	return c.hooks.Message
+func (c *SessionGroupClient) Hooks() []Hook {
+	return c.hooks.SessionGroup
 }
 
 // Interceptors returns the client interceptors.
-func (c *MessageClient) Interceptors() []Interceptor {
-	return c.inters.Message
+func (c *SessionGroupClient) Interceptors() []Interceptor {
+	return c.inters.SessionGroup
 }
 
-func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value, error) {
+func (c *SessionGroupClient) mutate(ctx context.Context, m *SessionGroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SessionGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SessionGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Message mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SessionGroup mutation op: %q", m.Op())
 	}
 }
 
@@ -3026,16 +5389,181 @@ func (c *TimelineEventClient) mutate(ctx context.Context, m *TimelineEventMutati
 	}
 }
 
+// UserProfileClient is a client for the UserProfile schema.
+type UserProfileClient struct {
+	config
+}
+
+// NewUserProfileClient returns a client for the UserProfile from the given config.
+func NewUserProfileClient(c config) *UserProfileClient {
+	return &UserProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userprofile.Hooks(f(g(h())))`.
+func (c *UserProfileClient) Use(hooks ...Hook) {
+	c.hooks.UserProfile = append(c.hooks.UserProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userprofile.Intercept(f(g(h())))`.
+func (c *UserProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserProfile = append(c.inters.UserProfile, interceptors...)
+}
+
+// Create returns a builder for creating a UserProfile entity.
+func (c *UserProfileClient) Create() *UserProfileCreate {
+	mutation := newUserProfileMutation(c.config, OpCreate)
+	return &UserProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserProfile entities.
+func (c *UserProfileClient) CreateBulk(builders ...*UserProfileCreate) *UserProfileCreateBulk {
+	return &UserProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserProfileClient) MapCreateBulk(slice any, setFunc func(*UserProfileCreate, int)) *UserProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserProfileCreateBulk{err: fmt.Errorf("calling to UserProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserProfile.
+func (c *UserProfileClient) Update() *UserProfileUpdate {
+	mutation := newUserProfileMutation(c.config, OpUpdate)
+	return &UserProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserProfileClient) UpdateOne(_m *UserProfile) *UserProfileUpdateOne {
+	mutation := newUserProfileMutation(c.config, OpUpdateOne, withUserProfile(_m))
+	return &UserProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserProfileClient) UpdateOneID(id string) *UserProfileUpdateOne {
+	mutation := newUserProfileMutation(c.config, OpUpdateOne, withUserProfileID(id))
+	return &UserProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserProfile.
+func (c *UserProfileClient) Delete() *UserProfileDelete {
+	mutation := newUserProfileMutation(c.config, OpDelete)
+	return &UserProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserProfileClient) DeleteOne(_m *UserProfile) *UserProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserProfileClient) DeleteOneID(id string) *UserProfileDeleteOne {
+	builder := c.Delete().Where(userprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for UserProfile.
+func (c *UserProfileClient) Query() *UserProfileQuery {
+	return &UserProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserProfile entity by its id.
+func (c *UserProfileClient) Get(ctx context.Context, id string) (*UserProfile, error) {
+	return c.Query().Where(userprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserProfileClient) GetX(ctx context.Context, id string) *UserProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserProfileClient) Hooks() []Hook {
+	return c.hooks.UserProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserProfileClient) Interceptors() []Interceptor {
+	return c.inters.UserProfile
+}
+
+func (c *UserProfileClient) mutate(ctx context.Context, m *UserProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserProfile mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AgentExecution, AlertSession, Chat, ChatUserMessage, Event, InvestigationMemory,
-		LLMInteraction, MCPInteraction, Message, SessionReviewActivity, SessionScore,
-		Stage, TimelineEvent []ent.Hook
+		ActionItem, ActivityReport, AgentExecution, AlertSession, Blob, Chat,
+		ChatUserMessage, ConfigRegistration, Event, FeatureFlagOverride,
+		HandoffNoteRevision, InvestigationMemory, JobLeader, LLMInteraction,
+		MCPInteraction, Message, PodHeartbeat, QueuePause, RedactionReview, SavedView,
+		SchemaCompatibility, SessionClaim, SessionComparison, SessionGroup,
+		SessionReviewActivity, SessionScore, Stage, TimelineEvent,
+		UserProfile []ent.Hook
 	}
 	inters struct {
-		AgentExecution, AlertSession, Chat, ChatUserMessage, Event, InvestigationMemory,
-		LLMInteraction, MCPInteraction, Message, SessionReviewActivity, SessionScore,
-		Stage, TimelineEvent []ent.Interceptor
+		ActionItem, ActivityReport, AgentExecution, AlertSession, Blob, Chat,
+		ChatUserMessage, ConfigRegistration, Event, FeatureFlagOverride,
+		HandoffNoteRevision, InvestigationMemory, JobLeader, LLMInteraction,
+		MCPInteraction, Message, PodHeartbeat, QueuePause, RedactionReview, SavedView,
+		SchemaCompatibility, SessionClaim, SessionComparison, SessionGroup,
+		SessionReviewActivity, SessionScore, Stage, TimelineEvent,
+		UserProfile []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package configregistration
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldVersion, v))
+}
+
+// Definition applies equality check predicate on the "definition" field. It's identical to DefinitionEQ.
+func Definition(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldDefinition, v))
+}
+
+// Deleted applies equality check predicate on the "deleted" field. It's identical to DeletedEQ.
+func Deleted(v bool) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldDeleted, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLTE(FieldVersion, v))
+}
+
+// DefinitionEQ applies the EQ predicate on the "definition" field.
+func DefinitionEQ(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldDefinition, v))
+}
+
+// DefinitionNEQ applies the NEQ predicate on the "definition" field.
+func DefinitionNEQ(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldDefinition, v))
+}
+
+// DefinitionIn applies the In predicate on the "definition" field.
+func DefinitionIn(vs ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIn(FieldDefinition, vs...))
+}
+
+// DefinitionNotIn applies the NotIn predicate on the "definition" field.
+func DefinitionNotIn(vs ...string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotIn(FieldDefinition, vs...))
+}
+
+// DefinitionGT applies the GT predicate on the "definition" field.
+func DefinitionGT(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGT(FieldDefinition, v))
+}
+
+// DefinitionGTE applies the GTE predicate on the "definition" field.
+func DefinitionGTE(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldGTE(FieldDefinition, v))
+}
+
+// DefinitionLT applies the LT predicate on the "definition" field.
+func DefinitionLT(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLT(FieldDefinition, v))
+}
+
+// DefinitionLTE applies the LTE predicate on the "definition" field.
+func DefinitionLTE(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldLTE(FieldDefinition, v))
+}
+
+// DefinitionContains applies the Contains predicate on the "definition" field.
+func DefinitionContains(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContains(FieldDefinition, v))
+}
+
+// DefinitionHasPrefix applies the HasPrefix predicate on the "definition" field.
+func DefinitionHasPrefix(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldHasPrefix(FieldDefinition, v))
+}
+
+// DefinitionHasSuffix applies the HasSuffix predicate on the "definition" field.
+func DefinitionHasSuffix(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldHasSuffix(FieldDefinition, v))
+}
+
+// DefinitionIsNil applies the IsNil predicate on the "definition" field.
+func DefinitionIsNil() predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldIsNull(FieldDefinition))
+}
+
+// DefinitionNotNil applies the NotNil predicate on the "definition" field.
+func DefinitionNotNil() predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNotNull(FieldDefinition))
+}
+
+// DefinitionEqualFold applies the EqualFold predicate on the "definition" field.
+func DefinitionEqualFold(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEqualFold(FieldDefinition, v))
+}
+
+// DefinitionContainsFold applies the ContainsFold predicate on the "definition" field.
+func DefinitionContainsFold(v string) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldContainsFold(FieldDefinition, v))
+}
+
+// DeletedEQ applies the EQ predicate on the "deleted" field.
+func DeletedEQ(v bool) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldEQ(FieldDeleted, v))
+}
+
+// DeletedNEQ applies the NEQ predicate on the "deleted" field.
+func DeletedNEQ(v bool) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.FieldNEQ(FieldDeleted, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConfigRegistration) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConfigRegistration) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConfigRegistration) predicate.ConfigRegistration {
+	return predicate.ConfigRegistration(sql.NotPredicates(p))
+}
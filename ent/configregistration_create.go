@@ -0,0 +1,352 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
+)
+
+// ConfigRegistrationCreate is the builder for creating a ConfigRegistration entity.
+type ConfigRegistrationCreate struct {
+	config
+	mutation *ConfigRegistrationMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConfigRegistrationCreate) SetCreatedAt(v time.Time) *ConfigRegistrationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConfigRegistrationCreate) SetNillableCreatedAt(v *time.Time) *ConfigRegistrationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ConfigRegistrationCreate) SetCreatedBy(v string) *ConfigRegistrationCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ConfigRegistrationCreate) SetNillableCreatedBy(v *string) *ConfigRegistrationCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *ConfigRegistrationCreate) SetUpdatedBy(v string) *ConfigRegistrationCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *ConfigRegistrationCreate) SetNillableUpdatedBy(v *string) *ConfigRegistrationCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ConfigRegistrationCreate) SetKind(v configregistration.Kind) *ConfigRegistrationCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ConfigRegistrationCreate) SetName(v string) *ConfigRegistrationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *ConfigRegistrationCreate) SetVersion(v int) *ConfigRegistrationCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetDefinition sets the "definition" field.
+func (_c *ConfigRegistrationCreate) SetDefinition(v string) *ConfigRegistrationCreate {
+	_c.mutation.SetDefinition(v)
+	return _c
+}
+
+// SetNillableDefinition sets the "definition" field if the given value is not nil.
+func (_c *ConfigRegistrationCreate) SetNillableDefinition(v *string) *ConfigRegistrationCreate {
+	if v != nil {
+		_c.SetDefinition(*v)
+	}
+	return _c
+}
+
+// SetDeleted sets the "deleted" field.
+func (_c *ConfigRegistrationCreate) SetDeleted(v bool) *ConfigRegistrationCreate {
+	_c.mutation.SetDeleted(v)
+	return _c
+}
+
+// SetNillableDeleted sets the "deleted" field if the given value is not nil.
+func (_c *ConfigRegistrationCreate) SetNillableDeleted(v *bool) *ConfigRegistrationCreate {
+	if v != nil {
+		_c.SetDeleted(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConfigRegistrationCreate) SetID(v string) *ConfigRegistrationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ConfigRegistrationMutation object of the builder.
+func (_c *ConfigRegistrationCreate) Mutation() *ConfigRegistrationMutation {
+	return _c.mutation
+}
+
+// Save creates the ConfigRegistration in the database.
+func (_c *ConfigRegistrationCreate) Save(ctx context.Context) (*ConfigRegistration, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConfigRegistrationCreate) SaveX(ctx context.Context) *ConfigRegistration {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigRegistrationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigRegistrationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConfigRegistrationCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if configregistration.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized configregistration.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := configregistration.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Deleted(); !ok {
+		v := configregistration.DefaultDeleted
+		_c.mutation.SetDeleted(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConfigRegistrationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConfigRegistration.created_at"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ConfigRegistration.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := configregistration.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ConfigRegistration.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ConfigRegistration.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := configregistration.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ConfigRegistration.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ConfigRegistration.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := configregistration.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ConfigRegistration.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Deleted(); !ok {
+		return &ValidationError{Name: "deleted", err: errors.New(`ent: missing required field "ConfigRegistration.deleted"`)}
+	}
+	return nil
+}
+
+func (_c *ConfigRegistrationCreate) sqlSave(ctx context.Context) (*ConfigRegistration, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ConfigRegistration.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConfigRegistrationCreate) createSpec() (*ConfigRegistration, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConfigRegistration{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(configregistration.Table, sqlgraph.NewFieldSpec(configregistration.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(configregistration.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(configregistration.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(configregistration.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(configregistration.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(configregistration.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(configregistration.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Definition(); ok {
+		_spec.SetField(configregistration.FieldDefinition, field.TypeString, value)
+		_node.Definition = value
+	}
+	if value, ok := _c.mutation.Deleted(); ok {
+		_spec.SetField(configregistration.FieldDeleted, field.TypeBool, value)
+		_node.Deleted = value
+	}
+	return _node, _spec
+}
+
+// ConfigRegistrationCreateBulk is the builder for creating many ConfigRegistration entities in bulk.
+type ConfigRegistrationCreateBulk struct {
+	config
+	err      error
+	builders []*ConfigRegistrationCreate
+}
+
+// Save creates the ConfigRegistration entities in the database.
+func (_c *ConfigRegistrationCreateBulk) Save(ctx context.Context) ([]*ConfigRegistration, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConfigRegistration, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConfigRegistrationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConfigRegistrationCreateBulk) SaveX(ctx context.Context) []*ConfigRegistration {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConfigRegistrationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConfigRegistrationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
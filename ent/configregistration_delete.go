@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ConfigRegistrationDelete is the builder for deleting a ConfigRegistration entity.
+type ConfigRegistrationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConfigRegistrationMutation
+}
+
+// Where appends a list predicates to the ConfigRegistrationDelete builder.
+func (_d *ConfigRegistrationDelete) Where(ps ...predicate.ConfigRegistration) *ConfigRegistrationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ConfigRegistrationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConfigRegistrationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ConfigRegistrationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(configregistration.Table, sqlgraph.NewFieldSpec(configregistration.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ConfigRegistrationDeleteOne is the builder for deleting a single ConfigRegistration entity.
+type ConfigRegistrationDeleteOne struct {
+	_d *ConfigRegistrationDelete
+}
+
+// Where appends a list predicates to the ConfigRegistrationDelete builder.
+func (_d *ConfigRegistrationDeleteOne) Where(ps ...predicate.ConfigRegistration) *ConfigRegistrationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ConfigRegistrationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{configregistration.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConfigRegistrationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ConfigRegistrationUpdate is the builder for updating ConfigRegistration entities.
+type ConfigRegistrationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ConfigRegistrationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ConfigRegistrationUpdate builder.
+func (_u *ConfigRegistrationUpdate) Where(ps ...predicate.ConfigRegistration) *ConfigRegistrationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ConfigRegistrationUpdate) SetCreatedBy(v string) *ConfigRegistrationUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ConfigRegistrationUpdate) SetNillableCreatedBy(v *string) *ConfigRegistrationUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ConfigRegistrationUpdate) ClearCreatedBy() *ConfigRegistrationUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ConfigRegistrationUpdate) SetUpdatedBy(v string) *ConfigRegistrationUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ConfigRegistrationUpdate) SetNillableUpdatedBy(v *string) *ConfigRegistrationUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ConfigRegistrationUpdate) ClearUpdatedBy() *ConfigRegistrationUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// Mutation returns the ConfigRegistrationMutation object of the builder.
+func (_u *ConfigRegistrationUpdate) Mutation() *ConfigRegistrationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConfigRegistrationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigRegistrationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConfigRegistrationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigRegistrationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConfigRegistrationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConfigRegistrationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ConfigRegistrationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(configregistration.Table, configregistration.Columns, sqlgraph.NewFieldSpec(configregistration.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(configregistration.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(configregistration.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(configregistration.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(configregistration.FieldUpdatedBy, field.TypeString)
+	}
+	if _u.mutation.DefinitionCleared() {
+		_spec.ClearField(configregistration.FieldDefinition, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configregistration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConfigRegistrationUpdateOne is the builder for updating a single ConfigRegistration entity.
+type ConfigRegistrationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ConfigRegistrationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ConfigRegistrationUpdateOne) SetCreatedBy(v string) *ConfigRegistrationUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ConfigRegistrationUpdateOne) SetNillableCreatedBy(v *string) *ConfigRegistrationUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ConfigRegistrationUpdateOne) ClearCreatedBy() *ConfigRegistrationUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ConfigRegistrationUpdateOne) SetUpdatedBy(v string) *ConfigRegistrationUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ConfigRegistrationUpdateOne) SetNillableUpdatedBy(v *string) *ConfigRegistrationUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ConfigRegistrationUpdateOne) ClearUpdatedBy() *ConfigRegistrationUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// Mutation returns the ConfigRegistrationMutation object of the builder.
+func (_u *ConfigRegistrationUpdateOne) Mutation() *ConfigRegistrationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConfigRegistrationUpdate builder.
+func (_u *ConfigRegistrationUpdateOne) Where(ps ...predicate.ConfigRegistration) *ConfigRegistrationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConfigRegistrationUpdateOne) Select(field string, fields ...string) *ConfigRegistrationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConfigRegistration entity.
+func (_u *ConfigRegistrationUpdateOne) Save(ctx context.Context) (*ConfigRegistration, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConfigRegistrationUpdateOne) SaveX(ctx context.Context) *ConfigRegistration {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConfigRegistrationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConfigRegistrationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConfigRegistrationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConfigRegistrationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ConfigRegistrationUpdateOne) sqlSave(ctx context.Context) (_node *ConfigRegistration, err error) {
+	_spec := sqlgraph.NewUpdateSpec(configregistration.Table, configregistration.Columns, sqlgraph.NewFieldSpec(configregistration.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConfigRegistration.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, configregistration.FieldID)
+		for _, f := range fields {
+			if !configregistration.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != configregistration.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(configregistration.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(configregistration.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(configregistration.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(configregistration.FieldUpdatedBy, field.TypeString)
+	}
+	if _u.mutation.DefinitionCleared() {
+		_spec.ClearField(configregistration.FieldDefinition, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ConfigRegistration{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{configregistration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,19 +12,35 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
 	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/blob"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
 	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
 	"github.com/codeready-toolchain/tarsy/ent/event"
+	"github.com/codeready-toolchain/tarsy/ent/featureflagoverride"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
 	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
+	"github.com/codeready-toolchain/tarsy/ent/jobleader"
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
 	"github.com/codeready-toolchain/tarsy/ent/message"
+	"github.com/codeready-toolchain/tarsy/ent/podheartbeat"
+	"github.com/codeready-toolchain/tarsy/ent/queuepause"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+	"github.com/codeready-toolchain/tarsy/ent/savedview"
+	"github.com/codeready-toolchain/tarsy/ent/schemacompatibility"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
 	"github.com/codeready-toolchain/tarsy/ent/sessionreviewactivity"
 	"github.com/codeready-toolchain/tarsy/ent/sessionscore"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/ent/userprofile"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -85,19 +101,35 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			actionitem.Table:            actionitem.ValidColumn,
+			activityreport.Table:        activityreport.ValidColumn,
 			agentexecution.Table:        agentexecution.ValidColumn,
 			alertsession.Table:          alertsession.ValidColumn,
+			blob.Table:                  blob.ValidColumn,
 			chat.Table:                  chat.ValidColumn,
 			chatusermessage.Table:       chatusermessage.ValidColumn,
+			configregistration.Table:    configregistration.ValidColumn,
 			event.Table:                 event.ValidColumn,
+			featureflagoverride.Table:   featureflagoverride.ValidColumn,
+			handoffnoterevision.Table:   handoffnoterevision.ValidColumn,
 			investigationmemory.Table:   investigationmemory.ValidColumn,
+			jobleader.Table:             jobleader.ValidColumn,
 			llminteraction.Table:        llminteraction.ValidColumn,
 			mcpinteraction.Table:        mcpinteraction.ValidColumn,
 			message.Table:               message.ValidColumn,
+			podheartbeat.Table:          podheartbeat.ValidColumn,
+			queuepause.Table:            queuepause.ValidColumn,
+			redactionreview.Table:       redactionreview.ValidColumn,
+			savedview.Table:             savedview.ValidColumn,
+			schemacompatibility.Table:   schemacompatibility.ValidColumn,
+			sessionclaim.Table:          sessionclaim.ValidColumn,
+			sessioncomparison.Table:     sessioncomparison.ValidColumn,
+			sessiongroup.Table:          sessiongroup.ValidColumn,
 			sessionreviewactivity.Table: sessionreviewactivity.ValidColumn,
 			sessionscore.Table:          sessionscore.ValidColumn,
 			stage.Table:                 stage.ValidColumn,
 			timelineevent.Table:         timelineevent.ValidColumn,
+			userprofile.Table:           userprofile.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
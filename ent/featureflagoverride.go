@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/featureflagoverride"
+)
+
+// FeatureFlagOverride is the model entity for the FeatureFlagOverride schema.
+type FeatureFlagOverride struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// API caller that created the row; null when created by the system
+	CreatedBy *string `json:"created_by,omitempty"`
+	// API caller that last changed the row
+	UpdatedBy *string `json:"updated_by,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Share of targets (0-100) that see the flag; null = all
+	Percentage *int `json:"percentage,omitempty"`
+	// Restrict the flag to these alert types; empty = all
+	AlertTypes []string `json:"alert_types,omitempty"`
+	// Restrict the flag to these chain IDs; empty = all
+	Chains []string `json:"chains,omitempty"`
+	// Restrict the flag to alerts targeting these namespaces; empty = all
+	Namespaces   []string `json:"namespaces,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FeatureFlagOverride) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case featureflagoverride.FieldAlertTypes, featureflagoverride.FieldChains, featureflagoverride.FieldNamespaces:
+			values[i] = new([]byte)
+		case featureflagoverride.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case featureflagoverride.FieldPercentage:
+			values[i] = new(sql.NullInt64)
+		case featureflagoverride.FieldID, featureflagoverride.FieldCreatedBy, featureflagoverride.FieldUpdatedBy:
+			values[i] = new(sql.NullString)
+		case featureflagoverride.FieldCreatedAt, featureflagoverride.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FeatureFlagOverride fields.
+func (_m *FeatureFlagOverride) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case featureflagoverride.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case featureflagoverride.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case featureflagoverride.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case featureflagoverride.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(string)
+				*_m.CreatedBy = value.String
+			}
+		case featureflagoverride.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(string)
+				*_m.UpdatedBy = value.String
+			}
+		case featureflagoverride.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case featureflagoverride.FieldPercentage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field percentage", values[i])
+			} else if value.Valid {
+				_m.Percentage = new(int)
+				*_m.Percentage = int(value.Int64)
+			}
+		case featureflagoverride.FieldAlertTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AlertTypes); err != nil {
+					return fmt.Errorf("unmarshal field alert_types: %w", err)
+				}
+			}
+		case featureflagoverride.FieldChains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field chains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Chains); err != nil {
+					return fmt.Errorf("unmarshal field chains: %w", err)
+				}
+			}
+		case featureflagoverride.FieldNamespaces:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field namespaces", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Namespaces); err != nil {
+					return fmt.Errorf("unmarshal field namespaces: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FeatureFlagOverride.
+// This includes values selected through modifiers, order, etc.
+func (_m *FeatureFlagOverride) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FeatureFlagOverride.
+// Note that you need to call FeatureFlagOverride.Unwrap() before calling this method if this FeatureFlagOverride
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FeatureFlagOverride) Update() *FeatureFlagOverrideUpdateOne {
+	return NewFeatureFlagOverrideClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FeatureFlagOverride entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FeatureFlagOverride) Unwrap() *FeatureFlagOverride {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FeatureFlagOverride is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FeatureFlagOverride) String() string {
+	var builder strings.Builder
+	builder.WriteString("FeatureFlagOverride(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.Percentage; v != nil {
+		builder.WriteString("percentage=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("alert_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlertTypes))
+	builder.WriteString(", ")
+	builder.WriteString("chains=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Chains))
+	builder.WriteString(", ")
+	builder.WriteString("namespaces=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Namespaces))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FeatureFlagOverrides is a parsable slice of FeatureFlagOverride.
+type FeatureFlagOverrides []*FeatureFlagOverride
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package featureflagoverride
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Percentage applies equality check predicate on the "percentage" field. It's identical to PercentageEQ.
+func Percentage(v int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldPercentage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PercentageEQ applies the EQ predicate on the "percentage" field.
+func PercentageEQ(v int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldEQ(FieldPercentage, v))
+}
+
+// PercentageNEQ applies the NEQ predicate on the "percentage" field.
+func PercentageNEQ(v int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNEQ(FieldPercentage, v))
+}
+
+// PercentageIn applies the In predicate on the "percentage" field.
+func PercentageIn(vs ...int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIn(FieldPercentage, vs...))
+}
+
+// PercentageNotIn applies the NotIn predicate on the "percentage" field.
+func PercentageNotIn(vs ...int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotIn(FieldPercentage, vs...))
+}
+
+// PercentageGT applies the GT predicate on the "percentage" field.
+func PercentageGT(v int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGT(FieldPercentage, v))
+}
+
+// PercentageGTE applies the GTE predicate on the "percentage" field.
+func PercentageGTE(v int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldGTE(FieldPercentage, v))
+}
+
+// PercentageLT applies the LT predicate on the "percentage" field.
+func PercentageLT(v int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLT(FieldPercentage, v))
+}
+
+// PercentageLTE applies the LTE predicate on the "percentage" field.
+func PercentageLTE(v int) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldLTE(FieldPercentage, v))
+}
+
+// PercentageIsNil applies the IsNil predicate on the "percentage" field.
+func PercentageIsNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIsNull(FieldPercentage))
+}
+
+// PercentageNotNil applies the NotNil predicate on the "percentage" field.
+func PercentageNotNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotNull(FieldPercentage))
+}
+
+// AlertTypesIsNil applies the IsNil predicate on the "alert_types" field.
+func AlertTypesIsNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIsNull(FieldAlertTypes))
+}
+
+// AlertTypesNotNil applies the NotNil predicate on the "alert_types" field.
+func AlertTypesNotNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotNull(FieldAlertTypes))
+}
+
+// ChainsIsNil applies the IsNil predicate on the "chains" field.
+func ChainsIsNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIsNull(FieldChains))
+}
+
+// ChainsNotNil applies the NotNil predicate on the "chains" field.
+func ChainsNotNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotNull(FieldChains))
+}
+
+// NamespacesIsNil applies the IsNil predicate on the "namespaces" field.
+func NamespacesIsNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldIsNull(FieldNamespaces))
+}
+
+// NamespacesNotNil applies the NotNil predicate on the "namespaces" field.
+func NamespacesNotNil() predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.FieldNotNull(FieldNamespaces))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FeatureFlagOverride) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FeatureFlagOverride) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FeatureFlagOverride) predicate.FeatureFlagOverride {
+	return predicate.FeatureFlagOverride(sql.NotPredicates(p))
+}
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/featureflagoverride"
+)
+
+// FeatureFlagOverrideCreate is the builder for creating a FeatureFlagOverride entity.
+type FeatureFlagOverrideCreate struct {
+	config
+	mutation *FeatureFlagOverrideMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FeatureFlagOverrideCreate) SetCreatedAt(v time.Time) *FeatureFlagOverrideCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FeatureFlagOverrideCreate) SetNillableCreatedAt(v *time.Time) *FeatureFlagOverrideCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FeatureFlagOverrideCreate) SetUpdatedAt(v time.Time) *FeatureFlagOverrideCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FeatureFlagOverrideCreate) SetNillableUpdatedAt(v *time.Time) *FeatureFlagOverrideCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *FeatureFlagOverrideCreate) SetCreatedBy(v string) *FeatureFlagOverrideCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *FeatureFlagOverrideCreate) SetNillableCreatedBy(v *string) *FeatureFlagOverrideCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *FeatureFlagOverrideCreate) SetUpdatedBy(v string) *FeatureFlagOverrideCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *FeatureFlagOverrideCreate) SetNillableUpdatedBy(v *string) *FeatureFlagOverrideCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *FeatureFlagOverrideCreate) SetEnabled(v bool) *FeatureFlagOverrideCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetPercentage sets the "percentage" field.
+func (_c *FeatureFlagOverrideCreate) SetPercentage(v int) *FeatureFlagOverrideCreate {
+	_c.mutation.SetPercentage(v)
+	return _c
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_c *FeatureFlagOverrideCreate) SetNillablePercentage(v *int) *FeatureFlagOverrideCreate {
+	if v != nil {
+		_c.SetPercentage(*v)
+	}
+	return _c
+}
+
+// SetAlertTypes sets the "alert_types" field.
+func (_c *FeatureFlagOverrideCreate) SetAlertTypes(v []string) *FeatureFlagOverrideCreate {
+	_c.mutation.SetAlertTypes(v)
+	return _c
+}
+
+// SetChains sets the "chains" field.
+func (_c *FeatureFlagOverrideCreate) SetChains(v []string) *FeatureFlagOverrideCreate {
+	_c.mutation.SetChains(v)
+	return _c
+}
+
+// SetNamespaces sets the "namespaces" field.
+func (_c *FeatureFlagOverrideCreate) SetNamespaces(v []string) *FeatureFlagOverrideCreate {
+	_c.mutation.SetNamespaces(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FeatureFlagOverrideCreate) SetID(v string) *FeatureFlagOverrideCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the FeatureFlagOverrideMutation object of the builder.
+func (_c *FeatureFlagOverrideCreate) Mutation() *FeatureFlagOverrideMutation {
+	return _c.mutation
+}
+
+// Save creates the FeatureFlagOverride in the database.
+func (_c *FeatureFlagOverrideCreate) Save(ctx context.Context) (*FeatureFlagOverride, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FeatureFlagOverrideCreate) SaveX(ctx context.Context) *FeatureFlagOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FeatureFlagOverrideCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FeatureFlagOverrideCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FeatureFlagOverrideCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if featureflagoverride.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized featureflagoverride.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := featureflagoverride.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if featureflagoverride.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized featureflagoverride.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := featureflagoverride.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FeatureFlagOverrideCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FeatureFlagOverride.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FeatureFlagOverride.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "FeatureFlagOverride.enabled"`)}
+	}
+	return nil
+}
+
+func (_c *FeatureFlagOverrideCreate) sqlSave(ctx context.Context) (*FeatureFlagOverride, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected FeatureFlagOverride.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FeatureFlagOverrideCreate) createSpec() (*FeatureFlagOverride, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FeatureFlagOverride{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(featureflagoverride.Table, sqlgraph.NewFieldSpec(featureflagoverride.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(featureflagoverride.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(featureflagoverride.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(featureflagoverride.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(featureflagoverride.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(featureflagoverride.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Percentage(); ok {
+		_spec.SetField(featureflagoverride.FieldPercentage, field.TypeInt, value)
+		_node.Percentage = &value
+	}
+	if value, ok := _c.mutation.AlertTypes(); ok {
+		_spec.SetField(featureflagoverride.FieldAlertTypes, field.TypeJSON, value)
+		_node.AlertTypes = value
+	}
+	if value, ok := _c.mutation.Chains(); ok {
+		_spec.SetField(featureflagoverride.FieldChains, field.TypeJSON, value)
+		_node.Chains = value
+	}
+	if value, ok := _c.mutation.Namespaces(); ok {
+		_spec.SetField(featureflagoverride.FieldNamespaces, field.TypeJSON, value)
+		_node.Namespaces = value
+	}
+	return _node, _spec
+}
+
+// FeatureFlagOverrideCreateBulk is the builder for creating many FeatureFlagOverride entities in bulk.
+type FeatureFlagOverrideCreateBulk struct {
+	config
+	err      error
+	builders []*FeatureFlagOverrideCreate
+}
+
+// Save creates the FeatureFlagOverride entities in the database.
+func (_c *FeatureFlagOverrideCreateBulk) Save(ctx context.Context) ([]*FeatureFlagOverride, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FeatureFlagOverride, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeatureFlagOverrideMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FeatureFlagOverrideCreateBulk) SaveX(ctx context.Context) []*FeatureFlagOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FeatureFlagOverrideCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FeatureFlagOverrideCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
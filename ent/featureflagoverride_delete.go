@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/featureflagoverride"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// FeatureFlagOverrideDelete is the builder for deleting a FeatureFlagOverride entity.
+type FeatureFlagOverrideDelete struct {
+	config
+	hooks    []Hook
+	mutation *FeatureFlagOverrideMutation
+}
+
+// Where appends a list predicates to the FeatureFlagOverrideDelete builder.
+func (_d *FeatureFlagOverrideDelete) Where(ps ...predicate.FeatureFlagOverride) *FeatureFlagOverrideDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *FeatureFlagOverrideDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FeatureFlagOverrideDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *FeatureFlagOverrideDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(featureflagoverride.Table, sqlgraph.NewFieldSpec(featureflagoverride.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// FeatureFlagOverrideDeleteOne is the builder for deleting a single FeatureFlagOverride entity.
+type FeatureFlagOverrideDeleteOne struct {
+	_d *FeatureFlagOverrideDelete
+}
+
+// Where appends a list predicates to the FeatureFlagOverrideDelete builder.
+func (_d *FeatureFlagOverrideDeleteOne) Where(ps ...predicate.FeatureFlagOverride) *FeatureFlagOverrideDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *FeatureFlagOverrideDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{featureflagoverride.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FeatureFlagOverrideDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
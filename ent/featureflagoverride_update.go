@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/featureflagoverride"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// FeatureFlagOverrideUpdate is the builder for updating FeatureFlagOverride entities.
+type FeatureFlagOverrideUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FeatureFlagOverrideMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FeatureFlagOverrideUpdate builder.
+func (_u *FeatureFlagOverrideUpdate) Where(ps ...predicate.FeatureFlagOverride) *FeatureFlagOverrideUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FeatureFlagOverrideUpdate) SetUpdatedAt(v time.Time) *FeatureFlagOverrideUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *FeatureFlagOverrideUpdate) SetCreatedBy(v string) *FeatureFlagOverrideUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *FeatureFlagOverrideUpdate) SetNillableCreatedBy(v *string) *FeatureFlagOverrideUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *FeatureFlagOverrideUpdate) ClearCreatedBy() *FeatureFlagOverrideUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *FeatureFlagOverrideUpdate) SetUpdatedBy(v string) *FeatureFlagOverrideUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *FeatureFlagOverrideUpdate) SetNillableUpdatedBy(v *string) *FeatureFlagOverrideUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *FeatureFlagOverrideUpdate) ClearUpdatedBy() *FeatureFlagOverrideUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *FeatureFlagOverrideUpdate) SetEnabled(v bool) *FeatureFlagOverrideUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *FeatureFlagOverrideUpdate) SetNillableEnabled(v *bool) *FeatureFlagOverrideUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *FeatureFlagOverrideUpdate) SetPercentage(v int) *FeatureFlagOverrideUpdate {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *FeatureFlagOverrideUpdate) SetNillablePercentage(v *int) *FeatureFlagOverrideUpdate {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *FeatureFlagOverrideUpdate) AddPercentage(v int) *FeatureFlagOverrideUpdate {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (_u *FeatureFlagOverrideUpdate) ClearPercentage() *FeatureFlagOverrideUpdate {
+	_u.mutation.ClearPercentage()
+	return _u
+}
+
+// SetAlertTypes sets the "alert_types" field.
+func (_u *FeatureFlagOverrideUpdate) SetAlertTypes(v []string) *FeatureFlagOverrideUpdate {
+	_u.mutation.SetAlertTypes(v)
+	return _u
+}
+
+// AppendAlertTypes appends value to the "alert_types" field.
+func (_u *FeatureFlagOverrideUpdate) AppendAlertTypes(v []string) *FeatureFlagOverrideUpdate {
+	_u.mutation.AppendAlertTypes(v)
+	return _u
+}
+
+// ClearAlertTypes clears the value of the "alert_types" field.
+func (_u *FeatureFlagOverrideUpdate) ClearAlertTypes() *FeatureFlagOverrideUpdate {
+	_u.mutation.ClearAlertTypes()
+	return _u
+}
+
+// SetChains sets the "chains" field.
+func (_u *FeatureFlagOverrideUpdate) SetChains(v []string) *FeatureFlagOverrideUpdate {
+	_u.mutation.SetChains(v)
+	return _u
+}
+
+// AppendChains appends value to the "chains" field.
+func (_u *FeatureFlagOverrideUpdate) AppendChains(v []string) *FeatureFlagOverrideUpdate {
+	_u.mutation.AppendChains(v)
+	return _u
+}
+
+// ClearChains clears the value of the "chains" field.
+func (_u *FeatureFlagOverrideUpdate) ClearChains() *FeatureFlagOverrideUpdate {
+	_u.mutation.ClearChains()
+	return _u
+}
+
+// SetNamespaces sets the "namespaces" field.
+func (_u *FeatureFlagOverrideUpdate) SetNamespaces(v []string) *FeatureFlagOverrideUpdate {
+	_u.mutation.SetNamespaces(v)
+	return _u
+}
+
+// AppendNamespaces appends value to the "namespaces" field.
+func (_u *FeatureFlagOverrideUpdate) AppendNamespaces(v []string) *FeatureFlagOverrideUpdate {
+	_u.mutation.AppendNamespaces(v)
+	return _u
+}
+
+// ClearNamespaces clears the value of the "namespaces" field.
+func (_u *FeatureFlagOverrideUpdate) ClearNamespaces() *FeatureFlagOverrideUpdate {
+	_u.mutation.ClearNamespaces()
+	return _u
+}
+
+// Mutation returns the FeatureFlagOverrideMutation object of the builder.
+func (_u *FeatureFlagOverrideUpdate) Mutation() *FeatureFlagOverrideMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FeatureFlagOverrideUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FeatureFlagOverrideUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FeatureFlagOverrideUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FeatureFlagOverrideUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FeatureFlagOverrideUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if featureflagoverride.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized featureflagoverride.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := featureflagoverride.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FeatureFlagOverrideUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FeatureFlagOverrideUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FeatureFlagOverrideUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(featureflagoverride.Table, featureflagoverride.Columns, sqlgraph.NewFieldSpec(featureflagoverride.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(featureflagoverride.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(featureflagoverride.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(featureflagoverride.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(featureflagoverride.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(featureflagoverride.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(featureflagoverride.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(featureflagoverride.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(featureflagoverride.FieldPercentage, field.TypeInt, value)
+	}
+	if _u.mutation.PercentageCleared() {
+		_spec.ClearField(featureflagoverride.FieldPercentage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AlertTypes(); ok {
+		_spec.SetField(featureflagoverride.FieldAlertTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAlertTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflagoverride.FieldAlertTypes, value)
+		})
+	}
+	if _u.mutation.AlertTypesCleared() {
+		_spec.ClearField(featureflagoverride.FieldAlertTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Chains(); ok {
+		_spec.SetField(featureflagoverride.FieldChains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflagoverride.FieldChains, value)
+		})
+	}
+	if _u.mutation.ChainsCleared() {
+		_spec.ClearField(featureflagoverride.FieldChains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Namespaces(); ok {
+		_spec.SetField(featureflagoverride.FieldNamespaces, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNamespaces(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflagoverride.FieldNamespaces, value)
+		})
+	}
+	if _u.mutation.NamespacesCleared() {
+		_spec.ClearField(featureflagoverride.FieldNamespaces, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{featureflagoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FeatureFlagOverrideUpdateOne is the builder for updating a single FeatureFlagOverride entity.
+type FeatureFlagOverrideUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FeatureFlagOverrideMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FeatureFlagOverrideUpdateOne) SetUpdatedAt(v time.Time) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *FeatureFlagOverrideUpdateOne) SetCreatedBy(v string) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *FeatureFlagOverrideUpdateOne) SetNillableCreatedBy(v *string) *FeatureFlagOverrideUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *FeatureFlagOverrideUpdateOne) ClearCreatedBy() *FeatureFlagOverrideUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *FeatureFlagOverrideUpdateOne) SetUpdatedBy(v string) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *FeatureFlagOverrideUpdateOne) SetNillableUpdatedBy(v *string) *FeatureFlagOverrideUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *FeatureFlagOverrideUpdateOne) ClearUpdatedBy() *FeatureFlagOverrideUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *FeatureFlagOverrideUpdateOne) SetEnabled(v bool) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *FeatureFlagOverrideUpdateOne) SetNillableEnabled(v *bool) *FeatureFlagOverrideUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPercentage sets the "percentage" field.
+func (_u *FeatureFlagOverrideUpdateOne) SetPercentage(v int) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.ResetPercentage()
+	_u.mutation.SetPercentage(v)
+	return _u
+}
+
+// SetNillablePercentage sets the "percentage" field if the given value is not nil.
+func (_u *FeatureFlagOverrideUpdateOne) SetNillablePercentage(v *int) *FeatureFlagOverrideUpdateOne {
+	if v != nil {
+		_u.SetPercentage(*v)
+	}
+	return _u
+}
+
+// AddPercentage adds value to the "percentage" field.
+func (_u *FeatureFlagOverrideUpdateOne) AddPercentage(v int) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.AddPercentage(v)
+	return _u
+}
+
+// ClearPercentage clears the value of the "percentage" field.
+func (_u *FeatureFlagOverrideUpdateOne) ClearPercentage() *FeatureFlagOverrideUpdateOne {
+	_u.mutation.ClearPercentage()
+	return _u
+}
+
+// SetAlertTypes sets the "alert_types" field.
+func (_u *FeatureFlagOverrideUpdateOne) SetAlertTypes(v []string) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.SetAlertTypes(v)
+	return _u
+}
+
+// AppendAlertTypes appends value to the "alert_types" field.
+func (_u *FeatureFlagOverrideUpdateOne) AppendAlertTypes(v []string) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.AppendAlertTypes(v)
+	return _u
+}
+
+// ClearAlertTypes clears the value of the "alert_types" field.
+func (_u *FeatureFlagOverrideUpdateOne) ClearAlertTypes() *FeatureFlagOverrideUpdateOne {
+	_u.mutation.ClearAlertTypes()
+	return _u
+}
+
+// SetChains sets the "chains" field.
+func (_u *FeatureFlagOverrideUpdateOne) SetChains(v []string) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.SetChains(v)
+	return _u
+}
+
+// AppendChains appends value to the "chains" field.
+func (_u *FeatureFlagOverrideUpdateOne) AppendChains(v []string) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.AppendChains(v)
+	return _u
+}
+
+// ClearChains clears the value of the "chains" field.
+func (_u *FeatureFlagOverrideUpdateOne) ClearChains() *FeatureFlagOverrideUpdateOne {
+	_u.mutation.ClearChains()
+	return _u
+}
+
+// SetNamespaces sets the "namespaces" field.
+func (_u *FeatureFlagOverrideUpdateOne) SetNamespaces(v []string) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.SetNamespaces(v)
+	return _u
+}
+
+// AppendNamespaces appends value to the "namespaces" field.
+func (_u *FeatureFlagOverrideUpdateOne) AppendNamespaces(v []string) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.AppendNamespaces(v)
+	return _u
+}
+
+// ClearNamespaces clears the value of the "namespaces" field.
+func (_u *FeatureFlagOverrideUpdateOne) ClearNamespaces() *FeatureFlagOverrideUpdateOne {
+	_u.mutation.ClearNamespaces()
+	return _u
+}
+
+// Mutation returns the FeatureFlagOverrideMutation object of the builder.
+func (_u *FeatureFlagOverrideUpdateOne) Mutation() *FeatureFlagOverrideMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FeatureFlagOverrideUpdate builder.
+func (_u *FeatureFlagOverrideUpdateOne) Where(ps ...predicate.FeatureFlagOverride) *FeatureFlagOverrideUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FeatureFlagOverrideUpdateOne) Select(field string, fields ...string) *FeatureFlagOverrideUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FeatureFlagOverride entity.
+func (_u *FeatureFlagOverrideUpdateOne) Save(ctx context.Context) (*FeatureFlagOverride, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FeatureFlagOverrideUpdateOne) SaveX(ctx context.Context) *FeatureFlagOverride {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FeatureFlagOverrideUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FeatureFlagOverrideUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FeatureFlagOverrideUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if featureflagoverride.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized featureflagoverride.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := featureflagoverride.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FeatureFlagOverrideUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FeatureFlagOverrideUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FeatureFlagOverrideUpdateOne) sqlSave(ctx context.Context) (_node *FeatureFlagOverride, err error) {
+	_spec := sqlgraph.NewUpdateSpec(featureflagoverride.Table, featureflagoverride.Columns, sqlgraph.NewFieldSpec(featureflagoverride.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FeatureFlagOverride.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, featureflagoverride.FieldID)
+		for _, f := range fields {
+			if !featureflagoverride.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != featureflagoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(featureflagoverride.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(featureflagoverride.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(featureflagoverride.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(featureflagoverride.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(featureflagoverride.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(featureflagoverride.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Percentage(); ok {
+		_spec.SetField(featureflagoverride.FieldPercentage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPercentage(); ok {
+		_spec.AddField(featureflagoverride.FieldPercentage, field.TypeInt, value)
+	}
+	if _u.mutation.PercentageCleared() {
+		_spec.ClearField(featureflagoverride.FieldPercentage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AlertTypes(); ok {
+		_spec.SetField(featureflagoverride.FieldAlertTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAlertTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflagoverride.FieldAlertTypes, value)
+		})
+	}
+	if _u.mutation.AlertTypesCleared() {
+		_spec.ClearField(featureflagoverride.FieldAlertTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Chains(); ok {
+		_spec.SetField(featureflagoverride.FieldChains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflagoverride.FieldChains, value)
+		})
+	}
+	if _u.mutation.ChainsCleared() {
+		_spec.ClearField(featureflagoverride.FieldChains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Namespaces(); ok {
+		_spec.SetField(featureflagoverride.FieldNamespaces, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNamespaces(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, featureflagoverride.FieldNamespaces, value)
+		})
+	}
+	if _u.mutation.NamespacesCleared() {
+		_spec.ClearField(featureflagoverride.FieldNamespaces, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FeatureFlagOverride{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{featureflagoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
+)
+
+// HandoffNoteRevision is the model entity for the HandoffNoteRevision schema.
+type HandoffNoteRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID string `json:"session_id,omitempty"`
+	// 1-based, increasing per session
+	Revision int `json:"revision,omitempty"`
+	// Full notes markdown after this edit; empty when the notes were cleared
+	Content string `json:"content,omitempty"`
+	// User who saved the revision
+	Author string `json:"author,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HandoffNoteRevisionQuery when eager-loading is set.
+	Edges        HandoffNoteRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HandoffNoteRevisionEdges holds the relations/edges for other nodes in the graph.
+type HandoffNoteRevisionEdges struct {
+	// Session holds the value of the session edge.
+	Session *AlertSession `json:"session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HandoffNoteRevisionEdges) SessionOrErr() (*AlertSession, error) {
+	if e.Session != nil {
+		return e.Session, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: alertsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*HandoffNoteRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case handoffnoterevision.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case handoffnoterevision.FieldID, handoffnoterevision.FieldSessionID, handoffnoterevision.FieldContent, handoffnoterevision.FieldAuthor:
+			values[i] = new(sql.NullString)
+		case handoffnoterevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the HandoffNoteRevision fields.
+func (_m *HandoffNoteRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case handoffnoterevision.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case handoffnoterevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case handoffnoterevision.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				_m.SessionID = value.String
+			}
+		case handoffnoterevision.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case handoffnoterevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case handoffnoterevision.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				_m.Author = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the HandoffNoteRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *HandoffNoteRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySession queries the "session" edge of the HandoffNoteRevision entity.
+func (_m *HandoffNoteRevision) QuerySession() *AlertSessionQuery {
+	return NewHandoffNoteRevisionClient(_m.config).QuerySession(_m)
+}
+
+// Update returns a builder for updating this HandoffNoteRevision.
+// Note that you need to call HandoffNoteRevision.Unwrap() before calling this method if this HandoffNoteRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *HandoffNoteRevision) Update() *HandoffNoteRevisionUpdateOne {
+	return NewHandoffNoteRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the HandoffNoteRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *HandoffNoteRevision) Unwrap() *HandoffNoteRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: HandoffNoteRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *HandoffNoteRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("HandoffNoteRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(_m.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(_m.Author)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// HandoffNoteRevisions is a parsable slice of HandoffNoteRevision.
+type HandoffNoteRevisions []*HandoffNoteRevision
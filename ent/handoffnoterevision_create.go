@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
+)
+
+// HandoffNoteRevisionCreate is the builder for creating a HandoffNoteRevision entity.
+type HandoffNoteRevisionCreate struct {
+	config
+	mutation *HandoffNoteRevisionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *HandoffNoteRevisionCreate) SetCreatedAt(v time.Time) *HandoffNoteRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *HandoffNoteRevisionCreate) SetNillableCreatedAt(v *time.Time) *HandoffNoteRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *HandoffNoteRevisionCreate) SetSessionID(v string) *HandoffNoteRevisionCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *HandoffNoteRevisionCreate) SetRevision(v int) *HandoffNoteRevisionCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *HandoffNoteRevisionCreate) SetContent(v string) *HandoffNoteRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *HandoffNoteRevisionCreate) SetAuthor(v string) *HandoffNoteRevisionCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *HandoffNoteRevisionCreate) SetID(v string) *HandoffNoteRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSession sets the "session" edge to the AlertSession entity.
+func (_c *HandoffNoteRevisionCreate) SetSession(v *AlertSession) *HandoffNoteRevisionCreate {
+	return _c.SetSessionID(v.ID)
+}
+
+// Mutation returns the HandoffNoteRevisionMutation object of the builder.
+func (_c *HandoffNoteRevisionCreate) Mutation() *HandoffNoteRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the HandoffNoteRevision in the database.
+func (_c *HandoffNoteRevisionCreate) Save(ctx context.Context) (*HandoffNoteRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *HandoffNoteRevisionCreate) SaveX(ctx context.Context) *HandoffNoteRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HandoffNoteRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HandoffNoteRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *HandoffNoteRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := handoffnoterevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *HandoffNoteRevisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "HandoffNoteRevision.created_at"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "HandoffNoteRevision.session_id"`)}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "HandoffNoteRevision.revision"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "HandoffNoteRevision.content"`)}
+	}
+	if _, ok := _c.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "HandoffNoteRevision.author"`)}
+	}
+	if len(_c.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "HandoffNoteRevision.session"`)}
+	}
+	return nil
+}
+
+func (_c *HandoffNoteRevisionCreate) sqlSave(ctx context.Context) (*HandoffNoteRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected HandoffNoteRevision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *HandoffNoteRevisionCreate) createSpec() (*HandoffNoteRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &HandoffNoteRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(handoffnoterevision.Table, sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(handoffnoterevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(handoffnoterevision.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(handoffnoterevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(handoffnoterevision.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   handoffnoterevision.SessionTable,
+			Columns: []string{handoffnoterevision.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HandoffNoteRevisionCreateBulk is the builder for creating many HandoffNoteRevision entities in bulk.
+type HandoffNoteRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*HandoffNoteRevisionCreate
+}
+
+// Save creates the HandoffNoteRevision entities in the database.
+func (_c *HandoffNoteRevisionCreateBulk) Save(ctx context.Context) ([]*HandoffNoteRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*HandoffNoteRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HandoffNoteRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *HandoffNoteRevisionCreateBulk) SaveX(ctx context.Context) []*HandoffNoteRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *HandoffNoteRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *HandoffNoteRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// HandoffNoteRevisionDelete is the builder for deleting a HandoffNoteRevision entity.
+type HandoffNoteRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *HandoffNoteRevisionMutation
+}
+
+// Where appends a list predicates to the HandoffNoteRevisionDelete builder.
+func (_d *HandoffNoteRevisionDelete) Where(ps ...predicate.HandoffNoteRevision) *HandoffNoteRevisionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *HandoffNoteRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *HandoffNoteRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *HandoffNoteRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(handoffnoterevision.Table, sqlgraph.NewFieldSpec(handoffnoterevision.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// HandoffNoteRevisionDeleteOne is the builder for deleting a single HandoffNoteRevision entity.
+type HandoffNoteRevisionDeleteOne struct {
+	_d *HandoffNoteRevisionDelete
+}
+
+// Where appends a list predicates to the HandoffNoteRevisionDelete builder.
+func (_d *HandoffNoteRevisionDeleteOne) Where(ps ...predicate.HandoffNoteRevision) *HandoffNoteRevisionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *HandoffNoteRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{handoffnoterevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *HandoffNoteRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,6 +9,30 @@ import (
 	"github.com/codeready-toolchain/tarsy/ent"
 )
 
+// The ActionItemFunc type is an adapter to allow the use of ordinary
+// function as ActionItem mutator.
+type ActionItemFunc func(context.Context, *ent.ActionItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ActionItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ActionItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActionItemMutation", m)
+}
+
+// The ActivityReportFunc type is an adapter to allow the use of ordinary
+// function as ActivityReport mutator.
+type ActivityReportFunc func(context.Context, *ent.ActivityReportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ActivityReportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ActivityReportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ActivityReportMutation", m)
+}
+
 // The AgentExecutionFunc type is an adapter to allow the use of ordinary
 // function as AgentExecution mutator.
 type AgentExecutionFunc func(context.Context, *ent.AgentExecutionMutation) (ent.Value, error)
@@ -33,6 +57,18 @@ func (f AlertSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AlertSessionMutation", m)
 }
 
+// The BlobFunc type is an adapter to allow the use of ordinary
+// function as Blob mutator.
+type BlobFunc func(context.Context, *ent.BlobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlobMutation", m)
+}
+
 // The ChatFunc type is an adapter to allow the use of ordinary
 // function as Chat mutator.
 type ChatFunc func(context.Context, *ent.ChatMutation) (ent.Value, error)
@@ -57,6 +93,18 @@ func (f ChatUserMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatUserMessageMutation", m)
 }
 
+// The ConfigRegistrationFunc type is an adapter to allow the use of ordinary
+// function as ConfigRegistration mutator.
+type ConfigRegistrationFunc func(context.Context, *ent.ConfigRegistrationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConfigRegistrationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConfigRegistrationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConfigRegistrationMutation", m)
+}
+
 // The EventFunc type is an adapter to allow the use of ordinary
 // function as Event mutator.
 type EventFunc func(context.Context, *ent.EventMutation) (ent.Value, error)
@@ -69,6 +117,30 @@ func (f EventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventMutation", m)
 }
 
+// The FeatureFlagOverrideFunc type is an adapter to allow the use of ordinary
+// function as FeatureFlagOverride mutator.
+type FeatureFlagOverrideFunc func(context.Context, *ent.FeatureFlagOverrideMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FeatureFlagOverrideFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FeatureFlagOverrideMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FeatureFlagOverrideMutation", m)
+}
+
+// The HandoffNoteRevisionFunc type is an adapter to allow the use of ordinary
+// function as HandoffNoteRevision mutator.
+type HandoffNoteRevisionFunc func(context.Context, *ent.HandoffNoteRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f HandoffNoteRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.HandoffNoteRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.HandoffNoteRevisionMutation", m)
+}
+
 // The InvestigationMemoryFunc type is an adapter to allow the use of ordinary
 // function as InvestigationMemory mutator.
 type InvestigationMemoryFunc func(context.Context, *ent.InvestigationMemoryMutation) (ent.Value, error)
@@ -81,6 +153,18 @@ func (f InvestigationMemoryFunc) Mutate(ctx context.Context, m ent.Mutation) (en
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvestigationMemoryMutation", m)
 }
 
+// The JobLeaderFunc type is an adapter to allow the use of ordinary
+// function as JobLeader mutator.
+type JobLeaderFunc func(context.Context, *ent.JobLeaderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobLeaderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobLeaderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobLeaderMutation", m)
+}
+
 // The LLMInteractionFunc type is an adapter to allow the use of ordinary
 // function as LLMInteraction mutator.
 type LLMInteractionFunc func(context.Context, *ent.LLMInteractionMutation) (ent.Value, error)
@@ -117,6 +201,102 @@ func (f MessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMutation", m)
 }
 
+// The PodHeartbeatFunc type is an adapter to allow the use of ordinary
+// function as PodHeartbeat mutator.
+type PodHeartbeatFunc func(context.Context, *ent.PodHeartbeatMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PodHeartbeatFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PodHeartbeatMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PodHeartbeatMutation", m)
+}
+
+// The QueuePauseFunc type is an adapter to allow the use of ordinary
+// function as QueuePause mutator.
+type QueuePauseFunc func(context.Context, *ent.QueuePauseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QueuePauseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QueuePauseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QueuePauseMutation", m)
+}
+
+// The RedactionReviewFunc type is an adapter to allow the use of ordinary
+// function as RedactionReview mutator.
+type RedactionReviewFunc func(context.Context, *ent.RedactionReviewMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RedactionReviewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RedactionReviewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RedactionReviewMutation", m)
+}
+
+// The SavedViewFunc type is an adapter to allow the use of ordinary
+// function as SavedView mutator.
+type SavedViewFunc func(context.Context, *ent.SavedViewMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedViewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedViewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedViewMutation", m)
+}
+
+// The SchemaCompatibilityFunc type is an adapter to allow the use of ordinary
+// function as SchemaCompatibility mutator.
+type SchemaCompatibilityFunc func(context.Context, *ent.SchemaCompatibilityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SchemaCompatibilityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SchemaCompatibilityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SchemaCompatibilityMutation", m)
+}
+
+// The SessionClaimFunc type is an adapter to allow the use of ordinary
+// function as SessionClaim mutator.
+type SessionClaimFunc func(context.Context, *ent.SessionClaimMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionClaimFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionClaimMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionClaimMutation", m)
+}
+
+// The SessionComparisonFunc type is an adapter to allow the use of ordinary
+// function as SessionComparison mutator.
+type SessionComparisonFunc func(context.Context, *ent.SessionComparisonMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionComparisonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionComparisonMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionComparisonMutation", m)
+}
+
+// The SessionGroupFunc type is an adapter to allow the use of ordinary
+// function as SessionGroup mutator.
+type SessionGroupFunc func(context.Context, *ent.SessionGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionGroupMutation", m)
+}
+
 // The SessionReviewActivityFunc type is an adapter to allow the use of ordinary
 // function as SessionReviewActivity mutator.
 type SessionReviewActivityFunc func(context.Context, *ent.SessionReviewActivityMutation) (ent.Value, error)
@@ -165,6 +345,18 @@ func (f TimelineEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TimelineEventMutation", m)
 }
 
+// The UserProfileFunc type is an adapter to allow the use of ordinary
+// function as UserProfile mutator.
+type UserProfileFunc func(context.Context, *ent.UserProfileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserProfileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserProfileMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
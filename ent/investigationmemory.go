@@ -18,6 +18,14 @@ type InvestigationMemory struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// API caller that created the row; null when created by the system
+	CreatedBy *string `json:"created_by,omitempty"`
+	// API caller that last changed the row
+	UpdatedBy *string `json:"updated_by,omitempty"`
 	// Project holds the value of the "project" field.
 	Project string `json:"project,omitempty"`
 	// Content holds the value of the "content" field.
@@ -36,10 +44,6 @@ type InvestigationMemory struct {
 	AlertType *string `json:"alert_type,omitempty"`
 	// ChainID holds the value of the "chain_id" field.
 	ChainID *string `json:"chain_id,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// LastSeenAt holds the value of the "last_seen_at" field.
 	LastSeenAt time.Time `json:"last_seen_at,omitempty"`
 	// Deprecated holds the value of the "deprecated" field.
@@ -92,7 +96,7 @@ func (*InvestigationMemory) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case investigationmemory.FieldSeenCount:
 			values[i] = new(sql.NullInt64)
-		case investigationmemory.FieldID, investigationmemory.FieldProject, investigationmemory.FieldContent, investigationmemory.FieldCategory, investigationmemory.FieldValence, investigationmemory.FieldSourceSessionID, investigationmemory.FieldAlertType, investigationmemory.FieldChainID:
+		case investigationmemory.FieldID, investigationmemory.FieldCreatedBy, investigationmemory.FieldUpdatedBy, investigationmemory.FieldProject, investigationmemory.FieldContent, investigationmemory.FieldCategory, investigationmemory.FieldValence, investigationmemory.FieldSourceSessionID, investigationmemory.FieldAlertType, investigationmemory.FieldChainID:
 			values[i] = new(sql.NullString)
 		case investigationmemory.FieldCreatedAt, investigationmemory.FieldUpdatedAt, investigationmemory.FieldLastSeenAt:
 			values[i] = new(sql.NullTime)
@@ -117,6 +121,32 @@ func (_m *InvestigationMemory) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case investigationmemory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case investigationmemory.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case investigationmemory.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(string)
+				*_m.CreatedBy = value.String
+			}
+		case investigationmemory.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				_m.UpdatedBy = new(string)
+				*_m.UpdatedBy = value.String
+			}
 		case investigationmemory.FieldProject:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field project", values[i])
@@ -173,18 +203,6 @@ func (_m *InvestigationMemory) assignValues(columns []string, values []any) erro
 				_m.ChainID = new(string)
 				*_m.ChainID = value.String
 			}
-		case investigationmemory.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
-			} else if value.Valid {
-				_m.CreatedAt = value.Time
-			}
-		case investigationmemory.FieldUpdatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
-			} else if value.Valid {
-				_m.UpdatedAt = value.Time
-			}
 		case investigationmemory.FieldLastSeenAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
@@ -243,6 +261,22 @@ func (_m *InvestigationMemory) String() string {
 	var builder strings.Builder
 	builder.WriteString("InvestigationMemory(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedBy; v != nil {
+		builder.WriteString("updated_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("project=")
 	builder.WriteString(_m.Project)
 	builder.WriteString(", ")
@@ -274,12 +308,6 @@ func (_m *InvestigationMemory) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
-	builder.WriteString("updated_at=")
-	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
 	builder.WriteString("last_seen_at=")
 	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -21,6 +21,62 @@ type InvestigationMemoryCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvestigationMemoryCreate) SetCreatedAt(v time.Time) *InvestigationMemoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvestigationMemoryCreate) SetNillableCreatedAt(v *time.Time) *InvestigationMemoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InvestigationMemoryCreate) SetUpdatedAt(v time.Time) *InvestigationMemoryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InvestigationMemoryCreate) SetNillableUpdatedAt(v *time.Time) *InvestigationMemoryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *InvestigationMemoryCreate) SetCreatedBy(v string) *InvestigationMemoryCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *InvestigationMemoryCreate) SetNillableCreatedBy(v *string) *InvestigationMemoryCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *InvestigationMemoryCreate) SetUpdatedBy(v string) *InvestigationMemoryCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *InvestigationMemoryCreate) SetNillableUpdatedBy(v *string) *InvestigationMemoryCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
 // SetProject sets the "project" field.
 func (_c *InvestigationMemoryCreate) SetProject(v string) *InvestigationMemoryCreate {
 	_c.mutation.SetProject(v)
@@ -115,34 +171,6 @@ func (_c *InvestigationMemoryCreate) SetNillableChainID(v *string) *Investigatio
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *InvestigationMemoryCreate) SetCreatedAt(v time.Time) *InvestigationMemoryCreate {
-	_c.mutation.SetCreatedAt(v)
-	return _c
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *InvestigationMemoryCreate) SetNillableCreatedAt(v *time.Time) *InvestigationMemoryCreate {
-	if v != nil {
-		_c.SetCreatedAt(*v)
-	}
-	return _c
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_c *InvestigationMemoryCreate) SetUpdatedAt(v time.Time) *InvestigationMemoryCreate {
-	_c.mutation.SetUpdatedAt(v)
-	return _c
-}
-
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_c *InvestigationMemoryCreate) SetNillableUpdatedAt(v *time.Time) *InvestigationMemoryCreate {
-	if v != nil {
-		_c.SetUpdatedAt(*v)
-	}
-	return _c
-}
-
 // SetLastSeenAt sets the "last_seen_at" field.
 func (_c *InvestigationMemoryCreate) SetLastSeenAt(v time.Time) *InvestigationMemoryCreate {
 	_c.mutation.SetLastSeenAt(v)
@@ -204,7 +232,9 @@ func (_c *InvestigationMemoryCreate) Mutation() *InvestigationMemoryMutation {
 
 // Save creates the InvestigationMemory in the database.
 func (_c *InvestigationMemoryCreate) Save(ctx context.Context) (*InvestigationMemory, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -231,7 +261,21 @@ func (_c *InvestigationMemoryCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *InvestigationMemoryCreate) defaults() {
+func (_c *InvestigationMemoryCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if investigationmemory.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized investigationmemory.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := investigationmemory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if investigationmemory.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized investigationmemory.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := investigationmemory.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
 	if _, ok := _c.mutation.Project(); !ok {
 		v := investigationmemory.DefaultProject
 		_c.mutation.SetProject(v)
@@ -244,15 +288,10 @@ func (_c *InvestigationMemoryCreate) defaults() {
 		v := investigationmemory.DefaultSeenCount
 		_c.mutation.SetSeenCount(v)
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		v := investigationmemory.DefaultCreatedAt()
-		_c.mutation.SetCreatedAt(v)
-	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		v := investigationmemory.DefaultUpdatedAt()
-		_c.mutation.SetUpdatedAt(v)
-	}
 	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		if investigationmemory.DefaultLastSeenAt == nil {
+			return fmt.Errorf("ent: uninitialized investigationmemory.DefaultLastSeenAt (forgotten import ent/runtime?)")
+		}
 		v := investigationmemory.DefaultLastSeenAt()
 		_c.mutation.SetLastSeenAt(v)
 	}
@@ -260,10 +299,17 @@ func (_c *InvestigationMemoryCreate) defaults() {
 		v := investigationmemory.DefaultDeprecated
 		_c.mutation.SetDeprecated(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *InvestigationMemoryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvestigationMemory.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InvestigationMemory.updated_at"`)}
+	}
 	if _, ok := _c.mutation.Project(); !ok {
 		return &ValidationError{Name: "project", err: errors.New(`ent: missing required field "InvestigationMemory.project"`)}
 	}
@@ -320,12 +366,6 @@ func (_c *InvestigationMemoryCreate) check() error {
 			return &ValidationError{Name: "source_session_id", err: fmt.Errorf(`ent: validator failed for field "InvestigationMemory.source_session_id": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InvestigationMemory.created_at"`)}
-	}
-	if _, ok := _c.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InvestigationMemory.updated_at"`)}
-	}
 	if _, ok := _c.mutation.LastSeenAt(); !ok {
 		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "InvestigationMemory.last_seen_at"`)}
 	}
@@ -370,6 +410,22 @@ func (_c *InvestigationMemoryCreate) createSpec() (*InvestigationMemory, *sqlgra
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(investigationmemory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(investigationmemory.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(investigationmemory.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(investigationmemory.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = &value
+	}
 	if value, ok := _c.mutation.Project(); ok {
 		_spec.SetField(investigationmemory.FieldProject, field.TypeString, value)
 		_node.Project = value
@@ -402,14 +458,6 @@ func (_c *InvestigationMemoryCreate) createSpec() (*InvestigationMemory, *sqlgra
 		_spec.SetField(investigationmemory.FieldChainID, field.TypeString, value)
 		_node.ChainID = &value
 	}
-	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(investigationmemory.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
-	}
-	if value, ok := _c.mutation.UpdatedAt(); ok {
-		_spec.SetField(investigationmemory.FieldUpdatedAt, field.TypeTime, value)
-		_node.UpdatedAt = value
-	}
 	if value, ok := _c.mutation.LastSeenAt(); ok {
 		_spec.SetField(investigationmemory.FieldLastSeenAt, field.TypeTime, value)
 		_node.LastSeenAt = value
@@ -337,12 +337,12 @@ func (_q *InvestigationMemoryQuery) WithInjectedIntoSessions(opts ...func(*Alert
 // Example:
 //
 //	var v []struct {
-//		Project string `json:"project,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.InvestigationMemory.Query().
-//		GroupBy(investigationmemory.FieldProject).
+//		GroupBy(investigationmemory.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *InvestigationMemoryQuery) GroupBy(field string, fields ...string) *InvestigationMemoryGroupBy {
@@ -360,11 +360,11 @@ func (_q *InvestigationMemoryQuery) GroupBy(field string, fields ...string) *Inv
 // Example:
 //
 //	var v []struct {
-//		Project string `json:"project,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.InvestigationMemory.Query().
-//		Select(investigationmemory.FieldProject).
+//		Select(investigationmemory.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *InvestigationMemoryQuery) Select(fields ...string) *InvestigationMemorySelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -30,6 +30,52 @@ func (_u *InvestigationMemoryUpdate) Where(ps ...predicate.InvestigationMemory)
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvestigationMemoryUpdate) SetUpdatedAt(v time.Time) *InvestigationMemoryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *InvestigationMemoryUpdate) SetCreatedBy(v string) *InvestigationMemoryUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *InvestigationMemoryUpdate) SetNillableCreatedBy(v *string) *InvestigationMemoryUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *InvestigationMemoryUpdate) ClearCreatedBy() *InvestigationMemoryUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *InvestigationMemoryUpdate) SetUpdatedBy(v string) *InvestigationMemoryUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *InvestigationMemoryUpdate) SetNillableUpdatedBy(v *string) *InvestigationMemoryUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *InvestigationMemoryUpdate) ClearUpdatedBy() *InvestigationMemoryUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
 // SetProject sets the "project" field.
 func (_u *InvestigationMemoryUpdate) SetProject(v string) *InvestigationMemoryUpdate {
 	_u.mutation.SetProject(v)
@@ -182,12 +228,6 @@ func (_u *InvestigationMemoryUpdate) ClearChainID() *InvestigationMemoryUpdate {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *InvestigationMemoryUpdate) SetUpdatedAt(v time.Time) *InvestigationMemoryUpdate {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
 // SetLastSeenAt sets the "last_seen_at" field.
 func (_u *InvestigationMemoryUpdate) SetLastSeenAt(v time.Time) *InvestigationMemoryUpdate {
 	_u.mutation.SetLastSeenAt(v)
@@ -270,7 +310,9 @@ func (_u *InvestigationMemoryUpdate) RemoveInjectedIntoSessions(v ...*AlertSessi
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *InvestigationMemoryUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -297,11 +339,15 @@ func (_u *InvestigationMemoryUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *InvestigationMemoryUpdate) defaults() {
+func (_u *InvestigationMemoryUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if investigationmemory.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized investigationmemory.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := investigationmemory.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -365,6 +411,21 @@ func (_u *InvestigationMemoryUpdate) sqlSave(ctx context.Context) (_node int, er
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(investigationmemory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(investigationmemory.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(investigationmemory.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(investigationmemory.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(investigationmemory.FieldUpdatedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Project(); ok {
 		_spec.SetField(investigationmemory.FieldProject, field.TypeString, value)
 	}
@@ -401,9 +462,6 @@ func (_u *InvestigationMemoryUpdate) sqlSave(ctx context.Context) (_node int, er
 	if _u.mutation.ChainIDCleared() {
 		_spec.ClearField(investigationmemory.FieldChainID, field.TypeString)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(investigationmemory.FieldUpdatedAt, field.TypeTime, value)
-	}
 	if value, ok := _u.mutation.LastSeenAt(); ok {
 		_spec.SetField(investigationmemory.FieldLastSeenAt, field.TypeTime, value)
 	}
@@ -506,6 +564,52 @@ type InvestigationMemoryUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InvestigationMemoryUpdateOne) SetUpdatedAt(v time.Time) *InvestigationMemoryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *InvestigationMemoryUpdateOne) SetCreatedBy(v string) *InvestigationMemoryUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *InvestigationMemoryUpdateOne) SetNillableCreatedBy(v *string) *InvestigationMemoryUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *InvestigationMemoryUpdateOne) ClearCreatedBy() *InvestigationMemoryUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *InvestigationMemoryUpdateOne) SetUpdatedBy(v string) *InvestigationMemoryUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *InvestigationMemoryUpdateOne) SetNillableUpdatedBy(v *string) *InvestigationMemoryUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *InvestigationMemoryUpdateOne) ClearUpdatedBy() *InvestigationMemoryUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
 // SetProject sets the "project" field.
 func (_u *InvestigationMemoryUpdateOne) SetProject(v string) *InvestigationMemoryUpdateOne {
 	_u.mutation.SetProject(v)
@@ -658,12 +762,6 @@ func (_u *InvestigationMemoryUpdateOne) ClearChainID() *InvestigationMemoryUpdat
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *InvestigationMemoryUpdateOne) SetUpdatedAt(v time.Time) *InvestigationMemoryUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
 // SetLastSeenAt sets the "last_seen_at" field.
 func (_u *InvestigationMemoryUpdateOne) SetLastSeenAt(v time.Time) *InvestigationMemoryUpdateOne {
 	_u.mutation.SetLastSeenAt(v)
@@ -759,7 +857,9 @@ func (_u *InvestigationMemoryUpdateOne) Select(field string, fields ...string) *
 
 // Save executes the query and returns the updated InvestigationMemory entity.
 func (_u *InvestigationMemoryUpdateOne) Save(ctx context.Context) (*InvestigationMemory, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -786,11 +886,15 @@ func (_u *InvestigationMemoryUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *InvestigationMemoryUpdateOne) defaults() {
+func (_u *InvestigationMemoryUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if investigationmemory.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized investigationmemory.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := investigationmemory.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -871,6 +975,21 @@ func (_u *InvestigationMemoryUpdateOne) sqlSave(ctx context.Context) (_node *Inv
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(investigationmemory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(investigationmemory.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(investigationmemory.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(investigationmemory.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(investigationmemory.FieldUpdatedBy, field.TypeString)
+	}
 	if value, ok := _u.mutation.Project(); ok {
 		_spec.SetField(investigationmemory.FieldProject, field.TypeString, value)
 	}
@@ -907,9 +1026,6 @@ func (_u *InvestigationMemoryUpdateOne) sqlSave(ctx context.Context) (_node *Inv
 	if _u.mutation.ChainIDCleared() {
 		_spec.ClearField(investigationmemory.FieldChainID, field.TypeString)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(investigationmemory.FieldUpdatedAt, field.TypeTime, value)
-	}
 	if value, ok := _u.mutation.LastSeenAt(); ok {
 		_spec.SetField(investigationmemory.FieldLastSeenAt, field.TypeTime, value)
 	}
@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/jobleader"
+)
+
+// JobLeader is the model entity for the JobLeader schema.
+type JobLeader struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Singleton job name, e.g. 'retention-cleanup'
+	ID string `json:"id,omitempty"`
+	// PodID holds the value of the "pod_id" field.
+	PodID string `json:"pod_id,omitempty"`
+	// AcquiredAt holds the value of the "acquired_at" field.
+	AcquiredAt time.Time `json:"acquired_at,omitempty"`
+	// RenewedAt holds the value of the "renewed_at" field.
+	RenewedAt    time.Time `json:"renewed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobLeader) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobleader.FieldID, jobleader.FieldPodID:
+			values[i] = new(sql.NullString)
+		case jobleader.FieldAcquiredAt, jobleader.FieldRenewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobLeader fields.
+func (_m *JobLeader) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobleader.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case jobleader.FieldPodID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pod_id", values[i])
+			} else if value.Valid {
+				_m.PodID = value.String
+			}
+		case jobleader.FieldAcquiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acquired_at", values[i])
+			} else if value.Valid {
+				_m.AcquiredAt = value.Time
+			}
+		case jobleader.FieldRenewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field renewed_at", values[i])
+			} else if value.Valid {
+				_m.RenewedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobLeader.
+// This includes values selected through modifiers, order, etc.
+func (_m *JobLeader) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JobLeader.
+// Note that you need to call JobLeader.Unwrap() before calling this method if this JobLeader
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *JobLeader) Update() *JobLeaderUpdateOne {
+	return NewJobLeaderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the JobLeader entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *JobLeader) Unwrap() *JobLeader {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JobLeader is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *JobLeader) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobLeader(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("pod_id=")
+	builder.WriteString(_m.PodID)
+	builder.WriteString(", ")
+	builder.WriteString("acquired_at=")
+	builder.WriteString(_m.AcquiredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("renewed_at=")
+	builder.WriteString(_m.RenewedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobLeaders is a parsable slice of JobLeader.
+type JobLeaders []*JobLeader
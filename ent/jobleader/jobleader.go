@@ -0,0 +1,70 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobleader
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the jobleader type in the database.
+	Label = "job_leader"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "job"
+	// FieldPodID holds the string denoting the pod_id field in the database.
+	FieldPodID = "pod_id"
+	// FieldAcquiredAt holds the string denoting the acquired_at field in the database.
+	FieldAcquiredAt = "acquired_at"
+	// FieldRenewedAt holds the string denoting the renewed_at field in the database.
+	FieldRenewedAt = "renewed_at"
+	// Table holds the table name of the jobleader in the database.
+	Table = "job_leaders"
+)
+
+// Columns holds all SQL columns for jobleader fields.
+var Columns = []string{
+	FieldID,
+	FieldPodID,
+	FieldAcquiredAt,
+	FieldRenewedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRenewedAt holds the default value on creation for the "renewed_at" field.
+	DefaultRenewedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the JobLeader queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPodID orders the results by the pod_id field.
+func ByPodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPodID, opts...).ToFunc()
+}
+
+// ByAcquiredAt orders the results by the acquired_at field.
+func ByAcquiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcquiredAt, opts...).ToFunc()
+}
+
+// ByRenewedAt orders the results by the renewed_at field.
+func ByRenewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenewedAt, opts...).ToFunc()
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jobleader
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldContainsFold(FieldID, id))
+}
+
+// PodID applies equality check predicate on the "pod_id" field. It's identical to PodIDEQ.
+func PodID(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEQ(FieldPodID, v))
+}
+
+// AcquiredAt applies equality check predicate on the "acquired_at" field. It's identical to AcquiredAtEQ.
+func AcquiredAt(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEQ(FieldAcquiredAt, v))
+}
+
+// RenewedAt applies equality check predicate on the "renewed_at" field. It's identical to RenewedAtEQ.
+func RenewedAt(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEQ(FieldRenewedAt, v))
+}
+
+// PodIDEQ applies the EQ predicate on the "pod_id" field.
+func PodIDEQ(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEQ(FieldPodID, v))
+}
+
+// PodIDNEQ applies the NEQ predicate on the "pod_id" field.
+func PodIDNEQ(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldNEQ(FieldPodID, v))
+}
+
+// PodIDIn applies the In predicate on the "pod_id" field.
+func PodIDIn(vs ...string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldIn(FieldPodID, vs...))
+}
+
+// PodIDNotIn applies the NotIn predicate on the "pod_id" field.
+func PodIDNotIn(vs ...string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldNotIn(FieldPodID, vs...))
+}
+
+// PodIDGT applies the GT predicate on the "pod_id" field.
+func PodIDGT(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldGT(FieldPodID, v))
+}
+
+// PodIDGTE applies the GTE predicate on the "pod_id" field.
+func PodIDGTE(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldGTE(FieldPodID, v))
+}
+
+// PodIDLT applies the LT predicate on the "pod_id" field.
+func PodIDLT(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldLT(FieldPodID, v))
+}
+
+// PodIDLTE applies the LTE predicate on the "pod_id" field.
+func PodIDLTE(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldLTE(FieldPodID, v))
+}
+
+// PodIDContains applies the Contains predicate on the "pod_id" field.
+func PodIDContains(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldContains(FieldPodID, v))
+}
+
+// PodIDHasPrefix applies the HasPrefix predicate on the "pod_id" field.
+func PodIDHasPrefix(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldHasPrefix(FieldPodID, v))
+}
+
+// PodIDHasSuffix applies the HasSuffix predicate on the "pod_id" field.
+func PodIDHasSuffix(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldHasSuffix(FieldPodID, v))
+}
+
+// PodIDEqualFold applies the EqualFold predicate on the "pod_id" field.
+func PodIDEqualFold(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEqualFold(FieldPodID, v))
+}
+
+// PodIDContainsFold applies the ContainsFold predicate on the "pod_id" field.
+func PodIDContainsFold(v string) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldContainsFold(FieldPodID, v))
+}
+
+// AcquiredAtEQ applies the EQ predicate on the "acquired_at" field.
+func AcquiredAtEQ(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEQ(FieldAcquiredAt, v))
+}
+
+// AcquiredAtNEQ applies the NEQ predicate on the "acquired_at" field.
+func AcquiredAtNEQ(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldNEQ(FieldAcquiredAt, v))
+}
+
+// AcquiredAtIn applies the In predicate on the "acquired_at" field.
+func AcquiredAtIn(vs ...time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldIn(FieldAcquiredAt, vs...))
+}
+
+// AcquiredAtNotIn applies the NotIn predicate on the "acquired_at" field.
+func AcquiredAtNotIn(vs ...time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldNotIn(FieldAcquiredAt, vs...))
+}
+
+// AcquiredAtGT applies the GT predicate on the "acquired_at" field.
+func AcquiredAtGT(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldGT(FieldAcquiredAt, v))
+}
+
+// AcquiredAtGTE applies the GTE predicate on the "acquired_at" field.
+func AcquiredAtGTE(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldGTE(FieldAcquiredAt, v))
+}
+
+// AcquiredAtLT applies the LT predicate on the "acquired_at" field.
+func AcquiredAtLT(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldLT(FieldAcquiredAt, v))
+}
+
+// AcquiredAtLTE applies the LTE predicate on the "acquired_at" field.
+func AcquiredAtLTE(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldLTE(FieldAcquiredAt, v))
+}
+
+// RenewedAtEQ applies the EQ predicate on the "renewed_at" field.
+func RenewedAtEQ(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldEQ(FieldRenewedAt, v))
+}
+
+// RenewedAtNEQ applies the NEQ predicate on the "renewed_at" field.
+func RenewedAtNEQ(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldNEQ(FieldRenewedAt, v))
+}
+
+// RenewedAtIn applies the In predicate on the "renewed_at" field.
+func RenewedAtIn(vs ...time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldIn(FieldRenewedAt, vs...))
+}
+
+// RenewedAtNotIn applies the NotIn predicate on the "renewed_at" field.
+func RenewedAtNotIn(vs ...time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldNotIn(FieldRenewedAt, vs...))
+}
+
+// RenewedAtGT applies the GT predicate on the "renewed_at" field.
+func RenewedAtGT(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldGT(FieldRenewedAt, v))
+}
+
+// RenewedAtGTE applies the GTE predicate on the "renewed_at" field.
+func RenewedAtGTE(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldGTE(FieldRenewedAt, v))
+}
+
+// RenewedAtLT applies the LT predicate on the "renewed_at" field.
+func RenewedAtLT(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldLT(FieldRenewedAt, v))
+}
+
+// RenewedAtLTE applies the LTE predicate on the "renewed_at" field.
+func RenewedAtLTE(v time.Time) predicate.JobLeader {
+	return predicate.JobLeader(sql.FieldLTE(FieldRenewedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JobLeader) predicate.JobLeader {
+	return predicate.JobLeader(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JobLeader) predicate.JobLeader {
+	return predicate.JobLeader(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JobLeader) predicate.JobLeader {
+	return predicate.JobLeader(sql.NotPredicates(p))
+}
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/jobleader"
+)
+
+// JobLeaderCreate is the builder for creating a JobLeader entity.
+type JobLeaderCreate struct {
+	config
+	mutation *JobLeaderMutation
+	hooks    []Hook
+}
+
+// SetPodID sets the "pod_id" field.
+func (_c *JobLeaderCreate) SetPodID(v string) *JobLeaderCreate {
+	_c.mutation.SetPodID(v)
+	return _c
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (_c *JobLeaderCreate) SetAcquiredAt(v time.Time) *JobLeaderCreate {
+	_c.mutation.SetAcquiredAt(v)
+	return _c
+}
+
+// SetRenewedAt sets the "renewed_at" field.
+func (_c *JobLeaderCreate) SetRenewedAt(v time.Time) *JobLeaderCreate {
+	_c.mutation.SetRenewedAt(v)
+	return _c
+}
+
+// SetNillableRenewedAt sets the "renewed_at" field if the given value is not nil.
+func (_c *JobLeaderCreate) SetNillableRenewedAt(v *time.Time) *JobLeaderCreate {
+	if v != nil {
+		_c.SetRenewedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *JobLeaderCreate) SetID(v string) *JobLeaderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the JobLeaderMutation object of the builder.
+func (_c *JobLeaderCreate) Mutation() *JobLeaderMutation {
+	return _c.mutation
+}
+
+// Save creates the JobLeader in the database.
+func (_c *JobLeaderCreate) Save(ctx context.Context) (*JobLeader, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *JobLeaderCreate) SaveX(ctx context.Context) *JobLeader {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobLeaderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobLeaderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *JobLeaderCreate) defaults() {
+	if _, ok := _c.mutation.RenewedAt(); !ok {
+		v := jobleader.DefaultRenewedAt()
+		_c.mutation.SetRenewedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *JobLeaderCreate) check() error {
+	if _, ok := _c.mutation.PodID(); !ok {
+		return &ValidationError{Name: "pod_id", err: errors.New(`ent: missing required field "JobLeader.pod_id"`)}
+	}
+	if _, ok := _c.mutation.AcquiredAt(); !ok {
+		return &ValidationError{Name: "acquired_at", err: errors.New(`ent: missing required field "JobLeader.acquired_at"`)}
+	}
+	if _, ok := _c.mutation.RenewedAt(); !ok {
+		return &ValidationError{Name: "renewed_at", err: errors.New(`ent: missing required field "JobLeader.renewed_at"`)}
+	}
+	return nil
+}
+
+func (_c *JobLeaderCreate) sqlSave(ctx context.Context) (*JobLeader, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected JobLeader.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *JobLeaderCreate) createSpec() (*JobLeader, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobLeader{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(jobleader.Table, sqlgraph.NewFieldSpec(jobleader.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.PodID(); ok {
+		_spec.SetField(jobleader.FieldPodID, field.TypeString, value)
+		_node.PodID = value
+	}
+	if value, ok := _c.mutation.AcquiredAt(); ok {
+		_spec.SetField(jobleader.FieldAcquiredAt, field.TypeTime, value)
+		_node.AcquiredAt = value
+	}
+	if value, ok := _c.mutation.RenewedAt(); ok {
+		_spec.SetField(jobleader.FieldRenewedAt, field.TypeTime, value)
+		_node.RenewedAt = value
+	}
+	return _node, _spec
+}
+
+// JobLeaderCreateBulk is the builder for creating many JobLeader entities in bulk.
+type JobLeaderCreateBulk struct {
+	config
+	err      error
+	builders []*JobLeaderCreate
+}
+
+// Save creates the JobLeader entities in the database.
+func (_c *JobLeaderCreateBulk) Save(ctx context.Context) ([]*JobLeader, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*JobLeader, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobLeaderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *JobLeaderCreateBulk) SaveX(ctx context.Context) []*JobLeader {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *JobLeaderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *JobLeaderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
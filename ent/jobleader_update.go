@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/jobleader"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// JobLeaderUpdate is the builder for updating JobLeader entities.
+type JobLeaderUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *JobLeaderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the JobLeaderUpdate builder.
+func (_u *JobLeaderUpdate) Where(ps ...predicate.JobLeader) *JobLeaderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPodID sets the "pod_id" field.
+func (_u *JobLeaderUpdate) SetPodID(v string) *JobLeaderUpdate {
+	_u.mutation.SetPodID(v)
+	return _u
+}
+
+// SetNillablePodID sets the "pod_id" field if the given value is not nil.
+func (_u *JobLeaderUpdate) SetNillablePodID(v *string) *JobLeaderUpdate {
+	if v != nil {
+		_u.SetPodID(*v)
+	}
+	return _u
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (_u *JobLeaderUpdate) SetAcquiredAt(v time.Time) *JobLeaderUpdate {
+	_u.mutation.SetAcquiredAt(v)
+	return _u
+}
+
+// SetNillableAcquiredAt sets the "acquired_at" field if the given value is not nil.
+func (_u *JobLeaderUpdate) SetNillableAcquiredAt(v *time.Time) *JobLeaderUpdate {
+	if v != nil {
+		_u.SetAcquiredAt(*v)
+	}
+	return _u
+}
+
+// SetRenewedAt sets the "renewed_at" field.
+func (_u *JobLeaderUpdate) SetRenewedAt(v time.Time) *JobLeaderUpdate {
+	_u.mutation.SetRenewedAt(v)
+	return _u
+}
+
+// SetNillableRenewedAt sets the "renewed_at" field if the given value is not nil.
+func (_u *JobLeaderUpdate) SetNillableRenewedAt(v *time.Time) *JobLeaderUpdate {
+	if v != nil {
+		_u.SetRenewedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the JobLeaderMutation object of the builder.
+func (_u *JobLeaderUpdate) Mutation() *JobLeaderMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *JobLeaderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobLeaderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *JobLeaderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobLeaderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *JobLeaderUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobLeaderUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *JobLeaderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobleader.Table, jobleader.Columns, sqlgraph.NewFieldSpec(jobleader.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PodID(); ok {
+		_spec.SetField(jobleader.FieldPodID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AcquiredAt(); ok {
+		_spec.SetField(jobleader.FieldAcquiredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RenewedAt(); ok {
+		_spec.SetField(jobleader.FieldRenewedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobleader.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// JobLeaderUpdateOne is the builder for updating a single JobLeader entity.
+type JobLeaderUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *JobLeaderMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPodID sets the "pod_id" field.
+func (_u *JobLeaderUpdateOne) SetPodID(v string) *JobLeaderUpdateOne {
+	_u.mutation.SetPodID(v)
+	return _u
+}
+
+// SetNillablePodID sets the "pod_id" field if the given value is not nil.
+func (_u *JobLeaderUpdateOne) SetNillablePodID(v *string) *JobLeaderUpdateOne {
+	if v != nil {
+		_u.SetPodID(*v)
+	}
+	return _u
+}
+
+// SetAcquiredAt sets the "acquired_at" field.
+func (_u *JobLeaderUpdateOne) SetAcquiredAt(v time.Time) *JobLeaderUpdateOne {
+	_u.mutation.SetAcquiredAt(v)
+	return _u
+}
+
+// SetNillableAcquiredAt sets the "acquired_at" field if the given value is not nil.
+func (_u *JobLeaderUpdateOne) SetNillableAcquiredAt(v *time.Time) *JobLeaderUpdateOne {
+	if v != nil {
+		_u.SetAcquiredAt(*v)
+	}
+	return _u
+}
+
+// SetRenewedAt sets the "renewed_at" field.
+func (_u *JobLeaderUpdateOne) SetRenewedAt(v time.Time) *JobLeaderUpdateOne {
+	_u.mutation.SetRenewedAt(v)
+	return _u
+}
+
+// SetNillableRenewedAt sets the "renewed_at" field if the given value is not nil.
+func (_u *JobLeaderUpdateOne) SetNillableRenewedAt(v *time.Time) *JobLeaderUpdateOne {
+	if v != nil {
+		_u.SetRenewedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the JobLeaderMutation object of the builder.
+func (_u *JobLeaderUpdateOne) Mutation() *JobLeaderMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the JobLeaderUpdate builder.
+func (_u *JobLeaderUpdateOne) Where(ps ...predicate.JobLeader) *JobLeaderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *JobLeaderUpdateOne) Select(field string, fields ...string) *JobLeaderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated JobLeader entity.
+func (_u *JobLeaderUpdateOne) Save(ctx context.Context) (*JobLeader, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *JobLeaderUpdateOne) SaveX(ctx context.Context) *JobLeader {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *JobLeaderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *JobLeaderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *JobLeaderUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *JobLeaderUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *JobLeaderUpdateOne) sqlSave(ctx context.Context) (_node *JobLeader, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jobleader.Table, jobleader.Columns, sqlgraph.NewFieldSpec(jobleader.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobLeader.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobleader.FieldID)
+		for _, f := range fields {
+			if !jobleader.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobleader.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PodID(); ok {
+		_spec.SetField(jobleader.FieldPodID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AcquiredAt(); ok {
+		_spec.SetField(jobleader.FieldAcquiredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RenewedAt(); ok {
+		_spec.SetField(jobleader.FieldRenewedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &JobLeader{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobleader.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
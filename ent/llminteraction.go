@@ -22,14 +22,14 @@ type LLMInteraction struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// SessionID holds the value of the "session_id" field.
 	SessionID string `json:"session_id,omitempty"`
 	// Nil for session-level interactions (e.g. executive summary)
 	StageID *string `json:"stage_id,omitempty"`
 	// Which agent; nil for session-level interactions
 	ExecutionID *string `json:"execution_id,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
 	// InteractionType holds the value of the "interaction_type" field.
 	InteractionType llminteraction.InteractionType `json:"interaction_type,omitempty"`
 	// e.g., 'gemini-2.0-flash-thinking-exp'
@@ -40,6 +40,8 @@ type LLMInteraction struct {
 	LlmRequest map[string]interface{} `json:"llm_request,omitempty"`
 	// Full API response payload
 	LlmResponse map[string]interface{} `json:"llm_response,omitempty"`
+	// zstd-compressed {llm_request, llm_response} for large payloads; both columns are empty objects when set
+	PayloadZstd *[]byte `json:"payload_zstd,omitempty"`
 	// Native thinking (Gemini)
 	ThinkingContent *string `json:"thinking_content,omitempty"`
 	// Grounding, tool usage, etc.
@@ -58,6 +60,10 @@ type LLMInteraction struct {
 	DurationMs *int `json:"duration_ms,omitempty"`
 	// null = success, not-null = failed
 	ErrorMessage *string `json:"error_message,omitempty"`
+	// X-Request-ID of the API call that triggered this work
+	RequestID *string `json:"request_id,omitempty"`
+	// Team charged for the call (agent owner, else chain owner); null = unattributed
+	Owner *string `json:"owner,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LLMInteractionQuery when eager-loading is set.
 	Edges        LLMInteractionEdges `json:"edges"`
@@ -139,13 +145,13 @@ func (*LLMInteraction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case llminteraction.FieldLlmRequest, llminteraction.FieldLlmResponse, llminteraction.FieldResponseMetadata:
+		case llminteraction.FieldLlmRequest, llminteraction.FieldLlmResponse, llminteraction.FieldPayloadZstd, llminteraction.FieldResponseMetadata:
 			values[i] = new([]byte)
 		case llminteraction.FieldEstimatedCostUsd:
 			values[i] = new(sql.NullFloat64)
 		case llminteraction.FieldInputTokens, llminteraction.FieldOutputTokens, llminteraction.FieldTotalTokens, llminteraction.FieldThinkingTokens, llminteraction.FieldDurationMs:
 			values[i] = new(sql.NullInt64)
-		case llminteraction.FieldID, llminteraction.FieldSessionID, llminteraction.FieldStageID, llminteraction.FieldExecutionID, llminteraction.FieldInteractionType, llminteraction.FieldModelName, llminteraction.FieldLastMessageID, llminteraction.FieldThinkingContent, llminteraction.FieldErrorMessage:
+		case llminteraction.FieldID, llminteraction.FieldSessionID, llminteraction.FieldStageID, llminteraction.FieldExecutionID, llminteraction.FieldInteractionType, llminteraction.FieldModelName, llminteraction.FieldLastMessageID, llminteraction.FieldThinkingContent, llminteraction.FieldErrorMessage, llminteraction.FieldRequestID, llminteraction.FieldOwner:
 			values[i] = new(sql.NullString)
 		case llminteraction.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -170,6 +176,12 @@ func (_m *LLMInteraction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case llminteraction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
 		case llminteraction.FieldSessionID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field session_id", values[i])
@@ -190,12 +202,6 @@ func (_m *LLMInteraction) assignValues(columns []string, values []any) error {
 				_m.ExecutionID = new(string)
 				*_m.ExecutionID = value.String
 			}
-		case llminteraction.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
-			} else if value.Valid {
-				_m.CreatedAt = value.Time
-			}
 		case llminteraction.FieldInteractionType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field interaction_type", values[i])
@@ -231,6 +237,12 @@ func (_m *LLMInteraction) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field llm_response: %w", err)
 				}
 			}
+		case llminteraction.FieldPayloadZstd:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload_zstd", values[i])
+			} else if value != nil {
+				_m.PayloadZstd = value
+			}
 		case llminteraction.FieldThinkingContent:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field thinking_content", values[i])
@@ -295,6 +307,20 @@ func (_m *LLMInteraction) assignValues(columns []string, values []any) error {
 				_m.ErrorMessage = new(string)
 				*_m.ErrorMessage = value.String
 			}
+		case llminteraction.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = new(string)
+				*_m.RequestID = value.String
+			}
+		case llminteraction.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = new(string)
+				*_m.Owner = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -356,6 +382,9 @@ func (_m *LLMInteraction) String() string {
 	var builder strings.Builder
 	builder.WriteString("LLMInteraction(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("session_id=")
 	builder.WriteString(_m.SessionID)
 	builder.WriteString(", ")
@@ -369,9 +398,6 @@ func (_m *LLMInteraction) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
 	builder.WriteString("interaction_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.InteractionType))
 	builder.WriteString(", ")
@@ -389,6 +415,11 @@ func (_m *LLMInteraction) String() string {
 	builder.WriteString("llm_response=")
 	builder.WriteString(fmt.Sprintf("%v", _m.LlmResponse))
 	builder.WriteString(", ")
+	if v := _m.PayloadZstd; v != nil {
+		builder.WriteString("payload_zstd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.ThinkingContent; v != nil {
 		builder.WriteString("thinking_content=")
 		builder.WriteString(*v)
@@ -431,6 +462,16 @@ func (_m *LLMInteraction) String() string {
 		builder.WriteString("error_message=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.RequestID; v != nil {
+		builder.WriteString("request_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Owner; v != nil {
+		builder.WriteString("owner=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
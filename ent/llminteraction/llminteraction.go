@@ -15,14 +15,14 @@ const (
 	Label = "llm_interaction"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "interaction_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// FieldSessionID holds the string denoting the session_id field in the database.
 	FieldSessionID = "session_id"
 	// FieldStageID holds the string denoting the stage_id field in the database.
 	FieldStageID = "stage_id"
 	// FieldExecutionID holds the string denoting the execution_id field in the database.
 	FieldExecutionID = "execution_id"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
 	// FieldInteractionType holds the string denoting the interaction_type field in the database.
 	FieldInteractionType = "interaction_type"
 	// FieldModelName holds the string denoting the model_name field in the database.
@@ -33,6 +33,8 @@ const (
 	FieldLlmRequest = "llm_request"
 	// FieldLlmResponse holds the string denoting the llm_response field in the database.
 	FieldLlmResponse = "llm_response"
+	// FieldPayloadZstd holds the string denoting the payload_zstd field in the database.
+	FieldPayloadZstd = "payload_zstd"
 	// FieldThinkingContent holds the string denoting the thinking_content field in the database.
 	FieldThinkingContent = "thinking_content"
 	// FieldResponseMetadata holds the string denoting the response_metadata field in the database.
@@ -51,6 +53,10 @@ const (
 	FieldDurationMs = "duration_ms"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
 	FieldErrorMessage = "error_message"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
 	// EdgeSession holds the string denoting the session edge name in mutations.
 	EdgeSession = "session"
 	// EdgeStage holds the string denoting the stage edge name in mutations.
@@ -113,15 +119,16 @@ const (
 // Columns holds all SQL columns for llminteraction fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
 	FieldSessionID,
 	FieldStageID,
 	FieldExecutionID,
-	FieldCreatedAt,
 	FieldInteractionType,
 	FieldModelName,
 	FieldLastMessageID,
 	FieldLlmRequest,
 	FieldLlmResponse,
+	FieldPayloadZstd,
 	FieldThinkingContent,
 	FieldResponseMetadata,
 	FieldInputTokens,
@@ -131,6 +138,8 @@ var Columns = []string{
 	FieldEstimatedCostUsd,
 	FieldDurationMs,
 	FieldErrorMessage,
+	FieldRequestID,
+	FieldOwner,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -153,15 +162,22 @@ type InteractionType string
 
 // InteractionType values.
 const (
-	InteractionTypeIteration        InteractionType = "iteration"
-	InteractionTypeFinalAnalysis    InteractionType = "final_analysis"
-	InteractionTypeExecutiveSummary InteractionType = "executive_summary"
-	InteractionTypeChatResponse     InteractionType = "chat_response"
-	InteractionTypeSummarization    InteractionType = "summarization"
-	InteractionTypeSynthesis        InteractionType = "synthesis"
-	InteractionTypeForcedConclusion InteractionType = "forced_conclusion"
-	InteractionTypeScoring          InteractionType = "scoring"
-	InteractionTypeMemoryExtraction InteractionType = "memory_extraction"
+	InteractionTypeIteration             InteractionType = "iteration"
+	InteractionTypeFinalAnalysis         InteractionType = "final_analysis"
+	InteractionTypeExecutiveSummary      InteractionType = "executive_summary"
+	InteractionTypeTechnicalSummary      InteractionType = "technical_summary"
+	InteractionTypeChatResponse          InteractionType = "chat_response"
+	InteractionTypeSummarization         InteractionType = "summarization"
+	InteractionTypeSynthesis             InteractionType = "synthesis"
+	InteractionTypeForcedConclusion      InteractionType = "forced_conclusion"
+	InteractionTypeScoring               InteractionType = "scoring"
+	InteractionTypeMemoryExtraction      InteractionType = "memory_extraction"
+	InteractionTypeOutcomeClassification InteractionType = "outcome_classification"
+	InteractionTypeActionItemExtraction  InteractionType = "action_item_extraction"
+	InteractionTypeRecurrenceComparison  InteractionType = "recurrence_comparison"
+	InteractionTypeFanoutSynthesis       InteractionType = "fanout_synthesis"
+	InteractionTypeNoiseTriage           InteractionType = "noise_triage"
+	InteractionTypeModelRouting          InteractionType = "model_routing"
 )
 
 func (it InteractionType) String() string {
@@ -171,7 +187,7 @@ func (it InteractionType) String() string {
 // InteractionTypeValidator is a validator for the "interaction_type" field enum values. It is called by the builders before save.
 func InteractionTypeValidator(it InteractionType) error {
 	switch it {
-	case InteractionTypeIteration, InteractionTypeFinalAnalysis, InteractionTypeExecutiveSummary, InteractionTypeChatResponse, InteractionTypeSummarization, InteractionTypeSynthesis, InteractionTypeForcedConclusion, InteractionTypeScoring, InteractionTypeMemoryExtraction:
+	case InteractionTypeIteration, InteractionTypeFinalAnalysis, InteractionTypeExecutiveSummary, InteractionTypeTechnicalSummary, InteractionTypeChatResponse, InteractionTypeSummarization, InteractionTypeSynthesis, InteractionTypeForcedConclusion, InteractionTypeScoring, InteractionTypeMemoryExtraction, InteractionTypeOutcomeClassification, InteractionTypeActionItemExtraction, InteractionTypeRecurrenceComparison, InteractionTypeFanoutSynthesis, InteractionTypeNoiseTriage, InteractionTypeModelRouting:
 		return nil
 	default:
 		return fmt.Errorf("llminteraction: invalid enum value for interaction_type field: %q", it)
@@ -186,6 +202,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
 // BySessionID orders the results by the session_id field.
 func BySessionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
@@ -201,11 +222,6 @@ func ByExecutionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExecutionID, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
-}
-
 // ByInteractionType orders the results by the interaction_type field.
 func ByInteractionType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInteractionType, opts...).ToFunc()
@@ -261,6 +277,16 @@ func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
 }
 
+// ByRequestID orders the results by the request_id field.
+func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
 // BySessionField orders the results by session field.
 func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -65,6 +65,11 @@ func IDContainsFold(id string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldContainsFold(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
 // SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
 func SessionID(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldSessionID, v))
@@ -80,11 +85,6 @@ func ExecutionID(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldExecutionID, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldEQ(FieldCreatedAt, v))
-}
-
 // ModelName applies equality check predicate on the "model_name" field. It's identical to ModelNameEQ.
 func ModelName(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldModelName, v))
@@ -95,6 +95,11 @@ func LastMessageID(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldLastMessageID, v))
 }
 
+// PayloadZstd applies equality check predicate on the "payload_zstd" field. It's identical to PayloadZstdEQ.
+func PayloadZstd(v []byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEQ(FieldPayloadZstd, v))
+}
+
 // ThinkingContent applies equality check predicate on the "thinking_content" field. It's identical to ThinkingContentEQ.
 func ThinkingContent(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldThinkingContent, v))
@@ -135,6 +140,56 @@ func ErrorMessage(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldErrorMessage, v))
 }
 
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEQ(FieldRequestID, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEQ(FieldOwner, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
 // SessionIDEQ applies the EQ predicate on the "session_id" field.
 func SessionIDEQ(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldSessionID, v))
@@ -350,46 +405,6 @@ func ExecutionIDContainsFold(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldContainsFold(FieldExecutionID, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldNEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldNotIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldGT(FieldCreatedAt, v))
-}
-
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldGTE(FieldCreatedAt, v))
-}
-
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldLT(FieldCreatedAt, v))
-}
-
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.LLMInteraction {
-	return predicate.LLMInteraction(sql.FieldLTE(FieldCreatedAt, v))
-}
-
 // InteractionTypeEQ applies the EQ predicate on the "interaction_type" field.
 func InteractionTypeEQ(v InteractionType) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldInteractionType, v))
@@ -550,6 +565,56 @@ func LastMessageIDContainsFold(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldContainsFold(FieldLastMessageID, v))
 }
 
+// PayloadZstdEQ applies the EQ predicate on the "payload_zstd" field.
+func PayloadZstdEQ(v []byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEQ(FieldPayloadZstd, v))
+}
+
+// PayloadZstdNEQ applies the NEQ predicate on the "payload_zstd" field.
+func PayloadZstdNEQ(v []byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNEQ(FieldPayloadZstd, v))
+}
+
+// PayloadZstdIn applies the In predicate on the "payload_zstd" field.
+func PayloadZstdIn(vs ...[]byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldIn(FieldPayloadZstd, vs...))
+}
+
+// PayloadZstdNotIn applies the NotIn predicate on the "payload_zstd" field.
+func PayloadZstdNotIn(vs ...[]byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNotIn(FieldPayloadZstd, vs...))
+}
+
+// PayloadZstdGT applies the GT predicate on the "payload_zstd" field.
+func PayloadZstdGT(v []byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldGT(FieldPayloadZstd, v))
+}
+
+// PayloadZstdGTE applies the GTE predicate on the "payload_zstd" field.
+func PayloadZstdGTE(v []byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldGTE(FieldPayloadZstd, v))
+}
+
+// PayloadZstdLT applies the LT predicate on the "payload_zstd" field.
+func PayloadZstdLT(v []byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldLT(FieldPayloadZstd, v))
+}
+
+// PayloadZstdLTE applies the LTE predicate on the "payload_zstd" field.
+func PayloadZstdLTE(v []byte) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldLTE(FieldPayloadZstd, v))
+}
+
+// PayloadZstdIsNil applies the IsNil predicate on the "payload_zstd" field.
+func PayloadZstdIsNil() predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldIsNull(FieldPayloadZstd))
+}
+
+// PayloadZstdNotNil applies the NotNil predicate on the "payload_zstd" field.
+func PayloadZstdNotNil() predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNotNull(FieldPayloadZstd))
+}
+
 // ThinkingContentEQ applies the EQ predicate on the "thinking_content" field.
 func ThinkingContentEQ(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldEQ(FieldThinkingContent, v))
@@ -1010,6 +1075,156 @@ func ErrorMessageContainsFold(v string) predicate.LLMInteraction {
 	return predicate.LLMInteraction(sql.FieldContainsFold(FieldErrorMessage, v))
 }
 
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.LLMInteraction {
+	return predicate.LLMInteraction(sql.FieldContainsFold(FieldOwner, v))
+}
+
 // HasSession applies the HasEdge predicate on the "session" edge.
 func HasSession() predicate.LLMInteraction {
 	return predicate.LLMInteraction(func(s *sql.Selector) {
@@ -25,6 +25,20 @@ type LLMInteractionCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *LLMInteractionCreate) SetCreatedAt(v time.Time) *LLMInteractionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LLMInteractionCreate) SetNillableCreatedAt(v *time.Time) *LLMInteractionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // SetSessionID sets the "session_id" field.
 func (_c *LLMInteractionCreate) SetSessionID(v string) *LLMInteractionCreate {
 	_c.mutation.SetSessionID(v)
@@ -59,20 +73,6 @@ func (_c *LLMInteractionCreate) SetNillableExecutionID(v *string) *LLMInteractio
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *LLMInteractionCreate) SetCreatedAt(v time.Time) *LLMInteractionCreate {
-	_c.mutation.SetCreatedAt(v)
-	return _c
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *LLMInteractionCreate) SetNillableCreatedAt(v *time.Time) *LLMInteractionCreate {
-	if v != nil {
-		_c.SetCreatedAt(*v)
-	}
-	return _c
-}
-
 // SetInteractionType sets the "interaction_type" field.
 func (_c *LLMInteractionCreate) SetInteractionType(v llminteraction.InteractionType) *LLMInteractionCreate {
 	_c.mutation.SetInteractionType(v)
@@ -111,6 +111,12 @@ func (_c *LLMInteractionCreate) SetLlmResponse(v map[string]interface{}) *LLMInt
 	return _c
 }
 
+// SetPayloadZstd sets the "payload_zstd" field.
+func (_c *LLMInteractionCreate) SetPayloadZstd(v []byte) *LLMInteractionCreate {
+	_c.mutation.SetPayloadZstd(v)
+	return _c
+}
+
 // SetThinkingContent sets the "thinking_content" field.
 func (_c *LLMInteractionCreate) SetThinkingContent(v string) *LLMInteractionCreate {
 	_c.mutation.SetThinkingContent(v)
@@ -229,6 +235,34 @@ func (_c *LLMInteractionCreate) SetNillableErrorMessage(v *string) *LLMInteracti
 	return _c
 }
 
+// SetRequestID sets the "request_id" field.
+func (_c *LLMInteractionCreate) SetRequestID(v string) *LLMInteractionCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *LLMInteractionCreate) SetNillableRequestID(v *string) *LLMInteractionCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *LLMInteractionCreate) SetOwner(v string) *LLMInteractionCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_c *LLMInteractionCreate) SetNillableOwner(v *string) *LLMInteractionCreate {
+	if v != nil {
+		_c.SetOwner(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *LLMInteractionCreate) SetID(v string) *LLMInteractionCreate {
 	_c.mutation.SetID(v)
@@ -327,12 +361,12 @@ func (_c *LLMInteractionCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *LLMInteractionCreate) check() error {
-	if _, ok := _c.mutation.SessionID(); !ok {
-		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "LLMInteraction.session_id"`)}
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LLMInteraction.created_at"`)}
 	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "LLMInteraction.session_id"`)}
+	}
 	if _, ok := _c.mutation.InteractionType(); !ok {
 		return &ValidationError{Name: "interaction_type", err: errors.New(`ent: missing required field "LLMInteraction.interaction_type"`)}
 	}
@@ -408,6 +442,10 @@ func (_c *LLMInteractionCreate) createSpec() (*LLMInteraction, *sqlgraph.CreateS
 		_spec.SetField(llminteraction.FieldLlmResponse, field.TypeJSON, value)
 		_node.LlmResponse = value
 	}
+	if value, ok := _c.mutation.PayloadZstd(); ok {
+		_spec.SetField(llminteraction.FieldPayloadZstd, field.TypeBytes, value)
+		_node.PayloadZstd = &value
+	}
 	if value, ok := _c.mutation.ThinkingContent(); ok {
 		_spec.SetField(llminteraction.FieldThinkingContent, field.TypeString, value)
 		_node.ThinkingContent = &value
@@ -444,6 +482,14 @@ func (_c *LLMInteractionCreate) createSpec() (*LLMInteraction, *sqlgraph.CreateS
 		_spec.SetField(llminteraction.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = &value
 	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(llminteraction.FieldRequestID, field.TypeString, value)
+		_node.RequestID = &value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(llminteraction.FieldOwner, field.TypeString, value)
+		_node.Owner = &value
+	}
 	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
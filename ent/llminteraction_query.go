@@ -446,12 +446,12 @@ func (_q *LLMInteractionQuery) WithTimelineEvents(opts ...func(*TimelineEventQue
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.LLMInteraction.Query().
-//		GroupBy(llminteraction.FieldSessionID).
+//		GroupBy(llminteraction.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *LLMInteractionQuery) GroupBy(field string, fields ...string) *LLMInteractionGroupBy {
@@ -469,11 +469,11 @@ func (_q *LLMInteractionQuery) GroupBy(field string, fields ...string) *LLMInter
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.LLMInteraction.Query().
-//		Select(llminteraction.FieldSessionID).
+//		Select(llminteraction.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *LLMInteractionQuery) Select(fields ...string) *LLMInteractionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -90,6 +90,18 @@ func (_u *LLMInteractionUpdate) SetLlmResponse(v map[string]interface{}) *LLMInt
 	return _u
 }
 
+// SetPayloadZstd sets the "payload_zstd" field.
+func (_u *LLMInteractionUpdate) SetPayloadZstd(v []byte) *LLMInteractionUpdate {
+	_u.mutation.SetPayloadZstd(v)
+	return _u
+}
+
+// ClearPayloadZstd clears the value of the "payload_zstd" field.
+func (_u *LLMInteractionUpdate) ClearPayloadZstd() *LLMInteractionUpdate {
+	_u.mutation.ClearPayloadZstd()
+	return _u
+}
+
 // SetThinkingContent sets the "thinking_content" field.
 func (_u *LLMInteractionUpdate) SetThinkingContent(v string) *LLMInteractionUpdate {
 	_u.mutation.SetThinkingContent(v)
@@ -304,6 +316,46 @@ func (_u *LLMInteractionUpdate) ClearErrorMessage() *LLMInteractionUpdate {
 	return _u
 }
 
+// SetRequestID sets the "request_id" field.
+func (_u *LLMInteractionUpdate) SetRequestID(v string) *LLMInteractionUpdate {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *LLMInteractionUpdate) SetNillableRequestID(v *string) *LLMInteractionUpdate {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (_u *LLMInteractionUpdate) ClearRequestID() *LLMInteractionUpdate {
+	_u.mutation.ClearRequestID()
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *LLMInteractionUpdate) SetOwner(v string) *LLMInteractionUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *LLMInteractionUpdate) SetNillableOwner(v *string) *LLMInteractionUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *LLMInteractionUpdate) ClearOwner() *LLMInteractionUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
 // SetLastMessage sets the "last_message" edge to the Message entity.
 func (_u *LLMInteractionUpdate) SetLastMessage(v *Message) *LLMInteractionUpdate {
 	return _u.SetLastMessageID(v.ID)
@@ -426,6 +478,12 @@ func (_u *LLMInteractionUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.LlmResponse(); ok {
 		_spec.SetField(llminteraction.FieldLlmResponse, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.PayloadZstd(); ok {
+		_spec.SetField(llminteraction.FieldPayloadZstd, field.TypeBytes, value)
+	}
+	if _u.mutation.PayloadZstdCleared() {
+		_spec.ClearField(llminteraction.FieldPayloadZstd, field.TypeBytes)
+	}
 	if value, ok := _u.mutation.ThinkingContent(); ok {
 		_spec.SetField(llminteraction.FieldThinkingContent, field.TypeString, value)
 	}
@@ -498,6 +556,18 @@ func (_u *LLMInteractionUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(llminteraction.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(llminteraction.FieldRequestID, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(llminteraction.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(llminteraction.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(llminteraction.FieldOwner, field.TypeString)
+	}
 	if _u.mutation.LastMessageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -654,6 +724,18 @@ func (_u *LLMInteractionUpdateOne) SetLlmResponse(v map[string]interface{}) *LLM
 	return _u
 }
 
+// SetPayloadZstd sets the "payload_zstd" field.
+func (_u *LLMInteractionUpdateOne) SetPayloadZstd(v []byte) *LLMInteractionUpdateOne {
+	_u.mutation.SetPayloadZstd(v)
+	return _u
+}
+
+// ClearPayloadZstd clears the value of the "payload_zstd" field.
+func (_u *LLMInteractionUpdateOne) ClearPayloadZstd() *LLMInteractionUpdateOne {
+	_u.mutation.ClearPayloadZstd()
+	return _u
+}
+
 // SetThinkingContent sets the "thinking_content" field.
 func (_u *LLMInteractionUpdateOne) SetThinkingContent(v string) *LLMInteractionUpdateOne {
 	_u.mutation.SetThinkingContent(v)
@@ -868,6 +950,46 @@ func (_u *LLMInteractionUpdateOne) ClearErrorMessage() *LLMInteractionUpdateOne
 	return _u
 }
 
+// SetRequestID sets the "request_id" field.
+func (_u *LLMInteractionUpdateOne) SetRequestID(v string) *LLMInteractionUpdateOne {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *LLMInteractionUpdateOne) SetNillableRequestID(v *string) *LLMInteractionUpdateOne {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (_u *LLMInteractionUpdateOne) ClearRequestID() *LLMInteractionUpdateOne {
+	_u.mutation.ClearRequestID()
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *LLMInteractionUpdateOne) SetOwner(v string) *LLMInteractionUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *LLMInteractionUpdateOne) SetNillableOwner(v *string) *LLMInteractionUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *LLMInteractionUpdateOne) ClearOwner() *LLMInteractionUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
 // SetLastMessage sets the "last_message" edge to the Message entity.
 func (_u *LLMInteractionUpdateOne) SetLastMessage(v *Message) *LLMInteractionUpdateOne {
 	return _u.SetLastMessageID(v.ID)
@@ -1020,6 +1142,12 @@ func (_u *LLMInteractionUpdateOne) sqlSave(ctx context.Context) (_node *LLMInter
 	if value, ok := _u.mutation.LlmResponse(); ok {
 		_spec.SetField(llminteraction.FieldLlmResponse, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.PayloadZstd(); ok {
+		_spec.SetField(llminteraction.FieldPayloadZstd, field.TypeBytes, value)
+	}
+	if _u.mutation.PayloadZstdCleared() {
+		_spec.ClearField(llminteraction.FieldPayloadZstd, field.TypeBytes)
+	}
 	if value, ok := _u.mutation.ThinkingContent(); ok {
 		_spec.SetField(llminteraction.FieldThinkingContent, field.TypeString, value)
 	}
@@ -1092,6 +1220,18 @@ func (_u *LLMInteractionUpdateOne) sqlSave(ctx context.Context) (_node *LLMInter
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(llminteraction.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(llminteraction.FieldRequestID, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(llminteraction.FieldRequestID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(llminteraction.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(llminteraction.FieldOwner, field.TypeString)
+	}
 	if _u.mutation.LastMessageCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
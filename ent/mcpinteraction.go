@@ -21,14 +21,14 @@ type MCPInteraction struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// SessionID holds the value of the "session_id" field.
 	SessionID string `json:"session_id,omitempty"`
 	// StageID holds the value of the "stage_id" field.
 	StageID string `json:"stage_id,omitempty"`
 	// Which agent
 	ExecutionID string `json:"execution_id,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
 	// InteractionType holds the value of the "interaction_type" field.
 	InteractionType mcpinteraction.InteractionType `json:"interaction_type,omitempty"`
 	// e.g., 'kubernetes', 'argocd'
@@ -39,12 +39,20 @@ type MCPInteraction struct {
 	ToolArguments map[string]interface{} `json:"tool_arguments,omitempty"`
 	// Tool output
 	ToolResult map[string]interface{} `json:"tool_result,omitempty"`
+	// zstd-compressed tool_result for large outputs; tool_result is null when set
+	ToolResultZstd *[]byte `json:"tool_result_zstd,omitempty"`
+	// Uncompressed JSON size of tool_result_zstd or tool_result_blob (for usage statistics)
+	ToolResultBytes *int `json:"tool_result_bytes,omitempty"`
+	// Blob store key of an offloaded tool_result; tool_result is null when set
+	ToolResultBlob *string `json:"tool_result_blob,omitempty"`
 	// For tool_list type
 	AvailableTools []interface{} `json:"available_tools,omitempty"`
 	// DurationMs holds the value of the "duration_ms" field.
 	DurationMs *int `json:"duration_ms,omitempty"`
 	// null = success, not-null = failed
 	ErrorMessage *string `json:"error_message,omitempty"`
+	// X-Request-ID of the API call that triggered this work
+	RequestID *string `json:"request_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MCPInteractionQuery when eager-loading is set.
 	Edges        MCPInteractionEdges `json:"edges"`
@@ -113,11 +121,11 @@ func (*MCPInteraction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case mcpinteraction.FieldToolArguments, mcpinteraction.FieldToolResult, mcpinteraction.FieldAvailableTools:
+		case mcpinteraction.FieldToolArguments, mcpinteraction.FieldToolResult, mcpinteraction.FieldToolResultZstd, mcpinteraction.FieldAvailableTools:
 			values[i] = new([]byte)
-		case mcpinteraction.FieldDurationMs:
+		case mcpinteraction.FieldToolResultBytes, mcpinteraction.FieldDurationMs:
 			values[i] = new(sql.NullInt64)
-		case mcpinteraction.FieldID, mcpinteraction.FieldSessionID, mcpinteraction.FieldStageID, mcpinteraction.FieldExecutionID, mcpinteraction.FieldInteractionType, mcpinteraction.FieldServerName, mcpinteraction.FieldToolName, mcpinteraction.FieldErrorMessage:
+		case mcpinteraction.FieldID, mcpinteraction.FieldSessionID, mcpinteraction.FieldStageID, mcpinteraction.FieldExecutionID, mcpinteraction.FieldInteractionType, mcpinteraction.FieldServerName, mcpinteraction.FieldToolName, mcpinteraction.FieldToolResultBlob, mcpinteraction.FieldErrorMessage, mcpinteraction.FieldRequestID:
 			values[i] = new(sql.NullString)
 		case mcpinteraction.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -142,6 +150,12 @@ func (_m *MCPInteraction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case mcpinteraction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
 		case mcpinteraction.FieldSessionID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field session_id", values[i])
@@ -160,12 +174,6 @@ func (_m *MCPInteraction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ExecutionID = value.String
 			}
-		case mcpinteraction.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
-			} else if value.Valid {
-				_m.CreatedAt = value.Time
-			}
 		case mcpinteraction.FieldInteractionType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field interaction_type", values[i])
@@ -201,6 +209,26 @@ func (_m *MCPInteraction) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field tool_result: %w", err)
 				}
 			}
+		case mcpinteraction.FieldToolResultZstd:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tool_result_zstd", values[i])
+			} else if value != nil {
+				_m.ToolResultZstd = value
+			}
+		case mcpinteraction.FieldToolResultBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tool_result_bytes", values[i])
+			} else if value.Valid {
+				_m.ToolResultBytes = new(int)
+				*_m.ToolResultBytes = int(value.Int64)
+			}
+		case mcpinteraction.FieldToolResultBlob:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tool_result_blob", values[i])
+			} else if value.Valid {
+				_m.ToolResultBlob = new(string)
+				*_m.ToolResultBlob = value.String
+			}
 		case mcpinteraction.FieldAvailableTools:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field available_tools", values[i])
@@ -223,6 +251,13 @@ func (_m *MCPInteraction) assignValues(columns []string, values []any) error {
 				_m.ErrorMessage = new(string)
 				*_m.ErrorMessage = value.String
 			}
+		case mcpinteraction.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = new(string)
+				*_m.RequestID = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -279,6 +314,9 @@ func (_m *MCPInteraction) String() string {
 	var builder strings.Builder
 	builder.WriteString("MCPInteraction(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("session_id=")
 	builder.WriteString(_m.SessionID)
 	builder.WriteString(", ")
@@ -288,9 +326,6 @@ func (_m *MCPInteraction) String() string {
 	builder.WriteString("execution_id=")
 	builder.WriteString(_m.ExecutionID)
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
 	builder.WriteString("interaction_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.InteractionType))
 	builder.WriteString(", ")
@@ -308,6 +343,21 @@ func (_m *MCPInteraction) String() string {
 	builder.WriteString("tool_result=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ToolResult))
 	builder.WriteString(", ")
+	if v := _m.ToolResultZstd; v != nil {
+		builder.WriteString("tool_result_zstd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ToolResultBytes; v != nil {
+		builder.WriteString("tool_result_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ToolResultBlob; v != nil {
+		builder.WriteString("tool_result_blob=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("available_tools=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AvailableTools))
 	builder.WriteString(", ")
@@ -320,6 +370,11 @@ func (_m *MCPInteraction) String() string {
 		builder.WriteString("error_message=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.RequestID; v != nil {
+		builder.WriteString("request_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -15,14 +15,14 @@ const (
 	Label = "mcp_interaction"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "interaction_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// FieldSessionID holds the string denoting the session_id field in the database.
 	FieldSessionID = "session_id"
 	// FieldStageID holds the string denoting the stage_id field in the database.
 	FieldStageID = "stage_id"
 	// FieldExecutionID holds the string denoting the execution_id field in the database.
 	FieldExecutionID = "execution_id"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
 	// FieldInteractionType holds the string denoting the interaction_type field in the database.
 	FieldInteractionType = "interaction_type"
 	// FieldServerName holds the string denoting the server_name field in the database.
@@ -33,12 +33,20 @@ const (
 	FieldToolArguments = "tool_arguments"
 	// FieldToolResult holds the string denoting the tool_result field in the database.
 	FieldToolResult = "tool_result"
+	// FieldToolResultZstd holds the string denoting the tool_result_zstd field in the database.
+	FieldToolResultZstd = "tool_result_zstd"
+	// FieldToolResultBytes holds the string denoting the tool_result_bytes field in the database.
+	FieldToolResultBytes = "tool_result_bytes"
+	// FieldToolResultBlob holds the string denoting the tool_result_blob field in the database.
+	FieldToolResultBlob = "tool_result_blob"
 	// FieldAvailableTools holds the string denoting the available_tools field in the database.
 	FieldAvailableTools = "available_tools"
 	// FieldDurationMs holds the string denoting the duration_ms field in the database.
 	FieldDurationMs = "duration_ms"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
 	FieldErrorMessage = "error_message"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
 	// EdgeSession holds the string denoting the session edge name in mutations.
 	EdgeSession = "session"
 	// EdgeStage holds the string denoting the stage edge name in mutations.
@@ -90,18 +98,22 @@ const (
 // Columns holds all SQL columns for mcpinteraction fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
 	FieldSessionID,
 	FieldStageID,
 	FieldExecutionID,
-	FieldCreatedAt,
 	FieldInteractionType,
 	FieldServerName,
 	FieldToolName,
 	FieldToolArguments,
 	FieldToolResult,
+	FieldToolResultZstd,
+	FieldToolResultBytes,
+	FieldToolResultBlob,
 	FieldAvailableTools,
 	FieldDurationMs,
 	FieldErrorMessage,
+	FieldRequestID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -150,6 +162,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
 // BySessionID orders the results by the session_id field.
 func BySessionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
@@ -165,11 +182,6 @@ func ByExecutionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExecutionID, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
-}
-
 // ByInteractionType orders the results by the interaction_type field.
 func ByInteractionType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInteractionType, opts...).ToFunc()
@@ -185,6 +197,16 @@ func ByToolName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldToolName, opts...).ToFunc()
 }
 
+// ByToolResultBytes orders the results by the tool_result_bytes field.
+func ByToolResultBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToolResultBytes, opts...).ToFunc()
+}
+
+// ByToolResultBlob orders the results by the tool_result_blob field.
+func ByToolResultBlob(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToolResultBlob, opts...).ToFunc()
+}
+
 // ByDurationMs orders the results by the duration_ms field.
 func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
@@ -195,6 +217,11 @@ func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
 }
 
+// ByRequestID orders the results by the request_id field.
+func ByRequestID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestID, opts...).ToFunc()
+}
+
 // BySessionField orders the results by session field.
 func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -65,6 +65,11 @@ func IDContainsFold(id string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldContainsFold(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
 // SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
 func SessionID(v string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldEQ(FieldSessionID, v))
@@ -80,11 +85,6 @@ func ExecutionID(v string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldEQ(FieldExecutionID, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldEQ(FieldCreatedAt, v))
-}
-
 // ServerName applies equality check predicate on the "server_name" field. It's identical to ServerNameEQ.
 func ServerName(v string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldEQ(FieldServerName, v))
@@ -95,6 +95,21 @@ func ToolName(v string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldEQ(FieldToolName, v))
 }
 
+// ToolResultZstd applies equality check predicate on the "tool_result_zstd" field. It's identical to ToolResultZstdEQ.
+func ToolResultZstd(v []byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldToolResultZstd, v))
+}
+
+// ToolResultBytes applies equality check predicate on the "tool_result_bytes" field. It's identical to ToolResultBytesEQ.
+func ToolResultBytes(v int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldToolResultBytes, v))
+}
+
+// ToolResultBlob applies equality check predicate on the "tool_result_blob" field. It's identical to ToolResultBlobEQ.
+func ToolResultBlob(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldToolResultBlob, v))
+}
+
 // DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
 func DurationMs(v int) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldEQ(FieldDurationMs, v))
@@ -105,6 +120,51 @@ func ErrorMessage(v string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldEQ(FieldErrorMessage, v))
 }
 
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldRequestID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
 // SessionIDEQ applies the EQ predicate on the "session_id" field.
 func SessionIDEQ(v string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldEQ(FieldSessionID, v))
@@ -300,46 +360,6 @@ func ExecutionIDContainsFold(v string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldContainsFold(FieldExecutionID, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldNEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldNotIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldGT(FieldCreatedAt, v))
-}
-
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldGTE(FieldCreatedAt, v))
-}
-
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldLT(FieldCreatedAt, v))
-}
-
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.MCPInteraction {
-	return predicate.MCPInteraction(sql.FieldLTE(FieldCreatedAt, v))
-}
-
 // InteractionTypeEQ applies the EQ predicate on the "interaction_type" field.
 func InteractionTypeEQ(v InteractionType) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldEQ(FieldInteractionType, v))
@@ -520,6 +540,181 @@ func ToolResultNotNil() predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldNotNull(FieldToolResult))
 }
 
+// ToolResultZstdEQ applies the EQ predicate on the "tool_result_zstd" field.
+func ToolResultZstdEQ(v []byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldToolResultZstd, v))
+}
+
+// ToolResultZstdNEQ applies the NEQ predicate on the "tool_result_zstd" field.
+func ToolResultZstdNEQ(v []byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNEQ(FieldToolResultZstd, v))
+}
+
+// ToolResultZstdIn applies the In predicate on the "tool_result_zstd" field.
+func ToolResultZstdIn(vs ...[]byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIn(FieldToolResultZstd, vs...))
+}
+
+// ToolResultZstdNotIn applies the NotIn predicate on the "tool_result_zstd" field.
+func ToolResultZstdNotIn(vs ...[]byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotIn(FieldToolResultZstd, vs...))
+}
+
+// ToolResultZstdGT applies the GT predicate on the "tool_result_zstd" field.
+func ToolResultZstdGT(v []byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGT(FieldToolResultZstd, v))
+}
+
+// ToolResultZstdGTE applies the GTE predicate on the "tool_result_zstd" field.
+func ToolResultZstdGTE(v []byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGTE(FieldToolResultZstd, v))
+}
+
+// ToolResultZstdLT applies the LT predicate on the "tool_result_zstd" field.
+func ToolResultZstdLT(v []byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLT(FieldToolResultZstd, v))
+}
+
+// ToolResultZstdLTE applies the LTE predicate on the "tool_result_zstd" field.
+func ToolResultZstdLTE(v []byte) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLTE(FieldToolResultZstd, v))
+}
+
+// ToolResultZstdIsNil applies the IsNil predicate on the "tool_result_zstd" field.
+func ToolResultZstdIsNil() predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIsNull(FieldToolResultZstd))
+}
+
+// ToolResultZstdNotNil applies the NotNil predicate on the "tool_result_zstd" field.
+func ToolResultZstdNotNil() predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotNull(FieldToolResultZstd))
+}
+
+// ToolResultBytesEQ applies the EQ predicate on the "tool_result_bytes" field.
+func ToolResultBytesEQ(v int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldToolResultBytes, v))
+}
+
+// ToolResultBytesNEQ applies the NEQ predicate on the "tool_result_bytes" field.
+func ToolResultBytesNEQ(v int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNEQ(FieldToolResultBytes, v))
+}
+
+// ToolResultBytesIn applies the In predicate on the "tool_result_bytes" field.
+func ToolResultBytesIn(vs ...int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIn(FieldToolResultBytes, vs...))
+}
+
+// ToolResultBytesNotIn applies the NotIn predicate on the "tool_result_bytes" field.
+func ToolResultBytesNotIn(vs ...int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotIn(FieldToolResultBytes, vs...))
+}
+
+// ToolResultBytesGT applies the GT predicate on the "tool_result_bytes" field.
+func ToolResultBytesGT(v int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGT(FieldToolResultBytes, v))
+}
+
+// ToolResultBytesGTE applies the GTE predicate on the "tool_result_bytes" field.
+func ToolResultBytesGTE(v int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGTE(FieldToolResultBytes, v))
+}
+
+// ToolResultBytesLT applies the LT predicate on the "tool_result_bytes" field.
+func ToolResultBytesLT(v int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLT(FieldToolResultBytes, v))
+}
+
+// ToolResultBytesLTE applies the LTE predicate on the "tool_result_bytes" field.
+func ToolResultBytesLTE(v int) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLTE(FieldToolResultBytes, v))
+}
+
+// ToolResultBytesIsNil applies the IsNil predicate on the "tool_result_bytes" field.
+func ToolResultBytesIsNil() predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIsNull(FieldToolResultBytes))
+}
+
+// ToolResultBytesNotNil applies the NotNil predicate on the "tool_result_bytes" field.
+func ToolResultBytesNotNil() predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotNull(FieldToolResultBytes))
+}
+
+// ToolResultBlobEQ applies the EQ predicate on the "tool_result_blob" field.
+func ToolResultBlobEQ(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobNEQ applies the NEQ predicate on the "tool_result_blob" field.
+func ToolResultBlobNEQ(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNEQ(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobIn applies the In predicate on the "tool_result_blob" field.
+func ToolResultBlobIn(vs ...string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIn(FieldToolResultBlob, vs...))
+}
+
+// ToolResultBlobNotIn applies the NotIn predicate on the "tool_result_blob" field.
+func ToolResultBlobNotIn(vs ...string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotIn(FieldToolResultBlob, vs...))
+}
+
+// ToolResultBlobGT applies the GT predicate on the "tool_result_blob" field.
+func ToolResultBlobGT(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGT(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobGTE applies the GTE predicate on the "tool_result_blob" field.
+func ToolResultBlobGTE(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGTE(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobLT applies the LT predicate on the "tool_result_blob" field.
+func ToolResultBlobLT(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLT(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobLTE applies the LTE predicate on the "tool_result_blob" field.
+func ToolResultBlobLTE(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLTE(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobContains applies the Contains predicate on the "tool_result_blob" field.
+func ToolResultBlobContains(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldContains(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobHasPrefix applies the HasPrefix predicate on the "tool_result_blob" field.
+func ToolResultBlobHasPrefix(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldHasPrefix(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobHasSuffix applies the HasSuffix predicate on the "tool_result_blob" field.
+func ToolResultBlobHasSuffix(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldHasSuffix(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobIsNil applies the IsNil predicate on the "tool_result_blob" field.
+func ToolResultBlobIsNil() predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIsNull(FieldToolResultBlob))
+}
+
+// ToolResultBlobNotNil applies the NotNil predicate on the "tool_result_blob" field.
+func ToolResultBlobNotNil() predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotNull(FieldToolResultBlob))
+}
+
+// ToolResultBlobEqualFold applies the EqualFold predicate on the "tool_result_blob" field.
+func ToolResultBlobEqualFold(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEqualFold(FieldToolResultBlob, v))
+}
+
+// ToolResultBlobContainsFold applies the ContainsFold predicate on the "tool_result_blob" field.
+func ToolResultBlobContainsFold(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldContainsFold(FieldToolResultBlob, v))
+}
+
 // AvailableToolsIsNil applies the IsNil predicate on the "available_tools" field.
 func AvailableToolsIsNil() predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldIsNull(FieldAvailableTools))
@@ -655,6 +850,81 @@ func ErrorMessageContainsFold(v string) predicate.MCPInteraction {
 	return predicate.MCPInteraction(sql.FieldContainsFold(FieldErrorMessage, v))
 }
 
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.MCPInteraction {
+	return predicate.MCPInteraction(sql.FieldContainsFold(FieldRequestID, v))
+}
+
 // HasSession applies the HasEdge predicate on the "session" edge.
 func HasSession() predicate.MCPInteraction {
 	return predicate.MCPInteraction(func(s *sql.Selector) {
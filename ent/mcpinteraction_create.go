@@ -24,6 +24,20 @@ type MCPInteractionCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *MCPInteractionCreate) SetCreatedAt(v time.Time) *MCPInteractionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MCPInteractionCreate) SetNillableCreatedAt(v *time.Time) *MCPInteractionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // SetSessionID sets the "session_id" field.
 func (_c *MCPInteractionCreate) SetSessionID(v string) *MCPInteractionCreate {
 	_c.mutation.SetSessionID(v)
@@ -42,20 +56,6 @@ func (_c *MCPInteractionCreate) SetExecutionID(v string) *MCPInteractionCreate {
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *MCPInteractionCreate) SetCreatedAt(v time.Time) *MCPInteractionCreate {
-	_c.mutation.SetCreatedAt(v)
-	return _c
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *MCPInteractionCreate) SetNillableCreatedAt(v *time.Time) *MCPInteractionCreate {
-	if v != nil {
-		_c.SetCreatedAt(*v)
-	}
-	return _c
-}
-
 // SetInteractionType sets the "interaction_type" field.
 func (_c *MCPInteractionCreate) SetInteractionType(v mcpinteraction.InteractionType) *MCPInteractionCreate {
 	_c.mutation.SetInteractionType(v)
@@ -94,6 +94,40 @@ func (_c *MCPInteractionCreate) SetToolResult(v map[string]interface{}) *MCPInte
 	return _c
 }
 
+// SetToolResultZstd sets the "tool_result_zstd" field.
+func (_c *MCPInteractionCreate) SetToolResultZstd(v []byte) *MCPInteractionCreate {
+	_c.mutation.SetToolResultZstd(v)
+	return _c
+}
+
+// SetToolResultBytes sets the "tool_result_bytes" field.
+func (_c *MCPInteractionCreate) SetToolResultBytes(v int) *MCPInteractionCreate {
+	_c.mutation.SetToolResultBytes(v)
+	return _c
+}
+
+// SetNillableToolResultBytes sets the "tool_result_bytes" field if the given value is not nil.
+func (_c *MCPInteractionCreate) SetNillableToolResultBytes(v *int) *MCPInteractionCreate {
+	if v != nil {
+		_c.SetToolResultBytes(*v)
+	}
+	return _c
+}
+
+// SetToolResultBlob sets the "tool_result_blob" field.
+func (_c *MCPInteractionCreate) SetToolResultBlob(v string) *MCPInteractionCreate {
+	_c.mutation.SetToolResultBlob(v)
+	return _c
+}
+
+// SetNillableToolResultBlob sets the "tool_result_blob" field if the given value is not nil.
+func (_c *MCPInteractionCreate) SetNillableToolResultBlob(v *string) *MCPInteractionCreate {
+	if v != nil {
+		_c.SetToolResultBlob(*v)
+	}
+	return _c
+}
+
 // SetAvailableTools sets the "available_tools" field.
 func (_c *MCPInteractionCreate) SetAvailableTools(v []interface{}) *MCPInteractionCreate {
 	_c.mutation.SetAvailableTools(v)
@@ -128,6 +162,20 @@ func (_c *MCPInteractionCreate) SetNillableErrorMessage(v *string) *MCPInteracti
 	return _c
 }
 
+// SetRequestID sets the "request_id" field.
+func (_c *MCPInteractionCreate) SetRequestID(v string) *MCPInteractionCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_c *MCPInteractionCreate) SetNillableRequestID(v *string) *MCPInteractionCreate {
+	if v != nil {
+		_c.SetRequestID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *MCPInteractionCreate) SetID(v string) *MCPInteractionCreate {
 	_c.mutation.SetID(v)
@@ -213,6 +261,9 @@ func (_c *MCPInteractionCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *MCPInteractionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MCPInteraction.created_at"`)}
+	}
 	if _, ok := _c.mutation.SessionID(); !ok {
 		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "MCPInteraction.session_id"`)}
 	}
@@ -222,9 +273,6 @@ func (_c *MCPInteractionCreate) check() error {
 	if _, ok := _c.mutation.ExecutionID(); !ok {
 		return &ValidationError{Name: "execution_id", err: errors.New(`ent: missing required field "MCPInteraction.execution_id"`)}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MCPInteraction.created_at"`)}
-	}
 	if _, ok := _c.mutation.InteractionType(); !ok {
 		return &ValidationError{Name: "interaction_type", err: errors.New(`ent: missing required field "MCPInteraction.interaction_type"`)}
 	}
@@ -304,6 +352,18 @@ func (_c *MCPInteractionCreate) createSpec() (*MCPInteraction, *sqlgraph.CreateS
 		_spec.SetField(mcpinteraction.FieldToolResult, field.TypeJSON, value)
 		_node.ToolResult = value
 	}
+	if value, ok := _c.mutation.ToolResultZstd(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultZstd, field.TypeBytes, value)
+		_node.ToolResultZstd = &value
+	}
+	if value, ok := _c.mutation.ToolResultBytes(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultBytes, field.TypeInt, value)
+		_node.ToolResultBytes = &value
+	}
+	if value, ok := _c.mutation.ToolResultBlob(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultBlob, field.TypeString, value)
+		_node.ToolResultBlob = &value
+	}
 	if value, ok := _c.mutation.AvailableTools(); ok {
 		_spec.SetField(mcpinteraction.FieldAvailableTools, field.TypeJSON, value)
 		_node.AvailableTools = value
@@ -316,6 +376,10 @@ func (_c *MCPInteractionCreate) createSpec() (*MCPInteraction, *sqlgraph.CreateS
 		_spec.SetField(mcpinteraction.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = &value
 	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(mcpinteraction.FieldRequestID, field.TypeString, value)
+		_node.RequestID = &value
+	}
 	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
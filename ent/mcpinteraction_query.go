@@ -410,12 +410,12 @@ func (_q *MCPInteractionQuery) WithTimelineEvents(opts ...func(*TimelineEventQue
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.MCPInteraction.Query().
-//		GroupBy(mcpinteraction.FieldSessionID).
+//		GroupBy(mcpinteraction.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *MCPInteractionQuery) GroupBy(field string, fields ...string) *MCPInteractionGroupBy {
@@ -433,11 +433,11 @@ func (_q *MCPInteractionQuery) GroupBy(field string, fields ...string) *MCPInter
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.MCPInteraction.Query().
-//		Select(mcpinteraction.FieldSessionID).
+//		Select(mcpinteraction.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *MCPInteractionQuery) Select(fields ...string) *MCPInteractionSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
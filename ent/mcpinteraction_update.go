@@ -102,6 +102,65 @@ func (_u *MCPInteractionUpdate) ClearToolResult() *MCPInteractionUpdate {
 	return _u
 }
 
+// SetToolResultZstd sets the "tool_result_zstd" field.
+func (_u *MCPInteractionUpdate) SetToolResultZstd(v []byte) *MCPInteractionUpdate {
+	_u.mutation.SetToolResultZstd(v)
+	return _u
+}
+
+// ClearToolResultZstd clears the value of the "tool_result_zstd" field.
+func (_u *MCPInteractionUpdate) ClearToolResultZstd() *MCPInteractionUpdate {
+	_u.mutation.ClearToolResultZstd()
+	return _u
+}
+
+// SetToolResultBytes sets the "tool_result_bytes" field.
+func (_u *MCPInteractionUpdate) SetToolResultBytes(v int) *MCPInteractionUpdate {
+	_u.mutation.ResetToolResultBytes()
+	_u.mutation.SetToolResultBytes(v)
+	return _u
+}
+
+// SetNillableToolResultBytes sets the "tool_result_bytes" field if the given value is not nil.
+func (_u *MCPInteractionUpdate) SetNillableToolResultBytes(v *int) *MCPInteractionUpdate {
+	if v != nil {
+		_u.SetToolResultBytes(*v)
+	}
+	return _u
+}
+
+// AddToolResultBytes adds value to the "tool_result_bytes" field.
+func (_u *MCPInteractionUpdate) AddToolResultBytes(v int) *MCPInteractionUpdate {
+	_u.mutation.AddToolResultBytes(v)
+	return _u
+}
+
+// ClearToolResultBytes clears the value of the "tool_result_bytes" field.
+func (_u *MCPInteractionUpdate) ClearToolResultBytes() *MCPInteractionUpdate {
+	_u.mutation.ClearToolResultBytes()
+	return _u
+}
+
+// SetToolResultBlob sets the "tool_result_blob" field.
+func (_u *MCPInteractionUpdate) SetToolResultBlob(v string) *MCPInteractionUpdate {
+	_u.mutation.SetToolResultBlob(v)
+	return _u
+}
+
+// SetNillableToolResultBlob sets the "tool_result_blob" field if the given value is not nil.
+func (_u *MCPInteractionUpdate) SetNillableToolResultBlob(v *string) *MCPInteractionUpdate {
+	if v != nil {
+		_u.SetToolResultBlob(*v)
+	}
+	return _u
+}
+
+// ClearToolResultBlob clears the value of the "tool_result_blob" field.
+func (_u *MCPInteractionUpdate) ClearToolResultBlob() *MCPInteractionUpdate {
+	_u.mutation.ClearToolResultBlob()
+	return _u
+}
+
 // SetAvailableTools sets the "available_tools" field.
 func (_u *MCPInteractionUpdate) SetAvailableTools(v []interface{}) *MCPInteractionUpdate {
 	_u.mutation.SetAvailableTools(v)
@@ -167,6 +226,26 @@ func (_u *MCPInteractionUpdate) ClearErrorMessage() *MCPInteractionUpdate {
 	return _u
 }
 
+// SetRequestID sets the "request_id" field.
+func (_u *MCPInteractionUpdate) SetRequestID(v string) *MCPInteractionUpdate {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *MCPInteractionUpdate) SetNillableRequestID(v *string) *MCPInteractionUpdate {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (_u *MCPInteractionUpdate) ClearRequestID() *MCPInteractionUpdate {
+	_u.mutation.ClearRequestID()
+	return _u
+}
+
 // AddTimelineEventIDs adds the "timeline_events" edge to the TimelineEvent entity by IDs.
 func (_u *MCPInteractionUpdate) AddTimelineEventIDs(ids ...string) *MCPInteractionUpdate {
 	_u.mutation.AddTimelineEventIDs(ids...)
@@ -296,6 +375,27 @@ func (_u *MCPInteractionUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.ToolResultCleared() {
 		_spec.ClearField(mcpinteraction.FieldToolResult, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ToolResultZstd(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultZstd, field.TypeBytes, value)
+	}
+	if _u.mutation.ToolResultZstdCleared() {
+		_spec.ClearField(mcpinteraction.FieldToolResultZstd, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.ToolResultBytes(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultBytes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedToolResultBytes(); ok {
+		_spec.AddField(mcpinteraction.FieldToolResultBytes, field.TypeInt, value)
+	}
+	if _u.mutation.ToolResultBytesCleared() {
+		_spec.ClearField(mcpinteraction.FieldToolResultBytes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ToolResultBlob(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultBlob, field.TypeString, value)
+	}
+	if _u.mutation.ToolResultBlobCleared() {
+		_spec.ClearField(mcpinteraction.FieldToolResultBlob, field.TypeString)
+	}
 	if value, ok := _u.mutation.AvailableTools(); ok {
 		_spec.SetField(mcpinteraction.FieldAvailableTools, field.TypeJSON, value)
 	}
@@ -322,6 +422,12 @@ func (_u *MCPInteractionUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(mcpinteraction.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(mcpinteraction.FieldRequestID, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(mcpinteraction.FieldRequestID, field.TypeString)
+	}
 	if _u.mutation.TimelineEventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -461,6 +567,65 @@ func (_u *MCPInteractionUpdateOne) ClearToolResult() *MCPInteractionUpdateOne {
 	return _u
 }
 
+// SetToolResultZstd sets the "tool_result_zstd" field.
+func (_u *MCPInteractionUpdateOne) SetToolResultZstd(v []byte) *MCPInteractionUpdateOne {
+	_u.mutation.SetToolResultZstd(v)
+	return _u
+}
+
+// ClearToolResultZstd clears the value of the "tool_result_zstd" field.
+func (_u *MCPInteractionUpdateOne) ClearToolResultZstd() *MCPInteractionUpdateOne {
+	_u.mutation.ClearToolResultZstd()
+	return _u
+}
+
+// SetToolResultBytes sets the "tool_result_bytes" field.
+func (_u *MCPInteractionUpdateOne) SetToolResultBytes(v int) *MCPInteractionUpdateOne {
+	_u.mutation.ResetToolResultBytes()
+	_u.mutation.SetToolResultBytes(v)
+	return _u
+}
+
+// SetNillableToolResultBytes sets the "tool_result_bytes" field if the given value is not nil.
+func (_u *MCPInteractionUpdateOne) SetNillableToolResultBytes(v *int) *MCPInteractionUpdateOne {
+	if v != nil {
+		_u.SetToolResultBytes(*v)
+	}
+	return _u
+}
+
+// AddToolResultBytes adds value to the "tool_result_bytes" field.
+func (_u *MCPInteractionUpdateOne) AddToolResultBytes(v int) *MCPInteractionUpdateOne {
+	_u.mutation.AddToolResultBytes(v)
+	return _u
+}
+
+// ClearToolResultBytes clears the value of the "tool_result_bytes" field.
+func (_u *MCPInteractionUpdateOne) ClearToolResultBytes() *MCPInteractionUpdateOne {
+	_u.mutation.ClearToolResultBytes()
+	return _u
+}
+
+// SetToolResultBlob sets the "tool_result_blob" field.
+func (_u *MCPInteractionUpdateOne) SetToolResultBlob(v string) *MCPInteractionUpdateOne {
+	_u.mutation.SetToolResultBlob(v)
+	return _u
+}
+
+// SetNillableToolResultBlob sets the "tool_result_blob" field if the given value is not nil.
+func (_u *MCPInteractionUpdateOne) SetNillableToolResultBlob(v *string) *MCPInteractionUpdateOne {
+	if v != nil {
+		_u.SetToolResultBlob(*v)
+	}
+	return _u
+}
+
+// ClearToolResultBlob clears the value of the "tool_result_blob" field.
+func (_u *MCPInteractionUpdateOne) ClearToolResultBlob() *MCPInteractionUpdateOne {
+	_u.mutation.ClearToolResultBlob()
+	return _u
+}
+
 // SetAvailableTools sets the "available_tools" field.
 func (_u *MCPInteractionUpdateOne) SetAvailableTools(v []interface{}) *MCPInteractionUpdateOne {
 	_u.mutation.SetAvailableTools(v)
@@ -526,6 +691,26 @@ func (_u *MCPInteractionUpdateOne) ClearErrorMessage() *MCPInteractionUpdateOne
 	return _u
 }
 
+// SetRequestID sets the "request_id" field.
+func (_u *MCPInteractionUpdateOne) SetRequestID(v string) *MCPInteractionUpdateOne {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *MCPInteractionUpdateOne) SetNillableRequestID(v *string) *MCPInteractionUpdateOne {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (_u *MCPInteractionUpdateOne) ClearRequestID() *MCPInteractionUpdateOne {
+	_u.mutation.ClearRequestID()
+	return _u
+}
+
 // AddTimelineEventIDs adds the "timeline_events" edge to the TimelineEvent entity by IDs.
 func (_u *MCPInteractionUpdateOne) AddTimelineEventIDs(ids ...string) *MCPInteractionUpdateOne {
 	_u.mutation.AddTimelineEventIDs(ids...)
@@ -685,6 +870,27 @@ func (_u *MCPInteractionUpdateOne) sqlSave(ctx context.Context) (_node *MCPInter
 	if _u.mutation.ToolResultCleared() {
 		_spec.ClearField(mcpinteraction.FieldToolResult, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ToolResultZstd(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultZstd, field.TypeBytes, value)
+	}
+	if _u.mutation.ToolResultZstdCleared() {
+		_spec.ClearField(mcpinteraction.FieldToolResultZstd, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.ToolResultBytes(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultBytes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedToolResultBytes(); ok {
+		_spec.AddField(mcpinteraction.FieldToolResultBytes, field.TypeInt, value)
+	}
+	if _u.mutation.ToolResultBytesCleared() {
+		_spec.ClearField(mcpinteraction.FieldToolResultBytes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ToolResultBlob(); ok {
+		_spec.SetField(mcpinteraction.FieldToolResultBlob, field.TypeString, value)
+	}
+	if _u.mutation.ToolResultBlobCleared() {
+		_spec.ClearField(mcpinteraction.FieldToolResultBlob, field.TypeString)
+	}
 	if value, ok := _u.mutation.AvailableTools(); ok {
 		_spec.SetField(mcpinteraction.FieldAvailableTools, field.TypeJSON, value)
 	}
@@ -711,6 +917,12 @@ func (_u *MCPInteractionUpdateOne) sqlSave(ctx context.Context) (_node *MCPInter
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(mcpinteraction.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(mcpinteraction.FieldRequestID, field.TypeString, value)
+	}
+	if _u.mutation.RequestIDCleared() {
+		_spec.ClearField(mcpinteraction.FieldRequestID, field.TypeString)
+	}
 	if _u.mutation.TimelineEventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
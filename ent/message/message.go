@@ -15,6 +15,8 @@ const (
 	Label = "message"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "message_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// FieldSessionID holds the string denoting the session_id field in the database.
 	FieldSessionID = "session_id"
 	// FieldStageID holds the string denoting the stage_id field in the database.
@@ -33,8 +35,8 @@ const (
 	FieldToolCallID = "tool_call_id"
 	// FieldToolName holds the string denoting the tool_name field in the database.
 	FieldToolName = "tool_name"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
+	// FieldImages holds the string denoting the images field in the database.
+	FieldImages = "images"
 	// EdgeSession holds the string denoting the session edge name in mutations.
 	EdgeSession = "session"
 	// EdgeStage holds the string denoting the stage edge name in mutations.
@@ -86,6 +88,7 @@ const (
 // Columns holds all SQL columns for message fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
 	FieldSessionID,
 	FieldStageID,
 	FieldExecutionID,
@@ -95,7 +98,7 @@ var Columns = []string{
 	FieldToolCalls,
 	FieldToolCallID,
 	FieldToolName,
-	FieldCreatedAt,
+	FieldImages,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -146,6 +149,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
 // BySessionID orders the results by the session_id field.
 func BySessionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
@@ -186,11 +194,6 @@ func ByToolName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldToolName, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
-}
-
 // BySessionField orders the results by session field.
 func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
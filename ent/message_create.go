@@ -25,6 +25,20 @@ type MessageCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *MessageCreate) SetCreatedAt(v time.Time) *MessageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableCreatedAt(v *time.Time) *MessageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // SetSessionID sets the "session_id" field.
 func (_c *MessageCreate) SetSessionID(v string) *MessageCreate {
 	_c.mutation.SetSessionID(v)
@@ -95,17 +109,9 @@ func (_c *MessageCreate) SetNillableToolName(v *string) *MessageCreate {
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *MessageCreate) SetCreatedAt(v time.Time) *MessageCreate {
-	_c.mutation.SetCreatedAt(v)
-	return _c
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *MessageCreate) SetNillableCreatedAt(v *time.Time) *MessageCreate {
-	if v != nil {
-		_c.SetCreatedAt(*v)
-	}
+// SetImages sets the "images" field.
+func (_c *MessageCreate) SetImages(v []schema.ImageRef) *MessageCreate {
+	_c.mutation.SetImages(v)
 	return _c
 }
 
@@ -194,6 +200,9 @@ func (_c *MessageCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *MessageCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Message.created_at"`)}
+	}
 	if _, ok := _c.mutation.SessionID(); !ok {
 		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "Message.session_id"`)}
 	}
@@ -217,9 +226,6 @@ func (_c *MessageCreate) check() error {
 	if _, ok := _c.mutation.Content(); !ok {
 		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Message.content"`)}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Message.created_at"`)}
-	}
 	if len(_c.mutation.SessionIDs()) == 0 {
 		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "Message.session"`)}
 	}
@@ -264,6 +270,10 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	if value, ok := _c.mutation.SequenceNumber(); ok {
 		_spec.SetField(message.FieldSequenceNumber, field.TypeInt, value)
 		_node.SequenceNumber = value
@@ -288,9 +298,9 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldToolName, field.TypeString, value)
 		_node.ToolName = &value
 	}
-	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
+	if value, ok := _c.mutation.Images(); ok {
+		_spec.SetField(message.FieldImages, field.TypeJSON, value)
+		_node.Images = value
 	}
 	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -410,12 +410,12 @@ func (_q *MessageQuery) WithLlmInteractions(opts ...func(*LLMInteractionQuery))
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Message.Query().
-//		GroupBy(message.FieldSessionID).
+//		GroupBy(message.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *MessageQuery) GroupBy(field string, fields ...string) *MessageGroupBy {
@@ -433,11 +433,11 @@ func (_q *MessageQuery) GroupBy(field string, fields ...string) *MessageGroupBy
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.Message.Query().
-//		Select(message.FieldSessionID).
+//		Select(message.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *MessageQuery) Select(fields ...string) *MessageSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
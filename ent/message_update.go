@@ -138,6 +138,24 @@ func (_u *MessageUpdate) ClearToolName() *MessageUpdate {
 	return _u
 }
 
+// SetImages sets the "images" field.
+func (_u *MessageUpdate) SetImages(v []schema.ImageRef) *MessageUpdate {
+	_u.mutation.SetImages(v)
+	return _u
+}
+
+// AppendImages appends value to the "images" field.
+func (_u *MessageUpdate) AppendImages(v []schema.ImageRef) *MessageUpdate {
+	_u.mutation.AppendImages(v)
+	return _u
+}
+
+// ClearImages clears the value of the "images" field.
+func (_u *MessageUpdate) ClearImages() *MessageUpdate {
+	_u.mutation.ClearImages()
+	return _u
+}
+
 // AddLlmInteractionIDs adds the "llm_interactions" edge to the LLMInteraction entity by IDs.
 func (_u *MessageUpdate) AddLlmInteractionIDs(ids ...string) *MessageUpdate {
 	_u.mutation.AddLlmInteractionIDs(ids...)
@@ -278,6 +296,17 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ToolNameCleared() {
 		_spec.ClearField(message.FieldToolName, field.TypeString)
 	}
+	if value, ok := _u.mutation.Images(); ok {
+		_spec.SetField(message.FieldImages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, message.FieldImages, value)
+		})
+	}
+	if _u.mutation.ImagesCleared() {
+		_spec.ClearField(message.FieldImages, field.TypeJSON)
+	}
 	if _u.mutation.LlmInteractionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -452,6 +481,24 @@ func (_u *MessageUpdateOne) ClearToolName() *MessageUpdateOne {
 	return _u
 }
 
+// SetImages sets the "images" field.
+func (_u *MessageUpdateOne) SetImages(v []schema.ImageRef) *MessageUpdateOne {
+	_u.mutation.SetImages(v)
+	return _u
+}
+
+// AppendImages appends value to the "images" field.
+func (_u *MessageUpdateOne) AppendImages(v []schema.ImageRef) *MessageUpdateOne {
+	_u.mutation.AppendImages(v)
+	return _u
+}
+
+// ClearImages clears the value of the "images" field.
+func (_u *MessageUpdateOne) ClearImages() *MessageUpdateOne {
+	_u.mutation.ClearImages()
+	return _u
+}
+
 // AddLlmInteractionIDs adds the "llm_interactions" edge to the LLMInteraction entity by IDs.
 func (_u *MessageUpdateOne) AddLlmInteractionIDs(ids ...string) *MessageUpdateOne {
 	_u.mutation.AddLlmInteractionIDs(ids...)
@@ -622,6 +669,17 @@ func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err er
 	if _u.mutation.ToolNameCleared() {
 		_spec.ClearField(message.FieldToolName, field.TypeString)
 	}
+	if value, ok := _u.mutation.Images(); ok {
+		_spec.SetField(message.FieldImages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedImages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, message.FieldImages, value)
+		})
+	}
+	if _u.mutation.ImagesCleared() {
+		_spec.ClearField(message.FieldImages, field.TypeJSON)
+	}
 	if _u.mutation.LlmInteractionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
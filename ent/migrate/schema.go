@@ -9,6 +9,77 @@ import (
 )
 
 var (
+	// ActionItemsColumns holds the columns for the "action_items" table.
+	ActionItemsColumns = []*schema.Column{
+		{Name: "action_item_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "details", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "done"}, Default: "open"},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"extracted", "manual"}},
+		{Name: "assignee", Type: field.TypeString, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_by", Type: field.TypeString, Nullable: true},
+		{Name: "session_id", Type: field.TypeString},
+	}
+	// ActionItemsTable holds the schema information for the "action_items" table.
+	ActionItemsTable = &schema.Table{
+		Name:       "action_items",
+		Columns:    ActionItemsColumns,
+		PrimaryKey: []*schema.Column{ActionItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "action_items_alert_sessions_action_items",
+				Columns:    []*schema.Column{ActionItemsColumns[12]},
+				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "actionitem_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{ActionItemsColumns[12]},
+			},
+			{
+				Name:    "actionitem_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActionItemsColumns[7], ActionItemsColumns[1]},
+			},
+		},
+	}
+	// ActivityReportsColumns holds the columns for the "activity_reports" table.
+	ActivityReportsColumns = []*schema.Column{
+		{Name: "report_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "period", Type: field.TypeEnum, Enums: []string{"daily", "weekly"}},
+		{Name: "window_start", Type: field.TypeTime},
+		{Name: "window_end", Type: field.TypeTime},
+		{Name: "stats", Type: field.TypeJSON},
+		{Name: "narrative", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "narrative_error", Type: field.TypeString, Nullable: true},
+	}
+	// ActivityReportsTable holds the schema information for the "activity_reports" table.
+	ActivityReportsTable = &schema.Table{
+		Name:       "activity_reports",
+		Columns:    ActivityReportsColumns,
+		PrimaryKey: []*schema.Column{ActivityReportsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "activityreport_period_window_start",
+				Unique:  true,
+				Columns: []*schema.Column{ActivityReportsColumns[2], ActivityReportsColumns[3]},
+			},
+			{
+				Name:    "activityreport_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ActivityReportsColumns[1]},
+			},
+		},
+	}
 	// AgentExecutionsColumns holds the columns for the "agent_executions" table.
 	AgentExecutionsColumns = []*schema.Column{
 		{Name: "execution_id", Type: field.TypeString, Unique: true},
@@ -24,6 +95,8 @@ var (
 		{Name: "original_llm_provider", Type: field.TypeString, Nullable: true},
 		{Name: "original_llm_backend", Type: field.TypeString, Nullable: true},
 		{Name: "task", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "tool_call_count", Type: field.TypeInt, Default: 0},
+		{Name: "loop_detected", Type: field.TypeBool, Default: false},
 		{Name: "parent_execution_id", Type: field.TypeString, Nullable: true},
 		{Name: "session_id", Type: field.TypeString},
 		{Name: "stage_id", Type: field.TypeString},
@@ -36,19 +109,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "agent_executions_agent_executions_sub_agents",
-				Columns:    []*schema.Column{AgentExecutionsColumns[13]},
+				Columns:    []*schema.Column{AgentExecutionsColumns[15]},
 				RefColumns: []*schema.Column{AgentExecutionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "agent_executions_alert_sessions_agent_executions",
-				Columns:    []*schema.Column{AgentExecutionsColumns[14]},
+				Columns:    []*schema.Column{AgentExecutionsColumns[16]},
 				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "agent_executions_stages_agent_executions",
-				Columns:    []*schema.Column{AgentExecutionsColumns[15]},
+				Columns:    []*schema.Column{AgentExecutionsColumns[17]},
 				RefColumns: []*schema.Column{StagesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -57,22 +130,24 @@ var (
 			{
 				Name:    "agentexecution_session_id",
 				Unique:  false,
-				Columns: []*schema.Column{AgentExecutionsColumns[14]},
+				Columns: []*schema.Column{AgentExecutionsColumns[16]},
 			},
 			{
 				Name:    "agentexecution_parent_execution_id",
 				Unique:  false,
-				Columns: []*schema.Column{AgentExecutionsColumns[13]},
+				Columns: []*schema.Column{AgentExecutionsColumns[15]},
 			},
 		},
 	}
 	// AlertSessionsColumns holds the columns for the "alert_sessions" table.
 	AlertSessionsColumns = []*schema.Column{
 		{Name: "session_id", Type: field.TypeString, Unique: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "alert_data", Type: field.TypeString, Size: 2147483647},
 		{Name: "agent_type", Type: field.TypeString},
 		{Name: "alert_type", Type: field.TypeString, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "in_progress", "cancelling", "completed", "failed", "cancelled", "timed_out"}, Default: "pending"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "in_progress", "cancelling", "completed", "failed", "cancelled", "timed_out", "auto_cancelled"}, Default: "pending"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
@@ -80,17 +155,52 @@ var (
 		{Name: "final_analysis", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "executive_summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "executive_summary_error", Type: field.TypeString, Nullable: true},
+		{Name: "executive_summary_template", Type: field.TypeString, Nullable: true},
+		{Name: "technical_summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "technical_summary_error", Type: field.TypeString, Nullable: true},
+		{Name: "severity", Type: field.TypeEnum, Nullable: true, Enums: []string{"critical", "high", "medium", "low"}},
+		{Name: "outcome", Type: field.TypeEnum, Nullable: true, Enums: []string{"root_cause_identified", "mitigated", "needs_human", "false_positive", "inconclusive"}},
+		{Name: "outcome_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "outcome_source", Type: field.TypeEnum, Nullable: true, Enums: []string{"agent", "classifier", "noise_triage"}},
 		{Name: "session_metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "author", Type: field.TypeString, Nullable: true},
+		{Name: "author_subject", Type: field.TypeString, Nullable: true},
 		{Name: "runbook_url", Type: field.TypeString, Nullable: true},
 		{Name: "mcp_selection", Type: field.TypeJSON, Nullable: true},
+		{Name: "alert_instructions", Type: field.TypeJSON, Nullable: true},
+		{Name: "alert_images", Type: field.TypeJSON, Nullable: true},
+		{Name: "output_language", Type: field.TypeString, Nullable: true},
 		{Name: "chain_id", Type: field.TypeString},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
 		{Name: "current_stage_index", Type: field.TypeInt, Nullable: true},
 		{Name: "current_stage_id", Type: field.TypeString, Nullable: true},
 		{Name: "pod_id", Type: field.TypeString, Nullable: true},
 		{Name: "last_interaction_at", Type: field.TypeTime, Nullable: true},
 		{Name: "slack_message_fingerprint", Type: field.TypeString, Nullable: true},
-		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "alert_key", Type: field.TypeString, Nullable: true},
+		{Name: "alert_resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "alert_resolution", Type: field.TypeString, Nullable: true},
+		{Name: "cancel_initiator", Type: field.TypeEnum, Nullable: true, Enums: []string{"user", "pending_ttl", "alert_resolved", "preemption"}},
+		{Name: "cancel_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "cancelled_by", Type: field.TypeString, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "degraded_reason", Type: field.TypeString, Nullable: true},
+		{Name: "model_routing", Type: field.TypeJSON, Nullable: true},
+		{Name: "noise_triage", Type: field.TypeJSON, Nullable: true},
+		{Name: "force_full_investigation", Type: field.TypeBool, Default: false},
+		{Name: "federation_origin", Type: field.TypeJSON, Nullable: true},
+		{Name: "target", Type: field.TypeJSON, Nullable: true},
+		{Name: "on_call", Type: field.TypeJSON, Nullable: true},
+		{Name: "duplicated_from", Type: field.TypeString, Nullable: true},
+		{Name: "llm_provider", Type: field.TypeString, Nullable: true},
+		{Name: "sandbox", Type: field.TypeBool, Default: false},
+		{Name: "fallback_chain", Type: field.TypeBool, Default: false},
+		{Name: "callback_url", Type: field.TypeString, Nullable: true},
+		{Name: "callback_secret", Type: field.TypeString, Nullable: true},
+		{Name: "callback_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "delivered", "failed"}},
+		{Name: "callback_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "callback_last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "callback_delivered_at", Type: field.TypeTime, Nullable: true},
 		{Name: "review_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"needs_review", "in_progress", "reviewed"}},
 		{Name: "assignee", Type: field.TypeString, Nullable: true},
 		{Name: "assigned_at", Type: field.TypeTime, Nullable: true},
@@ -98,78 +208,137 @@ var (
 		{Name: "quality_rating", Type: field.TypeEnum, Nullable: true, Enums: []string{"accurate", "partially_accurate", "inaccurate"}},
 		{Name: "action_taken", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "investigation_feedback", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "handoff_notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "handoff_notes_revision", Type: field.TypeInt, Default: 0},
+		{Name: "handoff_notes_updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "handoff_notes_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "group_id", Type: field.TypeString, Nullable: true},
 	}
 	// AlertSessionsTable holds the schema information for the "alert_sessions" table.
 	AlertSessionsTable = &schema.Table{
 		Name:       "alert_sessions",
 		Columns:    AlertSessionsColumns,
 		PrimaryKey: []*schema.Column{AlertSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "alert_sessions_session_groups_sessions",
+				Columns:    []*schema.Column{AlertSessionsColumns[71]},
+				RefColumns: []*schema.Column{SessionGroupsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
+			{
+				Name:    "alertsession_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{AlertSessionsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NOT NULL",
+				},
+			},
 			{
 				Name:    "alertsession_status",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[4]},
+				Columns: []*schema.Column{AlertSessionsColumns[6]},
 			},
 			{
 				Name:    "alertsession_agent_type",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[2]},
+				Columns: []*schema.Column{AlertSessionsColumns[4]},
 			},
 			{
 				Name:    "alertsession_alert_type",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[3]},
+				Columns: []*schema.Column{AlertSessionsColumns[5]},
 			},
 			{
 				Name:    "alertsession_chain_id",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[16]},
+				Columns: []*schema.Column{AlertSessionsColumns[29]},
 			},
 			{
-				Name:    "alertsession_status_created_at",
+				Name:    "alertsession_owner",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[4], AlertSessionsColumns[5]},
+				Columns: []*schema.Column{AlertSessionsColumns[30]},
 			},
 			{
-				Name:    "alertsession_status_started_at",
+				Name:    "alertsession_request_id",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[4], AlertSessionsColumns[6]},
+				Columns: []*schema.Column{AlertSessionsColumns[42]},
 			},
 			{
-				Name:    "alertsession_status_last_interaction_at",
+				Name:    "alertsession_alert_key",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[4], AlertSessionsColumns[20]},
+				Columns: []*schema.Column{AlertSessionsColumns[36]},
 			},
 			{
-				Name:    "alertsession_deleted_at",
+				Name:    "alertsession_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[22]},
-				Annotation: &entsql.IndexAnnotation{
-					Where: "deleted_at IS NOT NULL",
-				},
+				Columns: []*schema.Column{AlertSessionsColumns[71]},
+			},
+			{
+				Name:    "alertsession_duplicated_from",
+				Unique:  false,
+				Columns: []*schema.Column{AlertSessionsColumns[50]},
+			},
+			{
+				Name:    "alertsession_outcome",
+				Unique:  false,
+				Columns: []*schema.Column{AlertSessionsColumns[18]},
+			},
+			{
+				Name:    "alertsession_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AlertSessionsColumns[6], AlertSessionsColumns[7]},
+			},
+			{
+				Name:    "alertsession_status_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{AlertSessionsColumns[6], AlertSessionsColumns[8]},
+			},
+			{
+				Name:    "alertsession_status_last_interaction_at",
+				Unique:  false,
+				Columns: []*schema.Column{AlertSessionsColumns[6], AlertSessionsColumns[34]},
 			},
 			{
 				Name:    "alertsession_review_status",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[23]},
+				Columns: []*schema.Column{AlertSessionsColumns[60]},
 			},
 			{
 				Name:    "alertsession_review_status_assignee",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[23], AlertSessionsColumns[24]},
+				Columns: []*schema.Column{AlertSessionsColumns[60], AlertSessionsColumns[61]},
 			},
 			{
 				Name:    "alertsession_assignee",
 				Unique:  false,
-				Columns: []*schema.Column{AlertSessionsColumns[24]},
+				Columns: []*schema.Column{AlertSessionsColumns[61]},
 			},
 		},
 	}
+	// BlobsColumns holds the columns for the "blobs" table.
+	BlobsColumns = []*schema.Column{
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "object_oid", Type: field.TypeUint32, SchemaType: map[string]string{"postgres": "oid"}},
+		{Name: "size_bytes", Type: field.TypeInt64},
+		{Name: "content_type", Type: field.TypeString},
+	}
+	// BlobsTable holds the schema information for the "blobs" table.
+	BlobsTable = &schema.Table{
+		Name:       "blobs",
+		Columns:    BlobsColumns,
+		PrimaryKey: []*schema.Column{BlobsColumns[0]},
+	}
 	// ChatsColumns holds the columns for the "chats" table.
 	ChatsColumns = []*schema.Column{
 		{Name: "chat_id", Type: field.TypeString, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
 		{Name: "chain_id", Type: field.TypeString},
 		{Name: "pod_id", Type: field.TypeString, Nullable: true},
 		{Name: "last_interaction_at", Type: field.TypeTime, Nullable: true},
@@ -183,7 +352,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "chats_alert_sessions_chat",
-				Columns:    []*schema.Column{ChatsColumns[6]},
+				Columns:    []*schema.Column{ChatsColumns[8]},
 				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -192,7 +361,7 @@ var (
 			{
 				Name:    "chat_session_id",
 				Unique:  true,
-				Columns: []*schema.Column{ChatsColumns[6]},
+				Columns: []*schema.Column{ChatsColumns[8]},
 			},
 			{
 				Name:    "chat_created_at",
@@ -202,16 +371,19 @@ var (
 			{
 				Name:    "chat_pod_id_last_interaction_at",
 				Unique:  false,
-				Columns: []*schema.Column{ChatsColumns[4], ChatsColumns[5]},
+				Columns: []*schema.Column{ChatsColumns[6], ChatsColumns[7]},
 			},
 		},
 	}
 	// ChatUserMessagesColumns holds the columns for the "chat_user_messages" table.
 	ChatUserMessagesColumns = []*schema.Column{
 		{Name: "message_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "author", Type: field.TypeString},
-		{Name: "created_at", Type: field.TypeTime},
+		{Name: "author_subject", Type: field.TypeString, Nullable: true},
+		{Name: "images", Type: field.TypeJSON, Nullable: true},
+		{Name: "voice_note", Type: field.TypeJSON, Nullable: true},
 		{Name: "chat_id", Type: field.TypeString},
 	}
 	// ChatUserMessagesTable holds the schema information for the "chat_user_messages" table.
@@ -222,7 +394,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "chat_user_messages_chats_user_messages",
-				Columns:    []*schema.Column{ChatUserMessagesColumns[4]},
+				Columns:    []*schema.Column{ChatUserMessagesColumns[7]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -231,21 +403,46 @@ var (
 			{
 				Name:    "chatusermessage_chat_id",
 				Unique:  false,
-				Columns: []*schema.Column{ChatUserMessagesColumns[4]},
+				Columns: []*schema.Column{ChatUserMessagesColumns[7]},
 			},
 			{
 				Name:    "chatusermessage_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{ChatUserMessagesColumns[3]},
+				Columns: []*schema.Column{ChatUserMessagesColumns[1]},
+			},
+		},
+	}
+	// ConfigRegistrationsColumns holds the columns for the "config_registrations" table.
+	ConfigRegistrationsColumns = []*schema.Column{
+		{Name: "registration_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"agent", "chain"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "definition", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "deleted", Type: field.TypeBool, Default: false},
+	}
+	// ConfigRegistrationsTable holds the schema information for the "config_registrations" table.
+	ConfigRegistrationsTable = &schema.Table{
+		Name:       "config_registrations",
+		Columns:    ConfigRegistrationsColumns,
+		PrimaryKey: []*schema.Column{ConfigRegistrationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "configregistration_kind_name_version",
+				Unique:  true,
+				Columns: []*schema.Column{ConfigRegistrationsColumns[4], ConfigRegistrationsColumns[5], ConfigRegistrationsColumns[6]},
 			},
 		},
 	}
 	// EventsColumns holds the columns for the "events" table.
 	EventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
 		{Name: "channel", Type: field.TypeString},
 		{Name: "payload", Type: field.TypeJSON},
-		{Name: "created_at", Type: field.TypeTime},
 		{Name: "session_id", Type: field.TypeString},
 	}
 	// EventsTable holds the schema information for the "events" table.
@@ -265,7 +462,7 @@ var (
 			{
 				Name:    "event_channel",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[1]},
+				Columns: []*schema.Column{EventsColumns[2]},
 			},
 			{
 				Name:    "event_session_id",
@@ -275,18 +472,71 @@ var (
 			{
 				Name:    "event_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[3]},
+				Columns: []*schema.Column{EventsColumns[1]},
 			},
 			{
 				Name:    "event_channel_id",
 				Unique:  false,
-				Columns: []*schema.Column{EventsColumns[1], EventsColumns[0]},
+				Columns: []*schema.Column{EventsColumns[2], EventsColumns[0]},
+			},
+		},
+	}
+	// FeatureFlagOverridesColumns holds the columns for the "feature_flag_overrides" table.
+	FeatureFlagOverridesColumns = []*schema.Column{
+		{Name: "flag", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool},
+		{Name: "percentage", Type: field.TypeInt, Nullable: true},
+		{Name: "alert_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "chains", Type: field.TypeJSON, Nullable: true},
+		{Name: "namespaces", Type: field.TypeJSON, Nullable: true},
+	}
+	// FeatureFlagOverridesTable holds the schema information for the "feature_flag_overrides" table.
+	FeatureFlagOverridesTable = &schema.Table{
+		Name:       "feature_flag_overrides",
+		Columns:    FeatureFlagOverridesColumns,
+		PrimaryKey: []*schema.Column{FeatureFlagOverridesColumns[0]},
+	}
+	// HandoffNoteRevisionsColumns holds the columns for the "handoff_note_revisions" table.
+	HandoffNoteRevisionsColumns = []*schema.Column{
+		{Name: "revision_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "revision", Type: field.TypeInt},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "author", Type: field.TypeString},
+		{Name: "session_id", Type: field.TypeString},
+	}
+	// HandoffNoteRevisionsTable holds the schema information for the "handoff_note_revisions" table.
+	HandoffNoteRevisionsTable = &schema.Table{
+		Name:       "handoff_note_revisions",
+		Columns:    HandoffNoteRevisionsColumns,
+		PrimaryKey: []*schema.Column{HandoffNoteRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "handoff_note_revisions_alert_sessions_handoff_note_revisions",
+				Columns:    []*schema.Column{HandoffNoteRevisionsColumns[5]},
+				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "handoffnoterevision_session_id_revision",
+				Unique:  true,
+				Columns: []*schema.Column{HandoffNoteRevisionsColumns[5], HandoffNoteRevisionsColumns[2]},
 			},
 		},
 	}
 	// InvestigationMemoriesColumns holds the columns for the "investigation_memories" table.
 	InvestigationMemoriesColumns = []*schema.Column{
 		{Name: "memory_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
 		{Name: "project", Type: field.TypeString, Default: "default"},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "category", Type: field.TypeEnum, Enums: []string{"semantic", "episodic", "procedural"}},
@@ -295,8 +545,6 @@ var (
 		{Name: "seen_count", Type: field.TypeInt, Default: 1},
 		{Name: "alert_type", Type: field.TypeString, Nullable: true},
 		{Name: "chain_id", Type: field.TypeString, Nullable: true},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "last_seen_at", Type: field.TypeTime},
 		{Name: "deprecated", Type: field.TypeBool, Default: false},
 		{Name: "source_session_id", Type: field.TypeString},
@@ -309,7 +557,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "investigation_memories_alert_sessions_memories",
-				Columns:    []*schema.Column{InvestigationMemoriesColumns[13]},
+				Columns:    []*schema.Column{InvestigationMemoriesColumns[15]},
 				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -318,33 +566,47 @@ var (
 			{
 				Name:    "investigationmemory_project",
 				Unique:  false,
-				Columns: []*schema.Column{InvestigationMemoriesColumns[1]},
+				Columns: []*schema.Column{InvestigationMemoriesColumns[5]},
 			},
 			{
 				Name:    "investigationmemory_project_deprecated",
 				Unique:  false,
-				Columns: []*schema.Column{InvestigationMemoriesColumns[1], InvestigationMemoriesColumns[12]},
+				Columns: []*schema.Column{InvestigationMemoriesColumns[5], InvestigationMemoriesColumns[14]},
 			},
 			{
 				Name:    "investigationmemory_source_session_id",
 				Unique:  false,
-				Columns: []*schema.Column{InvestigationMemoriesColumns[13]},
+				Columns: []*schema.Column{InvestigationMemoriesColumns[15]},
 			},
 			{
 				Name:    "investigationmemory_category",
 				Unique:  false,
-				Columns: []*schema.Column{InvestigationMemoriesColumns[3]},
+				Columns: []*schema.Column{InvestigationMemoriesColumns[7]},
 			},
 		},
 	}
+	// JobLeadersColumns holds the columns for the "job_leaders" table.
+	JobLeadersColumns = []*schema.Column{
+		{Name: "job", Type: field.TypeString, Unique: true},
+		{Name: "pod_id", Type: field.TypeString},
+		{Name: "acquired_at", Type: field.TypeTime},
+		{Name: "renewed_at", Type: field.TypeTime},
+	}
+	// JobLeadersTable holds the schema information for the "job_leaders" table.
+	JobLeadersTable = &schema.Table{
+		Name:       "job_leaders",
+		Columns:    JobLeadersColumns,
+		PrimaryKey: []*schema.Column{JobLeadersColumns[0]},
+	}
 	// LlmInteractionsColumns holds the columns for the "llm_interactions" table.
 	LlmInteractionsColumns = []*schema.Column{
 		{Name: "interaction_id", Type: field.TypeString, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
-		{Name: "interaction_type", Type: field.TypeEnum, Enums: []string{"iteration", "final_analysis", "executive_summary", "chat_response", "summarization", "synthesis", "forced_conclusion", "scoring", "memory_extraction"}},
+		{Name: "interaction_type", Type: field.TypeEnum, Enums: []string{"iteration", "final_analysis", "executive_summary", "technical_summary", "chat_response", "summarization", "synthesis", "forced_conclusion", "scoring", "memory_extraction", "outcome_classification", "action_item_extraction", "recurrence_comparison", "fanout_synthesis", "noise_triage", "model_routing"}},
 		{Name: "model_name", Type: field.TypeString},
 		{Name: "llm_request", Type: field.TypeJSON},
 		{Name: "llm_response", Type: field.TypeJSON},
+		{Name: "payload_zstd", Type: field.TypeBytes, Nullable: true},
 		{Name: "thinking_content", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "response_metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "input_tokens", Type: field.TypeInt, Nullable: true},
@@ -354,6 +616,8 @@ var (
 		{Name: "estimated_cost_usd", Type: field.TypeFloat64, Nullable: true},
 		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
 		{Name: "execution_id", Type: field.TypeString, Nullable: true},
 		{Name: "session_id", Type: field.TypeString},
 		{Name: "last_message_id", Type: field.TypeString, Nullable: true},
@@ -367,25 +631,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "llm_interactions_agent_executions_llm_interactions",
-				Columns:    []*schema.Column{LlmInteractionsColumns[15]},
+				Columns:    []*schema.Column{LlmInteractionsColumns[18]},
 				RefColumns: []*schema.Column{AgentExecutionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "llm_interactions_alert_sessions_llm_interactions",
-				Columns:    []*schema.Column{LlmInteractionsColumns[16]},
+				Columns:    []*schema.Column{LlmInteractionsColumns[19]},
 				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "llm_interactions_messages_llm_interactions",
-				Columns:    []*schema.Column{LlmInteractionsColumns[17]},
+				Columns:    []*schema.Column{LlmInteractionsColumns[20]},
 				RefColumns: []*schema.Column{MessagesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "llm_interactions_stages_llm_interactions",
-				Columns:    []*schema.Column{LlmInteractionsColumns[18]},
+				Columns:    []*schema.Column{LlmInteractionsColumns[21]},
 				RefColumns: []*schema.Column{StagesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -394,17 +658,17 @@ var (
 			{
 				Name:    "llminteraction_execution_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LlmInteractionsColumns[15], LlmInteractionsColumns[1]},
+				Columns: []*schema.Column{LlmInteractionsColumns[18], LlmInteractionsColumns[1]},
 			},
 			{
 				Name:    "llminteraction_stage_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LlmInteractionsColumns[18], LlmInteractionsColumns[1]},
+				Columns: []*schema.Column{LlmInteractionsColumns[21], LlmInteractionsColumns[1]},
 			},
 			{
 				Name:    "llminteraction_session_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{LlmInteractionsColumns[16], LlmInteractionsColumns[1]},
+				Columns: []*schema.Column{LlmInteractionsColumns[19], LlmInteractionsColumns[1]},
 			},
 		},
 	}
@@ -417,9 +681,13 @@ var (
 		{Name: "tool_name", Type: field.TypeString, Nullable: true},
 		{Name: "tool_arguments", Type: field.TypeJSON, Nullable: true},
 		{Name: "tool_result", Type: field.TypeJSON, Nullable: true},
+		{Name: "tool_result_zstd", Type: field.TypeBytes, Nullable: true},
+		{Name: "tool_result_bytes", Type: field.TypeInt, Nullable: true},
+		{Name: "tool_result_blob", Type: field.TypeString, Nullable: true},
 		{Name: "available_tools", Type: field.TypeJSON, Nullable: true},
 		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
 		{Name: "execution_id", Type: field.TypeString},
 		{Name: "session_id", Type: field.TypeString},
 		{Name: "stage_id", Type: field.TypeString},
@@ -432,19 +700,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "mcp_interactions_agent_executions_mcp_interactions",
-				Columns:    []*schema.Column{McpInteractionsColumns[10]},
+				Columns:    []*schema.Column{McpInteractionsColumns[14]},
 				RefColumns: []*schema.Column{AgentExecutionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "mcp_interactions_alert_sessions_mcp_interactions",
-				Columns:    []*schema.Column{McpInteractionsColumns[11]},
+				Columns:    []*schema.Column{McpInteractionsColumns[15]},
 				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "mcp_interactions_stages_mcp_interactions",
-				Columns:    []*schema.Column{McpInteractionsColumns[12]},
+				Columns:    []*schema.Column{McpInteractionsColumns[16]},
 				RefColumns: []*schema.Column{StagesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -453,30 +721,31 @@ var (
 			{
 				Name:    "mcpinteraction_execution_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{McpInteractionsColumns[10], McpInteractionsColumns[1]},
+				Columns: []*schema.Column{McpInteractionsColumns[14], McpInteractionsColumns[1]},
 			},
 			{
 				Name:    "mcpinteraction_stage_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{McpInteractionsColumns[12], McpInteractionsColumns[1]},
+				Columns: []*schema.Column{McpInteractionsColumns[16], McpInteractionsColumns[1]},
 			},
 			{
 				Name:    "mcpinteraction_session_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{McpInteractionsColumns[11], McpInteractionsColumns[1]},
+				Columns: []*schema.Column{McpInteractionsColumns[15], McpInteractionsColumns[1]},
 			},
 		},
 	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "message_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
 		{Name: "sequence_number", Type: field.TypeInt},
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"system", "user", "assistant", "tool"}},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "tool_calls", Type: field.TypeJSON, Nullable: true},
 		{Name: "tool_call_id", Type: field.TypeString, Nullable: true},
 		{Name: "tool_name", Type: field.TypeString, Nullable: true},
-		{Name: "created_at", Type: field.TypeTime},
+		{Name: "images", Type: field.TypeJSON, Nullable: true},
 		{Name: "execution_id", Type: field.TypeString},
 		{Name: "session_id", Type: field.TypeString},
 		{Name: "stage_id", Type: field.TypeString},
@@ -489,19 +758,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_agent_executions_messages",
-				Columns:    []*schema.Column{MessagesColumns[8]},
+				Columns:    []*schema.Column{MessagesColumns[9]},
 				RefColumns: []*schema.Column{AgentExecutionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "messages_alert_sessions_messages",
-				Columns:    []*schema.Column{MessagesColumns[9]},
+				Columns:    []*schema.Column{MessagesColumns[10]},
 				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "messages_stages_messages",
-				Columns:    []*schema.Column{MessagesColumns[10]},
+				Columns:    []*schema.Column{MessagesColumns[11]},
 				RefColumns: []*schema.Column{StagesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -510,18 +779,254 @@ var (
 			{
 				Name:    "message_execution_id_sequence_number",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[8], MessagesColumns[1]},
+				Columns: []*schema.Column{MessagesColumns[9], MessagesColumns[2]},
 			},
 			{
 				Name:    "message_stage_id_execution_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[10], MessagesColumns[8]},
+				Columns: []*schema.Column{MessagesColumns[11], MessagesColumns[9]},
+			},
+		},
+	}
+	// PodHeartbeatsColumns holds the columns for the "pod_heartbeats" table.
+	PodHeartbeatsColumns = []*schema.Column{
+		{Name: "pod_id", Type: field.TypeString, Unique: true},
+		{Name: "config_hash", Type: field.TypeString},
+		{Name: "version", Type: field.TypeString},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime},
+	}
+	// PodHeartbeatsTable holds the schema information for the "pod_heartbeats" table.
+	PodHeartbeatsTable = &schema.Table{
+		Name:       "pod_heartbeats",
+		Columns:    PodHeartbeatsColumns,
+		PrimaryKey: []*schema.Column{PodHeartbeatsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "podheartbeat_last_seen_at",
+				Unique:  false,
+				Columns: []*schema.Column{PodHeartbeatsColumns[4]},
+			},
+		},
+	}
+	// QueuePausesColumns holds the columns for the "queue_pauses" table.
+	QueuePausesColumns = []*schema.Column{
+		{Name: "scope", Type: field.TypeString, Unique: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "maintenance", Type: field.TypeBool, Default: false},
+		{Name: "drain", Type: field.TypeBool, Default: false},
+		{Name: "paused_by", Type: field.TypeString, Nullable: true},
+		{Name: "paused_at", Type: field.TypeTime},
+	}
+	// QueuePausesTable holds the schema information for the "queue_pauses" table.
+	QueuePausesTable = &schema.Table{
+		Name:       "queue_pauses",
+		Columns:    QueuePausesColumns,
+		PrimaryKey: []*schema.Column{QueuePausesColumns[0]},
+	}
+	// RedactionReviewsColumns holds the columns for the "redaction_reviews" table.
+	RedactionReviewsColumns = []*schema.Column{
+		{Name: "review_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "mcp_interaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "server_name", Type: field.TypeString},
+		{Name: "tool_name", Type: field.TypeString, Nullable: true},
+		{Name: "heuristic", Type: field.TypeString},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "preview", Type: field.TypeString},
+		{Name: "context", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "confirmed", "dismissed"}, Default: "pending"},
+		{Name: "reviewed_by", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "rows_redacted", Type: field.TypeJSON, Nullable: true},
+		{Name: "session_id", Type: field.TypeString},
+	}
+	// RedactionReviewsTable holds the schema information for the "redaction_reviews" table.
+	RedactionReviewsTable = &schema.Table{
+		Name:       "redaction_reviews",
+		Columns:    RedactionReviewsColumns,
+		PrimaryKey: []*schema.Column{RedactionReviewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "redaction_reviews_alert_sessions_redaction_reviews",
+				Columns:    []*schema.Column{RedactionReviewsColumns[16]},
+				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "redactionreview_session_id_fingerprint",
+				Unique:  true,
+				Columns: []*schema.Column{RedactionReviewsColumns[16], RedactionReviewsColumns[7]},
+			},
+			{
+				Name:    "redactionreview_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RedactionReviewsColumns[11], RedactionReviewsColumns[1]},
+			},
+			{
+				Name:    "redactionreview_fingerprint",
+				Unique:  false,
+				Columns: []*schema.Column{RedactionReviewsColumns[7]},
+			},
+		},
+	}
+	// SavedViewsColumns holds the columns for the "saved_views" table.
+	SavedViewsColumns = []*schema.Column{
+		{Name: "view_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "filter", Type: field.TypeJSON},
+		{Name: "notify", Type: field.TypeBool, Default: false},
+		{Name: "slack_channel", Type: field.TypeString, Nullable: true},
+	}
+	// SavedViewsTable holds the schema information for the "saved_views" table.
+	SavedViewsTable = &schema.Table{
+		Name:       "saved_views",
+		Columns:    SavedViewsColumns,
+		PrimaryKey: []*schema.Column{SavedViewsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedview_owner_name",
+				Unique:  true,
+				Columns: []*schema.Column{SavedViewsColumns[5], SavedViewsColumns[6]},
+			},
+		},
+	}
+	// SchemaCompatibilitiesColumns holds the columns for the "schema_compatibilities" table.
+	SchemaCompatibilitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "min_version", Type: field.TypeInt64, Default: 0},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+	}
+	// SchemaCompatibilitiesTable holds the schema information for the "schema_compatibilities" table.
+	SchemaCompatibilitiesTable = &schema.Table{
+		Name:       "schema_compatibilities",
+		Columns:    SchemaCompatibilitiesColumns,
+		PrimaryKey: []*schema.Column{SchemaCompatibilitiesColumns[0]},
+	}
+	// SessionClaimsColumns holds the columns for the "session_claims" table.
+	SessionClaimsColumns = []*schema.Column{
+		{Name: "claim_id", Type: field.TypeString, Unique: true},
+		{Name: "pod_id", Type: field.TypeString},
+		{Name: "worker_id", Type: field.TypeString},
+		{Name: "claimed_at", Type: field.TypeTime},
+		{Name: "queue_wait_ms", Type: field.TypeInt64},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "outcome", Type: field.TypeEnum, Nullable: true, Enums: []string{"completed", "failed", "timed_out", "cancelled", "orphaned", "pod_restarted"}},
+		{Name: "last_heartbeat_at", Type: field.TypeTime, Nullable: true},
+		{Name: "detail", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "session_id", Type: field.TypeString},
+	}
+	// SessionClaimsTable holds the schema information for the "session_claims" table.
+	SessionClaimsTable = &schema.Table{
+		Name:       "session_claims",
+		Columns:    SessionClaimsColumns,
+		PrimaryKey: []*schema.Column{SessionClaimsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "session_claims_alert_sessions_claims",
+				Columns:    []*schema.Column{SessionClaimsColumns[9]},
+				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sessionclaim_session_id_claimed_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionClaimsColumns[9], SessionClaimsColumns[3]},
+			},
+			{
+				Name:    "sessionclaim_released_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionClaimsColumns[5]},
+			},
+		},
+	}
+	// SessionComparisonsColumns holds the columns for the "session_comparisons" table.
+	SessionComparisonsColumns = []*schema.Column{
+		{Name: "comparison_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "alert_key", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"completed", "failed"}},
+		{Name: "previous_conclusion", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "current_conclusion", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "differences", Type: field.TypeJSON, Nullable: true},
+		{Name: "same_root_cause", Type: field.TypeBool, Nullable: true},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "session_id", Type: field.TypeString, Unique: true},
+		{Name: "previous_session_id", Type: field.TypeString},
+	}
+	// SessionComparisonsTable holds the schema information for the "session_comparisons" table.
+	SessionComparisonsTable = &schema.Table{
+		Name:       "session_comparisons",
+		Columns:    SessionComparisonsColumns,
+		PrimaryKey: []*schema.Column{SessionComparisonsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "session_comparisons_alert_sessions_comparison",
+				Columns:    []*schema.Column{SessionComparisonsColumns[9]},
+				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "session_comparisons_alert_sessions_next_comparisons",
+				Columns:    []*schema.Column{SessionComparisonsColumns[10]},
+				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sessioncomparison_previous_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionComparisonsColumns[10]},
+			},
+			{
+				Name:    "sessioncomparison_alert_key",
+				Unique:  false,
+				Columns: []*schema.Column{SessionComparisonsColumns[2]},
+			},
+		},
+	}
+	// SessionGroupsColumns holds the columns for the "session_groups" table.
+	SessionGroupsColumns = []*schema.Column{
+		{Name: "group_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "alert_type", Type: field.TypeString, Nullable: true},
+		{Name: "primary_chain_id", Type: field.TypeString},
+		{Name: "synthesis_status", Type: field.TypeEnum, Enums: []string{"pending", "in_progress", "completed", "failed", "skipped"}, Default: "pending"},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "summary_error", Type: field.TypeString, Nullable: true},
+		{Name: "synthesized_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SessionGroupsTable holds the schema information for the "session_groups" table.
+	SessionGroupsTable = &schema.Table{
+		Name:       "session_groups",
+		Columns:    SessionGroupsColumns,
+		PrimaryKey: []*schema.Column{SessionGroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sessiongroup_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionGroupsColumns[1]},
 			},
 		},
 	}
 	// SessionReviewActivitiesColumns holds the columns for the "session_review_activities" table.
 	SessionReviewActivitiesColumns = []*schema.Column{
 		{Name: "activity_id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
 		{Name: "actor", Type: field.TypeString},
 		{Name: "action", Type: field.TypeEnum, Enums: []string{"claim", "unclaim", "complete", "reopen", "update_feedback", "acknowledge"}},
 		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"needs_review", "in_progress", "reviewed"}},
@@ -529,7 +1034,6 @@ var (
 		{Name: "quality_rating", Type: field.TypeEnum, Nullable: true, Enums: []string{"accurate", "partially_accurate", "inaccurate"}},
 		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "investigation_feedback", Type: field.TypeString, Nullable: true, Size: 2147483647},
-		{Name: "created_at", Type: field.TypeTime},
 		{Name: "session_id", Type: field.TypeString},
 	}
 	// SessionReviewActivitiesTable holds the schema information for the "session_review_activities" table.
@@ -549,7 +1053,7 @@ var (
 			{
 				Name:    "sessionreviewactivity_session_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SessionReviewActivitiesColumns[9], SessionReviewActivitiesColumns[8]},
+				Columns: []*schema.Column{SessionReviewActivitiesColumns[9], SessionReviewActivitiesColumns[1]},
 			},
 		},
 	}
@@ -642,7 +1146,7 @@ var (
 		{Name: "expected_agent_count", Type: field.TypeInt},
 		{Name: "parallel_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"multi_agent", "replica"}},
 		{Name: "success_policy", Type: field.TypeEnum, Nullable: true, Enums: []string{"all", "any"}},
-		{Name: "stage_type", Type: field.TypeEnum, Enums: []string{"investigation", "synthesis", "chat", "exec_summary", "scoring", "action"}, Default: "investigation"},
+		{Name: "stage_type", Type: field.TypeEnum, Enums: []string{"investigation", "synthesis", "chat", "exec_summary", "technical_summary", "scoring", "action"}, Default: "investigation"},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "active", "completed", "failed", "timed_out", "cancelled"}, Default: "pending"},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
@@ -696,12 +1200,14 @@ var (
 	// TimelineEventsColumns holds the columns for the "timeline_events" table.
 	TimelineEventsColumns = []*schema.Column{
 		{Name: "event_id", Type: field.TypeString, Unique: true},
-		{Name: "sequence_number", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "event_type", Type: field.TypeEnum, Enums: []string{"llm_thinking", "llm_response", "llm_tool_call", "mcp_tool_summary", "error", "user_question", "executive_summary", "final_analysis", "code_execution", "google_search_result", "url_context_result", "task_assigned", "provider_fallback", "skill_loaded", "memory_injected"}},
+		{Name: "sequence_number", Type: field.TypeInt},
+		{Name: "event_type", Type: field.TypeEnum, Enums: []string{"llm_thinking", "llm_response", "llm_tool_call", "mcp_tool_summary", "error", "user_question", "executive_summary", "final_analysis", "code_execution", "google_search_result", "url_context_result", "task_assigned", "provider_fallback", "skill_loaded", "memory_injected", "operator_note", "mcp_server_disabled", "capability_fallback"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"streaming", "completed", "failed", "cancelled", "timed_out"}, Default: "streaming"},
+		{Name: "highlight", Type: field.TypeEnum, Nullable: true, Enums: []string{"key_finding", "error", "warning"}},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "content_zstd", Type: field.TypeBytes, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "execution_id", Type: field.TypeString, Nullable: true},
 		{Name: "parent_execution_id", Type: field.TypeString, Nullable: true},
@@ -718,37 +1224,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "timeline_events_agent_executions_timeline_events",
-				Columns:    []*schema.Column{TimelineEventsColumns[8]},
+				Columns:    []*schema.Column{TimelineEventsColumns[10]},
 				RefColumns: []*schema.Column{AgentExecutionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "timeline_events_agent_executions_sub_agent_timeline_events",
-				Columns:    []*schema.Column{TimelineEventsColumns[9]},
+				Columns:    []*schema.Column{TimelineEventsColumns[11]},
 				RefColumns: []*schema.Column{AgentExecutionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "timeline_events_alert_sessions_timeline_events",
-				Columns:    []*schema.Column{TimelineEventsColumns[10]},
+				Columns:    []*schema.Column{TimelineEventsColumns[12]},
 				RefColumns: []*schema.Column{AlertSessionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "timeline_events_llm_interactions_timeline_events",
-				Columns:    []*schema.Column{TimelineEventsColumns[11]},
+				Columns:    []*schema.Column{TimelineEventsColumns[13]},
 				RefColumns: []*schema.Column{LlmInteractionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "timeline_events_mcp_interactions_timeline_events",
-				Columns:    []*schema.Column{TimelineEventsColumns[12]},
+				Columns:    []*schema.Column{TimelineEventsColumns[14]},
 				RefColumns: []*schema.Column{McpInteractionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "timeline_events_stages_timeline_events",
-				Columns:    []*schema.Column{TimelineEventsColumns[13]},
+				Columns:    []*schema.Column{TimelineEventsColumns[15]},
 				RefColumns: []*schema.Column{StagesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -757,27 +1263,50 @@ var (
 			{
 				Name:    "timelineevent_session_id_sequence_number",
 				Unique:  false,
-				Columns: []*schema.Column{TimelineEventsColumns[10], TimelineEventsColumns[1]},
+				Columns: []*schema.Column{TimelineEventsColumns[12], TimelineEventsColumns[3]},
 			},
 			{
 				Name:    "timelineevent_stage_id_sequence_number",
 				Unique:  false,
-				Columns: []*schema.Column{TimelineEventsColumns[13], TimelineEventsColumns[1]},
+				Columns: []*schema.Column{TimelineEventsColumns[15], TimelineEventsColumns[3]},
 			},
 			{
 				Name:    "timelineevent_execution_id_sequence_number",
 				Unique:  false,
-				Columns: []*schema.Column{TimelineEventsColumns[8], TimelineEventsColumns[1]},
+				Columns: []*schema.Column{TimelineEventsColumns[10], TimelineEventsColumns[3]},
 			},
 			{
 				Name:    "timelineevent_parent_execution_id_sequence_number",
 				Unique:  false,
-				Columns: []*schema.Column{TimelineEventsColumns[9], TimelineEventsColumns[1]},
+				Columns: []*schema.Column{TimelineEventsColumns[11], TimelineEventsColumns[3]},
 			},
 			{
 				Name:    "timelineevent_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{TimelineEventsColumns[2]},
+				Columns: []*schema.Column{TimelineEventsColumns[1]},
+			},
+		},
+	}
+	// UserProfilesColumns holds the columns for the "user_profiles" table.
+	UserProfilesColumns = []*schema.Column{
+		{Name: "subject", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "display_name", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "preferences", Type: field.TypeJSON},
+		{Name: "last_seen_at", Type: field.TypeTime},
+	}
+	// UserProfilesTable holds the schema information for the "user_profiles" table.
+	UserProfilesTable = &schema.Table{
+		Name:       "user_profiles",
+		Columns:    UserProfilesColumns,
+		PrimaryKey: []*schema.Column{UserProfilesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userprofile_email",
+				Unique:  false,
+				Columns: []*schema.Column{UserProfilesColumns[4]},
 			},
 		},
 	}
@@ -808,30 +1337,49 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ActionItemsTable,
+		ActivityReportsTable,
 		AgentExecutionsTable,
 		AlertSessionsTable,
+		BlobsTable,
 		ChatsTable,
 		ChatUserMessagesTable,
+		ConfigRegistrationsTable,
 		EventsTable,
+		FeatureFlagOverridesTable,
+		HandoffNoteRevisionsTable,
 		InvestigationMemoriesTable,
+		JobLeadersTable,
 		LlmInteractionsTable,
 		McpInteractionsTable,
 		MessagesTable,
+		PodHeartbeatsTable,
+		QueuePausesTable,
+		RedactionReviewsTable,
+		SavedViewsTable,
+		SchemaCompatibilitiesTable,
+		SessionClaimsTable,
+		SessionComparisonsTable,
+		SessionGroupsTable,
 		SessionReviewActivitiesTable,
 		SessionScoresTable,
 		StagesTable,
 		TimelineEventsTable,
+		UserProfilesTable,
 		AlertSessionInjectedMemoriesTable,
 	}
 )
 
 func init() {
+	ActionItemsTable.ForeignKeys[0].RefTable = AlertSessionsTable
 	AgentExecutionsTable.ForeignKeys[0].RefTable = AgentExecutionsTable
 	AgentExecutionsTable.ForeignKeys[1].RefTable = AlertSessionsTable
 	AgentExecutionsTable.ForeignKeys[2].RefTable = StagesTable
+	AlertSessionsTable.ForeignKeys[0].RefTable = SessionGroupsTable
 	ChatsTable.ForeignKeys[0].RefTable = AlertSessionsTable
 	ChatUserMessagesTable.ForeignKeys[0].RefTable = ChatsTable
 	EventsTable.ForeignKeys[0].RefTable = AlertSessionsTable
+	HandoffNoteRevisionsTable.ForeignKeys[0].RefTable = AlertSessionsTable
 	InvestigationMemoriesTable.ForeignKeys[0].RefTable = AlertSessionsTable
 	LlmInteractionsTable.ForeignKeys[0].RefTable = AgentExecutionsTable
 	LlmInteractionsTable.ForeignKeys[1].RefTable = AlertSessionsTable
@@ -843,6 +1391,10 @@ func init() {
 	MessagesTable.ForeignKeys[0].RefTable = AgentExecutionsTable
 	MessagesTable.ForeignKeys[1].RefTable = AlertSessionsTable
 	MessagesTable.ForeignKeys[2].RefTable = StagesTable
+	RedactionReviewsTable.ForeignKeys[0].RefTable = AlertSessionsTable
+	SessionClaimsTable.ForeignKeys[0].RefTable = AlertSessionsTable
+	SessionComparisonsTable.ForeignKeys[0].RefTable = AlertSessionsTable
+	SessionComparisonsTable.ForeignKeys[1].RefTable = AlertSessionsTable
 	SessionReviewActivitiesTable.ForeignKeys[0].RefTable = AlertSessionsTable
 	SessionScoresTable.ForeignKeys[0].RefTable = AlertSessionsTable
 	SessionScoresTable.ForeignKeys[1].RefTable = StagesTable
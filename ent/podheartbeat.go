@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/podheartbeat"
+)
+
+// PodHeartbeat is the model entity for the PodHeartbeat schema.
+type PodHeartbeat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Fingerprint of the resolved configuration loaded by the pod
+	ConfigHash string `json:"config_hash,omitempty"`
+	// Binary version (git commit)
+	Version string `json:"version,omitempty"`
+	// When the current process started
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// LastSeenAt holds the value of the "last_seen_at" field.
+	LastSeenAt   time.Time `json:"last_seen_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PodHeartbeat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case podheartbeat.FieldID, podheartbeat.FieldConfigHash, podheartbeat.FieldVersion:
+			values[i] = new(sql.NullString)
+		case podheartbeat.FieldStartedAt, podheartbeat.FieldLastSeenAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PodHeartbeat fields.
+func (_m *PodHeartbeat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case podheartbeat.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case podheartbeat.FieldConfigHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field config_hash", values[i])
+			} else if value.Valid {
+				_m.ConfigHash = value.String
+			}
+		case podheartbeat.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.String
+			}
+		case podheartbeat.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case podheartbeat.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				_m.LastSeenAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PodHeartbeat.
+// This includes values selected through modifiers, order, etc.
+func (_m *PodHeartbeat) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PodHeartbeat.
+// Note that you need to call PodHeartbeat.Unwrap() before calling this method if this PodHeartbeat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PodHeartbeat) Update() *PodHeartbeatUpdateOne {
+	return NewPodHeartbeatClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PodHeartbeat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PodHeartbeat) Unwrap() *PodHeartbeat {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PodHeartbeat is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PodHeartbeat) String() string {
+	var builder strings.Builder
+	builder.WriteString("PodHeartbeat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("config_hash=")
+	builder.WriteString(_m.ConfigHash)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(_m.Version)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen_at=")
+	builder.WriteString(_m.LastSeenAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PodHeartbeats is a parsable slice of PodHeartbeat.
+type PodHeartbeats []*PodHeartbeat
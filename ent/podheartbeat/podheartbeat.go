@@ -0,0 +1,78 @@
+// Code generated by ent, DO NOT EDIT.
+
+package podheartbeat
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the podheartbeat type in the database.
+	Label = "pod_heartbeat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "pod_id"
+	// FieldConfigHash holds the string denoting the config_hash field in the database.
+	FieldConfigHash = "config_hash"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// Table holds the table name of the podheartbeat in the database.
+	Table = "pod_heartbeats"
+)
+
+// Columns holds all SQL columns for podheartbeat fields.
+var Columns = []string{
+	FieldID,
+	FieldConfigHash,
+	FieldVersion,
+	FieldStartedAt,
+	FieldLastSeenAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultLastSeenAt holds the default value on creation for the "last_seen_at" field.
+	DefaultLastSeenAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PodHeartbeat queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByConfigHash orders the results by the config_hash field.
+func ByConfigHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfigHash, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package podheartbeat
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldContainsFold(FieldID, id))
+}
+
+// ConfigHash applies equality check predicate on the "config_hash" field. It's identical to ConfigHashEQ.
+func ConfigHash(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldConfigHash, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldVersion, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// LastSeenAt applies equality check predicate on the "last_seen_at" field. It's identical to LastSeenAtEQ.
+func LastSeenAt(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// ConfigHashEQ applies the EQ predicate on the "config_hash" field.
+func ConfigHashEQ(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldConfigHash, v))
+}
+
+// ConfigHashNEQ applies the NEQ predicate on the "config_hash" field.
+func ConfigHashNEQ(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNEQ(FieldConfigHash, v))
+}
+
+// ConfigHashIn applies the In predicate on the "config_hash" field.
+func ConfigHashIn(vs ...string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldIn(FieldConfigHash, vs...))
+}
+
+// ConfigHashNotIn applies the NotIn predicate on the "config_hash" field.
+func ConfigHashNotIn(vs ...string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNotIn(FieldConfigHash, vs...))
+}
+
+// ConfigHashGT applies the GT predicate on the "config_hash" field.
+func ConfigHashGT(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGT(FieldConfigHash, v))
+}
+
+// ConfigHashGTE applies the GTE predicate on the "config_hash" field.
+func ConfigHashGTE(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGTE(FieldConfigHash, v))
+}
+
+// ConfigHashLT applies the LT predicate on the "config_hash" field.
+func ConfigHashLT(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLT(FieldConfigHash, v))
+}
+
+// ConfigHashLTE applies the LTE predicate on the "config_hash" field.
+func ConfigHashLTE(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLTE(FieldConfigHash, v))
+}
+
+// ConfigHashContains applies the Contains predicate on the "config_hash" field.
+func ConfigHashContains(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldContains(FieldConfigHash, v))
+}
+
+// ConfigHashHasPrefix applies the HasPrefix predicate on the "config_hash" field.
+func ConfigHashHasPrefix(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldHasPrefix(FieldConfigHash, v))
+}
+
+// ConfigHashHasSuffix applies the HasSuffix predicate on the "config_hash" field.
+func ConfigHashHasSuffix(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldHasSuffix(FieldConfigHash, v))
+}
+
+// ConfigHashEqualFold applies the EqualFold predicate on the "config_hash" field.
+func ConfigHashEqualFold(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEqualFold(FieldConfigHash, v))
+}
+
+// ConfigHashContainsFold applies the ContainsFold predicate on the "config_hash" field.
+func ConfigHashContainsFold(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldContainsFold(FieldConfigHash, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// LastSeenAtEQ applies the EQ predicate on the "last_seen_at" field.
+func LastSeenAtEQ(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtNEQ applies the NEQ predicate on the "last_seen_at" field.
+func LastSeenAtNEQ(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNEQ(FieldLastSeenAt, v))
+}
+
+// LastSeenAtIn applies the In predicate on the "last_seen_at" field.
+func LastSeenAtIn(vs ...time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtNotIn applies the NotIn predicate on the "last_seen_at" field.
+func LastSeenAtNotIn(vs ...time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldNotIn(FieldLastSeenAt, vs...))
+}
+
+// LastSeenAtGT applies the GT predicate on the "last_seen_at" field.
+func LastSeenAtGT(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtGTE applies the GTE predicate on the "last_seen_at" field.
+func LastSeenAtGTE(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldGTE(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLT applies the LT predicate on the "last_seen_at" field.
+func LastSeenAtLT(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLT(FieldLastSeenAt, v))
+}
+
+// LastSeenAtLTE applies the LTE predicate on the "last_seen_at" field.
+func LastSeenAtLTE(v time.Time) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.FieldLTE(FieldLastSeenAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PodHeartbeat) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PodHeartbeat) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PodHeartbeat) predicate.PodHeartbeat {
+	return predicate.PodHeartbeat(sql.NotPredicates(p))
+}
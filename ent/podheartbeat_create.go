@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/podheartbeat"
+)
+
+// PodHeartbeatCreate is the builder for creating a PodHeartbeat entity.
+type PodHeartbeatCreate struct {
+	config
+	mutation *PodHeartbeatMutation
+	hooks    []Hook
+}
+
+// SetConfigHash sets the "config_hash" field.
+func (_c *PodHeartbeatCreate) SetConfigHash(v string) *PodHeartbeatCreate {
+	_c.mutation.SetConfigHash(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *PodHeartbeatCreate) SetVersion(v string) *PodHeartbeatCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *PodHeartbeatCreate) SetStartedAt(v time.Time) *PodHeartbeatCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *PodHeartbeatCreate) SetLastSeenAt(v time.Time) *PodHeartbeatCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *PodHeartbeatCreate) SetNillableLastSeenAt(v *time.Time) *PodHeartbeatCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PodHeartbeatCreate) SetID(v string) *PodHeartbeatCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PodHeartbeatMutation object of the builder.
+func (_c *PodHeartbeatCreate) Mutation() *PodHeartbeatMutation {
+	return _c.mutation
+}
+
+// Save creates the PodHeartbeat in the database.
+func (_c *PodHeartbeatCreate) Save(ctx context.Context) (*PodHeartbeat, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PodHeartbeatCreate) SaveX(ctx context.Context) *PodHeartbeat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PodHeartbeatCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PodHeartbeatCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PodHeartbeatCreate) defaults() {
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		v := podheartbeat.DefaultLastSeenAt()
+		_c.mutation.SetLastSeenAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PodHeartbeatCreate) check() error {
+	if _, ok := _c.mutation.ConfigHash(); !ok {
+		return &ValidationError{Name: "config_hash", err: errors.New(`ent: missing required field "PodHeartbeat.config_hash"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "PodHeartbeat.version"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "PodHeartbeat.started_at"`)}
+	}
+	if _, ok := _c.mutation.LastSeenAt(); !ok {
+		return &ValidationError{Name: "last_seen_at", err: errors.New(`ent: missing required field "PodHeartbeat.last_seen_at"`)}
+	}
+	return nil
+}
+
+func (_c *PodHeartbeatCreate) sqlSave(ctx context.Context) (*PodHeartbeat, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PodHeartbeat.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PodHeartbeatCreate) createSpec() (*PodHeartbeat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PodHeartbeat{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(podheartbeat.Table, sqlgraph.NewFieldSpec(podheartbeat.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ConfigHash(); ok {
+		_spec.SetField(podheartbeat.FieldConfigHash, field.TypeString, value)
+		_node.ConfigHash = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(podheartbeat.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(podheartbeat.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(podheartbeat.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	return _node, _spec
+}
+
+// PodHeartbeatCreateBulk is the builder for creating many PodHeartbeat entities in bulk.
+type PodHeartbeatCreateBulk struct {
+	config
+	err      error
+	builders []*PodHeartbeatCreate
+}
+
+// Save creates the PodHeartbeat entities in the database.
+func (_c *PodHeartbeatCreateBulk) Save(ctx context.Context) ([]*PodHeartbeat, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PodHeartbeat, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PodHeartbeatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PodHeartbeatCreateBulk) SaveX(ctx context.Context) []*PodHeartbeat {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PodHeartbeatCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PodHeartbeatCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
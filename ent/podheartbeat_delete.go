@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/podheartbeat"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// PodHeartbeatDelete is the builder for deleting a PodHeartbeat entity.
+type PodHeartbeatDelete struct {
+	config
+	hooks    []Hook
+	mutation *PodHeartbeatMutation
+}
+
+// Where appends a list predicates to the PodHeartbeatDelete builder.
+func (_d *PodHeartbeatDelete) Where(ps ...predicate.PodHeartbeat) *PodHeartbeatDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PodHeartbeatDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PodHeartbeatDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PodHeartbeatDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(podheartbeat.Table, sqlgraph.NewFieldSpec(podheartbeat.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PodHeartbeatDeleteOne is the builder for deleting a single PodHeartbeat entity.
+type PodHeartbeatDeleteOne struct {
+	_d *PodHeartbeatDelete
+}
+
+// Where appends a list predicates to the PodHeartbeatDelete builder.
+func (_d *PodHeartbeatDeleteOne) Where(ps ...predicate.PodHeartbeat) *PodHeartbeatDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PodHeartbeatDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{podheartbeat.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PodHeartbeatDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/podheartbeat"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// PodHeartbeatUpdate is the builder for updating PodHeartbeat entities.
+type PodHeartbeatUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PodHeartbeatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PodHeartbeatUpdate builder.
+func (_u *PodHeartbeatUpdate) Where(ps ...predicate.PodHeartbeat) *PodHeartbeatUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetConfigHash sets the "config_hash" field.
+func (_u *PodHeartbeatUpdate) SetConfigHash(v string) *PodHeartbeatUpdate {
+	_u.mutation.SetConfigHash(v)
+	return _u
+}
+
+// SetNillableConfigHash sets the "config_hash" field if the given value is not nil.
+func (_u *PodHeartbeatUpdate) SetNillableConfigHash(v *string) *PodHeartbeatUpdate {
+	if v != nil {
+		_u.SetConfigHash(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *PodHeartbeatUpdate) SetVersion(v string) *PodHeartbeatUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PodHeartbeatUpdate) SetNillableVersion(v *string) *PodHeartbeatUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *PodHeartbeatUpdate) SetStartedAt(v time.Time) *PodHeartbeatUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *PodHeartbeatUpdate) SetNillableStartedAt(v *time.Time) *PodHeartbeatUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *PodHeartbeatUpdate) SetLastSeenAt(v time.Time) *PodHeartbeatUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *PodHeartbeatUpdate) SetNillableLastSeenAt(v *time.Time) *PodHeartbeatUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PodHeartbeatMutation object of the builder.
+func (_u *PodHeartbeatUpdate) Mutation() *PodHeartbeatMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PodHeartbeatUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PodHeartbeatUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PodHeartbeatUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PodHeartbeatUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PodHeartbeatUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PodHeartbeatUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PodHeartbeatUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(podheartbeat.Table, podheartbeat.Columns, sqlgraph.NewFieldSpec(podheartbeat.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConfigHash(); ok {
+		_spec.SetField(podheartbeat.FieldConfigHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(podheartbeat.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(podheartbeat.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(podheartbeat.FieldLastSeenAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{podheartbeat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PodHeartbeatUpdateOne is the builder for updating a single PodHeartbeat entity.
+type PodHeartbeatUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PodHeartbeatMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetConfigHash sets the "config_hash" field.
+func (_u *PodHeartbeatUpdateOne) SetConfigHash(v string) *PodHeartbeatUpdateOne {
+	_u.mutation.SetConfigHash(v)
+	return _u
+}
+
+// SetNillableConfigHash sets the "config_hash" field if the given value is not nil.
+func (_u *PodHeartbeatUpdateOne) SetNillableConfigHash(v *string) *PodHeartbeatUpdateOne {
+	if v != nil {
+		_u.SetConfigHash(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *PodHeartbeatUpdateOne) SetVersion(v string) *PodHeartbeatUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PodHeartbeatUpdateOne) SetNillableVersion(v *string) *PodHeartbeatUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *PodHeartbeatUpdateOne) SetStartedAt(v time.Time) *PodHeartbeatUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *PodHeartbeatUpdateOne) SetNillableStartedAt(v *time.Time) *PodHeartbeatUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *PodHeartbeatUpdateOne) SetLastSeenAt(v time.Time) *PodHeartbeatUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *PodHeartbeatUpdateOne) SetNillableLastSeenAt(v *time.Time) *PodHeartbeatUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PodHeartbeatMutation object of the builder.
+func (_u *PodHeartbeatUpdateOne) Mutation() *PodHeartbeatMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PodHeartbeatUpdate builder.
+func (_u *PodHeartbeatUpdateOne) Where(ps ...predicate.PodHeartbeat) *PodHeartbeatUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PodHeartbeatUpdateOne) Select(field string, fields ...string) *PodHeartbeatUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PodHeartbeat entity.
+func (_u *PodHeartbeatUpdateOne) Save(ctx context.Context) (*PodHeartbeat, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PodHeartbeatUpdateOne) SaveX(ctx context.Context) *PodHeartbeat {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PodHeartbeatUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PodHeartbeatUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PodHeartbeatUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PodHeartbeatUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PodHeartbeatUpdateOne) sqlSave(ctx context.Context) (_node *PodHeartbeat, err error) {
+	_spec := sqlgraph.NewUpdateSpec(podheartbeat.Table, podheartbeat.Columns, sqlgraph.NewFieldSpec(podheartbeat.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PodHeartbeat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, podheartbeat.FieldID)
+		for _, f := range fields {
+			if !podheartbeat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != podheartbeat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ConfigHash(); ok {
+		_spec.SetField(podheartbeat.FieldConfigHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(podheartbeat.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(podheartbeat.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(podheartbeat.FieldLastSeenAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PodHeartbeat{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{podheartbeat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,24 +6,45 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ActionItem is the predicate function for actionitem builders.
+type ActionItem func(*sql.Selector)
+
+// ActivityReport is the predicate function for activityreport builders.
+type ActivityReport func(*sql.Selector)
+
 // AgentExecution is the predicate function for agentexecution builders.
 type AgentExecution func(*sql.Selector)
 
 // AlertSession is the predicate function for alertsession builders.
 type AlertSession func(*sql.Selector)
 
+// Blob is the predicate function for blob builders.
+type Blob func(*sql.Selector)
+
 // Chat is the predicate function for chat builders.
 type Chat func(*sql.Selector)
 
 // ChatUserMessage is the predicate function for chatusermessage builders.
 type ChatUserMessage func(*sql.Selector)
 
+// ConfigRegistration is the predicate function for configregistration builders.
+type ConfigRegistration func(*sql.Selector)
+
 // Event is the predicate function for event builders.
 type Event func(*sql.Selector)
 
+// FeatureFlagOverride is the predicate function for featureflagoverride builders.
+type FeatureFlagOverride func(*sql.Selector)
+
+// HandoffNoteRevision is the predicate function for handoffnoterevision builders.
+type HandoffNoteRevision func(*sql.Selector)
+
 // InvestigationMemory is the predicate function for investigationmemory builders.
 type InvestigationMemory func(*sql.Selector)
 
+// JobLeader is the predicate function for jobleader builders.
+type JobLeader func(*sql.Selector)
+
 // LLMInteraction is the predicate function for llminteraction builders.
 type LLMInteraction func(*sql.Selector)
 
@@ -33,6 +54,30 @@ type MCPInteraction func(*sql.Selector)
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// PodHeartbeat is the predicate function for podheartbeat builders.
+type PodHeartbeat func(*sql.Selector)
+
+// QueuePause is the predicate function for queuepause builders.
+type QueuePause func(*sql.Selector)
+
+// RedactionReview is the predicate function for redactionreview builders.
+type RedactionReview func(*sql.Selector)
+
+// SavedView is the predicate function for savedview builders.
+type SavedView func(*sql.Selector)
+
+// SchemaCompatibility is the predicate function for schemacompatibility builders.
+type SchemaCompatibility func(*sql.Selector)
+
+// SessionClaim is the predicate function for sessionclaim builders.
+type SessionClaim func(*sql.Selector)
+
+// SessionComparison is the predicate function for sessioncomparison builders.
+type SessionComparison func(*sql.Selector)
+
+// SessionGroup is the predicate function for sessiongroup builders.
+type SessionGroup func(*sql.Selector)
+
 // SessionReviewActivity is the predicate function for sessionreviewactivity builders.
 type SessionReviewActivity func(*sql.Selector)
 
@@ -44,3 +89,6 @@ type Stage func(*sql.Selector)
 
 // TimelineEvent is the predicate function for timelineevent builders.
 type TimelineEvent func(*sql.Selector)
+
+// UserProfile is the predicate function for userprofile builders.
+type UserProfile func(*sql.Selector)
@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/queuepause"
+)
+
+// QueuePause is the model entity for the QueuePause schema.
+type QueuePause struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Pod ID, or "cluster" for a cluster-wide pause
+	ID string `json:"id,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason *string `json:"reason,omitempty"`
+	// Surface the pause as a maintenance banner via system warnings
+	Maintenance bool `json:"maintenance,omitempty"`
+	// Set by the drain API: the pod is being removed and reports drained once its in-flight sessions finish
+	Drain bool `json:"drain,omitempty"`
+	// PausedBy holds the value of the "paused_by" field.
+	PausedBy *string `json:"paused_by,omitempty"`
+	// PausedAt holds the value of the "paused_at" field.
+	PausedAt     time.Time `json:"paused_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QueuePause) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case queuepause.FieldMaintenance, queuepause.FieldDrain:
+			values[i] = new(sql.NullBool)
+		case queuepause.FieldID, queuepause.FieldReason, queuepause.FieldPausedBy:
+			values[i] = new(sql.NullString)
+		case queuepause.FieldPausedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QueuePause fields.
+func (_m *QueuePause) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case queuepause.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case queuepause.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		case queuepause.FieldMaintenance:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance", values[i])
+			} else if value.Valid {
+				_m.Maintenance = value.Bool
+			}
+		case queuepause.FieldDrain:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field drain", values[i])
+			} else if value.Valid {
+				_m.Drain = value.Bool
+			}
+		case queuepause.FieldPausedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_by", values[i])
+			} else if value.Valid {
+				_m.PausedBy = new(string)
+				*_m.PausedBy = value.String
+			}
+		case queuepause.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				_m.PausedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QueuePause.
+// This includes values selected through modifiers, order, etc.
+func (_m *QueuePause) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QueuePause.
+// Note that you need to call QueuePause.Unwrap() before calling this method if this QueuePause
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QueuePause) Update() *QueuePauseUpdateOne {
+	return NewQueuePauseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QueuePause entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QueuePause) Unwrap() *QueuePause {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QueuePause is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QueuePause) String() string {
+	var builder strings.Builder
+	builder.WriteString("QueuePause(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("maintenance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Maintenance))
+	builder.WriteString(", ")
+	builder.WriteString("drain=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Drain))
+	builder.WriteString(", ")
+	if v := _m.PausedBy; v != nil {
+		builder.WriteString("paused_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("paused_at=")
+	builder.WriteString(_m.PausedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QueuePauses is a parsable slice of QueuePause.
+type QueuePauses []*QueuePause
@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queuepause
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the queuepause type in the database.
+	Label = "queue_pause"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "scope"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldMaintenance holds the string denoting the maintenance field in the database.
+	FieldMaintenance = "maintenance"
+	// FieldDrain holds the string denoting the drain field in the database.
+	FieldDrain = "drain"
+	// FieldPausedBy holds the string denoting the paused_by field in the database.
+	FieldPausedBy = "paused_by"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// Table holds the table name of the queuepause in the database.
+	Table = "queue_pauses"
+)
+
+// Columns holds all SQL columns for queuepause fields.
+var Columns = []string{
+	FieldID,
+	FieldReason,
+	FieldMaintenance,
+	FieldDrain,
+	FieldPausedBy,
+	FieldPausedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMaintenance holds the default value on creation for the "maintenance" field.
+	DefaultMaintenance bool
+	// DefaultDrain holds the default value on creation for the "drain" field.
+	DefaultDrain bool
+	// DefaultPausedAt holds the default value on creation for the "paused_at" field.
+	DefaultPausedAt func() time.Time
+	// UpdateDefaultPausedAt holds the default value on update for the "paused_at" field.
+	UpdateDefaultPausedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the QueuePause queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByMaintenance orders the results by the maintenance field.
+func ByMaintenance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaintenance, opts...).ToFunc()
+}
+
+// ByDrain orders the results by the drain field.
+func ByDrain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrain, opts...).ToFunc()
+}
+
+// ByPausedBy orders the results by the paused_by field.
+func ByPausedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedBy, opts...).ToFunc()
+}
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queuepause
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContainsFold(FieldID, id))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldReason, v))
+}
+
+// Maintenance applies equality check predicate on the "maintenance" field. It's identical to MaintenanceEQ.
+func Maintenance(v bool) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldMaintenance, v))
+}
+
+// Drain applies equality check predicate on the "drain" field. It's identical to DrainEQ.
+func Drain(v bool) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldDrain, v))
+}
+
+// PausedBy applies equality check predicate on the "paused_by" field. It's identical to PausedByEQ.
+func PausedBy(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldPausedBy, v))
+}
+
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContainsFold(FieldReason, v))
+}
+
+// MaintenanceEQ applies the EQ predicate on the "maintenance" field.
+func MaintenanceEQ(v bool) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldMaintenance, v))
+}
+
+// MaintenanceNEQ applies the NEQ predicate on the "maintenance" field.
+func MaintenanceNEQ(v bool) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldMaintenance, v))
+}
+
+// DrainEQ applies the EQ predicate on the "drain" field.
+func DrainEQ(v bool) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldDrain, v))
+}
+
+// DrainNEQ applies the NEQ predicate on the "drain" field.
+func DrainNEQ(v bool) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldDrain, v))
+}
+
+// PausedByEQ applies the EQ predicate on the "paused_by" field.
+func PausedByEQ(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldPausedBy, v))
+}
+
+// PausedByNEQ applies the NEQ predicate on the "paused_by" field.
+func PausedByNEQ(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldPausedBy, v))
+}
+
+// PausedByIn applies the In predicate on the "paused_by" field.
+func PausedByIn(vs ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIn(FieldPausedBy, vs...))
+}
+
+// PausedByNotIn applies the NotIn predicate on the "paused_by" field.
+func PausedByNotIn(vs ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotIn(FieldPausedBy, vs...))
+}
+
+// PausedByGT applies the GT predicate on the "paused_by" field.
+func PausedByGT(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGT(FieldPausedBy, v))
+}
+
+// PausedByGTE applies the GTE predicate on the "paused_by" field.
+func PausedByGTE(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGTE(FieldPausedBy, v))
+}
+
+// PausedByLT applies the LT predicate on the "paused_by" field.
+func PausedByLT(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLT(FieldPausedBy, v))
+}
+
+// PausedByLTE applies the LTE predicate on the "paused_by" field.
+func PausedByLTE(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLTE(FieldPausedBy, v))
+}
+
+// PausedByContains applies the Contains predicate on the "paused_by" field.
+func PausedByContains(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContains(FieldPausedBy, v))
+}
+
+// PausedByHasPrefix applies the HasPrefix predicate on the "paused_by" field.
+func PausedByHasPrefix(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldHasPrefix(FieldPausedBy, v))
+}
+
+// PausedByHasSuffix applies the HasSuffix predicate on the "paused_by" field.
+func PausedByHasSuffix(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldHasSuffix(FieldPausedBy, v))
+}
+
+// PausedByIsNil applies the IsNil predicate on the "paused_by" field.
+func PausedByIsNil() predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIsNull(FieldPausedBy))
+}
+
+// PausedByNotNil applies the NotNil predicate on the "paused_by" field.
+func PausedByNotNil() predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotNull(FieldPausedBy))
+}
+
+// PausedByEqualFold applies the EqualFold predicate on the "paused_by" field.
+func PausedByEqualFold(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEqualFold(FieldPausedBy, v))
+}
+
+// PausedByContainsFold applies the ContainsFold predicate on the "paused_by" field.
+func PausedByContainsFold(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContainsFold(FieldPausedBy, v))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QueuePause) predicate.QueuePause {
+	return predicate.QueuePause(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QueuePause) predicate.QueuePause {
+	return predicate.QueuePause(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QueuePause) predicate.QueuePause {
+	return predicate.QueuePause(sql.NotPredicates(p))
+}
@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/queuepause"
+)
+
+// QueuePauseCreate is the builder for creating a QueuePause entity.
+type QueuePauseCreate struct {
+	config
+	mutation *QueuePauseMutation
+	hooks    []Hook
+}
+
+// SetReason sets the "reason" field.
+func (_c *QueuePauseCreate) SetReason(v string) *QueuePauseCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *QueuePauseCreate) SetNillableReason(v *string) *QueuePauseCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetMaintenance sets the "maintenance" field.
+func (_c *QueuePauseCreate) SetMaintenance(v bool) *QueuePauseCreate {
+	_c.mutation.SetMaintenance(v)
+	return _c
+}
+
+// SetNillableMaintenance sets the "maintenance" field if the given value is not nil.
+func (_c *QueuePauseCreate) SetNillableMaintenance(v *bool) *QueuePauseCreate {
+	if v != nil {
+		_c.SetMaintenance(*v)
+	}
+	return _c
+}
+
+// SetDrain sets the "drain" field.
+func (_c *QueuePauseCreate) SetDrain(v bool) *QueuePauseCreate {
+	_c.mutation.SetDrain(v)
+	return _c
+}
+
+// SetNillableDrain sets the "drain" field if the given value is not nil.
+func (_c *QueuePauseCreate) SetNillableDrain(v *bool) *QueuePauseCreate {
+	if v != nil {
+		_c.SetDrain(*v)
+	}
+	return _c
+}
+
+// SetPausedBy sets the "paused_by" field.
+func (_c *QueuePauseCreate) SetPausedBy(v string) *QueuePauseCreate {
+	_c.mutation.SetPausedBy(v)
+	return _c
+}
+
+// SetNillablePausedBy sets the "paused_by" field if the given value is not nil.
+func (_c *QueuePauseCreate) SetNillablePausedBy(v *string) *QueuePauseCreate {
+	if v != nil {
+		_c.SetPausedBy(*v)
+	}
+	return _c
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_c *QueuePauseCreate) SetPausedAt(v time.Time) *QueuePauseCreate {
+	_c.mutation.SetPausedAt(v)
+	return _c
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_c *QueuePauseCreate) SetNillablePausedAt(v *time.Time) *QueuePauseCreate {
+	if v != nil {
+		_c.SetPausedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QueuePauseCreate) SetID(v string) *QueuePauseCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the QueuePauseMutation object of the builder.
+func (_c *QueuePauseCreate) Mutation() *QueuePauseMutation {
+	return _c.mutation
+}
+
+// Save creates the QueuePause in the database.
+func (_c *QueuePauseCreate) Save(ctx context.Context) (*QueuePause, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QueuePauseCreate) SaveX(ctx context.Context) *QueuePause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QueuePauseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QueuePauseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QueuePauseCreate) defaults() {
+	if _, ok := _c.mutation.Maintenance(); !ok {
+		v := queuepause.DefaultMaintenance
+		_c.mutation.SetMaintenance(v)
+	}
+	if _, ok := _c.mutation.Drain(); !ok {
+		v := queuepause.DefaultDrain
+		_c.mutation.SetDrain(v)
+	}
+	if _, ok := _c.mutation.PausedAt(); !ok {
+		v := queuepause.DefaultPausedAt()
+		_c.mutation.SetPausedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QueuePauseCreate) check() error {
+	if _, ok := _c.mutation.Maintenance(); !ok {
+		return &ValidationError{Name: "maintenance", err: errors.New(`ent: missing required field "QueuePause.maintenance"`)}
+	}
+	if _, ok := _c.mutation.Drain(); !ok {
+		return &ValidationError{Name: "drain", err: errors.New(`ent: missing required field "QueuePause.drain"`)}
+	}
+	if _, ok := _c.mutation.PausedAt(); !ok {
+		return &ValidationError{Name: "paused_at", err: errors.New(`ent: missing required field "QueuePause.paused_at"`)}
+	}
+	return nil
+}
+
+func (_c *QueuePauseCreate) sqlSave(ctx context.Context) (*QueuePause, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected QueuePause.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QueuePauseCreate) createSpec() (*QueuePause, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QueuePause{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(queuepause.Table, sqlgraph.NewFieldSpec(queuepause.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(queuepause.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	if value, ok := _c.mutation.Maintenance(); ok {
+		_spec.SetField(queuepause.FieldMaintenance, field.TypeBool, value)
+		_node.Maintenance = value
+	}
+	if value, ok := _c.mutation.Drain(); ok {
+		_spec.SetField(queuepause.FieldDrain, field.TypeBool, value)
+		_node.Drain = value
+	}
+	if value, ok := _c.mutation.PausedBy(); ok {
+		_spec.SetField(queuepause.FieldPausedBy, field.TypeString, value)
+		_node.PausedBy = &value
+	}
+	if value, ok := _c.mutation.PausedAt(); ok {
+		_spec.SetField(queuepause.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = value
+	}
+	return _node, _spec
+}
+
+// QueuePauseCreateBulk is the builder for creating many QueuePause entities in bulk.
+type QueuePauseCreateBulk struct {
+	config
+	err      error
+	builders []*QueuePauseCreate
+}
+
+// Save creates the QueuePause entities in the database.
+func (_c *QueuePauseCreateBulk) Save(ctx context.Context) ([]*QueuePause, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QueuePause, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QueuePauseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QueuePauseCreateBulk) SaveX(ctx context.Context) []*QueuePause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QueuePauseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QueuePauseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+)
+
+// RedactionReview is the model entity for the RedactionReview schema.
+type RedactionReview struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID string `json:"session_id,omitempty"`
+	// MCP interaction whose tool result contained the value
+	McpInteractionID *string `json:"mcp_interaction_id,omitempty"`
+	// ServerName holds the value of the "server_name" field.
+	ServerName string `json:"server_name,omitempty"`
+	// ToolName holds the value of the "tool_name" field.
+	ToolName *string `json:"tool_name,omitempty"`
+	// Detector heuristic that flagged the value, e.g. 'high_entropy'
+	Heuristic string `json:"heuristic,omitempty"`
+	// SHA-256 of the value; same value in other sessions shares it
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// Flagged value, needed to redact it; cleared once resolved
+	Value *string `json:"-"`
+	// Value with all but its first and last characters elided
+	Preview string `json:"preview,omitempty"`
+	// Surrounding text with the value shown as its preview
+	Context string `json:"context,omitempty"`
+	// Status holds the value of the "status" field.
+	Status redactionreview.Status `json:"status,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Reviewer's reason for the decision
+	Note *string `json:"note,omitempty"`
+	// Rows rewritten on confirm, keyed by table.column
+	RowsRedacted map[string]int `json:"rows_redacted,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RedactionReviewQuery when eager-loading is set.
+	Edges        RedactionReviewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RedactionReviewEdges holds the relations/edges for other nodes in the graph.
+type RedactionReviewEdges struct {
+	// Session holds the value of the session edge.
+	Session *AlertSession `json:"session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RedactionReviewEdges) SessionOrErr() (*AlertSession, error) {
+	if e.Session != nil {
+		return e.Session, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: alertsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RedactionReview) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case redactionreview.FieldRowsRedacted:
+			values[i] = new([]byte)
+		case redactionreview.FieldID, redactionreview.FieldSessionID, redactionreview.FieldMcpInteractionID, redactionreview.FieldServerName, redactionreview.FieldToolName, redactionreview.FieldHeuristic, redactionreview.FieldFingerprint, redactionreview.FieldValue, redactionreview.FieldPreview, redactionreview.FieldContext, redactionreview.FieldStatus, redactionreview.FieldReviewedBy, redactionreview.FieldNote:
+			values[i] = new(sql.NullString)
+		case redactionreview.FieldCreatedAt, redactionreview.FieldUpdatedAt, redactionreview.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RedactionReview fields.
+func (_m *RedactionReview) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case redactionreview.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case redactionreview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case redactionreview.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case redactionreview.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				_m.SessionID = value.String
+			}
+		case redactionreview.FieldMcpInteractionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mcp_interaction_id", values[i])
+			} else if value.Valid {
+				_m.McpInteractionID = new(string)
+				*_m.McpInteractionID = value.String
+			}
+		case redactionreview.FieldServerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field server_name", values[i])
+			} else if value.Valid {
+				_m.ServerName = value.String
+			}
+		case redactionreview.FieldToolName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tool_name", values[i])
+			} else if value.Valid {
+				_m.ToolName = new(string)
+				*_m.ToolName = value.String
+			}
+		case redactionreview.FieldHeuristic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field heuristic", values[i])
+			} else if value.Valid {
+				_m.Heuristic = value.String
+			}
+		case redactionreview.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				_m.Fingerprint = value.String
+			}
+		case redactionreview.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = new(string)
+				*_m.Value = value.String
+			}
+		case redactionreview.FieldPreview:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field preview", values[i])
+			} else if value.Valid {
+				_m.Preview = value.String
+			}
+		case redactionreview.FieldContext:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field context", values[i])
+			} else if value.Valid {
+				_m.Context = value.String
+			}
+		case redactionreview.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = redactionreview.Status(value.String)
+			}
+		case redactionreview.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = new(string)
+				*_m.ReviewedBy = value.String
+			}
+		case redactionreview.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case redactionreview.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = new(string)
+				*_m.Note = value.String
+			}
+		case redactionreview.FieldRowsRedacted:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rows_redacted", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RowsRedacted); err != nil {
+					return fmt.Errorf("unmarshal field rows_redacted: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the RedactionReview.
+// This includes values selected through modifiers, order, etc.
+func (_m *RedactionReview) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySession queries the "session" edge of the RedactionReview entity.
+func (_m *RedactionReview) QuerySession() *AlertSessionQuery {
+	return NewRedactionReviewClient(_m.config).QuerySession(_m)
+}
+
+// Update returns a builder for updating this RedactionReview.
+// Note that you need to call RedactionReview.Unwrap() before calling this method if this RedactionReview
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RedactionReview) Update() *RedactionReviewUpdateOne {
+	return NewRedactionReviewClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RedactionReview entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RedactionReview) Unwrap() *RedactionReview {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RedactionReview is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RedactionReview) String() string {
+	var builder strings.Builder
+	builder.WriteString("RedactionReview(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(_m.SessionID)
+	builder.WriteString(", ")
+	if v := _m.McpInteractionID; v != nil {
+		builder.WriteString("mcp_interaction_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("server_name=")
+	builder.WriteString(_m.ServerName)
+	builder.WriteString(", ")
+	if v := _m.ToolName; v != nil {
+		builder.WriteString("tool_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("heuristic=")
+	builder.WriteString(_m.Heuristic)
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(_m.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("value=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("preview=")
+	builder.WriteString(_m.Preview)
+	builder.WriteString(", ")
+	builder.WriteString("context=")
+	builder.WriteString(_m.Context)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Note; v != nil {
+		builder.WriteString("note=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rows_redacted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RowsRedacted))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RedactionReviews is a parsable slice of RedactionReview.
+type RedactionReviews []*RedactionReview
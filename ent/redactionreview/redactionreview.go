@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package redactionreview
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the redactionreview type in the database.
+	Label = "redaction_review"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "review_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldMcpInteractionID holds the string denoting the mcp_interaction_id field in the database.
+	FieldMcpInteractionID = "mcp_interaction_id"
+	// FieldServerName holds the string denoting the server_name field in the database.
+	FieldServerName = "server_name"
+	// FieldToolName holds the string denoting the tool_name field in the database.
+	FieldToolName = "tool_name"
+	// FieldHeuristic holds the string denoting the heuristic field in the database.
+	FieldHeuristic = "heuristic"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldPreview holds the string denoting the preview field in the database.
+	FieldPreview = "preview"
+	// FieldContext holds the string denoting the context field in the database.
+	FieldContext = "context"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldRowsRedacted holds the string denoting the rows_redacted field in the database.
+	FieldRowsRedacted = "rows_redacted"
+	// EdgeSession holds the string denoting the session edge name in mutations.
+	EdgeSession = "session"
+	// AlertSessionFieldID holds the string denoting the ID field of the AlertSession.
+	AlertSessionFieldID = "session_id"
+	// Table holds the table name of the redactionreview in the database.
+	Table = "redaction_reviews"
+	// SessionTable is the table that holds the session relation/edge.
+	SessionTable = "redaction_reviews"
+	// SessionInverseTable is the table name for the AlertSession entity.
+	// It exists in this package in order to avoid circular dependency with the "alertsession" package.
+	SessionInverseTable = "alert_sessions"
+	// SessionColumn is the table column denoting the session relation/edge.
+	SessionColumn = "session_id"
+)
+
+// Columns holds all SQL columns for redactionreview fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldSessionID,
+	FieldMcpInteractionID,
+	FieldServerName,
+	FieldToolName,
+	FieldHeuristic,
+	FieldFingerprint,
+	FieldValue,
+	FieldPreview,
+	FieldContext,
+	FieldStatus,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldNote,
+	FieldRowsRedacted,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusConfirmed Status = "confirmed"
+	StatusDismissed Status = "dismissed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusConfirmed, StatusDismissed:
+		return nil
+	default:
+		return fmt.Errorf("redactionreview: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the RedactionReview queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByMcpInteractionID orders the results by the mcp_interaction_id field.
+func ByMcpInteractionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMcpInteractionID, opts...).ToFunc()
+}
+
+// ByServerName orders the results by the server_name field.
+func ByServerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServerName, opts...).ToFunc()
+}
+
+// ByToolName orders the results by the tool_name field.
+func ByToolName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToolName, opts...).ToFunc()
+}
+
+// ByHeuristic orders the results by the heuristic field.
+func ByHeuristic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeuristic, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByPreview orders the results by the preview field.
+func ByPreview(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreview, opts...).ToFunc()
+}
+
+// ByContext orders the results by the context field.
+func ByContext(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContext, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// BySessionField orders the results by session field.
+func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionInverseTable, AlertSessionFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+	)
+}
@@ -0,0 +1,1099 @@
+// Code generated by ent, DO NOT EDIT.
+
+package redactionreview
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldSessionID, v))
+}
+
+// McpInteractionID applies equality check predicate on the "mcp_interaction_id" field. It's identical to McpInteractionIDEQ.
+func McpInteractionID(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldMcpInteractionID, v))
+}
+
+// ServerName applies equality check predicate on the "server_name" field. It's identical to ServerNameEQ.
+func ServerName(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldServerName, v))
+}
+
+// ToolName applies equality check predicate on the "tool_name" field. It's identical to ToolNameEQ.
+func ToolName(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldToolName, v))
+}
+
+// Heuristic applies equality check predicate on the "heuristic" field. It's identical to HeuristicEQ.
+func Heuristic(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldHeuristic, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldValue, v))
+}
+
+// Preview applies equality check predicate on the "preview" field. It's identical to PreviewEQ.
+func Preview(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldPreview, v))
+}
+
+// Context applies equality check predicate on the "context" field. It's identical to ContextEQ.
+func Context(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldContext, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// McpInteractionIDEQ applies the EQ predicate on the "mcp_interaction_id" field.
+func McpInteractionIDEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDNEQ applies the NEQ predicate on the "mcp_interaction_id" field.
+func McpInteractionIDNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDIn applies the In predicate on the "mcp_interaction_id" field.
+func McpInteractionIDIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldMcpInteractionID, vs...))
+}
+
+// McpInteractionIDNotIn applies the NotIn predicate on the "mcp_interaction_id" field.
+func McpInteractionIDNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldMcpInteractionID, vs...))
+}
+
+// McpInteractionIDGT applies the GT predicate on the "mcp_interaction_id" field.
+func McpInteractionIDGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDGTE applies the GTE predicate on the "mcp_interaction_id" field.
+func McpInteractionIDGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDLT applies the LT predicate on the "mcp_interaction_id" field.
+func McpInteractionIDLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDLTE applies the LTE predicate on the "mcp_interaction_id" field.
+func McpInteractionIDLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDContains applies the Contains predicate on the "mcp_interaction_id" field.
+func McpInteractionIDContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDHasPrefix applies the HasPrefix predicate on the "mcp_interaction_id" field.
+func McpInteractionIDHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDHasSuffix applies the HasSuffix predicate on the "mcp_interaction_id" field.
+func McpInteractionIDHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDIsNil applies the IsNil predicate on the "mcp_interaction_id" field.
+func McpInteractionIDIsNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIsNull(FieldMcpInteractionID))
+}
+
+// McpInteractionIDNotNil applies the NotNil predicate on the "mcp_interaction_id" field.
+func McpInteractionIDNotNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotNull(FieldMcpInteractionID))
+}
+
+// McpInteractionIDEqualFold applies the EqualFold predicate on the "mcp_interaction_id" field.
+func McpInteractionIDEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldMcpInteractionID, v))
+}
+
+// McpInteractionIDContainsFold applies the ContainsFold predicate on the "mcp_interaction_id" field.
+func McpInteractionIDContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldMcpInteractionID, v))
+}
+
+// ServerNameEQ applies the EQ predicate on the "server_name" field.
+func ServerNameEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldServerName, v))
+}
+
+// ServerNameNEQ applies the NEQ predicate on the "server_name" field.
+func ServerNameNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldServerName, v))
+}
+
+// ServerNameIn applies the In predicate on the "server_name" field.
+func ServerNameIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldServerName, vs...))
+}
+
+// ServerNameNotIn applies the NotIn predicate on the "server_name" field.
+func ServerNameNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldServerName, vs...))
+}
+
+// ServerNameGT applies the GT predicate on the "server_name" field.
+func ServerNameGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldServerName, v))
+}
+
+// ServerNameGTE applies the GTE predicate on the "server_name" field.
+func ServerNameGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldServerName, v))
+}
+
+// ServerNameLT applies the LT predicate on the "server_name" field.
+func ServerNameLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldServerName, v))
+}
+
+// ServerNameLTE applies the LTE predicate on the "server_name" field.
+func ServerNameLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldServerName, v))
+}
+
+// ServerNameContains applies the Contains predicate on the "server_name" field.
+func ServerNameContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldServerName, v))
+}
+
+// ServerNameHasPrefix applies the HasPrefix predicate on the "server_name" field.
+func ServerNameHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldServerName, v))
+}
+
+// ServerNameHasSuffix applies the HasSuffix predicate on the "server_name" field.
+func ServerNameHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldServerName, v))
+}
+
+// ServerNameEqualFold applies the EqualFold predicate on the "server_name" field.
+func ServerNameEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldServerName, v))
+}
+
+// ServerNameContainsFold applies the ContainsFold predicate on the "server_name" field.
+func ServerNameContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldServerName, v))
+}
+
+// ToolNameEQ applies the EQ predicate on the "tool_name" field.
+func ToolNameEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldToolName, v))
+}
+
+// ToolNameNEQ applies the NEQ predicate on the "tool_name" field.
+func ToolNameNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldToolName, v))
+}
+
+// ToolNameIn applies the In predicate on the "tool_name" field.
+func ToolNameIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldToolName, vs...))
+}
+
+// ToolNameNotIn applies the NotIn predicate on the "tool_name" field.
+func ToolNameNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldToolName, vs...))
+}
+
+// ToolNameGT applies the GT predicate on the "tool_name" field.
+func ToolNameGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldToolName, v))
+}
+
+// ToolNameGTE applies the GTE predicate on the "tool_name" field.
+func ToolNameGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldToolName, v))
+}
+
+// ToolNameLT applies the LT predicate on the "tool_name" field.
+func ToolNameLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldToolName, v))
+}
+
+// ToolNameLTE applies the LTE predicate on the "tool_name" field.
+func ToolNameLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldToolName, v))
+}
+
+// ToolNameContains applies the Contains predicate on the "tool_name" field.
+func ToolNameContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldToolName, v))
+}
+
+// ToolNameHasPrefix applies the HasPrefix predicate on the "tool_name" field.
+func ToolNameHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldToolName, v))
+}
+
+// ToolNameHasSuffix applies the HasSuffix predicate on the "tool_name" field.
+func ToolNameHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldToolName, v))
+}
+
+// ToolNameIsNil applies the IsNil predicate on the "tool_name" field.
+func ToolNameIsNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIsNull(FieldToolName))
+}
+
+// ToolNameNotNil applies the NotNil predicate on the "tool_name" field.
+func ToolNameNotNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotNull(FieldToolName))
+}
+
+// ToolNameEqualFold applies the EqualFold predicate on the "tool_name" field.
+func ToolNameEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldToolName, v))
+}
+
+// ToolNameContainsFold applies the ContainsFold predicate on the "tool_name" field.
+func ToolNameContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldToolName, v))
+}
+
+// HeuristicEQ applies the EQ predicate on the "heuristic" field.
+func HeuristicEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldHeuristic, v))
+}
+
+// HeuristicNEQ applies the NEQ predicate on the "heuristic" field.
+func HeuristicNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldHeuristic, v))
+}
+
+// HeuristicIn applies the In predicate on the "heuristic" field.
+func HeuristicIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldHeuristic, vs...))
+}
+
+// HeuristicNotIn applies the NotIn predicate on the "heuristic" field.
+func HeuristicNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldHeuristic, vs...))
+}
+
+// HeuristicGT applies the GT predicate on the "heuristic" field.
+func HeuristicGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldHeuristic, v))
+}
+
+// HeuristicGTE applies the GTE predicate on the "heuristic" field.
+func HeuristicGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldHeuristic, v))
+}
+
+// HeuristicLT applies the LT predicate on the "heuristic" field.
+func HeuristicLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldHeuristic, v))
+}
+
+// HeuristicLTE applies the LTE predicate on the "heuristic" field.
+func HeuristicLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldHeuristic, v))
+}
+
+// HeuristicContains applies the Contains predicate on the "heuristic" field.
+func HeuristicContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldHeuristic, v))
+}
+
+// HeuristicHasPrefix applies the HasPrefix predicate on the "heuristic" field.
+func HeuristicHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldHeuristic, v))
+}
+
+// HeuristicHasSuffix applies the HasSuffix predicate on the "heuristic" field.
+func HeuristicHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldHeuristic, v))
+}
+
+// HeuristicEqualFold applies the EqualFold predicate on the "heuristic" field.
+func HeuristicEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldHeuristic, v))
+}
+
+// HeuristicContainsFold applies the ContainsFold predicate on the "heuristic" field.
+func HeuristicContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldHeuristic, v))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueIsNil applies the IsNil predicate on the "value" field.
+func ValueIsNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIsNull(FieldValue))
+}
+
+// ValueNotNil applies the NotNil predicate on the "value" field.
+func ValueNotNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotNull(FieldValue))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldValue, v))
+}
+
+// PreviewEQ applies the EQ predicate on the "preview" field.
+func PreviewEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldPreview, v))
+}
+
+// PreviewNEQ applies the NEQ predicate on the "preview" field.
+func PreviewNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldPreview, v))
+}
+
+// PreviewIn applies the In predicate on the "preview" field.
+func PreviewIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldPreview, vs...))
+}
+
+// PreviewNotIn applies the NotIn predicate on the "preview" field.
+func PreviewNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldPreview, vs...))
+}
+
+// PreviewGT applies the GT predicate on the "preview" field.
+func PreviewGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldPreview, v))
+}
+
+// PreviewGTE applies the GTE predicate on the "preview" field.
+func PreviewGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldPreview, v))
+}
+
+// PreviewLT applies the LT predicate on the "preview" field.
+func PreviewLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldPreview, v))
+}
+
+// PreviewLTE applies the LTE predicate on the "preview" field.
+func PreviewLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldPreview, v))
+}
+
+// PreviewContains applies the Contains predicate on the "preview" field.
+func PreviewContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldPreview, v))
+}
+
+// PreviewHasPrefix applies the HasPrefix predicate on the "preview" field.
+func PreviewHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldPreview, v))
+}
+
+// PreviewHasSuffix applies the HasSuffix predicate on the "preview" field.
+func PreviewHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldPreview, v))
+}
+
+// PreviewEqualFold applies the EqualFold predicate on the "preview" field.
+func PreviewEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldPreview, v))
+}
+
+// PreviewContainsFold applies the ContainsFold predicate on the "preview" field.
+func PreviewContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldPreview, v))
+}
+
+// ContextEQ applies the EQ predicate on the "context" field.
+func ContextEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldContext, v))
+}
+
+// ContextNEQ applies the NEQ predicate on the "context" field.
+func ContextNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldContext, v))
+}
+
+// ContextIn applies the In predicate on the "context" field.
+func ContextIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldContext, vs...))
+}
+
+// ContextNotIn applies the NotIn predicate on the "context" field.
+func ContextNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldContext, vs...))
+}
+
+// ContextGT applies the GT predicate on the "context" field.
+func ContextGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldContext, v))
+}
+
+// ContextGTE applies the GTE predicate on the "context" field.
+func ContextGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldContext, v))
+}
+
+// ContextLT applies the LT predicate on the "context" field.
+func ContextLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldContext, v))
+}
+
+// ContextLTE applies the LTE predicate on the "context" field.
+func ContextLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldContext, v))
+}
+
+// ContextContains applies the Contains predicate on the "context" field.
+func ContextContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldContext, v))
+}
+
+// ContextHasPrefix applies the HasPrefix predicate on the "context" field.
+func ContextHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldContext, v))
+}
+
+// ContextHasSuffix applies the HasSuffix predicate on the "context" field.
+func ContextHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldContext, v))
+}
+
+// ContextEqualFold applies the EqualFold predicate on the "context" field.
+func ContextEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldContext, v))
+}
+
+// ContextContainsFold applies the ContainsFold predicate on the "context" field.
+func ContextContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldContext, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByContains applies the Contains predicate on the "reviewed_by" field.
+func ReviewedByContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldReviewedBy, v))
+}
+
+// ReviewedByHasPrefix applies the HasPrefix predicate on the "reviewed_by" field.
+func ReviewedByHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldReviewedBy, v))
+}
+
+// ReviewedByHasSuffix applies the HasSuffix predicate on the "reviewed_by" field.
+func ReviewedByHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedByEqualFold applies the EqualFold predicate on the "reviewed_by" field.
+func ReviewedByEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldReviewedBy, v))
+}
+
+// ReviewedByContainsFold applies the ContainsFold predicate on the "reviewed_by" field.
+func ReviewedByContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldReviewedBy, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldContainsFold(FieldNote, v))
+}
+
+// RowsRedactedIsNil applies the IsNil predicate on the "rows_redacted" field.
+func RowsRedactedIsNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldIsNull(FieldRowsRedacted))
+}
+
+// RowsRedactedNotNil applies the NotNil predicate on the "rows_redacted" field.
+func RowsRedactedNotNil() predicate.RedactionReview {
+	return predicate.RedactionReview(sql.FieldNotNull(FieldRowsRedacted))
+}
+
+// HasSession applies the HasEdge predicate on the "session" edge.
+func HasSession() predicate.RedactionReview {
+	return predicate.RedactionReview(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionWith applies the HasEdge predicate on the "session" edge with a given conditions (other predicates).
+func HasSessionWith(preds ...predicate.AlertSession) predicate.RedactionReview {
+	return predicate.RedactionReview(func(s *sql.Selector) {
+		step := newSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RedactionReview) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RedactionReview) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RedactionReview) predicate.RedactionReview {
+	return predicate.RedactionReview(sql.NotPredicates(p))
+}
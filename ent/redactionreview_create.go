@@ -0,0 +1,486 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+)
+
+// RedactionReviewCreate is the builder for creating a RedactionReview entity.
+type RedactionReviewCreate struct {
+	config
+	mutation *RedactionReviewMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RedactionReviewCreate) SetCreatedAt(v time.Time) *RedactionReviewCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableCreatedAt(v *time.Time) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RedactionReviewCreate) SetUpdatedAt(v time.Time) *RedactionReviewCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableUpdatedAt(v *time.Time) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *RedactionReviewCreate) SetSessionID(v string) *RedactionReviewCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetMcpInteractionID sets the "mcp_interaction_id" field.
+func (_c *RedactionReviewCreate) SetMcpInteractionID(v string) *RedactionReviewCreate {
+	_c.mutation.SetMcpInteractionID(v)
+	return _c
+}
+
+// SetNillableMcpInteractionID sets the "mcp_interaction_id" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableMcpInteractionID(v *string) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetMcpInteractionID(*v)
+	}
+	return _c
+}
+
+// SetServerName sets the "server_name" field.
+func (_c *RedactionReviewCreate) SetServerName(v string) *RedactionReviewCreate {
+	_c.mutation.SetServerName(v)
+	return _c
+}
+
+// SetToolName sets the "tool_name" field.
+func (_c *RedactionReviewCreate) SetToolName(v string) *RedactionReviewCreate {
+	_c.mutation.SetToolName(v)
+	return _c
+}
+
+// SetNillableToolName sets the "tool_name" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableToolName(v *string) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetToolName(*v)
+	}
+	return _c
+}
+
+// SetHeuristic sets the "heuristic" field.
+func (_c *RedactionReviewCreate) SetHeuristic(v string) *RedactionReviewCreate {
+	_c.mutation.SetHeuristic(v)
+	return _c
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_c *RedactionReviewCreate) SetFingerprint(v string) *RedactionReviewCreate {
+	_c.mutation.SetFingerprint(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *RedactionReviewCreate) SetValue(v string) *RedactionReviewCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableValue(v *string) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetPreview sets the "preview" field.
+func (_c *RedactionReviewCreate) SetPreview(v string) *RedactionReviewCreate {
+	_c.mutation.SetPreview(v)
+	return _c
+}
+
+// SetContext sets the "context" field.
+func (_c *RedactionReviewCreate) SetContext(v string) *RedactionReviewCreate {
+	_c.mutation.SetContext(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *RedactionReviewCreate) SetStatus(v redactionreview.Status) *RedactionReviewCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableStatus(v *redactionreview.Status) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *RedactionReviewCreate) SetReviewedBy(v string) *RedactionReviewCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableReviewedBy(v *string) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *RedactionReviewCreate) SetReviewedAt(v time.Time) *RedactionReviewCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableReviewedAt(v *time.Time) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *RedactionReviewCreate) SetNote(v string) *RedactionReviewCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *RedactionReviewCreate) SetNillableNote(v *string) *RedactionReviewCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetRowsRedacted sets the "rows_redacted" field.
+func (_c *RedactionReviewCreate) SetRowsRedacted(v map[string]int) *RedactionReviewCreate {
+	_c.mutation.SetRowsRedacted(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RedactionReviewCreate) SetID(v string) *RedactionReviewCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSession sets the "session" edge to the AlertSession entity.
+func (_c *RedactionReviewCreate) SetSession(v *AlertSession) *RedactionReviewCreate {
+	return _c.SetSessionID(v.ID)
+}
+
+// Mutation returns the RedactionReviewMutation object of the builder.
+func (_c *RedactionReviewCreate) Mutation() *RedactionReviewMutation {
+	return _c.mutation
+}
+
+// Save creates the RedactionReview in the database.
+func (_c *RedactionReviewCreate) Save(ctx context.Context) (*RedactionReview, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RedactionReviewCreate) SaveX(ctx context.Context) *RedactionReview {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RedactionReviewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RedactionReviewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RedactionReviewCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := redactionreview.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := redactionreview.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := redactionreview.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RedactionReviewCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RedactionReview.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RedactionReview.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "RedactionReview.session_id"`)}
+	}
+	if _, ok := _c.mutation.ServerName(); !ok {
+		return &ValidationError{Name: "server_name", err: errors.New(`ent: missing required field "RedactionReview.server_name"`)}
+	}
+	if _, ok := _c.mutation.Heuristic(); !ok {
+		return &ValidationError{Name: "heuristic", err: errors.New(`ent: missing required field "RedactionReview.heuristic"`)}
+	}
+	if _, ok := _c.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "RedactionReview.fingerprint"`)}
+	}
+	if _, ok := _c.mutation.Preview(); !ok {
+		return &ValidationError{Name: "preview", err: errors.New(`ent: missing required field "RedactionReview.preview"`)}
+	}
+	if _, ok := _c.mutation.Context(); !ok {
+		return &ValidationError{Name: "context", err: errors.New(`ent: missing required field "RedactionReview.context"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RedactionReview.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := redactionreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RedactionReview.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "RedactionReview.session"`)}
+	}
+	return nil
+}
+
+func (_c *RedactionReviewCreate) sqlSave(ctx context.Context) (*RedactionReview, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RedactionReview.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RedactionReviewCreate) createSpec() (*RedactionReview, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RedactionReview{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(redactionreview.Table, sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(redactionreview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(redactionreview.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.McpInteractionID(); ok {
+		_spec.SetField(redactionreview.FieldMcpInteractionID, field.TypeString, value)
+		_node.McpInteractionID = &value
+	}
+	if value, ok := _c.mutation.ServerName(); ok {
+		_spec.SetField(redactionreview.FieldServerName, field.TypeString, value)
+		_node.ServerName = value
+	}
+	if value, ok := _c.mutation.ToolName(); ok {
+		_spec.SetField(redactionreview.FieldToolName, field.TypeString, value)
+		_node.ToolName = &value
+	}
+	if value, ok := _c.mutation.Heuristic(); ok {
+		_spec.SetField(redactionreview.FieldHeuristic, field.TypeString, value)
+		_node.Heuristic = value
+	}
+	if value, ok := _c.mutation.Fingerprint(); ok {
+		_spec.SetField(redactionreview.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(redactionreview.FieldValue, field.TypeString, value)
+		_node.Value = &value
+	}
+	if value, ok := _c.mutation.Preview(); ok {
+		_spec.SetField(redactionreview.FieldPreview, field.TypeString, value)
+		_node.Preview = value
+	}
+	if value, ok := _c.mutation.Context(); ok {
+		_spec.SetField(redactionreview.FieldContext, field.TypeString, value)
+		_node.Context = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(redactionreview.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(redactionreview.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(redactionreview.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(redactionreview.FieldNote, field.TypeString, value)
+		_node.Note = &value
+	}
+	if value, ok := _c.mutation.RowsRedacted(); ok {
+		_spec.SetField(redactionreview.FieldRowsRedacted, field.TypeJSON, value)
+		_node.RowsRedacted = value
+	}
+	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   redactionreview.SessionTable,
+			Columns: []string{redactionreview.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RedactionReviewCreateBulk is the builder for creating many RedactionReview entities in bulk.
+type RedactionReviewCreateBulk struct {
+	config
+	err      error
+	builders []*RedactionReviewCreate
+}
+
+// Save creates the RedactionReview entities in the database.
+func (_c *RedactionReviewCreateBulk) Save(ctx context.Context) ([]*RedactionReview, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RedactionReview, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RedactionReviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RedactionReviewCreateBulk) SaveX(ctx context.Context) []*RedactionReview {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RedactionReviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RedactionReviewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+)
+
+// RedactionReviewUpdate is the builder for updating RedactionReview entities.
+type RedactionReviewUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RedactionReviewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RedactionReviewUpdate builder.
+func (_u *RedactionReviewUpdate) Where(ps ...predicate.RedactionReview) *RedactionReviewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RedactionReviewUpdate) SetUpdatedAt(v time.Time) *RedactionReviewUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *RedactionReviewUpdate) SetValue(v string) *RedactionReviewUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *RedactionReviewUpdate) SetNillableValue(v *string) *RedactionReviewUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// ClearValue clears the value of the "value" field.
+func (_u *RedactionReviewUpdate) ClearValue() *RedactionReviewUpdate {
+	_u.mutation.ClearValue()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RedactionReviewUpdate) SetStatus(v redactionreview.Status) *RedactionReviewUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RedactionReviewUpdate) SetNillableStatus(v *redactionreview.Status) *RedactionReviewUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *RedactionReviewUpdate) SetReviewedBy(v string) *RedactionReviewUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *RedactionReviewUpdate) SetNillableReviewedBy(v *string) *RedactionReviewUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *RedactionReviewUpdate) ClearReviewedBy() *RedactionReviewUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *RedactionReviewUpdate) SetReviewedAt(v time.Time) *RedactionReviewUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *RedactionReviewUpdate) SetNillableReviewedAt(v *time.Time) *RedactionReviewUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *RedactionReviewUpdate) ClearReviewedAt() *RedactionReviewUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *RedactionReviewUpdate) SetNote(v string) *RedactionReviewUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *RedactionReviewUpdate) SetNillableNote(v *string) *RedactionReviewUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *RedactionReviewUpdate) ClearNote() *RedactionReviewUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetRowsRedacted sets the "rows_redacted" field.
+func (_u *RedactionReviewUpdate) SetRowsRedacted(v map[string]int) *RedactionReviewUpdate {
+	_u.mutation.SetRowsRedacted(v)
+	return _u
+}
+
+// ClearRowsRedacted clears the value of the "rows_redacted" field.
+func (_u *RedactionReviewUpdate) ClearRowsRedacted() *RedactionReviewUpdate {
+	_u.mutation.ClearRowsRedacted()
+	return _u
+}
+
+// Mutation returns the RedactionReviewMutation object of the builder.
+func (_u *RedactionReviewUpdate) Mutation() *RedactionReviewMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RedactionReviewUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RedactionReviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RedactionReviewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RedactionReviewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RedactionReviewUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := redactionreview.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RedactionReviewUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := redactionreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RedactionReview.status": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RedactionReview.session"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RedactionReviewUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RedactionReviewUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RedactionReviewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(redactionreview.Table, redactionreview.Columns, sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(redactionreview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.McpInteractionIDCleared() {
+		_spec.ClearField(redactionreview.FieldMcpInteractionID, field.TypeString)
+	}
+	if _u.mutation.ToolNameCleared() {
+		_spec.ClearField(redactionreview.FieldToolName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(redactionreview.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(redactionreview.FieldValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(redactionreview.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(redactionreview.FieldReviewedBy, field.TypeString, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(redactionreview.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(redactionreview.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(redactionreview.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(redactionreview.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(redactionreview.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.RowsRedacted(); ok {
+		_spec.SetField(redactionreview.FieldRowsRedacted, field.TypeJSON, value)
+	}
+	if _u.mutation.RowsRedactedCleared() {
+		_spec.ClearField(redactionreview.FieldRowsRedacted, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{redactionreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RedactionReviewUpdateOne is the builder for updating a single RedactionReview entity.
+type RedactionReviewUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RedactionReviewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RedactionReviewUpdateOne) SetUpdatedAt(v time.Time) *RedactionReviewUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *RedactionReviewUpdateOne) SetValue(v string) *RedactionReviewUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *RedactionReviewUpdateOne) SetNillableValue(v *string) *RedactionReviewUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// ClearValue clears the value of the "value" field.
+func (_u *RedactionReviewUpdateOne) ClearValue() *RedactionReviewUpdateOne {
+	_u.mutation.ClearValue()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RedactionReviewUpdateOne) SetStatus(v redactionreview.Status) *RedactionReviewUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RedactionReviewUpdateOne) SetNillableStatus(v *redactionreview.Status) *RedactionReviewUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *RedactionReviewUpdateOne) SetReviewedBy(v string) *RedactionReviewUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *RedactionReviewUpdateOne) SetNillableReviewedBy(v *string) *RedactionReviewUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *RedactionReviewUpdateOne) ClearReviewedBy() *RedactionReviewUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *RedactionReviewUpdateOne) SetReviewedAt(v time.Time) *RedactionReviewUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *RedactionReviewUpdateOne) SetNillableReviewedAt(v *time.Time) *RedactionReviewUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *RedactionReviewUpdateOne) ClearReviewedAt() *RedactionReviewUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *RedactionReviewUpdateOne) SetNote(v string) *RedactionReviewUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *RedactionReviewUpdateOne) SetNillableNote(v *string) *RedactionReviewUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *RedactionReviewUpdateOne) ClearNote() *RedactionReviewUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetRowsRedacted sets the "rows_redacted" field.
+func (_u *RedactionReviewUpdateOne) SetRowsRedacted(v map[string]int) *RedactionReviewUpdateOne {
+	_u.mutation.SetRowsRedacted(v)
+	return _u
+}
+
+// ClearRowsRedacted clears the value of the "rows_redacted" field.
+func (_u *RedactionReviewUpdateOne) ClearRowsRedacted() *RedactionReviewUpdateOne {
+	_u.mutation.ClearRowsRedacted()
+	return _u
+}
+
+// Mutation returns the RedactionReviewMutation object of the builder.
+func (_u *RedactionReviewUpdateOne) Mutation() *RedactionReviewMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RedactionReviewUpdate builder.
+func (_u *RedactionReviewUpdateOne) Where(ps ...predicate.RedactionReview) *RedactionReviewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RedactionReviewUpdateOne) Select(field string, fields ...string) *RedactionReviewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RedactionReview entity.
+func (_u *RedactionReviewUpdateOne) Save(ctx context.Context) (*RedactionReview, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RedactionReviewUpdateOne) SaveX(ctx context.Context) *RedactionReview {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RedactionReviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RedactionReviewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RedactionReviewUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := redactionreview.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RedactionReviewUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := redactionreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RedactionReview.status": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RedactionReview.session"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RedactionReviewUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RedactionReviewUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RedactionReviewUpdateOne) sqlSave(ctx context.Context) (_node *RedactionReview, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(redactionreview.Table, redactionreview.Columns, sqlgraph.NewFieldSpec(redactionreview.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RedactionReview.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, redactionreview.FieldID)
+		for _, f := range fields {
+			if !redactionreview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != redactionreview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(redactionreview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.McpInteractionIDCleared() {
+		_spec.ClearField(redactionreview.FieldMcpInteractionID, field.TypeString)
+	}
+	if _u.mutation.ToolNameCleared() {
+		_spec.ClearField(redactionreview.FieldToolName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(redactionreview.FieldValue, field.TypeString, value)
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(redactionreview.FieldValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(redactionreview.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(redactionreview.FieldReviewedBy, field.TypeString, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(redactionreview.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(redactionreview.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(redactionreview.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(redactionreview.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(redactionreview.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.RowsRedacted(); ok {
+		_spec.SetField(redactionreview.FieldRowsRedacted, field.TypeJSON, value)
+	}
+	if _u.mutation.RowsRedactedCleared() {
+		_spec.ClearField(redactionreview.FieldRowsRedacted, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RedactionReview{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{redactionreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
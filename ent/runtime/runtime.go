@@ -2,7 +2,457 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/codeready-toolchain/tarsy/ent/runtime.go
+import (
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/blob"
+	"github.com/codeready-toolchain/tarsy/ent/chat"
+	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
+	"github.com/codeready-toolchain/tarsy/ent/event"
+	"github.com/codeready-toolchain/tarsy/ent/featureflagoverride"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
+	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
+	"github.com/codeready-toolchain/tarsy/ent/jobleader"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/message"
+	"github.com/codeready-toolchain/tarsy/ent/podheartbeat"
+	"github.com/codeready-toolchain/tarsy/ent/queuepause"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+	"github.com/codeready-toolchain/tarsy/ent/savedview"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/ent/schemacompatibility"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
+	"github.com/codeready-toolchain/tarsy/ent/sessionreviewactivity"
+	"github.com/codeready-toolchain/tarsy/ent/sessionscore"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/ent/userprofile"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	actionitemMixin := schema.ActionItem{}.Mixin()
+	actionitemMixinHooks1 := actionitemMixin[1].Hooks()
+	actionitem.Hooks[0] = actionitemMixinHooks1[0]
+	actionitemMixinFields0 := actionitemMixin[0].Fields()
+	_ = actionitemMixinFields0
+	actionitemFields := schema.ActionItem{}.Fields()
+	_ = actionitemFields
+	// actionitemDescCreatedAt is the schema descriptor for created_at field.
+	actionitemDescCreatedAt := actionitemMixinFields0[0].Descriptor()
+	// actionitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	actionitem.DefaultCreatedAt = actionitemDescCreatedAt.Default.(func() time.Time)
+	// actionitemDescUpdatedAt is the schema descriptor for updated_at field.
+	actionitemDescUpdatedAt := actionitemMixinFields0[1].Descriptor()
+	// actionitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	actionitem.DefaultUpdatedAt = actionitemDescUpdatedAt.Default.(func() time.Time)
+	// actionitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	actionitem.UpdateDefaultUpdatedAt = actionitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// actionitemDescTitle is the schema descriptor for title field.
+	actionitemDescTitle := actionitemFields[2].Descriptor()
+	// actionitem.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	actionitem.TitleValidator = actionitemDescTitle.Validators[0].(func(string) error)
+	activityreportMixin := schema.ActivityReport{}.Mixin()
+	activityreportMixinFields0 := activityreportMixin[0].Fields()
+	_ = activityreportMixinFields0
+	activityreportFields := schema.ActivityReport{}.Fields()
+	_ = activityreportFields
+	// activityreportDescCreatedAt is the schema descriptor for created_at field.
+	activityreportDescCreatedAt := activityreportMixinFields0[0].Descriptor()
+	// activityreport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	activityreport.DefaultCreatedAt = activityreportDescCreatedAt.Default.(func() time.Time)
+	agentexecutionFields := schema.AgentExecution{}.Fields()
+	_ = agentexecutionFields
+	// agentexecutionDescToolCallCount is the schema descriptor for tool_call_count field.
+	agentexecutionDescToolCallCount := agentexecutionFields[16].Descriptor()
+	// agentexecution.DefaultToolCallCount holds the default value on creation for the tool_call_count field.
+	agentexecution.DefaultToolCallCount = agentexecutionDescToolCallCount.Default.(int)
+	// agentexecutionDescLoopDetected is the schema descriptor for loop_detected field.
+	agentexecutionDescLoopDetected := agentexecutionFields[17].Descriptor()
+	// agentexecution.DefaultLoopDetected holds the default value on creation for the loop_detected field.
+	agentexecution.DefaultLoopDetected = agentexecutionDescLoopDetected.Default.(bool)
+	alertsessionMixin := schema.AlertSession{}.Mixin()
+	alertsessionMixinFields0 := alertsessionMixin[0].Fields()
+	_ = alertsessionMixinFields0
+	alertsessionFields := schema.AlertSession{}.Fields()
+	_ = alertsessionFields
+	// alertsessionDescUpdatedAt is the schema descriptor for updated_at field.
+	alertsessionDescUpdatedAt := alertsessionMixinFields0[0].Descriptor()
+	// alertsession.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	alertsession.DefaultUpdatedAt = alertsessionDescUpdatedAt.Default.(func() time.Time)
+	// alertsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	alertsession.UpdateDefaultUpdatedAt = alertsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// alertsessionDescCreatedAt is the schema descriptor for created_at field.
+	alertsessionDescCreatedAt := alertsessionFields[5].Descriptor()
+	// alertsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alertsession.DefaultCreatedAt = alertsessionDescCreatedAt.Default.(func() time.Time)
+	// alertsessionDescForceFullInvestigation is the schema descriptor for force_full_investigation field.
+	alertsessionDescForceFullInvestigation := alertsessionFields[44].Descriptor()
+	// alertsession.DefaultForceFullInvestigation holds the default value on creation for the force_full_investigation field.
+	alertsession.DefaultForceFullInvestigation = alertsessionDescForceFullInvestigation.Default.(bool)
+	// alertsessionDescSandbox is the schema descriptor for sandbox field.
+	alertsessionDescSandbox := alertsessionFields[51].Descriptor()
+	// alertsession.DefaultSandbox holds the default value on creation for the sandbox field.
+	alertsession.DefaultSandbox = alertsessionDescSandbox.Default.(bool)
+	// alertsessionDescFallbackChain is the schema descriptor for fallback_chain field.
+	alertsessionDescFallbackChain := alertsessionFields[52].Descriptor()
+	// alertsession.DefaultFallbackChain holds the default value on creation for the fallback_chain field.
+	alertsession.DefaultFallbackChain = alertsessionDescFallbackChain.Default.(bool)
+	// alertsessionDescCallbackAttempts is the schema descriptor for callback_attempts field.
+	alertsessionDescCallbackAttempts := alertsessionFields[56].Descriptor()
+	// alertsession.DefaultCallbackAttempts holds the default value on creation for the callback_attempts field.
+	alertsession.DefaultCallbackAttempts = alertsessionDescCallbackAttempts.Default.(int)
+	// alertsessionDescHandoffNotesRevision is the schema descriptor for handoff_notes_revision field.
+	alertsessionDescHandoffNotesRevision := alertsessionFields[67].Descriptor()
+	// alertsession.DefaultHandoffNotesRevision holds the default value on creation for the handoff_notes_revision field.
+	alertsession.DefaultHandoffNotesRevision = alertsessionDescHandoffNotesRevision.Default.(int)
+	blobMixin := schema.Blob{}.Mixin()
+	blobMixinFields0 := blobMixin[0].Fields()
+	_ = blobMixinFields0
+	blobFields := schema.Blob{}.Fields()
+	_ = blobFields
+	// blobDescCreatedAt is the schema descriptor for created_at field.
+	blobDescCreatedAt := blobMixinFields0[0].Descriptor()
+	// blob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blob.DefaultCreatedAt = blobDescCreatedAt.Default.(func() time.Time)
+	chatMixin := schema.Chat{}.Mixin()
+	chatMixinHooks1 := chatMixin[1].Hooks()
+	chat.Hooks[0] = chatMixinHooks1[0]
+	chatMixinFields0 := chatMixin[0].Fields()
+	_ = chatMixinFields0
+	chatFields := schema.Chat{}.Fields()
+	_ = chatFields
+	// chatDescCreatedAt is the schema descriptor for created_at field.
+	chatDescCreatedAt := chatMixinFields0[0].Descriptor()
+	// chat.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chat.DefaultCreatedAt = chatDescCreatedAt.Default.(func() time.Time)
+	// chatDescUpdatedAt is the schema descriptor for updated_at field.
+	chatDescUpdatedAt := chatMixinFields0[1].Descriptor()
+	// chat.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	chat.DefaultUpdatedAt = chatDescUpdatedAt.Default.(func() time.Time)
+	// chat.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	chat.UpdateDefaultUpdatedAt = chatDescUpdatedAt.UpdateDefault.(func() time.Time)
+	chatusermessageMixin := schema.ChatUserMessage{}.Mixin()
+	chatusermessageMixinFields0 := chatusermessageMixin[0].Fields()
+	_ = chatusermessageMixinFields0
+	chatusermessageFields := schema.ChatUserMessage{}.Fields()
+	_ = chatusermessageFields
+	// chatusermessageDescCreatedAt is the schema descriptor for created_at field.
+	chatusermessageDescCreatedAt := chatusermessageMixinFields0[0].Descriptor()
+	// chatusermessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	chatusermessage.DefaultCreatedAt = chatusermessageDescCreatedAt.Default.(func() time.Time)
+	configregistrationMixin := schema.ConfigRegistration{}.Mixin()
+	configregistrationMixinHooks1 := configregistrationMixin[1].Hooks()
+	configregistration.Hooks[0] = configregistrationMixinHooks1[0]
+	configregistrationMixinFields0 := configregistrationMixin[0].Fields()
+	_ = configregistrationMixinFields0
+	configregistrationFields := schema.ConfigRegistration{}.Fields()
+	_ = configregistrationFields
+	// configregistrationDescCreatedAt is the schema descriptor for created_at field.
+	configregistrationDescCreatedAt := configregistrationMixinFields0[0].Descriptor()
+	// configregistration.DefaultCreatedAt holds the default value on creation for the created_at field.
+	configregistration.DefaultCreatedAt = configregistrationDescCreatedAt.Default.(func() time.Time)
+	// configregistrationDescName is the schema descriptor for name field.
+	configregistrationDescName := configregistrationFields[2].Descriptor()
+	// configregistration.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	configregistration.NameValidator = configregistrationDescName.Validators[0].(func(string) error)
+	// configregistrationDescVersion is the schema descriptor for version field.
+	configregistrationDescVersion := configregistrationFields[3].Descriptor()
+	// configregistration.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	configregistration.VersionValidator = configregistrationDescVersion.Validators[0].(func(int) error)
+	// configregistrationDescDeleted is the schema descriptor for deleted field.
+	configregistrationDescDeleted := configregistrationFields[5].Descriptor()
+	// configregistration.DefaultDeleted holds the default value on creation for the deleted field.
+	configregistration.DefaultDeleted = configregistrationDescDeleted.Default.(bool)
+	eventMixin := schema.Event{}.Mixin()
+	eventMixinFields0 := eventMixin[0].Fields()
+	_ = eventMixinFields0
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescCreatedAt is the schema descriptor for created_at field.
+	eventDescCreatedAt := eventMixinFields0[0].Descriptor()
+	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
+	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
+	featureflagoverrideMixin := schema.FeatureFlagOverride{}.Mixin()
+	featureflagoverrideMixinHooks1 := featureflagoverrideMixin[1].Hooks()
+	featureflagoverride.Hooks[0] = featureflagoverrideMixinHooks1[0]
+	featureflagoverrideMixinFields0 := featureflagoverrideMixin[0].Fields()
+	_ = featureflagoverrideMixinFields0
+	featureflagoverrideFields := schema.FeatureFlagOverride{}.Fields()
+	_ = featureflagoverrideFields
+	// featureflagoverrideDescCreatedAt is the schema descriptor for created_at field.
+	featureflagoverrideDescCreatedAt := featureflagoverrideMixinFields0[0].Descriptor()
+	// featureflagoverride.DefaultCreatedAt holds the default value on creation for the created_at field.
+	featureflagoverride.DefaultCreatedAt = featureflagoverrideDescCreatedAt.Default.(func() time.Time)
+	// featureflagoverrideDescUpdatedAt is the schema descriptor for updated_at field.
+	featureflagoverrideDescUpdatedAt := featureflagoverrideMixinFields0[1].Descriptor()
+	// featureflagoverride.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	featureflagoverride.DefaultUpdatedAt = featureflagoverrideDescUpdatedAt.Default.(func() time.Time)
+	// featureflagoverride.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	featureflagoverride.UpdateDefaultUpdatedAt = featureflagoverrideDescUpdatedAt.UpdateDefault.(func() time.Time)
+	handoffnoterevisionMixin := schema.HandoffNoteRevision{}.Mixin()
+	handoffnoterevisionMixinFields0 := handoffnoterevisionMixin[0].Fields()
+	_ = handoffnoterevisionMixinFields0
+	handoffnoterevisionFields := schema.HandoffNoteRevision{}.Fields()
+	_ = handoffnoterevisionFields
+	// handoffnoterevisionDescCreatedAt is the schema descriptor for created_at field.
+	handoffnoterevisionDescCreatedAt := handoffnoterevisionMixinFields0[0].Descriptor()
+	// handoffnoterevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	handoffnoterevision.DefaultCreatedAt = handoffnoterevisionDescCreatedAt.Default.(func() time.Time)
+	investigationmemoryMixin := schema.InvestigationMemory{}.Mixin()
+	investigationmemoryMixinHooks1 := investigationmemoryMixin[1].Hooks()
+	investigationmemory.Hooks[0] = investigationmemoryMixinHooks1[0]
+	investigationmemoryMixinFields0 := investigationmemoryMixin[0].Fields()
+	_ = investigationmemoryMixinFields0
+	investigationmemoryFields := schema.InvestigationMemory{}.Fields()
+	_ = investigationmemoryFields
+	// investigationmemoryDescCreatedAt is the schema descriptor for created_at field.
+	investigationmemoryDescCreatedAt := investigationmemoryMixinFields0[0].Descriptor()
+	// investigationmemory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	investigationmemory.DefaultCreatedAt = investigationmemoryDescCreatedAt.Default.(func() time.Time)
+	// investigationmemoryDescUpdatedAt is the schema descriptor for updated_at field.
+	investigationmemoryDescUpdatedAt := investigationmemoryMixinFields0[1].Descriptor()
+	// investigationmemory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	investigationmemory.DefaultUpdatedAt = investigationmemoryDescUpdatedAt.Default.(func() time.Time)
+	// investigationmemory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	investigationmemory.UpdateDefaultUpdatedAt = investigationmemoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// investigationmemoryDescProject is the schema descriptor for project field.
+	investigationmemoryDescProject := investigationmemoryFields[1].Descriptor()
+	// investigationmemory.DefaultProject holds the default value on creation for the project field.
+	investigationmemory.DefaultProject = investigationmemoryDescProject.Default.(string)
+	// investigationmemory.ProjectValidator is a validator for the "project" field. It is called by the builders before save.
+	investigationmemory.ProjectValidator = investigationmemoryDescProject.Validators[0].(func(string) error)
+	// investigationmemoryDescContent is the schema descriptor for content field.
+	investigationmemoryDescContent := investigationmemoryFields[2].Descriptor()
+	// investigationmemory.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	investigationmemory.ContentValidator = investigationmemoryDescContent.Validators[0].(func(string) error)
+	// investigationmemoryDescConfidence is the schema descriptor for confidence field.
+	investigationmemoryDescConfidence := investigationmemoryFields[5].Descriptor()
+	// investigationmemory.DefaultConfidence holds the default value on creation for the confidence field.
+	investigationmemory.DefaultConfidence = investigationmemoryDescConfidence.Default.(float64)
+	// investigationmemory.ConfidenceValidator is a validator for the "confidence" field. It is called by the builders before save.
+	investigationmemory.ConfidenceValidator = func() func(float64) error {
+		validators := investigationmemoryDescConfidence.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(confidence float64) error {
+			for _, fn := range fns {
+				if err := fn(confidence); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// investigationmemoryDescSeenCount is the schema descriptor for seen_count field.
+	investigationmemoryDescSeenCount := investigationmemoryFields[6].Descriptor()
+	// investigationmemory.DefaultSeenCount holds the default value on creation for the seen_count field.
+	investigationmemory.DefaultSeenCount = investigationmemoryDescSeenCount.Default.(int)
+	// investigationmemory.SeenCountValidator is a validator for the "seen_count" field. It is called by the builders before save.
+	investigationmemory.SeenCountValidator = investigationmemoryDescSeenCount.Validators[0].(func(int) error)
+	// investigationmemoryDescSourceSessionID is the schema descriptor for source_session_id field.
+	investigationmemoryDescSourceSessionID := investigationmemoryFields[7].Descriptor()
+	// investigationmemory.SourceSessionIDValidator is a validator for the "source_session_id" field. It is called by the builders before save.
+	investigationmemory.SourceSessionIDValidator = investigationmemoryDescSourceSessionID.Validators[0].(func(string) error)
+	// investigationmemoryDescLastSeenAt is the schema descriptor for last_seen_at field.
+	investigationmemoryDescLastSeenAt := investigationmemoryFields[10].Descriptor()
+	// investigationmemory.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	investigationmemory.DefaultLastSeenAt = investigationmemoryDescLastSeenAt.Default.(func() time.Time)
+	// investigationmemoryDescDeprecated is the schema descriptor for deprecated field.
+	investigationmemoryDescDeprecated := investigationmemoryFields[11].Descriptor()
+	// investigationmemory.DefaultDeprecated holds the default value on creation for the deprecated field.
+	investigationmemory.DefaultDeprecated = investigationmemoryDescDeprecated.Default.(bool)
+	jobleaderFields := schema.JobLeader{}.Fields()
+	_ = jobleaderFields
+	// jobleaderDescRenewedAt is the schema descriptor for renewed_at field.
+	jobleaderDescRenewedAt := jobleaderFields[3].Descriptor()
+	// jobleader.DefaultRenewedAt holds the default value on creation for the renewed_at field.
+	jobleader.DefaultRenewedAt = jobleaderDescRenewedAt.Default.(func() time.Time)
+	llminteractionMixin := schema.LLMInteraction{}.Mixin()
+	llminteractionMixinFields0 := llminteractionMixin[0].Fields()
+	_ = llminteractionMixinFields0
+	llminteractionFields := schema.LLMInteraction{}.Fields()
+	_ = llminteractionFields
+	// llminteractionDescCreatedAt is the schema descriptor for created_at field.
+	llminteractionDescCreatedAt := llminteractionMixinFields0[0].Descriptor()
+	// llminteraction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	llminteraction.DefaultCreatedAt = llminteractionDescCreatedAt.Default.(func() time.Time)
+	mcpinteractionMixin := schema.MCPInteraction{}.Mixin()
+	mcpinteractionMixinFields0 := mcpinteractionMixin[0].Fields()
+	_ = mcpinteractionMixinFields0
+	mcpinteractionFields := schema.MCPInteraction{}.Fields()
+	_ = mcpinteractionFields
+	// mcpinteractionDescCreatedAt is the schema descriptor for created_at field.
+	mcpinteractionDescCreatedAt := mcpinteractionMixinFields0[0].Descriptor()
+	// mcpinteraction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mcpinteraction.DefaultCreatedAt = mcpinteractionDescCreatedAt.Default.(func() time.Time)
+	messageMixin := schema.Message{}.Mixin()
+	messageMixinFields0 := messageMixin[0].Fields()
+	_ = messageMixinFields0
+	messageFields := schema.Message{}.Fields()
+	_ = messageFields
+	// messageDescCreatedAt is the schema descriptor for created_at field.
+	messageDescCreatedAt := messageMixinFields0[0].Descriptor()
+	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
+	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
+	podheartbeatFields := schema.PodHeartbeat{}.Fields()
+	_ = podheartbeatFields
+	// podheartbeatDescLastSeenAt is the schema descriptor for last_seen_at field.
+	podheartbeatDescLastSeenAt := podheartbeatFields[4].Descriptor()
+	// podheartbeat.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	podheartbeat.DefaultLastSeenAt = podheartbeatDescLastSeenAt.Default.(func() time.Time)
+	queuepauseFields := schema.QueuePause{}.Fields()
+	_ = queuepauseFields
+	// queuepauseDescMaintenance is the schema descriptor for maintenance field.
+	queuepauseDescMaintenance := queuepauseFields[2].Descriptor()
+	// queuepause.DefaultMaintenance holds the default value on creation for the maintenance field.
+	queuepause.DefaultMaintenance = queuepauseDescMaintenance.Default.(bool)
+	// queuepauseDescDrain is the schema descriptor for drain field.
+	queuepauseDescDrain := queuepauseFields[3].Descriptor()
+	// queuepause.DefaultDrain holds the default value on creation for the drain field.
+	queuepause.DefaultDrain = queuepauseDescDrain.Default.(bool)
+	// queuepauseDescPausedAt is the schema descriptor for paused_at field.
+	queuepauseDescPausedAt := queuepauseFields[5].Descriptor()
+	// queuepause.DefaultPausedAt holds the default value on creation for the paused_at field.
+	queuepause.DefaultPausedAt = queuepauseDescPausedAt.Default.(func() time.Time)
+	// queuepause.UpdateDefaultPausedAt holds the default value on update for the paused_at field.
+	queuepause.UpdateDefaultPausedAt = queuepauseDescPausedAt.UpdateDefault.(func() time.Time)
+	redactionreviewMixin := schema.RedactionReview{}.Mixin()
+	redactionreviewMixinFields0 := redactionreviewMixin[0].Fields()
+	_ = redactionreviewMixinFields0
+	redactionreviewFields := schema.RedactionReview{}.Fields()
+	_ = redactionreviewFields
+	// redactionreviewDescCreatedAt is the schema descriptor for created_at field.
+	redactionreviewDescCreatedAt := redactionreviewMixinFields0[0].Descriptor()
+	// redactionreview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	redactionreview.DefaultCreatedAt = redactionreviewDescCreatedAt.Default.(func() time.Time)
+	// redactionreviewDescUpdatedAt is the schema descriptor for updated_at field.
+	redactionreviewDescUpdatedAt := redactionreviewMixinFields0[1].Descriptor()
+	// redactionreview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	redactionreview.DefaultUpdatedAt = redactionreviewDescUpdatedAt.Default.(func() time.Time)
+	// redactionreview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	redactionreview.UpdateDefaultUpdatedAt = redactionreviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	savedviewMixin := schema.SavedView{}.Mixin()
+	savedviewMixinHooks1 := savedviewMixin[1].Hooks()
+	savedview.Hooks[0] = savedviewMixinHooks1[0]
+	savedviewMixinFields0 := savedviewMixin[0].Fields()
+	_ = savedviewMixinFields0
+	savedviewFields := schema.SavedView{}.Fields()
+	_ = savedviewFields
+	// savedviewDescCreatedAt is the schema descriptor for created_at field.
+	savedviewDescCreatedAt := savedviewMixinFields0[0].Descriptor()
+	// savedview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedview.DefaultCreatedAt = savedviewDescCreatedAt.Default.(func() time.Time)
+	// savedviewDescUpdatedAt is the schema descriptor for updated_at field.
+	savedviewDescUpdatedAt := savedviewMixinFields0[1].Descriptor()
+	// savedview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	savedview.DefaultUpdatedAt = savedviewDescUpdatedAt.Default.(func() time.Time)
+	// savedview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	savedview.UpdateDefaultUpdatedAt = savedviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// savedviewDescOwner is the schema descriptor for owner field.
+	savedviewDescOwner := savedviewFields[1].Descriptor()
+	// savedview.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	savedview.OwnerValidator = savedviewDescOwner.Validators[0].(func(string) error)
+	// savedviewDescName is the schema descriptor for name field.
+	savedviewDescName := savedviewFields[2].Descriptor()
+	// savedview.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	savedview.NameValidator = savedviewDescName.Validators[0].(func(string) error)
+	// savedviewDescNotify is the schema descriptor for notify field.
+	savedviewDescNotify := savedviewFields[4].Descriptor()
+	// savedview.DefaultNotify holds the default value on creation for the notify field.
+	savedview.DefaultNotify = savedviewDescNotify.Default.(bool)
+	schemacompatibilityFields := schema.SchemaCompatibility{}.Fields()
+	_ = schemacompatibilityFields
+	// schemacompatibilityDescMinVersion is the schema descriptor for min_version field.
+	schemacompatibilityDescMinVersion := schemacompatibilityFields[0].Descriptor()
+	// schemacompatibility.DefaultMinVersion holds the default value on creation for the min_version field.
+	schemacompatibility.DefaultMinVersion = schemacompatibilityDescMinVersion.Default.(int64)
+	sessioncomparisonMixin := schema.SessionComparison{}.Mixin()
+	sessioncomparisonMixinFields0 := sessioncomparisonMixin[0].Fields()
+	_ = sessioncomparisonMixinFields0
+	sessioncomparisonFields := schema.SessionComparison{}.Fields()
+	_ = sessioncomparisonFields
+	// sessioncomparisonDescCreatedAt is the schema descriptor for created_at field.
+	sessioncomparisonDescCreatedAt := sessioncomparisonMixinFields0[0].Descriptor()
+	// sessioncomparison.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sessioncomparison.DefaultCreatedAt = sessioncomparisonDescCreatedAt.Default.(func() time.Time)
+	sessiongroupMixin := schema.SessionGroup{}.Mixin()
+	sessiongroupMixinFields0 := sessiongroupMixin[0].Fields()
+	_ = sessiongroupMixinFields0
+	sessiongroupFields := schema.SessionGroup{}.Fields()
+	_ = sessiongroupFields
+	// sessiongroupDescCreatedAt is the schema descriptor for created_at field.
+	sessiongroupDescCreatedAt := sessiongroupMixinFields0[0].Descriptor()
+	// sessiongroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sessiongroup.DefaultCreatedAt = sessiongroupDescCreatedAt.Default.(func() time.Time)
+	// sessiongroupDescUpdatedAt is the schema descriptor for updated_at field.
+	sessiongroupDescUpdatedAt := sessiongroupMixinFields0[1].Descriptor()
+	// sessiongroup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sessiongroup.DefaultUpdatedAt = sessiongroupDescUpdatedAt.Default.(func() time.Time)
+	// sessiongroup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sessiongroup.UpdateDefaultUpdatedAt = sessiongroupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sessionreviewactivityMixin := schema.SessionReviewActivity{}.Mixin()
+	sessionreviewactivityMixinFields0 := sessionreviewactivityMixin[0].Fields()
+	_ = sessionreviewactivityMixinFields0
+	sessionreviewactivityFields := schema.SessionReviewActivity{}.Fields()
+	_ = sessionreviewactivityFields
+	// sessionreviewactivityDescCreatedAt is the schema descriptor for created_at field.
+	sessionreviewactivityDescCreatedAt := sessionreviewactivityMixinFields0[0].Descriptor()
+	// sessionreviewactivity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sessionreviewactivity.DefaultCreatedAt = sessionreviewactivityDescCreatedAt.Default.(func() time.Time)
+	sessionscoreFields := schema.SessionScore{}.Fields()
+	_ = sessionscoreFields
+	// sessionscoreDescStartedAt is the schema descriptor for started_at field.
+	sessionscoreDescStartedAt := sessionscoreFields[9].Descriptor()
+	// sessionscore.DefaultStartedAt holds the default value on creation for the started_at field.
+	sessionscore.DefaultStartedAt = sessionscoreDescStartedAt.Default.(func() time.Time)
+	stageFields := schema.Stage{}.Fields()
+	_ = stageFields
+	timelineeventMixin := schema.TimelineEvent{}.Mixin()
+	timelineeventMixinFields0 := timelineeventMixin[0].Fields()
+	_ = timelineeventMixinFields0
+	timelineeventFields := schema.TimelineEvent{}.Fields()
+	_ = timelineeventFields
+	// timelineeventDescCreatedAt is the schema descriptor for created_at field.
+	timelineeventDescCreatedAt := timelineeventMixinFields0[0].Descriptor()
+	// timelineevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	timelineevent.DefaultCreatedAt = timelineeventDescCreatedAt.Default.(func() time.Time)
+	// timelineeventDescUpdatedAt is the schema descriptor for updated_at field.
+	timelineeventDescUpdatedAt := timelineeventMixinFields0[1].Descriptor()
+	// timelineevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	timelineevent.DefaultUpdatedAt = timelineeventDescUpdatedAt.Default.(func() time.Time)
+	// timelineevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	timelineevent.UpdateDefaultUpdatedAt = timelineeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userprofileMixin := schema.UserProfile{}.Mixin()
+	userprofileMixinFields0 := userprofileMixin[0].Fields()
+	_ = userprofileMixinFields0
+	userprofileFields := schema.UserProfile{}.Fields()
+	_ = userprofileFields
+	// userprofileDescCreatedAt is the schema descriptor for created_at field.
+	userprofileDescCreatedAt := userprofileMixinFields0[0].Descriptor()
+	// userprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userprofile.DefaultCreatedAt = userprofileDescCreatedAt.Default.(func() time.Time)
+	// userprofileDescUpdatedAt is the schema descriptor for updated_at field.
+	userprofileDescUpdatedAt := userprofileMixinFields0[1].Descriptor()
+	// userprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userprofile.DefaultUpdatedAt = userprofileDescUpdatedAt.Default.(func() time.Time)
+	// userprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userprofile.UpdateDefaultUpdatedAt = userprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userprofileDescLastSeenAt is the schema descriptor for last_seen_at field.
+	userprofileDescLastSeenAt := userprofileFields[4].Descriptor()
+	// userprofile.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	userprofile.DefaultLastSeenAt = userprofileDescLastSeenAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package savedview
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldOwner, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldName, v))
+}
+
+// Notify applies equality check predicate on the "notify" field. It's identical to NotifyEQ.
+func Notify(v bool) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldNotify, v))
+}
+
+// SlackChannel applies equality check predicate on the "slack_channel" field. It's identical to SlackChannelEQ.
+func SlackChannel(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldSlackChannel, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.SavedView {
+	return predicate.SavedView(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.SavedView {
+	return predicate.SavedView(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContainsFold(FieldName, v))
+}
+
+// NotifyEQ applies the EQ predicate on the "notify" field.
+func NotifyEQ(v bool) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldNotify, v))
+}
+
+// NotifyNEQ applies the NEQ predicate on the "notify" field.
+func NotifyNEQ(v bool) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldNotify, v))
+}
+
+// SlackChannelEQ applies the EQ predicate on the "slack_channel" field.
+func SlackChannelEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEQ(FieldSlackChannel, v))
+}
+
+// SlackChannelNEQ applies the NEQ predicate on the "slack_channel" field.
+func SlackChannelNEQ(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNEQ(FieldSlackChannel, v))
+}
+
+// SlackChannelIn applies the In predicate on the "slack_channel" field.
+func SlackChannelIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldIn(FieldSlackChannel, vs...))
+}
+
+// SlackChannelNotIn applies the NotIn predicate on the "slack_channel" field.
+func SlackChannelNotIn(vs ...string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotIn(FieldSlackChannel, vs...))
+}
+
+// SlackChannelGT applies the GT predicate on the "slack_channel" field.
+func SlackChannelGT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGT(FieldSlackChannel, v))
+}
+
+// SlackChannelGTE applies the GTE predicate on the "slack_channel" field.
+func SlackChannelGTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldGTE(FieldSlackChannel, v))
+}
+
+// SlackChannelLT applies the LT predicate on the "slack_channel" field.
+func SlackChannelLT(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLT(FieldSlackChannel, v))
+}
+
+// SlackChannelLTE applies the LTE predicate on the "slack_channel" field.
+func SlackChannelLTE(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldLTE(FieldSlackChannel, v))
+}
+
+// SlackChannelContains applies the Contains predicate on the "slack_channel" field.
+func SlackChannelContains(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContains(FieldSlackChannel, v))
+}
+
+// SlackChannelHasPrefix applies the HasPrefix predicate on the "slack_channel" field.
+func SlackChannelHasPrefix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasPrefix(FieldSlackChannel, v))
+}
+
+// SlackChannelHasSuffix applies the HasSuffix predicate on the "slack_channel" field.
+func SlackChannelHasSuffix(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldHasSuffix(FieldSlackChannel, v))
+}
+
+// SlackChannelIsNil applies the IsNil predicate on the "slack_channel" field.
+func SlackChannelIsNil() predicate.SavedView {
+	return predicate.SavedView(sql.FieldIsNull(FieldSlackChannel))
+}
+
+// SlackChannelNotNil applies the NotNil predicate on the "slack_channel" field.
+func SlackChannelNotNil() predicate.SavedView {
+	return predicate.SavedView(sql.FieldNotNull(FieldSlackChannel))
+}
+
+// SlackChannelEqualFold applies the EqualFold predicate on the "slack_channel" field.
+func SlackChannelEqualFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldEqualFold(FieldSlackChannel, v))
+}
+
+// SlackChannelContainsFold applies the ContainsFold predicate on the "slack_channel" field.
+func SlackChannelContainsFold(v string) predicate.SavedView {
+	return predicate.SavedView(sql.FieldContainsFold(FieldSlackChannel, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SavedView) predicate.SavedView {
+	return predicate.SavedView(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SavedView) predicate.SavedView {
+	return predicate.SavedView(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SavedView) predicate.SavedView {
+	return predicate.SavedView(sql.NotPredicates(p))
+}
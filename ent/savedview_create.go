@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/savedview"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// SavedViewCreate is the builder for creating a SavedView entity.
+type SavedViewCreate struct {
+	config
+	mutation *SavedViewMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SavedViewCreate) SetCreatedAt(v time.Time) *SavedViewCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SavedViewCreate) SetNillableCreatedAt(v *time.Time) *SavedViewCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SavedViewCreate) SetUpdatedAt(v time.Time) *SavedViewCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SavedViewCreate) SetNillableUpdatedAt(v *time.Time) *SavedViewCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *SavedViewCreate) SetCreatedBy(v string) *SavedViewCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *SavedViewCreate) SetNillableCreatedBy(v *string) *SavedViewCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *SavedViewCreate) SetUpdatedBy(v string) *SavedViewCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *SavedViewCreate) SetNillableUpdatedBy(v *string) *SavedViewCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *SavedViewCreate) SetOwner(v string) *SavedViewCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SavedViewCreate) SetName(v string) *SavedViewCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetFilter sets the "filter" field.
+func (_c *SavedViewCreate) SetFilter(v schema.SavedViewFilter) *SavedViewCreate {
+	_c.mutation.SetFilter(v)
+	return _c
+}
+
+// SetNotify sets the "notify" field.
+func (_c *SavedViewCreate) SetNotify(v bool) *SavedViewCreate {
+	_c.mutation.SetNotify(v)
+	return _c
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (_c *SavedViewCreate) SetNillableNotify(v *bool) *SavedViewCreate {
+	if v != nil {
+		_c.SetNotify(*v)
+	}
+	return _c
+}
+
+// SetSlackChannel sets the "slack_channel" field.
+func (_c *SavedViewCreate) SetSlackChannel(v string) *SavedViewCreate {
+	_c.mutation.SetSlackChannel(v)
+	return _c
+}
+
+// SetNillableSlackChannel sets the "slack_channel" field if the given value is not nil.
+func (_c *SavedViewCreate) SetNillableSlackChannel(v *string) *SavedViewCreate {
+	if v != nil {
+		_c.SetSlackChannel(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SavedViewCreate) SetID(v string) *SavedViewCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the SavedViewMutation object of the builder.
+func (_c *SavedViewCreate) Mutation() *SavedViewMutation {
+	return _c.mutation
+}
+
+// Save creates the SavedView in the database.
+func (_c *SavedViewCreate) Save(ctx context.Context) (*SavedView, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SavedViewCreate) SaveX(ctx context.Context) *SavedView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SavedViewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SavedViewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SavedViewCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if savedview.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized savedview.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := savedview.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if savedview.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized savedview.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := savedview.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Notify(); !ok {
+		v := savedview.DefaultNotify
+		_c.mutation.SetNotify(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SavedViewCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedView.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SavedView.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Owner(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required field "SavedView.owner"`)}
+	}
+	if v, ok := _c.mutation.Owner(); ok {
+		if err := savedview.OwnerValidator(v); err != nil {
+			return &ValidationError{Name: "owner", err: fmt.Errorf(`ent: validator failed for field "SavedView.owner": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SavedView.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := savedview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedView.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Filter(); !ok {
+		return &ValidationError{Name: "filter", err: errors.New(`ent: missing required field "SavedView.filter"`)}
+	}
+	if _, ok := _c.mutation.Notify(); !ok {
+		return &ValidationError{Name: "notify", err: errors.New(`ent: missing required field "SavedView.notify"`)}
+	}
+	return nil
+}
+
+func (_c *SavedViewCreate) sqlSave(ctx context.Context) (*SavedView, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SavedView.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SavedViewCreate) createSpec() (*SavedView, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedView{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(savedview.Table, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(savedview.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedview.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(savedview.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(savedview.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(savedview.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(savedview.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Filter(); ok {
+		_spec.SetField(savedview.FieldFilter, field.TypeJSON, value)
+		_node.Filter = value
+	}
+	if value, ok := _c.mutation.Notify(); ok {
+		_spec.SetField(savedview.FieldNotify, field.TypeBool, value)
+		_node.Notify = value
+	}
+	if value, ok := _c.mutation.SlackChannel(); ok {
+		_spec.SetField(savedview.FieldSlackChannel, field.TypeString, value)
+		_node.SlackChannel = &value
+	}
+	return _node, _spec
+}
+
+// SavedViewCreateBulk is the builder for creating many SavedView entities in bulk.
+type SavedViewCreateBulk struct {
+	config
+	err      error
+	builders []*SavedViewCreate
+}
+
+// Save creates the SavedView entities in the database.
+func (_c *SavedViewCreateBulk) Save(ctx context.Context) ([]*SavedView, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SavedView, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedViewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SavedViewCreateBulk) SaveX(ctx context.Context) []*SavedView {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SavedViewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SavedViewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
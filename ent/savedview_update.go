@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/savedview"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// SavedViewUpdate is the builder for updating SavedView entities.
+type SavedViewUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SavedViewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SavedViewUpdate builder.
+func (_u *SavedViewUpdate) Where(ps ...predicate.SavedView) *SavedViewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SavedViewUpdate) SetUpdatedAt(v time.Time) *SavedViewUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *SavedViewUpdate) SetCreatedBy(v string) *SavedViewUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *SavedViewUpdate) SetNillableCreatedBy(v *string) *SavedViewUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *SavedViewUpdate) ClearCreatedBy() *SavedViewUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *SavedViewUpdate) SetUpdatedBy(v string) *SavedViewUpdate {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *SavedViewUpdate) SetNillableUpdatedBy(v *string) *SavedViewUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *SavedViewUpdate) ClearUpdatedBy() *SavedViewUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SavedViewUpdate) SetName(v string) *SavedViewUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SavedViewUpdate) SetNillableName(v *string) *SavedViewUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFilter sets the "filter" field.
+func (_u *SavedViewUpdate) SetFilter(v schema.SavedViewFilter) *SavedViewUpdate {
+	_u.mutation.SetFilter(v)
+	return _u
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_u *SavedViewUpdate) SetNillableFilter(v *schema.SavedViewFilter) *SavedViewUpdate {
+	if v != nil {
+		_u.SetFilter(*v)
+	}
+	return _u
+}
+
+// SetNotify sets the "notify" field.
+func (_u *SavedViewUpdate) SetNotify(v bool) *SavedViewUpdate {
+	_u.mutation.SetNotify(v)
+	return _u
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (_u *SavedViewUpdate) SetNillableNotify(v *bool) *SavedViewUpdate {
+	if v != nil {
+		_u.SetNotify(*v)
+	}
+	return _u
+}
+
+// SetSlackChannel sets the "slack_channel" field.
+func (_u *SavedViewUpdate) SetSlackChannel(v string) *SavedViewUpdate {
+	_u.mutation.SetSlackChannel(v)
+	return _u
+}
+
+// SetNillableSlackChannel sets the "slack_channel" field if the given value is not nil.
+func (_u *SavedViewUpdate) SetNillableSlackChannel(v *string) *SavedViewUpdate {
+	if v != nil {
+		_u.SetSlackChannel(*v)
+	}
+	return _u
+}
+
+// ClearSlackChannel clears the value of the "slack_channel" field.
+func (_u *SavedViewUpdate) ClearSlackChannel() *SavedViewUpdate {
+	_u.mutation.ClearSlackChannel()
+	return _u
+}
+
+// Mutation returns the SavedViewMutation object of the builder.
+func (_u *SavedViewUpdate) Mutation() *SavedViewMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SavedViewUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedViewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SavedViewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedViewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SavedViewUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if savedview.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized savedview.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := savedview.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedViewUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := savedview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedView.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedViewUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedViewUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SavedViewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedview.Table, savedview.Columns, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(savedview.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(savedview.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(savedview.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(savedview.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(savedview.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Filter(); ok {
+		_spec.SetField(savedview.FieldFilter, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Notify(); ok {
+		_spec.SetField(savedview.FieldNotify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SlackChannel(); ok {
+		_spec.SetField(savedview.FieldSlackChannel, field.TypeString, value)
+	}
+	if _u.mutation.SlackChannelCleared() {
+		_spec.ClearField(savedview.FieldSlackChannel, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SavedViewUpdateOne is the builder for updating a single SavedView entity.
+type SavedViewUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SavedViewMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SavedViewUpdateOne) SetUpdatedAt(v time.Time) *SavedViewUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *SavedViewUpdateOne) SetCreatedBy(v string) *SavedViewUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *SavedViewUpdateOne) SetNillableCreatedBy(v *string) *SavedViewUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *SavedViewUpdateOne) ClearCreatedBy() *SavedViewUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *SavedViewUpdateOne) SetUpdatedBy(v string) *SavedViewUpdateOne {
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *SavedViewUpdateOne) SetNillableUpdatedBy(v *string) *SavedViewUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *SavedViewUpdateOne) ClearUpdatedBy() *SavedViewUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SavedViewUpdateOne) SetName(v string) *SavedViewUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SavedViewUpdateOne) SetNillableName(v *string) *SavedViewUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFilter sets the "filter" field.
+func (_u *SavedViewUpdateOne) SetFilter(v schema.SavedViewFilter) *SavedViewUpdateOne {
+	_u.mutation.SetFilter(v)
+	return _u
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_u *SavedViewUpdateOne) SetNillableFilter(v *schema.SavedViewFilter) *SavedViewUpdateOne {
+	if v != nil {
+		_u.SetFilter(*v)
+	}
+	return _u
+}
+
+// SetNotify sets the "notify" field.
+func (_u *SavedViewUpdateOne) SetNotify(v bool) *SavedViewUpdateOne {
+	_u.mutation.SetNotify(v)
+	return _u
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (_u *SavedViewUpdateOne) SetNillableNotify(v *bool) *SavedViewUpdateOne {
+	if v != nil {
+		_u.SetNotify(*v)
+	}
+	return _u
+}
+
+// SetSlackChannel sets the "slack_channel" field.
+func (_u *SavedViewUpdateOne) SetSlackChannel(v string) *SavedViewUpdateOne {
+	_u.mutation.SetSlackChannel(v)
+	return _u
+}
+
+// SetNillableSlackChannel sets the "slack_channel" field if the given value is not nil.
+func (_u *SavedViewUpdateOne) SetNillableSlackChannel(v *string) *SavedViewUpdateOne {
+	if v != nil {
+		_u.SetSlackChannel(*v)
+	}
+	return _u
+}
+
+// ClearSlackChannel clears the value of the "slack_channel" field.
+func (_u *SavedViewUpdateOne) ClearSlackChannel() *SavedViewUpdateOne {
+	_u.mutation.ClearSlackChannel()
+	return _u
+}
+
+// Mutation returns the SavedViewMutation object of the builder.
+func (_u *SavedViewUpdateOne) Mutation() *SavedViewMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SavedViewUpdate builder.
+func (_u *SavedViewUpdateOne) Where(ps ...predicate.SavedView) *SavedViewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SavedViewUpdateOne) Select(field string, fields ...string) *SavedViewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SavedView entity.
+func (_u *SavedViewUpdateOne) Save(ctx context.Context) (*SavedView, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedViewUpdateOne) SaveX(ctx context.Context) *SavedView {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SavedViewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedViewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SavedViewUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if savedview.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized savedview.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := savedview.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedViewUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := savedview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedView.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedViewUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedViewUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SavedViewUpdateOne) sqlSave(ctx context.Context) (_node *SavedView, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedview.Table, savedview.Columns, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedView.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedview.FieldID)
+		for _, f := range fields {
+			if !savedview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(savedview.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(savedview.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(savedview.FieldUpdatedBy, field.TypeString, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(savedview.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(savedview.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Filter(); ok {
+		_spec.SetField(savedview.FieldFilter, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Notify(); ok {
+		_spec.SetField(savedview.FieldNotify, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SlackChannel(); ok {
+		_spec.SetField(savedview.FieldSlackChannel, field.TypeString, value)
+	}
+	if _u.mutation.SlackChannelCleared() {
+		_spec.ClearField(savedview.FieldSlackChannel, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SavedView{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ActionItem holds the schema definition for the ActionItem entity.
+// A follow-up task from a session's remediation recommendations, extracted
+// from the final analysis or added by hand, tracked until it is done.
+type ActionItem struct {
+	ent.Schema
+}
+
+// Mixin of the ActionItem.
+func (ActionItem) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		AuditMixin{},
+	}
+}
+
+// Fields of the ActionItem.
+func (ActionItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("action_item_id").
+			Unique().
+			Immutable(),
+		field.String("session_id").
+			Immutable(),
+		field.String("title").
+			NotEmpty().
+			Comment("What should be done, in one line"),
+		field.Text("details").
+			Optional().
+			Nillable().
+			Comment("Why and how, from the recommendation"),
+		field.Enum("status").
+			Values("open", "done").
+			Default("open"),
+		field.Enum("source").
+			Values("extracted", "manual").
+			Immutable().
+			Comment("extracted from the final analysis (system.action_items) or added through the API"),
+		field.String("assignee").
+			Optional().
+			Nillable().
+			Comment("Team or person responsible"),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("When status last changed to done"),
+		field.String("completed_by").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ActionItem.
+func (ActionItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", AlertSession.Type).
+			Ref("action_items").
+			Field("session_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ActionItem.
+func (ActionItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id"),
+		index.Fields("status", "created_at"),
+	}
+}
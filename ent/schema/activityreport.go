@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ActivityReportStats is the aggregated activity snapshot for a report window.
+// Stored as JSON in the stats column.
+type ActivityReportStats struct {
+	TotalSessions    int                       `json:"total_sessions"`
+	StatusCounts     map[string]int            `json:"status_counts"`
+	SuccessRate      *float64                  `json:"success_rate"` // completed / (completed + failed + timed_out); nil when nothing finished
+	TopAlertTypes    []ActivityReportAlertType `json:"top_alert_types"`
+	NotableSessions  []ActivityReportNotable   `json:"notable_sessions"`
+	TotalTokens      int64                     `json:"total_tokens"`
+	EstimatedCostUsd *float64                  `json:"estimated_cost_usd,omitempty"` // nil when cost estimation is disabled
+}
+
+// ActivityReportAlertType is a per-alert-type session count within the window.
+type ActivityReportAlertType struct {
+	AlertType string `json:"alert_type"`
+	Sessions  int    `json:"sessions"`
+	Failed    int    `json:"failed"`
+}
+
+// ActivityReportNotable is a high/critical severity session whose executive
+// summary is surfaced as a notable root cause.
+type ActivityReportNotable struct {
+	SessionID        string    `json:"session_id"`
+	AlertType        string    `json:"alert_type"`
+	ChainID          string    `json:"chain_id"`
+	Severity         string    `json:"severity"`
+	ExecutiveSummary string    `json:"executive_summary"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// ActivityReport holds the schema definition for the ActivityReport entity.
+// A periodic (daily/weekly) digest of fleet activity. One row per period and
+// window start; the unique index lets exactly one replica claim each window.
+type ActivityReport struct {
+	ent.Schema
+}
+
+// Mixin of the ActivityReport.
+func (ActivityReport) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the ActivityReport.
+func (ActivityReport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("report_id").
+			Unique().
+			Immutable(),
+		field.Enum("period").
+			Values("daily", "weekly").
+			Immutable(),
+		field.Time("window_start").
+			Immutable().
+			Comment("Inclusive start of the reported window (UTC)"),
+		field.Time("window_end").
+			Immutable().
+			Comment("Exclusive end of the reported window (UTC)"),
+		field.JSON("stats", ActivityReportStats{}).
+			Comment("Aggregated activity for the window"),
+		field.Text("narrative").
+			Optional().
+			Nillable().
+			Comment("LLM-written summary of the window"),
+		field.String("narrative_error").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the ActivityReport.
+func (ActivityReport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("period", "window_start").
+			Unique(),
+		index.Fields("created_at"),
+	}
+}
@@ -78,6 +78,14 @@ func (AgentExecution) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Task description from orchestrator dispatch"),
+
+		// Tool-call accounting (enforced by max_tool_calls)
+		field.Int("tool_call_count").
+			Default(0).
+			Comment("Tool calls the agent made during this execution"),
+		field.Bool("loop_detected").
+			Default(false).
+			Comment("The agent repeated an identical tool call beyond max_duplicate_tool_calls"),
 	}
 }
 
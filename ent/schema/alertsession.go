@@ -11,14 +11,72 @@ import (
 	"entgo.io/ent/schema/index"
 )
 
+// ModelRoutingDecision records how the complexity router picked the provider
+// tier for a session. Stored as JSON in the model_routing column.
+type ModelRoutingDecision struct {
+	Tier               string `json:"tier,omitempty"`     // "fast" or "strong"; empty when classification failed
+	Provider           string `json:"provider,omitempty"` // LLM provider the chain ran on
+	Score              int    `json:"score,omitempty"`    // complexity score, 1 (trivial) to 10 (hard)
+	Reason             string `json:"reason,omitempty"`   // classifier's one-line rationale
+	ClassifierProvider string `json:"classifier_provider"`
+	Error              string `json:"error,omitempty"` // why classification failed (chain ran as configured)
+}
+
+// NoiseTriageDecision records the triage pre-chain's verdict on a session's
+// alert. Stored as JSON in the noise_triage column.
+type NoiseTriageDecision struct {
+	Verdict            string  `json:"verdict,omitempty"`      // "actionable", "false_positive" or "duplicate"; empty when classification failed
+	Confidence         float64 `json:"confidence,omitempty"`   // classifier confidence, 0 to 1
+	Reason             string  `json:"reason,omitempty"`       // classifier's one-line rationale
+	DuplicateOf        string  `json:"duplicate_of,omitempty"` // recent session the alert duplicates
+	Skipped            bool    `json:"skipped"`                // chain skipped; the session closed with the verdict
+	ClassifierProvider string  `json:"classifier_provider"`
+	Error              string  `json:"error,omitempty"` // why classification failed (full chain ran)
+}
+
+// FederationOrigin identifies the stage another TARSy instance delegated to
+// this session. Stored as JSON in the federation_origin column.
+type FederationOrigin struct {
+	SourceURL string `json:"source_url,omitempty"` // dashboard URL of the delegating instance
+	SessionID string `json:"session_id"`           // delegating session
+	StageName string `json:"stage_name"`           // delegated stage in the delegating chain
+	Context   string `json:"context,omitempty"`    // prior stage results, passed to this session's first stage
+}
+
+// AlertTarget is the cluster context an alert is about, validated against
+// system.targets. Stored as JSON in the target column.
+type AlertTarget struct {
+	Cluster   string `json:"cluster"`
+	Region    string `json:"region,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// OnCallContact is who was on call for the alert's service when the session
+// started (system.on_call). Stored as JSON in the on_call column.
+type OnCallContact struct {
+	Service    string     `json:"service"`        // affected service the schedule was looked up for
+	Team       string     `json:"team,omitempty"` // owning team, from system.on_call.services
+	Provider   string     `json:"provider"`       // "pagerduty" or "opsgenie"
+	ScheduleID string     `json:"schedule_id"`
+	Name       string     `json:"name,omitempty"` // on-call engineer; empty when nobody is on call
+	Email      string     `json:"email,omitempty"`
+	Until      *time.Time `json:"until,omitempty"` // end of the current on-call shift, when known
+	ResolvedAt time.Time  `json:"resolved_at"`
+	Error      string     `json:"error,omitempty"` // why the lookup failed
+}
+
 // AlertSession holds the schema definition for the AlertSession entity.
 type AlertSession struct {
 	ent.Schema
 }
 
-// Mixin for custom ID field.
+// Mixin of the AlertSession. created_at stays a regular mutable field:
+// retention tests backdate it.
 func (AlertSession) Mixin() []ent.Mixin {
-	return []ent.Mixin{}
+	return []ent.Mixin{
+		UpdateTimeMixin{},
+		SoftDeleteMixin{},
+	}
 }
 
 // Fields of the AlertSession.
@@ -36,7 +94,7 @@ func (AlertSession) Fields() []ent.Field {
 			Optional().
 			Comment("Alert classification"),
 		field.Enum("status").
-			Values("pending", "in_progress", "cancelling", "completed", "failed", "cancelled", "timed_out").
+			Values("pending", "in_progress", "cancelling", "completed", "failed", "cancelled", "timed_out", "auto_cancelled").
 			Default("pending"),
 		field.Time("created_at").
 			Default(time.Now).
@@ -62,20 +120,68 @@ func (AlertSession) Fields() []ent.Field {
 		field.String("executive_summary_error").
 			Optional().
 			Nillable(),
+		field.String("executive_summary_template").
+			Optional().
+			Nillable().
+			Comment("Version of the executive summary prompt that produced executive_summary"),
+		field.Text("technical_summary").
+			Optional().
+			Nillable().
+			Comment("Detailed summary with next steps, for engineers (chains with technical_summary enabled)"),
+		field.String("technical_summary_error").
+			Optional().
+			Nillable(),
+		field.Enum("severity").
+			Values("critical", "high", "medium", "low").
+			Optional().
+			Nillable().
+			Comment("Severity classified by the executive summary agent (drives notification routing)"),
+		field.Enum("outcome").
+			Values("root_cause_identified", "mitigated", "needs_human", "false_positive", "inconclusive").
+			Optional().
+			Nillable().
+			Comment("Outcome taxonomy label assigned after completion (system.outcome_classification)"),
+		field.Text("outcome_reason").
+			Optional().
+			Nillable().
+			Comment("One-line rationale for outcome"),
+		field.Enum("outcome_source").
+			Values("agent", "classifier", "noise_triage").
+			Optional().
+			Nillable().
+			Comment("How outcome was decided: stated by the agent, classified by an LLM call, or taken from noise triage"),
 		field.JSON("session_metadata", map[string]interface{}{}).
 			Optional(),
 		field.String("author").
 			Optional().
 			Nillable().
 			Comment("From oauth2-proxy"),
+		field.String("author_subject").
+			Optional().
+			Nillable().
+			Comment("OIDC subject of the submitter (user_profiles key)"),
 		field.String("runbook_url").
 			Optional().
 			Nillable(),
 		field.JSON("mcp_selection", map[string]interface{}{}).
 			Optional().
 			Comment("MCP override config"),
+		field.JSON("alert_instructions", map[string]interface{}{}).
+			Optional().
+			Comment("Extra agent guidance submitted with the alert (global, per-stage, per-agent); masked before storage"),
+		field.JSON("alert_images", []ImageRef{}).
+			Optional().
+			Comment("Image attachments (dashboard screenshots) submitted with the alert; kept in the blob store"),
+		field.String("output_language").
+			Optional().
+			Nillable().
+			Comment("Output language requested with the alert; overrides chain and defaults"),
 		field.String("chain_id").
 			Comment("Chain identifier (live lookup, no snapshot)"),
+		field.String("owner").
+			Optional().
+			Nillable().
+			Comment("Team charged for the session (chain owner at submission); null = unattributed"),
 		field.Int("current_stage_index").
 			Optional().
 			Nillable(),
@@ -94,10 +200,110 @@ func (AlertSession) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("For Slack threading"),
-		field.Time("deleted_at").
+		field.String("alert_key").
+			Optional().
+			Nillable().
+			Comment("Identifier of the originating alert in the source system (e.g. Alertmanager fingerprint), matched by the resolution webhook"),
+		field.Time("alert_resolved_at").
+			Optional().
+			Nillable().
+			Comment("When the alert source reported the alert resolved while the session was queued or running"),
+		field.String("alert_resolution").
+			Optional().
+			Nillable().
+			Comment("Resolution reason reported by the alert source"),
+		field.Enum("cancel_initiator").
+			Values("user", "pending_ttl", "alert_resolved", "preemption").
+			Optional().
+			Nillable().
+			Comment("What cancelled the session: an operator via the API, the pending TTL policy, the alert resolution webhook, or the backlog preemption policy"),
+		field.Text("cancel_reason").
+			Optional().
+			Nillable().
+			Comment("Why the session was cancelled"),
+		field.String("cancelled_by").
 			Optional().
 			Nillable().
-			Comment("Soft delete for retention policy"),
+			Comment("User who requested the cancellation (X-Forwarded-User value); NULL for automatic cancellations"),
+		field.String("request_id").
+			Optional().
+			Nillable().
+			Comment("X-Request-ID of the submitting API call (correlation across logs, events, interactions)"),
+		field.String("degraded_reason").
+			Optional().
+			Nillable().
+			Comment("Set when the session ran the triage-only degradation profile because its preferred LLM provider was failing"),
+		field.JSON("model_routing", &ModelRoutingDecision{}).
+			Optional().
+			Comment("Provider tier chosen by the complexity router (system.model_routing)"),
+		field.JSON("noise_triage", &NoiseTriageDecision{}).
+			Optional().
+			Comment("Verdict of the noise triage pre-chain (system.noise_triage)"),
+		field.Bool("force_full_investigation").
+			Default(false).
+			Immutable().
+			Comment("Submitted with force_full_investigation: bypasses the noise triage pre-chain"),
+		field.JSON("federation_origin", &FederationOrigin{}).
+			Optional().
+			Comment("Delegating instance and stage when this session runs a stage for another TARSy instance (system.federation)"),
+		field.JSON("target", &AlertTarget{}).
+			Optional().
+			Comment("Cluster, region and namespace the alert targets (system.targets); rendered into MCP server transports"),
+		field.JSON("on_call", &OnCallContact{}).
+			Optional().
+			Comment("On-call engineer for the alert's service at session start (system.on_call)"),
+		field.String("group_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Fan-out group of sibling sessions created for the same alert"),
+		field.String("duplicated_from").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Session this one was duplicated from for what-if comparison"),
+		field.String("llm_provider").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Chain-level LLM provider override set when duplicating a session; skips model routing"),
+		field.Bool("sandbox").
+			Default(false).
+			Immutable().
+			Comment("Sandbox run against fixture MCP tools; never claimed by the worker pool"),
+		field.Bool("fallback_chain").
+			Default(false).
+			Immutable().
+			Comment("Alert type matched no chain; the session runs the fallback chain of its target namespace (defaults.fallback_chains)"),
+
+		// Completion callback fields
+		field.String("callback_url").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("URL POSTed the final result when the session reaches a terminal state"),
+		field.String("callback_secret").
+			Optional().
+			Nillable().
+			Immutable().
+			Sensitive().
+			Comment("HMAC-SHA256 key signing callback deliveries"),
+		field.Enum("callback_status").
+			Values("pending", "delivered", "failed").
+			Optional().
+			Nillable().
+			Comment("Completion callback delivery state — NULL when no callback is registered"),
+		field.Int("callback_attempts").
+			Default(0).
+			Comment("Completion callback delivery attempts made so far"),
+		field.Text("callback_last_error").
+			Optional().
+			Nillable().
+			Comment("Error of the latest failed callback delivery attempt"),
+		field.Time("callback_delivered_at").
+			Optional().
+			Nillable().
+			Comment("When the completion callback was acknowledged by the receiver"),
 
 		// Review workflow fields
 		field.Enum("review_status").
@@ -130,6 +336,23 @@ func (AlertSession) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Why the investigation was good or bad"),
+
+		// Handoff notes (operator-edited markdown; history in handoff_note_revisions)
+		field.Text("handoff_notes").
+			Optional().
+			Nillable().
+			Comment("Current handoff notes markdown — NULL when never written or cleared"),
+		field.Int("handoff_notes_revision").
+			Default(0).
+			Comment("Revision number of the current handoff notes; 0 = never edited"),
+		field.String("handoff_notes_updated_by").
+			Optional().
+			Nillable().
+			Comment("Author of the current handoff notes revision"),
+		field.Time("handoff_notes_updated_at").
+			Optional().
+			Nillable().
+			Comment("When the current handoff notes revision was saved"),
 	}
 }
 
@@ -157,9 +380,27 @@ func (AlertSession) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("review_activities", SessionReviewActivity.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("claims", SessionClaim.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("memories", InvestigationMemory.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("injected_memories", InvestigationMemory.Type),
+		edge.To("redaction_reviews", RedactionReview.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("handoff_note_revisions", HandoffNoteRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("comparison", SessionComparison.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("next_comparisons", SessionComparison.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("action_items", ActionItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("group", SessionGroup.Type).
+			Ref("sessions").
+			Field("group_id").
+			Unique().
+			Immutable(),
 	}
 }
 
@@ -171,16 +412,18 @@ func (AlertSession) Indexes() []ent.Index {
 		index.Fields("agent_type"),
 		index.Fields("alert_type"),
 		index.Fields("chain_id"),
+		index.Fields("owner"),
+		index.Fields("request_id"),
+		index.Fields("alert_key"),
+		index.Fields("group_id"),
+		index.Fields("duplicated_from"),
+		index.Fields("outcome"),
 
 		// Composite indexes
 		index.Fields("status", "created_at"),
 		index.Fields("status", "started_at"),
 		index.Fields("status", "last_interaction_at"),
 
-		// Partial index for soft deletes
-		index.Fields("deleted_at").
-			Annotations(entsql.IndexWhere("deleted_at IS NOT NULL")),
-
 		// Review workflow indexes
 		index.Fields("review_status"),
 		index.Fields("review_status", "assignee"),
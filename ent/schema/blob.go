@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+)
+
+// Blob holds the schema definition for the Blob entity.
+// Index of objects kept by the "postgres" blob store backend (see
+// pkg/blobstore). The bytes live in a PostgreSQL large object referenced by
+// object_oid; this row maps the store key to it. Read and written with raw
+// SQL because Ent has no large-object support.
+type Blob struct {
+	ent.Schema
+}
+
+// Mixin of the Blob.
+func (Blob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the Blob.
+func (Blob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("key").
+			Unique().
+			Immutable().
+			Comment("Store key, e.g. 'tool-results/<session_id>/<interaction_id>.json'"),
+		field.Uint32("object_oid").
+			SchemaType(map[string]string{dialect.Postgres: "oid"}).
+			Comment("Large object holding the bytes"),
+		field.Int64("size_bytes"),
+		field.String("content_type"),
+	}
+}
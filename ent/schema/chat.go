@@ -1,8 +1,6 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
@@ -16,6 +14,14 @@ type Chat struct {
 	ent.Schema
 }
 
+// Mixin of the Chat.
+func (Chat) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		AuditMixin{},
+	}
+}
+
 // Fields of the Chat.
 func (Chat) Fields() []ent.Field {
 	return []ent.Field{
@@ -26,13 +32,6 @@ func (Chat) Fields() []ent.Field {
 		field.String("session_id").
 			Unique().
 			Immutable(),
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
-		field.String("created_by").
-			Optional().
-			Nillable().
-			Comment("User email"),
 		field.String("chain_id").
 			Comment("From original session (live lookup, no snapshot)"),
 		field.String("pod_id").
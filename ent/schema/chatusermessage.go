@@ -1,20 +1,36 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
 
+// VoiceNote is a recorded question kept in the blob store under Key, with
+// the transcript that became the message content.
+type VoiceNote struct {
+	ID         string `json:"id"`
+	Key        string `json:"key"`
+	MimeType   string `json:"mime_type"`
+	Name       string `json:"name,omitempty"`
+	SizeBytes  int    `json:"size_bytes"`
+	Transcript string `json:"transcript"`
+}
+
 // ChatUserMessage holds the schema definition for the ChatUserMessage entity.
 // User messages in chat conversations.
 type ChatUserMessage struct {
 	ent.Schema
 }
 
+// Mixin of the ChatUserMessage.
+func (ChatUserMessage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
 // Fields of the ChatUserMessage.
 func (ChatUserMessage) Fields() []ent.Field {
 	return []ent.Field{
@@ -28,9 +44,16 @@ func (ChatUserMessage) Fields() []ent.Field {
 			Comment("Question text"),
 		field.String("author").
 			Comment("User email"),
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
+		field.String("author_subject").
+			Optional().
+			Nillable().
+			Comment("OIDC subject of the author (user_profiles key)"),
+		field.JSON("images", []ImageRef{}).
+			Optional().
+			Comment("Image attachments (screenshots) sent with the question"),
+		field.JSON("voice_note", &VoiceNote{}).
+			Optional().
+			Comment("Voice note the question was transcribed from"),
 	}
 }
 
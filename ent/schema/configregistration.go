@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ConfigRegistration holds the schema definition for the ConfigRegistration entity.
+// One version of an agent or chain registered at runtime through the admin
+// API. Rows are append-only: every update adds the next version and a
+// deletion adds a tombstone, so the latest version per kind and name is in
+// force and earlier versions are the change history.
+type ConfigRegistration struct {
+	ent.Schema
+}
+
+// Mixin of the ConfigRegistration.
+func (ConfigRegistration) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+		AuditMixin{},
+	}
+}
+
+// Fields of the ConfigRegistration.
+func (ConfigRegistration) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("registration_id").
+			Unique().
+			Immutable(),
+		field.Enum("kind").
+			Values("agent", "chain").
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			Immutable().
+			Comment("Agent name or chain ID"),
+		field.Int("version").
+			Positive().
+			Immutable(),
+		field.Text("definition").
+			Optional().
+			Immutable().
+			Comment("YAML definition, as under agents: or agent_chains: in tarsy.yaml; empty for deletions"),
+		field.Bool("deleted").
+			Default(false).
+			Immutable(),
+	}
+}
+
+// Indexes of the ConfigRegistration.
+func (ConfigRegistration) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "name", "version").
+			Unique(),
+	}
+}
@@ -1,8 +1,6 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -15,6 +13,13 @@ type Event struct {
 	ent.Schema
 }
 
+// Mixin of the Event.
+func (Event) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
 // Fields of the Event.
 func (Event) Fields() []ent.Field {
 	return []ent.Field{
@@ -24,9 +29,6 @@ func (Event) Fields() []ent.Field {
 			Comment("Event channel"),
 		field.JSON("payload", map[string]interface{}{}).
 			Comment("Event data"),
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
 	}
 }
 
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// FeatureFlagOverride holds the schema definition for the FeatureFlagOverride entity.
+// A runtime override of one feature flag's rollout, set through the admin API.
+// Replaces the flag's configured rollout on every replica until deleted.
+type FeatureFlagOverride struct {
+	ent.Schema
+}
+
+// Mixin of the FeatureFlagOverride.
+func (FeatureFlagOverride) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		AuditMixin{},
+	}
+}
+
+// Fields of the FeatureFlagOverride.
+func (FeatureFlagOverride) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("flag").
+			Unique().
+			Immutable(),
+		field.Bool("enabled"),
+		field.Int("percentage").
+			Optional().
+			Nillable().
+			Comment("Share of targets (0-100) that see the flag; null = all"),
+		field.JSON("alert_types", []string{}).
+			Optional().
+			Comment("Restrict the flag to these alert types; empty = all"),
+		field.JSON("chains", []string{}).
+			Optional().
+			Comment("Restrict the flag to these chain IDs; empty = all"),
+		field.JSON("namespaces", []string{}).
+			Optional().
+			Comment("Restrict the flag to alerts targeting these namespaces; empty = all"),
+	}
+}
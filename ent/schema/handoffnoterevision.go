@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HandoffNoteRevision holds the schema definition for the HandoffNoteRevision
+// entity. Every save of a session's handoff notes appends one revision; the
+// latest is mirrored on the session (handoff_notes).
+type HandoffNoteRevision struct {
+	ent.Schema
+}
+
+// Mixin of the HandoffNoteRevision.
+func (HandoffNoteRevision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the HandoffNoteRevision.
+func (HandoffNoteRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("revision_id").
+			Unique().
+			Immutable(),
+		field.String("session_id").
+			Immutable(),
+		field.Int("revision").
+			Immutable().
+			Comment("1-based, increasing per session"),
+		field.Text("content").
+			Immutable().
+			Comment("Full notes markdown after this edit; empty when the notes were cleared"),
+		field.String("author").
+			Immutable().
+			Comment("User who saved the revision"),
+	}
+}
+
+// Edges of the HandoffNoteRevision.
+func (HandoffNoteRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", AlertSession.Type).
+			Ref("handoff_note_revisions").
+			Field("session_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the HandoffNoteRevision.
+func (HandoffNoteRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id", "revision").
+			Unique(),
+	}
+}
@@ -14,6 +14,14 @@ type InvestigationMemory struct {
 	ent.Schema
 }
 
+// Mixin of the InvestigationMemory.
+func (InvestigationMemory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		AuditMixin{},
+	}
+}
+
 func (InvestigationMemory) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").
@@ -48,12 +56,6 @@ func (InvestigationMemory) Fields() []ent.Field {
 			Nillable(),
 
 		// Lifecycle
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now),
 		field.Time("last_seen_at").
 			Default(time.Now),
 		field.Bool("deprecated").
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// JobLeader holds the schema definition for the JobLeader entity.
+// Records which pod currently leads each singleton background job. The
+// PostgreSQL advisory lock is the source of truth; this row is informational
+// (API visibility) and is renewed by the leader while it holds the lock.
+type JobLeader struct {
+	ent.Schema
+}
+
+// Fields of the JobLeader.
+func (JobLeader) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("job").
+			Unique().
+			Immutable().
+			Comment("Singleton job name, e.g. 'retention-cleanup'"),
+		field.String("pod_id"),
+		field.Time("acquired_at"),
+		field.Time("renewed_at").
+			Default(time.Now),
+	}
+}
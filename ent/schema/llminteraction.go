@@ -1,8 +1,6 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -15,6 +13,13 @@ type LLMInteraction struct {
 	ent.Schema
 }
 
+// Mixin of the LLMInteraction.
+func (LLMInteraction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
 // Fields of the LLMInteraction.
 func (LLMInteraction) Fields() []ent.Field {
 	return []ent.Field{
@@ -36,13 +41,10 @@ func (LLMInteraction) Fields() []ent.Field {
 			Comment("Which agent; nil for session-level interactions"),
 
 		// Timing
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
 
 		// Interaction Details
 		field.Enum("interaction_type").
-			Values("iteration", "final_analysis", "executive_summary", "chat_response", "summarization", "synthesis", "forced_conclusion", "scoring", "memory_extraction"),
+			Values("iteration", "final_analysis", "executive_summary", "technical_summary", "chat_response", "summarization", "synthesis", "forced_conclusion", "scoring", "memory_extraction", "outcome_classification", "action_item_extraction", "recurrence_comparison", "fanout_synthesis", "noise_triage", "model_routing"),
 		field.String("model_name").
 			Comment("e.g., 'gemini-2.0-flash-thinking-exp'"),
 
@@ -57,6 +59,10 @@ func (LLMInteraction) Fields() []ent.Field {
 			Comment("Full API request payload"),
 		field.JSON("llm_response", map[string]interface{}{}).
 			Comment("Full API response payload"),
+		field.Bytes("payload_zstd").
+			Optional().
+			Nillable().
+			Comment("zstd-compressed {llm_request, llm_response} for large payloads; both columns are empty objects when set"),
 		field.Text("thinking_content").
 			Optional().
 			Nillable().
@@ -90,6 +96,14 @@ func (LLMInteraction) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("null = success, not-null = failed"),
+		field.String("request_id").
+			Optional().
+			Nillable().
+			Comment("X-Request-ID of the API call that triggered this work"),
+		field.String("owner").
+			Optional().
+			Nillable().
+			Comment("Team charged for the call (agent owner, else chain owner); null = unattributed"),
 	}
 }
 
@@ -1,8 +1,6 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -15,6 +13,13 @@ type MCPInteraction struct {
 	ent.Schema
 }
 
+// Mixin of the MCPInteraction.
+func (MCPInteraction) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
 // Fields of the MCPInteraction.
 func (MCPInteraction) Fields() []ent.Field {
 	return []ent.Field{
@@ -31,9 +36,6 @@ func (MCPInteraction) Fields() []ent.Field {
 			Comment("Which agent"),
 
 		// Timing
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
 
 		// Interaction Details
 		field.Enum("interaction_type").
@@ -52,6 +54,18 @@ func (MCPInteraction) Fields() []ent.Field {
 		field.JSON("tool_result", map[string]interface{}{}).
 			Optional().
 			Comment("Tool output"),
+		field.Bytes("tool_result_zstd").
+			Optional().
+			Nillable().
+			Comment("zstd-compressed tool_result for large outputs; tool_result is null when set"),
+		field.Int("tool_result_bytes").
+			Optional().
+			Nillable().
+			Comment("Uncompressed JSON size of tool_result_zstd or tool_result_blob (for usage statistics)"),
+		field.String("tool_result_blob").
+			Optional().
+			Nillable().
+			Comment("Blob store key of an offloaded tool_result; tool_result is null when set"),
 		field.JSON("available_tools", []interface{}{}).
 			Optional().
 			Comment("For tool_list type"),
@@ -64,6 +78,10 @@ func (MCPInteraction) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("null = success, not-null = failed"),
+		field.String("request_id").
+			Optional().
+			Nillable().
+			Comment("X-Request-ID of the API call that triggered this work"),
 	}
 }
 
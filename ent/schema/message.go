@@ -1,8 +1,6 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -17,12 +15,29 @@ type MessageToolCall struct {
 	Arguments string `json:"arguments"`
 }
 
+// ImageRef points at an image attachment kept in the blob store under
+// Key. Stored as JSON on sessions, chat user messages, and messages.
+type ImageRef struct {
+	ID        string `json:"id"`
+	Key       string `json:"key"`
+	MimeType  string `json:"mime_type"`
+	Name      string `json:"name,omitempty"`
+	SizeBytes int    `json:"size_bytes"`
+}
+
 // Message holds the schema definition for the Message entity (Layer 2).
 // LLM conversation history (LLM context building).
 type Message struct {
 	ent.Schema
 }
 
+// Mixin of the Message.
+func (Message) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
 // Fields of the Message.
 func (Message) Fields() []ent.Field {
 	return []ent.Field{
@@ -59,10 +74,9 @@ func (Message) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("For tool messages: name of the tool that was called"),
-
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
+		field.JSON("images", []ImageRef{}).
+			Optional().
+			Comment("For user messages: image attachments sent to the LLM with the text"),
 	}
 }
 
@@ -0,0 +1,128 @@
+package schema
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+
+	"github.com/codeready-toolchain/tarsy/pkg/actor"
+)
+
+// CreateTimeMixin adds an immutable created_at, set when the row is created.
+type CreateTimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the CreateTimeMixin.
+func (CreateTimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// UpdateTimeMixin adds updated_at, refreshed by every update.
+type UpdateTimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the UpdateTimeMixin.
+func (UpdateTimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// TimeMixin adds created_at and updated_at.
+type TimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TimeMixin.
+func (TimeMixin) Fields() []ent.Field {
+	return append(CreateTimeMixin{}.Fields(), UpdateTimeMixin{}.Fields()...)
+}
+
+// AuditMixin adds created_by and updated_by. Unless a mutation sets them
+// explicitly, both are stamped from the actor on the context (the API
+// caller, see pkg/actor). Changes made by the system itself (workers,
+// background jobs) carry no actor and leave them unchanged.
+type AuditMixin struct {
+	mixin.Schema
+}
+
+// Fields of the AuditMixin.
+func (AuditMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("created_by").
+			Optional().
+			Nillable().
+			Comment("API caller that created the row; null when created by the system"),
+		field.String("updated_by").
+			Optional().
+			Nillable().
+			Comment("API caller that last changed the row"),
+	}
+}
+
+// Hooks of the AuditMixin.
+func (AuditMixin) Hooks() []ent.Hook {
+	return []ent.Hook{auditHook}
+}
+
+// auditHook stamps created_by / updated_by from the context actor.
+func auditHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		name := actor.FromContext(ctx)
+		if name == "" {
+			return next.Mutate(ctx, m)
+		}
+		fields := []string{"updated_by"}
+		if m.Op().Is(ent.OpCreate) {
+			fields = append(fields, "created_by")
+		}
+		for _, f := range fields {
+			if _, set := m.Field(f); set {
+				continue
+			}
+			if err := m.SetField(f, name); err != nil {
+				return nil, err
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// SoftDeleteMixin adds deleted_at. Soft-deleted rows stay in the table
+// until retention purges them; queries filter them explicitly
+// (DeletedAtIsNil) because retention and restore must still see them.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Soft delete for retention policy"),
+	}
+}
+
+// Indexes of the SoftDeleteMixin.
+func (SoftDeleteMixin) Indexes() []ent.Index {
+	return []ent.Index{
+		// Partial index for soft deletes
+		index.Fields("deleted_at").
+			Annotations(entsql.IndexWhere("deleted_at IS NOT NULL")),
+	}
+}
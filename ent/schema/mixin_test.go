@@ -0,0 +1,63 @@
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	entgo "entgo.io/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/actor"
+)
+
+// runAuditHook runs the AuditMixin hook over m.
+func runAuditHook(t *testing.T, ctx context.Context, m entgo.Mutation) {
+	t.Helper()
+	hooks := schema.AuditMixin{}.Hooks()
+	require.Len(t, hooks, 1)
+	next := entgo.MutateFunc(func(context.Context, entgo.Mutation) (entgo.Value, error) { return nil, nil })
+	_, err := hooks[0](next).Mutate(ctx, m)
+	require.NoError(t, err)
+}
+
+func TestAuditHook(t *testing.T) {
+	client := ent.NewClient()
+	ctx := actor.WithContext(context.Background(), "alice@example.com")
+
+	t.Run("create stamps both fields", func(t *testing.T) {
+		m := client.SavedView.Create().Mutation()
+		runAuditHook(t, ctx, m)
+		createdBy, _ := m.CreatedBy()
+		updatedBy, _ := m.UpdatedBy()
+		assert.Equal(t, "alice@example.com", createdBy)
+		assert.Equal(t, "alice@example.com", updatedBy)
+	})
+
+	t.Run("update stamps updated_by only", func(t *testing.T) {
+		m := client.SavedView.UpdateOneID("v1").Mutation()
+		runAuditHook(t, ctx, m)
+		_, created := m.CreatedBy()
+		updatedBy, _ := m.UpdatedBy()
+		assert.False(t, created)
+		assert.Equal(t, "alice@example.com", updatedBy)
+	})
+
+	t.Run("explicit values win", func(t *testing.T) {
+		m := client.FeatureFlagOverride.Create().SetUpdatedBy("bob").Mutation()
+		runAuditHook(t, ctx, m)
+		createdBy, _ := m.CreatedBy()
+		updatedBy, _ := m.UpdatedBy()
+		assert.Equal(t, "alice@example.com", createdBy)
+		assert.Equal(t, "bob", updatedBy)
+	})
+
+	t.Run("system changes are not stamped", func(t *testing.T) {
+		m := client.Chat.UpdateOneID("c1").Mutation()
+		runAuditHook(t, context.Background(), m)
+		_, updated := m.UpdatedBy()
+		assert.False(t, updated)
+	})
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PodHeartbeat holds the schema definition for the PodHeartbeat entity.
+// One row per running replica, refreshed periodically. Used to compare the
+// configuration fingerprints of live replicas (config drift detection).
+type PodHeartbeat struct {
+	ent.Schema
+}
+
+// Fields of the PodHeartbeat.
+func (PodHeartbeat) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("pod_id").
+			Unique().
+			Immutable(),
+		field.String("config_hash").
+			Comment("Fingerprint of the resolved configuration loaded by the pod"),
+		field.String("version").
+			Comment("Binary version (git commit)"),
+		field.Time("started_at").
+			Comment("When the current process started"),
+		field.Time("last_seen_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the PodHeartbeat.
+func (PodHeartbeat) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_seen_at"),
+	}
+}
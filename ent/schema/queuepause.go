@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// QueuePause holds the schema definition for the QueuePause entity.
+// A pause of session claiming set through the admin API, either for one pod
+// or for the whole cluster. In-flight sessions are unaffected; paused workers
+// stop claiming new ones until the row is deleted.
+type QueuePause struct {
+	ent.Schema
+}
+
+// Fields of the QueuePause.
+func (QueuePause) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("scope").
+			Unique().
+			Immutable().
+			Comment("Pod ID, or \"cluster\" for a cluster-wide pause"),
+		field.Text("reason").
+			Optional().
+			Nillable(),
+		field.Bool("maintenance").
+			Default(false).
+			Comment("Surface the pause as a maintenance banner via system warnings"),
+		field.Bool("drain").
+			Default(false).
+			Comment("Set by the drain API: the pod is being removed and reports drained once its in-flight sessions finish"),
+		field.String("paused_by").
+			Optional().
+			Nillable(),
+		field.Time("paused_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RedactionReview holds the schema definition for the RedactionReview entity.
+// A value in a masked MCP tool result that still looks like a secret, queued
+// for a human to confirm (redact it everywhere the session stored it) or
+// dismiss.
+type RedactionReview struct {
+	ent.Schema
+}
+
+// Mixin of the RedactionReview.
+func (RedactionReview) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RedactionReview.
+func (RedactionReview) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("review_id").
+			Unique().
+			Immutable(),
+		field.String("session_id").
+			Immutable(),
+		field.String("mcp_interaction_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("MCP interaction whose tool result contained the value"),
+		field.String("server_name").
+			Immutable(),
+		field.String("tool_name").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("heuristic").
+			Immutable().
+			Comment("Detector heuristic that flagged the value, e.g. 'high_entropy'"),
+		field.String("fingerprint").
+			Immutable().
+			Comment("SHA-256 of the value; same value in other sessions shares it"),
+		field.Text("value").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("Flagged value, needed to redact it; cleared once resolved"),
+		field.String("preview").
+			Immutable().
+			Comment("Value with all but its first and last characters elided"),
+		field.Text("context").
+			Immutable().
+			Comment("Surrounding text with the value shown as its preview"),
+		field.Enum("status").
+			Values("pending", "confirmed", "dismissed").
+			Default("pending"),
+		field.String("reviewed_by").
+			Optional().
+			Nillable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+		field.Text("note").
+			Optional().
+			Nillable().
+			Comment("Reviewer's reason for the decision"),
+		field.JSON("rows_redacted", map[string]int{}).
+			Optional().
+			Comment("Rows rewritten on confirm, keyed by table.column"),
+	}
+}
+
+// Edges of the RedactionReview.
+func (RedactionReview) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", AlertSession.Type).
+			Ref("redaction_reviews").
+			Field("session_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the RedactionReview.
+func (RedactionReview) Indexes() []ent.Index {
+	return []ent.Index{
+		// A value is queued once per session
+		index.Fields("session_id", "fingerprint").
+			Unique(),
+		// Review queue listing
+		index.Fields("status", "created_at"),
+		// Confirming applies to pending reviews of the same value
+		index.Fields("fingerprint"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SavedViewFilter is a named session filter. Field names match the session
+// list query parameters so the dashboard can apply a view directly.
+// Stored as JSON in the filter column.
+type SavedViewFilter struct {
+	Status        string `json:"status,omitempty"`         // comma-separated session statuses
+	AlertType     string `json:"alert_type,omitempty"`     // exact match
+	ChainID       string `json:"chain_id,omitempty"`       // exact match
+	Search        string `json:"search,omitempty"`         // case-insensitive substring of alert data / final analysis
+	CreatedWithin string `json:"created_within,omitempty"` // Go duration, e.g. "24h"; list-only (new sessions always match)
+}
+
+// SavedView holds the schema definition for the SavedView entity.
+// A user's named session filter, optionally subscribed to notifications for
+// new sessions that match it.
+type SavedView struct {
+	ent.Schema
+}
+
+// Mixin of the SavedView.
+func (SavedView) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+		AuditMixin{},
+	}
+}
+
+// Fields of the SavedView.
+func (SavedView) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("view_id").
+			Unique().
+			Immutable(),
+		field.String("owner").
+			NotEmpty().
+			Immutable().
+			Comment("OIDC subject of the owning user"),
+		field.String("name").
+			NotEmpty(),
+		field.JSON("filter", SavedViewFilter{}),
+		field.Bool("notify").
+			Default(false).
+			Comment("Send a WebSocket notification to the owner on match"),
+		field.String("slack_channel").
+			Optional().
+			Nillable().
+			Comment("Slack channel ID to notify on match"),
+	}
+}
+
+// Indexes of the SavedView.
+func (SavedView) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("owner", "name").
+			Unique(),
+	}
+}
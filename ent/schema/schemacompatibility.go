@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SchemaCompatibility holds the schema definition for the SchemaCompatibility entity.
+// A single row (id 1) records the oldest migration version a binary must
+// embed to run against this database. Breaking (contract-phase) migrations
+// raise it so older binaries refuse to start instead of failing mid-request.
+type SchemaCompatibility struct {
+	ent.Schema
+}
+
+// Fields of the SchemaCompatibility.
+func (SchemaCompatibility) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("min_version").
+			Default(0).
+			Comment("Migration version (timestamp prefix) the binary's latest embedded migration must be at least"),
+		field.String("reason").
+			Optional().
+			Nillable().
+			Comment("Why older binaries are incompatible, shown in the startup error"),
+	}
+}
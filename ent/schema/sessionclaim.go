@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SessionClaim records one claim of a session by a queue worker: who took
+// it, when, how long it had been waiting, and how the claim ended. Used to
+// debug queue latency and orphan recovery.
+type SessionClaim struct {
+	ent.Schema
+}
+
+// Fields of the SessionClaim.
+func (SessionClaim) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("claim_id").
+			Unique().
+			Immutable(),
+		field.String("session_id").
+			Immutable(),
+		field.String("pod_id").
+			Immutable().
+			Comment("Pod that claimed the session"),
+		field.String("worker_id").
+			Immutable().
+			Comment("Worker within the pod that claimed the session"),
+		field.Time("claimed_at").
+			Immutable(),
+		field.Int64("queue_wait_ms").
+			Immutable().
+			Comment("Time the session spent pending before this claim"),
+		field.Time("released_at").
+			Optional().
+			Nillable().
+			Comment("When the claim ended (nil while the worker still holds it)"),
+		field.Enum("outcome").
+			Values("completed", "failed", "timed_out", "cancelled", "orphaned", "pod_restarted").
+			Optional().
+			Nillable().
+			Comment("How the claim ended: terminal session status, or orphan recovery"),
+		field.Time("last_heartbeat_at").
+			Optional().
+			Nillable().
+			Comment("Last heartbeat seen for the session when the claim ended"),
+		field.Text("detail").
+			Optional().
+			Nillable().
+			Comment("Recovery detail for orphaned claims"),
+	}
+}
+
+// Edges of the SessionClaim.
+func (SessionClaim) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", AlertSession.Type).
+			Ref("claims").
+			Field("session_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the SessionClaim.
+func (SessionClaim) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_id", "claimed_at"),
+		index.Fields("released_at"),
+	}
+}
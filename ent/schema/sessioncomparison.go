@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SessionComparison holds the schema definition for the SessionComparison entity.
+// Compares a completed session's conclusion with the previous investigation
+// of the same alert (same alert_key and chain).
+type SessionComparison struct {
+	ent.Schema
+}
+
+// Mixin of the SessionComparison.
+func (SessionComparison) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the SessionComparison.
+func (SessionComparison) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("comparison_id").
+			Unique().
+			Immutable(),
+		field.String("session_id").
+			Unique().
+			Immutable().
+			Comment("The later session"),
+		field.String("previous_session_id").
+			Immutable().
+			Comment("The earlier session it is compared with"),
+		field.String("alert_key").
+			Immutable().
+			Comment("Alert fingerprint shared by both sessions"),
+		field.Enum("status").
+			Values("completed", "failed"),
+		field.Text("previous_conclusion").
+			Optional().
+			Nillable().
+			Comment("One-paragraph summary of what the previous investigation concluded"),
+		field.Text("current_conclusion").
+			Optional().
+			Nillable().
+			Comment("One-paragraph summary of what this investigation concluded"),
+		field.JSON("differences", []string{}).
+			Optional().
+			Comment("Material differences between the two conclusions"),
+		field.Bool("same_root_cause").
+			Optional().
+			Nillable().
+			Comment("Whether both investigations point to the same root cause"),
+		field.Text("error_message").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the SessionComparison.
+func (SessionComparison) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("session", AlertSession.Type).
+			Ref("comparison").
+			Field("session_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("previous_session", AlertSession.Type).
+			Ref("next_comparisons").
+			Field("previous_session_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the SessionComparison.
+func (SessionComparison) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("previous_session_id"),
+		index.Fields("alert_key"),
+	}
+}
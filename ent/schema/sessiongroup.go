@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SessionGroup groups the sibling sessions created when an alert fans out to
+// several chains (chain fan_out config). Holds the cross-session synthesis
+// that consolidates the siblings' findings once all of them are terminal.
+type SessionGroup struct {
+	ent.Schema
+}
+
+// Mixin of the SessionGroup.
+func (SessionGroup) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the SessionGroup.
+func (SessionGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("group_id").
+			Unique().
+			Immutable(),
+		field.String("alert_type").
+			Optional().
+			Immutable(),
+		field.String("primary_chain_id").
+			Immutable().
+			Comment("Chain the alert type resolved to; its fan_out config drives synthesis"),
+		field.Enum("synthesis_status").
+			Values("pending", "in_progress", "completed", "failed", "skipped").
+			Default("pending").
+			Comment("skipped when no sibling session completed"),
+		field.Text("summary").
+			Optional().
+			Nillable().
+			Comment("Consolidated summary across all sibling sessions"),
+		field.String("summary_error").
+			Optional().
+			Nillable(),
+		field.Time("synthesized_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the SessionGroup.
+func (SessionGroup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("sessions", AlertSession.Type),
+	}
+}
+
+// Indexes of the SessionGroup.
+func (SessionGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
@@ -1,8 +1,6 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -14,6 +12,13 @@ type SessionReviewActivity struct {
 	ent.Schema
 }
 
+// Mixin of the SessionReviewActivity.
+func (SessionReviewActivity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CreateTimeMixin{},
+	}
+}
+
 func (SessionReviewActivity) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").
@@ -55,9 +60,6 @@ func (SessionReviewActivity) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Investigation feedback snapshot"),
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
 	}
 }
 
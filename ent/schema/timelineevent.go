@@ -1,8 +1,6 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -15,6 +13,13 @@ type TimelineEvent struct {
 	ent.Schema
 }
 
+// Mixin of the TimelineEvent.
+func (TimelineEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
 // Fields of the TimelineEvent.
 func (TimelineEvent) Fields() []ent.Field {
 	return []ent.Field{
@@ -44,16 +49,6 @@ func (TimelineEvent) Fields() []ent.Field {
 		field.Int("sequence_number").
 			Comment("Order in timeline"),
 
-		// Timestamps
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable().
-			Comment("Creation timestamp"),
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now).
-			Comment("Last update (for streaming)"),
-
 		// Event Details
 		//
 		// Event types and their semantics:
@@ -69,6 +64,7 @@ func (TimelineEvent) Fields() []ent.Field {
 		//                        LLM requests a tool call (metadata: server_name, tool_name, arguments).
 		//                        Completed with the storage-truncated raw result in content and
 		//                        is_error in metadata after ToolExecutor.Execute() returns.
+		//                        Marked "cancelled" if the session is cancelled mid-call.
 		//   mcp_tool_summary   — MCP tool result summary. Created with status "streaming"
 		//                        when summarization starts. Completed with the LLM-generated summary.
 		//                        Metadata: server_name, tool_name, original_tokens, summarization_model.
@@ -80,6 +76,16 @@ func (TimelineEvent) Fields() []ent.Field {
 		//   memory_injected    — Emitted when pre-loaded memories are injected into an agent's
 		//                        prompt at investigation start. Content lists the injected memories
 		//                        with category, valence, age, and text.
+		//   operator_note      — Note an operator posted to a queued or running session (e.g. "we just
+		//                        rolled back deploy-1234"). Attached to the current stage, no execution.
+		//                        Delivered to running agents at their next iteration and to later stages
+		//                        via the stage context. Metadata: author.
+		//   mcp_server_disabled — An operator disabled an MCP server for the rest of the session (API or
+		//                        /disable-mcp chat command). Running agents drop its tools at their next
+		//                        iteration. Metadata: server_name, reason, author.
+		//   capability_fallback — The agent's requested features exceed the selected model's capabilities
+		//                        and config resolution fell back (another provider, no tools, no stream
+		//                        timeouts). Emitted when the agent starts. Metadata: provider, model.
 		field.Enum("event_type").
 			Values(
 				"llm_thinking",
@@ -97,12 +103,31 @@ func (TimelineEvent) Fields() []ent.Field {
 				"provider_fallback",
 				"skill_loaded",
 				"memory_injected",
+				"operator_note",
+				"mcp_server_disabled",
+				"capability_fallback",
 			),
 		field.Enum("status").
 			Values("streaming", "completed", "failed", "cancelled", "timed_out").
 			Default("streaming"),
+		// Highlight marks events worth seeing in a condensed view of a long
+		// investigation. Set by the framework from the event type (errors,
+		// fallbacks, final analyses) and by agents via mark_key_finding.
+		//   key_finding — Conclusion or finding the agent flagged as important.
+		//   error       — Agent-level failure (LLM error, aborted iteration).
+		//   warning     — Something went wrong but the execution continued
+		//                 (failed tool call, provider fallback, disabled MCP server).
+		field.Enum("highlight").
+			Values("key_finding", "error", "warning").
+			Optional().
+			Nillable().
+			Comment("Highlight marker for condensed timeline views; NULL for ordinary events"),
 		field.Text("content").
 			Comment("Event content (grows during streaming, updateable on completion)"),
+		field.Bytes("content_zstd").
+			Optional().
+			Nillable().
+			Comment("zstd-compressed content for large events; content is empty when set"),
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("Type-specific data (tool_name, server_name, etc.)"),
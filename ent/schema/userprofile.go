@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserPreferences holds per-user dashboard preferences. Stored as JSON in the
+// preferences column; the backend only validates and persists them.
+type UserPreferences struct {
+	Notifications  NotificationPreferences `json:"notifications"`
+	DefaultFilters map[string]string       `json:"default_filters,omitempty"` // session list query params applied on load
+	FavoriteChains []string                `json:"favorite_chains,omitempty"`
+}
+
+// NotificationPreferences selects which session events the dashboard notifies
+// the user about.
+type NotificationPreferences struct {
+	SessionCompleted bool `json:"session_completed"`
+	SessionFailed    bool `json:"session_failed"`
+	ReviewAssigned   bool `json:"review_assigned"`
+	OnlyMine         bool `json:"only_mine"` // restrict to sessions the user submitted or is assigned
+}
+
+// UserProfile holds the schema definition for the UserProfile entity.
+// One row per OIDC subject, created on first access to the profile API.
+type UserProfile struct {
+	ent.Schema
+}
+
+// Mixin of the UserProfile.
+func (UserProfile) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the UserProfile.
+func (UserProfile) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			StorageKey("subject").
+			Unique().
+			Immutable().
+			Comment("OIDC subject (X-Forwarded-User / X-Remote-User)"),
+		field.String("display_name").
+			Optional().
+			Nillable().
+			Comment("Used for author attribution when set"),
+		field.String("email").
+			Optional().
+			Nillable(),
+		field.JSON("preferences", UserPreferences{}),
+		field.Time("last_seen_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the UserProfile.
+func (UserProfile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email"),
+	}
+}
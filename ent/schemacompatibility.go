@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/schemacompatibility"
+)
+
+// SchemaCompatibility is the model entity for the SchemaCompatibility schema.
+type SchemaCompatibility struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Migration version (timestamp prefix) the binary's latest embedded migration must be at least
+	MinVersion int64 `json:"min_version,omitempty"`
+	// Why older binaries are incompatible, shown in the startup error
+	Reason       *string `json:"reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SchemaCompatibility) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schemacompatibility.FieldID, schemacompatibility.FieldMinVersion:
+			values[i] = new(sql.NullInt64)
+		case schemacompatibility.FieldReason:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SchemaCompatibility fields.
+func (_m *SchemaCompatibility) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schemacompatibility.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case schemacompatibility.FieldMinVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_version", values[i])
+			} else if value.Valid {
+				_m.MinVersion = value.Int64
+			}
+		case schemacompatibility.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = new(string)
+				*_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SchemaCompatibility.
+// This includes values selected through modifiers, order, etc.
+func (_m *SchemaCompatibility) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SchemaCompatibility.
+// Note that you need to call SchemaCompatibility.Unwrap() before calling this method if this SchemaCompatibility
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SchemaCompatibility) Update() *SchemaCompatibilityUpdateOne {
+	return NewSchemaCompatibilityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SchemaCompatibility entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SchemaCompatibility) Unwrap() *SchemaCompatibility {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SchemaCompatibility is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SchemaCompatibility) String() string {
+	var builder strings.Builder
+	builder.WriteString("SchemaCompatibility(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("min_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinVersion))
+	builder.WriteString(", ")
+	if v := _m.Reason; v != nil {
+		builder.WriteString("reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SchemaCompatibilities is a parsable slice of SchemaCompatibility.
+type SchemaCompatibilities []*SchemaCompatibility
@@ -0,0 +1,60 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schemacompatibility
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the schemacompatibility type in the database.
+	Label = "schema_compatibility"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMinVersion holds the string denoting the min_version field in the database.
+	FieldMinVersion = "min_version"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// Table holds the table name of the schemacompatibility in the database.
+	Table = "schema_compatibilities"
+)
+
+// Columns holds all SQL columns for schemacompatibility fields.
+var Columns = []string{
+	FieldID,
+	FieldMinVersion,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMinVersion holds the default value on creation for the "min_version" field.
+	DefaultMinVersion int64
+)
+
+// OrderOption defines the ordering options for the SchemaCompatibility queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMinVersion orders the results by the min_version field.
+func ByMinVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinVersion, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package schemacompatibility
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldLTE(FieldID, id))
+}
+
+// MinVersion applies equality check predicate on the "min_version" field. It's identical to MinVersionEQ.
+func MinVersion(v int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldEQ(FieldMinVersion, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldEQ(FieldReason, v))
+}
+
+// MinVersionEQ applies the EQ predicate on the "min_version" field.
+func MinVersionEQ(v int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldEQ(FieldMinVersion, v))
+}
+
+// MinVersionNEQ applies the NEQ predicate on the "min_version" field.
+func MinVersionNEQ(v int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldNEQ(FieldMinVersion, v))
+}
+
+// MinVersionIn applies the In predicate on the "min_version" field.
+func MinVersionIn(vs ...int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldIn(FieldMinVersion, vs...))
+}
+
+// MinVersionNotIn applies the NotIn predicate on the "min_version" field.
+func MinVersionNotIn(vs ...int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldNotIn(FieldMinVersion, vs...))
+}
+
+// MinVersionGT applies the GT predicate on the "min_version" field.
+func MinVersionGT(v int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldGT(FieldMinVersion, v))
+}
+
+// MinVersionGTE applies the GTE predicate on the "min_version" field.
+func MinVersionGTE(v int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldGTE(FieldMinVersion, v))
+}
+
+// MinVersionLT applies the LT predicate on the "min_version" field.
+func MinVersionLT(v int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldLT(FieldMinVersion, v))
+}
+
+// MinVersionLTE applies the LTE predicate on the "min_version" field.
+func MinVersionLTE(v int64) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldLTE(FieldMinVersion, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SchemaCompatibility) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SchemaCompatibility) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SchemaCompatibility) predicate.SchemaCompatibility {
+	return predicate.SchemaCompatibility(sql.NotPredicates(p))
+}
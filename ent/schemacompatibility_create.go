@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/schemacompatibility"
+)
+
+// SchemaCompatibilityCreate is the builder for creating a SchemaCompatibility entity.
+type SchemaCompatibilityCreate struct {
+	config
+	mutation *SchemaCompatibilityMutation
+	hooks    []Hook
+}
+
+// SetMinVersion sets the "min_version" field.
+func (_c *SchemaCompatibilityCreate) SetMinVersion(v int64) *SchemaCompatibilityCreate {
+	_c.mutation.SetMinVersion(v)
+	return _c
+}
+
+// SetNillableMinVersion sets the "min_version" field if the given value is not nil.
+func (_c *SchemaCompatibilityCreate) SetNillableMinVersion(v *int64) *SchemaCompatibilityCreate {
+	if v != nil {
+		_c.SetMinVersion(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *SchemaCompatibilityCreate) SetReason(v string) *SchemaCompatibilityCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *SchemaCompatibilityCreate) SetNillableReason(v *string) *SchemaCompatibilityCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SchemaCompatibilityMutation object of the builder.
+func (_c *SchemaCompatibilityCreate) Mutation() *SchemaCompatibilityMutation {
+	return _c.mutation
+}
+
+// Save creates the SchemaCompatibility in the database.
+func (_c *SchemaCompatibilityCreate) Save(ctx context.Context) (*SchemaCompatibility, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SchemaCompatibilityCreate) SaveX(ctx context.Context) *SchemaCompatibility {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SchemaCompatibilityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SchemaCompatibilityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SchemaCompatibilityCreate) defaults() {
+	if _, ok := _c.mutation.MinVersion(); !ok {
+		v := schemacompatibility.DefaultMinVersion
+		_c.mutation.SetMinVersion(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SchemaCompatibilityCreate) check() error {
+	if _, ok := _c.mutation.MinVersion(); !ok {
+		return &ValidationError{Name: "min_version", err: errors.New(`ent: missing required field "SchemaCompatibility.min_version"`)}
+	}
+	return nil
+}
+
+func (_c *SchemaCompatibilityCreate) sqlSave(ctx context.Context) (*SchemaCompatibility, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SchemaCompatibilityCreate) createSpec() (*SchemaCompatibility, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SchemaCompatibility{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(schemacompatibility.Table, sqlgraph.NewFieldSpec(schemacompatibility.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.MinVersion(); ok {
+		_spec.SetField(schemacompatibility.FieldMinVersion, field.TypeInt64, value)
+		_node.MinVersion = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(schemacompatibility.FieldReason, field.TypeString, value)
+		_node.Reason = &value
+	}
+	return _node, _spec
+}
+
+// SchemaCompatibilityCreateBulk is the builder for creating many SchemaCompatibility entities in bulk.
+type SchemaCompatibilityCreateBulk struct {
+	config
+	err      error
+	builders []*SchemaCompatibilityCreate
+}
+
+// Save creates the SchemaCompatibility entities in the database.
+func (_c *SchemaCompatibilityCreateBulk) Save(ctx context.Context) ([]*SchemaCompatibility, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SchemaCompatibility, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SchemaCompatibilityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SchemaCompatibilityCreateBulk) SaveX(ctx context.Context) []*SchemaCompatibility {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SchemaCompatibilityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SchemaCompatibilityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/schemacompatibility"
+)
+
+// SchemaCompatibilityDelete is the builder for deleting a SchemaCompatibility entity.
+type SchemaCompatibilityDelete struct {
+	config
+	hooks    []Hook
+	mutation *SchemaCompatibilityMutation
+}
+
+// Where appends a list predicates to the SchemaCompatibilityDelete builder.
+func (_d *SchemaCompatibilityDelete) Where(ps ...predicate.SchemaCompatibility) *SchemaCompatibilityDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SchemaCompatibilityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SchemaCompatibilityDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SchemaCompatibilityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(schemacompatibility.Table, sqlgraph.NewFieldSpec(schemacompatibility.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SchemaCompatibilityDeleteOne is the builder for deleting a single SchemaCompatibility entity.
+type SchemaCompatibilityDeleteOne struct {
+	_d *SchemaCompatibilityDelete
+}
+
+// Where appends a list predicates to the SchemaCompatibilityDelete builder.
+func (_d *SchemaCompatibilityDeleteOne) Where(ps ...predicate.SchemaCompatibility) *SchemaCompatibilityDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SchemaCompatibilityDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{schemacompatibility.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SchemaCompatibilityDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
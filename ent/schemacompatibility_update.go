@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/schemacompatibility"
+)
+
+// SchemaCompatibilityUpdate is the builder for updating SchemaCompatibility entities.
+type SchemaCompatibilityUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SchemaCompatibilityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SchemaCompatibilityUpdate builder.
+func (_u *SchemaCompatibilityUpdate) Where(ps ...predicate.SchemaCompatibility) *SchemaCompatibilityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMinVersion sets the "min_version" field.
+func (_u *SchemaCompatibilityUpdate) SetMinVersion(v int64) *SchemaCompatibilityUpdate {
+	_u.mutation.ResetMinVersion()
+	_u.mutation.SetMinVersion(v)
+	return _u
+}
+
+// SetNillableMinVersion sets the "min_version" field if the given value is not nil.
+func (_u *SchemaCompatibilityUpdate) SetNillableMinVersion(v *int64) *SchemaCompatibilityUpdate {
+	if v != nil {
+		_u.SetMinVersion(*v)
+	}
+	return _u
+}
+
+// AddMinVersion adds value to the "min_version" field.
+func (_u *SchemaCompatibilityUpdate) AddMinVersion(v int64) *SchemaCompatibilityUpdate {
+	_u.mutation.AddMinVersion(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *SchemaCompatibilityUpdate) SetReason(v string) *SchemaCompatibilityUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *SchemaCompatibilityUpdate) SetNillableReason(v *string) *SchemaCompatibilityUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *SchemaCompatibilityUpdate) ClearReason() *SchemaCompatibilityUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the SchemaCompatibilityMutation object of the builder.
+func (_u *SchemaCompatibilityUpdate) Mutation() *SchemaCompatibilityMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SchemaCompatibilityUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SchemaCompatibilityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SchemaCompatibilityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SchemaCompatibilityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SchemaCompatibilityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SchemaCompatibilityUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SchemaCompatibilityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(schemacompatibility.Table, schemacompatibility.Columns, sqlgraph.NewFieldSpec(schemacompatibility.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MinVersion(); ok {
+		_spec.SetField(schemacompatibility.FieldMinVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinVersion(); ok {
+		_spec.AddField(schemacompatibility.FieldMinVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(schemacompatibility.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(schemacompatibility.FieldReason, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schemacompatibility.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SchemaCompatibilityUpdateOne is the builder for updating a single SchemaCompatibility entity.
+type SchemaCompatibilityUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SchemaCompatibilityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMinVersion sets the "min_version" field.
+func (_u *SchemaCompatibilityUpdateOne) SetMinVersion(v int64) *SchemaCompatibilityUpdateOne {
+	_u.mutation.ResetMinVersion()
+	_u.mutation.SetMinVersion(v)
+	return _u
+}
+
+// SetNillableMinVersion sets the "min_version" field if the given value is not nil.
+func (_u *SchemaCompatibilityUpdateOne) SetNillableMinVersion(v *int64) *SchemaCompatibilityUpdateOne {
+	if v != nil {
+		_u.SetMinVersion(*v)
+	}
+	return _u
+}
+
+// AddMinVersion adds value to the "min_version" field.
+func (_u *SchemaCompatibilityUpdateOne) AddMinVersion(v int64) *SchemaCompatibilityUpdateOne {
+	_u.mutation.AddMinVersion(v)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *SchemaCompatibilityUpdateOne) SetReason(v string) *SchemaCompatibilityUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *SchemaCompatibilityUpdateOne) SetNillableReason(v *string) *SchemaCompatibilityUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *SchemaCompatibilityUpdateOne) ClearReason() *SchemaCompatibilityUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the SchemaCompatibilityMutation object of the builder.
+func (_u *SchemaCompatibilityUpdateOne) Mutation() *SchemaCompatibilityMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SchemaCompatibilityUpdate builder.
+func (_u *SchemaCompatibilityUpdateOne) Where(ps ...predicate.SchemaCompatibility) *SchemaCompatibilityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SchemaCompatibilityUpdateOne) Select(field string, fields ...string) *SchemaCompatibilityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SchemaCompatibility entity.
+func (_u *SchemaCompatibilityUpdateOne) Save(ctx context.Context) (*SchemaCompatibility, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SchemaCompatibilityUpdateOne) SaveX(ctx context.Context) *SchemaCompatibility {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SchemaCompatibilityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SchemaCompatibilityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SchemaCompatibilityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SchemaCompatibilityUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SchemaCompatibilityUpdateOne) sqlSave(ctx context.Context) (_node *SchemaCompatibility, err error) {
+	_spec := sqlgraph.NewUpdateSpec(schemacompatibility.Table, schemacompatibility.Columns, sqlgraph.NewFieldSpec(schemacompatibility.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SchemaCompatibility.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schemacompatibility.FieldID)
+		for _, f := range fields {
+			if !schemacompatibility.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schemacompatibility.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MinVersion(); ok {
+		_spec.SetField(schemacompatibility.FieldMinVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinVersion(); ok {
+		_spec.AddField(schemacompatibility.FieldMinVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(schemacompatibility.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(schemacompatibility.FieldReason, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SchemaCompatibility{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schemacompatibility.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
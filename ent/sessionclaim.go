@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+)
+
+// SessionClaim is the model entity for the SessionClaim schema.
+type SessionClaim struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// SessionID holds the value of the "session_id" field.
+	SessionID string `json:"session_id,omitempty"`
+	// Pod that claimed the session
+	PodID string `json:"pod_id,omitempty"`
+	// Worker within the pod that claimed the session
+	WorkerID string `json:"worker_id,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt time.Time `json:"claimed_at,omitempty"`
+	// Time the session spent pending before this claim
+	QueueWaitMs int64 `json:"queue_wait_ms,omitempty"`
+	// When the claim ended (nil while the worker still holds it)
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// How the claim ended: terminal session status, or orphan recovery
+	Outcome *sessionclaim.Outcome `json:"outcome,omitempty"`
+	// Last heartbeat seen for the session when the claim ended
+	LastHeartbeatAt *time.Time `json:"last_heartbeat_at,omitempty"`
+	// Recovery detail for orphaned claims
+	Detail *string `json:"detail,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SessionClaimQuery when eager-loading is set.
+	Edges        SessionClaimEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SessionClaimEdges holds the relations/edges for other nodes in the graph.
+type SessionClaimEdges struct {
+	// Session holds the value of the session edge.
+	Session *AlertSession `json:"session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SessionClaimEdges) SessionOrErr() (*AlertSession, error) {
+	if e.Session != nil {
+		return e.Session, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: alertsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SessionClaim) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sessionclaim.FieldQueueWaitMs:
+			values[i] = new(sql.NullInt64)
+		case sessionclaim.FieldID, sessionclaim.FieldSessionID, sessionclaim.FieldPodID, sessionclaim.FieldWorkerID, sessionclaim.FieldOutcome, sessionclaim.FieldDetail:
+			values[i] = new(sql.NullString)
+		case sessionclaim.FieldClaimedAt, sessionclaim.FieldReleasedAt, sessionclaim.FieldLastHeartbeatAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SessionClaim fields.
+func (_m *SessionClaim) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sessionclaim.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case sessionclaim.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				_m.SessionID = value.String
+			}
+		case sessionclaim.FieldPodID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pod_id", values[i])
+			} else if value.Valid {
+				_m.PodID = value.String
+			}
+		case sessionclaim.FieldWorkerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field worker_id", values[i])
+			} else if value.Valid {
+				_m.WorkerID = value.String
+			}
+		case sessionclaim.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				_m.ClaimedAt = value.Time
+			}
+		case sessionclaim.FieldQueueWaitMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field queue_wait_ms", values[i])
+			} else if value.Valid {
+				_m.QueueWaitMs = value.Int64
+			}
+		case sessionclaim.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		case sessionclaim.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				_m.Outcome = new(sessionclaim.Outcome)
+				*_m.Outcome = sessionclaim.Outcome(value.String)
+			}
+		case sessionclaim.FieldLastHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_heartbeat_at", values[i])
+			} else if value.Valid {
+				_m.LastHeartbeatAt = new(time.Time)
+				*_m.LastHeartbeatAt = value.Time
+			}
+		case sessionclaim.FieldDetail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detail", values[i])
+			} else if value.Valid {
+				_m.Detail = new(string)
+				*_m.Detail = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SessionClaim.
+// This includes values selected through modifiers, order, etc.
+func (_m *SessionClaim) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySession queries the "session" edge of the SessionClaim entity.
+func (_m *SessionClaim) QuerySession() *AlertSessionQuery {
+	return NewSessionClaimClient(_m.config).QuerySession(_m)
+}
+
+// Update returns a builder for updating this SessionClaim.
+// Note that you need to call SessionClaim.Unwrap() before calling this method if this SessionClaim
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SessionClaim) Update() *SessionClaimUpdateOne {
+	return NewSessionClaimClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SessionClaim entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SessionClaim) Unwrap() *SessionClaim {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SessionClaim is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SessionClaim) String() string {
+	var builder strings.Builder
+	builder.WriteString("SessionClaim(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("session_id=")
+	builder.WriteString(_m.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("pod_id=")
+	builder.WriteString(_m.PodID)
+	builder.WriteString(", ")
+	builder.WriteString("worker_id=")
+	builder.WriteString(_m.WorkerID)
+	builder.WriteString(", ")
+	builder.WriteString("claimed_at=")
+	builder.WriteString(_m.ClaimedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("queue_wait_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QueueWaitMs))
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Outcome; v != nil {
+		builder.WriteString("outcome=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastHeartbeatAt; v != nil {
+		builder.WriteString("last_heartbeat_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Detail; v != nil {
+		builder.WriteString("detail=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SessionClaims is a parsable slice of SessionClaim.
+type SessionClaims []*SessionClaim
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessionclaim
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sessionclaim type in the database.
+	Label = "session_claim"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "claim_id"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldPodID holds the string denoting the pod_id field in the database.
+	FieldPodID = "pod_id"
+	// FieldWorkerID holds the string denoting the worker_id field in the database.
+	FieldWorkerID = "worker_id"
+	// FieldClaimedAt holds the string denoting the claimed_at field in the database.
+	FieldClaimedAt = "claimed_at"
+	// FieldQueueWaitMs holds the string denoting the queue_wait_ms field in the database.
+	FieldQueueWaitMs = "queue_wait_ms"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldOutcome holds the string denoting the outcome field in the database.
+	FieldOutcome = "outcome"
+	// FieldLastHeartbeatAt holds the string denoting the last_heartbeat_at field in the database.
+	FieldLastHeartbeatAt = "last_heartbeat_at"
+	// FieldDetail holds the string denoting the detail field in the database.
+	FieldDetail = "detail"
+	// EdgeSession holds the string denoting the session edge name in mutations.
+	EdgeSession = "session"
+	// AlertSessionFieldID holds the string denoting the ID field of the AlertSession.
+	AlertSessionFieldID = "session_id"
+	// Table holds the table name of the sessionclaim in the database.
+	Table = "session_claims"
+	// SessionTable is the table that holds the session relation/edge.
+	SessionTable = "session_claims"
+	// SessionInverseTable is the table name for the AlertSession entity.
+	// It exists in this package in order to avoid circular dependency with the "alertsession" package.
+	SessionInverseTable = "alert_sessions"
+	// SessionColumn is the table column denoting the session relation/edge.
+	SessionColumn = "session_id"
+)
+
+// Columns holds all SQL columns for sessionclaim fields.
+var Columns = []string{
+	FieldID,
+	FieldSessionID,
+	FieldPodID,
+	FieldWorkerID,
+	FieldClaimedAt,
+	FieldQueueWaitMs,
+	FieldReleasedAt,
+	FieldOutcome,
+	FieldLastHeartbeatAt,
+	FieldDetail,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Outcome defines the type for the "outcome" enum field.
+type Outcome string
+
+// Outcome values.
+const (
+	OutcomeCompleted    Outcome = "completed"
+	OutcomeFailed       Outcome = "failed"
+	OutcomeTimedOut     Outcome = "timed_out"
+	OutcomeCancelled    Outcome = "cancelled"
+	OutcomeOrphaned     Outcome = "orphaned"
+	OutcomePodRestarted Outcome = "pod_restarted"
+)
+
+func (o Outcome) String() string {
+	return string(o)
+}
+
+// OutcomeValidator is a validator for the "outcome" field enum values. It is called by the builders before save.
+func OutcomeValidator(o Outcome) error {
+	switch o {
+	case OutcomeCompleted, OutcomeFailed, OutcomeTimedOut, OutcomeCancelled, OutcomeOrphaned, OutcomePodRestarted:
+		return nil
+	default:
+		return fmt.Errorf("sessionclaim: invalid enum value for outcome field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the SessionClaim queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByPodID orders the results by the pod_id field.
+func ByPodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPodID, opts...).ToFunc()
+}
+
+// ByWorkerID orders the results by the worker_id field.
+func ByWorkerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkerID, opts...).ToFunc()
+}
+
+// ByClaimedAt orders the results by the claimed_at field.
+func ByClaimedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClaimedAt, opts...).ToFunc()
+}
+
+// ByQueueWaitMs orders the results by the queue_wait_ms field.
+func ByQueueWaitMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQueueWaitMs, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByOutcome orders the results by the outcome field.
+func ByOutcome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcome, opts...).ToFunc()
+}
+
+// ByLastHeartbeatAt orders the results by the last_heartbeat_at field.
+func ByLastHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHeartbeatAt, opts...).ToFunc()
+}
+
+// ByDetail orders the results by the detail field.
+func ByDetail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetail, opts...).ToFunc()
+}
+
+// BySessionField orders the results by session field.
+func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionInverseTable, AlertSessionFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+	)
+}
@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessionclaim
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContainsFold(FieldID, id))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldSessionID, v))
+}
+
+// PodID applies equality check predicate on the "pod_id" field. It's identical to PodIDEQ.
+func PodID(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldPodID, v))
+}
+
+// WorkerID applies equality check predicate on the "worker_id" field. It's identical to WorkerIDEQ.
+func WorkerID(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldWorkerID, v))
+}
+
+// ClaimedAt applies equality check predicate on the "claimed_at" field. It's identical to ClaimedAtEQ.
+func ClaimedAt(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// QueueWaitMs applies equality check predicate on the "queue_wait_ms" field. It's identical to QueueWaitMsEQ.
+func QueueWaitMs(v int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldQueueWaitMs, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// LastHeartbeatAt applies equality check predicate on the "last_heartbeat_at" field. It's identical to LastHeartbeatAtEQ.
+func LastHeartbeatAt(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldLastHeartbeatAt, v))
+}
+
+// Detail applies equality check predicate on the "detail" field. It's identical to DetailEQ.
+func Detail(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldDetail, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// PodIDEQ applies the EQ predicate on the "pod_id" field.
+func PodIDEQ(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldPodID, v))
+}
+
+// PodIDNEQ applies the NEQ predicate on the "pod_id" field.
+func PodIDNEQ(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldPodID, v))
+}
+
+// PodIDIn applies the In predicate on the "pod_id" field.
+func PodIDIn(vs ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldPodID, vs...))
+}
+
+// PodIDNotIn applies the NotIn predicate on the "pod_id" field.
+func PodIDNotIn(vs ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldPodID, vs...))
+}
+
+// PodIDGT applies the GT predicate on the "pod_id" field.
+func PodIDGT(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldPodID, v))
+}
+
+// PodIDGTE applies the GTE predicate on the "pod_id" field.
+func PodIDGTE(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldPodID, v))
+}
+
+// PodIDLT applies the LT predicate on the "pod_id" field.
+func PodIDLT(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldPodID, v))
+}
+
+// PodIDLTE applies the LTE predicate on the "pod_id" field.
+func PodIDLTE(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldPodID, v))
+}
+
+// PodIDContains applies the Contains predicate on the "pod_id" field.
+func PodIDContains(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContains(FieldPodID, v))
+}
+
+// PodIDHasPrefix applies the HasPrefix predicate on the "pod_id" field.
+func PodIDHasPrefix(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldHasPrefix(FieldPodID, v))
+}
+
+// PodIDHasSuffix applies the HasSuffix predicate on the "pod_id" field.
+func PodIDHasSuffix(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldHasSuffix(FieldPodID, v))
+}
+
+// PodIDEqualFold applies the EqualFold predicate on the "pod_id" field.
+func PodIDEqualFold(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEqualFold(FieldPodID, v))
+}
+
+// PodIDContainsFold applies the ContainsFold predicate on the "pod_id" field.
+func PodIDContainsFold(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContainsFold(FieldPodID, v))
+}
+
+// WorkerIDEQ applies the EQ predicate on the "worker_id" field.
+func WorkerIDEQ(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldWorkerID, v))
+}
+
+// WorkerIDNEQ applies the NEQ predicate on the "worker_id" field.
+func WorkerIDNEQ(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldWorkerID, v))
+}
+
+// WorkerIDIn applies the In predicate on the "worker_id" field.
+func WorkerIDIn(vs ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldWorkerID, vs...))
+}
+
+// WorkerIDNotIn applies the NotIn predicate on the "worker_id" field.
+func WorkerIDNotIn(vs ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldWorkerID, vs...))
+}
+
+// WorkerIDGT applies the GT predicate on the "worker_id" field.
+func WorkerIDGT(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldWorkerID, v))
+}
+
+// WorkerIDGTE applies the GTE predicate on the "worker_id" field.
+func WorkerIDGTE(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldWorkerID, v))
+}
+
+// WorkerIDLT applies the LT predicate on the "worker_id" field.
+func WorkerIDLT(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldWorkerID, v))
+}
+
+// WorkerIDLTE applies the LTE predicate on the "worker_id" field.
+func WorkerIDLTE(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldWorkerID, v))
+}
+
+// WorkerIDContains applies the Contains predicate on the "worker_id" field.
+func WorkerIDContains(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContains(FieldWorkerID, v))
+}
+
+// WorkerIDHasPrefix applies the HasPrefix predicate on the "worker_id" field.
+func WorkerIDHasPrefix(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldHasPrefix(FieldWorkerID, v))
+}
+
+// WorkerIDHasSuffix applies the HasSuffix predicate on the "worker_id" field.
+func WorkerIDHasSuffix(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldHasSuffix(FieldWorkerID, v))
+}
+
+// WorkerIDEqualFold applies the EqualFold predicate on the "worker_id" field.
+func WorkerIDEqualFold(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEqualFold(FieldWorkerID, v))
+}
+
+// WorkerIDContainsFold applies the ContainsFold predicate on the "worker_id" field.
+func WorkerIDContainsFold(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContainsFold(FieldWorkerID, v))
+}
+
+// ClaimedAtEQ applies the EQ predicate on the "claimed_at" field.
+func ClaimedAtEQ(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtNEQ applies the NEQ predicate on the "claimed_at" field.
+func ClaimedAtNEQ(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtIn applies the In predicate on the "claimed_at" field.
+func ClaimedAtIn(vs ...time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtNotIn applies the NotIn predicate on the "claimed_at" field.
+func ClaimedAtNotIn(vs ...time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtGT applies the GT predicate on the "claimed_at" field.
+func ClaimedAtGT(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldClaimedAt, v))
+}
+
+// ClaimedAtGTE applies the GTE predicate on the "claimed_at" field.
+func ClaimedAtGTE(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtLT applies the LT predicate on the "claimed_at" field.
+func ClaimedAtLT(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldClaimedAt, v))
+}
+
+// ClaimedAtLTE applies the LTE predicate on the "claimed_at" field.
+func ClaimedAtLTE(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldClaimedAt, v))
+}
+
+// QueueWaitMsEQ applies the EQ predicate on the "queue_wait_ms" field.
+func QueueWaitMsEQ(v int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldQueueWaitMs, v))
+}
+
+// QueueWaitMsNEQ applies the NEQ predicate on the "queue_wait_ms" field.
+func QueueWaitMsNEQ(v int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldQueueWaitMs, v))
+}
+
+// QueueWaitMsIn applies the In predicate on the "queue_wait_ms" field.
+func QueueWaitMsIn(vs ...int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldQueueWaitMs, vs...))
+}
+
+// QueueWaitMsNotIn applies the NotIn predicate on the "queue_wait_ms" field.
+func QueueWaitMsNotIn(vs ...int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldQueueWaitMs, vs...))
+}
+
+// QueueWaitMsGT applies the GT predicate on the "queue_wait_ms" field.
+func QueueWaitMsGT(v int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldQueueWaitMs, v))
+}
+
+// QueueWaitMsGTE applies the GTE predicate on the "queue_wait_ms" field.
+func QueueWaitMsGTE(v int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldQueueWaitMs, v))
+}
+
+// QueueWaitMsLT applies the LT predicate on the "queue_wait_ms" field.
+func QueueWaitMsLT(v int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldQueueWaitMs, v))
+}
+
+// QueueWaitMsLTE applies the LTE predicate on the "queue_wait_ms" field.
+func QueueWaitMsLTE(v int64) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldQueueWaitMs, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// OutcomeEQ applies the EQ predicate on the "outcome" field.
+func OutcomeEQ(v Outcome) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldOutcome, v))
+}
+
+// OutcomeNEQ applies the NEQ predicate on the "outcome" field.
+func OutcomeNEQ(v Outcome) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldOutcome, v))
+}
+
+// OutcomeIn applies the In predicate on the "outcome" field.
+func OutcomeIn(vs ...Outcome) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldOutcome, vs...))
+}
+
+// OutcomeNotIn applies the NotIn predicate on the "outcome" field.
+func OutcomeNotIn(vs ...Outcome) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldOutcome, vs...))
+}
+
+// OutcomeIsNil applies the IsNil predicate on the "outcome" field.
+func OutcomeIsNil() predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIsNull(FieldOutcome))
+}
+
+// OutcomeNotNil applies the NotNil predicate on the "outcome" field.
+func OutcomeNotNil() predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotNull(FieldOutcome))
+}
+
+// LastHeartbeatAtEQ applies the EQ predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtEQ(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtNEQ applies the NEQ predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNEQ(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtIn applies the In predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtIn(vs ...time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldLastHeartbeatAt, vs...))
+}
+
+// LastHeartbeatAtNotIn applies the NotIn predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNotIn(vs ...time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldLastHeartbeatAt, vs...))
+}
+
+// LastHeartbeatAtGT applies the GT predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtGT(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtGTE applies the GTE predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtGTE(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtLT applies the LT predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtLT(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtLTE applies the LTE predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtLTE(v time.Time) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtIsNil applies the IsNil predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtIsNil() predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIsNull(FieldLastHeartbeatAt))
+}
+
+// LastHeartbeatAtNotNil applies the NotNil predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNotNil() predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotNull(FieldLastHeartbeatAt))
+}
+
+// DetailEQ applies the EQ predicate on the "detail" field.
+func DetailEQ(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEQ(FieldDetail, v))
+}
+
+// DetailNEQ applies the NEQ predicate on the "detail" field.
+func DetailNEQ(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNEQ(FieldDetail, v))
+}
+
+// DetailIn applies the In predicate on the "detail" field.
+func DetailIn(vs ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIn(FieldDetail, vs...))
+}
+
+// DetailNotIn applies the NotIn predicate on the "detail" field.
+func DetailNotIn(vs ...string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotIn(FieldDetail, vs...))
+}
+
+// DetailGT applies the GT predicate on the "detail" field.
+func DetailGT(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGT(FieldDetail, v))
+}
+
+// DetailGTE applies the GTE predicate on the "detail" field.
+func DetailGTE(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldGTE(FieldDetail, v))
+}
+
+// DetailLT applies the LT predicate on the "detail" field.
+func DetailLT(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLT(FieldDetail, v))
+}
+
+// DetailLTE applies the LTE predicate on the "detail" field.
+func DetailLTE(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldLTE(FieldDetail, v))
+}
+
+// DetailContains applies the Contains predicate on the "detail" field.
+func DetailContains(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContains(FieldDetail, v))
+}
+
+// DetailHasPrefix applies the HasPrefix predicate on the "detail" field.
+func DetailHasPrefix(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldHasPrefix(FieldDetail, v))
+}
+
+// DetailHasSuffix applies the HasSuffix predicate on the "detail" field.
+func DetailHasSuffix(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldHasSuffix(FieldDetail, v))
+}
+
+// DetailIsNil applies the IsNil predicate on the "detail" field.
+func DetailIsNil() predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldIsNull(FieldDetail))
+}
+
+// DetailNotNil applies the NotNil predicate on the "detail" field.
+func DetailNotNil() predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldNotNull(FieldDetail))
+}
+
+// DetailEqualFold applies the EqualFold predicate on the "detail" field.
+func DetailEqualFold(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldEqualFold(FieldDetail, v))
+}
+
+// DetailContainsFold applies the ContainsFold predicate on the "detail" field.
+func DetailContainsFold(v string) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.FieldContainsFold(FieldDetail, v))
+}
+
+// HasSession applies the HasEdge predicate on the "session" edge.
+func HasSession() predicate.SessionClaim {
+	return predicate.SessionClaim(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SessionTable, SessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionWith applies the HasEdge predicate on the "session" edge with a given conditions (other predicates).
+func HasSessionWith(preds ...predicate.AlertSession) predicate.SessionClaim {
+	return predicate.SessionClaim(func(s *sql.Selector) {
+		step := newSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SessionClaim) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SessionClaim) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SessionClaim) predicate.SessionClaim {
+	return predicate.SessionClaim(sql.NotPredicates(p))
+}
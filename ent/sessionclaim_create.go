@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+)
+
+// SessionClaimCreate is the builder for creating a SessionClaim entity.
+type SessionClaimCreate struct {
+	config
+	mutation *SessionClaimMutation
+	hooks    []Hook
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *SessionClaimCreate) SetSessionID(v string) *SessionClaimCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetPodID sets the "pod_id" field.
+func (_c *SessionClaimCreate) SetPodID(v string) *SessionClaimCreate {
+	_c.mutation.SetPodID(v)
+	return _c
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (_c *SessionClaimCreate) SetWorkerID(v string) *SessionClaimCreate {
+	_c.mutation.SetWorkerID(v)
+	return _c
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_c *SessionClaimCreate) SetClaimedAt(v time.Time) *SessionClaimCreate {
+	_c.mutation.SetClaimedAt(v)
+	return _c
+}
+
+// SetQueueWaitMs sets the "queue_wait_ms" field.
+func (_c *SessionClaimCreate) SetQueueWaitMs(v int64) *SessionClaimCreate {
+	_c.mutation.SetQueueWaitMs(v)
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *SessionClaimCreate) SetReleasedAt(v time.Time) *SessionClaimCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *SessionClaimCreate) SetNillableReleasedAt(v *time.Time) *SessionClaimCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetOutcome sets the "outcome" field.
+func (_c *SessionClaimCreate) SetOutcome(v sessionclaim.Outcome) *SessionClaimCreate {
+	_c.mutation.SetOutcome(v)
+	return _c
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_c *SessionClaimCreate) SetNillableOutcome(v *sessionclaim.Outcome) *SessionClaimCreate {
+	if v != nil {
+		_c.SetOutcome(*v)
+	}
+	return _c
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_c *SessionClaimCreate) SetLastHeartbeatAt(v time.Time) *SessionClaimCreate {
+	_c.mutation.SetLastHeartbeatAt(v)
+	return _c
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_c *SessionClaimCreate) SetNillableLastHeartbeatAt(v *time.Time) *SessionClaimCreate {
+	if v != nil {
+		_c.SetLastHeartbeatAt(*v)
+	}
+	return _c
+}
+
+// SetDetail sets the "detail" field.
+func (_c *SessionClaimCreate) SetDetail(v string) *SessionClaimCreate {
+	_c.mutation.SetDetail(v)
+	return _c
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_c *SessionClaimCreate) SetNillableDetail(v *string) *SessionClaimCreate {
+	if v != nil {
+		_c.SetDetail(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SessionClaimCreate) SetID(v string) *SessionClaimCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSession sets the "session" edge to the AlertSession entity.
+func (_c *SessionClaimCreate) SetSession(v *AlertSession) *SessionClaimCreate {
+	return _c.SetSessionID(v.ID)
+}
+
+// Mutation returns the SessionClaimMutation object of the builder.
+func (_c *SessionClaimCreate) Mutation() *SessionClaimMutation {
+	return _c.mutation
+}
+
+// Save creates the SessionClaim in the database.
+func (_c *SessionClaimCreate) Save(ctx context.Context) (*SessionClaim, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SessionClaimCreate) SaveX(ctx context.Context) *SessionClaim {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SessionClaimCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SessionClaimCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SessionClaimCreate) check() error {
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "SessionClaim.session_id"`)}
+	}
+	if _, ok := _c.mutation.PodID(); !ok {
+		return &ValidationError{Name: "pod_id", err: errors.New(`ent: missing required field "SessionClaim.pod_id"`)}
+	}
+	if _, ok := _c.mutation.WorkerID(); !ok {
+		return &ValidationError{Name: "worker_id", err: errors.New(`ent: missing required field "SessionClaim.worker_id"`)}
+	}
+	if _, ok := _c.mutation.ClaimedAt(); !ok {
+		return &ValidationError{Name: "claimed_at", err: errors.New(`ent: missing required field "SessionClaim.claimed_at"`)}
+	}
+	if _, ok := _c.mutation.QueueWaitMs(); !ok {
+		return &ValidationError{Name: "queue_wait_ms", err: errors.New(`ent: missing required field "SessionClaim.queue_wait_ms"`)}
+	}
+	if v, ok := _c.mutation.Outcome(); ok {
+		if err := sessionclaim.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "SessionClaim.outcome": %w`, err)}
+		}
+	}
+	if len(_c.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "SessionClaim.session"`)}
+	}
+	return nil
+}
+
+func (_c *SessionClaimCreate) sqlSave(ctx context.Context) (*SessionClaim, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SessionClaim.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SessionClaimCreate) createSpec() (*SessionClaim, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SessionClaim{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sessionclaim.Table, sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.PodID(); ok {
+		_spec.SetField(sessionclaim.FieldPodID, field.TypeString, value)
+		_node.PodID = value
+	}
+	if value, ok := _c.mutation.WorkerID(); ok {
+		_spec.SetField(sessionclaim.FieldWorkerID, field.TypeString, value)
+		_node.WorkerID = value
+	}
+	if value, ok := _c.mutation.ClaimedAt(); ok {
+		_spec.SetField(sessionclaim.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = value
+	}
+	if value, ok := _c.mutation.QueueWaitMs(); ok {
+		_spec.SetField(sessionclaim.FieldQueueWaitMs, field.TypeInt64, value)
+		_node.QueueWaitMs = value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(sessionclaim.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := _c.mutation.Outcome(); ok {
+		_spec.SetField(sessionclaim.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = &value
+	}
+	if value, ok := _c.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(sessionclaim.FieldLastHeartbeatAt, field.TypeTime, value)
+		_node.LastHeartbeatAt = &value
+	}
+	if value, ok := _c.mutation.Detail(); ok {
+		_spec.SetField(sessionclaim.FieldDetail, field.TypeString, value)
+		_node.Detail = &value
+	}
+	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sessionclaim.SessionTable,
+			Columns: []string{sessionclaim.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SessionClaimCreateBulk is the builder for creating many SessionClaim entities in bulk.
+type SessionClaimCreateBulk struct {
+	config
+	err      error
+	builders []*SessionClaimCreate
+}
+
+// Save creates the SessionClaim entities in the database.
+func (_c *SessionClaimCreateBulk) Save(ctx context.Context) ([]*SessionClaim, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SessionClaim, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SessionClaimMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SessionClaimCreateBulk) SaveX(ctx context.Context) []*SessionClaim {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SessionClaimCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SessionClaimCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+)
+
+// SessionClaimUpdate is the builder for updating SessionClaim entities.
+type SessionClaimUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SessionClaimMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SessionClaimUpdate builder.
+func (_u *SessionClaimUpdate) Where(ps ...predicate.SessionClaim) *SessionClaimUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *SessionClaimUpdate) SetReleasedAt(v time.Time) *SessionClaimUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *SessionClaimUpdate) SetNillableReleasedAt(v *time.Time) *SessionClaimUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *SessionClaimUpdate) ClearReleasedAt() *SessionClaimUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *SessionClaimUpdate) SetOutcome(v sessionclaim.Outcome) *SessionClaimUpdate {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *SessionClaimUpdate) SetNillableOutcome(v *sessionclaim.Outcome) *SessionClaimUpdate {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// ClearOutcome clears the value of the "outcome" field.
+func (_u *SessionClaimUpdate) ClearOutcome() *SessionClaimUpdate {
+	_u.mutation.ClearOutcome()
+	return _u
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_u *SessionClaimUpdate) SetLastHeartbeatAt(v time.Time) *SessionClaimUpdate {
+	_u.mutation.SetLastHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_u *SessionClaimUpdate) SetNillableLastHeartbeatAt(v *time.Time) *SessionClaimUpdate {
+	if v != nil {
+		_u.SetLastHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHeartbeatAt clears the value of the "last_heartbeat_at" field.
+func (_u *SessionClaimUpdate) ClearLastHeartbeatAt() *SessionClaimUpdate {
+	_u.mutation.ClearLastHeartbeatAt()
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *SessionClaimUpdate) SetDetail(v string) *SessionClaimUpdate {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_u *SessionClaimUpdate) SetNillableDetail(v *string) *SessionClaimUpdate {
+	if v != nil {
+		_u.SetDetail(*v)
+	}
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *SessionClaimUpdate) ClearDetail() *SessionClaimUpdate {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// Mutation returns the SessionClaimMutation object of the builder.
+func (_u *SessionClaimUpdate) Mutation() *SessionClaimMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SessionClaimUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionClaimUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SessionClaimUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionClaimUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionClaimUpdate) check() error {
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := sessionclaim.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "SessionClaim.outcome": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SessionClaim.session"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SessionClaimUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SessionClaimUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SessionClaimUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sessionclaim.Table, sessionclaim.Columns, sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(sessionclaim.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(sessionclaim.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(sessionclaim.FieldOutcome, field.TypeEnum, value)
+	}
+	if _u.mutation.OutcomeCleared() {
+		_spec.ClearField(sessionclaim.FieldOutcome, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(sessionclaim.FieldLastHeartbeatAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHeartbeatAtCleared() {
+		_spec.ClearField(sessionclaim.FieldLastHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(sessionclaim.FieldDetail, field.TypeString, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(sessionclaim.FieldDetail, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessionclaim.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SessionClaimUpdateOne is the builder for updating a single SessionClaim entity.
+type SessionClaimUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SessionClaimMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *SessionClaimUpdateOne) SetReleasedAt(v time.Time) *SessionClaimUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *SessionClaimUpdateOne) SetNillableReleasedAt(v *time.Time) *SessionClaimUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *SessionClaimUpdateOne) ClearReleasedAt() *SessionClaimUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *SessionClaimUpdateOne) SetOutcome(v sessionclaim.Outcome) *SessionClaimUpdateOne {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *SessionClaimUpdateOne) SetNillableOutcome(v *sessionclaim.Outcome) *SessionClaimUpdateOne {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// ClearOutcome clears the value of the "outcome" field.
+func (_u *SessionClaimUpdateOne) ClearOutcome() *SessionClaimUpdateOne {
+	_u.mutation.ClearOutcome()
+	return _u
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_u *SessionClaimUpdateOne) SetLastHeartbeatAt(v time.Time) *SessionClaimUpdateOne {
+	_u.mutation.SetLastHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_u *SessionClaimUpdateOne) SetNillableLastHeartbeatAt(v *time.Time) *SessionClaimUpdateOne {
+	if v != nil {
+		_u.SetLastHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHeartbeatAt clears the value of the "last_heartbeat_at" field.
+func (_u *SessionClaimUpdateOne) ClearLastHeartbeatAt() *SessionClaimUpdateOne {
+	_u.mutation.ClearLastHeartbeatAt()
+	return _u
+}
+
+// SetDetail sets the "detail" field.
+func (_u *SessionClaimUpdateOne) SetDetail(v string) *SessionClaimUpdateOne {
+	_u.mutation.SetDetail(v)
+	return _u
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (_u *SessionClaimUpdateOne) SetNillableDetail(v *string) *SessionClaimUpdateOne {
+	if v != nil {
+		_u.SetDetail(*v)
+	}
+	return _u
+}
+
+// ClearDetail clears the value of the "detail" field.
+func (_u *SessionClaimUpdateOne) ClearDetail() *SessionClaimUpdateOne {
+	_u.mutation.ClearDetail()
+	return _u
+}
+
+// Mutation returns the SessionClaimMutation object of the builder.
+func (_u *SessionClaimUpdateOne) Mutation() *SessionClaimMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SessionClaimUpdate builder.
+func (_u *SessionClaimUpdateOne) Where(ps ...predicate.SessionClaim) *SessionClaimUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SessionClaimUpdateOne) Select(field string, fields ...string) *SessionClaimUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SessionClaim entity.
+func (_u *SessionClaimUpdateOne) Save(ctx context.Context) (*SessionClaim, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionClaimUpdateOne) SaveX(ctx context.Context) *SessionClaim {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SessionClaimUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionClaimUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionClaimUpdateOne) check() error {
+	if v, ok := _u.mutation.Outcome(); ok {
+		if err := sessionclaim.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "SessionClaim.outcome": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SessionClaim.session"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SessionClaimUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SessionClaimUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SessionClaimUpdateOne) sqlSave(ctx context.Context) (_node *SessionClaim, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sessionclaim.Table, sessionclaim.Columns, sqlgraph.NewFieldSpec(sessionclaim.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SessionClaim.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sessionclaim.FieldID)
+		for _, f := range fields {
+			if !sessionclaim.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sessionclaim.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(sessionclaim.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(sessionclaim.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(sessionclaim.FieldOutcome, field.TypeEnum, value)
+	}
+	if _u.mutation.OutcomeCleared() {
+		_spec.ClearField(sessionclaim.FieldOutcome, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(sessionclaim.FieldLastHeartbeatAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHeartbeatAtCleared() {
+		_spec.ClearField(sessionclaim.FieldLastHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Detail(); ok {
+		_spec.SetField(sessionclaim.FieldDetail, field.TypeString, value)
+	}
+	if _u.mutation.DetailCleared() {
+		_spec.ClearField(sessionclaim.FieldDetail, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SessionClaim{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessionclaim.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+)
+
+// SessionComparison is the model entity for the SessionComparison schema.
+type SessionComparison struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The later session
+	SessionID string `json:"session_id,omitempty"`
+	// The earlier session it is compared with
+	PreviousSessionID string `json:"previous_session_id,omitempty"`
+	// Alert fingerprint shared by both sessions
+	AlertKey string `json:"alert_key,omitempty"`
+	// Status holds the value of the "status" field.
+	Status sessioncomparison.Status `json:"status,omitempty"`
+	// One-paragraph summary of what the previous investigation concluded
+	PreviousConclusion *string `json:"previous_conclusion,omitempty"`
+	// One-paragraph summary of what this investigation concluded
+	CurrentConclusion *string `json:"current_conclusion,omitempty"`
+	// Material differences between the two conclusions
+	Differences []string `json:"differences,omitempty"`
+	// Whether both investigations point to the same root cause
+	SameRootCause *bool `json:"same_root_cause,omitempty"`
+	// ErrorMessage holds the value of the "error_message" field.
+	ErrorMessage *string `json:"error_message,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SessionComparisonQuery when eager-loading is set.
+	Edges        SessionComparisonEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SessionComparisonEdges holds the relations/edges for other nodes in the graph.
+type SessionComparisonEdges struct {
+	// Session holds the value of the session edge.
+	Session *AlertSession `json:"session,omitempty"`
+	// PreviousSession holds the value of the previous_session edge.
+	PreviousSession *AlertSession `json:"previous_session,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SessionOrErr returns the Session value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SessionComparisonEdges) SessionOrErr() (*AlertSession, error) {
+	if e.Session != nil {
+		return e.Session, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: alertsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "session"}
+}
+
+// PreviousSessionOrErr returns the PreviousSession value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SessionComparisonEdges) PreviousSessionOrErr() (*AlertSession, error) {
+	if e.PreviousSession != nil {
+		return e.PreviousSession, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: alertsession.Label}
+	}
+	return nil, &NotLoadedError{edge: "previous_session"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SessionComparison) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sessioncomparison.FieldDifferences:
+			values[i] = new([]byte)
+		case sessioncomparison.FieldSameRootCause:
+			values[i] = new(sql.NullBool)
+		case sessioncomparison.FieldID, sessioncomparison.FieldSessionID, sessioncomparison.FieldPreviousSessionID, sessioncomparison.FieldAlertKey, sessioncomparison.FieldStatus, sessioncomparison.FieldPreviousConclusion, sessioncomparison.FieldCurrentConclusion, sessioncomparison.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case sessioncomparison.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SessionComparison fields.
+func (_m *SessionComparison) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sessioncomparison.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case sessioncomparison.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sessioncomparison.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				_m.SessionID = value.String
+			}
+		case sessioncomparison.FieldPreviousSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_session_id", values[i])
+			} else if value.Valid {
+				_m.PreviousSessionID = value.String
+			}
+		case sessioncomparison.FieldAlertKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_key", values[i])
+			} else if value.Valid {
+				_m.AlertKey = value.String
+			}
+		case sessioncomparison.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = sessioncomparison.Status(value.String)
+			}
+		case sessioncomparison.FieldPreviousConclusion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_conclusion", values[i])
+			} else if value.Valid {
+				_m.PreviousConclusion = new(string)
+				*_m.PreviousConclusion = value.String
+			}
+		case sessioncomparison.FieldCurrentConclusion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field current_conclusion", values[i])
+			} else if value.Valid {
+				_m.CurrentConclusion = new(string)
+				*_m.CurrentConclusion = value.String
+			}
+		case sessioncomparison.FieldDifferences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field differences", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Differences); err != nil {
+					return fmt.Errorf("unmarshal field differences: %w", err)
+				}
+			}
+		case sessioncomparison.FieldSameRootCause:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field same_root_cause", values[i])
+			} else if value.Valid {
+				_m.SameRootCause = new(bool)
+				*_m.SameRootCause = value.Bool
+			}
+		case sessioncomparison.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = new(string)
+				*_m.ErrorMessage = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SessionComparison.
+// This includes values selected through modifiers, order, etc.
+func (_m *SessionComparison) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySession queries the "session" edge of the SessionComparison entity.
+func (_m *SessionComparison) QuerySession() *AlertSessionQuery {
+	return NewSessionComparisonClient(_m.config).QuerySession(_m)
+}
+
+// QueryPreviousSession queries the "previous_session" edge of the SessionComparison entity.
+func (_m *SessionComparison) QueryPreviousSession() *AlertSessionQuery {
+	return NewSessionComparisonClient(_m.config).QueryPreviousSession(_m)
+}
+
+// Update returns a builder for updating this SessionComparison.
+// Note that you need to call SessionComparison.Unwrap() before calling this method if this SessionComparison
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SessionComparison) Update() *SessionComparisonUpdateOne {
+	return NewSessionComparisonClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SessionComparison entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SessionComparison) Unwrap() *SessionComparison {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SessionComparison is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SessionComparison) String() string {
+	var builder strings.Builder
+	builder.WriteString("SessionComparison(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(_m.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("previous_session_id=")
+	builder.WriteString(_m.PreviousSessionID)
+	builder.WriteString(", ")
+	builder.WriteString("alert_key=")
+	builder.WriteString(_m.AlertKey)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.PreviousConclusion; v != nil {
+		builder.WriteString("previous_conclusion=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CurrentConclusion; v != nil {
+		builder.WriteString("current_conclusion=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("differences=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Differences))
+	builder.WriteString(", ")
+	if v := _m.SameRootCause; v != nil {
+		builder.WriteString("same_root_cause=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ErrorMessage; v != nil {
+		builder.WriteString("error_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SessionComparisons is a parsable slice of SessionComparison.
+type SessionComparisons []*SessionComparison
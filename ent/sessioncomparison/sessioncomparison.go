@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessioncomparison
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sessioncomparison type in the database.
+	Label = "session_comparison"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "comparison_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldPreviousSessionID holds the string denoting the previous_session_id field in the database.
+	FieldPreviousSessionID = "previous_session_id"
+	// FieldAlertKey holds the string denoting the alert_key field in the database.
+	FieldAlertKey = "alert_key"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPreviousConclusion holds the string denoting the previous_conclusion field in the database.
+	FieldPreviousConclusion = "previous_conclusion"
+	// FieldCurrentConclusion holds the string denoting the current_conclusion field in the database.
+	FieldCurrentConclusion = "current_conclusion"
+	// FieldDifferences holds the string denoting the differences field in the database.
+	FieldDifferences = "differences"
+	// FieldSameRootCause holds the string denoting the same_root_cause field in the database.
+	FieldSameRootCause = "same_root_cause"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// EdgeSession holds the string denoting the session edge name in mutations.
+	EdgeSession = "session"
+	// EdgePreviousSession holds the string denoting the previous_session edge name in mutations.
+	EdgePreviousSession = "previous_session"
+	// AlertSessionFieldID holds the string denoting the ID field of the AlertSession.
+	AlertSessionFieldID = "session_id"
+	// Table holds the table name of the sessioncomparison in the database.
+	Table = "session_comparisons"
+	// SessionTable is the table that holds the session relation/edge.
+	SessionTable = "session_comparisons"
+	// SessionInverseTable is the table name for the AlertSession entity.
+	// It exists in this package in order to avoid circular dependency with the "alertsession" package.
+	SessionInverseTable = "alert_sessions"
+	// SessionColumn is the table column denoting the session relation/edge.
+	SessionColumn = "session_id"
+	// PreviousSessionTable is the table that holds the previous_session relation/edge.
+	PreviousSessionTable = "session_comparisons"
+	// PreviousSessionInverseTable is the table name for the AlertSession entity.
+	// It exists in this package in order to avoid circular dependency with the "alertsession" package.
+	PreviousSessionInverseTable = "alert_sessions"
+	// PreviousSessionColumn is the table column denoting the previous_session relation/edge.
+	PreviousSessionColumn = "previous_session_id"
+)
+
+// Columns holds all SQL columns for sessioncomparison fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldSessionID,
+	FieldPreviousSessionID,
+	FieldAlertKey,
+	FieldStatus,
+	FieldPreviousConclusion,
+	FieldCurrentConclusion,
+	FieldDifferences,
+	FieldSameRootCause,
+	FieldErrorMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusCompleted, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("sessioncomparison: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SessionComparison queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByPreviousSessionID orders the results by the previous_session_id field.
+func ByPreviousSessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousSessionID, opts...).ToFunc()
+}
+
+// ByAlertKey orders the results by the alert_key field.
+func ByAlertKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertKey, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPreviousConclusion orders the results by the previous_conclusion field.
+func ByPreviousConclusion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousConclusion, opts...).ToFunc()
+}
+
+// ByCurrentConclusion orders the results by the current_conclusion field.
+func ByCurrentConclusion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentConclusion, opts...).ToFunc()
+}
+
+// BySameRootCause orders the results by the same_root_cause field.
+func BySameRootCause(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSameRootCause, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// BySessionField orders the results by session field.
+func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPreviousSessionField orders the results by previous_session field.
+func ByPreviousSessionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPreviousSessionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionInverseTable, AlertSessionFieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, SessionTable, SessionColumn),
+	)
+}
+func newPreviousSessionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PreviousSessionInverseTable, AlertSessionFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PreviousSessionTable, PreviousSessionColumn),
+	)
+}
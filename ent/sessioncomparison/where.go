@@ -0,0 +1,677 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessioncomparison
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldSessionID, v))
+}
+
+// PreviousSessionID applies equality check predicate on the "previous_session_id" field. It's identical to PreviousSessionIDEQ.
+func PreviousSessionID(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldPreviousSessionID, v))
+}
+
+// AlertKey applies equality check predicate on the "alert_key" field. It's identical to AlertKeyEQ.
+func AlertKey(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldAlertKey, v))
+}
+
+// PreviousConclusion applies equality check predicate on the "previous_conclusion" field. It's identical to PreviousConclusionEQ.
+func PreviousConclusion(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldPreviousConclusion, v))
+}
+
+// CurrentConclusion applies equality check predicate on the "current_conclusion" field. It's identical to CurrentConclusionEQ.
+func CurrentConclusion(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldCurrentConclusion, v))
+}
+
+// SameRootCause applies equality check predicate on the "same_root_cause" field. It's identical to SameRootCauseEQ.
+func SameRootCause(v bool) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldSameRootCause, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// PreviousSessionIDEQ applies the EQ predicate on the "previous_session_id" field.
+func PreviousSessionIDEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDNEQ applies the NEQ predicate on the "previous_session_id" field.
+func PreviousSessionIDNEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDIn applies the In predicate on the "previous_session_id" field.
+func PreviousSessionIDIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldPreviousSessionID, vs...))
+}
+
+// PreviousSessionIDNotIn applies the NotIn predicate on the "previous_session_id" field.
+func PreviousSessionIDNotIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldPreviousSessionID, vs...))
+}
+
+// PreviousSessionIDGT applies the GT predicate on the "previous_session_id" field.
+func PreviousSessionIDGT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGT(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDGTE applies the GTE predicate on the "previous_session_id" field.
+func PreviousSessionIDGTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGTE(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDLT applies the LT predicate on the "previous_session_id" field.
+func PreviousSessionIDLT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLT(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDLTE applies the LTE predicate on the "previous_session_id" field.
+func PreviousSessionIDLTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLTE(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDContains applies the Contains predicate on the "previous_session_id" field.
+func PreviousSessionIDContains(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContains(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDHasPrefix applies the HasPrefix predicate on the "previous_session_id" field.
+func PreviousSessionIDHasPrefix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasPrefix(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDHasSuffix applies the HasSuffix predicate on the "previous_session_id" field.
+func PreviousSessionIDHasSuffix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasSuffix(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDEqualFold applies the EqualFold predicate on the "previous_session_id" field.
+func PreviousSessionIDEqualFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEqualFold(FieldPreviousSessionID, v))
+}
+
+// PreviousSessionIDContainsFold applies the ContainsFold predicate on the "previous_session_id" field.
+func PreviousSessionIDContainsFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContainsFold(FieldPreviousSessionID, v))
+}
+
+// AlertKeyEQ applies the EQ predicate on the "alert_key" field.
+func AlertKeyEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldAlertKey, v))
+}
+
+// AlertKeyNEQ applies the NEQ predicate on the "alert_key" field.
+func AlertKeyNEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldAlertKey, v))
+}
+
+// AlertKeyIn applies the In predicate on the "alert_key" field.
+func AlertKeyIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldAlertKey, vs...))
+}
+
+// AlertKeyNotIn applies the NotIn predicate on the "alert_key" field.
+func AlertKeyNotIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldAlertKey, vs...))
+}
+
+// AlertKeyGT applies the GT predicate on the "alert_key" field.
+func AlertKeyGT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGT(FieldAlertKey, v))
+}
+
+// AlertKeyGTE applies the GTE predicate on the "alert_key" field.
+func AlertKeyGTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGTE(FieldAlertKey, v))
+}
+
+// AlertKeyLT applies the LT predicate on the "alert_key" field.
+func AlertKeyLT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLT(FieldAlertKey, v))
+}
+
+// AlertKeyLTE applies the LTE predicate on the "alert_key" field.
+func AlertKeyLTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLTE(FieldAlertKey, v))
+}
+
+// AlertKeyContains applies the Contains predicate on the "alert_key" field.
+func AlertKeyContains(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContains(FieldAlertKey, v))
+}
+
+// AlertKeyHasPrefix applies the HasPrefix predicate on the "alert_key" field.
+func AlertKeyHasPrefix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasPrefix(FieldAlertKey, v))
+}
+
+// AlertKeyHasSuffix applies the HasSuffix predicate on the "alert_key" field.
+func AlertKeyHasSuffix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasSuffix(FieldAlertKey, v))
+}
+
+// AlertKeyEqualFold applies the EqualFold predicate on the "alert_key" field.
+func AlertKeyEqualFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEqualFold(FieldAlertKey, v))
+}
+
+// AlertKeyContainsFold applies the ContainsFold predicate on the "alert_key" field.
+func AlertKeyContainsFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContainsFold(FieldAlertKey, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// PreviousConclusionEQ applies the EQ predicate on the "previous_conclusion" field.
+func PreviousConclusionEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionNEQ applies the NEQ predicate on the "previous_conclusion" field.
+func PreviousConclusionNEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionIn applies the In predicate on the "previous_conclusion" field.
+func PreviousConclusionIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldPreviousConclusion, vs...))
+}
+
+// PreviousConclusionNotIn applies the NotIn predicate on the "previous_conclusion" field.
+func PreviousConclusionNotIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldPreviousConclusion, vs...))
+}
+
+// PreviousConclusionGT applies the GT predicate on the "previous_conclusion" field.
+func PreviousConclusionGT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGT(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionGTE applies the GTE predicate on the "previous_conclusion" field.
+func PreviousConclusionGTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGTE(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionLT applies the LT predicate on the "previous_conclusion" field.
+func PreviousConclusionLT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLT(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionLTE applies the LTE predicate on the "previous_conclusion" field.
+func PreviousConclusionLTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLTE(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionContains applies the Contains predicate on the "previous_conclusion" field.
+func PreviousConclusionContains(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContains(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionHasPrefix applies the HasPrefix predicate on the "previous_conclusion" field.
+func PreviousConclusionHasPrefix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasPrefix(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionHasSuffix applies the HasSuffix predicate on the "previous_conclusion" field.
+func PreviousConclusionHasSuffix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasSuffix(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionIsNil applies the IsNil predicate on the "previous_conclusion" field.
+func PreviousConclusionIsNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIsNull(FieldPreviousConclusion))
+}
+
+// PreviousConclusionNotNil applies the NotNil predicate on the "previous_conclusion" field.
+func PreviousConclusionNotNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotNull(FieldPreviousConclusion))
+}
+
+// PreviousConclusionEqualFold applies the EqualFold predicate on the "previous_conclusion" field.
+func PreviousConclusionEqualFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEqualFold(FieldPreviousConclusion, v))
+}
+
+// PreviousConclusionContainsFold applies the ContainsFold predicate on the "previous_conclusion" field.
+func PreviousConclusionContainsFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContainsFold(FieldPreviousConclusion, v))
+}
+
+// CurrentConclusionEQ applies the EQ predicate on the "current_conclusion" field.
+func CurrentConclusionEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionNEQ applies the NEQ predicate on the "current_conclusion" field.
+func CurrentConclusionNEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionIn applies the In predicate on the "current_conclusion" field.
+func CurrentConclusionIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldCurrentConclusion, vs...))
+}
+
+// CurrentConclusionNotIn applies the NotIn predicate on the "current_conclusion" field.
+func CurrentConclusionNotIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldCurrentConclusion, vs...))
+}
+
+// CurrentConclusionGT applies the GT predicate on the "current_conclusion" field.
+func CurrentConclusionGT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGT(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionGTE applies the GTE predicate on the "current_conclusion" field.
+func CurrentConclusionGTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGTE(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionLT applies the LT predicate on the "current_conclusion" field.
+func CurrentConclusionLT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLT(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionLTE applies the LTE predicate on the "current_conclusion" field.
+func CurrentConclusionLTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLTE(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionContains applies the Contains predicate on the "current_conclusion" field.
+func CurrentConclusionContains(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContains(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionHasPrefix applies the HasPrefix predicate on the "current_conclusion" field.
+func CurrentConclusionHasPrefix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasPrefix(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionHasSuffix applies the HasSuffix predicate on the "current_conclusion" field.
+func CurrentConclusionHasSuffix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasSuffix(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionIsNil applies the IsNil predicate on the "current_conclusion" field.
+func CurrentConclusionIsNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIsNull(FieldCurrentConclusion))
+}
+
+// CurrentConclusionNotNil applies the NotNil predicate on the "current_conclusion" field.
+func CurrentConclusionNotNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotNull(FieldCurrentConclusion))
+}
+
+// CurrentConclusionEqualFold applies the EqualFold predicate on the "current_conclusion" field.
+func CurrentConclusionEqualFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEqualFold(FieldCurrentConclusion, v))
+}
+
+// CurrentConclusionContainsFold applies the ContainsFold predicate on the "current_conclusion" field.
+func CurrentConclusionContainsFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContainsFold(FieldCurrentConclusion, v))
+}
+
+// DifferencesIsNil applies the IsNil predicate on the "differences" field.
+func DifferencesIsNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIsNull(FieldDifferences))
+}
+
+// DifferencesNotNil applies the NotNil predicate on the "differences" field.
+func DifferencesNotNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotNull(FieldDifferences))
+}
+
+// SameRootCauseEQ applies the EQ predicate on the "same_root_cause" field.
+func SameRootCauseEQ(v bool) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldSameRootCause, v))
+}
+
+// SameRootCauseNEQ applies the NEQ predicate on the "same_root_cause" field.
+func SameRootCauseNEQ(v bool) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldSameRootCause, v))
+}
+
+// SameRootCauseIsNil applies the IsNil predicate on the "same_root_cause" field.
+func SameRootCauseIsNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIsNull(FieldSameRootCause))
+}
+
+// SameRootCauseNotNil applies the NotNil predicate on the "same_root_cause" field.
+func SameRootCauseNotNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotNull(FieldSameRootCause))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// HasSession applies the HasEdge predicate on the "session" edge.
+func HasSession() predicate.SessionComparison {
+	return predicate.SessionComparison(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, SessionTable, SessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionWith applies the HasEdge predicate on the "session" edge with a given conditions (other predicates).
+func HasSessionWith(preds ...predicate.AlertSession) predicate.SessionComparison {
+	return predicate.SessionComparison(func(s *sql.Selector) {
+		step := newSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPreviousSession applies the HasEdge predicate on the "previous_session" edge.
+func HasPreviousSession() predicate.SessionComparison {
+	return predicate.SessionComparison(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PreviousSessionTable, PreviousSessionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPreviousSessionWith applies the HasEdge predicate on the "previous_session" edge with a given conditions (other predicates).
+func HasPreviousSessionWith(preds ...predicate.AlertSession) predicate.SessionComparison {
+	return predicate.SessionComparison(func(s *sql.Selector) {
+		step := newPreviousSessionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SessionComparison) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SessionComparison) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SessionComparison) predicate.SessionComparison {
+	return predicate.SessionComparison(sql.NotPredicates(p))
+}
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+)
+
+// SessionComparisonCreate is the builder for creating a SessionComparison entity.
+type SessionComparisonCreate struct {
+	config
+	mutation *SessionComparisonMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SessionComparisonCreate) SetCreatedAt(v time.Time) *SessionComparisonCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SessionComparisonCreate) SetNillableCreatedAt(v *time.Time) *SessionComparisonCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *SessionComparisonCreate) SetSessionID(v string) *SessionComparisonCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetPreviousSessionID sets the "previous_session_id" field.
+func (_c *SessionComparisonCreate) SetPreviousSessionID(v string) *SessionComparisonCreate {
+	_c.mutation.SetPreviousSessionID(v)
+	return _c
+}
+
+// SetAlertKey sets the "alert_key" field.
+func (_c *SessionComparisonCreate) SetAlertKey(v string) *SessionComparisonCreate {
+	_c.mutation.SetAlertKey(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SessionComparisonCreate) SetStatus(v sessioncomparison.Status) *SessionComparisonCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetPreviousConclusion sets the "previous_conclusion" field.
+func (_c *SessionComparisonCreate) SetPreviousConclusion(v string) *SessionComparisonCreate {
+	_c.mutation.SetPreviousConclusion(v)
+	return _c
+}
+
+// SetNillablePreviousConclusion sets the "previous_conclusion" field if the given value is not nil.
+func (_c *SessionComparisonCreate) SetNillablePreviousConclusion(v *string) *SessionComparisonCreate {
+	if v != nil {
+		_c.SetPreviousConclusion(*v)
+	}
+	return _c
+}
+
+// SetCurrentConclusion sets the "current_conclusion" field.
+func (_c *SessionComparisonCreate) SetCurrentConclusion(v string) *SessionComparisonCreate {
+	_c.mutation.SetCurrentConclusion(v)
+	return _c
+}
+
+// SetNillableCurrentConclusion sets the "current_conclusion" field if the given value is not nil.
+func (_c *SessionComparisonCreate) SetNillableCurrentConclusion(v *string) *SessionComparisonCreate {
+	if v != nil {
+		_c.SetCurrentConclusion(*v)
+	}
+	return _c
+}
+
+// SetDifferences sets the "differences" field.
+func (_c *SessionComparisonCreate) SetDifferences(v []string) *SessionComparisonCreate {
+	_c.mutation.SetDifferences(v)
+	return _c
+}
+
+// SetSameRootCause sets the "same_root_cause" field.
+func (_c *SessionComparisonCreate) SetSameRootCause(v bool) *SessionComparisonCreate {
+	_c.mutation.SetSameRootCause(v)
+	return _c
+}
+
+// SetNillableSameRootCause sets the "same_root_cause" field if the given value is not nil.
+func (_c *SessionComparisonCreate) SetNillableSameRootCause(v *bool) *SessionComparisonCreate {
+	if v != nil {
+		_c.SetSameRootCause(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *SessionComparisonCreate) SetErrorMessage(v string) *SessionComparisonCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *SessionComparisonCreate) SetNillableErrorMessage(v *string) *SessionComparisonCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SessionComparisonCreate) SetID(v string) *SessionComparisonCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetSession sets the "session" edge to the AlertSession entity.
+func (_c *SessionComparisonCreate) SetSession(v *AlertSession) *SessionComparisonCreate {
+	return _c.SetSessionID(v.ID)
+}
+
+// SetPreviousSession sets the "previous_session" edge to the AlertSession entity.
+func (_c *SessionComparisonCreate) SetPreviousSession(v *AlertSession) *SessionComparisonCreate {
+	return _c.SetPreviousSessionID(v.ID)
+}
+
+// Mutation returns the SessionComparisonMutation object of the builder.
+func (_c *SessionComparisonCreate) Mutation() *SessionComparisonMutation {
+	return _c.mutation
+}
+
+// Save creates the SessionComparison in the database.
+func (_c *SessionComparisonCreate) Save(ctx context.Context) (*SessionComparison, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SessionComparisonCreate) SaveX(ctx context.Context) *SessionComparison {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SessionComparisonCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SessionComparisonCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SessionComparisonCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sessioncomparison.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SessionComparisonCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SessionComparison.created_at"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "SessionComparison.session_id"`)}
+	}
+	if _, ok := _c.mutation.PreviousSessionID(); !ok {
+		return &ValidationError{Name: "previous_session_id", err: errors.New(`ent: missing required field "SessionComparison.previous_session_id"`)}
+	}
+	if _, ok := _c.mutation.AlertKey(); !ok {
+		return &ValidationError{Name: "alert_key", err: errors.New(`ent: missing required field "SessionComparison.alert_key"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SessionComparison.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := sessioncomparison.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SessionComparison.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.SessionIDs()) == 0 {
+		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "SessionComparison.session"`)}
+	}
+	if len(_c.mutation.PreviousSessionIDs()) == 0 {
+		return &ValidationError{Name: "previous_session", err: errors.New(`ent: missing required edge "SessionComparison.previous_session"`)}
+	}
+	return nil
+}
+
+func (_c *SessionComparisonCreate) sqlSave(ctx context.Context) (*SessionComparison, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SessionComparison.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SessionComparisonCreate) createSpec() (*SessionComparison, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SessionComparison{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sessioncomparison.Table, sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sessioncomparison.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.AlertKey(); ok {
+		_spec.SetField(sessioncomparison.FieldAlertKey, field.TypeString, value)
+		_node.AlertKey = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(sessioncomparison.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PreviousConclusion(); ok {
+		_spec.SetField(sessioncomparison.FieldPreviousConclusion, field.TypeString, value)
+		_node.PreviousConclusion = &value
+	}
+	if value, ok := _c.mutation.CurrentConclusion(); ok {
+		_spec.SetField(sessioncomparison.FieldCurrentConclusion, field.TypeString, value)
+		_node.CurrentConclusion = &value
+	}
+	if value, ok := _c.mutation.Differences(); ok {
+		_spec.SetField(sessioncomparison.FieldDifferences, field.TypeJSON, value)
+		_node.Differences = value
+	}
+	if value, ok := _c.mutation.SameRootCause(); ok {
+		_spec.SetField(sessioncomparison.FieldSameRootCause, field.TypeBool, value)
+		_node.SameRootCause = &value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(sessioncomparison.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   sessioncomparison.SessionTable,
+			Columns: []string{sessioncomparison.SessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PreviousSessionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sessioncomparison.PreviousSessionTable,
+			Columns: []string{sessioncomparison.PreviousSessionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PreviousSessionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SessionComparisonCreateBulk is the builder for creating many SessionComparison entities in bulk.
+type SessionComparisonCreateBulk struct {
+	config
+	err      error
+	builders []*SessionComparisonCreate
+}
+
+// Save creates the SessionComparison entities in the database.
+func (_c *SessionComparisonCreateBulk) Save(ctx context.Context) ([]*SessionComparison, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SessionComparison, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SessionComparisonMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SessionComparisonCreateBulk) SaveX(ctx context.Context) []*SessionComparison {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SessionComparisonCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SessionComparisonCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
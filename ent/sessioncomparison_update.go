@@ -0,0 +1,530 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+)
+
+// SessionComparisonUpdate is the builder for updating SessionComparison entities.
+type SessionComparisonUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SessionComparisonMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SessionComparisonUpdate builder.
+func (_u *SessionComparisonUpdate) Where(ps ...predicate.SessionComparison) *SessionComparisonUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SessionComparisonUpdate) SetStatus(v sessioncomparison.Status) *SessionComparisonUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SessionComparisonUpdate) SetNillableStatus(v *sessioncomparison.Status) *SessionComparisonUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPreviousConclusion sets the "previous_conclusion" field.
+func (_u *SessionComparisonUpdate) SetPreviousConclusion(v string) *SessionComparisonUpdate {
+	_u.mutation.SetPreviousConclusion(v)
+	return _u
+}
+
+// SetNillablePreviousConclusion sets the "previous_conclusion" field if the given value is not nil.
+func (_u *SessionComparisonUpdate) SetNillablePreviousConclusion(v *string) *SessionComparisonUpdate {
+	if v != nil {
+		_u.SetPreviousConclusion(*v)
+	}
+	return _u
+}
+
+// ClearPreviousConclusion clears the value of the "previous_conclusion" field.
+func (_u *SessionComparisonUpdate) ClearPreviousConclusion() *SessionComparisonUpdate {
+	_u.mutation.ClearPreviousConclusion()
+	return _u
+}
+
+// SetCurrentConclusion sets the "current_conclusion" field.
+func (_u *SessionComparisonUpdate) SetCurrentConclusion(v string) *SessionComparisonUpdate {
+	_u.mutation.SetCurrentConclusion(v)
+	return _u
+}
+
+// SetNillableCurrentConclusion sets the "current_conclusion" field if the given value is not nil.
+func (_u *SessionComparisonUpdate) SetNillableCurrentConclusion(v *string) *SessionComparisonUpdate {
+	if v != nil {
+		_u.SetCurrentConclusion(*v)
+	}
+	return _u
+}
+
+// ClearCurrentConclusion clears the value of the "current_conclusion" field.
+func (_u *SessionComparisonUpdate) ClearCurrentConclusion() *SessionComparisonUpdate {
+	_u.mutation.ClearCurrentConclusion()
+	return _u
+}
+
+// SetDifferences sets the "differences" field.
+func (_u *SessionComparisonUpdate) SetDifferences(v []string) *SessionComparisonUpdate {
+	_u.mutation.SetDifferences(v)
+	return _u
+}
+
+// AppendDifferences appends value to the "differences" field.
+func (_u *SessionComparisonUpdate) AppendDifferences(v []string) *SessionComparisonUpdate {
+	_u.mutation.AppendDifferences(v)
+	return _u
+}
+
+// ClearDifferences clears the value of the "differences" field.
+func (_u *SessionComparisonUpdate) ClearDifferences() *SessionComparisonUpdate {
+	_u.mutation.ClearDifferences()
+	return _u
+}
+
+// SetSameRootCause sets the "same_root_cause" field.
+func (_u *SessionComparisonUpdate) SetSameRootCause(v bool) *SessionComparisonUpdate {
+	_u.mutation.SetSameRootCause(v)
+	return _u
+}
+
+// SetNillableSameRootCause sets the "same_root_cause" field if the given value is not nil.
+func (_u *SessionComparisonUpdate) SetNillableSameRootCause(v *bool) *SessionComparisonUpdate {
+	if v != nil {
+		_u.SetSameRootCause(*v)
+	}
+	return _u
+}
+
+// ClearSameRootCause clears the value of the "same_root_cause" field.
+func (_u *SessionComparisonUpdate) ClearSameRootCause() *SessionComparisonUpdate {
+	_u.mutation.ClearSameRootCause()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *SessionComparisonUpdate) SetErrorMessage(v string) *SessionComparisonUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *SessionComparisonUpdate) SetNillableErrorMessage(v *string) *SessionComparisonUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *SessionComparisonUpdate) ClearErrorMessage() *SessionComparisonUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// Mutation returns the SessionComparisonMutation object of the builder.
+func (_u *SessionComparisonUpdate) Mutation() *SessionComparisonMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SessionComparisonUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionComparisonUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SessionComparisonUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionComparisonUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionComparisonUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := sessioncomparison.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SessionComparison.status": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SessionComparison.session"`)
+	}
+	if _u.mutation.PreviousSessionCleared() && len(_u.mutation.PreviousSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SessionComparison.previous_session"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SessionComparisonUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SessionComparisonUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SessionComparisonUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sessioncomparison.Table, sessioncomparison.Columns, sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(sessioncomparison.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviousConclusion(); ok {
+		_spec.SetField(sessioncomparison.FieldPreviousConclusion, field.TypeString, value)
+	}
+	if _u.mutation.PreviousConclusionCleared() {
+		_spec.ClearField(sessioncomparison.FieldPreviousConclusion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CurrentConclusion(); ok {
+		_spec.SetField(sessioncomparison.FieldCurrentConclusion, field.TypeString, value)
+	}
+	if _u.mutation.CurrentConclusionCleared() {
+		_spec.ClearField(sessioncomparison.FieldCurrentConclusion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Differences(); ok {
+		_spec.SetField(sessioncomparison.FieldDifferences, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDifferences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sessioncomparison.FieldDifferences, value)
+		})
+	}
+	if _u.mutation.DifferencesCleared() {
+		_spec.ClearField(sessioncomparison.FieldDifferences, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SameRootCause(); ok {
+		_spec.SetField(sessioncomparison.FieldSameRootCause, field.TypeBool, value)
+	}
+	if _u.mutation.SameRootCauseCleared() {
+		_spec.ClearField(sessioncomparison.FieldSameRootCause, field.TypeBool)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(sessioncomparison.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(sessioncomparison.FieldErrorMessage, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessioncomparison.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SessionComparisonUpdateOne is the builder for updating a single SessionComparison entity.
+type SessionComparisonUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SessionComparisonMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (_u *SessionComparisonUpdateOne) SetStatus(v sessioncomparison.Status) *SessionComparisonUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SessionComparisonUpdateOne) SetNillableStatus(v *sessioncomparison.Status) *SessionComparisonUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPreviousConclusion sets the "previous_conclusion" field.
+func (_u *SessionComparisonUpdateOne) SetPreviousConclusion(v string) *SessionComparisonUpdateOne {
+	_u.mutation.SetPreviousConclusion(v)
+	return _u
+}
+
+// SetNillablePreviousConclusion sets the "previous_conclusion" field if the given value is not nil.
+func (_u *SessionComparisonUpdateOne) SetNillablePreviousConclusion(v *string) *SessionComparisonUpdateOne {
+	if v != nil {
+		_u.SetPreviousConclusion(*v)
+	}
+	return _u
+}
+
+// ClearPreviousConclusion clears the value of the "previous_conclusion" field.
+func (_u *SessionComparisonUpdateOne) ClearPreviousConclusion() *SessionComparisonUpdateOne {
+	_u.mutation.ClearPreviousConclusion()
+	return _u
+}
+
+// SetCurrentConclusion sets the "current_conclusion" field.
+func (_u *SessionComparisonUpdateOne) SetCurrentConclusion(v string) *SessionComparisonUpdateOne {
+	_u.mutation.SetCurrentConclusion(v)
+	return _u
+}
+
+// SetNillableCurrentConclusion sets the "current_conclusion" field if the given value is not nil.
+func (_u *SessionComparisonUpdateOne) SetNillableCurrentConclusion(v *string) *SessionComparisonUpdateOne {
+	if v != nil {
+		_u.SetCurrentConclusion(*v)
+	}
+	return _u
+}
+
+// ClearCurrentConclusion clears the value of the "current_conclusion" field.
+func (_u *SessionComparisonUpdateOne) ClearCurrentConclusion() *SessionComparisonUpdateOne {
+	_u.mutation.ClearCurrentConclusion()
+	return _u
+}
+
+// SetDifferences sets the "differences" field.
+func (_u *SessionComparisonUpdateOne) SetDifferences(v []string) *SessionComparisonUpdateOne {
+	_u.mutation.SetDifferences(v)
+	return _u
+}
+
+// AppendDifferences appends value to the "differences" field.
+func (_u *SessionComparisonUpdateOne) AppendDifferences(v []string) *SessionComparisonUpdateOne {
+	_u.mutation.AppendDifferences(v)
+	return _u
+}
+
+// ClearDifferences clears the value of the "differences" field.
+func (_u *SessionComparisonUpdateOne) ClearDifferences() *SessionComparisonUpdateOne {
+	_u.mutation.ClearDifferences()
+	return _u
+}
+
+// SetSameRootCause sets the "same_root_cause" field.
+func (_u *SessionComparisonUpdateOne) SetSameRootCause(v bool) *SessionComparisonUpdateOne {
+	_u.mutation.SetSameRootCause(v)
+	return _u
+}
+
+// SetNillableSameRootCause sets the "same_root_cause" field if the given value is not nil.
+func (_u *SessionComparisonUpdateOne) SetNillableSameRootCause(v *bool) *SessionComparisonUpdateOne {
+	if v != nil {
+		_u.SetSameRootCause(*v)
+	}
+	return _u
+}
+
+// ClearSameRootCause clears the value of the "same_root_cause" field.
+func (_u *SessionComparisonUpdateOne) ClearSameRootCause() *SessionComparisonUpdateOne {
+	_u.mutation.ClearSameRootCause()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *SessionComparisonUpdateOne) SetErrorMessage(v string) *SessionComparisonUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *SessionComparisonUpdateOne) SetNillableErrorMessage(v *string) *SessionComparisonUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *SessionComparisonUpdateOne) ClearErrorMessage() *SessionComparisonUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// Mutation returns the SessionComparisonMutation object of the builder.
+func (_u *SessionComparisonUpdateOne) Mutation() *SessionComparisonMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SessionComparisonUpdate builder.
+func (_u *SessionComparisonUpdateOne) Where(ps ...predicate.SessionComparison) *SessionComparisonUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SessionComparisonUpdateOne) Select(field string, fields ...string) *SessionComparisonUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SessionComparison entity.
+func (_u *SessionComparisonUpdateOne) Save(ctx context.Context) (*SessionComparison, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionComparisonUpdateOne) SaveX(ctx context.Context) *SessionComparison {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SessionComparisonUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionComparisonUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionComparisonUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := sessioncomparison.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SessionComparison.status": %w`, err)}
+		}
+	}
+	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SessionComparison.session"`)
+	}
+	if _u.mutation.PreviousSessionCleared() && len(_u.mutation.PreviousSessionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SessionComparison.previous_session"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SessionComparisonUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SessionComparisonUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SessionComparisonUpdateOne) sqlSave(ctx context.Context) (_node *SessionComparison, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sessioncomparison.Table, sessioncomparison.Columns, sqlgraph.NewFieldSpec(sessioncomparison.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SessionComparison.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sessioncomparison.FieldID)
+		for _, f := range fields {
+			if !sessioncomparison.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sessioncomparison.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(sessioncomparison.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviousConclusion(); ok {
+		_spec.SetField(sessioncomparison.FieldPreviousConclusion, field.TypeString, value)
+	}
+	if _u.mutation.PreviousConclusionCleared() {
+		_spec.ClearField(sessioncomparison.FieldPreviousConclusion, field.TypeString)
+	}
+	if value, ok := _u.mutation.CurrentConclusion(); ok {
+		_spec.SetField(sessioncomparison.FieldCurrentConclusion, field.TypeString, value)
+	}
+	if _u.mutation.CurrentConclusionCleared() {
+		_spec.ClearField(sessioncomparison.FieldCurrentConclusion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Differences(); ok {
+		_spec.SetField(sessioncomparison.FieldDifferences, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDifferences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sessioncomparison.FieldDifferences, value)
+		})
+	}
+	if _u.mutation.DifferencesCleared() {
+		_spec.ClearField(sessioncomparison.FieldDifferences, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SameRootCause(); ok {
+		_spec.SetField(sessioncomparison.FieldSameRootCause, field.TypeBool, value)
+	}
+	if _u.mutation.SameRootCauseCleared() {
+		_spec.ClearField(sessioncomparison.FieldSameRootCause, field.TypeBool)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(sessioncomparison.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(sessioncomparison.FieldErrorMessage, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SessionComparison{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessioncomparison.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
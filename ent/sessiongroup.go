@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
+)
+
+// SessionGroup is the model entity for the SessionGroup schema.
+type SessionGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// AlertType holds the value of the "alert_type" field.
+	AlertType string `json:"alert_type,omitempty"`
+	// Chain the alert type resolved to; its fan_out config drives synthesis
+	PrimaryChainID string `json:"primary_chain_id,omitempty"`
+	// skipped when no sibling session completed
+	SynthesisStatus sessiongroup.SynthesisStatus `json:"synthesis_status,omitempty"`
+	// Consolidated summary across all sibling sessions
+	Summary *string `json:"summary,omitempty"`
+	// SummaryError holds the value of the "summary_error" field.
+	SummaryError *string `json:"summary_error,omitempty"`
+	// SynthesizedAt holds the value of the "synthesized_at" field.
+	SynthesizedAt *time.Time `json:"synthesized_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SessionGroupQuery when eager-loading is set.
+	Edges        SessionGroupEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SessionGroupEdges holds the relations/edges for other nodes in the graph.
+type SessionGroupEdges struct {
+	// Sessions holds the value of the sessions edge.
+	Sessions []*AlertSession `json:"sessions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e SessionGroupEdges) SessionsOrErr() ([]*AlertSession, error) {
+	if e.loadedTypes[0] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SessionGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sessiongroup.FieldID, sessiongroup.FieldAlertType, sessiongroup.FieldPrimaryChainID, sessiongroup.FieldSynthesisStatus, sessiongroup.FieldSummary, sessiongroup.FieldSummaryError:
+			values[i] = new(sql.NullString)
+		case sessiongroup.FieldCreatedAt, sessiongroup.FieldUpdatedAt, sessiongroup.FieldSynthesizedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SessionGroup fields.
+func (_m *SessionGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sessiongroup.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case sessiongroup.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sessiongroup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case sessiongroup.FieldAlertType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_type", values[i])
+			} else if value.Valid {
+				_m.AlertType = value.String
+			}
+		case sessiongroup.FieldPrimaryChainID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_chain_id", values[i])
+			} else if value.Valid {
+				_m.PrimaryChainID = value.String
+			}
+		case sessiongroup.FieldSynthesisStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field synthesis_status", values[i])
+			} else if value.Valid {
+				_m.SynthesisStatus = sessiongroup.SynthesisStatus(value.String)
+			}
+		case sessiongroup.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				_m.Summary = new(string)
+				*_m.Summary = value.String
+			}
+		case sessiongroup.FieldSummaryError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary_error", values[i])
+			} else if value.Valid {
+				_m.SummaryError = new(string)
+				*_m.SummaryError = value.String
+			}
+		case sessiongroup.FieldSynthesizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field synthesized_at", values[i])
+			} else if value.Valid {
+				_m.SynthesizedAt = new(time.Time)
+				*_m.SynthesizedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SessionGroup.
+// This includes values selected through modifiers, order, etc.
+func (_m *SessionGroup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySessions queries the "sessions" edge of the SessionGroup entity.
+func (_m *SessionGroup) QuerySessions() *AlertSessionQuery {
+	return NewSessionGroupClient(_m.config).QuerySessions(_m)
+}
+
+// Update returns a builder for updating this SessionGroup.
+// Note that you need to call SessionGroup.Unwrap() before calling this method if this SessionGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SessionGroup) Update() *SessionGroupUpdateOne {
+	return NewSessionGroupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SessionGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SessionGroup) Unwrap() *SessionGroup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SessionGroup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SessionGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("SessionGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("alert_type=")
+	builder.WriteString(_m.AlertType)
+	builder.WriteString(", ")
+	builder.WriteString("primary_chain_id=")
+	builder.WriteString(_m.PrimaryChainID)
+	builder.WriteString(", ")
+	builder.WriteString("synthesis_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SynthesisStatus))
+	builder.WriteString(", ")
+	if v := _m.Summary; v != nil {
+		builder.WriteString("summary=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SummaryError; v != nil {
+		builder.WriteString("summary_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SynthesizedAt; v != nil {
+		builder.WriteString("synthesized_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SessionGroups is a parsable slice of SessionGroup.
+type SessionGroups []*SessionGroup
@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessiongroup
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sessiongroup type in the database.
+	Label = "session_group"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "group_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldAlertType holds the string denoting the alert_type field in the database.
+	FieldAlertType = "alert_type"
+	// FieldPrimaryChainID holds the string denoting the primary_chain_id field in the database.
+	FieldPrimaryChainID = "primary_chain_id"
+	// FieldSynthesisStatus holds the string denoting the synthesis_status field in the database.
+	FieldSynthesisStatus = "synthesis_status"
+	// FieldSummary holds the string denoting the summary field in the database.
+	FieldSummary = "summary"
+	// FieldSummaryError holds the string denoting the summary_error field in the database.
+	FieldSummaryError = "summary_error"
+	// FieldSynthesizedAt holds the string denoting the synthesized_at field in the database.
+	FieldSynthesizedAt = "synthesized_at"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// AlertSessionFieldID holds the string denoting the ID field of the AlertSession.
+	AlertSessionFieldID = "session_id"
+	// Table holds the table name of the sessiongroup in the database.
+	Table = "session_groups"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "alert_sessions"
+	// SessionsInverseTable is the table name for the AlertSession entity.
+	// It exists in this package in order to avoid circular dependency with the "alertsession" package.
+	SessionsInverseTable = "alert_sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "group_id"
+)
+
+// Columns holds all SQL columns for sessiongroup fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldAlertType,
+	FieldPrimaryChainID,
+	FieldSynthesisStatus,
+	FieldSummary,
+	FieldSummaryError,
+	FieldSynthesizedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// SynthesisStatus defines the type for the "synthesis_status" enum field.
+type SynthesisStatus string
+
+// SynthesisStatusPending is the default value of the SynthesisStatus enum.
+const DefaultSynthesisStatus = SynthesisStatusPending
+
+// SynthesisStatus values.
+const (
+	SynthesisStatusPending    SynthesisStatus = "pending"
+	SynthesisStatusInProgress SynthesisStatus = "in_progress"
+	SynthesisStatusCompleted  SynthesisStatus = "completed"
+	SynthesisStatusFailed     SynthesisStatus = "failed"
+	SynthesisStatusSkipped    SynthesisStatus = "skipped"
+)
+
+func (ss SynthesisStatus) String() string {
+	return string(ss)
+}
+
+// SynthesisStatusValidator is a validator for the "synthesis_status" field enum values. It is called by the builders before save.
+func SynthesisStatusValidator(ss SynthesisStatus) error {
+	switch ss {
+	case SynthesisStatusPending, SynthesisStatusInProgress, SynthesisStatusCompleted, SynthesisStatusFailed, SynthesisStatusSkipped:
+		return nil
+	default:
+		return fmt.Errorf("sessiongroup: invalid enum value for synthesis_status field: %q", ss)
+	}
+}
+
+// OrderOption defines the ordering options for the SessionGroup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByAlertType orders the results by the alert_type field.
+func ByAlertType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertType, opts...).ToFunc()
+}
+
+// ByPrimaryChainID orders the results by the primary_chain_id field.
+func ByPrimaryChainID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrimaryChainID, opts...).ToFunc()
+}
+
+// BySynthesisStatus orders the results by the synthesis_status field.
+func BySynthesisStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSynthesisStatus, opts...).ToFunc()
+}
+
+// BySummary orders the results by the summary field.
+func BySummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummary, opts...).ToFunc()
+}
+
+// BySummaryError orders the results by the summary_error field.
+func BySummaryError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummaryError, opts...).ToFunc()
+}
+
+// BySynthesizedAt orders the results by the synthesized_at field.
+func BySynthesizedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSynthesizedAt, opts...).ToFunc()
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, AlertSessionFieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sessiongroup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AlertType applies equality check predicate on the "alert_type" field. It's identical to AlertTypeEQ.
+func AlertType(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldAlertType, v))
+}
+
+// PrimaryChainID applies equality check predicate on the "primary_chain_id" field. It's identical to PrimaryChainIDEQ.
+func PrimaryChainID(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldPrimaryChainID, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryError applies equality check predicate on the "summary_error" field. It's identical to SummaryErrorEQ.
+func SummaryError(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldSummaryError, v))
+}
+
+// SynthesizedAt applies equality check predicate on the "synthesized_at" field. It's identical to SynthesizedAtEQ.
+func SynthesizedAt(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldSynthesizedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AlertTypeEQ applies the EQ predicate on the "alert_type" field.
+func AlertTypeEQ(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldAlertType, v))
+}
+
+// AlertTypeNEQ applies the NEQ predicate on the "alert_type" field.
+func AlertTypeNEQ(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldAlertType, v))
+}
+
+// AlertTypeIn applies the In predicate on the "alert_type" field.
+func AlertTypeIn(vs ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldAlertType, vs...))
+}
+
+// AlertTypeNotIn applies the NotIn predicate on the "alert_type" field.
+func AlertTypeNotIn(vs ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldAlertType, vs...))
+}
+
+// AlertTypeGT applies the GT predicate on the "alert_type" field.
+func AlertTypeGT(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGT(FieldAlertType, v))
+}
+
+// AlertTypeGTE applies the GTE predicate on the "alert_type" field.
+func AlertTypeGTE(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGTE(FieldAlertType, v))
+}
+
+// AlertTypeLT applies the LT predicate on the "alert_type" field.
+func AlertTypeLT(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLT(FieldAlertType, v))
+}
+
+// AlertTypeLTE applies the LTE predicate on the "alert_type" field.
+func AlertTypeLTE(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLTE(FieldAlertType, v))
+}
+
+// AlertTypeContains applies the Contains predicate on the "alert_type" field.
+func AlertTypeContains(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContains(FieldAlertType, v))
+}
+
+// AlertTypeHasPrefix applies the HasPrefix predicate on the "alert_type" field.
+func AlertTypeHasPrefix(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldHasPrefix(FieldAlertType, v))
+}
+
+// AlertTypeHasSuffix applies the HasSuffix predicate on the "alert_type" field.
+func AlertTypeHasSuffix(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldHasSuffix(FieldAlertType, v))
+}
+
+// AlertTypeIsNil applies the IsNil predicate on the "alert_type" field.
+func AlertTypeIsNil() predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIsNull(FieldAlertType))
+}
+
+// AlertTypeNotNil applies the NotNil predicate on the "alert_type" field.
+func AlertTypeNotNil() predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotNull(FieldAlertType))
+}
+
+// AlertTypeEqualFold applies the EqualFold predicate on the "alert_type" field.
+func AlertTypeEqualFold(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEqualFold(FieldAlertType, v))
+}
+
+// AlertTypeContainsFold applies the ContainsFold predicate on the "alert_type" field.
+func AlertTypeContainsFold(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContainsFold(FieldAlertType, v))
+}
+
+// PrimaryChainIDEQ applies the EQ predicate on the "primary_chain_id" field.
+func PrimaryChainIDEQ(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDNEQ applies the NEQ predicate on the "primary_chain_id" field.
+func PrimaryChainIDNEQ(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDIn applies the In predicate on the "primary_chain_id" field.
+func PrimaryChainIDIn(vs ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldPrimaryChainID, vs...))
+}
+
+// PrimaryChainIDNotIn applies the NotIn predicate on the "primary_chain_id" field.
+func PrimaryChainIDNotIn(vs ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldPrimaryChainID, vs...))
+}
+
+// PrimaryChainIDGT applies the GT predicate on the "primary_chain_id" field.
+func PrimaryChainIDGT(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGT(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDGTE applies the GTE predicate on the "primary_chain_id" field.
+func PrimaryChainIDGTE(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGTE(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDLT applies the LT predicate on the "primary_chain_id" field.
+func PrimaryChainIDLT(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLT(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDLTE applies the LTE predicate on the "primary_chain_id" field.
+func PrimaryChainIDLTE(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLTE(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDContains applies the Contains predicate on the "primary_chain_id" field.
+func PrimaryChainIDContains(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContains(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDHasPrefix applies the HasPrefix predicate on the "primary_chain_id" field.
+func PrimaryChainIDHasPrefix(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldHasPrefix(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDHasSuffix applies the HasSuffix predicate on the "primary_chain_id" field.
+func PrimaryChainIDHasSuffix(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldHasSuffix(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDEqualFold applies the EqualFold predicate on the "primary_chain_id" field.
+func PrimaryChainIDEqualFold(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEqualFold(FieldPrimaryChainID, v))
+}
+
+// PrimaryChainIDContainsFold applies the ContainsFold predicate on the "primary_chain_id" field.
+func PrimaryChainIDContainsFold(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContainsFold(FieldPrimaryChainID, v))
+}
+
+// SynthesisStatusEQ applies the EQ predicate on the "synthesis_status" field.
+func SynthesisStatusEQ(v SynthesisStatus) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldSynthesisStatus, v))
+}
+
+// SynthesisStatusNEQ applies the NEQ predicate on the "synthesis_status" field.
+func SynthesisStatusNEQ(v SynthesisStatus) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldSynthesisStatus, v))
+}
+
+// SynthesisStatusIn applies the In predicate on the "synthesis_status" field.
+func SynthesisStatusIn(vs ...SynthesisStatus) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldSynthesisStatus, vs...))
+}
+
+// SynthesisStatusNotIn applies the NotIn predicate on the "synthesis_status" field.
+func SynthesisStatusNotIn(vs ...SynthesisStatus) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldSynthesisStatus, vs...))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// SummaryErrorEQ applies the EQ predicate on the "summary_error" field.
+func SummaryErrorEQ(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldSummaryError, v))
+}
+
+// SummaryErrorNEQ applies the NEQ predicate on the "summary_error" field.
+func SummaryErrorNEQ(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldSummaryError, v))
+}
+
+// SummaryErrorIn applies the In predicate on the "summary_error" field.
+func SummaryErrorIn(vs ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldSummaryError, vs...))
+}
+
+// SummaryErrorNotIn applies the NotIn predicate on the "summary_error" field.
+func SummaryErrorNotIn(vs ...string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldSummaryError, vs...))
+}
+
+// SummaryErrorGT applies the GT predicate on the "summary_error" field.
+func SummaryErrorGT(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGT(FieldSummaryError, v))
+}
+
+// SummaryErrorGTE applies the GTE predicate on the "summary_error" field.
+func SummaryErrorGTE(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGTE(FieldSummaryError, v))
+}
+
+// SummaryErrorLT applies the LT predicate on the "summary_error" field.
+func SummaryErrorLT(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLT(FieldSummaryError, v))
+}
+
+// SummaryErrorLTE applies the LTE predicate on the "summary_error" field.
+func SummaryErrorLTE(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLTE(FieldSummaryError, v))
+}
+
+// SummaryErrorContains applies the Contains predicate on the "summary_error" field.
+func SummaryErrorContains(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContains(FieldSummaryError, v))
+}
+
+// SummaryErrorHasPrefix applies the HasPrefix predicate on the "summary_error" field.
+func SummaryErrorHasPrefix(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldHasPrefix(FieldSummaryError, v))
+}
+
+// SummaryErrorHasSuffix applies the HasSuffix predicate on the "summary_error" field.
+func SummaryErrorHasSuffix(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldHasSuffix(FieldSummaryError, v))
+}
+
+// SummaryErrorIsNil applies the IsNil predicate on the "summary_error" field.
+func SummaryErrorIsNil() predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIsNull(FieldSummaryError))
+}
+
+// SummaryErrorNotNil applies the NotNil predicate on the "summary_error" field.
+func SummaryErrorNotNil() predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotNull(FieldSummaryError))
+}
+
+// SummaryErrorEqualFold applies the EqualFold predicate on the "summary_error" field.
+func SummaryErrorEqualFold(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEqualFold(FieldSummaryError, v))
+}
+
+// SummaryErrorContainsFold applies the ContainsFold predicate on the "summary_error" field.
+func SummaryErrorContainsFold(v string) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldContainsFold(FieldSummaryError, v))
+}
+
+// SynthesizedAtEQ applies the EQ predicate on the "synthesized_at" field.
+func SynthesizedAtEQ(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldEQ(FieldSynthesizedAt, v))
+}
+
+// SynthesizedAtNEQ applies the NEQ predicate on the "synthesized_at" field.
+func SynthesizedAtNEQ(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNEQ(FieldSynthesizedAt, v))
+}
+
+// SynthesizedAtIn applies the In predicate on the "synthesized_at" field.
+func SynthesizedAtIn(vs ...time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIn(FieldSynthesizedAt, vs...))
+}
+
+// SynthesizedAtNotIn applies the NotIn predicate on the "synthesized_at" field.
+func SynthesizedAtNotIn(vs ...time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotIn(FieldSynthesizedAt, vs...))
+}
+
+// SynthesizedAtGT applies the GT predicate on the "synthesized_at" field.
+func SynthesizedAtGT(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGT(FieldSynthesizedAt, v))
+}
+
+// SynthesizedAtGTE applies the GTE predicate on the "synthesized_at" field.
+func SynthesizedAtGTE(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldGTE(FieldSynthesizedAt, v))
+}
+
+// SynthesizedAtLT applies the LT predicate on the "synthesized_at" field.
+func SynthesizedAtLT(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLT(FieldSynthesizedAt, v))
+}
+
+// SynthesizedAtLTE applies the LTE predicate on the "synthesized_at" field.
+func SynthesizedAtLTE(v time.Time) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldLTE(FieldSynthesizedAt, v))
+}
+
+// SynthesizedAtIsNil applies the IsNil predicate on the "synthesized_at" field.
+func SynthesizedAtIsNil() predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldIsNull(FieldSynthesizedAt))
+}
+
+// SynthesizedAtNotNil applies the NotNil predicate on the "synthesized_at" field.
+func SynthesizedAtNotNil() predicate.SessionGroup {
+	return predicate.SessionGroup(sql.FieldNotNull(FieldSynthesizedAt))
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.SessionGroup {
+	return predicate.SessionGroup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.AlertSession) predicate.SessionGroup {
+	return predicate.SessionGroup(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SessionGroup) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SessionGroup) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SessionGroup) predicate.SessionGroup {
+	return predicate.SessionGroup(sql.NotPredicates(p))
+}
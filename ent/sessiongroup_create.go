@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
+)
+
+// SessionGroupCreate is the builder for creating a SessionGroup entity.
+type SessionGroupCreate struct {
+	config
+	mutation *SessionGroupMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SessionGroupCreate) SetCreatedAt(v time.Time) *SessionGroupCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SessionGroupCreate) SetNillableCreatedAt(v *time.Time) *SessionGroupCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SessionGroupCreate) SetUpdatedAt(v time.Time) *SessionGroupCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SessionGroupCreate) SetNillableUpdatedAt(v *time.Time) *SessionGroupCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAlertType sets the "alert_type" field.
+func (_c *SessionGroupCreate) SetAlertType(v string) *SessionGroupCreate {
+	_c.mutation.SetAlertType(v)
+	return _c
+}
+
+// SetNillableAlertType sets the "alert_type" field if the given value is not nil.
+func (_c *SessionGroupCreate) SetNillableAlertType(v *string) *SessionGroupCreate {
+	if v != nil {
+		_c.SetAlertType(*v)
+	}
+	return _c
+}
+
+// SetPrimaryChainID sets the "primary_chain_id" field.
+func (_c *SessionGroupCreate) SetPrimaryChainID(v string) *SessionGroupCreate {
+	_c.mutation.SetPrimaryChainID(v)
+	return _c
+}
+
+// SetSynthesisStatus sets the "synthesis_status" field.
+func (_c *SessionGroupCreate) SetSynthesisStatus(v sessiongroup.SynthesisStatus) *SessionGroupCreate {
+	_c.mutation.SetSynthesisStatus(v)
+	return _c
+}
+
+// SetNillableSynthesisStatus sets the "synthesis_status" field if the given value is not nil.
+func (_c *SessionGroupCreate) SetNillableSynthesisStatus(v *sessiongroup.SynthesisStatus) *SessionGroupCreate {
+	if v != nil {
+		_c.SetSynthesisStatus(*v)
+	}
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *SessionGroupCreate) SetSummary(v string) *SessionGroupCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *SessionGroupCreate) SetNillableSummary(v *string) *SessionGroupCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetSummaryError sets the "summary_error" field.
+func (_c *SessionGroupCreate) SetSummaryError(v string) *SessionGroupCreate {
+	_c.mutation.SetSummaryError(v)
+	return _c
+}
+
+// SetNillableSummaryError sets the "summary_error" field if the given value is not nil.
+func (_c *SessionGroupCreate) SetNillableSummaryError(v *string) *SessionGroupCreate {
+	if v != nil {
+		_c.SetSummaryError(*v)
+	}
+	return _c
+}
+
+// SetSynthesizedAt sets the "synthesized_at" field.
+func (_c *SessionGroupCreate) SetSynthesizedAt(v time.Time) *SessionGroupCreate {
+	_c.mutation.SetSynthesizedAt(v)
+	return _c
+}
+
+// SetNillableSynthesizedAt sets the "synthesized_at" field if the given value is not nil.
+func (_c *SessionGroupCreate) SetNillableSynthesizedAt(v *time.Time) *SessionGroupCreate {
+	if v != nil {
+		_c.SetSynthesizedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SessionGroupCreate) SetID(v string) *SessionGroupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddSessionIDs adds the "sessions" edge to the AlertSession entity by IDs.
+func (_c *SessionGroupCreate) AddSessionIDs(ids ...string) *SessionGroupCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the AlertSession entity.
+func (_c *SessionGroupCreate) AddSessions(v ...*AlertSession) *SessionGroupCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// Mutation returns the SessionGroupMutation object of the builder.
+func (_c *SessionGroupCreate) Mutation() *SessionGroupMutation {
+	return _c.mutation
+}
+
+// Save creates the SessionGroup in the database.
+func (_c *SessionGroupCreate) Save(ctx context.Context) (*SessionGroup, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SessionGroupCreate) SaveX(ctx context.Context) *SessionGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SessionGroupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SessionGroupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SessionGroupCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sessiongroup.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := sessiongroup.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SynthesisStatus(); !ok {
+		v := sessiongroup.DefaultSynthesisStatus
+		_c.mutation.SetSynthesisStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SessionGroupCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SessionGroup.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SessionGroup.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PrimaryChainID(); !ok {
+		return &ValidationError{Name: "primary_chain_id", err: errors.New(`ent: missing required field "SessionGroup.primary_chain_id"`)}
+	}
+	if _, ok := _c.mutation.SynthesisStatus(); !ok {
+		return &ValidationError{Name: "synthesis_status", err: errors.New(`ent: missing required field "SessionGroup.synthesis_status"`)}
+	}
+	if v, ok := _c.mutation.SynthesisStatus(); ok {
+		if err := sessiongroup.SynthesisStatusValidator(v); err != nil {
+			return &ValidationError{Name: "synthesis_status", err: fmt.Errorf(`ent: validator failed for field "SessionGroup.synthesis_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *SessionGroupCreate) sqlSave(ctx context.Context) (*SessionGroup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SessionGroup.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SessionGroupCreate) createSpec() (*SessionGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SessionGroup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sessiongroup.Table, sqlgraph.NewFieldSpec(sessiongroup.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sessiongroup.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessiongroup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.AlertType(); ok {
+		_spec.SetField(sessiongroup.FieldAlertType, field.TypeString, value)
+		_node.AlertType = value
+	}
+	if value, ok := _c.mutation.PrimaryChainID(); ok {
+		_spec.SetField(sessiongroup.FieldPrimaryChainID, field.TypeString, value)
+		_node.PrimaryChainID = value
+	}
+	if value, ok := _c.mutation.SynthesisStatus(); ok {
+		_spec.SetField(sessiongroup.FieldSynthesisStatus, field.TypeEnum, value)
+		_node.SynthesisStatus = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(sessiongroup.FieldSummary, field.TypeString, value)
+		_node.Summary = &value
+	}
+	if value, ok := _c.mutation.SummaryError(); ok {
+		_spec.SetField(sessiongroup.FieldSummaryError, field.TypeString, value)
+		_node.SummaryError = &value
+	}
+	if value, ok := _c.mutation.SynthesizedAt(); ok {
+		_spec.SetField(sessiongroup.FieldSynthesizedAt, field.TypeTime, value)
+		_node.SynthesizedAt = &value
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sessiongroup.SessionsTable,
+			Columns: []string{sessiongroup.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SessionGroupCreateBulk is the builder for creating many SessionGroup entities in bulk.
+type SessionGroupCreateBulk struct {
+	config
+	err      error
+	builders []*SessionGroupCreate
+}
+
+// Save creates the SessionGroup entities in the database.
+func (_c *SessionGroupCreateBulk) Save(ctx context.Context) ([]*SessionGroup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SessionGroup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SessionGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SessionGroupCreateBulk) SaveX(ctx context.Context) []*SessionGroup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SessionGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SessionGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
+)
+
+// SessionGroupUpdate is the builder for updating SessionGroup entities.
+type SessionGroupUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SessionGroupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SessionGroupUpdate builder.
+func (_u *SessionGroupUpdate) Where(ps ...predicate.SessionGroup) *SessionGroupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SessionGroupUpdate) SetUpdatedAt(v time.Time) *SessionGroupUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSynthesisStatus sets the "synthesis_status" field.
+func (_u *SessionGroupUpdate) SetSynthesisStatus(v sessiongroup.SynthesisStatus) *SessionGroupUpdate {
+	_u.mutation.SetSynthesisStatus(v)
+	return _u
+}
+
+// SetNillableSynthesisStatus sets the "synthesis_status" field if the given value is not nil.
+func (_u *SessionGroupUpdate) SetNillableSynthesisStatus(v *sessiongroup.SynthesisStatus) *SessionGroupUpdate {
+	if v != nil {
+		_u.SetSynthesisStatus(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *SessionGroupUpdate) SetSummary(v string) *SessionGroupUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *SessionGroupUpdate) SetNillableSummary(v *string) *SessionGroupUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *SessionGroupUpdate) ClearSummary() *SessionGroupUpdate {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetSummaryError sets the "summary_error" field.
+func (_u *SessionGroupUpdate) SetSummaryError(v string) *SessionGroupUpdate {
+	_u.mutation.SetSummaryError(v)
+	return _u
+}
+
+// SetNillableSummaryError sets the "summary_error" field if the given value is not nil.
+func (_u *SessionGroupUpdate) SetNillableSummaryError(v *string) *SessionGroupUpdate {
+	if v != nil {
+		_u.SetSummaryError(*v)
+	}
+	return _u
+}
+
+// ClearSummaryError clears the value of the "summary_error" field.
+func (_u *SessionGroupUpdate) ClearSummaryError() *SessionGroupUpdate {
+	_u.mutation.ClearSummaryError()
+	return _u
+}
+
+// SetSynthesizedAt sets the "synthesized_at" field.
+func (_u *SessionGroupUpdate) SetSynthesizedAt(v time.Time) *SessionGroupUpdate {
+	_u.mutation.SetSynthesizedAt(v)
+	return _u
+}
+
+// SetNillableSynthesizedAt sets the "synthesized_at" field if the given value is not nil.
+func (_u *SessionGroupUpdate) SetNillableSynthesizedAt(v *time.Time) *SessionGroupUpdate {
+	if v != nil {
+		_u.SetSynthesizedAt(*v)
+	}
+	return _u
+}
+
+// ClearSynthesizedAt clears the value of the "synthesized_at" field.
+func (_u *SessionGroupUpdate) ClearSynthesizedAt() *SessionGroupUpdate {
+	_u.mutation.ClearSynthesizedAt()
+	return _u
+}
+
+// AddSessionIDs adds the "sessions" edge to the AlertSession entity by IDs.
+func (_u *SessionGroupUpdate) AddSessionIDs(ids ...string) *SessionGroupUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the AlertSession entity.
+func (_u *SessionGroupUpdate) AddSessions(v ...*AlertSession) *SessionGroupUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// Mutation returns the SessionGroupMutation object of the builder.
+func (_u *SessionGroupUpdate) Mutation() *SessionGroupMutation {
+	return _u.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the AlertSession entity.
+func (_u *SessionGroupUpdate) ClearSessions() *SessionGroupUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to AlertSession entities by IDs.
+func (_u *SessionGroupUpdate) RemoveSessionIDs(ids ...string) *SessionGroupUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to AlertSession entities.
+func (_u *SessionGroupUpdate) RemoveSessions(v ...*AlertSession) *SessionGroupUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SessionGroupUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SessionGroupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionGroupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SessionGroupUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sessiongroup.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionGroupUpdate) check() error {
+	if v, ok := _u.mutation.SynthesisStatus(); ok {
+		if err := sessiongroup.SynthesisStatusValidator(v); err != nil {
+			return &ValidationError{Name: "synthesis_status", err: fmt.Errorf(`ent: validator failed for field "SessionGroup.synthesis_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SessionGroupUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SessionGroupUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SessionGroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sessiongroup.Table, sessiongroup.Columns, sqlgraph.NewFieldSpec(sessiongroup.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessiongroup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AlertTypeCleared() {
+		_spec.ClearField(sessiongroup.FieldAlertType, field.TypeString)
+	}
+	if value, ok := _u.mutation.SynthesisStatus(); ok {
+		_spec.SetField(sessiongroup.FieldSynthesisStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(sessiongroup.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(sessiongroup.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.SummaryError(); ok {
+		_spec.SetField(sessiongroup.FieldSummaryError, field.TypeString, value)
+	}
+	if _u.mutation.SummaryErrorCleared() {
+		_spec.ClearField(sessiongroup.FieldSummaryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SynthesizedAt(); ok {
+		_spec.SetField(sessiongroup.FieldSynthesizedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SynthesizedAtCleared() {
+		_spec.ClearField(sessiongroup.FieldSynthesizedAt, field.TypeTime)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sessiongroup.SessionsTable,
+			Columns: []string{sessiongroup.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sessiongroup.SessionsTable,
+			Columns: []string{sessiongroup.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sessiongroup.SessionsTable,
+			Columns: []string{sessiongroup.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessiongroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SessionGroupUpdateOne is the builder for updating a single SessionGroup entity.
+type SessionGroupUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SessionGroupMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SessionGroupUpdateOne) SetUpdatedAt(v time.Time) *SessionGroupUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSynthesisStatus sets the "synthesis_status" field.
+func (_u *SessionGroupUpdateOne) SetSynthesisStatus(v sessiongroup.SynthesisStatus) *SessionGroupUpdateOne {
+	_u.mutation.SetSynthesisStatus(v)
+	return _u
+}
+
+// SetNillableSynthesisStatus sets the "synthesis_status" field if the given value is not nil.
+func (_u *SessionGroupUpdateOne) SetNillableSynthesisStatus(v *sessiongroup.SynthesisStatus) *SessionGroupUpdateOne {
+	if v != nil {
+		_u.SetSynthesisStatus(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *SessionGroupUpdateOne) SetSummary(v string) *SessionGroupUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *SessionGroupUpdateOne) SetNillableSummary(v *string) *SessionGroupUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *SessionGroupUpdateOne) ClearSummary() *SessionGroupUpdateOne {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetSummaryError sets the "summary_error" field.
+func (_u *SessionGroupUpdateOne) SetSummaryError(v string) *SessionGroupUpdateOne {
+	_u.mutation.SetSummaryError(v)
+	return _u
+}
+
+// SetNillableSummaryError sets the "summary_error" field if the given value is not nil.
+func (_u *SessionGroupUpdateOne) SetNillableSummaryError(v *string) *SessionGroupUpdateOne {
+	if v != nil {
+		_u.SetSummaryError(*v)
+	}
+	return _u
+}
+
+// ClearSummaryError clears the value of the "summary_error" field.
+func (_u *SessionGroupUpdateOne) ClearSummaryError() *SessionGroupUpdateOne {
+	_u.mutation.ClearSummaryError()
+	return _u
+}
+
+// SetSynthesizedAt sets the "synthesized_at" field.
+func (_u *SessionGroupUpdateOne) SetSynthesizedAt(v time.Time) *SessionGroupUpdateOne {
+	_u.mutation.SetSynthesizedAt(v)
+	return _u
+}
+
+// SetNillableSynthesizedAt sets the "synthesized_at" field if the given value is not nil.
+func (_u *SessionGroupUpdateOne) SetNillableSynthesizedAt(v *time.Time) *SessionGroupUpdateOne {
+	if v != nil {
+		_u.SetSynthesizedAt(*v)
+	}
+	return _u
+}
+
+// ClearSynthesizedAt clears the value of the "synthesized_at" field.
+func (_u *SessionGroupUpdateOne) ClearSynthesizedAt() *SessionGroupUpdateOne {
+	_u.mutation.ClearSynthesizedAt()
+	return _u
+}
+
+// AddSessionIDs adds the "sessions" edge to the AlertSession entity by IDs.
+func (_u *SessionGroupUpdateOne) AddSessionIDs(ids ...string) *SessionGroupUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the AlertSession entity.
+func (_u *SessionGroupUpdateOne) AddSessions(v ...*AlertSession) *SessionGroupUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// Mutation returns the SessionGroupMutation object of the builder.
+func (_u *SessionGroupUpdateOne) Mutation() *SessionGroupMutation {
+	return _u.mutation
+}
+
+// ClearSessions clears all "sessions" edges to the AlertSession entity.
+func (_u *SessionGroupUpdateOne) ClearSessions() *SessionGroupUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to AlertSession entities by IDs.
+func (_u *SessionGroupUpdateOne) RemoveSessionIDs(ids ...string) *SessionGroupUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to AlertSession entities.
+func (_u *SessionGroupUpdateOne) RemoveSessions(v ...*AlertSession) *SessionGroupUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// Where appends a list predicates to the SessionGroupUpdate builder.
+func (_u *SessionGroupUpdateOne) Where(ps ...predicate.SessionGroup) *SessionGroupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SessionGroupUpdateOne) Select(field string, fields ...string) *SessionGroupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SessionGroup entity.
+func (_u *SessionGroupUpdateOne) Save(ctx context.Context) (*SessionGroup, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionGroupUpdateOne) SaveX(ctx context.Context) *SessionGroup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SessionGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SessionGroupUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sessiongroup.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionGroupUpdateOne) check() error {
+	if v, ok := _u.mutation.SynthesisStatus(); ok {
+		if err := sessiongroup.SynthesisStatusValidator(v); err != nil {
+			return &ValidationError{Name: "synthesis_status", err: fmt.Errorf(`ent: validator failed for field "SessionGroup.synthesis_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SessionGroupUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SessionGroupUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SessionGroupUpdateOne) sqlSave(ctx context.Context) (_node *SessionGroup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sessiongroup.Table, sessiongroup.Columns, sqlgraph.NewFieldSpec(sessiongroup.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SessionGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sessiongroup.FieldID)
+		for _, f := range fields {
+			if !sessiongroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sessiongroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sessiongroup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AlertTypeCleared() {
+		_spec.ClearField(sessiongroup.FieldAlertType, field.TypeString)
+	}
+	if value, ok := _u.mutation.SynthesisStatus(); ok {
+		_spec.SetField(sessiongroup.FieldSynthesisStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(sessiongroup.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(sessiongroup.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.SummaryError(); ok {
+		_spec.SetField(sessiongroup.FieldSummaryError, field.TypeString, value)
+	}
+	if _u.mutation.SummaryErrorCleared() {
+		_spec.ClearField(sessiongroup.FieldSummaryError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SynthesizedAt(); ok {
+		_spec.SetField(sessiongroup.FieldSynthesizedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SynthesizedAtCleared() {
+		_spec.ClearField(sessiongroup.FieldSynthesizedAt, field.TypeTime)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sessiongroup.SessionsTable,
+			Columns: []string{sessiongroup.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sessiongroup.SessionsTable,
+			Columns: []string{sessiongroup.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sessiongroup.SessionsTable,
+			Columns: []string{sessiongroup.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(alertsession.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SessionGroup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sessiongroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
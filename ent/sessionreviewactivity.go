@@ -18,6 +18,8 @@ type SessionReviewActivity struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// SessionID holds the value of the "session_id" field.
 	SessionID string `json:"session_id,omitempty"`
 	// User who performed the action (X-Forwarded-User)
@@ -34,8 +36,6 @@ type SessionReviewActivity struct {
 	Note *string `json:"note,omitempty"`
 	// Investigation feedback snapshot
 	InvestigationFeedback *string `json:"investigation_feedback,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SessionReviewActivityQuery when eager-loading is set.
 	Edges        SessionReviewActivityEdges `json:"edges"`
@@ -92,6 +92,12 @@ func (_m *SessionReviewActivity) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case sessionreviewactivity.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
 		case sessionreviewactivity.FieldSessionID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field session_id", values[i])
@@ -144,12 +150,6 @@ func (_m *SessionReviewActivity) assignValues(columns []string, values []any) er
 				_m.InvestigationFeedback = new(string)
 				*_m.InvestigationFeedback = value.String
 			}
-		case sessionreviewactivity.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
-			} else if value.Valid {
-				_m.CreatedAt = value.Time
-			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -191,6 +191,9 @@ func (_m *SessionReviewActivity) String() string {
 	var builder strings.Builder
 	builder.WriteString("SessionReviewActivity(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("session_id=")
 	builder.WriteString(_m.SessionID)
 	builder.WriteString(", ")
@@ -222,9 +225,6 @@ func (_m *SessionReviewActivity) String() string {
 		builder.WriteString("investigation_feedback=")
 		builder.WriteString(*v)
 	}
-	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -15,6 +15,8 @@ const (
 	Label = "session_review_activity"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "activity_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// FieldSessionID holds the string denoting the session_id field in the database.
 	FieldSessionID = "session_id"
 	// FieldActor holds the string denoting the actor field in the database.
@@ -31,8 +33,6 @@ const (
 	FieldNote = "note"
 	// FieldInvestigationFeedback holds the string denoting the investigation_feedback field in the database.
 	FieldInvestigationFeedback = "investigation_feedback"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
 	// EdgeSession holds the string denoting the session edge name in mutations.
 	EdgeSession = "session"
 	// AlertSessionFieldID holds the string denoting the ID field of the AlertSession.
@@ -51,6 +51,7 @@ const (
 // Columns holds all SQL columns for sessionreviewactivity fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
 	FieldSessionID,
 	FieldActor,
 	FieldAction,
@@ -59,7 +60,6 @@ var Columns = []string{
 	FieldQualityRating,
 	FieldNote,
 	FieldInvestigationFeedback,
-	FieldCreatedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -184,6 +184,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
 // BySessionID orders the results by the session_id field.
 func BySessionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
@@ -224,11 +229,6 @@ func ByInvestigationFeedback(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInvestigationFeedback, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
-}
-
 // BySessionField orders the results by session field.
 func BySessionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
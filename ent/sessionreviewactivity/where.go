@@ -65,6 +65,11 @@ func IDContainsFold(id string) predicate.SessionReviewActivity {
 	return predicate.SessionReviewActivity(sql.FieldContainsFold(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SessionReviewActivity {
+	return predicate.SessionReviewActivity(sql.FieldEQ(FieldCreatedAt, v))
+}
+
 // SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
 func SessionID(v string) predicate.SessionReviewActivity {
 	return predicate.SessionReviewActivity(sql.FieldEQ(FieldSessionID, v))
@@ -85,11 +90,46 @@ func InvestigationFeedback(v string) predicate.SessionReviewActivity {
 	return predicate.SessionReviewActivity(sql.FieldEQ(FieldInvestigationFeedback, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.SessionReviewActivity {
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SessionReviewActivity {
 	return predicate.SessionReviewActivity(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SessionReviewActivity {
+	return predicate.SessionReviewActivity(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SessionReviewActivity {
+	return predicate.SessionReviewActivity(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SessionReviewActivity {
+	return predicate.SessionReviewActivity(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SessionReviewActivity {
+	return predicate.SessionReviewActivity(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SessionReviewActivity {
+	return predicate.SessionReviewActivity(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SessionReviewActivity {
+	return predicate.SessionReviewActivity(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SessionReviewActivity {
+	return predicate.SessionReviewActivity(sql.FieldLTE(FieldCreatedAt, v))
+}
+
 // SessionIDEQ applies the EQ predicate on the "session_id" field.
 func SessionIDEQ(v string) predicate.SessionReviewActivity {
 	return predicate.SessionReviewActivity(sql.FieldEQ(FieldSessionID, v))
@@ -470,46 +510,6 @@ func InvestigationFeedbackContainsFold(v string) predicate.SessionReviewActivity
 	return predicate.SessionReviewActivity(sql.FieldContainsFold(FieldInvestigationFeedback, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.SessionReviewActivity {
-	return predicate.SessionReviewActivity(sql.FieldEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.SessionReviewActivity {
-	return predicate.SessionReviewActivity(sql.FieldNEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.SessionReviewActivity {
-	return predicate.SessionReviewActivity(sql.FieldIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.SessionReviewActivity {
-	return predicate.SessionReviewActivity(sql.FieldNotIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.SessionReviewActivity {
-	return predicate.SessionReviewActivity(sql.FieldGT(FieldCreatedAt, v))
-}
-
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.SessionReviewActivity {
-	return predicate.SessionReviewActivity(sql.FieldGTE(FieldCreatedAt, v))
-}
-
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.SessionReviewActivity {
-	return predicate.SessionReviewActivity(sql.FieldLT(FieldCreatedAt, v))
-}
-
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.SessionReviewActivity {
-	return predicate.SessionReviewActivity(sql.FieldLTE(FieldCreatedAt, v))
-}
-
 // HasSession applies the HasEdge predicate on the "session" edge.
 func HasSession() predicate.SessionReviewActivity {
 	return predicate.SessionReviewActivity(func(s *sql.Selector) {
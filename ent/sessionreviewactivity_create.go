@@ -21,6 +21,20 @@ type SessionReviewActivityCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *SessionReviewActivityCreate) SetCreatedAt(v time.Time) *SessionReviewActivityCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SessionReviewActivityCreate) SetNillableCreatedAt(v *time.Time) *SessionReviewActivityCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // SetSessionID sets the "session_id" field.
 func (_c *SessionReviewActivityCreate) SetSessionID(v string) *SessionReviewActivityCreate {
 	_c.mutation.SetSessionID(v)
@@ -101,20 +115,6 @@ func (_c *SessionReviewActivityCreate) SetNillableInvestigationFeedback(v *strin
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *SessionReviewActivityCreate) SetCreatedAt(v time.Time) *SessionReviewActivityCreate {
-	_c.mutation.SetCreatedAt(v)
-	return _c
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *SessionReviewActivityCreate) SetNillableCreatedAt(v *time.Time) *SessionReviewActivityCreate {
-	if v != nil {
-		_c.SetCreatedAt(*v)
-	}
-	return _c
-}
-
 // SetID sets the "id" field.
 func (_c *SessionReviewActivityCreate) SetID(v string) *SessionReviewActivityCreate {
 	_c.mutation.SetID(v)
@@ -169,6 +169,9 @@ func (_c *SessionReviewActivityCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *SessionReviewActivityCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SessionReviewActivity.created_at"`)}
+	}
 	if _, ok := _c.mutation.SessionID(); !ok {
 		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "SessionReviewActivity.session_id"`)}
 	}
@@ -201,9 +204,6 @@ func (_c *SessionReviewActivityCreate) check() error {
 			return &ValidationError{Name: "quality_rating", err: fmt.Errorf(`ent: validator failed for field "SessionReviewActivity.quality_rating": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SessionReviewActivity.created_at"`)}
-	}
 	if len(_c.mutation.SessionIDs()) == 0 {
 		return &ValidationError{Name: "session", err: errors.New(`ent: missing required edge "SessionReviewActivity.session"`)}
 	}
@@ -242,6 +242,10 @@ func (_c *SessionReviewActivityCreate) createSpec() (*SessionReviewActivity, *sq
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sessionreviewactivity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	if value, ok := _c.mutation.Actor(); ok {
 		_spec.SetField(sessionreviewactivity.FieldActor, field.TypeString, value)
 		_node.Actor = value
@@ -270,10 +274,6 @@ func (_c *SessionReviewActivityCreate) createSpec() (*SessionReviewActivity, *sq
 		_spec.SetField(sessionreviewactivity.FieldInvestigationFeedback, field.TypeString, value)
 		_node.InvestigationFeedback = &value
 	}
-	if value, ok := _c.mutation.CreatedAt(); ok {
-		_spec.SetField(sessionreviewactivity.FieldCreatedAt, field.TypeTime, value)
-		_node.CreatedAt = value
-	}
 	if nodes := _c.mutation.SessionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -301,12 +301,12 @@ func (_q *SessionReviewActivityQuery) WithSession(opts ...func(*AlertSessionQuer
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.SessionReviewActivity.Query().
-//		GroupBy(sessionreviewactivity.FieldSessionID).
+//		GroupBy(sessionreviewactivity.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *SessionReviewActivityQuery) GroupBy(field string, fields ...string) *SessionReviewActivityGroupBy {
@@ -324,11 +324,11 @@ func (_q *SessionReviewActivityQuery) GroupBy(field string, fields ...string) *S
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.SessionReviewActivity.Query().
-//		Select(sessionreviewactivity.FieldSessionID).
+//		Select(sessionreviewactivity.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *SessionReviewActivityQuery) Select(fields ...string) *SessionReviewActivitySelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -246,12 +246,13 @@ const DefaultStageType = StageTypeInvestigation
 
 // StageType values.
 const (
-	StageTypeInvestigation StageType = "investigation"
-	StageTypeSynthesis     StageType = "synthesis"
-	StageTypeChat          StageType = "chat"
-	StageTypeExecSummary   StageType = "exec_summary"
-	StageTypeScoring       StageType = "scoring"
-	StageTypeAction        StageType = "action"
+	StageTypeInvestigation    StageType = "investigation"
+	StageTypeSynthesis        StageType = "synthesis"
+	StageTypeChat             StageType = "chat"
+	StageTypeExecSummary      StageType = "exec_summary"
+	StageTypeTechnicalSummary StageType = "technical_summary"
+	StageTypeScoring          StageType = "scoring"
+	StageTypeAction           StageType = "action"
 )
 
 func (st StageType) String() string {
@@ -261,7 +262,7 @@ func (st StageType) String() string {
 // StageTypeValidator is a validator for the "stage_type" field enum values. It is called by the builders before save.
 func StageTypeValidator(st StageType) error {
 	switch st {
-	case StageTypeInvestigation, StageTypeSynthesis, StageTypeChat, StageTypeExecSummary, StageTypeScoring, StageTypeAction:
+	case StageTypeInvestigation, StageTypeSynthesis, StageTypeChat, StageTypeExecSummary, StageTypeTechnicalSummary, StageTypeScoring, StageTypeAction:
 		return nil
 	default:
 		return fmt.Errorf("stage: invalid enum value for stage_type field: %q", st)
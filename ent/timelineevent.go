@@ -23,6 +23,10 @@ type TimelineEvent struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// SessionID holds the value of the "session_id" field.
 	SessionID string `json:"session_id,omitempty"`
 	// Stage grouping — nil for session-level events (e.g. executive_summary)
@@ -33,16 +37,16 @@ type TimelineEvent struct {
 	ParentExecutionID *string `json:"parent_execution_id,omitempty"`
 	// Order in timeline
 	SequenceNumber int `json:"sequence_number,omitempty"`
-	// Creation timestamp
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	// Last update (for streaming)
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// EventType holds the value of the "event_type" field.
 	EventType timelineevent.EventType `json:"event_type,omitempty"`
 	// Status holds the value of the "status" field.
 	Status timelineevent.Status `json:"status,omitempty"`
+	// Highlight marker for condensed timeline views; NULL for ordinary events
+	Highlight *timelineevent.Highlight `json:"highlight,omitempty"`
 	// Event content (grows during streaming, updateable on completion)
 	Content string `json:"content,omitempty"`
+	// zstd-compressed content for large events; content is empty when set
+	ContentZstd *[]byte `json:"content_zstd,omitempty"`
 	// Type-specific data (tool_name, server_name, etc.)
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 	// Link to trace details
@@ -145,11 +149,11 @@ func (*TimelineEvent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case timelineevent.FieldMetadata:
+		case timelineevent.FieldContentZstd, timelineevent.FieldMetadata:
 			values[i] = new([]byte)
 		case timelineevent.FieldSequenceNumber:
 			values[i] = new(sql.NullInt64)
-		case timelineevent.FieldID, timelineevent.FieldSessionID, timelineevent.FieldStageID, timelineevent.FieldExecutionID, timelineevent.FieldParentExecutionID, timelineevent.FieldEventType, timelineevent.FieldStatus, timelineevent.FieldContent, timelineevent.FieldLlmInteractionID, timelineevent.FieldMcpInteractionID:
+		case timelineevent.FieldID, timelineevent.FieldSessionID, timelineevent.FieldStageID, timelineevent.FieldExecutionID, timelineevent.FieldParentExecutionID, timelineevent.FieldEventType, timelineevent.FieldStatus, timelineevent.FieldHighlight, timelineevent.FieldContent, timelineevent.FieldLlmInteractionID, timelineevent.FieldMcpInteractionID:
 			values[i] = new(sql.NullString)
 		case timelineevent.FieldCreatedAt, timelineevent.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -174,6 +178,18 @@ func (_m *TimelineEvent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case timelineevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case timelineevent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
 		case timelineevent.FieldSessionID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field session_id", values[i])
@@ -207,18 +223,6 @@ func (_m *TimelineEvent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SequenceNumber = int(value.Int64)
 			}
-		case timelineevent.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
-			} else if value.Valid {
-				_m.CreatedAt = value.Time
-			}
-		case timelineevent.FieldUpdatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
-			} else if value.Valid {
-				_m.UpdatedAt = value.Time
-			}
 		case timelineevent.FieldEventType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field event_type", values[i])
@@ -231,12 +235,25 @@ func (_m *TimelineEvent) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = timelineevent.Status(value.String)
 			}
+		case timelineevent.FieldHighlight:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field highlight", values[i])
+			} else if value.Valid {
+				_m.Highlight = new(timelineevent.Highlight)
+				*_m.Highlight = timelineevent.Highlight(value.String)
+			}
 		case timelineevent.FieldContent:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field content", values[i])
 			} else if value.Valid {
 				_m.Content = value.String
 			}
+		case timelineevent.FieldContentZstd:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field content_zstd", values[i])
+			} else if value != nil {
+				_m.ContentZstd = value
+			}
 		case timelineevent.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
@@ -325,6 +342,12 @@ func (_m *TimelineEvent) String() string {
 	var builder strings.Builder
 	builder.WriteString("TimelineEvent(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("session_id=")
 	builder.WriteString(_m.SessionID)
 	builder.WriteString(", ")
@@ -346,21 +369,25 @@ func (_m *TimelineEvent) String() string {
 	builder.WriteString("sequence_number=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SequenceNumber))
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
-	builder.WriteString("updated_at=")
-	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
 	builder.WriteString("event_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EventType))
 	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	if v := _m.Highlight; v != nil {
+		builder.WriteString("highlight=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("content=")
 	builder.WriteString(_m.Content)
 	builder.WriteString(", ")
+	if v := _m.ContentZstd; v != nil {
+		builder.WriteString("content_zstd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteString(", ")
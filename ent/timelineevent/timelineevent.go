@@ -15,6 +15,10 @@ const (
 	Label = "timeline_event"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "event_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
 	// FieldSessionID holds the string denoting the session_id field in the database.
 	FieldSessionID = "session_id"
 	// FieldStageID holds the string denoting the stage_id field in the database.
@@ -25,16 +29,16 @@ const (
 	FieldParentExecutionID = "parent_execution_id"
 	// FieldSequenceNumber holds the string denoting the sequence_number field in the database.
 	FieldSequenceNumber = "sequence_number"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
-	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
-	FieldUpdatedAt = "updated_at"
 	// FieldEventType holds the string denoting the event_type field in the database.
 	FieldEventType = "event_type"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldHighlight holds the string denoting the highlight field in the database.
+	FieldHighlight = "highlight"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
+	// FieldContentZstd holds the string denoting the content_zstd field in the database.
+	FieldContentZstd = "content_zstd"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
 	// FieldLlmInteractionID holds the string denoting the llm_interaction_id field in the database.
@@ -112,16 +116,18 @@ const (
 // Columns holds all SQL columns for timelineevent fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
 	FieldSessionID,
 	FieldStageID,
 	FieldExecutionID,
 	FieldParentExecutionID,
 	FieldSequenceNumber,
-	FieldCreatedAt,
-	FieldUpdatedAt,
 	FieldEventType,
 	FieldStatus,
+	FieldHighlight,
 	FieldContent,
+	FieldContentZstd,
 	FieldMetadata,
 	FieldLlmInteractionID,
 	FieldMcpInteractionID,
@@ -166,6 +172,9 @@ const (
 	EventTypeProviderFallback   EventType = "provider_fallback"
 	EventTypeSkillLoaded        EventType = "skill_loaded"
 	EventTypeMemoryInjected     EventType = "memory_injected"
+	EventTypeOperatorNote       EventType = "operator_note"
+	EventTypeMcpServerDisabled  EventType = "mcp_server_disabled"
+	EventTypeCapabilityFallback EventType = "capability_fallback"
 )
 
 func (et EventType) String() string {
@@ -175,7 +184,7 @@ func (et EventType) String() string {
 // EventTypeValidator is a validator for the "event_type" field enum values. It is called by the builders before save.
 func EventTypeValidator(et EventType) error {
 	switch et {
-	case EventTypeLlmThinking, EventTypeLlmResponse, EventTypeLlmToolCall, EventTypeMcpToolSummary, EventTypeError, EventTypeUserQuestion, EventTypeExecutiveSummary, EventTypeFinalAnalysis, EventTypeCodeExecution, EventTypeGoogleSearchResult, EventTypeURLContextResult, EventTypeTaskAssigned, EventTypeProviderFallback, EventTypeSkillLoaded, EventTypeMemoryInjected:
+	case EventTypeLlmThinking, EventTypeLlmResponse, EventTypeLlmToolCall, EventTypeMcpToolSummary, EventTypeError, EventTypeUserQuestion, EventTypeExecutiveSummary, EventTypeFinalAnalysis, EventTypeCodeExecution, EventTypeGoogleSearchResult, EventTypeURLContextResult, EventTypeTaskAssigned, EventTypeProviderFallback, EventTypeSkillLoaded, EventTypeMemoryInjected, EventTypeOperatorNote, EventTypeMcpServerDisabled, EventTypeCapabilityFallback:
 		return nil
 	default:
 		return fmt.Errorf("timelineevent: invalid enum value for event_type field: %q", et)
@@ -211,6 +220,30 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// Highlight defines the type for the "highlight" enum field.
+type Highlight string
+
+// Highlight values.
+const (
+	HighlightKeyFinding Highlight = "key_finding"
+	HighlightError      Highlight = "error"
+	HighlightWarning    Highlight = "warning"
+)
+
+func (h Highlight) String() string {
+	return string(h)
+}
+
+// HighlightValidator is a validator for the "highlight" field enum values. It is called by the builders before save.
+func HighlightValidator(h Highlight) error {
+	switch h {
+	case HighlightKeyFinding, HighlightError, HighlightWarning:
+		return nil
+	default:
+		return fmt.Errorf("timelineevent: invalid enum value for highlight field: %q", h)
+	}
+}
+
 // OrderOption defines the ordering options for the TimelineEvent queries.
 type OrderOption func(*sql.Selector)
 
@@ -219,6 +252,16 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
 // BySessionID orders the results by the session_id field.
 func BySessionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
@@ -244,16 +287,6 @@ func BySequenceNumber(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSequenceNumber, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
-}
-
-// ByUpdatedAt orders the results by the updated_at field.
-func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
-}
-
 // ByEventType orders the results by the event_type field.
 func ByEventType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEventType, opts...).ToFunc()
@@ -264,6 +297,11 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByHighlight orders the results by the highlight field.
+func ByHighlight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHighlight, opts...).ToFunc()
+}
+
 // ByContent orders the results by the content field.
 func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
@@ -65,6 +65,16 @@ func IDContainsFold(id string) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldContainsFold(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
 func SessionID(v string) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldEQ(FieldSessionID, v))
@@ -90,21 +100,16 @@ func SequenceNumber(v int) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldEQ(FieldSequenceNumber, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldEQ(FieldCreatedAt, v))
-}
-
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldEQ(FieldUpdatedAt, v))
-}
-
 // Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
 func Content(v string) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldEQ(FieldContent, v))
 }
 
+// ContentZstd applies equality check predicate on the "content_zstd" field. It's identical to ContentZstdEQ.
+func ContentZstd(v []byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldEQ(FieldContentZstd, v))
+}
+
 // LlmInteractionID applies equality check predicate on the "llm_interaction_id" field. It's identical to LlmInteractionIDEQ.
 func LlmInteractionID(v string) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldEQ(FieldLlmInteractionID, v))
@@ -115,6 +120,86 @@ func McpInteractionID(v string) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldEQ(FieldMcpInteractionID, v))
 }
 
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
 // SessionIDEQ applies the EQ predicate on the "session_id" field.
 func SessionIDEQ(v string) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldEQ(FieldSessionID, v))
@@ -445,86 +530,6 @@ func SequenceNumberLTE(v int) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldLTE(FieldSequenceNumber, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldNEQ(FieldCreatedAt, v))
-}
-
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
-}
-
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldGT(FieldCreatedAt, v))
-}
-
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldGTE(FieldCreatedAt, v))
-}
-
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldLT(FieldCreatedAt, v))
-}
-
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldLTE(FieldCreatedAt, v))
-}
-
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldEQ(FieldUpdatedAt, v))
-}
-
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldNEQ(FieldUpdatedAt, v))
-}
-
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldIn(FieldUpdatedAt, vs...))
-}
-
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldNotIn(FieldUpdatedAt, vs...))
-}
-
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldGT(FieldUpdatedAt, v))
-}
-
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldGTE(FieldUpdatedAt, v))
-}
-
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldLT(FieldUpdatedAt, v))
-}
-
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.TimelineEvent {
-	return predicate.TimelineEvent(sql.FieldLTE(FieldUpdatedAt, v))
-}
-
 // EventTypeEQ applies the EQ predicate on the "event_type" field.
 func EventTypeEQ(v EventType) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldEQ(FieldEventType, v))
@@ -565,6 +570,36 @@ func StatusNotIn(vs ...Status) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// HighlightEQ applies the EQ predicate on the "highlight" field.
+func HighlightEQ(v Highlight) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldEQ(FieldHighlight, v))
+}
+
+// HighlightNEQ applies the NEQ predicate on the "highlight" field.
+func HighlightNEQ(v Highlight) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNEQ(FieldHighlight, v))
+}
+
+// HighlightIn applies the In predicate on the "highlight" field.
+func HighlightIn(vs ...Highlight) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldIn(FieldHighlight, vs...))
+}
+
+// HighlightNotIn applies the NotIn predicate on the "highlight" field.
+func HighlightNotIn(vs ...Highlight) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNotIn(FieldHighlight, vs...))
+}
+
+// HighlightIsNil applies the IsNil predicate on the "highlight" field.
+func HighlightIsNil() predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldIsNull(FieldHighlight))
+}
+
+// HighlightNotNil applies the NotNil predicate on the "highlight" field.
+func HighlightNotNil() predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNotNull(FieldHighlight))
+}
+
 // ContentEQ applies the EQ predicate on the "content" field.
 func ContentEQ(v string) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldEQ(FieldContent, v))
@@ -630,6 +665,56 @@ func ContentContainsFold(v string) predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldContainsFold(FieldContent, v))
 }
 
+// ContentZstdEQ applies the EQ predicate on the "content_zstd" field.
+func ContentZstdEQ(v []byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldEQ(FieldContentZstd, v))
+}
+
+// ContentZstdNEQ applies the NEQ predicate on the "content_zstd" field.
+func ContentZstdNEQ(v []byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNEQ(FieldContentZstd, v))
+}
+
+// ContentZstdIn applies the In predicate on the "content_zstd" field.
+func ContentZstdIn(vs ...[]byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldIn(FieldContentZstd, vs...))
+}
+
+// ContentZstdNotIn applies the NotIn predicate on the "content_zstd" field.
+func ContentZstdNotIn(vs ...[]byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNotIn(FieldContentZstd, vs...))
+}
+
+// ContentZstdGT applies the GT predicate on the "content_zstd" field.
+func ContentZstdGT(v []byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldGT(FieldContentZstd, v))
+}
+
+// ContentZstdGTE applies the GTE predicate on the "content_zstd" field.
+func ContentZstdGTE(v []byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldGTE(FieldContentZstd, v))
+}
+
+// ContentZstdLT applies the LT predicate on the "content_zstd" field.
+func ContentZstdLT(v []byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldLT(FieldContentZstd, v))
+}
+
+// ContentZstdLTE applies the LTE predicate on the "content_zstd" field.
+func ContentZstdLTE(v []byte) predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldLTE(FieldContentZstd, v))
+}
+
+// ContentZstdIsNil applies the IsNil predicate on the "content_zstd" field.
+func ContentZstdIsNil() predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldIsNull(FieldContentZstd))
+}
+
+// ContentZstdNotNil applies the NotNil predicate on the "content_zstd" field.
+func ContentZstdNotNil() predicate.TimelineEvent {
+	return predicate.TimelineEvent(sql.FieldNotNull(FieldContentZstd))
+}
+
 // MetadataIsNil applies the IsNil predicate on the "metadata" field.
 func MetadataIsNil() predicate.TimelineEvent {
 	return predicate.TimelineEvent(sql.FieldIsNull(FieldMetadata))
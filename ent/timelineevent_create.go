@@ -25,6 +25,34 @@ type TimelineEventCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *TimelineEventCreate) SetCreatedAt(v time.Time) *TimelineEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TimelineEventCreate) SetNillableCreatedAt(v *time.Time) *TimelineEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TimelineEventCreate) SetUpdatedAt(v time.Time) *TimelineEventCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TimelineEventCreate) SetNillableUpdatedAt(v *time.Time) *TimelineEventCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
 // SetSessionID sets the "session_id" field.
 func (_c *TimelineEventCreate) SetSessionID(v string) *TimelineEventCreate {
 	_c.mutation.SetSessionID(v)
@@ -79,34 +107,6 @@ func (_c *TimelineEventCreate) SetSequenceNumber(v int) *TimelineEventCreate {
 	return _c
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_c *TimelineEventCreate) SetCreatedAt(v time.Time) *TimelineEventCreate {
-	_c.mutation.SetCreatedAt(v)
-	return _c
-}
-
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_c *TimelineEventCreate) SetNillableCreatedAt(v *time.Time) *TimelineEventCreate {
-	if v != nil {
-		_c.SetCreatedAt(*v)
-	}
-	return _c
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_c *TimelineEventCreate) SetUpdatedAt(v time.Time) *TimelineEventCreate {
-	_c.mutation.SetUpdatedAt(v)
-	return _c
-}
-
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_c *TimelineEventCreate) SetNillableUpdatedAt(v *time.Time) *TimelineEventCreate {
-	if v != nil {
-		_c.SetUpdatedAt(*v)
-	}
-	return _c
-}
-
 // SetEventType sets the "event_type" field.
 func (_c *TimelineEventCreate) SetEventType(v timelineevent.EventType) *TimelineEventCreate {
 	_c.mutation.SetEventType(v)
@@ -127,12 +127,32 @@ func (_c *TimelineEventCreate) SetNillableStatus(v *timelineevent.Status) *Timel
 	return _c
 }
 
+// SetHighlight sets the "highlight" field.
+func (_c *TimelineEventCreate) SetHighlight(v timelineevent.Highlight) *TimelineEventCreate {
+	_c.mutation.SetHighlight(v)
+	return _c
+}
+
+// SetNillableHighlight sets the "highlight" field if the given value is not nil.
+func (_c *TimelineEventCreate) SetNillableHighlight(v *timelineevent.Highlight) *TimelineEventCreate {
+	if v != nil {
+		_c.SetHighlight(*v)
+	}
+	return _c
+}
+
 // SetContent sets the "content" field.
 func (_c *TimelineEventCreate) SetContent(v string) *TimelineEventCreate {
 	_c.mutation.SetContent(v)
 	return _c
 }
 
+// SetContentZstd sets the "content_zstd" field.
+func (_c *TimelineEventCreate) SetContentZstd(v []byte) *TimelineEventCreate {
+	_c.mutation.SetContentZstd(v)
+	return _c
+}
+
 // SetMetadata sets the "metadata" field.
 func (_c *TimelineEventCreate) SetMetadata(v map[string]interface{}) *TimelineEventCreate {
 	_c.mutation.SetMetadata(v)
@@ -268,18 +288,18 @@ func (_c *TimelineEventCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *TimelineEventCreate) check() error {
-	if _, ok := _c.mutation.SessionID(); !ok {
-		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "TimelineEvent.session_id"`)}
-	}
-	if _, ok := _c.mutation.SequenceNumber(); !ok {
-		return &ValidationError{Name: "sequence_number", err: errors.New(`ent: missing required field "TimelineEvent.sequence_number"`)}
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TimelineEvent.created_at"`)}
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TimelineEvent.updated_at"`)}
 	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "TimelineEvent.session_id"`)}
+	}
+	if _, ok := _c.mutation.SequenceNumber(); !ok {
+		return &ValidationError{Name: "sequence_number", err: errors.New(`ent: missing required field "TimelineEvent.sequence_number"`)}
+	}
 	if _, ok := _c.mutation.EventType(); !ok {
 		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "TimelineEvent.event_type"`)}
 	}
@@ -296,6 +316,11 @@ func (_c *TimelineEventCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Highlight(); ok {
+		if err := timelineevent.HighlightValidator(v); err != nil {
+			return &ValidationError{Name: "highlight", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.highlight": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Content(); !ok {
 		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "TimelineEvent.content"`)}
 	}
@@ -337,10 +362,6 @@ func (_c *TimelineEventCreate) createSpec() (*TimelineEvent, *sqlgraph.CreateSpe
 		_node.ID = id
 		_spec.ID.Value = id
 	}
-	if value, ok := _c.mutation.SequenceNumber(); ok {
-		_spec.SetField(timelineevent.FieldSequenceNumber, field.TypeInt, value)
-		_node.SequenceNumber = value
-	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(timelineevent.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -349,6 +370,10 @@ func (_c *TimelineEventCreate) createSpec() (*TimelineEvent, *sqlgraph.CreateSpe
 		_spec.SetField(timelineevent.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.SequenceNumber(); ok {
+		_spec.SetField(timelineevent.FieldSequenceNumber, field.TypeInt, value)
+		_node.SequenceNumber = value
+	}
 	if value, ok := _c.mutation.EventType(); ok {
 		_spec.SetField(timelineevent.FieldEventType, field.TypeEnum, value)
 		_node.EventType = value
@@ -357,10 +382,18 @@ func (_c *TimelineEventCreate) createSpec() (*TimelineEvent, *sqlgraph.CreateSpe
 		_spec.SetField(timelineevent.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.Highlight(); ok {
+		_spec.SetField(timelineevent.FieldHighlight, field.TypeEnum, value)
+		_node.Highlight = &value
+	}
 	if value, ok := _c.mutation.Content(); ok {
 		_spec.SetField(timelineevent.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := _c.mutation.ContentZstd(); ok {
+		_spec.SetField(timelineevent.FieldContentZstd, field.TypeBytes, value)
+		_node.ContentZstd = &value
+	}
 	if value, ok := _c.mutation.Metadata(); ok {
 		_spec.SetField(timelineevent.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
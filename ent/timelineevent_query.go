@@ -480,12 +480,12 @@ func (_q *TimelineEventQuery) WithMcpInteraction(opts ...func(*MCPInteractionQue
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.TimelineEvent.Query().
-//		GroupBy(timelineevent.FieldSessionID).
+//		GroupBy(timelineevent.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *TimelineEventQuery) GroupBy(field string, fields ...string) *TimelineEventGroupBy {
@@ -503,11 +503,11 @@ func (_q *TimelineEventQuery) GroupBy(field string, fields ...string) *TimelineE
 // Example:
 //
 //	var v []struct {
-//		SessionID string `json:"session_id,omitempty"`
+//		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
 //	client.TimelineEvent.Query().
-//		Select(timelineevent.FieldSessionID).
+//		Select(timelineevent.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (_q *TimelineEventQuery) Select(fields ...string) *TimelineEventSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -31,6 +31,12 @@ func (_u *TimelineEventUpdate) Where(ps ...predicate.TimelineEvent) *TimelineEve
 	return _u
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TimelineEventUpdate) SetUpdatedAt(v time.Time) *TimelineEventUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetSequenceNumber sets the "sequence_number" field.
 func (_u *TimelineEventUpdate) SetSequenceNumber(v int) *TimelineEventUpdate {
 	_u.mutation.ResetSequenceNumber()
@@ -52,12 +58,6 @@ func (_u *TimelineEventUpdate) AddSequenceNumber(v int) *TimelineEventUpdate {
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *TimelineEventUpdate) SetUpdatedAt(v time.Time) *TimelineEventUpdate {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
 // SetEventType sets the "event_type" field.
 func (_u *TimelineEventUpdate) SetEventType(v timelineevent.EventType) *TimelineEventUpdate {
 	_u.mutation.SetEventType(v)
@@ -86,6 +86,26 @@ func (_u *TimelineEventUpdate) SetNillableStatus(v *timelineevent.Status) *Timel
 	return _u
 }
 
+// SetHighlight sets the "highlight" field.
+func (_u *TimelineEventUpdate) SetHighlight(v timelineevent.Highlight) *TimelineEventUpdate {
+	_u.mutation.SetHighlight(v)
+	return _u
+}
+
+// SetNillableHighlight sets the "highlight" field if the given value is not nil.
+func (_u *TimelineEventUpdate) SetNillableHighlight(v *timelineevent.Highlight) *TimelineEventUpdate {
+	if v != nil {
+		_u.SetHighlight(*v)
+	}
+	return _u
+}
+
+// ClearHighlight clears the value of the "highlight" field.
+func (_u *TimelineEventUpdate) ClearHighlight() *TimelineEventUpdate {
+	_u.mutation.ClearHighlight()
+	return _u
+}
+
 // SetContent sets the "content" field.
 func (_u *TimelineEventUpdate) SetContent(v string) *TimelineEventUpdate {
 	_u.mutation.SetContent(v)
@@ -100,6 +120,18 @@ func (_u *TimelineEventUpdate) SetNillableContent(v *string) *TimelineEventUpdat
 	return _u
 }
 
+// SetContentZstd sets the "content_zstd" field.
+func (_u *TimelineEventUpdate) SetContentZstd(v []byte) *TimelineEventUpdate {
+	_u.mutation.SetContentZstd(v)
+	return _u
+}
+
+// ClearContentZstd clears the value of the "content_zstd" field.
+func (_u *TimelineEventUpdate) ClearContentZstd() *TimelineEventUpdate {
+	_u.mutation.ClearContentZstd()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *TimelineEventUpdate) SetMetadata(v map[string]interface{}) *TimelineEventUpdate {
 	_u.mutation.SetMetadata(v)
@@ -227,6 +259,11 @@ func (_u *TimelineEventUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Highlight(); ok {
+		if err := timelineevent.HighlightValidator(v); err != nil {
+			return &ValidationError{Name: "highlight", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.highlight": %w`, err)}
+		}
+	}
 	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "TimelineEvent.session"`)
 	}
@@ -251,24 +288,36 @@ func (_u *TimelineEventUpdate) sqlSave(ctx context.Context) (_node int, err erro
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(timelineevent.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.SequenceNumber(); ok {
 		_spec.SetField(timelineevent.FieldSequenceNumber, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedSequenceNumber(); ok {
 		_spec.AddField(timelineevent.FieldSequenceNumber, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(timelineevent.FieldUpdatedAt, field.TypeTime, value)
-	}
 	if value, ok := _u.mutation.EventType(); ok {
 		_spec.SetField(timelineevent.FieldEventType, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(timelineevent.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Highlight(); ok {
+		_spec.SetField(timelineevent.FieldHighlight, field.TypeEnum, value)
+	}
+	if _u.mutation.HighlightCleared() {
+		_spec.ClearField(timelineevent.FieldHighlight, field.TypeEnum)
+	}
 	if value, ok := _u.mutation.Content(); ok {
 		_spec.SetField(timelineevent.FieldContent, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ContentZstd(); ok {
+		_spec.SetField(timelineevent.FieldContentZstd, field.TypeBytes, value)
+	}
+	if _u.mutation.ContentZstdCleared() {
+		_spec.ClearField(timelineevent.FieldContentZstd, field.TypeBytes)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(timelineevent.FieldMetadata, field.TypeJSON, value)
 	}
@@ -355,6 +404,12 @@ type TimelineEventUpdateOne struct {
 	modifiers []func(*sql.UpdateBuilder)
 }
 
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TimelineEventUpdateOne) SetUpdatedAt(v time.Time) *TimelineEventUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetSequenceNumber sets the "sequence_number" field.
 func (_u *TimelineEventUpdateOne) SetSequenceNumber(v int) *TimelineEventUpdateOne {
 	_u.mutation.ResetSequenceNumber()
@@ -376,12 +431,6 @@ func (_u *TimelineEventUpdateOne) AddSequenceNumber(v int) *TimelineEventUpdateO
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *TimelineEventUpdateOne) SetUpdatedAt(v time.Time) *TimelineEventUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
 // SetEventType sets the "event_type" field.
 func (_u *TimelineEventUpdateOne) SetEventType(v timelineevent.EventType) *TimelineEventUpdateOne {
 	_u.mutation.SetEventType(v)
@@ -410,6 +459,26 @@ func (_u *TimelineEventUpdateOne) SetNillableStatus(v *timelineevent.Status) *Ti
 	return _u
 }
 
+// SetHighlight sets the "highlight" field.
+func (_u *TimelineEventUpdateOne) SetHighlight(v timelineevent.Highlight) *TimelineEventUpdateOne {
+	_u.mutation.SetHighlight(v)
+	return _u
+}
+
+// SetNillableHighlight sets the "highlight" field if the given value is not nil.
+func (_u *TimelineEventUpdateOne) SetNillableHighlight(v *timelineevent.Highlight) *TimelineEventUpdateOne {
+	if v != nil {
+		_u.SetHighlight(*v)
+	}
+	return _u
+}
+
+// ClearHighlight clears the value of the "highlight" field.
+func (_u *TimelineEventUpdateOne) ClearHighlight() *TimelineEventUpdateOne {
+	_u.mutation.ClearHighlight()
+	return _u
+}
+
 // SetContent sets the "content" field.
 func (_u *TimelineEventUpdateOne) SetContent(v string) *TimelineEventUpdateOne {
 	_u.mutation.SetContent(v)
@@ -424,6 +493,18 @@ func (_u *TimelineEventUpdateOne) SetNillableContent(v *string) *TimelineEventUp
 	return _u
 }
 
+// SetContentZstd sets the "content_zstd" field.
+func (_u *TimelineEventUpdateOne) SetContentZstd(v []byte) *TimelineEventUpdateOne {
+	_u.mutation.SetContentZstd(v)
+	return _u
+}
+
+// ClearContentZstd clears the value of the "content_zstd" field.
+func (_u *TimelineEventUpdateOne) ClearContentZstd() *TimelineEventUpdateOne {
+	_u.mutation.ClearContentZstd()
+	return _u
+}
+
 // SetMetadata sets the "metadata" field.
 func (_u *TimelineEventUpdateOne) SetMetadata(v map[string]interface{}) *TimelineEventUpdateOne {
 	_u.mutation.SetMetadata(v)
@@ -564,6 +645,11 @@ func (_u *TimelineEventUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Highlight(); ok {
+		if err := timelineevent.HighlightValidator(v); err != nil {
+			return &ValidationError{Name: "highlight", err: fmt.Errorf(`ent: validator failed for field "TimelineEvent.highlight": %w`, err)}
+		}
+	}
 	if _u.mutation.SessionCleared() && len(_u.mutation.SessionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "TimelineEvent.session"`)
 	}
@@ -605,24 +691,36 @@ func (_u *TimelineEventUpdateOne) sqlSave(ctx context.Context) (_node *TimelineE
 			}
 		}
 	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(timelineevent.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if value, ok := _u.mutation.SequenceNumber(); ok {
 		_spec.SetField(timelineevent.FieldSequenceNumber, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.AddedSequenceNumber(); ok {
 		_spec.AddField(timelineevent.FieldSequenceNumber, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(timelineevent.FieldUpdatedAt, field.TypeTime, value)
-	}
 	if value, ok := _u.mutation.EventType(); ok {
 		_spec.SetField(timelineevent.FieldEventType, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(timelineevent.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Highlight(); ok {
+		_spec.SetField(timelineevent.FieldHighlight, field.TypeEnum, value)
+	}
+	if _u.mutation.HighlightCleared() {
+		_spec.ClearField(timelineevent.FieldHighlight, field.TypeEnum)
+	}
 	if value, ok := _u.mutation.Content(); ok {
 		_spec.SetField(timelineevent.FieldContent, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.ContentZstd(); ok {
+		_spec.SetField(timelineevent.FieldContentZstd, field.TypeBytes, value)
+	}
+	if _u.mutation.ContentZstdCleared() {
+		_spec.ClearField(timelineevent.FieldContentZstd, field.TypeBytes)
+	}
 	if value, ok := _u.mutation.Metadata(); ok {
 		_spec.SetField(timelineevent.FieldMetadata, field.TypeJSON, value)
 	}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,24 +14,54 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ActionItem is the client for interacting with the ActionItem builders.
+	ActionItem *ActionItemClient
+	// ActivityReport is the client for interacting with the ActivityReport builders.
+	ActivityReport *ActivityReportClient
 	// AgentExecution is the client for interacting with the AgentExecution builders.
 	AgentExecution *AgentExecutionClient
 	// AlertSession is the client for interacting with the AlertSession builders.
 	AlertSession *AlertSessionClient
+	// Blob is the client for interacting with the Blob builders.
+	Blob *BlobClient
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
 	// ChatUserMessage is the client for interacting with the ChatUserMessage builders.
 	ChatUserMessage *ChatUserMessageClient
+	// ConfigRegistration is the client for interacting with the ConfigRegistration builders.
+	ConfigRegistration *ConfigRegistrationClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
+	// FeatureFlagOverride is the client for interacting with the FeatureFlagOverride builders.
+	FeatureFlagOverride *FeatureFlagOverrideClient
+	// HandoffNoteRevision is the client for interacting with the HandoffNoteRevision builders.
+	HandoffNoteRevision *HandoffNoteRevisionClient
 	// InvestigationMemory is the client for interacting with the InvestigationMemory builders.
 	InvestigationMemory *InvestigationMemoryClient
+	// JobLeader is the client for interacting with the JobLeader builders.
+	JobLeader *JobLeaderClient
 	// LLMInteraction is the client for interacting with the LLMInteraction builders.
 	LLMInteraction *LLMInteractionClient
 	// MCPInteraction is the client for interacting with the MCPInteraction builders.
 	MCPInteraction *MCPInteractionClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// PodHeartbeat is the client for interacting with the PodHeartbeat builders.
+	PodHeartbeat *PodHeartbeatClient
+	// QueuePause is the client for interacting with the QueuePause builders.
+	QueuePause *QueuePauseClient
+	// RedactionReview is the client for interacting with the RedactionReview builders.
+	RedactionReview *RedactionReviewClient
+	// SavedView is the client for interacting with the SavedView builders.
+	SavedView *SavedViewClient
+	// SchemaCompatibility is the client for interacting with the SchemaCompatibility builders.
+	SchemaCompatibility *SchemaCompatibilityClient
+	// SessionClaim is the client for interacting with the SessionClaim builders.
+	SessionClaim *SessionClaimClient
+	// SessionComparison is the client for interacting with the SessionComparison builders.
+	SessionComparison *SessionComparisonClient
+	// SessionGroup is the client for interacting with the SessionGroup builders.
+	SessionGroup *SessionGroupClient
 	// SessionReviewActivity is the client for interacting with the SessionReviewActivity builders.
 	SessionReviewActivity *SessionReviewActivityClient
 	// SessionScore is the client for interacting with the SessionScore builders.
@@ -38,6 +70,8 @@ type Tx struct {
 	Stage *StageClient
 	// TimelineEvent is the client for interacting with the TimelineEvent builders.
 	TimelineEvent *TimelineEventClient
+	// UserProfile is the client for interacting with the UserProfile builders.
+	UserProfile *UserProfileClient
 
 	// lazily loaded.
 	client     *Client
@@ -169,19 +203,35 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ActionItem = NewActionItemClient(tx.config)
+	tx.ActivityReport = NewActivityReportClient(tx.config)
 	tx.AgentExecution = NewAgentExecutionClient(tx.config)
 	tx.AlertSession = NewAlertSessionClient(tx.config)
+	tx.Blob = NewBlobClient(tx.config)
 	tx.Chat = NewChatClient(tx.config)
 	tx.ChatUserMessage = NewChatUserMessageClient(tx.config)
+	tx.ConfigRegistration = NewConfigRegistrationClient(tx.config)
 	tx.Event = NewEventClient(tx.config)
+	tx.FeatureFlagOverride = NewFeatureFlagOverrideClient(tx.config)
+	tx.HandoffNoteRevision = NewHandoffNoteRevisionClient(tx.config)
 	tx.InvestigationMemory = NewInvestigationMemoryClient(tx.config)
+	tx.JobLeader = NewJobLeaderClient(tx.config)
 	tx.LLMInteraction = NewLLMInteractionClient(tx.config)
 	tx.MCPInteraction = NewMCPInteractionClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.PodHeartbeat = NewPodHeartbeatClient(tx.config)
+	tx.QueuePause = NewQueuePauseClient(tx.config)
+	tx.RedactionReview = NewRedactionReviewClient(tx.config)
+	tx.SavedView = NewSavedViewClient(tx.config)
+	tx.SchemaCompatibility = NewSchemaCompatibilityClient(tx.config)
+	tx.SessionClaim = NewSessionClaimClient(tx.config)
+	tx.SessionComparison = NewSessionComparisonClient(tx.config)
+	tx.SessionGroup = NewSessionGroupClient(tx.config)
 	tx.SessionReviewActivity = NewSessionReviewActivityClient(tx.config)
 	tx.SessionScore = NewSessionScoreClient(tx.config)
 	tx.Stage = NewStageClient(tx.config)
 	tx.TimelineEvent = NewTimelineEventClient(tx.config)
+	tx.UserProfile = NewUserProfileClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -191,7 +241,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AgentExecution.QueryXXX(), the query will be executed
+// applies a query, for example: ActionItem.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -244,3 +294,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
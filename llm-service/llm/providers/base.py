@@ -24,3 +24,20 @@ class LLMProvider(ABC):
         The last chunk must have is_final=True.
         """
         ...
+
+
+def generation_params(config: pb.LLMConfig) -> dict:
+    """Return the generation parameters set on config, keyed by proto field name.
+
+    Parameters Go left unset are omitted so the provider default applies.
+    """
+    if not config.HasField("generation"):
+        return {}
+    gen = config.generation
+    params = {}
+    for field in ("temperature", "top_p", "max_output_tokens"):
+        if gen.HasField(field):
+            params[field] = getattr(gen, field)
+    if gen.reasoning_effort:
+        params["reasoning_effort"] = gen.reasoning_effort
+    return params
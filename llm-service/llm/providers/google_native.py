@@ -12,7 +12,7 @@ from google.genai import errors as genai_errors
 from google.genai import types as genai_types
 
 from llm_proto import llm_service_pb2 as pb
-from llm.providers.base import LLMProvider
+from llm.providers.base import LLMProvider, generation_params
 from llm.providers.tool_names import tool_name_to_api, tool_name_from_api
 
 logger = logging.getLogger(__name__)
@@ -26,6 +26,10 @@ EMPTY_RESPONSE_RETRY_DELAY = 3  # seconds
 # Executions typically complete in minutes; 1 hour is generous headroom.
 MODEL_CONTENT_CACHE_TTL = 3600  # 1 hour
 
+# Thinking budgets for reasoning_effort "low"/"medium" on budget-based
+# (Gemini 2.5) models; "high" (and no effort) keeps the per-model defaults.
+THINKING_BUDGETS = {"low": 4096, "medium": 12288}
+
 
 class GoogleNativeProvider(LLMProvider):
     """LLM provider using Google's native genai SDK.
@@ -70,23 +74,24 @@ class GoogleNativeProvider(LLMProvider):
         logger.info("Created genai client for %s", api_key_env)
         return client
 
-    def _get_thinking_config(self, model: str) -> genai_types.ThinkingConfig:
-        """Get thinking configuration based on model name."""
+    def _get_thinking_config(self, model: str, effort: str = "") -> genai_types.ThinkingConfig:
+        """Get thinking configuration based on model name and reasoning effort."""
         model_lower = model.lower()
         if "gemini-2.5-pro" in model_lower:
             return genai_types.ThinkingConfig(
-                thinking_budget=32768,
+                thinking_budget=THINKING_BUDGETS.get(effort, 32768),
                 include_thoughts=True,
             )
         elif "gemini-2.5-flash" in model_lower:
             return genai_types.ThinkingConfig(
-                thinking_budget=24576,
+                thinking_budget=THINKING_BUDGETS.get(effort, 24576),
                 include_thoughts=True,
             )
         else:
             # Default for Gemini 3 models and others
+            level = genai_types.ThinkingLevel.LOW if effort == "low" else genai_types.ThinkingLevel.HIGH
             return genai_types.ThinkingConfig(
-                thinking_level=genai_types.ThinkingLevel.HIGH,
+                thinking_level=level,
                 include_thoughts=True,
             )
 
@@ -120,12 +125,12 @@ class GoogleNativeProvider(LLMProvider):
                     )
                 system_instruction = msg.content
             elif msg.role == "user":
-                contents.append(
-                    genai_types.Content(
-                        role="user",
-                        parts=[genai_types.Part(text=msg.content)],
+                parts = [genai_types.Part(text=msg.content)]
+                for img in msg.images:
+                    parts.append(
+                        genai_types.Part.from_bytes(data=img.data, mime_type=img.mime_type)
                     )
-                )
+                contents.append(genai_types.Content(role="user", parts=parts))
             elif msg.role == "assistant":
                 if model_turn_idx < len(cached_turns):
                     # Use cached Content objects — preserves all thought_signatures
@@ -318,10 +323,14 @@ class GoogleNativeProvider(LLMProvider):
             return
 
         # Build generation config
-        thinking_config = self._get_thinking_config(config.model)
+        params = generation_params(config)
+        thinking_config = self._get_thinking_config(config.model, params.get("reasoning_effort", ""))
         gen_config = genai_types.GenerateContentConfig(
             thinking_config=thinking_config,
             system_instruction=system_instruction,
+            temperature=params.get("temperature"),
+            top_p=params.get("top_p"),
+            max_output_tokens=params.get("max_output_tokens"),
         )
         if tools:
             gen_config.tools = tools
@@ -6,6 +6,7 @@ Features: streaming, native thinking/reasoning, function calling, retries.
 Replaces langchain_stub.py with a real multi-provider implementation.
 """
 import asyncio
+import base64
 import enum
 import json
 import logging
@@ -24,7 +25,7 @@ from langchain_core.messages import (
 )
 
 from llm_proto import llm_service_pb2 as pb
-from llm.providers.base import LLMProvider
+from llm.providers.base import LLMProvider, generation_params
 from llm.providers.tool_names import tool_name_to_api, tool_name_from_api
 
 logger = logging.getLogger(__name__)
@@ -33,6 +34,12 @@ logger = logging.getLogger(__name__)
 MAX_RETRIES = 3
 RETRY_BACKOFF_BASE = 2  # seconds
 
+# Thinking budgets for reasoning_effort "low"/"medium"; "high" (and no
+# effort) keeps the per-model defaults below.
+GOOGLE_THINKING_BUDGETS = {"low": 4096, "medium": 12288}
+ANTHROPIC_THINKING_BUDGETS = {"low": 4000, "medium": 16000}
+ANTHROPIC_MIN_THINKING_BUDGET = 1024
+
 
 class ProviderType(str, enum.Enum):
     """Supported LangChain provider types.
@@ -52,7 +59,7 @@ class LangChainProvider(LLMProvider):
 
     Supports: OpenAI, Anthropic, xAI, Google (via LangChain), VertexAI.
     Features:
-    - Cached chat model instances per (provider, model, api_key_env)
+    - Cached chat model instances per (provider, model, api_key_env, generation)
     - Streaming via astream() with content_blocks for unified reasoning/text/tool_calls
     - Tool binding via bind_tools()
     - Tool name encoding via shared tool_names utility
@@ -61,15 +68,18 @@ class LangChainProvider(LLMProvider):
     """
 
     def __init__(self):
-        # Cache BaseChatModel instances per (provider, model, api_key_env) tuple.
+        # Cache BaseChatModel instances per (provider, model, api_key_env,
+        # generation params) tuple. Generation params are constructor
+        # arguments, so each distinct set gets its own instance.
         # LangChain model objects are stateless — conversation state is passed
         # per-call via messages. This avoids re-reading env vars and
         # re-initializing HTTP clients on every request.
-        self._model_cache: Dict[Tuple[str, str, str], object] = {}
+        self._model_cache: Dict[Tuple[str, str, str, tuple], object] = {}
 
     def _get_or_create_model(self, config: pb.LLMConfig, tools: List[pb.ToolDefinition]):
         """Get or create a cached LangChain chat model, with tools bound if provided."""
-        cache_key = (config.provider, config.model, config.api_key_env)
+        generation = tuple(sorted(generation_params(config).items()))
+        cache_key = (config.provider, config.model, config.api_key_env, generation)
         if cache_key not in self._model_cache:
             self._model_cache[cache_key] = self._create_chat_model(config)
         model = self._model_cache[cache_key]
@@ -80,7 +90,7 @@ class LangChainProvider(LLMProvider):
     # ── Reasoning/thinking configuration per provider ──────────────────
 
     @staticmethod
-    def _get_google_thinking_kwargs(model: str) -> dict:
+    def _get_google_thinking_kwargs(model: str, effort: str = "") -> dict:
         """Return kwargs to enable thinking/reasoning for Google models.
 
         Mirrors the thinking configuration from GoogleNativeProvider._get_thinking_config
@@ -88,14 +98,17 @@ class LangChainProvider(LLMProvider):
         """
         model_lower = model.lower()
         if "gemini-2.5-pro" in model_lower:
-            return {"include_thoughts": True, "thinking_budget": 32768}
+            budget = GOOGLE_THINKING_BUDGETS.get(effort, 32768)
+            return {"include_thoughts": True, "thinking_budget": budget}
         elif "gemini-2.5-flash" in model_lower:
-            return {"include_thoughts": True, "thinking_budget": 24576}
+            budget = GOOGLE_THINKING_BUDGETS.get(effort, 24576)
+            return {"include_thoughts": True, "thinking_budget": budget}
         else:
-            return {"include_thoughts": True, "thinking_level": "high"}
+            level = "low" if effort == "low" else "high"
+            return {"include_thoughts": True, "thinking_level": level}
 
     @staticmethod
-    def _get_openai_reasoning_kwargs(model: str) -> dict:
+    def _get_openai_reasoning_kwargs(model: str, effort: str = "") -> dict:
         """Return kwargs to enable reasoning for OpenAI models.
 
         Uses the Responses API which properly streams reasoning summaries.
@@ -109,7 +122,7 @@ class LangChainProvider(LLMProvider):
             return {}
         return {
             "use_responses_api": True,
-            "reasoning": {"effort": "high", "summary": "auto"},
+            "reasoning": {"effort": effort or "high", "summary": "auto"},
         }
 
     # Matches bare-major-version-5 Claude model families (e.g. claude-sonnet-5,
@@ -119,7 +132,7 @@ class LangChainProvider(LLMProvider):
     _ADAPTIVE_ONLY_THINKING_RE = re.compile(r"claude-[a-z]+-5(?:-|$)")
 
     @classmethod
-    def _get_anthropic_thinking_kwargs(cls, model: str) -> dict:
+    def _get_anthropic_thinking_kwargs(cls, model: str, effort: str = "") -> dict:
         """Return kwargs to enable thinking for Claude models.
 
         budget_tokens must be less than max_tokens. Claude Sonnet 5 (and other
@@ -133,10 +146,45 @@ class LangChainProvider(LLMProvider):
                 "max_tokens": 64000,
             }
         return {
-            "thinking": {"type": "enabled", "budget_tokens": 32000},
+            "thinking": {
+                "type": "enabled",
+                "budget_tokens": ANTHROPIC_THINKING_BUDGETS.get(effort, 32000),
+            },
             "max_tokens": 64000,
         }
 
+    @staticmethod
+    def _apply_anthropic_max_tokens(kwargs: dict, max_tokens: Optional[int]) -> dict:
+        """Cap max_tokens to the configured max_output_tokens.
+
+        A manual thinking budget must stay below max_tokens, so it shrinks
+        with it; thinking is dropped when no budget above Anthropic's minimum
+        fits.
+        """
+        if max_tokens is None:
+            return kwargs
+        kwargs["max_tokens"] = max_tokens
+        thinking = kwargs.get("thinking")
+        if thinking and thinking.get("type") == "enabled":
+            budget = min(thinking["budget_tokens"], max_tokens - 1)
+            if budget < ANTHROPIC_MIN_THINKING_BUDGET:
+                del kwargs["thinking"]
+            else:
+                kwargs["thinking"] = {**thinking, "budget_tokens": budget}
+        return kwargs
+
+    @staticmethod
+    def _sampling_kwargs(params: dict, max_tokens_name: Optional[str] = "max_tokens") -> dict:
+        """Return the temperature/top_p/max-output-tokens constructor kwargs.
+
+        max_tokens_name is the provider's name for the output token limit
+        (None when the caller applies it itself).
+        """
+        kwargs = {k: params[k] for k in ("temperature", "top_p") if k in params}
+        if max_tokens_name and "max_output_tokens" in params:
+            kwargs[max_tokens_name] = params["max_output_tokens"]
+        return kwargs
+
     def _create_chat_model(self, config: pb.LLMConfig):
         """Create a LangChain BaseChatModel for the given provider config."""
         try:
@@ -149,6 +197,8 @@ class LangChainProvider(LLMProvider):
             ) from err
 
         api_key = os.getenv(config.api_key_env) if config.api_key_env else None
+        params = generation_params(config)
+        effort = params.get("reasoning_effort", "")
 
         def _require_api_key() -> str:
             """Validate that the API key env var is set and return its value."""
@@ -161,18 +211,19 @@ class LangChainProvider(LLMProvider):
 
         if provider is ProviderType.OPENAI:
             from langchain_openai import ChatOpenAI
-            reasoning_kwargs = self._get_openai_reasoning_kwargs(config.model)
+            reasoning_kwargs = self._get_openai_reasoning_kwargs(config.model, effort)
             return ChatOpenAI(
                 model=config.model,
                 api_key=_require_api_key(),
                 streaming=True,
                 stream_usage=True,
                 **reasoning_kwargs,
+                **self._sampling_kwargs(params),
             )
 
         elif provider is ProviderType.ANTHROPIC:
             from langchain_anthropic import ChatAnthropic
-            thinking_kwargs = self._get_anthropic_thinking_kwargs(config.model)
+            thinking_kwargs = self._get_anthropic_thinking_kwargs(config.model, effort)
             base_kwargs = {
                 "model": config.model,
                 "api_key": _require_api_key(),
@@ -181,31 +232,39 @@ class LangChainProvider(LLMProvider):
             }
             # thinking_kwargs may override max_tokens to ensure budget_tokens < max_tokens
             base_kwargs.update(thinking_kwargs)
+            self._apply_anthropic_max_tokens(base_kwargs, params.get("max_output_tokens"))
+            base_kwargs.update(self._sampling_kwargs(params, max_tokens_name=None))
             return ChatAnthropic(**base_kwargs)
 
         elif provider is ProviderType.XAI:
             from langchain_xai import ChatXAI
+            xai_kwargs = self._sampling_kwargs(params)
+            if effort:
+                xai_kwargs["reasoning_effort"] = effort
             return ChatXAI(
                 model=config.model,
                 api_key=_require_api_key(),
                 streaming=True,
+                **xai_kwargs,
             )
 
         elif provider is ProviderType.GOOGLE:
             from langchain_google_genai import ChatGoogleGenerativeAI
-            thinking_kwargs = self._get_google_thinking_kwargs(config.model)
+            thinking_kwargs = self._get_google_thinking_kwargs(config.model, effort)
             return ChatGoogleGenerativeAI(
                 model=config.model,
                 google_api_key=_require_api_key(),
                 streaming=True,
                 **thinking_kwargs,
+                **self._sampling_kwargs(params, max_tokens_name="max_output_tokens"),
             )
 
         elif provider is ProviderType.VERTEXAI:
             model_lower = config.model.lower()
             if "claude" in model_lower or "anthropic" in model_lower:
                 from langchain_google_vertexai.model_garden import ChatAnthropicVertex
-                thinking_kwargs = self._get_anthropic_thinking_kwargs(config.model)
+                thinking_kwargs = self._get_anthropic_thinking_kwargs(config.model, effort)
+                self._apply_anthropic_max_tokens(thinking_kwargs, params.get("max_output_tokens"))
                 max_tokens = thinking_kwargs.pop("max_tokens", 64000)
                 return ChatAnthropicVertex(
                     model=config.model,
@@ -214,16 +273,18 @@ class LangChainProvider(LLMProvider):
                     streaming=True,
                     max_tokens=max_tokens,
                     model_kwargs=thinking_kwargs,
+                    **self._sampling_kwargs(params, max_tokens_name=None),
                 )
             else:
                 from langchain_google_genai import ChatGoogleGenerativeAI
-                thinking_kwargs = self._get_google_thinking_kwargs(config.model)
+                thinking_kwargs = self._get_google_thinking_kwargs(config.model, effort)
                 return ChatGoogleGenerativeAI(
                     model=config.model,
                     project=config.project,
                     location=config.location,
                     streaming=True,
                     **thinking_kwargs,
+                    **self._sampling_kwargs(params, max_tokens_name="max_output_tokens"),
                 )
 
     def _convert_messages(self, messages: List[pb.ConversationMessage]) -> List[BaseMessage]:
@@ -233,7 +294,7 @@ class LangChainProvider(LLMProvider):
             if msg.role == "system":
                 result.append(SystemMessage(content=msg.content))
             elif msg.role == "user":
-                result.append(HumanMessage(content=msg.content))
+                result.append(HumanMessage(content=self._user_content(msg)))
             elif msg.role == "assistant":
                 content = msg.content or ""
                 tool_calls = []
@@ -265,6 +326,21 @@ class LangChainProvider(LLMProvider):
                 )
         return result
 
+    @staticmethod
+    def _user_content(msg: pb.ConversationMessage):
+        """Build user message content: plain text, or text plus image blocks
+        (data URLs, accepted by every multimodal LangChain chat model)."""
+        if not msg.images:
+            return msg.content
+        blocks: List[dict] = [{"type": "text", "text": msg.content}]
+        for img in msg.images:
+            data = base64.b64encode(img.data).decode("ascii")
+            blocks.append({
+                "type": "image_url",
+                "image_url": {"url": f"data:{img.mime_type};base64,{data}"},
+            })
+        return blocks
+
     @staticmethod
     def _bind_tools(model, tools: List[pb.ToolDefinition]):
         """Bind MCP tools to the model via LangChain's bind_tools()."""
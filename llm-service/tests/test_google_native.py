@@ -101,6 +101,13 @@ class TestGoogleNativeProvider:
         assert config.thinking_level == genai_types.ThinkingLevel.HIGH
         assert config.include_thoughts is True
 
+    def test_get_thinking_config_reasoning_effort(self, provider):
+        """Test that reasoning_effort lowers the budget or level."""
+        assert provider._get_thinking_config("gemini-2.5-pro", "low").thinking_budget == 4096
+        assert provider._get_thinking_config("gemini-2.5-flash", "medium").thinking_budget == 12288
+        assert provider._get_thinking_config("gemini-2.5-pro", "high").thinking_budget == 32768
+        assert provider._get_thinking_config("gemini-3.1", "low").thinking_level == genai_types.ThinkingLevel.LOW
+
     def test_convert_messages_system_instruction(self, provider):
         """Test that system messages are extracted as system_instruction."""
         messages = [
@@ -140,6 +147,23 @@ class TestGoogleNativeProvider:
         assert contents[1].role == "model"
         assert contents[1].parts[0].text == "Hi there"
 
+    def test_convert_messages_user_with_images(self, provider):
+        """Test that user image attachments become inline data parts."""
+        messages = [
+            pb.ConversationMessage(
+                role="user",
+                content="What does this panel show?",
+                images=[pb.ImagePart(mime_type="image/png", data=b"\x89PNG")],
+            ),
+        ]
+
+        _, contents = provider._convert_messages(messages)
+
+        assert len(contents[0].parts) == 2
+        assert contents[0].parts[0].text == "What does this panel show?"
+        assert contents[0].parts[1].inline_data.mime_type == "image/png"
+        assert contents[0].parts[1].inline_data.data == b"\x89PNG"
+
     def test_convert_messages_with_tool_calls(self, provider):
         """Test conversion of assistant messages with tool calls."""
         tool_call = pb.ToolCall(
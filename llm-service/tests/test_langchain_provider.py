@@ -40,6 +40,18 @@ class TestLangChainProviderMessageConversion:
         assert isinstance(result[0], HumanMessage)
         assert result[0].content == "Hello"
 
+    def test_user_message_with_images(self, provider):
+        messages = [pb.ConversationMessage(
+            role="user",
+            content="What does this panel show?",
+            images=[pb.ImagePart(mime_type="image/png", data=b"\x89PNG")],
+        )]
+        result = provider._convert_messages(messages)
+        assert result[0].content == [
+            {"type": "text", "text": "What does this panel show?"},
+            {"type": "image_url", "image_url": {"url": "data:image/png;base64,iVBORw=="}},
+        ]
+
     def test_assistant_message_text_only(self, provider):
         messages = [pb.ConversationMessage(role="assistant", content="Hi there")]
         result = provider._convert_messages(messages)
@@ -195,6 +207,26 @@ class TestLangChainProviderReasoningConfig:
         assert result["thinking"] == {"type": "adaptive"}
         assert result["max_tokens"] == 64000
 
+    # --- reasoning_effort overrides ---
+    def test_reasoning_effort(self):
+        assert LangChainProvider._get_openai_reasoning_kwargs("o3", "low")["reasoning"]["effort"] == "low"
+        assert LangChainProvider._get_google_thinking_kwargs("gemini-2.5-pro", "low")["thinking_budget"] == 4096
+        assert LangChainProvider._get_google_thinking_kwargs("gemini-3-pro", "low")["thinking_level"] == "low"
+        result = LangChainProvider._get_anthropic_thinking_kwargs("claude-opus-4-6", "medium")
+        assert result["thinking"]["budget_tokens"] == 16000
+
+    def test_anthropic_max_tokens_shrinks_thinking_budget(self):
+        kwargs = LangChainProvider._get_anthropic_thinking_kwargs("claude-opus-4-6")
+        LangChainProvider._apply_anthropic_max_tokens(kwargs, 8000)
+        assert kwargs["max_tokens"] == 8000
+        assert kwargs["thinking"]["budget_tokens"] == 7999
+
+    def test_anthropic_max_tokens_drops_thinking_below_minimum(self):
+        kwargs = LangChainProvider._get_anthropic_thinking_kwargs("claude-opus-4-6")
+        LangChainProvider._apply_anthropic_max_tokens(kwargs, 512)
+        assert kwargs["max_tokens"] == 512
+        assert "thinking" not in kwargs
+
 
 
 class TestLangChainProviderModelCreation:
@@ -212,6 +244,22 @@ class TestLangChainProviderModelCreation:
 
         mock_create.assert_called_once()
 
+    @patch.dict(os.environ, {"OPENAI_API_KEY": "test-key"})
+    @patch("llm.providers.langchain_provider.LangChainProvider._create_chat_model")
+    def test_get_or_create_model_caches_per_generation_params(self, mock_create, provider):
+        mock_create.side_effect = lambda config: MagicMock()
+        base = pb.LLMConfig(provider="openai", model="o4-mini", api_key_env="OPENAI_API_KEY")
+        tuned = pb.LLMConfig(
+            provider="openai", model="o4-mini", api_key_env="OPENAI_API_KEY",
+            generation=pb.GenerationParams(temperature=0.0),
+        )
+
+        provider._get_or_create_model(base, [])
+        provider._get_or_create_model(tuned, [])
+        provider._get_or_create_model(tuned, [])
+
+        assert mock_create.call_count == 2
+
     @patch.dict(os.environ, {"OPENAI_API_KEY": "test-key"})
     def test_create_openai_model(self, provider):
         with patch("llm.providers.langchain_provider.ChatOpenAI", create=True) as MockChat:
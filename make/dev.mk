@@ -129,6 +129,10 @@ build: ## Build Go application
 	@go build -o bin/tarsy ./cmd/tarsy
 	@echo -e "$(GREEN)✅ Build complete: bin/tarsy$(NC)"
 
+.PHONY: bench
+bench: ## Run the load benchmark against a local TARSy (usage: make bench ARGS="-rate 5 -duration 2m")
+	@go run ./cmd/tarsy-bench $(ARGS)
+
 # =============================================================================
 # Testing
 # =============================================================================
@@ -0,0 +1,26 @@
+// Package actor carries who is making a change (the caller of an API
+// request) through contexts, so database hooks can stamp created_by and
+// updated_by without every service threading the author through.
+package actor
+
+import "context"
+
+type ctxKey struct{}
+
+// WithContext returns a copy of ctx carrying name. An empty name returns ctx unchanged.
+func WithContext(ctx context.Context, name string) context.Context {
+	if name == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey{}, name)
+}
+
+// FromContext returns the actor carried by ctx, or "" for changes made by
+// the system itself (workers, background jobs).
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	name, _ := ctx.Value(ctxKey{}).(string)
+	return name
+}
@@ -0,0 +1,15 @@
+package actor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, FromContext(ctx))
+	assert.Equal(t, ctx, WithContext(ctx, ""))
+	assert.Equal(t, "alice@example.com", FromContext(WithContext(ctx, "alice@example.com")))
+}
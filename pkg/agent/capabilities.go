@@ -0,0 +1,88 @@
+package agent
+
+import (
+	"fmt"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// usesTools reports whether agents of type t run the iterating
+// function-calling controller (investigation, action, and chat agents).
+func usesTools(t config.AgentType) bool {
+	return t == config.AgentTypeDefault || t == config.AgentTypeAction
+}
+
+// applyCapabilityFallbacks adjusts a resolved config whose requested features
+// exceed the selected model's capabilities (config.ModelCapabilities) and
+// records a warning for each adjustment:
+//   - Tool-using agent on a model without function calling: switch to the
+//     first fallback provider that has it, otherwise run without tools.
+//   - Fallback providers without function calling are dropped for tool-using
+//     agents, so a runtime provider fallback cannot strand an investigation.
+//   - Model without streaming: disable the first-chunk and stall watchdogs,
+//     since the response arrives whole; LLMCallTimeout still bounds the call.
+func applyCapabilityFallbacks(r *ResolvedAgentConfig) {
+	if usesTools(r.Type) {
+		applyFunctionCallingFallback(r)
+	}
+
+	if !r.LLMProvider.EffectiveCapabilities().Streaming {
+		r.InitialResponseTimeout = 0
+		r.StallTimeout = 0
+		r.warnCapability("LLM provider %q (%s) does not stream responses: stream timeouts disabled, waiting up to %s per call",
+			r.LLMProviderName, r.LLMProvider.Model, r.LLMCallTimeout)
+	}
+}
+
+// applyFunctionCallingFallback makes sure a tool-using agent runs on a model
+// with function calling, or without tools when no configured provider has it.
+func applyFunctionCallingFallback(r *ResolvedAgentConfig) {
+	if !r.LLMProvider.EffectiveCapabilities().FunctionCalling {
+		idx := -1
+		for i, entry := range r.ResolvedFallbackProviders {
+			if !entry.Config.EffectiveCapabilities().FunctionCalling {
+				continue
+			}
+			if r.RequiresNativeTools && entry.Backend != config.LLMBackendNativeGemini {
+				continue
+			}
+			idx = i
+			break
+		}
+		if idx == -1 {
+			r.warnCapability("LLM provider %q (%s) does not support function calling and no fallback provider does: running without tools",
+				r.LLMProviderName, r.LLMProvider.Model)
+			r.ToolsDisabled = true
+			r.MCPServers = nil
+			r.OnDemandSkills = nil
+			return
+		}
+
+		entry := r.ResolvedFallbackProviders[idx]
+		r.warnCapability("LLM provider %q (%s) does not support function calling: using fallback provider %q",
+			r.LLMProviderName, r.LLMProvider.Model, entry.ProviderName)
+		r.LLMProvider = entry.Config
+		r.LLMProviderName = entry.ProviderName
+		r.LLMBackend = entry.Backend
+		r.ResolvedFallbackProviders = append(r.ResolvedFallbackProviders[:idx:idx], r.ResolvedFallbackProviders[idx+1:]...)
+	}
+
+	var kept []ResolvedFallbackEntry
+	dropped := false
+	for _, entry := range r.ResolvedFallbackProviders {
+		if entry.Config.EffectiveCapabilities().FunctionCalling {
+			kept = append(kept, entry)
+			continue
+		}
+		dropped = true
+		r.warnCapability("Fallback provider %q (%s) does not support function calling: skipped",
+			entry.ProviderName, entry.Config.Model)
+	}
+	if dropped {
+		r.ResolvedFallbackProviders = kept
+	}
+}
+
+func (r *ResolvedAgentConfig) warnCapability(format string, args ...any) {
+	r.CapabilityWarnings = append(r.CapabilityWarnings, fmt.Sprintf(format, args...))
+}
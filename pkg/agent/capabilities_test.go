@@ -0,0 +1,122 @@
+package agent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyCapabilityFallbacks(t *testing.T) {
+	noTools := false
+	noStream := false
+	localProvider := &config.LLMProviderConfig{
+		Type:         config.LLMProviderTypeOpenAI,
+		Model:        "local-llama",
+		Capabilities: &config.LLMCapabilities{FunctionCalling: &noTools},
+	}
+	geminiProvider := &config.LLMProviderConfig{Type: config.LLMProviderTypeGoogle, Model: "gemini-2.5-pro"}
+	openaiProvider := &config.LLMProviderConfig{Type: config.LLMProviderTypeOpenAI, Model: "gpt-5"}
+
+	newResolved := func(agentType config.AgentType, provider *config.LLMProviderConfig, fallbacks ...ResolvedFallbackEntry) *ResolvedAgentConfig {
+		return &ResolvedAgentConfig{
+			AgentName:                 "TestAgent",
+			Type:                      agentType,
+			LLMProviderName:           "local",
+			LLMProvider:               provider,
+			LLMBackend:                config.LLMBackendLangChain,
+			MCPServers:                []string{"kubernetes-server"},
+			ResolvedFallbackProviders: fallbacks,
+			LLMCallTimeout:            5 * time.Minute,
+			InitialResponseTimeout:    2 * time.Minute,
+			StallTimeout:              time.Minute,
+		}
+	}
+	gemini := ResolvedFallbackEntry{ProviderName: "gemini", Backend: config.LLMBackendNativeGemini, Config: geminiProvider}
+	openai := ResolvedFallbackEntry{ProviderName: "openai", Backend: config.LLMBackendLangChain, Config: openaiProvider}
+	local := ResolvedFallbackEntry{ProviderName: "local", Backend: config.LLMBackendLangChain, Config: localProvider}
+
+	t.Run("capable model is unchanged", func(t *testing.T) {
+		r := newResolved(config.AgentTypeDefault, openaiProvider, gemini)
+		r.LLMProviderName = "openai"
+		applyCapabilityFallbacks(r)
+
+		assert.Empty(t, r.CapabilityWarnings)
+		assert.False(t, r.ToolsDisabled)
+		assert.Equal(t, []ResolvedFallbackEntry{gemini}, r.ResolvedFallbackProviders)
+		assert.Equal(t, time.Minute, r.StallTimeout)
+	})
+
+	t.Run("promotes first fallback with function calling", func(t *testing.T) {
+		r := newResolved(config.AgentTypeDefault, localProvider, gemini, openai)
+		applyCapabilityFallbacks(r)
+
+		assert.Equal(t, "gemini", r.LLMProviderName)
+		assert.Equal(t, geminiProvider, r.LLMProvider)
+		assert.Equal(t, config.LLMBackendNativeGemini, r.LLMBackend)
+		assert.Equal(t, []ResolvedFallbackEntry{openai}, r.ResolvedFallbackProviders)
+		assert.False(t, r.ToolsDisabled)
+		require.Len(t, r.CapabilityWarnings, 1)
+		assert.Contains(t, r.CapabilityWarnings[0], `using fallback provider "gemini"`)
+	})
+
+	t.Run("native tools skip non-gemini fallbacks", func(t *testing.T) {
+		r := newResolved(config.AgentTypeDefault, localProvider, openai, gemini)
+		r.RequiresNativeTools = true
+		applyCapabilityFallbacks(r)
+
+		assert.Equal(t, "gemini", r.LLMProviderName)
+		assert.Equal(t, []ResolvedFallbackEntry{openai}, r.ResolvedFallbackProviders)
+	})
+
+	t.Run("runs without tools when no provider has function calling", func(t *testing.T) {
+		r := newResolved(config.AgentTypeAction, localProvider)
+		r.OnDemandSkills = []SkillCatalogEntry{{Name: "runbook"}}
+		applyCapabilityFallbacks(r)
+
+		assert.Equal(t, "local", r.LLMProviderName)
+		assert.True(t, r.ToolsDisabled)
+		assert.Nil(t, r.MCPServers)
+		assert.Nil(t, r.OnDemandSkills)
+		require.Len(t, r.CapabilityWarnings, 1)
+		assert.Contains(t, r.CapabilityWarnings[0], "running without tools")
+	})
+
+	t.Run("drops fallbacks without function calling", func(t *testing.T) {
+		r := newResolved(config.AgentTypeDefault, openaiProvider, local, gemini)
+		r.LLMProviderName = "openai"
+		applyCapabilityFallbacks(r)
+
+		assert.Equal(t, []ResolvedFallbackEntry{gemini}, r.ResolvedFallbackProviders)
+		require.Len(t, r.CapabilityWarnings, 1)
+		assert.Contains(t, r.CapabilityWarnings[0], `Fallback provider "local"`)
+	})
+
+	t.Run("tool-less agents keep the model", func(t *testing.T) {
+		r := newResolved(config.AgentTypeExecSummary, localProvider, local)
+		applyCapabilityFallbacks(r)
+
+		assert.Equal(t, "local", r.LLMProviderName)
+		assert.False(t, r.ToolsDisabled)
+		assert.Equal(t, []ResolvedFallbackEntry{local}, r.ResolvedFallbackProviders)
+		assert.Empty(t, r.CapabilityWarnings)
+	})
+
+	t.Run("non-streaming model disables stream timeouts", func(t *testing.T) {
+		provider := &config.LLMProviderConfig{
+			Type:         config.LLMProviderTypeOpenAI,
+			Model:        "batch-model",
+			Capabilities: &config.LLMCapabilities{Streaming: &noStream},
+		}
+		r := newResolved(config.AgentTypeDefault, provider)
+		applyCapabilityFallbacks(r)
+
+		assert.Zero(t, r.InitialResponseTimeout)
+		assert.Zero(t, r.StallTimeout)
+		assert.Equal(t, 5*time.Minute, r.LLMCallTimeout)
+		require.Len(t, r.CapabilityWarnings, 1)
+		assert.Contains(t, r.CapabilityWarnings[0], "does not stream responses")
+	})
+}
@@ -28,6 +28,14 @@ const DefaultLLMCallTimeout = 5 * time.Minute
 // DefaultToolCallTimeout caps a single MCP tool call within an iteration.
 const DefaultToolCallTimeout = 1 * time.Minute
 
+// DefaultWrapUpAt is the fraction of an execution's time budget after which
+// the iterating controller forces a conclusion (time_warnings.wrap_up).
+const DefaultWrapUpAt = 0.8
+
+// DefaultMaxDuplicateToolCalls is how many times an identical tool call may
+// run within an execution before repeats are answered from the prior result.
+const DefaultMaxDuplicateToolCalls = 3
+
 // DefaultInitialResponseTimeout is the max wait for the first streaming chunk
 // before treating the provider as unresponsive.
 const DefaultInitialResponseTimeout = 120 * time.Second
@@ -79,6 +87,18 @@ func ResolveAgentConfig(
 		chain.MaxIterations, stageConfig.MaxIterations, agentConfig.MaxIterations,
 	)
 
+	// Resolve max tool calls (agentDef → chain → stage → agentConfig)
+	maxToolCalls := resolveMaxToolCalls(
+		agentDef.MaxToolCalls, chain.MaxToolCalls,
+		stageConfig.MaxToolCalls, agentConfig.MaxToolCalls,
+	)
+
+	// Resolve generation overrides (agentDef → chain → stage → agentConfig)
+	generation := config.MergeGenerationParams(
+		agentDef.Generation, chain.Generation,
+		stageConfig.Generation, agentConfig.Generation,
+	)
+
 	// Resolve MCP servers (stage-agent > stage > chain > agent-def > defaults)
 	var mcpServers []string
 	if len(agentDef.MCPServers) > 0 {
@@ -114,16 +134,21 @@ func ResolveAgentConfig(
 	skillAgentDef := effectiveAgentDefForSkills(agentDef, agentConfig)
 	requiredSkills, onDemandSkills := resolveSkills(cfg, &skillAgentDef)
 
-	return &ResolvedAgentConfig{
+	resolved := &ResolvedAgentConfig{
 		AgentName:                 agentConfig.Name,
 		Type:                      agentType,
 		LLMBackend:                backend,
 		LLMProvider:               resolvedProvider,
 		LLMProviderName:           providerName,
+		Owner:                     resolveOwner(agentDef, chain),
 		MaxIterations:             maxIter,
+		MaxToolCalls:              maxToolCalls,
+		MaxDuplicateToolCalls:     resolveMaxDuplicateToolCalls(cfg.Defaults),
+		Generation:                generation,
 		IterationTimeout:          DefaultIterationTimeout,
 		LLMCallTimeout:            DefaultLLMCallTimeout,
 		ToolCallTimeout:           DefaultToolCallTimeout,
+		WrapUpAt:                  resolveWrapUpAt(cfg.Defaults, chain),
 		MCPServers:                mcpServers,
 		CustomInstructions:        agentDef.CustomInstructions,
 		FallbackProviders:         fallbackProviders,
@@ -133,7 +158,9 @@ func ResolveAgentConfig(
 		RequiresNativeTools:       requiresNativeTools(agentDef.NativeTools),
 		RequiredSkillContent:      requiredSkills,
 		OnDemandSkills:            onDemandSkills,
-	}, nil
+	}
+	applyCapabilityFallbacks(resolved)
+	return resolved, nil
 }
 
 // ResolveChatProviderName resolves the LLM provider name for a chat execution
@@ -154,6 +181,22 @@ func ResolveChatProviderName(defaults *config.Defaults, chain *config.ChainConfi
 	return providerName
 }
 
+// ResolveOutputLanguage resolves the language for user-facing output using
+// the hierarchy: defaults → chain → alert submission.
+func ResolveOutputLanguage(defaults *config.Defaults, chain *config.ChainConfig, alertLanguage string) string {
+	var lang string
+	if defaults != nil {
+		lang = defaults.OutputLanguage
+	}
+	if chain != nil && chain.OutputLanguage != "" {
+		lang = chain.OutputLanguage
+	}
+	if alertLanguage != "" {
+		lang = alertLanguage
+	}
+	return lang
+}
+
 // ResolveChatAgentConfig builds the agent configuration for a chat execution.
 // Hierarchy: defaults → agent definition → chain → chat config.
 // Similar to ResolveAgentConfig but without stage-level overrides.
@@ -187,10 +230,12 @@ func ResolveChatAgentConfig(
 	var chatBackend config.LLMBackend
 	var chatProvider string
 	var chatMaxIter *int
+	var chatGeneration *config.GenerationParams
 	if chatCfg != nil {
 		chatBackend = chatCfg.LLMBackend
 		chatProvider = chatCfg.LLMProvider
 		chatMaxIter = chatCfg.MaxIterations
+		chatGeneration = chatCfg.Generation
 	}
 
 	// Resolve LLM backend (defaults → agentDef → chain → chatCfg)
@@ -213,6 +258,14 @@ func ResolveChatAgentConfig(
 		chain.MaxIterations, chatMaxIter,
 	)
 
+	// Resolve max tool calls (agentDef → chain)
+	maxToolCalls := resolveMaxToolCalls(agentDef.MaxToolCalls, chain.MaxToolCalls)
+
+	// Resolve generation overrides (agentDef → chain → chatCfg)
+	generation := config.MergeGenerationParams(
+		agentDef.Generation, chain.Generation, chatGeneration,
+	)
+
 	// Resolve MCP servers for chat (lowest-to-highest precedence):
 	// agentDef → chain (or aggregated chain stages) → chatCfg
 	var mcpServers []string
@@ -244,7 +297,7 @@ func ResolveChatAgentConfig(
 
 	requiredSkills, onDemandSkills := resolveSkills(cfg, agentDef)
 
-	return &ResolvedAgentConfig{
+	resolved := &ResolvedAgentConfig{
 		AgentName: agentName,
 		// Chat always uses the iterating function-calling controller,
 		// regardless of what the agent definition's Type field says.
@@ -252,10 +305,15 @@ func ResolveChatAgentConfig(
 		LLMBackend:                backend,
 		LLMProvider:               resolvedProvider,
 		LLMProviderName:           providerName,
+		Owner:                     resolveOwner(agentDef, chain),
 		MaxIterations:             maxIter,
+		MaxToolCalls:              maxToolCalls,
+		MaxDuplicateToolCalls:     resolveMaxDuplicateToolCalls(cfg.Defaults),
+		Generation:                generation,
 		IterationTimeout:          DefaultIterationTimeout,
 		LLMCallTimeout:            DefaultLLMCallTimeout,
 		ToolCallTimeout:           DefaultToolCallTimeout,
+		WrapUpAt:                  resolveWrapUpAt(cfg.Defaults, chain),
 		MCPServers:                mcpServers,
 		CustomInstructions:        agentDef.CustomInstructions,
 		FallbackProviders:         fallbackProviders,
@@ -265,7 +323,9 @@ func ResolveChatAgentConfig(
 		RequiresNativeTools:       requiresNativeTools(agentDef.NativeTools),
 		RequiredSkillContent:      requiredSkills,
 		OnDemandSkills:            onDemandSkills,
-	}, nil
+	}
+	applyCapabilityFallbacks(resolved)
+	return resolved, nil
 }
 
 // ResolveScoringConfig builds the agent configuration for a scoring execution.
@@ -312,10 +372,12 @@ func ResolveScoringConfig(
 	var scoringBackend config.LLMBackend
 	var scoringProvider string
 	var scoringMaxIter *int
+	var scoringGeneration *config.GenerationParams
 	if scoringCfg != nil {
 		scoringBackend = scoringCfg.LLMBackend
 		scoringProvider = scoringCfg.LLMProvider
 		scoringMaxIter = scoringCfg.MaxIterations
+		scoringGeneration = scoringCfg.Generation
 	}
 
 	// Resolve LLM backend (defaults → agentDef → defaults.Scoring → scoringCfg).
@@ -341,6 +403,11 @@ func ResolveScoringConfig(
 		chain.MaxIterations, scoringMaxIter,
 	)
 
+	// Resolve generation overrides (agentDef → scoringCfg). chain.Generation
+	// is intentionally excluded: it tunes investigation agents, and scoring
+	// needs its own (typically low) temperature.
+	generation := config.MergeGenerationParams(agentDef.Generation, scoringGeneration)
+
 	// Resolve MCP servers: agentDef → chain → scoringCfg
 	// No stage aggregation — scoring isn't part of investigation stages.
 	var mcpServers []string
@@ -364,13 +431,15 @@ func ResolveScoringConfig(
 
 	resolvedFallback := resolveFullFallbackEntries(cfg, fallbackProviders, agentDef.NativeTools)
 
-	return &ResolvedAgentConfig{
+	resolved := &ResolvedAgentConfig{
 		AgentName:                 agentName,
 		Type:                      config.AgentTypeScoring,
 		LLMBackend:                backend,
 		LLMProvider:               resolvedProvider,
 		LLMProviderName:           providerName,
+		Owner:                     resolveOwner(agentDef, chain),
 		MaxIterations:             maxIter,
+		Generation:                generation,
 		IterationTimeout:          DefaultIterationTimeout,
 		LLMCallTimeout:            DefaultLLMCallTimeout,
 		ToolCallTimeout:           DefaultToolCallTimeout,
@@ -381,7 +450,9 @@ func ResolveScoringConfig(
 		InitialResponseTimeout:    DefaultInitialResponseTimeout,
 		StallTimeout:              DefaultStallTimeout,
 		RequiresNativeTools:       requiresNativeTools(agentDef.NativeTools),
-	}, nil
+	}
+	applyCapabilityFallbacks(resolved)
+	return resolved, nil
 }
 
 // ResolveExecSummaryConfig builds the agent configuration for an executive summary execution.
@@ -428,6 +499,10 @@ func ResolveExecSummaryConfig(
 		defaults.MaxIterations, agentDef.MaxIterations, chain.MaxIterations, nil,
 	)
 
+	// Resolve generation overrides (agentDef only). Like scoring, the summary
+	// is not tuned by chain.Generation, which targets investigation agents.
+	generation := config.MergeGenerationParams(agentDef.Generation)
+
 	// Resolve fallback providers (defaults → chain).
 	fallbackProviders := resolveFallbackProviders(
 		defaults.FallbackProviders, chain.FallbackProviders,
@@ -438,13 +513,15 @@ func ResolveExecSummaryConfig(
 
 	resolvedFallback := resolveFullFallbackEntries(cfg, fallbackProviders, agentDef.NativeTools)
 
-	return &ResolvedAgentConfig{
+	resolved := &ResolvedAgentConfig{
 		AgentName:                 config.AgentNameExecSummary,
 		Type:                      config.AgentTypeExecSummary,
 		LLMBackend:                backend,
 		LLMProvider:               resolvedProvider,
 		LLMProviderName:           providerName,
+		Owner:                     resolveOwner(agentDef, chain),
 		MaxIterations:             maxIter,
+		Generation:                generation,
 		IterationTimeout:          DefaultIterationTimeout,
 		LLMCallTimeout:            DefaultLLMCallTimeout,
 		ToolCallTimeout:           DefaultToolCallTimeout,
@@ -454,9 +531,71 @@ func ResolveExecSummaryConfig(
 		InitialResponseTimeout:    DefaultInitialResponseTimeout,
 		StallTimeout:              DefaultStallTimeout,
 		RequiresNativeTools:       requiresNativeTools(agentDef.NativeTools),
+	}
+	applyCapabilityFallbacks(resolved)
+	return resolved, nil
+}
+
+// ResolveSideCallConfig builds the configuration for a single-shot LLM call
+// made outside an agent execution (outcome classification, action item
+// extraction, recurrence comparison, ...). Like executive summaries, side
+// calls default to the chain's executive_summary_provider.
+// Hierarchy: defaults → chain (llm_provider, executive_summary_provider) → providerOverride.
+// chain may be nil, in which case only defaults and providerOverride apply.
+func ResolveSideCallConfig(
+	cfg *config.Config,
+	chain *config.ChainConfig,
+	providerOverride string,
+) (*ResolvedAgentConfig, error) {
+	var defaults config.Defaults
+	if cfg.Defaults != nil {
+		defaults = *cfg.Defaults
+	}
+	if chain == nil {
+		chain = &config.ChainConfig{}
+	}
+
+	// Resolve LLM backend (defaults → chain).
+	backend := resolveLLMBackend(defaults.LLMBackend, chain.LLMBackend)
+
+	// Resolve LLM provider (defaults → chain.llm_provider →
+	// chain.executive_summary_provider → providerOverride).
+	provider, providerName, err := resolveLLMProvider(cfg,
+		defaults.LLMProvider, chain.LLMProvider, chain.ExecutiveSummaryProvider, providerOverride,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResolvedAgentConfig{
+		LLMBackend:             backend,
+		LLMProvider:            provider,
+		LLMProviderName:        providerName,
+		Owner:                  chain.Owner,
+		LLMCallTimeout:         DefaultLLMCallTimeout,
+		InitialResponseTimeout: DefaultInitialResponseTimeout,
+		StallTimeout:           DefaultStallTimeout,
 	}, nil
 }
 
+// resolveOwner returns the team charged for an agent's LLM usage: the
+// agent definition's owner, else the chain's.
+func resolveOwner(agentDef *config.AgentConfig, chain *config.ChainConfig) string {
+	if agentDef.Owner != "" {
+		return agentDef.Owner
+	}
+	return chain.Owner
+}
+
+// resolveWrapUpAt returns the fraction of an execution's time budget after
+// which the agent must conclude (defaults → chain time_warnings.wrap_up).
+func resolveWrapUpAt(defaults *config.Defaults, chain *config.ChainConfig) float64 {
+	if wrapUp := config.ResolveTimeWarnings(defaults, chain).WrapUp; wrapUp > 0 {
+		return wrapUp
+	}
+	return DefaultWrapUpAt
+}
+
 // requiresNativeTools returns true when the agent definition declares at least
 // one enabled native tool. Used to set RequiresNativeTools on ResolvedAgentConfig.
 func requiresNativeTools(agentTools map[config.GoogleNativeTool]bool) bool {
@@ -609,6 +748,27 @@ func resolveMaxIterations(overrides ...*int) int {
 	return maxIter
 }
 
+// resolveMaxToolCalls returns the last non-nil value from the given
+// overrides, or 0 (unlimited) when none is set.
+func resolveMaxToolCalls(overrides ...*int) int {
+	maxCalls := 0
+	for _, o := range overrides {
+		if o != nil {
+			maxCalls = *o
+		}
+	}
+	return maxCalls
+}
+
+// resolveMaxDuplicateToolCalls returns defaults.max_duplicate_tool_calls,
+// falling back to DefaultMaxDuplicateToolCalls.
+func resolveMaxDuplicateToolCalls(defaults *config.Defaults) int {
+	if defaults != nil && defaults.MaxDuplicateToolCalls != nil {
+		return *defaults.MaxDuplicateToolCalls
+	}
+	return DefaultMaxDuplicateToolCalls
+}
+
 // resolveSkills determines which skills an agent gets, split into required
 // (injected into prompt) and on-demand (available via load_skill tool).
 //
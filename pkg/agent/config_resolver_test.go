@@ -10,6 +10,8 @@ import (
 
 func intPtr(i int) *int { return &i }
 
+func floatPtr(f float64) *float64 { return &f }
+
 func TestResolveAgentConfig(t *testing.T) {
 	// Setup: build a Config with registries
 	maxIter25 := 25
@@ -1589,6 +1591,18 @@ func TestResolveAdaptiveTimeoutDefaults(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, DefaultInitialResponseTimeout, resolved.InitialResponseTimeout)
 		assert.Equal(t, DefaultStallTimeout, resolved.StallTimeout)
+		assert.Equal(t, DefaultWrapUpAt, resolved.WrapUpAt)
+	})
+
+	t.Run("chain time_warnings.wrap_up overrides the default", func(t *testing.T) {
+		chain := &config.ChainConfig{TimeWarnings: &config.TimeWarningsConfig{WrapUp: 0.9}}
+		resolved, err := ResolveAgentConfig(cfg, chain, config.StageConfig{}, config.StageAgentConfig{Name: "TestAgent"})
+		require.NoError(t, err)
+		assert.Equal(t, 0.9, resolved.WrapUpAt)
+
+		resolved, err = ResolveAgentConfig(cfg, &config.ChainConfig{}, config.StageConfig{}, config.StageAgentConfig{Name: "TestAgent"})
+		require.NoError(t, err)
+		assert.Equal(t, DefaultWrapUpAt, resolved.WrapUpAt)
 	})
 
 	t.Run("ResolveScoringConfig sets timeout defaults", func(t *testing.T) {
@@ -1606,6 +1620,208 @@ func TestResolveAdaptiveTimeoutDefaults(t *testing.T) {
 	})
 }
 
+func TestResolveGenerationParams(t *testing.T) {
+	temp := func(v float64) *config.GenerationParams { return &config.GenerationParams{Temperature: &v} }
+	maxTokens := 4096
+
+	cfg := &config.Config{
+		Defaults: &config.Defaults{LLMProvider: "google-default"},
+		AgentRegistry: config.NewAgentRegistry(map[string]*config.AgentConfig{
+			"TestAgent": {Generation: &config.GenerationParams{
+				Temperature:     floatPtr(0.9),
+				MaxOutputTokens: &maxTokens,
+			}},
+			"PlainAgent":                {},
+			config.AgentNameScoring:     {Type: config.AgentTypeScoring, Generation: temp(0.2)},
+			config.AgentNameChat:        {},
+			config.AgentNameExecSummary: {Type: config.AgentTypeExecSummary},
+		}),
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"google-default": {
+				Type:                config.LLMProviderTypeGoogle,
+				Model:               "gemini-2.5-pro",
+				MaxToolResultTokens: 950000,
+				Generation:          temp(1.0),
+			},
+		}),
+	}
+
+	t.Run("no overrides leaves generation nil", func(t *testing.T) {
+		resolved, err := ResolveAgentConfig(cfg, &config.ChainConfig{}, config.StageConfig{},
+			config.StageAgentConfig{Name: "PlainAgent"})
+		require.NoError(t, err)
+		assert.Nil(t, resolved.Generation, "provider defaults are applied per call, not resolved in")
+	})
+
+	t.Run("agent def, chain, stage and stage agent merge per field", func(t *testing.T) {
+		resolved, err := ResolveAgentConfig(cfg,
+			&config.ChainConfig{Generation: &config.GenerationParams{TopP: floatPtr(0.5)}},
+			config.StageConfig{Generation: temp(0.7)},
+			config.StageAgentConfig{Name: "TestAgent", Generation: &config.GenerationParams{
+				ReasoningEffort: config.ReasoningEffortLow,
+			}},
+		)
+		require.NoError(t, err)
+		require.NotNil(t, resolved.Generation)
+		assert.Equal(t, 0.7, *resolved.Generation.Temperature, "stage overrides agent def")
+		assert.Equal(t, 0.5, *resolved.Generation.TopP)
+		assert.Equal(t, 4096, *resolved.Generation.MaxOutputTokens)
+		assert.Equal(t, config.ReasoningEffortLow, resolved.Generation.ReasoningEffort)
+	})
+
+	t.Run("stage agent overrides stage", func(t *testing.T) {
+		resolved, err := ResolveAgentConfig(cfg, &config.ChainConfig{},
+			config.StageConfig{Generation: temp(0.7)},
+			config.StageAgentConfig{Name: "TestAgent", Generation: temp(0.1)},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 0.1, *resolved.Generation.Temperature)
+	})
+
+	t.Run("chat uses chat config over chain", func(t *testing.T) {
+		resolved, err := ResolveChatAgentConfig(cfg,
+			&config.ChainConfig{Generation: temp(0.7)},
+			&config.ChatConfig{Generation: temp(0.3)},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 0.3, *resolved.Generation.Temperature)
+	})
+
+	t.Run("scoring ignores chain generation", func(t *testing.T) {
+		resolved, err := ResolveScoringConfig(cfg, &config.ChainConfig{Generation: temp(0.7)}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0.2, *resolved.Generation.Temperature)
+
+		resolved, err = ResolveScoringConfig(cfg, &config.ChainConfig{},
+			&config.ScoringConfig{Generation: temp(0)})
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, *resolved.Generation.Temperature)
+	})
+
+	t.Run("exec summary ignores chain generation", func(t *testing.T) {
+		resolved, err := ResolveExecSummaryConfig(cfg, &config.ChainConfig{Generation: temp(0.7)})
+		require.NoError(t, err)
+		assert.Nil(t, resolved.Generation)
+	})
+}
+
+func TestResolveMaxToolCalls(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	cfg := &config.Config{
+		Defaults: &config.Defaults{LLMProvider: "google-default"},
+		AgentRegistry: config.NewAgentRegistry(map[string]*config.AgentConfig{
+			"TestAgent":          {MaxToolCalls: intPtr(30)},
+			"PlainAgent":         {},
+			config.AgentNameChat: {},
+		}),
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"google-default": {
+				Type:                config.LLMProviderTypeGoogle,
+				Model:               "gemini-2.5-pro",
+				MaxToolResultTokens: 950000,
+			},
+		}),
+	}
+
+	t.Run("unset means unlimited", func(t *testing.T) {
+		resolved, err := ResolveAgentConfig(cfg, &config.ChainConfig{}, config.StageConfig{},
+			config.StageAgentConfig{Name: "PlainAgent"})
+		require.NoError(t, err)
+		assert.Equal(t, 0, resolved.MaxToolCalls)
+	})
+
+	t.Run("agent def applies", func(t *testing.T) {
+		resolved, err := ResolveAgentConfig(cfg, &config.ChainConfig{}, config.StageConfig{},
+			config.StageAgentConfig{Name: "TestAgent"})
+		require.NoError(t, err)
+		assert.Equal(t, 30, resolved.MaxToolCalls)
+	})
+
+	t.Run("chain, stage and stage agent override in order", func(t *testing.T) {
+		chain := &config.ChainConfig{MaxToolCalls: intPtr(20)}
+		resolved, err := ResolveAgentConfig(cfg, chain, config.StageConfig{},
+			config.StageAgentConfig{Name: "TestAgent"})
+		require.NoError(t, err)
+		assert.Equal(t, 20, resolved.MaxToolCalls)
+
+		resolved, err = ResolveAgentConfig(cfg, chain, config.StageConfig{MaxToolCalls: intPtr(10)},
+			config.StageAgentConfig{Name: "TestAgent"})
+		require.NoError(t, err)
+		assert.Equal(t, 10, resolved.MaxToolCalls)
+
+		resolved, err = ResolveAgentConfig(cfg, chain, config.StageConfig{MaxToolCalls: intPtr(10)},
+			config.StageAgentConfig{Name: "TestAgent", MaxToolCalls: intPtr(5)})
+		require.NoError(t, err)
+		assert.Equal(t, 5, resolved.MaxToolCalls)
+	})
+
+	t.Run("chat inherits the chain limit", func(t *testing.T) {
+		resolved, err := ResolveChatAgentConfig(cfg, &config.ChainConfig{MaxToolCalls: intPtr(15)}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 15, resolved.MaxToolCalls)
+	})
+
+	t.Run("max_duplicate_tool_calls defaults and overrides", func(t *testing.T) {
+		resolved, err := ResolveAgentConfig(cfg, &config.ChainConfig{}, config.StageConfig{},
+			config.StageAgentConfig{Name: "PlainAgent"})
+		require.NoError(t, err)
+		assert.Equal(t, DefaultMaxDuplicateToolCalls, resolved.MaxDuplicateToolCalls)
+
+		withDefault := *cfg
+		withDefault.Defaults = &config.Defaults{LLMProvider: "google-default", MaxDuplicateToolCalls: intPtr(5)}
+		resolved, err = ResolveChatAgentConfig(&withDefault, &config.ChainConfig{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 5, resolved.MaxDuplicateToolCalls)
+	})
+}
+
+func TestResolveOwner(t *testing.T) {
+	cfg := &config.Config{
+		Defaults: &config.Defaults{LLMProvider: "google-default"},
+		AgentRegistry: config.NewAgentRegistry(map[string]*config.AgentConfig{
+			"OwnedAgent":                {Owner: "observability"},
+			"PlainAgent":                {},
+			config.AgentNameChat:        {},
+			config.AgentNameExecSummary: {Type: config.AgentTypeExecSummary},
+		}),
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"google-default": {Type: config.LLMProviderTypeGoogle, Model: "gemini-2.5-pro"},
+		}),
+	}
+	chain := &config.ChainConfig{Owner: "payments-sre"}
+
+	resolved, err := ResolveAgentConfig(cfg, chain, config.StageConfig{}, config.StageAgentConfig{Name: "OwnedAgent"})
+	require.NoError(t, err)
+	assert.Equal(t, "observability", resolved.Owner, "agent owner wins")
+
+	resolved, err = ResolveAgentConfig(cfg, chain, config.StageConfig{}, config.StageAgentConfig{Name: "PlainAgent"})
+	require.NoError(t, err)
+	assert.Equal(t, "payments-sre", resolved.Owner, "falls back to the chain owner")
+
+	resolved, err = ResolveChatAgentConfig(cfg, chain, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "payments-sre", resolved.Owner)
+
+	resolved, err = ResolveExecSummaryConfig(cfg, chain)
+	require.NoError(t, err)
+	assert.Equal(t, "payments-sre", resolved.Owner)
+
+	resolved, err = ResolveAgentConfig(cfg, &config.ChainConfig{}, config.StageConfig{}, config.StageAgentConfig{Name: "PlainAgent"})
+	require.NoError(t, err)
+	assert.Empty(t, resolved.Owner, "unattributed")
+}
+
+func TestResolveOutputLanguage(t *testing.T) {
+	defaults := &config.Defaults{OutputLanguage: "German"}
+	chain := &config.ChainConfig{OutputLanguage: "Japanese"}
+
+	assert.Equal(t, "", ResolveOutputLanguage(nil, nil, ""))
+	assert.Equal(t, "German", ResolveOutputLanguage(defaults, &config.ChainConfig{}, ""))
+	assert.Equal(t, "Japanese", ResolveOutputLanguage(defaults, chain, ""))
+	assert.Equal(t, "French", ResolveOutputLanguage(defaults, chain, "French"))
+}
+
 func TestResolveExecSummaryConfig(t *testing.T) {
 	defaults := &config.Defaults{
 		LLMProvider: "google-default",
@@ -1732,6 +1948,56 @@ func TestResolveExecSummaryConfig(t *testing.T) {
 	})
 }
 
+func TestResolveSideCallConfig(t *testing.T) {
+	googleProvider := &config.LLMProviderConfig{Type: config.LLMProviderTypeGoogle, Model: "gemini-2.5-pro"}
+	openaiProvider := &config.LLMProviderConfig{Type: config.LLMProviderTypeOpenAI, Model: "gpt-5"}
+	anthropicProvider := &config.LLMProviderConfig{Type: config.LLMProviderTypeAnthropic, Model: "claude-sonnet"}
+	cfg := &config.Config{
+		Defaults: &config.Defaults{LLMProvider: "google-default", LLMBackend: config.LLMBackendLangChain},
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"google-default":    googleProvider,
+			"openai-default":    openaiProvider,
+			"anthropic-default": anthropicProvider,
+		}),
+	}
+
+	t.Run("nil chain uses defaults", func(t *testing.T) {
+		resolved, err := ResolveSideCallConfig(cfg, nil, "")
+		require.NoError(t, err)
+		assert.Equal(t, googleProvider, resolved.LLMProvider)
+		assert.Equal(t, "google-default", resolved.LLMProviderName)
+		assert.Equal(t, config.LLMBackendLangChain, resolved.LLMBackend)
+		assert.Equal(t, DefaultStallTimeout, resolved.StallTimeout)
+		assert.Equal(t, DefaultInitialResponseTimeout, resolved.InitialResponseTimeout)
+	})
+
+	t.Run("chain executive_summary_provider, backend and owner", func(t *testing.T) {
+		chain := &config.ChainConfig{
+			LLMProvider:              "openai-default",
+			ExecutiveSummaryProvider: "anthropic-default",
+			LLMBackend:               config.LLMBackendNativeGemini,
+			Owner:                    "team-sre",
+		}
+		resolved, err := ResolveSideCallConfig(cfg, chain, "")
+		require.NoError(t, err)
+		assert.Equal(t, "anthropic-default", resolved.LLMProviderName)
+		assert.Equal(t, config.LLMBackendNativeGemini, resolved.LLMBackend)
+		assert.Equal(t, "team-sre", resolved.Owner)
+	})
+
+	t.Run("provider override wins", func(t *testing.T) {
+		chain := &config.ChainConfig{ExecutiveSummaryProvider: "anthropic-default"}
+		resolved, err := ResolveSideCallConfig(cfg, chain, "openai-default")
+		require.NoError(t, err)
+		assert.Equal(t, openaiProvider, resolved.LLMProvider)
+	})
+
+	t.Run("unknown provider returns error", func(t *testing.T) {
+		_, err := ResolveSideCallConfig(cfg, nil, "nonexistent-provider")
+		assert.Error(t, err)
+	})
+}
+
 func TestResolveSkills(t *testing.T) {
 	registry := config.NewSkillRegistry(map[string]*config.SkillConfig{
 		"kubernetes-basics": {
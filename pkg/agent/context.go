@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
@@ -24,6 +25,10 @@ type ExecutionContext struct {
 	// Arbitrary text — not parsed, not assumed to be JSON.
 	AlertData string
 
+	// Images submitted with the alert (loaded from the blob store by the
+	// executor). Attached to the initial user message.
+	AlertImages []ImagePart
+
 	// Alert type (from session/chain config)
 	AlertType string
 
@@ -33,6 +38,22 @@ type ExecutionContext struct {
 	// Runbook content (fetched by executor, passed as text)
 	RunbookContent string
 
+	// Extra guidance submitted with the alert that applies to this agent
+	// (global, then stage, then agent). Empty when the alert carried none.
+	AlertInstructions string
+
+	// On-call engineer for the alert's service, looked up when the session
+	// started. nil when on-call lookup is disabled or no service matched.
+	OnCall *schema.OnCallContact
+
+	// Language for user-facing output (final answer, executive summary,
+	// chat answers). Empty leaves the choice to the model.
+	OutputLanguage string
+
+	// Chain's executive_summary block, read by the executive summary
+	// controller. Nil keeps the built-in prompt.
+	ExecutiveSummary *config.ExecutiveSummaryConfig
+
 	// Configuration (resolved from hierarchy)
 	Config *ResolvedAgentConfig
 
@@ -58,6 +79,20 @@ type ExecutionContext struct {
 	// Implemented by orchestrator.ResultCollector; interface avoids agent↔orchestrator cycle.
 	SubAgentCollector SubAgentResultCollector
 
+	// OrchestratorBudget reports how much of an orchestrator's budget is
+	// used. Its note is added before every LLM call. nil for non-orchestrator
+	// agents. Implemented by orchestrator.BudgetTracker.
+	OrchestratorBudget OrchestratorBudgetSource
+
+	// OperatorNotes delivers notes operators post to the running session.
+	// Drained at the start of every iteration. nil when not wired (e.g. chat).
+	OperatorNotes OperatorNoteSource
+
+	// DisabledServers reports MCP servers operators turn off for the
+	// session while the agent runs. Checked at the start of every iteration.
+	// nil when not wired.
+	DisabledServers DisabledServerSource
+
 	// SubAgentCatalog lists agents available for orchestrator dispatch.
 	// Used by the prompt builder to include the catalog in the system prompt.
 	SubAgentCatalog []config.SubAgentEntry
@@ -67,9 +102,24 @@ type ExecutionContext struct {
 	// nil when all servers initialized successfully.
 	FailedServers map[string]string
 
+	// LinkTemplates lists the generate_link templates the agent can use.
+	// Used by the prompt builder to document the tool. nil when the tool is
+	// not wired.
+	LinkTemplates []LinkTemplateEntry
+
+	// RecentHistory summarizes earlier sessions of the same alert type,
+	// gathered when the session started. nil when the block is off for the
+	// chain or no earlier session matched.
+	RecentHistory *RecentHistory
+
 	// MemoryBriefing holds pre-retrieved memories for Tier 4 prompt injection.
 	// nil when memory is disabled or no relevant memories exist.
 	MemoryBriefing *MemoryBriefing
+
+	// ProviderHealth receives the outcome of every streamed LLM call so the
+	// session executor can detect failing providers. nil when degradation
+	// is disabled.
+	ProviderHealth *ProviderHealth
 }
 
 // ServiceBundle groups all service dependencies needed during execution.
@@ -96,13 +146,25 @@ type ResolvedAgentConfig struct {
 	LLMBackend         config.LLMBackend // Determines SDK path (sent as-is to LLM service)
 	LLMProvider        *config.LLMProviderConfig
 	LLMProviderName    string // The resolved provider key (for observability / DB records)
+	Owner              string // Team charged for the agent's LLM usage (agent → chain; "" = unattributed)
 	MaxIterations      int
+	MaxToolCalls       int           // Tool calls after which the agent must conclude (0 = unlimited)
 	IterationTimeout   time.Duration // Overall per-iteration ceiling (default: 6m)
 	LLMCallTimeout     time.Duration // Per-LLM-streaming-call timeout (default: 5m)
 	ToolCallTimeout    time.Duration // Per-MCP-tool-call timeout (default: 1m)
+	WrapUpAt           float64       // Fraction of the time budget after which the agent must conclude (default: 0.8; 0 or 1 = never)
 	MCPServers         []string
 	CustomInstructions string
 
+	// Runs allowed per identical tool call (same tool and arguments); repeats
+	// beyond it get the prior result and a warning (0 = no detection).
+	MaxDuplicateToolCalls int
+
+	// Generation parameter overrides from the agent definition and chain
+	// hierarchy (nil = none). Layered over the provider's own Generation at
+	// call time, so they survive a switch to a fallback provider.
+	Generation *config.GenerationParams
+
 	// Fallback providers to try when the primary provider fails (ordered by preference)
 	FallbackProviders []config.FallbackProviderEntry
 	// Pre-resolved fallback provider configs (parallel to FallbackProviders)
@@ -129,6 +191,17 @@ type ResolvedAgentConfig struct {
 	// OnDemandSkills: skills available via load_skill tool.
 	// Names + descriptions for the catalog prompt (Tier 2.6). Bodies loaded on tool call.
 	OnDemandSkills []SkillCatalogEntry
+
+	// ToolsDisabled is set when the model lacks function calling and no
+	// fallback provider has it: the agent runs without MCP tools, skills, or
+	// sub-agents and answers from the alert and stage context alone.
+	ToolsDisabled bool
+
+	// CapabilityWarnings describes the fallbacks applied because the agent's
+	// requested features exceed the selected model's capabilities (see
+	// applyCapabilityFallbacks). Recorded as capability_fallback timeline
+	// events when the agent runs.
+	CapabilityWarnings []string
 }
 
 // ResolvedSkill is a skill whose full body has been resolved from the registry.
@@ -162,10 +235,14 @@ type PromptBuilder interface {
 	BuildFunctionCallingMessages(execCtx *ExecutionContext, prevStageContext string) []ConversationMessage
 	BuildSynthesisMessages(execCtx *ExecutionContext, prevStageContext string) []ConversationMessage
 	BuildForcedConclusionPrompt(iteration int) string
+	BuildTimeBudgetConclusionPrompt(elapsed, remaining time.Duration) string
+	BuildToolCallLimitConclusionPrompt(toolCalls int) string
 	BuildMCPSummarizationSystemPrompt(serverName, toolName string, maxSummaryTokens int) string
 	BuildMCPSummarizationUserPrompt(conversationContext, serverName, toolName, resultText string) string
-	BuildExecutiveSummarySystemPrompt() string
-	BuildExecutiveSummaryUserPrompt(finalAnalysis string) string
+	BuildExecutiveSummarySystemPrompt(outputLanguage string, cfg *config.ExecutiveSummaryConfig) string
+	BuildExecutiveSummaryUserPrompt(finalAnalysis string, cfg *config.ExecutiveSummaryConfig) string
+	BuildTechnicalSummarySystemPrompt(outputLanguage string) string
+	BuildTechnicalSummaryUserPrompt(finalAnalysis string) string
 	BuildScoringSystemPrompt() string
 	BuildScoringInitialPrompt(sessionInvestigationContext, outputSchema string) string
 	BuildScoringOutputSchemaReminderPrompt(outputSchema string) string
@@ -190,8 +267,11 @@ type EventPublisher interface {
 	PublishSessionProgress(ctx context.Context, payload events.SessionProgressPayload) error
 	PublishExecutionProgress(ctx context.Context, sessionID string, payload events.ExecutionProgressPayload) error
 	PublishExecutionStatus(ctx context.Context, sessionID string, payload events.ExecutionStatusPayload) error
+	PublishSubAgentDispatched(ctx context.Context, sessionID string, payload events.SubAgentDispatchedPayload) error
+	PublishSubAgentStatus(ctx context.Context, sessionID string, payload events.SubAgentStatusPayload) error
 	PublishReviewStatus(ctx context.Context, sessionID string, payload events.ReviewStatusPayload) error
 	PublishSessionScoreUpdated(ctx context.Context, sessionID string, payload events.SessionScoreUpdatedPayload) error
+	PublishMCPServerLog(ctx context.Context, sessionID string, payload events.MCPServerLogPayload) error
 }
 
 // SubAgentResultCollector provides push-based delivery of completed sub-agent
@@ -213,6 +293,59 @@ type SubAgentResultCollector interface {
 	HasPending() bool
 }
 
+// OrchestratorBudgetSource reports an orchestrator's time and token budget.
+// Implemented by orchestrator.BudgetTracker.
+type OrchestratorBudgetSource interface {
+	// BudgetNote returns the budget used so far — ownUsage, the
+	// orchestrator's own tokens, plus those of its finished sub-agents — and
+	// what remains, formatted as a user message.
+	BudgetNote(ownUsage TokenUsage) ConversationMessage
+}
+
+// OperatorNoteSource delivers operator notes posted to a running session.
+// Implemented by the session executor, which reads them from the timeline.
+type OperatorNoteSource interface {
+	// TryDrainNotes returns notes posted since the last call, formatted as
+	// user messages. Never blocks on the agent; returns nil when there are none.
+	TryDrainNotes(ctx context.Context) []ConversationMessage
+}
+
+// DisabledServerSource reports MCP servers disabled for a running session.
+// Implemented by the session executors, which read them from the timeline.
+type DisabledServerSource interface {
+	// TryDrainDisabled returns a notice for each of the agent's servers
+	// disabled since the last call, formatted as user messages. From then on
+	// the agent's ToolExecutor no longer lists or runs those servers' tools.
+	// Returns nil when nothing changed.
+	TryDrainDisabled(ctx context.Context) []ConversationMessage
+}
+
+// LinkTemplateEntry describes a generate_link URL template for the prompt.
+type LinkTemplateEntry struct {
+	Name        string
+	Description string
+	Params      []string // parameters the agent must supply
+}
+
+// RecentHistory summarizes earlier sessions of the same alert type (and
+// service) for the prompt, so recurring alerts are analyzed in context.
+type RecentHistory struct {
+	Window   time.Duration
+	Service  string // "" when sessions matched on alert type only
+	Total    int    // matching sessions in the window; Sessions may list fewer
+	Sessions []RecentSession
+}
+
+// RecentSession is one earlier session in RecentHistory, most recent first.
+type RecentSession struct {
+	ID        string
+	CreatedAt time.Time
+	Status    string
+	Outcome   string // "" when not classified
+	Severity  string // "" when not assessed
+	Summary   string // executive summary, else the start of the final analysis or error
+}
+
 // MemoryBriefing carries pre-retrieved memories for auto-injection into the
 // agent's system prompt (Tier 4) and for excluding from tool search results.
 type MemoryBriefing struct {
@@ -234,6 +367,8 @@ type MemoryHint struct {
 type ChatContext struct {
 	UserQuestion         string
 	InvestigationContext string
+	Images               []ImagePart // Images attached to the question
+	Guardrails           []string    // Chain chat.guardrails, then the session's own
 }
 
 // SubAgentContext carries sub-agent-specific data for controllers and prompt
@@ -3,6 +3,7 @@ package context
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 // StageResult holds the output of a completed stage for context building.
@@ -40,3 +41,51 @@ func BuildStageContext(stages []StageResult) string {
 	sb.WriteString("<!-- CHAIN_CONTEXT_END -->")
 	return sb.String()
 }
+
+// FormatAlertResolutionNote formats the note injected into the next stage's
+// context when the alert source reports the alert resolved while the session
+// is still running. reason may be empty.
+func FormatAlertResolutionNote(resolvedAt time.Time, reason string) string {
+	var sb strings.Builder
+	sb.WriteString("### Alert Update\n\n")
+	sb.WriteString(fmt.Sprintf("The alert source reported this alert as RESOLVED at %s", resolvedAt.UTC().Format(time.RFC3339)))
+	if reason != "" {
+		sb.WriteString(fmt.Sprintf(" (reason: %s)", reason))
+	}
+	sb.WriteString(". Take this into account: determine whether the condition cleared on its own or was fixed, " +
+		"and do not recommend remediation that is no longer needed.")
+	return sb.String()
+}
+
+// FormatMCPServerDisabled tells a running agent that an operator turned off
+// one of its MCP servers. author and reason may be empty.
+func FormatMCPServerDisabled(serverID, author, reason string) string {
+	var sb strings.Builder
+	sb.WriteString("### MCP Server Disabled\n\n")
+	if author != "" {
+		sb.WriteString(fmt.Sprintf("%s disabled the MCP server **%s** for the rest of this session", author, serverID))
+	} else {
+		sb.WriteString(fmt.Sprintf("An operator disabled the MCP server **%s** for the rest of this session", serverID))
+	}
+	if reason != "" {
+		sb.WriteString(fmt.Sprintf(" (reason: %s)", reason))
+	}
+	sb.WriteString(". Its tools are no longer available; continue with the remaining tools.")
+	return sb.String()
+}
+
+// FormatOperatorNote formats a note an operator posted to the running
+// session. Used both for mid-stage delivery to the running agent and when
+// carrying notes into later stages' context. author may be empty.
+func FormatOperatorNote(author, content string, postedAt time.Time) string {
+	var sb strings.Builder
+	sb.WriteString("### Operator Note\n\n")
+	if author != "" {
+		sb.WriteString(fmt.Sprintf("%s added a note at %s", author, postedAt.UTC().Format(time.RFC3339)))
+	} else {
+		sb.WriteString(fmt.Sprintf("An operator added a note at %s", postedAt.UTC().Format(time.RFC3339)))
+	}
+	sb.WriteString(" while this investigation was running. Treat it as new information about the environment:\n\n")
+	sb.WriteString(content)
+	return sb.String()
+}
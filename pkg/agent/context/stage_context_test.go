@@ -1,7 +1,9 @@
 package context
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -37,3 +39,51 @@ func TestBuildStageContext(t *testing.T) {
 		assert.Equal(t, expected, result)
 	})
 }
+
+func TestFormatAlertResolutionNote(t *testing.T) {
+	resolvedAt := time.Date(2026, 3, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	t.Run("with reason", func(t *testing.T) {
+		note := FormatAlertResolutionNote(resolvedAt, "pod restarted")
+		assert.Contains(t, note, "### Alert Update")
+		assert.Contains(t, note, "RESOLVED at 2026-03-01T11:30:00Z (reason: pod restarted).")
+	})
+
+	t.Run("without reason", func(t *testing.T) {
+		note := FormatAlertResolutionNote(resolvedAt, "")
+		assert.Contains(t, note, "RESOLVED at 2026-03-01T11:30:00Z.")
+		assert.NotContains(t, note, "reason:")
+	})
+}
+
+func TestFormatOperatorNote(t *testing.T) {
+	postedAt := time.Date(2026, 3, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	t.Run("with author", func(t *testing.T) {
+		note := FormatOperatorNote("alice", "we just rolled back deploy-1234", postedAt)
+		assert.Contains(t, note, "### Operator Note")
+		assert.Contains(t, note, "alice added a note at 2026-03-01T11:30:00Z")
+		assert.True(t, strings.HasSuffix(note, "\n\nwe just rolled back deploy-1234"))
+	})
+
+	t.Run("without author", func(t *testing.T) {
+		note := FormatOperatorNote("", "scaled the deployment to 3 replicas", postedAt)
+		assert.Contains(t, note, "An operator added a note at 2026-03-01T11:30:00Z")
+		assert.Contains(t, note, "scaled the deployment to 3 replicas")
+	})
+}
+
+func TestFormatMCPServerDisabled(t *testing.T) {
+	t.Run("with author and reason", func(t *testing.T) {
+		msg := FormatMCPServerDisabled("kubernetes-server", "alice", "returns stale data")
+		assert.Contains(t, msg, "### MCP Server Disabled")
+		assert.Contains(t, msg, "alice disabled the MCP server **kubernetes-server**")
+		assert.Contains(t, msg, "(reason: returns stale data)")
+	})
+
+	t.Run("without author or reason", func(t *testing.T) {
+		msg := FormatMCPServerDisabled("kubernetes-server", "", "")
+		assert.Contains(t, msg, "An operator disabled the MCP server **kubernetes-server**")
+		assert.NotContains(t, msg, "reason:")
+	})
+}
@@ -26,6 +26,8 @@ func (f *Factory) CreateController(agentType config.AgentType, execCtx *agent.Ex
 		return NewSynthesisController(execCtx.PromptBuilder), nil
 	case config.AgentTypeExecSummary:
 		return NewExecSummaryController(execCtx.PromptBuilder), nil
+	case config.AgentTypeTechnicalSummary:
+		return NewTechnicalSummaryController(execCtx.PromptBuilder), nil
 	case config.AgentTypeScoring:
 		return NewScoringController(), nil
 	case config.AgentTypeAction:
@@ -11,6 +11,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/tokenizer"
 	"github.com/google/uuid"
 )
 
@@ -34,6 +35,32 @@ func accumulateTokenUsage(total *agent.TokenUsage, usage *agent.TokenUsage) {
 	total.ThinkingTokens += usage.ThinkingTokens
 }
 
+// promptSize returns the total character count of the prompt messages and
+// the share contributed by tool results. Persisted on each LLM interaction so
+// context growth can be attributed without re-reading the conversation.
+func promptSize(messages []agent.ConversationMessage) (total, toolResults int) {
+	return promptCount(messages, func(text string) int { return len(text) })
+}
+
+// promptTokens is promptSize counted in tokens by the model's tokenizer.
+func promptTokens(tok tokenizer.Tokenizer, messages []agent.ConversationMessage) (total, toolResults int) {
+	return promptCount(messages, tok.Count)
+}
+
+func promptCount(messages []agent.ConversationMessage, count func(string) int) (total, toolResults int) {
+	for _, m := range messages {
+		n := count(m.Content)
+		for _, tc := range m.ToolCalls {
+			n += count(tc.Name) + count(tc.Arguments)
+		}
+		total += n
+		if m.Role == agent.RoleTool {
+			toolResults += n
+		}
+	}
+	return total, toolResults
+}
+
 // recordLLMInteraction creates an LLMInteraction record in the database.
 // Logs slog.Error on failure but does not abort the investigation loop —
 // the in-memory state is authoritative during execution.
@@ -42,7 +69,7 @@ func recordLLMInteraction(
 	execCtx *agent.ExecutionContext,
 	iteration int,
 	interactionType llminteraction.InteractionType,
-	messagesCount int,
+	messages []agent.ConversationMessage,
 	resp *LLMResponse,
 	lastMessageID *string,
 	startTime time.Time,
@@ -96,7 +123,18 @@ func recordLLMInteraction(
 	// Build response_metadata with full grounding details for dashboard rendering.
 	responseMeta := buildResponseMetadata(resp)
 
-	llmRequestMeta := map[string]any{"messages_count": messagesCount, "iteration": iteration}
+	promptChars, toolResultChars := promptSize(messages)
+	tok := tokenizer.ForProvider(execCtx.Config.LLMProvider)
+	promptTokenCount, toolResultTokens := promptTokens(tok, messages)
+	llmRequestMeta := map[string]any{
+		"messages_count":     len(messages),
+		"iteration":          iteration,
+		"prompt_chars":       promptChars,
+		"tool_result_chars":  toolResultChars,
+		"prompt_tokens":      promptTokenCount,
+		"tool_result_tokens": toolResultTokens,
+		"tokenizer":          tok.Name(),
+	}
 
 	// Include resolved native tools config so the dashboard can display
 	// which native tools were enabled for this LLM call.
@@ -104,12 +142,19 @@ func recordLLMInteraction(
 		llmRequestMeta["native_tools"] = nativeTools
 	}
 
+	// Record the chat guardrails in force so the trace shows which rules
+	// the answer was held to.
+	if execCtx.ChatContext != nil && len(execCtx.ChatContext.Guardrails) > 0 {
+		llmRequestMeta["chat_guardrails"] = execCtx.ChatContext.Guardrails
+	}
+
 	interaction, err := execCtx.Services.Interaction.CreateLLMInteraction(ctx, models.CreateLLMInteractionRequest{
 		SessionID:        execCtx.SessionID,
 		StageID:          &execCtx.StageID,
 		ExecutionID:      &execCtx.ExecutionID,
 		InteractionType:  string(interactionType),
 		ModelName:        execCtx.Config.LLMProvider.Model,
+		Owner:            execCtx.Config.Owner,
 		LastMessageID:    lastMessageID,
 		LLMRequest:       llmRequestMeta,
 		LLMResponse:      llmResponseMeta,
@@ -193,9 +238,30 @@ func publishInteractionCreated(ctx context.Context, execCtx *agent.ExecutionCont
 	}
 }
 
+// maxProgressPercent caps percent-complete estimates. 100% is only implied by
+// the terminal execution.status event, never by a progress estimate.
+const maxProgressPercent = 95
+
+// iterationPercent estimates percent-complete from the iterations completed so
+// far against the iteration budget, capped at maxProgressPercent.
+func iterationPercent(completed, maxIter int) *int {
+	if maxIter <= 0 {
+		return nil
+	}
+	percent := min(max(completed, 0)*100/maxIter, maxProgressPercent)
+	return &percent
+}
+
+// progressPercent returns a pointer to a fixed percent-complete estimate.
+func progressPercent(percent int) *int {
+	return &percent
+}
+
 // publishExecutionProgress publishes an execution.progress transient event.
-// Best-effort: logs on failure, never aborts the investigation.
-func publishExecutionProgress(ctx context.Context, execCtx *agent.ExecutionContext, phase, message string) {
+// percent is the estimated completion (nil when unknown; the dashboard keeps
+// the previous estimate). Best-effort: logs on failure, never aborts the
+// investigation.
+func publishExecutionProgress(ctx context.Context, execCtx *agent.ExecutionContext, phase, message string, percent *int) {
 	if execCtx.EventPublisher == nil {
 		return
 	}
@@ -210,6 +276,7 @@ func publishExecutionProgress(ctx context.Context, execCtx *agent.ExecutionConte
 		ParentExecutionID: parentExecID(execCtx),
 		Phase:             phase,
 		Message:           message,
+		Percent:           percent,
 	}); err != nil {
 		slog.Warn("Failed to publish execution progress",
 			"session_id", execCtx.SessionID,
@@ -219,6 +286,39 @@ func publishExecutionProgress(ctx context.Context, execCtx *agent.ExecutionConte
 	}
 }
 
+// publishMCPServerLog streams a stderr line the MCP server wrote during a
+// tool call. Best-effort: failures are logged at debug level, as a dropped
+// line is still stored on the MCP interaction record.
+func publishMCPServerLog(ctx context.Context, execCtx *agent.ExecutionContext, p *preparedToolCall, line string) {
+	if execCtx.EventPublisher == nil {
+		return
+	}
+	var eventID string
+	if p.event != nil {
+		eventID = p.event.ID
+	}
+	if err := execCtx.EventPublisher.PublishMCPServerLog(ctx, execCtx.SessionID, events.MCPServerLogPayload{
+		BasePayload: events.BasePayload{
+			Type:      events.EventTypeMCPServerLog,
+			SessionID: execCtx.SessionID,
+			Timestamp: time.Now().Format(time.RFC3339Nano),
+		},
+		StageID:           execCtx.StageID,
+		ExecutionID:       execCtx.ExecutionID,
+		ParentExecutionID: parentExecID(execCtx),
+		EventID:           eventID,
+		ServerID:          p.serverID,
+		ToolName:          p.toolName,
+		Line:              line,
+	}); err != nil {
+		slog.Debug("Failed to publish MCP server log line",
+			"session_id", execCtx.SessionID,
+			"server", p.serverID,
+			"error", err,
+		)
+	}
+}
+
 // resolveEffectiveNativeTools computes the native tools map that was sent to the
 // LLM for this execution. Starts from the provider defaults and applies the
 // per-alert override (if any). Returns nil when the provider has no native tools.
@@ -12,6 +12,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/cost"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/tokenizer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -401,6 +402,67 @@ func TestResolveEffectiveNativeTools(t *testing.T) {
 	})
 }
 
+func TestPromptSize(t *testing.T) {
+	tests := []struct {
+		name            string
+		messages        []agent.ConversationMessage
+		wantTotal       int
+		wantToolResults int
+	}{
+		{"empty", nil, 0, 0},
+		{
+			name: "counts content and tool call arguments",
+			messages: []agent.ConversationMessage{
+				{Role: agent.RoleSystem, Content: "sys"},
+				{Role: agent.RoleUser, Content: "alert"},
+				{Role: agent.RoleAssistant, ToolCalls: []agent.ToolCall{{Name: "k8s.get", Arguments: `{"a":1}`}}},
+				{Role: agent.RoleTool, Content: "result-one", ToolName: "k8s.get"},
+			},
+			wantTotal:       3 + 5 + len("k8s.get") + len(`{"a":1}`) + 10,
+			wantToolResults: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, toolResults := promptSize(tt.messages)
+			assert.Equal(t, tt.wantTotal, total)
+			assert.Equal(t, tt.wantToolResults, toolResults)
+		})
+	}
+}
+
+func TestPromptTokens(t *testing.T) {
+	messages := []agent.ConversationMessage{
+		{Role: agent.RoleSystem, Content: "system prompt"},                                                // 4 tokens
+		{Role: agent.RoleAssistant, ToolCalls: []agent.ToolCall{{Name: "k8s.get", Arguments: `{"a":1}`}}}, // 2 + 2
+		{Role: agent.RoleTool, Content: "result-one", ToolName: "k8s.get"},                                // 3
+	}
+	total, toolResults := promptTokens(tokenizer.Heuristic, messages)
+	assert.Equal(t, 11, total)
+	assert.Equal(t, 3, toolResults)
+}
+
+func TestIterationPercent(t *testing.T) {
+	tests := []struct {
+		name      string
+		completed int
+		maxIter   int
+		want      *int
+	}{
+		{"first iteration", 0, 10, progressPercent(0)},
+		{"midway", 5, 10, progressPercent(50)},
+		{"rounds down", 1, 3, progressPercent(33)},
+		{"capped below 100", 10, 10, progressPercent(maxProgressPercent)},
+		{"negative completed", -1, 10, progressPercent(0)},
+		{"unknown budget", 3, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, iterationPercent(tt.completed, tt.maxIter))
+		})
+	}
+}
+
 func TestRecordLLMInteraction_PersistsThinkingAndCost(t *testing.T) {
 	book, err := cost.NewBook(&cost.Config{
 		Enabled: true,
@@ -413,7 +475,7 @@ func TestRecordLLMInteraction_PersistsThinkingAndCost(t *testing.T) {
 	execCtx := newTestExecCtx(t, nil, nil, book)
 	ctx := t.Context()
 
-	recordLLMInteraction(ctx, execCtx, 1, llminteraction.InteractionTypeIteration, 3, &LLMResponse{
+	recordLLMInteraction(ctx, execCtx, 1, llminteraction.InteractionTypeIteration, make([]agent.ConversationMessage, 3), &LLMResponse{
 		Text: "ok",
 		Usage: &agent.TokenUsage{
 			InputTokens:    1_000_000,
@@ -440,7 +502,7 @@ func TestRecordLLMInteraction_NilUsageSkipsTokens(t *testing.T) {
 	execCtx := newTestExecCtx(t, nil, nil)
 	ctx := t.Context()
 
-	recordLLMInteraction(ctx, execCtx, 0, llminteraction.InteractionTypeIteration, 1, &LLMResponse{
+	recordLLMInteraction(ctx, execCtx, 0, llminteraction.InteractionTypeIteration, make([]agent.ConversationMessage, 1), &LLMResponse{
 		Text: "no usage",
 	}, nil, time.Now())
 
@@ -464,7 +526,7 @@ func TestRecordLLMInteraction_ZeroThinkingTokensLeftNil(t *testing.T) {
 	execCtx := newTestExecCtx(t, nil, nil, book)
 	ctx := t.Context()
 
-	recordLLMInteraction(ctx, execCtx, 1, llminteraction.InteractionTypeIteration, 1, &LLMResponse{
+	recordLLMInteraction(ctx, execCtx, 1, llminteraction.InteractionTypeIteration, make([]agent.ConversationMessage, 1), &LLMResponse{
 		Text: "ok",
 		Usage: &agent.TokenUsage{
 			InputTokens:    1000,
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
-	"github.com/codeready-toolchain/tarsy/ent/stage"
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
@@ -20,6 +19,11 @@ import (
 // returns an empty text response with no tool calls before accepting it.
 const maxEmptyResponseRetries = 2
 
+// maxStalledStreamRetries is the number of times single-shot controllers
+// retry an LLM call aborted by the stall watchdog when no fallback provider
+// is available. The iterating loop retries stalls within its iteration budget.
+const maxStalledStreamRetries = 1
+
 // IteratingController implements the multi-turn tool-calling loop.
 // Used by both google-native (Google SDK) and langchain (multi-provider) backends.
 // Tool calls come as structured ToolCallChunk values (not parsed from text).
@@ -75,6 +79,9 @@ func (c *IteratingController) Run(
 	// 2.6. Emit a single memory_injected event for all pre-loaded memories
 	emitMemoryInjectedEvent(ctx, execCtx, &eventSeq)
 
+	// 2.7. Record fallbacks for features the model lacks
+	emitCapabilityFallbackEvents(ctx, execCtx, &eventSeq)
+
 	// 3. Get available tools
 	tools, err := execCtx.ToolExecutor.ListTools(ctx)
 	if err != nil {
@@ -87,16 +94,16 @@ func (c *IteratingController) Run(
 	// Record tool_list interactions for the trace view (one per MCP server).
 	recordToolListInteractions(ctx, execCtx, tools)
 
+	budget := newTimeBudget(ctx, execCtx.Config.WrapUpAt)
+	toolCalls := &toolCallLimit{max: execCtx.Config.MaxToolCalls}
+	loops := newToolLoopDetector(execCtx.Config.MaxDuplicateToolCalls)
+
 	// Main iteration loop
 	for iteration := 0; iteration < maxIter; iteration++ {
 		state.CurrentIteration = iteration + 1
 
-		phase := events.ProgressPhaseInvestigating
-		if execCtx.StageType == string(stage.StageTypeAction) {
-			phase = events.ProgressPhaseRemediating
-		}
-		publishExecutionProgress(ctx, execCtx, phase,
-			fmt.Sprintf("Iteration %d/%d", iteration+1, maxIter))
+		publishExecutionProgress(ctx, execCtx, events.ProgressPhaseAnalyzing,
+			fmt.Sprintf("Iteration %d/%d", iteration+1, maxIter), iterationPercent(iteration, maxIter))
 
 		if state.ShouldAbortOnTimeouts() {
 			return failedResult(state, totalUsage), nil
@@ -115,6 +122,46 @@ func (c *IteratingController) Run(
 			}
 		}
 
+		// Deliver operator notes posted since the previous iteration.
+		if notes := execCtx.OperatorNotes; notes != nil {
+			for _, msg := range notes.TryDrainNotes(ctx) {
+				messages = append(messages, msg)
+				storeObservationMessage(ctx, execCtx, msg.Content, &msgSeq)
+			}
+		}
+
+		// Drop tools of MCP servers disabled since the previous iteration.
+		if disabled := execCtx.DisabledServers; disabled != nil {
+			if notices := disabled.TryDrainDisabled(ctx); len(notices) > 0 {
+				for _, msg := range notices {
+					messages = append(messages, msg)
+					storeObservationMessage(ctx, execCtx, msg.Content, &msgSeq)
+				}
+				if refreshed, listErr := execCtx.ToolExecutor.ListTools(ctx); listErr == nil {
+					tools = refreshed
+				} else {
+					slog.Warn("Failed to refresh tools after disabling MCP servers, keeping previous list",
+						"execution_id", execCtx.ExecutionID, "error", listErr)
+				}
+			}
+		}
+
+		// Remind orchestrators how much budget is left so they can stop
+		// dispatching and conclude in time.
+		if orchBudget := execCtx.OrchestratorBudget; orchBudget != nil {
+			msg := orchBudget.BudgetNote(totalUsage)
+			messages = append(messages, msg)
+			storeObservationMessage(ctx, execCtx, msg.Content, &msgSeq)
+		}
+
+		// Running out of time: conclude now rather than time out with
+		// nothing persisted.
+		if now := time.Now(); budget.wrapUpDue(now) {
+			state.CurrentIteration = iteration // this iteration becomes the conclusion
+			return c.forceConclusion(ctx, execCtx, messages, &totalUsage, state, fbState, &msgSeq, &eventSeq,
+				timeBudgetConclusion(execCtx, state, budget, now))
+		}
+
 		iterCtx, iterCancel := context.WithTimeout(ctx, execCtx.Config.IterationTimeout)
 		startTime := time.Now()
 
@@ -127,6 +174,7 @@ func (c *IteratingController) Run(
 			ExecutionID: execCtx.ExecutionID,
 			Messages:    messages,
 			Config:      execCtx.Config.LLMProvider,
+			Generation:  execCtx.Config.Generation,
 			Tools:       tools, // Tools bound for native calling
 			Backend:     execCtx.Config.LLMBackend,
 			ClearCache:  fbState.consumeClearCache(),
@@ -200,7 +248,7 @@ func (c *IteratingController) Run(
 				iterCancel()
 				return nil, fmt.Errorf("failed to store assistant message: %w", storeErr)
 			}
-			recordLLMInteraction(ctx, execCtx, iteration+1, llminteraction.InteractionTypeIteration, len(messages), resp, &assistantMsg.ID, startTime)
+			recordLLMInteraction(ctx, execCtx, iteration+1, llminteraction.InteractionTypeIteration, messages, resp, &assistantMsg.ID, startTime)
 
 			// Append assistant message to conversation
 			messages = append(messages, agent.ConversationMessage{
@@ -209,22 +257,63 @@ func (c *IteratingController) Run(
 				ToolCalls: resp.ToolCalls,
 			})
 
-			// Execute each tool call and append results
-			for _, tc := range resp.ToolCalls {
-				tcResult := executeToolCall(iterCtx, execCtx, tc, messages, resp.Groundings, &eventSeq)
+			// Repeats of an identical call beyond max_duplicate_tool_calls
+			// get its prior result, and calls over max_tool_calls are not
+			// executed; both are still answered.
+			answers := make(map[int]string)
+			var runCalls []agent.ToolCall
+			for i, tc := range resp.ToolCalls {
+				if prior, ok := loops.repeat(tc); ok {
+					if !loops.reported {
+						loops.reported = true
+						recordLoopDetected(ctx, execCtx, tc)
+					}
+					answers[i] = loops.repeatResult(tc, prior)
+					continue
+				}
+				if !toolCalls.take() {
+					answers[i] = toolCalls.skippedResult()
+					continue
+				}
+				runCalls = append(runCalls, tc)
+			}
 
-				if tcResult.IsError {
-					state.RecordFailure(tcResult.Content, isTimeoutError(tcResult.Err))
+			// Execute tool calls (concurrently when there are several) and
+			// append results in call order
+			tcResults := executeToolCalls(iterCtx, execCtx, runCalls, messages, resp.Groundings, &eventSeq)
+			next := 0
+			for i, tc := range resp.ToolCalls {
+				content, answered := answers[i]
+				if !answered {
+					tcResult := tcResults[next]
+					next++
+
+					if tcResult.IsError {
+						state.RecordFailure(tcResult.Content, isTimeoutError(tcResult.Err))
+					} else {
+						loops.record(tc, tcResult.Content)
+					}
+					accumulateTokenUsage(&totalUsage, tcResult.Usage)
+					content = tcResult.Content
 				}
-				accumulateTokenUsage(&totalUsage, tcResult.Usage)
 
 				messages = append(messages, agent.ConversationMessage{
 					Role:       agent.RoleTool,
-					Content:    tcResult.Content,
+					Content:    content,
 					ToolCallID: tc.ID,
 					ToolName:   tc.Name,
 				})
-				storeToolResultMessage(ctx, execCtx, tc.ID, tc.Name, tcResult.Content, &msgSeq)
+				storeToolResultMessage(ctx, execCtx, tc.ID, tc.Name, content, &msgSeq)
+			}
+			if len(runCalls) > 0 {
+				recordToolCallCount(ctx, execCtx, toolCalls.used)
+			}
+
+			// Tool call limit reached: conclude without further tool calls.
+			if toolCalls.reached() {
+				iterCancel()
+				return c.forceConclusion(ctx, execCtx, messages, &totalUsage, state, fbState, &msgSeq, &eventSeq,
+					toolCallLimitConclusion(execCtx, state, toolCalls))
 			}
 		} else {
 			// No tool calls — check for pending sub-agents before treating as final
@@ -236,7 +325,7 @@ func (c *IteratingController) Run(
 					iterCancel()
 					return nil, fmt.Errorf("failed to store assistant message: %w", storeErr)
 				}
-				recordLLMInteraction(ctx, execCtx, iteration+1, llminteraction.InteractionTypeIteration, len(messages), resp, &assistantMsg.ID, startTime)
+				recordLLMInteraction(ctx, execCtx, iteration+1, llminteraction.InteractionTypeIteration, messages, resp, &assistantMsg.ID, startTime)
 
 				if resp.Text != "" {
 					messages = append(messages, agent.ConversationMessage{
@@ -245,6 +334,8 @@ func (c *IteratingController) Run(
 					})
 				}
 
+				publishExecutionProgress(ctx, execCtx, events.ProgressPhaseWaitingSubAgents,
+					"Waiting for sub-agent results", nil)
 				msg, waitErr := collector.WaitForResult(ctx)
 				if waitErr != nil {
 					iterCancel()
@@ -281,7 +372,7 @@ func (c *IteratingController) Run(
 				iterCancel()
 				return nil, fmt.Errorf("failed to store assistant message: %w", storeErr)
 			}
-			recordLLMInteraction(ctx, execCtx, iteration+1, llminteraction.InteractionTypeIteration, len(messages), resp, &assistantMsg.ID, startTime)
+			recordLLMInteraction(ctx, execCtx, iteration+1, llminteraction.InteractionTypeIteration, messages, resp, &assistantMsg.ID, startTime)
 
 			createTimelineEvent(ctx, execCtx, timelineevent.EventTypeFinalAnalysis, resp.Text, nil, &eventSeq)
 
@@ -297,7 +388,82 @@ func (c *IteratingController) Run(
 	}
 
 	// Max iterations — force conclusion (call LLM WITHOUT tools)
-	return c.forceConclusion(ctx, execCtx, messages, &totalUsage, state, fbState, &msgSeq, &eventSeq)
+	return c.forceConclusion(ctx, execCtx, messages, &totalUsage, state, fbState, &msgSeq, &eventSeq,
+		iterationLimitConclusion(execCtx, state))
+}
+
+// Forced conclusion reasons, recorded as forced_reason metadata.
+const (
+	forcedReasonMaxIterations = "max_iterations"
+	forcedReasonTimeBudget    = "time_budget"
+	forcedReasonMaxToolCalls  = "max_tool_calls"
+)
+
+// forcedConclusion describes why and how the loop stops calling tools.
+type forcedConclusion struct {
+	progress string                 // execution.progress message
+	prompt   string                 // user message asking for the conclusion
+	meta     map[string]interface{} // metadata carried by the conclusion's events
+}
+
+// iterationLimitConclusion forces a conclusion at max_iterations.
+func iterationLimitConclusion(execCtx *agent.ExecutionContext, state *agent.IterationState) forcedConclusion {
+	return forcedConclusion{
+		progress: fmt.Sprintf("Forcing conclusion after %d iterations", state.CurrentIteration),
+		prompt:   execCtx.PromptBuilder.BuildForcedConclusionPrompt(state.CurrentIteration),
+		meta: map[string]interface{}{
+			"forced_conclusion": true,
+			"forced_reason":     forcedReasonMaxIterations,
+			"iterations_used":   state.CurrentIteration,
+			"max_iterations":    state.MaxIterations,
+		},
+	}
+}
+
+// timeBudgetConclusion forces a conclusion once the execution has used its
+// time_warnings.wrap_up share of the time left until its deadline.
+func timeBudgetConclusion(execCtx *agent.ExecutionContext, state *agent.IterationState, budget *timeBudget, now time.Time) forcedConclusion {
+	elapsed, remaining := now.Sub(budget.start), budget.deadline.Sub(now)
+	used := budget.usedPercent(now)
+	slog.Info("Time budget nearly used, forcing conclusion",
+		"session_id", execCtx.SessionID,
+		"execution_id", execCtx.ExecutionID,
+		"iteration", state.CurrentIteration,
+		"elapsed", elapsed,
+		"remaining", remaining)
+	return forcedConclusion{
+		progress: fmt.Sprintf("Forcing conclusion: %d%% of the time budget used", used),
+		prompt:   execCtx.PromptBuilder.BuildTimeBudgetConclusionPrompt(elapsed, remaining),
+		meta: map[string]interface{}{
+			"forced_conclusion":        true,
+			"forced_reason":            forcedReasonTimeBudget,
+			"iterations_used":          state.CurrentIteration,
+			"max_iterations":           state.MaxIterations,
+			"time_budget_used_percent": used,
+		},
+	}
+}
+
+// toolCallLimitConclusion forces a conclusion once the execution has made
+// max_tool_calls tool calls.
+func toolCallLimitConclusion(execCtx *agent.ExecutionContext, state *agent.IterationState, limit *toolCallLimit) forcedConclusion {
+	slog.Info("Tool call limit reached, forcing conclusion",
+		"session_id", execCtx.SessionID,
+		"execution_id", execCtx.ExecutionID,
+		"iteration", state.CurrentIteration,
+		"tool_calls", limit.used)
+	return forcedConclusion{
+		progress: fmt.Sprintf("Forcing conclusion after %d tool calls", limit.used),
+		prompt:   execCtx.PromptBuilder.BuildToolCallLimitConclusionPrompt(limit.used),
+		meta: map[string]interface{}{
+			"forced_conclusion": true,
+			"forced_reason":     forcedReasonMaxToolCalls,
+			"iterations_used":   state.CurrentIteration,
+			"max_iterations":    state.MaxIterations,
+			"tool_calls_used":   limit.used,
+			"max_tool_calls":    limit.max,
+		},
+	}
 }
 
 // forceConclusion forces the LLM to produce a final answer by calling without tools.
@@ -310,24 +476,20 @@ func (c *IteratingController) forceConclusion(
 	fbState *FallbackState,
 	msgSeq *int,
 	eventSeq *int,
+	forced forcedConclusion,
 ) (*agent.ExecutionResult, error) {
-	// Publish execution progress: concluding
-	publishExecutionProgress(ctx, execCtx, events.ProgressPhaseConcluding,
-		fmt.Sprintf("Forcing conclusion after %d iterations", state.CurrentIteration))
+	// Publish execution progress: finalizing
+	publishExecutionProgress(ctx, execCtx, events.ProgressPhaseFinalizing, forced.progress,
+		progressPercent(maxProgressPercent))
 
 	// Append forced conclusion prompt
-	conclusionPrompt := execCtx.PromptBuilder.BuildForcedConclusionPrompt(state.CurrentIteration)
-	messages = append(messages, agent.ConversationMessage{Role: agent.RoleUser, Content: conclusionPrompt})
-	storeObservationMessage(ctx, execCtx, conclusionPrompt, msgSeq)
+	messages = append(messages, agent.ConversationMessage{Role: agent.RoleUser, Content: forced.prompt})
+	storeObservationMessage(ctx, execCtx, forced.prompt, msgSeq)
 
 	startTime := time.Now()
 
 	// Metadata for forced conclusion — carried by all streaming events + final_analysis.
-	forcedMeta := map[string]interface{}{
-		"forced_conclusion": true,
-		"iterations_used":   state.CurrentIteration,
-		"max_iterations":    state.MaxIterations,
-	}
+	forcedMeta := forced.meta
 
 	// Call LLM WITHOUT tools with streaming — forces text-only response.
 	// Apply LLM call timeout (the parent ctx is the session context here).
@@ -350,6 +512,7 @@ func (c *IteratingController) forceConclusion(
 			ExecutionID: execCtx.ExecutionID,
 			Messages:    messages,
 			Config:      execCtx.Config.LLMProvider,
+			Generation:  execCtx.Config.Generation,
 			Tools:       nil, // No tools — force conclusion
 			Backend:     execCtx.Config.LLMBackend,
 			ClearCache:  fbState.consumeClearCache(),
@@ -394,7 +557,7 @@ func (c *IteratingController) forceConclusion(
 			TokensUsed: *totalUsage,
 		}, nil
 	}
-	recordLLMInteraction(ctx, execCtx, state.CurrentIteration+1, llminteraction.InteractionTypeForcedConclusion, len(messages), resp, &assistantMsg.ID, startTime)
+	recordLLMInteraction(ctx, execCtx, state.CurrentIteration+1, llminteraction.InteractionTypeForcedConclusion, messages, resp, &assistantMsg.ID, startTime)
 
 	if !streamed.ThinkingEventCreated && resp.ThinkingText != "" {
 		createTimelineEvent(ctx, execCtx, timelineevent.EventTypeLlmThinking, resp.ThinkingText,
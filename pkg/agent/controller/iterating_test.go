@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
@@ -262,6 +264,7 @@ func TestIteratingController_ForcedConclusion(t *testing.T) {
 		if ev.EventType == timelineevent.EventTypeFinalAnalysis {
 			found = true
 			require.Equal(t, true, ev.Metadata["forced_conclusion"], "final_analysis should have forced_conclusion=true")
+			require.Equal(t, "max_iterations", ev.Metadata["forced_reason"])
 			require.EqualValues(t, 3, ev.Metadata["iterations_used"], "should report 3 iterations used")
 			require.EqualValues(t, 3, ev.Metadata["max_iterations"], "should report max_iterations=3")
 			break
@@ -270,6 +273,157 @@ func TestIteratingController_ForcedConclusion(t *testing.T) {
 	require.True(t, found, "expected final_analysis timeline event")
 }
 
+// slowToolExecutor delays every tool call, to let time budgets run down.
+type slowToolExecutor struct {
+	*mockToolExecutor
+	delay time.Duration
+}
+
+func (e *slowToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+	time.Sleep(e.delay)
+	return e.mockToolExecutor.Execute(ctx, call)
+}
+
+func TestIteratingController_TimeBudgetConclusion(t *testing.T) {
+	// The first iteration's tool call uses up the wrap-up share of the budget;
+	// the second iteration is replaced by a conclusion without tools.
+	llm := &mockLLMClient{responses: []mockLLMResponse{
+		{chunks: []agent.Chunk{&agent.ToolCallChunk{CallID: "call-1", Name: "k8s.get_pods", Arguments: "{}"}}},
+		{chunks: []agent.Chunk{&agent.TextChunk{Content: "Out of time: pod-1 is running, root cause unconfirmed."}}},
+	}}
+	executor := &slowToolExecutor{
+		mockToolExecutor: &mockToolExecutor{
+			tools:   []agent.ToolDefinition{{Name: "k8s.get_pods", Description: "Get pods"}},
+			results: map[string]*agent.ToolResult{"k8s.get_pods": {Content: "pod-1 Running"}},
+		},
+		delay: 600 * time.Millisecond,
+	}
+
+	execCtx := newTestExecCtx(t, llm, executor)
+	execCtx.Config.WrapUpAt = 0.05 // 500ms of the 10s budget
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := NewIteratingController().Run(ctx, execCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, agent.ExecutionStatusCompleted, result.Status)
+	require.Contains(t, result.FinalAnalysis, "Out of time")
+
+	require.Len(t, llm.capturedInputs, 2)
+	assert.Nil(t, llm.capturedInputs[1].Tools, "conclusion is requested without tools")
+	lastMsg := llm.capturedInputs[1].Messages[len(llm.capturedInputs[1].Messages)-1]
+	assert.Contains(t, lastMsg.Content, "most of the time available")
+
+	events, qErr := execCtx.Services.Timeline.GetAgentTimeline(context.Background(), execCtx.ExecutionID)
+	require.NoError(t, qErr)
+	found := false
+	for _, ev := range events {
+		if ev.EventType == timelineevent.EventTypeFinalAnalysis {
+			found = true
+			assert.Equal(t, true, ev.Metadata["forced_conclusion"])
+			assert.Equal(t, "time_budget", ev.Metadata["forced_reason"])
+			assert.EqualValues(t, 1, ev.Metadata["iterations_used"])
+			assert.NotNil(t, ev.Metadata["time_budget_used_percent"])
+		}
+	}
+	require.True(t, found, "expected final_analysis timeline event")
+}
+
+func TestIteratingController_ToolCallLimitConclusion(t *testing.T) {
+	// Two calls fit in the first batch; only one of the second batch fits
+	// max_tool_calls=3, the other is answered without running. The third
+	// response is the conclusion, requested without tools.
+	pods := func(id string) agent.Chunk {
+		return &agent.ToolCallChunk{CallID: id, Name: "k8s.get_pods", Arguments: "{}"}
+	}
+	llm := &mockLLMClient{responses: []mockLLMResponse{
+		{chunks: []agent.Chunk{pods("call-1"), pods("call-2")}},
+		{chunks: []agent.Chunk{pods("call-3"), pods("call-4")}},
+		{chunks: []agent.Chunk{&agent.TextChunk{Content: "pod-1 is running; no further checks possible."}}},
+	}}
+	var executed atomic.Int32
+	executor := &mockToolExecutorFunc{
+		tools: []agent.ToolDefinition{{Name: "k8s.get_pods", Description: "Get pods"}},
+		executeFn: func(_ context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+			executed.Add(1)
+			return &agent.ToolResult{CallID: call.ID, Name: call.Name, Content: "pod-1 Running"}, nil
+		},
+	}
+
+	execCtx := newTestExecCtx(t, llm, executor)
+	execCtx.Config.MaxToolCalls = 3
+
+	result, err := NewIteratingController().Run(context.Background(), execCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, agent.ExecutionStatusCompleted, result.Status)
+	require.Contains(t, result.FinalAnalysis, "no further checks possible")
+	assert.EqualValues(t, 3, executed.Load())
+
+	require.Len(t, llm.capturedInputs, 3)
+	assert.Nil(t, llm.capturedInputs[2].Tools, "conclusion is requested without tools")
+	conclusionMsgs := llm.capturedInputs[2].Messages
+	assert.Contains(t, conclusionMsgs[len(conclusionMsgs)-1].Content, "tool call limit for this investigation (3 tool calls)")
+	skipped := conclusionMsgs[len(conclusionMsgs)-2]
+	assert.Equal(t, agent.RoleTool, skipped.Role)
+	assert.Equal(t, "call-4", skipped.ToolCallID)
+	assert.Contains(t, skipped.Content, "Tool call not executed")
+
+	exec, err := execCtx.Services.Stage.GetAgentExecutionByID(context.Background(), execCtx.ExecutionID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, exec.ToolCallCount)
+
+	events, qErr := execCtx.Services.Timeline.GetAgentTimeline(context.Background(), execCtx.ExecutionID)
+	require.NoError(t, qErr)
+	found := false
+	for _, ev := range events {
+		if ev.EventType == timelineevent.EventTypeFinalAnalysis {
+			found = true
+			assert.Equal(t, true, ev.Metadata["forced_conclusion"])
+			assert.Equal(t, "max_tool_calls", ev.Metadata["forced_reason"])
+			assert.EqualValues(t, 3, ev.Metadata["tool_calls_used"])
+			assert.EqualValues(t, 3, ev.Metadata["max_tool_calls"])
+		}
+	}
+	require.True(t, found, "expected final_analysis timeline event")
+}
+
+func TestIteratingController_DuplicateToolCallLoop(t *testing.T) {
+	// The second identical call is answered from the first one's result.
+	llm := &mockLLMClient{responses: []mockLLMResponse{
+		{chunks: []agent.Chunk{&agent.ToolCallChunk{CallID: "call-1", Name: "k8s.get_pods", Arguments: `{"ns":"prod"}`}}},
+		{chunks: []agent.Chunk{&agent.ToolCallChunk{CallID: "call-2", Name: "k8s.get_pods", Arguments: `{ "ns": "prod" }`}}},
+		{chunks: []agent.Chunk{&agent.TextChunk{Content: "pod-1 is running."}}},
+	}}
+	var executed atomic.Int32
+	executor := &mockToolExecutorFunc{
+		tools: []agent.ToolDefinition{{Name: "k8s.get_pods", Description: "Get pods"}},
+		executeFn: func(_ context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+			executed.Add(1)
+			return &agent.ToolResult{CallID: call.ID, Name: call.Name, Content: "pod-1 Running"}, nil
+		},
+	}
+
+	execCtx := newTestExecCtx(t, llm, executor)
+	execCtx.Config.MaxDuplicateToolCalls = 1
+
+	result, err := NewIteratingController().Run(context.Background(), execCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, agent.ExecutionStatusCompleted, result.Status)
+	assert.EqualValues(t, 1, executed.Load())
+
+	require.Len(t, llm.capturedInputs, 3)
+	msgs := llm.capturedInputs[2].Messages
+	repeat := msgs[len(msgs)-1]
+	assert.Equal(t, "call-2", repeat.ToolCallID)
+	assert.Contains(t, repeat.Content, "Duplicate tool call")
+	assert.Contains(t, repeat.Content, "pod-1 Running")
+
+	exec, err := execCtx.Services.Stage.GetAgentExecutionByID(context.Background(), execCtx.ExecutionID)
+	require.NoError(t, err)
+	assert.True(t, exec.LoopDetected)
+	assert.Equal(t, 1, exec.ToolCallCount, "the repeat is not executed")
+}
+
 func TestIteratingController_ThinkingContent(t *testing.T) {
 	// Verify thinking content is processed without error and the LLM receives
 	// the thinking chunk. Timeline event verification would require querying the
@@ -1117,6 +1271,48 @@ func TestIteratingController_NilCollectorSkipsDrainWait(t *testing.T) {
 	require.Equal(t, 1, llm.callCount)
 }
 
+func TestIteratingController_InjectsOrchestratorBudget(t *testing.T) {
+	// Every LLM call ends with the budget note, built from the tokens the
+	// orchestrator used so far.
+	llm := &mockLLMClient{
+		capture: true,
+		responses: []mockLLMResponse{
+			{chunks: []agent.Chunk{
+				&agent.ToolCallChunk{CallID: "call-1", Name: "test.tool", Arguments: "{}"},
+				&agent.UsageChunk{InputTokens: 10, OutputTokens: 20, TotalTokens: 30},
+			}},
+			{chunks: []agent.Chunk{
+				&agent.TextChunk{Content: "Done."},
+			}},
+		},
+	}
+
+	executor := &mockToolExecutor{
+		tools: []agent.ToolDefinition{{Name: "test.tool", Description: "A test tool"}},
+		results: map[string]*agent.ToolResult{
+			"test.tool": {Content: "tool result"},
+		},
+	}
+
+	execCtx := newTestExecCtx(t, llm, executor)
+	execCtx.Config.LLMBackend = config.LLMBackendNativeGemini
+	budget := &mockOrchestratorBudget{}
+	execCtx.OrchestratorBudget = budget
+
+	ctrl := NewIteratingController()
+	result, err := ctrl.Run(context.Background(), execCtx, "")
+	require.NoError(t, err)
+	require.Equal(t, agent.ExecutionStatusCompleted, result.Status)
+
+	assert.Equal(t, []int{0, 30}, budget.calls)
+	require.Len(t, llm.capturedInputs, 2)
+	for i, input := range llm.capturedInputs {
+		last := input.Messages[len(input.Messages)-1]
+		assert.Equal(t, agent.RoleUser, last.Role)
+		assert.Equal(t, fmt.Sprintf("[Orchestrator budget] %d tokens", budget.calls[i]), last.Content)
+	}
+}
+
 func TestIteratingController_FallbackOnMaxRetries(t *testing.T) {
 	// First call: max_retries error → immediate fallback
 	// Second call (with fallback provider): success
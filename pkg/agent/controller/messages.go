@@ -7,6 +7,7 @@ import (
 
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/message"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
 )
@@ -20,6 +21,10 @@ func storeMessages(
 ) error {
 	for _, msg := range messages {
 		*msgSeq++
+		var images []schema.ImageRef
+		for _, img := range msg.Images {
+			images = append(images, img.Ref)
+		}
 		_, err := execCtx.Services.Message.CreateMessage(ctx, models.CreateMessageRequest{
 			SessionID:      execCtx.SessionID,
 			StageID:        execCtx.StageID,
@@ -27,6 +32,7 @@ func storeMessages(
 			SequenceNumber: *msgSeq,
 			Role:           message.Role(msg.Role),
 			Content:        msg.Content,
+			Images:         images,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to store message: %w", err)
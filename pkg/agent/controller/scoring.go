@@ -12,6 +12,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent/prompt"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
 )
 
@@ -64,6 +65,7 @@ func scoringCallLLM(
 			ExecutionID: execCtx.ExecutionID,
 			Messages:    messages,
 			Config:      execCtx.Config.LLMProvider,
+			Generation:  execCtx.Config.Generation,
 			Backend:     execCtx.Config.LLMBackend,
 			ClearCache:  fbState.consumeClearCache(),
 		}, eventSeq)
@@ -126,6 +128,9 @@ func (c *ScoringController) Run(
 
 	// --- Turn 1: Score evaluation ---
 
+	publishExecutionProgress(ctx, execCtx, events.ProgressPhaseAnalyzing,
+		"Scoring investigation", progressPercent(0))
+
 	messages := []agent.ConversationMessage{
 		{Role: agent.RoleSystem, Content: execCtx.PromptBuilder.BuildScoringSystemPrompt()},
 		{Role: agent.RoleUser, Content: execCtx.PromptBuilder.BuildScoringInitialPrompt(prevStageContext, scoringOutputSchema)},
@@ -147,7 +152,7 @@ func (c *ScoringController) Run(
 	if storeErr != nil {
 		return nil, fmt.Errorf("failed to store scoring assistant message: %w", storeErr)
 	}
-	recordLLMInteraction(ctx, execCtx, iteration, llminteraction.InteractionTypeScoring, len(messages), resp, &assistantMsg.ID, startTime)
+	recordLLMInteraction(ctx, execCtx, iteration, llminteraction.InteractionTypeScoring, messages, resp, &assistantMsg.ID, startTime)
 	iteration++
 
 	// Extract score from the response text.
@@ -181,7 +186,7 @@ func (c *ScoringController) Run(
 		if storeErr != nil {
 			return nil, fmt.Errorf("failed to store scoring retry assistant message: %w", storeErr)
 		}
-		recordLLMInteraction(ctx, execCtx, iteration, llminteraction.InteractionTypeScoring, len(messages), resp, &assistantMsg.ID, startTime)
+		recordLLMInteraction(ctx, execCtx, iteration, llminteraction.InteractionTypeScoring, messages, resp, &assistantMsg.ID, startTime)
 		iteration++
 		score, analysis, err = extractScore(resp.Text)
 		if analysis != "" {
@@ -199,6 +204,9 @@ func (c *ScoringController) Run(
 
 	// --- Turn 2: Tool improvement report ---
 
+	publishExecutionProgress(ctx, execCtx, events.ProgressPhaseFinalizing,
+		"Writing tool improvement report", progressPercent(50))
+
 	toolReportPrompt := execCtx.PromptBuilder.BuildScoringToolImprovementReportPrompt()
 	messages = append(messages,
 		agent.ConversationMessage{Role: agent.RoleAssistant, Content: resp.Text},
@@ -218,7 +226,7 @@ func (c *ScoringController) Run(
 	if storeErr != nil {
 		return nil, fmt.Errorf("failed to store tool improvement report assistant message: %w", storeErr)
 	}
-	recordLLMInteraction(ctx, execCtx, iteration, llminteraction.InteractionTypeScoring, len(messages), toolReportResp, &toolReportMsg.ID, startTime)
+	recordLLMInteraction(ctx, execCtx, iteration, llminteraction.InteractionTypeScoring, messages, toolReportResp, &toolReportMsg.ID, startTime)
 
 	// --- Build result ---
 
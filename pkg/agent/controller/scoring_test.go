@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
@@ -28,6 +29,14 @@ func (m *mockScoringPromptBuilder) BuildForcedConclusionPrompt(_ int) string {
 	panic("unexpected call")
 }
 
+func (m *mockScoringPromptBuilder) BuildTimeBudgetConclusionPrompt(_, _ time.Duration) string {
+	panic("unexpected call")
+}
+
+func (m *mockScoringPromptBuilder) BuildToolCallLimitConclusionPrompt(_ int) string {
+	panic("unexpected call")
+}
+
 func (m *mockScoringPromptBuilder) BuildMCPSummarizationSystemPrompt(_, _ string, _ int) string {
 	panic("unexpected call")
 }
@@ -36,11 +45,19 @@ func (m *mockScoringPromptBuilder) BuildMCPSummarizationUserPrompt(_, _, _, _ st
 	panic("unexpected call")
 }
 
-func (m *mockScoringPromptBuilder) BuildExecutiveSummarySystemPrompt() string {
+func (m *mockScoringPromptBuilder) BuildExecutiveSummarySystemPrompt(string, *config.ExecutiveSummaryConfig) string {
+	panic("unexpected call")
+}
+
+func (m *mockScoringPromptBuilder) BuildExecutiveSummaryUserPrompt(_ string, _ *config.ExecutiveSummaryConfig) string {
+	panic("unexpected call")
+}
+
+func (m *mockScoringPromptBuilder) BuildTechnicalSummarySystemPrompt(string) string {
 	panic("unexpected call")
 }
 
-func (m *mockScoringPromptBuilder) BuildExecutiveSummaryUserPrompt(_ string) string {
+func (m *mockScoringPromptBuilder) BuildTechnicalSummaryUserPrompt(_ string) string {
 	panic("unexpected call")
 }
 
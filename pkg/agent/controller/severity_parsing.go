@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// severityRegex matches a "SEVERITY: <level>" marker on the last line (case-insensitive).
+var severityRegex = regexp.MustCompile(`(?i)^[\s*]*SEVERITY[\s*]*:[\s*]*(CRITICAL|HIGH|MEDIUM|LOW)[\s*]*$`)
+
+// ExtractSeverity parses the executive summary agent's response to extract the
+// SEVERITY marker from the last line and return the cleaned summary
+// (everything before the marker line, trailing blank lines removed).
+func ExtractSeverity(text string) (severity config.Severity, cleanedSummary string, err error) {
+	text = strings.TrimRight(text, "\n\r ")
+	if text == "" {
+		return "", "", fmt.Errorf("empty response text")
+	}
+
+	lastNewline := strings.LastIndex(text, "\n")
+	var lastLine string
+	if lastNewline == -1 {
+		lastLine = text
+	} else {
+		lastLine = text[lastNewline+1:]
+	}
+
+	match := severityRegex.FindStringSubmatch(lastLine)
+	if match == nil {
+		return "", "", fmt.Errorf("no SEVERITY marker found on last line: %q", lastLine)
+	}
+
+	severity = config.Severity(strings.ToLower(match[1]))
+
+	if lastNewline != -1 {
+		cleanedSummary = strings.TrimRight(text[:lastNewline], "\n\r ")
+	}
+
+	return severity, cleanedSummary, nil
+}
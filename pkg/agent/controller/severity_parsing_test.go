@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractSeverity(t *testing.T) {
+	tests := []struct {
+		name            string
+		text            string
+		wantSeverity    config.Severity
+		wantSummary     string
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:         "high with summary",
+			text:         "Pod-1 OOM killed due to memory leak.\nSEVERITY: HIGH",
+			wantSeverity: config.SeverityHigh,
+			wantSummary:  "Pod-1 OOM killed due to memory leak.",
+		},
+		{
+			name:         "blank line before marker is trimmed",
+			text:         "Disk pressure resolved itself.\n\nSEVERITY: LOW\n",
+			wantSeverity: config.SeverityLow,
+			wantSummary:  "Disk pressure resolved itself.",
+		},
+		{
+			name:         "case insensitive",
+			text:         "Summary.\nseverity: Critical",
+			wantSeverity: config.SeverityCritical,
+			wantSummary:  "Summary.",
+		},
+		{
+			name:         "markdown bold tolerated",
+			text:         "Summary.\n**SEVERITY:** MEDIUM",
+			wantSeverity: config.SeverityMedium,
+			wantSummary:  "Summary.",
+		},
+		{
+			name:         "marker only",
+			text:         "SEVERITY: LOW",
+			wantSeverity: config.SeverityLow,
+			wantSummary:  "",
+		},
+		{
+			name:            "empty text",
+			text:            "",
+			wantErr:         true,
+			wantErrContains: "empty response text",
+		},
+		{
+			name:            "no marker",
+			text:            "Pod-1 OOM killed due to memory leak.",
+			wantErr:         true,
+			wantErrContains: "no SEVERITY marker found on last line",
+		},
+		{
+			name:            "unknown level",
+			text:            "Summary.\nSEVERITY: URGENT",
+			wantErr:         true,
+			wantErrContains: "no SEVERITY marker found on last line",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			severity, summary, err := ExtractSeverity(tt.text)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSeverity, severity)
+			assert.Equal(t, tt.wantSummary, summary)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+	"github.com/codeready-toolchain/tarsy/pkg/tokenizer"
+)
+
+// SideCall is a single-shot LLM call made outside an agent execution:
+// classification, extraction, comparison, synthesis or report narratives.
+type SideCall struct {
+	// SessionID is passed to the LLM service and names the session the call
+	// is recorded on. Callers without a session use a caller with no
+	// interaction service (e.g. activity report narratives).
+	SessionID       string
+	InteractionType llminteraction.InteractionType
+	Config          *agent.ResolvedAgentConfig // provider, backend, watchdog timeouts and owner
+	Messages        []agent.ConversationMessage
+	Timeout         time.Duration // bounds the whole call
+}
+
+// SideCaller runs side calls with the same safeguards as agent LLM calls:
+// the stall watchdog aborts a stream that stops producing chunks, and each
+// call is recorded as a session-level LLM interaction charged to the
+// configured owner. Nil-safe: Call fails when the caller is nil.
+type SideCaller struct {
+	llmClient    agent.LLMClient
+	interactions *services.InteractionService // nil = calls are not recorded
+}
+
+// NewSideCaller creates a side caller. interactions may be nil (calls are
+// not recorded).
+func NewSideCaller(llmClient agent.LLMClient, interactions *services.InteractionService) *SideCaller {
+	return &SideCaller{llmClient: llmClient, interactions: interactions}
+}
+
+// Call runs the call and returns its response text, trimmed. Returns an
+// error when the stream fails, stalls, times out or produces no text.
+func (s *SideCaller) Call(ctx context.Context, call SideCall) (string, error) {
+	if s == nil || s.llmClient == nil {
+		return "", fmt.Errorf("LLM client not configured")
+	}
+
+	llmCtx, cancel := context.WithTimeout(ctx, call.Timeout)
+	defer cancel()
+
+	startTime := time.Now()
+	var resp *LLMResponse
+	stream, err := s.llmClient.Generate(llmCtx, &agent.GenerateInput{
+		SessionID: call.SessionID,
+		Messages:  call.Messages,
+		Config:    call.Config.LLMProvider,
+		Backend:   call.Config.LLMBackend,
+	})
+	if err != nil {
+		err = fmt.Errorf("LLM Generate failed: %w", err)
+	} else {
+		resp, err = collectStreamWithCallback(stream, nil, cancel,
+			call.Config.InitialResponseTimeout, call.Config.StallTimeout)
+	}
+	if err == nil && llmCtx.Err() != nil {
+		err = fmt.Errorf("LLM call interrupted: %w", llmCtx.Err())
+	}
+	if err == nil && strings.TrimSpace(resp.Text) == "" {
+		err = errors.New("LLM returned an empty response")
+	}
+
+	metrics.ObserveLLMCall(call.Config.LLMProviderName, call.Config.LLMProvider.Model,
+		time.Since(startTime), metricsTokens(&StreamedResponse{LLMResponse: resp}, err), err)
+	s.record(ctx, call, resp, err, startTime)
+
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(resp.Text), nil
+}
+
+// record stores the call as a session-level LLM interaction. Best-effort:
+// a failed write is logged and the call's result still returned.
+func (s *SideCaller) record(ctx context.Context, call SideCall, resp *LLMResponse, callErr error, startTime time.Time) {
+	if s.interactions == nil || call.SessionID == "" {
+		return
+	}
+	durationMs := int(time.Since(startTime).Milliseconds())
+
+	promptChars, toolResultChars := promptSize(call.Messages)
+	tok := tokenizer.ForProvider(call.Config.LLMProvider)
+	promptTokenCount, toolResultTokens := promptTokens(tok, call.Messages)
+	req := models.CreateLLMInteractionRequest{
+		SessionID:       call.SessionID,
+		InteractionType: string(call.InteractionType),
+		ModelName:       call.Config.LLMProvider.Model,
+		Owner:           call.Config.Owner,
+		LLMRequest: map[string]any{
+			"messages_count":     len(call.Messages),
+			"prompt_chars":       promptChars,
+			"tool_result_chars":  toolResultChars,
+			"prompt_tokens":      promptTokenCount,
+			"tool_result_tokens": toolResultTokens,
+			"tokenizer":          tok.Name(),
+		},
+		DurationMs: &durationMs,
+	}
+
+	usage := usageOf(resp, callErr)
+	if usage != nil {
+		req.InputTokens = &usage.InputTokens
+		req.OutputTokens = &usage.OutputTokens
+		req.TotalTokens = &usage.TotalTokens
+		if usage.ThinkingTokens > 0 {
+			req.ThinkingTokens = &usage.ThinkingTokens
+		}
+	}
+	textLen := 0
+	if resp != nil {
+		textLen = len(resp.Text)
+		if resp.ThinkingText != "" {
+			req.ThinkingContent = &resp.ThinkingText
+		}
+	}
+	req.LLMResponse = map[string]any{"text_length": textLen}
+	if callErr != nil {
+		msg := callErr.Error()
+		req.ErrorMessage = &msg
+	}
+
+	// The interaction must be stored even if the caller's context expired
+	if _, err := s.interactions.CreateLLMInteraction(context.WithoutCancel(ctx), req); err != nil {
+		slog.Error("Failed to record LLM interaction",
+			"session_id", call.SessionID, "type", call.InteractionType, "error", err)
+	}
+}
+
+// usageOf returns the token usage of a completed call, or the partial usage
+// carried by a PartialOutputError.
+func usageOf(resp *LLMResponse, err error) *agent.TokenUsage {
+	if resp != nil {
+		return resp.Usage
+	}
+	var poe *PartialOutputError
+	if errors.As(err, &poe) {
+		return poe.Usage
+	}
+	return nil
+}
+
+// ParseJSONObject unmarshals the first JSON object in an LLM response into
+// v, tolerating markdown fences and surrounding prose.
+func ParseJSONObject(raw string, v any) error {
+	object := ExtractJSONObject(raw)
+	if object == "" {
+		return errors.New("LLM response contains no JSON object")
+	}
+	if err := json.Unmarshal([]byte(object), v); err != nil {
+		return fmt.Errorf("invalid JSON in LLM response: %w", err)
+	}
+	return nil
+}
+
+// ExtractJSONObject returns the first balanced JSON object in s, or "" when
+// there is none. Braces inside JSON strings are ignored.
+func ExtractJSONObject(s string) string {
+	start := strings.IndexByte(s, '{')
+	if start == -1 {
+		return ""
+	}
+
+	depth := 0
+	inString := false
+	escaped := false
+	for i := start; i < len(s); i++ {
+		ch := s[i]
+		if escaped {
+			escaped = false
+			continue
+		}
+		if ch == '\\' && inString {
+			escaped = true
+			continue
+		}
+		if ch == '"' {
+			inString = !inString
+			continue
+		}
+		if inString {
+			continue
+		}
+		switch ch {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return s[start : i+1]
+			}
+		}
+	}
+	return ""
+}
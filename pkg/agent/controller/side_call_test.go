@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+	"github.com/codeready-toolchain/tarsy/test/util"
+	"github.com/google/uuid"
+)
+
+func newTestSideCall(sessionID string) SideCall {
+	return SideCall{
+		SessionID:       sessionID,
+		InteractionType: llminteraction.InteractionTypeOutcomeClassification,
+		Config: &agent.ResolvedAgentConfig{
+			LLMBackend:             config.LLMBackendLangChain,
+			LLMProvider:            &config.LLMProviderConfig{Model: "test-model"},
+			LLMProviderName:        "test-provider",
+			Owner:                  "team-sre",
+			InitialResponseTimeout: time.Second,
+			StallTimeout:           50 * time.Millisecond,
+		},
+		Messages: []agent.ConversationMessage{
+			{Role: agent.RoleSystem, Content: "Classify."},
+			{Role: agent.RoleUser, Content: "Alert type: kubernetes"},
+		},
+		Timeout: 5 * time.Second,
+	}
+}
+
+func TestSideCaller_Call(t *testing.T) {
+	t.Run("returns trimmed text", func(t *testing.T) {
+		llm := &mockLLMClient{responses: []mockLLMResponse{
+			{chunks: []agent.Chunk{&agent.TextChunk{Content: "  summary "}, &agent.TextChunk{Content: "text\n"}}},
+		}}
+		text, err := NewSideCaller(llm, nil).Call(context.Background(), newTestSideCall(""))
+		require.NoError(t, err)
+		assert.Equal(t, "summary text", text)
+		assert.Equal(t, "test-model", llm.lastInput.Config.Model)
+		assert.Equal(t, config.LLMBackendLangChain, llm.lastInput.Backend)
+	})
+
+	t.Run("stall watchdog aborts the stream", func(t *testing.T) {
+		llm := &mockLLMClient{responses: []mockLLMResponse{
+			{chunks: []agent.Chunk{&agent.TextChunk{Content: "partial"}}, stall: true},
+		}}
+		_, err := NewSideCaller(llm, nil).Call(context.Background(), newTestSideCall(""))
+		require.Error(t, err)
+		assert.True(t, isStalledStreamError(err))
+	})
+
+	t.Run("error chunk", func(t *testing.T) {
+		llm := &mockLLMClient{responses: []mockLLMResponse{
+			{chunks: []agent.Chunk{&agent.ErrorChunk{Message: "quota exceeded", Code: "provider_error"}}},
+		}}
+		_, err := NewSideCaller(llm, nil).Call(context.Background(), newTestSideCall(""))
+		assert.ErrorContains(t, err, "quota exceeded")
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		llm := &mockLLMClient{responses: []mockLLMResponse{{chunks: []agent.Chunk{&agent.TextChunk{Content: "  "}}}}}
+		_, err := NewSideCaller(llm, nil).Call(context.Background(), newTestSideCall(""))
+		assert.ErrorContains(t, err, "empty response")
+	})
+
+	t.Run("nil caller", func(t *testing.T) {
+		var s *SideCaller
+		_, err := s.Call(context.Background(), newTestSideCall(""))
+		assert.ErrorContains(t, err, "not configured")
+	})
+}
+
+func TestSideCaller_RecordsInteraction(t *testing.T) {
+	entClient, _ := util.SetupTestDatabase(t)
+	ctx := context.Background()
+
+	sessionID := uuid.New().String()
+	_, err := entClient.AlertSession.Create().
+		SetID(sessionID).
+		SetAlertData("Test alert").
+		SetAgentType("test-agent").
+		SetAlertType("test-alert").
+		SetChainID("test-chain").
+		SetStatus(alertsession.StatusCompleted).
+		SetAuthor("test").
+		Save(ctx)
+	require.NoError(t, err)
+
+	llm := &mockLLMClient{responses: []mockLLMResponse{
+		{chunks: []agent.Chunk{
+			&agent.TextChunk{Content: `{"outcome":"mitigated"}`},
+			&agent.UsageChunk{InputTokens: 120, OutputTokens: 8, TotalTokens: 128},
+		}},
+		{chunks: []agent.Chunk{&agent.ErrorChunk{Message: "quota exceeded"}}},
+	}}
+	caller := NewSideCaller(llm, services.NewInteractionService(entClient, nil, nil))
+
+	_, err = caller.Call(ctx, newTestSideCall(sessionID))
+	require.NoError(t, err)
+	_, err = caller.Call(ctx, newTestSideCall(sessionID))
+	require.Error(t, err)
+
+	interactions, err := entClient.LLMInteraction.Query().
+		Where(llminteraction.SessionIDEQ(sessionID)).
+		Order(llminteraction.ByCreatedAt()).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, interactions, 2)
+
+	ok := interactions[0]
+	assert.Equal(t, llminteraction.InteractionTypeOutcomeClassification, ok.InteractionType)
+	assert.Nil(t, ok.ExecutionID)
+	assert.Equal(t, "test-model", ok.ModelName)
+	require.NotNil(t, ok.Owner)
+	assert.Equal(t, "team-sre", *ok.Owner)
+	require.NotNil(t, ok.InputTokens)
+	assert.Equal(t, 120, *ok.InputTokens)
+	assert.Nil(t, ok.ErrorMessage)
+
+	failed := interactions[1]
+	require.NotNil(t, failed.ErrorMessage)
+	assert.Contains(t, *failed.ErrorMessage, "quota exceeded")
+}
+
+func TestParseJSONObject(t *testing.T) {
+	var parsed struct {
+		Outcome string `json:"outcome"`
+		Reason  string `json:"reason"`
+	}
+
+	t.Run("fenced JSON with prose", func(t *testing.T) {
+		raw := "Result:\n```json\n" + `{"outcome":"mitigated","reason":"Restarted {the} pod"}` + "\n```\nDone {really}."
+		require.NoError(t, ParseJSONObject(raw, &parsed))
+		assert.Equal(t, "mitigated", parsed.Outcome)
+		assert.Equal(t, "Restarted {the} pod", parsed.Reason)
+	})
+
+	t.Run("no JSON object", func(t *testing.T) {
+		assert.ErrorContains(t, ParseJSONObject("mitigated", &parsed), "no JSON object")
+		assert.ErrorContains(t, ParseJSONObject(`{"outcome": "mitigated"`, &parsed), "no JSON object")
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		assert.ErrorContains(t, ParseJSONObject(`{"outcome": mitigated}`, &parsed), "invalid JSON")
+	})
+}
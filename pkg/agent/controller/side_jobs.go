@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// sideJobMargin is the time a background side job gets beyond its LLM call
+// timeout for loading its inputs and storing its result.
+const sideJobMargin = 30 * time.Second
+
+// truncatedMarker marks prompt content cut by TruncatePrompt.
+const truncatedMarker = "\n[truncated]"
+
+// BackgroundJobs runs the side jobs started when a session finishes
+// (classification, extraction, comparison, synthesis) detached from the
+// worker, and lets shutdown wait for them. The zero value is ready to use.
+type BackgroundJobs struct {
+	mu      sync.Mutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// Go runs job in the background with a context bounded by callTimeout plus
+// a margin for the surrounding database work. A no-op after Stop; reports
+// whether the job was started.
+func (b *BackgroundJobs) Go(callTimeout time.Duration, job func(ctx context.Context)) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.stopped {
+		return false
+	}
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout+sideJobMargin)
+		defer cancel()
+		job(ctx)
+	}()
+	return true
+}
+
+// Stop stops launching jobs and waits for the running ones to finish.
+func (b *BackgroundJobs) Stop() {
+	b.mu.Lock()
+	b.stopped = true
+	b.mu.Unlock()
+	b.wg.Wait()
+}
+
+// Truncate caps s at maxBytes without splitting a multi-byte UTF-8
+// character.
+func Truncate(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
+
+// TruncatePrompt caps prompt content at maxBytes like Truncate and marks
+// the cut with "[truncated]" so the model knows the text is incomplete.
+func TruncatePrompt(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	return Truncate(s, maxBytes) + truncatedMarker
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackgroundJobs(t *testing.T) {
+	var jobs BackgroundJobs
+	release := make(chan struct{})
+	var finished atomic.Int32
+
+	started := jobs.Go(time.Minute, func(ctx context.Context) {
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(time.Minute+sideJobMargin), deadline, 5*time.Second)
+		<-release
+		finished.Add(1)
+	})
+	require.True(t, started)
+
+	stopped := make(chan struct{})
+	go func() {
+		jobs.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("Stop returned while a job was running")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	<-stopped
+	assert.Equal(t, int32(1), finished.Load())
+
+	assert.False(t, jobs.Go(time.Minute, func(context.Context) { finished.Add(1) }),
+		"no jobs start after Stop")
+	assert.Equal(t, int32(1), finished.Load())
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		max  int
+		want string
+	}{
+		{name: "short", s: "abc", max: 5, want: "abc"},
+		{name: "exact", s: "abcde", max: 5, want: "abcde"},
+		{name: "ascii", s: "abcdef", max: 4, want: "abcd"},
+		{name: "cut inside a rune", s: "ab€cd", max: 4, want: "ab"},
+		{name: "cut after a rune", s: "ab€cd", max: 5, want: "ab€"},
+		{name: "zero", s: "€", max: 0, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Truncate(tt.s, tt.max))
+		})
+	}
+}
+
+func TestTruncatePrompt(t *testing.T) {
+	assert.Equal(t, "short", TruncatePrompt("short", 10))
+	assert.Equal(t, "ab\n[truncated]", TruncatePrompt("ab€cd", 4))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
 )
 
@@ -23,6 +24,11 @@ type SingleShotConfig struct {
 
 	// InteractionLabel is recorded in LLM interactions (e.g. InteractionTypeSynthesis).
 	InteractionLabel llminteraction.InteractionType
+
+	// ProgressPhase is the execution.progress phase published when the call
+	// starts (e.g. events.ProgressPhaseSynthesizing). Empty publishes nothing,
+	// for controllers that run inside another execution's progress.
+	ProgressPhase string
 }
 
 // SingleShotController executes a single LLM call with no MCP tools.
@@ -47,6 +53,7 @@ func NewSynthesisController(pb agent.PromptBuilder) *SingleShotController {
 		BuildMessages:    pb.BuildSynthesisMessages,
 		ThinkingFallback: true,
 		InteractionLabel: llminteraction.InteractionTypeSynthesis,
+		ProgressPhase:    events.ProgressPhaseSynthesizing,
 	})
 }
 
@@ -54,14 +61,31 @@ func NewSynthesisController(pb agent.PromptBuilder) *SingleShotController {
 // prevStageContext receives the finalAnalysis text from the preceding investigation/synthesis stages.
 func NewExecSummaryController(pb agent.PromptBuilder) *SingleShotController {
 	return NewSingleShotController(SingleShotConfig{
-		BuildMessages: func(_ *agent.ExecutionContext, prevStageContext string) []agent.ConversationMessage {
+		BuildMessages: func(execCtx *agent.ExecutionContext, prevStageContext string) []agent.ConversationMessage {
 			return []agent.ConversationMessage{
-				{Role: agent.RoleSystem, Content: pb.BuildExecutiveSummarySystemPrompt()},
-				{Role: agent.RoleUser, Content: pb.BuildExecutiveSummaryUserPrompt(prevStageContext)},
+				{Role: agent.RoleSystem, Content: pb.BuildExecutiveSummarySystemPrompt(execCtx.OutputLanguage, execCtx.ExecutiveSummary)},
+				{Role: agent.RoleUser, Content: pb.BuildExecutiveSummaryUserPrompt(prevStageContext, execCtx.ExecutiveSummary)},
 			}
 		},
 		ThinkingFallback: false,
 		InteractionLabel: llminteraction.InteractionTypeExecutiveSummary,
+		ProgressPhase:    events.ProgressPhaseFinalizing,
+	})
+}
+
+// NewTechnicalSummaryController creates a SingleShotController configured for technical summary.
+// prevStageContext receives the finalAnalysis text from the preceding investigation/synthesis stages.
+func NewTechnicalSummaryController(pb agent.PromptBuilder) *SingleShotController {
+	return NewSingleShotController(SingleShotConfig{
+		BuildMessages: func(execCtx *agent.ExecutionContext, prevStageContext string) []agent.ConversationMessage {
+			return []agent.ConversationMessage{
+				{Role: agent.RoleSystem, Content: pb.BuildTechnicalSummarySystemPrompt(execCtx.OutputLanguage)},
+				{Role: agent.RoleUser, Content: pb.BuildTechnicalSummaryUserPrompt(prevStageContext)},
+			}
+		},
+		ThinkingFallback: false,
+		InteractionLabel: llminteraction.InteractionTypeTechnicalSummary,
+		ProgressPhase:    events.ProgressPhaseFinalizing,
 	})
 }
 
@@ -114,11 +138,20 @@ func (c *SingleShotController) Run(
 	// 2.6. Emit a single memory_injected event for all pre-loaded memories
 	emitMemoryInjectedEvent(ctx, execCtx, &eventSeq)
 
+	// 2.7. Record fallbacks for features the model lacks
+	emitCapabilityFallbackEvents(ctx, execCtx, &eventSeq)
+
+	if c.cfg.ProgressPhase != "" {
+		publishExecutionProgress(ctx, execCtx, c.cfg.ProgressPhase,
+			fmt.Sprintf("Running %s", c.cfg.InteractionLabel), progressPercent(0))
+	}
+
 	// 3. Single LLM call with streaming (no tools), with fallback retry
 	var streamed *StreamedResponse
 	var err error
 	var totalUsage agent.TokenUsage
 	emptyRetries := 0
+	stallRetries := 0
 	for {
 		if status, done := agent.StatusFromContextErr(ctx); done {
 			return &agent.ExecutionResult{
@@ -133,6 +166,7 @@ func (c *SingleShotController) Run(
 			ExecutionID: execCtx.ExecutionID,
 			Messages:    messages,
 			Config:      execCtx.Config.LLMProvider,
+			Generation:  execCtx.Config.Generation,
 			Tools:       nil, // No MCP tools; native tools (Google Search) may still activate
 			Backend:     execCtx.Config.LLMBackend,
 			ClearCache:  fbState.consumeClearCache(),
@@ -158,11 +192,20 @@ func (c *SingleShotController) Run(
 			startTime = time.Now()
 			continue
 		}
-		if !tryFallback(ctx, execCtx, fbState, err, &eventSeq) {
-			createTimelineEvent(ctx, execCtx, timelineevent.EventTypeError, err.Error(), nil, &eventSeq)
-			return nil, fmt.Errorf("%s LLM call failed: %w", c.cfg.InteractionLabel, err)
+		if tryFallback(ctx, execCtx, fbState, err, &eventSeq) {
+			startTime = time.Now()
+			continue
+		}
+		if isStalledStreamError(err) && stallRetries < maxStalledStreamRetries && ctx.Err() == nil {
+			stallRetries++
+			slog.Warn("LLM stream stalled, retrying",
+				"session_id", execCtx.SessionID, "label", c.cfg.InteractionLabel,
+				"attempt", stallRetries, "max_attempts", maxStalledStreamRetries, "error", err)
+			startTime = time.Now()
+			continue
 		}
-		startTime = time.Now()
+		createTimelineEvent(ctx, execCtx, timelineevent.EventTypeError, err.Error(), nil, &eventSeq)
+		return nil, fmt.Errorf("%s LLM call failed: %w", c.cfg.InteractionLabel, err)
 	}
 
 	if status, done := agent.StatusFromContextErr(ctx); done {
@@ -207,7 +250,7 @@ func (c *SingleShotController) Run(
 	if storeErr != nil {
 		return nil, fmt.Errorf("failed to store assistant message: %w", storeErr)
 	}
-	recordLLMInteraction(ctx, execCtx, 1, c.cfg.InteractionLabel, len(messages), storeResp, &assistantMsg.ID, startTime)
+	recordLLMInteraction(ctx, execCtx, 1, c.cfg.InteractionLabel, messages, storeResp, &assistantMsg.ID, startTime)
 
 	return &agent.ExecutionResult{
 		Status:        agent.ExecutionStatusCompleted,
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
@@ -342,6 +343,40 @@ func TestSingleShotController_NoFallback_ReturnsError(t *testing.T) {
 	require.Contains(t, err.Error(), "synthesis LLM call failed")
 }
 
+func TestSingleShotController_StalledStreamRetry(t *testing.T) {
+	// First call stalls mid-stream, the retry succeeds.
+	llm := &mockLLMClient{
+		responses: []mockLLMResponse{
+			{chunks: []agent.Chunk{&agent.TextChunk{Content: "partial"}}, stall: true},
+			{chunks: []agent.Chunk{&agent.TextChunk{Content: "Synthesis after stall."}}},
+		},
+	}
+
+	execCtx := newTestExecCtx(t, llm, &mockToolExecutor{})
+	execCtx.Config.StallTimeout = 50 * time.Millisecond
+
+	ctrl := NewSynthesisController(execCtx.PromptBuilder)
+	result, err := ctrl.Run(context.Background(), execCtx, "Agent 1 analysis text.")
+	require.NoError(t, err)
+	require.Equal(t, agent.ExecutionStatusCompleted, result.Status)
+	require.Equal(t, "Synthesis after stall.", result.FinalAnalysis)
+	require.Equal(t, 2, llm.callCount)
+}
+
+func TestSingleShotController_StalledStreamRetriesExhausted(t *testing.T) {
+	stalled := mockLLMResponse{chunks: []agent.Chunk{&agent.TextChunk{Content: "partial"}}, stall: true}
+	llm := &mockLLMClient{responses: []mockLLMResponse{stalled, stalled}}
+
+	execCtx := newTestExecCtx(t, llm, &mockToolExecutor{})
+	execCtx.Config.StallTimeout = 50 * time.Millisecond
+
+	ctrl := NewSynthesisController(execCtx.PromptBuilder)
+	_, err := ctrl.Run(context.Background(), execCtx, "Agent 1 analysis text.")
+	require.Error(t, err)
+	require.True(t, isStalledStreamError(err))
+	require.Equal(t, 1+maxStalledStreamRetries, llm.callCount)
+}
+
 func TestExecSummaryController_HappyPath(t *testing.T) {
 	llm := &mockLLMClient{
 		responses: []mockLLMResponse{
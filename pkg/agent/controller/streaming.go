@@ -46,6 +46,31 @@ type PartialOutputError struct {
 func (e *PartialOutputError) Error() string { return e.Cause.Error() }
 func (e *PartialOutputError) Unwrap() error { return e.Cause }
 
+// ErrorCategoryStalledStream classifies LLM calls aborted by the streaming
+// watchdog (no first chunk, or no chunk for too long after the first).
+const ErrorCategoryStalledStream = "stalled_stream"
+
+// ErrorCategory returns a bounded classification used as the error_code
+// metric label, or "" when the error has no dedicated category.
+func (e *PartialOutputError) ErrorCategory() string {
+	if e.Code.IsStalledStream() {
+		return ErrorCategoryStalledStream
+	}
+	return ""
+}
+
+// IsStalledStream reports whether the code was produced by the streaming
+// watchdog rather than by the LLM service.
+func (c LLMErrorCode) IsStalledStream() bool {
+	return c == LLMErrorInitialTimeout || c == LLMErrorStallTimeout
+}
+
+// isStalledStreamError reports whether err is a watchdog-aborted LLM call.
+func isStalledStreamError(err error) bool {
+	var poe *PartialOutputError
+	return errors.As(err, &poe) && poe.Code.IsStalledStream()
+}
+
 // LLMResponse holds the fully-collected response from a streaming LLM call.
 type LLMResponse struct {
 	Text           string
@@ -267,6 +292,9 @@ loop:
 				code = LLMErrorStallTimeout
 				msg = fmt.Sprintf("stream stalled: no data for %s", stallTimeout)
 			}
+			slog.Warn("LLM stream watchdog aborted call",
+				"category", ErrorCategoryStalledStream, "code", code,
+				"partial_text_len", textBuf.Len())
 			return nil, &PartialOutputError{
 				Cause:           fmt.Errorf("adaptive timeout: %s", msg),
 				PartialText:     textBuf.String(),
@@ -358,7 +386,9 @@ func callLLMWithStreaming(
 	input *agent.GenerateInput,
 	eventSeq *int,
 	extraMetadata ...map[string]interface{},
-) (*StreamedResponse, error) {
+) (_ *StreamedResponse, retErr error) {
+	defer func() { recordProviderHealth(ctx, execCtx, retErr) }()
+
 	llmCtx, llmCancel := context.WithCancel(ctx)
 	defer llmCancel()
 
@@ -367,9 +397,12 @@ func callLLMWithStreaming(
 		return nil, fmt.Errorf("LLM Generate failed: %w", err)
 	}
 
-	// If no EventPublisher, use simple collection (no streaming events)
+	// If no EventPublisher, use simple collection (no streaming events).
+	// The stall watchdog still applies so a hung stream can't consume the
+	// whole session timeout.
 	if execCtx.EventPublisher == nil {
-		resp, err := collectStream(stream)
+		resp, err := collectStreamWithCallback(stream, nil, llmCancel,
+			execCtx.Config.InitialResponseTimeout, execCtx.Config.StallTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -635,3 +668,25 @@ func mergeMetadata(base, extra map[string]interface{}) map[string]interface{} {
 	}
 	return merged
 }
+
+// recordProviderHealth reports an LLM call outcome to execCtx.ProviderHealth.
+// Cancellations and loop detections say nothing about the provider and are
+// not recorded.
+func recordProviderHealth(ctx context.Context, execCtx *agent.ExecutionContext, err error) {
+	if execCtx.ProviderHealth == nil || execCtx.Config == nil {
+		return
+	}
+	provider := execCtx.Config.LLMProviderName
+	if err == nil {
+		execCtx.ProviderHealth.RecordSuccess(provider)
+		return
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	var poe *PartialOutputError
+	if errors.As(err, &poe) && poe.IsLoop {
+		return
+	}
+	execCtx.ProviderHealth.RecordFailure(provider)
+}
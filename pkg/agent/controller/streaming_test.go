@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -455,6 +456,78 @@ func TestCollectStreamWithCallback_InitialToStallTransition(t *testing.T) {
 	assert.Equal(t, "first chunk", poe.PartialText)
 }
 
+// stallingLLMClient emits its chunks and then holds the stream open until
+// the caller cancels the Generate context.
+type stallingLLMClient struct {
+	chunks    []agent.Chunk
+	cancelled chan struct{}
+}
+
+func (m *stallingLLMClient) Generate(ctx context.Context, _ *agent.GenerateInput) (<-chan agent.Chunk, error) {
+	ch := make(chan agent.Chunk, len(m.chunks))
+	for _, c := range m.chunks {
+		ch <- c
+	}
+	go func() {
+		<-ctx.Done()
+		close(m.cancelled)
+		close(ch)
+	}()
+	return ch, nil
+}
+
+func (m *stallingLLMClient) Close() error { return nil }
+
+func TestCallLLMWithStreaming_StallWatchdogWithoutPublisher(t *testing.T) {
+	llm := &stallingLLMClient{
+		chunks:    []agent.Chunk{&agent.TextChunk{Content: "partial"}},
+		cancelled: make(chan struct{}),
+	}
+	execCtx := &agent.ExecutionContext{
+		Config: &agent.ResolvedAgentConfig{
+			InitialResponseTimeout: time.Second,
+			StallTimeout:           50 * time.Millisecond,
+		},
+	}
+
+	eventSeq := 0
+	_, err := callLLMWithStreaming(context.Background(), execCtx, llm, &agent.GenerateInput{}, &eventSeq)
+	require.Error(t, err)
+	assert.True(t, isStalledStreamError(err))
+
+	var poe *PartialOutputError
+	require.ErrorAs(t, err, &poe)
+	assert.Equal(t, LLMErrorStallTimeout, poe.Code)
+	assert.Equal(t, "partial", poe.PartialText)
+
+	select {
+	case <-llm.cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("stalled Generate context was not cancelled")
+	}
+}
+
+func TestPartialOutputError_ErrorCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		code LLMErrorCode
+		want string
+	}{
+		{"initial response timeout", LLMErrorInitialTimeout, ErrorCategoryStalledStream},
+		{"stall timeout", LLMErrorStallTimeout, ErrorCategoryStalledStream},
+		{"partial stream error", LLMErrorPartialStreamError, ""},
+		{"max retries", LLMErrorMaxRetries, ""},
+		{"no code", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &PartialOutputError{Cause: errors.New("boom"), Code: tt.code}
+			assert.Equal(t, tt.want, err.ErrorCategory())
+			assert.Equal(t, tt.want != "", isStalledStreamError(fmt.Errorf("wrapped: %w", err)))
+		})
+	}
+}
+
 func TestCollectStreamWithCallback_ActiveStreamNoTimeout(t *testing.T) {
 	ch := make(chan agent.Chunk, 5)
 	ch <- &agent.TextChunk{Content: "Hello "}
@@ -758,6 +831,15 @@ func (noopEventPublisher) PublishReviewStatus(context.Context, string, events.Re
 func (noopEventPublisher) PublishSessionScoreUpdated(context.Context, string, events.SessionScoreUpdatedPayload) error {
 	return nil
 }
+func (noopEventPublisher) PublishMCPServerLog(context.Context, string, events.MCPServerLogPayload) error {
+	return nil
+}
+func (noopEventPublisher) PublishSubAgentDispatched(context.Context, string, events.SubAgentDispatchedPayload) error {
+	return nil
+}
+func (noopEventPublisher) PublishSubAgentStatus(context.Context, string, events.SubAgentStatusPayload) error {
+	return nil
+}
 
 // contextExpiryErrorLLMClient sends initial chunks immediately, then waits
 // for the caller's context to expire before sending an error chunk. This
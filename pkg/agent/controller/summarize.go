@@ -15,6 +15,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/mcp"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/tokenizer"
 )
 
 // SummarizationResult holds the outcome of a summarization attempt.
@@ -63,8 +64,9 @@ func maybeSummarize(
 		return &SummarizationResult{Content: rawContent}, nil
 	}
 
-	// 2. Estimate token count and resolve effective config (defaults for nil)
-	estimatedTokens := mcp.EstimateTokens(rawContent)
+	// 2. Count tokens with the model's tokenizer and resolve effective config (defaults for nil)
+	tok := tokenizer.ForProvider(execCtx.Config.LLMProvider)
+	estimatedTokens := tok.Count(rawContent)
 	threshold := config.DefaultSizeThresholdTokens
 	maxSummaryTokens := 1000
 	if serverConfig.Summarization != nil {
@@ -83,14 +85,14 @@ func maybeSummarize(
 	// 3. Summarization needed
 	slog.Info("Tool result exceeds summarization threshold",
 		"server", serverID, "tool", toolName,
-		"estimated_tokens", estimatedTokens, "threshold", threshold)
+		"estimated_tokens", estimatedTokens, "threshold", threshold, "tokenizer", tok.Name())
 
-	// Publish execution progress: distilling
-	publishExecutionProgress(ctx, execCtx, events.ProgressPhaseDistilling,
-		fmt.Sprintf("Summarizing %s.%s (%d tokens)", serverID, toolName, estimatedTokens))
+	// Publish execution progress: still calling_tools (summarizing a tool result)
+	publishExecutionProgress(ctx, execCtx, events.ProgressPhaseCallingTools,
+		fmt.Sprintf("Summarizing %s.%s (%d tokens)", serverID, toolName, estimatedTokens), nil)
 
 	// 4. Safety-net truncate for summarization input
-	truncatedForLLM := mcp.TruncateForSummarization(rawContent)
+	truncatedForLLM := mcp.TruncateForSummarizationTokens(rawContent, tok)
 
 	// 5. Build summarization prompts
 	systemPrompt := execCtx.PromptBuilder.BuildMCPSummarizationSystemPrompt(serverID, toolName, maxSummaryTokens)
@@ -229,7 +231,8 @@ func callSummarizationLLMWithStreaming(
 					"event_id", streamTarget.existingEventID, "session_id", execCtx.SessionID, "error", pubErr)
 			}
 		}
-		resp, collectErr := collectStreamWithCallback(stream, callback, nil, 0, 0)
+		resp, collectErr := collectStreamWithCallback(stream, callback, llmCancel,
+			execCtx.Config.InitialResponseTimeout, execCtx.Config.StallTimeout)
 		if collectErr != nil {
 			return nil, collectErr
 		}
@@ -237,7 +240,8 @@ func callSummarizationLLMWithStreaming(
 	}
 
 	if !streamTarget.createEvent || execCtx.EventPublisher == nil {
-		resp, collectErr := collectStream(stream)
+		resp, collectErr := collectStreamWithCallback(stream, nil, llmCancel,
+			execCtx.Config.InitialResponseTimeout, execCtx.Config.StallTimeout)
 		if collectErr != nil {
 			return nil, collectErr
 		}
@@ -323,7 +327,8 @@ func callSummarizationLLMWithStreaming(
 		}
 	}
 
-	resp, err := collectStreamWithCallback(stream, callback, nil, 0, 0)
+	resp, err := collectStreamWithCallback(stream, callback, llmCancel,
+		execCtx.Config.InitialResponseTimeout, execCtx.Config.StallTimeout)
 	if err != nil {
 		// Mark streaming event as failed if it was created
 		if summaryEventID != "" {
@@ -412,6 +417,7 @@ func recordSummarizationInteraction(
 		ExecutionID:     &execCtx.ExecutionID,
 		InteractionType: string(llminteraction.InteractionTypeSummarization),
 		ModelName:       execCtx.Config.LLMProvider.Model,
+		Owner:           execCtx.Config.Owner,
 		LLMRequest: map[string]any{
 			"messages_count": len(inputMessages),
 			"iteration":      0,
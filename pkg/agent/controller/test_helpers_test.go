@@ -24,6 +24,7 @@ import (
 type mockLLMResponse struct {
 	chunks []agent.Chunk
 	err    error
+	stall  bool // leave the stream open after chunks (exercises the stall watchdog)
 }
 
 // mockLLMClient is a test mock for agent.LLMClient.
@@ -68,7 +69,9 @@ func (m *mockLLMClient) Generate(_ context.Context, input *agent.GenerateInput)
 	for _, c := range r.chunks {
 		ch <- c
 	}
-	close(ch)
+	if !r.stall {
+		close(ch)
+	}
 	return ch, nil
 }
 
@@ -195,6 +198,20 @@ func newTestExecCtx(t *testing.T, llm agent.LLMClient, toolExec agent.ToolExecut
 	}
 }
 
+// mockOrchestratorBudget implements agent.OrchestratorBudgetSource for
+// testing, recording the orchestrator's token count at each call.
+type mockOrchestratorBudget struct {
+	calls []int
+}
+
+func (m *mockOrchestratorBudget) BudgetNote(ownUsage agent.TokenUsage) agent.ConversationMessage {
+	m.calls = append(m.calls, ownUsage.TotalTokens)
+	return agent.ConversationMessage{
+		Role:    agent.RoleUser,
+		Content: fmt.Sprintf("[Orchestrator budget] %d tokens", ownUsage.TotalTokens),
+	}
+}
+
 // mockSubAgentCollector implements agent.SubAgentResultCollector for testing.
 type mockSubAgentCollector struct {
 	// Results to return from TryDrainResult (consumed in order).
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"context"
+	"time"
+)
+
+// timeBudget tracks how much of an execution's time budget is used: the time
+// from the start of the run to the context deadline (the session timeout, or
+// the sub-agent timeout for sub-agents).
+type timeBudget struct {
+	start    time.Time
+	deadline time.Time
+	wrapUpAt float64
+}
+
+// newTimeBudget returns the budget of a run starting now, or nil when ctx has
+// no deadline or wrapUpAt disables wrap-up (outside (0, 1)).
+func newTimeBudget(ctx context.Context, wrapUpAt float64) *timeBudget {
+	deadline, ok := ctx.Deadline()
+	if !ok || wrapUpAt <= 0 || wrapUpAt >= 1 {
+		return nil
+	}
+	return &timeBudget{start: time.Now(), deadline: deadline, wrapUpAt: wrapUpAt}
+}
+
+// wrapUpDue reports whether the run has used its wrap-up fraction of the
+// budget at now. Nil-safe.
+func (b *timeBudget) wrapUpDue(now time.Time) bool {
+	if b == nil {
+		return false
+	}
+	total := b.deadline.Sub(b.start)
+	return now.Sub(b.start) >= time.Duration(float64(total)*b.wrapUpAt)
+}
+
+// usedPercent returns the share of the budget used at now, 0-100.
+func (b *timeBudget) usedPercent(now time.Time) int {
+	total := b.deadline.Sub(b.start)
+	if total <= 0 {
+		return 100
+	}
+	return min(100, int(100*now.Sub(b.start)/total))
+}
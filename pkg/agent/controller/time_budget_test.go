@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimeBudget(t *testing.T) {
+	assert.Nil(t, newTimeBudget(context.Background(), 0.8), "no deadline")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	assert.Nil(t, newTimeBudget(ctx, 0), "disabled")
+	assert.Nil(t, newTimeBudget(ctx, 1), "disabled")
+	require.NotNil(t, newTimeBudget(ctx, 0.8))
+}
+
+func TestTimeBudget_WrapUpDue(t *testing.T) {
+	start := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	b := &timeBudget{start: start, deadline: start.Add(10 * time.Minute), wrapUpAt: 0.8}
+
+	assert.False(t, b.wrapUpDue(start))
+	assert.False(t, b.wrapUpDue(start.Add(7*time.Minute+59*time.Second)))
+	assert.True(t, b.wrapUpDue(start.Add(8*time.Minute)))
+	assert.Equal(t, 80, b.usedPercent(start.Add(8*time.Minute)))
+	assert.Equal(t, 100, b.usedPercent(start.Add(time.Hour)))
+
+	var disabled *timeBudget
+	assert.False(t, disabled.wrapUpDue(start.Add(time.Hour)))
+}
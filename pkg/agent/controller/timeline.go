@@ -99,6 +99,7 @@ func publishTimelineCreated(
 		Status:            timelineevent.StatusCompleted,
 		Content:           content,
 		Metadata:          metadata,
+		Highlight:         eventHighlight(event),
 		SequenceNumber:    seqNum,
 	})
 	if publishErr != nil {
@@ -107,6 +108,14 @@ func publishTimelineCreated(
 	}
 }
 
+// eventHighlight returns the event's highlight as sent in WS payloads.
+func eventHighlight(event *ent.TimelineEvent) string {
+	if event.Highlight == nil {
+		return ""
+	}
+	return string(*event.Highlight)
+}
+
 // emitMemoryInjectedEvent creates a single memory_injected timeline event
 // consolidating all pre-loaded memories. No-op when no memories were injected.
 func emitMemoryInjectedEvent(ctx context.Context, execCtx *agent.ExecutionContext, eventSeq *int) {
@@ -139,6 +148,29 @@ func emitMemoryInjectedEvent(ctx context.Context, execCtx *agent.ExecutionContex
 	)
 }
 
+// emitCapabilityFallbackEvents creates a capability_fallback timeline event
+// for each fallback applied at config resolution because the agent's
+// requested features exceed the model's capabilities.
+func emitCapabilityFallbackEvents(ctx context.Context, execCtx *agent.ExecutionContext, eventSeq *int) {
+	for _, warning := range execCtx.Config.CapabilityWarnings {
+		slog.Warn("Capability fallback applied",
+			"session_id", execCtx.SessionID,
+			"execution_id", execCtx.ExecutionID,
+			"agent", execCtx.AgentName,
+			"warning", warning,
+		)
+		createTimelineEvent(ctx, execCtx, timelineevent.EventTypeCapabilityFallback,
+			warning,
+			map[string]interface{}{
+				"provider":       execCtx.Config.LLMProviderName,
+				"model":          execCtx.Config.LLMProvider.Model,
+				"tools_disabled": execCtx.Config.ToolsDisabled,
+			},
+			eventSeq,
+		)
+	}
+}
+
 // finalizeStreamingEvent completes or fails a streaming timeline event.
 // If content is non-empty, the event is completed normally. If content is
 // empty (edge case: event created but all chunks were empty), it is marked
@@ -358,6 +390,7 @@ func completeToolCallEvent(
 	event *ent.TimelineEvent,
 	content string,
 	isError bool,
+	highlight timelineevent.Highlight,
 ) {
 	if event == nil {
 		return
@@ -376,6 +409,12 @@ func completeToolCallEvent(
 		slog.Warn("Failed to complete tool call event",
 			"event_id", event.ID, "session_id", execCtx.SessionID, "error", err)
 	}
+	if highlight != "" {
+		if err := execCtx.Services.Timeline.SetTimelineEventHighlight(ctx, event.ID, highlight); err != nil {
+			slog.Warn("Failed to highlight tool call event",
+				"event_id", event.ID, "session_id", execCtx.SessionID, "error", err)
+		}
+	}
 
 	// Publish completion to WebSocket
 	if execCtx.EventPublisher != nil {
@@ -391,6 +430,7 @@ func completeToolCallEvent(
 			Content:           content,
 			Status:            timelineevent.StatusCompleted,
 			Metadata:          completionMeta,
+			Highlight:         string(highlight),
 		}); pubErr != nil {
 			slog.Warn("Failed to publish tool call completed",
 				"event_id", event.ID, "session_id", execCtx.SessionID, "error", pubErr)
@@ -398,6 +438,40 @@ func completeToolCallEvent(
 	}
 }
 
+// cancelToolCallEvent marks an llm_tool_call timeline event as cancelled.
+// Uses a detached context: the caller's context is already cancelled, but
+// the event must not stay stuck at status "streaming".
+func cancelToolCallEvent(execCtx *agent.ExecutionContext, event *ent.TimelineEvent, content string) {
+	if event == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := execCtx.Services.Timeline.CancelTimelineEvent(ctx, event.ID, content); err != nil {
+		slog.Warn("Failed to cancel tool call event",
+			"event_id", event.ID, "session_id", execCtx.SessionID, "error", err)
+		return
+	}
+	if execCtx.EventPublisher != nil {
+		if pubErr := execCtx.EventPublisher.PublishTimelineCompleted(ctx, execCtx.SessionID, events.TimelineCompletedPayload{
+			BasePayload: events.BasePayload{
+				Type:      events.EventTypeTimelineCompleted,
+				SessionID: execCtx.SessionID,
+				Timestamp: time.Now().Format(time.RFC3339Nano),
+			},
+			EventID:           event.ID,
+			ParentExecutionID: parentExecID(execCtx),
+			EventType:         timelineevent.EventTypeLlmToolCall,
+			Content:           content,
+			Status:            timelineevent.StatusCancelled,
+		}); pubErr != nil {
+			slog.Warn("Failed to publish tool call cancelled",
+				"event_id", event.ID, "session_id", execCtx.SessionID, "error", pubErr)
+		}
+	}
+}
+
 // ============================================================================
 // Native tool event helpers
 // ============================================================================
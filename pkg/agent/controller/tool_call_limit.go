@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+)
+
+// toolCallLimit tracks an execution's tool calls against max_tool_calls.
+// Some models loop on the same tool dozens of times; the limit stops them
+// and forces a conclusion.
+type toolCallLimit struct {
+	max  int // 0 = unlimited
+	used int
+}
+
+// take counts one more tool call, or reports false when the limit is
+// reached. Calls over the limit are not executed but still need a result:
+// every tool call of an assistant message must be answered.
+func (l *toolCallLimit) take() bool {
+	if l.max > 0 && l.used >= l.max {
+		return false
+	}
+	l.used++
+	return true
+}
+
+// reached reports whether no tool calls are left.
+func (l *toolCallLimit) reached() bool {
+	return l.max > 0 && l.used >= l.max
+}
+
+// skippedResult is the tool result returned for a call over the limit.
+func (l *toolCallLimit) skippedResult() string {
+	return fmt.Sprintf("Tool call not executed: the limit of %d tool calls for this execution was reached.", l.max)
+}
+
+// recordToolCallCount persists the execution's tool call count (best-effort).
+func recordToolCallCount(ctx context.Context, execCtx *agent.ExecutionContext, count int) {
+	if execCtx.Services == nil || execCtx.Services.Stage == nil {
+		return
+	}
+	if err := execCtx.Services.Stage.UpdateExecutionToolCallCount(ctx, execCtx.ExecutionID, count); err != nil {
+		slog.Warn("Failed to update execution tool call count",
+			"execution_id", execCtx.ExecutionID, "error", err)
+	}
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToolCallLimit(t *testing.T) {
+	t.Run("unlimited never runs out", func(t *testing.T) {
+		l := &toolCallLimit{used: 100}
+		assert.True(t, l.take())
+		assert.False(t, l.reached())
+	})
+
+	t.Run("calls over the limit are refused", func(t *testing.T) {
+		l := &toolCallLimit{max: 2}
+		assert.True(t, l.take())
+		assert.False(t, l.reached())
+		assert.True(t, l.take())
+		assert.True(t, l.reached())
+		assert.False(t, l.take())
+		assert.Equal(t, 2, l.used)
+		assert.Contains(t, l.skippedResult(), "limit of 2 tool calls")
+	})
+}
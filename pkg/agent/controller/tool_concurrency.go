@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"sync"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// builtinToolSlot is the limiter key shared by all non-MCP tools
+// (orchestration, skills, memory). They run one at a time.
+const builtinToolSlot = ""
+
+// toolCallLimiter bounds concurrent tool execution within one iteration.
+// Each MCP server gets a semaphore sized by its max_concurrent_calls;
+// built-in tools share a single slot.
+type toolCallLimiter struct {
+	registry *config.MCPServerRegistry
+
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+func newToolCallLimiter(execCtx *agent.ExecutionContext) *toolCallLimiter {
+	l := &toolCallLimiter{slots: make(map[string]chan struct{})}
+	if execCtx.PromptBuilder != nil {
+		l.registry = execCtx.PromptBuilder.MCPServerRegistry()
+	}
+	return l
+}
+
+// acquire blocks until p may run and returns the matching release func.
+// Gemini provider-native tools are synthesized locally and never wait.
+func (l *toolCallLimiter) acquire(p *preparedToolCall) func() {
+	if p.providerNativeTool {
+		return func() {}
+	}
+	key := builtinToolSlot
+	if p.toolType == ToolTypeMCP {
+		key = p.serverID
+	}
+	slot := l.slot(key)
+	slot <- struct{}{}
+	return func() { <-slot }
+}
+
+func (l *toolCallLimiter) slot(key string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if ch, ok := l.slots[key]; ok {
+		return ch
+	}
+	ch := make(chan struct{}, l.limit(key))
+	l.slots[key] = ch
+	return ch
+}
+
+// limit returns the concurrency for key. Unknown servers (e.g. a
+// hallucinated server name the executor will reject) get the default.
+func (l *toolCallLimiter) limit(key string) int {
+	if key == builtinToolSlot {
+		return 1
+	}
+	if l.registry == nil {
+		return config.DefaultMCPMaxConcurrentCalls
+	}
+	serverCfg, err := l.registry.Get(key)
+	if err != nil {
+		return config.DefaultMCPMaxConcurrentCalls
+	}
+	return serverCfg.ConcurrencyLimit()
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestToolCallLimiter_Limit(t *testing.T) {
+	l := &toolCallLimiter{
+		registry: config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+			"serial":  {MaxConcurrentCalls: 1},
+			"default": {},
+		}),
+		slots: make(map[string]chan struct{}),
+	}
+
+	assert.Equal(t, 1, l.limit("serial"))
+	assert.Equal(t, config.DefaultMCPMaxConcurrentCalls, l.limit("default"))
+	assert.Equal(t, config.DefaultMCPMaxConcurrentCalls, l.limit("unknown"))
+	assert.Equal(t, 1, l.limit(builtinToolSlot))
+
+	noRegistry := &toolCallLimiter{slots: make(map[string]chan struct{})}
+	assert.Equal(t, config.DefaultMCPMaxConcurrentCalls, noRegistry.limit("serial"))
+}
+
+func TestToolCallLimiter_Acquire(t *testing.T) {
+	tests := []struct {
+		name    string
+		calls   []*preparedToolCall
+		wantMax int32
+	}{
+		{
+			name: "mcp server bounded by max_concurrent_calls",
+			calls: []*preparedToolCall{
+				{toolType: ToolTypeMCP, serverID: "k8s"},
+				{toolType: ToolTypeMCP, serverID: "k8s"},
+				{toolType: ToolTypeMCP, serverID: "k8s"},
+				{toolType: ToolTypeMCP, serverID: "k8s"},
+			},
+			wantMax: 2,
+		},
+		{
+			name: "built-in tools run one at a time",
+			calls: []*preparedToolCall{
+				{toolType: ToolTypeSkill},
+				{toolType: ToolTypeMemory},
+				{toolType: ToolTypeOrchestrator},
+			},
+			wantMax: 1,
+		},
+		{
+			name: "provider-native tools are not limited",
+			calls: []*preparedToolCall{
+				{toolType: ToolTypeNative, providerNativeTool: true},
+				{toolType: ToolTypeNative, providerNativeTool: true},
+				{toolType: ToolTypeNative, providerNativeTool: true},
+			},
+			wantMax: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &toolCallLimiter{
+				registry: config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+					"k8s": {MaxConcurrentCalls: 2},
+				}),
+				slots: make(map[string]chan struct{}),
+			}
+
+			var running, peak atomic.Int32
+			start := make(chan struct{})
+			var wg sync.WaitGroup
+			for _, p := range tt.calls {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					<-start
+					release := l.acquire(p)
+					defer release()
+					n := running.Add(1)
+					for {
+						old := peak.Load()
+						if n <= old || peak.CompareAndSwap(old, n) {
+							break
+						}
+					}
+					time.Sleep(20 * time.Millisecond)
+					running.Add(-1)
+				}()
+			}
+			close(start)
+			wg.Wait()
+
+			assert.Equal(t, tt.wantMax, peak.Load())
+		})
+	}
+}
@@ -3,13 +3,16 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent/orchestrator"
 	"github.com/codeready-toolchain/tarsy/pkg/agent/skill"
@@ -19,6 +22,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/mcp"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/tokenizer"
 )
 
 // ToolType classifies a tool call for dashboard rendering and trace metadata.
@@ -29,6 +33,9 @@ const (
 	ToolTypeOrchestrator ToolType = "orchestrator"
 	ToolTypeSkill        ToolType = "skill"
 	ToolTypeMemory       ToolType = "memory"
+	ToolTypeHighlight    ToolType = "highlight"
+	ToolTypeLink         ToolType = "link"
+	ToolTypeEnrichment   ToolType = "enrichment"
 	ToolTypeNative       ToolType = "google_native"
 )
 
@@ -166,6 +173,85 @@ func executeToolCall(
 	sameTurnGroundings []agent.GroundingChunk,
 	eventSeq *int,
 ) toolCallResult {
+	p := prepareToolCall(ctx, execCtx, call, sameTurnGroundings, eventSeq)
+	outcome := runToolCall(ctx, execCtx, p, sameTurnGroundings)
+	return finishToolCall(ctx, execCtx, p, outcome, messages, sameTurnGroundings, eventSeq)
+}
+
+// executeToolCalls runs all tool calls from one LLM iteration and returns
+// their results in call order.
+//
+// Steps 1–2 (events) and 4–5 (completion, summarization) run sequentially
+// so timeline sequence numbers stay deterministic; only step 3 — the tool
+// execution itself — runs concurrently. MCP calls are bounded per server by
+// max_concurrent_calls; built-in tools (orchestration, skills, memory) share
+// a single slot so they never run alongside each other.
+func executeToolCalls(
+	ctx context.Context,
+	execCtx *agent.ExecutionContext,
+	calls []agent.ToolCall,
+	messages []agent.ConversationMessage,
+	sameTurnGroundings []agent.GroundingChunk,
+	eventSeq *int,
+) []toolCallResult {
+	if len(calls) == 1 {
+		return []toolCallResult{executeToolCall(ctx, execCtx, calls[0], messages, sameTurnGroundings, eventSeq)}
+	}
+
+	prepared := make([]*preparedToolCall, len(calls))
+	for i, call := range calls {
+		prepared[i] = prepareToolCall(ctx, execCtx, call, sameTurnGroundings, eventSeq)
+	}
+
+	limiter := newToolCallLimiter(execCtx)
+	outcomes := make([]toolRunOutcome, len(calls))
+	var wg sync.WaitGroup
+	for i, p := range prepared {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release := limiter.acquire(p)
+			defer release()
+			outcomes[i] = runToolCall(ctx, execCtx, p, sameTurnGroundings)
+		}()
+	}
+	wg.Wait()
+
+	results := make([]toolCallResult, len(calls))
+	for i, p := range prepared {
+		results[i] = finishToolCall(ctx, execCtx, p, outcomes[i], messages, sameTurnGroundings, eventSeq)
+	}
+	return results
+}
+
+// preparedToolCall is a tool call with its name resolved and its
+// llm_tool_call timeline event (if any) created, ready to execute.
+type preparedToolCall struct {
+	call               agent.ToolCall // As emitted by the LLM
+	execCall           agent.ToolCall // As sent to the ToolExecutor
+	effectiveName      string
+	serverID           string
+	toolName           string
+	toolType           ToolType
+	providerNativeTool bool
+	event              *ent.TimelineEvent // nil when omitted or creation failed
+}
+
+// toolRunOutcome is the raw result of executing a prepared tool call.
+type toolRunOutcome struct {
+	result   *agent.ToolResult
+	err      error
+	duration time.Duration
+}
+
+// prepareToolCall performs steps 1–2 of executeToolCall.
+func prepareToolCall(
+	ctx context.Context,
+	execCtx *agent.ExecutionContext,
+	call agent.ToolCall,
+	sameTurnGroundings []agent.GroundingChunk,
+	eventSeq *int,
+) *preparedToolCall {
 	// Step 1: Normalize and split tool name (colon-prefixed orchestration names first)
 	plainFixed := mcp.NormalizeBuiltinPlainToolName(call.Name)
 	effectiveName := mcp.NormalizeToolName(plainFixed)
@@ -183,6 +269,12 @@ func executeToolCall(
 			toolType = ToolTypeSkill
 		} else if k, ok := builtintools.KindForPlainTool(toolName); ok && k == builtintools.KindMemory {
 			toolType = ToolTypeMemory
+		} else if ok && k == builtintools.KindHighlight {
+			toolType = ToolTypeHighlight
+		} else if ok && k == builtintools.KindLink {
+			toolType = ToolTypeLink
+		} else if ok && k == builtintools.KindEnrichment {
+			toolType = ToolTypeEnrichment
 		} else if config.IsGoogleNativeToolWireName(effectiveName) {
 			serverID = geminiNativeServerID
 			toolType = ToolTypeNative
@@ -199,9 +291,9 @@ func executeToolCall(
 	omitToolCallTimeline := providerNativeTool &&
 		omitLLMToolCallForNativeSearchOrURL(effectiveName, sameTurnGroundings, call.Arguments)
 
-	// Publish execution progress: gathering_info
-	publishExecutionProgress(ctx, execCtx, events.ProgressPhaseGatheringInfo,
-		fmt.Sprintf("Calling %s.%s", serverID, toolName))
+	// Publish execution progress: calling_tools
+	publishExecutionProgress(ctx, execCtx, events.ProgressPhaseCallingTools,
+		fmt.Sprintf("Calling %s.%s", serverID, toolName), nil)
 
 	// Step 2: Create streaming llm_tool_call event (dashboard shows spinner).
 	// Native google_search / url_context: omit when grounding (or URL-arg fallback) already produced a timeline row.
@@ -214,9 +306,6 @@ func executeToolCall(
 		}
 	}
 
-	// Step 3: Execute the tool with its own timeout within the iteration budget.
-	toolCtx, toolCancel := context.WithTimeout(ctx, execCtx.Config.ToolCallTimeout)
-	startTime := time.Now()
 	execCall := call
 	// Only rewrite the tool name when colon-prefix built-in correction applied.
 	// Otherwise preserve the LLM name (e.g. server__tool) — MCP executor normalizes.
@@ -224,28 +313,82 @@ func executeToolCall(
 		execCall.Name = effectiveName
 	}
 
-	var result *agent.ToolResult
-	var toolErr error
-	if providerNativeTool {
-		result = &agent.ToolResult{
-			CallID:  call.ID,
-			Name:    call.Name,
-			Content: syntheticNativeGeminiToolResult(effectiveName, sameTurnGroundings),
-			IsError: false,
+	return &preparedToolCall{
+		call:               call,
+		execCall:           execCall,
+		effectiveName:      effectiveName,
+		serverID:           serverID,
+		toolName:           toolName,
+		toolType:           toolType,
+		providerNativeTool: providerNativeTool,
+		event:              toolCallEvent,
+	}
+}
+
+// runToolCall performs step 3 of executeToolCall: execute the tool with its
+// own timeout within the iteration budget. It touches neither the timeline
+// nor eventSeq, so it is safe to run concurrently for sibling calls.
+func runToolCall(
+	ctx context.Context,
+	execCtx *agent.ExecutionContext,
+	p *preparedToolCall,
+	sameTurnGroundings []agent.GroundingChunk,
+) toolRunOutcome {
+	toolCtx, toolCancel := context.WithTimeout(ctx, execCtx.Config.ToolCallTimeout)
+	defer toolCancel()
+	startTime := time.Now()
+
+	if p.providerNativeTool {
+		return toolRunOutcome{
+			result: &agent.ToolResult{
+				CallID:  p.call.ID,
+				Name:    p.call.Name,
+				Content: syntheticNativeGeminiToolResult(p.effectiveName, sameTurnGroundings),
+				IsError: false,
+			},
+			duration: time.Since(startTime),
 		}
-	} else {
-		result, toolErr = execCtx.ToolExecutor.Execute(toolCtx, execCall)
 	}
-	toolCancel()
+	if p.toolType == ToolTypeMCP {
+		toolCtx = mcp.WithServerLogSink(toolCtx, func(line string) {
+			publishMCPServerLog(ctx, execCtx, p, line)
+		})
+	}
+	result, err := execCtx.ToolExecutor.Execute(toolCtx, p.execCall)
+	return toolRunOutcome{result: result, err: err, duration: time.Since(startTime)}
+}
+
+// finishToolCall performs steps 4–5 of executeToolCall: metrics, MCP
+// interaction recording, event completion, and summarization.
+func finishToolCall(
+	ctx context.Context,
+	execCtx *agent.ExecutionContext,
+	p *preparedToolCall,
+	outcome toolRunOutcome,
+	messages []agent.ConversationMessage,
+	sameTurnGroundings []agent.GroundingChunk,
+	eventSeq *int,
+) toolCallResult {
+	call, serverID, toolName, toolCallEvent := p.call, p.serverID, p.toolName, p.event
+	result, toolErr := outcome.result, outcome.err
 
 	metrics.MCPCallsTotal.WithLabelValues(serverID, toolName).Inc()
-	metrics.MCPDurationSeconds.WithLabelValues(serverID, toolName).Observe(time.Since(startTime).Seconds())
+	metrics.MCPDurationSeconds.WithLabelValues(serverID, toolName).Observe(outcome.duration.Seconds())
+
+	// Session or execution cancelled mid-call: whatever the tool returned is
+	// an artefact of the abort, so the event is marked cancelled rather than
+	// completed with an error result.
+	if errors.Is(ctx.Err(), context.Canceled) {
+		content := fmt.Sprintf("Tool call cancelled: %s", context.Cause(ctx))
+		cancelToolCallEvent(execCtx, toolCallEvent, content)
+		return toolCallResult{Content: content, IsError: true, Err: ctx.Err()}
+	}
 
 	if toolErr != nil {
 		metrics.MCPErrorsTotal.WithLabelValues(serverID, toolName).Inc()
 		errContent := fmt.Sprintf("Error executing tool: %s", toolErr.Error())
-		completeToolCallEvent(ctx, execCtx, toolCallEvent, errContent, true)
-		recordMCPInteraction(ctx, execCtx, serverID, toolName, call.Arguments, nil, startTime, toolErr)
+		completeToolCallEvent(ctx, execCtx, toolCallEvent, errContent, true, timelineevent.HighlightWarning)
+		recordMCPInteraction(ctx, execCtx, serverID, toolName, call.Arguments, nil, outcome.duration, toolErr)
 		return toolCallResult{Content: errContent, IsError: true, Err: toolErr}
 	}
 
@@ -254,21 +397,22 @@ func executeToolCall(
 	}
 
 	// Record MCP interaction (raw data preserved in trace for debugging)
-	recordMCPInteraction(ctx, execCtx, serverID, toolName, call.Arguments, result, startTime, nil)
+	recordMCPInteraction(ctx, execCtx, serverID, toolName, call.Arguments, result, outcome.duration, nil)
 
 	// When Gemini streaming omits url_context_metadata/grounding sources but the model still
 	// issued a url_context function call, emit a dashboard row from parsed tool arguments.
-	if providerNativeTool && !result.IsError &&
-		effectiveName == string(config.GoogleNativeToolURLContext) {
+	if p.providerNativeTool && !result.IsError &&
+		p.effectiveName == string(config.GoogleNativeToolURLContext) {
 		createURLContextFallbackFromToolArgs(ctx, execCtx, call.Arguments, sameTurnGroundings, eventSeq)
 	}
-	if providerNativeTool && !result.IsError &&
-		effectiveName == string(config.GoogleNativeToolGoogleSearch) {
+	if p.providerNativeTool && !result.IsError &&
+		p.effectiveName == string(config.GoogleNativeToolGoogleSearch) {
 		createGoogleSearchFallbackFromToolArgs(ctx, execCtx, call.Arguments, sameTurnGroundings, eventSeq)
 	}
 
 	content := result.Content
 	var usage *agent.TokenUsage
+	highlight := toolCallHighlight(result)
 
 	// Step 4–5: Complete tool call event and optionally summarize.
 	//
@@ -282,7 +426,7 @@ func executeToolCall(
 	// Regular tools: complete with the raw result, then optionally summarize
 	// large results via maybeSummarize (creates a separate mcp_tool_summary).
 	if !result.IsError && result.RequiredSummarization != nil {
-		estimatedTokens := mcp.EstimateTokens(result.Content)
+		estimatedTokens := tokenizer.ForProvider(execCtx.Config.LLMProvider).Count(result.Content)
 		var streamEventID string
 		if toolCallEvent != nil {
 			streamEventID = toolCallEvent.ID
@@ -297,6 +441,7 @@ func executeToolCall(
 				"server", serverID, "tool", toolName, "error", sumErr)
 			content = "Unable to retrieve session history — summarization failed."
 			result.IsError = true
+			highlight = timelineevent.HighlightWarning
 		} else {
 			content = summary
 			if result.RequiredSummarization.TransformResult != nil {
@@ -304,10 +449,10 @@ func executeToolCall(
 			}
 			usage = sumUsage
 		}
-		completeToolCallEvent(ctx, execCtx, toolCallEvent, content, result.IsError)
+		completeToolCallEvent(ctx, execCtx, toolCallEvent, content, result.IsError, highlight)
 	} else {
 		storageTruncated := mcp.TruncateForStorage(result.Content)
-		completeToolCallEvent(ctx, execCtx, toolCallEvent, storageTruncated, result.IsError)
+		completeToolCallEvent(ctx, execCtx, toolCallEvent, storageTruncated, result.IsError, highlight)
 
 		if !result.IsError {
 			convContext := buildConversationContext(messages)
@@ -323,6 +468,23 @@ func executeToolCall(
 	return toolCallResult{Content: content, IsError: result.IsError, Usage: usage}
 }
 
+// toolCallHighlight returns the highlight for a completed tool call: the
+// tool's own (e.g. mark_key_finding), else warning for failed calls.
+// Unknown values are dropped rather than failing the event update.
+func toolCallHighlight(result *agent.ToolResult) timelineevent.Highlight {
+	if result.Highlight != "" {
+		h := timelineevent.Highlight(result.Highlight)
+		if timelineevent.HighlightValidator(h) == nil {
+			return h
+		}
+		slog.Warn("Ignoring unknown tool result highlight", "tool", result.Name, "highlight", result.Highlight)
+	}
+	if result.IsError {
+		return timelineevent.HighlightWarning
+	}
+	return ""
+}
+
 // toolListEntry is the per-tool object stored in available_tools.
 type toolListEntry struct {
 	Name        string `json:"name"`
@@ -408,10 +570,10 @@ func recordMCPInteraction(
 	toolName string,
 	arguments string,
 	result *agent.ToolResult,
-	startTime time.Time,
+	duration time.Duration,
 	toolErr error,
 ) {
-	durationMs := int(time.Since(startTime).Milliseconds())
+	durationMs := int(duration.Milliseconds())
 
 	// Parse arguments from JSON string into map for structured storage.
 	var toolArgs map[string]any
@@ -428,6 +590,9 @@ func recordMCPInteraction(
 			"content":  mcp.TruncateForStorage(result.Content),
 			"is_error": result.IsError,
 		}
+		if result.ServerLog != "" {
+			toolResult["server_log"] = result.ServerLog
+		}
 	}
 
 	var errMsg *string
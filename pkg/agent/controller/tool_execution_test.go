@@ -34,7 +34,7 @@ func TestRecordMCPInteraction_Success(t *testing.T) {
 	}
 
 	recordMCPInteraction(ctx, execCtx, "kubernetes-server", "get_pods",
-		`{"namespace":"default"}`, result, startTime, nil)
+		`{"namespace":"default"}`, result, time.Since(startTime), nil)
 
 	// Query DB to verify the record was created.
 	interactions, err := execCtx.Services.Interaction.GetMCPInteractionsList(ctx, execCtx.SessionID)
@@ -56,6 +56,7 @@ func TestRecordMCPInteraction_Success(t *testing.T) {
 	// Result should include content and is_error flag.
 	assert.NotNil(t, rec.ToolResult)
 	assert.Equal(t, false, rec.ToolResult["is_error"])
+	assert.NotContains(t, rec.ToolResult, "server_log")
 
 	// Duration should be positive.
 	assert.NotNil(t, rec.DurationMs)
@@ -74,7 +75,7 @@ func TestRecordMCPInteraction_ToolError(t *testing.T) {
 	toolErr := errors.New("connection refused to MCP server")
 
 	recordMCPInteraction(ctx, execCtx, "test-mcp", "get_logs",
-		`{"pod":"app-1"}`, nil, startTime, toolErr)
+		`{"pod":"app-1"}`, nil, time.Since(startTime), toolErr)
 
 	interactions, err := execCtx.Services.Interaction.GetMCPInteractionsList(ctx, execCtx.SessionID)
 	require.NoError(t, err)
@@ -106,7 +107,7 @@ func TestRecordMCPInteraction_InvalidJSONArgs(t *testing.T) {
 	}
 
 	recordMCPInteraction(ctx, execCtx, "test-mcp", "get_pods",
-		"not-valid-json{{{", result, startTime, nil)
+		"not-valid-json{{{", result, time.Since(startTime), nil)
 
 	interactions, err := execCtx.Services.Interaction.GetMCPInteractionsList(ctx, execCtx.SessionID)
 	require.NoError(t, err)
@@ -131,7 +132,7 @@ func TestRecordMCPInteraction_EmptyArgs(t *testing.T) {
 	}
 
 	recordMCPInteraction(ctx, execCtx, "test-mcp", "list_items",
-		"", result, startTime, nil)
+		"", result, time.Since(startTime), nil)
 
 	interactions, err := execCtx.Services.Interaction.GetMCPInteractionsList(ctx, execCtx.SessionID)
 	require.NoError(t, err)
@@ -148,14 +149,15 @@ func TestRecordMCPInteraction_ErrorResult(t *testing.T) {
 	startTime := time.Now()
 
 	result := &agent.ToolResult{
-		CallID:  "call-1",
-		Name:    "test-mcp__get_pods",
-		Content: "pod not found",
-		IsError: true,
+		CallID:    "call-1",
+		Name:      "test-mcp__get_pods",
+		Content:   "pod not found",
+		IsError:   true,
+		ServerLog: "error: pods \"missing\" not found",
 	}
 
 	recordMCPInteraction(ctx, execCtx, "test-mcp", "get_pods",
-		`{"name":"missing"}`, result, startTime, nil)
+		`{"name":"missing"}`, result, time.Since(startTime), nil)
 
 	interactions, err := execCtx.Services.Interaction.GetMCPInteractionsList(ctx, execCtx.SessionID)
 	require.NoError(t, err)
@@ -164,6 +166,7 @@ func TestRecordMCPInteraction_ErrorResult(t *testing.T) {
 	rec := interactions[0]
 	assert.NotNil(t, rec.ToolResult)
 	assert.Equal(t, true, rec.ToolResult["is_error"])
+	assert.Equal(t, `error: pods "missing" not found`, rec.ToolResult["server_log"])
 	// No execution error — just a tool-level error flag.
 	assert.Nil(t, rec.ErrorMessage)
 }
@@ -342,6 +345,103 @@ func TestExecuteToolCall_ToolError(t *testing.T) {
 	assert.Contains(t, *interactions[0].ErrorMessage, "server unavailable")
 }
 
+func TestExecuteToolCall_HighlightsEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  *agent.ToolResult
+		toolErr error
+		want    *timelineevent.Highlight
+	}{
+		{
+			name:   "tool-provided highlight",
+			result: &agent.ToolResult{Content: "Key finding recorded: OOM", Highlight: "key_finding"},
+			want:   highlightPtr(timelineevent.HighlightKeyFinding),
+		},
+		{
+			name:   "error result highlighted as warning",
+			result: &agent.ToolResult{Content: "not found", IsError: true},
+			want:   highlightPtr(timelineevent.HighlightWarning),
+		},
+		{
+			name:    "executor error highlighted as warning",
+			toolErr: errors.New("server unavailable"),
+			want:    highlightPtr(timelineevent.HighlightWarning),
+		},
+		{
+			name:   "unknown highlight ignored",
+			result: &agent.ToolResult{Content: "ok", Highlight: "urgent"},
+		},
+		{
+			name:   "successful call not highlighted",
+			result: &agent.ToolResult{Content: "ok"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toolExec := &mockToolExecutorFunc{
+				tools: []agent.ToolDefinition{{Name: "test-mcp__tool"}},
+				executeFn: func(_ context.Context, _ agent.ToolCall) (*agent.ToolResult, error) {
+					return tt.result, tt.toolErr
+				},
+			}
+			execCtx := newTestExecCtx(t, &mockLLMClient{}, toolExec)
+			ctx := context.Background()
+			eventSeq := 0
+
+			executeToolCall(ctx, execCtx, agent.ToolCall{
+				ID:        "tc-highlight",
+				Name:      "test-mcp__tool",
+				Arguments: `{}`,
+			}, nil, nil, &eventSeq)
+
+			events, err := execCtx.Services.Timeline.GetSessionTimeline(ctx, execCtx.SessionID)
+			require.NoError(t, err)
+			require.NotEmpty(t, events)
+			lastEvent := events[len(events)-1]
+			assert.Equal(t, timelineevent.EventTypeLlmToolCall, lastEvent.EventType)
+			assert.Equal(t, tt.want, lastEvent.Highlight)
+		})
+	}
+}
+
+func highlightPtr(h timelineevent.Highlight) *timelineevent.Highlight {
+	return &h
+}
+
+func TestExecuteToolCall_CancelledMarksEventCancelled(t *testing.T) {
+	// Session cancelled while the tool runs: the llm_tool_call event ends
+	// as "cancelled", not "completed" with an error result.
+	ctx, cancel := context.WithCancel(context.Background())
+	toolExec := &mockToolExecutorFunc{
+		tools: []agent.ToolDefinition{{Name: "test-mcp__slow_tool"}},
+		executeFn: func(execCtx context.Context, _ agent.ToolCall) (*agent.ToolResult, error) {
+			cancel()
+			<-execCtx.Done()
+			return &agent.ToolResult{Content: "MCP tool execution failed: context canceled", IsError: true}, nil
+		},
+	}
+	execCtx := newTestExecCtx(t, &mockLLMClient{}, toolExec)
+	eventSeq := 0
+
+	result := executeToolCall(ctx, execCtx, agent.ToolCall{
+		ID:        "tc-cancel",
+		Name:      "test-mcp__slow_tool",
+		Arguments: `{}`,
+	}, nil, nil, &eventSeq)
+
+	assert.True(t, result.IsError)
+	assert.ErrorIs(t, result.Err, context.Canceled)
+	assert.Contains(t, result.Content, "Tool call cancelled")
+
+	events, err := execCtx.Services.Timeline.GetSessionTimeline(context.Background(), execCtx.SessionID)
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	lastEvent := events[len(events)-1]
+	assert.Equal(t, timelineevent.EventTypeLlmToolCall, lastEvent.EventType)
+	assert.Equal(t, timelineevent.StatusCancelled, lastEvent.Status)
+}
+
 func TestExecuteToolCall_ToolTypeClassification(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -388,6 +488,21 @@ func TestExecuteToolCall_ToolTypeClassification(t *testing.T) {
 			toolCallName: "recall_past_investigations",
 			wantToolType: string(ToolTypeMemory),
 		},
+		{
+			name:         "mark_key_finding classified as highlight",
+			toolCallName: "mark_key_finding",
+			wantToolType: string(ToolTypeHighlight),
+		},
+		{
+			name:         "generate_link classified as link",
+			toolCallName: "generate_link",
+			wantToolType: string(ToolTypeLink),
+		},
+		{
+			name:         "lookup_ip classified as enrichment",
+			toolCallName: "lookup_ip",
+			wantToolType: string(ToolTypeEnrichment),
+		},
 		{
 			name:         "malformed MCP name without server prefix stays MCP",
 			toolCallName: "resources_get",
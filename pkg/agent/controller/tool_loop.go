@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+)
+
+// toolLoopDetector counts identical tool calls (same tool and arguments)
+// within an execution. Once a call has run max times, repeats are answered
+// with its prior result plus a warning instead of running again.
+type toolLoopDetector struct {
+	max      int // 0 = no detection
+	calls    map[string]*repeatedToolCall
+	reported bool // loop_detected already recorded on the execution
+}
+
+type repeatedToolCall struct {
+	runs   int
+	result string
+}
+
+func newToolLoopDetector(max int) *toolLoopDetector {
+	return &toolLoopDetector{max: max, calls: make(map[string]*repeatedToolCall)}
+}
+
+// repeat returns the prior result of call when it has already run max times.
+func (d *toolLoopDetector) repeat(call agent.ToolCall) (string, bool) {
+	if d.max <= 0 {
+		return "", false
+	}
+	rc, ok := d.calls[toolCallKey(call)]
+	if !ok || rc.runs < d.max {
+		return "", false
+	}
+	return rc.result, true
+}
+
+// record counts a successful run of call. Failed runs are not counted: they
+// may be retried.
+func (d *toolLoopDetector) record(call agent.ToolCall, result string) {
+	key := toolCallKey(call)
+	rc, ok := d.calls[key]
+	if !ok {
+		rc = &repeatedToolCall{}
+		d.calls[key] = rc
+	}
+	rc.runs++
+	rc.result = result
+}
+
+// repeatResult is the tool result returned for a short-circuited repeat.
+func (d *toolLoopDetector) repeatResult(call agent.ToolCall, prior string) string {
+	return fmt.Sprintf("Duplicate tool call: %s was already called %d times with these arguments, so it was not run again. "+
+		"The previous result is repeated below; calling it again will not return new data. "+
+		"Use the data you have, try a different tool or different arguments, or conclude.\n\n%s",
+		call.Name, d.max, prior)
+}
+
+// toolCallKey identifies identical calls. Arguments are compared as JSON, so
+// key order and whitespace do not matter.
+func toolCallKey(call agent.ToolCall) string {
+	args := strings.TrimSpace(call.Arguments)
+	var v any
+	if err := json.Unmarshal([]byte(args), &v); err == nil {
+		if canonical, err := json.Marshal(v); err == nil {
+			args = string(canonical)
+		}
+	}
+	return call.Name + "\x00" + args
+}
+
+// recordLoopDetected flags the execution for analytics (best-effort).
+func recordLoopDetected(ctx context.Context, execCtx *agent.ExecutionContext, call agent.ToolCall) {
+	slog.Warn("Duplicate tool call loop detected",
+		"session_id", execCtx.SessionID,
+		"execution_id", execCtx.ExecutionID,
+		"tool", call.Name)
+	if execCtx.Services == nil || execCtx.Services.Stage == nil {
+		return
+	}
+	if err := execCtx.Services.Stage.MarkExecutionLoopDetected(ctx, execCtx.ExecutionID); err != nil {
+		slog.Warn("Failed to mark execution loop detected",
+			"execution_id", execCtx.ExecutionID, "error", err)
+	}
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToolLoopDetector(t *testing.T) {
+	pods := agent.ToolCall{ID: "1", Name: "k8s.get_pods", Arguments: `{"namespace": "prod", "label": "app=web"}`}
+	reordered := agent.ToolCall{ID: "2", Name: "k8s.get_pods", Arguments: `{"label":"app=web","namespace":"prod"}`}
+	other := agent.ToolCall{ID: "3", Name: "k8s.get_pods", Arguments: `{"namespace": "dev"}`}
+
+	t.Run("repeats beyond max get the prior result", func(t *testing.T) {
+		d := newToolLoopDetector(2)
+		_, ok := d.repeat(pods)
+		assert.False(t, ok)
+		d.record(pods, "first")
+		_, ok = d.repeat(reordered)
+		assert.False(t, ok, "second run is allowed")
+		d.record(reordered, "second")
+
+		prior, ok := d.repeat(pods)
+		assert.True(t, ok, "argument key order does not matter")
+		assert.Equal(t, "second", prior)
+		assert.Contains(t, d.repeatResult(pods, prior), "already called 2 times")
+		assert.Contains(t, d.repeatResult(pods, prior), "second")
+
+		_, ok = d.repeat(other)
+		assert.False(t, ok, "different arguments are a different call")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		d := newToolLoopDetector(0)
+		d.record(pods, "first")
+		d.record(pods, "second")
+		_, ok := d.repeat(pods)
+		assert.False(t, ok)
+	})
+
+	t.Run("non-JSON arguments compare as text", func(t *testing.T) {
+		assert.Equal(t,
+			toolCallKey(agent.ToolCall{Name: "x", Arguments: " raw "}),
+			toolCallKey(agent.ToolCall{Name: "x", Arguments: "raw"}))
+	})
+}
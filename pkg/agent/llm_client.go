@@ -3,6 +3,7 @@ package agent
 import (
 	"context"
 
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 )
 
@@ -24,9 +25,10 @@ type GenerateInput struct {
 	ExecutionID string
 	Messages    []ConversationMessage
 	Config      *config.LLMProviderConfig
-	Tools       []ToolDefinition  // nil = no tools
-	Backend     config.LLMBackend // see config.LLMBackendNativeGemini, config.LLMBackendLangChain
-	ClearCache  bool              // signal to clear provider content cache (e.g. on fallback provider switch)
+	Generation  *config.GenerationParams // overrides layered over Config.Generation (nil = none)
+	Tools       []ToolDefinition         // nil = no tools
+	Backend     config.LLMBackend        // see config.LLMBackendNativeGemini, config.LLMBackendLangChain
+	ClearCache  bool                     // signal to clear provider content cache (e.g. on fallback provider switch)
 }
 
 // Conversation message roles.
@@ -41,9 +43,16 @@ const (
 type ConversationMessage struct {
 	Role       string // RoleSystem, RoleUser, RoleAssistant, RoleTool
 	Content    string
-	ToolCalls  []ToolCall // For assistant messages
-	ToolCallID string     // For tool result messages
-	ToolName   string     // For tool result messages
+	ToolCalls  []ToolCall  // For assistant messages
+	ToolCallID string      // For tool result messages
+	ToolName   string      // For tool result messages
+	Images     []ImagePart // For user messages (sent only to multimodal providers)
+}
+
+// ImagePart is an image attachment sent to the LLM with a user message.
+type ImagePart struct {
+	Ref  schema.ImageRef // Blob store reference, recorded on the stored message
+	Data []byte
 }
 
 // ToolDefinition describes a tool available to the LLM.
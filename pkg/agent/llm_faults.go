@@ -0,0 +1,43 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
+)
+
+// injectedRateLimitMessage mirrors the error the LLM service returns when a
+// provider keeps answering 429 through all of its retries.
+const injectedRateLimitMessage = "Generation failed after 3 retries: 429 RESOURCE_EXHAUSTED: rate limit exceeded (injected fault)"
+
+// faultInjectingLLMClient fails Generate calls as rate limited at the
+// injector's rate, without reaching the LLM service.
+type faultInjectingLLMClient struct {
+	LLMClient
+	faults *faultinject.Injector
+}
+
+// WithFaultInjection wraps client so that calls fail as rate limited at the
+// injector's rate. Returns client unchanged when injector is nil.
+func WithFaultInjection(client LLMClient, injector *faultinject.Injector) LLMClient {
+	if injector == nil {
+		return client
+	}
+	return &faultInjectingLLMClient{LLMClient: client, faults: injector}
+}
+
+// Generate implements LLMClient.
+func (c *faultInjectingLLMClient) Generate(ctx context.Context, input *GenerateInput) (<-chan Chunk, error) {
+	model := ""
+	if input.Config != nil {
+		model = input.Config.Model
+	}
+	if c.faults.LLMFault(model) != faultinject.FaultLLMRateLimit {
+		return c.LLMClient.Generate(ctx, input)
+	}
+
+	ch := make(chan Chunk, 1)
+	ch <- &ErrorChunk{Message: injectedRateLimitMessage, Code: "max_retries", Retryable: false}
+	close(ch)
+	return ch, nil
+}
@@ -0,0 +1,56 @@
+package agent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
+)
+
+// stubLLMClient streams a single text chunk.
+type stubLLMClient struct{ calls int }
+
+func (s *stubLLMClient) Generate(context.Context, *GenerateInput) (<-chan Chunk, error) {
+	s.calls++
+	ch := make(chan Chunk, 1)
+	ch <- &TextChunk{Content: "ok"}
+	close(ch)
+	return ch, nil
+}
+
+func (s *stubLLMClient) Close() error { return nil }
+
+func TestWithFaultInjection(t *testing.T) {
+	inner := &stubLLMClient{}
+	assert.Same(t, inner, WithFaultInjection(inner, nil), "no wrapping without an injector")
+
+	t.Run("rate limited calls never reach the service", func(t *testing.T) {
+		client := WithFaultInjection(inner, faultinject.New(&config.FaultInjectionConfig{Enabled: true, LLMRateLimitRate: 1}, nil))
+		ch, err := client.Generate(context.Background(), &GenerateInput{Config: &config.LLMProviderConfig{Model: "gemini"}})
+		require.NoError(t, err)
+
+		var chunks []Chunk
+		for c := range ch {
+			chunks = append(chunks, c)
+		}
+		require.Len(t, chunks, 1)
+		errChunk, ok := chunks[0].(*ErrorChunk)
+		require.True(t, ok)
+		assert.Equal(t, "max_retries", errChunk.Code)
+		assert.Contains(t, errChunk.Message, "429")
+		assert.Zero(t, inner.calls)
+	})
+
+	t.Run("zero rate passes through", func(t *testing.T) {
+		client := WithFaultInjection(inner, faultinject.New(&config.FaultInjectionConfig{Enabled: true}, nil))
+		ch, err := client.Generate(context.Background(), &GenerateInput{})
+		require.NoError(t, err)
+		for range ch {
+		}
+		assert.Equal(t, 1, inner.calls)
+	})
+}
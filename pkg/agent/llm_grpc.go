@@ -83,12 +83,14 @@ func toProtoRequest(input *GenerateInput) *llmv1.GenerateRequest {
 	req := &llmv1.GenerateRequest{
 		SessionId:   input.SessionID,
 		ExecutionId: input.ExecutionID,
-		Messages:    toProtoMessages(input.Messages),
+		Messages:    toProtoMessages(input.Messages, input.Config != nil && input.Config.EffectiveCapabilities().Vision),
 		Tools:       toProtoTools(input.Tools),
 		ClearCache:  input.ClearCache,
 	}
 	if input.Config != nil {
 		req.LlmConfig = toProtoLLMConfig(input.Config)
+		req.LlmConfig.Generation = toProtoGenerationParams(
+			config.MergeGenerationParams(input.Config.Generation, input.Generation))
 	}
 	// Backend is set by the caller from LLMBackend config, not derived from provider type
 	if req.LlmConfig != nil && input.Backend != "" {
@@ -97,7 +99,10 @@ func toProtoRequest(input *GenerateInput) *llmv1.GenerateRequest {
 	return req
 }
 
-func toProtoMessages(msgs []ConversationMessage) []*llmv1.ConversationMessage {
+// toProtoMessages converts the conversation. Images are attached only when
+// the provider is multimodal; otherwise the text notes that they were left
+// out, so the model does not assume it saw them.
+func toProtoMessages(msgs []ConversationMessage, multimodal bool) []*llmv1.ConversationMessage {
 	out := make([]*llmv1.ConversationMessage, len(msgs))
 	for i, m := range msgs {
 		pm := &llmv1.ConversationMessage{
@@ -106,6 +111,18 @@ func toProtoMessages(msgs []ConversationMessage) []*llmv1.ConversationMessage {
 			ToolCallId: m.ToolCallID,
 			ToolName:   m.ToolName,
 		}
+		if len(m.Images) > 0 {
+			if multimodal {
+				for _, img := range m.Images {
+					pm.Images = append(pm.Images, &llmv1.ImagePart{
+						MimeType: img.Ref.MimeType,
+						Data:     img.Data,
+					})
+				}
+			} else {
+				pm.Content += fmt.Sprintf("\n\n[%d attached image(s) omitted: this model does not accept image input]", len(m.Images))
+			}
+		}
 		for _, tc := range m.ToolCalls {
 			pm.ToolCalls = append(pm.ToolCalls, &llmv1.ToolCall{
 				Id:        tc.ID,
@@ -153,6 +170,24 @@ func toProtoLLMConfig(cfg *config.LLMProviderConfig) *llmv1.LLMConfig {
 	return pc
 }
 
+// toProtoGenerationParams converts merged generation parameters; nil leaves
+// every parameter at the provider default.
+func toProtoGenerationParams(g *config.GenerationParams) *llmv1.GenerationParams {
+	if g == nil {
+		return nil
+	}
+	pg := &llmv1.GenerationParams{
+		Temperature:     g.Temperature,
+		TopP:            g.TopP,
+		ReasoningEffort: string(g.ReasoningEffort),
+	}
+	if g.MaxOutputTokens != nil {
+		v := clampToInt32(*g.MaxOutputTokens)
+		pg.MaxOutputTokens = &v
+	}
+	return pg
+}
+
 // clampToInt32 converts an int to int32, clamping to math.MaxInt32 if needed.
 func clampToInt32(v int) int32 {
 	if v > math.MaxInt32 {
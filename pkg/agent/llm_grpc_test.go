@@ -3,6 +3,7 @@ package agent
 import (
 	"testing"
 
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	llmv1 "github.com/codeready-toolchain/tarsy/proto"
 	"github.com/stretchr/testify/assert"
@@ -19,7 +20,7 @@ func TestToProtoMessages(t *testing.T) {
 		{Role: "tool", Content: `{"result":"ok"}`, ToolCallID: "tc1", ToolName: "k8s.get_pods"},
 	}
 
-	result := toProtoMessages(messages)
+	result := toProtoMessages(messages, false)
 	require.Len(t, result, 4)
 
 	assert.Equal(t, "system", result[0].Role)
@@ -40,6 +41,28 @@ func TestToProtoMessages(t *testing.T) {
 	assert.Equal(t, "k8s.get_pods", result[3].ToolName)
 }
 
+func TestToProtoMessages_Images(t *testing.T) {
+	messages := []ConversationMessage{
+		{Role: "user", Content: "What does this panel show?", Images: []ImagePart{
+			{Ref: schema.ImageRef{ID: "img-1", MimeType: "image/png"}, Data: []byte("png-bytes")},
+		}},
+	}
+
+	t.Run("multimodal providers get image parts", func(t *testing.T) {
+		result := toProtoMessages(messages, true)
+		require.Len(t, result[0].Images, 1)
+		assert.Equal(t, "image/png", result[0].Images[0].MimeType)
+		assert.Equal(t, []byte("png-bytes"), result[0].Images[0].Data)
+		assert.Equal(t, "What does this panel show?", result[0].Content)
+	})
+
+	t.Run("other providers get a text note", func(t *testing.T) {
+		result := toProtoMessages(messages, false)
+		assert.Empty(t, result[0].Images)
+		assert.Contains(t, result[0].Content, "[1 attached image(s) omitted")
+	})
+}
+
 func TestToProtoLLMConfig(t *testing.T) {
 	cfg := &config.LLMProviderConfig{
 		Type:                config.LLMProviderTypeGoogle,
@@ -102,6 +125,51 @@ func TestToProtoRequest_BackendPassthrough(t *testing.T) {
 	})
 }
 
+func TestToProtoRequest_Generation(t *testing.T) {
+	temp, topP, maxTokens := 1.0, 0.9, 2048
+	provider := &config.LLMProviderConfig{
+		Type:  config.LLMProviderTypeOpenAI,
+		Model: "gpt-5",
+		Generation: &config.GenerationParams{
+			Temperature:     &temp,
+			MaxOutputTokens: &maxTokens,
+		},
+	}
+
+	t.Run("no params leaves generation unset", func(t *testing.T) {
+		req := toProtoRequest(&GenerateInput{Config: &config.LLMProviderConfig{Type: config.LLMProviderTypeOpenAI}})
+		assert.Nil(t, req.LlmConfig.Generation)
+	})
+
+	t.Run("provider params are sent", func(t *testing.T) {
+		req := toProtoRequest(&GenerateInput{Config: provider})
+		require.NotNil(t, req.LlmConfig.Generation)
+		assert.Equal(t, 1.0, req.LlmConfig.Generation.GetTemperature())
+		assert.Equal(t, int32(2048), req.LlmConfig.Generation.GetMaxOutputTokens())
+		assert.Nil(t, req.LlmConfig.Generation.TopP)
+	})
+
+	t.Run("input overrides are layered over the provider", func(t *testing.T) {
+		zero := 0.0
+		req := toProtoRequest(&GenerateInput{
+			Config: provider,
+			Generation: &config.GenerationParams{
+				Temperature:     &zero,
+				TopP:            &topP,
+				ReasoningEffort: config.ReasoningEffortMedium,
+			},
+		})
+		g := req.LlmConfig.Generation
+		require.NotNil(t, g)
+		require.NotNil(t, g.Temperature, "explicit zero temperature must be sent")
+		assert.Equal(t, 0.0, *g.Temperature)
+		assert.Equal(t, 0.9, g.GetTopP())
+		assert.Equal(t, int32(2048), g.GetMaxOutputTokens())
+		assert.Equal(t, "medium", g.ReasoningEffort)
+		assert.Equal(t, 1.0, *provider.Generation.Temperature, "provider config is not mutated")
+	})
+}
+
 func TestFromProtoResponse(t *testing.T) {
 	t.Run("text delta", func(t *testing.T) {
 		resp := &llmv1.GenerateResponse{
@@ -0,0 +1,112 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ScriptedResponse is one canned LLM response of a sandbox run. Responses
+// are returned in order, one per Generate call, whichever agent makes it.
+type ScriptedResponse struct {
+	Thinking  string             `json:"thinking,omitempty"`
+	Text      string             `json:"text,omitempty"`
+	ToolCalls []ScriptedToolCall `json:"tool_calls,omitempty"`
+	Error     string             `json:"error,omitempty"` // the call fails with this message instead
+}
+
+// ScriptedToolCall is a tool call in a scripted response. Name is the tool
+// as agents see it, e.g. "kubernetes-server.get_pods".
+type ScriptedToolCall struct {
+	Name      string         `json:"name"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+}
+
+// ValidateScript checks that every response has content or an error.
+func ValidateScript(script []ScriptedResponse) error {
+	for i, resp := range script {
+		if resp.Text == "" && len(resp.ToolCalls) == 0 && resp.Error == "" {
+			return fmt.Errorf("response %d has no text, tool_calls or error", i)
+		}
+		for _, call := range resp.ToolCalls {
+			if call.Name == "" {
+				return fmt.Errorf("response %d has a tool call without a name", i)
+			}
+		}
+	}
+	return nil
+}
+
+// scriptedLLMClient answers Generate calls from a script instead of the
+// LLM service.
+type scriptedLLMClient struct {
+	mu     sync.Mutex
+	script []ScriptedResponse
+	next   int
+}
+
+// NewScriptedLLMClient returns an LLMClient that answers each Generate call
+// with the next scripted response. Calls past the end of the script fail.
+func NewScriptedLLMClient(script []ScriptedResponse) LLMClient {
+	return &scriptedLLMClient{script: script}
+}
+
+// Generate implements LLMClient.
+func (c *scriptedLLMClient) Generate(_ context.Context, _ *GenerateInput) (<-chan Chunk, error) {
+	c.mu.Lock()
+	index := c.next
+	c.next++
+	c.mu.Unlock()
+
+	if index >= len(c.script) {
+		return singleChunk(&ErrorChunk{
+			Message: fmt.Sprintf("LLM script exhausted after %d responses", len(c.script)),
+			Code:    "script_exhausted",
+		}), nil
+	}
+	resp := c.script[index]
+	if resp.Error != "" {
+		return singleChunk(&ErrorChunk{Message: resp.Error, Code: "scripted"}), nil
+	}
+
+	chunks := make([]Chunk, 0, len(resp.ToolCalls)+3)
+	if resp.Thinking != "" {
+		chunks = append(chunks, &ThinkingChunk{Content: resp.Thinking})
+	}
+	if resp.Text != "" {
+		chunks = append(chunks, &TextChunk{Content: resp.Text})
+	}
+	for i, call := range resp.ToolCalls {
+		args := []byte("{}")
+		if call.Arguments != nil {
+			var err error
+			if args, err = json.Marshal(call.Arguments); err != nil {
+				return nil, fmt.Errorf("scripted response %d: marshal arguments of %s: %w", index, call.Name, err)
+			}
+		}
+		chunks = append(chunks, &ToolCallChunk{
+			CallID:    fmt.Sprintf("scripted-%d-%d", index, i),
+			Name:      call.Name,
+			Arguments: string(args),
+		})
+	}
+	chunks = append(chunks, &UsageChunk{})
+
+	ch := make(chan Chunk, len(chunks))
+	for _, chunk := range chunks {
+		ch <- chunk
+	}
+	close(ch)
+	return ch, nil
+}
+
+// Close implements LLMClient.
+func (c *scriptedLLMClient) Close() error { return nil }
+
+func singleChunk(chunk Chunk) <-chan Chunk {
+	ch := make(chan Chunk, 1)
+	ch <- chunk
+	close(ch)
+	return ch
+}
@@ -0,0 +1,56 @@
+package agent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func drain(t *testing.T, client LLMClient) []Chunk {
+	t.Helper()
+	ch, err := client.Generate(context.Background(), &GenerateInput{})
+	require.NoError(t, err)
+	var chunks []Chunk
+	for c := range ch {
+		chunks = append(chunks, c)
+	}
+	return chunks
+}
+
+func TestScriptedLLMClient(t *testing.T) {
+	client := NewScriptedLLMClient([]ScriptedResponse{
+		{Thinking: "check pods", ToolCalls: []ScriptedToolCall{
+			{Name: "kubernetes-server.get_pods", Arguments: map[string]any{"namespace": "payments"}},
+			{Name: "kubernetes-server.get_events"},
+		}},
+		{Error: "provider unavailable"},
+		{Text: "The payments API is crash looping."},
+	})
+
+	chunks := drain(t, client)
+	require.Len(t, chunks, 4)
+	assert.Equal(t, &ThinkingChunk{Content: "check pods"}, chunks[0])
+	assert.Equal(t, &ToolCallChunk{CallID: "scripted-0-0", Name: "kubernetes-server.get_pods", Arguments: `{"namespace":"payments"}`}, chunks[1])
+	assert.Equal(t, &ToolCallChunk{CallID: "scripted-0-1", Name: "kubernetes-server.get_events", Arguments: `{}`}, chunks[2])
+	assert.IsType(t, &UsageChunk{}, chunks[3])
+
+	chunks = drain(t, client)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "provider unavailable", chunks[0].(*ErrorChunk).Message)
+
+	chunks = drain(t, client)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, &TextChunk{Content: "The payments API is crash looping."}, chunks[0])
+
+	chunks = drain(t, client)
+	require.Len(t, chunks, 1)
+	assert.Contains(t, chunks[0].(*ErrorChunk).Message, "script exhausted after 3 responses")
+}
+
+func TestValidateScript(t *testing.T) {
+	assert.NoError(t, ValidateScript([]ScriptedResponse{{Text: "ok"}, {Error: "boom"}}))
+	assert.ErrorContains(t, ValidateScript([]ScriptedResponse{{Thinking: "hmm"}}), "response 0 has no text")
+	assert.ErrorContains(t, ValidateScript([]ScriptedResponse{{ToolCalls: []ScriptedToolCall{{}}}}), "without a name")
+}
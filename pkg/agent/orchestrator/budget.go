@@ -0,0 +1,123 @@
+package orchestrator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+)
+
+// budgetLowFraction is the share of a budget left below which the budget
+// note tells the orchestrator to stop dispatching and conclude.
+const budgetLowFraction = 0.2
+
+// Compile-time check that BudgetTracker implements agent.OrchestratorBudgetSource.
+var _ agent.OrchestratorBudgetSource = (*BudgetTracker)(nil)
+
+// BudgetTracker tracks an orchestrator's budget: the time since it started
+// against max_budget (or the session deadline, if earlier), and the tokens
+// it and its sub-agents used against max_tokens. The budget is advisory —
+// the controller shows it to the LLM before every call so it can decide to
+// stop dispatching; nothing is cut off when it runs out.
+type BudgetTracker struct {
+	runner    *SubAgentRunner
+	start     time.Time
+	deadline  time.Time
+	maxTokens int
+}
+
+// NewBudgetTracker starts tracking the budget of the orchestrator whose
+// sub-agents runner manages.
+func NewBudgetTracker(runner *SubAgentRunner) *BudgetTracker {
+	start := time.Now()
+	deadline := start.Add(runner.guardrails.MaxBudget)
+	if d, ok := runner.parentCtx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	return &BudgetTracker{
+		runner:    runner,
+		start:     start,
+		deadline:  deadline,
+		maxTokens: runner.guardrails.MaxTokens,
+	}
+}
+
+// budgetUsage is what the orchestrator's sub-agents used so far.
+type budgetUsage struct {
+	dispatched int
+	running    int
+	finished   int
+	tokens     int
+}
+
+// usage sums the tokens of finished sub-agents and counts running ones.
+func (r *SubAgentRunner) usage() budgetUsage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var u budgetUsage
+	for _, exec := range r.executions {
+		u.dispatched++
+		if exec.status == agent.ExecutionStatusActive {
+			u.running++
+			continue
+		}
+		u.finished++
+		u.tokens += exec.tokensUsed.TotalTokens
+	}
+	return u
+}
+
+// BudgetNote implements agent.OrchestratorBudgetSource.
+func (b *BudgetTracker) BudgetNote(ownUsage agent.TokenUsage) agent.ConversationMessage {
+	return agent.ConversationMessage{
+		Role:    agent.RoleUser,
+		Content: b.formatNote(time.Now(), ownUsage.TotalTokens, b.runner.usage()),
+	}
+}
+
+// formatNote renders the budget note at now.
+func (b *BudgetTracker) formatNote(now time.Time, ownTokens int, u budgetUsage) string {
+	total := b.deadline.Sub(b.start)
+	elapsed := now.Sub(b.start)
+	remaining := max(0, b.deadline.Sub(now))
+
+	var sb strings.Builder
+	sb.WriteString("[Orchestrator budget]\n")
+	fmt.Fprintf(&sb, "Time: %s used, %s left (of %s)\n",
+		elapsed.Round(time.Second), remaining.Round(time.Second), total.Round(time.Second))
+
+	used := ownTokens + u.tokens
+	fmt.Fprintf(&sb, "Tokens: %d used (%d by you, %d by %d finished sub-agents)", used, ownTokens, u.tokens, u.finished)
+	if b.maxTokens > 0 {
+		fmt.Fprintf(&sb, ", %d left (of %d)", max(0, b.maxTokens-used), b.maxTokens)
+	}
+	sb.WriteString("\n")
+
+	fmt.Fprintf(&sb, "Sub-agents: %d dispatched, %d running", u.dispatched, u.running)
+	if u.running > 0 {
+		sb.WriteString(" (their tokens count once they finish)")
+	}
+
+	timeLeft := fraction(remaining, total)
+	tokensLeft := 1.0
+	if b.maxTokens > 0 {
+		tokensLeft = 1 - float64(used)/float64(b.maxTokens)
+	}
+	switch left := min(timeLeft, tokensLeft); {
+	case left <= 0:
+		sb.WriteString("\nBudget exhausted: do not dispatch more sub-agents. Cancel the ones you no longer need and conclude with the findings you have.")
+	case left < budgetLowFraction:
+		sb.WriteString("\nBudget is running low: dispatch new sub-agents only if essential, and conclude as soon as the running ones report.")
+	}
+	return sb.String()
+}
+
+// fraction returns part/whole, or 0 when whole is not positive.
+func fraction(part, whole time.Duration) float64 {
+	if whole <= 0 {
+		return 0
+	}
+	return float64(part) / float64(whole)
+}
@@ -0,0 +1,129 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+)
+
+func TestNewBudgetTracker_Deadline(t *testing.T) {
+	r := newMinimalRunner(2) // MaxBudget 10m
+	b := NewBudgetTracker(r)
+	assert.Equal(t, 10*time.Minute, b.deadline.Sub(b.start))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	r.parentCtx = ctx
+	b = NewBudgetTracker(r)
+	assert.WithinDuration(t, b.start.Add(time.Minute), b.deadline, time.Second,
+		"an earlier session deadline caps the budget")
+}
+
+func TestSubAgentRunner_Usage(t *testing.T) {
+	r := newMinimalRunner(3)
+	r.executions["e1"] = &subAgentExecution{status: agent.ExecutionStatusActive}
+	r.executions["e2"] = &subAgentExecution{
+		status:     agent.ExecutionStatusCompleted,
+		tokensUsed: agent.TokenUsage{TotalTokens: 1000},
+	}
+	r.executions["e3"] = &subAgentExecution{
+		status:     agent.ExecutionStatusFailed,
+		tokensUsed: agent.TokenUsage{TotalTokens: 250},
+	}
+
+	assert.Equal(t, budgetUsage{dispatched: 3, running: 1, finished: 2, tokens: 1250}, r.usage())
+}
+
+func TestBudgetTracker_FormatNote(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := &BudgetTracker{start: start, deadline: start.Add(15 * time.Minute)}
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		maxTokens int
+		ownTokens int
+		usage     budgetUsage
+		want      []string
+		notWant   []string
+	}{
+		{
+			name:      "plenty left",
+			now:       start.Add(4*time.Minute + 10*time.Second),
+			ownTokens: 4000,
+			usage:     budgetUsage{dispatched: 2, running: 1, finished: 1, tokens: 6000},
+			want: []string{
+				"[Orchestrator budget]\n",
+				"Time: 4m10s used, 10m50s left (of 15m0s)\n",
+				"Tokens: 10000 used (4000 by you, 6000 by 1 finished sub-agents)\n",
+				"Sub-agents: 2 dispatched, 1 running (their tokens count once they finish)",
+			},
+			notWant: []string{"left (of 0)", "running low", "exhausted"},
+		},
+		{
+			name:      "token budget",
+			now:       start.Add(time.Minute),
+			maxTokens: 50000,
+			ownTokens: 10000,
+			usage:     budgetUsage{dispatched: 1, finished: 1, tokens: 15000},
+			want: []string{
+				"Tokens: 25000 used (10000 by you, 15000 by 1 finished sub-agents), 25000 left (of 50000)\n",
+				"Sub-agents: 1 dispatched, 0 running",
+			},
+			notWant: []string{"their tokens", "running low", "exhausted"},
+		},
+		{
+			name: "time running low",
+			now:  start.Add(13 * time.Minute),
+			want: []string{"2m0s left", "Budget is running low"},
+		},
+		{
+			name:      "tokens running low",
+			now:       start.Add(time.Minute),
+			maxTokens: 10000,
+			ownTokens: 9000,
+			want:      []string{"1000 left (of 10000)", "Budget is running low"},
+		},
+		{
+			name: "time exhausted",
+			now:  start.Add(20 * time.Minute),
+			want: []string{"Time: 20m0s used, 0s left", "Budget exhausted"},
+		},
+		{
+			name:      "tokens exhausted",
+			now:       start.Add(time.Minute),
+			maxTokens: 10000,
+			ownTokens: 12000,
+			want:      []string{"0 left (of 10000)", "Budget exhausted"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b.maxTokens = tt.maxTokens
+			note := b.formatNote(tt.now, tt.ownTokens, tt.usage)
+			for _, w := range tt.want {
+				assert.Contains(t, note, w)
+			}
+			for _, nw := range tt.notWant {
+				assert.NotContains(t, note, nw)
+			}
+		})
+	}
+}
+
+func TestBudgetTracker_BudgetNote(t *testing.T) {
+	r := newMinimalRunner(2)
+	r.executions["e1"] = &subAgentExecution{
+		status:     agent.ExecutionStatusCompleted,
+		tokensUsed: agent.TokenUsage{TotalTokens: 700},
+	}
+	msg := NewBudgetTracker(r).BudgetNote(agent.TokenUsage{TotalTokens: 300})
+	require.Equal(t, agent.RoleUser, msg.Role)
+	assert.Contains(t, msg.Content, "Tokens: 1000 used (300 by you, 700 by 1 finished sub-agents)")
+}
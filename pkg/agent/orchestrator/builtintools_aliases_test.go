@@ -12,6 +12,8 @@ func TestOrchestrationToolAliasesMatchBuiltintools(t *testing.T) {
 	assert.Equal(t, builtintools.DispatchAgent, ToolDispatchAgent)
 	assert.Equal(t, builtintools.CancelAgent, ToolCancelAgent)
 	assert.Equal(t, builtintools.ListAgents, ToolListAgents)
+	assert.Equal(t, builtintools.PollAgent, ToolPollAgent)
+	assert.Equal(t, builtintools.ReportProgress, ToolReportProgress)
 }
 
 // orchestrationToolNames must match orchestrationTools (used by CompositeToolExecutor.Execute);
@@ -0,0 +1,185 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+)
+
+// Sub-agents stream intermediate findings to their orchestrator with the
+// report_progress tool; the orchestrator reads them with poll_agent before
+// the sub-agent finishes, so it can re-plan early. Reports are kept in the
+// runner's in-memory record of the sub-agent, which is the only channel
+// between the two: they are not persisted beyond the tool call's own
+// timeline event.
+const (
+	// maxProgressReports caps the reports kept per sub-agent; later ones are
+	// dropped (and counted) so a chatty sub-agent can't grow the
+	// orchestrator's context without bound.
+	maxProgressReports = 20
+
+	// maxProgressFindingLength caps a single report, in runes.
+	maxProgressFindingLength = 2000
+)
+
+// Compile-time check that progressToolExecutor implements agent.ToolExecutor.
+var _ agent.ToolExecutor = (*progressToolExecutor)(nil)
+
+// reportProgressTool is the tool definition exposed to sub-agents.
+var reportProgressTool = agent.ToolDefinition{
+	Name:        ToolReportProgress,
+	Description: "Report a significant intermediate finding to the orchestrator that dispatched you, before you finish — e.g. a confirmed root cause or a ruled-out hypothesis that should change its plan. The orchestrator may read it while you keep working. Your final response is still required and is delivered separately.",
+	ParametersSchema: `{
+		"type": "object",
+		"properties": {
+			"finding": {"type": "string", "description": "The finding in one or two sentences, with its key evidence"}
+		},
+		"required": ["finding"]
+	}`,
+}
+
+// progressToolExecutor wraps a sub-agent's tool executor and handles
+// report_progress calls by recording them on the sub-agent's execution.
+// Everything else passes through.
+type progressToolExecutor struct {
+	inner  agent.ToolExecutor
+	runner *SubAgentRunner
+	exec   *subAgentExecution
+}
+
+func newProgressToolExecutor(inner agent.ToolExecutor, runner *SubAgentRunner, exec *subAgentExecution) *progressToolExecutor {
+	return &progressToolExecutor{inner: inner, runner: runner, exec: exec}
+}
+
+// ListTools returns report_progress followed by the inner tools.
+func (p *progressToolExecutor) ListTools(ctx context.Context) ([]agent.ToolDefinition, error) {
+	innerTools, err := p.inner.ListTools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inner tools: %w", err)
+	}
+	tools := make([]agent.ToolDefinition, 0, len(innerTools)+1)
+	tools = append(tools, reportProgressTool)
+	for _, t := range innerTools {
+		if t.Name != ToolReportProgress {
+			tools = append(tools, t)
+		}
+	}
+	return tools, nil
+}
+
+// Execute records report_progress calls and delegates the rest.
+func (p *progressToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+	if mcp.NormalizeBuiltinPlainToolName(call.Name) != ToolReportProgress {
+		return p.inner.Execute(ctx, call)
+	}
+
+	var args struct {
+		Finding string `json:"finding"`
+	}
+	if err := json.Unmarshal([]byte(call.Arguments), &args); err != nil {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: fmt.Sprintf("invalid arguments: %v", err),
+			IsError: true,
+		}, nil
+	}
+	finding := strings.TrimSpace(args.Finding)
+	if finding == "" {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: "'finding' is required and must be non-empty",
+			IsError: true,
+		}, nil
+	}
+	if len([]rune(finding)) > maxProgressFindingLength {
+		finding = string([]rune(finding)[:maxProgressFindingLength]) + "…"
+	}
+
+	if !p.runner.recordProgress(p.exec, finding) {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: fmt.Sprintf("progress report limit (%d) reached — put further findings in your final response", maxProgressReports),
+			IsError: true,
+		}, nil
+	}
+	return &agent.ToolResult{
+		CallID:  call.ID,
+		Name:    call.Name,
+		Content: "Progress reported to the orchestrator. Continue your task.",
+	}, nil
+}
+
+// Close delegates to the inner executor.
+func (p *progressToolExecutor) Close() error {
+	return p.inner.Close()
+}
+
+// recordProgress appends a progress report to exec. Returns false (and
+// counts the report as dropped) once maxProgressReports is reached.
+func (r *SubAgentRunner) recordProgress(exec *subAgentExecution, finding string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(exec.progress) >= maxProgressReports {
+		exec.dropped++
+		return false
+	}
+	exec.progress = append(exec.progress, SubAgentProgress{
+		Seq:        len(exec.progress) + 1,
+		Finding:    finding,
+		ReportedAt: time.Now(),
+	})
+	return true
+}
+
+// Poll returns a sub-agent's status and the progress reports it made since
+// the previous Poll for it.
+func (r *SubAgentRunner) Poll(executionID string) (*SubAgentPoll, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	exec, ok := r.executions[executionID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrExecutionNotFound, executionID)
+	}
+	poll := &SubAgentPoll{
+		SubAgentStatus: SubAgentStatus{
+			ExecutionID: exec.executionID,
+			AgentName:   exec.agentName,
+			Task:        exec.task,
+			Status:      exec.status,
+		},
+		Progress: append([]SubAgentProgress(nil), exec.progress[exec.progressRead:]...),
+		Dropped:  exec.dropped,
+	}
+	exec.progressRead = len(exec.progress)
+	return poll, nil
+}
+
+// FormatSubAgentPoll renders a poll result as poll_agent tool output.
+func FormatSubAgentPoll(poll *SubAgentPoll) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (exec %s): status=%s", poll.AgentName, poll.ExecutionID, poll.Status)
+	if len(poll.Progress) == 0 {
+		b.WriteString("\nNo new progress reports since your last poll.")
+	} else {
+		b.WriteString("\nNew progress reports:")
+		for _, p := range poll.Progress {
+			fmt.Fprintf(&b, "\n%d. %s", p.Seq, p.Finding)
+		}
+	}
+	if poll.Dropped > 0 {
+		fmt.Fprintf(&b, "\n(%d further reports were dropped after the limit of %d.)", poll.Dropped, maxProgressReports)
+	}
+	if poll.Status == agent.ExecutionStatusActive {
+		b.WriteString("\nThe agent is still running; its final result will be delivered automatically. Treat these findings as preliminary.")
+	}
+	return b.String()
+}
@@ -0,0 +1,115 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+)
+
+func newProgressTestExecution(r *SubAgentRunner) *subAgentExecution {
+	exec := &subAgentExecution{
+		executionID: "e1", agentName: "LogAnalyzer", task: "check logs",
+		status: agent.ExecutionStatusActive,
+	}
+	r.mu.Lock()
+	r.executions[exec.executionID] = exec
+	r.mu.Unlock()
+	return exec
+}
+
+func TestProgressToolExecutor_ListTools(t *testing.T) {
+	r := newMinimalRunner(1)
+	inner := agent.NewStubToolExecutor([]agent.ToolDefinition{{Name: "server.read_file"}})
+	p := newProgressToolExecutor(inner, r, newProgressTestExecution(r))
+
+	tools, err := p.ListTools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tools, 2)
+	assert.Equal(t, ToolReportProgress, tools[0].Name)
+	assert.Equal(t, "server.read_file", tools[1].Name)
+}
+
+func TestProgressToolExecutor_Execute(t *testing.T) {
+	r := newMinimalRunner(1)
+	exec := newProgressTestExecution(r)
+	inner := agent.NewStubToolExecutor([]agent.ToolDefinition{{Name: "server.read_file"}})
+	p := newProgressToolExecutor(inner, r, exec)
+
+	t.Run("records the finding", func(t *testing.T) {
+		result, err := p.Execute(context.Background(), agent.ToolCall{
+			ID: "c1", Name: "google:" + ToolReportProgress, Arguments: `{"finding": "  disk full on node-3  "}`,
+		})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		poll, err := r.Poll("e1")
+		require.NoError(t, err)
+		require.Len(t, poll.Progress, 1)
+		assert.Equal(t, 1, poll.Progress[0].Seq)
+		assert.Equal(t, "disk full on node-3", poll.Progress[0].Finding)
+	})
+
+	t.Run("blank finding is an error", func(t *testing.T) {
+		result, err := p.Execute(context.Background(), agent.ToolCall{
+			ID: "c2", Name: ToolReportProgress, Arguments: `{"finding": " "}`,
+		})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("long findings are truncated", func(t *testing.T) {
+		result, err := p.Execute(context.Background(), agent.ToolCall{
+			ID: "c3", Name: ToolReportProgress,
+			Arguments: fmt.Sprintf(`{"finding": %q}`, strings.Repeat("x", maxProgressFindingLength+10)),
+		})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+
+		poll, err := r.Poll("e1")
+		require.NoError(t, err)
+		require.Len(t, poll.Progress, 1)
+		assert.Len(t, []rune(poll.Progress[0].Finding), maxProgressFindingLength+1)
+	})
+
+	t.Run("other tools pass through", func(t *testing.T) {
+		result, err := p.Execute(context.Background(), agent.ToolCall{
+			ID: "c4", Name: "server.read_file", Arguments: "{}",
+		})
+		require.NoError(t, err)
+		assert.Contains(t, result.Content, "server.read_file")
+	})
+}
+
+func TestSubAgentRunner_Poll(t *testing.T) {
+	r := newMinimalRunner(1)
+	exec := newProgressTestExecution(r)
+
+	_, err := r.Poll("missing")
+	assert.ErrorIs(t, err, ErrExecutionNotFound)
+
+	poll, err := r.Poll("e1")
+	require.NoError(t, err)
+	assert.Empty(t, poll.Progress)
+	assert.Equal(t, agent.ExecutionStatusActive, poll.Status)
+
+	for i := range maxProgressReports {
+		require.True(t, r.recordProgress(exec, fmt.Sprintf("finding %d", i+1)))
+	}
+	assert.False(t, r.recordProgress(exec, "one too many"))
+
+	poll, err = r.Poll("e1")
+	require.NoError(t, err)
+	assert.Len(t, poll.Progress, maxProgressReports)
+	assert.Equal(t, 1, poll.Dropped)
+	assert.Contains(t, FormatSubAgentPoll(poll), "1 further reports were dropped")
+
+	poll, err = r.Poll("e1")
+	require.NoError(t, err)
+	assert.Empty(t, poll.Progress, "reports are returned once")
+}
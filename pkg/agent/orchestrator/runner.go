@@ -219,6 +219,7 @@ func (r *SubAgentRunner) Dispatch(ctx context.Context, name, task string) (strin
 		status:      agent.ExecutionStatusActive,
 		cancel:      cancel,
 		done:        make(chan struct{}),
+		startedAt:   time.Now(),
 	}
 
 	// Register the execution and release the reservation in a single lock hold
@@ -232,6 +233,7 @@ func (r *SubAgentRunner) Dispatch(ctx context.Context, name, task string) (strin
 
 	atomic.AddInt32(&r.pending, 1)
 
+	r.publishSubAgentDispatched(ctx, subExec)
 	go r.runSubAgent(subCtx, cancel, subExec, resolvedConfig, agentIndex)
 
 	return executionID, nil
@@ -254,7 +256,7 @@ func (r *SubAgentRunner) runSubAgent(
 		"sub_agent", exec.agentName,
 	)
 
-	toolExecutor := r.createSubAgentToolExecutor(ctx, resolvedConfig, logger)
+	toolExecutor := r.createSubAgentToolExecutor(ctx, exec, resolvedConfig, logger)
 	defer func() { _ = toolExecutor.Close() }()
 
 	// MemoryBriefing is intentionally omitted: Tier 4 memories are matched
@@ -292,7 +294,7 @@ func (r *SubAgentRunner) runSubAgent(
 	agentInstance, err := r.deps.AgentFactory.CreateAgent(execCtx)
 	if err != nil {
 		logger.Error("Failed to create sub-agent", "error", err)
-		r.completeSubAgent(exec, agent.ExecutionStatusFailed, "", err.Error())
+		r.completeSubAgent(exec, agent.ExecutionStatusFailed, "", err.Error(), agent.TokenUsage{})
 		return
 	}
 
@@ -300,7 +302,7 @@ func (r *SubAgentRunner) runSubAgent(
 	if err != nil {
 		status := agent.StatusFromErr(ctx.Err())
 		logger.Error("Sub-agent execution error", "error", err, "resolved_status", status)
-		r.completeSubAgent(exec, status, "", err.Error())
+		r.completeSubAgent(exec, status, "", err.Error(), agent.TokenUsage{})
 		return
 	}
 
@@ -309,7 +311,7 @@ func (r *SubAgentRunner) runSubAgent(
 	if result.Error != nil {
 		errMsg = result.Error.Error()
 	}
-	r.completeSubAgent(exec, result.Status, result.FinalAnalysis, errMsg)
+	r.completeSubAgent(exec, result.Status, result.FinalAnalysis, errMsg, result.TokensUsed)
 }
 
 // completeSubAgent updates the execution record and delivers the result.
@@ -318,9 +320,13 @@ func (r *SubAgentRunner) completeSubAgent(
 	status agent.ExecutionStatus,
 	finalAnalysis string,
 	errMsg string,
+	tokensUsed agent.TokenUsage,
 ) {
 	r.mu.Lock()
 	exec.status = status
+	exec.duration = time.Since(exec.startedAt)
+	exec.tokensUsed = tokensUsed
+	duration := exec.duration
 	r.mu.Unlock()
 
 	// Use a detached context with a short deadline: the parent context may
@@ -338,6 +344,7 @@ func (r *SubAgentRunner) completeSubAgent(
 			"execution_id", exec.executionID, "status", status, "error", updateErr)
 	}
 	r.publishSubAgentStatus(cleanupCtx, exec.executionID, exec.agentIndex, string(entStatus), errMsg)
+	r.publishSubAgentTerminal(cleanupCtx, exec, string(entStatus), errMsg, duration, tokensUsed)
 
 	result := &SubAgentResult{
 		ExecutionID: exec.executionID,
@@ -346,6 +353,8 @@ func (r *SubAgentRunner) completeSubAgent(
 		Status:      status,
 		Result:      finalAnalysis,
 		Error:       errMsg,
+		Duration:    duration,
+		TokensUsed:  tokensUsed,
 	}
 
 	// Non-blocking on shutdown: if closeCh is closed (CancelAll during cleanup),
@@ -382,10 +391,70 @@ func (r *SubAgentRunner) publishSubAgentStatus(ctx context.Context, executionID
 	}
 }
 
+// publishSubAgentDispatched publishes a subagent.dispatched WS event.
+// Best-effort: logs on failure, never aborts.
+func (r *SubAgentRunner) publishSubAgentDispatched(ctx context.Context, exec *subAgentExecution) {
+	if r.deps.EventPublisher == nil {
+		return
+	}
+	if err := r.deps.EventPublisher.PublishSubAgentDispatched(ctx, r.sessionID, events.SubAgentDispatchedPayload{
+		BasePayload: events.BasePayload{
+			Type:      events.EventTypeSubAgentDispatched,
+			SessionID: r.sessionID,
+			Timestamp: time.Now().Format(time.RFC3339Nano),
+		},
+		StageID:           r.stageID,
+		ExecutionID:       exec.executionID,
+		ParentExecutionID: r.parentExecID,
+		AgentName:         exec.agentName,
+		AgentIndex:        exec.agentIndex,
+		Task:              exec.task,
+	}); err != nil {
+		slog.Warn("Failed to publish sub-agent dispatched event",
+			"session_id", r.sessionID, "execution_id", exec.executionID, "error", err)
+	}
+}
+
+// publishSubAgentTerminal publishes a subagent.status WS event for a
+// sub-agent that reached a terminal status. Best-effort: logs on failure,
+// never aborts.
+func (r *SubAgentRunner) publishSubAgentTerminal(
+	ctx context.Context,
+	exec *subAgentExecution,
+	status, errMsg string,
+	duration time.Duration,
+	tokensUsed agent.TokenUsage,
+) {
+	if r.deps.EventPublisher == nil {
+		return
+	}
+	if err := r.deps.EventPublisher.PublishSubAgentStatus(ctx, r.sessionID, events.SubAgentStatusPayload{
+		BasePayload: events.BasePayload{
+			Type:      events.EventTypeSubAgentStatus,
+			SessionID: r.sessionID,
+			Timestamp: time.Now().Format(time.RFC3339Nano),
+		},
+		StageID:           r.stageID,
+		ExecutionID:       exec.executionID,
+		ParentExecutionID: r.parentExecID,
+		AgentName:         exec.agentName,
+		AgentIndex:        exec.agentIndex,
+		Status:            status,
+		ErrorMessage:      errMsg,
+		DurationMs:        duration.Milliseconds(),
+		TotalTokens:       tokensUsed.TotalTokens,
+	}); err != nil {
+		slog.Warn("Failed to publish sub-agent status event",
+			"session_id", r.sessionID, "execution_id", exec.executionID, "status", status, "error", err)
+	}
+}
+
 // createSubAgentToolExecutor builds a ToolExecutor for the sub-agent's MCP servers,
-// optionally wrapped with a SkillToolExecutor for on-demand skills.
+// optionally wrapped with a SkillToolExecutor for on-demand skills, and with
+// the report_progress tool.
 func (r *SubAgentRunner) createSubAgentToolExecutor(
 	ctx context.Context,
+	exec *subAgentExecution,
 	resolvedConfig *agent.ResolvedAgentConfig,
 	logger *slog.Logger,
 ) agent.ToolExecutor {
@@ -415,10 +484,16 @@ func (r *SubAgentRunner) createSubAgentToolExecutor(
 	nativeOnly := len(resolvedConfig.MCPServers) == 0 &&
 		resolvedConfig.LLMProvider != nil &&
 		len(resolvedConfig.LLMProvider.NativeTools) > 0
-	if r.deps.WrapToolExecutor != nil && !nativeOnly {
+	if r.deps.WrapToolExecutor != nil && !nativeOnly && !resolvedConfig.ToolsDisabled {
 		executor = r.deps.WrapToolExecutor(executor)
 	}
 
+	// Same for report_progress: those agents report through their final
+	// response only.
+	if !nativeOnly && !resolvedConfig.ToolsDisabled {
+		executor = newProgressToolExecutor(executor, r, exec)
+	}
+
 	return executor
 }
 
@@ -560,6 +560,74 @@ func TestSubAgentRunner_PublishSubAgentStatus_NilPublisher(t *testing.T) {
 	r.publishSubAgentStatus(context.Background(), "exec-1", 1, "active", "")
 }
 
+func TestSubAgentRunner_PublishSubAgentEvents(t *testing.T) {
+	r := newMinimalRunner(1)
+	publisher := &recordingEventPublisher{}
+	r.deps.EventPublisher = publisher
+	exec := &subAgentExecution{executionID: "exec-1", agentName: "LogAnalyzer", task: "check logs", agentIndex: 2}
+
+	r.publishSubAgentDispatched(context.Background(), exec)
+	r.publishSubAgentTerminal(context.Background(), exec, "failed", "boom",
+		1500*time.Millisecond, agent.TokenUsage{TotalTokens: 1200})
+
+	require.Len(t, publisher.dispatchedEvs, 1)
+	dispatched := publisher.dispatchedEvs[0]
+	assert.Equal(t, events.EventTypeSubAgentDispatched, dispatched.Type)
+	assert.Equal(t, r.sessionID, dispatched.SessionID)
+	assert.Equal(t, r.stageID, dispatched.StageID)
+	assert.Equal(t, "exec-1", dispatched.ExecutionID)
+	assert.Equal(t, r.parentExecID, dispatched.ParentExecutionID)
+	assert.Equal(t, "LogAnalyzer", dispatched.AgentName)
+	assert.Equal(t, 2, dispatched.AgentIndex)
+	assert.Equal(t, "check logs", dispatched.Task)
+
+	require.Len(t, publisher.subStatusEvs, 1)
+	status := publisher.subStatusEvs[0]
+	assert.Equal(t, events.EventTypeSubAgentStatus, status.Type)
+	assert.Equal(t, "exec-1", status.ExecutionID)
+	assert.Equal(t, r.parentExecID, status.ParentExecutionID)
+	assert.Equal(t, 2, status.AgentIndex)
+	assert.Equal(t, "failed", status.Status)
+	assert.Equal(t, "boom", status.ErrorMessage)
+	assert.Equal(t, int64(1500), status.DurationMs)
+	assert.Equal(t, 1200, status.TotalTokens)
+
+	// Nil publisher — must not panic
+	r.deps.EventPublisher = nil
+	r.publishSubAgentDispatched(context.Background(), exec)
+	r.publishSubAgentTerminal(context.Background(), exec, "completed", "", 0, agent.TokenUsage{})
+}
+
+func TestSubAgentRunner_Dispatch_PublishesSubAgentEvents(t *testing.T) {
+	ctx := context.Background()
+	publisher := &recordingEventPublisher{}
+	runner, cleanup := setupIntegrationRunner(t, func(_ context.Context) (*agent.ExecutionResult, error) {
+		return &agent.ExecutionResult{
+			Status:        agent.ExecutionStatusCompleted,
+			FinalAnalysis: "done",
+			TokensUsed:    agent.TokenUsage{TotalTokens: 300},
+		}, nil
+	})
+	defer cleanup()
+	runner.deps.EventPublisher = publisher
+
+	execID, err := runner.Dispatch(ctx, "TestAgent", "tree event task")
+	require.NoError(t, err)
+
+	_, err = runner.WaitForNext(ctx)
+	require.NoError(t, err)
+
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+	require.Len(t, publisher.dispatchedEvs, 1)
+	assert.Equal(t, execID, publisher.dispatchedEvs[0].ExecutionID)
+	assert.Equal(t, "tree event task", publisher.dispatchedEvs[0].Task)
+	require.Len(t, publisher.subStatusEvs, 1)
+	assert.Equal(t, execID, publisher.subStatusEvs[0].ExecutionID)
+	assert.Equal(t, string(agentexecution.StatusCompleted), publisher.subStatusEvs[0].Status)
+	assert.Equal(t, 300, publisher.subStatusEvs[0].TotalTokens)
+}
+
 // ─── Task-assigned timeline WS event (integration) ──────────────────────────
 
 func TestSubAgentRunner_Dispatch_PublishesTaskAssignedTimelineEvent(t *testing.T) {
@@ -642,7 +710,7 @@ func TestCreateSubAgentToolExecutor_SkipsMemoryForNativeOnly(t *testing.T) {
 				},
 			},
 		}
-		r.createSubAgentToolExecutor(t.Context(), cfg, slog.Default())
+		r.createSubAgentToolExecutor(t.Context(), &subAgentExecution{}, cfg, slog.Default())
 		assert.False(t, wrapped, "memory wrapping should be skipped for native-only agents")
 	})
 
@@ -652,7 +720,7 @@ func TestCreateSubAgentToolExecutor_SkipsMemoryForNativeOnly(t *testing.T) {
 			AgentName:   "GeneralWorker",
 			LLMProvider: &config.LLMProviderConfig{},
 		}
-		r.createSubAgentToolExecutor(t.Context(), cfg, slog.Default())
+		r.createSubAgentToolExecutor(t.Context(), &subAgentExecution{}, cfg, slog.Default())
 		assert.True(t, wrapped, "memory wrapping should apply for non-native agents")
 	})
 
@@ -667,11 +735,42 @@ func TestCreateSubAgentToolExecutor_SkipsMemoryForNativeOnly(t *testing.T) {
 				},
 			},
 		}
-		r.createSubAgentToolExecutor(t.Context(), cfg, slog.Default())
+		r.createSubAgentToolExecutor(t.Context(), &subAgentExecution{}, cfg, slog.Default())
 		assert.True(t, wrapped, "memory wrapping should apply when MCP servers are present")
 	})
 }
 
+func TestCreateSubAgentToolExecutor_ReportProgress(t *testing.T) {
+	r := newMinimalRunner(1)
+	hasReportProgress := func(t *testing.T, cfg *agent.ResolvedAgentConfig) bool {
+		executor := r.createSubAgentToolExecutor(t.Context(), &subAgentExecution{}, cfg, slog.Default())
+		tools, err := executor.ListTools(t.Context())
+		require.NoError(t, err)
+		for _, tool := range tools {
+			if tool.Name == ToolReportProgress {
+				return true
+			}
+		}
+		return false
+	}
+
+	assert.True(t, hasReportProgress(t, &agent.ResolvedAgentConfig{
+		AgentName:   "GeneralWorker",
+		LLMProvider: &config.LLMProviderConfig{},
+	}))
+	assert.False(t, hasReportProgress(t, &agent.ResolvedAgentConfig{
+		AgentName: "WebResearcher",
+		LLMProvider: &config.LLMProviderConfig{
+			NativeTools: map[config.GoogleNativeTool]bool{config.GoogleNativeToolGoogleSearch: true},
+		},
+	}), "native-only agents get no function tools")
+	assert.False(t, hasReportProgress(t, &agent.ResolvedAgentConfig{
+		AgentName:     "Reasoner",
+		LLMProvider:   &config.LLMProviderConfig{},
+		ToolsDisabled: true,
+	}))
+}
+
 // ─── FormatSubAgentResult ───────────────────────────────────────────────────
 
 func TestFormatSubAgentResult_Completed(t *testing.T) {
@@ -697,6 +796,19 @@ func TestFormatSubAgentResult_Failed(t *testing.T) {
 	assert.Equal(t, agent.RoleUser, msg.Role)
 	assert.Contains(t, msg.Content, "[Sub-agent failed]")
 	assert.Contains(t, msg.Content, "connection refused")
+	assert.NotContains(t, msg.Content, "Budget used")
+}
+
+func TestFormatSubAgentResult_BudgetUsed(t *testing.T) {
+	msg := FormatSubAgentResult(&SubAgentResult{
+		ExecutionID: "exec-3",
+		AgentName:   "LogAnalyzer",
+		Status:      agent.ExecutionStatusCompleted,
+		Result:      "Found 42 errors",
+		Duration:    2*time.Minute + 13400*time.Millisecond,
+		TokensUsed:  agent.TokenUsage{TotalTokens: 45120},
+	})
+	assert.Contains(t, msg.Content, "Found 42 errors\n(Budget used: 2m13s, 45120 tokens.)")
 }
 
 // ─── Test helpers ───────────────────────────────────────────────────────────
@@ -755,6 +867,7 @@ func TestSubAgentRunner_Dispatch_NeverSetsOrchestratorFields(t *testing.T) {
 	assert.NotNil(t, execCtx.SubAgent, "sub-agent context must be set")
 	assert.Nil(t, execCtx.SubAgentCatalog, "sub-agents must not receive SubAgentCatalog")
 	assert.Nil(t, execCtx.SubAgentCollector, "sub-agents must not receive SubAgentCollector")
+	assert.Nil(t, execCtx.OrchestratorBudget, "sub-agents must not receive OrchestratorBudget")
 }
 
 // mockControllerFactory returns a factory that produces controllers
@@ -834,14 +947,41 @@ func (noopEventPublisher) PublishReviewStatus(_ context.Context, _ string, _ eve
 func (noopEventPublisher) PublishSessionScoreUpdated(_ context.Context, _ string, _ events.SessionScoreUpdatedPayload) error {
 	return nil
 }
+func (noopEventPublisher) PublishMCPServerLog(_ context.Context, _ string, _ events.MCPServerLogPayload) error {
+	return nil
+}
+
+func (noopEventPublisher) PublishSubAgentDispatched(_ context.Context, _ string, _ events.SubAgentDispatchedPayload) error {
+	return nil
+}
 
-// recordingEventPublisher embeds noopEventPublisher and records execution.status
-// and timeline_event.created payloads for assertion.
+func (noopEventPublisher) PublishSubAgentStatus(_ context.Context, _ string, _ events.SubAgentStatusPayload) error {
+	return nil
+}
+
+// recordingEventPublisher embeds noopEventPublisher and records execution.status,
+// timeline_event.created and subagent.* payloads for assertion.
 type recordingEventPublisher struct {
 	noopEventPublisher
-	mu          sync.Mutex
-	statEvs     []events.ExecutionStatusPayload
-	timelineEvs []events.TimelineCreatedPayload
+	mu            sync.Mutex
+	statEvs       []events.ExecutionStatusPayload
+	timelineEvs   []events.TimelineCreatedPayload
+	dispatchedEvs []events.SubAgentDispatchedPayload
+	subStatusEvs  []events.SubAgentStatusPayload
+}
+
+func (r *recordingEventPublisher) PublishSubAgentDispatched(_ context.Context, _ string, p events.SubAgentDispatchedPayload) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dispatchedEvs = append(r.dispatchedEvs, p)
+	return nil
+}
+
+func (r *recordingEventPublisher) PublishSubAgentStatus(_ context.Context, _ string, p events.SubAgentStatusPayload) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subStatusEvs = append(r.subStatusEvs, p)
+	return nil
 }
 
 func (r *recordingEventPublisher) PublishExecutionStatus(_ context.Context, _ string, p events.ExecutionStatusPayload) error {
@@ -21,7 +21,7 @@ var _ agent.ToolExecutor = (*CompositeToolExecutor)(nil)
 var closeTimeout = 30 * time.Second
 
 // CompositeToolExecutor wraps an MCP tool executor and adds orchestration tools
-// (dispatch_agent, cancel_agent, list_agents). It routes calls by name: known
+// (dispatch_agent, cancel_agent, list_agents, poll_agent). It routes calls by name: known
 // orchestration tool names go to the SubAgentRunner; everything else goes to
 // the inner MCP executor.
 type CompositeToolExecutor struct {
@@ -107,6 +107,8 @@ func (c *CompositeToolExecutor) executeOrchestrationTool(ctx context.Context, ca
 		return c.handleCancel(ctx, call)
 	case ToolListAgents:
 		return c.handleList(call)
+	case ToolPollAgent:
+		return c.handlePoll(call)
 	default:
 		return &agent.ToolResult{
 			CallID:  call.ID,
@@ -234,3 +236,41 @@ func (c *CompositeToolExecutor) handleList(call agent.ToolCall) (*agent.ToolResu
 		Content: b.String(),
 	}, nil
 }
+
+func (c *CompositeToolExecutor) handlePoll(call agent.ToolCall) (*agent.ToolResult, error) {
+	var args struct {
+		ExecutionID string `json:"execution_id"`
+	}
+	if err := json.Unmarshal([]byte(call.Arguments), &args); err != nil {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: fmt.Sprintf("invalid arguments: %v", err),
+			IsError: true,
+		}, nil
+	}
+	if args.ExecutionID == "" {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: "'execution_id' is required",
+			IsError: true,
+		}, nil
+	}
+
+	poll, err := c.runner.Poll(args.ExecutionID)
+	if err != nil {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: fmt.Sprintf("poll failed: %v", err),
+			IsError: true,
+		}, nil
+	}
+
+	return &agent.ToolResult{
+		CallID:  call.ID,
+		Name:    call.Name,
+		Content: FormatSubAgentPoll(poll),
+	}, nil
+}
@@ -30,8 +30,9 @@ func TestCompositeToolExecutor_ListTools_CombinesMCPAndOrchestration(t *testing.
 	assert.Equal(t, ToolDispatchAgent, tools[0].Name)
 	assert.Equal(t, ToolCancelAgent, tools[1].Name)
 	assert.Equal(t, ToolListAgents, tools[2].Name)
-	assert.Equal(t, "server1.read_file", tools[3].Name)
-	assert.Equal(t, "server1.write_file", tools[4].Name)
+	assert.Equal(t, ToolPollAgent, tools[3].Name)
+	assert.Equal(t, "server1.read_file", tools[4].Name)
+	assert.Equal(t, "server1.write_file", tools[5].Name)
 }
 
 func TestCompositeToolExecutor_ListTools_NilMCPExecutor(t *testing.T) {
@@ -208,6 +209,56 @@ func TestCompositeToolExecutor_Execute_ListAgents_WithEntries(t *testing.T) {
 	assert.Contains(t, result.Content, "active")
 }
 
+func TestCompositeToolExecutor_Execute_PollAgent(t *testing.T) {
+	runner := newMinimalRunner(5)
+	exec := &subAgentExecution{
+		executionID: "e1", agentName: "AgentA", task: "task A",
+		status: agent.ExecutionStatusActive,
+	}
+	runner.mu.Lock()
+	runner.executions["e1"] = exec
+	runner.mu.Unlock()
+	require.True(t, runner.recordProgress(exec, "pod OOMKilled at 10:02"))
+
+	registry := config.BuildSubAgentRegistry(nil)
+	c := NewCompositeToolExecutor(nil, runner, registry)
+
+	result, err := c.Execute(context.Background(), agent.ToolCall{
+		ID: "call-poll", Name: ToolPollAgent, Arguments: `{"execution_id": "e1"}`,
+	})
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Contains(t, result.Content, "status=active")
+	assert.Contains(t, result.Content, "1. pod OOMKilled at 10:02")
+	assert.Contains(t, result.Content, "still running")
+
+	result, err = c.Execute(context.Background(), agent.ToolCall{
+		ID: "call-poll-2", Name: ToolPollAgent, Arguments: `{"execution_id": "e1"}`,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, result.Content, "No new progress reports")
+}
+
+func TestCompositeToolExecutor_Execute_PollAgent_ValidationError(t *testing.T) {
+	runner := newMinimalRunner(5)
+	registry := config.BuildSubAgentRegistry(nil)
+	c := NewCompositeToolExecutor(nil, runner, registry)
+
+	result, err := c.Execute(context.Background(), agent.ToolCall{
+		ID: "call-poll-3", Name: ToolPollAgent, Arguments: "{}",
+	})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content, "'execution_id' is required")
+
+	result, err = c.Execute(context.Background(), agent.ToolCall{
+		ID: "call-poll-4", Name: ToolPollAgent, Arguments: `{"execution_id": "missing"}`,
+	})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, result.Content, "execution not found")
+}
+
 func TestCompositeToolExecutor_Execute_MCPTool(t *testing.T) {
 	mcpStub := agent.NewStubToolExecutor([]agent.ToolDefinition{
 		{Name: "server.read_file"},
@@ -55,6 +55,7 @@ type OrchestratorGuardrails struct {
 	MaxConcurrentAgents int
 	AgentTimeout        time.Duration
 	MaxBudget           time.Duration
+	MaxTokens           int // 0 = no token budget
 }
 
 // SubAgentResult is the outcome of a completed sub-agent execution.
@@ -66,6 +67,10 @@ type SubAgentResult struct {
 	Status      agent.ExecutionStatus
 	Result      string // FinalAnalysis text on success
 	Error       string // Error message on failure
+
+	// Budget the sub-agent consumed, reported with its result.
+	Duration   time.Duration
+	TokensUsed agent.TokenUsage
 }
 
 // SubAgentStatus is a snapshot of a dispatched sub-agent's state.
@@ -77,6 +82,24 @@ type SubAgentStatus struct {
 	Status      agent.ExecutionStatus
 }
 
+// SubAgentProgress is an intermediate finding a sub-agent reported with the
+// report_progress tool before finishing.
+type SubAgentProgress struct {
+	Seq        int // 1-based, per sub-agent
+	Finding    string
+	ReportedAt time.Time
+}
+
+// SubAgentPoll is the result of SubAgentRunner.Poll: the sub-agent's state
+// and the progress reports not returned by an earlier poll.
+type SubAgentPoll struct {
+	SubAgentStatus
+	Progress []SubAgentProgress
+	// Dropped counts reports discarded because the sub-agent exceeded
+	// maxProgressReports.
+	Dropped int
+}
+
 // subAgentExecution tracks the state of a single dispatched sub-agent.
 type subAgentExecution struct {
 	executionID string
@@ -86,6 +109,18 @@ type subAgentExecution struct {
 	status      agent.ExecutionStatus
 	cancel      func()
 	done        chan struct{}
+	startedAt   time.Time
+
+	// Set on completion: what the sub-agent consumed of the orchestrator's
+	// budget. Protected by SubAgentRunner.mu.
+	duration   time.Duration
+	tokensUsed agent.TokenUsage
+
+	// Progress reports (report_progress) and how many Poll has returned.
+	// Protected by SubAgentRunner.mu.
+	progress     []SubAgentProgress
+	progressRead int
+	dropped      int
 }
 
 // OrchestrationServerName is the synthetic server_name recorded in MCP
@@ -98,18 +133,23 @@ const (
 	ToolDispatchAgent = builtintools.DispatchAgent
 	ToolCancelAgent   = builtintools.CancelAgent
 	ToolListAgents    = builtintools.ListAgents
+	ToolPollAgent     = builtintools.PollAgent
+
+	// ToolReportProgress is offered to sub-agents, not to the orchestrator.
+	ToolReportProgress = builtintools.ReportProgress
 )
 
-// IsOrchestrationTool reports whether name is a known orchestration tool.
+// IsOrchestrationTool reports whether name is a known orchestration tool,
+// including the sub-agent side's report_progress.
 func IsOrchestrationTool(name string) bool {
-	return orchestrationToolNames[name]
+	return orchestrationToolNames[name] || name == ToolReportProgress
 }
 
 // orchestrationTools defines the tool set exposed to the orchestrator LLM.
 var orchestrationTools = []agent.ToolDefinition{
 	{
 		Name:        ToolDispatchAgent,
-		Description: "Dispatch a sub-agent to execute a task. Returns immediately. Results are automatically delivered when the sub-agent finishes — do not poll.",
+		Description: fmt.Sprintf("Dispatch a sub-agent to execute a task. Returns immediately. Results are automatically delivered when the sub-agent finishes — do not poll for them. Use %s only to read intermediate findings of a long-running agent when they would change your plan.", builtintools.PollAgent),
 		ParametersSchema: `{
 			"type": "object",
 			"properties": {
@@ -138,6 +178,17 @@ var orchestrationTools = []agent.ToolDefinition{
 			"properties": {}
 		}`,
 	},
+	{
+		Name:        ToolPollAgent,
+		Description: "Read the intermediate findings a running sub-agent has reported since your last poll, plus its status. Use it to re-plan early (dispatch follow-ups, cancel redundant agents) while an agent is still working. Its final result is still delivered automatically.",
+		ParametersSchema: fmt.Sprintf(`{
+			"type": "object",
+			"properties": {
+				"execution_id": {"type": "string", "description": "Execution ID from %s"}
+			},
+			"required": ["execution_id"]
+		}`, builtintools.DispatchAgent),
+	},
 }
 
 // orchestrationToolNames is used for quick lookup when routing tool calls.
@@ -152,7 +203,8 @@ func init() {
 
 // FormatSubAgentResult formats a sub-agent result as a conversation message
 // for injection into the orchestrator's conversation. Used by the controller
-// (PR4) to deliver results between iterations.
+// (PR4) to deliver results between iterations. The time and tokens the
+// sub-agent used are appended so per-dispatch consumption shows in the trace.
 func FormatSubAgentResult(result *SubAgentResult) agent.ConversationMessage {
 	var content string
 	if result.Status == agent.ExecutionStatusCompleted {
@@ -166,5 +218,9 @@ func FormatSubAgentResult(result *SubAgentResult) agent.ConversationMessage {
 			result.Status, result.AgentName, result.ExecutionID, result.Error,
 		)
 	}
+	if result.Duration > 0 {
+		content += fmt.Sprintf("\n(Budget used: %s, %d tokens.)",
+			result.Duration.Round(time.Second), result.TokensUsed.TotalTokens)
+	}
 	return agent.ConversationMessage{Role: agent.RoleUser, Content: content}
 }
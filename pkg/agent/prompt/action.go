@@ -42,6 +42,7 @@ func (b *PromptBuilder) buildActionMessages(
 	messages = append(messages, agent.ConversationMessage{
 		Role:    agent.RoleUser,
 		Content: userContent,
+		Images:  execCtx.AlertImages,
 	})
 
 	return messages
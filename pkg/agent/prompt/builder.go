@@ -1,8 +1,12 @@
 package prompt
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
@@ -79,8 +83,10 @@ func (b *PromptBuilder) BuildFunctionCallingMessages(
 	}
 
 	var userContent string
+	images := execCtx.AlertImages
 	if isChat {
 		userContent = b.buildChatUserMessage(execCtx)
+		images = append(images[:len(images):len(images)], execCtx.ChatContext.Images...)
 	} else {
 		userContent = b.buildInvestigationUserMessage(execCtx, prevStageContext)
 	}
@@ -88,6 +94,7 @@ func (b *PromptBuilder) BuildFunctionCallingMessages(
 	messages = append(messages, agent.ConversationMessage{
 		Role:    agent.RoleUser,
 		Content: userContent,
+		Images:  images,
 	})
 
 	return messages
@@ -126,6 +133,19 @@ func (b *PromptBuilder) BuildForcedConclusionPrompt(iteration int) string {
 	return fmt.Sprintf(forcedConclusionTemplate, iteration, forcedConclusionFormat)
 }
 
+// BuildTimeBudgetConclusionPrompt returns a prompt to force an LLM
+// conclusion when the execution is running out of time.
+func (b *PromptBuilder) BuildTimeBudgetConclusionPrompt(elapsed, remaining time.Duration) string {
+	return fmt.Sprintf(timeBudgetConclusionTemplate,
+		elapsed.Round(time.Second), remaining.Round(time.Second), forcedConclusionFormat)
+}
+
+// BuildToolCallLimitConclusionPrompt returns a prompt to force an LLM
+// conclusion once the execution has made max_tool_calls tool calls.
+func (b *PromptBuilder) BuildToolCallLimitConclusionPrompt(toolCalls int) string {
+	return fmt.Sprintf(toolCallLimitConclusionTemplate, toolCalls, forcedConclusionFormat)
+}
+
 // BuildMCPSummarizationSystemPrompt builds the system prompt for MCP result summarization.
 func (b *PromptBuilder) BuildMCPSummarizationSystemPrompt(serverName, toolName string, maxSummaryTokens int) string {
 	return fmt.Sprintf(mcpSummarizationSystemTemplate, serverName, toolName, maxSummaryTokens)
@@ -137,13 +157,66 @@ func (b *PromptBuilder) BuildMCPSummarizationUserPrompt(conversationContext, ser
 }
 
 // BuildExecutiveSummarySystemPrompt returns the system prompt for executive summary generation.
-func (b *PromptBuilder) BuildExecutiveSummarySystemPrompt() string {
-	return executiveSummarySystemPrompt
+// A non-empty outputLanguage asks for the summary in that language. cfg is the
+// chain's executive_summary block (nil = built-in behavior).
+func (b *PromptBuilder) BuildExecutiveSummarySystemPrompt(outputLanguage string, cfg *config.ExecutiveSummaryConfig) string {
+	template := executiveSummarySystemTemplate
+	if cfg != nil && cfg.Audience == config.ExecSummaryAudienceEngineer {
+		template = engineerSummarySystemTemplate
+	}
+	prompt := fmt.Sprintf(template, cfg.EffectiveMaxLines())
+	if cfg != nil && cfg.Style == config.ExecSummaryStyleBullets {
+		prompt += "\n\n" + executiveSummaryBulletsInstruction
+	}
+	if outputLanguage != "" {
+		prompt += "\n\n" + fmt.Sprintf(executiveSummaryLanguageTemplate, outputLanguage)
+	}
+	return prompt
 }
 
 // BuildExecutiveSummaryUserPrompt builds the user prompt for generating an executive summary.
-func (b *PromptBuilder) BuildExecutiveSummaryUserPrompt(finalAnalysis string) string {
-	return fmt.Sprintf(executiveSummaryUserTemplate, finalAnalysis)
+// A chain's prompt_template replaces the built-in one; the severity
+// instruction is appended to it so severity parsing keeps working.
+func (b *PromptBuilder) BuildExecutiveSummaryUserPrompt(finalAnalysis string, cfg *config.ExecutiveSummaryConfig) string {
+	template := executiveSummaryUserTemplate
+	if cfg != nil && cfg.PromptTemplate != "" {
+		template = cfg.PromptTemplate + "\n\n" + ExecSummarySeveritySchema
+	}
+	// Single pass, so placeholders inside the analysis text are left alone.
+	return strings.NewReplacer(
+		"{analysis}", finalAnalysis,
+		"{max_lines}", strconv.Itoa(cfg.EffectiveMaxLines()),
+	).Replace(template)
+}
+
+// ExecutiveSummaryTemplateVersion identifies the executive summary prompt a
+// chain's config produces, for recording on the sessions it summarizes:
+// the configured template_version, "builtin-v1" for the built-in prompt, or
+// a short hash of the customized prompt templates.
+func ExecutiveSummaryTemplateVersion(cfg *config.ExecutiveSummaryConfig) string {
+	if cfg != nil && cfg.TemplateVersion != "" {
+		return cfg.TemplateVersion
+	}
+	if cfg.IsDefault() {
+		return builtinExecSummaryTemplateVersion
+	}
+	var b PromptBuilder
+	sum := sha256.Sum256([]byte(b.BuildExecutiveSummarySystemPrompt("", cfg) + "\x00" + b.BuildExecutiveSummaryUserPrompt("{analysis}", cfg)))
+	return "sha256:" + hex.EncodeToString(sum[:])[:12]
+}
+
+// BuildTechnicalSummarySystemPrompt returns the system prompt for technical summary generation.
+// A non-empty outputLanguage asks for the summary in that language.
+func (b *PromptBuilder) BuildTechnicalSummarySystemPrompt(outputLanguage string) string {
+	if outputLanguage == "" {
+		return technicalSummarySystemPrompt
+	}
+	return technicalSummarySystemPrompt + "\n\n" + fmt.Sprintf(technicalSummaryLanguageTemplate, outputLanguage)
+}
+
+// BuildTechnicalSummaryUserPrompt builds the user prompt for generating a technical summary.
+func (b *PromptBuilder) BuildTechnicalSummaryUserPrompt(finalAnalysis string) string {
+	return fmt.Sprintf(technicalSummaryUserTemplate, finalAnalysis)
 }
 
 func (b *PromptBuilder) BuildScoringSystemPrompt() string {
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
@@ -366,6 +367,20 @@ func TestIntegration_ForcedConclusion(t *testing.T) {
 	assertGolden(t, "forced_conclusion", result)
 }
 
+func TestIntegration_TimeBudgetConclusion(t *testing.T) {
+	builder := newIntegrationBuilder()
+	result := builder.BuildTimeBudgetConclusionPrompt(32*time.Minute, 8*time.Minute)
+
+	assertGolden(t, "time_budget_conclusion", result)
+}
+
+func TestIntegration_ToolCallLimitConclusion(t *testing.T) {
+	builder := newIntegrationBuilder()
+	result := builder.BuildToolCallLimitConclusionPrompt(25)
+
+	assertGolden(t, "tool_call_limit_conclusion", result)
+}
+
 // ===========================================================================
 // Utility prompt tests
 // ===========================================================================
@@ -387,9 +402,10 @@ func TestIntegration_MCPSummarization(t *testing.T) {
 func TestIntegration_ExecutiveSummary(t *testing.T) {
 	builder := newIntegrationBuilder()
 
-	systemPrompt := builder.BuildExecutiveSummarySystemPrompt()
+	systemPrompt := builder.BuildExecutiveSummarySystemPrompt("", nil)
 	userPrompt := builder.BuildExecutiveSummaryUserPrompt(
 		"Root cause: OOM kill due to memory leak in pod-1. Recommendation: increase memory limit to 1Gi.",
+		nil,
 	)
 
 	combined := systemPrompt + "\n\n=== USER PROMPT ===\n\n" + userPrompt
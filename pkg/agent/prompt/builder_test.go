@@ -1,8 +1,11 @@
 package prompt
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -119,6 +122,24 @@ func TestBuildForcedConclusionPrompt(t *testing.T) {
 	assert.NotContains(t, result, "Final Answer:")
 }
 
+func TestBuildTimeBudgetConclusionPrompt(t *testing.T) {
+	builder := newBuilderForTest()
+	result := builder.BuildTimeBudgetConclusionPrompt(32*time.Minute+1500*time.Millisecond, 8*time.Minute)
+
+	assert.Contains(t, result, "32m2s elapsed, about 8m0s left")
+	assert.Contains(t, result, "conclude now")
+	assert.Contains(t, result, "structured conclusion")
+}
+
+func TestBuildToolCallLimitConclusionPrompt(t *testing.T) {
+	builder := newBuilderForTest()
+	result := builder.BuildToolCallLimitConclusionPrompt(25)
+
+	assert.Contains(t, result, "tool call limit for this investigation (25 tool calls)")
+	assert.Contains(t, result, "conclude now")
+	assert.Contains(t, result, "structured conclusion")
+}
+
 func TestBuildMCPSummarizationPrompts(t *testing.T) {
 	builder := newBuilderForTest()
 
@@ -136,11 +157,77 @@ func TestBuildMCPSummarizationPrompts(t *testing.T) {
 func TestBuildExecutiveSummaryPrompts(t *testing.T) {
 	builder := newBuilderForTest()
 
-	systemPrompt := builder.BuildExecutiveSummarySystemPrompt()
+	systemPrompt := builder.BuildExecutiveSummarySystemPrompt("", nil)
 	assert.Contains(t, systemPrompt, "executive summaries")
+	assert.NotContains(t, systemPrompt, "Write the summary in")
+
+	localized := builder.BuildExecutiveSummarySystemPrompt("Japanese", nil)
+	assert.Contains(t, localized, "Write the summary in Japanese.")
+	assert.Contains(t, localized, "SEVERITY: <LEVEL>")
 
-	userPrompt := builder.BuildExecutiveSummaryUserPrompt("The root cause was OOM.")
+	userPrompt := builder.BuildExecutiveSummaryUserPrompt("The root cause was OOM.", nil)
 	assert.Contains(t, userPrompt, "The root cause was OOM.")
+	assert.Contains(t, userPrompt, "Generate a 1-4 line executive summary")
+}
+
+func TestBuildTechnicalSummaryPrompts(t *testing.T) {
+	builder := newBuilderForTest()
+
+	systemPrompt := builder.BuildTechnicalSummarySystemPrompt("")
+	assert.Contains(t, systemPrompt, "technical summaries")
+	assert.NotContains(t, systemPrompt, "Write the summary in")
+
+	localized := builder.BuildTechnicalSummarySystemPrompt("Japanese")
+	assert.Contains(t, localized, "Write the summary in Japanese.")
+
+	userPrompt := builder.BuildTechnicalSummaryUserPrompt("The root cause was OOM.")
+	assert.Contains(t, userPrompt, "The root cause was OOM.")
+	for _, section := range []string{"## Root Cause", "## Affected Components", "## Key Evidence", "## Next Steps"} {
+		assert.Contains(t, userPrompt, section)
+	}
+	assert.NotContains(t, userPrompt, "SEVERITY")
+}
+
+func TestBuildExecutiveSummaryPrompts_Customized(t *testing.T) {
+	builder := newBuilderForTest()
+
+	t.Run("engineer audience with bullets", func(t *testing.T) {
+		cfg := &config.ExecutiveSummaryConfig{
+			Audience: config.ExecSummaryAudienceEngineer,
+			Style:    config.ExecSummaryStyleBullets,
+			MaxLines: 8,
+		}
+		systemPrompt := builder.BuildExecutiveSummarySystemPrompt("German", cfg)
+		assert.Contains(t, systemPrompt, "1-8 line technical summaries")
+		assert.Contains(t, systemPrompt, "bulleted list")
+		assert.True(t, strings.HasSuffix(systemPrompt, "Write the summary in German. Keep the \"SEVERITY: <LEVEL>\" line exactly as specified, in English."))
+
+		userPrompt := builder.BuildExecutiveSummaryUserPrompt("analysis", cfg)
+		assert.Contains(t, userPrompt, "Generate a 1-8 line executive summary")
+		assert.Contains(t, userPrompt, "Executive Summary (1-8 lines, facts only):")
+	})
+
+	t.Run("custom prompt template", func(t *testing.T) {
+		cfg := &config.ExecutiveSummaryConfig{
+			PromptTemplate: "Summarize in at most {max_lines} lines:\n{analysis}",
+			MaxLines:       3,
+		}
+		userPrompt := builder.BuildExecutiveSummaryUserPrompt("Disk full on node-1 ({max_lines} left as-is)", cfg)
+		assert.True(t, strings.HasPrefix(userPrompt, "Summarize in at most 3 lines:\nDisk full on node-1 ({max_lines} left as-is)"))
+		assert.True(t, strings.HasSuffix(userPrompt, ExecSummarySeveritySchema))
+		assert.NotContains(t, userPrompt, "CRITICAL RULES")
+	})
+}
+
+func TestExecutiveSummaryTemplateVersion(t *testing.T) {
+	assert.Equal(t, "builtin-v1", ExecutiveSummaryTemplateVersion(nil))
+	assert.Equal(t, "builtin-v1", ExecutiveSummaryTemplateVersion(&config.ExecutiveSummaryConfig{MaxLines: 4}))
+	assert.Equal(t, "ops-2026-10", ExecutiveSummaryTemplateVersion(&config.ExecutiveSummaryConfig{TemplateVersion: "ops-2026-10"}))
+
+	bullets := ExecutiveSummaryTemplateVersion(&config.ExecutiveSummaryConfig{Style: config.ExecSummaryStyleBullets})
+	assert.Regexp(t, `^sha256:[0-9a-f]{12}$`, bullets)
+	assert.Equal(t, bullets, ExecutiveSummaryTemplateVersion(&config.ExecutiveSummaryConfig{Style: config.ExecSummaryStyleBullets}))
+	assert.NotEqual(t, bullets, ExecutiveSummaryTemplateVersion(&config.ExecutiveSummaryConfig{Style: config.ExecSummaryStyleBullets, MaxLines: 6}))
 }
 
 func TestBuildFunctionCallingMessages_ChatMode(t *testing.T) {
@@ -157,6 +244,24 @@ func TestBuildFunctionCallingMessages_ChatMode(t *testing.T) {
 	assert.Contains(t, messages[1].Content, "Show me the pod status")
 }
 
+func TestBuildFunctionCallingMessages_Images(t *testing.T) {
+	builder := newBuilderForTest()
+	alertImage := agent.ImagePart{Ref: schema.ImageRef{ID: "alert-img"}, Data: []byte("a")}
+	chatImage := agent.ImagePart{Ref: schema.ImageRef{ID: "chat-img"}, Data: []byte("c")}
+
+	execCtx := newFullExecCtx()
+	execCtx.AlertImages = []agent.ImagePart{alertImage}
+	messages := builder.BuildFunctionCallingMessages(execCtx, "")
+	assert.Empty(t, messages[0].Images)
+	assert.Equal(t, []agent.ImagePart{alertImage}, messages[1].Images)
+
+	// Chat questions carry the alert's images plus their own
+	execCtx.ChatContext = &agent.ChatContext{UserQuestion: "Is this the same spike?", Images: []agent.ImagePart{chatImage}}
+	messages = builder.BuildFunctionCallingMessages(execCtx, "")
+	assert.Equal(t, []agent.ImagePart{alertImage, chatImage}, messages[1].Images)
+	assert.Len(t, execCtx.AlertImages, 1, "alert images must not be modified")
+}
+
 func TestBuildFunctionCallingMessages_OrchestratorInjection(t *testing.T) {
 	builder := newBuilderForTest()
 	execCtx := newFullExecCtx()
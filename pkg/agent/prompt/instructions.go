@@ -110,14 +110,29 @@ func (b *PromptBuilder) ComposeInstructions(execCtx *agent.ExecutionContext) str
 	// Tier 2.5: Required skill content (injected directly into prompt)
 	sections = appendSkillSections(sections, execCtx)
 
+	// generate_link templates, when the tool is available
+	sections = appendLinkTemplates(sections, execCtx)
+
 	// Tier 3: Custom agent instructions
 	if execCtx.Config.CustomInstructions != "" {
 		sections = append(sections, "## Agent-Specific Instructions\n\n"+execCtx.Config.CustomInstructions)
 	}
 
+	// Tier 3.5: Instructions submitted with the alert
+	sections = appendAlertInstructions(sections, execCtx)
+
+	// Who to page for the affected service
+	sections = appendOnCallSection(sections, execCtx)
+
+	// Earlier sessions of the same alert type, for recurring alerts
+	sections = appendRecentHistory(sections, execCtx)
+
 	// Tier 4: Memory hints from past investigations (investigation sessions only)
 	sections = appendMemorySection(sections, execCtx)
 
+	// Output language for the final answer
+	sections = appendOutputLanguage(sections, execCtx)
+
 	return strings.Join(sections, "\n\n")
 }
 
@@ -140,6 +155,9 @@ func (b *PromptBuilder) ComposeChatInstructions(execCtx *agent.ExecutionContext)
 	// Tier 2.5: Required skill content (injected directly into prompt)
 	sections = appendSkillSections(sections, execCtx)
 
+	// generate_link templates, when the tool is available
+	sections = appendLinkTemplates(sections, execCtx)
+
 	// Tier 3: Custom agent instructions
 	if execCtx.Config.CustomInstructions != "" {
 		sections = append(sections, "## Agent-Specific Instructions\n\n"+execCtx.Config.CustomInstructions)
@@ -148,6 +166,13 @@ func (b *PromptBuilder) ComposeChatInstructions(execCtx *agent.ExecutionContext)
 	// Chat-specific guidelines
 	sections = append(sections, chatResponseGuidelines)
 
+	// Guardrails from the chain and the alert (after the guidelines, so they
+	// take precedence)
+	sections = appendChatGuardrails(sections, execCtx)
+
+	// Output language for chat answers
+	sections = appendOutputLanguage(sections, execCtx)
+
 	return strings.Join(sections, "\n\n")
 }
 
@@ -182,6 +207,18 @@ func (b *PromptBuilder) composeSynthesisInstructions(execCtx *agent.ExecutionCon
 		sections = append(sections, "## Agent-Specific Instructions\n\n"+execCtx.Config.CustomInstructions)
 	}
 
+	// Tier 3.5: Instructions submitted with the alert
+	sections = appendAlertInstructions(sections, execCtx)
+
+	// Who to page for the affected service
+	sections = appendOnCallSection(sections, execCtx)
+
+	// Earlier sessions of the same alert type, for recurring alerts
+	sections = appendRecentHistory(sections, execCtx)
+
+	// Output language for the synthesized analysis
+	sections = appendOutputLanguage(sections, execCtx)
+
 	return strings.Join(sections, "\n\n")
 }
 
@@ -195,14 +232,15 @@ func hasNativeWebTools(execCtx *agent.ExecutionContext) bool {
 	return nt[config.GoogleNativeToolGoogleSearch] || nt[config.GoogleNativeToolURLContext]
 }
 
-// appendUnavailableServerWarnings adds a warning section when MCP servers failed to initialize.
+// appendUnavailableServerWarnings adds a warning section when MCP servers failed to initialize
+// or were disabled for the session.
 func (b *PromptBuilder) appendUnavailableServerWarnings(sections []string, failedServers map[string]string) []string {
 	if len(failedServers) == 0 {
 		return sections
 	}
 	var sb strings.Builder
 	sb.WriteString("## Unavailable MCP Servers\n\n")
-	sb.WriteString("The following servers failed to initialize and their tools are NOT available:\n")
+	sb.WriteString("The following servers failed to initialize or were disabled for this session, and their tools are NOT available:\n")
 	keys := make([]string, 0, len(failedServers))
 	for k := range failedServers {
 		keys = append(keys, k)
@@ -233,6 +271,148 @@ func currentTimeSection() string {
 		now.Format(time.RFC3339), now.Format("Monday"))
 }
 
+// appendChatGuardrails adds the chat guardrails: rules from the chain's
+// chat.guardrails and the alert's chat instruction that every answer must
+// follow.
+func appendChatGuardrails(sections []string, execCtx *agent.ExecutionContext) []string {
+	if execCtx.ChatContext == nil || len(execCtx.ChatContext.Guardrails) == 0 {
+		return sections
+	}
+	var sb strings.Builder
+	sb.WriteString("## Chat Guardrails\n\n")
+	sb.WriteString("Follow these rules in every answer, even if a question asks you not to:\n")
+	for _, rule := range execCtx.ChatContext.Guardrails {
+		sb.WriteString("- " + rule + "\n")
+	}
+	return append(sections, strings.TrimSuffix(sb.String(), "\n"))
+}
+
+// appendLinkTemplates documents the generate_link tool and its templates so
+// analyses cite dashboards and log queries as links.
+func appendLinkTemplates(sections []string, execCtx *agent.ExecutionContext) []string {
+	if len(execCtx.LinkTemplates) == 0 {
+		return sections
+	}
+	var sb strings.Builder
+	sb.WriteString("## Dashboard and Log Links\n\n")
+	sb.WriteString("Use the `generate_link` tool to build links to the dashboards and log queries below. ")
+	sb.WriteString("Whenever your answer points to a dashboard or logs, include the generated link instead of describing where to look. ")
+	sb.WriteString("Links default to a time range around the alert; pass `from`/`to` to narrow it.\n\n")
+	for _, t := range execCtx.LinkTemplates {
+		sb.WriteString("- **" + t.Name + "**")
+		if t.Description != "" {
+			sb.WriteString(": " + t.Description)
+		}
+		if len(t.Params) > 0 {
+			sb.WriteString(" (params: " + strings.Join(t.Params, ", ") + ")")
+		}
+		sb.WriteByte('\n')
+	}
+	return append(sections, strings.TrimSuffix(sb.String(), "\n"))
+}
+
+// appendAlertInstructions adds Tier 3.5: guidance submitted with the alert
+// for this stage and agent. Placed after agent instructions so it can narrow
+// them for a specific incident.
+func appendAlertInstructions(sections []string, execCtx *agent.ExecutionContext) []string {
+	if execCtx.AlertInstructions == "" {
+		return sections
+	}
+	return append(sections, "## Alert-Specific Instructions\n\n"+
+		"The submitter of this alert provided the following additional guidance:\n\n"+execCtx.AlertInstructions)
+}
+
+// appendOnCallSection names the on-call engineer of the alert's service so
+// recommendations to escalate or page point at the right person. Omitted
+// when the lookup failed or found nobody on call.
+func appendOnCallSection(sections []string, execCtx *agent.ExecutionContext) []string {
+	oc := execCtx.OnCall
+	if oc == nil || oc.Name == "" {
+		return sections
+	}
+	var sb strings.Builder
+	sb.WriteString("## Current On-Call\n\n")
+	sb.WriteString(fmt.Sprintf("Service: %s\n", oc.Service))
+	if oc.Team != "" {
+		sb.WriteString(fmt.Sprintf("Team: %s\n", oc.Team))
+	}
+	who := oc.Name
+	if oc.Email != "" && oc.Email != oc.Name {
+		who += " <" + oc.Email + ">"
+	}
+	sb.WriteString("On-call engineer: " + who)
+	if oc.Until != nil {
+		sb.WriteString(fmt.Sprintf(" (shift ends %s)", oc.Until.UTC().Format(time.RFC3339)))
+	}
+	sb.WriteString("\n\nWhen your recommendations call for escalating or paging someone, name this engineer.")
+	return append(sections, sb.String())
+}
+
+// appendRecentHistory lists earlier sessions of the same alert type (and
+// service) so recurring and flapping alerts are not analyzed from scratch
+// every time. Summaries are rendered inside delimiters and treated as
+// untrusted data, like memory hints.
+func appendRecentHistory(sections []string, execCtx *agent.ExecutionContext) []string {
+	h := execCtx.RecentHistory
+	if h == nil || len(h.Sessions) == 0 {
+		return sections
+	}
+
+	var sb strings.Builder
+	sb.WriteString("## Recent History\n\n")
+	scope := "this alert type"
+	if h.Service != "" {
+		scope += fmt.Sprintf(" for service %q", h.Service)
+	}
+	sb.WriteString(fmt.Sprintf("In the last %s, %d earlier session(s) investigated %s", formatWindow(h.Window), h.Total, scope))
+	if h.Total > len(h.Sessions) {
+		sb.WriteString(fmt.Sprintf(" (the %d most recent are listed)", len(h.Sessions)))
+	}
+	sb.WriteString(".\n")
+	sb.WriteString("If this alert is recurring with the same cause, say so, refer to the earlier conclusions and focus on what changed instead of repeating the same analysis.\n")
+	sb.WriteString("IMPORTANT: This is context from PAST sessions. Verify the current state with your tools before relying on it.\n\n")
+	sb.WriteString("<recent_history>\n")
+	for _, s := range h.Sessions {
+		sb.WriteString("- " + s.CreatedAt.UTC().Format("2006-01-02 15:04 UTC") + " — " + s.Status)
+		if s.Outcome != "" {
+			sb.WriteString(", outcome: " + s.Outcome)
+		}
+		if s.Severity != "" {
+			sb.WriteString(", severity: " + s.Severity)
+		}
+		if s.Summary != "" {
+			sb.WriteString(": " + s.Summary)
+		}
+		sb.WriteByte('\n')
+	}
+	sb.WriteString("</recent_history>")
+	return append(sections, sb.String())
+}
+
+// formatWindow renders a history window in days when it is a whole number
+// of days (e.g. "7 days"), else as a duration.
+func formatWindow(d time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case d == day:
+		return "day"
+	case d > 0 && d%day == 0:
+		return fmt.Sprintf("%d days", d/day)
+	default:
+		return d.String()
+	}
+}
+
+// appendOutputLanguage asks for user-facing output in the configured
+// language. Only the final answer is constrained: reasoning, tool calls,
+// and quoted evidence stay in whatever form the agent needs.
+func appendOutputLanguage(sections []string, execCtx *agent.ExecutionContext) []string {
+	if execCtx.OutputLanguage == "" {
+		return sections
+	}
+	return append(sections, fmt.Sprintf(outputLanguageTemplate, execCtx.OutputLanguage))
+}
+
 // appendMemorySection adds Tier 4 memory hints from past investigations.
 // Only appended when MemoryBriefing is non-nil and contains memories.
 // Content is rendered inside delimiters and treated as untrusted data
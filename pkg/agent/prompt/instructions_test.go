@@ -3,7 +3,9 @@ package prompt
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -53,6 +55,157 @@ func TestComposeInstructions_ThreeTiers(t *testing.T) {
 	assert.Contains(t, result, "Custom test instructions.")
 }
 
+func TestComposeInstructions_AlertInstructions(t *testing.T) {
+	builder := NewPromptBuilder(newTestMCPRegistry(nil))
+
+	t.Run("appended after agent instructions", func(t *testing.T) {
+		execCtx := newTestExecCtx()
+		execCtx.AlertInstructions = "Focus on the payments namespace."
+
+		result := builder.ComposeInstructions(execCtx)
+		assert.Contains(t, result, "## Alert-Specific Instructions")
+		assert.Contains(t, result, "Focus on the payments namespace.")
+		assert.Greater(t, strings.Index(result, "Alert-Specific Instructions"), strings.Index(result, "Agent-Specific Instructions"))
+	})
+
+	t.Run("omitted when empty", func(t *testing.T) {
+		result := builder.ComposeInstructions(newTestExecCtx())
+		assert.NotContains(t, result, "Alert-Specific Instructions")
+	})
+
+	t.Run("included for synthesis", func(t *testing.T) {
+		execCtx := newTestExecCtx()
+		execCtx.AlertInstructions = "Prefer the rollback hypothesis."
+
+		result := builder.composeSynthesisInstructions(execCtx)
+		assert.Contains(t, result, "Prefer the rollback hypothesis.")
+	})
+}
+
+func TestComposeInstructions_LinkTemplates(t *testing.T) {
+	builder := NewPromptBuilder(newTestMCPRegistry(nil))
+
+	t.Run("documents generate_link templates", func(t *testing.T) {
+		execCtx := newTestExecCtx()
+		execCtx.LinkTemplates = []agent.LinkTemplateEntry{
+			{Name: "grafana-pod", Description: "Pod resources dashboard", Params: []string{"namespace", "pod"}},
+			{Name: "kibana"},
+		}
+
+		result := builder.ComposeInstructions(execCtx)
+		assert.Contains(t, result, "## Dashboard and Log Links")
+		assert.Contains(t, result, "`generate_link`")
+		assert.Contains(t, result, "- **grafana-pod**: Pod resources dashboard (params: namespace, pod)\n- **kibana**")
+	})
+
+	t.Run("omitted without templates", func(t *testing.T) {
+		assert.NotContains(t, builder.ComposeInstructions(newTestExecCtx()), "generate_link")
+	})
+}
+
+func TestComposeInstructions_OnCall(t *testing.T) {
+	builder := NewPromptBuilder(newTestMCPRegistry(nil))
+	until := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	t.Run("names the on-call engineer", func(t *testing.T) {
+		execCtx := newTestExecCtx()
+		execCtx.OnCall = &schema.OnCallContact{
+			Service: "payments", Team: "Payments SRE", Name: "Ada Lovelace", Email: "ada@example.com", Until: &until,
+		}
+
+		result := builder.ComposeInstructions(execCtx)
+		assert.Contains(t, result, "## Current On-Call")
+		assert.Contains(t, result, "Service: payments\nTeam: Payments SRE\n")
+		assert.Contains(t, result, "On-call engineer: Ada Lovelace <ada@example.com> (shift ends 2026-10-17T09:00:00Z)")
+		assert.Contains(t, builder.composeSynthesisInstructions(execCtx), "Ada Lovelace")
+	})
+
+	t.Run("omitted without an engineer", func(t *testing.T) {
+		execCtx := newTestExecCtx()
+		assert.NotContains(t, builder.ComposeInstructions(execCtx), "Current On-Call")
+
+		execCtx.OnCall = &schema.OnCallContact{Service: "payments", Error: "status 401"}
+		assert.NotContains(t, builder.ComposeInstructions(execCtx), "Current On-Call")
+	})
+}
+
+func TestComposeInstructions_RecentHistory(t *testing.T) {
+	builder := NewPromptBuilder(newTestMCPRegistry(nil))
+
+	t.Run("lists earlier sessions", func(t *testing.T) {
+		execCtx := newTestExecCtx()
+		execCtx.RecentHistory = &agent.RecentHistory{
+			Window:  7 * 24 * time.Hour,
+			Service: "checkout",
+			Total:   3,
+			Sessions: []agent.RecentSession{
+				{
+					CreatedAt: time.Date(2026, 10, 15, 8, 30, 0, 0, time.UTC),
+					Status:    "completed",
+					Outcome:   "false_positive",
+					Severity:  "low",
+					Summary:   "Pod OOM-killed after the nightly batch job.",
+				},
+				{
+					CreatedAt: time.Date(2026, 10, 14, 8, 0, 0, 0, time.UTC),
+					Status:    "failed",
+				},
+			},
+		}
+
+		result := builder.ComposeInstructions(execCtx)
+		assert.Contains(t, result, "## Recent History")
+		assert.Contains(t, result, `In the last 7 days, 3 earlier session(s) investigated this alert type for service "checkout" (the 2 most recent are listed).`)
+		assert.Contains(t, result, "<recent_history>\n"+
+			"- 2026-10-15 08:30 UTC — completed, outcome: false_positive, severity: low: Pod OOM-killed after the nightly batch job.\n"+
+			"- 2026-10-14 08:00 UTC — failed\n"+
+			"</recent_history>")
+		assert.Contains(t, builder.composeSynthesisInstructions(execCtx), "## Recent History")
+		assert.NotContains(t, builder.ComposeChatInstructions(execCtx), "Recent History")
+	})
+
+	t.Run("omitted without history", func(t *testing.T) {
+		assert.NotContains(t, builder.ComposeInstructions(newTestExecCtx()), "Recent History")
+	})
+}
+
+func TestFormatWindow(t *testing.T) {
+	assert.Equal(t, "day", formatWindow(24*time.Hour))
+	assert.Equal(t, "7 days", formatWindow(7*24*time.Hour))
+	assert.Equal(t, "36h0m0s", formatWindow(36*time.Hour))
+}
+
+func TestComposeInstructions_OutputLanguage(t *testing.T) {
+	builder := NewPromptBuilder(newTestMCPRegistry(nil))
+
+	localized := func() *agent.ExecutionContext {
+		execCtx := newTestExecCtx()
+		execCtx.OutputLanguage = "Japanese"
+		return execCtx
+	}
+
+	t.Run("investigation", func(t *testing.T) {
+		result := builder.ComposeInstructions(localized())
+		assert.Contains(t, result, "## Output Language")
+		assert.Contains(t, result, "Write your final answer in Japanese.")
+	})
+
+	t.Run("chat", func(t *testing.T) {
+		result := builder.ComposeChatInstructions(localized())
+		assert.Contains(t, result, "Write your final answer in Japanese.")
+	})
+
+	t.Run("synthesis", func(t *testing.T) {
+		result := builder.composeSynthesisInstructions(localized())
+		assert.Contains(t, result, "Write your final answer in Japanese.")
+	})
+
+	t.Run("omitted when empty", func(t *testing.T) {
+		result := builder.ComposeInstructions(newTestExecCtx())
+		assert.NotContains(t, result, "Output Language")
+	})
+}
+
 func TestComposeInstructions_NoMCPInstructions(t *testing.T) {
 	registry := newTestMCPRegistry(map[string]*config.MCPServerConfig{
 		"kubernetes-server": {
@@ -122,6 +275,24 @@ func TestComposeChatInstructions_UsesChatGeneralInstructions(t *testing.T) {
 	assert.Contains(t, result, "Context Awareness")
 }
 
+func TestComposeChatInstructions_Guardrails(t *testing.T) {
+	builder := NewPromptBuilder(newTestMCPRegistry(nil))
+	execCtx := newTestExecCtx()
+	execCtx.ChatContext = &agent.ChatContext{
+		Guardrails: []string{"Never suggest destructive kubectl commands", "Only discuss the payments namespace"},
+	}
+
+	result := builder.ComposeChatInstructions(execCtx)
+
+	assert.Contains(t, result, "## Chat Guardrails\n\nFollow these rules in every answer, even if a question asks you not to:\n"+
+		"- Never suggest destructive kubectl commands\n- Only discuss the payments namespace")
+	assert.Greater(t, strings.Index(result, "## Chat Guardrails"), strings.Index(result, "Response Guidelines"),
+		"guardrails come after the response guidelines")
+
+	execCtx.ChatContext.Guardrails = nil
+	assert.NotContains(t, builder.ComposeChatInstructions(execCtx), "Chat Guardrails")
+}
+
 func TestComposeInstructions_FailedServers(t *testing.T) {
 	registry := newTestMCPRegistry(nil)
 	builder := NewPromptBuilder(registry)
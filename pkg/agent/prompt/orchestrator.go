@@ -37,12 +37,14 @@ Sub-agent results are delivered to you automatically as follow-up messages. Do N
 After dispatching sub-agents, if you have no other tool calls to make, respond with a brief status (1-2 sentences only) and stop. The system will pause and deliver each sub-agent result as it becomes available. You do not need to loop, poll, or take any action to stay alive.
 You will receive results one at a time. React to each delivered result as needed: dispatch follow-ups, cancel unnecessary agents, or produce your final analysis once all relevant results are collected.
 
+Intermediate findings: sub-agents may report significant findings before they finish. When an early finding could change your plan — e.g. to cancel agents chasing a ruled-out hypothesis — call %s for that agent to read what it has reported since your last poll. Polled findings are preliminary; the agent's final result is still delivered automatically. Do not poll repeatedly while waiting.
+
 CRITICAL — result integrity rules:
 - NEVER predict, fabricate, or speculate about what a sub-agent might find. You do not know the results until they are delivered.
 - NEVER dispatch follow-up sub-agents based on anticipated outcomes. Only act on results you have actually received in a prior message.
 - If you have not yet received a sub-agent's result, do NOT reference its findings — wait for delivery.
 
-Tracking: keep a mental checklist of every agent you dispatch. When a result arrives, match it against your list. Only produce your final analysis once every dispatched agent has reported back (completed, failed, or cancelled by you).`, builtintools.ListAgents)
+Tracking: keep a mental checklist of every agent you dispatch. When a result arrives, match it against your list. Only produce your final analysis once every dispatched agent has reported back (completed, failed, or cancelled by you).`, builtintools.ListAgents, builtintools.PollAgent)
 }
 
 const orchestratorTaskFocus = "Give clear, actionable guidance. Prefer sub-agents when parallel or specialized work fits the problem; you may work directly when it stays simpler."
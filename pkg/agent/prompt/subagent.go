@@ -1,14 +1,20 @@
 package prompt
 
-import "github.com/codeready-toolchain/tarsy/pkg/agent"
+import (
+	"fmt"
 
-const subAgentFocus = `You are a sub-agent dispatched by an orchestrator for a specific task.
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/builtintools"
+)
+
+var subAgentFocus = fmt.Sprintf(`You are a sub-agent dispatched by an orchestrator for a specific task.
 
 Rules:
 - Focus exclusively on your assigned task — do not investigate unrelated areas.
 - Your final response is automatically reported back to the orchestrator. Do not address the user directly.
 - Be concise: state what you found, key evidence, and any relevant details the orchestrator should know.
-- If you have tools available, use them to complete your task. If not, use reasoning alone.`
+- If you have tools available, use them to complete your task. If not, use reasoning alone.
+- If you have the %s tool and confirm something that could change the orchestrator's plan (a root cause, a ruled-out hypothesis), report it right away and keep working. Use it sparingly; it does not replace your final response.`, builtintools.ReportProgress)
 
 // buildSubAgentMessages builds the initial conversation for a sub-agent
 // dispatched by an orchestrator. System prompt: Tier 1-3 instructions
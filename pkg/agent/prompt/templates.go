@@ -60,6 +60,38 @@ Please conclude your investigation by answering the original question based on w
 // forcedConclusionFormat is the forced conclusion format instruction.
 const forcedConclusionFormat = `Provide a clear, structured conclusion that directly addresses the investigation question.`
 
+// timeBudgetConclusionTemplate forces a conclusion when an execution has used
+// most of its time budget (time_warnings.wrap_up).
+// %s = elapsed time, %s = time left, %s = format instructions.
+const timeBudgetConclusionTemplate = `You have used most of the time available for this investigation (%s elapsed, about %s left before it is stopped).
+
+Stop investigating and conclude now with your best current answer. Anything not in this response is lost when the time runs out.
+
+**Conclusion guidance:**
+- Use the data and observations you've already gathered
+- Perfect information is not required - provide actionable insights from available findings
+- If gaps remain, clearly state what you couldn't determine and that the investigation ran out of time
+- Clearly distinguish between conclusions supported by tool-gathered evidence and those based only on the original alert data
+- Focus on practical next steps based on current knowledge
+
+%s`
+
+// toolCallLimitConclusionTemplate forces a conclusion when an execution has
+// made max_tool_calls tool calls.
+// %d = tool calls made, %s = format instructions.
+const toolCallLimitConclusionTemplate = `You have reached the tool call limit for this investigation (%d tool calls). No more tools are available.
+
+Stop investigating and conclude now with your best current answer. Do not repeat calls you have already made.
+
+**Conclusion guidance:**
+- Use the data and observations you've already gathered
+- Perfect information is not required - provide actionable insights from available findings
+- If gaps remain, clearly state what you couldn't determine and what further checks would resolve them
+- Clearly distinguish between conclusions supported by tool-gathered evidence and those based only on the original alert data
+- Focus on practical next steps based on current knowledge
+
+%s`
+
 // mcpSummarizationSystemTemplate is the system prompt for MCP result summarization.
 // %s = server name, %s = tool name, %d = max summary tokens.
 const mcpSummarizationSystemTemplate = `You are an expert at summarizing technical output from system administration and monitoring tools for ongoing incident investigation.
@@ -109,12 +141,84 @@ Based on the investigation context above, provide a concise summary of the tool
 
 CRITICAL INSTRUCTION: Return ONLY the summary text. Do NOT include "Final Answer:", "Thought:", "Action:", or any other formatting.`
 
-// executiveSummarySystemPrompt is the system prompt for executive summary generation.
-const executiveSummarySystemPrompt = `You are an expert Site Reliability Engineer assistant that creates concise 1-4 line executive summaries of incident analyses for alert notifications. Focus on clarity, brevity, and actionable information.`
+// builtinExecSummaryTemplateVersion labels sessions summarized with the
+// built-in executive summary prompt. Bump it when the built-in prompt changes.
+const builtinExecSummaryTemplateVersion = "builtin-v1"
+
+// executiveSummarySystemTemplate is the system prompt for executive summary
+// generation for the executive audience. %d = max lines.
+const executiveSummarySystemTemplate = `You are an expert Site Reliability Engineer assistant that creates concise 1-%d line executive summaries of incident analyses for alert notifications. Focus on clarity, brevity, and actionable information.`
+
+// engineerSummarySystemTemplate is the system prompt for executive summary
+// generation for the engineer audience. %d = max lines.
+const engineerSummarySystemTemplate = `You are an expert Site Reliability Engineer assistant that creates 1-%d line technical summaries of incident analyses for the on-call engineers who will act on them. Keep the specifics they need: affected components, key evidence (resource names, error messages, metrics), and the next steps the analysis states.`
 
-// executiveSummaryUserTemplate is the user prompt for executive summary generation.
-// %s = final analysis text.
-const executiveSummaryUserTemplate = `Generate a 1-4 line executive summary of this incident analysis.
+// executiveSummaryBulletsInstruction is appended to the executive summary
+// system prompt for the bullets style.
+const executiveSummaryBulletsInstruction = `Format the summary as a bulleted list: one point per line, each starting with "- ". Do not add headings.`
+
+// ExecSummarySeveritySchema instructs the executive summary agent to classify
+// the incident severity on a trailing marker line. The executor parses it to
+// populate the severity column on the session and route notifications.
+const ExecSummarySeveritySchema = `SEVERITY CLASSIFICATION:
+After the summary, add one final line of the form "SEVERITY: <LEVEL>" where <LEVEL> is one of:
+- CRITICAL: customer-facing outage, data loss, or security exposure in progress
+- HIGH: significant degradation that needs prompt human attention
+- MEDIUM: contained issue that can wait for working hours
+- LOW: informational, transient, or already self-resolved
+The SEVERITY line must be the very last line and contain nothing else.`
+
+// executiveSummaryLanguageTemplate is appended to the executive summary
+// system prompt when an output language is configured.
+// %s = output language.
+const executiveSummaryLanguageTemplate = `Write the summary in %s. Keep the "SEVERITY: <LEVEL>" line exactly as specified, in English.`
+
+// technicalSummarySystemPrompt is the system prompt for technical summary generation.
+const technicalSummarySystemPrompt = `You are an expert Site Reliability Engineer assistant that writes technical summaries of incident analyses for the engineers who will follow up on them. Be precise and complete: keep resource names, error messages, metrics, and commands exactly as they appear in the analysis.`
+
+// technicalSummaryLanguageTemplate asks for the technical summary in the
+// configured language. %s = output language.
+const technicalSummaryLanguageTemplate = `Write the summary in %s. Keep section headings, commands, resource names, error messages, and log excerpts in their original form.`
+
+// technicalSummaryUserTemplate is the user prompt for technical summary
+// generation. %s = final analysis text.
+const technicalSummaryUserTemplate = `Write a technical summary of this incident analysis with these sections:
+
+## Root Cause
+The cause the analysis identified, with its confidence. Say so if it is unconfirmed.
+
+## Affected Components
+The services, resources, and environments involved.
+
+## Key Evidence
+The findings that support the root cause: errors, metrics, events, and log lines.
+
+## Next Steps
+A numbered list of the remediation and follow-up steps the analysis recommends, most urgent first.
+
+RULES:
+- Only use what is EXPLICITLY stated in the analysis; write "Not determined" for a section it does not cover
+- Do NOT invent next steps the analysis does not mention
+- Stay under 400 words
+
+Analysis to summarize:
+
+=================================================================================
+%s
+=================================================================================
+
+Technical Summary:`
+
+// outputLanguageTemplate asks agents to write their final answer in the
+// configured language. %s = output language.
+const outputLanguageTemplate = `## Output Language
+
+Write your final answer in %s. This applies only to the response shown to users: think, call tools, and pass tool arguments in whatever language works best. Keep commands, resource names, error messages, and log excerpts in their original form.`
+
+// executiveSummaryUserTemplate is the built-in user prompt for executive
+// summary generation, in the placeholder syntax of a chain's
+// executive_summary.prompt_template.
+const executiveSummaryUserTemplate = `Generate a 1-{max_lines} line executive summary of this incident analysis.
 
 CRITICAL RULES:
 - Only summarize what is EXPLICITLY stated in the analysis
@@ -122,10 +226,12 @@ CRITICAL RULES:
 - Do NOT add your own conclusions
 - Focus on: what happened, current status, and ONLY stated next steps
 
+` + ExecSummarySeveritySchema + `
+
 Analysis to summarize:
 
 =================================================================================
-%s
+{analysis}
 =================================================================================
 
-Executive Summary (1-4 lines, facts only):`
+Executive Summary (1-{max_lines} lines, facts only):`
@@ -0,0 +1,78 @@
+package agent
+
+import (
+	"sync"
+	"time"
+)
+
+// ProviderHealth tracks LLM call outcomes per provider across all sessions
+// handled by this process. A provider is degraded once it accumulates
+// threshold consecutive failures, and stays degraded until a call succeeds
+// or cooldown passes without a new failure.
+//
+// All methods are nil-safe: a nil *ProviderHealth records nothing and never
+// reports a provider as degraded.
+type ProviderHealth struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	providers map[string]*providerHealthState
+}
+
+type providerHealthState struct {
+	consecutiveFailures int
+	lastFailure         time.Time
+}
+
+// NewProviderHealth creates a tracker with the given failure threshold and
+// cooldown (see config.DegradationConfig).
+func NewProviderHealth(threshold int, cooldown time.Duration) *ProviderHealth {
+	return &ProviderHealth{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		providers: make(map[string]*providerHealthState),
+	}
+}
+
+// RecordSuccess resets the provider's failure streak.
+func (h *ProviderHealth) RecordSuccess(provider string) {
+	if h == nil || provider == "" {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.providers, provider)
+}
+
+// RecordFailure extends the provider's failure streak.
+func (h *ProviderHealth) RecordFailure(provider string) {
+	if h == nil || provider == "" {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	st, ok := h.providers[provider]
+	if !ok || h.now().Sub(st.lastFailure) > h.cooldown {
+		st = &providerHealthState{}
+		h.providers[provider] = st
+	}
+	st.consecutiveFailures++
+	st.lastFailure = h.now()
+}
+
+// Degraded reports whether provider is currently considered failing.
+func (h *ProviderHealth) Degraded(provider string) bool {
+	if h == nil || provider == "" {
+		return false
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	st, ok := h.providers[provider]
+	if !ok {
+		return false
+	}
+	return st.consecutiveFailures >= h.threshold && h.now().Sub(st.lastFailure) <= h.cooldown
+}
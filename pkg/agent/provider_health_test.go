@@ -0,0 +1,58 @@
+package agent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderHealth(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	newHealth := func() *ProviderHealth {
+		h := NewProviderHealth(3, 5*time.Minute)
+		h.now = func() time.Time { return now }
+		return h
+	}
+
+	t.Run("degraded after threshold consecutive failures", func(t *testing.T) {
+		h := newHealth()
+		h.RecordFailure("gemini")
+		h.RecordFailure("gemini")
+		assert.False(t, h.Degraded("gemini"))
+		h.RecordFailure("gemini")
+		assert.True(t, h.Degraded("gemini"))
+		assert.False(t, h.Degraded("openai"))
+	})
+
+	t.Run("success resets the streak", func(t *testing.T) {
+		h := newHealth()
+		for range 3 {
+			h.RecordFailure("gemini")
+		}
+		h.RecordSuccess("gemini")
+		assert.False(t, h.Degraded("gemini"))
+		h.RecordFailure("gemini")
+		assert.False(t, h.Degraded("gemini"))
+	})
+
+	t.Run("recovers after cooldown without failures", func(t *testing.T) {
+		h := newHealth()
+		for range 3 {
+			h.RecordFailure("gemini")
+		}
+		now = now.Add(6 * time.Minute)
+		assert.False(t, h.Degraded("gemini"))
+
+		// A stale streak does not carry over into a new one.
+		h.RecordFailure("gemini")
+		assert.False(t, h.Degraded("gemini"))
+	})
+
+	t.Run("nil tracker is inert", func(t *testing.T) {
+		var h *ProviderHealth
+		h.RecordFailure("gemini")
+		h.RecordSuccess("gemini")
+		assert.False(t, h.Degraded("gemini"))
+	})
+}
@@ -9,6 +9,8 @@ import (
 type ToolExecutor interface {
 	// Execute runs a single tool call and returns the result.
 	// The result is always a string (tool output or error message).
+	// Must be safe for concurrent use: tool calls from the same LLM
+	// iteration may execute in parallel.
 	Execute(ctx context.Context, call ToolCall) (*ToolResult, error)
 
 	// ListTools returns available tool definitions for the current execution.
@@ -27,6 +29,12 @@ type ToolResult struct {
 	Content string // Tool output (text)
 	IsError bool   // Whether the tool returned an error
 
+	// ServerLog is the stderr a stdio MCP server wrote during the call,
+	// masked and capped at mcp.ServerLogMaxBytes (tail kept). Empty for
+	// other transports. Stored on the MCP interaction record for debugging;
+	// never shown to the LLM.
+	ServerLog string
+
 	// RequiredSummarization signals that the tool's raw result must always
 	// be summarized by an LLM before being returned to the agent.
 	//
@@ -47,6 +55,11 @@ type ToolResult struct {
 	// runs the LLM call, records the interaction, and replaces Content with
 	// the summary in both the timeline event and the agent conversation.
 	RequiredSummarization *SummarizationRequest
+
+	// Highlight marks the tool call's timeline event (e.g. "key_finding"
+	// from mark_key_finding). Empty leaves the framework default: failed
+	// calls are highlighted as warnings, others are not highlighted.
+	Highlight string
 }
 
 // SummarizationRequest carries the LLM prompts for a required summarization.
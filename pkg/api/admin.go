@@ -0,0 +1,26 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+)
+
+// requireAdmin restricts admin endpoints to callers listed in system.admins.
+// Identity comes from the auth proxy headers. Without admins the endpoints
+// are disabled (404).
+func (s *Server) requireAdmin(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		if s.cfg == nil || len(s.cfg.Admins) == 0 {
+			return echo.NewHTTPError(http.StatusNotFound, "admin endpoints are not enabled")
+		}
+		id := extractIdentity(c)
+		if !s.cfg.IsAdmin(id.Subject, id.Email, id.PreferredUsername) {
+			slog.Warn("Rejected admin request from non-admin",
+				"path", c.Request().URL.Path, "caller", extractAuthor(c))
+			return echo.NewHTTPError(http.StatusForbidden, "this endpoint requires an admin")
+		}
+		return next(c)
+	}
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	ok := func(c *echo.Context) error { return c.NoContent(http.StatusOK) }
+	admins := &config.Config{Admins: []string{"sre-lead@example.com", "system:serviceaccount:tarsy:ops"}}
+
+	tests := []struct {
+		name     string
+		cfg      *config.Config
+		headers  map[string]string
+		wantCode int
+	}{
+		{name: "not found without admins", cfg: &config.Config{}, headers: map[string]string{"X-Forwarded-Email": "sre-lead@example.com"}, wantCode: http.StatusNotFound},
+		{name: "not found without config", cfg: nil, wantCode: http.StatusNotFound},
+		{name: "anonymous rejected", cfg: admins, wantCode: http.StatusForbidden},
+		{name: "non-admin rejected", cfg: admins, headers: map[string]string{"X-Forwarded-Email": "bob@example.com"}, wantCode: http.StatusForbidden},
+		{name: "admin allowed", cfg: admins, headers: map[string]string{"X-Forwarded-Email": "sre-lead@example.com"}, wantCode: http.StatusOK},
+		{name: "service account allowed", cfg: admins, headers: map[string]string{"X-Remote-User": "system:serviceaccount:tarsy:ops"}, wantCode: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/system/log-levels", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			err := (&Server{cfg: tt.cfg}).requireAdmin(ok)(echo.New().NewContext(req, rec))
+			if tt.wantCode == http.StatusOK {
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				return
+			}
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, tt.wantCode, httpErr.Code)
+		})
+	}
+}
+
+func TestAdminRoutes_NonAdminForbidden(t *testing.T) {
+	cfg := &config.Config{Admins: []string{"sre-lead@example.com"}}
+	s := NewServer(cfg, nil, nil, nil, nil, nil)
+
+	for _, route := range []struct {
+		method, path, body string
+	}{
+		{http.MethodPut, "/api/v1/system/log-levels", `{"levels": {"mcp": "debug"}}`},
+		{http.MethodPut, "/api/v1/system/feature-flags/fan_out", `{"enabled": false}`},
+		{http.MethodDelete, "/api/v1/system/feature-flags/fan_out", ""},
+		{http.MethodPut, "/api/v1/system/queue/pause", `{"reason": "deploy"}`},
+		{http.MethodDelete, "/api/v1/system/queue/pause", ""},
+		{http.MethodPut, "/api/v1/system/fault-injection", `{"llm_rate_limit_rate": 0.5}`},
+		{http.MethodGet, "/api/v1/system/registrations", ""},
+		{http.MethodPut, "/api/v1/system/registrations/agents/x", `{"definition": "description: x"}`},
+		{http.MethodDelete, "/api/v1/system/registrations/agents/x", ""},
+		{http.MethodPost, "/api/v1/system/drain/pod-a", `{"reason": "scale down"}`},
+		{http.MethodGet, "/api/v1/system/drain/pod-a", ""},
+		{http.MethodDelete, "/api/v1/system/drain/pod-a", ""},
+		{http.MethodGet, "/api/v1/debug/pprof", ""},
+		{http.MethodGet, "/api/v1/debug/pprof/heap", ""},
+	} {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			req := httptest.NewRequest(route.method, route.path, strings.NewReader(route.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Forwarded-Email", "bob@example.com")
+			rec := httptest.NewRecorder()
+			s.echo.ServeHTTP(rec, req)
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		})
+	}
+}
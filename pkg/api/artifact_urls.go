@@ -0,0 +1,107 @@
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// artifactURLSigner issues and verifies the signed download URLs of trace
+// artifacts. The signature is an HMAC-SHA256 over the session, interaction
+// and expiry, so a URL grants access to exactly one payload until it
+// expires. Nil-safe: a nil signer issues no URLs and rejects all.
+type artifactURLSigner struct {
+	key []byte
+	ttl time.Duration
+	now func() time.Time
+}
+
+// newArtifactURLSigner reads the signing key from system.blob_store
+// signing_key_env. Without one, URLs are signed with a random per-process
+// key and only verify on the replica that issued them.
+func newArtifactURLSigner(cfg *config.BlobStoreConfig) *artifactURLSigner {
+	if cfg == nil {
+		cfg = config.DefaultBlobStoreConfig()
+	}
+	key := []byte(os.Getenv(cfg.SigningKeyEnv))
+	if len(key) == 0 {
+		slog.Warn("Blob signing key not set, trace download URLs are only valid on this replica",
+			"env", cfg.SigningKeyEnv)
+		key = make([]byte, 32)
+		_, _ = rand.Read(key) // never fails since Go 1.24
+	}
+	return &artifactURLSigner{key: key, ttl: cfg.DownloadURLTTL, now: time.Now}
+}
+
+// toolResultURL returns the signed download URL of an MCP tool result and
+// its expiry. The URL is relative to the API host.
+func (a *artifactURLSigner) toolResultURL(sessionID, interactionID string) (string, time.Time) {
+	expiresAt := a.now().Add(a.ttl).Truncate(time.Second)
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	query := url.Values{
+		"expires":   {expires},
+		"signature": {a.signature(sessionID, interactionID, expires)},
+	}
+	return fmt.Sprintf("/api/v1/sessions/%s/trace/mcp/%s/result?%s",
+		url.PathEscape(sessionID), url.PathEscape(interactionID), query.Encode()), expiresAt
+}
+
+// verify checks the expires and signature query parameters of a download
+// URL issued for sessionID and interactionID.
+func (a *artifactURLSigner) verify(sessionID, interactionID, expires, signature string) error {
+	if a == nil {
+		return errors.New("download URLs are not configured")
+	}
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return errors.New("invalid expires parameter")
+	}
+	want := a.signature(sessionID, interactionID, expires)
+	if !hmac.Equal([]byte(signature), []byte(want)) {
+		return errors.New("invalid signature")
+	}
+	if !a.now().Before(time.Unix(unix, 0)) {
+		return errors.New("download URL has expired")
+	}
+	return nil
+}
+
+func (a *artifactURLSigner) signature(sessionID, interactionID, expires string) string {
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(sessionID + "\n" + interactionID + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signTrace sets the download URL of every artifact in the trace, including
+// those of sub-agent executions.
+func (a *artifactURLSigner) signTrace(sessionID string, trace *models.TraceListResponse) {
+	if a == nil || trace == nil {
+		return
+	}
+	var sign func(groups []models.TraceExecutionGroup)
+	sign = func(groups []models.TraceExecutionGroup) {
+		for i := range groups {
+			for j := range groups[i].Artifacts {
+				art := &groups[i].Artifacts[j]
+				u, expiresAt := a.toolResultURL(sessionID, art.InteractionID)
+				art.DownloadURL = u
+				art.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+			}
+			sign(groups[i].SubAgents)
+		}
+	}
+	for i := range trace.Stages {
+		sign(trace.Stages[i].Executions)
+	}
+}
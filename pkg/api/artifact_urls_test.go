@@ -0,0 +1,100 @@
+package api
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+func TestArtifactURLSigner(t *testing.T) {
+	t.Setenv("TEST_BLOB_SIGNING_KEY", "secret")
+	cfg := config.DefaultBlobStoreConfig()
+	cfg.SigningKeyEnv = "TEST_BLOB_SIGNING_KEY"
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	signer := newArtifactURLSigner(cfg)
+	signer.now = func() time.Time { return now }
+
+	raw, expiresAt := signer.toolResultURL("s1", "mcp-1")
+	assert.Equal(t, now.Add(config.DefaultDownloadURLTTL), expiresAt)
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/sessions/s1/trace/mcp/mcp-1/result", u.Path)
+	expires, signature := u.Query().Get("expires"), u.Query().Get("signature")
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, signer.verify("s1", "mcp-1", expires, signature))
+	})
+
+	t.Run("same key on another replica", func(t *testing.T) {
+		other := newArtifactURLSigner(cfg)
+		other.now = signer.now
+		assert.NoError(t, other.verify("s1", "mcp-1", expires, signature))
+	})
+
+	t.Run("other interaction", func(t *testing.T) {
+		assert.ErrorContains(t, signer.verify("s1", "mcp-2", expires, signature), "invalid signature")
+	})
+
+	t.Run("tampered expiry", func(t *testing.T) {
+		assert.ErrorContains(t, signer.verify("s1", "mcp-1", expires+"0", signature), "invalid signature")
+	})
+
+	t.Run("malformed expiry", func(t *testing.T) {
+		assert.ErrorContains(t, signer.verify("s1", "mcp-1", "soon", signature), "invalid expires")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		signer.now = func() time.Time { return expiresAt }
+		defer func() { signer.now = func() time.Time { return now } }()
+		assert.ErrorContains(t, signer.verify("s1", "mcp-1", expires, signature), "expired")
+	})
+
+	t.Run("nil signer rejects", func(t *testing.T) {
+		var nilSigner *artifactURLSigner
+		assert.Error(t, nilSigner.verify("s1", "mcp-1", expires, signature))
+	})
+}
+
+func TestArtifactURLSigner_RandomKey(t *testing.T) {
+	cfg := config.DefaultBlobStoreConfig()
+	cfg.SigningKeyEnv = "TEST_BLOB_SIGNING_KEY_UNSET"
+
+	a, b := newArtifactURLSigner(cfg), newArtifactURLSigner(cfg)
+	raw, _ := a.toolResultURL("s1", "mcp-1")
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	assert.NoError(t, a.verify("s1", "mcp-1", u.Query().Get("expires"), u.Query().Get("signature")))
+	assert.Error(t, b.verify("s1", "mcp-1", u.Query().Get("expires"), u.Query().Get("signature")))
+}
+
+func TestArtifactURLSigner_SignTrace(t *testing.T) {
+	signer := newArtifactURLSigner(config.DefaultBlobStoreConfig())
+	trace := &models.TraceListResponse{
+		Stages: []models.TraceStageGroup{{
+			Executions: []models.TraceExecutionGroup{{
+				Artifacts: []models.TraceArtifact{{InteractionID: "mcp-1"}},
+				SubAgents: []models.TraceExecutionGroup{{
+					Artifacts: []models.TraceArtifact{{InteractionID: "mcp-2"}},
+				}},
+			}},
+		}},
+	}
+
+	signer.signTrace("s1", trace)
+
+	exec := trace.Stages[0].Executions[0]
+	assert.True(t, strings.HasPrefix(exec.Artifacts[0].DownloadURL, "/api/v1/sessions/s1/trace/mcp/mcp-1/result?"))
+	assert.NotEmpty(t, exec.Artifacts[0].ExpiresAt)
+	assert.True(t, strings.HasPrefix(exec.SubAgents[0].Artifacts[0].DownloadURL, "/api/v1/sessions/s1/trace/mcp/mcp-2/result?"))
+
+	var nilSigner *artifactURLSigner
+	nilSigner.signTrace("s1", trace) // no-op
+}
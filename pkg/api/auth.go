@@ -1,7 +1,11 @@
 package api
 
 import (
+	"log/slog"
+
 	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/models"
 )
 
 // extractAuthor extracts the author from proxy headers.
@@ -19,3 +23,42 @@ func extractAuthor(c *echo.Context) string {
 	}
 	return "api-client"
 }
+
+// extractIdentity extracts the caller identity from proxy headers. With an
+// OIDC provider (e.g. Keycloak) oauth2-proxy forwards the subject claim as
+// X-Forwarded-User; kube-rbac-proxy forwards the service account as
+// X-Remote-User. Subject is empty for unauthenticated API clients.
+func extractIdentity(c *echo.Context) models.UserIdentity {
+	h := c.Request().Header
+	subject := h.Get("X-Forwarded-User")
+	if subject == "" {
+		subject = h.Get("X-Remote-User")
+	}
+	return models.UserIdentity{
+		Subject:           subject,
+		Email:             h.Get("X-Forwarded-Email"),
+		PreferredUsername: h.Get("X-Forwarded-Preferred-Username"),
+	}
+}
+
+// resolveAuthor returns the author name to record for the caller and the
+// OIDC subject it belongs to. The profile display name is preferred when the
+// caller has one; otherwise it falls back to extractAuthor. Profile lookup
+// failures are logged and never block the request.
+func (s *Server) resolveAuthor(c *echo.Context) (author, subject string) {
+	author = extractAuthor(c)
+	subject = extractIdentity(c).Subject
+	if s.userProfileService == nil || subject == "" {
+		return author, subject
+	}
+	name, err := s.userProfileService.DisplayName(c.Request().Context(), subject)
+	if err != nil {
+		slog.WarnContext(c.Request().Context(), "Failed to resolve profile display name, using proxy identity",
+			"subject", subject, "error", err)
+		return author, subject
+	}
+	if name != "" {
+		author = name
+	}
+	return author, subject
+}
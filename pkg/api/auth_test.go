@@ -67,3 +67,44 @@ func TestExtractAuthor(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractIdentity(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Forwarded-User", "f4c1e6d2-sub")
+	req.Header.Set("X-Forwarded-Email", "alice@example.com")
+	req.Header.Set("X-Forwarded-Preferred-Username", "alice")
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	id := extractIdentity(c)
+	assert.Equal(t, "f4c1e6d2-sub", id.Subject)
+	assert.Equal(t, "alice@example.com", id.Email)
+	assert.Equal(t, "alice", id.PreferredUsername)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Remote-User", "system:serviceaccount:ns:sa")
+	id = extractIdentity(e.NewContext(req, httptest.NewRecorder()))
+	assert.Equal(t, "system:serviceaccount:ns:sa", id.Subject)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Forwarded-Email", "bob@example.com")
+	id = extractIdentity(e.NewContext(req, httptest.NewRecorder()))
+	assert.Empty(t, id.Subject, "email alone is not a subject")
+}
+
+func TestResolveAuthor_WithoutProfileService(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Forwarded-User", "alice")
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	author, subject := s.resolveAuthor(c)
+	assert.Equal(t, "alice", author)
+	assert.Equal(t, "alice", subject)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	author, subject = s.resolveAuthor(e.NewContext(req, httptest.NewRecorder()))
+	assert.Equal(t, "api-client", author)
+	assert.Empty(t, subject)
+}
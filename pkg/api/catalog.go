@@ -0,0 +1,395 @@
+package api
+
+import (
+	"slices"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// --- Catalog DTOs ---
+
+// CatalogChainsResponse is returned by GET /api/v1/catalog/chains.
+type CatalogChainsResponse struct {
+	Window models.UsageWindow `json:"window"`
+	Chains []CatalogChainView `json:"chains"`
+}
+
+// CatalogAgentsResponse is returned by GET /api/v1/catalog/agents.
+type CatalogAgentsResponse struct {
+	Window models.UsageWindow `json:"window"`
+	Agents []CatalogAgentView `json:"agents"`
+}
+
+// CatalogMCPServersResponse is returned by GET /api/v1/catalog/mcp-servers.
+type CatalogMCPServersResponse struct {
+	Window     models.UsageWindow     `json:"window"`
+	MCPServers []CatalogMCPServerView `json:"mcp_servers"`
+}
+
+// CatalogChainView is a chain with its stages resolved against defaults.
+type CatalogChainView struct {
+	ChainID     string              `json:"chain_id"`
+	Description string              `json:"description,omitempty"`
+	AlertTypes  []string            `json:"alert_types"`
+	Stages      []CatalogStageView  `json:"stages"`
+	SubAgents   []string            `json:"sub_agents,omitempty"`
+	MCPServers  []string            `json:"mcp_servers"`
+	Usage       models.CatalogUsage `json:"usage"`
+}
+
+// CatalogStageView is a chain stage with resolved agents.
+type CatalogStageView struct {
+	Name   string                     `json:"name"`
+	Agents []CatalogResolvedAgentView `json:"agents"`
+}
+
+// CatalogResolvedAgentView is a stage agent after applying the
+// defaults → agent → chain → stage → stage-agent hierarchy.
+type CatalogResolvedAgentView struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type,omitempty"`
+	LLMProvider   string   `json:"llm_provider,omitempty"`
+	LLMBackend    string   `json:"llm_backend,omitempty"`
+	MaxIterations int      `json:"max_iterations,omitempty"`
+	MCPServers    []string `json:"mcp_servers,omitempty"`
+	ResolveError  string   `json:"resolve_error,omitempty"`
+	// CapabilityWarnings lists the fallbacks applied because the selected
+	// model lacks a capability the agent needs.
+	CapabilityWarnings []string `json:"capability_warnings,omitempty"`
+}
+
+// CatalogAgentView is an agent definition with the chains that reference it.
+type CatalogAgentView struct {
+	Name          string              `json:"name"`
+	Type          string              `json:"type,omitempty"`
+	Description   string              `json:"description,omitempty"`
+	LLMBackend    string              `json:"llm_backend"`
+	MaxIterations int                 `json:"max_iterations"`
+	MCPServers    []string            `json:"mcp_servers,omitempty"`
+	Chains        []string            `json:"chains"`
+	AlertTypes    []string            `json:"alert_types"`
+	Usage         models.CatalogUsage `json:"usage"`
+}
+
+// CatalogMCPServerView is an MCP server with the agents and chains using it.
+type CatalogMCPServerView struct {
+	ID            string              `json:"id"`
+	TransportType string              `json:"transport_type"`
+	Agents        []string            `json:"agents"`
+	Chains        []string            `json:"chains"`
+	AlertTypes    []string            `json:"alert_types"`
+	Usage         models.CatalogUsage `json:"usage"`
+}
+
+// CatalogMCPToolsResponse is returned by GET /api/v1/catalog/mcp-tools.
+type CatalogMCPToolsResponse struct {
+	Window  models.UsageWindow         `json:"window"`
+	Servers []CatalogMCPToolServerView `json:"servers"`
+}
+
+// CatalogMCPToolServerView is a configured MCP server with its tools.
+// Healthy is nil when health monitoring is disabled or hasn't checked the
+// server yet; ToolsDiscovered is false when no tool list is cached.
+type CatalogMCPToolServerView struct {
+	ID              string               `json:"id"`
+	Healthy         *bool                `json:"healthy"`
+	ToolsDiscovered bool                 `json:"tools_discovered"`
+	TotalCalls      int                  `json:"total_calls"`
+	Tools           []CatalogMCPToolView `json:"tools"`
+}
+
+// CatalogMCPToolView is a single tool with its schema and usage. Discovered
+// is false for tools that were called in the window but are no longer
+// advertised by the server.
+type CatalogMCPToolView struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	InputSchema any                 `json:"input_schema,omitempty"`
+	Discovered  bool                `json:"discovered"`
+	Usage       models.MCPToolUsage `json:"usage"`
+}
+
+// --- Builders ---
+
+// catalogRefs records which agents and MCP servers each chain references.
+type catalogRefs struct {
+	chainAgents  map[string][]string // chain ID → agent names
+	chainServers map[string][]string // chain ID → MCP server IDs
+	agentServers map[string][]string // agent name → MCP server IDs (definition + resolved)
+}
+
+// buildCatalogChains returns every configured chain, sorted by ID, with
+// resolved stage agents and usage (zero when absent from usage).
+func buildCatalogChains(cfg *config.Config, usage map[string]models.CatalogUsage) ([]CatalogChainView, catalogRefs) {
+	refs := catalogRefs{
+		chainAgents:  map[string][]string{},
+		chainServers: map[string][]string{},
+		agentServers: map[string][]string{},
+	}
+	if cfg == nil || cfg.ChainRegistry == nil {
+		return []CatalogChainView{}, refs
+	}
+
+	chains := cfg.ChainRegistry.GetAll()
+	out := make([]CatalogChainView, 0, len(chains))
+	for _, id := range sortedKeys(chains) {
+		chain := chains[id]
+		view := CatalogChainView{
+			ChainID:     id,
+			Description: chain.Description,
+			AlertTypes:  sortedCopy(chain.AlertTypes),
+			Stages:      make([]CatalogStageView, 0, len(chain.Stages)),
+			Usage:       usage[id],
+		}
+
+		var agents, servers, subAgents []string
+		addServers := func(agentName string, ids []string) {
+			servers = append(servers, ids...)
+			refs.agentServers[agentName] = append(refs.agentServers[agentName], ids...)
+		}
+
+		for _, stage := range chain.Stages {
+			sv := CatalogStageView{Name: stage.Name, Agents: make([]CatalogResolvedAgentView, 0, len(stage.Agents))}
+			for _, sa := range stage.Agents {
+				agents = append(agents, sa.Name)
+				av := CatalogResolvedAgentView{Name: sa.Name}
+				resolved, err := agent.ResolveAgentConfig(cfg, chain, stage, sa)
+				if err != nil {
+					av.ResolveError = err.Error()
+				} else {
+					av.Type = string(resolved.Type)
+					av.LLMProvider = resolved.LLMProviderName
+					av.LLMBackend = string(resolved.LLMBackend)
+					av.MaxIterations = resolved.MaxIterations
+					av.MCPServers = resolved.MCPServers
+					av.CapabilityWarnings = resolved.CapabilityWarnings
+					addServers(sa.Name, resolved.MCPServers)
+				}
+				sv.Agents = append(sv.Agents, av)
+				subAgents = append(subAgents, subAgentNames(sa.SubAgents)...)
+			}
+			if stage.Synthesis != nil && stage.Synthesis.Agent != "" {
+				agents = append(agents, stage.Synthesis.Agent)
+			}
+			subAgents = append(subAgents, subAgentNames(stage.SubAgents)...)
+			view.Stages = append(view.Stages, sv)
+		}
+		subAgents = append(subAgents, subAgentNames(chain.SubAgents)...)
+
+		for _, name := range subAgents {
+			if def, err := cfg.GetAgent(name); err == nil {
+				addServers(name, def.MCPServers)
+			}
+		}
+		agents = append(agents, subAgents...)
+
+		view.SubAgents = dedupeSorted(subAgents)
+		view.MCPServers = dedupeSorted(servers)
+		refs.chainAgents[id] = dedupeSorted(agents)
+		refs.chainServers[id] = view.MCPServers
+		out = append(out, view)
+	}
+	return out, refs
+}
+
+// buildCatalogAgents returns every configured agent, sorted by name, with the
+// chains (and their alert types) that reference it as a stage, synthesis, or
+// sub-agent.
+func buildCatalogAgents(cfg *config.Config, refs catalogRefs, usage map[string]models.CatalogUsage) []CatalogAgentView {
+	if cfg == nil || cfg.AgentRegistry == nil {
+		return []CatalogAgentView{}
+	}
+
+	var defaults config.Defaults
+	if cfg.Defaults != nil {
+		defaults = *cfg.Defaults
+	}
+
+	chainsByAgent := invertRefs(refs.chainAgents)
+	agents := cfg.AgentRegistry.GetAll()
+	out := make([]CatalogAgentView, 0, len(agents))
+	for _, name := range sortedKeys(agents) {
+		def := agents[name]
+
+		backend := agent.DefaultLLMBackend
+		if defaults.LLMBackend != "" {
+			backend = defaults.LLMBackend
+		}
+		if def.LLMBackend != "" {
+			backend = def.LLMBackend
+		}
+		maxIter := agent.DefaultMaxIterations
+		if defaults.MaxIterations != nil {
+			maxIter = *defaults.MaxIterations
+		}
+		if def.MaxIterations != nil {
+			maxIter = *def.MaxIterations
+		}
+
+		chainIDs := dedupeSorted(chainsByAgent[name])
+		out = append(out, CatalogAgentView{
+			Name:          name,
+			Type:          string(def.Type),
+			Description:   def.Description,
+			LLMBackend:    string(backend),
+			MaxIterations: maxIter,
+			MCPServers:    def.MCPServers,
+			Chains:        chainIDs,
+			AlertTypes:    chainAlertTypes(cfg, chainIDs),
+			Usage:         usage[name],
+		})
+	}
+	return out
+}
+
+// buildCatalogMCPServers returns every configured MCP server, sorted by ID,
+// with the agents and chains that can call it.
+func buildCatalogMCPServers(cfg *config.Config, refs catalogRefs, usage map[string]models.CatalogUsage) []CatalogMCPServerView {
+	if cfg == nil || cfg.MCPServerRegistry == nil {
+		return []CatalogMCPServerView{}
+	}
+
+	agentServers := make(map[string][]string, len(refs.agentServers))
+	for name, ids := range refs.agentServers {
+		agentServers[name] = ids
+	}
+	if cfg.AgentRegistry != nil {
+		for name, def := range cfg.AgentRegistry.GetAll() {
+			agentServers[name] = append(agentServers[name], def.MCPServers...)
+		}
+	}
+	agentsByServer := invertRefs(agentServers)
+	chainsByServer := invertRefs(refs.chainServers)
+
+	servers := cfg.MCPServerRegistry.GetAll()
+	out := make([]CatalogMCPServerView, 0, len(servers))
+	for _, id := range sortedKeys(servers) {
+		chainIDs := dedupeSorted(chainsByServer[id])
+		out = append(out, CatalogMCPServerView{
+			ID:            id,
+			TransportType: string(servers[id].Transport.Type),
+			Agents:        dedupeSorted(agentsByServer[id]),
+			Chains:        chainIDs,
+			AlertTypes:    chainAlertTypes(cfg, chainIDs),
+			Usage:         usage[id],
+		})
+	}
+	return out
+}
+
+// buildCatalogMCPTools merges configured servers, the tool lists cached by
+// the health monitor, and per-tool usage. Tools are sorted by call count
+// (descending), then name, so unused tools sink to the bottom. serverID
+// limits the result to one server when non-empty.
+func buildCatalogMCPTools(
+	cfg *config.Config,
+	statuses map[string]*mcp.HealthStatus,
+	cachedTools map[string][]*mcpsdk.Tool,
+	usage map[string]map[string]models.MCPToolUsage,
+	serverID string,
+) []CatalogMCPToolServerView {
+	if cfg == nil || cfg.MCPServerRegistry == nil {
+		return []CatalogMCPToolServerView{}
+	}
+
+	servers := cfg.MCPServerRegistry.GetAll()
+	out := make([]CatalogMCPToolServerView, 0, len(servers))
+	for _, id := range sortedKeys(servers) {
+		if serverID != "" && id != serverID {
+			continue
+		}
+		view := CatalogMCPToolServerView{ID: id, Tools: []CatalogMCPToolView{}}
+		if status, ok := statuses[id]; ok && status != nil {
+			healthy := status.Healthy
+			view.Healthy = &healthy
+		}
+
+		toolUsage := usage[id]
+		seen := map[string]bool{}
+		if tools, ok := cachedTools[id]; ok {
+			view.ToolsDiscovered = true
+			for _, t := range tools {
+				seen[t.Name] = true
+				view.Tools = append(view.Tools, CatalogMCPToolView{
+					Name:        t.Name,
+					Description: t.Description,
+					InputSchema: t.InputSchema,
+					Discovered:  true,
+					Usage:       toolUsage[t.Name],
+				})
+			}
+		}
+		for name, u := range toolUsage {
+			view.TotalCalls += u.Calls
+			if !seen[name] {
+				view.Tools = append(view.Tools, CatalogMCPToolView{Name: name, Usage: u})
+			}
+		}
+
+		slices.SortFunc(view.Tools, func(a, b CatalogMCPToolView) int {
+			if a.Usage.Calls != b.Usage.Calls {
+				return b.Usage.Calls - a.Usage.Calls
+			}
+			if a.Name < b.Name {
+				return -1
+			}
+			if a.Name > b.Name {
+				return 1
+			}
+			return 0
+		})
+		out = append(out, view)
+	}
+	return out
+}
+
+func subAgentNames(refs config.SubAgentRefs) []string {
+	names := make([]string, 0, len(refs))
+	for _, r := range refs {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+// invertRefs turns key → values into value → keys. Callers sort and
+// deduplicate on lookup with dedupeSorted.
+func invertRefs(refs map[string][]string) map[string][]string {
+	out := map[string][]string{}
+	for key, values := range refs {
+		for _, v := range values {
+			out[v] = append(out[v], key)
+		}
+	}
+	return out
+}
+
+// chainAlertTypes returns the sorted union of alert types for chainIDs.
+func chainAlertTypes(cfg *config.Config, chainIDs []string) []string {
+	var types []string
+	for _, id := range chainIDs {
+		if chain, err := cfg.GetChain(id); err == nil {
+			types = append(types, chain.AlertTypes...)
+		}
+	}
+	return dedupeSorted(types)
+}
+
+func sortedCopy(in []string) []string {
+	out := slices.Clone(in)
+	if out == nil {
+		out = []string{}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// dedupeSorted returns a sorted, deduplicated, non-nil copy of in so JSON
+// emits [] rather than null.
+func dedupeSorted(in []string) []string {
+	return slices.Compact(sortedCopy(in))
+}
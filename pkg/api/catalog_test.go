@@ -0,0 +1,242 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+func catalogTestConfig() *config.Config {
+	maxIter := 5
+	return &config.Config{
+		Defaults: &config.Defaults{
+			LLMProvider: "google-default",
+			LLMBackend:  config.LLMBackendNativeGemini,
+		},
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"google-default": {Type: config.LLMProviderTypeGoogle, Model: "gemini-2.5-pro"},
+			"fast":           {Type: config.LLMProviderTypeGoogle, Model: "gemini-2.5-flash"},
+		}),
+		AgentRegistry: config.NewAgentRegistry(map[string]*config.AgentConfig{
+			"KubernetesAgent": {Description: "K8s agent", MCPServers: []string{"kubernetes-server"}},
+			"LogAgent":        {MCPServers: []string{"loki"}, MaxIterations: &maxIter},
+			"Unused":          {LLMBackend: config.LLMBackendLangChain},
+		}),
+		MCPServerRegistry: config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+			"kubernetes-server": {Transport: config.TransportConfig{Type: config.TransportTypeStdio, Command: "npx"}},
+			"loki":              {Transport: config.TransportConfig{Type: config.TransportTypeHTTP, URL: "http://loki"}},
+			"orphan":            {Transport: config.TransportConfig{Type: config.TransportTypeStdio, Command: "x"}},
+		}),
+		ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{
+			"k8s-chain": {
+				AlertTypes:  []string{"PodCrash", "NodeDown"},
+				LLMProvider: "fast",
+				Stages: []config.StageConfig{
+					{
+						Name:      "investigate",
+						Agents:    []config.StageAgentConfig{{Name: "KubernetesAgent"}},
+						SubAgents: config.SubAgentRefs{{Name: "LogAgent"}},
+					},
+				},
+			},
+			"broken-chain": {
+				AlertTypes: []string{"Broken"},
+				Stages: []config.StageConfig{
+					{Name: "s1", Agents: []config.StageAgentConfig{{Name: "Missing"}}},
+				},
+			},
+		}),
+	}
+}
+
+func TestBuildCatalogChains(t *testing.T) {
+	cfg := catalogTestConfig()
+	rate := 0.5
+	usage := map[string]models.CatalogUsage{"k8s-chain": {Sessions: 4, SuccessRate: &rate}}
+
+	chains, refs := buildCatalogChains(cfg, usage)
+	require.Len(t, chains, 2)
+	assert.Equal(t, "broken-chain", chains[0].ChainID)
+	assert.Equal(t, "k8s-chain", chains[1].ChainID)
+
+	broken := chains[0]
+	require.Len(t, broken.Stages, 1)
+	assert.NotEmpty(t, broken.Stages[0].Agents[0].ResolveError)
+	assert.Zero(t, broken.Usage.Sessions)
+	assert.Equal(t, []string{}, broken.MCPServers)
+
+	k8s := chains[1]
+	assert.Equal(t, []string{"NodeDown", "PodCrash"}, k8s.AlertTypes)
+	assert.Equal(t, 4, k8s.Usage.Sessions)
+	assert.Equal(t, []string{"LogAgent"}, k8s.SubAgents)
+	assert.Equal(t, []string{"kubernetes-server", "loki"}, k8s.MCPServers)
+
+	resolved := k8s.Stages[0].Agents[0]
+	assert.Empty(t, resolved.ResolveError)
+	assert.Equal(t, "fast", resolved.LLMProvider)
+	assert.Equal(t, string(config.LLMBackendNativeGemini), resolved.LLMBackend)
+	assert.Equal(t, 20, resolved.MaxIterations)
+	assert.Equal(t, []string{"kubernetes-server"}, resolved.MCPServers)
+
+	assert.Equal(t, []string{"KubernetesAgent", "LogAgent"}, refs.chainAgents["k8s-chain"])
+}
+
+func TestBuildCatalogAgents(t *testing.T) {
+	cfg := catalogTestConfig()
+	_, refs := buildCatalogChains(cfg, nil)
+	agents := buildCatalogAgents(cfg, refs, map[string]models.CatalogUsage{"KubernetesAgent": {Sessions: 3, Invocations: 5}})
+
+	byName := map[string]CatalogAgentView{}
+	for _, a := range agents {
+		byName[a.Name] = a
+	}
+	require.Len(t, byName, 3)
+
+	k8s := byName["KubernetesAgent"]
+	assert.Equal(t, []string{"k8s-chain"}, k8s.Chains)
+	assert.Equal(t, []string{"NodeDown", "PodCrash"}, k8s.AlertTypes)
+	assert.Equal(t, string(config.LLMBackendNativeGemini), k8s.LLMBackend)
+	assert.Equal(t, 20, k8s.MaxIterations)
+	assert.Equal(t, 5, k8s.Usage.Invocations)
+
+	logAgent := byName["LogAgent"]
+	assert.Equal(t, []string{"k8s-chain"}, logAgent.Chains, "sub-agent references count")
+	assert.Equal(t, 5, logAgent.MaxIterations)
+
+	unused := byName["Unused"]
+	assert.Equal(t, []string{}, unused.Chains)
+	assert.Equal(t, []string{}, unused.AlertTypes)
+	assert.Equal(t, string(config.LLMBackendLangChain), unused.LLMBackend)
+}
+
+func TestBuildCatalogMCPServers(t *testing.T) {
+	cfg := catalogTestConfig()
+	_, refs := buildCatalogChains(cfg, nil)
+	servers := buildCatalogMCPServers(cfg, refs, nil)
+
+	require.Len(t, servers, 3)
+	assert.Equal(t, "kubernetes-server", servers[0].ID)
+	assert.Equal(t, []string{"KubernetesAgent"}, servers[0].Agents)
+	assert.Equal(t, []string{"k8s-chain"}, servers[0].Chains)
+	assert.Equal(t, []string{"NodeDown", "PodCrash"}, servers[0].AlertTypes)
+
+	assert.Equal(t, "loki", servers[1].ID)
+	assert.Equal(t, string(config.TransportTypeHTTP), servers[1].TransportType)
+	assert.Equal(t, []string{"LogAgent"}, servers[1].Agents)
+	assert.Equal(t, []string{"k8s-chain"}, servers[1].Chains)
+
+	assert.Equal(t, "orphan", servers[2].ID)
+	assert.Equal(t, []string{}, servers[2].Agents)
+	assert.Equal(t, []string{}, servers[2].Chains)
+}
+
+func TestBuildCatalogMCPTools(t *testing.T) {
+	cfg := catalogTestConfig()
+	errRate := 0.25
+	statuses := map[string]*mcp.HealthStatus{
+		"kubernetes-server": {ServerID: "kubernetes-server", Healthy: true},
+		"loki":              {ServerID: "loki", Healthy: false},
+	}
+	cached := map[string][]*mcpsdk.Tool{
+		"kubernetes-server": {
+			{Name: "pods_list", Description: "List pods", InputSchema: map[string]any{"type": "object"}},
+			{Name: "events_list", Description: "List events"},
+			{Name: "pods_log", Description: "Pod logs"},
+		},
+	}
+	usage := map[string]map[string]models.MCPToolUsage{
+		"kubernetes-server": {
+			"pods_log":    {Calls: 4, Sessions: 2, ErrorRate: &errRate},
+			"pods_list":   {Calls: 10, Sessions: 3},
+			"legacy_tool": {Calls: 1, Sessions: 1},
+		},
+	}
+
+	servers := buildCatalogMCPTools(cfg, statuses, cached, usage, "")
+	require.Len(t, servers, 3)
+
+	k8s := servers[0]
+	assert.Equal(t, "kubernetes-server", k8s.ID)
+	require.NotNil(t, k8s.Healthy)
+	assert.True(t, *k8s.Healthy)
+	assert.True(t, k8s.ToolsDiscovered)
+	assert.Equal(t, 15, k8s.TotalCalls)
+
+	names := make([]string, 0, len(k8s.Tools))
+	for _, tool := range k8s.Tools {
+		names = append(names, tool.Name)
+	}
+	assert.Equal(t, []string{"pods_list", "pods_log", "legacy_tool", "events_list"}, names, "sorted by calls, then name")
+	assert.Equal(t, map[string]any{"type": "object"}, k8s.Tools[0].InputSchema)
+	assert.True(t, k8s.Tools[0].Discovered)
+	assert.False(t, k8s.Tools[2].Discovered, "called but no longer advertised")
+	assert.Zero(t, k8s.Tools[3].Usage.Calls)
+
+	loki := servers[1]
+	require.NotNil(t, loki.Healthy)
+	assert.False(t, *loki.Healthy)
+	assert.False(t, loki.ToolsDiscovered)
+	assert.Equal(t, []CatalogMCPToolView{}, loki.Tools)
+
+	orphan := servers[2]
+	assert.Nil(t, orphan.Healthy)
+	assert.Zero(t, orphan.TotalCalls)
+
+	filtered := buildCatalogMCPTools(cfg, nil, nil, nil, "loki")
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "loki", filtered[0].ID)
+}
+
+func TestBuildCatalogNilConfig(t *testing.T) {
+	chains, refs := buildCatalogChains(nil, nil)
+	assert.Equal(t, []CatalogChainView{}, chains)
+	assert.Equal(t, []CatalogAgentView{}, buildCatalogAgents(nil, refs, nil))
+	assert.Equal(t, []CatalogMCPServerView{}, buildCatalogMCPServers(nil, refs, nil))
+	assert.Equal(t, []CatalogMCPToolServerView{}, buildCatalogMCPTools(nil, nil, nil, nil, ""))
+}
+
+func TestCatalogHandlers(t *testing.T) {
+	handlers := map[string]func(*Server, *echo.Context) error{
+		"chains":      (*Server).catalogChainsHandler,
+		"agents":      (*Server).catalogAgentsHandler,
+		"mcp-servers": (*Server).catalogMCPServersHandler,
+		"mcp-tools":   (*Server).catalogMCPToolsHandler,
+	}
+
+	for name, handler := range handlers {
+		t.Run(name+" returns 503 without session service", func(t *testing.T) {
+			s := &Server{cfg: catalogTestConfig()}
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/catalog/"+name, nil)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			err := handler(s, c)
+			var he *echo.HTTPError
+			require.ErrorAs(t, err, &he)
+			assert.Equal(t, http.StatusServiceUnavailable, he.Code)
+		})
+
+		t.Run(name+" rejects invalid days", func(t *testing.T) {
+			for _, days := range []string{"0", "366", "abc"} {
+				s := &Server{cfg: catalogTestConfig()}
+				e := echo.New()
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/catalog/"+name+"?days="+days, nil)
+				c := e.NewContext(req, httptest.NewRecorder())
+
+				err := handler(s, c)
+				var he *echo.HTTPError
+				require.ErrorAs(t, err, &he, "days=%s", days)
+				assert.Equal(t, http.StatusBadRequest, he.Code, "days=%s", days)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+const (
+	// consistencyTokenHeader carries the token of a previous write on GET
+	// requests (alternatively ?consistency_token).
+	consistencyTokenHeader = "X-Consistency-Token"
+	// consistencyStatusHeader tells whether the read reflects the token's
+	// write: "reflected", or "pending" when maxConsistencyWait ran out.
+	consistencyStatusHeader = "X-Consistency-Status"
+	// maxConsistencyWait bounds how long a GET waits for its token's write.
+	maxConsistencyWait = 5 * time.Second
+)
+
+// consistencyPollInterval is how often a waiting GET re-checks its token.
+// A variable so tests can shorten it.
+var consistencyPollInterval = 100 * time.Millisecond
+
+// consistencyMiddleware makes GETs that present a consistency token (returned
+// by submit, cancel and chat) wait until the token's write is reflected, up
+// to maxConsistencyWait, so the dashboard doesn't read a status from before
+// its own action. The read proceeds either way; the status header says
+// which.
+func (s *Server) consistencyMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			if c.Request().Method != http.MethodGet {
+				return next(c)
+			}
+			raw := c.Request().Header.Get(consistencyTokenHeader)
+			if raw == "" {
+				raw = c.QueryParam("consistency_token")
+			}
+			if raw == "" {
+				return next(c)
+			}
+			token, err := services.ParseConsistencyToken(raw)
+			if err != nil {
+				return mapServiceError(err)
+			}
+			if s.sessionService == nil {
+				return next(c)
+			}
+
+			status := "pending"
+			if s.awaitWrite(c, token) {
+				status = "reflected"
+			}
+			c.Response().Header().Set(consistencyStatusHeader, status)
+			return next(c)
+		}
+	}
+}
+
+// awaitWrite polls until the token's write is reflected or
+// maxConsistencyWait elapses. Check failures are logged and end the wait.
+func (s *Server) awaitWrite(c *echo.Context, token services.ConsistencyToken) bool {
+	ctx := c.Request().Context()
+	deadline := time.NewTimer(maxConsistencyWait)
+	defer deadline.Stop()
+	ticker := time.NewTicker(consistencyPollInterval)
+	defer ticker.Stop()
+	for {
+		reflected, err := s.sessionService.WriteReflected(ctx, token)
+		if err != nil {
+			slog.Warn("Failed to check consistency token, reading without waiting",
+				"session_id", token.SessionID, "kind", token.Kind, "error", err)
+			return false
+		}
+		if reflected {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-deadline.C:
+			return false
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+func TestConsistencyMiddleware(t *testing.T) {
+	valid := services.ConsistencyToken{Kind: services.ConsistencySubmit, SessionID: "s1"}.Encode()
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		header     string
+		wantCode   int
+		wantStatus string
+	}{
+		{name: "no token", method: http.MethodGet, target: "/x", wantCode: http.StatusOK},
+		{name: "malformed header token", method: http.MethodGet, target: "/x", header: "not-a-token", wantCode: http.StatusBadRequest},
+		{name: "malformed query token", method: http.MethodGet, target: "/x?consistency_token=bm9wZQ", wantCode: http.StatusBadRequest},
+		{name: "writes ignore tokens", method: http.MethodPost, target: "/x", header: "not-a-token", wantCode: http.StatusOK},
+		// Without a session service there is nothing to wait on.
+		{name: "valid token without session service", method: http.MethodGet, target: "/x", header: valid, wantCode: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{}
+			e := echo.New()
+			e.Use(s.consistencyMiddleware())
+			ok := func(c *echo.Context) error { return c.NoContent(http.StatusOK) }
+			e.GET("/x", ok)
+			e.POST("/x", ok)
+
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			if tt.header != "" {
+				req.Header.Set(consistencyTokenHeader, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, tt.wantStatus, rec.Header().Get(consistencyStatusHeader))
+		})
+	}
+}
@@ -14,7 +14,7 @@ import (
 func mapServiceError(err error) *echo.HTTPError {
 	var validErr *services.ValidationError
 	if errors.As(err, &validErr) {
-		return echo.NewHTTPError(http.StatusBadRequest, validErr.Error())
+		return invalidField(validErr.Field, "%s", validErr.Message)
 	}
 	if errors.Is(err, services.ErrNotFound) {
 		return echo.NewHTTPError(http.StatusNotFound, "resource not found")
@@ -22,12 +22,22 @@ func mapServiceError(err error) *echo.HTTPError {
 	if errors.Is(err, services.ErrNotCancellable) {
 		return echo.NewHTTPError(http.StatusConflict, "session is not in a cancellable state")
 	}
+	if errors.Is(err, services.ErrSessionNotActive) {
+		return echo.NewHTTPError(http.StatusConflict, "session is not pending or in progress")
+	}
 	if errors.Is(err, services.ErrAlreadyExists) {
 		return echo.NewHTTPError(http.StatusConflict, "resource already exists")
 	}
 	if errors.Is(err, services.ErrConflict) {
 		return echo.NewHTTPError(http.StatusConflict, "state conflict: session was modified concurrently")
 	}
+	if errors.Is(err, services.ErrTranscriptionFailed) {
+		slog.Warn("Voice note transcription failed", "error", err)
+		return echo.NewHTTPError(http.StatusBadGateway, "voice note transcription failed")
+	}
+	if errors.Is(err, services.ErrBudgetExceeded) {
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	}
 
 	// Unexpected error
 	slog.Error("Unexpected service error", "error", err)
@@ -36,12 +36,24 @@ func TestMapServiceError(t *testing.T) {
 			expectCode: http.StatusConflict,
 			expectMsg:  "session is not in a cancellable state",
 		},
+		{
+			name:       "budget exceeded maps to 422",
+			err:        fmt.Errorf("%w: chain 'k8s': estimated cost $4.20 exceeds max_cost_usd $3.00", services.ErrBudgetExceeded),
+			expectCode: http.StatusUnprocessableEntity,
+			expectMsg:  "estimated cost $4.20 exceeds max_cost_usd $3.00",
+		},
 		{
 			name:       "already exists maps to 409",
 			err:        fmt.Errorf("wrapped: %w", services.ErrAlreadyExists),
 			expectCode: http.StatusConflict,
 			expectMsg:  "resource already exists",
 		},
+		{
+			name:       "transcription failure maps to 502",
+			err:        fmt.Errorf("%w: status 500", services.ErrTranscriptionFailed),
+			expectCode: http.StatusBadGateway,
+			expectMsg:  "voice note transcription failed",
+		},
 		{
 			name:       "unknown error maps to 500",
 			err:        fmt.Errorf("something unexpected happened"),
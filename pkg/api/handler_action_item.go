@@ -0,0 +1,126 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// listSessionActionItemsHandler handles GET /api/v1/sessions/:id/action-items.
+// Returns all of the session's action items, oldest first.
+func (s *Server) listSessionActionItemsHandler(c *echo.Context) error {
+	if s.actionItemService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "action items are not available")
+	}
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return invalidField("id", "required")
+	}
+
+	items, err := s.actionItemService.ListSessionItems(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewActionItemListResponse(items, len(items)))
+}
+
+// createActionItemHandler handles POST /api/v1/sessions/:id/action-items.
+// Adds an action item to the session by hand.
+func (s *Server) createActionItemHandler(c *echo.Context) error {
+	if s.actionItemService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "action items are not available")
+	}
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return invalidField("id", "required")
+	}
+
+	var req models.CreateActionItemRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	item, err := s.actionItemService.CreateItem(c.Request().Context(), sessionID, req)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusCreated, models.NewActionItemResponse(item))
+}
+
+// listActionItemsHandler handles GET /api/v1/action-items.
+// Optional query params: status (open|done|all, default open), chain_id,
+// assignee, limit (1-200, default 50), offset.
+func (s *Server) listActionItemsHandler(c *echo.Context) error {
+	if s.actionItemService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "action items are not available")
+	}
+
+	params := models.ActionItemListParams{
+		Status:   c.QueryParam("status"),
+		ChainID:  c.QueryParam("chain_id"),
+		Assignee: c.QueryParam("assignee"),
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			return invalidField("limit", "must be between 1 and %d", maxPageSize)
+		}
+		params.Limit = n
+	}
+	if v := c.QueryParam("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return invalidField("offset", "must be a non-negative integer")
+		}
+		params.Offset = n
+	}
+
+	items, total, err := s.actionItemService.ListItems(c.Request().Context(), params)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewActionItemListResponse(items, total))
+}
+
+// updateActionItemHandler handles PATCH /api/v1/action-items/:id.
+// Marking an item done attributes the completion to the caller.
+func (s *Server) updateActionItemHandler(c *echo.Context) error {
+	if s.actionItemService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "action items are not available")
+	}
+	itemID := c.Param("id")
+	if itemID == "" {
+		return invalidField("id", "required")
+	}
+
+	var req models.UpdateActionItemRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	author, _ := s.resolveAuthor(c)
+	item, err := s.actionItemService.UpdateItem(c.Request().Context(), itemID, author, req)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewActionItemResponse(item))
+}
+
+// deleteActionItemHandler handles DELETE /api/v1/action-items/:id.
+func (s *Server) deleteActionItemHandler(c *echo.Context) error {
+	if s.actionItemService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "action items are not available")
+	}
+	itemID := c.Param("id")
+	if itemID == "" {
+		return invalidField("id", "required")
+	}
+
+	if err := s.actionItemService.DeleteItem(c.Request().Context(), itemID); err != nil {
+		return mapServiceError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
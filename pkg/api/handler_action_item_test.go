@@ -0,0 +1,142 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+func TestActionItemHandlers(t *testing.T) {
+	withService := &Server{
+		// Requests in this test are rejected before the client is used.
+		actionItemService: services.NewActionItemService(nil),
+	}
+
+	tests := []struct {
+		name     string
+		server   *Server
+		method   string
+		path     string
+		body     string
+		wantCode int
+	}{
+		{
+			name:     "list without service",
+			server:   &Server{},
+			method:   http.MethodGet,
+			path:     "/api/v1/action-items",
+			wantCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "session list without service",
+			server:   &Server{},
+			method:   http.MethodGet,
+			path:     "/api/v1/sessions/s1/action-items",
+			wantCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "list with invalid status",
+			server:   withService,
+			method:   http.MethodGet,
+			path:     "/api/v1/action-items?status=closed",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "list with invalid limit",
+			server:   withService,
+			method:   http.MethodGet,
+			path:     "/api/v1/action-items?limit=0",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "create without title",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/sessions/s1/action-items",
+			body:     `{"details":"why"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "create with blank title",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/sessions/s1/action-items",
+			body:     `{"title":"   "}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "create with title too long",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/sessions/s1/action-items",
+			body:     `{"title":"` + strings.Repeat("x", 201) + `"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "update with invalid status",
+			server:   withService,
+			method:   http.MethodPatch,
+			path:     "/api/v1/action-items/a1",
+			body:     `{"status":"closed"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "update with blank title",
+			server:   withService,
+			method:   http.MethodPatch,
+			path:     "/api/v1/action-items/a1",
+			body:     `{"title":" "}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "delete without service",
+			server:   &Server{},
+			method:   http.MethodDelete,
+			path:     "/api/v1/action-items/a1",
+			wantCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.GET("/api/v1/sessions/:id/action-items", tt.server.listSessionActionItemsHandler)
+			e.POST("/api/v1/sessions/:id/action-items", tt.server.createActionItemHandler)
+			e.GET("/api/v1/action-items", tt.server.listActionItemsHandler)
+			e.PATCH("/api/v1/action-items/:id", tt.server.updateActionItemHandler)
+			e.DELETE("/api/v1/action-items/:id", tt.server.deleteActionItemHandler)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func TestListActionItemsHandler_InvalidPaging(t *testing.T) {
+	s := &Server{actionItemService: services.NewActionItemService(nil)}
+	tests := []struct {
+		query string
+		want  ValidationErrors
+	}{
+		{query: "limit=0", want: ValidationErrors{{Field: "limit", Message: "must be between 1 and 200"}}},
+		{query: "limit=x", want: ValidationErrors{{Field: "limit", Message: "must be between 1 and 200"}}},
+		{query: "offset=-1", want: ValidationErrors{{Field: "offset", Message: "must be a non-negative integer"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			e := echo.New()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/action-items?"+tt.query, nil), httptest.NewRecorder())
+			assert.Equal(t, tt.want, fieldErrors(t, s.listActionItemsHandler(c)))
+		})
+	}
+}
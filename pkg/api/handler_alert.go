@@ -2,12 +2,20 @@ package api
 
 import (
 	"fmt"
+	"log/slog"
 	"net/http"
+	"time"
 
 	echo "github.com/labstack/echo/v5"
 
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/callback"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 	"github.com/codeready-toolchain/tarsy/pkg/runbook"
 	"github.com/codeready-toolchain/tarsy/pkg/services"
 )
@@ -17,61 +25,223 @@ import (
 func (s *Server) submitAlertHandler(c *echo.Context) error {
 	// 1. Bind HTTP request
 	var req SubmitAlertRequest
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
 	}
 
-	// 2. Validate required fields
-	if req.Data == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "data field is required")
+	// 2. Validate the request
+	if err := s.validateSubmitAlertRequest(&req); err != nil {
+		return err
 	}
 
-	// 3. Enforce alert data size limit
+	// 3. Transform to service input
+	input := s.newSubmitAlertInput(c, &req)
+
+	// 4. Call service
+	session, err := s.alertService.SubmitAlert(c.Request().Context(), input)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	metrics.SessionsSubmittedTotal.WithLabelValues(session.AlertType).Inc()
+	s.eventStream.SessionCreated(session)
+
+	// 5. Return response
+	resp := &AlertResponse{
+		SessionID:        session.ID,
+		Status:           "queued",
+		Message:          "Alert submitted for processing",
+		ConsistencyToken: services.ConsistencyToken{Kind: services.ConsistencySubmit, SessionID: session.ID}.Encode(),
+	}
+	if session.GroupID != nil {
+		resp.GroupID = *session.GroupID
+	}
+	return c.JSON(http.StatusAccepted, resp)
+}
+
+// validateSubmitAlertRequest checks a POST /api/v1/alerts body before it
+// reaches the service. Shared by submission and dry run.
+func (s *Server) validateSubmitAlertRequest(req *SubmitAlertRequest) error {
+	// Enforce alert data size limit
 	if len(req.Data) > agent.MaxAlertDataSize {
 		return echo.NewHTTPError(http.StatusRequestEntityTooLarge,
 			fmt.Sprintf("alert data exceeds maximum size of %d bytes", agent.MaxAlertDataSize))
 	}
 
-	// 4. Validate MCP selection override servers (if provided)
+	// Validate MCP selection override servers (if provided)
 	if req.MCP != nil && s.cfg.MCPServerRegistry != nil {
-		for _, sel := range req.MCP.Servers {
+		for i, sel := range req.MCP.Servers {
 			if !s.cfg.MCPServerRegistry.Has(sel.Name) {
-				return echo.NewHTTPError(http.StatusBadRequest,
-					fmt.Sprintf("MCP server %q not found in configuration", sel.Name))
+				return invalidField(fmt.Sprintf("mcp.servers[%d].name", i),
+					"MCP server %q not found in configuration", sel.Name)
 			}
 		}
 	}
 
-	// 5. Validate runbook URL (if provided)
+	// Validate runbook URL (if provided)
 	if req.Runbook != "" && s.cfg.Runbooks != nil {
 		if err := runbook.ValidateRunbookURL(req.Runbook, s.cfg.Runbooks.AllowedDomains); err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest,
-				fmt.Sprintf("invalid runbook URL: %s", err.Error()))
+			return invalidField("runbook", "invalid runbook URL: %s", err.Error())
 		}
 	}
 
-	// 6. Transform to service input
-	input := services.SubmitAlertInput{
+	// Validate completion callback (if provided)
+	if req.Callback != nil {
+		if s.cfg.Callbacks == nil || !s.cfg.Callbacks.Enabled {
+			return invalidField("callback", "completion callbacks are not enabled (system.callbacks.enabled)")
+		}
+		if err := callback.ValidateRegistration(req.Callback, s.cfg.Callbacks.AllowedHosts); err != nil {
+			return invalidField("callback", "invalid callback: %s", err.Error())
+		}
+	}
+
+	// Validate stage delegation from another instance (if provided)
+	if req.Federation != nil {
+		if s.cfg.Federation == nil || !s.cfg.Federation.AcceptDelegations {
+			return invalidField("federation", "stage delegation is not enabled (system.federation.accept_delegations)")
+		}
+		var missing []FieldError
+		if req.Federation.SessionID == "" {
+			missing = append(missing, FieldError{Field: "federation.session_id", Message: "required"})
+		}
+		if req.Federation.StageName == "" {
+			missing = append(missing, FieldError{Field: "federation.stage_name", Message: "required"})
+		}
+		if len(missing) > 0 {
+			return invalidFields(missing...)
+		}
+		if len(req.Federation.Context) > agent.MaxAlertDataSize {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge,
+				fmt.Sprintf("federation context exceeds maximum size of %d bytes", agent.MaxAlertDataSize))
+		}
+	}
+
+	// Validate the target against system.targets. The resolved target (the
+	// default cluster when none is given, region filled in) replaces it.
+	var cluster, region, namespace string
+	if req.Target != nil {
+		cluster, region, namespace = req.Target.Cluster, req.Target.Region, req.Target.Namespace
+	}
+	target, err := s.cfg.Targets.Resolve(cluster, region, namespace)
+	if err != nil {
+		return invalidField("target", "invalid target: %s", err.Error())
+	}
+	req.Target = nil
+	if target != nil {
+		req.Target = &schema.AlertTarget{Cluster: target.Cluster, Region: target.Region, Namespace: target.Namespace}
+	}
+	return nil
+}
+
+// newSubmitAlertInput converts a validated request into service input.
+func (s *Server) newSubmitAlertInput(c *echo.Context, req *SubmitAlertRequest) services.SubmitAlertInput {
+	author, subject := s.resolveAuthor(c)
+	return services.SubmitAlertInput{
 		AlertType:               req.AlertType,
 		Runbook:                 req.Runbook,
 		Data:                    req.Data,
 		MCP:                     req.MCP,
-		Author:                  extractAuthor(c),
+		Author:                  author,
+		AuthorSubject:           subject,
 		SlackMessageFingerprint: req.SlackMessageFingerprint,
+		RequestID:               requestid.FromContext(c.Request().Context()),
+		AlertKey:                req.AlertKey,
+		Instructions:            req.Instructions,
+		OutputLanguage:          req.OutputLanguage,
+		Images:                  req.Images,
+		Callback:                req.Callback,
+		Federation:              req.Federation,
+		Target:                  req.Target,
+		ForceFullInvestigation:  req.ForceFullInvestigation,
 	}
+}
 
-	// 7. Call service
-	session, err := s.alertService.SubmitAlert(c.Request().Context(), input)
+// dryRunAlertHandler handles POST /api/v1/alerts/dry-run.
+// Validates an alert submission exactly like POST /alerts and returns the
+// chains it would run with a budget forecast for each (historical averages
+// of tokens, estimated cost and duration), without creating sessions.
+// Accepted is false when a chain budget would reject the submission.
+func (s *Server) dryRunAlertHandler(c *echo.Context) error {
+	var req SubmitAlertRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	if err := s.validateSubmitAlertRequest(&req); err != nil {
+		return err
+	}
+
+	plan, err := s.alertService.PlanAlert(c.Request().Context(), s.newSubmitAlertInput(c, &req))
 	if err != nil {
 		return mapServiceError(err)
 	}
 
-	metrics.SessionsSubmittedTotal.WithLabelValues(session.AlertType).Inc()
+	resp := &AlertDryRunResponse{
+		AlertType:     plan.AlertType,
+		ChainIDs:      plan.ChainIDs,
+		FallbackChain: plan.FallbackChain,
+		Forecasts:     plan.Forecasts,
+		Accepted:      true,
+	}
+	if resp.Forecasts == nil {
+		resp.Forecasts = []*models.BudgetForecast{}
+	}
+	for _, f := range plan.Forecasts {
+		if f.Budget != nil && f.Budget.Enforced {
+			resp.Accepted = false
+		}
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// resolveAlertHandler handles POST /api/v1/alerts/resolve.
+// Called by the alert source when an alert clears. Records the resolution on
+// queued and in-flight sessions for that alert and, unless cancel_queued is
+// false, auto-cancels the ones still queued. Running sessions continue and
+// see the resolution at their next stage boundary.
+func (s *Server) resolveAlertHandler(c *echo.Context) error {
+	var req ResolveAlertRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	cancelQueued := req.CancelQueued == nil || *req.CancelQueued
+	result, err := s.sessionService.ResolveAlert(c.Request().Context(), req.AlertKey, req.Reason, cancelQueued)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	resolved := make([]string, 0, len(result.Resolved))
+	for _, sess := range result.Resolved {
+		resolved = append(resolved, sess.ID)
+	}
+	cancelled := make([]string, 0, len(result.Cancelled))
+	for _, sess := range result.Cancelled {
+		cancelled = append(cancelled, sess.ID)
+		metrics.SessionsTerminalTotal.WithLabelValues(sess.AlertType, string(alertsession.StatusAutoCancelled)).Inc()
+		if s.eventPublisher != nil {
+			if err := s.eventPublisher.PublishSessionStatus(c.Request().Context(), sess.ID, events.SessionStatusPayload{
+				BasePayload: events.BasePayload{
+					Type:      events.EventTypeSessionStatus,
+					SessionID: sess.ID,
+					Timestamp: time.Now().Format(time.RFC3339Nano),
+				},
+				Status:    alertsession.StatusAutoCancelled,
+				ChainID:   sess.ChainID,
+				AlertType: sess.AlertType,
+			}); err != nil {
+				slog.Warn("Failed to publish auto-cancelled status", "session_id", sess.ID, "error", err)
+			}
+		}
+		s.callbacks.Deliver(sess.ID)
+	}
+	if len(resolved) > 0 {
+		slog.Info("Recorded alert resolution on open sessions",
+			"alert_key", req.AlertKey, "resolved", len(resolved), "cancelled", len(cancelled))
+	}
 
-	// 8. Return response
-	return c.JSON(http.StatusAccepted, &AlertResponse{
-		SessionID: session.ID,
-		Status:    "queued",
-		Message:   "Alert submitted for processing",
+	return c.JSON(http.StatusOK, &ResolveAlertResponse{
+		AlertKey:            req.AlertKey,
+		ResolvedSessionIDs:  resolved,
+		CancelledSessionIDs: cancelled,
 	})
 }
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestSubmitAlertHandler_CallbackValidation(t *testing.T) {
+	enabled := config.DefaultCallbacksConfig()
+	enabled.Enabled = true
+	enabled.AllowedHosts = []string{"ci.example.com"}
+
+	tests := []struct {
+		name      string
+		callbacks *config.CallbacksConfig
+		callback  string
+		wantMsg   string
+	}{
+		{
+			name:      "callbacks disabled",
+			callbacks: config.DefaultCallbacksConfig(),
+			callback:  `{"url":"https://ci.example.com/hook"}`,
+			wantMsg:   "completion callbacks are not enabled",
+		},
+		{
+			name:      "missing url",
+			callbacks: enabled,
+			callback:  `{"secret":"s3cret"}`,
+			wantMsg:   "invalid callback: url is required",
+		},
+		{
+			name:      "host not allowed",
+			callbacks: enabled,
+			callback:  `{"url":"https://other.example.com/hook"}`,
+			wantMsg:   "not in allowed list",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Requests in this test are rejected before the alert service is used.
+			s := &Server{cfg: &config.Config{Callbacks: tt.callbacks}}
+			e := echo.New()
+			e.POST("/api/v1/alerts", s.submitAlertHandler)
+
+			body := `{"alert_type":"PodCrash","data":"pod crashed","callback":` + tt.callback + `}`
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantMsg)
+		})
+	}
+}
+
+func TestSubmitAlertHandler_FederationValidation(t *testing.T) {
+	accepting := &config.FederationConfig{AcceptDelegations: true}
+
+	tests := []struct {
+		name       string
+		federation *config.FederationConfig
+		origin     string
+		wantCode   int
+		wantMsg    string
+	}{
+		{
+			name:       "delegations not accepted",
+			federation: config.DefaultFederationConfig(),
+			origin:     `{"session_id":"s1","stage_name":"cluster"}`,
+			wantCode:   http.StatusBadRequest,
+			wantMsg:    "stage delegation is not enabled",
+		},
+		{
+			name:       "missing stage name",
+			federation: accepting,
+			origin:     `{"session_id":"s1"}`,
+			wantCode:   http.StatusBadRequest,
+			wantMsg:    "federation.stage_name: required",
+		},
+		{
+			name:       "oversized context",
+			federation: accepting,
+			origin:     `{"session_id":"s1","stage_name":"cluster","context":"` + strings.Repeat("x", agent.MaxAlertDataSize+1) + `"}`,
+			wantCode:   http.StatusRequestEntityTooLarge,
+			wantMsg:    "federation context exceeds maximum size",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Requests in this test are rejected before the alert service is used.
+			s := &Server{cfg: &config.Config{Federation: tt.federation}}
+			e := echo.New()
+			e.POST("/api/v1/alerts", s.submitAlertHandler)
+
+			body := `{"alert_type":"PodCrash","data":"pod crashed","federation":` + tt.origin + `}`
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantMsg)
+		})
+	}
+}
+
+func TestSubmitAlertHandler_TargetValidation(t *testing.T) {
+	targets := &config.TargetsConfig{
+		Clusters: map[string]*config.ClusterTargetConfig{
+			"prod-east": {Region: "us-east-1", Namespaces: []string{"payments"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		targets *config.TargetsConfig
+		target  string
+		wantMsg string
+	}{
+		{
+			name:    "targets not configured",
+			targets: config.DefaultTargetsConfig(),
+			target:  `{"cluster":"prod-east"}`,
+			wantMsg: "no targets are configured",
+		},
+		{
+			name:    "unknown cluster",
+			targets: targets,
+			target:  `{"cluster":"prod-west"}`,
+			wantMsg: `unknown cluster \"prod-west\"`,
+		},
+		{
+			name:    "region mismatch",
+			targets: targets,
+			target:  `{"cluster":"prod-east","region":"eu-west-1"}`,
+			wantMsg: "is in region",
+		},
+		{
+			name:    "namespace not allowed",
+			targets: targets,
+			target:  `{"cluster":"prod-east","namespace":"billing"}`,
+			wantMsg: "is not targetable",
+		},
+		{
+			name:    "namespace without cluster",
+			targets: targets,
+			target:  `{"namespace":"payments"}`,
+			wantMsg: "cluster is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Requests in this test are rejected before the alert service is used.
+			s := &Server{cfg: &config.Config{Targets: tt.targets}}
+			e := echo.New()
+			e.POST("/api/v1/alerts", s.submitAlertHandler)
+
+			body := `{"alert_type":"PodCrash","data":"pod crashed","target":` + tt.target + `}`
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantMsg)
+		})
+	}
+}
+
+func TestResolveAlertHandler_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{
+			name:     "invalid body",
+			body:     `{"alert_key":1}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "missing alert key",
+			body:     `{"reason":"cleared"}`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Requests in this test are rejected before the session service is used.
+			s := &Server{}
+			e := echo.New()
+			e.POST("/api/v1/alerts/resolve", s.resolveAlertHandler)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts/resolve", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func TestDryRunAlertHandler_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+		wantMsg  string
+	}{
+		{
+			name:     "invalid body",
+			body:     `{"data":1}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "missing data",
+			body:     `{"alert_type":"PodCrash"}`,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "data: required",
+		},
+		{
+			name:     "callbacks disabled",
+			body:     `{"data":"pod crashed","callback":{"url":"https://ci.example.com/hook"}}`,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "completion callbacks are not enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Requests in this test are rejected before the alert service is used.
+			s := &Server{cfg: &config.Config{Callbacks: config.DefaultCallbacksConfig()}}
+			e := echo.New()
+			e.POST("/api/v1/alerts/dry-run", s.dryRunAlertHandler)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts/dry-run", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantMsg)
+		})
+	}
+}
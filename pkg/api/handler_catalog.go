@@ -0,0 +1,123 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+const (
+	// defaultCatalogDays is the default trailing usage window for catalog endpoints.
+	defaultCatalogDays = 30
+	// maxCatalogDays matches the usage summary's maximum window.
+	maxCatalogDays = 365
+)
+
+// catalogChainsHandler handles GET /api/v1/catalog/chains.
+// Optional query param: days (1-365, default 30) — trailing usage window.
+func (s *Server) catalogChainsHandler(c *echo.Context) error {
+	window, err := s.catalogWindow(c)
+	if err != nil {
+		return err
+	}
+	usage, err := s.sessionService.GetChainUsage(c.Request().Context(), window.Start)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	chains, _ := buildCatalogChains(s.cfg, usage)
+	return c.JSON(http.StatusOK, CatalogChainsResponse{Window: window, Chains: chains})
+}
+
+// catalogAgentsHandler handles GET /api/v1/catalog/agents.
+// Optional query param: days (1-365, default 30) — trailing usage window.
+func (s *Server) catalogAgentsHandler(c *echo.Context) error {
+	window, err := s.catalogWindow(c)
+	if err != nil {
+		return err
+	}
+	usage, err := s.sessionService.GetAgentUsage(c.Request().Context(), window.Start)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	_, refs := buildCatalogChains(s.cfg, nil)
+	return c.JSON(http.StatusOK, CatalogAgentsResponse{
+		Window: window,
+		Agents: buildCatalogAgents(s.cfg, refs, usage),
+	})
+}
+
+// catalogMCPServersHandler handles GET /api/v1/catalog/mcp-servers.
+// Optional query param: days (1-365, default 30) — trailing usage window.
+func (s *Server) catalogMCPServersHandler(c *echo.Context) error {
+	window, err := s.catalogWindow(c)
+	if err != nil {
+		return err
+	}
+	usage, err := s.sessionService.GetMCPServerUsage(c.Request().Context(), window.Start)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	_, refs := buildCatalogChains(s.cfg, nil)
+	return c.JSON(http.StatusOK, CatalogMCPServersResponse{
+		Window:     window,
+		MCPServers: buildCatalogMCPServers(s.cfg, refs, usage),
+	})
+}
+
+// catalogMCPToolsHandler handles GET /api/v1/catalog/mcp-tools.
+// Lists each configured MCP server's discovered tools (schemas cached by the
+// health monitor) with per-tool call statistics, so unused tools and servers
+// stand out. Optional query params: days (1-365, default 30) — trailing
+// usage window; server — limit to one MCP server ID.
+func (s *Server) catalogMCPToolsHandler(c *echo.Context) error {
+	window, err := s.catalogWindow(c)
+	if err != nil {
+		return err
+	}
+	serverID := c.QueryParam("server")
+	if serverID != "" && (s.cfg.MCPServerRegistry == nil || !s.cfg.MCPServerRegistry.Has(serverID)) {
+		return echo.NewHTTPError(http.StatusNotFound, "MCP server not found")
+	}
+
+	usage, err := s.sessionService.GetMCPToolUsage(c.Request().Context(), window.Start)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	var statuses map[string]*mcp.HealthStatus
+	var cachedTools map[string][]*mcpsdk.Tool
+	if s.healthMonitor != nil {
+		statuses = s.healthMonitor.GetStatuses()
+		cachedTools = s.healthMonitor.GetCachedTools()
+	}
+	return c.JSON(http.StatusOK, CatalogMCPToolsResponse{
+		Window:  window,
+		Servers: buildCatalogMCPTools(s.cfg, statuses, cachedTools, usage, serverID),
+	})
+}
+
+// catalogWindow parses the days query param and checks that usage can be
+// queried. Returns an HTTP error suitable for returning from the handler.
+func (s *Server) catalogWindow(c *echo.Context) (models.UsageWindow, error) {
+	days := defaultCatalogDays
+	if v := c.QueryParam("days"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxCatalogDays {
+			return models.UsageWindow{}, echo.NewHTTPError(http.StatusBadRequest, "invalid days: must be between 1 and 365")
+		}
+		days = n
+	}
+
+	if s.sessionService == nil {
+		return models.UsageWindow{}, echo.NewHTTPError(http.StatusServiceUnavailable, "session service is not available")
+	}
+
+	end := time.Now().UTC()
+	return models.UsageWindow{Start: end.AddDate(0, 0, -days), End: end}, nil
+}
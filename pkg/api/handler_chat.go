@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"time"
 
 	echo "github.com/labstack/echo/v5"
@@ -18,7 +19,9 @@ import (
 
 // SendChatMessageRequest is the HTTP request body for POST /sessions/:id/chat/messages.
 type SendChatMessageRequest struct {
-	Content string `json:"content"`
+	Content   string                      `json:"content"`
+	Images    []models.ImageAttachment    `json:"images,omitempty"`
+	VoiceNote *models.VoiceNoteAttachment `json:"voice_note,omitempty"`
 }
 
 // SendChatMessageResponse is the HTTP response for POST /sessions/:id/chat/messages.
@@ -26,15 +29,34 @@ type SendChatMessageResponse struct {
 	ChatID    string `json:"chat_id"`
 	MessageID string `json:"message_id"`
 	StageID   string `json:"stage_id"`
+	// Content is the question as sent to the agent. Only set for voice
+	// notes, where it includes the transcript.
+	Content     string `json:"content,omitempty"`
+	VoiceNoteID string `json:"voice_note_id,omitempty"`
+	// ConsistencyToken makes GETs that present it show the question.
+	ConsistencyToken string `json:"consistency_token,omitempty"`
+	// Queued is set (with an empty StageID) when a response was still being
+	// generated or the pod was at its chat capacity: the message starts once
+	// its turn comes. QueuePosition is its 1-based place in the queue;
+	// chat.queue_position events report later changes.
+	Queued        bool `json:"queued,omitempty"`
+	QueuePosition int  `json:"queue_position,omitempty"`
+	// DisabledMCPServer is set instead of the IDs above when the message
+	// was the /disable-mcp command, which is applied without an agent turn.
+	DisabledMCPServer *DisabledMCPServerResponse `json:"disabled_mcp_server,omitempty"`
 }
 
 // sendChatMessageHandler handles POST /api/v1/sessions/:id/chat/messages.
 // Creates/gets a chat, adds the user message, and submits it for async processing.
+// While a response is still being generated the message is queued (one per
+// chat); chat rate limits answer 429 with Retry-After.
+// The "/disable-mcp <server_id> [reason]" command is handled directly instead
+// (200 OK), even while a chat response is being generated.
 func (s *Server) sendChatMessageHandler(c *echo.Context) error {
 	// 1. Validate session ID
 	sessionID := c.Param("id")
 	if sessionID == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+		return invalidField("id", "required")
 	}
 
 	// 1b. Verify chat dependencies are initialized
@@ -48,30 +70,48 @@ func (s *Server) sendChatMessageHandler(c *echo.Context) error {
 		return mapServiceError(err)
 	}
 
-	// 3. Resolve chain config, validate chat is available
+	// 3. Bind request body; apply the /disable-mcp command without an agent turn
+	var req SendChatMessageRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	if serverID, reason, ok := parseDisableMCPCommand(req.Content); ok {
+		if serverID == "" {
+			return invalidField("content", "usage: %s <server_id> [reason]", disableMCPCommand)
+		}
+		disabled, err := s.disableMCPServer(c, sessionID, serverID, reason)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, &SendChatMessageResponse{DisabledMCPServer: disabled})
+	}
+
+	// 4. Resolve chain config, validate chat is available
 	chain, err := s.cfg.GetChain(session.ChainID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "chain configuration not found")
+		return invalidField("id", "chain configuration of the session not found")
 	}
 
 	if reason := isChatAvailable(session.Status, chain); reason != "" {
-		return echo.NewHTTPError(http.StatusBadRequest, reason)
+		return invalidField("id", "%s", reason)
 	}
 
-	// 4. Bind and validate request body
-	var req SendChatMessageRequest
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-	}
-	if req.Content == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "content is required")
+	// 4b. Validate request body
+	if req.Content == "" && req.VoiceNote == nil {
+		return invalidField("content", "required unless voice_note is set")
 	}
 	if len(req.Content) > 100_000 {
-		return echo.NewHTTPError(http.StatusBadRequest, "content exceeds maximum length of 100,000 characters")
+		return invalidField("content", "must be at most 100,000 characters")
+	}
+	if msg := models.ValidateImageAttachments(req.Images); msg != "" {
+		return invalidField("images", "%s", msg)
+	}
+	if msg := models.ValidateVoiceNote(req.VoiceNote); msg != "" {
+		return invalidField("voice_note", "%s", msg)
 	}
 
 	// 5. Extract author
-	author := extractAuthor(c)
+	author, subject := s.resolveAuthor(c)
 
 	// 6. Get or create chat
 	chatObj, created, err := s.chatService.GetOrCreateChat(c.Request().Context(), sessionID, author)
@@ -97,37 +137,64 @@ func (s *Server) sendChatMessageHandler(c *echo.Context) error {
 
 	// 8. Add chat message
 	msg, err := s.chatService.AddChatMessage(c.Request().Context(), models.AddChatMessageRequest{
-		ChatID:  chatObj.ID,
-		Content: req.Content,
-		Author:  author,
+		ChatID:        chatObj.ID,
+		Content:       req.Content,
+		Author:        author,
+		AuthorSubject: subject,
+		Images:        req.Images,
+		VoiceNote:     req.VoiceNote,
 	})
 	if err != nil {
 		return mapServiceError(err)
 	}
 
-	// 9. Submit to ChatMessageExecutor
-	stageID, err := s.chatExecutor.Submit(c.Request().Context(), queue.ChatExecuteInput{
+	// 9. Submit to ChatMessageExecutor, queueing behind an active response
+	// or until a slot is free
+	input := queue.ChatExecuteInput{
 		Chat:    chatObj,
 		Message: msg,
 		Session: session,
-	})
+	}
+	stageID, err := s.chatExecutor.Submit(c.Request().Context(), input)
+	if errors.Is(err, queue.ErrChatExecutionActive) || errors.Is(err, queue.ErrChatAtCapacity) {
+		var position int
+		if position, err = s.chatExecutor.QueuePending(c.Request().Context(), input); err == nil {
+			return c.JSON(http.StatusAccepted, &SendChatMessageResponse{
+				ChatID:        chatObj.ID,
+				MessageID:     msg.ID,
+				Queued:        true,
+				QueuePosition: position,
+			})
+		}
+	}
 	if err != nil {
 		// Clean up orphaned message on rejection errors
-		if errors.Is(err, queue.ErrChatExecutionActive) || errors.Is(err, queue.ErrShuttingDown) {
+		if errors.Is(err, queue.ErrChatExecutionActive) || errors.Is(err, queue.ErrChatAtCapacity) ||
+			errors.Is(err, queue.ErrChatRateLimited) || errors.Is(err, queue.ErrShuttingDown) {
 			if delErr := s.chatService.DeleteChatMessage(c.Request().Context(), msg.ID); delErr != nil {
 				slog.Warn("Failed to clean up rejected chat message",
 					"message_id", msg.ID, "error", delErr)
 			}
 		}
+		var limitErr *queue.ChatLimitError
+		if errors.As(err, &limitErr) {
+			c.Response().Header().Set("Retry-After", strconv.Itoa(limitErr.RetryAfterSeconds()))
+		}
 		return mapChatExecutorError(err)
 	}
 
 	// 10. Return 202 Accepted
-	return c.JSON(http.StatusAccepted, &SendChatMessageResponse{
-		ChatID:    chatObj.ID,
-		MessageID: msg.ID,
-		StageID:   stageID,
-	})
+	resp := &SendChatMessageResponse{
+		ChatID:           chatObj.ID,
+		MessageID:        msg.ID,
+		StageID:          stageID,
+		ConsistencyToken: services.ConsistencyToken{Kind: services.ConsistencyChat, SessionID: sessionID, StageID: stageID}.Encode(),
+	}
+	if msg.VoiceNote != nil {
+		resp.Content = msg.Content
+		resp.VoiceNoteID = msg.VoiceNote.ID
+	}
+	return c.JSON(http.StatusAccepted, resp)
 }
 
 // isChatAvailable checks if a chat can be started for a session.
@@ -141,7 +208,7 @@ func isChatAvailable(sessionStatus alertsession.Status, chain *config.ChainConfi
 		return "chat is not available while session is still processing"
 	case alertsession.StatusCancelling:
 		return "chat is not available while session is being cancelled"
-	case alertsession.StatusCancelled:
+	case alertsession.StatusCancelled, alertsession.StatusAutoCancelled:
 		return "chat is not available for cancelled sessions"
 	default:
 		return "chat is not available for sessions in this state"
@@ -160,13 +227,20 @@ func mapChatExecutorError(err error) *echo.HTTPError {
 	if errors.Is(err, queue.ErrChatExecutionActive) {
 		return echo.NewHTTPError(http.StatusConflict, "a chat response is already being generated")
 	}
+	if errors.Is(err, queue.ErrChatAtCapacity) {
+		return echo.NewHTTPError(http.StatusTooManyRequests, "too many chat responses are being generated right now")
+	}
+	var limitErr *queue.ChatLimitError
+	if errors.As(err, &limitErr) {
+		return echo.NewHTTPError(http.StatusTooManyRequests, limitErr.Error())
+	}
 	if errors.Is(err, queue.ErrShuttingDown) {
 		return echo.NewHTTPError(http.StatusServiceUnavailable, "service is shutting down")
 	}
 
 	var validErr *services.ValidationError
 	if errors.As(err, &validErr) {
-		return echo.NewHTTPError(http.StatusBadRequest, validErr.Error())
+		return invalidField(validErr.Field, "%s", validErr.Message)
 	}
 
 	return echo.NewHTTPError(http.StatusInternalServerError, "failed to process chat message")
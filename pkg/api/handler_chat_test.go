@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
@@ -117,6 +118,18 @@ func TestMapChatExecutorError(t *testing.T) {
 			wantCode:   http.StatusConflict,
 			wantSubstr: "already being generated",
 		},
+		{
+			name:       "ErrChatAtCapacity maps to 429",
+			err:        queue.ErrChatAtCapacity,
+			wantCode:   http.StatusTooManyRequests,
+			wantSubstr: "too many chat responses",
+		},
+		{
+			name:       "ChatLimitError maps to 429",
+			err:        &queue.ChatLimitError{Reason: "you are sending chat messages too quickly", RetryAfter: 1500 * time.Millisecond},
+			wantCode:   http.StatusTooManyRequests,
+			wantSubstr: "too quickly; try again in 2s",
+		},
 		{
 			name:       "ErrShuttingDown maps to 503",
 			err:        queue.ErrShuttingDown,
@@ -145,3 +158,8 @@ func TestMapChatExecutorError(t *testing.T) {
 		})
 	}
 }
+
+func TestMapChatExecutorError_FieldErrors(t *testing.T) {
+	err := mapChatExecutorError(services.NewValidationError("content", "required"))
+	assert.Equal(t, ValidationErrors{{Field: "content", Message: "required"}}, fieldErrors(t, err))
+}
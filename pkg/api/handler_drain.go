@@ -0,0 +1,89 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/queue"
+)
+
+// drainPodHandler handles POST /api/v1/system/drain/:pod_id.
+// Stops the pod from claiming new sessions ahead of its removal. Poll
+// GET /system/drain/:pod_id until the state is "drained".
+func (s *Server) drainPodHandler(c *echo.Context) error {
+	podID := strings.TrimSpace(c.Param("pod_id"))
+	if podID == "" {
+		return invalidField("pod_id", "required")
+	}
+	if s.workerPool == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "pod drain is not available")
+	}
+	var req DrainPodRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	status, err := s.workerPool.Drain(c.Request().Context(), podID, strings.TrimSpace(req.Reason), extractAuthor(c))
+	if err != nil {
+		return mapDrainError(c, err)
+	}
+	return c.JSON(http.StatusAccepted, status)
+}
+
+// drainStatusHandler handles GET /api/v1/system/drain/:pod_id.
+func (s *Server) drainStatusHandler(c *echo.Context) error {
+	podID := strings.TrimSpace(c.Param("pod_id"))
+	if podID == "" {
+		return invalidField("pod_id", "required")
+	}
+	if s.workerPool == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "pod drain is not available")
+	}
+
+	status, err := s.workerPool.DrainStatus(c.Request().Context(), podID)
+	if err != nil {
+		return mapDrainError(c, err)
+	}
+	return c.JSON(http.StatusOK, status)
+}
+
+// cancelDrainHandler handles DELETE /api/v1/system/drain/:pod_id.
+// Lets the pod claim sessions again. Cancelling a drain that is not in
+// progress is not an error.
+func (s *Server) cancelDrainHandler(c *echo.Context) error {
+	podID := strings.TrimSpace(c.Param("pod_id"))
+	if podID == "" {
+		return invalidField("pod_id", "required")
+	}
+	if s.workerPool == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "pod drain is not available")
+	}
+
+	ctx := c.Request().Context()
+	status, err := s.workerPool.DrainStatus(ctx, podID)
+	if err != nil {
+		return mapDrainError(c, err)
+	}
+	// Only a drain is cancelled here; plain pauses go through /system/queue/pause
+	if status.State != queue.DrainStateNone {
+		if _, err := s.workerPool.Resume(ctx, podID, extractAuthor(c)); err != nil {
+			return mapDrainError(c, err)
+		}
+		if status, err = s.workerPool.DrainStatus(ctx, podID); err != nil {
+			return mapDrainError(c, err)
+		}
+	}
+	return c.JSON(http.StatusOK, status)
+}
+
+func mapDrainError(c *echo.Context, err error) error {
+	if errors.Is(err, queue.ErrPauseUnavailable) {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "pod drain is not available")
+	}
+	slog.ErrorContext(c.Request().Context(), "Failed to drain pod", "pod_id", c.Param("pod_id"), "error", err)
+	return echo.NewHTTPError(http.StatusInternalServerError, "failed to drain pod")
+}
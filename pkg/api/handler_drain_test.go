@@ -0,0 +1,44 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainHandlers_Unavailable(t *testing.T) {
+	for name, handler := range map[string]func(*Server, *echo.Context) error{
+		"drain":  (*Server).drainPodHandler,
+		"status": (*Server).drainStatusHandler,
+		"cancel": (*Server).cancelDrainHandler,
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := echo.New()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/system/drain/pod-a", nil), httptest.NewRecorder())
+			c.SetPathValues(echo.PathValues{{Name: "pod_id", Value: "pod-a"}})
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, handler(&Server{}, c), &httpErr)
+			assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+		})
+	}
+}
+
+func TestDrainHandlers_MissingPodID(t *testing.T) {
+	for name, handler := range map[string]func(*Server, *echo.Context) error{
+		"drain":  (*Server).drainPodHandler,
+		"status": (*Server).drainStatusHandler,
+		"cancel": (*Server).cancelDrainHandler,
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := echo.New()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/system/drain/%20", nil), httptest.NewRecorder())
+			c.SetPathValues(echo.PathValues{{Name: "pod_id", Value: " "}})
+			fields := fieldErrors(t, handler(&Server{}, c))
+			assert.Equal(t, ValidationErrors{{Field: "pod_id", Message: "required"}}, fields)
+		})
+	}
+}
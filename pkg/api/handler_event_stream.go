@@ -0,0 +1,20 @@
+package api
+
+import (
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/eventstream"
+)
+
+// eventStreamSchemaHandler handles GET /api/v1/event-stream/schemas/:name.
+// Serves the JSON Schema of a lifecycle event's data; published events
+// reference it in their CloudEvents "dataschema" attribute.
+func (s *Server) eventStreamSchemaHandler(c *echo.Context) error {
+	schema, ok := eventstream.Schema(c.Param("name"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "schema not found")
+	}
+	return c.Blob(http.StatusOK, "application/schema+json", schema)
+}
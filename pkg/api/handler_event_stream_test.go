@@ -0,0 +1,28 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventStreamSchemaHandler(t *testing.T) {
+	e := echo.New()
+	s := &Server{}
+	e.GET("/api/v1/event-stream/schemas/:name", s.eventStreamSchemaHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/event-stream/schemas/session.terminal.v1.json", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/schema+json", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `"$id": "tarsy.session.terminal.v1"`)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/event-stream/schemas/session.deleted.v1.json", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
+)
+
+// FaultInjectionResponse is returned by GET and PUT /api/v1/system/fault-injection.
+type FaultInjectionResponse struct {
+	MCPTimeoutRate   float64        `json:"mcp_timeout_rate"`
+	MCPMalformedRate float64        `json:"mcp_malformed_rate"`
+	LLMRateLimitRate float64        `json:"llm_rate_limit_rate"`
+	MCPServers       []string       `json:"mcp_servers"` // empty = all servers
+	Injected         map[string]int `json:"injected"`    // faults injected by this pod, by kind
+}
+
+// faultInjectionHandler handles GET /api/v1/system/fault-injection.
+func (s *Server) faultInjectionHandler(c *echo.Context) error {
+	if s.faults == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "fault injection is not enabled")
+	}
+	return c.JSON(http.StatusOK, buildFaultInjection(s.faults))
+}
+
+// updateFaultInjectionHandler handles PUT /api/v1/system/fault-injection.
+// Replaces all rates on this pod (not persisted). Only available when
+// system.fault_injection.enabled is set.
+func (s *Server) updateFaultInjectionHandler(c *echo.Context) error {
+	if s.faults == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "fault injection is not enabled")
+	}
+
+	var req UpdateFaultInjectionRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	rates := config.FaultInjectionConfig{
+		MCPTimeoutRate:   req.MCPTimeoutRate,
+		MCPMalformedRate: req.MCPMalformedRate,
+		LLMRateLimitRate: req.LLMRateLimitRate,
+		MCPServers:       req.MCPServers,
+	}
+	if err := s.faults.SetRates(rates, extractAuthor(c)); err != nil {
+		if errors.Is(err, faultinject.ErrInvalidRates) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update fault injection")
+	}
+	return c.JSON(http.StatusOK, buildFaultInjection(s.faults))
+}
+
+func buildFaultInjection(faults *faultinject.Injector) FaultInjectionResponse {
+	rates := faults.Rates()
+	resp := FaultInjectionResponse{
+		MCPTimeoutRate:   rates.MCPTimeoutRate,
+		MCPMalformedRate: rates.MCPMalformedRate,
+		LLMRateLimitRate: rates.LLMRateLimitRate,
+		MCPServers:       rates.MCPServers,
+		Injected:         map[string]int{},
+	}
+	if resp.MCPServers == nil {
+		resp.MCPServers = []string{}
+	}
+	for fault, n := range faults.Injected() {
+		resp.Injected[string(fault)] = n
+	}
+	return resp
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
+)
+
+func TestFaultInjectionHandlers(t *testing.T) {
+	call := func(t *testing.T, s *Server, method, body string, handler func(*Server, *echo.Context) error) (*httptest.ResponseRecorder, error) {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(method, "/api/v1/system/fault-injection", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		return rec, handler(s, e.NewContext(req, rec))
+	}
+
+	t.Run("not found when disabled", func(t *testing.T) {
+		_, err := call(t, &Server{}, http.MethodGet, "", (*Server).faultInjectionHandler)
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	})
+
+	s := &Server{faults: faultinject.New(&config.FaultInjectionConfig{Enabled: true, MCPTimeoutRate: 0.2}, nil)}
+
+	t.Run("get returns configured rates", func(t *testing.T) {
+		rec, err := call(t, s, http.MethodGet, "", (*Server).faultInjectionHandler)
+		require.NoError(t, err)
+		var resp FaultInjectionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 0.2, resp.MCPTimeoutRate)
+		assert.NotNil(t, resp.MCPServers)
+		assert.NotNil(t, resp.Injected)
+	})
+
+	t.Run("put replaces rates", func(t *testing.T) {
+		rec, err := call(t, s, http.MethodPut, `{"llm_rate_limit_rate": 0.5}`, (*Server).updateFaultInjectionHandler)
+		require.NoError(t, err)
+		var resp FaultInjectionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Zero(t, resp.MCPTimeoutRate)
+		assert.Equal(t, 0.5, resp.LLMRateLimitRate)
+	})
+
+	t.Run("put rejects invalid rates", func(t *testing.T) {
+		_, err := call(t, s, http.MethodPut, `{"mcp_timeout_rate": 3}`, (*Server).updateFaultInjectionHandler)
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+	})
+}
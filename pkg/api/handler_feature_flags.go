@@ -0,0 +1,152 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/featureflag"
+)
+
+// --- Response types ---
+
+// FeatureFlagsResponse is returned by GET /api/v1/system/feature-flags.
+type FeatureFlagsResponse struct {
+	Flags []FeatureFlagView `json:"flags"`
+}
+
+// FeatureFlagView is the state of one feature flag.
+type FeatureFlagView struct {
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Default     bool                     `json:"default"`
+	Configured  FeatureFlagRolloutView   `json:"configured"` // from system.feature_flags or the default
+	Override    *FeatureFlagOverrideView `json:"override"`   // runtime override, null when unset
+	Effective   FeatureFlagRolloutView   `json:"effective"`
+}
+
+// FeatureFlagRolloutView is a flag rollout.
+type FeatureFlagRolloutView struct {
+	Enabled    bool     `json:"enabled"`
+	Percentage *int     `json:"percentage,omitempty"`
+	AlertTypes []string `json:"alert_types,omitempty"`
+	Chains     []string `json:"chains,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// FeatureFlagOverrideView is a runtime override with its author.
+type FeatureFlagOverrideView struct {
+	FeatureFlagRolloutView
+	UpdatedBy string    `json:"updated_by,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// --- Handlers ---
+
+// featureFlagsHandler handles GET /api/v1/system/feature-flags.
+func (s *Server) featureFlagsHandler(c *echo.Context) error {
+	if s.featureFlags == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "feature flags are not available")
+	}
+	flags := s.featureFlags.Flags()
+	resp := FeatureFlagsResponse{Flags: make([]FeatureFlagView, 0, len(flags))}
+	for _, f := range flags {
+		resp.Flags = append(resp.Flags, buildFeatureFlagView(f))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// updateFeatureFlagHandler handles PUT /api/v1/system/feature-flags/:name.
+// The override reaches other replicas on their next refresh.
+func (s *Server) updateFeatureFlagHandler(c *echo.Context) error {
+	if s.featureFlags == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "feature flags are not available")
+	}
+	name := c.Param("name")
+	if _, ok := config.LookupFeatureFlag(name); !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "feature flag not found")
+	}
+
+	var req UpdateFeatureFlagRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	rollout := config.FeatureFlagConfig{
+		Enabled:    req.Enabled,
+		Percentage: req.Percentage,
+		AlertTypes: req.AlertTypes,
+		Chains:     req.Chains,
+		Namespaces: req.Namespaces,
+	}
+	if err := s.featureFlags.SetOverride(c.Request().Context(), name, rollout, extractAuthor(c)); err != nil {
+		return mapFeatureFlagError(c, err)
+	}
+	return s.featureFlagResponse(c, name)
+}
+
+// deleteFeatureFlagHandler handles DELETE /api/v1/system/feature-flags/:name.
+// Removes the runtime override so the configured rollout applies again.
+func (s *Server) deleteFeatureFlagHandler(c *echo.Context) error {
+	if s.featureFlags == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "feature flags are not available")
+	}
+	name := c.Param("name")
+	if _, ok := config.LookupFeatureFlag(name); !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "feature flag not found")
+	}
+
+	if err := s.featureFlags.ClearOverride(c.Request().Context(), name, extractAuthor(c)); err != nil {
+		return mapFeatureFlagError(c, err)
+	}
+	return s.featureFlagResponse(c, name)
+}
+
+func (s *Server) featureFlagResponse(c *echo.Context, name string) error {
+	f, _ := s.featureFlags.Flag(name)
+	return c.JSON(http.StatusOK, buildFeatureFlagView(f))
+}
+
+func mapFeatureFlagError(c *echo.Context, err error) error {
+	switch {
+	case errors.Is(err, featureflag.ErrInvalidRollout):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, featureflag.ErrUnavailable):
+		return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+	default:
+		slog.ErrorContext(c.Request().Context(), "Failed to update feature flag", "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update feature flag")
+	}
+}
+
+func buildFeatureFlagView(f featureflag.Flag) FeatureFlagView {
+	view := FeatureFlagView{
+		Name:        f.Name,
+		Description: f.Description,
+		Default:     f.Default,
+		Configured:  buildRolloutView(f.Configured),
+		Effective:   buildRolloutView(f.Effective()),
+	}
+	if o := f.Override; o != nil {
+		view.Override = &FeatureFlagOverrideView{
+			FeatureFlagRolloutView: buildRolloutView(o.Rollout),
+			UpdatedBy:              o.UpdatedBy,
+			UpdatedAt:              o.UpdatedAt,
+		}
+	}
+	return view
+}
+
+func buildRolloutView(r config.FeatureFlagConfig) FeatureFlagRolloutView {
+	return FeatureFlagRolloutView{
+		Enabled:    r.Enabled,
+		Percentage: r.Percentage,
+		AlertTypes: r.AlertTypes,
+		Chains:     r.Chains,
+		Namespaces: r.Namespaces,
+	}
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/featureflag"
+)
+
+// memFlagStore is an in-memory featureflag.Store.
+type memFlagStore map[string]*ent.FeatureFlagOverride
+
+func (m memFlagStore) List(context.Context) ([]*ent.FeatureFlagOverride, error) {
+	var out []*ent.FeatureFlagOverride
+	for _, r := range m {
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (m memFlagStore) Set(_ context.Context, flag string, r config.FeatureFlagConfig, author string) (*ent.FeatureFlagOverride, error) {
+	row := &ent.FeatureFlagOverride{
+		ID: flag, Enabled: r.Enabled, Percentage: r.Percentage,
+		AlertTypes: r.AlertTypes, Chains: r.Chains, Namespaces: r.Namespaces,
+		UpdatedBy: &author, UpdatedAt: time.Now(),
+	}
+	m[flag] = row
+	return row, nil
+}
+
+func (m memFlagStore) Delete(_ context.Context, flag string) error {
+	delete(m, flag)
+	return nil
+}
+
+func TestFeatureFlagHandlers(t *testing.T) {
+	newServer := func() *Server {
+		configured := map[string]*config.FeatureFlagConfig{
+			config.FeatureFlagFanOut:       {Enabled: true},
+			config.FeatureFlagModelRouting: {Enabled: true, AlertTypes: []string{"Outage"}},
+		}
+		return &Server{featureFlags: featureflag.NewManager(configured, nil, memFlagStore{})}
+	}
+	call := func(t *testing.T, method, name, body string, handler func(*Server, *echo.Context) error, s *Server) (*httptest.ResponseRecorder, error) {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(method, "/api/v1/system/feature-flags/"+name, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("X-Forwarded-User", "alice")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPathValues(echo.PathValues{{Name: "name", Value: name}})
+		return rec, handler(s, c)
+	}
+
+	t.Run("get lists every flag", func(t *testing.T) {
+		s := newServer()
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/system/feature-flags", nil), rec)
+		require.NoError(t, s.featureFlagsHandler(c))
+
+		var resp FeatureFlagsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Flags, len(config.FeatureFlagDefinitions()))
+		for _, f := range resp.Flags {
+			assert.Nil(t, f.Override)
+			if f.Name == config.FeatureFlagModelRouting {
+				assert.Equal(t, []string{"Outage"}, f.Effective.AlertTypes)
+			}
+		}
+	})
+
+	t.Run("put sets override and delete clears it", func(t *testing.T) {
+		s := newServer()
+		rec, err := call(t, http.MethodPut, config.FeatureFlagFanOut, `{"enabled": true, "percentage": 10, "namespaces": ["payments"]}`,
+			(*Server).updateFeatureFlagHandler, s)
+		require.NoError(t, err)
+
+		var view FeatureFlagView
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &view))
+		require.NotNil(t, view.Override)
+		assert.Equal(t, "alice", view.Override.UpdatedBy)
+		require.NotNil(t, view.Effective.Percentage)
+		assert.Equal(t, 10, *view.Effective.Percentage)
+		assert.Equal(t, []string{"payments"}, view.Effective.Namespaces)
+		assert.Nil(t, view.Configured.Percentage)
+
+		rec, err = call(t, http.MethodDelete, config.FeatureFlagFanOut, "", (*Server).deleteFeatureFlagHandler, s)
+		require.NoError(t, err)
+		view = FeatureFlagView{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &view))
+		assert.Nil(t, view.Override)
+		assert.Nil(t, view.Effective.Percentage)
+	})
+
+	errTests := []struct {
+		name     string
+		method   string
+		flag     string
+		body     string
+		handler  func(*Server, *echo.Context) error
+		wantCode int
+	}{
+		{"unknown flag", http.MethodPut, "no_such_flag", `{"enabled": true}`, (*Server).updateFeatureFlagHandler, http.StatusNotFound},
+		{"unknown flag on delete", http.MethodDelete, "no_such_flag", "", (*Server).deleteFeatureFlagHandler, http.StatusNotFound},
+		{"invalid percentage", http.MethodPut, config.FeatureFlagFanOut, `{"enabled": true, "percentage": 150}`, (*Server).updateFeatureFlagHandler, http.StatusBadRequest},
+		{"invalid body", http.MethodPut, config.FeatureFlagFanOut, `not json`, (*Server).updateFeatureFlagHandler, http.StatusBadRequest},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := call(t, tt.method, tt.flag, tt.body, tt.handler, newServer())
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, tt.wantCode, httpErr.Code)
+		})
+	}
+
+	t.Run("unavailable without manager", func(t *testing.T) {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/system/feature-flags", nil), httptest.NewRecorder())
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, (&Server{}).featureFlagsHandler(c), &httpErr)
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+	})
+}
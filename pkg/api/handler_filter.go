@@ -39,6 +39,7 @@ func (s *Server) filterOptionsHandler(c *echo.Context) error {
 		string(alertsession.StatusFailed),
 		string(alertsession.StatusCancelled),
 		string(alertsession.StatusTimedOut),
+		string(alertsession.StatusAutoCancelled),
 	}
 
 	return c.JSON(http.StatusOK, FilterOptionsResponse{
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// getHandoffNotesHandler handles GET /api/v1/sessions/:id/handoff-notes.
+func (s *Server) getHandoffNotesHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	session, err := s.sessionService.GetHandoffNotes(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewHandoffNotesResponse(session))
+}
+
+// updateHandoffNotesHandler handles PUT /api/v1/sessions/:id/handoff-notes.
+// Replaces the session's handoff notes and records the edit as a revision
+// attributed to the caller. Returns 409 when base_revision is stale.
+func (s *Server) updateHandoffNotesHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	var req models.UpdateHandoffNotesRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	author, _ := s.resolveAuthor(c)
+	session, err := s.sessionService.UpdateHandoffNotes(c.Request().Context(), sessionID, author, req)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewHandoffNotesResponse(session))
+}
+
+// listHandoffNoteRevisionsHandler handles GET /api/v1/sessions/:id/handoff-notes/revisions.
+func (s *Server) listHandoffNoteRevisionsHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	revisions, err := s.sessionService.ListHandoffNoteRevisions(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	resp := models.HandoffNoteRevisionsResponse{
+		Revisions: make([]models.HandoffNoteRevisionResponse, 0, len(revisions)),
+	}
+	for _, r := range revisions {
+		resp.Revisions = append(resp.Revisions, models.NewHandoffNoteRevisionResponse(r))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
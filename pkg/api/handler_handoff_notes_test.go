@@ -0,0 +1,26 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateHandoffNotesHandler_InvalidBody(t *testing.T) {
+	// The request is rejected before the session service is used.
+	s := &Server{}
+	e := echo.New()
+	e.PUT("/api/v1/sessions/:id/handoff-notes", s.updateHandoffNotesHandler)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/sessions/sess-1/handoff-notes",
+		strings.NewReader(`{"content":"x","base_revision":"one"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -3,11 +3,14 @@ package api
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	echo "github.com/labstack/echo/v5"
 
+	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/database"
+	"github.com/codeready-toolchain/tarsy/pkg/queue"
 	"github.com/codeready-toolchain/tarsy/pkg/version"
 )
 
@@ -19,7 +22,8 @@ const (
 
 // healthHandler handles GET /health.
 // Returns a minimal, safe response suitable for unauthenticated access.
-// Only tarsy's own components (database, worker_pool) are checked.
+// Only tarsy's own components (database, worker_pool) and the secrets chains
+// declare in required_env (chain_env, reported by name only) are checked.
 // External dependencies (MCP servers, LLM service) are excluded to prevent
 // the orchestrator from restarting tarsy when an external service is unhealthy.
 func (s *Server) healthHandler(c *echo.Context) error {
@@ -53,6 +57,22 @@ func (s *Server) healthHandler(c *echo.Context) error {
 		}
 	}
 
+	// Chains declaring secrets this pod lacks would fail mid-session
+	if s.cfg != nil && s.cfg.ChainRegistry != nil {
+		check := chainEnvCheck(s.cfg.ChainRegistry)
+		if check.Status != healthStatusHealthy && status == healthStatusHealthy {
+			status = healthStatusDegraded
+		}
+		checks["chain_env"] = check
+	}
+
+	// A draining pod stays healthy: it still serves the API and finishes
+	// its in-flight sessions.
+	var drain *queue.DrainStatus
+	if s.workerPool != nil {
+		drain = s.workerPool.LocalDrainStatus()
+	}
+
 	httpStatus := http.StatusOK
 	if status == healthStatusUnhealthy {
 		httpStatus = http.StatusServiceUnavailable
@@ -62,5 +82,20 @@ func (s *Server) healthHandler(c *echo.Context) error {
 		Status:  status,
 		Version: version.GitCommit,
 		Checks:  checks,
+		Drain:   drain,
 	})
 }
+
+// chainEnvCheck reports the chains whose required_env variables are unset,
+// naming each chain and variable.
+func chainEnvCheck(chains *config.ChainRegistry) HealthCheck {
+	errs := config.MissingChainEnv(chains)
+	if len(errs) == 0 {
+		return HealthCheck{Status: healthStatusHealthy}
+	}
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return HealthCheck{Status: healthStatusDegraded, Message: strings.Join(msgs, "; ")}
+}
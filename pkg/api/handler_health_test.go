@@ -0,0 +1,31 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestChainEnvCheck(t *testing.T) {
+	t.Setenv("TARSY_TEST_SPLUNK_TOKEN", "secret")
+	chain := func(env ...string) *config.ChainConfig {
+		return &config.ChainConfig{AlertTypes: []string{"test"}, RequiredEnv: env}
+	}
+
+	check := chainEnvCheck(config.NewChainRegistry(map[string]*config.ChainConfig{
+		"k8s": chain("TARSY_TEST_SPLUNK_TOKEN"),
+	}))
+	assert.Equal(t, HealthCheck{Status: healthStatusHealthy}, check)
+
+	check = chainEnvCheck(config.NewChainRegistry(map[string]*config.ChainConfig{
+		"k8s":   chain("TARSY_TEST_SPLUNK_TOKEN", "TARSY_TEST_UNSET_A"),
+		"slack": chain("TARSY_TEST_UNSET_B"),
+	}))
+	assert.Equal(t, healthStatusDegraded, check.Status)
+	assert.Equal(t,
+		"chain 'k8s': field 'required_env': environment variable TARSY_TEST_UNSET_A is not set; "+
+			"chain 'slack': field 'required_env': environment variable TARSY_TEST_UNSET_B is not set",
+		check.Message)
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+)
+
+// getSessionImageHandler handles GET /api/v1/sessions/:id/images/:image_id.
+// Serves an image attached to the session's alert or chat, for inline
+// rendering in the trace view.
+func (s *Server) getSessionImageHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+	imageID := c.Param("image_id")
+	if imageID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "image id is required")
+	}
+
+	ref, data, err := s.sessionService.GetSessionImage(c.Request().Context(), sessionID, imageID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	// Images never change once stored
+	c.Response().Header().Set("Cache-Control", "private, max-age=86400, immutable")
+	return c.Blob(http.StatusOK, ref.MimeType, data)
+}
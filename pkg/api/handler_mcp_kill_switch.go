@@ -0,0 +1,143 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// disableMCPCommand is the chat command that disables an MCP server for the
+// session: "/disable-mcp <server_id> [reason]".
+const disableMCPCommand = "/disable-mcp"
+
+// DisableMCPServerRequest is the HTTP request body for
+// POST /sessions/:id/disabled-mcp-servers.
+type DisableMCPServerRequest struct {
+	ServerID string `json:"server_id" validate:"required"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// DisabledMCPServerResponse describes an MCP server disabled for a session.
+type DisabledMCPServerResponse struct {
+	EventID   string `json:"event_id"`
+	SessionID string `json:"session_id"`
+	ServerID  string `json:"server_id"`
+	Reason    string `json:"reason,omitempty"`
+	Author    string `json:"author"`
+	CreatedAt string `json:"created_at"`
+}
+
+// disableMCPServerHandler handles POST /api/v1/sessions/:id/disabled-mcp-servers.
+// Turns off an MCP server for the rest of the session. Running agents drop
+// its tools at their next iteration; later stages and chat turns start
+// without it.
+func (s *Server) disableMCPServerHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	var req DisableMCPServerRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	resp, err := s.disableMCPServer(c, sessionID, req.ServerID, req.Reason)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// listDisabledMCPServersHandler handles GET /api/v1/sessions/:id/disabled-mcp-servers.
+func (s *Server) listDisabledMCPServersHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	disabled, err := s.sessionService.ListDisabledMCPServers(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	resp := make([]DisabledMCPServerResponse, 0, len(disabled))
+	for _, event := range disabled {
+		resp = append(resp, toDisabledMCPServerResponse(event))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// disableMCPServer records the disabled server and publishes the timeline
+// event. Shared by the REST endpoint and the chat command.
+func (s *Server) disableMCPServer(c *echo.Context, sessionID, serverID, reason string) (*DisabledMCPServerResponse, error) {
+	author, _ := s.resolveAuthor(c)
+	event, err := s.sessionService.DisableMCPServer(c.Request().Context(), sessionID, serverID, author, reason)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	if s.eventPublisher != nil {
+		payload := events.TimelineCreatedPayload{
+			BasePayload: events.BasePayload{
+				Type:      events.EventTypeTimelineCreated,
+				SessionID: sessionID,
+				Timestamp: event.CreatedAt.Format(time.RFC3339Nano),
+			},
+			EventID:        event.ID,
+			EventType:      event.EventType,
+			Status:         event.Status,
+			Content:        event.Content,
+			Metadata:       event.Metadata,
+			SequenceNumber: event.SequenceNumber,
+		}
+		if event.StageID != nil {
+			payload.StageID = *event.StageID
+		}
+		if event.Highlight != nil {
+			payload.Highlight = string(*event.Highlight)
+		}
+		if err := s.eventPublisher.PublishTimelineCreated(c.Request().Context(), sessionID, payload); err != nil {
+			slog.Warn("Failed to publish mcp_server_disabled timeline event", "session_id", sessionID, "error", err)
+		}
+	}
+
+	resp := toDisabledMCPServerResponse(event)
+	return &resp, nil
+}
+
+// parseDisableMCPCommand recognizes "/disable-mcp <server_id> [reason]" in a
+// chat message. ok is false for ordinary messages; serverID is empty when
+// the command is missing its argument.
+func parseDisableMCPCommand(content string) (serverID, reason string, ok bool) {
+	rest, found := strings.CutPrefix(strings.TrimSpace(content), disableMCPCommand)
+	if !found || (rest != "" && rest[0] != ' ' && rest[0] != '\t' && rest[0] != '\n') {
+		return "", "", false
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return "", "", true
+	}
+	reason = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(rest), fields[0]))
+	return fields[0], reason, true
+}
+
+func toDisabledMCPServerResponse(event *ent.TimelineEvent) DisabledMCPServerResponse {
+	author, _ := event.Metadata["author"].(string)
+	reason, _ := event.Metadata[services.MetadataKeyReason].(string)
+	return DisabledMCPServerResponse{
+		EventID:   event.ID,
+		SessionID: event.SessionID,
+		ServerID:  services.DisabledMCPServerID(event),
+		Reason:    reason,
+		Author:    author,
+		CreatedAt: event.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableMCPServerHandler_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{
+			name:     "invalid body",
+			body:     `{"server_id":1}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "missing server_id",
+			body:     `{"reason":"returns garbage"}`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Requests in this test are rejected before the session service is used.
+			s := &Server{}
+			e := echo.New()
+			e.POST("/api/v1/sessions/:id/disabled-mcp-servers", s.disableMCPServerHandler)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/sessions/sess-1/disabled-mcp-servers", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func TestParseDisableMCPCommand(t *testing.T) {
+	tests := []struct {
+		content    string
+		wantServer string
+		wantReason string
+		wantOK     bool
+	}{
+		{content: "what is wrong with the pod?"},
+		{content: "/disable-mcpserver github"},
+		{content: "please /disable-mcp github"},
+		{content: "/disable-mcp", wantOK: true},
+		{content: "  /disable-mcp   ", wantOK: true},
+		{content: "/disable-mcp github", wantServer: "github", wantOK: true},
+		{
+			content:    " /disable-mcp kubernetes-server  returns pods from the wrong cluster ",
+			wantServer: "kubernetes-server",
+			wantReason: "returns pods from the wrong cluster",
+			wantOK:     true,
+		},
+		{content: "/disable-mcp\ngithub\nflaky", wantServer: "github", wantReason: "flaky", wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.content, func(t *testing.T) {
+			server, reason, ok := parseDisableMCPCommand(tt.content)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantServer, server)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
@@ -19,7 +19,7 @@ func (s *Server) getSessionMemoriesHandler(c *echo.Context) error {
 	}
 	sessionID := c.Param("id")
 	if sessionID == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+		return invalidField("id", "required")
 	}
 
 	memories, err := s.memoryService.GetBySessionID(c.Request().Context(), sessionID)
@@ -37,7 +37,7 @@ func (s *Server) getInjectedMemoriesHandler(c *echo.Context) error {
 	}
 	sessionID := c.Param("id")
 	if sessionID == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+		return invalidField("id", "required")
 	}
 
 	memories, err := s.memoryService.GetInjectedBySessionID(c.Request().Context(), sessionID)
@@ -108,7 +108,7 @@ func (s *Server) getMemoryHandler(c *echo.Context) error {
 	}
 	memoryID := c.Param("id")
 	if memoryID == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "memory id is required")
+		return invalidField("id", "required")
 	}
 
 	m, err := s.memoryService.GetByID(c.Request().Context(), memoryID)
@@ -125,12 +125,12 @@ func (s *Server) updateMemoryHandler(c *echo.Context) error {
 	}
 	memoryID := c.Param("id")
 	if memoryID == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "memory id is required")
+		return invalidField("id", "required")
 	}
 
 	var req models.UpdateMemoryRequest
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
 	}
 
 	m, err := s.memoryService.Update(c.Request().Context(), memoryID, memory.UpdateInput{
@@ -152,7 +152,7 @@ func (s *Server) deleteMemoryHandler(c *echo.Context) error {
 	}
 	memoryID := c.Param("id")
 	if memoryID == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "memory id is required")
+		return invalidField("id", "required")
 	}
 
 	if err := s.memoryService.Delete(c.Request().Context(), memoryID); err != nil {
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+)
+
+// AddOperatorNoteRequest is the HTTP request body for POST /sessions/:id/notes.
+type AddOperatorNoteRequest struct {
+	Content string `json:"content" validate:"required"`
+}
+
+// OperatorNoteResponse describes a single operator note.
+type OperatorNoteResponse struct {
+	EventID   string  `json:"event_id"`
+	SessionID string  `json:"session_id"`
+	StageID   *string `json:"stage_id,omitempty"`
+	Content   string  `json:"content"`
+	Author    string  `json:"author"`
+	CreatedAt string  `json:"created_at"`
+}
+
+// addOperatorNoteHandler handles POST /api/v1/sessions/:id/notes.
+// Records an operator note on a queued or running session. The running agent
+// receives it at its next iteration; later stages see it in their context.
+func (s *Server) addOperatorNoteHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	var req AddOperatorNoteRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	author, _ := s.resolveAuthor(c)
+	note, err := s.sessionService.AddOperatorNote(c.Request().Context(), sessionID, author, req.Content)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	if s.eventPublisher != nil {
+		payload := events.TimelineCreatedPayload{
+			BasePayload: events.BasePayload{
+				Type:      events.EventTypeTimelineCreated,
+				SessionID: sessionID,
+				Timestamp: note.CreatedAt.Format(time.RFC3339Nano),
+			},
+			EventID:        note.ID,
+			EventType:      note.EventType,
+			Status:         note.Status,
+			Content:        note.Content,
+			Metadata:       note.Metadata,
+			SequenceNumber: note.SequenceNumber,
+		}
+		if note.StageID != nil {
+			payload.StageID = *note.StageID
+		}
+		if err := s.eventPublisher.PublishTimelineCreated(c.Request().Context(), sessionID, payload); err != nil {
+			slog.Warn("Failed to publish operator note timeline event", "session_id", sessionID, "error", err)
+		}
+	}
+
+	return c.JSON(http.StatusCreated, toOperatorNoteResponse(note))
+}
+
+// listOperatorNotesHandler handles GET /api/v1/sessions/:id/notes.
+func (s *Server) listOperatorNotesHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	notes, err := s.sessionService.ListOperatorNotes(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	resp := make([]OperatorNoteResponse, 0, len(notes))
+	for _, note := range notes {
+		resp = append(resp, toOperatorNoteResponse(note))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func toOperatorNoteResponse(note *ent.TimelineEvent) OperatorNoteResponse {
+	author, _ := note.Metadata["author"].(string)
+	return OperatorNoteResponse{
+		EventID:   note.ID,
+		SessionID: note.SessionID,
+		StageID:   note.StageID,
+		Content:   note.Content,
+		Author:    author,
+		CreatedAt: note.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
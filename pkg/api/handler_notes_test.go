@@ -0,0 +1,46 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddOperatorNoteHandler_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{
+			name:     "invalid body",
+			body:     `{"content":1}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "missing content",
+			body:     `{}`,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Requests in this test are rejected before the session service is used.
+			s := &Server{}
+			e := echo.New()
+			e.POST("/api/v1/sessions/:id/notes", s.addOperatorNoteHandler)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/sessions/sess-1/notes", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
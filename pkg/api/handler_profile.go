@@ -0,0 +1,68 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// getProfileHandler handles GET /api/v1/profile.
+// Returns the caller's profile, creating it on first access.
+func (s *Server) getProfileHandler(c *echo.Context) error {
+	if s.userProfileService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "user profiles are not available")
+	}
+	identity := extractIdentity(c)
+	if identity.Subject == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no authenticated user identity")
+	}
+
+	profile, err := s.userProfileService.GetOrCreate(c.Request().Context(), identity)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, toUserProfileResponse(profile))
+}
+
+// updateProfileHandler handles PATCH /api/v1/profile.
+// Partially updates the caller's display name and preferences.
+func (s *Server) updateProfileHandler(c *echo.Context) error {
+	if s.userProfileService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "user profiles are not available")
+	}
+	identity := extractIdentity(c)
+	if identity.Subject == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "no authenticated user identity")
+	}
+
+	var req models.UpdateUserProfileRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	for i, chainID := range req.FavoriteChains {
+		if chainID != "" && !s.cfg.ChainRegistry.Has(chainID) {
+			return invalidField(fmt.Sprintf("favorite_chains[%d]", i), "unknown chain %q", chainID)
+		}
+	}
+
+	ctx := c.Request().Context()
+	if _, err := s.userProfileService.GetOrCreate(ctx, identity); err != nil {
+		return mapServiceError(err)
+	}
+	profile, err := s.userProfileService.UpdateProfile(ctx, identity.Subject, req)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, toUserProfileResponse(profile))
+}
+
+func toUserProfileResponse(p *ent.UserProfile) models.UserProfileResponse {
+	resp := models.NewUserProfileResponse(p)
+	resp.Channel = events.UserChannel(p.ID)
+	return resp
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+func TestProfileHandlers(t *testing.T) {
+	withService := &Server{
+		cfg: &config.Config{
+			ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{
+				"k8s": {AlertTypes: []string{"Pod"}},
+			}),
+		},
+		// Requests in this test are rejected before the client is used.
+		userProfileService: services.NewUserProfileService(nil),
+	}
+
+	tests := []struct {
+		name     string
+		server   *Server
+		method   string
+		body     string
+		headers  map[string]string
+		wantCode int
+	}{
+		{
+			name:     "get without service",
+			server:   &Server{},
+			method:   http.MethodGet,
+			headers:  map[string]string{"X-Forwarded-User": "sub-1"},
+			wantCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "get without identity",
+			server:   withService,
+			method:   http.MethodGet,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "patch without identity",
+			server:   withService,
+			method:   http.MethodPatch,
+			body:     `{"display_name":"Alice"}`,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "patch with invalid body",
+			server:   withService,
+			method:   http.MethodPatch,
+			body:     `{"favorite_chains":"k8s"}`,
+			headers:  map[string]string{"X-Forwarded-User": "sub-1"},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "patch with unknown favorite chain",
+			server:   withService,
+			method:   http.MethodPatch,
+			body:     `{"favorite_chains":["k8s","nope"]}`,
+			headers:  map[string]string{"X-Forwarded-User": "sub-1"},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.GET("/api/v1/profile", tt.server.getProfileHandler)
+			e.PATCH("/api/v1/profile", tt.server.updateProfileHandler)
+
+			req := httptest.NewRequest(tt.method, "/api/v1/profile", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
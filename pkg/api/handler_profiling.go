@@ -0,0 +1,158 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"sort"
+	"strconv"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/diagnostics"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/version"
+)
+
+// maxProfileSeconds caps CPU profiles and execution traces so a single
+// request cannot keep the profiler busy indefinitely.
+const maxProfileSeconds = 60
+
+// RuntimeStatsResponse is returned by GET /api/v1/system/runtime.
+type RuntimeStatsResponse struct {
+	PodID                 string                  `json:"pod_id"`
+	GoVersion             string                  `json:"go_version"`
+	Version               string                  `json:"version"`
+	GOMAXPROCS            int                     `json:"gomaxprocs"`
+	Goroutines            int                     `json:"goroutines"`
+	GoroutinesBySubsystem map[string]int          `json:"goroutines_by_subsystem"`
+	Memory                diagnostics.MemoryStats `json:"memory"`
+	ActiveSessions        int                     `json:"active_sessions"` // processing on this pod
+	MCPSubprocesses       int                     `json:"mcp_subprocesses"`
+	HeapCapture           *HeapCaptureStatus      `json:"heap_capture,omitempty"` // nil when automatic capture is off
+}
+
+// HeapCaptureStatus describes automatic heap-profile capture on this pod.
+type HeapCaptureStatus struct {
+	RSSThresholdBytes uint64                    `json:"rss_threshold_bytes"`
+	Profiles          []diagnostics.HeapProfile `json:"profiles"` // newest first
+}
+
+// ProfilesResponse is returned by GET /api/v1/debug/pprof.
+type ProfilesResponse struct {
+	Profiles []string                  `json:"profiles"` // names usable as /debug/pprof/:name
+	Captured []diagnostics.HeapProfile `json:"captured"` // automatic heap captures, newest first
+}
+
+// runtimeStatsHandler handles GET /api/v1/system/runtime.
+// Per-pod: reports the runtime of the pod that serves the request.
+func (s *Server) runtimeStatsHandler(c *echo.Context) error {
+	resp := RuntimeStatsResponse{
+		GoVersion:             runtime.Version(),
+		Version:               version.GitCommit,
+		GOMAXPROCS:            runtime.GOMAXPROCS(0),
+		Goroutines:            runtime.NumGoroutine(),
+		GoroutinesBySubsystem: diagnostics.GoroutinesBySubsystem(),
+		Memory:                diagnostics.ReadMemory(),
+		MCPSubprocesses:       mcp.StdioProcessCount(),
+	}
+	if s.workerPool != nil {
+		resp.PodID = s.workerPool.PodID()
+		resp.ActiveSessions = s.workerPool.ActiveSessionCount()
+	}
+	if s.heapCapture != nil {
+		resp.HeapCapture = &HeapCaptureStatus{
+			RSSThresholdBytes: s.heapCapture.ThresholdBytes(),
+			Profiles:          s.heapCapture.Profiles(),
+		}
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// auditProfiling logs every access to the pprof endpoints with the caller
+// and keeps profiles out of caches. It runs after requireAdmin, which is why
+// these routes live under /api/* (covered by the proxy auth rule).
+func auditProfiling(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		slog.Info("Profiling endpoint accessed",
+			"path", c.Request().URL.Path, "caller", extractAuthor(c))
+		c.Response().Header().Set("Cache-Control", "no-store")
+		return next(c)
+	}
+}
+
+// profilesHandler handles GET /api/v1/debug/pprof.
+func (s *Server) profilesHandler(c *echo.Context) error {
+	resp := ProfilesResponse{
+		Profiles: []string{"profile", "trace"},
+		Captured: s.heapCapture.Profiles(),
+	}
+	for _, p := range runtimepprof.Profiles() {
+		resp.Profiles = append(resp.Profiles, p.Name())
+	}
+	sort.Strings(resp.Profiles)
+	if resp.Captured == nil {
+		resp.Captured = []diagnostics.HeapProfile{}
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// pprofHandler handles GET /api/v1/debug/pprof/:name. "profile" (CPU) and
+// "trace" honour ?seconds= up to maxProfileSeconds; other names are runtime
+// profiles such as heap, allocs, goroutine, block, mutex, and threadcreate.
+func (s *Server) pprofHandler(c *echo.Context) error {
+	name := c.Param("name")
+	switch name {
+	case "profile", "trace":
+		if v := c.QueryParam("seconds"); v != "" {
+			sec, err := strconv.Atoi(v)
+			if err != nil || sec <= 0 || sec > maxProfileSeconds {
+				return echo.NewHTTPError(http.StatusBadRequest,
+					"seconds must be between 1 and "+strconv.Itoa(maxProfileSeconds))
+			}
+		}
+		if name == "profile" {
+			pprof.Profile(c.Response(), c.Request())
+		} else {
+			pprof.Trace(c.Response(), c.Request())
+		}
+		return nil
+	case "cmdline":
+		pprof.Cmdline(c.Response(), c.Request())
+		return nil
+	case "symbol":
+		pprof.Symbol(c.Response(), c.Request())
+		return nil
+	}
+
+	if runtimepprof.Lookup(name) == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "unknown profile: "+name)
+	}
+	pprof.Handler(name).ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// capturedProfileHandler handles GET /api/v1/debug/pprof/captured/:name,
+// downloading a heap profile written by automatic capture.
+func (s *Server) capturedProfileHandler(c *echo.Context) error {
+	f, err := s.heapCapture.Open(c.Param("name"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return echo.NewHTTPError(http.StatusNotFound, "captured profile not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to open captured profile")
+	}
+	defer func() { _ = f.Close() }()
+
+	h := c.Response().Header()
+	h.Set("Content-Type", "application/octet-stream")
+	h.Set("Content-Disposition", `attachment; filename="`+c.Param("name")+`"`)
+	c.Response().WriteHeader(http.StatusOK)
+	_, err = io.Copy(c.Response(), f)
+	return err
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditProfiling(t *testing.T) {
+	ok := func(c *echo.Context) error { return c.NoContent(http.StatusOK) }
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/debug/pprof", nil)
+	req.Header.Set("X-Forwarded-Email", "alice@example.com")
+	rec := httptest.NewRecorder()
+
+	require.NoError(t, auditProfiling(ok)(echo.New().NewContext(req, rec)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+}
+
+func TestPprofHandler(t *testing.T) {
+	call := func(t *testing.T, name, query string) (*httptest.ResponseRecorder, error) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/debug/pprof/"+name+query, nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetPathValues(echo.PathValues{{Name: "name", Value: name}})
+		return rec, (&Server{}).pprofHandler(c)
+	}
+
+	t.Run("goroutine text profile", func(t *testing.T) {
+		rec, err := call(t, "goroutine", "?debug=1")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "goroutine profile: total")
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, err := call(t, "nope", "")
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	})
+
+	t.Run("cpu profile duration is capped", func(t *testing.T) {
+		for _, q := range []string{"?seconds=0", "?seconds=61", "?seconds=abc"} {
+			_, err := call(t, "profile", q)
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr, q)
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code, q)
+		}
+	})
+}
+
+func TestProfilesHandler(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/debug/pprof", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, (&Server{}).profilesHandler(echo.New().NewContext(req, rec)))
+
+	var resp ProfilesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Contains(t, resp.Profiles, "heap")
+	assert.Contains(t, resp.Profiles, "profile")
+	assert.NotNil(t, resp.Captured)
+}
+
+func TestCapturedProfileHandler_NotFoundWithoutCapture(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/debug/pprof/captured/heap-x.pb.gz", nil)
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.SetPathValues(echo.PathValues{{Name: "name", Value: "heap-x.pb.gz"}})
+
+	err := (&Server{}).capturedProfileHandler(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+}
+
+func TestRuntimeStatsHandler(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/system/runtime", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, (&Server{}).runtimeStatsHandler(echo.New().NewContext(req, rec)))
+
+	var resp RuntimeStatsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.True(t, strings.HasPrefix(resp.GoVersion, "go"))
+	assert.Positive(t, resp.Goroutines)
+	assert.NotEmpty(t, resp.GoroutinesBySubsystem)
+	assert.Positive(t, resp.Memory.HeapAllocBytes)
+	assert.Nil(t, resp.HeapCapture)
+}
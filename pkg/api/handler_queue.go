@@ -0,0 +1,356 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/queue"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// queueRecoveryWindow is how far back GET /system/queue reports orphan
+// recoveries.
+const queueRecoveryWindow = 24 * time.Hour
+
+// --- Response types ---
+
+// QueueResponse is returned by GET /api/v1/system/queue.
+type QueueResponse struct {
+	PendingSessions         int     `json:"pending_sessions"`
+	OldestPendingAt         *string `json:"oldest_pending_at,omitempty"`
+	OldestPendingAgeSeconds float64 `json:"oldest_pending_age_seconds"`
+
+	// Workers lists every claim currently held, across all pods.
+	Workers []QueueWorkerItem `json:"workers"`
+
+	// RecentRecoveries lists claims ended by orphan recovery in the last 24h.
+	RecentRecoveries []SessionClaimItem `json:"recent_recoveries"`
+
+	// Pauses lists the active pauses of session claiming.
+	Pauses []queue.QueuePause `json:"pauses"`
+
+	// LocalPod is the in-memory worker state of the pod serving the request.
+	LocalPod *queue.PoolHealth `json:"local_pod,omitempty"`
+}
+
+// QueuePausesResponse is returned by the /api/v1/system/queue/pause endpoints.
+type QueuePausesResponse struct {
+	Pauses []queue.QueuePause `json:"pauses"`
+}
+
+// QueueWorkerItem is a worker currently holding a session.
+type QueueWorkerItem struct {
+	PodID           string  `json:"pod_id"`
+	WorkerID        string  `json:"worker_id"`
+	SessionID       string  `json:"session_id"`
+	AlertType       string  `json:"alert_type,omitempty"`
+	SessionStatus   string  `json:"session_status,omitempty"`
+	ClaimedAt       string  `json:"claimed_at"`
+	QueueWaitMs     int64   `json:"queue_wait_ms"`
+	LastHeartbeatAt *string `json:"last_heartbeat_at,omitempty"`
+}
+
+// SessionClaimItem is one claim of a session by a worker.
+type SessionClaimItem struct {
+	ID              string  `json:"id"`
+	SessionID       string  `json:"session_id"`
+	PodID           string  `json:"pod_id"`
+	WorkerID        string  `json:"worker_id"`
+	ClaimedAt       string  `json:"claimed_at"`
+	QueueWaitMs     int64   `json:"queue_wait_ms"`
+	ReleasedAt      *string `json:"released_at,omitempty"`
+	Outcome         *string `json:"outcome,omitempty"`
+	LastHeartbeatAt *string `json:"last_heartbeat_at,omitempty"`
+	Detail          *string `json:"detail,omitempty"`
+}
+
+// OrphanRecoveriesResponse is returned by GET /api/v1/system/orphans.
+type OrphanRecoveriesResponse struct {
+	Since      string               `json:"since"`
+	Recoveries []OrphanRecoveryItem `json:"recoveries"`
+}
+
+// OrphanRecoveryItem is a session recovered from a worker that stopped
+// heartbeating. Reason is the recovery message recorded on the claim.
+type OrphanRecoveryItem struct {
+	SessionClaimItem
+	AlertType     string `json:"alert_type,omitempty"`
+	ChainID       string `json:"chain_id,omitempty"`
+	SessionStatus string `json:"session_status,omitempty"` // current status; pending/in_progress again after a requeue
+	Requeued      bool   `json:"requeued"`
+}
+
+// SessionQueueResponse is returned by GET /api/v1/sessions/:id/queue.
+type SessionQueueResponse struct {
+	SessionID       string  `json:"session_id"`
+	Status          string  `json:"status"`
+	CreatedAt       string  `json:"created_at"`
+	StartedAt       *string `json:"started_at,omitempty"`
+	QueueWaitMs     *int64  `json:"queue_wait_ms,omitempty"`  // submit → first claim (or → now while pending)
+	QueuePosition   *int    `json:"queue_position,omitempty"` // 1-based, pending sessions only
+	PodID           *string `json:"pod_id,omitempty"`
+	LastHeartbeatAt *string `json:"last_heartbeat_at,omitempty"`
+	ClaimCount      int     `json:"claim_count"`
+	RequeueCount    int     `json:"requeue_count"` // claims beyond the first
+
+	Claims []SessionClaimItem `json:"claims"`
+}
+
+// --- Handlers ---
+
+// queueHandler handles GET /api/v1/system/queue.
+func (s *Server) queueHandler(c *echo.Context) error {
+	now := time.Now()
+	snap, err := s.sessionService.GetQueueSnapshot(c.Request().Context(), now.Add(-queueRecoveryWindow))
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	resp := buildQueueResponse(snap, now)
+	if s.workerPool != nil {
+		resp.Pauses = s.workerPool.Pauses()
+		resp.LocalPod = s.workerPool.Health()
+	}
+	if resp.Pauses == nil {
+		resp.Pauses = []queue.QueuePause{}
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// queuePausesHandler handles GET /api/v1/system/queue/pause.
+func (s *Server) queuePausesHandler(c *echo.Context) error {
+	if s.workerPool == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "queue pause is not available")
+	}
+	return s.queuePausesResponse(c)
+}
+
+// pauseQueueHandler handles PUT /api/v1/system/queue/pause.
+// Stops claiming of new sessions on one pod or, without pod_id, on every
+// pod; in-flight sessions run to completion. Other pods pick the pause up
+// on their next refresh.
+func (s *Server) pauseQueueHandler(c *echo.Context) error {
+	if s.workerPool == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "queue pause is not available")
+	}
+	var req PauseQueueRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	scope := queuePauseScope(req.PodID)
+	reason := strings.TrimSpace(req.Reason)
+	if _, err := s.workerPool.Pause(c.Request().Context(), scope, reason, req.Maintenance, extractAuthor(c)); err != nil {
+		return mapQueuePauseError(c, err)
+	}
+	return s.queuePausesResponse(c)
+}
+
+// resumeQueueHandler handles DELETE /api/v1/system/queue/pause.
+// Optional query param: pod_id (default: the cluster-wide pause). Resuming
+// a scope that is not paused is not an error.
+func (s *Server) resumeQueueHandler(c *echo.Context) error {
+	if s.workerPool == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "queue pause is not available")
+	}
+	scope := queuePauseScope(c.QueryParam("pod_id"))
+	if _, err := s.workerPool.Resume(c.Request().Context(), scope, extractAuthor(c)); err != nil {
+		return mapQueuePauseError(c, err)
+	}
+	return s.queuePausesResponse(c)
+}
+
+func (s *Server) queuePausesResponse(c *echo.Context) error {
+	pauses := s.workerPool.Pauses()
+	if pauses == nil {
+		pauses = []queue.QueuePause{}
+	}
+	return c.JSON(http.StatusOK, QueuePausesResponse{Pauses: pauses})
+}
+
+// queuePauseScope maps an optional pod ID to a pause scope.
+func queuePauseScope(podID string) string {
+	if podID = strings.TrimSpace(podID); podID != "" {
+		return podID
+	}
+	return services.QueuePauseScopeCluster
+}
+
+func mapQueuePauseError(c *echo.Context, err error) error {
+	if errors.Is(err, queue.ErrPauseUnavailable) {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+	}
+	slog.ErrorContext(c.Request().Context(), "Failed to update queue pause", "error", err)
+	return echo.NewHTTPError(http.StatusInternalServerError, "failed to update queue pause")
+}
+
+// orphanRecoveriesHandler handles GET /api/v1/system/orphans.
+// Optional query params: since (RFC3339 or a duration such as 6h, default 24h),
+// limit (1-200, default 20).
+func (s *Server) orphanRecoveriesHandler(c *echo.Context) error {
+	now := time.Now()
+	since := now.Add(-queueRecoveryWindow)
+	if v := c.QueryParam("since"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			since = now.Add(-d)
+		} else if t, err := time.Parse(time.RFC3339, v); err == nil {
+			since = t
+		} else {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid since: must be RFC3339 or a positive duration")
+		}
+	}
+
+	limit := defaultPageSize
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid limit: must be between 1 and %d", maxPageSize))
+		}
+		limit = n
+	}
+
+	claims, err := s.sessionService.ListOrphanRecoveries(c.Request().Context(), since, limit)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, buildOrphanRecoveriesResponse(claims, since))
+}
+
+// sessionQueueHandler handles GET /api/v1/sessions/:id/queue.
+func (s *Server) sessionQueueHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	info, err := s.sessionService.GetSessionQueueInfo(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, buildSessionQueueResponse(info, time.Now()))
+}
+
+func buildQueueResponse(snap *services.QueueSnapshot, now time.Time) *QueueResponse {
+	resp := &QueueResponse{
+		PendingSessions:  snap.PendingCount,
+		Workers:          make([]QueueWorkerItem, 0, len(snap.ActiveClaims)),
+		RecentRecoveries: make([]SessionClaimItem, 0, len(snap.RecentRecoveries)),
+	}
+	if snap.OldestPendingAt != nil {
+		resp.OldestPendingAt = formatTimePtr(snap.OldestPendingAt)
+		resp.OldestPendingAgeSeconds = now.Sub(*snap.OldestPendingAt).Seconds()
+	}
+
+	for _, cl := range snap.ActiveClaims {
+		item := QueueWorkerItem{
+			PodID:       cl.PodID,
+			WorkerID:    cl.WorkerID,
+			SessionID:   cl.SessionID,
+			ClaimedAt:   cl.ClaimedAt.Format(time.RFC3339),
+			QueueWaitMs: cl.QueueWaitMs,
+		}
+		if sess := cl.Edges.Session; sess != nil {
+			item.AlertType = sess.AlertType
+			item.SessionStatus = string(sess.Status)
+			item.LastHeartbeatAt = formatTimePtr(sess.LastInteractionAt)
+		}
+		resp.Workers = append(resp.Workers, item)
+	}
+	for _, cl := range snap.RecentRecoveries {
+		resp.RecentRecoveries = append(resp.RecentRecoveries, buildSessionClaimItem(cl))
+	}
+	return resp
+}
+
+func buildOrphanRecoveriesResponse(claims []*ent.SessionClaim, since time.Time) *OrphanRecoveriesResponse {
+	resp := &OrphanRecoveriesResponse{
+		Since:      since.Format(time.RFC3339),
+		Recoveries: make([]OrphanRecoveryItem, 0, len(claims)),
+	}
+	for _, cl := range claims {
+		item := OrphanRecoveryItem{SessionClaimItem: buildSessionClaimItem(cl)}
+		if cl.Detail != nil {
+			item.Requeued = strings.HasSuffix(*cl.Detail, queue.RequeuedDetailSuffix)
+		}
+		if sess := cl.Edges.Session; sess != nil {
+			item.AlertType = sess.AlertType
+			item.ChainID = sess.ChainID
+			item.SessionStatus = string(sess.Status)
+		}
+		resp.Recoveries = append(resp.Recoveries, item)
+	}
+	return resp
+}
+
+func buildSessionQueueResponse(info *services.SessionQueueInfo, now time.Time) *SessionQueueResponse {
+	sess := info.Session
+	resp := &SessionQueueResponse{
+		SessionID:       sess.ID,
+		Status:          string(sess.Status),
+		CreatedAt:       sess.CreatedAt.Format(time.RFC3339),
+		StartedAt:       formatTimePtr(sess.StartedAt),
+		PodID:           sess.PodID,
+		LastHeartbeatAt: formatTimePtr(sess.LastInteractionAt),
+		ClaimCount:      len(info.Claims),
+		RequeueCount:    max(len(info.Claims)-1, 0),
+		Claims:          make([]SessionClaimItem, 0, len(info.Claims)),
+	}
+
+	switch {
+	case len(info.Claims) > 0:
+		wait := info.Claims[0].QueueWaitMs
+		resp.QueueWaitMs = &wait
+	case sess.StartedAt != nil:
+		// Claimed before claim history was recorded
+		wait := sess.StartedAt.Sub(sess.CreatedAt).Milliseconds()
+		resp.QueueWaitMs = &wait
+	case info.Position > 0:
+		wait := now.Sub(sess.CreatedAt).Milliseconds()
+		resp.QueueWaitMs = &wait
+	}
+	if info.Position > 0 {
+		pos := info.Position
+		resp.QueuePosition = &pos
+	}
+
+	for _, cl := range info.Claims {
+		resp.Claims = append(resp.Claims, buildSessionClaimItem(cl))
+	}
+	return resp
+}
+
+func buildSessionClaimItem(cl *ent.SessionClaim) SessionClaimItem {
+	item := SessionClaimItem{
+		ID:              cl.ID,
+		SessionID:       cl.SessionID,
+		PodID:           cl.PodID,
+		WorkerID:        cl.WorkerID,
+		ClaimedAt:       cl.ClaimedAt.Format(time.RFC3339),
+		QueueWaitMs:     cl.QueueWaitMs,
+		ReleasedAt:      formatTimePtr(cl.ReleasedAt),
+		LastHeartbeatAt: formatTimePtr(cl.LastHeartbeatAt),
+		Detail:          cl.Detail,
+	}
+	if cl.Outcome != nil {
+		outcome := string(*cl.Outcome)
+		item.Outcome = &outcome
+	}
+	return item
+}
+
+// formatTimePtr formats t as RFC 3339, or returns nil when t is nil.
+func formatTimePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
@@ -0,0 +1,276 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/queue"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+func TestBuildQueueResponse(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	oldest := now.Add(-20 * time.Minute)
+	heartbeat := now.Add(-10 * time.Second)
+	released := now.Add(-time.Hour)
+	orphaned := sessionclaim.OutcomeOrphaned
+	detail := "Orphaned: no heartbeat from pod pod-b since 2024-06-01T10:55:00Z"
+
+	resp := buildQueueResponse(&services.QueueSnapshot{
+		PendingCount:    3,
+		OldestPendingAt: &oldest,
+		ActiveClaims: []*ent.SessionClaim{{
+			ID: "c1", SessionID: "s1", PodID: "pod-a", WorkerID: "pod-a-worker-0",
+			ClaimedAt: now.Add(-time.Minute), QueueWaitMs: 1500,
+			Edges: ent.SessionClaimEdges{Session: &ent.AlertSession{
+				AlertType: "PodCrash", Status: alertsession.StatusInProgress, LastInteractionAt: &heartbeat,
+			}},
+		}},
+		RecentRecoveries: []*ent.SessionClaim{{
+			ID: "c0", SessionID: "s0", PodID: "pod-b", WorkerID: "pod-b-worker-1",
+			ClaimedAt: now.Add(-2 * time.Hour), ReleasedAt: &released, Outcome: &orphaned, Detail: &detail,
+		}},
+	}, now)
+
+	assert.Equal(t, 3, resp.PendingSessions)
+	assert.Equal(t, "2024-06-01T11:40:00Z", *resp.OldestPendingAt)
+	assert.Equal(t, float64(1200), resp.OldestPendingAgeSeconds)
+
+	require.Len(t, resp.Workers, 1)
+	assert.Equal(t, QueueWorkerItem{
+		PodID:           "pod-a",
+		WorkerID:        "pod-a-worker-0",
+		SessionID:       "s1",
+		AlertType:       "PodCrash",
+		SessionStatus:   "in_progress",
+		ClaimedAt:       "2024-06-01T11:59:00Z",
+		QueueWaitMs:     1500,
+		LastHeartbeatAt: strPtr("2024-06-01T11:59:50Z"),
+	}, resp.Workers[0])
+
+	require.Len(t, resp.RecentRecoveries, 1)
+	assert.Equal(t, "orphaned", *resp.RecentRecoveries[0].Outcome)
+	assert.Equal(t, detail, *resp.RecentRecoveries[0].Detail)
+
+	empty := buildQueueResponse(&services.QueueSnapshot{}, now)
+	assert.NotNil(t, empty.Workers)
+	assert.NotNil(t, empty.RecentRecoveries)
+	assert.Nil(t, empty.OldestPendingAt)
+}
+
+func TestBuildSessionQueueResponse(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	created := now.Add(-30 * time.Minute)
+
+	t.Run("pending session reports position and wait so far", func(t *testing.T) {
+		resp := buildSessionQueueResponse(&services.SessionQueueInfo{
+			Session:  &ent.AlertSession{ID: "s1", Status: alertsession.StatusPending, CreatedAt: created},
+			Position: 4,
+		}, now)
+
+		assert.Equal(t, 4, *resp.QueuePosition)
+		assert.Equal(t, int64(30*time.Minute/time.Millisecond), *resp.QueueWaitMs)
+		assert.Zero(t, resp.ClaimCount)
+		assert.NotNil(t, resp.Claims)
+	})
+
+	t.Run("claimed session reports claim history", func(t *testing.T) {
+		started := created.Add(20 * time.Minute)
+		completed := sessionclaim.OutcomeCompleted
+		resp := buildSessionQueueResponse(&services.SessionQueueInfo{
+			Session: &ent.AlertSession{ID: "s1", Status: alertsession.StatusCompleted, CreatedAt: created, StartedAt: &started, PodID: strPtr("pod-a")},
+			Claims: []*ent.SessionClaim{
+				{ID: "c1", SessionID: "s1", PodID: "pod-a", WorkerID: "pod-a-worker-2", ClaimedAt: started, QueueWaitMs: 1_200_000, Outcome: &completed},
+			},
+		}, now)
+
+		assert.Nil(t, resp.QueuePosition)
+		assert.Equal(t, int64(1_200_000), *resp.QueueWaitMs)
+		assert.Equal(t, 1, resp.ClaimCount)
+		assert.Equal(t, 0, resp.RequeueCount)
+		require.Len(t, resp.Claims, 1)
+		assert.Equal(t, "pod-a-worker-2", resp.Claims[0].WorkerID)
+		assert.Equal(t, "completed", *resp.Claims[0].Outcome)
+	})
+
+	t.Run("session claimed before history falls back to started_at", func(t *testing.T) {
+		started := created.Add(5 * time.Minute)
+		resp := buildSessionQueueResponse(&services.SessionQueueInfo{
+			Session: &ent.AlertSession{ID: "s1", Status: alertsession.StatusCompleted, CreatedAt: created, StartedAt: &started},
+		}, now)
+		assert.Equal(t, int64(5*time.Minute/time.Millisecond), *resp.QueueWaitMs)
+	})
+}
+
+func TestSessionQueueHandler_MissingSessionID(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions//queue", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	err := s.sessionQueueHandler(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+}
+
+func TestBuildOrphanRecoveriesResponse(t *testing.T) {
+	since := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	released := since.Add(time.Hour)
+	orphaned := sessionclaim.OutcomeOrphaned
+	restarted := sessionclaim.OutcomePodRestarted
+	requeuedDetail := "Orphaned: no heartbeat from pod pod-b since 2024-06-01T00:55:00Z" + queue.RequeuedDetailSuffix
+	failedDetail := "Orphaned: pod pod-c restarted while session was in progress"
+
+	resp := buildOrphanRecoveriesResponse([]*ent.SessionClaim{
+		{
+			ID: "c1", SessionID: "s1", PodID: "pod-b", WorkerID: "pod-b-worker-0",
+			ClaimedAt: since, ReleasedAt: &released, Outcome: &orphaned, Detail: &requeuedDetail,
+			Edges: ent.SessionClaimEdges{Session: &ent.AlertSession{
+				AlertType: "PodCrash", ChainID: "k8s", Status: alertsession.StatusPending,
+			}},
+		},
+		{
+			ID: "c2", SessionID: "s2", PodID: "pod-c", WorkerID: "pod-c-worker-1",
+			ClaimedAt: since, ReleasedAt: &released, Outcome: &restarted, Detail: &failedDetail,
+		},
+	}, since)
+
+	assert.Equal(t, "2024-06-01T00:00:00Z", resp.Since)
+	require.Len(t, resp.Recoveries, 2)
+
+	assert.True(t, resp.Recoveries[0].Requeued)
+	assert.Equal(t, "PodCrash", resp.Recoveries[0].AlertType)
+	assert.Equal(t, "k8s", resp.Recoveries[0].ChainID)
+	assert.Equal(t, "pending", resp.Recoveries[0].SessionStatus)
+	assert.Equal(t, "orphaned", *resp.Recoveries[0].Outcome)
+
+	assert.False(t, resp.Recoveries[1].Requeued)
+	assert.Equal(t, "pod_restarted", *resp.Recoveries[1].Outcome)
+	assert.Equal(t, failedDetail, *resp.Recoveries[1].Detail)
+	assert.Empty(t, resp.Recoveries[1].AlertType)
+
+	empty := buildOrphanRecoveriesResponse(nil, since)
+	assert.NotNil(t, empty.Recoveries)
+}
+
+func TestOrphanRecoveriesHandler_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "invalid since", query: "since=yesterday"},
+		{name: "negative since duration", query: "since=-1h"},
+		{name: "limit too large", query: "limit=1000"},
+		{name: "limit not a number", query: "limit=abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{}
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/system/orphans?"+tt.query, nil)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			err := s.orphanRecoveriesHandler(c)
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		})
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+// memPauseStore is an in-memory queue.PauseStore.
+type memPauseStore map[string]*ent.QueuePause
+
+func (m memPauseStore) List(context.Context) ([]*ent.QueuePause, error) {
+	var out []*ent.QueuePause
+	for _, r := range m {
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (m memPauseStore) Pause(_ context.Context, scope, reason string, maintenance bool, author string) (*ent.QueuePause, error) {
+	row := &ent.QueuePause{ID: scope, Reason: &reason, Maintenance: maintenance, PausedBy: &author, PausedAt: time.Now()}
+	m[scope] = row
+	return row, nil
+}
+
+func (m memPauseStore) Drain(_ context.Context, podID, reason, author string) (*ent.QueuePause, error) {
+	row := &ent.QueuePause{ID: podID, Reason: &reason, Drain: true, PausedBy: &author, PausedAt: time.Now()}
+	m[podID] = row
+	return row, nil
+}
+
+func (m memPauseStore) Resume(_ context.Context, scope string) (bool, error) {
+	_, ok := m[scope]
+	delete(m, scope)
+	return ok, nil
+}
+
+func TestQueuePauseHandlers(t *testing.T) {
+	pool := queue.NewWorkerPool("pod-a", nil, &config.QueueConfig{}, nil, nil, nil, nil)
+	pool.SetPauseStore(memPauseStore{}, nil)
+	s := &Server{workerPool: pool}
+
+	call := func(t *testing.T, method, target, body string, handler func(*Server, *echo.Context) error) QueuePausesResponse {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("X-Forwarded-User", "alice")
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler(s, e.NewContext(req, rec)))
+		var resp QueuePausesResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := call(t, http.MethodGet, "/api/v1/system/queue/pause", "", (*Server).queuePausesHandler)
+	assert.NotNil(t, resp.Pauses)
+	assert.Empty(t, resp.Pauses)
+
+	resp = call(t, http.MethodPut, "/api/v1/system/queue/pause",
+		`{"reason":"  deploy  ","maintenance":true}`, (*Server).pauseQueueHandler)
+	require.Len(t, resp.Pauses, 1)
+	assert.Equal(t, services.QueuePauseScopeCluster, resp.Pauses[0].Scope)
+	assert.Equal(t, "deploy", resp.Pauses[0].Reason)
+	assert.True(t, resp.Pauses[0].Maintenance)
+	assert.Equal(t, "alice", resp.Pauses[0].PausedBy)
+
+	resp = call(t, http.MethodPut, "/api/v1/system/queue/pause", `{"pod_id":"pod-b"}`, (*Server).pauseQueueHandler)
+	require.Len(t, resp.Pauses, 2)
+	assert.Equal(t, "pod-b", resp.Pauses[1].Scope)
+
+	resp = call(t, http.MethodDelete, "/api/v1/system/queue/pause", "", (*Server).resumeQueueHandler)
+	require.Len(t, resp.Pauses, 1)
+	assert.Equal(t, "pod-b", resp.Pauses[0].Scope)
+
+	resp = call(t, http.MethodDelete, "/api/v1/system/queue/pause?pod_id=pod-b", "", (*Server).resumeQueueHandler)
+	assert.Empty(t, resp.Pauses)
+
+	t.Run("unavailable without a worker pool", func(t *testing.T) {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodPut, "/api/v1/system/queue/pause", nil), httptest.NewRecorder())
+		err := (&Server{}).pauseQueueHandler(c)
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+	})
+}
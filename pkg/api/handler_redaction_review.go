@@ -0,0 +1,139 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// listRedactionReviewsHandler handles GET /api/v1/redaction-reviews.
+// Optional query params: status (pending|confirmed|dismissed|all, default
+// pending), session_id, limit (1-200, default 50), offset.
+func (s *Server) listRedactionReviewsHandler(c *echo.Context) error {
+	if s.redactionReviews == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "redaction reviews are not available")
+	}
+
+	params := models.RedactionReviewListParams{
+		Status:    c.QueryParam("status"),
+		SessionID: c.QueryParam("session_id"),
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid limit: must be between 1 and %d", maxPageSize))
+		}
+		params.Limit = n
+	}
+	if v := c.QueryParam("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "offset must be a non-negative integer")
+		}
+		params.Offset = n
+	}
+
+	reviews, total, err := s.redactionReviews.ListReviews(c.Request().Context(), params)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	resp := models.RedactionReviewListResponse{
+		Reviews: make([]models.RedactionReviewResponse, 0, len(reviews)),
+		Total:   total,
+	}
+	for _, r := range reviews {
+		resp.Reviews = append(resp.Reviews, models.NewRedactionReviewResponse(r))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// getRedactionReviewHandler handles GET /api/v1/redaction-reviews/:id.
+func (s *Server) getRedactionReviewHandler(c *echo.Context) error {
+	if s.redactionReviews == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "redaction reviews are not available")
+	}
+	reviewID := c.Param("id")
+	if reviewID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "review id is required")
+	}
+
+	review, err := s.redactionReviews.GetReview(c.Request().Context(), reviewID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewRedactionReviewResponse(review))
+}
+
+// confirmRedactionReviewHandler handles POST /api/v1/redaction-reviews/:id/confirm.
+// Redacts the flagged value from everything the session stored, along with
+// pending reviews of the same value in other sessions.
+func (s *Server) confirmRedactionReviewHandler(c *echo.Context) error {
+	reviewID, reviewer, req, err := s.redactionReviewDecision(c)
+	if err != nil {
+		return err
+	}
+
+	review, err := s.redactionReviews.ConfirmReview(c.Request().Context(), reviewID, reviewer, req.Note)
+	if errors.Is(err, services.ErrConflict) {
+		return echo.NewHTTPError(http.StatusConflict, "review is already resolved")
+	}
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewRedactionReviewResponse(review))
+}
+
+// dismissRedactionReviewHandler handles POST /api/v1/redaction-reviews/:id/dismiss.
+func (s *Server) dismissRedactionReviewHandler(c *echo.Context) error {
+	reviewID, reviewer, req, err := s.redactionReviewDecision(c)
+	if err != nil {
+		return err
+	}
+
+	review, err := s.redactionReviews.DismissReview(c.Request().Context(), reviewID, reviewer, req.Note)
+	if errors.Is(err, services.ErrConflict) {
+		return echo.NewHTTPError(http.StatusConflict, "review is already resolved")
+	}
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewRedactionReviewResponse(review))
+}
+
+// redactionReviewDecision validates a confirm/dismiss request and returns
+// the review ID, the reviewer to record, and the request body. Only
+// callers listed in system.redaction_review.reviewers (anyone when empty)
+// may decide.
+func (s *Server) redactionReviewDecision(c *echo.Context) (string, string, models.ResolveRedactionReviewRequest, error) {
+	var req models.ResolveRedactionReviewRequest
+	if s.redactionReviews == nil {
+		return "", "", req, echo.NewHTTPError(http.StatusServiceUnavailable, "redaction reviews are not available")
+	}
+	reviewID := c.Param("id")
+	if reviewID == "" {
+		return "", "", req, echo.NewHTTPError(http.StatusBadRequest, "review id is required")
+	}
+
+	id := extractIdentity(c)
+	if id.Subject == "" {
+		return "", "", req, echo.NewHTTPError(http.StatusUnauthorized, "no authenticated user identity")
+	}
+	if s.cfg != nil && !s.cfg.RedactionReview.IsReviewer(id.Subject, id.Email, id.PreferredUsername) {
+		slog.Warn("Rejected redaction review decision from non-reviewer",
+			"review_id", reviewID, "caller", extractAuthor(c))
+		return "", "", req, echo.NewHTTPError(http.StatusForbidden, "redaction review decisions require a reviewer")
+	}
+
+	if err := bindAndValidate(c, &req); err != nil {
+		return "", "", req, err
+	}
+	author, _ := s.resolveAuthor(c)
+	return reviewID, author, req, nil
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+func TestRedactionReviewHandlers(t *testing.T) {
+	withService := &Server{
+		cfg: &config.Config{
+			RedactionReview: &config.RedactionReviewConfig{Enabled: true, Reviewers: []string{"secops@example.com"}},
+		},
+		// Requests in this test are rejected before the client is used.
+		redactionReviews: services.NewRedactionReviewService(nil),
+	}
+	reviewer := map[string]string{"X-Forwarded-User": "sub-1", "X-Forwarded-Email": "secops@example.com"}
+	other := map[string]string{"X-Forwarded-User": "sub-2", "X-Forwarded-Email": "dev@example.com"}
+
+	tests := []struct {
+		name     string
+		server   *Server
+		method   string
+		path     string
+		body     string
+		headers  map[string]string
+		wantCode int
+	}{
+		{
+			name:     "list without service",
+			server:   &Server{},
+			method:   http.MethodGet,
+			path:     "/api/v1/redaction-reviews",
+			wantCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "list with invalid limit",
+			server:   withService,
+			method:   http.MethodGet,
+			path:     "/api/v1/redaction-reviews?limit=0",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "list with invalid offset",
+			server:   withService,
+			method:   http.MethodGet,
+			path:     "/api/v1/redaction-reviews?offset=-1",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "list with invalid status",
+			server:   withService,
+			method:   http.MethodGet,
+			path:     "/api/v1/redaction-reviews?status=bogus",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "confirm without identity",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/redaction-reviews/r1/confirm",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "confirm by non-reviewer",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/redaction-reviews/r1/confirm",
+			headers:  other,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "dismiss with invalid body",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/redaction-reviews/r1/dismiss",
+			body:     `{"note":1}`,
+			headers:  reviewer,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "dismiss with oversized note",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/redaction-reviews/r1/dismiss",
+			body:     `{"note":"` + strings.Repeat("x", 2001) + `"}`,
+			headers:  reviewer,
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.GET("/api/v1/redaction-reviews", tt.server.listRedactionReviewsHandler)
+			e.GET("/api/v1/redaction-reviews/:id", tt.server.getRedactionReviewHandler)
+			e.POST("/api/v1/redaction-reviews/:id/confirm", tt.server.confirmRedactionReviewHandler)
+			e.POST("/api/v1/redaction-reviews/:id/dismiss", tt.server.dismissRedactionReviewHandler)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+	"gopkg.in/yaml.v3"
+
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
+	"github.com/codeready-toolchain/tarsy/pkg/registration"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// --- Response types ---
+
+// RegistrationsResponse is returned by GET /api/v1/system/registrations.
+type RegistrationsResponse struct {
+	Registrations []RegistrationView `json:"registrations"`
+}
+
+// RegistrationHistoryResponse is returned by
+// GET /api/v1/system/registrations/:kind/:name.
+type RegistrationHistoryResponse struct {
+	Versions []RegistrationView `json:"versions"`
+}
+
+// RegistrationView is one version of a registered agent or chain.
+type RegistrationView struct {
+	Kind       string    `json:"kind"` // "agent" or "chain"
+	Name       string    `json:"name"`
+	Version    int       `json:"version"`
+	Definition string    `json:"definition,omitempty"` // YAML; empty for deletions
+	Deleted    bool      `json:"deleted,omitempty"`
+	CreatedBy  string    `json:"created_by,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// --- Handlers ---
+
+// registrationsHandler handles GET /api/v1/system/registrations.
+func (s *Server) registrationsHandler(c *echo.Context) error {
+	if s.registrations == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "registration is not available")
+	}
+	regs := s.registrations.Registrations()
+	resp := RegistrationsResponse{Registrations: make([]RegistrationView, 0, len(regs))}
+	for _, r := range regs {
+		resp.Registrations = append(resp.Registrations, buildRegistrationView(r))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// registrationHistoryHandler handles
+// GET /api/v1/system/registrations/:kind/:name: every version, newest first.
+func (s *Server) registrationHistoryHandler(c *echo.Context) error {
+	if s.registrations == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "registration is not available")
+	}
+	kind, err := registrationKind(c.Param("kind"))
+	if err != nil {
+		return err
+	}
+
+	versions, err := s.registrations.History(c.Request().Context(), kind, c.Param("name"))
+	if err != nil {
+		return mapRegistrationError(c, err)
+	}
+	resp := RegistrationHistoryResponse{Versions: make([]RegistrationView, 0, len(versions))}
+	for _, r := range versions {
+		resp.Versions = append(resp.Versions, buildRegistrationView(r))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// registerHandler handles PUT /api/v1/system/registrations/:kind/:name.
+// Creates the agent or chain, or stores a new version of it. The change
+// reaches other replicas on their next refresh.
+func (s *Server) registerHandler(c *echo.Context) error {
+	if s.registrations == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "registration is not available")
+	}
+	kind, err := registrationKind(c.Param("kind"))
+	if err != nil {
+		return err
+	}
+
+	var req RegisterRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	definition, err := definitionYAML(req.Definition)
+	if err != nil {
+		return invalidField("definition", "%s", err.Error())
+	}
+
+	r, err := s.registrations.Register(c.Request().Context(), kind, c.Param("name"), definition, extractAuthor(c))
+	if err != nil {
+		return mapRegistrationError(c, err)
+	}
+	return c.JSON(http.StatusOK, buildRegistrationView(r))
+}
+
+// unregisterHandler handles DELETE /api/v1/system/registrations/:kind/:name.
+func (s *Server) unregisterHandler(c *echo.Context) error {
+	if s.registrations == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "registration is not available")
+	}
+	kind, err := registrationKind(c.Param("kind"))
+	if err != nil {
+		return err
+	}
+
+	if err := s.registrations.Unregister(c.Request().Context(), kind, c.Param("name"), extractAuthor(c)); err != nil {
+		return mapRegistrationError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// registrationKind maps the :kind path segment to a registration kind.
+func registrationKind(segment string) (configregistration.Kind, error) {
+	switch segment {
+	case "agents":
+		return configregistration.KindAgent, nil
+	case "chains":
+		return configregistration.KindChain, nil
+	default:
+		return "", echo.NewHTTPError(http.StatusNotFound, "kind must be agents or chains")
+	}
+}
+
+// definitionYAML returns the definition as YAML text. It is sent either as
+// a YAML string or as a JSON object with the same fields.
+func definitionYAML(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", errors.New("definition is required")
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		if text == "" {
+			return "", errors.New("definition is required")
+		}
+		return text, nil
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return "", errors.New("definition must be a YAML string or a JSON object")
+	}
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", errors.New("definition must be a YAML string or a JSON object")
+	}
+	return string(out), nil
+}
+
+func mapRegistrationError(c *echo.Context, err error) error {
+	switch {
+	case errors.Is(err, registration.ErrInvalidDefinition):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, registration.ErrReserved), errors.Is(err, services.ErrConflict):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, registration.ErrNotRegistered), errors.Is(err, services.ErrNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, "registration not found")
+	case errors.Is(err, registration.ErrUnavailable):
+		return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+	default:
+		slog.ErrorContext(c.Request().Context(), "Failed to update registration", "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update registration")
+	}
+}
+
+func buildRegistrationView(r registration.Registration) RegistrationView {
+	return RegistrationView{
+		Kind:       string(r.Kind),
+		Name:       r.Name,
+		Version:    r.Version,
+		Definition: r.Definition,
+		Deleted:    r.Deleted,
+		CreatedBy:  r.CreatedBy,
+		CreatedAt:  r.CreatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefinitionYAML(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{name: "YAML string", raw: `"description: Triage\nmcp_servers: [k8s]\n"`, want: "description: Triage\nmcp_servers: [k8s]\n"},
+		{name: "JSON object", raw: `{"mcp_servers": ["k8s"], "description": "Triage"}`, want: "description: Triage\nmcp_servers:\n    - k8s\n"},
+		{name: "missing", raw: ``, wantErr: true},
+		{name: "null", raw: `null`, wantErr: true},
+		{name: "empty string", raw: `""`, wantErr: true},
+		{name: "array", raw: `["k8s"]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := definitionYAML(json.RawMessage(tt.raw))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRegisterHandler_Validation(t *testing.T) {
+	call := func(t *testing.T, s *Server, kind, body string) error {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/system/registrations/"+kind+"/payments", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+		c.SetPathValues(echo.PathValues{{Name: "kind", Value: kind}, {Name: "name", Value: "payments"}})
+		return s.registerHandler(c)
+	}
+
+	t.Run("unavailable without manager", func(t *testing.T) {
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, call(t, &Server{}, "chains", `{"definition": "alert_types: [x]"}`), &httpErr)
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		_, err := registrationKind("skills")
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusNotFound, httpErr.Code)
+	})
+}
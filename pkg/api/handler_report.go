@@ -0,0 +1,77 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// listReportsHandler handles GET /api/v1/reports.
+// Returns the most recent activity reports, newest window first.
+// Optional query params: period (daily|weekly), limit (1-200, default 20).
+func (s *Server) listReportsHandler(c *echo.Context) error {
+	if s.reportService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "report service is not available")
+	}
+
+	limit := defaultPageSize
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid limit: must be between 1 and %d", maxPageSize))
+		}
+		limit = n
+	}
+
+	reports, err := s.reportService.ListReports(c.Request().Context(), c.QueryParam("period"), limit)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	resp := models.ActivityReportListResponse{Reports: make([]models.ActivityReportResponse, 0, len(reports))}
+	for _, r := range reports {
+		resp.Reports = append(resp.Reports, models.NewActivityReportResponse(r))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// getReportHandler handles GET /api/v1/reports/:id.
+func (s *Server) getReportHandler(c *echo.Context) error {
+	reportID := c.Param("id")
+	if reportID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "report id is required")
+	}
+
+	if s.reportService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "report service is not available")
+	}
+
+	report, err := s.reportService.GetReport(c.Request().Context(), reportID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewActivityReportResponse(report))
+}
+
+// getRenderedReportHandler handles GET /api/v1/reports/:id/html.
+// Serves the report as rendered (and emailed) when it was generated.
+func (s *Server) getRenderedReportHandler(c *echo.Context) error {
+	reportID := c.Param("id")
+	if reportID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "report id is required")
+	}
+
+	if s.reportService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "report service is not available")
+	}
+
+	html, err := s.reportService.GetRenderedReport(c.Request().Context(), reportID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.Blob(http.StatusOK, "text/html; charset=utf-8", html)
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListReportsHandler_NilReportService(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	e.GET("/api/v1/reports", s.listReportsHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestGetReportHandler_MissingReportID(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := s.getReportHandler(c)
+	if assert.Error(t, err) {
+		he, ok := err.(*echo.HTTPError)
+		if assert.True(t, ok) {
+			assert.Equal(t, http.StatusBadRequest, he.Code)
+		}
+	}
+}
+
+func TestGetReportHandler_NilReportService(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	e.GET("/api/v1/reports/:id", s.getReportHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/rep-1", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestGetRenderedReportHandler_NilReportService(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	e.GET("/api/v1/reports/:id/html", s.getRenderedReportHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/rep-1/html", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
@@ -19,30 +19,18 @@ const (
 	maxPageSize     = 200
 )
 
-const maxSessionIDs = 50
-
 // updateReviewHandler handles PATCH /api/v1/sessions/review.
 // Accepts one or more session IDs in the request body.
 func (s *Server) updateReviewHandler(c *echo.Context) error {
 	var req models.UpdateReviewRequest
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
-	}
-	if len(req.SessionIDs) == 0 {
-		return echo.NewHTTPError(http.StatusBadRequest, "session_ids is required and must not be empty")
-	}
-	if len(req.SessionIDs) > maxSessionIDs {
-		return echo.NewHTTPError(http.StatusBadRequest,
-			fmt.Sprintf("session_ids must not exceed %d entries", maxSessionIDs))
-	}
-	if !models.ValidReviewAction(req.Action) {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown action %q", req.Action))
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
 	}
 	if models.ReviewAction(req.Action) == models.ReviewActionComplete && req.QualityRating == nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "quality_rating is required for complete action")
+		return invalidField("quality_rating", "required for the complete action")
 	}
 	if models.ReviewAction(req.Action) == models.ReviewActionAcknowledge && req.QualityRating != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "quality_rating must not be set for acknowledge action")
+		return invalidField("quality_rating", "must not be set for the acknowledge action")
 	}
 	req.Actor = extractAuthor(c)
 
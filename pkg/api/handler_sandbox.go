@@ -0,0 +1,107 @@
+package api
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/queue"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+	"github.com/codeready-toolchain/tarsy/pkg/runbook"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// sandboxRunHandler handles POST /api/v1/sandbox/runs.
+// Runs a chain synchronously against fixture MCP tools (and an optional
+// scripted LLM) and returns the run's outcome and trace. The response is
+// sent when the run reaches a terminal state, which can take as long as a
+// regular session.
+func (s *Server) sandboxRunHandler(c *echo.Context) error {
+	if s.sandbox == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "sandbox runs are not available")
+	}
+
+	var req SandboxRunRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	if err := s.validateSandboxRunRequest(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	author, subject := s.resolveAuthor(c)
+	session, err := s.alertService.CreateSandboxSession(ctx, services.SandboxSessionInput{
+		AlertType:     req.AlertType,
+		ChainID:       req.ChainID,
+		Data:          req.Data,
+		Runbook:       req.Runbook,
+		Author:        author,
+		AuthorSubject: subject,
+		RequestID:     requestid.FromContext(ctx),
+	})
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	result, err := s.sandbox.Run(ctx, session, queue.SandboxRun{
+		Fixtures:  req.MCPFixtures,
+		LLMScript: req.LLMScript,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Sandbox run failed", "session_id", session.ID, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "sandbox run failed")
+	}
+
+	resp := &SandboxRunResponse{
+		SessionID:        session.ID,
+		ChainID:          session.ChainID,
+		Status:           string(result.Status),
+		FinalAnalysis:    result.FinalAnalysis,
+		ExecutiveSummary: result.ExecutiveSummary,
+		TechnicalSummary: result.TechnicalSummary,
+	}
+	if result.Error != nil {
+		resp.Error = result.Error.Error()
+	}
+	if s.interactionService != nil && s.stageService != nil {
+		trace, err := s.loadTraceList(ctx, session.ID)
+		if err != nil {
+			return mapServiceError(err)
+		}
+		resp.Trace = trace
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// validateSandboxRunRequest checks a POST /api/v1/sandbox/runs body before
+// it reaches the service.
+func (s *Server) validateSandboxRunRequest(req *SandboxRunRequest) error {
+	if len(req.Data) > agent.MaxAlertDataSize {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("alert data exceeds maximum size of %d bytes", agent.MaxAlertDataSize))
+	}
+
+	for serverID := range req.MCPFixtures {
+		if s.cfg.MCPServerRegistry == nil || !s.cfg.MCPServerRegistry.Has(serverID) {
+			return invalidField("mcp_fixtures."+serverID, "MCP server %q not found in configuration", serverID)
+		}
+	}
+	if err := mcp.ValidateFixtures(req.MCPFixtures); err != nil {
+		return invalidField("mcp_fixtures", "invalid mcp_fixtures: %s", err.Error())
+	}
+	if err := agent.ValidateScript(req.LLMScript); err != nil {
+		return invalidField("llm_script", "invalid llm_script: %s", err.Error())
+	}
+
+	if req.Runbook != "" && s.cfg.Runbooks != nil {
+		if err := runbook.ValidateRunbookURL(req.Runbook, s.cfg.Runbooks.AllowedDomains); err != nil {
+			return invalidField("runbook", "invalid runbook URL: %s", err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/queue"
+)
+
+func TestSandboxRunHandler_Validation(t *testing.T) {
+	s := &Server{
+		cfg: &config.Config{
+			MCPServerRegistry: config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+				"kubernetes-server": {},
+			}),
+		},
+		sandbox: queue.NewSandboxRunner(nil, nil, nil, "pod-1", nil, nil),
+	}
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+		wantMsg  string
+	}{
+		{name: "missing data", body: `{}`, wantCode: http.StatusBadRequest, wantMsg: "data: required"},
+		{name: "oversized data", body: `{"data":"` + strings.Repeat("x", agent.MaxAlertDataSize+1) + `"}`, wantCode: http.StatusRequestEntityTooLarge, wantMsg: "exceeds maximum size"},
+		{
+			name:     "unknown fixture server",
+			body:     `{"data":"alert","mcp_fixtures":{"no-such-server":[{"name":"t","responses":[{"result":"x"}]}]}}`,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  `MCP server "no-such-server" not found`,
+		},
+		{
+			name:     "fixture without responses",
+			body:     `{"data":"alert","mcp_fixtures":{"kubernetes-server":[{"name":"get_pods"}]}}`,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "invalid mcp_fixtures",
+		},
+		{
+			name:     "empty script response",
+			body:     `{"data":"alert","llm_script":[{}]}`,
+			wantCode: http.StatusBadRequest,
+			wantMsg:  "invalid llm_script",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/sandbox/runs", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := s.sandboxRunHandler(c)
+			if assert.Error(t, err) {
+				he, ok := err.(*echo.HTTPError)
+				if assert.True(t, ok, "expected echo.HTTPError") {
+					assert.Equal(t, tt.wantCode, he.Code)
+					assert.Contains(t, he.Message, tt.wantMsg)
+				}
+			}
+		})
+	}
+}
+
+func TestSandboxRunHandler_Unavailable(t *testing.T) {
+	s := &Server{cfg: &config.Config{}}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/sandbox/runs", strings.NewReader(`{"data":"alert"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	err := s.sandboxRunHandler(c)
+	he, ok := err.(*echo.HTTPError)
+	if assert.True(t, ok, "expected echo.HTTPError") {
+		assert.Equal(t, http.StatusServiceUnavailable, he.Code)
+	}
+}
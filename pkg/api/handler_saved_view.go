@@ -0,0 +1,118 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// listSavedViewsHandler handles GET /api/v1/saved-views.
+// Returns the caller's saved views.
+func (s *Server) listSavedViewsHandler(c *echo.Context) error {
+	owner, err := s.savedViewOwner(c)
+	if err != nil {
+		return err
+	}
+
+	views, err := s.savedViewService.ListViews(c.Request().Context(), owner)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	resp := models.SavedViewListResponse{Views: make([]models.SavedViewResponse, 0, len(views))}
+	for _, v := range views {
+		resp.Views = append(resp.Views, models.NewSavedViewResponse(v))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// createSavedViewHandler handles POST /api/v1/saved-views.
+func (s *Server) createSavedViewHandler(c *echo.Context) error {
+	owner, err := s.savedViewOwner(c)
+	if err != nil {
+		return err
+	}
+
+	var req models.CreateSavedViewRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	if err := s.validateSavedViewChain(req.Filter); err != nil {
+		return err
+	}
+
+	view, err := s.savedViewService.CreateView(c.Request().Context(), owner, req)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusCreated, models.NewSavedViewResponse(view))
+}
+
+// updateSavedViewHandler handles PATCH /api/v1/saved-views/:id.
+func (s *Server) updateSavedViewHandler(c *echo.Context) error {
+	owner, err := s.savedViewOwner(c)
+	if err != nil {
+		return err
+	}
+	viewID := c.Param("id")
+	if viewID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "view id is required")
+	}
+
+	var req models.UpdateSavedViewRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	if req.Filter != nil {
+		if err := s.validateSavedViewChain(*req.Filter); err != nil {
+			return err
+		}
+	}
+
+	view, err := s.savedViewService.UpdateView(c.Request().Context(), owner, viewID, req)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, models.NewSavedViewResponse(view))
+}
+
+// deleteSavedViewHandler handles DELETE /api/v1/saved-views/:id.
+func (s *Server) deleteSavedViewHandler(c *echo.Context) error {
+	owner, err := s.savedViewOwner(c)
+	if err != nil {
+		return err
+	}
+	viewID := c.Param("id")
+	if viewID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "view id is required")
+	}
+
+	if err := s.savedViewService.DeleteView(c.Request().Context(), owner, viewID); err != nil {
+		return mapServiceError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// savedViewOwner returns the caller's subject, or the HTTP error to return
+// when saved views are unavailable or the caller is unauthenticated.
+func (s *Server) savedViewOwner(c *echo.Context) (string, error) {
+	if s.savedViewService == nil {
+		return "", echo.NewHTTPError(http.StatusServiceUnavailable, "saved views are not available")
+	}
+	subject := extractIdentity(c).Subject
+	if subject == "" {
+		return "", echo.NewHTTPError(http.StatusUnauthorized, "no authenticated user identity")
+	}
+	return subject, nil
+}
+
+// validateSavedViewChain rejects filters naming a chain that is not configured.
+func (s *Server) validateSavedViewChain(f schema.SavedViewFilter) error {
+	if f.ChainID != "" && !s.cfg.ChainRegistry.Has(f.ChainID) {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown chain %q in filter", f.ChainID))
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+func TestSavedViewHandlers(t *testing.T) {
+	withService := &Server{
+		cfg: &config.Config{
+			ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{
+				"k8s": {AlertTypes: []string{"Pod"}},
+			}),
+		},
+		// Requests in this test are rejected before the client is used.
+		savedViewService: services.NewSavedViewService(nil),
+	}
+	authed := map[string]string{"X-Forwarded-User": "sub-1"}
+
+	tests := []struct {
+		name     string
+		server   *Server
+		method   string
+		path     string
+		body     string
+		headers  map[string]string
+		wantCode int
+	}{
+		{
+			name:     "list without service",
+			server:   &Server{},
+			method:   http.MethodGet,
+			path:     "/api/v1/saved-views",
+			headers:  authed,
+			wantCode: http.StatusServiceUnavailable,
+		},
+		{
+			name:     "list without identity",
+			server:   withService,
+			method:   http.MethodGet,
+			path:     "/api/v1/saved-views",
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "create with invalid body",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/saved-views",
+			body:     `{"name":1}`,
+			headers:  authed,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "create with unknown chain",
+			server:   withService,
+			method:   http.MethodPost,
+			path:     "/api/v1/saved-views",
+			body:     `{"name":"x","filter":{"chain_id":"nope"}}`,
+			headers:  authed,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "update with unknown chain",
+			server:   withService,
+			method:   http.MethodPatch,
+			path:     "/api/v1/saved-views/v1",
+			body:     `{"filter":{"chain_id":"nope"}}`,
+			headers:  authed,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "delete without identity",
+			server:   withService,
+			method:   http.MethodDelete,
+			path:     "/api/v1/saved-views/v1",
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.GET("/api/v1/saved-views", tt.server.listSavedViewsHandler)
+			e.POST("/api/v1/saved-views", tt.server.createSavedViewHandler)
+			e.PATCH("/api/v1/saved-views/:id", tt.server.updateSavedViewHandler)
+			e.DELETE("/api/v1/saved-views/:id", tt.server.deleteSavedViewHandler)
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -10,7 +11,12 @@ import (
 	echo "github.com/labstack/echo/v5"
 
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+	"github.com/codeready-toolchain/tarsy/pkg/sessionreport"
 )
 
 // getSessionHandler handles GET /api/v1/sessions/:id.
@@ -24,6 +30,7 @@ func (s *Server) getSessionHandler(c *echo.Context) error {
 	if err != nil {
 		return mapServiceError(err)
 	}
+	detail.VoiceNotesEnabled = detail.ChatEnabled && s.chatService != nil && s.chatService.VoiceNotesEnabled()
 
 	return c.JSON(http.StatusOK, detail)
 }
@@ -120,12 +127,35 @@ func (s *Server) listSessionsHandler(c *echo.Context) error {
 		params.ReviewStatus = v
 	}
 	params.Assignee = c.QueryParam("assignee")
+	params.RequestID = c.QueryParam("request_id")
+	if v := c.QueryParam("sandbox"); v != "" {
+		sandbox, err := strconv.ParseBool(v)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid sandbox: must be true or false")
+		}
+		params.Sandbox = sandbox
+	}
+	if v := c.QueryParam("fallback_chain"); v != "" {
+		fallback, err := strconv.ParseBool(v)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid fallback_chain: must be true or false")
+		}
+		params.FallbackChain = fallback
+	}
 	if v := c.QueryParam("quality_rating"); v != "" {
 		if err := alertsession.QualityRatingValidator(alertsession.QualityRating(v)); err != nil {
 			return echo.NewHTTPError(http.StatusBadRequest, "invalid quality_rating: "+v)
 		}
 		params.QualityRating = v
 	}
+	if v := c.QueryParam("outcome"); v != "" {
+		for _, o := range strings.Split(v, ",") {
+			if err := alertsession.OutcomeValidator(alertsession.Outcome(o)); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid outcome: "+o)
+			}
+		}
+		params.Outcome = v
+	}
 
 	result, err := s.sessionService.ListSessionsForDashboard(c.Request().Context(), params)
 	if err != nil {
@@ -160,6 +190,45 @@ func (s *Server) sessionSummaryHandler(c *echo.Context) error {
 	return c.JSON(http.StatusOK, summary)
 }
 
+// sessionReportHandler handles GET /api/v1/sessions/:id/report.
+// Renders the final analysis, executive summary, and key milestones as a
+// standalone document. Optional query params: format (html|pdf|markdown,
+// default html), download (true sends it as an attachment).
+func (s *Server) sessionReportHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	format, err := sessionreport.ParseFormat(c.QueryParam("format"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	detail, err := s.sessionService.GetSessionDetail(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	var dashboardURL string
+	if s.cfg != nil {
+		dashboardURL = s.cfg.DashboardURL
+	}
+	body, err := sessionreport.Render(sessionreport.Build(detail, dashboardURL, time.Now()), format)
+	if err != nil {
+		slog.Error("Failed to render session report", "session_id", sessionID, "format", format, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to render report")
+	}
+
+	disposition := "inline"
+	if c.QueryParam("download") == "true" {
+		disposition = "attachment"
+	}
+	c.Response().Header().Set("Content-Disposition",
+		fmt.Sprintf(`%s; filename="tarsy-report-%s.%s"`, disposition, sessionID, format.Extension()))
+	return c.Blob(http.StatusOK, format.ContentType(), body)
+}
+
 // sessionStatusHandler handles GET /api/v1/sessions/:id/status.
 func (s *Server) sessionStatusHandler(c *echo.Context) error {
 	sessionID := c.Param("id")
@@ -175,6 +244,21 @@ func (s *Server) sessionStatusHandler(c *echo.Context) error {
 	return c.JSON(http.StatusOK, status)
 }
 
+// getSessionGroupHandler handles GET /api/v1/session-groups/:id.
+func (s *Server) getSessionGroupHandler(c *echo.Context) error {
+	groupID := c.Param("id")
+	if groupID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "group id is required")
+	}
+
+	group, err := s.sessionService.GetSessionGroup(c.Request().Context(), groupID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	return c.JSON(http.StatusOK, group)
+}
+
 // cancelSessionHandler handles POST /api/v1/sessions/:id/cancel.
 func (s *Server) cancelSessionHandler(c *echo.Context) error {
 	sessionID := c.Param("id")
@@ -182,8 +266,16 @@ func (s *Server) cancelSessionHandler(c *echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
 	}
 
+	// The body is optional; an empty POST cancels without a reason.
+	var req CancelSessionRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	reason := strings.TrimSpace(req.Reason)
+	author, _ := s.resolveAuthor(c)
+
 	// Try to cancel the investigation (DB status in_progress → cancelling).
-	sessionErr := s.sessionService.CancelSession(c.Request().Context(), sessionID)
+	sessionErr := s.sessionService.CancelSession(c.Request().Context(), sessionID, reason, author)
 
 	// Always try to cancel on this pod via worker pool, regardless of DB result.
 	if s.workerPool != nil {
@@ -199,7 +291,12 @@ func (s *Server) cancelSessionHandler(c *echo.Context) error {
 
 	// Broadcast to all pods via NOTIFY so the owning pod cancels the context.
 	if s.cancelNotifier != nil {
-		if err := s.cancelNotifier.NotifyCancelSession(c.Request().Context(), sessionID); err != nil {
+		if err := s.cancelNotifier.NotifyCancelSession(c.Request().Context(), events.CancellationPayload{
+			SessionID:   sessionID,
+			Initiator:   string(alertsession.CancelInitiatorUser),
+			Reason:      reason,
+			CancelledBy: author,
+		}); err != nil {
 			slog.Warn("Failed to broadcast cancel notification", "session_id", sessionID, "error", err)
 		}
 	}
@@ -210,7 +307,60 @@ func (s *Server) cancelSessionHandler(c *echo.Context) error {
 	}
 
 	return c.JSON(http.StatusOK, &CancelResponse{
-		SessionID: sessionID,
-		Message:   "Session cancellation requested",
+		SessionID:        sessionID,
+		Message:          "Session cancellation requested",
+		ConsistencyToken: services.ConsistencyToken{Kind: services.ConsistencyCancel, SessionID: sessionID}.Encode(),
+	})
+}
+
+// duplicateSessionHandler handles POST /api/v1/sessions/:id/duplicate.
+// Queues a new session for the same alert, optionally on another chain, LLM
+// provider or MCP selection, linked to the original for comparison.
+func (s *Server) duplicateSessionHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	// The body is optional; an empty POST re-runs the alert unchanged.
+	var req DuplicateSessionRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+	if req.LLMProvider != "" && s.cfg.LLMProviderRegistry != nil && !s.cfg.LLMProviderRegistry.Has(req.LLMProvider) {
+		return invalidField("llm_provider", "LLM provider %q not found in configuration", req.LLMProvider)
+	}
+	if req.MCP != nil && s.cfg.MCPServerRegistry != nil {
+		for i, sel := range req.MCP.Servers {
+			if !s.cfg.MCPServerRegistry.Has(sel.Name) {
+				return invalidField(fmt.Sprintf("mcp.servers[%d].name", i),
+					"MCP server %q not found in configuration", sel.Name)
+			}
+		}
+	}
+
+	author, subject := s.resolveAuthor(c)
+	session, err := s.alertService.DuplicateSession(c.Request().Context(), services.DuplicateSessionInput{
+		SessionID:     sessionID,
+		ChainID:       req.ChainID,
+		LLMProvider:   req.LLMProvider,
+		MCP:           req.MCP,
+		Author:        author,
+		AuthorSubject: subject,
+		RequestID:     requestid.FromContext(c.Request().Context()),
+	})
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	metrics.SessionsSubmittedTotal.WithLabelValues(session.AlertType).Inc()
+	s.eventStream.SessionCreated(session)
+
+	return c.JSON(http.StatusAccepted, &DuplicateSessionResponse{
+		SessionID:        session.ID,
+		DuplicatedFrom:   sessionID,
+		ChainID:          session.ChainID,
+		Status:           "queued",
+		ConsistencyToken: services.ConsistencyToken{Kind: services.ConsistencySubmit, SessionID: session.ID}.Encode(),
 	})
 }
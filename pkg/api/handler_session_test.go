@@ -3,10 +3,13 @@ package api
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	echo "github.com/labstack/echo/v5"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
 )
 
 func TestListSessionsHandler_Validation(t *testing.T) {
@@ -68,6 +71,12 @@ func TestListSessionsHandler_Validation(t *testing.T) {
 			wantErr: http.StatusBadRequest,
 			errMsg:  "invalid quality_rating",
 		},
+		{
+			name:    "invalid outcome",
+			query:   "outcome=mitigated,resolved",
+			wantErr: http.StatusBadRequest,
+			errMsg:  "invalid outcome: resolved",
+		},
 	}
 
 	for _, tt := range tests {
@@ -149,3 +158,78 @@ func TestSessionStatusHandler_Validation(t *testing.T) {
 		}
 	})
 }
+
+func TestSessionReportHandler_Validation(t *testing.T) {
+	s := &Server{}
+
+	tests := []struct {
+		name    string
+		id      string
+		query   string
+		wantMsg string
+	}{
+		{name: "missing session id returns 400", id: "", wantMsg: "session id"},
+		{name: "unsupported format returns 400", id: "sess-1", query: "?format=docx", wantMsg: "unsupported format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/"+tt.id+"/report"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPathValues(echo.PathValues{{Name: "id", Value: tt.id}})
+
+			err := s.sessionReportHandler(c)
+			if assert.Error(t, err) {
+				he, ok := err.(*echo.HTTPError)
+				if assert.True(t, ok, "expected echo.HTTPError") {
+					assert.Equal(t, http.StatusBadRequest, he.Code)
+					assert.Contains(t, he.Message, tt.wantMsg)
+				}
+			}
+		})
+	}
+}
+
+func TestDuplicateSessionHandler_Validation(t *testing.T) {
+	s := &Server{cfg: &config.Config{
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"gemini-pro": {Type: config.LLMProviderTypeGoogle, Model: "gemini-2.5-pro"},
+		}),
+		MCPServerRegistry: config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+			"kubernetes-server": {},
+		}),
+	}}
+
+	tests := []struct {
+		name    string
+		id      string
+		body    string
+		wantMsg string
+	}{
+		{name: "missing session id returns 400", id: "", wantMsg: "session id"},
+		{name: "unknown LLM provider returns 400", id: "sess-1", body: `{"llm_provider":"no-such-provider"}`, wantMsg: "LLM provider \"no-such-provider\" not found"},
+		{name: "unknown MCP server returns 400", id: "sess-1", body: `{"mcp":{"servers":[{"name":"no-such-server"}]}}`, wantMsg: "MCP server \"no-such-server\" not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/sessions/"+tt.id+"/duplicate", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPathValues(echo.PathValues{{Name: "id", Value: tt.id}})
+
+			err := s.duplicateSessionHandler(c)
+			if assert.Error(t, err) {
+				he, ok := err.(*echo.HTTPError)
+				if assert.True(t, ok, "expected echo.HTTPError") {
+					assert.Equal(t, http.StatusBadRequest, he.Code)
+					assert.Contains(t, he.Message, tt.wantMsg)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/queue"
+)
+
+const (
+	// defaultWatchTimeout is how long GET /sessions/:id/watch blocks when the
+	// caller doesn't pass ?timeout.
+	defaultWatchTimeout = 30 * time.Second
+	// maxWatchTimeout caps ?timeout so a request stays well below typical
+	// proxy and load balancer idle timeouts.
+	maxWatchTimeout = 120 * time.Second
+)
+
+// watchPollInterval is how often a blocked watch re-reads the session status.
+// A variable so tests can shorten it.
+var watchPollInterval = time.Second
+
+// SessionWatchResponse is returned by GET /api/v1/sessions/:id/watch.
+type SessionWatchResponse struct {
+	models.SessionStatusResponse
+	Changed  bool `json:"changed"`  // status differs from since_status (false on timeout)
+	Terminal bool `json:"terminal"` // status will not change again
+}
+
+// watchSessionHandler handles GET /api/v1/sessions/:id/watch.
+// Long-polling alternative to the WebSocket stream for scripts and CI jobs:
+// blocks until the session status differs from ?since_status (default: the
+// status at request time) or ?timeout seconds elapse, then returns the
+// current status. Returns immediately for terminal sessions when no
+// since_status is given, so "watch until terminal" is a simple loop.
+func (s *Server) watchSessionHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+
+	timeout, err := parseWatchTimeout(c.QueryParam("timeout"))
+	if err != nil {
+		return err
+	}
+	sinceStatus := c.QueryParam("since_status")
+	if sinceStatus != "" {
+		if err := alertsession.StatusValidator(alertsession.Status(sinceStatus)); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid since_status: "+sinceStatus)
+		}
+	}
+
+	ctx := c.Request().Context()
+	status, err := s.sessionService.GetSessionStatus(ctx, sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	if sinceStatus == "" {
+		if isWatchTerminal(status.Status) {
+			return c.JSON(http.StatusOK, newSessionWatchResponse(status, false))
+		}
+		sinceStatus = status.Status
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for status.Status == sinceStatus {
+		select {
+		case <-ctx.Done():
+			// Client went away; nothing useful to send.
+			return ctx.Err()
+		case <-deadline.C:
+			return c.JSON(http.StatusOK, newSessionWatchResponse(status, false))
+		case <-ticker.C:
+			if status, err = s.sessionService.GetSessionStatus(ctx, sessionID); err != nil {
+				return mapServiceError(err)
+			}
+		}
+	}
+	return c.JSON(http.StatusOK, newSessionWatchResponse(status, true))
+}
+
+// parseWatchTimeout parses ?timeout (whole seconds, 1..maxWatchTimeout).
+func parseWatchTimeout(v string) (time.Duration, error) {
+	if v == "" {
+		return defaultWatchTimeout, nil
+	}
+	secs, err := strconv.Atoi(v)
+	if err != nil || secs < 1 || time.Duration(secs)*time.Second > maxWatchTimeout {
+		return 0, echo.NewHTTPError(http.StatusBadRequest,
+			"invalid timeout: must be whole seconds between 1 and "+strconv.Itoa(int(maxWatchTimeout.Seconds())))
+	}
+	return time.Duration(secs) * time.Second, nil
+}
+
+// isWatchTerminal reports whether a session status is final. Unlike
+// queue.IsTerminalStatus it includes auto_cancelled: the session never ran,
+// but its status will not change again.
+func isWatchTerminal(status string) bool {
+	s := alertsession.Status(status)
+	return queue.IsTerminalStatus(s) || s == alertsession.StatusAutoCancelled
+}
+
+func newSessionWatchResponse(status *models.SessionStatusResponse, changed bool) SessionWatchResponse {
+	return SessionWatchResponse{
+		SessionStatusResponse: *status,
+		Changed:               changed,
+		Terminal:              isWatchTerminal(status.Status),
+	}
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+)
+
+func TestWatchSessionHandler_Validation(t *testing.T) {
+	s := &Server{}
+
+	tests := []struct {
+		name    string
+		id      string
+		query   string
+		wantMsg string
+	}{
+		{name: "missing session id", id: "", wantMsg: "session id"},
+		{name: "non-numeric timeout", id: "sess-1", query: "?timeout=soon", wantMsg: "invalid timeout"},
+		{name: "zero timeout", id: "sess-1", query: "?timeout=0", wantMsg: "invalid timeout"},
+		{name: "timeout above max", id: "sess-1", query: "?timeout=121", wantMsg: "invalid timeout"},
+		{name: "unknown since_status", id: "sess-1", query: "?since_status=sleeping", wantMsg: "invalid since_status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/"+tt.id+"/watch"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPathValues(echo.PathValues{{Name: "id", Value: tt.id}})
+
+			err := s.watchSessionHandler(c)
+			if assert.Error(t, err) {
+				he, ok := err.(*echo.HTTPError)
+				if assert.True(t, ok, "expected echo.HTTPError") {
+					assert.Equal(t, http.StatusBadRequest, he.Code)
+					assert.Contains(t, he.Message, tt.wantMsg)
+				}
+			}
+		})
+	}
+}
+
+func TestParseWatchTimeout(t *testing.T) {
+	d, err := parseWatchTimeout("")
+	require.NoError(t, err)
+	assert.Equal(t, defaultWatchTimeout, d)
+
+	d, err = parseWatchTimeout("120")
+	require.NoError(t, err)
+	assert.Equal(t, maxWatchTimeout, d)
+}
+
+func TestIsWatchTerminal(t *testing.T) {
+	for status, want := range map[alertsession.Status]bool{
+		alertsession.StatusPending:       false,
+		alertsession.StatusInProgress:    false,
+		alertsession.StatusCancelling:    false,
+		alertsession.StatusCompleted:     true,
+		alertsession.StatusFailed:        true,
+		alertsession.StatusTimedOut:      true,
+		alertsession.StatusCancelled:     true,
+		alertsession.StatusAutoCancelled: true,
+	} {
+		assert.Equal(t, want, isWatchTerminal(string(status)), "status %s", status)
+	}
+}
+
+func TestWatchSessionHandler_LongPoll(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	sessionSvc := services.NewSessionService(client.Client,
+		config.NewChainRegistry(map[string]*config.ChainConfig{}),
+		config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{}))
+	session := createTimelineTestSession(t, client.Client)
+
+	orig := watchPollInterval
+	watchPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { watchPollInterval = orig })
+
+	e := echo.New()
+	s := &Server{sessionService: sessionSvc}
+	e.GET("/api/v1/sessions/:id/watch", s.watchSessionHandler)
+
+	watch := func(query string) SessionWatchResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/"+session.ID+"/watch"+query, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var resp SessionWatchResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return resp
+	}
+
+	t.Run("times out without a change", func(t *testing.T) {
+		resp := watch("?timeout=1")
+		assert.False(t, resp.Changed)
+		assert.False(t, resp.Terminal)
+		assert.Equal(t, string(alertsession.StatusPending), resp.Status)
+	})
+
+	t.Run("returns immediately when since_status is stale", func(t *testing.T) {
+		resp := watch("?since_status=in_progress&timeout=60")
+		assert.True(t, resp.Changed)
+		assert.Equal(t, string(alertsession.StatusPending), resp.Status)
+	})
+
+	t.Run("unblocks on status change", func(t *testing.T) {
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			_ = client.AlertSession.UpdateOneID(session.ID).
+				SetStatus(alertsession.StatusCompleted).
+				Exec(context.Background())
+		}()
+		resp := watch("?timeout=30")
+		assert.True(t, resp.Changed)
+		assert.True(t, resp.Terminal)
+		assert.Equal(t, string(alertsession.StatusCompleted), resp.Status)
+	})
+
+	t.Run("terminal session returns immediately", func(t *testing.T) {
+		resp := watch("?timeout=60")
+		assert.False(t, resp.Changed)
+		assert.True(t, resp.Terminal)
+	})
+}
@@ -2,14 +2,18 @@ package api
 
 import (
 	"errors"
+	"log/slog"
 	"net/http"
 	"sort"
 	"time"
 
 	echo "github.com/labstack/echo/v5"
 
+	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/coordination"
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
 )
 
 // --- Response types ---
@@ -57,6 +61,31 @@ type DefaultToolsResponse struct {
 	NativeTools map[string]bool `json:"native_tools"`
 }
 
+// JobLeadersResponse is returned by GET /api/v1/system/leaders.
+type JobLeadersResponse struct {
+	Leaders []JobLeaderItem `json:"leaders"`
+}
+
+// JobLeaderItem is the pod currently leading a singleton background job.
+// Stale is set when the leader stopped renewing (its pod most likely died
+// and failover has not happened yet).
+type JobLeaderItem struct {
+	Job        string `json:"job"`
+	PodID      string `json:"pod_id"`
+	AcquiredAt string `json:"acquired_at"`
+	RenewedAt  string `json:"renewed_at"`
+	Stale      bool   `json:"stale"`
+}
+
+// LogLevelsResponse is returned by GET and PUT /api/v1/system/log-levels.
+// Levels apply to the pod that served the request only and are not persisted.
+type LogLevelsResponse struct {
+	Base            string            `json:"base"`
+	Subsystems      map[string]string `json:"subsystems"` // effective level per subsystem
+	Overrides       map[string]string `json:"overrides"`  // subsystems not following base
+	RedactSensitive bool              `json:"redact_sensitive"`
+}
+
 // --- Handlers ---
 
 // systemWarningsHandler handles GET /api/v1/system/warnings.
@@ -81,6 +110,98 @@ func (s *Server) systemWarningsHandler(c *echo.Context) error {
 	return c.JSON(http.StatusOK, response)
 }
 
+// jobLeadersHandler handles GET /api/v1/system/leaders.
+func (s *Server) jobLeadersHandler(c *echo.Context) error {
+	if s.jobLeaderService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "job leader service is not available")
+	}
+
+	leaders, err := s.jobLeaderService.ListLeaders(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list job leaders")
+	}
+	return c.JSON(http.StatusOK, JobLeadersResponse{Leaders: buildJobLeaders(leaders, time.Now())})
+}
+
+func buildJobLeaders(leaders []*ent.JobLeader, now time.Time) []JobLeaderItem {
+	items := make([]JobLeaderItem, 0, len(leaders))
+	for _, l := range leaders {
+		items = append(items, JobLeaderItem{
+			Job:        l.ID,
+			PodID:      l.PodID,
+			AcquiredAt: l.AcquiredAt.Format(time.RFC3339),
+			RenewedAt:  l.RenewedAt.Format(time.RFC3339),
+			Stale:      now.Sub(l.RenewedAt) > coordination.StaleAfter,
+		})
+	}
+	return items
+}
+
+// logLevelsHandler handles GET /api/v1/system/log-levels.
+func (s *Server) logLevelsHandler(c *echo.Context) error {
+	if s.logLevels == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "log levels are not available")
+	}
+	return c.JSON(http.StatusOK, buildLogLevels(s.logLevels))
+}
+
+// updateLogLevelsHandler handles PUT /api/v1/system/log-levels.
+// All entries are validated before any is applied.
+func (s *Server) updateLogLevelsHandler(c *echo.Context) error {
+	if s.logLevels == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "log levels are not available")
+	}
+
+	var req UpdateLogLevelsRequest
+	if err := bindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	set := make(map[logging.Subsystem]slog.Level, len(req.Levels))
+	var reset []logging.Subsystem
+	for name, value := range req.Levels {
+		sub := logging.Subsystem(name)
+		if !sub.IsValid() {
+			return invalidField("levels."+name, "unknown subsystem %q (must be one of %v)", name, logging.Subsystems())
+		}
+		if value == "" {
+			reset = append(reset, sub)
+			continue
+		}
+		level, err := logging.ParseLevel(value)
+		if err != nil {
+			return invalidField("levels."+name, "%s", err.Error())
+		}
+		set[sub] = level
+	}
+
+	for sub, level := range set {
+		s.logLevels.Set(sub, level)
+	}
+	for _, sub := range reset {
+		s.logLevels.Reset(sub)
+	}
+	slog.Info("Subsystem log levels changed", "levels", req.Levels, "author", extractAuthor(c))
+
+	return c.JSON(http.StatusOK, buildLogLevels(s.logLevels))
+}
+
+func buildLogLevels(levels *logging.LevelTable) LogLevelsResponse {
+	resp := LogLevelsResponse{
+		Base:            logging.LevelName(levels.Base()),
+		Subsystems:      map[string]string{},
+		Overrides:       map[string]string{},
+		RedactSensitive: logging.RedactSensitive(),
+	}
+	for _, sub := range logging.Subsystems() {
+		resp.Subsystems[string(sub)] = logging.LevelName(levels.For(sub))
+	}
+	for sub, level := range levels.Overrides() {
+		resp.Overrides[string(sub)] = logging.LevelName(level)
+	}
+	return resp
+}
+
 // mcpServersHandler handles GET /api/v1/system/mcp-servers.
 func (s *Server) mcpServersHandler(c *echo.Context) error {
 	response := MCPServersResponse{
@@ -2,15 +2,20 @@ package api
 
 import (
 	"encoding/json"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	echo "github.com/labstack/echo/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
 	"github.com/codeready-toolchain/tarsy/pkg/services"
 )
 
@@ -293,3 +298,105 @@ func TestFilterOptionsHandler(t *testing.T) {
 		assert.Len(t, expectedStatuses, 7)
 	})
 }
+
+func TestBuildJobLeaders(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	leaders := []*ent.JobLeader{
+		{ID: "activity-reports", PodID: "pod-a", AcquiredAt: now.Add(-time.Hour), RenewedAt: now.Add(-5 * time.Second)},
+		{ID: "retention-cleanup", PodID: "pod-b", AcquiredAt: now.Add(-time.Hour), RenewedAt: now.Add(-10 * time.Minute)},
+	}
+
+	items := buildJobLeaders(leaders, now)
+	require.Len(t, items, 2)
+	assert.Equal(t, JobLeaderItem{
+		Job:        "activity-reports",
+		PodID:      "pod-a",
+		AcquiredAt: "2024-06-01T11:00:00Z",
+		RenewedAt:  "2024-06-01T11:59:55Z",
+		Stale:      false,
+	}, items[0])
+	assert.True(t, items[1].Stale)
+
+	assert.NotNil(t, buildJobLeaders(nil, now))
+}
+
+func TestJobLeadersHandler_Unavailable(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/system/leaders", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	err := s.jobLeadersHandler(c)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+}
+
+func TestLogLevelsHandlers(t *testing.T) {
+	newServer := func() *Server {
+		return &Server{logLevels: logging.NewLevelTable(slog.LevelInfo)}
+	}
+	put := func(t *testing.T, s *Server, body string) (*httptest.ResponseRecorder, error) {
+		t.Helper()
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/system/log-levels", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		return rec, s.updateLogLevelsHandler(e.NewContext(req, rec))
+	}
+
+	t.Run("get returns effective levels", func(t *testing.T) {
+		s := newServer()
+		s.logLevels.Set(logging.SubsystemMCP, slog.LevelDebug)
+
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/system/log-levels", nil), rec)
+		require.NoError(t, s.logLevelsHandler(c))
+
+		var resp LogLevelsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "info", resp.Base)
+		assert.Equal(t, map[string]string{"queue": "info", "mcp": "debug", "events": "info", "agent": "info"}, resp.Subsystems)
+		assert.Equal(t, map[string]string{"mcp": "debug"}, resp.Overrides)
+	})
+
+	t.Run("put sets and resets levels", func(t *testing.T) {
+		s := newServer()
+		s.logLevels.Set(logging.SubsystemQueue, slog.LevelError)
+
+		rec, err := put(t, s, `{"levels": {"agent": "debug", "queue": ""}}`)
+		require.NoError(t, err)
+
+		var resp LogLevelsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, map[string]string{"agent": "debug"}, resp.Overrides)
+		assert.Equal(t, slog.LevelInfo, s.logLevels.For(logging.SubsystemQueue))
+		assert.Equal(t, slog.LevelDebug, s.logLevels.For(logging.SubsystemAgent))
+	})
+
+	t.Run("put rejects invalid entries without applying any", func(t *testing.T) {
+		for _, body := range []string{
+			`{"levels": {"agent": "debug", "api": "debug"}}`,
+			`{"levels": {"agent": "debug", "mcp": "trace"}}`,
+			`{"levels": {}}`,
+			`not json`,
+		} {
+			s := newServer()
+			_, err := put(t, s, body)
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr, body)
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code, body)
+			assert.Empty(t, s.logLevels.Overrides(), body)
+		}
+	})
+
+	t.Run("unavailable without level table", func(t *testing.T) {
+		s := &Server{}
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/system/log-levels", nil), httptest.NewRecorder())
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, s.logLevelsHandler(c), &httpErr)
+		assert.Equal(t, http.StatusServiceUnavailable, httpErr.Code)
+	})
+}
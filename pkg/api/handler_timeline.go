@@ -1,12 +1,22 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
 )
 
 // getTimelineHandler handles GET /api/v1/sessions/:id/timeline.
+// ?highlights=true returns only highlighted events (key findings, errors,
+// warnings) for a condensed view of long investigations.
 func (s *Server) getTimelineHandler(c *echo.Context) error {
 	sessionID := c.Param("id")
 	if sessionID == "" {
@@ -16,10 +26,98 @@ func (s *Server) getTimelineHandler(c *echo.Context) error {
 		return echo.NewHTTPError(http.StatusServiceUnavailable, "timeline endpoint not configured")
 	}
 
-	events, err := s.timelineService.GetSessionTimeline(c.Request().Context(), sessionID)
+	highlightsOnly := false
+	if v := c.QueryParam("highlights"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "highlights must be true or false")
+		}
+		highlightsOnly = b
+	}
+
+	var events []*ent.TimelineEvent
+	var err error
+	if highlightsOnly {
+		events, err = s.timelineService.GetSessionHighlights(c.Request().Context(), sessionID)
+	} else {
+		events, err = s.timelineService.GetSessionTimeline(c.Request().Context(), sessionID)
+	}
 	if err != nil {
 		return mapServiceError(err)
 	}
 
 	return c.JSON(http.StatusOK, events)
 }
+
+// getTimelinePageHandler handles GET /api/v1/sessions/:id/timeline/events:
+// one page of the timeline in sequence order, for lazily loading large
+// investigations. Optional query params: after_sequence (exclusive; pass the
+// previous page's next_after_sequence), before_sequence (exclusive),
+// event_type (comma-separated), execution_id, limit (1-1000, default 200).
+func (s *Server) getTimelinePageHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+	if s.timelineService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "timeline endpoint not configured")
+	}
+
+	params := models.TimelinePageParams{ExecutionID: c.QueryParam("execution_id")}
+	for _, bound := range []struct {
+		name string
+		dst  *int
+	}{
+		{"after_sequence", &params.AfterSequence},
+		{"before_sequence", &params.BeforeSequence},
+	} {
+		if v := c.QueryParam(bound.name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				return echo.NewHTTPError(http.StatusBadRequest, bound.name+" must be a non-negative integer")
+			}
+			*bound.dst = n
+		}
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > services.MaxTimelinePageLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid limit: must be between 1 and %d", services.MaxTimelinePageLimit))
+		}
+		params.Limit = n
+	}
+	if v := c.QueryParam("event_type"); v != "" {
+		for _, et := range strings.Split(v, ",") {
+			eventType := timelineevent.EventType(et)
+			if err := timelineevent.EventTypeValidator(eventType); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid event_type: "+et)
+			}
+			params.EventTypes = append(params.EventTypes, eventType)
+		}
+	}
+
+	page, err := s.timelineService.GetSessionTimelinePage(c.Request().Context(), sessionID, params)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, page)
+}
+
+// getTimelineSummaryHandler handles GET /api/v1/sessions/:id/timeline/summary:
+// event counts per event type and per execution, so clients can size a
+// timeline before paging through it.
+func (s *Server) getTimelineSummaryHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+	if s.timelineService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "timeline endpoint not configured")
+	}
+
+	summary, err := s.timelineService.GetSessionTimelineSummary(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, summary)
+}
@@ -127,6 +127,62 @@ func TestGetTimelineHandler_WithEvents(t *testing.T) {
 	}
 }
 
+func TestGetTimelineHandler_HighlightsOnly(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	timelineSvc := services.NewTimelineService(client.Client)
+
+	session := createTimelineTestSession(t, client.Client)
+	stageID, execID := createTimelineTestStageAndExecution(t, client.Client, session.ID)
+
+	for i, eventType := range []timelineevent.EventType{
+		timelineevent.EventTypeLlmThinking,
+		timelineevent.EventTypeError,
+		timelineevent.EventTypeLlmResponse,
+		timelineevent.EventTypeFinalAnalysis,
+	} {
+		_, err := timelineSvc.CreateTimelineEvent(context.Background(), models.CreateTimelineEventRequest{
+			SessionID:      session.ID,
+			StageID:        &stageID,
+			ExecutionID:    &execID,
+			SequenceNumber: i + 1,
+			EventType:      eventType,
+			Status:         timelineevent.StatusCompleted,
+			Content:        string(eventType),
+		})
+		require.NoError(t, err)
+	}
+
+	s := &Server{timelineService: timelineSvc}
+	e := timelineTestEcho(s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/"+session.ID+"/timeline?highlights=true", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var events []*ent.TimelineEvent
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &events))
+	require.Len(t, events, 2)
+	assert.Equal(t, timelineevent.EventTypeError, events[0].EventType)
+	require.NotNil(t, events[0].Highlight)
+	assert.Equal(t, timelineevent.HighlightError, *events[0].Highlight)
+	assert.Equal(t, timelineevent.EventTypeFinalAnalysis, events[1].EventType)
+	require.NotNil(t, events[1].Highlight)
+	assert.Equal(t, timelineevent.HighlightKeyFinding, *events[1].Highlight)
+}
+
+func TestGetTimelineHandler_InvalidHighlightsParam(t *testing.T) {
+	s := &Server{timelineService: services.NewTimelineService(nil)}
+	e := timelineTestEcho(s)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/any-id/timeline?highlights=maybe", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func TestGetTimelineHandler_SubAgentParentExecutionID(t *testing.T) {
 	client := testdb.NewTestClient(t)
 	timelineSvc := services.NewTimelineService(client.Client)
@@ -164,12 +220,150 @@ func TestGetTimelineHandler_SubAgentParentExecutionID(t *testing.T) {
 	assert.Equal(t, execID, *events[0].ParentExecutionID)
 }
 
+func TestGetTimelinePageHandler_ServiceNotConfigured(t *testing.T) {
+	e := timelineTestEcho(&Server{})
+
+	for _, path := range []string{"/timeline/events", "/timeline/summary"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/any-id"+path, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code, path)
+	}
+}
+
+func TestGetTimelinePageHandler_InvalidParams(t *testing.T) {
+	e := timelineTestEcho(&Server{timelineService: services.NewTimelineService(nil)})
+
+	for _, query := range []string{
+		"after_sequence=-1",
+		"before_sequence=abc",
+		"limit=0",
+		"limit=1001",
+		"event_type=llm_response,bogus",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/any-id/timeline/events?"+query, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func TestGetTimelinePageHandler_Pages(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	timelineSvc := services.NewTimelineService(client.Client)
+
+	session := createTimelineTestSession(t, client.Client)
+	stageID, execA := createTimelineTestStageAndExecution(t, client.Client, session.ID)
+	execB := createTimelineTestExecution(t, client.Client, session.ID, stageID, "tl-exec-b-"+t.Name())
+
+	// Parallel agents share sequence numbers: A has 1-3, B has 2-3.
+	for _, ev := range []struct {
+		execID string
+		seq    int
+		typ    timelineevent.EventType
+	}{
+		{execA, 1, timelineevent.EventTypeLlmThinking},
+		{execA, 2, timelineevent.EventTypeLlmToolCall},
+		{execB, 2, timelineevent.EventTypeLlmThinking},
+		{execA, 3, timelineevent.EventTypeLlmResponse},
+		{execB, 3, timelineevent.EventTypeFinalAnalysis},
+	} {
+		_, err := timelineSvc.CreateTimelineEvent(context.Background(), models.CreateTimelineEventRequest{
+			SessionID:      session.ID,
+			StageID:        &stageID,
+			ExecutionID:    &ev.execID,
+			SequenceNumber: ev.seq,
+			EventType:      ev.typ,
+			Status:         timelineevent.StatusCompleted,
+			Content:        string(ev.typ),
+		})
+		require.NoError(t, err)
+	}
+
+	e := timelineTestEcho(&Server{timelineService: timelineSvc})
+	get := func(query string) models.TimelinePageResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/"+session.ID+"/timeline/events?"+query, nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var page models.TimelinePageResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+		return page
+	}
+	sequences := func(page models.TimelinePageResponse) []int {
+		out := []int{}
+		for _, ev := range page.Events {
+			out = append(out, ev.SequenceNumber)
+		}
+		return out
+	}
+
+	t.Run("a page never splits a sequence number", func(t *testing.T) {
+		page := get("limit=2")
+		assert.Equal(t, []int{1, 2, 2}, sequences(page))
+		assert.True(t, page.HasMore)
+		require.NotNil(t, page.NextAfterSequence)
+		assert.Equal(t, 2, *page.NextAfterSequence)
+
+		page = get("limit=2&after_sequence=2")
+		assert.Equal(t, []int{3, 3}, sequences(page))
+		assert.False(t, page.HasMore)
+	})
+
+	t.Run("sequence range", func(t *testing.T) {
+		page := get("after_sequence=1&before_sequence=3")
+		assert.Equal(t, []int{2, 2}, sequences(page))
+		assert.False(t, page.HasMore)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		page := get("event_type=llm_thinking,final_analysis")
+		assert.Equal(t, []int{1, 2, 3}, sequences(page))
+
+		page = get("execution_id=" + execB)
+		assert.Equal(t, []int{2, 3}, sequences(page))
+		for _, ev := range page.Events {
+			assert.Equal(t, execB, *ev.ExecutionID)
+		}
+	})
+
+	t.Run("past the end", func(t *testing.T) {
+		page := get("after_sequence=3")
+		assert.Empty(t, page.Events)
+		assert.Nil(t, page.NextAfterSequence)
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/"+session.ID+"/timeline/summary", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var summary models.TimelineSummaryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &summary))
+		assert.Equal(t, 5, summary.TotalEvents)
+		assert.Equal(t, 3, summary.MaxSequence)
+		assert.Equal(t, 1, summary.Highlights) // final_analysis
+		assert.Equal(t, 2, summary.EventTypes[string(timelineevent.EventTypeLlmThinking)])
+		require.Len(t, summary.Executions, 2)
+		assert.Equal(t, execA, *summary.Executions[0].ExecutionID)
+		assert.Equal(t, 3, summary.Executions[0].Events)
+		assert.Equal(t, 1, summary.Executions[0].FirstSequence)
+		assert.Equal(t, execB, *summary.Executions[1].ExecutionID)
+		assert.Equal(t, 2, summary.Executions[1].Events)
+		assert.Equal(t, 3, summary.Executions[1].LastSequence)
+	})
+}
+
 // ── Helpers ──────────────────────────────────────────────────
 
 // timelineTestEcho creates a minimal echo instance with the timeline route registered.
 func timelineTestEcho(s *Server) *echo.Echo {
 	e := echo.New()
 	e.GET("/api/v1/sessions/:id/timeline", s.getTimelineHandler)
+	e.GET("/api/v1/sessions/:id/timeline/events", s.getTimelinePageHandler)
+	e.GET("/api/v1/sessions/:id/timeline/summary", s.getTimelineSummaryHandler)
 	return e
 }
 
@@ -200,16 +394,20 @@ func createTimelineTestStageAndExecution(t *testing.T, client *ent.Client, sessi
 		Save(context.Background())
 	require.NoError(t, err)
 
+	return stg.ID, createTimelineTestExecution(t, client, sessionID, stg.ID, "tl-exec-"+t.Name())
+}
+
+func createTimelineTestExecution(t *testing.T, client *ent.Client, sessionID, stageID, execID string) string {
+	t.Helper()
 	exec, err := client.AgentExecution.Create().
-		SetID("tl-exec-" + t.Name()).
+		SetID(execID).
 		SetSessionID(sessionID).
-		SetStageID(stg.ID).
+		SetStageID(stageID).
 		SetAgentName("DataCollector").
 		SetAgentIndex(1).
 		SetLlmBackend("google-native").
 		SetStatus(agentexecution.StatusCompleted).
 		Save(context.Background())
 	require.NoError(t, err)
-
-	return stg.ID, exec.ID
+	return exec.ID
 }
@@ -1,12 +1,18 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"sort"
 	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/message"
 	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
 	echo "github.com/labstack/echo/v5"
 )
@@ -25,26 +31,34 @@ func (s *Server) getTraceListHandler(c *echo.Context) error {
 		return echo.NewHTTPError(http.StatusServiceUnavailable, "trace endpoints not configured")
 	}
 
-	ctx := c.Request().Context()
+	resp, err := s.loadTraceList(c.Request().Context(), sessionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
 
+// loadTraceList loads a session's stages and interactions and groups them.
+func (s *Server) loadTraceList(ctx context.Context, sessionID string) (*models.TraceListResponse, error) {
 	// Load stages with their agent executions.
 	stages, err := s.stageService.GetStagesBySession(ctx, sessionID, true)
 	if err != nil {
-		return mapServiceError(err)
+		return nil, err
 	}
 
 	// Load all LLM and MCP interactions for the session.
 	llmInteractions, err := s.interactionService.GetLLMInteractionsList(ctx, sessionID)
 	if err != nil {
-		return mapServiceError(err)
+		return nil, err
 	}
 	mcpInteractions, err := s.interactionService.GetMCPInteractionsList(ctx, sessionID)
 	if err != nil {
-		return mapServiceError(err)
+		return nil, err
 	}
 
 	resp := buildTraceListResponse(stages, llmInteractions, mcpInteractions)
-	return c.JSON(http.StatusOK, resp)
+	s.artifactURLs.signTrace(sessionID, resp)
+	return resp, nil
 }
 
 // ────────────────────────────────────────────────────────────
@@ -102,6 +116,61 @@ func (s *Server) getMCPInteractionHandler(c *echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// ────────────────────────────────────────────────────────────
+// GET /api/v1/sessions/:id/trace/mcp/:interaction_id/result
+// Raw tool result download, authorized by the signed URL listed in the
+// execution's artifacts.
+// ────────────────────────────────────────────────────────────
+
+func (s *Server) getMCPToolResultHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	interactionID := c.Param("interaction_id")
+	if sessionID == "" || interactionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id and interaction_id are required")
+	}
+	if s.interactionService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "trace endpoints not configured")
+	}
+	if err := s.artifactURLs.verify(sessionID, interactionID, c.QueryParam("expires"), c.QueryParam("signature")); err != nil {
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	}
+
+	interaction, err := s.interactionService.GetMCPInteractionDetail(c.Request().Context(), interactionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	if interaction.SessionID != sessionID || interaction.ToolResult == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "tool result not found")
+	}
+
+	c.Response().Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, interactionID))
+	c.Response().Header().Set("Cache-Control", "private, no-store")
+	return c.JSON(http.StatusOK, interaction.ToolResult)
+}
+
+// ────────────────────────────────────────────────────────────
+// GET /api/v1/sessions/:id/trace/executions/:execution_id/context
+// Per-execution context growth report (prompt budget per LLM call).
+// ────────────────────────────────────────────────────────────
+
+func (s *Server) getExecutionContextHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	executionID := c.Param("execution_id")
+	if sessionID == "" || executionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id and execution_id are required")
+	}
+	if s.interactionService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "trace endpoints not configured")
+	}
+
+	interactions, messages, err := s.interactionService.GetExecutionContextTrace(c.Request().Context(), sessionID, executionID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+
+	return c.JSON(http.StatusOK, buildContextGrowthResponse(executionID, interactions, messages))
+}
+
 // ────────────────────────────────────────────────────────────
 // Grouping logic (pure function — no HTTP/service dependencies)
 // ────────────────────────────────────────────────────────────
@@ -193,8 +262,10 @@ func buildTraceExecutionGroup(
 	mcpByExec map[string][]*ent.MCPInteraction,
 ) models.TraceExecutionGroup {
 	eg := models.TraceExecutionGroup{
-		ExecutionID: exec.ID,
-		AgentName:   exec.AgentName,
+		ExecutionID:   exec.ID,
+		AgentName:     exec.AgentName,
+		ToolCallCount: exec.ToolCallCount,
+		LoopDetected:  exec.LoopDetected,
 	}
 	for _, li := range llmByExec[exec.ID] {
 		eg.LLMInteractions = append(eg.LLMInteractions, toLLMListItem(li))
@@ -204,13 +275,117 @@ func buildTraceExecutionGroup(
 	}
 	for _, mi := range mcpByExec[exec.ID] {
 		eg.MCPInteractions = append(eg.MCPInteractions, toMCPListItem(mi))
+		if art, ok := toToolResultArtifact(mi); ok {
+			eg.Artifacts = append(eg.Artifacts, art)
+		}
 	}
 	if eg.MCPInteractions == nil {
 		eg.MCPInteractions = []models.MCPInteractionListItem{}
 	}
+	if eg.Artifacts == nil {
+		eg.Artifacts = []models.TraceArtifact{}
+	}
 	return eg
 }
 
+// maxLargestToolResults caps the largest_tool_results list of the context report.
+const maxLargestToolResults = 10
+
+// buildContextGrowthResponse computes the prompt budget of each LLM call in an
+// execution. Tool result messages are attributed to the first call whose prompt
+// included them: those sequenced after the previous call's response and before
+// this call's response.
+func buildContextGrowthResponse(
+	executionID string,
+	interactions []*ent.LLMInteraction,
+	messages []*ent.Message,
+) *models.ContextGrowthResponse {
+	seqByID := make(map[string]int, len(messages))
+	var toolResults []*ent.Message
+	for _, msg := range messages {
+		seqByID[msg.ID] = msg.SequenceNumber
+		if msg.Role == message.RoleTool {
+			toolResults = append(toolResults, msg)
+		}
+	}
+
+	attributed := make(map[string]string, len(toolResults)) // message ID → interaction ID
+	iterations := make([]models.ContextGrowthIteration, 0, len(interactions))
+	prevSeq := 0
+	var prevInput *int
+	for _, li := range interactions {
+		it := models.ContextGrowthIteration{
+			InteractionID:    li.ID,
+			InteractionType:  string(li.InteractionType),
+			Iteration:        requestMetaInt(li.LlmRequest, "iteration"),
+			MessagesCount:    requestMetaInt(li.LlmRequest, "messages_count"),
+			PromptChars:      requestMetaInt(li.LlmRequest, "prompt_chars"),
+			ToolResultChars:  requestMetaInt(li.LlmRequest, "tool_result_chars"),
+			PromptTokens:     requestMetaInt(li.LlmRequest, "prompt_tokens"),
+			ToolResultTokens: requestMetaInt(li.LlmRequest, "tool_result_tokens"),
+			Tokenizer:        requestMetaString(li.LlmRequest, "tokenizer"),
+			InputTokens:      li.InputTokens,
+			OutputTokens:     li.OutputTokens,
+			AddedToolResults: []models.ContextToolResult{},
+			CreatedAt:        li.CreatedAt.Format(time.RFC3339Nano),
+		}
+		if li.InputTokens != nil {
+			if prevInput != nil {
+				delta := *li.InputTokens - *prevInput
+				it.InputTokensDelta = &delta
+			}
+			prevInput = li.InputTokens
+		}
+
+		if li.LastMessageID != nil {
+			if lastSeq, ok := seqByID[*li.LastMessageID]; ok {
+				for _, msg := range toolResults {
+					if msg.SequenceNumber > prevSeq && msg.SequenceNumber < lastSeq {
+						attributed[msg.ID] = li.ID
+						it.AddedToolResults = append(it.AddedToolResults, toContextToolResult(msg, li.ID))
+					}
+				}
+				prevSeq = lastSeq
+			}
+		}
+		iterations = append(iterations, it)
+	}
+
+	largest := make([]models.ContextToolResult, 0, len(toolResults))
+	for _, msg := range toolResults {
+		largest = append(largest, toContextToolResult(msg, attributed[msg.ID]))
+	}
+	sort.SliceStable(largest, func(i, j int) bool {
+		return largest[i].Chars > largest[j].Chars
+	})
+	if len(largest) > maxLargestToolResults {
+		largest = largest[:maxLargestToolResults]
+	}
+
+	return &models.ContextGrowthResponse{
+		ExecutionID:        executionID,
+		Iterations:         iterations,
+		LargestToolResults: largest,
+	}
+}
+
+// requestMetaInt reads an integer from llm_request metadata. JSON numbers
+// deserialize as float64; returns nil when the key is absent.
+func requestMetaInt(llmRequest map[string]any, key string) *int {
+	raw, ok := llmRequest[key].(float64)
+	if !ok {
+		return nil
+	}
+	v := int(raw)
+	return &v
+}
+
+// requestMetaString reads a string from llm_request metadata; empty when absent.
+func requestMetaString(llmRequest map[string]any, key string) string {
+	v, _ := llmRequest[key].(string)
+	return v
+}
+
 // ────────────────────────────────────────────────────────────
 // Mapping helpers
 // ────────────────────────────────────────────────────────────
@@ -229,6 +404,17 @@ func toLLMListItem(li *ent.LLMInteraction) models.LLMInteractionListItem {
 	}
 }
 
+func toContextToolResult(msg *ent.Message, interactionID string) models.ContextToolResult {
+	return models.ContextToolResult{
+		MessageID:       msg.ID,
+		ToolName:        msg.ToolName,
+		ToolCallID:      msg.ToolCallID,
+		Chars:           len(msg.Content),
+		EstimatedTokens: mcp.EstimateTokens(msg.Content),
+		InteractionID:   interactionID,
+	}
+}
+
 func toMCPListItem(mi *ent.MCPInteraction) models.MCPInteractionListItem {
 	return models.MCPInteractionListItem{
 		ID:              mi.ID,
@@ -241,6 +427,42 @@ func toMCPListItem(mi *ent.MCPInteraction) models.MCPInteractionListItem {
 	}
 }
 
+// toToolResultArtifact describes the result of a tool call as an artifact
+// reference. Offloaded results report the size recorded at offload time;
+// inline results are measured by their JSON encoding. Returns false for
+// interactions without a result.
+func toToolResultArtifact(mi *ent.MCPInteraction) (models.TraceArtifact, bool) {
+	if mi.InteractionType != mcpinteraction.InteractionTypeToolCall {
+		return models.TraceArtifact{}, false
+	}
+	art := models.TraceArtifact{
+		Kind:          "tool_result",
+		InteractionID: mi.ID,
+		Name:          mi.ServerName,
+		ContentType:   "application/json",
+	}
+	if mi.ToolName != nil {
+		art.Name += "." + *mi.ToolName
+	}
+	switch {
+	case mi.ToolResultBlob != nil:
+		art.Storage = "blob_store"
+		if mi.ToolResultBytes != nil {
+			art.SizeBytes = *mi.ToolResultBytes
+		}
+	case mi.ToolResult != nil:
+		raw, err := json.Marshal(mi.ToolResult)
+		if err != nil {
+			return models.TraceArtifact{}, false
+		}
+		art.Storage = "database"
+		art.SizeBytes = len(raw)
+	default:
+		return models.TraceArtifact{}, false
+	}
+	return art, true
+}
+
 func toLLMDetailResponse(li *ent.LLMInteraction, messages []*ent.Message) *models.LLMInteractionDetailResponse {
 	// Build conversation from Message records (normal iteration/synthesis path).
 	conversation := make([]models.ConversationMessage, 0, len(messages))
@@ -258,6 +480,14 @@ func toLLMDetailResponse(li *ent.LLMInteraction, messages []*ent.Message) *model
 				cm.ToolCalls[i] = schemaToolCallToModel(tc)
 			}
 		}
+		for _, img := range msg.Images {
+			cm.Images = append(cm.Images, models.MessageImage{
+				ID:        img.ID,
+				MimeType:  img.MimeType,
+				Name:      img.Name,
+				SizeBytes: img.SizeBytes,
+			})
+		}
 		conversation = append(conversation, cm)
 	}
 
@@ -291,6 +521,7 @@ func toLLMDetailResponse(li *ent.LLMInteraction, messages []*ent.Message) *model
 		LLMRequest:       llmRequest,
 		LLMResponse:      li.LlmResponse,
 		ResponseMetadata: li.ResponseMetadata,
+		RequestID:        li.RequestID,
 		CreatedAt:        li.CreatedAt.Format(time.RFC3339Nano),
 		Conversation:     conversation,
 	}
@@ -338,6 +569,7 @@ func toMCPDetailResponse(mi *ent.MCPInteraction) *models.MCPInteractionDetailRes
 		AvailableTools:  mi.AvailableTools,
 		DurationMs:      mi.DurationMs,
 		ErrorMessage:    mi.ErrorMessage,
+		RequestID:       mi.RequestID,
 		CreatedAt:       mi.CreatedAt.Format(time.RFC3339Nano),
 	}
 }
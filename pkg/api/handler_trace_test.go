@@ -1,6 +1,8 @@
 package api
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -241,6 +243,156 @@ func TestBuildTraceListResponse_SubAgentNesting(t *testing.T) {
 	assert.Empty(t, orch.SubAgents[1].MCPInteractions)
 }
 
+func TestBuildTraceListResponse_Artifacts(t *testing.T) {
+	now := time.Now()
+	stages := []*ent.Stage{
+		{
+			StageName: "investigation",
+			StageType: stage.StageTypeInvestigation,
+			Edges: ent.StageEdges{
+				AgentExecutions: []*ent.AgentExecution{{ID: "exec-1", AgentName: "Agent1", AgentIndex: 1}},
+			},
+		},
+	}
+
+	toolName := "get_logs"
+	blobKey := "tool-results/s1/mcp-2.json"
+	blobBytes := 5 * 1024 * 1024
+	mcpInteractions := []*ent.MCPInteraction{
+		{ID: "mcp-0", ExecutionID: "exec-1", InteractionType: mcpinteraction.InteractionTypeToolList, ServerName: "k8s", CreatedAt: now},
+		{ID: "mcp-1", ExecutionID: "exec-1", InteractionType: mcpinteraction.InteractionTypeToolCall, ServerName: "k8s", ToolName: &toolName,
+			ToolResult: map[string]interface{}{"content": "ok"}, CreatedAt: now},
+		{ID: "mcp-2", ExecutionID: "exec-1", InteractionType: mcpinteraction.InteractionTypeToolCall, ServerName: "k8s", ToolName: &toolName,
+			ToolResultBlob: &blobKey, ToolResultBytes: &blobBytes, CreatedAt: now},
+		{ID: "mcp-3", ExecutionID: "exec-1", InteractionType: mcpinteraction.InteractionTypeToolCall, ServerName: "k8s", ToolName: &toolName, CreatedAt: now},
+	}
+
+	resp := buildTraceListResponse(stages, nil, mcpInteractions)
+
+	arts := resp.Stages[0].Executions[0].Artifacts
+	require.Len(t, arts, 2)
+	assert.Equal(t, "tool_result", arts[0].Kind)
+	assert.Equal(t, "mcp-1", arts[0].InteractionID)
+	assert.Equal(t, "k8s.get_logs", arts[0].Name)
+	assert.Equal(t, "application/json", arts[0].ContentType)
+	assert.Equal(t, "database", arts[0].Storage)
+	assert.Equal(t, len(`{"content":"ok"}`), arts[0].SizeBytes)
+	assert.Empty(t, arts[0].DownloadURL)
+
+	assert.Equal(t, "mcp-2", arts[1].InteractionID)
+	assert.Equal(t, "blob_store", arts[1].Storage)
+	assert.Equal(t, blobBytes, arts[1].SizeBytes)
+}
+
+// ============================================================================
+// buildContextGrowthResponse tests
+// ============================================================================
+
+func TestBuildContextGrowthResponse_Empty(t *testing.T) {
+	resp := buildContextGrowthResponse("exec-1", nil, nil)
+	assert.Equal(t, "exec-1", resp.ExecutionID)
+	assert.NotNil(t, resp.Iterations)
+	assert.NotNil(t, resp.LargestToolResults)
+	assert.Empty(t, resp.Iterations)
+	assert.Empty(t, resp.LargestToolResults)
+}
+
+func TestBuildContextGrowthResponse_AttributesToolResults(t *testing.T) {
+	now := time.Now()
+	ptr := func(v int) *int { return &v }
+	str := func(v string) *string { return &v }
+
+	// seq 1-2: system/user, seq 3: assistant (call 1), seq 4-5: tool results,
+	// seq 6: assistant (call 2), seq 7: tool result, seq 8: assistant (call 3).
+	messages := []*ent.Message{
+		{ID: "m1", SequenceNumber: 1, Role: message.RoleSystem, Content: "sys"},
+		{ID: "m2", SequenceNumber: 2, Role: message.RoleUser, Content: "alert"},
+		{ID: "m3", SequenceNumber: 3, Role: message.RoleAssistant},
+		{ID: "m4", SequenceNumber: 4, Role: message.RoleTool, Content: "small", ToolName: str("k8s.get_pods"), ToolCallID: str("c1")},
+		{ID: "m5", SequenceNumber: 5, Role: message.RoleTool, Content: strings.Repeat("x", 4000), ToolName: str("k8s.get_logs"), ToolCallID: str("c2")},
+		{ID: "m6", SequenceNumber: 6, Role: message.RoleAssistant},
+		{ID: "m7", SequenceNumber: 7, Role: message.RoleTool, Content: strings.Repeat("y", 400), ToolName: str("k8s.describe")},
+		{ID: "m8", SequenceNumber: 8, Role: message.RoleAssistant},
+	}
+	interactions := []*ent.LLMInteraction{
+		{
+			ID: "li-1", InteractionType: llminteraction.InteractionTypeIteration,
+			LlmRequest:    map[string]any{"iteration": float64(1), "messages_count": float64(2), "prompt_chars": float64(8)},
+			InputTokens:   ptr(100),
+			LastMessageID: str("m3"),
+			CreatedAt:     now,
+		},
+		{
+			ID: "li-2", InteractionType: llminteraction.InteractionTypeIteration,
+			LlmRequest: map[string]any{
+				"iteration": float64(2), "tool_result_chars": float64(4005),
+				"tool_result_tokens": float64(950), "tokenizer": "tiktoken:o200k_base.tiktoken",
+			},
+			InputTokens:   ptr(1200),
+			LastMessageID: str("m6"),
+			CreatedAt:     now.Add(time.Second),
+		},
+		{
+			// Legacy interaction: no metadata, no usage.
+			ID: "li-3", InteractionType: llminteraction.InteractionTypeIteration,
+			LastMessageID: str("m8"),
+			CreatedAt:     now.Add(2 * time.Second),
+		},
+	}
+
+	resp := buildContextGrowthResponse("exec-1", interactions, messages)
+	require.Len(t, resp.Iterations, 3)
+
+	first := resp.Iterations[0]
+	assert.Equal(t, ptr(1), first.Iteration)
+	assert.Equal(t, ptr(2), first.MessagesCount)
+	assert.Equal(t, ptr(8), first.PromptChars)
+	assert.Nil(t, first.InputTokensDelta)
+	assert.Empty(t, first.AddedToolResults)
+
+	second := resp.Iterations[1]
+	assert.Equal(t, ptr(4005), second.ToolResultChars)
+	assert.Equal(t, ptr(950), second.ToolResultTokens)
+	assert.Equal(t, "tiktoken:o200k_base.tiktoken", second.Tokenizer)
+	assert.Equal(t, ptr(1100), second.InputTokensDelta)
+	require.Len(t, second.AddedToolResults, 2)
+	assert.Equal(t, "m4", second.AddedToolResults[0].MessageID)
+	assert.Equal(t, "m5", second.AddedToolResults[1].MessageID)
+	assert.Equal(t, 4000, second.AddedToolResults[1].Chars)
+	assert.Equal(t, 1000, second.AddedToolResults[1].EstimatedTokens)
+	assert.Equal(t, "li-2", second.AddedToolResults[1].InteractionID)
+
+	third := resp.Iterations[2]
+	assert.Nil(t, third.Iteration)
+	assert.Nil(t, third.PromptChars)
+	assert.Nil(t, third.InputTokensDelta)
+	require.Len(t, third.AddedToolResults, 1)
+	assert.Equal(t, "m7", third.AddedToolResults[0].MessageID)
+
+	require.Len(t, resp.LargestToolResults, 3)
+	assert.Equal(t, "m5", resp.LargestToolResults[0].MessageID)
+	assert.Equal(t, "m7", resp.LargestToolResults[1].MessageID)
+	assert.Equal(t, "li-3", resp.LargestToolResults[1].InteractionID)
+	assert.Equal(t, "m4", resp.LargestToolResults[2].MessageID)
+}
+
+func TestBuildContextGrowthResponse_CapsLargestToolResults(t *testing.T) {
+	var messages []*ent.Message
+	for i := 0; i < maxLargestToolResults+5; i++ {
+		messages = append(messages, &ent.Message{
+			ID:             fmt.Sprintf("m%d", i),
+			SequenceNumber: i + 1,
+			Role:           message.RoleTool,
+			Content:        strings.Repeat("z", i),
+		})
+	}
+
+	resp := buildContextGrowthResponse("exec-1", nil, messages)
+	require.Len(t, resp.LargestToolResults, maxLargestToolResults)
+	assert.Equal(t, maxLargestToolResults+4, resp.LargestToolResults[0].Chars)
+	assert.Empty(t, resp.LargestToolResults[0].InteractionID, "never sent to an LLM call")
+}
+
 // ============================================================================
 // toLLMListItem tests
 // ============================================================================
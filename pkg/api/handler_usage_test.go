@@ -69,6 +69,12 @@ func TestUsageSummaryHandler_Validation(t *testing.T) {
 			wantErr: http.StatusBadRequest,
 			errMsg:  "invalid rank_by",
 		},
+		{
+			name:    "invalid outcome",
+			query:   validWindow + "&outcome=resolved",
+			wantErr: http.StatusBadRequest,
+			errMsg:  "invalid outcome",
+		},
 		{
 			name:    "window longer than 365 days",
 			query:   "start_date=2024-01-01T00:00:00Z&end_date=2025-01-02T00:00:00Z",
@@ -146,6 +152,39 @@ func TestUsageSummaryHandler_Validation(t *testing.T) {
 	})
 }
 
+func TestUsageOwnersHandler_Validation(t *testing.T) {
+	s := &Server{}
+	for _, query := range []string{
+		"end_date=2024-02-01T00:00:00Z",
+		"start_date=2024-02-01T00:00:00Z&end_date=2024-01-01T00:00:00Z",
+	} {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/usage/owners?"+query, nil)
+		c := e.NewContext(req, httptest.NewRecorder())
+
+		err := s.usageOwnersHandler(c)
+		var he *echo.HTTPError
+		require.ErrorAs(t, err, &he, query)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+	}
+
+	t.Run("returns owners", func(t *testing.T) {
+		client := testdb.NewTestClient(t)
+		server := &Server{sessionService: newUsageTestSessionService(client.Client)}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet,
+			"/api/v1/usage/owners?start_date=2024-01-01T00:00:00Z&end_date=2024-02-01T00:00:00Z", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, server.usageOwnersHandler(c))
+		var resp models.UsageOwnersResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Empty(t, resp.Owners)
+	})
+}
+
 func newUsageTestSessionService(client *ent.Client) *services.SessionService {
 	chainRegistry := config.NewChainRegistry(map[string]*config.ChainConfig{
 		"k8s-analysis": {
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+)
+
+// getVoiceNoteHandler handles GET /api/v1/sessions/:id/voice-notes/:voice_note_id.
+// Serves the original audio of a chat question sent as a voice note.
+func (s *Server) getVoiceNoteHandler(c *echo.Context) error {
+	sessionID := c.Param("id")
+	if sessionID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "session id is required")
+	}
+	voiceNoteID := c.Param("voice_note_id")
+	if voiceNoteID == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "voice note id is required")
+	}
+	if s.chatService == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "chat service is not available")
+	}
+
+	note, data, err := s.chatService.GetVoiceNote(c.Request().Context(), sessionID, voiceNoteID)
+	if err != nil {
+		return mapServiceError(err)
+	}
+	// Voice notes never change once stored
+	c.Response().Header().Set("Cache-Control", "private, max-age=86400, immutable")
+	return c.Blob(http.StatusOK, note.MimeType, data)
+}
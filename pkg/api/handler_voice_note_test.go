@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetVoiceNoteHandler_MissingVoiceNoteID(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/s1/voice-notes/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPathValues(echo.PathValues{{Name: "id", Value: "s1"}})
+
+	err := s.getVoiceNoteHandler(c)
+	if assert.Error(t, err) {
+		he, ok := err.(*echo.HTTPError)
+		if assert.True(t, ok) {
+			assert.Equal(t, http.StatusBadRequest, he.Code)
+		}
+	}
+}
+
+func TestGetVoiceNoteHandler_NilChatService(t *testing.T) {
+	s := &Server{}
+	e := echo.New()
+	e.GET("/api/v1/sessions/:id/voice-notes/:voice_note_id", s.getVoiceNoteHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sessions/s1/voice-notes/vn-1", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
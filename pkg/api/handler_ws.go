@@ -1,6 +1,10 @@
 package api
 
 import (
+	"math"
+	"net/http"
+	"strconv"
+
 	"github.com/coder/websocket"
 	echo "github.com/labstack/echo/v5"
 )
@@ -10,6 +14,11 @@ func (s *Server) wsHandler(c *echo.Context) error {
 	if s.connManager == nil {
 		return echo.NewHTTPError(503, "WebSocket not available")
 	}
+	if retryAfter, draining := s.connManager.Draining(); draining {
+		// Shutting down: have the client reconnect to another pod
+		c.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "server is shutting down")
+	}
 
 	conn, err := websocket.Accept(c.Response(), c.Request(), &websocket.AcceptOptions{
 		OriginPatterns: s.wsOriginPatterns,
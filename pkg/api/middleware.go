@@ -8,7 +8,9 @@ import (
 
 	echo "github.com/labstack/echo/v5"
 
+	"github.com/codeready-toolchain/tarsy/pkg/actor"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 )
 
 // securityHeaders returns middleware that sets standard security response headers.
@@ -25,6 +27,37 @@ func securityHeaders() echo.MiddlewareFunc {
 	}
 }
 
+// requestIDMiddleware accepts a client-supplied X-Request-ID (or generates
+// one), echoes it on the response, and stores it on the request context so
+// logs, sessions, events, and interaction records can be correlated.
+func requestIDMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			req := c.Request()
+			id := requestid.Sanitize(req.Header.Get(requestid.Header))
+			if id == "" {
+				id = requestid.New()
+			}
+			c.Response().Header().Set(requestid.Header, id)
+			c.SetRequest(req.WithContext(requestid.WithContext(req.Context(), id)))
+			return next(c)
+		}
+	}
+}
+
+// actorMiddleware stores the caller (see extractAuthor) on the request
+// context, so the ent audit hooks stamp created_by / updated_by on the rows
+// the request writes.
+func actorMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			req := c.Request()
+			c.SetRequest(req.WithContext(actor.WithContext(req.Context(), extractAuthor(c))))
+			return next(c)
+		}
+	}
+}
+
 // prometheusMiddleware records HTTP request count and duration for all routes
 // except /health and /metrics (which would create scrape noise).
 func prometheusMiddleware() echo.MiddlewareFunc {
@@ -7,6 +7,9 @@ import (
 
 	echo "github.com/labstack/echo/v5"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/actor"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 )
 
 func TestSecurityHeaders(t *testing.T) {
@@ -26,3 +29,60 @@ func TestSecurityHeaders(t *testing.T) {
 	assert.Equal(t, "strict-origin-when-cross-origin", rec.Header().Get("Referrer-Policy"))
 	assert.Equal(t, "camera=(), microphone=(), geolocation=()", rec.Header().Get("Permissions-Policy"))
 }
+
+func TestRequestIDMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(requestIDMiddleware())
+	var seen string
+	e.GET("/test", func(c *echo.Context) error {
+		seen = requestid.FromContext(c.Request().Context())
+		return c.String(http.StatusOK, "ok")
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		wantEcho bool // client value is kept
+	}{
+		{name: "generated when absent"},
+		{name: "client value accepted", header: "incident-42", wantEcho: true},
+		{name: "invalid client value replaced", header: "bad value\r\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if tt.header != "" {
+				req.Header.Set(requestid.Header, tt.header)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(requestid.Header)
+			assert.NotEmpty(t, got)
+			assert.Equal(t, got, seen, "context carries the response ID")
+			if tt.wantEcho {
+				assert.Equal(t, tt.header, got)
+			} else {
+				assert.NotEqual(t, tt.header, got)
+			}
+		})
+	}
+}
+
+func TestActorMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(actorMiddleware())
+	var seen string
+	e.POST("/test", func(c *echo.Context) error {
+		seen = actor.FromContext(c.Request().Context())
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/test", nil)
+	req.Header.Set("X-Forwarded-Email", "alice@example.com")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "alice@example.com", seen)
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/test", nil))
+	assert.Equal(t, "api-client", seen)
+}
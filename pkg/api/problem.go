@@ -0,0 +1,87 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	echo "github.com/labstack/echo/v5"
+
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+)
+
+// problemContentType is the media type of error responses (RFC 7807).
+const problemContentType = "application/problem+json"
+
+// Problem types. Errors without a more specific type use about:blank, for
+// which the title is the HTTP status text.
+const (
+	problemTypeDefault    = "about:blank"
+	problemTypeValidation = "urn:tarsy:problem:validation"
+)
+
+// Problem is the RFC 7807 body of every API error response. Errors lists
+// the invalid fields of a rejected request; RequestID correlates the
+// response with server logs.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// newProblem builds the problem response for an error returned by a handler
+// or middleware. *echo.HTTPError keeps its status and message; other errors
+// are unexpected and reported as 500 without details.
+func newProblem(c *echo.Context, err error) *Problem {
+	p := &Problem{
+		Type:      problemTypeDefault,
+		Status:    http.StatusInternalServerError,
+		Detail:    "internal server error",
+		Instance:  c.Request().URL.Path,
+		RequestID: requestid.FromContext(c.Request().Context()),
+	}
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		p.Status = he.Code
+		p.Detail = he.Message
+	} else {
+		var sc echo.HTTPStatusCoder
+		if errors.As(err, &sc) && sc.StatusCode() != 0 {
+			p.Status = sc.StatusCode()
+			p.Detail = http.StatusText(p.Status)
+		} else {
+			slog.ErrorContext(c.Request().Context(), "Unhandled API error", "path", p.Instance, "error", err)
+		}
+	}
+	var fields ValidationErrors
+	if errors.As(err, &fields) {
+		p.Type = problemTypeValidation
+		p.Errors = fields
+	}
+	p.Title = http.StatusText(p.Status)
+	if p.Title == "" {
+		p.Title = "Error"
+	}
+	return p
+}
+
+// problemErrorHandler renders handler errors as problem+json responses.
+func problemErrorHandler(c *echo.Context, err error) {
+	if r, _ := echo.UnwrapResponse(c.Response()); r != nil && r.Committed {
+		return
+	}
+	p := newProblem(c, err)
+	if c.Request().Method == http.MethodHead {
+		_ = c.NoContent(p.Status)
+		return
+	}
+	c.Response().Header().Set(echo.HeaderContentType, problemContentType)
+	c.Response().WriteHeader(p.Status)
+	if err := c.Echo().JSONSerializer.Serialize(c, p, ""); err != nil {
+		slog.Debug("Failed to write problem response", "error", err)
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+)
+
+func TestProblemErrorHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantCode  int
+		wantType  string
+		wantMsg   string
+		wantError []FieldError
+	}{
+		{
+			name:     "http error keeps status and message",
+			err:      echo.NewHTTPError(http.StatusNotFound, "session not found"),
+			wantCode: http.StatusNotFound,
+			wantType: problemTypeDefault,
+			wantMsg:  "session not found",
+		},
+		{
+			name:      "validation errors list the fields",
+			err:       invalidFields(FieldError{Field: "data", Message: "required"}, FieldError{Field: "runbook", Message: "invalid URL"}),
+			wantCode:  http.StatusBadRequest,
+			wantType:  problemTypeValidation,
+			wantMsg:   "data: required; runbook: invalid URL",
+			wantError: []FieldError{{Field: "data", Message: "required"}, {Field: "runbook", Message: "invalid URL"}},
+		},
+		{
+			name:     "unexpected errors are not exposed",
+			err:      errors.New("pq: connection refused"),
+			wantCode: http.StatusInternalServerError,
+			wantType: problemTypeDefault,
+			wantMsg:  "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/alerts", nil)
+			req = req.WithContext(requestid.WithContext(req.Context(), "req-123"))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			problemErrorHandler(c, tt.err)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, problemContentType, rec.Header().Get(echo.HeaderContentType))
+			var p Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &p))
+			assert.Equal(t, tt.wantType, p.Type)
+			assert.Equal(t, http.StatusText(tt.wantCode), p.Title)
+			assert.Equal(t, tt.wantCode, p.Status)
+			assert.Equal(t, tt.wantMsg, p.Detail)
+			assert.Equal(t, "/api/v1/alerts", p.Instance)
+			assert.Equal(t, "req-123", p.RequestID)
+			assert.Equal(t, tt.wantError, p.Errors)
+		})
+	}
+}
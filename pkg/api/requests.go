@@ -1,12 +1,114 @@
 package api
 
-import "github.com/codeready-toolchain/tarsy/pkg/models"
+import (
+	"encoding/json"
+
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
 
 // SubmitAlertRequest is the HTTP request body for POST /api/v1/alerts.
 type SubmitAlertRequest struct {
 	AlertType               string                     `json:"alert_type"`
 	Runbook                 string                     `json:"runbook,omitempty"`
-	Data                    string                     `json:"data"`
+	Data                    string                     `json:"data" validate:"required"`
 	MCP                     *models.MCPSelectionConfig `json:"mcp,omitempty"`
 	SlackMessageFingerprint string                     `json:"slack_message_fingerprint,omitempty"`
+	AlertKey                string                     `json:"alert_key,omitempty"`
+	Instructions            *models.AlertInstructions  `json:"instructions,omitempty"`
+	OutputLanguage          string                     `json:"output_language,omitempty"`
+	Images                  []models.ImageAttachment   `json:"images,omitempty"`
+	Callback                *models.CompletionCallback `json:"callback,omitempty"`
+	Federation              *schema.FederationOrigin   `json:"federation,omitempty"`
+	Target                  *schema.AlertTarget        `json:"target,omitempty"`
+	ForceFullInvestigation  bool                       `json:"force_full_investigation,omitempty"`
+}
+
+// ResolveAlertRequest is the HTTP request body for POST /api/v1/alerts/resolve.
+// CancelQueued defaults to true when omitted.
+type ResolveAlertRequest struct {
+	AlertKey     string `json:"alert_key" validate:"required"`
+	Reason       string `json:"reason,omitempty"`
+	CancelQueued *bool  `json:"cancel_queued,omitempty"`
+}
+
+// CancelSessionRequest is the optional HTTP request body for
+// POST /api/v1/sessions/:id/cancel.
+type CancelSessionRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// DuplicateSessionRequest is the optional HTTP request body for
+// POST /api/v1/sessions/:id/duplicate. Omitted overrides keep the original
+// session's chain, providers and MCP selection.
+type DuplicateSessionRequest struct {
+	ChainID     string                     `json:"chain_id,omitempty"`
+	LLMProvider string                     `json:"llm_provider,omitempty"`
+	MCP         *models.MCPSelectionConfig `json:"mcp,omitempty"`
+}
+
+// SandboxRunRequest is the HTTP request body for POST /api/v1/sandbox/runs.
+// MCPFixtures maps MCP server IDs to the canned tools served in their place;
+// LLMScript replaces the configured LLM providers when set.
+type SandboxRunRequest struct {
+	AlertType   string                       `json:"alert_type,omitempty"`
+	ChainID     string                       `json:"chain_id,omitempty"`
+	Runbook     string                       `json:"runbook,omitempty"`
+	Data        string                       `json:"data" validate:"required"`
+	MCPFixtures map[string][]mcp.ToolFixture `json:"mcp_fixtures,omitempty"`
+	LLMScript   []agent.ScriptedResponse     `json:"llm_script,omitempty"`
+}
+
+// UpdateLogLevelsRequest is the HTTP request body for PUT /api/v1/system/log-levels.
+// Keys are subsystems (queue, mcp, events, agent); values are debug, info,
+// warn, or error. An empty value resets the subsystem to the base level.
+type UpdateLogLevelsRequest struct {
+	Levels map[string]string `json:"levels" validate:"required"`
+}
+
+// PauseQueueRequest is the HTTP request body for PUT /api/v1/system/queue/pause.
+// An empty PodID pauses claiming on every pod. Maintenance additionally
+// raises a maintenance banner through the system warnings API.
+type PauseQueueRequest struct {
+	PodID       string `json:"pod_id,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	Maintenance bool   `json:"maintenance,omitempty"`
+}
+
+// DrainPodRequest is the HTTP request body for
+// POST /api/v1/system/drain/:pod_id. The body is optional.
+type DrainPodRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// UpdateFaultInjectionRequest is the HTTP request body for
+// PUT /api/v1/system/fault-injection. It replaces every rate on the serving
+// pod; omitted rates become 0 and omitted mcp_servers means all servers.
+type UpdateFaultInjectionRequest struct {
+	MCPTimeoutRate   float64  `json:"mcp_timeout_rate" validate:"min=0,max=1"`
+	MCPMalformedRate float64  `json:"mcp_malformed_rate" validate:"min=0,max=1"`
+	LLMRateLimitRate float64  `json:"llm_rate_limit_rate" validate:"min=0,max=1"`
+	MCPServers       []string `json:"mcp_servers,omitempty"`
+}
+
+// RegisterRequest is the HTTP request body for
+// PUT /api/v1/system/registrations/:kind/:name. Definition is the agent or
+// chain as it would appear under agents: or agent_chains: in tarsy.yaml,
+// either as a YAML string or as a JSON object with the same fields.
+type RegisterRequest struct {
+	Definition json.RawMessage `json:"definition"`
+}
+
+// UpdateFeatureFlagRequest is the HTTP request body for
+// PUT /api/v1/system/feature-flags/:name. It replaces the flag's rollout on
+// every replica until the override is deleted. Omitted targeting fields
+// mean "all".
+type UpdateFeatureFlagRequest struct {
+	Enabled    bool     `json:"enabled"`
+	Percentage *int     `json:"percentage,omitempty" validate:"min=0,max=100"`
+	AlertTypes []string `json:"alert_types,omitempty"`
+	Chains     []string `json:"chains,omitempty"`
+	Namespaces []string `json:"namespaces,omitempty"`
 }
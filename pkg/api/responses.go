@@ -1,16 +1,66 @@
 package api
 
+import (
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/queue"
+)
+
 // AlertResponse is returned by POST /api/v1/alerts.
 type AlertResponse struct {
 	SessionID string `json:"session_id"`
+	GroupID   string `json:"group_id,omitempty"` // set when the alert fanned out to sibling chains
 	Status    string `json:"status"`
 	Message   string `json:"message"`
+	// ConsistencyToken makes GETs that present it reflect this submission.
+	ConsistencyToken string `json:"consistency_token"`
+}
+
+// DuplicateSessionResponse is returned by POST /api/v1/sessions/:id/duplicate.
+type DuplicateSessionResponse struct {
+	SessionID      string `json:"session_id"`
+	DuplicatedFrom string `json:"duplicated_from"`
+	ChainID        string `json:"chain_id"`
+	Status         string `json:"status"`
+	// ConsistencyToken makes GETs that present it reflect the new session.
+	ConsistencyToken string `json:"consistency_token"`
+}
+
+// SandboxRunResponse is returned by POST /api/v1/sandbox/runs once the run
+// reaches a terminal state. LLM and MCP interaction details are read through
+// the session's trace endpoints.
+type SandboxRunResponse struct {
+	SessionID        string                    `json:"session_id"`
+	ChainID          string                    `json:"chain_id"`
+	Status           string                    `json:"status"`
+	FinalAnalysis    string                    `json:"final_analysis,omitempty"`
+	ExecutiveSummary string                    `json:"executive_summary,omitempty"`
+	TechnicalSummary string                    `json:"technical_summary,omitempty"`
+	Error            string                    `json:"error,omitempty"`
+	Trace            *models.TraceListResponse `json:"trace"`
+}
+
+// AlertDryRunResponse is returned by POST /api/v1/alerts/dry-run.
+type AlertDryRunResponse struct {
+	AlertType     string                   `json:"alert_type"`
+	ChainIDs      []string                 `json:"chain_ids"`                // primary chain first, then fan-out siblings
+	FallbackChain bool                     `json:"fallback_chain,omitempty"` // the alert type matched no chain; a fallback chain runs
+	Forecasts     []*models.BudgetForecast `json:"forecasts"`                // one per chain
+	Accepted      bool                     `json:"accepted"`                 // false when a chain budget would reject the submission
+}
+
+// ResolveAlertResponse is returned by POST /api/v1/alerts/resolve.
+type ResolveAlertResponse struct {
+	AlertKey            string   `json:"alert_key"`
+	ResolvedSessionIDs  []string `json:"resolved_session_ids"`  // queued or in-flight sessions the resolution was recorded on
+	CancelledSessionIDs []string `json:"cancelled_session_ids"` // subset that was still queued and got auto-cancelled
 }
 
 // CancelResponse is returned by POST /api/v1/sessions/:id/cancel.
 type CancelResponse struct {
 	SessionID string `json:"session_id"`
 	Message   string `json:"message"`
+	// ConsistencyToken makes GETs that present it wait for the cancellation to finish.
+	ConsistencyToken string `json:"consistency_token"`
 }
 
 // HealthResponse is returned by GET /health.
@@ -18,6 +68,7 @@ type HealthResponse struct {
 	Status  string                 `json:"status"`
 	Version string                 `json:"version"`
 	Checks  map[string]HealthCheck `json:"checks"`
+	Drain   *queue.DrainStatus     `json:"drain,omitempty"` // set while this pod is draining
 }
 
 // HealthCheck represents the status of a single health check component.
@@ -19,13 +19,22 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/callback"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/cost"
 	"github.com/codeready-toolchain/tarsy/pkg/database"
+	"github.com/codeready-toolchain/tarsy/pkg/diagnostics"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/eventstream"
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
+	"github.com/codeready-toolchain/tarsy/pkg/featureflag"
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
 	"github.com/codeready-toolchain/tarsy/pkg/mcp"
 	"github.com/codeready-toolchain/tarsy/pkg/memory"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
 	"github.com/codeready-toolchain/tarsy/pkg/queue"
+	"github.com/codeready-toolchain/tarsy/pkg/registration"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 	"github.com/codeready-toolchain/tarsy/pkg/runbook"
 	"github.com/codeready-toolchain/tarsy/pkg/services"
 )
@@ -40,22 +49,37 @@ type Server struct {
 	sessionService     *services.SessionService
 	workerPool         *queue.WorkerPool
 	connManager        *events.ConnectionManager
-	healthMonitor      *mcp.HealthMonitor              // nil if MCP disabled
-	warningService     *services.SystemWarningsService // nil if MCP disabled
-	chatService        *services.ChatService           // nil until set
-	chatExecutor       *queue.ChatMessageExecutor      // nil until set
-	eventPublisher     agent.EventPublisher            // nil if streaming disabled
-	interactionService *services.InteractionService    // nil until set (trace endpoints)
-	stageService       *services.StageService          // nil until set (trace endpoints)
-	timelineService    *services.TimelineService       // nil until set (timeline endpoint)
-	runbookService     *runbook.Service                // nil until set (runbook endpoint)
-	scoringExecutor    *queue.ScoringExecutor          // nil until set (scoring endpoint)
-	scoringService     *services.ScoringService        // nil until set (score read endpoint)
-	cancelNotifier     events.SessionCancelNotifier    // nil until set (cross-pod cancel)
-	memoryService      *memory.Service                 // nil until set (memory endpoints + review refinement)
-	costBook           *cost.Book                      // nil until set (cost estimation / Config Viewer)
-	dashboardDir       string                          // path to dashboard build dir (empty = no static serving)
-	wsOriginPatterns   []string                        // allowed WebSocket origin patterns
+	healthMonitor      *mcp.HealthMonitor               // nil if MCP disabled
+	warningService     *services.SystemWarningsService  // nil if MCP disabled
+	chatService        *services.ChatService            // nil until set
+	chatExecutor       *queue.ChatMessageExecutor       // nil until set
+	eventPublisher     agent.EventPublisher             // nil if streaming disabled
+	interactionService *services.InteractionService     // nil until set (trace endpoints)
+	stageService       *services.StageService           // nil until set (trace endpoints)
+	timelineService    *services.TimelineService        // nil until set (timeline endpoint)
+	runbookService     *runbook.Service                 // nil until set (runbook endpoint)
+	scoringExecutor    *queue.ScoringExecutor           // nil until set (scoring endpoint)
+	scoringService     *services.ScoringService         // nil until set (score read endpoint)
+	reportService      *services.ReportService          // nil until set (activity report endpoints)
+	jobLeaderService   *services.JobLeaderService       // nil until set (job leaders endpoint)
+	cancelNotifier     events.SessionCancelNotifier     // nil until set (cross-pod cancel)
+	memoryService      *memory.Service                  // nil until set (memory endpoints + review refinement)
+	costBook           *cost.Book                       // nil until set (cost estimation / Config Viewer)
+	userProfileService *services.UserProfileService     // nil until set (profile endpoints + author display names)
+	savedViewService   *services.SavedViewService       // nil until set (saved view endpoints)
+	actionItemService  *services.ActionItemService      // nil until set (action item endpoints)
+	redactionReviews   *services.RedactionReviewService // nil until set (redaction review endpoints)
+	logLevels          *logging.LevelTable              // process-wide log levels (log-levels endpoints)
+	featureFlags       *featureflag.Manager             // nil until set (feature flag endpoints)
+	registrations      *registration.Manager            // nil until set (registration endpoints)
+	sandbox            *queue.SandboxRunner             // nil until set (sandbox runs)
+	faults             *faultinject.Injector            // nil unless fault injection is enabled
+	heapCapture        *diagnostics.HeapCapture         // nil unless automatic heap capture is enabled
+	callbacks          *callback.Deliverer              // nil until set (completion callbacks of resolved alerts)
+	eventStream        *eventstream.Publisher           // nil unless the lifecycle event stream is enabled
+	artifactURLs       *artifactURLSigner               // signs trace artifact download URLs
+	dashboardDir       string                           // path to dashboard build dir (empty = no static serving)
+	wsOriginPatterns   []string                         // allowed WebSocket origin patterns
 }
 
 // NewServer creates a new API server with Echo v5.
@@ -68,6 +92,7 @@ func NewServer(
 	connManager *events.ConnectionManager,
 ) *Server {
 	e := echo.New()
+	e.HTTPErrorHandler = problemErrorHandler
 
 	s := &Server{
 		echo:           e,
@@ -77,6 +102,8 @@ func NewServer(
 		sessionService: sessionService,
 		workerPool:     workerPool,
 		connManager:    connManager,
+		logLevels:      logging.Levels(),
+		artifactURLs:   newArtifactURLSigner(cfg.BlobStore),
 	}
 
 	s.wsOriginPatterns = s.resolveWSOriginPatterns()
@@ -144,6 +171,16 @@ func (s *Server) SetScoringService(svc *services.ScoringService) {
 	s.scoringService = svc
 }
 
+// SetReportService sets the report service for activity report endpoints.
+func (s *Server) SetReportService(svc *services.ReportService) {
+	s.reportService = svc
+}
+
+// SetJobLeaderService sets the job leader service for the job leaders endpoint.
+func (s *Server) SetJobLeaderService(svc *services.JobLeaderService) {
+	s.jobLeaderService = svc
+}
+
 // SetMemoryService sets the memory service for memory CRUD endpoints and review-triggered refinement.
 func (s *Server) SetMemoryService(svc *memory.Service) {
 	s.memoryService = svc
@@ -154,6 +191,68 @@ func (s *Server) SetCostBook(book *cost.Book) {
 	s.costBook = book
 }
 
+// SetUserProfileService sets the user profile service for profile endpoints
+// and display-name author attribution.
+func (s *Server) SetUserProfileService(svc *services.UserProfileService) {
+	s.userProfileService = svc
+}
+
+// SetFeatureFlags sets the feature flag manager for the feature flag endpoints.
+func (s *Server) SetFeatureFlags(flags *featureflag.Manager) {
+	s.featureFlags = flags
+}
+
+// SetRegistrations sets the registration manager for the agent and chain
+// registration endpoints.
+func (s *Server) SetRegistrations(registrations *registration.Manager) {
+	s.registrations = registrations
+}
+
+// SetSandboxRunner sets the runner behind the sandbox run endpoint.
+func (s *Server) SetSandboxRunner(runner *queue.SandboxRunner) {
+	s.sandbox = runner
+}
+
+// SetFaultInjector sets the fault injector for the fault injection endpoints.
+// injector is nil when fault injection is not enabled.
+func (s *Server) SetFaultInjector(injector *faultinject.Injector) {
+	s.faults = injector
+}
+
+// SetHeapCapture sets the automatic heap capture whose profiles are listed
+// by the runtime stats and pprof endpoints.
+func (s *Server) SetHeapCapture(capture *diagnostics.HeapCapture) {
+	s.heapCapture = capture
+}
+
+// SetSavedViewService sets the saved view service for saved view endpoints.
+func (s *Server) SetSavedViewService(svc *services.SavedViewService) {
+	s.savedViewService = svc
+}
+
+// SetActionItemService sets the action item service for action item endpoints.
+func (s *Server) SetActionItemService(svc *services.ActionItemService) {
+	s.actionItemService = svc
+}
+
+// SetRedactionReviewService sets the redaction review service for the
+// redaction review queue endpoints.
+func (s *Server) SetRedactionReviewService(svc *services.RedactionReviewService) {
+	s.redactionReviews = svc
+}
+
+// SetCallbackDeliverer sets the completion callback deliverer, used for
+// sessions auto-cancelled by the alert resolution webhook.
+func (s *Server) SetCallbackDeliverer(deliverer *callback.Deliverer) {
+	s.callbacks = deliverer
+}
+
+// SetEventStream sets the lifecycle event publisher, used to publish
+// session.created for submitted alerts.
+func (s *Server) SetEventStream(stream *eventstream.Publisher) {
+	s.eventStream = stream
+}
+
 // SetDashboardDir sets the path to the dashboard build directory and
 // registers static file serving routes. When set and the directory
 // contains an index.html, assets are served from /assets/* and a SPA
@@ -231,20 +330,44 @@ func (s *Server) corsAllowOrigins() []string {
 	return allowed
 }
 
+// imageBodyLimit is the body size limit of routes that accept image
+// attachments: the 2 MB base plus the largest allowed images, base64-encoded.
+const imageBodyLimit = 2*1024*1024 + models.MaxImageAttachments*models.MaxImageSize*4/3
+
+// chatBodyLimit also fits a voice note, which chat messages may carry.
+const chatBodyLimit = imageBodyLimit + models.MaxVoiceNoteSize*4/3
+
+// acceptsAttachments reports whether the matched route accepts image or
+// voice note attachments (and so applies its own body limit).
+func acceptsAttachments(c *echo.Context) bool {
+	switch c.Path() {
+	case "/api/v1/alerts", "/api/v1/sessions/:id/chat/messages":
+		return c.Request().Method == http.MethodPost
+	}
+	return false
+}
+
 // setupRoutes registers all API routes.
 func (s *Server) setupRoutes() {
+	s.echo.Use(requestIDMiddleware())
+	s.echo.Use(actorMiddleware())
 	s.echo.Use(securityHeaders())
 
 	// Server-wide body size limit (2 MB) — set slightly above MaxAlertDataSize
 	// (1 MB) to account for JSON envelope overhead. Rejects multi-MB/GB payloads
 	// at the HTTP read level before deserialization, complementing the
 	// application-level MaxAlertDataSize check in submitAlertHandler.
-	s.echo.Use(middleware.BodyLimit(2 * 1024 * 1024))
+	// Routes accepting attachments apply imageBodyLimit or chatBodyLimit instead.
+	s.echo.Use(middleware.BodyLimitWithConfig(middleware.BodyLimitConfig{
+		LimitBytes: 2 * 1024 * 1024,
+		Skipper:    acceptsAttachments,
+	}))
 
 	s.echo.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     s.corsAllowOrigins(),
-		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodDelete, http.MethodOptions},
-		AllowHeaders:     []string{"Content-Type", "Accept", "Authorization"},
+		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions},
+		AllowHeaders:     []string{"Content-Type", "Accept", "Authorization", requestid.Header, consistencyTokenHeader},
+		ExposeHeaders:    []string{requestid.Header, consistencyStatusHeader},
 		AllowCredentials: true,
 		MaxAge:           3600,
 	}))
@@ -252,13 +375,18 @@ func (s *Server) setupRoutes() {
 	// Prometheus metrics middleware (records request count/duration for all API routes)
 	s.echo.Use(prometheusMiddleware())
 
+	// Read-after-write: GETs presenting a consistency token wait for its write
+	s.echo.Use(s.consistencyMiddleware())
+
 	// Health check and Prometheus metrics endpoint
 	s.echo.GET("/health", s.healthHandler)
 	s.echo.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
 
 	// API v1
 	v1 := s.echo.Group("/api/v1")
-	v1.POST("/alerts", s.submitAlertHandler)
+	v1.POST("/alerts", s.submitAlertHandler, middleware.BodyLimit(imageBodyLimit))
+	v1.POST("/alerts/dry-run", s.dryRunAlertHandler, middleware.BodyLimit(imageBodyLimit))
+	v1.POST("/alerts/resolve", s.resolveAlertHandler)
 
 	// Session list and filter endpoints (static paths before :id param).
 	v1.GET("/sessions", s.listSessionsHandler)
@@ -270,26 +398,84 @@ func (s *Server) setupRoutes() {
 	// Session detail and actions.
 	v1.GET("/sessions/:id", s.getSessionHandler)
 	v1.GET("/sessions/:id/summary", s.sessionSummaryHandler)
+	v1.GET("/sessions/:id/report", s.sessionReportHandler)
 	v1.GET("/sessions/:id/status", s.sessionStatusHandler)
+	v1.GET("/sessions/:id/watch", s.watchSessionHandler)
 	v1.POST("/sessions/:id/cancel", s.cancelSessionHandler)
-	v1.POST("/sessions/:id/chat/messages", s.sendChatMessageHandler)
+	v1.POST("/sessions/:id/duplicate", s.duplicateSessionHandler)
+	v1.POST("/sessions/:id/chat/messages", s.sendChatMessageHandler, middleware.BodyLimit(chatBodyLimit))
+	v1.POST("/sessions/:id/notes", s.addOperatorNoteHandler)
+	v1.GET("/sessions/:id/notes", s.listOperatorNotesHandler)
+	v1.GET("/sessions/:id/handoff-notes", s.getHandoffNotesHandler)
+	v1.PUT("/sessions/:id/handoff-notes", s.updateHandoffNotesHandler)
+	v1.GET("/sessions/:id/handoff-notes/revisions", s.listHandoffNoteRevisionsHandler)
+	v1.GET("/sessions/:id/action-items", s.listSessionActionItemsHandler)
+	v1.POST("/sessions/:id/action-items", s.createActionItemHandler)
+	v1.POST("/sessions/:id/disabled-mcp-servers", s.disableMCPServerHandler)
+	v1.GET("/sessions/:id/disabled-mcp-servers", s.listDisabledMCPServersHandler)
 	v1.POST("/sessions/:id/score", s.scoreSessionHandler)
 	v1.GET("/sessions/:id/score", s.getScoreHandler)
 	v1.GET("/sessions/:id/review-activity", s.getReviewActivityHandler)
+	v1.GET("/sessions/:id/queue", s.sessionQueueHandler)
 	v1.GET("/sessions/:id/timeline", s.getTimelineHandler)
+	v1.GET("/sessions/:id/timeline/events", s.getTimelinePageHandler)
+	v1.GET("/sessions/:id/timeline/summary", s.getTimelineSummaryHandler)
+	v1.GET("/sessions/:id/images/:image_id", s.getSessionImageHandler)
+	v1.GET("/sessions/:id/voice-notes/:voice_note_id", s.getVoiceNoteHandler)
+	v1.GET("/session-groups/:id", s.getSessionGroupHandler)
+
+	// Sandbox runs against fixture MCP tools (synchronous).
+	v1.POST("/sandbox/runs", s.sandboxRunHandler)
 
 	// Usage aggregation.
 	v1.GET("/usage/summary", s.usageSummaryHandler)
+	v1.GET("/usage/owners", s.usageOwnersHandler)
+
+	// Catalog of configured chains, agents, and MCP servers with usage.
+	v1.GET("/catalog/chains", s.catalogChainsHandler)
+	v1.GET("/catalog/agents", s.catalogAgentsHandler)
+	v1.GET("/catalog/mcp-servers", s.catalogMCPServersHandler)
+	v1.GET("/catalog/mcp-tools", s.catalogMCPToolsHandler)
+
+	// Periodic activity reports.
+	v1.GET("/reports", s.listReportsHandler)
+	v1.GET("/reports/:id", s.getReportHandler)
+	v1.GET("/reports/:id/html", s.getRenderedReportHandler)
 
 	// System endpoints.
 	v1.GET("/system/warnings", s.systemWarningsHandler)
 	v1.GET("/system/mcp-servers", s.mcpServersHandler)
 	v1.GET("/system/default-tools", s.defaultToolsHandler)
 	v1.GET("/system/config", s.systemConfigHandler)
+	v1.GET("/system/leaders", s.jobLeadersHandler)
+	v1.GET("/system/queue", s.queueHandler)
+	v1.GET("/system/queue/pause", s.queuePausesHandler)
+	v1.GET("/system/orphans", s.orphanRecoveriesHandler)
+	v1.GET("/system/log-levels", s.logLevelsHandler)
+	v1.GET("/system/feature-flags", s.featureFlagsHandler)
+	v1.GET("/system/fault-injection", s.faultInjectionHandler)
 	v1.GET("/system/config/skills/:name", s.systemConfigSkillHandler)
+	v1.GET("/system/runtime", s.runtimeStatsHandler)
 	v1.GET("/alert-types", s.alertTypesHandler)
+	v1.GET("/event-stream/schemas/:name", s.eventStreamSchemaHandler)
 	v1.GET("/runbooks", s.handleListRunbooks)
 
+	// Admin endpoints (system.admins allowlist, see requireAdmin).
+	admin := v1.Group("/system", s.requireAdmin)
+	admin.PUT("/log-levels", s.updateLogLevelsHandler)
+	admin.PUT("/feature-flags/:name", s.updateFeatureFlagHandler)
+	admin.DELETE("/feature-flags/:name", s.deleteFeatureFlagHandler)
+	admin.PUT("/queue/pause", s.pauseQueueHandler)
+	admin.DELETE("/queue/pause", s.resumeQueueHandler)
+	admin.PUT("/fault-injection", s.updateFaultInjectionHandler)
+	admin.GET("/registrations", s.registrationsHandler)
+	admin.GET("/registrations/:kind/:name", s.registrationHistoryHandler)
+	admin.PUT("/registrations/:kind/:name", s.registerHandler)
+	admin.DELETE("/registrations/:kind/:name", s.unregisterHandler)
+	admin.POST("/drain/:pod_id", s.drainPodHandler)
+	admin.GET("/drain/:pod_id", s.drainStatusHandler)
+	admin.DELETE("/drain/:pod_id", s.cancelDrainHandler)
+
 	// Memory endpoints.
 	v1.GET("/sessions/:id/memories", s.getSessionMemoriesHandler)
 	v1.GET("/sessions/:id/injected-memories", s.getInjectedMemoriesHandler)
@@ -298,10 +484,38 @@ func (s *Server) setupRoutes() {
 	v1.PATCH("/memories/:id", s.updateMemoryHandler)
 	v1.DELETE("/memories/:id", s.deleteMemoryHandler)
 
+	// Current user's profile and dashboard preferences.
+	v1.GET("/profile", s.getProfileHandler)
+	v1.PATCH("/profile", s.updateProfileHandler)
+	v1.GET("/saved-views", s.listSavedViewsHandler)
+	v1.POST("/saved-views", s.createSavedViewHandler)
+	v1.PATCH("/saved-views/:id", s.updateSavedViewHandler)
+	v1.DELETE("/saved-views/:id", s.deleteSavedViewHandler)
+
+	// Follow-up action items across sessions.
+	v1.GET("/action-items", s.listActionItemsHandler)
+	v1.PATCH("/action-items/:id", s.updateActionItemHandler)
+	v1.DELETE("/action-items/:id", s.deleteActionItemHandler)
+
+	// Redaction review queue (suspected secrets that masking missed).
+	v1.GET("/redaction-reviews", s.listRedactionReviewsHandler)
+	v1.GET("/redaction-reviews/:id", s.getRedactionReviewHandler)
+	v1.POST("/redaction-reviews/:id/confirm", s.confirmRedactionReviewHandler)
+	v1.POST("/redaction-reviews/:id/dismiss", s.dismissRedactionReviewHandler)
+
 	// Trace/observability endpoints (two-level loading).
 	v1.GET("/sessions/:id/trace", s.getTraceListHandler)
 	v1.GET("/sessions/:id/trace/llm/:interaction_id", s.getLLMInteractionHandler)
 	v1.GET("/sessions/:id/trace/mcp/:interaction_id", s.getMCPInteractionHandler)
+	v1.GET("/sessions/:id/trace/mcp/:interaction_id/result", s.getMCPToolResultHandler)
+	v1.GET("/sessions/:id/trace/executions/:execution_id/context", s.getExecutionContextHandler)
+
+	// Profiling endpoints (admin allowlist, see requireAdmin and auditProfiling).
+	debug := v1.Group("/debug/pprof", s.requireAdmin, auditProfiling)
+	debug.GET("", s.profilesHandler)
+	debug.GET("/:name", s.pprofHandler)
+	debug.POST("/symbol", s.pprofHandler)
+	debug.GET("/captured/:name", s.capturedProfileHandler)
 
 	// WebSocket endpoint for real-time event streaming.
 	// Moved under /api/v1 so all sensitive endpoints share a single
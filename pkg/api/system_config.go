@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/codeready-toolchain/tarsy/pkg/agent/prompt"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/cost"
 )
@@ -42,6 +43,7 @@ type SanitizedTransport struct {
 	VerifySSL      *bool    `json:"verify_ssl,omitempty"`
 	Timeout        int      `json:"timeout,omitempty"`
 	EnvKeys        []string `json:"env_keys,omitempty"`
+	HeaderKeys     []string `json:"header_keys,omitempty"`
 	BearerTokenSet bool     `json:"bearer_token_set"`
 }
 
@@ -57,6 +59,7 @@ type MCPServerView struct {
 type AgentView struct {
 	Type               string            `json:"type,omitempty"`
 	Description        string            `json:"description,omitempty"`
+	Owner              string            `json:"owner,omitempty"`
 	MCPServers         []string          `json:"mcp_servers,omitempty"`
 	CustomInstructions string            `json:"custom_instructions"`
 	LLMBackend         string            `json:"llm_backend,omitempty"`
@@ -65,6 +68,8 @@ type AgentView struct {
 	Orchestrator       *OrchestratorView `json:"orchestrator"`
 	Skills             *[]string         `json:"skills"`
 	RequiredSkills     []string          `json:"required_skills,omitempty"`
+	Extends            string            `json:"extends,omitempty"`
+	Mixins             []string          `json:"mixins,omitempty"`
 }
 
 // OrchestratorView emits duration fields as strings.
@@ -72,22 +77,53 @@ type OrchestratorView struct {
 	MaxConcurrentAgents *int    `json:"max_concurrent_agents,omitempty"`
 	AgentTimeout        *string `json:"agent_timeout,omitempty"`
 	MaxBudget           *string `json:"max_budget,omitempty"`
+	MaxTokens           *int    `json:"max_tokens,omitempty"`
 }
 
 // ChainView is the chain config view.
 type ChainView struct {
 	AlertTypes               []string               `json:"alert_types"`
+	Extends                  string                 `json:"extends,omitempty"`
 	Description              string                 `json:"description,omitempty"`
+	Owner                    string                 `json:"owner,omitempty"`
 	Stages                   []StageView            `json:"stages"`
 	Chat                     *ChatView              `json:"chat,omitempty"`
 	Scoring                  *ScoringView           `json:"scoring,omitempty"`
+	Slack                    *SlackTemplateView     `json:"slack,omitempty"`
+	Email                    *ChainEmailView        `json:"email,omitempty"`
 	LLMProvider              string                 `json:"llm_provider,omitempty"`
 	ExecutiveSummaryProvider string                 `json:"executive_summary_provider,omitempty"`
+	ExecutiveSummary         *ExecutiveSummaryView  `json:"executive_summary,omitempty"`
+	TechnicalSummary         *TechnicalSummaryView  `json:"technical_summary,omitempty"`
 	LLMBackend               string                 `json:"llm_backend,omitempty"`
 	FallbackProviders        []FallbackProviderView `json:"fallback_providers,omitempty"`
 	MaxIterations            *int                   `json:"max_iterations,omitempty"`
 	MCPServers               []string               `json:"mcp_servers,omitempty"`
 	SubAgents                []SubAgentView         `json:"sub_agents,omitempty"`
+	FanOut                   *FanOutView            `json:"fan_out,omitempty"`
+}
+
+// ExecutiveSummaryView is a chain's executive summary customization.
+// The prompt template itself is not exposed, only whether one is set.
+type ExecutiveSummaryView struct {
+	Enabled         bool   `json:"enabled"`
+	Audience        string `json:"audience,omitempty"`
+	Style           string `json:"style,omitempty"`
+	MaxLines        int    `json:"max_lines"`
+	CustomPrompt    bool   `json:"custom_prompt,omitempty"`
+	TemplateVersion string `json:"template_version"`
+}
+
+// TechnicalSummaryView is a chain's technical summary step.
+type TechnicalSummaryView struct {
+	Enabled     bool   `json:"enabled"`
+	LLMProvider string `json:"llm_provider,omitempty"`
+}
+
+// FanOutView is a chain's multi-chain fan-out.
+type FanOutView struct {
+	Chains      []string `json:"chains"`
+	LLMProvider string   `json:"llm_provider,omitempty"`
 }
 
 // StageView is a chain stage.
@@ -101,6 +137,13 @@ type StageView struct {
 	FallbackProviders []FallbackProviderView `json:"fallback_providers,omitempty"`
 	SubAgents         []SubAgentView         `json:"sub_agents,omitempty"`
 	Synthesis         *SynthesisView         `json:"synthesis,omitempty"`
+	Remote            *RemoteStageView       `json:"remote,omitempty"`
+}
+
+// RemoteStageView is a stage delegated to another TARSy instance.
+type RemoteStageView struct {
+	Instance  string `json:"instance"`
+	AlertType string `json:"alert_type,omitempty"`
 }
 
 // StageAgentView is a stage agent reference with overrides.
@@ -164,15 +207,33 @@ type ScoringView struct {
 
 // LLMProviderView is an LLM provider config entry.
 type LLMProviderView struct {
-	Type                string          `json:"type"`
-	Model               string          `json:"model"`
-	APIKeyEnv           string          `json:"api_key_env,omitempty"`
-	CredentialsEnv      string          `json:"credentials_env,omitempty"`
-	ProjectEnv          string          `json:"project_env,omitempty"`
-	LocationEnv         string          `json:"location_env,omitempty"`
-	BaseURL             string          `json:"base_url,omitempty"`
-	MaxToolResultTokens int             `json:"max_tool_result_tokens"`
-	NativeTools         map[string]bool `json:"native_tools,omitempty"`
+	Type                string           `json:"type"`
+	Model               string           `json:"model"`
+	APIKeyEnv           string           `json:"api_key_env,omitempty"`
+	CredentialsEnv      string           `json:"credentials_env,omitempty"`
+	ProjectEnv          string           `json:"project_env,omitempty"`
+	LocationEnv         string           `json:"location_env,omitempty"`
+	BaseURL             string           `json:"base_url,omitempty"`
+	MaxToolResultTokens int              `json:"max_tool_result_tokens"`
+	NativeTools         map[string]bool  `json:"native_tools,omitempty"`
+	Capabilities        CapabilitiesView `json:"capabilities"`
+	Tokenizer           *TokenizerView   `json:"tokenizer,omitempty"`
+}
+
+// TokenizerView is a provider's tokenizer config (omitted for the heuristic default).
+type TokenizerView struct {
+	Type string `json:"type"`
+	File string `json:"file,omitempty"`
+}
+
+// CapabilitiesView is a provider's effective capability matrix: type
+// defaults with the configured overrides applied.
+type CapabilitiesView struct {
+	FunctionCalling  bool `json:"function_calling"`
+	Streaming        bool `json:"streaming"`
+	StructuredOutput bool `json:"structured_output"`
+	Vision           bool `json:"vision"`
+	ContextWindow    int  `json:"context_window,omitempty"`
 }
 
 // SkillMetaView is skill metadata (no body).
@@ -225,20 +286,28 @@ type QueueView struct {
 	MaxConcurrentSessions   int    `json:"max_concurrent_sessions"`
 	PollInterval            string `json:"poll_interval"`
 	PollIntervalJitter      string `json:"poll_interval_jitter"`
+	MaxPollInterval         string `json:"max_poll_interval"`
+	ClaimBatchSize          int    `json:"claim_batch_size"`
 	SessionTimeout          string `json:"session_timeout"`
 	GracefulShutdownTimeout string `json:"graceful_shutdown_timeout"`
 	ScoringShutdownTimeout  string `json:"scoring_shutdown_timeout"`
 	OrphanDetectionInterval string `json:"orphan_detection_interval"`
 	OrphanThreshold         string `json:"orphan_threshold"`
 	HeartbeatInterval       string `json:"heartbeat_interval"`
+	OrphanRecovery          string `json:"orphan_recovery"`
+	OrphanMaxRequeues       int    `json:"orphan_max_requeues"`
 }
 
 // SystemView is GitHub/Slack/runbooks/retention/dashboard settings.
 type SystemView struct {
 	GitHub           *GitHubView         `json:"github,omitempty"`
 	Slack            *SlackView          `json:"slack,omitempty"`
+	Email            *EmailView          `json:"email,omitempty"`
+	Reports          *ReportsView        `json:"reports,omitempty"`
 	Runbooks         *RunbooksView       `json:"runbooks,omitempty"`
 	Retention        *RetentionView      `json:"retention,omitempty"`
+	Degradation      *DegradationView    `json:"degradation,omitempty"`
+	ModelRouting     *ModelRoutingView   `json:"model_routing,omitempty"`
 	CostEstimation   *CostEstimationView `json:"cost_estimation,omitempty"`
 	DashboardURL     string              `json:"dashboard_url,omitempty"`
 	AllowedWSOrigins []string            `json:"allowed_ws_origins"`
@@ -272,9 +341,61 @@ type GitHubView struct {
 
 // SlackView shows token env name only.
 type SlackView struct {
-	Enabled  bool   `json:"enabled"`
-	TokenEnv string `json:"token_env,omitempty"`
-	Channel  string `json:"channel,omitempty"`
+	Enabled             bool   `json:"enabled"`
+	TokenEnv            string `json:"token_env,omitempty"`
+	Channel             string `json:"channel,omitempty"`
+	ThreadStatusUpdates bool   `json:"thread_status_updates"`
+	RateLimitPerMinute  int    `json:"rate_limit_per_minute"`
+	DedupWindow         string `json:"dedup_window"`
+}
+
+// EmailView shows SMTP settings; the password is referenced by env var name only.
+type EmailView struct {
+	Enabled     bool   `json:"enabled"`
+	SMTPHost    string `json:"smtp_host,omitempty"`
+	SMTPPort    int    `json:"smtp_port"`
+	Username    string `json:"username,omitempty"`
+	PasswordEnv string `json:"password_env,omitempty"`
+	From        string `json:"from,omitempty"`
+	StartTLS    bool   `json:"starttls"`
+	DigestHour  int    `json:"digest_hour"`
+}
+
+// ReportsView is periodic activity report config.
+type ReportsView struct {
+	Enabled         bool     `json:"enabled"`
+	Periods         []string `json:"periods"`
+	Hour            int      `json:"hour"`
+	Weekday         string   `json:"weekday"`
+	Narrative       bool     `json:"narrative"`
+	LLMProvider     string   `json:"llm_provider,omitempty"`
+	SlackChannel    string   `json:"slack_channel,omitempty"`
+	EmailRecipients []string `json:"email_recipients,omitempty"`
+	WebhookURL      string   `json:"webhook_url,omitempty"`
+}
+
+// ChainEmailView is a chain's email recipients and schedule.
+type ChainEmailView struct {
+	Recipients []string `json:"recipients"`
+	Schedule   string   `json:"schedule"`
+}
+
+// SlackTemplateView is a chain's Slack message template.
+type SlackTemplateView struct {
+	Fields  []SlackTemplateFieldView  `json:"fields,omitempty"`
+	Buttons []SlackTemplateButtonView `json:"buttons,omitempty"`
+}
+
+// SlackTemplateFieldView is a labelled template field.
+type SlackTemplateFieldView struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// SlackTemplateButtonView is a template link button.
+type SlackTemplateButtonView struct {
+	Text string `json:"text"`
+	URL  string `json:"url"`
 }
 
 // RunbooksView is runbook system config.
@@ -291,6 +412,25 @@ type RetentionView struct {
 	CleanupInterval      string `json:"cleanup_interval"`
 }
 
+// DegradationView is the triage-only degradation profile config.
+type DegradationView struct {
+	Enabled          bool   `json:"enabled"`
+	FailureThreshold int    `json:"failure_threshold"`
+	Cooldown         string `json:"cooldown"`
+	MaxIterations    int    `json:"max_iterations"`
+	LLMProvider      string `json:"llm_provider,omitempty"`
+}
+
+// ModelRoutingView is the complexity-based model routing config.
+type ModelRoutingView struct {
+	Enabled             bool   `json:"enabled"`
+	ClassifierProvider  string `json:"classifier_provider,omitempty"`
+	FastProvider        string `json:"fast_provider,omitempty"`
+	StrongProvider      string `json:"strong_provider,omitempty"`
+	ComplexityThreshold int    `json:"complexity_threshold"`
+	Timeout             string `json:"timeout"`
+}
+
 // --- Builder ---
 
 func buildSystemConfigResponse(cfg *config.Config, costBook *cost.Book) SystemConfigResponse {
@@ -406,12 +546,16 @@ func buildQueueView(q *config.QueueConfig) *QueueView {
 		MaxConcurrentSessions:   q.MaxConcurrentSessions,
 		PollInterval:            durationString(q.PollInterval),
 		PollIntervalJitter:      durationString(q.PollIntervalJitter),
+		MaxPollInterval:         durationString(q.MaxPollInterval),
+		ClaimBatchSize:          q.ClaimBatchSize,
 		SessionTimeout:          durationString(q.SessionTimeout),
 		GracefulShutdownTimeout: durationString(q.GracefulShutdownTimeout),
 		ScoringShutdownTimeout:  durationString(q.ScoringShutdownTimeout),
 		OrphanDetectionInterval: durationString(q.OrphanDetectionInterval),
 		OrphanThreshold:         durationString(q.OrphanThreshold),
 		HeartbeatInterval:       durationString(q.HeartbeatInterval),
+		OrphanRecovery:          string(q.OrphanRecovery),
+		OrphanMaxRequeues:       q.OrphanMaxRequeues,
 	}
 }
 
@@ -428,9 +572,41 @@ func buildSystemView(cfg *config.Config, costBook *cost.Book) SystemView {
 	}
 	if cfg.Slack != nil {
 		view.Slack = &SlackView{
-			Enabled:  cfg.Slack.Enabled,
-			TokenEnv: cfg.Slack.TokenEnv,
-			Channel:  cfg.Slack.Channel,
+			Enabled:             cfg.Slack.Enabled,
+			TokenEnv:            cfg.Slack.TokenEnv,
+			Channel:             cfg.Slack.Channel,
+			ThreadStatusUpdates: cfg.Slack.ThreadStatusUpdates,
+			RateLimitPerMinute:  cfg.Slack.RateLimitPerMinute,
+			DedupWindow:         durationString(cfg.Slack.DedupWindow),
+		}
+	}
+	if cfg.Email != nil {
+		view.Email = &EmailView{
+			Enabled:     cfg.Email.Enabled,
+			SMTPHost:    cfg.Email.SMTPHost,
+			SMTPPort:    cfg.Email.SMTPPort,
+			Username:    cfg.Email.Username,
+			PasswordEnv: cfg.Email.PasswordEnv,
+			From:        cfg.Email.From,
+			StartTLS:    cfg.Email.StartTLS,
+			DigestHour:  cfg.Email.DigestHour,
+		}
+	}
+	if cfg.Reports != nil {
+		periods := make([]string, 0, len(cfg.Reports.Periods))
+		for _, p := range cfg.Reports.Periods {
+			periods = append(periods, string(p))
+		}
+		view.Reports = &ReportsView{
+			Enabled:         cfg.Reports.Enabled,
+			Periods:         periods,
+			Hour:            cfg.Reports.Hour,
+			Weekday:         strings.ToLower(cfg.Reports.Weekday.String()),
+			Narrative:       cfg.Reports.Narrative,
+			LLMProvider:     cfg.Reports.LLMProvider,
+			SlackChannel:    cfg.Reports.SlackChannel,
+			EmailRecipients: cfg.Reports.EmailRecipients,
+			WebhookURL:      sanitizeURL(cfg.Reports.WebhookURL),
 		}
 	}
 	if cfg.Runbooks != nil {
@@ -447,6 +623,25 @@ func buildSystemView(cfg *config.Config, costBook *cost.Book) SystemView {
 			CleanupInterval:      durationString(cfg.Retention.CleanupInterval),
 		}
 	}
+	if cfg.Degradation != nil {
+		view.Degradation = &DegradationView{
+			Enabled:          cfg.Degradation.Enabled,
+			FailureThreshold: cfg.Degradation.FailureThreshold,
+			Cooldown:         durationString(cfg.Degradation.Cooldown),
+			MaxIterations:    cfg.Degradation.MaxIterations,
+			LLMProvider:      cfg.Degradation.LLMProvider,
+		}
+	}
+	if r := cfg.ModelRouting; r != nil {
+		view.ModelRouting = &ModelRoutingView{
+			Enabled:             r.Enabled,
+			ClassifierProvider:  r.ClassifierProvider,
+			FastProvider:        r.FastProvider,
+			StrongProvider:      r.StrongProvider,
+			ComplexityThreshold: r.ComplexityThreshold,
+			Timeout:             durationString(r.Timeout),
+		}
+	}
 	view.CostEstimation = buildCostEstimationView(cfg.CostEstimation, costBook)
 	return view
 }
@@ -505,6 +700,7 @@ func buildAgentView(a *config.AgentConfig) AgentView {
 	return AgentView{
 		Type:               string(a.Type),
 		Description:        a.Description,
+		Owner:              a.Owner,
 		MCPServers:         a.MCPServers,
 		CustomInstructions: a.CustomInstructions,
 		LLMBackend:         string(a.LLMBackend),
@@ -513,6 +709,8 @@ func buildAgentView(a *config.AgentConfig) AgentView {
 		Orchestrator:       buildOrchestratorView(a.Orchestrator),
 		Skills:             a.Skills,
 		RequiredSkills:     a.RequiredSkills,
+		Extends:            a.Extends,
+		Mixins:             a.Mixins,
 	}
 }
 
@@ -522,6 +720,7 @@ func buildOrchestratorView(o *config.OrchestratorConfig) *OrchestratorView {
 	}
 	view := &OrchestratorView{
 		MaxConcurrentAgents: o.MaxConcurrentAgents,
+		MaxTokens:           o.MaxTokens,
 	}
 	if o.AgentTimeout != nil {
 		s := durationString(*o.AgentTimeout)
@@ -544,18 +743,53 @@ func buildChainView(c *config.ChainConfig) ChainView {
 	}
 	return ChainView{
 		AlertTypes:               c.AlertTypes,
+		Extends:                  c.Extends,
 		Description:              c.Description,
+		Owner:                    c.Owner,
 		Stages:                   stages,
 		Chat:                     buildChatView(c.Chat),
 		Scoring:                  buildScoringView(c.Scoring),
+		Slack:                    buildSlackTemplateView(c.Slack),
+		Email:                    buildChainEmailView(c.Email),
 		LLMProvider:              c.LLMProvider,
 		ExecutiveSummaryProvider: c.ExecutiveSummaryProvider,
+		ExecutiveSummary:         buildExecutiveSummaryView(c.ExecutiveSummary),
+		TechnicalSummary:         buildTechnicalSummaryView(c.TechnicalSummary),
 		LLMBackend:               string(c.LLMBackend),
 		FallbackProviders:        buildFallbackProviders(c.FallbackProviders),
 		MaxIterations:            c.MaxIterations,
 		MCPServers:               c.MCPServers,
 		SubAgents:                buildSubAgentViews(c.SubAgents),
+		FanOut:                   buildFanOutView(c.FanOut),
+	}
+}
+
+func buildExecutiveSummaryView(es *config.ExecutiveSummaryConfig) *ExecutiveSummaryView {
+	if es == nil {
+		return nil
+	}
+	return &ExecutiveSummaryView{
+		Enabled:         es.IsEnabled(),
+		Audience:        string(es.Audience),
+		Style:           string(es.Style),
+		MaxLines:        es.EffectiveMaxLines(),
+		CustomPrompt:    es.PromptTemplate != "",
+		TemplateVersion: prompt.ExecutiveSummaryTemplateVersion(es),
+	}
+}
+
+func buildTechnicalSummaryView(ts *config.TechnicalSummaryConfig) *TechnicalSummaryView {
+	if ts == nil {
+		return nil
 	}
+	return &TechnicalSummaryView{Enabled: ts.Enabled, LLMProvider: ts.LLMProvider}
+}
+
+func buildFanOutView(f *config.FanOutConfig) *FanOutView {
+	if f == nil {
+		return nil
+	}
+	return &FanOutView{Chains: f.Chains, LLMProvider: f.LLMProvider}
 }
 
 func buildStageView(st config.StageConfig) StageView {
@@ -582,6 +816,10 @@ func buildStageView(st config.StageConfig) StageView {
 			LLMProvider: st.Synthesis.LLMProvider,
 		}
 	}
+	var remote *RemoteStageView
+	if st.Remote != nil {
+		remote = &RemoteStageView{Instance: st.Remote.Instance, AlertType: st.Remote.AlertType}
+	}
 	return StageView{
 		Name:              st.Name,
 		Agents:            agents,
@@ -592,6 +830,7 @@ func buildStageView(st config.StageConfig) StageView {
 		FallbackProviders: buildFallbackProviders(st.FallbackProviders),
 		SubAgents:         buildSubAgentViews(st.SubAgents),
 		Synthesis:         synthesis,
+		Remote:            remote,
 	}
 }
 
@@ -624,6 +863,30 @@ func buildScoringView(s *config.ScoringConfig) *ScoringView {
 	}
 }
 
+func buildChainEmailView(e *config.ChainEmailConfig) *ChainEmailView {
+	if e == nil {
+		return nil
+	}
+	return &ChainEmailView{
+		Recipients: e.Recipients,
+		Schedule:   string(e.EffectiveSchedule()),
+	}
+}
+
+func buildSlackTemplateView(t *config.SlackTemplateConfig) *SlackTemplateView {
+	if t == nil {
+		return nil
+	}
+	view := &SlackTemplateView{}
+	for _, f := range t.Fields {
+		view.Fields = append(view.Fields, SlackTemplateFieldView{Label: f.Label, Value: f.Value})
+	}
+	for _, b := range t.Buttons {
+		view.Buttons = append(view.Buttons, SlackTemplateButtonView{Text: b.Text, URL: b.URL})
+	}
+	return view
+}
+
 func buildSubAgentViews(refs config.SubAgentRefs) []SubAgentView {
 	if refs == nil {
 		return nil
@@ -683,6 +946,25 @@ func buildLLMProviderView(p *config.LLMProviderConfig) LLMProviderView {
 		BaseURL:             sanitizeURL(p.BaseURL),
 		MaxToolResultTokens: p.MaxToolResultTokens,
 		NativeTools:         nativeToolsToMap(p.NativeTools),
+		Capabilities:        buildCapabilitiesView(p.EffectiveCapabilities()),
+		Tokenizer:           buildTokenizerView(p.Tokenizer),
+	}
+}
+
+func buildTokenizerView(t *config.TokenizerConfig) *TokenizerView {
+	if t == nil {
+		return nil
+	}
+	return &TokenizerView{Type: string(t.Type), File: t.File}
+}
+
+func buildCapabilitiesView(c config.ModelCapabilities) CapabilitiesView {
+	return CapabilitiesView{
+		FunctionCalling:  c.FunctionCalling,
+		Streaming:        c.Streaming,
+		StructuredOutput: c.StructuredOutput,
+		Vision:           c.Vision,
+		ContextWindow:    c.ContextWindow,
 	}
 }
 
@@ -715,6 +997,9 @@ func sanitizeTransport(t config.TransportConfig) SanitizedTransport {
 		slices.Sort(keys)
 		out.EnvKeys = keys
 	}
+	if len(t.Headers) > 0 {
+		out.HeaderKeys = slices.Sorted(maps.Keys(t.Headers))
+	}
 
 	return out
 }
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	echo "github.com/labstack/echo/v5"
+)
+
+// FieldError describes one invalid field of a request. Field is the JSON
+// path of the field (e.g. "mcp.servers[0].name"); Message says what is
+// wrong with it (e.g. "required", "must be at most 50 entries").
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationErrors is the set of field errors of a rejected request. Handlers
+// return it wrapped in a 400 *echo.HTTPError (see invalidFields), and the
+// error handler renders it as the "errors" member of the problem response.
+type ValidationErrors []FieldError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, len(v))
+	for i, fe := range v {
+		msgs[i] = fe.Field + ": " + fe.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// invalidFields returns a 400 error carrying field-level details.
+func invalidFields(errs ...FieldError) *echo.HTTPError {
+	v := ValidationErrors(errs)
+	return echo.NewHTTPError(http.StatusBadRequest, v.Error()).Wrap(v).(*echo.HTTPError)
+}
+
+// invalidField returns a 400 error for a single invalid field.
+func invalidField(field, format string, args ...any) *echo.HTTPError {
+	return invalidFields(FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// bindAndValidate binds the request body into req and checks its `validate`
+// struct tags. Malformed bodies and tag violations both come back as 400
+// errors with field-level details where the field is known.
+func bindAndValidate(c *echo.Context, req any) error {
+	if err := c.Bind(req); err != nil {
+		return bindError(err)
+	}
+	return validateRequest(req)
+}
+
+// bindError translates a binding failure into a 400 error, naming the
+// offending field when the decoder reports it.
+func bindError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return invalidField(typeErr.Field, "must be %s", jsonTypeName(typeErr.Type))
+	}
+	var bindErr *echo.BindingError
+	if errors.As(err, &bindErr) {
+		return invalidField(bindErr.Field, "invalid value")
+	}
+	var he *echo.HTTPError
+	if errors.As(err, &he) && he.Code != http.StatusBadRequest {
+		return he // e.g. 415 for an unsupported content type
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, "malformed request body")
+}
+
+// jsonTypeName names a Go type the way a JSON client sees it.
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
+
+// validateRequest checks the `validate` struct tags of req (a struct or a
+// pointer to one) and returns every violation at once. Supported rules,
+// comma-separated:
+//
+//	required   non-empty string (ignoring whitespace), non-nil pointer,
+//	           non-empty slice or map, non-zero number
+//	min=N      at least N characters / entries, or a value of at least N
+//	max=N      at most N characters / entries, or a value of at most N
+//	oneof=a b  one of the space-separated values
+//
+// Rules other than required skip empty values. Nested structs, pointers
+// to structs, and slices of them are validated recursively.
+func validateRequest(req any) error {
+	var errs ValidationErrors
+	validateValue(reflect.ValueOf(req), "", &errs)
+	if len(errs) > 0 {
+		return invalidFields(errs...)
+	}
+	return nil
+}
+
+func validateValue(v reflect.Value, path string, errs *ValidationErrors) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			name := jsonFieldName(sf)
+			if name == "" {
+				continue
+			}
+			fieldPath := name
+			if path != "" {
+				fieldPath = path + "." + name
+			}
+			fv := v.Field(i)
+			if tag := sf.Tag.Get("validate"); tag != "" {
+				if fe, ok := checkRules(fv, tag); !ok {
+					*errs = append(*errs, FieldError{Field: fieldPath, Message: fe})
+					continue
+				}
+			}
+			validateValue(fv, fieldPath, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		if elem := v.Type().Elem(); indirectKind(elem) != reflect.Struct {
+			return
+		}
+		for i := range v.Len() {
+			validateValue(v.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	}
+}
+
+// jsonFieldName returns the JSON name of a struct field, or "" when the
+// field is not serialized.
+func jsonFieldName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return sf.Name
+	}
+	return name
+}
+
+func indirectKind(t reflect.Type) reflect.Kind {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind()
+}
+
+// checkRules applies the rules of one tag to v. Returns the violation
+// message and false for the first rule v breaks.
+func checkRules(v reflect.Value, tag string) (string, bool) {
+	empty := isEmpty(v)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	for _, rule := range strings.Split(tag, ",") {
+		name, arg, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			if empty {
+				return "required", false
+			}
+		case "min", "max":
+			if empty {
+				continue
+			}
+			limit, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				panic(fmt.Sprintf("validate: bad %s argument %q", name, arg))
+			}
+			if msg, ok := checkBound(v, name, limit, arg); !ok {
+				return msg, false
+			}
+		case "oneof":
+			if empty {
+				continue
+			}
+			allowed := strings.Fields(arg)
+			if v.Kind() != reflect.String || !slices.Contains(allowed, v.String()) {
+				return "must be one of " + strings.Join(allowed, ", "), false
+			}
+		default:
+			panic(fmt.Sprintf("validate: unknown rule %q", rule))
+		}
+	}
+	return "", true
+}
+
+// checkBound checks a min or max rule against the size or value of v.
+func checkBound(v reflect.Value, rule string, limit float64, arg string) (string, bool) {
+	var size float64
+	var unit string
+	switch v.Kind() {
+	case reflect.String:
+		size, unit = float64(utf8.RuneCountInString(v.String())), " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		size, unit = float64(v.Len()), " entries"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		size = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		size = v.Float()
+	default:
+		panic(fmt.Sprintf("validate: %s on unsupported kind %s", rule, v.Kind()))
+	}
+	if rule == "min" && size < limit {
+		return "must be at least " + arg + unit, false
+	}
+	if rule == "max" && size > limit {
+		return "must be at most " + arg + unit, false
+	}
+	return "", true
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.String:
+		return strings.TrimSpace(v.String()) == ""
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validateTestServer struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type validateTestRequest struct {
+	Title   string               `json:"title" validate:"required,max=5"`
+	Mode    string               `json:"mode,omitempty" validate:"oneof=fast slow"`
+	Rate    float64              `json:"rate" validate:"min=0,max=1"`
+	Tags    []string             `json:"tags,omitempty" validate:"max=2"`
+	Servers []validateTestServer `json:"servers,omitempty"`
+	Nested  *validateTestServer  `json:"nested,omitempty"`
+	Skipped string               `json:"-" validate:"required"`
+}
+
+func fieldErrors(t *testing.T, err error) ValidationErrors {
+	t.Helper()
+	var he *echo.HTTPError
+	require.True(t, errors.As(err, &he))
+	assert.Equal(t, http.StatusBadRequest, he.Code)
+	var fields ValidationErrors
+	require.True(t, errors.As(err, &fields))
+	return fields
+}
+
+func TestValidateRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		req  validateTestRequest
+		want ValidationErrors
+	}{
+		{
+			name: "valid",
+			req:  validateTestRequest{Title: "ok", Mode: "fast", Rate: 0.5, Tags: []string{"a"}},
+		},
+		{
+			name: "empty optional fields skip rules",
+			req:  validateTestRequest{Title: "ok"},
+		},
+		{
+			name: "blank required string",
+			req:  validateTestRequest{Title: "   "},
+			want: ValidationErrors{{Field: "title", Message: "required"}},
+		},
+		{
+			name: "every violation is reported",
+			req:  validateTestRequest{Title: "too long", Mode: "medium", Rate: 1.5, Tags: []string{"a", "b", "c"}},
+			want: ValidationErrors{
+				{Field: "title", Message: "must be at most 5 characters"},
+				{Field: "mode", Message: "must be one of fast, slow"},
+				{Field: "rate", Message: "must be at most 1"},
+				{Field: "tags", Message: "must be at most 2 entries"},
+			},
+		},
+		{
+			name: "nested paths",
+			req: validateTestRequest{
+				Title:   "ok",
+				Servers: []validateTestServer{{Name: "a"}, {}},
+				Nested:  &validateTestServer{},
+			},
+			want: ValidationErrors{
+				{Field: "servers[1].name", Message: "required"},
+				{Field: "nested.name", Message: "required"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRequest(&tt.req)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.want, fieldErrors(t, err))
+		})
+	}
+}
+
+func TestBindAndValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantCode   int
+		wantFields ValidationErrors
+	}{
+		{
+			name:       "wrong type names the field",
+			body:       `{"title":1}`,
+			wantCode:   http.StatusBadRequest,
+			wantFields: ValidationErrors{{Field: "title", Message: "must be a string"}},
+		},
+		{
+			name:     "malformed json",
+			body:     `{"title":`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "validation runs after binding",
+			body:       `{"title":"ok","rate":-1}`,
+			wantCode:   http.StatusBadRequest,
+			wantFields: ValidationErrors{{Field: "rate", Message: "must be at least 0"}},
+		},
+		{
+			name: "valid body",
+			body: `{"title":"ok"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			err := bindAndValidate(c, &validateTestRequest{})
+			if tt.wantCode == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			var he *echo.HTTPError
+			require.True(t, errors.As(err, &he))
+			assert.Equal(t, tt.wantCode, he.Code)
+			if tt.wantFields != nil {
+				assert.Equal(t, tt.wantFields, fieldErrors(t, err))
+			}
+		})
+	}
+}
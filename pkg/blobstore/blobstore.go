@@ -0,0 +1,111 @@
+// Package blobstore keeps large binary and document payloads — offloaded
+// tool results, rendered reports, exports, artifacts — outside the JSONB
+// columns of the main tables. A single Store is configured centrally from
+// system.blob_store and backed by PostgreSQL large objects, S3, Google Cloud
+// Storage, or a local directory.
+package blobstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// ErrNotFound is returned by Get when no object exists under the key.
+var ErrNotFound = errors.New("blob not found")
+
+// Store reads and writes whole objects by key. Keys are slash-separated
+// relative paths (see ValidateKey). Implementations are safe for concurrent
+// use.
+type Store interface {
+	// Put stores data under key, replacing any existing object.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get returns the object stored under key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the object stored under key. Deleting a missing
+	// object is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// New creates the Store selected by cfg.Backend. db is the TARSy database
+// connection, used by the postgres backend.
+func New(cfg *config.BlobStoreConfig, db *sql.DB) (Store, error) {
+	var (
+		store Store
+		err   error
+	)
+	switch cfg.Backend {
+	case config.BlobBackendPostgres:
+		store = NewPostgresStore(db)
+	case config.BlobBackendLocal:
+		store, err = NewLocalStore(cfg.Local.Dir)
+	case config.BlobBackendS3:
+		store, err = NewS3Store(S3Options{
+			Bucket:       cfg.S3.Bucket,
+			Region:       cfg.S3.Region,
+			Endpoint:     cfg.S3.Endpoint,
+			PathStyle:    cfg.S3.PathStyle,
+			AccessKey:    os.Getenv(cfg.S3.AccessKeyEnv),
+			SecretKey:    os.Getenv(cfg.S3.SecretKeyEnv),
+			SessionToken: os.Getenv(cfg.S3.SessionTokenEnv),
+		})
+	case config.BlobBackendGCS:
+		store, err = NewGCSStore(cfg.GCS.Bucket, os.Getenv(cfg.GCS.AccessIDEnv), os.Getenv(cfg.GCS.SecretEnv))
+	default:
+		return nil, fmt.Errorf("unknown blob store backend %q", cfg.Backend)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s blob store: %w", cfg.Backend, err)
+	}
+	if cfg.Prefix != "" {
+		store = &prefixStore{Store: store, prefix: cfg.Prefix}
+	}
+	return store, nil
+}
+
+// ValidateKey checks that key is a non-empty relative path made of letters,
+// digits, '-', '_', '.', and '/' separators, with no empty, "." or ".."
+// segments. Keeping keys this plain lets every backend use them verbatim as
+// file paths and object names.
+func ValidateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("blob key must not be empty")
+	}
+	for _, seg := range strings.Split(key, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			return fmt.Errorf("invalid blob key %q: empty, '.' or '..' path segment", key)
+		}
+		for _, r := range seg {
+			switch {
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			default:
+				return fmt.Errorf("invalid blob key %q: unsupported character %q", key, r)
+			}
+		}
+	}
+	return nil
+}
+
+// prefixStore prepends system.blob_store.prefix to every key, so several
+// deployments can share one bucket.
+type prefixStore struct {
+	Store
+	prefix string
+}
+
+func (p *prefixStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	return p.Store.Put(ctx, p.prefix+key, data, contentType)
+}
+
+func (p *prefixStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return p.Store.Get(ctx, p.prefix+key)
+}
+
+func (p *prefixStore) Delete(ctx context.Context, key string) error {
+	return p.Store.Delete(ctx, p.prefix+key)
+}
@@ -0,0 +1,67 @@
+package blobstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestValidateKey(t *testing.T) {
+	valid := []string{
+		"a",
+		"reports/r1.html",
+		"tool-results/8f1c-22/3b_9.json",
+	}
+	for _, key := range valid {
+		assert.NoError(t, ValidateKey(key), key)
+	}
+
+	invalid := []string{
+		"",
+		"/abs",
+		"trailing/",
+		"a//b",
+		"a/../b",
+		"./a",
+		"spaces in key",
+		"a\\b",
+		"naïve",
+	}
+	for _, key := range invalid {
+		assert.Error(t, ValidateKey(key), key)
+	}
+}
+
+func TestNew_LocalWithPrefix(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.DefaultBlobStoreConfig()
+	cfg.Backend = config.BlobBackendLocal
+	cfg.Local.Dir = dir
+	cfg.Prefix = "tarsy/"
+
+	store, err := New(cfg, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, "reports/r1.html", []byte("<p>hi</p>"), "text/html"))
+
+	_, err = os.Stat(filepath.Join(dir, "tarsy", "reports", "r1.html"))
+	require.NoError(t, err, "prefix is prepended to the key")
+
+	data, err := store.Get(ctx, "reports/r1.html")
+	require.NoError(t, err)
+	assert.Equal(t, "<p>hi</p>", string(data))
+}
+
+func TestNew_UnknownBackend(t *testing.T) {
+	cfg := config.DefaultBlobStoreConfig()
+	cfg.Backend = "ftp"
+	_, err := New(cfg, nil)
+	require.Error(t, err)
+}
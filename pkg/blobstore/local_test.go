@@ -0,0 +1,47 @@
+package blobstore
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("missing object", func(t *testing.T) {
+		_, err := store.Get(ctx, "missing.json")
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.NoError(t, store.Delete(ctx, "missing.json"))
+	})
+
+	t.Run("put, replace, get, delete", func(t *testing.T) {
+		key := "tool-results/s1/i1.json"
+		require.NoError(t, store.Put(ctx, key, []byte("first"), "application/json"))
+		require.NoError(t, store.Put(ctx, key, []byte("second"), "application/json"))
+
+		data, err := store.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, "second", string(data))
+
+		entries, err := os.ReadDir(store.root + "/tool-results/s1")
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "no temporary files are left behind")
+
+		require.NoError(t, store.Delete(ctx, key))
+		_, err = store.Get(ctx, key)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("rejects keys escaping the root", func(t *testing.T) {
+		require.Error(t, store.Put(ctx, "../escape", []byte("x"), ""))
+		_, err := store.Get(ctx, "a/../../etc/passwd")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrNotFound)
+	})
+}
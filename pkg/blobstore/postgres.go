@@ -0,0 +1,117 @@
+package blobstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PostgresStore keeps objects as PostgreSQL large objects in the TARSy
+// database, indexed by the blobs table. Large objects live outside the table
+// rows, so big payloads neither bloat the main tables nor pass through JSONB
+// parsing. Each object is written and replaced in one transaction, and
+// replaced or deleted objects are unlinked so no orphans are left behind.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+// NewPostgresStore creates a PostgresStore on db.
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Put stores data as a new large object and points key at it, unlinking the
+// object key referenced before.
+func (s *PostgresStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		var previous sql.NullInt64
+		err := tx.QueryRowContext(ctx,
+			`SELECT object_oid FROM blobs WHERE key = $1 FOR UPDATE`, key).Scan(&previous)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to look up blob %s: %w", key, err)
+		}
+
+		var oid int64
+		if err := tx.QueryRowContext(ctx, `SELECT lo_from_bytea(0, $1)`, data).Scan(&oid); err != nil {
+			return fmt.Errorf("failed to write blob %s: %w", key, err)
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO blobs (key, object_oid, size_bytes, content_type, created_at)
+			 VALUES ($1, $2, $3, $4, $5)
+			 ON CONFLICT (key) DO UPDATE SET
+			   object_oid = EXCLUDED.object_oid,
+			   size_bytes = EXCLUDED.size_bytes,
+			   content_type = EXCLUDED.content_type,
+			   created_at = EXCLUDED.created_at`,
+			key, oid, len(data), contentType, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to index blob %s: %w", key, err)
+		}
+
+		if previous.Valid {
+			if _, err := tx.ExecContext(ctx, `SELECT lo_unlink($1)`, previous.Int64); err != nil {
+				return fmt.Errorf("failed to unlink replaced blob %s: %w", key, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Get reads the large object key points at.
+func (s *PostgresStore) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	var data []byte
+	err := s.db.QueryRowContext(ctx,
+		`SELECT lo_get(object_oid) FROM blobs WHERE key = $1`, key).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob %s: %w", key, err)
+	}
+	return data, nil
+}
+
+// Delete removes key and unlinks its large object.
+func (s *PostgresStore) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		var oid int64
+		err := tx.QueryRowContext(ctx,
+			`DELETE FROM blobs WHERE key = $1 RETURNING object_oid`, key).Scan(&oid)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete blob %s: %w", key, err)
+		}
+		if _, err := tx.ExecContext(ctx, `SELECT lo_unlink($1)`, oid); err != nil {
+			return fmt.Errorf("failed to unlink blob %s: %w", key, err)
+		}
+		return nil
+	})
+}
+
+func (s *PostgresStore) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/test/util"
+)
+
+func TestPostgresStore(t *testing.T) {
+	_, db := util.SetupTestDatabase(t)
+	store := NewPostgresStore(db)
+	ctx := context.Background()
+
+	countObjects := func() int {
+		var n int
+		require.NoError(t, db.QueryRowContext(ctx, `SELECT count(*) FROM pg_largeobject_metadata`).Scan(&n))
+		return n
+	}
+	before := countObjects()
+
+	_, err := store.Get(ctx, "missing.json")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, store.Delete(ctx, "missing.json"))
+
+	key := "tool-results/s1/i1.json"
+	large := bytes.Repeat([]byte("x"), 3*1024*1024)
+	require.NoError(t, store.Put(ctx, key, []byte("first"), "application/json"))
+	require.NoError(t, store.Put(ctx, key, large, "application/json"))
+	assert.Equal(t, before+1, countObjects(), "replaced large object is unlinked")
+
+	data, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, large, data)
+
+	var size int64
+	var contentType string
+	require.NoError(t, db.QueryRowContext(ctx,
+		`SELECT size_bytes, content_type FROM blobs WHERE key = $1`, key).Scan(&size, &contentType))
+	assert.Equal(t, int64(len(large)), size)
+	assert.Equal(t, "application/json", contentType)
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, before, countObjects(), "deleted large object is unlinked")
+}
@@ -7,12 +7,20 @@ package builtintools
 type Kind uint8
 
 const (
-	// KindOrchestration is agent orchestration built-ins (dispatch, cancel, list).
+	// KindOrchestration is agent orchestration built-ins (dispatch, cancel,
+	// list, poll, and the sub-agent side's progress reports).
 	KindOrchestration Kind = iota
 	// KindSkill is skill-loading built-ins.
 	KindSkill
 	// KindMemory is investigation memory / search built-ins.
 	KindMemory
+	// KindHighlight is the timeline highlight built-in (mark_key_finding).
+	KindHighlight
+	// KindLink is the dashboard / log deep link built-in (generate_link).
+	KindLink
+	// KindEnrichment is the IP / asset inventory lookup built-ins (lookup_ip,
+	// lookup_host).
+	KindEnrichment
 )
 
 // Wire names — single source of truth for these string literals.
@@ -20,9 +28,15 @@ const (
 	DispatchAgent            = "dispatch_agent"
 	CancelAgent              = "cancel_agent"
 	ListAgents               = "list_agents"
+	PollAgent                = "poll_agent"
+	ReportProgress           = "report_progress"
 	LoadSkill                = "load_skill"
 	RecallPastInvestigations = "recall_past_investigations"
 	SearchPastSessions       = "search_past_sessions"
+	MarkKeyFinding           = "mark_key_finding"
+	GenerateLink             = "generate_link"
+	LookupIP                 = "lookup_ip"
+	LookupHost               = "lookup_host"
 )
 
 // PlainToolKinds maps wire name → category. Must include every const above.
@@ -30,9 +44,15 @@ var PlainToolKinds = map[string]Kind{
 	DispatchAgent:            KindOrchestration,
 	CancelAgent:              KindOrchestration,
 	ListAgents:               KindOrchestration,
+	PollAgent:                KindOrchestration,
+	ReportProgress:           KindOrchestration,
 	LoadSkill:                KindSkill,
 	RecallPastInvestigations: KindMemory,
 	SearchPastSessions:       KindMemory,
+	MarkKeyFinding:           KindHighlight,
+	GenerateLink:             KindLink,
+	LookupIP:                 KindEnrichment,
+	LookupHost:               KindEnrichment,
 }
 
 // KindForPlainTool reports the category for a built-in plain tool name.
@@ -12,9 +12,15 @@ func TestPlainToolKindsCoversExportedConsts(t *testing.T) {
 		DispatchAgent,
 		CancelAgent,
 		ListAgents,
+		PollAgent,
+		ReportProgress,
 		LoadSkill,
 		RecallPastInvestigations,
 		SearchPastSessions,
+		MarkKeyFinding,
+		GenerateLink,
+		LookupIP,
+		LookupHost,
 	}
 	require.Len(t, PlainToolKinds, len(consts), "each const must have a PlainToolKinds entry and vice versa")
 	for _, c := range consts {
@@ -26,9 +32,15 @@ func TestPlainToolKindsCoversExportedConsts(t *testing.T) {
 func TestKindForPlainTool_categories(t *testing.T) {
 	assert.Equal(t, KindOrchestration, PlainToolKinds[DispatchAgent])
 	assert.Equal(t, KindOrchestration, PlainToolKinds[ListAgents])
+	assert.Equal(t, KindOrchestration, PlainToolKinds[PollAgent])
+	assert.Equal(t, KindOrchestration, PlainToolKinds[ReportProgress])
 	assert.Equal(t, KindSkill, PlainToolKinds[LoadSkill])
 	assert.Equal(t, KindMemory, PlainToolKinds[RecallPastInvestigations])
 	assert.Equal(t, KindMemory, PlainToolKinds[SearchPastSessions])
+	assert.Equal(t, KindHighlight, PlainToolKinds[MarkKeyFinding])
+	assert.Equal(t, KindLink, PlainToolKinds[GenerateLink])
+	assert.Equal(t, KindEnrichment, PlainToolKinds[LookupIP])
+	assert.Equal(t, KindEnrichment, PlainToolKinds[LookupHost])
 }
 
 func TestKindForPlainTool_unknown(t *testing.T) {
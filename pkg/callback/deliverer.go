@@ -0,0 +1,371 @@
+// Package callback delivers completion callbacks: the final result of a
+// session POSTed to the URL registered with its alert submission, with
+// retries and the delivery state recorded on the session.
+package callback
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// Request headers set on every delivery.
+const (
+	SignatureHeader = "X-Tarsy-Signature"        // "sha256=<hex HMAC of the body>", only when a secret is registered
+	AttemptHeader   = "X-Tarsy-Delivery-Attempt" // 1-based attempt number
+)
+
+// maxErrorLength bounds callback_last_error.
+const maxErrorLength = 1024
+
+// terminalStatuses are the session statuses that trigger the callback.
+var terminalStatuses = []alertsession.Status{
+	alertsession.StatusCompleted,
+	alertsession.StatusFailed,
+	alertsession.StatusTimedOut,
+	alertsession.StatusCancelled,
+	alertsession.StatusAutoCancelled,
+}
+
+// Payload is the JSON body POSTed to a completion callback.
+type Payload struct {
+	SessionID        string     `json:"session_id"`
+	GroupID          string     `json:"group_id,omitempty"`
+	AlertType        string     `json:"alert_type"`
+	ChainID          string     `json:"chain_id"`
+	AlertKey         string     `json:"alert_key,omitempty"`
+	Status           string     `json:"status"`
+	Severity         string     `json:"severity,omitempty"`
+	FinalAnalysis    string     `json:"final_analysis,omitempty"`
+	ExecutiveSummary string     `json:"executive_summary,omitempty"`
+	TechnicalSummary string     `json:"technical_summary,omitempty"`
+	ErrorMessage     string     `json:"error_message,omitempty"`
+	SessionURL       string     `json:"session_url,omitempty"`
+	CompletedAt      *time.Time `json:"completed_at,omitempty"`
+}
+
+// Deliverer POSTs completion callbacks for terminal sessions.
+//
+// Each attempt is claimed by incrementing callback_attempts conditionally,
+// so concurrent deliverers (e.g. a pod resuming pending callbacks while the
+// pod that started them is still backing off) never send the same attempt
+// twice. Nil-safe: all methods are no-ops when the deliverer is nil.
+type Deliverer struct {
+	client       *ent.Client
+	cfg          *config.CallbacksConfig
+	dashboardURL string
+	httpClient   *http.Client
+	logger       *slog.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDeliverer creates a completion callback deliverer. Callbacks already
+// registered are delivered even when system.callbacks is later disabled;
+// the flag only gates new registrations.
+func NewDeliverer(client *ent.Client, cfg *config.CallbacksConfig, dashboardURL string) *Deliverer {
+	if cfg == nil {
+		cfg = config.DefaultCallbacksConfig()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Deliverer{
+		client:       client,
+		cfg:          cfg,
+		dashboardURL: dashboardURL,
+		httpClient:   &http.Client{Timeout: cfg.Timeout},
+		logger:       slog.Default().With("component", "callback-deliverer"),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+}
+
+// Start resumes callbacks left pending by a previous shutdown.
+func (d *Deliverer) Start(ctx context.Context) {
+	if d == nil {
+		return
+	}
+	ids, err := d.client.AlertSession.Query().
+		Where(
+			alertsession.CallbackStatusEQ(alertsession.CallbackStatusPending),
+			alertsession.StatusIn(terminalStatuses...),
+		).
+		IDs(ctx)
+	if err != nil {
+		d.logger.Error("Failed to query pending completion callbacks", "error", err)
+		return
+	}
+	for _, id := range ids {
+		d.Deliver(id)
+	}
+	if len(ids) > 0 {
+		d.logger.Info("Resumed pending completion callbacks", "count", len(ids))
+	}
+}
+
+// Stop abandons backoff waits and in-flight attempts and waits for the
+// delivery goroutines to exit. Abandoned callbacks stay pending and are
+// resumed by the next Start.
+func (d *Deliverer) Stop() {
+	if d == nil {
+		return
+	}
+	d.cancel()
+	d.wg.Wait()
+}
+
+// Deliver sends the completion callback of a session in the background.
+// Call it after the terminal status is written; sessions without a pending
+// callback are ignored.
+func (d *Deliverer) Deliver(sessionID string) {
+	if d == nil || d.ctx.Err() != nil {
+		return
+	}
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.deliver(d.ctx, sessionID)
+	}()
+}
+
+func (d *Deliverer) deliver(ctx context.Context, sessionID string) {
+	log := d.logger.With("session_id", sessionID)
+
+	session, err := d.client.AlertSession.Get(ctx, sessionID)
+	if err != nil {
+		log.Error("Failed to load session for completion callback", "error", err)
+		return
+	}
+	if session.CallbackURL == nil || session.CallbackStatus == nil ||
+		*session.CallbackStatus != alertsession.CallbackStatusPending {
+		return
+	}
+
+	body, err := json.Marshal(d.buildPayload(session))
+	if err != nil {
+		log.Error("Failed to marshal completion callback", "error", err)
+		return
+	}
+	secret := ""
+	if session.CallbackSecret != nil {
+		secret = *session.CallbackSecret
+	}
+
+	for attempt := session.CallbackAttempts + 1; attempt <= d.cfg.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(Backoff(attempt-1, d.cfg.InitialBackoff, d.cfg.MaxBackoff)):
+			}
+		}
+
+		claimed, err := d.claimAttempt(ctx, sessionID, attempt)
+		if err != nil {
+			log.Error("Failed to claim completion callback attempt", "attempt", attempt, "error", err)
+			return
+		}
+		if !claimed {
+			// Delivered, failed, or retried by another deliverer.
+			return
+		}
+
+		retryable, err := d.post(ctx, *session.CallbackURL, secret, body, attempt)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			d.record(ctx, log, d.client.AlertSession.UpdateOneID(sessionID).
+				SetCallbackStatus(alertsession.CallbackStatusDelivered).
+				SetCallbackDeliveredAt(time.Now()).
+				ClearCallbackLastError())
+			log.Info("Delivered completion callback", "attempt", attempt)
+			return
+		}
+
+		update := d.client.AlertSession.UpdateOneID(sessionID).
+			SetCallbackLastError(controller.Truncate(err.Error(), maxErrorLength))
+		if !retryable || attempt >= d.cfg.MaxAttempts {
+			d.record(ctx, log, update.SetCallbackStatus(alertsession.CallbackStatusFailed))
+			log.Warn("Completion callback failed", "attempt", attempt, "error", err)
+			return
+		}
+		d.record(ctx, log, update)
+		log.Warn("Completion callback attempt failed, will retry", "attempt", attempt, "error", err)
+	}
+
+	// Attempts were exhausted before this deliverer started (e.g. the
+	// configured max_attempts was lowered).
+	d.record(ctx, log, d.client.AlertSession.Update().
+		Where(
+			alertsession.ID(sessionID),
+			alertsession.CallbackStatusEQ(alertsession.CallbackStatusPending),
+		).
+		SetCallbackStatus(alertsession.CallbackStatusFailed))
+}
+
+// claimAttempt moves callback_attempts from attempt-1 to attempt while the
+// callback is still pending. false means someone else owns the delivery.
+func (d *Deliverer) claimAttempt(ctx context.Context, sessionID string, attempt int) (bool, error) {
+	n, err := d.client.AlertSession.Update().
+		Where(
+			alertsession.ID(sessionID),
+			alertsession.CallbackStatusEQ(alertsession.CallbackStatusPending),
+			alertsession.CallbackAttempts(attempt-1),
+		).
+		SetCallbackAttempts(attempt).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// recordable is satisfied by the ent update builders used to record delivery state.
+type recordable interface {
+	Exec(ctx context.Context) error
+}
+
+func (d *Deliverer) record(ctx context.Context, log *slog.Logger, update recordable) {
+	if err := update.Exec(ctx); err != nil {
+		log.Error("Failed to record completion callback state", "error", err)
+	}
+}
+
+func (d *Deliverer) buildPayload(session *ent.AlertSession) Payload {
+	p := Payload{
+		SessionID:   session.ID,
+		AlertType:   session.AlertType,
+		ChainID:     session.ChainID,
+		Status:      string(session.Status),
+		CompletedAt: session.CompletedAt,
+	}
+	if session.GroupID != nil {
+		p.GroupID = *session.GroupID
+	}
+	if session.AlertKey != nil {
+		p.AlertKey = *session.AlertKey
+	}
+	if session.Severity != nil {
+		p.Severity = string(*session.Severity)
+	}
+	if session.FinalAnalysis != nil {
+		p.FinalAnalysis = *session.FinalAnalysis
+	}
+	if session.ExecutiveSummary != nil {
+		p.ExecutiveSummary = *session.ExecutiveSummary
+	}
+	if session.TechnicalSummary != nil {
+		p.TechnicalSummary = *session.TechnicalSummary
+	}
+	if session.ErrorMessage != nil {
+		p.ErrorMessage = *session.ErrorMessage
+	}
+	if d.dashboardURL != "" {
+		p.SessionURL = fmt.Sprintf("%s/sessions/%s", d.dashboardURL, session.ID)
+	}
+	return p
+}
+
+// post sends one delivery attempt. retryable reports whether a failure may
+// succeed on a later attempt: transport errors, 408, 429 and 5xx are
+// retried; other 4xx responses mean the receiver rejected the callback.
+func (d *Deliverer) post(ctx context.Context, callbackURL, secret string, body []byte, attempt int) (retryable bool, err error) {
+	reqCtx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to build callback request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(AttemptHeader, strconv.Itoa(attempt))
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("callback request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable = resp.StatusCode == http.StatusRequestTimeout ||
+		resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= 500
+	return retryable, fmt.Errorf("callback returned status %d", resp.StatusCode)
+}
+
+// Sign returns the signature header value for body: "sha256=" followed by
+// the hex HMAC-SHA256 of the body keyed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff returns the wait after the given number of failed attempts:
+// initial doubled per earlier retry, capped at maxBackoff.
+func Backoff(failedAttempts int, initial, maxBackoff time.Duration) time.Duration {
+	wait := initial
+	for i := 1; i < failedAttempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, maxBackoff)
+}
+
+// ValidateRegistration checks a callback submitted with an alert: an
+// absolute http(s) URL on an allowed host (any host when allowedHosts is
+// empty; subdomains of an allowed host match) and bounded field lengths.
+func ValidateRegistration(cb *models.CompletionCallback, allowedHosts []string) error {
+	if cb.URL == "" {
+		return fmt.Errorf("url is required")
+	}
+	if len(cb.URL) > models.MaxCallbackURLLength {
+		return fmt.Errorf("url exceeds %d characters", models.MaxCallbackURLLength)
+	}
+	if len(cb.Secret) > models.MaxCallbackSecretLength {
+		return fmt.Errorf("secret exceeds %d characters", models.MaxCallbackSecretLength)
+	}
+	parsed, err := url.Parse(cb.URL)
+	if err != nil {
+		return fmt.Errorf("malformed url: %w", err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("invalid scheme %q: only http and https allowed", parsed.Scheme)
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if host == "" {
+		return fmt.Errorf("url must include a host")
+	}
+	if len(allowedHosts) == 0 {
+		return nil
+	}
+	for _, allowed := range allowedHosts {
+		a := strings.ToLower(strings.TrimSpace(allowed))
+		if host == a || strings.HasSuffix(host, "."+a) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %q not in allowed list", host)
+}
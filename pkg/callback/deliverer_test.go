@@ -0,0 +1,281 @@
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '{"a":1}' | openssl dgst -sha256 -hmac s3cret
+	assert.Equal(t, "sha256=5910e62016ef5034272c926c27071992a465c2335cecf41851bda071577f4f6d", Sign("s3cret", []byte(`{"a":1}`)))
+	assert.NotEqual(t, Sign("s3cret", []byte("body")), Sign("other", []byte("body")))
+}
+
+func TestBackoff(t *testing.T) {
+	initial, maxBackoff := 10*time.Second, time.Minute
+	assert.Equal(t, 10*time.Second, Backoff(1, initial, maxBackoff))
+	assert.Equal(t, 20*time.Second, Backoff(2, initial, maxBackoff))
+	assert.Equal(t, 40*time.Second, Backoff(3, initial, maxBackoff))
+	assert.Equal(t, time.Minute, Backoff(4, initial, maxBackoff))
+	assert.Equal(t, time.Minute, Backoff(50, initial, maxBackoff))
+}
+
+func TestValidateRegistration(t *testing.T) {
+	tests := []struct {
+		name    string
+		cb      models.CompletionCallback
+		allowed []string
+		wantErr string
+	}{
+		{name: "https url", cb: models.CompletionCallback{URL: "https://ci.example.com/hooks/tarsy"}},
+		{name: "with secret", cb: models.CompletionCallback{URL: "http://ci.example.com/hook", Secret: "s3cret"}},
+		{name: "allowed host", cb: models.CompletionCallback{URL: "https://ci.example.com/hook"}, allowed: []string{"ci.example.com"}},
+		{name: "allowed subdomain", cb: models.CompletionCallback{URL: "https://eu.ci.example.com/hook"}, allowed: []string{"CI.example.com"}},
+		{name: "missing url", cb: models.CompletionCallback{}, wantErr: "url is required"},
+		{name: "bad scheme", cb: models.CompletionCallback{URL: "ftp://ci.example.com/hook"}, wantErr: "invalid scheme"},
+		{name: "relative url", cb: models.CompletionCallback{URL: "/hook"}, wantErr: "invalid scheme"},
+		{name: "missing host", cb: models.CompletionCallback{URL: "https:///hook"}, wantErr: "must include a host"},
+		{name: "host not allowed", cb: models.CompletionCallback{URL: "https://evil-example.com/hook"}, allowed: []string{"example.com"}, wantErr: "not in allowed list"},
+		{name: "url too long", cb: models.CompletionCallback{URL: "https://ci.example.com/" + strings.Repeat("a", models.MaxCallbackURLLength)}, wantErr: "url exceeds"},
+		{name: "secret too long", cb: models.CompletionCallback{URL: "https://ci.example.com/", Secret: strings.Repeat("s", models.MaxCallbackSecretLength+1)}, wantErr: "secret exceeds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRegistration(&tt.cb, tt.allowed)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestDeliverer_Post(t *testing.T) {
+	var gotSignature, gotAttempt string
+	var gotBody []byte
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(SignatureHeader)
+		gotAttempt = r.Header.Get(AttemptHeader)
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	d := NewDeliverer(nil, config.DefaultCallbacksConfig(), "")
+	t.Cleanup(d.Stop)
+	body := []byte(`{"session_id":"sess-1"}`)
+
+	retryable, err := d.post(context.Background(), srv.URL, "s3cret", body, 2)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+	assert.Equal(t, Sign("s3cret", body), gotSignature)
+	assert.Equal(t, "2", gotAttempt)
+	assert.Equal(t, body, gotBody)
+
+	_, err = d.post(context.Background(), srv.URL, "", body, 1)
+	require.NoError(t, err)
+	assert.Empty(t, gotSignature, "no signature without a secret")
+
+	for code, wantRetry := range map[int]bool{
+		http.StatusInternalServerError: true,
+		http.StatusBadGateway:          true,
+		http.StatusTooManyRequests:     true,
+		http.StatusRequestTimeout:      true,
+		http.StatusNotFound:            false,
+		http.StatusUnauthorized:        false,
+	} {
+		status = code
+		retryable, err = d.post(context.Background(), srv.URL, "", body, 1)
+		require.Error(t, err, "status %d", code)
+		assert.Equal(t, wantRetry, retryable, "status %d", code)
+	}
+}
+
+func TestDeliverer_BuildPayload(t *testing.T) {
+	d := NewDeliverer(nil, nil, "https://tarsy.example.com")
+	t.Cleanup(d.Stop)
+
+	analysis := "OOMKilled"
+	severity := alertsession.SeverityHigh
+	completedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	p := d.buildPayload(&ent.AlertSession{
+		ID:            "sess-1",
+		AlertType:     "PodCrash",
+		ChainID:       "k8s",
+		Status:        alertsession.StatusCompleted,
+		FinalAnalysis: &analysis,
+		Severity:      &severity,
+		CompletedAt:   &completedAt,
+	})
+
+	assert.Equal(t, Payload{
+		SessionID:     "sess-1",
+		AlertType:     "PodCrash",
+		ChainID:       "k8s",
+		Status:        "completed",
+		Severity:      "high",
+		FinalAnalysis: "OOMKilled",
+		SessionURL:    "https://tarsy.example.com/sessions/sess-1",
+		CompletedAt:   &completedAt,
+	}, p)
+}
+
+func TestDeliverer_NilSafe(t *testing.T) {
+	var d *Deliverer
+	d.Deliver("sess-1")
+	d.Start(context.Background())
+	d.Stop()
+}
+
+// callbackReceiver answers with the queued status codes in order, then 200.
+type callbackReceiver struct {
+	mu       sync.Mutex
+	statuses []int
+	payloads []Payload
+}
+
+func (r *callbackReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var p Payload
+	_ = json.NewDecoder(req.Body).Decode(&p)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.payloads = append(r.payloads, p)
+	code := http.StatusOK
+	if len(r.statuses) > 0 {
+		code, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(code)
+}
+
+func (r *callbackReceiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.payloads)
+}
+
+func TestDeliverer_Deliver(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	ctx := context.Background()
+
+	cfg := config.DefaultCallbacksConfig()
+	cfg.MaxAttempts = 3
+	cfg.InitialBackoff = 10 * time.Millisecond
+	cfg.MaxBackoff = 20 * time.Millisecond
+
+	createSession := func(t *testing.T, url string, status alertsession.Status) *ent.AlertSession {
+		t.Helper()
+		session, err := client.AlertSession.Create().
+			SetID(uuid.New().String()).
+			SetAlertData("test").
+			SetAgentType("kubernetes").
+			SetAlertType("PodCrash").
+			SetChainID("k8s").
+			SetStatus(status).
+			SetCallbackURL(url).
+			SetCallbackSecret("s3cret").
+			SetCallbackStatus(alertsession.CallbackStatusPending).
+			Save(ctx)
+		require.NoError(t, err)
+		return session
+	}
+	waitForStatus := func(t *testing.T, id string, want alertsession.CallbackStatus) *ent.AlertSession {
+		t.Helper()
+		var session *ent.AlertSession
+		require.Eventually(t, func() bool {
+			var err error
+			session, err = client.AlertSession.Get(ctx, id)
+			return err == nil && session.CallbackStatus != nil && *session.CallbackStatus == want
+		}, 5*time.Second, 10*time.Millisecond)
+		return session
+	}
+
+	t.Run("retries transient failures then records delivery", func(t *testing.T) {
+		rec := &callbackReceiver{statuses: []int{http.StatusServiceUnavailable}}
+		srv := httptest.NewServer(rec)
+		t.Cleanup(srv.Close)
+		d := NewDeliverer(client.Client, cfg, "")
+		t.Cleanup(d.Stop)
+
+		session := createSession(t, srv.URL, alertsession.StatusCompleted)
+		d.Deliver(session.ID)
+
+		got := waitForStatus(t, session.ID, alertsession.CallbackStatusDelivered)
+		assert.Equal(t, 2, got.CallbackAttempts)
+		assert.NotNil(t, got.CallbackDeliveredAt)
+		assert.Nil(t, got.CallbackLastError)
+		assert.Equal(t, 2, rec.count())
+		assert.Equal(t, session.ID, rec.payloads[1].SessionID)
+		assert.Equal(t, "completed", rec.payloads[1].Status)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		rec := &callbackReceiver{statuses: []int{500, 500, 500, 500}}
+		srv := httptest.NewServer(rec)
+		t.Cleanup(srv.Close)
+		d := NewDeliverer(client.Client, cfg, "")
+		t.Cleanup(d.Stop)
+
+		session := createSession(t, srv.URL, alertsession.StatusFailed)
+		d.Deliver(session.ID)
+
+		got := waitForStatus(t, session.ID, alertsession.CallbackStatusFailed)
+		assert.Equal(t, 3, got.CallbackAttempts)
+		require.NotNil(t, got.CallbackLastError)
+		assert.Contains(t, *got.CallbackLastError, "status 500")
+		assert.Equal(t, 3, rec.count())
+	})
+
+	t.Run("rejection is not retried", func(t *testing.T) {
+		rec := &callbackReceiver{statuses: []int{http.StatusGone}}
+		srv := httptest.NewServer(rec)
+		t.Cleanup(srv.Close)
+		d := NewDeliverer(client.Client, cfg, "")
+		t.Cleanup(d.Stop)
+
+		session := createSession(t, srv.URL, alertsession.StatusCompleted)
+		d.Deliver(session.ID)
+
+		got := waitForStatus(t, session.ID, alertsession.CallbackStatusFailed)
+		assert.Equal(t, 1, got.CallbackAttempts)
+		assert.Equal(t, 1, rec.count())
+	})
+
+	t.Run("start resumes pending callbacks of terminal sessions only", func(t *testing.T) {
+		rec := &callbackReceiver{}
+		srv := httptest.NewServer(rec)
+		t.Cleanup(srv.Close)
+		d := NewDeliverer(client.Client, cfg, "")
+		t.Cleanup(d.Stop)
+
+		done := createSession(t, srv.URL, alertsession.StatusTimedOut)
+		running := createSession(t, srv.URL, alertsession.StatusInProgress)
+		d.Start(ctx)
+
+		waitForStatus(t, done.ID, alertsession.CallbackStatusDelivered)
+		got, err := client.AlertSession.Get(ctx, running.ID)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.CallbackStatusPending, *got.CallbackStatus)
+		assert.Equal(t, 0, got.CallbackAttempts)
+	})
+}
@@ -14,7 +14,9 @@ import (
 //   - Soft-deletes old sessions (completed + stale pending)
 //   - Removes orphaned Event rows past their TTL
 //
-// All operations are idempotent and safe to run from multiple pods.
+// All operations are idempotent and safe to run from multiple pods. In
+// production it runs as a leader-elected singleton job (see pkg/coordination)
+// and may be started again after Stop.
 type Service struct {
 	config         *config.RetentionConfig
 	sessionService *services.SessionService
@@ -60,6 +62,7 @@ func (s *Service) Stop() {
 	}
 	s.cancel()
 	<-s.done
+	s.cancel = nil
 	slog.Info("Cleanup service stopped")
 }
 
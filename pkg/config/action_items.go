@@ -0,0 +1,29 @@
+package config
+
+// ActionItemsConfig controls the extraction of follow-up action items from
+// the remediation recommendations in a completed session's final analysis.
+// Extracted items are stored with status "open" and tracked through the
+// action items API. Disabled by default.
+type ActionItemsConfig struct {
+	Enabled bool
+
+	// LLMProvider runs the extraction. Empty uses the chain's executive
+	// summary provider, chain provider, or defaults.llm_provider.
+	LLMProvider string
+
+	// MaxItems caps the number of items extracted from one session.
+	MaxItems int
+}
+
+// ActionItemsYAMLConfig holds action item extraction settings from YAML.
+type ActionItemsYAMLConfig struct {
+	Enabled     *bool  `yaml:"enabled,omitempty"`
+	LLMProvider string `yaml:"llm_provider,omitempty"`
+	MaxItems    int    `yaml:"max_items,omitempty"`
+}
+
+// DefaultActionItemsConfig returns the built-in action item extraction
+// defaults: disabled, at most 10 items per session.
+func DefaultActionItemsConfig() *ActionItemsConfig {
+	return &ActionItemsConfig{MaxItems: 10}
+}
@@ -0,0 +1,18 @@
+package config
+
+import "slices"
+
+// IsAdmin reports whether any of the caller's identities (user name, email,
+// or service account forwarded by the auth proxy) is listed in
+// system.admins. Nil-safe.
+func (c *Config) IsAdmin(identities ...string) bool {
+	if c == nil {
+		return false
+	}
+	for _, id := range identities {
+		if id != "" && slices.Contains(c.Admins, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_IsAdmin(t *testing.T) {
+	cfg := &Config{Admins: []string{"sre-lead@example.com", "system:serviceaccount:tarsy:ops"}}
+
+	assert.True(t, cfg.IsAdmin("alice", "sre-lead@example.com"))
+	assert.True(t, cfg.IsAdmin("system:serviceaccount:tarsy:ops"))
+	assert.False(t, cfg.IsAdmin("bob", "bob@example.com"))
+	assert.False(t, cfg.IsAdmin(""), "empty identities never match")
+	assert.False(t, (&Config{}).IsAdmin("sre-lead@example.com"))
+	assert.False(t, (*Config)(nil).IsAdmin("sre-lead@example.com"))
+}
+
+func TestValidateAdmins(t *testing.T) {
+	assert.NoError(t, NewValidator(&Config{}).validateAdmins())
+	assert.NoError(t, NewValidator(&Config{Admins: []string{"sre-lead@example.com"}}).validateAdmins())
+	assert.EqualError(t, NewValidator(&Config{Admins: []string{"sre-lead@example.com", " "}}).validateAdmins(),
+		"system.admins[1] must not be empty")
+}
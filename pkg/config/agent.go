@@ -16,6 +16,9 @@ type AgentConfig struct {
 	// Human-readable description
 	Description string `yaml:"description,omitempty"`
 
+	// Team or owner charged for this agent's LLM usage, over the chain's owner
+	Owner string `yaml:"owner,omitempty"`
+
 	// MCP servers this agent uses
 	MCPServers []string `yaml:"mcp_servers" validate:"omitempty"`
 
@@ -28,11 +31,17 @@ type AgentConfig struct {
 	// Max iterations for this agent (forces conclusion when reached, no pause/resume)
 	MaxIterations *int `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
 
+	// Max tool calls per execution (forces conclusion when reached; unset = unlimited)
+	MaxToolCalls *int `yaml:"max_tool_calls,omitempty" validate:"omitempty,min=1"`
+
 	// Per-agent native tool overrides (Google/Gemini). Merges with the LLM
 	// provider's NativeTools on a per-key basis: agent keys override provider keys,
 	// missing keys fall through to the provider default.
 	NativeTools map[GoogleNativeTool]bool `yaml:"native_tools,omitempty"`
 
+	// Per-agent generation parameter overrides (per field, over the provider's)
+	Generation *GenerationParams `yaml:"generation,omitempty"`
+
 	// Orchestrator guardrails (valid on any agent type; inert unless the agent
 	// has sub-agents at runtime).
 	Orchestrator *OrchestratorConfig `yaml:"orchestrator,omitempty"`
@@ -48,6 +57,13 @@ type AgentConfig struct {
 	// Validated against the skill registry only (no dependency on Skills allowlist).
 	// These are excluded from the on-demand catalog.
 	RequiredSkills []string `yaml:"required_skills,omitempty"`
+
+	// Extends names a base agent; unset fields are inherited from it.
+	Extends string `yaml:"extends,omitempty"`
+
+	// Mixins name agent_mixins entries layered over this agent (see
+	// resolveAgentComposition for precedence).
+	Mixins []string `yaml:"mixins,omitempty"`
 }
 
 // OrchestratorConfig holds orchestrator-specific settings.
@@ -56,6 +72,7 @@ type OrchestratorConfig struct {
 	MaxConcurrentAgents *int           `yaml:"max_concurrent_agents,omitempty"`
 	AgentTimeout        *time.Duration `yaml:"agent_timeout,omitempty"`
 	MaxBudget           *time.Duration `yaml:"max_budget,omitempty"`
+	MaxTokens           *int           `yaml:"max_tokens,omitempty"`
 }
 
 // AgentRegistry stores agent configurations in memory with thread-safe access
@@ -110,6 +127,19 @@ func (r *AgentRegistry) Has(name string) bool {
 	return exists
 }
 
+// Replace swaps in a new set of agent configurations (thread-safe). Used
+// to apply agents registered at runtime; callers validate the set first.
+func (r *AgentRegistry) Replace(agents map[string]*AgentConfig) {
+	copied := make(map[string]*AgentConfig, len(agents))
+	for k, v := range agents {
+		copied[k] = v
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.agents = copied
+}
+
 // Len returns the number of agents in the registry (thread-safe)
 func (r *AgentRegistry) Len() int {
 	r.mu.RLock()
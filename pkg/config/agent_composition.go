@@ -0,0 +1,234 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// additiveAgentFields are combined across mixins instead of conflicting.
+var additiveAgentFields = []string{"MCPServers", "CustomInstructions", "NativeTools", "RequiredSkills"}
+
+// resolveAgentComposition expands agent composition in place, before
+// validation. Precedence for each field is: the agent's own value, then its
+// mixins, then its extends base.
+//   - Single-valued fields (type, description, llm_backend, max_iterations,
+//     generation, orchestrator, skills) left unset are taken from the mixins;
+//     two mixins setting different values is a conflict the agent must settle
+//     itself.
+//   - Remaining unset fields are inherited wholesale from the base agent.
+//   - Mixins then add to the result: mcp_servers and required_skills are
+//     unioned, custom_instructions appended, and native_tools merged (keys
+//     the agent sets itself win).
+//
+// Agents are resolved depth-first, so a base is fully expanded before its
+// variants. Extends cycles are rejected.
+func resolveAgentComposition(agents map[string]*AgentConfig, mixins map[string]AgentConfig) error {
+	names := make([]string, 0, len(mixins))
+	for name := range mixins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		m := mixins[name]
+		if m.Extends != "" || len(m.Mixins) > 0 {
+			return NewValidationError("agent_mixin", name, "extends",
+				fmt.Errorf("mixins cannot extend agents or use other mixins"))
+		}
+	}
+
+	r := &agentComposer{
+		agents: agents,
+		mixins: mixins,
+		state:  make(map[string]compositionState, len(agents)),
+	}
+
+	// Sorted for deterministic error reporting
+	ids := make([]string, 0, len(agents))
+	for id := range agents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if err := r.resolve(id, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type agentComposer struct {
+	agents map[string]*AgentConfig
+	mixins map[string]AgentConfig
+	state  map[string]compositionState
+}
+
+// resolve expands agent id; path holds the agents currently being resolved,
+// for the cycle message.
+func (r *agentComposer) resolve(id string, path []string) error {
+	if r.state[id] == compositionResolved {
+		return nil
+	}
+	r.state[id] = compositionVisiting
+	path = append(path, id)
+	agent := r.agents[id]
+
+	used, err := r.lookupMixins(id, agent.Mixins)
+	if err != nil {
+		return err
+	}
+	if err := applyMixinFields(id, agent, used); err != nil {
+		return err
+	}
+
+	if agent.Extends != "" {
+		base, ok := r.agents[agent.Extends]
+		if !ok {
+			return NewValidationError("agent", id, "extends", fmt.Errorf("agent '%s' not found", agent.Extends))
+		}
+		if r.state[agent.Extends] == compositionVisiting {
+			return NewValidationError("agent", id, "extends",
+				fmt.Errorf("composition cycle: %s -> %s", strings.Join(path, " -> "), agent.Extends))
+		}
+		if err := r.resolve(agent.Extends, path); err != nil {
+			return err
+		}
+		// The base's mixins are already folded into its fields
+		inheritUnsetFields(agent, base, "Extends", "Mixins")
+	}
+
+	if err := applyMixinAdditions(id, agent, used); err != nil {
+		return err
+	}
+
+	r.state[id] = compositionResolved
+	return nil
+}
+
+// namedMixin pairs a mixin with its name, for conflict messages.
+type namedMixin struct {
+	name   string
+	config *AgentConfig
+}
+
+// lookupMixins returns the mixins agent id references, in listed order.
+func (r *agentComposer) lookupMixins(id string, names []string) ([]namedMixin, error) {
+	used := make([]namedMixin, 0, len(names))
+	for i, name := range names {
+		m, ok := r.mixins[name]
+		if !ok {
+			return nil, NewValidationError("agent", id, fmt.Sprintf("mixins[%d]", i), fmt.Errorf("mixin '%s' not found", name))
+		}
+		if slices.Contains(names[:i], name) {
+			return nil, NewValidationError("agent", id, fmt.Sprintf("mixins[%d]", i), fmt.Errorf("mixin '%s' listed twice", name))
+		}
+		used = append(used, namedMixin{name: name, config: &m})
+	}
+	return used, nil
+}
+
+// applyMixinFields fills the agent's unset single-valued fields from its
+// mixins, rejecting mixins that disagree.
+func applyMixinFields(id string, agent *AgentConfig, used []namedMixin) error {
+	dst := reflect.ValueOf(agent).Elem()
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if slices.Contains(additiveAgentFields, field.Name) || field.Name == "Extends" || field.Name == "Mixins" {
+			continue
+		}
+		if !dst.Field(i).IsZero() {
+			continue
+		}
+		var from string
+		for _, m := range used {
+			v := reflect.ValueOf(m.config).Elem().Field(i)
+			if v.IsZero() {
+				continue
+			}
+			if from == "" {
+				dst.Field(i).Set(v)
+				from = m.name
+				continue
+			}
+			if !reflect.DeepEqual(dst.Field(i).Interface(), v.Interface()) {
+				return NewValidationError("agent", id, yamlFieldName(field),
+					fmt.Errorf("mixins '%s' and '%s' set conflicting values; set it on the agent to choose", from, m.name))
+			}
+		}
+	}
+	return nil
+}
+
+// applyMixinAdditions layers the mixins' additive fields over the agent.
+// Always builds fresh slices and maps so a base's values are never mutated.
+func applyMixinAdditions(id string, agent *AgentConfig, used []namedMixin) error {
+	if len(used) == 0 {
+		return nil
+	}
+
+	instructions := []string{}
+	if agent.CustomInstructions != "" {
+		instructions = append(instructions, agent.CustomInstructions)
+	}
+	mcpServers := slices.Clone(agent.MCPServers)
+	requiredSkills := slices.Clone(agent.RequiredSkills)
+
+	var nativeTools map[GoogleNativeTool]bool
+	if agent.NativeTools != nil {
+		nativeTools = make(map[GoogleNativeTool]bool, len(agent.NativeTools))
+	}
+	toolSource := make(map[GoogleNativeTool]string)
+
+	for _, m := range used {
+		mcpServers = appendMissing(mcpServers, m.config.MCPServers...)
+		requiredSkills = appendMissing(requiredSkills, m.config.RequiredSkills...)
+		if m.config.CustomInstructions != "" {
+			instructions = append(instructions, m.config.CustomInstructions)
+		}
+		for tool, enabled := range m.config.NativeTools {
+			if _, own := agent.NativeTools[tool]; own {
+				continue
+			}
+			if from, seen := toolSource[tool]; seen && nativeTools[tool] != enabled {
+				return NewValidationError("agent", id, "native_tools."+string(tool),
+					fmt.Errorf("mixins '%s' and '%s' set conflicting values; set it on the agent to choose", from, m.name))
+			}
+			if nativeTools == nil {
+				nativeTools = make(map[GoogleNativeTool]bool)
+			}
+			nativeTools[tool] = enabled
+			toolSource[tool] = m.name
+		}
+	}
+	for tool, enabled := range agent.NativeTools {
+		nativeTools[tool] = enabled
+	}
+
+	agent.MCPServers = mcpServers
+	agent.RequiredSkills = requiredSkills
+	agent.CustomInstructions = strings.Join(instructions, "\n\n")
+	agent.NativeTools = nativeTools
+	return nil
+}
+
+// appendMissing appends the values not already in list.
+func appendMissing(list []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// yamlFieldName returns the YAML key of a struct field.
+func yamlFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
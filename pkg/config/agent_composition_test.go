@@ -0,0 +1,247 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveAgentComposition(t *testing.T) {
+	maxIter := 5
+
+	t.Run("extends inherits unset fields", func(t *testing.T) {
+		agents := map[string]*AgentConfig{
+			"base": {
+				Description:        "k8s base",
+				MCPServers:         []string{"kubernetes-server"},
+				CustomInstructions: "Investigate the cluster.",
+				LLMBackend:         LLMBackendNativeGemini,
+				MaxIterations:      &maxIter,
+			},
+			"variant": {
+				Extends:     "base",
+				Description: "k8s variant",
+			},
+		}
+		require.NoError(t, resolveAgentComposition(agents, nil))
+
+		variant := agents["variant"]
+		assert.Equal(t, "k8s variant", variant.Description, "set field overrides base")
+		assert.Equal(t, []string{"kubernetes-server"}, variant.MCPServers)
+		assert.Equal(t, "Investigate the cluster.", variant.CustomInstructions)
+		assert.Equal(t, LLMBackendNativeGemini, variant.LLMBackend)
+		assert.Equal(t, &maxIter, variant.MaxIterations)
+	})
+
+	t.Run("multi-level extends resolves base first", func(t *testing.T) {
+		agents := map[string]*AgentConfig{
+			"c": {Extends: "b"},
+			"b": {Extends: "a", Description: "b"},
+			"a": {MCPServers: []string{"a-server"}, Description: "a"},
+		}
+		require.NoError(t, resolveAgentComposition(agents, nil))
+		assert.Equal(t, "b", agents["c"].Description)
+		assert.Equal(t, []string{"a-server"}, agents["c"].MCPServers)
+	})
+
+	t.Run("mixins layer over base", func(t *testing.T) {
+		mixins := map[string]AgentConfig{
+			"prometheus": {
+				MCPServers:         []string{"prometheus-server"},
+				CustomInstructions: "Check metrics.",
+				RequiredSkills:     []string{"promql"},
+				NativeTools:        map[GoogleNativeTool]bool{GoogleNativeToolGoogleSearch: false},
+			},
+			"deep": {MaxIterations: &maxIter},
+		}
+		agents := map[string]*AgentConfig{
+			"base": {
+				MCPServers:         []string{"kubernetes-server"},
+				CustomInstructions: "Investigate the cluster.",
+				RequiredSkills:     []string{"promql"},
+			},
+			"variant": {
+				Extends:     "base",
+				Mixins:      []string{"prometheus", "deep"},
+				NativeTools: map[GoogleNativeTool]bool{GoogleNativeToolCodeExecution: true},
+			},
+		}
+		require.NoError(t, resolveAgentComposition(agents, mixins))
+
+		variant := agents["variant"]
+		assert.Equal(t, []string{"kubernetes-server", "prometheus-server"}, variant.MCPServers)
+		assert.Equal(t, "Investigate the cluster.\n\nCheck metrics.", variant.CustomInstructions)
+		assert.Equal(t, []string{"promql"}, variant.RequiredSkills)
+		assert.Equal(t, map[GoogleNativeTool]bool{
+			GoogleNativeToolGoogleSearch:  false,
+			GoogleNativeToolCodeExecution: true,
+		}, variant.NativeTools)
+		assert.Equal(t, &maxIter, variant.MaxIterations)
+
+		assert.Equal(t, []string{"kubernetes-server"}, agents["base"].MCPServers, "base must not be mutated")
+	})
+
+	t.Run("agent value wins over mixins and mixins win over base", func(t *testing.T) {
+		mixins := map[string]AgentConfig{
+			"gemini": {LLMBackend: LLMBackendNativeGemini, Description: "from mixin"},
+		}
+		agents := map[string]*AgentConfig{
+			"base":    {LLMBackend: LLMBackendLangChain, Description: "from base"},
+			"variant": {Extends: "base", Mixins: []string{"gemini"}, Description: "own"},
+		}
+		require.NoError(t, resolveAgentComposition(agents, mixins))
+		assert.Equal(t, "own", agents["variant"].Description)
+		assert.Equal(t, LLMBackendNativeGemini, agents["variant"].LLMBackend)
+	})
+
+	t.Run("agent settles a mixin conflict", func(t *testing.T) {
+		mixins := map[string]AgentConfig{
+			"gemini":    {LLMBackend: LLMBackendNativeGemini},
+			"langchain": {LLMBackend: LLMBackendLangChain},
+		}
+		agents := map[string]*AgentConfig{
+			"a": {Mixins: []string{"gemini", "langchain"}, LLMBackend: LLMBackendLangChain},
+		}
+		require.NoError(t, resolveAgentComposition(agents, mixins))
+		assert.Equal(t, LLMBackendLangChain, agents["a"].LLMBackend)
+	})
+
+	errTests := []struct {
+		name   string
+		agents map[string]*AgentConfig
+		mixins map[string]AgentConfig
+		errMsg []string
+	}{
+		{
+			name:   "unknown base",
+			agents: map[string]*AgentConfig{"a": {Extends: "missing"}},
+			errMsg: []string{"extends", "agent 'missing' not found"},
+		},
+		{
+			name:   "unknown mixin",
+			agents: map[string]*AgentConfig{"a": {Mixins: []string{"missing"}}},
+			errMsg: []string{"mixins[0]", "mixin 'missing' not found"},
+		},
+		{
+			name:   "duplicate mixin",
+			agents: map[string]*AgentConfig{"a": {Mixins: []string{"m", "m"}}},
+			mixins: map[string]AgentConfig{"m": {}},
+			errMsg: []string{"mixins[1]", "listed twice"},
+		},
+		{
+			name:   "mixin extends",
+			agents: map[string]*AgentConfig{"a": {}},
+			mixins: map[string]AgentConfig{"m": {Extends: "a"}},
+			errMsg: []string{"mixins cannot extend agents"},
+		},
+		{
+			name:   "self extends",
+			agents: map[string]*AgentConfig{"a": {Extends: "a"}},
+			errMsg: []string{"composition cycle: a -> a"},
+		},
+		{
+			name: "extends cycle",
+			agents: map[string]*AgentConfig{
+				"a": {Extends: "b"},
+				"b": {Extends: "c"},
+				"c": {Extends: "a"},
+			},
+			errMsg: []string{"composition cycle: a -> b -> c -> a"},
+		},
+		{
+			name:   "conflicting mixin fields",
+			agents: map[string]*AgentConfig{"a": {Mixins: []string{"gemini", "langchain"}}},
+			mixins: map[string]AgentConfig{
+				"gemini":    {LLMBackend: LLMBackendNativeGemini},
+				"langchain": {LLMBackend: LLMBackendLangChain},
+			},
+			errMsg: []string{"llm_backend", "mixins 'gemini' and 'langchain' set conflicting values"},
+		},
+		{
+			name:   "conflicting mixin native tools",
+			agents: map[string]*AgentConfig{"a": {Mixins: []string{"on", "off"}}},
+			mixins: map[string]AgentConfig{
+				"on":  {NativeTools: map[GoogleNativeTool]bool{GoogleNativeToolGoogleSearch: true}},
+				"off": {NativeTools: map[GoogleNativeTool]bool{GoogleNativeToolGoogleSearch: false}},
+			},
+			errMsg: []string{"native_tools.google_search", "conflicting values"},
+		},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resolveAgentComposition(tt.agents, tt.mixins)
+			require.Error(t, err)
+			for _, msg := range tt.errMsg {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
+
+func TestLoadResolvesAgentComposition(t *testing.T) {
+	dir := t.TempDir()
+	tarsyYAML := `
+mcp_servers:
+  prometheus-server:
+    transport:
+      type: "stdio"
+      command: "prometheus-mcp"
+
+agent_mixins:
+  metrics:
+    mcp_servers: ["prometheus-server"]
+    custom_instructions: "Correlate with Prometheus metrics."
+
+agents:
+  k8s-base:
+    mcp_servers: ["kubernetes-server"]
+    custom_instructions: "Investigate the cluster."
+    max_iterations: 10
+  k8s-metrics:
+    extends: k8s-base
+    mixins: ["metrics"]
+    description: "Kubernetes agent with metrics"
+
+agent_chains:
+  k8s:
+    alert_types: ["PodCrash"]
+    stages:
+      - name: "investigate"
+        agents:
+          - name: "k8s-metrics"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tarsy.yaml"), []byte(tarsyYAML), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "llm-providers.yaml"), []byte("llm_providers: {}\n"), 0644))
+
+	cfg, err := load(context.Background(), dir)
+	require.NoError(t, err)
+
+	agent, err := cfg.GetAgent("k8s-metrics")
+	require.NoError(t, err)
+	assert.Equal(t, "Kubernetes agent with metrics", agent.Description)
+	assert.Equal(t, []string{"kubernetes-server", "prometheus-server"}, agent.MCPServers)
+	assert.Equal(t, "Investigate the cluster.\n\nCorrelate with Prometheus metrics.", agent.CustomInstructions)
+	require.NotNil(t, agent.MaxIterations)
+	assert.Equal(t, 10, *agent.MaxIterations)
+
+	t.Run("cycle fails to load", func(t *testing.T) {
+		dir := t.TempDir()
+		cyclic := `
+agents:
+  a:
+    extends: b
+  b:
+    extends: a
+`
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "tarsy.yaml"), []byte(cyclic), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "llm-providers.yaml"), []byte("llm_providers: {}\n"), 0644))
+
+		_, err := load(context.Background(), dir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "composition cycle: a -> b -> a")
+	})
+}
@@ -0,0 +1,108 @@
+package config
+
+import "time"
+
+// BlobBackend selects where the blob store keeps objects.
+type BlobBackend string
+
+// Supported blob store backends.
+const (
+	// BlobBackendPostgres stores objects as PostgreSQL large objects in the
+	// TARSy database. Needs no extra infrastructure.
+	BlobBackendPostgres BlobBackend = "postgres"
+	// BlobBackendS3 stores objects in an S3 (or S3-compatible, e.g. MinIO) bucket.
+	BlobBackendS3 BlobBackend = "s3"
+	// BlobBackendGCS stores objects in a Google Cloud Storage bucket through
+	// its S3-interoperable XML API, authenticated with HMAC keys.
+	BlobBackendGCS BlobBackend = "gcs"
+	// BlobBackendLocal stores objects as files under a directory. Only
+	// suitable for single-pod deployments or a shared volume.
+	BlobBackendLocal BlobBackend = "local"
+)
+
+// IsValid reports whether b is a supported backend.
+func (b BlobBackend) IsValid() bool {
+	switch b {
+	case BlobBackendPostgres, BlobBackendS3, BlobBackendGCS, BlobBackendLocal:
+		return true
+	}
+	return false
+}
+
+// DefaultToolResultOffloadBytes is the default size from which MCP tool
+// results are kept in the blob store instead of the mcp_interactions table.
+const DefaultToolResultOffloadBytes = 1024 * 1024
+
+// DefaultDownloadURLTTL is how long the signed download URLs of trace
+// artifacts stay valid by default.
+const DefaultDownloadURLTTL = 15 * time.Minute
+
+// BlobStoreConfig holds resolved blob store settings. The blob store keeps
+// large binary or document payloads (offloaded tool results, rendered
+// reports) out of the JSONB columns of the main tables.
+type BlobStoreConfig struct {
+	Backend BlobBackend
+	Prefix  string // Prepended to every key, e.g. "tarsy/prod/"
+
+	// ToolResultThresholdBytes is the encoded size from which MCP tool
+	// results are offloaded to the blob store. 0 keeps every result in the
+	// database (default: 1 MiB).
+	ToolResultThresholdBytes int
+
+	// DownloadURLTTL is how long the signed download URLs handed out in
+	// trace responses stay valid (default: 15m).
+	DownloadURLTTL time.Duration
+	// SigningKeyEnv names the environment variable holding the key that
+	// signs download URLs. When unset, each process signs with a random
+	// key, so URLs only work on the replica that issued them.
+	SigningKeyEnv string
+
+	Local LocalBlobStoreConfig
+	S3    S3BlobStoreConfig
+	GCS   GCSBlobStoreConfig
+}
+
+// LocalBlobStoreConfig configures the "local" backend.
+type LocalBlobStoreConfig struct {
+	Dir string `yaml:"dir,omitempty"`
+}
+
+// S3BlobStoreConfig configures the "s3" backend. Credentials are read from
+// the named environment variables.
+type S3BlobStoreConfig struct {
+	Bucket          string `yaml:"bucket,omitempty"`
+	Region          string `yaml:"region,omitempty"`
+	Endpoint        string `yaml:"endpoint,omitempty"`   // Default: https://s3.<region>.amazonaws.com
+	PathStyle       bool   `yaml:"path_style,omitempty"` // Address the bucket in the path (MinIO and most S3-compatible stores)
+	AccessKeyEnv    string `yaml:"access_key_env,omitempty"`
+	SecretKeyEnv    string `yaml:"secret_key_env,omitempty"`
+	SessionTokenEnv string `yaml:"session_token_env,omitempty"` // Optional; sent when the variable is set
+}
+
+// GCSBlobStoreConfig configures the "gcs" backend. The HMAC key pair is
+// read from the named environment variables.
+type GCSBlobStoreConfig struct {
+	Bucket      string `yaml:"bucket,omitempty"`
+	AccessIDEnv string `yaml:"access_id_env,omitempty"`
+	SecretEnv   string `yaml:"secret_env,omitempty"`
+}
+
+// DefaultBlobStoreConfig returns the built-in blob store defaults: the
+// postgres backend, offloading tool results of 1 MiB or more.
+func DefaultBlobStoreConfig() *BlobStoreConfig {
+	return &BlobStoreConfig{
+		Backend:                  BlobBackendPostgres,
+		ToolResultThresholdBytes: DefaultToolResultOffloadBytes,
+		DownloadURLTTL:           DefaultDownloadURLTTL,
+		SigningKeyEnv:            "TARSY_BLOB_SIGNING_KEY",
+		S3: S3BlobStoreConfig{
+			AccessKeyEnv:    "AWS_ACCESS_KEY_ID",
+			SecretKeyEnv:    "AWS_SECRET_ACCESS_KEY",
+			SessionTokenEnv: "AWS_SESSION_TOKEN",
+		},
+		GCS: GCSBlobStoreConfig{
+			AccessIDEnv: "GCS_HMAC_ACCESS_ID",
+			SecretEnv:   "GCS_HMAC_SECRET",
+		},
+	}
+}
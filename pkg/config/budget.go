@@ -0,0 +1,31 @@
+package config
+
+import "time"
+
+// DefaultBudgetMinSamples is how many completed sessions a chain needs before
+// its budget gates submissions, unless budget.min_samples says otherwise.
+const DefaultBudgetMinSamples = 5
+
+// ChainBudgetConfig rejects alert submissions whose forecast (the historical
+// average cost and duration of the chain) exceeds a limit. Zero limits are
+// not enforced.
+type ChainBudgetConfig struct {
+	// MaxCostUSD caps the forecast estimated cost. Requires cost estimation.
+	MaxCostUSD float64 `yaml:"max_cost_usd,omitempty"`
+
+	// MaxDuration caps the forecast processing time.
+	MaxDuration time.Duration `yaml:"max_duration,omitempty"`
+
+	// MinSamples is the number of completed sessions the forecast must be
+	// based on before submissions are rejected; with less history the
+	// budget is reported but not enforced. Default DefaultBudgetMinSamples.
+	MinSamples int `yaml:"min_samples,omitempty"`
+}
+
+// EffectiveMinSamples returns MinSamples, or DefaultBudgetMinSamples when unset.
+func (b *ChainBudgetConfig) EffectiveMinSamples() int {
+	if b.MinSamples > 0 {
+		return b.MinSamples
+	}
+	return DefaultBudgetMinSamples
+}
@@ -34,11 +34,12 @@ type BuiltinAgentConfig struct {
 // Built-in agent names. Use these constants instead of string literals
 // when referencing built-in agents in resolvers, executors, and tests.
 const (
-	AgentNameKubernetes  = "KubernetesAgent"
-	AgentNameChat        = "ChatAgent"
-	AgentNameExecSummary = "ExecSummaryAgent"
-	AgentNameSynthesis   = "SynthesisAgent"
-	AgentNameScoring     = "ScoringAgent"
+	AgentNameKubernetes       = "KubernetesAgent"
+	AgentNameChat             = "ChatAgent"
+	AgentNameExecSummary      = "ExecSummaryAgent"
+	AgentNameTechnicalSummary = "TechnicalSummaryAgent"
+	AgentNameSynthesis        = "SynthesisAgent"
+	AgentNameScoring          = "ScoringAgent"
 )
 
 var (
@@ -81,6 +82,11 @@ func initBuiltinAgents() map[string]BuiltinAgentConfig {
 			Type:        AgentTypeExecSummary,
 			// No MCP servers — single-shot, no tools
 		},
+		AgentNameTechnicalSummary: {
+			Description: "Generates technical summary of the investigation",
+			Type:        AgentTypeTechnicalSummary,
+			// No MCP servers — single-shot, no tools
+		},
 		AgentNameScoring: {
 			Description: "Evaluates session quality via a multi-turn LLM conversation",
 			Type:        AgentTypeScoring,
@@ -211,6 +217,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-3.6-flash",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiNativeTools(),
 		},
 		"google-image-flash": {
@@ -218,6 +225,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-3.1-flash-image-preview",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiImageNativeTools(),
 		},
 		"gemini-3-flash": {
@@ -225,6 +233,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-3-flash-preview",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiNativeTools(),
 		},
 		"gemini-3.1-pro": {
@@ -232,6 +241,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-3.1-pro-preview",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiNativeTools(),
 		},
 		"gemini-3.1-flash": {
@@ -239,6 +249,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-3.1-flash-lite",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiNativeTools(),
 		},
 		"gemini-3.6-flash": {
@@ -246,6 +257,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-3.6-flash",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiNativeTools(),
 		},
 		"gemini-3.5-flash": {
@@ -253,6 +265,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-3.5-flash",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiNativeTools(),
 		},
 		"gemini-2.5-flash": {
@@ -260,6 +273,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-2.5-flash",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiNativeTools(),
 		},
 		"gemini-2.5-pro": {
@@ -267,6 +281,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gemini-2.5-pro",
 			APIKeyEnv:           "GOOGLE_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context
+			Multimodal:          true,
 			NativeTools:         geminiNativeTools(),
 		},
 
@@ -276,6 +291,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "gpt-5.2",
 			APIKeyEnv:           "OPENAI_API_KEY",
 			MaxToolResultTokens: 380000, // Conservative for 400K context
+			Multimodal:          true,
 		},
 
 		// --- Anthropic ---
@@ -284,6 +300,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "claude-sonnet-5", // Dateless canonical model ID
 			APIKeyEnv:           "ANTHROPIC_API_KEY",
 			MaxToolResultTokens: 950000, // Conservative for 1M context (GA)
+			Multimodal:          true,
 		},
 
 		// --- xAI ---
@@ -292,6 +309,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			Model:               "grok-4-1-fast-reasoning",
 			APIKeyEnv:           "XAI_API_KEY",
 			MaxToolResultTokens: 1500000, // Conservative for 2M context
+			Multimodal:          true,
 		},
 
 		// --- Vertex AI ---
@@ -301,6 +319,7 @@ func initBuiltinLLMProviders() map[string]LLMProviderConfig {
 			ProjectEnv:          "GOOGLE_CLOUD_PROJECT",  // Standard GCP project ID env var
 			LocationEnv:         "GOOGLE_CLOUD_LOCATION", // Standard GCP location env var
 			MaxToolResultTokens: 950000,                  // Conservative for 1M context (GA)
+			Multimodal:          true,
 		},
 	}
 }
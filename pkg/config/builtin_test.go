@@ -103,6 +103,12 @@ func TestBuiltinAgents(t *testing.T) {
 			wantDesc: "Generates executive summary of the investigation",
 			wantType: AgentTypeExecSummary,
 		},
+		{
+			name:     AgentNameTechnicalSummary,
+			agentID:  AgentNameTechnicalSummary,
+			wantDesc: "Generates technical summary of the investigation",
+			wantType: AgentTypeTechnicalSummary,
+		},
 		{
 			name:     AgentNameScoring,
 			agentID:  AgentNameScoring,
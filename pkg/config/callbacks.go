@@ -0,0 +1,37 @@
+package config
+
+import "time"
+
+// CallbacksConfig controls completion callbacks: a URL registered with an
+// alert submission that TARSy POSTs the final result to once the session
+// reaches a terminal state. Disabled by default.
+type CallbacksConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// AllowedHosts restricts callback URLs to these hosts (and their
+	// subdomains). Empty allows any host.
+	AllowedHosts []string `yaml:"allowed_hosts,omitempty"`
+
+	// MaxAttempts bounds delivery attempts per session, including the first.
+	MaxAttempts int `yaml:"max_attempts"`
+
+	// InitialBackoff is the wait before the first retry; it doubles on each
+	// further retry, capped at MaxBackoff.
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+
+	// Timeout bounds one delivery attempt.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// DefaultCallbacksConfig returns the built-in completion callback defaults:
+// disabled, five attempts with 10s..5m exponential backoff when enabled.
+func DefaultCallbacksConfig() *CallbacksConfig {
+	return &CallbacksConfig{
+		Enabled:        false,
+		MaxAttempts:    5,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     5 * time.Minute,
+		Timeout:        10 * time.Second,
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+// LLMCapabilities overrides what a provider's model supports. Unset fields
+// keep the defaults of DefaultModelCapabilities; vision defaults to the
+// provider's multimodal flag.
+type LLMCapabilities struct {
+	FunctionCalling  *bool `yaml:"function_calling,omitempty"`
+	Streaming        *bool `yaml:"streaming,omitempty"`
+	StructuredOutput *bool `yaml:"structured_output,omitempty"`
+	Vision           *bool `yaml:"vision,omitempty"`
+
+	// ContextWindow is the model's context size in tokens (0 = not declared).
+	ContextWindow int `yaml:"context_window,omitempty"`
+}
+
+// ModelCapabilities is the effective capability matrix of a provider's model,
+// consulted when resolving agent configs to fall back from features the
+// model lacks.
+type ModelCapabilities struct {
+	FunctionCalling  bool
+	Streaming        bool
+	StructuredOutput bool
+	Vision           bool
+	ContextWindow    int
+}
+
+// DefaultModelCapabilities returns the capabilities assumed for a model of
+// the given provider type. Every supported provider type serves models with
+// native function calling, streaming and JSON output; models that lack them
+// (older or self-hosted ones behind an OpenAI-compatible base_url) must say so.
+func DefaultModelCapabilities(LLMProviderType) ModelCapabilities {
+	return ModelCapabilities{
+		FunctionCalling:  true,
+		Streaming:        true,
+		StructuredOutput: true,
+	}
+}
+
+// EffectiveCapabilities returns the provider's defaults with its capability
+// overrides applied.
+func (p *LLMProviderConfig) EffectiveCapabilities() ModelCapabilities {
+	caps := DefaultModelCapabilities(p.Type)
+	caps.Vision = p.Multimodal
+
+	o := p.Capabilities
+	if o == nil {
+		return caps
+	}
+	if o.FunctionCalling != nil {
+		caps.FunctionCalling = *o.FunctionCalling
+	}
+	if o.Streaming != nil {
+		caps.Streaming = *o.Streaming
+	}
+	if o.StructuredOutput != nil {
+		caps.StructuredOutput = *o.StructuredOutput
+	}
+	if o.Vision != nil {
+		caps.Vision = *o.Vision
+	}
+	caps.ContextWindow = o.ContextWindow
+	return caps
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLLMProviderConfig_EffectiveCapabilities(t *testing.T) {
+	no := false
+	yes := true
+
+	t.Run("defaults", func(t *testing.T) {
+		p := &LLMProviderConfig{Type: LLMProviderTypeOpenAI, Model: "gpt"}
+		assert.Equal(t, ModelCapabilities{
+			FunctionCalling:  true,
+			Streaming:        true,
+			StructuredOutput: true,
+		}, p.EffectiveCapabilities())
+	})
+
+	t.Run("vision follows multimodal", func(t *testing.T) {
+		p := &LLMProviderConfig{Type: LLMProviderTypeGoogle, Model: "gemini", Multimodal: true}
+		assert.True(t, p.EffectiveCapabilities().Vision)
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		p := &LLMProviderConfig{
+			Type:       LLMProviderTypeOpenAI,
+			Model:      "local-llama",
+			Multimodal: true,
+			Capabilities: &LLMCapabilities{
+				FunctionCalling: &no,
+				Streaming:       &no,
+				Vision:          &no,
+				ContextWindow:   32768,
+			},
+		}
+		assert.Equal(t, ModelCapabilities{
+			StructuredOutput: true,
+			ContextWindow:    32768,
+		}, p.EffectiveCapabilities())
+
+		p.Capabilities = &LLMCapabilities{Vision: &yes}
+		assert.True(t, p.EffectiveCapabilities().Vision)
+	})
+}
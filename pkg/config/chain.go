@@ -7,12 +7,19 @@ import (
 
 // ChainConfig defines a multi-stage agent chain configuration
 type ChainConfig struct {
-	// Alert types this chain handles (required, min 1)
+	// Alert types this chain handles (required, min 1; never inherited via extends)
 	AlertTypes []string `yaml:"alert_types" validate:"required,min=1"`
 
+	// Base chain whose top-level fields this chain inherits unless set here
+	Extends string `yaml:"extends,omitempty"`
+
 	// Human-readable description
 	Description string `yaml:"description,omitempty"`
 
+	// Team or owner charged for the chain's sessions and LLM usage (cost
+	// attribution); agents with their own owner override it for their calls
+	Owner string `yaml:"owner,omitempty"`
+
 	// Stages to execute (required, min 1)
 	Stages []StageConfig `yaml:"stages" validate:"required,min=1,dive"`
 
@@ -22,12 +29,30 @@ type ChainConfig struct {
 	// Optional scoring configuration
 	Scoring *ScoringConfig `yaml:"scoring,omitempty"`
 
+	// Optional Slack message template (extra fields and buttons)
+	Slack *SlackTemplateConfig `yaml:"slack,omitempty"`
+
+	// Optional email notification recipients and schedule
+	Email *ChainEmailConfig `yaml:"email,omitempty"`
+
+	// Optional notifications on intermediate milestones (Slack thread and/or webhook)
+	MilestoneNotifications *MilestoneNotificationConfig `yaml:"milestone_notifications,omitempty"`
+
+	// Optional export of each session's final result to object storage
+	ResultExport *ResultExportConfig `yaml:"result_export,omitempty"`
+
 	// Chain-level LLM provider override
 	LLMProvider string `yaml:"llm_provider,omitempty"`
 
 	// LLM provider for executive summary generation (overrides LLMProvider for this purpose)
 	ExecutiveSummaryProvider string `yaml:"executive_summary_provider,omitempty"`
 
+	// Optional executive summary customization (prompt, audience, length, style, or off)
+	ExecutiveSummary *ExecutiveSummaryConfig `yaml:"executive_summary,omitempty"`
+
+	// Optional technical summary generated alongside the executive summary
+	TechnicalSummary *TechnicalSummaryConfig `yaml:"technical_summary,omitempty"`
+
 	// Chain-level LLM backend override
 	LLMBackend LLMBackend `yaml:"llm_backend,omitempty"`
 
@@ -37,23 +62,62 @@ type ChainConfig struct {
 	// Chain-level max iterations override
 	MaxIterations *int `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
 
+	// Chain-level max tool calls override
+	MaxToolCalls *int `yaml:"max_tool_calls,omitempty" validate:"omitempty,min=1"`
+
+	// Chain-level generation parameter overrides
+	Generation *GenerationParams `yaml:"generation,omitempty"`
+
 	// Chain-level MCP servers override
 	MCPServers []string `yaml:"mcp_servers,omitempty"`
 
 	// Sub-agents available to orchestrator agents in this chain
 	SubAgents SubAgentRefs `yaml:"sub_agents,omitempty"`
+
+	// Chain-level output language override
+	OutputLanguage string `yaml:"output_language,omitempty"`
+
+	// Service whose on-call engineer is looked up for the chain's sessions
+	// (a key of system.on_call.services); overrides the service named in
+	// the alert data
+	OnCallService string `yaml:"on_call_service,omitempty"`
+
+	// Summaries of earlier sessions of the same alert type in the prompt
+	// (overrides system.recent_history)
+	RecentHistory *ChainRecentHistoryConfig `yaml:"recent_history,omitempty"`
+
+	// Chain-level soft duration thresholds (non-zero fields override defaults)
+	TimeWarnings *TimeWarningsConfig `yaml:"time_warnings,omitempty"`
+
+	// Optional fan-out: additional chains run as sibling sessions for each alert
+	FanOut *FanOutConfig `yaml:"fan_out,omitempty"`
+
+	// Optional cost/duration budget checked against the forecast at submission
+	Budget *ChainBudgetConfig `yaml:"budget,omitempty"`
+
+	// Environment variables the chain needs beyond LLM provider keys (MCP
+	// credentials, webhook tokens, ...), checked at validation and by GET /health
+	RequiredEnv []string `yaml:"required_env,omitempty"`
 }
 
 // StageConfig defines a single stage in a chain
 type StageConfig struct {
+	// Include expands this entry into all stages of the named chain at load
+	// time. An include entry sets no other stage fields.
+	Include string `yaml:"include,omitempty"`
+
 	// Stage name (required)
 	Name string `yaml:"name" validate:"required"`
 
-	// Agents to execute (always use array, min 1)
+	// Agents to execute (always use array, min 1; none for remote stages)
 	// Single agent: [{name: "AgentName"}]
 	// Multiple agents: [{name: "Agent1"}, {name: "Agent2"}]
 	Agents []StageAgentConfig `yaml:"agents" validate:"required,min=1,dive"`
 
+	// Optional delegation of the whole stage to another TARSy instance
+	// (system.federation). A remote stage sets no agents.
+	Remote *RemoteStageConfig `yaml:"remote,omitempty"`
+
 	// Replicas for simple redundancy (default: 1)
 	// Run same agent N times with same config
 	Replicas int `yaml:"replicas,omitempty" validate:"omitempty,min=1"`
@@ -64,6 +128,12 @@ type StageConfig struct {
 	// Stage-level max iterations override
 	MaxIterations *int `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
 
+	// Stage-level max tool calls override
+	MaxToolCalls *int `yaml:"max_tool_calls,omitempty" validate:"omitempty,min=1"`
+
+	// Stage-level generation parameter overrides
+	Generation *GenerationParams `yaml:"generation,omitempty"`
+
 	// Stage-level MCP servers override
 	MCPServers []string `yaml:"mcp_servers,omitempty"`
 
@@ -165,6 +235,19 @@ func (r *ChainRegistry) Has(chainID string) bool {
 	return exists
 }
 
+// Replace swaps in a new set of chain configurations (thread-safe). Used
+// to apply chains registered at runtime; callers validate the set first.
+func (r *ChainRegistry) Replace(chains map[string]*ChainConfig) {
+	copied := make(map[string]*ChainConfig, len(chains))
+	for k, v := range chains {
+		copied[k] = v
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.chains = copied
+}
+
 // Len returns the number of chains in the registry (thread-safe)
 func (r *ChainRegistry) Len() int {
 	r.mu.RLock()
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// resolveChainComposition expands chain composition in place, before
+// validation:
+//   - extends: every top-level field the chain leaves unset is inherited from
+//     the base chain (set fields replace the base's value wholesale;
+//     alert_types is never inherited).
+//   - stages[].include: the entry is replaced by all stages of the named chain.
+//
+// Chains are resolved depth-first, so bases and included chains are fully
+// expanded first. Cycles through either mechanism are rejected.
+func resolveChainComposition(chains map[string]*ChainConfig) error {
+	r := &chainComposer{
+		chains: chains,
+		state:  make(map[string]compositionState, len(chains)),
+	}
+
+	// Sorted for deterministic error reporting
+	ids := make([]string, 0, len(chains))
+	for id := range chains {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if err := r.resolve(id, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type compositionState int
+
+const (
+	compositionUnvisited compositionState = iota
+	compositionVisiting
+	compositionResolved
+)
+
+type chainComposer struct {
+	chains map[string]*ChainConfig
+	state  map[string]compositionState
+}
+
+// resolve expands chain id; path holds the chains currently being resolved,
+// for the cycle message.
+func (r *chainComposer) resolve(id string, path []string) error {
+	if r.state[id] == compositionResolved {
+		return nil
+	}
+	r.state[id] = compositionVisiting
+	path = append(path, id)
+	chain := r.chains[id]
+
+	var stages []StageConfig // stays nil when unset, so extends can inherit it
+	for i, stage := range chain.Stages {
+		if stage.Include == "" {
+			stages = append(stages, stage)
+			continue
+		}
+		field := fmt.Sprintf("stages[%d].include", i)
+		if !isIncludeOnly(stage) {
+			return NewValidationError("chain", id, field, fmt.Errorf("include cannot be combined with other stage fields"))
+		}
+		included, err := r.dependency(id, field, stage.Include, path)
+		if err != nil {
+			return err
+		}
+		stages = append(stages, included.Stages...)
+	}
+	chain.Stages = stages
+
+	if chain.Extends != "" {
+		base, err := r.dependency(id, "extends", chain.Extends, path)
+		if err != nil {
+			return err
+		}
+		inheritUnsetFields(chain, base, "AlertTypes", "Extends")
+	}
+
+	r.state[id] = compositionResolved
+	return nil
+}
+
+// dependency resolves the chain that id references through field.
+func (r *chainComposer) dependency(id, field, target string, path []string) (*ChainConfig, error) {
+	dep, ok := r.chains[target]
+	if !ok {
+		return nil, NewValidationError("chain", id, field, fmt.Errorf("chain '%s' not found", target))
+	}
+	if r.state[target] == compositionVisiting {
+		return nil, NewValidationError("chain", id, field,
+			fmt.Errorf("composition cycle: %s -> %s", strings.Join(path, " -> "), target))
+	}
+	if err := r.resolve(target, path); err != nil {
+		return nil, err
+	}
+	return dep, nil
+}
+
+// isIncludeOnly reports whether stage sets nothing besides include.
+func isIncludeOnly(stage StageConfig) bool {
+	stage.Include = ""
+	return reflect.ValueOf(stage).IsZero()
+}
+
+// inheritUnsetFields fills every zero top-level field of *dst from *src,
+// except the named fields. Set fields replace the base value wholesale.
+func inheritUnsetFields[T any](dst, src *T, skip ...string) {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	for i := 0; i < d.NumField(); i++ {
+		if slices.Contains(skip, d.Type().Field(i).Name) {
+			continue
+		}
+		if f := d.Field(i); f.IsZero() {
+			f.Set(s.Field(i))
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveChainComposition(t *testing.T) {
+	stage := func(name string) StageConfig {
+		return StageConfig{Name: name, Agents: []StageAgentConfig{{Name: "KubernetesAgent"}}}
+	}
+	maxIter := 7
+
+	t.Run("extends inherits unset fields", func(t *testing.T) {
+		chains := map[string]*ChainConfig{
+			"base": {
+				AlertTypes:    []string{"Base"},
+				Description:   "base chain",
+				LLMProvider:   "pro",
+				MaxIterations: &maxIter,
+				MCPServers:    []string{"kubernetes-server"},
+				Chat:          &ChatConfig{Enabled: true, Agent: "ChatAgent"},
+				Stages:        []StageConfig{stage("collect"), stage("diagnose")},
+			},
+			"child": {
+				AlertTypes:  []string{"Child"},
+				Extends:     "base",
+				LLMProvider: "flash",
+			},
+		}
+		require.NoError(t, resolveChainComposition(chains))
+
+		child := chains["child"]
+		assert.Equal(t, []string{"Child"}, child.AlertTypes)
+		assert.Equal(t, "flash", child.LLMProvider, "set field overrides base")
+		assert.Equal(t, "base chain", child.Description)
+		assert.Equal(t, &maxIter, child.MaxIterations)
+		assert.Equal(t, []string{"kubernetes-server"}, child.MCPServers)
+		require.NotNil(t, child.Chat)
+		assert.Equal(t, "ChatAgent", child.Chat.Agent)
+		require.Len(t, child.Stages, 2)
+		assert.Equal(t, "collect", child.Stages[0].Name)
+		assert.Equal(t, "base", child.Extends)
+		assert.Equal(t, "pro", chains["base"].LLMProvider, "base must not be modified")
+	})
+
+	t.Run("extends does not inherit alert types", func(t *testing.T) {
+		chains := map[string]*ChainConfig{
+			"base":  {AlertTypes: []string{"Base"}, Stages: []StageConfig{stage("s")}},
+			"child": {Extends: "base"},
+		}
+		require.NoError(t, resolveChainComposition(chains))
+		assert.Empty(t, chains["child"].AlertTypes)
+	})
+
+	t.Run("child stages replace base stages", func(t *testing.T) {
+		chains := map[string]*ChainConfig{
+			"base":  {AlertTypes: []string{"Base"}, Stages: []StageConfig{stage("collect"), stage("diagnose")}},
+			"child": {AlertTypes: []string{"Child"}, Extends: "base", Stages: []StageConfig{stage("triage")}},
+		}
+		require.NoError(t, resolveChainComposition(chains))
+		require.Len(t, chains["child"].Stages, 1)
+		assert.Equal(t, "triage", chains["child"].Stages[0].Name)
+	})
+
+	t.Run("include expands stages in place", func(t *testing.T) {
+		chains := map[string]*ChainConfig{
+			"collection": {AlertTypes: []string{"Collect"}, Stages: []StageConfig{stage("pods"), stage("events")}},
+			"full": {
+				AlertTypes: []string{"Full"},
+				Stages:     []StageConfig{stage("triage"), {Include: "collection"}, stage("diagnose")},
+			},
+		}
+		require.NoError(t, resolveChainComposition(chains))
+
+		var names []string
+		for _, s := range chains["full"].Stages {
+			names = append(names, s.Name)
+			assert.Empty(t, s.Include)
+		}
+		assert.Equal(t, []string{"triage", "pods", "events", "diagnose"}, names)
+	})
+
+	t.Run("transitive composition resolves dependencies first", func(t *testing.T) {
+		chains := map[string]*ChainConfig{
+			"a": {AlertTypes: []string{"A"}, Extends: "b"},
+			"b": {AlertTypes: []string{"B"}, LLMProvider: "pro", Stages: []StageConfig{{Include: "c"}, stage("b-stage")}},
+			"c": {AlertTypes: []string{"C"}, Stages: []StageConfig{stage("c-stage")}},
+		}
+		require.NoError(t, resolveChainComposition(chains))
+		require.Len(t, chains["a"].Stages, 2)
+		assert.Equal(t, "c-stage", chains["a"].Stages[0].Name)
+		assert.Equal(t, "pro", chains["a"].LLMProvider)
+	})
+
+	errTests := []struct {
+		name   string
+		chains map[string]*ChainConfig
+		errMsg []string
+	}{
+		{
+			name:   "unknown base",
+			chains: map[string]*ChainConfig{"child": {AlertTypes: []string{"C"}, Extends: "missing"}},
+			errMsg: []string{"extends", "chain 'missing' not found"},
+		},
+		{
+			name:   "unknown include",
+			chains: map[string]*ChainConfig{"c": {AlertTypes: []string{"C"}, Stages: []StageConfig{{Include: "missing"}}}},
+			errMsg: []string{"stages[0].include", "chain 'missing' not found"},
+		},
+		{
+			name: "include mixed with stage fields",
+			chains: map[string]*ChainConfig{
+				"a": {AlertTypes: []string{"A"}, Stages: []StageConfig{stage("s")}},
+				"b": {AlertTypes: []string{"B"}, Stages: []StageConfig{{Include: "a", Name: "x"}}},
+			},
+			errMsg: []string{"include cannot be combined"},
+		},
+		{
+			name:   "self extends",
+			chains: map[string]*ChainConfig{"a": {AlertTypes: []string{"A"}, Extends: "a"}},
+			errMsg: []string{"composition cycle: a -> a"},
+		},
+		{
+			name: "extends cycle",
+			chains: map[string]*ChainConfig{
+				"a": {AlertTypes: []string{"A"}, Extends: "b"},
+				"b": {AlertTypes: []string{"B"}, Extends: "a"},
+			},
+			errMsg: []string{"composition cycle: a -> b -> a"},
+		},
+		{
+			name: "cycle through include and extends",
+			chains: map[string]*ChainConfig{
+				"a": {AlertTypes: []string{"A"}, Stages: []StageConfig{{Include: "b"}}},
+				"b": {AlertTypes: []string{"B"}, Extends: "c"},
+				"c": {AlertTypes: []string{"C"}, Stages: []StageConfig{{Include: "a"}}},
+			},
+			errMsg: []string{"composition cycle: a -> b -> c -> a"},
+		},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resolveChainComposition(tt.chains)
+			require.Error(t, err)
+			for _, msg := range tt.errMsg {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
+
+func TestLoadResolvesChainComposition(t *testing.T) {
+	dir := t.TempDir()
+	tarsyYAML := `
+agents:
+  test-agent:
+    mcp_servers: []
+
+agent_chains:
+  base-k8s:
+    alert_types: ["PodCrash"]
+    llm_provider: "google-default"
+    stages:
+      - name: "collect"
+        agents:
+          - name: "test-agent"
+      - name: "diagnose"
+        agents:
+          - name: "test-agent"
+  oom-k8s:
+    alert_types: ["PodOOM"]
+    extends: base-k8s
+    max_iterations: 5
+  escalation:
+    alert_types: ["Escalation"]
+    stages:
+      - include: base-k8s
+      - name: "page"
+        agents:
+          - name: "test-agent"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tarsy.yaml"), []byte(tarsyYAML), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "llm-providers.yaml"), []byte("llm_providers: {}\n"), 0644))
+
+	cfg, err := load(context.Background(), dir)
+	require.NoError(t, err)
+
+	oom, err := cfg.GetChainByAlertType("PodOOM")
+	require.NoError(t, err)
+	assert.Equal(t, "google-default", oom.LLMProvider)
+	require.NotNil(t, oom.MaxIterations)
+	assert.Equal(t, 5, *oom.MaxIterations)
+	assert.Len(t, oom.Stages, 2)
+
+	escalation, err := cfg.GetChain("escalation")
+	require.NoError(t, err)
+	require.Len(t, escalation.Stages, 3)
+	assert.Equal(t, "collect", escalation.Stages[0].Name)
+	assert.Equal(t, "page", escalation.Stages[2].Name)
+
+	t.Run("cycle fails to load", func(t *testing.T) {
+		dir := t.TempDir()
+		cyclic := `
+agent_chains:
+  a:
+    alert_types: ["A"]
+    extends: b
+  b:
+    alert_types: ["B"]
+    extends: a
+`
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "tarsy.yaml"), []byte(cyclic), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "llm-providers.yaml"), []byte("llm_providers: {}\n"), 0644))
+
+		_, err := load(context.Background(), dir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "composition cycle")
+	})
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits for chat.guardrails. Each rule is a bullet in the chat agent's
+// system prompt, so rules are short single lines.
+const (
+	MaxChatGuardrails      = 20
+	MaxChatGuardrailLength = 500
+)
+
+// ValidateChatGuardrails checks a chain's chat guardrail rules: at most
+// MaxChatGuardrails non-blank, single-line, distinct rules of at most
+// MaxChatGuardrailLength characters.
+func ValidateChatGuardrails(rules []string) error {
+	if len(rules) > MaxChatGuardrails {
+		return fmt.Errorf("at most %d rules are allowed, got %d", MaxChatGuardrails, len(rules))
+	}
+	seen := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		switch {
+		case rule == "":
+			return fmt.Errorf("rule %d must not be blank", i)
+		case utf8.RuneCountInString(rule) > MaxChatGuardrailLength:
+			return fmt.Errorf("rule %d must be at most %d characters", i, MaxChatGuardrailLength)
+		case strings.ContainsAny(rule, "\r\n"):
+			return fmt.Errorf("rule %d must be a single line", i)
+		case seen[rule]:
+			return fmt.Errorf("rule %d duplicates an earlier rule", i)
+		}
+		seen[rule] = true
+	}
+	return nil
+}
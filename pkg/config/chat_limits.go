@@ -0,0 +1,34 @@
+package config
+
+// ChatLimitsConfig protects follow-up chat from runaway clients. Rates are
+// per pod and count chat messages over a sliding minute. A message that
+// cannot start yet — its chat's previous response is still being generated,
+// or the pod is at MaxConcurrentExecutions — is queued (one per chat, in
+// arrival order across sessions); the rest are rejected with 429.
+type ChatLimitsConfig struct {
+	// PerUserPerMinute caps messages from one user across all sessions
+	// (0 = unlimited).
+	PerUserPerMinute int
+
+	// PerSessionPerMinute caps messages on one session from all users
+	// (0 = unlimited).
+	PerSessionPerMinute int
+
+	// MaxConcurrentExecutions caps chat responses generated at once on this
+	// pod (0 = unlimited).
+	MaxConcurrentExecutions int
+
+	// MaxQueuedMessages caps chat messages waiting to start on this pod
+	// (0 = unlimited).
+	MaxQueuedMessages int
+}
+
+// DefaultChatLimitsConfig returns the built-in chat limits.
+func DefaultChatLimitsConfig() *ChatLimitsConfig {
+	return &ChatLimitsConfig{
+		PerUserPerMinute:        10,
+		PerSessionPerMinute:     20,
+		MaxConcurrentExecutions: 10,
+		MaxQueuedMessages:       50,
+	}
+}
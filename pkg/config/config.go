@@ -21,12 +21,97 @@ type Config struct {
 	// Slack notification configuration (resolved from system.slack)
 	Slack *SlackConfig
 
+	// PagerDuty notification configuration (resolved from system.pagerduty)
+	PagerDuty *PagerDutyConfig
+
+	// Severity-based notification routing (resolved from system.notification_routing)
+	NotificationRouting *NotificationRoutingConfig
+
+	// Email notification configuration (resolved from system.email)
+	Email *EmailConfig
+
+	// Periodic activity report configuration (resolved from system.reports)
+	Reports *ReportsConfig
+
 	// Cost estimation configuration (resolved from system.cost_estimation)
 	CostEstimation *CostEstimationConfig
 
+	// At-rest compression of large payloads (resolved from system.compression)
+	Compression *CompressionConfig
+
+	// Blob store for offloaded tool results and rendered reports (resolved from system.blob_store)
+	BlobStore *BlobStoreConfig
+
 	// Retention and cleanup configuration (resolved from system.retention)
 	Retention *RetentionConfig
 
+	// Triage-only degradation profile (resolved from system.degradation)
+	Degradation *DegradationConfig
+
+	// Automatic model selection by alert complexity (resolved from system.model_routing)
+	ModelRouting *ModelRoutingConfig
+
+	// Triage pre-chain for noisy alert types (resolved from system.noise_triage)
+	NoiseTriage *NoiseTriageConfig
+
+	// Speech-to-text for chat voice notes (resolved from system.transcription)
+	Transcription *TranscriptionConfig
+
+	// Completion callbacks registered at alert submission (resolved from system.callbacks)
+	Callbacks *CallbacksConfig
+
+	// Session lifecycle events published as CloudEvents (resolved from system.event_stream)
+	EventStream *EventStreamConfig
+
+	// Feature flag rollouts by flag name, built-in defaults applied
+	// (resolved from system.feature_flags). Runtime overrides live in the database.
+	FeatureFlags map[string]*FeatureFlagConfig
+
+	// Per-subsystem log levels and debug-log redaction (resolved from system.logging)
+	Logging *LoggingConfig
+
+	// Callers allowed to use the admin endpoints of the API (resolved from system.admins)
+	Admins []string
+
+	// Simulated MCP and LLM failures for resilience testing (resolved from system.fault_injection)
+	FaultInjection *FaultInjectionConfig
+
+	// pprof admin allowlist and automatic heap-profile capture (resolved from system.profiling)
+	Profiling *ProfilingConfig
+
+	// Review queue for suspected secrets that masking missed (resolved from system.redaction_review)
+	RedactionReview *RedactionReviewConfig
+
+	// Stage delegation to and from other TARSy instances (resolved from system.federation)
+	Federation *FederationConfig
+
+	// Clusters alert submissions may target (resolved from system.targets)
+	Targets *TargetsConfig
+
+	// Chat message rate limits and concurrency cap (resolved from system.chat_limits)
+	ChatLimits *ChatLimitsConfig
+
+	// Comparison of recurring alerts with their previous investigation (resolved from system.recurrence)
+	Recurrence *RecurrenceConfig
+
+	// Outcome taxonomy labelling of completed sessions (resolved from system.outcome_classification)
+	OutcomeClassification *OutcomeClassificationConfig
+
+	// Follow-up action item extraction from completed sessions (resolved from system.action_items)
+	ActionItems *ActionItemsConfig
+
+	// Current on-call lookup for the alert's service (resolved from system.on_call)
+	OnCall *OnCallConfig
+
+	// Dashboard and log link templates for the generate_link tool (resolved from system.deep_links)
+	DeepLinks *DeepLinksConfig
+
+	// IP and host lookup tool sources (resolved from system.enrichment)
+	Enrichment *EnrichmentConfig
+
+	// Prior-session summaries in investigation prompts (resolved from system.recent_history)
+	RecentHistory *RecentHistoryConfig
+
 	// Base URL for dashboard links (default: "http://localhost:5173")
 	DashboardURL string
 
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"regexp"
+	"slices"
+	"time"
+)
+
+// DeepLinkTimePlaceholders are filled with the link's time range rather
+// than agent-supplied parameters: {from} and {to} as RFC 3339 UTC
+// timestamps (Kibana), {from_ms} and {to_ms} as Unix milliseconds (Grafana,
+// Loki).
+var DeepLinkTimePlaceholders = []string{"from", "to", "from_ms", "to_ms"}
+
+// deepLinkPlaceholderPattern matches {name} placeholders in link templates.
+// Braces around anything else (e.g. the JSON of a Grafana Explore URL) are
+// left alone.
+var deepLinkPlaceholderPattern = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+
+// deepLinkNamePattern is the allowed form of template names.
+var deepLinkNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// DeepLinksConfig holds URL templates for Grafana dashboards, Loki queries,
+// Kibana searches and similar tools. Agents get a generate_link tool that
+// fills a template with parameters and the investigation's time range, so
+// analyses carry clickable links instead of prose directions. Disabled
+// unless templates are configured.
+type DeepLinksConfig struct {
+	// DefaultLookback is how far before the alert arrived a link's time
+	// range starts when the agent gives no explicit range. The range ends now.
+	DefaultLookback time.Duration
+
+	// Templates maps template names to URL templates.
+	Templates map[string]*DeepLinkTemplate
+}
+
+// DeepLinkTemplate is one URL template.
+type DeepLinkTemplate struct {
+	// Description tells agents what the link shows (e.g. "Grafana pod
+	// resources dashboard").
+	Description string `yaml:"description"`
+
+	// URL with {placeholder} references. Time placeholders are filled with
+	// the link's range; every other placeholder is a parameter the agent
+	// must supply. Parameter values are URL-encoded.
+	URL string `yaml:"url"`
+}
+
+// Params returns the template's agent-supplied parameters in order of first
+// appearance.
+func (t *DeepLinkTemplate) Params() []string {
+	var params []string
+	for _, m := range deepLinkPlaceholderPattern.FindAllStringSubmatch(t.URL, -1) {
+		name := m[1]
+		if !slices.Contains(DeepLinkTimePlaceholders, name) && !slices.Contains(params, name) {
+			params = append(params, name)
+		}
+	}
+	return params
+}
+
+// Expand substitutes values for the template's placeholders. Placeholders
+// without a value are left as-is.
+func (t *DeepLinkTemplate) Expand(values map[string]string) string {
+	return deepLinkPlaceholderPattern.ReplaceAllStringFunc(t.URL, func(m string) string {
+		if v, ok := values[m[1:len(m)-1]]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+// DeepLinksYAMLConfig holds deep link settings from YAML.
+type DeepLinksYAMLConfig struct {
+	DefaultLookback time.Duration                `yaml:"default_lookback,omitempty"`
+	Templates       map[string]*DeepLinkTemplate `yaml:"templates,omitempty"`
+}
+
+// DefaultDeepLinksConfig returns the built-in deep link defaults: no
+// templates, a one hour lookback.
+func DefaultDeepLinksConfig() *DeepLinksConfig {
+	return &DeepLinksConfig{DefaultLookback: time.Hour}
+}
+
+// Enabled reports whether any link template is configured.
+func (c *DeepLinksConfig) Enabled() bool {
+	return c != nil && len(c.Templates) > 0
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeepLinkTemplate(t *testing.T) {
+	tmpl := &DeepLinkTemplate{
+		URL: `https://grafana.example.com/explore?left={"queries":[{"expr":"{query}"}],"range":{"from":"{from_ms}","to":"{to_ms}"}}&ns={namespace}&again={query}`,
+	}
+
+	t.Run("params exclude time placeholders and JSON braces", func(t *testing.T) {
+		assert.Equal(t, []string{"query", "namespace"}, tmpl.Params())
+	})
+
+	t.Run("expand", func(t *testing.T) {
+		got := tmpl.Expand(map[string]string{"query": "Q", "from_ms": "1", "to_ms": "2"})
+		assert.Equal(t, `https://grafana.example.com/explore?left={"queries":[{"expr":"Q"}],"range":{"from":"1","to":"2"}}&ns={namespace}&again=Q`, got)
+	})
+
+	t.Run("no params", func(t *testing.T) {
+		assert.Empty(t, (&DeepLinkTemplate{URL: "https://kibana.example.com/app/discover#/?_g=(time:(from:'{from}',to:'{to}'))"}).Params())
+	})
+}
@@ -9,6 +9,10 @@ type Defaults struct {
 	// Max iterations default (forces conclusion when reached, no pause/resume)
 	MaxIterations *int `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
 
+	// Times an identical tool call (same tool and arguments) may run within
+	// an execution; repeats beyond it get the prior result and a warning
+	MaxDuplicateToolCalls *int `yaml:"max_duplicate_tool_calls,omitempty" validate:"omitempty,min=1"`
+
 	// LLM backend default
 	LLMBackend LLMBackend `yaml:"llm_backend,omitempty"`
 
@@ -26,6 +30,9 @@ type Defaults struct {
 	// Default alert type for new sessions (application state default)
 	AlertType string `yaml:"alert_type,omitempty"`
 
+	// Chains for alerts whose type matches no chain, per target namespace
+	FallbackChains *FallbackChainsConfig `yaml:"fallback_chains,omitempty"`
+
 	// Default runbook content for new sessions (application state default)
 	Runbook string `yaml:"runbook,omitempty"`
 
@@ -37,6 +44,17 @@ type Defaults struct {
 
 	// Investigation memory configuration
 	Memory *MemoryConfig `yaml:"memory,omitempty"`
+
+	// Language for final analyses, executive summaries, and chat answers
+	// (e.g. "Japanese"). Empty leaves the choice to the model.
+	OutputLanguage string `yaml:"output_language,omitempty"`
+
+	// Offer agents with MCP servers the mark_key_finding tool, which
+	// highlights a conclusion on the session timeline.
+	KeyFindingsTool bool `yaml:"key_findings_tool,omitempty"`
+
+	// Soft stage/agent duration thresholds that emit warnings
+	TimeWarnings *TimeWarningsConfig `yaml:"time_warnings,omitempty"`
 }
 
 // AlertMaskingDefaults holds alert payload masking settings.
@@ -0,0 +1,37 @@
+package config
+
+import "time"
+
+// DegradationConfig controls the triage-only degradation profile. When a
+// chain's preferred LLM provider is failing, new sessions run a reduced
+// profile (first stage, first agent, fewer iterations, no synthesis)
+// instead of failing outright. Disabled by default.
+type DegradationConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// FailureThreshold is the number of consecutive failed LLM calls after
+	// which a provider is considered degraded.
+	FailureThreshold int `yaml:"failure_threshold"`
+
+	// Cooldown is how long a provider stays degraded after its last failure
+	// when no successful call has been observed in the meantime.
+	Cooldown time.Duration `yaml:"cooldown"`
+
+	// MaxIterations caps the triage agent's iterations.
+	MaxIterations int `yaml:"max_iterations"`
+
+	// LLMProvider optionally runs the triage profile on a different (e.g.
+	// cheaper) provider. Empty keeps the chain's resolved provider, relying
+	// on its fallback_providers.
+	LLMProvider string `yaml:"llm_provider,omitempty"`
+}
+
+// DefaultDegradationConfig returns the built-in degradation defaults.
+func DefaultDegradationConfig() *DegradationConfig {
+	return &DegradationConfig{
+		Enabled:          false,
+		FailureThreshold: 3,
+		Cooldown:         5 * time.Minute,
+		MaxIterations:    5,
+	}
+}
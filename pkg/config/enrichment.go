@@ -0,0 +1,69 @@
+package config
+
+import (
+	"path/filepath"
+	"time"
+)
+
+// EnrichmentConfig configures the built-in lookup tools that spare
+// network-related investigations an MCP server for trivial lookups:
+// lookup_ip (IP → owner, ASN, location) and lookup_host (hostname → asset
+// inventory record). Each tool is offered only when its source is set.
+type EnrichmentConfig struct {
+	// IPLookup is the source of IP ownership records (lookup_ip).
+	IPLookup *EnrichmentSourceConfig
+
+	// HostLookup is the source of asset inventory records (lookup_host).
+	HostLookup *EnrichmentSourceConfig
+}
+
+// EnrichmentSourceConfig is a CSV file or an HTTP endpoint. Exactly one of
+// CSVFile and URL is set.
+type EnrichmentSourceConfig struct {
+	// CSVFile is a CSV file with a header row. IP sources need a "cidr"
+	// column (a single address is a /32 or /128); host sources need a
+	// "hostname" column. Every other column is returned as a record field.
+	// Relative paths are resolved against the config directory. Read at
+	// startup.
+	CSVFile string `yaml:"csv_file,omitempty"`
+
+	// URL is an HTTP GET endpoint returning the record as JSON, with an {ip}
+	// or {hostname} placeholder. A 404 means no record.
+	URL string `yaml:"url,omitempty"`
+
+	// TokenEnv names an env var holding a bearer token sent to URL.
+	TokenEnv string `yaml:"token_env,omitempty"`
+
+	// Timeout bounds one HTTP lookup (default: 10s).
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// EnrichmentYAMLConfig holds lookup tool settings from YAML.
+type EnrichmentYAMLConfig struct {
+	IPLookup   *EnrichmentSourceConfig `yaml:"ip_lookup,omitempty"`
+	HostLookup *EnrichmentSourceConfig `yaml:"host_lookup,omitempty"`
+}
+
+// DefaultEnrichmentTimeout bounds one HTTP lookup when no timeout is set.
+const DefaultEnrichmentTimeout = 10 * time.Second
+
+// Enabled reports whether any lookup source is configured.
+func (c *EnrichmentConfig) Enabled() bool {
+	return c != nil && (c.IPLookup != nil || c.HostLookup != nil)
+}
+
+// resolveEnrichmentSource applies defaults to a lookup source and makes a
+// relative CSV path absolute against the config directory.
+func resolveEnrichmentSource(src *EnrichmentSourceConfig, configDir string) *EnrichmentSourceConfig {
+	if src == nil {
+		return nil
+	}
+	resolved := *src
+	if resolved.CSVFile != "" && !filepath.IsAbs(resolved.CSVFile) {
+		resolved.CSVFile = filepath.Join(configDir, resolved.CSVFile)
+	}
+	if resolved.URL != "" && resolved.Timeout == 0 {
+		resolved.Timeout = DefaultEnrichmentTimeout
+	}
+	return &resolved
+}
@@ -10,6 +10,8 @@ const (
 	AgentTypeSynthesis AgentType = "synthesis"
 	// AgentTypeExecSummary generates an executive summary of the investigation (single-shot)
 	AgentTypeExecSummary AgentType = "exec_summary"
+	// AgentTypeTechnicalSummary generates a technical summary of the investigation (single-shot)
+	AgentTypeTechnicalSummary AgentType = "technical_summary"
 	// AgentTypeScoring evaluates session quality (single-shot)
 	AgentTypeScoring AgentType = "scoring"
 	// AgentTypeAction evaluates findings and executes remediation actions (iterating controller)
@@ -19,7 +21,7 @@ const (
 // IsValid checks if the agent type is valid (empty string is valid — means default).
 func (t AgentType) IsValid() bool {
 	switch t {
-	case AgentTypeDefault, AgentTypeSynthesis, AgentTypeExecSummary, AgentTypeScoring, AgentTypeAction:
+	case AgentTypeDefault, AgentTypeSynthesis, AgentTypeExecSummary, AgentTypeTechnicalSummary, AgentTypeScoring, AgentTypeAction:
 		return true
 	default:
 		return false
@@ -147,3 +149,150 @@ func CanonicalGoogleNativeToolWireName(name string) string {
 	}
 	return name
 }
+
+// Severity is the incident severity classified by the executive summary agent.
+// Drives notification routing (system.notification_routing).
+type Severity string
+
+const (
+	// SeverityCritical is a customer-facing outage or data-loss risk
+	SeverityCritical Severity = "critical"
+	// SeverityHigh is significant degradation requiring prompt attention
+	SeverityHigh Severity = "high"
+	// SeverityMedium is a contained issue that should be handled during working hours
+	SeverityMedium Severity = "medium"
+	// SeverityLow is informational or self-resolved
+	SeverityLow Severity = "low"
+)
+
+// severityRank orders severities from least to most severe.
+var severityRank = map[Severity]int{
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+// IsValid checks if the severity is a known value (empty string is NOT valid).
+func (s Severity) IsValid() bool {
+	_, ok := severityRank[s]
+	return ok
+}
+
+// AtLeast reports whether s is as severe as or more severe than other.
+// Unknown severities are never at least anything.
+func (s Severity) AtLeast(other Severity) bool {
+	r, ok := severityRank[s]
+	if !ok {
+		return false
+	}
+	return r >= severityRank[other]
+}
+
+// EmailSchedule controls when a chain's email notifications are sent.
+type EmailSchedule string
+
+const (
+	// EmailScheduleImmediate sends one email per session as soon as it finishes
+	EmailScheduleImmediate EmailSchedule = "immediate"
+	// EmailScheduleHourly batches finished sessions into an hourly digest
+	EmailScheduleHourly EmailSchedule = "hourly"
+	// EmailScheduleDaily batches finished sessions into a daily digest
+	EmailScheduleDaily EmailSchedule = "daily"
+)
+
+// IsValid checks if the email schedule is valid
+func (s EmailSchedule) IsValid() bool {
+	switch s {
+	case EmailScheduleImmediate, EmailScheduleHourly, EmailScheduleDaily:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReportPeriod is the window covered by a periodic activity report.
+type ReportPeriod string
+
+const (
+	// ReportPeriodDaily covers the previous UTC day
+	ReportPeriodDaily ReportPeriod = "daily"
+	// ReportPeriodWeekly covers the seven UTC days before the report weekday
+	ReportPeriodWeekly ReportPeriod = "weekly"
+)
+
+// IsValid checks if the report period is valid
+func (p ReportPeriod) IsValid() bool {
+	switch p {
+	case ReportPeriodDaily, ReportPeriodWeekly:
+		return true
+	default:
+		return false
+	}
+}
+
+// MilestoneEvent is an intermediate session milestone that can trigger a
+// notification before the session finishes.
+type MilestoneEvent string
+
+const (
+	// MilestoneFirstToolCall fires when the first agent tool call of the session executes
+	MilestoneFirstToolCall MilestoneEvent = "first_tool_call"
+	// MilestoneStageCompleted fires whenever a chain stage (or its synthesis) completes
+	MilestoneStageCompleted MilestoneEvent = "stage_completed"
+	// MilestoneFinalAnalysis fires once the final analysis is available, before the executive summary
+	MilestoneFinalAnalysis MilestoneEvent = "final_analysis"
+)
+
+// IsValid checks if the milestone event is valid
+func (e MilestoneEvent) IsValid() bool {
+	switch e {
+	case MilestoneFirstToolCall, MilestoneStageCompleted, MilestoneFinalAnalysis:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrphanRecoveryMode controls how sessions whose worker stopped heartbeating
+// are recovered.
+type OrphanRecoveryMode string
+
+const (
+	// OrphanRecoveryFail marks orphaned sessions as timed_out
+	OrphanRecoveryFail OrphanRecoveryMode = "fail"
+	// OrphanRecoveryRequeue discards the partial run and returns the session to pending
+	OrphanRecoveryRequeue OrphanRecoveryMode = "requeue"
+)
+
+// IsValid checks if the orphan recovery mode is valid
+func (m OrphanRecoveryMode) IsValid() bool {
+	switch m {
+	case OrphanRecoveryFail, OrphanRecoveryRequeue:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReasoningEffort controls how much a reasoning model thinks before answering.
+type ReasoningEffort string
+
+const (
+	// ReasoningEffortLow favors latency and cost over depth
+	ReasoningEffortLow ReasoningEffort = "low"
+	// ReasoningEffortMedium balances depth against latency
+	ReasoningEffortMedium ReasoningEffort = "medium"
+	// ReasoningEffortHigh favors depth (the provider default for most reasoning models)
+	ReasoningEffortHigh ReasoningEffort = "high"
+)
+
+// IsValid checks if the reasoning effort is valid (empty string is NOT valid)
+func (e ReasoningEffort) IsValid() bool {
+	switch e {
+	case ReasoningEffortLow, ReasoningEffortMedium, ReasoningEffortHigh:
+		return true
+	default:
+		return false
+	}
+}
@@ -142,3 +142,71 @@ func TestCanonicalGoogleNativeToolWireName(t *testing.T) {
 	assert.Equal(t, "url_context", CanonicalGoogleNativeToolWireName("url_context"))
 	assert.Equal(t, "google_search", CanonicalGoogleNativeToolWireName("google_search"))
 }
+
+func TestSeverityIsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity Severity
+		valid    bool
+	}{
+		{"critical", SeverityCritical, true},
+		{"high", SeverityHigh, true},
+		{"medium", SeverityMedium, true},
+		{"low", SeverityLow, true},
+		{"uppercase", Severity("HIGH"), false},
+		{"empty", Severity(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, tt.severity.IsValid())
+		})
+	}
+}
+
+func TestSeverityAtLeast(t *testing.T) {
+	assert.True(t, SeverityCritical.AtLeast(SeverityHigh))
+	assert.True(t, SeverityHigh.AtLeast(SeverityHigh))
+	assert.False(t, SeverityMedium.AtLeast(SeverityHigh))
+	assert.True(t, SeverityLow.AtLeast(SeverityLow))
+	assert.False(t, Severity("unknown").AtLeast(SeverityLow))
+}
+
+func TestEmailScheduleIsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule EmailSchedule
+		valid    bool
+	}{
+		{"immediate", EmailScheduleImmediate, true},
+		{"hourly", EmailScheduleHourly, true},
+		{"daily", EmailScheduleDaily, true},
+		{"weekly", EmailSchedule("weekly"), false},
+		{"empty", EmailSchedule(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, tt.schedule.IsValid())
+		})
+	}
+}
+
+func TestReportPeriodIsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		period ReportPeriod
+		valid  bool
+	}{
+		{"daily", ReportPeriodDaily, true},
+		{"weekly", ReportPeriodWeekly, true},
+		{"monthly", ReportPeriod("monthly"), false},
+		{"empty", ReportPeriod(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.valid, tt.period.IsValid())
+		})
+	}
+}
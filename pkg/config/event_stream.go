@@ -0,0 +1,91 @@
+package config
+
+import (
+	"slices"
+	"time"
+)
+
+// EventStreamMode selects the CloudEvents HTTP content mode.
+type EventStreamMode string
+
+const (
+	// EventStreamModeStructured sends the whole CloudEvent as the JSON body
+	// (Content-Type: application/cloudevents+json).
+	EventStreamModeStructured EventStreamMode = "structured"
+	// EventStreamModeBinary sends the event data as the body and the
+	// attributes as ce-* headers (what Knative brokers and most Kafka HTTP
+	// bridges map onto record headers).
+	EventStreamModeBinary EventStreamMode = "binary"
+)
+
+// IsValid reports whether m is a known content mode.
+func (m EventStreamMode) IsValid() bool {
+	return m == EventStreamModeStructured || m == EventStreamModeBinary
+}
+
+// Session lifecycle events published to the event stream.
+const (
+	StreamEventSessionCreated  = "session.created"
+	StreamEventSessionStarted  = "session.started"
+	StreamEventStageCompleted  = "stage.completed"
+	StreamEventSessionTerminal = "session.terminal"
+)
+
+// StreamEvents lists every event the stream can publish.
+var StreamEvents = []string{
+	StreamEventSessionCreated,
+	StreamEventSessionStarted,
+	StreamEventStageCompleted,
+	StreamEventSessionTerminal,
+}
+
+// EventStreamConfig controls publication of session lifecycle events as
+// CloudEvents over HTTP, for analytics and downstream automation that
+// shouldn't poll the API. Point Endpoint at a Knative broker, a Kafka REST
+// proxy / HTTP bridge, or any CloudEvents-aware receiver. Disabled by default.
+type EventStreamConfig struct {
+	Enabled  bool            `yaml:"enabled"`
+	Endpoint string          `yaml:"endpoint"`
+	Mode     EventStreamMode `yaml:"mode,omitempty"`
+
+	// Source is the CloudEvents "source" attribute (a URI-reference).
+	Source string `yaml:"source,omitempty"`
+
+	// Events restricts publication to these events. Empty publishes all.
+	Events []string `yaml:"events,omitempty"`
+
+	// TokenEnv names an env var holding a bearer token for the endpoint.
+	TokenEnv string `yaml:"token_env,omitempty"`
+
+	// Timeout bounds one delivery attempt.
+	Timeout time.Duration `yaml:"timeout"`
+
+	// MaxAttempts bounds delivery attempts per event, including the first.
+	MaxAttempts int `yaml:"max_attempts"`
+
+	// BufferSize bounds events waiting for delivery. Events published while
+	// the buffer is full are dropped rather than slowing down sessions.
+	BufferSize int `yaml:"buffer_size"`
+}
+
+// DefaultEventStreamConfig returns the built-in event stream defaults:
+// disabled, structured mode, three attempts of 10s each, 1000 buffered events.
+func DefaultEventStreamConfig() *EventStreamConfig {
+	return &EventStreamConfig{
+		Enabled:     false,
+		Mode:        EventStreamModeStructured,
+		Source:      "tarsy",
+		Timeout:     10 * time.Second,
+		MaxAttempts: 3,
+		BufferSize:  1000,
+	}
+}
+
+// WantsEvent reports whether the stream publishes the named event.
+// Nil-safe: a nil or disabled config publishes nothing.
+func (c *EventStreamConfig) WantsEvent(name string) bool {
+	if c == nil || !c.Enabled {
+		return false
+	}
+	return len(c.Events) == 0 || slices.Contains(c.Events, name)
+}
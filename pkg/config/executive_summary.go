@@ -0,0 +1,141 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Executive summary length bounds, in lines.
+const (
+	DefaultExecSummaryMaxLines = 4
+	maxExecSummaryMaxLines     = 20
+)
+
+// ExecSummaryAudience selects who the executive summary is written for.
+type ExecSummaryAudience string
+
+const (
+	// ExecSummaryAudienceExecutive is a brief, non-technical summary for alert notifications (default)
+	ExecSummaryAudienceExecutive ExecSummaryAudience = "executive"
+	// ExecSummaryAudienceEngineer keeps the technical specifics on-call engineers act on
+	ExecSummaryAudienceEngineer ExecSummaryAudience = "engineer"
+)
+
+// IsValid checks if the audience is valid (empty string is valid — means executive).
+func (a ExecSummaryAudience) IsValid() bool {
+	switch a {
+	case "", ExecSummaryAudienceExecutive, ExecSummaryAudienceEngineer:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExecSummaryStyle selects how the executive summary is formatted.
+type ExecSummaryStyle string
+
+const (
+	// ExecSummaryStyleProse writes plain sentences (default)
+	ExecSummaryStyleProse ExecSummaryStyle = "prose"
+	// ExecSummaryStyleBullets writes one "- " bullet per line
+	ExecSummaryStyleBullets ExecSummaryStyle = "bullets"
+)
+
+// IsValid checks if the style is valid (empty string is valid — means prose).
+func (s ExecSummaryStyle) IsValid() bool {
+	switch s {
+	case "", ExecSummaryStyleProse, ExecSummaryStyleBullets:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExecSummaryTemplatePlaceholders are the placeholders a custom executive
+// summary prompt_template may use. {analysis} is required.
+var ExecSummaryTemplatePlaceholders = []string{"analysis", "max_lines"}
+
+// ExecutiveSummaryConfig customizes a chain's executive summary step.
+// A nil config, or unset fields, keep the built-in behavior.
+type ExecutiveSummaryConfig struct {
+	// Enabled turns the step off when false (default: true). Without a
+	// summary the session gets no severity.
+	Enabled *bool `yaml:"enabled,omitempty"`
+
+	// Audience the summary is written for (default: executive)
+	Audience ExecSummaryAudience `yaml:"audience,omitempty"`
+
+	// Style of the summary (default: prose)
+	Style ExecSummaryStyle `yaml:"style,omitempty"`
+
+	// MaxLines caps the summary length (default: 4, max: 20)
+	MaxLines int `yaml:"max_lines,omitempty"`
+
+	// PromptTemplate replaces the built-in user prompt. It must contain
+	// {analysis}; {max_lines} is optional. The severity instruction is
+	// appended automatically.
+	PromptTemplate string `yaml:"prompt_template,omitempty"`
+
+	// TemplateVersion labels the prompt in the sessions it summarizes
+	// (default: derived from the prompt).
+	TemplateVersion string `yaml:"template_version,omitempty"`
+}
+
+// IsEnabled reports whether the executive summary step runs. Safe on nil.
+func (c *ExecutiveSummaryConfig) IsEnabled() bool {
+	return c == nil || c.Enabled == nil || *c.Enabled
+}
+
+// EffectiveMaxLines returns MaxLines or its default. Safe on nil.
+func (c *ExecutiveSummaryConfig) EffectiveMaxLines() int {
+	if c == nil || c.MaxLines == 0 {
+		return DefaultExecSummaryMaxLines
+	}
+	return c.MaxLines
+}
+
+// IsDefault reports whether c changes nothing about the summary prompt.
+// Safe on nil.
+func (c *ExecutiveSummaryConfig) IsDefault() bool {
+	return c == nil ||
+		(c.Audience == "" || c.Audience == ExecSummaryAudienceExecutive) &&
+			(c.Style == "" || c.Style == ExecSummaryStyleProse) &&
+			c.EffectiveMaxLines() == DefaultExecSummaryMaxLines &&
+			c.PromptTemplate == "" &&
+			c.TemplateVersion == ""
+}
+
+// execSummaryPlaceholderPattern matches {placeholder} tokens in a prompt template.
+var execSummaryPlaceholderPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+// validateExecutiveSummary checks a chain's executive_summary block.
+func validateExecutiveSummary(c *ExecutiveSummaryConfig) error {
+	if c == nil {
+		return nil
+	}
+	if !c.Audience.IsValid() {
+		return fmt.Errorf("invalid audience %q (use executive or engineer)", c.Audience)
+	}
+	if !c.Style.IsValid() {
+		return fmt.Errorf("invalid style %q (use prose or bullets)", c.Style)
+	}
+	if c.MaxLines < 0 || c.MaxLines > maxExecSummaryMaxLines {
+		return fmt.Errorf("max_lines must be between 1 and %d", maxExecSummaryMaxLines)
+	}
+	if c.PromptTemplate != "" {
+		if !strings.Contains(c.PromptTemplate, "{analysis}") {
+			return fmt.Errorf("prompt_template must contain {analysis}")
+		}
+		for _, m := range execSummaryPlaceholderPattern.FindAllStringSubmatch(c.PromptTemplate, -1) {
+			if !slices.Contains(ExecSummaryTemplatePlaceholders, m[1]) {
+				return fmt.Errorf("prompt_template: unknown placeholder {%s} (allowed: %s)", m[1], strings.Join(ExecSummaryTemplatePlaceholders, ", "))
+			}
+		}
+	}
+	if strings.TrimSpace(c.TemplateVersion) != c.TemplateVersion {
+		return fmt.Errorf("template_version must not have leading or trailing whitespace")
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package config
+
+// FallbackChainsConfig routes alerts whose type matches no chain. Without
+// it such alerts are rejected at submission. Sessions created through a
+// fallback chain are flagged (fallback_chain), so operators can find the
+// alert types that still need a proper chain.
+type FallbackChainsConfig struct {
+	// Default is the chain for unmatched alerts whose target namespace has
+	// no entry in Namespaces (including untargeted alerts). Empty rejects
+	// them.
+	Default string `yaml:"default,omitempty"`
+
+	// Namespaces maps a target namespace (system.targets) to its fallback
+	// chain.
+	Namespaces map[string]string `yaml:"namespaces,omitempty"`
+}
+
+// ChainFor returns the fallback chain for an alert targeting namespace
+// (empty = untargeted), or "" when unmatched alerts are rejected. Nil-safe.
+func (c *FallbackChainsConfig) ChainFor(namespace string) string {
+	if c == nil {
+		return ""
+	}
+	if chainID, ok := c.Namespaces[namespace]; ok && namespace != "" {
+		return chainID
+	}
+	return c.Default
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFallbackChainsConfig_ChainFor(t *testing.T) {
+	var unset *FallbackChainsConfig
+	assert.Equal(t, "", unset.ChainFor("payments"))
+
+	fc := &FallbackChainsConfig{
+		Default:    "generic",
+		Namespaces: map[string]string{"payments": "payments-generic"},
+	}
+	assert.Equal(t, "payments-generic", fc.ChainFor("payments"))
+	assert.Equal(t, "generic", fc.ChainFor("checkout"))
+	assert.Equal(t, "generic", fc.ChainFor(""))
+
+	assert.Equal(t, "", (&FallbackChainsConfig{Namespaces: fc.Namespaces}).ChainFor("checkout"),
+		"no default rejects other namespaces")
+}
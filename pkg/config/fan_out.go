@@ -0,0 +1,54 @@
+package config
+
+import "fmt"
+
+// FanOutConfig runs additional chains for every alert the owning chain
+// handles. Each chain runs as its own sibling session in one session group;
+// once all siblings are terminal, a synthesis call consolidates their
+// findings into a single summary.
+type FanOutConfig struct {
+	// Sibling chains to run alongside the owning chain (min 1)
+	Chains []string `yaml:"chains"`
+
+	// LLM provider for the cross-session synthesis (defaults to the owning
+	// chain's executive summary provider, then its llm_provider, then defaults)
+	LLMProvider string `yaml:"llm_provider,omitempty"`
+}
+
+// FanOutChainIDs returns the chains an alert resolved to chainID runs on:
+// chainID first, followed by its fan-out siblings. Returns just chainID when
+// the chain does not fan out.
+func FanOutChainIDs(chainID string, chain *ChainConfig) []string {
+	if chain == nil || chain.FanOut == nil || len(chain.FanOut.Chains) == 0 {
+		return []string{chainID}
+	}
+	return append([]string{chainID}, chain.FanOut.Chains...)
+}
+
+func (v *Validator) validateFanOut(chainID string, fo *FanOutConfig) error {
+	if len(fo.Chains) == 0 {
+		return fmt.Errorf("chains must list at least one chain")
+	}
+	seen := make(map[string]bool, len(fo.Chains))
+	for _, id := range fo.Chains {
+		if id == chainID {
+			return fmt.Errorf("chain '%s' cannot fan out to itself", id)
+		}
+		if seen[id] {
+			return fmt.Errorf("chain '%s' listed more than once", id)
+		}
+		seen[id] = true
+
+		sibling, err := v.cfg.ChainRegistry.Get(id)
+		if err != nil {
+			return fmt.Errorf("chain '%s' not found", id)
+		}
+		if sibling.FanOut != nil {
+			return fmt.Errorf("chain '%s' itself fans out (nested fan-out is not supported)", id)
+		}
+	}
+	if fo.LLMProvider != "" && !v.cfg.LLMProviderRegistry.Has(fo.LLMProvider) {
+		return fmt.Errorf("LLM provider '%s' not found", fo.LLMProvider)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFanOutChainIDs(t *testing.T) {
+	assert.Equal(t, []string{"k8s"}, FanOutChainIDs("k8s", nil))
+	assert.Equal(t, []string{"k8s"}, FanOutChainIDs("k8s", &ChainConfig{}))
+	assert.Equal(t, []string{"k8s", "net", "db"},
+		FanOutChainIDs("k8s", &ChainConfig{FanOut: &FanOutConfig{Chains: []string{"net", "db"}}}))
+}
+
+func TestValidateFanOut(t *testing.T) {
+	stages := []StageConfig{{Name: "investigation", Agents: []StageAgentConfig{{Name: "test-agent"}}}}
+	chain := func(alertType string, fo *FanOutConfig) *ChainConfig {
+		return &ChainConfig{AlertTypes: []string{alertType}, Stages: stages, FanOut: fo}
+	}
+
+	tests := []struct {
+		name   string
+		chains map[string]*ChainConfig
+		errMsg string
+	}{
+		{
+			name: "valid fan-out",
+			chains: map[string]*ChainConfig{
+				"k8s": chain("Pod", &FanOutConfig{Chains: []string{"net"}, LLMProvider: "p"}),
+				"net": chain("Network", nil),
+			},
+		},
+		{
+			name:   "empty chains",
+			chains: map[string]*ChainConfig{"k8s": chain("Pod", &FanOutConfig{})},
+			errMsg: "at least one chain",
+		},
+		{
+			name:   "self reference",
+			chains: map[string]*ChainConfig{"k8s": chain("Pod", &FanOutConfig{Chains: []string{"k8s"}})},
+			errMsg: "cannot fan out to itself",
+		},
+		{
+			name: "duplicate sibling",
+			chains: map[string]*ChainConfig{
+				"k8s": chain("Pod", &FanOutConfig{Chains: []string{"net", "net"}}),
+				"net": chain("Network", nil),
+			},
+			errMsg: "listed more than once",
+		},
+		{
+			name:   "unknown sibling",
+			chains: map[string]*ChainConfig{"k8s": chain("Pod", &FanOutConfig{Chains: []string{"missing"}})},
+			errMsg: "chain 'missing' not found",
+		},
+		{
+			name: "nested fan-out",
+			chains: map[string]*ChainConfig{
+				"k8s": chain("Pod", &FanOutConfig{Chains: []string{"net"}}),
+				"net": chain("Network", &FanOutConfig{Chains: []string{"db"}}),
+				"db":  chain("DB", nil),
+			},
+			errMsg: "nested fan-out",
+		},
+		{
+			name: "unknown synthesis provider",
+			chains: map[string]*ChainConfig{
+				"k8s": chain("Pod", &FanOutConfig{Chains: []string{"net"}, LLMProvider: "missing"}),
+				"net": chain("Network", nil),
+			},
+			errMsg: "LLM provider 'missing' not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				ChainRegistry: NewChainRegistry(tt.chains),
+				AgentRegistry: NewAgentRegistry(map[string]*AgentConfig{"test-agent": {}}),
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+					"p": {Type: LLMProviderTypeGoogle, Model: "m"},
+				}),
+			}
+			err := NewValidator(cfg).validateChains()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "fan_out")
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
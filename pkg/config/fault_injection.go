@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+)
+
+// FaultInjectionConfig controls simulated failures for resilience testing.
+// When enabled, MCP tool calls time out or return malformed results and LLM
+// calls fail as rate limited (HTTP 429) at the configured rates, so chains'
+// error handling can be exercised without waiting for real outages. Rates
+// can be changed at runtime through the admin API, but the facility itself
+// can only be enabled here. Disabled by default; meant for test and staging
+// deployments only.
+type FaultInjectionConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// MCPTimeoutRate is the share (0-1) of MCP tool calls that fail with a
+	// timeout instead of reaching the server.
+	MCPTimeoutRate float64 `yaml:"mcp_timeout_rate" json:"mcp_timeout_rate"`
+
+	// MCPMalformedRate is the share (0-1) of MCP tool calls whose result is
+	// replaced with truncated, unparseable output.
+	MCPMalformedRate float64 `yaml:"mcp_malformed_rate" json:"mcp_malformed_rate"`
+
+	// LLMRateLimitRate is the share (0-1) of LLM calls that fail as rate
+	// limited, as if the provider kept returning 429 through all retries.
+	LLMRateLimitRate float64 `yaml:"llm_rate_limit_rate" json:"llm_rate_limit_rate"`
+
+	// MCPServers restricts MCP faults to these servers; empty means all.
+	MCPServers []string `yaml:"mcp_servers,omitempty" json:"mcp_servers,omitempty"`
+}
+
+// ValidateFaultInjection checks fault injection rates and that MCPServers
+// are configured (skipped when servers is nil).
+func ValidateFaultInjection(cfg FaultInjectionConfig, servers *MCPServerRegistry) error {
+	rates := []struct {
+		field string
+		rate  float64
+	}{
+		{"mcp_timeout_rate", cfg.MCPTimeoutRate},
+		{"mcp_malformed_rate", cfg.MCPMalformedRate},
+		{"llm_rate_limit_rate", cfg.LLMRateLimitRate},
+	}
+	for _, r := range rates {
+		if r.rate < 0 || r.rate > 1 {
+			return fmt.Errorf("%s must be between 0 and 1, got %g", r.field, r.rate)
+		}
+	}
+	if cfg.MCPTimeoutRate+cfg.MCPMalformedRate > 1 {
+		return fmt.Errorf("mcp_timeout_rate and mcp_malformed_rate must not add up to more than 1")
+	}
+	if i := slices.Index(cfg.MCPServers, ""); i >= 0 {
+		return fmt.Errorf("mcp_servers[%d] must not be empty", i)
+	}
+	if servers != nil {
+		for _, id := range cfg.MCPServers {
+			if !servers.Has(id) {
+				return fmt.Errorf("mcp_servers: unknown MCP server %q", id)
+			}
+		}
+	}
+	return nil
+}
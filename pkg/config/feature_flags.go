@@ -0,0 +1,108 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Feature flags gate risky behaviors so they can be rolled out gradually.
+// Each flag is consulted by the subsystem that owns the behavior.
+const (
+	// FeatureFlagModelRouting gates complexity-based model routing
+	// (system.model_routing) per session.
+	FeatureFlagModelRouting = "model_routing"
+
+	// FeatureFlagNoiseTriage gates the noise triage pre-chain
+	// (system.noise_triage) per session.
+	FeatureFlagNoiseTriage = "noise_triage"
+
+	// FeatureFlagFanOut gates chain fan_out per alert submission. When off,
+	// the alert runs on its primary chain only.
+	FeatureFlagFanOut = "fan_out"
+)
+
+// FeatureFlagDefinition describes a built-in feature flag.
+type FeatureFlagDefinition struct {
+	Name        string
+	Description string
+	// Default is the rollout when system.feature_flags does not mention the flag.
+	Default bool
+}
+
+var featureFlagDefinitions = []FeatureFlagDefinition{
+	{
+		Name:        FeatureFlagFanOut,
+		Description: "Run alerts on their chain's fan_out sibling chains",
+		Default:     true,
+	},
+	{
+		Name:        FeatureFlagModelRouting,
+		Description: "Route sessions to the fast or strong provider tier by alert complexity",
+		Default:     true,
+	},
+	{
+		Name:        FeatureFlagNoiseTriage,
+		Description: "Close non-actionable alerts with a triage verdict instead of running the chain",
+		Default:     true,
+	},
+}
+
+// FeatureFlagDefinitions returns the built-in feature flags, sorted by name.
+func FeatureFlagDefinitions() []FeatureFlagDefinition {
+	return slices.Clone(featureFlagDefinitions)
+}
+
+// LookupFeatureFlag returns the definition of the named flag.
+func LookupFeatureFlag(name string) (FeatureFlagDefinition, bool) {
+	for _, d := range featureFlagDefinitions {
+		if d.Name == name {
+			return d, true
+		}
+	}
+	return FeatureFlagDefinition{}, false
+}
+
+// FeatureFlagConfig is the rollout of one feature flag. A flag is on for a
+// target when Enabled is set, the target matches AlertTypes, Chains and
+// Namespaces (empty = any), and the target falls into the Percentage bucket.
+type FeatureFlagConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Percentage is the share of targets (0-100) that see the flag, bucketed
+	// by a stable key (e.g. the session ID). nil = all targets.
+	Percentage *int `yaml:"percentage,omitempty"`
+
+	// AlertTypes restricts the flag to these alert types.
+	AlertTypes []string `yaml:"alert_types,omitempty"`
+
+	// Chains restricts the flag to these chain IDs.
+	Chains []string `yaml:"chains,omitempty"`
+
+	// Namespaces restricts the flag to alerts targeting these namespaces
+	// (system.targets). Untargeted alerts never match.
+	Namespaces []string `yaml:"namespaces,omitempty"`
+}
+
+// ValidateFeatureFlag checks a rollout for the named flag. Chain IDs are
+// checked against chains when it is non-nil. Used for both YAML config and
+// runtime overrides.
+func ValidateFeatureFlag(name string, f FeatureFlagConfig, chains *ChainRegistry) error {
+	if _, ok := LookupFeatureFlag(name); !ok {
+		known := make([]string, 0, len(featureFlagDefinitions))
+		for _, d := range featureFlagDefinitions {
+			known = append(known, d.Name)
+		}
+		return fmt.Errorf("unknown feature flag %q (must be one of %v)", name, known)
+	}
+	if f.Percentage != nil && (*f.Percentage < 0 || *f.Percentage > 100) {
+		return fmt.Errorf("percentage must be between 0 and 100, got %d", *f.Percentage)
+	}
+	if chains != nil {
+		for _, id := range f.Chains {
+			if !chains.Has(id) {
+				return fmt.Errorf("chain '%s' not found", id)
+			}
+		}
+	}
+	return nil
+}
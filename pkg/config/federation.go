@@ -0,0 +1,70 @@
+package config
+
+import "time"
+
+// FederationConfig controls stage delegation between TARSy instances. A
+// chain stage with a remote block is submitted to a named instance —
+// typically one running inside a cluster whose MCP servers can't be
+// exposed — which runs it and reports back the result. Instances receiving
+// delegated stages set AcceptDelegations.
+type FederationConfig struct {
+	// AcceptDelegations lets other instances submit stages to this one.
+	AcceptDelegations bool `yaml:"accept_delegations"`
+
+	// Instances are the remote instances stages may be delegated to, by name.
+	Instances map[string]*FederationInstanceConfig `yaml:"instances,omitempty"`
+}
+
+// FederationInstanceConfig describes one remote TARSy instance.
+type FederationInstanceConfig struct {
+	// URL is the instance's API base URL (e.g. https://tarsy.east.example.com).
+	URL string `yaml:"url"`
+
+	// DashboardURL is the base for links to remote sessions. Defaults to URL.
+	DashboardURL string `yaml:"dashboard_url,omitempty"`
+
+	// TokenEnv names an env var holding a bearer token for the instance's
+	// auth proxy.
+	TokenEnv string `yaml:"token_env,omitempty"`
+
+	// Timeout bounds a whole delegated stage, from submission to the remote
+	// session's terminal state (default: 30m).
+	Timeout time.Duration `yaml:"timeout"`
+
+	// PollInterval is how often the remote session's status is checked
+	// (default: 10s).
+	PollInterval time.Duration `yaml:"poll_interval"`
+}
+
+// RemoteStageConfig delegates a stage to a remote instance. The remote runs
+// the chain its alert type maps to, starting from this session's prior
+// stage results, and its final analysis becomes the stage result.
+type RemoteStageConfig struct {
+	// Instance names an entry in system.federation.instances.
+	Instance string `yaml:"instance"`
+
+	// AlertType is submitted to the remote instance. Defaults to the
+	// session's alert type.
+	AlertType string `yaml:"alert_type,omitempty"`
+}
+
+// Default federation instance settings.
+const (
+	DefaultFederationTimeout      = 30 * time.Minute
+	DefaultFederationPollInterval = 10 * time.Second
+)
+
+// DefaultFederationConfig returns the built-in defaults: no remote
+// instances, delegations not accepted.
+func DefaultFederationConfig() *FederationConfig {
+	return &FederationConfig{}
+}
+
+// Instance returns the named remote instance, or nil when it isn't
+// configured.
+func (c *FederationConfig) Instance(name string) *FederationInstanceConfig {
+	if c == nil {
+		return nil
+	}
+	return c.Instances[name]
+}
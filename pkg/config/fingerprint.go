@@ -0,0 +1,80 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// fingerprintLen is the number of hex characters kept from the SHA-256 digest.
+const fingerprintLen = 16
+
+// configSnapshot is the serializable view of Config hashed by Fingerprint.
+// Registries are flattened to their maps; encoding/json sorts map keys, so
+// the output is deterministic for equal configurations.
+type configSnapshot struct {
+	Defaults            *Defaults                     `json:"defaults"`
+	Queue               *QueueConfig                  `json:"queue"`
+	GitHub              *GitHubConfig                 `json:"github"`
+	Runbooks            *RunbookConfig                `json:"runbooks"`
+	Slack               *SlackConfig                  `json:"slack"`
+	PagerDuty           *PagerDutyConfig              `json:"pagerduty"`
+	NotificationRouting *NotificationRoutingConfig    `json:"notification_routing"`
+	Email               *EmailConfig                  `json:"email"`
+	Reports             *ReportsConfig                `json:"reports"`
+	CostEstimation      *CostEstimationConfig         `json:"cost_estimation"`
+	Retention           *RetentionConfig              `json:"retention"`
+	FeatureFlags        map[string]*FeatureFlagConfig `json:"feature_flags"`
+	DashboardURL        string                        `json:"dashboard_url"`
+	AllowedWSOrigins    []string                      `json:"allowed_ws_origins"`
+	Agents              map[string]*AgentConfig       `json:"agents"`
+	Chains              map[string]*ChainConfig       `json:"chains"`
+	MCPServers          map[string]*MCPServerConfig   `json:"mcp_servers"`
+	LLMProviders        map[string]*LLMProviderConfig `json:"llm_providers"`
+	Skills              map[string]*SkillConfig       `json:"skills"`
+}
+
+// Fingerprint returns a short, stable hash of the fully resolved configuration
+// (built-ins merged, defaults applied, env vars expanded). Replicas loaded from
+// identical config files and the same binary produce the same fingerprint.
+func (c *Config) Fingerprint() (string, error) {
+	snap := configSnapshot{
+		Defaults:            c.Defaults,
+		Queue:               c.Queue,
+		GitHub:              c.GitHub,
+		Runbooks:            c.Runbooks,
+		Slack:               c.Slack,
+		PagerDuty:           c.PagerDuty,
+		NotificationRouting: c.NotificationRouting,
+		Email:               c.Email,
+		Reports:             c.Reports,
+		CostEstimation:      c.CostEstimation,
+		Retention:           c.Retention,
+		FeatureFlags:        c.FeatureFlags,
+		DashboardURL:        c.DashboardURL,
+		AllowedWSOrigins:    c.AllowedWSOrigins,
+	}
+	if c.AgentRegistry != nil {
+		snap.Agents = c.AgentRegistry.GetAll()
+	}
+	if c.ChainRegistry != nil {
+		snap.Chains = c.ChainRegistry.GetAll()
+	}
+	if c.MCPServerRegistry != nil {
+		snap.MCPServers = c.MCPServerRegistry.GetAll()
+	}
+	if c.LLMProviderRegistry != nil {
+		snap.LLMProviders = c.LLMProviderRegistry.GetAll()
+	}
+	if c.SkillRegistry != nil {
+		snap.Skills = c.SkillRegistry.GetAll()
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize configuration: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:fingerprintLen], nil
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	t.Setenv("GOOGLE_API_KEY", "test-key")
+	t.Setenv("OPENAI_API_KEY", "test-key")
+	t.Setenv("ANTHROPIC_API_KEY", "test-key")
+	t.Setenv("XAI_API_KEY", "test-key")
+	t.Setenv("GOOGLE_CLOUD_PROJECT", "test-project")
+	t.Setenv("GOOGLE_CLOUD_LOCATION", "us-central1")
+	t.Setenv("KUBECONFIG", "/test/kubeconfig")
+
+	load := func(t *testing.T, dir string) string {
+		t.Helper()
+		cfg, err := Initialize(context.Background(), dir)
+		require.NoError(t, err)
+		fp, err := cfg.Fingerprint()
+		require.NoError(t, err)
+		return fp
+	}
+
+	t.Run("identical config yields identical fingerprint", func(t *testing.T) {
+		a := load(t, setupTestConfigDir(t))
+		b := load(t, setupTestConfigDir(t))
+		assert.Len(t, a, fingerprintLen)
+		assert.Equal(t, a, b)
+	})
+
+	t.Run("changed config yields different fingerprint", func(t *testing.T) {
+		base := load(t, setupTestConfigDir(t))
+
+		dir := setupTestConfigDir(t)
+		changed := `
+defaults:
+  llm_provider: "google-default"
+  max_iterations: 25
+
+agents: {}
+mcp_servers: {}
+agent_chains: {}
+`
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "tarsy.yaml"), []byte(changed), 0644))
+		assert.NotEqual(t, base, load(t, dir))
+	})
+
+	t.Run("empty config", func(t *testing.T) {
+		fp, err := (&Config{}).Fingerprint()
+		require.NoError(t, err)
+		assert.Len(t, fp, fingerprintLen)
+	})
+}
@@ -0,0 +1,86 @@
+package config
+
+import "fmt"
+
+// Generation parameter ranges accepted by validation. Providers narrow these
+// further (e.g. Anthropic caps temperature at 1); the LLM service passes
+// values through and lets the provider reject what it does not support.
+const (
+	MinTemperature = 0.0
+	MaxTemperature = 2.0
+)
+
+// GenerationParams are sampling and output parameters for LLM calls. Every
+// field is optional: unset fields fall through to the next level of the
+// hierarchy and, at the end, to the provider's own default.
+//
+// Set on an LLM provider, they apply to every call made with it. Agent
+// definitions, chains, stages, stage agents, synthesis, chat and scoring
+// configs override them per field.
+type GenerationParams struct {
+	// Sampling temperature (0-2)
+	Temperature *float64 `yaml:"temperature,omitempty"`
+
+	// Nucleus sampling probability mass (0 exclusive - 1)
+	TopP *float64 `yaml:"top_p,omitempty"`
+
+	// Maximum tokens in the response (min 1)
+	MaxOutputTokens *int `yaml:"max_output_tokens,omitempty"`
+
+	// Thinking depth for reasoning models; ignored by models without one
+	ReasoningEffort ReasoningEffort `yaml:"reasoning_effort,omitempty"`
+}
+
+// IsZero reports whether no parameter is set.
+func (g *GenerationParams) IsZero() bool {
+	return g == nil || (g.Temperature == nil && g.TopP == nil && g.MaxOutputTokens == nil && g.ReasoningEffort == "")
+}
+
+// Validate checks the ranges of the parameters that are set. A nil receiver
+// is valid.
+func (g *GenerationParams) Validate() error {
+	if g == nil {
+		return nil
+	}
+	if g.Temperature != nil && (*g.Temperature < MinTemperature || *g.Temperature > MaxTemperature) {
+		return fmt.Errorf("temperature must be between %g and %g, got %g", MinTemperature, MaxTemperature, *g.Temperature)
+	}
+	if g.TopP != nil && (*g.TopP <= 0 || *g.TopP > 1) {
+		return fmt.Errorf("top_p must be greater than 0 and at most 1, got %g", *g.TopP)
+	}
+	if g.MaxOutputTokens != nil && *g.MaxOutputTokens < 1 {
+		return fmt.Errorf("max_output_tokens must be at least 1, got %d", *g.MaxOutputTokens)
+	}
+	if g.ReasoningEffort != "" && !g.ReasoningEffort.IsValid() {
+		return fmt.Errorf("invalid reasoning_effort: %s", g.ReasoningEffort)
+	}
+	return nil
+}
+
+// MergeGenerationParams layers the given params, listed in lowest-to-highest
+// precedence order: each set field overrides the same field of the layers
+// before it. Returns nil when no layer sets anything.
+func MergeGenerationParams(layers ...*GenerationParams) *GenerationParams {
+	var merged GenerationParams
+	for _, l := range layers {
+		if l == nil {
+			continue
+		}
+		if l.Temperature != nil {
+			merged.Temperature = l.Temperature
+		}
+		if l.TopP != nil {
+			merged.TopP = l.TopP
+		}
+		if l.MaxOutputTokens != nil {
+			merged.MaxOutputTokens = l.MaxOutputTokens
+		}
+		if l.ReasoningEffort != "" {
+			merged.ReasoningEffort = l.ReasoningEffort
+		}
+	}
+	if merged.IsZero() {
+		return nil
+	}
+	return &merged
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerationParamsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params *GenerationParams
+		errMsg string
+	}{
+		{name: "nil is valid", params: nil},
+		{name: "empty is valid", params: &GenerationParams{}},
+		{
+			name: "all fields at range bounds",
+			params: &GenerationParams{
+				Temperature:     floatPtr(0),
+				TopP:            floatPtr(1),
+				MaxOutputTokens: intPtr(1),
+				ReasoningEffort: ReasoningEffortHigh,
+			},
+		},
+		{name: "temperature at maximum", params: &GenerationParams{Temperature: floatPtr(2)}},
+		{
+			name:   "negative temperature",
+			params: &GenerationParams{Temperature: floatPtr(-0.1)},
+			errMsg: "temperature must be between 0 and 2, got -0.1",
+		},
+		{
+			name:   "temperature above maximum",
+			params: &GenerationParams{Temperature: floatPtr(2.1)},
+			errMsg: "temperature must be between 0 and 2, got 2.1",
+		},
+		{
+			name:   "zero top_p",
+			params: &GenerationParams{TopP: floatPtr(0)},
+			errMsg: "top_p must be greater than 0 and at most 1, got 0",
+		},
+		{
+			name:   "top_p above 1",
+			params: &GenerationParams{TopP: floatPtr(1.5)},
+			errMsg: "top_p must be greater than 0 and at most 1, got 1.5",
+		},
+		{
+			name:   "zero max_output_tokens",
+			params: &GenerationParams{MaxOutputTokens: intPtr(0)},
+			errMsg: "max_output_tokens must be at least 1, got 0",
+		},
+		{
+			name:   "unknown reasoning_effort",
+			params: &GenerationParams{ReasoningEffort: "extreme"},
+			errMsg: "invalid reasoning_effort: extreme",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.errMsg, err.Error())
+		})
+	}
+}
+
+func TestMergeGenerationParams(t *testing.T) {
+	t.Run("no layers set returns nil", func(t *testing.T) {
+		assert.Nil(t, MergeGenerationParams())
+		assert.Nil(t, MergeGenerationParams(nil, &GenerationParams{}, nil))
+	})
+
+	t.Run("later layers override per field", func(t *testing.T) {
+		provider := &GenerationParams{
+			Temperature:     floatPtr(1),
+			MaxOutputTokens: intPtr(8192),
+			ReasoningEffort: ReasoningEffortHigh,
+		}
+		stage := &GenerationParams{Temperature: floatPtr(0.2), TopP: floatPtr(0.9)}
+		agent := &GenerationParams{ReasoningEffort: ReasoningEffortLow}
+
+		merged := MergeGenerationParams(provider, nil, stage, agent)
+		require.NotNil(t, merged)
+		assert.Equal(t, 0.2, *merged.Temperature)
+		assert.Equal(t, 0.9, *merged.TopP)
+		assert.Equal(t, 8192, *merged.MaxOutputTokens)
+		assert.Equal(t, ReasoningEffortLow, merged.ReasoningEffort)
+		assert.Equal(t, 1.0, *provider.Temperature, "layers are not mutated")
+	})
+
+	t.Run("explicit zero temperature overrides", func(t *testing.T) {
+		merged := MergeGenerationParams(&GenerationParams{Temperature: floatPtr(0.7)}, &GenerationParams{Temperature: floatPtr(0)})
+		require.NotNil(t, merged)
+		assert.Equal(t, 0.0, *merged.Temperature)
+	})
+}
@@ -0,0 +1,380 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Lint checks, reported in LintWarning.Check.
+const (
+	LintUnreferencedAgent       = "unreferenced_agent"
+	LintUnreferencedLLMProvider = "unreferenced_llm_provider"
+	LintUnreferencedMCPServer   = "unreferenced_mcp_server"
+	LintAlertTypeWithoutChain   = "alert_type_without_chain"
+	LintStageNeverRuns          = "stage_never_runs"
+	LintShadowedStageSetting    = "shadowed_stage_setting"
+	LintShadowedDefault         = "shadowed_default"
+)
+
+// LintWarning is a configuration smell found by Validator.Lint: the
+// configuration is valid, but part of it is never used or has no effect.
+type LintWarning struct {
+	Check   string // one of the Lint* constants
+	Ref     string // what the warning is about, e.g. "agent 'LogAnalyzer'"
+	Message string
+}
+
+// String formats the warning as "ref: message".
+func (w LintWarning) String() string {
+	return w.Ref + ": " + w.Message
+}
+
+// Lint reports warnings for a configuration that passed ValidateAll:
+// user-defined agents, LLM providers and MCP servers nothing references,
+// alert types named outside chains that no chain handles, synthesis stages
+// that can never run, stage settings every agent of the stage overrides, and
+// defaults every chain overrides. Built-in components are not reported.
+// Warnings are sorted by check, then by ref.
+func (v *Validator) Lint() []LintWarning {
+	var warnings []LintWarning
+	warnings = append(warnings, v.lintUnreferencedAgents()...)
+	warnings = append(warnings, v.lintUnreferencedLLMProviders()...)
+	warnings = append(warnings, v.lintUnreferencedMCPServers()...)
+	warnings = append(warnings, v.lintAlertTypesWithoutChain()...)
+	warnings = append(warnings, v.lintStages()...)
+	warnings = append(warnings, v.lintShadowedDefaults()...)
+
+	slices.SortFunc(warnings, func(a, b LintWarning) int {
+		if c := strings.Compare(a.Check, b.Check); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Ref, b.Ref)
+	})
+	return warnings
+}
+
+func (v *Validator) lintUnreferencedAgents() []LintWarning {
+	if v.cfg.AgentRegistry == nil {
+		return nil
+	}
+	referenced := v.collectReferencedAgents()
+	builtin := GetBuiltinConfig().Agents
+
+	var warnings []LintWarning
+	for name := range v.cfg.AgentRegistry.GetAll() {
+		if _, isBuiltin := builtin[name]; isBuiltin || referenced[name] {
+			continue
+		}
+		warnings = append(warnings, LintWarning{
+			Check:   LintUnreferencedAgent,
+			Ref:     fmt.Sprintf("agent '%s'", name),
+			Message: "not used by any chain stage, sub_agents list, synthesis, chat, scoring or extending agent",
+		})
+	}
+	return warnings
+}
+
+func (v *Validator) lintUnreferencedLLMProviders() []LintWarning {
+	if v.cfg.LLMProviderRegistry == nil {
+		return nil
+	}
+	referenced := v.collectReferencedLLMProviders()
+	builtin := GetBuiltinConfig().LLMProviders
+
+	var warnings []LintWarning
+	for name := range v.cfg.LLMProviderRegistry.GetAll() {
+		if _, isBuiltin := builtin[name]; isBuiltin || referenced[name] {
+			continue
+		}
+		warnings = append(warnings, LintWarning{
+			Check:   LintUnreferencedLLMProvider,
+			Ref:     fmt.Sprintf("LLM provider '%s'", name),
+			Message: "not used by defaults, any chain, or a system section; only session duplicates can select it",
+		})
+	}
+	return warnings
+}
+
+func (v *Validator) lintUnreferencedMCPServers() []LintWarning {
+	if v.cfg.MCPServerRegistry == nil {
+		return nil
+	}
+	referenced := v.collectReferencedMCPServers()
+	builtin := GetBuiltinConfig().MCPServers
+
+	var warnings []LintWarning
+	for name := range v.cfg.MCPServerRegistry.GetAll() {
+		if _, isBuiltin := builtin[name]; isBuiltin || referenced[name] {
+			continue
+		}
+		warnings = append(warnings, LintWarning{
+			Check:   LintUnreferencedMCPServer,
+			Ref:     fmt.Sprintf("MCP server '%s'", name),
+			Message: "not used by any agent or chain; only per-alert mcp overrides can select it",
+		})
+	}
+	return warnings
+}
+
+// lintAlertTypesWithoutChain reports alert types named in defaults, auto-cancel
+// TTLs and feature flag rollouts that no chain handles.
+func (v *Validator) lintAlertTypesWithoutChain() []LintWarning {
+	if v.cfg.ChainRegistry == nil {
+		return nil
+	}
+	handled := make(map[string]bool)
+	for _, chain := range v.cfg.ChainRegistry.GetAll() {
+		for _, alertType := range chain.AlertTypes {
+			handled[alertType] = true
+		}
+	}
+
+	var warnings []LintWarning
+	check := func(alertType, ref string) {
+		if alertType == "" || handled[alertType] {
+			return
+		}
+		warnings = append(warnings, LintWarning{
+			Check:   LintAlertTypeWithoutChain,
+			Ref:     ref,
+			Message: fmt.Sprintf("alert type '%s' is not handled by any chain", alertType),
+		})
+	}
+
+	if v.cfg.Defaults != nil {
+		check(v.cfg.Defaults.AlertType, "defaults.alert_type")
+	}
+	if v.cfg.Queue != nil && v.cfg.Queue.AutoCancel != nil {
+		for alertType := range v.cfg.Queue.AutoCancel.AlertTypeTTLs {
+			check(alertType, fmt.Sprintf("queue.auto_cancel.alert_type_ttls[%s]", alertType))
+		}
+	}
+	if v.cfg.Queue != nil && v.cfg.Queue.Preemption != nil {
+		for alertType := range v.cfg.Queue.Preemption.AlertTypePriorities {
+			check(alertType, fmt.Sprintf("queue.preemption.alert_type_priorities[%s]", alertType))
+		}
+	}
+	for name, flag := range v.cfg.FeatureFlags {
+		if flag == nil {
+			continue
+		}
+		for _, alertType := range flag.AlertTypes {
+			check(alertType, fmt.Sprintf("system.feature_flags.%s.alert_types", name))
+		}
+	}
+	return warnings
+}
+
+// lintStages reports synthesis blocks on stages that never run in parallel
+// (the synthesis stage never runs), success policies on such stages, and
+// stage-level settings every agent of the stage overrides.
+func (v *Validator) lintStages() []LintWarning {
+	if v.cfg.ChainRegistry == nil {
+		return nil
+	}
+
+	var warnings []LintWarning
+	for chainID, chain := range v.cfg.ChainRegistry.GetAll() {
+		for i, stage := range chain.Stages {
+			if stage.Remote != nil || len(stage.Agents) == 0 {
+				continue
+			}
+			stageRef := fmt.Sprintf("chain '%s' stage '%s'", chainID, stage.Name)
+
+			if len(stage.Agents) == 1 && stage.Replicas <= 1 {
+				if stage.Synthesis != nil {
+					warnings = append(warnings, LintWarning{
+						Check:   LintStageNeverRuns,
+						Ref:     stageRef,
+						Message: "synthesis never runs: the stage runs a single agent without replicas",
+					})
+				}
+				if stage.SuccessPolicy != "" {
+					warnings = append(warnings, LintWarning{
+						Check:   LintShadowedStageSetting,
+						Ref:     stageRef,
+						Message: "success_policy has no effect: the stage runs a single agent without replicas",
+					})
+				}
+			}
+
+			shadowed := func(field string, set func(StageAgentConfig) bool) {
+				for _, agent := range stage.Agents {
+					if !set(agent) {
+						return
+					}
+				}
+				warnings = append(warnings, LintWarning{
+					Check:   LintShadowedStageSetting,
+					Ref:     stageRef,
+					Message: fmt.Sprintf("stages[%d].%s is overridden by every agent of the stage", i, field),
+				})
+			}
+			if stage.MaxIterations != nil {
+				shadowed("max_iterations", func(a StageAgentConfig) bool { return a.MaxIterations != nil })
+			}
+			if len(stage.MCPServers) > 0 {
+				shadowed("mcp_servers", func(a StageAgentConfig) bool { return len(a.MCPServers) > 0 })
+			}
+			if stage.FallbackProviders != nil {
+				shadowed("fallback_providers", func(a StageAgentConfig) bool { return a.FallbackProviders != nil })
+			}
+			if len(stage.SubAgents) > 0 {
+				shadowed("sub_agents", func(a StageAgentConfig) bool { return len(a.SubAgents) > 0 })
+			}
+		}
+	}
+	return warnings
+}
+
+// lintShadowedDefaults reports defaults that every chain overrides, so they
+// never take effect.
+func (v *Validator) lintShadowedDefaults() []LintWarning {
+	defaults := v.cfg.Defaults
+	if defaults == nil || v.cfg.ChainRegistry == nil {
+		return nil
+	}
+	chains := v.cfg.ChainRegistry.GetAll()
+	if len(chains) == 0 {
+		return nil
+	}
+
+	everyChain := func(set func(*ChainConfig) bool) bool {
+		for _, chain := range chains {
+			if !set(chain) {
+				return false
+			}
+		}
+		return true
+	}
+
+	var warnings []LintWarning
+	shadowed := func(field string) {
+		warnings = append(warnings, LintWarning{
+			Check:   LintShadowedDefault,
+			Ref:     "defaults." + field,
+			Message: "every chain overrides it, so the default never takes effect",
+		})
+	}
+
+	// The report narrative falls back to defaults.llm_provider
+	narrativeUsesDefault := v.cfg.Reports != nil && v.cfg.Reports.Enabled &&
+		v.cfg.Reports.Narrative && v.cfg.Reports.LLMProvider == ""
+	if defaults.LLMProvider != "" && !narrativeUsesDefault &&
+		everyChain(func(c *ChainConfig) bool { return c.LLMProvider != "" }) {
+		shadowed("llm_provider")
+	}
+	if defaults.MaxIterations != nil &&
+		everyChain(func(c *ChainConfig) bool { return c.MaxIterations != nil }) {
+		shadowed("max_iterations")
+	}
+	if defaults.FallbackProviders != nil &&
+		everyChain(func(c *ChainConfig) bool { return c.FallbackProviders != nil }) {
+		shadowed("fallback_providers")
+	}
+	if defaults.OutputLanguage != "" &&
+		everyChain(func(c *ChainConfig) bool { return c.OutputLanguage != "" }) {
+		shadowed("output_language")
+	}
+	return warnings
+}
+
+// collectReferencedAgents returns the names of agents used by chains (stage
+// agents, sub_agents at every level, synthesis, chat and scoring), by
+// defaults.scoring, or as the base of another agent.
+func (v *Validator) collectReferencedAgents() map[string]bool {
+	referenced := make(map[string]bool)
+	addRefs := func(refs SubAgentRefs) {
+		for _, ref := range refs {
+			referenced[ref.Name] = true
+		}
+	}
+
+	if v.cfg.Defaults != nil && v.cfg.Defaults.Scoring != nil && v.cfg.Defaults.Scoring.Agent != "" {
+		referenced[v.cfg.Defaults.Scoring.Agent] = true
+	}
+	if v.cfg.AgentRegistry != nil {
+		for _, agent := range v.cfg.AgentRegistry.GetAll() {
+			if agent.Extends != "" {
+				referenced[agent.Extends] = true
+			}
+		}
+	}
+	if v.cfg.ChainRegistry == nil {
+		return referenced
+	}
+
+	for _, chain := range v.cfg.ChainRegistry.GetAll() {
+		addRefs(chain.SubAgents)
+		if chain.Chat != nil {
+			if chain.Chat.Agent != "" {
+				referenced[chain.Chat.Agent] = true
+			}
+			addRefs(chain.Chat.SubAgents)
+		}
+		if chain.Scoring != nil && chain.Scoring.Agent != "" {
+			referenced[chain.Scoring.Agent] = true
+		}
+		for _, stage := range chain.Stages {
+			addRefs(stage.SubAgents)
+			for _, agent := range stage.Agents {
+				referenced[agent.Name] = true
+				addRefs(agent.SubAgents)
+			}
+			if stage.Synthesis != nil && stage.Synthesis.Agent != "" {
+				referenced[stage.Synthesis.Agent] = true
+			}
+		}
+	}
+	return referenced
+}
+
+// collectReferencedMCPServers returns the IDs of MCP servers used by agent
+// definitions or by chain, stage, agent, sub-agent, chat or scoring overrides.
+func (v *Validator) collectReferencedMCPServers() map[string]bool {
+	referenced := make(map[string]bool)
+	add := func(ids []string) {
+		for _, id := range ids {
+			referenced[id] = true
+		}
+	}
+	addRefs := func(refs SubAgentRefs) {
+		for _, ref := range refs {
+			add(ref.MCPServers)
+		}
+	}
+
+	if v.cfg.Defaults != nil && v.cfg.Defaults.Scoring != nil {
+		add(v.cfg.Defaults.Scoring.MCPServers)
+	}
+	if v.cfg.AgentRegistry != nil {
+		for _, agent := range v.cfg.AgentRegistry.GetAll() {
+			add(agent.MCPServers)
+		}
+	}
+	if v.cfg.ChainRegistry == nil {
+		return referenced
+	}
+
+	for _, chain := range v.cfg.ChainRegistry.GetAll() {
+		add(chain.MCPServers)
+		addRefs(chain.SubAgents)
+		if chain.Chat != nil {
+			add(chain.Chat.MCPServers)
+			addRefs(chain.Chat.SubAgents)
+		}
+		if chain.Scoring != nil {
+			add(chain.Scoring.MCPServers)
+		}
+		for _, stage := range chain.Stages {
+			add(stage.MCPServers)
+			addRefs(stage.SubAgents)
+			for _, agent := range stage.Agents {
+				add(agent.MCPServers)
+				addRefs(agent.SubAgents)
+			}
+		}
+	}
+	return referenced
+}
@@ -0,0 +1,195 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lintTestConfig returns a configuration with one chain that uses every
+// user-defined component, so Lint reports nothing.
+func lintTestConfig() *Config {
+	return &Config{
+		Defaults: &Defaults{AlertType: "pod-crash", LLMProvider: "primary"},
+		Queue:    DefaultQueueConfig(),
+		AgentRegistry: NewAgentRegistry(map[string]*AgentConfig{
+			"Investigator": {MCPServers: []string{"k8s"}},
+			"Worker":       {Description: "Reads logs"},
+		}),
+		LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+			"primary": {Type: LLMProviderTypeGoogle, Model: "gemini-2.5-pro"},
+		}),
+		MCPServerRegistry: NewMCPServerRegistry(map[string]*MCPServerConfig{
+			"k8s": {},
+		}),
+		ChainRegistry: NewChainRegistry(map[string]*ChainConfig{
+			"k8s-chain": {
+				AlertTypes: []string{"pod-crash"},
+				Stages: []StageConfig{{
+					Name:      "investigate",
+					Agents:    []StageAgentConfig{{Name: "Investigator"}},
+					SubAgents: SubAgentRefs{{Name: "Worker"}},
+				}},
+			},
+		}),
+	}
+}
+
+func lintChecks(warnings []LintWarning) []string {
+	checks := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		checks = append(checks, w.Check+" "+w.Ref)
+	}
+	return checks
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		want   []string
+	}{
+		{
+			name:   "fully referenced config has no warnings",
+			modify: func(cfg *Config) {},
+			want:   []string{},
+		},
+		{
+			name: "unreferenced agent, provider and MCP server",
+			modify: func(cfg *Config) {
+				agents := cfg.AgentRegistry.GetAll()
+				agents["Unused"] = &AgentConfig{MCPServers: []string{"k8s"}}
+				cfg.AgentRegistry = NewAgentRegistry(agents)
+				providers := cfg.LLMProviderRegistry.GetAll()
+				providers["spare"] = &LLMProviderConfig{Type: LLMProviderTypeGoogle, Model: "gemini-2.5-flash"}
+				cfg.LLMProviderRegistry = NewLLMProviderRegistry(providers)
+				servers := cfg.MCPServerRegistry.GetAll()
+				servers["grafana"] = &MCPServerConfig{}
+				cfg.MCPServerRegistry = NewMCPServerRegistry(servers)
+			},
+			want: []string{
+				"unreferenced_agent agent 'Unused'",
+				"unreferenced_llm_provider LLM provider 'spare'",
+				"unreferenced_mcp_server MCP server 'grafana'",
+			},
+		},
+		{
+			name: "base agent of an extending agent is referenced",
+			modify: func(cfg *Config) {
+				agents := cfg.AgentRegistry.GetAll()
+				agents["Base"] = &AgentConfig{}
+				agents["Investigator"] = &AgentConfig{Extends: "Base", MCPServers: []string{"k8s"}}
+				cfg.AgentRegistry = NewAgentRegistry(agents)
+			},
+			want: []string{},
+		},
+		{
+			name: "alert types without a chain",
+			modify: func(cfg *Config) {
+				cfg.Defaults.AlertType = "generic"
+				cfg.Queue.AutoCancel = &AutoCancelConfig{
+					CheckInterval: time.Minute,
+					AlertTypeTTLs: map[string]time.Duration{"pod-crash": time.Hour, "disk-full": time.Hour},
+				}
+				cfg.FeatureFlags = map[string]*FeatureFlagConfig{
+					FeatureFlagFanOut: {Enabled: true, AlertTypes: []string{"outage"}},
+				}
+			},
+			want: []string{
+				"alert_type_without_chain defaults.alert_type",
+				"alert_type_without_chain queue.auto_cancel.alert_type_ttls[disk-full]",
+				"alert_type_without_chain system.feature_flags." + FeatureFlagFanOut + ".alert_types",
+			},
+		},
+		{
+			name: "synthesis and success policy on a single-agent stage",
+			modify: func(cfg *Config) {
+				chain, _ := cfg.ChainRegistry.Get("k8s-chain")
+				chain.Stages[0].Synthesis = &SynthesisConfig{}
+				chain.Stages[0].SuccessPolicy = SuccessPolicyAny
+			},
+			want: []string{
+				"shadowed_stage_setting chain 'k8s-chain' stage 'investigate'",
+				"stage_never_runs chain 'k8s-chain' stage 'investigate'",
+			},
+		},
+		{
+			name: "synthesis on a replicated stage runs",
+			modify: func(cfg *Config) {
+				chain, _ := cfg.ChainRegistry.Get("k8s-chain")
+				chain.Stages[0].Synthesis = &SynthesisConfig{}
+				chain.Stages[0].Replicas = 2
+			},
+			want: []string{},
+		},
+		{
+			name: "stage setting every agent overrides",
+			modify: func(cfg *Config) {
+				chain, _ := cfg.ChainRegistry.Get("k8s-chain")
+				chain.Stages[0].MaxIterations = intPtr(5)
+				chain.Stages[0].Agents[0].MaxIterations = intPtr(10)
+			},
+			want: []string{"shadowed_stage_setting chain 'k8s-chain' stage 'investigate'"},
+		},
+		{
+			name: "defaults every chain overrides",
+			modify: func(cfg *Config) {
+				cfg.Defaults.MaxIterations = intPtr(20)
+				cfg.Defaults.OutputLanguage = "German"
+				chain, _ := cfg.ChainRegistry.Get("k8s-chain")
+				chain.LLMProvider = "primary"
+				chain.MaxIterations = intPtr(10)
+			},
+			want: []string{
+				"shadowed_default defaults.llm_provider",
+				"shadowed_default defaults.max_iterations",
+			},
+		},
+		{
+			name: "report narrative keeps defaults.llm_provider in use",
+			modify: func(cfg *Config) {
+				cfg.Reports = &ReportsConfig{Enabled: true, Narrative: true}
+				chain, _ := cfg.ChainRegistry.Get("k8s-chain")
+				chain.LLMProvider = "primary"
+			},
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := lintTestConfig()
+			tt.modify(cfg)
+			assert.Equal(t, tt.want, lintChecks(NewValidator(cfg).Lint()))
+		})
+	}
+}
+
+func TestLint_BuiltinComponentsNotReported(t *testing.T) {
+	cfg := lintTestConfig()
+	builtin := GetBuiltinConfig()
+
+	agents := cfg.AgentRegistry.GetAll()
+	for name := range builtin.Agents {
+		agents[name] = &AgentConfig{}
+	}
+	cfg.AgentRegistry = NewAgentRegistry(agents)
+	servers := cfg.MCPServerRegistry.GetAll()
+	for name := range builtin.MCPServers {
+		servers[name] = &MCPServerConfig{}
+	}
+	cfg.MCPServerRegistry = NewMCPServerRegistry(servers)
+
+	assert.Empty(t, NewValidator(cfg).Lint())
+}
+
+func TestLintWarning_String(t *testing.T) {
+	warnings := NewValidator(&Config{
+		Defaults:      &Defaults{AlertType: "generic"},
+		ChainRegistry: NewChainRegistry(map[string]*ChainConfig{}),
+	}).Lint()
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "defaults.alert_type: alert type 'generic' is not handled by any chain", warnings[0].String())
+}
@@ -31,6 +31,19 @@ type LLMProviderConfig struct {
 
 	// Google-specific native tools
 	NativeTools map[GoogleNativeTool]bool `yaml:"native_tools,omitempty"`
+
+	// Whether the model accepts image input. Image attachments are only
+	// sent to multimodal providers; others get a text note instead.
+	Multimodal bool `yaml:"multimodal,omitempty"`
+
+	// Default generation parameters for calls made with this provider
+	Generation *GenerationParams `yaml:"generation,omitempty"`
+
+	// Overrides of the model's default capabilities (see EffectiveCapabilities)
+	Capabilities *LLMCapabilities `yaml:"capabilities,omitempty"`
+
+	// Tokenizer used to count tokens for this model (nil = heuristic)
+	Tokenizer *TokenizerConfig `yaml:"tokenizer,omitempty"`
 }
 
 // LLMProviderRegistry stores LLM provider configurations in memory with thread-safe access
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"dario.cat/mergo"
@@ -18,6 +19,7 @@ type TarsyYAMLConfig struct {
 	System      *SystemYAMLConfig          `yaml:"system"`
 	MCPServers  map[string]MCPServerConfig `yaml:"mcp_servers"`
 	Agents      map[string]AgentConfig     `yaml:"agents"`
+	AgentMixins map[string]AgentConfig     `yaml:"agent_mixins"`
 	AgentChains map[string]ChainConfig     `yaml:"agent_chains"`
 	Defaults    *Defaults                  `yaml:"defaults"`
 	Queue       *QueueConfig               `yaml:"queue"`
@@ -25,13 +27,41 @@ type TarsyYAMLConfig struct {
 
 // SystemYAMLConfig groups system-wide infrastructure settings.
 type SystemYAMLConfig struct {
-	DashboardURL     string                    `yaml:"dashboard_url"`
-	AllowedWSOrigins []string                  `yaml:"allowed_ws_origins"`
-	GitHub           *GitHubYAMLConfig         `yaml:"github"`
-	Runbooks         *RunbooksYAMLConfig       `yaml:"runbooks"`
-	Slack            *SlackYAMLConfig          `yaml:"slack"`
-	CostEstimation   *CostEstimationYAMLConfig `yaml:"cost_estimation"`
-	Retention        *RetentionConfig          `yaml:"retention"`
+	DashboardURL          string                           `yaml:"dashboard_url"`
+	AllowedWSOrigins      []string                         `yaml:"allowed_ws_origins"`
+	GitHub                *GitHubYAMLConfig                `yaml:"github"`
+	Runbooks              *RunbooksYAMLConfig              `yaml:"runbooks"`
+	Slack                 *SlackYAMLConfig                 `yaml:"slack"`
+	PagerDuty             *PagerDutyYAMLConfig             `yaml:"pagerduty"`
+	NotificationRouting   *NotificationRoutingConfig       `yaml:"notification_routing"`
+	Email                 *EmailYAMLConfig                 `yaml:"email"`
+	Reports               *ReportsYAMLConfig               `yaml:"reports"`
+	CostEstimation        *CostEstimationYAMLConfig        `yaml:"cost_estimation"`
+	Compression           *CompressionYAMLConfig           `yaml:"compression"`
+	BlobStore             *BlobStoreYAMLConfig             `yaml:"blob_store"`
+	Retention             *RetentionConfig                 `yaml:"retention"`
+	Degradation           *DegradationConfig               `yaml:"degradation"`
+	ModelRouting          *ModelRoutingConfig              `yaml:"model_routing"`
+	NoiseTriage           *NoiseTriageConfig               `yaml:"noise_triage"`
+	Transcription         *TranscriptionConfig             `yaml:"transcription"`
+	Callbacks             *CallbacksConfig                 `yaml:"callbacks"`
+	EventStream           *EventStreamConfig               `yaml:"event_stream"`
+	FeatureFlags          map[string]FeatureFlagConfig     `yaml:"feature_flags"`
+	Logging               *LoggingYAMLConfig               `yaml:"logging"`
+	Admins                []string                         `yaml:"admins"`
+	FaultInjection        *FaultInjectionConfig            `yaml:"fault_injection"`
+	Profiling             *ProfilingConfig                 `yaml:"profiling"`
+	RedactionReview       *RedactionReviewConfig           `yaml:"redaction_review"`
+	Federation            *FederationConfig                `yaml:"federation"`
+	Targets               *TargetsConfig                   `yaml:"targets"`
+	ChatLimits            *ChatLimitsYAMLConfig            `yaml:"chat_limits"`
+	Recurrence            *RecurrenceYAMLConfig            `yaml:"recurrence"`
+	OutcomeClassification *OutcomeClassificationYAMLConfig `yaml:"outcome_classification"`
+	ActionItems           *ActionItemsYAMLConfig           `yaml:"action_items"`
+	OnCall                *OnCallYAMLConfig                `yaml:"on_call"`
+	DeepLinks             *DeepLinksYAMLConfig             `yaml:"deep_links"`
+	Enrichment            *EnrichmentYAMLConfig            `yaml:"enrichment"`
+	RecentHistory         *RecentHistoryYAMLConfig         `yaml:"recent_history"`
 }
 
 // CostEstimationYAMLConfig holds cost-estimation settings from YAML.
@@ -41,6 +71,26 @@ type CostEstimationYAMLConfig struct {
 	ModelRates map[string]ModelRateYAMLConfig `yaml:"model_rates,omitempty"`
 }
 
+// CompressionYAMLConfig holds at-rest compression settings from YAML.
+// Enabled is a *bool: nil (or whole block omitted) means enabled (default true).
+type CompressionYAMLConfig struct {
+	Enabled        *bool `yaml:"enabled,omitempty"`
+	ThresholdBytes int   `yaml:"threshold_bytes,omitempty"`
+}
+
+// BlobStoreYAMLConfig holds blob store settings from YAML.
+// ToolResultThresholdBytes is a *int so that 0 (never offload) can be set explicitly.
+type BlobStoreYAMLConfig struct {
+	Backend                  BlobBackend           `yaml:"backend,omitempty"`
+	Prefix                   string                `yaml:"prefix,omitempty"`
+	ToolResultThresholdBytes *int                  `yaml:"tool_result_threshold_bytes,omitempty"`
+	DownloadURLTTL           time.Duration         `yaml:"download_url_ttl,omitempty"`
+	SigningKeyEnv            string                `yaml:"signing_key_env,omitempty"`
+	Local                    *LocalBlobStoreConfig `yaml:"local,omitempty"`
+	S3                       *S3BlobStoreConfig    `yaml:"s3,omitempty"`
+	GCS                      *GCSBlobStoreConfig   `yaml:"gcs,omitempty"`
+}
+
 // ModelRateYAMLConfig is a flat per-million USD override from YAML.
 type ModelRateYAMLConfig struct {
 	InputPerMillion  float64 `yaml:"input_per_million"`
@@ -49,9 +99,52 @@ type ModelRateYAMLConfig struct {
 
 // SlackYAMLConfig holds Slack notification settings from YAML.
 type SlackYAMLConfig struct {
-	Enabled  *bool  `yaml:"enabled,omitempty"`
-	TokenEnv string `yaml:"token_env,omitempty"`
-	Channel  string `yaml:"channel,omitempty"`
+	Enabled             *bool  `yaml:"enabled,omitempty"`
+	TokenEnv            string `yaml:"token_env,omitempty"`
+	Channel             string `yaml:"channel,omitempty"`
+	ThreadStatusUpdates *bool  `yaml:"thread_status_updates,omitempty"`
+	RateLimitPerMinute  *int   `yaml:"rate_limit_per_minute,omitempty"`
+	DedupWindow         string `yaml:"dedup_window,omitempty"` // Parsed to time.Duration
+}
+
+// ChatLimitsYAMLConfig holds chat rate limits from YAML. Pointers
+// distinguish an explicit 0 (unlimited) from an omitted field.
+type ChatLimitsYAMLConfig struct {
+	PerUserPerMinute        *int `yaml:"per_user_per_minute,omitempty"`
+	PerSessionPerMinute     *int `yaml:"per_session_per_minute,omitempty"`
+	MaxConcurrentExecutions *int `yaml:"max_concurrent_executions,omitempty"`
+	MaxQueuedMessages       *int `yaml:"max_queued_messages,omitempty"`
+}
+
+// EmailYAMLConfig holds SMTP email notification settings from YAML.
+type EmailYAMLConfig struct {
+	Enabled     *bool  `yaml:"enabled,omitempty"`
+	SMTPHost    string `yaml:"smtp_host,omitempty"`
+	SMTPPort    int    `yaml:"smtp_port,omitempty"`
+	Username    string `yaml:"username,omitempty"`
+	PasswordEnv string `yaml:"password_env,omitempty"`
+	From        string `yaml:"from,omitempty"`
+	StartTLS    *bool  `yaml:"starttls,omitempty"`
+	DigestHour  *int   `yaml:"digest_hour,omitempty"`
+}
+
+// ReportsYAMLConfig holds periodic activity report settings from YAML.
+type ReportsYAMLConfig struct {
+	Enabled         *bool          `yaml:"enabled,omitempty"`
+	Periods         []ReportPeriod `yaml:"periods,omitempty"`
+	Hour            *int           `yaml:"hour,omitempty"`
+	Weekday         string         `yaml:"weekday,omitempty"` // e.g. "monday"
+	Narrative       *bool          `yaml:"narrative,omitempty"`
+	LLMProvider     string         `yaml:"llm_provider,omitempty"`
+	SlackChannel    string         `yaml:"slack_channel,omitempty"`
+	EmailRecipients []string       `yaml:"email_recipients,omitempty"`
+	WebhookURL      string         `yaml:"webhook_url,omitempty"`
+}
+
+// PagerDutyYAMLConfig holds PagerDuty notification settings from YAML.
+type PagerDutyYAMLConfig struct {
+	Enabled       *bool  `yaml:"enabled,omitempty"`
+	RoutingKeyEnv string `yaml:"routing_key_env,omitempty"`
 }
 
 // GitHubYAMLConfig holds GitHub integration settings from YAML.
@@ -136,6 +229,16 @@ func load(_ context.Context, configDir string) (*Config, error) {
 	mcpServers := mergeMCPServers(builtin.MCPServers, tarsyConfig.MCPServers)
 	chains := mergeChains(builtin.ChainDefinitions, tarsyConfig.AgentChains)
 	llmProvidersMerged := mergeLLMProviders(builtin.LLMProviders, llmProviders)
+	resolveTokenizerFiles(llmProvidersMerged, configDir)
+
+	// 4b. Expand agent and chain composition (extends, mixins, stage
+	// includes) before validation
+	if err := resolveAgentComposition(agents, tarsyConfig.AgentMixins); err != nil {
+		return nil, err
+	}
+	if err := resolveChainComposition(chains); err != nil {
+		return nil, err
+	}
 
 	// 5. Apply MCP server defaults (before validation)
 	for _, server := range mcpServers {
@@ -197,32 +300,102 @@ func load(_ context.Context, configDir string) (*Config, error) {
 			return nil, fmt.Errorf("failed to merge queue config: %w", err)
 		}
 	}
+	if ac := queueConfig.AutoCancel; ac != nil && ac.CheckInterval == 0 {
+		ac.CheckInterval = DefaultAutoCancelCheckInterval
+	}
+	if pc := queueConfig.Preemption; pc != nil {
+		if pc.Deadline == 0 {
+			pc.Deadline = DefaultPreemptionDeadline
+		}
+		if pc.MaxPerCheck == 0 {
+			pc.MaxPerCheck = DefaultPreemptionMaxPerCheck
+		}
+		if pc.CheckInterval == 0 {
+			pc.CheckInterval = DefaultPreemptionCheckInterval
+		}
+	}
 
-	// Resolve system config (GitHub + Runbooks + Slack + CostEstimation + Retention + DashboardURL + WS Origins)
+	// Resolve system config (GitHub + Runbooks + Slack + PagerDuty + NotificationRouting + Email + Reports + CostEstimation + Compression + BlobStore + Retention + Degradation + ModelRouting + NoiseTriage + Transcription + Callbacks + EventStream + FeatureFlags + Logging + Admins + FaultInjection + Profiling + RedactionReview + Federation + Targets + ChatLimits + Recurrence + OutcomeClassification + ActionItems + OnCall + DeepLinks + Enrichment + RecentHistory + DashboardURL + WS Origins)
 	githubCfg := resolveGitHubConfig(tarsyConfig.System)
 	runbooksCfg := resolveRunbooksConfig(tarsyConfig.System)
 	slackCfg := resolveSlackConfig(tarsyConfig.System)
+	pagerDutyCfg := resolvePagerDutyConfig(tarsyConfig.System)
+	notificationRoutingCfg := resolveNotificationRoutingConfig(tarsyConfig.System)
+	emailCfg := resolveEmailConfig(tarsyConfig.System)
+	reportsCfg := resolveReportsConfig(tarsyConfig.System)
 	costEstimationCfg := resolveCostEstimationConfig(tarsyConfig.System)
+	compressionCfg := resolveCompressionConfig(tarsyConfig.System)
+	blobStoreCfg := resolveBlobStoreConfig(tarsyConfig.System)
 	retentionCfg := resolveRetentionConfig(tarsyConfig.System)
+	degradationCfg := resolveDegradationConfig(tarsyConfig.System)
+	modelRoutingCfg := resolveModelRoutingConfig(tarsyConfig.System)
+	noiseTriageCfg := resolveNoiseTriageConfig(tarsyConfig.System)
+	transcriptionCfg := resolveTranscriptionConfig(tarsyConfig.System)
+	callbacksCfg := resolveCallbacksConfig(tarsyConfig.System)
+	eventStreamCfg := resolveEventStreamConfig(tarsyConfig.System)
+	featureFlags := resolveFeatureFlags(tarsyConfig.System)
+	loggingCfg := resolveLoggingConfig(tarsyConfig.System)
+	admins := resolveAdmins(tarsyConfig.System)
+	faultInjectionCfg := resolveFaultInjectionConfig(tarsyConfig.System)
+	profilingCfg := resolveProfilingConfig(tarsyConfig.System)
+	redactionReviewCfg := resolveRedactionReviewConfig(tarsyConfig.System)
+	federationCfg := resolveFederationConfig(tarsyConfig.System)
+	targetsCfg := resolveTargetsConfig(tarsyConfig.System)
+	chatLimitsCfg := resolveChatLimitsConfig(tarsyConfig.System)
+	recurrenceCfg := resolveRecurrenceConfig(tarsyConfig.System)
+	outcomeCfg := resolveOutcomeClassificationConfig(tarsyConfig.System)
+	actionItemsCfg := resolveActionItemsConfig(tarsyConfig.System)
+	onCallCfg := resolveOnCallConfig(tarsyConfig.System)
+	deepLinksCfg := resolveDeepLinksConfig(tarsyConfig.System)
+	enrichmentCfg := resolveEnrichmentConfig(tarsyConfig.System, configDir)
+	recentHistoryCfg := resolveRecentHistoryConfig(tarsyConfig.System)
 	dashboardURL := resolveDashboardURL(tarsyConfig.System)
 	allowedWSOrigins := resolveAllowedWSOrigins(tarsyConfig.System)
 
 	return &Config{
-		configDir:           configDir,
-		Defaults:            defaults,
-		Queue:               queueConfig,
-		GitHub:              githubCfg,
-		Runbooks:            runbooksCfg,
-		Slack:               slackCfg,
-		CostEstimation:      costEstimationCfg,
-		Retention:           retentionCfg,
-		DashboardURL:        dashboardURL,
-		AllowedWSOrigins:    allowedWSOrigins,
-		AgentRegistry:       agentRegistry,
-		ChainRegistry:       chainRegistry,
-		MCPServerRegistry:   mcpServerRegistry,
-		LLMProviderRegistry: llmProviderRegistry,
-		SkillRegistry:       skillRegistry,
+		configDir:             configDir,
+		Defaults:              defaults,
+		Queue:                 queueConfig,
+		GitHub:                githubCfg,
+		Runbooks:              runbooksCfg,
+		Slack:                 slackCfg,
+		PagerDuty:             pagerDutyCfg,
+		NotificationRouting:   notificationRoutingCfg,
+		Email:                 emailCfg,
+		Reports:               reportsCfg,
+		CostEstimation:        costEstimationCfg,
+		Compression:           compressionCfg,
+		BlobStore:             blobStoreCfg,
+		Retention:             retentionCfg,
+		Degradation:           degradationCfg,
+		ModelRouting:          modelRoutingCfg,
+		NoiseTriage:           noiseTriageCfg,
+		Transcription:         transcriptionCfg,
+		Callbacks:             callbacksCfg,
+		EventStream:           eventStreamCfg,
+		FeatureFlags:          featureFlags,
+		Logging:               loggingCfg,
+		Admins:                admins,
+		FaultInjection:        faultInjectionCfg,
+		Profiling:             profilingCfg,
+		RedactionReview:       redactionReviewCfg,
+		Federation:            federationCfg,
+		Targets:               targetsCfg,
+		ChatLimits:            chatLimitsCfg,
+		Recurrence:            recurrenceCfg,
+		OutcomeClassification: outcomeCfg,
+		ActionItems:           actionItemsCfg,
+		OnCall:                onCallCfg,
+		DeepLinks:             deepLinksCfg,
+		Enrichment:            enrichmentCfg,
+		RecentHistory:         recentHistoryCfg,
+		DashboardURL:          dashboardURL,
+		AllowedWSOrigins:      allowedWSOrigins,
+		AgentRegistry:         agentRegistry,
+		ChainRegistry:         chainRegistry,
+		MCPServerRegistry:     mcpServerRegistry,
+		LLMProviderRegistry:   llmProviderRegistry,
+		SkillRegistry:         skillRegistry,
 	}, nil
 }
 
@@ -337,8 +510,10 @@ func resolveRunbooksConfig(sys *SystemYAMLConfig) *RunbookConfig {
 // resolveSlackConfig resolves Slack configuration from system YAML, applying defaults.
 func resolveSlackConfig(sys *SystemYAMLConfig) *SlackConfig {
 	cfg := &SlackConfig{
-		Enabled:  false,
-		TokenEnv: "SLACK_BOT_TOKEN",
+		Enabled:            false,
+		TokenEnv:           "SLACK_BOT_TOKEN",
+		RateLimitPerMinute: 50,
+		DedupWindow:        10 * time.Minute,
 	}
 
 	if sys == nil || sys.Slack == nil {
@@ -355,10 +530,153 @@ func resolveSlackConfig(sys *SystemYAMLConfig) *SlackConfig {
 	if s.Channel != "" {
 		cfg.Channel = s.Channel
 	}
+	if s.ThreadStatusUpdates != nil {
+		cfg.ThreadStatusUpdates = *s.ThreadStatusUpdates
+	}
+	if s.RateLimitPerMinute != nil {
+		cfg.RateLimitPerMinute = *s.RateLimitPerMinute
+	}
+	if s.DedupWindow != "" {
+		if d, err := time.ParseDuration(s.DedupWindow); err == nil {
+			cfg.DedupWindow = d
+		} else {
+			slog.Warn("Invalid dedup_window in slack config, using default",
+				"value", s.DedupWindow,
+				"default", cfg.DedupWindow,
+				"error", err)
+		}
+	}
+
+	return cfg
+}
+
+// resolvePagerDutyConfig resolves PagerDuty configuration from system YAML, applying defaults.
+func resolvePagerDutyConfig(sys *SystemYAMLConfig) *PagerDutyConfig {
+	cfg := &PagerDutyConfig{
+		Enabled:       false,
+		RoutingKeyEnv: "PAGERDUTY_ROUTING_KEY",
+	}
+
+	if sys == nil || sys.PagerDuty == nil {
+		return cfg
+	}
+
+	pd := sys.PagerDuty
+	if pd.Enabled != nil {
+		cfg.Enabled = *pd.Enabled
+	}
+	if pd.RoutingKeyEnv != "" {
+		cfg.RoutingKeyEnv = pd.RoutingKeyEnv
+	}
 
 	return cfg
 }
 
+// resolveEmailConfig resolves email configuration from system YAML, applying defaults.
+func resolveEmailConfig(sys *SystemYAMLConfig) *EmailConfig {
+	cfg := &EmailConfig{
+		Enabled:     false,
+		SMTPPort:    587,
+		PasswordEnv: "SMTP_PASSWORD",
+		StartTLS:    true,
+		DigestHour:  8,
+	}
+
+	if sys == nil || sys.Email == nil {
+		return cfg
+	}
+
+	e := sys.Email
+	if e.Enabled != nil {
+		cfg.Enabled = *e.Enabled
+	}
+	if e.SMTPHost != "" {
+		cfg.SMTPHost = e.SMTPHost
+	}
+	if e.SMTPPort != 0 {
+		cfg.SMTPPort = e.SMTPPort
+	}
+	if e.Username != "" {
+		cfg.Username = e.Username
+	}
+	if e.PasswordEnv != "" {
+		cfg.PasswordEnv = e.PasswordEnv
+	}
+	if e.From != "" {
+		cfg.From = e.From
+	}
+	if e.StartTLS != nil {
+		cfg.StartTLS = *e.StartTLS
+	}
+	if e.DigestHour != nil {
+		cfg.DigestHour = *e.DigestHour
+	}
+
+	return cfg
+}
+
+// resolveReportsConfig resolves activity report configuration from system YAML, applying defaults.
+func resolveReportsConfig(sys *SystemYAMLConfig) *ReportsConfig {
+	cfg := &ReportsConfig{
+		Enabled:   false,
+		Periods:   []ReportPeriod{ReportPeriodDaily},
+		Hour:      8,
+		Weekday:   time.Monday,
+		Narrative: true,
+	}
+
+	if sys == nil || sys.Reports == nil {
+		return cfg
+	}
+
+	r := sys.Reports
+	if r.Enabled != nil {
+		cfg.Enabled = *r.Enabled
+	}
+	if len(r.Periods) > 0 {
+		cfg.Periods = r.Periods
+	}
+	if r.Hour != nil {
+		cfg.Hour = *r.Hour
+	}
+	if r.Weekday != "" {
+		if d, ok := parseWeekday(r.Weekday); ok {
+			cfg.Weekday = d
+		} else {
+			slog.Warn("Invalid system.reports.weekday, using default",
+				"value", r.Weekday, "default", cfg.Weekday)
+		}
+	}
+	if r.Narrative != nil {
+		cfg.Narrative = *r.Narrative
+	}
+	cfg.LLMProvider = r.LLMProvider
+	cfg.SlackChannel = r.SlackChannel
+	cfg.EmailRecipients = r.EmailRecipients
+	cfg.WebhookURL = r.WebhookURL
+
+	return cfg
+}
+
+// parseWeekday parses a case-insensitive English weekday name.
+func parseWeekday(s string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(s, d.String()) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// resolveNotificationRoutingConfig returns severity routing rules from system YAML.
+// Omitted block resolves to an empty rule set (everything goes to the default channel).
+func resolveNotificationRoutingConfig(sys *SystemYAMLConfig) *NotificationRoutingConfig {
+	if sys == nil || sys.NotificationRouting == nil {
+		return &NotificationRoutingConfig{}
+	}
+	return sys.NotificationRouting
+}
+
 // resolveCostEstimationConfig resolves cost-estimation config from system YAML.
 // Default: enabled=true when the block is omitted entirely.
 func resolveCostEstimationConfig(sys *SystemYAMLConfig) *CostEstimationConfig {
@@ -390,6 +708,82 @@ func resolveDashboardURL(sys *SystemYAMLConfig) string {
 	return "http://localhost:5173"
 }
 
+// resolveCompressionConfig resolves at-rest compression configuration from system YAML.
+// Defaults: enabled, 8 KiB threshold.
+func resolveCompressionConfig(sys *SystemYAMLConfig) *CompressionConfig {
+	cfg := &CompressionConfig{
+		Enabled:        true,
+		ThresholdBytes: 8192,
+	}
+
+	if sys == nil || sys.Compression == nil {
+		return cfg
+	}
+
+	c := sys.Compression
+	if c.Enabled != nil {
+		cfg.Enabled = *c.Enabled
+	}
+	if c.ThresholdBytes != 0 {
+		cfg.ThresholdBytes = c.ThresholdBytes
+	}
+
+	return cfg
+}
+
+// resolveBlobStoreConfig resolves blob store configuration from system YAML, applying defaults.
+func resolveBlobStoreConfig(sys *SystemYAMLConfig) *BlobStoreConfig {
+	cfg := DefaultBlobStoreConfig()
+
+	if sys == nil || sys.BlobStore == nil {
+		return cfg
+	}
+
+	b := sys.BlobStore
+	if b.Backend != "" {
+		cfg.Backend = b.Backend
+	}
+	cfg.Prefix = b.Prefix
+	if b.ToolResultThresholdBytes != nil {
+		cfg.ToolResultThresholdBytes = *b.ToolResultThresholdBytes
+	}
+	if b.DownloadURLTTL != 0 {
+		cfg.DownloadURLTTL = b.DownloadURLTTL
+	}
+	if b.SigningKeyEnv != "" {
+		cfg.SigningKeyEnv = b.SigningKeyEnv
+	}
+	if b.Local != nil {
+		cfg.Local.Dir = b.Local.Dir
+	}
+	if s3 := b.S3; s3 != nil {
+		cfg.S3.Bucket = s3.Bucket
+		cfg.S3.Region = s3.Region
+		cfg.S3.Endpoint = s3.Endpoint
+		cfg.S3.PathStyle = s3.PathStyle
+		if s3.AccessKeyEnv != "" {
+			cfg.S3.AccessKeyEnv = s3.AccessKeyEnv
+		}
+		if s3.SecretKeyEnv != "" {
+			cfg.S3.SecretKeyEnv = s3.SecretKeyEnv
+		}
+		if s3.SessionTokenEnv != "" {
+			cfg.S3.SessionTokenEnv = s3.SessionTokenEnv
+		}
+	}
+	if gcs := b.GCS; gcs != nil {
+		cfg.GCS.Bucket = gcs.Bucket
+		if gcs.AccessIDEnv != "" {
+			cfg.GCS.AccessIDEnv = gcs.AccessIDEnv
+		}
+		if gcs.SecretEnv != "" {
+			cfg.GCS.SecretEnv = gcs.SecretEnv
+		}
+	}
+
+	return cfg
+}
+
 // resolveRetentionConfig resolves retention configuration from system YAML, applying defaults.
 func resolveRetentionConfig(sys *SystemYAMLConfig) *RetentionConfig {
 	cfg := DefaultRetentionConfig()
@@ -412,6 +806,497 @@ func resolveRetentionConfig(sys *SystemYAMLConfig) *RetentionConfig {
 	return cfg
 }
 
+// resolveDegradationConfig resolves degradation configuration from system YAML, applying defaults.
+func resolveDegradationConfig(sys *SystemYAMLConfig) *DegradationConfig {
+	cfg := DefaultDegradationConfig()
+
+	if sys == nil || sys.Degradation == nil {
+		return cfg
+	}
+
+	d := sys.Degradation
+	cfg.Enabled = d.Enabled
+	if d.FailureThreshold > 0 {
+		cfg.FailureThreshold = d.FailureThreshold
+	}
+	if d.Cooldown > 0 {
+		cfg.Cooldown = d.Cooldown
+	}
+	if d.MaxIterations > 0 {
+		cfg.MaxIterations = d.MaxIterations
+	}
+	cfg.LLMProvider = d.LLMProvider
+
+	return cfg
+}
+
+// resolveModelRoutingConfig resolves model routing configuration from system YAML, applying defaults.
+func resolveModelRoutingConfig(sys *SystemYAMLConfig) *ModelRoutingConfig {
+	cfg := DefaultModelRoutingConfig()
+
+	if sys == nil || sys.ModelRouting == nil {
+		return cfg
+	}
+
+	r := sys.ModelRouting
+	cfg.Enabled = r.Enabled
+	cfg.ClassifierProvider = r.ClassifierProvider
+	cfg.FastProvider = r.FastProvider
+	cfg.StrongProvider = r.StrongProvider
+	if r.ComplexityThreshold > 0 {
+		cfg.ComplexityThreshold = r.ComplexityThreshold
+	}
+	if r.Timeout > 0 {
+		cfg.Timeout = r.Timeout
+	}
+
+	return cfg
+}
+
+// resolveNoiseTriageConfig resolves noise triage configuration from system
+// YAML, applying defaults. Alert types without min_confidence get the default.
+func resolveNoiseTriageConfig(sys *SystemYAMLConfig) *NoiseTriageConfig {
+	cfg := DefaultNoiseTriageConfig()
+
+	if sys == nil || sys.NoiseTriage == nil {
+		return cfg
+	}
+
+	t := sys.NoiseTriage
+	cfg.Enabled = t.Enabled
+	cfg.ClassifierProvider = t.ClassifierProvider
+	if t.Timeout > 0 {
+		cfg.Timeout = t.Timeout
+	}
+	if t.DuplicateWindow > 0 {
+		cfg.DuplicateWindow = t.DuplicateWindow
+	}
+	if len(t.AlertTypes) > 0 {
+		cfg.AlertTypes = make(map[string]NoiseTriageAlertTypeConfig, len(t.AlertTypes))
+		for alertType, at := range t.AlertTypes {
+			if at.MinConfidence == 0 {
+				at.MinConfidence = defaultNoiseTriageMinConfidence
+			}
+			cfg.AlertTypes[alertType] = at
+		}
+	}
+
+	return cfg
+}
+
+// resolveTranscriptionConfig resolves voice note transcription configuration
+// from system YAML, applying defaults.
+func resolveTranscriptionConfig(sys *SystemYAMLConfig) *TranscriptionConfig {
+	cfg := DefaultTranscriptionConfig()
+
+	if sys == nil || sys.Transcription == nil {
+		return cfg
+	}
+
+	t := sys.Transcription
+	cfg.Enabled = t.Enabled
+	if t.Provider != "" {
+		cfg.Provider = t.Provider
+	}
+	if t.Model != "" {
+		cfg.Model = t.Model
+	}
+	if t.APIKeyEnv != "" {
+		cfg.APIKeyEnv = t.APIKeyEnv
+	}
+	cfg.BaseURL = t.BaseURL
+	cfg.Language = t.Language
+	if t.Timeout > 0 {
+		cfg.Timeout = t.Timeout
+	}
+
+	return cfg
+}
+
+// resolveCallbacksConfig resolves completion callback configuration from
+// system YAML, applying defaults.
+func resolveCallbacksConfig(sys *SystemYAMLConfig) *CallbacksConfig {
+	cfg := DefaultCallbacksConfig()
+
+	if sys == nil || sys.Callbacks == nil {
+		return cfg
+	}
+
+	c := sys.Callbacks
+	cfg.Enabled = c.Enabled
+	cfg.AllowedHosts = c.AllowedHosts
+	if c.MaxAttempts != 0 {
+		cfg.MaxAttempts = c.MaxAttempts
+	}
+	if c.InitialBackoff != 0 {
+		cfg.InitialBackoff = c.InitialBackoff
+	}
+	if c.MaxBackoff != 0 {
+		cfg.MaxBackoff = c.MaxBackoff
+	}
+	if c.Timeout != 0 {
+		cfg.Timeout = c.Timeout
+	}
+
+	return cfg
+}
+
+// resolveEventStreamConfig resolves lifecycle event stream configuration
+// from system YAML, applying defaults.
+func resolveEventStreamConfig(sys *SystemYAMLConfig) *EventStreamConfig {
+	cfg := DefaultEventStreamConfig()
+
+	if sys == nil || sys.EventStream == nil {
+		return cfg
+	}
+
+	e := sys.EventStream
+	cfg.Enabled = e.Enabled
+	cfg.Endpoint = e.Endpoint
+	cfg.Events = e.Events
+	cfg.TokenEnv = e.TokenEnv
+	if e.Mode != "" {
+		cfg.Mode = e.Mode
+	}
+	if e.Source != "" {
+		cfg.Source = e.Source
+	}
+	if e.Timeout != 0 {
+		cfg.Timeout = e.Timeout
+	}
+	if e.MaxAttempts != 0 {
+		cfg.MaxAttempts = e.MaxAttempts
+	}
+	if e.BufferSize != 0 {
+		cfg.BufferSize = e.BufferSize
+	}
+
+	return cfg
+}
+
+// resolveFeatureFlags resolves feature flag rollouts from system YAML. Every
+// built-in flag gets an entry (enabled per its default); a YAML entry
+// replaces the default rollout of its flag. Unknown names are kept for the
+// validator to reject.
+func resolveFeatureFlags(sys *SystemYAMLConfig) map[string]*FeatureFlagConfig {
+	flags := make(map[string]*FeatureFlagConfig, len(featureFlagDefinitions))
+	for _, d := range featureFlagDefinitions {
+		flags[d.Name] = &FeatureFlagConfig{Enabled: d.Default}
+	}
+
+	if sys == nil {
+		return flags
+	}
+	for name, f := range sys.FeatureFlags {
+		flags[name] = &f
+	}
+
+	return flags
+}
+
+// resolveFaultInjectionConfig resolves fault injection configuration from
+// system YAML. Disabled (all rates zero) when omitted.
+func resolveFaultInjectionConfig(sys *SystemYAMLConfig) *FaultInjectionConfig {
+	if sys == nil || sys.FaultInjection == nil {
+		return &FaultInjectionConfig{}
+	}
+	cfg := *sys.FaultInjection
+	return &cfg
+}
+
+// resolveProfilingConfig resolves profiling configuration from system YAML, applying defaults.
+func resolveProfilingConfig(sys *SystemYAMLConfig) *ProfilingConfig {
+	cfg := DefaultProfilingConfig()
+
+	if sys == nil || sys.Profiling == nil {
+		return cfg
+	}
+
+	p := sys.Profiling
+	h := p.HeapCapture
+	cfg.HeapCapture.RSSThresholdMB = h.RSSThresholdMB
+	if h.Dir != "" {
+		cfg.HeapCapture.Dir = h.Dir
+	}
+	if h.MaxProfiles > 0 {
+		cfg.HeapCapture.MaxProfiles = h.MaxProfiles
+	}
+	if h.MinInterval > 0 {
+		cfg.HeapCapture.MinInterval = h.MinInterval
+	}
+	if h.CheckInterval > 0 {
+		cfg.HeapCapture.CheckInterval = h.CheckInterval
+	}
+
+	return cfg
+}
+
+// resolveRedactionReviewConfig resolves redaction review configuration from
+// system YAML, applying defaults.
+func resolveRedactionReviewConfig(sys *SystemYAMLConfig) *RedactionReviewConfig {
+	cfg := DefaultRedactionReviewConfig()
+
+	if sys == nil || sys.RedactionReview == nil {
+		return cfg
+	}
+
+	r := sys.RedactionReview
+	cfg.Enabled = r.Enabled
+	cfg.Reviewers = r.Reviewers
+	if r.MinEntropy != 0 {
+		cfg.MinEntropy = r.MinEntropy
+	}
+	if r.MaxPerInteraction != 0 {
+		cfg.MaxPerInteraction = r.MaxPerInteraction
+	}
+
+	return cfg
+}
+
+// resolveFederationConfig resolves stage delegation configuration from
+// system YAML, applying per-instance defaults.
+func resolveFederationConfig(sys *SystemYAMLConfig) *FederationConfig {
+	cfg := DefaultFederationConfig()
+
+	if sys == nil || sys.Federation == nil {
+		return cfg
+	}
+
+	f := sys.Federation
+	cfg.AcceptDelegations = f.AcceptDelegations
+	cfg.Instances = make(map[string]*FederationInstanceConfig, len(f.Instances))
+	for name, inst := range f.Instances {
+		if inst == nil {
+			cfg.Instances[name] = nil
+			continue
+		}
+		resolved := *inst
+		if resolved.DashboardURL == "" {
+			resolved.DashboardURL = resolved.URL
+		}
+		if resolved.Timeout == 0 {
+			resolved.Timeout = DefaultFederationTimeout
+		}
+		if resolved.PollInterval == 0 {
+			resolved.PollInterval = DefaultFederationPollInterval
+		}
+		cfg.Instances[name] = &resolved
+	}
+
+	return cfg
+}
+
+// resolveTargetsConfig resolves alert target configuration from system YAML.
+func resolveTargetsConfig(sys *SystemYAMLConfig) *TargetsConfig {
+	if sys == nil || sys.Targets == nil {
+		return DefaultTargetsConfig()
+	}
+	return sys.Targets
+}
+
+// resolveChatLimitsConfig resolves chat rate limits from system YAML, applying defaults.
+func resolveChatLimitsConfig(sys *SystemYAMLConfig) *ChatLimitsConfig {
+	cfg := DefaultChatLimitsConfig()
+
+	if sys == nil || sys.ChatLimits == nil {
+		return cfg
+	}
+
+	l := sys.ChatLimits
+	if l.PerUserPerMinute != nil {
+		cfg.PerUserPerMinute = *l.PerUserPerMinute
+	}
+	if l.PerSessionPerMinute != nil {
+		cfg.PerSessionPerMinute = *l.PerSessionPerMinute
+	}
+	if l.MaxConcurrentExecutions != nil {
+		cfg.MaxConcurrentExecutions = *l.MaxConcurrentExecutions
+	}
+	if l.MaxQueuedMessages != nil {
+		cfg.MaxQueuedMessages = *l.MaxQueuedMessages
+	}
+
+	return cfg
+}
+
+// resolveRecurrenceConfig resolves recurring alert comparison configuration
+// from system YAML, applying defaults.
+func resolveRecurrenceConfig(sys *SystemYAMLConfig) *RecurrenceConfig {
+	cfg := DefaultRecurrenceConfig()
+
+	if sys == nil || sys.Recurrence == nil {
+		return cfg
+	}
+
+	r := sys.Recurrence
+	if r.Enabled != nil {
+		cfg.Enabled = *r.Enabled
+	}
+	if r.LookbackWindow != 0 {
+		cfg.LookbackWindow = r.LookbackWindow
+	}
+	cfg.LLMProvider = r.LLMProvider
+
+	return cfg
+}
+
+// resolveOutcomeClassificationConfig resolves session outcome classification
+// configuration from system YAML, applying defaults.
+func resolveOutcomeClassificationConfig(sys *SystemYAMLConfig) *OutcomeClassificationConfig {
+	cfg := DefaultOutcomeClassificationConfig()
+
+	if sys == nil || sys.OutcomeClassification == nil {
+		return cfg
+	}
+
+	o := sys.OutcomeClassification
+	if o.Enabled != nil {
+		cfg.Enabled = *o.Enabled
+	}
+	cfg.LLMProvider = o.LLMProvider
+
+	return cfg
+}
+
+// resolveActionItemsConfig resolves action item extraction configuration
+// from system YAML, applying defaults.
+func resolveActionItemsConfig(sys *SystemYAMLConfig) *ActionItemsConfig {
+	cfg := DefaultActionItemsConfig()
+
+	if sys == nil || sys.ActionItems == nil {
+		return cfg
+	}
+
+	a := sys.ActionItems
+	if a.Enabled != nil {
+		cfg.Enabled = *a.Enabled
+	}
+	if a.MaxItems != 0 {
+		cfg.MaxItems = a.MaxItems
+	}
+	cfg.LLMProvider = a.LLMProvider
+
+	return cfg
+}
+
+// resolveOnCallConfig resolves on-call lookup configuration from system
+// YAML, applying defaults. The token env var defaults by provider.
+func resolveOnCallConfig(sys *SystemYAMLConfig) *OnCallConfig {
+	cfg := DefaultOnCallConfig()
+
+	if sys == nil || sys.OnCall == nil {
+		return cfg
+	}
+
+	o := sys.OnCall
+	cfg.Provider = o.Provider
+	cfg.APITokenEnv = o.APITokenEnv
+	if cfg.APITokenEnv == "" {
+		switch o.Provider {
+		case OnCallProviderPagerDuty:
+			cfg.APITokenEnv = "PAGERDUTY_API_TOKEN"
+		case OnCallProviderOpsgenie:
+			cfg.APITokenEnv = "OPSGENIE_API_KEY"
+		}
+	}
+	cfg.APIURL = o.APIURL
+	if o.ServiceLabel != "" {
+		cfg.ServiceLabel = o.ServiceLabel
+	}
+	cfg.DefaultService = o.DefaultService
+	cfg.Services = o.Services
+	if o.CacheTTL != 0 {
+		cfg.CacheTTL = o.CacheTTL
+	}
+
+	return cfg
+}
+
+// resolveDeepLinksConfig resolves link templates from system YAML. Links
+// are disabled (no templates) unless configured.
+func resolveDeepLinksConfig(sys *SystemYAMLConfig) *DeepLinksConfig {
+	cfg := DefaultDeepLinksConfig()
+
+	if sys == nil || sys.DeepLinks == nil {
+		return cfg
+	}
+
+	d := sys.DeepLinks
+	if d.DefaultLookback != 0 {
+		cfg.DefaultLookback = d.DefaultLookback
+	}
+	cfg.Templates = d.Templates
+
+	return cfg
+}
+
+// resolveEnrichmentConfig resolves the lookup tool sources from system
+// YAML. Both lookups are disabled unless configured.
+func resolveEnrichmentConfig(sys *SystemYAMLConfig, configDir string) *EnrichmentConfig {
+	if sys == nil || sys.Enrichment == nil {
+		return &EnrichmentConfig{}
+	}
+	return &EnrichmentConfig{
+		IPLookup:   resolveEnrichmentSource(sys.Enrichment.IPLookup, configDir),
+		HostLookup: resolveEnrichmentSource(sys.Enrichment.HostLookup, configDir),
+	}
+}
+
+// resolveRecentHistoryConfig resolves the recent history prompt block from
+// system YAML, applying defaults.
+func resolveRecentHistoryConfig(sys *SystemYAMLConfig) *RecentHistoryConfig {
+	cfg := DefaultRecentHistoryConfig()
+
+	if sys == nil || sys.RecentHistory == nil {
+		return cfg
+	}
+
+	r := sys.RecentHistory
+	cfg.Enabled = r.Enabled
+	if r.Window != 0 {
+		cfg.Window = r.Window
+	}
+	if r.MaxSessions != 0 {
+		cfg.MaxSessions = r.MaxSessions
+	}
+	if r.ServiceLabel != "" {
+		cfg.ServiceLabel = r.ServiceLabel
+	}
+
+	return cfg
+}
+
+// resolveLoggingConfig resolves logging configuration from system YAML, applying defaults.
+func resolveLoggingConfig(sys *SystemYAMLConfig) *LoggingConfig {
+	cfg := &LoggingConfig{
+		Levels:          map[string]string{},
+		RedactSensitive: true,
+	}
+
+	if sys == nil || sys.Logging == nil {
+		return cfg
+	}
+
+	l := sys.Logging
+	for sub, level := range l.Levels {
+		cfg.Levels[sub] = level
+	}
+	if l.RedactSensitive != nil {
+		cfg.RedactSensitive = *l.RedactSensitive
+	}
+
+	return cfg
+}
+
+// resolveAdmins resolves the admin allowlist from system YAML. The admin
+// endpoints are disabled (no admins) unless configured.
+func resolveAdmins(sys *SystemYAMLConfig) []string {
+	if sys == nil {
+		return nil
+	}
+	return sys.Admins
+}
+
 // resolveAllowedWSOrigins returns additional WebSocket origin patterns from system YAML.
 func resolveAllowedWSOrigins(sys *SystemYAMLConfig) []string {
 	if sys != nil {
@@ -336,6 +336,19 @@ llm_providers:
 	assert.Equal(t, "TEST_API_KEY", provider.APIKeyEnv)
 }
 
+func TestResolveTokenizerFiles(t *testing.T) {
+	providers := map[string]*LLMProviderConfig{
+		"relative": {Tokenizer: &TokenizerConfig{Type: TokenizerTypeTiktoken, File: "tokenizers/o200k_base.tiktoken"}},
+		"absolute": {Tokenizer: &TokenizerConfig{Type: TokenizerTypeSentencePiece, File: "/models/tokenizer.model"}},
+		"none":     {},
+	}
+	resolveTokenizerFiles(providers, "/etc/tarsy")
+
+	assert.Equal(t, "/etc/tarsy/tokenizers/o200k_base.tiktoken", providers["relative"].Tokenizer.File)
+	assert.Equal(t, "/models/tokenizer.model", providers["absolute"].Tokenizer.File)
+	assert.Nil(t, providers["none"].Tokenizer)
+}
+
 func TestEnvironmentVariableInterpolationInConfig(t *testing.T) {
 	configDir := t.TempDir()
 
@@ -846,6 +859,732 @@ func TestResolveRetentionConfig(t *testing.T) {
 	})
 }
 
+func TestResolveDegradationConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveDegradationConfig(nil)
+		assert.Equal(t, DefaultDegradationConfig(), cfg)
+		assert.False(t, cfg.Enabled)
+	})
+
+	t.Run("partial config keeps defaults for unset fields", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			Degradation: &DegradationConfig{
+				Enabled:     true,
+				Cooldown:    10 * time.Minute,
+				LLMProvider: "gemini-flash",
+			},
+		}
+		cfg := resolveDegradationConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, 3, cfg.FailureThreshold)
+		assert.Equal(t, 10*time.Minute, cfg.Cooldown)
+		assert.Equal(t, 5, cfg.MaxIterations)
+		assert.Equal(t, "gemini-flash", cfg.LLMProvider)
+	})
+}
+
+func TestResolveRecurrenceConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveRecurrenceConfig(nil)
+		assert.Equal(t, DefaultRecurrenceConfig(), cfg)
+		assert.True(t, cfg.Enabled)
+	})
+
+	t.Run("explicit disable and overrides", func(t *testing.T) {
+		disabled := false
+		sys := &SystemYAMLConfig{
+			Recurrence: &RecurrenceYAMLConfig{
+				Enabled:     &disabled,
+				LLMProvider: "gemini-flash",
+			},
+		}
+		cfg := resolveRecurrenceConfig(sys)
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, 30*24*time.Hour, cfg.LookbackWindow)
+		assert.Equal(t, "gemini-flash", cfg.LLMProvider)
+	})
+}
+
+func TestResolveOutcomeClassificationConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveOutcomeClassificationConfig(nil)
+		assert.Equal(t, DefaultOutcomeClassificationConfig(), cfg)
+		assert.True(t, cfg.Enabled)
+	})
+
+	t.Run("explicit disable and provider", func(t *testing.T) {
+		disabled := false
+		sys := &SystemYAMLConfig{
+			OutcomeClassification: &OutcomeClassificationYAMLConfig{
+				Enabled:     &disabled,
+				LLMProvider: "gemini-flash",
+			},
+		}
+		cfg := resolveOutcomeClassificationConfig(sys)
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, "gemini-flash", cfg.LLMProvider)
+	})
+}
+
+func TestResolveActionItemsConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveActionItemsConfig(nil)
+		assert.Equal(t, DefaultActionItemsConfig(), cfg)
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, 10, cfg.MaxItems)
+	})
+
+	t.Run("explicit values", func(t *testing.T) {
+		enabled := true
+		sys := &SystemYAMLConfig{
+			ActionItems: &ActionItemsYAMLConfig{
+				Enabled:     &enabled,
+				LLMProvider: "gemini-flash",
+				MaxItems:    5,
+			},
+		}
+		cfg := resolveActionItemsConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, "gemini-flash", cfg.LLMProvider)
+		assert.Equal(t, 5, cfg.MaxItems)
+	})
+}
+
+func TestResolveOnCallConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveOnCallConfig(nil)
+		assert.Equal(t, DefaultOnCallConfig(), cfg)
+		assert.False(t, cfg.Enabled())
+	})
+
+	t.Run("token env defaults by provider", func(t *testing.T) {
+		pd := resolveOnCallConfig(&SystemYAMLConfig{OnCall: &OnCallYAMLConfig{Provider: OnCallProviderPagerDuty}})
+		assert.Equal(t, "PAGERDUTY_API_TOKEN", pd.APITokenEnv)
+		og := resolveOnCallConfig(&SystemYAMLConfig{OnCall: &OnCallYAMLConfig{Provider: OnCallProviderOpsgenie}})
+		assert.Equal(t, "OPSGENIE_API_KEY", og.APITokenEnv)
+	})
+
+	t.Run("explicit values", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			OnCall: &OnCallYAMLConfig{
+				Provider:       OnCallProviderOpsgenie,
+				APITokenEnv:    "GENIE_KEY",
+				APIURL:         "https://api.eu.opsgenie.com",
+				ServiceLabel:   "app",
+				DefaultService: "platform",
+				Services:       map[string]*OnCallServiceConfig{"platform": {ScheduleID: "S1"}},
+				CacheTTL:       time.Minute,
+			},
+		}
+		cfg := resolveOnCallConfig(sys)
+		assert.True(t, cfg.Enabled())
+		assert.Equal(t, "GENIE_KEY", cfg.APITokenEnv)
+		assert.Equal(t, "https://api.eu.opsgenie.com", cfg.APIURL)
+		assert.Equal(t, "app", cfg.ServiceLabel)
+		assert.Equal(t, "platform", cfg.DefaultService)
+		assert.Equal(t, "S1", cfg.Services["platform"].ScheduleID)
+		assert.Equal(t, time.Minute, cfg.CacheTTL)
+	})
+}
+
+func TestResolveDeepLinksConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveDeepLinksConfig(nil)
+		assert.Equal(t, DefaultDeepLinksConfig(), cfg)
+		assert.False(t, cfg.Enabled())
+	})
+
+	t.Run("explicit values", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			DeepLinks: &DeepLinksYAMLConfig{
+				DefaultLookback: 2 * time.Hour,
+				Templates: map[string]*DeepLinkTemplate{
+					"loki": {Description: "Loki logs", URL: "https://grafana.example.com/explore?q={query}"},
+				},
+			},
+		}
+		cfg := resolveDeepLinksConfig(sys)
+		assert.True(t, cfg.Enabled())
+		assert.Equal(t, 2*time.Hour, cfg.DefaultLookback)
+		assert.Equal(t, "Loki logs", cfg.Templates["loki"].Description)
+	})
+}
+
+func TestResolveEnrichmentConfig(t *testing.T) {
+	t.Run("nil system config disables lookups", func(t *testing.T) {
+		cfg := resolveEnrichmentConfig(nil, "/etc/tarsy")
+		assert.False(t, cfg.Enabled())
+	})
+
+	t.Run("resolves CSV paths and HTTP timeouts", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			Enrichment: &EnrichmentYAMLConfig{
+				IPLookup:   &EnrichmentSourceConfig{CSVFile: "data/ip-ranges.csv"},
+				HostLookup: &EnrichmentSourceConfig{URL: "https://cmdb.example.com/hosts/{hostname}"},
+			},
+		}
+		cfg := resolveEnrichmentConfig(sys, "/etc/tarsy")
+		assert.True(t, cfg.Enabled())
+		assert.Equal(t, "/etc/tarsy/data/ip-ranges.csv", cfg.IPLookup.CSVFile)
+		assert.Equal(t, DefaultEnrichmentTimeout, cfg.HostLookup.Timeout)
+		assert.Equal(t, "data/ip-ranges.csv", sys.Enrichment.IPLookup.CSVFile, "YAML config must not be modified")
+	})
+
+	t.Run("absolute CSV path kept", func(t *testing.T) {
+		sys := &SystemYAMLConfig{Enrichment: &EnrichmentYAMLConfig{HostLookup: &EnrichmentSourceConfig{CSVFile: "/data/hosts.csv"}}}
+		cfg := resolveEnrichmentConfig(sys, "/etc/tarsy")
+		assert.Nil(t, cfg.IPLookup)
+		assert.Equal(t, "/data/hosts.csv", cfg.HostLookup.CSVFile)
+	})
+}
+
+func TestResolveRecentHistoryConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveRecentHistoryConfig(nil)
+		assert.Equal(t, DefaultRecentHistoryConfig(), cfg)
+		assert.False(t, cfg.Enabled)
+	})
+
+	t.Run("enable and overrides", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			RecentHistory: &RecentHistoryYAMLConfig{
+				Enabled:     true,
+				Window:      72 * time.Hour,
+				MaxSessions: 5,
+			},
+		}
+		cfg := resolveRecentHistoryConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, 72*time.Hour, cfg.Window)
+		assert.Equal(t, 5, cfg.MaxSessions)
+		assert.Equal(t, "service", cfg.ServiceLabel)
+	})
+}
+
+func TestResolveModelRoutingConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveModelRoutingConfig(nil)
+		assert.Equal(t, DefaultModelRoutingConfig(), cfg)
+		assert.False(t, cfg.Enabled)
+	})
+
+	t.Run("partial config keeps defaults for unset fields", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			ModelRouting: &ModelRoutingConfig{
+				Enabled:            true,
+				ClassifierProvider: "flash",
+				FastProvider:       "flash",
+				StrongProvider:     "pro",
+				Timeout:            10 * time.Second,
+			},
+		}
+		cfg := resolveModelRoutingConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, "flash", cfg.ClassifierProvider)
+		assert.Equal(t, "flash", cfg.FastProvider)
+		assert.Equal(t, "pro", cfg.StrongProvider)
+		assert.Equal(t, 6, cfg.ComplexityThreshold)
+		assert.Equal(t, 10*time.Second, cfg.Timeout)
+	})
+}
+
+func TestResolveNoiseTriageConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveNoiseTriageConfig(nil)
+		assert.Equal(t, DefaultNoiseTriageConfig(), cfg)
+		assert.False(t, cfg.Enabled)
+	})
+
+	t.Run("alert types default min_confidence", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			NoiseTriage: &NoiseTriageConfig{
+				Enabled:            true,
+				ClassifierProvider: "flash",
+				AlertTypes: map[string]NoiseTriageAlertTypeConfig{
+					"KubeJobFailed": {},
+					"TargetDown":    {MinConfidence: 0.95},
+				},
+			},
+		}
+		cfg := resolveNoiseTriageConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, 30*time.Second, cfg.Timeout)
+		assert.Equal(t, time.Hour, cfg.DuplicateWindow)
+		assert.Equal(t, 0.8, cfg.AlertTypes["KubeJobFailed"].MinConfidence)
+		assert.Equal(t, 0.95, cfg.AlertTypes["TargetDown"].MinConfidence)
+	})
+}
+
+func TestNoiseTriageConfig_ForAlertType(t *testing.T) {
+	cfg := &NoiseTriageConfig{
+		Enabled: true,
+		AlertTypes: map[string]NoiseTriageAlertTypeConfig{
+			"*":              {MinConfidence: 0.9},
+			"TargetDown":     {MinConfidence: 0.7},
+			"SecurityBreach": {ForceFull: true},
+		},
+	}
+
+	at, ok := cfg.ForAlertType("TargetDown")
+	assert.True(t, ok)
+	assert.Equal(t, 0.7, at.MinConfidence)
+
+	at, ok = cfg.ForAlertType("KubeJobFailed")
+	assert.True(t, ok, "wildcard covers unlisted alert types")
+	assert.Equal(t, 0.9, at.MinConfidence)
+
+	_, ok = cfg.ForAlertType("SecurityBreach")
+	assert.False(t, ok, "force_full exempts the alert type")
+
+	delete(cfg.AlertTypes, "*")
+	_, ok = cfg.ForAlertType("KubeJobFailed")
+	assert.False(t, ok)
+
+	cfg.Enabled = false
+	_, ok = cfg.ForAlertType("TargetDown")
+	assert.False(t, ok)
+}
+
+func TestResolveTranscriptionConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveTranscriptionConfig(nil)
+		assert.Equal(t, DefaultTranscriptionConfig(), cfg)
+		assert.False(t, cfg.Enabled)
+	})
+
+	t.Run("partial config keeps defaults for unset fields", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			Transcription: &TranscriptionConfig{
+				Enabled:  true,
+				Model:    "gpt-4o-mini-transcribe",
+				Language: "en",
+			},
+		}
+		cfg := resolveTranscriptionConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, TranscriptionProviderOpenAI, cfg.Provider)
+		assert.Equal(t, "gpt-4o-mini-transcribe", cfg.Model)
+		assert.Equal(t, "OPENAI_API_KEY", cfg.APIKeyEnv)
+		assert.Equal(t, "en", cfg.Language)
+		assert.Equal(t, 60*time.Second, cfg.Timeout)
+	})
+}
+
+func TestResolveCallbacksConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveCallbacksConfig(nil)
+		assert.Equal(t, DefaultCallbacksConfig(), cfg)
+		assert.False(t, cfg.Enabled)
+	})
+
+	t.Run("partial config keeps defaults for unset fields", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			Callbacks: &CallbacksConfig{
+				Enabled:      true,
+				AllowedHosts: []string{"ci.example.com"},
+				MaxAttempts:  3,
+			},
+		}
+		cfg := resolveCallbacksConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, []string{"ci.example.com"}, cfg.AllowedHosts)
+		assert.Equal(t, 3, cfg.MaxAttempts)
+		assert.Equal(t, 10*time.Second, cfg.InitialBackoff)
+		assert.Equal(t, 5*time.Minute, cfg.MaxBackoff)
+		assert.Equal(t, 10*time.Second, cfg.Timeout)
+	})
+}
+
+func TestResolveEventStreamConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveEventStreamConfig(nil)
+		assert.Equal(t, DefaultEventStreamConfig(), cfg)
+		assert.False(t, cfg.Enabled)
+	})
+
+	t.Run("partial config keeps defaults for unset fields", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			EventStream: &EventStreamConfig{
+				Enabled:  true,
+				Endpoint: "http://broker-ingress.knative-eventing/tarsy/default",
+				Mode:     EventStreamModeBinary,
+				Events:   []string{StreamEventSessionTerminal},
+			},
+		}
+		cfg := resolveEventStreamConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, "http://broker-ingress.knative-eventing/tarsy/default", cfg.Endpoint)
+		assert.Equal(t, EventStreamModeBinary, cfg.Mode)
+		assert.Equal(t, []string{StreamEventSessionTerminal}, cfg.Events)
+		assert.Equal(t, "tarsy", cfg.Source)
+		assert.Equal(t, 10*time.Second, cfg.Timeout)
+		assert.Equal(t, 3, cfg.MaxAttempts)
+		assert.Equal(t, 1000, cfg.BufferSize)
+	})
+}
+
+func TestResolveFederationConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveFederationConfig(nil)
+		assert.Equal(t, DefaultFederationConfig(), cfg)
+		assert.False(t, cfg.AcceptDelegations)
+		assert.Nil(t, cfg.Instance("east"))
+	})
+
+	t.Run("instances get defaults for unset fields", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			Federation: &FederationConfig{
+				AcceptDelegations: true,
+				Instances: map[string]*FederationInstanceConfig{
+					"east": {URL: "https://tarsy.east.example.com", Timeout: time.Hour},
+				},
+			},
+		}
+		cfg := resolveFederationConfig(sys)
+		assert.True(t, cfg.AcceptDelegations)
+		east := cfg.Instance("east")
+		require.NotNil(t, east)
+		assert.Equal(t, "https://tarsy.east.example.com", east.DashboardURL)
+		assert.Equal(t, time.Hour, east.Timeout)
+		assert.Equal(t, DefaultFederationPollInterval, east.PollInterval)
+		assert.Zero(t, sys.Federation.Instances["east"].PollInterval, "YAML config is not modified")
+	})
+}
+
+func TestEventStreamConfig_WantsEvent(t *testing.T) {
+	var nilCfg *EventStreamConfig
+	assert.False(t, nilCfg.WantsEvent(StreamEventSessionCreated))
+	assert.False(t, DefaultEventStreamConfig().WantsEvent(StreamEventSessionCreated), "disabled")
+
+	all := &EventStreamConfig{Enabled: true}
+	for _, name := range StreamEvents {
+		assert.True(t, all.WantsEvent(name), name)
+	}
+
+	some := &EventStreamConfig{Enabled: true, Events: []string{StreamEventSessionTerminal}}
+	assert.True(t, some.WantsEvent(StreamEventSessionTerminal))
+	assert.False(t, some.WantsEvent(StreamEventStageCompleted))
+}
+
+func TestResolveFeatureFlags(t *testing.T) {
+	t.Run("nil system config uses built-in defaults", func(t *testing.T) {
+		flags := resolveFeatureFlags(nil)
+		require.Len(t, flags, len(FeatureFlagDefinitions()))
+		for _, d := range FeatureFlagDefinitions() {
+			require.Contains(t, flags, d.Name)
+			assert.Equal(t, d.Default, flags[d.Name].Enabled)
+		}
+	})
+
+	t.Run("YAML entry replaces the default rollout", func(t *testing.T) {
+		pct := 20
+		sys := &SystemYAMLConfig{
+			FeatureFlags: map[string]FeatureFlagConfig{
+				FeatureFlagFanOut: {Enabled: true, Percentage: &pct, AlertTypes: []string{"Outage"}},
+			},
+		}
+		flags := resolveFeatureFlags(sys)
+		assert.Equal(t, &FeatureFlagConfig{Enabled: true, Percentage: &pct, AlertTypes: []string{"Outage"}}, flags[FeatureFlagFanOut])
+		assert.True(t, flags[FeatureFlagModelRouting].Enabled)
+	})
+}
+
+func TestResolveLoggingConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveLoggingConfig(nil)
+		assert.Empty(t, cfg.Levels)
+		assert.True(t, cfg.RedactSensitive)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			Logging: &LoggingYAMLConfig{
+				Levels:          map[string]string{"mcp": "debug", "queue": "warn"},
+				RedactSensitive: BoolPtr(false),
+			},
+		}
+		cfg := resolveLoggingConfig(sys)
+		assert.Equal(t, map[string]string{"mcp": "debug", "queue": "warn"}, cfg.Levels)
+		assert.False(t, cfg.RedactSensitive)
+	})
+}
+
+func TestResolveProfilingConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveProfilingConfig(nil)
+		assert.Zero(t, cfg.HeapCapture.RSSThresholdMB)
+		assert.NotEmpty(t, cfg.HeapCapture.Dir)
+		assert.Equal(t, 5, cfg.HeapCapture.MaxProfiles)
+		assert.Equal(t, 30*time.Minute, cfg.HeapCapture.MinInterval)
+		assert.Equal(t, 30*time.Second, cfg.HeapCapture.CheckInterval)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			Profiling: &ProfilingConfig{
+				HeapCapture: HeapCaptureConfig{
+					RSSThresholdMB: 1536,
+					Dir:            "/var/tmp/heap",
+					MinInterval:    time.Hour,
+				},
+			},
+		}
+		cfg := resolveProfilingConfig(sys)
+		assert.Equal(t, 1536, cfg.HeapCapture.RSSThresholdMB)
+		assert.Equal(t, "/var/tmp/heap", cfg.HeapCapture.Dir)
+		assert.Equal(t, time.Hour, cfg.HeapCapture.MinInterval)
+		assert.Equal(t, 5, cfg.HeapCapture.MaxProfiles)
+	})
+}
+
+func TestResolveCompressionConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveCompressionConfig(nil)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, 8192, cfg.ThresholdBytes)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		disabled := false
+		sys := &SystemYAMLConfig{
+			Compression: &CompressionYAMLConfig{Enabled: &disabled, ThresholdBytes: 65536},
+		}
+		cfg := resolveCompressionConfig(sys)
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, 65536, cfg.ThresholdBytes)
+	})
+}
+
+func TestResolveBlobStoreConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveBlobStoreConfig(nil)
+		assert.Equal(t, BlobBackendPostgres, cfg.Backend)
+		assert.Equal(t, DefaultToolResultOffloadBytes, cfg.ToolResultThresholdBytes)
+		assert.Equal(t, DefaultDownloadURLTTL, cfg.DownloadURLTTL)
+		assert.Equal(t, "TARSY_BLOB_SIGNING_KEY", cfg.SigningKeyEnv)
+		assert.Equal(t, "AWS_ACCESS_KEY_ID", cfg.S3.AccessKeyEnv)
+		assert.Equal(t, "GCS_HMAC_SECRET", cfg.GCS.SecretEnv)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		threshold := 0
+		sys := &SystemYAMLConfig{
+			BlobStore: &BlobStoreYAMLConfig{
+				Backend:                  BlobBackendS3,
+				Prefix:                   "tarsy/",
+				ToolResultThresholdBytes: &threshold,
+				DownloadURLTTL:           time.Hour,
+				SigningKeyEnv:            "BLOB_SIGNING_KEY",
+				S3: &S3BlobStoreConfig{
+					Bucket:       "tarsy-blobs",
+					Region:       "eu-west-1",
+					PathStyle:    true,
+					AccessKeyEnv: "BLOB_ACCESS_KEY",
+				},
+			},
+		}
+		cfg := resolveBlobStoreConfig(sys)
+		assert.Equal(t, BlobBackendS3, cfg.Backend)
+		assert.Equal(t, "tarsy/", cfg.Prefix)
+		assert.Equal(t, 0, cfg.ToolResultThresholdBytes)
+		assert.Equal(t, time.Hour, cfg.DownloadURLTTL)
+		assert.Equal(t, "BLOB_SIGNING_KEY", cfg.SigningKeyEnv)
+		assert.Equal(t, "tarsy-blobs", cfg.S3.Bucket)
+		assert.Equal(t, "eu-west-1", cfg.S3.Region)
+		assert.True(t, cfg.S3.PathStyle)
+		assert.Equal(t, "BLOB_ACCESS_KEY", cfg.S3.AccessKeyEnv)
+		assert.Equal(t, "AWS_SECRET_ACCESS_KEY", cfg.S3.SecretKeyEnv)
+	})
+}
+
+func TestResolvePagerDutyConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolvePagerDutyConfig(nil)
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, "PAGERDUTY_ROUTING_KEY", cfg.RoutingKeyEnv)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		sys := &SystemYAMLConfig{
+			PagerDuty: &PagerDutyYAMLConfig{Enabled: BoolPtr(true), RoutingKeyEnv: "PD_KEY"},
+		}
+		cfg := resolvePagerDutyConfig(sys)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, "PD_KEY", cfg.RoutingKeyEnv)
+	})
+}
+
+func TestResolveSlackConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveSlackConfig(nil)
+		assert.False(t, cfg.Enabled)
+		assert.False(t, cfg.ThreadStatusUpdates)
+		assert.Equal(t, 50, cfg.RateLimitPerMinute)
+		assert.Equal(t, 10*time.Minute, cfg.DedupWindow)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		rate := 0
+		sys := &SystemYAMLConfig{
+			Slack: &SlackYAMLConfig{
+				Enabled:             BoolPtr(true),
+				Channel:             "C1",
+				ThreadStatusUpdates: BoolPtr(true),
+				RateLimitPerMinute:  &rate,
+				DedupWindow:         "30s",
+			},
+		}
+		cfg := resolveSlackConfig(sys)
+		assert.True(t, cfg.ThreadStatusUpdates)
+		assert.Equal(t, 0, cfg.RateLimitPerMinute)
+		assert.Equal(t, 30*time.Second, cfg.DedupWindow)
+	})
+
+	t.Run("invalid dedup window keeps default", func(t *testing.T) {
+		cfg := resolveSlackConfig(&SystemYAMLConfig{Slack: &SlackYAMLConfig{DedupWindow: "soon"}})
+		assert.Equal(t, 10*time.Minute, cfg.DedupWindow)
+	})
+}
+
+func TestResolveChatLimitsConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveChatLimitsConfig(nil)
+		assert.Equal(t, DefaultChatLimitsConfig(), cfg)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		unlimited, perSession, queued := 0, 5, 3
+		sys := &SystemYAMLConfig{
+			ChatLimits: &ChatLimitsYAMLConfig{
+				PerUserPerMinute:    &unlimited,
+				PerSessionPerMinute: &perSession,
+				MaxQueuedMessages:   &queued,
+			},
+		}
+		cfg := resolveChatLimitsConfig(sys)
+		assert.Equal(t, 0, cfg.PerUserPerMinute)
+		assert.Equal(t, 5, cfg.PerSessionPerMinute)
+		assert.Equal(t, 10, cfg.MaxConcurrentExecutions)
+		assert.Equal(t, 3, cfg.MaxQueuedMessages)
+	})
+}
+
+func TestResolveEmailConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveEmailConfig(nil)
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, 587, cfg.SMTPPort)
+		assert.Equal(t, "SMTP_PASSWORD", cfg.PasswordEnv)
+		assert.True(t, cfg.StartTLS)
+		assert.Equal(t, 8, cfg.DigestHour)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		hour := 0
+		sys := &SystemYAMLConfig{
+			Email: &EmailYAMLConfig{
+				Enabled:     BoolPtr(true),
+				SMTPHost:    "smtp.example.com",
+				SMTPPort:    25,
+				Username:    "tarsy",
+				PasswordEnv: "MAIL_PASS",
+				From:        "tarsy@example.com",
+				StartTLS:    BoolPtr(false),
+				DigestHour:  &hour,
+			},
+		}
+		cfg := resolveEmailConfig(sys)
+		assert.Equal(t, &EmailConfig{
+			Enabled:     true,
+			SMTPHost:    "smtp.example.com",
+			SMTPPort:    25,
+			Username:    "tarsy",
+			PasswordEnv: "MAIL_PASS",
+			From:        "tarsy@example.com",
+			StartTLS:    false,
+			DigestHour:  0,
+		}, cfg)
+	})
+}
+
+func TestResolveReportsConfig(t *testing.T) {
+	t.Run("nil system config uses defaults", func(t *testing.T) {
+		cfg := resolveReportsConfig(nil)
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, []ReportPeriod{ReportPeriodDaily}, cfg.Periods)
+		assert.Equal(t, 8, cfg.Hour)
+		assert.Equal(t, time.Monday, cfg.Weekday)
+		assert.True(t, cfg.Narrative)
+	})
+
+	t.Run("explicit values override defaults", func(t *testing.T) {
+		hour := 6
+		sys := &SystemYAMLConfig{
+			Reports: &ReportsYAMLConfig{
+				Enabled:         BoolPtr(true),
+				Periods:         []ReportPeriod{ReportPeriodDaily, ReportPeriodWeekly},
+				Hour:            &hour,
+				Weekday:         "Friday",
+				Narrative:       BoolPtr(false),
+				LLMProvider:     "gemini-flash",
+				SlackChannel:    "C-reports",
+				EmailRecipients: []string{"sre@example.com"},
+				WebhookURL:      "https://hooks.example.com/tarsy",
+			},
+		}
+		cfg := resolveReportsConfig(sys)
+		assert.Equal(t, &ReportsConfig{
+			Enabled:         true,
+			Periods:         []ReportPeriod{ReportPeriodDaily, ReportPeriodWeekly},
+			Hour:            6,
+			Weekday:         time.Friday,
+			Narrative:       false,
+			LLMProvider:     "gemini-flash",
+			SlackChannel:    "C-reports",
+			EmailRecipients: []string{"sre@example.com"},
+			WebhookURL:      "https://hooks.example.com/tarsy",
+		}, cfg)
+	})
+
+	t.Run("invalid weekday keeps default", func(t *testing.T) {
+		cfg := resolveReportsConfig(&SystemYAMLConfig{
+			Reports: &ReportsYAMLConfig{Weekday: "someday"},
+		})
+		assert.Equal(t, time.Monday, cfg.Weekday)
+	})
+}
+
+func TestNotificationRoutingYAMLLoading(t *testing.T) {
+	dir := t.TempDir()
+
+	tarsyYAML := `
+system:
+  notification_routing:
+    rules:
+      - severities: [critical, high]
+        slack_channel: "C-oncall"
+        pagerduty: true
+      - severities: [medium]
+        slack_channel: "C-alerts"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tarsy.yaml"), []byte(tarsyYAML), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "llm-providers.yaml"), []byte("llm_providers: {}\n"), 0644))
+
+	cfg, err := load(context.Background(), dir)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.NotificationRouting.Rules, 2)
+	assert.Equal(t, []Severity{SeverityCritical, SeverityHigh}, cfg.NotificationRouting.Rules[0].Severities)
+	assert.True(t, cfg.NotificationRouting.Rules[0].PagerDuty)
+
+	rule := cfg.NotificationRouting.Route(SeverityMedium)
+	require.NotNil(t, rule)
+	assert.Equal(t, "C-alerts", rule.SlackChannel)
+	assert.False(t, rule.PagerDuty)
+	assert.Nil(t, cfg.NotificationRouting.Route(SeverityLow))
+	assert.Nil(t, cfg.NotificationRouting.Route(""))
+}
+
 func TestSystemConfigYAMLLoading(t *testing.T) {
 	t.Run("system section parsed from YAML", func(t *testing.T) {
 		dir := t.TempDir()
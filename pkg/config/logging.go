@@ -0,0 +1,20 @@
+package config
+
+// LoggingConfig controls per-subsystem log levels and redaction of
+// sensitive values in debug logs. The base level comes from LOG_LEVEL.
+type LoggingConfig struct {
+	// Levels overrides LOG_LEVEL for individual subsystems
+	// (queue, mcp, events, agent), e.g. {"mcp": "debug"}.
+	Levels map[string]string
+
+	// RedactSensitive passes alert data snippets and tool arguments through
+	// the masking service before they are logged at debug level.
+	RedactSensitive bool
+}
+
+// LoggingYAMLConfig holds logging settings from YAML.
+// RedactSensitive is a *bool: nil means enabled (default true).
+type LoggingYAMLConfig struct {
+	Levels          map[string]string `yaml:"levels,omitempty"`
+	RedactSensitive *bool             `yaml:"redact_sensitive,omitempty"`
+}
@@ -19,6 +19,22 @@ type MCPServerConfig struct {
 
 	// Summarization configuration (critical for large responses)
 	Summarization *SummarizationConfig `yaml:"summarization,omitempty"`
+
+	// MaxConcurrentCalls caps how many tool calls from one LLM iteration run
+	// against this server at once (0 = DefaultMCPMaxConcurrentCalls, 1 = sequential).
+	MaxConcurrentCalls int `yaml:"max_concurrent_calls,omitempty" validate:"omitempty,min=0"`
+}
+
+// DefaultMCPMaxConcurrentCalls is the per-server concurrency limit for tool
+// calls emitted in the same LLM iteration when max_concurrent_calls is unset.
+const DefaultMCPMaxConcurrentCalls = 4
+
+// ConcurrencyLimit returns the effective max_concurrent_calls (default applied).
+func (c *MCPServerConfig) ConcurrencyLimit() int {
+	if c == nil || c.MaxConcurrentCalls <= 0 {
+		return DefaultMCPMaxConcurrentCalls
+	}
+	return c.MaxConcurrentCalls
 }
 
 // MCPServerRegistry stores MCP server configurations in memory with thread-safe access
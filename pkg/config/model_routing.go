@@ -0,0 +1,44 @@
+package config
+
+import "time"
+
+// Model routing tiers recorded on the session.
+const (
+	ModelRoutingTierFast   = "fast"
+	ModelRoutingTierStrong = "strong"
+)
+
+// ModelRoutingConfig controls automatic model selection by alert complexity.
+// When enabled, a cheap classification call scores each new session's alert
+// from 1 (trivial) to 10 (hard) and runs the chain on the fast or strong
+// provider tier. Explicit stage- and agent-level llm_provider settings still
+// win. Disabled by default.
+type ModelRoutingConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// ClassifierProvider is the LLM provider that scores alert complexity.
+	// Should be a cheap, fast model.
+	ClassifierProvider string `yaml:"classifier_provider"`
+
+	// FastProvider runs alerts scored below ComplexityThreshold.
+	FastProvider string `yaml:"fast_provider"`
+
+	// StrongProvider runs alerts scored at or above ComplexityThreshold.
+	StrongProvider string `yaml:"strong_provider"`
+
+	// ComplexityThreshold is the score (1-10) from which the strong tier is used.
+	ComplexityThreshold int `yaml:"complexity_threshold"`
+
+	// Timeout bounds the classification call. On timeout or error the
+	// chain runs with its configured providers.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// DefaultModelRoutingConfig returns the built-in model routing defaults.
+func DefaultModelRoutingConfig() *ModelRoutingConfig {
+	return &ModelRoutingConfig{
+		Enabled:             false,
+		ComplexityThreshold: 6,
+		Timeout:             30 * time.Second,
+	}
+}
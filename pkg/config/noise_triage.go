@@ -0,0 +1,74 @@
+package config
+
+import "time"
+
+// NoiseTriageAllAlertTypes is the noise_triage.alert_types key that applies
+// to every alert type without its own entry.
+const NoiseTriageAllAlertTypes = "*"
+
+// NoiseTriageConfig controls the triage pre-chain for noisy alert types.
+// When enabled, a cheap classification call labels each new session's alert
+// as actionable, a known false positive, or duplicate noise before the chain
+// runs. Non-actionable alerts classified with enough confidence complete
+// with the triage verdict instead of running the full chain. Only alert
+// types listed in AlertTypes (or covered by "*") are triaged. Disabled by
+// default.
+type NoiseTriageConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// ClassifierProvider is the LLM provider that classifies alerts.
+	// Should be a cheap, fast model.
+	ClassifierProvider string `yaml:"classifier_provider"`
+
+	// Timeout bounds the classification call. On timeout or error the
+	// full chain runs.
+	Timeout time.Duration `yaml:"timeout"`
+
+	// DuplicateWindow is how far back recent sessions of the same alert are
+	// shown to the classifier to spot duplicates.
+	DuplicateWindow time.Duration `yaml:"duplicate_window"`
+
+	// AlertTypes maps alert types (or "*" for all others) to their triage
+	// settings. Alert types without an entry are not triaged.
+	AlertTypes map[string]NoiseTriageAlertTypeConfig `yaml:"alert_types"`
+}
+
+// NoiseTriageAlertTypeConfig is the triage setting of one alert type.
+type NoiseTriageAlertTypeConfig struct {
+	// ForceFull always runs the full chain, e.g. to exempt an alert type
+	// from a "*" entry.
+	ForceFull bool `yaml:"force_full"`
+
+	// MinConfidence (0-1) is the classifier confidence from which a
+	// non-actionable verdict skips the chain. Default 0.8.
+	MinConfidence float64 `yaml:"min_confidence"`
+}
+
+// DefaultNoiseTriageConfig returns the built-in noise triage defaults.
+func DefaultNoiseTriageConfig() *NoiseTriageConfig {
+	return &NoiseTriageConfig{
+		Enabled:         false,
+		Timeout:         30 * time.Second,
+		DuplicateWindow: time.Hour,
+	}
+}
+
+// defaultNoiseTriageMinConfidence applies to alert types that leave
+// min_confidence unset.
+const defaultNoiseTriageMinConfidence = 0.8
+
+// ForAlertType returns the triage settings of alertType, or false when the
+// alert type is not triaged.
+func (c *NoiseTriageConfig) ForAlertType(alertType string) (NoiseTriageAlertTypeConfig, bool) {
+	if c == nil || !c.Enabled {
+		return NoiseTriageAlertTypeConfig{}, false
+	}
+	at, ok := c.AlertTypes[alertType]
+	if !ok {
+		at, ok = c.AlertTypes[NoiseTriageAllAlertTypes]
+	}
+	if !ok || at.ForceFull {
+		return NoiseTriageAlertTypeConfig{}, false
+	}
+	return at, true
+}
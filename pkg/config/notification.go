@@ -0,0 +1,116 @@
+package config
+
+import "slices"
+
+// NotificationRoutingConfig routes terminal session notifications by the
+// severity classified in the executive summary. Rules are evaluated in order
+// and the first rule listing the session severity wins. Sessions without a
+// severity, or with no matching rule, go to the default Slack channel and
+// never page.
+type NotificationRoutingConfig struct {
+	Rules []NotificationRouteRule `yaml:"rules"`
+}
+
+// NotificationRouteRule maps one or more severities to notification targets.
+type NotificationRouteRule struct {
+	// Severities this rule applies to (required, min 1)
+	Severities []Severity `yaml:"severities"`
+
+	// SlackChannel overrides system.slack.channel (empty = default channel)
+	SlackChannel string `yaml:"slack_channel,omitempty"`
+
+	// PagerDuty triggers a PagerDuty incident (high/critical rules only)
+	PagerDuty bool `yaml:"pagerduty,omitempty"`
+}
+
+// Route returns the first rule matching severity, or nil when there is no
+// match. Nil-safe: a nil config never matches.
+func (c *NotificationRoutingConfig) Route(severity Severity) *NotificationRouteRule {
+	if c == nil || severity == "" {
+		return nil
+	}
+	for i := range c.Rules {
+		if slices.Contains(c.Rules[i].Severities, severity) {
+			return &c.Rules[i]
+		}
+	}
+	return nil
+}
+
+// SlackTemplatePlaceholders lists the {placeholder} names that may appear in
+// per-chain Slack template field values and button URLs.
+var SlackTemplatePlaceholders = []string{
+	"session_id",
+	"session_url",
+	"alert_type",
+	"chain_id",
+	"status",
+	"severity",
+	"dashboard_url",
+}
+
+// Slack Block Kit limits enforced at config load time.
+const (
+	MaxSlackTemplateFields  = 10 // section block fields
+	MaxSlackTemplateButtons = 5
+)
+
+// SlackTemplateConfig customizes the Slack messages sent for sessions of a
+// chain. Fields are rendered as a two-column section under the header and
+// buttons are appended after the default dashboard button.
+type SlackTemplateConfig struct {
+	Fields  []SlackTemplateField  `yaml:"fields,omitempty"`
+	Buttons []SlackTemplateButton `yaml:"buttons,omitempty"`
+}
+
+// SlackTemplateField is a labelled value shown in the message. Value may
+// contain {placeholder} references (see SlackTemplatePlaceholders).
+type SlackTemplateField struct {
+	Label string `yaml:"label"`
+	Value string `yaml:"value"`
+}
+
+// SlackTemplateButton is a link button. URL may contain {placeholder}
+// references (see SlackTemplatePlaceholders).
+type SlackTemplateButton struct {
+	Text string `yaml:"text"`
+	URL  string `yaml:"url"`
+}
+
+// ChainEmailConfig configures email notifications for sessions of a chain.
+// Requires system.email to be enabled.
+type ChainEmailConfig struct {
+	// Recipients to notify (required, min 1)
+	Recipients []string `yaml:"recipients"`
+
+	// Schedule: immediate (default), hourly, or daily digest
+	Schedule EmailSchedule `yaml:"schedule,omitempty"`
+}
+
+// EffectiveSchedule returns the configured schedule, defaulting to immediate.
+func (c *ChainEmailConfig) EffectiveSchedule() EmailSchedule {
+	if c.Schedule == "" {
+		return EmailScheduleImmediate
+	}
+	return c.Schedule
+}
+
+// MilestoneNotificationConfig sends early notifications for a chain's
+// sessions as they reach intermediate milestones, so consumers that only
+// need an early signal don't wait for the terminal notification.
+type MilestoneNotificationConfig struct {
+	// Events to notify on (required, min 1)
+	Events []MilestoneEvent `yaml:"events"`
+
+	// Slack posts milestones to the session's Slack thread (requires system.slack)
+	Slack bool `yaml:"slack,omitempty"`
+
+	// WebhookURL receives a JSON POST per milestone (empty = no webhook)
+	WebhookURL string `yaml:"webhook_url,omitempty"`
+}
+
+// Wants reports whether event is configured. Nil-safe: a nil config wants
+// nothing.
+func (c *MilestoneNotificationConfig) Wants(event MilestoneEvent) bool {
+	return c != nil && slices.Contains(c.Events, event)
+}
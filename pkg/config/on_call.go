@@ -0,0 +1,79 @@
+package config
+
+import "time"
+
+// OnCallProvider is the schedule API the current on-call engineer is
+// looked up in.
+type OnCallProvider string
+
+// Supported on-call providers.
+const (
+	OnCallProviderPagerDuty OnCallProvider = "pagerduty"
+	OnCallProviderOpsgenie  OnCallProvider = "opsgenie"
+)
+
+// OnCallConfig controls the lookup of who is on call for the alert's
+// service when a session starts. The result is recorded on the session,
+// given to the agents so recommendations name the right person to page,
+// and mentioned in Slack and PagerDuty notifications. Disabled unless a
+// provider is set.
+type OnCallConfig struct {
+	Provider OnCallProvider
+
+	// APITokenEnv names the env var holding the schedule API token
+	// (default: PAGERDUTY_API_TOKEN or OPSGENIE_API_KEY).
+	APITokenEnv string
+
+	// APIURL overrides the provider's API base URL (e.g. the Opsgenie EU
+	// endpoint). Empty uses the provider default.
+	APIURL string
+
+	// ServiceLabel is the alert data key naming the affected service. JSON
+	// alert data is searched at the top level, then under labels and
+	// commonLabels (Alertmanager). Default: "service".
+	ServiceLabel string
+
+	// DefaultService is used when neither the chain nor the alert names a
+	// service. Empty leaves such sessions without on-call context.
+	DefaultService string
+
+	// Services maps service names to their on-call schedules.
+	Services map[string]*OnCallServiceConfig
+
+	// CacheTTL is how long a schedule lookup is reused across sessions.
+	CacheTTL time.Duration
+}
+
+// OnCallServiceConfig is the on-call schedule of one service.
+type OnCallServiceConfig struct {
+	// ScheduleID is the PagerDuty schedule ID or Opsgenie schedule ID (required).
+	ScheduleID string `yaml:"schedule_id"`
+
+	// Team owning the service, shown alongside the on-call engineer.
+	Team string `yaml:"team,omitempty"`
+}
+
+// OnCallYAMLConfig holds on-call lookup settings from YAML.
+type OnCallYAMLConfig struct {
+	Provider       OnCallProvider                  `yaml:"provider,omitempty"`
+	APITokenEnv    string                          `yaml:"api_token_env,omitempty"`
+	APIURL         string                          `yaml:"api_url,omitempty"`
+	ServiceLabel   string                          `yaml:"service_label,omitempty"`
+	DefaultService string                          `yaml:"default_service,omitempty"`
+	Services       map[string]*OnCallServiceConfig `yaml:"services,omitempty"`
+	CacheTTL       time.Duration                   `yaml:"cache_ttl,omitempty"`
+}
+
+// DefaultOnCallConfig returns the built-in on-call defaults: disabled, the
+// "service" label, schedules cached for five minutes.
+func DefaultOnCallConfig() *OnCallConfig {
+	return &OnCallConfig{
+		ServiceLabel: "service",
+		CacheTTL:     5 * time.Minute,
+	}
+}
+
+// Enabled reports whether on-call lookup is configured.
+func (c *OnCallConfig) Enabled() bool {
+	return c != nil && c.Provider != ""
+}
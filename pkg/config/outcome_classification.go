@@ -0,0 +1,28 @@
+package config
+
+// OutcomeClassificationConfig controls the labelling of completed sessions
+// with the outcome taxonomy (root_cause_identified, mitigated, needs_human,
+// false_positive, inconclusive). The label is taken from an explicit
+// "Outcome: <label>" line in the final analysis when the agent wrote one,
+// from the noise triage verdict for triaged sessions, and otherwise from a
+// short LLM call. Enabled by default.
+type OutcomeClassificationConfig struct {
+	Enabled bool
+
+	// LLMProvider runs the classification. Empty uses the chain's executive
+	// summary provider, chain provider, or defaults.llm_provider.
+	LLMProvider string
+}
+
+// OutcomeClassificationYAMLConfig holds outcome classification settings
+// from YAML. Enabled is a *bool: nil (or whole block omitted) means enabled.
+type OutcomeClassificationYAMLConfig struct {
+	Enabled     *bool  `yaml:"enabled,omitempty"`
+	LLMProvider string `yaml:"llm_provider,omitempty"`
+}
+
+// DefaultOutcomeClassificationConfig returns the built-in outcome
+// classification defaults: enabled, on the chain's summary provider.
+func DefaultOutcomeClassificationConfig() *OutcomeClassificationConfig {
+	return &OutcomeClassificationConfig{Enabled: true}
+}
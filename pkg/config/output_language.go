@@ -0,0 +1,30 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxOutputLanguageLength bounds output_language values. The value is a
+// language name or locale (e.g. "Japanese", "de-DE"), not free text.
+const MaxOutputLanguageLength = 64
+
+// ValidateOutputLanguage checks that lang is a short, single-line language
+// name. It is interpolated into system prompts, so multi-line values are
+// rejected. Empty means "no preference" and is valid.
+func ValidateOutputLanguage(lang string) error {
+	if lang == "" {
+		return nil
+	}
+	if strings.TrimSpace(lang) == "" {
+		return fmt.Errorf("must not be blank")
+	}
+	if utf8.RuneCountInString(lang) > MaxOutputLanguageLength {
+		return fmt.Errorf("must be at most %d characters", MaxOutputLanguageLength)
+	}
+	if strings.ContainsAny(lang, "\r\n") {
+		return fmt.Errorf("must be a single line")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ProfilingConfig controls automatic heap-profile capture used to diagnose
+// memory growth on long-lived pods. The pprof endpoints are limited to
+// system.admins.
+type ProfilingConfig struct {
+	// HeapCapture writes a heap profile to disk when RSS crosses a threshold.
+	HeapCapture HeapCaptureConfig `yaml:"heap_capture"`
+}
+
+// HeapCaptureConfig controls automatic heap-profile capture.
+type HeapCaptureConfig struct {
+	// RSSThresholdMB triggers a capture when the process resident set size
+	// reaches it. 0 disables automatic capture.
+	RSSThresholdMB int `yaml:"rss_threshold_mb"`
+
+	// Dir is where profiles are written (heap-<pod>-<timestamp>.pb.gz).
+	Dir string `yaml:"dir"`
+
+	// MaxProfiles is how many captured profiles to keep; older ones are deleted.
+	MaxProfiles int `yaml:"max_profiles"`
+
+	// MinInterval is the minimum time between captures while RSS stays high.
+	MinInterval time.Duration `yaml:"min_interval"`
+
+	// CheckInterval is how often RSS is sampled.
+	CheckInterval time.Duration `yaml:"check_interval"`
+}
+
+// DefaultProfilingConfig returns the built-in profiling defaults: automatic
+// heap capture off.
+func DefaultProfilingConfig() *ProfilingConfig {
+	return &ProfilingConfig{
+		HeapCapture: HeapCaptureConfig{
+			Dir:           filepath.Join(os.TempDir(), "tarsy-heap-profiles"),
+			MaxProfiles:   5,
+			MinInterval:   30 * time.Minute,
+			CheckInterval: 30 * time.Second,
+		},
+	}
+}
@@ -20,6 +20,16 @@ type QueueConfig struct {
 	// Actual interval: PollInterval ± PollIntervalJitter.
 	PollIntervalJitter time.Duration `yaml:"poll_interval_jitter"`
 
+	// MaxPollInterval caps the adaptive idle backoff: while the queue stays
+	// empty, the interval between claim rounds doubles from PollInterval up
+	// to MaxPollInterval, and resets once a session is claimed. Values at
+	// or below PollInterval turn the backoff off.
+	MaxPollInterval time.Duration `yaml:"max_poll_interval"`
+
+	// ClaimBatchSize is the maximum number of sessions a pod claims in one
+	// round, one per idle worker, in a single transaction.
+	ClaimBatchSize int `yaml:"claim_batch_size"`
+
 	// SessionTimeout is the maximum time a session can be processed.
 	SessionTimeout time.Duration `yaml:"session_timeout"`
 
@@ -41,6 +51,119 @@ type QueueConfig struct {
 	// HeartbeatInterval is how often workers update session last_interaction_at.
 	// Must be less than OrphanThreshold.
 	HeartbeatInterval time.Duration `yaml:"heartbeat_interval"`
+
+	// OrphanRecovery selects what happens to an orphaned session: fail it
+	// as timed_out (default) or requeue it for another worker.
+	OrphanRecovery OrphanRecoveryMode `yaml:"orphan_recovery"`
+
+	// OrphanMaxRequeues caps how many times a single session is requeued
+	// after being orphaned before it is failed instead. Only used with
+	// orphan_recovery: requeue.
+	OrphanMaxRequeues int `yaml:"orphan_max_requeues"`
+
+	// AutoCancel configures TTL-based cancellation of sessions that are still
+	// queued when their alert has gone stale. Nil disables the TTL sweep;
+	// the resolution webhook works regardless.
+	AutoCancel *AutoCancelConfig `yaml:"auto_cancel"`
+
+	// Preemption configures cancellation of low-priority in-flight sessions
+	// when the backlog grows, freeing workers for more critical alerts.
+	// Nil or disabled turns it off.
+	Preemption *PreemptionConfig `yaml:"preemption"`
+}
+
+// DefaultAutoCancelCheckInterval is used when auto_cancel.check_interval is unset.
+const DefaultAutoCancelCheckInterval = 1 * time.Minute
+
+// AutoCancelConfig controls automatic cancellation of stale pending sessions.
+type AutoCancelConfig struct {
+	// PendingTTL is how long a session may wait in the queue before it is
+	// auto-cancelled. Zero means no TTL unless an alert-type rule sets one.
+	PendingTTL time.Duration `yaml:"pending_ttl"`
+
+	// AlertTypeTTLs overrides PendingTTL per alert type. A zero value exempts
+	// that alert type from the TTL.
+	AlertTypeTTLs map[string]time.Duration `yaml:"alert_type_ttls"`
+
+	// CheckInterval is how often the queue is scanned for stale sessions.
+	CheckInterval time.Duration `yaml:"check_interval"`
+}
+
+// TTLFor returns the pending TTL that applies to alertType (zero = none).
+func (c *AutoCancelConfig) TTLFor(alertType string) time.Duration {
+	if ttl, ok := c.AlertTypeTTLs[alertType]; ok {
+		return ttl
+	}
+	return c.PendingTTL
+}
+
+// MinTTL returns the shortest non-zero TTL across all rules, or zero when
+// no rule sets one.
+func (c *AutoCancelConfig) MinTTL() time.Duration {
+	minTTL := c.PendingTTL
+	for _, ttl := range c.AlertTypeTTLs {
+		if ttl > 0 && (minTTL == 0 || ttl < minTTL) {
+			minTTL = ttl
+		}
+	}
+	return minTTL
+}
+
+// Defaults applied when queue.preemption leaves a setting unset.
+const (
+	DefaultPreemptionDeadline      = 10 * time.Minute
+	DefaultPreemptionMaxPerCheck   = 1
+	DefaultPreemptionCheckInterval = 30 * time.Second
+)
+
+// PreemptionConfig controls backlog-driven preemption. When at least
+// BacklogThreshold sessions are pending, in-flight sessions that have run
+// past Deadline are auto-cancelled, oldest and lowest priority first, as long
+// as a pending session of a higher priority is waiting for the worker.
+type PreemptionConfig struct {
+	// Enabled turns preemption on. Off by default.
+	Enabled bool `yaml:"enabled"`
+
+	// BacklogThreshold is the number of pending sessions at which
+	// preemption starts.
+	BacklogThreshold int `yaml:"backlog_threshold"`
+
+	// Deadline is how long an in-flight session may run before it can be
+	// preempted.
+	Deadline time.Duration `yaml:"deadline"`
+
+	// MaxPerCheck caps the sessions preempted by one check.
+	MaxPerCheck int `yaml:"max_per_check"`
+
+	// CheckInterval is how often the backlog is checked.
+	CheckInterval time.Duration `yaml:"check_interval"`
+
+	// DefaultPriority is the priority of alert types without an entry in
+	// AlertTypePriorities. Higher is more critical.
+	DefaultPriority int `yaml:"default_priority"`
+
+	// AlertTypePriorities sets the priority per alert type.
+	AlertTypePriorities map[string]int `yaml:"alert_type_priorities"`
+}
+
+// Active reports whether preemption is configured and enabled.
+func (c *PreemptionConfig) Active() bool {
+	return c != nil && c.Enabled
+}
+
+// PriorityFor returns the priority of alertType.
+func (c *PreemptionConfig) PriorityFor(alertType string) int {
+	if p, ok := c.AlertTypePriorities[alertType]; ok {
+		return p
+	}
+	return c.DefaultPriority
+}
+
+// IdlePollCeiling returns the longest interval between claim rounds while
+// the queue is empty: MaxPollInterval, or PollInterval when the backoff is
+// off.
+func (q *QueueConfig) IdlePollCeiling() time.Duration {
+	return max(q.PollInterval, q.MaxPollInterval)
 }
 
 // DefaultQueueConfig returns the built-in queue defaults.
@@ -50,11 +173,15 @@ func DefaultQueueConfig() *QueueConfig {
 		MaxConcurrentSessions:   5,
 		PollInterval:            1 * time.Second,
 		PollIntervalJitter:      500 * time.Millisecond,
+		MaxPollInterval:         5 * time.Second,
+		ClaimBatchSize:          10,
 		SessionTimeout:          40 * time.Minute,
 		GracefulShutdownTimeout: 40 * time.Minute,
 		ScoringShutdownTimeout:  3 * time.Minute,
 		OrphanDetectionInterval: 5 * time.Minute,
 		OrphanThreshold:         5 * time.Minute,
 		HeartbeatInterval:       30 * time.Second,
+		OrphanRecovery:          OrphanRecoveryFail,
+		OrphanMaxRequeues:       1,
 	}
 }
@@ -15,12 +15,16 @@ func TestDefaultQueueConfig(t *testing.T) {
 	assert.Equal(t, 5, cfg.MaxConcurrentSessions)
 	assert.Equal(t, 1*time.Second, cfg.PollInterval)
 	assert.Equal(t, 500*time.Millisecond, cfg.PollIntervalJitter)
+	assert.Equal(t, 5*time.Second, cfg.MaxPollInterval)
+	assert.Equal(t, 10, cfg.ClaimBatchSize)
 	assert.Equal(t, 40*time.Minute, cfg.SessionTimeout)
 	assert.Equal(t, 40*time.Minute, cfg.GracefulShutdownTimeout)
 	assert.Equal(t, 3*time.Minute, cfg.ScoringShutdownTimeout)
 	assert.Equal(t, 5*time.Minute, cfg.OrphanDetectionInterval)
 	assert.Equal(t, 5*time.Minute, cfg.OrphanThreshold)
 	assert.Equal(t, 30*time.Second, cfg.HeartbeatInterval)
+	assert.Equal(t, OrphanRecoveryFail, cfg.OrphanRecovery)
+	assert.Equal(t, 1, cfg.OrphanMaxRequeues)
 }
 
 func TestValidateQueue(t *testing.T) {
@@ -191,6 +195,35 @@ func TestValidateQueue(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "negative max poll interval",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.MaxPollInterval = -1 * time.Second
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "max_poll_interval must be non-negative",
+		},
+		{
+			name: "max poll interval below poll interval is valid",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.MaxPollInterval = 0
+				return q
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "zero claim batch size",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.ClaimBatchSize = 0
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "claim_batch_size must be at least 1",
+		},
 		{
 			name: "heartbeat interval zero",
 			queue: func() *QueueConfig {
@@ -233,6 +266,137 @@ func TestValidateQueue(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "requeue orphan recovery is valid",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.OrphanRecovery = OrphanRecoveryRequeue
+				q.OrphanMaxRequeues = 3
+				return q
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "invalid orphan recovery",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.OrphanRecovery = "retry"
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "orphan_recovery must be one of fail, requeue",
+		},
+		{
+			name: "negative orphan max requeues",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.OrphanMaxRequeues = -1
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "orphan_max_requeues must be non-negative",
+		},
+		{
+			name: "valid auto cancel",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.AutoCancel = &AutoCancelConfig{
+					PendingTTL:    30 * time.Minute,
+					AlertTypeTTLs: map[string]time.Duration{"PodCrashLooping": 10 * time.Minute, "NodeDown": 0},
+					CheckInterval: time.Minute,
+				}
+				return q
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "negative auto cancel pending ttl",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.AutoCancel = &AutoCancelConfig{PendingTTL: -time.Minute, CheckInterval: time.Minute}
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "auto_cancel.pending_ttl must be non-negative",
+		},
+		{
+			name: "negative auto cancel alert type ttl",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.AutoCancel = &AutoCancelConfig{
+					AlertTypeTTLs: map[string]time.Duration{"NodeDown": -time.Second},
+					CheckInterval: time.Minute,
+				}
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "auto_cancel.alert_type_ttls[NodeDown] must be non-negative",
+		},
+		{
+			name: "zero auto cancel check interval",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.AutoCancel = &AutoCancelConfig{PendingTTL: time.Hour}
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "auto_cancel.check_interval must be positive",
+		},
+		{
+			name: "valid preemption",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.Preemption = &PreemptionConfig{
+					Enabled:             true,
+					BacklogThreshold:    10,
+					Deadline:            10 * time.Minute,
+					MaxPerCheck:         1,
+					CheckInterval:       30 * time.Second,
+					AlertTypePriorities: map[string]int{"NodeDown": 10},
+				}
+				return q
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "disabled preemption is not validated",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.Preemption = &PreemptionConfig{}
+				return q
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "zero preemption backlog threshold",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.Preemption = &PreemptionConfig{Enabled: true, Deadline: time.Minute, MaxPerCheck: 1, CheckInterval: time.Minute}
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "preemption.backlog_threshold must be at least 1",
+		},
+		{
+			name: "zero preemption deadline",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.Preemption = &PreemptionConfig{Enabled: true, BacklogThreshold: 5, MaxPerCheck: 1, CheckInterval: time.Minute}
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "preemption.deadline must be positive",
+		},
+		{
+			name: "zero preemption max per check",
+			queue: func() *QueueConfig {
+				q := DefaultQueueConfig()
+				q.Preemption = &PreemptionConfig{Enabled: true, BacklogThreshold: 5, Deadline: time.Minute, CheckInterval: time.Minute}
+				return q
+			}(),
+			wantErr: true,
+			errMsg:  "preemption.max_per_check must be at least 1",
+		},
 	}
 
 	for _, tt := range tests {
@@ -250,3 +414,77 @@ func TestValidateQueue(t *testing.T) {
 		})
 	}
 }
+
+func TestQueueConfig_IdlePollCeiling(t *testing.T) {
+	q := &QueueConfig{PollInterval: time.Second, MaxPollInterval: 5 * time.Second}
+	assert.Equal(t, 5*time.Second, q.IdlePollCeiling())
+
+	q.MaxPollInterval = 0
+	assert.Equal(t, time.Second, q.IdlePollCeiling(), "backoff off")
+}
+
+func TestAutoCancelConfig_TTLFor(t *testing.T) {
+	cfg := &AutoCancelConfig{
+		PendingTTL:    time.Hour,
+		AlertTypeTTLs: map[string]time.Duration{"PodCrashLooping": 10 * time.Minute, "NodeDown": 0},
+	}
+
+	assert.Equal(t, time.Hour, cfg.TTLFor("Other"))
+	assert.Equal(t, 10*time.Minute, cfg.TTLFor("PodCrashLooping"))
+	assert.Equal(t, time.Duration(0), cfg.TTLFor("NodeDown"))
+}
+
+func TestPreemptionConfig_PriorityFor(t *testing.T) {
+	cfg := &PreemptionConfig{
+		DefaultPriority:     1,
+		AlertTypePriorities: map[string]int{"NodeDown": 10, "DiskPressure": 0},
+	}
+
+	assert.Equal(t, 1, cfg.PriorityFor("Other"))
+	assert.Equal(t, 10, cfg.PriorityFor("NodeDown"))
+	assert.Equal(t, 0, cfg.PriorityFor("DiskPressure"))
+
+	var nilCfg *PreemptionConfig
+	assert.False(t, nilCfg.Active())
+	assert.False(t, cfg.Active())
+}
+
+func TestAutoCancelConfig_MinTTL(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *AutoCancelConfig
+		want time.Duration
+	}{
+		{
+			name: "no rules",
+			cfg:  &AutoCancelConfig{},
+			want: 0,
+		},
+		{
+			name: "default only",
+			cfg:  &AutoCancelConfig{PendingTTL: time.Hour},
+			want: time.Hour,
+		},
+		{
+			name: "shorter alert type rule",
+			cfg: &AutoCancelConfig{
+				PendingTTL:    time.Hour,
+				AlertTypeTTLs: map[string]time.Duration{"A": 10 * time.Minute, "B": 0},
+			},
+			want: 10 * time.Minute,
+		},
+		{
+			name: "alert type rule without default",
+			cfg: &AutoCancelConfig{
+				AlertTypeTTLs: map[string]time.Duration{"A": 20 * time.Minute},
+			},
+			want: 20 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cfg.MinTTL())
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package config
+
+import "time"
+
+// RecentHistoryConfig controls the "Recent History" prompt block: when a
+// session starts, earlier sessions of the same alert type (and service,
+// when the alert names one) within Window are summarized for the
+// investigating agents, so recurring or flapping alerts are not analyzed
+// from scratch every time. Disabled by default; chains can opt in or out
+// with their own recent_history block.
+type RecentHistoryConfig struct {
+	Enabled bool
+
+	// Window bounds how old a prior session may be.
+	Window time.Duration
+
+	// MaxSessions caps the prior sessions listed in the prompt. The block
+	// still reports how many matched in total.
+	MaxSessions int
+
+	// ServiceLabel is the alert data key naming the affected service, also
+	// read under labels / commonLabels. When the alert names a service, only
+	// prior sessions of the same service are listed.
+	ServiceLabel string
+}
+
+// RecentHistoryYAMLConfig holds recent history settings from YAML.
+type RecentHistoryYAMLConfig struct {
+	Enabled      bool          `yaml:"enabled,omitempty"`
+	Window       time.Duration `yaml:"window,omitempty"`
+	MaxSessions  int           `yaml:"max_sessions,omitempty"`
+	ServiceLabel string        `yaml:"service_label,omitempty"`
+}
+
+// ChainRecentHistoryConfig overrides system.recent_history for one chain.
+type ChainRecentHistoryConfig struct {
+	// Enabled turns the block on or off for the chain (default: the system
+	// setting)
+	Enabled *bool `yaml:"enabled,omitempty"`
+
+	// Window overrides the system window (default: the system window)
+	Window time.Duration `yaml:"window,omitempty"`
+}
+
+// DefaultRecentHistoryConfig returns the built-in recent history defaults:
+// disabled, listing up to 10 sessions from the last 7 days.
+func DefaultRecentHistoryConfig() *RecentHistoryConfig {
+	return &RecentHistoryConfig{
+		Window:       7 * 24 * time.Hour,
+		MaxSessions:  10,
+		ServiceLabel: "service",
+	}
+}
+
+// ResolveRecentHistory returns the recent history settings that apply to
+// chain, or nil when the block is off for it. The chain's enabled and
+// window fields override sys.
+func ResolveRecentHistory(sys *RecentHistoryConfig, chain *ChainConfig) *RecentHistoryConfig {
+	resolved := *DefaultRecentHistoryConfig()
+	if sys != nil {
+		resolved = *sys
+	}
+	if chain != nil && chain.RecentHistory != nil {
+		if chain.RecentHistory.Enabled != nil {
+			resolved.Enabled = *chain.RecentHistory.Enabled
+		}
+		if chain.RecentHistory.Window != 0 {
+			resolved.Window = chain.RecentHistory.Window
+		}
+	}
+	if !resolved.Enabled {
+		return nil
+	}
+	return &resolved
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveRecentHistory(t *testing.T) {
+	on, off := true, false
+	enabled := DefaultRecentHistoryConfig()
+	enabled.Enabled = true
+
+	t.Run("system default applies", func(t *testing.T) {
+		assert.Nil(t, ResolveRecentHistory(DefaultRecentHistoryConfig(), &ChainConfig{}))
+		assert.Equal(t, enabled, ResolveRecentHistory(enabled, &ChainConfig{}))
+	})
+
+	t.Run("chain opts in with its own window", func(t *testing.T) {
+		got := ResolveRecentHistory(nil, &ChainConfig{
+			RecentHistory: &ChainRecentHistoryConfig{Enabled: &on, Window: 48 * time.Hour},
+		})
+		require.NotNil(t, got)
+		assert.Equal(t, 48*time.Hour, got.Window)
+		assert.Equal(t, 10, got.MaxSessions)
+	})
+
+	t.Run("chain opts out", func(t *testing.T) {
+		assert.Nil(t, ResolveRecentHistory(enabled, &ChainConfig{
+			RecentHistory: &ChainRecentHistoryConfig{Enabled: &off},
+		}))
+	})
+
+	t.Run("does not modify the system config", func(t *testing.T) {
+		ResolveRecentHistory(enabled, &ChainConfig{RecentHistory: &ChainRecentHistoryConfig{Window: time.Hour}})
+		assert.Equal(t, 7*24*time.Hour, enabled.Window)
+	})
+}
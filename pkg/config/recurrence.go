@@ -0,0 +1,36 @@
+package config
+
+import "time"
+
+// RecurrenceConfig controls the comparison of recurring alerts: when a
+// session completes and an earlier session investigated the same source
+// alert (same alert_key and chain), a short LLM call compares the two
+// conclusions and the result is linked on both sessions. Enabled by default.
+type RecurrenceConfig struct {
+	Enabled bool
+
+	// LookbackWindow bounds how old the previous session may be. Older
+	// investigations are not compared.
+	LookbackWindow time.Duration
+
+	// LLMProvider runs the comparison. Empty uses the chain's executive
+	// summary provider, chain provider, or defaults.llm_provider.
+	LLMProvider string
+}
+
+// RecurrenceYAMLConfig holds recurring alert comparison settings from YAML.
+// Enabled is a *bool: nil (or whole block omitted) means enabled.
+type RecurrenceYAMLConfig struct {
+	Enabled        *bool         `yaml:"enabled,omitempty"`
+	LookbackWindow time.Duration `yaml:"lookback_window,omitempty"`
+	LLMProvider    string        `yaml:"llm_provider,omitempty"`
+}
+
+// DefaultRecurrenceConfig returns the built-in recurrence defaults:
+// enabled, comparing against sessions from the last 30 days.
+func DefaultRecurrenceConfig() *RecurrenceConfig {
+	return &RecurrenceConfig{
+		Enabled:        true,
+		LookbackWindow: 30 * 24 * time.Hour,
+	}
+}
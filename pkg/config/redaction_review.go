@@ -0,0 +1,51 @@
+package config
+
+// RedactionReviewConfig controls the redaction review queue: MCP tool
+// results are scanned after masking for values that still look like
+// secrets, and each suspect is queued for a human to confirm (redacting it
+// everywhere the session stored it) or dismiss.
+type RedactionReviewConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// MinEntropy is the Shannon entropy in bits per character above which a
+	// long token is flagged (default: 4.3). Hex digests and UUIDs stay below 4.
+	MinEntropy float64 `yaml:"min_entropy"`
+
+	// MaxPerInteraction caps the suspects queued from one tool result (default: 10).
+	MaxPerInteraction int `yaml:"max_per_interaction"`
+
+	// Reviewers lists the callers allowed to confirm or dismiss suspects,
+	// matched against the user name, email, or service account forwarded by
+	// the auth proxy. Empty allows any authenticated user.
+	Reviewers []string `yaml:"reviewers"`
+}
+
+// DefaultRedactionReviewConfig returns the built-in defaults: disabled.
+func DefaultRedactionReviewConfig() *RedactionReviewConfig {
+	return &RedactionReviewConfig{
+		MinEntropy:        4.3,
+		MaxPerInteraction: 10,
+	}
+}
+
+// IsReviewer reports whether any of the caller's identities may resolve
+// suspects.
+func (c *RedactionReviewConfig) IsReviewer(identities ...string) bool {
+	if c == nil {
+		return false
+	}
+	if len(c.Reviewers) == 0 {
+		return true
+	}
+	for _, id := range identities {
+		if id == "" {
+			continue
+		}
+		for _, reviewer := range c.Reviewers {
+			if id == reviewer {
+				return true
+			}
+		}
+	}
+	return false
+}
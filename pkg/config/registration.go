@@ -0,0 +1,100 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseAgentDefinition parses a registered agent definition, written like an
+// entry under agents: in tarsy.yaml. Unknown fields are rejected. Mixins are
+// a load-time construct and are not available to registered agents.
+func ParseAgentDefinition(data []byte) (*AgentConfig, error) {
+	var agent AgentConfig
+	if err := decodeDefinition(data, &agent); err != nil {
+		return nil, err
+	}
+	if len(agent.Mixins) > 0 {
+		return nil, fmt.Errorf("mixins are not available to registered agents")
+	}
+	return &agent, nil
+}
+
+// ParseChainDefinition parses a registered chain definition, written like an
+// entry under agent_chains: in tarsy.yaml. Unknown fields are rejected.
+func ParseChainDefinition(data []byte) (*ChainConfig, error) {
+	var chain ChainConfig
+	if err := decodeDefinition(data, &chain); err != nil {
+		return nil, err
+	}
+	return &chain, nil
+}
+
+// decodeDefinition strictly decodes one YAML document into target.
+// Environment variables are not expanded: definitions come from API callers,
+// not from the deployment.
+func decodeDefinition(data []byte, target any) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(target); err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("%w: empty definition", ErrInvalidYAML)
+		}
+		return fmt.Errorf("%w: %v", ErrInvalidYAML, err)
+	}
+	return nil
+}
+
+// ComposeRegistered expands composition (extends, stage includes) of the
+// registered agents and chains named in newAgents and newChains, in place.
+// agents and chains hold every definition, the loaded ones already
+// resolved, so registered definitions can extend loaded ones and each
+// other. Chains without a scoring block get defaults.scoring like loaded
+// chains do.
+func ComposeRegistered(agents map[string]*AgentConfig, newAgents []string,
+	chains map[string]*ChainConfig, newChains []string, defaults *Defaults) error {
+	ar := &agentComposer{agents: agents, state: resolvedExcept(agents, newAgents)}
+	for _, id := range sortedCopy(newAgents) {
+		if err := ar.resolve(id, nil); err != nil {
+			return err
+		}
+	}
+
+	cr := &chainComposer{chains: chains, state: resolvedExcept(chains, newChains)}
+	for _, id := range sortedCopy(newChains) {
+		if err := cr.resolve(id, nil); err != nil {
+			return err
+		}
+	}
+
+	if defaults != nil && defaults.Scoring != nil && defaults.Scoring.Enabled {
+		for _, id := range newChains {
+			if chain := chains[id]; chain.Scoring == nil {
+				chain.Scoring = &ScoringConfig{Enabled: true}
+			}
+		}
+	}
+	return nil
+}
+
+// resolvedExcept marks every key of m as resolved, except pending.
+func resolvedExcept[T any](m map[string]T, pending []string) map[string]compositionState {
+	state := make(map[string]compositionState, len(m))
+	for id := range m {
+		state[id] = compositionResolved
+	}
+	for _, id := range pending {
+		delete(state, id)
+	}
+	return state
+}
+
+func sortedCopy(s []string) []string {
+	out := append([]string(nil), s...)
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAgentDefinition(t *testing.T) {
+	agent, err := ParseAgentDefinition([]byte("description: Triage\nmcp_servers: [k8s]\nmax_iterations: 5\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "Triage", agent.Description)
+	assert.Equal(t, []string{"k8s"}, agent.MCPServers)
+	assert.Equal(t, 5, *agent.MaxIterations)
+
+	_, err = ParseAgentDefinition([]byte("descripton: Triage\n"))
+	assert.ErrorIs(t, err, ErrInvalidYAML, "unknown fields are rejected")
+
+	_, err = ParseAgentDefinition([]byte(""))
+	assert.ErrorIs(t, err, ErrInvalidYAML)
+
+	_, err = ParseAgentDefinition([]byte("mixins: [k8s-tools]\n"))
+	assert.ErrorContains(t, err, "mixins are not available")
+}
+
+func TestParseChainDefinition_NoEnvExpansion(t *testing.T) {
+	t.Setenv("SECRET_TOKEN", "s3cr3t")
+	chain, err := ParseChainDefinition([]byte("alert_types: [x]\ndescription: \"{{.SECRET_TOKEN}}\"\nstages:\n  - name: s\n    agents: [{name: A}]\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "{{.SECRET_TOKEN}}", chain.Description)
+}
+
+func TestComposeRegistered(t *testing.T) {
+	base := &AgentConfig{Description: "Base", MCPServers: []string{"k8s"}, Mixins: []string{"loaded-mixin"}}
+	agents := map[string]*AgentConfig{
+		"Base":     base,
+		"Variant":  {Extends: "Base", Description: "Variant"},
+		"Variant2": {Extends: "Variant"},
+	}
+	chains := map[string]*ChainConfig{
+		"loaded": {AlertTypes: []string{"a"}, Description: "Loaded", Stages: []StageConfig{{Name: "s1"}, {Name: "s2"}}},
+		"new":    {AlertTypes: []string{"b"}, Extends: "loaded", Stages: []StageConfig{{Include: "loaded"}, {Name: "s3"}}},
+	}
+	defaults := &Defaults{Scoring: &ScoringConfig{Enabled: true}}
+
+	require.NoError(t, ComposeRegistered(agents, []string{"Variant2", "Variant"}, chains, []string{"new"}, defaults))
+
+	// Loaded agents are not re-resolved (their mixins are gone at runtime)
+	assert.Equal(t, []string{"loaded-mixin"}, base.Mixins)
+	assert.Equal(t, []string{"k8s"}, agents["Variant"].MCPServers)
+	assert.Equal(t, "Variant", agents["Variant2"].Description)
+
+	newChain := chains["new"]
+	assert.Equal(t, "Loaded", newChain.Description)
+	require.Len(t, newChain.Stages, 3)
+	assert.Equal(t, "s3", newChain.Stages[2].Name)
+	require.NotNil(t, newChain.Scoring)
+	assert.True(t, newChain.Scoring.Enabled)
+	assert.Nil(t, chains["loaded"].Scoring)
+}
+
+func TestComposeRegistered_Cycle(t *testing.T) {
+	agents := map[string]*AgentConfig{
+		"A": {Extends: "B"},
+		"B": {Extends: "A"},
+	}
+	err := ComposeRegistered(agents, []string{"A", "B"}, map[string]*ChainConfig{}, nil, nil)
+	assert.ErrorContains(t, err, "composition cycle")
+}
+
+func TestRegistries_Replace(t *testing.T) {
+	agents := NewAgentRegistry(map[string]*AgentConfig{"A": {}})
+	agents.Replace(map[string]*AgentConfig{"B": {}})
+	assert.False(t, agents.Has("A"))
+	assert.True(t, agents.Has("B"))
+
+	chains := NewChainRegistry(map[string]*ChainConfig{"a": {}})
+	chains.Replace(map[string]*ChainConfig{"b": {AlertTypes: []string{"x"}}})
+	id, err := chains.GetIDByAlertType("x")
+	require.NoError(t, err)
+	assert.Equal(t, "b", id)
+	assert.False(t, chains.Has("a"))
+}
@@ -214,6 +214,14 @@ func TestMCPServerRegistry(t *testing.T) {
 	})
 }
 
+func TestMCPServerConfigConcurrencyLimit(t *testing.T) {
+	var nilCfg *MCPServerConfig
+	assert.Equal(t, DefaultMCPMaxConcurrentCalls, nilCfg.ConcurrencyLimit())
+	assert.Equal(t, DefaultMCPMaxConcurrentCalls, (&MCPServerConfig{}).ConcurrencyLimit())
+	assert.Equal(t, 1, (&MCPServerConfig{MaxConcurrentCalls: 1}).ConcurrencyLimit())
+	assert.Equal(t, 8, (&MCPServerConfig{MaxConcurrentCalls: 8}).ConcurrencyLimit())
+}
+
 func TestMCPServerRegistryThreadSafety(_ *testing.T) {
 	servers := map[string]*MCPServerConfig{
 		"server1": {
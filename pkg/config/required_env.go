@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// envVarNamePattern matches a portable environment variable name.
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validateRequiredEnvNames checks that required_env lists valid, distinct
+// variable names.
+func validateRequiredEnvNames(names []string) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if !envVarNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid environment variable name %q", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("environment variable %s is listed more than once", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// MissingRequiredEnv returns the chain's required_env variables that are
+// unset or empty, in declaration order.
+func (c *ChainConfig) MissingRequiredEnv() []string {
+	var missing []string
+	for _, name := range c.RequiredEnv {
+		if os.Getenv(name) == "" {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// MissingChainEnv returns a chain-attributed error for every chain that
+// handles an alert type and has unset required_env variables, sorted by
+// chain ID, e.g.
+// "chain 'k8s': field 'required_env': environment variable SPLUNK_TOKEN is not set".
+func MissingChainEnv(chains *ChainRegistry) []error {
+	all := chains.GetAll()
+	var errs []error
+	for _, chainID := range slices.Sorted(maps.Keys(all)) {
+		chain := all[chainID]
+		if len(chain.AlertTypes) == 0 {
+			continue // never runs a session
+		}
+		if missing := chain.MissingRequiredEnv(); len(missing) > 0 {
+			errs = append(errs, NewValidationError("chain", chainID, "required_env", errMissingEnv(missing)))
+		}
+	}
+	return errs
+}
+
+// errMissingEnv describes unset environment variables.
+func errMissingEnv(missing []string) error {
+	if len(missing) == 1 {
+		return fmt.Errorf("environment variable %s is not set", missing[0])
+	}
+	return fmt.Errorf("environment variables %s are not set", strings.Join(missing, ", "))
+}
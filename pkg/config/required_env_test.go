@@ -0,0 +1,82 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requiredEnvChain(env ...string) *ChainConfig {
+	return &ChainConfig{
+		AlertTypes:  []string{"test"},
+		Stages:      []StageConfig{{Name: "stage1", Agents: []StageAgentConfig{{Name: "test-agent"}}}},
+		RequiredEnv: env,
+	}
+}
+
+func TestValidateChains_RequiredEnv(t *testing.T) {
+	t.Setenv("TARSY_TEST_SPLUNK_TOKEN", "secret")
+	t.Setenv("TARSY_TEST_WEBHOOK_TOKEN", "")
+
+	tests := []struct {
+		name   string
+		env    []string
+		errMsg string
+	}{
+		{name: "all set", env: []string{"TARSY_TEST_SPLUNK_TOKEN"}},
+		// Unset variables only warn; GET /health reports them
+		{name: "one missing", env: []string{"TARSY_TEST_SPLUNK_TOKEN", "TARSY_TEST_WEBHOOK_TOKEN"}},
+		{name: "several missing", env: []string{"TARSY_TEST_UNSET_A", "TARSY_TEST_UNSET_B"}},
+		{
+			name:   "invalid name",
+			env:    []string{"SPLUNK-TOKEN"},
+			errMsg: `invalid environment variable name "SPLUNK-TOKEN"`,
+		},
+		{
+			name:   "duplicate",
+			env:    []string{"TARSY_TEST_SPLUNK_TOKEN", "TARSY_TEST_SPLUNK_TOKEN"},
+			errMsg: "environment variable TARSY_TEST_SPLUNK_TOKEN is listed more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				ChainRegistry: NewChainRegistry(map[string]*ChainConfig{"k8s": requiredEnvChain(tt.env...)}),
+				AgentRegistry: NewAgentRegistry(map[string]*AgentConfig{
+					"test-agent": {MCPServers: []string{"test"}},
+				}),
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{}),
+			}
+
+			err := NewValidator(cfg).validateChains()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestMissingChainEnv(t *testing.T) {
+	t.Setenv("TARSY_TEST_SPLUNK_TOKEN", "secret")
+
+	chains := NewChainRegistry(map[string]*ChainConfig{
+		"zeta":  requiredEnvChain("TARSY_TEST_UNSET_Z"),
+		"alpha": requiredEnvChain("TARSY_TEST_SPLUNK_TOKEN", "TARSY_TEST_UNSET_A"),
+		"plain": requiredEnvChain(),
+		"base":  {RequiredEnv: []string{"TARSY_TEST_UNSET_BASE"}}, // handles no alert type
+	})
+
+	errs := MissingChainEnv(chains)
+	require.Len(t, errs, 2)
+	assert.Equal(t, "chain 'alpha': field 'required_env': environment variable TARSY_TEST_UNSET_A is not set", errs[0].Error())
+	assert.Equal(t, "chain 'zeta': field 'required_env': environment variable TARSY_TEST_UNSET_Z is not set", errs[1].Error())
+	assert.Equal(t, "environment variables TARSY_TEST_UNSET_A, TARSY_TEST_UNSET_B are not set",
+		errMissingEnv([]string{"TARSY_TEST_UNSET_A", "TARSY_TEST_UNSET_B"}).Error())
+
+	assert.Empty(t, MissingChainEnv(NewChainRegistry(map[string]*ChainConfig{"plain": requiredEnvChain()})))
+}
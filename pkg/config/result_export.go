@@ -0,0 +1,77 @@
+package config
+
+import "slices"
+
+// ResultExportFormat is a file written by a chain's result_export.
+type ResultExportFormat string
+
+// Supported result export formats.
+const (
+	// ResultExportJSON is the session detail as returned by
+	// GET /api/v1/sessions/:id.
+	ResultExportJSON ResultExportFormat = "json"
+	// ResultExportMarkdown is the session report as returned by
+	// GET /api/v1/sessions/:id/report?format=markdown.
+	ResultExportMarkdown ResultExportFormat = "markdown"
+)
+
+// IsValid reports whether f is a supported format.
+func (f ResultExportFormat) IsValid() bool {
+	return f == ResultExportJSON || f == ResultExportMarkdown
+}
+
+// ResultExportConfig writes the final export of each of a chain's sessions
+// to object storage once the session reaches a terminal state, for
+// data-lake ingestion and archival beyond the database retention policy.
+// The destination is configured like system.blob_store but is independent
+// of it; the postgres backend is not allowed.
+type ResultExportConfig struct {
+	Backend BlobBackend `yaml:"backend"`
+
+	// Prefix is prepended to every key, e.g. "tarsy/results/". Objects are
+	// written as <prefix><yyyy>/<mm>/<dd>/<session_id>.<json|md>, dated by
+	// the session's completion time (UTC).
+	Prefix string `yaml:"prefix,omitempty"`
+
+	// Formats to write (default: json and markdown).
+	Formats []ResultExportFormat `yaml:"formats,omitempty"`
+
+	Local LocalBlobStoreConfig `yaml:"local,omitempty"`
+	S3    S3BlobStoreConfig    `yaml:"s3,omitempty"`
+	GCS   GCSBlobStoreConfig   `yaml:"gcs,omitempty"`
+}
+
+// WantsFormat reports whether f is written. An empty Formats list means
+// every format.
+func (c *ResultExportConfig) WantsFormat(f ResultExportFormat) bool {
+	return len(c.Formats) == 0 || slices.Contains(c.Formats, f)
+}
+
+// StoreConfig returns the destination as a blob store configuration, with
+// the system.blob_store default credential variables filled in.
+func (c *ResultExportConfig) StoreConfig() *BlobStoreConfig {
+	defaults := DefaultBlobStoreConfig()
+	cfg := &BlobStoreConfig{
+		Backend: c.Backend,
+		Prefix:  c.Prefix,
+		Local:   c.Local,
+		S3:      c.S3,
+		GCS:     c.GCS,
+	}
+	if cfg.S3.AccessKeyEnv == "" {
+		cfg.S3.AccessKeyEnv = defaults.S3.AccessKeyEnv
+	}
+	if cfg.S3.SecretKeyEnv == "" {
+		cfg.S3.SecretKeyEnv = defaults.S3.SecretKeyEnv
+	}
+	if cfg.S3.SessionTokenEnv == "" {
+		cfg.S3.SessionTokenEnv = defaults.S3.SessionTokenEnv
+	}
+	if cfg.GCS.AccessIDEnv == "" {
+		cfg.GCS.AccessIDEnv = defaults.GCS.AccessIDEnv
+	}
+	if cfg.GCS.SecretEnv == "" {
+		cfg.GCS.SecretEnv = defaults.GCS.SecretEnv
+	}
+	return cfg
+}
@@ -19,6 +19,47 @@ type SlackConfig struct {
 	Enabled  bool
 	TokenEnv string // Env var name for Slack bot token (default: "SLACK_BOT_TOKEN")
 	Channel  string // Slack channel ID (e.g., "C12345678")
+
+	// ThreadStatusUpdates posts a top-level "started" message for sessions
+	// without a Slack fingerprint so later status changes reply in its thread.
+	ThreadStatusUpdates bool
+	RateLimitPerMinute  int           // Max chat.postMessage calls per minute, 0 = unlimited (default: 50)
+	DedupWindow         time.Duration // Suppress repeated notifications for the same session+status, 0 = off (default: 10m)
+}
+
+// EmailConfig holds resolved SMTP email notification configuration.
+// Recipients and schedules are configured per chain (agent_chains.<id>.email).
+type EmailConfig struct {
+	Enabled     bool
+	SMTPHost    string // SMTP server hostname (required when enabled)
+	SMTPPort    int    // SMTP server port (default: 587)
+	Username    string // SMTP auth username (empty = no auth)
+	PasswordEnv string // Env var name for SMTP auth password (default: "SMTP_PASSWORD")
+	From        string // Sender address (required when enabled)
+	StartTLS    bool   // Upgrade the connection with STARTTLS (default: true)
+	DigestHour  int    // Hour of day (UTC, 0-23) daily digests are sent (default: 8)
+}
+
+// ReportsConfig holds resolved periodic activity report configuration.
+// Reports are always stored and served by the API; each delivery target is
+// optional.
+type ReportsConfig struct {
+	Enabled         bool
+	Periods         []ReportPeriod // Report windows to generate (default: [daily])
+	Hour            int            // Hour of day (UTC, 0-23) reports are generated (default: 8)
+	Weekday         time.Weekday   // Day weekly reports are generated (default: Monday)
+	Narrative       bool           // Add an LLM-written narrative (default: true)
+	LLMProvider     string         // Provider for the narrative (empty = defaults.llm_provider)
+	SlackChannel    string         // Slack channel to post to (empty = no Slack delivery; requires system.slack)
+	EmailRecipients []string       // Addresses to email (requires system.email)
+	WebhookURL      string         // URL the report JSON is POSTed to (empty = no webhook)
+}
+
+// PagerDutyConfig holds resolved PagerDuty notification configuration.
+// Incidents are only triggered by notification routing rules with pagerduty: true.
+type PagerDutyConfig struct {
+	Enabled       bool
+	RoutingKeyEnv string // Env var name for the Events API v2 routing key (default: "PAGERDUTY_ROUTING_KEY")
 }
 
 // CostEstimationConfig holds resolved LLM cost-estimation settings.
@@ -28,6 +69,14 @@ type CostEstimationConfig struct {
 	ModelRates map[string]ModelRateConfig // exact model_name → flat per-million USD overrides
 }
 
+// CompressionConfig holds resolved at-rest compression settings for large
+// timeline contents and interaction payloads.
+// Enabled defaults to true when system.compression is omitted.
+type CompressionConfig struct {
+	Enabled        bool
+	ThresholdBytes int // Payloads at least this large are stored zstd-compressed (default: 8192)
+}
+
 // ModelRateConfig is a flat per-million USD override for one model.
 type ModelRateConfig struct {
 	InputPerMillion  float64
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// TargetsConfig lists the clusters alerts may target. A submission's target
+// (cluster, region, namespace) is validated against it, recorded on the
+// session, and substituted for ${target.*} placeholders in MCP server
+// transports, so one chain definition serves every cluster.
+type TargetsConfig struct {
+	// Default is the cluster used when a submission names none (and by MCP
+	// health checks). Empty means untargeted submissions stay untargeted.
+	Default string `yaml:"default,omitempty"`
+
+	// Clusters are the targetable clusters, by name.
+	Clusters map[string]*ClusterTargetConfig `yaml:"clusters,omitempty"`
+}
+
+// ClusterTargetConfig describes one targetable cluster.
+type ClusterTargetConfig struct {
+	// Region the cluster runs in. When set, submitted regions must match.
+	Region string `yaml:"region,omitempty"`
+
+	// Namespaces limits which namespaces may be targeted (empty = any).
+	Namespaces []string `yaml:"namespaces,omitempty"`
+
+	// Params are cluster-specific values for ${target.params.<key>}
+	// placeholders (e.g. API server URL, kubeconfig path).
+	Params map[string]string `yaml:"params,omitempty"`
+}
+
+// DefaultTargetsConfig returns the built-in defaults: no clusters, targets
+// not accepted.
+func DefaultTargetsConfig() *TargetsConfig {
+	return &TargetsConfig{}
+}
+
+// Enabled reports whether any clusters are configured.
+func (c *TargetsConfig) Enabled() bool {
+	return c != nil && len(c.Clusters) > 0
+}
+
+// Target is a resolved alert target: what MCP server transports of the
+// session's agents are rendered with.
+type Target struct {
+	Cluster   string
+	Region    string
+	Namespace string
+	Params    map[string]string
+}
+
+// Resolve validates a submitted target against the configured clusters and
+// returns it with the cluster's region and params filled in. An empty
+// cluster falls back to Default; nil is returned when there is none.
+func (c *TargetsConfig) Resolve(cluster, region, namespace string) (*Target, error) {
+	if !c.Enabled() {
+		if cluster != "" || region != "" || namespace != "" {
+			return nil, fmt.Errorf("no targets are configured (system.targets)")
+		}
+		return nil, nil
+	}
+	if cluster == "" {
+		if c.Default == "" {
+			if region != "" || namespace != "" {
+				return nil, fmt.Errorf("cluster is required")
+			}
+			return nil, nil
+		}
+		cluster = c.Default
+	}
+
+	cl, ok := c.Clusters[cluster]
+	if !ok {
+		return nil, fmt.Errorf("unknown cluster %q (configured: %s)",
+			cluster, strings.Join(slices.Sorted(maps.Keys(c.Clusters)), ", "))
+	}
+	if cl == nil {
+		cl = &ClusterTargetConfig{}
+	}
+	switch {
+	case region == "":
+		region = cl.Region
+	case cl.Region != "" && region != cl.Region:
+		return nil, fmt.Errorf("cluster %q is in region %q, not %q", cluster, cl.Region, region)
+	}
+	if namespace != "" && len(cl.Namespaces) > 0 && !slices.Contains(cl.Namespaces, namespace) {
+		return nil, fmt.Errorf("namespace %q is not targetable on cluster %q", namespace, cluster)
+	}
+	return &Target{
+		Cluster:   cluster,
+		Region:    region,
+		Namespace: namespace,
+		Params:    cl.Params,
+	}, nil
+}
+
+// targetPlaceholderPattern matches ${target.cluster}, ${target.region},
+// ${target.namespace} and ${target.params.<key>}. The $ syntax keeps the
+// placeholders out of the load-time {{.VAR}} environment expansion.
+var targetPlaceholderPattern = regexp.MustCompile(`\$\{target\.([A-Za-z0-9_.-]+)\}`)
+
+// targetParamKeyPattern matches param keys usable in placeholders.
+var targetParamKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// targetPlaceholderField reports whether name (the part after "target.") is
+// a placeholder the target can fill.
+func targetPlaceholderField(name string) bool {
+	switch name {
+	case "cluster", "region", "namespace":
+		return true
+	}
+	key, ok := strings.CutPrefix(name, "params.")
+	return ok && key != ""
+}
+
+// Expand substitutes the target's values for ${target.*} placeholders in s.
+// A nil target, and params the cluster doesn't set, expand to "".
+func (t *Target) Expand(s string) string {
+	if !strings.Contains(s, "${target.") {
+		return s
+	}
+	return targetPlaceholderPattern.ReplaceAllStringFunc(s, func(m string) string {
+		if t == nil {
+			return ""
+		}
+		name := targetPlaceholderPattern.FindStringSubmatch(m)[1]
+		switch name {
+		case "cluster":
+			return t.Cluster
+		case "region":
+			return t.Region
+		case "namespace":
+			return t.Namespace
+		}
+		if key, ok := strings.CutPrefix(name, "params."); ok {
+			return t.Params[key]
+		}
+		return m
+	})
+}
+
+// WithTarget returns a copy of the transport with the target's values
+// substituted into the command, args, env, URL, headers and bearer token.
+func (t TransportConfig) WithTarget(target *Target) TransportConfig {
+	t.Command = target.Expand(t.Command)
+	t.URL = target.Expand(t.URL)
+	t.BearerToken = target.Expand(t.BearerToken)
+	if t.Args != nil {
+		args := make([]string, len(t.Args))
+		for i, a := range t.Args {
+			args[i] = target.Expand(a)
+		}
+		t.Args = args
+	}
+	t.Env = expandTargetMap(t.Env, target)
+	t.Headers = expandTargetMap(t.Headers, target)
+	return t
+}
+
+func expandTargetMap(m map[string]string, target *Target) map[string]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[k] = target.Expand(v)
+	}
+	return out
+}
+
+// targetPlaceholders returns the ${target.*} placeholder names used by the
+// transport, for validation.
+func (t TransportConfig) targetPlaceholders() []string {
+	values := []string{t.Command, t.URL, t.BearerToken}
+	values = append(values, t.Args...)
+	values = slices.AppendSeq(values, maps.Values(t.Env))
+	values = slices.AppendSeq(values, maps.Values(t.Headers))
+
+	var names []string
+	for _, v := range values {
+		for _, m := range targetPlaceholderPattern.FindAllStringSubmatch(v, -1) {
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargetsConfig_Resolve(t *testing.T) {
+	targets := &TargetsConfig{
+		Default: "prod-east",
+		Clusters: map[string]*ClusterTargetConfig{
+			"prod-east": {Region: "us-east-1", Namespaces: []string{"payments", "checkout"}, Params: map[string]string{"api_url": "https://api.prod-east:6443"}},
+			"staging":   nil,
+		},
+	}
+
+	tests := []struct {
+		name                       string
+		cfg                        *TargetsConfig
+		cluster, region, namespace string
+		want                       *Target
+		errMsg                     string
+	}{
+		{name: "not configured, untargeted", cfg: nil},
+		{name: "not configured, targeted", cfg: DefaultTargetsConfig(), cluster: "prod-east", errMsg: "no targets are configured"},
+		{
+			name:      "explicit cluster",
+			cfg:       targets,
+			cluster:   "prod-east",
+			namespace: "payments",
+			want:      &Target{Cluster: "prod-east", Region: "us-east-1", Namespace: "payments", Params: map[string]string{"api_url": "https://api.prod-east:6443"}},
+		},
+		{
+			name:      "default cluster",
+			cfg:       targets,
+			namespace: "checkout",
+			want:      &Target{Cluster: "prod-east", Region: "us-east-1", Namespace: "checkout", Params: map[string]string{"api_url": "https://api.prod-east:6443"}},
+		},
+		{name: "bare cluster takes any region", cfg: targets, cluster: "staging", region: "eu-west-1", want: &Target{Cluster: "staging", Region: "eu-west-1"}},
+		{name: "no default", cfg: &TargetsConfig{Clusters: targets.Clusters}},
+		{name: "no default, namespace only", cfg: &TargetsConfig{Clusters: targets.Clusters}, namespace: "payments", errMsg: "cluster is required"},
+		{name: "unknown cluster", cfg: targets, cluster: "prod-west", errMsg: `unknown cluster "prod-west" (configured: prod-east, staging)`},
+		{name: "region mismatch", cfg: targets, cluster: "prod-east", region: "eu-west-1", errMsg: `cluster "prod-east" is in region "us-east-1"`},
+		{name: "namespace not allowed", cfg: targets, cluster: "prod-east", namespace: "billing", errMsg: `namespace "billing" is not targetable`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.Resolve(tt.cluster, tt.region, tt.namespace)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTransportConfig_WithTarget(t *testing.T) {
+	transport := TransportConfig{
+		Type:    TransportTypeStdio,
+		Command: "kubernetes-mcp-server",
+		Args:    []string{"--server", "${target.params.api_url}", "--namespace=${target.namespace}"},
+		Env:     map[string]string{"KUBECONFIG": "/etc/kube/${target.cluster}", "REGION": "${target.region}"},
+		URL:     "https://mcp.${target.region}.example.com/${target.params.missing}",
+		Headers: map[string]string{"X-Cluster": "${target.cluster}"},
+	}
+	target := &Target{Cluster: "prod-east", Region: "us-east-1", Namespace: "payments", Params: map[string]string{"api_url": "https://api.prod-east:6443"}}
+
+	got := transport.WithTarget(target)
+	assert.Equal(t, []string{"--server", "https://api.prod-east:6443", "--namespace=payments"}, got.Args)
+	assert.Equal(t, map[string]string{"KUBECONFIG": "/etc/kube/prod-east", "REGION": "us-east-1"}, got.Env)
+	assert.Equal(t, "https://mcp.us-east-1.example.com/", got.URL)
+	assert.Equal(t, map[string]string{"X-Cluster": "prod-east"}, got.Headers)
+	assert.Equal(t, "--namespace=${target.namespace}", transport.Args[2], "original transport is unchanged")
+
+	untargeted := transport.WithTarget(nil)
+	assert.Equal(t, "--namespace=", untargeted.Args[2])
+	assert.Equal(t, "kubernetes-mcp-server", untargeted.Command)
+}
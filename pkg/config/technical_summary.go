@@ -0,0 +1,29 @@
+package config
+
+import "fmt"
+
+// TechnicalSummaryConfig adds a technical summary step after the executive
+// summary: a longer, engineer-facing summary with root cause, evidence and
+// next steps, stored separately from the executive summary.
+type TechnicalSummaryConfig struct {
+	// Enabled turns the step on (default: false — it costs an extra LLM call
+	// per session)
+	Enabled bool `yaml:"enabled"`
+
+	// LLM provider for the technical summary (defaults to the chain's
+	// llm_provider, then defaults; executive_summary_provider does not apply)
+	LLMProvider string `yaml:"llm_provider,omitempty"`
+}
+
+// IsEnabled reports whether the technical summary step runs. Safe on nil.
+func (c *TechnicalSummaryConfig) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
+// validateTechnicalSummary checks a chain's technical_summary block.
+func (v *Validator) validateTechnicalSummary(c *TechnicalSummaryConfig) error {
+	if c.LLMProvider != "" && !v.cfg.LLMProviderRegistry.Has(c.LLMProvider) {
+		return fmt.Errorf("LLM provider '%s' not found", c.LLMProvider)
+	}
+	return nil
+}
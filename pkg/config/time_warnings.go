@@ -0,0 +1,60 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeWarningsConfig sets soft duration thresholds. Crossing one emits a
+// warning but never interrupts the run; queue.session_timeout remains the
+// hard limit. Zero disables a threshold.
+type TimeWarningsConfig struct {
+	// Stage warns when a chain stage runs longer than this
+	Stage time.Duration `yaml:"stage,omitempty"`
+
+	// Agent warns when a single agent execution runs longer than this
+	Agent time.Duration `yaml:"agent,omitempty"`
+
+	// WrapUp is the fraction (0-1] of an agent's time budget — from its start
+	// to the session or sub-agent deadline — after which the agent is told to
+	// conclude with its best current answer instead of calling more tools.
+	// Zero uses the built-in default (0.8); 1 disables.
+	WrapUp float64 `yaml:"wrap_up,omitempty"`
+}
+
+// ResolveTimeWarnings returns the thresholds that apply to chain: each
+// non-zero chain field overrides the corresponding defaults field.
+func ResolveTimeWarnings(defaults *Defaults, chain *ChainConfig) TimeWarningsConfig {
+	var resolved TimeWarningsConfig
+	if defaults != nil && defaults.TimeWarnings != nil {
+		resolved = *defaults.TimeWarnings
+	}
+	if chain != nil && chain.TimeWarnings != nil {
+		if chain.TimeWarnings.Stage > 0 {
+			resolved.Stage = chain.TimeWarnings.Stage
+		}
+		if chain.TimeWarnings.Agent > 0 {
+			resolved.Agent = chain.TimeWarnings.Agent
+		}
+		if chain.TimeWarnings.WrapUp > 0 {
+			resolved.WrapUp = chain.TimeWarnings.WrapUp
+		}
+	}
+	return resolved
+}
+
+func validateTimeWarnings(tw *TimeWarningsConfig) error {
+	if tw == nil {
+		return nil
+	}
+	if tw.Stage < 0 {
+		return fmt.Errorf("stage must be non-negative, got %v", tw.Stage)
+	}
+	if tw.Agent < 0 {
+		return fmt.Errorf("agent must be non-negative, got %v", tw.Agent)
+	}
+	if tw.WrapUp < 0 || tw.WrapUp > 1 {
+		return fmt.Errorf("wrap_up must be between 0 and 1, got %v", tw.WrapUp)
+	}
+	return nil
+}
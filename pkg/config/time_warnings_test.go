@@ -0,0 +1,76 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveTimeWarnings(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults *Defaults
+		chain    *ChainConfig
+		want     TimeWarningsConfig
+	}{
+		{name: "nothing configured", want: TimeWarningsConfig{}},
+		{
+			name:     "defaults only",
+			defaults: &Defaults{TimeWarnings: &TimeWarningsConfig{Stage: 5 * time.Minute, Agent: 3 * time.Minute}},
+			chain:    &ChainConfig{},
+			want:     TimeWarningsConfig{Stage: 5 * time.Minute, Agent: 3 * time.Minute},
+		},
+		{
+			name:     "chain overrides set fields only",
+			defaults: &Defaults{TimeWarnings: &TimeWarningsConfig{Stage: 5 * time.Minute, Agent: 3 * time.Minute}},
+			chain:    &ChainConfig{TimeWarnings: &TimeWarningsConfig{Stage: 15 * time.Minute}},
+			want:     TimeWarningsConfig{Stage: 15 * time.Minute, Agent: 3 * time.Minute},
+		},
+		{
+			name:     "chain overrides wrap_up",
+			defaults: &Defaults{TimeWarnings: &TimeWarningsConfig{WrapUp: 0.9}},
+			chain:    &ChainConfig{TimeWarnings: &TimeWarningsConfig{WrapUp: 0.7}},
+			want:     TimeWarningsConfig{WrapUp: 0.7},
+		},
+		{
+			name:  "chain only",
+			chain: &ChainConfig{TimeWarnings: &TimeWarningsConfig{Agent: time.Minute}},
+			want:  TimeWarningsConfig{Agent: time.Minute},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResolveTimeWarnings(tt.defaults, tt.chain))
+		})
+	}
+}
+
+func TestValidateTimeWarnings(t *testing.T) {
+	tests := []struct {
+		name    string
+		tw      *TimeWarningsConfig
+		wantErr string
+	}{
+		{name: "nil", tw: nil},
+		{name: "zero disables", tw: &TimeWarningsConfig{}},
+		{name: "valid", tw: &TimeWarningsConfig{Stage: 5 * time.Minute, Agent: 2 * time.Minute}},
+		{name: "negative stage", tw: &TimeWarningsConfig{Stage: -time.Minute}, wantErr: "stage must be non-negative"},
+		{name: "negative agent", tw: &TimeWarningsConfig{Agent: -time.Minute}, wantErr: "agent must be non-negative"},
+		{name: "wrap_up disabled", tw: &TimeWarningsConfig{WrapUp: 1}},
+		{name: "wrap_up above 1", tw: &TimeWarningsConfig{WrapUp: 1.5}, wantErr: "wrap_up must be between 0 and 1"},
+		{name: "negative wrap_up", tw: &TimeWarningsConfig{WrapUp: -0.1}, wantErr: "wrap_up must be between 0 and 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTimeWarnings(tt.tw)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
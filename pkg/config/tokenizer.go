@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// TokenizerType selects how token counts are computed for a provider's model.
+type TokenizerType string
+
+const (
+	// TokenizerTypeHeuristic estimates ~4 characters per token (the default).
+	TokenizerTypeHeuristic TokenizerType = "heuristic"
+	// TokenizerTypeTiktoken is byte-level BPE from a tiktoken rank file
+	// (e.g. cl100k_base.tiktoken, o200k_base.tiktoken).
+	TokenizerTypeTiktoken TokenizerType = "tiktoken"
+	// TokenizerTypeSentencePiece is a SentencePiece model file (unigram or
+	// BPE, e.g. tokenizer.model of Gemma or Llama 2 derived models).
+	TokenizerTypeSentencePiece TokenizerType = "sentencepiece"
+)
+
+// IsValid checks if the tokenizer type is valid
+func (t TokenizerType) IsValid() bool {
+	switch t {
+	case TokenizerTypeHeuristic, TokenizerTypeTiktoken, TokenizerTypeSentencePiece:
+		return true
+	default:
+		return false
+	}
+}
+
+// TokenizerConfig selects the tokenizer used to count tokens for a provider's
+// model: summarization thresholds, summarization input limits and the
+// prompt token counts recorded on LLM interactions.
+type TokenizerConfig struct {
+	// Tokenizer type (required)
+	Type TokenizerType `yaml:"type"`
+
+	// Vocabulary file: a tiktoken rank file or a SentencePiece model.
+	// Required for both; relative paths are resolved against the config
+	// directory.
+	File string `yaml:"file,omitempty"`
+}
+
+// Validate checks the tokenizer config. A nil receiver is valid (heuristic).
+func (t *TokenizerConfig) Validate() error {
+	if t == nil {
+		return nil
+	}
+	if !t.Type.IsValid() {
+		return fmt.Errorf("invalid tokenizer type: %q", t.Type)
+	}
+	if t.Type != TokenizerTypeHeuristic && t.File == "" {
+		return fmt.Errorf("file is required for %s tokenizers", t.Type)
+	}
+	return nil
+}
+
+// resolveTokenizerFiles makes relative tokenizer file paths absolute against
+// the config directory.
+func resolveTokenizerFiles(providers map[string]*LLMProviderConfig, configDir string) {
+	for _, p := range providers {
+		if p.Tokenizer != nil && p.Tokenizer.File != "" && !filepath.IsAbs(p.Tokenizer.File) {
+			p.Tokenizer.File = filepath.Join(configDir, p.Tokenizer.File)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package config
+
+import "time"
+
+// TranscriptionProviderType identifies the speech-to-text API provider.
+type TranscriptionProviderType string
+
+// Known transcription provider types.
+const (
+	// TranscriptionProviderOpenAI calls an OpenAI-compatible
+	// /audio/transcriptions endpoint (OpenAI, Groq, self-hosted Whisper
+	// servers via base_url).
+	TranscriptionProviderOpenAI TranscriptionProviderType = "openai"
+	// TranscriptionProviderGoogle asks a Gemini model to transcribe the
+	// audio inline.
+	TranscriptionProviderGoogle TranscriptionProviderType = "google"
+)
+
+// IsValid returns true for known transcription provider types.
+func (p TranscriptionProviderType) IsValid() bool {
+	switch p {
+	case TranscriptionProviderOpenAI, TranscriptionProviderGoogle:
+		return true
+	default:
+		return false
+	}
+}
+
+// TranscriptionConfig controls transcription of voice notes attached to chat
+// messages. The transcript becomes the message sent to the chat agent; the
+// audio is kept in the blob store. Disabled by default.
+type TranscriptionConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	Provider  TranscriptionProviderType `yaml:"provider"`
+	Model     string                    `yaml:"model"`
+	APIKeyEnv string                    `yaml:"api_key_env"`
+	BaseURL   string                    `yaml:"base_url,omitempty"`
+
+	// Language is an optional ISO-639-1 hint (e.g. "en") that improves
+	// accuracy and latency when all responders speak the same language.
+	Language string `yaml:"language,omitempty"`
+
+	// Timeout bounds one transcription call.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// DefaultTranscriptionConfig returns the built-in transcription defaults:
+// disabled, OpenAI whisper-1 when enabled.
+func DefaultTranscriptionConfig() *TranscriptionConfig {
+	return &TranscriptionConfig{
+		Enabled:   false,
+		Provider:  TranscriptionProviderOpenAI,
+		Model:     "whisper-1",
+		APIKeyEnv: "OPENAI_API_KEY",
+		Timeout:   60 * time.Second,
+	}
+}
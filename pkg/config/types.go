@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -16,12 +17,32 @@ type TransportConfig struct {
 	Command string            `yaml:"command,omitempty"`
 	Args    []string          `yaml:"args,omitempty"`
 	Env     map[string]string `yaml:"env,omitempty"` // Environment overrides for stdio subprocess
+	// CancelGracePeriod is how long (in seconds) a stdio subprocess gets to
+	// exit after a cancelled tool call before it is sent SIGTERM, and again
+	// before SIGKILL. Zero uses DefaultStdioCancelGracePeriod.
+	CancelGracePeriod int `yaml:"cancel_grace_period,omitempty"`
 
 	// For http/sse transport
 	URL         string `yaml:"url,omitempty"`
 	BearerToken string `yaml:"bearer_token,omitempty"`
 	VerifySSL   *bool  `yaml:"verify_ssl,omitempty"`
 	Timeout     int    `yaml:"timeout,omitempty"` // In seconds
+
+	// Headers are sent with every request to an http/sse server
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// DefaultStdioCancelGracePeriod is the grace period given to a stdio MCP
+// subprocess to exit on its own before it is terminated.
+const DefaultStdioCancelGracePeriod = 5 * time.Second
+
+// StdioCancelGracePeriod returns the configured cancel grace period, falling
+// back to DefaultStdioCancelGracePeriod when unset.
+func (t TransportConfig) StdioCancelGracePeriod() time.Duration {
+	if t.CancelGracePeriod > 0 {
+		return time.Duration(t.CancelGracePeriod) * time.Second
+	}
+	return DefaultStdioCancelGracePeriod
 }
 
 // MaskingConfig defines data masking configuration for MCP servers
@@ -68,9 +89,11 @@ type StageAgentConfig struct {
 	LLMProvider       string                  `yaml:"llm_provider,omitempty"`
 	LLMBackend        LLMBackend              `yaml:"llm_backend,omitempty"`
 	MaxIterations     *int                    `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
+	MaxToolCalls      *int                    `yaml:"max_tool_calls,omitempty" validate:"omitempty,min=1"`
 	MCPServers        []string                `yaml:"mcp_servers,omitempty"`
 	SubAgents         SubAgentRefs            `yaml:"sub_agents,omitempty"`
 	FallbackProviders []FallbackProviderEntry `yaml:"fallback_providers,omitempty"`
+	Generation        *GenerationParams       `yaml:"generation,omitempty"`
 	// RequiredSkills and Skills are additive with the agent definition (merged at resolve time, deduplicated).
 	RequiredSkills []string `yaml:"required_skills,omitempty"`
 	Skills         []string `yaml:"skills,omitempty"`
@@ -173,30 +196,38 @@ type FallbackProviderEntry struct {
 
 // SynthesisConfig defines synthesis agent configuration
 type SynthesisConfig struct {
-	Agent       string     `yaml:"agent,omitempty"`
-	LLMBackend  LLMBackend `yaml:"llm_backend,omitempty"`
-	LLMProvider string     `yaml:"llm_provider,omitempty"`
+	Agent       string            `yaml:"agent,omitempty"`
+	LLMBackend  LLMBackend        `yaml:"llm_backend,omitempty"`
+	LLMProvider string            `yaml:"llm_provider,omitempty"`
+	Generation  *GenerationParams `yaml:"generation,omitempty"`
 }
 
 // ChatConfig defines chat agent configuration
 type ChatConfig struct {
-	Enabled       bool         `yaml:"enabled"`
-	Agent         string       `yaml:"agent,omitempty"`
-	LLMBackend    LLMBackend   `yaml:"llm_backend,omitempty"`
-	LLMProvider   string       `yaml:"llm_provider,omitempty"`
-	MCPServers    []string     `yaml:"mcp_servers,omitempty"`
-	MaxIterations *int         `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
-	SubAgents     SubAgentRefs `yaml:"sub_agents,omitempty"`
+	Enabled       bool              `yaml:"enabled"`
+	Agent         string            `yaml:"agent,omitempty"`
+	LLMBackend    LLMBackend        `yaml:"llm_backend,omitempty"`
+	LLMProvider   string            `yaml:"llm_provider,omitempty"`
+	MCPServers    []string          `yaml:"mcp_servers,omitempty"`
+	MaxIterations *int              `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
+	SubAgents     SubAgentRefs      `yaml:"sub_agents,omitempty"`
+	Generation    *GenerationParams `yaml:"generation,omitempty"`
+
+	// Guardrails are rules merged into the chat agent's system prompt (e.g.
+	// "Never suggest destructive kubectl commands"), so chat follows the
+	// chain's safety posture. Alerts may add session-specific ones.
+	Guardrails []string `yaml:"guardrails,omitempty"`
 }
 
 // ScoringConfig defines scoring agent configuration for session quality evaluation
 type ScoringConfig struct {
-	Enabled       bool       `yaml:"enabled"`
-	Agent         string     `yaml:"agent,omitempty"`
-	LLMBackend    LLMBackend `yaml:"llm_backend,omitempty"`
-	LLMProvider   string     `yaml:"llm_provider,omitempty"`
-	MCPServers    []string   `yaml:"mcp_servers,omitempty"`
-	MaxIterations *int       `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
+	Enabled       bool              `yaml:"enabled"`
+	Agent         string            `yaml:"agent,omitempty"`
+	LLMBackend    LLMBackend        `yaml:"llm_backend,omitempty"`
+	LLMProvider   string            `yaml:"llm_provider,omitempty"`
+	MCPServers    []string          `yaml:"mcp_servers,omitempty"`
+	MaxIterations *int              `yaml:"max_iterations,omitempty" validate:"omitempty,min=1"`
+	Generation    *GenerationParams `yaml:"generation,omitempty"`
 }
 
 // EmbeddingProviderType identifies the embedding API provider.
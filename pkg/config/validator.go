@@ -3,8 +3,15 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"maps"
+	"net/mail"
 	"net/url"
 	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
 )
 
 // Validator validates configuration comprehensively with clear error messages
@@ -60,10 +67,120 @@ func (v *Validator) ValidateAll() error {
 		return fmt.Errorf("slack validation failed: %w", err)
 	}
 
+	if err := v.validatePagerDuty(); err != nil {
+		return fmt.Errorf("pagerduty validation failed: %w", err)
+	}
+
+	if err := v.validateNotificationRouting(); err != nil {
+		return fmt.Errorf("notification routing validation failed: %w", err)
+	}
+
+	if err := v.validateEmail(); err != nil {
+		return fmt.Errorf("email validation failed: %w", err)
+	}
+
+	if err := v.validateReports(); err != nil {
+		return fmt.Errorf("reports validation failed: %w", err)
+	}
+
 	if err := v.validateCostEstimation(); err != nil {
 		return fmt.Errorf("cost estimation validation failed: %w", err)
 	}
 
+	if err := v.validateCompression(); err != nil {
+		return fmt.Errorf("compression validation failed: %w", err)
+	}
+
+	if err := v.validateBlobStore(); err != nil {
+		return fmt.Errorf("blob store validation failed: %w", err)
+	}
+
+	if err := v.validateDegradation(); err != nil {
+		return fmt.Errorf("degradation validation failed: %w", err)
+	}
+	if err := v.validateModelRouting(); err != nil {
+		return fmt.Errorf("model routing validation failed: %w", err)
+	}
+	if err := v.validateNoiseTriage(); err != nil {
+		return fmt.Errorf("noise triage validation failed: %w", err)
+	}
+
+	if err := v.validateTranscription(); err != nil {
+		return fmt.Errorf("transcription validation failed: %w", err)
+	}
+
+	if err := v.validateCallbacks(); err != nil {
+		return fmt.Errorf("callbacks validation failed: %w", err)
+	}
+
+	if err := v.validateEventStream(); err != nil {
+		return fmt.Errorf("event stream validation failed: %w", err)
+	}
+
+	if err := v.validateFeatureFlags(); err != nil {
+		return fmt.Errorf("feature flags validation failed: %w", err)
+	}
+
+	if err := v.validateLogging(); err != nil {
+		return fmt.Errorf("logging validation failed: %w", err)
+	}
+
+	if err := v.validateAdmins(); err != nil {
+		return fmt.Errorf("admins validation failed: %w", err)
+	}
+
+	if err := v.validateProfiling(); err != nil {
+		return fmt.Errorf("profiling validation failed: %w", err)
+	}
+
+	if err := v.validateFaultInjection(); err != nil {
+		return fmt.Errorf("fault injection validation failed: %w", err)
+	}
+
+	if err := v.validateRedactionReview(); err != nil {
+		return fmt.Errorf("redaction review validation failed: %w", err)
+	}
+
+	if err := v.validateFederation(); err != nil {
+		return fmt.Errorf("federation validation failed: %w", err)
+	}
+
+	if err := v.validateTargets(); err != nil {
+		return fmt.Errorf("targets validation failed: %w", err)
+	}
+
+	if err := v.validateChatLimits(); err != nil {
+		return fmt.Errorf("chat limits validation failed: %w", err)
+	}
+
+	if err := v.validateRecurrence(); err != nil {
+		return fmt.Errorf("recurrence validation failed: %w", err)
+	}
+
+	if err := v.validateOutcomeClassification(); err != nil {
+		return fmt.Errorf("outcome classification validation failed: %w", err)
+	}
+
+	if err := v.validateActionItems(); err != nil {
+		return fmt.Errorf("action items validation failed: %w", err)
+	}
+
+	if err := v.validateOnCall(); err != nil {
+		return fmt.Errorf("on-call validation failed: %w", err)
+	}
+
+	if err := v.validateDeepLinks(); err != nil {
+		return fmt.Errorf("deep links validation failed: %w", err)
+	}
+
+	if err := v.validateEnrichment(); err != nil {
+		return fmt.Errorf("enrichment validation failed: %w", err)
+	}
+
+	if err := v.validateRecentHistory(); err != nil {
+		return fmt.Errorf("recent history validation failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -88,6 +205,12 @@ func (v *Validator) validateQueue() error {
 	if q.PollIntervalJitter >= q.PollInterval {
 		return fmt.Errorf("poll_interval_jitter must be less than poll_interval, got jitter=%v interval=%v", q.PollIntervalJitter, q.PollInterval)
 	}
+	if q.MaxPollInterval < 0 {
+		return fmt.Errorf("max_poll_interval must be non-negative, got %v", q.MaxPollInterval)
+	}
+	if q.ClaimBatchSize < 1 {
+		return fmt.Errorf("claim_batch_size must be at least 1, got %d", q.ClaimBatchSize)
+	}
 	if q.SessionTimeout <= 0 {
 		return fmt.Errorf("session_timeout must be positive, got %v", q.SessionTimeout)
 	}
@@ -109,6 +232,39 @@ func (v *Validator) validateQueue() error {
 	if q.HeartbeatInterval >= q.OrphanThreshold {
 		return fmt.Errorf("heartbeat_interval must be less than orphan_threshold to prevent false orphan detection, got heartbeat=%v threshold=%v", q.HeartbeatInterval, q.OrphanThreshold)
 	}
+	if !q.OrphanRecovery.IsValid() {
+		return fmt.Errorf("orphan_recovery must be one of fail, requeue, got %q", q.OrphanRecovery)
+	}
+	if q.OrphanMaxRequeues < 0 {
+		return fmt.Errorf("orphan_max_requeues must be non-negative, got %d", q.OrphanMaxRequeues)
+	}
+	if ac := q.AutoCancel; ac != nil {
+		if ac.PendingTTL < 0 {
+			return fmt.Errorf("auto_cancel.pending_ttl must be non-negative, got %v", ac.PendingTTL)
+		}
+		for alertType, ttl := range ac.AlertTypeTTLs {
+			if ttl < 0 {
+				return fmt.Errorf("auto_cancel.alert_type_ttls[%s] must be non-negative, got %v", alertType, ttl)
+			}
+		}
+		if ac.CheckInterval <= 0 {
+			return fmt.Errorf("auto_cancel.check_interval must be positive, got %v", ac.CheckInterval)
+		}
+	}
+	if pc := q.Preemption; pc.Active() {
+		if pc.BacklogThreshold < 1 {
+			return fmt.Errorf("preemption.backlog_threshold must be at least 1, got %d", pc.BacklogThreshold)
+		}
+		if pc.Deadline <= 0 {
+			return fmt.Errorf("preemption.deadline must be positive, got %v", pc.Deadline)
+		}
+		if pc.MaxPerCheck < 1 {
+			return fmt.Errorf("preemption.max_per_check must be at least 1, got %d", pc.MaxPerCheck)
+		}
+		if pc.CheckInterval <= 0 {
+			return fmt.Errorf("preemption.check_interval must be positive, got %v", pc.CheckInterval)
+		}
+	}
 
 	return nil
 }
@@ -141,11 +297,42 @@ func (v *Validator) validateDefaults() error {
 		}
 	}
 
+	// Validate fallback chains if specified
+	if fc := defaults.FallbackChains; fc != nil {
+		if fc.Default != "" && !v.cfg.ChainRegistry.Has(fc.Default) {
+			return NewValidationError("defaults", "", "fallback_chains.default",
+				fmt.Errorf("chain '%s' not found", fc.Default))
+		}
+		for _, namespace := range slices.Sorted(maps.Keys(fc.Namespaces)) {
+			chainID := fc.Namespaces[namespace]
+			if namespace == "" {
+				return NewValidationError("defaults", "", "fallback_chains.namespaces",
+					fmt.Errorf("namespace must not be empty"))
+			}
+			if !v.cfg.ChainRegistry.Has(chainID) {
+				return NewValidationError("defaults", "", "fallback_chains.namespaces."+namespace,
+					fmt.Errorf("chain '%s' not found", chainID))
+			}
+		}
+	}
+
+	if defaults.MaxDuplicateToolCalls != nil && *defaults.MaxDuplicateToolCalls < 1 {
+		return NewValidationError("defaults", "", "max_duplicate_tool_calls", fmt.Errorf("must be at least 1"))
+	}
+
 	// Validate fallback providers if specified
 	if err := v.validateFallbackProviders(defaults.FallbackProviders, "defaults", "", "fallback_providers"); err != nil {
 		return err
 	}
 
+	if err := ValidateOutputLanguage(defaults.OutputLanguage); err != nil {
+		return NewValidationError("defaults", "", "output_language", err)
+	}
+
+	if err := validateTimeWarnings(defaults.TimeWarnings); err != nil {
+		return NewValidationError("defaults", "", "time_warnings", err)
+	}
+
 	// Validate alert masking configuration
 	if defaults.AlertMasking != nil && defaults.AlertMasking.Enabled {
 		builtin := GetBuiltinConfig()
@@ -255,6 +442,15 @@ func (v *Validator) validateAgents() error {
 			return NewValidationError("agent", name, "max_iterations", fmt.Errorf("must be at least 1"))
 		}
 
+		if err := validateOwner(agent.Owner); err != nil {
+			return NewValidationError("agent", name, "owner", err)
+		}
+
+		// Validate max tool calls if specified
+		if agent.MaxToolCalls != nil && *agent.MaxToolCalls < 1 {
+			return NewValidationError("agent", name, "max_tool_calls", fmt.Errorf("must be at least 1"))
+		}
+
 		// Validate native tool keys if specified
 		for tool := range agent.NativeTools {
 			if !tool.IsValid() {
@@ -262,6 +458,11 @@ func (v *Validator) validateAgents() error {
 			}
 		}
 
+		// Validate agent generation parameters if specified
+		if err := agent.Generation.Validate(); err != nil {
+			return NewValidationError("agent", name, "generation", err)
+		}
+
 		if agent.Orchestrator != nil {
 			if err := v.validateOrchestratorConfig(agent.Orchestrator, "agent", name); err != nil {
 				return err
@@ -290,6 +491,23 @@ func (v *Validator) validateChains() error {
 			alertTypeToChain[alertType] = chainID
 		}
 
+		if err := validateOwner(chain.Owner); err != nil {
+			return NewValidationError("chain", chainID, "owner", err)
+		}
+
+		if err := validateExecutiveSummary(chain.ExecutiveSummary); err != nil {
+			return NewValidationError("chain", chainID, "executive_summary", err)
+		}
+
+		if chain.OnCallService != "" {
+			if !v.cfg.OnCall.Enabled() {
+				return NewValidationError("chain", chainID, "on_call_service", fmt.Errorf("requires system.on_call"))
+			}
+			if _, ok := v.cfg.OnCall.Services[chain.OnCallService]; !ok {
+				return NewValidationError("chain", chainID, "on_call_service", fmt.Errorf("service '%s' not found in system.on_call.services", chain.OnCallService))
+			}
+		}
+
 		// Validate stages
 		if len(chain.Stages) == 0 {
 			return NewValidationError("chain", chainID, "stages", fmt.Errorf("at least one stage required"))
@@ -327,9 +545,18 @@ func (v *Validator) validateChains() error {
 				return NewValidationError("chain", chainID, "chat.max_iterations", fmt.Errorf("must be at least 1"))
 			}
 
+			// Validate chat generation parameters if specified
+			if err := chain.Chat.Generation.Validate(); err != nil {
+				return NewValidationError("chain", chainID, "chat.generation", err)
+			}
+
 			if err := v.validateSubAgentRefs(chain.Chat.SubAgents, "chain", chainID, "chat.sub_agents"); err != nil {
 				return err
 			}
+
+			if err := ValidateChatGuardrails(chain.Chat.Guardrails); err != nil {
+				return NewValidationError("chain", chainID, "chat.guardrails", err)
+			}
 		}
 
 		// Validate scoring agent if enabled
@@ -360,6 +587,11 @@ func (v *Validator) validateChains() error {
 				return NewValidationError("chain", chainID, "scoring.max_iterations", fmt.Errorf("must be at least 1"))
 			}
 
+			// Validate scoring generation parameters if specified
+			if err := chain.Scoring.Generation.Validate(); err != nil {
+				return NewValidationError("chain", chainID, "scoring.generation", err)
+			}
+
 			// Validate scoring MCP servers if specified
 			for _, serverID := range chain.Scoring.MCPServers {
 				if !v.cfg.MCPServerRegistry.Has(serverID) {
@@ -368,6 +600,63 @@ func (v *Validator) validateChains() error {
 			}
 		}
 
+		if err := ValidateOutputLanguage(chain.OutputLanguage); err != nil {
+			return NewValidationError("chain", chainID, "output_language", err)
+		}
+
+		if err := validateTimeWarnings(chain.TimeWarnings); err != nil {
+			return NewValidationError("chain", chainID, "time_warnings", err)
+		}
+
+		if chain.RecentHistory != nil && chain.RecentHistory.Window < 0 {
+			return NewValidationError("chain", chainID, "recent_history.window", fmt.Errorf("must not be negative"))
+		}
+
+		// Validate Slack message template if specified
+		if chain.Slack != nil {
+			if err := validateSlackTemplate(chain.Slack); err != nil {
+				return NewValidationError("chain", chainID, "slack", err)
+			}
+		}
+
+		// Validate email recipients and schedule if specified
+		if chain.Email != nil {
+			if err := v.validateChainEmail(chain.Email); err != nil {
+				return NewValidationError("chain", chainID, "email", err)
+			}
+		}
+
+		// Validate milestone notifications if specified
+		if chain.MilestoneNotifications != nil {
+			if err := v.validateMilestoneNotifications(chain.MilestoneNotifications); err != nil {
+				return NewValidationError("chain", chainID, "milestone_notifications", err)
+			}
+		}
+
+		// Validate result export destination if specified
+		if chain.ResultExport != nil {
+			if err := validateResultExport(chain.ResultExport); err != nil {
+				return NewValidationError("chain", chainID, "result_export", err)
+			}
+		}
+
+		// Validate forecast budget if specified
+		if chain.Budget != nil {
+			if err := v.validateChainBudget(chain.Budget); err != nil {
+				return NewValidationError("chain", chainID, "budget", err)
+			}
+		}
+
+		// Validate required environment names. Unset variables only warn:
+		// GET /health keeps reporting them (chain_env) until they are set
+		if err := validateRequiredEnvNames(chain.RequiredEnv); err != nil {
+			return NewValidationError("chain", chainID, "required_env", err)
+		}
+		if missing := chain.MissingRequiredEnv(); len(missing) > 0 {
+			slog.Warn("Chain required environment variables are not set — its sessions will fail when they need them",
+				"chain", chainID, "missing", missing)
+		}
+
 		// Validate chain-level LLM provider if specified
 		if chain.LLMProvider != "" && !v.cfg.LLMProviderRegistry.Has(chain.LLMProvider) {
 			return NewValidationError("chain", chainID, "llm_provider", fmt.Errorf("LLM provider '%s' not found", chain.LLMProvider))
@@ -383,6 +672,16 @@ func (v *Validator) validateChains() error {
 			return NewValidationError("chain", chainID, "max_iterations", fmt.Errorf("must be at least 1"))
 		}
 
+		// Validate chain-level max tool calls if specified
+		if chain.MaxToolCalls != nil && *chain.MaxToolCalls < 1 {
+			return NewValidationError("chain", chainID, "max_tool_calls", fmt.Errorf("must be at least 1"))
+		}
+
+		// Validate chain-level generation parameters if specified
+		if err := chain.Generation.Validate(); err != nil {
+			return NewValidationError("chain", chainID, "generation", err)
+		}
+
 		// Validate chain-level MCP servers if specified
 		for _, serverID := range chain.MCPServers {
 			if !v.cfg.MCPServerRegistry.Has(serverID) {
@@ -394,6 +693,20 @@ func (v *Validator) validateChains() error {
 		if err := v.validateSubAgentRefs(chain.SubAgents, "chain", chainID, "sub_agents"); err != nil {
 			return err
 		}
+
+		// Validate fan-out siblings if specified
+		if chain.FanOut != nil {
+			if err := v.validateFanOut(chainID, chain.FanOut); err != nil {
+				return NewValidationError("chain", chainID, "fan_out", err)
+			}
+		}
+
+		// Validate technical summary if specified
+		if chain.TechnicalSummary != nil {
+			if err := v.validateTechnicalSummary(chain.TechnicalSummary); err != nil {
+				return NewValidationError("chain", chainID, "technical_summary", err)
+			}
+		}
 	}
 
 	return nil
@@ -407,6 +720,11 @@ func (v *Validator) validateStage(chainID string, stageIndex int, stage *StageCo
 		return fmt.Errorf("%s: stage name required", stageRef)
 	}
 
+	// Remote stages run on another instance with its own agents
+	if stage.Remote != nil {
+		return v.validateRemoteStage(stageRef, stage)
+	}
+
 	// Validate agents field (must have at least 1 agent)
 	if len(stage.Agents) == 0 {
 		return fmt.Errorf("%s: must specify at least one agent in 'agents' array", stageRef)
@@ -438,6 +756,16 @@ func (v *Validator) validateStage(chainID string, stageIndex int, stage *StageCo
 			return fmt.Errorf("%s: agent '%s' max_iterations must be at least 1", stageRef, agentConfig.Name)
 		}
 
+		// Validate agent-level max tool calls if specified
+		if agentConfig.MaxToolCalls != nil && *agentConfig.MaxToolCalls < 1 {
+			return fmt.Errorf("%s: agent '%s' max_tool_calls must be at least 1", stageRef, agentConfig.Name)
+		}
+
+		// Validate agent-level generation parameters if specified
+		if err := agentConfig.Generation.Validate(); err != nil {
+			return fmt.Errorf("%s: agent '%s' generation: %w", stageRef, agentConfig.Name, err)
+		}
+
 		// Validate agent-level MCP servers if specified
 		for _, serverID := range agentConfig.MCPServers {
 			if !v.cfg.MCPServerRegistry.Has(serverID) {
@@ -494,6 +822,16 @@ func (v *Validator) validateStage(chainID string, stageIndex int, stage *StageCo
 		return fmt.Errorf("%s: max_iterations must be at least 1", stageRef)
 	}
 
+	// Validate stage-level max tool calls if specified
+	if stage.MaxToolCalls != nil && *stage.MaxToolCalls < 1 {
+		return fmt.Errorf("%s: max_tool_calls must be at least 1", stageRef)
+	}
+
+	// Validate stage-level generation parameters if specified
+	if err := stage.Generation.Validate(); err != nil {
+		return fmt.Errorf("%s: generation: %w", stageRef, err)
+	}
+
 	// Validate synthesis agent if specified
 	if stage.Synthesis != nil {
 		if stage.Synthesis.Agent != "" && !v.cfg.AgentRegistry.Has(stage.Synthesis.Agent) {
@@ -509,11 +847,31 @@ func (v *Validator) validateStage(chainID string, stageIndex int, stage *StageCo
 		if stage.Synthesis.LLMProvider != "" && !v.cfg.LLMProviderRegistry.Has(stage.Synthesis.LLMProvider) {
 			return fmt.Errorf("%s: synthesis specifies LLM provider '%s' which is not found", stageRef, stage.Synthesis.LLMProvider)
 		}
+
+		// Validate synthesis generation parameters if specified
+		if err := stage.Synthesis.Generation.Validate(); err != nil {
+			return fmt.Errorf("%s: synthesis generation: %w", stageRef, err)
+		}
 	}
 
 	return nil
 }
 
+// validateRemoteStage checks a stage delegated to another instance. Agent
+// settings belong to the remote chain, so a remote stage sets none.
+func (v *Validator) validateRemoteStage(stageRef string, stage *StageConfig) error {
+	if stage.Remote.Instance == "" {
+		return fmt.Errorf("%s: remote.instance required", stageRef)
+	}
+	if v.cfg.Federation.Instance(stage.Remote.Instance) == nil {
+		return fmt.Errorf("%s: remote instance '%s' not found in system.federation.instances", stageRef, stage.Remote.Instance)
+	}
+	if len(stage.Agents) > 0 || stage.Replicas > 0 || stage.Synthesis != nil || len(stage.SubAgents) > 0 {
+		return fmt.Errorf("%s: remote stages must not set agents, replicas, sub_agents or synthesis", stageRef)
+	}
+	return nil
+}
+
 // warnMixedActionStage logs a warning when a stage has both action and non-action
 // agents. The stage type will fall back to "investigation", losing action-stage
 // benefits (dashboard rendering, DB queryability).
@@ -558,6 +916,9 @@ func (v *Validator) validateMCPServers() error {
 			if server.Transport.Command == "" {
 				return NewValidationError("mcp_server", serverID, "transport.command", fmt.Errorf("command required for stdio transport"))
 			}
+			if server.Transport.CancelGracePeriod < 0 {
+				return NewValidationError("mcp_server", serverID, "transport.cancel_grace_period", fmt.Errorf("must be non-negative"))
+			}
 
 		case TransportTypeHTTP, TransportTypeSSE:
 			if server.Transport.URL == "" {
@@ -565,6 +926,13 @@ func (v *Validator) validateMCPServers() error {
 			}
 		}
 
+		// Validate ${target.*} placeholders
+		for _, name := range server.Transport.targetPlaceholders() {
+			if !targetPlaceholderField(name) {
+				return NewValidationError("mcp_server", serverID, "transport", fmt.Errorf("unknown placeholder ${target.%s} (use cluster, region, namespace or params.<key>)", name))
+			}
+		}
+
 		// Validate data masking configuration
 		if server.DataMasking != nil && server.DataMasking.Enabled {
 			// Validate pattern groups reference built-in patterns
@@ -592,6 +960,10 @@ func (v *Validator) validateMCPServers() error {
 			}
 		}
 
+		if server.MaxConcurrentCalls < 0 {
+			return NewValidationError("mcp_server", serverID, "max_concurrent_calls", fmt.Errorf("must be non-negative"))
+		}
+
 		// Validate summarization configuration
 		if server.Summarization != nil && !server.Summarization.SummarizationDisabled() {
 			if server.Summarization.SizeThresholdTokens < 100 {
@@ -634,6 +1006,26 @@ func (v *Validator) validateLLMProviders() error {
 			return NewValidationError("llm_provider", name, "max_tool_result_tokens", fmt.Errorf("must be at least 1000"))
 		}
 
+		// Validate declared capabilities
+		if c := provider.Capabilities; c != nil {
+			if c.ContextWindow < 0 {
+				return NewValidationError("llm_provider", name, "capabilities.context_window", fmt.Errorf("must be non-negative"))
+			}
+			if c.ContextWindow > 0 && provider.MaxToolResultTokens > c.ContextWindow {
+				return NewValidationError("llm_provider", name, "max_tool_result_tokens",
+					fmt.Errorf("%d exceeds capabilities.context_window %d", provider.MaxToolResultTokens, c.ContextWindow))
+			}
+		}
+
+		// Validate default generation parameters if specified
+		if err := provider.Generation.Validate(); err != nil {
+			return NewValidationError("llm_provider", name, "generation", err)
+		}
+
+		if err := provider.Tokenizer.Validate(); err != nil {
+			return NewValidationError("llm_provider", name, "tokenizer", err)
+		}
+
 		// Validate native tools (Google-specific)
 		if provider.Type == LLMProviderTypeGoogle && provider.NativeTools != nil {
 			for tool := range provider.NativeTools {
@@ -664,6 +1056,45 @@ func (v *Validator) collectReferencedLLMProviders() map[string]bool {
 		}
 	}
 
+	// Report narrative provider
+	if r := v.cfg.Reports; r != nil && r.Enabled && r.Narrative && r.LLMProvider != "" {
+		referenced[r.LLMProvider] = true
+	}
+
+	// Degradation profile provider
+	if d := v.cfg.Degradation; d != nil && d.Enabled && d.LLMProvider != "" {
+		referenced[d.LLMProvider] = true
+	}
+
+	// Noise triage classifier provider
+	if t := v.cfg.NoiseTriage; t != nil && t.Enabled && t.ClassifierProvider != "" {
+		referenced[t.ClassifierProvider] = true
+	}
+
+	// Recurrence comparison provider
+	if r := v.cfg.Recurrence; r != nil && r.Enabled && r.LLMProvider != "" {
+		referenced[r.LLMProvider] = true
+	}
+
+	// Outcome classification provider
+	if o := v.cfg.OutcomeClassification; o != nil && o.Enabled && o.LLMProvider != "" {
+		referenced[o.LLMProvider] = true
+	}
+
+	// Action item extraction provider
+	if a := v.cfg.ActionItems; a != nil && a.Enabled && a.LLMProvider != "" {
+		referenced[a.LLMProvider] = true
+	}
+
+	// Model routing classifier and tier providers
+	if r := v.cfg.ModelRouting; r != nil && r.Enabled {
+		for _, name := range []string{r.ClassifierProvider, r.FastProvider, r.StrongProvider} {
+			if name != "" {
+				referenced[name] = true
+			}
+		}
+	}
+
 	// If no chain registry exists, no chain-level providers are referenced
 	if v.cfg.ChainRegistry == nil {
 		return referenced
@@ -675,6 +1106,16 @@ func (v *Validator) collectReferencedLLMProviders() map[string]bool {
 			referenced[chain.LLMProvider] = true
 		}
 
+		// Executive summary provider
+		if chain.ExecutiveSummaryProvider != "" {
+			referenced[chain.ExecutiveSummaryProvider] = true
+		}
+
+		// Technical summary provider
+		if chain.TechnicalSummary != nil && chain.TechnicalSummary.LLMProvider != "" {
+			referenced[chain.TechnicalSummary.LLMProvider] = true
+		}
+
 		// Chain-level fallback providers
 		for _, fb := range chain.FallbackProviders {
 			referenced[fb.Provider] = true
@@ -704,6 +1145,11 @@ func (v *Validator) collectReferencedLLMProviders() map[string]bool {
 			referenced[chain.Scoring.LLMProvider] = true
 		}
 
+		// Fan-out synthesis provider
+		if chain.FanOut != nil && chain.FanOut.LLMProvider != "" {
+			referenced[chain.FanOut.LLMProvider] = true
+		}
+
 		// Stage-level LLM providers
 		for _, stage := range chain.Stages {
 			// Stage-level fallback providers
@@ -755,6 +1201,9 @@ func (v *Validator) validateOrchestratorConfig(oc *OrchestratorConfig, section,
 	if oc.MaxBudget != nil && *oc.MaxBudget <= 0 {
 		return NewValidationError(section, name, "orchestrator.max_budget", fmt.Errorf("must be positive"))
 	}
+	if oc.MaxTokens != nil && *oc.MaxTokens < 1 {
+		return NewValidationError(section, name, "orchestrator.max_tokens", fmt.Errorf("must be at least 1"))
+	}
 	return nil
 }
 
@@ -816,6 +1265,40 @@ func missingProviderEnvVar(provider *LLMProviderConfig) string {
 	return ""
 }
 
+// MissingOptionalEnv lists credentials environment variables that validation
+// tolerates being unset because nothing needs them at startup: LLM providers
+// no chain references (built-ins excluded, they ship for every backend), the
+// memory embedding key and the transcription key. Features depending on them
+// fail at runtime instead; strict startup turns each entry into a fatal error.
+func (v *Validator) MissingOptionalEnv() []string {
+	var missing []string
+
+	referenced := v.collectReferencedLLMProviders()
+	builtin := GetBuiltinConfig()
+	for _, name := range slices.Sorted(maps.Keys(v.cfg.LLMProviderRegistry.GetAll())) {
+		if referenced[name] {
+			continue
+		}
+		if _, ok := builtin.LLMProviders[name]; ok {
+			continue
+		}
+		provider, _ := v.cfg.LLMProviderRegistry.Get(name)
+		if env := missingProviderEnvVar(provider); env != "" {
+			missing = append(missing, fmt.Sprintf("llm_provider '%s': environment variable %s is not set", name, env))
+		}
+	}
+
+	if mc := ResolvedMemoryConfig(v.cfg.Defaults); mc != nil && os.Getenv(mc.Embedding.APIKeyEnv) == "" {
+		missing = append(missing, fmt.Sprintf("defaults.memory.embedding.api_key_env: environment variable %s is not set", mc.Embedding.APIKeyEnv))
+	}
+
+	if t := v.cfg.Transcription; t != nil && t.Enabled && os.Getenv(t.APIKeyEnv) == "" {
+		missing = append(missing, fmt.Sprintf("system.transcription.api_key_env: environment variable %s is not set", t.APIKeyEnv))
+	}
+
+	return missing
+}
+
 func (v *Validator) validateFallbackProviders(entries []FallbackProviderEntry, section, name, field string) error {
 	for i, entry := range entries {
 		entryRef := fmt.Sprintf("%s[%d]", field, i)
@@ -868,6 +1351,45 @@ func (v *Validator) validateRunbooks() error {
 	return nil
 }
 
+var slackPlaceholderPattern = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+
+// validateSlackTemplate checks Block Kit limits, required fields, and that
+// every {placeholder} is known.
+func validateSlackTemplate(t *SlackTemplateConfig) error {
+	if len(t.Fields) > MaxSlackTemplateFields {
+		return fmt.Errorf("at most %d fields allowed, got %d", MaxSlackTemplateFields, len(t.Fields))
+	}
+	if len(t.Buttons) > MaxSlackTemplateButtons {
+		return fmt.Errorf("at most %d buttons allowed, got %d", MaxSlackTemplateButtons, len(t.Buttons))
+	}
+	for i, f := range t.Fields {
+		if f.Label == "" || f.Value == "" {
+			return fmt.Errorf("fields[%d]: label and value are required", i)
+		}
+		if err := checkSlackPlaceholders(f.Value); err != nil {
+			return fmt.Errorf("fields[%d].value: %w", i, err)
+		}
+	}
+	for i, b := range t.Buttons {
+		if b.Text == "" || b.URL == "" {
+			return fmt.Errorf("buttons[%d]: text and url are required", i)
+		}
+		if err := checkSlackPlaceholders(b.URL); err != nil {
+			return fmt.Errorf("buttons[%d].url: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func checkSlackPlaceholders(s string) error {
+	for _, m := range slackPlaceholderPattern.FindAllStringSubmatch(s, -1) {
+		if !slices.Contains(SlackTemplatePlaceholders, m[1]) {
+			return fmt.Errorf("unknown placeholder {%s} (allowed: %s)", m[1], strings.Join(SlackTemplatePlaceholders, ", "))
+		}
+	}
+	return nil
+}
+
 func (v *Validator) validateSlack() error {
 	s := v.cfg.Slack
 	if s == nil || !s.Enabled {
@@ -886,49 +1408,869 @@ func (v *Validator) validateSlack() error {
 		return fmt.Errorf("system.slack.token_env: environment variable %s is not set", s.TokenEnv)
 	}
 
+	if s.RateLimitPerMinute < 0 {
+		return fmt.Errorf("system.slack.rate_limit_per_minute must be non-negative, got %d", s.RateLimitPerMinute)
+	}
+
+	if s.DedupWindow < 0 {
+		return fmt.Errorf("system.slack.dedup_window must be non-negative, got %v", s.DedupWindow)
+	}
+
 	return nil
 }
 
-func (v *Validator) validateCostEstimation() error {
-	ce := v.cfg.CostEstimation
-	if ce == nil {
+func (v *Validator) validatePagerDuty() error {
+	pd := v.cfg.PagerDuty
+	if pd == nil || !pd.Enabled {
 		return nil
 	}
 
-	for name, rate := range ce.ModelRates {
-		if name == "" {
-			return fmt.Errorf("system.cost_estimation.model_rates: model name must not be empty")
-		}
-		if rate.InputPerMillion < 0 {
-			return fmt.Errorf("system.cost_estimation.model_rates.%s.input_per_million must be >= 0", name)
-		}
-		if rate.OutputPerMillion < 0 {
-			return fmt.Errorf("system.cost_estimation.model_rates.%s.output_per_million must be >= 0", name)
-		}
+	if pd.RoutingKeyEnv == "" {
+		return fmt.Errorf("system.pagerduty.routing_key_env is required when PagerDuty is enabled")
+	}
+
+	if key := os.Getenv(pd.RoutingKeyEnv); key == "" {
+		return fmt.Errorf("system.pagerduty.routing_key_env: environment variable %s is not set", pd.RoutingKeyEnv)
 	}
 
 	return nil
 }
 
-// validateSkillNameList checks that each name exists in the skill registry and is unique within names.
-func (v *Validator) validateSkillNameList(names []string, section, resourceName, field string) error {
-	if len(names) == 0 {
+func (v *Validator) validateEmail() error {
+	e := v.cfg.Email
+	if e == nil || !e.Enabled {
 		return nil
 	}
-	registry := v.cfg.SkillRegistry
-	if registry == nil {
-		registry = NewSkillRegistry(nil)
+
+	if e.SMTPHost == "" {
+		return fmt.Errorf("system.email.smtp_host is required when email is enabled")
 	}
-	seen := make(map[string]struct{}, len(names))
-	for _, skillName := range names {
-		if _, dup := seen[skillName]; dup {
-			return NewValidationError(section, resourceName, field,
-				fmt.Errorf("duplicate skill %q", skillName))
-		}
-		seen[skillName] = struct{}{}
-		if !registry.Has(skillName) {
-			return NewValidationError(section, resourceName, field,
-				fmt.Errorf("%w: %s", ErrSkillNotFound, skillName))
+
+	if e.SMTPPort < 1 || e.SMTPPort > 65535 {
+		return fmt.Errorf("system.email.smtp_port must be between 1 and 65535, got %d", e.SMTPPort)
+	}
+
+	if e.From == "" {
+		return fmt.Errorf("system.email.from is required when email is enabled")
+	}
+
+	if _, err := mail.ParseAddress(e.From); err != nil {
+		return fmt.Errorf("system.email.from: invalid address %q: %w", e.From, err)
+	}
+
+	if e.DigestHour < 0 || e.DigestHour > 23 {
+		return fmt.Errorf("system.email.digest_hour must be between 0 and 23, got %d", e.DigestHour)
+	}
+
+	if e.Username != "" {
+		if e.PasswordEnv == "" {
+			return fmt.Errorf("system.email.password_env is required when username is set")
+		}
+		if password := os.Getenv(e.PasswordEnv); password == "" {
+			return fmt.Errorf("system.email.password_env: environment variable %s is not set", e.PasswordEnv)
+		}
+	}
+
+	return nil
+}
+
+// validateChainEmail checks a chain's email block. Email must be enabled
+// system-wide for chain recipients to be usable.
+func (v *Validator) validateChainEmail(c *ChainEmailConfig) error {
+	if v.cfg.Email == nil || !v.cfg.Email.Enabled {
+		return fmt.Errorf("requires system.email to be enabled")
+	}
+	if len(c.Recipients) == 0 {
+		return fmt.Errorf("at least one recipient required")
+	}
+	for _, r := range c.Recipients {
+		if _, err := mail.ParseAddress(r); err != nil {
+			return fmt.Errorf("invalid recipient %q: %w", r, err)
+		}
+	}
+	if c.Schedule != "" && !c.Schedule.IsValid() {
+		return fmt.Errorf("invalid schedule %q (must be immediate, hourly, or daily)", c.Schedule)
+	}
+	return nil
+}
+
+// validateMilestoneNotifications checks a chain's milestone_notifications
+// block: at least one known event and at least one usable delivery target.
+func (v *Validator) validateMilestoneNotifications(c *MilestoneNotificationConfig) error {
+	if len(c.Events) == 0 {
+		return fmt.Errorf("at least one event required")
+	}
+	for _, e := range c.Events {
+		if !e.IsValid() {
+			return fmt.Errorf("invalid event %q (must be first_tool_call, stage_completed, or final_analysis)", e)
+		}
+	}
+	if !c.Slack && c.WebhookURL == "" {
+		return fmt.Errorf("slack or webhook_url must be set")
+	}
+	if c.Slack && (v.cfg.Slack == nil || !v.cfg.Slack.Enabled) {
+		return fmt.Errorf("slack requires system.slack to be enabled")
+	}
+	if c.WebhookURL != "" {
+		u, err := url.Parse(c.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("webhook_url must be an absolute http(s) URL, got %q", c.WebhookURL)
+		}
+	}
+	return nil
+}
+
+// validateResultExport checks a chain's result_export block: an object
+// storage backend (not postgres, which the retention policy cleans up), known
+// formats, and the backend settings.
+func validateResultExport(c *ResultExportConfig) error {
+	switch c.Backend {
+	case BlobBackendS3, BlobBackendGCS, BlobBackendLocal:
+	default:
+		return fmt.Errorf("backend must be one of s3, gcs, local, got %q", c.Backend)
+	}
+	for _, f := range c.Formats {
+		if !f.IsValid() {
+			return fmt.Errorf("invalid format %q (must be json or markdown)", f)
+		}
+	}
+	return validateBlobDestination(c.StoreConfig(), "")
+}
+
+// validateChainBudget checks a chain's budget block: at least one
+// non-negative limit, and cost limits only when cost estimation is on (the
+// forecast has no cost otherwise).
+func (v *Validator) validateChainBudget(b *ChainBudgetConfig) error {
+	if b.MaxCostUSD < 0 || b.MaxDuration < 0 || b.MinSamples < 0 {
+		return fmt.Errorf("max_cost_usd, max_duration and min_samples must not be negative")
+	}
+	if b.MaxCostUSD == 0 && b.MaxDuration == 0 {
+		return fmt.Errorf("at least one of max_cost_usd or max_duration is required")
+	}
+	if b.MaxCostUSD > 0 && v.cfg.CostEstimation != nil && !v.cfg.CostEstimation.Enabled {
+		return fmt.Errorf("max_cost_usd requires system.cost_estimation to be enabled")
+	}
+	return nil
+}
+
+func (v *Validator) validateDegradation() error {
+	d := v.cfg.Degradation
+	if d == nil || !d.Enabled {
+		return nil
+	}
+
+	if d.FailureThreshold < 1 {
+		return fmt.Errorf("system.degradation.failure_threshold must be at least 1, got %d", d.FailureThreshold)
+	}
+	if d.Cooldown <= 0 {
+		return fmt.Errorf("system.degradation.cooldown must be positive")
+	}
+	if d.MaxIterations < 1 {
+		return fmt.Errorf("system.degradation.max_iterations must be at least 1, got %d", d.MaxIterations)
+	}
+	if d.LLMProvider != "" {
+		if _, err := v.cfg.GetLLMProvider(d.LLMProvider); err != nil {
+			return fmt.Errorf("system.degradation.llm_provider: %w", err)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateModelRouting() error {
+	r := v.cfg.ModelRouting
+	if r == nil || !r.Enabled {
+		return nil
+	}
+
+	providers := []struct{ field, name string }{
+		{"classifier_provider", r.ClassifierProvider},
+		{"fast_provider", r.FastProvider},
+		{"strong_provider", r.StrongProvider},
+	}
+	for _, p := range providers {
+		if p.name == "" {
+			return fmt.Errorf("system.model_routing.%s is required when model routing is enabled", p.field)
+		}
+		if _, err := v.cfg.GetLLMProvider(p.name); err != nil {
+			return fmt.Errorf("system.model_routing.%s: %w", p.field, err)
+		}
+	}
+	// The classifier answers with a JSON verdict
+	if classifier, _ := v.cfg.GetLLMProvider(r.ClassifierProvider); !classifier.EffectiveCapabilities().StructuredOutput {
+		return fmt.Errorf("system.model_routing.classifier_provider: LLM provider %q does not support structured output", r.ClassifierProvider)
+	}
+	if r.ComplexityThreshold < 1 || r.ComplexityThreshold > 10 {
+		return fmt.Errorf("system.model_routing.complexity_threshold must be between 1 and 10, got %d", r.ComplexityThreshold)
+	}
+	if r.Timeout <= 0 {
+		return fmt.Errorf("system.model_routing.timeout must be positive")
+	}
+	return nil
+}
+
+func (v *Validator) validateNoiseTriage() error {
+	t := v.cfg.NoiseTriage
+	if t == nil || !t.Enabled {
+		return nil
+	}
+
+	if t.ClassifierProvider == "" {
+		return fmt.Errorf("system.noise_triage.classifier_provider is required when noise triage is enabled")
+	}
+	classifier, err := v.cfg.GetLLMProvider(t.ClassifierProvider)
+	if err != nil {
+		return fmt.Errorf("system.noise_triage.classifier_provider: %w", err)
+	}
+	// The classifier answers with a JSON verdict
+	if !classifier.EffectiveCapabilities().StructuredOutput {
+		return fmt.Errorf("system.noise_triage.classifier_provider: LLM provider %q does not support structured output", t.ClassifierProvider)
+	}
+	if t.Timeout <= 0 {
+		return fmt.Errorf("system.noise_triage.timeout must be positive")
+	}
+	if t.DuplicateWindow <= 0 {
+		return fmt.Errorf("system.noise_triage.duplicate_window must be positive")
+	}
+	if len(t.AlertTypes) == 0 {
+		return fmt.Errorf("system.noise_triage.alert_types must list at least one alert type (or \"*\") when noise triage is enabled")
+	}
+	for alertType, at := range t.AlertTypes {
+		if strings.TrimSpace(alertType) == "" {
+			return fmt.Errorf("system.noise_triage.alert_types: alert type must not be empty")
+		}
+		if at.MinConfidence < 0 || at.MinConfidence > 1 {
+			return fmt.Errorf("system.noise_triage.alert_types.%s.min_confidence must be between 0 and 1, got %g", alertType, at.MinConfidence)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateTranscription() error {
+	t := v.cfg.Transcription
+	if t == nil || !t.Enabled {
+		return nil
+	}
+	if !t.Provider.IsValid() {
+		return fmt.Errorf("system.transcription.provider: invalid transcription provider: %s", t.Provider)
+	}
+	if t.Model == "" {
+		return fmt.Errorf("system.transcription.model is required when transcription is enabled")
+	}
+	if t.APIKeyEnv == "" {
+		return fmt.Errorf("system.transcription.api_key_env is required when transcription is enabled")
+	}
+	if t.Timeout <= 0 {
+		return fmt.Errorf("system.transcription.timeout must be positive")
+	}
+	if os.Getenv(t.APIKeyEnv) == "" {
+		slog.Warn("Transcription API key env var is not set — voice notes will fail to transcribe",
+			"env_var", t.APIKeyEnv)
+	}
+	return nil
+}
+
+func (v *Validator) validateCallbacks() error {
+	c := v.cfg.Callbacks
+	if c == nil || !c.Enabled {
+		return nil
+	}
+	if c.MaxAttempts < 1 {
+		return fmt.Errorf("system.callbacks.max_attempts must be at least 1")
+	}
+	if c.InitialBackoff <= 0 {
+		return fmt.Errorf("system.callbacks.initial_backoff must be positive")
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("system.callbacks.max_backoff must not be less than initial_backoff")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("system.callbacks.timeout must be positive")
+	}
+	for i, host := range c.AllowedHosts {
+		if strings.TrimSpace(host) == "" {
+			return fmt.Errorf("system.callbacks.allowed_hosts[%d] must not be empty", i)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateEventStream() error {
+	e := v.cfg.EventStream
+	if e == nil || !e.Enabled {
+		return nil
+	}
+	if e.Endpoint == "" {
+		return fmt.Errorf("system.event_stream.endpoint is required when enabled")
+	}
+	u, err := url.Parse(e.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("system.event_stream.endpoint must be an http(s) URL, got %q", e.Endpoint)
+	}
+	if !e.Mode.IsValid() {
+		return fmt.Errorf("system.event_stream.mode %q is invalid (must be %s or %s)",
+			e.Mode, EventStreamModeStructured, EventStreamModeBinary)
+	}
+	for i, name := range e.Events {
+		if !slices.Contains(StreamEvents, name) {
+			return fmt.Errorf("system.event_stream.events[%d] %q is unknown (must be one of: %s)",
+				i, name, strings.Join(StreamEvents, ", "))
+		}
+	}
+	if e.TokenEnv != "" && os.Getenv(e.TokenEnv) == "" {
+		return fmt.Errorf("system.event_stream.token_env: environment variable %s is not set", e.TokenEnv)
+	}
+	if e.Timeout <= 0 {
+		return fmt.Errorf("system.event_stream.timeout must be positive")
+	}
+	if e.MaxAttempts < 1 {
+		return fmt.Errorf("system.event_stream.max_attempts must be at least 1")
+	}
+	if e.BufferSize < 1 {
+		return fmt.Errorf("system.event_stream.buffer_size must be at least 1")
+	}
+	return nil
+}
+
+func (v *Validator) validateFeatureFlags() error {
+	for _, name := range slices.Sorted(maps.Keys(v.cfg.FeatureFlags)) {
+		if err := ValidateFeatureFlag(name, *v.cfg.FeatureFlags[name], v.cfg.ChainRegistry); err != nil {
+			return fmt.Errorf("system.feature_flags.%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateFaultInjection() error {
+	f := v.cfg.FaultInjection
+	if f == nil || !f.Enabled {
+		return nil
+	}
+	if err := ValidateFaultInjection(*f, v.cfg.MCPServerRegistry); err != nil {
+		return fmt.Errorf("system.fault_injection.%w", err)
+	}
+	return nil
+}
+
+func (v *Validator) validateProfiling() error {
+	p := v.cfg.Profiling
+	if p == nil {
+		return nil
+	}
+
+	if t := p.HeapCapture.RSSThresholdMB; t < 0 {
+		return fmt.Errorf("system.profiling.heap_capture.rss_threshold_mb must be non-negative, got %d", t)
+	}
+
+	return nil
+}
+
+func (v *Validator) validateRecurrence() error {
+	r := v.cfg.Recurrence
+	if r == nil || !r.Enabled {
+		return nil
+	}
+
+	if r.LookbackWindow <= 0 {
+		return fmt.Errorf("system.recurrence.lookback_window must be positive")
+	}
+	if r.LLMProvider != "" {
+		if _, err := v.cfg.GetLLMProvider(r.LLMProvider); err != nil {
+			return fmt.Errorf("system.recurrence.llm_provider: %w", err)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateOutcomeClassification() error {
+	o := v.cfg.OutcomeClassification
+	if o == nil || !o.Enabled || o.LLMProvider == "" {
+		return nil
+	}
+	if _, err := v.cfg.GetLLMProvider(o.LLMProvider); err != nil {
+		return fmt.Errorf("system.outcome_classification.llm_provider: %w", err)
+	}
+	return nil
+}
+
+func (v *Validator) validateOnCall() error {
+	o := v.cfg.OnCall
+	if !o.Enabled() {
+		return nil
+	}
+
+	switch o.Provider {
+	case OnCallProviderPagerDuty, OnCallProviderOpsgenie:
+	default:
+		return fmt.Errorf("system.on_call.provider: unknown provider %q (must be pagerduty or opsgenie)", o.Provider)
+	}
+	if o.APITokenEnv == "" {
+		return fmt.Errorf("system.on_call.api_token_env is required")
+	}
+	if os.Getenv(o.APITokenEnv) == "" {
+		return fmt.Errorf("system.on_call.api_token_env: environment variable %s is not set", o.APITokenEnv)
+	}
+	if len(o.Services) == 0 {
+		return fmt.Errorf("system.on_call.services: at least one service required")
+	}
+	for name, svc := range o.Services {
+		if svc == nil || strings.TrimSpace(svc.ScheduleID) == "" {
+			return fmt.Errorf("system.on_call.services.%s.schedule_id is required", name)
+		}
+	}
+	if o.DefaultService != "" {
+		if _, ok := o.Services[o.DefaultService]; !ok {
+			return fmt.Errorf("system.on_call.default_service: service %q not found in services", o.DefaultService)
+		}
+	}
+	if o.CacheTTL < 0 {
+		return fmt.Errorf("system.on_call.cache_ttl must not be negative")
+	}
+	return nil
+}
+
+func (v *Validator) validateDeepLinks() error {
+	d := v.cfg.DeepLinks
+	if !d.Enabled() {
+		return nil
+	}
+
+	if d.DefaultLookback <= 0 {
+		return fmt.Errorf("system.deep_links.default_lookback must be positive")
+	}
+	for name, tmpl := range d.Templates {
+		if !deepLinkNamePattern.MatchString(name) {
+			return fmt.Errorf("system.deep_links.templates: invalid name %q (lowercase letters, digits, '-' and '_')", name)
+		}
+		if tmpl == nil || strings.TrimSpace(tmpl.URL) == "" {
+			return fmt.Errorf("system.deep_links.templates.%s.url is required", name)
+		}
+		if !strings.HasPrefix(tmpl.URL, "http://") && !strings.HasPrefix(tmpl.URL, "https://") {
+			return fmt.Errorf("system.deep_links.templates.%s.url must be an http(s) URL", name)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateEnrichment() error {
+	e := v.cfg.Enrichment
+	if e == nil {
+		return nil
+	}
+	if err := validateEnrichmentSource("system.enrichment.ip_lookup", e.IPLookup, "{ip}"); err != nil {
+		return err
+	}
+	return validateEnrichmentSource("system.enrichment.host_lookup", e.HostLookup, "{hostname}")
+}
+
+// validateEnrichmentSource checks one lookup source. placeholder is the key
+// reference a URL source must contain.
+func validateEnrichmentSource(path string, src *EnrichmentSourceConfig, placeholder string) error {
+	if src == nil {
+		return nil
+	}
+	if (src.CSVFile == "") == (src.URL == "") {
+		return fmt.Errorf("%s: exactly one of csv_file and url is required", path)
+	}
+	if src.URL != "" {
+		if !strings.HasPrefix(src.URL, "http://") && !strings.HasPrefix(src.URL, "https://") {
+			return fmt.Errorf("%s.url must be an http(s) URL", path)
+		}
+		if !strings.Contains(src.URL, placeholder) {
+			return fmt.Errorf("%s.url must contain %s", path, placeholder)
+		}
+		if src.Timeout < 0 {
+			return fmt.Errorf("%s.timeout must not be negative", path)
+		}
+	}
+	if src.TokenEnv != "" {
+		if src.URL == "" {
+			return fmt.Errorf("%s.token_env requires url", path)
+		}
+		if os.Getenv(src.TokenEnv) == "" {
+			return fmt.Errorf("%s.token_env: environment variable %s is not set", path, src.TokenEnv)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateRecentHistory() error {
+	r := v.cfg.RecentHistory
+	if r == nil {
+		return nil
+	}
+	if r.Window <= 0 {
+		return fmt.Errorf("system.recent_history.window must be positive")
+	}
+	if r.MaxSessions <= 0 {
+		return fmt.Errorf("system.recent_history.max_sessions must be positive")
+	}
+	return nil
+}
+
+func (v *Validator) validateActionItems() error {
+	a := v.cfg.ActionItems
+	if a == nil || !a.Enabled {
+		return nil
+	}
+
+	if a.MaxItems <= 0 {
+		return fmt.Errorf("system.action_items.max_items must be positive")
+	}
+	if a.LLMProvider != "" {
+		if _, err := v.cfg.GetLLMProvider(a.LLMProvider); err != nil {
+			return fmt.Errorf("system.action_items.llm_provider: %w", err)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateRedactionReview() error {
+	r := v.cfg.RedactionReview
+	if r == nil || !r.Enabled {
+		return nil
+	}
+
+	if r.MinEntropy < 3 || r.MinEntropy > 6 {
+		return fmt.Errorf("system.redaction_review.min_entropy must be between 3 and 6, got %g", r.MinEntropy)
+	}
+	if r.MaxPerInteraction < 1 {
+		return fmt.Errorf("system.redaction_review.max_per_interaction must be at least 1, got %d", r.MaxPerInteraction)
+	}
+	for i, reviewer := range r.Reviewers {
+		if strings.TrimSpace(reviewer) == "" {
+			return fmt.Errorf("system.redaction_review.reviewers[%d] must not be empty", i)
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validateFederation() error {
+	f := v.cfg.Federation
+	if f == nil {
+		return nil
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(f.Instances)) {
+		inst := f.Instances[name]
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("system.federation.instances: instance name must not be empty")
+		}
+		field := fmt.Sprintf("system.federation.instances.%s", name)
+		if inst == nil {
+			return fmt.Errorf("%s must not be empty", field)
+		}
+		u, err := url.Parse(inst.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s.url must be an http(s) URL, got %q", field, inst.URL)
+		}
+		if inst.TokenEnv != "" && os.Getenv(inst.TokenEnv) == "" {
+			return fmt.Errorf("%s.token_env: environment variable %s is not set", field, inst.TokenEnv)
+		}
+		if inst.Timeout <= 0 {
+			return fmt.Errorf("%s.timeout must be positive", field)
+		}
+		if inst.PollInterval <= 0 || inst.PollInterval >= inst.Timeout {
+			return fmt.Errorf("%s.poll_interval must be positive and less than timeout", field)
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validateTargets() error {
+	t := v.cfg.Targets
+	if t == nil {
+		return nil
+	}
+
+	if _, ok := t.Clusters[t.Default]; t.Default != "" && !ok {
+		return fmt.Errorf("system.targets.default: cluster %q is not configured", t.Default)
+	}
+	for _, name := range slices.Sorted(maps.Keys(t.Clusters)) {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("system.targets.clusters: cluster name must not be empty")
+		}
+		field := fmt.Sprintf("system.targets.clusters.%s", name)
+		cl := t.Clusters[name]
+		if cl == nil {
+			continue // a bare name: any namespace, no region or params
+		}
+		for i, ns := range cl.Namespaces {
+			if strings.TrimSpace(ns) == "" {
+				return fmt.Errorf("%s.namespaces[%d] must not be empty", field, i)
+			}
+		}
+		for key := range cl.Params {
+			if !targetParamKeyPattern.MatchString(key) {
+				return fmt.Errorf("%s.params: invalid key %q (letters, digits, _, . and - only)", field, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validateChatLimits() error {
+	l := v.cfg.ChatLimits
+	if l == nil {
+		return nil
+	}
+
+	if l.PerUserPerMinute < 0 {
+		return fmt.Errorf("system.chat_limits.per_user_per_minute must be non-negative, got %d", l.PerUserPerMinute)
+	}
+	if l.PerSessionPerMinute < 0 {
+		return fmt.Errorf("system.chat_limits.per_session_per_minute must be non-negative, got %d", l.PerSessionPerMinute)
+	}
+	if l.MaxConcurrentExecutions < 0 {
+		return fmt.Errorf("system.chat_limits.max_concurrent_executions must be non-negative, got %d", l.MaxConcurrentExecutions)
+	}
+	if l.MaxQueuedMessages < 0 {
+		return fmt.Errorf("system.chat_limits.max_queued_messages must be non-negative, got %d", l.MaxQueuedMessages)
+	}
+
+	return nil
+}
+
+func (v *Validator) validateReports() error {
+	r := v.cfg.Reports
+	if r == nil || !r.Enabled {
+		return nil
+	}
+
+	if len(r.Periods) == 0 {
+		return fmt.Errorf("system.reports.periods must list at least one period when reports are enabled")
+	}
+	for i, p := range r.Periods {
+		if !p.IsValid() {
+			return fmt.Errorf("system.reports.periods[%d]: invalid period %q (must be daily or weekly)", i, p)
+		}
+	}
+
+	if r.Hour < 0 || r.Hour > 23 {
+		return fmt.Errorf("system.reports.hour must be between 0 and 23, got %d", r.Hour)
+	}
+
+	if r.Narrative && r.LLMProvider != "" {
+		if _, err := v.cfg.GetLLMProvider(r.LLMProvider); err != nil {
+			return fmt.Errorf("system.reports.llm_provider: %w", err)
+		}
+	}
+
+	if r.SlackChannel != "" && (v.cfg.Slack == nil || !v.cfg.Slack.Enabled) {
+		return fmt.Errorf("system.reports.slack_channel requires system.slack to be enabled")
+	}
+
+	if len(r.EmailRecipients) > 0 {
+		if v.cfg.Email == nil || !v.cfg.Email.Enabled {
+			return fmt.Errorf("system.reports.email_recipients requires system.email to be enabled")
+		}
+		for _, addr := range r.EmailRecipients {
+			if _, err := mail.ParseAddress(addr); err != nil {
+				return fmt.Errorf("system.reports.email_recipients: invalid address %q: %w", addr, err)
+			}
+		}
+	}
+
+	if r.WebhookURL != "" {
+		u, err := url.Parse(r.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("system.reports.webhook_url must be an absolute http(s) URL, got %q", r.WebhookURL)
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validateLogging() error {
+	l := v.cfg.Logging
+	if l == nil {
+		return nil
+	}
+
+	for sub, level := range l.Levels {
+		if !logging.Subsystem(sub).IsValid() {
+			return fmt.Errorf("system.logging.levels: unknown subsystem %q (must be one of %v)", sub, logging.Subsystems())
+		}
+		if _, err := logging.ParseLevel(level); err != nil {
+			return fmt.Errorf("system.logging.levels.%s: %w", sub, err)
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validateAdmins() error {
+	for i, admin := range v.cfg.Admins {
+		if strings.TrimSpace(admin) == "" {
+			return fmt.Errorf("system.admins[%d] must not be empty", i)
+		}
+	}
+	return nil
+}
+
+func (v *Validator) validateNotificationRouting() error {
+	nr := v.cfg.NotificationRouting
+	if nr == nil {
+		return nil
+	}
+
+	slackEnabled := v.cfg.Slack != nil && v.cfg.Slack.Enabled
+	pagerDutyEnabled := v.cfg.PagerDuty != nil && v.cfg.PagerDuty.Enabled
+
+	for i, rule := range nr.Rules {
+		if len(rule.Severities) == 0 {
+			return fmt.Errorf("system.notification_routing.rules[%d].severities must list at least one severity", i)
+		}
+		for _, sev := range rule.Severities {
+			if !sev.IsValid() {
+				return fmt.Errorf("system.notification_routing.rules[%d].severities: invalid severity %q (must be critical, high, medium, or low)", i, sev)
+			}
+			if rule.PagerDuty && !sev.AtLeast(SeverityHigh) {
+				return fmt.Errorf("system.notification_routing.rules[%d]: pagerduty is only allowed for high or critical severities, got %q", i, sev)
+			}
+		}
+		if rule.SlackChannel == "" && !rule.PagerDuty {
+			return fmt.Errorf("system.notification_routing.rules[%d] must set slack_channel and/or pagerduty", i)
+		}
+		if rule.SlackChannel != "" && !slackEnabled {
+			return fmt.Errorf("system.notification_routing.rules[%d].slack_channel requires system.slack to be enabled", i)
+		}
+		if rule.PagerDuty && !pagerDutyEnabled {
+			return fmt.Errorf("system.notification_routing.rules[%d].pagerduty requires system.pagerduty to be enabled", i)
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validateCostEstimation() error {
+	ce := v.cfg.CostEstimation
+	if ce == nil {
+		return nil
+	}
+
+	for name, rate := range ce.ModelRates {
+		if name == "" {
+			return fmt.Errorf("system.cost_estimation.model_rates: model name must not be empty")
+		}
+		if rate.InputPerMillion < 0 {
+			return fmt.Errorf("system.cost_estimation.model_rates.%s.input_per_million must be >= 0", name)
+		}
+		if rate.OutputPerMillion < 0 {
+			return fmt.Errorf("system.cost_estimation.model_rates.%s.output_per_million must be >= 0", name)
+		}
+	}
+
+	return nil
+}
+
+// minCompressionThreshold keeps small payloads, where zstd framing overhead
+// outweighs the savings, uncompressed.
+const minCompressionThreshold = 1024
+
+func (v *Validator) validateCompression() error {
+	c := v.cfg.Compression
+	if c == nil || !c.Enabled {
+		return nil
+	}
+
+	if c.ThresholdBytes < minCompressionThreshold {
+		return fmt.Errorf("system.compression.threshold_bytes must be at least %d, got %d",
+			minCompressionThreshold, c.ThresholdBytes)
+	}
+
+	return nil
+}
+
+func (v *Validator) validateBlobStore() error {
+	b := v.cfg.BlobStore
+	if b == nil {
+		return nil
+	}
+
+	if !b.Backend.IsValid() {
+		return fmt.Errorf("system.blob_store.backend must be one of postgres, s3, gcs, local, got %q", b.Backend)
+	}
+
+	if b.ToolResultThresholdBytes < 0 {
+		return fmt.Errorf("system.blob_store.tool_result_threshold_bytes must be non-negative, got %d", b.ToolResultThresholdBytes)
+	}
+
+	if b.DownloadURLTTL <= 0 {
+		return fmt.Errorf("system.blob_store.download_url_ttl must be positive, got %s", b.DownloadURLTTL)
+	}
+
+	return validateBlobDestination(b, "system.blob_store.")
+}
+
+// validateBlobDestination checks the prefix and the settings of the selected
+// backend of a blob store destination. field prefixes the reported field
+// names (e.g. "system.blob_store.").
+func validateBlobDestination(b *BlobStoreConfig, field string) error {
+	if strings.HasPrefix(b.Prefix, "/") || slices.Contains(strings.Split(b.Prefix, "/"), "..") {
+		return fmt.Errorf("%sprefix must be a relative path without '..', got %q", field, b.Prefix)
+	}
+
+	switch b.Backend {
+	case BlobBackendLocal:
+		if b.Local.Dir == "" {
+			return fmt.Errorf("%slocal.dir is required for the local backend", field)
+		}
+	case BlobBackendS3:
+		if b.S3.Bucket == "" {
+			return fmt.Errorf("%ss3.bucket is required for the s3 backend", field)
+		}
+		if b.S3.Region == "" {
+			return fmt.Errorf("%ss3.region is required for the s3 backend", field)
+		}
+		if b.S3.Endpoint != "" {
+			u, err := url.Parse(b.S3.Endpoint)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("%ss3.endpoint must be an absolute http(s) URL, got %q", field, b.S3.Endpoint)
+			}
+		}
+		if os.Getenv(b.S3.AccessKeyEnv) == "" {
+			return fmt.Errorf("%ss3.access_key_env: environment variable %s is not set", field, b.S3.AccessKeyEnv)
+		}
+		if os.Getenv(b.S3.SecretKeyEnv) == "" {
+			return fmt.Errorf("%ss3.secret_key_env: environment variable %s is not set", field, b.S3.SecretKeyEnv)
+		}
+	case BlobBackendGCS:
+		if b.GCS.Bucket == "" {
+			return fmt.Errorf("%sgcs.bucket is required for the gcs backend", field)
+		}
+		if os.Getenv(b.GCS.AccessIDEnv) == "" {
+			return fmt.Errorf("%sgcs.access_id_env: environment variable %s is not set", field, b.GCS.AccessIDEnv)
+		}
+		if os.Getenv(b.GCS.SecretEnv) == "" {
+			return fmt.Errorf("%sgcs.secret_env: environment variable %s is not set", field, b.GCS.SecretEnv)
+		}
+	}
+
+	return nil
+}
+
+// validateSkillNameList checks that each name exists in the skill registry and is unique within names.
+func (v *Validator) validateSkillNameList(names []string, section, resourceName, field string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	registry := v.cfg.SkillRegistry
+	if registry == nil {
+		registry = NewSkillRegistry(nil)
+	}
+	seen := make(map[string]struct{}, len(names))
+	for _, skillName := range names {
+		if _, dup := seen[skillName]; dup {
+			return NewValidationError(section, resourceName, field,
+				fmt.Errorf("duplicate skill %q", skillName))
+		}
+		seen[skillName] = struct{}{}
+		if !registry.Has(skillName) {
+			return NewValidationError(section, resourceName, field,
+				fmt.Errorf("%w: %s", ErrSkillNotFound, skillName))
 		}
 	}
 	return nil
@@ -1030,3 +2372,21 @@ func resolveEffectiveFallback(layers ...[]FallbackProviderEntry) []FallbackProvi
 	}
 	return result
 }
+
+// maxOwnerLength caps chain and agent owner names.
+const maxOwnerLength = 100
+
+// validateOwner checks a chain or agent owner. Usage is grouped by the exact
+// string, so padding or a blank name would split a team's costs.
+func validateOwner(owner string) error {
+	if owner == "" {
+		return nil
+	}
+	if strings.TrimSpace(owner) != owner {
+		return fmt.Errorf("must not be blank or have leading or trailing whitespace")
+	}
+	if len(owner) > maxOwnerLength {
+		return fmt.Errorf("must be at most %d characters", maxOwnerLength)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"bytes"
 	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
@@ -32,6 +33,19 @@ func TestValidateAgents(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:    "agent with owner is valid",
+			agents:  map[string]*AgentConfig{"test-agent": {Owner: "observability-team"}},
+			servers: map[string]*MCPServerConfig{},
+			wantErr: false,
+		},
+		{
+			name:    "agent owner with surrounding whitespace",
+			agents:  map[string]*AgentConfig{"test-agent": {Owner: " observability-team"}},
+			servers: map[string]*MCPServerConfig{},
+			wantErr: true,
+			errMsg:  "agent 'test-agent': field 'owner': must not be blank or have leading or trailing whitespace",
+		},
 		{
 			name: "agent with no MCP servers is valid",
 			agents: map[string]*AgentConfig{
@@ -246,6 +260,70 @@ func TestValidateChains(t *testing.T) {
 			providers: map[string]*LLMProviderConfig{},
 			wantErr:   false,
 		},
+		{
+			name: "chain owner too long",
+			chains: map[string]*ChainConfig{
+				"test-chain": {
+					AlertTypes: []string{"test"},
+					Owner:      strings.Repeat("x", maxOwnerLength+1),
+					Stages:     []StageConfig{{Name: "stage1", Agents: []StageAgentConfig{{Name: "test-agent"}}}},
+				},
+			},
+			agents: map[string]*AgentConfig{
+				"test-agent": {MCPServers: []string{"test"}},
+			},
+			providers: map[string]*LLMProviderConfig{},
+			wantErr:   true,
+			errMsg:    "chain 'test-chain': field 'owner': must be at most 100 characters",
+		},
+		{
+			name: "chain on-call service without system.on_call",
+			chains: map[string]*ChainConfig{
+				"test-chain": {
+					AlertTypes:    []string{"test"},
+					OnCallService: "payments",
+					Stages:        []StageConfig{{Name: "stage1", Agents: []StageAgentConfig{{Name: "test-agent"}}}},
+				},
+			},
+			agents: map[string]*AgentConfig{
+				"test-agent": {MCPServers: []string{"test"}},
+			},
+			providers: map[string]*LLMProviderConfig{},
+			wantErr:   true,
+			errMsg:    "chain 'test-chain': field 'on_call_service': requires system.on_call",
+		},
+		{
+			name: "chain with invalid executive summary",
+			chains: map[string]*ChainConfig{
+				"test-chain": {
+					AlertTypes:       []string{"test"},
+					Stages:           []StageConfig{{Name: "stage1", Agents: []StageAgentConfig{{Name: "test-agent"}}}},
+					ExecutiveSummary: &ExecutiveSummaryConfig{PromptTemplate: "Summarize this"},
+				},
+			},
+			agents: map[string]*AgentConfig{
+				"test-agent": {MCPServers: []string{"test"}},
+			},
+			providers: map[string]*LLMProviderConfig{},
+			wantErr:   true,
+			errMsg:    "chain 'test-chain': field 'executive_summary': prompt_template must contain {analysis}",
+		},
+		{
+			name: "chain with technical summary provider not found",
+			chains: map[string]*ChainConfig{
+				"test-chain": {
+					AlertTypes:       []string{"test"},
+					Stages:           []StageConfig{{Name: "stage1", Agents: []StageAgentConfig{{Name: "test-agent"}}}},
+					TechnicalSummary: &TechnicalSummaryConfig{Enabled: true, LLMProvider: "missing"},
+				},
+			},
+			agents: map[string]*AgentConfig{
+				"test-agent": {MCPServers: []string{"test"}},
+			},
+			providers: map[string]*LLMProviderConfig{},
+			wantErr:   true,
+			errMsg:    "chain 'test-chain': field 'technical_summary': LLM provider 'missing' not found",
+		},
 		{
 			name: "chain with no alert types",
 			chains: map[string]*ChainConfig{
@@ -401,6 +479,33 @@ func TestValidateMCPServers(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid target placeholders",
+			servers: map[string]*MCPServerConfig{
+				"test-server": {
+					Transport: TransportConfig{
+						Type:    TransportTypeHTTP,
+						URL:     "https://mcp.${target.region}.example.com",
+						Headers: map[string]string{"X-Cluster-API": "${target.params.api_url}"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown target placeholder",
+			servers: map[string]*MCPServerConfig{
+				"test-server": {
+					Transport: TransportConfig{
+						Type:    TransportTypeStdio,
+						Command: "kubernetes-mcp-server",
+						Args:    []string{"--context", "${target.context}"},
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "unknown placeholder ${target.context}",
+		},
 		{
 			name: "invalid transport type",
 			servers: map[string]*MCPServerConfig{
@@ -425,6 +530,20 @@ func TestValidateMCPServers(t *testing.T) {
 			wantErr: true,
 			errMsg:  "command required for stdio transport",
 		},
+		{
+			name: "stdio server negative cancel grace period",
+			servers: map[string]*MCPServerConfig{
+				"test-server": {
+					Transport: TransportConfig{
+						Type:              TransportTypeStdio,
+						Command:           "test-mcp",
+						CancelGracePeriod: -1,
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "transport.cancel_grace_period",
+		},
 		{
 			name: "http server missing url",
 			servers: map[string]*MCPServerConfig{
@@ -437,6 +556,20 @@ func TestValidateMCPServers(t *testing.T) {
 			wantErr: true,
 			errMsg:  "url required for http transport",
 		},
+		{
+			name: "negative max_concurrent_calls",
+			servers: map[string]*MCPServerConfig{
+				"test-server": {
+					Transport: TransportConfig{
+						Type:    TransportTypeStdio,
+						Command: "test",
+					},
+					MaxConcurrentCalls: -1,
+				},
+			},
+			wantErr: true,
+			errMsg:  "max_concurrent_calls",
+		},
 		{
 			name: "invalid pattern group",
 			servers: map[string]*MCPServerConfig{
@@ -611,6 +744,89 @@ func TestValidateLLMProviders(t *testing.T) {
 			wantErr: true,
 			errMsg:  "must be at least 1000",
 		},
+		{
+			name: "provider with out-of-range generation temperature",
+			providers: map[string]*LLMProviderConfig{
+				"test-provider": {
+					Type:                LLMProviderTypeGoogle,
+					Model:               "test-model",
+					MaxToolResultTokens: 100000,
+					Generation:          &GenerationParams{Temperature: floatPtr(2.5)},
+				},
+			},
+			env:     map[string]string{},
+			wantErr: true,
+			errMsg:  "temperature must be between 0 and 2",
+		},
+		{
+			name: "provider with negative context window",
+			providers: map[string]*LLMProviderConfig{
+				"test-provider": {
+					Type:                LLMProviderTypeOpenAI,
+					Model:               "test-model",
+					MaxToolResultTokens: 100000,
+					Capabilities:        &LLMCapabilities{ContextWindow: -1},
+				},
+			},
+			env:     map[string]string{},
+			wantErr: true,
+			errMsg:  "capabilities.context_window",
+		},
+		{
+			name: "max tool result tokens exceed context window",
+			providers: map[string]*LLMProviderConfig{
+				"test-provider": {
+					Type:                LLMProviderTypeOpenAI,
+					Model:               "test-model",
+					MaxToolResultTokens: 100000,
+					Capabilities:        &LLMCapabilities{ContextWindow: 32768},
+				},
+			},
+			env:     map[string]string{},
+			wantErr: true,
+			errMsg:  "100000 exceeds capabilities.context_window 32768",
+		},
+		{
+			name: "invalid tokenizer type",
+			providers: map[string]*LLMProviderConfig{
+				"test-provider": {
+					Type:                LLMProviderTypeOpenAI,
+					Model:               "test-model",
+					MaxToolResultTokens: 100000,
+					Tokenizer:           &TokenizerConfig{Type: "wordpiece"},
+				},
+			},
+			env:     map[string]string{},
+			wantErr: true,
+			errMsg:  `invalid tokenizer type: "wordpiece"`,
+		},
+		{
+			name: "tokenizer without vocabulary file",
+			providers: map[string]*LLMProviderConfig{
+				"test-provider": {
+					Type:                LLMProviderTypeOpenAI,
+					Model:               "test-model",
+					MaxToolResultTokens: 100000,
+					Tokenizer:           &TokenizerConfig{Type: TokenizerTypeTiktoken},
+				},
+			},
+			env:     map[string]string{},
+			wantErr: true,
+			errMsg:  "file is required for tiktoken tokenizers",
+		},
+		{
+			name: "heuristic tokenizer needs no file",
+			providers: map[string]*LLMProviderConfig{
+				"test-provider": {
+					Type:                LLMProviderTypeOpenAI,
+					Model:               "test-model",
+					MaxToolResultTokens: 100000,
+					Tokenizer:           &TokenizerConfig{Type: TokenizerTypeHeuristic},
+				},
+			},
+			env:     map[string]string{},
+			wantErr: false,
+		},
 		{
 			name: "VertexAI provider with both environment variables set",
 			providers: map[string]*LLMProviderConfig{
@@ -1313,6 +1529,44 @@ func TestValidateStageComprehensive(t *testing.T) {
 			wantErr: true,
 			errMsg:  "max_iterations must be at least 1",
 		},
+		{
+			name: "stage with invalid stage-level max tool calls",
+			stage: StageConfig{
+				Name:         "stage1",
+				Agents:       []StageAgentConfig{{Name: "test-agent"}},
+				MaxToolCalls: &maxIter0,
+			},
+			agents: map[string]*AgentConfig{
+				"test-agent": {MCPServers: []string{"test-server"}},
+			},
+			providers: map[string]*LLMProviderConfig{},
+			servers: map[string]*MCPServerConfig{
+				"test-server": {Transport: TransportConfig{Type: TransportTypeStdio, Command: "test"}},
+			},
+			wantErr: true,
+			errMsg:  "max_tool_calls must be at least 1",
+		},
+		{
+			name: "stage with agent-level invalid max tool calls",
+			stage: StageConfig{
+				Name: "stage1",
+				Agents: []StageAgentConfig{
+					{
+						Name:         "test-agent",
+						MaxToolCalls: &maxIter0,
+					},
+				},
+			},
+			agents: map[string]*AgentConfig{
+				"test-agent": {MCPServers: []string{"test-server"}},
+			},
+			providers: map[string]*LLMProviderConfig{},
+			servers: map[string]*MCPServerConfig{
+				"test-server": {Transport: TransportConfig{Type: TransportTypeStdio, Command: "test"}},
+			},
+			wantErr: true,
+			errMsg:  "max_tool_calls must be at least 1",
+		},
 		{
 			name: "stage with synthesis agent not found",
 			stage: StageConfig{
@@ -1660,6 +1914,34 @@ func TestValidateChainsEdgeCases(t *testing.T) {
 			wantErr: true,
 			errMsg:  "agent 'nonexistent-chat-agent' not found",
 		},
+		{
+			name: "chain with invalid chat guardrails",
+			chains: map[string]*ChainConfig{
+				"test-chain": {
+					AlertTypes: []string{"test"},
+					Chat: &ChatConfig{
+						Enabled:    true,
+						Agent:      "test-agent",
+						Guardrails: []string{"Never run kubectl delete", ""},
+					},
+					Stages: []StageConfig{
+						{
+							Name:   "stage1",
+							Agents: []StageAgentConfig{{Name: "test-agent"}},
+						},
+					},
+				},
+			},
+			agents: map[string]*AgentConfig{
+				"test-agent": {MCPServers: []string{"test-server"}},
+			},
+			providers: map[string]*LLMProviderConfig{},
+			servers: map[string]*MCPServerConfig{
+				"test-server": {Transport: TransportConfig{Type: TransportTypeStdio, Command: "test"}},
+			},
+			wantErr: true,
+			errMsg:  "rule 1 must not be blank",
+		},
 		{
 			name: "valid chain with all optional fields",
 			chains: map[string]*ChainConfig{
@@ -2121,6 +2403,41 @@ func TestValidateDefaults(t *testing.T) {
 	}
 }
 
+func TestValidateDefaults_FallbackChains(t *testing.T) {
+	tests := []struct {
+		name    string
+		fc      *FallbackChainsConfig
+		wantErr string
+	}{
+		{name: "known chains pass", fc: &FallbackChainsConfig{
+			Default:    "generic",
+			Namespaces: map[string]string{"payments": "generic"},
+		}},
+		{name: "unknown default chain fails", fc: &FallbackChainsConfig{Default: "missing"},
+			wantErr: "fallback_chains.default"},
+		{name: "unknown namespace chain fails", fc: &FallbackChainsConfig{Namespaces: map[string]string{"payments": "missing"}},
+			wantErr: "fallback_chains.namespaces.payments"},
+		{name: "empty namespace fails", fc: &FallbackChainsConfig{Namespaces: map[string]string{"": "generic"}},
+			wantErr: "namespace must not be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Defaults:      &Defaults{FallbackChains: tt.fc},
+				ChainRegistry: NewChainRegistry(map[string]*ChainConfig{"generic": {}}),
+			}
+			err := NewValidator(cfg).validateDefaults()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateDefaultsScoring(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -2429,6 +2746,30 @@ func TestValidateSlack(t *testing.T) {
 			wantErr: true,
 			errMsg:  "environment variable MISSING_SLACK_TOKEN is not set",
 		},
+		{
+			name: "negative rate limit fails",
+			slack: &SlackConfig{
+				Enabled:            true,
+				TokenEnv:           "TEST_SLACK_TOKEN",
+				Channel:            "C12345678",
+				RateLimitPerMinute: -1,
+			},
+			env:     map[string]string{"TEST_SLACK_TOKEN": "xoxb-test"},
+			wantErr: true,
+			errMsg:  "system.slack.rate_limit_per_minute must be non-negative",
+		},
+		{
+			name: "negative dedup window fails",
+			slack: &SlackConfig{
+				Enabled:     true,
+				TokenEnv:    "TEST_SLACK_TOKEN",
+				Channel:     "C12345678",
+				DedupWindow: -time.Second,
+			},
+			env:     map[string]string{"TEST_SLACK_TOKEN": "xoxb-test"},
+			wantErr: true,
+			errMsg:  "system.slack.dedup_window must be non-negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -2451,20 +2792,68 @@ func TestValidateSlack(t *testing.T) {
 	}
 }
 
-func TestValidateSlack_IntegrationWithValidateAll(t *testing.T) {
-	cfg := &Config{
-		Queue:               DefaultQueueConfig(),
-		AgentRegistry:       NewAgentRegistry(map[string]*AgentConfig{}),
-		MCPServerRegistry:   NewMCPServerRegistry(map[string]*MCPServerConfig{}),
-		LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{}),
-		ChainRegistry:       NewChainRegistry(map[string]*ChainConfig{}),
-		Slack: &SlackConfig{
-			Enabled:  true,
-			TokenEnv: "SLACK_BOT_TOKEN",
-			Channel:  "",
-		},
-	}
-
+func TestValidateSlackTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *SlackTemplateConfig
+		errMsg   string
+	}{
+		{
+			name: "valid fields and buttons",
+			template: &SlackTemplateConfig{
+				Fields:  []SlackTemplateField{{Label: "Alert", Value: "{alert_type} ({severity})"}},
+				Buttons: []SlackTemplateButton{{Text: "Runbook", URL: "https://wiki.example.com/{chain_id}"}},
+			},
+		},
+		{
+			name:     "field without value",
+			template: &SlackTemplateConfig{Fields: []SlackTemplateField{{Label: "Alert"}}},
+			errMsg:   "fields[0]: label and value are required",
+		},
+		{
+			name:     "button without url",
+			template: &SlackTemplateConfig{Buttons: []SlackTemplateButton{{Text: "Open"}}},
+			errMsg:   "buttons[0]: text and url are required",
+		},
+		{
+			name:     "unknown placeholder",
+			template: &SlackTemplateConfig{Fields: []SlackTemplateField{{Label: "Owner", Value: "{owner}"}}},
+			errMsg:   "fields[0].value: unknown placeholder {owner}",
+		},
+		{
+			name:     "too many buttons",
+			template: &SlackTemplateConfig{Buttons: make([]SlackTemplateButton, MaxSlackTemplateButtons+1)},
+			errMsg:   "at most 5 buttons allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSlackTemplate(tt.template)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateSlack_IntegrationWithValidateAll(t *testing.T) {
+	cfg := &Config{
+		Queue:               DefaultQueueConfig(),
+		AgentRegistry:       NewAgentRegistry(map[string]*AgentConfig{}),
+		MCPServerRegistry:   NewMCPServerRegistry(map[string]*MCPServerConfig{}),
+		LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{}),
+		ChainRegistry:       NewChainRegistry(map[string]*ChainConfig{}),
+		Slack: &SlackConfig{
+			Enabled:  true,
+			TokenEnv: "SLACK_BOT_TOKEN",
+			Channel:  "",
+		},
+	}
+
 	validator := NewValidator(cfg)
 	err := validator.ValidateAll()
 
@@ -2473,73 +2862,322 @@ func TestValidateSlack_IntegrationWithValidateAll(t *testing.T) {
 	assert.Contains(t, err.Error(), "system.slack.channel is required")
 }
 
-func TestValidateCostEstimation(t *testing.T) {
+func TestValidateEmail(t *testing.T) {
+	valid := func() *EmailConfig {
+		return &EmailConfig{
+			Enabled:     true,
+			SMTPHost:    "smtp.example.com",
+			SMTPPort:    587,
+			PasswordEnv: "TEST_SMTP_PASSWORD",
+			From:        "TARSy <tarsy@example.com>",
+			StartTLS:    true,
+			DigestHour:  8,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*EmailConfig)
+		env    map[string]string
+		errMsg string
+	}{
+		{name: "valid without auth", mutate: func(*EmailConfig) {}},
+		{
+			name:   "valid with auth",
+			mutate: func(e *EmailConfig) { e.Username = "tarsy" },
+			env:    map[string]string{"TEST_SMTP_PASSWORD": "secret"},
+		},
+		{name: "missing host", mutate: func(e *EmailConfig) { e.SMTPHost = "" }, errMsg: "system.email.smtp_host is required"},
+		{name: "bad port", mutate: func(e *EmailConfig) { e.SMTPPort = 70000 }, errMsg: "system.email.smtp_port must be between 1 and 65535"},
+		{name: "missing from", mutate: func(e *EmailConfig) { e.From = "" }, errMsg: "system.email.from is required"},
+		{name: "invalid from", mutate: func(e *EmailConfig) { e.From = "not-an-address" }, errMsg: "system.email.from: invalid address"},
+		{name: "bad digest hour", mutate: func(e *EmailConfig) { e.DigestHour = 24 }, errMsg: "system.email.digest_hour must be between 0 and 23"},
+		{
+			name:   "auth without password env var",
+			mutate: func(e *EmailConfig) { e.Username = "tarsy"; e.PasswordEnv = "MISSING_SMTP_PASSWORD" },
+			errMsg: "environment variable MISSING_SMTP_PASSWORD is not set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			cfg := valid()
+			tt.mutate(cfg)
+
+			err := NewValidator(&Config{Email: cfg}).validateEmail()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+
+	t.Run("disabled email passes", func(t *testing.T) {
+		assert.NoError(t, NewValidator(&Config{Email: &EmailConfig{}}).validateEmail())
+	})
+}
+
+func TestValidateChainEmail(t *testing.T) {
+	enabled := &EmailConfig{Enabled: true}
+
+	tests := []struct {
+		name   string
+		email  *EmailConfig
+		chain  *ChainEmailConfig
+		errMsg string
+	}{
+		{
+			name:  "valid immediate",
+			email: enabled,
+			chain: &ChainEmailConfig{Recipients: []string{"oncall@example.com"}},
+		},
+		{
+			name:  "valid daily digest",
+			email: enabled,
+			chain: &ChainEmailConfig{Recipients: []string{"a@example.com", "Team B <b@example.com>"}, Schedule: EmailScheduleDaily},
+		},
+		{
+			name:   "email disabled",
+			email:  &EmailConfig{},
+			chain:  &ChainEmailConfig{Recipients: []string{"oncall@example.com"}},
+			errMsg: "requires system.email to be enabled",
+		},
+		{
+			name:   "no recipients",
+			email:  enabled,
+			chain:  &ChainEmailConfig{},
+			errMsg: "at least one recipient required",
+		},
+		{
+			name:   "invalid recipient",
+			email:  enabled,
+			chain:  &ChainEmailConfig{Recipients: []string{"oncall"}},
+			errMsg: `invalid recipient "oncall"`,
+		},
+		{
+			name:   "invalid schedule",
+			email:  enabled,
+			chain:  &ChainEmailConfig{Recipients: []string{"oncall@example.com"}, Schedule: "weekly"},
+			errMsg: `invalid schedule "weekly"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{Email: tt.email}).validateChainEmail(tt.chain)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateMilestoneNotifications(t *testing.T) {
+	slackOn := &SlackConfig{Enabled: true}
+
+	tests := []struct {
+		name   string
+		slack  *SlackConfig
+		cfg    *MilestoneNotificationConfig
+		errMsg string
+	}{
+		{
+			name: "valid webhook",
+			cfg:  &MilestoneNotificationConfig{Events: []MilestoneEvent{MilestoneFirstToolCall, MilestoneFinalAnalysis}, WebhookURL: "https://hooks.example.com/tarsy"},
+		},
+		{
+			name:  "valid slack",
+			slack: slackOn,
+			cfg:   &MilestoneNotificationConfig{Events: []MilestoneEvent{MilestoneStageCompleted}, Slack: true},
+		},
+		{
+			name:   "no events",
+			cfg:    &MilestoneNotificationConfig{WebhookURL: "https://hooks.example.com"},
+			errMsg: "at least one event required",
+		},
+		{
+			name:   "unknown event",
+			cfg:    &MilestoneNotificationConfig{Events: []MilestoneEvent{"session_started"}, WebhookURL: "https://hooks.example.com"},
+			errMsg: `invalid event "session_started"`,
+		},
+		{
+			name:   "no target",
+			cfg:    &MilestoneNotificationConfig{Events: []MilestoneEvent{MilestoneFinalAnalysis}},
+			errMsg: "slack or webhook_url must be set",
+		},
+		{
+			name:   "slack disabled",
+			slack:  &SlackConfig{},
+			cfg:    &MilestoneNotificationConfig{Events: []MilestoneEvent{MilestoneFinalAnalysis}, Slack: true},
+			errMsg: "requires system.slack to be enabled",
+		},
+		{
+			name:   "relative webhook",
+			cfg:    &MilestoneNotificationConfig{Events: []MilestoneEvent{MilestoneFinalAnalysis}, WebhookURL: "/hooks"},
+			errMsg: "webhook_url must be an absolute http(s) URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{Slack: tt.slack}).validateMilestoneNotifications(tt.cfg)
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+
+	var nilCfg *MilestoneNotificationConfig
+	assert.False(t, nilCfg.Wants(MilestoneFinalAnalysis))
+}
+
+func TestValidateReports(t *testing.T) {
+	valid := func() *ReportsConfig {
+		return &ReportsConfig{
+			Enabled:   true,
+			Periods:   []ReportPeriod{ReportPeriodDaily},
+			Hour:      8,
+			Narrative: true,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*ReportsConfig)
+		slack  *SlackConfig
+		email  *EmailConfig
+		errMsg string
+	}{
+		{name: "valid API-only", mutate: func(*ReportsConfig) {}},
+		{
+			name: "valid with all deliveries",
+			mutate: func(r *ReportsConfig) {
+				r.Periods = []ReportPeriod{ReportPeriodDaily, ReportPeriodWeekly}
+				r.LLMProvider = "test-provider"
+				r.SlackChannel = "C-reports"
+				r.EmailRecipients = []string{"sre@example.com"}
+				r.WebhookURL = "https://hooks.example.com/tarsy"
+			},
+			slack: &SlackConfig{Enabled: true},
+			email: &EmailConfig{Enabled: true},
+		},
+		{name: "no periods", mutate: func(r *ReportsConfig) { r.Periods = nil }, errMsg: "system.reports.periods must list at least one period"},
+		{name: "invalid period", mutate: func(r *ReportsConfig) { r.Periods = []ReportPeriod{"monthly"} }, errMsg: `invalid period "monthly"`},
+		{name: "bad hour", mutate: func(r *ReportsConfig) { r.Hour = 24 }, errMsg: "system.reports.hour must be between 0 and 23"},
+		{name: "unknown llm provider", mutate: func(r *ReportsConfig) { r.LLMProvider = "missing" }, errMsg: "system.reports.llm_provider"},
+		{
+			name:   "unknown llm provider ignored without narrative",
+			mutate: func(r *ReportsConfig) { r.Narrative = false; r.LLMProvider = "missing" },
+		},
+		{
+			name:   "slack channel without slack",
+			mutate: func(r *ReportsConfig) { r.SlackChannel = "C-reports" },
+			errMsg: "system.reports.slack_channel requires system.slack to be enabled",
+		},
+		{
+			name:   "email recipients without email",
+			mutate: func(r *ReportsConfig) { r.EmailRecipients = []string{"sre@example.com"} },
+			errMsg: "system.reports.email_recipients requires system.email to be enabled",
+		},
+		{
+			name:   "invalid email recipient",
+			mutate: func(r *ReportsConfig) { r.EmailRecipients = []string{"sre"} },
+			email:  &EmailConfig{Enabled: true},
+			errMsg: `system.reports.email_recipients: invalid address "sre"`,
+		},
+		{
+			name:   "relative webhook url",
+			mutate: func(r *ReportsConfig) { r.WebhookURL = "/hooks/tarsy" },
+			errMsg: "system.reports.webhook_url must be an absolute http(s) URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.mutate(r)
+			cfg := &Config{
+				Reports: r,
+				Slack:   tt.slack,
+				Email:   tt.email,
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+					"test-provider": {Type: LLMProviderTypeGoogle, Model: "test-model"},
+				}),
+			}
+
+			err := NewValidator(cfg).validateReports()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+
+	t.Run("disabled reports pass", func(t *testing.T) {
+		assert.NoError(t, NewValidator(&Config{Reports: &ReportsConfig{}}).validateReports())
+	})
+}
+
+func TestValidatePagerDuty(t *testing.T) {
 	tests := []struct {
 		name    string
-		ce      *CostEstimationConfig
+		pd      *PagerDutyConfig
+		env     map[string]string
 		wantErr bool
 		errMsg  string
 	}{
 		{
-			name:    "nil passes",
-			ce:      nil,
+			name:    "nil pagerduty config passes",
+			pd:      nil,
 			wantErr: false,
 		},
 		{
-			name: "valid rates pass",
-			ce: &CostEstimationConfig{
-				Enabled: true,
-				ModelRates: map[string]ModelRateConfig{
-					"gemini-3.1-pro-preview": {InputPerMillion: 2.0, OutputPerMillion: 12.0},
-				},
-			},
+			name:    "disabled pagerduty passes",
+			pd:      &PagerDutyConfig{Enabled: false},
 			wantErr: false,
 		},
 		{
-			name: "zero rates pass",
-			ce: &CostEstimationConfig{
-				ModelRates: map[string]ModelRateConfig{
-					"free-model": {InputPerMillion: 0, OutputPerMillion: 0},
-				},
-			},
+			name:    "enabled with routing key passes",
+			pd:      &PagerDutyConfig{Enabled: true, RoutingKeyEnv: "TEST_PD_KEY"},
+			env:     map[string]string{"TEST_PD_KEY": "abc123"},
 			wantErr: false,
 		},
 		{
-			name: "empty model name fails",
-			ce: &CostEstimationConfig{
-				ModelRates: map[string]ModelRateConfig{
-					"": {InputPerMillion: 1.0, OutputPerMillion: 1.0},
-				},
-			},
-			wantErr: true,
-			errMsg:  "model name must not be empty",
-		},
-		{
-			name: "negative input rate fails",
-			ce: &CostEstimationConfig{
-				ModelRates: map[string]ModelRateConfig{
-					"bad": {InputPerMillion: -1.0, OutputPerMillion: 1.0},
-				},
-			},
+			name:    "enabled with empty routing_key_env fails",
+			pd:      &PagerDutyConfig{Enabled: true},
 			wantErr: true,
-			errMsg:  "input_per_million must be >= 0",
+			errMsg:  "system.pagerduty.routing_key_env is required when PagerDuty is enabled",
 		},
 		{
-			name: "negative output rate fails",
-			ce: &CostEstimationConfig{
-				ModelRates: map[string]ModelRateConfig{
-					"bad": {InputPerMillion: 1.0, OutputPerMillion: -0.5},
-				},
-			},
+			name:    "enabled with missing env var fails",
+			pd:      &PagerDutyConfig{Enabled: true, RoutingKeyEnv: "MISSING_PD_KEY"},
 			wantErr: true,
-			errMsg:  "output_per_million must be >= 0",
+			errMsg:  "environment variable MISSING_PD_KEY is not set",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cfg := &Config{CostEstimation: tt.ce}
-			err := NewValidator(cfg).validateCostEstimation()
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			validator := NewValidator(&Config{PagerDuty: tt.pd})
+			err := validator.validatePagerDuty()
+
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errMsg)
@@ -2550,54 +3188,101 @@ func TestValidateCostEstimation(t *testing.T) {
 	}
 }
 
-func TestValidateOrchestratorDefaults(t *testing.T) {
+func TestValidateNotificationRouting(t *testing.T) {
+	slackOn := &SlackConfig{Enabled: true, TokenEnv: "SLACK_BOT_TOKEN", Channel: "C-default"}
+	pagerDutyOn := &PagerDutyConfig{Enabled: true, RoutingKeyEnv: "PAGERDUTY_ROUTING_KEY"}
+
 	tests := []struct {
 		name    string
-		orch    *OrchestratorConfig
+		cfg     *Config
 		wantErr bool
 		errMsg  string
 	}{
 		{
-			name:    "nil orchestrator defaults is valid",
-			orch:    nil,
+			name:    "nil routing passes",
+			cfg:     &Config{},
 			wantErr: false,
 		},
 		{
-			name:    "valid orchestrator defaults",
-			orch:    &OrchestratorConfig{MaxConcurrentAgents: intPtr(5), AgentTimeout: durPtr(300 * time.Second)},
+			name: "valid rules pass",
+			cfg: &Config{
+				Slack:     slackOn,
+				PagerDuty: pagerDutyOn,
+				NotificationRouting: &NotificationRoutingConfig{Rules: []NotificationRouteRule{
+					{Severities: []Severity{SeverityCritical, SeverityHigh}, SlackChannel: "C-oncall", PagerDuty: true},
+					{Severities: []Severity{SeverityMedium}, SlackChannel: "C-alerts"},
+				}},
+			},
 			wantErr: false,
 		},
 		{
-			name:    "zero max_concurrent_agents",
-			orch:    &OrchestratorConfig{MaxConcurrentAgents: intPtr(0)},
+			name: "empty severities fails",
+			cfg: &Config{
+				Slack: slackOn,
+				NotificationRouting: &NotificationRoutingConfig{Rules: []NotificationRouteRule{
+					{SlackChannel: "C-alerts"},
+				}},
+			},
 			wantErr: true,
-			errMsg:  "must be at least 1",
+			errMsg:  "rules[0].severities must list at least one severity",
 		},
 		{
-			name:    "negative agent_timeout",
-			orch:    &OrchestratorConfig{AgentTimeout: durPtr(-5 * time.Second)},
+			name: "unknown severity fails",
+			cfg: &Config{
+				Slack: slackOn,
+				NotificationRouting: &NotificationRoutingConfig{Rules: []NotificationRouteRule{
+					{Severities: []Severity{"urgent"}, SlackChannel: "C-alerts"},
+				}},
+			},
 			wantErr: true,
-			errMsg:  "must be positive",
+			errMsg:  `invalid severity "urgent"`,
 		},
 		{
-			name:    "zero max_budget",
-			orch:    &OrchestratorConfig{MaxBudget: durPtr(0)},
+			name: "pagerduty for medium severity fails",
+			cfg: &Config{
+				PagerDuty: pagerDutyOn,
+				NotificationRouting: &NotificationRoutingConfig{Rules: []NotificationRouteRule{
+					{Severities: []Severity{SeverityHigh, SeverityMedium}, PagerDuty: true},
+				}},
+			},
 			wantErr: true,
-			errMsg:  "must be positive",
+			errMsg:  "pagerduty is only allowed for high or critical severities",
+		},
+		{
+			name: "rule without targets fails",
+			cfg: &Config{
+				NotificationRouting: &NotificationRoutingConfig{Rules: []NotificationRouteRule{
+					{Severities: []Severity{SeverityLow}},
+				}},
+			},
+			wantErr: true,
+			errMsg:  "must set slack_channel and/or pagerduty",
+		},
+		{
+			name: "slack channel with slack disabled fails",
+			cfg: &Config{
+				NotificationRouting: &NotificationRoutingConfig{Rules: []NotificationRouteRule{
+					{Severities: []Severity{SeverityLow}, SlackChannel: "C-low"},
+				}},
+			},
+			wantErr: true,
+			errMsg:  "slack_channel requires system.slack to be enabled",
+		},
+		{
+			name: "pagerduty with pagerduty disabled fails",
+			cfg: &Config{
+				NotificationRouting: &NotificationRoutingConfig{Rules: []NotificationRouteRule{
+					{Severities: []Severity{SeverityCritical}, PagerDuty: true},
+				}},
+			},
+			wantErr: true,
+			errMsg:  "pagerduty requires system.pagerduty to be enabled",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cfg := &Config{
-				Defaults:            &Defaults{Orchestrator: tt.orch},
-				AgentRegistry:       NewAgentRegistry(map[string]*AgentConfig{}),
-				MCPServerRegistry:   NewMCPServerRegistry(map[string]*MCPServerConfig{}),
-				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{}),
-			}
-
-			validator := NewValidator(cfg)
-			err := validator.validateDefaults()
+			err := NewValidator(tt.cfg).validateNotificationRouting()
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -2609,9 +3294,195 @@ func TestValidateOrchestratorDefaults(t *testing.T) {
 	}
 }
 
-func TestValidateSubAgents(t *testing.T) {
-	baseAgents := map[string]*AgentConfig{
-		"LogAnalyzer":    {Description: "Analyzes logs"},
+func TestValidateLogging(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *LoggingConfig
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "nil passes",
+			cfg:     nil,
+			wantErr: false,
+		},
+		{
+			name:    "valid levels pass",
+			cfg:     &LoggingConfig{Levels: map[string]string{"queue": "debug", "mcp": "WARN", "events": "error", "agent": "info"}},
+			wantErr: false,
+		},
+		{
+			name:    "unknown subsystem fails",
+			cfg:     &LoggingConfig{Levels: map[string]string{"api": "debug"}},
+			wantErr: true,
+			errMsg:  `unknown subsystem "api"`,
+		},
+		{
+			name:    "invalid level fails",
+			cfg:     &LoggingConfig{Levels: map[string]string{"mcp": "trace"}},
+			wantErr: true,
+			errMsg:  "system.logging.levels.mcp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{Logging: tt.cfg}).validateLogging()
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCostEstimation(t *testing.T) {
+	tests := []struct {
+		name    string
+		ce      *CostEstimationConfig
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "nil passes",
+			ce:      nil,
+			wantErr: false,
+		},
+		{
+			name: "valid rates pass",
+			ce: &CostEstimationConfig{
+				Enabled: true,
+				ModelRates: map[string]ModelRateConfig{
+					"gemini-3.1-pro-preview": {InputPerMillion: 2.0, OutputPerMillion: 12.0},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "zero rates pass",
+			ce: &CostEstimationConfig{
+				ModelRates: map[string]ModelRateConfig{
+					"free-model": {InputPerMillion: 0, OutputPerMillion: 0},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty model name fails",
+			ce: &CostEstimationConfig{
+				ModelRates: map[string]ModelRateConfig{
+					"": {InputPerMillion: 1.0, OutputPerMillion: 1.0},
+				},
+			},
+			wantErr: true,
+			errMsg:  "model name must not be empty",
+		},
+		{
+			name: "negative input rate fails",
+			ce: &CostEstimationConfig{
+				ModelRates: map[string]ModelRateConfig{
+					"bad": {InputPerMillion: -1.0, OutputPerMillion: 1.0},
+				},
+			},
+			wantErr: true,
+			errMsg:  "input_per_million must be >= 0",
+		},
+		{
+			name: "negative output rate fails",
+			ce: &CostEstimationConfig{
+				ModelRates: map[string]ModelRateConfig{
+					"bad": {InputPerMillion: 1.0, OutputPerMillion: -0.5},
+				},
+			},
+			wantErr: true,
+			errMsg:  "output_per_million must be >= 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{CostEstimation: tt.ce}
+			err := NewValidator(cfg).validateCostEstimation()
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateOrchestratorDefaults(t *testing.T) {
+	tests := []struct {
+		name    string
+		orch    *OrchestratorConfig
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "nil orchestrator defaults is valid",
+			orch:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "valid orchestrator defaults",
+			orch:    &OrchestratorConfig{MaxConcurrentAgents: intPtr(5), AgentTimeout: durPtr(300 * time.Second)},
+			wantErr: false,
+		},
+		{
+			name:    "zero max_concurrent_agents",
+			orch:    &OrchestratorConfig{MaxConcurrentAgents: intPtr(0)},
+			wantErr: true,
+			errMsg:  "must be at least 1",
+		},
+		{
+			name:    "negative agent_timeout",
+			orch:    &OrchestratorConfig{AgentTimeout: durPtr(-5 * time.Second)},
+			wantErr: true,
+			errMsg:  "must be positive",
+		},
+		{
+			name:    "zero max_budget",
+			orch:    &OrchestratorConfig{MaxBudget: durPtr(0)},
+			wantErr: true,
+			errMsg:  "must be positive",
+		},
+		{
+			name:    "zero max_tokens",
+			orch:    &OrchestratorConfig{MaxTokens: intPtr(0)},
+			wantErr: true,
+			errMsg:  "must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Defaults:            &Defaults{Orchestrator: tt.orch},
+				AgentRegistry:       NewAgentRegistry(map[string]*AgentConfig{}),
+				MCPServerRegistry:   NewMCPServerRegistry(map[string]*MCPServerConfig{}),
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{}),
+			}
+
+			validator := NewValidator(cfg)
+			err := validator.validateDefaults()
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSubAgents(t *testing.T) {
+	baseAgents := map[string]*AgentConfig{
+		"LogAnalyzer":    {Description: "Analyzes logs"},
 		"MetricChecker":  {Description: "Checks metrics"},
 		"MyOrchestrator": {Description: "Orchestrator agent"},
 	}
@@ -3421,6 +4292,57 @@ func TestCollectReferencedLLMProviders_IncludesFallbackAndSubAgents(t *testing.T
 	assert.True(t, referenced["agent-subagent"], "agent sub-agent provider should be referenced")
 }
 
+func TestMissingOptionalEnv(t *testing.T) {
+	t.Setenv("TEST_USED_KEY", "")
+	t.Setenv("TEST_SPARE_KEY", "")
+	t.Setenv("TEST_EMBEDDING_KEY", "")
+	t.Setenv("TEST_TRANSCRIPTION_KEY", "")
+
+	newCfg := func() *Config {
+		return &Config{
+			Defaults: &Defaults{
+				LLMProvider: "used",
+				Memory: &MemoryConfig{
+					Enabled:   true,
+					Embedding: EmbeddingConfig{APIKeyEnv: "TEST_EMBEDDING_KEY"},
+				},
+			},
+			Transcription: &TranscriptionConfig{Enabled: true, APIKeyEnv: "TEST_TRANSCRIPTION_KEY"},
+			LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+				"used":              {Type: LLMProviderTypeGoogle, Model: "m", APIKeyEnv: "TEST_USED_KEY"},
+				"spare":             {Type: LLMProviderTypeGoogle, Model: "m", APIKeyEnv: "TEST_SPARE_KEY"},
+				"openai-default":    {Type: LLMProviderTypeOpenAI, Model: "m", APIKeyEnv: "TEST_SPARE_KEY"},
+				"spare-with-no-env": {Type: LLMProviderTypeGoogle, Model: "m"},
+			}),
+			ChainRegistry: NewChainRegistry(map[string]*ChainConfig{}),
+		}
+	}
+
+	t.Run("reports unreferenced providers, embedding and transcription keys", func(t *testing.T) {
+		missing := NewValidator(newCfg()).MissingOptionalEnv()
+		assert.Equal(t, []string{
+			"llm_provider 'spare': environment variable TEST_SPARE_KEY is not set",
+			"defaults.memory.embedding.api_key_env: environment variable TEST_EMBEDDING_KEY is not set",
+			"system.transcription.api_key_env: environment variable TEST_TRANSCRIPTION_KEY is not set",
+		}, missing)
+	})
+
+	t.Run("nothing missing when credentials are set", func(t *testing.T) {
+		t.Setenv("TEST_SPARE_KEY", "k")
+		t.Setenv("TEST_EMBEDDING_KEY", "k")
+		t.Setenv("TEST_TRANSCRIPTION_KEY", "k")
+		assert.Empty(t, NewValidator(newCfg()).MissingOptionalEnv())
+	})
+
+	t.Run("disabled features are not checked", func(t *testing.T) {
+		cfg := newCfg()
+		cfg.Defaults.Memory.Enabled = false
+		cfg.Transcription.Enabled = false
+		assert.Equal(t, []string{"llm_provider 'spare': environment variable TEST_SPARE_KEY is not set"},
+			NewValidator(cfg).MissingOptionalEnv())
+	})
+}
+
 func TestValidateSkills(t *testing.T) {
 	baseSkills := map[string]*SkillConfig{
 		"k8s-basics":   {Name: "k8s-basics", Description: "Kubernetes basics"},
@@ -3682,6 +4604,10 @@ func TestValidateSkills_IntegrationWithValidateAll(t *testing.T) {
 	})
 }
 
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
 func intPtr(i int) *int {
 	return &i
 }
@@ -3990,3 +4916,1079 @@ func TestWarnNativeToolAgentsWithoutCompatibleFallback(t *testing.T) {
 		assert.Contains(t, buf.String(), "CodeExecutor")
 	})
 }
+
+func TestValidateDegradation(t *testing.T) {
+	valid := func() *DegradationConfig {
+		d := DefaultDegradationConfig()
+		d.Enabled = true
+		return d
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*DegradationConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*DegradationConfig) {}},
+		{name: "valid with provider", mutate: func(d *DegradationConfig) { d.LLMProvider = "test-provider" }},
+		{name: "disabled skips checks", mutate: func(d *DegradationConfig) { d.Enabled = false; d.FailureThreshold = 0 }},
+		{name: "zero threshold", mutate: func(d *DegradationConfig) { d.FailureThreshold = 0 }, errMsg: "system.degradation.failure_threshold"},
+		{name: "zero cooldown", mutate: func(d *DegradationConfig) { d.Cooldown = 0 }, errMsg: "system.degradation.cooldown"},
+		{name: "zero max iterations", mutate: func(d *DegradationConfig) { d.MaxIterations = 0 }, errMsg: "system.degradation.max_iterations"},
+		{name: "unknown provider", mutate: func(d *DegradationConfig) { d.LLMProvider = "missing" }, errMsg: "system.degradation.llm_provider"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := valid()
+			tt.mutate(d)
+			cfg := &Config{
+				Degradation: d,
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+					"test-provider": {Type: LLMProviderTypeGoogle, Model: "test-model"},
+				}),
+			}
+
+			err := NewValidator(cfg).validateDegradation()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateNoiseTriage(t *testing.T) {
+	valid := func() *NoiseTriageConfig {
+		n := DefaultNoiseTriageConfig()
+		n.Enabled = true
+		n.ClassifierProvider = "flash"
+		n.AlertTypes = map[string]NoiseTriageAlertTypeConfig{"KubeJobFailed": {MinConfidence: 0.8}}
+		return n
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*NoiseTriageConfig)
+		errMsg string
+	}{
+		{name: "valid", mutate: func(*NoiseTriageConfig) {}},
+		{name: "disabled skips checks", mutate: func(n *NoiseTriageConfig) { n.Enabled = false; n.ClassifierProvider = "" }},
+		{name: "missing classifier", mutate: func(n *NoiseTriageConfig) { n.ClassifierProvider = "" }, errMsg: "classifier_provider is required"},
+		{name: "unknown classifier", mutate: func(n *NoiseTriageConfig) { n.ClassifierProvider = "missing" }, errMsg: "system.noise_triage.classifier_provider"},
+		{name: "classifier without structured output", mutate: func(n *NoiseTriageConfig) { n.ClassifierProvider = "local" }, errMsg: "does not support structured output"},
+		{name: "zero timeout", mutate: func(n *NoiseTriageConfig) { n.Timeout = 0 }, errMsg: "system.noise_triage.timeout"},
+		{name: "zero duplicate window", mutate: func(n *NoiseTriageConfig) { n.DuplicateWindow = 0 }, errMsg: "system.noise_triage.duplicate_window"},
+		{name: "no alert types", mutate: func(n *NoiseTriageConfig) { n.AlertTypes = nil }, errMsg: "alert_types must list"},
+		{
+			name: "min confidence out of range",
+			mutate: func(n *NoiseTriageConfig) {
+				n.AlertTypes["KubeJobFailed"] = NoiseTriageAlertTypeConfig{MinConfidence: 1.5}
+			},
+			errMsg: "alert_types.KubeJobFailed.min_confidence",
+		},
+	}
+
+	noJSON := false
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := valid()
+			tt.mutate(n)
+			cfg := &Config{
+				NoiseTriage: n,
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+					"flash": {Type: LLMProviderTypeGoogle, Model: "flash-model"},
+					"local": {Type: LLMProviderTypeOpenAI, Model: "local-model", Capabilities: &LLMCapabilities{StructuredOutput: &noJSON}},
+				}),
+			}
+
+			err := NewValidator(cfg).validateNoiseTriage()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateRecurrence(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*RecurrenceConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*RecurrenceConfig) {}},
+		{name: "valid with provider", mutate: func(r *RecurrenceConfig) { r.LLMProvider = "test-provider" }},
+		{name: "disabled skips checks", mutate: func(r *RecurrenceConfig) { r.Enabled = false; r.LookbackWindow = 0 }},
+		{name: "zero lookback", mutate: func(r *RecurrenceConfig) { r.LookbackWindow = 0 }, errMsg: "system.recurrence.lookback_window"},
+		{name: "unknown provider", mutate: func(r *RecurrenceConfig) { r.LLMProvider = "missing" }, errMsg: "system.recurrence.llm_provider"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := DefaultRecurrenceConfig()
+			tt.mutate(r)
+			cfg := &Config{
+				Recurrence: r,
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+					"test-provider": {Type: LLMProviderTypeGoogle, Model: "test-model"},
+				}),
+			}
+
+			err := NewValidator(cfg).validateRecurrence()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateOutcomeClassification(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*OutcomeClassificationConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*OutcomeClassificationConfig) {}},
+		{name: "valid with provider", mutate: func(o *OutcomeClassificationConfig) { o.LLMProvider = "test-provider" }},
+		{name: "disabled skips checks", mutate: func(o *OutcomeClassificationConfig) { o.Enabled = false; o.LLMProvider = "missing" }},
+		{name: "unknown provider", mutate: func(o *OutcomeClassificationConfig) { o.LLMProvider = "missing" }, errMsg: "system.outcome_classification.llm_provider"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultOutcomeClassificationConfig()
+			tt.mutate(o)
+			cfg := &Config{
+				OutcomeClassification: o,
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+					"test-provider": {Type: LLMProviderTypeGoogle, Model: "test-model"},
+				}),
+			}
+
+			err := NewValidator(cfg).validateOutcomeClassification()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateActionItems(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*ActionItemsConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*ActionItemsConfig) {}},
+		{name: "valid with provider", mutate: func(a *ActionItemsConfig) { a.LLMProvider = "test-provider" }},
+		{name: "disabled skips checks", mutate: func(a *ActionItemsConfig) { a.Enabled = false; a.MaxItems = 0 }},
+		{name: "non-positive max items", mutate: func(a *ActionItemsConfig) { a.MaxItems = 0 }, errMsg: "system.action_items.max_items"},
+		{name: "unknown provider", mutate: func(a *ActionItemsConfig) { a.LLMProvider = "missing" }, errMsg: "system.action_items.llm_provider"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := DefaultActionItemsConfig()
+			a.Enabled = true
+			tt.mutate(a)
+			cfg := &Config{
+				ActionItems: a,
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+					"test-provider": {Type: LLMProviderTypeGoogle, Model: "test-model"},
+				}),
+			}
+
+			err := NewValidator(cfg).validateActionItems()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateOnCall(t *testing.T) {
+	t.Setenv("TEST_ONCALL_TOKEN", "token")
+
+	tests := []struct {
+		name   string
+		mutate func(*OnCallConfig)
+		errMsg string
+	}{
+		{name: "valid", mutate: func(*OnCallConfig) {}},
+		{name: "disabled skips checks", mutate: func(o *OnCallConfig) { o.Provider = ""; o.Services = nil }},
+		{name: "unknown provider", mutate: func(o *OnCallConfig) { o.Provider = "victorops" }, errMsg: "system.on_call.provider"},
+		{name: "token env not set", mutate: func(o *OnCallConfig) { o.APITokenEnv = "TEST_ONCALL_MISSING" }, errMsg: "environment variable TEST_ONCALL_MISSING is not set"},
+		{name: "no services", mutate: func(o *OnCallConfig) { o.Services = nil }, errMsg: "system.on_call.services"},
+		{name: "missing schedule", mutate: func(o *OnCallConfig) { o.Services["payments"].ScheduleID = " " }, errMsg: "system.on_call.services.payments.schedule_id"},
+		{name: "unknown default service", mutate: func(o *OnCallConfig) { o.DefaultService = "billing" }, errMsg: "system.on_call.default_service"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := DefaultOnCallConfig()
+			o.Provider = OnCallProviderPagerDuty
+			o.APITokenEnv = "TEST_ONCALL_TOKEN"
+			o.Services = map[string]*OnCallServiceConfig{"payments": {ScheduleID: "P123", Team: "Payments"}}
+			tt.mutate(o)
+
+			err := NewValidator(&Config{OnCall: o}).validateOnCall()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+
+	t.Run("chain references an unknown service", func(t *testing.T) {
+		o := DefaultOnCallConfig()
+		o.Provider = OnCallProviderOpsgenie
+		o.Services = map[string]*OnCallServiceConfig{"payments": {ScheduleID: "S1"}}
+		cfg := &Config{
+			OnCall: o,
+			ChainRegistry: NewChainRegistry(map[string]*ChainConfig{
+				"test-chain": {
+					AlertTypes:    []string{"test"},
+					OnCallService: "billing",
+					Stages:        []StageConfig{{Name: "stage1", Agents: []StageAgentConfig{{Name: "test-agent"}}}},
+				},
+			}),
+			AgentRegistry:       NewAgentRegistry(map[string]*AgentConfig{"test-agent": {MCPServers: []string{"test"}}}),
+			LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{}),
+		}
+		err := NewValidator(cfg).validateChains()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "service 'billing' not found in system.on_call.services")
+	})
+}
+
+func TestValidateDeepLinks(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*DeepLinksConfig)
+		errMsg string
+	}{
+		{name: "valid", mutate: func(*DeepLinksConfig) {}},
+		{name: "no templates skips checks", mutate: func(d *DeepLinksConfig) { d.Templates = nil; d.DefaultLookback = 0 }},
+		{name: "non-positive lookback", mutate: func(d *DeepLinksConfig) { d.DefaultLookback = 0 }, errMsg: "system.deep_links.default_lookback"},
+		{name: "invalid name", mutate: func(d *DeepLinksConfig) { d.Templates["Pod Dashboard"] = d.Templates["grafana-pod"] }, errMsg: `invalid name "Pod Dashboard"`},
+		{name: "missing URL", mutate: func(d *DeepLinksConfig) { d.Templates["grafana-pod"].URL = "" }, errMsg: "system.deep_links.templates.grafana-pod.url is required"},
+		{name: "non-HTTP URL", mutate: func(d *DeepLinksConfig) { d.Templates["grafana-pod"].URL = "javascript:alert(1)" }, errMsg: "must be an http(s) URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DefaultDeepLinksConfig()
+			d.Templates = map[string]*DeepLinkTemplate{
+				"grafana-pod": {URL: "https://grafana.example.com/d/pods?var-pod={pod}&from={from_ms}&to={to_ms}"},
+			}
+			tt.mutate(d)
+
+			err := NewValidator(&Config{DeepLinks: d}).validateDeepLinks()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateEnrichment(t *testing.T) {
+	t.Setenv("TEST_CMDB_TOKEN", "token")
+
+	tests := []struct {
+		name   string
+		cfg    *EnrichmentConfig
+		errMsg string
+	}{
+		{name: "disabled", cfg: &EnrichmentConfig{}},
+		{name: "valid CSV and URL", cfg: &EnrichmentConfig{
+			IPLookup:   &EnrichmentSourceConfig{CSVFile: "/data/ip-ranges.csv"},
+			HostLookup: &EnrichmentSourceConfig{URL: "https://cmdb.example.com/hosts/{hostname}", TokenEnv: "TEST_CMDB_TOKEN"},
+		}},
+		{name: "neither source", cfg: &EnrichmentConfig{IPLookup: &EnrichmentSourceConfig{}}, errMsg: "system.enrichment.ip_lookup: exactly one of csv_file and url"},
+		{name: "both sources", cfg: &EnrichmentConfig{HostLookup: &EnrichmentSourceConfig{CSVFile: "/h.csv", URL: "https://cmdb/{hostname}"}}, errMsg: "system.enrichment.host_lookup: exactly one"},
+		{name: "non-HTTP URL", cfg: &EnrichmentConfig{IPLookup: &EnrichmentSourceConfig{URL: "ftp://ipam/{ip}"}}, errMsg: "system.enrichment.ip_lookup.url must be an http(s) URL"},
+		{name: "URL without placeholder", cfg: &EnrichmentConfig{IPLookup: &EnrichmentSourceConfig{URL: "https://ipam.example.com/lookup?ip={hostname}"}}, errMsg: "must contain {ip}"},
+		{name: "token for CSV", cfg: &EnrichmentConfig{IPLookup: &EnrichmentSourceConfig{CSVFile: "/ip.csv", TokenEnv: "TEST_CMDB_TOKEN"}}, errMsg: "token_env requires url"},
+		{name: "token env not set", cfg: &EnrichmentConfig{HostLookup: &EnrichmentSourceConfig{URL: "https://cmdb/{hostname}", TokenEnv: "TEST_CMDB_MISSING"}}, errMsg: "environment variable TEST_CMDB_MISSING is not set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{Enrichment: tt.cfg}).validateEnrichment()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateRecentHistory(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*RecentHistoryConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*RecentHistoryConfig) {}},
+		{name: "valid enabled", mutate: func(r *RecentHistoryConfig) { r.Enabled = true }},
+		{name: "zero window", mutate: func(r *RecentHistoryConfig) { r.Window = 0 }, errMsg: "system.recent_history.window"},
+		{name: "zero max sessions", mutate: func(r *RecentHistoryConfig) { r.MaxSessions = 0 }, errMsg: "system.recent_history.max_sessions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := DefaultRecentHistoryConfig()
+			tt.mutate(r)
+			err := NewValidator(&Config{RecentHistory: r}).validateRecentHistory()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateModelRouting(t *testing.T) {
+	valid := func() *ModelRoutingConfig {
+		r := DefaultModelRoutingConfig()
+		r.Enabled = true
+		r.ClassifierProvider = "flash"
+		r.FastProvider = "flash"
+		r.StrongProvider = "pro"
+		return r
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*ModelRoutingConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*ModelRoutingConfig) {}},
+		{name: "disabled skips checks", mutate: func(r *ModelRoutingConfig) { r.Enabled = false; r.StrongProvider = "" }},
+		{name: "missing classifier", mutate: func(r *ModelRoutingConfig) { r.ClassifierProvider = "" }, errMsg: "system.model_routing.classifier_provider is required"},
+		{name: "missing fast tier", mutate: func(r *ModelRoutingConfig) { r.FastProvider = "" }, errMsg: "system.model_routing.fast_provider is required"},
+		{name: "unknown strong tier", mutate: func(r *ModelRoutingConfig) { r.StrongProvider = "missing" }, errMsg: "system.model_routing.strong_provider"},
+		{name: "threshold too low", mutate: func(r *ModelRoutingConfig) { r.ComplexityThreshold = 0 }, errMsg: "system.model_routing.complexity_threshold"},
+		{name: "threshold too high", mutate: func(r *ModelRoutingConfig) { r.ComplexityThreshold = 11 }, errMsg: "system.model_routing.complexity_threshold"},
+		{name: "zero timeout", mutate: func(r *ModelRoutingConfig) { r.Timeout = 0 }, errMsg: "system.model_routing.timeout"},
+		{name: "classifier without structured output", mutate: func(r *ModelRoutingConfig) { r.ClassifierProvider = "local" }, errMsg: "does not support structured output"},
+	}
+
+	noJSON := false
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.mutate(r)
+			cfg := &Config{
+				ModelRouting: r,
+				LLMProviderRegistry: NewLLMProviderRegistry(map[string]*LLMProviderConfig{
+					"flash": {Type: LLMProviderTypeGoogle, Model: "flash-model"},
+					"pro":   {Type: LLMProviderTypeGoogle, Model: "pro-model"},
+					"local": {Type: LLMProviderTypeOpenAI, Model: "local-model", Capabilities: &LLMCapabilities{StructuredOutput: &noJSON}},
+				}),
+			}
+
+			err := NewValidator(cfg).validateModelRouting()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateTranscription(t *testing.T) {
+	valid := func() *TranscriptionConfig {
+		c := DefaultTranscriptionConfig()
+		c.Enabled = true
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*TranscriptionConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*TranscriptionConfig) {}},
+		{name: "google provider", mutate: func(c *TranscriptionConfig) { c.Provider = TranscriptionProviderGoogle; c.Model = "gemini-2.5-flash" }},
+		{name: "disabled skips checks", mutate: func(c *TranscriptionConfig) { c.Enabled = false; c.Provider = "bogus" }},
+		{name: "unknown provider", mutate: func(c *TranscriptionConfig) { c.Provider = "bogus" }, errMsg: "system.transcription.provider"},
+		{name: "missing model", mutate: func(c *TranscriptionConfig) { c.Model = "" }, errMsg: "system.transcription.model is required"},
+		{name: "missing api key env", mutate: func(c *TranscriptionConfig) { c.APIKeyEnv = "" }, errMsg: "system.transcription.api_key_env is required"},
+		{name: "zero timeout", mutate: func(c *TranscriptionConfig) { c.Timeout = 0 }, errMsg: "system.transcription.timeout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid()
+			tt.mutate(c)
+
+			err := NewValidator(&Config{Transcription: c}).validateTranscription()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateCallbacks(t *testing.T) {
+	valid := func() *CallbacksConfig {
+		c := DefaultCallbacksConfig()
+		c.Enabled = true
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*CallbacksConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*CallbacksConfig) {}},
+		{name: "disabled skips checks", mutate: func(c *CallbacksConfig) { c.Enabled = false; c.MaxAttempts = 0 }},
+		{name: "zero max attempts", mutate: func(c *CallbacksConfig) { c.MaxAttempts = 0 }, errMsg: "system.callbacks.max_attempts"},
+		{name: "zero initial backoff", mutate: func(c *CallbacksConfig) { c.InitialBackoff = 0 }, errMsg: "system.callbacks.initial_backoff"},
+		{name: "max backoff below initial", mutate: func(c *CallbacksConfig) { c.MaxBackoff = time.Second }, errMsg: "system.callbacks.max_backoff"},
+		{name: "zero timeout", mutate: func(c *CallbacksConfig) { c.Timeout = 0 }, errMsg: "system.callbacks.timeout"},
+		{name: "blank allowed host", mutate: func(c *CallbacksConfig) { c.AllowedHosts = []string{"ok.example.com", " "} }, errMsg: "system.callbacks.allowed_hosts[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid()
+			tt.mutate(c)
+
+			err := NewValidator(&Config{Callbacks: c}).validateCallbacks()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateEventStream(t *testing.T) {
+	valid := func() *EventStreamConfig {
+		c := DefaultEventStreamConfig()
+		c.Enabled = true
+		c.Endpoint = "https://kafka-bridge.example.com/topics/tarsy"
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*EventStreamConfig)
+		errMsg string
+	}{
+		{name: "valid defaults", mutate: func(*EventStreamConfig) {}},
+		{name: "disabled skips checks", mutate: func(c *EventStreamConfig) { c.Enabled = false; c.Endpoint = "" }},
+		{name: "event subset", mutate: func(c *EventStreamConfig) { c.Events = []string{StreamEventSessionCreated, StreamEventSessionTerminal} }},
+		{name: "missing endpoint", mutate: func(c *EventStreamConfig) { c.Endpoint = "" }, errMsg: "system.event_stream.endpoint is required"},
+		{name: "non-http endpoint", mutate: func(c *EventStreamConfig) { c.Endpoint = "kafka://broker:9092" }, errMsg: "system.event_stream.endpoint must be an http(s) URL"},
+		{name: "unknown mode", mutate: func(c *EventStreamConfig) { c.Mode = "batched" }, errMsg: "system.event_stream.mode"},
+		{name: "unknown event", mutate: func(c *EventStreamConfig) { c.Events = []string{"stage.started"} }, errMsg: "system.event_stream.events[0]"},
+		{name: "unset token env", mutate: func(c *EventStreamConfig) { c.TokenEnv = "TARSY_TEST_EVENT_STREAM_TOKEN_UNSET" }, errMsg: "system.event_stream.token_env"},
+		{name: "zero timeout", mutate: func(c *EventStreamConfig) { c.Timeout = 0 }, errMsg: "system.event_stream.timeout"},
+		{name: "zero max attempts", mutate: func(c *EventStreamConfig) { c.MaxAttempts = 0 }, errMsg: "system.event_stream.max_attempts"},
+		{name: "zero buffer size", mutate: func(c *EventStreamConfig) { c.BufferSize = 0 }, errMsg: "system.event_stream.buffer_size"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := valid()
+			tt.mutate(c)
+
+			err := NewValidator(&Config{EventStream: c}).validateEventStream()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateFeatureFlags(t *testing.T) {
+	pct := func(i int) *int { return &i }
+
+	tests := []struct {
+		name   string
+		flags  map[string]*FeatureFlagConfig
+		errMsg string
+	}{
+		{name: "defaults", flags: resolveFeatureFlags(nil)},
+		{
+			name:  "targeted rollout",
+			flags: map[string]*FeatureFlagConfig{FeatureFlagFanOut: {Enabled: true, Percentage: pct(50), Chains: []string{"k8s"}}},
+		},
+		{
+			name:   "unknown flag",
+			flags:  map[string]*FeatureFlagConfig{"speculative_tools": {Enabled: true}},
+			errMsg: `system.feature_flags.speculative_tools: unknown feature flag "speculative_tools"`,
+		},
+		{
+			name:   "percentage out of range",
+			flags:  map[string]*FeatureFlagConfig{FeatureFlagFanOut: {Enabled: true, Percentage: pct(-1)}},
+			errMsg: "system.feature_flags.fan_out: percentage must be between 0 and 100",
+		},
+		{
+			name:   "unknown chain",
+			flags:  map[string]*FeatureFlagConfig{FeatureFlagModelRouting: {Enabled: true, Chains: []string{"missing"}}},
+			errMsg: "system.feature_flags.model_routing: chain 'missing' not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				FeatureFlags:  tt.flags,
+				ChainRegistry: NewChainRegistry(map[string]*ChainConfig{"k8s": {AlertTypes: []string{"PodCrash"}}}),
+			}
+
+			err := NewValidator(cfg).validateFeatureFlags()
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestValidateFaultInjection(t *testing.T) {
+	servers := NewMCPServerRegistry(map[string]*MCPServerConfig{"kubernetes-server": {}})
+
+	tests := []struct {
+		name   string
+		cfg    *FaultInjectionConfig
+		errMsg string
+	}{
+		{name: "nil passes", cfg: nil},
+		{name: "disabled skips checks", cfg: &FaultInjectionConfig{MCPTimeoutRate: 2}},
+		{name: "valid rates", cfg: &FaultInjectionConfig{Enabled: true, MCPTimeoutRate: 0.1, MCPMalformedRate: 0.1, LLMRateLimitRate: 1, MCPServers: []string{"kubernetes-server"}}},
+		{name: "rate above 1", cfg: &FaultInjectionConfig{Enabled: true, LLMRateLimitRate: 1.5}, errMsg: "system.fault_injection.llm_rate_limit_rate"},
+		{name: "negative rate", cfg: &FaultInjectionConfig{Enabled: true, MCPMalformedRate: -0.1}, errMsg: "system.fault_injection.mcp_malformed_rate"},
+		{name: "MCP rates above 1 combined", cfg: &FaultInjectionConfig{Enabled: true, MCPTimeoutRate: 0.6, MCPMalformedRate: 0.6}, errMsg: "must not add up to more than 1"},
+		{name: "unknown server", cfg: &FaultInjectionConfig{Enabled: true, MCPServers: []string{"github"}}, errMsg: `unknown MCP server "github"`},
+		{name: "empty server", cfg: &FaultInjectionConfig{Enabled: true, MCPServers: []string{""}}, errMsg: "mcp_servers[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{FaultInjection: tt.cfg, MCPServerRegistry: servers}).validateFaultInjection()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateProfiling(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *ProfilingConfig
+		errMsg string
+	}{
+		{name: "nil passes", cfg: nil},
+		{name: "defaults pass", cfg: DefaultProfilingConfig()},
+		{name: "threshold", cfg: &ProfilingConfig{HeapCapture: HeapCaptureConfig{RSSThresholdMB: 2048}}},
+		{name: "negative threshold", cfg: &ProfilingConfig{HeapCapture: HeapCaptureConfig{RSSThresholdMB: -1}}, errMsg: "system.profiling.heap_capture.rss_threshold_mb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{Profiling: tt.cfg}).validateProfiling()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRedactionReview(t *testing.T) {
+	enabled := func(mut func(*RedactionReviewConfig)) *RedactionReviewConfig {
+		cfg := DefaultRedactionReviewConfig()
+		cfg.Enabled = true
+		mut(cfg)
+		return cfg
+	}
+	tests := []struct {
+		name   string
+		cfg    *RedactionReviewConfig
+		errMsg string
+	}{
+		{name: "nil passes", cfg: nil},
+		{name: "disabled defaults pass", cfg: DefaultRedactionReviewConfig()},
+		{name: "enabled defaults pass", cfg: enabled(func(*RedactionReviewConfig) {})},
+		{name: "entropy too low", cfg: enabled(func(c *RedactionReviewConfig) { c.MinEntropy = 2 }), errMsg: "system.redaction_review.min_entropy"},
+		{name: "no suspects per interaction", cfg: enabled(func(c *RedactionReviewConfig) { c.MaxPerInteraction = -1 }), errMsg: "system.redaction_review.max_per_interaction"},
+		{name: "blank reviewer", cfg: enabled(func(c *RedactionReviewConfig) { c.Reviewers = []string{""} }), errMsg: "system.redaction_review.reviewers[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{RedactionReview: tt.cfg}).validateRedactionReview()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRedactionReviewConfig_IsReviewer(t *testing.T) {
+	open := &RedactionReviewConfig{}
+	assert.True(t, open.IsReviewer("anyone"))
+
+	cfg := &RedactionReviewConfig{Reviewers: []string{"secops@example.com"}}
+	assert.True(t, cfg.IsReviewer("", "secops@example.com"))
+	assert.False(t, cfg.IsReviewer("bob@example.com"))
+	assert.False(t, (*RedactionReviewConfig)(nil).IsReviewer("secops@example.com"))
+}
+
+func TestValidateFederation(t *testing.T) {
+	t.Setenv("TEST_FEDERATION_TOKEN", "token")
+	instance := func(mut func(*FederationInstanceConfig)) *FederationConfig {
+		inst := &FederationInstanceConfig{
+			URL:          "https://tarsy.east.example.com",
+			TokenEnv:     "TEST_FEDERATION_TOKEN",
+			Timeout:      DefaultFederationTimeout,
+			PollInterval: DefaultFederationPollInterval,
+		}
+		mut(inst)
+		return &FederationConfig{Instances: map[string]*FederationInstanceConfig{"east": inst}}
+	}
+	tests := []struct {
+		name   string
+		cfg    *FederationConfig
+		errMsg string
+	}{
+		{name: "nil passes", cfg: nil},
+		{name: "defaults pass", cfg: DefaultFederationConfig()},
+		{name: "valid instance", cfg: instance(func(*FederationInstanceConfig) {})},
+		{name: "nil instance", cfg: &FederationConfig{Instances: map[string]*FederationInstanceConfig{"east": nil}}, errMsg: "system.federation.instances.east must not be empty"},
+		{name: "relative url", cfg: instance(func(i *FederationInstanceConfig) { i.URL = "tarsy.east" }), errMsg: "system.federation.instances.east.url"},
+		{name: "token env unset", cfg: instance(func(i *FederationInstanceConfig) { i.TokenEnv = "TEST_FEDERATION_UNSET" }), errMsg: "TEST_FEDERATION_UNSET is not set"},
+		{name: "no timeout", cfg: instance(func(i *FederationInstanceConfig) { i.Timeout = 0 }), errMsg: "system.federation.instances.east.timeout"},
+		{name: "poll interval above timeout", cfg: instance(func(i *FederationInstanceConfig) { i.PollInterval = time.Hour }), errMsg: "system.federation.instances.east.poll_interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{Federation: tt.cfg}).validateFederation()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRemoteStage(t *testing.T) {
+	cfg := &Config{
+		AgentRegistry:       NewAgentRegistry(map[string]*AgentConfig{"test-agent": {}}),
+		LLMProviderRegistry: NewLLMProviderRegistry(nil),
+		MCPServerRegistry:   NewMCPServerRegistry(nil),
+		Federation: &FederationConfig{Instances: map[string]*FederationInstanceConfig{
+			"east": {URL: "https://tarsy.east.example.com"},
+		}},
+	}
+	tests := []struct {
+		name   string
+		stage  StageConfig
+		errMsg string
+	}{
+		{name: "remote stage without agents", stage: StageConfig{Name: "cluster", Remote: &RemoteStageConfig{Instance: "east"}}},
+		{name: "missing instance", stage: StageConfig{Name: "cluster", Remote: &RemoteStageConfig{}}, errMsg: "remote.instance required"},
+		{name: "unknown instance", stage: StageConfig{Name: "cluster", Remote: &RemoteStageConfig{Instance: "west"}}, errMsg: "remote instance 'west' not found"},
+		{
+			name:   "remote stage with agents",
+			stage:  StageConfig{Name: "cluster", Remote: &RemoteStageConfig{Instance: "east"}, Agents: []StageAgentConfig{{Name: "test-agent"}}},
+			errMsg: "remote stages must not set agents",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(cfg).validateStage("test-chain", 0, &tt.stage)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCompression(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *CompressionConfig
+		errMsg string
+	}{
+		{name: "nil passes", cfg: nil},
+		{name: "enabled with threshold", cfg: &CompressionConfig{Enabled: true, ThresholdBytes: 8192}},
+		{name: "disabled ignores threshold", cfg: &CompressionConfig{Enabled: false, ThresholdBytes: 10}},
+		{name: "threshold too small", cfg: &CompressionConfig{Enabled: true, ThresholdBytes: 512}, errMsg: "system.compression.threshold_bytes"},
+		{name: "negative threshold", cfg: &CompressionConfig{Enabled: true, ThresholdBytes: -1}, errMsg: "system.compression.threshold_bytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{Compression: tt.cfg}).validateCompression()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBlobStore(t *testing.T) {
+	t.Setenv("TEST_BLOB_ACCESS", "access")
+	t.Setenv("TEST_BLOB_SECRET", "secret")
+
+	s3 := func(mod func(*S3BlobStoreConfig)) *BlobStoreConfig {
+		cfg := DefaultBlobStoreConfig()
+		cfg.Backend = BlobBackendS3
+		cfg.S3 = S3BlobStoreConfig{
+			Bucket:       "tarsy",
+			Region:       "us-east-1",
+			AccessKeyEnv: "TEST_BLOB_ACCESS",
+			SecretKeyEnv: "TEST_BLOB_SECRET",
+		}
+		if mod != nil {
+			mod(&cfg.S3)
+		}
+		return cfg
+	}
+	gcs := func(mod func(*GCSBlobStoreConfig)) *BlobStoreConfig {
+		cfg := DefaultBlobStoreConfig()
+		cfg.Backend = BlobBackendGCS
+		cfg.GCS = GCSBlobStoreConfig{Bucket: "tarsy", AccessIDEnv: "TEST_BLOB_ACCESS", SecretEnv: "TEST_BLOB_SECRET"}
+		if mod != nil {
+			mod(&cfg.GCS)
+		}
+		return cfg
+	}
+	with := func(mod func(*BlobStoreConfig)) *BlobStoreConfig {
+		cfg := DefaultBlobStoreConfig()
+		mod(cfg)
+		return cfg
+	}
+
+	tests := []struct {
+		name   string
+		cfg    *BlobStoreConfig
+		errMsg string
+	}{
+		{name: "nil passes", cfg: nil},
+		{name: "defaults pass", cfg: DefaultBlobStoreConfig()},
+		{name: "unknown backend", cfg: with(func(c *BlobStoreConfig) { c.Backend = "ftp" }), errMsg: "system.blob_store.backend"},
+		{name: "absolute prefix", cfg: with(func(c *BlobStoreConfig) { c.Prefix = "/tarsy" }), errMsg: "system.blob_store.prefix"},
+		{name: "prefix with parent segment", cfg: with(func(c *BlobStoreConfig) { c.Prefix = "a/../b/" }), errMsg: "system.blob_store.prefix"},
+		{name: "negative threshold", cfg: with(func(c *BlobStoreConfig) { c.ToolResultThresholdBytes = -1 }), errMsg: "system.blob_store.tool_result_threshold_bytes"},
+		{name: "zero download URL TTL", cfg: with(func(c *BlobStoreConfig) { c.DownloadURLTTL = 0 }), errMsg: "system.blob_store.download_url_ttl"},
+		{name: "local requires dir", cfg: with(func(c *BlobStoreConfig) { c.Backend = BlobBackendLocal }), errMsg: "system.blob_store.local.dir"},
+		{name: "local with dir", cfg: with(func(c *BlobStoreConfig) { c.Backend = BlobBackendLocal; c.Local.Dir = "/var/lib/tarsy" })},
+		{name: "s3 valid", cfg: s3(nil)},
+		{name: "s3 with endpoint", cfg: s3(func(c *S3BlobStoreConfig) { c.Endpoint = "http://minio:9000"; c.PathStyle = true })},
+		{name: "s3 requires bucket", cfg: s3(func(c *S3BlobStoreConfig) { c.Bucket = "" }), errMsg: "system.blob_store.s3.bucket"},
+		{name: "s3 requires region", cfg: s3(func(c *S3BlobStoreConfig) { c.Region = "" }), errMsg: "system.blob_store.s3.region"},
+		{name: "s3 invalid endpoint", cfg: s3(func(c *S3BlobStoreConfig) { c.Endpoint = "minio:9000" }), errMsg: "system.blob_store.s3.endpoint"},
+		{name: "s3 access key unset", cfg: s3(func(c *S3BlobStoreConfig) { c.AccessKeyEnv = "TEST_BLOB_UNSET" }), errMsg: "system.blob_store.s3.access_key_env"},
+		{name: "s3 secret key unset", cfg: s3(func(c *S3BlobStoreConfig) { c.SecretKeyEnv = "TEST_BLOB_UNSET" }), errMsg: "system.blob_store.s3.secret_key_env"},
+		{name: "gcs valid", cfg: gcs(nil)},
+		{name: "gcs requires bucket", cfg: gcs(func(c *GCSBlobStoreConfig) { c.Bucket = "" }), errMsg: "system.blob_store.gcs.bucket"},
+		{name: "gcs secret unset", cfg: gcs(func(c *GCSBlobStoreConfig) { c.SecretEnv = "TEST_BLOB_UNSET" }), errMsg: "system.blob_store.gcs.secret_env"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{BlobStore: tt.cfg}).validateBlobStore()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateResultExport(t *testing.T) {
+	t.Setenv("TEST_EXPORT_ACCESS", "access")
+	t.Setenv("TEST_EXPORT_SECRET", "secret")
+
+	s3 := func(mod func(*ResultExportConfig)) *ResultExportConfig {
+		cfg := &ResultExportConfig{
+			Backend: BlobBackendS3,
+			Prefix:  "tarsy/results/",
+			S3: S3BlobStoreConfig{
+				Bucket:       "datalake",
+				Region:       "us-east-1",
+				AccessKeyEnv: "TEST_EXPORT_ACCESS",
+				SecretKeyEnv: "TEST_EXPORT_SECRET",
+			},
+		}
+		if mod != nil {
+			mod(cfg)
+		}
+		return cfg
+	}
+
+	tests := []struct {
+		name   string
+		cfg    *ResultExportConfig
+		errMsg string
+	}{
+		{name: "s3 valid", cfg: s3(nil)},
+		{name: "markdown only", cfg: s3(func(c *ResultExportConfig) { c.Formats = []ResultExportFormat{ResultExportMarkdown} })},
+		{name: "local valid", cfg: &ResultExportConfig{Backend: BlobBackendLocal, Local: LocalBlobStoreConfig{Dir: "/archive"}}},
+		{name: "postgres rejected", cfg: &ResultExportConfig{Backend: BlobBackendPostgres}, errMsg: "backend must be one of s3, gcs, local"},
+		{name: "missing backend", cfg: &ResultExportConfig{}, errMsg: "backend must be one of s3, gcs, local"},
+		{name: "unknown format", cfg: s3(func(c *ResultExportConfig) { c.Formats = []ResultExportFormat{"pdf"} }), errMsg: `invalid format "pdf"`},
+		{name: "absolute prefix", cfg: s3(func(c *ResultExportConfig) { c.Prefix = "/results" }), errMsg: "prefix must be a relative path"},
+		{name: "s3 requires bucket", cfg: s3(func(c *ResultExportConfig) { c.S3.Bucket = "" }), errMsg: "s3.bucket is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateResultExport(tt.cfg)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("gcs falls back to default credential variables", func(t *testing.T) {
+		t.Setenv("GCS_HMAC_ACCESS_ID", "")
+		err := validateResultExport(&ResultExportConfig{Backend: BlobBackendGCS, GCS: GCSBlobStoreConfig{Bucket: "datalake"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "gcs.access_id_env: environment variable GCS_HMAC_ACCESS_ID is not set")
+	})
+}
+
+func TestResultExportConfig_WantsFormat(t *testing.T) {
+	all := &ResultExportConfig{}
+	assert.True(t, all.WantsFormat(ResultExportJSON))
+	assert.True(t, all.WantsFormat(ResultExportMarkdown))
+
+	jsonOnly := &ResultExportConfig{Formats: []ResultExportFormat{ResultExportJSON}}
+	assert.True(t, jsonOnly.WantsFormat(ResultExportJSON))
+	assert.False(t, jsonOnly.WantsFormat(ResultExportMarkdown))
+}
+
+func TestValidateChainBudget(t *testing.T) {
+	tests := []struct {
+		name    string
+		budget  *ChainBudgetConfig
+		costOff bool
+		errMsg  string
+	}{
+		{name: "cost limit", budget: &ChainBudgetConfig{MaxCostUSD: 5}},
+		{name: "duration limit", budget: &ChainBudgetConfig{MaxDuration: 20 * time.Minute, MinSamples: 10}},
+		{name: "duration limit without cost estimation", budget: &ChainBudgetConfig{MaxDuration: time.Hour}, costOff: true},
+		{name: "no limits", budget: &ChainBudgetConfig{MinSamples: 3}, errMsg: "at least one of max_cost_usd or max_duration"},
+		{name: "negative cost", budget: &ChainBudgetConfig{MaxCostUSD: -1}, errMsg: "must not be negative"},
+		{name: "negative min samples", budget: &ChainBudgetConfig{MaxCostUSD: 1, MinSamples: -1}, errMsg: "must not be negative"},
+		{name: "cost limit without cost estimation", budget: &ChainBudgetConfig{MaxCostUSD: 5}, costOff: true, errMsg: "requires system.cost_estimation"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{CostEstimation: &CostEstimationConfig{Enabled: !tt.costOff}}
+			err := NewValidator(cfg).validateChainBudget(tt.budget)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	assert.Equal(t, DefaultBudgetMinSamples, (&ChainBudgetConfig{}).EffectiveMinSamples())
+	assert.Equal(t, 12, (&ChainBudgetConfig{MinSamples: 12}).EffectiveMinSamples())
+}
+
+func TestValidateExecutiveSummary(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *ExecutiveSummaryConfig
+		errMsg string
+	}{
+		{name: "nil", cfg: nil},
+		{name: "disabled", cfg: &ExecutiveSummaryConfig{Enabled: new(bool)}},
+		{name: "engineer bullets", cfg: &ExecutiveSummaryConfig{Audience: ExecSummaryAudienceEngineer, Style: ExecSummaryStyleBullets, MaxLines: 10}},
+		{name: "custom template", cfg: &ExecutiveSummaryConfig{PromptTemplate: "At most {max_lines} lines:\n{analysis}", TemplateVersion: "v2"}},
+		{name: "invalid audience", cfg: &ExecutiveSummaryConfig{Audience: "board"}, errMsg: `invalid audience "board"`},
+		{name: "invalid style", cfg: &ExecutiveSummaryConfig{Style: "table"}, errMsg: `invalid style "table"`},
+		{name: "negative max lines", cfg: &ExecutiveSummaryConfig{MaxLines: -1}, errMsg: "max_lines must be between 1 and 20"},
+		{name: "max lines too large", cfg: &ExecutiveSummaryConfig{MaxLines: 21}, errMsg: "max_lines must be between 1 and 20"},
+		{name: "template without analysis", cfg: &ExecutiveSummaryConfig{PromptTemplate: "Summarize in {max_lines} lines"}, errMsg: "must contain {analysis}"},
+		{name: "unknown placeholder", cfg: &ExecutiveSummaryConfig{PromptTemplate: "{analysis} for {alert_type}"}, errMsg: "unknown placeholder {alert_type}"},
+		{name: "padded version", cfg: &ExecutiveSummaryConfig{TemplateVersion: " v2"}, errMsg: "template_version must not have leading or trailing whitespace"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateExecutiveSummary(tt.cfg)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExecutiveSummaryConfig_Defaults(t *testing.T) {
+	var nilCfg *ExecutiveSummaryConfig
+	assert.True(t, nilCfg.IsEnabled())
+	assert.True(t, nilCfg.IsDefault())
+	assert.Equal(t, DefaultExecSummaryMaxLines, nilCfg.EffectiveMaxLines())
+
+	disabled := false
+	assert.False(t, (&ExecutiveSummaryConfig{Enabled: &disabled}).IsEnabled())
+	assert.True(t, (&ExecutiveSummaryConfig{Audience: ExecSummaryAudienceExecutive, Style: ExecSummaryStyleProse, MaxLines: 4}).IsDefault())
+	assert.False(t, (&ExecutiveSummaryConfig{MaxLines: 6}).IsDefault())
+	assert.Equal(t, 6, (&ExecutiveSummaryConfig{MaxLines: 6}).EffectiveMaxLines())
+}
+
+func TestValidateTargets(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *TargetsConfig
+		errMsg string
+	}{
+		{name: "nil passes", cfg: nil},
+		{name: "defaults pass", cfg: DefaultTargetsConfig()},
+		{
+			name: "valid clusters",
+			cfg: &TargetsConfig{Default: "prod-east", Clusters: map[string]*ClusterTargetConfig{
+				"prod-east": {Region: "us-east-1", Namespaces: []string{"payments"}, Params: map[string]string{"api_url": "https://api.prod-east:6443"}},
+				"staging":   nil,
+			}},
+		},
+		{
+			name:   "unknown default",
+			cfg:    &TargetsConfig{Default: "prod-west", Clusters: map[string]*ClusterTargetConfig{"prod-east": {}}},
+			errMsg: `system.targets.default: cluster "prod-west" is not configured`,
+		},
+		{
+			name:   "empty namespace",
+			cfg:    &TargetsConfig{Clusters: map[string]*ClusterTargetConfig{"prod-east": {Namespaces: []string{""}}}},
+			errMsg: "system.targets.clusters.prod-east.namespaces[0] must not be empty",
+		},
+		{
+			name:   "invalid param key",
+			cfg:    &TargetsConfig{Clusters: map[string]*ClusterTargetConfig{"prod-east": {Params: map[string]string{"api url": "x"}}}},
+			errMsg: `system.targets.clusters.prod-east.params: invalid key "api url"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{Targets: tt.cfg}).validateTargets()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateChatLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    *ChatLimitsConfig
+		errMsg string
+	}{
+		{name: "nil passes", cfg: nil},
+		{name: "defaults pass", cfg: DefaultChatLimitsConfig()},
+		{name: "zero means unlimited", cfg: &ChatLimitsConfig{}},
+		{
+			name:   "negative per-user rate",
+			cfg:    &ChatLimitsConfig{PerUserPerMinute: -1},
+			errMsg: "system.chat_limits.per_user_per_minute must be non-negative",
+		},
+		{
+			name:   "negative per-session rate",
+			cfg:    &ChatLimitsConfig{PerSessionPerMinute: -1},
+			errMsg: "system.chat_limits.per_session_per_minute must be non-negative",
+		},
+		{
+			name:   "negative concurrency cap",
+			cfg:    &ChatLimitsConfig{MaxConcurrentExecutions: -1},
+			errMsg: "system.chat_limits.max_concurrent_executions must be non-negative",
+		},
+		{
+			name:   "negative queue bound",
+			cfg:    &ChatLimitsConfig{MaxQueuedMessages: -1},
+			errMsg: "system.chat_limits.max_queued_messages must be non-negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewValidator(&Config{ChatLimits: tt.cfg}).validateChatLimits()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
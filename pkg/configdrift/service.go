@@ -0,0 +1,219 @@
+// Package configdrift detects configuration skew between replicas.
+//
+// Each pod periodically publishes a fingerprint of its resolved configuration
+// to the database and compares it with the fingerprints of all other live
+// pods. When they disagree (e.g. one pod restarted with a newer ConfigMap),
+// a system warning is raised on every pod until the fleet converges.
+package configdrift
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+const (
+	// heartbeatInterval is how often the pod publishes and compares fingerprints.
+	heartbeatInterval = 30 * time.Second
+
+	// staleAfter is how long a pod may miss heartbeats before it is no longer
+	// considered live (and its fingerprint no longer compared).
+	staleAfter = 2 * time.Minute
+
+	// pruneAfter is how long a crashed pod's row is kept before deletion.
+	pruneAfter = time.Hour
+
+	// stopTimeout bounds removing this pod's heartbeat on shutdown.
+	stopTimeout = 5 * time.Second
+)
+
+// Store persists pod heartbeats. Implemented by services.PodHeartbeatService.
+type Store interface {
+	Publish(ctx context.Context, podID, configHash, version string, startedAt time.Time) error
+	ListActive(ctx context.Context, since time.Time) ([]*ent.PodHeartbeat, error)
+	Remove(ctx context.Context, podID string) error
+	DeleteStale(ctx context.Context, cutoff time.Time) (int, error)
+}
+
+// Service publishes this pod's config fingerprint and raises a
+// WarningCategoryConfigDrift warning while live pods disagree.
+type Service struct {
+	podID      string
+	configHash string
+	version    string
+	startedAt  time.Time
+	store      Store
+	warnings   *services.SystemWarningsService
+	logger     *slog.Logger
+
+	drifting bool // only touched by the loop goroutine
+
+	now    func() time.Time
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewService creates a new config drift service.
+func NewService(podID, configHash, version string, store Store, warnings *services.SystemWarningsService) *Service {
+	now := time.Now
+	return &Service{
+		podID:      podID,
+		configHash: configHash,
+		version:    version,
+		startedAt:  now(),
+		store:      store,
+		warnings:   warnings,
+		logger:     slog.Default().With("component", "config-drift"),
+		now:        now,
+	}
+}
+
+// Start launches the background heartbeat loop.
+func (s *Service) Start(ctx context.Context) {
+	if s.cancel != nil {
+		return
+	}
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go s.run(ctx)
+
+	s.logger.Info("Config drift detection started",
+		"pod_id", s.podID,
+		"config_hash", s.configHash,
+		"version", s.version)
+}
+
+// Stop signals the loop to exit, waits for it, and removes this pod's
+// heartbeat so the remaining pods stop comparing against it immediately.
+func (s *Service) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+	defer cancel()
+	if err := s.store.Remove(ctx, s.podID); err != nil {
+		s.logger.Warn("Failed to remove pod heartbeat", "error", err)
+	}
+	s.logger.Info("Config drift detection stopped")
+}
+
+func (s *Service) run(ctx context.Context) {
+	defer close(s.done)
+
+	s.check(ctx)
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+// check publishes this pod's heartbeat, prunes crashed pods, and compares
+// fingerprints of live pods. Errors are logged; the warning state is left
+// unchanged when the comparison cannot be made.
+func (s *Service) check(ctx context.Context) {
+	now := s.now()
+
+	if err := s.store.Publish(ctx, s.podID, s.configHash, s.version, s.startedAt); err != nil {
+		s.logger.Error("Failed to publish pod heartbeat", "error", err)
+		return
+	}
+
+	if n, err := s.store.DeleteStale(ctx, now.Add(-pruneAfter)); err != nil {
+		s.logger.Warn("Failed to prune stale pod heartbeats", "error", err)
+	} else if n > 0 {
+		s.logger.Info("Pruned stale pod heartbeats", "count", n)
+	}
+
+	pods, err := s.store.ListActive(ctx, now.Add(-staleAfter))
+	if err != nil {
+		s.logger.Error("Failed to list pod heartbeats", "error", err)
+		return
+	}
+
+	groups := groupByHash(pods)
+	if len(groups) <= 1 {
+		if s.drifting {
+			s.logger.Info("Config drift resolved: all live replicas share one configuration",
+				"config_hash", s.configHash, "replicas", len(pods))
+		}
+		s.drifting = false
+		s.warnings.ClearByServerID(services.WarningCategoryConfigDrift, "")
+		return
+	}
+
+	details := describeGroups(groups)
+	if !s.drifting {
+		s.logger.Warn("Config drift detected: live replicas loaded different configurations",
+			"config_hash", s.configHash, "replicas", details)
+	}
+	s.drifting = true
+	s.warnings.AddWarning(services.WarningCategoryConfigDrift,
+		fmt.Sprintf("Replicas are running %d different configurations", len(groups)),
+		details, "")
+}
+
+// hashGroup is the set of live pods that loaded the same configuration.
+type hashGroup struct {
+	hash     string
+	pods     []string
+	versions []string
+}
+
+// groupByHash groups pods by config hash, largest group first (ties by hash).
+func groupByHash(pods []*ent.PodHeartbeat) []hashGroup {
+	byHash := make(map[string]*hashGroup)
+	for _, p := range pods {
+		g, ok := byHash[p.ConfigHash]
+		if !ok {
+			g = &hashGroup{hash: p.ConfigHash}
+			byHash[p.ConfigHash] = g
+		}
+		g.pods = append(g.pods, p.ID)
+		if !slices.Contains(g.versions, p.Version) {
+			g.versions = append(g.versions, p.Version)
+		}
+	}
+
+	out := make([]hashGroup, 0, len(byHash))
+	for _, g := range byHash {
+		slices.Sort(g.pods)
+		slices.Sort(g.versions)
+		out = append(out, *g)
+	}
+	slices.SortFunc(out, func(a, b hashGroup) int {
+		if len(a.pods) != len(b.pods) {
+			return len(b.pods) - len(a.pods)
+		}
+		return strings.Compare(a.hash, b.hash)
+	})
+	return out
+}
+
+// describeGroups renders groups for the warning details, e.g.
+// "config 1a2b3c4d (version abc12345): pod-a, pod-b; config 5e6f… (version def67890): pod-c".
+func describeGroups(groups []hashGroup) string {
+	parts := make([]string, 0, len(groups))
+	for _, g := range groups {
+		parts = append(parts, fmt.Sprintf("config %s (version %s): %s",
+			g.hash, strings.Join(g.versions, ", "), strings.Join(g.pods, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
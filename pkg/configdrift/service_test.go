@@ -0,0 +1,165 @@
+package configdrift
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// fakeStore is an in-memory Store.
+type fakeStore struct {
+	mu      sync.Mutex
+	pods    map[string]*ent.PodHeartbeat
+	listErr error
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{pods: map[string]*ent.PodHeartbeat{}}
+}
+
+func (f *fakeStore) Publish(_ context.Context, podID, configHash, version string, startedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pods[podID] = &ent.PodHeartbeat{ID: podID, ConfigHash: configHash, Version: version, StartedAt: startedAt, LastSeenAt: time.Now()}
+	return nil
+}
+
+func (f *fakeStore) ListActive(_ context.Context, since time.Time) ([]*ent.PodHeartbeat, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	var out []*ent.PodHeartbeat
+	for _, p := range f.pods {
+		if !p.LastSeenAt.Before(since) {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeStore) Remove(_ context.Context, podID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.pods, podID)
+	return nil
+}
+
+func (f *fakeStore) DeleteStale(_ context.Context, cutoff time.Time) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for id, p := range f.pods {
+		if p.LastSeenAt.Before(cutoff) {
+			delete(f.pods, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (f *fakeStore) seed(podID, hash, version string, lastSeen time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pods[podID] = &ent.PodHeartbeat{ID: podID, ConfigHash: hash, Version: version, LastSeenAt: lastSeen}
+}
+
+func driftWarnings(w *services.SystemWarningsService) []*services.SystemWarning {
+	var out []*services.SystemWarning
+	for _, warning := range w.GetWarnings() {
+		if warning.Category == services.WarningCategoryConfigDrift {
+			out = append(out, warning)
+		}
+	}
+	return out
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("single replica raises no warning", func(t *testing.T) {
+		store := newFakeStore()
+		warnings := services.NewSystemWarningsService()
+		svc := NewService("pod-a", "hash-1", "v1", store, warnings)
+
+		svc.check(ctx)
+		assert.Empty(t, driftWarnings(warnings))
+		assert.Contains(t, store.pods, "pod-a")
+	})
+
+	t.Run("differing hashes raise a warning, convergence clears it", func(t *testing.T) {
+		store := newFakeStore()
+		warnings := services.NewSystemWarningsService()
+		svc := NewService("pod-a", "hash-1", "v1", store, warnings)
+		store.seed("pod-b", "hash-1", "v1", time.Now())
+		store.seed("pod-c", "hash-2", "v2", time.Now())
+
+		svc.check(ctx)
+		got := driftWarnings(warnings)
+		require.Len(t, got, 1)
+		assert.Equal(t, "Replicas are running 2 different configurations", got[0].Message)
+		assert.Equal(t, "config hash-1 (version v1): pod-a, pod-b; config hash-2 (version v2): pod-c", got[0].Details)
+		assert.True(t, svc.drifting)
+
+		// Re-check does not duplicate the warning.
+		svc.check(ctx)
+		assert.Len(t, driftWarnings(warnings), 1)
+
+		store.seed("pod-c", "hash-1", "v1", time.Now())
+		svc.check(ctx)
+		assert.Empty(t, driftWarnings(warnings))
+		assert.False(t, svc.drifting)
+	})
+
+	t.Run("stale replicas are ignored and pruned", func(t *testing.T) {
+		store := newFakeStore()
+		warnings := services.NewSystemWarningsService()
+		svc := NewService("pod-a", "hash-1", "v1", store, warnings)
+		store.seed("pod-stale", "hash-2", "v2", time.Now().Add(-staleAfter-time.Minute))
+		store.seed("pod-dead", "hash-3", "v3", time.Now().Add(-pruneAfter-time.Minute))
+
+		svc.check(ctx)
+		assert.Empty(t, driftWarnings(warnings))
+		assert.Contains(t, store.pods, "pod-stale")
+		assert.NotContains(t, store.pods, "pod-dead")
+	})
+
+	t.Run("list error keeps existing warning state", func(t *testing.T) {
+		store := newFakeStore()
+		warnings := services.NewSystemWarningsService()
+		svc := NewService("pod-a", "hash-1", "v1", store, warnings)
+		store.seed("pod-b", "hash-2", "v1", time.Now())
+
+		svc.check(ctx)
+		require.Len(t, driftWarnings(warnings), 1)
+
+		store.listErr = errors.New("db down")
+		svc.check(ctx)
+		assert.Len(t, driftWarnings(warnings), 1)
+	})
+}
+
+func TestStartStopRemovesHeartbeat(t *testing.T) {
+	store := newFakeStore()
+	svc := NewService("pod-a", "hash-1", "v1", store, services.NewSystemWarningsService())
+
+	svc.Start(context.Background())
+	require.Eventually(t, func() bool {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		_, ok := store.pods["pod-a"]
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	svc.Stop()
+	assert.NotContains(t, store.pods, "pod-a")
+}
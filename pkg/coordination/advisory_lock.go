@@ -0,0 +1,71 @@
+package coordination
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"hash/fnv"
+)
+
+// DB is the subset of *sql.DB needed to hold advisory locks.
+type DB interface {
+	Conn(ctx context.Context) (*sql.Conn, error)
+}
+
+// pgLocker takes session-level PostgreSQL advisory locks, each on its own
+// pooled connection that is held for as long as the lock is.
+type pgLocker struct {
+	db DB
+}
+
+// lockKey maps a job name to a stable advisory lock key.
+func lockKey(job string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("tarsy:job:" + job))
+	return int64(h.Sum64())
+}
+
+func (l *pgLocker) TryLock(ctx context.Context, job string) (lock, bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	key := lockKey(job)
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired); err != nil {
+		_ = conn.Close()
+		return nil, false, fmt.Errorf("pg_try_advisory_lock failed: %w", err)
+	}
+	if !acquired {
+		_ = conn.Close()
+		return nil, false, nil
+	}
+	return &pgLock{conn: conn, key: key}, true, nil
+}
+
+type pgLock struct {
+	conn *sql.Conn
+	key  int64
+}
+
+func (l *pgLock) Check(ctx context.Context) error {
+	return l.conn.PingContext(ctx)
+}
+
+// Unlock releases the lock and returns the connection to the pool. If the
+// unlock query fails the connection is discarded instead, so a pooled
+// session can never keep holding the lock; PostgreSQL releases session
+// locks when the session ends.
+func (l *pgLock) Unlock(ctx context.Context) error {
+	_, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	if err != nil {
+		_ = l.conn.Raw(func(any) error { return driver.ErrBadConn })
+		err = fmt.Errorf("pg_advisory_unlock failed: %w", err)
+	}
+	if closeErr := l.conn.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,241 @@
+// Package coordination runs singleton background jobs on exactly one replica.
+//
+// Leadership for each job is a PostgreSQL session-level advisory lock held on
+// a dedicated connection. Every pod periodically tries to take the lock for
+// jobs it does not lead; the holder starts the job and keeps the connection
+// alive. If the leader crashes or loses its database connection, PostgreSQL
+// releases the lock and another pod takes over on its next attempt.
+// The job_leaders table only mirrors the current leaders for the API.
+package coordination
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// checkInterval is how often each pod tries to acquire jobs it does not
+	// lead and verifies the locks it holds.
+	checkInterval = 10 * time.Second
+
+	// StaleAfter is how long a job_leaders row may go without renewal before
+	// it is reported as stale (its pod most likely died).
+	StaleAfter = 3 * checkInterval
+
+	// releaseTimeout bounds unlocking and clearing leader rows on shutdown.
+	releaseTimeout = 5 * time.Second
+)
+
+// Job is a background job that must run on at most one pod.
+// Start is called when this pod becomes leader and Stop when it steps down;
+// a job may be started again after being stopped.
+type Job interface {
+	Start(ctx context.Context)
+	Stop()
+}
+
+// Store records job leaders. Implemented by services.JobLeaderService.
+type Store interface {
+	Claim(ctx context.Context, job, podID string) error
+	Renew(ctx context.Context, job, podID string) error
+	Release(ctx context.Context, job, podID string) error
+}
+
+// locker acquires exclusive per-job locks. Implemented by pgLocker.
+type locker interface {
+	TryLock(ctx context.Context, job string) (lock, bool, error)
+}
+
+// lock is a held job lock.
+type lock interface {
+	// Check returns an error when the lock can no longer be trusted
+	// (e.g. the underlying connection died).
+	Check(ctx context.Context) error
+	Unlock(ctx context.Context) error
+}
+
+type jobState struct {
+	name string
+	job  Job
+	lock lock // non-nil while this pod leads the job
+}
+
+// Elector runs registered jobs on this pod while it holds their leadership.
+type Elector struct {
+	podID  string
+	locker locker
+	store  Store
+	logger *slog.Logger
+
+	mu   sync.Mutex
+	jobs []*jobState
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewElector creates an elector that uses PostgreSQL advisory locks on db.
+func NewElector(podID string, db DB, store Store) *Elector {
+	return newElector(podID, &pgLocker{db: db}, store)
+}
+
+func newElector(podID string, l locker, store Store) *Elector {
+	return &Elector{
+		podID:  podID,
+		locker: l,
+		store:  store,
+		logger: slog.Default().With("component", "leader-elector"),
+	}
+}
+
+// Register adds a singleton job. Must be called before Start.
+// Nil jobs are ignored so optional services can be registered unconditionally.
+func (e *Elector) Register(name string, job Job) {
+	if job == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.jobs = append(e.jobs, &jobState{name: name, job: job})
+}
+
+// Start launches the election loop.
+func (e *Elector) Start(ctx context.Context) {
+	if e.cancel != nil {
+		return
+	}
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.done = make(chan struct{})
+
+	go e.run(ctx)
+
+	e.logger.Info("Leader elector started", "pod_id", e.podID, "jobs", e.jobNames())
+}
+
+// Stop stops every job this pod leads, releases their locks, and waits for
+// the election loop to exit.
+func (e *Elector) Stop() {
+	if e.cancel == nil {
+		return
+	}
+	e.cancel()
+	<-e.done
+	e.releaseAll()
+	e.cancel = nil
+	e.logger.Info("Leader elector stopped")
+}
+
+// IsLeader reports whether this pod currently leads job.
+func (e *Elector) IsLeader(job string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, js := range e.jobs {
+		if js.name == job {
+			return js.lock != nil
+		}
+	}
+	return false
+}
+
+func (e *Elector) run(ctx context.Context) {
+	defer close(e.done)
+
+	e.reconcile(ctx)
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.reconcile(ctx)
+		}
+	}
+}
+
+// reconcile verifies held locks (stepping down on failure) and tries to
+// acquire jobs without a leader on this pod.
+func (e *Elector) reconcile(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, js := range e.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		if js.lock != nil {
+			e.verify(ctx, js)
+			continue
+		}
+		e.tryAcquire(ctx, js)
+	}
+}
+
+func (e *Elector) tryAcquire(ctx context.Context, js *jobState) {
+	l, ok, err := e.locker.TryLock(ctx, js.name)
+	if err != nil {
+		e.logger.Warn("Failed to try job lock", "job", js.name, "error", err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	js.lock = l
+	if err := e.store.Claim(ctx, js.name, e.podID); err != nil {
+		e.logger.Warn("Failed to record job leader", "job", js.name, "error", err)
+	}
+	js.job.Start(ctx)
+	e.logger.Info("Acquired job leadership", "job", js.name, "pod_id", e.podID)
+}
+
+func (e *Elector) verify(ctx context.Context, js *jobState) {
+	if err := js.lock.Check(ctx); err != nil {
+		e.logger.Warn("Lost job leadership, stopping job", "job", js.name, "error", err)
+		js.job.Stop()
+		_ = js.lock.Unlock(ctx)
+		js.lock = nil
+		return
+	}
+	if err := e.store.Renew(ctx, js.name, e.podID); err != nil {
+		e.logger.Warn("Failed to renew job leader", "job", js.name, "error", err)
+	}
+}
+
+// releaseAll stops every led job, then unlocks it and clears its leader row
+// so another pod can take over immediately.
+func (e *Elector) releaseAll() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+
+	for _, js := range e.jobs {
+		if js.lock == nil {
+			continue
+		}
+		js.job.Stop()
+		if err := js.lock.Unlock(ctx); err != nil {
+			e.logger.Warn("Failed to release job lock", "job", js.name, "error", err)
+		}
+		js.lock = nil
+		if err := e.store.Release(ctx, js.name, e.podID); err != nil {
+			e.logger.Warn("Failed to clear job leader", "job", js.name, "error", err)
+		}
+	}
+}
+
+func (e *Elector) jobNames() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	names := make([]string, 0, len(e.jobs))
+	for _, js := range e.jobs {
+		names = append(names, js.name)
+	}
+	return names
+}
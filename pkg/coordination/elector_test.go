@@ -0,0 +1,193 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLocker simulates advisory locks shared by several pods.
+type fakeLocker struct {
+	mu      sync.Mutex
+	holders map[string]*fakeLock
+}
+
+func newFakeLocker() *fakeLocker {
+	return &fakeLocker{holders: map[string]*fakeLock{}}
+}
+
+func (f *fakeLocker) TryLock(_ context.Context, job string) (lock, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, held := f.holders[job]; held {
+		return nil, false, nil
+	}
+	l := &fakeLock{locker: f, job: job}
+	f.holders[job] = l
+	return l, true, nil
+}
+
+// kill simulates the leader's session dying: PostgreSQL drops the lock and
+// the holder's next Check fails.
+func (f *fakeLocker) kill(job string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if l, ok := f.holders[job]; ok {
+		l.dead = true
+		delete(f.holders, job)
+	}
+}
+
+type fakeLock struct {
+	locker *fakeLocker
+	job    string
+	dead   bool
+}
+
+func (l *fakeLock) Check(context.Context) error {
+	l.locker.mu.Lock()
+	defer l.locker.mu.Unlock()
+	if l.dead {
+		return errors.New("connection lost")
+	}
+	return nil
+}
+
+func (l *fakeLock) Unlock(context.Context) error {
+	l.locker.mu.Lock()
+	defer l.locker.mu.Unlock()
+	if l.locker.holders[l.job] == l {
+		delete(l.locker.holders, l.job)
+	}
+	return nil
+}
+
+// fakeStore is an in-memory Store.
+type fakeStore struct {
+	mu      sync.Mutex
+	leaders map[string]string
+	renews  int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{leaders: map[string]string{}}
+}
+
+func (f *fakeStore) Claim(_ context.Context, job, podID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.leaders[job] = podID
+	return nil
+}
+
+func (f *fakeStore) Renew(context.Context, string, string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.renews++
+	return nil
+}
+
+func (f *fakeStore) Release(_ context.Context, job, podID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.leaders[job] == podID {
+		delete(f.leaders, job)
+	}
+	return nil
+}
+
+// fakeJob counts Start/Stop calls.
+type fakeJob struct {
+	starts, stops int
+}
+
+func (j *fakeJob) Start(context.Context) { j.starts++ }
+func (j *fakeJob) Stop()                 { j.stops++ }
+
+func (j *fakeJob) running() bool { return j.starts > j.stops }
+
+func TestElector_SingleLeaderAndFailover(t *testing.T) {
+	ctx := context.Background()
+	locker := newFakeLocker()
+	store := newFakeStore()
+
+	jobA, jobB := &fakeJob{}, &fakeJob{}
+	podA := newElector("pod-a", locker, store)
+	podA.Register("cleanup", jobA)
+	podB := newElector("pod-b", locker, store)
+	podB.Register("cleanup", jobB)
+
+	podA.reconcile(ctx)
+	podB.reconcile(ctx)
+
+	assert.True(t, podA.IsLeader("cleanup"))
+	assert.False(t, podB.IsLeader("cleanup"))
+	assert.True(t, jobA.running())
+	assert.False(t, jobB.running())
+	assert.Equal(t, "pod-a", store.leaders["cleanup"])
+
+	// Leader keeps renewing while its lock is healthy.
+	podA.reconcile(ctx)
+	assert.Equal(t, 1, store.renews)
+	assert.Equal(t, 1, jobA.starts)
+
+	// Leader's connection dies: it steps down and the other pod takes over.
+	locker.kill("cleanup")
+	podA.reconcile(ctx)
+	assert.False(t, podA.IsLeader("cleanup"))
+	assert.False(t, jobA.running())
+
+	podB.reconcile(ctx)
+	assert.True(t, podB.IsLeader("cleanup"))
+	assert.True(t, jobB.running())
+	assert.Equal(t, "pod-b", store.leaders["cleanup"])
+}
+
+func TestElector_ReleaseAllFreesLeadership(t *testing.T) {
+	locker := newFakeLocker()
+	store := newFakeStore()
+
+	job := &fakeJob{}
+	podA := newElector("pod-a", locker, store)
+	podA.Register("reports", job)
+	podA.reconcile(context.Background())
+	require.True(t, podA.IsLeader("reports"))
+	podA.releaseAll()
+
+	assert.False(t, podA.IsLeader("reports"))
+	assert.Equal(t, 1, job.starts)
+	assert.Equal(t, 1, job.stops)
+	assert.Empty(t, store.leaders)
+
+	// Lock is free for another pod immediately.
+	other := &fakeJob{}
+	podB := newElector("pod-b", locker, store)
+	podB.Register("reports", other)
+	podB.reconcile(context.Background())
+	require.True(t, podB.IsLeader("reports"))
+	assert.True(t, other.running())
+}
+
+func TestElector_RegisterIgnoresNilJob(t *testing.T) {
+	e := newElector("pod-a", newFakeLocker(), newFakeStore())
+	e.Register("nil", nil)
+	assert.Empty(t, e.jobNames())
+}
+
+func TestElector_StartStop(t *testing.T) {
+	e := newElector("pod-a", newFakeLocker(), newFakeStore())
+	e.Register("cleanup", &fakeJob{})
+	e.Start(context.Background())
+	e.Stop()
+	e.Stop() // idempotent
+	assert.False(t, e.IsLeader("cleanup"))
+}
+
+func TestLockKey(t *testing.T) {
+	assert.Equal(t, lockKey("retention-cleanup"), lockKey("retention-cleanup"))
+	assert.NotEqual(t, lockKey("retention-cleanup"), lockKey("activity-reports"))
+}
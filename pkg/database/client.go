@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/codeready-toolchain/tarsy/ent"
+	_ "github.com/codeready-toolchain/tarsy/ent/runtime" // Register schema defaults and hooks
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/golang-migrate/migrate/v4/source"
@@ -40,12 +41,36 @@ type Config struct {
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
+
+	// Dedicated API pool: with APIMaxOpenConns > 0, HTTP handlers get a pool
+	// of their own, so a burst of dashboard traffic cannot starve executor
+	// writes of connections. 0 = the API shares the main pool.
+	APIMaxOpenConns int
+	APIMaxIdleConns int
+
+	// Migration settings
+	AutoMigrate          bool          // apply pending migrations on startup; when false, only verify the schema
+	MigrationLockTimeout time.Duration // max wait for another pod's migration to finish (0 = default)
 }
 
+// defaultMigrationLockTimeout bounds how long startup waits for the
+// migration lock held by another pod before giving up.
+const defaultMigrationLockTimeout = 10 * time.Minute
+
+// Pool names, as reported by Pools (and the tarsy_db_pool_* metrics).
+const (
+	PoolMain = "main"
+	PoolAPI  = "api"
+)
+
 // Client wraps Ent client and provides access to the underlying database
 type Client struct {
 	*ent.Client
 	db *stdsql.DB
+
+	// Dedicated API pool; nil when the API shares the main pool
+	api   *ent.Client
+	apiDB *stdsql.DB
 }
 
 // DB returns the underlying database connection for health checks and direct queries
@@ -53,6 +78,33 @@ func (c *Client) DB() *stdsql.DB {
 	return c.db
 }
 
+// API returns the Ent client for services serving HTTP requests: the
+// dedicated API pool when configured, the main client otherwise.
+func (c *Client) API() *ent.Client {
+	if c.api != nil {
+		return c.api
+	}
+	return c.Client
+}
+
+// Pools returns the connection pools by name, for pool metrics.
+func (c *Client) Pools() map[string]*stdsql.DB {
+	pools := map[string]*stdsql.DB{PoolMain: c.db}
+	if c.apiDB != nil {
+		pools[PoolAPI] = c.apiDB
+	}
+	return pools
+}
+
+// Close closes the API pool, if any, and the main pool.
+func (c *Client) Close() error {
+	var apiErr error
+	if c.api != nil {
+		apiErr = c.api.Close()
+	}
+	return errors.Join(apiErr, c.Client.Close())
+}
+
 // NewClientFromEnt wraps an existing Ent client (useful for testing)
 func NewClientFromEnt(entClient *ent.Client, db *stdsql.DB) *Client {
 	return &Client{
@@ -83,26 +135,13 @@ func quoteConnValue(v string) string {
 	return "'" + replacer.Replace(v) + "'"
 }
 
-// NewClient creates a new database client with connection pooling and migrations
+// NewClient creates a new database client with connection pooling and migrations.
+// Returns an error wrapping ErrIncompatibleSchema when this binary must not
+// run against the database schema.
 func NewClient(ctx context.Context, cfg Config) (*Client, error) {
-	dsn := cfg.DSN()
-
-	// Open database connection using pgx driver
-	db, err := stdsql.Open("pgx", dsn)
+	db, err := openPool(ctx, cfg, cfg.MaxOpenConns, cfg.MaxIdleConns)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open database: %w", err)
-	}
-
-	// Configure connection pool
-	db.SetMaxOpenConns(cfg.MaxOpenConns)
-	db.SetMaxIdleConns(cfg.MaxIdleConns)
-	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
-	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
-
-	// Test connection
-	if err := db.PingContext(ctx); err != nil {
-		_ = db.Close()
-		return nil, fmt.Errorf("failed to ping database: %w", err)
+		return nil, err
 	}
 
 	// Create Ent driver from existing database connection
@@ -112,10 +151,10 @@ func NewClient(ctx context.Context, cfg Config) (*Client, error) {
 	// Create Ent client with configured driver
 	entClient := ent.NewClient(ent.Driver(drv))
 
-	// Run migrations
-	if err := runMigrations(ctx, db, cfg, drv); err != nil {
+	// Verify schema compatibility and apply pending migrations
+	if err := prepareSchema(ctx, db, cfg, drv); err != nil {
 		_ = entClient.Close()
-		return nil, fmt.Errorf("failed to run migrations: %w", err)
+		return nil, err
 	}
 
 	// Wrap in our client type
@@ -124,9 +163,76 @@ func NewClient(ctx context.Context, cfg Config) (*Client, error) {
 		db:     db,
 	}
 
+	if cfg.APIMaxOpenConns > 0 {
+		apiDB, err := openPool(ctx, cfg, cfg.APIMaxOpenConns, cfg.APIMaxIdleConns)
+		if err != nil {
+			_ = entClient.Close()
+			return nil, fmt.Errorf("API pool: %w", err)
+		}
+		client.apiDB = apiDB
+		client.api = ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, apiDB)))
+	}
+
 	return client, nil
 }
 
+// openPool opens and pings a connection pool with the given size; lifetime
+// settings are shared by all pools.
+func openPool(ctx context.Context, cfg Config, maxOpen, maxIdle int) (*stdsql.DB, error) {
+	// Open database connection using pgx driver
+	db, err := stdsql.Open("pgx", cfg.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// Configure connection pool
+	db.SetMaxOpenConns(maxOpen)
+	db.SetMaxIdleConns(maxIdle)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	// Test connection
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	return db, nil
+}
+
+// prepareSchema checks that this binary can run against the database schema
+// and, with cfg.AutoMigrate, applies pending migrations. A schema migrated by
+// a newer binary is accepted as long as its compatibility floor allows this
+// binary (rolling deploys run old and new pods side by side).
+func prepareSchema(ctx context.Context, db *stdsql.DB, cfg Config, drv *entsql.Driver) error {
+	status, err := ReadSchemaStatus(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to read schema status: %w", err)
+	}
+	if err := status.Check(cfg.AutoMigrate); err != nil {
+		return err
+	}
+
+	if status.Newer() {
+		slog.Warn("Database schema is newer than this binary, skipping migrations",
+			"schema_version", status.Version, "binary_latest", status.Latest)
+		return nil
+	}
+	if !cfg.AutoMigrate {
+		slog.Info("Database schema is up to date (auto-migrate disabled)", "schema_version", status.Version)
+		return nil
+	}
+
+	if err := runMigrations(ctx, db, cfg, drv); err != nil {
+		// A newer pod may have migrated past this binary while we waited
+		// for the migration lock; that is fine if it is still compatible.
+		if after, readErr := ReadSchemaStatus(ctx, db); readErr == nil && after.Newer() {
+			return after.Check(false)
+		}
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+	return nil
+}
+
 // runMigrations runs database migrations using golang-migrate with embedded migration files.
 //
 // Migration files are embedded into the binary using go:embed, ensuring they're available
@@ -139,7 +245,11 @@ func NewClient(ctx context.Context, cfg Config) (*Client, error) {
 //  4. Files embedded into binary at compile time
 //  5. Review & commit: Check SQL files, commit to git
 //  6. Deploy: Build binary (migrations embedded automatically)
-//  7. Auto-apply: App applies pending migrations on startup (this function)
+//  7. Auto-apply: App applies pending migrations on startup (this function),
+//     or a dedicated 'tarsy --migrate-only' run does when DB_AUTO_MIGRATE=false
+//
+// golang-migrate serializes concurrent runs with a PostgreSQL advisory lock;
+// pods that start while another pod migrates wait up to MigrationLockTimeout.
 func runMigrations(ctx context.Context, db *stdsql.DB, cfg Config, drv *entsql.Driver) error {
 	// Check if embedded migrations exist
 	hasMigrations, err := hasEmbeddedMigrations()
@@ -167,6 +277,10 @@ func runMigrations(ctx context.Context, db *stdsql.DB, cfg Config, drv *entsql.D
 	if err != nil {
 		return fmt.Errorf("failed to create migrate instance: %w", err)
 	}
+	m.LockTimeout = defaultMigrationLockTimeout
+	if cfg.MigrationLockTimeout > 0 {
+		m.LockTimeout = cfg.MigrationLockTimeout
+	}
 
 	// Apply all pending migrations. If a previous deploy left the DB dirty
 	// (failed migration with transactional DDL), recover automatically by
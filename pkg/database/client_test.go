@@ -2,12 +2,15 @@ package database
 
 import (
 	"context"
+	stdsql "database/sql"
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/test/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -255,6 +258,51 @@ func TestLoadConfigFromEnv(t *testing.T) {
 			wantErr:     true,
 			errContains: "invalid DB_CONN_MAX_IDLE_TIME",
 		},
+		{
+			name: "dedicated API pool",
+			envVars: map[string]string{
+				"DB_API_MAX_OPEN_CONNS": "8",
+				"DB_PASSWORD":           "test",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid DB_API_MAX_OPEN_CONNS",
+			envVars: map[string]string{
+				"DB_API_MAX_OPEN_CONNS": "many",
+				"DB_PASSWORD":           "test",
+			},
+			wantErr:     true,
+			errContains: "invalid DB_API_MAX_OPEN_CONNS",
+		},
+		{
+			name: "API idle conns exceed API max conns",
+			envVars: map[string]string{
+				"DB_API_MAX_OPEN_CONNS": "4",
+				"DB_API_MAX_IDLE_CONNS": "6",
+				"DB_PASSWORD":           "test",
+			},
+			wantErr:     true,
+			errContains: "DB_API_MAX_IDLE_CONNS (6) cannot exceed DB_API_MAX_OPEN_CONNS (4)",
+		},
+		{
+			name: "invalid DB_AUTO_MIGRATE",
+			envVars: map[string]string{
+				"DB_AUTO_MIGRATE": "sometimes",
+				"DB_PASSWORD":     "test",
+			},
+			wantErr:     true,
+			errContains: "invalid DB_AUTO_MIGRATE",
+		},
+		{
+			name: "invalid DB_MIGRATION_LOCK_TIMEOUT",
+			envVars: map[string]string{
+				"DB_MIGRATION_LOCK_TIMEOUT": "forever",
+				"DB_PASSWORD":               "test",
+			},
+			wantErr:     true,
+			errContains: "invalid DB_MIGRATION_LOCK_TIMEOUT",
+		},
 		{
 			name: "missing password",
 			envVars: map[string]string{
@@ -272,6 +320,8 @@ func TestLoadConfigFromEnv(t *testing.T) {
 				"DB_HOST", "DB_PORT", "DB_USER", "DB_PASSWORD", "DB_NAME", "DB_SSLMODE",
 				"DB_MAX_OPEN_CONNS", "DB_MAX_IDLE_CONNS",
 				"DB_CONN_MAX_LIFETIME", "DB_CONN_MAX_IDLE_TIME",
+				"DB_API_MAX_OPEN_CONNS", "DB_API_MAX_IDLE_CONNS",
+				"DB_AUTO_MIGRATE", "DB_MIGRATION_LOCK_TIMEOUT",
 			}
 			for _, key := range envKeys {
 				os.Unsetenv(key)
@@ -308,12 +358,42 @@ func TestLoadConfigFromEnv(t *testing.T) {
 					assert.Equal(t, 5432, cfg.Port)
 					assert.Equal(t, 25, cfg.MaxOpenConns)
 					assert.Equal(t, 10, cfg.MaxIdleConns)
+					assert.Zero(t, cfg.APIMaxOpenConns, "API shares the main pool by default")
+					assert.True(t, cfg.AutoMigrate)
+					assert.Equal(t, 10*time.Minute, cfg.MigrationLockTimeout)
+				}
+				if tt.name == "dedicated API pool" {
+					assert.Equal(t, 8, cfg.APIMaxOpenConns)
+					assert.Equal(t, 8, cfg.APIMaxIdleConns, "idle defaults to DB_MAX_IDLE_CONNS capped at the API pool size")
 				}
 			}
 		})
 	}
 }
 
+func TestClient_Pools(t *testing.T) {
+	db, err := stdsql.Open("pgx", "host=localhost")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	t.Run("API shares the main pool", func(t *testing.T) {
+		c := NewClientFromEnt(ent.NewClient(), db)
+		assert.Same(t, c.Client, c.API())
+		assert.Equal(t, map[string]*stdsql.DB{PoolMain: db}, c.Pools())
+	})
+
+	t.Run("dedicated API pool", func(t *testing.T) {
+		apiDB, err := stdsql.Open("pgx", "host=localhost")
+		require.NoError(t, err)
+		c := NewClientFromEnt(ent.NewClient(), db)
+		c.apiDB = apiDB
+		c.api = ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, apiDB)))
+		assert.NotSame(t, c.Client, c.API())
+		assert.Equal(t, map[string]*stdsql.DB{PoolMain: db, PoolAPI: apiDB}, c.Pools())
+		require.NoError(t, c.api.Close())
+	})
+}
+
 func TestHealthStatus_JSONMilliseconds(t *testing.T) {
 	client := newTestClient(t)
 	ctx := context.Background()
@@ -447,6 +527,20 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "negative API max conns",
+			cfg: Config{
+				Host:            "localhost",
+				Port:            5432,
+				User:            "test",
+				Password:        "test",
+				Database:        "test",
+				MaxOpenConns:    10,
+				MaxIdleConns:    5,
+				APIMaxOpenConns: -1,
+			},
+			wantErr: true,
+		},
 		{
 			name: "negative idle conns",
 			cfg: Config{
@@ -37,6 +37,27 @@ func LoadConfigFromEnv() (Config, error) {
 		return Config{}, fmt.Errorf("invalid DB_CONN_MAX_IDLE_TIME: %w", err)
 	}
 
+	// Dedicated API pool: off (0) by default, the API shares the main pool
+	apiMaxOpen, err := strconv.Atoi(getEnvOrDefault("DB_API_MAX_OPEN_CONNS", "0"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid DB_API_MAX_OPEN_CONNS: %w", err)
+	}
+
+	apiMaxIdle, err := strconv.Atoi(getEnvOrDefault("DB_API_MAX_IDLE_CONNS", strconv.Itoa(min(maxIdle, apiMaxOpen))))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid DB_API_MAX_IDLE_CONNS: %w", err)
+	}
+
+	autoMigrate, err := strconv.ParseBool(getEnvOrDefault("DB_AUTO_MIGRATE", "true"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid DB_AUTO_MIGRATE: %w", err)
+	}
+
+	lockTimeout, err := parseDuration(getEnvOrDefault("DB_MIGRATION_LOCK_TIMEOUT", "10m"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid DB_MIGRATION_LOCK_TIMEOUT: %w", err)
+	}
+
 	cfg := Config{
 		Host:            getEnvOrDefault("DB_HOST", "localhost"),
 		Port:            port,
@@ -48,6 +69,11 @@ func LoadConfigFromEnv() (Config, error) {
 		MaxIdleConns:    maxIdle,
 		ConnMaxLifetime: maxLifetime,
 		ConnMaxIdleTime: maxIdleTime,
+		APIMaxOpenConns: apiMaxOpen,
+		APIMaxIdleConns: apiMaxIdle,
+
+		AutoMigrate:          autoMigrate,
+		MigrationLockTimeout: lockTimeout,
 	}
 
 	// Validate configuration
@@ -73,6 +99,16 @@ func (c Config) Validate() error {
 	if c.MaxIdleConns < 0 {
 		return fmt.Errorf("DB_MAX_IDLE_CONNS cannot be negative")
 	}
+	if c.APIMaxOpenConns < 0 {
+		return fmt.Errorf("DB_API_MAX_OPEN_CONNS cannot be negative")
+	}
+	if c.APIMaxOpenConns > 0 && c.APIMaxIdleConns > c.APIMaxOpenConns {
+		return fmt.Errorf("DB_API_MAX_IDLE_CONNS (%d) cannot exceed DB_API_MAX_OPEN_CONNS (%d)",
+			c.APIMaxIdleConns, c.APIMaxOpenConns)
+	}
+	if c.APIMaxIdleConns < 0 {
+		return fmt.Errorf("DB_API_MAX_IDLE_CONNS cannot be negative")
+	}
 	return nil
 }
 
@@ -95,7 +95,8 @@ func TestMigrations_EntParity(t *testing.T) {
 
 	// Compare columns for each shared table.
 	// The investigation_memories.embedding column is raw SQL (pgvector type),
-	// not managed by Ent, so we exclude it from the parity comparison.
+	// and the search vectors are raw SQL too; none are managed by Ent, so we
+	// exclude them from the parity comparison.
 	sharedTables := intersect(migTables, entTables)
 	for _, table := range sharedTables {
 		migCols := queryColumnTypes(t, dbMig, "public", table)
@@ -106,6 +107,8 @@ func TestMigrations_EntParity(t *testing.T) {
 			migCols = filterColumns(migCols, "embedding", "search_vector")
 		case "alert_sessions":
 			migCols = filterColumns(migCols, "search_vector")
+		case "timeline_events":
+			migCols = filterColumns(migCols, "content_tsv")
 		}
 
 		assert.Equal(t, entCols, migCols,
@@ -383,3 +386,40 @@ func latestMigrationVersion(t *testing.T) uint {
 	require.Greater(t, latest, uint64(0))
 	return uint(latest)
 }
+
+// TestPrepareSchema_Compatibility covers the startup compatibility check:
+// pending migrations with auto-migrate off, a schema migrated by a newer
+// binary, and a compatibility floor that excludes this binary.
+func TestPrepareSchema_Compatibility(t *testing.T) {
+	ctx := context.Background()
+	db, dbName := setupMigrationTestDB(t)
+	drv := entsql.OpenDB(dialect.Postgres, db)
+	latest := latestMigrationVersion(t)
+
+	err := prepareSchema(ctx, db, Config{Database: dbName}, drv)
+	require.ErrorIs(t, err, ErrIncompatibleSchema, "fresh database with auto-migrate off must be refused")
+	assert.Contains(t, err.Error(), "--migrate-only")
+
+	err = prepareSchema(ctx, db, Config{Database: dbName, AutoMigrate: true}, drv)
+	require.NoError(t, err)
+
+	status, err := ReadSchemaStatus(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, latest, status.Version)
+	assert.Equal(t, latest, status.Latest)
+	assert.Zero(t, status.Pending)
+	assert.False(t, status.Dirty)
+
+	// A newer binary migrated past this one: still compatible, nothing to apply.
+	_, err = db.ExecContext(ctx, "UPDATE schema_migrations SET version = $1", latest+1)
+	require.NoError(t, err)
+	require.NoError(t, prepareSchema(ctx, db, Config{Database: dbName, AutoMigrate: true}, drv))
+
+	// ...until a breaking migration raises the compatibility floor.
+	_, err = db.ExecContext(ctx,
+		"UPDATE schema_compatibilities SET min_version = $1, reason = 'dropped legacy column' WHERE id = 1", latest+1)
+	require.NoError(t, err)
+	err = prepareSchema(ctx, db, Config{Database: dbName, AutoMigrate: true}, drv)
+	require.ErrorIs(t, err, ErrIncompatibleSchema)
+	assert.Contains(t, err.Error(), "dropped legacy column")
+}
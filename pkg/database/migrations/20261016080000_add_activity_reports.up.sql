@@ -0,0 +1,16 @@
+-- create "activity_reports" table
+CREATE TABLE "public"."activity_reports" (
+  "report_id" character varying NOT NULL,
+  "period" character varying NOT NULL,
+  "window_start" timestamptz NOT NULL,
+  "window_end" timestamptz NOT NULL,
+  "stats" jsonb NOT NULL,
+  "narrative" text NULL,
+  "narrative_error" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("report_id")
+);
+-- create index "activityreport_created_at" to table: "activity_reports"
+CREATE INDEX "activityreport_created_at" ON "public"."activity_reports" ("created_at");
+-- create index "activityreport_period_window_start" to table: "activity_reports"
+CREATE UNIQUE INDEX "activityreport_period_window_start" ON "public"."activity_reports" ("period", "window_start");
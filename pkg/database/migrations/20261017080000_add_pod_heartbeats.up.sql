@@ -0,0 +1,11 @@
+-- create "pod_heartbeats" table
+CREATE TABLE "public"."pod_heartbeats" (
+  "pod_id" character varying NOT NULL,
+  "config_hash" character varying NOT NULL,
+  "version" character varying NOT NULL,
+  "started_at" timestamptz NOT NULL,
+  "last_seen_at" timestamptz NOT NULL,
+  PRIMARY KEY ("pod_id")
+);
+-- create index "podheartbeat_last_seen_at" to table: "pod_heartbeats"
+CREATE INDEX "podheartbeat_last_seen_at" ON "public"."pod_heartbeats" ("last_seen_at");
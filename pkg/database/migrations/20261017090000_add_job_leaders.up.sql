@@ -0,0 +1,8 @@
+-- create "job_leaders" table
+CREATE TABLE "public"."job_leaders" (
+  "job" character varying NOT NULL,
+  "pod_id" character varying NOT NULL,
+  "acquired_at" timestamptz NOT NULL,
+  "renewed_at" timestamptz NOT NULL,
+  PRIMARY KEY ("job")
+);
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- create "schema_compatibilities" table
+CREATE TABLE "public"."schema_compatibilities" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "min_version" bigint NOT NULL DEFAULT 0,
+  "reason" character varying NULL,
+  PRIMARY KEY ("id")
+);
+
+-- Seed the singleton row read by the startup compatibility check.
+-- Breaking migrations raise it with:
+--   UPDATE "schema_compatibilities" SET "min_version" = <own version>, "reason" = '...' WHERE "id" = 1;
+INSERT INTO "public"."schema_compatibilities" ("id", "min_version") VALUES (1, 0);
+
+COMMIT;
@@ -0,0 +1,8 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "request_id" character varying NULL;
+-- create index "alertsession_request_id" to table: "alert_sessions"
+CREATE INDEX "alertsession_request_id" ON "public"."alert_sessions" ("request_id");
+-- modify "llm_interactions" table
+ALTER TABLE "public"."llm_interactions" ADD COLUMN "request_id" character varying NULL;
+-- modify "mcp_interactions" table
+ALTER TABLE "public"."mcp_interactions" ADD COLUMN "request_id" character varying NULL;
@@ -0,0 +1,17 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "author_subject" character varying NULL;
+-- modify "chat_user_messages" table
+ALTER TABLE "public"."chat_user_messages" ADD COLUMN "author_subject" character varying NULL;
+-- create "user_profiles" table
+CREATE TABLE "public"."user_profiles" (
+  "subject" character varying NOT NULL,
+  "display_name" character varying NULL,
+  "email" character varying NULL,
+  "preferences" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "last_seen_at" timestamptz NOT NULL,
+  PRIMARY KEY ("subject")
+);
+-- create index "userprofile_email" to table: "user_profiles"
+CREATE INDEX "userprofile_email" ON "public"."user_profiles" ("email");
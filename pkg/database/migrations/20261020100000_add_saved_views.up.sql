@@ -0,0 +1,14 @@
+-- create "saved_views" table
+CREATE TABLE "public"."saved_views" (
+  "view_id" character varying NOT NULL,
+  "owner" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "filter" jsonb NOT NULL,
+  "notify" boolean NOT NULL DEFAULT false,
+  "slack_channel" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("view_id")
+);
+-- create index "savedview_owner_name" to table: "saved_views"
+CREATE UNIQUE INDEX "savedview_owner_name" ON "public"."saved_views" ("owner", "name");
@@ -0,0 +1,4 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "alert_key" character varying NULL;
+-- create index "alertsession_alert_key" to table: "alert_sessions"
+CREATE INDEX "alertsession_alert_key" ON "public"."alert_sessions" ("alert_key");
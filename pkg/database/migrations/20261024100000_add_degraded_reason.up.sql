@@ -0,0 +1,2 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "degraded_reason" character varying NULL;
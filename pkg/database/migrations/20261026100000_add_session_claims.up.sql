@@ -0,0 +1,19 @@
+-- create "session_claims" table
+CREATE TABLE "public"."session_claims" (
+  "claim_id" character varying NOT NULL,
+  "pod_id" character varying NOT NULL,
+  "worker_id" character varying NOT NULL,
+  "claimed_at" timestamptz NOT NULL,
+  "queue_wait_ms" bigint NOT NULL,
+  "released_at" timestamptz NULL,
+  "outcome" character varying NULL,
+  "last_heartbeat_at" timestamptz NULL,
+  "detail" text NULL,
+  "session_id" character varying NOT NULL,
+  PRIMARY KEY ("claim_id"),
+  CONSTRAINT "session_claims_alert_sessions_claims" FOREIGN KEY ("session_id") REFERENCES "public"."alert_sessions" ("session_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "sessionclaim_released_at" to table: "session_claims"
+CREATE INDEX "sessionclaim_released_at" ON "public"."session_claims" ("released_at");
+-- create index "sessionclaim_session_id_claimed_at" to table: "session_claims"
+CREATE INDEX "sessionclaim_session_id_claimed_at" ON "public"."session_claims" ("session_id", "claimed_at");
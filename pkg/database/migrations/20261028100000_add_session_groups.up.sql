@@ -0,0 +1,18 @@
+-- create "session_groups" table
+CREATE TABLE "public"."session_groups" (
+  "group_id" character varying NOT NULL,
+  "alert_type" character varying NULL,
+  "primary_chain_id" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "synthesis_status" character varying NOT NULL DEFAULT 'pending',
+  "summary" text NULL,
+  "summary_error" character varying NULL,
+  "synthesized_at" timestamptz NULL,
+  PRIMARY KEY ("group_id")
+);
+-- create index "sessiongroup_created_at" to table: "session_groups"
+CREATE INDEX "sessiongroup_created_at" ON "public"."session_groups" ("created_at");
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "group_id" character varying NULL, ADD CONSTRAINT "alert_sessions_session_groups_sessions" FOREIGN KEY ("group_id") REFERENCES "public"."session_groups" ("group_id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- create index "alertsession_group_id" to table: "alert_sessions"
+CREATE INDEX "alertsession_group_id" ON "public"."alert_sessions" ("group_id");
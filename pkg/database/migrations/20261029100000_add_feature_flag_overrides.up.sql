@@ -0,0 +1,11 @@
+-- create "feature_flag_overrides" table
+CREATE TABLE "public"."feature_flag_overrides" (
+  "flag" character varying NOT NULL,
+  "enabled" boolean NOT NULL,
+  "percentage" bigint NULL,
+  "alert_types" jsonb NULL,
+  "chains" jsonb NULL,
+  "updated_by" character varying NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("flag")
+);
@@ -0,0 +1,2 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "cancel_initiator" character varying NULL, ADD COLUMN "cancel_reason" text NULL, ADD COLUMN "cancelled_by" character varying NULL;
@@ -0,0 +1,9 @@
+-- create "queue_pauses" table
+CREATE TABLE "public"."queue_pauses" (
+  "scope" character varying NOT NULL,
+  "reason" text NULL,
+  "maintenance" boolean NOT NULL DEFAULT false,
+  "paused_by" character varying NULL,
+  "paused_at" timestamptz NOT NULL,
+  PRIMARY KEY ("scope")
+);
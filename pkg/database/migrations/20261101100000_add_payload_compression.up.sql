@@ -0,0 +1,15 @@
+-- modify "timeline_events" table
+ALTER TABLE "public"."timeline_events" ADD COLUMN "content_zstd" bytea NULL;
+-- modify "llm_interactions" table
+ALTER TABLE "public"."llm_interactions" ADD COLUMN "payload_zstd" bytea NULL;
+-- modify "mcp_interactions" table
+ALTER TABLE "public"."mcp_interactions" ADD COLUMN "tool_result_zstd" bytea NULL, ADD COLUMN "tool_result_bytes" bigint NULL;
+
+-- Search vector for compressed timeline content (tsvector — not managed by Ent).
+-- PostgreSQL cannot read content_zstd, so when content is stored compressed
+-- the services layer sets content_tsv via a raw SQL expression in the same
+-- UPDATE; it is NULL for uncompressed rows, which are searched through the
+-- content column as before.
+ALTER TABLE "public"."timeline_events" ADD COLUMN "content_tsv" tsvector NULL;
+CREATE INDEX "idx_timeline_events_content_tsv"
+  ON "public"."timeline_events" USING gin ("content_tsv");
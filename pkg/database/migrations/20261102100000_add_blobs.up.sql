@@ -0,0 +1,11 @@
+-- create "blobs" table
+CREATE TABLE "public"."blobs" (
+  "key" character varying NOT NULL,
+  "object_oid" oid NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "content_type" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("key")
+);
+-- modify "mcp_interactions" table
+ALTER TABLE "public"."mcp_interactions" ADD COLUMN "tool_result_blob" character varying NULL;
@@ -0,0 +1,6 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "alert_images" jsonb NULL;
+-- modify "chat_user_messages" table
+ALTER TABLE "public"."chat_user_messages" ADD COLUMN "images" jsonb NULL;
+-- modify "messages" table
+ALTER TABLE "public"."messages" ADD COLUMN "images" jsonb NULL;
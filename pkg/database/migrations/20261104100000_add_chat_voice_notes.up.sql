@@ -0,0 +1,2 @@
+-- modify "chat_user_messages" table
+ALTER TABLE "public"."chat_user_messages" ADD COLUMN "voice_note" jsonb NULL;
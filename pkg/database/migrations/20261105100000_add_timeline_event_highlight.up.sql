@@ -0,0 +1,2 @@
+-- modify "timeline_events" table
+ALTER TABLE "public"."timeline_events" ADD COLUMN "highlight" character varying NULL;
@@ -0,0 +1,2 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "callback_url" character varying NULL, ADD COLUMN "callback_secret" character varying NULL, ADD COLUMN "callback_status" character varying NULL, ADD COLUMN "callback_attempts" bigint NOT NULL DEFAULT 0, ADD COLUMN "callback_last_error" text NULL, ADD COLUMN "callback_delivered_at" timestamptz NULL;
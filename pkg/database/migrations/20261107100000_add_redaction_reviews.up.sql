@@ -0,0 +1,27 @@
+-- create "redaction_reviews" table
+CREATE TABLE "public"."redaction_reviews" (
+  "review_id" character varying NOT NULL,
+  "mcp_interaction_id" character varying NULL,
+  "server_name" character varying NOT NULL,
+  "tool_name" character varying NULL,
+  "heuristic" character varying NOT NULL,
+  "fingerprint" character varying NOT NULL,
+  "value" text NULL,
+  "preview" character varying NOT NULL,
+  "context" text NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "reviewed_by" character varying NULL,
+  "reviewed_at" timestamptz NULL,
+  "note" text NULL,
+  "rows_redacted" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "session_id" character varying NOT NULL,
+  PRIMARY KEY ("review_id"),
+  CONSTRAINT "redaction_reviews_alert_sessions_redaction_reviews" FOREIGN KEY ("session_id") REFERENCES "public"."alert_sessions" ("session_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "redactionreview_fingerprint" to table: "redaction_reviews"
+CREATE INDEX "redactionreview_fingerprint" ON "public"."redaction_reviews" ("fingerprint");
+-- create index "redactionreview_session_id_fingerprint" to table: "redaction_reviews"
+CREATE UNIQUE INDEX "redactionreview_session_id_fingerprint" ON "public"."redaction_reviews" ("session_id", "fingerprint");
+-- create index "redactionreview_status_created_at" to table: "redaction_reviews"
+CREATE INDEX "redactionreview_status_created_at" ON "public"."redaction_reviews" ("status", "created_at");
@@ -0,0 +1,4 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "duplicated_from" character varying NULL, ADD COLUMN "llm_provider" character varying NULL;
+-- create index "alertsession_duplicated_from" to table: "alert_sessions"
+CREATE INDEX "alertsession_duplicated_from" ON "public"."alert_sessions" ("duplicated_from");
@@ -0,0 +1,14 @@
+-- create "config_registrations" table
+CREATE TABLE "public"."config_registrations" (
+  "registration_id" character varying NOT NULL,
+  "kind" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "version" bigint NOT NULL,
+  "definition" text NULL,
+  "deleted" boolean NOT NULL DEFAULT false,
+  "created_by" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("registration_id")
+);
+-- create index "configregistration_kind_name_version" to table: "config_registrations"
+CREATE UNIQUE INDEX "configregistration_kind_name_version" ON "public"."config_registrations" ("kind", "name", "version");
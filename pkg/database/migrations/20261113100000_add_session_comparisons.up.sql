@@ -0,0 +1,23 @@
+-- create "session_comparisons" table
+CREATE TABLE "public"."session_comparisons" (
+  "comparison_id" character varying NOT NULL,
+  "alert_key" character varying NOT NULL,
+  "status" character varying NOT NULL,
+  "previous_conclusion" text NULL,
+  "current_conclusion" text NULL,
+  "differences" jsonb NULL,
+  "same_root_cause" boolean NULL,
+  "error_message" text NULL,
+  "created_at" timestamptz NOT NULL,
+  "session_id" character varying NOT NULL,
+  "previous_session_id" character varying NOT NULL,
+  PRIMARY KEY ("comparison_id"),
+  CONSTRAINT "session_comparisons_alert_sessions_comparison" FOREIGN KEY ("session_id") REFERENCES "public"."alert_sessions" ("session_id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "session_comparisons_alert_sessions_next_comparisons" FOREIGN KEY ("previous_session_id") REFERENCES "public"."alert_sessions" ("session_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "session_comparisons_session_id_key" to table: "session_comparisons"
+CREATE UNIQUE INDEX "session_comparisons_session_id_key" ON "public"."session_comparisons" ("session_id");
+-- create index "sessioncomparison_alert_key" to table: "session_comparisons"
+CREATE INDEX "sessioncomparison_alert_key" ON "public"."session_comparisons" ("alert_key");
+-- create index "sessioncomparison_previous_session_id" to table: "session_comparisons"
+CREATE INDEX "sessioncomparison_previous_session_id" ON "public"."session_comparisons" ("previous_session_id");
@@ -0,0 +1,2 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "noise_triage" jsonb NULL, ADD COLUMN "force_full_investigation" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- modify "queue_pauses" table
+ALTER TABLE "public"."queue_pauses" ADD COLUMN "drain" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- modify "agent_executions" table
+ALTER TABLE "public"."agent_executions" ADD COLUMN "tool_call_count" bigint NOT NULL DEFAULT 0;
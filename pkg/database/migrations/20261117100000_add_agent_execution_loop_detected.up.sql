@@ -0,0 +1,2 @@
+-- modify "agent_executions" table
+ALTER TABLE "public"."agent_executions" ADD COLUMN "loop_detected" boolean NOT NULL DEFAULT false;
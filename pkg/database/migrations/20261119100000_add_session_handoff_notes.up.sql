@@ -0,0 +1,15 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "handoff_notes" text NULL, ADD COLUMN "handoff_notes_revision" bigint NOT NULL DEFAULT 0, ADD COLUMN "handoff_notes_updated_by" character varying NULL, ADD COLUMN "handoff_notes_updated_at" timestamptz NULL;
+-- create "handoff_note_revisions" table
+CREATE TABLE "public"."handoff_note_revisions" (
+  "revision_id" character varying NOT NULL,
+  "revision" bigint NOT NULL,
+  "content" text NOT NULL,
+  "author" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "session_id" character varying NOT NULL,
+  PRIMARY KEY ("revision_id"),
+  CONSTRAINT "handoff_note_revisions_alert_sessions_handoff_note_revisions" FOREIGN KEY ("session_id") REFERENCES "public"."alert_sessions" ("session_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "handoffnoterevision_session_id_revision" to table: "handoff_note_revisions"
+CREATE UNIQUE INDEX "handoffnoterevision_session_id_revision" ON "public"."handoff_note_revisions" ("session_id", "revision");
@@ -0,0 +1,26 @@
+-- modify "alert_sessions" table
+-- alert_sessions.updated_at and chats.updated_at are backfilled in batches
+-- and made NOT NULL by 20261120110000_backfill_updated_at; the default keeps
+-- rows inserted by the previous release valid until then.
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "updated_at" timestamptz NULL DEFAULT now();
+-- modify "chats" table
+ALTER TABLE "public"."chats" ADD COLUMN "updated_at" timestamptz NULL DEFAULT now(), ADD COLUMN "updated_by" character varying NULL;
+-- modify "config_registrations" table
+ALTER TABLE "public"."config_registrations" ADD COLUMN "updated_by" character varying NULL;
+UPDATE "public"."config_registrations" SET "updated_by" = "created_by";
+-- modify "feature_flag_overrides" table
+ALTER TABLE "public"."feature_flag_overrides" ADD COLUMN "created_at" timestamptz NULL, ADD COLUMN "created_by" character varying NULL;
+UPDATE "public"."feature_flag_overrides" SET "created_at" = "updated_at";
+ALTER TABLE "public"."feature_flag_overrides" ALTER COLUMN "created_at" SET NOT NULL;
+-- modify "investigation_memories" table
+ALTER TABLE "public"."investigation_memories" ADD COLUMN "created_by" character varying NULL, ADD COLUMN "updated_by" character varying NULL;
+-- modify "redaction_reviews" table
+ALTER TABLE "public"."redaction_reviews" ADD COLUMN "updated_at" timestamptz NULL;
+UPDATE "public"."redaction_reviews" SET "updated_at" = GREATEST("created_at", "reviewed_at");
+ALTER TABLE "public"."redaction_reviews" ALTER COLUMN "updated_at" SET NOT NULL;
+-- modify "saved_views" table
+ALTER TABLE "public"."saved_views" ADD COLUMN "created_by" character varying NULL, ADD COLUMN "updated_by" character varying NULL;
+-- modify "session_groups" table
+ALTER TABLE "public"."session_groups" ADD COLUMN "updated_at" timestamptz NULL;
+UPDATE "public"."session_groups" SET "updated_at" = GREATEST("created_at", "synthesized_at");
+ALTER TABLE "public"."session_groups" ALTER COLUMN "updated_at" SET NOT NULL;
@@ -0,0 +1,79 @@
+-- atlas:txmode none
+
+-- ============================================================
+-- Backfill alert_sessions.updated_at and chats.updated_at
+--
+-- Both tables grow with every session, so instead of one
+-- full-table UPDATE the backfill commits in batches of 5000
+-- rows. NOT NULL is then applied through a CHECK constraint
+-- that is validated without blocking writes; SET NOT NULL
+-- reuses it and skips its own scan under an exclusive lock.
+--
+-- The file is a single DO statement so the batches can COMMIT
+-- (not possible inside a multi-statement query). Every step
+-- is idempotent, so a rerun after a failure is safe.
+-- ============================================================
+
+DO $$
+DECLARE
+  last_id character varying := '';
+  batch_last character varying;
+BEGIN
+  LOOP
+    WITH batch AS (
+      SELECT "session_id" FROM "public"."alert_sessions"
+      WHERE "session_id" > last_id
+      ORDER BY "session_id"
+      LIMIT 5000
+    ), updated AS (
+      UPDATE "public"."alert_sessions" s
+      SET "updated_at" = GREATEST(s."created_at", s."started_at", s."last_interaction_at", s."completed_at", s."deleted_at")
+      FROM batch
+      WHERE s."session_id" = batch."session_id"
+      RETURNING s."session_id"
+    )
+    SELECT max("session_id") INTO batch_last FROM updated;
+    EXIT WHEN batch_last IS NULL;
+    last_id := batch_last;
+    COMMIT;
+  END LOOP;
+
+  ALTER TABLE "public"."alert_sessions" DROP CONSTRAINT IF EXISTS "alert_sessions_updated_at_not_null";
+  ALTER TABLE "public"."alert_sessions" ADD CONSTRAINT "alert_sessions_updated_at_not_null" CHECK ("updated_at" IS NOT NULL) NOT VALID;
+  COMMIT;
+  ALTER TABLE "public"."alert_sessions" VALIDATE CONSTRAINT "alert_sessions_updated_at_not_null";
+  COMMIT;
+  ALTER TABLE "public"."alert_sessions" ALTER COLUMN "updated_at" SET NOT NULL;
+  ALTER TABLE "public"."alert_sessions" DROP CONSTRAINT "alert_sessions_updated_at_not_null";
+  COMMIT;
+
+  last_id := '';
+  LOOP
+    WITH batch AS (
+      SELECT "chat_id" FROM "public"."chats"
+      WHERE "chat_id" > last_id
+      ORDER BY "chat_id"
+      LIMIT 5000
+    ), updated AS (
+      UPDATE "public"."chats" c
+      SET "updated_at" = GREATEST(c."created_at", c."last_interaction_at")
+      FROM batch
+      WHERE c."chat_id" = batch."chat_id"
+      RETURNING c."chat_id"
+    )
+    SELECT max("chat_id") INTO batch_last FROM updated;
+    EXIT WHEN batch_last IS NULL;
+    last_id := batch_last;
+    COMMIT;
+  END LOOP;
+
+  ALTER TABLE "public"."chats" DROP CONSTRAINT IF EXISTS "chats_updated_at_not_null";
+  ALTER TABLE "public"."chats" ADD CONSTRAINT "chats_updated_at_not_null" CHECK ("updated_at" IS NOT NULL) NOT VALID;
+  COMMIT;
+  ALTER TABLE "public"."chats" VALIDATE CONSTRAINT "chats_updated_at_not_null";
+  COMMIT;
+  ALTER TABLE "public"."chats" ALTER COLUMN "updated_at" SET NOT NULL;
+  ALTER TABLE "public"."chats" DROP CONSTRAINT "chats_updated_at_not_null";
+  COMMIT;
+END
+$$;
@@ -0,0 +1,6 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "owner" character varying NULL;
+-- create index "alertsession_owner" to table: "alert_sessions"
+CREATE INDEX "alertsession_owner" ON "public"."alert_sessions" ("owner");
+-- modify "llm_interactions" table
+ALTER TABLE "public"."llm_interactions" ADD COLUMN "owner" character varying NULL;
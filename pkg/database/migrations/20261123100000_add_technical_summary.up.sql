@@ -0,0 +1,2 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "technical_summary" text NULL, ADD COLUMN "technical_summary_error" character varying NULL;
@@ -0,0 +1,4 @@
+-- modify "alert_sessions" table
+ALTER TABLE "public"."alert_sessions" ADD COLUMN "outcome" character varying NULL, ADD COLUMN "outcome_reason" text NULL, ADD COLUMN "outcome_source" character varying NULL;
+-- create index "alertsession_outcome" to table: "alert_sessions"
+CREATE INDEX "alertsession_outcome" ON "public"."alert_sessions" ("outcome");
@@ -0,0 +1,22 @@
+-- create "action_items" table
+CREATE TABLE "public"."action_items" (
+  "action_item_id" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "created_by" character varying NULL,
+  "updated_by" character varying NULL,
+  "title" character varying NOT NULL,
+  "details" text NULL,
+  "status" character varying NOT NULL DEFAULT 'open',
+  "source" character varying NOT NULL,
+  "assignee" character varying NULL,
+  "completed_at" timestamptz NULL,
+  "completed_by" character varying NULL,
+  "session_id" character varying NOT NULL,
+  PRIMARY KEY ("action_item_id"),
+  CONSTRAINT "action_items_alert_sessions_action_items" FOREIGN KEY ("session_id") REFERENCES "public"."alert_sessions" ("session_id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "actionitem_session_id" to table: "action_items"
+CREATE INDEX "actionitem_session_id" ON "public"."action_items" ("session_id");
+-- create index "actionitem_status_created_at" to table: "action_items"
+CREATE INDEX "actionitem_status_created_at" ON "public"."action_items" ("status", "created_at");
@@ -0,0 +1,2 @@
+-- modify "feature_flag_overrides" table
+ALTER TABLE "public"."feature_flag_overrides" ADD COLUMN "namespaces" jsonb NULL;
@@ -0,0 +1,164 @@
+package database
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// ErrIncompatibleSchema is returned when this binary cannot safely run
+// against the database schema (pending migrations with auto-migrate off,
+// a dirty migration it cannot recover, or a newer schema whose
+// compatibility floor excludes this binary).
+var ErrIncompatibleSchema = errors.New("incompatible database schema")
+
+// SchemaStatus compares the database schema version with the migrations
+// embedded in this binary.
+type SchemaStatus struct {
+	// Version is the last applied migration (0 = none).
+	Version uint
+	// Dirty is set while a migration is running or after one failed.
+	Dirty bool
+	// Latest is the newest migration embedded in this binary.
+	Latest uint
+	// Pending is the number of embedded migrations newer than Version.
+	Pending int
+	// MinVersion is the compatibility floor recorded by breaking migrations:
+	// binaries whose Latest is below it must not run.
+	MinVersion uint
+	// Reason explains MinVersion, if set.
+	Reason string
+}
+
+// Newer reports whether the database was migrated by a newer binary.
+func (s SchemaStatus) Newer() bool {
+	return s.Version > s.Latest
+}
+
+// Check returns an ErrIncompatibleSchema error describing why this binary
+// must not start, or nil. With autoMigrate, pending and dirty migrations
+// known to this binary are fine — runMigrations applies or recovers them.
+func (s SchemaStatus) Check(autoMigrate bool) error {
+	if s.MinVersion > s.Latest {
+		msg := fmt.Sprintf("database schema requires a binary that includes migration %d; this binary only includes migrations up to %d", s.MinVersion, s.Latest)
+		if s.Reason != "" {
+			msg += " (" + s.Reason + ")"
+		}
+		return fmt.Errorf("%w: %s — upgrade TARSy", ErrIncompatibleSchema, msg)
+	}
+
+	if s.Newer() {
+		if s.Dirty {
+			return fmt.Errorf("%w: newer migration %d is in progress or failed and is unknown to this binary (latest %d) — wait for the migration to finish or upgrade TARSy",
+				ErrIncompatibleSchema, s.Version, s.Latest)
+		}
+		return nil
+	}
+
+	if autoMigrate {
+		return nil
+	}
+	if s.Dirty {
+		return fmt.Errorf("%w: migration %d is in progress or failed (dirty) — wait for it to finish or run 'tarsy --migrate-only' to recover",
+			ErrIncompatibleSchema, s.Version)
+	}
+	if s.Pending > 0 {
+		return fmt.Errorf("%w: database schema is at version %d but this binary requires %d (%d pending migrations) — run 'tarsy --migrate-only' or set DB_AUTO_MIGRATE=true",
+			ErrIncompatibleSchema, s.Version, s.Latest, s.Pending)
+	}
+	return nil
+}
+
+// ReadSchemaStatus reads the applied migration version and compatibility
+// floor from db and compares them with the embedded migrations.
+func ReadSchemaStatus(ctx context.Context, db *stdsql.DB) (SchemaStatus, error) {
+	versions, err := embeddedVersions()
+	if err != nil {
+		return SchemaStatus{}, err
+	}
+
+	var status SchemaStatus
+	if len(versions) > 0 {
+		status.Latest = versions[len(versions)-1]
+	}
+
+	exists, err := tableExists(ctx, db, "schema_migrations")
+	if err != nil {
+		return SchemaStatus{}, err
+	}
+	if exists {
+		var version int64
+		err := db.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &status.Dirty)
+		if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+			return SchemaStatus{}, fmt.Errorf("failed to read schema version: %w", err)
+		}
+		if version > 0 {
+			status.Version = uint(version)
+		}
+	}
+
+	for _, v := range versions {
+		if v > status.Version {
+			status.Pending++
+		}
+	}
+
+	exists, err = tableExists(ctx, db, "schema_compatibilities")
+	if err != nil {
+		return SchemaStatus{}, err
+	}
+	if exists {
+		var minVersion int64
+		var reason stdsql.NullString
+		err := db.QueryRowContext(ctx, `SELECT min_version, reason FROM schema_compatibilities WHERE id = 1`).Scan(&minVersion, &reason)
+		if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+			return SchemaStatus{}, fmt.Errorf("failed to read schema compatibility: %w", err)
+		}
+		if minVersion > 0 {
+			status.MinVersion = uint(minVersion)
+		}
+		status.Reason = reason.String
+	}
+
+	return status, nil
+}
+
+func tableExists(ctx context.Context, db *stdsql.DB, table string) (bool, error) {
+	var exists bool
+	if err := db.QueryRowContext(ctx, `SELECT to_regclass($1) IS NOT NULL`, table).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check for table %s: %w", table, err)
+	}
+	return exists, nil
+}
+
+// embeddedVersions returns the versions of the embedded migrations in
+// ascending order, parsed from their "<version>_<name>.up.sql" file names.
+func embeddedVersions() ([]uint, error) {
+	entries, err := fs.ReadDir(migrationsFS, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	var versions []uint
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".up.sql") {
+			continue
+		}
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %q: %w", name, err)
+		}
+		versions = append(versions, uint(v))
+	}
+	// fs.ReadDir returns entries sorted by name; fixed-width timestamps sort numerically.
+	return versions, nil
+}
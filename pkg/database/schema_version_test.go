@@ -0,0 +1,78 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaStatus_Check(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      SchemaStatus
+		autoMigrate bool
+		errContains string // empty = compatible
+	}{
+		{
+			name:   "up to date",
+			status: SchemaStatus{Version: 3, Latest: 3},
+		},
+		{
+			name:        "pending with auto-migrate",
+			status:      SchemaStatus{Version: 2, Latest: 3, Pending: 1},
+			autoMigrate: true,
+		},
+		{
+			name:        "pending without auto-migrate",
+			status:      SchemaStatus{Version: 2, Latest: 3, Pending: 1},
+			errContains: "1 pending migrations",
+		},
+		{
+			name:        "dirty with auto-migrate is recovered by migrations",
+			status:      SchemaStatus{Version: 3, Latest: 3, Dirty: true},
+			autoMigrate: true,
+		},
+		{
+			name:        "dirty without auto-migrate",
+			status:      SchemaStatus{Version: 3, Latest: 3, Dirty: true},
+			errContains: "dirty",
+		},
+		{
+			name:   "newer schema within floor",
+			status: SchemaStatus{Version: 5, Latest: 3, MinVersion: 2},
+		},
+		{
+			name:        "newer schema mid-migration",
+			status:      SchemaStatus{Version: 5, Latest: 3, Dirty: true},
+			autoMigrate: true,
+			errContains: "newer migration 5",
+		},
+		{
+			name:        "floor above binary",
+			status:      SchemaStatus{Version: 5, Latest: 3, MinVersion: 4, Reason: "renamed alert_data"},
+			autoMigrate: true,
+			errContains: "includes migration 4; this binary only includes migrations up to 3 (renamed alert_data)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.status.Check(tt.autoMigrate)
+			if tt.errContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrIncompatibleSchema)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestEmbeddedVersions(t *testing.T) {
+	versions, err := embeddedVersions()
+	require.NoError(t, err)
+	require.Len(t, versions, countUpMigrations(t))
+	assert.IsNonDecreasing(t, versions)
+	assert.Equal(t, latestMigrationVersion(t), versions[len(versions)-1])
+}
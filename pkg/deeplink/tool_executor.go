@@ -0,0 +1,217 @@
+// Package deeplink provides the generate_link built-in tool, which fills
+// the configured Grafana, Loki, Kibana and similar URL templates
+// (system.deep_links) so analyses can point at the exact dashboard or log
+// query, scoped to the incident's time range.
+package deeplink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/builtintools"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+)
+
+// Compile-time check that ToolExecutor implements agent.ToolExecutor.
+var _ agent.ToolExecutor = (*ToolExecutor)(nil)
+
+// ToolGenerateLink is the wire name from pkg/builtintools.
+const ToolGenerateLink = builtintools.GenerateLink
+
+// ToolExecutor wraps an inner agent.ToolExecutor and handles generate_link
+// calls. Everything else passes through.
+type ToolExecutor struct {
+	inner     agent.ToolExecutor
+	cfg       *config.DeepLinksConfig
+	alertTime time.Time
+	now       func() time.Time
+}
+
+// NewToolExecutor creates a link tool executor around inner. alertTime is
+// when the alert arrived; links without an explicit range cover
+// cfg.DefaultLookback before it up to now.
+func NewToolExecutor(inner agent.ToolExecutor, cfg *config.DeepLinksConfig, alertTime time.Time) *ToolExecutor {
+	return &ToolExecutor{inner: inner, cfg: cfg, alertTime: alertTime, now: time.Now}
+}
+
+// Catalog lists the configured templates, sorted by name, for the prompt.
+func Catalog(cfg *config.DeepLinksConfig) []agent.LinkTemplateEntry {
+	if !cfg.Enabled() {
+		return nil
+	}
+	entries := make([]agent.LinkTemplateEntry, 0, len(cfg.Templates))
+	for name, tmpl := range cfg.Templates {
+		entries = append(entries, agent.LinkTemplateEntry{
+			Name:        name,
+			Description: tmpl.Description,
+			Params:      tmpl.Params(),
+		})
+	}
+	slices.SortFunc(entries, func(a, b agent.LinkTemplateEntry) int { return strings.Compare(a.Name, b.Name) })
+	return entries
+}
+
+// toolDefinition is the generate_link definition exposed to the LLM. The
+// template parameter is restricted to the configured names.
+func (te *ToolExecutor) toolDefinition() agent.ToolDefinition {
+	names := make([]string, 0, len(te.cfg.Templates))
+	for name := range te.cfg.Templates {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	enum, _ := json.Marshal(names)
+
+	return agent.ToolDefinition{
+		Name: ToolGenerateLink,
+		Description: "Build a link to a dashboard or log query from a configured template, scoped to the incident's time range. " +
+			"Include the returned URL in your analysis wherever you refer to a dashboard or logs.",
+		ParametersSchema: `{
+  "type": "object",
+  "properties": {
+    "template": {
+      "type": "string",
+      "enum": ` + string(enum) + `,
+      "description": "Name of the link template"
+    },
+    "params": {
+      "type": "object",
+      "additionalProperties": {"type": "string"},
+      "description": "Values for the template's parameters"
+    },
+    "from": {
+      "type": "string",
+      "description": "Optional start of the time range (RFC 3339). Default: shortly before the alert arrived"
+    },
+    "to": {
+      "type": "string",
+      "description": "Optional end of the time range (RFC 3339). Default: now"
+    }
+  },
+  "required": ["template"]
+}`,
+	}
+}
+
+// ListTools returns generate_link followed by the inner tools.
+func (te *ToolExecutor) ListTools(ctx context.Context) ([]agent.ToolDefinition, error) {
+	innerTools, err := te.inner.ListTools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inner tools: %w", err)
+	}
+	tools := make([]agent.ToolDefinition, 0, len(innerTools)+1)
+	tools = append(tools, te.toolDefinition())
+	for _, t := range innerTools {
+		if t.Name != ToolGenerateLink {
+			tools = append(tools, t)
+		}
+	}
+	return tools, nil
+}
+
+// Execute builds the requested link and delegates every other call.
+func (te *ToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+	if mcp.NormalizeBuiltinPlainToolName(call.Name) != ToolGenerateLink {
+		return te.inner.Execute(ctx, call)
+	}
+
+	link, err := te.build(call.Arguments)
+	if err != nil {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: err.Error(),
+			IsError: true,
+		}, nil
+	}
+	return &agent.ToolResult{
+		CallID:  call.ID,
+		Name:    call.Name,
+		Content: link,
+	}, nil
+}
+
+// build fills the template named in the call's arguments.
+func (te *ToolExecutor) build(arguments string) (string, error) {
+	var args struct {
+		Template string            `json:"template"`
+		Params   map[string]string `json:"params"`
+		From     string            `json:"from"`
+		To       string            `json:"to"`
+	}
+	if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+		return "", fmt.Errorf("invalid arguments: %v", err)
+	}
+	tmpl := te.cfg.Templates[args.Template]
+	if tmpl == nil {
+		return "", fmt.Errorf("unknown template %q", args.Template)
+	}
+
+	values := make(map[string]string)
+	var missing []string
+	for _, p := range tmpl.Params() {
+		v := strings.TrimSpace(args.Params[p])
+		if v == "" {
+			missing = append(missing, p)
+			continue
+		}
+		values[p] = url.QueryEscape(v)
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("template %q requires params: %s", args.Template, strings.Join(missing, ", "))
+	}
+
+	from, to, err := te.timeRange(args.From, args.To)
+	if err != nil {
+		return "", err
+	}
+	values["from"] = from.UTC().Format(time.RFC3339)
+	values["to"] = to.UTC().Format(time.RFC3339)
+	values["from_ms"] = strconv.FormatInt(from.UnixMilli(), 10)
+	values["to_ms"] = strconv.FormatInt(to.UnixMilli(), 10)
+
+	return tmpl.Expand(values), nil
+}
+
+// timeRange resolves the link's time range. An omitted end is now; an
+// omitted start is DefaultLookback before the alert, or before the end when
+// the end precedes the alert.
+func (te *ToolExecutor) timeRange(fromArg, toArg string) (time.Time, time.Time, error) {
+	to := te.now()
+	if toArg != "" {
+		t, err := time.Parse(time.RFC3339, toArg)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid 'to': must be RFC 3339 (e.g. 2024-06-01T12:00:00Z)")
+		}
+		to = t
+	}
+
+	anchor := te.alertTime
+	if anchor.IsZero() || to.Before(anchor) {
+		anchor = to
+	}
+	from := anchor.Add(-te.cfg.DefaultLookback)
+	if fromArg != "" {
+		t, err := time.Parse(time.RFC3339, fromArg)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid 'from': must be RFC 3339 (e.g. 2024-06-01T11:00:00Z)")
+		}
+		from = t
+	}
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("'from' must be before 'to'")
+	}
+	return from, to, nil
+}
+
+// Close delegates to the inner executor.
+func (te *ToolExecutor) Close() error {
+	return te.inner.Close()
+}
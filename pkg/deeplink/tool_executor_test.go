@@ -0,0 +1,116 @@
+package deeplink
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alertTime = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	now       = alertTime.Add(20 * time.Minute)
+)
+
+func testConfig() *config.DeepLinksConfig {
+	cfg := config.DefaultDeepLinksConfig()
+	cfg.Templates = map[string]*config.DeepLinkTemplate{
+		"loki": {
+			Description: "Loki logs in Grafana Explore",
+			URL:         "https://grafana.example.com/explore?q={query}&from={from_ms}&to={to_ms}",
+		},
+		"kibana": {
+			Description: "Kibana discover",
+			URL:         "https://kibana.example.com/app/discover#/?_g=(time:(from:'{from}',to:'{to}'))",
+		},
+	}
+	return cfg
+}
+
+func newTestExecutor() *ToolExecutor {
+	te := NewToolExecutor(agent.NewStubToolExecutor([]agent.ToolDefinition{
+		{Name: "kubernetes-server.get_pods"},
+	}), testConfig(), alertTime)
+	te.now = func() time.Time { return now }
+	return te
+}
+
+func TestCatalog(t *testing.T) {
+	assert.Nil(t, Catalog(config.DefaultDeepLinksConfig()))
+	assert.Equal(t, []agent.LinkTemplateEntry{
+		{Name: "kibana", Description: "Kibana discover"},
+		{Name: "loki", Description: "Loki logs in Grafana Explore", Params: []string{"query"}},
+	}, Catalog(testConfig()))
+}
+
+func TestToolExecutor_ListTools(t *testing.T) {
+	tools, err := newTestExecutor().ListTools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tools, 2)
+	assert.Equal(t, ToolGenerateLink, tools[0].Name)
+	assert.Contains(t, tools[0].ParametersSchema, `"enum": ["kibana","loki"]`)
+	assert.Equal(t, "kubernetes-server.get_pods", tools[1].Name)
+}
+
+func TestToolExecutor_Execute(t *testing.T) {
+	te := newTestExecutor()
+	ctx := context.Background()
+
+	run := func(args string) *agent.ToolResult {
+		t.Helper()
+		result, err := te.Execute(ctx, agent.ToolCall{ID: "call-1", Name: ToolGenerateLink, Arguments: args})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("default range spans the lookback before the alert to now", func(t *testing.T) {
+		result := run(`{"template":"loki","params":{"query":"{app=\"api\"} |= \"error\""}}`)
+		assert.False(t, result.IsError)
+		assert.Equal(t, "https://grafana.example.com/explore?q=%7Bapp%3D%22api%22%7D+%7C%3D+%22error%22"+
+			"&from=1780311600000&to=1780316400000", result.Content)
+	})
+
+	t.Run("explicit range", func(t *testing.T) {
+		result := run(`{"template":"kibana","from":"2026-06-01T11:30:00Z","to":"2026-06-01T12:10:00+00:00"}`)
+		assert.False(t, result.IsError)
+		assert.Equal(t, "https://kibana.example.com/app/discover#/?_g=(time:(from:'2026-06-01T11:30:00Z',to:'2026-06-01T12:10:00Z'))", result.Content)
+	})
+
+	t.Run("end before the alert moves the default start", func(t *testing.T) {
+		result := run(`{"template":"kibana","to":"2026-06-01T09:00:00Z"}`)
+		assert.Contains(t, result.Content, "from:'2026-06-01T08:00:00Z'")
+	})
+
+	t.Run("accepts provider-prefixed name", func(t *testing.T) {
+		result, err := te.Execute(ctx, agent.ToolCall{Name: "google:" + ToolGenerateLink, Arguments: `{"template":"kibana"}`})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+
+	errorCases := []struct {
+		name, args, want string
+	}{
+		{"unknown template", `{"template":"splunk"}`, `unknown template "splunk"`},
+		{"missing param", `{"template":"loki","params":{"query":" "}}`, `template "loki" requires params: query`},
+		{"invalid from", `{"template":"kibana","from":"yesterday"}`, "invalid 'from'"},
+		{"empty range", `{"template":"kibana","from":"2026-06-01T12:30:00Z"}`, "'from' must be before 'to'"},
+		{"invalid JSON", `{`, "invalid arguments"},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := run(tc.args)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content, tc.want)
+		})
+	}
+}
+
+func TestToolExecutor_DelegatesOtherTools(t *testing.T) {
+	result, err := newTestExecutor().Execute(context.Background(), agent.ToolCall{Name: "kubernetes-server.get_pods", Arguments: "{}"})
+	require.NoError(t, err)
+	assert.NotContains(t, result.Content, "template")
+}
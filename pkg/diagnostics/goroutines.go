@@ -0,0 +1,126 @@
+package diagnostics
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+)
+
+const modulePrefix = "github.com/codeready-toolchain/tarsy/"
+
+// SubsystemOther groups goroutines with no recognizable owner (runtime,
+// signal handling, and third-party libraries not listed in libraryOwners).
+const SubsystemOther = "other"
+
+// libraryOwners attributes goroutines started entirely inside third-party
+// code to the subsystem that uses the library. Checked in order; the first
+// frame prefix found anywhere in the stack wins.
+var libraryOwners = []struct{ prefix, subsystem string }{
+	{"github.com/modelcontextprotocol/go-sdk/", "mcp"},
+	{"google.golang.org/grpc", "grpc"},
+	{"github.com/jackc/pgx/", "database"},
+	{"database/sql.", "database"},
+	{"github.com/coder/websocket", "events"},
+	{"net/http.", "http"},
+}
+
+// GoroutinesBySubsystem returns the number of live goroutines grouped by the
+// TARSy package that started them (e.g. "queue", "mcp", "events"). Stacks
+// with no TARSy frame are attributed via libraryOwners or SubsystemOther.
+//
+// Takes a goroutine profile, which stops the world for a time proportional
+// to the goroutine count; intended for on-demand diagnostics.
+func GoroutinesBySubsystem() map[string]int {
+	var buf bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buf, 1); err != nil {
+		return map[string]int{}
+	}
+	return countGoroutines(&buf)
+}
+
+// countGoroutines parses a debug=1 goroutine profile. Each record starts with
+// "<count> @ <pcs>" followed by "#\t<pc>\t<func>+<off>\t<file>:<line>"
+// frames, innermost first.
+func countGoroutines(r io.Reader) map[string]int {
+	counts := map[string]int{}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	count := 0
+	var frames []string
+	flush := func() {
+		if count > 0 {
+			counts[classifyStack(frames)] += count
+		}
+		count, frames = 0, frames[:0]
+	}
+
+	for sc.Scan() {
+		line := sc.Text()
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#\t"):
+			if parts := strings.Split(line, "\t"); len(parts) >= 3 {
+				frames = append(frames, parts[2])
+			}
+		default:
+			if n, _, ok := strings.Cut(line, " @ "); ok {
+				flush()
+				count, _ = strconv.Atoi(n)
+			}
+		}
+	}
+	flush()
+	return counts
+}
+
+// classifyStack attributes a stack (innermost frame first) to a subsystem.
+// The outermost TARSy frame decides, since that is the function the
+// goroutine was started with (or the handler it is serving).
+func classifyStack(frames []string) string {
+	for i := len(frames) - 1; i >= 0; i-- {
+		if rest, ok := strings.CutPrefix(frames[i], modulePrefix); ok {
+			return subsystemOf(rest)
+		}
+	}
+	for _, owner := range libraryOwners {
+		for _, f := range frames {
+			if strings.HasPrefix(f, owner.prefix) {
+				return owner.subsystem
+			}
+		}
+	}
+	return SubsystemOther
+}
+
+// subsystemOf maps a module-relative function name such as
+// "pkg/queue.(*Worker).run+0x1f" to its subsystem ("queue").
+func subsystemOf(fn string) string {
+	// Drop generic type arguments, which may contain slashes and dots.
+	if i := strings.IndexByte(fn, '['); i >= 0 {
+		fn = fn[:i]
+	}
+	// The import path ends at the first dot after the last slash.
+	pkgPath := fn
+	if slash := strings.LastIndexByte(fn, '/'); slash >= 0 {
+		if dot := strings.IndexByte(fn[slash:], '.'); dot >= 0 {
+			pkgPath = fn[:slash+dot]
+		}
+	} else if dot := strings.IndexByte(fn, '.'); dot >= 0 {
+		pkgPath = fn[:dot]
+	}
+
+	segments := strings.Split(pkgPath, "/")
+	switch {
+	case segments[0] == "pkg" && len(segments) > 1:
+		return segments[1]
+	case segments[0] == "cmd":
+		return "main"
+	default:
+		return segments[0]
+	}
+}
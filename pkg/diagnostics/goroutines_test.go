@@ -0,0 +1,72 @@
+package diagnostics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sampleGoroutineProfile = `goroutine profile: total 9
+4 @ 0x43e1ce 0x44f0a5 0x4a1b2c 0x4a1c3d
+#	0x4a1b2b	sync.runtime_notifyListWait+0x11b	/usr/local/go/src/runtime/sema.go:597
+#	0x4a1c3c	github.com/codeready-toolchain/tarsy/pkg/queue.(*Worker).pollAndProcess+0x5c	/src/pkg/queue/worker.go:210
+#	0x4a1d4d	github.com/codeready-toolchain/tarsy/pkg/queue.(*Worker).run+0x8d	/src/pkg/queue/worker.go:150
+
+2 @ 0x43e1ce 0x4a2e5f
+# labels: {"subsystem":"x"}
+#	0x4a2e5e	github.com/modelcontextprotocol/go-sdk/internal/jsonrpc2.(*Connection).readIncoming+0x9e	/mod/jsonrpc2/conn.go:400
+
+1 @ 0x43e1ce 0x4a3f60
+#	0x4a3f5f	github.com/codeready-toolchain/tarsy/pkg/api.(*Server).wsHandler+0x1f	/src/pkg/api/handler_ws.go:30
+#	0x4a3f70	net/http.(*conn).serve+0x600	/usr/local/go/src/net/http/server.go:2100
+
+1 @ 0x43e1ce 0x4a4071
+#	0x4a4070	github.com/codeready-toolchain/tarsy/cmd/tarsy.main.func3+0x30	/src/cmd/tarsy/main.go:600
+
+1 @ 0x43e1ce 0x4a5182
+#	0x4a5181	os/signal.signal_recv+0x25	/usr/local/go/src/runtime/sigqueue.go:152
+`
+
+func TestCountGoroutines(t *testing.T) {
+	counts := countGoroutines(strings.NewReader(sampleGoroutineProfile))
+	assert.Equal(t, map[string]int{
+		"queue": 4,
+		"mcp":   2,
+		"api":   1,
+		"main":  1,
+		"other": 1,
+	}, counts)
+}
+
+func TestSubsystemOf(t *testing.T) {
+	tests := map[string]string{
+		"pkg/queue.(*Worker).run+0x8d":                        "queue",
+		"pkg/agent/controller.(*ReActController).Run+0x10":    "agent",
+		"pkg/events.(*ConnectionManager).listen.func1+0x4":    "events",
+		"cmd/tarsy.main+0x1":                                  "main",
+		"ent.(*Client).init+0x2":                              "ent",
+		"pkg/fanout.run[go.shape.struct { a/b.C }]+0x3":       "fanout",
+		"pkg/mcp.(*HealthMonitor).loop+0x1c":                  "mcp",
+		"ent/runtime.init.func1+0x4":                          "ent",
+		"pkg/services.(*SystemWarningsService).Add-fm+0x1":    "services",
+		"pkg/api.(*Server).setupRoutes.requireAdmin.func1+0x": "api",
+	}
+	for fn, want := range tests {
+		assert.Equal(t, want, subsystemOf(fn), fn)
+	}
+}
+
+func TestGoroutinesBySubsystem_Live(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	started := make(chan struct{})
+	go func() {
+		close(started)
+		<-stop
+	}()
+	<-started
+
+	counts := GoroutinesBySubsystem()
+	assert.GreaterOrEqual(t, counts["diagnostics"], 1, "goroutines started by this package are attributed to it: %v", counts)
+}
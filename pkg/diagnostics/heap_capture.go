@@ -0,0 +1,233 @@
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+)
+
+const (
+	heapProfilePrefix = "heap-"
+	heapProfileSuffix = ".pb.gz"
+	heapProfileTime   = "20060102T150405Z"
+)
+
+// HeapProfile describes a heap profile written by HeapCapture.
+type HeapProfile struct {
+	Name       string    `json:"name"`
+	SizeBytes  int64     `json:"size_bytes"`
+	CapturedAt time.Time `json:"captured_at"`
+}
+
+// HeapCapture writes a heap profile to disk whenever the process RSS is at or
+// above the configured threshold, at most once per MinInterval, keeping the
+// newest MaxProfiles files. Profiles are read with `go tool pprof`.
+// Nil-safe: a nil HeapCapture never captures and lists no profiles.
+type HeapCapture struct {
+	cfg       config.HeapCaptureConfig
+	podID     string
+	threshold uint64
+	readRSS   func() (uint64, error)
+	logger    *slog.Logger
+
+	mu          sync.Mutex
+	lastCapture time.Time
+}
+
+// NewHeapCapture creates a HeapCapture. Returns nil when
+// cfg.RSSThresholdMB is zero (automatic capture disabled).
+func NewHeapCapture(cfg config.HeapCaptureConfig, podID string) *HeapCapture {
+	if cfg.RSSThresholdMB <= 0 {
+		return nil
+	}
+	return &HeapCapture{
+		cfg:       cfg,
+		podID:     podID,
+		threshold: uint64(cfg.RSSThresholdMB) * 1024 * 1024,
+		readRSS:   ReadRSS,
+		logger:    slog.Default().With("component", "heap-capture"),
+	}
+}
+
+// ThresholdBytes returns the RSS threshold, or 0 when capture is disabled.
+func (h *HeapCapture) ThresholdBytes() uint64 {
+	if h == nil {
+		return 0
+	}
+	return h.threshold
+}
+
+// Run samples RSS every CheckInterval until ctx is cancelled.
+func (h *HeapCapture) Run(ctx context.Context) {
+	if h == nil {
+		return
+	}
+	if err := os.MkdirAll(h.cfg.Dir, 0o750); err != nil {
+		h.logger.Error("Heap capture disabled: cannot create profile directory",
+			"dir", h.cfg.Dir, "error", err)
+		return
+	}
+	if _, err := h.readRSS(); err != nil {
+		h.logger.Warn("Heap capture disabled: cannot read process RSS", "error", err)
+		return
+	}
+	h.logger.Info("Automatic heap capture enabled",
+		"rss_threshold_mb", h.cfg.RSSThresholdMB, "dir", h.cfg.Dir,
+		"check_interval", h.cfg.CheckInterval, "min_interval", h.cfg.MinInterval)
+
+	ticker := time.NewTicker(h.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := h.check(time.Now()); err != nil {
+				h.logger.Error("Heap capture failed", "error", err)
+			}
+		}
+	}
+}
+
+// check captures a profile if RSS is over the threshold and MinInterval has
+// passed since the previous capture. Returns the written profile, if any.
+func (h *HeapCapture) check(now time.Time) (*HeapProfile, error) {
+	rss, err := h.readRSS()
+	if err != nil {
+		return nil, err
+	}
+	if rss < h.threshold {
+		return nil, nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.lastCapture.IsZero() && now.Sub(h.lastCapture) < h.cfg.MinInterval {
+		return nil, nil
+	}
+	h.lastCapture = now
+
+	profile, err := h.write(now)
+	if err != nil {
+		return nil, err
+	}
+	metrics.HeapProfilesCapturedTotal.Inc()
+	h.logger.Warn("RSS over threshold, captured heap profile",
+		"rss_mb", rss/(1024*1024), "rss_threshold_mb", h.cfg.RSSThresholdMB,
+		"profile", filepath.Join(h.cfg.Dir, profile.Name))
+
+	h.prune()
+	return profile, nil
+}
+
+// write takes a heap profile and stores it under Dir.
+func (h *HeapCapture) write(now time.Time) (*HeapProfile, error) {
+	name := heapProfilePrefix + sanitizeName(h.podID) + "-" + now.UTC().Format(heapProfileTime) + heapProfileSuffix
+	path := filepath.Join(h.cfg.Dir, name)
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create heap profile: %w", err)
+	}
+	if err := pprof.Lookup("heap").WriteTo(f, 0); err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("failed to write heap profile: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close heap profile: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return &HeapProfile{Name: name, SizeBytes: info.Size(), CapturedAt: now.UTC()}, nil
+}
+
+// prune deletes the oldest profiles beyond MaxProfiles.
+func (h *HeapCapture) prune() {
+	profiles := h.Profiles()
+	for _, p := range profiles[min(len(profiles), h.cfg.MaxProfiles):] {
+		if err := os.Remove(filepath.Join(h.cfg.Dir, p.Name)); err != nil {
+			h.logger.Warn("Failed to delete old heap profile", "profile", p.Name, "error", err)
+		}
+	}
+}
+
+// Profiles lists captured heap profiles, newest first.
+func (h *HeapCapture) Profiles() []HeapProfile {
+	if h == nil {
+		return nil
+	}
+	entries, err := os.ReadDir(h.cfg.Dir)
+	if err != nil {
+		return nil
+	}
+	var profiles []HeapProfile
+	for _, e := range entries {
+		if e.IsDir() || !isHeapProfileName(e.Name()) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		profiles = append(profiles, HeapProfile{
+			Name:       e.Name(),
+			SizeBytes:  info.Size(),
+			CapturedAt: capturedAt(e.Name(), info.ModTime()),
+		})
+	}
+	slices.SortFunc(profiles, func(a, b HeapProfile) int {
+		return b.CapturedAt.Compare(a.CapturedAt)
+	})
+	return profiles
+}
+
+// Open opens a captured profile by name for download. Names are restricted
+// to files HeapCapture wrote, so callers cannot traverse out of Dir.
+func (h *HeapCapture) Open(name string) (*os.File, error) {
+	if h == nil || name != filepath.Base(name) || !isHeapProfileName(name) {
+		return nil, os.ErrNotExist
+	}
+	return os.Open(filepath.Join(h.cfg.Dir, name))
+}
+
+func isHeapProfileName(name string) bool {
+	return strings.HasPrefix(name, heapProfilePrefix) && strings.HasSuffix(name, heapProfileSuffix)
+}
+
+// capturedAt reads the capture time from a profile name, falling back to
+// the file modification time for names it cannot parse.
+func capturedAt(name string, modTime time.Time) time.Time {
+	stem := strings.TrimSuffix(name, heapProfileSuffix)
+	if len(stem) >= len(heapProfileTime) {
+		if t, err := time.Parse(heapProfileTime, stem[len(stem)-len(heapProfileTime):]); err == nil {
+			return t
+		}
+	}
+	return modTime.UTC()
+}
+
+// sanitizeName keeps pod IDs safe for use in file names.
+func sanitizeName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, s)
+}
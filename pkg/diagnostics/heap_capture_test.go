@@ -0,0 +1,92 @@
+package diagnostics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func newTestCapture(t *testing.T, rss *uint64) *HeapCapture {
+	t.Helper()
+	h := NewHeapCapture(config.HeapCaptureConfig{
+		RSSThresholdMB: 100,
+		Dir:            t.TempDir(),
+		MaxProfiles:    2,
+		MinInterval:    10 * time.Minute,
+		CheckInterval:  time.Second,
+	}, "tarsy-7f9c/pod")
+	require.NotNil(t, h)
+	h.readRSS = func() (uint64, error) { return *rss, nil }
+	return h
+}
+
+func TestNewHeapCapture_DisabledWithoutThreshold(t *testing.T) {
+	h := NewHeapCapture(config.HeapCaptureConfig{Dir: t.TempDir()}, "pod")
+	assert.Nil(t, h)
+	assert.Zero(t, h.ThresholdBytes())
+	assert.Empty(t, h.Profiles())
+	_, err := h.Open("heap-pod-1.pb.gz")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestHeapCapture_Check(t *testing.T) {
+	rss := uint64(50 * 1024 * 1024)
+	h := newTestCapture(t, &rss)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	t.Run("below threshold does nothing", func(t *testing.T) {
+		p, err := h.check(now)
+		require.NoError(t, err)
+		assert.Nil(t, p)
+		assert.Empty(t, h.Profiles())
+	})
+
+	rss = 150 * 1024 * 1024
+
+	t.Run("over threshold writes a profile", func(t *testing.T) {
+		p, err := h.check(now)
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		assert.Equal(t, "heap-tarsy-7f9c_pod-20261016T120000Z.pb.gz", p.Name)
+		assert.Positive(t, p.SizeBytes)
+
+		f, err := h.Open(p.Name)
+		require.NoError(t, err)
+		_ = f.Close()
+	})
+
+	t.Run("min interval suppresses repeat captures", func(t *testing.T) {
+		p, err := h.check(now.Add(5 * time.Minute))
+		require.NoError(t, err)
+		assert.Nil(t, p)
+	})
+
+	t.Run("old profiles are pruned", func(t *testing.T) {
+		for i := 1; i <= 3; i++ {
+			_, err := h.check(now.Add(time.Duration(i) * 20 * time.Minute))
+			require.NoError(t, err)
+		}
+		profiles := h.Profiles()
+		require.Len(t, profiles, 2)
+		assert.Equal(t, "heap-tarsy-7f9c_pod-20261016T130000Z.pb.gz", profiles[0].Name)
+		assert.Equal(t, "heap-tarsy-7f9c_pod-20261016T124000Z.pb.gz", profiles[1].Name)
+		assert.Equal(t, now.Add(time.Hour), profiles[0].CapturedAt)
+	})
+}
+
+func TestHeapCapture_OpenRejectsOtherFiles(t *testing.T) {
+	rss := uint64(0)
+	h := newTestCapture(t, &rss)
+	require.NoError(t, os.WriteFile(filepath.Join(h.cfg.Dir, "secrets.txt"), []byte("x"), 0o600))
+
+	for _, name := range []string{"secrets.txt", "../heap-x.pb.gz", "heap-../../etc/passwd.pb.gz", ""} {
+		_, err := h.Open(name)
+		assert.ErrorIs(t, err, os.ErrNotExist, name)
+	}
+}
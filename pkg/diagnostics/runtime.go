@@ -0,0 +1,97 @@
+// Package diagnostics provides lightweight runtime statistics and automatic
+// heap-profile capture for diagnosing memory and goroutine growth on
+// long-lived pods. Heavier on-demand profiling is served by net/http/pprof
+// behind the admin allowlist (see pkg/api).
+package diagnostics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrRSSUnavailable is returned by ReadRSS on platforms without /proc.
+var ErrRSSUnavailable = errors.New("process RSS is not available on this platform")
+
+// MemoryStats is a summary of the Go runtime memory statistics.
+type MemoryStats struct {
+	HeapAllocBytes  uint64     `json:"heap_alloc_bytes"`
+	HeapInuseBytes  uint64     `json:"heap_inuse_bytes"`
+	HeapObjects     uint64     `json:"heap_objects"`
+	SysBytes        uint64     `json:"sys_bytes"`
+	RSSBytes        uint64     `json:"rss_bytes,omitempty"` // omitted when /proc is unavailable
+	NumGC           uint32     `json:"num_gc"`
+	LastGC          *time.Time `json:"last_gc,omitempty"`
+	GCPauseTotalMs  float64    `json:"gc_pause_total_ms"`
+	NextGCBytes     uint64     `json:"next_gc_bytes"`
+	StackInuseBytes uint64     `json:"stack_inuse_bytes"`
+}
+
+// ReadMemory returns current memory statistics. runtime.ReadMemStats stops
+// the world briefly, so callers should not poll this in a tight loop.
+func ReadMemory() MemoryStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	stats := MemoryStats{
+		HeapAllocBytes:  m.HeapAlloc,
+		HeapInuseBytes:  m.HeapInuse,
+		HeapObjects:     m.HeapObjects,
+		SysBytes:        m.Sys,
+		NumGC:           m.NumGC,
+		GCPauseTotalMs:  float64(m.PauseTotalNs) / float64(time.Millisecond),
+		NextGCBytes:     m.NextGC,
+		StackInuseBytes: m.StackInuse,
+	}
+	if m.LastGC > 0 {
+		t := time.Unix(0, int64(m.LastGC)).UTC()
+		stats.LastGC = &t
+	}
+	if rss, err := ReadRSS(); err == nil {
+		stats.RSSBytes = rss
+	}
+	return stats
+}
+
+// ReadRSS returns the resident set size of this process in bytes, read from
+// /proc/self/status. Returns ErrRSSUnavailable where /proc does not exist.
+func ReadRSS() (uint64, error) {
+	f, err := os.Open("/proc/self/status")
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, ErrRSSUnavailable
+		}
+		return 0, err
+	}
+	defer func() { _ = f.Close() }()
+	return parseVmRSS(bufio.NewScanner(f))
+}
+
+// parseVmRSS extracts the VmRSS line ("VmRSS:   123456 kB") from a
+// /proc/<pid>/status listing.
+func parseVmRSS(sc *bufio.Scanner) (uint64, error) {
+	for sc.Scan() {
+		rest, ok := strings.CutPrefix(sc.Text(), "VmRSS:")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) != 2 || fields[1] != "kB" {
+			return 0, fmt.Errorf("unexpected VmRSS format %q", sc.Text())
+		}
+		kb, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid VmRSS value %q: %w", fields[0], err)
+		}
+		return kb * 1024, nil
+	}
+	if err := sc.Err(); err != nil {
+		return 0, err
+	}
+	return 0, ErrRSSUnavailable
+}
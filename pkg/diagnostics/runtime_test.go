@@ -0,0 +1,36 @@
+package diagnostics
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVmRSS(t *testing.T) {
+	t.Run("reads kB value", func(t *testing.T) {
+		status := "Name:\ttarsy\nVmPeak:\t 2000000 kB\nVmRSS:\t  123456 kB\nThreads:\t12\n"
+		rss, err := parseVmRSS(bufio.NewScanner(strings.NewReader(status)))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(123456*1024), rss)
+	})
+
+	t.Run("missing line", func(t *testing.T) {
+		_, err := parseVmRSS(bufio.NewScanner(strings.NewReader("Name:\ttarsy\n")))
+		assert.ErrorIs(t, err, ErrRSSUnavailable)
+	})
+
+	t.Run("unexpected unit", func(t *testing.T) {
+		_, err := parseVmRSS(bufio.NewScanner(strings.NewReader("VmRSS:\t12 MB\n")))
+		assert.ErrorContains(t, err, "unexpected VmRSS format")
+	})
+}
+
+func TestReadMemory(t *testing.T) {
+	m := ReadMemory()
+	assert.Positive(t, m.HeapAllocBytes)
+	assert.Positive(t, m.SysBytes)
+	assert.GreaterOrEqual(t, m.SysBytes, m.HeapInuseBytes)
+}
@@ -0,0 +1,49 @@
+package email
+
+import (
+	"context"
+	"io"
+	"mime/quotedprintable"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMIME(t *testing.T) {
+	now := time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC)
+	html := "<p>Résumé " + strings.Repeat("a", 100) + "</p>"
+	raw, err := buildMIME("TARSy <tarsy@example.com>", Message{
+		To:      []string{"a@example.com", "b@example.com"},
+		Subject: "Analysis Complete – PodCrash",
+		HTML:    html,
+	}, now)
+	require.NoError(t, err)
+
+	headers, body, found := strings.Cut(string(raw), "\r\n\r\n")
+	require.True(t, found)
+	assert.Contains(t, headers, "From: TARSy <tarsy@example.com>\r\n")
+	assert.Contains(t, headers, "To: a@example.com, b@example.com\r\n")
+	assert.Contains(t, headers, "Subject: =?utf-8?q?")
+	assert.Contains(t, headers, "Content-Type: text/html; charset=UTF-8")
+	assert.Contains(t, headers, "Date: Thu, 15 Oct 2026 10:00:00 +0000")
+
+	decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body)))
+	require.NoError(t, err)
+	assert.Equal(t, html, string(decoded))
+}
+
+func TestClient_SendValidation(t *testing.T) {
+	c := NewClient(ClientConfig{Host: "localhost", Port: 25, From: "tarsy@example.com"})
+
+	err := c.Send(context.Background(), Message{Subject: "x"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no recipients")
+
+	bad := NewClient(ClientConfig{Host: "localhost", Port: 25, From: "not an address"})
+	err = bad.Send(context.Background(), Message{To: []string{"a@example.com"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid from address")
+}
@@ -0,0 +1,199 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+)
+
+// maxDigestSummaryLength caps the per-session summary shown in digests.
+const maxDigestSummaryLength = 400
+
+var statusLabel = map[string]string{
+	"completed":      "Analysis Complete",
+	"failed":         "Analysis Failed",
+	"timed_out":      "Analysis Timed Out",
+	"cancelled":      "Analysis Cancelled",
+	"auto_cancelled": "Analysis Auto-Cancelled",
+}
+
+var statusColor = map[string]string{
+	"completed":      "#2e7d32",
+	"failed":         "#c62828",
+	"timed_out":      "#ef6c00",
+	"cancelled":      "#616161",
+	"auto_cancelled": "#616161",
+}
+
+const baseStyle = `font-family: -apple-system, Segoe UI, Helvetica, Arial, sans-serif; font-size: 14px; color: #212121;`
+
+var sessionTemplate = template.Must(template.New("session").Parse(`<!DOCTYPE html>
+<html><body style="` + baseStyle + `">
+<h2 style="color: {{.Color}}; margin-bottom: 4px;">{{.Label}}</h2>
+<table cellpadding="4" style="border-collapse: collapse; margin-bottom: 12px;">
+<tr><td><b>Alert type</b></td><td>{{.AlertType}}</td></tr>
+<tr><td><b>Chain</b></td><td>{{.ChainID}}</td></tr>
+{{- if .Severity}}
+<tr><td><b>Severity</b></td><td>{{.Severity}}</td></tr>
+{{- end}}
+<tr><td><b>Finished</b></td><td>{{.CompletedAt}}</td></tr>
+</table>
+{{- if .Content}}
+<h3>{{.ContentTitle}}</h3>
+<div style="white-space: pre-wrap; background: #f5f5f5; padding: 12px; border-radius: 4px;">{{.Content}}</div>
+{{- end}}
+{{- if .TechnicalSummary}}
+<h3>Technical summary</h3>
+<div style="white-space: pre-wrap; background: #f5f5f5; padding: 12px; border-radius: 4px;">{{.TechnicalSummary}}</div>
+{{- end}}
+<p><a href="{{.SessionURL}}">View full analysis in TARSy</a></p>
+</body></html>
+`))
+
+var digestTemplate = template.Must(template.New("digest").Parse(`<!DOCTYPE html>
+<html><body style="` + baseStyle + `">
+<h2 style="margin-bottom: 4px;">{{.Title}}</h2>
+<p>{{.Period}} &middot; {{len .Rows}} session(s){{if .Partial}} &middot; <i>partial digest (sent on shutdown)</i>{{end}}</p>
+<table cellpadding="6" style="border-collapse: collapse; width: 100%;">
+<tr style="background: #eeeeee; text-align: left;">
+<th>Finished</th><th>Alert type</th><th>Status</th><th>Severity</th><th>Summary</th>
+</tr>
+{{- range .Rows}}
+<tr style="border-top: 1px solid #e0e0e0; vertical-align: top;">
+<td style="white-space: nowrap;"><a href="{{.SessionURL}}">{{.CompletedAt}}</a></td>
+<td>{{.AlertType}}</td>
+<td style="color: {{.Color}};">{{.Label}}</td>
+<td>{{.Severity}}</td>
+<td style="white-space: pre-wrap;">{{.Summary}}</td>
+</tr>
+{{- end}}
+</table>
+</body></html>
+`))
+
+// BuildSessionMessage renders the subject and HTML body for a single
+// terminal session notification.
+func BuildSessionMessage(input SessionCompletedInput, dashboardURL string) (string, string, error) {
+	label := labelFor(input.Status)
+
+	content, contentTitle := input.ExecutiveSummary, "Executive summary"
+	if content == "" && input.Status == "completed" {
+		content, contentTitle = input.FinalAnalysis, "Analysis"
+	}
+	technical := input.TechnicalSummary
+	if input.Status != "completed" {
+		content, contentTitle = input.ErrorMessage, "Error"
+		technical = ""
+	}
+
+	var buf bytes.Buffer
+	err := sessionTemplate.Execute(&buf, map[string]any{
+		"Label":            label,
+		"Color":            colorFor(input.Status),
+		"AlertType":        input.AlertType,
+		"ChainID":          input.ChainID,
+		"Severity":         strings.ToUpper(input.Severity),
+		"CompletedAt":      formatTime(input.CompletedAt),
+		"Content":          content,
+		"ContentTitle":     contentTitle,
+		"TechnicalSummary": technical,
+		"SessionURL":       sessionURL(input.SessionID, dashboardURL),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("render session email: %w", err)
+	}
+
+	subject := fmt.Sprintf("[TARSy] %s: %s", label, input.AlertType)
+	if input.Severity != "" {
+		subject = fmt.Sprintf("[TARSy] [%s] %s: %s", strings.ToUpper(input.Severity), label, input.AlertType)
+	}
+	return subject, buf.String(), nil
+}
+
+// BuildDigestMessage renders the subject and HTML body for a batched digest
+// of sessions from one chain. partial marks digests flushed early on shutdown.
+func BuildDigestMessage(chainID, schedule string, sessions []SessionCompletedInput, dashboardURL string, partial bool) (string, string, error) {
+	type row struct {
+		SessionURL  string
+		CompletedAt string
+		AlertType   string
+		Label       string
+		Color       string
+		Severity    string
+		Summary     string
+	}
+
+	rows := make([]row, 0, len(sessions))
+	var first, last time.Time
+	for i, s := range sessions {
+		summary := s.ExecutiveSummary
+		if summary == "" {
+			summary = s.ErrorMessage
+		}
+		rows = append(rows, row{
+			SessionURL:  sessionURL(s.SessionID, dashboardURL),
+			CompletedAt: formatTime(s.CompletedAt),
+			AlertType:   s.AlertType,
+			Label:       labelFor(s.Status),
+			Color:       colorFor(s.Status),
+			Severity:    strings.ToUpper(s.Severity),
+			Summary:     truncate(summary, maxDigestSummaryLength),
+		})
+		if i == 0 || s.CompletedAt.Before(first) {
+			first = s.CompletedAt
+		}
+		if s.CompletedAt.After(last) {
+			last = s.CompletedAt
+		}
+	}
+
+	title := fmt.Sprintf("TARSy %s digest: %s", schedule, chainID)
+	var buf bytes.Buffer
+	err := digestTemplate.Execute(&buf, map[string]any{
+		"Title":   title,
+		"Period":  fmt.Sprintf("%s – %s", formatTime(first), formatTime(last)),
+		"Rows":    rows,
+		"Partial": partial,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("render digest email: %w", err)
+	}
+
+	subject := fmt.Sprintf("[TARSy] %s digest for %s: %d session(s)", schedule, chainID, len(sessions))
+	return subject, buf.String(), nil
+}
+
+func labelFor(status string) string {
+	if l, ok := statusLabel[status]; ok {
+		return l
+	}
+	return "Analysis " + status
+}
+
+func colorFor(status string) string {
+	if c, ok := statusColor[status]; ok {
+		return c
+	}
+	return "#212121"
+}
+
+func sessionURL(sessionID, dashboardURL string) string {
+	return fmt.Sprintf("%s/sessions/%s", dashboardURL, sessionID)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02 15:04 UTC")
+}
+
+func truncate(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	return string(runes[:maxLen]) + "…"
+}
@@ -0,0 +1,82 @@
+package email
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSessionMessage(t *testing.T) {
+	t.Run("completed uses executive summary and escapes HTML", func(t *testing.T) {
+		subject, html, err := BuildSessionMessage(SessionCompletedInput{
+			SessionID:        "sess-1",
+			AlertType:        "PodCrash",
+			ChainID:          "k8s",
+			Status:           "completed",
+			ExecutiveSummary: "OOM in <payments> pod",
+			FinalAnalysis:    "long analysis",
+			CompletedAt:      time.Date(2026, 10, 15, 10, 0, 0, 0, time.UTC),
+		}, "https://tarsy.example.com")
+		require.NoError(t, err)
+
+		assert.Equal(t, "[TARSy] Analysis Complete: PodCrash", subject)
+		assert.Contains(t, html, "OOM in &lt;payments&gt; pod")
+		assert.NotContains(t, html, "long analysis")
+		assert.Contains(t, html, "https://tarsy.example.com/sessions/sess-1")
+		assert.Contains(t, html, "2026-10-15 10:00 UTC")
+		assert.NotContains(t, html, "Severity")
+	})
+
+	t.Run("completed adds technical summary", func(t *testing.T) {
+		_, html, err := BuildSessionMessage(SessionCompletedInput{
+			SessionID:        "sess-3",
+			Status:           "completed",
+			ExecutiveSummary: "OOM in payments pod",
+			TechnicalSummary: "## Next Steps\n1. Raise the memory limit",
+		}, "https://tarsy.example.com")
+		require.NoError(t, err)
+		assert.Contains(t, html, "OOM in payments pod")
+		assert.Contains(t, html, "<h3>Technical summary</h3>")
+		assert.Contains(t, html, "1. Raise the memory limit")
+	})
+
+	t.Run("completed falls back to final analysis", func(t *testing.T) {
+		_, html, err := BuildSessionMessage(SessionCompletedInput{
+			SessionID: "sess-2", Status: "completed", FinalAnalysis: "final analysis text",
+		}, "https://tarsy.example.com")
+		require.NoError(t, err)
+		assert.Contains(t, html, "final analysis text")
+	})
+
+	t.Run("failed shows error", func(t *testing.T) {
+		subject, html, err := BuildSessionMessage(SessionCompletedInput{
+			SessionID: "sess-3", AlertType: "PodCrash", Status: "failed", ErrorMessage: "LLM unavailable",
+		}, "https://tarsy.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "[TARSy] Analysis Failed: PodCrash", subject)
+		assert.Contains(t, html, "LLM unavailable")
+	})
+}
+
+func TestBuildDigestMessage(t *testing.T) {
+	sessions := []SessionCompletedInput{
+		{SessionID: "sess-1", AlertType: "PodCrash", Status: "completed", Severity: "low",
+			ExecutiveSummary: strings.Repeat("x", maxDigestSummaryLength+50),
+			CompletedAt:      time.Date(2026, 10, 15, 10, 15, 0, 0, time.UTC)},
+		{SessionID: "sess-2", AlertType: "DiskFull", Status: "timed_out",
+			CompletedAt: time.Date(2026, 10, 15, 10, 45, 0, 0, time.UTC)},
+	}
+
+	subject, html, err := BuildDigestMessage("k8s", "hourly", sessions, "https://tarsy.example.com", false)
+	require.NoError(t, err)
+
+	assert.Equal(t, "[TARSy] hourly digest for k8s: 2 session(s)", subject)
+	assert.Contains(t, html, "2026-10-15 10:15 UTC – 2026-10-15 10:45 UTC")
+	assert.Contains(t, html, "https://tarsy.example.com/sessions/sess-2")
+	assert.Contains(t, html, "Analysis Timed Out")
+	assert.Contains(t, html, strings.Repeat("x", maxDigestSummaryLength)+"…")
+	assert.NotContains(t, html, "partial digest")
+}
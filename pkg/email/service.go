@@ -0,0 +1,226 @@
+package email
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// digestCheckInterval is how often the digest loop checks for due digests.
+const digestCheckInterval = time.Minute
+
+// sendTimeout bounds a single SMTP exchange.
+const sendTimeout = 30 * time.Second
+
+// ServiceConfig holds the parameters needed to construct a Service.
+type ServiceConfig struct {
+	DashboardURL string
+	DigestHour   int                                 // UTC hour daily digests are sent
+	Chains       map[string]*config.ChainEmailConfig // chain ID → recipients + schedule
+}
+
+// SessionCompletedInput contains data for a terminal session email.
+type SessionCompletedInput struct {
+	SessionID        string
+	AlertType        string
+	ChainID          string
+	Status           string // completed, failed, timed_out, cancelled
+	Severity         string // critical, high, medium, low (empty if unclassified)
+	ExecutiveSummary string
+	TechnicalSummary string // Chains with technical_summary enabled (empty otherwise)
+	FinalAnalysis    string
+	ErrorMessage     string
+	CompletedAt      time.Time
+}
+
+// Service sends per-chain email notifications, either immediately or as
+// hourly/daily digests. Digests are buffered in memory per pod and flushed
+// on shutdown so no session is silently dropped.
+// Nil-safe: all methods are no-ops when service is nil.
+type Service struct {
+	sender       Sender
+	dashboardURL string
+	digestHour   int
+	chains       map[string]*config.ChainEmailConfig
+	logger       *slog.Logger
+
+	mu         sync.Mutex
+	pending    map[string][]SessionCompletedInput // chain ID → sessions awaiting digest
+	lastHourly time.Time                          // start of the hour last flushed
+	lastDaily  time.Time                          // day (UTC midnight) last flushed
+
+	now    func() time.Time
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewService creates a new email notification service.
+// Returns nil if sender is nil or no chain has email recipients.
+func NewService(sender Sender, cfg ServiceConfig) *Service {
+	if sender == nil || len(cfg.Chains) == 0 {
+		return nil
+	}
+	return newService(sender, cfg, time.Now)
+}
+
+func newService(sender Sender, cfg ServiceConfig, now func() time.Time) *Service {
+	s := &Service{
+		sender:       sender,
+		dashboardURL: cfg.DashboardURL,
+		digestHour:   cfg.DigestHour,
+		chains:       cfg.Chains,
+		logger:       slog.Default().With("component", "email-service"),
+		pending:      make(map[string][]SessionCompletedInput),
+		now:          now,
+	}
+	t := now().UTC()
+	s.lastHourly = t.Truncate(time.Hour)
+	s.lastDaily = startOfDay(t)
+	if t.Hour() < s.digestHour {
+		// Today's digest is still ahead of us.
+		s.lastDaily = s.lastDaily.AddDate(0, 0, -1)
+	}
+	return s
+}
+
+// NotifySessionCompleted emails the chain's recipients about a terminal
+// session, or queues it for the chain's next digest.
+// Fail-open: errors are logged, never returned.
+func (s *Service) NotifySessionCompleted(ctx context.Context, input SessionCompletedInput) {
+	if s == nil {
+		return
+	}
+	chain, ok := s.chains[input.ChainID]
+	if !ok {
+		return
+	}
+	if input.CompletedAt.IsZero() {
+		input.CompletedAt = s.now()
+	}
+
+	if chain.EffectiveSchedule() != config.EmailScheduleImmediate {
+		s.mu.Lock()
+		s.pending[input.ChainID] = append(s.pending[input.ChainID], input)
+		s.mu.Unlock()
+		return
+	}
+
+	subject, html, err := BuildSessionMessage(input, s.dashboardURL)
+	if err != nil {
+		s.logger.Error("Failed to render session email", "session_id", input.SessionID, "error", err)
+		return
+	}
+	s.send(ctx, Message{To: chain.Recipients, Subject: subject, HTML: html}, "session_id", input.SessionID)
+}
+
+// Start launches the background digest loop.
+func (s *Service) Start(ctx context.Context) {
+	if s == nil || s.cancel != nil {
+		return
+	}
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go s.run(ctx)
+
+	s.logger.Info("Email service started", "chains", len(s.chains), "digest_hour_utc", s.digestHour)
+}
+
+// Stop exits the digest loop and flushes all pending digests.
+func (s *Service) Stop() {
+	if s == nil || s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	s.flush(ctx, func(config.EmailSchedule) bool { return true }, true)
+	s.logger.Info("Email service stopped")
+}
+
+func (s *Service) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(digestCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.flushDue(ctx)
+		}
+	}
+}
+
+// flushDue sends hourly digests once per clock hour and daily digests once
+// per day at the configured UTC hour.
+func (s *Service) flushDue(ctx context.Context) {
+	now := s.now().UTC()
+	hour := now.Truncate(time.Hour)
+	day := startOfDay(now)
+
+	s.mu.Lock()
+	hourlyDue := hour.After(s.lastHourly)
+	if hourlyDue {
+		s.lastHourly = hour
+	}
+	dailyDue := day.After(s.lastDaily) && now.Hour() >= s.digestHour
+	if dailyDue {
+		s.lastDaily = day
+	}
+	s.mu.Unlock()
+
+	if !hourlyDue && !dailyDue {
+		return
+	}
+	s.flush(ctx, func(sched config.EmailSchedule) bool {
+		return (hourlyDue && sched == config.EmailScheduleHourly) ||
+			(dailyDue && sched == config.EmailScheduleDaily)
+	}, false)
+}
+
+// flush sends one digest per chain whose schedule matches and has pending sessions.
+func (s *Service) flush(ctx context.Context, match func(config.EmailSchedule) bool, partial bool) {
+	s.mu.Lock()
+	batches := make(map[string][]SessionCompletedInput)
+	for chainID, sessions := range s.pending {
+		if len(sessions) == 0 || !match(s.chains[chainID].EffectiveSchedule()) {
+			continue
+		}
+		batches[chainID] = sessions
+		delete(s.pending, chainID)
+	}
+	s.mu.Unlock()
+
+	for chainID, sessions := range batches {
+		chain := s.chains[chainID]
+		subject, html, err := BuildDigestMessage(chainID, string(chain.EffectiveSchedule()), sessions, s.dashboardURL, partial)
+		if err != nil {
+			s.logger.Error("Failed to render digest email", "chain_id", chainID, "error", err)
+			continue
+		}
+		s.send(ctx, Message{To: chain.Recipients, Subject: subject, HTML: html}, "chain_id", chainID, "sessions", len(sessions))
+	}
+}
+
+func (s *Service) send(ctx context.Context, msg Message, logAttrs ...any) {
+	sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	if err := s.sender.Send(sendCtx, msg); err != nil {
+		s.logger.Error("Failed to send email", append(logAttrs, "error", err)...)
+		return
+	}
+	s.logger.Debug("Email sent", append(logAttrs, "recipients", len(msg.To))...)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,161 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSender struct {
+	mu   sync.Mutex
+	sent []Message
+	err  error
+}
+
+func (f *fakeSender) Send(_ context.Context, msg Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func (f *fakeSender) messages() []Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Message(nil), f.sent...)
+}
+
+// newTestService returns a service whose clock is controlled by *clock.
+func newTestService(t *testing.T, sender Sender, digestHour int, chains map[string]*config.ChainEmailConfig, clock *time.Time) *Service {
+	t.Helper()
+	return newService(sender, ServiceConfig{
+		DashboardURL: "https://tarsy.example.com",
+		DigestHour:   digestHour,
+		Chains:       chains,
+	}, func() time.Time { return *clock })
+}
+
+func TestService_NilReceiver(t *testing.T) {
+	var s *Service
+	s.NotifySessionCompleted(context.Background(), SessionCompletedInput{SessionID: "sess-1"})
+	s.Start(context.Background())
+	s.Stop()
+}
+
+func TestNewService(t *testing.T) {
+	chains := map[string]*config.ChainEmailConfig{"k8s": {Recipients: []string{"a@example.com"}}}
+
+	assert.Nil(t, NewService(nil, ServiceConfig{Chains: chains}), "nil sender")
+	assert.Nil(t, NewService(&fakeSender{}, ServiceConfig{}), "no chains")
+	assert.NotNil(t, NewService(&fakeSender{}, ServiceConfig{Chains: chains}))
+}
+
+func TestService_Immediate(t *testing.T) {
+	sender := &fakeSender{}
+	clock := time.Date(2026, 10, 15, 10, 30, 0, 0, time.UTC)
+	svc := newTestService(t, sender, 8, map[string]*config.ChainEmailConfig{
+		"k8s": {Recipients: []string{"oncall@example.com"}},
+	}, &clock)
+
+	svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{
+		SessionID: "sess-1", ChainID: "k8s", AlertType: "PodCrash", Status: "completed", Severity: "high",
+	})
+	svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{
+		SessionID: "sess-2", ChainID: "other", Status: "completed",
+	})
+
+	msgs := sender.messages()
+	require.Len(t, msgs, 1, "chains without email config are skipped")
+	assert.Equal(t, []string{"oncall@example.com"}, msgs[0].To)
+	assert.Equal(t, "[TARSy] [HIGH] Analysis Complete: PodCrash", msgs[0].Subject)
+}
+
+func TestService_SendErrorIsSwallowed(t *testing.T) {
+	sender := &fakeSender{err: errors.New("smtp down")}
+	clock := time.Date(2026, 10, 15, 10, 30, 0, 0, time.UTC)
+	svc := newTestService(t, sender, 8, map[string]*config.ChainEmailConfig{
+		"k8s": {Recipients: []string{"oncall@example.com"}},
+	}, &clock)
+
+	svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{SessionID: "sess-1", ChainID: "k8s", Status: "failed"})
+	assert.Empty(t, sender.messages())
+}
+
+func TestService_HourlyDigest(t *testing.T) {
+	sender := &fakeSender{}
+	clock := time.Date(2026, 10, 15, 10, 5, 0, 0, time.UTC)
+	svc := newTestService(t, sender, 8, map[string]*config.ChainEmailConfig{
+		"k8s": {Recipients: []string{"team@example.com"}, Schedule: config.EmailScheduleHourly},
+	}, &clock)
+
+	for _, id := range []string{"sess-1", "sess-2"} {
+		svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{SessionID: id, ChainID: "k8s", Status: "completed"})
+	}
+	assert.Empty(t, sender.messages(), "digest sessions are queued")
+
+	clock = clock.Add(30 * time.Minute)
+	svc.flushDue(context.Background())
+	assert.Empty(t, sender.messages(), "same hour: not due yet")
+
+	clock = time.Date(2026, 10, 15, 11, 0, 30, 0, time.UTC)
+	svc.flushDue(context.Background())
+	msgs := sender.messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "[TARSy] hourly digest for k8s: 2 session(s)", msgs[0].Subject)
+
+	clock = time.Date(2026, 10, 15, 12, 0, 30, 0, time.UTC)
+	svc.flushDue(context.Background())
+	assert.Len(t, sender.messages(), 1, "empty digests are not sent")
+}
+
+func TestService_DailyDigest(t *testing.T) {
+	sender := &fakeSender{}
+	clock := time.Date(2026, 10, 15, 6, 0, 0, 0, time.UTC)
+	svc := newTestService(t, sender, 8, map[string]*config.ChainEmailConfig{
+		"k8s": {Recipients: []string{"team@example.com"}, Schedule: config.EmailScheduleDaily},
+	}, &clock)
+
+	svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{SessionID: "sess-1", ChainID: "k8s", Status: "completed"})
+
+	clock = time.Date(2026, 10, 15, 7, 59, 0, 0, time.UTC)
+	svc.flushDue(context.Background())
+	assert.Empty(t, sender.messages(), "before digest hour")
+
+	clock = time.Date(2026, 10, 15, 8, 0, 30, 0, time.UTC)
+	svc.flushDue(context.Background())
+	require.Len(t, sender.messages(), 1)
+
+	svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{SessionID: "sess-2", ChainID: "k8s", Status: "failed"})
+	clock = time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+	svc.flushDue(context.Background())
+	assert.Len(t, sender.messages(), 1, "only one daily digest per day")
+
+	clock = time.Date(2026, 10, 16, 8, 1, 0, 0, time.UTC)
+	svc.flushDue(context.Background())
+	assert.Len(t, sender.messages(), 2)
+}
+
+func TestService_StopFlushesPartialDigests(t *testing.T) {
+	sender := &fakeSender{}
+	clock := time.Date(2026, 10, 15, 10, 5, 0, 0, time.UTC)
+	svc := newTestService(t, sender, 8, map[string]*config.ChainEmailConfig{
+		"k8s": {Recipients: []string{"team@example.com"}, Schedule: config.EmailScheduleDaily},
+	}, &clock)
+
+	svc.Start(context.Background())
+	svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{SessionID: "sess-1", ChainID: "k8s", Status: "completed"})
+	svc.Stop()
+
+	msgs := sender.messages()
+	require.Len(t, msgs, 1)
+	assert.Contains(t, msgs[0].HTML, "partial digest")
+}
@@ -0,0 +1,281 @@
+// Package enrichment provides the lookup_ip and lookup_host built-in tools:
+// IP ownership (owner, ASN, location) and asset inventory lookups backed by
+// a CSV file or an HTTP endpoint (system.enrichment), so investigations do
+// not need a dedicated MCP server for trivial lookups.
+package enrichment
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// Record is one lookup result: the CSV row's columns or the HTTP
+// endpoint's JSON object.
+type Record map[string]any
+
+// Source looks up the record for a key (an IP address or a hostname).
+// Returns nil, nil when there is no record.
+type Source interface {
+	Lookup(ctx context.Context, key string) (Record, error)
+}
+
+// Sources holds the configured lookup sources. A nil field disables the
+// corresponding tool.
+type Sources struct {
+	IP   Source
+	Host Source
+}
+
+// NewSources builds the sources configured in cfg, reading CSV files.
+// Returns nil when no source is configured.
+func NewSources(cfg *config.EnrichmentConfig) (*Sources, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+	sources := &Sources{}
+	if src := cfg.IPLookup; src != nil {
+		if src.CSVFile != "" {
+			table, err := LoadIPTable(src.CSVFile)
+			if err != nil {
+				return nil, fmt.Errorf("system.enrichment.ip_lookup: %w", err)
+			}
+			sources.IP = table
+		} else {
+			sources.IP = NewHTTPSource(src.URL, "{ip}", os.Getenv(src.TokenEnv), src.Timeout)
+		}
+	}
+	if src := cfg.HostLookup; src != nil {
+		if src.CSVFile != "" {
+			table, err := LoadHostTable(src.CSVFile)
+			if err != nil {
+				return nil, fmt.Errorf("system.enrichment.host_lookup: %w", err)
+			}
+			sources.Host = table
+		} else {
+			sources.Host = NewHTTPSource(src.URL, "{hostname}", os.Getenv(src.TokenEnv), src.Timeout)
+		}
+	}
+	return sources, nil
+}
+
+// ipEntry is one row of an IP table.
+type ipEntry struct {
+	prefix netip.Prefix
+	record Record
+}
+
+// IPTable resolves addresses to the most specific matching CIDR row.
+type IPTable struct {
+	entries []ipEntry // most specific prefix first
+}
+
+// LoadIPTable reads a CSV file with a "cidr" column.
+func LoadIPTable(path string) (*IPTable, error) {
+	header, rows, err := readCSV(path, "cidr")
+	if err != nil {
+		return nil, err
+	}
+	keyCol := slices.Index(header, "cidr")
+	table := &IPTable{}
+	for i, row := range rows {
+		cidr := strings.TrimSpace(row[keyCol])
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(cidr)
+			if addrErr != nil {
+				return nil, fmt.Errorf("%s line %d: invalid cidr %q", path, i+2, cidr)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		table.entries = append(table.entries, ipEntry{prefix: prefix.Masked(), record: rowRecord(header, row)})
+	}
+	slices.SortStableFunc(table.entries, func(a, b ipEntry) int { return b.prefix.Bits() - a.prefix.Bits() })
+	return table, nil
+}
+
+// Lookup returns the record of the most specific range containing ip.
+func (t *IPTable) Lookup(_ context.Context, ip string) (Record, error) {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IP address %q", ip)
+	}
+	addr = addr.Unmap()
+	for _, e := range t.entries {
+		if e.prefix.Contains(addr) {
+			return e.record, nil
+		}
+	}
+	return nil, nil
+}
+
+// HostTable resolves hostnames to inventory rows. Matching is
+// case-insensitive; a short name also matches a unique fully qualified
+// row and vice versa.
+type HostTable struct {
+	byName  map[string]Record
+	byShort map[string]Record // nil value = ambiguous short name
+}
+
+// LoadHostTable reads a CSV file with a "hostname" column.
+func LoadHostTable(path string) (*HostTable, error) {
+	header, rows, err := readCSV(path, "hostname")
+	if err != nil {
+		return nil, err
+	}
+	keyCol := slices.Index(header, "hostname")
+	table := &HostTable{byName: make(map[string]Record), byShort: make(map[string]Record)}
+	for _, row := range rows {
+		name := strings.ToLower(strings.TrimSpace(row[keyCol]))
+		if name == "" {
+			continue
+		}
+		record := rowRecord(header, row)
+		table.byName[name] = record
+		short := shortName(name)
+		if _, seen := table.byShort[short]; seen {
+			table.byShort[short] = nil
+		} else {
+			table.byShort[short] = record
+		}
+	}
+	return table, nil
+}
+
+// Lookup returns the inventory record of hostname.
+func (t *HostTable) Lookup(_ context.Context, hostname string) (Record, error) {
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(hostname)), ".")
+	if record, ok := t.byName[name]; ok {
+		return record, nil
+	}
+	if record := t.byShort[shortName(name)]; record != nil {
+		return record, nil
+	}
+	return nil, nil
+}
+
+func shortName(hostname string) string {
+	short, _, _ := strings.Cut(hostname, ".")
+	return short
+}
+
+// readCSV reads a CSV file with a header row that must contain keyColumn.
+// Header names are lower-cased and trimmed.
+func readCSV(path, keyColumn string) ([]string, [][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	r := csv.NewReader(f)
+	r.TrimLeadingSpace = true
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil, fmt.Errorf("%s is empty", path)
+	}
+	header := make([]string, len(rows[0]))
+	for i, h := range rows[0] {
+		header[i] = strings.ToLower(strings.TrimSpace(h))
+	}
+	if !slices.Contains(header, keyColumn) {
+		return nil, nil, fmt.Errorf("%s has no %q column", path, keyColumn)
+	}
+	return header, rows[1:], nil
+}
+
+// rowRecord maps a CSV row to a record, skipping empty cells.
+func rowRecord(header, row []string) Record {
+	record := make(Record, len(header))
+	for i, col := range header {
+		if v := strings.TrimSpace(row[i]); v != "" && col != "" {
+			record[col] = v
+		}
+	}
+	return record
+}
+
+// HTTPSource looks records up from an HTTP endpoint returning JSON.
+type HTTPSource struct {
+	httpClient  *http.Client
+	urlTemplate string
+	placeholder string
+	token       string
+	timeout     time.Duration
+}
+
+// NewHTTPSource creates an HTTP source. placeholder in urlTemplate is
+// replaced by the URL-encoded key; token, when set, is sent as a bearer
+// token.
+func NewHTTPSource(urlTemplate, placeholder, token string, timeout time.Duration) *HTTPSource {
+	if timeout <= 0 {
+		timeout = config.DefaultEnrichmentTimeout
+	}
+	return &HTTPSource{
+		httpClient:  &http.Client{},
+		urlTemplate: urlTemplate,
+		placeholder: placeholder,
+		token:       token,
+		timeout:     timeout,
+	}
+}
+
+// Lookup fetches the record of key. A 404 response means no record.
+func (s *HTTPSource) Lookup(ctx context.Context, key string) (Record, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	endpoint := strings.ReplaceAll(s.urlTemplate, s.placeholder, url.QueryEscape(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("lookup request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("lookup returned status %d: %s", resp.StatusCode, string(snippet))
+	}
+
+	var body any
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode lookup response: %w", err)
+	}
+	switch v := body.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return Record(v), nil
+	default:
+		return Record{"result": v}, nil
+	}
+}
+
+// maxResponseBytes caps an HTTP lookup response.
+const maxResponseBytes = 1 << 20
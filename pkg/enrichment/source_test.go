@@ -0,0 +1,150 @@
+package enrichment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCSV(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "table.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestIPTable(t *testing.T) {
+	table, err := LoadIPTable(writeCSV(t, `CIDR, owner, asn, country
+10.0.0.0/8,corp,,
+10.1.0.0/16,payments,AS64500,DE
+203.0.113.7,partner-vpn,AS64501,US
+2001:db8::/32,ipv6-lab,,
+`))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tests := []struct {
+		ip   string
+		want Record
+	}{
+		{"10.1.2.3", Record{"cidr": "10.1.0.0/16", "owner": "payments", "asn": "AS64500", "country": "DE"}},
+		{"10.2.0.1", Record{"cidr": "10.0.0.0/8", "owner": "corp"}},
+		{"203.0.113.7", Record{"cidr": "203.0.113.7", "owner": "partner-vpn", "asn": "AS64501", "country": "US"}},
+		{"::ffff:10.1.0.1", Record{"cidr": "10.1.0.0/16", "owner": "payments", "asn": "AS64500", "country": "DE"}},
+		{"2001:db8::1", Record{"cidr": "2001:db8::/32", "owner": "ipv6-lab"}},
+		{"192.0.2.1", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			got, err := table.Lookup(ctx, tt.ip)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err = table.Lookup(ctx, "not-an-ip")
+	assert.Error(t, err)
+}
+
+func TestLoadIPTable_Errors(t *testing.T) {
+	_, err := LoadIPTable(writeCSV(t, "network,owner\n10.0.0.0/8,corp\n"))
+	assert.ErrorContains(t, err, `no "cidr" column`)
+
+	_, err = LoadIPTable(writeCSV(t, "cidr,owner\n10.0.0.0/33,corp\n"))
+	assert.ErrorContains(t, err, "line 2: invalid cidr")
+
+	_, err = LoadIPTable(filepath.Join(t.TempDir(), "missing.csv"))
+	assert.ErrorContains(t, err, "failed to open")
+}
+
+func TestHostTable(t *testing.T) {
+	table, err := LoadHostTable(writeCSV(t, `hostname,owner,env
+db-01.prod.example.com,dba-team,prod
+db-01.stage.example.com,dba-team,stage
+Web-01.prod.example.com,web-team,prod
+`))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	got, err := table.Lookup(ctx, "web-01.PROD.example.com.")
+	require.NoError(t, err)
+	assert.Equal(t, Record{"hostname": "Web-01.prod.example.com", "owner": "web-team", "env": "prod"}, got)
+
+	got, err = table.Lookup(ctx, "web-01")
+	require.NoError(t, err)
+	assert.Equal(t, "web-team", got["owner"], "unique short name matches")
+
+	got, err = table.Lookup(ctx, "db-01")
+	require.NoError(t, err)
+	assert.Nil(t, got, "ambiguous short name does not match")
+
+	got, err = table.Lookup(ctx, "db-01.stage.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "stage", got["env"])
+}
+
+func TestHTTPSource(t *testing.T) {
+	var gotAuth, gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotPath = r.URL.RawQuery
+		switch r.URL.Query().Get("q") {
+		case "missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "broken":
+			http.Error(w, "boom", http.StatusInternalServerError)
+		case "list":
+			_, _ = w.Write([]byte(`["a","b"]`))
+		default:
+			_, _ = w.Write([]byte(`{"owner":"payments","asn":64500}`))
+		}
+	}))
+	defer srv.Close()
+
+	src := NewHTTPSource(srv.URL+"/lookup?q={hostname}", "{hostname}", "secret", time.Second)
+	ctx := context.Background()
+
+	got, err := src.Lookup(ctx, "db 01")
+	require.NoError(t, err)
+	assert.Equal(t, Record{"owner": "payments", "asn": float64(64500)}, got)
+	assert.Equal(t, "Bearer secret", gotAuth)
+	assert.Equal(t, "q=db+01", gotPath)
+
+	got, err = src.Lookup(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = src.Lookup(ctx, "list")
+	require.NoError(t, err)
+	assert.Equal(t, Record{"result": []any{"a", "b"}}, got)
+
+	_, err = src.Lookup(ctx, "broken")
+	assert.ErrorContains(t, err, "status 500")
+}
+
+func TestNewSources(t *testing.T) {
+	sources, err := NewSources(&config.EnrichmentConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, sources)
+
+	sources, err = NewSources(&config.EnrichmentConfig{
+		IPLookup:   &config.EnrichmentSourceConfig{CSVFile: writeCSV(t, "cidr,owner\n10.0.0.0/8,corp\n")},
+		HostLookup: &config.EnrichmentSourceConfig{URL: "https://cmdb.example.com/hosts/{hostname}"},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, &IPTable{}, sources.IP)
+	assert.IsType(t, &HTTPSource{}, sources.Host)
+
+	_, err = NewSources(&config.EnrichmentConfig{
+		HostLookup: &config.EnrichmentSourceConfig{CSVFile: writeCSV(t, "name\nweb-01\n")},
+	})
+	assert.ErrorContains(t, err, "system.enrichment.host_lookup")
+}
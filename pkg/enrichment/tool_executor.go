@@ -0,0 +1,175 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/builtintools"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+)
+
+// Compile-time check that ToolExecutor implements agent.ToolExecutor.
+var _ agent.ToolExecutor = (*ToolExecutor)(nil)
+
+// Wire names from pkg/builtintools.
+const (
+	ToolLookupIP   = builtintools.LookupIP
+	ToolLookupHost = builtintools.LookupHost
+)
+
+// ToolExecutor wraps an inner agent.ToolExecutor and handles lookup_ip and
+// lookup_host calls. Everything else passes through.
+type ToolExecutor struct {
+	inner   agent.ToolExecutor
+	sources *Sources
+}
+
+// NewToolExecutor creates a lookup tool executor around inner. Only the
+// tools whose source is set in sources are offered.
+func NewToolExecutor(inner agent.ToolExecutor, sources *Sources) *ToolExecutor {
+	return &ToolExecutor{inner: inner, sources: sources}
+}
+
+// toolDefinitions returns the definitions of the configured lookup tools.
+func (te *ToolExecutor) toolDefinitions() []agent.ToolDefinition {
+	var defs []agent.ToolDefinition
+	if te.sources.IP != nil {
+		defs = append(defs, agent.ToolDefinition{
+			Name: ToolLookupIP,
+			Description: "Look up who owns an IP address (owner, ASN, location or network details) " +
+				"from the organization's IP inventory. Returns the record as JSON.",
+			ParametersSchema: `{
+  "type": "object",
+  "properties": {
+    "ip": {
+      "type": "string",
+      "description": "IPv4 or IPv6 address"
+    }
+  },
+  "required": ["ip"]
+}`,
+		})
+	}
+	if te.sources.Host != nil {
+		defs = append(defs, agent.ToolDefinition{
+			Name: ToolLookupHost,
+			Description: "Look up a host in the asset inventory (owner, environment, role and similar details). " +
+				"Returns the record as JSON.",
+			ParametersSchema: `{
+  "type": "object",
+  "properties": {
+    "hostname": {
+      "type": "string",
+      "description": "Short or fully qualified hostname"
+    }
+  },
+  "required": ["hostname"]
+}`,
+		})
+	}
+	return defs
+}
+
+// ListTools returns the lookup tools followed by the inner tools.
+func (te *ToolExecutor) ListTools(ctx context.Context) ([]agent.ToolDefinition, error) {
+	innerTools, err := te.inner.ListTools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inner tools: %w", err)
+	}
+	defs := te.toolDefinitions()
+	tools := make([]agent.ToolDefinition, 0, len(innerTools)+len(defs))
+	tools = append(tools, defs...)
+	for _, t := range innerTools {
+		if t.Name != ToolLookupIP && t.Name != ToolLookupHost {
+			tools = append(tools, t)
+		}
+	}
+	return tools, nil
+}
+
+// Execute runs a lookup and delegates every other call.
+func (te *ToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+	var content string
+	var err error
+	switch mcp.NormalizeBuiltinPlainToolName(call.Name) {
+	case ToolLookupIP:
+		if te.sources.IP == nil {
+			return te.inner.Execute(ctx, call)
+		}
+		content, err = te.lookupIP(ctx, call.Arguments)
+	case ToolLookupHost:
+		if te.sources.Host == nil {
+			return te.inner.Execute(ctx, call)
+		}
+		content, err = te.lookupHost(ctx, call.Arguments)
+	default:
+		return te.inner.Execute(ctx, call)
+	}
+
+	if err != nil {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: err.Error(),
+			IsError: true,
+		}, nil
+	}
+	return &agent.ToolResult{
+		CallID:  call.ID,
+		Name:    call.Name,
+		Content: content,
+	}, nil
+}
+
+func (te *ToolExecutor) lookupIP(ctx context.Context, arguments string) (string, error) {
+	var args struct {
+		IP string `json:"ip"`
+	}
+	if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+		return "", fmt.Errorf("invalid arguments: %v", err)
+	}
+	ip := strings.TrimSpace(args.IP)
+	if _, err := netip.ParseAddr(ip); err != nil {
+		return "", fmt.Errorf("invalid IP address %q", args.IP)
+	}
+	return lookup(ctx, te.sources.IP, ip, "IP address")
+}
+
+func (te *ToolExecutor) lookupHost(ctx context.Context, arguments string) (string, error) {
+	var args struct {
+		Hostname string `json:"hostname"`
+	}
+	if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+		return "", fmt.Errorf("invalid arguments: %v", err)
+	}
+	hostname := strings.TrimSpace(args.Hostname)
+	if hostname == "" {
+		return "", fmt.Errorf("hostname is required")
+	}
+	return lookup(ctx, te.sources.Host, hostname, "host")
+}
+
+// lookup queries src and renders the record as JSON.
+func lookup(ctx context.Context, src Source, key, what string) (string, error) {
+	record, err := src.Lookup(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("lookup of %s failed: %v", key, err)
+	}
+	if record == nil {
+		return fmt.Sprintf("No record for %s %s.", what, key), nil
+	}
+	out, err := json.Marshal(record)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode record: %v", err)
+	}
+	return string(out), nil
+}
+
+// Close delegates to the inner executor.
+func (te *ToolExecutor) Close() error {
+	return te.inner.Close()
+}
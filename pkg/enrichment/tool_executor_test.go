@@ -0,0 +1,100 @@
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSource returns fixed records by key.
+type stubSource struct {
+	records map[string]Record
+	err     error
+}
+
+func (s *stubSource) Lookup(_ context.Context, key string) (Record, error) {
+	return s.records[key], s.err
+}
+
+func newTestExecutor(sources *Sources) *ToolExecutor {
+	return NewToolExecutor(agent.NewStubToolExecutor([]agent.ToolDefinition{
+		{Name: "kubernetes-server.get_pods"},
+	}), sources)
+}
+
+func TestToolExecutor_ListTools(t *testing.T) {
+	t.Run("both sources", func(t *testing.T) {
+		tools, err := newTestExecutor(&Sources{IP: &stubSource{}, Host: &stubSource{}}).ListTools(context.Background())
+		require.NoError(t, err)
+		require.Len(t, tools, 3)
+		assert.Equal(t, ToolLookupIP, tools[0].Name)
+		assert.Equal(t, ToolLookupHost, tools[1].Name)
+		assert.Equal(t, "kubernetes-server.get_pods", tools[2].Name)
+	})
+
+	t.Run("host source only", func(t *testing.T) {
+		tools, err := newTestExecutor(&Sources{Host: &stubSource{}}).ListTools(context.Background())
+		require.NoError(t, err)
+		require.Len(t, tools, 2)
+		assert.Equal(t, ToolLookupHost, tools[0].Name)
+	})
+}
+
+func TestToolExecutor_Execute(t *testing.T) {
+	te := newTestExecutor(&Sources{
+		IP:   &stubSource{records: map[string]Record{"10.1.2.3": {"owner": "payments", "asn": "AS64500"}}},
+		Host: &stubSource{err: errors.New("connection refused")},
+	})
+	ctx := context.Background()
+
+	run := func(name, args string) *agent.ToolResult {
+		t.Helper()
+		result, err := te.Execute(ctx, agent.ToolCall{ID: "call-1", Name: name, Arguments: args})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("record found", func(t *testing.T) {
+		result := run(ToolLookupIP, `{"ip":" 10.1.2.3 "}`)
+		assert.False(t, result.IsError)
+		assert.JSONEq(t, `{"owner":"payments","asn":"AS64500"}`, result.Content)
+	})
+
+	t.Run("no record", func(t *testing.T) {
+		result := run("google:"+ToolLookupIP, `{"ip":"192.0.2.1"}`)
+		assert.False(t, result.IsError)
+		assert.Equal(t, "No record for IP address 192.0.2.1.", result.Content)
+	})
+
+	errorCases := []struct {
+		name, tool, args, want string
+	}{
+		{"invalid IP", ToolLookupIP, `{"ip":"10.1.2"}`, `invalid IP address "10.1.2"`},
+		{"missing hostname", ToolLookupHost, `{}`, "hostname is required"},
+		{"source error", ToolLookupHost, `{"hostname":"web-01"}`, "lookup of web-01 failed: connection refused"},
+		{"invalid JSON", ToolLookupIP, `{`, "invalid arguments"},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := run(tc.tool, tc.args)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content, tc.want)
+		})
+	}
+}
+
+func TestToolExecutor_DelegatesUnconfiguredAndOtherTools(t *testing.T) {
+	te := newTestExecutor(&Sources{IP: &stubSource{}})
+
+	result, err := te.Execute(context.Background(), agent.ToolCall{Name: "kubernetes-server.get_pods", Arguments: "{}"})
+	require.NoError(t, err)
+	assert.NotContains(t, result.Content, "No record")
+
+	result, err = te.Execute(context.Background(), agent.ToolCall{Name: ToolLookupHost, Arguments: `{"hostname":"web-01"}`})
+	require.NoError(t, err)
+	assert.NotContains(t, result.Content, "No record")
+}
@@ -0,0 +1,437 @@
+// Package client is a Go client for the TARSy WebSocket event stream
+// (GET /api/v1/ws), for internal services that follow sessions in real time.
+//
+// It subscribes to channels (events.GlobalSessionsChannel,
+// events.SessionChannel, events.UserChannel), hands each event to the
+// channel's handlers with the typed payloads of pkg/events (see Event.Decode),
+// and keeps the connection alive: protocol pings detect dead connections and
+// reconnects back off exponentially (200ms doubling to 3s, never giving up).
+// Subscriptions request events.ProtocolVersion, the protocol of the payload
+// types the client is built with; an older server downconverts to its own.
+//
+// On subscribe the server replays the channel's persisted events. After a
+// reconnect, each channel resumes after the last persisted event it
+// delivered, and events delivered twice (replayed and live) are dropped.
+// Transient events (stream.chunk, progress) sent while disconnected are
+// lost. When a pod shuts down it sends connection.going_away; the client
+// then reconnects after the delay the server suggests instead of backing
+// off. When more events were missed than the server replays, the channel's
+// handlers get a catchup.overflow event: reload the state over REST.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+)
+
+// Defaults applied when Config leaves a setting unset. They match the
+// dashboard's WebSocket client.
+const (
+	DefaultPingInterval = 20 * time.Second
+	DefaultPongTimeout  = 10 * time.Second
+	DefaultMinBackoff   = 200 * time.Millisecond
+	DefaultMaxBackoff   = 3 * time.Second
+)
+
+const (
+	// maxMessageBytes caps a single WebSocket message. Replayed events carry
+	// the full stored payload, which may exceed the NOTIFY size limit.
+	maxMessageBytes = 16 << 20
+
+	// dedupWindow is how many recently delivered persisted events each
+	// channel remembers to drop duplicates.
+	dedupWindow = 1024
+)
+
+// Config configures a Client.
+type Config struct {
+	// URL is the WebSocket endpoint, e.g. wss://tarsy.example.com/api/v1/ws.
+	URL string
+
+	// Header is sent with every dial, e.g. an Authorization header or the
+	// oauth2-proxy session cookie.
+	Header http.Header
+
+	// PingInterval is how often the connection is pinged.
+	PingInterval time.Duration
+
+	// PongTimeout is how long a ping may go unanswered before the
+	// connection is dropped and re-established.
+	PongTimeout time.Duration
+
+	// MinBackoff and MaxBackoff bound the delay between reconnects.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// OnConnectionChange, when set, is called with true after each
+	// successful dial and with false when the connection drops.
+	OnConnectionChange func(connected bool)
+
+	// Logger defaults to slog.Default().
+	Logger *slog.Logger
+}
+
+// Event is one message delivered on a subscribed channel.
+type Event struct {
+	// Channel the event was delivered on.
+	Channel string
+
+	// Type is the event type (e.g. events.EventTypeSessionStatus), or
+	// events.MessageTypeCatchupOverflow / events.MessageTypeSubscriptionError
+	// for the channel's control messages.
+	Type string
+
+	SessionID string
+
+	// DBEventID is the position of a persisted event in its channel; zero
+	// for transient events and control messages.
+	DBEventID int
+
+	// Truncated marks an event cut to its routing fields because it exceeded
+	// the NOTIFY size limit. Fetch the full event over REST.
+	Truncated bool
+
+	// Message explains a subscription.error.
+	Message string
+
+	// Raw is the JSON payload as received.
+	Raw json.RawMessage
+}
+
+// Handler receives the events of a channel. Handlers run on the client's
+// read loop, one event at a time; a slow handler delays every channel.
+type Handler func(Event)
+
+// Client is a reconnecting WebSocket client. Subscribe may be called before
+// or while Run is running.
+type Client struct {
+	cfg    Config
+	logger *slog.Logger
+
+	mu         sync.Mutex
+	conn       *websocket.Conn // nil while disconnected
+	subs       map[string]*subscription
+	retryAfter time.Duration // reconnect delay from connection.going_away
+}
+
+// subscription is the client-side state of one channel.
+type subscription struct {
+	filter      *SubscriptionFilter
+	handlers    []*Handler
+	lastEventID int
+	seen        map[int]struct{}
+	seenOrder   []int
+}
+
+// envelope holds the fields of an incoming message the client routes on.
+type envelope struct {
+	Type      string `json:"type"`
+	Channel   string `json:"channel"`
+	SessionID string `json:"session_id"`
+	DBEventID int    `json:"db_event_id"`
+	Truncated bool   `json:"truncated"`
+	Message   string `json:"message"`
+
+	RetryAfterMS int64 `json:"retry_after_ms"`
+}
+
+// New creates a client for the endpoint in cfg.URL.
+func New(cfg Config) (*Client, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("url is required")
+	}
+	if cfg.PingInterval <= 0 {
+		cfg.PingInterval = DefaultPingInterval
+	}
+	if cfg.PongTimeout <= 0 {
+		cfg.PongTimeout = DefaultPongTimeout
+	}
+	if cfg.MinBackoff <= 0 {
+		cfg.MinBackoff = DefaultMinBackoff
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		cfg.MaxBackoff = max(DefaultMaxBackoff, cfg.MinBackoff)
+	}
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Client{
+		cfg:    cfg,
+		logger: logger.With("component", "tarsy-ws-client"),
+		subs:   make(map[string]*subscription),
+	}, nil
+}
+
+// Subscribe registers h for the events of channel and returns a function
+// that removes it. The first subscription to a channel sets its server-side
+// filter (nil for every event); later ones share it. The channel is
+// unsubscribed when its last handler is removed.
+func (c *Client) Subscribe(channel string, filter *SubscriptionFilter, h Handler) (unsubscribe func()) {
+	handler := &h
+
+	c.mu.Lock()
+	sub, exists := c.subs[channel]
+	if !exists {
+		sub = &subscription{filter: filter, seen: make(map[int]struct{})}
+		c.subs[channel] = sub
+	}
+	sub.handlers = append(sub.handlers, handler)
+	conn := c.conn
+	c.mu.Unlock()
+
+	if !exists && conn != nil {
+		c.send(conn, events.ClientMessage{Action: "subscribe", Channel: channel, Filter: filter, ProtocolVersion: events.ProtocolVersion})
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { c.removeHandler(channel, handler) })
+	}
+}
+
+func (c *Client) removeHandler(channel string, handler *Handler) {
+	c.mu.Lock()
+	sub, ok := c.subs[channel]
+	if !ok {
+		c.mu.Unlock()
+		return
+	}
+	sub.handlers = slices.DeleteFunc(sub.handlers, func(h *Handler) bool { return h == handler })
+	last := len(sub.handlers) == 0
+	if last {
+		delete(c.subs, channel)
+	}
+	conn := c.conn
+	c.mu.Unlock()
+
+	if last && conn != nil {
+		c.send(conn, events.ClientMessage{Action: "unsubscribe", Channel: channel})
+	}
+}
+
+// Run connects and delivers events until ctx is cancelled, reconnecting
+// whenever the connection drops. Always returns ctx's error.
+func (c *Client) Run(ctx context.Context) error {
+	attempt := 0
+	for {
+		connected, err := c.runConnection(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if connected {
+			attempt = 0
+		}
+		var delay time.Duration
+		if retryAfter := c.takeRetryAfter(); retryAfter > 0 {
+			// The pod is shutting down; this is not a failure
+			delay = retryAfter
+			c.logger.Info("WebSocket server going away, reconnecting", "retry_in", delay)
+		} else {
+			delay = c.backoff(attempt)
+			attempt++
+			c.logger.Warn("WebSocket disconnected, reconnecting", "error", err, "retry_in", delay)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// takeRetryAfter returns and clears the reconnect delay the server asked
+// for when it closed the last connection.
+func (c *Client) takeRetryAfter() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d := c.retryAfter
+	c.retryAfter = 0
+	return d
+}
+
+// backoff returns the delay before reconnect attempt n (0-based).
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.cfg.MinBackoff
+	for range attempt {
+		delay *= 2
+		if delay >= c.cfg.MaxBackoff {
+			return c.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// runConnection dials, resubscribes every channel and reads until the
+// connection fails. Reports whether the dial succeeded.
+func (c *Client) runConnection(ctx context.Context) (bool, error) {
+	conn, _, err := websocket.Dial(ctx, c.cfg.URL, &websocket.DialOptions{HTTPHeader: c.cfg.Header})
+	if err != nil {
+		return false, fmt.Errorf("dial: %w", err)
+	}
+	defer func() { _ = conn.CloseNow() }()
+	conn.SetReadLimit(maxMessageBytes)
+
+	connCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Resubscribe under the lock so a concurrent Subscribe either lands in
+	// this snapshot or sees the new connection and subscribes itself.
+	c.mu.Lock()
+	c.conn = conn
+	resubscribe := make([]events.ClientMessage, 0, len(c.subs))
+	for channel, sub := range c.subs {
+		msg := events.ClientMessage{Action: "subscribe", Channel: channel, Filter: sub.filter, ProtocolVersion: events.ProtocolVersion}
+		if sub.lastEventID > 0 {
+			last := sub.lastEventID
+			msg.LastEventID = &last
+		}
+		resubscribe = append(resubscribe, msg)
+	}
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.conn = nil
+		c.mu.Unlock()
+	}()
+
+	c.notifyConnection(true)
+	defer c.notifyConnection(false)
+
+	for _, msg := range resubscribe {
+		c.send(conn, msg)
+	}
+	go c.keepalive(connCtx, conn)
+
+	for {
+		_, data, err := conn.Read(connCtx)
+		if err != nil {
+			return true, err
+		}
+		c.dispatch(data)
+	}
+}
+
+// keepalive pings the server and drops the connection when a pong is late,
+// so Run reconnects instead of waiting on a dead socket.
+func (c *Client) keepalive(ctx context.Context, conn *websocket.Conn) {
+	ticker := time.NewTicker(c.cfg.PingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(ctx, c.cfg.PongTimeout)
+			err := conn.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				if ctx.Err() == nil {
+					c.logger.Warn("WebSocket ping failed, dropping connection", "error", err)
+					_ = conn.Close(websocket.StatusGoingAway, "ping timeout")
+				}
+				return
+			}
+		}
+	}
+}
+
+// dispatch routes one message to the handlers of its channel.
+func (c *Client) dispatch(data []byte) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		c.logger.Warn("Ignoring malformed WebSocket message", "error", err)
+		return
+	}
+	switch env.Type {
+	case events.MessageTypeConnectionEstablished, events.MessageTypeSubscriptionConfirmed, events.MessageTypePong:
+		return
+	case events.MessageTypeError:
+		c.logger.Warn("WebSocket server error", "message", env.Message)
+		return
+	case events.MessageTypeConnectionGoingAway:
+		// Resubscribing with the positions tracked here makes the resume
+		// token unnecessary
+		c.mu.Lock()
+		c.retryAfter = time.Duration(env.RetryAfterMS) * time.Millisecond
+		c.mu.Unlock()
+		return
+	}
+
+	channel := env.Channel
+	if channel == "" && env.SessionID != "" {
+		// Servers that predate protocol version 2 do not tag the channel
+		channel = events.SessionChannel(env.SessionID)
+	}
+
+	c.mu.Lock()
+	sub, ok := c.subs[channel]
+	if !ok || (env.DBEventID > 0 && !sub.markDelivered(env.DBEventID)) {
+		c.mu.Unlock()
+		return
+	}
+	handlers := slices.Clone(sub.handlers)
+	c.mu.Unlock()
+
+	evt := Event{
+		Channel:   channel,
+		Type:      env.Type,
+		SessionID: env.SessionID,
+		DBEventID: env.DBEventID,
+		Truncated: env.Truncated,
+		Message:   env.Message,
+		Raw:       json.RawMessage(data),
+	}
+	for _, h := range handlers {
+		(*h)(evt)
+	}
+}
+
+// markDelivered records a persisted event and reports whether it is new.
+// Caller holds the client lock.
+func (s *subscription) markDelivered(id int) bool {
+	if _, dup := s.seen[id]; dup {
+		return false
+	}
+	s.seen[id] = struct{}{}
+	s.seenOrder = append(s.seenOrder, id)
+	if len(s.seenOrder) > dedupWindow {
+		delete(s.seen, s.seenOrder[0])
+		s.seenOrder = s.seenOrder[1:]
+	}
+	s.lastEventID = max(s.lastEventID, id)
+	return true
+}
+
+// send writes a client message. Failures are logged; a broken connection is
+// detected by the read loop.
+func (c *Client) send(conn *websocket.Conn, msg events.ClientMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		c.logger.Warn("Failed to marshal WebSocket message", "action", msg.Action, "error", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.cfg.PongTimeout)
+	defer cancel()
+	if err := conn.Write(ctx, websocket.MessageText, data); err != nil {
+		c.logger.Warn("Failed to send WebSocket message", "action", msg.Action, "channel", msg.Channel, "error", err)
+	}
+}
+
+func (c *Client) notifyConnection(connected bool) {
+	if c.cfg.OnConnectionChange != nil {
+		c.cfg.OnConnectionChange(connected)
+	}
+}
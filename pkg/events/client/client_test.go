@@ -0,0 +1,282 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+)
+
+// fakeQuerier serves catchup events and records the sinceID of each query.
+type fakeQuerier struct {
+	mu     sync.Mutex
+	events []events.CatchupEvent
+	since  []int
+}
+
+func (q *fakeQuerier) GetCatchupEvents(_ context.Context, _ string, sinceID, limit int) ([]events.CatchupEvent, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.since = append(q.since, sinceID)
+	var out []events.CatchupEvent
+	for _, evt := range q.events {
+		if evt.ID > sinceID && len(out) < limit {
+			// Copied: the manager tags the payload with the channel
+			payload := make(map[string]interface{}, len(evt.Payload))
+			for k, v := range evt.Payload {
+				payload[k] = v
+			}
+			out = append(out, events.CatchupEvent{ID: evt.ID, Payload: payload})
+		}
+	}
+	return out, nil
+}
+
+func (q *fakeQuerier) sinceIDs() []int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]int(nil), q.since...)
+}
+
+// testServer runs a ConnectionManager behind httptest. drop closes every
+// open connection, as when a pod restarts.
+type testServer struct {
+	manager *events.ConnectionManager
+	url     string
+
+	mu    sync.Mutex
+	conns []context.CancelFunc
+}
+
+func newTestServer(t *testing.T, q events.CatchupQuerier) *testServer {
+	t.Helper()
+	ts := &testServer{manager: events.NewConnectionManager(q, 5*time.Second)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+		if err != nil {
+			t.Logf("WebSocket accept error: %v", err)
+			return
+		}
+		ctx, cancel := context.WithCancel(r.Context())
+		ts.mu.Lock()
+		ts.conns = append(ts.conns, cancel)
+		ts.mu.Unlock()
+		ts.manager.HandleConnection(ctx, conn)
+	}))
+	t.Cleanup(server.Close)
+	ts.url = "ws" + server.URL[len("http"):]
+	return ts
+}
+
+func (ts *testServer) drop() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for _, cancel := range ts.conns {
+		cancel()
+	}
+	ts.conns = nil
+}
+
+// recorder collects the events delivered to a handler.
+type recorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (r *recorder) handle(evt Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, evt)
+}
+
+func (r *recorder) get() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events...)
+}
+
+func (r *recorder) types() []string {
+	var out []string
+	for _, evt := range r.get() {
+		out = append(out, evt.Type)
+	}
+	return out
+}
+
+func startClient(t *testing.T, url string, onChange func(bool)) *Client {
+	t.Helper()
+	c, err := New(Config{URL: url, MinBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond, OnConnectionChange: onChange})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- c.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+	})
+	return c
+}
+
+func statusEvent(id int, sessionID, status string) events.CatchupEvent {
+	return events.CatchupEvent{ID: id, Payload: map[string]interface{}{
+		"type":       events.EventTypeSessionStatus,
+		"session_id": sessionID,
+		"status":     status,
+		"timestamp":  "2026-10-16T12:00:00Z",
+	}}
+}
+
+func broadcast(t *testing.T, m *events.ConnectionManager, channel string, id int, payload map[string]interface{}) {
+	t.Helper()
+	if id > 0 {
+		payload["db_event_id"] = id
+	}
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	m.Broadcast(channel, data)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Config{})
+	require.Error(t, err)
+
+	c, err := New(Config{URL: "ws://localhost/api/v1/ws"})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPingInterval, c.cfg.PingInterval)
+	assert.Equal(t, DefaultPongTimeout, c.cfg.PongTimeout)
+	assert.Equal(t, DefaultMinBackoff, c.cfg.MinBackoff)
+	assert.Equal(t, DefaultMaxBackoff, c.cfg.MaxBackoff)
+}
+
+func TestClient_Backoff(t *testing.T) {
+	c, err := New(Config{URL: "ws://localhost/api/v1/ws"})
+	require.NoError(t, err)
+	assert.Equal(t, 200*time.Millisecond, c.backoff(0))
+	assert.Equal(t, 400*time.Millisecond, c.backoff(1))
+	assert.Equal(t, 1600*time.Millisecond, c.backoff(3))
+	assert.Equal(t, 3*time.Second, c.backoff(4))
+	assert.Equal(t, 3*time.Second, c.backoff(50))
+}
+
+func TestClient_GoingAway(t *testing.T) {
+	c, err := New(Config{URL: "ws://localhost/api/v1/ws"})
+	require.NoError(t, err)
+	c.dispatch([]byte(`{"type":"connection.going_away","retry_after_ms":2500,"resume_token":"abc"}`))
+	assert.Equal(t, 2500*time.Millisecond, c.takeRetryAfter())
+	assert.Zero(t, c.takeRetryAfter(), "the delay applies to one reconnect only")
+}
+
+func TestClient_CatchupAndLiveEvents(t *testing.T) {
+	channel := events.SessionChannel("sess-1")
+	q := &fakeQuerier{events: []events.CatchupEvent{
+		statusEvent(1, "sess-1", "pending"),
+		statusEvent(2, "sess-1", "in_progress"),
+	}}
+	ts := newTestServer(t, q)
+	c := startClient(t, ts.url, nil)
+
+	rec := &recorder{}
+	c.Subscribe(channel, nil, rec.handle)
+
+	require.Eventually(t, func() bool { return len(rec.get()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	first := rec.get()[0]
+	assert.Equal(t, channel, first.Channel)
+	assert.Equal(t, "sess-1", first.SessionID)
+	assert.Equal(t, 1, first.DBEventID)
+
+	payload, err := first.Decode()
+	require.NoError(t, err)
+	status, ok := payload.(*SessionStatusPayload)
+	require.True(t, ok)
+	assert.Equal(t, "pending", string(status.Status))
+
+	// Live events, including a replayed duplicate and a transient chunk
+	broadcast(t, ts.manager, channel, 2, map[string]interface{}{
+		"type": events.EventTypeSessionStatus, "session_id": "sess-1", "status": "in_progress",
+	})
+	broadcast(t, ts.manager, channel, 0, map[string]interface{}{
+		"type": events.EventTypeStreamChunk, "session_id": "sess-1", "event_id": "tl-1", "delta": "he",
+	})
+	broadcast(t, ts.manager, channel, 3, map[string]interface{}{
+		"type": events.EventTypeSessionStatus, "session_id": "sess-1", "status": "completed",
+	})
+
+	require.Eventually(t, func() bool { return len(rec.get()) == 4 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{
+		events.EventTypeSessionStatus, events.EventTypeSessionStatus,
+		events.EventTypeStreamChunk, events.EventTypeSessionStatus,
+	}, rec.types())
+
+	chunk, err := rec.get()[2].Decode()
+	require.NoError(t, err)
+	assert.Equal(t, "he", chunk.(*StreamChunkPayload).Delta)
+	assert.Equal(t, 3, rec.get()[3].DBEventID)
+}
+
+func TestClient_ReconnectResumes(t *testing.T) {
+	channel := events.SessionChannel("sess-1")
+	q := &fakeQuerier{events: []events.CatchupEvent{statusEvent(1, "sess-1", "pending")}}
+	ts := newTestServer(t, q)
+
+	var mu sync.Mutex
+	var changes []bool
+	c := startClient(t, ts.url, func(connected bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		changes = append(changes, connected)
+	})
+
+	rec := &recorder{}
+	c.Subscribe(channel, nil, rec.handle)
+	require.Eventually(t, func() bool { return len(rec.get()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// Missed while disconnected
+	q.mu.Lock()
+	q.events = append(q.events, statusEvent(2, "sess-1", "in_progress"))
+	q.mu.Unlock()
+	ts.drop()
+
+	require.Eventually(t, func() bool { return len(rec.get()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, rec.get()[1].DBEventID)
+	assert.Equal(t, []int{0, 1}, q.sinceIDs(), "reconnect resumes after the last delivered event")
+
+	mu.Lock()
+	assert.Equal(t, []bool{true, false, true}, changes)
+	mu.Unlock()
+}
+
+func TestClient_Unsubscribe(t *testing.T) {
+	ts := newTestServer(t, &fakeQuerier{events: []events.CatchupEvent{statusEvent(1, "sess-1", "pending")}})
+	c := startClient(t, ts.url, nil)
+
+	kept, removed := &recorder{}, &recorder{}
+	c.Subscribe(events.GlobalSessionsChannel, nil, kept.handle)
+	unsubscribe := c.Subscribe(events.GlobalSessionsChannel, nil, removed.handle)
+	require.Eventually(t, func() bool { return len(kept.get()) == 1 && len(removed.get()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	unsubscribe()
+	unsubscribe() // idempotent
+	broadcast(t, ts.manager, events.GlobalSessionsChannel, 2, map[string]interface{}{
+		"type": events.EventTypeSessionStatus, "session_id": "sess-1", "status": "completed",
+	})
+
+	require.Eventually(t, func() bool { return len(kept.get()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, removed.get(), 1)
+}
+
+func TestEvent_Decode_UnknownType(t *testing.T) {
+	_, err := Event{Type: "session.unknown", Raw: json.RawMessage(`{}`)}.Decode()
+	require.Error(t, err)
+
+	_, err = Event{Type: events.EventTypeSessionStatus, Raw: json.RawMessage(`not json`)}.Decode()
+	require.Error(t, err)
+}
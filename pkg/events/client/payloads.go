@@ -0,0 +1,71 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+)
+
+// Payload types delivered over the WebSocket. They alias the structs the
+// server publishes, so consumers share one definition with the server.
+type (
+	BasePayload                   = events.BasePayload
+	TimelineCreatedPayload        = events.TimelineCreatedPayload
+	TimelineCompletedPayload      = events.TimelineCompletedPayload
+	StreamChunkPayload            = events.StreamChunkPayload
+	SessionStatusPayload          = events.SessionStatusPayload
+	StageStatusPayload            = events.StageStatusPayload
+	ChatCreatedPayload            = events.ChatCreatedPayload
+	ChatQueuePositionPayload      = events.ChatQueuePositionPayload
+	InteractionCreatedPayload     = events.InteractionCreatedPayload
+	SessionProgressPayload        = events.SessionProgressPayload
+	ExecutionProgressPayload      = events.ExecutionProgressPayload
+	ReviewStatusPayload           = events.ReviewStatusPayload
+	SessionScoreUpdatedPayload    = events.SessionScoreUpdatedPayload
+	ExecutionStatusPayload        = events.ExecutionStatusPayload
+	SubAgentDispatchedPayload     = events.SubAgentDispatchedPayload
+	SubAgentStatusPayload         = events.SubAgentStatusPayload
+	SavedViewMatchedPayload       = events.SavedViewMatchedPayload
+	SessionOrphanRecoveredPayload = events.SessionOrphanRecoveredPayload
+	MCPServerLogPayload           = events.MCPServerLogPayload
+	SubscriptionFilter            = events.SubscriptionFilter
+)
+
+// payloadTypes maps each event type to a constructor of its payload.
+var payloadTypes = map[string]func() any{
+	events.EventTypeTimelineCreated:        func() any { return &TimelineCreatedPayload{} },
+	events.EventTypeTimelineCompleted:      func() any { return &TimelineCompletedPayload{} },
+	events.EventTypeStreamChunk:            func() any { return &StreamChunkPayload{} },
+	events.EventTypeSessionStatus:          func() any { return &SessionStatusPayload{} },
+	events.EventTypeStageStatus:            func() any { return &StageStatusPayload{} },
+	events.EventTypeChatCreated:            func() any { return &ChatCreatedPayload{} },
+	events.EventTypeChatQueuePosition:      func() any { return &ChatQueuePositionPayload{} },
+	events.EventTypeInteractionCreated:     func() any { return &InteractionCreatedPayload{} },
+	events.EventTypeSessionProgress:        func() any { return &SessionProgressPayload{} },
+	events.EventTypeExecutionProgress:      func() any { return &ExecutionProgressPayload{} },
+	events.EventTypeReviewStatus:           func() any { return &ReviewStatusPayload{} },
+	events.EventTypeSessionScoreUpdated:    func() any { return &SessionScoreUpdatedPayload{} },
+	events.EventTypeExecutionStatus:        func() any { return &ExecutionStatusPayload{} },
+	events.EventTypeSubAgentDispatched:     func() any { return &SubAgentDispatchedPayload{} },
+	events.EventTypeSubAgentStatus:         func() any { return &SubAgentStatusPayload{} },
+	events.EventTypeSavedViewMatched:       func() any { return &SavedViewMatchedPayload{} },
+	events.EventTypeSessionOrphanRecovered: func() any { return &SessionOrphanRecoveredPayload{} },
+	events.EventTypeMCPServerLog:           func() any { return &MCPServerLogPayload{} },
+}
+
+// Decode unmarshals the event into the payload struct of its type and
+// returns a pointer to it, e.g. *SessionStatusPayload for session.status.
+// Truncated events decode to a payload holding only the routing fields;
+// fetch the full event over REST.
+func (e Event) Decode() (any, error) {
+	newPayload, ok := payloadTypes[e.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %q", e.Type)
+	}
+	payload := newPayload()
+	if err := json.Unmarshal(e.Raw, payload); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event: %w", e.Type, err)
+	}
+	return payload, nil
+}
@@ -0,0 +1,102 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// maxFilterValues bounds each SubscriptionFilter list so a client cannot make
+// every Broadcast scan an arbitrarily long list.
+const maxFilterValues = 50
+
+// SubscriptionFilter narrows a channel subscription to matching events. It is
+// evaluated server-side before sending, so clients that only render a slice of
+// a busy channel (e.g. a wallboard watching failures of one chain on the
+// global sessions channel) don't pay for the rest of the stream.
+//
+// Each non-empty list must contain the event's value for the corresponding
+// top-level payload field; empty lists match everything. Events that lack a
+// filtered field (e.g. session.progress has no chain_id) do not match.
+type SubscriptionFilter struct {
+	EventTypes []string `json:"event_types,omitempty"` // payload "type" (e.g. session.status)
+	Statuses   []string `json:"statuses,omitempty"`    // payload "status" (e.g. failed, timed_out)
+	ChainIDs   []string `json:"chain_ids,omitempty"`   // payload "chain_id"
+	AlertTypes []string `json:"alert_types,omitempty"` // payload "alert_type"
+}
+
+// filterFields holds the payload fields a SubscriptionFilter inspects, and
+// the position of persisted events.
+type filterFields struct {
+	Type      string `json:"type"`
+	Status    string `json:"status"`
+	ChainID   string `json:"chain_id"`
+	AlertType string `json:"alert_type"`
+	DBEventID int    `json:"db_event_id"`
+}
+
+// Validate rejects filters with oversized lists. Nil-safe.
+func (f *SubscriptionFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+	for _, list := range []struct {
+		name   string
+		values []string
+	}{
+		{"event_types", f.EventTypes},
+		{"statuses", f.Statuses},
+		{"chain_ids", f.ChainIDs},
+		{"alert_types", f.AlertTypes},
+	} {
+		if len(list.values) > maxFilterValues {
+			return fmt.Errorf("filter %s has %d values, at most %d allowed", list.name, len(list.values), maxFilterValues)
+		}
+	}
+	return nil
+}
+
+// IsEmpty reports whether the filter matches every event. Nil-safe.
+func (f *SubscriptionFilter) IsEmpty() bool {
+	return f == nil || (len(f.EventTypes) == 0 && len(f.Statuses) == 0 &&
+		len(f.ChainIDs) == 0 && len(f.AlertTypes) == 0)
+}
+
+// matches reports whether an event with the given fields passes the filter.
+func (f *SubscriptionFilter) matches(fields filterFields) bool {
+	return matchesValue(f.EventTypes, fields.Type) &&
+		matchesValue(f.Statuses, fields.Status) &&
+		matchesValue(f.ChainIDs, fields.ChainID) &&
+		matchesValue(f.AlertTypes, fields.AlertType)
+}
+
+// matchesPayload reports whether a catchup payload passes the filter.
+func (f *SubscriptionFilter) matchesPayload(payload map[string]interface{}) bool {
+	str := func(key string) string {
+		s, _ := payload[key].(string)
+		return s
+	}
+	return f.matches(filterFields{
+		Type:      str("type"),
+		Status:    str("status"),
+		ChainID:   str("chain_id"),
+		AlertType: str("alert_type"),
+	})
+}
+
+func matchesValue(allowed []string, value string) bool {
+	return len(allowed) == 0 || slices.Contains(allowed, value)
+}
+
+// parseFilterFields extracts the filterable fields from a raw event payload.
+// Unparseable events yield empty fields, which only match empty filter lists.
+func parseFilterFields(channel string, event []byte) *filterFields {
+	var fields filterFields
+	if err := json.Unmarshal(event, &fields); err != nil {
+		slog.Warn("Failed to parse event for subscription filters",
+			"channel", channel, "error", err)
+		return &filterFields{}
+	}
+	return &fields
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionFilter_Matches(t *testing.T) {
+	fields := filterFields{Type: EventTypeSessionStatus, Status: "failed", ChainID: "k8s", AlertType: "PodCrash"}
+
+	tests := []struct {
+		name   string
+		filter SubscriptionFilter
+		want   bool
+	}{
+		{"empty filter", SubscriptionFilter{}, true},
+		{"matching type", SubscriptionFilter{EventTypes: []string{EventTypeStageStatus, EventTypeSessionStatus}}, true},
+		{"other type", SubscriptionFilter{EventTypes: []string{EventTypeStageStatus}}, false},
+		{"all fields match", SubscriptionFilter{
+			EventTypes: []string{EventTypeSessionStatus},
+			Statuses:   []string{"failed", "timed_out"},
+			ChainIDs:   []string{"k8s"},
+			AlertTypes: []string{"PodCrash"},
+		}, true},
+		{"one field differs", SubscriptionFilter{Statuses: []string{"failed"}, ChainIDs: []string{"db"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.matches(fields))
+		})
+	}
+}
+
+func TestSubscriptionFilter_MissingFieldDoesNotMatch(t *testing.T) {
+	filter := SubscriptionFilter{ChainIDs: []string{"k8s"}}
+	fields := parseFilterFields(GlobalSessionsChannel, []byte(`{"type":"session.progress","session_id":"s1"}`))
+	assert.False(t, filter.matches(*fields))
+}
+
+func TestSubscriptionFilter_MatchesPayload(t *testing.T) {
+	filter := SubscriptionFilter{EventTypes: []string{EventTypeSessionStatus}, Statuses: []string{"failed"}}
+	assert.True(t, filter.matchesPayload(map[string]interface{}{"type": EventTypeSessionStatus, "status": "failed"}))
+	assert.False(t, filter.matchesPayload(map[string]interface{}{"type": EventTypeSessionStatus, "status": "completed"}))
+	assert.False(t, filter.matchesPayload(map[string]interface{}{"type": EventTypeSessionStatus, "status": 3}))
+}
+
+func TestSubscriptionFilter_UnparseableEvent(t *testing.T) {
+	fields := parseFilterFields(GlobalSessionsChannel, []byte(`not json`))
+	assert.True(t, (&SubscriptionFilter{}).matches(*fields))
+	assert.False(t, (&SubscriptionFilter{EventTypes: []string{EventTypeSessionStatus}}).matches(*fields))
+}
+
+func TestSubscriptionFilter_Validate(t *testing.T) {
+	var nilFilter *SubscriptionFilter
+	assert.NoError(t, nilFilter.Validate())
+	assert.NoError(t, (&SubscriptionFilter{Statuses: []string{"failed"}}).Validate())
+
+	tooMany := make([]string, maxFilterValues+1)
+	err := (&SubscriptionFilter{ChainIDs: tooMany}).Validate()
+	assert.ErrorContains(t, err, "chain_ids")
+}
+
+func TestSubscriptionFilter_IsEmpty(t *testing.T) {
+	var nilFilter *SubscriptionFilter
+	assert.True(t, nilFilter.IsEmpty())
+	assert.True(t, (&SubscriptionFilter{}).IsEmpty())
+	assert.False(t, (&SubscriptionFilter{AlertTypes: []string{"PodCrash"}}).IsEmpty())
+}
@@ -640,11 +640,18 @@ func TestCancellationsChannel_CrossPodNotify(t *testing.T) {
 	})
 
 	// Publish a cancel notification (simulates what cancelSessionHandler does)
-	require.NoError(t, env.publisher.NotifyCancelSession(ctx, env.sessionID))
+	require.NoError(t, env.publisher.NotifyCancelSession(ctx, CancellationPayload{
+		SessionID:   env.sessionID,
+		Initiator:   "user",
+		Reason:      "duplicate",
+		CancelledBy: "alice",
+	}))
 
 	select {
 	case got := <-received:
-		assert.Equal(t, env.sessionID, got)
+		parsed := ParseCancellationPayload([]byte(got))
+		assert.Equal(t, env.sessionID, parsed.SessionID)
+		assert.Equal(t, "duplicate", parsed.Reason)
 	case <-time.After(5 * time.Second):
 		t.Fatal("timed out waiting for cancel notification")
 	}
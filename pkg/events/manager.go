@@ -1,10 +1,14 @@
 package events
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,6 +28,16 @@ const catchupLimit = 200
 // subscribing goroutine (and thus the client's read loop) indefinitely.
 const listenTimeout = 10 * time.Second
 
+// DefaultDrainRetryAfter is the reconnect delay suggested to clients when the
+// pod shuts down: long enough for the load balancer to stop routing to it.
+const DefaultDrainRetryAfter = 2 * time.Second
+
+// goingAwayReason is the close reason sent with websocket.StatusGoingAway.
+const goingAwayReason = "server shutting down"
+
+// dbEventIDKey marks persisted events, which carry their position.
+var dbEventIDKey = []byte(`"db_event_id"`)
+
 // CatchupEvent holds the data returned by the catchup query.
 type CatchupEvent struct {
 	ID      int
@@ -42,8 +56,8 @@ type ConnectionManager struct {
 	connections map[string]*Connection
 	mu          sync.RWMutex
 
-	// Channel subscriptions: channel → set of connection_ids
-	channels  map[string]map[string]bool
+	// Channel subscriptions: channel → connection_id → subscription
+	channels  map[string]map[string]subscription
 	channelMu sync.RWMutex
 
 	// CatchupQuerier for catchup queries
@@ -55,6 +69,17 @@ type ConnectionManager struct {
 
 	// Write timeout for WebSocket sends
 	writeTimeout time.Duration
+
+	// Set by Drain; guarded by mu. A draining manager accepts no new
+	// connections or subscriptions and broadcasts nothing.
+	draining        bool
+	drainRetryAfter time.Duration
+}
+
+// subscription is one connection's subscription to a channel.
+type subscription struct {
+	filter   *SubscriptionFilter // nil = unfiltered
+	protocol int                 // negotiated event protocol version
 }
 
 // Connection represents a single WebSocket client.
@@ -64,19 +89,25 @@ type ConnectionManager struct {
 // goroutine that owns this connection (HandleConnection's read loop and its
 // deferred cleanup). If a Connection is ever mutated from a different goroutine
 // (e.g. an admin "kick" feature), subscriptions must be protected by a mutex.
+//
+// positions is written by Broadcast and read by Drain, so unlike
+// subscriptions it is guarded by posMu.
 type Connection struct {
 	ID            string
 	Conn          *websocket.Conn
 	subscriptions map[string]bool // channels this connection is subscribed to
 	ctx           context.Context
 	cancel        context.CancelFunc
+
+	positions map[string]int // channel → last persisted event delivered
+	posMu     sync.Mutex
 }
 
 // NewConnectionManager creates a new ConnectionManager.
 func NewConnectionManager(catchupQuerier CatchupQuerier, writeTimeout time.Duration) *ConnectionManager {
 	return &ConnectionManager{
 		connections:    make(map[string]*Connection),
-		channels:       make(map[string]map[string]bool),
+		channels:       make(map[string]map[string]subscription),
 		catchupQuerier: catchupQuerier,
 		writeTimeout:   writeTimeout,
 	}
@@ -103,15 +134,22 @@ func (m *ConnectionManager) HandleConnection(parentCtx context.Context, conn *we
 		subscriptions: make(map[string]bool),
 		ctx:           ctx,
 		cancel:        cancel,
+		positions:     make(map[string]int),
 	}
 
-	m.registerConnection(c)
+	if retryAfter, ok := m.registerConnection(c); !ok {
+		// Accepted while the pod started draining: send the client on its way
+		m.goAway(c, retryAfter, "")
+		cancel()
+		return
+	}
 	defer m.unregisterConnection(c)
 
-	// Send connection established message
-	m.sendJSON(c, map[string]string{
-		"type":          "connection.established",
-		"connection_id": connID,
+	// Send connection established message, advertising the newest protocol
+	m.sendJSON(c, map[string]interface{}{
+		"type":             MessageTypeConnectionEstablished,
+		"connection_id":    connID,
+		"protocol_version": ProtocolVersion,
 	})
 
 	// Read loop — process client messages until connection closes
@@ -133,26 +171,52 @@ func (m *ConnectionManager) HandleConnection(parentCtx context.Context, conn *we
 	}
 }
 
-// Broadcast sends an event payload to all connections subscribed to the given channel.
+// Broadcast sends an event payload to all connections subscribed to the given
+// channel whose subscription filter (if any) matches the event, encoded in
+// each subscription's protocol version.
 func (m *ConnectionManager) Broadcast(channel string, event []byte) {
 	m.channelMu.RLock()
-	connIDs, exists := m.channels[channel]
+	subs, exists := m.channels[channel]
 	if !exists {
 		m.channelMu.RUnlock()
 		return
 	}
-	// Copy IDs to avoid holding lock during sends
-	ids := make([]string, 0, len(connIDs))
-	for id := range connIDs {
+	// Copy IDs to avoid holding lock during sends. The event is parsed at
+	// most once, and only when it is persisted (to record each connection's
+	// position for resume tokens) or a filtered subscriber needs it.
+	ids := make([]string, 0, len(subs))
+	protocols := make(map[string]int, len(subs))
+	var fields *filterFields
+	if bytes.Contains(event, dbEventIDKey) {
+		fields = parseFilterFields(channel, event)
+	}
+	for id, sub := range subs {
+		if sub.filter != nil {
+			if fields == nil {
+				fields = parseFilterFields(channel, event)
+			}
+			if !sub.filter.matches(*fields) {
+				continue
+			}
+		}
 		ids = append(ids, id)
+		protocols[id] = sub.protocol
 	}
 	m.channelMu.RUnlock()
+	if len(ids) == 0 {
+		return
+	}
 
 	// Snapshot connection pointers under the lock, then release before
 	// sending. This avoids holding mu.RLock during potentially slow
 	// writes (up to writeTimeout per connection), which would stall
 	// connection register/unregister operations.
 	m.mu.RLock()
+	if m.draining {
+		// Clients resume from the positions in their resume tokens
+		m.mu.RUnlock()
+		return
+	}
 	conns := make([]*Connection, 0, len(ids))
 	for _, id := range ids {
 		if conn, ok := m.connections[id]; ok {
@@ -161,10 +225,22 @@ func (m *ConnectionManager) Broadcast(channel string, event []byte) {
 	}
 	m.mu.RUnlock()
 
+	// Encode once per protocol version in use
+	encoded := make(map[int][]byte, 1)
 	for _, conn := range conns {
-		if err := m.sendRaw(conn, event); err != nil {
+		protocol := protocols[conn.ID]
+		data, ok := encoded[protocol]
+		if !ok {
+			data = encodeEvent(channel, event, protocol)
+			encoded[protocol] = data
+		}
+		if err := m.sendRaw(conn, data); err != nil {
 			slog.Warn("Failed to send to WebSocket client",
 				"connection_id", conn.ID, "error", err)
+			continue
+		}
+		if fields != nil && fields.DBEventID > 0 {
+			conn.setPosition(channel, fields.DBEventID)
 		}
 	}
 }
@@ -176,6 +252,109 @@ func (m *ConnectionManager) ActiveConnections() int {
 	return len(m.connections)
 }
 
+// Draining reports whether Drain has been called, and the reconnect delay
+// suggested to clients.
+func (m *ConnectionManager) Draining() (retryAfter time.Duration, draining bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.drainRetryAfter, m.draining
+}
+
+// Drain prepares the pod's WebSocket clients for shutdown, so a deploy does
+// not end in a burst of dropped connections. From now on new connections
+// and subscriptions are refused and nothing is broadcast. Every connected
+// client gets a connection.going_away message with a reconnect delay and a
+// resume token for its subscriptions, then its connection is closed with
+// status 1001 (going away). Delays are spread over [retryAfter, 2*retryAfter)
+// so clients do not all reconnect to the remaining pods at once. Returns
+// when every connection is closed or ctx is done.
+func (m *ConnectionManager) Drain(ctx context.Context, retryAfter time.Duration) {
+	m.mu.Lock()
+	m.draining = true
+	m.drainRetryAfter = retryAfter
+	conns := make([]*Connection, 0, len(m.connections))
+	for _, c := range m.connections {
+		conns = append(conns, c)
+	}
+	m.mu.Unlock()
+	if len(conns) == 0 {
+		return
+	}
+
+	slog.Info("Draining WebSocket connections", "connections", len(conns), "retry_after", retryAfter)
+	tokens := m.resumeTokens(conns)
+	var wg sync.WaitGroup
+	for _, c := range conns {
+		wg.Go(func() { m.goAway(c, retryAfter, tokens[c.ID]) })
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		slog.Warn("WebSocket drain timed out; remaining connections close at exit")
+	}
+}
+
+// goAway tells a client to reconnect elsewhere and closes its connection.
+// token may be empty when the connection has no subscriptions.
+func (m *ConnectionManager) goAway(c *Connection, retryAfter time.Duration, token string) {
+	if retryAfter > 0 {
+		retryAfter += rand.N(retryAfter)
+	}
+	msg := map[string]interface{}{
+		"type":           MessageTypeConnectionGoingAway,
+		"retry_after_ms": retryAfter.Milliseconds(),
+	}
+	if token != "" {
+		msg["resume_token"] = token
+	}
+	m.sendJSON(c, msg)
+	_ = c.Conn.Close(websocket.StatusGoingAway, goingAwayReason)
+}
+
+// resumeTokens builds the resume token of each connection from its current
+// subscriptions and the positions delivered on them. Connections without
+// subscriptions get none.
+func (m *ConnectionManager) resumeTokens(conns []*Connection) map[string]string {
+	byConn := make(map[string][]resumeSubscription)
+	m.channelMu.RLock()
+	for channel, subs := range m.channels {
+		for id, sub := range subs {
+			byConn[id] = append(byConn[id], resumeSubscription{
+				Channel:         channel,
+				Filter:          sub.filter,
+				ProtocolVersion: sub.protocol,
+			})
+		}
+	}
+	m.channelMu.RUnlock()
+
+	tokens := make(map[string]string, len(conns))
+	for _, c := range conns {
+		subs := byConn[c.ID]
+		if len(subs) == 0 {
+			continue
+		}
+		c.posMu.Lock()
+		for i := range subs {
+			subs[i].LastEventID = c.positions[subs[i].Channel]
+		}
+		c.posMu.Unlock()
+		slices.SortFunc(subs, func(a, b resumeSubscription) int { return strings.Compare(a.Channel, b.Channel) })
+		token, err := encodeResumeToken(subs)
+		if err != nil {
+			slog.Warn("Failed to encode resume token", "connection_id", c.ID, "error", err)
+			continue
+		}
+		tokens[c.ID] = token
+	}
+	return tokens
+}
+
 // subscriberCount returns the number of subscribers for a channel.
 // Unexported — used by tests to poll instead of sleeping.
 func (m *ConnectionManager) subscriberCount(channel string) int {
@@ -189,34 +368,88 @@ func (m *ConnectionManager) handleClientMessage(ctx context.Context, c *Connecti
 	switch msg.Action {
 	case "subscribe":
 		if msg.Channel == "" {
-			m.sendJSON(c, map[string]string{"type": "error", "message": "channel is required for subscribe"})
+			m.sendJSON(c, map[string]string{"type": MessageTypeError, "message": "channel is required for subscribe"})
+			return
+		}
+		if _, draining := m.Draining(); draining {
+			m.sendJSON(c, map[string]string{
+				"type":    MessageTypeSubscriptionError,
+				"channel": msg.Channel,
+				"message": "server is shutting down; reconnect to subscribe",
+			})
+			return
+		}
+		if err := msg.Filter.Validate(); err != nil {
+			m.sendJSON(c, map[string]string{
+				"type":    MessageTypeSubscriptionError,
+				"channel": msg.Channel,
+				"message": err.Error(),
+			})
 			return
 		}
-		if err := m.subscribe(c, msg.Channel); err != nil {
+		protocol, err := negotiateProtocol(msg.ProtocolVersion)
+		if err != nil {
 			m.sendJSON(c, map[string]string{
-				"type":    "subscription.error",
+				"type":    MessageTypeSubscriptionError,
+				"channel": msg.Channel,
+				"message": err.Error(),
+			})
+			return
+		}
+		if err := m.subscribe(c, msg.Channel, msg.Filter, protocol); err != nil {
+			m.sendJSON(c, map[string]string{
+				"type":    MessageTypeSubscriptionError,
 				"channel": msg.Channel,
 				"message": "failed to subscribe to channel",
 			})
 			return
 		}
-		m.sendJSON(c, map[string]string{
-			"type":    "subscription.confirmed",
-			"channel": msg.Channel,
+		m.sendJSON(c, map[string]interface{}{
+			"type":             MessageTypeSubscriptionConfirmed,
+			"channel":          msg.Channel,
+			"protocol_version": protocol,
 		})
-		// Auto catch-up: deliver all prior events so late subscribers don't miss anything.
-		m.handleCatchup(ctx, c, msg.Channel, 0)
+		// Auto catch-up: deliver all prior events so late subscribers don't miss
+		// anything. A reconnecting client passes the last event it saw to
+		// resume from there instead.
+		sinceID := 0
+		if msg.LastEventID != nil {
+			sinceID = *msg.LastEventID
+		}
+		m.handleCatchup(ctx, c, msg.Channel, sinceID)
+
+	case "resume":
+		// Restore the subscriptions of a connection closed by a draining
+		// pod, each resuming after the last event it delivered
+		subs, err := decodeResumeToken(msg.ResumeToken)
+		if err != nil {
+			m.sendJSON(c, map[string]string{"type": MessageTypeError, "message": err.Error()})
+			return
+		}
+		for _, sub := range subs {
+			resubscribe := ClientMessage{
+				Action:          "subscribe",
+				Channel:         sub.Channel,
+				Filter:          sub.Filter,
+				ProtocolVersion: sub.ProtocolVersion,
+			}
+			if sub.LastEventID > 0 {
+				last := sub.LastEventID
+				resubscribe.LastEventID = &last
+			}
+			m.handleClientMessage(ctx, c, &resubscribe)
+		}
 
 	case "unsubscribe":
 		if msg.Channel == "" {
-			m.sendJSON(c, map[string]string{"type": "error", "message": "channel is required for unsubscribe"})
+			m.sendJSON(c, map[string]string{"type": MessageTypeError, "message": "channel is required for unsubscribe"})
 			return
 		}
 		m.unsubscribe(c, msg.Channel)
 
 	case "catchup":
 		if msg.Channel == "" {
-			m.sendJSON(c, map[string]string{"type": "error", "message": "channel is required for catchup"})
+			m.sendJSON(c, map[string]string{"type": MessageTypeError, "message": "channel is required for catchup"})
 			return
 		}
 		if msg.LastEventID != nil {
@@ -224,25 +457,31 @@ func (m *ConnectionManager) handleClientMessage(ctx context.Context, c *Connecti
 		}
 
 	case "ping":
-		m.sendJSON(c, map[string]string{"type": "pong"})
+		m.sendJSON(c, map[string]string{"type": MessageTypePong})
 	}
 }
 
 // subscribe registers a connection for a channel and starts LISTEN if first subscriber.
+// filter narrows the events delivered to this connection (nil = all events) and
+// protocol is the negotiated event protocol version; a repeat subscribe on the
+// same channel replaces both.
 // LISTEN is synchronous so it completes before subscribe returns — this guarantees
 // that the subsequent auto-catchup runs with LISTEN already active, closing the gap
 // where events published between catchup and LISTEN would be lost.
 //
 // Returns an error if LISTEN fails so the caller can inform the client instead of
 // sending a false subscription.confirmed.
-func (m *ConnectionManager) subscribe(c *Connection, channel string) error {
+func (m *ConnectionManager) subscribe(c *Connection, channel string, filter *SubscriptionFilter, protocol int) error {
+	if filter.IsEmpty() {
+		filter = nil
+	}
 	m.channelMu.Lock()
 	needsListen := false
 	if _, exists := m.channels[channel]; !exists {
-		m.channels[channel] = make(map[string]bool)
+		m.channels[channel] = make(map[string]subscription)
 		needsListen = true
 	}
-	m.channels[channel][c.ID] = true
+	m.channels[channel][c.ID] = subscription{filter: filter, protocol: protocol}
 	m.channelMu.Unlock()
 
 	if needsListen {
@@ -315,7 +554,7 @@ func (m *ConnectionManager) cleanupFailedChannel(triggering *Connection, channel
 		slog.Warn("Removing orphaned subscriber after LISTEN failure",
 			"connection_id", conn.ID, "channel", channel)
 		m.sendJSON(conn, map[string]string{
-			"type":    "subscription.error",
+			"type":    MessageTypeSubscriptionError,
 			"channel": channel,
 			"message": "channel listen failed; subscription removed",
 		})
@@ -358,6 +597,9 @@ func (m *ConnectionManager) unsubscribe(c *Connection, channel string) {
 	m.channelMu.Unlock()
 
 	delete(c.subscriptions, channel)
+	c.posMu.Lock()
+	delete(c.positions, channel)
+	c.posMu.Unlock()
 }
 
 // handleCatchup sends missed events since lastEventID to the client.
@@ -379,12 +621,17 @@ func (m *ConnectionManager) handleCatchup(ctx context.Context, c *Connection, ch
 		events = events[:catchupLimit]
 	}
 
+	sub := m.subscriptionOf(c, channel)
+
 	// Send missed events in order, injecting db_event_id for position tracking.
 	// The stored payload doesn't contain db_event_id (it's only added to the
 	// NOTIFY payload at publish time), so we add it here from the DB row ID.
 	for _, evt := range events {
+		if sub.filter != nil && !sub.filter.matchesPayload(evt.Payload) {
+			continue
+		}
 		evt.Payload["db_event_id"] = evt.ID
-		payload, err := json.Marshal(evt.Payload)
+		payload, err := encodePayload(channel, evt.Payload, sub.protocol)
 		if err != nil {
 			continue
 		}
@@ -393,25 +640,42 @@ func (m *ConnectionManager) handleCatchup(ctx context.Context, c *Connection, ch
 				"connection_id", c.ID, "error", err)
 			return
 		}
+		c.setPosition(channel, evt.ID)
 	}
 
 	// If more events were missed than the catchup limit, tell the client
 	// to do a full REST reload instead of paginating catchup requests.
 	if hasMore {
 		m.sendJSON(c, map[string]interface{}{
-			"type":     "catchup.overflow",
+			"type":     MessageTypeCatchupOverflow,
 			"channel":  channel,
 			"has_more": true,
 		})
 	}
 }
 
-// registerConnection adds a connection to the tracking map.
-func (m *ConnectionManager) registerConnection(c *Connection) {
+// subscriptionOf returns the connection's subscription to a channel. A
+// connection that is not subscribed gets an unfiltered, current-version one.
+func (m *ConnectionManager) subscriptionOf(c *Connection, channel string) subscription {
+	m.channelMu.RLock()
+	defer m.channelMu.RUnlock()
+	if sub, ok := m.channels[channel][c.ID]; ok {
+		return sub
+	}
+	return subscription{protocol: ProtocolVersion}
+}
+
+// registerConnection adds a connection to the tracking map. A draining
+// manager refuses it and returns the reconnect delay to suggest instead.
+func (m *ConnectionManager) registerConnection(c *Connection) (time.Duration, bool) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	if m.draining {
+		return m.drainRetryAfter, false
+	}
 	m.connections[c.ID] = c
 	metrics.WSConnectionsActive.Inc()
+	return 0, true
 }
 
 // unregisterConnection removes a connection and all its subscriptions.
@@ -430,6 +694,13 @@ func (m *ConnectionManager) unregisterConnection(c *Connection) {
 	_ = c.Conn.Close(websocket.StatusNormalClosure, "")
 }
 
+// setPosition records the last persisted event delivered on a channel.
+func (c *Connection) setPosition(channel string, eventID int) {
+	c.posMu.Lock()
+	defer c.posMu.Unlock()
+	c.positions[channel] = max(c.positions[channel], eventID)
+}
+
 // sendJSON marshals and sends a JSON message to a single connection.
 func (m *ConnectionManager) sendJSON(c *Connection, v interface{}) {
 	data, err := json.Marshal(v)
@@ -21,14 +21,20 @@ type mockCatchupQuerier struct {
 	err    error
 }
 
-func (m *mockCatchupQuerier) GetCatchupEvents(_ context.Context, _ string, _ int, limit int) ([]CatchupEvent, error) {
+func (m *mockCatchupQuerier) GetCatchupEvents(_ context.Context, _ string, sinceID int, limit int) ([]CatchupEvent, error) {
 	if m.err != nil {
 		return nil, m.err
 	}
-	if limit > 0 && len(m.events) > limit {
-		return m.events[:limit], nil
+	var events []CatchupEvent
+	for _, evt := range m.events {
+		if evt.ID > sinceID {
+			events = append(events, evt)
+		}
+	}
+	if limit > 0 && len(events) > limit {
+		return events[:limit], nil
 	}
-	return m.events, nil
+	return events, nil
 }
 
 func setupTestManager(t *testing.T) (*ConnectionManager, *httptest.Server) {
@@ -92,6 +98,7 @@ func TestConnectionManager_ConnectionEstablished(t *testing.T) {
 	msg := readJSON(t, conn)
 	assert.Equal(t, "connection.established", msg["type"])
 	assert.NotEmpty(t, msg["connection_id"])
+	assert.Equal(t, float64(ProtocolVersion), msg["protocol_version"])
 }
 
 func TestConnectionManager_SubscribeUnsubscribe(t *testing.T) {
@@ -126,14 +133,14 @@ func TestConnectionManager_Broadcast(t *testing.T) {
 	readJSON(t, conn1)
 	readJSON(t, conn2)
 
-	// Subscribe both to the same channel
+	// Subscribe both to the same channel, one on the legacy protocol
 	channel := "session:broadcast-test"
-	writeJSON(t, conn1, ClientMessage{Action: "subscribe", Channel: channel})
+	writeJSON(t, conn1, ClientMessage{Action: "subscribe", Channel: channel, ProtocolVersion: ProtocolVersion})
 	writeJSON(t, conn2, ClientMessage{Action: "subscribe", Channel: channel})
 
-	// Read subscription confirmations
-	readJSON(t, conn1)
-	readJSON(t, conn2)
+	// Read subscription confirmations with the negotiated versions
+	assert.Equal(t, float64(ProtocolVersion), readJSON(t, conn1)["protocol_version"])
+	assert.Equal(t, float64(ProtocolVersion1), readJSON(t, conn2)["protocol_version"])
 
 	// Wait for subscriptions to be fully registered
 	require.Eventually(t, func() bool {
@@ -150,8 +157,12 @@ func TestConnectionManager_Broadcast(t *testing.T) {
 
 	assert.Equal(t, "test", msg1["type"])
 	assert.Equal(t, "hello", msg1["data"])
+	assert.Equal(t, channel, msg1["channel"])
+	assert.Equal(t, float64(ProtocolVersion), msg1["protocol_version"])
 	assert.Equal(t, "test", msg2["type"])
 	assert.Equal(t, "hello", msg2["data"])
+	assert.NotContains(t, msg2, "channel", "version 1 payloads are downconverted")
+	assert.NotContains(t, msg2, "protocol_version")
 }
 
 func TestConnectionManager_PingPong(t *testing.T) {
@@ -281,18 +292,18 @@ func TestConnectionManager_MultipleChannels(t *testing.T) {
 	}, 2*time.Second, 10*time.Millisecond)
 
 	// Broadcast to channel 1 only
-	payload, _ := json.Marshal(map[string]string{"type": "test", "channel": "ch1"})
+	payload, _ := json.Marshal(map[string]string{"type": "test", "marker": "ch1"})
 	manager.Broadcast("session:ch1", payload)
 
 	msg := readJSON(t, conn)
-	assert.Equal(t, "ch1", msg["channel"])
+	assert.Equal(t, "ch1", msg["marker"])
 
 	// Broadcast to channel 2 only
-	payload2, _ := json.Marshal(map[string]string{"type": "test", "channel": "ch2"})
+	payload2, _ := json.Marshal(map[string]string{"type": "test", "marker": "ch2"})
 	manager.Broadcast("session:ch2", payload2)
 
 	msg2 := readJSON(t, conn)
-	assert.Equal(t, "ch2", msg2["channel"])
+	assert.Equal(t, "ch2", msg2["marker"])
 }
 
 func TestConnectionManager_Unsubscribe(t *testing.T) {
@@ -365,6 +376,94 @@ func TestConnectionManager_CatchupNormal(t *testing.T) {
 	assert.Error(t, err, "should not receive overflow message for small catchup")
 }
 
+func TestConnectionManager_SubscribeResumesCatchup(t *testing.T) {
+	// A reconnecting client passes last_event_id with subscribe and only
+	// gets the events it missed, tagged with the channel.
+	events := []CatchupEvent{
+		{ID: 10, Payload: map[string]interface{}{"type": "session.status", "seq": float64(1)}},
+		{ID: 11, Payload: map[string]interface{}{"type": "session.status", "seq": float64(2)}},
+		{ID: 12, Payload: map[string]interface{}{"type": "session.status", "seq": float64(3)}},
+	}
+
+	manager := NewConnectionManager(&mockCatchupQuerier{events: events}, 5*time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+		if err != nil {
+			return
+		}
+		manager.HandleConnection(r.Context(), conn)
+	}))
+	defer server.Close()
+
+	conn := connectWS(t, server)
+	readJSON(t, conn) // connection.established
+
+	lastEventID := 11
+	writeJSON(t, conn, ClientMessage{Action: "subscribe", Channel: GlobalSessionsChannel, LastEventID: &lastEventID,
+		ProtocolVersion: ProtocolVersion})
+	readJSON(t, conn) // subscription.confirmed
+
+	msg := readJSON(t, conn)
+	assert.Equal(t, float64(3), msg["seq"])
+	assert.Equal(t, float64(12), msg["db_event_id"])
+	assert.Equal(t, GlobalSessionsChannel, msg["channel"])
+}
+
+func TestConnectionManager_SubscribeProtocolVersion(t *testing.T) {
+	// A client newer than the server is served the server's version; a
+	// negative version is rejected.
+	manager, server := setupTestManager(t)
+	conn := connectWS(t, server)
+	readJSON(t, conn) // connection.established
+
+	writeJSON(t, conn, ClientMessage{Action: "subscribe", Channel: "session:v", ProtocolVersion: ProtocolVersion + 5})
+	msg := readJSON(t, conn)
+	assert.Equal(t, "subscription.confirmed", msg["type"])
+	assert.Equal(t, float64(ProtocolVersion), msg["protocol_version"])
+
+	writeJSON(t, conn, ClientMessage{Action: "subscribe", Channel: "session:bad", ProtocolVersion: -1})
+	msg = readJSON(t, conn)
+	assert.Equal(t, "subscription.error", msg["type"])
+	assert.Equal(t, "session:bad", msg["channel"])
+	assert.Equal(t, 0, manager.subscriberCount("session:bad"))
+}
+
+func TestConnectionManager_CatchupFiltered(t *testing.T) {
+	events := []CatchupEvent{
+		{ID: 10, Payload: map[string]interface{}{"type": "session.status", "status": "in_progress", "seq": float64(1)}},
+		{ID: 11, Payload: map[string]interface{}{"type": "session.status", "status": "failed", "seq": float64(2)}},
+		{ID: 12, Payload: map[string]interface{}{"type": "stage.status", "status": "failed", "seq": float64(3)}},
+	}
+
+	manager := NewConnectionManager(&mockCatchupQuerier{events: events}, 5*time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+		if err != nil {
+			return
+		}
+		manager.HandleConnection(r.Context(), conn)
+	}))
+	defer server.Close()
+
+	conn := connectWS(t, server)
+	readJSON(t, conn) // connection.established
+
+	writeJSON(t, conn, ClientMessage{
+		Action:  "subscribe",
+		Channel: "session:catchup-filter",
+		Filter:  &SubscriptionFilter{EventTypes: []string{"session.status"}, Statuses: []string{"failed"}},
+	})
+	readJSON(t, conn) // subscription.confirmed
+
+	msg := readJSON(t, conn)
+	assert.Equal(t, float64(2), msg["seq"], "only the failed session.status event should be replayed")
+
+	readCtx, readCancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer readCancel()
+	_, _, err := conn.Read(readCtx)
+	assert.Error(t, err, "filtered-out catchup events should not be sent")
+}
+
 func TestConnectionManager_CatchupError(t *testing.T) {
 	// Catchup error (including auto-catchup on subscribe) should be logged
 	// but not crash the connection. Connection remains usable.
@@ -425,6 +524,102 @@ func TestConnectionManager_BroadcastIsolation(t *testing.T) {
 	assert.Error(t, err, "conn2 should not receive ch1 broadcast")
 }
 
+func TestConnectionManager_BroadcastFiltered(t *testing.T) {
+	manager, server := setupTestManager(t)
+
+	all := connectWS(t, server)
+	failures := connectWS(t, server)
+	readJSON(t, all)      // connection.established
+	readJSON(t, failures) // connection.established
+
+	writeJSON(t, all, ClientMessage{Action: "subscribe", Channel: GlobalSessionsChannel})
+	readJSON(t, all) // subscription.confirmed
+	writeJSON(t, failures, ClientMessage{
+		Action:  "subscribe",
+		Channel: GlobalSessionsChannel,
+		Filter: &SubscriptionFilter{
+			EventTypes: []string{EventTypeSessionStatus},
+			Statuses:   []string{"failed", "timed_out"},
+			ChainIDs:   []string{"k8s-chain"},
+		},
+	})
+	readJSON(t, failures) // subscription.confirmed
+
+	require.Eventually(t, func() bool {
+		return manager.subscriberCount(GlobalSessionsChannel) == 2
+	}, 2*time.Second, 10*time.Millisecond)
+
+	broadcast := func(payload map[string]string) {
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		manager.Broadcast(GlobalSessionsChannel, data)
+	}
+	broadcast(map[string]string{"type": EventTypeSessionStatus, "status": "in_progress", "chain_id": "k8s-chain", "seq": "1"})
+	broadcast(map[string]string{"type": EventTypeSessionProgress, "status_text": "Starting", "seq": "2"})
+	broadcast(map[string]string{"type": EventTypeSessionStatus, "status": "failed", "chain_id": "other-chain", "seq": "3"})
+	broadcast(map[string]string{"type": EventTypeSessionStatus, "status": "failed", "chain_id": "k8s-chain", "seq": "4"})
+
+	// The unfiltered subscriber receives everything, in order.
+	for _, seq := range []string{"1", "2", "3", "4"} {
+		assert.Equal(t, seq, readJSON(t, all)["seq"])
+	}
+
+	// The filtered subscriber only receives the matching failure.
+	assert.Equal(t, "4", readJSON(t, failures)["seq"])
+	readCtx, readCancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer readCancel()
+	_, _, err := failures.Read(readCtx)
+	assert.Error(t, err, "filtered subscriber should not receive non-matching events")
+}
+
+func TestConnectionManager_ResubscribeReplacesFilter(t *testing.T) {
+	manager, server := setupTestManager(t)
+	conn := connectWS(t, server)
+	readJSON(t, conn) // connection.established
+
+	channel := "session:refilter"
+	writeJSON(t, conn, ClientMessage{Action: "subscribe", Channel: channel,
+		Filter: &SubscriptionFilter{EventTypes: []string{"a"}}})
+	readJSON(t, conn) // subscription.confirmed
+	writeJSON(t, conn, ClientMessage{Action: "subscribe", Channel: channel})
+	readJSON(t, conn) // subscription.confirmed
+
+	require.Eventually(t, func() bool {
+		manager.channelMu.RLock()
+		defer manager.channelMu.RUnlock()
+		subs := manager.channels[channel]
+		if len(subs) != 1 {
+			return false
+		}
+		for _, sub := range subs {
+			return sub.filter == nil
+		}
+		return false
+	}, 2*time.Second, 10*time.Millisecond, "unfiltered resubscribe should clear the filter")
+
+	payload, _ := json.Marshal(map[string]string{"type": "b"})
+	manager.Broadcast(channel, payload)
+	assert.Equal(t, "b", readJSON(t, conn)["type"])
+}
+
+func TestConnectionManager_SubscribeInvalidFilter(t *testing.T) {
+	manager, server := setupTestManager(t)
+	conn := connectWS(t, server)
+	readJSON(t, conn) // connection.established
+
+	statuses := make([]string, maxFilterValues+1)
+	for i := range statuses {
+		statuses[i] = fmt.Sprintf("s%d", i)
+	}
+	writeJSON(t, conn, ClientMessage{Action: "subscribe", Channel: GlobalSessionsChannel,
+		Filter: &SubscriptionFilter{Statuses: statuses}})
+
+	msg := readJSON(t, conn)
+	assert.Equal(t, "subscription.error", msg["type"])
+	assert.Contains(t, msg["message"], "statuses")
+	assert.Equal(t, 0, manager.subscriberCount(GlobalSessionsChannel))
+}
+
 func TestConnectionManager_EmptyChannelValidation(t *testing.T) {
 	_, server := setupTestManager(t)
 	conn := connectWS(t, server)
@@ -535,10 +730,10 @@ func TestConnectionManager_SubscribeListenFailure_CleansUpOrphanedSubscribers(t
 	// Simulate the state after all three subscribed but before LISTEN completes:
 	// - Channel exists in m.channels with all three connection IDs
 	manager.channelMu.Lock()
-	manager.channels[channel] = map[string]bool{
-		connA.ID: true,
-		"conn-b": true,
-		"conn-c": true,
+	manager.channels[channel] = map[string]subscription{
+		connA.ID: {},
+		"conn-b": {},
+		"conn-c": {},
 	}
 	manager.channelMu.Unlock()
 
@@ -648,3 +843,118 @@ func TestConnectionManager_CleanupOnDisconnect(t *testing.T) {
 		manager.Broadcast("session:cleanup-test", payload)
 	})
 }
+
+func TestConnectionManager_Drain(t *testing.T) {
+	// Clients get connection.going_away with a resume token holding their
+	// subscriptions and positions, then a going-away close.
+	events := []CatchupEvent{
+		{ID: 10, Payload: map[string]interface{}{"type": "session.status", "status": "failed"}},
+		{ID: 11, Payload: map[string]interface{}{"type": "session.status", "status": "failed"}},
+	}
+	manager := NewConnectionManager(&mockCatchupQuerier{events: events}, 5*time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+		if err != nil {
+			return
+		}
+		manager.HandleConnection(r.Context(), conn)
+	}))
+	defer server.Close()
+
+	conn := connectWS(t, server)
+	readJSON(t, conn) // connection.established
+
+	filter := &SubscriptionFilter{Statuses: []string{"failed"}}
+	writeJSON(t, conn, ClientMessage{Action: "subscribe", Channel: GlobalSessionsChannel, Filter: filter, ProtocolVersion: ProtocolVersion})
+	readJSON(t, conn) // subscription.confirmed
+	readJSON(t, conn) // event 10
+	readJSON(t, conn) // event 11
+
+	manager.Broadcast(GlobalSessionsChannel, []byte(`{"type":"session.status","status":"failed","db_event_id":12}`))
+	assert.Equal(t, float64(12), readJSON(t, conn)["db_event_id"])
+
+	go manager.Drain(context.Background(), time.Second)
+
+	msg := readJSON(t, conn)
+	assert.Equal(t, MessageTypeConnectionGoingAway, msg["type"])
+	assert.GreaterOrEqual(t, msg["retry_after_ms"], float64(1000))
+	assert.Less(t, msg["retry_after_ms"], float64(2000))
+
+	subs, err := decodeResumeToken(msg["resume_token"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, []resumeSubscription{
+		{Channel: GlobalSessionsChannel, LastEventID: 12, Filter: filter, ProtocolVersion: ProtocolVersion},
+	}, subs)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, _, err = conn.Read(ctx)
+	assert.Equal(t, websocket.StatusGoingAway, websocket.CloseStatus(err))
+
+	retryAfter, draining := manager.Draining()
+	assert.True(t, draining)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// Connections accepted while draining are sent away at once
+	late := connectWS(t, server)
+	msg = readJSON(t, late)
+	assert.Equal(t, MessageTypeConnectionGoingAway, msg["type"])
+	assert.NotContains(t, msg, "resume_token")
+}
+
+func TestConnectionManager_DrainRefusesSubscribe(t *testing.T) {
+	manager, server := setupTestManager(t)
+	conn := connectWS(t, server)
+	readJSON(t, conn) // connection.established
+
+	manager.mu.Lock()
+	manager.draining = true
+	manager.mu.Unlock()
+
+	writeJSON(t, conn, ClientMessage{Action: "subscribe", Channel: "session:late"})
+	msg := readJSON(t, conn)
+	assert.Equal(t, MessageTypeSubscriptionError, msg["type"])
+	assert.Equal(t, "session:late", msg["channel"])
+	assert.Equal(t, 0, manager.subscriberCount("session:late"))
+}
+
+func TestConnectionManager_Resume(t *testing.T) {
+	// Another pod restores the subscriptions of a resume token, replaying
+	// only the events after each channel's position.
+	events := []CatchupEvent{
+		{ID: 11, Payload: map[string]interface{}{"type": "session.status", "seq": float64(1)}},
+		{ID: 12, Payload: map[string]interface{}{"type": "session.status", "seq": float64(2)}},
+	}
+	manager := NewConnectionManager(&mockCatchupQuerier{events: events}, 5*time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+		if err != nil {
+			return
+		}
+		manager.HandleConnection(r.Context(), conn)
+	}))
+	defer server.Close()
+
+	token, err := encodeResumeToken([]resumeSubscription{
+		{Channel: GlobalSessionsChannel, LastEventID: 11, ProtocolVersion: ProtocolVersion},
+	})
+	require.NoError(t, err)
+
+	conn := connectWS(t, server)
+	readJSON(t, conn) // connection.established
+
+	writeJSON(t, conn, ClientMessage{Action: "resume", ResumeToken: token})
+	msg := readJSON(t, conn)
+	assert.Equal(t, MessageTypeSubscriptionConfirmed, msg["type"])
+	assert.Equal(t, GlobalSessionsChannel, msg["channel"])
+	assert.Equal(t, float64(ProtocolVersion), msg["protocol_version"])
+
+	msg = readJSON(t, conn)
+	assert.Equal(t, float64(12), msg["db_event_id"])
+	assert.Equal(t, 1, manager.subscriberCount(GlobalSessionsChannel))
+
+	writeJSON(t, conn, ClientMessage{Action: "resume", ResumeToken: "not a token"})
+	msg = readJSON(t, conn)
+	assert.Equal(t, MessageTypeError, msg["type"])
+	assert.Equal(t, "malformed resume_token", msg["message"])
+}
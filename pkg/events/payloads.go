@@ -12,9 +12,10 @@ import (
 // Any payload missing session_id is silently dropped by the frontend.
 // Embedding BasePayload in every payload struct prevents this bug class.
 type BasePayload struct {
-	Type      string `json:"type"`       // event type constant (e.g. EventTypeTimelineCreated)
-	SessionID string `json:"session_id"` // owning session — REQUIRED for frontend WS routing
-	Timestamp string `json:"timestamp"`  // RFC3339Nano
+	Type      string `json:"type"`                 // event type constant (e.g. EventTypeTimelineCreated)
+	SessionID string `json:"session_id"`           // owning session — REQUIRED for frontend WS routing
+	Timestamp string `json:"timestamp"`            // RFC3339Nano
+	RequestID string `json:"request_id,omitempty"` // X-Request-ID of the API call that triggered the work (set by EventPublisher from ctx)
 }
 
 // TimelineCreatedPayload is the payload for timeline_event.created events.
@@ -29,7 +30,8 @@ type TimelineCreatedPayload struct {
 	Status            timelineevent.Status    `json:"status"`                        // streaming, completed, failed, cancelled, timed_out
 	Content           string                  `json:"content"`                       // event content (may be empty for streaming)
 	Metadata          map[string]any          `json:"metadata,omitempty"`
-	SequenceNumber    int                     `json:"sequence_number"` // order in timeline
+	Highlight         string                  `json:"highlight,omitempty"` // key_finding, error, warning (empty when not highlighted)
+	SequenceNumber    int                     `json:"sequence_number"`     // order in timeline
 }
 
 // TimelineCompletedPayload is the payload for timeline_event.completed events.
@@ -42,6 +44,7 @@ type TimelineCompletedPayload struct {
 	Content           string                  `json:"content"`                       // final content
 	Status            timelineevent.Status    `json:"status"`                        // completed, failed, cancelled, timed_out
 	Metadata          map[string]any          `json:"metadata,omitempty"`
+	Highlight         string                  `json:"highlight,omitempty"` // set when completion highlighted the event (e.g. a failed tool call)
 }
 
 // StreamChunkPayload is the payload for stream.chunk transient events.
@@ -57,7 +60,16 @@ type StreamChunkPayload struct {
 // Published when a session transitions between lifecycle states.
 type SessionStatusPayload struct {
 	BasePayload
-	Status alertsession.Status `json:"status"` // pending, in_progress, cancelling, completed, failed, cancelled, timed_out
+	Status alertsession.Status `json:"status"` // pending, in_progress, cancelling, completed, failed, cancelled, timed_out, auto_cancelled
+
+	// Session scope, for server-side subscription filters on the global channel.
+	ChainID   string `json:"chain_id,omitempty"`
+	AlertType string `json:"alert_type,omitempty"`
+
+	// Set on cancelling/cancelled transitions when the cancellation was recorded.
+	CancelInitiator string `json:"cancel_initiator,omitempty"` // user, pending_ttl, alert_resolved, preemption
+	CancelReason    string `json:"cancel_reason,omitempty"`
+	CancelledBy     string `json:"cancelled_by,omitempty"`
 }
 
 // StageStatusPayload is the payload for stage.status events.
@@ -80,6 +92,19 @@ type ChatCreatedPayload struct {
 	CreatedBy string `json:"created_by"` // author who initiated the chat
 }
 
+// ChatQueuePositionPayload is the payload for chat.queue_position transient
+// events, published to the session channel when a chat message is queued,
+// whenever its position changes, and when it leaves the queue (position 0),
+// so users know their question is pending rather than lost.
+type ChatQueuePositionPayload struct {
+	BasePayload
+	ChatID    string `json:"chat_id"`
+	MessageID string `json:"message_id"`
+	Position  int    `json:"position"` // 1-based place in the pod's chat queue; 0 once it left
+	QueueSize int    `json:"queue_size"`
+	Reason    string `json:"reason"` // chat_busy, at_capacity, started, discarded
+}
+
 // InteractionCreatedPayload is the payload for interaction.created events.
 // Fired once when an LLM or MCP interaction record is saved to DB.
 // Used by trace view for live updates via event-notification + REST re-fetch.
@@ -100,6 +125,20 @@ type SessionProgressPayload struct {
 	TotalStages       int    `json:"total_stages"`        // total configured stages
 	ActiveExecutions  int    `json:"active_executions"`   // number of agents running
 	StatusText        string `json:"status_text"`         // human-readable status
+
+	// Set on the progress event published when the session starts; clients
+	// keep it for the rest of the session.
+	Forecast *ForecastSummary `json:"forecast,omitempty"`
+}
+
+// ForecastSummary is the budget forecast of a session's chain, from the
+// averages of recently completed sessions (see models.BudgetForecast).
+type ForecastSummary struct {
+	Basis                    string   `json:"basis"`       // alert_type, chain, or none
+	SampleSize               int      `json:"sample_size"` // completed sessions averaged
+	EstimatedTokens          int64    `json:"estimated_tokens"`
+	EstimatedCostUsd         *float64 `json:"estimated_cost_usd,omitempty"` // nil when cost estimation is disabled
+	EstimatedDurationSeconds int64    `json:"estimated_duration_seconds"`
 }
 
 // ExecutionProgressPayload is the payload for execution.progress transient events.
@@ -111,6 +150,7 @@ type ExecutionProgressPayload struct {
 	ParentExecutionID string `json:"parent_execution_id,omitempty"` // parent orchestrator execution (empty for non-sub-agents)
 	Phase             string `json:"phase"`                         // ProgressPhase constant
 	Message           string `json:"message"`                       // human-readable message
+	Percent           *int   `json:"percent,omitempty"`             // estimated completion 0-100; nil when unknown
 }
 
 // ReviewStatusPayload is the payload for review.status events.
@@ -147,3 +187,69 @@ type ExecutionStatusPayload struct {
 	Status            string `json:"status"`                        // active, completed, failed, timed_out, cancelled
 	ErrorMessage      string `json:"error_message,omitempty"`       // populated on failure
 }
+
+// SubAgentDispatchedPayload is the payload for subagent.dispatched events.
+// Published to SessionChannel(sessionID) when an orchestrator dispatches a
+// sub-agent, once its execution record exists.
+type SubAgentDispatchedPayload struct {
+	BasePayload
+	StageID           string `json:"stage_id"`            // stage UUID
+	ExecutionID       string `json:"execution_id"`        // sub-agent execution UUID
+	ParentExecutionID string `json:"parent_execution_id"` // orchestrator execution UUID
+	AgentName         string `json:"agent_name"`          // dispatched agent
+	AgentIndex        int    `json:"agent_index"`         // 1-based dispatch order within the orchestrator
+	Task              string `json:"task"`                // task the orchestrator gave the sub-agent
+}
+
+// SubAgentStatusPayload is the payload for subagent.status events.
+// Published to SessionChannel(sessionID) when a sub-agent reaches a terminal
+// status.
+type SubAgentStatusPayload struct {
+	BasePayload
+	StageID           string `json:"stage_id"`                // stage UUID
+	ExecutionID       string `json:"execution_id"`            // sub-agent execution UUID
+	ParentExecutionID string `json:"parent_execution_id"`     // orchestrator execution UUID
+	AgentName         string `json:"agent_name"`              // dispatched agent
+	AgentIndex        int    `json:"agent_index"`             // 1-based dispatch order within the orchestrator
+	Status            string `json:"status"`                  // completed, failed, timed_out, cancelled
+	ErrorMessage      string `json:"error_message,omitempty"` // populated on failure
+	DurationMs        int64  `json:"duration_ms"`             // time from dispatch to terminal status
+	TotalTokens       int    `json:"total_tokens"`            // tokens the sub-agent used
+}
+
+// SavedViewMatchedPayload is the payload for saved_view.matched transient events.
+// Published to UserChannel(owner) when a session status transition matches a
+// subscribed saved view. SessionID is the matching session.
+type SavedViewMatchedPayload struct {
+	BasePayload
+	ViewID    string `json:"view_id"`
+	ViewName  string `json:"view_name"`
+	Status    string `json:"status"` // session status at the matching transition
+	AlertType string `json:"alert_type"`
+	ChainID   string `json:"chain_id"`
+}
+
+// SessionOrphanRecoveredPayload is the payload for session.orphan_recovered
+// transient events. PodID is the pod that owned the session when it stopped
+// heartbeating.
+type SessionOrphanRecoveredPayload struct {
+	BasePayload
+	PodID           string `json:"pod_id"`
+	LastHeartbeatAt string `json:"last_heartbeat_at,omitempty"` // RFC3339; empty if the session never heartbeated
+	Recovery        string `json:"recovery"`                    // requeued, failed
+	Reason          string `json:"reason"`
+}
+
+// MCPServerLogPayload is the payload for mcp.server_log transient events: one
+// stderr line of a stdio MCP server, masked, written while a tool call was in
+// flight. Concurrent calls on the same server each receive every line.
+type MCPServerLogPayload struct {
+	BasePayload
+	StageID           string `json:"stage_id"`                      // stage UUID
+	ExecutionID       string `json:"execution_id"`                  // agent execution UUID
+	ParentExecutionID string `json:"parent_execution_id,omitempty"` // parent orchestrator execution (empty for non-sub-agents)
+	EventID           string `json:"event_id,omitempty"`            // llm_tool_call timeline event UUID
+	ServerID          string `json:"server_id"`
+	ToolName          string `json:"tool_name"`
+	Line              string `json:"line"`
+}
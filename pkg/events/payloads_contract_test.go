@@ -110,6 +110,21 @@ func TestSessionChannelPayloads_ContainSessionID(t *testing.T) {
 				CreatedBy: "user",
 			},
 		},
+		{
+			name: "ChatQueuePositionPayload",
+			payload: ChatQueuePositionPayload{
+				BasePayload: BasePayload{
+					Type:      EventTypeChatQueuePosition,
+					SessionID: testSessionID,
+					Timestamp: "2026-01-01T00:00:00Z",
+				},
+				ChatID:    "chat-1",
+				MessageID: "msg-1",
+				Position:  2,
+				QueueSize: 3,
+				Reason:    ChatQueueReasonAtCapacity,
+			},
+		},
 		{
 			name: "InteractionCreatedPayload",
 			payload: InteractionCreatedPayload{
@@ -134,10 +149,44 @@ func TestSessionChannelPayloads_ContainSessionID(t *testing.T) {
 				},
 				StageID:     "stg-1",
 				ExecutionID: "exec-1",
-				Phase:       ProgressPhaseInvestigating,
+				Phase:       ProgressPhaseAnalyzing,
 				Message:     "Iteration 1/5",
 			},
 		},
+		{
+			name: "SubAgentDispatchedPayload",
+			payload: SubAgentDispatchedPayload{
+				BasePayload: BasePayload{
+					Type:      EventTypeSubAgentDispatched,
+					SessionID: testSessionID,
+					Timestamp: "2026-01-01T00:00:00Z",
+				},
+				StageID:           "stg-1",
+				ExecutionID:       "exec-2",
+				ParentExecutionID: "exec-1",
+				AgentName:         "LogAnalyzer",
+				AgentIndex:        1,
+				Task:              "Check the logs",
+			},
+		},
+		{
+			name: "SubAgentStatusPayload",
+			payload: SubAgentStatusPayload{
+				BasePayload: BasePayload{
+					Type:      EventTypeSubAgentStatus,
+					SessionID: testSessionID,
+					Timestamp: "2026-01-01T00:00:00Z",
+				},
+				StageID:           "stg-1",
+				ExecutionID:       "exec-2",
+				ParentExecutionID: "exec-1",
+				AgentName:         "LogAnalyzer",
+				AgentIndex:        1,
+				Status:            "completed",
+				DurationMs:        1500,
+				TotalTokens:       1200,
+			},
+		},
 		{
 			name: "ReviewStatusPayload",
 			payload: func() ReviewStatusPayload {
@@ -153,6 +202,19 @@ func TestSessionChannelPayloads_ContainSessionID(t *testing.T) {
 				}
 			}(),
 		},
+		{
+			name: "SessionOrphanRecoveredPayload",
+			payload: SessionOrphanRecoveredPayload{
+				BasePayload: BasePayload{
+					Type:      EventTypeSessionOrphanRecovered,
+					SessionID: testSessionID,
+					Timestamp: "2026-01-01T00:00:00Z",
+				},
+				PodID:    "tarsy-0",
+				Recovery: OrphanRecoveryRequeued,
+				Reason:   "Orphaned: no heartbeat from pod tarsy-0",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,105 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// WebSocket event protocol versions. Each subscription negotiates one: the
+// client sends the highest version it understands in subscribe's
+// protocol_version, and the server delivers that channel's events in
+// min(requested, ProtocolVersion), downconverting newer payloads. Clients
+// that send no version get ProtocolVersion1, so deployed dashboards keep
+// working when event schemas evolve.
+//
+// Version history (add a downconverter for every bump):
+//
+//	1  payloads as stored, no version marker (implicit, before negotiation)
+//	2  every event carries protocol_version and the channel it was delivered on
+const (
+	ProtocolVersion1 = 1
+	ProtocolVersion  = 2 // current
+)
+
+// downconverters[v] rewrites an event payload of version v+1 into version v
+// in place: dropping added fields, restoring renamed types or fields.
+var downconverters = map[int]func(payload map[string]interface{}){
+	ProtocolVersion1: func(payload map[string]interface{}) {
+		delete(payload, "protocol_version")
+		delete(payload, "channel")
+	},
+}
+
+// negotiateProtocol returns the protocol version a subscription uses given
+// the version the client requested (0 when omitted).
+func negotiateProtocol(requested int) (int, error) {
+	switch {
+	case requested < 0:
+		return 0, fmt.Errorf("invalid protocol_version %d", requested)
+	case requested == 0:
+		return ProtocolVersion1, nil
+	case requested > ProtocolVersion:
+		return ProtocolVersion, nil
+	default:
+		return requested, nil
+	}
+}
+
+// encodeEvent renders a broadcast event for a subscriber of channel speaking
+// protocol. Events in the current version are stamped without re-encoding;
+// older versions are decoded and downconverted. Events that are not JSON
+// objects are returned as is.
+func encodeEvent(channel string, event []byte, protocol int) []byte {
+	if len(event) < 2 || event[0] != '{' {
+		return event
+	}
+	if protocol >= ProtocolVersion {
+		return stampEvent(channel, event)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(event))
+	dec.UseNumber() // keep large integers (token counts, IDs) exact
+	var payload map[string]interface{}
+	if err := dec.Decode(&payload); err != nil {
+		return event
+	}
+	data, err := encodePayload(channel, payload, protocol)
+	if err != nil {
+		return event
+	}
+	return data
+}
+
+// encodePayload stamps a decoded event payload with the current protocol
+// fields, downconverts it to protocol, and marshals it. Mutates payload.
+func encodePayload(channel string, payload map[string]interface{}, protocol int) ([]byte, error) {
+	payload["protocol_version"] = ProtocolVersion
+	payload["channel"] = channel
+	for v := ProtocolVersion - 1; v >= protocol; v-- {
+		downconverters[v](payload)
+	}
+	return json.Marshal(payload)
+}
+
+// stampEvent prepends the current protocol version and the channel an event
+// was delivered on, so clients subscribed to several channels can tell which
+// one delivered it (session-level events are published on both the session
+// and the global channel).
+func stampEvent(channel string, event []byte) []byte {
+	name, err := json.Marshal(channel)
+	if err != nil {
+		return event
+	}
+	version := strconv.Itoa(ProtocolVersion)
+	out := make([]byte, 0, len(event)+len(name)+len(version)+32)
+	out = append(out, `{"protocol_version":`...)
+	out = append(out, version...)
+	out = append(out, `,"channel":`...)
+	out = append(out, name...)
+	if rest := bytes.TrimSpace(event[1:]); len(rest) > 0 && rest[0] != '}' {
+		out = append(out, ',')
+	}
+	return append(out, event[1:]...)
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateProtocol(t *testing.T) {
+	tests := []struct {
+		requested int
+		want      int
+		wantErr   bool
+	}{
+		{requested: 0, want: ProtocolVersion1},
+		{requested: 1, want: ProtocolVersion1},
+		{requested: ProtocolVersion, want: ProtocolVersion},
+		{requested: ProtocolVersion + 1, want: ProtocolVersion},
+		{requested: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := negotiateProtocol(tt.requested)
+		if tt.wantErr {
+			assert.Error(t, err, "requested %d", tt.requested)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "requested %d", tt.requested)
+	}
+}
+
+func TestDownconvertersCoverEveryVersion(t *testing.T) {
+	for v := ProtocolVersion1; v < ProtocolVersion; v++ {
+		assert.Contains(t, downconverters, v, "missing downconverter from version %d to %d", v+1, v)
+	}
+}
+
+func TestEncodeEvent(t *testing.T) {
+	event := []byte(`{"type":"session.status","session_id":"s1","input_tokens":9007199254740993}`)
+
+	t.Run("current version is stamped", func(t *testing.T) {
+		assert.JSONEq(t,
+			`{"protocol_version":2,"channel":"sessions","type":"session.status","session_id":"s1","input_tokens":9007199254740993}`,
+			string(encodeEvent(GlobalSessionsChannel, event, ProtocolVersion)))
+		assert.JSONEq(t, `{"protocol_version":2,"channel":"session:a\"b"}`,
+			string(encodeEvent(`session:a"b`, []byte(`{}`), ProtocolVersion)))
+	})
+
+	t.Run("version 1 is downconverted", func(t *testing.T) {
+		// Large integers survive the decode
+		assert.JSONEq(t, string(event), string(encodeEvent(GlobalSessionsChannel, event, ProtocolVersion1)))
+	})
+
+	t.Run("non-object events pass through", func(t *testing.T) {
+		assert.Equal(t, "not json", string(encodeEvent(GlobalSessionsChannel, []byte("not json"), ProtocolVersion)))
+		assert.Equal(t, "{broken", string(encodeEvent(GlobalSessionsChannel, []byte("{broken"), ProtocolVersion1)))
+	})
+}
+
+func TestEncodePayload(t *testing.T) {
+	data, err := encodePayload("session:s1", map[string]interface{}{"type": "stage.status", "db_event_id": 7}, ProtocolVersion)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"stage.status","db_event_id":7,"channel":"session:s1","protocol_version":2}`, string(data))
+
+	data, err = encodePayload("session:s1", map[string]interface{}{"type": "stage.status", "db_event_id": 7}, ProtocolVersion1)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"stage.status","db_event_id":7}`, string(data))
+}
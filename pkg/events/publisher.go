@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"log/slog"
 	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 )
 
 // SessionCancelNotifier broadcasts session cancellation requests to all pods
 // via PostgreSQL NOTIFY. Used by the cancel API handler for cross-pod delivery.
 type SessionCancelNotifier interface {
-	NotifyCancelSession(ctx context.Context, sessionID string) error
+	NotifyCancelSession(ctx context.Context, payload CancellationPayload) error
 }
 
 // EventPublisher publishes events for WebSocket delivery.
@@ -20,8 +22,9 @@ type SessionCancelNotifier interface {
 // Transient events (streaming chunks) are broadcast via NOTIFY only.
 //
 // Each public method accepts a specific typed payload struct — see payloads.go.
-// Internally, payloads are marshaled to JSON and routed to the appropriate
-// channel (derived from sessionID) via persistAndNotify or notifyOnly.
+// Internally, payloads are stamped with the request ID carried by ctx (if any),
+// marshaled to JSON, and routed to the appropriate channel (derived from
+// sessionID) via persistAndNotify or notifyOnly.
 type EventPublisher struct {
 	db *sql.DB
 }
@@ -33,20 +36,31 @@ func NewEventPublisher(db *sql.DB) *EventPublisher {
 }
 
 // NotifyCancelSession broadcasts a session cancellation request to all pods.
-// The payload is the raw session ID — no JSON wrapping needed.
-func (p *EventPublisher) NotifyCancelSession(ctx context.Context, sessionID string) error {
-	_, err := p.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", CancellationsChannel, sessionID)
+func (p *EventPublisher) NotifyCancelSession(ctx context.Context, payload CancellationPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cancellation payload: %w", err)
+	}
+	_, err = p.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", CancellationsChannel, string(data))
 	if err != nil {
 		return fmt.Errorf("cancel notify failed: %w", err)
 	}
 	return nil
 }
 
+// stampRequestID sets base.RequestID from ctx unless the caller already set it.
+func stampRequestID(ctx context.Context, base *BasePayload) {
+	if base.RequestID == "" {
+		base.RequestID = requestid.FromContext(ctx)
+	}
+}
+
 // --- Typed public methods ---
 
 // PublishTimelineCreated persists and broadcasts a timeline_event.created event.
 // Used when a new timeline event is created (streaming or completed).
 func (p *EventPublisher) PublishTimelineCreated(ctx context.Context, sessionID string, payload TimelineCreatedPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal TimelineCreatedPayload: %w", err)
@@ -57,6 +71,7 @@ func (p *EventPublisher) PublishTimelineCreated(ctx context.Context, sessionID s
 // PublishTimelineCompleted persists and broadcasts a timeline_event.completed event.
 // Used when a streaming timeline event transitions to a terminal status.
 func (p *EventPublisher) PublishTimelineCompleted(ctx context.Context, sessionID string, payload TimelineCompletedPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal TimelineCompletedPayload: %w", err)
@@ -67,6 +82,7 @@ func (p *EventPublisher) PublishTimelineCompleted(ctx context.Context, sessionID
 // PublishStreamChunk broadcasts a stream.chunk transient event (no DB persistence).
 // Used for high-frequency LLM streaming tokens — ephemeral, lost on disconnect.
 func (p *EventPublisher) PublishStreamChunk(ctx context.Context, sessionID string, payload StreamChunkPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal StreamChunkPayload: %w", err)
@@ -77,6 +93,7 @@ func (p *EventPublisher) PublishStreamChunk(ctx context.Context, sessionID strin
 // PublishStageStatus persists and broadcasts a stage.status event.
 // Used for stage lifecycle transitions (started, completed, failed, etc.).
 func (p *EventPublisher) PublishStageStatus(ctx context.Context, sessionID string, payload StageStatusPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal StageStatusPayload: %w", err)
@@ -89,6 +106,7 @@ func (p *EventPublisher) PublishStageStatus(ctx context.Context, sessionID strin
 // Both publishes are best-effort: if the persistent one fails, the transient
 // one is still attempted. Returns the first error encountered (if any).
 func (p *EventPublisher) PublishSessionStatus(ctx context.Context, sessionID string, payload SessionStatusPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal SessionStatusPayload: %w", err)
@@ -119,6 +137,7 @@ func (p *EventPublisher) PublishSessionStatus(ctx context.Context, sessionID str
 // Both publishes are best-effort: if the persistent one fails, the transient
 // one is still attempted. Returns the first error encountered (if any).
 func (p *EventPublisher) PublishReviewStatus(ctx context.Context, sessionID string, payload ReviewStatusPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal ReviewStatusPayload: %w", err)
@@ -145,6 +164,7 @@ func (p *EventPublisher) PublishReviewStatus(ctx context.Context, sessionID stri
 // PublishChatCreated persists and broadcasts a chat.created event.
 // Used when a new chat is created for a session (first message).
 func (p *EventPublisher) PublishChatCreated(ctx context.Context, sessionID string, payload ChatCreatedPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal ChatCreatedPayload: %w", err)
@@ -155,6 +175,7 @@ func (p *EventPublisher) PublishChatCreated(ctx context.Context, sessionID strin
 // PublishInteractionCreated persists and broadcasts an interaction.created event.
 // Fired when an LLM or MCP interaction record is saved to the database.
 func (p *EventPublisher) PublishInteractionCreated(ctx context.Context, sessionID string, payload InteractionCreatedPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal InteractionCreatedPayload: %w", err)
@@ -166,6 +187,7 @@ func (p *EventPublisher) PublishInteractionCreated(ctx context.Context, sessionI
 // Published to both the session-specific channel (for SessionDetailPage) and
 // the global sessions channel (for the dashboard active alerts panel).
 func (p *EventPublisher) PublishSessionProgress(ctx context.Context, payload SessionProgressPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal SessionProgressPayload: %w", err)
@@ -180,6 +202,7 @@ func (p *EventPublisher) PublishSessionProgress(ctx context.Context, payload Ses
 // PublishExecutionProgress broadcasts an execution.progress transient event (no DB persistence).
 // Published to the session channel for per-agent progress display.
 func (p *EventPublisher) PublishExecutionProgress(ctx context.Context, sessionID string, payload ExecutionProgressPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal ExecutionProgressPayload: %w", err)
@@ -193,6 +216,7 @@ func (p *EventPublisher) PublishExecutionProgress(ctx context.Context, sessionID
 // provides real-time notification so the frontend can update individual agent cards
 // without waiting for the entire stage to complete.
 func (p *EventPublisher) PublishExecutionStatus(ctx context.Context, sessionID string, payload ExecutionStatusPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal ExecutionStatusPayload: %w", err)
@@ -200,11 +224,32 @@ func (p *EventPublisher) PublishExecutionStatus(ctx context.Context, sessionID s
 	return p.notifyOnly(ctx, SessionChannel(sessionID), payloadJSON)
 }
 
+// PublishSubAgentDispatched persists and broadcasts a subagent.dispatched event.
+func (p *EventPublisher) PublishSubAgentDispatched(ctx context.Context, sessionID string, payload SubAgentDispatchedPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SubAgentDispatchedPayload: %w", err)
+	}
+	return p.persistAndNotify(ctx, sessionID, SessionChannel(sessionID), payloadJSON)
+}
+
+// PublishSubAgentStatus persists and broadcasts a subagent.status event.
+func (p *EventPublisher) PublishSubAgentStatus(ctx context.Context, sessionID string, payload SubAgentStatusPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SubAgentStatusPayload: %w", err)
+	}
+	return p.persistAndNotify(ctx, sessionID, SessionChannel(sessionID), payloadJSON)
+}
+
 // PublishSessionScoreUpdated broadcasts a session.score_updated transient event
 // to both the session-specific channel (for SessionDetailPage scoring status)
 // and the global sessions channel (for the dashboard score spinner / refresh).
 func (p *EventPublisher) PublishSessionScoreUpdated(ctx context.Context, sessionID string, payload SessionScoreUpdatedPayload) error {
 	payload.SessionID = sessionID
+	stampRequestID(ctx, &payload.BasePayload)
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal SessionScoreUpdatedPayload: %w", err)
@@ -216,6 +261,55 @@ func (p *EventPublisher) PublishSessionScoreUpdated(ctx context.Context, session
 	return p.notifyOnly(ctx, GlobalSessionsChannel, payloadJSON)
 }
 
+// PublishMCPServerLog broadcasts an mcp.server_log transient event (no DB
+// persistence) to the session channel.
+func (p *EventPublisher) PublishMCPServerLog(ctx context.Context, sessionID string, payload MCPServerLogPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal MCPServerLogPayload: %w", err)
+	}
+	return p.notifyOnly(ctx, SessionChannel(sessionID), payloadJSON)
+}
+
+// PublishChatQueuePosition broadcasts a chat.queue_position transient event
+// (no DB persistence) to the session channel.
+func (p *EventPublisher) PublishChatQueuePosition(ctx context.Context, sessionID string, payload ChatQueuePositionPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ChatQueuePositionPayload: %w", err)
+	}
+	return p.notifyOnly(ctx, SessionChannel(sessionID), payloadJSON)
+}
+
+// PublishSavedViewMatched broadcasts a saved_view.matched transient event to
+// the owner's personal channel.
+func (p *EventPublisher) PublishSavedViewMatched(ctx context.Context, owner string, payload SavedViewMatchedPayload) error {
+	stampRequestID(ctx, &payload.BasePayload)
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SavedViewMatchedPayload: %w", err)
+	}
+	return p.notifyOnly(ctx, UserChannel(owner), payloadJSON)
+}
+
+// PublishSessionOrphanRecovered broadcasts a session.orphan_recovered transient
+// event to both the session-specific channel and the global sessions channel.
+func (p *EventPublisher) PublishSessionOrphanRecovered(ctx context.Context, sessionID string, payload SessionOrphanRecoveredPayload) error {
+	payload.SessionID = sessionID
+	stampRequestID(ctx, &payload.BasePayload)
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal SessionOrphanRecoveredPayload: %w", err)
+	}
+	if err := p.notifyOnly(ctx, SessionChannel(sessionID), payloadJSON); err != nil {
+		slog.Warn("Failed to publish orphan recovered to session channel",
+			"session_id", sessionID, "error", err)
+	}
+	return p.notifyOnly(ctx, GlobalSessionsChannel, payloadJSON)
+}
+
 // --- Internal core methods ---
 
 // persistAndNotify persists a pre-marshaled event to the database and broadcasts
@@ -1,11 +1,14 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 )
 
 func TestTruncateIfNeeded(t *testing.T) {
@@ -181,6 +184,34 @@ func TestNewEventPublisher(t *testing.T) {
 // Compile-time check: EventPublisher satisfies SessionCancelNotifier.
 var _ SessionCancelNotifier = (*EventPublisher)(nil)
 
+func TestStampRequestID(t *testing.T) {
+	ctx := requestid.WithContext(context.Background(), "req-1")
+
+	t.Run("stamped from context", func(t *testing.T) {
+		payload := SessionStatusPayload{BasePayload: BasePayload{Type: EventTypeSessionStatus, SessionID: "sess-1"}}
+		stampRequestID(ctx, &payload.BasePayload)
+
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"request_id":"req-1"`)
+	})
+
+	t.Run("explicit value kept", func(t *testing.T) {
+		base := BasePayload{RequestID: "req-explicit"}
+		stampRequestID(ctx, &base)
+		assert.Equal(t, "req-explicit", base.RequestID)
+	})
+
+	t.Run("omitted without request ID", func(t *testing.T) {
+		payload := SessionStatusPayload{BasePayload: BasePayload{Type: EventTypeSessionStatus, SessionID: "sess-1"}}
+		stampRequestID(context.Background(), &payload.BasePayload)
+
+		data, err := json.Marshal(payload)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "request_id")
+	})
+}
+
 func TestStageStatusPayload_JSON(t *testing.T) {
 	payload := StageStatusPayload{
 		BasePayload: BasePayload{
@@ -259,6 +290,7 @@ func TestSessionProgressPayload_JSON(t *testing.T) {
 }
 
 func TestExecutionProgressPayload_JSON(t *testing.T) {
+	percent := 20
 	payload := ExecutionProgressPayload{
 		BasePayload: BasePayload{
 			Type:      EventTypeExecutionProgress,
@@ -267,8 +299,9 @@ func TestExecutionProgressPayload_JSON(t *testing.T) {
 		},
 		StageID:     "stg-1",
 		ExecutionID: "exec-1",
-		Phase:       ProgressPhaseInvestigating,
+		Phase:       ProgressPhaseAnalyzing,
 		Message:     "Iteration 1/5",
+		Percent:     &percent,
 	}
 
 	data, err := json.Marshal(payload)
@@ -281,8 +314,23 @@ func TestExecutionProgressPayload_JSON(t *testing.T) {
 	assert.Equal(t, "sess-200", decoded.SessionID)
 	assert.Equal(t, "stg-1", decoded.StageID)
 	assert.Equal(t, "exec-1", decoded.ExecutionID)
-	assert.Equal(t, ProgressPhaseInvestigating, decoded.Phase)
+	assert.Equal(t, ProgressPhaseAnalyzing, decoded.Phase)
 	assert.Equal(t, "Iteration 1/5", decoded.Message)
+	require.NotNil(t, decoded.Percent)
+	assert.Equal(t, 20, *decoded.Percent)
+}
+
+func TestExecutionProgressPayload_OmitsUnknownPercent(t *testing.T) {
+	payload := ExecutionProgressPayload{
+		BasePayload: BasePayload{Type: EventTypeExecutionProgress, SessionID: "sess-201"},
+		ExecutionID: "exec-1",
+		Phase:       ProgressPhaseCallingTools,
+		Message:     "Calling k8s.get_pods",
+	}
+
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"percent"`)
 }
 
 func TestInteractionCreatedPayload_JSON(t *testing.T) {
@@ -0,0 +1,67 @@
+package events
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Resume tokens let a client that was told to go away (pod shutdown) restore
+// its subscriptions on whichever pod it reconnects to: the token lists each
+// subscribed channel with its filter, protocol version, and the last
+// persisted event delivered on it, and holds nothing tied to the issuing pod
+// or connection. It is not signed: it only carries what the client could
+// send in subscribe messages itself.
+const (
+	resumeTokenVersion = 1
+
+	// maxResumeTokenLength bounds the token a client may send, and with it
+	// the number of subscriptions a single resume restores.
+	maxResumeTokenLength = 64 << 10
+)
+
+// resumeState is the decoded content of a resume token.
+type resumeState struct {
+	Version       int                  `json:"v"`
+	Subscriptions []resumeSubscription `json:"subscriptions"`
+}
+
+// resumeSubscription is one channel subscription of a resume token.
+type resumeSubscription struct {
+	Channel         string              `json:"channel"`
+	LastEventID     int                 `json:"last_event_id,omitempty"`
+	Filter          *SubscriptionFilter `json:"filter,omitempty"`
+	ProtocolVersion int                 `json:"protocol_version"`
+}
+
+// encodeResumeToken renders subscriptions as an opaque, URL-safe token.
+func encodeResumeToken(subs []resumeSubscription) (string, error) {
+	data, err := json.Marshal(resumeState{Version: resumeTokenVersion, Subscriptions: subs})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeResumeToken parses a token produced by encodeResumeToken.
+func decodeResumeToken(token string) ([]resumeSubscription, error) {
+	if token == "" {
+		return nil, errors.New("resume_token is required")
+	}
+	if len(token) > maxResumeTokenLength {
+		return nil, fmt.Errorf("resume_token exceeds %d bytes", maxResumeTokenLength)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("malformed resume_token")
+	}
+	var state resumeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, errors.New("malformed resume_token")
+	}
+	if state.Version != resumeTokenVersion {
+		return nil, fmt.Errorf("unsupported resume_token version %d", state.Version)
+	}
+	return state.Subscriptions, nil
+}
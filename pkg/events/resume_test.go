@@ -0,0 +1,43 @@
+package events
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResumeToken(t *testing.T) {
+	subs := []resumeSubscription{
+		{Channel: GlobalSessionsChannel, LastEventID: 42, Filter: &SubscriptionFilter{EventTypes: []string{"session.status"}}, ProtocolVersion: ProtocolVersion},
+		{Channel: SessionChannel("abc"), ProtocolVersion: ProtocolVersion1},
+	}
+	token, err := encodeResumeToken(subs)
+	require.NoError(t, err)
+	assert.NotContains(t, token, "=", "token must be URL-safe without padding")
+
+	decoded, err := decodeResumeToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, subs, decoded)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{name: "empty", token: "", wantErr: "resume_token is required"},
+		{name: "not base64", token: "!!!", wantErr: "malformed resume_token"},
+		{name: "not json", token: base64.RawURLEncoding.EncodeToString([]byte("nope")), wantErr: "malformed resume_token"},
+		{name: "unknown version", token: base64.RawURLEncoding.EncodeToString([]byte(`{"v":9}`)), wantErr: "unsupported resume_token version 9"},
+		{name: "oversized", token: strings.Repeat("a", maxResumeTokenLength+1), wantErr: "resume_token exceeds"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeResumeToken(tt.token)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
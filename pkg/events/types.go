@@ -46,6 +46,12 @@
 // ════════════════════════════════════════════════════════════════
 package events
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
 // Persistent event types (stored in DB + NOTIFY).
 const (
 	// Timeline event lifecycle — see package doc for the two lifecycle patterns.
@@ -60,6 +66,12 @@ const (
 
 	// Review workflow lifecycle
 	EventTypeReviewStatus = "review.status"
+
+	// Sub-agent lifecycle — an orchestrator dispatched a sub-agent, and the
+	// sub-agent reached a terminal status. Persisted so a reconnecting
+	// dashboard can rebuild the orchestration tree from catch-up.
+	EventTypeSubAgentDispatched = "subagent.dispatched"
+	EventTypeSubAgentStatus     = "subagent.status"
 )
 
 // Stage lifecycle status values (used in StageStatusPayload.Status).
@@ -82,9 +94,21 @@ const (
 	ScoringStatusFailed     ScoringStatus = "failed"      // scoring encountered an error
 )
 
-// Chat event types (stored in DB + NOTIFY).
+// Chat event types.
+const (
+	EventTypeChatCreated = "chat.created" // stored in DB + NOTIFY
+
+	// Transient (NOTIFY only): a queued chat message's place in the pod's
+	// chat queue. Position 0 means it left the queue (started or discarded).
+	EventTypeChatQueuePosition = "chat.queue_position"
+)
+
+// Why a chat message is queued (used in ChatQueuePositionPayload.Reason).
 const (
-	EventTypeChatCreated = "chat.created"
+	ChatQueueReasonChatBusy   = "chat_busy"   // the chat's previous response is still being generated
+	ChatQueueReasonAtCapacity = "at_capacity" // the pod is generating as many responses as it may
+	ChatQueueReasonStarted    = "started"     // left the queue: the response is being generated
+	ChatQueueReasonDiscarded  = "discarded"   // left the queue: cancelled, shutdown, or failed to start
 )
 
 // Interaction event types (stored in DB + NOTIFY).
@@ -121,17 +145,60 @@ const (
 	// Score updated — published to GlobalSessionsChannel when scoring starts or finishes.
 	// Allows the dashboard session list to refresh and show the spinner / final score.
 	EventTypeSessionScoreUpdated = "session.score_updated"
+
+	// Saved view matched — published to UserChannel(owner) when a session
+	// status transition matches one of the user's subscribed saved views.
+	EventTypeSavedViewMatched = "saved_view.matched"
+
+	// Orphan recovered — published to SessionChannel(sessionID) and
+	// GlobalSessionsChannel when a session whose worker stopped heartbeating
+	// is failed or requeued, so crashing workers are visible on the dashboard.
+	EventTypeSessionOrphanRecovered = "session.orphan_recovered"
+
+	// MCP server log — published to SessionChannel(sessionID) for each
+	// stderr line a stdio MCP server writes during a tool call, so failing
+	// tools can be debugged live. The log is also stored on the MCP
+	// interaction record.
+	EventTypeMCPServerLog = "mcp.server_log"
 )
 
-// ProgressPhase values for execution-level progress events.
+// Connection control messages sent by the server to WebSocket clients. They
+// are not events: they carry no session_id and are never persisted.
 const (
-	ProgressPhaseInvestigating = "investigating"
-	ProgressPhaseRemediating   = "remediating"
-	ProgressPhaseGatheringInfo = "gathering_info"
-	ProgressPhaseDistilling    = "distilling"
-	ProgressPhaseConcluding    = "concluding"
-	ProgressPhaseSynthesizing  = "synthesizing"
-	ProgressPhaseFinalizing    = "finalizing"
+	MessageTypeConnectionEstablished = "connection.established"
+	MessageTypeSubscriptionConfirmed = "subscription.confirmed"
+	MessageTypeSubscriptionError     = "subscription.error"
+	MessageTypeCatchupOverflow       = "catchup.overflow"
+	MessageTypePong                  = "pong"
+	MessageTypeError                 = "error"
+
+	// MessageTypeConnectionGoingAway is sent before a shutting-down pod
+	// closes the connection. It carries retry_after_ms, the delay before
+	// reconnecting, and resume_token (when subscribed), which the client
+	// passes to the resume action on its next connection to any pod.
+	MessageTypeConnectionGoingAway = "connection.going_away"
+)
+
+// Orphan recovery actions (used in SessionOrphanRecoveredPayload.Recovery).
+const (
+	OrphanRecoveryRequeued = "requeued"
+	OrphanRecoveryFailed   = "failed"
+)
+
+// ProgressPhase values for execution-level progress events. Every controller
+// reports one of these standard phases so the dashboard can render them
+// uniformly.
+const (
+	ProgressPhaseAnalyzing        = "analyzing"          // LLM reasoning over the current context
+	ProgressPhaseCallingTools     = "calling_tools"      // executing the tool calls of an iteration
+	ProgressPhaseWaitingSubAgents = "waiting_sub_agents" // orchestrator blocked on sub-agent results
+	ProgressPhaseSynthesizing     = "synthesizing"       // merging parallel agent results
+	ProgressPhaseFinalizing       = "finalizing"         // producing the final answer or summary
+	ProgressPhaseWaitingRemote    = "waiting_remote"     // stage delegated to another TARSy instance (system.federation)
+
+	// ProgressPhaseTimeWarning marks a soft time_warnings threshold being
+	// crossed. ExecutionID is empty for stage-level warnings.
+	ProgressPhaseTimeWarning = "time_warning"
 )
 
 // GlobalSessionsChannel is the channel for session-level status events.
@@ -140,9 +207,47 @@ const GlobalSessionsChannel = "sessions"
 
 // CancellationsChannel is the backend-to-backend channel for cross-pod
 // session cancellation. All pods LISTEN on this channel; the cancel handler
-// publishes the session ID as payload. The owning pod cancels the context.
+// publishes a CancellationPayload. The owning pod cancels the context.
 const CancellationsChannel = "cancellations"
 
+// CancellationPayload is the NOTIFY payload on CancellationsChannel.
+// Reason, Initiator, and CancelledBy describe the request for logging on the
+// owning pod; the authoritative record is persisted on the session.
+type CancellationPayload struct {
+	SessionID   string `json:"session_id"`
+	Initiator   string `json:"initiator,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+	CancelledBy string `json:"cancelled_by,omitempty"`
+}
+
+// ParseCancellationPayload decodes a CancellationsChannel payload. A payload
+// that is not a JSON object is treated as a bare session ID, the format
+// published by older pods during a rolling upgrade.
+func ParseCancellationPayload(data []byte) CancellationPayload {
+	var p CancellationPayload
+	if len(data) > 0 && data[0] == '{' && json.Unmarshal(data, &p) == nil {
+		return p
+	}
+	return CancellationPayload{SessionID: string(data)}
+}
+
+// maxChannelLength is PostgreSQL's identifier limit; LISTEN truncates
+// longer channel names, so they would never match the NOTIFY side.
+const maxChannelLength = 63
+
+// UserChannel returns the channel name for a user's personal notifications.
+// Format: "user:{subject}", or "user:{sha256(subject)[:32]}" when the subject
+// would exceed the PostgreSQL channel name limit. Clients read the channel
+// name from GET /api/v1/profile instead of deriving it.
+func UserChannel(subject string) string {
+	ch := "user:" + subject
+	if len(ch) <= maxChannelLength {
+		return ch
+	}
+	sum := sha256.Sum256([]byte(subject))
+	return "user:" + hex.EncodeToString(sum[:16])
+}
+
 // SessionChannel returns the channel name for a specific session's events.
 // Format: "session:{session_id}"
 func SessionChannel(sessionID string) string {
@@ -151,7 +256,10 @@ func SessionChannel(sessionID string) string {
 
 // ClientMessage is the JSON structure for client → server WebSocket messages.
 type ClientMessage struct {
-	Action      string `json:"action"`                  // "subscribe", "unsubscribe", "catchup", "ping"
-	Channel     string `json:"channel,omitempty"`       // Channel name (e.g., "session:abc-123")
-	LastEventID *int   `json:"last_event_id,omitempty"` // For catchup
+	Action          string              `json:"action"`                     // "subscribe", "unsubscribe", "catchup", "resume", "ping"
+	Channel         string              `json:"channel,omitempty"`          // Channel name (e.g., "session:abc-123")
+	LastEventID     *int                `json:"last_event_id,omitempty"`    // For catchup; for subscribe, resumes the auto catch-up after this event
+	Filter          *SubscriptionFilter `json:"filter,omitempty"`           // For subscribe: server-side event filter
+	ProtocolVersion int                 `json:"protocol_version,omitempty"` // For subscribe: highest event protocol version understood (omitted = 1)
+	ResumeToken     string              `json:"resume_token,omitempty"`     // For resume: token from connection.going_away
 }
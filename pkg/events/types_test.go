@@ -1,6 +1,7 @@
 package events
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,6 +37,17 @@ func TestSessionChannel(t *testing.T) {
 	}
 }
 
+func TestUserChannel(t *testing.T) {
+	assert.Equal(t, "user:f4c1e6d2-0b7a-4c1e-9d3e-5a6b7c8d9e0f", UserChannel("f4c1e6d2-0b7a-4c1e-9d3e-5a6b7c8d9e0f"))
+
+	long := "system:serviceaccount:some-long-namespace-name:some-long-service-account"
+	ch := UserChannel(long)
+	assert.LessOrEqual(t, len(ch), maxChannelLength)
+	assert.True(t, strings.HasPrefix(ch, "user:"))
+	assert.Equal(t, ch, UserChannel(long), "hashed channel is stable")
+	assert.NotEqual(t, ch, UserChannel(long+"-2"))
+}
+
 func TestEventTypeConstants(t *testing.T) {
 	// Verify event types are non-empty and distinct
 	types := []string{
@@ -47,6 +59,7 @@ func TestEventTypeConstants(t *testing.T) {
 		EventTypeSessionProgress,
 		EventTypeExecutionProgress,
 		EventTypeInteractionCreated,
+		EventTypeSavedViewMatched,
 	}
 
 	seen := make(map[string]bool)
@@ -59,13 +72,12 @@ func TestEventTypeConstants(t *testing.T) {
 
 func TestProgressPhaseConstants(t *testing.T) {
 	phases := []string{
-		ProgressPhaseInvestigating,
-		ProgressPhaseRemediating,
-		ProgressPhaseGatheringInfo,
-		ProgressPhaseDistilling,
-		ProgressPhaseConcluding,
+		ProgressPhaseAnalyzing,
+		ProgressPhaseCallingTools,
+		ProgressPhaseWaitingSubAgents,
 		ProgressPhaseSynthesizing,
 		ProgressPhaseFinalizing,
+		ProgressPhaseTimeWarning,
 	}
 
 	seen := make(map[string]bool)
@@ -85,3 +97,15 @@ func TestInteractionTypeConstants(t *testing.T) {
 func TestGlobalSessionsChannel(t *testing.T) {
 	assert.Equal(t, "sessions", GlobalSessionsChannel)
 }
+
+func TestParseCancellationPayload(t *testing.T) {
+	t.Run("json payload", func(t *testing.T) {
+		got := ParseCancellationPayload([]byte(`{"session_id":"s-1","initiator":"user","reason":"dup","cancelled_by":"alice"}`))
+		assert.Equal(t, CancellationPayload{SessionID: "s-1", Initiator: "user", Reason: "dup", CancelledBy: "alice"}, got)
+	})
+
+	t.Run("bare session id from older pods", func(t *testing.T) {
+		got := ParseCancellationPayload([]byte("550e8400-e29b-41d4-a716-446655440000"))
+		assert.Equal(t, CancellationPayload{SessionID: "550e8400-e29b-41d4-a716-446655440000"}, got)
+	})
+}
@@ -0,0 +1,37 @@
+package eventstream
+
+import (
+	"context"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+)
+
+// streamingPublisher forwards every event to the wrapped publisher and
+// additionally feeds session and stage status transitions to the stream.
+type streamingPublisher struct {
+	agent.EventPublisher
+	stream *Publisher
+}
+
+// Wrap returns an agent.EventPublisher that publishes session.started,
+// stage.completed and session.terminal events for the status transitions
+// passing through next. Returns next unchanged when p or next is nil.
+func (p *Publisher) Wrap(next agent.EventPublisher) agent.EventPublisher {
+	if p == nil || next == nil {
+		return next
+	}
+	return &streamingPublisher{EventPublisher: next, stream: p}
+}
+
+func (s *streamingPublisher) PublishSessionStatus(ctx context.Context, sessionID string, payload events.SessionStatusPayload) error {
+	err := s.EventPublisher.PublishSessionStatus(ctx, sessionID, payload)
+	s.stream.sessionStatus(sessionID, payload.Status)
+	return err
+}
+
+func (s *streamingPublisher) PublishStageStatus(ctx context.Context, sessionID string, payload events.StageStatusPayload) error {
+	err := s.EventPublisher.PublishStageStatus(ctx, sessionID, payload)
+	s.stream.stageStatus(sessionID, payload)
+	return err
+}
@@ -0,0 +1,163 @@
+package eventstream
+
+import (
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// SpecVersion is the CloudEvents specification version of published events.
+const SpecVersion = "1.0"
+
+// SchemaVersion is appended to every event type and schema name. Bump it
+// (and add new schema files) for breaking changes to the event data.
+const SchemaVersion = "v1"
+
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+// Type returns the CloudEvents "type" attribute of a stream event,
+// e.g. "tarsy.session.created.v1".
+func Type(event string) string {
+	return "tarsy." + event + "." + SchemaVersion
+}
+
+// SchemaName returns the file name of the JSON Schema describing the data of
+// a stream event, e.g. "session.created.v1.json".
+func SchemaName(event string) string {
+	return event + "." + SchemaVersion + ".json"
+}
+
+// Schema returns the JSON Schema with the given file name (see SchemaName).
+func Schema(name string) ([]byte, bool) {
+	data, err := schemaFS.ReadFile("schemas/" + name)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// SchemaPath is the API route serving the JSON Schema of an event's data,
+// used for the CloudEvents "dataschema" attribute.
+func SchemaPath(event string) string {
+	return "/api/v1/event-stream/schemas/" + SchemaName(event)
+}
+
+// CloudEvent is a CloudEvents 1.0 envelope as sent in structured mode.
+type CloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	DataSchema      string    `json:"dataschema,omitempty"`
+
+	// PartitionKey is the CloudEvents partitioning extension: the session
+	// ID, so Kafka bindings keep each session's events in one partition.
+	PartitionKey string `json:"partitionkey,omitempty"`
+
+	Data any `json:"data"`
+}
+
+// SessionData is the data of session.created, session.started and
+// session.terminal events. Fields are filled according to the event; see
+// the schemas directory for which ones each event carries.
+type SessionData struct {
+	SessionID        string     `json:"session_id"`
+	GroupID          string     `json:"group_id,omitempty"`
+	AlertType        string     `json:"alert_type"`
+	ChainID          string     `json:"chain_id"`
+	AlertKey         string     `json:"alert_key,omitempty"`
+	Status           string     `json:"status"`
+	Author           string     `json:"author,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	StartedAt        *time.Time `json:"started_at,omitempty"`
+	CompletedAt      *time.Time `json:"completed_at,omitempty"`
+	QueueWaitMs      *int64     `json:"queue_wait_ms,omitempty"`
+	DurationMs       *int64     `json:"duration_ms,omitempty"`
+	Severity         string     `json:"severity,omitempty"`
+	ExecutiveSummary string     `json:"executive_summary,omitempty"`
+	ErrorMessage     string     `json:"error_message,omitempty"`
+	CancelInitiator  string     `json:"cancel_initiator,omitempty"`
+	SessionURL       string     `json:"session_url,omitempty"`
+}
+
+// StageData is the data of stage.completed events.
+type StageData struct {
+	SessionID  string `json:"session_id"`
+	GroupID    string `json:"group_id,omitempty"`
+	AlertType  string `json:"alert_type"`
+	ChainID    string `json:"chain_id"`
+	StageID    string `json:"stage_id,omitempty"`
+	StageName  string `json:"stage_name"`
+	StageIndex int    `json:"stage_index"`
+	StageType  string `json:"stage_type"`
+	Status     string `json:"status"`
+	SessionURL string `json:"session_url,omitempty"`
+}
+
+// newSessionData builds session event data from the stored session.
+// status is the status that triggered the event: the stored one may already
+// have moved on by the time the event is sent.
+func newSessionData(event string, session *ent.AlertSession, status, dashboardURL string) SessionData {
+	d := SessionData{
+		SessionID: session.ID,
+		AlertType: session.AlertType,
+		ChainID:   session.ChainID,
+		Status:    status,
+		CreatedAt: session.CreatedAt,
+	}
+	if session.GroupID != nil {
+		d.GroupID = *session.GroupID
+	}
+	if session.AlertKey != nil {
+		d.AlertKey = *session.AlertKey
+	}
+	if session.Author != nil {
+		d.Author = *session.Author
+	}
+	if dashboardURL != "" {
+		d.SessionURL = sessionURL(dashboardURL, session.ID)
+	}
+	if event == config.StreamEventSessionCreated {
+		return d
+	}
+
+	d.StartedAt = session.StartedAt
+	if session.StartedAt != nil {
+		wait := session.StartedAt.Sub(session.CreatedAt).Milliseconds()
+		d.QueueWaitMs = &wait
+	}
+	if event == config.StreamEventSessionStarted {
+		return d
+	}
+
+	d.QueueWaitMs = nil
+	d.CompletedAt = session.CompletedAt
+	if session.StartedAt != nil && session.CompletedAt != nil {
+		dur := session.CompletedAt.Sub(*session.StartedAt).Milliseconds()
+		d.DurationMs = &dur
+	}
+	if session.Severity != nil {
+		d.Severity = string(*session.Severity)
+	}
+	if session.ExecutiveSummary != nil {
+		d.ExecutiveSummary = *session.ExecutiveSummary
+	}
+	if session.ErrorMessage != nil {
+		d.ErrorMessage = *session.ErrorMessage
+	}
+	if session.CancelInitiator != nil {
+		d.CancelInitiator = string(*session.CancelInitiator)
+	}
+	return d
+}
+
+func sessionURL(dashboardURL, sessionID string) string {
+	return fmt.Sprintf("%s/sessions/%s", dashboardURL, sessionID)
+}
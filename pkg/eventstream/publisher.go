@@ -0,0 +1,376 @@
+// Package eventstream publishes session lifecycle events (created, started,
+// stage completed, terminal) as CloudEvents over HTTP, so analytics and
+// downstream automation can consume TARSy activity without polling the API.
+//
+// Events go to a single HTTP endpoint: a Knative broker, a Kafka REST proxy
+// or HTTP bridge, or any other CloudEvents receiver. Each event carries a
+// versioned type ("tarsy.session.created.v1") and, when the dashboard URL is
+// configured, a dataschema pointing at the JSON Schema served by the API.
+package eventstream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+)
+
+// Content types of the two CloudEvents HTTP content modes.
+const (
+	structuredContentType = "application/cloudevents+json"
+	dataContentType       = "application/json"
+)
+
+// maxBackoff caps the wait between delivery attempts of one event.
+const maxBackoff = 30 * time.Second
+
+// initialBackoff is the wait before the first retry; it doubles on each
+// further retry. A variable so tests can shorten it.
+var initialBackoff = time.Second
+
+// item is a lifecycle event waiting for delivery. Session data is loaded
+// when the event is sent, so publishing never blocks on the database.
+type item struct {
+	event     string
+	sessionID string
+	status    string                     // session status that triggered the event
+	stage     *events.StageStatusPayload // stage.completed only
+	at        time.Time
+}
+
+// Publisher sends lifecycle events to the configured endpoint from a single
+// background goroutine, in publication order. Publishing only enqueues: when
+// the buffer is full the event is dropped (and counted) rather than slowing
+// down the session that produced it. Nil-safe: all methods are no-ops when
+// the publisher is nil.
+type Publisher struct {
+	client       *ent.Client
+	cfg          *config.EventStreamConfig
+	dashboardURL string
+	token        string
+	httpClient   *http.Client
+	logger       *slog.Logger
+
+	queue    chan item
+	stopped  atomic.Bool
+	stopping chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// NewPublisher creates a lifecycle event publisher. Returns nil when the
+// event stream is disabled. Call Start to begin delivery.
+func NewPublisher(client *ent.Client, cfg *config.EventStreamConfig, dashboardURL string) *Publisher {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Publisher{
+		client:       client,
+		cfg:          cfg,
+		dashboardURL: dashboardURL,
+		httpClient:   &http.Client{},
+		logger:       slog.With("component", "event_stream"),
+		queue:        make(chan item, cfg.BufferSize),
+		stopping:     make(chan struct{}),
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+	if cfg.TokenEnv != "" {
+		p.token = os.Getenv(cfg.TokenEnv)
+	}
+	return p
+}
+
+// Start launches the delivery goroutine.
+func (p *Publisher) Start() {
+	if p == nil {
+		return
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.run()
+	}()
+	p.logger.Info("Event stream started", "endpoint", p.cfg.Endpoint, "mode", p.cfg.Mode)
+}
+
+// Stop stops accepting events, delivers what is already buffered (bounded by
+// twice the per-attempt timeout) and waits for the delivery goroutine.
+// Events still undelivered at the deadline are dropped.
+func (p *Publisher) Stop() {
+	if p == nil || p.stopped.Swap(true) {
+		return
+	}
+	close(p.stopping)
+	timer := time.AfterFunc(2*p.cfg.Timeout, p.cancel)
+	p.wg.Wait()
+	timer.Stop()
+	p.cancel()
+}
+
+// SessionCreated publishes session.created for a newly submitted session.
+// For fan-out submissions pass the primary session: every session of its
+// group gets an event.
+func (p *Publisher) SessionCreated(session *ent.AlertSession) {
+	if p == nil {
+		return
+	}
+	p.enqueue(item{
+		event:     config.StreamEventSessionCreated,
+		sessionID: session.ID,
+		status:    string(alertsession.StatusPending),
+	})
+}
+
+// sessionStatus maps a session status transition onto session.started or
+// session.terminal. Other transitions (e.g. cancelling) are not published.
+func (p *Publisher) sessionStatus(sessionID string, status alertsession.Status) {
+	switch status {
+	case alertsession.StatusInProgress:
+		p.enqueue(item{event: config.StreamEventSessionStarted, sessionID: sessionID, status: string(status)})
+	case alertsession.StatusCompleted, alertsession.StatusFailed, alertsession.StatusTimedOut,
+		alertsession.StatusCancelled, alertsession.StatusAutoCancelled:
+		p.enqueue(item{event: config.StreamEventSessionTerminal, sessionID: sessionID, status: string(status)})
+	}
+}
+
+// stageStatus publishes stage.completed for stage transitions to a final
+// status. Started transitions are not published.
+func (p *Publisher) stageStatus(sessionID string, payload events.StageStatusPayload) {
+	if payload.Status == events.StageStatusStarted {
+		return
+	}
+	p.enqueue(item{event: config.StreamEventStageCompleted, sessionID: sessionID, status: payload.Status, stage: &payload})
+}
+
+func (p *Publisher) enqueue(it item) {
+	if !p.cfg.WantsEvent(it.event) {
+		return
+	}
+	if p.stopped.Load() {
+		metrics.EventStreamEventsTotal.WithLabelValues(it.event, "dropped").Inc()
+		return
+	}
+	if it.at.IsZero() {
+		it.at = time.Now()
+	}
+	select {
+	case p.queue <- it:
+	default:
+		metrics.EventStreamEventsTotal.WithLabelValues(it.event, "dropped").Inc()
+		p.logger.Warn("Event stream buffer full, dropping event",
+			"event", it.event, "session_id", it.sessionID, "buffer_size", p.cfg.BufferSize)
+	}
+}
+
+// run delivers events until Stop, then drains the buffer.
+func (p *Publisher) run() {
+	for {
+		select {
+		case it := <-p.queue:
+			p.deliver(it)
+		case <-p.stopping:
+			for {
+				select {
+				case it := <-p.queue:
+					p.deliver(it)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *Publisher) deliver(it item) {
+	log := p.logger.With("event", it.event, "session_id", it.sessionID)
+	if p.ctx.Err() != nil {
+		metrics.EventStreamEventsTotal.WithLabelValues(it.event, "dropped").Inc()
+		return
+	}
+
+	ces, err := p.build(p.ctx, it)
+	if err != nil {
+		metrics.EventStreamEventsTotal.WithLabelValues(it.event, "failed").Inc()
+		log.Error("Failed to build lifecycle event", "error", err)
+		return
+	}
+	for _, ce := range ces {
+		if err := p.send(p.ctx, ce); err != nil {
+			metrics.EventStreamEventsTotal.WithLabelValues(it.event, "failed").Inc()
+			log.Error("Failed to publish lifecycle event", "error", err)
+			continue
+		}
+		metrics.EventStreamEventsTotal.WithLabelValues(it.event, "delivered").Inc()
+	}
+}
+
+// build loads the session(s) an item refers to and wraps their data in
+// CloudEvents. session.created of a fan-out session yields one event per
+// session of its group.
+func (p *Publisher) build(ctx context.Context, it item) ([]CloudEvent, error) {
+	session, err := p.client.AlertSession.Get(ctx, it.sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load session: %w", err)
+	}
+
+	switch it.event {
+	case config.StreamEventStageCompleted:
+		return []CloudEvent{p.newCloudEvent(it, session.ID, p.newStageData(session, it.stage))}, nil
+	case config.StreamEventSessionCreated:
+		sessions := []*ent.AlertSession{session}
+		if session.GroupID != nil {
+			sessions, err = p.client.AlertSession.Query().
+				Where(alertsession.GroupID(*session.GroupID)).
+				Order(ent.Asc(alertsession.FieldCreatedAt), ent.Asc(alertsession.FieldID)).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load session group: %w", err)
+			}
+		}
+		ces := make([]CloudEvent, 0, len(sessions))
+		for _, s := range sessions {
+			ces = append(ces, p.newCloudEvent(it, s.ID, newSessionData(it.event, s, it.status, p.dashboardURL)))
+		}
+		return ces, nil
+	default:
+		return []CloudEvent{p.newCloudEvent(it, session.ID, newSessionData(it.event, session, it.status, p.dashboardURL))}, nil
+	}
+}
+
+func (p *Publisher) newStageData(session *ent.AlertSession, stage *events.StageStatusPayload) StageData {
+	d := StageData{
+		SessionID:  session.ID,
+		AlertType:  session.AlertType,
+		ChainID:    session.ChainID,
+		StageID:    stage.StageID,
+		StageName:  stage.StageName,
+		StageIndex: stage.StageIndex,
+		StageType:  stage.StageType,
+		Status:     stage.Status,
+	}
+	if session.GroupID != nil {
+		d.GroupID = *session.GroupID
+	}
+	if p.dashboardURL != "" {
+		d.SessionURL = sessionURL(p.dashboardURL, session.ID)
+	}
+	return d
+}
+
+func (p *Publisher) newCloudEvent(it item, sessionID string, data any) CloudEvent {
+	ce := CloudEvent{
+		SpecVersion:     SpecVersion,
+		ID:              uuid.New().String(),
+		Source:          p.cfg.Source,
+		Type:            Type(it.event),
+		Subject:         sessionID,
+		Time:            it.at.UTC(),
+		DataContentType: dataContentType,
+		PartitionKey:    sessionID,
+		Data:            data,
+	}
+	if p.dashboardURL != "" {
+		ce.DataSchema = p.dashboardURL + SchemaPath(it.event)
+	}
+	return ce
+}
+
+// send delivers one event, retrying transport errors, 408, 429 and 5xx
+// responses with exponential backoff up to MaxAttempts.
+func (p *Publisher) send(ctx context.Context, ce CloudEvent) error {
+	var err error
+	for attempt := 1; attempt <= p.cfg.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			wait := min(initialBackoff<<(attempt-2), maxBackoff)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w (last error: %v)", ctx.Err(), err)
+			case <-time.After(wait):
+			}
+		}
+		var retryable bool
+		retryable, err = p.post(ctx, ce)
+		if err == nil || !retryable {
+			return err
+		}
+	}
+	return err
+}
+
+func (p *Publisher) post(ctx context.Context, ce CloudEvent) (retryable bool, err error) {
+	req, err := p.newRequest(ctx, ce)
+	if err != nil {
+		return false, err
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	resp, err := p.httpClient.Do(req.WithContext(reqCtx))
+	if err != nil {
+		return true, fmt.Errorf("event stream request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable = resp.StatusCode == http.StatusRequestTimeout ||
+		resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= 500
+	return retryable, fmt.Errorf("event stream endpoint returned status %d", resp.StatusCode)
+}
+
+// newRequest encodes a CloudEvent in the configured content mode.
+func (p *Publisher) newRequest(ctx context.Context, ce CloudEvent) (*http.Request, error) {
+	var body []byte
+	var err error
+	if p.cfg.Mode == config.EventStreamModeBinary {
+		body, err = json.Marshal(ce.Data)
+	} else {
+		body, err = json.Marshal(ce)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build event stream request: %w", err)
+	}
+	if p.cfg.Mode == config.EventStreamModeBinary {
+		req.Header.Set("Content-Type", ce.DataContentType)
+		req.Header.Set("ce-specversion", ce.SpecVersion)
+		req.Header.Set("ce-id", ce.ID)
+		req.Header.Set("ce-source", ce.Source)
+		req.Header.Set("ce-type", ce.Type)
+		req.Header.Set("ce-subject", ce.Subject)
+		req.Header.Set("ce-time", ce.Time.Format(time.RFC3339Nano))
+		req.Header.Set("ce-partitionkey", ce.PartitionKey)
+		if ce.DataSchema != "" {
+			req.Header.Set("ce-dataschema", ce.DataSchema)
+		}
+	} else {
+		req.Header.Set("Content-Type", structuredContentType)
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	return req, nil
+}
@@ -0,0 +1,331 @@
+package eventstream
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+)
+
+func newTestPublisher(t *testing.T, mutate func(*config.EventStreamConfig)) *Publisher {
+	t.Helper()
+	cfg := config.DefaultEventStreamConfig()
+	cfg.Enabled = true
+	cfg.Endpoint = "http://127.0.0.1:1"
+	if mutate != nil {
+		mutate(cfg)
+	}
+	p := NewPublisher(nil, cfg, "https://tarsy.example.com")
+	require.NotNil(t, p)
+	return p
+}
+
+func testCloudEvent() CloudEvent {
+	return CloudEvent{
+		SpecVersion:     SpecVersion,
+		ID:              "evt-1",
+		Source:          "tarsy",
+		Type:            Type(config.StreamEventSessionTerminal),
+		Subject:         "sess-1",
+		Time:            time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		DataContentType: dataContentType,
+		DataSchema:      "https://tarsy.example.com" + SchemaPath(config.StreamEventSessionTerminal),
+		PartitionKey:    "sess-1",
+		Data:            SessionData{SessionID: "sess-1", AlertType: "PodCrash", ChainID: "k8s", Status: "completed"},
+	}
+}
+
+func TestNewPublisher_Disabled(t *testing.T) {
+	assert.Nil(t, NewPublisher(nil, nil, ""))
+	assert.Nil(t, NewPublisher(nil, config.DefaultEventStreamConfig(), ""))
+
+	// Nil publisher is a no-op everywhere.
+	var p *Publisher
+	p.Start()
+	p.SessionCreated(&ent.AlertSession{ID: "sess-1"})
+	p.Stop()
+	assert.Nil(t, p.Wrap(nil))
+}
+
+func TestSchemas(t *testing.T) {
+	for _, event := range config.StreamEvents {
+		t.Run(event, func(t *testing.T) {
+			data, ok := Schema(SchemaName(event))
+			require.True(t, ok, "missing schema for %s", event)
+
+			var schema struct {
+				ID       string   `json:"$id"`
+				Required []string `json:"required"`
+			}
+			require.NoError(t, json.Unmarshal(data, &schema))
+			assert.Equal(t, Type(event), schema.ID)
+			assert.Contains(t, schema.Required, "session_id")
+		})
+	}
+
+	_, ok := Schema("../publisher.go")
+	assert.False(t, ok)
+}
+
+func TestPublisher_Send(t *testing.T) {
+	t.Setenv("TARSY_TEST_EVENT_STREAM_TOKEN", "s3cret")
+
+	tests := []struct {
+		name  string
+		mode  config.EventStreamMode
+		check func(t *testing.T, r *http.Request, body []byte)
+	}{
+		{
+			name: "structured",
+			mode: config.EventStreamModeStructured,
+			check: func(t *testing.T, r *http.Request, body []byte) {
+				assert.Equal(t, "application/cloudevents+json", r.Header.Get("Content-Type"))
+				assert.Empty(t, r.Header.Get("ce-type"))
+
+				var got map[string]any
+				require.NoError(t, json.Unmarshal(body, &got))
+				assert.Equal(t, "1.0", got["specversion"])
+				assert.Equal(t, "tarsy.session.terminal.v1", got["type"])
+				assert.Equal(t, "sess-1", got["subject"])
+				assert.Equal(t, "sess-1", got["partitionkey"])
+				assert.Equal(t, "https://tarsy.example.com/api/v1/event-stream/schemas/session.terminal.v1.json", got["dataschema"])
+				data, _ := got["data"].(map[string]any)
+				assert.Equal(t, "completed", data["status"])
+			},
+		},
+		{
+			name: "binary",
+			mode: config.EventStreamModeBinary,
+			check: func(t *testing.T, r *http.Request, body []byte) {
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "1.0", r.Header.Get("ce-specversion"))
+				assert.Equal(t, "evt-1", r.Header.Get("ce-id"))
+				assert.Equal(t, "tarsy", r.Header.Get("ce-source"))
+				assert.Equal(t, "tarsy.session.terminal.v1", r.Header.Get("ce-type"))
+				assert.Equal(t, "sess-1", r.Header.Get("ce-subject"))
+				assert.Equal(t, "sess-1", r.Header.Get("ce-partitionkey"))
+				assert.Equal(t, "2026-10-01T12:00:00Z", r.Header.Get("ce-time"))
+
+				var data SessionData
+				require.NoError(t, json.Unmarshal(body, &data))
+				assert.Equal(t, "sess-1", data.SessionID)
+				assert.Equal(t, "completed", data.Status)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "Bearer s3cret", r.Header.Get("Authorization"))
+				tt.check(t, r, body)
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer srv.Close()
+
+			p := newTestPublisher(t, func(c *config.EventStreamConfig) {
+				c.Endpoint = srv.URL
+				c.Mode = tt.mode
+				c.TokenEnv = "TARSY_TEST_EVENT_STREAM_TOKEN"
+			})
+			require.NoError(t, p.send(context.Background(), testCloudEvent()))
+			assert.Equal(t, int32(1), calls.Load())
+		})
+	}
+}
+
+func TestPublisher_SendRetries(t *testing.T) {
+	orig := initialBackoff
+	initialBackoff = time.Millisecond
+	t.Cleanup(func() { initialBackoff = orig })
+
+	tests := []struct {
+		name      string
+		statuses  []int
+		wantCalls int32
+		wantErr   string
+	}{
+		{name: "retries server errors", statuses: []int{503, 429, 200}, wantCalls: 3},
+		{name: "gives up after max attempts", statuses: []int{500, 500, 500, 200}, wantCalls: 3, wantErr: "status 500"},
+		{name: "client error is final", statuses: []int{400, 200}, wantCalls: 1, wantErr: "status 400"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				n := calls.Add(1)
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer srv.Close()
+
+			p := newTestPublisher(t, func(c *config.EventStreamConfig) { c.Endpoint = srv.URL })
+			err := p.send(context.Background(), testCloudEvent())
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+			assert.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
+
+// statusRecorder is an agent.EventPublisher that records the status events
+// it receives; other methods are not used by these tests.
+type statusRecorder struct {
+	agent.EventPublisher
+	sessionStatuses []alertsession.Status
+	stageStatuses   []string
+}
+
+func (r *statusRecorder) PublishSessionStatus(_ context.Context, _ string, payload events.SessionStatusPayload) error {
+	r.sessionStatuses = append(r.sessionStatuses, payload.Status)
+	return nil
+}
+
+func (r *statusRecorder) PublishStageStatus(_ context.Context, _ string, payload events.StageStatusPayload) error {
+	r.stageStatuses = append(r.stageStatuses, payload.Status)
+	return nil
+}
+
+func drain(p *Publisher) []item {
+	var items []item
+	for {
+		select {
+		case it := <-p.queue:
+			items = append(items, it)
+		default:
+			return items
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPublisher(t, nil)
+	next := &statusRecorder{}
+	pub := p.Wrap(next)
+
+	for _, status := range []alertsession.Status{
+		alertsession.StatusInProgress,
+		alertsession.StatusCancelling,
+		alertsession.StatusCancelled,
+	} {
+		require.NoError(t, pub.PublishSessionStatus(ctx, "sess-1", events.SessionStatusPayload{Status: status}))
+	}
+	for _, status := range []string{events.StageStatusStarted, events.StageStatusFailed} {
+		require.NoError(t, pub.PublishStageStatus(ctx, "sess-1", events.StageStatusPayload{
+			StageName: "investigation", StageIndex: 1, StageType: "investigation", Status: status,
+		}))
+	}
+
+	// Every event still reaches the wrapped publisher.
+	assert.Len(t, next.sessionStatuses, 3)
+	assert.Len(t, next.stageStatuses, 2)
+
+	items := drain(p)
+	require.Len(t, items, 3)
+	assert.Equal(t, config.StreamEventSessionStarted, items[0].event)
+	assert.Equal(t, config.StreamEventSessionTerminal, items[1].event)
+	assert.Equal(t, "cancelled", items[1].status)
+	assert.Equal(t, config.StreamEventStageCompleted, items[2].event)
+	require.NotNil(t, items[2].stage)
+	assert.Equal(t, "investigation", items[2].stage.StageName)
+	assert.Equal(t, events.StageStatusFailed, items[2].status)
+}
+
+func TestPublisher_Enqueue(t *testing.T) {
+	t.Run("filters unwanted events", func(t *testing.T) {
+		p := newTestPublisher(t, func(c *config.EventStreamConfig) {
+			c.Events = []string{config.StreamEventSessionTerminal}
+		})
+		p.SessionCreated(&ent.AlertSession{ID: "sess-1"})
+		p.sessionStatus("sess-1", alertsession.StatusInProgress)
+		p.sessionStatus("sess-1", alertsession.StatusCompleted)
+
+		items := drain(p)
+		require.Len(t, items, 1)
+		assert.Equal(t, config.StreamEventSessionTerminal, items[0].event)
+		assert.False(t, items[0].at.IsZero())
+	})
+
+	t.Run("drops when the buffer is full", func(t *testing.T) {
+		p := newTestPublisher(t, func(c *config.EventStreamConfig) { c.BufferSize = 1 })
+		p.SessionCreated(&ent.AlertSession{ID: "sess-1"})
+		p.SessionCreated(&ent.AlertSession{ID: "sess-2"})
+
+		items := drain(p)
+		require.Len(t, items, 1)
+		assert.Equal(t, "sess-1", items[0].sessionID)
+	})
+
+	t.Run("drops after stop", func(t *testing.T) {
+		p := newTestPublisher(t, nil)
+		p.Start()
+		p.Stop()
+		p.SessionCreated(&ent.AlertSession{ID: "sess-1"})
+		assert.Empty(t, drain(p))
+	})
+}
+
+func TestNewSessionData(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	started := created.Add(2 * time.Second)
+	completed := started.Add(90 * time.Second)
+	severity := alertsession.SeverityCritical
+	group, key, summary := "grp-1", "alert-1", "Pod OOMKilled"
+	session := &ent.AlertSession{
+		ID:               "sess-1",
+		AlertType:        "PodCrash",
+		ChainID:          "k8s",
+		Status:           alertsession.StatusCompleted,
+		CreatedAt:        created,
+		StartedAt:        &started,
+		CompletedAt:      &completed,
+		GroupID:          &group,
+		AlertKey:         &key,
+		Severity:         &severity,
+		ExecutiveSummary: &summary,
+	}
+
+	d := newSessionData(config.StreamEventSessionCreated, session, "pending", "https://tarsy.example.com")
+	assert.Equal(t, "pending", d.Status)
+	assert.Equal(t, "grp-1", d.GroupID)
+	assert.Equal(t, "alert-1", d.AlertKey)
+	assert.Equal(t, "https://tarsy.example.com/sessions/sess-1", d.SessionURL)
+	assert.Nil(t, d.StartedAt)
+	assert.Empty(t, d.ExecutiveSummary)
+
+	d = newSessionData(config.StreamEventSessionStarted, session, "in_progress", "")
+	assert.Equal(t, "in_progress", d.Status)
+	require.NotNil(t, d.QueueWaitMs)
+	assert.Equal(t, int64(2000), *d.QueueWaitMs)
+	assert.Nil(t, d.DurationMs)
+	assert.Empty(t, d.SessionURL)
+
+	d = newSessionData(config.StreamEventSessionTerminal, session, "completed", "")
+	assert.Nil(t, d.QueueWaitMs)
+	require.NotNil(t, d.DurationMs)
+	assert.Equal(t, int64(90000), *d.DurationMs)
+	assert.Equal(t, string(severity), d.Severity)
+	assert.Equal(t, summary, d.ExecutiveSummary)
+}
@@ -0,0 +1,352 @@
+// Package fanout consolidates the findings of sibling sessions created when
+// an alert fans out to several chains (chain fan_out config).
+package fanout
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+const (
+	// synthesisTimeout bounds one synthesis LLM call.
+	synthesisTimeout = 5 * time.Minute
+
+	// sweepInterval is how often the leader looks for groups whose last
+	// sibling finished without a worker (e.g. cancelled while pending).
+	sweepInterval = time.Minute
+
+	// sweepBatchSize caps the groups synthesized per sweep.
+	sweepBatchSize = 20
+
+	// staleClaimAge is how long a group may stay in_progress before the sweep
+	// reclaims it. Longer than any synthesis job may run, so only claims of a
+	// pod that died mid-synthesis are reclaimed.
+	staleClaimAge = synthesisTimeout + time.Minute
+
+	// maxSiblingChars caps each sibling's findings in the synthesis prompt.
+	maxSiblingChars = 20000
+)
+
+const synthesisSystemPrompt = `You are an SRE lead consolidating parallel investigations of the same alert.
+Each investigation ran a different specialised chain (for example Kubernetes and networking) and reports its own findings.
+
+Write one consolidated summary for the on-call engineer:
+- Start with the most likely root cause, citing which investigation(s) support it.
+- Reconcile the findings: say where they agree, and call out contradictions explicitly.
+- Mention investigations that failed or were cancelled and what that leaves unverified.
+- End with the recommended next actions, most important first.
+
+Be concise. Do not invent evidence that is not in the investigations.`
+
+// nonTerminalStatuses are session statuses that still block synthesis.
+var nonTerminalStatuses = []alertsession.Status{
+	alertsession.StatusPending,
+	alertsession.StatusInProgress,
+	alertsession.StatusCancelling,
+}
+
+// Synthesizer writes the consolidated summary of a session group once all of
+// its sibling sessions are terminal. The worker triggers it as each sibling
+// finishes; a leader-elected sweep catches groups whose last sibling ended
+// without a worker and groups left in_progress by a pod that died. A
+// conditional claim on synthesis_status makes each group synthesize exactly
+// once across pods.
+// Nil-safe: all methods are no-ops when synthesizer is nil.
+type Synthesizer struct {
+	client    *ent.Client
+	cfg       *config.Config
+	sideCalls *controller.SideCaller
+	logger    *slog.Logger
+	jobs      controller.BackgroundJobs
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSynthesizer creates a fan-out synthesizer.
+func NewSynthesizer(client *ent.Client, cfg *config.Config, sideCalls *controller.SideCaller) *Synthesizer {
+	return &Synthesizer{
+		client:    client,
+		cfg:       cfg,
+		sideCalls: sideCalls,
+		logger:    slog.Default().With("component", "fan-out-synthesizer"),
+	}
+}
+
+// SessionFinished is called by the worker after session reached a terminal
+// status. Launches synthesis in the background when the session belongs to a
+// group; the group is only synthesized once every sibling is terminal.
+// A no-op after Drain.
+func (s *Synthesizer) SessionFinished(session *ent.AlertSession) {
+	if s == nil || session == nil || session.GroupID == nil {
+		return
+	}
+	groupID := *session.GroupID
+	s.jobs.Go(synthesisTimeout, func(ctx context.Context) {
+		if err := s.Synthesize(ctx, groupID); err != nil {
+			s.logger.Warn("Fan-out synthesis failed", "group_id", groupID, "error", err)
+		}
+	})
+}
+
+// Drain stops launching synthesis for finished sessions and waits for the
+// running ones to finish. Called on shutdown; Stop only ends the sweep, which
+// runs while this pod is leader.
+func (s *Synthesizer) Drain() {
+	if s == nil {
+		return
+	}
+	s.jobs.Stop()
+}
+
+// Start runs the sweep loop. Implements coordination.Job.
+func (s *Synthesizer) Start(ctx context.Context) {
+	if s == nil || s.cancel != nil {
+		return
+	}
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+	go s.run(ctx)
+}
+
+// Stop signals the sweep loop to exit and waits for it to finish.
+func (s *Synthesizer) Stop() {
+	if s == nil || s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	s.cancel = nil
+}
+
+func (s *Synthesizer) run(ctx context.Context) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+}
+
+// sweep reclaims stale claims and synthesizes pending groups whose siblings
+// are all terminal.
+func (s *Synthesizer) sweep(ctx context.Context) {
+	s.reclaimStale(ctx)
+
+	ids, err := s.client.SessionGroup.Query().
+		Where(
+			sessiongroup.SynthesisStatusEQ(sessiongroup.SynthesisStatusPending),
+			sessiongroup.HasSessions(),
+			sessiongroup.Not(sessiongroup.HasSessionsWith(alertsession.StatusIn(nonTerminalStatuses...))),
+		).
+		Order(ent.Asc(sessiongroup.FieldCreatedAt)).
+		Limit(sweepBatchSize).
+		IDs(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to query groups ready for synthesis", "error", err)
+		return
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.Synthesize(ctx, id); err != nil {
+			s.logger.Warn("Fan-out synthesis failed", "group_id", id, "error", err)
+		}
+	}
+}
+
+// reclaimStale returns groups claimed longer than staleClaimAge ago to
+// pending, so a synthesis interrupted by a pod crash is retried.
+func (s *Synthesizer) reclaimStale(ctx context.Context) {
+	n, err := s.client.SessionGroup.Update().
+		Where(
+			sessiongroup.SynthesisStatusEQ(sessiongroup.SynthesisStatusInProgress),
+			sessiongroup.UpdatedAtLT(time.Now().Add(-staleClaimAge)),
+		).
+		SetSynthesisStatus(sessiongroup.SynthesisStatusPending).
+		Save(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to reclaim stale synthesis claims", "error", err)
+		return
+	}
+	if n > 0 {
+		s.logger.Info("Reclaimed stale synthesis claims", "groups", n)
+	}
+}
+
+// Synthesize consolidates the group's sibling sessions into one summary.
+// Returns nil without doing anything when a sibling is still running or the
+// group was already claimed. The outcome (completed, failed, or skipped when
+// no sibling completed) is stored on the group; the returned error only
+// reports database failures.
+func (s *Synthesizer) Synthesize(ctx context.Context, groupID string) error {
+	if s == nil {
+		return nil
+	}
+
+	sessions, err := s.client.AlertSession.Query().
+		Where(alertsession.GroupIDEQ(groupID)).
+		Order(ent.Asc(alertsession.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load group sessions: %w", err)
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+	for _, sess := range sessions {
+		if !isTerminal(sess.Status) {
+			return nil
+		}
+	}
+
+	// Claim the group; only one caller proceeds
+	claimed, err := s.client.SessionGroup.Update().
+		Where(
+			sessiongroup.IDEQ(groupID),
+			sessiongroup.SynthesisStatusEQ(sessiongroup.SynthesisStatusPending),
+		).
+		SetSynthesisStatus(sessiongroup.SynthesisStatusInProgress).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to claim group: %w", err)
+	}
+	if claimed == 0 {
+		return nil
+	}
+
+	group, err := s.client.SessionGroup.Get(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to load group: %w", err)
+	}
+
+	update := s.client.SessionGroup.UpdateOneID(groupID).SetSynthesizedAt(time.Now())
+	if !anyCompleted(sessions) {
+		update.SetSynthesisStatus(sessiongroup.SynthesisStatusSkipped).
+			SetSummaryError("no sibling session completed")
+	} else if summary, err := s.generate(ctx, group, sessions); err != nil {
+		update.SetSynthesisStatus(sessiongroup.SynthesisStatusFailed).
+			SetSummaryError(err.Error())
+	} else {
+		update.SetSynthesisStatus(sessiongroup.SynthesisStatusCompleted).
+			SetSummary(summary)
+	}
+
+	// The result must be stored even if the caller's context expired
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := update.Exec(writeCtx); err != nil {
+		return fmt.Errorf("failed to store synthesis result: %w", err)
+	}
+
+	s.logger.Info("Fan-out group synthesized",
+		"group_id", groupID, "sessions", len(sessions))
+	return nil
+}
+
+// generate runs the synthesis LLM call for the group on the synthesis
+// provider: fan_out.llm_provider → chain executive_summary_provider → chain
+// llm_provider → defaults. The call is recorded on the owning chain's
+// session, which comes first in the group.
+func (s *Synthesizer) generate(ctx context.Context, group *ent.SessionGroup, sessions []*ent.AlertSession) (string, error) {
+	chain, _ := s.cfg.GetChain(group.PrimaryChainID) // nil chain falls back to defaults
+	var providerOverride string
+	if chain != nil && chain.FanOut != nil {
+		providerOverride = chain.FanOut.LLMProvider
+	}
+	llmCfg, err := agent.ResolveSideCallConfig(s.cfg, chain, providerOverride)
+	if err != nil {
+		return "", fmt.Errorf("synthesis provider: %w", err)
+	}
+
+	systemPrompt := synthesisSystemPrompt
+	if lang := agent.ResolveOutputLanguage(s.cfg.Defaults, chain, derefString(sessions[0].OutputLanguage)); lang != "" {
+		systemPrompt += fmt.Sprintf("\n\nWrite the summary in %s.", lang)
+	}
+
+	summary, err := s.sideCalls.Call(ctx, controller.SideCall{
+		SessionID:       sessions[0].ID,
+		InteractionType: llminteraction.InteractionTypeFanoutSynthesis,
+		Config:          llmCfg,
+		Messages: []agent.ConversationMessage{
+			{Role: agent.RoleSystem, Content: systemPrompt},
+			{Role: agent.RoleUser, Content: buildSynthesisPrompt(group.AlertType, sessions)},
+		},
+		Timeout: synthesisTimeout,
+	})
+	if err != nil {
+		return "", fmt.Errorf("synthesis failed: %w", err)
+	}
+	return summary, nil
+}
+
+// buildSynthesisPrompt renders each sibling's outcome and findings. Prefers
+// the final analysis over the executive summary since it carries the
+// evidence; both are capped at maxSiblingChars.
+func buildSynthesisPrompt(alertType string, sessions []*ent.AlertSession) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Alert type: %s\n", alertType)
+	fmt.Fprintf(&sb, "Parallel investigations: %d\n", len(sessions))
+
+	for i, sess := range sessions {
+		fmt.Fprintf(&sb, "\n## Investigation %d: chain %s (%s)\n\n", i+1, sess.ChainID, sess.Status)
+
+		findings := derefString(sess.FinalAnalysis)
+		if findings == "" {
+			findings = derefString(sess.ExecutiveSummary)
+		}
+		switch {
+		case findings != "":
+			sb.WriteString(controller.TruncatePrompt(findings, maxSiblingChars))
+		case sess.ErrorMessage != nil:
+			fmt.Fprintf(&sb, "No findings. Error: %s", *sess.ErrorMessage)
+		default:
+			sb.WriteString("No findings.")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func isTerminal(status alertsession.Status) bool {
+	for _, s := range nonTerminalStatuses {
+		if status == s {
+			return false
+		}
+	}
+	return true
+}
+
+func anyCompleted(sessions []*ent.AlertSession) bool {
+	for _, sess := range sessions {
+		if sess.Status == alertsession.StatusCompleted {
+			return true
+		}
+	}
+	return false
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
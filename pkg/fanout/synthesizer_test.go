@@ -0,0 +1,232 @@
+package fanout
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/sessiongroup"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestBuildSynthesisPrompt(t *testing.T) {
+	sessions := []*ent.AlertSession{
+		{ChainID: "k8s", Status: alertsession.StatusCompleted, FinalAnalysis: strPtr("Pod OOMKilled"), ExecutiveSummary: strPtr("short")},
+		{ChainID: "net", Status: alertsession.StatusCompleted, ExecutiveSummary: strPtr("DNS timeouts")},
+		{ChainID: "db", Status: alertsession.StatusFailed, ErrorMessage: strPtr("MCP server unreachable")},
+		{ChainID: "cache", Status: alertsession.StatusCancelled},
+		{ChainID: "big", Status: alertsession.StatusCompleted, FinalAnalysis: strPtr(strings.Repeat("x", maxSiblingChars+100))},
+	}
+
+	prompt := buildSynthesisPrompt("Outage", sessions)
+
+	assert.Contains(t, prompt, "Alert type: Outage")
+	assert.Contains(t, prompt, "Parallel investigations: 5")
+	assert.Contains(t, prompt, "## Investigation 1: chain k8s (completed)\n\nPod OOMKilled")
+	assert.NotContains(t, prompt, "short", "final analysis is preferred over the executive summary")
+	assert.Contains(t, prompt, "## Investigation 2: chain net (completed)\n\nDNS timeouts")
+	assert.Contains(t, prompt, "## Investigation 3: chain db (failed)\n\nNo findings. Error: MCP server unreachable")
+	assert.Contains(t, prompt, "## Investigation 4: chain cache (cancelled)\n\nNo findings.")
+	assert.Contains(t, prompt, "[truncated]")
+	assert.Less(t, len(prompt), maxSiblingChars+1000)
+
+	t.Run("does not split multi-byte characters", func(t *testing.T) {
+		prompt := buildSynthesisPrompt("Outage", []*ent.AlertSession{
+			{ChainID: "k8s", Status: alertsession.StatusCompleted, FinalAnalysis: strPtr("x" + strings.Repeat("é", maxSiblingChars))},
+		})
+		assert.True(t, utf8.ValidString(prompt))
+		assert.Contains(t, prompt, "é\n[truncated]")
+	})
+}
+
+func TestSynthesizer_NilSafe(t *testing.T) {
+	var s *Synthesizer
+	s.SessionFinished(&ent.AlertSession{GroupID: strPtr("g")})
+	s.Start(context.Background())
+	s.Stop()
+	s.Drain()
+	assert.NoError(t, s.Synthesize(context.Background(), "g"))
+}
+
+func TestSynthesizer_Synthesize(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	ctx := context.Background()
+
+	cfg := &config.Config{
+		Defaults: &config.Defaults{LLMProvider: "default"},
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"default": {Type: config.LLMProviderTypeGoogle, Model: "default-model"},
+			"synth":   {Type: config.LLMProviderTypeGoogle, Model: "synth-model"},
+		}),
+		ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{
+			"k8s": {
+				AlertTypes: []string{"Outage"},
+				FanOut:     &config.FanOutConfig{Chains: []string{"net"}, LLMProvider: "synth"},
+			},
+			"net": {AlertTypes: []string{"Network"}},
+		}),
+	}
+
+	interactions := services.NewInteractionService(client.Client, nil, nil)
+	// An empty script fails any LLM call
+	noLLM := controller.NewSideCaller(agent.NewScriptedLLMClient(nil), nil)
+
+	newGroup := func(t *testing.T, statuses ...alertsession.Status) string {
+		t.Helper()
+		group, err := client.SessionGroup.Create().
+			SetID(uuid.New().String()).
+			SetAlertType("Outage").
+			SetPrimaryChainID("k8s").
+			Save(ctx)
+		require.NoError(t, err)
+		for i, status := range statuses {
+			create := client.AlertSession.Create().
+				SetID(uuid.New().String()).
+				SetAlertData("checkout is down").
+				SetAgentType("Outage").
+				SetAlertType("Outage").
+				SetChainID([]string{"k8s", "net"}[i%2]).
+				SetStatus(status).
+				SetGroupID(group.ID)
+			if status == alertsession.StatusCompleted {
+				create.SetFinalAnalysis("findings of sibling " + string(rune('A'+i)))
+			}
+			_, err := create.Save(ctx)
+			require.NoError(t, err)
+		}
+		return group.ID
+	}
+
+	t.Run("waits for running siblings", func(t *testing.T) {
+		groupID := newGroup(t, alertsession.StatusCompleted, alertsession.StatusInProgress)
+
+		require.NoError(t, NewSynthesizer(client.Client, cfg, noLLM).Synthesize(ctx, groupID))
+
+		group, err := client.SessionGroup.Get(ctx, groupID)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusPending, group.SynthesisStatus)
+	})
+
+	t.Run("consolidates completed siblings once", func(t *testing.T) {
+		// A second LLM call would exhaust the script and fail the group
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Text: " Root cause: DNS. "}})
+		groupID := newGroup(t, alertsession.StatusCompleted, alertsession.StatusFailed)
+		synth := NewSynthesizer(client.Client, cfg, controller.NewSideCaller(llm, interactions))
+
+		require.NoError(t, synth.Synthesize(ctx, groupID))
+		require.NoError(t, synth.Synthesize(ctx, groupID))
+
+		group, err := client.SessionGroup.Get(ctx, groupID)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusCompleted, group.SynthesisStatus)
+		require.NotNil(t, group.Summary)
+		assert.Equal(t, "Root cause: DNS.", *group.Summary)
+		assert.NotNil(t, group.SynthesizedAt)
+
+		// Recorded once, on the owning chain's session, with the fan_out provider
+		recorded, err := client.LLMInteraction.Query().
+			Where(
+				llminteraction.InteractionTypeEQ(llminteraction.InteractionTypeFanoutSynthesis),
+				llminteraction.HasSessionWith(alertsession.GroupIDEQ(groupID)),
+			).
+			WithSession().
+			All(ctx)
+		require.NoError(t, err)
+		require.Len(t, recorded, 1)
+		assert.Equal(t, "synth-model", recorded[0].ModelName)
+		assert.Equal(t, "k8s", recorded[0].Edges.Session.ChainID)
+	})
+
+	t.Run("records LLM errors", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Error: "quota exceeded"}})
+		groupID := newGroup(t, alertsession.StatusCompleted, alertsession.StatusCompleted)
+
+		require.NoError(t, NewSynthesizer(client.Client, cfg, controller.NewSideCaller(llm, nil)).Synthesize(ctx, groupID))
+
+		group, err := client.SessionGroup.Get(ctx, groupID)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusFailed, group.SynthesisStatus)
+		require.NotNil(t, group.SummaryError)
+		assert.Contains(t, *group.SummaryError, "quota exceeded")
+	})
+
+	t.Run("skips when no sibling completed", func(t *testing.T) {
+		groupID := newGroup(t, alertsession.StatusFailed, alertsession.StatusCancelled)
+
+		require.NoError(t, NewSynthesizer(client.Client, cfg, noLLM).Synthesize(ctx, groupID))
+
+		group, err := client.SessionGroup.Get(ctx, groupID)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusSkipped, group.SynthesisStatus)
+	})
+
+	t.Run("finished sessions are synthesized before Drain returns", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Text: "drained"}})
+		groupID := newGroup(t, alertsession.StatusCompleted, alertsession.StatusCompleted)
+		synth := NewSynthesizer(client.Client, cfg, controller.NewSideCaller(llm, nil))
+
+		synth.SessionFinished(&ent.AlertSession{GroupID: &groupID})
+		synth.Drain()
+		synth.SessionFinished(&ent.AlertSession{GroupID: &groupID}) // no-op after Drain
+
+		group, err := client.SessionGroup.Get(ctx, groupID)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusCompleted, group.SynthesisStatus)
+	})
+
+	t.Run("sweep reclaims stale claims", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Text: "retried"}})
+		stale := newGroup(t, alertsession.StatusCompleted, alertsession.StatusCompleted)
+		fresh := newGroup(t, alertsession.StatusCompleted, alertsession.StatusCompleted)
+		require.NoError(t, client.SessionGroup.UpdateOneID(stale).
+			SetSynthesisStatus(sessiongroup.SynthesisStatusInProgress).
+			SetUpdatedAt(time.Now().Add(-staleClaimAge-time.Minute)).
+			Exec(ctx))
+		require.NoError(t, client.SessionGroup.UpdateOneID(fresh).
+			SetSynthesisStatus(sessiongroup.SynthesisStatusInProgress).
+			Exec(ctx))
+
+		NewSynthesizer(client.Client, cfg, controller.NewSideCaller(llm, nil)).sweep(ctx)
+
+		group, err := client.SessionGroup.Get(ctx, stale)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusCompleted, group.SynthesisStatus)
+		require.NotNil(t, group.Summary)
+		assert.Equal(t, "retried", *group.Summary)
+
+		group, err = client.SessionGroup.Get(ctx, fresh)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusInProgress, group.SynthesisStatus, "a running synthesis is left alone")
+	})
+
+	t.Run("sweep picks up groups ready for synthesis", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Text: "swept"}})
+		ready := newGroup(t, alertsession.StatusCompleted, alertsession.StatusAutoCancelled)
+		running := newGroup(t, alertsession.StatusCompleted, alertsession.StatusPending)
+
+		NewSynthesizer(client.Client, cfg, controller.NewSideCaller(llm, nil)).sweep(ctx)
+
+		group, err := client.SessionGroup.Get(ctx, ready)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusCompleted, group.SynthesisStatus)
+
+		group, err = client.SessionGroup.Get(ctx, running)
+		require.NoError(t, err)
+		assert.Equal(t, sessiongroup.SynthesisStatusPending, group.SynthesisStatus)
+	})
+}
@@ -0,0 +1,150 @@
+// Package faultinject injects simulated MCP and LLM failures for resilience
+// testing in test and staging deployments.
+//
+// The facility exists only when system.fault_injection.enabled is set; rates
+// start from the configuration and can be changed at runtime through the
+// admin API. Runtime changes apply to the serving pod only and are not
+// persisted.
+package faultinject
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sync"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+)
+
+// Fault is a kind of injected failure.
+type Fault string
+
+// Fault kinds.
+const (
+	FaultNone         Fault = ""
+	FaultMCPTimeout   Fault = "mcp_timeout"
+	FaultMCPMalformed Fault = "mcp_malformed"
+	FaultLLMRateLimit Fault = "llm_rate_limit"
+)
+
+// ErrInvalidRates is returned for rates outside 0-1 or unknown MCP servers.
+var ErrInvalidRates = errors.New("invalid fault injection rates")
+
+// Injector decides which calls fail.
+// Nil-safe: a nil Injector never injects.
+type Injector struct {
+	servers *config.MCPServerRegistry
+	logger  *slog.Logger
+	random  func() float64
+
+	mu       sync.RWMutex
+	rates    config.FaultInjectionConfig
+	injected map[Fault]int
+}
+
+// New creates an Injector from the resolved configuration. Returns nil when
+// fault injection is not enabled. servers validates runtime MCP server
+// restrictions (may be nil).
+func New(cfg *config.FaultInjectionConfig, servers *config.MCPServerRegistry) *Injector {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+	return &Injector{
+		servers:  servers,
+		logger:   slog.Default().With("component", "fault-injection"),
+		random:   rand.Float64,
+		rates:    *cfg,
+		injected: map[Fault]int{},
+	}
+}
+
+// Rates returns the rates in force.
+func (i *Injector) Rates() config.FaultInjectionConfig {
+	if i == nil {
+		return config.FaultInjectionConfig{}
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	rates := i.rates
+	rates.MCPServers = slices.Clone(i.rates.MCPServers)
+	return rates
+}
+
+// SetRates validates and applies new rates on this pod. Returns an error
+// wrapping ErrInvalidRates when they are invalid.
+func (i *Injector) SetRates(rates config.FaultInjectionConfig, author string) error {
+	rates.Enabled = true
+	if err := config.ValidateFaultInjection(rates, i.servers); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRates, err)
+	}
+
+	i.mu.Lock()
+	i.rates = rates
+	i.mu.Unlock()
+	i.logger.Warn("Fault injection rates changed",
+		"mcp_timeout_rate", rates.MCPTimeoutRate,
+		"mcp_malformed_rate", rates.MCPMalformedRate,
+		"llm_rate_limit_rate", rates.LLMRateLimitRate,
+		"mcp_servers", rates.MCPServers,
+		"author", author)
+	return nil
+}
+
+// Injected returns how many faults of each kind this pod has injected.
+func (i *Injector) Injected() map[Fault]int {
+	if i == nil {
+		return map[Fault]int{}
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	out := make(map[Fault]int, len(i.injected))
+	for k, v := range i.injected {
+		out[k] = v
+	}
+	return out
+}
+
+// MCPFault returns the fault to inject into a call to serverID's tool, or
+// FaultNone.
+func (i *Injector) MCPFault(serverID, toolName string) Fault {
+	if i == nil {
+		return FaultNone
+	}
+	rates := i.Rates()
+	if len(rates.MCPServers) > 0 && !slices.Contains(rates.MCPServers, serverID) {
+		return FaultNone
+	}
+
+	r := i.random()
+	switch {
+	case r < rates.MCPTimeoutRate:
+		return i.record(FaultMCPTimeout, "server", serverID, "tool", toolName)
+	case r < rates.MCPTimeoutRate+rates.MCPMalformedRate:
+		return i.record(FaultMCPMalformed, "server", serverID, "tool", toolName)
+	}
+	return FaultNone
+}
+
+// LLMFault returns the fault to inject into an LLM call to model, or
+// FaultNone.
+func (i *Injector) LLMFault(model string) Fault {
+	if i == nil {
+		return FaultNone
+	}
+	if i.random() < i.Rates().LLMRateLimitRate {
+		return i.record(FaultLLMRateLimit, "model", model)
+	}
+	return FaultNone
+}
+
+func (i *Injector) record(fault Fault, attrs ...any) Fault {
+	i.mu.Lock()
+	i.injected[fault]++
+	i.mu.Unlock()
+	metrics.FaultInjectionsTotal.WithLabelValues(string(fault)).Inc()
+	i.logger.Warn("Injecting fault", append([]any{"fault", fault}, attrs...)...)
+	return fault
+}
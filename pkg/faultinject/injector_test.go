@@ -0,0 +1,74 @@
+package faultinject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// sequence returns a random source yielding values in order.
+func sequence(values ...float64) func() float64 {
+	return func() float64 {
+		v := values[0]
+		values = values[1:]
+		return v
+	}
+}
+
+func TestNew_DisabledReturnsNil(t *testing.T) {
+	assert.Nil(t, New(nil, nil))
+	assert.Nil(t, New(&config.FaultInjectionConfig{MCPTimeoutRate: 1}, nil))
+}
+
+func TestInjector_NilNeverInjects(t *testing.T) {
+	var i *Injector
+	assert.Equal(t, FaultNone, i.MCPFault("kubernetes", "get_pods"))
+	assert.Equal(t, FaultNone, i.LLMFault("gemini"))
+	assert.Empty(t, i.Injected())
+	assert.Zero(t, i.Rates())
+}
+
+func TestInjector_MCPFault(t *testing.T) {
+	i := New(&config.FaultInjectionConfig{Enabled: true, MCPTimeoutRate: 0.1, MCPMalformedRate: 0.2}, nil)
+	i.random = sequence(0.05, 0.25, 0.5)
+
+	assert.Equal(t, FaultMCPTimeout, i.MCPFault("kubernetes", "get_pods"))
+	assert.Equal(t, FaultMCPMalformed, i.MCPFault("kubernetes", "get_pods"))
+	assert.Equal(t, FaultNone, i.MCPFault("kubernetes", "get_pods"))
+	assert.Equal(t, map[Fault]int{FaultMCPTimeout: 1, FaultMCPMalformed: 1}, i.Injected())
+}
+
+func TestInjector_MCPFaultRestrictedToServers(t *testing.T) {
+	i := New(&config.FaultInjectionConfig{Enabled: true, MCPTimeoutRate: 1, MCPServers: []string{"github"}}, nil)
+	assert.Equal(t, FaultNone, i.MCPFault("kubernetes", "get_pods"))
+	assert.Equal(t, FaultMCPTimeout, i.MCPFault("github", "search"))
+}
+
+func TestInjector_LLMFault(t *testing.T) {
+	i := New(&config.FaultInjectionConfig{Enabled: true, LLMRateLimitRate: 0.5}, nil)
+	i.random = sequence(0.4, 0.6)
+
+	assert.Equal(t, FaultLLMRateLimit, i.LLMFault("gemini"))
+	assert.Equal(t, FaultNone, i.LLMFault("gemini"))
+}
+
+func TestInjector_SetRates(t *testing.T) {
+	servers := config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{"kubernetes": {}})
+	i := New(&config.FaultInjectionConfig{Enabled: true, MCPTimeoutRate: 0.1}, servers)
+
+	require.NoError(t, i.SetRates(config.FaultInjectionConfig{LLMRateLimitRate: 0.3, MCPServers: []string{"kubernetes"}}, "alice"))
+	rates := i.Rates()
+	assert.True(t, rates.Enabled)
+	assert.Zero(t, rates.MCPTimeoutRate, "omitted rates are reset")
+	assert.Equal(t, 0.3, rates.LLMRateLimitRate)
+	assert.Equal(t, []string{"kubernetes"}, rates.MCPServers)
+
+	err := i.SetRates(config.FaultInjectionConfig{MCPTimeoutRate: 1.5}, "alice")
+	assert.ErrorIs(t, err, ErrInvalidRates)
+	err = i.SetRates(config.FaultInjectionConfig{MCPServers: []string{"github"}}, "alice")
+	assert.ErrorIs(t, err, ErrInvalidRates)
+	assert.Equal(t, 0.3, i.Rates().LLMRateLimitRate, "invalid rates are not applied")
+}
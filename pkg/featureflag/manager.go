@@ -0,0 +1,316 @@
+// Package featureflag evaluates feature flags that gate risky behaviors.
+//
+// Each flag's rollout comes from system.feature_flags (built-in defaults
+// applied) unless a runtime override was set through the admin API.
+// Overrides are stored in the database and every replica reloads them
+// periodically, so a change reaches the whole fleet within refreshInterval.
+package featureflag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// refreshInterval is how often each replica reloads runtime overrides.
+const refreshInterval = 15 * time.Second
+
+var (
+	// ErrInvalidRollout is returned for unknown flags and invalid rollouts.
+	ErrInvalidRollout = errors.New("invalid feature flag rollout")
+
+	// ErrUnavailable is returned when overrides cannot be stored.
+	ErrUnavailable = errors.New("feature flag overrides are not available")
+)
+
+// Store persists runtime overrides. Implemented by services.FeatureFlagService.
+type Store interface {
+	List(ctx context.Context) ([]*ent.FeatureFlagOverride, error)
+	Set(ctx context.Context, flag string, rollout config.FeatureFlagConfig, author string) (*ent.FeatureFlagOverride, error)
+	Delete(ctx context.Context, flag string) error
+}
+
+// Target is what a flag is evaluated for.
+type Target struct {
+	// Key buckets the target for percentage rollouts, so the same key always
+	// gets the same answer on every replica (e.g. the session ID). Empty
+	// keys are bucketed randomly per evaluation.
+	Key       string
+	AlertType string
+	ChainID   string
+	Namespace string // target namespace of the alert; empty when untargeted
+}
+
+// Override is a runtime override of one flag's rollout.
+type Override struct {
+	Rollout   config.FeatureFlagConfig
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// Flag is the state of one built-in flag.
+type Flag struct {
+	config.FeatureFlagDefinition
+	Configured config.FeatureFlagConfig // from system.feature_flags or the default
+	Override   *Override                // nil when no runtime override is set
+}
+
+// Effective returns the rollout in force: the override if set, else the
+// configured rollout.
+func (f Flag) Effective() config.FeatureFlagConfig {
+	if f.Override != nil {
+		return f.Override.Rollout
+	}
+	return f.Configured
+}
+
+// Manager evaluates feature flags against the configured rollouts and the
+// runtime overrides cached from the Store.
+// Nil-safe: a nil Manager evaluates every flag at its built-in default.
+type Manager struct {
+	configured map[string]*config.FeatureFlagConfig
+	chains     *config.ChainRegistry
+	store      Store
+	logger     *slog.Logger
+
+	mu        sync.RWMutex
+	overrides map[string]*Override
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewManager creates a feature flag manager. configured is the resolved
+// system.feature_flags; chains validates override chain IDs (may be nil).
+func NewManager(configured map[string]*config.FeatureFlagConfig, chains *config.ChainRegistry, store Store) *Manager {
+	return &Manager{
+		configured: configured,
+		chains:     chains,
+		store:      store,
+		logger:     slog.Default().With("component", "feature-flags"),
+		overrides:  map[string]*Override{},
+	}
+}
+
+// Enabled reports whether flag is on for target. Unknown flags are off.
+func (m *Manager) Enabled(flag string, target Target) bool {
+	def, ok := config.LookupFeatureFlag(flag)
+	if !ok {
+		return false
+	}
+	if m == nil {
+		return def.Default
+	}
+	return evaluate(flag, m.rollout(flag, def), target)
+}
+
+// rollout returns the rollout in force for flag.
+func (m *Manager) rollout(flag string, def config.FeatureFlagDefinition) config.FeatureFlagConfig {
+	m.mu.RLock()
+	override := m.overrides[flag]
+	m.mu.RUnlock()
+	if override != nil {
+		return override.Rollout
+	}
+	if c := m.configured[flag]; c != nil {
+		return *c
+	}
+	return config.FeatureFlagConfig{Enabled: def.Default}
+}
+
+// evaluate applies rollout to target.
+func evaluate(flag string, rollout config.FeatureFlagConfig, target Target) bool {
+	if !rollout.Enabled {
+		return false
+	}
+	if len(rollout.AlertTypes) > 0 && !slices.Contains(rollout.AlertTypes, target.AlertType) {
+		return false
+	}
+	if len(rollout.Chains) > 0 && !slices.Contains(rollout.Chains, target.ChainID) {
+		return false
+	}
+	if len(rollout.Namespaces) > 0 && !slices.Contains(rollout.Namespaces, target.Namespace) {
+		return false
+	}
+	if rollout.Percentage == nil {
+		return true
+	}
+	return bucket(flag, target.Key) < *rollout.Percentage
+}
+
+// bucket maps key to [0, 100), stable per flag so that rollouts of
+// different flags are independent.
+func bucket(flag, key string) int {
+	if key == "" {
+		return rand.IntN(100)
+	}
+	h := fnv.New32a()
+	h.Write([]byte(flag + ":" + key))
+	return int(h.Sum32() % 100)
+}
+
+// Flags returns the state of every built-in flag, sorted by name.
+func (m *Manager) Flags() []Flag {
+	defs := config.FeatureFlagDefinitions()
+	flags := make([]Flag, 0, len(defs))
+	for _, def := range defs {
+		f := Flag{FeatureFlagDefinition: def, Configured: config.FeatureFlagConfig{Enabled: def.Default}}
+		if m != nil {
+			if c := m.configured[def.Name]; c != nil {
+				f.Configured = *c
+			}
+			m.mu.RLock()
+			f.Override = m.overrides[def.Name]
+			m.mu.RUnlock()
+		}
+		flags = append(flags, f)
+	}
+	return flags
+}
+
+// Flag returns the state of one built-in flag.
+func (m *Manager) Flag(name string) (Flag, bool) {
+	for _, f := range m.Flags() {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Flag{}, false
+}
+
+// SetOverride validates and stores a runtime override for flag, applying it
+// on this replica immediately. Returns an error wrapping ErrInvalidRollout
+// when the flag is unknown or the rollout is invalid.
+func (m *Manager) SetOverride(ctx context.Context, flag string, rollout config.FeatureFlagConfig, author string) error {
+	if m == nil || m.store == nil {
+		return ErrUnavailable
+	}
+	if err := config.ValidateFeatureFlag(flag, rollout, m.chains); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRollout, err)
+	}
+	row, err := m.store.Set(ctx, flag, rollout, author)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.overrides[flag] = overrideFromEnt(row)
+	m.mu.Unlock()
+	m.logger.Info("Feature flag override set", "flag", flag, "enabled", rollout.Enabled,
+		"percentage", rollout.Percentage, "alert_types", rollout.AlertTypes, "chains", rollout.Chains,
+		"namespaces", rollout.Namespaces, "author", author)
+	return nil
+}
+
+// ClearOverride removes the runtime override for flag so the configured
+// rollout applies again.
+func (m *Manager) ClearOverride(ctx context.Context, flag, author string) error {
+	if m == nil || m.store == nil {
+		return ErrUnavailable
+	}
+	if _, ok := config.LookupFeatureFlag(flag); !ok {
+		return fmt.Errorf("%w: unknown feature flag %q", ErrInvalidRollout, flag)
+	}
+	if err := m.store.Delete(ctx, flag); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	delete(m.overrides, flag)
+	m.mu.Unlock()
+	m.logger.Info("Feature flag override cleared", "flag", flag, "author", author)
+	return nil
+}
+
+// Refresh reloads runtime overrides from the store. Overrides for flags
+// this binary does not know (e.g. set by a newer replica) are ignored.
+func (m *Manager) Refresh(ctx context.Context) error {
+	if m == nil || m.store == nil {
+		return nil
+	}
+	rows, err := m.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	overrides := make(map[string]*Override, len(rows))
+	for _, row := range rows {
+		if _, ok := config.LookupFeatureFlag(row.ID); !ok {
+			continue
+		}
+		overrides[row.ID] = overrideFromEnt(row)
+	}
+
+	m.mu.Lock()
+	m.overrides = overrides
+	m.mu.Unlock()
+	return nil
+}
+
+// Start loads the overrides and launches the background refresh loop.
+func (m *Manager) Start(ctx context.Context) {
+	if m == nil || m.store == nil || m.cancel != nil {
+		return
+	}
+	if err := m.Refresh(ctx); err != nil {
+		m.logger.Warn("Failed to load feature flag overrides, using configured rollouts", "error", err)
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	go m.run(ctx)
+}
+
+// Stop signals the refresh loop to exit and waits for it to finish.
+func (m *Manager) Stop() {
+	if m == nil || m.cancel == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+	m.cancel = nil
+}
+
+func (m *Manager) run(ctx context.Context) {
+	defer close(m.done)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Keep the last known overrides on failure
+			if err := m.Refresh(ctx); err != nil && ctx.Err() == nil {
+				m.logger.Warn("Failed to refresh feature flag overrides", "error", err)
+			}
+		}
+	}
+}
+
+func overrideFromEnt(row *ent.FeatureFlagOverride) *Override {
+	o := &Override{
+		Rollout: config.FeatureFlagConfig{
+			Enabled:    row.Enabled,
+			Percentage: row.Percentage,
+			AlertTypes: row.AlertTypes,
+			Chains:     row.Chains,
+			Namespaces: row.Namespaces,
+		},
+		UpdatedAt: row.UpdatedAt,
+	}
+	if row.UpdatedBy != nil {
+		o.UpdatedBy = *row.UpdatedBy
+	}
+	return o
+}
@@ -0,0 +1,244 @@
+package featureflag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// fakeStore is an in-memory Store.
+type fakeStore struct {
+	mu      sync.Mutex
+	rows    map[string]*ent.FeatureFlagOverride
+	listErr error
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{rows: map[string]*ent.FeatureFlagOverride{}}
+}
+
+func (f *fakeStore) List(_ context.Context) ([]*ent.FeatureFlagOverride, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	var out []*ent.FeatureFlagOverride
+	for _, r := range f.rows {
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (f *fakeStore) Set(_ context.Context, flag string, rollout config.FeatureFlagConfig, author string) (*ent.FeatureFlagOverride, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	row := &ent.FeatureFlagOverride{
+		ID:         flag,
+		Enabled:    rollout.Enabled,
+		Percentage: rollout.Percentage,
+		AlertTypes: rollout.AlertTypes,
+		Chains:     rollout.Chains,
+		Namespaces: rollout.Namespaces,
+		UpdatedBy:  &author,
+		UpdatedAt:  time.Now(),
+	}
+	f.rows[flag] = row
+	return row, nil
+}
+
+func (f *fakeStore) Delete(_ context.Context, flag string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.rows, flag)
+	return nil
+}
+
+func intPtr(i int) *int { return &i }
+
+func TestEvaluate(t *testing.T) {
+	target := Target{Key: "session-1", AlertType: "Outage", ChainID: "k8s", Namespace: "payments"}
+
+	tests := []struct {
+		name    string
+		rollout config.FeatureFlagConfig
+		target  Target
+		want    bool
+	}{
+		{name: "disabled", rollout: config.FeatureFlagConfig{}, target: target, want: false},
+		{name: "enabled", rollout: config.FeatureFlagConfig{Enabled: true}, target: target, want: true},
+		{
+			name:    "alert type matches",
+			rollout: config.FeatureFlagConfig{Enabled: true, AlertTypes: []string{"Network", "Outage"}},
+			target:  target,
+			want:    true,
+		},
+		{
+			name:    "alert type does not match",
+			rollout: config.FeatureFlagConfig{Enabled: true, AlertTypes: []string{"Network"}},
+			target:  target,
+			want:    false,
+		},
+		{
+			name:    "chain does not match",
+			rollout: config.FeatureFlagConfig{Enabled: true, Chains: []string{"net"}},
+			target:  target,
+			want:    false,
+		},
+		{
+			name:    "namespace matches",
+			rollout: config.FeatureFlagConfig{Enabled: true, Namespaces: []string{"payments", "checkout"}},
+			target:  target,
+			want:    true,
+		},
+		{
+			name:    "namespace does not match",
+			rollout: config.FeatureFlagConfig{Enabled: true, Namespaces: []string{"checkout"}},
+			target:  target,
+			want:    false,
+		},
+		{
+			name:    "untargeted alert does not match namespaces",
+			rollout: config.FeatureFlagConfig{Enabled: true, Namespaces: []string{"payments"}},
+			target:  Target{Key: "session-1", AlertType: "Outage", ChainID: "k8s"},
+			want:    false,
+		},
+		{
+			name:    "zero percent",
+			rollout: config.FeatureFlagConfig{Enabled: true, Percentage: intPtr(0)},
+			target:  target,
+			want:    false,
+		},
+		{
+			name:    "hundred percent",
+			rollout: config.FeatureFlagConfig{Enabled: true, Percentage: intPtr(100)},
+			target:  target,
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, evaluate(config.FeatureFlagFanOut, tt.rollout, tt.target))
+		})
+	}
+}
+
+func TestBucket(t *testing.T) {
+	t.Run("stable per key", func(t *testing.T) {
+		assert.Equal(t, bucket("fan_out", "session-1"), bucket("fan_out", "session-1"))
+	})
+
+	t.Run("percentage rollout reaches roughly that share of keys", func(t *testing.T) {
+		rollout := config.FeatureFlagConfig{Enabled: true, Percentage: intPtr(30)}
+		on := 0
+		for i := range 2000 {
+			if evaluate(config.FeatureFlagFanOut, rollout, Target{Key: fmt.Sprintf("session-%d", i)}) {
+				on++
+			}
+		}
+		assert.InDelta(t, 600, on, 100)
+	})
+}
+
+func TestManager_NilSafe(t *testing.T) {
+	var m *Manager
+	assert.True(t, m.Enabled(config.FeatureFlagFanOut, Target{}), "nil manager uses built-in defaults")
+	assert.False(t, m.Enabled("no_such_flag", Target{}))
+	assert.Len(t, m.Flags(), len(config.FeatureFlagDefinitions()))
+	assert.ErrorIs(t, m.SetOverride(context.Background(), config.FeatureFlagFanOut, config.FeatureFlagConfig{}, "a"), ErrUnavailable)
+	assert.NoError(t, m.Refresh(context.Background()))
+	m.Start(context.Background())
+	m.Stop()
+}
+
+func TestManager_Overrides(t *testing.T) {
+	ctx := context.Background()
+	configured := map[string]*config.FeatureFlagConfig{
+		config.FeatureFlagFanOut:       {Enabled: true, AlertTypes: []string{"Outage"}},
+		config.FeatureFlagModelRouting: {Enabled: true},
+	}
+	chains := config.NewChainRegistry(map[string]*config.ChainConfig{"k8s": {AlertTypes: []string{"Outage"}}})
+	store := newFakeStore()
+	m := NewManager(configured, chains, store)
+
+	outage := Target{Key: "s1", AlertType: "Outage", ChainID: "k8s"}
+	network := Target{Key: "s2", AlertType: "Network", ChainID: "net"}
+
+	assert.True(t, m.Enabled(config.FeatureFlagFanOut, outage))
+	assert.False(t, m.Enabled(config.FeatureFlagFanOut, network))
+
+	t.Run("override replaces configured rollout", func(t *testing.T) {
+		require.NoError(t, m.SetOverride(ctx, config.FeatureFlagFanOut, config.FeatureFlagConfig{Enabled: false}, "alice"))
+		assert.False(t, m.Enabled(config.FeatureFlagFanOut, outage))
+
+		flag, ok := m.Flag(config.FeatureFlagFanOut)
+		require.True(t, ok)
+		require.NotNil(t, flag.Override)
+		assert.Equal(t, "alice", flag.Override.UpdatedBy)
+		assert.True(t, flag.Configured.Enabled)
+		assert.False(t, flag.Effective().Enabled)
+	})
+
+	t.Run("override targets namespaces", func(t *testing.T) {
+		rollout := config.FeatureFlagConfig{Enabled: true, Namespaces: []string{"payments"}}
+		require.NoError(t, m.SetOverride(ctx, config.FeatureFlagFanOut, rollout, "alice"))
+
+		payments := outage
+		payments.Namespace = "payments"
+		assert.True(t, m.Enabled(config.FeatureFlagFanOut, payments))
+		assert.False(t, m.Enabled(config.FeatureFlagFanOut, outage))
+
+		flag, ok := m.Flag(config.FeatureFlagFanOut)
+		require.True(t, ok)
+		assert.Equal(t, []string{"payments"}, flag.Override.Rollout.Namespaces)
+	})
+
+	t.Run("invalid rollouts are rejected", func(t *testing.T) {
+		err := m.SetOverride(ctx, config.FeatureFlagFanOut, config.FeatureFlagConfig{Enabled: true, Percentage: intPtr(101)}, "alice")
+		assert.ErrorIs(t, err, ErrInvalidRollout)
+
+		err = m.SetOverride(ctx, config.FeatureFlagFanOut, config.FeatureFlagConfig{Enabled: true, Chains: []string{"missing"}}, "alice")
+		assert.ErrorIs(t, err, ErrInvalidRollout)
+
+		err = m.SetOverride(ctx, "no_such_flag", config.FeatureFlagConfig{Enabled: true}, "alice")
+		assert.ErrorIs(t, err, ErrInvalidRollout)
+	})
+
+	t.Run("other replicas pick up overrides on refresh", func(t *testing.T) {
+		other := NewManager(configured, chains, store)
+		assert.True(t, other.Enabled(config.FeatureFlagFanOut, outage))
+		require.NoError(t, other.Refresh(ctx))
+		assert.False(t, other.Enabled(config.FeatureFlagFanOut, outage))
+	})
+
+	t.Run("refresh failure keeps last known overrides", func(t *testing.T) {
+		store.listErr = errors.New("db down")
+		defer func() { store.listErr = nil }()
+		assert.Error(t, m.Refresh(ctx))
+		assert.False(t, m.Enabled(config.FeatureFlagFanOut, outage))
+	})
+
+	t.Run("clear restores configured rollout", func(t *testing.T) {
+		require.NoError(t, m.ClearOverride(ctx, config.FeatureFlagFanOut, "alice"))
+		assert.True(t, m.Enabled(config.FeatureFlagFanOut, outage))
+		assert.ErrorIs(t, m.ClearOverride(ctx, "no_such_flag", "alice"), ErrInvalidRollout)
+	})
+
+	t.Run("refresh ignores unknown flags", func(t *testing.T) {
+		_, err := store.Set(ctx, "retired_flag", config.FeatureFlagConfig{Enabled: true}, "bob")
+		require.NoError(t, err)
+		require.NoError(t, m.Refresh(ctx))
+		_, ok := m.Flag("retired_flag")
+		assert.False(t, ok)
+	})
+}
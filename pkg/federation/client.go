@@ -0,0 +1,251 @@
+// Package federation delegates chain stages to other TARSy instances: the
+// stage is submitted as an alert to the remote instance's API, which runs it
+// next to the MCP servers it can reach, and the remote session is polled
+// until it reaches a terminal state.
+package federation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// requestTimeout bounds one API call to a remote instance.
+const requestTimeout = 30 * time.Second
+
+// ErrTimedOut is returned by Wait when the remote session did not finish
+// within the instance's timeout.
+var ErrTimedOut = errors.New("remote stage timed out")
+
+// StageRequest is a stage to run on a remote instance.
+type StageRequest struct {
+	AlertType  string
+	AlertData  string
+	RunbookURL string
+
+	// Origin identifies the delegating session and stage and carries the
+	// prior stage results. SourceURL is filled in by the client.
+	Origin schema.FederationOrigin
+}
+
+// Delegation references a stage running on a remote instance.
+type Delegation struct {
+	Instance    string    `json:"instance"`
+	SessionID   string    `json:"remote_session_id"`
+	SessionURL  string    `json:"remote_session_url"`
+	SubmittedAt time.Time `json:"-"`
+}
+
+// Client talks to the remote instances in system.federation.instances.
+type Client struct {
+	cfg        *config.FederationConfig
+	sourceURL  string
+	tokens     map[string]string
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewClient creates a client for the configured remote instances.
+// sourceURL is this instance's dashboard URL, sent along with delegated
+// stages so remote sessions link back. Returns nil when no instances are
+// configured.
+func NewClient(cfg *config.FederationConfig, sourceURL string) *Client {
+	if cfg == nil || len(cfg.Instances) == 0 {
+		return nil
+	}
+	tokens := make(map[string]string, len(cfg.Instances))
+	for name, inst := range cfg.Instances {
+		if inst.TokenEnv != "" {
+			tokens[name] = os.Getenv(inst.TokenEnv)
+		}
+	}
+	return &Client{
+		cfg:        cfg,
+		sourceURL:  sourceURL,
+		tokens:     tokens,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		logger:     slog.With("component", "federation"),
+	}
+}
+
+// Submit starts the stage on the named instance.
+func (c *Client) Submit(ctx context.Context, instance string, req StageRequest) (*Delegation, error) {
+	inst, err := c.instance(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := req.Origin
+	origin.SourceURL = c.sourceURL
+	body := submitRequest{
+		AlertType:  req.AlertType,
+		Data:       req.AlertData,
+		Runbook:    req.RunbookURL,
+		Federation: &origin,
+	}
+	var resp submitResponse
+	if _, err := c.do(ctx, instance, http.MethodPost, apiURL(inst.URL, "alerts"), body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to submit stage to %s: %w", instance, err)
+	}
+	if resp.SessionID == "" {
+		return nil, fmt.Errorf("failed to submit stage to %s: response has no session_id", instance)
+	}
+	return &Delegation{
+		Instance:    instance,
+		SessionID:   resp.SessionID,
+		SessionURL:  fmt.Sprintf("%s/sessions/%s", strings.TrimRight(inst.DashboardURL, "/"), resp.SessionID),
+		SubmittedAt: time.Now(),
+	}, nil
+}
+
+// Wait polls the remote session until it reaches a terminal state and
+// returns its final status. Transient poll failures (network errors, 408,
+// 429, 5xx) are retried until the instance's timeout, counted from
+// submission, runs out; the error then wraps ErrTimedOut.
+func (c *Client) Wait(ctx context.Context, d *Delegation) (*models.SessionStatusResponse, error) {
+	inst, err := c.instance(d.Instance)
+	if err != nil {
+		return nil, err
+	}
+	waitCtx, cancel := context.WithDeadline(ctx, d.SubmittedAt.Add(inst.Timeout))
+	defer cancel()
+
+	statusURL := apiURL(inst.URL, "sessions", d.SessionID, "status")
+	ticker := time.NewTicker(inst.PollInterval)
+	defer ticker.Stop()
+	for {
+		var status models.SessionStatusResponse
+		retryable, err := c.do(waitCtx, d.Instance, http.MethodGet, statusURL, nil, &status)
+		switch {
+		case err == nil && isTerminal(status.Status):
+			return &status, nil
+		case err != nil && !retryable && waitCtx.Err() == nil:
+			return nil, fmt.Errorf("failed to poll remote session %s on %s: %w", d.SessionID, d.Instance, err)
+		case err != nil:
+			c.logger.Warn("Failed to poll remote session, retrying",
+				"instance", d.Instance, "remote_session_id", d.SessionID, "error", err)
+		}
+
+		select {
+		case <-waitCtx.Done():
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("%w after %s (remote session %s on %s)", ErrTimedOut, inst.Timeout, d.SessionID, d.Instance)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Cancel asks the remote instance to cancel the session.
+func (c *Client) Cancel(ctx context.Context, d *Delegation, reason string) error {
+	inst, err := c.instance(d.Instance)
+	if err != nil {
+		return err
+	}
+	body := map[string]string{"reason": reason}
+	if _, err := c.do(ctx, d.Instance, http.MethodPost, apiURL(inst.URL, "sessions", d.SessionID, "cancel"), body, nil); err != nil {
+		return fmt.Errorf("failed to cancel remote session %s on %s: %w", d.SessionID, d.Instance, err)
+	}
+	return nil
+}
+
+func (c *Client) instance(name string) (*config.FederationInstanceConfig, error) {
+	inst := c.cfg.Instance(name)
+	if inst == nil {
+		return nil, fmt.Errorf("federation instance %q is not configured", name)
+	}
+	return inst, nil
+}
+
+// do sends a JSON request to an instance and decodes the JSON response into
+// out (when non-nil). retryable reports whether the failure is transient.
+func (c *Client) do(ctx context.Context, instance, method, target string, in, out any) (retryable bool, err error) {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return false, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return false, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token := c.tokens[instance]; token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		retryable = resp.StatusCode == http.StatusRequestTimeout ||
+			resp.StatusCode == http.StatusTooManyRequests ||
+			resp.StatusCode >= 500
+		return retryable, fmt.Errorf("instance returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	if out == nil {
+		return false, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return true, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return false, nil
+}
+
+// submitRequest mirrors the fields of POST /api/v1/alerts used for
+// delegated stages.
+type submitRequest struct {
+	AlertType  string                   `json:"alert_type,omitempty"`
+	Data       string                   `json:"data"`
+	Runbook    string                   `json:"runbook,omitempty"`
+	Federation *schema.FederationOrigin `json:"federation"`
+}
+
+type submitResponse struct {
+	SessionID string `json:"session_id"`
+}
+
+// apiURL joins the /api/v1 path segments onto an instance's base URL.
+func apiURL(base string, segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		escaped[i] = url.PathEscape(s)
+	}
+	return strings.TrimRight(base, "/") + "/api/v1/" + strings.Join(escaped, "/")
+}
+
+// isTerminal reports whether a remote session status is final.
+func isTerminal(status string) bool {
+	switch alertsession.Status(status) {
+	case alertsession.StatusCompleted, alertsession.StatusFailed, alertsession.StatusCancelled,
+		alertsession.StatusTimedOut, alertsession.StatusAutoCancelled:
+		return true
+	}
+	return false
+}
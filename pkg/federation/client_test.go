@@ -0,0 +1,125 @@
+package federation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func newTestClient(t *testing.T, handler http.Handler, timeout time.Duration) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	t.Setenv("TEST_FEDERATION_TOKEN", "s3cret")
+	return NewClient(&config.FederationConfig{
+		Instances: map[string]*config.FederationInstanceConfig{
+			"east": {
+				URL:          srv.URL,
+				DashboardURL: "https://tarsy.east.example.com/",
+				TokenEnv:     "TEST_FEDERATION_TOKEN",
+				Timeout:      timeout,
+				PollInterval: 10 * time.Millisecond,
+			},
+		},
+	}, "https://tarsy.example.com")
+}
+
+func TestNewClient_NoInstances(t *testing.T) {
+	assert.Nil(t, NewClient(nil, ""))
+	assert.Nil(t, NewClient(&config.FederationConfig{AcceptDelegations: true}, ""))
+}
+
+func TestClient_SubmitAndWait(t *testing.T) {
+	var polls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/alerts", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer s3cret", r.Header.Get("Authorization"))
+		var body submitRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "pod-crash", body.AlertType)
+		assert.Equal(t, "alert payload", body.Data)
+		require.NotNil(t, body.Federation)
+		assert.Equal(t, schema.FederationOrigin{
+			SourceURL: "https://tarsy.example.com",
+			SessionID: "local-1",
+			StageName: "cluster-investigation",
+			Context:   "prior results",
+		}, *body.Federation)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"session_id":"remote-1","status":"queued"}`))
+	})
+	mux.HandleFunc("GET /api/v1/sessions/remote-1/status", func(w http.ResponseWriter, _ *http.Request) {
+		switch polls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			_, _ = w.Write([]byte(`{"id":"remote-1","status":"in_progress"}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":"remote-1","status":"completed","final_analysis":"Node disk is full."}`))
+		}
+	})
+	client := newTestClient(t, mux, time.Minute)
+
+	d, err := client.Submit(t.Context(), "east", StageRequest{
+		AlertType: "pod-crash",
+		AlertData: "alert payload",
+		Origin: schema.FederationOrigin{
+			SessionID: "local-1",
+			StageName: "cluster-investigation",
+			Context:   "prior results",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "remote-1", d.SessionID)
+	assert.Equal(t, "https://tarsy.east.example.com/sessions/remote-1", d.SessionURL)
+
+	status, err := client.Wait(t.Context(), d)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", status.Status)
+	require.NotNil(t, status.FinalAnalysis)
+	assert.Equal(t, "Node disk is full.", *status.FinalAnalysis)
+	assert.Equal(t, int32(3), polls.Load())
+}
+
+func TestClient_Errors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v1/alerts", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"stage delegation is not enabled"}`, http.StatusBadRequest)
+	})
+	mux.HandleFunc("GET /api/v1/sessions/missing/status", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"message":"session not found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("GET /api/v1/sessions/slow/status", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"slow","status":"in_progress"}`))
+	})
+	client := newTestClient(t, mux, 50*time.Millisecond)
+
+	t.Run("unknown instance", func(t *testing.T) {
+		_, err := client.Submit(t.Context(), "west", StageRequest{})
+		assert.ErrorContains(t, err, `federation instance "west" is not configured`)
+	})
+
+	t.Run("rejected submission", func(t *testing.T) {
+		_, err := client.Submit(t.Context(), "east", StageRequest{})
+		assert.ErrorContains(t, err, "instance returned 400")
+	})
+
+	t.Run("permanent poll failure", func(t *testing.T) {
+		_, err := client.Wait(t.Context(), &Delegation{Instance: "east", SessionID: "missing", SubmittedAt: time.Now()})
+		assert.ErrorContains(t, err, "instance returned 404")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		_, err := client.Wait(t.Context(), &Delegation{Instance: "east", SessionID: "slow", SubmittedAt: time.Now()})
+		assert.ErrorIs(t, err, ErrTimedOut)
+	})
+}
@@ -0,0 +1,224 @@
+// Package followup turns the remediation recommendations in a completed
+// session's final analysis into follow-up action items that teams can
+// track until the recommended fixes are done.
+package followup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/google/uuid"
+)
+
+const (
+	// extractionTimeout bounds one extraction LLM call.
+	extractionTimeout = time.Minute
+
+	// maxAnalysisChars caps the final analysis in the extraction prompt.
+	maxAnalysisChars = 15000
+
+	// maxTitleChars caps an extracted item's title; longer titles are cut.
+	maxTitleChars = 200
+)
+
+const extractionSystemPrompt = `You extract follow-up action items from the conclusion of an automated SRE investigation of an alert.
+An action item is a concrete remediation or prevention step the investigation recommends that a person or team still has to carry out
+(e.g. "Raise the memory limit of the payments deployment", "Add an alert on certificate expiry").
+Do not include steps the investigation already performed, general observations, or further investigation of the same alert.
+Return an empty list when the conclusion recommends nothing.
+
+Respond with a single JSON object and nothing else:
+{"action_items": [{"title": "<imperative one-line summary>", "details": "<why and how, one or two sentences>"}]}`
+
+// Item is one extracted action item.
+type Item struct {
+	Title   string `json:"title"`
+	Details string `json:"details"`
+}
+
+// Extractor creates action items for each completed session from the
+// recommendations in its final analysis. The worker triggers it as each
+// session completes; sessions that already have extracted items are left
+// unchanged. Nil-safe: all methods are no-ops when extractor is nil.
+type Extractor struct {
+	client    *ent.Client
+	cfg       *config.Config
+	sideCalls *controller.SideCaller
+	logger    *slog.Logger
+
+	jobs controller.BackgroundJobs
+}
+
+// NewExtractor creates an action item extractor. Returns nil when
+// extraction is disabled.
+func NewExtractor(client *ent.Client, cfg *config.Config, sideCalls *controller.SideCaller) *Extractor {
+	if cfg.ActionItems == nil || !cfg.ActionItems.Enabled {
+		return nil
+	}
+	return &Extractor{
+		client:    client,
+		cfg:       cfg,
+		sideCalls: sideCalls,
+		logger:    slog.Default().With("component", "action-item-extractor"),
+	}
+}
+
+// SessionCompleted is called by the worker after session completed.
+// Launches the extraction in the background; a no-op after Stop.
+func (e *Extractor) SessionCompleted(session *ent.AlertSession) {
+	if e == nil || session == nil {
+		return
+	}
+	sessionID := session.ID
+	e.jobs.Go(extractionTimeout, func(ctx context.Context) {
+		if err := e.Extract(ctx, sessionID); err != nil {
+			e.logger.Warn("Action item extraction failed", "session_id", sessionID, "error", err)
+		}
+	})
+}
+
+// Stop stops launching extractions and waits for the running ones to
+// finish.
+func (e *Extractor) Stop() {
+	if e == nil {
+		return
+	}
+	e.jobs.Stop()
+}
+
+// Extract stores the session's action items. Returns nil without doing
+// anything when the session is not completed, has no final analysis, was
+// closed by noise triage, or already has extracted items.
+func (e *Extractor) Extract(ctx context.Context, sessionID string) error {
+	if e == nil {
+		return nil
+	}
+
+	session, err := e.client.AlertSession.Get(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	if session.Status != alertsession.StatusCompleted ||
+		session.FinalAnalysis == nil || *session.FinalAnalysis == "" {
+		return nil
+	}
+	if nt := session.NoiseTriage; nt != nil && nt.Skipped {
+		return nil
+	}
+	exists, err := e.client.ActionItem.Query().
+		Where(actionitem.SessionIDEQ(sessionID), actionitem.SourceEQ(actionitem.SourceExtracted)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check existing action items: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	items, err := e.generate(ctx, session)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	// The items must be stored even if the caller's context expired
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	builders := make([]*ent.ActionItemCreate, 0, len(items))
+	for _, item := range items {
+		create := e.client.ActionItem.Create().
+			SetID(uuid.New().String()).
+			SetSessionID(sessionID).
+			SetTitle(item.Title).
+			SetSource(actionitem.SourceExtracted)
+		if item.Details != "" {
+			create.SetDetails(item.Details)
+		}
+		builders = append(builders, create)
+	}
+	if _, err := e.client.ActionItem.CreateBulk(builders...).Save(writeCtx); err != nil {
+		return fmt.Errorf("failed to store action items: %w", err)
+	}
+
+	e.logger.Info("Action items extracted", "session_id", sessionID, "count", len(items))
+	return nil
+}
+
+// generate runs the extraction LLM call on the extractor provider:
+// action_items.llm_provider → chain executive_summary_provider →
+// chain llm_provider → defaults.
+func (e *Extractor) generate(ctx context.Context, session *ent.AlertSession) ([]Item, error) {
+	chain, _ := e.cfg.GetChain(session.ChainID) // nil chain falls back to defaults
+	llmCfg, err := agent.ResolveSideCallConfig(e.cfg, chain, e.cfg.ActionItems.LLMProvider)
+	if err != nil {
+		return nil, fmt.Errorf("extractor provider: %w", err)
+	}
+
+	text, err := e.sideCalls.Call(ctx, controller.SideCall{
+		SessionID:       session.ID,
+		InteractionType: llminteraction.InteractionTypeActionItemExtraction,
+		Config:          llmCfg,
+		Messages: []agent.ConversationMessage{
+			{Role: agent.RoleSystem, Content: extractionSystemPrompt},
+			{Role: agent.RoleUser, Content: buildExtractionPrompt(session)},
+		},
+		Timeout: extractionTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("extraction failed: %w", err)
+	}
+	return parseItems(text, e.cfg.ActionItems.MaxItems)
+}
+
+// buildExtractionPrompt renders the alert type and the final analysis,
+// capped at maxAnalysisChars.
+func buildExtractionPrompt(session *ent.AlertSession) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Alert type: %s\n", session.AlertType)
+	sb.WriteString("\n## Investigation conclusion\n\n")
+	sb.WriteString(controller.TruncatePrompt(*session.FinalAnalysis, maxAnalysisChars))
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// parseItems extracts the action items JSON object from the LLM output,
+// tolerating markdown fences and surrounding prose. Items without a title
+// and duplicate titles are dropped, and at most maxItems are kept.
+func parseItems(raw string, maxItems int) ([]Item, error) {
+	var parsed struct {
+		ActionItems []Item `json:"action_items"`
+	}
+	if err := controller.ParseJSONObject(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM action items: %w", err)
+	}
+
+	seen := make(map[string]bool, len(parsed.ActionItems))
+	var items []Item
+	for _, item := range parsed.ActionItems {
+		title := strings.Join(strings.Fields(item.Title), " ")
+		if title == "" || seen[strings.ToLower(title)] {
+			continue
+		}
+		seen[strings.ToLower(title)] = true
+		if r := []rune(title); len(r) > maxTitleChars {
+			title = string(r[:maxTitleChars-1]) + "…"
+		}
+		items = append(items, Item{Title: title, Details: strings.TrimSpace(item.Details)})
+		if maxItems > 0 && len(items) == maxItems {
+			break
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,135 @@
+package followup
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestNewExtractor_Disabled(t *testing.T) {
+	assert.Nil(t, NewExtractor(nil, &config.Config{ActionItems: config.DefaultActionItemsConfig()}, nil))
+	assert.Nil(t, NewExtractor(nil, &config.Config{}, nil))
+	enabled := config.DefaultActionItemsConfig()
+	enabled.Enabled = true
+	assert.NotNil(t, NewExtractor(nil, &config.Config{ActionItems: enabled}, nil))
+
+	// Nil extractor is a no-op
+	var e *Extractor
+	e.SessionCompleted(&ent.AlertSession{ID: "s1"})
+	assert.NoError(t, e.Extract(context.Background(), "s1"))
+	e.Stop()
+}
+
+func TestExtractor_Stop(t *testing.T) {
+	enabled := config.DefaultActionItemsConfig()
+	enabled.Enabled = true
+	e := NewExtractor(nil, &config.Config{ActionItems: enabled}, nil)
+	e.Stop()
+
+	// An extraction launched after Stop would dereference the nil client
+	e.SessionCompleted(&ent.AlertSession{ID: "s1"})
+	e.Stop()
+}
+
+func TestParseItems(t *testing.T) {
+	t.Run("fenced JSON with prose", func(t *testing.T) {
+		raw := "Here you go:\n```json\n" +
+			`{"action_items":[{"title":"  Raise the   memory limit ","details":" OOM at 512Mi. "},{"title":"Add an alert on cert expiry"}]}` +
+			"\n```"
+		items, err := parseItems(raw, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []Item{
+			{Title: "Raise the memory limit", Details: "OOM at 512Mi."},
+			{Title: "Add an alert on cert expiry"},
+		}, items)
+	})
+
+	t.Run("drops empty and duplicate titles and caps the count", func(t *testing.T) {
+		raw := `{"action_items":[{"title":""},{"title":"Fix A"},{"title":"fix a"},{"title":"Fix B"},{"title":"Fix C"}]}`
+		items, err := parseItems(raw, 2)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, "Fix A", items[0].Title)
+		assert.Equal(t, "Fix B", items[1].Title)
+	})
+
+	t.Run("truncates long titles", func(t *testing.T) {
+		raw := `{"action_items":[{"title":"` + strings.Repeat("x", maxTitleChars+50) + `"}]}`
+		items, err := parseItems(raw, 10)
+		require.NoError(t, err)
+		assert.Len(t, []rune(items[0].Title), maxTitleChars)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		items, err := parseItems(`{"action_items":[]}`, 10)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+
+	t.Run("no JSON", func(t *testing.T) {
+		_, err := parseItems("Nothing to do.", 10)
+		assert.ErrorContains(t, err, "no JSON object")
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	actionItems := config.DefaultActionItemsConfig()
+	actionItems.Enabled = true
+	cfg := &config.Config{
+		Defaults:    &config.Defaults{LLMProvider: "default"},
+		ActionItems: actionItems,
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"default": {Type: config.LLMProviderTypeGoogle, Model: "test-model"},
+		}),
+		ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{}),
+	}
+	session := &ent.AlertSession{ChainID: "k8s", AlertType: "kubernetes", FinalAnalysis: strPtr("Raise the memory limit.")}
+
+	t.Run("extracts with the LLM", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{
+			{Text: `{"action_items":[{"title":"Raise the memory limit"}]}`},
+		})
+		e := &Extractor{cfg: cfg, sideCalls: controller.NewSideCaller(llm, nil)}
+		items, err := e.generate(context.Background(), session)
+		require.NoError(t, err)
+		assert.Equal(t, []Item{{Title: "Raise the memory limit"}}, items)
+	})
+
+	t.Run("LLM error", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Error: "quota exceeded"}})
+		e := &Extractor{cfg: cfg, sideCalls: controller.NewSideCaller(llm, nil)}
+		_, err := e.generate(context.Background(), session)
+		assert.ErrorContains(t, err, "quota exceeded")
+	})
+}
+
+func TestBuildExtractionPrompt(t *testing.T) {
+	session := &ent.AlertSession{
+		AlertType:     "kubernetes",
+		FinalAnalysis: strPtr(strings.Repeat("x", maxAnalysisChars+10)),
+	}
+	prompt := buildExtractionPrompt(session)
+	assert.Contains(t, prompt, "Alert type: kubernetes")
+	assert.Contains(t, prompt, "[truncated]")
+
+	t.Run("does not split multi-byte characters", func(t *testing.T) {
+		session := &ent.AlertSession{
+			AlertType:     "kubernetes",
+			FinalAnalysis: strPtr("x" + strings.Repeat("é", maxAnalysisChars)),
+		}
+		prompt := buildExtractionPrompt(session)
+		assert.True(t, utf8.ValidString(prompt))
+		assert.Contains(t, prompt, "é\n[truncated]")
+	})
+}
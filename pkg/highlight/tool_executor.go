@@ -0,0 +1,114 @@
+// Package highlight provides the mark_key_finding built-in tool, which lets
+// an agent flag a conclusion so its timeline event shows up in the
+// dashboard's highlights-only view.
+package highlight
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/builtintools"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+)
+
+// Compile-time check that ToolExecutor implements agent.ToolExecutor.
+var _ agent.ToolExecutor = (*ToolExecutor)(nil)
+
+// ToolMarkKeyFinding is the wire name from pkg/builtintools.
+const ToolMarkKeyFinding = builtintools.MarkKeyFinding
+
+// MaxFindingLength caps the recorded finding, which is stored as the tool
+// call's timeline content.
+const MaxFindingLength = 2000
+
+// markKeyFindingTool is the tool definition exposed to the LLM.
+var markKeyFindingTool = agent.ToolDefinition{
+	Name:        ToolMarkKeyFinding,
+	Description: "Record a key finding of this investigation — a confirmed root cause, an important piece of evidence, or a conclusion operators should not miss. Findings are highlighted in the session timeline. Use sparingly, for conclusions rather than routine observations.",
+	ParametersSchema: `{
+  "type": "object",
+  "properties": {
+    "finding": {
+      "type": "string",
+      "description": "The finding in one or two sentences"
+    }
+  },
+  "required": ["finding"]
+}`,
+}
+
+// ToolExecutor wraps an inner agent.ToolExecutor and handles
+// mark_key_finding calls. Everything else passes through.
+type ToolExecutor struct {
+	inner agent.ToolExecutor
+}
+
+// NewToolExecutor creates a key finding tool executor around inner.
+func NewToolExecutor(inner agent.ToolExecutor) *ToolExecutor {
+	return &ToolExecutor{inner: inner}
+}
+
+// ListTools returns mark_key_finding followed by the inner tools.
+func (te *ToolExecutor) ListTools(ctx context.Context) ([]agent.ToolDefinition, error) {
+	innerTools, err := te.inner.ListTools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inner tools: %w", err)
+	}
+	tools := make([]agent.ToolDefinition, 0, len(innerTools)+1)
+	tools = append(tools, markKeyFindingTool)
+	for _, t := range innerTools {
+		if t.Name != ToolMarkKeyFinding {
+			tools = append(tools, t)
+		}
+	}
+	return tools, nil
+}
+
+// Execute acknowledges mark_key_finding calls and delegates the rest. The
+// finding becomes the tool call's timeline content; the controller applies
+// the returned highlight to that event.
+func (te *ToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+	if mcp.NormalizeBuiltinPlainToolName(call.Name) != ToolMarkKeyFinding {
+		return te.inner.Execute(ctx, call)
+	}
+
+	var args struct {
+		Finding string `json:"finding"`
+	}
+	if err := json.Unmarshal([]byte(call.Arguments), &args); err != nil {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: fmt.Sprintf("invalid arguments: %v", err),
+			IsError: true,
+		}, nil
+	}
+	finding := strings.TrimSpace(args.Finding)
+	if finding == "" {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: "'finding' is required and must be non-empty",
+			IsError: true,
+		}, nil
+	}
+	if len([]rune(finding)) > MaxFindingLength {
+		finding = string([]rune(finding)[:MaxFindingLength]) + "…"
+	}
+
+	return &agent.ToolResult{
+		CallID:    call.ID,
+		Name:      call.Name,
+		Content:   "Key finding recorded: " + finding,
+		Highlight: string(timelineevent.HighlightKeyFinding),
+	}, nil
+}
+
+// Close delegates to the inner executor.
+func (te *ToolExecutor) Close() error {
+	return te.inner.Close()
+}
@@ -0,0 +1,81 @@
+package highlight
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestExecutor() *ToolExecutor {
+	return NewToolExecutor(agent.NewStubToolExecutor([]agent.ToolDefinition{
+		{Name: "kubernetes-server.get_pods"},
+	}))
+}
+
+func TestToolExecutor_ListTools(t *testing.T) {
+	tools, err := newTestExecutor().ListTools(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tools, 2)
+	assert.Equal(t, ToolMarkKeyFinding, tools[0].Name)
+	assert.Equal(t, "kubernetes-server.get_pods", tools[1].Name)
+}
+
+func TestToolExecutor_Execute(t *testing.T) {
+	te := newTestExecutor()
+	ctx := context.Background()
+
+	t.Run("records finding", func(t *testing.T) {
+		result, err := te.Execute(ctx, agent.ToolCall{
+			ID:        "call-1",
+			Name:      ToolMarkKeyFinding,
+			Arguments: `{"finding":"  Pod OOMKilled due to 128Mi memory limit  "}`,
+		})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Equal(t, "call-1", result.CallID)
+		assert.Equal(t, "Key finding recorded: Pod OOMKilled due to 128Mi memory limit", result.Content)
+		assert.Equal(t, "key_finding", result.Highlight)
+	})
+
+	t.Run("accepts provider-prefixed name", func(t *testing.T) {
+		result, err := te.Execute(ctx, agent.ToolCall{
+			Name:      "google:" + ToolMarkKeyFinding,
+			Arguments: `{"finding":"disk full"}`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "key_finding", result.Highlight)
+	})
+
+	t.Run("truncates long finding", func(t *testing.T) {
+		result, err := te.Execute(ctx, agent.ToolCall{
+			Name:      ToolMarkKeyFinding,
+			Arguments: `{"finding":"` + strings.Repeat("x", MaxFindingLength+10) + `"}`,
+		})
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(result.Content, "…"))
+		assert.Equal(t, MaxFindingLength+1, len([]rune(strings.TrimPrefix(result.Content, "Key finding recorded: "))))
+	})
+
+	t.Run("rejects empty finding", func(t *testing.T) {
+		result, err := te.Execute(ctx, agent.ToolCall{Name: ToolMarkKeyFinding, Arguments: `{"finding":"  "}`})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Empty(t, result.Highlight)
+	})
+
+	t.Run("rejects invalid arguments", func(t *testing.T) {
+		result, err := te.Execute(ctx, agent.ToolCall{Name: ToolMarkKeyFinding, Arguments: `not json`})
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+	})
+
+	t.Run("delegates other tools", func(t *testing.T) {
+		result, err := te.Execute(ctx, agent.ToolCall{Name: "kubernetes-server.get_pods", Arguments: `{}`})
+		require.NoError(t, err)
+		assert.Empty(t, result.Highlight)
+	})
+}
@@ -0,0 +1,102 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+)
+
+// modulePrefix is the import path prefix of TARSy packages.
+const modulePrefix = "github.com/codeready-toolchain/tarsy/pkg/"
+
+// requestIDKey is the attribute key for the correlation ID.
+const requestIDKey = "request_id"
+
+// Handler filters records by the level of the subsystem that logged them,
+// then passes them to an inner handler. The inner handler's own level
+// should be the table itself (or lower) so it does not drop records first.
+//
+// Records logged with a context carrying a request ID (slog.InfoContext etc.)
+// get a request_id attribute unless the logger already has one.
+type Handler struct {
+	inner        slog.Handler
+	levels       *LevelTable
+	hasRequestID bool // request_id already attached via WithAttrs
+}
+
+// NewHandler wraps inner with per-subsystem level filtering from levels.
+func NewHandler(inner slog.Handler, levels *LevelTable) *Handler {
+	return &Handler{inner: inner, levels: levels}
+}
+
+// Enabled reports whether any subsystem logs at level. The per-subsystem
+// decision is made in Handle, once the caller is known.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.levels.Level() && h.inner.Enabled(ctx, level)
+}
+
+// Handle drops r when it is below its subsystem's level.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level < h.levels.For(subsystemForPC(r.PC)) {
+		return nil
+	}
+	if !h.hasRequestID {
+		if id := requestid.FromContext(ctx); id != "" {
+			r = r.Clone()
+			r.AddAttrs(slog.String(requestIDKey, id))
+		}
+	}
+	return h.inner.Handle(ctx, r)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	has := h.hasRequestID
+	for _, a := range attrs {
+		has = has || a.Key == requestIDKey
+	}
+	return &Handler{inner: h.inner.WithAttrs(attrs), levels: h.levels, hasRequestID: has}
+}
+
+// WithGroup implements slog.Handler.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{inner: h.inner.WithGroup(name), levels: h.levels, hasRequestID: h.hasRequestID}
+}
+
+// pcSubsystems caches program counter → subsystem lookups.
+var pcSubsystems sync.Map
+
+// subsystemForPC returns the subsystem of the function containing pc.
+func subsystemForPC(pc uintptr) Subsystem {
+	if pc == 0 {
+		return subsystemDefault
+	}
+	if v, ok := pcSubsystems.Load(pc); ok {
+		return v.(Subsystem)
+	}
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	sub := subsystemForFunc(frame.Function)
+	pcSubsystems.Store(pc, sub)
+	return sub
+}
+
+// subsystemForFunc maps a fully qualified function name such as
+// "github.com/codeready-toolchain/tarsy/pkg/agent/controller.(*X).Run"
+// to its subsystem by the first path element under pkg/.
+func subsystemForFunc(fn string) Subsystem {
+	rest, ok := strings.CutPrefix(fn, modulePrefix)
+	if !ok {
+		return subsystemDefault
+	}
+	if i := strings.IndexAny(rest, "/."); i >= 0 {
+		rest = rest[:i]
+	}
+	if sub := Subsystem(rest); sub.IsValid() {
+		return sub
+	}
+	return subsystemDefault
+}
@@ -0,0 +1,108 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+)
+
+func TestSubsystemForFunc(t *testing.T) {
+	tests := []struct {
+		fn   string
+		want Subsystem
+	}{
+		{"github.com/codeready-toolchain/tarsy/pkg/queue.(*Worker).run", SubsystemQueue},
+		{"github.com/codeready-toolchain/tarsy/pkg/queue.NewWorker", SubsystemQueue},
+		{"github.com/codeready-toolchain/tarsy/pkg/mcp.(*ToolExecutor).Execute", SubsystemMCP},
+		{"github.com/codeready-toolchain/tarsy/pkg/events.(*NotifyListener).Start.func1", SubsystemEvents},
+		{"github.com/codeready-toolchain/tarsy/pkg/agent.ResolveAgentConfig", SubsystemAgent},
+		{"github.com/codeready-toolchain/tarsy/pkg/agent/controller.(*IteratingController).Run", SubsystemAgent},
+		{"github.com/codeready-toolchain/tarsy/pkg/services.(*AlertService).SubmitAlert", subsystemDefault},
+		{"github.com/codeready-toolchain/tarsy/pkg/queueing.Foo", subsystemDefault},
+		{"main.main", subsystemDefault},
+		{"", subsystemDefault},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fn, func(t *testing.T) {
+			assert.Equal(t, tt.want, subsystemForFunc(tt.fn))
+		})
+	}
+}
+
+func TestSubsystemForPC(t *testing.T) {
+	assert.Equal(t, subsystemDefault, subsystemForPC(0))
+
+	// A function in this package is outside every named subsystem.
+	var buf bytes.Buffer
+	levels := NewLevelTable(slog.LevelInfo)
+	logger := slog.New(NewHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: levels}), levels))
+	logger.Info("hello")
+	assert.Contains(t, buf.String(), "msg=hello")
+}
+
+func TestHandler_Filtering(t *testing.T) {
+	var buf bytes.Buffer
+	levels := NewLevelTable(slog.LevelWarn)
+	h := NewHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: levels}), levels)
+	ctx := context.Background()
+
+	assert.False(t, h.Enabled(ctx, slog.LevelDebug))
+	assert.True(t, h.Enabled(ctx, slog.LevelWarn))
+
+	// An agent override lowers the handler-wide threshold...
+	levels.Set(SubsystemAgent, slog.LevelDebug)
+	assert.True(t, h.Enabled(ctx, slog.LevelDebug))
+
+	// ...but records from other subsystems still use the base level.
+	require.NoError(t, h.Handle(ctx, slog.NewRecord(time.Now(), slog.LevelDebug, "dropped", 0)))
+	require.NoError(t, h.Handle(ctx, slog.NewRecord(time.Now(), slog.LevelWarn, "kept", 0)))
+	out := buf.String()
+	assert.NotContains(t, out, "dropped")
+	assert.Contains(t, out, "kept")
+
+	levels.Reset(SubsystemAgent)
+	assert.False(t, h.Enabled(ctx, slog.LevelDebug))
+}
+
+func TestHandler_WithAttrsKeepsLevels(t *testing.T) {
+	var buf bytes.Buffer
+	levels := NewLevelTable(slog.LevelInfo)
+	logger := slog.New(NewHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: levels}), levels)).
+		With("component", "test").WithGroup("g")
+
+	logger.Debug("hidden")
+	levels.SetBase(slog.LevelDebug)
+	logger.Debug("shown", "k", "v")
+
+	out := buf.String()
+	assert.NotContains(t, out, "hidden")
+	assert.True(t, strings.Contains(out, "component=test") && strings.Contains(out, "g.k=v"), out)
+}
+
+func TestHandler_RequestID(t *testing.T) {
+	var buf bytes.Buffer
+	levels := NewLevelTable(slog.LevelInfo)
+	logger := slog.New(NewHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: levels}), levels))
+	ctx := requestid.WithContext(context.Background(), "req-1")
+
+	logger.InfoContext(ctx, "with context")
+	assert.Contains(t, buf.String(), "request_id=req-1")
+
+	buf.Reset()
+	logger.Info("without context")
+	assert.NotContains(t, buf.String(), "request_id")
+
+	// Not duplicated when the logger already carries one.
+	buf.Reset()
+	logger.With("request_id", "req-2").InfoContext(ctx, "explicit")
+	assert.Equal(t, 1, strings.Count(buf.String(), "request_id="))
+	assert.Contains(t, buf.String(), "request_id=req-2")
+}
@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"log/slog"
+	"maps"
+	"sync"
+)
+
+// LevelTable holds the base log level and per-subsystem overrides.
+// It implements slog.Leveler, reporting the most verbose level in effect
+// so handlers built on it never drop a record some subsystem wants.
+// Safe for concurrent use.
+type LevelTable struct {
+	mu        sync.RWMutex
+	base      slog.Level
+	overrides map[Subsystem]slog.Level
+}
+
+// NewLevelTable creates a table with base level and no overrides.
+func NewLevelTable(base slog.Level) *LevelTable {
+	return &LevelTable{base: base, overrides: map[Subsystem]slog.Level{}}
+}
+
+// Level implements slog.Leveler: the lowest level any subsystem logs at.
+func (t *LevelTable) Level() slog.Level {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	lowest := t.base
+	for _, l := range t.overrides {
+		lowest = min(lowest, l)
+	}
+	return lowest
+}
+
+// Base returns the level for records outside any overridden subsystem.
+func (t *LevelTable) Base() slog.Level {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.base
+}
+
+// SetBase sets the level for records outside any overridden subsystem.
+func (t *LevelTable) SetBase(l slog.Level) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.base = l
+}
+
+// For returns the effective level for sub (its override, else the base).
+func (t *LevelTable) For(sub Subsystem) slog.Level {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if l, ok := t.overrides[sub]; ok {
+		return l
+	}
+	return t.base
+}
+
+// Set overrides the level for sub.
+func (t *LevelTable) Set(sub Subsystem, l slog.Level) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.overrides[sub] = l
+}
+
+// Reset removes the override for sub so it follows the base level again.
+func (t *LevelTable) Reset(sub Subsystem) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.overrides, sub)
+}
+
+// Overrides returns a copy of the per-subsystem overrides.
+func (t *LevelTable) Overrides() map[Subsystem]slog.Level {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return maps.Clone(t.overrides)
+}
@@ -0,0 +1,144 @@
+// Package logging configures TARSy's slog handler: per-subsystem log levels
+// that can be changed at runtime, request IDs from the context, and redaction
+// of sensitive values in debug-level logs.
+//
+// The subsystem of a record is derived from the package of the code that
+// logged it, so existing slog call sites need no changes.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// Subsystem names a group of packages whose log level can be set independently.
+type Subsystem string
+
+// Subsystems with independently configurable log levels.
+const (
+	SubsystemQueue  Subsystem = "queue"  // pkg/queue
+	SubsystemMCP    Subsystem = "mcp"    // pkg/mcp
+	SubsystemEvents Subsystem = "events" // pkg/events
+	SubsystemAgent  Subsystem = "agent"  // pkg/agent and its subpackages
+)
+
+// subsystemDefault is used for records outside every named subsystem.
+const subsystemDefault Subsystem = ""
+
+// Subsystems returns all configurable subsystems in display order.
+func Subsystems() []Subsystem {
+	return []Subsystem{SubsystemQueue, SubsystemMCP, SubsystemEvents, SubsystemAgent}
+}
+
+// IsValid reports whether s is a configurable subsystem.
+func (s Subsystem) IsValid() bool {
+	switch s {
+	case SubsystemQueue, SubsystemMCP, SubsystemEvents, SubsystemAgent:
+		return true
+	}
+	return false
+}
+
+// ParseLevel parses a case-insensitive level name (debug, info, warn/warning, error).
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return slog.LevelInfo, fmt.Errorf("invalid log level %q (must be debug, info, warn, or error)", s)
+}
+
+// LevelName returns the lowercase name ParseLevel accepts for l.
+func LevelName(l slog.Level) string {
+	return strings.ToLower(l.String())
+}
+
+// --- Process-wide state ---
+
+var (
+	defaultLevels = NewLevelTable(slog.LevelInfo)
+	redact        atomic.Bool
+)
+
+func init() {
+	redact.Store(true)
+}
+
+// Levels returns the process-wide level table used by the handler installed
+// with Setup. Changes take effect immediately.
+func Levels() *LevelTable {
+	return defaultLevels
+}
+
+// Setup installs a text or JSON handler writing to w as the slog default,
+// filtering records through the process-wide level table with base level.
+func Setup(w io.Writer, format string, base slog.Level) {
+	defaultLevels.SetBase(base)
+
+	opts := &slog.HandlerOptions{Level: defaultLevels}
+	var inner slog.Handler
+	switch format {
+	case "json":
+		inner = slog.NewJSONHandler(w, opts)
+	default:
+		inner = slog.NewTextHandler(w, opts)
+	}
+	slog.SetDefault(slog.New(NewHandler(inner, defaultLevels)))
+}
+
+// SetRedactSensitive controls whether Sensitive masks values (default true).
+func SetRedactSensitive(enabled bool) {
+	redact.Store(enabled)
+}
+
+// RedactSensitive reports whether sensitive values are masked before logging.
+func RedactSensitive() bool {
+	return redact.Load()
+}
+
+// maxSnippet caps values logged by Sensitive.
+const maxSnippet = 256
+
+// Sensitive wraps value for use as a debug log attribute. When the record is
+// written, value is passed through mask if redaction is enabled, then
+// truncated to a short snippet. A nil mask with redaction enabled omits the
+// value entirely (fail-closed). Masking is skipped for dropped records.
+func Sensitive(value string, mask func(string) string) slog.LogValuer {
+	return sensitiveValue{value: value, mask: mask}
+}
+
+type sensitiveValue struct {
+	value string
+	mask  func(string) string
+}
+
+// LogValue implements slog.LogValuer.
+func (v sensitiveValue) LogValue() slog.Value {
+	value := v.value
+	if RedactSensitive() {
+		if v.mask == nil {
+			return slog.StringValue("[REDACTED]")
+		}
+		value = v.mask(value)
+	}
+	return slog.StringValue(snippet(value, maxSnippet))
+}
+
+// snippet truncates s to at most n runes, appending an ellipsis when cut.
+func snippet(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	r := []rune(s)
+	return string(r[:n]) + "…"
+}
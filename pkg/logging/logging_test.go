@@ -0,0 +1,104 @@
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    slog.Level
+		wantErr bool
+	}{
+		{"debug", slog.LevelDebug, false},
+		{"INFO", slog.LevelInfo, false},
+		{"warn", slog.LevelWarn, false},
+		{" warning ", slog.LevelWarn, false},
+		{"error", slog.LevelError, false},
+		{"trace", slog.LevelInfo, true},
+		{"", slog.LevelInfo, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseLevel(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			// Round-trips through LevelName.
+			back, err := ParseLevel(LevelName(got))
+			require.NoError(t, err)
+			assert.Equal(t, got, back)
+		})
+	}
+}
+
+func TestLevelTable(t *testing.T) {
+	levels := NewLevelTable(slog.LevelInfo)
+	assert.Equal(t, slog.LevelInfo, levels.Level())
+	assert.Equal(t, slog.LevelInfo, levels.For(SubsystemMCP))
+
+	levels.Set(SubsystemMCP, slog.LevelDebug)
+	levels.Set(SubsystemQueue, slog.LevelError)
+	assert.Equal(t, slog.LevelDebug, levels.Level())
+	assert.Equal(t, slog.LevelDebug, levels.For(SubsystemMCP))
+	assert.Equal(t, slog.LevelError, levels.For(SubsystemQueue))
+	assert.Equal(t, slog.LevelInfo, levels.For(SubsystemAgent))
+
+	overrides := levels.Overrides()
+	overrides[SubsystemAgent] = slog.LevelDebug // copy, not the table
+	assert.Len(t, levels.Overrides(), 2)
+
+	levels.Reset(SubsystemMCP)
+	assert.Equal(t, slog.LevelInfo, levels.Level())
+	assert.Equal(t, slog.LevelInfo, levels.For(SubsystemMCP))
+}
+
+func TestSensitive(t *testing.T) {
+	t.Cleanup(func() { SetRedactSensitive(true) })
+	calls := 0
+	upper := func(s string) string { calls++; return strings.ToUpper(s) }
+	value := func(v slog.LogValuer) string { return v.LogValue().String() }
+
+	SetRedactSensitive(true)
+	assert.Equal(t, "SECRET", value(Sensitive("secret", upper)))
+	assert.Equal(t, "[REDACTED]", value(Sensitive("secret", nil)))
+
+	SetRedactSensitive(false)
+	assert.Equal(t, "secret", value(Sensitive("secret", upper)))
+	assert.Equal(t, "secret", value(Sensitive("secret", nil)))
+
+	long := strings.Repeat("é", maxSnippet+10)
+	assert.Equal(t, strings.Repeat("é", maxSnippet)+"…", value(Sensitive(long, nil)))
+
+	// Masking runs only when a record is actually written.
+	SetRedactSensitive(true)
+	calls = 0
+	var buf bytes.Buffer
+	levels := NewLevelTable(slog.LevelInfo)
+	logger := slog.New(NewHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: levels}), levels))
+	levels.Set(SubsystemMCP, slog.LevelDebug) // handler-wide threshold now debug
+	logger.Debug("dropped", "arguments", Sensitive("secret", upper))
+	assert.Equal(t, 0, calls)
+	assert.Empty(t, buf.String())
+	levels.SetBase(slog.LevelDebug)
+	logger.Debug("written", "arguments", Sensitive("secret", upper))
+	assert.Equal(t, 1, calls)
+	assert.Contains(t, buf.String(), "arguments=SECRET")
+}
+
+func TestSubsystemIsValid(t *testing.T) {
+	for _, s := range Subsystems() {
+		assert.True(t, s.IsValid(), s)
+	}
+	assert.False(t, Subsystem("api").IsValid())
+	assert.False(t, subsystemDefault.IsValid())
+}
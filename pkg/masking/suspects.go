@@ -0,0 +1,244 @@
+package masking
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Heuristics that flag a value as a possible secret the masking patterns
+// missed. Stored on redaction reviews.
+const (
+	HeuristicPrivateKey       = "private_key"
+	HeuristicAWSAccessKey     = "aws_access_key"
+	HeuristicJWT              = "jwt"
+	HeuristicSecretAssignment = "secret_assignment"
+	HeuristicHighEntropy      = "high_entropy"
+)
+
+// DefaultMinEntropy is the Shannon entropy (bits per character) above which
+// a long token is flagged. Hex strings (at most 4 bits) such as digests and
+// UUIDs stay below it; random base64 keys are well above.
+const DefaultMinEntropy = 4.3
+
+// contextRadius is how many bytes of text around a suspect are kept for the
+// reviewer.
+const contextRadius = 60
+
+// Suspect is a value in (already masked) content that looks like a secret.
+type Suspect struct {
+	Heuristic string
+	Value     string
+	// Context is the text around the value, with the value itself shown as
+	// its Preview so the review queue does not repeat the secret.
+	Context string
+}
+
+// Detector flags possible secrets that survived masking: key material,
+// well-known credential formats, secret-named assignments, and
+// high-entropy tokens. It only reports; callers decide what to redact.
+type Detector struct {
+	minEntropy float64
+	maxResults int
+}
+
+// NewDetector creates a detector. minEntropy <= 0 uses DefaultMinEntropy;
+// maxResults <= 0 reports every suspect.
+func NewDetector(minEntropy float64, maxResults int) *Detector {
+	if minEntropy <= 0 {
+		minEntropy = DefaultMinEntropy
+	}
+	return &Detector{minEntropy: minEntropy, maxResults: maxResults}
+}
+
+var (
+	privateKeyRe   = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)
+	awsAccessKeyRe = regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`)
+	jwtRe          = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)
+	assignmentRe   = regexp.MustCompile(`(?i)((?:secret|passw(?:or)?d|token|api[_-]?key|access[_-]?key|credentials?)[A-Za-z0-9_-]*)["']?\s*[:=]\s*["']?([^\s"',;{}\[\]<>]{8,})`)
+	tokenRe        = regexp.MustCompile(`[A-Za-z0-9+/_=-]{32,}`) // shorter tokens are too often identifiers
+)
+
+// Detect returns the suspects in content, each distinct value once, in the
+// order the heuristics run (most specific first).
+func (d *Detector) Detect(content string) []Suspect {
+	if content == "" {
+		return nil
+	}
+
+	var suspects []Suspect
+	var covered [][2]int
+	seen := make(map[string]bool)
+	add := func(heuristic string, start, end int) bool {
+		if d.maxResults > 0 && len(suspects) >= d.maxResults {
+			return false
+		}
+		for _, c := range covered {
+			if start < c[1] && end > c[0] {
+				return true
+			}
+		}
+		covered = append(covered, [2]int{start, end})
+		value := content[start:end]
+		if seen[value] {
+			return true
+		}
+		seen[value] = true
+		suspects = append(suspects, Suspect{
+			Heuristic: heuristic,
+			Value:     value,
+			Context:   suspectContext(content, start, end),
+		})
+		return true
+	}
+
+	for _, m := range privateKeyRe.FindAllStringIndex(content, -1) {
+		if !add(HeuristicPrivateKey, m[0], m[1]) {
+			return suspects
+		}
+	}
+	for _, m := range awsAccessKeyRe.FindAllStringIndex(content, -1) {
+		if !add(HeuristicAWSAccessKey, m[0], m[1]) {
+			return suspects
+		}
+	}
+	for _, m := range jwtRe.FindAllStringIndex(content, -1) {
+		if !add(HeuristicJWT, m[0], m[1]) {
+			return suspects
+		}
+	}
+	for _, m := range assignmentRe.FindAllStringSubmatchIndex(content, -1) {
+		if isReferenceKey(content[m[2]:m[3]]) || !isSecretLike(content[m[4]:m[5]]) {
+			continue
+		}
+		if !add(HeuristicSecretAssignment, m[4], m[5]) {
+			return suspects
+		}
+	}
+	for _, m := range tokenRe.FindAllStringIndex(content, -1) {
+		token := content[m[0]:m[1]]
+		if !hasLetterAndDigit(token) || shannonEntropy(token) < d.minEntropy {
+			continue
+		}
+		if !add(HeuristicHighEntropy, m[0], m[1]) {
+			return suspects
+		}
+	}
+	return suspects
+}
+
+// Preview shows enough of a value to recognize it without revealing it:
+// the first line of key material, otherwise the first and last four
+// characters (two for short values).
+func Preview(value string) string {
+	if line, _, ok := strings.Cut(value, "\n"); ok {
+		return line + "…"
+	}
+	runes := []rune(value)
+	if len(runes) < 16 {
+		return string(runes[:min(2, len(runes))]) + "…"
+	}
+	return string(runes[:4]) + "…" + string(runes[len(runes)-4:])
+}
+
+// suspectContext returns up to contextRadius bytes on either side of
+// content[start:end] on the same lines, with the value replaced by its
+// preview.
+func suspectContext(content string, start, end int) string {
+	from := max(0, start-contextRadius)
+	for from > 0 && !utf8.RuneStart(content[from]) {
+		from++
+	}
+	if i := strings.LastIndexByte(content[from:start], '\n'); i >= 0 {
+		from += i + 1
+	}
+	to := min(len(content), end+contextRadius)
+	for to < len(content) && !utf8.RuneStart(content[to]) {
+		to--
+	}
+	if i := strings.IndexByte(content[end:to], '\n'); i >= 0 {
+		to = end + i
+	}
+	return strings.TrimSpace(content[from:start] + Preview(content[start:end]) + content[end:to])
+}
+
+// referenceKeySuffixes mark keys that name or describe a secret rather than
+// hold one, e.g. secretName or tokenPath.
+var referenceKeySuffixes = []string{"name", "ref", "type", "path", "file", "kind", "namespace", "id", "mode", "ttl"}
+
+// isReferenceKey reports whether an assignment key refers to a secret
+// instead of holding its value.
+func isReferenceKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range referenceKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSecretLike rejects assignment values that are plainly not secrets:
+// placeholders, references, and single-class words such as "required".
+func isSecretLike(value string) bool {
+	upper := strings.ToUpper(value)
+	if strings.Contains(upper, "MASKED") || strings.Contains(upper, "REDACTED") ||
+		strings.HasPrefix(value, "${") || strings.HasPrefix(value, "$(") ||
+		strings.Trim(value, "*xX.") == "" {
+		return false
+	}
+	var lower, upperCase, digit, other bool
+	for _, r := range value {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upperCase = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	classes := 0
+	for _, b := range []bool{lower, upperCase, digit, other} {
+		if b {
+			classes++
+		}
+	}
+	return classes >= 2
+}
+
+func hasLetterAndDigit(s string) bool {
+	var letter, digit bool
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			digit = true
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z':
+			letter = true
+		}
+	}
+	return letter && digit
+}
+
+// shannonEntropy returns the entropy of s in bits per byte.
+func shannonEntropy(s string) float64 {
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+	n := float64(len(s))
+	entropy := 0.0
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
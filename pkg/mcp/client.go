@@ -4,16 +4,19 @@ package mcp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"math/rand/v2"
+	"strings"
 	"sync"
 	"time"
 
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
 	"github.com/codeready-toolchain/tarsy/pkg/version"
 )
 
@@ -27,6 +30,7 @@ type Client struct {
 	sessions      map[string]*mcpsdk.ClientSession // serverID → session
 	clients       map[string]*mcpsdk.Client        // serverID → client (for reconnection)
 	failedServers map[string]string                // serverID → error message
+	serverLogs    map[string]*serverLog            // serverID → stderr of stdio servers
 
 	// Tool cache (populated on first ListTools, never invalidated — each Client
 	// instance is short-lived per session, so the cache is naturally fresh)
@@ -36,6 +40,9 @@ type Client struct {
 	// Per-server mutex for session recreation to prevent thundering herd
 	reinitMu sync.Map // serverID → *sync.Mutex
 
+	faults *faultinject.Injector // nil = no fault injection
+	target *config.Target        // rendered into server transports; nil = untargeted
+
 	logger *slog.Logger
 }
 
@@ -46,6 +53,7 @@ func newClient(registry *config.MCPServerRegistry) *Client {
 		sessions:      make(map[string]*mcpsdk.ClientSession),
 		clients:       make(map[string]*mcpsdk.Client),
 		failedServers: make(map[string]string),
+		serverLogs:    make(map[string]*serverLog),
 		toolCache:     make(map[string][]*mcpsdk.Tool),
 		logger:        slog.Default(),
 	}
@@ -98,8 +106,12 @@ func (c *Client) initializeServerLocked(ctx context.Context, serverID string) er
 		return fmt.Errorf("server %q not found in registry: %w", serverID, err)
 	}
 
-	// Create transport
-	transport, err := createTransport(serverCfg.Transport)
+	// Create transport, rendering ${target.*} placeholders for the session's target
+	var stderr io.Writer
+	if serverCfg.Transport.Type == config.TransportTypeStdio {
+		stderr = c.serverLogFor(serverID)
+	}
+	transport, err := createTransport(serverCfg.Transport.WithTarget(c.target), stderr)
 	if err != nil {
 		return fmt.Errorf("failed to create transport for %q: %w", serverID, err)
 	}
@@ -222,6 +234,10 @@ func (c *Client) CallTool(ctx context.Context, serverID, toolName string, args m
 	if err == nil {
 		return result, nil
 	}
+	if errors.Is(ctx.Err(), context.Canceled) {
+		c.terminateCancelledCall(serverID, toolName)
+		return nil, err
+	}
 
 	// Classify error for recovery
 	action := ClassifyError(err)
@@ -239,6 +255,9 @@ func (c *Client) CallTool(ctx context.Context, serverID, toolName string, args m
 	select {
 	case <-time.After(backoff):
 	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.Canceled) {
+			c.terminateCancelledCall(serverID, toolName)
+		}
 		return nil, ctx.Err()
 	}
 
@@ -252,11 +271,51 @@ func (c *Client) CallTool(ctx context.Context, serverID, toolName string, args m
 	// Second attempt
 	result, err = c.callToolOnce(ctx, serverID, params)
 	if err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			c.terminateCancelledCall(serverID, toolName)
+		}
 		return nil, fmt.Errorf("retry failed for %q.%s: %w", serverID, toolName, err)
 	}
 	return result, nil
 }
 
+// terminateCancelledCall handles a tool call aborted by caller cancellation
+// (session or execution cancelled, not a per-call timeout). The SDK has
+// already sent notifications/cancelled to the server; servers that honour it
+// stop on their own. Stdio servers cannot be trusted to, so their session is
+// detached and closed in the background: stdin is closed, and the subprocess
+// is sent SIGTERM and then SIGKILL if it outlives the configured grace period.
+// Later calls to the server on this Client fail with "no session".
+func (c *Client) terminateCancelledCall(serverID, toolName string) {
+	serverCfg, err := c.registry.Get(serverID)
+	if err != nil || serverCfg.Transport.Type != config.TransportTypeStdio {
+		return
+	}
+
+	c.mu.Lock()
+	session, exists := c.sessions[serverID]
+	if exists {
+		delete(c.sessions, serverID)
+		delete(c.clients, serverID)
+		c.failedServers[serverID] = "session terminated after cancelled tool call"
+	}
+	c.mu.Unlock()
+	if !exists {
+		return
+	}
+	c.InvalidateToolCache(serverID)
+
+	c.logger.Info("Terminating stdio MCP server after cancelled tool call",
+		"server", serverID, "tool", toolName,
+		"grace_period", serverCfg.Transport.StdioCancelGracePeriod())
+	go func() {
+		if err := session.Close(); err != nil {
+			c.logger.Warn("Stdio MCP server did not shut down cleanly",
+				"server", serverID, "error", err)
+		}
+	}()
+}
+
 // callToolOnce performs a single CallTool attempt.
 func (c *Client) callToolOnce(ctx context.Context, serverID string, params *mcpsdk.CallToolParams) (*mcpsdk.CallToolResult, error) {
 	c.mu.RLock()
@@ -266,10 +325,31 @@ func (c *Client) callToolOnce(ctx context.Context, serverID string, params *mcps
 		return nil, fmt.Errorf("no session for server %q", serverID)
 	}
 
+	fault := c.faults.MCPFault(serverID, params.Name)
+	if fault == faultinject.FaultMCPTimeout {
+		return nil, fmt.Errorf("call %q on %q: %w (injected fault)", params.Name, serverID, context.DeadlineExceeded)
+	}
+
 	opCtx, cancel := context.WithTimeout(ctx, OperationTimeout)
 	defer cancel()
 
-	return session.CallTool(opCtx, params)
+	result, err := session.CallTool(opCtx, params)
+	if err == nil && fault == faultinject.FaultMCPMalformed {
+		return malformResult(result), nil
+	}
+	return result, err
+}
+
+// malformResult replaces a tool result with the first half of its text,
+// which is unparseable for structured (JSON/YAML) output.
+func malformResult(result *mcpsdk.CallToolResult) *mcpsdk.CallToolResult {
+	text := extractTextContent(result)
+	if len(text) < 2 {
+		text = `{"items": [{"name": `
+	} else {
+		text = strings.ToValidUTF8(text[:len(text)/2], "")
+	}
+	return &mcpsdk.CallToolResult{Content: []mcpsdk.Content{&mcpsdk.TextContent{Text: text}}}
 }
 
 // recreateSession tears down and recreates the session for a server.
@@ -344,6 +424,32 @@ func (c *Client) InvalidateToolCache(serverID string) {
 	c.toolCacheMu.Unlock()
 }
 
+// serverLogFor returns the stderr log of a stdio server, creating it on
+// first use. The log is kept across session recreation.
+func (c *Client) serverLogFor(serverID string) *serverLog {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	l, ok := c.serverLogs[serverID]
+	if !ok {
+		l = newServerLog(serverID)
+		c.serverLogs[serverID] = l
+	}
+	return l
+}
+
+// captureServerLog starts collecting the stderr a stdio server writes, for
+// the duration of one tool call. Returns nil for servers without a log
+// (non-stdio transports); stop is nil-safe.
+func (c *Client) captureServerLog(serverID string, onLine func(line string)) *logCapture {
+	c.mu.RLock()
+	l := c.serverLogs[serverID]
+	c.mu.RUnlock()
+	if l == nil {
+		return nil
+	}
+	return l.capture(onLine)
+}
+
 // HasSession checks if a server has an active session.
 func (c *Client) HasSession(serverID string) bool {
 	c.mu.RLock()
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
 	"github.com/codeready-toolchain/tarsy/pkg/masking"
 )
 
@@ -11,6 +12,8 @@ import (
 type ClientFactory struct {
 	registry       *config.MCPServerRegistry
 	maskingService *masking.Service
+	faults         *faultinject.Injector // nil = no fault injection
+	defaultTarget  *config.Target        // nil = placeholders of untargeted clients render empty
 
 	// createClientFn overrides the default client creation logic.
 	// When non-nil, it is called instead of newClient + Initialize.
@@ -24,13 +27,32 @@ func NewClientFactory(registry *config.MCPServerRegistry, maskingService *maskin
 	return &ClientFactory{registry: registry, maskingService: maskingService}
 }
 
+// SetFaultInjector makes tool calls of clients created afterwards fail at
+// the injector's rates. injector may be nil (disabled).
+func (f *ClientFactory) SetFaultInjector(injector *faultinject.Injector) {
+	f.faults = injector
+}
+
+// SetDefaultTarget sets the target used by clients whose context carries
+// none (health checks, startup validation). target may be nil.
+func (f *ClientFactory) SetDefaultTarget(target *config.Target) {
+	f.defaultTarget = target
+}
+
 // CreateClient creates a new Client connected to the specified servers.
+// Server transports are rendered with the target carried by ctx (see
+// WithTarget), or the factory's default target.
 // The caller is responsible for calling Close() when done.
 func (f *ClientFactory) CreateClient(ctx context.Context, serverIDs []string) (*Client, error) {
 	if f.createClientFn != nil {
 		return f.createClientFn(ctx, serverIDs)
 	}
 	client := newClient(f.registry)
+	client.faults = f.faults
+	client.target = f.defaultTarget
+	if target := TargetFromContext(ctx); target != nil {
+		client.target = target
+	}
 	client.Initialize(ctx, serverIDs)
 	return client, nil
 }
@@ -48,3 +70,20 @@ func (f *ClientFactory) CreateToolExecutor(
 	}
 	return NewToolExecutor(client, f.registry, serverIDs, toolFilter, f.maskingService), client, nil
 }
+
+type targetKey struct{}
+
+// WithTarget returns a copy of ctx carrying the session's target, which
+// clients created from it substitute for ${target.*} placeholders.
+func WithTarget(ctx context.Context, target *config.Target) context.Context {
+	if target == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, targetKey{}, target)
+}
+
+// TargetFromContext returns the target carried by ctx, or nil.
+func TargetFromContext(ctx context.Context) *config.Target {
+	t, _ := ctx.Value(targetKey{}).(*config.Target)
+	return t
+}
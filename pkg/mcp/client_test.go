@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/faultinject"
 )
 
 // emptySchema is a minimal valid JSON Schema for test tools.
@@ -167,6 +168,97 @@ func TestClient_CallTool_ErrorResult(t *testing.T) {
 	assert.True(t, result.IsError)
 }
 
+func TestClient_CallTool_InjectedFaults(t *testing.T) {
+	calls := 0
+	ts := startTestServer(t, "test-server", map[string]mcpsdk.ToolHandler{
+		"get_pods": func(_ context.Context, _ *mcpsdk.CallToolRequest) (*mcpsdk.CallToolResult, error) {
+			calls++
+			return &mcpsdk.CallToolResult{
+				Content: []mcpsdk.Content{&mcpsdk.TextContent{Text: `{"items": [{"name": "pod-1"}]}`}},
+			}, nil
+		},
+	})
+	client := connectClientDirect(t, "kubernetes", ts.clientTransport)
+	ctx := context.Background()
+
+	t.Run("timeout never reaches the server", func(t *testing.T) {
+		client.faults = faultinject.New(&config.FaultInjectionConfig{Enabled: true, MCPTimeoutRate: 1}, nil)
+		_, err := client.CallTool(ctx, "kubernetes", "get_pods", map[string]any{})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("malformed result is truncated", func(t *testing.T) {
+		client.faults = faultinject.New(&config.FaultInjectionConfig{Enabled: true, MCPMalformedRate: 1}, nil)
+		result, err := client.CallTool(ctx, "kubernetes", "get_pods", map[string]any{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, `{"items": [{"na`, extractTextContent(result))
+	})
+
+	t.Run("other servers are spared", func(t *testing.T) {
+		client.faults = faultinject.New(&config.FaultInjectionConfig{
+			Enabled: true, MCPTimeoutRate: 1, MCPServers: []string{"github"},
+		}, nil)
+		_, err := client.CallTool(ctx, "kubernetes", "get_pods", map[string]any{})
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_CallTool_CancelledStdioSessionTerminated(t *testing.T) {
+	started := make(chan struct{})
+	ts := startTestServer(t, "kubernetes", map[string]mcpsdk.ToolHandler{
+		"hang": func(ctx context.Context, _ *mcpsdk.CallToolRequest) (*mcpsdk.CallToolResult, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+
+	client := connectClientDirect(t, "kubernetes", ts.clientTransport)
+	client.registry = config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+		"kubernetes": {Transport: config.TransportConfig{Type: config.TransportTypeStdio, Command: "kubernetes-mcp"}},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, err := client.CallTool(ctx, "kubernetes", "hang", map[string]any{})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.False(t, client.HasSession("kubernetes"))
+	assert.Contains(t, client.FailedServers()["kubernetes"], "cancelled tool call")
+}
+
+func TestClient_CallTool_CancelledHTTPSessionKept(t *testing.T) {
+	started := make(chan struct{})
+	ts := startTestServer(t, "monitoring", map[string]mcpsdk.ToolHandler{
+		"hang": func(ctx context.Context, _ *mcpsdk.CallToolRequest) (*mcpsdk.CallToolResult, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	})
+
+	client := connectClientDirect(t, "monitoring", ts.clientTransport)
+	client.registry = config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+		"monitoring": {Transport: config.TransportConfig{Type: config.TransportTypeHTTP, URL: "http://monitoring"}},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, err := client.CallTool(ctx, "monitoring", "hang", map[string]any{})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.True(t, client.HasSession("monitoring"))
+}
+
 func TestClient_ListTools_NoSession(t *testing.T) {
 	client := newClient(config.NewMCPServerRegistry(nil))
 
@@ -221,3 +313,22 @@ func TestClient_Close(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, client.HasSession("kubernetes"))
 }
+
+func TestClientFactory_Target(t *testing.T) {
+	factory := NewClientFactory(config.NewMCPServerRegistry(nil), nil)
+
+	client, err := factory.CreateClient(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Nil(t, client.target)
+
+	fallback := &config.Target{Cluster: "prod-east"}
+	factory.SetDefaultTarget(fallback)
+	client, err = factory.CreateClient(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Same(t, fallback, client.target)
+
+	session := &config.Target{Cluster: "prod-west", Namespace: "payments"}
+	client, err = factory.CreateClient(WithTarget(context.Background(), session), nil)
+	require.NoError(t, err)
+	assert.Same(t, session, client.target)
+}
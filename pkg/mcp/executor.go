@@ -7,11 +7,14 @@ import (
 	"log/slog"
 	"slices"
 	"strings"
+	"sync"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
 	"github.com/codeready-toolchain/tarsy/pkg/masking"
 )
 
@@ -34,6 +37,11 @@ type ToolExecutor struct {
 	// Optional masking service for redacting sensitive data in tool results.
 	// nil means no masking is applied.
 	maskingService *masking.Service
+
+	// Resolved input schemas for argument validation, keyed by tool.
+	// nil entries mark tools whose schema cannot be validated.
+	schemas   map[*mcpsdk.Tool]*jsonschema.Resolved
+	schemasMu sync.Mutex
 }
 
 // NewToolExecutor creates a new executor for the given servers.
@@ -62,10 +70,11 @@ func NewToolExecutor(
 //  3. Check server is in allowed serverIDs
 //  4. Check tool is in allowed tools (if filter set)
 //  5. Parse Arguments string into map[string]any
-//  6. Call Client.CallTool(ctx, serverID, toolName, params)
-//  7. Convert MCP result to ToolResult
-//  8. Apply data masking (if masking service configured)
-//  9. Return ToolResult (summarization is handled at the controller level)
+//  6. Validate arguments against the tool's input schema
+//  7. Call Client.CallTool(ctx, serverID, toolName, params)
+//  8. Convert MCP result to ToolResult
+//  9. Apply data masking (if masking service configured)
+//  10. Return ToolResult (summarization is handled at the controller level)
 func (e *ToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
 	// Step 1: Normalize name
 	name := NormalizeToolName(call.Name)
@@ -92,21 +101,44 @@ func (e *ToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent
 		}, nil
 	}
 
-	// Step 6: Execute via MCP
-	result, err := e.client.CallTool(ctx, serverID, toolName, params)
-	if err != nil {
+	// Step 6: Validate arguments (malformed calls never reach the server)
+	if argErr := e.validateArguments(ctx, serverID, toolName, params); argErr != nil {
+		slog.Debug("MCP tool arguments failed schema validation",
+			"server", serverID, "tool", toolName, "reason", argErr.Reason)
 		return &agent.ToolResult{
 			CallID:  call.ID,
 			Name:    call.Name,
-			Content: fmt.Sprintf("MCP tool execution failed: %s", err),
+			Content: argErr.Error(),
 			IsError: true,
 		}, nil
 	}
 
-	// Step 7: Convert to ToolResult
+	slog.Debug("Calling MCP tool",
+		"server", serverID,
+		"tool", toolName,
+		"arguments", logging.Sensitive(call.Arguments, e.maskFor(serverID)))
+
+	// Step 7: Execute via MCP, capturing what a stdio server logs meanwhile
+	capture := e.client.captureServerLog(serverID, e.serverLogLineSink(ctx, serverID))
+	result, err := e.client.CallTool(ctx, serverID, toolName, params)
+	serverLog := capture.stop()
+	if serverLog != "" && e.maskingService != nil {
+		serverLog = e.maskingService.MaskToolResult(serverLog, serverID)
+	}
+	if err != nil {
+		return &agent.ToolResult{
+			CallID:    call.ID,
+			Name:      call.Name,
+			Content:   fmt.Sprintf("MCP tool execution failed: %s", err),
+			IsError:   true,
+			ServerLog: serverLog,
+		}, nil
+	}
+
+	// Step 8: Convert to ToolResult
 	content := extractTextContent(result)
 
-	// Step 8: Apply data masking
+	// Step 9: Apply data masking
 	if e.maskingService != nil {
 		content = e.maskingService.MaskToolResult(content, serverID)
 	}
@@ -116,13 +148,29 @@ func (e *ToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent
 	// which are not available to ToolExecutor. See pkg/agent/controller/summarize.go.
 
 	return &agent.ToolResult{
-		CallID:  call.ID,
-		Name:    call.Name,
-		Content: content,
-		IsError: result.IsError,
+		CallID:    call.ID,
+		Name:      call.Name,
+		Content:   content,
+		IsError:   result.IsError,
+		ServerLog: serverLog,
 	}, nil
 }
 
+// serverLogLineSink returns the callback streaming a server's stderr lines,
+// masked, to the sink set on ctx by WithServerLogSink; nil when there is none.
+func (e *ToolExecutor) serverLogLineSink(ctx context.Context, serverID string) func(line string) {
+	sink := serverLogSinkFrom(ctx)
+	if sink == nil {
+		return nil
+	}
+	return func(line string) {
+		if e.maskingService != nil {
+			line = e.maskingService.MaskToolResult(line, serverID)
+		}
+		sink(line)
+	}
+}
+
 // ListTools returns all available tools from configured MCP servers.
 // Tools are returned with server-prefixed names (e.g., "kubernetes-server.get_pods").
 func (e *ToolExecutor) ListTools(ctx context.Context) ([]agent.ToolDefinition, error) {
@@ -167,6 +215,17 @@ func (e *ToolExecutor) Close() error {
 	return nil
 }
 
+// maskFor returns the masking function for serverID's content, or nil
+// when masking is disabled.
+func (e *ToolExecutor) maskFor(serverID string) func(string) string {
+	if e.maskingService == nil {
+		return nil
+	}
+	return func(content string) string {
+		return e.maskingService.MaskToolResult(content, serverID)
+	}
+}
+
 // resolveToolCall validates a tool call against the executor's configuration.
 func (e *ToolExecutor) resolveToolCall(name string) (serverID, toolName string, err error) {
 	serverID, toolName, err = SplitToolName(name)
@@ -2,6 +2,7 @@ package mcp
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
 	"github.com/codeready-toolchain/tarsy/pkg/masking"
 )
 
@@ -524,3 +526,92 @@ func TestToolExecutor_Execute_NilService(t *testing.T) {
 	assert.Contains(t, result.Content, "sk-FAKE-NOT-REAL-API-KEY-XXXXXXXXXXXX",
 		"Content should pass through with nil masking service")
 }
+
+func TestToolExecutor_MaskFor(t *testing.T) {
+	registry := config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+		"kubernetes": {
+			Transport:   config.TransportConfig{Type: config.TransportTypeStdio, Command: "echo"},
+			DataMasking: &config.MaskingConfig{Enabled: true, PatternGroups: []string{"basic"}},
+		},
+	})
+	args := `{"password": "FAKE-DB-PASSWORD-NOT-REAL"}`
+
+	t.Run("nil masking service", func(t *testing.T) {
+		executor := NewToolExecutor(nil, registry, []string{"kubernetes"}, nil, nil)
+		assert.Nil(t, executor.maskFor("kubernetes"))
+		assert.Equal(t, "[REDACTED]", logging.Sensitive(args, executor.maskFor("kubernetes")).LogValue().String())
+	})
+
+	t.Run("arguments masked with server patterns", func(t *testing.T) {
+		executor := NewToolExecutor(nil, registry, []string{"kubernetes"}, nil,
+			masking.NewService(registry, masking.AlertMaskingConfig{}))
+		got := logging.Sensitive(args, executor.maskFor("kubernetes")).LogValue().String()
+		assert.NotContains(t, got, "FAKE-DB-PASSWORD-NOT-REAL")
+		assert.Contains(t, got, "[MASKED_PASSWORD]")
+	})
+}
+
+func TestToolExecutor_Execute_ServerLog(t *testing.T) {
+	var stderr *serverLog
+	executor := newTestExecutorWithMasking(t, "kubernetes",
+		map[string]mcpsdk.ToolHandler{
+			"get_pods": func(_ context.Context, _ *mcpsdk.CallToolRequest) (*mcpsdk.CallToolResult, error) {
+				_, _ = stderr.Write([]byte("connecting with password: \"FAKE-DB-PASSWORD-NOT-REAL\"\n"))
+				_, _ = stderr.Write([]byte("error: forbidden\n"))
+				return &mcpsdk.CallToolResult{
+					Content: []mcpsdk.Content{&mcpsdk.TextContent{Text: "forbidden"}},
+					IsError: true,
+				}, nil
+			},
+		},
+		&config.MCPServerConfig{
+			Transport: config.TransportConfig{Type: config.TransportTypeStdio, Command: "echo"},
+			DataMasking: &config.MaskingConfig{
+				Enabled:       true,
+				PatternGroups: []string{"basic"},
+			},
+		},
+	)
+	stderr = executor.client.serverLogFor("kubernetes")
+	_, _ = stderr.Write([]byte("server started\n")) // before the call: not captured
+
+	var mu sync.Mutex
+	var live []string
+	ctx := WithServerLogSink(context.Background(), func(line string) {
+		mu.Lock()
+		defer mu.Unlock()
+		live = append(live, line)
+	})
+	result, err := executor.Execute(ctx, agent.ToolCall{
+		ID: "log-1", Name: "kubernetes.get_pods", Arguments: "{}",
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.NotContains(t, result.ServerLog, "FAKE-DB-PASSWORD-NOT-REAL", "server log should be masked")
+	assert.Contains(t, result.ServerLog, "[MASKED_PASSWORD]")
+	assert.NotContains(t, result.ServerLog, "server started")
+	assert.Contains(t, result.ServerLog, "\nerror: forbidden")
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, live, 2)
+	assert.Contains(t, live[0], "[MASKED_PASSWORD]")
+	assert.Equal(t, "error: forbidden", live[1])
+}
+
+func TestToolExecutor_Execute_NoServerLogForRemoteServers(t *testing.T) {
+	executor := newTestExecutor(t, map[string]map[string]mcpsdk.ToolHandler{
+		"kubernetes": {
+			"get_pods": func(_ context.Context, _ *mcpsdk.CallToolRequest) (*mcpsdk.CallToolResult, error) {
+				return &mcpsdk.CallToolResult{Content: []mcpsdk.Content{&mcpsdk.TextContent{Text: "ok"}}}, nil
+			},
+		},
+	})
+
+	result, err := executor.Execute(context.Background(), agent.ToolCall{
+		ID: "log-2", Name: "kubernetes.get_pods", Arguments: "{}",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, result.ServerLog)
+}
@@ -0,0 +1,166 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/masking"
+)
+
+// fixtureInputSchema is the input schema of fixture tools that declare none.
+var fixtureInputSchema = json.RawMessage(`{"type":"object"}`)
+
+// ToolFixture is a canned MCP tool served by a sandbox run instead of the
+// real MCP server.
+type ToolFixture struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"input_schema,omitempty"` // JSON Schema; defaults to any object
+	Responses   []FixtureResult `json:"responses"`
+}
+
+// FixtureResult is one canned response of a fixture tool. A call gets the
+// first response whose Arguments all equal the call's arguments; a response
+// without Arguments matches any call.
+type FixtureResult struct {
+	Arguments map[string]any `json:"arguments,omitempty"`
+	Result    string         `json:"result,omitempty"`
+	Error     string         `json:"error,omitempty"` // returned as a tool error instead of Result
+}
+
+// ValidateFixtures checks that every fixture tool has a name and at least one
+// response, and that names are unique per server.
+func ValidateFixtures(fixtures map[string][]ToolFixture) error {
+	for serverID, tools := range fixtures {
+		seen := make(map[string]bool, len(tools))
+		for i, tool := range tools {
+			if tool.Name == "" {
+				return fmt.Errorf("server %q: tool %d has no name", serverID, i)
+			}
+			if seen[tool.Name] {
+				return fmt.Errorf("server %q: tool %q is defined more than once", serverID, tool.Name)
+			}
+			seen[tool.Name] = true
+			if len(tool.Responses) == 0 {
+				return fmt.Errorf("server %q: tool %q has no responses", serverID, tool.Name)
+			}
+			if len(tool.InputSchema) > 0 && !json.Valid(tool.InputSchema) {
+				return fmt.Errorf("server %q: tool %q has an invalid input_schema", serverID, tool.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// NewFixtureClientFactory creates a ClientFactory whose clients talk to
+// in-memory MCP servers serving the fixture tools instead of the configured
+// transports. fixtures maps server ID to its tools; requested servers
+// without fixtures are served with no tools. registry supplies the server
+// configuration used for tool filtering and result handling.
+// maskingService may be nil (masking disabled).
+func NewFixtureClientFactory(registry *config.MCPServerRegistry, maskingService *masking.Service, fixtures map[string][]ToolFixture) *ClientFactory {
+	return &ClientFactory{
+		registry:       registry,
+		maskingService: maskingService,
+		createClientFn: func(ctx context.Context, serverIDs []string) (*Client, error) {
+			c := newClient(registry)
+			for _, serverID := range serverIDs {
+				if err := injectFixtureServer(ctx, c, serverID, fixtures[serverID]); err != nil {
+					_ = c.Close()
+					return nil, err
+				}
+			}
+			return c, nil
+		},
+	}
+}
+
+// injectFixtureServer starts an in-memory MCP server serving tools and
+// connects c to it. The server stops when c closes the session.
+func injectFixtureServer(ctx context.Context, c *Client, serverID string, tools []ToolFixture) error {
+	server := mcpsdk.NewServer(&mcpsdk.Implementation{Name: serverID, Version: "sandbox"}, nil)
+	for _, tool := range tools {
+		schema := tool.InputSchema
+		if len(schema) == 0 {
+			schema = fixtureInputSchema
+		}
+		server.AddTool(&mcpsdk.Tool{
+			Name:        tool.Name,
+			Description: tool.Description,
+			InputSchema: schema,
+		}, fixtureHandler(tool))
+	}
+
+	clientTransport, serverTransport := mcpsdk.NewInMemoryTransports()
+	go func() { _ = server.Run(context.WithoutCancel(ctx), serverTransport) }()
+
+	sdkClient := mcpsdk.NewClient(&mcpsdk.Implementation{Name: "tarsy-sandbox", Version: "sandbox"}, nil)
+	session, err := sdkClient.Connect(ctx, clientTransport, nil)
+	if err != nil {
+		return fmt.Errorf("connect fixture server %q: %w", serverID, err)
+	}
+	c.InjectSession(serverID, sdkClient, session)
+	return nil
+}
+
+// fixtureHandler answers calls of tool from its canned responses.
+func fixtureHandler(tool ToolFixture) mcpsdk.ToolHandler {
+	return func(_ context.Context, req *mcpsdk.CallToolRequest) (*mcpsdk.CallToolResult, error) {
+		var args map[string]any
+		if len(req.Params.Arguments) > 0 {
+			if err := json.Unmarshal(req.Params.Arguments, &args); err != nil {
+				return fixtureError(fmt.Sprintf("invalid arguments: %v", err)), nil
+			}
+		}
+		for _, resp := range tool.Responses {
+			if !fixtureArgsMatch(resp.Arguments, args) {
+				continue
+			}
+			if resp.Error != "" {
+				return fixtureError(resp.Error), nil
+			}
+			return &mcpsdk.CallToolResult{
+				Content: []mcpsdk.Content{&mcpsdk.TextContent{Text: resp.Result}},
+			}, nil
+		}
+		return fixtureError(fmt.Sprintf("no fixture response of %s matches the arguments", tool.Name)), nil
+	}
+}
+
+// fixtureArgsMatch reports whether every expected argument equals the
+// call's. Both sides are compared in their JSON-decoded form.
+func fixtureArgsMatch(expected, actual map[string]any) bool {
+	for k, want := range expected {
+		got, ok := actual[k]
+		if !ok || !reflect.DeepEqual(normalizeJSON(want), got) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeJSON round-trips v through JSON so numbers and nested values
+// compare equal to decoded call arguments.
+func normalizeJSON(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return v
+	}
+	return out
+}
+
+func fixtureError(msg string) *mcpsdk.CallToolResult {
+	return &mcpsdk.CallToolResult{
+		Content: []mcpsdk.Content{&mcpsdk.TextContent{Text: msg}},
+		IsError: true,
+	}
+}
@@ -0,0 +1,125 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func fixtureText(t *testing.T, result *mcpsdk.CallToolResult) string {
+	t.Helper()
+	require.Len(t, result.Content, 1)
+	text, ok := result.Content[0].(*mcpsdk.TextContent)
+	require.True(t, ok)
+	return text.Text
+}
+
+func TestFixtureClientFactory(t *testing.T) {
+	registry := config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+		"kubernetes": {Transport: config.TransportConfig{Type: config.TransportTypeStdio, Command: "unused"}},
+		"github":     {Transport: config.TransportConfig{Type: config.TransportTypeStdio, Command: "unused"}},
+	})
+	factory := NewFixtureClientFactory(registry, nil, map[string][]ToolFixture{
+		"kubernetes": {{
+			Name:        "get_pods",
+			Description: "List pods",
+			Responses: []FixtureResult{
+				{Arguments: map[string]any{"namespace": "payments", "limit": 5}, Result: "payments-api CrashLoopBackOff"},
+				{Arguments: map[string]any{"namespace": "forbidden"}, Error: "pods is forbidden"},
+				{Result: "no pods"},
+			},
+		}},
+	})
+
+	ctx := context.Background()
+	client, err := factory.CreateClient(ctx, []string{"kubernetes", "github"})
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	tools, err := client.ListTools(ctx, "kubernetes")
+	require.NoError(t, err)
+	require.Len(t, tools, 1)
+	assert.Equal(t, "get_pods", tools[0].Name)
+	assert.Equal(t, "List pods", tools[0].Description)
+
+	// Servers without fixtures are connected but serve no tools
+	tools, err = client.ListTools(ctx, "github")
+	require.NoError(t, err)
+	assert.Empty(t, tools)
+
+	tests := []struct {
+		name      string
+		args      map[string]any
+		wantText  string
+		wantError bool
+	}{
+		{"matching arguments", map[string]any{"namespace": "payments", "limit": 5, "extra": true}, "payments-api CrashLoopBackOff", false},
+		{"error response", map[string]any{"namespace": "forbidden"}, "pods is forbidden", true},
+		{"fallback response", map[string]any{"namespace": "other"}, "no pods", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.CallTool(ctx, "kubernetes", "get_pods", tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantError, result.IsError)
+			assert.Equal(t, tt.wantText, fixtureText(t, result))
+		})
+	}
+}
+
+func TestFixtureClientFactory_NoMatchingResponse(t *testing.T) {
+	registry := config.NewMCPServerRegistry(map[string]*config.MCPServerConfig{
+		"kubernetes": {Transport: config.TransportConfig{Type: config.TransportTypeStdio, Command: "unused"}},
+	})
+	factory := NewFixtureClientFactory(registry, nil, map[string][]ToolFixture{
+		"kubernetes": {{
+			Name:      "get_pods",
+			Responses: []FixtureResult{{Arguments: map[string]any{"namespace": "payments"}, Result: "ok"}},
+		}},
+	})
+
+	ctx := context.Background()
+	client, err := factory.CreateClient(ctx, []string{"kubernetes"})
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	result, err := client.CallTool(ctx, "kubernetes", "get_pods", map[string]any{"namespace": "other"})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, fixtureText(t, result), "no fixture response of get_pods")
+}
+
+func TestValidateFixtures(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixtures map[string][]ToolFixture
+		wantErr  string
+	}{
+		{"valid", map[string][]ToolFixture{"k8s": {{Name: "get_pods", Responses: []FixtureResult{{Result: "x"}}}}}, ""},
+		{"missing name", map[string][]ToolFixture{"k8s": {{Responses: []FixtureResult{{Result: "x"}}}}}, "has no name"},
+		{"no responses", map[string][]ToolFixture{"k8s": {{Name: "get_pods"}}}, "has no responses"},
+		{"duplicate", map[string][]ToolFixture{"k8s": {
+			{Name: "get_pods", Responses: []FixtureResult{{Result: "x"}}},
+			{Name: "get_pods", Responses: []FixtureResult{{Result: "y"}}},
+		}}, "more than once"},
+		{"invalid schema", map[string][]ToolFixture{"k8s": {
+			{Name: "get_pods", InputSchema: []byte("{"), Responses: []FixtureResult{{Result: "x"}}},
+		}}, "invalid input_schema"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFixtures(tt.fixtures)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
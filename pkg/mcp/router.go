@@ -74,12 +74,12 @@ func plainToolMisroutedMessage(fullName, canonical string, k builtintools.Kind,
 	case builtintools.KindOrchestration:
 		if hadColonPrefix {
 			return fmt.Sprintf(
-				"invalid tool name %q: %q is an orchestration tool (dispatch/cancel/list sub-agents) — call it as %q only "+
+				"invalid tool name %q: %q is an orchestration tool (dispatch/cancel/list/poll sub-agents, report progress) — call it as %q only "+
 					"(no provider: or server: prefix). Do not change it to server.tool; that pattern is only for MCP server tools.",
 				fullName, canonical, canonical)
 		}
 		return fmt.Sprintf(
-			"invalid tool name %q: this is an orchestration tool (dispatch/cancel/list sub-agents), not an MCP tool — "+
+			"invalid tool name %q: this is an orchestration tool (dispatch/cancel/list/poll sub-agents, report progress), not an MCP tool — "+
 				"use the exact name %q with no prefix. MCP integrations use server.tool with one dot (e.g. %s)",
 			fullName, canonical, mcpExample)
 	case builtintools.KindSkill:
@@ -104,6 +104,39 @@ func plainToolMisroutedMessage(fullName, canonical string, k builtintools.Kind,
 			"invalid tool name %q: this is an investigation memory tool (recall or session search), not an MCP tool — "+
 				"use the exact name %q with no prefix. MCP integrations use server.tool with one dot (e.g. %s)",
 			fullName, canonical, mcpExample)
+	case builtintools.KindHighlight:
+		if hadColonPrefix {
+			return fmt.Sprintf(
+				"invalid tool name %q: %q is the key finding marker — call it as %q only "+
+					"(no provider: or server: prefix). Do not change it to server.tool; that pattern is only for MCP server tools.",
+				fullName, canonical, canonical)
+		}
+		return fmt.Sprintf(
+			"invalid tool name %q: this is the key finding marker, not an MCP tool — "+
+				"use the exact name %q with no prefix. MCP integrations use server.tool with one dot (e.g. %s)",
+			fullName, canonical, mcpExample)
+	case builtintools.KindLink:
+		if hadColonPrefix {
+			return fmt.Sprintf(
+				"invalid tool name %q: %q is the dashboard link builder — call it as %q only "+
+					"(no provider: or server: prefix). Do not change it to server.tool; that pattern is only for MCP server tools.",
+				fullName, canonical, canonical)
+		}
+		return fmt.Sprintf(
+			"invalid tool name %q: this is the dashboard link builder, not an MCP tool — "+
+				"use the exact name %q with no prefix. MCP integrations use server.tool with one dot (e.g. %s)",
+			fullName, canonical, mcpExample)
+	case builtintools.KindEnrichment:
+		if hadColonPrefix {
+			return fmt.Sprintf(
+				"invalid tool name %q: %q is a built-in IP / host lookup — call it as %q only "+
+					"(no provider: or server: prefix). Do not change it to server.tool; that pattern is only for MCP server tools.",
+				fullName, canonical, canonical)
+		}
+		return fmt.Sprintf(
+			"invalid tool name %q: this is a built-in IP / host lookup, not an MCP tool — "+
+				"use the exact name %q with no prefix. MCP integrations use server.tool with one dot (e.g. %s)",
+			fullName, canonical, mcpExample)
 	default:
 		return fmt.Sprintf(
 			"invalid tool name %q: MCP tools must be server.tool with one dot between server id and tool id (e.g. %s)",
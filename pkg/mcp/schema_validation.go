@@ -0,0 +1,124 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// supportedSchemaDialects are the $schema values the validator understands.
+// Tools declaring any other dialect are not validated client-side.
+var supportedSchemaDialects = []string{
+	"",
+	"http://json-schema.org/draft-07/schema#",
+	"https://json-schema.org/draft-07/schema#",
+	"https://json-schema.org/draft/2020-12/schema",
+}
+
+// ToolArgumentError reports tool arguments that do not satisfy the tool's
+// input schema. It is returned to the LLM as the tool result so the agent
+// can correct the call without a round-trip to the MCP server.
+type ToolArgumentError struct {
+	Tool   string // server.tool
+	Reason string // First schema violation found
+	Schema string // Tool's input schema (JSON), for self-correction
+}
+
+func (e *ToolArgumentError) Error() string {
+	msg := fmt.Sprintf("Invalid arguments for tool %q: %s\n"+
+		"The call was not sent to the MCP server. Fix the arguments to match the tool's input schema and retry.",
+		e.Tool, e.Reason)
+	if e.Schema != "" {
+		msg += "\nInput schema: " + e.Schema
+	}
+	return msg
+}
+
+// validateArguments checks params against the input schema advertised by
+// serverID for toolName. Validation fails open: a missing tool listing,
+// absent schema, or schema the validator cannot handle skips the check and
+// leaves enforcement to the server.
+func (e *ToolExecutor) validateArguments(ctx context.Context, serverID, toolName string, params map[string]any) *ToolArgumentError {
+	tools, err := e.client.ListTools(ctx, serverID)
+	if err != nil {
+		return nil
+	}
+	idx := slices.IndexFunc(tools, func(t *mcpsdk.Tool) bool { return t.Name == toolName })
+	if idx < 0 {
+		return nil
+	}
+	tool := tools[idx]
+
+	resolved := e.resolvedSchema(serverID, tool)
+	if resolved == nil {
+		return nil
+	}
+	if err := resolved.Validate(params); err != nil {
+		return &ToolArgumentError{
+			Tool:   serverID + "." + toolName,
+			Reason: validationReason(err),
+			Schema: marshalSchema(tool.InputSchema),
+		}
+	}
+	return nil
+}
+
+// resolvedSchema returns the resolved input schema for tool, resolving and
+// caching it on first use. Returns nil when the tool cannot be validated.
+// The cache is keyed by the *mcpsdk.Tool pointer, so a refreshed tool
+// listing (after InvalidateToolCache) is resolved again.
+func (e *ToolExecutor) resolvedSchema(serverID string, tool *mcpsdk.Tool) *jsonschema.Resolved {
+	e.schemasMu.Lock()
+	defer e.schemasMu.Unlock()
+
+	if resolved, ok := e.schemas[tool]; ok {
+		return resolved
+	}
+	resolved, err := resolveInputSchema(tool.InputSchema)
+	if err != nil {
+		slog.Debug("Skipping argument validation for MCP tool",
+			"server", serverID, "tool", tool.Name, "reason", err)
+	}
+	if e.schemas == nil {
+		e.schemas = make(map[*mcpsdk.Tool]*jsonschema.Resolved)
+	}
+	e.schemas[tool] = resolved
+	return resolved
+}
+
+// resolveInputSchema converts a tool's InputSchema (decoded JSON of unknown
+// shape) into a resolved JSON Schema. Returns (nil, nil) for tools without
+// a schema.
+func resolveInputSchema(schema any) (*jsonschema.Resolved, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("marshal input schema: %w", err)
+	}
+	var s jsonschema.Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("decode input schema: %w", err)
+	}
+	if !slices.Contains(supportedSchemaDialects, s.Schema) {
+		return nil, fmt.Errorf("unsupported schema dialect %q", s.Schema)
+	}
+	resolved, err := s.Resolve(nil)
+	if err != nil {
+		return nil, fmt.Errorf("resolve input schema: %w", err)
+	}
+	return resolved, nil
+}
+
+// validationReason strips the validator's "validating root: " wrapper so
+// the message starts at the offending property.
+func validationReason(err error) string {
+	return strings.TrimPrefix(err.Error(), "validating root: ")
+}
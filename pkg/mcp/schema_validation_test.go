@@ -0,0 +1,156 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+
+	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// newSchemaTestExecutor wires a single-tool in-memory server whose tool
+// declares inputSchema, and counts how often the server handler runs.
+func newSchemaTestExecutor(t *testing.T, inputSchema json.RawMessage) (*ToolExecutor, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := mcpsdk.NewServer(&mcpsdk.Implementation{Name: "kubernetes", Version: "test"}, nil)
+	server.AddTool(&mcpsdk.Tool{
+		Name:        "scale",
+		Description: "Scale a deployment",
+		InputSchema: inputSchema,
+	}, func(_ context.Context, _ *mcpsdk.CallToolRequest) (*mcpsdk.CallToolResult, error) {
+		calls.Add(1)
+		return &mcpsdk.CallToolResult{
+			Content: []mcpsdk.Content{&mcpsdk.TextContent{Text: "scaled"}},
+		}, nil
+	})
+
+	clientTransport, serverTransport := mcpsdk.NewInMemoryTransports()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = server.Run(ctx, serverTransport) }()
+
+	registry := config.NewMCPServerRegistry(nil)
+	client := newClient(registry)
+	sdkClient := mcpsdk.NewClient(&mcpsdk.Implementation{Name: "tarsy-test", Version: "test"}, nil)
+	session, err := sdkClient.Connect(context.Background(), clientTransport, nil)
+	require.NoError(t, err)
+	client.InjectSession("kubernetes", sdkClient, session)
+
+	executor := NewToolExecutor(client, registry, []string{"kubernetes"}, nil, nil)
+	t.Cleanup(func() { _ = executor.Close() })
+	return executor, &calls
+}
+
+var scaleSchema = json.RawMessage(`{
+	"type": "object",
+	"properties": {
+		"deployment": {"type": "string"},
+		"replicas": {"type": "integer", "minimum": 0}
+	},
+	"required": ["deployment", "replicas"],
+	"additionalProperties": false
+}`)
+
+func TestToolExecutor_Execute_SchemaValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		schema     json.RawMessage
+		arguments  string
+		wantError  bool
+		wantReason string
+	}{
+		{
+			name:      "valid arguments reach the server",
+			schema:    scaleSchema,
+			arguments: `{"deployment": "api", "replicas": 3}`,
+		},
+		{
+			name:       "missing required property",
+			schema:     scaleSchema,
+			arguments:  `{"deployment": "api"}`,
+			wantError:  true,
+			wantReason: "replicas",
+		},
+		{
+			name:       "wrong type",
+			schema:     scaleSchema,
+			arguments:  `{"deployment": "api", "replicas": "three"}`,
+			wantError:  true,
+			wantReason: "replicas",
+		},
+		{
+			name:       "unexpected property",
+			schema:     scaleSchema,
+			arguments:  `{"deployment": "api", "replicas": 3, "namespace": "prod"}`,
+			wantError:  true,
+			wantReason: "namespace",
+		},
+		{
+			name:      "unsupported dialect fails open",
+			schema:    json.RawMessage(`{"$schema": "http://json-schema.org/draft-04/schema#", "type": "object", "required": ["deployment"]}`),
+			arguments: `{}`,
+		},
+		{
+			name:      "unresolvable remote ref fails open",
+			schema:    json.RawMessage(`{"type": "object", "properties": {"spec": {"$ref": "https://example.com/spec.json"}}}`),
+			arguments: `{"spec": 1}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor, calls := newSchemaTestExecutor(t, tt.schema)
+
+			result, err := executor.Execute(context.Background(), agent.ToolCall{
+				ID:        "call-1",
+				Name:      "kubernetes.scale",
+				Arguments: tt.arguments,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "call-1", result.CallID)
+
+			if !tt.wantError {
+				assert.False(t, result.IsError, result.Content)
+				assert.Equal(t, "scaled", result.Content)
+				assert.Equal(t, int32(1), calls.Load())
+				return
+			}
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content, `Invalid arguments for tool "kubernetes.scale"`)
+			assert.Contains(t, result.Content, tt.wantReason)
+			assert.Contains(t, result.Content, "Input schema: ")
+			assert.Equal(t, int32(0), calls.Load(), "invalid call must not reach the server")
+		})
+	}
+}
+
+func TestToolExecutor_ResolvedSchemaCached(t *testing.T) {
+	executor, _ := newSchemaTestExecutor(t, scaleSchema)
+
+	tools, err := executor.client.ListTools(context.Background(), "kubernetes")
+	require.NoError(t, err)
+	require.Len(t, tools, 1)
+
+	first := executor.resolvedSchema("kubernetes", tools[0])
+	require.NotNil(t, first)
+	assert.Same(t, first, executor.resolvedSchema("kubernetes", tools[0]))
+}
+
+func TestToolArgumentError_Error(t *testing.T) {
+	err := &ToolArgumentError{Tool: "k8s.scale", Reason: "required: missing properties: [\"replicas\"]"}
+	assert.Equal(t,
+		"Invalid arguments for tool \"k8s.scale\": required: missing properties: [\"replicas\"]\n"+
+			"The call was not sent to the MCP server. Fix the arguments to match the tool's input schema and retry.",
+		err.Error())
+
+	err.Schema = `{"type":"object"}`
+	assert.Contains(t, err.Error(), "\nInput schema: {\"type\":\"object\"}")
+}
@@ -0,0 +1,178 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// Server log limits. Stdio MCP servers report failures (stack traces, auth
+// errors, missing binaries) on stderr; stdout carries the JSON-RPC stream.
+const (
+	// ServerLogMaxBytes caps the stderr kept per tool call. The tail is kept:
+	// the last lines before a failure are the useful ones.
+	ServerLogMaxBytes = 16 * 1024
+
+	// ServerLogMaxLineBytes cuts overlong lines (e.g. a dumped payload).
+	ServerLogMaxLineBytes = 2 * 1024
+
+	// ServerLogMaxLiveLines caps the lines streamed live per tool call, so a
+	// chatty server cannot flood the session's WebSocket channel.
+	ServerLogMaxLiveLines = 200
+)
+
+// serverLogSinkKey is the context key for the live server log sink.
+type serverLogSinkKey struct{}
+
+// WithServerLogSink returns a context whose tool calls deliver each stderr
+// line of the MCP server (masked) to sink while the call is in flight.
+// sink is called from the goroutine draining the server's stderr and must
+// not block for long.
+func WithServerLogSink(ctx context.Context, sink func(line string)) context.Context {
+	return context.WithValue(ctx, serverLogSinkKey{}, sink)
+}
+
+func serverLogSinkFrom(ctx context.Context) func(line string) {
+	sink, _ := ctx.Value(serverLogSinkKey{}).(func(line string))
+	return sink
+}
+
+// serverLog is the stderr of a stdio MCP server subprocess. It splits the
+// output into lines and hands each one to the tool calls in flight on the
+// server; lines written outside any call go to the pod log at debug level.
+// It outlives session recreation, so one serverLog serves every subprocess
+// started for the server by a Client.
+type serverLog struct {
+	serverID string
+
+	mu       sync.Mutex
+	partial  []byte
+	captures map[*logCapture]struct{}
+}
+
+func newServerLog(serverID string) *serverLog {
+	return &serverLog{serverID: serverID, captures: make(map[*logCapture]struct{})}
+}
+
+// Write implements io.Writer for exec.Cmd.Stderr.
+func (l *serverLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	var lines []string
+	l.partial = append(l.partial, p...)
+	for {
+		i := bytes.IndexByte(l.partial, '\n')
+		if i < 0 {
+			break
+		}
+		lines = append(lines, string(l.partial[:i]))
+		l.partial = l.partial[i+1:]
+	}
+	if len(l.partial) > ServerLogMaxLineBytes {
+		lines = append(lines, string(l.partial))
+		l.partial = nil
+	}
+	if len(l.partial) == 0 {
+		l.partial = nil // release the backing array between writes
+	}
+	captures := make([]*logCapture, 0, len(l.captures))
+	for c := range l.captures {
+		captures = append(captures, c)
+	}
+	l.mu.Unlock()
+
+	for _, line := range lines {
+		line = truncateLogLine(strings.TrimRight(line, "\r"))
+		if len(captures) == 0 {
+			slog.Debug("MCP server stderr", "server", l.serverID, "line", line)
+			continue
+		}
+		for _, c := range captures {
+			c.add(line)
+		}
+	}
+	return len(p), nil
+}
+
+// capture starts collecting the lines the server writes until stop is
+// called. Lines are also passed to onLine (nil = none) as they arrive.
+func (l *serverLog) capture(onLine func(line string)) *logCapture {
+	c := &logCapture{log: l, onLine: onLine}
+	l.mu.Lock()
+	l.captures[c] = struct{}{}
+	l.mu.Unlock()
+	return c
+}
+
+// logCapture collects a server's stderr for one tool call. Concurrent calls
+// on the same server each receive every line written while they overlap:
+// stderr carries no request IDs to attribute lines more precisely.
+type logCapture struct {
+	log    *serverLog
+	onLine func(line string)
+
+	mu      sync.Mutex
+	lines   []string
+	size    int
+	dropped int
+	live    int
+	stopped bool
+}
+
+func (c *logCapture) add(line string) {
+	c.mu.Lock()
+	if c.stopped {
+		c.mu.Unlock()
+		return
+	}
+	c.lines = append(c.lines, line)
+	c.size += len(line) + 1
+	for c.size > ServerLogMaxBytes && len(c.lines) > 1 {
+		c.size -= len(c.lines[0]) + 1
+		c.lines = c.lines[1:]
+		c.dropped++
+	}
+	stream := c.onLine != nil && c.live < ServerLogMaxLiveLines
+	if stream {
+		c.live++
+	}
+	c.mu.Unlock()
+
+	if stream {
+		c.onLine(line)
+	}
+}
+
+// stop detaches the capture and returns the collected log, oldest line
+// first, or "" when the server wrote nothing. Nil-safe (non-stdio servers
+// have no log). Lines the server writes just before its response may race
+// it and miss the capture.
+func (c *logCapture) stop() string {
+	if c == nil {
+		return ""
+	}
+	c.log.mu.Lock()
+	delete(c.log.captures, c)
+	c.log.mu.Unlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	if len(c.lines) == 0 {
+		return ""
+	}
+	log := strings.Join(c.lines, "\n")
+	if c.dropped > 0 {
+		log = fmt.Sprintf("[%d earlier lines omitted]\n%s", c.dropped, log)
+	}
+	return log
+}
+
+func truncateLogLine(line string) string {
+	if len(line) <= ServerLogMaxLineBytes {
+		return line
+	}
+	return line[:ServerLogMaxLineBytes] + " [truncated]"
+}
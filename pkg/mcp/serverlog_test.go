@@ -0,0 +1,80 @@
+package mcp
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerLog_CapturesLinesDuringCall(t *testing.T) {
+	l := newServerLog("kubernetes")
+	_, _ = l.Write([]byte("before\n"))
+
+	var live []string
+	c := l.capture(func(line string) { live = append(live, line) })
+	n, err := l.Write([]byte("first li"))
+	require.NoError(t, err)
+	assert.Equal(t, 8, n)
+	_, _ = l.Write([]byte("ne\r\nsecond\nthi"))
+	_, _ = l.Write([]byte("rd\n"))
+	log := c.stop()
+
+	_, _ = l.Write([]byte("after\n"))
+	assert.Equal(t, "first line\nsecond\nthird", log)
+	assert.Equal(t, []string{"first line", "second", "third"}, live)
+	assert.Empty(t, l.captures)
+}
+
+func TestServerLog_ConcurrentCaptures(t *testing.T) {
+	l := newServerLog("kubernetes")
+	a := l.capture(nil)
+	_, _ = l.Write([]byte("one\n"))
+	b := l.capture(nil)
+	_, _ = l.Write([]byte("two\n"))
+
+	assert.Equal(t, "one\ntwo", a.stop())
+	_, _ = l.Write([]byte("three\n"))
+	assert.Equal(t, "two\nthree", b.stop())
+}
+
+func TestServerLog_Limits(t *testing.T) {
+	l := newServerLog("kubernetes")
+	streamed := 0
+	c := l.capture(func(string) { streamed++ })
+
+	long := strings.Repeat("x", ServerLogMaxLineBytes+100)
+	_, _ = l.Write([]byte(long + "\n"))
+	line := strings.Repeat("y", 99)
+	for i := 0; i < 1000; i++ {
+		_, _ = l.Write([]byte(line + "\n"))
+	}
+	log := c.stop()
+
+	assert.LessOrEqual(t, len(log), ServerLogMaxBytes+64)
+	assert.True(t, strings.HasPrefix(log, "["), "dropped lines are reported")
+	assert.Contains(t, log, "earlier lines omitted]")
+	assert.True(t, strings.HasSuffix(log, "\n"+line), "the tail is kept")
+	assert.Equal(t, ServerLogMaxLiveLines, streamed)
+
+	// An unterminated line longer than the cap is flushed as is
+	c = l.capture(nil)
+	_, _ = l.Write([]byte(long))
+	assert.Equal(t, long[:ServerLogMaxLineBytes]+" [truncated]", c.stop())
+}
+
+func TestLogCapture_StopNil(t *testing.T) {
+	var c *logCapture
+	assert.Empty(t, c.stop())
+}
+
+func TestServerLogSink(t *testing.T) {
+	assert.Nil(t, serverLogSinkFrom(context.Background()))
+
+	var got string
+	ctx := WithServerLogSink(context.Background(), func(line string) { got = line })
+	serverLogSinkFrom(ctx)("hello")
+	assert.Equal(t, "hello", got)
+}
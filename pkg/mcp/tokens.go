@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"unicode/utf8"
+
+	"github.com/codeready-toolchain/tarsy/pkg/tokenizer"
 )
 
 // charsPerToken is the approximate number of characters per token for English text.
@@ -19,20 +21,16 @@ const DefaultStorageMaxTokens = 8000
 const DefaultSummarizationMaxTokens = 100000
 
 // EstimateTokens returns an approximate token count for the given text.
-// Uses the common heuristic of ~4 characters per token for English text.
-// This is intentionally approximate — exact counts would require a tokenizer
-// library and add a dependency for minimal benefit (the threshold is a
-// configurable soft limit, not a hard boundary).
+// Uses the common heuristic of ~4 characters per token for English text
+// (tokenizer.Heuristic). Where the model is known, count with its tokenizer
+// instead (tokenizer.ForProvider).
 //
 // Note: len(text) counts bytes, not Unicode characters. For multi-byte UTF-8
 // content (CJK, emoji), this overestimates the character count and therefore
 // the token count. This is a safe direction to err — summarization triggers
 // slightly earlier than necessary, which is preferable to missing it.
 func EstimateTokens(text string) int {
-	if len(text) == 0 {
-		return 0
-	}
-	return (len(text) + charsPerToken - 1) / charsPerToken // Round up
+	return tokenizer.Heuristic.Count(text)
 }
 
 // truncateAtLineBoundary is the shared truncation logic. It cuts at the last newline
@@ -83,5 +81,30 @@ func TruncateForStorage(content string) string {
 // Uses a larger limit than storage truncation to give the summarizer maximum data.
 func TruncateForSummarization(content string) string {
 	return truncateAtLineBoundary(content, DefaultSummarizationMaxTokens*charsPerToken,
-		"Output exceeded summarization input limit")
+		summarizationTruncationMarker)
+}
+
+const summarizationTruncationMarker = "Output exceeded summarization input limit"
+
+// TruncateForSummarizationTokens is TruncateForSummarization with the limit
+// counted by the summarization model's tokenizer. Content denser than ~4
+// characters per token (non-English text, minified JSON) can still exceed
+// DefaultSummarizationMaxTokens after the byte cut; it is cut further in
+// proportion to the overshoot.
+func TruncateForSummarizationTokens(content string, tok tokenizer.Tokenizer) string {
+	truncated := TruncateForSummarization(content)
+	if tok == nil || tok == tokenizer.Heuristic {
+		return truncated
+	}
+	maxChars := DefaultSummarizationMaxTokens * charsPerToken
+	for range 5 {
+		n := tok.Count(truncated)
+		if n <= DefaultSummarizationMaxTokens {
+			break
+		}
+		// Aim 5% under the limit so line-boundary cuts rarely need another pass.
+		maxChars = maxChars * DefaultSummarizationMaxTokens / n * 95 / 100
+		truncated = truncateAtLineBoundary(content, maxChars, summarizationTruncationMarker)
+	}
+	return truncated
 }
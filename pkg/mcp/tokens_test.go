@@ -6,6 +6,7 @@ import (
 	"testing"
 	"unicode/utf8"
 
+	"github.com/codeready-toolchain/tarsy/pkg/tokenizer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -193,3 +194,30 @@ func TestTruncateForSummarization(t *testing.T) {
 		assert.Equal(t, want, TruncateForSummarization(large))
 	})
 }
+
+// byteTokenizer counts one token per byte: content 4x denser than the heuristic.
+type byteTokenizer struct{}
+
+func (byteTokenizer) Name() string          { return "bytes" }
+func (byteTokenizer) Count(text string) int { return len(text) }
+
+func TestTruncateForSummarizationTokens(t *testing.T) {
+	t.Run("heuristic matches byte truncation", func(t *testing.T) {
+		large := strings.Repeat("x", DefaultSummarizationMaxTokens*charsPerToken+1000)
+		assert.Equal(t, TruncateForSummarization(large), TruncateForSummarizationTokens(large, tokenizer.Heuristic))
+		assert.Equal(t, TruncateForSummarization(large), TruncateForSummarizationTokens(large, nil))
+	})
+
+	t.Run("small content unchanged", func(t *testing.T) {
+		assert.Equal(t, "small result", TruncateForSummarizationTokens("small result", byteTokenizer{}))
+	})
+
+	t.Run("dense content cut to the token limit", func(t *testing.T) {
+		line := strings.Repeat("y", 99) + "\n"
+		large := strings.Repeat(line, 2*DefaultSummarizationMaxTokens/len(line))
+		result := TruncateForSummarizationTokens(large, byteTokenizer{})
+		assert.LessOrEqual(t, len(result), DefaultSummarizationMaxTokens)
+		assert.Greater(t, len(result), DefaultSummarizationMaxTokens*9/10)
+		assert.Contains(t, result, "[TRUNCATED: Output exceeded summarization input limit")
+	})
+}
@@ -1,11 +1,15 @@
 package mcp
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/exec"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
@@ -13,11 +17,13 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 )
 
-// createTransport creates an MCP SDK transport from config.
-func createTransport(cfg config.TransportConfig) (mcpsdk.Transport, error) {
+// createTransport creates an MCP SDK transport from config. stderr receives
+// the stderr of stdio server subprocesses (nil = discarded); other transports
+// ignore it.
+func createTransport(cfg config.TransportConfig, stderr io.Writer) (mcpsdk.Transport, error) {
 	switch cfg.Type {
 	case config.TransportTypeStdio:
-		return createStdioTransport(cfg)
+		return createStdioTransport(cfg, stderr)
 	case config.TransportTypeHTTP:
 		return createHTTPTransport(cfg)
 	case config.TransportTypeSSE:
@@ -27,7 +33,7 @@ func createTransport(cfg config.TransportConfig) (mcpsdk.Transport, error) {
 	}
 }
 
-func createStdioTransport(cfg config.TransportConfig) (*mcpsdk.CommandTransport, error) {
+func createStdioTransport(cfg config.TransportConfig, stderr io.Writer) (mcpsdk.Transport, error) {
 	if cfg.Command == "" {
 		return nil, fmt.Errorf("stdio transport requires command")
 	}
@@ -42,7 +48,56 @@ func createStdioTransport(cfg config.TransportConfig) (*mcpsdk.CommandTransport,
 	}
 	cmd.Env = env
 
-	return &mcpsdk.CommandTransport{Command: cmd}, nil
+	// stdout carries the JSON-RPC stream; stderr is the server's own log.
+	if stderr != nil {
+		cmd.Stderr = stderr
+	}
+
+	// TerminateDuration bounds how long Close waits for the subprocess after
+	// closing stdin (and again after SIGTERM) before escalating. This is what
+	// reaps servers that ignore a cancelled tool call.
+	return &countedTransport{Transport: &mcpsdk.CommandTransport{
+		Command:           cmd,
+		TerminateDuration: cfg.StdioCancelGracePeriod(),
+	}}, nil
+}
+
+// stdioProcesses counts stdio MCP server subprocesses started by this
+// process and not yet closed, across all Clients.
+var stdioProcesses atomic.Int64
+
+// StdioProcessCount returns the number of running stdio MCP server
+// subprocesses. Exposed through the runtime stats API to spot leaked
+// servers on long-lived pods.
+func StdioProcessCount() int {
+	return int(stdioProcesses.Load())
+}
+
+// countedTransport wraps a stdio transport to maintain stdioProcesses.
+type countedTransport struct {
+	mcpsdk.Transport
+}
+
+func (t *countedTransport) Connect(ctx context.Context) (mcpsdk.Connection, error) {
+	conn, err := t.Transport.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stdioProcesses.Add(1)
+	return &countedConnection{Connection: conn}, nil
+}
+
+// countedConnection decrements stdioProcesses once when the connection (and
+// with it the subprocess) is closed.
+type countedConnection struct {
+	mcpsdk.Connection
+	once sync.Once
+}
+
+func (c *countedConnection) Close() error {
+	err := c.Connection.Close()
+	c.once.Do(func() { stdioProcesses.Add(-1) })
+	return err
 }
 
 func createHTTPTransport(cfg config.TransportConfig) (*mcpsdk.StreamableClientTransport, error) {
@@ -52,7 +107,7 @@ func createHTTPTransport(cfg config.TransportConfig) (*mcpsdk.StreamableClientTr
 	transport := &mcpsdk.StreamableClientTransport{
 		Endpoint: cfg.URL,
 	}
-	if cfg.BearerToken != "" || cfg.VerifySSL != nil || cfg.Timeout > 0 {
+	if cfg.BearerToken != "" || len(cfg.Headers) > 0 || cfg.VerifySSL != nil || cfg.Timeout > 0 {
 		transport.HTTPClient = buildHTTPClient(cfg)
 	}
 	return transport, nil
@@ -65,13 +120,13 @@ func createSSETransport(cfg config.TransportConfig) (*mcpsdk.SSEClientTransport,
 	transport := &mcpsdk.SSEClientTransport{
 		Endpoint: cfg.URL,
 	}
-	if cfg.BearerToken != "" || cfg.VerifySSL != nil || cfg.Timeout > 0 {
+	if cfg.BearerToken != "" || len(cfg.Headers) > 0 || cfg.VerifySSL != nil || cfg.Timeout > 0 {
 		transport.HTTPClient = buildHTTPClient(cfg)
 	}
 	return transport, nil
 }
 
-// buildHTTPClient creates an http.Client with auth, TLS, and timeout settings.
+// buildHTTPClient creates an http.Client with auth, headers, TLS, and timeout settings.
 func buildHTTPClient(cfg config.TransportConfig) *http.Client {
 	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
 
@@ -87,6 +142,14 @@ func buildHTTPClient(cfg config.TransportConfig) *http.Client {
 		Transport: httpTransport,
 	}
 
+	// Static headers via round-tripper wrapper
+	if len(cfg.Headers) > 0 {
+		client.Transport = &headerTransport{
+			base:    client.Transport,
+			headers: cfg.Headers,
+		}
+	}
+
 	// Bearer token via round-tripper wrapper
 	if cfg.BearerToken != "" {
 		client.Transport = &bearerTokenTransport{
@@ -114,3 +177,17 @@ func (t *bearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, err
 	req.Header.Set("Authorization", "Bearer "+t.token)
 	return t.base.RoundTrip(req)
 }
+
+// headerTransport wraps an http.RoundTripper to add configured headers.
+type headerTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	return t.base.RoundTrip(req)
+}
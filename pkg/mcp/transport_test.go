@@ -1,7 +1,12 @@
 package mcp
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	mcpsdk "github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/stretchr/testify/assert"
@@ -18,11 +23,10 @@ func TestCreateTransport_Stdio(t *testing.T) {
 		Env:     map[string]string{"KUBECONFIG": "/home/test/.kube/config"},
 	}
 
-	transport, err := createTransport(cfg)
+	transport, err := createTransport(cfg, nil)
 	require.NoError(t, err)
 
-	cmdTransport, ok := transport.(*mcpsdk.CommandTransport)
-	require.True(t, ok)
+	cmdTransport := commandTransport(t, transport)
 	// exec.Command resolves the full path, so check Args[0] for the basename
 	assert.Contains(t, cmdTransport.Command.Path, "npx")
 	assert.Contains(t, cmdTransport.Command.Args, "-y")
@@ -39,12 +43,84 @@ func TestCreateTransport_Stdio(t *testing.T) {
 	assert.True(t, found, "expected KUBECONFIG env override in command environment")
 }
 
+func TestCreateTransport_Stdio_Stderr(t *testing.T) {
+	cfg := config.TransportConfig{Type: config.TransportTypeStdio, Command: "npx"}
+
+	transport, err := createTransport(cfg, nil)
+	require.NoError(t, err)
+	assert.Nil(t, commandTransport(t, transport).Command.Stderr)
+
+	stderr := newServerLog("kubernetes")
+	transport, err = createTransport(cfg, stderr)
+	require.NoError(t, err)
+	assert.Same(t, stderr, commandTransport(t, transport).Command.Stderr)
+}
+
+func TestCreateTransport_Stdio_CancelGracePeriod(t *testing.T) {
+	transport, err := createTransport(config.TransportConfig{
+		Type:    config.TransportTypeStdio,
+		Command: "npx",
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, config.DefaultStdioCancelGracePeriod, commandTransport(t, transport).TerminateDuration)
+
+	transport, err = createTransport(config.TransportConfig{
+		Type:              config.TransportTypeStdio,
+		Command:           "npx",
+		CancelGracePeriod: 12,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 12*time.Second, commandTransport(t, transport).TerminateDuration)
+}
+
+// commandTransport unwraps the subprocess-counting wrapper around stdio transports.
+func commandTransport(t *testing.T, transport mcpsdk.Transport) *mcpsdk.CommandTransport {
+	t.Helper()
+	counted, ok := transport.(*countedTransport)
+	require.True(t, ok)
+	cmdTransport, ok := counted.Transport.(*mcpsdk.CommandTransport)
+	require.True(t, ok)
+	return cmdTransport
+}
+
+type fakeTransport struct{ err error }
+
+func (f fakeTransport) Connect(context.Context) (mcpsdk.Connection, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return fakeConnection{}, nil
+}
+
+type fakeConnection struct{ mcpsdk.Connection }
+
+func (fakeConnection) Close() error { return nil }
+
+func TestCountedTransport_TracksStdioProcesses(t *testing.T) {
+	before := StdioProcessCount()
+
+	conn1, err := (&countedTransport{Transport: fakeTransport{}}).Connect(context.Background())
+	require.NoError(t, err)
+	conn2, err := (&countedTransport{Transport: fakeTransport{}}).Connect(context.Background())
+	require.NoError(t, err)
+	_, err = (&countedTransport{Transport: fakeTransport{err: errors.New("spawn failed")}}).Connect(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, before+2, StdioProcessCount())
+
+	require.NoError(t, conn1.Close())
+	require.NoError(t, conn1.Close(), "double close must not decrement twice")
+	assert.Equal(t, before+1, StdioProcessCount())
+
+	require.NoError(t, conn2.Close())
+	assert.Equal(t, before, StdioProcessCount())
+}
+
 func TestCreateTransport_Stdio_MissingCommand(t *testing.T) {
 	cfg := config.TransportConfig{
 		Type: config.TransportTypeStdio,
 	}
 
-	_, err := createTransport(cfg)
+	_, err := createTransport(cfg, nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "requires command")
 }
@@ -55,7 +131,7 @@ func TestCreateTransport_HTTP(t *testing.T) {
 		URL:  "https://mcp.example.com/v1",
 	}
 
-	transport, err := createTransport(cfg)
+	transport, err := createTransport(cfg, nil)
 	require.NoError(t, err)
 
 	httpTransport, ok := transport.(*mcpsdk.StreamableClientTransport)
@@ -72,7 +148,7 @@ func TestCreateTransport_HTTP_WithAuth(t *testing.T) {
 		Timeout:     30,
 	}
 
-	transport, err := createTransport(cfg)
+	transport, err := createTransport(cfg, nil)
 	require.NoError(t, err)
 
 	httpTransport, ok := transport.(*mcpsdk.StreamableClientTransport)
@@ -80,12 +156,39 @@ func TestCreateTransport_HTTP_WithAuth(t *testing.T) {
 	assert.NotNil(t, httpTransport.HTTPClient)
 }
 
+func TestCreateTransport_HTTP_WithHeaders(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+	}))
+	defer srv.Close()
+
+	cfg := config.TransportConfig{
+		Type:        config.TransportTypeHTTP,
+		URL:         srv.URL,
+		BearerToken: "my-token",
+		Headers:     map[string]string{"X-Cluster": "prod-east"},
+	}
+
+	transport, err := createTransport(cfg, nil)
+	require.NoError(t, err)
+
+	httpTransport, ok := transport.(*mcpsdk.StreamableClientTransport)
+	require.True(t, ok)
+	require.NotNil(t, httpTransport.HTTPClient)
+	resp, err := httpTransport.HTTPClient.Get(srv.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, "prod-east", got.Get("X-Cluster"))
+	assert.Equal(t, "Bearer my-token", got.Get("Authorization"))
+}
+
 func TestCreateTransport_HTTP_MissingURL(t *testing.T) {
 	cfg := config.TransportConfig{
 		Type: config.TransportTypeHTTP,
 	}
 
-	_, err := createTransport(cfg)
+	_, err := createTransport(cfg, nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "requires url")
 }
@@ -96,7 +199,7 @@ func TestCreateTransport_SSE(t *testing.T) {
 		URL:  "https://mcp.example.com/sse",
 	}
 
-	transport, err := createTransport(cfg)
+	transport, err := createTransport(cfg, nil)
 	require.NoError(t, err)
 
 	sseTransport, ok := transport.(*mcpsdk.SSEClientTransport)
@@ -109,7 +212,7 @@ func TestCreateTransport_SSE_MissingURL(t *testing.T) {
 		Type: config.TransportTypeSSE,
 	}
 
-	_, err := createTransport(cfg)
+	_, err := createTransport(cfg, nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "requires url")
 }
@@ -119,7 +222,7 @@ func TestCreateTransport_UnknownType(t *testing.T) {
 		Type: "grpc",
 	}
 
-	_, err := createTransport(cfg)
+	_, err := createTransport(cfg, nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "unsupported transport type")
 }
@@ -132,7 +235,7 @@ func TestCreateTransport_SSE_WithVerifySSLFalse(t *testing.T) {
 		VerifySSL: &verifySSL,
 	}
 
-	transport, err := createTransport(cfg)
+	transport, err := createTransport(cfg, nil)
 	require.NoError(t, err)
 
 	sseTransport, ok := transport.(*mcpsdk.SSEClientTransport)
@@ -3,6 +3,8 @@ package memory
 import (
 	"encoding/json"
 	"strings"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
 )
 
 // ParseReflectorResponse extracts a ReflectorResult from potentially messy LLM output.
@@ -28,7 +30,7 @@ func ParseReflectorResponse(raw string) (*ReflectorResult, bool) {
 	}
 
 	// 3. Extract JSON object by bracket depth.
-	if extracted := extractJSONObject(raw); extracted != "" {
+	if extracted := controller.ExtractJSONObject(raw); extracted != "" {
 		if result, ok := tryUnmarshal(extracted); ok {
 			return result, true
 		}
@@ -70,42 +72,3 @@ func stripMarkdownFences(s string) string {
 	}
 	return strings.Join(out, "\n")
 }
-
-func extractJSONObject(s string) string {
-	start := strings.IndexByte(s, '{')
-	if start == -1 {
-		return ""
-	}
-
-	depth := 0
-	inString := false
-	escaped := false
-	for i := start; i < len(s); i++ {
-		ch := s[i]
-		if escaped {
-			escaped = false
-			continue
-		}
-		if ch == '\\' && inString {
-			escaped = true
-			continue
-		}
-		if ch == '"' {
-			inString = !inString
-			continue
-		}
-		if inString {
-			continue
-		}
-		switch ch {
-		case '{':
-			depth++
-		case '}':
-			depth--
-			if depth == 0 {
-				return s[start : i+1]
-			}
-		}
-	}
-	return ""
-}
@@ -14,6 +14,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/ent/stage"
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
 	"github.com/google/uuid"
 )
 
@@ -912,8 +913,12 @@ func (s *Service) FetchChatExchanges(ctx context.Context, sessionIDs []string) (
 		}
 
 		// Chat stages always have exactly one agent execution.
+		events := execs[0].Edges.TimelineEvents
+		if err := services.DecompressTimelineEvents(events...); err != nil {
+			return nil, err
+		}
 		var ce ChatExchange
-		for _, evt := range execs[0].Edges.TimelineEvents {
+		for _, evt := range events {
 			switch evt.EventType {
 			case timelineevent.EventTypeUserQuestion:
 				ce.Question = evt.Content
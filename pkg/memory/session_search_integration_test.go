@@ -3,13 +3,18 @@ package memory_test
 import (
 	stdsql "database/sql"
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/memory"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
 	"github.com/codeready-toolchain/tarsy/test/util"
 	"github.com/google/uuid"
 	_ "github.com/jackc/pgx/v5/stdlib"
@@ -431,6 +436,43 @@ func TestFetchChatExchanges_WithChatStages(t *testing.T) {
 	assert.Equal(t, "No deployments found in the last 24h", exchanges[sessionID][1].Answer)
 }
 
+func TestFetchChatExchanges_CompressedAnswer(t *testing.T) {
+	env := newSessionSearchEnv(t)
+	ctx := t.Context()
+
+	sessionID := env.createSession(t, "Alert: pod crash looping in payments", "kubernetes", "completed", nil)
+	stageID := env.createChatStage(t, sessionID, 1, "Why is the pod crashing?", "placeholder")
+	exec, err := env.entClient.AgentExecution.Query().Where(agentexecution.StageIDEQ(stageID)).Only(ctx)
+	require.NoError(t, err)
+
+	// Answers at or above the compression threshold are stored in content_zstd
+	answer := strings.Repeat("The container exceeds its memory limit. ", 400)
+	_, err = env.entClient.TimelineEvent.Delete().
+		Where(timelineevent.ExecutionIDEQ(exec.ID), timelineevent.EventTypeEQ(timelineevent.EventTypeFinalAnalysis)).
+		Exec(ctx)
+	require.NoError(t, err)
+	stored, err := services.NewTimelineService(env.entClient).CreateTimelineEvent(ctx, models.CreateTimelineEventRequest{
+		SessionID:      sessionID,
+		StageID:        &stageID,
+		ExecutionID:    &exec.ID,
+		SequenceNumber: 2,
+		EventType:      timelineevent.EventTypeFinalAnalysis,
+		Status:         timelineevent.StatusCompleted,
+		Content:        answer,
+	})
+	require.NoError(t, err)
+	raw, err := env.entClient.TimelineEvent.Get(ctx, stored.ID)
+	require.NoError(t, err)
+	require.NotNil(t, raw.ContentZstd)
+	require.Empty(t, raw.Content)
+
+	exchanges, err := env.svc.FetchChatExchanges(ctx, []string{sessionID})
+	require.NoError(t, err)
+	require.Len(t, exchanges[sessionID], 1)
+	assert.Equal(t, "Why is the pod crashing?", exchanges[sessionID][0].Question)
+	assert.Equal(t, answer, exchanges[sessionID][0].Answer)
+}
+
 func TestFetchChatExchanges_NoChatStages(t *testing.T) {
 	env := newSessionSearchEnv(t)
 	ctx := t.Context()
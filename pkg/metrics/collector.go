@@ -27,6 +27,7 @@ type ActionOutcomeRow struct {
 // SessionCounter abstracts the DB queries needed for gauge polling.
 type SessionCounter interface {
 	PendingCount(ctx context.Context) (int, error)
+	OldestPendingAt(ctx context.Context) (*time.Time, error) // nil when nothing is pending
 	ActiveCount(ctx context.Context) (int, error)
 	ReviewCountsByRating(ctx context.Context) (ReviewCounts, error)
 	ActionOutcomesByAgent(ctx context.Context) ([]ActionOutcomeRow, error)
@@ -95,6 +96,14 @@ func (g *GaugeCollector) poll(ctx context.Context) {
 		SessionsQueued.Set(float64(n))
 	}
 
+	if oldest, err := g.counter.OldestPendingAt(ctx); err != nil {
+		slog.Warn("metrics: failed to poll oldest pending session", "error", err)
+	} else if oldest == nil {
+		QueueOldestPendingSeconds.Set(0)
+	} else {
+		QueueOldestPendingSeconds.Set(time.Since(*oldest).Seconds())
+	}
+
 	if rc, err := g.counter.ReviewCountsByRating(ctx); err != nil {
 		slog.Warn("metrics: failed to poll review counts", "error", err)
 	} else {
@@ -15,6 +15,7 @@ type stubCounter struct {
 	active, pending int
 	activeErr       error
 	pendingErr      error
+	oldestPending   *time.Time
 	review          ReviewCounts
 	reviewErr       error
 	actionOutcomes  []ActionOutcomeRow
@@ -29,6 +30,10 @@ func (s *stubCounter) PendingCount(context.Context) (int, error) {
 	return s.pending, s.pendingErr
 }
 
+func (s *stubCounter) OldestPendingAt(context.Context) (*time.Time, error) {
+	return s.oldestPending, nil
+}
+
 func (s *stubCounter) ReviewCountsByRating(context.Context) (ReviewCounts, error) {
 	return s.review, s.reviewErr
 }
@@ -61,6 +66,17 @@ func TestGaugeCollector_Poll(t *testing.T) {
 		assert.Equal(t, float64(3), testutil.ToFloat64(ActionStageOutcomesTotal.WithLabelValues("RemediationAgent", "no")))
 	})
 
+	t.Run("oldest pending age", func(t *testing.T) {
+		oldest := time.Now().Add(-20 * time.Minute)
+		gc := NewGaugeCollector(&stubCounter{oldestPending: &oldest})
+		gc.poll(t.Context())
+		assert.InDelta(t, 1200, testutil.ToFloat64(QueueOldestPendingSeconds), 5)
+
+		gc = NewGaugeCollector(&stubCounter{})
+		gc.poll(t.Context())
+		assert.Equal(t, float64(0), testutil.ToFloat64(QueueOldestPendingSeconds))
+	})
+
 	t.Run("active error leaves gauge unchanged", func(t *testing.T) {
 		SessionsActive.Set(99)
 		sc := &stubCounter{activeErr: fmt.Errorf("db down"), pending: 5}
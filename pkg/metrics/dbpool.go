@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Database pool metrics, read from sql.DBStats at scrape time.
+var (
+	dbPoolMaxOpenDesc = prometheus.NewDesc("tarsy_db_pool_max_open_connections",
+		"Configured maximum open connections of the pool.", []string{"pool"}, nil)
+	dbPoolOpenDesc = prometheus.NewDesc("tarsy_db_pool_open_connections",
+		"Open connections of the pool (in use + idle).", []string{"pool"}, nil)
+	dbPoolInUseDesc = prometheus.NewDesc("tarsy_db_pool_in_use_connections",
+		"Connections of the pool currently in use.", []string{"pool"}, nil)
+	dbPoolIdleDesc = prometheus.NewDesc("tarsy_db_pool_idle_connections",
+		"Idle connections of the pool.", []string{"pool"}, nil)
+	dbPoolUtilizationDesc = prometheus.NewDesc("tarsy_db_pool_utilization",
+		"Connections in use as a fraction of the pool's maximum (0 when unbounded).", []string{"pool"}, nil)
+	dbPoolWaitsDesc = prometheus.NewDesc("tarsy_db_pool_waits_total",
+		"Times a caller waited for a free connection.", []string{"pool"}, nil)
+	dbPoolWaitSecondsDesc = prometheus.NewDesc("tarsy_db_pool_wait_seconds_total",
+		"Total time callers waited for a free connection.", []string{"pool"}, nil)
+)
+
+// DBStatser is the subset of *sql.DB read by the pool collector.
+type DBStatser interface {
+	Stats() sql.DBStats
+}
+
+// dbPoolCollector exports the statistics of one connection pool.
+type dbPoolCollector struct {
+	pool string
+	db   DBStatser
+}
+
+// RegisterDBPool exports the statistics of the named connection pool.
+func RegisterDBPool(pool string, db DBStatser) {
+	prometheus.MustRegister(&dbPoolCollector{pool: pool, db: db})
+}
+
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- dbPoolMaxOpenDesc
+	ch <- dbPoolOpenDesc
+	ch <- dbPoolInUseDesc
+	ch <- dbPoolIdleDesc
+	ch <- dbPoolUtilizationDesc
+	ch <- dbPoolWaitsDesc
+	ch <- dbPoolWaitSecondsDesc
+}
+
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.db.Stats()
+	utilization := 0.0
+	if s.MaxOpenConnections > 0 {
+		utilization = float64(s.InUse) / float64(s.MaxOpenConnections)
+	}
+	ch <- prometheus.MustNewConstMetric(dbPoolMaxOpenDesc, prometheus.GaugeValue, float64(s.MaxOpenConnections), c.pool)
+	ch <- prometheus.MustNewConstMetric(dbPoolOpenDesc, prometheus.GaugeValue, float64(s.OpenConnections), c.pool)
+	ch <- prometheus.MustNewConstMetric(dbPoolInUseDesc, prometheus.GaugeValue, float64(s.InUse), c.pool)
+	ch <- prometheus.MustNewConstMetric(dbPoolIdleDesc, prometheus.GaugeValue, float64(s.Idle), c.pool)
+	ch <- prometheus.MustNewConstMetric(dbPoolUtilizationDesc, prometheus.GaugeValue, utilization, c.pool)
+	ch <- prometheus.MustNewConstMetric(dbPoolWaitsDesc, prometheus.CounterValue, float64(s.WaitCount), c.pool)
+	ch <- prometheus.MustNewConstMetric(dbPoolWaitSecondsDesc, prometheus.CounterValue, s.WaitDuration.Seconds(), c.pool)
+}
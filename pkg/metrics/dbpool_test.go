@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeDBStats sql.DBStats
+
+func (f fakeDBStats) Stats() sql.DBStats { return sql.DBStats(f) }
+
+func TestDBPoolCollector(t *testing.T) {
+	c := &dbPoolCollector{pool: "api", db: fakeDBStats{
+		MaxOpenConnections: 10,
+		OpenConnections:    6,
+		InUse:              4,
+		Idle:               2,
+		WaitCount:          3,
+		WaitDuration:       1500 * time.Millisecond,
+	}}
+
+	expected := `
+# HELP tarsy_db_pool_in_use_connections Connections of the pool currently in use.
+# TYPE tarsy_db_pool_in_use_connections gauge
+tarsy_db_pool_in_use_connections{pool="api"} 4
+# HELP tarsy_db_pool_utilization Connections in use as a fraction of the pool's maximum (0 when unbounded).
+# TYPE tarsy_db_pool_utilization gauge
+tarsy_db_pool_utilization{pool="api"} 0.4
+# HELP tarsy_db_pool_wait_seconds_total Total time callers waited for a free connection.
+# TYPE tarsy_db_pool_wait_seconds_total counter
+tarsy_db_pool_wait_seconds_total{pool="api"} 1.5
+# HELP tarsy_db_pool_waits_total Times a caller waited for a free connection.
+# TYPE tarsy_db_pool_waits_total counter
+tarsy_db_pool_waits_total{pool="api"} 3
+`
+	assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(expected),
+		"tarsy_db_pool_in_use_connections", "tarsy_db_pool_utilization",
+		"tarsy_db_pool_wait_seconds_total", "tarsy_db_pool_waits_total"))
+	assert.Equal(t, 7, testutil.CollectAndCount(c))
+
+	t.Run("unbounded pool reports no utilization", func(t *testing.T) {
+		c := &dbPoolCollector{pool: "main", db: fakeDBStats{InUse: 4}}
+		assert.NoError(t, testutil.CollectAndCompare(c, strings.NewReader(`
+# HELP tarsy_db_pool_utilization Connections in use as a fraction of the pool's maximum (0 when unbounded).
+# TYPE tarsy_db_pool_utilization gauge
+tarsy_db_pool_utilization{pool="main"} 0
+`), "tarsy_db_pool_utilization"))
+	})
+}
@@ -15,6 +15,7 @@ var (
 	LLMBuckets     = []float64{1, 2, 5, 10, 20, 30, 60, 90, 120, 180}
 	MCPBuckets     = []float64{0.1, 0.25, 0.5, 1, 2, 5, 10, 30, 60}
 	SessionBuckets = []float64{30, 60, 120, 180, 300, 600, 900, 1200, 1800, 2400}
+	LockBuckets    = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 5}
 )
 
 // Session lifecycle metrics.
@@ -50,6 +51,21 @@ var (
 		Name: "tarsy_sessions_queued",
 		Help: "Pending sessions (DB-polled).",
 	})
+
+	TimeWarningsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tarsy_time_warnings_total",
+		Help: "Stages or agent executions that crossed their soft time_warnings threshold.",
+	}, []string{"kind"})
+
+	ModelRoutingDecisionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tarsy_model_routing_decisions_total",
+		Help: "Sessions routed by the complexity router, by tier (fast, strong, unrouted).",
+	}, []string{"tier"})
+
+	NoiseTriageVerdictsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tarsy_noise_triage_verdicts_total",
+		Help: "Alerts classified by the noise triage pre-chain, by verdict (actionable, false_positive, duplicate, unclassified) and whether the chain was skipped.",
+	}, []string{"alert_type", "verdict", "skipped"})
 )
 
 // Worker pool metrics.
@@ -68,6 +84,37 @@ var (
 		Name: "tarsy_orphans_recovered_total",
 		Help: "Orphaned sessions recovered.",
 	})
+
+	ClaimsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tarsy_queue_claims_total",
+		Help: "Sessions claimed from the queue, by pod.",
+	}, []string{"pod_id"})
+
+	ClaimRoundsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tarsy_queue_claim_rounds_total",
+		Help: "Claim transactions run, by result (claimed, empty, at_capacity).",
+	}, []string{"result"})
+
+	ClaimLockWaitSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "tarsy_queue_claim_lock_wait_seconds",
+		Help:    "Time a claim round waited for the cluster-wide claim lock.",
+		Buckets: LockBuckets,
+	})
+
+	ClaimsReleasedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tarsy_queue_claims_released_total",
+		Help: "Session claims ended, by outcome (terminal status, orphaned, pod_restarted).",
+	}, []string{"outcome"})
+
+	SessionsPreemptedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tarsy_queue_sessions_preempted_total",
+		Help: "In-flight sessions preempted by the backlog preemption policy.",
+	}, []string{"alert_type"})
+
+	QueueOldestPendingSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "tarsy_queue_oldest_pending_seconds",
+		Help: "Age of the oldest pending session, 0 when the queue is empty (DB-polled).",
+	})
 )
 
 // LLM call metrics.
@@ -162,6 +209,26 @@ var WSConnectionsActive = promauto.NewGauge(prometheus.GaugeOpts{
 	Help: "Active WebSocket connections.",
 })
 
+// EventStreamEventsTotal counts lifecycle events handed to the event stream.
+var EventStreamEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tarsy_event_stream_events_total",
+	Help: "Lifecycle events published to the event stream, by event and result (delivered, failed, dropped).",
+}, []string{"event", "result"})
+
+// FaultInjectionsTotal counts failures injected by the fault injection
+// facility (test and staging only).
+var FaultInjectionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tarsy_fault_injections_total",
+	Help: "Simulated failures injected, by fault (mcp_timeout, mcp_malformed, llm_rate_limit).",
+}, []string{"fault"})
+
+// HeapProfilesCapturedTotal counts heap profiles written automatically
+// because process RSS crossed system.profiling.heap_capture.rss_threshold_mb.
+var HeapProfilesCapturedTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "tarsy_heap_profiles_captured_total",
+	Help: "Heap profiles captured automatically after RSS crossed the configured threshold.",
+})
+
 // LLMTokens carries token counts without importing pkg/agent.
 type LLMTokens struct {
 	Input, Output, Thinking int
@@ -186,11 +253,23 @@ func ObserveLLMCall(provider, model string, duration time.Duration, tokens *LLMT
 	}
 }
 
+// categorizedError is implemented by errors that carry their own bounded
+// classification (e.g. "stalled_stream" from the LLM streaming watchdog).
+type categorizedError interface {
+	ErrorCategory() string
+}
+
 // errorCode extracts a short, bounded classification from an error.
 func errorCode(err error) string {
 	if err == nil {
 		return ""
 	}
+	var ce categorizedError
+	if errors.As(err, &ce) {
+		if category := ce.ErrorCategory(); category != "" {
+			return category
+		}
+	}
 	switch {
 	case errors.Is(err, context.DeadlineExceeded):
 		return "timeout"
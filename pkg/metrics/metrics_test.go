@@ -67,6 +67,11 @@ func TestObserveLLMCall(t *testing.T) {
 	})
 }
 
+type categoryErr string
+
+func (e categoryErr) Error() string         { return "categorized: " + string(e) }
+func (e categoryErr) ErrorCategory() string { return string(e) }
+
 func TestErrorCode(t *testing.T) {
 	tests := []struct {
 		name string
@@ -79,6 +84,9 @@ func TestErrorCode(t *testing.T) {
 		{"wrapped deadline", fmt.Errorf("call failed: %w", context.DeadlineExceeded), "timeout"},
 		{"wrapped canceled", fmt.Errorf("call failed: %w", context.Canceled), "canceled"},
 		{"generic error", fmt.Errorf("something broke"), "error"},
+		{"categorized error", categoryErr("stalled_stream"), "stalled_stream"},
+		{"wrapped categorized error", fmt.Errorf("call failed: %w", categoryErr("stalled_stream")), "stalled_stream"},
+		{"empty category falls through", categoryErr(""), "error"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,219 @@
+// Package milestone sends early notifications as a session reaches
+// intermediate milestones (first tool call, stage completion, final
+// analysis), for chains that opt in via milestone_notifications.
+package milestone
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	tarsyslack "github.com/codeready-toolchain/tarsy/pkg/slack"
+)
+
+const deliveryTimeout = 10 * time.Second
+
+// Payload is the JSON body POSTed to a chain's milestone webhook.
+type Payload struct {
+	Event      config.MilestoneEvent `json:"event"`
+	SessionID  string                `json:"session_id"`
+	AlertType  string                `json:"alert_type"`
+	ChainID    string                `json:"chain_id"`
+	SessionURL string                `json:"session_url,omitempty"`
+	Timestamp  time.Time             `json:"timestamp"`
+
+	AgentName     string `json:"agent_name,omitempty"`     // first_tool_call
+	ToolName      string `json:"tool_name,omitempty"`      // first_tool_call
+	StageName     string `json:"stage_name,omitempty"`     // stage_completed
+	StageType     string `json:"stage_type,omitempty"`     // stage_completed
+	FinalAnalysis string `json:"final_analysis,omitempty"` // final_analysis
+}
+
+// Session identifies the session a Tracker reports on.
+type Session struct {
+	ID        string
+	AlertType string
+	ChainID   string
+}
+
+// Notifier creates per-session Trackers for chains with milestone
+// notifications configured.
+// Nil-safe: Track returns a nil (no-op) Tracker when notifier is nil.
+type Notifier struct {
+	chains       *config.ChainRegistry
+	slack        *tarsyslack.Service // nil = Slack milestones disabled
+	dashboardURL string
+	httpClient   *http.Client
+	logger       *slog.Logger
+}
+
+// NewNotifier creates a milestone notifier. slackService may be nil.
+func NewNotifier(chains *config.ChainRegistry, slackService *tarsyslack.Service, dashboardURL string) *Notifier {
+	return &Notifier{
+		chains:       chains,
+		slack:        slackService,
+		dashboardURL: dashboardURL,
+		httpClient:   &http.Client{Timeout: deliveryTimeout},
+		logger:       slog.Default().With("component", "milestone-notifier"),
+	}
+}
+
+// Track returns a Tracker for session, or nil when its chain has no
+// milestone notifications configured. threadTS is the session's Slack
+// status thread (empty = none).
+func (n *Notifier) Track(session Session, threadTS string) *Tracker {
+	if n == nil || n.chains == nil {
+		return nil
+	}
+	chain, err := n.chains.Get(session.ChainID)
+	if err != nil || chain.MilestoneNotifications == nil {
+		return nil
+	}
+	return &Tracker{
+		notifier: n,
+		cfg:      chain.MilestoneNotifications,
+		session:  session,
+		threadTS: threadTS,
+		sent:     make(map[string]bool),
+	}
+}
+
+// Tracker delivers the milestones of a single session. Each milestone is
+// sent at most once (stage_completed once per stage) and delivery runs in
+// the background so agent execution is never blocked on a slow receiver.
+// Nil-safe: all methods are no-ops when tracker is nil.
+type Tracker struct {
+	notifier *Notifier
+	cfg      *config.MilestoneNotificationConfig
+	session  Session
+	threadTS string
+
+	mu   sync.Mutex
+	sent map[string]bool
+	wg   sync.WaitGroup
+}
+
+// FirstToolCall records that agentName executed toolName. Only the first
+// call of the session is notified.
+func (t *Tracker) FirstToolCall(ctx context.Context, agentName, toolName string) {
+	t.fire(ctx, Payload{Event: config.MilestoneFirstToolCall, AgentName: agentName, ToolName: toolName}, "")
+}
+
+// StageCompleted records that a stage completed successfully.
+func (t *Tracker) StageCompleted(ctx context.Context, stageName, stageType string) {
+	t.fire(ctx, Payload{Event: config.MilestoneStageCompleted, StageName: stageName, StageType: stageType}, stageName)
+}
+
+// FinalAnalysis records that the final analysis is available.
+func (t *Tracker) FinalAnalysis(ctx context.Context, analysis string) {
+	t.fire(ctx, Payload{Event: config.MilestoneFinalAnalysis, FinalAnalysis: analysis}, "")
+}
+
+// Wait blocks until all pending deliveries have finished, so milestones
+// land before the terminal notification.
+func (t *Tracker) Wait() {
+	if t == nil {
+		return
+	}
+	t.wg.Wait()
+}
+
+func (t *Tracker) fire(ctx context.Context, p Payload, key string) {
+	if t == nil || !t.cfg.Wants(p.Event) {
+		return
+	}
+
+	dedupKey := string(p.Event) + ":" + key
+	t.mu.Lock()
+	if t.sent[dedupKey] {
+		t.mu.Unlock()
+		return
+	}
+	t.sent[dedupKey] = true
+	t.mu.Unlock()
+
+	p.SessionID = t.session.ID
+	p.AlertType = t.session.AlertType
+	p.ChainID = t.session.ChainID
+	p.Timestamp = time.Now().UTC()
+	if t.notifier.dashboardURL != "" {
+		p.SessionURL = fmt.Sprintf("%s/sessions/%s", t.notifier.dashboardURL, t.session.ID)
+	}
+
+	// Deliver even if the session is cancelled mid-flight.
+	deliverCtx := context.WithoutCancel(ctx)
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		t.deliver(deliverCtx, p)
+	}()
+}
+
+func (t *Tracker) deliver(ctx context.Context, p Payload) {
+	n := t.notifier
+	if t.cfg.Slack {
+		n.slack.NotifyMilestone(ctx, tarsyslack.MilestoneInput{
+			SessionID:     p.SessionID,
+			ThreadTS:      t.threadTS,
+			Event:         string(p.Event),
+			AgentName:     p.AgentName,
+			ToolName:      p.ToolName,
+			StageName:     p.StageName,
+			FinalAnalysis: p.FinalAnalysis,
+		})
+	}
+	if t.cfg.WebhookURL != "" {
+		if err := n.postWebhook(ctx, t.cfg.WebhookURL, p); err != nil {
+			n.logger.Warn("Failed to post milestone webhook",
+				"session_id", p.SessionID, "event", p.Event, "error", err)
+		}
+	}
+}
+
+func (n *Notifier) postWebhook(ctx context.Context, url string, p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to marshal milestone: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+type trackerKey struct{}
+
+// WithTracker returns a copy of ctx carrying t.
+func WithTracker(ctx context.Context, t *Tracker) context.Context {
+	if t == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, trackerKey{}, t)
+}
+
+// FromContext returns the Tracker carried by ctx, or nil.
+func FromContext(ctx context.Context) *Tracker {
+	t, _ := ctx.Value(trackerKey{}).(*Tracker)
+	return t
+}
@@ -0,0 +1,157 @@
+package milestone
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+type webhookRecorder struct {
+	mu       sync.Mutex
+	payloads []Payload
+}
+
+func newWebhookServer(t *testing.T) (*httptest.Server, *webhookRecorder) {
+	t.Helper()
+	rec := &webhookRecorder{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p Payload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		rec.mu.Lock()
+		rec.payloads = append(rec.payloads, p)
+		rec.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, rec
+}
+
+func newTestNotifier(milestones *config.MilestoneNotificationConfig) *Notifier {
+	chains := config.NewChainRegistry(map[string]*config.ChainConfig{
+		"k8s":   {AlertTypes: []string{"PodCrash"}, MilestoneNotifications: milestones},
+		"quiet": {AlertTypes: []string{"Other"}},
+	})
+	return NewNotifier(chains, nil, "https://tarsy.example.com")
+}
+
+func TestTracker_DeliversConfiguredMilestones(t *testing.T) {
+	srv, rec := newWebhookServer(t)
+	n := newTestNotifier(&config.MilestoneNotificationConfig{
+		Events:     []config.MilestoneEvent{config.MilestoneFirstToolCall, config.MilestoneStageCompleted},
+		WebhookURL: srv.URL,
+	})
+
+	tr := n.Track(Session{ID: "sess-1", AlertType: "PodCrash", ChainID: "k8s"}, "")
+	require.NotNil(t, tr)
+
+	ctx := context.Background()
+	tr.FirstToolCall(ctx, "KubernetesAgent", "kubernetes-server.pods_list")
+	tr.FirstToolCall(ctx, "KubernetesAgent", "kubernetes-server.events_list")
+	tr.StageCompleted(ctx, "investigate", "investigation")
+	tr.StageCompleted(ctx, "investigate", "investigation")
+	tr.StageCompleted(ctx, "remediate", "action")
+	tr.FinalAnalysis(ctx, "not configured")
+	tr.Wait()
+
+	require.Len(t, rec.payloads, 3)
+	byEvent := map[config.MilestoneEvent][]Payload{}
+	for _, p := range rec.payloads {
+		assert.Equal(t, "sess-1", p.SessionID)
+		assert.Equal(t, "k8s", p.ChainID)
+		assert.Equal(t, "https://tarsy.example.com/sessions/sess-1", p.SessionURL)
+		byEvent[p.Event] = append(byEvent[p.Event], p)
+	}
+	require.Len(t, byEvent[config.MilestoneFirstToolCall], 1)
+	assert.Equal(t, "kubernetes-server.pods_list", byEvent[config.MilestoneFirstToolCall][0].ToolName)
+	assert.Len(t, byEvent[config.MilestoneStageCompleted], 2)
+}
+
+func TestNotifier_TrackWithoutConfig(t *testing.T) {
+	n := newTestNotifier(&config.MilestoneNotificationConfig{
+		Events:     []config.MilestoneEvent{config.MilestoneFinalAnalysis},
+		WebhookURL: "http://127.0.0.1:1",
+	})
+
+	assert.Nil(t, n.Track(Session{ID: "s", ChainID: "quiet"}, ""))
+	assert.Nil(t, n.Track(Session{ID: "s", ChainID: "missing"}, ""))
+
+	var nilNotifier *Notifier
+	tr := nilNotifier.Track(Session{ID: "s", ChainID: "k8s"}, "")
+	assert.Nil(t, tr)
+
+	// Nil tracker is a no-op
+	tr.FinalAnalysis(context.Background(), "x")
+	tr.Wait()
+}
+
+func TestTracker_FailOpen(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	n := newTestNotifier(&config.MilestoneNotificationConfig{
+		Events:     []config.MilestoneEvent{config.MilestoneFinalAnalysis},
+		WebhookURL: srv.URL,
+	})
+	tr := n.Track(Session{ID: "sess-1", ChainID: "k8s"}, "")
+	tr.FinalAnalysis(context.Background(), "analysis")
+	tr.Wait()
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, FromContext(ctx))
+	assert.Equal(t, ctx, WithTracker(ctx, nil))
+
+	tr := &Tracker{}
+	assert.Same(t, tr, FromContext(WithTracker(ctx, tr)))
+}
+
+type stubToolExecutor struct{ calls []string }
+
+func (s *stubToolExecutor) Execute(_ context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+	s.calls = append(s.calls, call.Name)
+	return &agent.ToolResult{CallID: call.ID, Name: call.Name, Content: "ok"}, nil
+}
+
+func (s *stubToolExecutor) ListTools(context.Context) ([]agent.ToolDefinition, error) {
+	return nil, nil
+}
+
+func (s *stubToolExecutor) Close() error { return nil }
+
+func TestToolExecutor_ReportsFirstCall(t *testing.T) {
+	srv, rec := newWebhookServer(t)
+	n := newTestNotifier(&config.MilestoneNotificationConfig{
+		Events:     []config.MilestoneEvent{config.MilestoneFirstToolCall},
+		WebhookURL: srv.URL,
+	})
+	tr := n.Track(Session{ID: "sess-1", ChainID: "k8s"}, "")
+	inner := &stubToolExecutor{}
+	exec := NewToolExecutor(inner, tr, "KubernetesAgent")
+
+	for _, name := range []string{"k8s.pods_list", "k8s.events_list"} {
+		result, err := exec.Execute(context.Background(), agent.ToolCall{ID: name, Name: name})
+		require.NoError(t, err)
+		assert.Equal(t, "ok", result.Content)
+	}
+	tr.Wait()
+
+	assert.Equal(t, []string{"k8s.pods_list", "k8s.events_list"}, inner.calls)
+	require.Len(t, rec.payloads, 1)
+	assert.Equal(t, "KubernetesAgent", rec.payloads[0].AgentName)
+	assert.Equal(t, "k8s.pods_list", rec.payloads[0].ToolName)
+}
@@ -0,0 +1,36 @@
+package milestone
+
+import (
+	"context"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+)
+
+// ToolExecutor wraps an inner agent.ToolExecutor and reports the first
+// tool call of the session to a Tracker. Calls pass through unchanged.
+type ToolExecutor struct {
+	inner     agent.ToolExecutor
+	tracker   *Tracker
+	agentName string
+}
+
+// NewToolExecutor creates a milestone-reporting tool executor.
+func NewToolExecutor(inner agent.ToolExecutor, tracker *Tracker, agentName string) *ToolExecutor {
+	return &ToolExecutor{inner: inner, tracker: tracker, agentName: agentName}
+}
+
+// Execute reports the call and delegates to the inner executor.
+func (e *ToolExecutor) Execute(ctx context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+	e.tracker.FirstToolCall(ctx, e.agentName, call.Name)
+	return e.inner.Execute(ctx, call)
+}
+
+// ListTools delegates to the inner executor.
+func (e *ToolExecutor) ListTools(ctx context.Context) ([]agent.ToolDefinition, error) {
+	return e.inner.ListTools(ctx)
+}
+
+// Close delegates to the inner executor.
+func (e *ToolExecutor) Close() error {
+	return e.inner.Close()
+}
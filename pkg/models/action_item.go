@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+)
+
+// ActionItemListParams filters GET /action-items.
+type ActionItemListParams struct {
+	Status   string // open (default), done, or "all"
+	ChainID  string
+	Assignee string
+	Limit    int
+	Offset   int
+}
+
+// CreateActionItemRequest is the body of POST /sessions/:id/action-items.
+type CreateActionItemRequest struct {
+	Title    string `json:"title" validate:"required,max=200"`
+	Details  string `json:"details,omitempty" validate:"max=5000"`
+	Assignee string `json:"assignee,omitempty" validate:"max=200"`
+}
+
+// UpdateActionItemRequest is the body of PATCH /action-items/:id. Omitted
+// fields are left unchanged; an empty details or assignee clears it.
+// Setting status to done records who completed the item and when;
+// reopening clears both.
+type UpdateActionItemRequest struct {
+	Title    *string `json:"title,omitempty" validate:"max=200"`
+	Details  *string `json:"details,omitempty" validate:"max=5000"`
+	Status   *string `json:"status,omitempty" validate:"oneof=open done"`
+	Assignee *string `json:"assignee,omitempty" validate:"max=200"`
+}
+
+// ActionItemResponse is an action item as returned by the action-items
+// endpoints.
+type ActionItemResponse struct {
+	ID          string     `json:"id"`
+	SessionID   string     `json:"session_id"`
+	Title       string     `json:"title"`
+	Details     *string    `json:"details"`
+	Status      string     `json:"status"`
+	Source      string     `json:"source"`
+	Assignee    *string    `json:"assignee"`
+	CompletedAt *time.Time `json:"completed_at"`
+	CompletedBy *string    `json:"completed_by"`
+	CreatedBy   *string    `json:"created_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ActionItemListResponse is the HTTP response for GET /action-items and
+// GET /sessions/:id/action-items. Total is the number of matching items
+// before limit and offset.
+type ActionItemListResponse struct {
+	ActionItems []ActionItemResponse `json:"action_items"`
+	Total       int                  `json:"total"`
+}
+
+// NewActionItemResponse converts a stored action item to its API shape.
+func NewActionItemResponse(a *ent.ActionItem) ActionItemResponse {
+	return ActionItemResponse{
+		ID:          a.ID,
+		SessionID:   a.SessionID,
+		Title:       a.Title,
+		Details:     a.Details,
+		Status:      string(a.Status),
+		Source:      string(a.Source),
+		Assignee:    a.Assignee,
+		CompletedAt: a.CompletedAt,
+		CompletedBy: a.CompletedBy,
+		CreatedBy:   a.CreatedBy,
+		CreatedAt:   a.CreatedAt,
+		UpdatedAt:   a.UpdatedAt,
+	}
+}
+
+// NewActionItemListResponse converts stored action items to the list shape.
+func NewActionItemListResponse(items []*ent.ActionItem, total int) ActionItemListResponse {
+	resp := ActionItemListResponse{
+		ActionItems: make([]ActionItemResponse, 0, len(items)),
+		Total:       total,
+	}
+	for _, a := range items {
+		resp.ActionItems = append(resp.ActionItems, NewActionItemResponse(a))
+	}
+	return resp
+}
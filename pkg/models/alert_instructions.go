@@ -0,0 +1,138 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Size limits for per-alert instructions.
+const (
+	// MaxAlertInstructionLength caps a single instruction (global, stage, or agent).
+	MaxAlertInstructionLength = 2000
+	// MaxAlertInstructionsTotal caps the combined length of all instructions.
+	MaxAlertInstructionsTotal = 8000
+)
+
+// AlertInstructions is extra guidance submitted with an alert and appended
+// to agent prompts. Global applies to every agent in the chain; Stages and
+// Agents are keyed by stage name and agent name and apply only there. Chat
+// is a guardrail for follow-up chat on the session, added to the chain's
+// chat.guardrails.
+type AlertInstructions struct {
+	Global string            `json:"global,omitempty"`
+	Stages map[string]string `json:"stages,omitempty"`
+	Agents map[string]string `json:"agents,omitempty"`
+	Chat   string            `json:"chat,omitempty"`
+}
+
+// IsEmpty reports whether no instruction is set.
+func (i *AlertInstructions) IsEmpty() bool {
+	if i == nil {
+		return true
+	}
+	return strings.TrimSpace(i.Global) == "" && strings.TrimSpace(i.Chat) == "" &&
+		len(i.Stages) == 0 && len(i.Agents) == 0
+}
+
+// Validate checks size limits. Returns a description of the first violation,
+// or "" when the instructions are acceptable.
+func (i *AlertInstructions) Validate() string {
+	if i == nil {
+		return ""
+	}
+	total := len(i.Global) + len(i.Chat)
+	if len(i.Global) > MaxAlertInstructionLength {
+		return fmt.Sprintf("global must be at most %d characters", MaxAlertInstructionLength)
+	}
+	if len(i.Chat) > MaxAlertInstructionLength {
+		return fmt.Sprintf("chat must be at most %d characters", MaxAlertInstructionLength)
+	}
+	for name, text := range i.Stages {
+		if strings.TrimSpace(text) == "" {
+			return fmt.Sprintf("stage %q has an empty instruction", name)
+		}
+		if len(text) > MaxAlertInstructionLength {
+			return fmt.Sprintf("stage %q must be at most %d characters", name, MaxAlertInstructionLength)
+		}
+		total += len(text)
+	}
+	for name, text := range i.Agents {
+		if strings.TrimSpace(text) == "" {
+			return fmt.Sprintf("agent %q has an empty instruction", name)
+		}
+		if len(text) > MaxAlertInstructionLength {
+			return fmt.Sprintf("agent %q must be at most %d characters", name, MaxAlertInstructionLength)
+		}
+		total += len(text)
+	}
+	if total > MaxAlertInstructionsTotal {
+		return fmt.Sprintf("combined instructions must be at most %d characters", MaxAlertInstructionsTotal)
+	}
+	return ""
+}
+
+// Map applies fn to every instruction and returns the result. Used to mask
+// instructions before storage.
+func (i *AlertInstructions) Map(fn func(string) string) *AlertInstructions {
+	if i == nil {
+		return nil
+	}
+	out := &AlertInstructions{Global: i.Global, Chat: i.Chat}
+	if out.Global != "" {
+		out.Global = fn(out.Global)
+	}
+	if out.Chat != "" {
+		out.Chat = fn(out.Chat)
+	}
+	if len(i.Stages) > 0 {
+		out.Stages = make(map[string]string, len(i.Stages))
+		for name, text := range i.Stages {
+			out.Stages[name] = fn(text)
+		}
+	}
+	if len(i.Agents) > 0 {
+		out.Agents = make(map[string]string, len(i.Agents))
+		for name, text := range i.Agents {
+			out.Agents[name] = fn(text)
+		}
+	}
+	return out
+}
+
+// For returns the instructions that apply to agentName running in stageName,
+// in order global, stage, agent, separated by blank lines. Empty when none apply.
+func (i *AlertInstructions) For(stageName, agentName string) string {
+	if i == nil {
+		return ""
+	}
+	var parts []string
+	if s := strings.TrimSpace(i.Global); s != "" {
+		parts = append(parts, s)
+	}
+	if s := strings.TrimSpace(i.Stages[stageName]); s != "" {
+		parts = append(parts, s)
+	}
+	if s := strings.TrimSpace(i.Agents[agentName]); s != "" {
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// ParseAlertInstructions deserializes a JSON map (from ent storage) into AlertInstructions.
+// Returns nil with no error if the raw map is nil or empty.
+func ParseAlertInstructions(raw map[string]interface{}) (*AlertInstructions, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal alert instructions: %w", err)
+	}
+	var instr AlertInstructions
+	if err := json.Unmarshal(data, &instr); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal alert instructions: %w", err)
+	}
+	return &instr, nil
+}
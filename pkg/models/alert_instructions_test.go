@@ -0,0 +1,103 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertInstructions_Validate(t *testing.T) {
+	long := strings.Repeat("x", MaxAlertInstructionLength+1)
+	nearMax := strings.Repeat("x", MaxAlertInstructionLength)
+
+	tests := []struct {
+		name    string
+		instr   *AlertInstructions
+		wantErr string
+	}{
+		{name: "nil", instr: nil},
+		{
+			name: "valid",
+			instr: &AlertInstructions{
+				Global: "Focus on the payments namespace",
+				Stages: map[string]string{"investigation": "Check recent deploys"},
+				Agents: map[string]string{"KubernetesAgent": "Ignore kube-system"},
+			},
+		},
+		{name: "global too long", instr: &AlertInstructions{Global: long}, wantErr: "global must be at most"},
+		{name: "chat too long", instr: &AlertInstructions{Chat: long}, wantErr: "chat must be at most"},
+		{name: "stage too long", instr: &AlertInstructions{Stages: map[string]string{"s1": long}}, wantErr: `stage "s1"`},
+		{name: "empty agent instruction", instr: &AlertInstructions{Agents: map[string]string{"a1": " "}}, wantErr: `agent "a1" has an empty instruction`},
+		{
+			name: "combined too long",
+			instr: &AlertInstructions{
+				Global: nearMax,
+				Stages: map[string]string{"s1": nearMax, "s2": nearMax},
+				Agents: map[string]string{"a1": nearMax, "a2": "x"},
+			},
+			wantErr: "combined instructions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.instr.Validate()
+			if tt.wantErr == "" {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Contains(t, got, tt.wantErr)
+		})
+	}
+}
+
+func TestAlertInstructions_For(t *testing.T) {
+	instr := &AlertInstructions{
+		Global: "global note",
+		Stages: map[string]string{"investigation": "stage note"},
+		Agents: map[string]string{"KubernetesAgent": "agent note"},
+	}
+
+	assert.Equal(t, "global note\n\nstage note\n\nagent note", instr.For("investigation", "KubernetesAgent"))
+	assert.Equal(t, "global note\n\nstage note", instr.For("investigation", "OtherAgent"))
+	assert.Equal(t, "global note", instr.For("remediation", "OtherAgent"))
+	assert.Empty(t, (&AlertInstructions{}).For("investigation", "KubernetesAgent"))
+	assert.Empty(t, (*AlertInstructions)(nil).For("investigation", "KubernetesAgent"))
+	assert.Empty(t, (&AlertInstructions{Chat: "chat only"}).For("investigation", "KubernetesAgent"),
+		"the chat guardrail is not an investigation instruction")
+}
+
+func TestAlertInstructions_Map(t *testing.T) {
+	instr := &AlertInstructions{
+		Global: "token abc",
+		Stages: map[string]string{"s1": "token def"},
+		Chat:   "token ghi",
+	}
+	masked := instr.Map(func(s string) string { return strings.ReplaceAll(s, "token", "[MASKED]") })
+
+	assert.Equal(t, "[MASKED] abc", masked.Global)
+	assert.Equal(t, "[MASKED] ghi", masked.Chat)
+	assert.Equal(t, "[MASKED] def", masked.Stages["s1"])
+	assert.Nil(t, masked.Agents)
+	assert.Equal(t, "token abc", instr.Global, "original is not modified")
+}
+
+func TestParseAlertInstructions(t *testing.T) {
+	got, err := ParseAlertInstructions(nil)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = ParseAlertInstructions(map[string]interface{}{
+		"global": "g",
+		"stages": map[string]interface{}{"s1": "stage"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "g", got.Global)
+	assert.Equal(t, "stage", got.Stages["s1"])
+
+	_, err = ParseAlertInstructions(map[string]interface{}{"global": 1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// Completion callback field limits.
+const (
+	MaxCallbackURLLength    = 2048
+	MaxCallbackSecretLength = 256
+)
+
+// CompletionCallback is registered with an alert submission: TARSy POSTs the
+// final result to URL once the session reaches a terminal state. When Secret
+// is set, each delivery carries an HMAC-SHA256 signature of the body.
+type CompletionCallback struct {
+	URL    string `json:"url"`
+	Secret string `json:"secret,omitempty"`
+}
+
+// CallbackDeliveryResponse reports completion callback delivery on the
+// session detail. The secret is never returned.
+type CallbackDeliveryResponse struct {
+	URL         string     `json:"url"`
+	Status      string     `json:"status"` // pending, delivered, failed
+	Attempts    int        `json:"attempts"`
+	LastError   *string    `json:"last_error,omitempty"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// CatalogUsage is trailing-window usage for a chain, agent, or MCP server
+// catalog entry.
+type CatalogUsage struct {
+	// Sessions is the number of distinct sessions that used the entry.
+	Sessions int `json:"sessions"`
+	// Invocations is the number of agent executions or MCP tool calls
+	// (omitted for chains, where it equals Sessions).
+	Invocations int `json:"invocations,omitempty"`
+	// SuccessRate is completed / (completed + failed + timed_out) for chains
+	// and agents, and error-free / total tool calls for MCP servers.
+	// Nil when nothing finished in the window.
+	SuccessRate *float64 `json:"success_rate"`
+	// AvgCostUsd is the estimated LLM cost per session attributable to the
+	// entry. Nil when cost estimation is disabled or for MCP servers.
+	AvgCostUsd *float64   `json:"avg_cost_usd,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// MCPToolUsage is trailing-window usage for a single MCP tool.
+type MCPToolUsage struct {
+	// Calls is the number of tool calls in the window.
+	Calls int `json:"calls"`
+	// Sessions is the number of distinct sessions that called the tool.
+	Sessions int `json:"sessions"`
+	// ErrorRate is failed / total calls. Nil when the tool was not called.
+	ErrorRate *float64 `json:"error_rate"`
+	// AvgDurationMs is the mean call latency. Nil when no call recorded a duration.
+	AvgDurationMs *float64 `json:"avg_duration_ms"`
+	// AvgResultBytes is the mean size of the stored tool result (JSON-encoded).
+	// Nil when no call stored a result.
+	AvgResultBytes *float64   `json:"avg_result_bytes"`
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty"`
+}
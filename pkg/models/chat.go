@@ -11,9 +11,12 @@ type CreateChatRequest struct {
 
 // AddChatMessageRequest contains fields for adding a chat message
 type AddChatMessageRequest struct {
-	ChatID  string `json:"chat_id"`
-	Content string `json:"content"`
-	Author  string `json:"author"`
+	ChatID        string               `json:"chat_id"`
+	Content       string               `json:"content"`
+	Author        string               `json:"author"`
+	AuthorSubject string               `json:"author_subject,omitempty"` // OIDC subject (optional)
+	Images        []ImageAttachment    `json:"images,omitempty"`         // Screenshots sent with the question (optional)
+	VoiceNote     *VoiceNoteAttachment `json:"voice_note,omitempty"`     // Recorded question, transcribed into Content (optional)
 }
 
 // CreateChatStageRequest contains fields for creating a chat response stage
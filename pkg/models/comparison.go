@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// SessionComparisonResponse compares a session's conclusion with the
+// previous investigation of the same alert. It is linked on both sessions:
+// as comparison on the later one and next_comparison on the earlier one.
+type SessionComparisonResponse struct {
+	ID                 string    `json:"id"`
+	SessionID          string    `json:"session_id"`          // The later session
+	PreviousSessionID  string    `json:"previous_session_id"` // The earlier session
+	Status             string    `json:"status"`              // completed, failed
+	PreviousConclusion *string   `json:"previous_conclusion,omitempty"`
+	CurrentConclusion  *string   `json:"current_conclusion,omitempty"`
+	Differences        []string  `json:"differences,omitempty"`
+	SameRootCause      *bool     `json:"same_root_cause,omitempty"`
+	ErrorMessage       *string   `json:"error_message,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+}
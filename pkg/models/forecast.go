@@ -0,0 +1,43 @@
+package models
+
+// Forecast bases: which history an estimate is drawn from.
+const (
+	ForecastBasisAlertType = "alert_type" // completed sessions of the chain for this alert type
+	ForecastBasisChain     = "chain"      // all completed sessions of the chain (too few for the alert type)
+	ForecastBasisNone      = "none"       // no completed sessions yet; estimates are zero
+)
+
+// BudgetForecast estimates the token, cost and time budget of running a
+// chain for an alert, from the averages of recently completed sessions.
+type BudgetForecast struct {
+	ChainID                  string          `json:"chain_id"`
+	AlertType                string          `json:"alert_type"`
+	Basis                    string          `json:"basis"`       // see ForecastBasis* constants
+	SampleSize               int             `json:"sample_size"` // completed sessions averaged
+	EstimatedTokens          int64           `json:"estimated_tokens"`
+	EstimatedCostUsd         *float64        `json:"estimated_cost_usd,omitempty"` // nil when cost estimation is disabled
+	EstimatedDurationSeconds int64           `json:"estimated_duration_seconds"`
+	Stages                   []StageForecast `json:"stages"`
+	Budget                   *BudgetCheck    `json:"budget,omitempty"` // set when the chain has a budget
+}
+
+// StageForecast is the average of one stage (by name) across the sampled
+// sessions that ran it. Stages added at runtime (synthesis, executive
+// summary) follow the configured ones.
+type StageForecast struct {
+	StageName                string   `json:"stage_name"`
+	SampleSize               int      `json:"sample_size"`
+	EstimatedTokens          int64    `json:"estimated_tokens"`
+	EstimatedCostUsd         *float64 `json:"estimated_cost_usd,omitempty"`
+	EstimatedDurationSeconds int64    `json:"estimated_duration_seconds"`
+}
+
+// BudgetCheck compares a forecast with the chain's budget.
+type BudgetCheck struct {
+	MaxCostUsd         *float64 `json:"max_cost_usd,omitempty"`
+	MaxDurationSeconds *int64   `json:"max_duration_seconds,omitempty"`
+	MinSamples         int      `json:"min_samples"`
+	Exceeded           bool     `json:"exceeded"` // forecast is over a limit
+	Enforced           bool     `json:"enforced"` // exceeded with enough history: submissions are rejected
+	Reason             string   `json:"reason,omitempty"`
+}
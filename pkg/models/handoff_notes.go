@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+)
+
+// UpdateHandoffNotesRequest is the body of PUT /sessions/:id/handoff-notes.
+// Content replaces the notes; empty clears them.
+type UpdateHandoffNotesRequest struct {
+	Content string `json:"content"`
+	// BaseRevision is the revision the edit was made on. A save is rejected
+	// with 409 when someone else saved in between. Omit to overwrite
+	// unconditionally.
+	BaseRevision *int `json:"base_revision,omitempty"`
+}
+
+// HandoffNotesResponse is a session's current handoff notes.
+type HandoffNotesResponse struct {
+	SessionID string     `json:"session_id"`
+	Content   string     `json:"content"`
+	Revision  int        `json:"revision"` // 0 = never edited
+	UpdatedBy *string    `json:"updated_by"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// HandoffNoteRevisionResponse is one saved revision of a session's handoff notes.
+type HandoffNoteRevisionResponse struct {
+	ID        string    `json:"id"`
+	Revision  int       `json:"revision"`
+	Content   string    `json:"content"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// HandoffNoteRevisionsResponse is the HTTP response for
+// GET /sessions/:id/handoff-notes/revisions, newest first.
+type HandoffNoteRevisionsResponse struct {
+	Revisions []HandoffNoteRevisionResponse `json:"revisions"`
+}
+
+// NewHandoffNotesResponse returns the current handoff notes of a session.
+func NewHandoffNotesResponse(s *ent.AlertSession) HandoffNotesResponse {
+	resp := HandoffNotesResponse{
+		SessionID: s.ID,
+		Revision:  s.HandoffNotesRevision,
+		UpdatedBy: s.HandoffNotesUpdatedBy,
+		UpdatedAt: s.HandoffNotesUpdatedAt,
+	}
+	if s.HandoffNotes != nil {
+		resp.Content = *s.HandoffNotes
+	}
+	return resp
+}
+
+// NewHandoffNoteRevisionResponse converts a stored revision to its API shape.
+func NewHandoffNoteRevisionResponse(r *ent.HandoffNoteRevision) HandoffNoteRevisionResponse {
+	return HandoffNoteRevisionResponse{
+		ID:        r.ID,
+		Revision:  r.Revision,
+		Content:   r.Content,
+		Author:    r.Author,
+		CreatedAt: r.CreatedAt,
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Limits for images attached to alerts and chat messages.
+const (
+	// MaxImageAttachments caps the images on one alert or chat message.
+	MaxImageAttachments = 4
+	// MaxImageSize caps a single decoded image.
+	MaxImageSize = 4 * 1024 * 1024 // 4 MB
+)
+
+// imageMimeTypes are the image formats every multimodal provider accepts.
+var imageMimeTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// ImageAttachment is an image submitted with an alert or chat message
+// (e.g. a Grafana panel screenshot). Data is base64-encoded in JSON.
+type ImageAttachment struct {
+	Name     string `json:"name,omitempty"`
+	MimeType string `json:"mime_type"`
+	Data     []byte `json:"data"`
+}
+
+// ValidateImageAttachments checks count, size, and format limits. Returns a
+// description of the first violation, or "" when the images are acceptable.
+// The declared MIME type must match the sniffed content.
+func ValidateImageAttachments(images []ImageAttachment) string {
+	if len(images) > MaxImageAttachments {
+		return fmt.Sprintf("at most %d images are allowed", MaxImageAttachments)
+	}
+	for i, img := range images {
+		if len(img.Data) == 0 {
+			return fmt.Sprintf("image %d is empty", i+1)
+		}
+		if len(img.Data) > MaxImageSize {
+			return fmt.Sprintf("image %d exceeds maximum size of %d bytes", i+1, MaxImageSize)
+		}
+		if !imageMimeTypes[img.MimeType] {
+			return fmt.Sprintf("image %d has unsupported mime_type %q (png, jpeg, gif, or webp)", i+1, img.MimeType)
+		}
+		if sniffed := http.DetectContentType(img.Data); sniffed != img.MimeType {
+			return fmt.Sprintf("image %d is not a valid %s (content looks like %s)", i+1, img.MimeType, sniffed)
+		}
+	}
+	return ""
+}
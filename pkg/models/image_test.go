@@ -0,0 +1,64 @@
+package models
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateImageAttachments(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	jpeg := []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+
+	tests := []struct {
+		name    string
+		images  []ImageAttachment
+		wantErr string
+	}{
+		{name: "none"},
+		{
+			name: "valid",
+			images: []ImageAttachment{
+				{Name: "panel.png", MimeType: "image/png", Data: png},
+				{MimeType: "image/jpeg", Data: jpeg},
+			},
+		},
+		{
+			name:    "too many",
+			images:  []ImageAttachment{{}, {}, {}, {}, {}},
+			wantErr: "at most 4 images",
+		},
+		{
+			name:    "empty",
+			images:  []ImageAttachment{{MimeType: "image/png"}},
+			wantErr: "image 1 is empty",
+		},
+		{
+			name:    "too large",
+			images:  []ImageAttachment{{MimeType: "image/png", Data: append(png, bytes.Repeat([]byte{0}, MaxImageSize)...)}},
+			wantErr: "exceeds maximum size",
+		},
+		{
+			name:    "unsupported type",
+			images:  []ImageAttachment{{MimeType: "image/svg+xml", Data: []byte("<svg/>")}},
+			wantErr: `unsupported mime_type "image/svg+xml"`,
+		},
+		{
+			name:    "content does not match type",
+			images:  []ImageAttachment{{MimeType: "image/png", Data: png}, {MimeType: "image/png", Data: jpeg}},
+			wantErr: "image 2 is not a valid image/png (content looks like image/jpeg)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := ValidateImageAttachments(tt.images)
+			if tt.wantErr == "" {
+				assert.Empty(t, msg)
+				return
+			}
+			assert.Contains(t, msg, tt.wantErr)
+		})
+	}
+}
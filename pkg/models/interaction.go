@@ -7,6 +7,7 @@ type CreateLLMInteractionRequest struct {
 	ExecutionID      *string        `json:"execution_id,omitempty"` // nil for session-level interactions
 	InteractionType  string         `json:"interaction_type"`       // "iteration", "final_analysis", "executive_summary", "chat_response"
 	ModelName        string         `json:"model_name"`
+	Owner            string         `json:"owner,omitempty"` // team charged for the call; "" = unattributed
 	LastMessageID    *string        `json:"last_message_id,omitempty"`
 	LLMRequest       map[string]any `json:"llm_request"`
 	LLMResponse      map[string]any `json:"llm_response"`
@@ -62,11 +63,28 @@ type TraceStageGroup struct {
 type TraceExecutionGroup struct {
 	ExecutionID     string                   `json:"execution_id"`
 	AgentName       string                   `json:"agent_name"`
+	ToolCallCount   int                      `json:"tool_call_count"`
+	LoopDetected    bool                     `json:"loop_detected"`
 	LLMInteractions []LLMInteractionListItem `json:"llm_interactions"`
 	MCPInteractions []MCPInteractionListItem `json:"mcp_interactions"`
+	Artifacts       []TraceArtifact          `json:"artifacts"`
 	SubAgents       []TraceExecutionGroup    `json:"sub_agents,omitempty"`
 }
 
+// TraceArtifact references a payload an execution produced (currently MCP
+// tool results) without inlining it. DownloadURL is a signed, expiring URL
+// that serves the full content.
+type TraceArtifact struct {
+	Kind          string `json:"kind"` // "tool_result"
+	InteractionID string `json:"interaction_id"`
+	Name          string `json:"name"` // "server.tool"
+	ContentType   string `json:"content_type"`
+	SizeBytes     int    `json:"size_bytes"`
+	Storage       string `json:"storage"` // "database" or "blob_store"
+	DownloadURL   string `json:"download_url,omitempty"`
+	ExpiresAt     string `json:"expires_at,omitempty"`
+}
+
 // LLMInteractionListItem contains metadata for collapsed list view.
 type LLMInteractionListItem struct {
 	ID              string  `json:"id"`
@@ -109,6 +127,7 @@ type LLMInteractionDetailResponse struct {
 	LLMRequest       map[string]any        `json:"llm_request"`
 	LLMResponse      map[string]any        `json:"llm_response"`
 	ResponseMetadata map[string]any        `json:"response_metadata,omitempty"`
+	RequestID        *string               `json:"request_id,omitempty"`
 	CreatedAt        string                `json:"created_at"`
 	Conversation     []ConversationMessage `json:"conversation"`
 }
@@ -120,6 +139,7 @@ type ConversationMessage struct {
 	ToolCalls  []MessageToolCall `json:"tool_calls,omitempty"`
 	ToolCallID *string           `json:"tool_call_id,omitempty"`
 	ToolName   *string           `json:"tool_name,omitempty"`
+	Images     []MessageImage    `json:"images,omitempty"`
 }
 
 // MessageToolCall mirrors ent/schema.MessageToolCall for API responses.
@@ -129,6 +149,15 @@ type MessageToolCall struct {
 	Arguments string `json:"arguments"`
 }
 
+// MessageImage describes an image sent with a message. The content is served
+// by GET /api/v1/sessions/:id/images/:image_id.
+type MessageImage struct {
+	ID        string `json:"id"`
+	MimeType  string `json:"mime_type"`
+	Name      string `json:"name,omitempty"`
+	SizeBytes int    `json:"size_bytes"`
+}
+
 // ────────────────────────────────────────────────────────────
 // MCP Detail (Level 2) — GET /api/v1/sessions/:id/trace/mcp/:interaction_id
 // ────────────────────────────────────────────────────────────
@@ -144,5 +173,48 @@ type MCPInteractionDetailResponse struct {
 	AvailableTools  []any          `json:"available_tools,omitempty"`
 	DurationMs      *int           `json:"duration_ms,omitempty"`
 	ErrorMessage    *string        `json:"error_message,omitempty"`
+	RequestID       *string        `json:"request_id,omitempty"`
 	CreatedAt       string         `json:"created_at"`
 }
+
+// ────────────────────────────────────────────────────────────
+// Context Growth — GET /api/v1/sessions/:id/trace/executions/:execution_id/context
+// ────────────────────────────────────────────────────────────
+
+// ContextGrowthResponse reports how an execution's prompt grew across LLM calls
+// and which tool results contributed to the growth.
+type ContextGrowthResponse struct {
+	ExecutionID        string                   `json:"execution_id"`
+	Iterations         []ContextGrowthIteration `json:"iterations"`
+	LargestToolResults []ContextToolResult      `json:"largest_tool_results"`
+}
+
+// ContextGrowthIteration is the prompt budget of a single LLM interaction.
+// Prompt sizes are nil for interactions recorded before they were tracked.
+type ContextGrowthIteration struct {
+	InteractionID    string              `json:"interaction_id"`
+	InteractionType  string              `json:"interaction_type"`
+	Iteration        *int                `json:"iteration,omitempty"`
+	MessagesCount    *int                `json:"messages_count,omitempty"`
+	PromptChars      *int                `json:"prompt_chars,omitempty"`
+	ToolResultChars  *int                `json:"tool_result_chars,omitempty"`
+	PromptTokens     *int                `json:"prompt_tokens,omitempty"`      // counted by Tokenizer before the call
+	ToolResultTokens *int                `json:"tool_result_tokens,omitempty"` // counted by Tokenizer before the call
+	Tokenizer        string              `json:"tokenizer,omitempty"`
+	InputTokens      *int                `json:"input_tokens,omitempty"`
+	OutputTokens     *int                `json:"output_tokens,omitempty"`
+	InputTokensDelta *int                `json:"input_tokens_delta,omitempty"` // vs. the previous call reporting input tokens
+	AddedToolResults []ContextToolResult `json:"added_tool_results"`           // tool results first sent in this call
+	CreatedAt        string              `json:"created_at"`
+}
+
+// ContextToolResult is a tool result message as it entered the conversation
+// (after any summarization).
+type ContextToolResult struct {
+	MessageID       string  `json:"message_id"`
+	ToolName        *string `json:"tool_name,omitempty"`
+	ToolCallID      *string `json:"tool_call_id,omitempty"`
+	Chars           int     `json:"chars"`
+	EstimatedTokens int     `json:"estimated_tokens"`
+	InteractionID   string  `json:"interaction_id,omitempty"` // first LLM call whose prompt included it
+}
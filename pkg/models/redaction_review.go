@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+)
+
+// RedactionReviewListParams filters GET /redaction-reviews.
+type RedactionReviewListParams struct {
+	Status    string // pending (default), confirmed, dismissed, or "all"
+	SessionID string
+	Limit     int
+	Offset    int
+}
+
+// ResolveRedactionReviewRequest is the body of POST /redaction-reviews/:id/confirm
+// and /dismiss.
+type ResolveRedactionReviewRequest struct {
+	Note string `json:"note,omitempty"`
+}
+
+// RedactionReviewResponse is a queued suspect as returned by the
+// redaction-reviews endpoints. The flagged value itself is never returned.
+type RedactionReviewResponse struct {
+	ID               string         `json:"id"`
+	SessionID        string         `json:"session_id"`
+	MCPInteractionID *string        `json:"mcp_interaction_id"`
+	ServerName       string         `json:"server_name"`
+	ToolName         *string        `json:"tool_name"`
+	Heuristic        string         `json:"heuristic"`
+	Fingerprint      string         `json:"fingerprint"`
+	Preview          string         `json:"preview"`
+	Context          string         `json:"context"`
+	Status           string         `json:"status"`
+	ReviewedBy       *string        `json:"reviewed_by"`
+	ReviewedAt       *time.Time     `json:"reviewed_at"`
+	Note             *string        `json:"note"`
+	RowsRedacted     map[string]int `json:"rows_redacted,omitempty"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
+
+// RedactionReviewListResponse is the HTTP response for GET /redaction-reviews.
+type RedactionReviewListResponse struct {
+	Reviews []RedactionReviewResponse `json:"reviews"`
+	Total   int                       `json:"total"`
+}
+
+// NewRedactionReviewResponse converts a stored review to its API shape.
+func NewRedactionReviewResponse(r *ent.RedactionReview) RedactionReviewResponse {
+	return RedactionReviewResponse{
+		ID:               r.ID,
+		SessionID:        r.SessionID,
+		MCPInteractionID: r.McpInteractionID,
+		ServerName:       r.ServerName,
+		ToolName:         r.ToolName,
+		Heuristic:        r.Heuristic,
+		Fingerprint:      r.Fingerprint,
+		Preview:          r.Preview,
+		Context:          r.Context,
+		Status:           string(r.Status),
+		ReviewedBy:       r.ReviewedBy,
+		ReviewedAt:       r.ReviewedAt,
+		Note:             r.Note,
+		RowsRedacted:     r.RowsRedacted,
+		CreatedAt:        r.CreatedAt,
+	}
+}
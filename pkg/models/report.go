@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// ActivityReportResponse is the HTTP response for GET /reports/:id. The same
+// shape is POSTed to the configured report webhook.
+type ActivityReportResponse struct {
+	ReportID       string                     `json:"report_id"`
+	Period         string                     `json:"period"`
+	WindowStart    time.Time                  `json:"window_start"`
+	WindowEnd      time.Time                  `json:"window_end"`
+	Stats          schema.ActivityReportStats `json:"stats"`
+	Narrative      *string                    `json:"narrative"`
+	NarrativeError *string                    `json:"narrative_error"`
+	CreatedAt      time.Time                  `json:"created_at"`
+}
+
+// ActivityReportListResponse is the HTTP response for GET /reports.
+type ActivityReportListResponse struct {
+	Reports []ActivityReportResponse `json:"reports"`
+}
+
+// NewActivityReportResponse converts a stored report to its API shape.
+func NewActivityReportResponse(r *ent.ActivityReport) ActivityReportResponse {
+	return ActivityReportResponse{
+		ReportID:       r.ID,
+		Period:         string(r.Period),
+		WindowStart:    r.WindowStart,
+		WindowEnd:      r.WindowEnd,
+		Stats:          r.Stats,
+		Narrative:      r.Narrative,
+		NarrativeError: r.NarrativeError,
+		CreatedAt:      r.CreatedAt,
+	}
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// CreateSavedViewRequest is the body of POST /saved-views.
+type CreateSavedViewRequest struct {
+	Name         string                 `json:"name"`
+	Filter       schema.SavedViewFilter `json:"filter"`
+	Notify       bool                   `json:"notify"`                  // WebSocket notification on match
+	SlackChannel string                 `json:"slack_channel,omitempty"` // Slack channel ID to notify on match
+}
+
+// UpdateSavedViewRequest is the body of PATCH /saved-views/:id. Omitted
+// fields are left unchanged; an empty slack_channel unsubscribes from Slack.
+type UpdateSavedViewRequest struct {
+	Name         *string                 `json:"name,omitempty"`
+	Filter       *schema.SavedViewFilter `json:"filter,omitempty"`
+	Notify       *bool                   `json:"notify,omitempty"`
+	SlackChannel *string                 `json:"slack_channel,omitempty"`
+}
+
+// SavedViewResponse is a saved view as returned by the saved-views endpoints.
+type SavedViewResponse struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Filter       schema.SavedViewFilter `json:"filter"`
+	Notify       bool                   `json:"notify"`
+	SlackChannel *string                `json:"slack_channel"`
+	CreatedAt    time.Time              `json:"created_at"`
+	UpdatedAt    time.Time              `json:"updated_at"`
+}
+
+// SavedViewListResponse is the HTTP response for GET /saved-views.
+type SavedViewListResponse struct {
+	Views []SavedViewResponse `json:"views"`
+}
+
+// NewSavedViewResponse converts a stored view to its API shape.
+func NewSavedViewResponse(v *ent.SavedView) SavedViewResponse {
+	return SavedViewResponse{
+		ID:           v.ID,
+		Name:         v.Name,
+		Filter:       v.Filter,
+		Notify:       v.Notify,
+		SlackChannel: v.SlackChannel,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+	}
+}
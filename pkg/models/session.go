@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 )
 
 // CreateSessionRequest contains fields for creating a new alert session
@@ -66,6 +67,10 @@ type DashboardListParams struct {
 	ReviewStatus  string     `json:"review_status"`  // comma-separated: needs_review, in_progress, reviewed
 	Assignee      string     `json:"assignee"`       // exact match filter
 	QualityRating string     `json:"quality_rating"` // accurate, partially_accurate, inaccurate
+	Outcome       string     `json:"outcome"`        // comma-separated outcome taxonomy labels
+	RequestID     string     `json:"request_id"`     // exact match on the submitting request's X-Request-ID
+	Sandbox       bool       `json:"sandbox"`        // list sandbox runs instead of real sessions
+	FallbackChain bool       `json:"fallback_chain"` // only sessions whose alert type matched no chain
 }
 
 // DashboardSessionItem is a single session in the dashboard list with pre-computed stats.
@@ -81,6 +86,7 @@ type DashboardSessionItem struct {
 	DurationMs            *int64           `json:"duration_ms"`
 	ErrorMessage          *string          `json:"error_message"`
 	ExecutiveSummary      *string          `json:"executive_summary"`
+	Outcome               *string          `json:"outcome"`
 	LLMInteractionCount   int              `json:"llm_interaction_count"`
 	MCPInteractionCount   int              `json:"mcp_interaction_count"`
 	InputTokens           int64            `json:"input_tokens"`
@@ -96,6 +102,8 @@ type DashboardSessionItem struct {
 	ActionsExecuted       *bool            `json:"actions_executed"`
 	ChatMessageCount      int              `json:"chat_message_count"`
 	ProviderFallbackCount int              `json:"provider_fallback_count"`
+	DegradedReason        *string          `json:"degraded_reason,omitempty"`
+	FallbackChain         bool             `json:"fallback_chain,omitempty"`
 	CurrentStageIndex     *int             `json:"current_stage_index"`
 	CurrentStageID        *string          `json:"current_stage_id"`
 	MatchedInContent      bool             `json:"matched_in_content"`
@@ -161,19 +169,52 @@ type QueuedSessionItem struct {
 // SessionDetailResponse is the enriched session detail DTO.
 type SessionDetailResponse struct {
 	// Core fields (from AlertSession)
-	ID                      string         `json:"id"`
-	AlertData               string         `json:"alert_data"`
-	AlertType               *string        `json:"alert_type"`
-	Status                  string         `json:"status"`
-	ChainID                 string         `json:"chain_id"`
-	Author                  *string        `json:"author"`
-	ErrorMessage            *string        `json:"error_message"`
-	FinalAnalysis           *string        `json:"final_analysis"`
-	ExecutiveSummary        *string        `json:"executive_summary"`
-	ExecutiveSummaryError   *string        `json:"executive_summary_error"`
-	RunbookURL              *string        `json:"runbook_url"`
-	SlackMessageFingerprint *string        `json:"slack_message_fingerprint,omitempty"`
-	MCPSelection            map[string]any `json:"mcp_selection,omitempty"`
+	ID                      string                       `json:"id"`
+	AlertData               string                       `json:"alert_data"`
+	AlertType               *string                      `json:"alert_type"`
+	Status                  string                       `json:"status"`
+	ChainID                 string                       `json:"chain_id"`
+	Owner                   *string                      `json:"owner,omitempty"`
+	Author                  *string                      `json:"author"`
+	ErrorMessage            *string                      `json:"error_message"`
+	FinalAnalysis           *string                      `json:"final_analysis"`
+	ExecutiveSummary        *string                      `json:"executive_summary"`
+	ExecutiveSummaryError   *string                      `json:"executive_summary_error"`
+	ExecSummaryTemplate     *string                      `json:"executive_summary_template,omitempty"`
+	TechnicalSummary        *string                      `json:"technical_summary,omitempty"`
+	TechnicalSummaryError   *string                      `json:"technical_summary_error,omitempty"`
+	Severity                *string                      `json:"severity"`
+	Outcome                 *string                      `json:"outcome"`
+	OutcomeReason           *string                      `json:"outcome_reason,omitempty"`
+	OutcomeSource           *string                      `json:"outcome_source,omitempty"`
+	RunbookURL              *string                      `json:"runbook_url"`
+	SlackMessageFingerprint *string                      `json:"slack_message_fingerprint,omitempty"`
+	RequestID               *string                      `json:"request_id,omitempty"`
+	AlertKey                *string                      `json:"alert_key,omitempty"`
+	AlertResolvedAt         *time.Time                   `json:"alert_resolved_at,omitempty"`
+	AlertResolution         *string                      `json:"alert_resolution,omitempty"`
+	CancelInitiator         *string                      `json:"cancel_initiator,omitempty"`
+	CancelReason            *string                      `json:"cancel_reason,omitempty"`
+	CancelledBy             *string                      `json:"cancelled_by,omitempty"`
+	DegradedReason          *string                      `json:"degraded_reason,omitempty"`
+	ModelRouting            *schema.ModelRoutingDecision `json:"model_routing,omitempty"`
+	NoiseTriage             *schema.NoiseTriageDecision  `json:"noise_triage,omitempty"`
+	OnCall                  *schema.OnCallContact        `json:"on_call,omitempty"`
+	ForceFullInvestigation  bool                         `json:"force_full_investigation,omitempty"`
+	FederationOrigin        *schema.FederationOrigin     `json:"federation_origin,omitempty"`
+	Target                  *schema.AlertTarget          `json:"target,omitempty"`
+	GroupID                 *string                      `json:"group_id,omitempty"`
+	DuplicatedFrom          *string                      `json:"duplicated_from,omitempty"`
+	LLMProvider             *string                      `json:"llm_provider,omitempty"`
+	Sandbox                 bool                         `json:"sandbox,omitempty"`
+	FallbackChain           bool                         `json:"fallback_chain,omitempty"`
+	MCPSelection            map[string]any               `json:"mcp_selection,omitempty"`
+	AlertInstructions       map[string]any               `json:"alert_instructions,omitempty"`
+	AlertImages             []MessageImage               `json:"alert_images,omitempty"`
+	OutputLanguage          *string                      `json:"output_language,omitempty"`
+	Callback                *CallbackDeliveryResponse    `json:"callback,omitempty"`
+	Comparison              *SessionComparisonResponse   `json:"comparison,omitempty"`      // vs the previous investigation of the same alert
+	NextComparison          *SessionComparisonResponse   `json:"next_comparison,omitempty"` // latest later investigation vs this one
 
 	// Timestamps
 	CreatedAt   time.Time  `json:"created_at"`
@@ -183,6 +224,7 @@ type SessionDetailResponse struct {
 	// Computed fields
 	DurationMs               *int64           `json:"duration_ms"`
 	ChatEnabled              bool             `json:"chat_enabled"`
+	VoiceNotesEnabled        bool             `json:"voice_notes_enabled"` // Chat accepts voice notes (system.transcription)
 	ChatID                   *string          `json:"chat_id"`
 	ChatMessageCount         int              `json:"chat_message_count"`
 	TotalStages              int              `json:"total_stages"`
@@ -218,6 +260,12 @@ type SessionDetailResponse struct {
 	FeedbackEditedBy      *string    `json:"feedback_edited_by"`
 	FeedbackEditedAt      *time.Time `json:"feedback_edited_at"`
 
+	// Handoff notes (history at GET /sessions/:id/handoff-notes/revisions)
+	HandoffNotes          *string    `json:"handoff_notes"`
+	HandoffNotesRevision  int        `json:"handoff_notes_revision"`
+	HandoffNotesUpdatedBy *string    `json:"handoff_notes_updated_by"`
+	HandoffNotesUpdatedAt *time.Time `json:"handoff_notes_updated_at"`
+
 	// Stage list
 	Stages []StageOverview `json:"stages"`
 }
@@ -262,6 +310,7 @@ type ExecutionOverview struct {
 	FallbackReason           *string             `json:"fallback_reason,omitempty"`
 	FallbackErrorCode        *string             `json:"fallback_error_code,omitempty"`
 	FallbackAttempt          *int                `json:"fallback_attempt,omitempty"`
+	LoopDetected             bool                `json:"loop_detected,omitempty"`
 	SubAgents                []ExecutionOverview `json:"sub_agents,omitempty"`
 }
 
@@ -292,6 +341,8 @@ type SessionStatusResponse struct {
 	Status           string  `json:"status"`
 	FinalAnalysis    *string `json:"final_analysis"`
 	ExecutiveSummary *string `json:"executive_summary"`
+	TechnicalSummary *string `json:"technical_summary,omitempty"`
+	Severity         *string `json:"severity"`
 	ErrorMessage     *string `json:"error_message"`
 }
 
@@ -353,19 +404,21 @@ type UsageSummaryParams struct {
 	EndDate   time.Time   // created_at < end (required)
 	AlertType string      // optional exact filter
 	ChainID   string      // optional exact filter
+	Outcome   string      // optional exact filter on the outcome taxonomy label
 	RankBy    UsageRankBy // cost or tokens; empty means default from costEstimationEnabled
 }
 
 // UsageSummaryResponse is returned by GET /api/v1/usage/summary.
 type UsageSummaryResponse struct {
-	CostEstimationEnabled bool                  `json:"cost_estimation_enabled"`
-	Window                UsageWindow           `json:"window"`
-	RankBy                UsageRankBy           `json:"rank_by"`
-	Totals                UsageTotals           `json:"totals"`
-	ByModel               []UsageModelBreakdown `json:"by_model"`
-	ByAlertType           []UsageAlertBreakdown `json:"by_alert_type"`
-	ByChain               []UsageChainBreakdown `json:"by_chain"`
-	TopSessions           []UsageTopSession     `json:"top_sessions"`
+	CostEstimationEnabled bool                    `json:"cost_estimation_enabled"`
+	Window                UsageWindow             `json:"window"`
+	RankBy                UsageRankBy             `json:"rank_by"`
+	Totals                UsageTotals             `json:"totals"`
+	ByModel               []UsageModelBreakdown   `json:"by_model"`
+	ByAlertType           []UsageAlertBreakdown   `json:"by_alert_type"`
+	ByChain               []UsageChainBreakdown   `json:"by_chain"`
+	ByOutcome             []UsageOutcomeBreakdown `json:"by_outcome"`
+	TopSessions           []UsageTopSession       `json:"top_sessions"`
 }
 
 // UsageWindow echoes the requested date range.
@@ -409,6 +462,15 @@ type UsageChainBreakdown struct {
 	EstimatedCostUsd *float64 `json:"estimated_cost_usd,omitempty"`
 }
 
+// UsageOutcomeBreakdown is a per-outcome rollup within the window. Outcome
+// "" collects sessions not (yet) classified.
+type UsageOutcomeBreakdown struct {
+	Outcome          string   `json:"outcome"`
+	SessionCount     int      `json:"session_count"`
+	TotalTokens      int64    `json:"total_tokens"`
+	EstimatedCostUsd *float64 `json:"estimated_cost_usd,omitempty"`
+}
+
 // UsageTopSession is one of the capped top sessions in the window.
 type UsageTopSession struct {
 	SessionID        string           `json:"session_id"`
@@ -420,6 +482,27 @@ type UsageTopSession struct {
 	CreatedAt        time.Time        `json:"created_at"`
 }
 
+// UsageOwnersResponse is returned by GET /api/v1/usage/owners.
+type UsageOwnersResponse struct {
+	CostEstimationEnabled bool                  `json:"cost_estimation_enabled"`
+	Window                UsageWindow           `json:"window"`
+	Owners                []UsageOwnerBreakdown `json:"owners"`
+}
+
+// UsageOwnerBreakdown is a per-owner rollup within the window, for
+// chargeback. Sessions count toward their chain's owner; tokens and cost
+// toward the owner of the agent that made each call, so an owner can have
+// usage without sessions. Owner "" collects everything unattributed.
+type UsageOwnerBreakdown struct {
+	Owner                    string   `json:"owner"`
+	SessionCount             int      `json:"session_count"`
+	InputTokens              int64    `json:"input_tokens"`
+	OutputTokens             int64    `json:"output_tokens"`
+	TotalTokens              int64    `json:"total_tokens"`
+	EstimatedCostUsd         *float64 `json:"estimated_cost_usd,omitempty"`
+	UnpricedInteractionCount *int     `json:"unpriced_interaction_count,omitempty"`
+}
+
 // --- Review workflow DTOs ---
 
 // ReviewAction represents a workflow transition action.
@@ -448,10 +531,10 @@ func ValidReviewAction(s string) bool {
 // UpdateReviewRequest is the request body for PATCH /api/v1/sessions/review.
 // SessionIDs contains one or more session IDs to apply the action to.
 type UpdateReviewRequest struct {
-	SessionIDs            []string `json:"session_ids"`
-	Action                string   `json:"action"`
+	SessionIDs            []string `json:"session_ids" validate:"required,max=50"`
+	Action                string   `json:"action" validate:"required,oneof=claim unclaim complete reopen update_feedback acknowledge"`
 	Actor                 string   `json:"-"`
-	QualityRating         *string  `json:"quality_rating,omitempty"`
+	QualityRating         *string  `json:"quality_rating,omitempty" validate:"oneof=accurate partially_accurate inaccurate"`
 	ActionTaken           *string  `json:"action_taken,omitempty"`
 	InvestigationFeedback *string  `json:"investigation_feedback,omitempty"`
 }
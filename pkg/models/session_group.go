@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Aggregate statuses of a fan-out session group.
+const (
+	SessionGroupStatusPending    = "pending"     // no sibling has started
+	SessionGroupStatusInProgress = "in_progress" // at least one sibling is still queued or running
+	SessionGroupStatusCompleted  = "completed"   // every sibling completed
+	SessionGroupStatusPartial    = "partial"     // all terminal, some completed
+	SessionGroupStatusFailed     = "failed"      // all terminal, none completed
+)
+
+// SessionGroupResponse is returned by GET /api/v1/session-groups/:id.
+type SessionGroupResponse struct {
+	ID              string               `json:"id"`
+	AlertType       string               `json:"alert_type"`
+	PrimaryChainID  string               `json:"primary_chain_id"`
+	Status          string               `json:"status"`
+	CreatedAt       time.Time            `json:"created_at"`
+	Sessions        []SessionGroupMember `json:"sessions"`
+	SynthesisStatus string               `json:"synthesis_status"`
+	Summary         *string              `json:"summary"`
+	SummaryError    *string              `json:"summary_error"`
+	SynthesizedAt   *time.Time           `json:"synthesized_at"`
+}
+
+// SessionGroupMember is one sibling session of a fan-out group.
+type SessionGroupMember struct {
+	ID               string     `json:"id"`
+	ChainID          string     `json:"chain_id"`
+	Status           string     `json:"status"`
+	ExecutiveSummary *string    `json:"executive_summary"`
+	Severity         *string    `json:"severity"`
+	ErrorMessage     *string    `json:"error_message"`
+	StartedAt        *time.Time `json:"started_at"`
+	CompletedAt      *time.Time `json:"completed_at"`
+}
@@ -18,9 +18,51 @@ type CreateTimelineEventRequest struct {
 	Status            timelineevent.Status    `json:"status,omitempty"` // defaults to StatusStreaming if empty
 	Content           string                  `json:"content"`
 	Metadata          map[string]any          `json:"metadata,omitempty"`
+	// Highlight overrides the default highlight for EventType (see
+	// services.DefaultHighlight). nil keeps the default.
+	Highlight *timelineevent.Highlight `json:"highlight,omitempty"`
 }
 
 // TimelineEventResponse wraps a TimelineEvent
 type TimelineEventResponse struct {
 	*ent.TimelineEvent
 }
+
+// TimelinePageParams selects a page of a session's timeline, in sequence
+// order. Zero values leave a bound or filter unset.
+type TimelinePageParams struct {
+	AfterSequence  int                       // exclusive lower bound; the previous page's next_after_sequence
+	BeforeSequence int                       // exclusive upper bound
+	EventTypes     []timelineevent.EventType // only these event types
+	ExecutionID    string                    // only this agent execution's events
+	Limit          int
+}
+
+// TimelinePageResponse is returned by GET /api/v1/sessions/:id/timeline/events.
+type TimelinePageResponse struct {
+	Events  []*ent.TimelineEvent `json:"events"`
+	HasMore bool                 `json:"has_more"`
+	// NextAfterSequence is the after_sequence of the next page; nil when
+	// the page is empty.
+	NextAfterSequence *int `json:"next_after_sequence,omitempty"`
+}
+
+// TimelineSummaryResponse is returned by GET /api/v1/sessions/:id/timeline/summary.
+// It sizes a timeline so clients can load it lazily.
+type TimelineSummaryResponse struct {
+	TotalEvents int                        `json:"total_events"`
+	Highlights  int                        `json:"highlights"`
+	MaxSequence int                        `json:"max_sequence"`
+	EventTypes  map[string]int             `json:"event_types"` // event count per event type
+	Executions  []TimelineExecutionSummary `json:"executions"`
+}
+
+// TimelineExecutionSummary counts the timeline events of one agent execution.
+type TimelineExecutionSummary struct {
+	ExecutionID       *string `json:"execution_id"` // nil for session-level events
+	StageID           *string `json:"stage_id"`
+	ParentExecutionID *string `json:"parent_execution_id,omitempty"` // set for sub-agents
+	Events            int     `json:"events"`
+	FirstSequence     int     `json:"first_sequence"`
+	LastSequence      int     `json:"last_sequence"`
+}
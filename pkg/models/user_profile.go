@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// UserIdentity is the caller identity forwarded by the auth proxy.
+type UserIdentity struct {
+	Subject           string // OIDC subject; profiles are keyed by it
+	Email             string
+	PreferredUsername string // seeds display_name when a profile is created
+}
+
+// UpdateUserProfileRequest is the body of PATCH /profile. Omitted fields are
+// left unchanged; an empty display_name clears it, and an empty
+// default_filters object or favorite_chains array clears those.
+type UpdateUserProfileRequest struct {
+	DisplayName    *string                         `json:"display_name,omitempty"`
+	Notifications  *schema.NotificationPreferences `json:"notifications,omitempty"`
+	DefaultFilters map[string]string               `json:"default_filters,omitempty"`
+	FavoriteChains []string                        `json:"favorite_chains,omitempty"`
+}
+
+// UserProfileResponse is the HTTP response for GET/PATCH /profile.
+type UserProfileResponse struct {
+	Subject     string                 `json:"subject"`
+	DisplayName *string                `json:"display_name"`
+	Email       *string                `json:"email"`
+	Preferences schema.UserPreferences `json:"preferences"`
+	Channel     string                 `json:"channel"` // WebSocket channel for personal notifications (saved view matches)
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   time.Time              `json:"updated_at"`
+}
+
+// NewUserProfileResponse converts a stored profile to its API shape.
+// Channel is left for the caller to fill in.
+func NewUserProfileResponse(p *ent.UserProfile) UserProfileResponse {
+	return UserProfileResponse{
+		Subject:     p.ID,
+		DisplayName: p.DisplayName,
+		Email:       p.Email,
+		Preferences: p.Preferences,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
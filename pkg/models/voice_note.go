@@ -0,0 +1,58 @@
+package models
+
+import (
+	"fmt"
+	"mime"
+)
+
+// MaxVoiceNoteSize caps a voice note attached to a chat message. A couple of
+// minutes of compressed speech is well under this.
+const MaxVoiceNoteSize = 8 * 1024 * 1024 // 8 MB
+
+// voiceNoteExtensions maps the accepted audio formats to the file extension
+// transcription APIs use to detect the format.
+var voiceNoteExtensions = map[string]string{
+	"audio/webm": ".webm",
+	"audio/ogg":  ".ogg",
+	"audio/mpeg": ".mp3",
+	"audio/mp4":  ".m4a",
+	"audio/wav":  ".wav",
+	"audio/flac": ".flac",
+}
+
+// VoiceNoteAttachment is a short audio recording sent with a chat message.
+// It is transcribed and the transcript becomes the question. Data is
+// base64-encoded in JSON.
+type VoiceNoteAttachment struct {
+	Name     string `json:"name,omitempty"`
+	MimeType string `json:"mime_type"` // Parameters allowed, e.g. "audio/webm;codecs=opus"
+	Data     []byte `json:"data"`
+}
+
+// VoiceNoteExtension returns the file extension for an accepted audio MIME
+// type (parameters ignored), or "" when the type is not accepted.
+func VoiceNoteExtension(mimeType string) string {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return ""
+	}
+	return voiceNoteExtensions[mediaType]
+}
+
+// ValidateVoiceNote checks size and format limits. Returns a description of
+// the violation, or "" when the voice note is acceptable (or nil).
+func ValidateVoiceNote(note *VoiceNoteAttachment) string {
+	if note == nil {
+		return ""
+	}
+	if len(note.Data) == 0 {
+		return "voice note is empty"
+	}
+	if len(note.Data) > MaxVoiceNoteSize {
+		return fmt.Sprintf("voice note exceeds maximum size of %d bytes", MaxVoiceNoteSize)
+	}
+	if VoiceNoteExtension(note.MimeType) == "" {
+		return fmt.Sprintf("voice note has unsupported mime_type %q (webm, ogg, mpeg, mp4, wav, or flac audio)", note.MimeType)
+	}
+	return ""
+}
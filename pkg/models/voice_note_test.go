@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVoiceNoteExtension(t *testing.T) {
+	assert.Equal(t, ".webm", VoiceNoteExtension("audio/webm;codecs=opus"))
+	assert.Equal(t, ".m4a", VoiceNoteExtension("audio/mp4"))
+	assert.Equal(t, ".mp3", VoiceNoteExtension("audio/mpeg"))
+	assert.Empty(t, VoiceNoteExtension("video/webm"))
+	assert.Empty(t, VoiceNoteExtension(""))
+}
+
+func TestValidateVoiceNote(t *testing.T) {
+	tests := []struct {
+		name    string
+		note    *VoiceNoteAttachment
+		wantErr string
+	}{
+		{name: "none"},
+		{name: "valid", note: &VoiceNoteAttachment{MimeType: "audio/webm;codecs=opus", Data: []byte("OggS")}},
+		{name: "empty", note: &VoiceNoteAttachment{MimeType: "audio/webm"}, wantErr: "voice note is empty"},
+		{
+			name:    "too large",
+			note:    &VoiceNoteAttachment{MimeType: "audio/webm", Data: make([]byte, MaxVoiceNoteSize+1)},
+			wantErr: "exceeds maximum size",
+		},
+		{
+			name:    "unsupported type",
+			note:    &VoiceNoteAttachment{MimeType: "audio/x-aiff", Data: []byte("FORM")},
+			wantErr: `unsupported mime_type "audio/x-aiff"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := ValidateVoiceNote(tt.note)
+			if tt.wantErr == "" {
+				assert.Empty(t, msg)
+				return
+			}
+			assert.Contains(t, msg, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package oncall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// Default API base URLs.
+const (
+	DefaultPagerDutyURL = "https://api.pagerduty.com"
+	DefaultOpsgenieURL  = "https://api.opsgenie.com"
+)
+
+// requestTimeout bounds one schedule API request.
+const requestTimeout = 10 * time.Second
+
+// Shift is who is on call for a schedule right now. Name is empty when
+// nobody is.
+type Shift struct {
+	Name  string
+	Email string
+	Until *time.Time
+}
+
+// Client looks up the current on-call shift of a schedule.
+type Client interface {
+	CurrentShift(ctx context.Context, scheduleID string) (*Shift, error)
+}
+
+// PagerDutyClient reads on-call shifts from the PagerDuty REST API.
+type PagerDutyClient struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+// NewPagerDutyClient creates a PagerDuty client. An empty baseURL uses
+// DefaultPagerDutyURL.
+func NewPagerDutyClient(baseURL, token string) *PagerDutyClient {
+	if baseURL == "" {
+		baseURL = DefaultPagerDutyURL
+	}
+	return &PagerDutyClient{httpClient: &http.Client{}, baseURL: baseURL, token: token}
+}
+
+// CurrentShift returns the first-level on-call user of the schedule.
+func (c *PagerDutyClient) CurrentShift(ctx context.Context, scheduleID string) (*Shift, error) {
+	query := url.Values{}
+	query.Set("schedule_ids[]", scheduleID)
+	query.Set("include[]", "users")
+	query.Set("earliest", "true")
+
+	var body struct {
+		Oncalls []struct {
+			EscalationLevel int        `json:"escalation_level"`
+			End             *time.Time `json:"end"`
+			User            struct {
+				Name    string `json:"name"`
+				Summary string `json:"summary"`
+				Email   string `json:"email"`
+			} `json:"user"`
+		} `json:"oncalls"`
+	}
+	headers := map[string]string{
+		"Authorization": "Token token=" + c.token,
+		"Accept":        "application/vnd.pagerduty+json;version=2",
+	}
+	if err := getJSON(ctx, c.httpClient, c.baseURL+"/oncalls?"+query.Encode(), headers, &body); err != nil {
+		return nil, err
+	}
+
+	oncalls := body.Oncalls
+	sort.SliceStable(oncalls, func(i, j int) bool { return oncalls[i].EscalationLevel < oncalls[j].EscalationLevel })
+	if len(oncalls) == 0 {
+		return &Shift{}, nil
+	}
+	first := oncalls[0]
+	name := first.User.Name
+	if name == "" {
+		name = first.User.Summary
+	}
+	return &Shift{Name: name, Email: first.User.Email, Until: first.End}, nil
+}
+
+// OpsgenieClient reads on-call participants from the Opsgenie API.
+type OpsgenieClient struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+// NewOpsgenieClient creates an Opsgenie client. An empty baseURL uses
+// DefaultOpsgenieURL.
+func NewOpsgenieClient(baseURL, apiKey string) *OpsgenieClient {
+	if baseURL == "" {
+		baseURL = DefaultOpsgenieURL
+	}
+	return &OpsgenieClient{httpClient: &http.Client{}, baseURL: baseURL, apiKey: apiKey}
+}
+
+// CurrentShift returns the first on-call user of the schedule. Opsgenie
+// names users by their login, which is their email address.
+func (c *OpsgenieClient) CurrentShift(ctx context.Context, scheduleID string) (*Shift, error) {
+	var body struct {
+		Data struct {
+			OnCallRecipients []string `json:"onCallRecipients"`
+		} `json:"data"`
+	}
+	endpoint := fmt.Sprintf("%s/v2/schedules/%s/on-calls?scheduleIdentifierType=id&flat=true",
+		c.baseURL, url.PathEscape(scheduleID))
+	headers := map[string]string{"Authorization": "GenieKey " + c.apiKey}
+	if err := getJSON(ctx, c.httpClient, endpoint, headers, &body); err != nil {
+		return nil, err
+	}
+
+	if len(body.Data.OnCallRecipients) == 0 {
+		return &Shift{}, nil
+	}
+	login := body.Data.OnCallRecipients[0]
+	return &Shift{Name: login, Email: login}, nil
+}
+
+// getJSON issues a GET request and decodes a 2xx JSON response into out.
+func getJSON(ctx context.Context, httpClient *http.Client, endpoint string, headers map[string]string, out any) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("schedule API request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("schedule API returned status %d: %s", resp.StatusCode, string(snippet))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode schedule API response: %w", err)
+	}
+	return nil
+}
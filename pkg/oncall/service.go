@@ -0,0 +1,193 @@
+// Package oncall looks up who is on call for an alert's service when a
+// session starts, so agents can name the right person to page and
+// notifications can mention them.
+package oncall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// persistTimeout bounds the write of the on-call contact to the session.
+const persistTimeout = 5 * time.Second
+
+// cachedShift is a schedule lookup reused until expiresAt.
+type cachedShift struct {
+	shift     *Shift
+	expiresAt time.Time
+}
+
+// Service resolves the on-call engineer of a session's service and records
+// it on the session. Lookups are best-effort: failures are logged and
+// recorded on the contact, never failing the session. Nil-safe: all
+// methods are no-ops when service is nil.
+type Service struct {
+	client    *ent.Client
+	cfg       *config.Config
+	schedules Client
+	logger    *slog.Logger
+
+	mu    sync.Mutex
+	cache map[string]cachedShift
+	now   func() time.Time
+}
+
+// NewService creates the on-call service. token is the schedule API token.
+// Returns nil when on-call lookup is disabled.
+func NewService(client *ent.Client, cfg *config.Config, token string) *Service {
+	if !cfg.OnCall.Enabled() {
+		return nil
+	}
+	var schedules Client
+	switch cfg.OnCall.Provider {
+	case config.OnCallProviderOpsgenie:
+		schedules = NewOpsgenieClient(cfg.OnCall.APIURL, token)
+	default:
+		schedules = NewPagerDutyClient(cfg.OnCall.APIURL, token)
+	}
+	return newService(client, cfg, schedules)
+}
+
+func newService(client *ent.Client, cfg *config.Config, schedules Client) *Service {
+	return &Service{
+		client:    client,
+		cfg:       cfg,
+		schedules: schedules,
+		logger:    slog.Default().With("component", "on-call"),
+		cache:     make(map[string]cachedShift),
+		now:       time.Now,
+	}
+}
+
+// Attach resolves the session's on-call contact, stores it on the session
+// and returns the updated session. Returns the session unchanged when no
+// service is known for it or the contact could not be stored.
+func (s *Service) Attach(ctx context.Context, session *ent.AlertSession) *ent.AlertSession {
+	if s == nil || session == nil {
+		return session
+	}
+	contact := s.Resolve(ctx, session.ChainID, session.AlertData)
+	if contact == nil {
+		return session
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, persistTimeout)
+	defer cancel()
+	updated, err := s.client.AlertSession.UpdateOneID(session.ID).SetOnCall(contact).Save(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to record on-call contact", "session_id", session.ID, "error", err)
+		return session
+	}
+	return updated
+}
+
+// Resolve returns the on-call contact for a session of the given chain and
+// alert data, or nil when no configured service applies. A failed lookup
+// returns a contact with Error set.
+func (s *Service) Resolve(ctx context.Context, chainID, alertData string) *schema.OnCallContact {
+	if s == nil {
+		return nil
+	}
+	serviceName := s.serviceFor(chainID, alertData)
+	svc := s.cfg.OnCall.Services[serviceName]
+	if svc == nil {
+		return nil
+	}
+
+	contact := &schema.OnCallContact{
+		Service:    serviceName,
+		Team:       svc.Team,
+		Provider:   string(s.cfg.OnCall.Provider),
+		ScheduleID: svc.ScheduleID,
+		ResolvedAt: s.now(),
+	}
+	shift, err := s.currentShift(ctx, svc.ScheduleID)
+	if err != nil {
+		s.logger.Warn("On-call lookup failed", "service", serviceName, "schedule_id", svc.ScheduleID, "error", err)
+		contact.Error = err.Error()
+		return contact
+	}
+	contact.Name = shift.Name
+	contact.Email = shift.Email
+	contact.Until = shift.Until
+	return contact
+}
+
+// serviceFor picks the session's service: the chain's on_call_service, then
+// the alert's service label, then the configured default.
+func (s *Service) serviceFor(chainID, alertData string) string {
+	if chain, err := s.cfg.ChainRegistry.Get(chainID); err == nil && chain.OnCallService != "" {
+		return chain.OnCallService
+	}
+	if name := ServiceName(alertData, s.cfg.OnCall.ServiceLabel); name != "" {
+		if _, ok := s.cfg.OnCall.Services[name]; ok {
+			return name
+		}
+	}
+	return s.cfg.OnCall.DefaultService
+}
+
+// currentShift returns the schedule's current shift, from cache when fresh.
+// Failed lookups are not cached.
+func (s *Service) currentShift(ctx context.Context, scheduleID string) (*Shift, error) {
+	s.mu.Lock()
+	cached, ok := s.cache[scheduleID]
+	s.mu.Unlock()
+	now := s.now()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.shift, nil
+	}
+
+	shift, err := s.schedules.CurrentShift(ctx, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up schedule %s: %w", scheduleID, err)
+	}
+	expiresAt := now.Add(s.cfg.OnCall.CacheTTL)
+	// Do not serve a shift past its end from cache.
+	if shift.Until != nil && shift.Until.Before(expiresAt) {
+		expiresAt = *shift.Until
+	}
+	s.mu.Lock()
+	s.cache[scheduleID] = cachedShift{shift: shift, expiresAt: expiresAt}
+	s.mu.Unlock()
+	return shift, nil
+}
+
+// ServiceName extracts the service named by label from JSON alert data. The
+// label is looked up at the top level, then under "labels" and
+// "commonLabels" (Alertmanager webhooks). Returns "" for non-JSON data or
+// when the label is absent.
+func ServiceName(alertData, label string) string {
+	if label == "" {
+		return ""
+	}
+	var data map[string]any
+	if err := json.Unmarshal([]byte(alertData), &data); err != nil {
+		return ""
+	}
+	if v := stringValue(data[label]); v != "" {
+		return v
+	}
+	for _, key := range []string{"labels", "commonLabels"} {
+		if nested, ok := data[key].(map[string]any); ok {
+			if v := stringValue(nested[label]); v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+func stringValue(v any) string {
+	s, _ := v.(string)
+	return strings.TrimSpace(s)
+}
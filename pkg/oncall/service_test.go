@@ -0,0 +1,191 @@
+package oncall
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+type fakeSchedules struct {
+	shift *Shift
+	err   error
+	calls int
+}
+
+func (f *fakeSchedules) CurrentShift(_ context.Context, _ string) (*Shift, error) {
+	f.calls++
+	return f.shift, f.err
+}
+
+func testConfig() *config.Config {
+	onCall := config.DefaultOnCallConfig()
+	onCall.Provider = config.OnCallProviderPagerDuty
+	onCall.Services = map[string]*config.OnCallServiceConfig{
+		"payments": {ScheduleID: "PSCHED1", Team: "Payments SRE"},
+		"platform": {ScheduleID: "PSCHED2"},
+	}
+	return &config.Config{
+		OnCall: onCall,
+		ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{
+			"k8s":      {},
+			"payments": {OnCallService: "payments"},
+		}),
+	}
+}
+
+func TestNewService_Disabled(t *testing.T) {
+	assert.Nil(t, NewService(nil, &config.Config{}, ""))
+	assert.Nil(t, NewService(nil, &config.Config{OnCall: config.DefaultOnCallConfig()}, ""))
+	assert.NotNil(t, NewService(nil, testConfig(), "token"))
+
+	// Nil service is a no-op
+	var s *Service
+	session := &ent.AlertSession{ID: "s1"}
+	assert.Same(t, session, s.Attach(context.Background(), session))
+	assert.Nil(t, s.Resolve(context.Background(), "k8s", "{}"))
+}
+
+func TestServiceName(t *testing.T) {
+	tests := []struct {
+		name      string
+		alertData string
+		want      string
+	}{
+		{"top level", `{"service":"payments"}`, "payments"},
+		{"labels", `{"labels":{"service":" payments "}}`, "payments"},
+		{"alertmanager common labels", `{"commonLabels":{"service":"platform"},"alerts":[]}`, "platform"},
+		{"top level wins", `{"service":"payments","labels":{"service":"platform"}}`, "payments"},
+		{"non-string value", `{"service":42}`, ""},
+		{"absent", `{"alertname":"PodCrash"}`, ""},
+		{"not JSON", "service=payments", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ServiceName(tt.alertData, "service"))
+		})
+	}
+	assert.Empty(t, ServiceName(`{"service":"payments"}`, ""))
+}
+
+func TestResolve(t *testing.T) {
+	until := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	t.Run("chain service wins over the alert label", func(t *testing.T) {
+		schedules := &fakeSchedules{shift: &Shift{Name: "Ada", Email: "ada@example.com", Until: &until}}
+		s := newService(nil, testConfig(), schedules)
+		contact := s.Resolve(context.Background(), "payments", `{"service":"platform"}`)
+		require.NotNil(t, contact)
+		assert.Equal(t, "payments", contact.Service)
+		assert.Equal(t, "Payments SRE", contact.Team)
+		assert.Equal(t, "pagerduty", contact.Provider)
+		assert.Equal(t, "PSCHED1", contact.ScheduleID)
+		assert.Equal(t, "Ada", contact.Name)
+		assert.Equal(t, "ada@example.com", contact.Email)
+		assert.Equal(t, &until, contact.Until)
+		assert.Empty(t, contact.Error)
+	})
+
+	t.Run("alert label", func(t *testing.T) {
+		s := newService(nil, testConfig(), &fakeSchedules{shift: &Shift{Name: "Bob"}})
+		contact := s.Resolve(context.Background(), "k8s", `{"labels":{"service":"platform"}}`)
+		require.NotNil(t, contact)
+		assert.Equal(t, "platform", contact.Service)
+	})
+
+	t.Run("unknown service falls back to the default", func(t *testing.T) {
+		cfg := testConfig()
+		s := newService(nil, cfg, &fakeSchedules{shift: &Shift{Name: "Bob"}})
+		assert.Nil(t, s.Resolve(context.Background(), "k8s", `{"service":"unknown"}`))
+
+		cfg.OnCall.DefaultService = "platform"
+		contact := s.Resolve(context.Background(), "k8s", `{"service":"unknown"}`)
+		require.NotNil(t, contact)
+		assert.Equal(t, "platform", contact.Service)
+	})
+
+	t.Run("lookup error is recorded", func(t *testing.T) {
+		s := newService(nil, testConfig(), &fakeSchedules{err: errors.New("status 401")})
+		contact := s.Resolve(context.Background(), "payments", "")
+		require.NotNil(t, contact)
+		assert.Empty(t, contact.Name)
+		assert.Contains(t, contact.Error, "status 401")
+	})
+
+	t.Run("caches shifts until the TTL or the shift end", func(t *testing.T) {
+		now := until.Add(-time.Hour)
+		schedules := &fakeSchedules{shift: &Shift{Name: "Ada", Until: &until}}
+		s := newService(nil, testConfig(), schedules)
+		s.now = func() time.Time { return now }
+
+		s.Resolve(context.Background(), "payments", "")
+		now = now.Add(4 * time.Minute)
+		s.Resolve(context.Background(), "payments", "")
+		assert.Equal(t, 1, schedules.calls)
+
+		now = now.Add(2 * time.Minute) // past the 5m TTL
+		s.Resolve(context.Background(), "payments", "")
+		assert.Equal(t, 2, schedules.calls)
+
+		now = until.Add(time.Second) // past the shift end
+		s.Resolve(context.Background(), "payments", "")
+		assert.Equal(t, 3, schedules.calls)
+	})
+}
+
+func TestPagerDutyClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oncalls", r.URL.Path)
+		assert.Equal(t, "PSCHED1", r.URL.Query().Get("schedule_ids[]"))
+		assert.Equal(t, "Token token=secret", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"oncalls":[
+			{"escalation_level":2,"end":"2026-10-17T09:00:00Z","user":{"name":"Manager","email":"mgr@example.com"}},
+			{"escalation_level":1,"end":"2026-10-17T09:00:00Z","user":{"summary":"Ada","email":"ada@example.com"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	shift, err := NewPagerDutyClient(server.URL, "secret").CurrentShift(context.Background(), "PSCHED1")
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", shift.Name)
+	assert.Equal(t, "ada@example.com", shift.Email)
+	require.NotNil(t, shift.Until)
+	assert.Equal(t, time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC), shift.Until.UTC())
+}
+
+func TestOpsgenieClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/schedules/sched-1/on-calls", r.URL.Path)
+		assert.Equal(t, "GenieKey secret", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"data":{"onCallRecipients":["ada@example.com","bob@example.com"]}}`))
+	}))
+	defer server.Close()
+
+	shift, err := NewOpsgenieClient(server.URL, "secret").CurrentShift(context.Background(), "sched-1")
+	require.NoError(t, err)
+	assert.Equal(t, "ada@example.com", shift.Name)
+	assert.Equal(t, "ada@example.com", shift.Email)
+}
+
+func TestClient_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"invalid token"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewPagerDutyClient(server.URL, "bad").CurrentShift(context.Background(), "PSCHED1")
+	assert.ErrorContains(t, err, "status 401")
+
+	shift, err := NewOpsgenieClient(server.URL, "bad").CurrentShift(context.Background(), "sched-1")
+	assert.Nil(t, shift)
+	assert.ErrorContains(t, err, "invalid token")
+}
@@ -0,0 +1,270 @@
+// Package outcome labels completed sessions with the outcome taxonomy
+// (root_cause_identified, mitigated, needs_human, false_positive,
+// inconclusive) so they can be filtered and aggregated by result.
+package outcome
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+const (
+	// classificationTimeout bounds one classification LLM call.
+	classificationTimeout = time.Minute
+
+	// maxAnalysisChars caps the final analysis in the classification prompt.
+	maxAnalysisChars = 15000
+)
+
+// outcomes lists the taxonomy labels.
+var outcomes = []alertsession.Outcome{
+	alertsession.OutcomeRootCauseIdentified,
+	alertsession.OutcomeMitigated,
+	alertsession.OutcomeNeedsHuman,
+	alertsession.OutcomeFalsePositive,
+	alertsession.OutcomeInconclusive,
+}
+
+// Noise triage verdicts (see queue.triageNoise) that closed a session.
+const (
+	noiseVerdictFalsePositive = "false_positive"
+	noiseVerdictDuplicate     = "duplicate"
+)
+
+const classificationSystemPrompt = `You classify the outcome of an automated SRE investigation of an alert.
+Pick exactly one outcome:
+- "root_cause_identified": the investigation found the root cause, but nothing was fixed yet.
+- "mitigated": the problem was fixed, mitigated or had already recovered.
+- "needs_human": a person must act or decide (missing access, risky remediation, escalation).
+- "false_positive": the alert did not reflect a real problem.
+- "inconclusive": the investigation could not determine what happened.
+
+Respond with a single JSON object and nothing else:
+{"outcome": "<one of the outcomes above>", "reason": "<one short sentence>"}`
+
+// explicitOutcomePattern matches an "Outcome: <label>" line written by the
+// agent, tolerating markdown emphasis, headings and list markers.
+var explicitOutcomePattern = regexp.MustCompile("(?im)^[\\s>#*_-]*outcome[*_]*\\s*:[\\s*_`]*([a-z][a-z _-]*[a-z])")
+
+// Result is a session's outcome label and where it came from.
+type Result struct {
+	Outcome alertsession.Outcome
+	Reason  string
+	Source  alertsession.OutcomeSource
+}
+
+// Classifier labels each completed session with its outcome. The worker
+// triggers it as each session completes; sessions that already carry an
+// outcome are left unchanged. Nil-safe: all methods are no-ops when
+// classifier is nil.
+type Classifier struct {
+	client    *ent.Client
+	cfg       *config.Config
+	sideCalls *controller.SideCaller
+	logger    *slog.Logger
+
+	jobs controller.BackgroundJobs
+}
+
+// NewClassifier creates a session outcome classifier. Returns nil when
+// classification is disabled.
+func NewClassifier(client *ent.Client, cfg *config.Config, sideCalls *controller.SideCaller) *Classifier {
+	if cfg.OutcomeClassification == nil || !cfg.OutcomeClassification.Enabled {
+		return nil
+	}
+	return &Classifier{
+		client:    client,
+		cfg:       cfg,
+		sideCalls: sideCalls,
+		logger:    slog.Default().With("component", "outcome-classifier"),
+	}
+}
+
+// SessionCompleted is called by the worker after session completed.
+// Launches the classification in the background; a no-op after Stop.
+func (c *Classifier) SessionCompleted(session *ent.AlertSession) {
+	if c == nil || session == nil {
+		return
+	}
+	sessionID := session.ID
+	c.jobs.Go(classificationTimeout, func(ctx context.Context) {
+		if err := c.Classify(ctx, sessionID); err != nil {
+			c.logger.Warn("Outcome classification failed", "session_id", sessionID, "error", err)
+		}
+	})
+}
+
+// Stop stops launching classifications and waits for the running ones to
+// finish.
+func (c *Classifier) Stop() {
+	if c == nil {
+		return
+	}
+	c.jobs.Stop()
+}
+
+// Classify labels the session. Returns nil without doing anything when the
+// session is not completed, has no final analysis, or already carries an
+// outcome. A failed LLM call leaves the outcome empty and is returned.
+func (c *Classifier) Classify(ctx context.Context, sessionID string) error {
+	if c == nil {
+		return nil
+	}
+
+	session, err := c.client.AlertSession.Get(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	if session.Status != alertsession.StatusCompleted || session.Outcome != nil ||
+		session.FinalAnalysis == nil || *session.FinalAnalysis == "" {
+		return nil
+	}
+
+	result, err := c.resolve(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	// The result must be stored even if the caller's context expired
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	update := c.client.AlertSession.Update().
+		Where(alertsession.IDEQ(session.ID), alertsession.OutcomeIsNil()).
+		SetOutcome(result.Outcome).
+		SetOutcomeSource(result.Source)
+	if result.Reason != "" {
+		update.SetOutcomeReason(result.Reason)
+	}
+	if _, err := update.Save(writeCtx); err != nil {
+		return fmt.Errorf("failed to store outcome: %w", err)
+	}
+
+	c.logger.Info("Session outcome classified",
+		"session_id", session.ID, "outcome", result.Outcome, "source", result.Source)
+	return nil
+}
+
+// resolve picks the outcome: the noise triage verdict for sessions closed
+// by triage, an explicit "Outcome:" line in the final analysis, and
+// otherwise the classification LLM call.
+func (c *Classifier) resolve(ctx context.Context, session *ent.AlertSession) (*Result, error) {
+	if nt := session.NoiseTriage; nt != nil && nt.Skipped {
+		return c.fromNoiseTriage(ctx, session), nil
+	}
+	if result := ParseExplicit(*session.FinalAnalysis); result != nil {
+		return result, nil
+	}
+	return c.generate(ctx, session)
+}
+
+// fromNoiseTriage maps a triage verdict to an outcome. A duplicate inherits
+// the outcome of the session it duplicates, or is inconclusive while that
+// session has none.
+func (c *Classifier) fromNoiseTriage(ctx context.Context, session *ent.AlertSession) *Result {
+	nt := session.NoiseTriage
+	if nt.Verdict != noiseVerdictDuplicate {
+		return &Result{Outcome: alertsession.OutcomeFalsePositive, Reason: nt.Reason, Source: alertsession.OutcomeSourceNoiseTriage}
+	}
+
+	reason := fmt.Sprintf("Duplicate of session %s", nt.DuplicateOf)
+	original, err := c.client.AlertSession.Get(ctx, nt.DuplicateOf)
+	if err != nil || original.Outcome == nil {
+		return &Result{Outcome: alertsession.OutcomeInconclusive, Reason: reason, Source: alertsession.OutcomeSourceNoiseTriage}
+	}
+	return &Result{Outcome: *original.Outcome, Reason: reason, Source: alertsession.OutcomeSourceNoiseTriage}
+}
+
+// ParseExplicit returns the outcome the agent stated on an "Outcome: <label>"
+// line of the final analysis, or nil when it stated none or an unknown
+// label. Hyphens and spaces in the label are read as underscores, so
+// "Outcome: needs-human" is accepted, and text after the label is ignored.
+// The last such line wins.
+func ParseExplicit(finalAnalysis string) *Result {
+	matches := explicitOutcomePattern.FindAllStringSubmatch(finalAnalysis, -1)
+	for i := len(matches) - 1; i >= 0; i-- {
+		label := normalizeLabel(matches[i][1])
+		for _, o := range outcomes {
+			// The label may be followed by prose ("Outcome: mitigated by a restart")
+			if label == string(o) || strings.HasPrefix(label, string(o)+"_") {
+				return &Result{Outcome: o, Source: alertsession.OutcomeSourceAgent}
+			}
+		}
+	}
+	return nil
+}
+
+// generate runs the classification LLM call on the classifier provider:
+// outcome_classification.llm_provider → chain executive_summary_provider →
+// chain llm_provider → defaults.
+func (c *Classifier) generate(ctx context.Context, session *ent.AlertSession) (*Result, error) {
+	chain, _ := c.cfg.GetChain(session.ChainID) // nil chain falls back to defaults
+	llmCfg, err := agent.ResolveSideCallConfig(c.cfg, chain, c.cfg.OutcomeClassification.LLMProvider)
+	if err != nil {
+		return nil, fmt.Errorf("classifier provider: %w", err)
+	}
+
+	text, err := c.sideCalls.Call(ctx, controller.SideCall{
+		SessionID:       session.ID,
+		InteractionType: llminteraction.InteractionTypeOutcomeClassification,
+		Config:          llmCfg,
+		Messages: []agent.ConversationMessage{
+			{Role: agent.RoleSystem, Content: classificationSystemPrompt},
+			{Role: agent.RoleUser, Content: buildClassificationPrompt(session)},
+		},
+		Timeout: classificationTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("classification failed: %w", err)
+	}
+	return parseClassification(text)
+}
+
+// buildClassificationPrompt renders the alert type and the final analysis,
+// capped at maxAnalysisChars.
+func buildClassificationPrompt(session *ent.AlertSession) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Alert type: %s\n", session.AlertType)
+	sb.WriteString("\n## Investigation conclusion\n\n")
+	sb.WriteString(controller.TruncatePrompt(*session.FinalAnalysis, maxAnalysisChars))
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// parseClassification extracts the outcome JSON object from the LLM output,
+// tolerating markdown fences and surrounding prose.
+func parseClassification(raw string) (*Result, error) {
+	var parsed struct {
+		Outcome string `json:"outcome"`
+		Reason  string `json:"reason"`
+	}
+	if err := controller.ParseJSONObject(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM classification: %w", err)
+	}
+	label := normalizeLabel(parsed.Outcome)
+	if err := alertsession.OutcomeValidator(alertsession.Outcome(label)); err != nil {
+		return nil, fmt.Errorf("LLM returned unknown outcome %q", parsed.Outcome)
+	}
+	return &Result{
+		Outcome: alertsession.Outcome(label),
+		Reason:  strings.TrimSpace(parsed.Reason),
+		Source:  alertsession.OutcomeSourceClassifier,
+	}, nil
+}
+
+// normalizeLabel lowercases label and reads hyphens and spaces as
+// underscores ("Needs-Human" → "needs_human").
+func normalizeLabel(label string) string {
+	label = strings.ToLower(strings.TrimSpace(label))
+	return strings.NewReplacer("-", "_", " ", "_").Replace(label)
+}
@@ -0,0 +1,168 @@
+package outcome
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestNewClassifier_Disabled(t *testing.T) {
+	disabled := config.DefaultOutcomeClassificationConfig()
+	disabled.Enabled = false
+	assert.Nil(t, NewClassifier(nil, &config.Config{OutcomeClassification: disabled}, nil))
+	assert.Nil(t, NewClassifier(nil, &config.Config{}, nil))
+	assert.NotNil(t, NewClassifier(nil, &config.Config{OutcomeClassification: config.DefaultOutcomeClassificationConfig()}, nil))
+
+	// Nil classifier is a no-op
+	var c *Classifier
+	c.SessionCompleted(&ent.AlertSession{ID: "s1"})
+	assert.NoError(t, c.Classify(context.Background(), "s1"))
+	c.Stop()
+}
+
+func TestClassifier_Stop(t *testing.T) {
+	c := NewClassifier(nil, &config.Config{OutcomeClassification: config.DefaultOutcomeClassificationConfig()}, nil)
+	c.Stop()
+
+	// A classification launched after Stop would dereference the nil client
+	c.SessionCompleted(&ent.AlertSession{ID: "s1"})
+	c.Stop()
+}
+
+func TestParseExplicit(t *testing.T) {
+	tests := []struct {
+		name     string
+		analysis string
+		want     alertsession.Outcome
+	}{
+		{name: "plain line", analysis: "Root cause: OOM.\nOutcome: root_cause_identified", want: alertsession.OutcomeRootCauseIdentified},
+		{name: "bold markdown", analysis: "**Outcome:** Mitigated\n\nRestarted the pod.", want: alertsession.OutcomeMitigated},
+		{name: "hyphenated label in list", analysis: "- Outcome: needs-human", want: alertsession.OutcomeNeedsHuman},
+		{name: "heading with code span", analysis: "## Outcome: `false_positive`", want: alertsession.OutcomeFalsePositive},
+		{name: "last valid line wins", analysis: "Outcome: mitigated\n...\nOutcome: inconclusive", want: alertsession.OutcomeInconclusive},
+		{name: "trailing prose", analysis: "Outcome: mitigated by restarting the pod", want: alertsession.OutcomeMitigated},
+		{name: "unknown label ignored", analysis: "Outcome: resolved"},
+		{name: "mid-sentence mention ignored", analysis: "The expected outcome: mitigated eventually."},
+		{name: "no outcome line", analysis: "Pod crash looping after deploy."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ParseExplicit(tt.analysis)
+			if tt.want == "" {
+				assert.Nil(t, result)
+				return
+			}
+			require.NotNil(t, result)
+			assert.Equal(t, tt.want, result.Outcome)
+			assert.Equal(t, alertsession.OutcomeSourceAgent, result.Source)
+		})
+	}
+}
+
+func TestParseClassification(t *testing.T) {
+	t.Run("fenced JSON with prose", func(t *testing.T) {
+		raw := "Classification:\n```json\n" + `{"outcome":"Needs-Human","reason":" Rollback needs approval. "}` + "\n```"
+		result, err := parseClassification(raw)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.OutcomeNeedsHuman, result.Outcome)
+		assert.Equal(t, "Rollback needs approval.", result.Reason)
+		assert.Equal(t, alertsession.OutcomeSourceClassifier, result.Source)
+	})
+
+	t.Run("no JSON", func(t *testing.T) {
+		_, err := parseClassification("mitigated")
+		assert.ErrorContains(t, err, "no JSON object")
+	})
+
+	t.Run("unknown outcome", func(t *testing.T) {
+		_, err := parseClassification(`{"outcome":"resolved"}`)
+		assert.ErrorContains(t, err, `unknown outcome "resolved"`)
+	})
+}
+
+func TestResolve(t *testing.T) {
+	cfg := &config.Config{
+		Defaults:              &config.Defaults{LLMProvider: "default"},
+		OutcomeClassification: config.DefaultOutcomeClassificationConfig(),
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"default": {Type: config.LLMProviderTypeGoogle, Model: "test-model"},
+		}),
+		ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{}),
+	}
+	// An empty script fails any LLM call
+	noLLM := controller.NewSideCaller(agent.NewScriptedLLMClient(nil), nil)
+
+	t.Run("noise triage false positive skips the LLM", func(t *testing.T) {
+		c := &Classifier{cfg: cfg, sideCalls: noLLM}
+		session := &ent.AlertSession{
+			FinalAnalysis: strPtr("**Noise triage: known false positive**"),
+			NoiseTriage:   &schema.NoiseTriageDecision{Verdict: noiseVerdictFalsePositive, Reason: "Synthetic probe", Skipped: true},
+		}
+		result, err := c.resolve(context.Background(), session)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.OutcomeFalsePositive, result.Outcome)
+		assert.Equal(t, "Synthetic probe", result.Reason)
+		assert.Equal(t, alertsession.OutcomeSourceNoiseTriage, result.Source)
+	})
+
+	t.Run("explicit outcome skips the LLM", func(t *testing.T) {
+		c := &Classifier{cfg: cfg, sideCalls: noLLM}
+		session := &ent.AlertSession{FinalAnalysis: strPtr("Scaled the deployment.\n\nOutcome: mitigated")}
+		result, err := c.resolve(context.Background(), session)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.OutcomeMitigated, result.Outcome)
+	})
+
+	t.Run("classifies with the LLM", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{
+			{Text: `{"outcome":"root_cause_identified","reason":"Bad config in the latest deploy"}`},
+		})
+		c := &Classifier{cfg: cfg, sideCalls: controller.NewSideCaller(llm, nil)}
+		session := &ent.AlertSession{ChainID: "k8s", AlertType: "kubernetes", FinalAnalysis: strPtr("Bad config.")}
+		result, err := c.resolve(context.Background(), session)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.OutcomeRootCauseIdentified, result.Outcome)
+		assert.Equal(t, "Bad config in the latest deploy", result.Reason)
+	})
+
+	t.Run("LLM error", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Error: "quota exceeded"}})
+		c := &Classifier{cfg: cfg, sideCalls: controller.NewSideCaller(llm, nil)}
+		_, err := c.resolve(context.Background(), &ent.AlertSession{FinalAnalysis: strPtr("x")})
+		assert.ErrorContains(t, err, "quota exceeded")
+	})
+}
+
+func TestBuildClassificationPrompt(t *testing.T) {
+	session := &ent.AlertSession{
+		AlertType:     "kubernetes",
+		FinalAnalysis: strPtr(strings.Repeat("x", maxAnalysisChars+10)),
+	}
+	prompt := buildClassificationPrompt(session)
+	assert.Contains(t, prompt, "Alert type: kubernetes")
+	assert.Contains(t, prompt, "[truncated]")
+
+	t.Run("does not split multi-byte characters", func(t *testing.T) {
+		session := &ent.AlertSession{
+			AlertType:     "kubernetes",
+			FinalAnalysis: strPtr("x" + strings.Repeat("é", maxAnalysisChars)),
+		}
+		prompt := buildClassificationPrompt(session)
+		assert.True(t, utf8.ValidString(prompt))
+		assert.Contains(t, prompt, "é\n[truncated]")
+	})
+}
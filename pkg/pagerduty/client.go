@@ -0,0 +1,86 @@
+// Package pagerduty provides a PagerDuty Events API v2 client and notification service.
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultEventsURL is the PagerDuty Events API v2 enqueue endpoint.
+const DefaultEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// Event is a PagerDuty Events API v2 request body.
+type Event struct {
+	RoutingKey  string        `json:"routing_key"`
+	EventAction string        `json:"event_action"` // trigger, acknowledge, resolve
+	DedupKey    string        `json:"dedup_key,omitempty"`
+	Payload     *EventPayload `json:"payload,omitempty"`
+	Links       []EventLink   `json:"links,omitempty"`
+}
+
+// EventPayload describes the incident for trigger events.
+type EventPayload struct {
+	Summary       string         `json:"summary"`
+	Source        string         `json:"source"`
+	Severity      string         `json:"severity"` // critical, error, warning, info
+	Class         string         `json:"class,omitempty"`
+	CustomDetails map[string]any `json:"custom_details,omitempty"`
+}
+
+// EventLink is a link rendered on the PagerDuty incident.
+type EventLink struct {
+	Href string `json:"href"`
+	Text string `json:"text,omitempty"`
+}
+
+// Client is a minimal Events API v2 client.
+type Client struct {
+	httpClient *http.Client
+	eventsURL  string
+}
+
+// NewClient creates a client that targets the given events URL.
+// An empty URL uses DefaultEventsURL.
+func NewClient(eventsURL string) *Client {
+	if eventsURL == "" {
+		eventsURL = DefaultEventsURL
+	}
+	return &Client{
+		httpClient: &http.Client{},
+		eventsURL:  eventsURL,
+	}
+}
+
+// SendEvent posts an event to the Events API.
+func (c *Client) SendEvent(ctx context.Context, event Event, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.eventsURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("events API request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("events API returned %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
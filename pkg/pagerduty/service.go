@@ -0,0 +1,121 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// maxSummaryLength is the Events API limit for payload.summary.
+const maxSummaryLength = 1024
+
+// ServiceConfig holds the parameters needed to construct a Service.
+type ServiceConfig struct {
+	RoutingKey   string
+	DashboardURL string
+	EventsURL    string // Optional override (default: DefaultEventsURL)
+}
+
+// SessionCompletedInput contains data for a paging notification.
+type SessionCompletedInput struct {
+	SessionID        string
+	AlertType        string
+	Severity         string // critical, high (TARSy severity)
+	ExecutiveSummary string
+	FinalAnalysis    string
+	OnCall           string // On-call engineer of the alert's service (empty = unknown)
+}
+
+// Service triggers PagerDuty incidents for high-severity sessions.
+// Nil-safe: all methods are no-ops when service is nil.
+type Service struct {
+	client       *Client
+	routingKey   string
+	dashboardURL string
+	logger       *slog.Logger
+}
+
+// NewService creates a new PagerDuty notification service.
+// Returns nil if RoutingKey is empty.
+func NewService(cfg ServiceConfig) *Service {
+	if cfg.RoutingKey == "" {
+		return nil
+	}
+	return &Service{
+		client:       NewClient(cfg.EventsURL),
+		routingKey:   cfg.RoutingKey,
+		dashboardURL: cfg.DashboardURL,
+		logger:       slog.Default().With("component", "pagerduty-service"),
+	}
+}
+
+// NotifySessionCompleted triggers an incident for a completed session.
+// The session ID is used as dedup key, so retries never open duplicates.
+// Fail-open: errors are logged, never returned.
+func (s *Service) NotifySessionCompleted(ctx context.Context, input SessionCompletedInput) {
+	if s == nil {
+		return
+	}
+
+	summary := input.ExecutiveSummary
+	if summary == "" {
+		summary = input.FinalAnalysis
+	}
+	if summary == "" {
+		summary = "TARSy investigation completed"
+	}
+	if input.AlertType != "" {
+		summary = fmt.Sprintf("[%s] %s", input.AlertType, summary)
+	}
+
+	sessionURL := fmt.Sprintf("%s/sessions/%s", s.dashboardURL, input.SessionID)
+	event := Event{
+		RoutingKey:  s.routingKey,
+		EventAction: "trigger",
+		DedupKey:    "tarsy-" + input.SessionID,
+		Payload: &EventPayload{
+			Summary:  truncate(summary, maxSummaryLength),
+			Source:   "tarsy",
+			Severity: eventSeverity(input.Severity),
+			Class:    input.AlertType,
+			CustomDetails: map[string]any{
+				"session_id": input.SessionID,
+				"severity":   input.Severity,
+			},
+		},
+		Links: []EventLink{{Href: sessionURL, Text: "View in TARSy"}},
+	}
+	if input.OnCall != "" {
+		event.Payload.CustomDetails["on_call"] = input.OnCall
+	}
+
+	if err := s.client.SendEvent(ctx, event, 10*time.Second); err != nil {
+		s.logger.Error("Failed to trigger PagerDuty incident",
+			"session_id", input.SessionID,
+			"severity", input.Severity,
+			"error", err)
+	}
+}
+
+// eventSeverity maps a TARSy severity onto the Events API severity scale.
+func eventSeverity(severity string) string {
+	switch severity {
+	case "critical":
+		return "critical"
+	case "high":
+		return "error"
+	case "medium":
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+func truncate(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-3]) + "..."
+}
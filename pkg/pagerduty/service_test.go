@@ -0,0 +1,89 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_NilReceiver(_ *testing.T) {
+	var s *Service
+	// Should not panic
+	s.NotifySessionCompleted(context.Background(), SessionCompletedInput{SessionID: "sess-1"})
+}
+
+func TestNewService(t *testing.T) {
+	t.Run("returns nil when routing key empty", func(t *testing.T) {
+		assert.Nil(t, NewService(ServiceConfig{}))
+	})
+
+	t.Run("returns service when configured", func(t *testing.T) {
+		assert.NotNil(t, NewService(ServiceConfig{RoutingKey: "key"}))
+	})
+}
+
+func TestService_NotifySessionCompleted(t *testing.T) {
+	var got Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	svc := NewService(ServiceConfig{
+		RoutingKey:   "routing-key",
+		DashboardURL: "https://tarsy.example.com",
+		EventsURL:    srv.URL,
+	})
+	svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{
+		SessionID:        "sess-1",
+		AlertType:        "PodCrashLooping",
+		Severity:         "critical",
+		ExecutiveSummary: "Payment API down after bad deploy.",
+		OnCall:           "Ada <ada@example.com> (payments)",
+	})
+
+	assert.Equal(t, "routing-key", got.RoutingKey)
+	assert.Equal(t, "trigger", got.EventAction)
+	assert.Equal(t, "tarsy-sess-1", got.DedupKey)
+	require.NotNil(t, got.Payload)
+	assert.Equal(t, "[PodCrashLooping] Payment API down after bad deploy.", got.Payload.Summary)
+	assert.Equal(t, "critical", got.Payload.Severity)
+	assert.Equal(t, "Ada <ada@example.com> (payments)", got.Payload.CustomDetails["on_call"])
+	require.Len(t, got.Links, 1)
+	assert.Equal(t, "https://tarsy.example.com/sessions/sess-1", got.Links[0].Href)
+}
+
+func TestClient_SendEvent_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":"invalid event"}`))
+	}))
+	defer srv.Close()
+
+	err := NewClient(srv.URL).SendEvent(context.Background(), Event{RoutingKey: "k", EventAction: "trigger"}, 5*time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "400")
+	assert.Contains(t, err.Error(), "invalid event")
+}
+
+func TestEventSeverity(t *testing.T) {
+	assert.Equal(t, "critical", eventSeverity("critical"))
+	assert.Equal(t, "error", eventSeverity("high"))
+	assert.Equal(t, "warning", eventSeverity("medium"))
+	assert.Equal(t, "info", eventSeverity("low"))
+	assert.Equal(t, "info", eventSeverity(""))
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "short", truncate("short", 10))
+	long := strings.Repeat("a", 20)
+	assert.Equal(t, strings.Repeat("a", 7)+"...", truncate(long, 10))
+}
@@ -0,0 +1,106 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// runAutoCancel periodically auto-cancels pending sessions that have waited
+// longer than their configured TTL. All pods run this independently — the
+// conditional update makes it idempotent and safe against concurrent claims.
+func (p *WorkerPool) runAutoCancel(ctx context.Context) {
+	ticker := time.NewTicker(p.config.AutoCancel.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			if err := p.cancelStalePendingSessions(ctx); err != nil {
+				slog.Error("Auto-cancel of stale sessions failed", "error", err)
+			}
+		}
+	}
+}
+
+// cancelStalePendingSessions marks pending sessions older than the TTL of
+// their alert type as auto_cancelled.
+func (p *WorkerPool) cancelStalePendingSessions(ctx context.Context) error {
+	rules := p.config.AutoCancel
+	minTTL := rules.MinTTL()
+	if minTTL <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	candidates, err := p.client.AlertSession.Query().
+		Where(
+			alertsession.StatusEQ(alertsession.StatusPending),
+			alertsession.CreatedAtLT(now.Add(-minTTL)),
+			alertsession.DeletedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query stale pending sessions: %w", err)
+	}
+
+	for _, session := range candidates {
+		ttl := rules.TTLFor(session.AlertType)
+		if ttl <= 0 || now.Sub(session.CreatedAt) < ttl {
+			continue
+		}
+
+		reason := fmt.Sprintf("still queued after %s (pending TTL)", ttl)
+		ok, err := services.AutoCancelPendingSession(ctx, p.client, session.ID, alertsession.CancelInitiatorPendingTTL, reason)
+		if err != nil {
+			slog.Error("Failed to auto-cancel stale session",
+				"session_id", session.ID,
+				"error", err)
+			continue
+		}
+		if !ok {
+			// Claimed by a worker or cancelled by another pod in the meantime.
+			continue
+		}
+
+		metrics.SessionsTerminalTotal.WithLabelValues(session.AlertType, string(alertsession.StatusAutoCancelled)).Inc()
+		p.publishAutoCancelled(ctx, session)
+		p.callbacks.Deliver(session.ID)
+		slog.Info("Auto-cancelled stale pending session",
+			"session_id", session.ID,
+			"alert_type", session.AlertType,
+			"ttl", ttl)
+	}
+
+	return nil
+}
+
+// publishAutoCancelled broadcasts the auto_cancelled status for a session.
+func (p *WorkerPool) publishAutoCancelled(ctx context.Context, session *ent.AlertSession) {
+	if p.eventPublisher == nil {
+		return
+	}
+	if err := p.eventPublisher.PublishSessionStatus(ctx, session.ID, events.SessionStatusPayload{
+		BasePayload: events.BasePayload{
+			Type:      events.EventTypeSessionStatus,
+			SessionID: session.ID,
+			Timestamp: time.Now().Format(time.RFC3339Nano),
+		},
+		Status:    alertsession.StatusAutoCancelled,
+		ChainID:   session.ChainID,
+		AlertType: session.AlertType,
+	}); err != nil {
+		slog.Warn("Failed to publish auto-cancelled status", "session_id", session.ID, "error", err)
+	}
+}
@@ -23,10 +23,14 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/agent/skill"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/cost"
+	"github.com/codeready-toolchain/tarsy/pkg/deeplink"
+	"github.com/codeready-toolchain/tarsy/pkg/enrichment"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/highlight"
 	"github.com/codeready-toolchain/tarsy/pkg/mcp"
 	"github.com/codeready-toolchain/tarsy/pkg/memory"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 	"github.com/codeready-toolchain/tarsy/pkg/runbook"
 	"github.com/codeready-toolchain/tarsy/pkg/services"
 )
@@ -34,6 +38,10 @@ import (
 // MetadataKeyAuthor is the metadata key for the message author.
 const MetadataKeyAuthor = "author"
 
+// MetadataKeyVoiceNoteID is the metadata key for the voice note a chat
+// question was transcribed from.
+const MetadataKeyVoiceNoteID = "voice_note_id"
+
 // ────────────────────────────────────────────────────────────
 // Input and config types
 // ────────────────────────────────────────────────────────────
@@ -47,8 +55,9 @@ type ChatExecuteInput struct {
 
 // ChatMessageExecutorConfig holds configuration for the chat message executor.
 type ChatMessageExecutorConfig struct {
-	SessionTimeout    time.Duration // Max duration for a chat execution (default: 15 minutes)
-	HeartbeatInterval time.Duration // Heartbeat frequency (default: 30s)
+	SessionTimeout    time.Duration            // Max duration for a chat execution (default: 15 minutes)
+	HeartbeatInterval time.Duration            // Heartbeat frequency (default: 30s)
+	Limits            *config.ChatLimitsConfig // Rate limits and concurrency cap (nil = unlimited)
 }
 
 // ────────────────────────────────────────────────────────────
@@ -56,8 +65,10 @@ type ChatMessageExecutorConfig struct {
 // ────────────────────────────────────────────────────────────
 
 // ChatMessageExecutor handles asynchronous chat message processing.
-// It manages a single goroutine per chat (one-at-a-time enforcement),
-// supports cancellation, and graceful shutdown.
+// It manages a single goroutine per chat (one-at-a-time enforcement), a
+// bounded per-pod concurrency budget with a fair queue of waiting messages
+// (see chat_queue.go), enforces chat rate limits, supports cancellation,
+// and graceful shutdown.
 type ChatMessageExecutor struct {
 	// Dependencies
 	cfg            *config.Config
@@ -72,8 +83,6 @@ type ChatMessageExecutor struct {
 	memoryService  *memory.Service
 	memoryConfig   *config.MemoryConfig
 
-	subAgentRegistry *config.SubAgentRegistry
-
 	// Services
 	timelineService    *services.TimelineService
 	stageService       *services.StageService
@@ -81,12 +90,24 @@ type ChatMessageExecutor struct {
 	messageService     *services.MessageService
 	interactionService *services.InteractionService
 	costBook           *cost.Book
+	queuePublisher     ChatQueuePublisher  // nil = queue positions not published
+	enrichment         *enrichment.Sources // nil = lookup tools disabled
+
+	// Chat limits (nil limiters = unlimited)
+	userLimiter    *chatRateLimiter
+	sessionLimiter *chatRateLimiter
 
 	// Active execution tracking (for cancellation + shutdown)
 	mu          sync.RWMutex
 	activeExecs map[string]context.CancelFunc // chatID → cancel
+	running     int                           // executions started and not yet finished
+	busyChats   map[string]struct{}           // chats holding a slot (starting or running)
+	queue       []*queuedChatMessage          // messages waiting for their chat or a slot, oldest first
+	dispatching bool                          // the queue dispatcher goroutine is running
+	wake        chan struct{}                 // nudges the dispatcher when a slot is released
 	wg          sync.WaitGroup                // tracks active goroutines for shutdown
 	stopped     bool                          // reject new submissions after Stop()
+	stopCh      chan struct{}                 // closed by Stop() to release queued messages
 }
 
 // NewChatMessageExecutor creates a new ChatMessageExecutor.
@@ -105,6 +126,11 @@ func NewChatMessageExecutor(
 ) *ChatMessageExecutor {
 	controllerFactory := controller.NewFactory()
 	msgService := services.NewMessageService(dbClient)
+	var userLimiter, sessionLimiter *chatRateLimiter
+	if execConfig.Limits != nil {
+		userLimiter = newChatRateLimiter(execConfig.Limits.PerUserPerMinute)
+		sessionLimiter = newChatRateLimiter(execConfig.Limits.PerSessionPerMinute)
+	}
 	return &ChatMessageExecutor{
 		cfg:                cfg,
 		dbClient:           dbClient,
@@ -117,13 +143,17 @@ func NewChatMessageExecutor(
 		runbookService:     runbookService,
 		memoryService:      memoryService,
 		memoryConfig:       memoryConfig,
-		subAgentRegistry:   config.BuildSubAgentRegistry(cfg.AgentRegistry.GetAll()),
 		timelineService:    services.NewTimelineService(dbClient),
 		stageService:       services.NewStageService(dbClient),
 		chatService:        services.NewChatService(dbClient),
 		messageService:     msgService,
 		interactionService: services.NewInteractionService(dbClient, msgService, nil),
+		userLimiter:        userLimiter,
+		sessionLimiter:     sessionLimiter,
 		activeExecs:        make(map[string]context.CancelFunc),
+		busyChats:          make(map[string]struct{}),
+		wake:               make(chan struct{}, 1),
+		stopCh:             make(chan struct{}),
 	}
 }
 
@@ -134,6 +164,12 @@ func (e *ChatMessageExecutor) SetCostBook(book *cost.Book) {
 	e.interactionService = services.NewInteractionService(e.dbClient, e.messageService, book)
 }
 
+// SetEnrichment sets the sources behind the lookup_ip and lookup_host
+// tools. May be nil (lookup tools disabled).
+func (e *ChatMessageExecutor) SetEnrichment(sources *enrichment.Sources) {
+	e.enrichment = sources
+}
+
 // resolveRunbook resolves runbook content for a session using the RunbookService.
 // Falls back to config defaults on error or when the service is nil.
 func (e *ChatMessageExecutor) resolveRunbook(ctx context.Context, session *ent.AlertSession) string {
@@ -165,8 +201,12 @@ func (e *ChatMessageExecutor) resolveRunbook(ctx context.Context, session *ent.A
 // Submit — entry point for chat message processing
 // ────────────────────────────────────────────────────────────
 
-// Submit validates the one-at-a-time constraint, creates a Stage record,
-// and launches asynchronous execution. Returns the stage ID for the response.
+// Submit enforces the chat rate limits, the one-at-a-time constraint and the
+// concurrency cap, creates a Stage record, and launches asynchronous
+// execution. Returns the stage ID for the response. While the chat has an
+// active execution it returns ErrChatExecutionActive, and while the pod is
+// at capacity (or messages are already waiting for a slot, so this one does
+// not overtake them) ErrChatAtCapacity; the caller may then QueuePending.
 func (e *ChatMessageExecutor) Submit(ctx context.Context, input ChatExecuteInput) (string, error) {
 	// 1. Fast-fail if already stopped (avoids unnecessary DB work)
 	e.mu.RLock()
@@ -176,18 +216,60 @@ func (e *ChatMessageExecutor) Submit(ctx context.Context, input ChatExecuteInput
 	}
 	e.mu.RUnlock()
 
-	// 2. Check one-at-a-time constraint
+	// 2. Per-user and per-session rate limits
+	if err := e.checkRateLimits(input); err != nil {
+		return "", err
+	}
+
+	// 3. Queue behind messages already waiting for a slot
+	if e.waitingForSlot() {
+		return "", ErrChatAtCapacity
+	}
+
+	return e.start(ctx, input)
+}
+
+// checkRateLimits takes the message from its author's and its session's
+// budgets. Every accepted or queued message counts.
+func (e *ChatMessageExecutor) checkRateLimits(input ChatExecuteInput) error {
+	now := time.Now()
+	author := input.Message.Author
+	if input.Message.AuthorSubject != nil && *input.Message.AuthorSubject != "" {
+		author = *input.Message.AuthorSubject
+	}
+	if wait, ok := e.userLimiter.allow(author, now); !ok {
+		return &ChatLimitError{Reason: "you are sending chat messages too quickly", RetryAfter: wait}
+	}
+	if wait, ok := e.sessionLimiter.allow(input.Session.ID, now); !ok {
+		return &ChatLimitError{Reason: "too many chat messages are being sent on this session", RetryAfter: wait}
+	}
+	return nil
+}
+
+// start checks the one-at-a-time constraint and the concurrency cap, then
+// creates the Stage record and launches the execution goroutine.
+func (e *ChatMessageExecutor) start(ctx context.Context, input ChatExecuteInput) (string, error) {
+	// 1. Reserve an execution slot under the concurrency cap; this also
+	// serializes executions of the chat on this pod
+	if err := e.reserveSlot(input.Chat.ID); err != nil {
+		return "", err
+	}
+
+	// 2. Check one-at-a-time constraint (covers executions on other pods)
 	activeStage, err := e.stageService.GetActiveStageForChat(ctx, input.Chat.ID)
 	if err != nil {
+		e.releaseSlot(input.Chat.ID)
 		return "", fmt.Errorf("failed to check active chat stage: %w", err)
 	}
 	if activeStage != nil {
+		e.releaseSlot(input.Chat.ID)
 		return "", ErrChatExecutionActive
 	}
 
 	// 3. Get next stage index (continues from investigation stages)
 	maxIndex, err := e.stageService.GetMaxStageIndex(ctx, input.Session.ID)
 	if err != nil {
+		e.releaseSlot(input.Chat.ID)
 		return "", fmt.Errorf("failed to get max stage index: %w", err)
 	}
 	stageIndex := maxIndex + 1
@@ -205,6 +287,7 @@ func (e *ChatMessageExecutor) Submit(ctx context.Context, input ChatExecuteInput
 		ChatUserMessageID:  &messageID,
 	})
 	if err != nil {
+		e.releaseSlot(input.Chat.ID)
 		return "", fmt.Errorf("failed to create chat stage: %w", err)
 	}
 
@@ -215,13 +298,15 @@ func (e *ChatMessageExecutor) Submit(ctx context.Context, input ChatExecuteInput
 	e.mu.RLock()
 	if e.stopped {
 		e.mu.RUnlock()
+		e.releaseSlot(input.Chat.ID)
 		return "", ErrShuttingDown
 	}
 	e.wg.Add(1)
 	e.mu.RUnlock()
 
-	// 6. Launch goroutine with detached context (not tied to HTTP request lifecycle)
-	go e.execute(context.Background(), input, stg.ID, stageIndex)
+	// 6. Launch goroutine with detached context (not tied to HTTP request
+	// lifecycle, but keeping its request ID for correlation)
+	go e.execute(requestid.WithContext(context.Background(), requestid.FromContext(ctx)), input, stg.ID, stageIndex)
 
 	return stg.ID, nil
 }
@@ -232,6 +317,7 @@ func (e *ChatMessageExecutor) Submit(ctx context.Context, input ChatExecuteInput
 
 func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecuteInput, stageID string, stageIndex int) {
 	defer e.wg.Done()
+	defer e.releaseSlot(input.Chat.ID)
 
 	logger := slog.With(
 		"session_id", input.Session.ID,
@@ -239,11 +325,15 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 		"stage_id", stageID,
 		"message_id", input.Message.ID,
 	)
+	if reqID := requestid.FromContext(parentCtx); reqID != "" {
+		logger = logger.With("request_id", reqID)
+	}
 	logger.Info("Chat executor: starting execution")
 
 	// Create cancellable context with timeout
 	execCtx, cancel := context.WithTimeout(parentCtx, e.execConfig.SessionTimeout)
 	defer cancel()
+	execCtx = withSessionTarget(execCtx, e.cfg.Targets, input.Session, logger)
 
 	// Register for cancellation
 	e.registerExecution(input.Chat.ID, cancel)
@@ -283,6 +373,11 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 		return
 	}
 
+	// Models without function calling run without tools
+	if resolvedConfig.ToolsDisabled {
+		serverIDs, toolFilter = nil, nil
+	}
+
 	// 3. Create AgentExecution record
 	exec, err := e.stageService.CreateAgentExecution(execCtx, models.CreateAgentExecutionRequest{
 		StageID:     stageID,
@@ -306,6 +401,9 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 	}
 	userQuestionSeq := maxSeq + 1
 	userQuestionMeta := map[string]any{MetadataKeyAuthor: input.Message.Author}
+	if input.Message.VoiceNote != nil {
+		userQuestionMeta[MetadataKeyVoiceNoteID] = input.Message.VoiceNote.ID
+	}
 	userQuestionEvent, err := e.timelineService.CreateTimelineEvent(execCtx, models.CreateTimelineEventRequest{
 		SessionID:      input.Session.ID,
 		StageID:        &stageID,
@@ -342,6 +440,8 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 
 	// 5. Build ChatContext (structured investigation history)
 	chatContext := e.buildChatContext(execCtx, input)
+	chatContext.Images = loadImages(execCtx, input.Session.ID, input.Message.Images)
+	chatContext.Guardrails = chatGuardrailsFor(chain, input.Session)
 
 	// 6. Update Stage status: active, publish stage.status: started, start heartbeat
 	if updateErr := e.stageService.UpdateAgentExecutionStatus(execCtx, exec.ID, agentexecution.StatusActive, ""); updateErr != nil {
@@ -354,16 +454,22 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 	defer cancelHeartbeat()
 	go e.runChatHeartbeat(heartbeatCtx, input.Chat.ID)
 
-	// 7. Create MCP ToolExecutor (shared helper, same as investigation)
+	// 7. Create MCP ToolExecutor (shared helper, same as investigation),
+	// without the MCP servers operators disabled for this session
+	serverIDs, disabledServers := withoutDisabledServers(execCtx, e.dbClient, input.Session.ID, serverIDs)
 	toolExecutor, failedServers := createToolExecutor(execCtx, e.mcpFactory, serverIDs, toolFilter, logger)
 	defer func() { _ = toolExecutor.Close() }()
+	failedServers = mergeFailedServers(failedServers, disabledServers)
+	toolExecutor, disabledSource := gateDisabledServers(toolExecutor, e.dbClient, input.Session.ID, serverIDs)
 
 	var chatSubCollector agent.SubAgentResultCollector
+	var chatBudget agent.OrchestratorBudgetSource
 	var chatSubCatalog []config.SubAgentEntry
 
 	subAgentRefs := resolveChatSubAgents(chain, chain.Chat)
-	if len(subAgentRefs) > 0 {
-		reg := e.subAgentRegistry.Filter(subAgentRefs.Names())
+	if len(subAgentRefs) > 0 && !resolvedConfig.ToolsDisabled {
+		// Built per execution so agents registered at runtime are dispatchable
+		reg := config.BuildSubAgentRegistry(e.cfg.AgentRegistry.GetAll()).Filter(subAgentRefs.Names())
 		if len(reg.Entries()) > 0 {
 			chatAgentDef, getErr := e.cfg.GetAgent(resolvedConfig.AgentName)
 			if getErr != nil {
@@ -398,6 +504,7 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 			runner := orchestrator.NewSubAgentRunner(execCtx, deps, exec.ID, input.Session.ID, stageID, reg, guardrails, subAgentRefs)
 			toolExecutor = orchestrator.NewCompositeToolExecutor(toolExecutor, runner, reg)
 			chatSubCollector = orchestrator.NewResultCollector(runner)
+			chatBudget = orchestrator.NewBudgetTracker(runner)
 			chatSubCatalog = applyCatalogOverrides(reg.Entries(), subAgentRefs)
 		}
 	}
@@ -408,31 +515,52 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 	}
 
 	// Wrap with memory tool executor (chat gets the tool but no auto-injection)
-	if e.memoryService != nil && e.memoryConfig != nil {
+	if e.memoryService != nil && e.memoryConfig != nil && !resolvedConfig.ToolsDisabled {
 		toolExecutor = memory.NewToolExecutor(
 			toolExecutor, e.memoryService, input.Session.ID, "default", nil,
 		)
 	}
 
+	// Let tool-using agents flag key findings on the timeline
+	if e.cfg.Defaults != nil && e.cfg.Defaults.KeyFindingsTool && len(serverIDs) > 0 {
+		toolExecutor = highlight.NewToolExecutor(toolExecutor)
+	}
+
+	// Let tool-using agents link dashboards and log queries
+	var linkTemplates []agent.LinkTemplateEntry
+	if e.cfg.DeepLinks.Enabled() && len(serverIDs) > 0 {
+		toolExecutor = deeplink.NewToolExecutor(toolExecutor, e.cfg.DeepLinks, input.Session.CreatedAt)
+		linkTemplates = deeplink.Catalog(e.cfg.DeepLinks)
+	}
+
+	// Let tool-using agents look up IP owners and inventory hosts
+	if e.enrichment != nil && len(serverIDs) > 0 {
+		toolExecutor = enrichment.NewToolExecutor(toolExecutor, e.enrichment)
+	}
+
 	// 8. Build ExecutionContext (with ChatContext populated)
 	agentExecCtx := &agent.ExecutionContext{
-		SessionID:         input.Session.ID,
-		StageID:           stageID,
-		ExecutionID:       exec.ID,
-		AgentName:         resolvedConfig.AgentName,
-		AgentIndex:        1,
-		AlertData:         input.Session.AlertData,
-		AlertType:         input.Session.AlertType,
-		RunbookContent:    e.resolveRunbook(execCtx, input.Session),
-		Config:            resolvedConfig,
-		LLMClient:         e.llmClient,
-		ToolExecutor:      toolExecutor,
-		EventPublisher:    e.eventPublisher,
-		PromptBuilder:     e.promptBuilder,
-		ChatContext:       chatContext,
-		FailedServers:     failedServers,
-		SubAgentCollector: chatSubCollector,
-		SubAgentCatalog:   chatSubCatalog,
+		SessionID:          input.Session.ID,
+		StageID:            stageID,
+		ExecutionID:        exec.ID,
+		AgentName:          resolvedConfig.AgentName,
+		AgentIndex:         1,
+		AlertData:          input.Session.AlertData,
+		AlertImages:        loadImages(execCtx, input.Session.ID, input.Session.AlertImages),
+		AlertType:          input.Session.AlertType,
+		RunbookContent:     e.resolveRunbook(execCtx, input.Session),
+		Config:             resolvedConfig,
+		LLMClient:          e.llmClient,
+		ToolExecutor:       toolExecutor,
+		EventPublisher:     e.eventPublisher,
+		PromptBuilder:      e.promptBuilder,
+		ChatContext:        chatContext,
+		FailedServers:      failedServers,
+		SubAgentCollector:  chatSubCollector,
+		OrchestratorBudget: chatBudget,
+		SubAgentCatalog:    chatSubCatalog,
+		DisabledServers:    disabledSource,
+		LinkTemplates:      linkTemplates,
 		Services: &agent.ServiceBundle{
 			Timeline:    e.timelineService,
 			Message:     e.messageService,
@@ -440,6 +568,7 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 			Stage:       e.stageService,
 		},
 	}
+	agentExecCtx.OutputLanguage = outputLanguageFor(e.cfg.Defaults, chain, input.Session)
 
 	// 9. Create agent via AgentFactory
 	agentInstance, err := e.agentFactory.CreateAgent(agentExecCtx)
@@ -476,20 +605,21 @@ func (e *ChatMessageExecutor) execute(parentCtx context.Context, input ChatExecu
 	}
 	terminalStatus := mapChatAgentStatus(agentStatus)
 
-	// 12. Update AgentExecution terminal status (use background context — execCtx may be cancelled)
+	// 12. Update AgentExecution terminal status (use uncancellable context — execCtx may be cancelled)
+	finalizeCtx := context.WithoutCancel(parentCtx)
 	entStatus := mapAgentStatusToEntStatus(agentStatus)
-	if updateErr := e.stageService.UpdateAgentExecutionStatus(context.Background(), exec.ID, entStatus, errMsg); updateErr != nil {
+	if updateErr := e.stageService.UpdateAgentExecutionStatus(finalizeCtx, exec.ID, entStatus, errMsg); updateErr != nil {
 		logger.Error("Failed to update agent execution status", "error", updateErr)
 	}
-	publishExecutionStatus(context.Background(), e.eventPublisher, input.Session.ID, stageID, exec.ID, 1, string(entStatus), errMsg)
+	publishExecutionStatus(finalizeCtx, e.eventPublisher, input.Session.ID, stageID, exec.ID, 1, string(entStatus), errMsg)
 
 	// 13. Update Stage terminal status
-	if updateErr := e.stageService.UpdateStageStatus(context.Background(), stageID); updateErr != nil {
+	if updateErr := e.stageService.UpdateStageStatus(finalizeCtx, stageID); updateErr != nil {
 		logger.Error("Failed to update stage status", "error", updateErr)
 	}
 
 	// 14. Publish stage.status: completed/failed/cancelled/timed_out
-	publishStageStatus(context.Background(), e.eventPublisher, input.Session.ID, stageID, "Chat", stageIndex, stage.StageTypeChat, nil, terminalStatus)
+	publishStageStatus(finalizeCtx, e.eventPublisher, input.Session.ID, stageID, "Chat", stageIndex, stage.StageTypeChat, nil, terminalStatus)
 
 	// 15. Stop heartbeat
 	cancelHeartbeat()
@@ -716,20 +846,26 @@ func stringFromNillable(s *string) string {
 // Cancellation
 // ────────────────────────────────────────────────────────────
 
-// CancelExecution cancels the active execution for a chat.
-// Returns true if an active execution was found and cancelled.
+// CancelExecution cancels the active execution for a chat and discards its
+// queued message. Returns true if either was found.
 func (e *ChatMessageExecutor) CancelExecution(chatID string) bool {
-	e.mu.RLock()
-	defer e.mu.RUnlock()
-	if cancel, ok := e.activeExecs[chatID]; ok {
+	e.mu.Lock()
+	queued := e.removeQueuedLocked(chatID)
+	cancel, active := e.activeExecs[chatID]
+	if active {
 		cancel()
-		return true
 	}
-	return false
+	e.mu.Unlock()
+
+	if queued != nil {
+		e.discardQueued(queued, "chat cancelled")
+		e.publishQueuePositions()
+	}
+	return active || queued != nil
 }
 
-// CancelBySessionID looks up the chat for the given session and cancels any active execution.
-// Returns true if an active execution was found and cancelled.
+// CancelBySessionID looks up the chat for the given session and cancels any active execution
+// (discarding its queued message). Returns true if either was found.
 func (e *ChatMessageExecutor) CancelBySessionID(ctx context.Context, sessionID string) bool {
 	chatObj, err := e.chatService.GetChatBySessionID(ctx, sessionID)
 	if err != nil || chatObj == nil {
@@ -742,6 +878,9 @@ func (e *ChatMessageExecutor) CancelBySessionID(ctx context.Context, sessionID s
 // for goroutines to drain. Safe to call multiple times.
 func (e *ChatMessageExecutor) Stop() {
 	e.mu.Lock()
+	if !e.stopped && e.stopCh != nil {
+		close(e.stopCh) // discard queued messages
+	}
 	e.stopped = true
 	// Cancel all active executions
 	for _, cancel := range e.activeExecs {
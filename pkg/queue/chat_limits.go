@@ -0,0 +1,95 @@
+package queue
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// chatLimitWindow is the period chat rate limits are expressed over.
+const chatLimitWindow = time.Minute
+
+// chatQueueFullRetryAfter is the Retry-After suggested when a chat already
+// has a queued message; the current response usually takes about this long.
+const chatQueueFullRetryAfter = 30 * time.Second
+
+// chatAtCapacityRetryAfter is the Retry-After suggested when the pod's
+// concurrent chat cap is reached.
+const chatAtCapacityRetryAfter = 10 * time.Second
+
+// ChatLimitError reports which chat limit rejected a message and when the
+// client may retry. It wraps ErrChatRateLimited.
+type ChatLimitError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *ChatLimitError) Error() string {
+	return fmt.Sprintf("%s; try again in %ds", e.Reason, e.RetryAfterSeconds())
+}
+
+// RetryAfterSeconds is RetryAfter rounded up to whole seconds (at least 1),
+// as sent in the Retry-After header.
+func (e *ChatLimitError) RetryAfterSeconds() int {
+	return max(int((e.RetryAfter+time.Second-1)/time.Second), 1)
+}
+
+func (e *ChatLimitError) Unwrap() error {
+	return ErrChatRateLimited
+}
+
+// chatRateLimiter allows up to perMinute chat messages per key (user or
+// session), refilling continuously, so a client can send a short burst but
+// not a sustained stream. A nil limiter allows everything.
+type chatRateLimiter struct {
+	perMinute int
+	mu        sync.Mutex
+	limiters  map[string]*keyLimiter
+}
+
+type keyLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newChatRateLimiter returns nil when perMinute is 0 (unlimited).
+func newChatRateLimiter(perMinute int) *chatRateLimiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	return &chatRateLimiter{perMinute: perMinute, limiters: make(map[string]*keyLimiter)}
+}
+
+// allow takes one message from key's budget. When the budget is spent it
+// returns false and how long until the next message is allowed. Keys idle
+// for a full window (whose budget has refilled) are pruned on every call.
+func (l *chatRateLimiter) allow(key string, now time.Time) (time.Duration, bool) {
+	if l == nil {
+		return 0, true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for k, kl := range l.limiters {
+		if now.Sub(kl.lastSeen) > chatLimitWindow {
+			delete(l.limiters, k)
+		}
+	}
+
+	kl, ok := l.limiters[key]
+	if !ok {
+		every := chatLimitWindow / time.Duration(l.perMinute)
+		kl = &keyLimiter{limiter: rate.NewLimiter(rate.Every(every), l.perMinute)}
+		l.limiters[key] = kl
+	}
+	kl.lastSeen = now
+
+	r := kl.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
@@ -0,0 +1,86 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+)
+
+func TestChatRateLimiter(t *testing.T) {
+	t.Run("nil limiter allows everything", func(t *testing.T) {
+		var l *chatRateLimiter
+		_, ok := l.allow("alice", time.Now())
+		assert.True(t, ok)
+		assert.Nil(t, newChatRateLimiter(0))
+	})
+
+	t.Run("burst then refill", func(t *testing.T) {
+		l := newChatRateLimiter(3)
+		now := time.Now()
+		for i := range 3 {
+			_, ok := l.allow("alice", now)
+			require.True(t, ok, "message %d", i+1)
+		}
+
+		wait, ok := l.allow("alice", now)
+		assert.False(t, ok)
+		assert.Equal(t, 20*time.Second, wait)
+
+		_, ok = l.allow("bob", now)
+		assert.True(t, ok, "other keys have their own budget")
+
+		_, ok = l.allow("alice", now.Add(20*time.Second))
+		assert.True(t, ok, "one message refilled after a third of a minute")
+	})
+
+	t.Run("idle keys are pruned", func(t *testing.T) {
+		l := newChatRateLimiter(1)
+		now := time.Now()
+		l.allow("alice", now)
+		l.allow("bob", now.Add(2*chatLimitWindow))
+		assert.Len(t, l.limiters, 1)
+	})
+}
+
+func TestChatLimitError(t *testing.T) {
+	err := &ChatLimitError{Reason: "too many", RetryAfter: 200 * time.Millisecond}
+	assert.ErrorIs(t, err, ErrChatRateLimited)
+	assert.Equal(t, 1, err.RetryAfterSeconds())
+	assert.Equal(t, "too many; try again in 1s", err.Error())
+
+	err.RetryAfter = 30 * time.Second
+	assert.Equal(t, 30, err.RetryAfterSeconds())
+}
+
+func TestChatMessageExecutor_CheckRateLimits(t *testing.T) {
+	subject := "sub-alice"
+	input := func(sessionID string) ChatExecuteInput {
+		return ChatExecuteInput{
+			Chat:    &ent.Chat{ID: "chat-" + sessionID},
+			Message: &ent.ChatUserMessage{ID: "msg", Author: "alice@example.com", AuthorSubject: &subject},
+			Session: &ent.AlertSession{ID: sessionID},
+		}
+	}
+
+	t.Run("per user across sessions", func(t *testing.T) {
+		e := &ChatMessageExecutor{userLimiter: newChatRateLimiter(2)}
+		require.NoError(t, e.checkRateLimits(input("s1")))
+		require.NoError(t, e.checkRateLimits(input("s2")))
+		err := e.checkRateLimits(input("s3"))
+		var limitErr *ChatLimitError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Contains(t, limitErr.Reason, "too quickly")
+		assert.Equal(t, 30, limitErr.RetryAfterSeconds())
+	})
+
+	t.Run("per session across users", func(t *testing.T) {
+		e := &ChatMessageExecutor{sessionLimiter: newChatRateLimiter(1)}
+		require.NoError(t, e.checkRateLimits(input("s1")))
+		assert.ErrorIs(t, e.checkRateLimits(input("s1")), ErrChatRateLimited)
+		assert.NoError(t, e.checkRateLimits(input("s2")))
+	})
+}
@@ -0,0 +1,369 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+)
+
+// chatPendingPollInterval is how often the queue dispatcher retries waiting
+// messages when no slot was released on this pod (their chat may have
+// finished on another one). A variable so tests can shorten it.
+var chatPendingPollInterval = 1 * time.Second
+
+// ChatQueuePublisher publishes chat.queue_position events for queued chat
+// messages. Implemented by events.EventPublisher.
+type ChatQueuePublisher interface {
+	PublishChatQueuePosition(ctx context.Context, sessionID string, payload events.ChatQueuePositionPayload) error
+}
+
+// queuedChatMessage is a chat message waiting for its chat's previous
+// response to finish or for an execution slot.
+type queuedChatMessage struct {
+	ctx    context.Context // detached from the request, keeping its request ID
+	input  ChatExecuteInput
+	reason string // events.ChatQueueReasonChatBusy or ChatQueueReasonAtCapacity
+
+	// What was last published for the message (0 = nothing yet)
+	publishedPosition int
+	publishedReason   string
+}
+
+// SetQueuePublisher sets the publisher used to tell users where their queued
+// chat messages stand. Without one, positions are only returned by
+// QueuePending.
+func (e *ChatMessageExecutor) SetQueuePublisher(p ChatQueuePublisher) {
+	e.queuePublisher = p
+}
+
+// ────────────────────────────────────────────────────────────
+// Slots — the per-pod concurrency budget
+// ────────────────────────────────────────────────────────────
+
+// reserveSlot counts an execution of chatID against MaxConcurrentExecutions.
+// It returns ErrChatExecutionActive when the chat already holds a slot (no
+// two executions of a chat run at once) and ErrChatAtCapacity when the pod
+// is at the cap.
+func (e *ChatMessageExecutor) reserveSlot(chatID string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.stopped {
+		return ErrShuttingDown
+	}
+	if _, busy := e.busyChats[chatID]; busy {
+		return ErrChatExecutionActive
+	}
+	if e.atCapacityLocked() {
+		return ErrChatAtCapacity
+	}
+	if e.busyChats == nil {
+		e.busyChats = make(map[string]struct{})
+	}
+	e.busyChats[chatID] = struct{}{}
+	e.running++
+	return nil
+}
+
+// releaseSlot frees a slot taken by reserveSlot and wakes the queue
+// dispatcher so the next waiting message can take it.
+func (e *ChatMessageExecutor) releaseSlot(chatID string) {
+	e.mu.Lock()
+	e.running--
+	delete(e.busyChats, chatID)
+	e.mu.Unlock()
+
+	select {
+	case e.wake <- struct{}{}:
+	default:
+	}
+}
+
+// atCapacityLocked reports whether every slot is taken. Caller holds e.mu.
+func (e *ChatMessageExecutor) atCapacityLocked() bool {
+	limits := e.execConfig.Limits
+	return limits != nil && limits.MaxConcurrentExecutions > 0 && e.running >= limits.MaxConcurrentExecutions
+}
+
+// waitingForSlot reports whether queued messages are waiting for a slot, in
+// which case new messages queue behind them instead of taking the next one.
+func (e *ChatMessageExecutor) waitingForSlot() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.waitingForSlotLocked()
+}
+
+func (e *ChatMessageExecutor) waitingForSlotLocked() bool {
+	return slices.ContainsFunc(e.queue, func(q *queuedChatMessage) bool {
+		return q.reason == events.ChatQueueReasonAtCapacity
+	})
+}
+
+// ────────────────────────────────────────────────────────────
+// QueuePending — the fair queue across sessions
+// ────────────────────────────────────────────────────────────
+
+// QueuePending queues a message that could not start yet (Submit returned
+// ErrChatExecutionActive or ErrChatAtCapacity) and returns its 1-based
+// position. Messages start in arrival order, across sessions, once their
+// chat has no active execution — on this pod or another — and a slot is
+// free; a message whose chat is still busy keeps its place without holding
+// up the ones behind it. Only one message per chat is queued, and at most
+// MaxQueuedMessages overall; further ones get a ChatLimitError. Cancelling
+// the chat, or shutdown, discards the queued message and deletes it.
+func (e *ChatMessageExecutor) QueuePending(ctx context.Context, input ChatExecuteInput) (int, error) {
+	e.mu.Lock()
+	if e.stopped {
+		e.mu.Unlock()
+		return 0, ErrShuttingDown
+	}
+	if e.queueIndexLocked(input.Chat.ID) >= 0 {
+		e.mu.Unlock()
+		return 0, &ChatLimitError{
+			Reason:     "a message is already queued behind the current chat response",
+			RetryAfter: chatQueueFullRetryAfter,
+		}
+	}
+	if limits := e.execConfig.Limits; limits != nil && limits.MaxQueuedMessages > 0 &&
+		len(e.queue) >= limits.MaxQueuedMessages {
+		e.mu.Unlock()
+		return 0, &ChatLimitError{
+			Reason:     "too many chat messages are waiting right now",
+			RetryAfter: chatAtCapacityRetryAfter,
+		}
+	}
+
+	reason := events.ChatQueueReasonChatBusy
+	if _, busy := e.busyChats[input.Chat.ID]; !busy && (e.atCapacityLocked() || e.waitingForSlotLocked()) {
+		reason = events.ChatQueueReasonAtCapacity
+	}
+	e.queue = append(e.queue, &queuedChatMessage{
+		ctx:    requestid.WithContext(context.Background(), requestid.FromContext(ctx)),
+		input:  input,
+		reason: reason,
+	})
+	position := len(e.queue)
+	if !e.dispatching {
+		e.dispatching = true
+		e.wg.Add(1)
+		go e.dispatchQueue()
+	}
+	e.mu.Unlock()
+
+	e.publishQueuePositions()
+	return position, nil
+}
+
+// dispatchQueue starts queued messages as slots are released and chats
+// become free. It runs while the queue is non-empty and discards what is
+// left on shutdown.
+func (e *ChatMessageExecutor) dispatchQueue() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(chatPendingPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.stopCh:
+			e.discardQueue()
+			return
+		case <-e.wake:
+		case <-ticker.C:
+		}
+
+		if !e.dispatchPass() {
+			return
+		}
+	}
+}
+
+// dispatchPass walks the queue oldest first, starting each message whose
+// chat is free. It stops at the first message that finds the pod at
+// capacity, so later messages cannot overtake it. Reports whether messages
+// are still waiting; when none are, the dispatcher is marked as stopped.
+func (e *ChatMessageExecutor) dispatchPass() bool {
+	e.mu.RLock()
+	waiting := slices.Clone(e.queue)
+	e.mu.RUnlock()
+
+pass:
+	for _, q := range waiting {
+		logger := queuedChatLogger(q)
+
+		e.mu.RLock()
+		stillQueued := slices.Contains(e.queue, q)
+		e.mu.RUnlock()
+		if !stillQueued {
+			continue // cancelled meanwhile
+		}
+
+		stageID, err := e.start(q.ctx, q.input)
+		switch {
+		case err == nil:
+			e.dequeue(q)
+			logger.Info("Chat executor: started queued message", "stage_id", stageID)
+			e.publishQueuePosition(q, 0, events.ChatQueueReasonStarted)
+		case errors.Is(err, ErrChatExecutionActive):
+			e.setQueueReason(q, events.ChatQueueReasonChatBusy)
+		case errors.Is(err, ErrChatAtCapacity):
+			e.setQueueReason(q, events.ChatQueueReasonAtCapacity)
+			break pass
+		case errors.Is(err, ErrShuttingDown):
+			return true // dispatchQueue discards the queue
+		default:
+			logger.Error("Failed to start queued chat message", "error", err)
+			if e.dequeue(q) {
+				e.discardQueued(q, "start failed")
+			}
+		}
+	}
+	e.publishQueuePositions()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.queue) == 0 {
+		e.dispatching = false
+		return false
+	}
+	return true
+}
+
+// discardQueue discards every queued message (shutdown).
+func (e *ChatMessageExecutor) discardQueue() {
+	e.mu.Lock()
+	queued := e.queue
+	e.queue = nil
+	e.dispatching = false
+	e.mu.Unlock()
+
+	for _, q := range queued {
+		e.discardQueued(q, "shutting down")
+	}
+}
+
+// discardQueued deletes a message removed from the queue without being
+// started, so the chat history doesn't show a question that was never
+// answered, and tells the user it left the queue.
+func (e *ChatMessageExecutor) discardQueued(q *queuedChatMessage, reason string) {
+	logger := queuedChatLogger(q)
+	logger.Info("Chat executor: discarding queued message", "reason", reason)
+	e.publishQueuePosition(q, 0, events.ChatQueueReasonDiscarded)
+	if e.chatService == nil {
+		return
+	}
+	if err := e.chatService.DeleteChatMessage(q.ctx, q.input.Message.ID); err != nil {
+		logger.Warn("Failed to delete discarded chat message", "error", err)
+	}
+}
+
+// queueIndexLocked returns the queue index of chatID's message, or -1.
+// Caller holds e.mu.
+func (e *ChatMessageExecutor) queueIndexLocked(chatID string) int {
+	return slices.IndexFunc(e.queue, func(q *queuedChatMessage) bool {
+		return q.input.Chat.ID == chatID
+	})
+}
+
+// removeQueuedLocked removes chatID's message from the queue and returns it
+// (nil if none). Caller holds e.mu.
+func (e *ChatMessageExecutor) removeQueuedLocked(chatID string) *queuedChatMessage {
+	i := e.queueIndexLocked(chatID)
+	if i < 0 {
+		return nil
+	}
+	q := e.queue[i]
+	e.queue = slices.Delete(e.queue, i, i+1)
+	return q
+}
+
+// dequeue removes q from the queue. Reports whether it was still queued.
+func (e *ChatMessageExecutor) dequeue(q *queuedChatMessage) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	i := slices.Index(e.queue, q)
+	if i < 0 {
+		return false
+	}
+	e.queue = slices.Delete(e.queue, i, i+1)
+	return true
+}
+
+// setQueueReason records what a queued message is waiting for.
+func (e *ChatMessageExecutor) setQueueReason(q *queuedChatMessage, reason string) {
+	e.mu.Lock()
+	q.reason = reason
+	e.mu.Unlock()
+}
+
+// ────────────────────────────────────────────────────────────
+// Queue position events
+// ────────────────────────────────────────────────────────────
+
+// publishQueuePositions publishes the position of every queued message
+// whose position or reason changed since it was last published.
+func (e *ChatMessageExecutor) publishQueuePositions() {
+	type update struct {
+		q        *queuedChatMessage
+		position int
+		reason   string
+	}
+	var updates []update
+
+	e.mu.Lock()
+	for i, q := range e.queue {
+		if q.publishedPosition == i+1 && q.publishedReason == q.reason {
+			continue
+		}
+		q.publishedPosition, q.publishedReason = i+1, q.reason
+		updates = append(updates, update{q: q, position: i + 1, reason: q.reason})
+	}
+	e.mu.Unlock()
+
+	for _, u := range updates {
+		e.publishQueuePosition(u.q, u.position, u.reason)
+	}
+}
+
+// publishQueuePosition publishes a chat.queue_position event for q
+// (best-effort). Position 0 means it left the queue.
+func (e *ChatMessageExecutor) publishQueuePosition(q *queuedChatMessage, position int, reason string) {
+	if e.queuePublisher == nil {
+		return
+	}
+	e.mu.RLock()
+	size := len(e.queue)
+	e.mu.RUnlock()
+
+	sessionID := q.input.Session.ID
+	if err := e.queuePublisher.PublishChatQueuePosition(q.ctx, sessionID, events.ChatQueuePositionPayload{
+		BasePayload: events.BasePayload{
+			Type:      events.EventTypeChatQueuePosition,
+			SessionID: sessionID,
+			Timestamp: time.Now().Format(time.RFC3339Nano),
+		},
+		ChatID:    q.input.Chat.ID,
+		MessageID: q.input.Message.ID,
+		Position:  position,
+		QueueSize: size,
+		Reason:    reason,
+	}); err != nil {
+		queuedChatLogger(q).Warn("Failed to publish chat queue position", "error", err)
+	}
+}
+
+func queuedChatLogger(q *queuedChatMessage) *slog.Logger {
+	logger := slog.With(
+		"session_id", q.input.Session.ID,
+		"chat_id", q.input.Chat.ID,
+		"message_id", q.input.Message.ID,
+	)
+	if reqID := requestid.FromContext(q.ctx); reqID != "" {
+		logger = logger.With("request_id", reqID)
+	}
+	return logger
+}
@@ -0,0 +1,174 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+)
+
+// recordingQueuePublisher records published chat.queue_position payloads.
+type recordingQueuePublisher struct {
+	mu       sync.Mutex
+	payloads []events.ChatQueuePositionPayload
+}
+
+func (p *recordingQueuePublisher) PublishChatQueuePosition(_ context.Context, _ string, payload events.ChatQueuePositionPayload) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.payloads = append(p.payloads, payload)
+	return nil
+}
+
+// take returns the payloads published since the last call.
+func (p *recordingQueuePublisher) take() []events.ChatQueuePositionPayload {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := p.payloads
+	p.payloads = nil
+	return out
+}
+
+func newQueueTestExecutor(t *testing.T, limits *config.ChatLimitsConfig) (*ChatMessageExecutor, *recordingQueuePublisher) {
+	t.Helper()
+	orig := chatPendingPollInterval
+	chatPendingPollInterval = time.Hour // never starts: no stage service here
+	t.Cleanup(func() { chatPendingPollInterval = orig })
+
+	pub := &recordingQueuePublisher{}
+	e := &ChatMessageExecutor{
+		execConfig:     ChatMessageExecutorConfig{Limits: limits},
+		activeExecs:    make(map[string]context.CancelFunc),
+		busyChats:      make(map[string]struct{}),
+		stopCh:         make(chan struct{}),
+		queuePublisher: pub,
+	}
+	t.Cleanup(e.Stop)
+	return e, pub
+}
+
+func queueTestInput(chatID, msgID string) ChatExecuteInput {
+	return ChatExecuteInput{
+		Chat:    &ent.Chat{ID: chatID},
+		Message: &ent.ChatUserMessage{ID: msgID},
+		Session: &ent.AlertSession{ID: "session-" + chatID},
+	}
+}
+
+func TestChatMessageExecutor_ReserveSlot(t *testing.T) {
+	e := &ChatMessageExecutor{execConfig: ChatMessageExecutorConfig{
+		Limits: &config.ChatLimitsConfig{MaxConcurrentExecutions: 2},
+	}}
+	require.NoError(t, e.reserveSlot("chat-1"))
+	assert.ErrorIs(t, e.reserveSlot("chat-1"), ErrChatExecutionActive, "one execution per chat")
+	require.NoError(t, e.reserveSlot("chat-2"))
+	assert.ErrorIs(t, e.reserveSlot("chat-3"), ErrChatAtCapacity)
+
+	e.releaseSlot("chat-1")
+	assert.NoError(t, e.reserveSlot("chat-3"))
+	assert.ErrorIs(t, e.reserveSlot("chat-1"), ErrChatAtCapacity)
+
+	unlimited := &ChatMessageExecutor{}
+	for i := range 5 {
+		require.NoError(t, unlimited.reserveSlot(fmt.Sprintf("chat-%d", i)))
+	}
+}
+
+func TestChatMessageExecutor_QueuePending(t *testing.T) {
+	e, pub := newQueueTestExecutor(t, nil)
+
+	position, err := e.QueuePending(context.Background(), queueTestInput("chat-1", "msg-1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, position)
+
+	_, err = e.QueuePending(context.Background(), queueTestInput("chat-1", "msg-2"))
+	var limitErr *ChatLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, chatQueueFullRetryAfter, limitErr.RetryAfter)
+
+	position, err = e.QueuePending(context.Background(), queueTestInput("chat-2", "msg-3"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, position, "other sessions queue behind")
+
+	published := pub.take()
+	require.Len(t, published, 2)
+	assert.Equal(t, "msg-1", published[0].MessageID)
+	assert.Equal(t, 1, published[0].Position)
+	assert.Equal(t, events.ChatQueueReasonChatBusy, published[0].Reason)
+	assert.Equal(t, "msg-3", published[1].MessageID)
+	assert.Equal(t, 2, published[1].Position)
+
+	assert.True(t, e.CancelExecution("chat-1"), "cancel discards the queued message")
+	assert.False(t, e.CancelExecution("chat-1"))
+	published = pub.take()
+	require.Len(t, published, 2)
+	assert.Equal(t, "msg-1", published[0].MessageID)
+	assert.Equal(t, 0, published[0].Position)
+	assert.Equal(t, events.ChatQueueReasonDiscarded, published[0].Reason)
+	assert.Equal(t, "msg-3", published[1].MessageID)
+	assert.Equal(t, 1, published[1].Position, "later messages move up")
+
+	position, err = e.QueuePending(context.Background(), queueTestInput("chat-1", "msg-4"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, position, "a re-sent message goes to the back")
+	pub.take()
+
+	e.Stop() // discards both
+	assert.Empty(t, e.queue)
+	published = pub.take()
+	require.Len(t, published, 2)
+	for _, p := range published {
+		assert.Equal(t, 0, p.Position)
+		assert.Equal(t, events.ChatQueueReasonDiscarded, p.Reason)
+	}
+	_, err = e.QueuePending(context.Background(), queueTestInput("chat-3", "msg-5"))
+	assert.ErrorIs(t, err, ErrShuttingDown)
+}
+
+func TestChatMessageExecutor_QueuePending_Bounded(t *testing.T) {
+	e, _ := newQueueTestExecutor(t, &config.ChatLimitsConfig{MaxQueuedMessages: 2})
+
+	for _, chatID := range []string{"chat-1", "chat-2"} {
+		_, err := e.QueuePending(context.Background(), queueTestInput(chatID, "msg-"+chatID))
+		require.NoError(t, err)
+	}
+	_, err := e.QueuePending(context.Background(), queueTestInput("chat-3", "msg-chat-3"))
+	var limitErr *ChatLimitError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Contains(t, limitErr.Reason, "waiting")
+	assert.Equal(t, chatAtCapacityRetryAfter, limitErr.RetryAfter)
+}
+
+func TestChatMessageExecutor_QueueAtCapacity(t *testing.T) {
+	e, pub := newQueueTestExecutor(t, &config.ChatLimitsConfig{MaxConcurrentExecutions: 1})
+	require.NoError(t, e.reserveSlot("chat-1")) // chat-1 is answering
+
+	// chat-1's follow-up waits for its chat; chat-2 waits for a slot
+	_, err := e.QueuePending(context.Background(), queueTestInput("chat-1", "msg-1"))
+	require.NoError(t, err)
+	_, err = e.QueuePending(context.Background(), queueTestInput("chat-2", "msg-2"))
+	require.NoError(t, err)
+	published := pub.take()
+	require.Len(t, published, 2)
+	assert.Equal(t, events.ChatQueueReasonChatBusy, published[0].Reason)
+	assert.Equal(t, events.ChatQueueReasonAtCapacity, published[1].Reason)
+
+	// New messages queue behind the ones waiting for a slot
+	assert.True(t, e.waitingForSlot())
+
+	// A pass at capacity starts nothing: chat-1 is skipped as busy and
+	// chat-2 finds no slot
+	assert.True(t, e.dispatchPass())
+	require.Len(t, e.queue, 2)
+	assert.Equal(t, "msg-1", e.queue[0].input.Message.ID)
+	assert.Equal(t, "msg-2", e.queue[1].input.Message.ID)
+	assert.Empty(t, pub.take(), "unchanged positions are not republished")
+}
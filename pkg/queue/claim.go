@@ -0,0 +1,130 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+)
+
+// Claim round results (tarsy_queue_claim_rounds_total).
+const (
+	claimRoundClaimed    = "claimed"
+	claimRoundEmpty      = "empty"
+	claimRoundAtCapacity = "at_capacity"
+)
+
+// claimLockKey is the transaction-level advisory lock serializing claim
+// rounds across pods. Holding it makes the capacity check exact, and the
+// rounds are short, so waiting for it is cheaper than racing: every round
+// that loses a race still pays for its count and SKIP LOCKED scan.
+var claimLockKey = func() int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("tarsy:queue:claim"))
+	return int64(h.Sum64())
+}()
+
+// claimSessions claims up to one pending session per worker ID in a single
+// transaction, bounded by cfg.ClaimBatchSize and the free global capacity.
+// Session i is claimed for workerIDs[i]. Returns ErrAtCapacity or
+// ErrNoSessionsAvailable when nothing could be claimed.
+func claimSessions(ctx context.Context, client *ent.Client, cfg *config.QueueConfig, podID string, workerIDs []string) ([]*ent.AlertSession, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	lockStart := time.Now()
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", claimLockKey); err != nil {
+		return nil, fmt.Errorf("failed to acquire claim lock: %w", err)
+	}
+	metrics.ClaimLockWaitSeconds.Observe(time.Since(lockStart).Seconds())
+
+	activeCount, err := tx.AlertSession.Query().
+		Where(
+			alertsession.StatusEQ(alertsession.StatusInProgress),
+			alertsession.Sandbox(false),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("checking active sessions: %w", err)
+	}
+	limit := min(len(workerIDs), cfg.MaxConcurrentSessions-activeCount)
+	if cfg.ClaimBatchSize > 0 {
+		limit = min(limit, cfg.ClaimBatchSize)
+	}
+	if limit <= 0 {
+		metrics.ClaimRoundsTotal.WithLabelValues(claimRoundAtCapacity).Inc()
+		return nil, ErrAtCapacity
+	}
+
+	// SELECT ... FOR UPDATE SKIP LOCKED: the claim lock keeps other rounds
+	// out; SKIP LOCKED still guards against pods of an older version, which
+	// claim without it, during a rollout.
+	// Order by created_at for FIFO processing. Sandbox sessions are run by
+	// the SandboxRunner of the pod that created them.
+	query := tx.AlertSession.Query().
+		Where(
+			alertsession.StatusEQ(alertsession.StatusPending),
+			alertsession.Sandbox(false),
+			alertsession.DeletedAtIsNil(),
+		)
+	if pc := cfg.Preemption; pc.Active() {
+		// Most critical alert types first, so workers freed by preemption
+		// go to the alerts they were freed for.
+		query = query.Order(priorityOrder(pc))
+	}
+	sessions, err := query.
+		Order(ent.Asc(alertsession.FieldCreatedAt)).
+		Limit(limit).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending sessions: %w", err)
+	}
+	if len(sessions) == 0 {
+		metrics.ClaimRoundsTotal.WithLabelValues(claimRoundEmpty).Inc()
+		return nil, ErrNoSessionsAvailable
+	}
+
+	// Claim: set in_progress, pod_id, started_at, last_interaction_at
+	// This is when actual execution starts (mirrors Stage and AgentExecution behavior)
+	ids := make([]string, len(sessions))
+	for i, s := range sessions {
+		ids[i] = s.ID
+	}
+	now := time.Now()
+	if err := tx.AlertSession.Update().
+		Where(alertsession.IDIn(ids...)).
+		SetStatus(alertsession.StatusInProgress).
+		SetPodID(podID).
+		SetStartedAt(now).
+		SetLastInteractionAt(now).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to claim sessions: %w", err)
+	}
+	for i, s := range sessions {
+		s.Status = alertsession.StatusInProgress
+		s.PodID = &podID
+		s.StartedAt = &now
+		s.LastInteractionAt = &now
+		if err := recordClaim(ctx, tx, s, podID, workerIDs[i], now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit claim: %w", err)
+	}
+	metrics.ClaimRoundsTotal.WithLabelValues(claimRoundClaimed).Inc()
+	metrics.ClaimsTotal.WithLabelValues(podID).Add(float64(len(sessions)))
+
+	return sessions, nil
+}
@@ -0,0 +1,283 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createPendingSessions creates n pending sessions, oldest first.
+func createPendingSessions(ctx context.Context, tb testing.TB, client *ent.Client, n int) []*ent.AlertSession {
+	tb.Helper()
+	base := time.Now().Add(-time.Hour)
+	sessions := make([]*ent.AlertSession, 0, n)
+	for start := 0; start < n; start += 1000 {
+		builders := make([]*ent.AlertSessionCreate, 0, min(1000, n-start))
+		for i := start; i < min(start+1000, n); i++ {
+			builders = append(builders, client.AlertSession.Create().
+				SetID(uuid.New().String()).
+				SetAlertData("test alert data").
+				SetAgentType("test-agent").
+				SetAlertType("test-alert").
+				SetChainID("test-chain").
+				SetStatus(alertsession.StatusPending).
+				SetAuthor("test-user").
+				SetCreatedAt(base.Add(time.Duration(i)*time.Millisecond)))
+		}
+		batch, err := client.AlertSession.CreateBulk(builders...).Save(ctx)
+		require.NoError(tb, err)
+		sessions = append(sessions, batch...)
+	}
+	return sessions
+}
+
+func TestClaimSessions(t *testing.T) {
+	dbClient := testdb.NewTestClient(t)
+	client := dbClient.Client
+	ctx := context.Background()
+
+	pending := createPendingSessions(ctx, t, client, 5)
+	cfg := intTestQueueConfig()
+	cfg.ClaimBatchSize = 10
+
+	t.Run("claims one session per worker, oldest first", func(t *testing.T) {
+		claimed, err := claimSessions(ctx, client, cfg, "test-pod", []string{"w0", "w1", "w2"})
+		require.NoError(t, err)
+		require.Len(t, claimed, 3)
+		for i, s := range claimed {
+			assert.Equal(t, pending[i].ID, s.ID)
+			assert.Equal(t, alertsession.StatusInProgress, s.Status)
+			require.NotNil(t, s.PodID)
+			assert.Equal(t, "test-pod", *s.PodID)
+
+			stored, err := client.AlertSession.Get(ctx, s.ID)
+			require.NoError(t, err)
+			assert.Equal(t, alertsession.StatusInProgress, stored.Status)
+			assert.NotNil(t, stored.StartedAt)
+
+			claim, err := client.SessionClaim.Query().Where(sessionclaim.SessionIDEQ(s.ID)).Only(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("w%d", i), claim.WorkerID)
+		}
+	})
+
+	t.Run("batch size caps the round", func(t *testing.T) {
+		cfg := *cfg
+		cfg.ClaimBatchSize = 1
+		claimed, err := claimSessions(ctx, client, &cfg, "test-pod", []string{"w3", "w4"})
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, pending[3].ID, claimed[0].ID)
+	})
+
+	t.Run("global capacity caps the round", func(t *testing.T) {
+		cfg := *cfg
+		cfg.MaxConcurrentSessions = 4
+		_, err := claimSessions(ctx, client, &cfg, "test-pod", []string{"w4", "w5"})
+		assert.ErrorIs(t, err, ErrAtCapacity)
+
+		cfg.MaxConcurrentSessions = 5
+		claimed, err := claimSessions(ctx, client, &cfg, "test-pod", []string{"w4", "w5"})
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, pending[4].ID, claimed[0].ID)
+	})
+
+	t.Run("empty queue", func(t *testing.T) {
+		_, err := claimSessions(ctx, client, cfg, "test-pod", []string{"w5"})
+		assert.ErrorIs(t, err, ErrNoSessionsAvailable)
+	})
+}
+
+func TestClaimerRequeuesSessionForStoppedWorker(t *testing.T) {
+	dbClient := testdb.NewTestClient(t)
+	client := dbClient.Client
+	ctx := context.Background()
+
+	createPendingSessions(ctx, t, client, 1)
+	cfg := intTestQueueConfig()
+	claimed, err := claimSessions(ctx, client, cfg, "test-pod", []string{"w0"})
+	require.NoError(t, err)
+
+	// The worker stopped waiting before the claim round delivered.
+	stopped := make(chan struct{})
+	close(stopped)
+	c := newClaimer("test-pod", client, cfg, nil, make(chan struct{}))
+	c.deliver(ctx, claimRequest{workerID: "w0", reply: make(chan claimReply), done: stopped}, claimReply{session: claimed[0]})
+
+	stored, err := client.AlertSession.Get(ctx, claimed[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, alertsession.StatusPending, stored.Status)
+	assert.Nil(t, stored.PodID)
+
+	claim, err := client.SessionClaim.Query().Where(sessionclaim.SessionIDEQ(stored.ID)).Only(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, claim.Outcome)
+	assert.Equal(t, sessionclaim.OutcomePodRestarted, *claim.Outcome)
+}
+
+// legacyClaimNextSession is the per-worker claim path that claimSessions
+// replaced: a separate capacity count, then LIMIT 1 ... SKIP LOCKED, one
+// transaction per worker poll. Kept as the benchmark baseline.
+func legacyClaimNextSession(ctx context.Context, client *ent.Client, cfg *config.QueueConfig, podID, workerID string) (*ent.AlertSession, error) {
+	activeCount, err := client.AlertSession.Query().
+		Where(
+			alertsession.StatusEQ(alertsession.StatusInProgress),
+			alertsession.Sandbox(false),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if activeCount >= cfg.MaxConcurrentSessions {
+		return nil, ErrAtCapacity
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	session, err := tx.AlertSession.Query().
+		Where(
+			alertsession.StatusEQ(alertsession.StatusPending),
+			alertsession.Sandbox(false),
+			alertsession.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(alertsession.FieldCreatedAt)).
+		Limit(1).
+		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNoSessionsAvailable
+		}
+		return nil, err
+	}
+	now := time.Now()
+	session, err = session.Update().
+		SetStatus(alertsession.StatusInProgress).
+		SetPodID(podID).
+		SetStartedAt(now).
+		SetLastInteractionAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordClaim(ctx, tx, session, podID, workerID, now); err != nil {
+		return nil, err
+	}
+	return session, tx.Commit()
+}
+
+// claimLockWait returns the total time claim rounds have waited for the
+// claim lock so far.
+func claimLockWait(tb testing.TB) time.Duration {
+	tb.Helper()
+	var m dto.Metric
+	require.NoError(tb, metrics.ClaimLockWaitSeconds.Write(&m))
+	return time.Duration(m.GetHistogram().GetSampleSum() * float64(time.Second))
+}
+
+// BenchmarkClaim drains a backlog of b.N pending sessions with several
+// pods of several workers each, comparing one claim transaction per
+// worker poll (per_worker) with one batched round per pod (batched).
+// Reports claims/sec, claim transactions per claim, transactions that
+// came back empty-handed per claim (SKIP LOCKED and capacity races), and
+// time waited on the claim lock per claim.
+func BenchmarkClaim(b *testing.B) {
+	const pods, workersPerPod = 4, 10
+
+	for _, mode := range []string{"per_worker", "batched"} {
+		b.Run(mode, func(b *testing.B) {
+			dbClient := testdb.NewTestClient(b)
+			client := dbClient.Client
+			ctx := context.Background()
+			cfg := config.DefaultQueueConfig()
+			cfg.MaxConcurrentSessions = b.N + 1 // measure claiming, not capacity
+
+			createPendingSessions(ctx, b, client, b.N)
+			lockWaitBefore := claimLockWait(b)
+
+			var claimed, txs, empty atomic.Int64
+			errCh := make(chan error, pods*workersPerPod)
+			var wg sync.WaitGroup
+
+			// run loops one claimer (a worker or a pod) until the backlog is gone.
+			run := func(claim func() (int, error)) {
+				defer wg.Done()
+				for claimed.Load() < int64(b.N) {
+					n, err := claim()
+					txs.Add(1)
+					switch {
+					case errors.Is(err, ErrNoSessionsAvailable), errors.Is(err, ErrAtCapacity):
+						empty.Add(1)
+					case err != nil:
+						errCh <- err
+						return
+					}
+					claimed.Add(int64(n))
+				}
+			}
+
+			b.ResetTimer()
+			start := time.Now()
+			for p := range pods {
+				podID := fmt.Sprintf("pod-%d", p)
+				workerIDs := make([]string, workersPerPod)
+				for w := range workerIDs {
+					workerIDs[w] = fmt.Sprintf("%s-worker-%d", podID, w)
+				}
+				if mode == "batched" {
+					wg.Add(1)
+					go run(func() (int, error) {
+						sessions, err := claimSessions(ctx, client, cfg, podID, workerIDs)
+						return len(sessions), err
+					})
+					continue
+				}
+				for _, workerID := range workerIDs {
+					wg.Add(1)
+					go run(func() (int, error) {
+						session, err := legacyClaimNextSession(ctx, client, cfg, podID, workerID)
+						if session == nil {
+							return 0, err
+						}
+						return 1, err
+					})
+				}
+			}
+			wg.Wait()
+			elapsed := time.Since(start)
+			b.StopTimer()
+
+			close(errCh)
+			for err := range errCh {
+				require.NoError(b, err)
+			}
+			n := float64(claimed.Load())
+			require.Equal(b, float64(b.N), n)
+			b.ReportMetric(n/elapsed.Seconds(), "claims/sec")
+			b.ReportMetric(float64(txs.Load())/n, "txs/claim")
+			b.ReportMetric(float64(empty.Load())/n, "empty_txs/claim")
+			b.ReportMetric(float64((claimLockWait(b)-lockWaitBefore).Microseconds())/n, "lock_wait_us/claim")
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// claimRequest is an idle worker asking the pod's claimer for a session.
+type claimRequest struct {
+	workerID string
+	reply    chan claimReply
+	done     <-chan struct{} // closed when the worker stops waiting
+}
+
+// claimReply answers a claimRequest with a session, or with ErrQueuePaused.
+type claimReply struct {
+	session *ent.AlertSession
+	err     error
+}
+
+// claimer claims sessions for all idle workers of a pod in batched rounds,
+// so a pod runs one claim transaction per round instead of one poll per
+// worker. Between rounds it backs off adaptively: the interval doubles
+// from poll_interval up to max_poll_interval while the queue stays empty,
+// and resets once a session is claimed.
+type claimer struct {
+	podID    string
+	client   *ent.Client
+	config   *config.QueueConfig
+	pause    *pauseGate // nil = never paused
+	requests chan claimRequest
+	stopCh   <-chan struct{}
+
+	// claim runs one round; claimSessions outside tests.
+	claim func(ctx context.Context, workerIDs []string) ([]*ent.AlertSession, error)
+}
+
+func newClaimer(podID string, client *ent.Client, cfg *config.QueueConfig, pause *pauseGate, stopCh <-chan struct{}) *claimer {
+	c := &claimer{
+		podID:    podID,
+		client:   client,
+		config:   cfg,
+		pause:    pause,
+		requests: make(chan claimRequest),
+		stopCh:   stopCh,
+	}
+	c.claim = func(ctx context.Context, workerIDs []string) ([]*ent.AlertSession, error) {
+		return claimSessions(ctx, client, cfg, podID, workerIDs)
+	}
+	return c
+}
+
+// next blocks until the claimer hands workerID a session or the queue is
+// paused (ErrQueuePaused). Returns ErrNoSessionsAvailable when done is
+// closed or ctx ends first.
+func (c *claimer) next(ctx context.Context, workerID string, done <-chan struct{}) (*ent.AlertSession, error) {
+	req := claimRequest{workerID: workerID, reply: make(chan claimReply), done: done}
+	select {
+	case c.requests <- req:
+	case <-done:
+		return nil, ErrNoSessionsAvailable
+	case <-ctx.Done():
+		return nil, ErrNoSessionsAvailable
+	}
+	select {
+	case r := <-req.reply:
+		return r.session, r.err
+	case <-done:
+		return nil, ErrNoSessionsAvailable
+	case <-ctx.Done():
+		return nil, ErrNoSessionsAvailable
+	}
+}
+
+// run serves claim requests until stopped.
+func (c *claimer) run(ctx context.Context) {
+	var waiting []claimRequest
+	interval := c.config.PollInterval
+	for {
+		// Wait for a worker to go idle.
+		if len(waiting) == 0 {
+			select {
+			case req := <-c.requests:
+				waiting = append(waiting, req)
+			case <-c.stopCh:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+		waiting = c.gather(waiting)
+
+		var err error
+		waiting, err = c.round(ctx, waiting)
+		switch {
+		case err == nil:
+			interval = c.config.PollInterval
+			if len(waiting) > 0 {
+				// The batch was capped; more sessions are likely pending.
+				continue
+			}
+		case errors.Is(err, ErrNoSessionsAvailable):
+			interval = nextPollInterval(interval, c.config)
+		case errors.Is(err, ErrAtCapacity), errors.Is(err, ErrQueuePaused):
+			interval = c.config.PollInterval
+		default:
+			slog.Error("Error claiming sessions", "pod_id", c.podID, "error", err)
+			interval = max(interval, time.Second) // Brief backoff on error
+		}
+		// A worker of this pod going idle frees capacity, so at capacity
+		// its request starts the next round right away.
+		wakeEarly := errors.Is(err, ErrAtCapacity)
+		if !c.wait(ctx, jitter(interval, c.config.PollIntervalJitter), wakeEarly, &waiting) {
+			return
+		}
+	}
+}
+
+// gather adds the requests already queued, without blocking.
+func (c *claimer) gather(waiting []claimRequest) []claimRequest {
+	for {
+		select {
+		case req := <-c.requests:
+			waiting = append(waiting, req)
+		default:
+			return waiting
+		}
+	}
+}
+
+// round runs one claim round for the waiting workers and returns the ones
+// still waiting. Workers that stopped waiting are dropped first.
+func (c *claimer) round(ctx context.Context, waiting []claimRequest) ([]claimRequest, error) {
+	live := waiting[:0]
+	for _, req := range waiting {
+		select {
+		case <-req.done:
+		default:
+			live = append(live, req)
+		}
+	}
+	waiting = live
+	if len(waiting) == 0 {
+		return nil, nil
+	}
+
+	if c.pause.active() != nil {
+		for _, req := range waiting {
+			c.deliver(ctx, req, claimReply{err: ErrQueuePaused})
+		}
+		return nil, ErrQueuePaused
+	}
+
+	workerIDs := make([]string, len(waiting))
+	for i, req := range waiting {
+		workerIDs[i] = req.workerID
+	}
+	sessions, err := c.claim(ctx, workerIDs)
+	if err != nil {
+		return waiting, err
+	}
+	for i, session := range sessions {
+		c.deliver(ctx, waiting[i], claimReply{session: session})
+	}
+	return waiting[len(sessions):], nil
+}
+
+// deliver hands a reply to a waiting worker. A session claimed for a worker
+// that stopped waiting in the meantime goes back to the queue.
+func (c *claimer) deliver(ctx context.Context, req claimRequest, reply claimReply) {
+	select {
+	case req.reply <- reply:
+		return
+	case <-req.done:
+	case <-ctx.Done():
+	}
+	if reply.session == nil {
+		return
+	}
+	requeueCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if _, err := requeueOrphanedSession(requeueCtx, c.client, reply.session, sessionclaim.OutcomePodRestarted, "worker stopped before starting the session"); err != nil {
+		slog.Error("Failed to requeue session claimed for a stopped worker",
+			"session_id", reply.session.ID, "worker_id", req.workerID, "error", err)
+	}
+}
+
+// wait sleeps d between rounds, queueing the requests that arrive
+// meanwhile. With wakeEarly a new request ends the wait. Returns false
+// when the claimer is stopped.
+func (c *claimer) wait(ctx context.Context, d time.Duration, wakeEarly bool, waiting *[]claimRequest) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	for {
+		select {
+		case req := <-c.requests:
+			*waiting = append(*waiting, req)
+			if wakeEarly {
+				return true
+			}
+		case <-timer.C:
+			return true
+		case <-c.stopCh:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// nextPollInterval doubles the idle interval up to the configured ceiling.
+func nextPollInterval(current time.Duration, cfg *config.QueueConfig) time.Duration {
+	return min(max(2*current, cfg.PollInterval), cfg.IdlePollCeiling())
+}
+
+// jitter spreads d over [d - j, d + j], so pods do not poll in lockstep.
+func jitter(d, j time.Duration) time.Duration {
+	if j <= 0 {
+		return d
+	}
+	return d - j + time.Duration(rand.Int64N(int64(2*j)))
+}
@@ -0,0 +1,208 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClaims records claim rounds and answers them from a script.
+type fakeClaims struct {
+	mu     sync.Mutex
+	rounds [][]string
+	answer func(round int, workerIDs []string) ([]*ent.AlertSession, error)
+}
+
+func (f *fakeClaims) claim(_ context.Context, workerIDs []string) ([]*ent.AlertSession, error) {
+	f.mu.Lock()
+	f.rounds = append(f.rounds, append([]string(nil), workerIDs...))
+	round := len(f.rounds)
+	f.mu.Unlock()
+	return f.answer(round, workerIDs)
+}
+
+func (f *fakeClaims) roundCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.rounds)
+}
+
+// sessionsFor returns one fake session per worker ID, named after it.
+func sessionsFor(workerIDs []string) []*ent.AlertSession {
+	sessions := make([]*ent.AlertSession, len(workerIDs))
+	for i, id := range workerIDs {
+		sessions[i] = &ent.AlertSession{ID: "session-for-" + id}
+	}
+	return sessions
+}
+
+func testClaimer(cfg *config.QueueConfig, claims *fakeClaims) *claimer {
+	c := newClaimer("test-pod", nil, cfg, nil, make(chan struct{}))
+	c.claim = claims.claim
+	return c
+}
+
+// bufferedRequest returns a request whose reply can be read after round.
+func bufferedRequest(workerID string, done <-chan struct{}) claimRequest {
+	return claimRequest{workerID: workerID, reply: make(chan claimReply, 1), done: done}
+}
+
+func TestNextPollInterval(t *testing.T) {
+	cfg := &config.QueueConfig{PollInterval: time.Second, MaxPollInterval: 5 * time.Second}
+
+	d := cfg.PollInterval
+	var got []time.Duration
+	for range 4 {
+		d = nextPollInterval(d, cfg)
+		got = append(got, d)
+	}
+	assert.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, got)
+
+	t.Run("backoff off when max is at or below poll interval", func(t *testing.T) {
+		cfg := &config.QueueConfig{PollInterval: time.Second}
+		assert.Equal(t, time.Second, nextPollInterval(time.Second, cfg))
+	})
+}
+
+func TestJitter(t *testing.T) {
+	assert.Equal(t, time.Second, jitter(time.Second, 0))
+	for range 100 {
+		d := jitter(4*time.Second, 500*time.Millisecond)
+		assert.GreaterOrEqual(t, d, 3500*time.Millisecond)
+		assert.LessOrEqual(t, d, 4500*time.Millisecond)
+	}
+}
+
+func TestClaimerRound(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("claims for all waiting workers in one round", func(t *testing.T) {
+		claims := &fakeClaims{answer: func(_ int, ids []string) ([]*ent.AlertSession, error) {
+			return sessionsFor(ids[:2]), nil // batch capped at 2
+		}}
+		c := testClaimer(config.DefaultQueueConfig(), claims)
+		reqs := []claimRequest{bufferedRequest("w0", nil), bufferedRequest("w1", nil), bufferedRequest("w2", nil)}
+
+		waiting, err := c.round(ctx, reqs)
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"w0", "w1", "w2"}}, claims.rounds)
+		assert.Equal(t, "session-for-w0", (<-reqs[0].reply).session.ID)
+		assert.Equal(t, "session-for-w1", (<-reqs[1].reply).session.ID)
+		require.Len(t, waiting, 1)
+		assert.Equal(t, "w2", waiting[0].workerID)
+	})
+
+	t.Run("drops workers that stopped waiting", func(t *testing.T) {
+		claims := &fakeClaims{answer: func(_ int, ids []string) ([]*ent.AlertSession, error) {
+			return sessionsFor(ids), nil
+		}}
+		c := testClaimer(config.DefaultQueueConfig(), claims)
+		stopped := make(chan struct{})
+		close(stopped)
+
+		waiting, err := c.round(ctx, []claimRequest{bufferedRequest("w0", stopped), bufferedRequest("w1", nil)})
+		require.NoError(t, err)
+		assert.Empty(t, waiting)
+		assert.Equal(t, [][]string{{"w1"}}, claims.rounds)
+	})
+
+	t.Run("keeps workers waiting when nothing is pending", func(t *testing.T) {
+		claims := &fakeClaims{answer: func(int, []string) ([]*ent.AlertSession, error) {
+			return nil, ErrNoSessionsAvailable
+		}}
+		c := testClaimer(config.DefaultQueueConfig(), claims)
+
+		waiting, err := c.round(ctx, []claimRequest{bufferedRequest("w0", nil)})
+		assert.ErrorIs(t, err, ErrNoSessionsAvailable)
+		assert.Len(t, waiting, 1)
+	})
+
+	t.Run("paused pod answers every worker without claiming", func(t *testing.T) {
+		claims := &fakeClaims{answer: func(int, []string) ([]*ent.AlertSession, error) {
+			t.Fatal("claimed while paused")
+			return nil, nil
+		}}
+		c := testClaimer(config.DefaultQueueConfig(), claims)
+		c.pause = &pauseGate{podID: "test-pod"}
+		c.pause.upsert(QueuePause{Scope: "test-pod", Reason: "draining"})
+		req := bufferedRequest("w0", nil)
+
+		waiting, err := c.round(ctx, []claimRequest{req})
+		assert.ErrorIs(t, err, ErrQueuePaused)
+		assert.Empty(t, waiting)
+		assert.ErrorIs(t, (<-req.reply).err, ErrQueuePaused)
+	})
+}
+
+func TestClaimerRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The first round finds nothing; the workers that go idle meanwhile are
+	// all claimed for in the second.
+	claims := &fakeClaims{answer: func(round int, ids []string) ([]*ent.AlertSession, error) {
+		if round == 1 {
+			return nil, ErrNoSessionsAvailable
+		}
+		return sessionsFor(ids), nil
+	}}
+	cfg := &config.QueueConfig{PollInterval: 200 * time.Millisecond, MaxPollInterval: time.Second}
+	c := testClaimer(cfg, claims)
+	go c.run(ctx)
+
+	type result struct {
+		workerID string
+		session  *ent.AlertSession
+		err      error
+	}
+	results := make(chan result, 3)
+	for _, id := range []string{"w0", "w1", "w2"} {
+		go func() {
+			s, err := c.next(ctx, id, nil)
+			results <- result{id, s, err}
+		}()
+		if id == "w0" {
+			awaitCondition(t, time.Second, 5*time.Millisecond, "first round", func() bool { return claims.roundCount() == 1 })
+		}
+	}
+
+	for range 3 {
+		r := <-results
+		require.NoError(t, r.err)
+		assert.Equal(t, "session-for-"+r.workerID, r.session.ID)
+	}
+	require.Equal(t, 2, claims.roundCount())
+	assert.ElementsMatch(t, []string{"w0", "w1", "w2"}, claims.rounds[1])
+}
+
+func TestClaimerNextReturnsWhenWorkerStops(t *testing.T) {
+	claims := &fakeClaims{answer: func(int, []string) ([]*ent.AlertSession, error) {
+		return nil, ErrNoSessionsAvailable
+	}}
+	c := testClaimer(&config.QueueConfig{PollInterval: time.Hour}, claims)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.run(ctx)
+
+	done := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := c.next(ctx, "w0", done)
+		errCh <- err
+	}()
+	awaitCondition(t, time.Second, 5*time.Millisecond, "first round", func() bool { return claims.roundCount() == 1 })
+	close(done)
+
+	select {
+	case err := <-errCh:
+		assert.ErrorIs(t, err, ErrNoSessionsAvailable)
+	case <-time.After(time.Second):
+		t.Fatal("next did not return after the worker stopped")
+	}
+}
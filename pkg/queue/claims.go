@@ -0,0 +1,70 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/google/uuid"
+)
+
+// recordClaim inserts the claim history row for a session just claimed by
+// podID/workerID. Runs inside the claiming transaction.
+func recordClaim(ctx context.Context, tx *ent.Tx, session *ent.AlertSession, podID, workerID string, claimedAt time.Time) error {
+	err := tx.SessionClaim.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(session.ID).
+		SetPodID(podID).
+		SetWorkerID(workerID).
+		SetClaimedAt(claimedAt).
+		SetQueueWaitMs(claimedAt.Sub(session.CreatedAt).Milliseconds()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record claim: %w", err)
+	}
+	return nil
+}
+
+// releaseClaims closes the open claim(s) of a session with the given
+// outcome. lastHeartbeat and detail are optional. Runs inside the
+// transaction that writes the session's terminal status.
+func releaseClaims(ctx context.Context, tx *ent.Tx, sessionID string, outcome sessionclaim.Outcome, lastHeartbeat *time.Time, detail string) error {
+	update := tx.SessionClaim.Update().
+		Where(
+			sessionclaim.SessionIDEQ(sessionID),
+			sessionclaim.ReleasedAtIsNil(),
+		).
+		SetReleasedAt(time.Now()).
+		SetOutcome(outcome).
+		SetNillableLastHeartbeatAt(lastHeartbeat)
+	if detail != "" {
+		update = update.SetDetail(detail)
+	}
+
+	n, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release claim: %w", err)
+	}
+	if n > 0 {
+		metrics.ClaimsReleasedTotal.WithLabelValues(string(outcome)).Add(float64(n))
+	}
+	return nil
+}
+
+// claimOutcome maps a terminal session status to a claim outcome.
+func claimOutcome(status alertsession.Status) sessionclaim.Outcome {
+	switch status {
+	case alertsession.StatusCompleted:
+		return sessionclaim.OutcomeCompleted
+	case alertsession.StatusTimedOut:
+		return sessionclaim.OutcomeTimedOut
+	case alertsession.StatusCancelled, alertsession.StatusAutoCancelled:
+		return sessionclaim.OutcomeCancelled
+	default:
+		return sessionclaim.OutcomeFailed
+	}
+}
@@ -0,0 +1,31 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+)
+
+func TestClaimOutcome(t *testing.T) {
+	tests := []struct {
+		status alertsession.Status
+		want   sessionclaim.Outcome
+	}{
+		{alertsession.StatusCompleted, sessionclaim.OutcomeCompleted},
+		{alertsession.StatusFailed, sessionclaim.OutcomeFailed},
+		{alertsession.StatusTimedOut, sessionclaim.OutcomeTimedOut},
+		{alertsession.StatusCancelled, sessionclaim.OutcomeCancelled},
+		{alertsession.StatusAutoCancelled, sessionclaim.OutcomeCancelled},
+		{alertsession.StatusInProgress, sessionclaim.OutcomeFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			assert.Equal(t, tt.want, claimOutcome(tt.status))
+			assert.NoError(t, sessionclaim.OutcomeValidator(tt.want))
+		})
+	}
+}
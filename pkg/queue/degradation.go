@@ -0,0 +1,80 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// newProviderHealth returns the process-wide LLM provider health tracker, or
+// nil when the degradation profile is disabled.
+func newProviderHealth(cfg *config.DegradationConfig) *agent.ProviderHealth {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+	return agent.NewProviderHealth(cfg.FailureThreshold, cfg.Cooldown)
+}
+
+// degradationReason returns why the session should run the triage-only
+// profile, or "" to run the chain as configured. The chain's preferred
+// provider is the one its first stage agent resolves to.
+func (e *RealSessionExecutor) degradationReason(chain *config.ChainConfig) string {
+	if e.providerHealth == nil || len(chain.Stages) == 0 || len(chain.Stages[0].Agents) == 0 {
+		return ""
+	}
+	first := chain.Stages[0]
+	resolved, err := agent.ResolveAgentConfig(e.cfg, chain, first, first.Agents[0])
+	if err != nil || !e.providerHealth.Degraded(resolved.LLMProviderName) {
+		return ""
+	}
+	return fmt.Sprintf("LLM provider %q is failing", resolved.LLMProviderName)
+}
+
+// triageChain derives the triage-only profile from chain: the first stage
+// with only its first agent (one replica, no sub-agents), iterations capped
+// at cfg.MaxIterations, and no synthesis. The original chain is not modified.
+func triageChain(chain *config.ChainConfig, cfg *config.DegradationConfig) *config.ChainConfig {
+	triage := *chain
+
+	stageCfg := chain.Stages[0]
+	agentCfg := stageCfg.Agents[0]
+	maxIter := cfg.MaxIterations
+	for _, configured := range []*int{stageCfg.MaxIterations, agentCfg.MaxIterations} {
+		if configured != nil && *configured < maxIter {
+			maxIter = *configured
+		}
+	}
+	agentCfg.MaxIterations = &maxIter
+	agentCfg.SubAgents = nil
+	if cfg.LLMProvider != "" {
+		agentCfg.LLMProvider = cfg.LLMProvider
+	}
+
+	stageCfg.Agents = []config.StageAgentConfig{agentCfg}
+	stageCfg.Replicas = 1
+	stageCfg.SubAgents = nil
+	stageCfg.Synthesis = nil
+
+	triage.Stages = []config.StageConfig{stageCfg}
+	return &triage
+}
+
+// markSessionDegraded tags the session as executed in degraded mode.
+// Best-effort: the session still runs if the update fails.
+func (e *RealSessionExecutor) markSessionDegraded(ctx context.Context, sessionID, reason string) {
+	if e.dbClient == nil {
+		return
+	}
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := e.dbClient.AlertSession.UpdateOneID(sessionID).
+		SetDegradedReason(reason).
+		Exec(writeCtx); err != nil {
+		slog.Warn("Failed to tag session as degraded",
+			"session_id", sessionID, "error", err)
+	}
+}
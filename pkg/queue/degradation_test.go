@@ -0,0 +1,123 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestTriageChain(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	chain := &config.ChainConfig{
+		AlertTypes:  []string{"PodCrash"},
+		LLMProvider: "gemini-pro",
+		Stages: []config.StageConfig{
+			{
+				Name: "investigation",
+				Agents: []config.StageAgentConfig{
+					{Name: "KubernetesAgent", MaxIterations: intPtr(20), SubAgents: config.SubAgentRefs{{Name: "WebResearcher"}}},
+					{Name: "LogAgent"},
+				},
+				Replicas:  2,
+				Synthesis: &config.SynthesisConfig{},
+			},
+			{Name: "remediation", Agents: []config.StageAgentConfig{{Name: "ActionAgent"}}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		stageMaxIter *int
+		cfg          *config.DegradationConfig
+		wantMaxIter  int
+		wantProvider string
+	}{
+		{
+			name:        "caps iterations and keeps provider",
+			cfg:         &config.DegradationConfig{MaxIterations: 5},
+			wantMaxIter: 5,
+		},
+		{
+			name:         "lower stage limit wins and provider overridden",
+			stageMaxIter: intPtr(3),
+			cfg:          &config.DegradationConfig{MaxIterations: 5, LLMProvider: "gemini-flash"},
+			wantMaxIter:  3,
+			wantProvider: "gemini-flash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := *chain
+			src.Stages = append([]config.StageConfig(nil), chain.Stages...)
+			src.Stages[0].MaxIterations = tt.stageMaxIter
+
+			got := triageChain(&src, tt.cfg)
+
+			require.Len(t, got.Stages, 1)
+			stage := got.Stages[0]
+			assert.Equal(t, "investigation", stage.Name)
+			assert.Equal(t, 1, stage.Replicas)
+			assert.Nil(t, stage.Synthesis)
+			require.Len(t, stage.Agents, 1)
+			assert.Equal(t, "KubernetesAgent", stage.Agents[0].Name)
+			assert.Nil(t, stage.Agents[0].SubAgents)
+			require.NotNil(t, stage.Agents[0].MaxIterations)
+			assert.Equal(t, tt.wantMaxIter, *stage.Agents[0].MaxIterations)
+			assert.Equal(t, tt.wantProvider, stage.Agents[0].LLMProvider)
+			assert.Equal(t, "gemini-pro", got.LLMProvider)
+
+			// The configured chain is untouched.
+			assert.Len(t, src.Stages, 2)
+			assert.Len(t, src.Stages[0].Agents, 2)
+			assert.Equal(t, 20, *src.Stages[0].Agents[0].MaxIterations)
+		})
+	}
+}
+
+func TestDegradationReason(t *testing.T) {
+	cfg := &config.Config{
+		Defaults:      &config.Defaults{LLMProvider: "gemini-pro"},
+		AgentRegistry: config.NewAgentRegistry(map[string]*config.AgentConfig{"KubernetesAgent": {}}),
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"gemini-pro": {Type: config.LLMProviderTypeGoogle, Model: "gemini-pro"},
+		}),
+		Degradation: &config.DegradationConfig{Enabled: true, FailureThreshold: 2, Cooldown: time.Minute, MaxIterations: 5},
+	}
+	chain := &config.ChainConfig{
+		Stages: []config.StageConfig{{Name: "investigation", Agents: []config.StageAgentConfig{{Name: "KubernetesAgent"}}}},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		e := &RealSessionExecutor{cfg: cfg}
+		assert.Empty(t, e.degradationReason(chain))
+	})
+
+	t.Run("healthy provider", func(t *testing.T) {
+		e := &RealSessionExecutor{cfg: cfg, providerHealth: newProviderHealth(cfg.Degradation)}
+		e.providerHealth.RecordFailure("gemini-pro")
+		assert.Empty(t, e.degradationReason(chain))
+	})
+
+	t.Run("failing provider", func(t *testing.T) {
+		e := &RealSessionExecutor{cfg: cfg, providerHealth: newProviderHealth(cfg.Degradation)}
+		e.providerHealth.RecordFailure("gemini-pro")
+		e.providerHealth.RecordFailure("gemini-pro")
+		assert.Equal(t, `LLM provider "gemini-pro" is failing`, e.degradationReason(chain))
+	})
+}
+
+func TestNewProviderHealth(t *testing.T) {
+	assert.Nil(t, newProviderHealth(nil))
+	assert.Nil(t, newProviderHealth(config.DefaultDegradationConfig()))
+
+	enabled := config.DefaultDegradationConfig()
+	enabled.Enabled = true
+	assert.IsType(t, &agent.ProviderHealth{}, newProviderHealth(enabled))
+}
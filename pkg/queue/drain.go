@@ -0,0 +1,137 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+)
+
+// Drain states reported by the drain API and the health endpoint.
+const (
+	DrainStateNone     = "not_draining"
+	DrainStateDraining = "draining"
+	DrainStateDrained  = "drained"
+)
+
+// drainPropagation is how long a drain set through another pod may take to
+// stop the drained pod from claiming. A drain is not reported drained before
+// it has been in force this long, so a claim racing the drain is not missed.
+const drainPropagation = 2 * pauseRefreshInterval
+
+// DrainStatus reports the progress of draining one pod.
+type DrainStatus struct {
+	PodID            string     `json:"pod_id"`
+	State            string     `json:"state"` // not_draining, draining or drained
+	InFlightSessions []string   `json:"in_flight_sessions"`
+	Reason           string     `json:"reason,omitempty"`
+	DrainedBy        string     `json:"drained_by,omitempty"`
+	DrainingSince    *time.Time `json:"draining_since,omitempty"`
+}
+
+// Drain starts draining the pod with ID podID ahead of its removal: the pod
+// stops claiming new sessions, finishes the ones in flight, and is then
+// reported drained by DrainStatus. Any pod can drain any other; the drained
+// pod picks the drain up within pauseRefreshInterval. Cancel with Resume.
+func (p *WorkerPool) Drain(ctx context.Context, podID, reason, author string) (*DrainStatus, error) {
+	if p.pauseStore == nil || p.pause == nil {
+		return nil, ErrPauseUnavailable
+	}
+	row, err := p.pauseStore.Drain(ctx, podID, reason, author)
+	if err != nil {
+		return nil, err
+	}
+
+	before := p.pause.active()
+	p.pause.upsert(pauseFromEnt(row))
+	p.applyPauses(before)
+	slog.Info("Pod drain started", "target_pod_id", podID, "reason", reason, "author", author)
+	return p.DrainStatus(ctx, podID)
+}
+
+// DrainStatus reports whether the pod with ID podID is draining and which
+// sessions it still holds. Reads the drain and the in-flight sessions from
+// the database, so it is accurate from any pod.
+func (p *WorkerPool) DrainStatus(ctx context.Context, podID string) (*DrainStatus, error) {
+	if p.pauseStore == nil {
+		return nil, ErrPauseUnavailable
+	}
+	rows, err := p.pauseStore.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var drain *QueuePause
+	for _, row := range rows {
+		if row.ID == podID && row.Drain {
+			pause := pauseFromEnt(row)
+			drain = &pause
+			break
+		}
+	}
+
+	inFlight, err := p.client.AlertSession.Query().
+		Where(
+			alertsession.StatusEQ(alertsession.StatusInProgress),
+			alertsession.PodIDEQ(podID),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query in-flight sessions: %w", err)
+	}
+	settled := drain != nil && time.Since(drain.PausedAt) >= drainPropagation
+	return buildDrainStatus(podID, drain, inFlight, settled), nil
+}
+
+// LocalDrainStatus reports the drain of this pod from the cached pauses and
+// the in-memory session registry, without querying the database. Nil when
+// the pod is not draining.
+func (p *WorkerPool) LocalDrainStatus() *DrainStatus {
+	drain := p.pause.drain()
+	if drain == nil {
+		return nil
+	}
+	// The cached drain already stops this pod's workers from claiming
+	return buildDrainStatus(p.podID, drain, p.getActiveSessionIDs(), true)
+}
+
+// buildDrainStatus derives the drain state. A drain is reported drained once
+// no sessions are in flight and it is settled: in force on the drained pod.
+func buildDrainStatus(podID string, drain *QueuePause, inFlight []string, settled bool) *DrainStatus {
+	if inFlight == nil {
+		inFlight = []string{}
+	}
+	slices.Sort(inFlight)
+	status := &DrainStatus{PodID: podID, State: DrainStateNone, InFlightSessions: inFlight}
+	if drain == nil {
+		return status
+	}
+
+	since := drain.PausedAt
+	status.Reason = drain.Reason
+	status.DrainedBy = drain.PausedBy
+	status.DrainingSince = &since
+	status.State = DrainStateDraining
+	if len(inFlight) == 0 && settled {
+		status.State = DrainStateDrained
+	}
+	return status
+}
+
+// drain returns this pod's own pause when it was set by the drain API, or nil.
+func (g *pauseGate) drain() *QueuePause {
+	if g == nil {
+		return nil
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for i := range g.pauses {
+		if g.pauses[i].Scope == g.podID && g.pauses[i].Drain {
+			p := g.pauses[i]
+			return &p
+		}
+	}
+	return nil
+}
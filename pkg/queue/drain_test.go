@@ -0,0 +1,92 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestBuildDrainStatus(t *testing.T) {
+	since := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	drain := &QueuePause{Scope: "pod-a", Drain: true, Reason: "scale down", PausedBy: "scaler", PausedAt: since}
+
+	tests := []struct {
+		name      string
+		drain     *QueuePause
+		inFlight  []string
+		settled   bool
+		wantState string
+	}{
+		{name: "not draining", inFlight: []string{"s1"}, wantState: DrainStateNone},
+		{name: "sessions in flight", drain: drain, inFlight: []string{"s1"}, settled: true, wantState: DrainStateDraining},
+		{name: "drain not yet in force on the pod", drain: drain, settled: false, wantState: DrainStateDraining},
+		{name: "drained", drain: drain, settled: true, wantState: DrainStateDrained},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := buildDrainStatus("pod-a", tt.drain, tt.inFlight, tt.settled)
+			assert.Equal(t, "pod-a", status.PodID)
+			assert.Equal(t, tt.wantState, status.State)
+			assert.NotNil(t, status.InFlightSessions)
+			if tt.drain == nil {
+				assert.Nil(t, status.DrainingSince)
+				return
+			}
+			require.NotNil(t, status.DrainingSince)
+			assert.Equal(t, since, *status.DrainingSince)
+			assert.Equal(t, "scale down", status.Reason)
+			assert.Equal(t, "scaler", status.DrainedBy)
+		})
+	}
+}
+
+func TestLocalDrainStatus(t *testing.T) {
+	store := memPauseStore{}
+	pool := NewWorkerPool("pod-a", nil, &config.QueueConfig{}, nil, nil, nil, nil)
+	pool.SetPauseStore(store, nil)
+	ctx := context.Background()
+	worker := &Worker{pause: pool.pause}
+
+	assert.Nil(t, pool.LocalDrainStatus())
+
+	t.Run("plain pause is not a drain", func(t *testing.T) {
+		_, err := pool.Pause(ctx, "pod-a", "", false, "alice")
+		require.NoError(t, err)
+		assert.Nil(t, pool.LocalDrainStatus())
+	})
+
+	t.Run("drain of another pod does not apply", func(t *testing.T) {
+		_, err := store.Drain(ctx, "pod-b", "", "scaler")
+		require.NoError(t, err)
+		require.NoError(t, pool.refreshPauses(ctx))
+		assert.Nil(t, pool.LocalDrainStatus())
+	})
+
+	t.Run("drain stops claiming and reports in-flight sessions", func(t *testing.T) {
+		_, err := store.Drain(ctx, "pod-a", "scale down", "scaler")
+		require.NoError(t, err)
+		require.NoError(t, pool.refreshPauses(ctx))
+		assert.ErrorIs(t, worker.pollAndProcess(ctx), ErrQueuePaused)
+
+		pool.RegisterSession("s1", func() {})
+		status := pool.LocalDrainStatus()
+		require.NotNil(t, status)
+		assert.Equal(t, DrainStateDraining, status.State)
+		assert.Equal(t, []string{"s1"}, status.InFlightSessions)
+
+		pool.UnregisterSession("s1")
+		assert.Equal(t, DrainStateDrained, pool.LocalDrainStatus().State)
+	})
+
+	t.Run("resume cancels the drain", func(t *testing.T) {
+		_, err := pool.Resume(ctx, "pod-a", "scaler")
+		require.NoError(t, err)
+		assert.Nil(t, pool.LocalDrainStatus())
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
@@ -18,28 +19,41 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/agent/skill"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/cost"
+	"github.com/codeready-toolchain/tarsy/pkg/deeplink"
+	"github.com/codeready-toolchain/tarsy/pkg/enrichment"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/featureflag"
+	"github.com/codeready-toolchain/tarsy/pkg/federation"
+	"github.com/codeready-toolchain/tarsy/pkg/highlight"
 	"github.com/codeready-toolchain/tarsy/pkg/mcp"
 	"github.com/codeready-toolchain/tarsy/pkg/memory"
+	"github.com/codeready-toolchain/tarsy/pkg/milestone"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/recenthistory"
 	"github.com/codeready-toolchain/tarsy/pkg/runbook"
 	"github.com/codeready-toolchain/tarsy/pkg/services"
 )
 
 // RealSessionExecutor implements SessionExecutor using the agent framework.
 type RealSessionExecutor struct {
-	cfg              *config.Config
-	dbClient         *ent.Client
-	llmClient        agent.LLMClient
-	eventPublisher   agent.EventPublisher
-	agentFactory     *agent.AgentFactory
-	promptBuilder    *prompt.PromptBuilder
-	mcpFactory       *mcp.ClientFactory
-	runbookService   *runbook.Service
-	subAgentRegistry *config.SubAgentRegistry
-	memoryService    *memory.Service
-	memoryConfig     *config.MemoryConfig
-	costBook         *cost.Book
+	cfg            *config.Config
+	dbClient       *ent.Client
+	llmClient      agent.LLMClient
+	eventPublisher agent.EventPublisher
+	agentFactory   *agent.AgentFactory
+	promptBuilder  *prompt.PromptBuilder
+	mcpFactory     *mcp.ClientFactory
+	runbookService *runbook.Service
+	memoryService  *memory.Service
+	memoryConfig   *config.MemoryConfig
+	costBook       *cost.Book
+	providerHealth *agent.ProviderHealth // nil when degradation is disabled
+	timeWarner     *timeWarner
+	recentHistory  *recenthistory.Collector
+	featureFlags   *featureflag.Manager      // nil = built-in flag defaults
+	forecaster     *services.ForecastService // nil = no start-of-session forecast
+	federation     *federation.Client        // nil = remote stages fail
+	enrichment     *enrichment.Sources       // nil = lookup tools disabled
 }
 
 // NewRealSessionExecutor creates a new session executor.
@@ -50,17 +64,19 @@ type RealSessionExecutor struct {
 func NewRealSessionExecutor(cfg *config.Config, dbClient *ent.Client, llmClient agent.LLMClient, eventPublisher agent.EventPublisher, mcpFactory *mcp.ClientFactory, runbookService *runbook.Service, memoryService *memory.Service, memoryConfig *config.MemoryConfig) *RealSessionExecutor {
 	controllerFactory := controller.NewFactory()
 	return &RealSessionExecutor{
-		cfg:              cfg,
-		dbClient:         dbClient,
-		llmClient:        llmClient,
-		eventPublisher:   eventPublisher,
-		agentFactory:     agent.NewAgentFactory(controllerFactory),
-		promptBuilder:    prompt.NewPromptBuilder(cfg.MCPServerRegistry),
-		mcpFactory:       mcpFactory,
-		runbookService:   runbookService,
-		subAgentRegistry: config.BuildSubAgentRegistry(cfg.AgentRegistry.GetAll()),
-		memoryService:    memoryService,
-		memoryConfig:     memoryConfig,
+		cfg:            cfg,
+		dbClient:       dbClient,
+		llmClient:      llmClient,
+		eventPublisher: eventPublisher,
+		agentFactory:   agent.NewAgentFactory(controllerFactory),
+		promptBuilder:  prompt.NewPromptBuilder(cfg.MCPServerRegistry),
+		mcpFactory:     mcpFactory,
+		runbookService: runbookService,
+		memoryService:  memoryService,
+		memoryConfig:   memoryConfig,
+		providerHealth: newProviderHealth(cfg.Degradation),
+		timeWarner:     &timeWarner{eventPublisher: eventPublisher},
+		recentHistory:  recenthistory.NewCollector(dbClient, cfg.RecentHistory),
 	}
 }
 
@@ -70,6 +86,60 @@ func (e *RealSessionExecutor) SetCostBook(book *cost.Book) {
 	e.costBook = book
 }
 
+// SetWarningsService sets the service that surfaces time_warnings threshold
+// crossings as system warnings. May be nil (execution.progress events only).
+func (e *RealSessionExecutor) SetWarningsService(svc *services.SystemWarningsService) {
+	e.timeWarner.warnings = svc
+}
+
+// SetFeatureFlags sets the manager consulted for gradually rolled-out
+// behaviors. May be nil (built-in flag defaults).
+func (e *RealSessionExecutor) SetFeatureFlags(flags *featureflag.Manager) {
+	e.featureFlags = flags
+}
+
+// SetForecaster sets the service whose budget forecast is published when a
+// session starts. May be nil (no forecast).
+func (e *RealSessionExecutor) SetForecaster(f *services.ForecastService) {
+	e.forecaster = f
+}
+
+// SetFederation sets the client that runs remote stages on other TARSy
+// instances. May be nil (no instances configured; remote stages fail).
+func (e *RealSessionExecutor) SetFederation(client *federation.Client) {
+	e.federation = client
+}
+
+// SetEnrichment sets the sources behind the lookup_ip and lookup_host
+// tools. May be nil (lookup tools disabled).
+func (e *RealSessionExecutor) SetEnrichment(sources *enrichment.Sources) {
+	e.enrichment = sources
+}
+
+// flagEnabled reports whether flag is rolled out to session.
+func (e *RealSessionExecutor) flagEnabled(flag string, session *ent.AlertSession) bool {
+	target := featureflag.Target{
+		Key:       session.ID,
+		AlertType: session.AlertType,
+		ChainID:   session.ChainID,
+	}
+	if session.Target != nil {
+		target.Namespace = session.Target.Namespace
+	}
+	return e.featureFlags.Enabled(flag, target)
+}
+
+// sideCaller returns the caller for single-shot LLM calls made before the
+// chain runs (noise triage, model routing). Calls are recorded on the
+// session when a database is configured.
+func (e *RealSessionExecutor) sideCaller() *controller.SideCaller {
+	var interactions *services.InteractionService
+	if e.dbClient != nil {
+		interactions = services.NewInteractionService(e.dbClient, nil, e.costBook)
+	}
+	return controller.NewSideCaller(e.llmClient, interactions)
+}
+
 // resolveRunbook resolves runbook content for a session using the RunbookService.
 // Falls back to config defaults on error or when the service is nil.
 func (e *RealSessionExecutor) resolveRunbook(ctx context.Context, session *ent.AlertSession) string {
@@ -109,6 +179,7 @@ type stageResult struct {
 	referencedStageID *string
 	status            alertsession.Status // mapped from agent status
 	finalAnalysis     string
+	severity          config.Severity // exec summary stages only; empty when not classified
 	err               error
 	agentResults      []agentResult // always populated (1 entry for single-agent, N for multi-agent)
 }
@@ -143,12 +214,18 @@ type executeStageInput struct {
 	stageIndex  int // 0-based DB stage index (includes synthesis stages)
 	prevContext string
 
+	// Operator notes already included in prevContext (skipped by the
+	// mid-stage note feed so agents see each note once).
+	deliveredNotes []string
+
 	// Total expected stages (config + synthesis + executive summary).
 	// Used for progress reporting so CurrentStageIndex never exceeds TotalStages.
 	totalExpectedStages int
 
 	// Precomputed once per session
 	runbookContent string
+	alertImages    []agent.ImagePart
+	recentHistory  *agent.RecentHistory
 
 	// Services (shared across stages)
 	stageService       *services.StageService
@@ -173,6 +250,9 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 	)
 	logger.Info("Session executor: starting execution")
 
+	// MCP servers of every stage's agents are rendered for the session's target
+	ctx = withSessionTarget(ctx, e.cfg.Targets, session, logger)
+
 	// 1. Resolve chain configuration
 	chain, err := e.cfg.GetChain(session.ChainID)
 	if err != nil {
@@ -190,29 +270,75 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 		}
 	}
 
+	// Close non-actionable alerts with the triage verdict instead of running
+	// the chain (no-op unless noise triage covers the alert type)
+	if triaged := e.triageNoise(ctx, session, logger); triaged != nil {
+		return triaged
+	}
+
+	// A duplicated session's provider override wins over model routing.
+	// Otherwise pick the provider tier by alert complexity (no-op unless
+	// model routing is enabled and rolled out to this session)
+	var routing *schema.ModelRoutingDecision
+	if session.LlmProvider != nil {
+		overridden := *chain
+		overridden.LLMProvider = *session.LlmProvider
+		chain = &overridden
+	} else if e.flagEnabled(config.FeatureFlagModelRouting, session) {
+		chain, routing = e.routeModel(ctx, session, chain)
+	}
+	if routing != nil {
+		logger.Info("Model routing decision",
+			"tier", routing.Tier, "provider", routing.Provider,
+			"score", routing.Score, "error", routing.Error)
+		e.recordModelRouting(ctx, session.ID, routing)
+	}
+
+	// Swap in the triage-only profile when the chain's preferred provider is failing
+	if reason := e.degradationReason(chain); reason != "" {
+		logger.Warn("Preferred LLM provider is failing, running triage-only profile", "reason", reason)
+		chain = triageChain(chain, e.cfg.Degradation)
+		e.markSessionDegraded(ctx, session.ID, reason)
+	}
+
 	// 2. Initialize services and resolve runbook (shared across all stages)
 	stageService := services.NewStageService(e.dbClient)
 	messageService := services.NewMessageService(e.dbClient)
 	timelineService := services.NewTimelineService(e.dbClient)
 	interactionService := services.NewInteractionService(e.dbClient, messageService, e.costBook)
 	runbookContent := e.resolveRunbook(ctx, session)
+	alertImages := loadImages(ctx, session.ID, session.AlertImages)
+	recentHistory := e.recentHistory.Collect(ctx, session, chain)
 
 	// 3. Sequential chain loop
 	// dbStageIndex tracks the actual DB stage index, which may differ from the
 	// config stage index when synthesis stages are inserted.
 	// totalExpectedStages includes config stages + synthesis + executive summary,
 	// so progress reporting never shows CurrentStageIndex > TotalStages.
+	// A stage delegated by another instance starts from the delegating
+	// session's prior stage results
 	var completedStages []stageResult
 	prevContext := ""
+	if session.FederationOrigin != nil {
+		prevContext = session.FederationOrigin.Context
+	}
 	dbStageIndex := 0
 	totalExpectedStages := countExpectedStages(chain)
 
+	// Tell clients what a session like this usually takes
+	e.publishForecast(ctx, session, chain.Stages[0].Name, totalExpectedStages)
+
 	for _, stageCfg := range chain.Stages {
 		// Check for cancellation between stages
 		if r := e.mapCancellation(ctx); r != nil {
 			return r
 		}
 
+		// Tell the stage if the alert resolved while the session was queued or running
+		stageContext := e.withAlertResolutionNote(ctx, session.ID, prevContext)
+		// ...and about any notes operators posted so far
+		stageContext, noteIDs := e.withOperatorNotes(ctx, session.ID, stageContext)
+
 		// session progress + stage.status: started are published inside executeStage()
 		// after Stage DB record is created (so stageID is always present)
 		sr := e.executeStage(ctx, executeStageInput{
@@ -220,9 +346,12 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 			chain:               chain,
 			stageConfig:         stageCfg,
 			stageIndex:          dbStageIndex,
-			prevContext:         prevContext,
+			prevContext:         stageContext,
+			deliveredNotes:      noteIDs,
 			totalExpectedStages: totalExpectedStages,
 			runbookContent:      runbookContent,
+			alertImages:         alertImages,
+			recentHistory:       recentHistory,
 			stageService:        stageService,
 			messageService:      messageService,
 			timelineService:     timelineService,
@@ -256,9 +385,12 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 				chain:               chain,
 				stageConfig:         stageCfg,
 				stageIndex:          dbStageIndex,
-				prevContext:         prevContext,
+				prevContext:         stageContext,
+				deliveredNotes:      noteIDs,
 				totalExpectedStages: totalExpectedStages,
 				runbookContent:      runbookContent,
+				alertImages:         alertImages,
+				recentHistory:       recentHistory,
 				stageService:        stageService,
 				messageService:      messageService,
 				timelineService:     timelineService,
@@ -290,18 +422,27 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 			completedStages = append(completedStages, sr)
 		}
 
+		milestone.FromContext(ctx).StageCompleted(ctx, stageCfg.Name, string(sr.stageType))
+
 		// Build context for next stage
 		prevContext = e.buildStageContext(completedStages)
 	}
 
 	// 4. Extract final analysis from completed stages
 	finalAnalysis := extractFinalAnalysis(completedStages)
+	if finalAnalysis != "" {
+		milestone.FromContext(ctx).FinalAnalysis(ctx, finalAnalysis)
+	}
 
 	// 5. Generate executive summary as a typed stage (fail-open).
-	// Only run when there is a final analysis to summarize.
+	// Only run when there is a final analysis to summarize, the chain has
+	// not disabled the step, and not for delegated stages — the delegating
+	// session summarizes the whole chain.
 	var execSummary string
 	var execSummaryErr string
-	if finalAnalysis != "" {
+	var execSummaryTemplate string
+	var severity config.Severity
+	if finalAnalysis != "" && session.FederationOrigin == nil && chain.ExecutiveSummary.IsEnabled() {
 		execSr := e.executeExecSummaryStage(ctx, executeStageInput{
 			session:             session,
 			chain:               chain,
@@ -309,6 +450,7 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 			prevContext:         finalAnalysis, // ExecSummaryController reads this as the text to summarize
 			totalExpectedStages: totalExpectedStages,
 			runbookContent:      runbookContent,
+			alertImages:         alertImages,
 			stageService:        stageService,
 			messageService:      messageService,
 			timelineService:     timelineService,
@@ -317,10 +459,40 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 		publishStageStatus(context.Background(), e.eventPublisher, session.ID, execSr.stageID, execSr.stageName, dbStageIndex, execSr.stageType, execSr.referencedStageID, mapTerminalStatus(execSr))
 		if execSr.status == alertsession.StatusCompleted {
 			execSummary = execSr.finalAnalysis
+			execSummaryTemplate = prompt.ExecutiveSummaryTemplateVersion(chain.ExecutiveSummary)
+			severity = execSr.severity
 		} else if execSr.err != nil {
 			logger.Warn("Executive summary stage failed (fail-open)", "error", execSr.err)
 			execSummaryErr = execSr.err.Error()
 		}
+		dbStageIndex++
+	}
+
+	// 6. Generate the technical summary as a typed stage (fail-open), for
+	// chains that enable it. Independent of the executive summary outcome.
+	var techSummary string
+	var techSummaryErr string
+	if finalAnalysis != "" && session.FederationOrigin == nil && chain.TechnicalSummary.IsEnabled() {
+		techSr := e.executeTechnicalSummaryStage(ctx, executeStageInput{
+			session:             session,
+			chain:               chain,
+			stageIndex:          dbStageIndex,
+			prevContext:         finalAnalysis, // TechnicalSummaryController reads this as the text to summarize
+			totalExpectedStages: totalExpectedStages,
+			runbookContent:      runbookContent,
+			alertImages:         alertImages,
+			stageService:        stageService,
+			messageService:      messageService,
+			timelineService:     timelineService,
+			interactionService:  interactionService,
+		})
+		publishStageStatus(context.Background(), e.eventPublisher, session.ID, techSr.stageID, techSr.stageName, dbStageIndex, techSr.stageType, techSr.referencedStageID, mapTerminalStatus(techSr))
+		if techSr.status == alertsession.StatusCompleted {
+			techSummary = techSr.finalAnalysis
+		} else if techSr.err != nil {
+			logger.Warn("Technical summary stage failed (fail-open)", "error", techSr.err)
+			techSummaryErr = techSr.err.Error()
+		}
 	}
 
 	if r := e.mapCancellation(ctx); r != nil {
@@ -331,6 +503,8 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 		"stages_completed", len(completedStages),
 		"has_final_analysis", finalAnalysis != "",
 		"has_executive_summary", execSummary != "",
+		"has_technical_summary", techSummary != "",
+		"severity", severity,
 	)
 
 	return &ExecutionResult{
@@ -338,6 +512,10 @@ func (e *RealSessionExecutor) Execute(ctx context.Context, session *ent.AlertSes
 		FinalAnalysis:         finalAnalysis,
 		ExecutiveSummary:      execSummary,
 		ExecutiveSummaryError: execSummaryErr,
+		ExecSummaryTemplate:   execSummaryTemplate,
+		TechnicalSummary:      techSummary,
+		TechnicalSummaryError: techSummaryErr,
+		Severity:              severity,
 	}
 }
 
@@ -355,6 +533,10 @@ func (e *RealSessionExecutor) executeStage(ctx context.Context, input executeSta
 		"stage_index", input.stageIndex,
 	)
 
+	if input.stageConfig.Remote != nil {
+		return e.executeRemoteStage(ctx, input)
+	}
+
 	if len(input.stageConfig.Agents) == 0 {
 		return stageResult{
 			stageName: input.stageConfig.Name,
@@ -404,6 +586,14 @@ func (e *RealSessionExecutor) executeStage(ctx context.Context, input executeSta
 		input.stageIndex, input.totalExpectedStages, len(configs),
 		fmt.Sprintf("Starting stage: %s", input.stageConfig.Name))
 
+	thresholds := config.ResolveTimeWarnings(e.cfg.Defaults, input.chain)
+	stopTimeWarning := e.timeWarner.watch(timeWarningTarget{
+		sessionID: input.session.ID,
+		stageID:   stg.ID,
+		label:     fmt.Sprintf("Stage %q", input.stageConfig.Name),
+	}, thresholds.Stage)
+	defer stopTimeWarning()
+
 	// 5. Launch goroutines (one per execution config — even if just one)
 	results := make(chan indexedAgentResult, len(configs))
 	var wg sync.WaitGroup
@@ -474,16 +664,26 @@ func (e *RealSessionExecutor) executeStage(ctx context.Context, input executeSta
 // final_analysis and llm_response timeline events for the given execution.
 // Best-effort: logs warnings on failure but never blocks the pipeline.
 func stripActionMarkerFromTimeline(timelineService *services.TimelineService, executionID string, logger *slog.Logger) {
+	stripMarkerFromTimeline(timelineService, executionID, logger, func(content string) (string, error) {
+		_, cleaned, err := controller.ExtractActionsTaken(content)
+		return cleaned, err
+	})
+}
+
+// stripMarkerFromTimeline rewrites final_analysis and llm_response timeline
+// events for the given execution using extract, skipping events where no
+// marker is found. Best-effort: logs warnings on failure but never blocks the pipeline.
+func stripMarkerFromTimeline(timelineService *services.TimelineService, executionID string, logger *slog.Logger, extract func(string) (string, error)) {
 	events, err := timelineService.GetAgentTimeline(context.Background(), executionID)
 	if err != nil {
-		logger.Warn("Failed to get timeline for action marker cleanup", "execution_id", executionID, "error", err)
+		logger.Warn("Failed to get timeline for marker cleanup", "execution_id", executionID, "error", err)
 		return
 	}
 	for _, evt := range events {
 		if evt.EventType != timelineevent.EventTypeFinalAnalysis && evt.EventType != timelineevent.EventTypeLlmResponse {
 			continue
 		}
-		_, evtCleaned, extractErr := controller.ExtractActionsTaken(evt.Content)
+		evtCleaned, extractErr := extract(evt.Content)
 		if extractErr != nil {
 			continue
 		}
@@ -491,7 +691,7 @@ func stripActionMarkerFromTimeline(timelineService *services.TimelineService, ex
 			continue
 		}
 		if updateErr := timelineService.UpdateTimelineEvent(context.Background(), evt.ID, evtCleaned); updateErr != nil {
-			logger.Warn("Failed to strip action marker from timeline event",
+			logger.Warn("Failed to strip marker from timeline event",
 				"event_id", evt.ID, "event_type", evt.EventType, "error", updateErr)
 		}
 	}
@@ -610,6 +810,12 @@ func (e *RealSessionExecutor) executeAgent(
 		logger.Warn("Failed to update agent execution to active", "error", updateErr)
 	}
 	publishExecutionStatus(ctx, e.eventPublisher, input.session.ID, stg.ID, exec.ID, agentIndex+1, string(agentexecution.StatusActive), "")
+	defer e.timeWarner.watch(timeWarningTarget{
+		sessionID:   input.session.ID,
+		stageID:     stg.ID,
+		executionID: exec.ID,
+		label:       fmt.Sprintf("Agent %q in stage %q", displayName, input.stageConfig.Name),
+	}, config.ResolveTimeWarnings(e.cfg.Defaults, input.chain).Agent)()
 
 	// Metadata carried on all agentResult returns below (for synthesis context).
 	resolvedBackend := string(resolvedConfig.LLMBackend)
@@ -634,9 +840,19 @@ func (e *RealSessionExecutor) executeAgent(
 		}
 	}
 
+	// Models without function calling run without tools
+	if resolvedConfig.ToolsDisabled {
+		serverIDs, toolFilter = nil, nil
+	}
+
+	// Leave out MCP servers operators disabled for this session
+	serverIDs, disabledServers := withoutDisabledServers(ctx, e.dbClient, input.session.ID, serverIDs)
+
 	// Create MCP tool executor
 	toolExecutor, failedServers := createToolExecutor(ctx, e.mcpFactory, serverIDs, toolFilter, logger)
 	defer func() { _ = toolExecutor.Close() }()
+	failedServers = mergeFailedServers(failedServers, disabledServers)
+	toolExecutor, disabledSource := gateDisabledServers(toolExecutor, e.dbClient, input.session.ID, serverIDs)
 
 	// Retrieve memories for auto-injection into system prompt (only for agent types
 	// whose prompts consume MemoryBriefing — investigation, action, orchestrator).
@@ -654,21 +870,24 @@ func (e *RealSessionExecutor) executeAgent(
 
 	// Build execution context
 	execCtx := &agent.ExecutionContext{
-		SessionID:      input.session.ID,
-		StageID:        stg.ID,
-		ExecutionID:    exec.ID,
-		AgentName:      displayName,
-		AgentIndex:     agentIndex + 1, // 1-based
-		AlertData:      input.session.AlertData,
-		AlertType:      input.session.AlertType,
-		StageType:      string(stg.StageType),
-		RunbookContent: input.runbookContent,
-		Config:         resolvedConfig,
-		LLMClient:      e.llmClient,
-		EventPublisher: e.eventPublisher,
-		PromptBuilder:  e.promptBuilder,
-		FailedServers:  failedServers,
-		MemoryBriefing: memoryBriefing,
+		SessionID:       input.session.ID,
+		StageID:         stg.ID,
+		ExecutionID:     exec.ID,
+		AgentName:       displayName,
+		AgentIndex:      agentIndex + 1, // 1-based
+		AlertData:       input.session.AlertData,
+		AlertImages:     input.alertImages,
+		AlertType:       input.session.AlertType,
+		StageType:       string(stg.StageType),
+		RunbookContent:  input.runbookContent,
+		Config:          resolvedConfig,
+		LLMClient:       e.llmClient,
+		EventPublisher:  e.eventPublisher,
+		PromptBuilder:   e.promptBuilder,
+		FailedServers:   failedServers,
+		MemoryBriefing:  memoryBriefing,
+		OperatorNotes:   newOperatorNoteFeed(e.dbClient, input.session.ID, input.deliveredNotes),
+		DisabledServers: disabledSource,
 		Services: &agent.ServiceBundle{
 			Timeline:    input.timelineService,
 			Message:     input.messageService,
@@ -676,10 +895,17 @@ func (e *RealSessionExecutor) executeAgent(
 			Stage:       input.stageService,
 		},
 	}
+	execCtx.AlertInstructions = alertInstructionsFor(input.session, input.stageConfig.Name, agentConfig.Name)
+	execCtx.OnCall = input.session.OnCall
+	execCtx.RecentHistory = input.recentHistory
+	execCtx.OutputLanguage = outputLanguageFor(e.cfg.Defaults, input.chain, input.session)
+	execCtx.ExecutiveSummary = input.chain.ExecutiveSummary
+	execCtx.ProviderHealth = e.providerHealth
 
 	subAgentRefs := resolveSubAgents(input.chain, input.stageConfig, agentConfig)
-	if len(subAgentRefs) > 0 {
-		registry := e.subAgentRegistry.Filter(subAgentRefs.Names())
+	if len(subAgentRefs) > 0 && !resolvedConfig.ToolsDisabled {
+		// Built per execution so agents registered at runtime are dispatchable
+		registry := config.BuildSubAgentRegistry(e.cfg.AgentRegistry.GetAll()).Filter(subAgentRefs.Names())
 		if len(registry.Entries()) > 0 {
 			agentDef, getErr := e.cfg.GetAgent(agentConfig.Name)
 			if getErr != nil {
@@ -723,6 +949,7 @@ func (e *RealSessionExecutor) executeAgent(
 			runner := orchestrator.NewSubAgentRunner(ctx, deps, exec.ID, input.session.ID, stg.ID, registry, guardrails, subAgentRefs)
 			toolExecutor = orchestrator.NewCompositeToolExecutor(toolExecutor, runner, registry)
 			execCtx.SubAgentCollector = orchestrator.NewResultCollector(runner)
+			execCtx.OrchestratorBudget = orchestrator.NewBudgetTracker(runner)
 			execCtx.SubAgentCatalog = applyCatalogOverrides(registry.Entries(), subAgentRefs)
 		}
 	}
@@ -733,13 +960,34 @@ func (e *RealSessionExecutor) executeAgent(
 	}
 
 	// Wrap with memory tool executor (outermost layer — same agent-type guard)
-	if e.memoryService != nil && e.memoryConfig != nil && agentTypeSupportsMemory(resolvedConfig.Type) {
+	if e.memoryService != nil && e.memoryConfig != nil && agentTypeSupportsMemory(resolvedConfig.Type) && !resolvedConfig.ToolsDisabled {
 		excludeIDs := memoryExcludeIDs(memoryBriefing)
 		toolExecutor = memory.NewToolExecutor(
 			toolExecutor, e.memoryService, input.session.ID, "default", excludeIDs,
 		)
 	}
 
+	// Let tool-using agents flag key findings on the timeline
+	if e.cfg.Defaults != nil && e.cfg.Defaults.KeyFindingsTool && len(serverIDs) > 0 {
+		toolExecutor = highlight.NewToolExecutor(toolExecutor)
+	}
+
+	// Let tool-using agents link dashboards and log queries
+	if e.cfg.DeepLinks.Enabled() && len(serverIDs) > 0 {
+		toolExecutor = deeplink.NewToolExecutor(toolExecutor, e.cfg.DeepLinks, input.session.CreatedAt)
+		execCtx.LinkTemplates = deeplink.Catalog(e.cfg.DeepLinks)
+	}
+
+	// Let tool-using agents look up IP owners and inventory hosts
+	if e.enrichment != nil && len(serverIDs) > 0 {
+		toolExecutor = enrichment.NewToolExecutor(toolExecutor, e.enrichment)
+	}
+
+	// Report the session's first tool call (outermost — sees every call)
+	if tracker := milestone.FromContext(ctx); tracker != nil {
+		toolExecutor = milestone.NewToolExecutor(toolExecutor, tracker, displayName)
+	}
+
 	execCtx.ToolExecutor = toolExecutor
 
 	agentInstance, err := e.agentFactory.CreateAgent(execCtx)
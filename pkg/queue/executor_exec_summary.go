@@ -7,6 +7,7 @@ import (
 
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
@@ -64,12 +65,33 @@ func (e *RealSessionExecutor) executeExecSummaryStage(ctx context.Context, input
 		logger.Error("Failed to update exec summary stage status", "error", updateErr)
 	}
 
+	// Parse the SEVERITY marker and strip it from the summary and its timeline
+	// events. Fail-open: an unclassified summary is kept verbatim and the
+	// session simply has no severity (default notification routing).
+	status := mapAgentStatusToSessionStatus(ar.status)
+	summary := ar.finalAnalysis
+	var severity config.Severity
+	if status == alertsession.StatusCompleted && summary != "" {
+		sev, cleaned, parseErr := controller.ExtractSeverity(summary)
+		if parseErr != nil {
+			logger.Warn("Failed to parse SEVERITY marker from executive summary", "error", parseErr)
+		} else {
+			severity = sev
+			summary = cleaned
+			stripMarkerFromTimeline(input.timelineService, ar.executionID, logger, func(content string) (string, error) {
+				_, evtCleaned, err := controller.ExtractSeverity(content)
+				return evtCleaned, err
+			})
+		}
+	}
+
 	return stageResult{
 		stageID:       stg.ID,
 		stageName:     "Executive Summary",
 		stageType:     stg.StageType,
-		status:        mapAgentStatusToSessionStatus(ar.status),
-		finalAnalysis: ar.finalAnalysis,
+		status:        status,
+		finalAnalysis: summary,
+		severity:      severity,
 		err:           ar.err,
 		agentResults:  []agentResult{ar},
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
 	agentctx "github.com/codeready-toolchain/tarsy/pkg/agent/context"
@@ -19,6 +21,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/events"
 	"github.com/codeready-toolchain/tarsy/pkg/mcp"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
 )
 
 // ────────────────────────────────────────────────────────────
@@ -49,6 +52,24 @@ func createToolExecutor(
 	return agent.NewStubToolExecutor(nil), nil
 }
 
+// withSessionTarget returns ctx carrying the session's target for the MCP
+// clients created from it. Package-level function shared by
+// RealSessionExecutor and ChatMessageExecutor. A recorded cluster that is no
+// longer configured keeps its recorded values, without params.
+func withSessionTarget(ctx context.Context, targets *config.TargetsConfig, session *ent.AlertSession, logger *slog.Logger) context.Context {
+	if session.Target == nil {
+		return ctx
+	}
+	t := session.Target
+	target, err := targets.Resolve(t.Cluster, t.Region, t.Namespace)
+	if err != nil {
+		logger.Warn("Session target no longer matches system.targets, using recorded values",
+			"cluster", t.Cluster, "error", err)
+		target = &config.Target{Cluster: t.Cluster, Region: t.Region, Namespace: t.Namespace}
+	}
+	return mcp.WithTarget(ctx, target)
+}
+
 // ────────────────────────────────────────────────────────────
 // Cancellation / context helpers
 // ────────────────────────────────────────────────────────────
@@ -112,6 +133,38 @@ func (e *RealSessionExecutor) buildStageContext(stages []stageResult) string {
 	return agentctx.BuildStageContext(results)
 }
 
+// withAlertResolutionNote appends an alert-resolution note to stageContext when
+// the alert source has reported the session's alert resolved. Re-read at every
+// stage boundary so running sessions pick up resolutions posted mid-chain.
+// Fail-open: lookup errors leave the context unchanged.
+func (e *RealSessionExecutor) withAlertResolutionNote(ctx context.Context, sessionID, stageContext string) string {
+	if e.dbClient == nil {
+		return stageContext
+	}
+	sess, err := e.dbClient.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID)).
+		Select(alertsession.FieldAlertResolvedAt, alertsession.FieldAlertResolution).
+		Only(ctx)
+	if err != nil {
+		slog.Warn("Failed to check alert resolution, continuing without it",
+			"session_id", sessionID, "error", err)
+		return stageContext
+	}
+	if sess.AlertResolvedAt == nil {
+		return stageContext
+	}
+
+	reason := ""
+	if sess.AlertResolution != nil {
+		reason = *sess.AlertResolution
+	}
+	note := agentctx.FormatAlertResolutionNote(*sess.AlertResolvedAt, reason)
+	if stageContext == "" {
+		return note
+	}
+	return stageContext + "\n\n" + note
+}
+
 // extractFinalAnalysis returns the final analysis from the last completed stage.
 // Only considers investigation, synthesis, and action stages; exec_summary and
 // scoring stages are excluded as a safety guard.
@@ -184,6 +237,44 @@ func publishSessionProgress(ctx context.Context, eventPublisher agent.EventPubli
 	}
 }
 
+// publishForecast publishes a session.progress event carrying the budget
+// forecast of the session's chain. Best-effort: forecast errors are logged,
+// never abort the session.
+func (e *RealSessionExecutor) publishForecast(ctx context.Context, session *ent.AlertSession, firstStage string, totalStages int) {
+	if e.forecaster == nil || e.eventPublisher == nil {
+		return
+	}
+	f, err := e.forecaster.Forecast(ctx, session.AlertType, session.ChainID)
+	if err != nil {
+		slog.Warn("Failed to forecast session budget", "session_id", session.ID, "error", err)
+		return
+	}
+	if f.SampleSize == 0 {
+		return
+	}
+	summary := &events.ForecastSummary{
+		Basis:                    f.Basis,
+		SampleSize:               f.SampleSize,
+		EstimatedTokens:          f.EstimatedTokens,
+		EstimatedCostUsd:         f.EstimatedCostUsd,
+		EstimatedDurationSeconds: f.EstimatedDurationSeconds,
+	}
+	if err := e.eventPublisher.PublishSessionProgress(ctx, events.SessionProgressPayload{
+		BasePayload: events.BasePayload{
+			Type:      events.EventTypeSessionProgress,
+			SessionID: session.ID,
+			Timestamp: time.Now().Format(time.RFC3339Nano),
+		},
+		CurrentStageName:  firstStage,
+		CurrentStageIndex: 1,
+		TotalStages:       totalStages,
+		StatusText:        "Starting",
+		Forecast:          summary,
+	}); err != nil {
+		slog.Warn("Failed to publish session forecast", "session_id", session.ID, "error", err)
+	}
+}
+
 // publishExecutionProgress publishes an execution.progress transient event.
 // Nil-safe for EventPublisher. Best-effort: logs on failure, never aborts.
 func publishExecutionProgressFromExecutor(ctx context.Context, eventPublisher agent.EventPublisher, sessionID, stageID, executionID, phase, message string) {
@@ -269,6 +360,70 @@ func publishStageStatus(ctx context.Context, eventPublisher agent.EventPublisher
 	}
 }
 
+// alertInstructionsFor returns the instructions submitted with the alert that
+// apply to agentName in stageName. Fail-open: an unparseable value is logged
+// and ignored (it was validated at submission).
+func alertInstructionsFor(session *ent.AlertSession, stageName, agentName string) string {
+	instr, err := models.ParseAlertInstructions(session.AlertInstructions)
+	if err != nil {
+		slog.Warn("Failed to parse alert instructions, continuing without them",
+			"session_id", session.ID, "error", err)
+		return ""
+	}
+	return instr.For(stageName, agentName)
+}
+
+// chatGuardrailsFor returns the guardrails for a chat on the session: the
+// chain's chat.guardrails, then the chat instruction submitted with the
+// alert (when not already among them).
+func chatGuardrailsFor(chain *config.ChainConfig, session *ent.AlertSession) []string {
+	var rules []string
+	if chain.Chat != nil {
+		for _, rule := range chain.Chat.Guardrails {
+			rules = append(rules, strings.TrimSpace(rule))
+		}
+	}
+	instr, err := models.ParseAlertInstructions(session.AlertInstructions)
+	if err != nil {
+		slog.Warn("Failed to parse alert instructions, chatting without session guardrails",
+			"session_id", session.ID, "error", err)
+		return rules
+	}
+	if instr != nil {
+		if rule := strings.TrimSpace(instr.Chat); rule != "" && !slices.Contains(rules, rule) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// loadImages fetches image attachments from the blob store. Fail-open: an
+// image that cannot be loaded is logged and left out, so a blob store outage
+// degrades the prompt instead of failing the session.
+func loadImages(ctx context.Context, sessionID string, refs []schema.ImageRef) []agent.ImagePart {
+	var images []agent.ImagePart
+	for _, ref := range refs {
+		data, err := services.LoadImage(ctx, ref)
+		if err != nil {
+			slog.Warn("Failed to load image attachment, continuing without it",
+				"session_id", sessionID, "image_id", ref.ID, "error", err)
+			continue
+		}
+		images = append(images, agent.ImagePart{Ref: ref, Data: data})
+	}
+	return images
+}
+
+// outputLanguageFor resolves the language for user-facing output of session
+// (defaults → chain → alert submission).
+func outputLanguageFor(defaults *config.Defaults, chain *config.ChainConfig, session *ent.AlertSession) string {
+	var alertLanguage string
+	if session.OutputLanguage != nil {
+		alertLanguage = *session.OutputLanguage
+	}
+	return agent.ResolveOutputLanguage(defaults, chain, alertLanguage)
+}
+
 // ────────────────────────────────────────────────────────────
 // MCP selection resolution
 // ────────────────────────────────────────────────────────────
@@ -369,9 +524,10 @@ func applyNativeToolsOverride(resolvedConfig *agent.ResolvedAgentConfig, nt *mod
 }
 
 // countExpectedStages computes the total number of progress steps for the chain,
-// including synthesis stages (for multi-agent/replica stages) and the executive
-// summary step. Used for accurate progress reporting so CurrentStageIndex never
-// exceeds TotalStages.
+// including synthesis stages (for multi-agent/replica stages), the executive
+// summary step (unless the chain disables it), and the technical summary step
+// (if the chain enables it). Used for accurate progress
+// reporting so CurrentStageIndex never exceeds TotalStages.
 func countExpectedStages(chain *config.ChainConfig) int {
 	total := len(chain.Stages)
 	for _, stageCfg := range chain.Stages {
@@ -379,7 +535,12 @@ func countExpectedStages(chain *config.ChainConfig) int {
 			total++ // synthesis stage will follow
 		}
 	}
-	total++ // executive summary step
+	if chain.ExecutiveSummary.IsEnabled() {
+		total++ // executive summary step
+	}
+	if chain.TechnicalSummary.IsEnabled() {
+		total++ // technical summary step
+	}
 	return total
 }
 
@@ -487,6 +648,9 @@ func applyOrchestratorConfig(g *orchestrator.OrchestratorGuardrails, oc *config.
 	if oc.MaxBudget != nil {
 		g.MaxBudget = *oc.MaxBudget
 	}
+	if oc.MaxTokens != nil {
+		g.MaxTokens = *oc.MaxTokens
+	}
 }
 
 // applyCatalogOverrides merges per-ref MCPServers overrides into catalog entries.
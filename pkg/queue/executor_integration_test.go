@@ -143,6 +143,18 @@ func (p *testEventPublisher) PublishSessionScoreUpdated(_ context.Context, _ str
 	return nil
 }
 
+func (p *testEventPublisher) PublishMCPServerLog(_ context.Context, _ string, _ events.MCPServerLogPayload) error {
+	return nil
+}
+
+func (p *testEventPublisher) PublishSubAgentDispatched(_ context.Context, _ string, _ events.SubAgentDispatchedPayload) error {
+	return nil
+}
+
+func (p *testEventPublisher) PublishSubAgentStatus(_ context.Context, _ string, _ events.SubAgentStatusPayload) error {
+	return nil
+}
+
 // hasStageStatus checks if a stage with the given name has the given status (thread-safe).
 func (p *testEventPublisher) hasStageStatus(stageName, status string) bool {
 	p.mu.Lock()
@@ -2089,6 +2101,29 @@ func TestCountExpectedStages(t *testing.T) {
 		// 0 config stages + 0 synthesis + 1 executive summary = 1
 		assert.Equal(t, 1, countExpectedStages(chain))
 	})
+
+	t.Run("executive summary disabled", func(t *testing.T) {
+		disabled := false
+		chain := &config.ChainConfig{
+			Stages: []config.StageConfig{
+				{Agents: []config.StageAgentConfig{{Name: "A"}}},
+			},
+			ExecutiveSummary: &config.ExecutiveSummaryConfig{Enabled: &disabled},
+		}
+		// 1 config stage + 0 synthesis + no executive summary = 1
+		assert.Equal(t, 1, countExpectedStages(chain))
+	})
+
+	t.Run("technical summary enabled", func(t *testing.T) {
+		chain := &config.ChainConfig{
+			Stages: []config.StageConfig{
+				{Agents: []config.StageAgentConfig{{Name: "A"}}},
+			},
+			TechnicalSummary: &config.TechnicalSummaryConfig{Enabled: true},
+		}
+		// 1 config stage + 0 synthesis + 1 executive summary + 1 technical summary = 3
+		assert.Equal(t, 3, countExpectedStages(chain))
+	})
 }
 
 // ────────────────────────────────────────────────────────────
@@ -0,0 +1,307 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/ent/stage"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/federation"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// remoteLLMBackend is recorded as the LLM backend of remote stage executions:
+// the LLM calls happen on the remote instance.
+const remoteLLMBackend = "remote"
+
+// remoteCancelTimeout bounds the best-effort cancellation of a remote session
+// after the local stage was cancelled or timed out.
+const remoteCancelTimeout = 10 * time.Second
+
+// Timeline metadata keys referencing the remote session of a delegated stage.
+const (
+	MetadataKeyFederationInstance = "federation_instance"
+	MetadataKeyRemoteSessionID    = "remote_session_id"
+	MetadataKeyRemoteSessionURL   = "remote_session_url"
+)
+
+// executeRemoteStage delegates a stage to another TARSy instance
+// (stage.remote). The stage gets one execution, named after the instance,
+// whose timeline records the remote final analysis (or failure) together
+// with a reference to the remote session holding the full trace.
+func (e *RealSessionExecutor) executeRemoteStage(ctx context.Context, input executeStageInput) stageResult {
+	remote := input.stageConfig.Remote
+	logger := slog.With(
+		"session_id", input.session.ID,
+		"stage_name", input.stageConfig.Name,
+		"stage_index", input.stageIndex,
+		"federation_instance", remote.Instance,
+	)
+
+	stg, err := input.stageService.CreateStage(ctx, models.CreateStageRequest{
+		SessionID:          input.session.ID,
+		StageName:          input.stageConfig.Name,
+		StageIndex:         input.stageIndex + 1, // 1-based in DB
+		ExpectedAgentCount: 1,
+		StageType:          string(stage.StageTypeInvestigation),
+	})
+	if err != nil {
+		if r := e.mapCancellation(ctx); r != nil {
+			return stageResult{stageName: input.stageConfig.Name, stageType: stage.StageTypeInvestigation, status: r.Status, err: r.Error}
+		}
+		logger.Error("Failed to create stage", "error", err)
+		return stageResult{
+			stageName: input.stageConfig.Name,
+			stageType: stage.StageTypeInvestigation,
+			status:    alertsession.StatusFailed,
+			err:       fmt.Errorf("failed to create stage: %w", err),
+		}
+	}
+
+	e.updateSessionProgress(ctx, input.session.ID, input.stageIndex, stg.ID)
+	publishStageStatus(ctx, e.eventPublisher, input.session.ID, stg.ID, input.stageConfig.Name, input.stageIndex, stg.StageType, nil, events.StageStatusStarted)
+	publishSessionProgress(ctx, e.eventPublisher, input.session.ID, input.stageConfig.Name,
+		input.stageIndex, input.totalExpectedStages, 1,
+		fmt.Sprintf("Starting stage: %s (on %s)", input.stageConfig.Name, remote.Instance))
+
+	ar := e.runRemoteStage(ctx, input, stg, logger)
+
+	// Use background context — ctx may be cancelled
+	if updateErr := input.stageService.UpdateStageStatus(context.Background(), stg.ID); updateErr != nil {
+		logger.Error("Failed to update stage status", "error", updateErr)
+	}
+
+	status := mapAgentStatusToSessionStatus(ar.status)
+	return stageResult{
+		stageID:       stg.ID,
+		stageName:     input.stageConfig.Name,
+		stageType:     stg.StageType,
+		status:        status,
+		finalAnalysis: ar.finalAnalysis,
+		err:           aggregateError([]agentResult{ar}, status, input.stageConfig),
+		agentResults:  []agentResult{ar},
+	}
+}
+
+// runRemoteStage creates the stage's execution, submits the stage to the
+// remote instance and waits for the remote session to finish.
+func (e *RealSessionExecutor) runRemoteStage(ctx context.Context, input executeStageInput, stg *ent.Stage, logger *slog.Logger) agentResult {
+	remote := input.stageConfig.Remote
+	exec, err := input.stageService.CreateAgentExecution(ctx, models.CreateAgentExecutionRequest{
+		StageID:    stg.ID,
+		SessionID:  input.session.ID,
+		AgentName:  "remote:" + remote.Instance,
+		AgentIndex: 1,
+		LLMBackend: remoteLLMBackend,
+	})
+	if err != nil {
+		logger.Error("Failed to create remote stage execution", "error", err)
+		if stageErr := input.stageService.ForceStageFailure(context.Background(), stg.ID, err.Error()); stageErr != nil {
+			logger.Error("Failed to force stage to failed state", "error", stageErr)
+		}
+		return agentResult{
+			status:     agent.ExecutionStatusFailed,
+			err:        fmt.Errorf("failed to create agent execution: %w", err),
+			llmBackend: remoteLLMBackend,
+		}
+	}
+
+	if updateErr := input.stageService.UpdateAgentExecutionStatus(ctx, exec.ID, agentexecution.StatusActive, ""); updateErr != nil {
+		logger.Warn("Failed to update agent execution to active", "error", updateErr)
+	}
+	publishExecutionStatus(ctx, e.eventPublisher, input.session.ID, stg.ID, exec.ID, 1, string(agentexecution.StatusActive), "")
+	defer e.timeWarner.watch(timeWarningTarget{
+		sessionID: input.session.ID,
+		stageID:   stg.ID,
+		label:     fmt.Sprintf("Stage %q", input.stageConfig.Name),
+	}, config.ResolveTimeWarnings(e.cfg.Defaults, input.chain).Stage)()
+
+	ar := e.delegateStage(ctx, input, stg.ID, exec.ID, logger)
+	ar.executionID = exec.ID
+	ar.llmBackend = remoteLLMBackend
+
+	content, eventType := ar.finalAnalysis, timelineevent.EventTypeFinalAnalysis
+	if ar.status != agent.ExecutionStatusCompleted {
+		content, eventType = ar.err.Error(), timelineevent.EventTypeError
+	}
+	e.recordRemoteTimelineEvent(input, stg.ID, exec.ID, eventType, content, ar.delegation, logger)
+
+	errMsg := ""
+	if ar.err != nil {
+		errMsg = ar.err.Error()
+	}
+	entStatus := mapAgentStatusToEntStatus(ar.status)
+	if updateErr := input.stageService.UpdateAgentExecutionStatus(context.Background(), exec.ID, entStatus, errMsg); updateErr != nil {
+		logger.Error("Failed to update remote stage execution status", "error", updateErr)
+	}
+	publishExecutionStatus(context.Background(), e.eventPublisher, input.session.ID, stg.ID, exec.ID, 1, string(entStatus), errMsg)
+	return ar.agentResult
+}
+
+// remoteAgentResult is an agentResult plus the remote session it came from
+// (nil when the stage couldn't be submitted).
+type remoteAgentResult struct {
+	agentResult
+	delegation *federation.Delegation
+}
+
+// delegateStage submits the stage and maps the remote session's terminal
+// state onto an agent status. A locally cancelled or timed-out stage cancels
+// the remote session too.
+func (e *RealSessionExecutor) delegateStage(ctx context.Context, input executeStageInput, stageID, executionID string, logger *slog.Logger) remoteAgentResult {
+	remote := input.stageConfig.Remote
+	if e.federation == nil {
+		return remoteAgentResult{agentResult: agentResult{
+			status: agent.ExecutionStatusFailed,
+			err:    fmt.Errorf("stage %q is remote but no federation instances are configured", input.stageConfig.Name),
+		}}
+	}
+
+	alertType := remote.AlertType
+	if alertType == "" {
+		alertType = input.session.AlertType
+	}
+	runbookURL := ""
+	if input.session.RunbookURL != nil {
+		runbookURL = *input.session.RunbookURL
+	}
+	d, err := e.federation.Submit(ctx, remote.Instance, federation.StageRequest{
+		AlertType:  alertType,
+		AlertData:  input.session.AlertData,
+		RunbookURL: runbookURL,
+		Origin: schema.FederationOrigin{
+			SessionID: input.session.ID,
+			StageName: input.stageConfig.Name,
+			Context:   input.prevContext,
+		},
+	})
+	if err != nil {
+		if r := e.mapCancellation(ctx); r != nil {
+			return remoteAgentResult{agentResult: agentResult{status: agentStatusFor(r.Status), err: r.Error}}
+		}
+		logger.Error("Failed to delegate stage", "error", err)
+		return remoteAgentResult{agentResult: agentResult{status: agent.ExecutionStatusFailed, err: err}}
+	}
+	logger.Info("Stage delegated to remote instance", "remote_session_id", d.SessionID)
+	publishExecutionProgressFromExecutor(ctx, e.eventPublisher, input.session.ID, stageID, executionID,
+		events.ProgressPhaseWaitingRemote, fmt.Sprintf("Waiting for %s (remote session %s)", remote.Instance, d.SessionID))
+
+	status, err := e.federation.Wait(ctx, d)
+	if err != nil {
+		result := remoteAgentResult{delegation: d, agentResult: agentResult{status: agent.ExecutionStatusFailed, err: err}}
+		reason := ""
+		switch {
+		case errors.Is(err, federation.ErrTimedOut):
+			result.status = agent.ExecutionStatusTimedOut
+			reason = "delegating stage timed out"
+		case ctx.Err() != nil:
+			if r := e.mapCancellation(ctx); r != nil {
+				result.status, result.err = agentStatusFor(r.Status), r.Error
+			}
+			reason = "delegating session was cancelled"
+		}
+		if reason != "" {
+			cancelCtx, cancel := context.WithTimeout(context.Background(), remoteCancelTimeout)
+			defer cancel()
+			if cancelErr := e.federation.Cancel(cancelCtx, d, reason); cancelErr != nil {
+				logger.Warn("Failed to cancel remote session", "remote_session_id", d.SessionID, "error", cancelErr)
+			}
+		}
+		return result
+	}
+
+	result := remoteAgentResult{delegation: d}
+	switch alertsession.Status(status.Status) {
+	case alertsession.StatusCompleted:
+		if status.FinalAnalysis == nil || *status.FinalAnalysis == "" {
+			result.status = agent.ExecutionStatusFailed
+			result.err = fmt.Errorf("remote session %s on %s completed without a final analysis", d.SessionID, remote.Instance)
+			return result
+		}
+		result.status = agent.ExecutionStatusCompleted
+		result.finalAnalysis = *status.FinalAnalysis
+		return result
+	case alertsession.StatusTimedOut:
+		result.status = agent.ExecutionStatusTimedOut
+	case alertsession.StatusCancelled, alertsession.StatusAutoCancelled:
+		result.status = agent.ExecutionStatusCancelled
+	default:
+		result.status = agent.ExecutionStatusFailed
+	}
+	detail := ""
+	if status.ErrorMessage != nil && *status.ErrorMessage != "" {
+		detail = ": " + *status.ErrorMessage
+	}
+	result.err = fmt.Errorf("remote session %s on %s %s%s", d.SessionID, remote.Instance, status.Status, detail)
+	return result
+}
+
+// recordRemoteTimelineEvent stores the outcome of a delegated stage on the
+// execution's timeline, referencing the remote session. Best-effort.
+func (e *RealSessionExecutor) recordRemoteTimelineEvent(input executeStageInput, stageID, executionID string, eventType timelineevent.EventType, content string, d *federation.Delegation, logger *slog.Logger) {
+	metadata := map[string]any{MetadataKeyFederationInstance: input.stageConfig.Remote.Instance}
+	if d != nil {
+		metadata[MetadataKeyRemoteSessionID] = d.SessionID
+		metadata[MetadataKeyRemoteSessionURL] = d.SessionURL
+	}
+
+	// Use background context — the stage outcome is recorded even when ctx is cancelled
+	ctx := context.Background()
+	event, err := input.timelineService.CreateTimelineEvent(ctx, models.CreateTimelineEventRequest{
+		SessionID:      input.session.ID,
+		StageID:        &stageID,
+		ExecutionID:    &executionID,
+		SequenceNumber: 1,
+		EventType:      eventType,
+		Status:         timelineevent.StatusCompleted,
+		Content:        content,
+		Metadata:       metadata,
+	})
+	if err != nil {
+		logger.Warn("Failed to create remote stage timeline event", "error", err)
+		return
+	}
+	if e.eventPublisher == nil {
+		return
+	}
+	highlight := ""
+	if event.Highlight != nil {
+		highlight = string(*event.Highlight)
+	}
+	if pubErr := e.eventPublisher.PublishTimelineCreated(ctx, input.session.ID, events.TimelineCreatedPayload{
+		BasePayload: events.BasePayload{
+			Type:      events.EventTypeTimelineCreated,
+			SessionID: input.session.ID,
+			Timestamp: event.CreatedAt.Format(time.RFC3339Nano),
+		},
+		EventID:        event.ID,
+		StageID:        stageID,
+		ExecutionID:    executionID,
+		EventType:      eventType,
+		Status:         timelineevent.StatusCompleted,
+		Content:        content,
+		Metadata:       metadata,
+		Highlight:      highlight,
+		SequenceNumber: 1,
+	}); pubErr != nil {
+		logger.Warn("Failed to publish remote stage timeline event", "error", pubErr)
+	}
+}
+
+// agentStatusFor maps a session cancellation status onto an agent status.
+func agentStatusFor(status alertsession.Status) agent.ExecutionStatus {
+	if status == alertsession.StatusTimedOut {
+		return agent.ExecutionStatusTimedOut
+	}
+	return agent.ExecutionStatusCancelled
+}
@@ -0,0 +1,125 @@
+package queue
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/federation"
+)
+
+func TestDelegateStage(t *testing.T) {
+	// remoteSession serves a remote instance whose session ends in the
+	// given status body; cancels counts cancellation requests.
+	remoteSession := func(t *testing.T, statusBody string, timeout time.Duration) (*federation.Client, *atomic.Int32) {
+		var cancels atomic.Int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /api/v1/alerts", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"session_id":"remote-1"}`))
+		})
+		mux.HandleFunc("GET /api/v1/sessions/remote-1/status", func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(statusBody))
+		})
+		mux.HandleFunc("POST /api/v1/sessions/remote-1/cancel", func(w http.ResponseWriter, _ *http.Request) {
+			cancels.Add(1)
+			_, _ = w.Write([]byte(`{}`))
+		})
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+		return federation.NewClient(&config.FederationConfig{
+			Instances: map[string]*config.FederationInstanceConfig{
+				"east": {URL: srv.URL, DashboardURL: srv.URL, Timeout: timeout, PollInterval: 10 * time.Millisecond},
+			},
+		}, ""), &cancels
+	}
+	input := executeStageInput{
+		session:     &ent.AlertSession{ID: "local-1", AlertType: "pod-crash", AlertData: "alert"},
+		stageConfig: config.StageConfig{Name: "cluster", Remote: &config.RemoteStageConfig{Instance: "east"}},
+		prevContext: "prior results",
+	}
+
+	tests := []struct {
+		name        string
+		statusBody  string
+		timeout     time.Duration
+		wantStatus  agent.ExecutionStatus
+		wantFinal   string
+		wantErr     string
+		wantCancels int32
+	}{
+		{
+			name:       "completed",
+			statusBody: `{"id":"remote-1","status":"completed","final_analysis":"Node disk is full."}`,
+			timeout:    time.Minute,
+			wantStatus: agent.ExecutionStatusCompleted,
+			wantFinal:  "Node disk is full.",
+		},
+		{
+			name:       "completed without analysis",
+			statusBody: `{"id":"remote-1","status":"completed"}`,
+			timeout:    time.Minute,
+			wantStatus: agent.ExecutionStatusFailed,
+			wantErr:    "completed without a final analysis",
+		},
+		{
+			name:       "remote failure",
+			statusBody: `{"id":"remote-1","status":"failed","error_message":"MCP server unreachable"}`,
+			timeout:    time.Minute,
+			wantStatus: agent.ExecutionStatusFailed,
+			wantErr:    "remote session remote-1 on east failed: MCP server unreachable",
+		},
+		{
+			name:       "remote timeout",
+			statusBody: `{"id":"remote-1","status":"timed_out"}`,
+			timeout:    time.Minute,
+			wantStatus: agent.ExecutionStatusTimedOut,
+			wantErr:    "remote session remote-1 on east timed_out",
+		},
+		{
+			name:        "instance timeout cancels remote session",
+			statusBody:  `{"id":"remote-1","status":"in_progress"}`,
+			timeout:     50 * time.Millisecond,
+			wantStatus:  agent.ExecutionStatusTimedOut,
+			wantErr:     "remote stage timed out",
+			wantCancels: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, cancels := remoteSession(t, tt.statusBody, tt.timeout)
+			e := &RealSessionExecutor{federation: client}
+
+			ar := e.delegateStage(context.Background(), input, "stage-1", "exec-1", slog.Default())
+			assert.Equal(t, tt.wantStatus, ar.status)
+			assert.Equal(t, tt.wantFinal, ar.finalAnalysis)
+			if tt.wantErr != "" {
+				require.Error(t, ar.err)
+				assert.Contains(t, ar.err.Error(), tt.wantErr)
+			} else {
+				assert.NoError(t, ar.err)
+			}
+			require.NotNil(t, ar.delegation)
+			assert.Equal(t, "remote-1", ar.delegation.SessionID)
+			assert.Equal(t, tt.wantCancels, cancels.Load())
+		})
+	}
+
+	t.Run("no federation client", func(t *testing.T) {
+		ar := (&RealSessionExecutor{}).delegateStage(context.Background(), input, "stage-1", "exec-1", slog.Default())
+		assert.Equal(t, agent.ExecutionStatusFailed, ar.status)
+		assert.ErrorContains(t, ar.err, "no federation instances are configured")
+		assert.Nil(t, ar.delegation)
+	})
+}
@@ -85,6 +85,7 @@ func (e *RealSessionExecutor) executeSynthesisStage(
 		if s.LLMProvider != "" {
 			synthAgentConfig.LLMProvider = s.LLMProvider
 		}
+		synthAgentConfig.Generation = s.Generation
 	}
 
 	// Build synthesis context: query full conversation history for each parallel agent
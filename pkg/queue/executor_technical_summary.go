@@ -0,0 +1,74 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/stage"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// executeTechnicalSummaryStage runs the technical summary agent as a typed
+// stage. input.prevContext must be set to the final analysis text by the
+// caller. Fail-open: always returns a stageResult; caller extracts the
+// summary from finalAnalysis.
+func (e *RealSessionExecutor) executeTechnicalSummaryStage(ctx context.Context, input executeStageInput) stageResult {
+	logger := slog.With("session_id", input.session.ID)
+	const stageName = "Technical Summary"
+
+	stg, err := input.stageService.CreateStage(ctx, models.CreateStageRequest{
+		SessionID:          input.session.ID,
+		StageName:          stageName,
+		StageIndex:         input.stageIndex + 1, // 1-based in DB
+		ExpectedAgentCount: 1,
+		StageType:          string(stage.StageTypeTechnicalSummary),
+	})
+	if err != nil {
+		if r := e.mapCancellation(ctx); r != nil {
+			return stageResult{stageName: stageName, stageType: stage.StageTypeTechnicalSummary, status: r.Status, err: r.Error}
+		}
+		logger.Error("Failed to create technical summary stage", "error", err)
+		return stageResult{
+			stageName: stageName,
+			stageType: stage.StageTypeTechnicalSummary,
+			status:    alertsession.StatusFailed,
+			err:       fmt.Errorf("failed to create technical summary stage: %w", err),
+		}
+	}
+
+	e.updateSessionProgress(ctx, input.session.ID, input.stageIndex, stg.ID)
+	publishStageStatus(ctx, e.eventPublisher, input.session.ID, stg.ID, stageName, input.stageIndex, stage.StageTypeTechnicalSummary, nil, events.StageStatusStarted)
+	publishSessionProgress(ctx, e.eventPublisher, input.session.ID, stageName,
+		input.stageIndex, input.totalExpectedStages, 0, "Generating technical summary")
+	publishExecutionProgressFromExecutor(ctx, e.eventPublisher, input.session.ID, stg.ID, "",
+		events.ProgressPhaseFinalizing, "Generating technical summary")
+
+	// technical_summary.llm_provider is the highest-priority override, picked
+	// up by ResolveAgentConfig via agentConfig.LLMProvider (defaults →
+	// chain.LLMProvider → agentConfig.LLMProvider). executive_summary_provider
+	// deliberately does not apply.
+	agentCfg := config.StageAgentConfig{
+		Name:        config.AgentNameTechnicalSummary,
+		LLMProvider: input.chain.TechnicalSummary.LLMProvider,
+	}
+	ar := e.executeAgent(ctx, input, stg, agentCfg, 0, config.AgentNameTechnicalSummary)
+
+	// Use background context — ctx may be cancelled.
+	if updateErr := input.stageService.UpdateStageStatus(context.Background(), stg.ID); updateErr != nil {
+		logger.Error("Failed to update technical summary stage status", "error", updateErr)
+	}
+
+	return stageResult{
+		stageID:       stg.ID,
+		stageName:     stageName,
+		stageType:     stg.StageType,
+		status:        mapAgentStatusToSessionStatus(ar.status),
+		finalAnalysis: ar.finalAnalysis,
+		err:           ar.err,
+		agentResults:  []agentResult{ar},
+	}
+}
@@ -222,6 +222,62 @@ func TestResolveMCPSelection(t *testing.T) {
 	})
 }
 
+func TestAlertInstructionsFor(t *testing.T) {
+	session := &ent.AlertSession{
+		ID: "test-session",
+		AlertInstructions: map[string]interface{}{
+			"global": "Focus on payments",
+			"stages": map[string]interface{}{"investigation": "Check deploys"},
+			"agents": map[string]interface{}{"KubernetesAgent": "Skip kube-system"},
+		},
+	}
+
+	assert.Equal(t, "Focus on payments\n\nCheck deploys\n\nSkip kube-system",
+		alertInstructionsFor(session, "investigation", "KubernetesAgent"))
+	assert.Equal(t, "Focus on payments", alertInstructionsFor(session, "remediation", "OtherAgent"))
+	assert.Empty(t, alertInstructionsFor(&ent.AlertSession{ID: "no-instructions"}, "investigation", "KubernetesAgent"))
+	assert.Empty(t, alertInstructionsFor(&ent.AlertSession{
+		ID:                "bad-instructions",
+		AlertInstructions: map[string]interface{}{"global": 42},
+	}, "investigation", "KubernetesAgent"))
+}
+
+func TestChatGuardrailsFor(t *testing.T) {
+	chain := &config.ChainConfig{Chat: &config.ChatConfig{
+		Guardrails: []string{"Never suggest destructive kubectl commands", " Do not share secrets "},
+	}}
+	session := &ent.AlertSession{
+		ID:                "test-session",
+		AlertInstructions: map[string]interface{}{"chat": "Only discuss the payments namespace"},
+	}
+
+	assert.Equal(t, []string{
+		"Never suggest destructive kubectl commands",
+		"Do not share secrets",
+		"Only discuss the payments namespace",
+	}, chatGuardrailsFor(chain, session))
+
+	// A session rule the chain already has is not repeated
+	session.AlertInstructions = map[string]interface{}{"chat": "Do not share secrets"}
+	assert.Len(t, chatGuardrailsFor(chain, session), 2)
+
+	assert.Empty(t, chatGuardrailsFor(&config.ChainConfig{}, &ent.AlertSession{ID: "none"}))
+	assert.Equal(t, chain.Chat.Guardrails[:1], chatGuardrailsFor(chain, &ent.AlertSession{
+		ID:                "bad-instructions",
+		AlertInstructions: map[string]interface{}{"chat": 42},
+	})[:1], "unparseable instructions keep the chain rules")
+}
+
+func TestOutputLanguageFor(t *testing.T) {
+	defaults := &config.Defaults{OutputLanguage: "German"}
+	chain := &config.ChainConfig{OutputLanguage: "Japanese"}
+	lang := "French"
+
+	assert.Equal(t, "Japanese", outputLanguageFor(defaults, chain, &ent.AlertSession{}))
+	assert.Equal(t, "French", outputLanguageFor(defaults, chain, &ent.AlertSession{OutputLanguage: &lang}))
+	assert.Equal(t, "German", outputLanguageFor(defaults, &config.ChainConfig{}, &ent.AlertSession{}))
+}
+
 func TestExtractFinalAnalysis(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -10,6 +10,7 @@ import (
 
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	testdb "github.com/codeready-toolchain/tarsy/test/database"
 	"github.com/google/uuid"
@@ -86,6 +87,14 @@ func TestForUpdateSkipLockedClaiming(t *testing.T) {
 	require.NotNil(t, claimed.PodID)
 	assert.Equal(t, "test-pod", *claimed.PodID)
 
+	// Claim history records the pod and worker
+	claims, err := client.SessionClaim.Query().Where(sessionclaim.SessionIDEQ(session.ID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, claims, 1)
+	assert.Equal(t, "test-pod", claims[0].PodID)
+	assert.Equal(t, "test-worker-0", claims[0].WorkerID)
+	assert.Nil(t, claims[0].ReleasedAt)
+
 	// Second claim should return ErrNoSessionsAvailable
 	claimed2, err := w.claimNextSession(ctx)
 	assert.ErrorIs(t, err, ErrNoSessionsAvailable)
@@ -202,6 +211,141 @@ func TestOrphanRecovery(t *testing.T) {
 	pool.orphans.mu.Unlock()
 }
 
+// TestOrphanRecoveryRequeue tests that orphan_recovery: requeue returns an
+// orphaned session to pending until orphan_max_requeues is reached.
+func TestOrphanRecoveryRequeue(t *testing.T) {
+	dbClient := testdb.NewTestClient(t)
+	client := dbClient.Client
+	ctx := context.Background()
+
+	cfg := intTestQueueConfig()
+	cfg.OrphanThreshold = 1 * time.Second
+	cfg.OrphanRecovery = config.OrphanRecoveryRequeue
+	cfg.OrphanMaxRequeues = 1
+
+	pool := &WorkerPool{
+		podID:  "test-pod",
+		client: client,
+		config: cfg,
+	}
+
+	session, err := client.AlertSession.Create().
+		SetID(uuid.New().String()).
+		SetAlertData("requeue test data").
+		SetAgentType("test-agent").
+		SetAlertType("test-alert").
+		SetChainID("test-chain").
+		SetStatus(alertsession.StatusPending).
+		SetAuthor("test-user").
+		Save(ctx)
+	require.NoError(t, err)
+
+	// simulateCrash puts the session in progress on a dead pod with an open claim.
+	simulateCrash := func() {
+		staleBeat := time.Now().Add(-10 * time.Minute)
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		require.NoError(t, recordClaim(ctx, tx, session, "crashed-pod", "crashed-pod-worker-0", staleBeat))
+		require.NoError(t, tx.AlertSession.UpdateOneID(session.ID).
+			SetStatus(alertsession.StatusInProgress).
+			SetPodID("crashed-pod").
+			SetStartedAt(staleBeat).
+			SetLastInteractionAt(staleBeat).
+			Exec(ctx))
+		require.NoError(t, tx.Commit())
+	}
+
+	// First orphaning: requeued
+	simulateCrash()
+	require.NoError(t, pool.detectAndRecoverOrphans(ctx))
+
+	updated, err := client.AlertSession.Get(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, alertsession.StatusPending, updated.Status)
+	assert.Nil(t, updated.PodID)
+	assert.Nil(t, updated.LastInteractionAt)
+
+	claim, err := client.SessionClaim.Query().Where(sessionclaim.SessionIDEQ(session.ID)).Only(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, claim.Outcome)
+	assert.Equal(t, sessionclaim.OutcomeOrphaned, *claim.Outcome)
+	require.NotNil(t, claim.Detail)
+	assert.Contains(t, *claim.Detail, "(requeued)")
+
+	// Second orphaning: requeue limit reached, failed
+	simulateCrash()
+	require.NoError(t, pool.detectAndRecoverOrphans(ctx))
+
+	updated, err = client.AlertSession.Get(ctx, session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, alertsession.StatusTimedOut, updated.Status)
+	require.NotNil(t, updated.ErrorMessage)
+	assert.Contains(t, *updated.ErrorMessage, "requeue limit of 1 reached")
+
+	pool.orphans.mu.Lock()
+	assert.Equal(t, 2, pool.orphans.orphansRecovered)
+	pool.orphans.mu.Unlock()
+}
+
+// TestAutoCancelStalePendingSessions tests TTL-based auto-cancellation of queued sessions.
+func TestAutoCancelStalePendingSessions(t *testing.T) {
+	dbClient := testdb.NewTestClient(t)
+	client := dbClient.Client
+	ctx := context.Background()
+
+	createPending := func(alertType string, age time.Duration) *ent.AlertSession {
+		session, err := client.AlertSession.Create().
+			SetID(uuid.New().String()).
+			SetAlertData("auto-cancel test data").
+			SetAgentType("test-agent").
+			SetAlertType(alertType).
+			SetChainID("test-chain").
+			SetStatus(alertsession.StatusPending).
+			SetCreatedAt(time.Now().Add(-age)).
+			Save(ctx)
+		require.NoError(t, err)
+		return session
+	}
+
+	stale := createPending("test-alert", 2*time.Hour)
+	fresh := createPending("test-alert", 10*time.Minute)
+	staleFastType := createPending("fast-alert", 20*time.Minute)
+	exempt := createPending("exempt-alert", 5*time.Hour)
+
+	cfg := intTestQueueConfig()
+	cfg.AutoCancel = &config.AutoCancelConfig{
+		PendingTTL:    time.Hour,
+		AlertTypeTTLs: map[string]time.Duration{"fast-alert": 15 * time.Minute, "exempt-alert": 0},
+		CheckInterval: time.Minute,
+	}
+	pool := &WorkerPool{
+		podID:  "test-pod",
+		client: client,
+		config: cfg,
+	}
+
+	require.NoError(t, pool.cancelStalePendingSessions(ctx))
+
+	for _, tc := range []struct {
+		session *ent.AlertSession
+		want    alertsession.Status
+	}{
+		{stale, alertsession.StatusAutoCancelled},
+		{fresh, alertsession.StatusPending},
+		{staleFastType, alertsession.StatusAutoCancelled},
+		{exempt, alertsession.StatusPending},
+	} {
+		updated, err := client.AlertSession.Get(ctx, tc.session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, updated.Status, "alert type %s", tc.session.AlertType)
+		if tc.want == alertsession.StatusAutoCancelled {
+			require.NotNil(t, updated.ErrorMessage)
+			assert.Contains(t, *updated.ErrorMessage, "pending TTL")
+			assert.NotNil(t, updated.CompletedAt)
+		}
+	}
+}
+
 // TestStartupOrphanCleanup tests the one-time startup orphan cleanup.
 func TestStartupOrphanCleanup(t *testing.T) {
 	dbClient := testdb.NewTestClient(t)
@@ -239,7 +383,12 @@ func TestStartupOrphanCleanup(t *testing.T) {
 	require.NoError(t, err)
 
 	// Run startup cleanup
-	err = CleanupStartupOrphans(ctx, client, podID)
+	pool := &WorkerPool{
+		podID:  podID,
+		client: client,
+		config: intTestQueueConfig(),
+	}
+	err = pool.CleanupStartupOrphans(ctx)
 	require.NoError(t, err)
 
 	// Verify this pod's sessions are timed_out (startup orphans are marked as timed_out)
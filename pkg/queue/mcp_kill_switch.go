@@ -0,0 +1,193 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	agentctx "github.com/codeready-toolchain/tarsy/pkg/agent/context"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// withoutDisabledServers drops the MCP servers already disabled for the
+// session from serverIDs, so the agent never connects to them. The dropped
+// servers are returned with a description for the prompt's unavailable
+// servers section. Fail-open: lookup errors leave serverIDs unchanged.
+func withoutDisabledServers(ctx context.Context, client *ent.Client, sessionID string, serverIDs []string) ([]string, map[string]string) {
+	if client == nil || len(serverIDs) == 0 {
+		return serverIDs, nil
+	}
+	events, err := services.QueryDisabledMCPServers(ctx, client, sessionID)
+	if err != nil {
+		slog.Warn("Failed to check disabled MCP servers, continuing with all servers",
+			"session_id", sessionID, "error", err)
+		return serverIDs, nil
+	}
+	if len(events) == 0 {
+		return serverIDs, nil
+	}
+
+	reasons := make(map[string]string, len(events))
+	for _, event := range events {
+		reasons[services.DisabledMCPServerID(event)] = describeDisabledServer(event)
+	}
+	var enabled []string
+	var disabled map[string]string
+	for _, id := range serverIDs {
+		if reason, ok := reasons[id]; ok {
+			if disabled == nil {
+				disabled = make(map[string]string)
+			}
+			disabled[id] = reason
+			continue
+		}
+		enabled = append(enabled, id)
+	}
+	return enabled, disabled
+}
+
+// mergeFailedServers adds the disabled servers to the failed servers map
+// presented to the agent.
+func mergeFailedServers(failed, disabled map[string]string) map[string]string {
+	if len(disabled) == 0 {
+		return failed
+	}
+	merged := make(map[string]string, len(failed)+len(disabled))
+	for id, msg := range failed {
+		merged[id] = msg
+	}
+	for id, msg := range disabled {
+		merged[id] = msg
+	}
+	return merged
+}
+
+func describeDisabledServer(event *ent.TimelineEvent) string {
+	author, _ := event.Metadata[MetadataKeyAuthor].(string)
+	reason, _ := event.Metadata[services.MetadataKeyReason].(string)
+	msg := "disabled by an operator for this session"
+	if author != "" {
+		msg = fmt.Sprintf("disabled by %s for this session", author)
+	}
+	if reason != "" {
+		msg += ": " + reason
+	}
+	return msg
+}
+
+// disabledServerGate applies the session's MCP kill switch to one agent
+// execution. It wraps the agent's MCP tool executor: tools of disabled
+// servers are hidden from ListTools and refused by Execute. Servers disabled
+// while the agent runs take effect when the controller drains them at the
+// start of its next iteration, so the agent is told before its tools vanish.
+type disabledServerGate struct {
+	inner     agent.ToolExecutor
+	client    *ent.Client
+	sessionID string
+	servers   map[string]bool // The agent's servers; others are ignored
+
+	mu       sync.RWMutex
+	disabled map[string]bool
+}
+
+// gateDisabledServers wraps inner with a disabledServerGate for the agent's
+// serverIDs. Returns inner and a nil source when there is nothing to gate.
+func gateDisabledServers(inner agent.ToolExecutor, client *ent.Client, sessionID string, serverIDs []string) (agent.ToolExecutor, agent.DisabledServerSource) {
+	if client == nil || len(serverIDs) == 0 {
+		return inner, nil
+	}
+	servers := make(map[string]bool, len(serverIDs))
+	for _, id := range serverIDs {
+		servers[id] = true
+	}
+	gate := &disabledServerGate{
+		inner:     inner,
+		client:    client,
+		sessionID: sessionID,
+		servers:   servers,
+		disabled:  make(map[string]bool),
+	}
+	return gate, gate
+}
+
+// TryDrainDisabled returns a notice for each of the agent's servers disabled
+// since the last drain and stops exposing their tools. Fail-open: lookup
+// errors are logged and leave the tool set unchanged.
+func (g *disabledServerGate) TryDrainDisabled(ctx context.Context) []agent.ConversationMessage {
+	events, err := services.QueryDisabledMCPServers(ctx, g.client, g.sessionID)
+	if err != nil {
+		slog.Warn("Failed to check disabled MCP servers, continuing with current tools",
+			"session_id", g.sessionID, "error", err)
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var msgs []agent.ConversationMessage
+	for _, event := range events {
+		id := services.DisabledMCPServerID(event)
+		if !g.servers[id] || g.disabled[id] {
+			continue
+		}
+		g.disabled[id] = true
+		author, _ := event.Metadata[MetadataKeyAuthor].(string)
+		reason, _ := event.Metadata[services.MetadataKeyReason].(string)
+		msgs = append(msgs, agent.ConversationMessage{
+			Role:    agent.RoleUser,
+			Content: agentctx.FormatMCPServerDisabled(id, author, reason),
+		})
+	}
+	return msgs
+}
+
+// Execute refuses calls to disabled servers and delegates the rest.
+func (g *disabledServerGate) Execute(ctx context.Context, call agent.ToolCall) (*agent.ToolResult, error) {
+	if serverID := g.disabledServerOf(call.Name); serverID != "" {
+		return &agent.ToolResult{
+			CallID:  call.ID,
+			Name:    call.Name,
+			Content: fmt.Sprintf("MCP server %q was disabled for this session; its tools are no longer available", serverID),
+			IsError: true,
+		}, nil
+	}
+	return g.inner.Execute(ctx, call)
+}
+
+// ListTools returns the inner tools without those of disabled servers.
+func (g *disabledServerGate) ListTools(ctx context.Context) ([]agent.ToolDefinition, error) {
+	tools, err := g.inner.ListTools(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]agent.ToolDefinition, 0, len(tools))
+	for _, tool := range tools {
+		if g.disabledServerOf(tool.Name) == "" {
+			filtered = append(filtered, tool)
+		}
+	}
+	return filtered, nil
+}
+
+// Close delegates to the inner executor.
+func (g *disabledServerGate) Close() error {
+	return g.inner.Close()
+}
+
+// disabledServerOf returns the server of an MCP tool name when that server
+// is disabled, or "" otherwise (including for non-MCP tools).
+func (g *disabledServerGate) disabledServerOf(toolName string) string {
+	serverID, _, err := mcp.SplitToolName(mcp.NormalizeToolName(toolName))
+	if err != nil {
+		return ""
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.disabled[serverID] {
+		return serverID
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+)
+
+func TestDisabledServerGate(t *testing.T) {
+	inner := agent.NewStubToolExecutor([]agent.ToolDefinition{
+		{Name: "kubernetes-server.pods_list"},
+		{Name: "github.search_code"},
+		{Name: "load_skill"},
+	})
+	executor, source := gateDisabledServers(inner, &ent.Client{}, "session-1", []string{"kubernetes-server", "github"})
+	require.NotNil(t, source)
+	gate := executor.(*disabledServerGate)
+	gate.disabled["kubernetes-server"] = true
+	ctx := context.Background()
+
+	tools, err := executor.ListTools(ctx)
+	require.NoError(t, err)
+	names := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	assert.Equal(t, []string{"github.search_code", "load_skill"}, names)
+
+	t.Run("refuses disabled server in either name format", func(t *testing.T) {
+		for _, name := range []string{"kubernetes-server.pods_list", "kubernetes-server__pods_list"} {
+			result, err := executor.Execute(ctx, agent.ToolCall{ID: "c1", Name: name})
+			require.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, result.Content, `"kubernetes-server" was disabled`)
+		}
+	})
+
+	t.Run("delegates other tools", func(t *testing.T) {
+		result, err := executor.Execute(ctx, agent.ToolCall{ID: "c2", Name: "github.search_code"})
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+	})
+}
+
+func TestGateDisabledServers_NothingToGate(t *testing.T) {
+	inner := agent.NewStubToolExecutor(nil)
+
+	executor, source := gateDisabledServers(inner, nil, "session-1", []string{"github"})
+	assert.Same(t, inner, executor)
+	assert.Nil(t, source)
+
+	executor, source = gateDisabledServers(inner, &ent.Client{}, "session-1", nil)
+	assert.Same(t, inner, executor)
+	assert.Nil(t, source)
+}
+
+func TestMergeFailedServers(t *testing.T) {
+	failed := map[string]string{"github": "connection refused"}
+
+	assert.Equal(t, failed, mergeFailedServers(failed, nil))
+	assert.Equal(t, map[string]string{
+		"github":            "connection refused",
+		"kubernetes-server": "disabled by alice for this session",
+	}, mergeFailedServers(failed, map[string]string{"kubernetes-server": "disabled by alice for this session"}))
+	assert.Len(t, failed, 1, "input map must not be modified")
+}
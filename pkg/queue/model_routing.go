@@ -0,0 +1,128 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+)
+
+// maxRoutingAlertChars caps the alert payload sent to the complexity
+// classifier; the first few KB are enough to judge an alert.
+const maxRoutingAlertChars = 8000
+
+const modelRoutingSystemPrompt = `You triage incoming infrastructure alerts before an automated SRE investigation.
+Rate how hard the alert is to investigate on a scale from 1 to 10:
+- 1-3: routine, single well-known cause (disk space, certificate expiry, a pod restarted once)
+- 4-6: needs correlating a few signals or resources
+- 7-10: ambiguous, multi-component, security-relevant, or likely requiring deep reasoning
+
+Respond with a single JSON object and nothing else:
+{"score": <integer 1-10>, "reason": "<one short sentence>"}`
+
+// complexityVerdict is the classifier's parsed response.
+type complexityVerdict struct {
+	Score  int    `json:"score"`
+	Reason string `json:"reason"`
+}
+
+// routeModel scores the alert's complexity and returns the chain with its
+// chain-level provider set to the matching tier, plus the decision to
+// record on the session. Returns the chain unchanged and a nil decision when
+// routing is disabled. Fail-open: a failed classification keeps the chain's
+// configured providers and records the error.
+func (e *RealSessionExecutor) routeModel(ctx context.Context, session *ent.AlertSession, chain *config.ChainConfig) (*config.ChainConfig, *schema.ModelRoutingDecision) {
+	rc := e.cfg.ModelRouting
+	if rc == nil || !rc.Enabled || e.llmClient == nil {
+		return chain, nil
+	}
+
+	decision := &schema.ModelRoutingDecision{ClassifierProvider: rc.ClassifierProvider}
+	verdict, err := e.classifyComplexity(ctx, rc, session, chain.Owner)
+	if err != nil {
+		decision.Error = err.Error()
+		metrics.ModelRoutingDecisionsTotal.WithLabelValues("unrouted").Inc()
+		return chain, decision
+	}
+
+	decision.Score = verdict.Score
+	decision.Reason = verdict.Reason
+	decision.Tier, decision.Provider = selectTier(rc, verdict.Score)
+	metrics.ModelRoutingDecisionsTotal.WithLabelValues(decision.Tier).Inc()
+
+	routed := *chain
+	routed.LLMProvider = decision.Provider
+	return &routed, decision
+}
+
+// selectTier maps a complexity score to a provider tier.
+func selectTier(rc *config.ModelRoutingConfig, score int) (tier, provider string) {
+	if score >= rc.ComplexityThreshold {
+		return config.ModelRoutingTierStrong, rc.StrongProvider
+	}
+	return config.ModelRoutingTierFast, rc.FastProvider
+}
+
+// classifyComplexity runs the classification call on the classifier
+// provider. The call is charged to owner, the owner of the session's chain.
+func (e *RealSessionExecutor) classifyComplexity(ctx context.Context, rc *config.ModelRoutingConfig, session *ent.AlertSession, owner string) (*complexityVerdict, error) {
+	llmCfg, err := agent.ResolveSideCallConfig(e.cfg, nil, rc.ClassifierProvider)
+	if err != nil {
+		return nil, fmt.Errorf("classifier provider: %w", err)
+	}
+	llmCfg.Owner = owner
+
+	text, err := e.sideCaller().Call(ctx, controller.SideCall{
+		SessionID:       session.ID,
+		InteractionType: llminteraction.InteractionTypeModelRouting,
+		Config:          llmCfg,
+		Messages: []agent.ConversationMessage{
+			{Role: agent.RoleSystem, Content: modelRoutingSystemPrompt},
+			{Role: agent.RoleUser, Content: fmt.Sprintf("Alert type: %s\n\nAlert data:\n%s", session.AlertType, controller.TruncatePrompt(session.AlertData, maxRoutingAlertChars))},
+		},
+		Timeout: rc.Timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("classification failed: %w", err)
+	}
+	return parseComplexityVerdict(text)
+}
+
+// parseComplexityVerdict extracts the JSON verdict from the classifier
+// output, tolerating markdown fences and surrounding prose.
+func parseComplexityVerdict(raw string) (*complexityVerdict, error) {
+	var v complexityVerdict
+	if err := controller.ParseJSONObject(raw, &v); err != nil {
+		return nil, fmt.Errorf("invalid classifier response: %w", err)
+	}
+	if v.Score < 1 || v.Score > 10 {
+		return nil, fmt.Errorf("classifier score %d out of range 1-10", v.Score)
+	}
+	v.Reason = strings.TrimSpace(v.Reason)
+	return &v, nil
+}
+
+// recordModelRouting stores the routing decision on the session.
+// Best-effort: the session still runs if the update fails.
+func (e *RealSessionExecutor) recordModelRouting(ctx context.Context, sessionID string, decision *schema.ModelRoutingDecision) {
+	if e.dbClient == nil || decision == nil {
+		return
+	}
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := e.dbClient.AlertSession.UpdateOneID(sessionID).
+		SetModelRouting(decision).
+		Exec(writeCtx); err != nil {
+		slog.Warn("Failed to record model routing decision",
+			"session_id", sessionID, "error", err)
+	}
+}
@@ -0,0 +1,166 @@
+package queue
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestParseComplexityVerdict(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        string
+		wantScore  int
+		wantReason string
+		errMsg     string
+	}{
+		{name: "plain JSON", raw: `{"score": 2, "reason": "Disk space alert."}`, wantScore: 2, wantReason: "Disk space alert."},
+		{name: "markdown fenced", raw: "```json\n{\"score\": 8, \"reason\": \" Multi-service outage \"}\n```", wantScore: 8, wantReason: "Multi-service outage"},
+		{name: "surrounding prose", raw: `Sure. {"score": 5, "reason": "x"} Hope this helps.`, wantScore: 5, wantReason: "x"},
+		{name: "no JSON", raw: "score: 3", errMsg: "no JSON object"},
+		{name: "invalid JSON", raw: `{"score": "high"}`, errMsg: "invalid classifier response"},
+		{name: "score out of range", raw: `{"score": 11}`, errMsg: "out of range"},
+		{name: "missing score", raw: `{"reason": "?"}`, errMsg: "out of range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := parseComplexityVerdict(tt.raw)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantScore, v.Score)
+			assert.Equal(t, tt.wantReason, v.Reason)
+		})
+	}
+}
+
+func TestRouteModel(t *testing.T) {
+	routing := &config.ModelRoutingConfig{
+		Enabled:             true,
+		ClassifierProvider:  "flash",
+		FastProvider:        "flash",
+		StrongProvider:      "pro",
+		ComplexityThreshold: 6,
+		Timeout:             time.Second,
+	}
+	cfg := &config.Config{
+		Defaults: &config.Defaults{LLMProvider: "pro"},
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"flash": {Type: config.LLMProviderTypeGoogle, Model: "flash-model"},
+			"pro":   {Type: config.LLMProviderTypeGoogle, Model: "pro-model"},
+		}),
+		ModelRouting: routing,
+	}
+	chain := &config.ChainConfig{
+		LLMProvider: "pro",
+		Stages:      []config.StageConfig{{Name: "investigation", Agents: []config.StageAgentConfig{{Name: "KubernetesAgent"}}}},
+	}
+	alert := func(alertType, alertData string) *ent.AlertSession {
+		return &ent.AlertSession{ID: "s1", AlertType: alertType, AlertData: alertData}
+	}
+	verdict := func(text string) *mockLLMClient {
+		return &mockLLMClient{capture: true, responses: []mockLLMResponse{{chunks: []agent.Chunk{&agent.TextChunk{Content: text}}}}}
+	}
+
+	t.Run("disabled leaves chain untouched", func(t *testing.T) {
+		disabled := *cfg
+		disabled.ModelRouting = config.DefaultModelRoutingConfig()
+		llm := verdict(`{"score": 1}`)
+		e := &RealSessionExecutor{cfg: &disabled, llmClient: llm}
+
+		got, decision := e.routeModel(t.Context(), alert("Disk", "disk full"), chain)
+		assert.Same(t, chain, got)
+		assert.Nil(t, decision)
+		assert.Zero(t, llm.callCount)
+	})
+
+	t.Run("simple alert routes to fast tier", func(t *testing.T) {
+		llm := verdict(`{"score": 2, "reason": "Routine disk alert."}`)
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+
+		got, decision := e.routeModel(t.Context(), alert("Disk", "disk full"), chain)
+		require.NotNil(t, decision)
+		assert.Equal(t, config.ModelRoutingTierFast, decision.Tier)
+		assert.Equal(t, "flash", decision.Provider)
+		assert.Equal(t, 2, decision.Score)
+		assert.Equal(t, "Routine disk alert.", decision.Reason)
+		assert.Equal(t, "flash", decision.ClassifierProvider)
+		assert.Empty(t, decision.Error)
+		assert.Equal(t, "flash", got.LLMProvider)
+		assert.Equal(t, "pro", chain.LLMProvider, "original chain must not be modified")
+
+		require.Len(t, llm.capturedInputs, 1)
+		input := llm.capturedInputs[0]
+		assert.Equal(t, "flash-model", input.Config.Model)
+		assert.Equal(t, agent.DefaultLLMBackend, input.Backend)
+		assert.Contains(t, input.Messages[1].Content, "disk full")
+	})
+
+	t.Run("score at threshold routes to strong tier", func(t *testing.T) {
+		e := &RealSessionExecutor{cfg: cfg, llmClient: verdict(`{"score": 6, "reason": "Cross-service."}`)}
+
+		got, decision := e.routeModel(t.Context(), alert("Outage", "many things"), chain)
+		require.NotNil(t, decision)
+		assert.Equal(t, config.ModelRoutingTierStrong, decision.Tier)
+		assert.Equal(t, "pro", decision.Provider)
+		assert.Equal(t, "pro", got.LLMProvider)
+	})
+
+	t.Run("classifier failure keeps configured providers", func(t *testing.T) {
+		llm := &mockLLMClient{responses: []mockLLMResponse{{err: errors.New("connection refused")}}}
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+
+		got, decision := e.routeModel(t.Context(), alert("Disk", "disk full"), chain)
+		assert.Same(t, chain, got)
+		require.NotNil(t, decision)
+		assert.Empty(t, decision.Tier)
+		assert.Contains(t, decision.Error, "connection refused")
+	})
+
+	t.Run("classifier error chunk keeps configured providers", func(t *testing.T) {
+		llm := &mockLLMClient{responses: []mockLLMResponse{{chunks: []agent.Chunk{
+			&agent.ErrorChunk{Message: "quota exceeded", Code: "provider_error"},
+		}}}}
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+
+		got, decision := e.routeModel(t.Context(), alert("Disk", "disk full"), chain)
+		assert.Same(t, chain, got)
+		require.NotNil(t, decision)
+		assert.Contains(t, decision.Error, "quota exceeded")
+	})
+
+	t.Run("large alert payload is truncated", func(t *testing.T) {
+		llm := verdict(`{"score": 3}`)
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+
+		_, decision := e.routeModel(t.Context(), alert("Disk", strings.Repeat("a", 3*maxRoutingAlertChars)), chain)
+		require.NotNil(t, decision)
+		require.Len(t, llm.capturedInputs, 1)
+		assert.Less(t, len(llm.capturedInputs[0].Messages[1].Content), maxRoutingAlertChars+200)
+		assert.Contains(t, llm.capturedInputs[0].Messages[1].Content, "[truncated]")
+	})
+
+	t.Run("truncation does not split multi-byte characters", func(t *testing.T) {
+		llm := verdict(`{"score": 3}`)
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+
+		_, decision := e.routeModel(t.Context(), alert("Disk", "x"+strings.Repeat("é", maxRoutingAlertChars)), chain)
+		require.NotNil(t, decision)
+		require.Len(t, llm.capturedInputs, 1)
+		assert.True(t, utf8.ValidString(llm.capturedInputs[0].Messages[1].Content))
+		assert.Contains(t, llm.capturedInputs[0].Messages[1].Content, "é\n[truncated]")
+	})
+}
@@ -0,0 +1,266 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+)
+
+// Noise triage verdicts.
+const (
+	noiseVerdictActionable    = "actionable"
+	noiseVerdictFalsePositive = "false_positive"
+	noiseVerdictDuplicate     = "duplicate"
+)
+
+const (
+	// maxTriageAlertChars caps the alert payload sent to the noise classifier.
+	maxTriageAlertChars = 8000
+
+	// maxTriageRecentSessions caps the recent sessions shown to the
+	// classifier for duplicate detection.
+	maxTriageRecentSessions = 5
+
+	// maxTriageRecentChars caps each recent session's alert data and
+	// conclusion in the classifier prompt.
+	maxTriageRecentChars = 1000
+)
+
+const noiseTriageSystemPrompt = `You triage incoming infrastructure alerts before an automated SRE investigation.
+Decide whether the alert needs an investigation:
+- "actionable": a real problem someone should look into. When in doubt, choose this.
+- "false_positive": a known false positive or pure noise (flapping thresholds, test or synthetic alerts, expected maintenance).
+- "duplicate": the same problem as one of the recent sessions listed, which already covers it.
+
+Respond with a single JSON object and nothing else:
+{"verdict": "actionable" | "false_positive" | "duplicate", "confidence": <number 0-1>, "reason": "<one short sentence>", "duplicate_of": "<recent session ID, only for duplicate>"}`
+
+// noiseVerdict is the classifier's parsed response.
+type noiseVerdict struct {
+	Verdict     string  `json:"verdict"`
+	Confidence  float64 `json:"confidence"`
+	Reason      string  `json:"reason"`
+	DuplicateOf string  `json:"duplicate_of"`
+}
+
+// triageNoise runs the noise triage pre-chain. Returns the result that
+// closes the session with the triage verdict when the alert is classified
+// non-actionable with enough confidence, or nil to run the chain. The
+// decision is recorded on the session either way. Fail-open: a failed
+// classification runs the chain and records the error.
+func (e *RealSessionExecutor) triageNoise(ctx context.Context, session *ent.AlertSession, logger *slog.Logger) *ExecutionResult {
+	tc := e.cfg.NoiseTriage
+	at, ok := tc.ForAlertType(session.AlertType)
+	if !ok || e.llmClient == nil || !noiseTriageApplies(session) ||
+		!e.flagEnabled(config.FeatureFlagNoiseTriage, session) {
+		return nil
+	}
+
+	decision := &schema.NoiseTriageDecision{ClassifierProvider: tc.ClassifierProvider}
+	recent, err := e.recentSessions(ctx, session, tc.DuplicateWindow)
+	var verdict *noiseVerdict
+	if err == nil {
+		verdict, err = e.classifyNoise(ctx, tc, session, recent)
+	}
+	if err != nil {
+		decision.Error = err.Error()
+		metrics.NoiseTriageVerdictsTotal.WithLabelValues(session.AlertType, "unclassified", "false").Inc()
+		logger.Warn("Noise triage failed, running full chain", "error", err)
+		e.recordNoiseTriage(ctx, session.ID, decision)
+		return nil
+	}
+
+	decision.Verdict = verdict.Verdict
+	decision.Confidence = verdict.Confidence
+	decision.Reason = verdict.Reason
+	decision.DuplicateOf = verdict.DuplicateOf
+	decision.Skipped = verdict.Verdict != noiseVerdictActionable && verdict.Confidence >= at.MinConfidence
+	metrics.NoiseTriageVerdictsTotal.WithLabelValues(session.AlertType, verdict.Verdict, strconv.FormatBool(decision.Skipped)).Inc()
+	logger.Info("Noise triage verdict",
+		"verdict", verdict.Verdict, "confidence", verdict.Confidence,
+		"duplicate_of", verdict.DuplicateOf, "skipped", decision.Skipped)
+	e.recordNoiseTriage(ctx, session.ID, decision)
+
+	if !decision.Skipped {
+		return nil
+	}
+	return &ExecutionResult{
+		Status:        alertsession.StatusCompleted,
+		FinalAnalysis: formatNoiseTriageAnalysis(decision),
+		NoiseTriaged:  true,
+	}
+}
+
+// noiseTriageApplies reports whether the session may be closed by triage.
+// Sessions forced to full investigation, what-if duplicates, sandbox runs and
+// stages delegated by another instance always run their chain.
+func noiseTriageApplies(session *ent.AlertSession) bool {
+	return !session.ForceFullInvestigation &&
+		session.DuplicatedFrom == nil &&
+		!session.Sandbox &&
+		session.FederationOrigin == nil
+}
+
+// recentSessions returns the latest sessions of the same alert type (and
+// alert_key, when set) created within window before session.
+func (e *RealSessionExecutor) recentSessions(ctx context.Context, session *ent.AlertSession, window time.Duration) ([]*ent.AlertSession, error) {
+	if e.dbClient == nil {
+		return nil, nil
+	}
+	query := e.dbClient.AlertSession.Query().
+		Where(
+			alertsession.AlertTypeEQ(session.AlertType),
+			alertsession.IDNEQ(session.ID),
+			alertsession.DeletedAtIsNil(),
+			alertsession.Sandbox(false),
+			alertsession.CreatedAtLTE(session.CreatedAt),
+			alertsession.CreatedAtGTE(session.CreatedAt.Add(-window)),
+		)
+	if session.AlertKey != nil && *session.AlertKey != "" {
+		query = query.Where(alertsession.AlertKeyEQ(*session.AlertKey))
+	}
+	recent, err := query.
+		Order(ent.Desc(alertsession.FieldCreatedAt)).
+		Limit(maxTriageRecentSessions).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load recent sessions: %w", err)
+	}
+	return recent, nil
+}
+
+// classifyNoise runs the classification call on the classifier provider.
+// The call is charged to the owner of the session's chain.
+func (e *RealSessionExecutor) classifyNoise(ctx context.Context, tc *config.NoiseTriageConfig, session *ent.AlertSession, recent []*ent.AlertSession) (*noiseVerdict, error) {
+	llmCfg, err := agent.ResolveSideCallConfig(e.cfg, nil, tc.ClassifierProvider)
+	if err != nil {
+		return nil, fmt.Errorf("classifier provider: %w", err)
+	}
+	if chain, err := e.cfg.GetChain(session.ChainID); err == nil {
+		llmCfg.Owner = chain.Owner
+	}
+
+	text, err := e.sideCaller().Call(ctx, controller.SideCall{
+		SessionID:       session.ID,
+		InteractionType: llminteraction.InteractionTypeNoiseTriage,
+		Config:          llmCfg,
+		Messages: []agent.ConversationMessage{
+			{Role: agent.RoleSystem, Content: noiseTriageSystemPrompt},
+			{Role: agent.RoleUser, Content: buildNoiseTriagePrompt(session, recent)},
+		},
+		Timeout: tc.Timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("classification failed: %w", err)
+	}
+
+	recentIDs := make([]string, len(recent))
+	for i, r := range recent {
+		recentIDs[i] = r.ID
+	}
+	return parseNoiseVerdict(text, recentIDs)
+}
+
+// buildNoiseTriagePrompt renders the alert and the recent sessions of the
+// same alert the classifier may name as duplicated.
+func buildNoiseTriagePrompt(session *ent.AlertSession, recent []*ent.AlertSession) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Alert type: %s\n", session.AlertType)
+	if session.AlertKey != nil && *session.AlertKey != "" {
+		fmt.Fprintf(&sb, "Alert key: %s\n", *session.AlertKey)
+	}
+	fmt.Fprintf(&sb, "\nAlert data:\n%s\n", controller.TruncatePrompt(session.AlertData, maxTriageAlertChars))
+
+	if len(recent) == 0 {
+		sb.WriteString("\nRecent sessions of this alert: none\n")
+		return sb.String()
+	}
+	sb.WriteString("\nRecent sessions of this alert:\n")
+	for _, r := range recent {
+		fmt.Fprintf(&sb, "\n## Session %s (%s, %s)\n", r.ID, r.Status, r.CreatedAt.UTC().Format(time.RFC3339))
+		fmt.Fprintf(&sb, "Alert data:\n%s\n", controller.TruncatePrompt(r.AlertData, maxTriageRecentChars))
+		conclusion := ""
+		if r.ExecutiveSummary != nil {
+			conclusion = *r.ExecutiveSummary
+		} else if r.FinalAnalysis != nil {
+			conclusion = *r.FinalAnalysis
+		}
+		if conclusion != "" {
+			fmt.Fprintf(&sb, "Conclusion:\n%s\n", controller.TruncatePrompt(conclusion, maxTriageRecentChars))
+		}
+	}
+	return sb.String()
+}
+
+// parseNoiseVerdict extracts the JSON verdict from the classifier output,
+// tolerating markdown fences and surrounding prose. A duplicate verdict must
+// name one of recentIDs.
+func parseNoiseVerdict(raw string, recentIDs []string) (*noiseVerdict, error) {
+	var v noiseVerdict
+	if err := controller.ParseJSONObject(raw, &v); err != nil {
+		return nil, fmt.Errorf("invalid classifier response: %w", err)
+	}
+	switch v.Verdict {
+	case noiseVerdictActionable, noiseVerdictFalsePositive:
+		v.DuplicateOf = ""
+	case noiseVerdictDuplicate:
+		if !slices.Contains(recentIDs, v.DuplicateOf) {
+			return nil, fmt.Errorf("classifier marked the alert duplicate of unknown session %q", v.DuplicateOf)
+		}
+	default:
+		return nil, fmt.Errorf("classifier verdict %q is not actionable, false_positive or duplicate", v.Verdict)
+	}
+	if v.Confidence < 0 || v.Confidence > 1 {
+		return nil, fmt.Errorf("classifier confidence %g out of range 0-1", v.Confidence)
+	}
+	v.Reason = strings.TrimSpace(v.Reason)
+	return &v, nil
+}
+
+// formatNoiseTriageAnalysis renders the final analysis of a session closed
+// by triage.
+func formatNoiseTriageAnalysis(d *schema.NoiseTriageDecision) string {
+	var sb strings.Builder
+	switch d.Verdict {
+	case noiseVerdictDuplicate:
+		fmt.Fprintf(&sb, "**Noise triage: duplicate of session %s**", d.DuplicateOf)
+	default:
+		sb.WriteString("**Noise triage: known false positive**")
+	}
+	fmt.Fprintf(&sb, " (confidence %.0f%%)\n\n", d.Confidence*100)
+	if d.Reason != "" {
+		sb.WriteString(d.Reason)
+		sb.WriteString("\n\n")
+	}
+	sb.WriteString("The full investigation was skipped. Resubmit the alert with `force_full_investigation: true` to investigate it.")
+	return sb.String()
+}
+
+// recordNoiseTriage stores the triage decision on the session.
+// Best-effort: the session still runs if the update fails.
+func (e *RealSessionExecutor) recordNoiseTriage(ctx context.Context, sessionID string, decision *schema.NoiseTriageDecision) {
+	if e.dbClient == nil {
+		return
+	}
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := e.dbClient.AlertSession.UpdateOneID(sessionID).
+		SetNoiseTriage(decision).
+		Exec(writeCtx); err != nil {
+		slog.Warn("Failed to record noise triage decision",
+			"session_id", sessionID, "error", err)
+	}
+}
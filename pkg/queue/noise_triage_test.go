@@ -0,0 +1,148 @@
+package queue
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestParseNoiseVerdict(t *testing.T) {
+	recent := []string{"session-1"}
+	tests := []struct {
+		name        string
+		raw         string
+		wantVerdict string
+		wantDupOf   string
+		errMsg      string
+	}{
+		{name: "actionable", raw: `{"verdict": "actionable", "confidence": 0.9, "reason": "Real outage."}`, wantVerdict: "actionable"},
+		{name: "false positive fenced", raw: "```json\n{\"verdict\": \"false_positive\", \"confidence\": 0.95, \"duplicate_of\": \"session-1\"}\n```", wantVerdict: "false_positive"},
+		{name: "duplicate of recent session", raw: `{"verdict": "duplicate", "confidence": 0.8, "duplicate_of": "session-1"}`, wantVerdict: "duplicate", wantDupOf: "session-1"},
+		{name: "duplicate of unknown session", raw: `{"verdict": "duplicate", "confidence": 0.8, "duplicate_of": "other"}`, errMsg: "unknown session"},
+		{name: "unknown verdict", raw: `{"verdict": "noise", "confidence": 0.8}`, errMsg: "is not actionable"},
+		{name: "confidence out of range", raw: `{"verdict": "actionable", "confidence": 80}`, errMsg: "out of range"},
+		{name: "no JSON", raw: "actionable", errMsg: "no JSON object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := parseNoiseVerdict(tt.raw, recent)
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVerdict, v.Verdict)
+			assert.Equal(t, tt.wantDupOf, v.DuplicateOf)
+		})
+	}
+}
+
+func TestTriageNoise(t *testing.T) {
+	cfg := &config.Config{
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"flash": {Type: config.LLMProviderTypeGoogle, Model: "flash-model"},
+		}),
+		ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{}),
+		NoiseTriage: &config.NoiseTriageConfig{
+			Enabled:            true,
+			ClassifierProvider: "flash",
+			Timeout:            time.Second,
+			DuplicateWindow:    time.Hour,
+			AlertTypes: map[string]config.NoiseTriageAlertTypeConfig{
+				"KubeJobFailed": {MinConfidence: 0.8},
+			},
+		},
+	}
+	session := func() *ent.AlertSession {
+		return &ent.AlertSession{ID: "s1", AlertType: "KubeJobFailed", AlertData: "job nightly-backup failed", CreatedAt: time.Now()}
+	}
+	verdict := func(text string) *mockLLMClient {
+		return &mockLLMClient{capture: true, responses: []mockLLMResponse{{chunks: []agent.Chunk{&agent.TextChunk{Content: text}}}}}
+	}
+
+	t.Run("confident false positive closes the session", func(t *testing.T) {
+		llm := verdict(`{"verdict": "false_positive", "confidence": 0.9, "reason": "Test job."}`)
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+
+		result := e.triageNoise(t.Context(), session(), slog.Default())
+		require.NotNil(t, result)
+		assert.Equal(t, alertsession.StatusCompleted, result.Status)
+		assert.True(t, result.NoiseTriaged)
+		assert.Contains(t, result.FinalAnalysis, "known false positive")
+		assert.Contains(t, result.FinalAnalysis, "Test job.")
+
+		require.Len(t, llm.capturedInputs, 1)
+		assert.Equal(t, "flash-model", llm.capturedInputs[0].Config.Model)
+		assert.Contains(t, llm.capturedInputs[0].Messages[1].Content, "job nightly-backup failed")
+	})
+
+	t.Run("low confidence runs the chain", func(t *testing.T) {
+		e := &RealSessionExecutor{cfg: cfg, llmClient: verdict(`{"verdict": "false_positive", "confidence": 0.5}`)}
+		assert.Nil(t, e.triageNoise(t.Context(), session(), slog.Default()))
+	})
+
+	t.Run("actionable runs the chain", func(t *testing.T) {
+		e := &RealSessionExecutor{cfg: cfg, llmClient: verdict(`{"verdict": "actionable", "confidence": 1}`)}
+		assert.Nil(t, e.triageNoise(t.Context(), session(), slog.Default()))
+	})
+
+	t.Run("classifier failure runs the chain", func(t *testing.T) {
+		llm := &mockLLMClient{responses: []mockLLMResponse{{err: errors.New("connection refused")}}}
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+		assert.Nil(t, e.triageNoise(t.Context(), session(), slog.Default()))
+	})
+
+	t.Run("untriaged alert type skips classification", func(t *testing.T) {
+		llm := verdict(`{"verdict": "false_positive", "confidence": 1}`)
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+		s := session()
+		s.AlertType = "PodCrashLooping"
+		assert.Nil(t, e.triageNoise(t.Context(), s, slog.Default()))
+		assert.Zero(t, llm.callCount)
+	})
+
+	t.Run("forced full investigation skips classification", func(t *testing.T) {
+		llm := verdict(`{"verdict": "false_positive", "confidence": 1}`)
+		e := &RealSessionExecutor{cfg: cfg, llmClient: llm}
+		s := session()
+		s.ForceFullInvestigation = true
+		assert.Nil(t, e.triageNoise(t.Context(), s, slog.Default()))
+		assert.Zero(t, llm.callCount)
+	})
+}
+
+func TestBuildNoiseTriagePrompt_MultiByteCutoff(t *testing.T) {
+	data := "x" + strings.Repeat("é", maxTriageAlertChars)
+	prompt := buildNoiseTriagePrompt(
+		&ent.AlertSession{AlertType: "KubeJobFailed", AlertData: data},
+		[]*ent.AlertSession{{ID: "session-1", Status: alertsession.StatusCompleted, AlertData: data, ExecutiveSummary: &data}},
+	)
+	assert.True(t, utf8.ValidString(prompt))
+	assert.Equal(t, 3, strings.Count(prompt, "é\n[truncated]"))
+}
+
+func TestFormatNoiseTriageAnalysis(t *testing.T) {
+	got := formatNoiseTriageAnalysis(&schema.NoiseTriageDecision{
+		Verdict:     "duplicate",
+		Confidence:  0.87,
+		Reason:      "Same job failure as an hour ago.",
+		DuplicateOf: "session-1",
+	})
+	assert.Contains(t, got, "duplicate of session session-1** (confidence 87%)")
+	assert.Contains(t, got, "Same job failure as an hour ago.")
+	assert.Contains(t, got, "force_full_investigation: true")
+}
@@ -0,0 +1,103 @@
+package queue
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	agentctx "github.com/codeready-toolchain/tarsy/pkg/agent/context"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// operatorNoteFeed delivers operator notes to a single agent execution.
+// Notes are read from the session timeline on every drain; notes the agent
+// has already seen (including those carried in its stage context) are skipped.
+type operatorNoteFeed struct {
+	client    *ent.Client
+	sessionID string
+	delivered map[string]bool
+}
+
+// newOperatorNoteFeed creates a feed for sessionID that skips the notes in
+// delivered. Returns nil when client is nil so the controller skips draining.
+func newOperatorNoteFeed(client *ent.Client, sessionID string, delivered []string) agent.OperatorNoteSource {
+	if client == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(delivered))
+	for _, id := range delivered {
+		seen[id] = true
+	}
+	return &operatorNoteFeed{client: client, sessionID: sessionID, delivered: seen}
+}
+
+// TryDrainNotes returns notes posted since the last drain as user messages.
+// Fail-open: lookup errors are logged and yield no notes.
+func (f *operatorNoteFeed) TryDrainNotes(ctx context.Context) []agent.ConversationMessage {
+	notes, err := listOperatorNotes(ctx, f.client, f.sessionID)
+	if err != nil {
+		slog.Warn("Failed to check operator notes, continuing without them",
+			"session_id", f.sessionID, "error", err)
+		return nil
+	}
+
+	var msgs []agent.ConversationMessage
+	for _, note := range notes {
+		if f.delivered[note.ID] {
+			continue
+		}
+		f.delivered[note.ID] = true
+		msgs = append(msgs, agent.ConversationMessage{
+			Role:    agent.RoleUser,
+			Content: formatOperatorNote(note),
+		})
+	}
+	return msgs
+}
+
+// withOperatorNotes appends every operator note posted so far to stageContext
+// and returns the IDs of the notes it included, so the stage's agents don't
+// receive them a second time mid-stage. Fail-open: lookup errors leave the
+// context unchanged.
+func (e *RealSessionExecutor) withOperatorNotes(ctx context.Context, sessionID, stageContext string) (string, []string) {
+	if e.dbClient == nil {
+		return stageContext, nil
+	}
+	notes, err := listOperatorNotes(ctx, e.dbClient, sessionID)
+	if err != nil {
+		slog.Warn("Failed to check operator notes, continuing without them",
+			"session_id", sessionID, "error", err)
+		return stageContext, nil
+	}
+
+	ids := make([]string, 0, len(notes))
+	for _, note := range notes {
+		if stageContext != "" {
+			stageContext += "\n\n"
+		}
+		stageContext += formatOperatorNote(note)
+		ids = append(ids, note.ID)
+	}
+	return stageContext, ids
+}
+
+func listOperatorNotes(ctx context.Context, client *ent.Client, sessionID string) ([]*ent.TimelineEvent, error) {
+	notes, err := client.TimelineEvent.Query().
+		Where(
+			timelineevent.SessionIDEQ(sessionID),
+			timelineevent.EventTypeEQ(timelineevent.EventTypeOperatorNote),
+		).
+		Order(ent.Asc(timelineevent.FieldSequenceNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return notes, services.DecompressTimelineEvents(notes...)
+}
+
+func formatOperatorNote(note *ent.TimelineEvent) string {
+	author, _ := note.Metadata[MetadataKeyAuthor].(string)
+	return agentctx.FormatOperatorNote(author, note.Content, note.CreatedAt)
+}
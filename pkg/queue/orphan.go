@@ -9,10 +9,21 @@ import (
 
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	"github.com/codeready-toolchain/tarsy/ent/stage"
 	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	tarsyslack "github.com/codeready-toolchain/tarsy/pkg/slack"
 )
 
+// RequeuedDetailSuffix is appended to the claim detail when an orphaned
+// session is requeued rather than failed.
+const RequeuedDetailSuffix = " (requeued)"
+
 // orphanState tracks orphan detection metrics (thread-safe).
 type orphanState struct {
 	mu               sync.Mutex
@@ -41,7 +52,7 @@ func (p *WorkerPool) runOrphanDetection(ctx context.Context) {
 }
 
 // detectAndRecoverOrphans finds in_progress sessions with stale heartbeats
-// and marks them as timed_out (terminal state).
+// and recovers them according to the configured orphan_recovery mode.
 func (p *WorkerPool) detectAndRecoverOrphans(ctx context.Context) error {
 	threshold := time.Now().Add(-p.config.OrphanThreshold)
 
@@ -69,14 +80,17 @@ func (p *WorkerPool) detectAndRecoverOrphans(ctx context.Context) error {
 	recovered := 0
 	failed := 0
 	for _, session := range orphans {
-		if err := p.recoverOrphanedSession(ctx, session); err != nil {
+		ok, err := p.recoverOrphanedSession(ctx, session)
+		if err != nil {
 			slog.Error("Failed to recover orphaned session",
 				"session_id", session.ID,
 				"error", err)
 			failed++
 			continue
 		}
-		recovered++
+		if ok {
+			recovered++
+		}
 	}
 
 	p.orphans.mu.Lock()
@@ -98,37 +112,35 @@ func (p *WorkerPool) detectAndRecoverOrphans(ctx context.Context) error {
 	return nil
 }
 
-// recoverOrphanedSession marks a single orphaned session as timed_out.
-func (p *WorkerPool) recoverOrphanedSession(ctx context.Context, session *ent.AlertSession) error {
+// recoverOrphanedSession applies the configured recovery to a single
+// orphaned session and notifies when this pod performed it. Returns false
+// when another pod recovered the session first.
+func (p *WorkerPool) recoverOrphanedSession(ctx context.Context, session *ent.AlertSession) (bool, error) {
 	log := slog.With("session_id", session.ID, "old_pod_id", session.PodID)
 
-	lastHeartbeat := "unknown"
-	if session.LastInteractionAt != nil {
-		lastHeartbeat = session.LastInteractionAt.Format(time.RFC3339)
-	}
-
-	podID := "unknown"
-	if session.PodID != nil {
-		podID = *session.PodID
+	reason := fmt.Sprintf("Orphaned: no heartbeat from pod %s since %s", orphanPodID(session), orphanLastHeartbeat(session))
+	status, err := recoverOrphan(ctx, p.client, p.config, session, sessionclaim.OutcomeOrphaned, reason)
+	if err != nil {
+		return false, err
 	}
-
-	errorMsg := fmt.Sprintf("Orphaned: no heartbeat from pod %s since %s", podID, lastHeartbeat)
-	if err := markSessionTimedOut(ctx, p.client, session.ID, errorMsg); err != nil {
-		return err
+	if status == "" {
+		log.Debug("Orphaned session already recovered by another pod")
+		return false, nil
 	}
 
-	log.Warn("Orphaned session marked as timed_out", "last_heartbeat", lastHeartbeat)
-	return nil
+	log.Warn("Orphaned session recovered", "status", status, "last_heartbeat", orphanLastHeartbeat(session))
+	p.notifyOrphanRecovered(ctx, session, status, reason)
+	return true, nil
 }
 
 // CleanupStartupOrphans performs a one-time cleanup of sessions owned by this pod
 // that were in-progress when the pod previously crashed.
 // Called once during startup, before the worker pool begins processing.
-func CleanupStartupOrphans(ctx context.Context, client *ent.Client, podID string) error {
-	orphans, err := client.AlertSession.Query().
+func (p *WorkerPool) CleanupStartupOrphans(ctx context.Context) error {
+	orphans, err := p.client.AlertSession.Query().
 		Where(
 			alertsession.StatusEQ(alertsession.StatusInProgress),
-			alertsession.PodIDEQ(podID),
+			alertsession.PodIDEQ(p.podID),
 			alertsession.DeletedAtIsNil(),
 		).
 		All(ctx)
@@ -141,44 +153,229 @@ func CleanupStartupOrphans(ctx context.Context, client *ent.Client, podID string
 	}
 
 	slog.Warn("Found startup orphans from previous run",
-		"pod_id", podID,
+		"pod_id", p.podID,
 		"count", len(orphans))
 
 	for _, session := range orphans {
-		errorMsg := fmt.Sprintf("Orphaned: pod %s restarted while session was in progress", podID)
-		if err := markSessionTimedOut(ctx, client, session.ID, errorMsg); err != nil {
-			slog.Error("Failed to mark startup orphan",
+		reason := fmt.Sprintf("Orphaned: pod %s restarted while session was in progress", p.podID)
+		status, err := recoverOrphan(ctx, p.client, p.config, session, sessionclaim.OutcomePodRestarted, reason)
+		if err != nil {
+			slog.Error("Failed to recover startup orphan",
 				"session_id", session.ID,
 				"error", err)
 			continue
 		}
+		if status == "" {
+			continue
+		}
 
-		slog.Info("Startup orphan recovered", "session_id", session.ID)
+		slog.Info("Startup orphan recovered", "session_id", session.ID, "status", status)
+		p.notifyOrphanRecovered(ctx, session, status, reason)
 	}
 
 	return nil
 }
 
-// markSessionTimedOut is a shared helper that marks a session as timed_out
-// and updates any streaming timeline events. Uses a transaction for atomicity.
-func markSessionTimedOut(ctx context.Context, client *ent.Client, sessionID, errorMsg string) error {
+// orphanEventPublisher is implemented by events.EventPublisher. The pool's
+// agent.EventPublisher is asserted to it so test doubles need not implement it.
+type orphanEventPublisher interface {
+	PublishSessionOrphanRecovered(ctx context.Context, sessionID string, payload events.SessionOrphanRecoveredPayload) error
+}
+
+// notifyOrphanRecovered broadcasts an orphan recovery over WebSocket and
+// Slack so crashing workers get noticed. Best-effort: errors are logged.
+func (p *WorkerPool) notifyOrphanRecovered(ctx context.Context, session *ent.AlertSession, status alertsession.Status, reason string) {
+	requeued := status == alertsession.StatusPending
+	recovery := events.OrphanRecoveryFailed
+	if requeued {
+		recovery = events.OrphanRecoveryRequeued
+	}
+
+	if p.eventPublisher != nil {
+		now := time.Now().Format(time.RFC3339Nano)
+		if err := p.eventPublisher.PublishSessionStatus(ctx, session.ID, events.SessionStatusPayload{
+			BasePayload: events.BasePayload{
+				Type:      events.EventTypeSessionStatus,
+				SessionID: session.ID,
+				Timestamp: now,
+			},
+			Status:    status,
+			ChainID:   session.ChainID,
+			AlertType: session.AlertType,
+		}); err != nil {
+			slog.Warn("Failed to publish orphan recovery status", "session_id", session.ID, "error", err)
+		}
+
+		if pub, ok := p.eventPublisher.(orphanEventPublisher); ok {
+			payload := events.SessionOrphanRecoveredPayload{
+				BasePayload: events.BasePayload{
+					Type:      events.EventTypeSessionOrphanRecovered,
+					SessionID: session.ID,
+					Timestamp: now,
+				},
+				PodID:    orphanPodID(session),
+				Recovery: recovery,
+				Reason:   reason,
+			}
+			if session.LastInteractionAt != nil {
+				payload.LastHeartbeatAt = session.LastInteractionAt.Format(time.RFC3339)
+			}
+			if err := pub.PublishSessionOrphanRecovered(ctx, session.ID, payload); err != nil {
+				slog.Warn("Failed to publish orphan recovered event", "session_id", session.ID, "error", err)
+			}
+		}
+	}
+
+	if !requeued {
+		p.callbacks.Deliver(session.ID)
+	}
+
+	p.slackService.NotifyOrphanRecovered(ctx, tarsyslack.OrphanRecoveredInput{
+		SessionID:     session.ID,
+		AlertType:     session.AlertType,
+		PodID:         orphanPodID(session),
+		LastHeartbeat: orphanLastHeartbeat(session),
+		Requeued:      requeued,
+		Reason:        reason,
+	})
+}
+
+func orphanPodID(session *ent.AlertSession) string {
+	if session.PodID != nil {
+		return *session.PodID
+	}
+	return "unknown"
+}
+
+func orphanLastHeartbeat(session *ent.AlertSession) string {
+	if session.LastInteractionAt != nil {
+		return session.LastInteractionAt.Format(time.RFC3339)
+	}
+	return "unknown"
+}
+
+// recoverOrphan applies cfg.OrphanRecovery to an orphaned session. Returns
+// the session's new status (pending when requeued, timed_out when failed),
+// or "" when the session was no longer in_progress (recovered by another pod).
+// Sandbox sessions are never requeued: their fixtures lived only in the
+// request that started them.
+func recoverOrphan(ctx context.Context, client *ent.Client, cfg *config.QueueConfig, session *ent.AlertSession, outcome sessionclaim.Outcome, reason string) (alertsession.Status, error) {
+	if cfg.OrphanRecovery == config.OrphanRecoveryRequeue && !session.Sandbox {
+		requeues, err := client.SessionClaim.Query().
+			Where(
+				sessionclaim.SessionIDEQ(session.ID),
+				sessionclaim.OutcomeIn(sessionclaim.OutcomeOrphaned, sessionclaim.OutcomePodRestarted),
+			).
+			Count(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to count previous requeues: %w", err)
+		}
+
+		if requeues < cfg.OrphanMaxRequeues {
+			ok, err := requeueOrphanedSession(ctx, client, session, outcome, reason)
+			if err != nil || !ok {
+				return "", err
+			}
+			return alertsession.StatusPending, nil
+		}
+		reason = fmt.Sprintf("%s (requeue limit of %d reached)", reason, cfg.OrphanMaxRequeues)
+	}
+
+	ok, err := markSessionTimedOut(ctx, client, session, outcome, reason)
+	if err != nil || !ok {
+		return "", err
+	}
+	return alertsession.StatusTimedOut, nil
+}
+
+// requeueOrphanedSession returns an in_progress session to pending and
+// discards the partial run (stages cascade to executions, messages, and
+// their interactions) so the next worker starts from scratch.
+// Returns false if the session was no longer in_progress.
+func requeueOrphanedSession(ctx context.Context, client *ent.Client, session *ent.AlertSession, outcome sessionclaim.Outcome, reason string) (bool, error) {
+	sessionID := session.ID
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	n, err := tx.AlertSession.Update().
+		Where(
+			alertsession.IDEQ(sessionID),
+			alertsession.StatusEQ(alertsession.StatusInProgress),
+		).
+		SetStatus(alertsession.StatusPending).
+		ClearPodID().
+		ClearStartedAt().
+		ClearLastInteractionAt().
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to requeue session: %w", err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	if err := releaseClaims(ctx, tx, sessionID, outcome, session.LastInteractionAt, reason+RequeuedDetailSuffix); err != nil {
+		return false, err
+	}
+
+	if _, err := tx.Stage.Delete().Where(stage.SessionIDEQ(sessionID)).Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to delete stages: %w", err)
+	}
+	if _, err := tx.TimelineEvent.Delete().Where(timelineevent.SessionIDEQ(sessionID)).Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to delete timeline events: %w", err)
+	}
+	if _, err := tx.LLMInteraction.Delete().Where(llminteraction.SessionIDEQ(sessionID)).Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to delete LLM interactions: %w", err)
+	}
+	if _, err := tx.MCPInteraction.Delete().Where(mcpinteraction.SessionIDEQ(sessionID)).Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to delete MCP interactions: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// markSessionTimedOut is a shared helper that marks an in_progress session
+// as timed_out, closes its open claim with outcome, and updates any
+// streaming timeline events. Uses a transaction for atomicity.
+// Returns false if the session was no longer in_progress.
+func markSessionTimedOut(ctx context.Context, client *ent.Client, session *ent.AlertSession, outcome sessionclaim.Outcome, errorMsg string) (bool, error) {
+	sessionID := session.ID
 	now := time.Now()
 
 	// Use transaction to ensure session and timeline events are updated atomically
 	tx, err := client.Tx(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
+		return false, fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer func() { _ = tx.Rollback() }()
 
 	// Mark session as timed_out (terminal — no resume)
-	err = tx.AlertSession.UpdateOneID(sessionID).
+	n, err := tx.AlertSession.Update().
+		Where(
+			alertsession.IDEQ(sessionID),
+			alertsession.StatusEQ(alertsession.StatusInProgress),
+		).
 		SetStatus(alertsession.StatusTimedOut).
 		SetCompletedAt(now).
 		SetErrorMessage(errorMsg).
-		Exec(ctx)
+		Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to mark session as timed_out: %w", err)
+		return false, fmt.Errorf("failed to mark session as timed_out: %w", err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+
+	if err := releaseClaims(ctx, tx, sessionID, outcome, session.LastInteractionAt, errorMsg); err != nil {
+		return false, err
 	}
 
 	// Mark any incomplete TimelineEvents as timed_out
@@ -190,12 +387,12 @@ func markSessionTimedOut(ctx context.Context, client *ent.Client, sessionID, err
 		SetStatus(timelineevent.StatusTimedOut).
 		SetUpdatedAt(now).
 		Exec(ctx); err != nil {
-		return fmt.Errorf("failed to update timeline events: %w", err)
+		return false, fmt.Errorf("failed to update timeline events: %w", err)
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return nil
+	return true, nil
 }
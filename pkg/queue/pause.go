@@ -0,0 +1,268 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// pauseRefreshInterval is how often each pod reloads queue pauses, bounding
+// how long a pause set through another pod takes to apply here.
+const pauseRefreshInterval = 10 * time.Second
+
+// ErrPauseUnavailable is returned when queue pauses cannot be stored.
+var ErrPauseUnavailable = errors.New("queue pause is not available")
+
+// PauseStore persists queue pauses. Implemented by services.QueuePauseService.
+type PauseStore interface {
+	List(ctx context.Context) ([]*ent.QueuePause, error)
+	Pause(ctx context.Context, scope, reason string, maintenance bool, author string) (*ent.QueuePause, error)
+	Drain(ctx context.Context, podID, reason, author string) (*ent.QueuePause, error)
+	Resume(ctx context.Context, scope string) (bool, error)
+}
+
+// QueuePause is an active pause of session claiming.
+type QueuePause struct {
+	Scope       string    `json:"scope"` // pod ID, or services.QueuePauseScopeCluster
+	Reason      string    `json:"reason,omitempty"`
+	Maintenance bool      `json:"maintenance"`
+	Drain       bool      `json:"drain"` // set by the drain API, see WorkerPool.Drain
+	PausedBy    string    `json:"paused_by,omitempty"`
+	PausedAt    time.Time `json:"paused_at"`
+}
+
+// pauseGate caches the queue pauses for the workers of one pod.
+// Nil-safe: a nil gate never pauses.
+type pauseGate struct {
+	podID string
+
+	mu      sync.RWMutex
+	pauses  []QueuePause // sorted by scope
+	warning string       // last maintenance warning raised, "" when cleared
+}
+
+// active returns the pause that stops this pod from claiming (cluster-wide
+// first), or nil.
+func (g *pauseGate) active() *QueuePause {
+	if g == nil {
+		return nil
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var pod *QueuePause
+	for i := range g.pauses {
+		switch g.pauses[i].Scope {
+		case services.QueuePauseScopeCluster:
+			p := g.pauses[i]
+			return &p
+		case g.podID:
+			p := g.pauses[i]
+			pod = &p
+		}
+	}
+	return pod
+}
+
+// all returns a copy of every cached pause.
+func (g *pauseGate) all() []QueuePause {
+	if g == nil {
+		return nil
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return slices.Clone(g.pauses)
+}
+
+// set replaces the cached pauses.
+func (g *pauseGate) set(pauses []QueuePause) {
+	slices.SortFunc(pauses, func(a, b QueuePause) int { return strings.Compare(a.Scope, b.Scope) })
+	g.mu.Lock()
+	g.pauses = pauses
+	g.mu.Unlock()
+}
+
+// upsert replaces or adds one cached pause.
+func (g *pauseGate) upsert(pause QueuePause) {
+	pauses := slices.DeleteFunc(g.all(), func(p QueuePause) bool { return p.Scope == pause.Scope })
+	g.set(append(pauses, pause))
+}
+
+// remove drops one cached pause.
+func (g *pauseGate) remove(scope string) {
+	g.set(slices.DeleteFunc(g.all(), func(p QueuePause) bool { return p.Scope == scope }))
+}
+
+// SetPauseStore enables pausing of session claiming. Maintenance pauses are
+// surfaced through warnings (may be nil). Must be called before Start.
+func (p *WorkerPool) SetPauseStore(store PauseStore, warnings *services.SystemWarningsService) {
+	p.pauseStore = store
+	p.warnings = warnings
+}
+
+// Pauses returns every active queue pause known to this pod.
+func (p *WorkerPool) Pauses() []QueuePause {
+	return p.pause.all()
+}
+
+// Pause stops claiming of new sessions on the pod with ID scope, or on every
+// pod when scope is services.QueuePauseScopeCluster. Sessions already in
+// progress run to completion. Applies on this pod immediately and on the
+// others within pauseRefreshInterval.
+func (p *WorkerPool) Pause(ctx context.Context, scope, reason string, maintenance bool, author string) (*QueuePause, error) {
+	if p.pauseStore == nil || p.pause == nil {
+		return nil, ErrPauseUnavailable
+	}
+	row, err := p.pauseStore.Pause(ctx, scope, reason, maintenance, author)
+	if err != nil {
+		return nil, err
+	}
+
+	pause := pauseFromEnt(row)
+	before := p.pause.active()
+	p.pause.upsert(pause)
+	p.applyPauses(before)
+	slog.Info("Queue pause set", "scope", scope, "reason", reason, "maintenance", maintenance, "author", author)
+	return &pause, nil
+}
+
+// Resume removes the pause for scope. Returns false if scope was not paused.
+func (p *WorkerPool) Resume(ctx context.Context, scope, author string) (bool, error) {
+	if p.pauseStore == nil || p.pause == nil {
+		return false, ErrPauseUnavailable
+	}
+	removed, err := p.pauseStore.Resume(ctx, scope)
+	if err != nil {
+		return false, err
+	}
+
+	before := p.pause.active()
+	p.pause.remove(scope)
+	p.applyPauses(before)
+	if removed {
+		slog.Info("Queue pause removed", "scope", scope, "author", author)
+	}
+	return removed, nil
+}
+
+// refreshPauses reloads queue pauses from the store.
+func (p *WorkerPool) refreshPauses(ctx context.Context) error {
+	rows, err := p.pauseStore.List(ctx)
+	if err != nil {
+		return err
+	}
+	pauses := make([]QueuePause, 0, len(rows))
+	for _, row := range rows {
+		pauses = append(pauses, pauseFromEnt(row))
+	}
+
+	before := p.pause.active()
+	p.pause.set(pauses)
+	p.applyPauses(before)
+	return nil
+}
+
+// runPauseRefresh periodically reloads queue pauses. The last known pauses
+// are kept when the store is unreachable.
+func (p *WorkerPool) runPauseRefresh(ctx context.Context) {
+	ticker := time.NewTicker(pauseRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			if err := p.refreshPauses(ctx); err != nil && ctx.Err() == nil {
+				slog.Warn("Failed to refresh queue pauses", "pod_id", p.podID, "error", err)
+			}
+		}
+	}
+}
+
+// applyPauses logs claiming transitions of this pod (before is the pause in
+// force prior to the change) and syncs the maintenance warning.
+func (p *WorkerPool) applyPauses(before *QueuePause) {
+	after := p.pause.active()
+	switch {
+	case before == nil && after != nil:
+		slog.Warn("Session claiming paused; in-flight sessions continue",
+			"pod_id", p.podID, "scope", after.Scope, "reason", after.Reason)
+	case before != nil && after == nil:
+		slog.Info("Session claiming resumed", "pod_id", p.podID)
+	}
+
+	if p.warnings == nil {
+		return
+	}
+	message, details := maintenanceWarning(p.pause.all())
+	p.pause.mu.Lock()
+	defer p.pause.mu.Unlock()
+	if message+details == p.pause.warning {
+		return
+	}
+	p.pause.warning = message + details
+	if message == "" {
+		p.warnings.ClearByServerID(services.WarningCategoryMaintenance, "")
+		return
+	}
+	p.warnings.AddWarning(services.WarningCategoryMaintenance, message, details, "")
+}
+
+// maintenanceWarning describes the maintenance pauses, or returns empty
+// strings when there are none.
+func maintenanceWarning(pauses []QueuePause) (message, details string) {
+	var lines []string
+	cluster := false
+	for _, pause := range pauses {
+		if !pause.Maintenance {
+			continue
+		}
+		line := pause.Scope
+		if pause.Scope == services.QueuePauseScopeCluster {
+			cluster = true
+			line = "all pods"
+		}
+		if pause.Reason != "" {
+			line += ": " + pause.Reason
+		}
+		if pause.PausedBy != "" {
+			line += " (by " + pause.PausedBy + ")"
+		}
+		lines = append(lines, line)
+	}
+	switch {
+	case len(lines) == 0:
+		return "", ""
+	case cluster:
+		message = "Maintenance mode: new sessions are queued but not started"
+	default:
+		message = fmt.Sprintf("Maintenance mode: %d pod(s) are not starting new sessions", len(lines))
+	}
+	return message, strings.Join(lines, "; ")
+}
+
+func pauseFromEnt(row *ent.QueuePause) QueuePause {
+	pause := QueuePause{
+		Scope:       row.ID,
+		Maintenance: row.Maintenance,
+		Drain:       row.Drain,
+		PausedAt:    row.PausedAt,
+	}
+	if row.Reason != nil {
+		pause.Reason = *row.Reason
+	}
+	if row.PausedBy != nil {
+		pause.PausedBy = *row.PausedBy
+	}
+	return pause
+}
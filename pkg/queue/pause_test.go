@@ -0,0 +1,145 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// memPauseStore is an in-memory PauseStore.
+type memPauseStore map[string]*ent.QueuePause
+
+func (m memPauseStore) List(context.Context) ([]*ent.QueuePause, error) {
+	var out []*ent.QueuePause
+	for _, r := range m {
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (m memPauseStore) Pause(_ context.Context, scope, reason string, maintenance bool, author string) (*ent.QueuePause, error) {
+	row := &ent.QueuePause{ID: scope, Maintenance: maintenance, PausedBy: &author, PausedAt: time.Now()}
+	if reason != "" {
+		row.Reason = &reason
+	}
+	m[scope] = row
+	return row, nil
+}
+
+func (m memPauseStore) Drain(_ context.Context, podID, reason, author string) (*ent.QueuePause, error) {
+	row := &ent.QueuePause{ID: podID, Drain: true, PausedBy: &author, PausedAt: time.Now()}
+	if reason != "" {
+		row.Reason = &reason
+	}
+	m[podID] = row
+	return row, nil
+}
+
+func (m memPauseStore) Resume(_ context.Context, scope string) (bool, error) {
+	_, ok := m[scope]
+	delete(m, scope)
+	return ok, nil
+}
+
+func TestPauseGateActive(t *testing.T) {
+	var nilGate *pauseGate
+	assert.Nil(t, nilGate.active())
+
+	g := &pauseGate{podID: "pod-a"}
+	assert.Nil(t, g.active())
+
+	g.set([]QueuePause{{Scope: "pod-b"}})
+	assert.Nil(t, g.active(), "other pods' pauses do not apply")
+
+	g.upsert(QueuePause{Scope: "pod-a", Reason: "draining"})
+	require.NotNil(t, g.active())
+	assert.Equal(t, "pod-a", g.active().Scope)
+
+	g.upsert(QueuePause{Scope: services.QueuePauseScopeCluster, Reason: "deploy"})
+	assert.Equal(t, services.QueuePauseScopeCluster, g.active().Scope, "cluster-wide pause takes precedence")
+
+	g.remove(services.QueuePauseScopeCluster)
+	g.remove("pod-a")
+	assert.Nil(t, g.active())
+	assert.Len(t, g.all(), 1)
+}
+
+func TestWorkerPoolPauseResume(t *testing.T) {
+	store := memPauseStore{}
+	warnings := services.NewSystemWarningsService()
+	pool := NewWorkerPool("pod-a", nil, &config.QueueConfig{}, nil, nil, nil, nil)
+	pool.SetPauseStore(store, warnings)
+	ctx := context.Background()
+
+	worker := &Worker{pause: pool.pause}
+
+	t.Run("pod pause stops claiming without a banner", func(t *testing.T) {
+		_, err := pool.Pause(ctx, "pod-a", "draining", false, "alice")
+		require.NoError(t, err)
+		assert.ErrorIs(t, worker.pollAndProcess(ctx), ErrQueuePaused)
+		assert.Empty(t, warnings.GetWarnings())
+	})
+
+	t.Run("maintenance pause raises a banner", func(t *testing.T) {
+		_, err := pool.Pause(ctx, services.QueuePauseScopeCluster, "provider outage", true, "bob")
+		require.NoError(t, err)
+
+		ws := warnings.GetWarnings()
+		require.Len(t, ws, 1)
+		assert.Equal(t, services.WarningCategoryMaintenance, ws[0].Category)
+		assert.Equal(t, "Maintenance mode: new sessions are queued but not started", ws[0].Message)
+		assert.Equal(t, "all pods: provider outage (by bob)", ws[0].Details)
+		assert.Len(t, pool.Pauses(), 2)
+	})
+
+	t.Run("pauses set by other pods are picked up on refresh", func(t *testing.T) {
+		_, err := store.Pause(ctx, "pod-b", "", true, "carol")
+		require.NoError(t, err)
+		require.NoError(t, pool.refreshPauses(ctx))
+
+		ws := warnings.GetWarnings()
+		require.Len(t, ws, 1)
+		assert.Equal(t, "all pods: provider outage (by bob); pod-b (by carol)", ws[0].Details)
+	})
+
+	t.Run("resume clears the pause and the banner", func(t *testing.T) {
+		for _, scope := range []string{services.QueuePauseScopeCluster, "pod-a", "pod-b"} {
+			removed, err := pool.Resume(ctx, scope, "alice")
+			require.NoError(t, err)
+			assert.True(t, removed)
+		}
+		assert.Nil(t, pool.pause.active())
+		assert.Empty(t, pool.Pauses())
+		assert.Empty(t, warnings.GetWarnings())
+
+		removed, err := pool.Resume(ctx, "pod-a", "alice")
+		require.NoError(t, err)
+		assert.False(t, removed)
+	})
+
+	t.Run("unavailable without a store", func(t *testing.T) {
+		p := NewWorkerPool("pod-a", nil, &config.QueueConfig{}, nil, nil, nil, nil)
+		_, err := p.Pause(ctx, "pod-a", "", false, "alice")
+		assert.ErrorIs(t, err, ErrPauseUnavailable)
+	})
+}
+
+func TestMaintenanceWarning(t *testing.T) {
+	msg, details := maintenanceWarning([]QueuePause{{Scope: "pod-a"}})
+	assert.Empty(t, msg, "plain pauses are not surfaced")
+	assert.Empty(t, details)
+
+	msg, details = maintenanceWarning([]QueuePause{
+		{Scope: "pod-a", Maintenance: true, Reason: "node drain"},
+		{Scope: "pod-b", Maintenance: true},
+	})
+	assert.Equal(t, "Maintenance mode: 2 pod(s) are not starting new sessions", msg)
+	assert.Equal(t, "pod-a: node drain; pod-b", details)
+}
@@ -9,7 +9,19 @@ import (
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/callback"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/email"
+	"github.com/codeready-toolchain/tarsy/pkg/fanout"
+	"github.com/codeready-toolchain/tarsy/pkg/followup"
+	"github.com/codeready-toolchain/tarsy/pkg/milestone"
+	"github.com/codeready-toolchain/tarsy/pkg/oncall"
+	"github.com/codeready-toolchain/tarsy/pkg/outcome"
+	"github.com/codeready-toolchain/tarsy/pkg/pagerduty"
+	"github.com/codeready-toolchain/tarsy/pkg/recurrence"
+	"github.com/codeready-toolchain/tarsy/pkg/resultexport"
+	"github.com/codeready-toolchain/tarsy/pkg/savedview"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
 	tarsyslack "github.com/codeready-toolchain/tarsy/pkg/slack"
 )
 
@@ -22,6 +34,21 @@ type WorkerPool struct {
 	scoringExecutor *ScoringExecutor
 	eventPublisher  agent.EventPublisher
 	slackService    *tarsyslack.Service
+	pagerDuty       *pagerduty.Service
+	routing         *config.NotificationRoutingConfig
+	emailService    *email.Service
+	savedViews      *savedview.Notifier
+	milestones      *milestone.Notifier
+	fanOut          *fanout.Synthesizer
+	callbacks       *callback.Deliverer
+	recurrence      *recurrence.Comparer
+	outcomes        *outcome.Classifier
+	actionItems     *followup.Extractor
+	onCall          *oncall.Service
+	resultExport    *resultexport.Exporter
+	pauseStore      PauseStore                      // nil = pausing disabled
+	warnings        *services.SystemWarningsService // nil = no maintenance banner
+	pause           *pauseGate
 	workers         []*Worker
 	stopCh          chan struct{}
 	stopOnce        sync.Once
@@ -52,10 +79,85 @@ func NewWorkerPool(podID string, client *ent.Client, cfg *config.QueueConfig, ex
 		workers:         make([]*Worker, 0, cfg.WorkerCount),
 		stopCh:          make(chan struct{}),
 		activeSessions:  make(map[string]context.CancelFunc),
+		pause:           &pauseGate{podID: podID},
 	}
 }
 
-// Start spawns worker goroutines and the orphan detection background task.
+// SetNotificationRouting configures severity-based routing of terminal
+// notifications. pagerDutyService may be nil (PagerDuty disabled).
+// Must be called before Start.
+func (p *WorkerPool) SetNotificationRouting(routing *config.NotificationRoutingConfig, pagerDutyService *pagerduty.Service) {
+	p.routing = routing
+	p.pagerDuty = pagerDutyService
+}
+
+// SetEmailService configures per-chain email notifications.
+// emailService may be nil (email disabled). Must be called before Start.
+func (p *WorkerPool) SetEmailService(emailService *email.Service) {
+	p.emailService = emailService
+}
+
+// SetSavedViewNotifier configures saved view match notifications on session
+// status transitions. notifier may be nil (disabled). Must be called before Start.
+func (p *WorkerPool) SetSavedViewNotifier(notifier *savedview.Notifier) {
+	p.savedViews = notifier
+}
+
+// SetMilestoneNotifier configures per-chain notifications on intermediate
+// session milestones. notifier may be nil (disabled). Must be called before Start.
+func (p *WorkerPool) SetMilestoneNotifier(notifier *milestone.Notifier) {
+	p.milestones = notifier
+}
+
+// SetFanOutSynthesizer configures the cross-session synthesis of fan-out
+// session groups. synthesizer may be nil (disabled). Must be called before Start.
+func (p *WorkerPool) SetFanOutSynthesizer(synthesizer *fanout.Synthesizer) {
+	p.fanOut = synthesizer
+}
+
+// SetCallbackDeliverer configures completion callbacks for terminal sessions.
+// deliverer may be nil (disabled). Must be called before Start.
+func (p *WorkerPool) SetCallbackDeliverer(deliverer *callback.Deliverer) {
+	p.callbacks = deliverer
+}
+
+// SetRecurrenceComparer configures the comparison of completed sessions
+// with the previous investigation of the same alert. comparer may be nil
+// (disabled). Must be called before Start.
+func (p *WorkerPool) SetRecurrenceComparer(comparer *recurrence.Comparer) {
+	p.recurrence = comparer
+}
+
+// SetOutcomeClassifier configures the outcome taxonomy labelling of
+// completed sessions. classifier may be nil (disabled). Must be called
+// before Start.
+func (p *WorkerPool) SetOutcomeClassifier(classifier *outcome.Classifier) {
+	p.outcomes = classifier
+}
+
+// SetActionItemExtractor configures the extraction of follow-up action
+// items from completed sessions. extractor may be nil (disabled). Must be
+// called before Start.
+func (p *WorkerPool) SetActionItemExtractor(extractor *followup.Extractor) {
+	p.actionItems = extractor
+}
+
+// SetOnCallService configures the lookup of the on-call engineer when a
+// session is claimed. service may be nil (disabled). Must be called before
+// Start.
+func (p *WorkerPool) SetOnCallService(service *oncall.Service) {
+	p.onCall = service
+}
+
+// SetResultExporter configures the export of final session results to the
+// chains' result_export destinations. exporter may be nil (disabled).
+// Must be called before Start.
+func (p *WorkerPool) SetResultExporter(exporter *resultexport.Exporter) {
+	p.resultExport = exporter
+}
+
+// Start spawns worker goroutines and the orphan detection (and, when
+// configured, stale-session auto-cancel) background tasks.
 // It is safe to call multiple times; subsequent calls are no-ops.
 func (p *WorkerPool) Start(ctx context.Context) error {
 	if p.started {
@@ -66,9 +168,40 @@ func (p *WorkerPool) Start(ctx context.Context) error {
 
 	slog.Info("Starting worker pool", "pod_id", p.podID, "worker_count", p.config.WorkerCount)
 
+	// Load pauses before the first poll so a pod starting during a pause
+	// does not claim sessions.
+	if p.pauseStore != nil {
+		if err := p.refreshPauses(ctx); err != nil {
+			slog.Warn("Failed to load queue pauses", "pod_id", p.podID, "error", err)
+		}
+	}
+
+	// Idle workers claim through one claimer, which batches their claims
+	// into one transaction per round and backs off while the queue is empty.
+	claims := newClaimer(p.podID, p.client, p.config, p.pause, p.stopCh)
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		claims.run(ctx)
+	}()
+
 	for i := 0; i < p.config.WorkerCount; i++ {
 		workerID := fmt.Sprintf("%s-worker-%d", p.podID, i)
 		worker := NewWorker(workerID, p.podID, p.client, p.config, p.sessionExecutor, p.scoringExecutor, p, p.eventPublisher, p.slackService)
+		worker.routing = p.routing
+		worker.pagerDuty = p.pagerDuty
+		worker.emailService = p.emailService
+		worker.savedViews = p.savedViews
+		worker.milestones = p.milestones
+		worker.fanOut = p.fanOut
+		worker.callbacks = p.callbacks
+		worker.resultExport = p.resultExport
+		worker.recurrence = p.recurrence
+		worker.outcomes = p.outcomes
+		worker.actionItems = p.actionItems
+		worker.onCall = p.onCall
+		worker.pause = p.pause
+		worker.claimer = claims
 		p.workers = append(p.workers, worker)
 		worker.Start(ctx)
 	}
@@ -80,6 +213,24 @@ func (p *WorkerPool) Start(ctx context.Context) error {
 		p.runOrphanDetection(ctx)
 	}()
 
+	// Start reloading queue pauses set through other pods
+	if p.pauseStore != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.runPauseRefresh(ctx)
+		}()
+	}
+
+	// Start TTL-based auto-cancellation of stale pending sessions
+	if p.config.AutoCancel != nil && p.config.AutoCancel.MinTTL() > 0 {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.runAutoCancel(ctx)
+		}()
+	}
+
 	slog.Info("Worker pool started")
 	return nil
 }
@@ -135,6 +286,19 @@ func (p *WorkerPool) CancelSession(sessionID string) bool {
 	return false
 }
 
+// PodID returns the identifier of the pod this pool runs on.
+func (p *WorkerPool) PodID() string {
+	return p.podID
+}
+
+// ActiveSessionCount returns the number of sessions processing on this pod.
+// Unlike Health, it does not query the database.
+func (p *WorkerPool) ActiveSessionCount() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.activeSessions)
+}
+
 // Health returns the current health status of the pool.
 func (p *WorkerPool) Health() *PoolHealth {
 	ctx := context.Background()
@@ -182,6 +346,8 @@ func (p *WorkerPool) Health() *PoolHealth {
 	orphansRecovered := p.orphans.orphansRecovered
 	p.orphans.mu.Unlock()
 
+	pause := p.pause.active()
+
 	var dbError string
 	if !dbHealthy {
 		if errQ != nil {
@@ -204,6 +370,8 @@ func (p *WorkerPool) Health() *PoolHealth {
 		WorkerStats:      workerStats,
 		LastOrphanScan:   lastOrphanScan,
 		OrphansRecovered: orphansRecovered,
+		ClaimingPaused:   pause != nil,
+		Pause:            pause,
 	}
 }
 
@@ -0,0 +1,228 @@
+package queue
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// Preemptor frees workers for critical alerts when the queue backs up: once
+// queue.preemption.backlog_threshold sessions are pending, it cancels the
+// oldest, lowest-priority in-flight sessions that have run past the deadline,
+// one for each pending session of a higher priority. Preempted sessions end
+// auto_cancelled with the reason recorded. Leader-elected, so a single pod
+// decides; the cancellation reaches the owning pod through the cancel NOTIFY.
+type Preemptor struct {
+	client   *ent.Client
+	cfg      *config.PreemptionConfig
+	notifier events.SessionCancelNotifier
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// preemption is an in-flight session picked to make room for a pending one.
+type preemption struct {
+	session  *ent.AlertSession
+	priority int
+	// forAlertType and forPriority describe the pending session it makes room for.
+	forAlertType string
+	forPriority  int
+}
+
+// NewPreemptor creates the preemption job. Returns nil when preemption is
+// not enabled.
+func NewPreemptor(client *ent.Client, cfg *config.QueueConfig, notifier events.SessionCancelNotifier) *Preemptor {
+	if cfg == nil || !cfg.Preemption.Active() {
+		return nil
+	}
+	return &Preemptor{client: client, cfg: cfg.Preemption, notifier: notifier}
+}
+
+// Start runs the check loop. Implements coordination.Job.
+func (p *Preemptor) Start(ctx context.Context) {
+	if p == nil || p.cancel != nil {
+		return
+	}
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	go p.run(ctx)
+}
+
+// Stop signals the check loop to exit and waits for it to finish.
+func (p *Preemptor) Stop() {
+	if p == nil || p.cancel == nil {
+		return
+	}
+	p.cancel()
+	<-p.done
+	p.cancel = nil
+}
+
+func (p *Preemptor) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.check(ctx); err != nil {
+				slog.Error("Session preemption check failed", "error", err)
+			}
+		}
+	}
+}
+
+// check preempts in-flight sessions when the backlog is over the threshold.
+func (p *Preemptor) check(ctx context.Context) error {
+	pending, err := p.client.AlertSession.Query().
+		Where(
+			alertsession.StatusEQ(alertsession.StatusPending),
+			alertsession.Sandbox(false),
+			alertsession.DeletedAtIsNil(),
+		).
+		Select(alertsession.FieldAlertType).
+		Strings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query pending sessions: %w", err)
+	}
+	if len(pending) < p.cfg.BacklogThreshold {
+		return nil
+	}
+
+	now := time.Now()
+	running, err := p.client.AlertSession.Query().
+		Where(
+			alertsession.StatusEQ(alertsession.StatusInProgress),
+			alertsession.Sandbox(false),
+			alertsession.StartedAtLT(now.Add(-p.cfg.Deadline)),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query in-flight sessions: %w", err)
+	}
+
+	for _, pr := range selectPreemptions(p.cfg, pending, running, now) {
+		session := pr.session
+		reason := fmt.Sprintf("preempted after running %s to free a worker for a higher-priority %s alert (priority %d over %d, %d sessions queued)",
+			now.Sub(*session.StartedAt).Round(time.Second), pr.forAlertType, pr.forPriority, pr.priority, len(pending))
+		ok, err := services.PreemptSession(ctx, p.client, session.ID, reason)
+		if err != nil {
+			slog.Error("Failed to preempt session", "session_id", session.ID, "error", err)
+			continue
+		}
+		if !ok {
+			// Finished or cancelled in the meantime.
+			continue
+		}
+
+		metrics.SessionsPreemptedTotal.WithLabelValues(session.AlertType).Inc()
+		if p.notifier != nil {
+			if err := p.notifier.NotifyCancelSession(ctx, events.CancellationPayload{
+				SessionID: session.ID,
+				Initiator: string(alertsession.CancelInitiatorPreemption),
+				Reason:    reason,
+			}); err != nil {
+				slog.Warn("Failed to broadcast preemption", "session_id", session.ID, "error", err)
+			}
+		}
+		slog.Info("Preempted in-flight session",
+			"session_id", session.ID,
+			"alert_type", session.AlertType,
+			"priority", pr.priority,
+			"for_alert_type", pr.forAlertType,
+			"backlog", len(pending))
+	}
+	return nil
+}
+
+// selectPreemptions picks the in-flight sessions to preempt given the alert
+// types of the pending sessions. Sessions that have run past the deadline are
+// taken lowest priority first, then oldest first; each makes room for one
+// pending session of a strictly higher priority, most critical first, up to
+// max_per_check. Nothing is picked while the backlog is under the threshold.
+func selectPreemptions(pc *config.PreemptionConfig, pending []string, running []*ent.AlertSession, now time.Time) []preemption {
+	if len(pending) < pc.BacklogThreshold {
+		return nil
+	}
+
+	waiting := make([]int, len(pending))
+	for i := range pending {
+		waiting[i] = i
+	}
+	slices.SortStableFunc(waiting, func(a, b int) int {
+		return cmp.Compare(pc.PriorityFor(pending[b]), pc.PriorityFor(pending[a]))
+	})
+
+	var candidates []*ent.AlertSession
+	for _, s := range running {
+		if s.StartedAt != nil && now.Sub(*s.StartedAt) >= pc.Deadline {
+			candidates = append(candidates, s)
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b *ent.AlertSession) int {
+		if c := cmp.Compare(pc.PriorityFor(a.AlertType), pc.PriorityFor(b.AlertType)); c != 0 {
+			return c
+		}
+		return a.StartedAt.Compare(*b.StartedAt)
+	})
+
+	var picked []preemption
+	for _, i := range waiting {
+		if len(picked) >= pc.MaxPerCheck || len(candidates) == 0 {
+			break
+		}
+		forPriority := pc.PriorityFor(pending[i])
+		priority := pc.PriorityFor(candidates[0].AlertType)
+		if priority >= forPriority {
+			// Pending sessions are sorted most critical first; none left outranks it
+			break
+		}
+		picked = append(picked, preemption{
+			session:      candidates[0],
+			priority:     priority,
+			forAlertType: pending[i],
+			forPriority:  forPriority,
+		})
+		candidates = candidates[1:]
+	}
+	return picked
+}
+
+// priorityOrder orders sessions by descending alert type priority, so
+// workers claim the most critical pending alerts first while preemption is
+// enabled. Priorities are config integers and are inlined; alert types are
+// bound as arguments.
+func priorityOrder(pc *config.PreemptionConfig) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if len(pc.AlertTypePriorities) == 0 {
+			return
+		}
+		// ExprFunc rather than OrderExprFunc, which drops the bound arguments
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("CASE ").WriteString(s.C(alertsession.FieldAlertType))
+			for _, alertType := range slices.Sorted(maps.Keys(pc.AlertTypePriorities)) {
+				b.WriteString(" WHEN ").Arg(alertType).
+					WriteString(" THEN ").WriteString(strconv.Itoa(pc.AlertTypePriorities[alertType]))
+			}
+			b.WriteString(" ELSE ").WriteString(strconv.Itoa(pc.DefaultPriority)).WriteString(" END DESC")
+		}))
+	}
+}
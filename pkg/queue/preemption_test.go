@@ -0,0 +1,125 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestNewPreemptor_Disabled(t *testing.T) {
+	assert.Nil(t, NewPreemptor(nil, nil, nil))
+	assert.Nil(t, NewPreemptor(nil, config.DefaultQueueConfig(), nil))
+	assert.Nil(t, NewPreemptor(nil, &config.QueueConfig{Preemption: &config.PreemptionConfig{}}, nil))
+
+	// Nil preemptor is a no-op job
+	var p *Preemptor
+	p.Start(t.Context())
+	p.Stop()
+}
+
+func TestSelectPreemptions(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	pc := &config.PreemptionConfig{
+		Enabled:          true,
+		BacklogThreshold: 3,
+		Deadline:         10 * time.Minute,
+		MaxPerCheck:      2,
+		DefaultPriority:  1,
+		AlertTypePriorities: map[string]int{
+			"NodeDown":   10,
+			"DiskFull":   5,
+			"LowLatency": 0,
+		},
+	}
+	running := func(id, alertType string, ranFor time.Duration) *ent.AlertSession {
+		started := now.Add(-ranFor)
+		return &ent.AlertSession{ID: id, AlertType: alertType, StartedAt: &started}
+	}
+	ids := func(picked []preemption) []string {
+		out := []string{}
+		for _, p := range picked {
+			out = append(out, p.session.ID)
+		}
+		return out
+	}
+
+	t.Run("backlog under threshold", func(t *testing.T) {
+		picked := selectPreemptions(pc, []string{"NodeDown", "NodeDown"},
+			[]*ent.AlertSession{running("s1", "LowLatency", time.Hour)}, now)
+		assert.Empty(t, picked)
+	})
+
+	t.Run("oldest lowest priority first", func(t *testing.T) {
+		picked := selectPreemptions(pc, []string{"Other", "NodeDown", "NodeDown"},
+			[]*ent.AlertSession{
+				running("default-old", "Other", 2*time.Hour),
+				running("low-new", "LowLatency", 20*time.Minute),
+				running("low-old", "LowLatency", time.Hour),
+			}, now)
+		require.Len(t, picked, 2)
+		assert.Equal(t, []string{"low-old", "low-new"}, ids(picked))
+		assert.Equal(t, "NodeDown", picked[0].forAlertType)
+		assert.Equal(t, 10, picked[0].forPriority)
+		assert.Equal(t, 0, picked[0].priority)
+	})
+
+	t.Run("sessions under the deadline are kept", func(t *testing.T) {
+		picked := selectPreemptions(pc, []string{"NodeDown", "NodeDown", "NodeDown"},
+			[]*ent.AlertSession{
+				running("young", "LowLatency", 5*time.Minute),
+				running("old", "Other", time.Hour),
+			}, now)
+		assert.Equal(t, []string{"old"}, ids(picked))
+	})
+
+	t.Run("one preemption per higher-priority pending session", func(t *testing.T) {
+		picked := selectPreemptions(pc, []string{"DiskFull", "Other", "Other"},
+			[]*ent.AlertSession{
+				running("low-1", "LowLatency", time.Hour),
+				running("low-2", "LowLatency", time.Hour),
+			}, now)
+		// DiskFull outranks LowLatency; the default-priority alerts outrank
+		// it too, but max_per_check stops at two.
+		assert.Len(t, picked, 2)
+
+		picked = selectPreemptions(pc, []string{"LowLatency", "LowLatency", "DiskFull"},
+			[]*ent.AlertSession{
+				running("low-1", "LowLatency", time.Hour),
+				running("low-2", "LowLatency", time.Hour),
+			}, now)
+		assert.Equal(t, []string{"low-1"}, ids(picked))
+	})
+
+	t.Run("equal priority is never preempted", func(t *testing.T) {
+		picked := selectPreemptions(pc, []string{"NodeDown", "NodeDown", "NodeDown"},
+			[]*ent.AlertSession{running("s1", "NodeDown", time.Hour)}, now)
+		assert.Empty(t, picked)
+	})
+}
+
+func TestPriorityOrder(t *testing.T) {
+	pc := &config.PreemptionConfig{
+		DefaultPriority:     1,
+		AlertTypePriorities: map[string]int{"NodeDown": 10, "DiskFull": 5},
+	}
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("alert_sessions")).
+		Where(sql.EQ("status", "pending"))
+	priorityOrder(pc)(s)
+
+	query, args := s.Query()
+	assert.Contains(t, query, `ORDER BY CASE "alert_sessions"."alert_type" WHEN $2 THEN 5 WHEN $3 THEN 10 ELSE 1 END DESC`)
+	assert.Equal(t, []any{"pending", "DiskFull", "NodeDown"}, args)
+
+	// Without per-type priorities every session ranks the same
+	s = sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("alert_sessions"))
+	priorityOrder(&config.PreemptionConfig{DefaultPriority: 1})(s)
+	query, _ = s.Query()
+	assert.NotContains(t, query, "ORDER BY")
+}
@@ -0,0 +1,261 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/event"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/masking"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+)
+
+// sandboxWorkerID identifies sandbox runs in session claims.
+const sandboxWorkerID = "sandbox"
+
+// SandboxRun is what a sandbox session runs against: canned MCP tools and,
+// optionally, a scripted LLM.
+type SandboxRun struct {
+	Fixtures  map[string][]mcp.ToolFixture // server ID → tools; servers without fixtures serve none
+	LLMScript []agent.ScriptedResponse     // nil = the configured LLM providers
+}
+
+// SandboxRunner runs sandbox sessions synchronously, outside the worker
+// pool. Tool calls go to in-memory MCP servers serving the run's fixtures,
+// remote stages and memory tools are disabled, and no notifications,
+// callbacks, scoring or result exports follow the run, so a chain can be
+// exercised end to end without touching production systems.
+type SandboxRunner struct {
+	client         *ent.Client
+	executor       *RealSessionExecutor
+	config         *config.QueueConfig
+	podID          string
+	pool           SessionRegistry  // nil = sandbox runs cannot be cancelled through the API
+	maskingService *masking.Service // nil = tool results are not masked
+}
+
+// NewSandboxRunner creates a SandboxRunner that derives its executors from
+// executor. pool registers runs for API cancellation and may be nil.
+// maskingService may be nil (masking disabled).
+func NewSandboxRunner(client *ent.Client, executor *RealSessionExecutor, cfg *config.QueueConfig, podID string, pool SessionRegistry, maskingService *masking.Service) *SandboxRunner {
+	return &SandboxRunner{
+		client:         client,
+		executor:       executor,
+		config:         cfg,
+		podID:          podID,
+		pool:           pool,
+		maskingService: maskingService,
+	}
+}
+
+// Run claims a pending sandbox session, executes it against run and writes
+// its terminal status. The session's stages, timeline and interactions are
+// stored like any other session's, so its trace is read through the usual
+// endpoints. Returns an error only when the session cannot be claimed or
+// finalized.
+func (r *SandboxRunner) Run(ctx context.Context, session *ent.AlertSession, run SandboxRun) (*ExecutionResult, error) {
+	if !session.Sandbox {
+		return nil, fmt.Errorf("session %s is not a sandbox session", session.ID)
+	}
+	session, err := r.claim(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	reqID := ""
+	if session.RequestID != nil {
+		reqID = *session.RequestID
+	}
+	ctx = requestid.WithContext(ctx, reqID)
+	log := slog.With("session_id", session.ID, "chain_id", session.ChainID)
+	log.Info("Sandbox run started", "fixture_servers", len(run.Fixtures), "scripted_llm", run.LLMScript != nil)
+
+	llmClient := r.executor.llmClient
+	if run.LLMScript != nil {
+		llmClient = agent.NewScriptedLLMClient(run.LLMScript)
+	}
+	executor := r.executor.sandboxed(
+		mcp.NewFixtureClientFactory(r.executor.cfg.MCPServerRegistry, r.maskingService, run.Fixtures),
+		llmClient,
+	)
+
+	sessionCtx, cancelSession := context.WithTimeout(ctx, r.config.SessionTimeout)
+	defer cancelSession()
+	if r.pool != nil {
+		r.pool.RegisterSession(session.ID, cancelSession)
+		defer r.pool.UnregisterSession(session.ID)
+	}
+
+	heartbeatCtx, cancelHeartbeat := context.WithCancel(sessionCtx)
+	defer cancelHeartbeat()
+	go r.runHeartbeat(heartbeatCtx, session.ID)
+
+	result := executor.Execute(sessionCtx, session)
+	if result == nil {
+		result = &ExecutionResult{Status: alertsession.StatusFailed, Error: errors.New("executor returned nil result")}
+	}
+	result = applySafetyNet(result, sessionCtx.Err(), r.config.SessionTimeout)
+	cancelHeartbeat()
+
+	finalizeCtx, finalizeCancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer finalizeCancel()
+	if err := r.finalize(finalizeCtx, session.ID, result); err != nil {
+		return nil, err
+	}
+	r.scheduleEventCleanup(session.ID)
+
+	log.Info("Sandbox run complete", "status", result.Status)
+	return result, nil
+}
+
+// sandboxed returns a copy of e that calls tools through mcpFactory and the
+// LLM through llmClient. Memory is disabled so the run neither reads nor
+// stores investigation memories, and remote stages fail. Provider health and
+// system warnings are left out so a sandbox run cannot degrade providers or
+// raise warnings for production sessions.
+func (e *RealSessionExecutor) sandboxed(mcpFactory *mcp.ClientFactory, llmClient agent.LLMClient) *RealSessionExecutor {
+	c := *e
+	c.mcpFactory = mcpFactory
+	c.llmClient = llmClient
+	c.memoryService = nil
+	c.memoryConfig = nil
+	c.federation = nil
+	c.providerHealth = nil
+	c.timeWarner = &timeWarner{eventPublisher: e.eventPublisher}
+	return &c
+}
+
+// claim moves a pending sandbox session to in_progress on this pod.
+func (r *SandboxRunner) claim(ctx context.Context, session *ent.AlertSession) (*ent.AlertSession, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now()
+	n, err := tx.AlertSession.Update().
+		Where(
+			alertsession.IDEQ(session.ID),
+			alertsession.StatusEQ(alertsession.StatusPending),
+			alertsession.Sandbox(true),
+		).
+		SetStatus(alertsession.StatusInProgress).
+		SetPodID(r.podID).
+		SetStartedAt(now).
+		SetLastInteractionAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim sandbox session: %w", err)
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("sandbox session %s is no longer pending", session.ID)
+	}
+	claimed, err := tx.AlertSession.Get(ctx, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload sandbox session: %w", err)
+	}
+	if err := recordClaim(ctx, tx, claimed, r.podID, sandboxWorkerID, now); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit sandbox claim: %w", err)
+	}
+	return claimed, nil
+}
+
+// runHeartbeat keeps the session from being detected as orphaned while it runs.
+func (r *SandboxRunner) runHeartbeat(ctx context.Context, sessionID string) {
+	ticker := time.NewTicker(r.config.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.client.AlertSession.UpdateOneID(sessionID).
+				SetLastInteractionAt(time.Now()).
+				Exec(ctx); err != nil {
+				slog.Warn("Sandbox heartbeat update failed", "session_id", sessionID, "error", err)
+			}
+		}
+	}
+}
+
+// finalize writes the terminal status. Sandbox sessions are not reviewed,
+// so review_status stays unset.
+func (r *SandboxRunner) finalize(ctx context.Context, sessionID string, result *ExecutionResult) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	update := tx.AlertSession.Update().
+		Where(
+			alertsession.IDEQ(sessionID),
+			alertsession.StatusIn(alertsession.StatusInProgress, alertsession.StatusCancelling),
+		).
+		SetStatus(result.Status).
+		SetCompletedAt(time.Now())
+	if result.FinalAnalysis != "" {
+		update = update.SetFinalAnalysis(result.FinalAnalysis)
+	}
+	if result.ExecutiveSummary != "" {
+		update = update.SetExecutiveSummary(result.ExecutiveSummary)
+	}
+	if result.ExecSummaryTemplate != "" {
+		update = update.SetExecutiveSummaryTemplate(result.ExecSummaryTemplate)
+	}
+	if result.ExecutiveSummaryError != "" {
+		update = update.SetExecutiveSummaryError(result.ExecutiveSummaryError)
+	}
+	if result.TechnicalSummary != "" {
+		update = update.SetTechnicalSummary(result.TechnicalSummary)
+	}
+	if result.TechnicalSummaryError != "" {
+		update = update.SetTechnicalSummaryError(result.TechnicalSummaryError)
+	}
+	if result.Severity != "" {
+		update = update.SetSeverity(alertsession.Severity(result.Severity))
+	}
+	if result.Error != nil {
+		update = update.SetErrorMessage(result.Error.Error())
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update sandbox session terminal status: %w", err)
+	}
+	if n == 0 {
+		// Recovered as an orphan meanwhile; its status stands
+		return nil
+	}
+	if err := releaseClaims(ctx, tx, sessionID, claimOutcome(result.Status), nil, ""); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit sandbox terminal status: %w", err)
+	}
+	return nil
+}
+
+// scheduleEventCleanup removes the run's transient WebSocket events after
+// the same grace period as worker-run sessions.
+func (r *SandboxRunner) scheduleEventCleanup(sessionID string) {
+	time.AfterFunc(60*time.Second, func() {
+		if _, err := r.client.Event.Delete().
+			Where(event.SessionIDEQ(sessionID)).
+			Exec(context.Background()); err != nil {
+			slog.Warn("Failed to cleanup sandbox session events after grace period",
+				"session_id", sessionID, "error", err)
+		}
+	})
+}
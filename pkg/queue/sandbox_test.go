@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/federation"
+	"github.com/codeready-toolchain/tarsy/pkg/mcp"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+func TestSandboxed_IsolatesProductionDependencies(t *testing.T) {
+	cfg := &config.Config{MCPServerRegistry: config.NewMCPServerRegistry(nil)}
+	base := NewRealSessionExecutor(cfg, nil, agent.NewScriptedLLMClient(nil), nil, mcp.NewClientFactory(cfg.MCPServerRegistry, nil), nil, nil, &config.MemoryConfig{})
+	base.federation = &federation.Client{}
+	base.providerHealth = &agent.ProviderHealth{}
+	base.SetWarningsService(&services.SystemWarningsService{})
+
+	factory := mcp.NewFixtureClientFactory(cfg.MCPServerRegistry, nil, nil)
+	llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Text: "done"}})
+	sandboxed := base.sandboxed(factory, llm)
+
+	assert.Same(t, factory, sandboxed.mcpFactory)
+	assert.Same(t, llm, sandboxed.llmClient)
+	assert.Nil(t, sandboxed.memoryConfig)
+	assert.Nil(t, sandboxed.federation)
+	assert.Nil(t, sandboxed.providerHealth)
+	assert.Nil(t, sandboxed.timeWarner.warnings)
+
+	// The base executor keeps serving production sessions unchanged
+	assert.NotSame(t, factory, base.mcpFactory)
+	assert.NotNil(t, base.memoryConfig)
+	assert.NotNil(t, base.federation)
+	assert.NotNil(t, base.timeWarner.warnings)
+}
@@ -166,7 +166,7 @@ func (e *ScoringExecutor) SubmitScoring(ctx context.Context, sessionID, triggere
 
 	go func() {
 		defer e.wg.Done()
-		execCtx, cancel := context.WithTimeout(context.Background(), scoringTimeout)
+		execCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), scoringTimeout)
 		e.trackCancel(scoreID, cancel)
 		defer e.removeCancel(scoreID)
 		e.executeScoring(execCtx, scoreID, stageID, sessionID)
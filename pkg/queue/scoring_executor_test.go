@@ -194,6 +194,10 @@ func (m *mockScoreEventPublisher) PublishSessionScoreUpdated(_ context.Context,
 	return nil
 }
 
+func (m *mockScoreEventPublisher) PublishMCPServerLog(_ context.Context, _ string, _ events.MCPServerLogPayload) error {
+	return nil
+}
+
 func TestScoringExecutor_RunFeedbackReflectorAsyncRejectedWhenStopped(t *testing.T) {
 	exec := &ScoringExecutor{}
 	exec.Stop(0)
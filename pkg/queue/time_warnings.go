@@ -0,0 +1,95 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// timeWarningTarget identifies the stage or agent execution being timed.
+type timeWarningTarget struct {
+	sessionID   string
+	stageID     string
+	executionID string // empty for stage-level watches
+	label       string // human-readable, e.g. `Stage "investigate"`
+}
+
+func (t timeWarningTarget) kind() string {
+	if t.executionID == "" {
+		return "stage"
+	}
+	return "agent"
+}
+
+// key deduplicates the system warning for this target.
+func (t timeWarningTarget) key() string {
+	if t.executionID == "" {
+		return t.stageID
+	}
+	return t.executionID
+}
+
+// timeWarner raises soft warnings when a stage or agent execution runs past
+// its time_warnings threshold: an execution.progress event with the
+// time_warning phase and a system warning. It never interrupts the run.
+type timeWarner struct {
+	eventPublisher agent.EventPublisher            // may be nil
+	warnings       *services.SystemWarningsService // may be nil
+}
+
+// watch arms a timer that warns once target has been running for threshold.
+// The returned stop function disarms it and clears the system warning if one
+// was raised. A zero threshold or nil receiver disables the watch.
+func (w *timeWarner) watch(target timeWarningTarget, threshold time.Duration) (stop func()) {
+	if w == nil || threshold <= 0 {
+		return func() {}
+	}
+
+	var mu sync.Mutex
+	stopped, fired := false, false
+	timer := time.AfterFunc(threshold, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped {
+			return
+		}
+		fired = true
+		w.warn(target, threshold)
+	})
+
+	return func() {
+		timer.Stop()
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = true
+		if fired && w.warnings != nil {
+			w.warnings.ClearByServerID(services.WarningCategorySlowRun, target.key())
+		}
+	}
+}
+
+func (w *timeWarner) warn(target timeWarningTarget, threshold time.Duration) {
+	msg := fmt.Sprintf("%s has been running for over %s", target.label, threshold)
+	slog.Warn("Soft time limit exceeded",
+		"session_id", target.sessionID,
+		"stage_id", target.stageID,
+		"execution_id", target.executionID,
+		"kind", target.kind(),
+		"threshold", threshold)
+	metrics.TimeWarningsTotal.WithLabelValues(target.kind()).Inc()
+
+	publishExecutionProgressFromExecutor(context.Background(), w.eventPublisher, target.sessionID,
+		target.stageID, target.executionID, events.ProgressPhaseTimeWarning, msg)
+	if w.warnings != nil {
+		w.warnings.AddWarning(services.WarningCategorySlowRun, msg,
+			fmt.Sprintf("Session %s continues running; queue.session_timeout is the hard limit.", target.sessionID),
+			target.key())
+	}
+}
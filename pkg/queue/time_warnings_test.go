@@ -0,0 +1,89 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+)
+
+// progressCapturePublisher records execution.progress events for time warning tests.
+type progressCapturePublisher struct {
+	mockEventPublisher
+	progress chan events.ExecutionProgressPayload
+}
+
+func (p *progressCapturePublisher) PublishExecutionProgress(_ context.Context, _ string, payload events.ExecutionProgressPayload) error {
+	p.progress <- payload
+	return nil
+}
+
+func TestTimeWarner_WarnsAndClearsOnStop(t *testing.T) {
+	pub := &progressCapturePublisher{progress: make(chan events.ExecutionProgressPayload, 1)}
+	warnings := services.NewSystemWarningsService()
+	w := &timeWarner{eventPublisher: pub, warnings: warnings}
+
+	stop := w.watch(timeWarningTarget{
+		sessionID:   "sess-1",
+		stageID:     "stage-1",
+		executionID: "exec-1",
+		label:       `Agent "KubernetesAgent" in stage "investigate"`,
+	}, 10*time.Millisecond)
+
+	select {
+	case payload := <-pub.progress:
+		assert.Equal(t, events.ProgressPhaseTimeWarning, payload.Phase)
+		assert.Equal(t, "stage-1", payload.StageID)
+		assert.Equal(t, "exec-1", payload.ExecutionID)
+		assert.Contains(t, payload.Message, `Agent "KubernetesAgent" in stage "investigate" has been running for over 10ms`)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a time_warning execution.progress event")
+	}
+
+	got := warnings.GetWarnings()
+	require.Len(t, got, 1)
+	assert.Equal(t, services.WarningCategorySlowRun, got[0].Category)
+	assert.Equal(t, "exec-1", got[0].ServerID)
+
+	stop()
+	assert.Empty(t, warnings.GetWarnings(), "warning should be cleared once the run finishes")
+}
+
+func TestTimeWarner_StopBeforeThreshold(t *testing.T) {
+	pub := &progressCapturePublisher{progress: make(chan events.ExecutionProgressPayload, 1)}
+	warnings := services.NewSystemWarningsService()
+	w := &timeWarner{eventPublisher: pub, warnings: warnings}
+
+	stop := w.watch(timeWarningTarget{sessionID: "sess-1", stageID: "stage-1", label: `Stage "investigate"`}, 50*time.Millisecond)
+	stop()
+
+	select {
+	case <-pub.progress:
+		t.Fatal("no warning expected after stop")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.Empty(t, warnings.GetWarnings())
+}
+
+func TestTimeWarner_Disabled(t *testing.T) {
+	var nilWarner *timeWarner
+	assert.NotPanics(t, func() { nilWarner.watch(timeWarningTarget{}, time.Millisecond)() })
+
+	w := &timeWarner{}
+	assert.NotPanics(t, func() { w.watch(timeWarningTarget{}, 0)() })
+}
+
+func TestTimeWarningTarget_KindAndKey(t *testing.T) {
+	stage := timeWarningTarget{stageID: "stage-1"}
+	assert.Equal(t, "stage", stage.kind())
+	assert.Equal(t, "stage-1", stage.key())
+
+	agentTarget := timeWarningTarget{stageID: "stage-1", executionID: "exec-1"}
+	assert.Equal(t, "agent", agentTarget.kind())
+	assert.Equal(t, "exec-1", agentTarget.key())
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
 )
 
 // Sentinel errors for queue operations.
@@ -18,10 +19,22 @@ var (
 	// ErrAtCapacity indicates the global concurrent session limit has been reached.
 	ErrAtCapacity = errors.New("at capacity")
 
+	// ErrQueuePaused indicates session claiming is paused for this pod.
+	ErrQueuePaused = errors.New("session claiming paused")
+
 	// ErrChatExecutionActive indicates a chat already has an active execution.
 	// Mapped to HTTP 409 Conflict by the API handler.
 	ErrChatExecutionActive = errors.New("chat execution already active")
 
+	// ErrChatRateLimited indicates a chat message exceeded a chat limit
+	// (see ChatLimitError). Mapped to HTTP 429 Too Many Requests.
+	ErrChatRateLimited = errors.New("chat rate limit exceeded")
+
+	// ErrChatAtCapacity indicates the pod is generating as many chat
+	// responses as MaxConcurrentExecutions allows, or that earlier messages
+	// are already waiting for a slot. The API handler queues the message.
+	ErrChatAtCapacity = errors.New("chat executions at capacity")
+
 	// ErrShuttingDown indicates the executor is shutting down and not accepting new work.
 	// Mapped to HTTP 503 Service Unavailable by the API handler.
 	ErrShuttingDown = errors.New("executor is shutting down")
@@ -55,7 +68,12 @@ type ExecutionResult struct {
 	FinalAnalysis         string              // Final analysis text (if completed)
 	ExecutiveSummary      string              // Executive summary (if completed)
 	ExecutiveSummaryError string              // Non-empty if summary generation failed (fail-open)
+	ExecSummaryTemplate   string              // Version of the prompt that produced ExecutiveSummary
+	TechnicalSummary      string              // Technical summary (if the chain enables it and it completed)
+	TechnicalSummaryError string              // Non-empty if technical summary generation failed (fail-open)
+	Severity              config.Severity     // Classified by the executive summary (empty if unclassified)
 	Error                 error               // Error details (if failed/timed_out)
+	NoiseTriaged          bool                // Closed by the noise triage pre-chain; no stages ran
 }
 
 // PoolHealth contains health information for the entire worker pool.
@@ -72,6 +90,8 @@ type PoolHealth struct {
 	WorkerStats      []WorkerHealth `json:"worker_stats"`
 	LastOrphanScan   time.Time      `json:"last_orphan_scan"`
 	OrphansRecovered int            `json:"orphans_recovered"`
+	ClaimingPaused   bool           `json:"claiming_paused"`
+	Pause            *QueuePause    `json:"pause,omitempty"` // pause in force on this pod
 }
 
 // WorkerHealth contains health information for a single worker.
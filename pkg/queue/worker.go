@@ -5,18 +5,29 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"math/rand/v2"
 	"sync"
 	"time"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/ent/event"
 	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/callback"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/email"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/codeready-toolchain/tarsy/pkg/fanout"
+	"github.com/codeready-toolchain/tarsy/pkg/followup"
 	"github.com/codeready-toolchain/tarsy/pkg/metrics"
+	"github.com/codeready-toolchain/tarsy/pkg/milestone"
+	"github.com/codeready-toolchain/tarsy/pkg/oncall"
+	"github.com/codeready-toolchain/tarsy/pkg/outcome"
+	"github.com/codeready-toolchain/tarsy/pkg/pagerduty"
+	"github.com/codeready-toolchain/tarsy/pkg/recurrence"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
+	"github.com/codeready-toolchain/tarsy/pkg/resultexport"
+	"github.com/codeready-toolchain/tarsy/pkg/savedview"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
 	tarsyslack "github.com/codeready-toolchain/tarsy/pkg/slack"
 )
 
@@ -39,6 +50,20 @@ type Worker struct {
 	scoringExecutor *ScoringExecutor
 	eventPublisher  agent.EventPublisher
 	slackService    *tarsyslack.Service
+	pagerDuty       *pagerduty.Service                // nil = PagerDuty disabled
+	routing         *config.NotificationRoutingConfig // nil = default channel only
+	emailService    *email.Service                    // nil = email disabled
+	savedViews      *savedview.Notifier               // nil = saved view subscriptions disabled
+	milestones      *milestone.Notifier               // nil = milestone notifications disabled
+	fanOut          *fanout.Synthesizer               // nil = fan-out synthesis disabled
+	callbacks       *callback.Deliverer               // nil = completion callbacks disabled
+	recurrence      *recurrence.Comparer              // nil = recurring alert comparison disabled
+	outcomes        *outcome.Classifier               // nil = outcome classification disabled
+	actionItems     *followup.Extractor               // nil = action item extraction disabled
+	onCall          *oncall.Service                   // nil = on-call lookup disabled
+	resultExport    *resultexport.Exporter            // nil = no chain exports results
+	pause           *pauseGate                        // nil = never paused
+	claimer         *claimer                          // nil = claim directly
 	pool            SessionRegistry
 	stopCh          chan struct{}
 	stopOnce        sync.Once
@@ -122,7 +147,7 @@ func (w *Worker) run(ctx context.Context) {
 			return
 		default:
 			if err := w.pollAndProcess(ctx); err != nil {
-				if errors.Is(err, ErrNoSessionsAvailable) || errors.Is(err, ErrAtCapacity) {
+				if errors.Is(err, ErrNoSessionsAvailable) || errors.Is(err, ErrAtCapacity) || errors.Is(err, ErrQueuePaused) {
 					w.sleep(w.pollInterval())
 					continue
 				}
@@ -141,41 +166,59 @@ func (w *Worker) sleep(d time.Duration) {
 	}
 }
 
-// pollAndProcess checks capacity, claims a session, and processes it.
+// pollAndProcess claims a session and processes it.
 func (w *Worker) pollAndProcess(ctx context.Context) error {
-	// 1. Check global capacity (best-effort; racy with concurrent workers but
-	//    bounded by WorkerCount and mitigated by poll jitter).
-	activeCount, err := w.client.AlertSession.Query().
-		Where(alertsession.StatusEQ(alertsession.StatusInProgress)).
-		Count(ctx)
-	if err != nil {
-		return fmt.Errorf("checking active sessions: %w", err)
-	}
-	if activeCount >= w.config.MaxConcurrentSessions {
-		return ErrAtCapacity
+	// 0. Paused pods leave pending sessions for others (or for later)
+	if w.pause.active() != nil {
+		return ErrQueuePaused
 	}
 
-	// 2. Claim next session
-	session, err := w.claimNextSession(ctx)
+	// 1-2. Claim next session; the claim round checks global capacity
+	// under the cluster-wide claim lock
+	session, err := w.nextSession(ctx)
 	if err != nil {
 		return err
 	}
 
+	// Carry the submitting call's request ID through execution so events,
+	// interaction records, and context-aware logs can be correlated with it.
+	reqID := ""
+	if session.RequestID != nil {
+		reqID = *session.RequestID
+	}
+	ctx = requestid.WithContext(ctx, reqID)
+
 	log := slog.With("session_id", session.ID, "worker_id", w.id)
+	if reqID != "" {
+		log = log.With("request_id", reqID)
+	}
 	log.Info("Session claimed")
 
 	// Publish session status "in_progress" to both session and global channels
-	w.publishSessionStatus(ctx, session.ID, alertsession.StatusInProgress)
+	w.publishSessionStatus(ctx, session, alertsession.StatusInProgress, nil)
+	w.notifySavedViews(ctx, session, alertsession.StatusInProgress, "")
+
+	// Record who is on call for the alert's service, for the agents and
+	// the notifications below (no-op unless system.on_call is set)
+	session = w.onCall.Attach(ctx, session)
 
 	// Send Slack start notification (only if fingerprint present, resolves threadTS)
 	slackThreadTS := w.notifySlackStart(ctx, session)
 
+	// Track intermediate milestones (no-op unless the chain opts in)
+	milestones := w.milestones.Track(milestone.Session{
+		ID:        session.ID,
+		AlertType: session.AlertType,
+		ChainID:   session.ChainID,
+	}, slackThreadTS)
+
 	w.setStatus(WorkerStatusWorking, session.ID)
 	defer w.setStatus(WorkerStatusIdle, "")
 
 	// 3. Create session context with timeout
 	sessionCtx, cancelSession := context.WithTimeout(ctx, w.config.SessionTimeout)
 	defer cancelSession()
+	sessionCtx = milestone.WithTracker(sessionCtx, milestones)
 
 	// 4. Register cancel function for API-triggered cancellation
 	w.pool.RegisterSession(session.ID, cancelSession)
@@ -231,11 +274,28 @@ func (w *Worker) pollAndProcess(ctx context.Context) error {
 	// cancellation (e.g. DB write failed on a cancelled context).
 	result = applySafetyNet(result, sessionCtx.Err(), w.config.SessionTimeout)
 
-	// 10. Stop heartbeat
+	// 9a. Explain the cancellation: the cancel API recorded who cancelled the
+	// session and why; that beats a bare "context canceled" in error_message
+	// and notifications.
+	var cancellation *ent.AlertSession
+	if result.Status == alertsession.StatusCancelled {
+		cancellation = w.loadCancellation(context.WithoutCancel(ctx), session.ID)
+		if msg := services.DescribeCancellation(cancellation); msg != "" {
+			result.Error = errors.New(msg)
+		}
+		// Preempted sessions end auto_cancelled, like other policy cancellations.
+		if cancellation != nil && *cancellation.CancelInitiator == alertsession.CancelInitiatorPreemption {
+			result.Status = alertsession.StatusAutoCancelled
+		}
+	}
+
+	// 10. Stop heartbeat and flush pending milestone notifications so they
+	// land before the terminal notification
 	cancelHeartbeat()
+	milestones.Wait()
 
 	// 11. Update terminal status + initialize review (atomic, background context)
-	finalizeCtx, finalizeCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	finalizeCtx, finalizeCancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
 	defer finalizeCancel()
 
 	statusUpdated, reviewInitialized, err := w.updateSessionTerminalStatus(finalizeCtx, session, result)
@@ -258,21 +318,50 @@ func (w *Worker) pollAndProcess(ctx context.Context) error {
 	}
 
 	// 11a. Publish terminal session status event
-	w.publishSessionStatus(finalizeCtx, session.ID, result.Status)
+	w.publishSessionStatus(finalizeCtx, session, result.Status, cancellation)
 
 	// 11b. Publish review.status event (only when review was actually initialized)
 	if reviewInitialized {
 		w.publishReviewStatus(finalizeCtx, session.ID, result.Status)
 	}
 
-	// 11c. Send Slack terminal notification
-	w.notifySlackTerminal(finalizeCtx, session, result, slackThreadTS)
+	// 11c. Send Slack terminal notification (channel routed by severity)
+	route := w.routing.Route(result.Severity)
+	w.notifySlackTerminal(finalizeCtx, session, result, slackThreadTS, route)
+
+	// 11d. Page on-call when the severity route asks for it
+	w.notifyPagerDuty(finalizeCtx, session, result, route)
+
+	// 11e. Email the chain's recipients (immediately or via digest)
+	w.notifyEmail(finalizeCtx, session, result)
 
-	// 11d. Fire scoring (async, fire-and-forget) for completed sessions
-	if result.Status == alertsession.StatusCompleted && w.scoringExecutor != nil {
+	// 11f. Notify subscribers of matching saved views
+	w.notifySavedViews(finalizeCtx, session, result.Status, result.FinalAnalysis)
+
+	// 11g. Fire scoring (async, fire-and-forget) for completed sessions;
+	// sessions closed by noise triage have no investigation to score
+	if result.Status == alertsession.StatusCompleted && !result.NoiseTriaged && w.scoringExecutor != nil {
 		w.scoringExecutor.ScoreSessionAsync(session.ID, "auto", true)
 	}
 
+	// 11h. Consolidate fan-out siblings once the last one is terminal (async)
+	w.fanOut.SessionFinished(session)
+
+	// 11i. POST the result to the completion callback registered at submission (async)
+	w.callbacks.Deliver(session.ID)
+
+	// 11j. Write the final export to the chain's object storage destination (async)
+	w.resultExport.Export(finalizeCtx, session.ID, session.ChainID)
+
+	// 11k. Compare with the previous investigation of the same alert (async)
+	// 11l. Label the session with its outcome (async)
+	// 11m. Extract follow-up action items from the recommendations (async)
+	if result.Status == alertsession.StatusCompleted {
+		w.recurrence.SessionCompleted(session)
+		w.outcomes.SessionCompleted(session)
+		w.actionItems.SessionCompleted(session)
+	}
+
 	// 12. Cleanup transient events after grace period (60s) to allow clients
 	// to receive final events before they are deleted.
 	w.scheduleEventCleanup(session.ID)
@@ -285,50 +374,22 @@ func (w *Worker) pollAndProcess(ctx context.Context) error {
 	return nil
 }
 
-// claimNextSession atomically claims the next pending session using FOR UPDATE SKIP LOCKED.
-func (w *Worker) claimNextSession(ctx context.Context) (*ent.AlertSession, error) {
-	tx, err := w.client.Tx(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to start transaction: %w", err)
-	}
-	defer func() { _ = tx.Rollback() }()
-
-	// SELECT ... FOR UPDATE SKIP LOCKED
-	// Order by created_at for FIFO processing
-	session, err := tx.AlertSession.Query().
-		Where(
-			alertsession.StatusEQ(alertsession.StatusPending),
-			alertsession.DeletedAtIsNil(),
-		).
-		Order(ent.Asc(alertsession.FieldCreatedAt)).
-		Limit(1).
-		ForUpdate(sql.WithLockAction(sql.SkipLocked)).
-		First(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, ErrNoSessionsAvailable
-		}
-		return nil, fmt.Errorf("failed to query pending session: %w", err)
+// nextSession claims the next session, through the pod's claimer when the
+// worker has one.
+func (w *Worker) nextSession(ctx context.Context) (*ent.AlertSession, error) {
+	if w.claimer != nil {
+		return w.claimer.next(ctx, w.id, w.stopCh)
 	}
+	return w.claimNextSession(ctx)
+}
 
-	// Claim: set in_progress, pod_id, started_at, last_interaction_at
-	// This is when actual execution starts (mirrors Stage and AgentExecution behavior)
-	now := time.Now()
-	session, err = session.Update().
-		SetStatus(alertsession.StatusInProgress).
-		SetPodID(w.podID).
-		SetStartedAt(now).
-		SetLastInteractionAt(now).
-		Save(ctx)
+// claimNextSession claims the next pending session for this worker alone.
+func (w *Worker) claimNextSession(ctx context.Context) (*ent.AlertSession, error) {
+	sessions, err := claimSessions(ctx, w.client, w.config, w.podID, []string{w.id})
 	if err != nil {
-		return nil, fmt.Errorf("failed to claim session: %w", err)
+		return nil, err
 	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit claim: %w", err)
-	}
-
-	return session, nil
+	return sessions[0], nil
 }
 
 // runHeartbeat periodically updates last_interaction_at for orphan detection.
@@ -383,9 +444,21 @@ func (w *Worker) updateSessionTerminalStatus(ctx context.Context, session *ent.A
 	if result.ExecutiveSummary != "" {
 		update = update.SetExecutiveSummary(result.ExecutiveSummary)
 	}
+	if result.ExecSummaryTemplate != "" {
+		update = update.SetExecutiveSummaryTemplate(result.ExecSummaryTemplate)
+	}
 	if result.ExecutiveSummaryError != "" {
 		update = update.SetExecutiveSummaryError(result.ExecutiveSummaryError)
 	}
+	if result.TechnicalSummary != "" {
+		update = update.SetTechnicalSummary(result.TechnicalSummary)
+	}
+	if result.TechnicalSummaryError != "" {
+		update = update.SetTechnicalSummaryError(result.TechnicalSummaryError)
+	}
+	if result.Severity != "" {
+		update = update.SetSeverity(alertsession.Severity(result.Severity))
+	}
 	if result.Error != nil {
 		update = update.SetErrorMessage(result.Error.Error())
 	}
@@ -397,10 +470,13 @@ func (w *Worker) updateSessionTerminalStatus(ctx context.Context, session *ent.A
 	if statusAffected == 0 {
 		return false, false, nil
 	}
+	if err := releaseClaims(ctx, tx, session.ID, claimOutcome(result.Status), nil, ""); err != nil {
+		return false, false, err
+	}
 
 	// 2. Initialize review_status (conditional on review_status IS NULL to avoid TOCTOU).
 	var reviewAffected int
-	if result.Status == alertsession.StatusCancelled {
+	if result.Status == alertsession.StatusCancelled || result.Status == alertsession.StatusAutoCancelled {
 		reviewAffected, err = tx.AlertSession.Update().
 			Where(
 				alertsession.IDEQ(session.ID),
@@ -429,20 +505,50 @@ func (w *Worker) updateSessionTerminalStatus(ctx context.Context, session *ent.A
 	return true, reviewAffected > 0, nil
 }
 
+// loadCancellation re-reads a cancelled session for the cancellation record
+// written by the cancel API after the worker claimed it. Returns nil (no
+// record) on lookup failure.
+func (w *Worker) loadCancellation(ctx context.Context, sessionID string) *ent.AlertSession {
+	sess, err := w.client.AlertSession.Get(ctx, sessionID)
+	if err != nil {
+		slog.Warn("Failed to load cancellation record", "session_id", sessionID, "error", err)
+		return nil
+	}
+	if sess.CancelInitiator == nil {
+		return nil
+	}
+	return sess
+}
+
 // publishSessionStatus publishes a session status event to both the session-specific
-// and global channels for real-time WebSocket delivery. Non-blocking: errors are logged.
-func (w *Worker) publishSessionStatus(ctx context.Context, sessionID string, status alertsession.Status) {
+// and global channels for real-time WebSocket delivery. cancellation, when
+// non-nil, carries the recorded cancel initiator, reason, and requester.
+// Non-blocking: errors are logged.
+func (w *Worker) publishSessionStatus(ctx context.Context, session *ent.AlertSession, status alertsession.Status, cancellation *ent.AlertSession) {
 	if w.eventPublisher == nil {
 		return
 	}
-	if err := w.eventPublisher.PublishSessionStatus(ctx, sessionID, events.SessionStatusPayload{
+	sessionID := session.ID
+	payload := events.SessionStatusPayload{
 		BasePayload: events.BasePayload{
 			Type:      events.EventTypeSessionStatus,
 			SessionID: sessionID,
 			Timestamp: time.Now().Format(time.RFC3339Nano),
 		},
-		Status: status,
-	}); err != nil {
+		Status:    status,
+		ChainID:   session.ChainID,
+		AlertType: session.AlertType,
+	}
+	if cancellation != nil && cancellation.CancelInitiator != nil {
+		payload.CancelInitiator = string(*cancellation.CancelInitiator)
+		if cancellation.CancelReason != nil {
+			payload.CancelReason = *cancellation.CancelReason
+		}
+		if cancellation.CancelledBy != nil {
+			payload.CancelledBy = *cancellation.CancelledBy
+		}
+	}
+	if err := w.eventPublisher.PublishSessionStatus(ctx, sessionID, payload); err != nil {
 		slog.Warn("Failed to publish session status",
 			"session_id", sessionID, "status", status, "error", err)
 	}
@@ -464,7 +570,7 @@ func (w *Worker) publishReviewStatus(ctx context.Context, sessionID string, term
 		Actor: "system",
 	}
 
-	if terminalStatus == alertsession.StatusCancelled {
+	if terminalStatus == alertsession.StatusCancelled || terminalStatus == alertsession.StatusAutoCancelled {
 		rs := string(alertsession.ReviewStatusReviewed)
 		payload.ReviewStatus = &rs
 	} else {
@@ -497,7 +603,8 @@ func (w *Worker) cleanupSessionEvents(ctx context.Context, sessionID string) err
 	return err
 }
 
-// notifySlackStart sends a Slack start notification if the session has a fingerprint.
+// notifySlackStart sends a Slack start notification (threaded reply for
+// Slack-originated sessions, or a thread root when status threading is on).
 // Returns the resolved threadTS for reuse by terminal notification.
 func (w *Worker) notifySlackStart(ctx context.Context, session *ent.AlertSession) string {
 	if w.slackService == nil {
@@ -512,12 +619,15 @@ func (w *Worker) notifySlackStart(ctx context.Context, session *ent.AlertSession
 	return w.slackService.NotifySessionStarted(ctx, tarsyslack.SessionStartedInput{
 		SessionID:               session.ID,
 		AlertType:               session.AlertType,
+		ChainID:                 session.ChainID,
 		SlackMessageFingerprint: fingerprint,
+		OnCall:                  slackOnCall(session),
 	})
 }
 
 // notifySlackTerminal sends a Slack terminal status notification.
-func (w *Worker) notifySlackTerminal(ctx context.Context, session *ent.AlertSession, result *ExecutionResult, threadTS string) {
+// route may be nil (no severity rule matched — default channel).
+func (w *Worker) notifySlackTerminal(ctx context.Context, session *ent.AlertSession, result *ExecutionResult, threadTS string, route *config.NotificationRouteRule) {
 	if w.slackService == nil {
 		return
 	}
@@ -532,28 +642,116 @@ func (w *Worker) notifySlackTerminal(ctx context.Context, session *ent.AlertSess
 		errMsg = result.Error.Error()
 	}
 
+	var channel string
+	if route != nil {
+		channel = route.SlackChannel
+	}
+
 	w.slackService.NotifySessionCompleted(ctx, tarsyslack.SessionCompletedInput{
 		SessionID:               session.ID,
 		AlertType:               session.AlertType,
+		ChainID:                 session.ChainID,
 		Status:                  string(result.Status),
 		ExecutiveSummary:        result.ExecutiveSummary,
+		TechnicalSummary:        result.TechnicalSummary,
 		FinalAnalysis:           result.FinalAnalysis,
 		ErrorMessage:            errMsg,
 		SlackMessageFingerprint: fingerprint,
 		ThreadTS:                threadTS,
+		Severity:                string(result.Severity),
+		Channel:                 channel,
+		OnCall:                  slackOnCall(session),
+	})
+}
+
+// slackOnCall converts the session's on-call contact for Slack messages.
+// Returns nil when no engineer was found.
+func slackOnCall(session *ent.AlertSession) *tarsyslack.OnCallInput {
+	oc := session.OnCall
+	if oc == nil || oc.Name == "" {
+		return nil
+	}
+	return &tarsyslack.OnCallInput{Service: oc.Service, Team: oc.Team, Name: oc.Name, Email: oc.Email}
+}
+
+// notifyPagerDuty triggers a PagerDuty incident for completed sessions whose
+// severity route has pagerduty enabled.
+func (w *Worker) notifyPagerDuty(ctx context.Context, session *ent.AlertSession, result *ExecutionResult, route *config.NotificationRouteRule) {
+	if w.pagerDuty == nil || route == nil || !route.PagerDuty {
+		return
+	}
+	if result.Status != alertsession.StatusCompleted {
+		return
+	}
+
+	w.pagerDuty.NotifySessionCompleted(ctx, pagerduty.SessionCompletedInput{
+		SessionID:        session.ID,
+		AlertType:        session.AlertType,
+		Severity:         string(result.Severity),
+		ExecutiveSummary: result.ExecutiveSummary,
+		FinalAnalysis:    result.FinalAnalysis,
+		OnCall:           pagerDutyOnCall(session),
+	})
+}
+
+// pagerDutyOnCall formats the session's on-call engineer for the incident's
+// custom details. Returns "" when no engineer was found.
+func pagerDutyOnCall(session *ent.AlertSession) string {
+	oc := session.OnCall
+	if oc == nil || oc.Name == "" {
+		return ""
+	}
+	if oc.Email != "" && oc.Email != oc.Name {
+		return fmt.Sprintf("%s <%s> (%s)", oc.Name, oc.Email, oc.Service)
+	}
+	return fmt.Sprintf("%s (%s)", oc.Name, oc.Service)
+}
+
+// notifyEmail sends (or queues for digest) a terminal session email to the
+// chain's configured recipients.
+func (w *Worker) notifyEmail(ctx context.Context, session *ent.AlertSession, result *ExecutionResult) {
+	if w.emailService == nil {
+		return
+	}
+
+	var errMsg string
+	if result.Error != nil {
+		errMsg = result.Error.Error()
+	}
+
+	w.emailService.NotifySessionCompleted(ctx, email.SessionCompletedInput{
+		SessionID:        session.ID,
+		AlertType:        session.AlertType,
+		ChainID:          session.ChainID,
+		Status:           string(result.Status),
+		Severity:         string(result.Severity),
+		ExecutiveSummary: result.ExecutiveSummary,
+		TechnicalSummary: result.TechnicalSummary,
+		FinalAnalysis:    result.FinalAnalysis,
+		ErrorMessage:     errMsg,
+		CompletedAt:      time.Now(),
+	})
+}
+
+// notifySavedViews notifies owners of subscribed saved views that match the
+// session's transition into status.
+func (w *Worker) notifySavedViews(ctx context.Context, session *ent.AlertSession, status alertsession.Status, finalAnalysis string) {
+	if w.savedViews == nil {
+		return
+	}
+	w.savedViews.NotifySessionStatus(ctx, savedview.Session{
+		ID:            session.ID,
+		AlertType:     session.AlertType,
+		ChainID:       session.ChainID,
+		Status:        status,
+		AlertData:     session.AlertData,
+		FinalAnalysis: finalAnalysis,
 	})
 }
 
 // pollInterval returns the poll duration with jitter.
 func (w *Worker) pollInterval() time.Duration {
-	base := w.config.PollInterval
-	jitter := w.config.PollIntervalJitter
-	if jitter <= 0 {
-		return base
-	}
-	// Range: [base - jitter, base + jitter]
-	offset := time.Duration(rand.Int64N(int64(2 * jitter)))
-	return base - jitter + offset
+	return jitter(w.config.PollInterval, w.config.PollIntervalJitter)
 }
 
 // setStatus updates the worker's health tracking state.
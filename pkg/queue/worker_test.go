@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/events"
@@ -69,10 +70,10 @@ func TestWorker_PublishSessionStatusNilPublisher(t *testing.T) {
 
 	// Should not panic with nil eventPublisher
 	assert.NotPanics(t, func() {
-		w.publishSessionStatus(t.Context(), "session-123", alertsession.StatusInProgress)
+		w.publishSessionStatus(t.Context(), &ent.AlertSession{ID: "session-123"}, alertsession.StatusInProgress, nil)
 	})
 	assert.NotPanics(t, func() {
-		w.publishSessionStatus(t.Context(), "session-456", alertsession.StatusCompleted)
+		w.publishSessionStatus(t.Context(), &ent.AlertSession{ID: "session-456"}, alertsession.StatusCompleted, nil)
 	})
 }
 
@@ -81,7 +82,7 @@ func TestWorker_PublishSessionStatusWithPublisher(t *testing.T) {
 	pub := &mockEventPublisher{}
 	w := NewWorker("worker-1", "pod-1", nil, cfg, nil, nil, nil, pub, nil)
 
-	w.publishSessionStatus(t.Context(), "session-abc", alertsession.StatusInProgress)
+	w.publishSessionStatus(t.Context(), &ent.AlertSession{ID: "session-abc", ChainID: "k8s-chain", AlertType: "PodCrash"}, alertsession.StatusInProgress, nil)
 
 	// PublishSessionStatus encapsulates both persistent + transient publish
 	assert.Equal(t, 1, pub.sessionStatusCount, "should call PublishSessionStatus once")
@@ -91,6 +92,8 @@ func TestWorker_PublishSessionStatusWithPublisher(t *testing.T) {
 	assert.Equal(t, "session.status", pub.lastSessionStatus.Type)
 	assert.Equal(t, "session-abc", pub.lastSessionStatus.SessionID)
 	assert.Equal(t, alertsession.StatusInProgress, pub.lastSessionStatus.Status)
+	assert.Equal(t, "k8s-chain", pub.lastSessionStatus.ChainID)
+	assert.Equal(t, "PodCrash", pub.lastSessionStatus.AlertType)
 	assert.NotEmpty(t, pub.lastSessionStatus.Timestamp)
 }
 
@@ -153,6 +156,18 @@ func (m *mockEventPublisher) PublishSessionScoreUpdated(_ context.Context, _ str
 	return nil
 }
 
+func (m *mockEventPublisher) PublishMCPServerLog(_ context.Context, _ string, _ events.MCPServerLogPayload) error {
+	return nil
+}
+
+func (m *mockEventPublisher) PublishSubAgentDispatched(_ context.Context, _ string, _ events.SubAgentDispatchedPayload) error {
+	return nil
+}
+
+func (m *mockEventPublisher) PublishSubAgentStatus(_ context.Context, _ string, _ events.SubAgentStatusPayload) error {
+	return nil
+}
+
 func TestWorker_PublishReviewStatusNilPublisher(t *testing.T) {
 	cfg := testQueueConfig()
 	w := NewWorker("worker-1", "pod-1", nil, cfg, nil, nil, nil, nil, nil)
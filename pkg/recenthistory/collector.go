@@ -0,0 +1,193 @@
+// Package recenthistory gathers earlier sessions of the same alert type (and
+// service) when a session starts and condenses them into the "Recent
+// History" prompt block, so recurring and flapping alerts are investigated
+// with knowledge of what earlier sessions concluded.
+package recenthistory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/oncall"
+)
+
+const (
+	// candidateLimit caps the prior sessions loaded per lookup. Matches
+	// beyond it are not counted in RecentHistory.Total.
+	candidateLimit = 200
+
+	// maxSummaryChars caps each session's summary line.
+	maxSummaryChars = 300
+)
+
+// terminalStatuses are the statuses of sessions that finished one way or
+// another. Sessions still queued or running have nothing to report yet.
+var terminalStatuses = []alertsession.Status{
+	alertsession.StatusCompleted,
+	alertsession.StatusFailed,
+	alertsession.StatusTimedOut,
+	alertsession.StatusCancelled,
+	alertsession.StatusAutoCancelled,
+}
+
+// Collector builds the recent history of a session from the database.
+// Nil-safe: Collect returns nil when the collector is nil.
+type Collector struct {
+	client *ent.Client
+	cfg    *config.RecentHistoryConfig
+	logger *slog.Logger
+}
+
+// NewCollector creates a recent history collector. cfg is the system
+// setting; chains may turn the block on even when it is off system-wide,
+// so the collector is always created.
+func NewCollector(client *ent.Client, cfg *config.RecentHistoryConfig) *Collector {
+	return &Collector{
+		client: client,
+		cfg:    cfg,
+		logger: slog.Default().With("component", "recent-history"),
+	}
+}
+
+// Collect returns the recent history of session for chain. Returns nil when
+// the block is off for the chain, no earlier session matched, or the lookup
+// failed (logged; the session runs without the block).
+func (c *Collector) Collect(ctx context.Context, session *ent.AlertSession, chain *config.ChainConfig) *agent.RecentHistory {
+	if c == nil {
+		return nil
+	}
+	cfg := config.ResolveRecentHistory(c.cfg, chain)
+	if cfg == nil {
+		return nil
+	}
+
+	service := oncall.ServiceName(session.AlertData, cfg.ServiceLabel)
+	candidates, err := c.candidates(ctx, session, cfg, service)
+	if err != nil {
+		c.logger.Warn("Failed to load recent history", "session_id", session.ID, "error", err)
+		return nil
+	}
+	return build(cfg, service, candidates)
+}
+
+// candidates loads the finished sessions of the same alert type (and, when
+// service is set, the same service) created in the window before session,
+// most recent first. Fan-out siblings, sandbox runs and deleted sessions
+// are skipped. Only the columns build reads are loaded.
+func (c *Collector) candidates(ctx context.Context, session *ent.AlertSession, cfg *config.RecentHistoryConfig, service string) ([]*ent.AlertSession, error) {
+	query := c.client.AlertSession.Query().
+		Where(
+			alertsession.AlertTypeEQ(session.AlertType),
+			alertsession.IDNEQ(session.ID),
+			alertsession.StatusIn(terminalStatuses...),
+			alertsession.DeletedAtIsNil(),
+			alertsession.Sandbox(false),
+			alertsession.CreatedAtLT(session.CreatedAt),
+			alertsession.CreatedAtGTE(session.CreatedAt.Add(-cfg.Window)),
+		)
+	if session.GroupID != nil {
+		query = query.Where(alertsession.Or(
+			alertsession.GroupIDIsNil(),
+			alertsession.GroupIDNEQ(*session.GroupID),
+		))
+	}
+
+	if service != "" {
+		query = query.Where(serviceIs(cfg.ServiceLabel, service))
+	}
+
+	sessions, err := query.
+		Select(
+			alertsession.FieldCreatedAt,
+			alertsession.FieldStatus,
+			alertsession.FieldOutcome,
+			alertsession.FieldSeverity,
+			alertsession.FieldExecutiveSummary,
+			alertsession.FieldFinalAnalysis,
+			alertsession.FieldErrorMessage,
+		).
+		Order(ent.Desc(alertsession.FieldCreatedAt)).
+		Limit(candidateLimit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query prior sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// serviceIs matches sessions whose alert data names service under label.
+// It is the SQL counterpart of oncall.ServiceName: the first non-blank
+// string among the top-level label, labels.<label> and
+// commonLabels.<label>. Alert data that is not a JSON object never matches.
+func serviceIs(label, service string) predicate.AlertSession {
+	return func(s *sql.Selector) {
+		col := s.C(alertsession.FieldAlertData)
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Arg(service).
+				WriteString(" = (SELECT btrim(v #>> '{}', E' \\t\\n\\r')").
+				WriteString(" FROM (SELECT CASE WHEN " + col + " IS JSON OBJECT THEN " + col + "::jsonb END AS d) doc").
+				WriteString(" CROSS JOIN LATERAL (VALUES (1, '{}'::text[]), (2, '{labels}'), (3, '{commonLabels}')) AS parent(pos, path)").
+				WriteString(" CROSS JOIN LATERAL (SELECT d #> (path || ").Arg(label).WriteString("::text) AS v) val").
+				WriteString(" WHERE jsonb_typeof(v) = 'string' AND btrim(v #>> '{}', E' \\t\\n\\r') <> ''").
+				WriteString(" ORDER BY pos LIMIT 1)")
+		}))
+	}
+}
+
+// build condenses candidates (most recent first, already filtered to
+// service when it is set) into a recent history. Returns nil when there
+// are none.
+func build(cfg *config.RecentHistoryConfig, service string, candidates []*ent.AlertSession) *agent.RecentHistory {
+	history := &agent.RecentHistory{Window: cfg.Window, Service: service}
+	for _, s := range candidates {
+		history.Total++
+		if len(history.Sessions) >= cfg.MaxSessions {
+			continue
+		}
+		entry := agent.RecentSession{
+			ID:        s.ID,
+			CreatedAt: s.CreatedAt,
+			Status:    string(s.Status),
+			Summary:   summarize(s),
+		}
+		if s.Outcome != nil {
+			entry.Outcome = string(*s.Outcome)
+		}
+		if s.Severity != nil {
+			entry.Severity = string(*s.Severity)
+		}
+		history.Sessions = append(history.Sessions, entry)
+	}
+	if history.Total == 0 {
+		return nil
+	}
+	return history
+}
+
+// summarize returns a one-line summary of s: its executive summary, else
+// the start of its final analysis, else its error.
+func summarize(s *ent.AlertSession) string {
+	var text string
+	switch {
+	case s.ExecutiveSummary != nil && strings.TrimSpace(*s.ExecutiveSummary) != "":
+		text = *s.ExecutiveSummary
+	case s.FinalAnalysis != nil && strings.TrimSpace(*s.FinalAnalysis) != "":
+		text = *s.FinalAnalysis
+	case s.ErrorMessage != nil:
+		text = *s.ErrorMessage
+	}
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > maxSummaryChars {
+		text = strings.TrimSpace(string(runes[:maxSummaryChars])) + "…"
+	}
+	return text
+}
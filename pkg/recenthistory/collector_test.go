@@ -0,0 +1,171 @@
+package recenthistory
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestCollect_Off(t *testing.T) {
+	session := &ent.AlertSession{ID: "s1", AlertType: "PodCrashLooping"}
+
+	// Nil collector is a no-op
+	var c *Collector
+	assert.Nil(t, c.Collect(context.Background(), session, &config.ChainConfig{}))
+
+	// Off system-wide and not enabled by the chain: no query is made
+	c = NewCollector(nil, config.DefaultRecentHistoryConfig())
+	assert.Nil(t, c.Collect(context.Background(), session, &config.ChainConfig{}))
+}
+
+func TestCollect(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	create := func(alertType, alertData string, createdAt time.Time) *ent.AlertSessionCreate {
+		return client.AlertSession.Create().
+			SetID(uuid.New().String()).
+			SetAlertData(alertData).
+			SetAgentType("test-agent").
+			SetAlertType(alertType).
+			SetChainID("k8s").
+			SetStatus(alertsession.StatusCompleted).
+			SetCreatedAt(createdAt)
+	}
+
+	// More sessions of other services than candidateLimit, all more recent
+	// than the checkout ones.
+	builders := make([]*ent.AlertSessionCreate, 0, candidateLimit+50)
+	for i := range candidateLimit + 50 {
+		builders = append(builders, create("PodCrashLooping", `{"labels":{"service":"payments"}}`,
+			now.Add(-time.Hour-time.Duration(i)*time.Second)))
+	}
+	client.AlertSession.CreateBulk(builders...).SaveX(ctx)
+
+	matching := []*ent.AlertSession{
+		create("PodCrashLooping", `{"labels":{"service":"checkout"}}`, now.Add(-24*time.Hour)).
+			SetExecutiveSummary("Pod OOM-killed.").
+			SaveX(ctx),
+		create("PodCrashLooping", `{"service":" checkout "}`, now.Add(-25*time.Hour)).SaveX(ctx),
+		create("PodCrashLooping", `{"service":"","commonLabels":{"service":"checkout"}}`, now.Add(-26*time.Hour)).SaveX(ctx),
+	}
+	create("PodCrashLooping", "checkout is down", now.Add(-27*time.Hour)).SaveX(ctx)
+	create("PodCrashLooping", `{"service":42,"labels":["checkout"]}`, now.Add(-28*time.Hour)).SaveX(ctx)
+	create("HighLatency", `{"service":"checkout"}`, now.Add(-29*time.Hour)).SaveX(ctx)
+
+	cfg := config.DefaultRecentHistoryConfig()
+	cfg.Enabled = true
+	c := NewCollector(client.Client, cfg)
+
+	t.Run("same service beyond the most recent candidates", func(t *testing.T) {
+		session := &ent.AlertSession{
+			ID:        "current",
+			AlertType: "PodCrashLooping",
+			AlertData: `{"commonLabels":{"service":"checkout"}}`,
+			CreatedAt: now,
+		}
+		got := c.Collect(ctx, session, &config.ChainConfig{})
+		require.NotNil(t, got)
+		assert.Equal(t, "checkout", got.Service)
+		assert.Equal(t, len(matching), got.Total)
+		require.Len(t, got.Sessions, len(matching))
+		for i, s := range matching {
+			assert.Equal(t, s.ID, got.Sessions[i].ID)
+		}
+		assert.Equal(t, "Pod OOM-killed.", got.Sessions[0].Summary)
+	})
+
+	t.Run("alert without a service matches all", func(t *testing.T) {
+		session := &ent.AlertSession{ID: "current", AlertType: "PodCrashLooping", AlertData: "plain text alert", CreatedAt: now}
+		got := c.Collect(ctx, session, &config.ChainConfig{})
+		require.NotNil(t, got)
+		assert.Empty(t, got.Service)
+		assert.Equal(t, candidateLimit, got.Total)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		session := &ent.AlertSession{ID: "current", AlertType: "PodCrashLooping", AlertData: `{"service":"search"}`, CreatedAt: now}
+		assert.Nil(t, c.Collect(ctx, session, &config.ChainConfig{}))
+	})
+}
+
+func TestBuild(t *testing.T) {
+	cfg := config.DefaultRecentHistoryConfig()
+	cfg.Enabled = true
+	cfg.MaxSessions = 2
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	outcome := alertsession.OutcomeFalsePositive
+	severity := alertsession.SeverityLow
+	candidates := []*ent.AlertSession{
+		{
+			ID:               "p1",
+			CreatedAt:        now.Add(-2 * time.Hour),
+			Status:           alertsession.StatusCompleted,
+			ExecutiveSummary: strPtr("Pod OOM-killed after\nthe nightly batch job."),
+			FinalAnalysis:    strPtr("long analysis"),
+			Outcome:          &outcome,
+			Severity:         &severity,
+		},
+		{
+			ID:            "p2",
+			CreatedAt:     now.Add(-24 * time.Hour),
+			Status:        alertsession.StatusCompleted,
+			FinalAnalysis: strPtr("Memory limit too low."),
+		},
+		{
+			ID:           "p3",
+			CreatedAt:    now.Add(-48 * time.Hour),
+			Status:       alertsession.StatusFailed,
+			ErrorMessage: strPtr("LLM provider unavailable"),
+		},
+	}
+
+	t.Run("capped", func(t *testing.T) {
+		got := build(cfg, "checkout", candidates)
+		require.NotNil(t, got)
+		assert.Equal(t, "checkout", got.Service)
+		assert.Equal(t, cfg.Window, got.Window)
+		assert.Equal(t, 3, got.Total)
+		require.Len(t, got.Sessions, 2)
+
+		assert.Equal(t, "p1", got.Sessions[0].ID)
+		assert.Equal(t, "completed", got.Sessions[0].Status)
+		assert.Equal(t, "false_positive", got.Sessions[0].Outcome)
+		assert.Equal(t, "low", got.Sessions[0].Severity)
+		assert.Equal(t, "Pod OOM-killed after the nightly batch job.", got.Sessions[0].Summary)
+
+		assert.Equal(t, "p2", got.Sessions[1].ID)
+		assert.Equal(t, "Memory limit too low.", got.Sessions[1].Summary)
+		assert.Empty(t, got.Sessions[1].Outcome)
+	})
+
+	t.Run("no candidates", func(t *testing.T) {
+		assert.Nil(t, build(cfg, "checkout", nil))
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	assert.Equal(t, "LLM provider unavailable", summarize(&ent.AlertSession{
+		ExecutiveSummary: strPtr("  "),
+		ErrorMessage:     strPtr("LLM provider unavailable"),
+	}))
+	assert.Empty(t, summarize(&ent.AlertSession{}))
+
+	long := summarize(&ent.AlertSession{FinalAnalysis: strPtr(strings.Repeat("word ", 100))})
+	assert.True(t, strings.HasSuffix(long, "…"))
+	assert.LessOrEqual(t, len([]rune(long)), maxSummaryChars+1)
+}
@@ -0,0 +1,279 @@
+// Package recurrence links investigations of recurring alerts: when a
+// session completes and an earlier session investigated the same alert, it
+// compares the two conclusions and stores the result on both.
+package recurrence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+const (
+	// comparisonTimeout bounds one comparison LLM call.
+	comparisonTimeout = 2 * time.Minute
+
+	// maxAnalysisChars caps each final analysis in the comparison prompt.
+	maxAnalysisChars = 15000
+)
+
+const comparisonSystemPrompt = `You are an SRE comparing two investigations of the same recurring alert.
+The previous investigation ran earlier; the current one just finished.
+
+Respond with a single JSON object and nothing else:
+{
+  "previous_conclusion": "one or two sentences: what the previous investigation concluded",
+  "current_conclusion": "one or two sentences: what the current investigation concluded",
+  "differences": ["each material difference in root cause, affected components, evidence or recommended actions"],
+  "same_root_cause": true
+}
+
+Use an empty differences list when the conclusions agree. Do not list wording differences.
+Do not invent evidence that is not in the investigations.`
+
+// Comparison is the parsed LLM comparison of two conclusions.
+type Comparison struct {
+	PreviousConclusion string   `json:"previous_conclusion"`
+	CurrentConclusion  string   `json:"current_conclusion"`
+	Differences        []string `json:"differences"`
+	SameRootCause      *bool    `json:"same_root_cause"`
+}
+
+// Comparer compares a completed session's final analysis with the previous
+// completed session of the same alert_key and chain within the lookback
+// window. The worker triggers it as each session completes; the unique
+// session_id on session_comparisons makes each session compared at most once.
+// Nil-safe: all methods are no-ops when comparer is nil.
+type Comparer struct {
+	client    *ent.Client
+	cfg       *config.Config
+	sideCalls *controller.SideCaller
+	logger    *slog.Logger
+
+	jobs controller.BackgroundJobs
+}
+
+// NewComparer creates a recurring alert comparer. Returns nil when
+// comparison is disabled.
+func NewComparer(client *ent.Client, cfg *config.Config, sideCalls *controller.SideCaller) *Comparer {
+	if cfg.Recurrence == nil || !cfg.Recurrence.Enabled {
+		return nil
+	}
+	return &Comparer{
+		client:    client,
+		cfg:       cfg,
+		sideCalls: sideCalls,
+		logger:    slog.Default().With("component", "recurrence-comparer"),
+	}
+}
+
+// SessionCompleted is called by the worker after session completed.
+// Launches the comparison in the background when the session carries an
+// alert_key; a no-op after Stop.
+func (c *Comparer) SessionCompleted(session *ent.AlertSession) {
+	if c == nil || session == nil || session.AlertKey == nil || *session.AlertKey == "" {
+		return
+	}
+	sessionID := session.ID
+	c.jobs.Go(comparisonTimeout, func(ctx context.Context) {
+		if err := c.Compare(ctx, sessionID); err != nil {
+			c.logger.Warn("Recurrence comparison failed", "session_id", sessionID, "error", err)
+		}
+	})
+}
+
+// Stop stops launching comparisons and waits for the running ones to finish.
+func (c *Comparer) Stop() {
+	if c == nil {
+		return
+	}
+	c.jobs.Stop()
+}
+
+// Compare compares the session with its previous investigation. Returns nil
+// without doing anything when the session has no alert_key, no final
+// analysis, no predecessor, or was already compared. An LLM failure is
+// stored as a failed comparison; the returned error only reports database
+// failures.
+func (c *Comparer) Compare(ctx context.Context, sessionID string) error {
+	if c == nil {
+		return nil
+	}
+
+	session, err := c.client.AlertSession.Get(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	if session.AlertKey == nil || *session.AlertKey == "" ||
+		session.FinalAnalysis == nil || *session.FinalAnalysis == "" {
+		return nil
+	}
+	exists, err := c.client.SessionComparison.Query().
+		Where(sessioncomparison.SessionIDEQ(sessionID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check existing comparison: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	previous, err := c.findPrevious(ctx, session)
+	if err != nil {
+		return err
+	}
+	if previous == nil {
+		return nil
+	}
+
+	create := c.client.SessionComparison.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(session.ID).
+		SetPreviousSessionID(previous.ID).
+		SetAlertKey(*session.AlertKey)
+	if cmp, err := c.generate(ctx, session, previous); err != nil {
+		create.SetStatus(sessioncomparison.StatusFailed).
+			SetErrorMessage(err.Error())
+	} else {
+		create.SetStatus(sessioncomparison.StatusCompleted).
+			SetPreviousConclusion(cmp.PreviousConclusion).
+			SetCurrentConclusion(cmp.CurrentConclusion).
+			SetDifferences(cmp.Differences).
+			SetNillableSameRootCause(cmp.SameRootCause)
+	}
+
+	// The result must be stored even if the caller's context expired
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := create.Exec(writeCtx); err != nil {
+		if ent.IsConstraintError(err) {
+			return nil // compared concurrently
+		}
+		return fmt.Errorf("failed to store comparison: %w", err)
+	}
+
+	c.logger.Info("Recurring alert compared",
+		"session_id", session.ID, "previous_session_id", previous.ID)
+	return nil
+}
+
+// findPrevious returns the most recent completed session of the same
+// alert_key and chain created before session within the lookback window.
+// Fan-out siblings, sandbox runs and deleted sessions are skipped.
+func (c *Comparer) findPrevious(ctx context.Context, session *ent.AlertSession) (*ent.AlertSession, error) {
+	query := c.client.AlertSession.Query().
+		Where(
+			alertsession.AlertKeyEQ(*session.AlertKey),
+			alertsession.ChainIDEQ(session.ChainID),
+			alertsession.IDNEQ(session.ID),
+			alertsession.StatusEQ(alertsession.StatusCompleted),
+			alertsession.FinalAnalysisNotNil(),
+			alertsession.FinalAnalysisNEQ(""),
+			alertsession.DeletedAtIsNil(),
+			alertsession.Sandbox(false),
+			alertsession.CreatedAtLT(session.CreatedAt),
+			alertsession.CreatedAtGTE(session.CreatedAt.Add(-c.cfg.Recurrence.LookbackWindow)),
+		)
+	if session.GroupID != nil {
+		query = query.Where(alertsession.Or(
+			alertsession.GroupIDIsNil(),
+			alertsession.GroupIDNEQ(*session.GroupID),
+		))
+	}
+
+	previous, err := query.
+		Order(ent.Desc(alertsession.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query previous session: %w", err)
+	}
+	return previous, nil
+}
+
+// generate runs the comparison LLM call on the comparison provider:
+// recurrence.llm_provider → chain executive_summary_provider → chain
+// llm_provider → defaults.
+func (c *Comparer) generate(ctx context.Context, session, previous *ent.AlertSession) (*Comparison, error) {
+	chain, _ := c.cfg.GetChain(session.ChainID) // nil chain falls back to defaults
+	llmCfg, err := agent.ResolveSideCallConfig(c.cfg, chain, c.cfg.Recurrence.LLMProvider)
+	if err != nil {
+		return nil, fmt.Errorf("comparison provider: %w", err)
+	}
+
+	var alertLanguage string
+	if session.OutputLanguage != nil {
+		alertLanguage = *session.OutputLanguage
+	}
+	systemPrompt := comparisonSystemPrompt
+	if lang := agent.ResolveOutputLanguage(c.cfg.Defaults, chain, alertLanguage); lang != "" {
+		systemPrompt += fmt.Sprintf("\n\nWrite the JSON string values in %s.", lang)
+	}
+
+	text, err := c.sideCalls.Call(ctx, controller.SideCall{
+		SessionID:       session.ID,
+		InteractionType: llminteraction.InteractionTypeRecurrenceComparison,
+		Config:          llmCfg,
+		Messages: []agent.ConversationMessage{
+			{Role: agent.RoleSystem, Content: systemPrompt},
+			{Role: agent.RoleUser, Content: buildComparisonPrompt(session, previous)},
+		},
+		Timeout: comparisonTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("comparison failed: %w", err)
+	}
+	return parseComparison(text)
+}
+
+// buildComparisonPrompt renders both final analyses, each capped at
+// maxAnalysisChars.
+func buildComparisonPrompt(session, previous *ent.AlertSession) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Alert type: %s\n", session.AlertType)
+	fmt.Fprintf(&sb, "Alert key: %s\n", *session.AlertKey)
+
+	fmt.Fprintf(&sb, "\n## Previous investigation (%s)\n\n", previous.CreatedAt.UTC().Format(time.RFC3339))
+	sb.WriteString(controller.TruncatePrompt(*previous.FinalAnalysis, maxAnalysisChars))
+	fmt.Fprintf(&sb, "\n\n## Current investigation (%s)\n\n", session.CreatedAt.UTC().Format(time.RFC3339))
+	sb.WriteString(controller.TruncatePrompt(*session.FinalAnalysis, maxAnalysisChars))
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// parseComparison extracts the comparison JSON object from the LLM output,
+// tolerating markdown fences and surrounding prose.
+func parseComparison(raw string) (*Comparison, error) {
+	var cmp Comparison
+	if err := controller.ParseJSONObject(raw, &cmp); err != nil {
+		return nil, fmt.Errorf("failed to parse LLM comparison: %w", err)
+	}
+	cmp.PreviousConclusion = strings.TrimSpace(cmp.PreviousConclusion)
+	cmp.CurrentConclusion = strings.TrimSpace(cmp.CurrentConclusion)
+	if cmp.PreviousConclusion == "" || cmp.CurrentConclusion == "" {
+		return nil, errors.New("LLM comparison is missing a conclusion")
+	}
+	differences := make([]string, 0, len(cmp.Differences))
+	for _, d := range cmp.Differences {
+		if d = strings.TrimSpace(d); d != "" {
+			differences = append(differences, d)
+		}
+	}
+	cmp.Differences = differences
+	return &cmp, nil
+}
@@ -0,0 +1,136 @@
+package recurrence
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestNewComparer_Disabled(t *testing.T) {
+	disabled := config.DefaultRecurrenceConfig()
+	disabled.Enabled = false
+	assert.Nil(t, NewComparer(nil, &config.Config{Recurrence: disabled}, nil))
+	assert.Nil(t, NewComparer(nil, &config.Config{}, nil))
+	assert.NotNil(t, NewComparer(nil, &config.Config{Recurrence: config.DefaultRecurrenceConfig()}, nil))
+
+	// Nil comparer is a no-op
+	var c *Comparer
+	c.SessionCompleted(&ent.AlertSession{ID: "s1", AlertKey: strPtr("fp")})
+	assert.NoError(t, c.Compare(context.Background(), "s1"))
+	c.Stop()
+}
+
+func TestComparer_Stop(t *testing.T) {
+	c := NewComparer(nil, &config.Config{Recurrence: config.DefaultRecurrenceConfig()}, nil)
+	c.Stop()
+
+	// A comparison launched after Stop would dereference the nil client
+	c.SessionCompleted(&ent.AlertSession{ID: "s1", AlertKey: strPtr("fp")})
+	c.Stop()
+}
+
+func TestParseComparison(t *testing.T) {
+	t.Run("fenced JSON with prose", func(t *testing.T) {
+		raw := "Here is the comparison:\n```json\n" +
+			`{"previous_conclusion":" OOM in payments-api ","current_conclusion":"Bad deploy of payments-api",` +
+			`"differences":["root cause changed from memory to config", " "],"same_root_cause":false}` +
+			"\n```"
+		cmp, err := parseComparison(raw)
+		require.NoError(t, err)
+		assert.Equal(t, "OOM in payments-api", cmp.PreviousConclusion)
+		assert.Equal(t, "Bad deploy of payments-api", cmp.CurrentConclusion)
+		assert.Equal(t, []string{"root cause changed from memory to config"}, cmp.Differences)
+		require.NotNil(t, cmp.SameRootCause)
+		assert.False(t, *cmp.SameRootCause)
+	})
+
+	t.Run("no differences", func(t *testing.T) {
+		cmp, err := parseComparison(`{"previous_conclusion":"a","current_conclusion":"a","differences":[],"same_root_cause":true}`)
+		require.NoError(t, err)
+		assert.Empty(t, cmp.Differences)
+		assert.NotNil(t, cmp.Differences)
+	})
+
+	t.Run("no JSON", func(t *testing.T) {
+		_, err := parseComparison("The investigations agree.")
+		assert.ErrorContains(t, err, "no JSON object")
+	})
+
+	t.Run("missing conclusion", func(t *testing.T) {
+		_, err := parseComparison(`{"previous_conclusion":"a"}`)
+		assert.ErrorContains(t, err, "missing a conclusion")
+	})
+}
+
+func TestBuildComparisonPrompt(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	previous := &ent.AlertSession{
+		CreatedAt:     created,
+		FinalAnalysis: strPtr(strings.Repeat("x", maxAnalysisChars+10)),
+	}
+	current := &ent.AlertSession{
+		AlertType:     "kubernetes",
+		AlertKey:      strPtr("fp-123"),
+		CreatedAt:     created.Add(24 * time.Hour),
+		FinalAnalysis: strPtr("Pod crash looping after deploy."),
+	}
+
+	prompt := buildComparisonPrompt(current, previous)
+	assert.Contains(t, prompt, "Alert key: fp-123")
+	assert.Contains(t, prompt, "## Previous investigation (2026-10-01T12:00:00Z)")
+	assert.Contains(t, prompt, "[truncated]")
+	assert.Contains(t, prompt, "## Current investigation (2026-10-02T12:00:00Z)\n\nPod crash looping after deploy.")
+}
+
+func TestBuildComparisonPrompt_MultiByteCutoff(t *testing.T) {
+	analysis := strPtr("x" + strings.Repeat("é", maxAnalysisChars))
+	prompt := buildComparisonPrompt(
+		&ent.AlertSession{AlertType: "kubernetes", AlertKey: strPtr("fp-123"), FinalAnalysis: analysis},
+		&ent.AlertSession{FinalAnalysis: analysis},
+	)
+	assert.True(t, utf8.ValidString(prompt))
+	assert.Equal(t, 2, strings.Count(prompt, "é\n[truncated]"))
+}
+
+func TestGenerate(t *testing.T) {
+	cfg := &config.Config{
+		Defaults:   &config.Defaults{LLMProvider: "default"},
+		Recurrence: config.DefaultRecurrenceConfig(),
+		LLMProviderRegistry: config.NewLLMProviderRegistry(map[string]*config.LLMProviderConfig{
+			"default": {Type: config.LLMProviderTypeGoogle, Model: "test-model"},
+		}),
+		ChainRegistry: config.NewChainRegistry(map[string]*config.ChainConfig{}),
+	}
+	session := &ent.AlertSession{ChainID: "k8s", AlertKey: strPtr("fp"), FinalAnalysis: strPtr("now")}
+	previous := &ent.AlertSession{FinalAnalysis: strPtr("before")}
+
+	t.Run("parses the response", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{
+			{Text: `{"previous_conclusion":"before","current_conclusion":"now","differences":["different"]}`},
+		})
+		c := &Comparer{cfg: cfg, sideCalls: controller.NewSideCaller(llm, nil)}
+		cmp, err := c.generate(context.Background(), session, previous)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"different"}, cmp.Differences)
+		assert.Nil(t, cmp.SameRootCause)
+	})
+
+	t.Run("LLM error", func(t *testing.T) {
+		llm := agent.NewScriptedLLMClient([]agent.ScriptedResponse{{Error: "quota exceeded"}})
+		c := &Comparer{cfg: cfg, sideCalls: controller.NewSideCaller(llm, nil)}
+		_, err := c.generate(context.Background(), session, previous)
+		assert.ErrorContains(t, err, "quota exceeded")
+	})
+}
@@ -0,0 +1,400 @@
+// Package registration applies agents and chains registered at runtime
+// through the admin API on top of the loaded configuration.
+//
+// A registration is validated with the configuration Validator against the
+// loaded configuration plus every other registration before it is stored.
+// Registrations are stored as versions in the database and every replica
+// reloads them periodically, so a change reaches the whole fleet within
+// refreshInterval. Agents and chains defined in the configuration files (or
+// built in) cannot be overridden, which keeps the config repo authoritative
+// for what it defines.
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// refreshInterval is how often each replica reloads registrations.
+const refreshInterval = 15 * time.Second
+
+// namePattern restricts registered names to what config files use in
+// practice and what is safe in URLs and YAML references.
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,127}$`)
+
+var (
+	// ErrInvalidDefinition is returned for definitions that do not parse or
+	// would make the configuration invalid.
+	ErrInvalidDefinition = errors.New("invalid definition")
+
+	// ErrReserved is returned when the name is defined in the configuration
+	// files or built in.
+	ErrReserved = errors.New("defined in the configuration files")
+
+	// ErrNotRegistered is returned when unregistering a name that is not
+	// registered.
+	ErrNotRegistered = errors.New("not registered")
+
+	// ErrUnavailable is returned when registrations cannot be stored.
+	ErrUnavailable = errors.New("registration is not available")
+)
+
+// Store persists registration versions. Implemented by
+// services.ConfigRegistrationService.
+type Store interface {
+	Latest(ctx context.Context) ([]*ent.ConfigRegistration, error)
+	History(ctx context.Context, kind configregistration.Kind, name string) ([]*ent.ConfigRegistration, error)
+	Append(ctx context.Context, kind configregistration.Kind, name, definition string, deleted bool, author string) (*ent.ConfigRegistration, error)
+}
+
+// Registration is one version of a registered agent or chain.
+type Registration struct {
+	Kind       configregistration.Kind
+	Name       string
+	Version    int
+	Definition string // YAML; empty for deletions
+	Deleted    bool
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+type key struct {
+	kind configregistration.Kind
+	name string
+}
+
+// Manager validates, stores and applies registrations. The configuration's
+// agent and chain registries are replaced in place, so every component
+// holding the Config sees registered agents and chains.
+// Nil-safe: a nil Manager has no registrations and rejects changes.
+type Manager struct {
+	cfg    *config.Config
+	store  Store
+	logger *slog.Logger
+
+	// Loaded (file and built-in) definitions, snapshotted at construction
+	staticAgents map[string]*config.AgentConfig
+	staticChains map[string]*config.ChainConfig
+
+	// mu serializes changes, so a registration is validated against the
+	// registrations it is applied with
+	mu      sync.Mutex
+	applied map[key]*Registration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewManager creates a registration manager for cfg. It must be created
+// before anything is registered, so the registries hold only loaded
+// definitions.
+func NewManager(cfg *config.Config, store Store) *Manager {
+	return &Manager{
+		cfg:          cfg,
+		store:        store,
+		logger:       slog.Default().With("component", "registration"),
+		staticAgents: cfg.AgentRegistry.GetAll(),
+		staticChains: cfg.ChainRegistry.GetAll(),
+		applied:      map[key]*Registration{},
+	}
+}
+
+// Registrations returns the registrations in force, ordered by kind and name.
+func (m *Manager) Registrations() []Registration {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make([]Registration, 0, len(m.applied))
+	for _, r := range m.applied {
+		out = append(out, *r)
+	}
+	slices.SortFunc(out, func(a, b Registration) int {
+		if c := strings.Compare(string(a.Kind), string(b.Kind)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return out
+}
+
+// Registration returns the registration in force for kind and name.
+func (m *Manager) Registration(kind configregistration.Kind, name string) (Registration, bool) {
+	if m == nil {
+		return Registration{}, false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.applied[key{kind, name}]
+	if !ok {
+		return Registration{}, false
+	}
+	return *r, true
+}
+
+// History returns every stored version of kind and name, newest first.
+func (m *Manager) History(ctx context.Context, kind configregistration.Kind, name string) ([]Registration, error) {
+	if m == nil || m.store == nil {
+		return nil, ErrUnavailable
+	}
+	rows, err := m.store.History(ctx, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Registration, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, *registrationFromEnt(row))
+	}
+	return out, nil
+}
+
+// Register validates definition, stores it as the next version of kind and
+// name, and applies it on this replica immediately. Returns an error
+// wrapping ErrInvalidDefinition when the definition is invalid or breaks
+// the configuration, or ErrReserved when name is a loaded definition.
+func (m *Manager) Register(ctx context.Context, kind configregistration.Kind, name, definition, author string) (Registration, error) {
+	if m == nil || m.store == nil {
+		return Registration{}, ErrUnavailable
+	}
+	if !namePattern.MatchString(name) {
+		return Registration{}, fmt.Errorf("%w: invalid %s name %q", ErrInvalidDefinition, kind, name)
+	}
+	if m.isStatic(kind, name) {
+		return Registration{}, fmt.Errorf("%s %q is %w", kind, name, ErrReserved)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	desired := maps.Clone(m.applied)
+	desired[key{kind, name}] = &Registration{Kind: kind, Name: name, Definition: definition}
+	agents, chains, err := m.build(desired)
+	if err != nil {
+		return Registration{}, err
+	}
+
+	row, err := m.store.Append(ctx, kind, name, definition, false, author)
+	if err != nil {
+		return Registration{}, err
+	}
+	r := registrationFromEnt(row)
+	desired[key{kind, name}] = r
+	m.apply(desired, agents, chains)
+	m.logger.Info("Registered definition", "kind", kind, "name", name, "version", r.Version, "author", author)
+	return *r, nil
+}
+
+// Unregister removes a registered agent or chain, storing a deletion as its
+// next version. Fails with ErrInvalidDefinition when something still
+// references it.
+func (m *Manager) Unregister(ctx context.Context, kind configregistration.Kind, name, author string) error {
+	if m == nil || m.store == nil {
+		return ErrUnavailable
+	}
+	if m.isStatic(kind, name) {
+		return fmt.Errorf("%s %q is %w", kind, name, ErrReserved)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.applied[key{kind, name}]; !ok {
+		return fmt.Errorf("%s %q is %w", kind, name, ErrNotRegistered)
+	}
+	desired := maps.Clone(m.applied)
+	delete(desired, key{kind, name})
+	agents, chains, err := m.build(desired)
+	if err != nil {
+		return err
+	}
+
+	row, err := m.store.Append(ctx, kind, name, "", true, author)
+	if err != nil {
+		return err
+	}
+	m.apply(desired, agents, chains)
+	m.logger.Info("Unregistered definition", "kind", kind, "name", name, "version", row.Version, "author", author)
+	return nil
+}
+
+// Refresh reloads registrations from the store and applies them when they
+// changed. A set that no longer validates (e.g. after a config change
+// removed something it references) is rejected as a whole and the
+// registrations in force are kept.
+func (m *Manager) Refresh(ctx context.Context) error {
+	if m == nil || m.store == nil {
+		return nil
+	}
+	rows, err := m.store.Latest(ctx)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[key]*Registration, len(rows))
+	for _, row := range rows {
+		if row.Deleted {
+			continue
+		}
+		r := registrationFromEnt(row)
+		if m.isStatic(r.Kind, r.Name) {
+			m.logger.Warn("Ignoring registration shadowed by the configuration files", "kind", r.Kind, "name", r.Name)
+			continue
+		}
+		desired[key{r.Kind, r.Name}] = r
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if sameVersions(m.applied, desired) {
+		return nil
+	}
+	agents, chains, err := m.build(desired)
+	if err != nil {
+		return err
+	}
+	m.apply(desired, agents, chains)
+	m.logger.Info("Applied registrations", "count", len(desired))
+	return nil
+}
+
+// Start loads the registrations and launches the background refresh loop.
+func (m *Manager) Start(ctx context.Context) {
+	if m == nil || m.store == nil || m.cancel != nil {
+		return
+	}
+	if err := m.Refresh(ctx); err != nil {
+		m.logger.Warn("Failed to load registrations, using loaded configuration only", "error", err)
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	go m.run(ctx)
+}
+
+// Stop signals the refresh loop to exit and waits for it to finish.
+func (m *Manager) Stop() {
+	if m == nil || m.cancel == nil {
+		return
+	}
+	m.cancel()
+	<-m.done
+	m.cancel = nil
+}
+
+func (m *Manager) run(ctx context.Context) {
+	defer close(m.done)
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Keep the registrations in force on failure
+			if err := m.Refresh(ctx); err != nil && ctx.Err() == nil {
+				m.logger.Warn("Failed to refresh registrations", "error", err)
+			}
+		}
+	}
+}
+
+func (m *Manager) isStatic(kind configregistration.Kind, name string) bool {
+	if kind == configregistration.KindAgent {
+		_, ok := m.staticAgents[name]
+		return ok
+	}
+	_, ok := m.staticChains[name]
+	return ok
+}
+
+// build parses and composes the registrations on top of the loaded
+// definitions and validates the resulting configuration. Returns the agent
+// and chain sets to apply.
+func (m *Manager) build(regs map[key]*Registration) (map[string]*config.AgentConfig, map[string]*config.ChainConfig, error) {
+	agents := maps.Clone(m.staticAgents)
+	chains := maps.Clone(m.staticChains)
+	var newAgents, newChains []string
+
+	for k, r := range regs {
+		switch k.kind {
+		case configregistration.KindAgent:
+			agent, err := config.ParseAgentDefinition([]byte(r.Definition))
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: agent %q: %w", ErrInvalidDefinition, k.name, err)
+			}
+			agents[k.name] = agent
+			newAgents = append(newAgents, k.name)
+		case configregistration.KindChain:
+			chain, err := config.ParseChainDefinition([]byte(r.Definition))
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: chain %q: %w", ErrInvalidDefinition, k.name, err)
+			}
+			chains[k.name] = chain
+			newChains = append(newChains, k.name)
+		}
+	}
+
+	if err := config.ComposeRegistered(agents, newAgents, chains, newChains, m.cfg.Defaults); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidDefinition, err)
+	}
+
+	candidate := *m.cfg
+	candidate.AgentRegistry = config.NewAgentRegistry(agents)
+	candidate.ChainRegistry = config.NewChainRegistry(chains)
+	if err := config.NewValidator(&candidate).ValidateAll(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrInvalidDefinition, err)
+	}
+	return agents, chains, nil
+}
+
+// apply swaps the validated sets into the live registries. Caller holds mu.
+func (m *Manager) apply(regs map[key]*Registration, agents map[string]*config.AgentConfig, chains map[string]*config.ChainConfig) {
+	// Agents first, so a new chain never references an agent that is not
+	// there yet
+	m.cfg.AgentRegistry.Replace(agents)
+	m.cfg.ChainRegistry.Replace(chains)
+	m.applied = regs
+}
+
+func sameVersions(a, b map[key]*Registration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, r := range a {
+		if other, ok := b[k]; !ok || other.Version != r.Version {
+			return false
+		}
+	}
+	return true
+}
+
+func registrationFromEnt(row *ent.ConfigRegistration) *Registration {
+	r := &Registration{
+		Kind:       row.Kind,
+		Name:       row.Name,
+		Version:    row.Version,
+		Definition: row.Definition,
+		Deleted:    row.Deleted,
+		CreatedAt:  row.CreatedAt,
+	}
+	if row.CreatedBy != nil {
+		r.CreatedBy = *row.CreatedBy
+	}
+	return r
+}
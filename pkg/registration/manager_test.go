@@ -0,0 +1,258 @@
+package registration
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// fakeStore is an in-memory Store.
+type fakeStore struct {
+	mu        sync.Mutex
+	rows      []*ent.ConfigRegistration
+	appendErr error
+}
+
+func (f *fakeStore) Latest(_ context.Context) ([]*ent.ConfigRegistration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	latest := map[key]*ent.ConfigRegistration{}
+	for _, r := range f.rows {
+		latest[key{r.Kind, r.Name}] = r
+	}
+	out := make([]*ent.ConfigRegistration, 0, len(latest))
+	for _, r := range latest {
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (f *fakeStore) History(_ context.Context, kind configregistration.Kind, name string) ([]*ent.ConfigRegistration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*ent.ConfigRegistration
+	for _, r := range f.rows {
+		if r.Kind == kind && r.Name == name {
+			out = append(out, r)
+		}
+	}
+	slices.Reverse(out)
+	return out, nil
+}
+
+func (f *fakeStore) Append(_ context.Context, kind configregistration.Kind, name, definition string, deleted bool, author string) (*ent.ConfigRegistration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.appendErr != nil {
+		return nil, f.appendErr
+	}
+	version := 1
+	for _, r := range f.rows {
+		if r.Kind == kind && r.Name == name {
+			version = r.Version + 1
+		}
+	}
+	row := &ent.ConfigRegistration{
+		Kind:       kind,
+		Name:       name,
+		Version:    version,
+		Definition: definition,
+		Deleted:    deleted,
+		CreatedBy:  &author,
+		CreatedAt:  time.Now(),
+	}
+	f.rows = append(f.rows, row)
+	return row, nil
+}
+
+// loadConfig loads a quickstart-like configuration (built-ins only).
+func loadConfig(t *testing.T) *config.Config {
+	t.Helper()
+	t.Setenv("GOOGLE_API_KEY", "test-key")
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tarsy.yaml"),
+		[]byte("defaults:\n  llm_provider: google-default\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "llm-providers.yaml"),
+		[]byte("llm_providers: {}\n"), 0o600))
+	cfg, err := config.Initialize(context.Background(), dir)
+	require.NoError(t, err)
+	return cfg
+}
+
+const triageAgent = `
+description: Triages payment alerts
+mcp_servers: [kubernetes-server]
+custom_instructions: Check the payment gateway first.
+`
+
+const paymentsChain = `
+alert_types: [payment-failure]
+stages:
+  - name: triage
+    agents:
+      - name: PaymentsTriage
+`
+
+func TestManager_RegisterAgentAndChain(t *testing.T) {
+	cfg := loadConfig(t)
+	store := &fakeStore{}
+	m := NewManager(cfg, store)
+	ctx := context.Background()
+
+	r, err := m.Register(ctx, configregistration.KindAgent, "PaymentsTriage", triageAgent, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 1, r.Version)
+	assert.Equal(t, "alice", r.CreatedBy)
+	assert.True(t, cfg.AgentRegistry.Has("PaymentsTriage"))
+
+	_, err = m.Register(ctx, configregistration.KindChain, "payments", paymentsChain, "alice")
+	require.NoError(t, err)
+	chainID, err := cfg.ChainRegistry.GetIDByAlertType("payment-failure")
+	require.NoError(t, err)
+	assert.Equal(t, "payments", chainID)
+
+	// Update bumps the version
+	r, err = m.Register(ctx, configregistration.KindAgent, "PaymentsTriage", triageAgent+"max_iterations: 5\n", "bob")
+	require.NoError(t, err)
+	assert.Equal(t, 2, r.Version)
+	agent, err := cfg.AgentRegistry.Get("PaymentsTriage")
+	require.NoError(t, err)
+	require.NotNil(t, agent.MaxIterations)
+	assert.Equal(t, 5, *agent.MaxIterations)
+
+	regs := m.Registrations()
+	require.Len(t, regs, 2)
+	assert.Equal(t, configregistration.KindAgent, regs[0].Kind)
+	assert.Equal(t, configregistration.KindChain, regs[1].Kind)
+
+	history, err := m.History(ctx, configregistration.KindAgent, "PaymentsTriage")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 2, history[0].Version)
+}
+
+func TestManager_RegisterRejections(t *testing.T) {
+	cfg := loadConfig(t)
+	m := NewManager(cfg, &fakeStore{})
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		kind       configregistration.Kind
+		regName    string
+		definition string
+		wantErr    error
+	}{
+		{"loaded agent", configregistration.KindAgent, "KubernetesAgent", triageAgent, ErrReserved},
+		{"loaded chain", configregistration.KindChain, "kubernetes", paymentsChain, ErrReserved},
+		{"invalid name", configregistration.KindAgent, "bad name", triageAgent, ErrInvalidDefinition},
+		{"unknown field", configregistration.KindAgent, "Typo", "descripton: x\n", ErrInvalidDefinition},
+		{"mixins", configregistration.KindAgent, "Mixed", "mixins: [x]\n", ErrInvalidDefinition},
+		{"unknown agent", configregistration.KindChain, "payments", paymentsChain, ErrInvalidDefinition},
+		{"unknown MCP server", configregistration.KindAgent, "Broken", "mcp_servers: [nope]\n", ErrInvalidDefinition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := m.Register(ctx, tt.kind, tt.regName, tt.definition, "alice")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+	assert.Empty(t, m.Registrations())
+}
+
+func TestManager_ExtendsLoadedAgent(t *testing.T) {
+	cfg := loadConfig(t)
+	m := NewManager(cfg, &fakeStore{})
+
+	_, err := m.Register(context.Background(), configregistration.KindAgent, "StrictK8s",
+		"extends: KubernetesAgent\nmax_iterations: 3\n", "alice")
+	require.NoError(t, err)
+
+	base, err := cfg.AgentRegistry.Get("KubernetesAgent")
+	require.NoError(t, err)
+	agent, err := cfg.AgentRegistry.Get("StrictK8s")
+	require.NoError(t, err)
+	assert.Equal(t, base.MCPServers, agent.MCPServers)
+	assert.Equal(t, 3, *agent.MaxIterations)
+}
+
+func TestManager_Unregister(t *testing.T) {
+	cfg := loadConfig(t)
+	store := &fakeStore{}
+	m := NewManager(cfg, store)
+	ctx := context.Background()
+
+	_, err := m.Register(ctx, configregistration.KindAgent, "PaymentsTriage", triageAgent, "alice")
+	require.NoError(t, err)
+	_, err = m.Register(ctx, configregistration.KindChain, "payments", paymentsChain, "alice")
+	require.NoError(t, err)
+
+	// The chain still references the agent
+	err = m.Unregister(ctx, configregistration.KindAgent, "PaymentsTriage", "alice")
+	assert.ErrorIs(t, err, ErrInvalidDefinition)
+
+	require.NoError(t, m.Unregister(ctx, configregistration.KindChain, "payments", "alice"))
+	require.NoError(t, m.Unregister(ctx, configregistration.KindAgent, "PaymentsTriage", "alice"))
+	assert.False(t, cfg.AgentRegistry.Has("PaymentsTriage"))
+	assert.False(t, cfg.ChainRegistry.Has("payments"))
+	assert.Empty(t, m.Registrations())
+
+	assert.ErrorIs(t, m.Unregister(ctx, configregistration.KindChain, "payments", "alice"), ErrNotRegistered)
+	assert.ErrorIs(t, m.Unregister(ctx, configregistration.KindChain, "kubernetes", "alice"), ErrReserved)
+
+	history, err := m.History(ctx, configregistration.KindChain, "payments")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.True(t, history[0].Deleted)
+}
+
+func TestManager_StoreFailureLeavesConfigUnchanged(t *testing.T) {
+	cfg := loadConfig(t)
+	m := NewManager(cfg, &fakeStore{appendErr: errors.New("db down")})
+
+	_, err := m.Register(context.Background(), configregistration.KindAgent, "PaymentsTriage", triageAgent, "alice")
+	require.Error(t, err)
+	assert.False(t, cfg.AgentRegistry.Has("PaymentsTriage"))
+}
+
+func TestManager_RefreshAppliesOtherReplicaChanges(t *testing.T) {
+	store := &fakeStore{}
+	writer := NewManager(loadConfig(t), store)
+	readerCfg := loadConfig(t)
+	reader := NewManager(readerCfg, store)
+	ctx := context.Background()
+
+	_, err := writer.Register(ctx, configregistration.KindAgent, "PaymentsTriage", triageAgent, "alice")
+	require.NoError(t, err)
+	_, err = writer.Register(ctx, configregistration.KindChain, "payments", paymentsChain, "alice")
+	require.NoError(t, err)
+
+	require.NoError(t, reader.Refresh(ctx))
+	assert.True(t, readerCfg.ChainRegistry.Has("payments"))
+	assert.Len(t, reader.Registrations(), 2)
+
+	require.NoError(t, writer.Unregister(ctx, configregistration.KindChain, "payments", "alice"))
+	require.NoError(t, reader.Refresh(ctx))
+	assert.False(t, readerCfg.ChainRegistry.Has("payments"))
+	assert.True(t, readerCfg.AgentRegistry.Has("PaymentsTriage"))
+}
+
+func TestManager_NilSafe(t *testing.T) {
+	var m *Manager
+	assert.Empty(t, m.Registrations())
+	_, err := m.Register(context.Background(), configregistration.KindAgent, "x", triageAgent, "alice")
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.NoError(t, m.Refresh(context.Background()))
+}
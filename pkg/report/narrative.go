@@ -0,0 +1,46 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+)
+
+const narrativeSystemPrompt = `You write short activity digests for TARSy, an automated incident investigation system used by SRE teams.
+
+Given aggregated statistics for a reporting window, write 3-5 plain-text sentences that:
+- state how busy the window was and how reliable the investigations were,
+- call out the alert types that dominated and any with notable failure counts,
+- summarize the common themes among the notable (critical/high severity) root causes,
+- mention LLM token usage and cost only if they are provided.
+
+Do not invent numbers that are not in the statistics. Do not use headings, bullet points, or Markdown.`
+
+// narrativeInput is the JSON payload handed to the LLM.
+type narrativeInput struct {
+	Period string                     `json:"period"`
+	Title  string                     `json:"title"`
+	Stats  schema.ActivityReportStats `json:"stats"`
+}
+
+// narrate asks the LLM for a short narrative summarizing stats.
+func (s *Service) narrate(ctx context.Context, reportID, period, reportTitle string, stats schema.ActivityReportStats) (string, error) {
+	payload, err := json.MarshalIndent(narrativeInput{Period: period, Title: reportTitle, Stats: stats}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal report stats: %w", err)
+	}
+
+	return s.narrator.Call(ctx, controller.SideCall{
+		SessionID: "activity-report:" + reportID,
+		Config:    s.narratorConfig,
+		Messages: []agent.ConversationMessage{
+			{Role: agent.RoleSystem, Content: narrativeSystemPrompt},
+			{Role: agent.RoleUser, Content: "Statistics for the " + period + " activity report:\n\n" + string(payload)},
+		},
+		Timeout: narrativeTimeout,
+	})
+}
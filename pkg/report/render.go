@@ -0,0 +1,201 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// maxSlackNotableSummary caps each notable summary in the Slack message.
+const maxSlackNotableSummary = 200
+
+// statusOrder fixes the order statuses are listed in rendered reports.
+var statusOrder = []string{"completed", "failed", "timed_out", "cancelled", "in_progress", "cancelling", "pending"}
+
+var severityEmoji = map[string]string{
+	"critical": ":rotating_light:",
+	"high":     ":red_circle:",
+}
+
+// title returns the human-readable report title, e.g.
+// "Daily activity report — 2024-06-01".
+func title(period string, start, end time.Time) string {
+	last := end.AddDate(0, 0, -1).Format(time.DateOnly)
+	if period == string(config.ReportPeriodWeekly) {
+		return fmt.Sprintf("Weekly activity report — %s to %s", start.Format(time.DateOnly), last)
+	}
+	return "Daily activity report — " + start.Format(time.DateOnly)
+}
+
+// statusBreakdown renders non-zero status counts in a fixed order,
+// e.g. "3 completed, 1 failed".
+func statusBreakdown(counts map[string]int) string {
+	var parts []string
+	for _, status := range statusOrder {
+		if n := counts[status]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, strings.ReplaceAll(status, "_", " ")))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatRate(rate *float64) string {
+	if rate == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.0f%%", *rate*100)
+}
+
+func formatUsage(stats schema.ActivityReportStats) string {
+	usage := fmt.Sprintf("%d tokens", stats.TotalTokens)
+	if stats.EstimatedCostUsd != nil {
+		usage += fmt.Sprintf(" · $%.2f estimated", *stats.EstimatedCostUsd)
+	}
+	return usage
+}
+
+func alertTypeLabel(alertType string) string {
+	if alertType == "" {
+		return "(none)"
+	}
+	return alertType
+}
+
+func sessionURL(dashboardURL, sessionID string) string {
+	return fmt.Sprintf("%s/sessions/%s", dashboardURL, sessionID)
+}
+
+// slackSummary renders report statistics as Slack mrkdwn.
+func slackSummary(r models.ActivityReportResponse, dashboardURL string) string {
+	st := r.Stats
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "*Sessions:* %d", st.TotalSessions)
+	if breakdown := statusBreakdown(st.StatusCounts); breakdown != "" {
+		fmt.Fprintf(&b, " (%s)", breakdown)
+	}
+	fmt.Fprintf(&b, "\n*Success rate:* %s", formatRate(st.SuccessRate))
+	fmt.Fprintf(&b, "\n*LLM usage:* %s", formatUsage(st))
+
+	if len(st.TopAlertTypes) > 0 {
+		items := make([]string, 0, len(st.TopAlertTypes))
+		for _, at := range st.TopAlertTypes {
+			item := fmt.Sprintf("`%s` %d", alertTypeLabel(at.AlertType), at.Sessions)
+			if at.Failed > 0 {
+				item += fmt.Sprintf(" (%d failed)", at.Failed)
+			}
+			items = append(items, item)
+		}
+		fmt.Fprintf(&b, "\n*Top alert types:* %s", strings.Join(items, ", "))
+	}
+
+	if len(st.NotableSessions) > 0 {
+		b.WriteString("\n*Notable root causes:*")
+		for _, n := range st.NotableSessions {
+			summary := []rune(strings.Join(strings.Fields(n.ExecutiveSummary), " "))
+			if len(summary) > maxSlackNotableSummary {
+				summary = append(summary[:maxSlackNotableSummary], '…')
+			}
+			fmt.Fprintf(&b, "\n• %s <%s|%s> — %s",
+				severityEmoji[n.Severity], sessionURL(dashboardURL, n.SessionID),
+				alertTypeLabel(n.AlertType), string(summary))
+		}
+	}
+	return b.String()
+}
+
+const baseStyle = `font-family: -apple-system, Segoe UI, Helvetica, Arial, sans-serif; font-size: 14px; color: #212121;`
+
+var htmlTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html><body style="` + baseStyle + `">
+<h2 style="margin-bottom: 4px;">{{.Title}}</h2>
+{{- if .Narrative}}
+<p style="white-space: pre-wrap;">{{.Narrative}}</p>
+{{- end}}
+<table cellpadding="4" style="border-collapse: collapse; margin-bottom: 12px;">
+<tr><td><b>Sessions</b></td><td>{{.Sessions}}{{if .Breakdown}} ({{.Breakdown}}){{end}}</td></tr>
+<tr><td><b>Success rate</b></td><td>{{.SuccessRate}}</td></tr>
+<tr><td><b>LLM usage</b></td><td>{{.Usage}}</td></tr>
+</table>
+{{- if .AlertTypes}}
+<h3>Top alert types</h3>
+<table cellpadding="6" style="border-collapse: collapse;">
+<tr style="background: #eeeeee; text-align: left;"><th>Alert type</th><th>Sessions</th><th>Failed</th></tr>
+{{- range .AlertTypes}}
+<tr style="border-top: 1px solid #e0e0e0;"><td>{{.Label}}</td><td>{{.Sessions}}</td><td>{{.Failed}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Notable}}
+<h3>Notable root causes</h3>
+<table cellpadding="6" style="border-collapse: collapse; width: 100%;">
+<tr style="background: #eeeeee; text-align: left;"><th>Severity</th><th>Alert type</th><th>Summary</th></tr>
+{{- range .Notable}}
+<tr style="border-top: 1px solid #e0e0e0; vertical-align: top;">
+<td>{{.Severity}}</td>
+<td><a href="{{.URL}}">{{.AlertType}}</a></td>
+<td style="white-space: pre-wrap;">{{.Summary}}</td>
+</tr>
+{{- end}}
+</table>
+{{- end}}
+</body></html>
+`))
+
+// renderHTML renders the report as an HTML email body.
+func renderHTML(r models.ActivityReportResponse, dashboardURL string) (string, error) {
+	type alertTypeRow struct {
+		Label    string
+		Sessions int
+		Failed   int
+	}
+	type notableRow struct {
+		Severity  string
+		AlertType string
+		URL       string
+		Summary   string
+	}
+
+	data := struct {
+		Title       string
+		Narrative   string
+		Sessions    int
+		Breakdown   string
+		SuccessRate string
+		Usage       string
+		AlertTypes  []alertTypeRow
+		Notable     []notableRow
+	}{
+		Title:       title(r.Period, r.WindowStart, r.WindowEnd),
+		Sessions:    r.Stats.TotalSessions,
+		Breakdown:   statusBreakdown(r.Stats.StatusCounts),
+		SuccessRate: formatRate(r.Stats.SuccessRate),
+		Usage:       formatUsage(r.Stats),
+	}
+	if r.Narrative != nil {
+		data.Narrative = *r.Narrative
+	}
+	for _, at := range r.Stats.TopAlertTypes {
+		data.AlertTypes = append(data.AlertTypes, alertTypeRow{Label: alertTypeLabel(at.AlertType), Sessions: at.Sessions, Failed: at.Failed})
+	}
+	for _, n := range r.Stats.NotableSessions {
+		data.Notable = append(data.Notable, notableRow{
+			Severity:  n.Severity,
+			AlertType: alertTypeLabel(n.AlertType),
+			URL:       sessionURL(dashboardURL, n.SessionID),
+			Summary:   n.ExecutiveSummary,
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render report email: %w", err)
+	}
+	return buf.String(), nil
+}
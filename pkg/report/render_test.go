@@ -0,0 +1,86 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReport() models.ActivityReportResponse {
+	rate := 0.75
+	cost := 3.5
+	narrative := "A busy day dominated by pod crashes."
+	return models.ActivityReportResponse{
+		ReportID:    "rep-1",
+		Period:      "daily",
+		WindowStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		WindowEnd:   time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC),
+		Narrative:   &narrative,
+		Stats: schema.ActivityReportStats{
+			TotalSessions: 5,
+			StatusCounts:  map[string]int{"completed": 3, "failed": 1, "cancelled": 1},
+			SuccessRate:   &rate,
+			TopAlertTypes: []schema.ActivityReportAlertType{
+				{AlertType: "PodCrash", Sessions: 4, Failed: 1},
+				{AlertType: "", Sessions: 1},
+			},
+			NotableSessions: []schema.ActivityReportNotable{
+				{SessionID: "sess-1", AlertType: "PodCrash", Severity: "critical", ExecutiveSummary: "OOM <killed> the\napi pod."},
+			},
+			TotalTokens:      12345,
+			EstimatedCostUsd: &cost,
+		},
+	}
+}
+
+func TestTitle(t *testing.T) {
+	start := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "Daily activity report — 2024-06-03", title("daily", start, start.AddDate(0, 0, 1)))
+	assert.Equal(t, "Weekly activity report — 2024-06-03 to 2024-06-09", title("weekly", start, start.AddDate(0, 0, 7)))
+}
+
+func TestStatusBreakdown(t *testing.T) {
+	assert.Equal(t, "3 completed, 1 timed out, 2 in progress",
+		statusBreakdown(map[string]int{"in_progress": 2, "timed_out": 1, "completed": 3, "failed": 0}))
+	assert.Empty(t, statusBreakdown(nil))
+}
+
+func TestSlackSummary(t *testing.T) {
+	summary := slackSummary(testReport(), "https://dash.example.com")
+
+	assert.Contains(t, summary, "*Sessions:* 5 (3 completed, 1 failed, 1 cancelled)")
+	assert.Contains(t, summary, "*Success rate:* 75%")
+	assert.Contains(t, summary, "*LLM usage:* 12345 tokens · $3.50 estimated")
+	assert.Contains(t, summary, "`PodCrash` 4 (1 failed), `(none)` 1")
+	assert.Contains(t, summary, ":rotating_light: <https://dash.example.com/sessions/sess-1|PodCrash> — OOM <killed> the api pod.")
+
+	t.Run("empty window", func(t *testing.T) {
+		summary := slackSummary(models.ActivityReportResponse{}, "https://dash.example.com")
+		assert.Equal(t, "*Sessions:* 0\n*Success rate:* n/a\n*LLM usage:* 0 tokens", summary)
+	})
+
+	t.Run("long notable summary is truncated", func(t *testing.T) {
+		r := testReport()
+		r.Stats.NotableSessions[0].ExecutiveSummary = strings.Repeat("a", maxSlackNotableSummary+50)
+		summary := slackSummary(r, "https://dash.example.com")
+		assert.Contains(t, summary, strings.Repeat("a", maxSlackNotableSummary)+"…")
+		assert.NotContains(t, summary, strings.Repeat("a", maxSlackNotableSummary+1))
+	})
+}
+
+func TestRenderHTML(t *testing.T) {
+	html, err := renderHTML(testReport(), "https://dash.example.com")
+	require.NoError(t, err)
+
+	assert.Contains(t, html, "Daily activity report — 2024-06-01")
+	assert.Contains(t, html, "A busy day dominated by pod crashes.")
+	assert.Contains(t, html, "5 (3 completed, 1 failed, 1 cancelled)")
+	assert.Contains(t, html, `<a href="https://dash.example.com/sessions/sess-1">PodCrash</a>`)
+	assert.Contains(t, html, "OOM &lt;killed&gt; the")
+	assert.NotContains(t, html, "<killed>")
+}
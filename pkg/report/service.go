@@ -0,0 +1,321 @@
+// Package report generates periodic activity reports (sessions run, success
+// rate, top alert types, notable root causes, LLM cost) and delivers them to
+// Slack, email, and a webhook.
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/email"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+	"github.com/codeready-toolchain/tarsy/pkg/slack"
+)
+
+const (
+	// checkInterval is how often the loop checks for due reports.
+	checkInterval = time.Minute
+
+	// narrativeTimeout bounds the narrative LLM call.
+	narrativeTimeout = 2 * time.Minute
+
+	// deliveryTimeout bounds each delivery (Slack, email, webhook).
+	deliveryTimeout = 30 * time.Second
+)
+
+// StatsSource computes activity statistics for a window.
+// Implemented by services.SessionService.
+type StatsSource interface {
+	GetActivityStats(ctx context.Context, start, end time.Time) (*schema.ActivityReportStats, error)
+}
+
+// Store persists reports. Implemented by services.ReportService.
+type Store interface {
+	CreateReport(ctx context.Context, period activityreport.Period, windowStart, windowEnd time.Time, stats schema.ActivityReportStats) (*ent.ActivityReport, error)
+	SetNarrative(ctx context.Context, reportID, narrative, narrativeErr string) (*ent.ActivityReport, error)
+	ReportExists(ctx context.Context, period activityreport.Period, windowStart time.Time) (bool, error)
+	StoreRenderedReport(ctx context.Context, reportID string, html []byte) error
+}
+
+// Service generates activity reports on schedule and delivers them.
+// It runs as a leader-elected singleton job (see pkg/coordination) and may be
+// started again after Stop. The unique (period, window_start) index still
+// guarantees each window is claimed, narrated, and delivered only once, even
+// if two pods briefly overlap during failover.
+// Nil-safe: all methods are no-ops when service is nil.
+type Service struct {
+	cfg          *config.ReportsConfig
+	dashboardURL string
+	stats        StatsSource
+	store        Store
+	logger       *slog.Logger
+
+	// Optional narrative (nil narrator = stats only)
+	narrator       *controller.SideCaller
+	narratorConfig *agent.ResolvedAgentConfig
+
+	// Optional deliveries
+	slackService *slack.Service
+	emailSender  email.Sender
+	httpClient   *http.Client
+
+	mu        sync.Mutex
+	generated map[config.ReportPeriod]time.Time // period → last window start known to exist
+
+	now    func() time.Time
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewService creates a new report service.
+// Returns nil if reports are disabled.
+func NewService(cfg *config.ReportsConfig, dashboardURL string, stats StatsSource, store Store) *Service {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+	return newService(cfg, dashboardURL, stats, store, time.Now)
+}
+
+func newService(cfg *config.ReportsConfig, dashboardURL string, stats StatsSource, store Store, now func() time.Time) *Service {
+	return &Service{
+		cfg:          cfg,
+		dashboardURL: dashboardURL,
+		stats:        stats,
+		store:        store,
+		logger:       slog.Default().With("component", "report-service"),
+		httpClient:   &http.Client{Timeout: deliveryTimeout},
+		generated:    make(map[config.ReportPeriod]time.Time),
+		now:          now,
+	}
+}
+
+// SetNarrator enables the LLM narrative, generated by sideCalls with
+// llmCfg. Must be called before Start.
+func (s *Service) SetNarrator(sideCalls *controller.SideCaller, llmCfg *agent.ResolvedAgentConfig) {
+	s.narrator = sideCalls
+	s.narratorConfig = llmCfg
+}
+
+// SetSlackService enables Slack delivery to cfg.SlackChannel. Must be called before Start.
+func (s *Service) SetSlackService(svc *slack.Service) {
+	s.slackService = svc
+}
+
+// SetEmailSender enables email delivery to cfg.EmailRecipients. Must be called before Start.
+func (s *Service) SetEmailSender(sender email.Sender) {
+	s.emailSender = sender
+}
+
+// Start launches the background report loop.
+func (s *Service) Start(ctx context.Context) {
+	if s == nil || s.cancel != nil {
+		return
+	}
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	go s.run(ctx)
+
+	s.logger.Info("Report service started",
+		"periods", s.cfg.Periods,
+		"hour_utc", s.cfg.Hour,
+		"weekday", s.cfg.Weekday,
+		"narrative", s.narrator != nil)
+}
+
+// Stop signals the report loop to exit and waits for it to finish.
+func (s *Service) Stop() {
+	if s == nil || s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	s.cancel = nil
+	s.logger.Info("Report service stopped")
+}
+
+func (s *Service) run(ctx context.Context) {
+	defer close(s.done)
+
+	s.generateDue(ctx)
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.generateDue(ctx)
+		}
+	}
+}
+
+// generateDue generates the latest window of each configured period unless
+// it has already been generated (by this or another replica).
+func (s *Service) generateDue(ctx context.Context) {
+	now := s.now()
+	for _, period := range s.cfg.Periods {
+		start, end := latestWindow(period, now, s.cfg.Hour, s.cfg.Weekday)
+
+		s.mu.Lock()
+		known := s.generated[period].Equal(start)
+		s.mu.Unlock()
+		if known {
+			continue
+		}
+
+		exists, err := s.store.ReportExists(ctx, activityreport.Period(period), start)
+		if err != nil {
+			s.logger.Error("Failed to check for existing report", "period", period, "window_start", start, "error", err)
+			continue
+		}
+		if !exists {
+			if err := s.Generate(ctx, period, start, end); err != nil {
+				s.logger.Error("Failed to generate report", "period", period, "window_start", start, "error", err)
+				continue
+			}
+		}
+
+		s.mu.Lock()
+		s.generated[period] = start
+		s.mu.Unlock()
+	}
+}
+
+// Generate computes, stores, narrates, and delivers the report for one
+// window. Returns nil without delivering when another replica already
+// claimed the window. Narrative and delivery failures are logged, not returned.
+func (s *Service) Generate(ctx context.Context, period config.ReportPeriod, start, end time.Time) error {
+	if s == nil {
+		return nil
+	}
+
+	stats, err := s.stats.GetActivityStats(ctx, start, end)
+	if err != nil {
+		return fmt.Errorf("failed to compute activity stats: %w", err)
+	}
+
+	report, err := s.store.CreateReport(ctx, activityreport.Period(period), start, end, *stats)
+	if err != nil {
+		if errors.Is(err, services.ErrAlreadyExists) {
+			s.logger.Debug("Report already generated by another replica", "period", period, "window_start", start)
+			return nil
+		}
+		return err
+	}
+
+	if s.narrator != nil && s.narratorConfig != nil {
+		narrative, narrErr := s.narrate(ctx, report.ID, string(period), title(string(period), start, end), *stats)
+		errMsg := ""
+		if narrErr != nil {
+			s.logger.Warn("Report narrative failed, delivering stats only", "report_id", report.ID, "error", narrErr)
+			errMsg = narrErr.Error()
+		}
+		updated, err := s.store.SetNarrative(ctx, report.ID, narrative, errMsg)
+		if err != nil {
+			s.logger.Error("Failed to store report narrative", "report_id", report.ID, "error", err)
+		} else {
+			report = updated
+		}
+	}
+
+	s.logger.Info("Activity report generated",
+		"report_id", report.ID,
+		"period", period,
+		"window_start", start,
+		"sessions", stats.TotalSessions)
+
+	resp := models.NewActivityReportResponse(report)
+	html, err := renderHTML(resp, s.dashboardURL)
+	if err != nil {
+		s.logger.Error("Failed to render report", "report_id", report.ID, "error", err)
+	} else if err := s.store.StoreRenderedReport(ctx, report.ID, []byte(html)); err != nil {
+		s.logger.Warn("Failed to store rendered report", "report_id", report.ID, "error", err)
+	}
+
+	s.deliver(ctx, resp, html)
+	return nil
+}
+
+// deliver sends the report to every configured target. html is the rendered
+// report, empty when rendering failed (email is then skipped).
+func (s *Service) deliver(ctx context.Context, r models.ActivityReportResponse, html string) {
+	reportTitle := title(r.Period, r.WindowStart, r.WindowEnd)
+	narrative := ""
+	if r.Narrative != nil {
+		narrative = *r.Narrative
+	}
+
+	if s.cfg.SlackChannel != "" && s.slackService != nil {
+		s.slackService.NotifyReport(ctx, slack.ReportInput{
+			Channel:   s.cfg.SlackChannel,
+			Title:     reportTitle,
+			Summary:   slackSummary(r, s.dashboardURL),
+			Narrative: narrative,
+		})
+	}
+
+	if len(s.cfg.EmailRecipients) > 0 && s.emailSender != nil && html != "" {
+		if err := s.sendEmail(ctx, reportTitle, html); err != nil {
+			s.logger.Error("Failed to email report", "report_id", r.ReportID, "error", err)
+		}
+	}
+
+	if s.cfg.WebhookURL != "" {
+		if err := s.postWebhook(ctx, r); err != nil {
+			s.logger.Error("Failed to post report webhook", "report_id", r.ReportID, "error", err)
+		}
+	}
+}
+
+func (s *Service) sendEmail(ctx context.Context, subject, html string) error {
+	sendCtx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	return s.emailSender.Send(sendCtx, email.Message{
+		To:      s.cfg.EmailRecipients,
+		Subject: "[TARSy] " + subject,
+		HTML:    html,
+	})
+}
+
+func (s *Service) postWebhook(ctx context.Context, r models.ActivityReportResponse) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, s.cfg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
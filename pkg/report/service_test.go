@@ -0,0 +1,288 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/agent"
+	"github.com/codeready-toolchain/tarsy/pkg/agent/controller"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/email"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStats struct {
+	calls int
+}
+
+func (f *fakeStats) GetActivityStats(_ context.Context, _, _ time.Time) (*schema.ActivityReportStats, error) {
+	f.calls++
+	return &schema.ActivityReportStats{TotalSessions: 3, StatusCounts: map[string]int{"completed": 3}}, nil
+}
+
+// fakeStore mimics services.ReportService, including the unique
+// (period, window_start) constraint.
+type fakeStore struct {
+	mu       sync.Mutex
+	reports  map[string]*ent.ActivityReport
+	rendered map[string]string
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{reports: make(map[string]*ent.ActivityReport), rendered: make(map[string]string)}
+}
+
+func storeKey(period activityreport.Period, start time.Time) string {
+	return string(period) + "|" + start.Format(time.RFC3339)
+}
+
+func (f *fakeStore) CreateReport(_ context.Context, period activityreport.Period, start, end time.Time, stats schema.ActivityReportStats) (*ent.ActivityReport, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := storeKey(period, start)
+	if _, ok := f.reports[key]; ok {
+		return nil, services.ErrAlreadyExists
+	}
+	r := &ent.ActivityReport{ID: key, Period: period, WindowStart: start, WindowEnd: end, Stats: stats}
+	f.reports[key] = r
+	return r, nil
+}
+
+func (f *fakeStore) SetNarrative(_ context.Context, reportID, narrative, narrativeErr string) (*ent.ActivityReport, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, ok := f.reports[reportID]
+	if !ok {
+		return nil, services.ErrNotFound
+	}
+	if narrative != "" {
+		r.Narrative = &narrative
+	}
+	if narrativeErr != "" {
+		r.NarrativeError = &narrativeErr
+	}
+	return r, nil
+}
+
+func (f *fakeStore) ReportExists(_ context.Context, period activityreport.Period, start time.Time) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.reports[storeKey(period, start)]
+	return ok, nil
+}
+
+func (f *fakeStore) StoreRenderedReport(_ context.Context, reportID string, html []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rendered[reportID] = string(html)
+	return nil
+}
+
+type fakeLLM struct {
+	chunks []agent.Chunk
+	input  *agent.GenerateInput
+}
+
+func (f *fakeLLM) Generate(_ context.Context, input *agent.GenerateInput) (<-chan agent.Chunk, error) {
+	f.input = input
+	ch := make(chan agent.Chunk, len(f.chunks))
+	for _, c := range f.chunks {
+		ch <- c
+	}
+	close(ch)
+	return ch, nil
+}
+
+func (f *fakeLLM) Close() error { return nil }
+
+type fakeSender struct {
+	sent []email.Message
+	err  error
+}
+
+func (f *fakeSender) Send(_ context.Context, msg email.Message) error {
+	f.sent = append(f.sent, msg)
+	return f.err
+}
+
+func fixedNow() time.Time { return time.Date(2024, 6, 5, 9, 0, 0, 0, time.UTC) }
+
+func TestNewService(t *testing.T) {
+	assert.Nil(t, NewService(nil, "", &fakeStats{}, newFakeStore()))
+	assert.Nil(t, NewService(&config.ReportsConfig{}, "", &fakeStats{}, newFakeStore()))
+	assert.NotNil(t, NewService(&config.ReportsConfig{Enabled: true}, "", &fakeStats{}, newFakeStore()))
+}
+
+func TestService_NilReceiver(t *testing.T) {
+	var s *Service
+	s.Start(context.Background())
+	s.Stop()
+	assert.NoError(t, s.Generate(context.Background(), config.ReportPeriodDaily, time.Time{}, time.Time{}))
+}
+
+func TestService_Restart(t *testing.T) {
+	cfg := &config.ReportsConfig{Enabled: true, Periods: []config.ReportPeriod{config.ReportPeriodDaily}, Hour: 8}
+	store := newFakeStore()
+	stats := &fakeStats{}
+	s := newService(cfg, "", stats, store, fixedNow)
+
+	s.Start(context.Background())
+	s.Stop()
+	s.Start(context.Background())
+	s.Stop()
+
+	// The second run remembers the window generated by the first.
+	assert.Len(t, store.reports, 1)
+	assert.Equal(t, 1, stats.calls)
+}
+
+func TestService_GenerateDue(t *testing.T) {
+	cfg := &config.ReportsConfig{
+		Enabled: true,
+		Periods: []config.ReportPeriod{config.ReportPeriodDaily, config.ReportPeriodWeekly},
+		Hour:    8,
+		Weekday: time.Monday,
+	}
+
+	t.Run("generates each period once", func(t *testing.T) {
+		stats, store := &fakeStats{}, newFakeStore()
+		svc := newService(cfg, "https://dash.example.com", stats, store, fixedNow)
+
+		svc.generateDue(context.Background())
+		svc.generateDue(context.Background())
+
+		assert.Equal(t, 2, stats.calls)
+		assert.Len(t, store.reports, 2)
+		daily := store.reports[storeKey(activityreport.PeriodDaily, time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC))]
+		require.NotNil(t, daily)
+		assert.Equal(t, time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC), daily.WindowEnd)
+		assert.Nil(t, daily.Narrative, "no narrator configured")
+	})
+
+	t.Run("skips windows already generated by another replica", func(t *testing.T) {
+		stats, store := &fakeStats{}, newFakeStore()
+		other := newService(cfg, "", &fakeStats{}, store, fixedNow)
+		other.generateDue(context.Background())
+
+		svc := newService(cfg, "", stats, store, fixedNow)
+		svc.generateDue(context.Background())
+
+		assert.Zero(t, stats.calls)
+	})
+}
+
+func TestService_Generate(t *testing.T) {
+	start := time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+
+	t.Run("narrative and all deliveries", func(t *testing.T) {
+		var webhookBody models.ActivityReportResponse
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&webhookBody))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		cfg := &config.ReportsConfig{
+			Enabled:         true,
+			EmailRecipients: []string{"sre@example.com"},
+			WebhookURL:      srv.URL,
+		}
+		store := newFakeStore()
+		llm := &fakeLLM{chunks: []agent.Chunk{
+			&agent.TextChunk{Content: "Three sessions, "},
+			&agent.TextChunk{Content: "all completed."},
+			&agent.UsageChunk{TotalTokens: 42},
+		}}
+		sender := &fakeSender{}
+		provider := &config.LLMProviderConfig{Model: "test-model"}
+
+		svc := newService(cfg, "https://dash.example.com", &fakeStats{}, store, fixedNow)
+		svc.SetNarrator(controller.NewSideCaller(llm, nil),
+			&agent.ResolvedAgentConfig{LLMProvider: provider, LLMBackend: config.LLMBackendLangChain})
+		svc.SetEmailSender(sender)
+
+		require.NoError(t, svc.Generate(context.Background(), config.ReportPeriodDaily, start, end))
+
+		require.NotNil(t, llm.input)
+		assert.Equal(t, provider, llm.input.Config)
+		assert.Equal(t, config.LLMBackendLangChain, llm.input.Backend)
+		require.Len(t, llm.input.Messages, 2)
+		assert.Contains(t, llm.input.Messages[1].Content, `"total_sessions": 3`)
+
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, []string{"sre@example.com"}, sender.sent[0].To)
+		assert.Equal(t, "[TARSy] Daily activity report — 2024-06-04", sender.sent[0].Subject)
+		assert.Contains(t, sender.sent[0].HTML, "Three sessions, all completed.")
+
+		require.Len(t, store.rendered, 1)
+		for _, html := range store.rendered {
+			assert.Equal(t, sender.sent[0].HTML, html, "the emailed HTML is stored as the rendered report")
+		}
+
+		require.NotNil(t, webhookBody.Narrative)
+		assert.Equal(t, "Three sessions, all completed.", *webhookBody.Narrative)
+		assert.Equal(t, "daily", webhookBody.Period)
+		assert.Equal(t, 3, webhookBody.Stats.TotalSessions)
+	})
+
+	t.Run("narrative error is stored and stats still delivered", func(t *testing.T) {
+		store := newFakeStore()
+		sender := &fakeSender{}
+		svc := newService(&config.ReportsConfig{Enabled: true, EmailRecipients: []string{"sre@example.com"}},
+			"", &fakeStats{}, store, fixedNow)
+		llm := &fakeLLM{chunks: []agent.Chunk{&agent.ErrorChunk{Message: "quota exceeded", Code: "429"}}}
+		svc.SetNarrator(controller.NewSideCaller(llm, nil),
+			&agent.ResolvedAgentConfig{LLMProvider: &config.LLMProviderConfig{}, LLMBackend: config.LLMBackendLangChain})
+		svc.SetEmailSender(sender)
+
+		require.NoError(t, svc.Generate(context.Background(), config.ReportPeriodDaily, start, end))
+
+		r := store.reports[storeKey(activityreport.PeriodDaily, start)]
+		assert.Nil(t, r.Narrative)
+		require.NotNil(t, r.NarrativeError)
+		assert.Contains(t, *r.NarrativeError, "quota exceeded")
+		assert.Len(t, sender.sent, 1)
+	})
+
+	t.Run("delivery failures do not fail generation", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		svc := newService(&config.ReportsConfig{
+			Enabled:         true,
+			EmailRecipients: []string{"sre@example.com"},
+			WebhookURL:      srv.URL,
+		}, "", &fakeStats{}, newFakeStore(), fixedNow)
+		svc.SetEmailSender(&fakeSender{err: errors.New("smtp down")})
+
+		assert.NoError(t, svc.Generate(context.Background(), config.ReportPeriodDaily, start, end))
+	})
+
+	t.Run("already claimed window is not delivered again", func(t *testing.T) {
+		store := newFakeStore()
+		sender := &fakeSender{}
+		svc := newService(&config.ReportsConfig{Enabled: true, EmailRecipients: []string{"sre@example.com"}},
+			"", &fakeStats{}, store, fixedNow)
+		svc.SetEmailSender(sender)
+
+		require.NoError(t, svc.Generate(context.Background(), config.ReportPeriodDaily, start, end))
+		require.NoError(t, svc.Generate(context.Background(), config.ReportPeriodDaily, start, end))
+		assert.Len(t, sender.sent, 1)
+	})
+}
@@ -0,0 +1,37 @@
+package report
+
+import (
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// latestWindow returns the most recent report window for period whose
+// generation time (window end + hour) is not after now. Windows are UTC
+// half-open intervals [start, end): daily windows cover one calendar day,
+// weekly windows cover the seven days before the configured weekday.
+func latestWindow(period config.ReportPeriod, now time.Time, hour int, weekday time.Weekday) (time.Time, time.Time) {
+	now = now.UTC()
+	today := startOfDay(now)
+	genOffset := time.Duration(hour) * time.Hour
+
+	switch period {
+	case config.ReportPeriodWeekly:
+		daysSince := (int(today.Weekday()) - int(weekday) + 7) % 7
+		end := today.AddDate(0, 0, -daysSince)
+		if now.Before(end.Add(genOffset)) {
+			end = end.AddDate(0, 0, -7)
+		}
+		return end.AddDate(0, 0, -7), end
+	default:
+		end := today
+		if now.Before(end.Add(genOffset)) {
+			end = end.AddDate(0, 0, -1)
+		}
+		return end.AddDate(0, 0, -1), end
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
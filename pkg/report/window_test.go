@@ -0,0 +1,45 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatestWindow(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2024, 6, d, h, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		period    config.ReportPeriod
+		now       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"daily after hour covers yesterday", config.ReportPeriodDaily, day(5, 9), day(4, 0), day(5, 0)},
+		{"daily at hour covers yesterday", config.ReportPeriodDaily, day(5, 8), day(4, 0), day(5, 0)},
+		{"daily before hour covers day before yesterday", config.ReportPeriodDaily, day(5, 7), day(3, 0), day(4, 0)},
+		// 2024-06-03 is a Monday.
+		{"weekly on weekday after hour", config.ReportPeriodWeekly, day(3, 9), time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC), day(3, 0)},
+		{"weekly on weekday before hour", config.ReportPeriodWeekly, day(3, 7), time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC)},
+		{"weekly mid-week", config.ReportPeriodWeekly, day(6, 15), time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC), day(3, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := latestWindow(tt.period, tt.now, 8, time.Monday)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+		})
+	}
+
+	t.Run("non-UTC input is normalized", func(t *testing.T) {
+		loc := time.FixedZone("UTC+10", 10*60*60)
+		// 2024-06-05 02:00 at UTC+10 is 2024-06-04 16:00 UTC.
+		start, end := latestWindow(config.ReportPeriodDaily, time.Date(2024, 6, 5, 2, 0, 0, 0, loc), 8, time.Monday)
+		assert.Equal(t, day(3, 0), start)
+		assert.Equal(t, day(4, 0), end)
+	})
+}
@@ -0,0 +1,64 @@
+// Package requestid carries the correlation ID of an API call (X-Request-ID)
+// through contexts, so a single incident can be traced across logs, database
+// records, and WebSocket events.
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Header is the HTTP header clients may set and responses always carry.
+const Header = "X-Request-ID"
+
+// maxLen caps client-supplied IDs (stored in varchar columns and log lines).
+const maxLen = 128
+
+type ctxKey struct{}
+
+// New returns a freshly generated request ID.
+func New() string {
+	return uuid.New().String()
+}
+
+// Sanitize returns id if it is acceptable as a client-supplied request ID:
+// 1-128 printable ASCII characters without spaces. Returns "" otherwise so
+// the caller generates a new one.
+func Sanitize(id string) string {
+	if id == "" || len(id) > maxLen {
+		return ""
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return ""
+		}
+	}
+	return id
+}
+
+// WithContext returns a copy of ctx carrying id. An empty id returns ctx unchanged.
+func WithContext(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// FromContext returns the request ID carried by ctx, or "".
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// Ptr returns the request ID carried by ctx as a pointer for nillable
+// columns, or nil when there is none.
+func Ptr(ctx context.Context) *string {
+	if id := FromContext(ctx); id != "" {
+		return &id
+	}
+	return nil
+}
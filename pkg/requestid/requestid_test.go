@@ -0,0 +1,50 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"uuid", "0b6f3c1e-5a1d-4d8e-9d43-1c2f3e4a5b6c", "0b6f3c1e-5a1d-4d8e-9d43-1c2f3e4a5b6c"},
+		{"opaque token", "req_01HZX:abc/def=", "req_01HZX:abc/def="},
+		{"empty", "", ""},
+		{"space", "abc def", ""},
+		{"newline", "abc\ninjected=1", ""},
+		{"non-ascii", "réq", ""},
+		{"max length", strings.Repeat("a", maxLen), strings.Repeat("a", maxLen)},
+		{"too long", strings.Repeat("a", maxLen+1), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Sanitize(tt.in))
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, FromContext(ctx))
+	assert.Nil(t, Ptr(ctx))
+	assert.Equal(t, ctx, WithContext(ctx, ""))
+
+	ctx = WithContext(ctx, "req-1")
+	assert.Equal(t, "req-1", FromContext(ctx))
+	require.NotNil(t, Ptr(ctx))
+	assert.Equal(t, "req-1", *Ptr(ctx))
+
+	// Survives cancellation-detached derivation.
+	assert.Equal(t, "req-1", FromContext(context.WithoutCancel(ctx)))
+
+	assert.NotEqual(t, New(), New())
+	assert.NotEmpty(t, Sanitize(New()), "generated IDs pass Sanitize")
+}
@@ -0,0 +1,168 @@
+// Package resultexport writes the final export of a session — the session
+// detail JSON and the Markdown report — to object storage for chains with a
+// result_export block, so results reach data lakes and archives
+// independently of the database retention policy.
+package resultexport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/blobstore"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/sessionreport"
+)
+
+// exportTimeout bounds loading and writing one session's export.
+const exportTimeout = 2 * time.Minute
+
+// DetailSource loads the session detail that is exported. Implemented by
+// services.SessionService.
+type DetailSource interface {
+	GetSessionDetail(ctx context.Context, sessionID string) (*models.SessionDetailResponse, error)
+}
+
+// Exporter writes session exports to the result_export destination of the
+// session's chain. Writes run in the background so finalization is never
+// blocked on a slow bucket. Nil-safe: all methods are no-ops when the
+// exporter is nil.
+type Exporter struct {
+	sessions     DetailSource
+	dashboardURL string
+	targets      map[string]target // by chain ID
+	logger       *slog.Logger
+	wg           sync.WaitGroup
+}
+
+// target is the destination and settings of one chain.
+type target struct {
+	cfg   *config.ResultExportConfig
+	store blobstore.Store
+}
+
+// NewExporter creates the destination stores of every chain with a
+// result_export block. Returns nil (exporting disabled) when no chain has one.
+func NewExporter(chains *config.ChainRegistry, sessions DetailSource, dashboardURL string) (*Exporter, error) {
+	targets := make(map[string]target)
+	all := chains.GetAll()
+	for _, chainID := range slices.Sorted(maps.Keys(all)) {
+		cfg := all[chainID].ResultExport
+		if cfg == nil {
+			continue
+		}
+		store, err := blobstore.New(cfg.StoreConfig(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("chain %s: result_export: %w", chainID, err)
+		}
+		targets[chainID] = target{cfg: cfg, store: store}
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	return newExporter(targets, sessions, dashboardURL), nil
+}
+
+func newExporter(targets map[string]target, sessions DetailSource, dashboardURL string) *Exporter {
+	return &Exporter{
+		sessions:     sessions,
+		dashboardURL: dashboardURL,
+		targets:      targets,
+		logger:       slog.Default().With("component", "result-exporter"),
+	}
+}
+
+// Chains returns the IDs of the chains that export results, sorted.
+func (e *Exporter) Chains() []string {
+	if e == nil {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(e.targets))
+}
+
+// Export writes the export of a terminal session in the background. It is a
+// no-op when the session's chain has no result_export block.
+func (e *Exporter) Export(ctx context.Context, sessionID, chainID string) {
+	if e == nil {
+		return
+	}
+	t, ok := e.targets[chainID]
+	if !ok {
+		return
+	}
+
+	// Export even if the caller's context is cancelled (e.g. shutdown).
+	exportCtx := context.WithoutCancel(ctx)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ctx, cancel := context.WithTimeout(exportCtx, exportTimeout)
+		defer cancel()
+		if err := e.export(ctx, sessionID, t); err != nil {
+			e.logger.Error("Failed to export session result",
+				"session_id", sessionID, "chain_id", chainID, "error", err)
+		}
+	}()
+}
+
+// Wait blocks until all pending exports have finished.
+func (e *Exporter) Wait() {
+	if e == nil {
+		return
+	}
+	e.wg.Wait()
+}
+
+func (e *Exporter) export(ctx context.Context, sessionID string, t target) error {
+	detail, err := e.sessions.GetSessionDetail(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to load session detail: %w", err)
+	}
+
+	now := time.Now()
+	completedAt := now
+	if detail.CompletedAt != nil {
+		completedAt = *detail.CompletedAt
+	}
+
+	if t.cfg.WantsFormat(config.ResultExportJSON) {
+		body, err := json.Marshal(detail)
+		if err != nil {
+			return fmt.Errorf("failed to marshal session detail: %w", err)
+		}
+		key := ObjectKey(sessionID, completedAt, config.ResultExportJSON)
+		if err := t.store.Put(ctx, key, body, "application/json"); err != nil {
+			return fmt.Errorf("failed to write %s: %w", key, err)
+		}
+	}
+	if t.cfg.WantsFormat(config.ResultExportMarkdown) {
+		body, err := sessionreport.Render(sessionreport.Build(detail, e.dashboardURL, now), sessionreport.FormatMarkdown)
+		if err != nil {
+			return fmt.Errorf("failed to render session report: %w", err)
+		}
+		key := ObjectKey(sessionID, completedAt, config.ResultExportMarkdown)
+		if err := t.store.Put(ctx, key, body, sessionreport.FormatMarkdown.ContentType()); err != nil {
+			return fmt.Errorf("failed to write %s: %w", key, err)
+		}
+	}
+
+	e.logger.Info("Exported session result", "session_id", sessionID)
+	return nil
+}
+
+// ObjectKey returns the key (relative to the destination prefix) of a
+// session export: <yyyy>/<mm>/<dd>/<session_id>.<json|md>, dated by the
+// completion time in UTC so data-lake tables can partition by day.
+func ObjectKey(sessionID string, completedAt time.Time, format config.ResultExportFormat) string {
+	ext := "json"
+	if format == config.ResultExportMarkdown {
+		ext = sessionreport.FormatMarkdown.Extension()
+	}
+	return fmt.Sprintf("%s/%s.%s", completedAt.UTC().Format("2006/01/02"), sessionID, ext)
+}
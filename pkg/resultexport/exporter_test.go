@@ -0,0 +1,193 @@
+package resultexport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/blobstore"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+type memStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func newMemStore() *memStore {
+	return &memStore{objects: make(map[string][]byte), types: make(map[string]string)}
+}
+
+func (m *memStore) Put(_ context.Context, key string, data []byte, contentType string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = data
+	m.types[key] = contentType
+	return nil
+}
+
+func (m *memStore) Get(_ context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, blobstore.ErrNotFound
+	}
+	return data, nil
+}
+
+func (m *memStore) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, key)
+	return nil
+}
+
+type fakeSessions map[string]*models.SessionDetailResponse
+
+func (f fakeSessions) GetSessionDetail(_ context.Context, sessionID string) (*models.SessionDetailResponse, error) {
+	if d, ok := f[sessionID]; ok {
+		return d, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func testDetail(id string, completedAt time.Time) *models.SessionDetailResponse {
+	alertType := "PodCrash"
+	analysis := "The pod was OOMKilled."
+	return &models.SessionDetailResponse{
+		ID:            id,
+		AlertType:     &alertType,
+		Status:        "completed",
+		ChainID:       "k8s",
+		FinalAnalysis: &analysis,
+		CreatedAt:     completedAt.Add(-time.Minute),
+		CompletedAt:   &completedAt,
+	}
+}
+
+func TestObjectKey(t *testing.T) {
+	at := time.Date(2026, 3, 7, 23, 30, 0, 0, time.FixedZone("EST", -5*3600))
+	assert.Equal(t, "2026/03/08/sess-1.json", ObjectKey("sess-1", at, config.ResultExportJSON))
+	assert.Equal(t, "2026/03/08/sess-1.md", ObjectKey("sess-1", at, config.ResultExportMarkdown))
+	for _, f := range []config.ResultExportFormat{config.ResultExportJSON, config.ResultExportMarkdown} {
+		assert.NoError(t, blobstore.ValidateKey(ObjectKey("0f8b9c2e-4d1a-4b6e-9a57-3c2d1e0f9a8b", at, f)))
+	}
+}
+
+func TestExporter_Export(t *testing.T) {
+	completedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	sessions := fakeSessions{"sess-1": testDetail("sess-1", completedAt)}
+
+	t.Run("writes json and markdown by default", func(t *testing.T) {
+		store := newMemStore()
+		e := newExporter(map[string]target{
+			"k8s": {cfg: &config.ResultExportConfig{Backend: config.BlobBackendS3}, store: store},
+		}, sessions, "https://tarsy.example.com")
+
+		e.Export(context.Background(), "sess-1", "k8s")
+		e.Wait()
+
+		require.Len(t, store.objects, 2)
+		raw := store.objects["2026/10/01/sess-1.json"]
+		require.NotNil(t, raw)
+		var detail models.SessionDetailResponse
+		require.NoError(t, json.Unmarshal(raw, &detail))
+		assert.Equal(t, "sess-1", detail.ID)
+		assert.Equal(t, "application/json", store.types["2026/10/01/sess-1.json"])
+
+		md := string(store.objects["2026/10/01/sess-1.md"])
+		assert.Contains(t, md, "The pod was OOMKilled.")
+		assert.Contains(t, md, "https://tarsy.example.com/sessions/sess-1")
+	})
+
+	t.Run("honors formats", func(t *testing.T) {
+		store := newMemStore()
+		e := newExporter(map[string]target{
+			"k8s": {cfg: &config.ResultExportConfig{Formats: []config.ResultExportFormat{config.ResultExportMarkdown}}, store: store},
+		}, sessions, "")
+
+		e.Export(context.Background(), "sess-1", "k8s")
+		e.Wait()
+
+		assert.Len(t, store.objects, 1)
+		assert.Contains(t, store.objects, "2026/10/01/sess-1.md")
+	})
+
+	t.Run("chains without result_export are skipped", func(t *testing.T) {
+		store := newMemStore()
+		e := newExporter(map[string]target{
+			"k8s": {cfg: &config.ResultExportConfig{}, store: store},
+		}, sessions, "")
+
+		e.Export(context.Background(), "sess-1", "other")
+		e.Wait()
+
+		assert.Empty(t, store.objects)
+	})
+
+	t.Run("runs after the caller's context is cancelled", func(t *testing.T) {
+		store := newMemStore()
+		e := newExporter(map[string]target{
+			"k8s": {cfg: &config.ResultExportConfig{}, store: store},
+		}, sessions, "")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		e.Export(ctx, "sess-1", "k8s")
+		e.Wait()
+
+		assert.Len(t, store.objects, 2)
+	})
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Run("nil when no chain exports", func(t *testing.T) {
+		chains := config.NewChainRegistry(map[string]*config.ChainConfig{"k8s": {AlertTypes: []string{"PodCrash"}}})
+		e, err := NewExporter(chains, fakeSessions{}, "")
+		require.NoError(t, err)
+		assert.Nil(t, e)
+
+		// Nil-safe
+		e.Export(context.Background(), "sess-1", "k8s")
+		e.Wait()
+		assert.Empty(t, e.Chains())
+	})
+
+	t.Run("local destination under prefix", func(t *testing.T) {
+		dir := t.TempDir()
+		completedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		chains := config.NewChainRegistry(map[string]*config.ChainConfig{
+			"k8s": {
+				AlertTypes: []string{"PodCrash"},
+				ResultExport: &config.ResultExportConfig{
+					Backend: config.BlobBackendLocal,
+					Prefix:  "results/",
+					Formats: []config.ResultExportFormat{config.ResultExportJSON},
+					Local:   config.LocalBlobStoreConfig{Dir: dir},
+				},
+			},
+			"quiet": {AlertTypes: []string{"Other"}},
+		})
+
+		e, err := NewExporter(chains, fakeSessions{"sess-1": testDetail("sess-1", completedAt)}, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"k8s"}, e.Chains())
+
+		e.Export(context.Background(), "sess-1", "k8s")
+		e.Wait()
+
+		_, err = os.Stat(filepath.Join(dir, "results", "2026", "10", "01", "sess-1.json"))
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,60 @@
+// Package savedview evaluates users' saved session filters against session
+// status transitions and notifies subscribers of matches.
+package savedview
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+)
+
+// Session is the session state evaluated at a status transition.
+type Session struct {
+	ID            string
+	AlertType     string
+	ChainID       string
+	Status        alertsession.Status
+	AlertData     string
+	FinalAnalysis string // empty until the session finishes
+}
+
+// Matches reports whether a status transition of s matches the filter.
+//
+// A filter with a status list fires on transitions into one of those
+// statuses. A filter without one fires once per session, on the terminal
+// transition. CreatedWithin only narrows the dashboard list: a session
+// reaching a transition is always new enough.
+func Matches(f schema.SavedViewFilter, s Session) bool {
+	if f.Status != "" {
+		if !slices.Contains(strings.Split(f.Status, ","), string(s.Status)) {
+			return false
+		}
+	} else if !isTerminal(s.Status) {
+		return false
+	}
+	if f.AlertType != "" && f.AlertType != s.AlertType {
+		return false
+	}
+	if f.ChainID != "" && f.ChainID != s.ChainID {
+		return false
+	}
+	if f.Search != "" {
+		needle := strings.ToLower(f.Search)
+		if !strings.Contains(strings.ToLower(s.AlertData), needle) &&
+			!strings.Contains(strings.ToLower(s.FinalAnalysis), needle) {
+			return false
+		}
+	}
+	return true
+}
+
+func isTerminal(status alertsession.Status) bool {
+	switch status {
+	case alertsession.StatusCompleted, alertsession.StatusFailed,
+		alertsession.StatusTimedOut, alertsession.StatusCancelled:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package savedview
+
+import (
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatches(t *testing.T) {
+	failedKube := Session{
+		ID:            "s1",
+		AlertType:     "PodCrashLooping",
+		ChainID:       "k8s",
+		Status:        alertsession.StatusFailed,
+		AlertData:     "namespace: payments\npod: api-7f9",
+		FinalAnalysis: "OOMKilled due to memory limit",
+	}
+	inProgress := failedKube
+	inProgress.Status = alertsession.StatusInProgress
+	inProgress.FinalAnalysis = ""
+
+	tests := []struct {
+		name    string
+		filter  schema.SavedViewFilter
+		session Session
+		want    bool
+	}{
+		{name: "empty filter matches terminal", filter: schema.SavedViewFilter{}, session: failedKube, want: true},
+		{name: "empty filter ignores in_progress", filter: schema.SavedViewFilter{}, session: inProgress, want: false},
+		{name: "status list match", filter: schema.SavedViewFilter{Status: "failed,timed_out"}, session: failedKube, want: true},
+		{name: "status list miss", filter: schema.SavedViewFilter{Status: "completed"}, session: failedKube, want: false},
+		{name: "in_progress status fires on claim", filter: schema.SavedViewFilter{Status: "in_progress"}, session: inProgress, want: true},
+		{name: "chain match", filter: schema.SavedViewFilter{ChainID: "k8s", Status: "failed"}, session: failedKube, want: true},
+		{name: "chain miss", filter: schema.SavedViewFilter{ChainID: "network"}, session: failedKube, want: false},
+		{name: "alert type miss", filter: schema.SavedViewFilter{AlertType: "NodeNotReady"}, session: failedKube, want: false},
+		{name: "search alert data case-insensitive", filter: schema.SavedViewFilter{Search: "PAYMENTS"}, session: failedKube, want: true},
+		{name: "search final analysis", filter: schema.SavedViewFilter{Search: "oomkilled"}, session: failedKube, want: true},
+		{name: "search miss", filter: schema.SavedViewFilter{Search: "checkout"}, session: failedKube, want: false},
+		{name: "created_within does not restrict", filter: schema.SavedViewFilter{CreatedWithin: "24h"}, session: failedKube, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Matches(tt.filter, tt.session))
+		})
+	}
+}
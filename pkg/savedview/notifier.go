@@ -0,0 +1,97 @@
+package savedview
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	tarsyslack "github.com/codeready-toolchain/tarsy/pkg/slack"
+)
+
+// ViewSource lists saved views that have a notification subscription.
+// Implemented by services.SavedViewService.
+type ViewSource interface {
+	ListSubscribedViews(ctx context.Context) ([]*ent.SavedView, error)
+}
+
+// MatchPublisher delivers WebSocket match notifications.
+// Implemented by events.EventPublisher.
+type MatchPublisher interface {
+	PublishSavedViewMatched(ctx context.Context, owner string, payload events.SavedViewMatchedPayload) error
+}
+
+// Notifier evaluates subscribed saved views on session status transitions
+// and notifies owners over WebSocket and/or Slack.
+// Nil-safe: all methods are no-ops when notifier is nil.
+type Notifier struct {
+	views     ViewSource
+	publisher MatchPublisher      // nil = WebSocket notifications disabled
+	slack     *tarsyslack.Service // nil = Slack notifications disabled
+	logger    *slog.Logger
+}
+
+// NewNotifier creates a saved view match notifier.
+// publisher and slackService may be nil.
+func NewNotifier(views ViewSource, publisher MatchPublisher, slackService *tarsyslack.Service) *Notifier {
+	return &Notifier{
+		views:     views,
+		publisher: publisher,
+		slack:     slackService,
+		logger:    slog.Default().With("component", "saved-view-notifier"),
+	}
+}
+
+// NotifySessionStatus notifies the owners of every subscribed view the
+// transition matches.
+// Fail-open: errors are logged, never returned.
+func (n *Notifier) NotifySessionStatus(ctx context.Context, s Session) {
+	if n == nil {
+		return
+	}
+
+	listCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	views, err := n.views.ListSubscribedViews(listCtx)
+	cancel()
+	if err != nil {
+		n.logger.Warn("Failed to load subscribed saved views", "session_id", s.ID, "error", err)
+		return
+	}
+
+	for _, v := range views {
+		if !Matches(v.Filter, s) {
+			continue
+		}
+		n.logger.DebugContext(ctx, "Session matched saved view",
+			"session_id", s.ID, "view_id", v.ID, "status", s.Status)
+
+		if v.Notify && n.publisher != nil {
+			if err := n.publisher.PublishSavedViewMatched(ctx, v.Owner, events.SavedViewMatchedPayload{
+				BasePayload: events.BasePayload{
+					Type:      events.EventTypeSavedViewMatched,
+					SessionID: s.ID,
+					Timestamp: time.Now().Format(time.RFC3339Nano),
+				},
+				ViewID:    v.ID,
+				ViewName:  v.Name,
+				Status:    string(s.Status),
+				AlertType: s.AlertType,
+				ChainID:   s.ChainID,
+			}); err != nil {
+				n.logger.Warn("Failed to publish saved view match",
+					"session_id", s.ID, "view_id", v.ID, "error", err)
+			}
+		}
+		if v.SlackChannel != nil {
+			n.slack.NotifySavedViewMatch(ctx, tarsyslack.SavedViewMatchInput{
+				Channel:   *v.SlackChannel,
+				ViewName:  v.Name,
+				SessionID: s.ID,
+				AlertType: s.AlertType,
+				ChainID:   s.ChainID,
+				Status:    string(s.Status),
+			})
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package savedview
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeViewSource struct {
+	views []*ent.SavedView
+	err   error
+}
+
+func (f *fakeViewSource) ListSubscribedViews(context.Context) ([]*ent.SavedView, error) {
+	return f.views, f.err
+}
+
+type publishedMatch struct {
+	owner   string
+	payload events.SavedViewMatchedPayload
+}
+
+type fakeMatchPublisher struct {
+	published []publishedMatch
+}
+
+func (f *fakeMatchPublisher) PublishSavedViewMatched(_ context.Context, owner string, payload events.SavedViewMatchedPayload) error {
+	f.published = append(f.published, publishedMatch{owner: owner, payload: payload})
+	return nil
+}
+
+func TestNotifier_NotifySessionStatus(t *testing.T) {
+	slackOnly := "C123"
+	source := &fakeViewSource{views: []*ent.SavedView{
+		{ID: "v1", Owner: "alice", Name: "failed kube", Notify: true, Filter: schema.SavedViewFilter{ChainID: "k8s", Status: "failed"}},
+		{ID: "v2", Owner: "bob", Name: "network", Notify: true, Filter: schema.SavedViewFilter{ChainID: "network"}},
+		{ID: "v3", Owner: "carol", Name: "slack only", SlackChannel: &slackOnly, Filter: schema.SavedViewFilter{}},
+	}}
+	pub := &fakeMatchPublisher{}
+	n := NewNotifier(source, pub, nil)
+
+	n.NotifySessionStatus(context.Background(), Session{
+		ID:        "sess-1",
+		AlertType: "PodCrashLooping",
+		ChainID:   "k8s",
+		Status:    alertsession.StatusFailed,
+	})
+
+	require.Len(t, pub.published, 1, "only WebSocket-subscribed matching views publish")
+	got := pub.published[0]
+	assert.Equal(t, "alice", got.owner)
+	assert.Equal(t, events.EventTypeSavedViewMatched, got.payload.Type)
+	assert.Equal(t, "sess-1", got.payload.SessionID)
+	assert.Equal(t, "v1", got.payload.ViewID)
+	assert.Equal(t, "failed kube", got.payload.ViewName)
+	assert.Equal(t, "failed", got.payload.Status)
+}
+
+func TestNotifier_FailOpen(t *testing.T) {
+	pub := &fakeMatchPublisher{}
+	n := NewNotifier(&fakeViewSource{err: errors.New("db down")}, pub, nil)
+	n.NotifySessionStatus(context.Background(), Session{ID: "s", Status: alertsession.StatusCompleted})
+	assert.Empty(t, pub.published)
+
+	var nilNotifier *Notifier
+	nilNotifier.NotifySessionStatus(context.Background(), Session{ID: "s"})
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/google/uuid"
+)
+
+const defaultActionItemLimit = 50
+
+// ActionItemService manages the follow-up action items of sessions: those
+// extracted from the final analysis (see pkg/followup) and those added
+// through the API.
+type ActionItemService struct {
+	client *ent.Client
+}
+
+// NewActionItemService creates a new ActionItemService.
+func NewActionItemService(client *ent.Client) *ActionItemService {
+	return &ActionItemService{client: client}
+}
+
+// ListSessionItems returns the session's action items, oldest first.
+// Returns ErrNotFound when the session does not exist.
+func (s *ActionItemService) ListSessionItems(httpCtx context.Context, sessionID string) ([]*ent.ActionItem, error) {
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	exists, err := s.client.AlertSession.Query().Where(alertsession.IDEQ(sessionID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check session: %w", err)
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	items, err := s.client.ActionItem.Query().
+		Where(actionitem.SessionIDEQ(sessionID)).
+		Order(ent.Asc(actionitem.FieldCreatedAt), ent.Asc(actionitem.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list action items: %w", err)
+	}
+	return items, nil
+}
+
+// ListItems returns action items across sessions, newest first, with the
+// total number of matches.
+func (s *ActionItemService) ListItems(httpCtx context.Context, params models.ActionItemListParams) ([]*ent.ActionItem, int, error) {
+	status := params.Status
+	if status == "" {
+		status = string(actionitem.StatusOpen)
+	}
+	if status != "all" && actionitem.StatusValidator(actionitem.Status(status)) != nil {
+		return nil, 0, NewValidationError("status", fmt.Sprintf("invalid status %q", status))
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultActionItemLimit
+	}
+
+	ctx, cancel := context.WithTimeout(httpCtx, 10*time.Second)
+	defer cancel()
+
+	query := s.client.ActionItem.Query()
+	if status != "all" {
+		query = query.Where(actionitem.StatusEQ(actionitem.Status(status)))
+	}
+	if params.ChainID != "" {
+		query = query.Where(actionitem.HasSessionWith(alertsession.ChainIDEQ(params.ChainID)))
+	}
+	if params.Assignee != "" {
+		query = query.Where(actionitem.AssigneeEQ(params.Assignee))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count action items: %w", err)
+	}
+	items, err := query.
+		Order(ent.Desc(actionitem.FieldCreatedAt), ent.Desc(actionitem.FieldID)).
+		Limit(limit).
+		Offset(max(params.Offset, 0)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list action items: %w", err)
+	}
+	return items, total, nil
+}
+
+// CreateItem adds an action item to the session by hand. Returns
+// ErrNotFound when the session does not exist.
+func (s *ActionItemService) CreateItem(httpCtx context.Context, sessionID string, req models.CreateActionItemRequest) (*ent.ActionItem, error) {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, NewValidationError("title", "required")
+	}
+
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	exists, err := s.client.AlertSession.Query().Where(alertsession.IDEQ(sessionID)).Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check session: %w", err)
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	create := s.client.ActionItem.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetTitle(title).
+		SetSource(actionitem.SourceManual)
+	if details := strings.TrimSpace(req.Details); details != "" {
+		create.SetDetails(details)
+	}
+	if assignee := strings.TrimSpace(req.Assignee); assignee != "" {
+		create.SetAssignee(assignee)
+	}
+	item, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create action item: %w", err)
+	}
+	return item, nil
+}
+
+// GetItem returns an action item by ID, or ErrNotFound.
+func (s *ActionItemService) GetItem(httpCtx context.Context, itemID string) (*ent.ActionItem, error) {
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	item, err := s.client.ActionItem.Get(ctx, itemID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get action item: %w", err)
+	}
+	return item, nil
+}
+
+// UpdateItem applies a partial update to an action item. Marking an open
+// item done records author and the time; reopening a done item clears them.
+func (s *ActionItemService) UpdateItem(httpCtx context.Context, itemID, author string, req models.UpdateActionItemRequest) (*ent.ActionItem, error) {
+	var title string
+	if req.Title != nil {
+		if title = strings.TrimSpace(*req.Title); title == "" {
+			return nil, NewValidationError("title", "must not be empty")
+		}
+	}
+	if req.Status != nil && actionitem.StatusValidator(actionitem.Status(*req.Status)) != nil {
+		return nil, NewValidationError("status", fmt.Sprintf("invalid status %q", *req.Status))
+	}
+
+	item, err := s.GetItem(httpCtx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	update := item.Update()
+	if req.Title != nil {
+		update.SetTitle(title)
+	}
+	if req.Details != nil {
+		if details := strings.TrimSpace(*req.Details); details != "" {
+			update.SetDetails(details)
+		} else {
+			update.ClearDetails()
+		}
+	}
+	if req.Assignee != nil {
+		if assignee := strings.TrimSpace(*req.Assignee); assignee != "" {
+			update.SetAssignee(assignee)
+		} else {
+			update.ClearAssignee()
+		}
+	}
+	if req.Status != nil {
+		status := actionitem.Status(*req.Status)
+		switch {
+		case status == actionitem.StatusDone && item.Status != actionitem.StatusDone:
+			update.SetStatus(status).SetCompletedAt(time.Now())
+			if author != "" {
+				update.SetCompletedBy(author)
+			}
+		case status == actionitem.StatusOpen && item.Status != actionitem.StatusOpen:
+			update.SetStatus(status).ClearCompletedAt().ClearCompletedBy()
+		}
+	}
+	item, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update action item: %w", err)
+	}
+	return item, nil
+}
+
+// DeleteItem removes an action item. Returns ErrNotFound when it does not
+// exist.
+func (s *ActionItemService) DeleteItem(httpCtx context.Context, itemID string) error {
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	n, err := s.client.ActionItem.Delete().Where(actionitem.IDEQ(itemID)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete action item: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
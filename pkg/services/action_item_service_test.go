@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionItemService(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := NewActionItemService(client.Client)
+	ctx := context.Background()
+
+	sessionID, _, _ := seedSessionSkeleton(t, client.Client, "action item alert")
+
+	t.Run("create and list for session", func(t *testing.T) {
+		item, err := service.CreateItem(ctx, sessionID, models.CreateActionItemRequest{
+			Title:    "  Raise the memory limit ",
+			Assignee: "team-payments",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Raise the memory limit", item.Title)
+		assert.Equal(t, actionitem.StatusOpen, item.Status)
+		assert.Equal(t, actionitem.SourceManual, item.Source)
+		assert.Nil(t, item.Details)
+
+		items, err := service.ListSessionItems(ctx, sessionID)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, item.ID, items[0].ID)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		_, err := service.ListSessionItems(ctx, "missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = service.CreateItem(ctx, "missing", models.CreateActionItemRequest{Title: "x"})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("done records completion and reopen clears it", func(t *testing.T) {
+		item, err := service.CreateItem(ctx, sessionID, models.CreateActionItemRequest{Title: "Add cert expiry alert"})
+		require.NoError(t, err)
+
+		done := "done"
+		item, err = service.UpdateItem(ctx, item.ID, "alice", models.UpdateActionItemRequest{Status: &done})
+		require.NoError(t, err)
+		assert.Equal(t, actionitem.StatusDone, item.Status)
+		require.NotNil(t, item.CompletedAt)
+		require.NotNil(t, item.CompletedBy)
+		assert.Equal(t, "alice", *item.CompletedBy)
+
+		open := "open"
+		item, err = service.UpdateItem(ctx, item.ID, "bob", models.UpdateActionItemRequest{Status: &open})
+		require.NoError(t, err)
+		assert.Equal(t, actionitem.StatusOpen, item.Status)
+		assert.Nil(t, item.CompletedAt)
+		assert.Nil(t, item.CompletedBy)
+	})
+
+	t.Run("list filters by status and assignee", func(t *testing.T) {
+		items, total, err := service.ListItems(ctx, models.ActionItemListParams{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Len(t, items, 2)
+
+		_, total, err = service.ListItems(ctx, models.ActionItemListParams{Status: "done"})
+		require.NoError(t, err)
+		assert.Zero(t, total)
+
+		items, total, err = service.ListItems(ctx, models.ActionItemListParams{Status: "all", Assignee: "team-payments", ChainID: "k8s-analysis"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, "Raise the memory limit", items[0].Title)
+
+		_, _, err = service.ListItems(ctx, models.ActionItemListParams{Status: "closed"})
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("update validation and clearing", func(t *testing.T) {
+		item, err := service.CreateItem(ctx, sessionID, models.CreateActionItemRequest{Title: "x", Details: "why", Assignee: "sre"})
+		require.NoError(t, err)
+
+		empty := " "
+		_, err = service.UpdateItem(ctx, item.ID, "", models.UpdateActionItemRequest{Title: &empty})
+		assert.True(t, IsValidationError(err))
+
+		item, err = service.UpdateItem(ctx, item.ID, "", models.UpdateActionItemRequest{Details: &empty, Assignee: &empty})
+		require.NoError(t, err)
+		assert.Nil(t, item.Details)
+		assert.Nil(t, item.Assignee)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		item, err := service.CreateItem(ctx, sessionID, models.CreateActionItemRequest{Title: "temporary"})
+		require.NoError(t, err)
+		require.NoError(t, service.DeleteItem(ctx, item.ID))
+		assert.ErrorIs(t, service.DeleteItem(ctx, item.ID), ErrNotFound)
+		_, err = service.GetItem(ctx, item.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"strings"
 
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/featureflag"
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
 	"github.com/codeready-toolchain/tarsy/pkg/masking"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
 	"github.com/google/uuid"
@@ -20,8 +25,18 @@ type SubmitAlertInput struct {
 	Runbook                 string
 	Data                    string                     // Alert payload (opaque text, may be masked before storage)
 	MCP                     *models.MCPSelectionConfig // MCP selection config (optional)
-	Author                  string                     // From oauth2-proxy headers
+	Author                  string                     // From oauth2-proxy headers or the user's profile display name
+	AuthorSubject           string                     // OIDC subject of the submitter (optional)
 	SlackMessageFingerprint string                     // For Slack threading (optional)
+	RequestID               string                     // X-Request-ID of the submitting call (optional)
+	AlertKey                string                     // Source-system alert identifier, matched by the resolution webhook (optional)
+	Instructions            *models.AlertInstructions  // Extra guidance appended to agent prompts (optional, masked before storage)
+	OutputLanguage          string                     // Language for analyses and summaries; overrides chain and defaults (optional)
+	Images                  []models.ImageAttachment   // Screenshots passed to multimodal providers; kept in the blob store (optional)
+	Callback                *models.CompletionCallback // POSTed the final result at a terminal state (optional, validated by the caller)
+	Federation              *schema.FederationOrigin   // Stage delegated by another TARSy instance (optional, validated by the caller)
+	Target                  *schema.AlertTarget        // Cluster context rendered into MCP server transports (optional, resolved by the caller)
+	ForceFullInvestigation  bool                       // Run the full chain even if noise triage would close the alert (optional)
 }
 
 // AlertService handles alert submission and session creation.
@@ -29,7 +44,9 @@ type AlertService struct {
 	client         *ent.Client
 	chainRegistry  *config.ChainRegistry
 	defaults       *config.Defaults
-	maskingService *masking.Service // Optional — nil means no masking
+	maskingService *masking.Service     // Optional — nil means no masking
+	featureFlags   *featureflag.Manager // Optional — nil means built-in flag defaults
+	forecaster     *ForecastService     // Optional — nil means no forecasts and no budget checks
 }
 
 // NewAlertService creates a new AlertService.
@@ -52,6 +69,52 @@ func NewAlertService(client *ent.Client, chainRegistry *config.ChainRegistry, de
 	}
 }
 
+// SetFeatureFlags sets the manager that gates fan-out per submission.
+func (s *AlertService) SetFeatureFlags(flags *featureflag.Manager) {
+	s.featureFlags = flags
+}
+
+// SetForecaster sets the service that forecasts chain budgets for dry runs
+// and enforces chain budgets at submission.
+func (s *AlertService) SetForecaster(forecaster *ForecastService) {
+	s.forecaster = forecaster
+}
+
+// AlertPlan is what submitting an alert would do: the chains its sessions
+// would run, with a budget forecast for each.
+type AlertPlan struct {
+	AlertType     string
+	ChainIDs      []string
+	FallbackChain bool // the alert type matched no chain (defaults.fallback_chains)
+	Forecasts     []*models.BudgetForecast
+}
+
+// PlanAlert validates an alert submission like SubmitAlert and forecasts the
+// budget of each chain it would run, without creating sessions. Forecasts
+// are empty when no forecaster is set.
+func (s *AlertService) PlanAlert(ctx context.Context, input SubmitAlertInput) (*AlertPlan, error) {
+	if input.Data == "" {
+		return nil, NewValidationError("data", "alert data is required")
+	}
+	alertType, chainIDs, fallback, err := s.resolveChains(input)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &AlertPlan{AlertType: alertType, ChainIDs: chainIDs, FallbackChain: fallback}
+	if s.forecaster == nil {
+		return plan, nil
+	}
+	for _, chainID := range chainIDs {
+		forecast, err := s.forecaster.Forecast(ctx, alertType, chainID)
+		if err != nil {
+			return nil, err
+		}
+		plan.Forecasts = append(plan.Forecasts, forecast)
+	}
+	return plan, nil
+}
+
 // SubmitAlert creates a new session from an alert submission.
 // The session starts in "pending" status and is picked up by the worker pool.
 func (s *AlertService) SubmitAlert(ctx context.Context, input SubmitAlertInput) (*ent.AlertSession, error) {
@@ -59,20 +122,17 @@ func (s *AlertService) SubmitAlert(ctx context.Context, input SubmitAlertInput)
 		return nil, NewValidationError("data", "alert data is required")
 	}
 
-	// Resolve alert type (use default if not provided)
-	alertType := input.AlertType
-	if alertType == "" {
-		alertType = s.defaults.AlertType
-	}
-
-	// Resolve chain ID from alert type
-	chainID, err := s.chainRegistry.GetIDByAlertType(alertType)
+	// Resolve the chains to run and validate per-alert options against them
+	alertType, chainIDs, fallback, err := s.resolveChains(input)
 	if err != nil {
-		return nil, NewValidationError("alert_type", fmt.Sprintf("no chain found for alert type '%s'", alertType))
+		return nil, err
 	}
+	chainID := chainIDs[0]
 
-	// Generate session ID
-	sessionID := uuid.New().String()
+	// Reject alerts whose chain forecast is over budget
+	if err := s.checkBudgets(ctx, alertType, chainIDs); err != nil {
+		return nil, err
+	}
 
 	// Convert MCP selection to JSON map for ent storage
 	var mcpSelectionJSON map[string]any
@@ -87,39 +147,309 @@ func (s *AlertService) SubmitAlert(ctx context.Context, input SubmitAlertInput)
 	}
 
 	// Apply alert data masking (before DB storage)
-	alertData := input.Data
+	var maskAlert func(string) string
 	if s.maskingService != nil {
-		alertData = s.maskingService.MaskAlertData(alertData)
+		maskAlert = s.maskingService.MaskAlertData
+	}
+	alertData := input.Data
+	if maskAlert != nil {
+		alertData = maskAlert(alertData)
+	}
+
+	// Instructions are free text from the caller — mask them like alert data
+	var instructionsJSON map[string]any
+	if !input.Instructions.IsEmpty() {
+		instructions := input.Instructions
+		if maskAlert != nil {
+			instructions = instructions.Map(maskAlert)
+		}
+		instrBytes, err := json.Marshal(instructions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal instructions: %w", err)
+		}
+		if err := json.Unmarshal(instrBytes, &instructionsJSON); err != nil {
+			return nil, fmt.Errorf("failed to convert instructions: %w", err)
+		}
+	}
+
+	// Prior stage results from a delegating instance are masked like alert data
+	var federation *schema.FederationOrigin
+	if input.Federation != nil {
+		origin := *input.Federation
+		if maskAlert != nil {
+			origin.Context = maskAlert(origin.Context)
+		}
+		federation = &origin
+	}
+
+	// Images are shared by every session of a fan-out group
+	imageRefs, err := StoreImages(ctx, input.Images)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create session in "pending" status
 	// Note: created_at is set automatically by schema default
 	// started_at will be set by the worker when it claims the session
-	builder := s.client.AlertSession.Create().
-		SetID(sessionID).
-		SetAlertData(alertData).
-		SetAgentType(alertType). // Use alert type as agent type
-		SetAlertType(alertType).
-		SetChainID(chainID).
-		SetStatus(alertsession.StatusPending)
+	newSession := func(client *ent.Client, chainID string) *ent.AlertSessionCreate {
+		builder := client.AlertSession.Create().
+			SetID(uuid.New().String()).
+			SetAlertData(alertData).
+			SetAgentType(alertType). // Use alert type as agent type
+			SetAlertType(alertType).
+			SetChainID(chainID).
+			SetStatus(alertsession.StatusPending)
+
+		if owner := s.chainOwner(chainID); owner != "" {
+			builder.SetOwner(owner)
+		}
+		if input.Author != "" {
+			builder.SetAuthor(input.Author)
+		}
+		if input.AuthorSubject != "" {
+			builder.SetAuthorSubject(input.AuthorSubject)
+		}
+		if input.Runbook != "" {
+			builder.SetRunbookURL(input.Runbook)
+		}
+		if mcpSelectionJSON != nil {
+			builder.SetMcpSelection(mcpSelectionJSON)
+		}
+		if instructionsJSON != nil {
+			builder.SetAlertInstructions(instructionsJSON)
+		}
+		if input.OutputLanguage != "" {
+			builder.SetOutputLanguage(input.OutputLanguage)
+		}
+		if len(imageRefs) > 0 {
+			builder.SetAlertImages(imageRefs)
+		}
+		if input.SlackMessageFingerprint != "" {
+			builder.SetSlackMessageFingerprint(input.SlackMessageFingerprint)
+		}
+		if input.RequestID != "" {
+			builder.SetRequestID(input.RequestID)
+		}
+		if input.AlertKey != "" {
+			builder.SetAlertKey(input.AlertKey)
+		}
+		if federation != nil {
+			builder.SetFederationOrigin(federation)
+		}
+		if input.Target != nil {
+			builder.SetTarget(input.Target)
+		}
+		if input.ForceFullInvestigation {
+			builder.SetForceFullInvestigation(true)
+		}
+		if fallback {
+			builder.SetFallbackChain(true)
+		}
+		if input.Callback != nil {
+			builder.SetCallbackURL(input.Callback.URL).
+				SetCallbackStatus(alertsession.CallbackStatusPending)
+			if input.Callback.Secret != "" {
+				builder.SetCallbackSecret(input.Callback.Secret)
+			}
+		}
+		return builder
+	}
+
+	var session *ent.AlertSession
+	if len(chainIDs) == 1 {
+		session, err = newSession(s.client, chainID).Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create session: %w", err)
+		}
+	} else {
+		session, err = s.createFanOutSessions(ctx, alertType, chainIDs, newSession)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	slog.DebugContext(ctx, "Alert submitted",
+		"session_id", session.ID,
+		"alert_type", alertType,
+		"chain_id", chainID,
+		"fallback_chain", fallback,
+		"fan_out_chains", len(chainIDs)-1,
+		"images", len(imageRefs),
+		"alert_data", logging.Sensitive(input.Data, maskAlert))
+
+	return session, nil
+}
+
+// resolveChains resolves the alert type (default if unset) and the chains
+// its sessions run on: the alert type's chain plus, when fan-out is rolled
+// out, its fan-out siblings. An alert type without a chain runs on the
+// fallback chain of its target namespace, if configured (fallback = true).
+// It also validates the per-alert instructions and output language against
+// those chains.
+func (s *AlertService) resolveChains(input SubmitAlertInput) (string, []string, bool, error) {
+	// Resolve alert type (use default if not provided)
+	alertType := input.AlertType
+	if alertType == "" {
+		alertType = s.defaults.AlertType
+	}
+
+	// Resolve chain ID from alert type
+	fallback := false
+	chainID, err := s.chainRegistry.GetIDByAlertType(alertType)
+	if err != nil {
+		// A delegated stage must run on the delegating instance's chain.
+		if input.Federation == nil {
+			chainID = s.fallbackChainID(input.Target)
+		}
+		if chainID == "" {
+			return "", nil, false, NewValidationError("alert_type", fmt.Sprintf("no chain found for alert type '%s'", alertType))
+		}
+		fallback = true
+	}
 
-	if input.Author != "" {
-		builder.SetAuthor(input.Author)
+	// An alert whose chain fans out runs on every sibling chain too
+	chain, err := s.chainRegistry.Get(chainID)
+	if err != nil {
+		return "", nil, false, NewValidationError("alert_type", fmt.Sprintf("chain '%s' not found", chainID))
+	}
+	// A delegated stage runs on the alert type's chain only: the delegating
+	// instance waits for a single session.
+	chainIDs := config.FanOutChainIDs(chainID, chain)
+	if len(chainIDs) > 1 && input.Federation != nil {
+		chainIDs = chainIDs[:1]
+	}
+	fanOutTarget := featureflag.Target{
+		Key:       input.AlertKey, // repeats of one source alert get the same answer
+		AlertType: alertType,
+		ChainID:   chainID,
+	}
+	if input.Target != nil {
+		fanOutTarget.Namespace = input.Target.Namespace
 	}
-	if input.Runbook != "" {
-		builder.SetRunbookURL(input.Runbook)
+	if len(chainIDs) > 1 && !s.featureFlags.Enabled(config.FeatureFlagFanOut, fanOutTarget) {
+		chainIDs = chainIDs[:1]
 	}
-	if mcpSelectionJSON != nil {
-		builder.SetMcpSelection(mcpSelectionJSON)
+
+	// Validate per-alert instructions (size limits, stage names in the chains)
+	if err := s.validateInstructions(chainIDs, input.Instructions); err != nil {
+		return "", nil, false, err
 	}
-	if input.SlackMessageFingerprint != "" {
-		builder.SetSlackMessageFingerprint(input.SlackMessageFingerprint)
+	if err := config.ValidateOutputLanguage(input.OutputLanguage); err != nil {
+		return "", nil, false, NewValidationError("output_language", err.Error())
 	}
+	return alertType, chainIDs, fallback, nil
+}
 
-	session, err := builder.Save(ctx)
+// chainOwner returns the owner charged for sessions on chainID, or "" when
+// the chain has none.
+func (s *AlertService) chainOwner(chainID string) string {
+	chain, err := s.chainRegistry.Get(chainID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
+		return ""
 	}
+	return chain.Owner
+}
 
-	return session, nil
+// fallbackChainID returns the fallback chain for an alert type without a
+// chain (defaults.fallback_chains), or "" when there is none.
+func (s *AlertService) fallbackChainID(target *schema.AlertTarget) string {
+	namespace := ""
+	if target != nil {
+		namespace = target.Namespace
+	}
+	return s.defaults.FallbackChains.ChainFor(namespace)
+}
+
+// checkBudgets rejects a submission when the forecast of one of its chains
+// exceeds the chain's budget with enough history to enforce it. Forecast
+// errors are logged and do not block submissions.
+func (s *AlertService) checkBudgets(ctx context.Context, alertType string, chainIDs []string) error {
+	if s.forecaster == nil {
+		return nil
+	}
+	for _, chainID := range chainIDs {
+		chain, err := s.chainRegistry.Get(chainID)
+		if err != nil || chain.Budget == nil {
+			continue
+		}
+		forecast, err := s.forecaster.Forecast(ctx, alertType, chainID)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to forecast chain budget, not enforcing it",
+				"chain_id", chainID, "error", err)
+			continue
+		}
+		if forecast.Budget != nil && forecast.Budget.Enforced {
+			return fmt.Errorf("%w: chain '%s': %s", ErrBudgetExceeded, chainID, forecast.Budget.Reason)
+		}
+	}
+	return nil
+}
+
+// createFanOutSessions creates the session group and one pending session per
+// chain in a single transaction, so workers never see a partial group.
+// Returns the session of the first (primary) chain.
+func (s *AlertService) createFanOutSessions(ctx context.Context, alertType string, chainIDs []string, newSession func(*ent.Client, string) *ent.AlertSessionCreate) (*ent.AlertSession, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	group, err := tx.SessionGroup.Create().
+		SetID(uuid.New().String()).
+		SetAlertType(alertType).
+		SetPrimaryChainID(chainIDs[0]).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session group: %w", err)
+	}
+
+	var primary *ent.AlertSession
+	for _, chainID := range chainIDs {
+		session, err := newSession(tx.Client(), chainID).SetGroupID(group.ID).Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create session for chain '%s': %w", chainID, err)
+		}
+		if primary == nil {
+			primary = session
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit session group: %w", err)
+	}
+	return primary, nil
+}
+
+// validateInstructions checks size limits and that every per-stage key names
+// a stage of one of the chains, so a typo doesn't silently drop guidance.
+// Agent keys are not checked — they may name agents created at runtime
+// (e.g. synthesis).
+func (s *AlertService) validateInstructions(chainIDs []string, instr *models.AlertInstructions) error {
+	if instr == nil {
+		return nil
+	}
+	if msg := instr.Validate(); msg != "" {
+		return NewValidationError("instructions", msg)
+	}
+	if len(instr.Stages) == 0 {
+		return nil
+	}
+
+	stageNames := make(map[string]bool)
+	for _, chainID := range chainIDs {
+		chain, err := s.chainRegistry.Get(chainID)
+		if err != nil {
+			return NewValidationError("instructions", fmt.Sprintf("chain '%s' not found", chainID))
+		}
+		for _, stage := range chain.Stages {
+			stageNames[stage.Name] = true
+		}
+	}
+	for name := range instr.Stages {
+		if !stageNames[name] {
+			return NewValidationError("instructions", fmt.Sprintf("unknown stage '%s' for chain '%s'", name, strings.Join(chainIDs, "', '")))
+		}
+	}
+	return nil
 }
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/google/uuid"
+)
+
+// DuplicateSessionInput contains the overrides for re-running a session's
+// alert. Empty overrides keep the original session's value.
+type DuplicateSessionInput struct {
+	SessionID     string
+	ChainID       string                     // Chain to run instead of the original's (optional)
+	LLMProvider   string                     // Chain-level LLM provider override (optional, validated by the caller)
+	MCP           *models.MCPSelectionConfig // MCP selection instead of the original's (optional, validated by the caller)
+	Author        string                     // User requesting the duplicate
+	AuthorSubject string                     // OIDC subject of the requester (optional)
+	RequestID     string                     // X-Request-ID of the duplicating call (optional)
+}
+
+// DuplicateSession creates a pending session from another session's alert
+// (data, type, runbook, instructions, images, output language and target),
+// linked to the original through duplicated_from so the two conclusions can
+// be compared. The duplicate runs a single chain — no fan-out — and takes
+// no part in the original's notifications: Slack threading, completion
+// callback, alert resolution and federation are not copied.
+func (s *AlertService) DuplicateSession(ctx context.Context, input DuplicateSessionInput) (*ent.AlertSession, error) {
+	original, err := s.client.AlertSession.Query().
+		Where(alertsession.IDEQ(input.SessionID), alertsession.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	chainID := original.ChainID
+	if input.ChainID != "" {
+		if _, err := s.chainRegistry.Get(input.ChainID); err != nil {
+			return nil, NewValidationError("chain_id", fmt.Sprintf("chain '%s' not found", input.ChainID))
+		}
+		chainID = input.ChainID
+	}
+
+	// Per-stage instructions must still name stages of the chain that runs
+	instructions, err := models.ParseAlertInstructions(original.AlertInstructions)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateInstructions([]string{chainID}, instructions); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkBudgets(ctx, original.AlertType, []string{chainID}); err != nil {
+		return nil, err
+	}
+
+	var mcpSelectionJSON map[string]any
+	if input.MCP != nil {
+		mcpBytes, err := json.Marshal(input.MCP)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal MCP selection: %w", err)
+		}
+		if err := json.Unmarshal(mcpBytes, &mcpSelectionJSON); err != nil {
+			return nil, fmt.Errorf("failed to convert MCP selection: %w", err)
+		}
+	} else {
+		mcpSelectionJSON = original.McpSelection
+	}
+
+	// Alert data and instructions were masked when the original was stored
+	builder := s.client.AlertSession.Create().
+		SetID(uuid.New().String()).
+		SetAlertData(original.AlertData).
+		SetAgentType(original.AgentType).
+		SetAlertType(original.AlertType).
+		SetChainID(chainID).
+		SetStatus(alertsession.StatusPending).
+		SetDuplicatedFrom(original.ID).
+		SetNillableRunbookURL(original.RunbookURL).
+		SetNillableOutputLanguage(original.OutputLanguage)
+
+	if owner := s.chainOwner(chainID); owner != "" {
+		builder.SetOwner(owner)
+	}
+	if input.Author != "" {
+		builder.SetAuthor(input.Author)
+	}
+	if input.AuthorSubject != "" {
+		builder.SetAuthorSubject(input.AuthorSubject)
+	}
+	if input.RequestID != "" {
+		builder.SetRequestID(input.RequestID)
+	}
+	if input.LLMProvider != "" {
+		builder.SetLlmProvider(input.LLMProvider)
+	}
+	if mcpSelectionJSON != nil {
+		builder.SetMcpSelection(mcpSelectionJSON)
+	}
+	if original.AlertInstructions != nil {
+		builder.SetAlertInstructions(original.AlertInstructions)
+	}
+	// Image blobs are shared with the original, like within a fan-out group
+	if len(original.AlertImages) > 0 {
+		builder.SetAlertImages(original.AlertImages)
+	}
+	if original.Target != nil {
+		builder.SetTarget(original.Target)
+	}
+
+	session, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	slog.DebugContext(ctx, "Session duplicated",
+		"session_id", session.ID,
+		"duplicated_from", original.ID,
+		"chain_id", chainID,
+		"llm_provider", input.LLMProvider,
+		"mcp_override", input.MCP != nil)
+
+	return session, nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertService_DuplicateSession(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestAlertService(t, client)
+	ctx := context.Background()
+
+	original, err := service.SubmitAlert(ctx, SubmitAlertInput{
+		AlertType:               "pod-crash",
+		Runbook:                 "https://runbooks.example.com/pod-crash.md",
+		Data:                    "pod crashed",
+		Author:                  "alice",
+		SlackMessageFingerprint: "fp-1",
+		AlertKey:                "alert-1",
+		OutputLanguage:          "German",
+		Instructions:            &models.AlertInstructions{Stages: map[string]string{"analysis": "Check the last deploy"}},
+		MCP:                     &models.MCPSelectionConfig{Servers: []models.MCPServerSelection{{Name: "kubernetes-server"}}},
+		Target:                  &schema.AlertTarget{Cluster: "prod-east"},
+	})
+	require.NoError(t, err)
+
+	t.Run("copies the alert and links the original", func(t *testing.T) {
+		dup, err := service.DuplicateSession(ctx, DuplicateSessionInput{SessionID: original.ID, Author: "bob"})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, original.ID, dup.ID)
+		assert.Equal(t, alertsession.StatusPending, dup.Status)
+		require.NotNil(t, dup.DuplicatedFrom)
+		assert.Equal(t, original.ID, *dup.DuplicatedFrom)
+		assert.Equal(t, original.AlertData, dup.AlertData)
+		assert.Equal(t, "pod-crash", dup.AlertType)
+		assert.Equal(t, "k8s-analysis", dup.ChainID)
+		assert.Equal(t, original.RunbookURL, dup.RunbookURL)
+		assert.Equal(t, original.OutputLanguage, dup.OutputLanguage)
+		assert.Equal(t, original.AlertInstructions, dup.AlertInstructions)
+		assert.Equal(t, original.McpSelection, dup.McpSelection)
+		assert.Equal(t, original.Target, dup.Target)
+		require.NotNil(t, dup.Author)
+		assert.Equal(t, "bob", *dup.Author)
+		assert.Nil(t, dup.LlmProvider)
+
+		// Notifications and resolution stay with the original
+		assert.Nil(t, dup.SlackMessageFingerprint)
+		assert.Nil(t, dup.AlertKey)
+		assert.Nil(t, dup.CallbackURL)
+	})
+
+	t.Run("applies chain, provider and MCP overrides", func(t *testing.T) {
+		dup, err := service.DuplicateSession(ctx, DuplicateSessionInput{
+			SessionID:   original.ID,
+			ChainID:     "default-chain",
+			LLMProvider: "gemini-pro",
+			MCP:         &models.MCPSelectionConfig{Servers: []models.MCPServerSelection{{Name: "github-server"}}},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "default-chain", dup.ChainID)
+		require.NotNil(t, dup.LlmProvider)
+		assert.Equal(t, "gemini-pro", *dup.LlmProvider)
+		servers, ok := dup.McpSelection["servers"].([]any)
+		require.True(t, ok)
+		require.Len(t, servers, 1)
+		assert.Equal(t, "github-server", servers[0].(map[string]any)["name"])
+	})
+
+	t.Run("rejects unknown chain", func(t *testing.T) {
+		_, err := service.DuplicateSession(ctx, DuplicateSessionInput{SessionID: original.ID, ChainID: "no-such-chain"})
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("rejects chain without the instructed stages", func(t *testing.T) {
+		withTriage, err := service.SubmitAlert(ctx, SubmitAlertInput{
+			AlertType:    "pod-crash",
+			Data:         "pod crashed",
+			Instructions: &models.AlertInstructions{Stages: map[string]string{"analysis": "x"}},
+		})
+		require.NoError(t, err)
+
+		_, err = service.DuplicateSession(ctx, DuplicateSessionInput{SessionID: withTriage.ID, ChainID: "outage-analysis"})
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		_, err := service.DuplicateSession(ctx, DuplicateSessionInput{SessionID: uuid.New().String()})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
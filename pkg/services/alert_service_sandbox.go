@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/logging"
+	"github.com/google/uuid"
+)
+
+// SandboxSessionInput contains the alert of a sandbox run.
+type SandboxSessionInput struct {
+	AlertType     string
+	ChainID       string // Chain to run instead of the alert type's (optional)
+	Data          string // Alert payload (masked before storage)
+	Runbook       string // Runbook URL (optional)
+	Author        string
+	AuthorSubject string // OIDC subject of the requester (optional)
+	RequestID     string // X-Request-ID of the sandbox call (optional)
+}
+
+// CreateSandboxSession creates the pending session of a sandbox run. Sandbox
+// sessions are never claimed by the worker pool: the caller runs them
+// against fixture MCP tools (see queue.SandboxRunner). They run a single
+// chain — no fan-out — and take no part in notifications, callbacks,
+// scoring or the default session list.
+func (s *AlertService) CreateSandboxSession(ctx context.Context, input SandboxSessionInput) (*ent.AlertSession, error) {
+	if input.Data == "" {
+		return nil, NewValidationError("data", "alert data is required")
+	}
+
+	alertType := input.AlertType
+	if alertType == "" {
+		alertType = s.defaults.AlertType
+	}
+	chainID := input.ChainID
+	if chainID == "" {
+		id, err := s.chainRegistry.GetIDByAlertType(alertType)
+		if err != nil {
+			return nil, NewValidationError("alert_type", fmt.Sprintf("no chain found for alert type '%s'", alertType))
+		}
+		chainID = id
+	}
+	chain, err := s.chainRegistry.Get(chainID)
+	if err != nil {
+		return nil, NewValidationError("chain_id", fmt.Sprintf("chain '%s' not found", chainID))
+	}
+	// Remote stages would run on another TARSy instance, against its real
+	// MCP servers.
+	for _, stage := range chain.Stages {
+		if stage.Remote != nil {
+			return nil, NewValidationError("chain_id",
+				fmt.Sprintf("chain '%s' has remote stage '%s', which cannot run in a sandbox", chainID, stage.Name))
+		}
+	}
+
+	var maskAlert func(string) string
+	if s.maskingService != nil {
+		maskAlert = s.maskingService.MaskAlertData
+	}
+	alertData := input.Data
+	if maskAlert != nil {
+		alertData = maskAlert(alertData)
+	}
+
+	builder := s.client.AlertSession.Create().
+		SetID(uuid.New().String()).
+		SetAlertData(alertData).
+		SetAgentType(alertType).
+		SetAlertType(alertType).
+		SetChainID(chainID).
+		SetStatus(alertsession.StatusPending).
+		SetSandbox(true)
+	if chain.Owner != "" {
+		builder.SetOwner(chain.Owner)
+	}
+	if input.Author != "" {
+		builder.SetAuthor(input.Author)
+	}
+	if input.AuthorSubject != "" {
+		builder.SetAuthorSubject(input.AuthorSubject)
+	}
+	if input.Runbook != "" {
+		builder.SetRunbookURL(input.Runbook)
+	}
+	if input.RequestID != "" {
+		builder.SetRequestID(input.RequestID)
+	}
+
+	session, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	slog.DebugContext(ctx, "Sandbox session created",
+		"session_id", session.ID,
+		"alert_type", alertType,
+		"chain_id", chainID,
+		"alert_data", logging.Sensitive(input.Data, maskAlert))
+
+	return session, nil
+}
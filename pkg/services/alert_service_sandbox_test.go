@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertService_CreateSandboxSession(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestAlertService(t, client)
+	ctx := context.Background()
+
+	t.Run("creates a pending sandbox session on the alert type's chain", func(t *testing.T) {
+		session, err := service.CreateSandboxSession(ctx, SandboxSessionInput{
+			AlertType: "pod-crash",
+			Data:      "pod crashed",
+			Author:    "alice",
+		})
+		require.NoError(t, err)
+		assert.True(t, session.Sandbox)
+		assert.Equal(t, alertsession.StatusPending, session.Status)
+		assert.Equal(t, "k8s-analysis", session.ChainID)
+		assert.Nil(t, session.GroupID, "sandbox runs never fan out")
+	})
+
+	t.Run("runs the requested chain", func(t *testing.T) {
+		session, err := service.CreateSandboxSession(ctx, SandboxSessionInput{
+			AlertType: "pod-crash",
+			ChainID:   "default-chain",
+			Data:      "pod crashed",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "default-chain", session.ChainID)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := service.CreateSandboxSession(ctx, SandboxSessionInput{AlertType: "pod-crash"})
+		assert.True(t, IsValidationError(err))
+
+		_, err = service.CreateSandboxSession(ctx, SandboxSessionInput{Data: "x", ChainID: "no-such-chain"})
+		assert.True(t, IsValidationError(err))
+	})
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/blobstore"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/google/uuid"
+)
+
+// Payloads kept in the blob store (system.blob_store) instead of the
+// database: MCP tool results at or above the offload threshold, rendered
+// activity reports, image attachments, and chat voice notes. Rows reference their blob by key;
+// read paths load the blob and clear the key, so callers see the same entity
+// either way.
+
+// blobSettings is the blob store applied by SetBlobStore.
+type blobSettings struct {
+	store               blobstore.Store
+	toolResultThreshold int
+}
+
+var blobs atomic.Pointer[blobSettings]
+
+// SetBlobStore applies system.blob_store. Called once at startup; until then
+// (and with a nil store) every payload stays in the database and offloaded
+// rows cannot be read.
+func SetBlobStore(store blobstore.Store, cfg *config.BlobStoreConfig) {
+	if store == nil {
+		blobs.Store(nil)
+		return
+	}
+	s := &blobSettings{store: store}
+	if cfg != nil {
+		s.toolResultThreshold = cfg.ToolResultThresholdBytes
+	}
+	blobs.Store(s)
+}
+
+// blobStore returns the configured store, or an error when there is none.
+func blobStore() (blobstore.Store, error) {
+	s := blobs.Load()
+	if s == nil {
+		return nil, fmt.Errorf("blob store is not configured")
+	}
+	return s.store, nil
+}
+
+func toolResultBlobKey(sessionID, interactionID string) string {
+	return "tool-results/" + sessionID + "/" + interactionID + ".json"
+}
+
+func reportBlobKey(reportID string) string {
+	return "reports/" + reportID + ".html"
+}
+
+func imageBlobKey(imageID string) string {
+	return "images/" + imageID
+}
+
+// StoreImages validates image attachments and puts each one in the blob
+// store, returning the references to record on the owning row. Returns nil
+// for no images.
+func StoreImages(ctx context.Context, images []models.ImageAttachment) ([]schema.ImageRef, error) {
+	if len(images) == 0 {
+		return nil, nil
+	}
+	if msg := models.ValidateImageAttachments(images); msg != "" {
+		return nil, NewValidationError("images", msg)
+	}
+	store, err := blobStore()
+	if err != nil {
+		return nil, NewValidationError("images", "image attachments require system.blob_store")
+	}
+	refs := make([]schema.ImageRef, 0, len(images))
+	for _, img := range images {
+		ref := schema.ImageRef{
+			ID:        uuid.New().String(),
+			MimeType:  img.MimeType,
+			Name:      img.Name,
+			SizeBytes: len(img.Data),
+		}
+		ref.Key = imageBlobKey(ref.ID)
+		if err := store.Put(ctx, ref.Key, img.Data, img.MimeType); err != nil {
+			return nil, fmt.Errorf("failed to store image: %w", err)
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+func voiceNoteBlobKey(voiceNoteID string) string {
+	return "voice-notes/" + voiceNoteID
+}
+
+// storeVoiceNote puts a validated voice note in the blob store and returns
+// the reference to record on the chat message.
+func storeVoiceNote(ctx context.Context, note *models.VoiceNoteAttachment, transcript string) (*schema.VoiceNote, error) {
+	store, err := blobStore()
+	if err != nil {
+		return nil, NewValidationError("voice_note", "voice notes require system.blob_store")
+	}
+	ref := &schema.VoiceNote{
+		ID:         uuid.New().String(),
+		MimeType:   note.MimeType,
+		Name:       note.Name,
+		SizeBytes:  len(note.Data),
+		Transcript: transcript,
+	}
+	ref.Key = voiceNoteBlobKey(ref.ID)
+	if err := store.Put(ctx, ref.Key, note.Data, note.MimeType); err != nil {
+		return nil, fmt.Errorf("failed to store voice note: %w", err)
+	}
+	return ref, nil
+}
+
+// LoadImage returns the content of an image attachment.
+func LoadImage(ctx context.Context, ref schema.ImageRef) ([]byte, error) {
+	store, err := blobStore()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load image %s: %w", ref.ID, err)
+	}
+	data, err := store.Get(ctx, ref.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load image %s: %w", ref.ID, err)
+	}
+	return data, nil
+}
+
+// storeMCPToolResult stores a tool result on an MCP interaction mutation:
+// in the blob store under key (with tool_result left null) when its encoding
+// reaches the offload threshold, otherwise in the database via
+// setMCPToolResult. Reports whether the result was offloaded.
+func storeMCPToolResult(ctx context.Context, m *ent.MCPInteractionMutation, key string, result map[string]interface{}) (bool, error) {
+	s := blobs.Load()
+	if s == nil || s.toolResultThreshold <= 0 {
+		return false, setMCPToolResult(m, result)
+	}
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode tool result: %w", err)
+	}
+	if len(raw) < s.toolResultThreshold {
+		return false, setMCPToolResult(m, result)
+	}
+
+	if err := s.store.Put(ctx, key, raw, "application/json"); err != nil {
+		return false, fmt.Errorf("failed to offload tool result: %w", err)
+	}
+	if m.Op() != ent.OpCreate {
+		m.ClearToolResult()
+		m.ClearToolResultZstd()
+	}
+	m.SetToolResultBlob(key)
+	m.SetToolResultBytes(len(raw))
+	return true, nil
+}
+
+// loadOffloadedToolResults restores ToolResult on interactions whose result
+// is kept in the blob store.
+func loadOffloadedToolResults(ctx context.Context, interactions ...*ent.MCPInteraction) error {
+	for _, mi := range interactions {
+		if mi == nil || mi.ToolResultBlob == nil {
+			continue
+		}
+		store, err := blobStore()
+		if err != nil {
+			return fmt.Errorf("failed to load tool result of MCP interaction %s: %w", mi.ID, err)
+		}
+		raw, err := store.Get(ctx, *mi.ToolResultBlob)
+		if err != nil {
+			return fmt.Errorf("failed to load tool result of MCP interaction %s: %w", mi.ID, err)
+		}
+		if err := json.Unmarshal(raw, &mi.ToolResult); err != nil {
+			return fmt.Errorf("failed to decode tool result of MCP interaction %s: %w", mi.ID, err)
+		}
+		mi.ToolResultBlob = nil
+		mi.ToolResultBytes = nil
+	}
+	return nil
+}
+
+// replaceOffloadedToolResult overwrites the blob at key with result and
+// updates tool_result_bytes, keeping the interaction offloaded regardless of
+// the result's new size.
+func replaceOffloadedToolResult(ctx context.Context, m *ent.MCPInteractionMutation, key string, result map[string]interface{}) error {
+	store, err := blobStore()
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode tool result: %w", err)
+	}
+	if err := store.Put(ctx, key, raw, "application/json"); err != nil {
+		return fmt.Errorf("failed to offload tool result: %w", err)
+	}
+	m.SetToolResultBytes(len(raw))
+	return nil
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/blobstore"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+func withBlobStore(t *testing.T, thresholdBytes int) blobstore.Store {
+	t.Helper()
+	store, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	SetBlobStore(store, &config.BlobStoreConfig{ToolResultThresholdBytes: thresholdBytes})
+	t.Cleanup(func() { SetBlobStore(nil, nil) })
+	return store
+}
+
+func TestStoreMCPToolResult(t *testing.T) {
+	ctx := context.Background()
+	client := ent.NewClient()
+	large := map[string]interface{}{"content": strings.Repeat("NAME READY STATUS RESTARTS\n", 100)}
+	small := map[string]interface{}{"content": "ok"}
+
+	t.Run("without a blob store results stay in the database", func(t *testing.T) {
+		m := client.MCPInteraction.Create().Mutation()
+		offloaded, err := storeMCPToolResult(ctx, m, "tool-results/s1/mi-1.json", large)
+		require.NoError(t, err)
+		assert.False(t, offloaded)
+		_, ok := m.ToolResultBlob()
+		assert.False(t, ok)
+	})
+
+	t.Run("large results are offloaded and loaded back", func(t *testing.T) {
+		store := withBlobStore(t, 1024)
+		key := toolResultBlobKey("s1", "mi-1")
+
+		m := client.MCPInteraction.Create().Mutation()
+		offloaded, err := storeMCPToolResult(ctx, m, key, large)
+		require.NoError(t, err)
+		assert.True(t, offloaded)
+
+		_, ok := m.ToolResult()
+		assert.False(t, ok, "tool_result must stay null when offloaded")
+		_, ok = m.ToolResultZstd()
+		assert.False(t, ok, "offloaded results are not also compressed in the row")
+		stored, ok := m.ToolResultBlob()
+		require.True(t, ok)
+		assert.Equal(t, key, stored)
+		size, ok := m.ToolResultBytes()
+		require.True(t, ok)
+
+		raw, err := store.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Len(t, raw, size)
+
+		mi := &ent.MCPInteraction{ID: "mi-1", ToolResultBlob: &stored, ToolResultBytes: &size}
+		require.NoError(t, loadOffloadedToolResults(ctx, mi))
+		assert.Equal(t, large, mi.ToolResult)
+		assert.Nil(t, mi.ToolResultBlob)
+		assert.Nil(t, mi.ToolResultBytes)
+	})
+
+	t.Run("small results stay in the database", func(t *testing.T) {
+		withBlobStore(t, 1024)
+		m := client.MCPInteraction.Create().Mutation()
+		offloaded, err := storeMCPToolResult(ctx, m, "tool-results/s1/mi-2.json", small)
+		require.NoError(t, err)
+		assert.False(t, offloaded)
+		result, ok := m.ToolResult()
+		require.True(t, ok)
+		assert.Equal(t, small, result)
+	})
+
+	t.Run("zero threshold disables offloading", func(t *testing.T) {
+		withBlobStore(t, 0)
+		m := client.MCPInteraction.Create().Mutation()
+		offloaded, err := storeMCPToolResult(ctx, m, "tool-results/s1/mi-3.json", large)
+		require.NoError(t, err)
+		assert.False(t, offloaded)
+	})
+
+	t.Run("inline update clears the blob reference", func(t *testing.T) {
+		update := client.MCPInteraction.UpdateOneID("mi-1").Mutation()
+		require.NoError(t, setMCPToolResult(update, small))
+		assert.True(t, update.ToolResultBlobCleared())
+	})
+}
+
+func TestLoadOffloadedToolResults_NoBlobStore(t *testing.T) {
+	SetBlobStore(nil, nil)
+	key := toolResultBlobKey("s1", "mi-1")
+	err := loadOffloadedToolResults(context.Background(), &ent.MCPInteraction{ID: "mi-1", ToolResultBlob: &key})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "blob store is not configured")
+}
+
+func TestReplaceOffloadedToolResult(t *testing.T) {
+	ctx := context.Background()
+	store := withBlobStore(t, 1024)
+	key := toolResultBlobKey("s1", "mi-1")
+	require.NoError(t, store.Put(ctx, key, []byte(`{"content":"secret"}`), "application/json"))
+
+	m := ent.NewClient().MCPInteraction.UpdateOneID("mi-1").Mutation()
+	require.NoError(t, replaceOffloadedToolResult(ctx, m, key, map[string]interface{}{"content": "[MASKED]"}))
+
+	raw, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"content":"[MASKED]"}`, string(raw))
+	size, ok := m.ToolResultBytes()
+	require.True(t, ok)
+	assert.Equal(t, len(raw), size)
+}
+
+func TestStoreImages(t *testing.T) {
+	ctx := context.Background()
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	images := []models.ImageAttachment{{Name: "panel.png", MimeType: "image/png", Data: png}}
+
+	t.Run("no images", func(t *testing.T) {
+		refs, err := StoreImages(ctx, nil)
+		require.NoError(t, err)
+		assert.Nil(t, refs)
+	})
+
+	t.Run("without a blob store images are rejected", func(t *testing.T) {
+		SetBlobStore(nil, nil)
+		_, err := StoreImages(ctx, images)
+		var valErr *ValidationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Contains(t, valErr.Message, "system.blob_store")
+	})
+
+	t.Run("invalid images are rejected", func(t *testing.T) {
+		withBlobStore(t, 0)
+		_, err := StoreImages(ctx, []models.ImageAttachment{{MimeType: "image/jpeg", Data: png}})
+		var valErr *ValidationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Equal(t, "images", valErr.Field)
+	})
+
+	t.Run("images are stored and loaded back", func(t *testing.T) {
+		withBlobStore(t, 0)
+		refs, err := StoreImages(ctx, images)
+		require.NoError(t, err)
+		require.Len(t, refs, 1)
+		assert.NotEmpty(t, refs[0].ID)
+		assert.Equal(t, imageBlobKey(refs[0].ID), refs[0].Key)
+		assert.Equal(t, "image/png", refs[0].MimeType)
+		assert.Equal(t, "panel.png", refs[0].Name)
+		assert.Equal(t, len(png), refs[0].SizeBytes)
+
+		data, err := LoadImage(ctx, refs[0])
+		require.NoError(t, err)
+		assert.Equal(t, png, data)
+	})
+}
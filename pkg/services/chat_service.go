@@ -8,13 +8,17 @@ import (
 
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/chat"
+	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/transcription"
 	"github.com/google/uuid"
 )
 
 // ChatService manages follow-up chat conversations
 type ChatService struct {
-	client *ent.Client
+	client      *ent.Client
+	transcriber transcription.Transcriber
 }
 
 // NewChatService creates a new ChatService
@@ -22,6 +26,17 @@ func NewChatService(client *ent.Client) *ChatService {
 	return &ChatService{client: client}
 }
 
+// SetTranscriber sets the speech-to-text provider for voice notes. Without
+// one, messages with a voice note are rejected.
+func (s *ChatService) SetTranscriber(t transcription.Transcriber) {
+	s.transcriber = t
+}
+
+// VoiceNotesEnabled reports whether messages may carry a voice note.
+func (s *ChatService) VoiceNotesEnabled() bool {
+	return s.transcriber != nil
+}
+
 // CreateChat initializes a chat for a session
 func (s *ChatService) CreateChat(httpCtx context.Context, req models.CreateChatRequest) (*ent.Chat, error) {
 	if req.SessionID == "" {
@@ -47,7 +62,6 @@ func (s *ChatService) CreateChat(httpCtx context.Context, req models.CreateChatR
 	chatObj, err := s.client.Chat.Create().
 		SetID(chatID).
 		SetSessionID(req.SessionID).
-		SetCreatedAt(time.Now()).
 		SetCreatedBy(req.CreatedBy).
 		SetChainID(session.ChainID).
 		Save(ctx)
@@ -64,12 +78,20 @@ func (s *ChatService) AddChatMessage(httpCtx context.Context, req models.AddChat
 	if req.ChatID == "" {
 		return nil, NewValidationError("chat_id", "required")
 	}
-	if req.Content == "" {
+	if req.Content == "" && req.VoiceNote == nil {
 		return nil, NewValidationError("content", "required")
 	}
 	if req.Author == "" {
 		return nil, NewValidationError("author", "required")
 	}
+	if req.VoiceNote != nil {
+		if msg := models.ValidateVoiceNote(req.VoiceNote); msg != "" {
+			return nil, NewValidationError("voice_note", msg)
+		}
+		if s.transcriber == nil {
+			return nil, NewValidationError("voice_note", "voice notes require system.transcription")
+		}
+	}
 
 	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
 	defer cancel()
@@ -83,14 +105,46 @@ func (s *ChatService) AddChatMessage(httpCtx context.Context, req models.AddChat
 		return nil, fmt.Errorf("failed to verify chat existence: %w", err)
 	}
 
+	// Uploads are outside the 5s DB budget — object stores can take longer
+	imageRefs, err := StoreImages(httpCtx, req.Images)
+	if err != nil {
+		return nil, err
+	}
+
+	content := req.Content
+	var voiceNote *schema.VoiceNote
+	if req.VoiceNote != nil {
+		// Transcription runs under the provider's own timeout
+		transcript, err := s.transcriber.Transcribe(httpCtx, req.VoiceNote.Data, req.VoiceNote.MimeType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrTranscriptionFailed, err)
+		}
+		if transcript == "" {
+			return nil, NewValidationError("voice_note", "no speech recognized")
+		}
+		voiceNote, err = storeVoiceNote(httpCtx, req.VoiceNote, transcript)
+		if err != nil {
+			return nil, err
+		}
+		content = joinVoiceNoteTranscript(req.Content, transcript)
+	}
+
 	messageID := uuid.New().String()
-	msg, err := s.client.ChatUserMessage.Create().
+	builder := s.client.ChatUserMessage.Create().
 		SetID(messageID).
 		SetChatID(req.ChatID).
-		SetContent(req.Content).
-		SetAuthor(req.Author).
-		SetCreatedAt(time.Now()).
-		Save(ctx)
+		SetContent(content).
+		SetAuthor(req.Author)
+	if req.AuthorSubject != "" {
+		builder.SetAuthorSubject(req.AuthorSubject)
+	}
+	if len(imageRefs) > 0 {
+		builder.SetImages(imageRefs)
+	}
+	if voiceNote != nil {
+		builder.SetVoiceNote(voiceNote)
+	}
+	msg, err := builder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add chat message: %w", err)
 	}
@@ -98,6 +152,52 @@ func (s *ChatService) AddChatMessage(httpCtx context.Context, req models.AddChat
 	return msg, nil
 }
 
+// joinVoiceNoteTranscript builds the question from typed text and a voice
+// note transcript. The transcript alone is the question when nothing was typed.
+func joinVoiceNoteTranscript(text, transcript string) string {
+	if text == "" {
+		return transcript
+	}
+	return text + "\n\n[Voice note transcript]\n" + transcript
+}
+
+// GetVoiceNote returns a voice note sent in the session's chat, with its
+// audio. Voice notes of other sessions are reported as ErrNotFound.
+func (s *ChatService) GetVoiceNote(ctx context.Context, sessionID, voiceNoteID string) (*schema.VoiceNote, []byte, error) {
+	if sessionID == "" {
+		return nil, nil, NewValidationError("sessionID", "required")
+	}
+	if voiceNoteID == "" {
+		return nil, nil, NewValidationError("voiceNoteID", "required")
+	}
+
+	msgs, err := s.client.ChatUserMessage.Query().
+		Where(
+			chatusermessage.HasChatWith(chat.SessionIDEQ(sessionID)),
+			chatusermessage.VoiceNoteNotNil(),
+		).
+		Select(chatusermessage.FieldVoiceNote).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get chat voice notes: %w", err)
+	}
+	for _, msg := range msgs {
+		if msg.VoiceNote == nil || msg.VoiceNote.ID != voiceNoteID {
+			continue
+		}
+		store, err := blobStore()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load voice note %s: %w", voiceNoteID, err)
+		}
+		data, err := store.Get(ctx, msg.VoiceNote.Key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load voice note %s: %w", voiceNoteID, err)
+		}
+		return msg.VoiceNote, data, nil
+	}
+	return nil, nil, ErrNotFound
+}
+
 // DeleteChatMessage removes a chat user message by ID.
 // Used to clean up orphaned messages when async submission is rejected.
 func (s *ChatService) DeleteChatMessage(httpCtx context.Context, messageID string) error {
@@ -144,7 +244,6 @@ func (s *ChatService) GetOrCreateChat(httpCtx context.Context, sessionID, author
 	chatObj, err := s.client.Chat.Create().
 		SetID(chatID).
 		SetSessionID(sessionID).
-		SetCreatedAt(time.Now()).
 		SetCreatedBy(author).
 		SetChainID(session.ChainID).
 		Save(ctx)
@@ -244,3 +244,39 @@ func TestChatService_GetChatBySessionID(t *testing.T) {
 		assert.Equal(t, session.ID, chat.SessionID)
 	})
 }
+
+func TestChatService_AddChatMessage_VoiceNoteValidation(t *testing.T) {
+	ctx := context.Background()
+	note := &models.VoiceNoteAttachment{MimeType: "audio/webm", Data: []byte("audio")}
+	// Validation runs before any database access
+	chatService := NewChatService(nil)
+
+	t.Run("voice note alone is enough", func(t *testing.T) {
+		_, err := chatService.AddChatMessage(ctx, models.AddChatMessageRequest{ChatID: "c1", Author: "a", VoiceNote: note})
+		var valErr *ValidationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Equal(t, "voice_note", valErr.Field, "content is not required with a voice note")
+	})
+
+	t.Run("without a transcriber voice notes are rejected", func(t *testing.T) {
+		_, err := chatService.AddChatMessage(ctx, models.AddChatMessageRequest{ChatID: "c1", Author: "a", Content: "q", VoiceNote: note})
+		var valErr *ValidationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Contains(t, valErr.Message, "system.transcription")
+	})
+
+	t.Run("invalid voice note", func(t *testing.T) {
+		_, err := chatService.AddChatMessage(ctx, models.AddChatMessageRequest{
+			ChatID: "c1", Author: "a", VoiceNote: &models.VoiceNoteAttachment{MimeType: "text/plain", Data: []byte("x")},
+		})
+		var valErr *ValidationError
+		require.ErrorAs(t, err, &valErr)
+		assert.Contains(t, valErr.Message, "unsupported mime_type")
+	})
+}
+
+func TestJoinVoiceNoteTranscript(t *testing.T) {
+	assert.Equal(t, "Check the pods", joinVoiceNoteTranscript("", "Check the pods"))
+	assert.Equal(t, "See attached\n\n[Voice note transcript]\nCheck the pods",
+		joinVoiceNoteTranscript("See attached", "Check the pods"))
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/klauspost/compress/zstd"
+)
+
+// At-rest compression of large timeline contents and interaction payloads.
+// Writers store a payload zstd-compressed in a companion bytea column once it
+// reaches the configured threshold; readers always decompress, so rows
+// written before compression was enabled (or after it was disabled) read the
+// same. Compressed columns never leave this package: every read path clears
+// them after restoring the plain fields.
+
+// defaultCompressionThreshold matches the system.compression default.
+const defaultCompressionThreshold = 8192
+
+// compressionThreshold is the minimum payload size in bytes stored
+// compressed. 0 disables compression of new writes.
+var compressionThreshold atomic.Int64
+
+func init() {
+	compressionThreshold.Store(defaultCompressionThreshold)
+}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+// SetCompression applies system.compression to new writes. Called once at
+// startup; nil or disabled stores every payload uncompressed.
+func SetCompression(cfg *config.CompressionConfig) {
+	if cfg == nil || !cfg.Enabled {
+		compressionThreshold.Store(0)
+		return
+	}
+	compressionThreshold.Store(int64(cfg.ThresholdBytes))
+}
+
+// shouldCompress reports whether a payload of n bytes is stored compressed.
+func shouldCompress(n int) bool {
+	threshold := compressionThreshold.Load()
+	return threshold > 0 && int64(n) >= threshold
+}
+
+// compressBytes returns the zstd encoding of b, or nil when b is below the
+// threshold or does not shrink.
+func compressBytes(b []byte) []byte {
+	if !shouldCompress(len(b)) {
+		return nil
+	}
+	packed := zstdEncoder.EncodeAll(b, make([]byte, 0, len(b)/4))
+	if len(packed) >= len(b) {
+		return nil
+	}
+	return packed
+}
+
+func decompressBytes(packed []byte) ([]byte, error) {
+	return zstdDecoder.DecodeAll(packed, nil)
+}
+
+// contentSearchColumn holds the full-text search vector of compressed
+// timeline content, which PostgreSQL cannot read. Like
+// investigation_memories.embedding it is not managed by Ent: it is written by
+// raw SQL alongside content and only referenced by search predicates.
+const contentSearchColumn = "content_tsv"
+
+// setTimelineContent stores content on a timeline event mutation, compressed
+// into content_zstd (with content left empty) when it reaches the threshold.
+// Reports whether the content was compressed.
+func setTimelineContent(m *ent.TimelineEventMutation, content string) bool {
+	packed := compressBytes([]byte(content))
+	if packed == nil {
+		m.SetContent(content)
+		if m.Op() != ent.OpCreate {
+			m.ClearContentZstd()
+		}
+		return false
+	}
+	m.SetContent("")
+	m.SetContentZstd(packed)
+	return true
+}
+
+// updateTimelineContent sets content on a timeline event update and keeps
+// content_tsv in sync with it.
+func updateTimelineContent(u *ent.TimelineEventUpdateOne, content string) *ent.TimelineEventUpdateOne {
+	compressed := setTimelineContent(u.Mutation(), content)
+	return u.Modify(contentSearchVector(content, compressed))
+}
+
+// indexCompressedContent fills content_tsv for a timeline event created with
+// compressed content (create builders cannot carry SQL expressions).
+func indexCompressedContent(ctx context.Context, client *ent.Client, eventID, content string) error {
+	return client.TimelineEvent.UpdateOneID(eventID).
+		Modify(contentSearchVector(content, true)).
+		Exec(ctx)
+}
+
+// contentSearchVector sets content_tsv from content when it is stored
+// compressed and clears it otherwise (the content column is searched
+// directly). Positions are stripped since search only tests for matches.
+func contentSearchVector(content string, compressed bool) func(*sql.UpdateBuilder) {
+	return func(u *sql.UpdateBuilder) {
+		if !compressed {
+			u.Set(contentSearchColumn, nil)
+			return
+		}
+		u.Set(contentSearchColumn, sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("strip(to_tsvector('english', ").Arg(content).WriteString("))")
+		}))
+	}
+}
+
+// DecompressTimelineEvents restores Content on events stored compressed.
+// Exported for packages that query timeline events with the ent client
+// directly instead of through TimelineService.
+func DecompressTimelineEvents(events ...*ent.TimelineEvent) error {
+	for _, ev := range events {
+		if ev == nil || ev.ContentZstd == nil {
+			continue
+		}
+		raw, err := decompressBytes(*ev.ContentZstd)
+		if err != nil {
+			return fmt.Errorf("failed to decompress timeline event %s: %w", ev.ID, err)
+		}
+		ev.Content = string(raw)
+		ev.ContentZstd = nil
+	}
+	return nil
+}
+
+// llmPayload is the compressed form of an LLM interaction's request and
+// response (llm_interactions.payload_zstd).
+type llmPayload struct {
+	Request  map[string]interface{} `json:"llm_request"`
+	Response map[string]interface{} `json:"llm_response"`
+}
+
+// setLLMPayload stores the request/response on an LLM interaction mutation,
+// compressed into payload_zstd (with both JSON columns set to {}) when their
+// combined encoding reaches the threshold.
+func setLLMPayload(m *ent.LLMInteractionMutation, request, response map[string]interface{}) error {
+	packed, err := compressJSON(llmPayload{Request: request, Response: response})
+	if err != nil {
+		return fmt.Errorf("failed to encode LLM payload: %w", err)
+	}
+	if packed == nil {
+		m.SetLlmRequest(request)
+		m.SetLlmResponse(response)
+		if m.Op() != ent.OpCreate {
+			m.ClearPayloadZstd()
+		}
+		return nil
+	}
+	m.SetLlmRequest(map[string]interface{}{})
+	m.SetLlmResponse(map[string]interface{}{})
+	m.SetPayloadZstd(packed)
+	return nil
+}
+
+// decompressLLMInteractions restores LlmRequest and LlmResponse on
+// interactions stored compressed.
+func decompressLLMInteractions(interactions ...*ent.LLMInteraction) error {
+	for _, li := range interactions {
+		if li == nil || li.PayloadZstd == nil {
+			continue
+		}
+		var payload llmPayload
+		if err := decompressJSON(*li.PayloadZstd, &payload); err != nil {
+			return fmt.Errorf("failed to decompress LLM interaction %s: %w", li.ID, err)
+		}
+		li.LlmRequest = payload.Request
+		li.LlmResponse = payload.Response
+		li.PayloadZstd = nil
+	}
+	return nil
+}
+
+// setMCPToolResult stores a tool result on an MCP interaction mutation,
+// compressed into tool_result_zstd (with tool_result left null) when its
+// encoding reaches the threshold. tool_result_bytes keeps the uncompressed
+// size for usage statistics.
+func setMCPToolResult(m *ent.MCPInteractionMutation, result map[string]interface{}) error {
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode tool result: %w", err)
+	}
+	packed := compressBytes(raw)
+	if packed == nil {
+		m.SetToolResult(result)
+		if m.Op() != ent.OpCreate {
+			m.ClearToolResultZstd()
+			m.ClearToolResultBytes()
+			m.ClearToolResultBlob()
+		}
+		return nil
+	}
+	if m.Op() != ent.OpCreate {
+		m.ClearToolResult()
+		m.ClearToolResultBlob()
+	}
+	m.SetToolResultZstd(packed)
+	m.SetToolResultBytes(len(raw))
+	return nil
+}
+
+// decompressMCPInteractions restores ToolResult on interactions stored
+// compressed.
+func decompressMCPInteractions(interactions ...*ent.MCPInteraction) error {
+	for _, mi := range interactions {
+		if mi == nil || mi.ToolResultZstd == nil {
+			continue
+		}
+		if err := decompressJSON(*mi.ToolResultZstd, &mi.ToolResult); err != nil {
+			return fmt.Errorf("failed to decompress MCP interaction %s: %w", mi.ID, err)
+		}
+		mi.ToolResultZstd = nil
+		mi.ToolResultBytes = nil
+	}
+	return nil
+}
+
+// compressJSON encodes v and compresses it, returning nil when the encoding
+// stays below the threshold.
+func compressJSON(v any) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return compressBytes(raw), nil
+}
+
+func decompressJSON(packed []byte, v any) error {
+	raw, err := decompressBytes(packed)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
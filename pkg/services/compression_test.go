@@ -0,0 +1,163 @@
+package services
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withCompression applies cfg for the duration of the test.
+func withCompression(t *testing.T, cfg *config.CompressionConfig) {
+	t.Helper()
+	SetCompression(cfg)
+	t.Cleanup(func() { compressionThreshold.Store(defaultCompressionThreshold) })
+}
+
+func TestSetCompression(t *testing.T) {
+	withCompression(t, &config.CompressionConfig{Enabled: true, ThresholdBytes: 2048})
+	assert.False(t, shouldCompress(2047))
+	assert.True(t, shouldCompress(2048))
+
+	SetCompression(&config.CompressionConfig{Enabled: false, ThresholdBytes: 2048})
+	assert.False(t, shouldCompress(1<<20))
+
+	SetCompression(nil)
+	assert.False(t, shouldCompress(1<<20))
+}
+
+func TestCompressBytes(t *testing.T) {
+	withCompression(t, &config.CompressionConfig{Enabled: true, ThresholdBytes: 1024})
+
+	t.Run("below threshold stays uncompressed", func(t *testing.T) {
+		assert.Nil(t, compressBytes([]byte(strings.Repeat("a", 1023))))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		raw := []byte(strings.Repeat("pod crashloop backoff\n", 200))
+		packed := compressBytes(raw)
+		require.NotNil(t, packed)
+		assert.Less(t, len(packed), len(raw))
+
+		out, err := decompressBytes(packed)
+		require.NoError(t, err)
+		assert.Equal(t, raw, out)
+	})
+
+	t.Run("incompressible data stays uncompressed", func(t *testing.T) {
+		raw := make([]byte, 4096)
+		_, err := rand.Read(raw)
+		require.NoError(t, err)
+		assert.Nil(t, compressBytes(raw))
+	})
+}
+
+func TestSetTimelineContent(t *testing.T) {
+	withCompression(t, &config.CompressionConfig{Enabled: true, ThresholdBytes: 1024})
+	client := ent.NewClient()
+	large := strings.Repeat("kubectl describe pod output\n", 100)
+
+	t.Run("large content is compressed", func(t *testing.T) {
+		m := client.TimelineEvent.Create().Mutation()
+		setTimelineContent(m, large)
+
+		content, _ := m.Content()
+		assert.Empty(t, content)
+		packed, ok := m.ContentZstd()
+		require.True(t, ok)
+
+		ev := &ent.TimelineEvent{ID: "ev-1", ContentZstd: &packed}
+		require.NoError(t, DecompressTimelineEvents(ev))
+		assert.Equal(t, large, ev.Content)
+		assert.Nil(t, ev.ContentZstd)
+	})
+
+	t.Run("small content on update clears compressed column", func(t *testing.T) {
+		m := client.TimelineEvent.UpdateOneID("ev-1").Mutation()
+		setTimelineContent(m, "short")
+
+		content, _ := m.Content()
+		assert.Equal(t, "short", content)
+		assert.True(t, m.ContentZstdCleared())
+	})
+
+	t.Run("uncompressed events are left alone", func(t *testing.T) {
+		ev := &ent.TimelineEvent{ID: "ev-2", Content: "plain"}
+		require.NoError(t, DecompressTimelineEvents(ev, nil))
+		assert.Equal(t, "plain", ev.Content)
+	})
+
+	t.Run("corrupt payload returns error", func(t *testing.T) {
+		bad := []byte("not zstd")
+		err := DecompressTimelineEvents(&ent.TimelineEvent{ID: "ev-3", ContentZstd: &bad})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ev-3")
+	})
+}
+
+func TestSetLLMPayload(t *testing.T) {
+	withCompression(t, &config.CompressionConfig{Enabled: true, ThresholdBytes: 1024})
+	client := ent.NewClient()
+
+	request := map[string]interface{}{
+		"messages_count": float64(3),
+		"conversation":   strings.Repeat("You are a Kubernetes SRE assistant. ", 100),
+	}
+	response := map[string]interface{}{"text": "Root cause: OOMKilled"}
+
+	m := client.LLMInteraction.Create().Mutation()
+	require.NoError(t, setLLMPayload(m, request, response))
+
+	storedReq, _ := m.LlmRequest()
+	storedResp, _ := m.LlmResponse()
+	assert.Empty(t, storedReq)
+	assert.Empty(t, storedResp)
+	packed, ok := m.PayloadZstd()
+	require.True(t, ok)
+
+	li := &ent.LLMInteraction{ID: "li-1", LlmRequest: storedReq, LlmResponse: storedResp, PayloadZstd: &packed}
+	require.NoError(t, decompressLLMInteractions(li))
+	assert.Equal(t, request, li.LlmRequest)
+	assert.Equal(t, response, li.LlmResponse)
+	assert.Nil(t, li.PayloadZstd)
+
+	small := client.LLMInteraction.Create().Mutation()
+	require.NoError(t, setLLMPayload(small, map[string]interface{}{"a": "b"}, response))
+	_, ok = small.PayloadZstd()
+	assert.False(t, ok)
+	storedReq, _ = small.LlmRequest()
+	assert.Equal(t, map[string]interface{}{"a": "b"}, storedReq)
+}
+
+func TestSetMCPToolResult(t *testing.T) {
+	withCompression(t, &config.CompressionConfig{Enabled: true, ThresholdBytes: 1024})
+	client := ent.NewClient()
+
+	result := map[string]interface{}{"content": strings.Repeat("NAME READY STATUS RESTARTS\n", 100)}
+
+	m := client.MCPInteraction.Create().Mutation()
+	require.NoError(t, setMCPToolResult(m, result))
+
+	_, ok := m.ToolResult()
+	assert.False(t, ok, "tool_result must stay null when compressed")
+	packed, ok := m.ToolResultZstd()
+	require.True(t, ok)
+	size, ok := m.ToolResultBytes()
+	require.True(t, ok)
+	assert.Greater(t, size, len(packed))
+
+	mi := &ent.MCPInteraction{ID: "mi-1", ToolResultZstd: &packed, ToolResultBytes: &size}
+	require.NoError(t, decompressMCPInteractions(mi))
+	assert.Equal(t, result, mi.ToolResult)
+	assert.Nil(t, mi.ToolResultZstd)
+	assert.Nil(t, mi.ToolResultBytes)
+
+	update := client.MCPInteraction.UpdateOneID("mi-1").Mutation()
+	require.NoError(t, setMCPToolResult(update, map[string]interface{}{"content": "ok"}))
+	assert.True(t, update.ToolResultZstdCleared())
+	assert.True(t, update.ToolResultBytesCleared())
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/configregistration"
+	"github.com/google/uuid"
+)
+
+// ConfigRegistrationService persists the versions of agents and chains
+// registered at runtime, shared by all replicas.
+type ConfigRegistrationService struct {
+	client *ent.Client
+}
+
+// NewConfigRegistrationService creates a new ConfigRegistrationService.
+func NewConfigRegistrationService(client *ent.Client) *ConfigRegistrationService {
+	return &ConfigRegistrationService{client: client}
+}
+
+// Latest returns the newest version of every registered agent and chain,
+// including deletion tombstones, ordered by kind and name.
+func (s *ConfigRegistrationService) Latest(ctx context.Context) ([]*ent.ConfigRegistration, error) {
+	rows, err := s.client.ConfigRegistration.Query().
+		Order(
+			ent.Asc(configregistration.FieldKind),
+			ent.Asc(configregistration.FieldName),
+			ent.Desc(configregistration.FieldVersion),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config registrations: %w", err)
+	}
+
+	latest := make([]*ent.ConfigRegistration, 0, len(rows))
+	for _, row := range rows {
+		if n := len(latest); n > 0 && latest[n-1].Kind == row.Kind && latest[n-1].Name == row.Name {
+			continue
+		}
+		latest = append(latest, row)
+	}
+	return latest, nil
+}
+
+// History returns every version of one registered agent or chain, newest
+// first. Returns ErrNotFound when it was never registered.
+func (s *ConfigRegistrationService) History(ctx context.Context, kind configregistration.Kind, name string) ([]*ent.ConfigRegistration, error) {
+	rows, err := s.client.ConfigRegistration.Query().
+		Where(
+			configregistration.KindEQ(kind),
+			configregistration.NameEQ(name),
+		).
+		Order(ent.Desc(configregistration.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config registration history: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, ErrNotFound
+	}
+	return rows, nil
+}
+
+// Append stores the next version of an agent or chain. An empty definition
+// with deleted set records a deletion. Returns ErrConflict when another
+// request stored the same version first.
+func (s *ConfigRegistrationService) Append(ctx context.Context, kind configregistration.Kind, name, definition string, deleted bool, author string) (*ent.ConfigRegistration, error) {
+	current, err := s.client.ConfigRegistration.Query().
+		Where(
+			configregistration.KindEQ(kind),
+			configregistration.NameEQ(name),
+		).
+		Order(ent.Desc(configregistration.FieldVersion)).
+		First(ctx)
+	version := 1
+	switch {
+	case err == nil:
+		version = current.Version + 1
+	case !ent.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get current config registration: %w", err)
+	}
+
+	create := s.client.ConfigRegistration.Create().
+		SetID(uuid.New().String()).
+		SetKind(kind).
+		SetName(name).
+		SetVersion(version).
+		SetDeleted(deleted)
+	if definition != "" {
+		create.SetDefinition(definition)
+	}
+	if author != "" {
+		create.SetCreatedBy(author)
+	}
+	row, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %s %q was modified concurrently", ErrConflict, kind, name)
+		}
+		return nil, fmt.Errorf("failed to create config registration: %w", err)
+	}
+	return row, nil
+}
@@ -21,9 +21,20 @@ var (
 	// ErrNotCancellable is returned when attempting to cancel a session that is not in a cancellable state
 	ErrNotCancellable = errors.New("session is not in a cancellable state")
 
+	// ErrSessionNotActive is returned when an operation requires a queued or running session
+	ErrSessionNotActive = errors.New("session is not active")
+
 	// ErrConflict is returned when a state transition fails because the current state
 	// doesn't match the expected precondition (e.g., concurrent claim/resolve race).
 	ErrConflict = errors.New("state conflict")
+
+	// ErrTranscriptionFailed is returned when the speech-to-text provider
+	// could not transcribe a voice note
+	ErrTranscriptionFailed = errors.New("voice note transcription failed")
+
+	// ErrBudgetExceeded is returned when an alert submission's forecast
+	// exceeds the budget of its chain
+	ErrBudgetExceeded = errors.New("forecast exceeds chain budget")
 )
 
 // ValidationError wraps field-specific validation errors
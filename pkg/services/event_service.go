@@ -29,7 +29,6 @@ func (s *EventService) CreateEvent(httpCtx context.Context, req models.CreateEve
 		SetSessionID(req.SessionID).
 		SetChannel(req.Channel).
 		SetPayload(req.Payload).
-		SetCreatedAt(time.Now()).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create event: %w", err)
@@ -68,7 +67,6 @@ func (s *EventService) CreateEventTx(ctx context.Context, tx *ent.Tx, req models
 		SetSessionID(req.SessionID).
 		SetChannel(req.Channel).
 		SetPayload(req.Payload).
-		SetCreatedAt(time.Now()).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create event in tx: %w", err)
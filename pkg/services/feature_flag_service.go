@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/featureflagoverride"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// FeatureFlagService persists runtime feature flag overrides, shared by all
+// replicas.
+type FeatureFlagService struct {
+	client *ent.Client
+}
+
+// NewFeatureFlagService creates a new FeatureFlagService.
+func NewFeatureFlagService(client *ent.Client) *FeatureFlagService {
+	return &FeatureFlagService{client: client}
+}
+
+// List returns all overrides, ordered by flag name.
+func (s *FeatureFlagService) List(ctx context.Context) ([]*ent.FeatureFlagOverride, error) {
+	overrides, err := s.client.FeatureFlagOverride.Query().
+		Order(ent.Asc(featureflagoverride.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flag overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+// Set upserts the override for flag.
+func (s *FeatureFlagService) Set(ctx context.Context, flag string, rollout config.FeatureFlagConfig, author string) (*ent.FeatureFlagOverride, error) {
+	update := s.client.FeatureFlagOverride.UpdateOneID(flag).
+		SetEnabled(rollout.Enabled).
+		SetAlertTypes(rollout.AlertTypes).
+		SetChains(rollout.Chains).
+		SetNamespaces(rollout.Namespaces).
+		SetUpdatedBy(author)
+	if rollout.Percentage != nil {
+		update.SetPercentage(*rollout.Percentage)
+	} else {
+		update.ClearPercentage()
+	}
+	override, err := update.Save(ctx)
+	if err == nil {
+		return override, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to update feature flag override: %w", err)
+	}
+
+	override, err = s.client.FeatureFlagOverride.Create().
+		SetID(flag).
+		SetEnabled(rollout.Enabled).
+		SetNillablePercentage(rollout.Percentage).
+		SetAlertTypes(rollout.AlertTypes).
+		SetChains(rollout.Chains).
+		SetNamespaces(rollout.Namespaces).
+		SetUpdatedBy(author).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create feature flag override: %w", err)
+	}
+	return override, nil
+}
+
+// Delete removes the override for flag. Deleting a missing override is not
+// an error.
+func (s *FeatureFlagService) Delete(ctx context.Context, flag string) error {
+	_, err := s.client.FeatureFlagOverride.Delete().
+		Where(featureflagoverride.IDEQ(flag)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete feature flag override: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+func TestFeatureFlagService(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := NewFeatureFlagService(client.Client)
+	ctx := context.Background()
+	pct := 25
+
+	t.Run("set creates then replaces", func(t *testing.T) {
+		_, err := service.Set(ctx, config.FeatureFlagFanOut, config.FeatureFlagConfig{
+			Enabled: true, Percentage: &pct, AlertTypes: []string{"Outage"},
+		}, "alice")
+		require.NoError(t, err)
+
+		override, err := service.Set(ctx, config.FeatureFlagFanOut, config.FeatureFlagConfig{
+			Enabled: false, Chains: []string{"k8s"},
+		}, "bob")
+		require.NoError(t, err)
+		assert.False(t, override.Enabled)
+		assert.Nil(t, override.Percentage, "percentage is cleared when omitted")
+		assert.Empty(t, override.AlertTypes)
+		assert.Equal(t, []string{"k8s"}, override.Chains)
+		require.NotNil(t, override.UpdatedBy)
+		assert.Equal(t, "bob", *override.UpdatedBy)
+
+		overrides, err := service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, overrides, 1)
+		assert.Equal(t, config.FeatureFlagFanOut, overrides[0].ID)
+	})
+
+	t.Run("delete is idempotent", func(t *testing.T) {
+		require.NoError(t, service.Delete(ctx, config.FeatureFlagFanOut))
+		require.NoError(t, service.Delete(ctx, config.FeatureFlagFanOut))
+
+		overrides, err := service.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, overrides)
+	})
+}
@@ -0,0 +1,281 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/stage"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+const (
+	// forecastSampleSize is how many of the most recently completed sessions
+	// a forecast averages.
+	forecastSampleSize = 50
+	// forecastMinAlertTypeSamples is the alert-type history needed before a
+	// forecast prefers it over the whole chain's history.
+	forecastMinAlertTypeSamples = 3
+)
+
+// ForecastService estimates chain budgets from the history of completed
+// sessions: per-stage averages of LLM tokens, estimated cost and duration.
+type ForecastService struct {
+	client                *ent.Client
+	chainRegistry         *config.ChainRegistry
+	costEstimationEnabled bool
+}
+
+// NewForecastService creates a new ForecastService.
+func NewForecastService(client *ent.Client, chainRegistry *config.ChainRegistry, costEstimationEnabled bool) *ForecastService {
+	return &ForecastService{
+		client:                client,
+		chainRegistry:         chainRegistry,
+		costEstimationEnabled: costEstimationEnabled,
+	}
+}
+
+// forecastSample is a completed session included in a forecast.
+type forecastSample struct {
+	ID          string     `json:"id"`
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// stageUsage is the LLM usage recorded for one stage (or, with a NULL stage
+// ID, outside any stage).
+type stageUsage struct {
+	StageID stdsql.NullString  `json:"stage_id"`
+	Tokens  stdsql.NullInt64   `json:"tokens"`
+	Cost    stdsql.NullFloat64 `json:"cost"`
+}
+
+// Forecast estimates the budget of running chainID for an alert of
+// alertType. It averages the alert type's completed sessions of the chain
+// when there are enough, otherwise all of the chain's. The chain's budget,
+// if any, is checked against the result.
+func (s *ForecastService) Forecast(ctx context.Context, alertType, chainID string) (*models.BudgetForecast, error) {
+	chain, err := s.chainRegistry.Get(chainID)
+	if err != nil {
+		return nil, NewValidationError("chain_id", fmt.Sprintf("chain '%s' not found", chainID))
+	}
+
+	basis := models.ForecastBasisAlertType
+	samples, err := s.sampleSessions(ctx, alertsession.ChainID(chainID), alertsession.AlertType(alertType))
+	if err != nil {
+		return nil, err
+	}
+	if len(samples) < forecastMinAlertTypeSamples {
+		basis = models.ForecastBasisChain
+		if samples, err = s.sampleSessions(ctx, alertsession.ChainID(chainID)); err != nil {
+			return nil, err
+		}
+	}
+
+	f := &models.BudgetForecast{
+		ChainID:    chainID,
+		AlertType:  alertType,
+		Basis:      basis,
+		SampleSize: len(samples),
+	}
+	if len(samples) == 0 {
+		f.Basis = models.ForecastBasisNone
+		for _, st := range chain.Stages {
+			f.Stages = append(f.Stages, models.StageForecast{StageName: st.Name, EstimatedCostUsd: s.zeroCost()})
+		}
+		f.EstimatedCostUsd = s.zeroCost()
+	} else if err := s.fillEstimates(ctx, f, chain, samples); err != nil {
+		return nil, err
+	}
+
+	if chain.Budget != nil {
+		f.Budget = CheckBudget(f, chain.Budget)
+	}
+	return f, nil
+}
+
+// sampleSessions returns the most recently completed sessions matching preds.
+func (s *ForecastService) sampleSessions(ctx context.Context, preds ...predicate.AlertSession) ([]forecastSample, error) {
+	var samples []forecastSample
+	err := s.client.AlertSession.Query().
+		Where(append(preds,
+			alertsession.StatusEQ(alertsession.StatusCompleted),
+			alertsession.DeletedAtIsNil(),
+			alertsession.StartedAtNotNil(),
+			alertsession.CompletedAtNotNil(),
+		)...).
+		Order(ent.Desc(alertsession.FieldCompletedAt)).
+		Limit(forecastSampleSize).
+		Select(alertsession.FieldID, alertsession.FieldStartedAt, alertsession.FieldCompletedAt).
+		Scan(ctx, &samples)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query forecast sessions: %w", err)
+	}
+	return samples, nil
+}
+
+// fillEstimates sets the session and per-stage averages of f from samples.
+func (s *ForecastService) fillEstimates(ctx context.Context, f *models.BudgetForecast, chain *config.ChainConfig, samples []forecastSample) error {
+	ids := make([]string, 0, len(samples))
+	var totalDuration time.Duration
+	for _, sample := range samples {
+		ids = append(ids, sample.ID)
+		totalDuration += sample.CompletedAt.Sub(*sample.StartedAt)
+	}
+	n := int64(len(samples))
+	f.EstimatedDurationSeconds = int64(math.Round(totalDuration.Seconds() / float64(n)))
+
+	var usage []stageUsage
+	err := s.client.LLMInteraction.Query().
+		Where(llminteraction.SessionIDIn(ids...)).
+		GroupBy(llminteraction.FieldStageID).
+		Aggregate(
+			ent.As(ent.Sum(llminteraction.FieldTotalTokens), "tokens"),
+			ent.As(ent.Sum(llminteraction.FieldEstimatedCostUsd), "cost"),
+		).
+		Scan(ctx, &usage)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate forecast usage: %w", err)
+	}
+
+	// Follow-up chats and scoring run after the session completed, so they
+	// are not part of what submitting an alert costs.
+	stages, err := s.client.Stage.Query().
+		Where(
+			stage.SessionIDIn(ids...),
+			stage.StageTypeNotIn(stage.StageTypeChat, stage.StageTypeScoring),
+		).
+		Select(stage.FieldID, stage.FieldStageName, stage.FieldStageIndex, stage.FieldDurationMs).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query forecast stages: %w", err)
+	}
+
+	usageByStage := make(map[string]stageUsage, len(usage))
+	var totalTokens int64
+	var totalCost float64
+	for _, u := range usage {
+		if u.StageID.Valid {
+			usageByStage[u.StageID.String] = u
+		} else {
+			totalTokens += u.Tokens.Int64
+			totalCost += u.Cost.Float64
+		}
+	}
+	for _, st := range stages {
+		totalTokens += usageByStage[st.ID].Tokens.Int64
+		totalCost += usageByStage[st.ID].Cost.Float64
+	}
+	f.EstimatedTokens = totalTokens / n
+	f.EstimatedCostUsd = s.cost(totalCost / float64(n))
+	f.Stages = s.stageForecasts(chain, stages, usageByStage)
+	return nil
+}
+
+// stageForecasts averages stages by name: configured stages first, in chain
+// order, then stages added at runtime ordered by their typical position.
+func (s *ForecastService) stageForecasts(chain *config.ChainConfig, stages []*ent.Stage, usageByStage map[string]stageUsage) []models.StageForecast {
+	type acc struct {
+		count, indexSum    int
+		durationMs, tokens int64
+		cost               float64
+	}
+	byName := make(map[string]*acc)
+	for _, st := range stages {
+		a := byName[st.StageName]
+		if a == nil {
+			a = &acc{}
+			byName[st.StageName] = a
+		}
+		a.count++
+		a.indexSum += st.StageIndex
+		if st.DurationMs != nil {
+			a.durationMs += int64(*st.DurationMs)
+		}
+		u := usageByStage[st.ID]
+		a.tokens += u.Tokens.Int64
+		a.cost += u.Cost.Float64
+	}
+
+	names := make([]string, 0, len(byName)+len(chain.Stages))
+	for _, st := range chain.Stages {
+		names = append(names, st.Name)
+	}
+	var extra []string
+	for name := range byName {
+		if !slices.Contains(names, name) {
+			extra = append(extra, name)
+		}
+	}
+	slices.SortFunc(extra, func(a, b string) int {
+		ai := float64(byName[a].indexSum) / float64(byName[a].count)
+		bi := float64(byName[b].indexSum) / float64(byName[b].count)
+		return cmp.Or(cmp.Compare(ai, bi), cmp.Compare(a, b))
+	})
+	names = append(names, extra...)
+
+	out := make([]models.StageForecast, 0, len(names))
+	for _, name := range names {
+		sf := models.StageForecast{StageName: name, EstimatedCostUsd: s.zeroCost()}
+		if a := byName[name]; a != nil {
+			n := int64(a.count)
+			sf.SampleSize = a.count
+			sf.EstimatedTokens = a.tokens / n
+			sf.EstimatedCostUsd = s.cost(a.cost / float64(n))
+			sf.EstimatedDurationSeconds = int64(math.Round(float64(a.durationMs) / float64(n) / 1000))
+		}
+		out = append(out, sf)
+	}
+	return out
+}
+
+// cost rounds an estimated cost to cents; nil when cost estimation is off.
+func (s *ForecastService) cost(usd float64) *float64 {
+	if !s.costEstimationEnabled {
+		return nil
+	}
+	rounded := math.Round(usd*100) / 100
+	return &rounded
+}
+
+func (s *ForecastService) zeroCost() *float64 {
+	return s.cost(0)
+}
+
+// CheckBudget compares a forecast with a chain budget. A budget is enforced
+// only when the forecast averages at least budget.EffectiveMinSamples()
+// sessions, so new chains are not rejected on thin history.
+func CheckBudget(f *models.BudgetForecast, budget *config.ChainBudgetConfig) *models.BudgetCheck {
+	check := &models.BudgetCheck{MinSamples: budget.EffectiveMinSamples()}
+	if budget.MaxCostUSD > 0 {
+		maxCost := budget.MaxCostUSD
+		check.MaxCostUsd = &maxCost
+		if f.EstimatedCostUsd != nil && *f.EstimatedCostUsd > maxCost {
+			check.Exceeded = true
+			check.Reason = fmt.Sprintf("estimated cost $%.2f exceeds max_cost_usd $%.2f", *f.EstimatedCostUsd, maxCost)
+		}
+	}
+	if budget.MaxDuration > 0 {
+		maxSecs := int64(budget.MaxDuration.Seconds())
+		check.MaxDurationSeconds = &maxSecs
+		if !check.Exceeded && f.EstimatedDurationSeconds > maxSecs {
+			check.Exceeded = true
+			check.Reason = fmt.Sprintf("estimated duration %s exceeds max_duration %s",
+				time.Duration(f.EstimatedDurationSeconds)*time.Second, budget.MaxDuration)
+		}
+	}
+	check.Enforced = check.Exceeded && f.SampleSize >= check.MinSamples
+	if check.Exceeded && !check.Enforced {
+		check.Reason += fmt.Sprintf(" (not enforced: %d of %d sessions of history)", f.SampleSize, check.MinSamples)
+	}
+	return check
+}
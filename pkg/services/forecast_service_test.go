@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBudget(t *testing.T) {
+	forecast := func(samples int, costUSD float64, seconds int64) *models.BudgetForecast {
+		return &models.BudgetForecast{SampleSize: samples, EstimatedCostUsd: &costUSD, EstimatedDurationSeconds: seconds}
+	}
+
+	tests := []struct {
+		name         string
+		forecast     *models.BudgetForecast
+		budget       *config.ChainBudgetConfig
+		wantExceeded bool
+		wantEnforced bool
+		wantReason   string
+	}{
+		{
+			name:     "within budget",
+			forecast: forecast(10, 0.50, 60),
+			budget:   &config.ChainBudgetConfig{MaxCostUSD: 1, MaxDuration: 5 * time.Minute},
+		},
+		{
+			name:         "cost exceeded with enough history",
+			forecast:     forecast(10, 1.25, 60),
+			budget:       &config.ChainBudgetConfig{MaxCostUSD: 1},
+			wantExceeded: true,
+			wantEnforced: true,
+			wantReason:   "estimated cost $1.25 exceeds max_cost_usd $1.00",
+		},
+		{
+			name:         "duration exceeded with enough history",
+			forecast:     forecast(5, 0.10, 600),
+			budget:       &config.ChainBudgetConfig{MaxDuration: 5 * time.Minute},
+			wantExceeded: true,
+			wantEnforced: true,
+			wantReason:   "estimated duration 10m0s exceeds max_duration 5m0s",
+		},
+		{
+			name:         "exceeded on thin history is not enforced",
+			forecast:     forecast(2, 1.25, 60),
+			budget:       &config.ChainBudgetConfig{MaxCostUSD: 1},
+			wantExceeded: true,
+			wantReason:   "(not enforced: 2 of 5 sessions of history)",
+		},
+		{
+			name:         "custom min samples",
+			forecast:     forecast(2, 1.25, 60),
+			budget:       &config.ChainBudgetConfig{MaxCostUSD: 1, MinSamples: 2},
+			wantExceeded: true,
+			wantEnforced: true,
+		},
+		{
+			name:     "cost limit ignored without cost estimation",
+			forecast: &models.BudgetForecast{SampleSize: 10, EstimatedDurationSeconds: 60},
+			budget:   &config.ChainBudgetConfig{MaxCostUSD: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := CheckBudget(tt.forecast, tt.budget)
+			assert.Equal(t, tt.wantExceeded, check.Exceeded)
+			assert.Equal(t, tt.wantEnforced, check.Enforced)
+			assert.Contains(t, check.Reason, tt.wantReason)
+		})
+	}
+}
+
+func TestForecastService_Forecast(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	ctx := context.Background()
+	chains := config.NewChainRegistry(map[string]*config.ChainConfig{
+		"k8s-analysis": {
+			AlertTypes: []string{"pod-crash", "oom"},
+			Stages:     []config.StageConfig{{Name: "analysis"}},
+			Budget:     &config.ChainBudgetConfig{MaxCostUSD: 0.05, MinSamples: 2},
+		},
+	})
+	svc := NewForecastService(client.Client, chains, true)
+
+	t.Run("no history", func(t *testing.T) {
+		f, err := svc.Forecast(ctx, "pod-crash", "k8s-analysis")
+		require.NoError(t, err)
+		assert.Equal(t, models.ForecastBasisNone, f.Basis)
+		assert.Zero(t, f.SampleSize)
+		require.Len(t, f.Stages, 1)
+		assert.Equal(t, "analysis", f.Stages[0].StageName)
+		require.NotNil(t, f.Budget)
+		assert.False(t, f.Budget.Exceeded)
+	})
+
+	for _, costUSD := range []float64{0.04, 0.08} {
+		id, stageID, execID := seedUsageSession(t, client.Client, usageSeed{
+			AlertData: "crash", AlertType: "oom", ChainID: "k8s-analysis", CreatedAt: time.Now(),
+		})
+		seedLLMInteraction(t, client.Client, id, stageID, execID, "model-a", 100, 100, 200, floatPtr(costUSD), 0)
+	}
+
+	t.Run("falls back to the chain's history", func(t *testing.T) {
+		f, err := svc.Forecast(ctx, "pod-crash", "k8s-analysis")
+		require.NoError(t, err)
+		assert.Equal(t, models.ForecastBasisChain, f.Basis)
+		assert.Equal(t, 2, f.SampleSize)
+		assert.Equal(t, int64(200), f.EstimatedTokens)
+		require.NotNil(t, f.EstimatedCostUsd)
+		assert.InDelta(t, 0.06, *f.EstimatedCostUsd, 1e-9)
+		assert.Equal(t, int64(5), f.EstimatedDurationSeconds)
+		require.Len(t, f.Stages, 1)
+		assert.Equal(t, 2, f.Stages[0].SampleSize)
+		assert.True(t, f.Budget.Enforced)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		_, err := svc.Forecast(ctx, "pod-crash", "missing")
+		assert.True(t, IsValidationError(err))
+	})
+}
@@ -3,13 +3,16 @@ package services
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
 	"github.com/codeready-toolchain/tarsy/pkg/cost"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 	"github.com/google/uuid"
 )
 
@@ -43,10 +46,11 @@ func (s *InteractionService) CreateLLMInteraction(httpCtx context.Context, req m
 		SetNillableExecutionID(req.ExecutionID).
 		SetInteractionType(llminteraction.InteractionType(req.InteractionType)).
 		SetModelName(req.ModelName).
-		SetLlmRequest(req.LLMRequest).
-		SetLlmResponse(req.LLMResponse).
-		SetCreatedAt(time.Now())
+		SetNillableRequestID(requestid.Ptr(httpCtx))
 
+	if req.Owner != "" {
+		builder = builder.SetOwner(req.Owner)
+	}
 	if req.LastMessageID != nil {
 		builder = builder.SetLastMessageID(*req.LastMessageID)
 	}
@@ -78,11 +82,15 @@ func (s *InteractionService) CreateLLMInteraction(httpCtx context.Context, req m
 	if estimated := s.estimateCost(req); estimated != nil {
 		builder = builder.SetEstimatedCostUsd(*estimated)
 	}
+	if err := setLLMPayload(builder.Mutation(), req.LLMRequest, req.LLMResponse); err != nil {
+		return nil, err
+	}
 
 	interaction, err := builder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create LLM interaction: %w", err)
 	}
+	interaction.LlmRequest, interaction.LlmResponse, interaction.PayloadZstd = req.LLMRequest, req.LLMResponse, nil
 
 	return interaction, nil
 }
@@ -124,7 +132,7 @@ func (s *InteractionService) CreateMCPInteraction(httpCtx context.Context, req m
 		SetExecutionID(req.ExecutionID).
 		SetInteractionType(mcpinteraction.InteractionType(req.InteractionType)).
 		SetServerName(req.ServerName).
-		SetCreatedAt(time.Now())
+		SetNillableRequestID(requestid.Ptr(httpCtx))
 
 	if req.ToolName != nil {
 		builder = builder.SetToolName(*req.ToolName)
@@ -132,8 +140,13 @@ func (s *InteractionService) CreateMCPInteraction(httpCtx context.Context, req m
 	if req.ToolArguments != nil {
 		builder = builder.SetToolArguments(req.ToolArguments)
 	}
+	offloaded := false
 	if req.ToolResult != nil {
-		builder = builder.SetToolResult(req.ToolResult)
+		var err error
+		offloaded, err = storeMCPToolResult(httpCtx, builder.Mutation(), toolResultBlobKey(req.SessionID, interactionID), req.ToolResult)
+		if err != nil {
+			return nil, err
+		}
 	}
 	if req.AvailableTools != nil {
 		builder = builder.SetAvailableTools(req.AvailableTools)
@@ -147,12 +160,33 @@ func (s *InteractionService) CreateMCPInteraction(httpCtx context.Context, req m
 
 	interaction, err := builder.Save(ctx)
 	if err != nil {
+		if offloaded {
+			s.deleteOffloadedToolResult(req.SessionID, interactionID)
+		}
 		return nil, fmt.Errorf("failed to create MCP interaction: %w", err)
 	}
+	interaction.ToolResult, interaction.ToolResultZstd, interaction.ToolResultBytes = req.ToolResult, nil, nil
+	interaction.ToolResultBlob = nil
+
+	queueRedactionSuspects(ctx, s.client, interaction)
 
 	return interaction, nil
 }
 
+// deleteOffloadedToolResult removes the blob of an interaction that failed
+// to save. Best effort: a leftover blob is unreferenced, not harmful.
+func (s *InteractionService) deleteOffloadedToolResult(sessionID, interactionID string) {
+	store, err := blobStore()
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := store.Delete(ctx, toolResultBlobKey(sessionID, interactionID)); err != nil {
+		slog.Warn("Failed to delete offloaded tool result", "interaction_id", interactionID, "error", err)
+	}
+}
+
 // GetLLMInteractionsList retrieves interaction metadata for list view
 func (s *InteractionService) GetLLMInteractionsList(ctx context.Context, sessionID string) ([]*ent.LLMInteraction, error) {
 	interactions, err := s.client.LLMInteraction.Query().
@@ -162,6 +196,9 @@ func (s *InteractionService) GetLLMInteractionsList(ctx context.Context, session
 	if err != nil {
 		return nil, fmt.Errorf("failed to get LLM interactions: %w", err)
 	}
+	if err := decompressLLMInteractions(interactions...); err != nil {
+		return nil, err
+	}
 
 	return interactions, nil
 }
@@ -175,11 +212,15 @@ func (s *InteractionService) GetLLMInteractionDetail(ctx context.Context, intera
 		}
 		return nil, fmt.Errorf("failed to get LLM interaction: %w", err)
 	}
+	if err := decompressLLMInteractions(interaction); err != nil {
+		return nil, err
+	}
 
 	return interaction, nil
 }
 
-// GetMCPInteractionsList retrieves interaction metadata for list view
+// GetMCPInteractionsList retrieves interaction metadata for list view.
+// Tool results offloaded to the blob store are not loaded (ToolResult is nil).
 func (s *InteractionService) GetMCPInteractionsList(ctx context.Context, sessionID string) ([]*ent.MCPInteraction, error) {
 	interactions, err := s.client.MCPInteraction.Query().
 		Where(mcpinteraction.SessionIDEQ(sessionID)).
@@ -188,6 +229,9 @@ func (s *InteractionService) GetMCPInteractionsList(ctx context.Context, session
 	if err != nil {
 		return nil, fmt.Errorf("failed to get MCP interactions: %w", err)
 	}
+	if err := decompressMCPInteractions(interactions...); err != nil {
+		return nil, err
+	}
 
 	return interactions, nil
 }
@@ -201,10 +245,49 @@ func (s *InteractionService) GetMCPInteractionDetail(ctx context.Context, intera
 		}
 		return nil, fmt.Errorf("failed to get MCP interaction: %w", err)
 	}
+	if err := decompressMCPInteractions(interaction); err != nil {
+		return nil, err
+	}
+	if err := loadOffloadedToolResults(ctx, interaction); err != nil {
+		return nil, err
+	}
 
 	return interaction, nil
 }
 
+// GetExecutionContextTrace loads the LLM interactions (oldest first) and the
+// conversation messages (by sequence) of one agent execution. Returns
+// ErrNotFound if the execution does not belong to the session.
+func (s *InteractionService) GetExecutionContextTrace(ctx context.Context, sessionID, executionID string) ([]*ent.LLMInteraction, []*ent.Message, error) {
+	exists, err := s.client.AgentExecution.Query().
+		Where(agentexecution.IDEQ(executionID), agentexecution.SessionIDEQ(sessionID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check execution: %w", err)
+	}
+	if !exists {
+		return nil, nil, ErrNotFound
+	}
+
+	interactions, err := s.client.LLMInteraction.Query().
+		Where(llminteraction.ExecutionIDEQ(executionID)).
+		Order(ent.Asc(llminteraction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get LLM interactions: %w", err)
+	}
+	if err := decompressLLMInteractions(interactions...); err != nil {
+		return nil, nil, err
+	}
+
+	messages, err := s.messageService.GetExecutionMessages(ctx, executionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return interactions, messages, nil
+}
+
 // ReconstructConversation rebuilds the conversation from messages.
 // Uses the messages_count stored in llm_request metadata to compute a sequence
 // range, so that only messages belonging to this specific LLM interaction are
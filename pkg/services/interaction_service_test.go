@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/codeready-toolchain/tarsy/ent"
@@ -11,6 +12,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/pkg/config"
 	"github.com/codeready-toolchain/tarsy/pkg/cost"
 	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/codeready-toolchain/tarsy/pkg/requestid"
 	testdb "github.com/codeready-toolchain/tarsy/test/database"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -79,6 +81,23 @@ func TestInteractionService_CreateLLMInteraction(t *testing.T) {
 		assert.Equal(t, req.ModelName, interaction.ModelName)
 		assert.Equal(t, thinking, *interaction.ThinkingContent)
 		assert.Equal(t, inputTokens, *interaction.InputTokens)
+		assert.Nil(t, interaction.RequestID)
+	})
+
+	t.Run("records request ID from context", func(t *testing.T) {
+		reqCtx := requestid.WithContext(ctx, "req-llm-1")
+		interaction, err := interactionService.CreateLLMInteraction(reqCtx, models.CreateLLMInteractionRequest{
+			SessionID:       session.ID,
+			StageID:         &stg.ID,
+			ExecutionID:     &exec.ID,
+			InteractionType: "iteration",
+			ModelName:       "gemini-2.0-flash",
+			LLMRequest:      map[string]any{},
+			LLMResponse:     map[string]any{},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, interaction.RequestID)
+		assert.Equal(t, "req-llm-1", *interaction.RequestID)
 	})
 }
 
@@ -338,6 +357,48 @@ func TestInteractionService_CreateMCPInteraction(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, interaction.AvailableTools)
 	})
+
+	t.Run("records request ID from context", func(t *testing.T) {
+		reqCtx := requestid.WithContext(ctx, "req-mcp-1")
+		interaction, err := interactionService.CreateMCPInteraction(reqCtx, models.CreateMCPInteractionRequest{
+			SessionID:       session.ID,
+			StageID:         stg.ID,
+			ExecutionID:     exec.ID,
+			InteractionType: "tool_list",
+			ServerName:      "kubernetes",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, interaction.RequestID)
+		assert.Equal(t, "req-mcp-1", *interaction.RequestID)
+	})
+
+	t.Run("offloads large tool results to the blob store", func(t *testing.T) {
+		store := withBlobStore(t, 1024)
+		result := map[string]any{"content": strings.Repeat("pod-1 Running\n", 200)}
+
+		interaction, err := interactionService.CreateMCPInteraction(ctx, models.CreateMCPInteractionRequest{
+			SessionID:       session.ID,
+			StageID:         stg.ID,
+			ExecutionID:     exec.ID,
+			InteractionType: "tool_call",
+			ServerName:      "kubernetes-server",
+			ToolResult:      result,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, result, interaction.ToolResult)
+		assert.Nil(t, interaction.ToolResultBlob)
+
+		row, err := client.MCPInteraction.Get(ctx, interaction.ID)
+		require.NoError(t, err)
+		assert.Nil(t, row.ToolResult)
+		require.NotNil(t, row.ToolResultBlob)
+		_, err = store.Get(ctx, *row.ToolResultBlob)
+		require.NoError(t, err)
+
+		detail, err := interactionService.GetMCPInteractionDetail(ctx, interaction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, result, detail.ToolResult)
+	})
 }
 
 func TestInteractionService_GetInteractionsList(t *testing.T) {
@@ -410,6 +471,18 @@ func TestInteractionService_GetInteractionsList(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, interactions, 1)
 	})
+
+	t.Run("retrieves execution context trace", func(t *testing.T) {
+		interactions, messages, err := interactionService.GetExecutionContextTrace(ctx, session.ID, exec.ID)
+		require.NoError(t, err)
+		assert.Len(t, interactions, 1)
+		assert.Empty(t, messages)
+	})
+
+	t.Run("execution context trace rejects foreign session", func(t *testing.T) {
+		_, _, err := interactionService.GetExecutionContextTrace(ctx, uuid.New().String(), exec.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
 }
 
 func TestInteractionService_GetInteractionDetail(t *testing.T) {
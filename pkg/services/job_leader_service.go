@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/jobleader"
+)
+
+// JobLeaderService records which pod leads each singleton background job.
+// Leadership itself is decided by PostgreSQL advisory locks (see
+// pkg/coordination); these rows only make it visible.
+type JobLeaderService struct {
+	client *ent.Client
+}
+
+// NewJobLeaderService creates a new JobLeaderService.
+func NewJobLeaderService(client *ent.Client) *JobLeaderService {
+	return &JobLeaderService{client: client}
+}
+
+// Claim records podID as the leader of job, replacing any previous leader.
+func (s *JobLeaderService) Claim(ctx context.Context, job, podID string) error {
+	now := time.Now()
+	err := s.client.JobLeader.UpdateOneID(job).
+		SetPodID(podID).
+		SetAcquiredAt(now).
+		SetRenewedAt(now).
+		Exec(ctx)
+	if err == nil {
+		return nil
+	}
+	if !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to update job leader: %w", err)
+	}
+
+	err = s.client.JobLeader.Create().
+		SetID(job).
+		SetPodID(podID).
+		SetAcquiredAt(now).
+		SetRenewedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create job leader: %w", err)
+	}
+	return nil
+}
+
+// Renew refreshes renewed_at for job if podID is still recorded as its leader.
+func (s *JobLeaderService) Renew(ctx context.Context, job, podID string) error {
+	_, err := s.client.JobLeader.Update().
+		Where(jobleader.IDEQ(job), jobleader.PodIDEQ(podID)).
+		SetRenewedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to renew job leader: %w", err)
+	}
+	return nil
+}
+
+// Release removes the leader row for job if podID still holds it.
+func (s *JobLeaderService) Release(ctx context.Context, job, podID string) error {
+	_, err := s.client.JobLeader.Delete().
+		Where(jobleader.IDEQ(job), jobleader.PodIDEQ(podID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to release job leader: %w", err)
+	}
+	return nil
+}
+
+// ListLeaders returns all recorded job leaders ordered by job name.
+func (s *JobLeaderService) ListLeaders(ctx context.Context) ([]*ent.JobLeader, error) {
+	leaders, err := s.client.JobLeader.Query().
+		Order(ent.Asc(jobleader.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list job leaders: %w", err)
+	}
+	return leaders, nil
+}
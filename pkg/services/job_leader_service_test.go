@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobLeaderService(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := NewJobLeaderService(client.Client)
+	ctx := context.Background()
+
+	t.Run("claim creates then takes over", func(t *testing.T) {
+		require.NoError(t, service.Claim(ctx, "retention-cleanup", "pod-a"))
+		require.NoError(t, service.Claim(ctx, "retention-cleanup", "pod-b"))
+
+		leaders, err := service.ListLeaders(ctx)
+		require.NoError(t, err)
+		require.Len(t, leaders, 1)
+		assert.Equal(t, "pod-b", leaders[0].PodID)
+	})
+
+	t.Run("renew and release only affect the recorded leader", func(t *testing.T) {
+		before, err := service.ListLeaders(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, service.Renew(ctx, "retention-cleanup", "pod-a"))
+		after, err := service.ListLeaders(ctx)
+		require.NoError(t, err)
+		assert.True(t, after[0].RenewedAt.Equal(before[0].RenewedAt))
+
+		require.NoError(t, service.Release(ctx, "retention-cleanup", "pod-a"))
+		leaders, err := service.ListLeaders(ctx)
+		require.NoError(t, err)
+		assert.Len(t, leaders, 1)
+
+		require.NoError(t, service.Release(ctx, "retention-cleanup", "pod-b"))
+		leaders, err = service.ListLeaders(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, leaders)
+	})
+}
@@ -3,7 +3,6 @@ package services
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/message"
@@ -82,8 +81,7 @@ func (s *MessageService) CreateMessage(ctx context.Context, req models.CreateMes
 		SetExecutionID(req.ExecutionID).
 		SetSequenceNumber(req.SequenceNumber).
 		SetRole(req.Role).
-		SetContent(req.Content).
-		SetCreatedAt(time.Now())
+		SetContent(req.Content)
 
 	// Tool-related fields
 	if len(req.ToolCalls) > 0 {
@@ -103,6 +101,9 @@ func (s *MessageService) CreateMessage(ctx context.Context, req models.CreateMes
 	if req.ToolName != "" {
 		builder = builder.SetToolName(req.ToolName)
 	}
+	if len(req.Images) > 0 {
+		builder = builder.SetImages(req.Images)
+	}
 
 	msg, err := builder.Save(ctx)
 	if err != nil {
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+)
+
+// defaultCompressionBatchSize is how many rows are loaded per page.
+const defaultCompressionBatchSize = 200
+
+// CompressionOptions controls a compression backfill run.
+type CompressionOptions struct {
+	// DryRun counts the rows that would be compressed without writing.
+	DryRun bool
+	// BatchSize is the number of rows loaded per page (0 = default).
+	BatchSize int
+}
+
+// CompressionResult reports rows compressed (or that would be, for a dry
+// run) per "table.column", and the stored payload size before and after.
+type CompressionResult struct {
+	DryRun      bool           `json:"dry_run"`
+	Rows        map[string]int `json:"rows_compressed"`
+	BytesBefore int64          `json:"bytes_before"`
+	BytesAfter  int64          `json:"bytes_after"`
+}
+
+// TotalRows returns the number of compressed rows across all columns.
+func (r *CompressionResult) TotalRows() int {
+	total := 0
+	for _, n := range r.Rows {
+		total += n
+	}
+	return total
+}
+
+// Columns returns the keys of Rows in sorted order.
+func (r *CompressionResult) Columns() []string {
+	cols := make([]string, 0, len(r.Rows))
+	for col := range r.Rows {
+		cols = append(cols, col)
+	}
+	sort.Strings(cols)
+	return cols
+}
+
+// PayloadCompressionService compresses timeline contents and interaction
+// payloads written before at-rest compression was enabled, using the same
+// threshold as new writes (see SetCompression). Used by the "tarsy compress"
+// admin command. Rows are committed one page at a time, so an interrupted
+// run can simply be restarted: already-compressed rows are not selected.
+// Streaming timeline events are skipped because their content still changes.
+type PayloadCompressionService struct {
+	client *ent.Client
+}
+
+// NewPayloadCompressionService creates a new PayloadCompressionService.
+func NewPayloadCompressionService(client *ent.Client) *PayloadCompressionService {
+	return &PayloadCompressionService{client: client}
+}
+
+// Run compresses every eligible row. Returns an error when compression of new
+// writes is disabled, since the threshold would be undefined.
+func (s *PayloadCompressionService) Run(ctx context.Context, opts CompressionOptions) (*CompressionResult, error) {
+	threshold := compressionThreshold.Load()
+	if threshold <= 0 {
+		return nil, fmt.Errorf("compression is disabled (system.compression.enabled: false)")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultCompressionBatchSize
+	}
+
+	result := &CompressionResult{DryRun: opts.DryRun, Rows: map[string]int{}}
+	if err := s.compressTimelineEvents(ctx, opts, threshold, result); err != nil {
+		return result, err
+	}
+	if err := s.compressLLMInteractions(ctx, opts, threshold, result); err != nil {
+		return result, err
+	}
+	if err := s.compressMCPInteractions(ctx, opts, threshold, result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// sizeAtLeast selects rows whose stored column text is at least n bytes.
+// JSON columns are measured by their text rendering, which can differ
+// slightly from the encoding compressed; rows that end up below the
+// threshold are left unchanged.
+func sizeAtLeast(column string, n int64) func(*sql.Selector) {
+	return func(sel *sql.Selector) {
+		sel.Where(sql.ExprP(fmt.Sprintf("octet_length(%s::text) >= %d", sel.C(column), n)))
+	}
+}
+
+func (s *PayloadCompressionService) compressTimelineEvents(ctx context.Context, opts CompressionOptions, threshold int64, result *CompressionResult) error {
+	lastID := ""
+	for {
+		events, err := s.client.TimelineEvent.Query().
+			Where(
+				timelineevent.IDGT(lastID),
+				timelineevent.ContentZstdIsNil(),
+				timelineevent.StatusNEQ(timelineevent.StatusStreaming),
+				sizeAtLeast(timelineevent.FieldContent, threshold),
+			).
+			Order(ent.Asc(timelineevent.FieldID)).
+			Limit(opts.BatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load timeline events: %w", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		err = s.inTx(ctx, opts.DryRun, func(client *ent.Client) error {
+			for _, ev := range events {
+				update := updateTimelineContent(
+					client.TimelineEvent.UpdateOneID(ev.ID).Where(timelineevent.ContentZstdIsNil()), ev.Content)
+				packed, ok := update.Mutation().ContentZstd()
+				if !ok {
+					continue
+				}
+				if !opts.DryRun {
+					if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+						return fmt.Errorf("failed to compress timeline event %s: %w", ev.ID, err)
+					}
+				}
+				result.Rows["timeline_events.content"]++
+				result.BytesBefore += int64(len(ev.Content))
+				result.BytesAfter += int64(len(packed))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		lastID = events[len(events)-1].ID
+	}
+}
+
+func (s *PayloadCompressionService) compressLLMInteractions(ctx context.Context, opts CompressionOptions, threshold int64, result *CompressionResult) error {
+	lastID := ""
+	for {
+		interactions, err := s.client.LLMInteraction.Query().
+			Where(
+				llminteraction.IDGT(lastID),
+				llminteraction.PayloadZstdIsNil(),
+				func(sel *sql.Selector) {
+					sel.Where(sql.ExprP(fmt.Sprintf("octet_length(%s::text) + octet_length(%s::text) >= %d",
+						sel.C(llminteraction.FieldLlmRequest), sel.C(llminteraction.FieldLlmResponse), threshold)))
+				},
+			).
+			Order(ent.Asc(llminteraction.FieldID)).
+			Limit(opts.BatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load LLM interactions: %w", err)
+		}
+		if len(interactions) == 0 {
+			return nil
+		}
+
+		err = s.inTx(ctx, opts.DryRun, func(client *ent.Client) error {
+			for _, li := range interactions {
+				update := client.LLMInteraction.UpdateOneID(li.ID).Where(llminteraction.PayloadZstdIsNil())
+				if err := setLLMPayload(update.Mutation(), li.LlmRequest, li.LlmResponse); err != nil {
+					return fmt.Errorf("LLM interaction %s: %w", li.ID, err)
+				}
+				packed, ok := update.Mutation().PayloadZstd()
+				if !ok {
+					continue
+				}
+				if !opts.DryRun {
+					if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+						return fmt.Errorf("failed to compress LLM interaction %s: %w", li.ID, err)
+					}
+				}
+				before, _ := json.Marshal(llmPayload{Request: li.LlmRequest, Response: li.LlmResponse})
+				result.Rows["llm_interactions.payload"]++
+				result.BytesBefore += int64(len(before))
+				result.BytesAfter += int64(len(packed))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		lastID = interactions[len(interactions)-1].ID
+	}
+}
+
+func (s *PayloadCompressionService) compressMCPInteractions(ctx context.Context, opts CompressionOptions, threshold int64, result *CompressionResult) error {
+	lastID := ""
+	for {
+		interactions, err := s.client.MCPInteraction.Query().
+			Where(
+				mcpinteraction.IDGT(lastID),
+				mcpinteraction.ToolResultZstdIsNil(),
+				mcpinteraction.ToolResultNotNil(),
+				sizeAtLeast(mcpinteraction.FieldToolResult, threshold),
+			).
+			Order(ent.Asc(mcpinteraction.FieldID)).
+			Limit(opts.BatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load MCP interactions: %w", err)
+		}
+		if len(interactions) == 0 {
+			return nil
+		}
+
+		err = s.inTx(ctx, opts.DryRun, func(client *ent.Client) error {
+			for _, mi := range interactions {
+				update := client.MCPInteraction.UpdateOneID(mi.ID).Where(mcpinteraction.ToolResultZstdIsNil())
+				if err := setMCPToolResult(update.Mutation(), mi.ToolResult); err != nil {
+					return fmt.Errorf("MCP interaction %s: %w", mi.ID, err)
+				}
+				packed, ok := update.Mutation().ToolResultZstd()
+				if !ok {
+					continue
+				}
+				if !opts.DryRun {
+					if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+						return fmt.Errorf("failed to compress MCP interaction %s: %w", mi.ID, err)
+					}
+				}
+				before, _ := update.Mutation().ToolResultBytes()
+				result.Rows["mcp_interactions.tool_result"]++
+				result.BytesBefore += int64(before)
+				result.BytesAfter += int64(len(packed))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		lastID = interactions[len(interactions)-1].ID
+	}
+}
+
+// inTx runs fn with a transactional client, committing on success. Dry runs
+// pass the plain client since fn builds updates without executing them.
+func (s *PayloadCompressionService) inTx(ctx context.Context, dryRun bool, fn func(client *ent.Client) error) error {
+	if dryRun {
+		return fn(s.client)
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx.Client()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadCompressionService_Run(t *testing.T) {
+	svc, client, event := setupTerminalEventFixture(t)
+	ctx := context.Background()
+	large := strings.Repeat("Events: Back-off restarting failed container\n", 100)
+
+	// Written while compression was disabled.
+	withCompression(t, &config.CompressionConfig{Enabled: false})
+	require.NoError(t, svc.CompleteTimelineEvent(ctx, event.ID, large, nil, nil))
+	interactionService := NewInteractionService(client.Client, NewMessageService(client.Client), nil)
+	toolName := "get_pods"
+	mi, err := interactionService.CreateMCPInteraction(ctx, models.CreateMCPInteractionRequest{
+		SessionID:       event.SessionID,
+		StageID:         *event.StageID,
+		ExecutionID:     *event.ExecutionID,
+		InteractionType: "tool_call",
+		ServerName:      "kubernetes",
+		ToolName:        &toolName,
+		ToolResult:      map[string]any{"content": large},
+	})
+	require.NoError(t, err)
+
+	compressor := NewPayloadCompressionService(client.Client)
+
+	t.Run("disabled compression is an error", func(t *testing.T) {
+		_, err := compressor.Run(ctx, CompressionOptions{})
+		require.Error(t, err)
+	})
+
+	SetCompression(&config.CompressionConfig{Enabled: true, ThresholdBytes: 1024})
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		result, err := compressor.Run(ctx, CompressionOptions{DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Rows["timeline_events.content"])
+		assert.Equal(t, 1, result.Rows["mcp_interactions.tool_result"])
+		assert.Less(t, result.BytesAfter, result.BytesBefore)
+
+		stored, err := client.TimelineEvent.Get(ctx, event.ID)
+		require.NoError(t, err)
+		assert.Nil(t, stored.ContentZstd)
+	})
+
+	t.Run("compresses existing rows", func(t *testing.T) {
+		result, err := compressor.Run(ctx, CompressionOptions{BatchSize: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.TotalRows())
+
+		stored, err := client.TimelineEvent.Get(ctx, event.ID)
+		require.NoError(t, err)
+		assert.Empty(t, stored.Content)
+		assert.NotNil(t, stored.ContentZstd)
+
+		events, err := svc.GetAgentTimeline(ctx, *event.ExecutionID)
+		require.NoError(t, err)
+		assert.Equal(t, large, events[0].Content)
+
+		detail, err := interactionService.GetMCPInteractionDetail(ctx, mi.ID)
+		require.NoError(t, err)
+		assert.Equal(t, large, detail.ToolResult["content"])
+	})
+
+	t.Run("rerun finds nothing to do", func(t *testing.T) {
+		result, err := compressor.Run(ctx, CompressionOptions{})
+		require.NoError(t, err)
+		assert.Zero(t, result.TotalRows())
+	})
+}
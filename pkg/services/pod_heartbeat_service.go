@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/podheartbeat"
+)
+
+// PodHeartbeatService records which replicas are alive and which
+// configuration fingerprint each one loaded.
+type PodHeartbeatService struct {
+	client *ent.Client
+}
+
+// NewPodHeartbeatService creates a new PodHeartbeatService.
+func NewPodHeartbeatService(client *ent.Client) *PodHeartbeatService {
+	return &PodHeartbeatService{client: client}
+}
+
+// Publish upserts the heartbeat for podID, setting last_seen_at to now.
+func (s *PodHeartbeatService) Publish(ctx context.Context, podID, configHash, version string, startedAt time.Time) error {
+	now := time.Now()
+	err := s.client.PodHeartbeat.UpdateOneID(podID).
+		SetConfigHash(configHash).
+		SetVersion(version).
+		SetStartedAt(startedAt).
+		SetLastSeenAt(now).
+		Exec(ctx)
+	if err == nil {
+		return nil
+	}
+	if !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to update pod heartbeat: %w", err)
+	}
+
+	err = s.client.PodHeartbeat.Create().
+		SetID(podID).
+		SetConfigHash(configHash).
+		SetVersion(version).
+		SetStartedAt(startedAt).
+		SetLastSeenAt(now).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create pod heartbeat: %w", err)
+	}
+	return nil
+}
+
+// ListActive returns heartbeats seen at or after since, ordered by pod ID.
+func (s *PodHeartbeatService) ListActive(ctx context.Context, since time.Time) ([]*ent.PodHeartbeat, error) {
+	pods, err := s.client.PodHeartbeat.Query().
+		Where(podheartbeat.LastSeenAtGTE(since)).
+		Order(ent.Asc(podheartbeat.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod heartbeats: %w", err)
+	}
+	return pods, nil
+}
+
+// Remove deletes the heartbeat for podID (graceful shutdown).
+func (s *PodHeartbeatService) Remove(ctx context.Context, podID string) error {
+	_, err := s.client.PodHeartbeat.Delete().
+		Where(podheartbeat.IDEQ(podID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove pod heartbeat: %w", err)
+	}
+	return nil
+}
+
+// DeleteStale removes heartbeats last seen before cutoff (crashed pods).
+// Returns the number of rows deleted.
+func (s *PodHeartbeatService) DeleteStale(ctx context.Context, cutoff time.Time) (int, error) {
+	n, err := s.client.PodHeartbeat.Delete().
+		Where(podheartbeat.LastSeenAtLT(cutoff)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale pod heartbeats: %w", err)
+	}
+	return n, nil
+}
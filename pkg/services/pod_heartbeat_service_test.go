@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPodHeartbeatService(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := NewPodHeartbeatService(client.Client)
+	ctx := context.Background()
+	started := time.Now().Add(-time.Hour)
+
+	t.Run("publish creates then updates", func(t *testing.T) {
+		require.NoError(t, service.Publish(ctx, "pod-a", "hash-1", "abc", started))
+		require.NoError(t, service.Publish(ctx, "pod-a", "hash-2", "abc", started))
+
+		pods, err := service.ListActive(ctx, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		require.Len(t, pods, 1)
+		assert.Equal(t, "pod-a", pods[0].ID)
+		assert.Equal(t, "hash-2", pods[0].ConfigHash)
+	})
+
+	t.Run("stale pods are excluded and deleted", func(t *testing.T) {
+		client.PodHeartbeat.Create().
+			SetID("pod-old").
+			SetConfigHash("hash-0").
+			SetVersion("old").
+			SetStartedAt(started).
+			SetLastSeenAt(time.Now().Add(-2 * time.Hour)).
+			SaveX(ctx)
+
+		pods, err := service.ListActive(ctx, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Len(t, pods, 1)
+
+		n, err := service.DeleteStale(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		require.NoError(t, service.Remove(ctx, "pod-a"))
+		pods, err := service.ListActive(ctx, time.Time{})
+		require.NoError(t, err)
+		assert.Empty(t, pods)
+	})
+}
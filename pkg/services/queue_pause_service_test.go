@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueuePauseService(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := NewQueuePauseService(client.Client)
+	ctx := context.Background()
+
+	t.Run("pause creates then replaces", func(t *testing.T) {
+		_, err := service.Pause(ctx, QueuePauseScopeCluster, "provider outage", true, "alice")
+		require.NoError(t, err)
+
+		pause, err := service.Pause(ctx, QueuePauseScopeCluster, "", false, "bob")
+		require.NoError(t, err)
+		assert.False(t, pause.Maintenance)
+		assert.Nil(t, pause.Reason, "reason is cleared when omitted")
+		require.NotNil(t, pause.PausedBy)
+		assert.Equal(t, "bob", *pause.PausedBy)
+
+		_, err = service.Pause(ctx, "pod-a", "draining", false, "alice")
+		require.NoError(t, err)
+
+		pauses, err := service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, pauses, 2)
+		assert.Equal(t, QueuePauseScopeCluster, pauses[0].ID)
+		assert.Equal(t, "pod-a", pauses[1].ID)
+	})
+
+	t.Run("drain marks a pod pause until it is paused again", func(t *testing.T) {
+		pause, err := service.Drain(ctx, "pod-a", "scale down", "hpa-controller")
+		require.NoError(t, err)
+		assert.True(t, pause.Drain)
+		assert.False(t, pause.Maintenance)
+
+		pause, err = service.Pause(ctx, "pod-a", "draining", false, "alice")
+		require.NoError(t, err)
+		assert.False(t, pause.Drain)
+	})
+
+	t.Run("resume reports whether a pause was removed", func(t *testing.T) {
+		removed, err := service.Resume(ctx, "pod-a")
+		require.NoError(t, err)
+		assert.True(t, removed)
+
+		removed, err = service.Resume(ctx, "pod-a")
+		require.NoError(t, err)
+		assert.False(t, removed)
+
+		pauses, err := service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, pauses, 1)
+		assert.Equal(t, QueuePauseScopeCluster, pauses[0].ID)
+	})
+}
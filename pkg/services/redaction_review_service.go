@@ -0,0 +1,849 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/chat"
+	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
+	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/message"
+	"github.com/codeready-toolchain/tarsy/ent/redactionreview"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/masking"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/google/uuid"
+)
+
+// redactedPlaceholder replaces a confirmed secret in stored content.
+const redactedPlaceholder = "[REDACTED_SECRET]"
+
+const (
+	defaultRedactionReviewLimit = 50
+	maxRedactionReviewNote      = 2000
+)
+
+// redactionDetector scans new MCP tool results for suspects. nil (the
+// default) disables the review queue.
+var redactionDetector atomic.Pointer[masking.Detector]
+
+// SetRedactionReview applies system.redaction_review to new MCP tool
+// results. Called once at startup; nil or disabled queues nothing.
+func SetRedactionReview(cfg *config.RedactionReviewConfig) {
+	if cfg == nil || !cfg.Enabled {
+		redactionDetector.Store(nil)
+		return
+	}
+	redactionDetector.Store(masking.NewDetector(cfg.MinEntropy, cfg.MaxPerInteraction))
+}
+
+// queueRedactionSuspects scans a stored (already masked) tool call result
+// and queues each suspect once per session. Best effort: failures are
+// logged and never fail the tool call.
+func queueRedactionSuspects(ctx context.Context, client *ent.Client, mi *ent.MCPInteraction) {
+	detector := redactionDetector.Load()
+	if detector == nil || mi.InteractionType != mcpinteraction.InteractionTypeToolCall {
+		return
+	}
+	content, _ := mi.ToolResult["content"].(string)
+	for _, suspect := range detector.Detect(content) {
+		err := client.RedactionReview.Create().
+			SetID(uuid.New().String()).
+			SetSessionID(mi.SessionID).
+			SetMcpInteractionID(mi.ID).
+			SetServerName(mi.ServerName).
+			SetNillableToolName(mi.ToolName).
+			SetHeuristic(suspect.Heuristic).
+			SetFingerprint(redactionFingerprint(suspect.Value)).
+			SetValue(suspect.Value).
+			SetPreview(masking.Preview(suspect.Value)).
+			SetContext(suspect.Context).
+			Exec(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			slog.Warn("Failed to queue redaction review",
+				"session_id", mi.SessionID, "interaction_id", mi.ID, "heuristic", suspect.Heuristic, "error", err)
+			return
+		}
+	}
+}
+
+func redactionFingerprint(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// RedactionReviewService manages the queue of values in MCP tool results
+// that masking let through but look like secrets. Confirming a suspect
+// redacts the value everywhere its session stored it; dismissing keeps the
+// content. Either way the raw value is dropped from the queue.
+type RedactionReviewService struct {
+	client *ent.Client
+}
+
+// NewRedactionReviewService creates a new RedactionReviewService.
+func NewRedactionReviewService(client *ent.Client) *RedactionReviewService {
+	return &RedactionReviewService{client: client}
+}
+
+// ListReviews returns reviews matching params, newest first, and the total
+// number of matches.
+func (s *RedactionReviewService) ListReviews(httpCtx context.Context, params models.RedactionReviewListParams) ([]*ent.RedactionReview, int, error) {
+	status := params.Status
+	if status == "" {
+		status = string(redactionreview.StatusPending)
+	}
+	if status != "all" && redactionreview.StatusValidator(redactionreview.Status(status)) != nil {
+		return nil, 0, NewValidationError("status", fmt.Sprintf("invalid status %q", status))
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultRedactionReviewLimit
+	}
+
+	ctx, cancel := context.WithTimeout(httpCtx, 10*time.Second)
+	defer cancel()
+
+	query := s.client.RedactionReview.Query()
+	if status != "all" {
+		query = query.Where(redactionreview.StatusEQ(redactionreview.Status(status)))
+	}
+	if params.SessionID != "" {
+		query = query.Where(redactionreview.SessionIDEQ(params.SessionID))
+	}
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count redaction reviews: %w", err)
+	}
+	reviews, err := query.
+		Order(ent.Desc(redactionreview.FieldCreatedAt)).
+		Limit(limit).
+		Offset(max(params.Offset, 0)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list redaction reviews: %w", err)
+	}
+	return reviews, total, nil
+}
+
+// GetReview returns a review by ID, or ErrNotFound.
+func (s *RedactionReviewService) GetReview(httpCtx context.Context, reviewID string) (*ent.RedactionReview, error) {
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	review, err := s.client.RedactionReview.Get(ctx, reviewID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get redaction review: %w", err)
+	}
+	return review, nil
+}
+
+// DismissReview marks a pending review as not a secret and drops the
+// stored value. Returns ErrConflict when the review is already resolved.
+func (s *RedactionReviewService) DismissReview(httpCtx context.Context, reviewID, reviewer, note string) (*ent.RedactionReview, error) {
+	if err := validateRedactionReviewNote(note); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	n, err := s.client.RedactionReview.Update().
+		Where(redactionreview.IDEQ(reviewID), redactionreview.StatusEQ(redactionreview.StatusPending)).
+		SetStatus(redactionreview.StatusDismissed).
+		ClearValue().
+		SetReviewedBy(reviewer).
+		SetReviewedAt(time.Now()).
+		SetNillableNote(nonEmpty(note)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dismiss redaction review: %w", err)
+	}
+	if n == 0 {
+		return nil, s.resolveMiss(ctx, reviewID)
+	}
+	return s.GetReview(ctx, reviewID)
+}
+
+// ConfirmReview marks a pending review as a secret and redacts its value
+// from every row of the session: alert data and summaries, MCP tool calls
+// (including offloaded results), messages, timeline events, and LLM
+// request/response payloads. Pending reviews of the same value in other
+// sessions are confirmed and redacted along with it. Each session is
+// rewritten in its own transaction. Returns ErrConflict when the review is
+// already resolved.
+func (s *RedactionReviewService) ConfirmReview(ctx context.Context, reviewID, reviewer, note string) (*ent.RedactionReview, error) {
+	if err := validateRedactionReviewNote(note); err != nil {
+		return nil, err
+	}
+	review, err := s.GetReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != redactionreview.StatusPending || review.Value == nil {
+		return nil, ErrConflict
+	}
+	value := *review.Value
+
+	same, err := s.client.RedactionReview.Query().
+		Where(
+			redactionreview.FingerprintEQ(review.Fingerprint),
+			redactionreview.StatusEQ(redactionreview.StatusPending),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load matching redaction reviews: %w", err)
+	}
+	for _, r := range same {
+		if err := s.confirmInSession(ctx, r, value, reviewer, note); err != nil {
+			return nil, fmt.Errorf("session %s: %w", r.SessionID, err)
+		}
+	}
+	return s.GetReview(ctx, reviewID)
+}
+
+// confirmInSession redacts value from the review's session and resolves the
+// review in the same transaction.
+func (s *RedactionReviewService) confirmInSession(ctx context.Context, review *ent.RedactionReview, value, reviewer, note string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	r := &sessionRedactor{tx: tx, sessionID: review.SessionID, value: value, rows: map[string]int{}}
+	for _, step := range []func(context.Context) error{
+		r.redactAlertSession,
+		r.redactMCPInteractions,
+		r.redactMessages,
+		r.redactTimelineEvents,
+		r.redactLLMInteractions,
+		r.redactActionItems,
+		r.redactComparisons,
+		r.redactHandoffNoteRevisions,
+		r.redactChatUserMessages,
+		r.redactMemories,
+		r.redactSessionGroup,
+		r.redactAgentExecutions,
+		r.redactActivityReports,
+	} {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+
+	n, err := tx.RedactionReview.Update().
+		Where(redactionreview.IDEQ(review.ID), redactionreview.StatusEQ(redactionreview.StatusPending)).
+		SetStatus(redactionreview.StatusConfirmed).
+		ClearValue().
+		SetReviewedBy(reviewer).
+		SetReviewedAt(time.Now()).
+		SetNillableNote(nonEmpty(note)).
+		SetRowsRedacted(r.rows).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to confirm redaction review: %w", err)
+	}
+	if n == 0 {
+		return nil // resolved concurrently; keep whichever decision landed first
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	slog.Info("Redacted confirmed secret",
+		"review_id", review.ID, "session_id", review.SessionID, "heuristic", review.Heuristic, "rows", r.rows)
+	return nil
+}
+
+// resolveMiss explains why a pending-only update matched nothing.
+func (s *RedactionReviewService) resolveMiss(ctx context.Context, reviewID string) error {
+	exists, err := s.client.RedactionReview.Query().Where(redactionreview.IDEQ(reviewID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get redaction review: %w", err)
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return ErrConflict
+}
+
+func validateRedactionReviewNote(note string) error {
+	if len(note) > maxRedactionReviewNote {
+		return NewValidationError("note", fmt.Sprintf("must be at most %d bytes", maxRedactionReviewNote))
+	}
+	return nil
+}
+
+func nonEmpty(s string) *string {
+	if s = strings.TrimSpace(s); s == "" {
+		return nil
+	}
+	return &s
+}
+
+// sessionRedactor replaces one value with redactedPlaceholder across a
+// session's rows, counting modified rows by "table.column".
+type sessionRedactor struct {
+	tx        *ent.Tx
+	sessionID string
+	value     string
+	rows      map[string]int
+}
+
+func (r *sessionRedactor) redact(s string) (string, bool) {
+	if !strings.Contains(s, r.value) {
+		return s, false
+	}
+	return strings.ReplaceAll(s, r.value, redactedPlaceholder), true
+}
+
+// redactJSON redacts every string inside a decoded JSON value.
+func (r *sessionRedactor) redactJSON(v any) (any, bool) {
+	switch v := v.(type) {
+	case string:
+		return r.redact(v)
+	case map[string]any:
+		changed := false
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			redacted, ok := r.redactJSON(item)
+			out[k] = redacted
+			changed = changed || ok
+		}
+		return out, changed
+	case []any:
+		changed := false
+		out := make([]any, len(v))
+		for i, item := range v {
+			redacted, ok := r.redactJSON(item)
+			out[i] = redacted
+			changed = changed || ok
+		}
+		return out, changed
+	default:
+		return v, false
+	}
+}
+
+func (r *sessionRedactor) redactMap(m map[string]any) (map[string]any, bool) {
+	if m == nil {
+		return nil, false
+	}
+	redacted, changed := r.redactJSON(m)
+	return redacted.(map[string]any), changed
+}
+
+func (r *sessionRedactor) redactAlertSession(ctx context.Context) error {
+	session, err := r.tx.AlertSession.Query().
+		Where(alertsession.IDEQ(r.sessionID)).
+		Select(alertsession.FieldAlertData, alertsession.FieldFinalAnalysis, alertsession.FieldExecutiveSummary, alertsession.FieldTechnicalSummary, alertsession.FieldHandoffNotes).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+
+	update := r.tx.AlertSession.UpdateOneID(r.sessionID)
+	changed := false
+	if redacted, ok := r.redact(session.AlertData); ok {
+		update.SetAlertData(redacted)
+		r.rows["alert_sessions.alert_data"]++
+		changed = true
+	}
+	if session.FinalAnalysis != nil {
+		if redacted, ok := r.redact(*session.FinalAnalysis); ok {
+			update.SetFinalAnalysis(redacted)
+			r.rows["alert_sessions.final_analysis"]++
+			changed = true
+		}
+	}
+	if session.ExecutiveSummary != nil {
+		if redacted, ok := r.redact(*session.ExecutiveSummary); ok {
+			update.SetExecutiveSummary(redacted)
+			r.rows["alert_sessions.executive_summary"]++
+			changed = true
+		}
+	}
+	if session.TechnicalSummary != nil {
+		if redacted, ok := r.redact(*session.TechnicalSummary); ok {
+			update.SetTechnicalSummary(redacted)
+			r.rows["alert_sessions.technical_summary"]++
+			changed = true
+		}
+	}
+	if session.HandoffNotes != nil {
+		if redacted, ok := r.redact(*session.HandoffNotes); ok {
+			update.SetHandoffNotes(redacted)
+			r.rows["alert_sessions.handoff_notes"]++
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to redact session: %w", err)
+	}
+	return nil
+}
+
+func (r *sessionRedactor) redactMCPInteractions(ctx context.Context) error {
+	interactions, err := r.tx.MCPInteraction.Query().
+		Where(
+			mcpinteraction.SessionIDEQ(r.sessionID),
+			mcpinteraction.InteractionTypeEQ(mcpinteraction.InteractionTypeToolCall),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load MCP interactions: %w", err)
+	}
+	if err := decompressMCPInteractions(interactions...); err != nil {
+		return err
+	}
+	offloaded := make(map[string]string)
+	for _, mi := range interactions {
+		if mi.ToolResultBlob != nil {
+			offloaded[mi.ID] = *mi.ToolResultBlob
+		}
+	}
+	if err := loadOffloadedToolResults(ctx, interactions...); err != nil {
+		return err
+	}
+
+	for _, mi := range interactions {
+		update := r.tx.MCPInteraction.UpdateOneID(mi.ID)
+		changed := false
+		if args, ok := r.redactMap(mi.ToolArguments); ok {
+			update.SetToolArguments(args)
+			r.rows["mcp_interactions.tool_arguments"]++
+			changed = true
+		}
+		if result, ok := r.redactMap(mi.ToolResult); ok {
+			if key, ok := offloaded[mi.ID]; ok {
+				// Overwrite the blob in place so the secret is not left behind.
+				if err := replaceOffloadedToolResult(ctx, update.Mutation(), key, result); err != nil {
+					return fmt.Errorf("MCP interaction %s: %w", mi.ID, err)
+				}
+			} else if err := setMCPToolResult(update.Mutation(), result); err != nil {
+				return err
+			}
+			r.rows["mcp_interactions.tool_result"]++
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact MCP interaction %s: %w", mi.ID, err)
+		}
+	}
+	return nil
+}
+
+func (r *sessionRedactor) redactMessages(ctx context.Context) error {
+	messages, err := r.tx.Message.Query().
+		Where(message.SessionIDEQ(r.sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load messages: %w", err)
+	}
+
+	for _, msg := range messages {
+		update := r.tx.Message.UpdateOneID(msg.ID)
+		changed := false
+		if redacted, ok := r.redact(msg.Content); ok {
+			update.SetContent(redacted)
+			r.rows["messages.content"]++
+			changed = true
+		}
+		callsChanged := false
+		for i, call := range msg.ToolCalls {
+			if redacted, ok := r.redact(call.Arguments); ok {
+				msg.ToolCalls[i].Arguments = redacted
+				callsChanged = true
+			}
+		}
+		if callsChanged {
+			update.SetToolCalls(msg.ToolCalls)
+			r.rows["messages.tool_calls"]++
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact message %s: %w", msg.ID, err)
+		}
+	}
+	return nil
+}
+
+func (r *sessionRedactor) redactTimelineEvents(ctx context.Context) error {
+	events, err := r.tx.TimelineEvent.Query().
+		Where(timelineevent.SessionIDEQ(r.sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load timeline events: %w", err)
+	}
+	if err := DecompressTimelineEvents(events...); err != nil {
+		return err
+	}
+
+	for _, ev := range events {
+		update := r.tx.TimelineEvent.UpdateOneID(ev.ID)
+		changed := false
+		if redacted, ok := r.redact(ev.Content); ok {
+			update = updateTimelineContent(update, redacted)
+			r.rows["timeline_events.content"]++
+			changed = true
+		}
+		if metadata, ok := r.redactMap(ev.Metadata); ok {
+			update.SetMetadata(metadata)
+			r.rows["timeline_events.metadata"]++
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact timeline event %s: %w", ev.ID, err)
+		}
+	}
+	return nil
+}
+
+func (r *sessionRedactor) redactLLMInteractions(ctx context.Context) error {
+	interactions, err := r.tx.LLMInteraction.Query().
+		Where(llminteraction.SessionIDEQ(r.sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load LLM interactions: %w", err)
+	}
+	if err := decompressLLMInteractions(interactions...); err != nil {
+		return err
+	}
+
+	for _, li := range interactions {
+		update := r.tx.LLMInteraction.UpdateOneID(li.ID)
+		changed := false
+		request, reqChanged := r.redactMap(li.LlmRequest)
+		response, respChanged := r.redactMap(li.LlmResponse)
+		if reqChanged || respChanged {
+			if err := setLLMPayload(update.Mutation(), request, response); err != nil {
+				return err
+			}
+			r.rows["llm_interactions.payload"]++
+			changed = true
+		}
+		if li.ThinkingContent != nil {
+			if redacted, ok := r.redact(*li.ThinkingContent); ok {
+				update.SetThinkingContent(redacted)
+				r.rows["llm_interactions.thinking_content"]++
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact LLM interaction %s: %w", li.ID, err)
+		}
+	}
+	return nil
+}
+
+func (r *sessionRedactor) redactActionItems(ctx context.Context) error {
+	items, err := r.tx.ActionItem.Query().
+		Where(actionitem.SessionIDEQ(r.sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load action items: %w", err)
+	}
+
+	for _, item := range items {
+		update := r.tx.ActionItem.UpdateOneID(item.ID)
+		changed := false
+		if redacted, ok := r.redact(item.Title); ok {
+			update.SetTitle(redacted)
+			r.rows["action_items.title"]++
+			changed = true
+		}
+		if item.Details != nil {
+			if redacted, ok := r.redact(*item.Details); ok {
+				update.SetDetails(redacted)
+				r.rows["action_items.details"]++
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact action item %s: %w", item.ID, err)
+		}
+	}
+	return nil
+}
+
+// redactComparisons covers recurrence comparisons on either side: the
+// session's own comparison and those of later sessions compared with it,
+// since both quote the session's conclusion.
+func (r *sessionRedactor) redactComparisons(ctx context.Context) error {
+	comparisons, err := r.tx.SessionComparison.Query().
+		Where(sessioncomparison.Or(
+			sessioncomparison.SessionIDEQ(r.sessionID),
+			sessioncomparison.PreviousSessionIDEQ(r.sessionID),
+		)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load session comparisons: %w", err)
+	}
+
+	for _, cmp := range comparisons {
+		update := r.tx.SessionComparison.UpdateOneID(cmp.ID)
+		changed := false
+		if cmp.PreviousConclusion != nil {
+			if redacted, ok := r.redact(*cmp.PreviousConclusion); ok {
+				update.SetPreviousConclusion(redacted)
+				r.rows["session_comparisons.previous_conclusion"]++
+				changed = true
+			}
+		}
+		if cmp.CurrentConclusion != nil {
+			if redacted, ok := r.redact(*cmp.CurrentConclusion); ok {
+				update.SetCurrentConclusion(redacted)
+				r.rows["session_comparisons.current_conclusion"]++
+				changed = true
+			}
+		}
+		if cmp.ErrorMessage != nil {
+			if redacted, ok := r.redact(*cmp.ErrorMessage); ok {
+				update.SetErrorMessage(redacted)
+				r.rows["session_comparisons.error_message"]++
+				changed = true
+			}
+		}
+		differencesChanged := false
+		for i, d := range cmp.Differences {
+			if redacted, ok := r.redact(d); ok {
+				cmp.Differences[i] = redacted
+				differencesChanged = true
+			}
+		}
+		if differencesChanged {
+			update.SetDifferences(cmp.Differences)
+			r.rows["session_comparisons.differences"]++
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact session comparison %s: %w", cmp.ID, err)
+		}
+	}
+	return nil
+}
+
+func (r *sessionRedactor) redactHandoffNoteRevisions(ctx context.Context) error {
+	revisions, err := r.tx.HandoffNoteRevision.Query().
+		Where(handoffnoterevision.SessionIDEQ(r.sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load handoff note revisions: %w", err)
+	}
+
+	for _, rev := range revisions {
+		redacted, ok := r.redact(rev.Content)
+		if !ok {
+			continue
+		}
+		// content is immutable in the schema; bypass via a raw SET.
+		err := r.tx.HandoffNoteRevision.UpdateOneID(rev.ID).
+			Modify(func(u *sql.UpdateBuilder) { u.Set(handoffnoterevision.FieldContent, redacted) }).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to redact handoff note revision %s: %w", rev.ID, err)
+		}
+		r.rows["handoff_note_revisions.content"]++
+	}
+	return nil
+}
+
+func (r *sessionRedactor) redactChatUserMessages(ctx context.Context) error {
+	messages, err := r.tx.ChatUserMessage.Query().
+		Where(chatusermessage.HasChatWith(chat.SessionIDEQ(r.sessionID))).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load chat messages: %w", err)
+	}
+
+	for _, msg := range messages {
+		redacted, ok := r.redact(msg.Content)
+		if !ok {
+			continue
+		}
+		if err := r.tx.ChatUserMessage.UpdateOneID(msg.ID).SetContent(redacted).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact chat message %s: %w", msg.ID, err)
+		}
+		r.rows["chat_user_messages.content"]++
+	}
+	return nil
+}
+
+// redactMemories covers memories extracted from the session, which may
+// quote its findings.
+func (r *sessionRedactor) redactMemories(ctx context.Context) error {
+	memories, err := r.tx.InvestigationMemory.Query().
+		Where(investigationmemory.SourceSessionIDEQ(r.sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load investigation memories: %w", err)
+	}
+
+	for _, mem := range memories {
+		redacted, ok := r.redact(mem.Content)
+		if !ok {
+			continue
+		}
+		if err := r.tx.InvestigationMemory.UpdateOneID(mem.ID).SetContent(redacted).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact investigation memory %s: %w", mem.ID, err)
+		}
+		r.rows["investigation_memories.content"]++
+	}
+	return nil
+}
+
+// redactSessionGroup covers the fan-out synthesis of the session's group,
+// which consolidates the findings of every sibling.
+func (r *sessionRedactor) redactSessionGroup(ctx context.Context) error {
+	session, err := r.tx.AlertSession.Query().
+		Where(alertsession.IDEQ(r.sessionID)).
+		Select(alertsession.FieldGroupID).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	if session.GroupID == nil {
+		return nil
+	}
+	group, err := r.tx.SessionGroup.Get(ctx, *session.GroupID)
+	if err != nil {
+		return fmt.Errorf("failed to load session group: %w", err)
+	}
+
+	update := r.tx.SessionGroup.UpdateOneID(group.ID)
+	changed := false
+	if group.Summary != nil {
+		if redacted, ok := r.redact(*group.Summary); ok {
+			update.SetSummary(redacted)
+			r.rows["session_groups.summary"]++
+			changed = true
+		}
+	}
+	if group.SummaryError != nil {
+		if redacted, ok := r.redact(*group.SummaryError); ok {
+			update.SetSummaryError(redacted)
+			r.rows["session_groups.summary_error"]++
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to redact session group %s: %w", group.ID, err)
+	}
+	return nil
+}
+
+func (r *sessionRedactor) redactAgentExecutions(ctx context.Context) error {
+	executions, err := r.tx.AgentExecution.Query().
+		Where(agentexecution.SessionIDEQ(r.sessionID), agentexecution.TaskNotNil()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load agent executions: %w", err)
+	}
+
+	for _, exec := range executions {
+		redacted, ok := r.redact(*exec.Task)
+		if !ok {
+			continue
+		}
+		if err := r.tx.AgentExecution.UpdateOneID(exec.ID).SetTask(redacted).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact agent execution %s: %w", exec.ID, err)
+		}
+		r.rows["agent_executions.task"]++
+	}
+	return nil
+}
+
+// redactActivityReports covers the reports whose window includes the
+// session: their narrative is written from the executive summaries of
+// notable sessions, which the stats also keep.
+func (r *sessionRedactor) redactActivityReports(ctx context.Context) error {
+	session, err := r.tx.AlertSession.Query().
+		Where(alertsession.IDEQ(r.sessionID)).
+		Select(alertsession.FieldCreatedAt).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	reports, err := r.tx.ActivityReport.Query().
+		Where(
+			activityreport.WindowStartLTE(session.CreatedAt),
+			activityreport.WindowEndGT(session.CreatedAt),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load activity reports: %w", err)
+	}
+
+	for _, report := range reports {
+		update := r.tx.ActivityReport.UpdateOneID(report.ID)
+		changed := false
+		if report.Narrative != nil {
+			if redacted, ok := r.redact(*report.Narrative); ok {
+				update.SetNarrative(redacted)
+				r.rows["activity_reports.narrative"]++
+				changed = true
+			}
+		}
+		notableChanged := false
+		for i, notable := range report.Stats.NotableSessions {
+			if redacted, ok := r.redact(notable.ExecutiveSummary); ok {
+				report.Stats.NotableSessions[i].ExecutiveSummary = redacted
+				notableChanged = true
+			}
+		}
+		if notableChanged {
+			update.SetStats(report.Stats)
+			r.rows["activity_reports.stats"]++
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to redact activity report %s: %w", report.ID, err)
+		}
+	}
+	return nil
+}
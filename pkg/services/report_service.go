@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/blobstore"
+	"github.com/google/uuid"
+)
+
+// ReportService persists and reads periodic activity reports.
+type ReportService struct {
+	client *ent.Client
+}
+
+// NewReportService creates a new ReportService.
+func NewReportService(client *ent.Client) *ReportService {
+	return &ReportService{client: client}
+}
+
+// CreateReport stores the stats for a report window. Returns ErrAlreadyExists
+// when a report for the same period and window start already exists (e.g.
+// another replica generated it first).
+func (s *ReportService) CreateReport(
+	ctx context.Context,
+	period activityreport.Period,
+	windowStart, windowEnd time.Time,
+	stats schema.ActivityReportStats,
+) (*ent.ActivityReport, error) {
+	if err := activityreport.PeriodValidator(period); err != nil {
+		return nil, NewValidationError("period", err.Error())
+	}
+	if !windowEnd.After(windowStart) {
+		return nil, NewValidationError("window_end", "must be after window_start")
+	}
+
+	report, err := s.client.ActivityReport.Create().
+		SetID(uuid.New().String()).
+		SetPeriod(period).
+		SetWindowStart(windowStart).
+		SetWindowEnd(windowEnd).
+		SetStats(stats).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create activity report: %w", err)
+	}
+	return report, nil
+}
+
+// SetNarrative records the LLM narrative for a report, or the error that
+// prevented generating one.
+func (s *ReportService) SetNarrative(ctx context.Context, reportID, narrative, narrativeErr string) (*ent.ActivityReport, error) {
+	update := s.client.ActivityReport.UpdateOneID(reportID)
+	if narrative != "" {
+		update.SetNarrative(narrative)
+	}
+	if narrativeErr != "" {
+		update.SetNarrativeError(narrativeErr)
+	}
+	report, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to update activity report narrative: %w", err)
+	}
+	return report, nil
+}
+
+// StoreRenderedReport keeps the rendered HTML of a report in the blob store.
+func (s *ReportService) StoreRenderedReport(ctx context.Context, reportID string, html []byte) error {
+	store, err := blobStore()
+	if err != nil {
+		return err
+	}
+	if err := store.Put(ctx, reportBlobKey(reportID), html, "text/html; charset=utf-8"); err != nil {
+		return fmt.Errorf("failed to store rendered report: %w", err)
+	}
+	return nil
+}
+
+// GetRenderedReport returns the rendered HTML of a report. Returns
+// ErrNotFound if the report does not exist or was not rendered (e.g. it was
+// generated before the blob store was introduced).
+func (s *ReportService) GetRenderedReport(ctx context.Context, reportID string) ([]byte, error) {
+	if _, err := s.GetReport(ctx, reportID); err != nil {
+		return nil, err
+	}
+	store, err := blobStore()
+	if err != nil {
+		return nil, err
+	}
+	html, err := store.Get(ctx, reportBlobKey(reportID))
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rendered report: %w", err)
+	}
+	return html, nil
+}
+
+// ReportExists reports whether a report for the period and window start exists.
+func (s *ReportService) ReportExists(ctx context.Context, period activityreport.Period, windowStart time.Time) (bool, error) {
+	exists, err := s.client.ActivityReport.Query().
+		Where(
+			activityreport.PeriodEQ(period),
+			activityreport.WindowStartEQ(windowStart),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check activity report: %w", err)
+	}
+	return exists, nil
+}
+
+// GetReport returns a report by ID. Returns ErrNotFound if it does not exist.
+func (s *ReportService) GetReport(ctx context.Context, reportID string) (*ent.ActivityReport, error) {
+	report, err := s.client.ActivityReport.Get(ctx, reportID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get activity report: %w", err)
+	}
+	return report, nil
+}
+
+// ListReports returns the most recent reports (newest window first),
+// optionally filtered by period.
+func (s *ReportService) ListReports(ctx context.Context, period string, limit int) ([]*ent.ActivityReport, error) {
+	query := s.client.ActivityReport.Query()
+	if period != "" {
+		p := activityreport.Period(period)
+		if err := activityreport.PeriodValidator(p); err != nil {
+			return nil, NewValidationError("period", err.Error())
+		}
+		query = query.Where(activityreport.PeriodEQ(p))
+	}
+
+	reports, err := query.
+		Order(ent.Desc(activityreport.FieldWindowStart), ent.Desc(activityreport.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list activity reports: %w", err)
+	}
+	return reports, nil
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/activityreport"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportService(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := NewReportService(client.Client)
+	ctx := context.Background()
+
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	stats := schema.ActivityReportStats{
+		TotalSessions: 4,
+		StatusCounts:  map[string]int{"completed": 4},
+	}
+
+	t.Run("create and get", func(t *testing.T) {
+		report, err := service.CreateReport(ctx, activityreport.PeriodDaily, day, day.AddDate(0, 0, 1), stats)
+		require.NoError(t, err)
+		assert.Nil(t, report.Narrative)
+
+		got, err := service.GetReport(ctx, report.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 4, got.Stats.TotalSessions)
+		assert.True(t, got.WindowStart.Equal(day))
+	})
+
+	t.Run("duplicate window returns ErrAlreadyExists", func(t *testing.T) {
+		_, err := service.CreateReport(ctx, activityreport.PeriodDaily, day, day.AddDate(0, 0, 1), stats)
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+
+		exists, err := service.ReportExists(ctx, activityreport.PeriodDaily, day)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = service.ReportExists(ctx, activityreport.PeriodWeekly, day)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("invalid window", func(t *testing.T) {
+		_, err := service.CreateReport(ctx, activityreport.PeriodDaily, day, day, stats)
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("set narrative", func(t *testing.T) {
+		report, err := service.CreateReport(ctx, activityreport.PeriodWeekly, day, day.AddDate(0, 0, 7), stats)
+		require.NoError(t, err)
+
+		updated, err := service.SetNarrative(ctx, report.ID, "A quiet week.", "")
+		require.NoError(t, err)
+		require.NotNil(t, updated.Narrative)
+		assert.Equal(t, "A quiet week.", *updated.Narrative)
+		assert.Nil(t, updated.NarrativeError)
+
+		_, err = service.SetNarrative(ctx, "missing", "x", "")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("list newest first with period filter", func(t *testing.T) {
+		_, err := service.CreateReport(ctx, activityreport.PeriodDaily, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2), stats)
+		require.NoError(t, err)
+
+		all, err := service.ListReports(ctx, "", 10)
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+
+		daily, err := service.ListReports(ctx, "daily", 10)
+		require.NoError(t, err)
+		require.Len(t, daily, 2)
+		assert.True(t, daily[0].WindowStart.After(daily[1].WindowStart))
+
+		_, err = service.ListReports(ctx, "monthly", 10)
+		assert.True(t, IsValidationError(err))
+
+		_, err = service.GetReport(ctx, "missing")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/savedview"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/google/uuid"
+)
+
+const (
+	maxSavedViewNameLength = 100
+	maxSavedViewsPerOwner  = 50
+	maxSavedViewWindow     = 90 * 24 * time.Hour
+)
+
+// SavedViewService manages users' named session filters and their
+// notification subscriptions. All reads and writes are scoped to the owner.
+type SavedViewService struct {
+	client *ent.Client
+}
+
+// NewSavedViewService creates a new SavedViewService.
+func NewSavedViewService(client *ent.Client) *SavedViewService {
+	return &SavedViewService{client: client}
+}
+
+// CreateView stores a new saved view for owner. Returns ErrAlreadyExists when
+// the owner already has a view with the same name.
+func (s *SavedViewService) CreateView(httpCtx context.Context, owner string, req models.CreateSavedViewRequest) (*ent.SavedView, error) {
+	if owner == "" {
+		return nil, NewValidationError("owner", "required")
+	}
+	name, err := validateSavedViewName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSavedViewFilter(req.Filter); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	count, err := s.client.SavedView.Query().Where(savedview.OwnerEQ(owner)).Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count saved views: %w", err)
+	}
+	if count >= maxSavedViewsPerOwner {
+		return nil, NewValidationError("name", fmt.Sprintf("at most %d saved views allowed", maxSavedViewsPerOwner))
+	}
+
+	create := s.client.SavedView.Create().
+		SetID(uuid.New().String()).
+		SetOwner(owner).
+		SetName(name).
+		SetFilter(req.Filter).
+		SetNotify(req.Notify)
+	if ch := strings.TrimSpace(req.SlackChannel); ch != "" {
+		create.SetSlackChannel(ch)
+	}
+	view, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create saved view: %w", err)
+	}
+	return view, nil
+}
+
+// ListViews returns the owner's saved views ordered by name.
+func (s *SavedViewService) ListViews(httpCtx context.Context, owner string) ([]*ent.SavedView, error) {
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	views, err := s.client.SavedView.Query().
+		Where(savedview.OwnerEQ(owner)).
+		Order(ent.Asc(savedview.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved views: %w", err)
+	}
+	return views, nil
+}
+
+// GetView returns one of the owner's saved views, or ErrNotFound.
+func (s *SavedViewService) GetView(httpCtx context.Context, owner, viewID string) (*ent.SavedView, error) {
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	view, err := s.client.SavedView.Query().
+		Where(savedview.IDEQ(viewID), savedview.OwnerEQ(owner)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+	return view, nil
+}
+
+// UpdateView applies a partial update to one of the owner's saved views.
+func (s *SavedViewService) UpdateView(httpCtx context.Context, owner, viewID string, req models.UpdateSavedViewRequest) (*ent.SavedView, error) {
+	var name string
+	if req.Name != nil {
+		var err error
+		if name, err = validateSavedViewName(*req.Name); err != nil {
+			return nil, err
+		}
+	}
+	if req.Filter != nil {
+		if err := validateSavedViewFilter(*req.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	view, err := s.GetView(httpCtx, owner, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	update := view.Update()
+	if req.Name != nil {
+		update.SetName(name)
+	}
+	if req.Filter != nil {
+		update.SetFilter(*req.Filter)
+	}
+	if req.Notify != nil {
+		update.SetNotify(*req.Notify)
+	}
+	if req.SlackChannel != nil {
+		if ch := strings.TrimSpace(*req.SlackChannel); ch != "" {
+			update.SetSlackChannel(ch)
+		} else {
+			update.ClearSlackChannel()
+		}
+	}
+	view, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to update saved view: %w", err)
+	}
+	return view, nil
+}
+
+// DeleteView removes one of the owner's saved views.
+func (s *SavedViewService) DeleteView(httpCtx context.Context, owner, viewID string) error {
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	n, err := s.client.SavedView.Delete().
+		Where(savedview.IDEQ(viewID), savedview.OwnerEQ(owner)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved view: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListSubscribedViews returns every view (across all owners) that has a
+// WebSocket or Slack subscription. Used by the match notifier on session
+// status transitions.
+func (s *SavedViewService) ListSubscribedViews(ctx context.Context) ([]*ent.SavedView, error) {
+	views, err := s.client.SavedView.Query().
+		Where(savedview.Or(
+			savedview.NotifyEQ(true),
+			savedview.SlackChannelNotNil(),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscribed saved views: %w", err)
+	}
+	return views, nil
+}
+
+func validateSavedViewName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" {
+		return "", NewValidationError("name", "required")
+	}
+	if utf8.RuneCountInString(name) > maxSavedViewNameLength {
+		return "", NewValidationError("name", fmt.Sprintf("must be at most %d characters", maxSavedViewNameLength))
+	}
+	return name, nil
+}
+
+func validateSavedViewFilter(f schema.SavedViewFilter) error {
+	if f.Status != "" {
+		for _, st := range strings.Split(f.Status, ",") {
+			if err := alertsession.StatusValidator(alertsession.Status(st)); err != nil {
+				return NewValidationError("filter.status", fmt.Sprintf("invalid status %q", st))
+			}
+		}
+	}
+	if len(f.Search) > maxFilterValueLength {
+		return NewValidationError("filter.search", fmt.Sprintf("must be at most %d characters", maxFilterValueLength))
+	}
+	if f.CreatedWithin != "" {
+		d, err := time.ParseDuration(f.CreatedWithin)
+		if err != nil || d <= 0 || d > maxSavedViewWindow {
+			return NewValidationError("filter.created_within", "must be a positive duration of at most 2160h (90 days)")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavedViewService(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := NewSavedViewService(client.Client)
+	ctx := context.Background()
+
+	failedKube := schema.SavedViewFilter{Status: "failed", ChainID: "k8s", CreatedWithin: "24h"}
+
+	t.Run("create, list, get are owner-scoped", func(t *testing.T) {
+		view, err := service.CreateView(ctx, "alice", models.CreateSavedViewRequest{
+			Name:   "  failed kube chains last 24h ",
+			Filter: failedKube,
+			Notify: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "failed kube chains last 24h", view.Name)
+		assert.Equal(t, failedKube, view.Filter)
+		assert.Nil(t, view.SlackChannel)
+
+		views, err := service.ListViews(ctx, "alice")
+		require.NoError(t, err)
+		assert.Len(t, views, 1)
+
+		views, err = service.ListViews(ctx, "bob")
+		require.NoError(t, err)
+		assert.Empty(t, views)
+
+		_, err = service.GetView(ctx, "bob", view.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("duplicate name per owner", func(t *testing.T) {
+		_, err := service.CreateView(ctx, "alice", models.CreateSavedViewRequest{Name: "failed kube chains last 24h"})
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+
+		_, err = service.CreateView(ctx, "bob", models.CreateSavedViewRequest{Name: "failed kube chains last 24h"})
+		assert.NoError(t, err, "names are unique per owner only")
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  models.CreateSavedViewRequest
+		}{
+			{name: "empty name", req: models.CreateSavedViewRequest{Name: " "}},
+			{name: "invalid status", req: models.CreateSavedViewRequest{Name: "x", Filter: schema.SavedViewFilter{Status: "failed,bogus"}}},
+			{name: "invalid window", req: models.CreateSavedViewRequest{Name: "x", Filter: schema.SavedViewFilter{CreatedWithin: "yesterday"}}},
+			{name: "window too long", req: models.CreateSavedViewRequest{Name: "x", Filter: schema.SavedViewFilter{CreatedWithin: "2161h"}}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := service.CreateView(ctx, "carol", tt.req)
+				assert.True(t, IsValidationError(err))
+			})
+		}
+	})
+
+	t.Run("update and subscriptions", func(t *testing.T) {
+		view, err := service.CreateView(ctx, "dave", models.CreateSavedViewRequest{Name: "network"})
+		require.NoError(t, err)
+
+		channel := "C123"
+		updated, err := service.UpdateView(ctx, "dave", view.ID, models.UpdateSavedViewRequest{SlackChannel: &channel})
+		require.NoError(t, err)
+		require.NotNil(t, updated.SlackChannel)
+		assert.Equal(t, "C123", *updated.SlackChannel)
+		assert.Equal(t, "network", updated.Name)
+
+		subscribed, err := service.ListSubscribedViews(ctx)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(subscribed))
+		for _, v := range subscribed {
+			ids = append(ids, v.ID)
+		}
+		assert.Contains(t, ids, view.ID)
+
+		empty := ""
+		updated, err = service.UpdateView(ctx, "dave", view.ID, models.UpdateSavedViewRequest{SlackChannel: &empty})
+		require.NoError(t, err)
+		assert.Nil(t, updated.SlackChannel)
+
+		_, err = service.UpdateView(ctx, "alice", view.ID, models.UpdateSavedViewRequest{SlackChannel: &channel})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		view, err := service.CreateView(ctx, "erin", models.CreateSavedViewRequest{Name: "tmp"})
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, service.DeleteView(ctx, "frank", view.ID), ErrNotFound)
+		require.NoError(t, service.DeleteView(ctx, "erin", view.ID))
+		assert.ErrorIs(t, service.DeleteView(ctx, "erin", view.ID), ErrNotFound)
+	})
+}
@@ -0,0 +1,659 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/chat"
+	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
+	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/message"
+	"github.com/codeready-toolchain/tarsy/ent/sessionreviewactivity"
+	"github.com/codeready-toolchain/tarsy/ent/sessionscore"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+)
+
+// anonymizedPrefix marks pseudonymized author values so reruns skip them.
+const anonymizedPrefix = "anon-"
+
+// defaultBackfillBatchSize is how many sessions are loaded per page.
+const defaultBackfillBatchSize = 100
+
+// ContentMasker re-applies data masking to stored content.
+// Implemented by masking.Service.
+type ContentMasker interface {
+	MaskAlertData(data string) string
+	MaskToolResult(content string, serverID string) string
+}
+
+// BackfillOptions controls a historical session rewrite.
+type BackfillOptions struct {
+	// DryRun computes what would change without writing anything.
+	DryRun bool
+	// AnonymizeAuthors replaces author/actor fields with pseudonyms.
+	AnonymizeAuthors bool
+	// Since limits the rewrite to sessions created at or after it (zero = all).
+	Since time.Time
+	// BatchSize is the number of sessions loaded per page (0 = default).
+	BatchSize int
+}
+
+// BackfillResult reports how many rows a rewrite modified (or would modify,
+// for a dry run), keyed by "table.column".
+type BackfillResult struct {
+	DryRun          bool           `json:"dry_run"`
+	SessionsScanned int            `json:"sessions_scanned"`
+	SessionsChanged int            `json:"sessions_changed"`
+	Rows            map[string]int `json:"rows_modified"`
+}
+
+// TotalRows returns the number of modified rows across all columns.
+func (r *BackfillResult) TotalRows() int {
+	total := 0
+	for _, n := range r.Rows {
+		total += n
+	}
+	return total
+}
+
+// Columns returns the keys of Rows in sorted order.
+func (r *BackfillResult) Columns() []string {
+	cols := make([]string, 0, len(r.Rows))
+	for col := range r.Rows {
+		cols = append(cols, col)
+	}
+	sort.Strings(cols)
+	return cols
+}
+
+// SessionBackfillService rewrites historical sessions through the current
+// masking configuration and optionally pseudonymizes author fields. Used by
+// the "tarsy remask" admin command when a secret pattern is added after the
+// fact. Each session is rewritten in its own transaction, so an interrupted
+// run can simply be restarted: already-masked content is left unchanged.
+type SessionBackfillService struct {
+	client *ent.Client
+	masker ContentMasker
+}
+
+// NewSessionBackfillService creates a new SessionBackfillService.
+func NewSessionBackfillService(client *ent.Client, masker ContentMasker) *SessionBackfillService {
+	return &SessionBackfillService{client: client, masker: masker}
+}
+
+// Run rewrites every session matching opts, including soft-deleted ones.
+func (s *SessionBackfillService) Run(ctx context.Context, opts BackfillOptions) (*BackfillResult, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBackfillBatchSize
+	}
+
+	var anon *pseudonymizer
+	if opts.AnonymizeAuthors {
+		var err error
+		if anon, err = newPseudonymizer(); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &BackfillResult{DryRun: opts.DryRun, Rows: map[string]int{}}
+	lastID := ""
+	for {
+		query := s.client.AlertSession.Query().
+			Where(alertsession.IDGT(lastID)).
+			Order(ent.Asc(alertsession.FieldID)).
+			Limit(batchSize)
+		if !opts.Since.IsZero() {
+			query = query.Where(alertsession.CreatedAtGTE(opts.Since))
+		}
+		sessionIDs, err := query.IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+		if len(sessionIDs) == 0 {
+			return result, nil
+		}
+
+		for _, id := range sessionIDs {
+			rows, err := s.backfillSession(ctx, id, anon, opts.DryRun)
+			if err != nil {
+				return result, fmt.Errorf("session %s: %w", id, err)
+			}
+			result.SessionsScanned++
+			if len(rows) > 0 {
+				result.SessionsChanged++
+			}
+			for col, n := range rows {
+				result.Rows[col] += n
+			}
+		}
+		lastID = sessionIDs[len(sessionIDs)-1]
+	}
+}
+
+// backfillSession rewrites one session in a transaction and returns the
+// modified row counts. Dry runs roll the transaction back.
+func (s *SessionBackfillService) backfillSession(ctx context.Context, sessionID string, anon *pseudonymizer, dryRun bool) (map[string]int, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows := map[string]int{}
+	steps := []func(context.Context, *ent.Tx, string, map[string]int) error{
+		s.remaskAlertData,
+		s.remaskTechnicalSummary,
+		s.remaskActionItems,
+		s.remaskMCPInteractions,
+		s.remaskToolMessages,
+		s.remaskToolCallEvents,
+	}
+	if anon != nil {
+		steps = append(steps, anon.anonymizeSession)
+	}
+	for _, step := range steps {
+		if err := step(ctx, tx, sessionID, rows); err != nil {
+			return nil, err
+		}
+	}
+
+	if dryRun || len(rows) == 0 {
+		return rows, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return rows, nil
+}
+
+func (s *SessionBackfillService) remaskAlertData(ctx context.Context, tx *ent.Tx, sessionID string, rows map[string]int) error {
+	session, err := tx.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID)).
+		Select(alertsession.FieldAlertData).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+
+	masked := s.masker.MaskAlertData(session.AlertData)
+	if masked == session.AlertData {
+		return nil
+	}
+	if err := tx.AlertSession.UpdateOneID(sessionID).SetAlertData(masked).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update alert data: %w", err)
+	}
+	rows["alert_sessions.alert_data"]++
+	return nil
+}
+
+// remaskTechnicalSummary masks the technical summary with the alert data
+// patterns: it is written for a wider audience and may quote alert content.
+func (s *SessionBackfillService) remaskTechnicalSummary(ctx context.Context, tx *ent.Tx, sessionID string, rows map[string]int) error {
+	session, err := tx.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID)).
+		Select(alertsession.FieldTechnicalSummary).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	if session.TechnicalSummary == nil {
+		return nil
+	}
+
+	masked := s.masker.MaskAlertData(*session.TechnicalSummary)
+	if masked == *session.TechnicalSummary {
+		return nil
+	}
+	if err := tx.AlertSession.UpdateOneID(sessionID).SetTechnicalSummary(masked).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update technical summary: %w", err)
+	}
+	rows["alert_sessions.technical_summary"]++
+	return nil
+}
+
+// remaskActionItems masks action items with the alert data patterns: they
+// are tracked outside the session and may quote the alert.
+func (s *SessionBackfillService) remaskActionItems(ctx context.Context, tx *ent.Tx, sessionID string, rows map[string]int) error {
+	items, err := tx.ActionItem.Query().Where(actionitem.SessionIDEQ(sessionID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load action items: %w", err)
+	}
+
+	for _, item := range items {
+		update := tx.ActionItem.UpdateOneID(item.ID)
+		changed := false
+		if masked := s.masker.MaskAlertData(item.Title); masked != item.Title {
+			update.SetTitle(masked)
+			rows["action_items.title"]++
+			changed = true
+		}
+		if item.Details != nil {
+			if masked := s.masker.MaskAlertData(*item.Details); masked != *item.Details {
+				update.SetDetails(masked)
+				rows["action_items.details"]++
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update action item %s: %w", item.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *SessionBackfillService) remaskMCPInteractions(ctx context.Context, tx *ent.Tx, sessionID string, rows map[string]int) error {
+	interactions, err := tx.MCPInteraction.Query().
+		Where(
+			mcpinteraction.SessionIDEQ(sessionID),
+			mcpinteraction.InteractionTypeEQ(mcpinteraction.InteractionTypeToolCall),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load MCP interactions: %w", err)
+	}
+	if err := decompressMCPInteractions(interactions...); err != nil {
+		return err
+	}
+	offloaded := make(map[string]string)
+	for _, mi := range interactions {
+		if mi.ToolResultBlob != nil {
+			offloaded[mi.ID] = *mi.ToolResultBlob
+		}
+	}
+	if err := loadOffloadedToolResults(ctx, interactions...); err != nil {
+		return err
+	}
+
+	for _, mi := range interactions {
+		content, ok := mi.ToolResult["content"].(string)
+		if !ok {
+			continue
+		}
+		masked := s.masker.MaskToolResult(content, mi.ServerName)
+		if masked == content {
+			continue
+		}
+		result := make(map[string]any, len(mi.ToolResult))
+		for k, v := range mi.ToolResult {
+			result[k] = v
+		}
+		result["content"] = masked
+		update := tx.MCPInteraction.UpdateOneID(mi.ID)
+		if key, ok := offloaded[mi.ID]; ok {
+			// Overwrite the blob in place so the unmasked result is not
+			// left behind, even if the result would now fit in the row.
+			if err := replaceOffloadedToolResult(ctx, update.Mutation(), key, result); err != nil {
+				return fmt.Errorf("MCP interaction %s: %w", mi.ID, err)
+			}
+		} else if err := setMCPToolResult(update.Mutation(), result); err != nil {
+			return err
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update MCP interaction %s: %w", mi.ID, err)
+		}
+		rows["mcp_interactions.tool_result"]++
+	}
+	return nil
+}
+
+func (s *SessionBackfillService) remaskToolMessages(ctx context.Context, tx *ent.Tx, sessionID string, rows map[string]int) error {
+	messages, err := tx.Message.Query().
+		Where(message.SessionIDEQ(sessionID), message.RoleEQ(message.RoleTool)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load tool messages: %w", err)
+	}
+
+	for _, msg := range messages {
+		if msg.ToolName == nil {
+			continue
+		}
+		serverID, ok := toolServerID(*msg.ToolName)
+		if !ok {
+			continue
+		}
+		masked := s.masker.MaskToolResult(msg.Content, serverID)
+		if masked == msg.Content {
+			continue
+		}
+		if err := tx.Message.UpdateOneID(msg.ID).SetContent(masked).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update message %s: %w", msg.ID, err)
+		}
+		rows["messages.content"]++
+	}
+	return nil
+}
+
+func (s *SessionBackfillService) remaskToolCallEvents(ctx context.Context, tx *ent.Tx, sessionID string, rows map[string]int) error {
+	events, err := tx.TimelineEvent.Query().
+		Where(
+			timelineevent.SessionIDEQ(sessionID),
+			timelineevent.EventTypeEQ(timelineevent.EventTypeLlmToolCall),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load tool call events: %w", err)
+	}
+	if err := DecompressTimelineEvents(events...); err != nil {
+		return err
+	}
+
+	for _, ev := range events {
+		serverID, _ := ev.Metadata["server_name"].(string)
+		if serverID == "" || ev.Content == "" {
+			continue
+		}
+		masked := s.masker.MaskToolResult(ev.Content, serverID)
+		if masked == ev.Content {
+			continue
+		}
+		if err := updateTimelineContent(tx.TimelineEvent.UpdateOneID(ev.ID), masked).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update timeline event %s: %w", ev.ID, err)
+		}
+		rows["timeline_events.content"]++
+	}
+	return nil
+}
+
+// toolServerID extracts the MCP server ID from a stored tool name
+// ("server.tool" or the function-calling form "server__tool").
+// Returns false for tools without a server prefix (orchestration tools).
+func toolServerID(name string) (string, bool) {
+	if !strings.Contains(name, ".") {
+		name = strings.Replace(name, "__", ".", 1)
+	}
+	serverID, _, ok := strings.Cut(name, ".")
+	return serverID, ok && serverID != ""
+}
+
+// pseudonymizer maps author values to stable, irreversible pseudonyms for
+// the duration of one run (HMAC with a random per-run key), so the same
+// person maps to the same pseudonym across sessions and tables.
+type pseudonymizer struct {
+	key []byte
+}
+
+func newPseudonymizer() (*pseudonymizer, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate anonymization key: %w", err)
+	}
+	return &pseudonymizer{key: key}, nil
+}
+
+// pseudonym returns the replacement for value and whether it changes.
+// Empty and already-anonymized values are kept.
+func (p *pseudonymizer) pseudonym(value string) (string, bool) {
+	if value == "" || strings.HasPrefix(value, anonymizedPrefix) {
+		return value, false
+	}
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(value))
+	return anonymizedPrefix + hex.EncodeToString(mac.Sum(nil))[:12], true
+}
+
+// anonymizeSession pseudonymizes the session author (email and OIDC
+// subject), assignee, canceller and on-call contact, handoff note editors,
+// chat creators, editors and message authors, review actors, action item
+// assignees and editors, memory creators and editors, and score triggers.
+func (p *pseudonymizer) anonymizeSession(ctx context.Context, tx *ent.Tx, sessionID string, rows map[string]int) error {
+	session, err := tx.AlertSession.Get(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to load session: %w", err)
+	}
+	update := tx.AlertSession.UpdateOneID(sessionID)
+	changed := false
+	if session.Author != nil {
+		if anon, ok := p.pseudonym(*session.Author); ok {
+			update.SetAuthor(anon)
+			rows["alert_sessions.author"]++
+			changed = true
+		}
+	}
+	if session.AuthorSubject != nil {
+		if anon, ok := p.pseudonym(*session.AuthorSubject); ok {
+			update.SetAuthorSubject(anon)
+			rows["alert_sessions.author_subject"]++
+			changed = true
+		}
+	}
+	if session.Assignee != nil {
+		if anon, ok := p.pseudonym(*session.Assignee); ok {
+			update.SetAssignee(anon)
+			rows["alert_sessions.assignee"]++
+			changed = true
+		}
+	}
+	if session.CancelledBy != nil {
+		if anon, ok := p.pseudonym(*session.CancelledBy); ok {
+			update.SetCancelledBy(anon)
+			rows["alert_sessions.cancelled_by"]++
+			changed = true
+		}
+	}
+	if session.HandoffNotesUpdatedBy != nil {
+		if anon, ok := p.pseudonym(*session.HandoffNotesUpdatedBy); ok {
+			update.SetHandoffNotesUpdatedBy(anon)
+			rows["alert_sessions.handoff_notes_updated_by"]++
+			changed = true
+		}
+	}
+	if session.OnCall != nil {
+		onCall := *session.OnCall
+		nameAnon, nameOK := p.pseudonym(onCall.Name)
+		emailAnon, emailOK := p.pseudonym(onCall.Email)
+		if nameOK || emailOK {
+			onCall.Name, onCall.Email = nameAnon, emailAnon
+			update.SetOnCall(&onCall)
+			rows["alert_sessions.on_call"]++
+			changed = true
+		}
+	}
+	if changed {
+		if err := update.Exec(ctx); err != nil {
+			return fmt.Errorf("failed to anonymize session: %w", err)
+		}
+	}
+
+	chats, err := tx.Chat.Query().Where(chat.SessionIDEQ(sessionID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load chats: %w", err)
+	}
+	for _, c := range chats {
+		chatUpdate := tx.Chat.UpdateOneID(c.ID)
+		chatChanged := false
+		if c.CreatedBy != nil {
+			if anon, ok := p.pseudonym(*c.CreatedBy); ok {
+				chatUpdate.SetCreatedBy(anon)
+				rows["chats.created_by"]++
+				chatChanged = true
+			}
+		}
+		if c.UpdatedBy != nil {
+			if anon, ok := p.pseudonym(*c.UpdatedBy); ok {
+				chatUpdate.SetUpdatedBy(anon)
+				rows["chats.updated_by"]++
+				chatChanged = true
+			}
+		}
+		if chatChanged {
+			if err := chatUpdate.Exec(ctx); err != nil {
+				return fmt.Errorf("failed to anonymize chat %s: %w", c.ID, err)
+			}
+		}
+
+		msgs, err := tx.ChatUserMessage.Query().Where(chatusermessage.ChatIDEQ(c.ID)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load chat messages: %w", err)
+		}
+		for _, m := range msgs {
+			msgUpdate := tx.ChatUserMessage.UpdateOneID(m.ID)
+			msgChanged := false
+			if anon, ok := p.pseudonym(m.Author); ok {
+				// author is immutable in the schema; bypass via a raw SET.
+				msgUpdate.Modify(func(u *sql.UpdateBuilder) { u.Set(chatusermessage.FieldAuthor, anon) })
+				rows["chat_user_messages.author"]++
+				msgChanged = true
+			}
+			if m.AuthorSubject != nil {
+				if anon, ok := p.pseudonym(*m.AuthorSubject); ok {
+					msgUpdate.SetAuthorSubject(anon)
+					rows["chat_user_messages.author_subject"]++
+					msgChanged = true
+				}
+			}
+			if msgChanged {
+				if err := msgUpdate.Exec(ctx); err != nil {
+					return fmt.Errorf("failed to anonymize chat message %s: %w", m.ID, err)
+				}
+			}
+		}
+	}
+
+	activities, err := tx.SessionReviewActivity.Query().
+		Where(sessionreviewactivity.SessionIDEQ(sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load review activity: %w", err)
+	}
+	for _, a := range activities {
+		anon, ok := p.pseudonym(a.Actor)
+		if !ok {
+			continue
+		}
+		// actor is immutable in the schema; bypass via a raw SET.
+		err := tx.SessionReviewActivity.UpdateOneID(a.ID).
+			Modify(func(u *sql.UpdateBuilder) { u.Set(sessionreviewactivity.FieldActor, anon) }).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to anonymize review activity %s: %w", a.ID, err)
+		}
+		rows["session_review_activities.actor"]++
+	}
+
+	revisions, err := tx.HandoffNoteRevision.Query().
+		Where(handoffnoterevision.SessionIDEQ(sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load handoff note revisions: %w", err)
+	}
+	for _, rev := range revisions {
+		anon, ok := p.pseudonym(rev.Author)
+		if !ok {
+			continue
+		}
+		// author is immutable in the schema; bypass via a raw SET.
+		err := tx.HandoffNoteRevision.UpdateOneID(rev.ID).
+			Modify(func(u *sql.UpdateBuilder) { u.Set(handoffnoterevision.FieldAuthor, anon) }).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to anonymize handoff note revision %s: %w", rev.ID, err)
+		}
+		rows["handoff_note_revisions.author"]++
+	}
+
+	items, err := tx.ActionItem.Query().Where(actionitem.SessionIDEQ(sessionID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load action items: %w", err)
+	}
+	for _, item := range items {
+		itemUpdate := tx.ActionItem.UpdateOneID(item.ID)
+		itemChanged := false
+		if item.Assignee != nil {
+			if anon, ok := p.pseudonym(*item.Assignee); ok {
+				itemUpdate.SetAssignee(anon)
+				rows["action_items.assignee"]++
+				itemChanged = true
+			}
+		}
+		if item.CompletedBy != nil {
+			if anon, ok := p.pseudonym(*item.CompletedBy); ok {
+				itemUpdate.SetCompletedBy(anon)
+				rows["action_items.completed_by"]++
+				itemChanged = true
+			}
+		}
+		if item.CreatedBy != nil {
+			if anon, ok := p.pseudonym(*item.CreatedBy); ok {
+				itemUpdate.SetCreatedBy(anon)
+				rows["action_items.created_by"]++
+				itemChanged = true
+			}
+		}
+		if item.UpdatedBy != nil {
+			if anon, ok := p.pseudonym(*item.UpdatedBy); ok {
+				itemUpdate.SetUpdatedBy(anon)
+				rows["action_items.updated_by"]++
+				itemChanged = true
+			}
+		}
+		if itemChanged {
+			if err := itemUpdate.Exec(ctx); err != nil {
+				return fmt.Errorf("failed to anonymize action item %s: %w", item.ID, err)
+			}
+		}
+	}
+
+	memories, err := tx.InvestigationMemory.Query().
+		Where(investigationmemory.SourceSessionIDEQ(sessionID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load memories: %w", err)
+	}
+	for _, mem := range memories {
+		memUpdate := tx.InvestigationMemory.UpdateOneID(mem.ID)
+		memChanged := false
+		if mem.CreatedBy != nil {
+			if anon, ok := p.pseudonym(*mem.CreatedBy); ok {
+				memUpdate.SetCreatedBy(anon)
+				rows["investigation_memories.created_by"]++
+				memChanged = true
+			}
+		}
+		if mem.UpdatedBy != nil {
+			if anon, ok := p.pseudonym(*mem.UpdatedBy); ok {
+				memUpdate.SetUpdatedBy(anon)
+				rows["investigation_memories.updated_by"]++
+				memChanged = true
+			}
+		}
+		if memChanged {
+			if err := memUpdate.Exec(ctx); err != nil {
+				return fmt.Errorf("failed to anonymize memory %s: %w", mem.ID, err)
+			}
+		}
+	}
+
+	scores, err := tx.SessionScore.Query().Where(sessionscore.SessionIDEQ(sessionID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load scores: %w", err)
+	}
+	for _, sc := range scores {
+		anon, ok := p.pseudonym(sc.ScoreTriggeredBy)
+		if !ok {
+			continue
+		}
+		if err := tx.SessionScore.UpdateOneID(sc.ID).SetScoreTriggeredBy(anon).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to anonymize score %s: %w", sc.ID, err)
+		}
+		rows["session_scores.score_triggered_by"]++
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/actionitem"
+	"github.com/codeready-toolchain/tarsy/ent/investigationmemory"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/message"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// secretMasker masks "s3cret" in alert data and in results from the
+// "kubernetes" server only, mimicking a newly added pattern.
+type secretMasker struct{}
+
+func (secretMasker) MaskAlertData(data string) string {
+	return strings.ReplaceAll(data, "s3cret", "[MASKED]")
+}
+
+func (secretMasker) MaskToolResult(content, serverID string) string {
+	if serverID != "kubernetes" {
+		return content
+	}
+	return strings.ReplaceAll(content, "s3cret", "[MASKED]")
+}
+
+func TestSessionBackfillService_Run(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	ctx := context.Background()
+
+	sessionID, stageID, execID := seedSessionSkeleton(t, client.Client, "password=s3cret")
+	client.AlertSession.UpdateOneID(sessionID).
+		SetAuthor("alice@example.com").
+		SetAuthorSubject("alice-sub").
+		SetTechnicalSummary("The pod reads password s3cret from its environment.").
+		SetHandoffNotes("Waiting on the platform team.").
+		SetHandoffNotesRevision(1).
+		SetHandoffNotesUpdatedBy("alice@example.com").
+		SetCancelledBy("bob@example.com").
+		SetOnCall(&schema.OnCallContact{
+			Service:    "payments",
+			Provider:   "pagerduty",
+			ScheduleID: "P123",
+			Name:       "Carol Oncall",
+			Email:      "carol@example.com",
+			ResolvedAt: time.Now(),
+		}).
+		ExecX(ctx)
+	client.HandoffNoteRevision.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetRevision(1).
+		SetContent("Waiting on the platform team.").
+		SetAuthor("alice@example.com").
+		SaveX(ctx)
+
+	client.MCPInteraction.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetStageID(stageID).
+		SetExecutionID(execID).
+		SetInteractionType(mcpinteraction.InteractionTypeToolCall).
+		SetServerName("kubernetes").
+		SetToolName("get_secret").
+		SetToolResult(map[string]any{"content": "token: s3cret", "is_error": false}).
+		SaveX(ctx)
+	client.MCPInteraction.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetStageID(stageID).
+		SetExecutionID(execID).
+		SetInteractionType(mcpinteraction.InteractionTypeToolCall).
+		SetServerName("github").
+		SetToolName("get_file").
+		SetToolResult(map[string]any{"content": "s3cret in a README"}).
+		SaveX(ctx)
+
+	client.Message.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetStageID(stageID).
+		SetExecutionID(execID).
+		SetSequenceNumber(1).
+		SetRole(message.RoleTool).
+		SetContent("token: s3cret").
+		SetToolCallID("call-1").
+		SetToolName("kubernetes.get_secret").
+		SaveX(ctx)
+
+	client.TimelineEvent.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetStageID(stageID).
+		SetExecutionID(execID).
+		SetSequenceNumber(1).
+		SetEventType(timelineevent.EventTypeLlmToolCall).
+		SetContent("token: s3cret").
+		SetMetadata(map[string]any{"server_name": "kubernetes", "tool_name": "get_secret"}).
+		SaveX(ctx)
+
+	client.ActionItem.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetTitle("Rotate password s3cret").
+		SetSource(actionitem.SourceExtracted).
+		SetAssignee("alice@example.com").
+		SetStatus(actionitem.StatusDone).
+		SetCompletedBy("bob@example.com").
+		SaveX(ctx)
+
+	client.InvestigationMemory.Create().
+		SetID(uuid.New().String()).
+		SetContent("The payments pods restart when the config map changes.").
+		SetCategory(investigationmemory.CategoryEpisodic).
+		SetValence(investigationmemory.ValenceNeutral).
+		SetSourceSessionID(sessionID).
+		SetCreatedBy("alice@example.com").
+		SetUpdatedBy("bob@example.com").
+		SaveX(ctx)
+
+	chatService := NewChatService(client.Client)
+	chat, err := chatService.CreateChat(ctx, models.CreateChatRequest{SessionID: sessionID, CreatedBy: "alice@example.com"})
+	require.NoError(t, err)
+	_, err = chatService.AddChatMessage(ctx, models.AddChatMessageRequest{ChatID: chat.ID, Content: "why?", Author: "alice@example.com", AuthorSubject: "alice-sub"})
+	require.NoError(t, err)
+
+	service := NewSessionBackfillService(client.Client, secretMasker{})
+
+	t.Run("dry run reports without writing", func(t *testing.T) {
+		result, err := service.Run(ctx, BackfillOptions{DryRun: true, AnonymizeAuthors: true})
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, 1, result.SessionsChanged)
+		assert.Equal(t, 1, result.Rows["alert_sessions.alert_data"])
+		assert.Equal(t, 1, result.Rows["mcp_interactions.tool_result"])
+		assert.Equal(t, 1, result.Rows["chat_user_messages.author"])
+
+		session := client.AlertSession.GetX(ctx, sessionID)
+		assert.Equal(t, "password=s3cret", session.AlertData)
+		assert.Equal(t, "alice@example.com", *session.Author)
+	})
+
+	t.Run("rewrites masked content and pseudonymizes authors", func(t *testing.T) {
+		result, err := service.Run(ctx, BackfillOptions{AnonymizeAuthors: true})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.SessionsScanned)
+		assert.Equal(t, map[string]int{
+			"alert_sessions.alert_data":               1,
+			"alert_sessions.technical_summary":        1,
+			"alert_sessions.author":                   1,
+			"alert_sessions.author_subject":           1,
+			"mcp_interactions.tool_result":            1,
+			"messages.content":                        1,
+			"timeline_events.content":                 1,
+			"chats.created_by":                        1,
+			"chat_user_messages.author":               1,
+			"chat_user_messages.author_subject":       1,
+			"action_items.title":                      1,
+			"action_items.assignee":                   1,
+			"action_items.completed_by":               1,
+			"alert_sessions.handoff_notes_updated_by": 1,
+			"handoff_note_revisions.author":           1,
+			"alert_sessions.cancelled_by":             1,
+			"alert_sessions.on_call":                  1,
+			"investigation_memories.created_by":       1,
+			"investigation_memories.updated_by":       1,
+		}, result.Rows)
+		assert.Equal(t, 19, result.TotalRows())
+
+		session := client.AlertSession.GetX(ctx, sessionID)
+		assert.Equal(t, "password=[MASKED]", session.AlertData)
+		assert.Equal(t, "The pod reads password [MASKED] from its environment.", *session.TechnicalSummary)
+		require.NotNil(t, session.Author)
+		assert.True(t, strings.HasPrefix(*session.Author, anonymizedPrefix))
+
+		// Same person → same pseudonym across tables within a run.
+		msgs := client.ChatUserMessage.Query().AllX(ctx)
+		require.Len(t, msgs, 1)
+		assert.Equal(t, *session.Author, msgs[0].Author)
+		require.NotNil(t, session.AuthorSubject)
+		assert.True(t, strings.HasPrefix(*session.AuthorSubject, anonymizedPrefix))
+		require.NotNil(t, msgs[0].AuthorSubject)
+		assert.Equal(t, *session.AuthorSubject, *msgs[0].AuthorSubject)
+
+		assert.Equal(t, *session.Author, *session.HandoffNotesUpdatedBy)
+		require.NotNil(t, session.CancelledBy)
+		assert.True(t, strings.HasPrefix(*session.CancelledBy, anonymizedPrefix))
+		require.NotNil(t, session.OnCall)
+		assert.True(t, strings.HasPrefix(session.OnCall.Name, anonymizedPrefix))
+		assert.True(t, strings.HasPrefix(session.OnCall.Email, anonymizedPrefix))
+		assert.Equal(t, "payments", session.OnCall.Service, "schedule details are kept")
+		revision := client.HandoffNoteRevision.Query().OnlyX(ctx)
+		assert.Equal(t, *session.Author, revision.Author)
+
+		item := client.ActionItem.Query().OnlyX(ctx)
+		assert.Equal(t, "Rotate password [MASKED]", item.Title)
+		assert.Equal(t, *session.Author, *item.Assignee)
+		assert.True(t, strings.HasPrefix(*item.CompletedBy, anonymizedPrefix))
+
+		memory := client.InvestigationMemory.Query().OnlyX(ctx)
+		assert.Equal(t, *session.Author, *memory.CreatedBy)
+		assert.Equal(t, *session.CancelledBy, *memory.UpdatedBy)
+
+		// Servers without the pattern are untouched.
+		github := client.MCPInteraction.Query().Where(mcpinteraction.ServerNameEQ("github")).OnlyX(ctx)
+		assert.Equal(t, "s3cret in a README", github.ToolResult["content"])
+	})
+
+	t.Run("rerun is a no-op", func(t *testing.T) {
+		result, err := service.Run(ctx, BackfillOptions{AnonymizeAuthors: true})
+		require.NoError(t, err)
+		assert.Zero(t, result.TotalRows())
+		assert.Zero(t, result.SessionsChanged)
+	})
+}
+
+func TestToolServerID(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOK bool
+	}{
+		{"kubernetes.get_pods", "kubernetes", true},
+		{"kubernetes__get_pods", "kubernetes", true},
+		{"dispatch_agent", "", false},
+		{".get_pods", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := toolServerID(tt.name)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPseudonymizer(t *testing.T) {
+	p, err := newPseudonymizer()
+	require.NoError(t, err)
+
+	a1, ok := p.pseudonym("alice@example.com")
+	require.True(t, ok)
+	a2, _ := p.pseudonym("alice@example.com")
+	b, _ := p.pseudonym("bob@example.com")
+	assert.Equal(t, a1, a2)
+	assert.NotEqual(t, a1, b)
+	assert.True(t, strings.HasPrefix(a1, anonymizedPrefix))
+	assert.NotContains(t, a1, "alice")
+
+	_, ok = p.pseudonym(a1)
+	assert.False(t, ok, "already anonymized values are kept")
+	_, ok = p.pseudonym("")
+	assert.False(t, ok)
+}
+
+func TestBackfillResult(t *testing.T) {
+	r := &BackfillResult{Rows: map[string]int{"messages.content": 2, "alert_sessions.alert_data": 1}}
+	assert.Equal(t, 3, r.TotalRows())
+	assert.Equal(t, []string{"alert_sessions.alert_data", "messages.content"}, r.Columns())
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/codeready-toolchain/tarsy/ent"
 	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
@@ -26,6 +27,22 @@ func (c *SessionCounter) PendingCount(ctx context.Context) (int, error) {
 		Count(ctx)
 }
 
+// OldestPendingAt returns the submission time of the oldest session waiting
+// to be claimed, or nil when the queue is empty.
+func (c *SessionCounter) OldestPendingAt(ctx context.Context) (*time.Time, error) {
+	oldest, err := c.client.AlertSession.Query().
+		Where(alertsession.StatusEQ(alertsession.StatusPending), alertsession.DeletedAtIsNil()).
+		Order(ent.Asc(alertsession.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("oldest pending query: %w", err)
+	}
+	return &oldest.CreatedAt, nil
+}
+
 // ActiveCount returns the number of sessions currently being processed.
 // Both in_progress and cancelling sessions are counted as active.
 func (c *SessionCounter) ActiveCount(ctx context.Context) (int, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/codeready-toolchain/tarsy/ent/alertsession"
 	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
 	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/sessioncomparison"
 	"github.com/codeready-toolchain/tarsy/ent/sessionreviewactivity"
 	"github.com/codeready-toolchain/tarsy/ent/sessionscore"
 	"github.com/codeready-toolchain/tarsy/ent/stage"
@@ -285,7 +286,8 @@ func (s *SessionService) UpdateSessionStatus(_ context.Context, sessionID string
 	if status == alertsession.StatusCompleted ||
 		status == alertsession.StatusFailed ||
 		status == alertsession.StatusCancelled ||
-		status == alertsession.StatusTimedOut {
+		status == alertsession.StatusTimedOut ||
+		status == alertsession.StatusAutoCancelled {
 		update = update.SetCompletedAt(time.Now())
 	}
 
@@ -319,22 +321,30 @@ func (s *SessionService) FindOrphanedSessions(ctx context.Context, timeoutDurati
 }
 
 // CancelSession requests cancellation of an in-progress session.
-// Sets the DB status to "cancelling" (intermediate state).
+// Sets the DB status to "cancelling" (intermediate state) and records the
+// user-initiated cancellation with its optional reason and requester.
 // The owning worker detects this and propagates cancellation.
-func (s *SessionService) CancelSession(_ context.Context, sessionID string) error {
+func (s *SessionService) CancelSession(_ context.Context, sessionID, reason, cancelledBy string) error {
 	// Use background context with timeout for critical write
 	bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	// Conditional update: only update if session exists and is in_progress
 	// This prevents TOCTOU race conditions
-	count, err := s.client.AlertSession.Update().
+	update := s.client.AlertSession.Update().
 		Where(
 			alertsession.IDEQ(sessionID),
 			alertsession.StatusEQ(alertsession.StatusInProgress),
 		).
 		SetStatus(alertsession.StatusCancelling).
-		Save(bgCtx)
+		SetCancelInitiator(alertsession.CancelInitiatorUser)
+	if reason != "" {
+		update.SetCancelReason(reason)
+	}
+	if cancelledBy != "" {
+		update.SetCancelledBy(cancelledBy)
+	}
+	count, err := update.Save(bgCtx)
 	if err != nil {
 		return fmt.Errorf("failed to cancel session: %w", err)
 	}
@@ -450,6 +460,7 @@ func (s *SessionService) GetSessionDetail(ctx context.Context, sessionID string)
 			})
 		}).
 		WithChat().
+		WithComparison().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -458,6 +469,18 @@ func (s *SessionService) GetSessionDetail(ctx context.Context, sessionID string)
 		return nil, fmt.Errorf("failed to get session: %w", err)
 	}
 
+	// Latest later investigation of the same alert compared with this one.
+	nextComparison, err := s.client.SessionComparison.Query().
+		Where(
+			sessioncomparison.PreviousSessionIDEQ(sessionID),
+			sessioncomparison.HasSessionWith(alertsession.DeletedAtIsNil()),
+		).
+		Order(sessioncomparison.ByCreatedAt(sql.OrderDesc())).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get next comparison: %w", err)
+	}
+
 	// Compute token and interaction stats via aggregate queries.
 	llmStats, err := s.aggregateLLMStats(ctx, sessionID)
 	if err != nil {
@@ -656,14 +679,47 @@ func (s *SessionService) GetSessionDetail(ctx context.Context, sessionID string)
 		AlertType:               alertType,
 		Status:                  string(session.Status),
 		ChainID:                 session.ChainID,
+		Owner:                   session.Owner,
 		Author:                  session.Author,
 		ErrorMessage:            session.ErrorMessage,
 		FinalAnalysis:           session.FinalAnalysis,
 		ExecutiveSummary:        session.ExecutiveSummary,
 		ExecutiveSummaryError:   session.ExecutiveSummaryError,
+		ExecSummaryTemplate:     session.ExecutiveSummaryTemplate,
+		TechnicalSummary:        session.TechnicalSummary,
+		TechnicalSummaryError:   session.TechnicalSummaryError,
+		Severity:                ptrStringFromSeverity(session.Severity),
+		Outcome:                 ptrStringFromOutcome(session.Outcome),
+		OutcomeReason:           session.OutcomeReason,
+		OutcomeSource:           ptrStringFromOutcomeSource(session.OutcomeSource),
 		RunbookURL:              session.RunbookURL,
 		SlackMessageFingerprint: session.SlackMessageFingerprint,
+		RequestID:               session.RequestID,
+		AlertKey:                session.AlertKey,
+		AlertResolvedAt:         session.AlertResolvedAt,
+		AlertResolution:         session.AlertResolution,
+		CancelInitiator:         ptrStringFromCancelInitiator(session.CancelInitiator),
+		CancelReason:            session.CancelReason,
+		CancelledBy:             session.CancelledBy,
+		DegradedReason:          session.DegradedReason,
+		ModelRouting:            session.ModelRouting,
+		NoiseTriage:             session.NoiseTriage,
+		OnCall:                  session.OnCall,
+		ForceFullInvestigation:  session.ForceFullInvestigation,
+		FederationOrigin:        session.FederationOrigin,
+		Target:                  session.Target,
+		GroupID:                 session.GroupID,
+		DuplicatedFrom:          session.DuplicatedFrom,
+		LLMProvider:             session.LlmProvider,
+		Sandbox:                 session.Sandbox,
+		FallbackChain:           session.FallbackChain,
 		MCPSelection:            session.McpSelection,
+		AlertInstructions:       session.AlertInstructions,
+		AlertImages:             toMessageImages(session.AlertImages),
+		OutputLanguage:          session.OutputLanguage,
+		Callback:                toCallbackDeliveryResponse(session),
+		Comparison:              toSessionComparisonResponse(session.Edges.Comparison),
+		NextComparison:          toSessionComparisonResponse(nextComparison),
 		CreatedAt:               session.CreatedAt,
 		StartedAt:               session.StartedAt,
 		CompletedAt:             session.CompletedAt,
@@ -696,6 +752,10 @@ func (s *SessionService) GetSessionDetail(ctx context.Context, sessionID string)
 		FeedbackEdited:          feedbackEdited,
 		FeedbackEditedBy:        feedbackEditedBy,
 		FeedbackEditedAt:        feedbackEditedAt,
+		HandoffNotes:            session.HandoffNotes,
+		HandoffNotesRevision:    session.HandoffNotesRevision,
+		HandoffNotesUpdatedBy:   session.HandoffNotesUpdatedBy,
+		HandoffNotesUpdatedAt:   session.HandoffNotesUpdatedAt,
 		Stages:                  stages,
 	}
 	if s.costEstimationEnabled {
@@ -807,10 +867,94 @@ func (s *SessionService) GetSessionStatus(ctx context.Context, sessionID string)
 		Status:           string(session.Status),
 		FinalAnalysis:    session.FinalAnalysis,
 		ExecutiveSummary: session.ExecutiveSummary,
+		TechnicalSummary: session.TechnicalSummary,
+		Severity:         ptrStringFromSeverity(session.Severity),
 		ErrorMessage:     session.ErrorMessage,
 	}, nil
 }
 
+// GetSessionGroup returns the combined status of a fan-out session group:
+// its sibling sessions, their aggregate status, and the cross-session
+// synthesis. Returns ErrNotFound when the group does not exist or all of its
+// sessions were deleted by retention.
+func (s *SessionService) GetSessionGroup(ctx context.Context, groupID string) (*models.SessionGroupResponse, error) {
+	group, err := s.client.SessionGroup.Get(ctx, groupID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get session group: %w", err)
+	}
+
+	sessions, err := group.QuerySessions().
+		Where(alertsession.DeletedAtIsNil()).
+		Order(ent.Asc(alertsession.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query group sessions: %w", err)
+	}
+	if len(sessions) == 0 {
+		return nil, ErrNotFound
+	}
+
+	members := make([]models.SessionGroupMember, 0, len(sessions))
+	statuses := make([]alertsession.Status, 0, len(sessions))
+	for _, sess := range sessions {
+		members = append(members, models.SessionGroupMember{
+			ID:               sess.ID,
+			ChainID:          sess.ChainID,
+			Status:           string(sess.Status),
+			ExecutiveSummary: sess.ExecutiveSummary,
+			Severity:         ptrStringFromSeverity(sess.Severity),
+			ErrorMessage:     sess.ErrorMessage,
+			StartedAt:        sess.StartedAt,
+			CompletedAt:      sess.CompletedAt,
+		})
+		statuses = append(statuses, sess.Status)
+	}
+
+	return &models.SessionGroupResponse{
+		ID:              group.ID,
+		AlertType:       group.AlertType,
+		PrimaryChainID:  group.PrimaryChainID,
+		Status:          sessionGroupStatus(statuses),
+		CreatedAt:       group.CreatedAt,
+		Sessions:        members,
+		SynthesisStatus: string(group.SynthesisStatus),
+		Summary:         group.Summary,
+		SummaryError:    group.SummaryError,
+		SynthesizedAt:   group.SynthesizedAt,
+	}, nil
+}
+
+// sessionGroupStatus folds sibling session statuses into the group's
+// aggregate status.
+func sessionGroupStatus(statuses []alertsession.Status) string {
+	var pending, active, completed int
+	for _, st := range statuses {
+		switch st {
+		case alertsession.StatusPending:
+			pending++
+		case alertsession.StatusInProgress, alertsession.StatusCancelling:
+			active++
+		case alertsession.StatusCompleted:
+			completed++
+		}
+	}
+	switch {
+	case pending == len(statuses):
+		return models.SessionGroupStatusPending
+	case pending+active > 0:
+		return models.SessionGroupStatusInProgress
+	case completed == len(statuses):
+		return models.SessionGroupStatusCompleted
+	case completed > 0:
+		return models.SessionGroupStatusPartial
+	default:
+		return models.SessionGroupStatusFailed
+	}
+}
+
 // GetActiveSessions returns in-progress + pending sessions.
 func (s *SessionService) GetActiveSessions(ctx context.Context) (*models.ActiveSessionsResponse, error) {
 	// Active sessions (in_progress or cancelling).
@@ -895,8 +1039,11 @@ type dashboardRow struct {
 	CompletedAt       *time.Time `sql:"completed_at"`
 	ErrorMessage      *string    `sql:"error_message"`
 	ExecutiveSummary  *string    `sql:"executive_summary"`
+	Outcome           *string    `sql:"outcome"`
 	CurrentStageIndex *int       `sql:"current_stage_index"`
 	CurrentStageID    *string    `sql:"current_stage_id"`
+	DegradedReason    *string    `sql:"degraded_reason"`
+	FallbackChain     bool       `sql:"fallback_chain"`
 	// Aggregated columns from subqueries.
 	LLMCount              int        `sql:"llm_count"`
 	LLMInputTokens        int64      `sql:"llm_input_tokens"`
@@ -930,7 +1077,10 @@ type dashboardRow struct {
 // ListSessionsForDashboard returns a paginated, filtered session list with aggregated stats.
 // All aggregated statistics are computed via SQL subqueries in a single query to avoid N+1.
 func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params models.DashboardListParams) (*models.DashboardListResponse, error) {
-	query := s.client.AlertSession.Query().Where(alertsession.DeletedAtIsNil())
+	query := s.client.AlertSession.Query().Where(
+		alertsession.DeletedAtIsNil(),
+		alertsession.Sandbox(params.Sandbox),
+	)
 
 	// Apply filters.
 	if params.Status != "" {
@@ -947,6 +1097,9 @@ func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params mo
 	if params.ChainID != "" {
 		query = query.Where(alertsession.ChainIDEQ(params.ChainID))
 	}
+	if params.FallbackChain {
+		query = query.Where(alertsession.FallbackChain(true))
+	}
 	if params.Search != "" {
 		search := params.Search
 		query = query.Where(func(sel *sql.Selector) {
@@ -956,11 +1109,11 @@ func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params mo
 				sql.ContainsFold(alertsession.FieldFinalAnalysis, search),
 				sql.P(func(b *sql.Builder) {
 					b.WriteString(fmt.Sprintf(
-						`EXISTS (SELECT 1 FROM timeline_events te WHERE te.session_id = %q.%q AND to_tsvector('english', te.content) @@ plainto_tsquery('english', `,
+						`EXISTS (SELECT 1 FROM timeline_events te WHERE te.session_id = %q.%q AND `,
 						t, alertsession.FieldID,
 					))
-					b.Arg(search)
-					b.WriteString("))")
+					writeTimelineContentMatch(b, search)
+					b.WriteString(")")
 				}),
 			))
 		})
@@ -1013,6 +1166,17 @@ func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params mo
 	if params.QualityRating != "" {
 		query = query.Where(alertsession.QualityRatingEQ(alertsession.QualityRating(params.QualityRating)))
 	}
+	if params.RequestID != "" {
+		query = query.Where(alertsession.RequestIDEQ(params.RequestID))
+	}
+	if params.Outcome != "" {
+		labels := strings.Split(params.Outcome, ",")
+		outcomes := make([]alertsession.Outcome, 0, len(labels))
+		for _, o := range labels {
+			outcomes = append(outcomes, alertsession.Outcome(o))
+		}
+		query = query.Where(alertsession.OutcomeIn(outcomes...))
+	}
 
 	// Count total (before pagination).
 	totalCount, err := query.Clone().Count(ctx)
@@ -1093,8 +1257,11 @@ func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params mo
 				sel.C(alertsession.FieldCompletedAt),
 				sel.C(alertsession.FieldErrorMessage),
 				sel.C(alertsession.FieldExecutiveSummary),
+				sel.C(alertsession.FieldOutcome),
 				sel.C(alertsession.FieldCurrentStageIndex),
 				sel.C(alertsession.FieldCurrentStageID),
+				sel.C(alertsession.FieldDegradedReason),
+				sel.C(alertsession.FieldFallbackChain),
 				sel.C(alertsession.FieldReviewStatus),
 				sel.C(alertsession.FieldAssignee),
 				sel.C(alertsession.FieldQualityRating),
@@ -1189,11 +1356,11 @@ func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params mo
 				sel.AppendSelectExprAs(
 					sql.P(func(b *sql.Builder) {
 						b.WriteString(fmt.Sprintf(
-							"(CASE WHEN EXISTS(SELECT 1 FROM timeline_events te WHERE te.session_id = %s AND to_tsvector('english', te.content) @@ plainto_tsquery('english', ",
+							"(CASE WHEN EXISTS(SELECT 1 FROM timeline_events te WHERE te.session_id = %s AND ",
 							sid,
 						))
-						b.Arg(params.Search)
-						b.WriteString(")) THEN 1 ELSE 0 END)")
+						writeTimelineContentMatch(b, params.Search)
+						b.WriteString(") THEN 1 ELSE 0 END)")
 					}),
 					"matched_in_content",
 				)
@@ -1264,6 +1431,7 @@ func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params mo
 			DurationMs:            durationMs,
 			ErrorMessage:          row.ErrorMessage,
 			ExecutiveSummary:      row.ExecutiveSummary,
+			Outcome:               row.Outcome,
 			LLMInteractionCount:   row.LLMCount,
 			MCPInteractionCount:   row.MCPCount,
 			InputTokens:           row.LLMInputTokens,
@@ -1277,6 +1445,8 @@ func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params mo
 			ActionsExecuted:       row.ActionsExecuted,
 			ChatMessageCount:      row.ChatMsgCount,
 			ProviderFallbackCount: row.FallbackCount,
+			DegradedReason:        row.DegradedReason,
+			FallbackChain:         row.FallbackChain,
 			CurrentStageIndex:     row.CurrentStageIndex,
 			CurrentStageID:        row.CurrentStageID,
 			MatchedInContent:      row.MatchedInContent != 0,
@@ -1316,6 +1486,17 @@ func (s *SessionService) ListSessionsForDashboard(ctx context.Context, params mo
 	}, nil
 }
 
+// writeTimelineContentMatch writes a full-text match of query against a
+// timeline event aliased "te": plain content is searched directly, content
+// stored compressed through its content_tsv vector.
+func writeTimelineContentMatch(b *sql.Builder, query string) {
+	b.WriteString("(to_tsvector('english', te.content) @@ plainto_tsquery('english', ")
+	b.Arg(query)
+	b.WriteString(") OR te." + contentSearchColumn + " @@ plainto_tsquery('english', ")
+	b.Arg(query)
+	b.WriteString("))")
+}
+
 // --- Aggregate helpers ---
 
 // tokenBearingPredicateSQL matches LLM rows that contribute to cost completeness.
@@ -1582,6 +1763,7 @@ func buildExecutionOverview(
 		Task:                exec.Task,
 		OriginalLLMProvider: exec.OriginalLlmProvider,
 		OriginalLLMBackend:  exec.OriginalLlmBackend,
+		LoopDetected:        exec.LoopDetected,
 	}
 	if costEstimationEnabled {
 		applyExecutionCostFields(&overview, stats)
@@ -1610,6 +1792,71 @@ func ptrStringFromReviewStatus(v *alertsession.ReviewStatus) *string {
 	return &s
 }
 
+func ptrStringFromSeverity(v *alertsession.Severity) *string {
+	if v == nil {
+		return nil
+	}
+	s := string(*v)
+	return &s
+}
+
+func ptrStringFromOutcome(v *alertsession.Outcome) *string {
+	if v == nil {
+		return nil
+	}
+	s := string(*v)
+	return &s
+}
+
+func ptrStringFromOutcomeSource(v *alertsession.OutcomeSource) *string {
+	if v == nil {
+		return nil
+	}
+	s := string(*v)
+	return &s
+}
+
+// toCallbackDeliveryResponse reports completion callback delivery, or nil
+// when the session has no callback registered.
+func toCallbackDeliveryResponse(session *ent.AlertSession) *models.CallbackDeliveryResponse {
+	if session.CallbackURL == nil || session.CallbackStatus == nil {
+		return nil
+	}
+	return &models.CallbackDeliveryResponse{
+		URL:         *session.CallbackURL,
+		Status:      string(*session.CallbackStatus),
+		Attempts:    session.CallbackAttempts,
+		LastError:   session.CallbackLastError,
+		DeliveredAt: session.CallbackDeliveredAt,
+	}
+}
+
+func toSessionComparisonResponse(c *ent.SessionComparison) *models.SessionComparisonResponse {
+	if c == nil {
+		return nil
+	}
+	return &models.SessionComparisonResponse{
+		ID:                 c.ID,
+		SessionID:          c.SessionID,
+		PreviousSessionID:  c.PreviousSessionID,
+		Status:             string(c.Status),
+		PreviousConclusion: c.PreviousConclusion,
+		CurrentConclusion:  c.CurrentConclusion,
+		Differences:        c.Differences,
+		SameRootCause:      c.SameRootCause,
+		ErrorMessage:       c.ErrorMessage,
+		CreatedAt:          c.CreatedAt,
+	}
+}
+
+func ptrStringFromCancelInitiator(v *alertsession.CancelInitiator) *string {
+	if v == nil {
+		return nil
+	}
+	s := string(*v)
+	return &s
+}
+
 func ptrStringFromQualityRating(v *alertsession.QualityRating) *string {
 	if v == nil {
 		return nil
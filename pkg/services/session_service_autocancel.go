@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+)
+
+// AutoCancelReasonPrefix starts the error_message of every auto-cancelled session.
+const AutoCancelReasonPrefix = "Auto-cancelled: "
+
+// ResolveAlertResult reports what ResolveAlert did.
+type ResolveAlertResult struct {
+	// Resolved are the queued or in-flight sessions the resolution was recorded on.
+	Resolved []*ent.AlertSession
+	// Cancelled is the subset of Resolved that was still queued and has been
+	// auto-cancelled.
+	Cancelled []*ent.AlertSession
+}
+
+// ResolveAlert records an alert source's resolution on every queued or
+// in-flight session whose alert_key matches. Running sessions pick the
+// resolution up at their next stage boundary. When cancelQueued is set,
+// sessions that are still pending are also auto-cancelled so workers don't
+// investigate an alert that has already cleared. Sessions that already
+// carry a resolution keep the first one.
+func (s *SessionService) ResolveAlert(_ context.Context, alertKey, reason string, cancelQueued bool) (*ResolveAlertResult, error) {
+	if alertKey == "" {
+		return nil, NewValidationError("alert_key", "required")
+	}
+
+	// Use background context with timeout for critical write
+	bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	open, err := s.client.AlertSession.Query().
+		Where(
+			alertsession.AlertKeyEQ(alertKey),
+			alertsession.StatusIn(alertsession.StatusPending, alertsession.StatusInProgress),
+			alertsession.AlertResolvedAtIsNil(),
+			alertsession.DeletedAtIsNil(),
+		).
+		All(bgCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query open sessions for alert: %w", err)
+	}
+
+	now := time.Now()
+	result := &ResolveAlertResult{}
+	for _, sess := range open {
+		update := s.client.AlertSession.Update().
+			Where(
+				alertsession.IDEQ(sess.ID),
+				alertsession.AlertResolvedAtIsNil(),
+			).
+			SetAlertResolvedAt(now)
+		if reason != "" {
+			update.SetAlertResolution(reason)
+		}
+		n, err := update.Save(bgCtx)
+		if err != nil {
+			return result, fmt.Errorf("failed to record alert resolution: %w", err)
+		}
+		if n == 0 {
+			continue
+		}
+		sess.AlertResolvedAt = &now
+		if reason != "" {
+			sess.AlertResolution = &reason
+		}
+		result.Resolved = append(result.Resolved, sess)
+
+		if !cancelQueued || sess.Status != alertsession.StatusPending {
+			continue
+		}
+		cancelReason := "alert resolved"
+		if reason != "" {
+			cancelReason += " (" + reason + ")"
+		}
+		ok, err := AutoCancelPendingSession(bgCtx, s.client, sess.ID, alertsession.CancelInitiatorAlertResolved, cancelReason)
+		if err != nil {
+			return result, err
+		}
+		if ok {
+			msg := AutoCancelReasonPrefix + cancelReason
+			initiator := alertsession.CancelInitiatorAlertResolved
+			sess.Status = alertsession.StatusAutoCancelled
+			sess.ErrorMessage = &msg
+			sess.CancelInitiator = &initiator
+			sess.CancelReason = &cancelReason
+			result.Cancelled = append(result.Cancelled, sess)
+		}
+	}
+	return result, nil
+}
+
+// AutoCancelPendingSession moves a session from pending to auto_cancelled,
+// recording the initiating policy and reason, with AutoCancelReasonPrefix +
+// reason as its error message. The update is conditional on the session
+// still being pending, so a worker that claims it first wins.
+// Returns false when the session was no longer pending.
+func AutoCancelPendingSession(ctx context.Context, client *ent.Client, sessionID string, initiator alertsession.CancelInitiator, reason string) (bool, error) {
+	n, err := client.AlertSession.Update().
+		Where(
+			alertsession.IDEQ(sessionID),
+			alertsession.StatusEQ(alertsession.StatusPending),
+		).
+		SetStatus(alertsession.StatusAutoCancelled).
+		SetCompletedAt(time.Now()).
+		SetErrorMessage(AutoCancelReasonPrefix + reason).
+		SetCancelInitiator(initiator).
+		SetCancelReason(reason).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to auto-cancel session: %w", err)
+	}
+	return n > 0, nil
+}
+
+// PreemptSession moves an in-flight session to cancelling on behalf of the
+// preemption policy, recording reason. The update is conditional on the
+// session still being in progress; the owning worker finishes it as
+// auto_cancelled once its context is cancelled. Returns false when the
+// session was no longer in progress.
+func PreemptSession(ctx context.Context, client *ent.Client, sessionID, reason string) (bool, error) {
+	n, err := client.AlertSession.Update().
+		Where(
+			alertsession.IDEQ(sessionID),
+			alertsession.StatusEQ(alertsession.StatusInProgress),
+		).
+		SetStatus(alertsession.StatusCancelling).
+		SetCancelInitiator(alertsession.CancelInitiatorPreemption).
+		SetCancelReason(reason).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to preempt session: %w", err)
+	}
+	return n > 0, nil
+}
+
+// DescribeCancellation renders a session's recorded cancellation as a
+// one-line explanation for error messages and notifications, e.g.
+// "Cancelled by alice: duplicate of INC-42". Returns "" when the session
+// carries no cancellation record.
+func DescribeCancellation(session *ent.AlertSession) string {
+	if session.CancelInitiator == nil {
+		return ""
+	}
+	var msg string
+	switch *session.CancelInitiator {
+	case alertsession.CancelInitiatorUser:
+		msg = "Cancelled by user"
+		if session.CancelledBy != nil && *session.CancelledBy != "" {
+			msg = "Cancelled by " + *session.CancelledBy
+		}
+	case alertsession.CancelInitiatorPendingTTL:
+		msg = "Cancelled by pending TTL policy"
+	case alertsession.CancelInitiatorAlertResolved:
+		msg = "Cancelled by alert resolution webhook"
+	case alertsession.CancelInitiatorPreemption:
+		msg = "Cancelled by preemption policy"
+	default:
+		msg = "Cancelled by " + string(*session.CancelInitiator)
+	}
+	if session.CancelReason != nil && *session.CancelReason != "" {
+		msg += ": " + *session.CancelReason
+	}
+	return msg
+}
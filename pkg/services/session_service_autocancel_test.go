@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_ResolveAlert(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	seedKeyed := func(t *testing.T, status alertsession.Status, alertKey string) string {
+		t.Helper()
+		id := seedActiveSession(t, service, status)
+		require.NoError(t, client.AlertSession.UpdateOneID(id).SetAlertKey(alertKey).Exec(ctx))
+		return id
+	}
+
+	sessionIDs := func(sessions []*ent.AlertSession) []string {
+		ids := make([]string, 0, len(sessions))
+		for _, sess := range sessions {
+			ids = append(ids, sess.ID)
+		}
+		return ids
+	}
+
+	t.Run("records resolution and cancels only pending sessions with the alert key", func(t *testing.T) {
+		key := uuid.New().String()
+		pending1 := seedKeyed(t, alertsession.StatusPending, key)
+		pending2 := seedKeyed(t, alertsession.StatusPending, key)
+		running := seedKeyed(t, alertsession.StatusInProgress, key)
+		done := seedKeyed(t, alertsession.StatusCompleted, key)
+		other := seedKeyed(t, alertsession.StatusPending, uuid.New().String())
+
+		result, err := service.ResolveAlert(ctx, key, "resolved in Alertmanager", true)
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{pending1, pending2, running}, sessionIDs(result.Resolved))
+		assert.ElementsMatch(t, []string{pending1, pending2}, sessionIDs(result.Cancelled))
+		for _, sess := range result.Cancelled {
+			assert.Equal(t, alertsession.StatusAutoCancelled, sess.Status)
+		}
+
+		got, err := client.AlertSession.Get(ctx, pending1)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.StatusAutoCancelled, got.Status)
+		assert.NotNil(t, got.CompletedAt)
+		require.NotNil(t, got.ErrorMessage)
+		assert.True(t, strings.HasPrefix(*got.ErrorMessage, AutoCancelReasonPrefix))
+		assert.Contains(t, *got.ErrorMessage, "resolved in Alertmanager")
+
+		got, err = client.AlertSession.Get(ctx, running)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.StatusInProgress, got.Status)
+		assert.NotNil(t, got.AlertResolvedAt)
+		require.NotNil(t, got.AlertResolution)
+		assert.Equal(t, "resolved in Alertmanager", *got.AlertResolution)
+
+		got, err = client.AlertSession.Get(ctx, done)
+		require.NoError(t, err)
+		assert.Nil(t, got.AlertResolvedAt, "terminal sessions are not updated")
+
+		got, err = client.AlertSession.Get(ctx, other)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.StatusPending, got.Status)
+		assert.Nil(t, got.AlertResolvedAt)
+	})
+
+	t.Run("records resolution without cancelling queued sessions", func(t *testing.T) {
+		key := uuid.New().String()
+		pending := seedKeyed(t, alertsession.StatusPending, key)
+
+		result, err := service.ResolveAlert(ctx, key, "", false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{pending}, sessionIDs(result.Resolved))
+		assert.Empty(t, result.Cancelled)
+
+		got, err := client.AlertSession.Get(ctx, pending)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.StatusPending, got.Status)
+		assert.NotNil(t, got.AlertResolvedAt)
+		assert.Nil(t, got.AlertResolution)
+	})
+
+	t.Run("keeps the first resolution", func(t *testing.T) {
+		key := uuid.New().String()
+		running := seedKeyed(t, alertsession.StatusInProgress, key)
+
+		_, err := service.ResolveAlert(ctx, key, "first", true)
+		require.NoError(t, err)
+		result, err := service.ResolveAlert(ctx, key, "second", true)
+		require.NoError(t, err)
+		assert.Empty(t, result.Resolved)
+
+		got, err := client.AlertSession.Get(ctx, running)
+		require.NoError(t, err)
+		require.NotNil(t, got.AlertResolution)
+		assert.Equal(t, "first", *got.AlertResolution)
+	})
+
+	t.Run("no matching sessions", func(t *testing.T) {
+		result, err := service.ResolveAlert(ctx, uuid.New().String(), "", true)
+		require.NoError(t, err)
+		assert.Empty(t, result.Resolved)
+		assert.Empty(t, result.Cancelled)
+	})
+
+	t.Run("requires alert key", func(t *testing.T) {
+		_, err := service.ResolveAlert(ctx, "", "", true)
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+}
+
+func TestAutoCancelPendingSession(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	t.Run("cancels pending session", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusPending)
+
+		ok, err := AutoCancelPendingSession(ctx, client.Client, id, alertsession.CancelInitiatorPendingTTL, "test")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		got, err := client.AlertSession.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.StatusAutoCancelled, got.Status)
+		require.NotNil(t, got.ErrorMessage)
+		assert.Equal(t, AutoCancelReasonPrefix+"test", *got.ErrorMessage)
+		require.NotNil(t, got.CancelInitiator)
+		assert.Equal(t, alertsession.CancelInitiatorPendingTTL, *got.CancelInitiator)
+		require.NotNil(t, got.CancelReason)
+		assert.Equal(t, "test", *got.CancelReason)
+	})
+
+	t.Run("leaves claimed session alone", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+
+		ok, err := AutoCancelPendingSession(ctx, client.Client, id, alertsession.CancelInitiatorPendingTTL, "test")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		got, err := client.AlertSession.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.StatusInProgress, got.Status)
+		assert.Nil(t, got.ErrorMessage)
+	})
+}
+
+func TestPreemptSession(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	t.Run("marks in-flight session cancelling", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+
+		ok, err := PreemptSession(ctx, client.Client, id, "test")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		got, err := client.AlertSession.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.StatusCancelling, got.Status)
+		require.NotNil(t, got.CancelInitiator)
+		assert.Equal(t, alertsession.CancelInitiatorPreemption, *got.CancelInitiator)
+		require.NotNil(t, got.CancelReason)
+		assert.Equal(t, "test", *got.CancelReason)
+	})
+
+	t.Run("leaves pending session alone", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusPending)
+
+		ok, err := PreemptSession(ctx, client.Client, id, "test")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		got, err := client.AlertSession.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, alertsession.StatusPending, got.Status)
+		assert.Nil(t, got.CancelInitiator)
+	})
+}
+
+func TestDescribeCancellation(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	initiator := func(i alertsession.CancelInitiator) *alertsession.CancelInitiator { return &i }
+
+	tests := []struct {
+		name    string
+		session *ent.AlertSession
+		want    string
+	}{
+		{
+			name:    "no cancellation record",
+			session: &ent.AlertSession{},
+			want:    "",
+		},
+		{
+			name: "user with reason",
+			session: &ent.AlertSession{
+				CancelInitiator: initiator(alertsession.CancelInitiatorUser),
+				CancelledBy:     ptr("alice"),
+				CancelReason:    ptr("duplicate of INC-42"),
+			},
+			want: "Cancelled by alice: duplicate of INC-42",
+		},
+		{
+			name:    "anonymous user without reason",
+			session: &ent.AlertSession{CancelInitiator: initiator(alertsession.CancelInitiatorUser)},
+			want:    "Cancelled by user",
+		},
+		{
+			name: "alert resolution webhook",
+			session: &ent.AlertSession{
+				CancelInitiator: initiator(alertsession.CancelInitiatorAlertResolved),
+				CancelReason:    ptr("alert resolved"),
+			},
+			want: "Cancelled by alert resolution webhook: alert resolved",
+		},
+		{
+			name: "preemption policy",
+			session: &ent.AlertSession{
+				CancelInitiator: initiator(alertsession.CancelInitiatorPreemption),
+				CancelReason:    ptr("preempted for a NodeDown alert"),
+			},
+			want: "Cancelled by preemption policy: preempted for a NodeDown alert",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DescribeCancellation(tt.session))
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package services
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent/agentexecution"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// Status filters shared by chain and agent success rates. Cancelled and
+// in-flight work is excluded from the denominator.
+const (
+	catalogCompletedSQL = `COUNT(*) FILTER (WHERE status = 'completed')`
+	catalogFinishedSQL  = `COUNT(*) FILTER (WHERE status IN ('completed', 'failed', 'timed_out'))`
+)
+
+// catalogUsageRow is the common aggregate shape scanned by the catalog queries.
+type catalogUsageRow struct {
+	Key         string          `json:"key"`
+	Sessions    int             `json:"sessions"`
+	Invocations int             `json:"invocations"`
+	Succeeded   int             `json:"succeeded"`
+	Finished    int             `json:"finished"`
+	LastUsedAt  stdsql.NullTime `json:"last_used_at"`
+}
+
+func catalogSessionPreds(since time.Time) []predicate.AlertSession {
+	return []predicate.AlertSession{
+		alertsession.DeletedAtIsNil(),
+		alertsession.CreatedAtGTE(since),
+	}
+}
+
+// GetChainUsage returns usage per chain ID for sessions created since the
+// given time (soft-deleted sessions excluded).
+func (s *SessionService) GetChainUsage(ctx context.Context, since time.Time) (map[string]models.CatalogUsage, error) {
+	sessionPreds := catalogSessionPreds(since)
+
+	var rows []catalogUsageRow
+	err := s.client.AlertSession.Query().
+		Where(sessionPreds...).
+		Modify(func(sel *sql.Selector) {
+			sel.Select(sql.As(sel.C(alertsession.FieldChainID), "key"))
+			sel.AppendSelectAs("COUNT(*)", "sessions")
+			sel.AppendSelectAs(catalogCompletedSQL, "succeeded")
+			sel.AppendSelectAs(catalogFinishedSQL, "finished")
+			sel.AppendSelectAs(fmt.Sprintf("MAX(%s)", sel.C(alertsession.FieldCreatedAt)), "last_used_at")
+			sel.GroupBy(sel.C(alertsession.FieldChainID))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate chain usage: %w", err)
+	}
+
+	costs := map[string]float64{}
+	if s.costEstimationEnabled {
+		byChain, err := s.usageByChain(ctx, sessionPreds)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range byChain {
+			if c.EstimatedCostUsd != nil {
+				costs[c.ChainID] = *c.EstimatedCostUsd
+			}
+		}
+	}
+	return s.buildCatalogUsage(rows, costs, false), nil
+}
+
+// GetAgentUsage returns usage per agent name (stage agents and sub-agents)
+// for sessions created since the given time.
+func (s *SessionService) GetAgentUsage(ctx context.Context, since time.Time) (map[string]models.CatalogUsage, error) {
+	sessionPreds := catalogSessionPreds(since)
+
+	var rows []catalogUsageRow
+	err := s.client.AgentExecution.Query().
+		Where(agentexecution.HasSessionWith(sessionPreds...)).
+		Modify(func(sel *sql.Selector) {
+			sel.Select(sql.As(sel.C(agentexecution.FieldAgentName), "key"))
+			sel.AppendSelectAs(fmt.Sprintf("COUNT(DISTINCT %s)", sel.C(agentexecution.FieldSessionID)), "sessions")
+			sel.AppendSelectAs("COUNT(*)", "invocations")
+			sel.AppendSelectAs(catalogCompletedSQL, "succeeded")
+			sel.AppendSelectAs(catalogFinishedSQL, "finished")
+			sel.AppendSelectAs(fmt.Sprintf("MAX(%s)", sel.C(agentexecution.FieldStartedAt)), "last_used_at")
+			sel.GroupBy(sel.C(agentexecution.FieldAgentName))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate agent usage: %w", err)
+	}
+
+	costs := map[string]float64{}
+	if s.costEstimationEnabled {
+		var costRows []struct {
+			AgentName string             `json:"agent_name"`
+			CostSum   stdsql.NullFloat64 `json:"cost_sum"`
+		}
+		err := s.client.LLMInteraction.Query().
+			Where(llminteraction.HasSessionWith(sessionPreds...)).
+			Modify(func(sel *sql.Selector) {
+				ae := sql.Table(agentexecution.Table).As("ae")
+				sel.Join(ae).On(sel.C(llminteraction.FieldExecutionID), ae.C(agentexecution.FieldID))
+				sel.Select(sql.As(ae.C(agentexecution.FieldAgentName), "agent_name"))
+				sel.AppendSelectAs(
+					fmt.Sprintf("COALESCE(SUM(%s), 0)", sel.C(llminteraction.FieldEstimatedCostUsd)),
+					"cost_sum",
+				)
+				sel.GroupBy(ae.C(agentexecution.FieldAgentName))
+			}).
+			Scan(ctx, &costRows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate agent cost: %w", err)
+		}
+		for _, row := range costRows {
+			costs[row.AgentName] = row.CostSum.Float64
+		}
+	}
+	return s.buildCatalogUsage(rows, costs, true), nil
+}
+
+// GetMCPServerUsage returns tool-call usage per MCP server ID for sessions
+// created since the given time. Tool listings are not counted.
+func (s *SessionService) GetMCPServerUsage(ctx context.Context, since time.Time) (map[string]models.CatalogUsage, error) {
+	var rows []catalogUsageRow
+	err := s.client.MCPInteraction.Query().
+		Where(
+			mcpinteraction.InteractionTypeEQ(mcpinteraction.InteractionTypeToolCall),
+			mcpinteraction.HasSessionWith(catalogSessionPreds(since)...),
+		).
+		Modify(func(sel *sql.Selector) {
+			sel.Select(sql.As(sel.C(mcpinteraction.FieldServerName), "key"))
+			sel.AppendSelectAs(fmt.Sprintf("COUNT(DISTINCT %s)", sel.C(mcpinteraction.FieldSessionID)), "sessions")
+			sel.AppendSelectAs("COUNT(*)", "invocations")
+			sel.AppendSelectAs(
+				fmt.Sprintf("COUNT(*) FILTER (WHERE %s IS NULL)", sel.C(mcpinteraction.FieldErrorMessage)),
+				"succeeded",
+			)
+			sel.AppendSelectAs("COUNT(*)", "finished")
+			sel.AppendSelectAs(fmt.Sprintf("MAX(%s)", sel.C(mcpinteraction.FieldCreatedAt)), "last_used_at")
+			sel.GroupBy(sel.C(mcpinteraction.FieldServerName))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate MCP server usage: %w", err)
+	}
+	return s.buildCatalogUsage(rows, nil, true), nil
+}
+
+// mcpToolUsageRow is the per-(server, tool) aggregate scanned by GetMCPToolUsage.
+type mcpToolUsageRow struct {
+	Server         string             `json:"server"`
+	Tool           string             `json:"tool"`
+	Sessions       int                `json:"sessions"`
+	Calls          int                `json:"calls"`
+	Failed         int                `json:"failed"`
+	AvgDurationMs  stdsql.NullFloat64 `json:"avg_duration_ms"`
+	AvgResultBytes stdsql.NullFloat64 `json:"avg_result_bytes"`
+	LastUsedAt     stdsql.NullTime    `json:"last_used_at"`
+}
+
+// GetMCPToolUsage returns tool call statistics keyed by server name, then
+// tool name, for sessions created since the given time (soft-deleted
+// sessions excluded). Result size is the length of the uncompressed JSON result.
+func (s *SessionService) GetMCPToolUsage(ctx context.Context, since time.Time) (map[string]map[string]models.MCPToolUsage, error) {
+	var rows []mcpToolUsageRow
+	err := s.client.MCPInteraction.Query().
+		Where(
+			mcpinteraction.InteractionTypeEQ(mcpinteraction.InteractionTypeToolCall),
+			mcpinteraction.HasSessionWith(catalogSessionPreds(since)...),
+		).
+		Modify(func(sel *sql.Selector) {
+			tool := fmt.Sprintf("COALESCE(%s, '')", sel.C(mcpinteraction.FieldToolName))
+			sel.Select(sql.As(sel.C(mcpinteraction.FieldServerName), "server"))
+			sel.AppendSelectAs(tool, "tool")
+			sel.AppendSelectAs(fmt.Sprintf("COUNT(DISTINCT %s)", sel.C(mcpinteraction.FieldSessionID)), "sessions")
+			sel.AppendSelectAs("COUNT(*)", "calls")
+			sel.AppendSelectAs(
+				fmt.Sprintf("COUNT(*) FILTER (WHERE %s IS NOT NULL)", sel.C(mcpinteraction.FieldErrorMessage)),
+				"failed",
+			)
+			sel.AppendSelectAs(fmt.Sprintf("AVG(%s)::float8", sel.C(mcpinteraction.FieldDurationMs)), "avg_duration_ms")
+			sel.AppendSelectAs(
+				fmt.Sprintf("AVG(COALESCE(octet_length(%s::text), %s))::float8",
+					sel.C(mcpinteraction.FieldToolResult), sel.C(mcpinteraction.FieldToolResultBytes)),
+				"avg_result_bytes",
+			)
+			sel.AppendSelectAs(fmt.Sprintf("MAX(%s)", sel.C(mcpinteraction.FieldCreatedAt)), "last_used_at")
+			sel.GroupBy(sel.C(mcpinteraction.FieldServerName), tool)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate MCP tool usage: %w", err)
+	}
+
+	out := make(map[string]map[string]models.MCPToolUsage)
+	for _, row := range rows {
+		usage := models.MCPToolUsage{
+			Calls:     row.Calls,
+			Sessions:  row.Sessions,
+			ErrorRate: ratio(row.Failed, row.Calls),
+		}
+		if row.AvgDurationMs.Valid {
+			v := row.AvgDurationMs.Float64
+			usage.AvgDurationMs = &v
+		}
+		if row.AvgResultBytes.Valid {
+			v := row.AvgResultBytes.Float64
+			usage.AvgResultBytes = &v
+		}
+		if row.LastUsedAt.Valid {
+			t := row.LastUsedAt.Time
+			usage.LastUsedAt = &t
+		}
+		if out[row.Server] == nil {
+			out[row.Server] = map[string]models.MCPToolUsage{}
+		}
+		out[row.Server][row.Tool] = usage
+	}
+	return out, nil
+}
+
+// buildCatalogUsage converts aggregate rows into usage keyed by row key.
+// costs is keyed the same way; a nil map means cost does not apply.
+func (s *SessionService) buildCatalogUsage(rows []catalogUsageRow, costs map[string]float64, withInvocations bool) map[string]models.CatalogUsage {
+	out := make(map[string]models.CatalogUsage, len(rows))
+	for _, row := range rows {
+		usage := models.CatalogUsage{
+			Sessions:    row.Sessions,
+			SuccessRate: ratio(row.Succeeded, row.Finished),
+		}
+		if withInvocations {
+			usage.Invocations = row.Invocations
+		}
+		if row.LastUsedAt.Valid {
+			t := row.LastUsedAt.Time
+			usage.LastUsedAt = &t
+		}
+		if costs != nil && s.costEstimationEnabled && row.Sessions > 0 {
+			avg := costs[row.Key] / float64(row.Sessions)
+			usage.AvgCostUsd = &avg
+		}
+		out[row.Key] = usage
+	}
+	return out
+}
+
+// ratio returns n / d, or nil when d is zero.
+func ratio(n, d int) *float64 {
+	if d == 0 {
+		return nil
+	}
+	r := float64(n) / float64(d)
+	return &r
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/mcpinteraction"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_CatalogUsage(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	since := now.Add(-30 * 24 * time.Hour)
+
+	okID, okStage, okExec := seedUsageSession(t, client.Client, usageSeed{
+		AlertData: "ok", AlertType: "pod-crash", ChainID: "k8s-analysis", CreatedAt: now.Add(-time.Hour),
+	})
+	seedLLMInteraction(t, client.Client, okID, okStage, okExec, "model-a", 100, 50, 150, floatPtr(0.10), 0)
+
+	failID, failStage, failExec := seedUsageSession(t, client.Client, usageSeed{
+		AlertData: "failed", AlertType: "pod-crash", ChainID: "k8s-analysis", CreatedAt: now.Add(-2 * time.Hour),
+	})
+	client.AlertSession.UpdateOneID(failID).SetStatus(alertsession.StatusFailed).ExecX(ctx)
+	seedLLMInteraction(t, client.Client, failID, failStage, failExec, "model-a", 100, 50, 150, floatPtr(0.30), 0)
+
+	oldID, oldStage, oldExec := seedUsageSession(t, client.Client, usageSeed{
+		AlertData: "old", AlertType: "pod-crash", ChainID: "k8s-analysis", CreatedAt: since.Add(-time.Hour),
+	})
+	seedLLMInteraction(t, client.Client, oldID, oldStage, oldExec, "model-a", 100, 50, 150, floatPtr(5.0), 0)
+
+	seedMCPToolCall := func(sessionID, stageID, execID, server string, failed bool) {
+		create := client.MCPInteraction.Create().
+			SetID(uuid.New().String()).
+			SetSessionID(sessionID).
+			SetStageID(stageID).
+			SetExecutionID(execID).
+			SetInteractionType(mcpinteraction.InteractionTypeToolCall).
+			SetServerName(server).
+			SetToolName("get_pods").
+			SetDurationMs(100)
+		if failed {
+			create = create.SetErrorMessage("boom")
+		} else {
+			create = create.SetToolResult(map[string]interface{}{"output": "pod-1"})
+		}
+		create.SaveX(ctx)
+	}
+	seedMCPToolCall(okID, okStage, okExec, "kubernetes-server", false)
+	seedMCPToolCall(okID, okStage, okExec, "kubernetes-server", false)
+	seedMCPToolCall(failID, failStage, failExec, "kubernetes-server", true)
+	seedMCPToolCall(oldID, oldStage, oldExec, "kubernetes-server", true)
+
+	t.Run("chains", func(t *testing.T) {
+		usage, err := service.GetChainUsage(ctx, since)
+		require.NoError(t, err)
+		require.Contains(t, usage, "k8s-analysis")
+
+		u := usage["k8s-analysis"]
+		assert.Equal(t, 2, u.Sessions)
+		assert.Zero(t, u.Invocations)
+		require.NotNil(t, u.SuccessRate)
+		assert.InDelta(t, 0.5, *u.SuccessRate, 1e-9)
+		require.NotNil(t, u.AvgCostUsd)
+		assert.InDelta(t, 0.20, *u.AvgCostUsd, 1e-9)
+		assert.NotNil(t, u.LastUsedAt)
+	})
+
+	t.Run("agents", func(t *testing.T) {
+		usage, err := service.GetAgentUsage(ctx, since)
+		require.NoError(t, err)
+		require.Contains(t, usage, "TestAgent")
+
+		u := usage["TestAgent"]
+		assert.Equal(t, 2, u.Sessions)
+		assert.Equal(t, 2, u.Invocations)
+		require.NotNil(t, u.SuccessRate)
+		assert.InDelta(t, 1.0, *u.SuccessRate, 1e-9)
+		require.NotNil(t, u.AvgCostUsd)
+		assert.InDelta(t, 0.20, *u.AvgCostUsd, 1e-9)
+	})
+
+	t.Run("mcp servers", func(t *testing.T) {
+		usage, err := service.GetMCPServerUsage(ctx, since)
+		require.NoError(t, err)
+		require.Contains(t, usage, "kubernetes-server")
+
+		u := usage["kubernetes-server"]
+		assert.Equal(t, 2, u.Sessions)
+		assert.Equal(t, 3, u.Invocations)
+		require.NotNil(t, u.SuccessRate)
+		assert.InDelta(t, 2.0/3.0, *u.SuccessRate, 1e-9)
+		assert.Nil(t, u.AvgCostUsd)
+	})
+
+	t.Run("mcp tools", func(t *testing.T) {
+		usage, err := service.GetMCPToolUsage(ctx, since)
+		require.NoError(t, err)
+		require.Contains(t, usage, "kubernetes-server")
+		require.Contains(t, usage["kubernetes-server"], "get_pods")
+
+		u := usage["kubernetes-server"]["get_pods"]
+		assert.Equal(t, 3, u.Calls)
+		assert.Equal(t, 2, u.Sessions)
+		require.NotNil(t, u.ErrorRate)
+		assert.InDelta(t, 1.0/3.0, *u.ErrorRate, 1e-9)
+		require.NotNil(t, u.AvgDurationMs)
+		assert.InDelta(t, 100, *u.AvgDurationMs, 1e-9)
+		require.NotNil(t, u.AvgResultBytes)
+		assert.Positive(t, *u.AvgResultBytes)
+		assert.NotNil(t, u.LastUsedAt)
+	})
+}
+
+func TestRatio(t *testing.T) {
+	assert.Nil(t, ratio(1, 0))
+	r := ratio(1, 4)
+	require.NotNil(t, r)
+	assert.InDelta(t, 0.25, *r, 1e-9)
+}
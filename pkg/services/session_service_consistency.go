@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/stage"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+)
+
+// Kinds of writes a ConsistencyToken is issued for.
+const (
+	ConsistencySubmit = "submit" // alert submitted: the session exists
+	ConsistencyCancel = "cancel" // cancel requested: cancellation has finished
+	ConsistencyChat   = "chat"   // chat message sent: its question is on the timeline
+)
+
+// consistencyTokenVersion prefixes encoded tokens so the format can change.
+const consistencyTokenVersion = "1"
+
+// ConsistencyToken identifies a write whose effects a later read must
+// reflect. Mutating endpoints return it encoded; GETs presenting it wait
+// until WriteReflected holds. Some effects land asynchronously — the owning
+// pod finishes a cancellation, the chat executor records the question — so
+// a read right after the write can otherwise be stale.
+type ConsistencyToken struct {
+	Kind      string
+	SessionID string
+	StageID   string // chat stage (ConsistencyChat)
+}
+
+// Encode returns the opaque token string.
+func (t ConsistencyToken) Encode() string {
+	raw := strings.Join([]string{consistencyTokenVersion, t.Kind, t.SessionID, t.StageID}, ":")
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseConsistencyToken decodes a token returned by Encode.
+func ParseConsistencyToken(s string) (ConsistencyToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ConsistencyToken{}, NewValidationError("consistency_token", "malformed token")
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 4 || parts[0] != consistencyTokenVersion || parts[2] == "" {
+		return ConsistencyToken{}, NewValidationError("consistency_token", "malformed token")
+	}
+	t := ConsistencyToken{Kind: parts[1], SessionID: parts[2], StageID: parts[3]}
+	switch t.Kind {
+	case ConsistencySubmit, ConsistencyCancel:
+	case ConsistencyChat:
+		if t.StageID == "" {
+			return ConsistencyToken{}, NewValidationError("consistency_token", "malformed token")
+		}
+	default:
+		return ConsistencyToken{}, NewValidationError("consistency_token", fmt.Sprintf("unknown kind %q", t.Kind))
+	}
+	return t, nil
+}
+
+// WriteReflected reports whether reads now reflect the write the token was
+// issued for. A deleted session counts as reflected: there is nothing left
+// to wait for.
+func (s *SessionService) WriteReflected(ctx context.Context, t ConsistencyToken) (bool, error) {
+	switch t.Kind {
+	case ConsistencySubmit:
+		exists, err := s.client.AlertSession.Query().
+			Where(alertsession.IDEQ(t.SessionID)).
+			Exist(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to check session: %w", err)
+		}
+		return exists, nil
+
+	case ConsistencyCancel:
+		// The session left cancelling and no chat response is still running
+		cancelling, err := s.client.AlertSession.Query().
+			Where(alertsession.IDEQ(t.SessionID), alertsession.StatusEQ(alertsession.StatusCancelling)).
+			Exist(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to check session status: %w", err)
+		}
+		if cancelling {
+			return false, nil
+		}
+		chatRunning, err := s.client.Stage.Query().
+			Where(
+				stage.SessionIDEQ(t.SessionID),
+				stage.StageTypeEQ(stage.StageTypeChat),
+				stage.StatusIn(stage.StatusPending, stage.StatusActive),
+			).
+			Exist(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to check chat stages: %w", err)
+		}
+		return !chatRunning, nil
+
+	case ConsistencyChat:
+		// The executor records the question first; a stage that ended
+		// without it (execution failed to start) has nothing more to show.
+		asked, err := s.client.TimelineEvent.Query().
+			Where(
+				timelineevent.StageIDEQ(t.StageID),
+				timelineevent.EventTypeEQ(timelineevent.EventTypeUserQuestion),
+			).
+			Exist(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to check chat question: %w", err)
+		}
+		if asked {
+			return true, nil
+		}
+		open, err := s.client.Stage.Query().
+			Where(stage.IDEQ(t.StageID), stage.StatusIn(stage.StatusPending, stage.StatusActive)).
+			Exist(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed to check chat stage: %w", err)
+		}
+		return !open, nil
+	}
+	return false, fmt.Errorf("unknown consistency token kind %q", t.Kind)
+}
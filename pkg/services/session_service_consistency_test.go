@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/stage"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConsistencyToken(t *testing.T) {
+	for _, tok := range []ConsistencyToken{
+		{Kind: ConsistencySubmit, SessionID: "s1"},
+		{Kind: ConsistencyCancel, SessionID: "s1"},
+		{Kind: ConsistencyChat, SessionID: "s1", StageID: "stg-1"},
+	} {
+		got, err := ParseConsistencyToken(tok.Encode())
+		require.NoError(t, err)
+		assert.Equal(t, tok, got)
+	}
+
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+	for name, raw := range map[string]string{
+		"not base64":         "%%%",
+		"wrong version":      encode("2:submit:s1:"),
+		"missing session":    encode("1:submit::"),
+		"chat without stage": encode("1:chat:s1:"),
+		"unknown kind":       encode("1:resolve:s1:"),
+		"too few parts":      encode("1:submit:s1"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseConsistencyToken(raw)
+			require.Error(t, err)
+			assert.True(t, IsValidationError(err))
+		})
+	}
+}
+
+func TestSessionService_WriteReflected(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	stageService := NewStageService(client.Client)
+	chatService := NewChatService(client.Client)
+	ctx := context.Background()
+
+	t.Run("submit", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusPending)
+		reflected, err := service.WriteReflected(ctx, ConsistencyToken{Kind: ConsistencySubmit, SessionID: id})
+		require.NoError(t, err)
+		assert.True(t, reflected)
+
+		reflected, err = service.WriteReflected(ctx, ConsistencyToken{Kind: ConsistencySubmit, SessionID: uuid.New().String()})
+		require.NoError(t, err)
+		assert.False(t, reflected)
+	})
+
+	t.Run("cancel waits for the owning pod", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+		require.NoError(t, service.CancelSession(ctx, id, "", "alice"))
+		tok := ConsistencyToken{Kind: ConsistencyCancel, SessionID: id}
+
+		reflected, err := service.WriteReflected(ctx, tok)
+		require.NoError(t, err)
+		assert.False(t, reflected, "still cancelling")
+
+		require.NoError(t, service.UpdateSessionStatus(ctx, id, alertsession.StatusCancelled))
+		reflected, err = service.WriteReflected(ctx, tok)
+		require.NoError(t, err)
+		assert.True(t, reflected)
+	})
+
+	t.Run("chat waits for the question", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusCompleted)
+		chatObj, err := chatService.CreateChat(ctx, models.CreateChatRequest{SessionID: id, CreatedBy: "alice"})
+		require.NoError(t, err)
+		chatID := chatObj.ID
+		stg, err := stageService.CreateStage(ctx, models.CreateStageRequest{
+			SessionID:          id,
+			StageName:          "Chat",
+			StageIndex:         1,
+			ExpectedAgentCount: 1,
+			ChatID:             &chatID,
+		})
+		require.NoError(t, err)
+		chatTok := ConsistencyToken{Kind: ConsistencyChat, SessionID: id, StageID: stg.ID}
+		cancelTok := ConsistencyToken{Kind: ConsistencyCancel, SessionID: id}
+
+		reflected, err := service.WriteReflected(ctx, chatTok)
+		require.NoError(t, err)
+		assert.False(t, reflected, "question not recorded yet")
+		reflected, err = service.WriteReflected(ctx, cancelTok)
+		require.NoError(t, err)
+		assert.False(t, reflected, "chat response still running")
+
+		stageID := stg.ID
+		_, err = NewTimelineService(client.Client).CreateTimelineEvent(ctx, models.CreateTimelineEventRequest{
+			SessionID:      id,
+			StageID:        &stageID,
+			SequenceNumber: 1,
+			EventType:      timelineevent.EventTypeUserQuestion,
+			Status:         timelineevent.StatusCompleted,
+			Content:        "why?",
+		})
+		require.NoError(t, err)
+		reflected, err = service.WriteReflected(ctx, chatTok)
+		require.NoError(t, err)
+		assert.True(t, reflected)
+
+		require.NoError(t, client.Stage.UpdateOneID(stg.ID).SetStatus(stage.StatusCancelled).Exec(ctx))
+		reflected, err = service.WriteReflected(ctx, cancelTok)
+		require.NoError(t, err)
+		assert.True(t, reflected)
+	})
+}
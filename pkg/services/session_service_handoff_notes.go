@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/handoffnoterevision"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	"github.com/google/uuid"
+)
+
+// MaxHandoffNotesLength caps the size of a session's handoff notes.
+const MaxHandoffNotesLength = 50000
+
+// GetHandoffNotes returns the session with its current handoff notes.
+func (s *SessionService) GetHandoffNotes(ctx context.Context, sessionID string) (*ent.AlertSession, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+	session, err := s.client.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID), alertsession.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return session, nil
+}
+
+// UpdateHandoffNotes replaces a session's handoff notes and appends the new
+// text as a revision by author. Notes can be edited in any session state.
+// When req.BaseRevision is set and another revision was saved since, the edit
+// is rejected with ErrConflict. Saving unchanged content is a no-op.
+func (s *SessionService) UpdateHandoffNotes(_ context.Context, sessionID, author string, req models.UpdateHandoffNotesRequest) (*ent.AlertSession, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+	content := strings.TrimSpace(req.Content)
+	if len(content) > MaxHandoffNotesLength {
+		return nil, NewValidationError("content", fmt.Sprintf("must be at most %d characters", MaxHandoffNotesLength))
+	}
+	if req.BaseRevision != nil && *req.BaseRevision < 0 {
+		return nil, NewValidationError("base_revision", "must not be negative")
+	}
+
+	writeCtx, cancel := context.WithTimeoutCause(
+		context.Background(), 5*time.Second,
+		fmt.Errorf("update handoff notes for %s: db write timed out", sessionID),
+	)
+	defer cancel()
+
+	tx, err := s.client.Tx(writeCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	session, err := tx.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID), alertsession.DeletedAtIsNil()).
+		Only(writeCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	base := session.HandoffNotesRevision
+	if req.BaseRevision != nil && *req.BaseRevision != base {
+		return nil, ErrConflict
+	}
+	current := ""
+	if session.HandoffNotes != nil {
+		current = *session.HandoffNotes
+	}
+	if content == current {
+		return session, nil
+	}
+
+	now := time.Now()
+	update := tx.AlertSession.Update().
+		Where(
+			alertsession.IDEQ(sessionID),
+			alertsession.HandoffNotesRevisionEQ(base),
+		).
+		SetHandoffNotesRevision(base + 1).
+		SetHandoffNotesUpdatedBy(author).
+		SetHandoffNotesUpdatedAt(now)
+	if content == "" {
+		update.ClearHandoffNotes()
+	} else {
+		update.SetHandoffNotes(content)
+	}
+	affected, err := update.Save(writeCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update handoff notes: %w", err)
+	}
+	if affected == 0 {
+		return nil, ErrConflict
+	}
+
+	if err := tx.HandoffNoteRevision.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetRevision(base + 1).
+		SetContent(content).
+		SetAuthor(author).
+		SetCreatedAt(now).
+		Exec(writeCtx); err != nil {
+		return nil, fmt.Errorf("failed to insert handoff note revision: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit handoff notes: %w", err)
+	}
+
+	session.HandoffNotesRevision = base + 1
+	session.HandoffNotesUpdatedBy = &author
+	session.HandoffNotesUpdatedAt = &now
+	session.HandoffNotes = nil
+	if content != "" {
+		session.HandoffNotes = &content
+	}
+	return session, nil
+}
+
+// ListHandoffNoteRevisions returns every saved revision of a session's
+// handoff notes, newest first.
+func (s *SessionService) ListHandoffNoteRevisions(ctx context.Context, sessionID string) ([]*ent.HandoffNoteRevision, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+	exists, err := s.client.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID), alertsession.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check session: %w", err)
+	}
+	if !exists {
+		return nil, ErrNotFound
+	}
+
+	revisions, err := s.client.HandoffNoteRevision.Query().
+		Where(handoffnoterevision.SessionIDEQ(sessionID)).
+		Order(ent.Desc(handoffnoterevision.FieldRevision)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list handoff note revisions: %w", err)
+	}
+	return revisions, nil
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_HandoffNotes(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	rev := func(n int) *int { return &n }
+
+	t.Run("records revisions with authors", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusCompleted)
+
+		session, err := service.GetHandoffNotes(ctx, id)
+		require.NoError(t, err)
+		assert.Nil(t, session.HandoffNotes)
+		assert.Equal(t, 0, session.HandoffNotesRevision)
+
+		session, err = service.UpdateHandoffNotes(ctx, id, "alice", models.UpdateHandoffNotesRequest{
+			Content: "  ## Status\n\nRolled back deploy-1234.  ", BaseRevision: rev(0),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, session.HandoffNotes)
+		assert.Equal(t, "## Status\n\nRolled back deploy-1234.", *session.HandoffNotes)
+		assert.Equal(t, 1, session.HandoffNotesRevision)
+		assert.Equal(t, "alice", *session.HandoffNotesUpdatedBy)
+
+		session, err = service.UpdateHandoffNotes(ctx, id, "bob", models.UpdateHandoffNotesRequest{
+			Content: "Watching memory overnight.", BaseRevision: rev(1),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, session.HandoffNotesRevision)
+
+		detail, err := service.GetSessionDetail(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, detail.HandoffNotes)
+		assert.Equal(t, "Watching memory overnight.", *detail.HandoffNotes)
+		assert.Equal(t, 2, detail.HandoffNotesRevision)
+		assert.Equal(t, "bob", *detail.HandoffNotesUpdatedBy)
+
+		revisions, err := service.ListHandoffNoteRevisions(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, 2, revisions[0].Revision)
+		assert.Equal(t, "bob", revisions[0].Author)
+		assert.Equal(t, "## Status\n\nRolled back deploy-1234.", revisions[1].Content)
+		assert.Equal(t, "alice", revisions[1].Author)
+	})
+
+	t.Run("rejects stale base revision", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+
+		_, err := service.UpdateHandoffNotes(ctx, id, "alice", models.UpdateHandoffNotesRequest{Content: "first"})
+		require.NoError(t, err)
+
+		_, err = service.UpdateHandoffNotes(ctx, id, "bob", models.UpdateHandoffNotesRequest{
+			Content: "edited from revision 0", BaseRevision: rev(0),
+		})
+		assert.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("unchanged content adds no revision", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusCompleted)
+
+		_, err := service.UpdateHandoffNotes(ctx, id, "alice", models.UpdateHandoffNotesRequest{Content: "same"})
+		require.NoError(t, err)
+		session, err := service.UpdateHandoffNotes(ctx, id, "bob", models.UpdateHandoffNotesRequest{Content: "same "})
+		require.NoError(t, err)
+		assert.Equal(t, 1, session.HandoffNotesRevision)
+		assert.Equal(t, "alice", *session.HandoffNotesUpdatedBy)
+	})
+
+	t.Run("clearing keeps history", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusCompleted)
+
+		_, err := service.UpdateHandoffNotes(ctx, id, "alice", models.UpdateHandoffNotesRequest{Content: "temporary"})
+		require.NoError(t, err)
+		session, err := service.UpdateHandoffNotes(ctx, id, "alice", models.UpdateHandoffNotesRequest{Content: ""})
+		require.NoError(t, err)
+		assert.Nil(t, session.HandoffNotes)
+		assert.Equal(t, 2, session.HandoffNotesRevision)
+
+		revisions, err := service.ListHandoffNoteRevisions(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Empty(t, revisions[0].Content)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		_, err := service.UpdateHandoffNotes(ctx, uuid.New().String(), "alice", models.UpdateHandoffNotesRequest{Content: "x"})
+		assert.ErrorIs(t, err, ErrNotFound)
+
+		_, err = service.ListHandoffNoteRevisions(ctx, uuid.New().String())
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("validates content", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusCompleted)
+
+		_, err := service.UpdateHandoffNotes(ctx, id, "alice", models.UpdateHandoffNotesRequest{
+			Content: strings.Repeat("x", MaxHandoffNotesLength+1),
+		})
+		assert.True(t, IsValidationError(err))
+	})
+}
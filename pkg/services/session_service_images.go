@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/chat"
+	"github.com/codeready-toolchain/tarsy/ent/chatusermessage"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// GetSessionImage returns an image attached to the session's alert or to one
+// of its chat questions, with its content. Images of other sessions are
+// reported as ErrNotFound.
+func (s *SessionService) GetSessionImage(ctx context.Context, sessionID, imageID string) (*schema.ImageRef, []byte, error) {
+	if sessionID == "" {
+		return nil, nil, NewValidationError("sessionID", "required")
+	}
+	if imageID == "" {
+		return nil, nil, NewValidationError("imageID", "required")
+	}
+
+	session, err := s.client.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID), alertsession.DeletedAtIsNil()).
+		Select(alertsession.FieldAlertImages).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	ref := findImageRef(session.AlertImages, imageID)
+
+	if ref == nil {
+		msgs, err := s.client.ChatUserMessage.Query().
+			Where(
+				chatusermessage.HasChatWith(chat.SessionIDEQ(sessionID)),
+				chatusermessage.ImagesNotNil(),
+			).
+			Select(chatusermessage.FieldImages).
+			All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get chat images: %w", err)
+		}
+		for _, msg := range msgs {
+			if ref = findImageRef(msg.Images, imageID); ref != nil {
+				break
+			}
+		}
+	}
+	if ref == nil {
+		return nil, nil, ErrNotFound
+	}
+
+	data, err := LoadImage(ctx, *ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ref, data, nil
+}
+
+// toMessageImages converts stored image references for API responses.
+func toMessageImages(refs []schema.ImageRef) []models.MessageImage {
+	if len(refs) == 0 {
+		return nil
+	}
+	out := make([]models.MessageImage, len(refs))
+	for i, ref := range refs {
+		out[i] = models.MessageImage{
+			ID:        ref.ID,
+			MimeType:  ref.MimeType,
+			Name:      ref.Name,
+			SizeBytes: ref.SizeBytes,
+		}
+	}
+	return out
+}
+
+func findImageRef(refs []schema.ImageRef, imageID string) *schema.ImageRef {
+	for i := range refs {
+		if refs[i].ID == imageID {
+			return &refs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/google/uuid"
+)
+
+// MaxDisableReasonLength caps the reason recorded when disabling an MCP server.
+const MaxDisableReasonLength = 1000
+
+// Metadata keys of mcp_server_disabled timeline events.
+const (
+	MetadataKeyServerName = "server_name"
+	MetadataKeyReason     = "reason"
+)
+
+// DisableMCPServer turns off an MCP server for the rest of a session, e.g.
+// when it returns garbage. It is recorded as an mcp_server_disabled timeline
+// event: running agents drop the server's tools at their next iteration, and
+// later stages and chat turns start without it. Disabling a server that is
+// already disabled returns ErrAlreadyExists.
+func (s *SessionService) DisableMCPServer(_ context.Context, sessionID, serverID, author, reason string) (*ent.TimelineEvent, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+	if serverID == "" {
+		return nil, NewValidationError("server_id", "required")
+	}
+	if !s.mcpServerRegistry.Has(serverID) {
+		return nil, NewValidationError("server_id", fmt.Sprintf("unknown MCP server %q", serverID))
+	}
+	reason = strings.TrimSpace(reason)
+	if len(reason) > MaxDisableReasonLength {
+		return nil, NewValidationError("reason", fmt.Sprintf("must be at most %d characters", MaxDisableReasonLength))
+	}
+
+	// Use background context with timeout for critical write
+	bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	session, err := s.client.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID), alertsession.DeletedAtIsNil()).
+		Only(bgCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	disabled, err := s.ListDisabledMCPServers(bgCtx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range disabled {
+		if DisabledMCPServerID(event) == serverID {
+			return nil, ErrAlreadyExists
+		}
+	}
+
+	seq, err := s.nextTimelineSequence(bgCtx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	content := fmt.Sprintf("MCP server %s disabled for the rest of the session", serverID)
+	if reason != "" {
+		content += ": " + reason
+	}
+	now := time.Now()
+	create := s.client.TimelineEvent.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetNillableStageID(session.CurrentStageID).
+		SetSequenceNumber(seq).
+		SetEventType(timelineevent.EventTypeMcpServerDisabled).
+		SetStatus(timelineevent.StatusCompleted).
+		SetNillableHighlight(DefaultHighlight(timelineevent.EventTypeMcpServerDisabled)).
+		SetMetadata(map[string]interface{}{
+			MetadataKeyServerName: serverID,
+			MetadataKeyReason:     reason,
+			"author":              author,
+		}).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+	compressed := setTimelineContent(create.Mutation(), content)
+
+	event, err := create.Save(bgCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record disabled MCP server: %w", err)
+	}
+	if compressed {
+		if err := indexCompressedContent(bgCtx, s.client, event.ID, content); err != nil {
+			return nil, fmt.Errorf("failed to index disabled MCP server event: %w", err)
+		}
+	}
+	event.Content, event.ContentZstd = content, nil
+	return event, nil
+}
+
+// ListDisabledMCPServers returns a session's mcp_server_disabled events in
+// the order the servers were disabled.
+func (s *SessionService) ListDisabledMCPServers(ctx context.Context, sessionID string) ([]*ent.TimelineEvent, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+	events, err := QueryDisabledMCPServers(ctx, s.client, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disabled MCP servers: %w", err)
+	}
+	return events, nil
+}
+
+// QueryDisabledMCPServers returns a session's mcp_server_disabled events in
+// sequence order. Shared with the executors, which apply them to running agents.
+func QueryDisabledMCPServers(ctx context.Context, client *ent.Client, sessionID string) ([]*ent.TimelineEvent, error) {
+	events, err := client.TimelineEvent.Query().
+		Where(
+			timelineevent.SessionIDEQ(sessionID),
+			timelineevent.EventTypeEQ(timelineevent.EventTypeMcpServerDisabled),
+		).
+		Order(ent.Asc(timelineevent.FieldSequenceNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return events, DecompressTimelineEvents(events...)
+}
+
+// DisabledMCPServerID returns the server an mcp_server_disabled event turned off.
+func DisabledMCPServerID(event *ent.TimelineEvent) string {
+	id, _ := event.Metadata[MetadataKeyServerName].(string)
+	return id
+}
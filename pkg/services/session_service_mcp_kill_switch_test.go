@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_DisableMCPServer(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	t.Run("records disabled server", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+
+		event, err := service.DisableMCPServer(ctx, id, "kubernetes-server", "alice", "  returns garbage  ")
+		require.NoError(t, err)
+		assert.Equal(t, timelineevent.EventTypeMcpServerDisabled, event.EventType)
+		assert.Equal(t, timelineevent.StatusCompleted, event.Status)
+		assert.Equal(t, "kubernetes-server", DisabledMCPServerID(event))
+		assert.Equal(t, "returns garbage", event.Metadata[MetadataKeyReason])
+		assert.Equal(t, "alice", event.Metadata["author"])
+		assert.Contains(t, event.Content, "returns garbage")
+
+		second, err := service.DisableMCPServer(ctx, id, "test-server", "bob", "")
+		require.NoError(t, err)
+		assert.Greater(t, second.SequenceNumber, event.SequenceNumber)
+
+		disabled, err := service.ListDisabledMCPServers(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, disabled, 2)
+		assert.Equal(t, event.ID, disabled[0].ID)
+		assert.Equal(t, second.ID, disabled[1].ID)
+	})
+
+	t.Run("accepts completed session for later chat turns", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusCompleted)
+
+		_, err := service.DisableMCPServer(ctx, id, "kubernetes-server", "alice", "")
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects already disabled server", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+
+		_, err := service.DisableMCPServer(ctx, id, "kubernetes-server", "alice", "")
+		require.NoError(t, err)
+		_, err = service.DisableMCPServer(ctx, id, "kubernetes-server", "bob", "")
+		assert.ErrorIs(t, err, ErrAlreadyExists)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		_, err := service.DisableMCPServer(ctx, uuid.New().String(), "kubernetes-server", "alice", "")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("validates input", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+
+		_, err := service.DisableMCPServer(ctx, id, "", "alice", "")
+		assert.True(t, IsValidationError(err))
+
+		_, err = service.DisableMCPServer(ctx, id, "no-such-server", "alice", "")
+		assert.True(t, IsValidationError(err))
+
+		_, err = service.DisableMCPServer(ctx, id, "kubernetes-server", "alice", strings.Repeat("x", MaxDisableReasonLength+1))
+		assert.True(t, IsValidationError(err))
+	})
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/google/uuid"
+)
+
+// MaxOperatorNoteLength caps the size of a single operator note.
+const MaxOperatorNoteLength = 4000
+
+// AddOperatorNote records a note from an operator on a queued or running
+// session as an operator_note timeline event. The executor delivers it to the
+// running agent at its next iteration and to every later stage. The note is
+// attached to the session's current stage, if any.
+func (s *SessionService) AddOperatorNote(_ context.Context, sessionID, author, content string) (*ent.TimelineEvent, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, NewValidationError("content", "required")
+	}
+	if len(content) > MaxOperatorNoteLength {
+		return nil, NewValidationError("content", fmt.Sprintf("must be at most %d characters", MaxOperatorNoteLength))
+	}
+
+	// Use background context with timeout for critical write
+	bgCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	session, err := s.client.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID), alertsession.DeletedAtIsNil()).
+		Only(bgCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session.Status != alertsession.StatusPending && session.Status != alertsession.StatusInProgress {
+		return nil, ErrSessionNotActive
+	}
+
+	seq, err := s.nextTimelineSequence(bgCtx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	create := s.client.TimelineEvent.Create().
+		SetID(uuid.New().String()).
+		SetSessionID(sessionID).
+		SetNillableStageID(session.CurrentStageID).
+		SetSequenceNumber(seq).
+		SetEventType(timelineevent.EventTypeOperatorNote).
+		SetStatus(timelineevent.StatusCompleted).
+		SetMetadata(map[string]interface{}{"author": author}).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+	compressed := setTimelineContent(create.Mutation(), content)
+
+	event, err := create.Save(bgCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create operator note: %w", err)
+	}
+	if compressed {
+		if err := indexCompressedContent(bgCtx, s.client, event.ID, content); err != nil {
+			return nil, fmt.Errorf("failed to index operator note: %w", err)
+		}
+	}
+	event.Content, event.ContentZstd = content, nil
+	return event, nil
+}
+
+// ListOperatorNotes returns a session's operator notes in the order they were posted.
+func (s *SessionService) ListOperatorNotes(ctx context.Context, sessionID string) ([]*ent.TimelineEvent, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+
+	notes, err := s.client.TimelineEvent.Query().
+		Where(
+			timelineevent.SessionIDEQ(sessionID),
+			timelineevent.EventTypeEQ(timelineevent.EventTypeOperatorNote),
+		).
+		Order(ent.Asc(timelineevent.FieldSequenceNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list operator notes: %w", err)
+	}
+	if err := DecompressTimelineEvents(notes...); err != nil {
+		return nil, err
+	}
+	return notes, nil
+}
+
+// nextTimelineSequence returns the sequence number for an event appended to
+// the end of a session's timeline from outside an agent execution.
+func (s *SessionService) nextTimelineSequence(ctx context.Context, sessionID string) (int, error) {
+	last, err := s.client.TimelineEvent.Query().
+		Where(timelineevent.SessionIDEQ(sessionID)).
+		Order(ent.Desc(timelineevent.FieldSequenceNumber)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, fmt.Errorf("failed to get max sequence number: %w", err)
+	}
+	if last == nil {
+		return 1, nil
+	}
+	return last.SequenceNumber + 1, nil
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_AddOperatorNote(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	t.Run("records note on running session", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+
+		note, err := service.AddOperatorNote(ctx, id, "alice", "  we just rolled back deploy-1234  ")
+		require.NoError(t, err)
+		assert.Equal(t, timelineevent.EventTypeOperatorNote, note.EventType)
+		assert.Equal(t, timelineevent.StatusCompleted, note.Status)
+		assert.Equal(t, "we just rolled back deploy-1234", note.Content)
+		assert.Equal(t, "alice", note.Metadata["author"])
+		assert.Nil(t, note.ExecutionID)
+
+		second, err := service.AddOperatorNote(ctx, id, "bob", "scaled to 3 replicas")
+		require.NoError(t, err)
+		assert.Greater(t, second.SequenceNumber, note.SequenceNumber)
+
+		notes, err := service.ListOperatorNotes(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, notes, 2)
+		assert.Equal(t, note.ID, notes[0].ID)
+		assert.Equal(t, second.ID, notes[1].ID)
+	})
+
+	t.Run("accepts notes on queued session", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusPending)
+
+		_, err := service.AddOperatorNote(ctx, id, "alice", "heads up")
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects terminal session", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusCompleted)
+
+		_, err := service.AddOperatorNote(ctx, id, "alice", "too late")
+		assert.ErrorIs(t, err, ErrSessionNotActive)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		_, err := service.AddOperatorNote(ctx, uuid.New().String(), "alice", "note")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("validates content", func(t *testing.T) {
+		id := seedActiveSession(t, service, alertsession.StatusInProgress)
+
+		_, err := service.AddOperatorNote(ctx, id, "alice", "   ")
+		assert.True(t, IsValidationError(err))
+
+		_, err = service.AddOperatorNote(ctx, id, "alice", strings.Repeat("x", MaxOperatorNoteLength+1))
+		assert.True(t, IsValidationError(err))
+	})
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+)
+
+// maxRecentRecoveries caps the orphan-recovery claims returned by
+// GetQueueSnapshot.
+const maxRecentRecoveries = 50
+
+// SessionQueueInfo is the queue history of a single session.
+type SessionQueueInfo struct {
+	Session *ent.AlertSession
+	Claims  []*ent.SessionClaim // oldest first
+
+	// Position is the 1-based position among pending sessions (0 when the
+	// session is not pending).
+	Position int
+}
+
+// QueueSnapshot is the cluster-wide queue state.
+type QueueSnapshot struct {
+	PendingCount    int
+	OldestPendingAt *time.Time // nil when nothing is pending
+
+	// ActiveClaims are unreleased claims, with the session edge loaded
+	// (alert type and last heartbeat), oldest first.
+	ActiveClaims []*ent.SessionClaim
+
+	// RecentRecoveries are claims ended by orphan recovery, newest first.
+	RecentRecoveries []*ent.SessionClaim
+}
+
+// GetSessionQueueInfo returns a session's claim history and, while it is
+// pending, its position in the queue.
+func (s *SessionService) GetSessionQueueInfo(ctx context.Context, sessionID string) (*SessionQueueInfo, error) {
+	session, err := s.client.AlertSession.Query().
+		Where(alertsession.IDEQ(sessionID), alertsession.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	claims, err := s.client.SessionClaim.Query().
+		Where(sessionclaim.SessionIDEQ(sessionID)).
+		Order(sessionclaim.ByClaimedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session claims: %w", err)
+	}
+
+	info := &SessionQueueInfo{Session: session, Claims: claims}
+	if session.Status == alertsession.StatusPending {
+		ahead, err := s.client.AlertSession.Query().
+			Where(
+				alertsession.StatusEQ(alertsession.StatusPending),
+				alertsession.DeletedAtIsNil(),
+				alertsession.CreatedAtLT(session.CreatedAt),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute queue position: %w", err)
+		}
+		info.Position = ahead + 1
+	}
+	return info, nil
+}
+
+// GetQueueSnapshot returns pending totals, the claims currently held by
+// workers across all pods, and orphan recoveries since recoveriesSince.
+func (s *SessionService) GetQueueSnapshot(ctx context.Context, recoveriesSince time.Time) (*QueueSnapshot, error) {
+	pending := s.client.AlertSession.Query().
+		Where(alertsession.StatusEQ(alertsession.StatusPending), alertsession.DeletedAtIsNil())
+
+	count, err := pending.Clone().Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pending sessions: %w", err)
+	}
+	snap := &QueueSnapshot{PendingCount: count}
+
+	if count > 0 {
+		oldest, err := pending.Clone().Order(ent.Asc(alertsession.FieldCreatedAt)).First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to query oldest pending session: %w", err)
+		}
+		if oldest != nil {
+			snap.OldestPendingAt = &oldest.CreatedAt
+		}
+	}
+
+	snap.ActiveClaims, err = s.client.SessionClaim.Query().
+		Where(sessionclaim.ReleasedAtIsNil()).
+		WithSession(func(q *ent.AlertSessionQuery) {
+			q.Select(alertsession.FieldAlertType, alertsession.FieldStatus, alertsession.FieldLastInteractionAt)
+		}).
+		Order(sessionclaim.ByClaimedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query active claims: %w", err)
+	}
+
+	snap.RecentRecoveries, err = s.ListOrphanRecoveries(ctx, recoveriesSince, maxRecentRecoveries)
+	if err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+// ListOrphanRecoveries returns claims ended by orphan recovery (a worker
+// stopped heartbeating or its pod restarted) since the given time, newest
+// first, with the session edge loaded (alert type, chain, current status).
+func (s *SessionService) ListOrphanRecoveries(ctx context.Context, since time.Time, limit int) ([]*ent.SessionClaim, error) {
+	claims, err := s.client.SessionClaim.Query().
+		Where(
+			sessionclaim.OutcomeIn(sessionclaim.OutcomeOrphaned, sessionclaim.OutcomePodRestarted),
+			sessionclaim.ReleasedAtGTE(since),
+		).
+		WithSession(func(q *ent.AlertSessionQuery) {
+			q.Select(alertsession.FieldAlertType, alertsession.FieldChainID, alertsession.FieldStatus)
+		}).
+		Order(sessionclaim.ByReleasedAt(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orphan recoveries: %w", err)
+	}
+	return claims, nil
+}
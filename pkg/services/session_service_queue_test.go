@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/sessionclaim"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_QueueIntrospection(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	first := seedActiveSession(t, service, alertsession.StatusPending)
+	second := seedActiveSession(t, service, alertsession.StatusPending)
+	running := seedActiveSession(t, service, alertsession.StatusInProgress)
+	orphaned := seedActiveSession(t, service, alertsession.StatusTimedOut)
+
+	now := time.Now()
+	client.SessionClaim.Create().
+		SetID(uuid.New().String()).SetSessionID(running).
+		SetPodID("pod-a").SetWorkerID("pod-a-worker-0").
+		SetClaimedAt(now).SetQueueWaitMs(1500).
+		ExecX(ctx)
+	client.SessionClaim.Create().
+		SetID(uuid.New().String()).SetSessionID(orphaned).
+		SetPodID("pod-b").SetWorkerID("pod-b-worker-1").
+		SetClaimedAt(now.Add(-time.Hour)).SetQueueWaitMs(0).
+		SetReleasedAt(now.Add(-time.Minute)).SetOutcome(sessionclaim.OutcomeOrphaned).
+		SetDetail("Orphaned: no heartbeat").
+		ExecX(ctx)
+
+	t.Run("pending session position", func(t *testing.T) {
+		info, err := service.GetSessionQueueInfo(ctx, second)
+		require.NoError(t, err)
+		assert.Equal(t, 2, info.Position)
+		assert.Empty(t, info.Claims)
+
+		info, err = service.GetSessionQueueInfo(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, 1, info.Position)
+	})
+
+	t.Run("claimed session history", func(t *testing.T) {
+		info, err := service.GetSessionQueueInfo(ctx, running)
+		require.NoError(t, err)
+		assert.Zero(t, info.Position)
+		require.Len(t, info.Claims, 1)
+		assert.Equal(t, "pod-a-worker-0", info.Claims[0].WorkerID)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		_, err := service.GetSessionQueueInfo(ctx, uuid.New().String())
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		snap, err := service.GetQueueSnapshot(ctx, now.Add(-24*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, snap.PendingCount)
+		require.NotNil(t, snap.OldestPendingAt)
+
+		require.Len(t, snap.ActiveClaims, 1)
+		assert.Equal(t, running, snap.ActiveClaims[0].SessionID)
+		require.NotNil(t, snap.ActiveClaims[0].Edges.Session)
+		assert.Equal(t, alertsession.StatusInProgress, snap.ActiveClaims[0].Edges.Session.Status)
+
+		require.Len(t, snap.RecentRecoveries, 1)
+		assert.Equal(t, orphaned, snap.RecentRecoveries[0].SessionID)
+
+		snap, err = service.GetQueueSnapshot(ctx, now)
+		require.NoError(t, err)
+		assert.Empty(t, snap.RecentRecoveries)
+	})
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/llminteraction"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+const (
+	reportTopAlertTypesCap   = 5
+	reportNotableSessionsCap = 10
+	reportSummaryMaxRunes    = 600
+)
+
+// GetActivityStats aggregates session activity for sessions created in
+// [start, end): status counts, success rate, top alert types, notable
+// (critical/high severity) sessions, and LLM token/cost totals.
+func (s *SessionService) GetActivityStats(ctx context.Context, start, end time.Time) (*schema.ActivityReportStats, error) {
+	sessionPreds := usageSessionPreds(models.UsageSummaryParams{StartDate: start, EndDate: end})
+
+	var rows []struct {
+		AlertType string `json:"alert_type"`
+		Status    string `json:"status"`
+		Count     int    `json:"count"`
+	}
+	err := s.client.AlertSession.Query().
+		Where(sessionPreds...).
+		GroupBy(alertsession.FieldAlertType, alertsession.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate session activity: %w", err)
+	}
+
+	stats := &schema.ActivityReportStats{
+		StatusCounts:    make(map[string]int),
+		TopAlertTypes:   []schema.ActivityReportAlertType{},
+		NotableSessions: []schema.ActivityReportNotable{},
+	}
+	byAlertType := make(map[string]*schema.ActivityReportAlertType)
+	for _, row := range rows {
+		stats.TotalSessions += row.Count
+		stats.StatusCounts[row.Status] += row.Count
+
+		at, ok := byAlertType[row.AlertType]
+		if !ok {
+			at = &schema.ActivityReportAlertType{AlertType: row.AlertType}
+			byAlertType[row.AlertType] = at
+		}
+		at.Sessions += row.Count
+		if isFailedStatus(alertsession.Status(row.Status)) {
+			at.Failed += row.Count
+		}
+	}
+	stats.SuccessRate = successRate(stats.StatusCounts)
+	stats.TopAlertTypes = topAlertTypes(byAlertType, reportTopAlertTypesCap)
+
+	notable, err := s.notableSessions(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	stats.NotableSessions = notable
+
+	totals, err := s.usageTotals(ctx, llminteraction.HasSessionWith(sessionPreds...))
+	if err != nil {
+		return nil, err
+	}
+	stats.TotalTokens = totals.TotalTokens
+	stats.EstimatedCostUsd = totals.EstimatedCostUsd
+
+	return stats, nil
+}
+
+// notableSessions returns up to reportNotableSessionsCap critical/high
+// severity sessions with an executive summary, critical first, newest first.
+func (s *SessionService) notableSessions(ctx context.Context, start, end time.Time) ([]schema.ActivityReportNotable, error) {
+	sessions, err := s.client.AlertSession.Query().
+		Where(
+			alertsession.DeletedAtIsNil(),
+			alertsession.CreatedAtGTE(start),
+			alertsession.CreatedAtLT(end),
+			alertsession.SeverityIn(alertsession.SeverityCritical, alertsession.SeverityHigh),
+			alertsession.ExecutiveSummaryNotNil(),
+		).
+		Order(ent.Desc(alertsession.FieldCreatedAt)).
+		Limit(reportNotableSessionsCap * 5).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notable sessions: %w", err)
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return *sessions[i].Severity == alertsession.SeverityCritical &&
+			*sessions[j].Severity != alertsession.SeverityCritical
+	})
+	if len(sessions) > reportNotableSessionsCap {
+		sessions = sessions[:reportNotableSessionsCap]
+	}
+
+	out := make([]schema.ActivityReportNotable, 0, len(sessions))
+	for _, sess := range sessions {
+		out = append(out, schema.ActivityReportNotable{
+			SessionID:        sess.ID,
+			AlertType:        sess.AlertType,
+			ChainID:          sess.ChainID,
+			Severity:         string(*sess.Severity),
+			ExecutiveSummary: truncateRunes(*sess.ExecutiveSummary, reportSummaryMaxRunes),
+			CreatedAt:        sess.CreatedAt,
+		})
+	}
+	return out, nil
+}
+
+func isFailedStatus(status alertsession.Status) bool {
+	return status == alertsession.StatusFailed || status == alertsession.StatusTimedOut
+}
+
+// successRate returns completed / (completed + failed + timed_out), or nil
+// when no session in the window has finished. Cancelled sessions are excluded.
+func successRate(counts map[string]int) *float64 {
+	completed := counts[string(alertsession.StatusCompleted)]
+	finished := completed +
+		counts[string(alertsession.StatusFailed)] +
+		counts[string(alertsession.StatusTimedOut)]
+	if finished == 0 {
+		return nil
+	}
+	rate := float64(completed) / float64(finished)
+	return &rate
+}
+
+// topAlertTypes returns the n alert types with the most sessions, ties broken
+// by name for stable output.
+func topAlertTypes(byAlertType map[string]*schema.ActivityReportAlertType, n int) []schema.ActivityReportAlertType {
+	out := make([]schema.ActivityReportAlertType, 0, len(byAlertType))
+	for _, at := range byAlertType {
+		out = append(out, *at)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Sessions != out[j].Sessions {
+			return out[i].Sessions > out[j].Sessions
+		}
+		return out[i].AlertType < out[j].AlertType
+	})
+	if len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+func truncateRunes(s string, max int) string {
+	r := []rune(s)
+	if len(r) <= max {
+		return s
+	}
+	return string(r[:max]) + "…"
+}
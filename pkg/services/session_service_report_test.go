@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/ent/alertsession"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionService_GetActivityStats(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	windowStart := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	windowEnd := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	inWindow := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	seed := func(alertType string, status alertsession.Status, createdAt time.Time) string {
+		id, _, _ := seedUsageSession(t, client.Client, usageSeed{
+			AlertData: alertType,
+			AlertType: alertType,
+			ChainID:   "k8s-analysis",
+			CreatedAt: createdAt,
+		})
+		client.AlertSession.UpdateOneID(id).SetStatus(status).ExecX(ctx)
+		return id
+	}
+
+	crashID := seed("pod-crash", alertsession.StatusCompleted, inWindow)
+	seed("pod-crash", alertsession.StatusCompleted, inWindow)
+	seed("pod-crash", alertsession.StatusFailed, inWindow)
+	seed("oom", alertsession.StatusTimedOut, inWindow)
+	seed("oom", alertsession.StatusCancelled, inWindow)
+	seed("pod-crash", alertsession.StatusFailed, windowEnd) // half-open: excluded
+
+	highID := seed("oom", alertsession.StatusCompleted, inWindow.Add(time.Hour))
+	client.AlertSession.UpdateOneID(highID).
+		SetSeverity(alertsession.SeverityHigh).
+		SetExecutiveSummary("Memory limit too low for the batch job.").
+		ExecX(ctx)
+	client.AlertSession.UpdateOneID(crashID).
+		SetSeverity(alertsession.SeverityCritical).
+		SetExecutiveSummary(strings.Repeat("x", reportSummaryMaxRunes+10)).
+		ExecX(ctx)
+
+	stats, err := service.GetActivityStats(ctx, windowStart, windowEnd)
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, stats.TotalSessions)
+	assert.Equal(t, map[string]int{"completed": 3, "failed": 1, "timed_out": 1, "cancelled": 1}, stats.StatusCounts)
+	require.NotNil(t, stats.SuccessRate)
+	assert.InDelta(t, 0.6, *stats.SuccessRate, 1e-9)
+
+	assert.Equal(t, []schema.ActivityReportAlertType{
+		{AlertType: "oom", Sessions: 3, Failed: 1},
+		{AlertType: "pod-crash", Sessions: 3, Failed: 1},
+	}, stats.TopAlertTypes)
+
+	require.Len(t, stats.NotableSessions, 2)
+	assert.Equal(t, crashID, stats.NotableSessions[0].SessionID, "critical sorts before high")
+	assert.Equal(t, "critical", stats.NotableSessions[0].Severity)
+	assert.Len(t, []rune(stats.NotableSessions[0].ExecutiveSummary), reportSummaryMaxRunes+1)
+	assert.Equal(t, highID, stats.NotableSessions[1].SessionID)
+	assert.Equal(t, "Memory limit too low for the batch job.", stats.NotableSessions[1].ExecutiveSummary)
+
+	t.Run("empty window", func(t *testing.T) {
+		stats, err := service.GetActivityStats(ctx, windowStart.AddDate(1, 0, 0), windowEnd.AddDate(1, 0, 0))
+		require.NoError(t, err)
+		assert.Zero(t, stats.TotalSessions)
+		assert.Nil(t, stats.SuccessRate)
+		assert.Empty(t, stats.TopAlertTypes)
+		assert.Empty(t, stats.NotableSessions)
+	})
+}
+
+func TestSuccessRate(t *testing.T) {
+	assert.Nil(t, successRate(map[string]int{"cancelled": 2, "in_progress": 1}))
+
+	rate := successRate(map[string]int{"completed": 3, "failed": 1})
+	require.NotNil(t, rate)
+	assert.InDelta(t, 0.75, *rate, 1e-9)
+}
+
+func TestTopAlertTypes(t *testing.T) {
+	in := map[string]*schema.ActivityReportAlertType{
+		"a": {AlertType: "a", Sessions: 1},
+		"b": {AlertType: "b", Sessions: 5},
+		"c": {AlertType: "c", Sessions: 5},
+	}
+	got := topAlertTypes(in, 2)
+	assert.Equal(t, []schema.ActivityReportAlertType{
+		{AlertType: "b", Sessions: 5},
+		{AlertType: "c", Sessions: 5},
+	}, got)
+}
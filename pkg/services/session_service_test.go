@@ -416,17 +416,23 @@ func TestSessionService_CancelSession(t *testing.T) {
 		err = service.UpdateSessionStatus(ctx, session.ID, alertsession.StatusInProgress)
 		require.NoError(t, err)
 
-		err = service.CancelSession(ctx, session.ID)
+		err = service.CancelSession(ctx, session.ID, "duplicate of INC-42", "alice")
 		require.NoError(t, err)
 
-		// Verify status is now cancelling
+		// Verify status is now cancelling and the cancellation is recorded
 		updated, err := service.GetSession(ctx, session.ID, false)
 		require.NoError(t, err)
 		assert.Equal(t, alertsession.StatusCancelling, updated.Status)
+		require.NotNil(t, updated.CancelInitiator)
+		assert.Equal(t, alertsession.CancelInitiatorUser, *updated.CancelInitiator)
+		require.NotNil(t, updated.CancelReason)
+		assert.Equal(t, "duplicate of INC-42", *updated.CancelReason)
+		require.NotNil(t, updated.CancelledBy)
+		assert.Equal(t, "alice", *updated.CancelledBy)
 	})
 
 	t.Run("returns ErrNotFound for missing session", func(t *testing.T) {
-		err := service.CancelSession(ctx, "nonexistent")
+		err := service.CancelSession(ctx, "nonexistent", "", "")
 		require.Error(t, err)
 		assert.Equal(t, ErrNotFound, err)
 	})
@@ -463,7 +469,7 @@ func TestSessionService_CancelSession(t *testing.T) {
 					require.NoError(t, err)
 				}
 
-				err = service.CancelSession(ctx, session.ID)
+				err = service.CancelSession(ctx, session.ID, "", "")
 				require.Error(t, err)
 				assert.Equal(t, ErrNotCancellable, err)
 			})
@@ -2841,6 +2847,108 @@ func TestSessionService_GetSessionStatus(t *testing.T) {
 	})
 }
 
+func TestSessionService_GetSessionGroup(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	group, err := client.SessionGroup.Create().
+		SetID(uuid.New().String()).
+		SetAlertType("outage").
+		SetPrimaryChainID("k8s-analysis").
+		Save(ctx)
+	require.NoError(t, err)
+
+	newSibling := func(chainID string, status alertsession.Status) *ent.AlertSession {
+		sess, err := client.AlertSession.Create().
+			SetID(uuid.New().String()).
+			SetAlertData("checkout is down").
+			SetAgentType("outage").
+			SetAlertType("outage").
+			SetChainID(chainID).
+			SetStatus(status).
+			SetGroupID(group.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		return sess
+	}
+	primary := newSibling("k8s-analysis", alertsession.StatusCompleted)
+	sibling := newSibling("network-analysis", alertsession.StatusInProgress)
+
+	t.Run("returns members and aggregate status", func(t *testing.T) {
+		resp, err := service.GetSessionGroup(ctx, group.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, group.ID, resp.ID)
+		assert.Equal(t, "outage", resp.AlertType)
+		assert.Equal(t, "k8s-analysis", resp.PrimaryChainID)
+		assert.Equal(t, models.SessionGroupStatusInProgress, resp.Status)
+		assert.Equal(t, "pending", resp.SynthesisStatus)
+		assert.Nil(t, resp.Summary)
+		require.Len(t, resp.Sessions, 2)
+		assert.Equal(t, primary.ID, resp.Sessions[0].ID)
+		assert.Equal(t, sibling.ID, resp.Sessions[1].ID)
+		assert.Equal(t, "network-analysis", resp.Sessions[1].ChainID)
+	})
+
+	t.Run("includes synthesis result", func(t *testing.T) {
+		require.NoError(t, client.AlertSession.UpdateOneID(sibling.ID).
+			SetStatus(alertsession.StatusFailed).Exec(ctx))
+		require.NoError(t, client.SessionGroup.UpdateOneID(group.ID).
+			SetSynthesisStatus("completed").
+			SetSummary("DNS outage took down checkout").
+			SetSynthesizedAt(time.Now()).
+			Exec(ctx))
+
+		resp, err := service.GetSessionGroup(ctx, group.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.SessionGroupStatusPartial, resp.Status)
+		assert.Equal(t, "completed", resp.SynthesisStatus)
+		require.NotNil(t, resp.Summary)
+		assert.Equal(t, "DNS outage took down checkout", *resp.Summary)
+		assert.NotNil(t, resp.SynthesizedAt)
+	})
+
+	t.Run("session detail exposes group id", func(t *testing.T) {
+		detail, err := service.GetSessionDetail(ctx, primary.ID)
+		require.NoError(t, err)
+		require.NotNil(t, detail.GroupID)
+		assert.Equal(t, group.ID, *detail.GroupID)
+	})
+
+	t.Run("returns ErrNotFound for unknown group", func(t *testing.T) {
+		_, err := service.GetSessionGroup(ctx, "nonexistent-id")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestSessionGroupStatus(t *testing.T) {
+	const (
+		pending   = alertsession.StatusPending
+		running   = alertsession.StatusInProgress
+		completed = alertsession.StatusCompleted
+		failed    = alertsession.StatusFailed
+		cancelled = alertsession.StatusCancelled
+	)
+	tests := []struct {
+		name     string
+		statuses []alertsession.Status
+		want     string
+	}{
+		{name: "all pending", statuses: []alertsession.Status{pending, pending}, want: models.SessionGroupStatusPending},
+		{name: "one running", statuses: []alertsession.Status{completed, running}, want: models.SessionGroupStatusInProgress},
+		{name: "one still queued", statuses: []alertsession.Status{failed, pending}, want: models.SessionGroupStatusInProgress},
+		{name: "all completed", statuses: []alertsession.Status{completed, completed}, want: models.SessionGroupStatusCompleted},
+		{name: "some completed", statuses: []alertsession.Status{completed, cancelled}, want: models.SessionGroupStatusPartial},
+		{name: "none completed", statuses: []alertsession.Status{failed, cancelled}, want: models.SessionGroupStatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sessionGroupStatus(tt.statuses))
+		})
+	}
+}
+
 func TestSessionService_GetDistinctChainIDs(t *testing.T) {
 	client := testdb.NewTestClient(t)
 	service := setupTestSessionService(t, client.Client)
@@ -1,9 +1,11 @@
 package services
 
 import (
+	"cmp"
 	"context"
 	stdsql "database/sql"
 	"fmt"
+	"slices"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -47,6 +49,10 @@ func (s *SessionService) GetUsageSummary(ctx context.Context, params models.Usag
 	if err != nil {
 		return nil, err
 	}
+	byOutcome, err := s.usageByOutcome(ctx, sessionPreds)
+	if err != nil {
+		return nil, err
+	}
 	top, err := s.usageTopSessions(ctx, sessionPreds, rankBy)
 	if err != nil {
 		return nil, err
@@ -63,11 +69,118 @@ func (s *SessionService) GetUsageSummary(ctx context.Context, params models.Usag
 		ByModel:     byModel,
 		ByAlertType: byAlert,
 		ByChain:     byChain,
+		ByOutcome:   byOutcome,
 		TopSessions: top,
 	}
 	return resp, nil
 }
 
+// GetUsageByOwner returns session counts and token/cost aggregates per owner
+// for sessions created in the given window (soft-deleted sessions excluded).
+// Owners are ordered by cost when estimation is enabled, else by tokens.
+func (s *SessionService) GetUsageByOwner(ctx context.Context, params models.UsageSummaryParams) (*models.UsageOwnersResponse, error) {
+	sessionPreds := usageSessionPreds(params)
+
+	var sessionRows []struct {
+		Owner stdsql.NullString `json:"owner"`
+		Count int               `json:"count"`
+	}
+	err := s.client.AlertSession.Query().
+		Where(sessionPreds...).
+		GroupBy(alertsession.FieldOwner).
+		Aggregate(ent.Count()).
+		Scan(ctx, &sessionRows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count sessions by owner: %w", err)
+	}
+
+	var usageRows []struct {
+		Owner        stdsql.NullString  `json:"owner"`
+		InputSum     stdsql.NullInt64   `json:"input_sum"`
+		OutputSum    stdsql.NullInt64   `json:"output_sum"`
+		TotalSum     stdsql.NullInt64   `json:"total_sum"`
+		CostSum      stdsql.NullFloat64 `json:"cost_sum"`
+		TokenBearing int                `json:"token_bearing"`
+		Priced       int                `json:"priced"`
+	}
+	aggs := []ent.AggregateFunc{
+		ent.As(ent.Sum(llminteraction.FieldInputTokens), "input_sum"),
+		ent.As(ent.Sum(llminteraction.FieldOutputTokens), "output_sum"),
+		ent.As(ent.Sum(llminteraction.FieldTotalTokens), "total_sum"),
+		ent.As(func(_ *sql.Selector) string {
+			return "COUNT(*) FILTER (WHERE " + tokenBearingPredicateSQL + ")"
+		}, "token_bearing"),
+	}
+	if s.costEstimationEnabled {
+		aggs = append(aggs,
+			ent.As(ent.Sum(llminteraction.FieldEstimatedCostUsd), "cost_sum"),
+			ent.As(func(_ *sql.Selector) string {
+				return "COUNT(*) FILTER (WHERE " + tokenBearingPredicateSQL + " AND estimated_cost_usd IS NOT NULL)"
+			}, "priced"),
+		)
+	}
+	err = s.client.LLMInteraction.Query().
+		Where(llminteraction.HasSessionWith(sessionPreds...)).
+		GroupBy(llminteraction.FieldOwner).
+		Aggregate(aggs...).
+		Scan(ctx, &usageRows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate usage by owner: %w", err)
+	}
+
+	byOwner := make(map[string]*models.UsageOwnerBreakdown)
+	entry := func(owner string) *models.UsageOwnerBreakdown {
+		item, ok := byOwner[owner]
+		if !ok {
+			item = &models.UsageOwnerBreakdown{Owner: owner}
+			if s.costEstimationEnabled {
+				item.EstimatedCostUsd = new(float64)
+				item.UnpricedInteractionCount = new(int)
+			}
+			byOwner[owner] = item
+		}
+		return item
+	}
+	for _, row := range sessionRows {
+		entry(row.Owner.String).SessionCount += row.Count
+	}
+	for _, row := range usageRows {
+		item := entry(row.Owner.String)
+		item.InputTokens += row.InputSum.Int64
+		item.OutputTokens += row.OutputSum.Int64
+		item.TotalTokens += row.TotalSum.Int64
+		if s.costEstimationEnabled {
+			*item.EstimatedCostUsd += row.CostSum.Float64
+			*item.UnpricedInteractionCount += row.TokenBearing - row.Priced
+		}
+	}
+
+	owners := make([]models.UsageOwnerBreakdown, 0, len(byOwner))
+	for _, item := range byOwner {
+		owners = append(owners, *item)
+	}
+	slices.SortFunc(owners, func(a, b models.UsageOwnerBreakdown) int {
+		if s.costEstimationEnabled {
+			if c := cmp.Compare(*b.EstimatedCostUsd, *a.EstimatedCostUsd); c != 0 {
+				return c
+			}
+		}
+		if c := cmp.Compare(b.TotalTokens, a.TotalTokens); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Owner, b.Owner)
+	})
+
+	return &models.UsageOwnersResponse{
+		CostEstimationEnabled: s.costEstimationEnabled,
+		Window: models.UsageWindow{
+			Start: params.StartDate,
+			End:   params.EndDate,
+		},
+		Owners: owners,
+	}, nil
+}
+
 func usageSessionPreds(params models.UsageSummaryParams) []predicate.AlertSession {
 	preds := []predicate.AlertSession{
 		alertsession.DeletedAtIsNil(),
@@ -80,6 +193,9 @@ func usageSessionPreds(params models.UsageSummaryParams) []predicate.AlertSessio
 	if params.ChainID != "" {
 		preds = append(preds, alertsession.ChainIDEQ(params.ChainID))
 	}
+	if params.Outcome != "" {
+		preds = append(preds, alertsession.OutcomeEQ(alertsession.Outcome(params.Outcome)))
+	}
 	return preds
 }
 
@@ -284,6 +400,62 @@ func (s *SessionService) usageByChain(ctx context.Context, sessionPreds []predic
 	return out, nil
 }
 
+func (s *SessionService) usageByOutcome(ctx context.Context, sessionPreds []predicate.AlertSession) ([]models.UsageOutcomeBreakdown, error) {
+	var rows []struct {
+		Outcome  stdsql.NullString  `json:"outcome"`
+		Count    int                `json:"session_count"`
+		TotalSum stdsql.NullInt64   `json:"total_sum"`
+		CostSum  stdsql.NullFloat64 `json:"cost_sum"`
+	}
+
+	// COUNT(DISTINCT) counts each session once, however many interactions
+	// the join yields for it.
+	err := s.client.AlertSession.Query().
+		Where(sessionPreds...).
+		Modify(func(sel *sql.Selector) {
+			li := sql.Table(llminteraction.Table).As("li")
+			sel.LeftJoin(li).On(sel.C(alertsession.FieldID), li.C(llminteraction.FieldSessionID))
+			sel.Select(sql.As(sel.C(alertsession.FieldOutcome), "outcome"))
+			sel.AppendSelectAs(fmt.Sprintf("COUNT(DISTINCT %s)", sel.C(alertsession.FieldID)), "session_count")
+			sel.AppendSelectAs(
+				fmt.Sprintf("COALESCE(SUM(%s), 0)", li.C(llminteraction.FieldTotalTokens)),
+				"total_sum",
+			)
+			if s.costEstimationEnabled {
+				sel.AppendSelectAs(
+					fmt.Sprintf("COALESCE(SUM(%s), 0)", li.C(llminteraction.FieldEstimatedCostUsd)),
+					"cost_sum",
+				)
+			}
+			sel.GroupBy(sel.C(alertsession.FieldOutcome))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate usage by outcome: %w", err)
+	}
+
+	out := make([]models.UsageOutcomeBreakdown, 0, len(rows))
+	for _, row := range rows {
+		item := models.UsageOutcomeBreakdown{
+			Outcome:      row.Outcome.String,
+			SessionCount: row.Count,
+			TotalTokens:  row.TotalSum.Int64,
+		}
+		if s.costEstimationEnabled {
+			cost := row.CostSum.Float64
+			item.EstimatedCostUsd = &cost
+		}
+		out = append(out, item)
+	}
+	slices.SortFunc(out, func(a, b models.UsageOutcomeBreakdown) int {
+		if c := cmp.Compare(b.SessionCount, a.SessionCount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Outcome, b.Outcome)
+	})
+	return out, nil
+}
+
 func (s *SessionService) usageTopSessions(
 	ctx context.Context,
 	sessionPreds []predicate.AlertSession,
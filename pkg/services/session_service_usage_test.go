@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -288,6 +289,51 @@ func TestSessionService_GetUsageSummary(t *testing.T) {
 		assert.Equal(t, int64(0), chainMap["chain-z"].TotalTokens)
 	})
 
+	t.Run("by_outcome rollup and outcome filter", func(t *testing.T) {
+		oc := testdb.NewTestClient(t)
+		svc := setupTestSessionService(t, oc.Client)
+
+		s1, st1, e1 := seedUsageSession(t, oc.Client, usageSeed{
+			AlertData: "outcome-1",
+			AlertType: "oom",
+			ChainID:   "chain-x",
+			CreatedAt: inWindow,
+		})
+		seedLLMInteraction(t, oc.Client, s1, st1, e1, "m", 50, 50, 100, floatPtr(0.5), 0)
+		seedLLMInteraction(t, oc.Client, s1, st1, e1, "m", 10, 10, 20, floatPtr(0.1), 0)
+		require.NoError(t, oc.Client.AlertSession.UpdateOneID(s1).SetOutcome(alertsession.OutcomeMitigated).Exec(ctx))
+
+		s2, st2, e2 := seedUsageSession(t, oc.Client, usageSeed{
+			AlertData: "outcome-2",
+			AlertType: "oom",
+			ChainID:   "chain-x",
+			CreatedAt: inWindow.Add(time.Minute),
+		})
+		seedLLMInteraction(t, oc.Client, s2, st2, e2, "m", 25, 25, 50, floatPtr(0.25), 0)
+
+		summary, err := svc.GetUsageSummary(ctx, params)
+		require.NoError(t, err)
+		outcomeMap := map[string]models.UsageOutcomeBreakdown{}
+		for _, row := range summary.ByOutcome {
+			outcomeMap[row.Outcome] = row
+		}
+		require.Contains(t, outcomeMap, "mitigated")
+		assert.Equal(t, 1, outcomeMap["mitigated"].SessionCount)
+		assert.Equal(t, int64(120), outcomeMap["mitigated"].TotalTokens)
+		require.Contains(t, outcomeMap, "")
+		assert.Equal(t, 1, outcomeMap[""].SessionCount)
+
+		filtered, err := svc.GetUsageSummary(ctx, models.UsageSummaryParams{
+			StartDate: windowStart,
+			EndDate:   windowEnd,
+			Outcome:   "mitigated",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(120), filtered.Totals.TotalTokens)
+		require.Len(t, filtered.TopSessions, 1)
+		assert.Equal(t, s1, filtered.TopSessions[0].SessionID)
+	})
+
 	t.Run("estimation disabled omits cost fields and defaults rank_by tokens", func(t *testing.T) {
 		dc := testdb.NewTestClient(t)
 		svc := setupTestSessionService(t, dc.Client)
@@ -453,10 +499,89 @@ func TestSessionService_GetUsageSummary(t *testing.T) {
 	})
 }
 
+func TestSessionService_GetUsageByOwner(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	params := models.UsageSummaryParams{
+		StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+	inWindow := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	// payments-sre owns two sessions; one call in them was made by an
+	// agent owned by observability
+	for i := range 2 {
+		sid, stageID, execID := seedUsageSession(t, client.Client, usageSeed{
+			AlertData: fmt.Sprintf("payments-%d", i),
+			AlertType: "pod-crash",
+			ChainID:   "payments",
+			Owner:     "payments-sre",
+			CreatedAt: inWindow,
+		})
+		seedLLMInteraction(t, client.Client, sid, stageID, execID, "model-a", 100, 50, 150, floatPtr(0.02), 0)
+		seedLLMInteraction(t, client.Client, sid, stageID, execID, "model-splunk", 10, 10, 20, floatPtr(0.5), 0)
+	}
+	client.Client.LLMInteraction.Update().
+		Where(llminteraction.ModelNameEQ("model-a")).
+		SetOwner("payments-sre").
+		ExecX(ctx)
+	client.Client.LLMInteraction.Update().
+		Where(llminteraction.ModelNameEQ("model-splunk")).
+		SetOwner("observability").
+		ExecX(ctx)
+
+	// An unattributed session with an unpriced call
+	sid, stageID, execID := seedUsageSession(t, client.Client, usageSeed{
+		AlertData: "legacy",
+		AlertType: "pod-crash",
+		ChainID:   "k8s-analysis",
+		CreatedAt: inWindow,
+	})
+	seedLLMInteraction(t, client.Client, sid, stageID, execID, "model-b", 5, 5, 10, nil, 0)
+
+	result, err := service.GetUsageByOwner(ctx, params)
+	require.NoError(t, err)
+	assert.True(t, result.CostEstimationEnabled)
+	require.Len(t, result.Owners, 3)
+
+	obs := result.Owners[0]
+	assert.Equal(t, "observability", obs.Owner, "ordered by cost")
+	assert.Equal(t, 0, obs.SessionCount)
+	assert.Equal(t, int64(40), obs.TotalTokens)
+	require.NotNil(t, obs.EstimatedCostUsd)
+	assert.InDelta(t, 1.0, *obs.EstimatedCostUsd, 1e-9)
+
+	payments := result.Owners[1]
+	assert.Equal(t, "payments-sre", payments.Owner)
+	assert.Equal(t, 2, payments.SessionCount)
+	assert.Equal(t, int64(200), payments.InputTokens)
+	assert.Equal(t, int64(300), payments.TotalTokens)
+	assert.InDelta(t, 0.04, *payments.EstimatedCostUsd, 1e-9)
+
+	unattributed := result.Owners[2]
+	assert.Equal(t, "", unattributed.Owner)
+	assert.Equal(t, 1, unattributed.SessionCount)
+	assert.Equal(t, int64(10), unattributed.TotalTokens)
+	require.NotNil(t, unattributed.UnpricedInteractionCount)
+	assert.Equal(t, 1, *unattributed.UnpricedInteractionCount)
+
+	filtered, err := service.GetUsageByOwner(ctx, models.UsageSummaryParams{
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+		ChainID:   "k8s-analysis",
+	})
+	require.NoError(t, err)
+	require.Len(t, filtered.Owners, 1)
+	assert.Equal(t, "", filtered.Owners[0].Owner)
+}
+
 type usageSeed struct {
 	AlertData string
 	AlertType string
 	ChainID   string
+	Owner     string
 	CreatedAt time.Time
 }
 
@@ -479,6 +604,9 @@ func seedUsageSession(t *testing.T, client *ent.Client, seed usageSeed) (session
 		SetStartedAt(started).
 		SetCompletedAt(completed).
 		SaveX(ctx)
+	if seed.Owner != "" {
+		client.AlertSession.UpdateOneID(sess.ID).SetOwner(seed.Owner).ExecX(ctx)
+	}
 
 	stg := client.Stage.Create().
 		SetID(uuid.New().String()).
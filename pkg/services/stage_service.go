@@ -257,6 +257,44 @@ func (s *StageService) UpdateExecutionProviderFallback(
 	return nil
 }
 
+// UpdateExecutionToolCallCount records the number of tool calls an execution
+// has made so far.
+func (s *StageService) UpdateExecutionToolCallCount(ctx context.Context, executionID string, count int) error {
+	writeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.client.AgentExecution.UpdateOneID(executionID).
+		SetToolCallCount(count).
+		Exec(writeCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to update execution tool call count: %w", err)
+	}
+
+	return nil
+}
+
+// MarkExecutionLoopDetected flags an execution whose agent repeated an
+// identical tool call beyond max_duplicate_tool_calls.
+func (s *StageService) MarkExecutionLoopDetected(ctx context.Context, executionID string) error {
+	writeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.client.AgentExecution.UpdateOneID(executionID).
+		SetLoopDetected(true).
+		Exec(writeCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to mark execution loop detected: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateStageStatus aggregates stage status from all agent executions
 func (s *StageService) UpdateStageStatus(ctx context.Context, stageID string) error {
 	// Use timeout context derived from incoming context
@@ -426,6 +426,88 @@ func TestStageService_UpdateAgentExecutionStatus(t *testing.T) {
 	})
 }
 
+func TestStageService_UpdateExecutionToolCallCount(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	stageService := NewStageService(client.Client)
+	sessionService := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	session, err := sessionService.CreateSession(ctx, models.CreateSessionRequest{
+		SessionID: uuid.New().String(),
+		AlertData: "test",
+		AgentType: "kubernetes",
+		ChainID:   "k8s-analysis",
+	})
+	require.NoError(t, err)
+	stg, err := stageService.CreateStage(ctx, models.CreateStageRequest{
+		SessionID:          session.ID,
+		StageName:          "Test",
+		StageIndex:         1,
+		ExpectedAgentCount: 1,
+	})
+	require.NoError(t, err)
+	exec, err := stageService.CreateAgentExecution(ctx, models.CreateAgentExecutionRequest{
+		StageID:    stg.ID,
+		SessionID:  session.ID,
+		AgentName:  "TestAgent",
+		AgentIndex: 1,
+		LLMBackend: config.LLMBackendLangChain,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, exec.ToolCallCount)
+
+	t.Run("records the count", func(t *testing.T) {
+		require.NoError(t, stageService.UpdateExecutionToolCallCount(ctx, exec.ID, 7))
+
+		updated, err := stageService.GetAgentExecutionByID(ctx, exec.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 7, updated.ToolCallCount)
+	})
+
+	t.Run("returns ErrNotFound for missing execution", func(t *testing.T) {
+		err := stageService.UpdateExecutionToolCallCount(ctx, "nonexistent", 1)
+		assert.Equal(t, ErrNotFound, err)
+	})
+}
+
+func TestStageService_MarkExecutionLoopDetected(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	stageService := NewStageService(client.Client)
+	sessionService := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	session, err := sessionService.CreateSession(ctx, models.CreateSessionRequest{
+		SessionID: uuid.New().String(),
+		AlertData: "test",
+		AgentType: "kubernetes",
+		ChainID:   "k8s-analysis",
+	})
+	require.NoError(t, err)
+	stg, err := stageService.CreateStage(ctx, models.CreateStageRequest{
+		SessionID:          session.ID,
+		StageName:          "Test",
+		StageIndex:         1,
+		ExpectedAgentCount: 1,
+	})
+	require.NoError(t, err)
+	exec, err := stageService.CreateAgentExecution(ctx, models.CreateAgentExecutionRequest{
+		StageID:    stg.ID,
+		SessionID:  session.ID,
+		AgentName:  "TestAgent",
+		AgentIndex: 1,
+		LLMBackend: config.LLMBackendLangChain,
+	})
+	require.NoError(t, err)
+	assert.False(t, exec.LoopDetected)
+
+	require.NoError(t, stageService.MarkExecutionLoopDetected(ctx, exec.ID))
+	updated, err := stageService.GetAgentExecutionByID(ctx, exec.ID)
+	require.NoError(t, err)
+	assert.True(t, updated.LoopDetected)
+
+	assert.Equal(t, ErrNotFound, stageService.MarkExecutionLoopDetected(ctx, "nonexistent"))
+}
+
 func TestStageService_UpdateStageStatus(t *testing.T) {
 	t.Run("success_policy=all - all agents must complete", func(t *testing.T) {
 		client := testdb.NewTestClient(t)
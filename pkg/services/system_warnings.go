@@ -9,7 +9,12 @@ import (
 
 // Warning category constants for categorizing system warnings.
 const (
-	WarningCategoryMCPHealth = "mcp_health" // MCP server became unhealthy at runtime
+	WarningCategoryMCPHealth      = "mcp_health"      // MCP server became unhealthy at runtime
+	WarningCategoryConfigDrift    = "config_drift"    // Live replicas loaded different configurations
+	WarningCategorySlowRun        = "slow_run"        // A stage or agent execution crossed its time_warnings threshold
+	WarningCategoryMaintenance    = "maintenance"     // Session claiming is paused for maintenance
+	WarningCategoryFaultInjection = "fault_injection" // Simulated MCP/LLM failures are enabled (test/staging)
+	WarningCategoryConfigLint     = "config_lint"     // Configuration defines components or settings that are never used
 )
 
 // SystemWarning represents a non-fatal system issue.
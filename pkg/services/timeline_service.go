@@ -45,26 +45,35 @@ func (s *TimelineService) CreateTimelineEvent(httpCtx context.Context, req model
 	if status == "" {
 		status = timelineevent.StatusStreaming
 	}
+	highlight := req.Highlight
+	if highlight == nil {
+		highlight = DefaultHighlight(req.EventType)
+	}
 	create := s.client.TimelineEvent.Create().
 		SetID(eventID).
 		SetSessionID(req.SessionID).
 		SetSequenceNumber(req.SequenceNumber).
 		SetEventType(req.EventType).
 		SetStatus(status).
-		SetContent(req.Content).
-		SetMetadata(req.Metadata).
-		SetCreatedAt(time.Now()).
-		SetUpdatedAt(time.Now())
+		SetNillableHighlight(highlight).
+		SetMetadata(req.Metadata)
 
 	// Set optional FK fields only when provided (session-level events pass nil)
 	create = create.SetNillableStageID(req.StageID).
 		SetNillableExecutionID(req.ExecutionID).
 		SetNillableParentExecutionID(req.ParentExecutionID)
+	compressed := setTimelineContent(create.Mutation(), req.Content)
 
 	event, err := create.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create timeline event: %w", err)
 	}
+	if compressed {
+		if err := indexCompressedContent(ctx, s.client, event.ID, req.Content); err != nil {
+			return nil, fmt.Errorf("failed to index timeline event content: %w", err)
+		}
+	}
+	event.Content, event.ContentZstd = req.Content, nil
 
 	return event, nil
 }
@@ -81,10 +90,9 @@ func (s *TimelineService) UpdateTimelineEvent(ctx context.Context, eventID strin
 	writeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	err := s.client.TimelineEvent.UpdateOneID(eventID).
-		SetContent(content).
-		SetUpdatedAt(time.Now()).
-		Exec(writeCtx)
+	update := updateTimelineContent(s.client.TimelineEvent.UpdateOneID(eventID), content)
+
+	err := update.Exec(writeCtx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return ErrNotFound
@@ -108,10 +116,8 @@ func (s *TimelineService) CompleteTimelineEvent(ctx context.Context, eventID str
 	writeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	update := s.client.TimelineEvent.UpdateOneID(eventID).
-		SetContent(content).
-		SetStatus(timelineevent.StatusCompleted).
-		SetUpdatedAt(time.Now())
+	update := updateTimelineContent(s.client.TimelineEvent.UpdateOneID(eventID), content).
+		SetStatus(timelineevent.StatusCompleted)
 
 	if llmInteractionID != nil {
 		update = update.SetLlmInteractionID(*llmInteractionID)
@@ -163,11 +169,9 @@ func (s *TimelineService) CompleteTimelineEventWithMetadata(ctx context.Context,
 		merged[k] = v
 	}
 
-	update := s.client.TimelineEvent.UpdateOneID(eventID).
-		SetContent(content).
+	update := updateTimelineContent(s.client.TimelineEvent.UpdateOneID(eventID), content).
 		SetStatus(timelineevent.StatusCompleted).
-		SetMetadata(merged).
-		SetUpdatedAt(time.Now())
+		SetMetadata(merged)
 
 	if llmInteractionID != nil {
 		update = update.SetLlmInteractionID(*llmInteractionID)
@@ -187,6 +191,49 @@ func (s *TimelineService) CompleteTimelineEventWithMetadata(ctx context.Context,
 	return nil
 }
 
+// SetTimelineEventHighlight marks an existing event, e.g. a tool call that
+// failed or that an agent flagged as a key finding on completion.
+func (s *TimelineService) SetTimelineEventHighlight(ctx context.Context, eventID string, highlight timelineevent.Highlight) error {
+	if eventID == "" {
+		return NewValidationError("eventID", "required")
+	}
+	if err := timelineevent.HighlightValidator(highlight); err != nil {
+		return NewValidationError("highlight", err.Error())
+	}
+
+	writeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err := s.client.TimelineEvent.UpdateOneID(eventID).
+		SetHighlight(highlight).
+		Exec(writeCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to set timeline event highlight: %w", err)
+	}
+	return nil
+}
+
+// DefaultHighlight returns the highlight the framework gives events of
+// eventType, or nil for ordinary events.
+func DefaultHighlight(eventType timelineevent.EventType) *timelineevent.Highlight {
+	var h timelineevent.Highlight
+	switch eventType {
+	case timelineevent.EventTypeFinalAnalysis, timelineevent.EventTypeExecutiveSummary:
+		h = timelineevent.HighlightKeyFinding
+	case timelineevent.EventTypeError:
+		h = timelineevent.HighlightError
+	case timelineevent.EventTypeProviderFallback, timelineevent.EventTypeMcpServerDisabled,
+		timelineevent.EventTypeCapabilityFallback:
+		h = timelineevent.HighlightWarning
+	default:
+		return nil
+	}
+	return &h
+}
+
 // FailTimelineEvent marks an event as failed with an error message.
 // Used to clean up streaming events that were interrupted by an error.
 func (s *TimelineService) FailTimelineEvent(ctx context.Context, eventID string, content string) error {
@@ -214,11 +261,10 @@ func (s *TimelineService) setTerminalStatus(ctx context.Context, eventID string,
 	writeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	err := s.client.TimelineEvent.UpdateOneID(eventID).
-		SetStatus(status).
-		SetContent(content).
-		SetUpdatedAt(time.Now()).
-		Exec(writeCtx)
+	update := updateTimelineContent(s.client.TimelineEvent.UpdateOneID(eventID), content).
+		SetStatus(status)
+
+	err := update.Exec(writeCtx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return ErrNotFound
@@ -229,6 +275,26 @@ func (s *TimelineService) setTerminalStatus(ctx context.Context, eventID string,
 	return nil
 }
 
+// GetSessionHighlights returns a session's highlighted events in timeline
+// order, for condensed views of long investigations.
+func (s *TimelineService) GetSessionHighlights(ctx context.Context, sessionID string) ([]*ent.TimelineEvent, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+
+	events, err := s.client.TimelineEvent.Query().
+		Where(timelineevent.SessionIDEQ(sessionID), timelineevent.HighlightNotNil()).
+		Order(ent.Asc(timelineevent.FieldSequenceNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session highlights: %w", err)
+	}
+	if err := DecompressTimelineEvents(events...); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
 // GetSessionTimeline retrieves all events for a session
 func (s *TimelineService) GetSessionTimeline(ctx context.Context, sessionID string) ([]*ent.TimelineEvent, error) {
 	if sessionID == "" {
@@ -242,6 +308,9 @@ func (s *TimelineService) GetSessionTimeline(ctx context.Context, sessionID stri
 	if err != nil {
 		return nil, fmt.Errorf("failed to get session timeline: %w", err)
 	}
+	if err := DecompressTimelineEvents(events...); err != nil {
+		return nil, err
+	}
 
 	return events, nil
 }
@@ -259,6 +328,9 @@ func (s *TimelineService) GetStageTimeline(ctx context.Context, stageID string)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get stage timeline: %w", err)
 	}
+	if err := DecompressTimelineEvents(events...); err != nil {
+		return nil, err
+	}
 
 	return events, nil
 }
@@ -319,6 +391,9 @@ func (s *TimelineService) GetAgentTimeline(ctx context.Context, executionID stri
 	if err != nil {
 		return nil, fmt.Errorf("failed to get agent timeline: %w", err)
 	}
+	if err := DecompressTimelineEvents(events...); err != nil {
+		return nil, err
+	}
 
 	return events, nil
 }
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/predicate"
+	"github.com/codeready-toolchain/tarsy/ent/timelineevent"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// Timeline page sizes. Orchestrator sessions reach 10k+ events, so clients
+// load them lazily instead of fetching the whole timeline.
+const (
+	DefaultTimelinePageLimit = 200
+	MaxTimelinePageLimit     = 1000
+)
+
+// GetSessionTimelinePage returns a page of a session's timeline events in
+// sequence order, starting after params.AfterSequence.
+//
+// Sequence numbers are per execution, so parallel agents share them. A page
+// never splits the events sharing its last sequence number: it may exceed the
+// limit by the events tied with its last one, so that next_after_sequence
+// resumes exactly where it stopped.
+func (s *TimelineService) GetSessionTimelinePage(ctx context.Context, sessionID string, params models.TimelinePageParams) (*models.TimelinePageResponse, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+	if params.AfterSequence < 0 || params.BeforeSequence < 0 {
+		return nil, NewValidationError("sequence", "must be non-negative")
+	}
+	for _, et := range params.EventTypes {
+		if err := timelineevent.EventTypeValidator(et); err != nil {
+			return nil, NewValidationError("event_type", fmt.Sprintf("invalid event type %q", et))
+		}
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultTimelinePageLimit
+	}
+	limit = min(limit, MaxTimelinePageLimit)
+
+	filters := []predicate.TimelineEvent{timelineevent.SessionIDEQ(sessionID)}
+	if params.BeforeSequence > 0 {
+		filters = append(filters, timelineevent.SequenceNumberLT(params.BeforeSequence))
+	}
+	if len(params.EventTypes) > 0 {
+		filters = append(filters, timelineevent.EventTypeIn(params.EventTypes...))
+	}
+	if params.ExecutionID != "" {
+		filters = append(filters, timelineevent.ExecutionIDEQ(params.ExecutionID))
+	}
+	query := func(extra ...predicate.TimelineEvent) *ent.TimelineEventQuery {
+		return s.client.TimelineEvent.Query().
+			Where(append(extra, filters...)...).
+			Order(ent.Asc(timelineevent.FieldSequenceNumber), ent.Asc(timelineevent.FieldID))
+	}
+
+	events, err := query(timelineevent.SequenceNumberGT(params.AfterSequence)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline page: %w", err)
+	}
+
+	resp := &models.TimelinePageResponse{}
+	if len(events) > limit {
+		last := events[limit-1]
+		// Complete the last sequence number so the next page can start after it
+		tied, err := query(
+			timelineevent.SequenceNumberEQ(last.SequenceNumber),
+			timelineevent.IDGT(last.ID),
+		).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get timeline page: %w", err)
+		}
+		events = append(events[:limit], tied...)
+		resp.HasMore, err = query(timelineevent.SequenceNumberGT(last.SequenceNumber)).Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get timeline page: %w", err)
+		}
+	}
+	resp.Events = events
+	if len(events) > 0 {
+		next := events[len(events)-1].SequenceNumber
+		resp.NextAfterSequence = &next
+	}
+	if err := DecompressTimelineEvents(events...); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetSessionTimelineSummary counts a session's timeline events per event
+// type and per execution, so clients can size a timeline before loading it.
+func (s *TimelineService) GetSessionTimelineSummary(ctx context.Context, sessionID string) (*models.TimelineSummaryResponse, error) {
+	if sessionID == "" {
+		return nil, NewValidationError("sessionID", "required")
+	}
+
+	var byType []struct {
+		EventType string `json:"event_type"`
+		Count     int    `json:"count"`
+	}
+	err := s.client.TimelineEvent.Query().
+		Where(timelineevent.SessionIDEQ(sessionID)).
+		GroupBy(timelineevent.FieldEventType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &byType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count timeline events by type: %w", err)
+	}
+
+	var byExecution []struct {
+		ExecutionID       stdsql.NullString `json:"execution_id"`
+		StageID           stdsql.NullString `json:"stage_id"`
+		ParentExecutionID stdsql.NullString `json:"parent_execution_id"`
+		Count             int               `json:"count"`
+		FirstSequence     int               `json:"first_sequence"`
+		LastSequence      int               `json:"last_sequence"`
+	}
+	err = s.client.TimelineEvent.Query().
+		Where(timelineevent.SessionIDEQ(sessionID)).
+		GroupBy(timelineevent.FieldExecutionID, timelineevent.FieldStageID, timelineevent.FieldParentExecutionID).
+		Aggregate(
+			ent.Count(),
+			ent.As(ent.Min(timelineevent.FieldSequenceNumber), "first_sequence"),
+			ent.As(ent.Max(timelineevent.FieldSequenceNumber), "last_sequence"),
+		).
+		Scan(ctx, &byExecution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count timeline events by execution: %w", err)
+	}
+
+	highlights, err := s.client.TimelineEvent.Query().
+		Where(timelineevent.SessionIDEQ(sessionID), timelineevent.HighlightNotNil()).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count timeline highlights: %w", err)
+	}
+
+	summary := &models.TimelineSummaryResponse{
+		Highlights: highlights,
+		EventTypes: make(map[string]int, len(byType)),
+		Executions: make([]models.TimelineExecutionSummary, 0, len(byExecution)),
+	}
+	for _, row := range byType {
+		summary.EventTypes[row.EventType] = row.Count
+		summary.TotalEvents += row.Count
+	}
+	nullable := func(v stdsql.NullString) *string {
+		if !v.Valid {
+			return nil
+		}
+		return &v.String
+	}
+	for _, row := range byExecution {
+		summary.Executions = append(summary.Executions, models.TimelineExecutionSummary{
+			ExecutionID:       nullable(row.ExecutionID),
+			StageID:           nullable(row.StageID),
+			ParentExecutionID: nullable(row.ParentExecutionID),
+			Events:            row.Count,
+			FirstSequence:     row.FirstSequence,
+			LastSequence:      row.LastSequence,
+		})
+		summary.MaxSequence = max(summary.MaxSequence, row.LastSequence)
+	}
+	// Timeline order: executions by their first event
+	slices.SortFunc(summary.Executions, func(a, b models.TimelineExecutionSummary) int {
+		return cmp.Compare(a.FirstSequence, b.FirstSequence)
+	})
+	return summary, nil
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/codeready-toolchain/tarsy/ent"
@@ -764,6 +765,99 @@ func TestTimelineService_GetTimelines(t *testing.T) {
 	})
 }
 
+func TestDefaultHighlight(t *testing.T) {
+	tests := []struct {
+		eventType timelineevent.EventType
+		want      *timelineevent.Highlight
+	}{
+		{timelineevent.EventTypeFinalAnalysis, highlightPtr(timelineevent.HighlightKeyFinding)},
+		{timelineevent.EventTypeExecutiveSummary, highlightPtr(timelineevent.HighlightKeyFinding)},
+		{timelineevent.EventTypeError, highlightPtr(timelineevent.HighlightError)},
+		{timelineevent.EventTypeProviderFallback, highlightPtr(timelineevent.HighlightWarning)},
+		{timelineevent.EventTypeMcpServerDisabled, highlightPtr(timelineevent.HighlightWarning)},
+		{timelineevent.EventTypeLlmThinking, nil},
+		{timelineevent.EventTypeLlmToolCall, nil},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.eventType), func(t *testing.T) {
+			assert.Equal(t, tt.want, DefaultHighlight(tt.eventType))
+		})
+	}
+}
+
+func TestTimelineService_Highlights(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	timelineService := NewTimelineService(client.Client)
+	sessionService := setupTestSessionService(t, client.Client)
+	ctx := context.Background()
+
+	session, err := sessionService.CreateSession(ctx, models.CreateSessionRequest{
+		SessionID: uuid.New().String(),
+		AlertData: "test",
+		AgentType: "kubernetes",
+		ChainID:   "k8s-analysis",
+	})
+	require.NoError(t, err)
+
+	create := func(seq int, eventType timelineevent.EventType, highlight *timelineevent.Highlight) *ent.TimelineEvent {
+		event, err := timelineService.CreateTimelineEvent(ctx, models.CreateTimelineEventRequest{
+			SessionID:      session.ID,
+			SequenceNumber: seq,
+			EventType:      eventType,
+			Status:         timelineevent.StatusCompleted,
+			Content:        "content",
+			Highlight:      highlight,
+		})
+		require.NoError(t, err)
+		return event
+	}
+
+	thinking := create(1, timelineevent.EventTypeLlmThinking, nil)
+	toolCall := create(2, timelineevent.EventTypeLlmToolCall, nil)
+	response := create(3, timelineevent.EventTypeLlmResponse, highlightPtr(timelineevent.HighlightKeyFinding))
+	final := create(4, timelineevent.EventTypeFinalAnalysis, nil)
+
+	t.Run("applies default and explicit highlights", func(t *testing.T) {
+		assert.Nil(t, thinking.Highlight)
+		require.NotNil(t, response.Highlight)
+		assert.Equal(t, timelineevent.HighlightKeyFinding, *response.Highlight)
+		require.NotNil(t, final.Highlight)
+		assert.Equal(t, timelineevent.HighlightKeyFinding, *final.Highlight)
+	})
+
+	t.Run("sets highlight on existing event", func(t *testing.T) {
+		require.NoError(t, timelineService.SetTimelineEventHighlight(ctx, toolCall.ID, timelineevent.HighlightWarning))
+		updated, err := client.TimelineEvent.Get(ctx, toolCall.ID)
+		require.NoError(t, err)
+		require.NotNil(t, updated.Highlight)
+		assert.Equal(t, timelineevent.HighlightWarning, *updated.Highlight)
+	})
+
+	t.Run("rejects unknown highlight", func(t *testing.T) {
+		err := timelineService.SetTimelineEventHighlight(ctx, toolCall.ID, timelineevent.Highlight("urgent"))
+		require.Error(t, err)
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("returns not found for missing event", func(t *testing.T) {
+		err := timelineService.SetTimelineEventHighlight(ctx, uuid.New().String(), timelineevent.HighlightWarning)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("gets highlighted events in order", func(t *testing.T) {
+		events, err := timelineService.GetSessionHighlights(ctx, session.ID)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, toolCall.ID, events[0].ID)
+		assert.Equal(t, response.ID, events[1].ID)
+		assert.Equal(t, final.ID, events[2].ID)
+	})
+}
+
+func highlightPtr(h timelineevent.Highlight) *timelineevent.Highlight {
+	return &h
+}
+
 func TestTimelineService_GetMaxSequenceNumber(t *testing.T) {
 	client := testdb.NewTestClient(t)
 	timelineService := NewTimelineService(client.Client)
@@ -826,3 +920,33 @@ func TestTimelineService_GetMaxSequenceNumber(t *testing.T) {
 		assert.True(t, IsValidationError(err))
 	})
 }
+
+func TestTimelineService_CompressedContent(t *testing.T) {
+	withCompression(t, &config.CompressionConfig{Enabled: true, ThresholdBytes: 1024})
+	svc, client, event := setupTerminalEventFixture(t)
+	ctx := context.Background()
+	large := strings.Repeat("NAME READY STATUS RESTARTS AGE\n", 200)
+
+	err := svc.CompleteTimelineEvent(ctx, event.ID, large, nil, nil)
+	require.NoError(t, err)
+
+	stored, err := client.TimelineEvent.Get(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored.Content)
+	require.NotNil(t, stored.ContentZstd)
+	assert.Less(t, len(*stored.ContentZstd), len(large))
+
+	events, err := svc.GetAgentTimeline(ctx, *event.ExecutionID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, large, events[0].Content)
+	assert.Nil(t, events[0].ContentZstd)
+
+	// Shrinking the content stores it uncompressed again.
+	err = svc.UpdateTimelineEvent(ctx, event.ID, "short")
+	require.NoError(t, err)
+	stored, err = client.TimelineEvent.Get(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "short", stored.Content)
+	assert.Nil(t, stored.ContentZstd)
+}
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/codeready-toolchain/tarsy/ent"
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+const (
+	maxDisplayNameLength = 100
+	maxFilterValueLength = 500
+	maxFavoriteChains    = 50
+)
+
+// defaultFilterKeys are the session list query parameters a user may store as
+// default dashboard filters. Paging and date ranges are deliberately excluded.
+var defaultFilterKeys = []string{
+	"status", "alert_type", "chain_id", "search", "scoring_status",
+	"review_status", "assignee", "quality_rating", "sort_by", "sort_order", "page_size",
+}
+
+// UserProfileService manages per-user profiles and dashboard preferences,
+// keyed by OIDC subject.
+type UserProfileService struct {
+	client *ent.Client
+}
+
+// NewUserProfileService creates a new UserProfileService.
+func NewUserProfileService(client *ent.Client) *UserProfileService {
+	return &UserProfileService{client: client}
+}
+
+// GetOrCreate returns the profile for the identity's subject, creating it on
+// first access. Existing profiles get last_seen_at and email refreshed from
+// the identity.
+func (s *UserProfileService) GetOrCreate(httpCtx context.Context, id models.UserIdentity) (*ent.UserProfile, error) {
+	if id.Subject == "" {
+		return nil, NewValidationError("subject", "required")
+	}
+
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	profile, err := s.client.UserProfile.Get(ctx, id.Subject)
+	if err == nil {
+		update := profile.Update().SetLastSeenAt(time.Now())
+		if id.Email != "" {
+			update.SetEmail(id.Email)
+		}
+		profile, err = update.Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update user profile: %w", err)
+		}
+		return profile, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+
+	create := s.client.UserProfile.Create().
+		SetID(id.Subject).
+		SetPreferences(schema.UserPreferences{})
+	if id.Email != "" {
+		create.SetEmail(id.Email)
+	}
+	if name := strings.TrimSpace(id.PreferredUsername); name != "" && utf8.RuneCountInString(name) <= maxDisplayNameLength {
+		create.SetDisplayName(name)
+	}
+	profile, err = create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			// Another request created it concurrently.
+			return s.Get(httpCtx, id.Subject)
+		}
+		return nil, fmt.Errorf("failed to create user profile: %w", err)
+	}
+	return profile, nil
+}
+
+// Get returns the profile for a subject, or ErrNotFound.
+func (s *UserProfileService) Get(httpCtx context.Context, subject string) (*ent.UserProfile, error) {
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	profile, err := s.client.UserProfile.Get(ctx, subject)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+	return profile, nil
+}
+
+// DisplayName returns the subject's display name, or "" when the subject has
+// no profile or the profile has no display name.
+func (s *UserProfileService) DisplayName(httpCtx context.Context, subject string) (string, error) {
+	if subject == "" {
+		return "", nil
+	}
+	profile, err := s.Get(httpCtx, subject)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if profile.DisplayName == nil {
+		return "", nil
+	}
+	return *profile.DisplayName, nil
+}
+
+// UpdateProfile applies a partial update to an existing profile. Chain IDs in
+// favorite_chains are not checked against the chain registry here; callers
+// with access to the configuration validate them.
+func (s *UserProfileService) UpdateProfile(httpCtx context.Context, subject string, req models.UpdateUserProfileRequest) (*ent.UserProfile, error) {
+	if subject == "" {
+		return nil, NewValidationError("subject", "required")
+	}
+
+	var displayName string
+	if req.DisplayName != nil {
+		displayName = strings.TrimSpace(*req.DisplayName)
+		if utf8.RuneCountInString(displayName) > maxDisplayNameLength {
+			return nil, NewValidationError("display_name", fmt.Sprintf("must be at most %d characters", maxDisplayNameLength))
+		}
+	}
+	for k, v := range req.DefaultFilters {
+		if !slices.Contains(defaultFilterKeys, k) {
+			return nil, NewValidationError("default_filters", fmt.Sprintf("unsupported filter %q", k))
+		}
+		if len(v) > maxFilterValueLength {
+			return nil, NewValidationError("default_filters", fmt.Sprintf("value for %q must be at most %d characters", k, maxFilterValueLength))
+		}
+	}
+	var favorites []string
+	if req.FavoriteChains != nil {
+		favorites = make([]string, 0, len(req.FavoriteChains))
+		for _, chainID := range req.FavoriteChains {
+			if chainID == "" {
+				return nil, NewValidationError("favorite_chains", "chain id must not be empty")
+			}
+			if !slices.Contains(favorites, chainID) {
+				favorites = append(favorites, chainID)
+			}
+		}
+		if len(favorites) > maxFavoriteChains {
+			return nil, NewValidationError("favorite_chains", fmt.Sprintf("at most %d chains allowed", maxFavoriteChains))
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(httpCtx, 5*time.Second)
+	defer cancel()
+
+	profile, err := s.client.UserProfile.Get(ctx, subject)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+
+	prefs := profile.Preferences
+	if req.Notifications != nil {
+		prefs.Notifications = *req.Notifications
+	}
+	if req.DefaultFilters != nil {
+		prefs.DefaultFilters = req.DefaultFilters
+		if len(prefs.DefaultFilters) == 0 {
+			prefs.DefaultFilters = nil
+		}
+	}
+	if favorites != nil {
+		prefs.FavoriteChains = favorites
+		if len(prefs.FavoriteChains) == 0 {
+			prefs.FavoriteChains = nil
+		}
+	}
+
+	update := profile.Update().SetPreferences(prefs)
+	if req.DisplayName != nil {
+		if displayName == "" {
+			update.ClearDisplayName()
+		} else {
+			update.SetDisplayName(displayName)
+		}
+	}
+	profile, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user profile: %w", err)
+	}
+	return profile, nil
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/ent/schema"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+	testdb "github.com/codeready-toolchain/tarsy/test/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserProfileService(t *testing.T) {
+	client := testdb.NewTestClient(t)
+	service := NewUserProfileService(client.Client)
+	ctx := context.Background()
+
+	t.Run("get or create seeds profile from identity", func(t *testing.T) {
+		profile, err := service.GetOrCreate(ctx, models.UserIdentity{
+			Subject:           "sub-alice",
+			Email:             "alice@example.com",
+			PreferredUsername: "alice",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "sub-alice", profile.ID)
+		require.NotNil(t, profile.DisplayName)
+		assert.Equal(t, "alice", *profile.DisplayName)
+		require.NotNil(t, profile.Email)
+		assert.Equal(t, "alice@example.com", *profile.Email)
+
+		again, err := service.GetOrCreate(ctx, models.UserIdentity{Subject: "sub-alice", Email: "alice@new.example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, "alice@new.example.com", *again.Email)
+		assert.Equal(t, "alice", *again.DisplayName, "existing display name is kept")
+		assert.False(t, again.LastSeenAt.Before(profile.LastSeenAt))
+	})
+
+	t.Run("get or create requires subject", func(t *testing.T) {
+		_, err := service.GetOrCreate(ctx, models.UserIdentity{Email: "x@example.com"})
+		assert.True(t, IsValidationError(err))
+	})
+
+	t.Run("update preferences partially", func(t *testing.T) {
+		_, err := service.GetOrCreate(ctx, models.UserIdentity{Subject: "sub-bob"})
+		require.NoError(t, err)
+
+		name := "  Bob Builder  "
+		profile, err := service.UpdateProfile(ctx, "sub-bob", models.UpdateUserProfileRequest{
+			DisplayName:    &name,
+			Notifications:  &schema.NotificationPreferences{SessionFailed: true},
+			DefaultFilters: map[string]string{"status": "failed", "chain_id": "k8s"},
+			FavoriteChains: []string{"k8s", "k8s", "network"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Bob Builder", *profile.DisplayName)
+		assert.True(t, profile.Preferences.Notifications.SessionFailed)
+		assert.Equal(t, map[string]string{"status": "failed", "chain_id": "k8s"}, profile.Preferences.DefaultFilters)
+		assert.Equal(t, []string{"k8s", "network"}, profile.Preferences.FavoriteChains)
+
+		// Omitted fields are left unchanged; empty collections clear.
+		profile, err = service.UpdateProfile(ctx, "sub-bob", models.UpdateUserProfileRequest{
+			FavoriteChains: []string{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Bob Builder", *profile.DisplayName)
+		assert.True(t, profile.Preferences.Notifications.SessionFailed)
+		assert.Len(t, profile.Preferences.DefaultFilters, 2)
+		assert.Empty(t, profile.Preferences.FavoriteChains)
+
+		empty := ""
+		profile, err = service.UpdateProfile(ctx, "sub-bob", models.UpdateUserProfileRequest{DisplayName: &empty})
+		require.NoError(t, err)
+		assert.Nil(t, profile.DisplayName)
+	})
+
+	t.Run("update validation", func(t *testing.T) {
+		long := strings.Repeat("x", maxDisplayNameLength+1)
+		tests := []struct {
+			name string
+			req  models.UpdateUserProfileRequest
+		}{
+			{name: "display name too long", req: models.UpdateUserProfileRequest{DisplayName: &long}},
+			{name: "unsupported filter", req: models.UpdateUserProfileRequest{DefaultFilters: map[string]string{"page": "2"}}},
+			{name: "empty chain id", req: models.UpdateUserProfileRequest{FavoriteChains: []string{""}}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := service.UpdateProfile(ctx, "sub-bob", tt.req)
+				assert.True(t, IsValidationError(err))
+			})
+		}
+	})
+
+	t.Run("update missing profile", func(t *testing.T) {
+		_, err := service.UpdateProfile(ctx, "sub-missing", models.UpdateUserProfileRequest{})
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("display name", func(t *testing.T) {
+		name, err := service.DisplayName(ctx, "sub-alice")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", name)
+
+		name, err = service.DisplayName(ctx, "sub-missing")
+		require.NoError(t, err)
+		assert.Empty(t, name)
+	})
+}
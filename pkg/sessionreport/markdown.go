@@ -0,0 +1,158 @@
+package sessionreport
+
+import (
+	"html"
+	"html/template"
+	"regexp"
+	"strings"
+)
+
+// blockKind identifies a block-level markdown element.
+type blockKind int
+
+const (
+	blockParagraph blockKind = iota
+	blockHeading
+	blockBullets
+	blockNumbered
+	blockCode  // Fenced code, or pipe tables rendered verbatim
+	blockQuote // Lines prefixed with ">"
+	blockRule
+)
+
+// block is one block-level element of agent-produced markdown. Only the
+// subset agents actually emit is recognised; anything else is a paragraph.
+type block struct {
+	kind  blockKind
+	level int      // Heading level (1-6)
+	lines []string // Paragraph/quote/code lines or list items
+}
+
+var (
+	headingRe  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	bulletRe   = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	numberedRe = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+	ruleRe     = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+
+	inlineCodeRe = regexp.MustCompile("`([^`]+)`")
+	boldRe       = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	linkRe       = regexp.MustCompile(`\[([^\]]+)\]\((https?://[^)\s]+)\)`)
+)
+
+// parseMarkdown splits text into blocks.
+func parseMarkdown(text string) []block {
+	var blocks []block
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			continue
+
+		case strings.HasPrefix(trimmed, "```"):
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			blocks = append(blocks, block{kind: blockCode, lines: code})
+
+		case strings.HasPrefix(trimmed, "|"):
+			table := []string{trimmed}
+			for i+1 < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i+1]), "|") {
+				i++
+				table = append(table, strings.TrimSpace(lines[i]))
+			}
+			blocks = append(blocks, block{kind: blockCode, lines: table})
+
+		case headingRe.MatchString(trimmed):
+			m := headingRe.FindStringSubmatch(trimmed)
+			blocks = append(blocks, block{kind: blockHeading, level: len(m[1]), lines: []string{m[2]}})
+
+		case ruleRe.MatchString(trimmed):
+			blocks = append(blocks, block{kind: blockRule})
+
+		case bulletRe.MatchString(line), numberedRe.MatchString(line):
+			kind, re := blockBullets, bulletRe
+			if !bulletRe.MatchString(line) {
+				kind, re = blockNumbered, numberedRe
+			}
+			b := block{kind: kind}
+			for ; i < len(lines); i++ {
+				if m := re.FindStringSubmatch(lines[i]); m != nil {
+					b.lines = append(b.lines, m[1])
+					continue
+				}
+				// Indented continuation of the previous item
+				next := strings.TrimSpace(lines[i])
+				if next == "" || !strings.HasPrefix(lines[i], " ") || len(b.lines) == 0 {
+					break
+				}
+				b.lines[len(b.lines)-1] += " " + next
+			}
+			i--
+			blocks = append(blocks, b)
+
+		case strings.HasPrefix(trimmed, ">"):
+			b := block{kind: blockQuote}
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				b.lines = append(b.lines, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")))
+			}
+			i--
+			blocks = append(blocks, b)
+
+		default:
+			b := block{kind: blockParagraph}
+			for ; i < len(lines) && startsParagraphLine(lines[i]); i++ {
+				b.lines = append(b.lines, strings.TrimSpace(lines[i]))
+			}
+			i--
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
+
+// startsParagraphLine reports whether line continues a paragraph rather than
+// starting a different block.
+func startsParagraphLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed != "" &&
+		!strings.HasPrefix(trimmed, "```") &&
+		!strings.HasPrefix(trimmed, "|") &&
+		!strings.HasPrefix(trimmed, ">") &&
+		!headingRe.MatchString(trimmed) &&
+		!ruleRe.MatchString(trimmed) &&
+		!bulletRe.MatchString(line) &&
+		!numberedRe.MatchString(line)
+}
+
+// inlineHTML renders inline code, bold text, and http(s) links. Everything
+// else is escaped, so agent output cannot inject markup.
+func inlineHTML(text string) template.HTML {
+	var b strings.Builder
+	last := 0
+	for _, loc := range inlineCodeRe.FindAllStringSubmatchIndex(text, -1) {
+		b.WriteString(inlineSpansHTML(text[last:loc[0]]))
+		b.WriteString("<code>" + html.EscapeString(text[loc[2]:loc[3]]) + "</code>")
+		last = loc[1]
+	}
+	b.WriteString(inlineSpansHTML(text[last:]))
+	return template.HTML(b.String()) //nolint:gosec // built from escaped text only
+}
+
+func inlineSpansHTML(text string) string {
+	s := html.EscapeString(text)
+	s = boldRe.ReplaceAllString(s, "<strong>$1$2</strong>")
+	return linkRe.ReplaceAllString(s, `<a href="$2">$1</a>`)
+}
+
+// inlinePlain strips inline markup for plain-text output (PDF). Links keep
+// their target so it survives printing.
+func inlinePlain(text string) string {
+	s := inlineCodeRe.ReplaceAllString(text, "$1")
+	s = boldRe.ReplaceAllString(s, "$1$2")
+	return linkRe.ReplaceAllString(s, "$1 ($2)")
+}
@@ -0,0 +1,56 @@
+package sessionreport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	text := "## Root cause\n\n" +
+		"The pod was\nOOMKilled.\n\n" +
+		"- first\n  continued\n* second\n\n" +
+		"1. step one\n2. step two\n\n" +
+		"```bash\nkubectl get pods\n```\n\n" +
+		"| a | b |\n|---|---|\n\n" +
+		"> quoted\n\n" +
+		"---\n"
+
+	assert.Equal(t, []block{
+		{kind: blockHeading, level: 2, lines: []string{"Root cause"}},
+		{kind: blockParagraph, lines: []string{"The pod was", "OOMKilled."}},
+		{kind: blockBullets, lines: []string{"first continued", "second"}},
+		{kind: blockNumbered, lines: []string{"step one", "step two"}},
+		{kind: blockCode, lines: []string{"kubectl get pods"}},
+		{kind: blockCode, lines: []string{"| a | b |", "|---|---|"}},
+		{kind: blockQuote, lines: []string{"quoted"}},
+		{kind: blockRule},
+	}, parseMarkdown(text))
+
+	assert.Empty(t, parseMarkdown(""))
+}
+
+func TestInlineHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "escapes markup", in: `<script>alert("x")</script>`, want: `&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;`},
+		{name: "bold", in: "a **b** c", want: "a <strong>b</strong> c"},
+		{name: "code is not formatted", in: "run `**x** <y>`", want: "run <code>**x** &lt;y&gt;</code>"},
+		{name: "http link", in: "[docs](https://x.io/a?b=1&c=2)", want: `<a href="https://x.io/a?b=1&amp;c=2">docs</a>`},
+		{name: "non-http link left as text", in: "[x](javascript:alert(1))", want: "[x](javascript:alert(1))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(inlineHTML(tt.in)))
+		})
+	}
+}
+
+func TestInlinePlain(t *testing.T) {
+	assert.Equal(t, "run kubectl on api (see docs (https://x.io))",
+		inlinePlain("run `kubectl` on **api** (see [docs](https://x.io))"))
+}
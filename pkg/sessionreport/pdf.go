@@ -0,0 +1,428 @@
+package sessionreport
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// The PDF writer below is deliberately minimal: text-only pages using the
+// standard Type 1 fonts every PDF viewer ships (no embedding), WinAnsi
+// encoding, and no compression. Characters outside WinAnsi (e.g. CJK) are
+// replaced with "?" — use the HTML format for those languages.
+
+const (
+	pageWidth    = 595.28 // A4, in points
+	pageHeight   = 841.89
+	pageMargin   = 56.0
+	footerHeight = 24.0
+	contentWidth = pageWidth - 2*pageMargin
+	factLabelW   = 96.0
+)
+
+type pdfFont int
+
+const (
+	fontRegular pdfFont = iota
+	fontBold
+	fontMono
+)
+
+var pdfFontNames = [...]string{"Helvetica", "Helvetica-Bold", "Courier"}
+
+// Glyph widths (1/1000 em) for WinAnsi 32..126 from the standard AFM files.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
+
+// winAnsiExtra maps the non-Latin-1 characters agents commonly emit to
+// their WinAnsi code points.
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// encodeWinAnsi converts s to WinAnsi bytes. Tabs become spaces; other
+// unrepresentable characters become "?".
+func encodeWinAnsi(s string) []byte {
+	out := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r == '\t':
+			out = append(out, ' ', ' ', ' ', ' ')
+		case r >= 32 && r <= 126, r >= 160 && r <= 255:
+			out = append(out, byte(r))
+		default:
+			if b, ok := winAnsiExtra[r]; ok {
+				out = append(out, b)
+			} else {
+				out = append(out, '?')
+			}
+		}
+	}
+	return out
+}
+
+// textWidth returns the width of WinAnsi-encoded text in points.
+func textWidth(text []byte, font pdfFont, size float64) float64 {
+	total := 0
+	for _, c := range text {
+		switch {
+		case font == fontMono:
+			total += 600
+		case c >= 32 && c <= 126 && font == fontBold:
+			total += helveticaBoldWidths[c-32]
+		case c >= 32 && c <= 126:
+			total += helveticaWidths[c-32]
+		default:
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// wrapText breaks text into lines no wider than width. Words longer than a
+// line are split.
+func wrapText(text []byte, font pdfFont, size, width float64) [][]byte {
+	var lines [][]byte
+	var line []byte
+	for _, word := range bytes.Fields(text) {
+		candidate := word
+		if len(line) > 0 {
+			candidate = append(append(append([]byte{}, line...), ' '), word...)
+		}
+		if textWidth(candidate, font, size) <= width {
+			line = candidate
+			continue
+		}
+		if len(line) > 0 {
+			lines = append(lines, line)
+			line = nil
+		}
+		for textWidth(word, font, size) > width {
+			n := 1
+			for n < len(word) && textWidth(word[:n+1], font, size) <= width {
+				n++
+			}
+			lines = append(lines, word[:n])
+			word = word[n:]
+		}
+		line = word
+	}
+	if len(line) > 0 || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// pdfString escapes WinAnsi bytes as a PDF literal string.
+func pdfString(text []byte) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, c := range text {
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c > 126:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// pdfTextString encodes s as a UTF-16BE hex string, the PDF encoding for
+// document metadata outside page content.
+func pdfTextString(s string) string {
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+// pdfDoc lays out text top-down across A4 pages. y is the distance of the
+// cursor from the top edge of the current page.
+type pdfDoc struct {
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	y     float64
+}
+
+func newPDFDoc() *pdfDoc {
+	d := &pdfDoc{}
+	d.newPage()
+	return d
+}
+
+func (d *pdfDoc) newPage() {
+	d.page = &bytes.Buffer{}
+	d.pages = append(d.pages, d.page)
+	d.y = pageMargin
+}
+
+// ensure starts a new page unless h points fit above the footer.
+func (d *pdfDoc) ensure(h float64) {
+	if d.y+h > pageHeight-pageMargin-footerHeight {
+		d.newPage()
+	}
+}
+
+func (d *pdfDoc) space(h float64) {
+	d.y += h
+}
+
+// text draws one line with its baseline at the cursor.
+func (d *pdfDoc) text(font pdfFont, size, x float64, text []byte, gray float64) {
+	fmt.Fprintf(d.page, "%.2f g BT /F%d %.1f Tf %.2f %.2f Td %s Tj ET\n",
+		gray, int(font)+1, size, x, pageHeight-d.y, pdfString(text))
+}
+
+// fillRect fills a rectangle whose top-left corner is (x, top).
+func (d *pdfDoc) fillRect(x, top, w, h, gray float64) {
+	fmt.Fprintf(d.page, "%.2f g %.2f %.2f %.2f %.2f re f\n", gray, x, pageHeight-top-h, w, h)
+}
+
+// rule draws a horizontal line across the content width at the cursor.
+func (d *pdfDoc) rule() {
+	fmt.Fprintf(d.page, "0.8 G 0.5 w %.2f %.2f m %.2f %.2f l S\n",
+		pageMargin, pageHeight-d.y, pageWidth-pageMargin, pageHeight-d.y)
+}
+
+// paragraph wraps text at the given indent and advances the cursor.
+func (d *pdfDoc) paragraph(font pdfFont, size, indent float64, text string, gray float64) {
+	leading := size * 1.4
+	for _, line := range wrapText(encodeWinAnsi(text), font, size, contentWidth-indent) {
+		d.ensure(leading)
+		d.y += leading
+		d.text(font, size, pageMargin+indent, line, gray)
+	}
+}
+
+// listItem renders a bullet or number marker with hanging-indented text.
+func (d *pdfDoc) listItem(marker, text string, size float64) {
+	leading := size * 1.4
+	lines := wrapText(encodeWinAnsi(text), fontRegular, size, contentWidth-18)
+	for i, line := range lines {
+		d.ensure(leading)
+		d.y += leading
+		if i == 0 {
+			d.text(fontRegular, size, pageMargin+4, encodeWinAnsi(marker), 0)
+		}
+		d.text(fontRegular, size, pageMargin+18, line, 0)
+	}
+}
+
+// code renders preformatted lines in Courier on a light background,
+// wrapping lines that do not fit instead of clipping them.
+func (d *pdfDoc) code(lines []string, size float64) {
+	leading := size * 1.35
+	for _, raw := range lines {
+		encoded := encodeWinAnsi(raw)
+		wrapped := [][]byte{encoded}
+		if textWidth(encoded, fontMono, size) > contentWidth-8 {
+			perLine := int((contentWidth - 8) / (0.6 * size))
+			wrapped = nil
+			for len(encoded) > perLine {
+				wrapped = append(wrapped, encoded[:perLine])
+				encoded = encoded[perLine:]
+			}
+			wrapped = append(wrapped, encoded)
+		}
+		for _, line := range wrapped {
+			d.ensure(leading)
+			d.fillRect(pageMargin, d.y, contentWidth, leading, 0.95)
+			d.y += leading
+			d.text(fontMono, size, pageMargin+4, line, 0)
+		}
+	}
+}
+
+func (d *pdfDoc) heading(text string, size float64) {
+	d.ensure(size*2.4 + 12) // Keep the heading with at least one line of body
+	d.space(size * 0.8)
+	d.paragraph(fontBold, size, 0, text, 0)
+	d.space(4)
+}
+
+// footers stamps every page with footer text and "Page i of n".
+func (d *pdfDoc) footers(footer string) {
+	for i, page := range d.pages {
+		label := encodeWinAnsi(fmt.Sprintf("%s  ·  Page %d of %d", footer, i+1, len(d.pages)))
+		fmt.Fprintf(page, "0.55 g BT /F1 8.0 Tf %.2f %.2f Td %s Tj ET\n",
+			pageMargin, pageMargin/2, pdfString(label))
+	}
+}
+
+// bytes serialises the document.
+func (d *pdfDoc) bytes(title string) []byte {
+	var out bytes.Buffer
+	var offsets []int
+	obj := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 1: catalog, 2: page tree, 3-5: fonts, 6: info, then page/content pairs
+	const firstPageObj = 7
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObj+2*i)
+	}
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	for _, name := range pdfFontNames {
+		obj(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name))
+	}
+	obj(fmt.Sprintf("<< /Title %s /Producer (TARSy) >>", pdfTextString(title)))
+	for i, page := range d.pages {
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R /F3 5 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, firstPageObj+2*i+1))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", page.Len(), page.Bytes()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 6 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(offsets)+1, xref)
+	return out.Bytes()
+}
+
+// renderPDF renders r as a paginated A4 PDF.
+func renderPDF(r *Report) ([]byte, error) {
+	d := newPDFDoc()
+
+	d.paragraph(fontBold, 18, 0, r.Title, 0)
+	d.space(10)
+	for _, f := range r.Facts {
+		lines := wrapText(encodeWinAnsi(f.Value), fontRegular, 10, contentWidth-factLabelW)
+		for i, line := range lines {
+			d.ensure(14)
+			d.y += 14
+			if i == 0 {
+				d.text(fontBold, 10, pageMargin, encodeWinAnsi(f.Label), 0)
+			}
+			d.text(fontRegular, 10, pageMargin+factLabelW, line, 0)
+		}
+	}
+
+	if r.ExecutiveSummary != "" {
+		pdfSection(d, "Executive summary")
+		for _, para := range strings.Split(strings.TrimSpace(r.ExecutiveSummary), "\n") {
+			d.paragraph(fontRegular, 11, 0, para, 0)
+		}
+	}
+	if r.TechnicalSummary != "" {
+		pdfSection(d, "Technical summary")
+		for _, blk := range parseMarkdown(r.TechnicalSummary) {
+			pdfBlock(d, blk)
+		}
+	}
+	if r.ErrorMessage != "" {
+		pdfSection(d, "Error")
+		d.code(strings.Split(strings.TrimSpace(r.ErrorMessage), "\n"), 9)
+	}
+
+	pdfSection(d, "Final analysis")
+	if r.FinalAnalysis == "" {
+		d.paragraph(fontRegular, 10.5, 0, "No final analysis available.", 0.4)
+	}
+	for _, blk := range parseMarkdown(r.FinalAnalysis) {
+		pdfBlock(d, blk)
+	}
+
+	if r.HandoffNotes != "" {
+		pdfSection(d, "Handoff notes")
+		for _, blk := range parseMarkdown(r.HandoffNotes) {
+			pdfBlock(d, blk)
+		}
+		d.paragraph(fontRegular, 9, 0, r.HandoffNotesBy, 0.4)
+	}
+
+	if len(r.Milestones) > 0 {
+		pdfSection(d, "Timeline")
+		for _, m := range r.Milestones {
+			event := m.Event
+			if m.Detail != "" {
+				event += " (" + m.Detail + ")"
+			}
+			d.ensure(14)
+			d.y += 14
+			d.text(fontMono, 8.5, pageMargin, encodeWinAnsi(formatTime(m.Time)), 0.4)
+			lines := wrapText(encodeWinAnsi(event), fontRegular, 10, contentWidth-130)
+			for i, line := range lines {
+				if i > 0 {
+					d.ensure(14)
+					d.y += 14
+				}
+				d.text(fontRegular, 10, pageMargin+130, line, 0)
+			}
+		}
+	}
+
+	if r.SessionURL != "" {
+		d.space(12)
+		d.paragraph(fontRegular, 9, 0, "View session in TARSy: "+r.SessionURL, 0.4)
+	}
+
+	d.footers(footerText(r))
+	return d.bytes(r.Title), nil
+}
+
+func pdfSection(d *pdfDoc, title string) {
+	d.space(10)
+	d.heading(title, 14)
+	d.rule()
+	d.space(2)
+}
+
+func pdfBlock(d *pdfDoc, blk block) {
+	switch blk.kind {
+	case blockHeading:
+		d.heading(inlinePlain(blk.lines[0]), max(11, 13-float64(blk.level)/2))
+	case blockBullets:
+		for _, item := range blk.lines {
+			d.listItem("•", inlinePlain(item), 10.5)
+		}
+	case blockNumbered:
+		for i, item := range blk.lines {
+			d.listItem(fmt.Sprintf("%d.", i+1), inlinePlain(item), 10.5)
+		}
+	case blockCode:
+		d.code(blk.lines, 8.5)
+	case blockQuote:
+		d.paragraph(fontRegular, 10.5, 12, inlinePlain(strings.Join(blk.lines, " ")), 0.4)
+	case blockRule:
+		d.space(6)
+		d.rule()
+	default:
+		d.paragraph(fontRegular, 10.5, 0, inlinePlain(strings.Join(blk.lines, " ")), 0)
+	}
+	d.space(6)
+}
@@ -0,0 +1,269 @@
+package sessionreport
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// Format is an output format for session reports.
+type Format string
+
+const (
+	FormatHTML     Format = "html"
+	FormatPDF      Format = "pdf"
+	FormatMarkdown Format = "markdown"
+)
+
+// ParseFormat parses a format query value. Empty means HTML; "md" is
+// accepted as shorthand for markdown.
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "", string(FormatHTML):
+		return FormatHTML, nil
+	case string(FormatPDF):
+		return FormatPDF, nil
+	case string(FormatMarkdown), "md":
+		return FormatMarkdown, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q (expected html, pdf, or markdown)", s)
+	}
+}
+
+// ContentType returns the HTTP content type for f.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatPDF:
+		return "application/pdf"
+	case FormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	default:
+		return "text/html; charset=utf-8"
+	}
+}
+
+// Extension returns the file extension for f, without the dot.
+func (f Format) Extension() string {
+	if f == FormatMarkdown {
+		return "md"
+	}
+	return string(f)
+}
+
+// Render renders r in format f.
+func Render(r *Report, f Format) ([]byte, error) {
+	switch f {
+	case FormatHTML:
+		return renderHTML(r)
+	case FormatPDF:
+		return renderPDF(r)
+	case FormatMarkdown:
+		return renderMarkdown(r), nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", f)
+	}
+}
+
+// renderMarkdown renders r as Markdown. The final analysis is embedded
+// verbatim since it is already Markdown.
+func renderMarkdown(r *Report) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", r.Title)
+	for _, f := range r.Facts {
+		fmt.Fprintf(&b, "- **%s:** %s\n", f.Label, f.Value)
+	}
+	if r.ExecutiveSummary != "" {
+		fmt.Fprintf(&b, "\n## Executive summary\n\n%s\n", strings.TrimSpace(r.ExecutiveSummary))
+	}
+	if r.TechnicalSummary != "" {
+		fmt.Fprintf(&b, "\n## Technical summary\n\n%s\n", strings.TrimSpace(r.TechnicalSummary))
+	}
+	if r.ErrorMessage != "" {
+		fmt.Fprintf(&b, "\n## Error\n\n```\n%s\n```\n", strings.TrimSpace(r.ErrorMessage))
+	}
+	b.WriteString("\n## Final analysis\n\n")
+	if r.FinalAnalysis != "" {
+		b.WriteString(strings.TrimSpace(r.FinalAnalysis) + "\n")
+	} else {
+		b.WriteString("_No final analysis available._\n")
+	}
+	if r.HandoffNotes != "" {
+		fmt.Fprintf(&b, "\n## Handoff notes\n\n%s\n\n_%s_\n", strings.TrimSpace(r.HandoffNotes), r.HandoffNotesBy)
+	}
+	if len(r.Milestones) > 0 {
+		b.WriteString("\n## Timeline\n\n")
+		for _, m := range r.Milestones {
+			fmt.Fprintf(&b, "- %s — %s", formatTime(m.Time), m.Event)
+			if m.Detail != "" {
+				fmt.Fprintf(&b, " (%s)", m.Detail)
+			}
+			b.WriteString("\n")
+		}
+	}
+	fmt.Fprintf(&b, "\n---\n\n%s\n", footerText(r))
+	if r.SessionURL != "" {
+		fmt.Fprintf(&b, "\n[View session in TARSy](%s)\n", r.SessionURL)
+	}
+	return []byte(b.String())
+}
+
+func footerText(r *Report) string {
+	return "Generated by TARSy on " + formatTime(r.GeneratedAt)
+}
+
+const reportCSS = `
+body { font-family: -apple-system, Segoe UI, Helvetica, Arial, sans-serif; font-size: 14px; color: #212121; max-width: 860px; margin: 32px auto; padding: 0 16px; line-height: 1.5; }
+h1 { font-size: 22px; margin-bottom: 8px; }
+h2 { font-size: 17px; border-bottom: 1px solid #e0e0e0; padding-bottom: 4px; margin-top: 28px; }
+h3, h4, h5, h6 { font-size: 15px; margin-top: 18px; }
+table.facts td { padding: 2px 16px 2px 0; vertical-align: top; }
+table.facts td:first-child { font-weight: 600; white-space: nowrap; }
+.summary { background: #e3f2fd; border-left: 4px solid #1976d2; padding: 10px 14px; white-space: pre-wrap; }
+.error { background: #ffebee; border-left: 4px solid #c62828; padding: 10px 14px; white-space: pre-wrap; font-family: monospace; }
+pre { background: #f5f5f5; padding: 10px; border-radius: 4px; overflow-x: auto; font-size: 12px; }
+code { background: #f5f5f5; padding: 1px 4px; border-radius: 3px; font-size: 12px; }
+blockquote { border-left: 3px solid #bdbdbd; margin: 0; padding-left: 12px; color: #616161; }
+ol.timeline { list-style: none; padding-left: 0; }
+ol.timeline li { padding: 2px 0; }
+ol.timeline .time { color: #757575; font-family: monospace; margin-right: 8px; }
+ol.timeline .detail { color: #757575; }
+.attribution { color: #757575; font-size: 12px; font-style: italic; }
+footer { margin-top: 32px; color: #9e9e9e; font-size: 12px; }
+@media print { body { margin: 0; } a { color: inherit; } }
+`
+
+var htmlTemplate = template.Must(template.New("session-report").Funcs(template.FuncMap{
+	"formatTime": formatTime,
+}).Parse(`<!DOCTYPE html>
+<html lang="en"><head>
+<meta charset="utf-8">
+<title>{{.R.Title}}</title>
+<style>` + reportCSS + `</style>
+</head><body>
+<h1>{{.R.Title}}</h1>
+<table class="facts">
+{{- range .R.Facts}}
+<tr><td>{{.Label}}</td><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+{{- if .R.ExecutiveSummary}}
+<h2>Executive summary</h2>
+<div class="summary">{{.R.ExecutiveSummary}}</div>
+{{- end}}
+{{- if .R.TechnicalSummary}}
+<h2>Technical summary</h2>
+{{.TechnicalSummary}}
+{{- end}}
+{{- if .R.ErrorMessage}}
+<h2>Error</h2>
+<div class="error">{{.R.ErrorMessage}}</div>
+{{- end}}
+<h2>Final analysis</h2>
+{{- if .Analysis}}
+{{.Analysis}}
+{{- else}}
+<p><i>No final analysis available.</i></p>
+{{- end}}
+{{- if .R.HandoffNotes}}
+<h2>Handoff notes</h2>
+{{.HandoffNotes}}
+<p class="attribution">{{.R.HandoffNotesBy}}</p>
+{{- end}}
+{{- if .R.Milestones}}
+<h2>Timeline</h2>
+<ol class="timeline">
+{{- range .R.Milestones}}
+<li><span class="time">{{formatTime .Time}}</span>{{.Event}}{{if .Detail}} <span class="detail">({{.Detail}})</span>{{end}}</li>
+{{- end}}
+</ol>
+{{- end}}
+<footer>{{.Footer}}{{if .R.SessionURL}} &middot; <a href="{{.R.SessionURL}}">View session in TARSy</a>{{end}}</footer>
+</body></html>
+`))
+
+var blockTemplate = template.Must(template.New("blocks").Funcs(template.FuncMap{
+	"inline": inlineHTML,
+}).Parse(`
+{{- define "block"}}
+{{- if eq .Kind "heading"}}<h{{.Level}}>{{inline .Text}}</h{{.Level}}>
+{{- else if eq .Kind "bullets"}}<ul>{{range .Lines}}<li>{{inline .}}</li>{{end}}</ul>
+{{- else if eq .Kind "numbered"}}<ol>{{range .Lines}}<li>{{inline .}}</li>{{end}}</ol>
+{{- else if eq .Kind "code"}}<pre>{{.Text}}</pre>
+{{- else if eq .Kind "quote"}}<blockquote>{{inline .Text}}</blockquote>
+{{- else if eq .Kind "rule"}}<hr>
+{{- else}}<p>{{inline .Text}}</p>
+{{- end}}
+{{- end}}
+{{- range .}}{{template "block" .}}
+{{end}}`))
+
+// blockView adapts a block for blockTemplate.
+type blockView struct {
+	Kind  string
+	Level int
+	Text  string
+	Lines []string
+}
+
+// analysisHTML renders agent or operator markdown as HTML. Headings are
+// shifted down two levels so they nest under the report's own section
+// heading.
+func analysisHTML(text string) (template.HTML, error) {
+	blocks := parseMarkdown(text)
+	views := make([]blockView, 0, len(blocks))
+	for _, blk := range blocks {
+		v := blockView{Lines: blk.lines, Text: strings.Join(blk.lines, "\n")}
+		switch blk.kind {
+		case blockHeading:
+			v.Kind, v.Level = "heading", min(blk.level+2, 6)
+		case blockBullets:
+			v.Kind = "bullets"
+		case blockNumbered:
+			v.Kind = "numbered"
+		case blockCode:
+			v.Kind = "code"
+		case blockQuote:
+			v.Kind, v.Text = "quote", strings.Join(blk.lines, " ")
+		case blockRule:
+			v.Kind = "rule"
+		default:
+			v.Kind, v.Text = "paragraph", strings.Join(blk.lines, " ")
+		}
+		views = append(views, v)
+	}
+
+	var buf bytes.Buffer
+	if err := blockTemplate.Execute(&buf, views); err != nil {
+		return "", err
+	}
+	return template.HTML(buf.String()), nil //nolint:gosec // rendered by html/template
+}
+
+// renderHTML renders r as a self-contained, print-friendly HTML page.
+func renderHTML(r *Report) ([]byte, error) {
+	analysis, err := analysisHTML(r.FinalAnalysis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render final analysis: %w", err)
+	}
+	notes, err := analysisHTML(r.HandoffNotes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render handoff notes: %w", err)
+	}
+	technical, err := analysisHTML(r.TechnicalSummary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render technical summary: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, struct {
+		R                *Report
+		Analysis         template.HTML
+		TechnicalSummary template.HTML
+		HandoffNotes     template.HTML
+		Footer           string
+	}{R: r, Analysis: analysis, TechnicalSummary: technical, HandoffNotes: notes, Footer: footerText(r)}); err != nil {
+		return nil, fmt.Errorf("failed to render session report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,180 @@
+package sessionreport
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReport() *Report {
+	r := Build(testDetail(), "https://tarsy.example.com", time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC))
+	r.FinalAnalysis = "## Root cause\n\nThe **api** pod hit its <limit>.\n\n- Raise the limit\n\n```\nkubectl top pod\n```"
+	return r
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Format
+		wantErr bool
+	}{
+		{in: "", want: FormatHTML},
+		{in: "html", want: FormatHTML},
+		{in: "PDF", want: FormatPDF},
+		{in: "markdown", want: FormatMarkdown},
+		{in: "md", want: FormatMarkdown},
+		{in: "docx", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseFormat(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.Equal(t, "application/pdf", FormatPDF.ContentType())
+	assert.Equal(t, "md", FormatMarkdown.Extension())
+}
+
+func TestRender_Markdown(t *testing.T) {
+	out, err := Render(testReport(), FormatMarkdown)
+	require.NoError(t, err)
+	md := string(out)
+
+	assert.True(t, strings.HasPrefix(md, "# Incident report — PodCrashLoop\n"))
+	assert.Contains(t, md, "- **Severity:** HIGH\n")
+	assert.Contains(t, md, "## Executive summary\n\nThe api pod was OOMKilled")
+	assert.Contains(t, md, "## Final analysis\n\n## Root cause")
+	assert.Contains(t, md, "## Handoff notes\n\nRolled back to 1.3; watch memory overnight.\n\n_Last edited by bob on 2026-03-04 10:07:00 UTC (revision 2)_\n")
+	assert.Contains(t, md, "- 2026-03-04 10:01:00 UTC — Investigation started\n")
+	assert.Contains(t, md, "Generated by TARSy on 2026-03-05 08:00:00 UTC")
+	assert.Contains(t, md, "[View session in TARSy](https://tarsy.example.com/sessions/sess-1)")
+}
+
+func TestRender_HTML(t *testing.T) {
+	out, err := Render(testReport(), FormatHTML)
+	require.NoError(t, err)
+	page := string(out)
+
+	assert.Contains(t, page, "<title>Incident report — PodCrashLoop</title>")
+	assert.Contains(t, page, "<h4>Root cause</h4>")
+	assert.Contains(t, page, "The <strong>api</strong> pod hit its &lt;limit&gt;.")
+	assert.Contains(t, page, "<ul><li>Raise the limit</li></ul>")
+	assert.Contains(t, page, "<pre>kubectl top pod</pre>")
+	assert.Contains(t, page, "<h2>Handoff notes</h2>\n<p>Rolled back to 1.3; watch memory overnight.</p>")
+	assert.Contains(t, page, `<p class="attribution">Last edited by bob on 2026-03-04 10:07:00 UTC (revision 2)</p>`)
+	assert.Contains(t, page, `<a href="https://tarsy.example.com/sessions/sess-1">`)
+	assert.NotContains(t, page, "<limit>")
+}
+
+func TestRender_TechnicalSummary(t *testing.T) {
+	r := testReport()
+	r.TechnicalSummary = "## Next Steps\n\n1. Raise the memory limit to 1Gi"
+
+	out, err := Render(r, FormatMarkdown)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "## Technical summary\n\n## Next Steps\n\n1. Raise the memory limit to 1Gi\n")
+
+	out, err = Render(r, FormatHTML)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "<h2>Technical summary</h2>")
+	assert.Contains(t, string(out), "<ol><li>Raise the memory limit to 1Gi</li></ol>")
+
+	out, err = Render(testReport(), FormatMarkdown)
+	require.NoError(t, err)
+	assert.NotContains(t, string(out), "Technical summary")
+}
+
+func TestRender_HTMLWithoutAnalysis(t *testing.T) {
+	r := testReport()
+	r.FinalAnalysis = ""
+	r.ErrorMessage = "LLM <provider> unavailable"
+
+	out, err := Render(r, FormatHTML)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "No final analysis available.")
+	assert.Contains(t, string(out), "LLM &lt;provider&gt; unavailable")
+}
+
+// assertValidPDF checks the header, trailer, xref offsets, and stream
+// lengths, and returns the page count.
+func assertValidPDF(t *testing.T, data []byte) int {
+	t.Helper()
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")))
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+	require.NotNil(t, m)
+	xref, err := strconv.Atoi(string(m[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data[xref:], []byte("xref\n")))
+
+	for i, off := range regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data, -1) {
+		n, err := strconv.Atoi(string(off[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data[n:], []byte(strconv.Itoa(i+1)+" 0 obj\n")), "xref entry %d", i+1)
+	}
+	for _, loc := range regexp.MustCompile(`/Length (\d+) >>\nstream\n`).FindAllSubmatchIndex(data, -1) {
+		length, err := strconv.Atoi(string(data[loc[2]:loc[3]]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data[loc[1]+length:], []byte("\nendstream")))
+	}
+
+	count := regexp.MustCompile(`/Count (\d+)`).FindSubmatch(data)
+	require.NotNil(t, count)
+	pages, err := strconv.Atoi(string(count[1]))
+	require.NoError(t, err)
+	return pages
+}
+
+func TestRender_PDF(t *testing.T) {
+	out, err := Render(testReport(), FormatPDF)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, assertValidPDF(t, out))
+	assert.Contains(t, string(out), "(Incident report \\227 PodCrashLoop) Tj")
+	assert.Contains(t, string(out), "(The api pod hit its <limit>.) Tj")
+	assert.Contains(t, string(out), "(Handoff notes) Tj")
+	assert.Contains(t, string(out), "Page 1 of 1")
+}
+
+func TestRender_PDFPaginates(t *testing.T) {
+	r := testReport()
+	r.FinalAnalysis = strings.Repeat("A long paragraph about the incident that wraps across lines. ", 800)
+
+	out, err := Render(r, FormatPDF)
+	require.NoError(t, err)
+
+	pages := assertValidPDF(t, out)
+	assert.Greater(t, pages, 3)
+	assert.Contains(t, string(out), "Page "+strconv.Itoa(pages)+" of "+strconv.Itoa(pages))
+}
+
+func TestEncodeWinAnsi(t *testing.T) {
+	assert.Equal(t, []byte("caf\xe9 \x97 ok?"), encodeWinAnsi("café — ok?"))
+	assert.Equal(t, []byte("??"), encodeWinAnsi("日本"))
+	assert.Equal(t, []byte("a    b"), encodeWinAnsi("a\tb"))
+}
+
+func TestWrapText(t *testing.T) {
+	lines := wrapText([]byte("alpha beta gamma"), fontRegular, 10, textWidth([]byte("alpha beta"), fontRegular, 10))
+	assert.Equal(t, [][]byte{[]byte("alpha beta"), []byte("gamma")}, lines)
+
+	long := wrapText([]byte(strings.Repeat("x", 40)), fontMono, 10, 60)
+	require.Len(t, long, 4)
+	assert.Len(t, long[0], 10)
+
+	assert.Equal(t, [][]byte{nil}, wrapText(nil, fontRegular, 10, 100))
+}
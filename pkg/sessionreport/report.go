@@ -0,0 +1,212 @@
+// Package sessionreport renders a session's final analysis, executive
+// summary, and key milestones as a standalone Markdown, HTML, or PDF
+// document for attaching to tickets or emailing to stakeholders.
+package sessionreport
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+// timeLayout is used for every timestamp in rendered reports.
+const timeLayout = "2006-01-02 15:04:05 UTC"
+
+// milestoneStageTypes are the stage types listed as milestones. Chat,
+// scoring, and executive summary stages are follow-up work, not part of
+// the incident timeline.
+var milestoneStageTypes = map[string]bool{
+	"investigation": true,
+	"synthesis":     true,
+	"action":        true,
+}
+
+var statusLabel = map[string]string{
+	"pending":        "Pending",
+	"in_progress":    "In progress",
+	"cancelling":     "Cancelling",
+	"completed":      "Completed",
+	"failed":         "Failed",
+	"cancelled":      "Cancelled",
+	"timed_out":      "Timed out",
+	"auto_cancelled": "Auto-cancelled",
+}
+
+// Report is the format-independent content of a session report.
+type Report struct {
+	Title            string
+	SessionID        string
+	SessionURL       string // Empty when no dashboard URL is configured
+	Facts            []Fact
+	ExecutiveSummary string
+	TechnicalSummary string // Markdown; empty unless the chain enables technical summaries
+	FinalAnalysis    string // Markdown as produced by the agent
+	HandoffNotes     string // Operator-written Markdown
+	HandoffNotesBy   string // Attribution of the latest edit, e.g. "Last edited by alice on ..."
+	ErrorMessage     string
+	Milestones       []Milestone
+	GeneratedAt      time.Time
+}
+
+// Fact is a labelled value in the report header table.
+type Fact struct {
+	Label string
+	Value string
+}
+
+// Milestone is a point on the session timeline.
+type Milestone struct {
+	Time   time.Time
+	Event  string
+	Detail string
+}
+
+// Build assembles the report for a session. dashboardURL may be empty.
+func Build(detail *models.SessionDetailResponse, dashboardURL string, now time.Time) *Report {
+	r := &Report{
+		Title:       "Incident report — " + alertTypeLabel(detail.AlertType),
+		SessionID:   detail.ID,
+		GeneratedAt: now.UTC(),
+	}
+	if dashboardURL != "" {
+		r.SessionURL = fmt.Sprintf("%s/sessions/%s", strings.TrimRight(dashboardURL, "/"), detail.ID)
+	}
+	if detail.ExecutiveSummary != nil {
+		r.ExecutiveSummary = *detail.ExecutiveSummary
+	}
+	if detail.TechnicalSummary != nil {
+		r.TechnicalSummary = *detail.TechnicalSummary
+	}
+	if detail.FinalAnalysis != nil {
+		r.FinalAnalysis = *detail.FinalAnalysis
+	}
+	if detail.ErrorMessage != nil {
+		r.ErrorMessage = *detail.ErrorMessage
+	}
+	if detail.HandoffNotes != nil {
+		r.HandoffNotes = *detail.HandoffNotes
+		r.HandoffNotesBy = handoffNotesAttribution(detail)
+	}
+	r.Facts = facts(detail)
+	r.Milestones = milestones(detail)
+	return r
+}
+
+func facts(d *models.SessionDetailResponse) []Fact {
+	out := []Fact{
+		{Label: "Status", Value: StatusLabel(d.Status)},
+		{Label: "Alert type", Value: alertTypeLabel(d.AlertType)},
+		{Label: "Chain", Value: d.ChainID},
+	}
+	if d.Severity != nil && *d.Severity != "" {
+		out = append(out, Fact{Label: "Severity", Value: strings.ToUpper(*d.Severity)})
+	}
+	if d.Author != nil && *d.Author != "" {
+		out = append(out, Fact{Label: "Submitted by", Value: *d.Author})
+	}
+	out = append(out, Fact{Label: "Submitted", Value: formatTime(d.CreatedAt)})
+	if d.DurationMs != nil {
+		out = append(out, Fact{Label: "Duration", Value: formatDuration(time.Duration(*d.DurationMs) * time.Millisecond)})
+	}
+	if d.TotalTokens > 0 {
+		usage := fmt.Sprintf("%d tokens", d.TotalTokens)
+		if d.EstimatedCostUsd != nil {
+			usage += fmt.Sprintf(" · $%.2f estimated", *d.EstimatedCostUsd)
+		}
+		out = append(out, Fact{Label: "LLM usage", Value: usage})
+	}
+	if d.DegradedReason != nil && *d.DegradedReason != "" {
+		out = append(out, Fact{Label: "Degraded", Value: *d.DegradedReason})
+	}
+	out = append(out, Fact{Label: "Session ID", Value: d.ID})
+	return out
+}
+
+// milestones lists submission, start, each investigation-relevant stage,
+// source-alert resolution, and completion in chronological order.
+func milestones(d *models.SessionDetailResponse) []Milestone {
+	out := []Milestone{{Time: d.CreatedAt, Event: "Alert submitted"}}
+	if d.StartedAt != nil {
+		out = append(out, Milestone{Time: *d.StartedAt, Event: "Investigation started"})
+	}
+	for _, stg := range d.Stages {
+		if !milestoneStageTypes[stg.StageType] {
+			continue
+		}
+		at := stg.CompletedAt
+		if at == nil {
+			at = stg.StartedAt
+		}
+		if at == nil {
+			continue
+		}
+		out = append(out, Milestone{
+			Time:   *at,
+			Event:  fmt.Sprintf("Stage %q %s", stg.StageName, strings.ToLower(StatusLabel(stg.Status))),
+			Detail: agentNames(stg.Executions),
+		})
+	}
+	if d.AlertResolvedAt != nil {
+		m := Milestone{Time: *d.AlertResolvedAt, Event: "Source alert resolved"}
+		if d.AlertResolution != nil {
+			m.Detail = *d.AlertResolution
+		}
+		out = append(out, m)
+	}
+	if d.CompletedAt != nil {
+		out = append(out, Milestone{Time: *d.CompletedAt, Event: "Session " + strings.ToLower(StatusLabel(d.Status))})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time.Before(out[j].Time) })
+	return out
+}
+
+// handoffNotesAttribution names the author and time of the latest edit of
+// the handoff notes.
+func handoffNotesAttribution(d *models.SessionDetailResponse) string {
+	out := "Last edited"
+	if d.HandoffNotesUpdatedBy != nil && *d.HandoffNotesUpdatedBy != "" {
+		out += " by " + *d.HandoffNotesUpdatedBy
+	}
+	if d.HandoffNotesUpdatedAt != nil {
+		out += " on " + formatTime(*d.HandoffNotesUpdatedAt)
+	}
+	if d.HandoffNotesRevision > 1 {
+		out += fmt.Sprintf(" (revision %d)", d.HandoffNotesRevision)
+	}
+	return out
+}
+
+func agentNames(execs []models.ExecutionOverview) string {
+	names := make([]string, 0, len(execs))
+	for _, e := range execs {
+		names = append(names, e.AgentName)
+	}
+	return strings.Join(names, ", ")
+}
+
+// StatusLabel returns the human-readable label for a session or stage status.
+func StatusLabel(status string) string {
+	if label, ok := statusLabel[status]; ok {
+		return label
+	}
+	return status
+}
+
+func alertTypeLabel(alertType *string) string {
+	if alertType == nil || *alertType == "" {
+		return "(no alert type)"
+	}
+	return *alertType
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+// formatDuration renders d rounded to seconds, e.g. "4m12s".
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
@@ -0,0 +1,107 @@
+package sessionreport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+func at(minute int) *time.Time {
+	t := time.Date(2026, 3, 4, 10, minute, 0, 0, time.UTC)
+	return &t
+}
+
+func strPtr(s string) *string { return &s }
+
+func testDetail() *models.SessionDetailResponse {
+	duration := int64(252_000)
+	cost := 0.42
+	return &models.SessionDetailResponse{
+		ID:               "sess-1",
+		AlertType:        strPtr("PodCrashLoop"),
+		Status:           "completed",
+		ChainID:          "k8s-chain",
+		Author:           strPtr("alice"),
+		Severity:         strPtr("high"),
+		ExecutiveSummary: strPtr("The api pod was OOMKilled after the 1.4 rollout."),
+		FinalAnalysis:    strPtr("## Root cause\n\nMemory limit too low."),
+		AlertResolvedAt:  at(6),
+		AlertResolution:  strPtr("auto-resolved"),
+		CreatedAt:        *at(0),
+		StartedAt:        at(1),
+		CompletedAt:      at(5),
+		DurationMs:       &duration,
+		TotalTokens:      12345,
+		EstimatedCostUsd: &cost,
+		Stages: []models.StageOverview{
+			{StageName: "investigate", StageType: "investigation", Status: "completed", StartedAt: at(1), CompletedAt: at(3),
+				Executions: []models.ExecutionOverview{{AgentName: "KubernetesAgent"}, {AgentName: "LogAgent"}}},
+			{StageName: "synthesize", StageType: "synthesis", Status: "failed", StartedAt: at(3)},
+			{StageName: "Executive Summary", StageType: "exec_summary", Status: "completed", CompletedAt: at(4)},
+			{StageName: "not started", StageType: "action", Status: "pending"},
+		},
+		HandoffNotes:          strPtr("Rolled back to 1.3; watch memory overnight."),
+		HandoffNotesRevision:  2,
+		HandoffNotesUpdatedBy: strPtr("bob"),
+		HandoffNotesUpdatedAt: at(7),
+	}
+}
+
+func TestBuild(t *testing.T) {
+	now := time.Date(2026, 3, 5, 8, 0, 0, 0, time.FixedZone("CET", 3600))
+	r := Build(testDetail(), "https://tarsy.example.com/", now)
+
+	assert.Equal(t, "Incident report — PodCrashLoop", r.Title)
+	assert.Equal(t, "https://tarsy.example.com/sessions/sess-1", r.SessionURL)
+	assert.Equal(t, "The api pod was OOMKilled after the 1.4 rollout.", r.ExecutiveSummary)
+	assert.Equal(t, time.UTC, r.GeneratedAt.Location())
+	assert.Equal(t, "Rolled back to 1.3; watch memory overnight.", r.HandoffNotes)
+	assert.Equal(t, "Last edited by bob on 2026-03-04 10:07:00 UTC (revision 2)", r.HandoffNotesBy)
+
+	facts := make(map[string]string, len(r.Facts))
+	for _, f := range r.Facts {
+		facts[f.Label] = f.Value
+	}
+	assert.Equal(t, "Completed", facts["Status"])
+	assert.Equal(t, "HIGH", facts["Severity"])
+	assert.Equal(t, "alice", facts["Submitted by"])
+	assert.Equal(t, "4m12s", facts["Duration"])
+	assert.Equal(t, "12345 tokens · $0.42 estimated", facts["LLM usage"])
+	assert.NotContains(t, facts, "Degraded")
+
+	require.Len(t, r.Milestones, 6)
+	events := make([]string, len(r.Milestones))
+	for i, m := range r.Milestones {
+		events[i] = m.Event
+	}
+	assert.Equal(t, []string{
+		"Alert submitted",
+		"Investigation started",
+		`Stage "investigate" completed`,
+		`Stage "synthesize" failed`,
+		"Session completed",
+		"Source alert resolved",
+	}, events)
+	assert.Equal(t, "KubernetesAgent, LogAgent", r.Milestones[2].Detail)
+	assert.Equal(t, "auto-resolved", r.Milestones[5].Detail)
+}
+
+func TestBuild_MinimalSession(t *testing.T) {
+	r := Build(&models.SessionDetailResponse{
+		ID:        "sess-2",
+		Status:    "in_progress",
+		CreatedAt: *at(0),
+	}, "", time.Now())
+
+	assert.Equal(t, "Incident report — (no alert type)", r.Title)
+	assert.Empty(t, r.SessionURL)
+	assert.Empty(t, r.FinalAnalysis)
+	assert.Empty(t, r.HandoffNotes)
+	require.Len(t, r.Milestones, 1)
+	assert.Equal(t, "Alert submitted", r.Milestones[0].Event)
+	assert.Equal(t, Fact{Label: "Status", Value: "In progress"}, r.Facts[0])
+}
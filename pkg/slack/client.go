@@ -39,7 +39,15 @@ func NewClientWithAPIURL(token, channelID, apiURL string) *Client {
 
 // PostMessage sends a message to the configured channel.
 // If threadTS is non-empty, the message is posted as a threaded reply.
-func (c *Client) PostMessage(ctx context.Context, blocks []goslack.Block, threadTS string, timeout time.Duration) error {
+// Returns the timestamp (ts) of the posted message.
+func (c *Client) PostMessage(ctx context.Context, blocks []goslack.Block, threadTS string, timeout time.Duration) (string, error) {
+	return c.PostMessageToChannel(ctx, c.channelID, blocks, threadTS, timeout)
+}
+
+// PostMessageToChannel sends a message to an explicit channel (e.g. a
+// severity-routed channel). If threadTS is non-empty, the message is posted
+// as a threaded reply. Returns the timestamp (ts) of the posted message.
+func (c *Client) PostMessageToChannel(ctx context.Context, channelID string, blocks []goslack.Block, threadTS string, timeout time.Duration) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
@@ -50,11 +58,16 @@ func (c *Client) PostMessage(ctx context.Context, blocks []goslack.Block, thread
 		opts = append(opts, goslack.MsgOptionTS(threadTS))
 	}
 
-	_, _, err := c.api.PostMessageContext(ctx, c.channelID, opts...)
+	_, ts, err := c.api.PostMessageContext(ctx, channelID, opts...)
 	if err != nil {
-		return fmt.Errorf("chat.postMessage failed: %w", err)
+		return "", fmt.Errorf("chat.postMessage failed: %w", err)
 	}
-	return nil
+	return ts, nil
+}
+
+// ChannelID returns the default channel this client posts to.
+func (c *Client) ChannelID() string {
+	return c.channelID
 }
 
 // FindMessageByFingerprint searches recent channel history for a message
@@ -2,24 +2,65 @@ package slack
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/codeready-toolchain/tarsy/pkg/config"
 	goslack "github.com/slack-go/slack"
 )
 
 const maxBlockTextLength = 2900
 
 var statusEmoji = map[string]string{
-	"completed": ":white_check_mark:",
-	"failed":    ":x:",
-	"timed_out": ":hourglass:",
-	"cancelled": ":no_entry_sign:",
+	"completed":      ":white_check_mark:",
+	"failed":         ":x:",
+	"timed_out":      ":hourglass:",
+	"cancelled":      ":no_entry_sign:",
+	"auto_cancelled": ":no_entry_sign:",
 }
 
 var statusLabel = map[string]string{
-	"completed": "Analysis Complete",
-	"failed":    "Analysis Failed",
-	"timed_out": "Analysis Timed Out",
-	"cancelled": "Analysis Cancelled",
+	"completed":      "Analysis Complete",
+	"failed":         "Analysis Failed",
+	"timed_out":      "Analysis Timed Out",
+	"cancelled":      "Analysis Cancelled",
+	"auto_cancelled": "Analysis Auto-Cancelled",
+}
+
+var severityEmoji = map[string]string{
+	"critical": ":rotating_light:",
+	"high":     ":red_circle:",
+	"medium":   ":large_orange_circle:",
+	"low":      ":large_blue_circle:",
+}
+
+// severitySuffix renders the severity badge appended to the header line.
+func severitySuffix(severity string) string {
+	if severity == "" {
+		return ""
+	}
+	emoji := severityEmoji[severity]
+	if emoji == "" {
+		emoji = ":grey_question:"
+	}
+	return fmt.Sprintf("  %s Severity: *%s*", emoji, strings.ToUpper(severity))
+}
+
+// onCallBlock renders the on-call engineer as a context line. Returns nil
+// when nobody is known to be on call.
+func onCallBlock(oc *OnCallInput) goslack.Block {
+	if oc == nil || oc.Name == "" {
+		return nil
+	}
+	text := fmt.Sprintf(":telephone_receiver: On-call for *%s*", oc.Service)
+	if oc.Team != "" {
+		text += fmt.Sprintf(" (%s)", oc.Team)
+	}
+	text += ": " + oc.Name
+	if oc.Email != "" && oc.Email != oc.Name {
+		text += fmt.Sprintf(" <mailto:%s|%s>", oc.Email, oc.Email)
+	}
+	return goslack.NewContextBlock("", goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false))
 }
 
 func sessionURL(sessionID, dashboardURL string) string {
@@ -39,8 +80,49 @@ func BuildStartedMessage(sessionID, dashboardURL string) []goslack.Block {
 	}
 }
 
+// BuildStatusRootMessage creates Block Kit blocks for the top-level message
+// that anchors the status thread of a session without a Slack fingerprint.
+func BuildStatusRootMessage(input SessionStartedInput, dashboardURL string, tmpl *config.SlackTemplateConfig) []goslack.Block {
+	url := sessionURL(input.SessionID, dashboardURL)
+	text := ":arrows_counterclockwise: *Processing started*"
+	if input.AlertType != "" {
+		text += fmt.Sprintf(" — `%s`", input.AlertType)
+	}
+	text += "\nStatus updates will be posted in this thread."
+
+	blocks := []goslack.Block{
+		goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false),
+			nil, nil,
+		),
+	}
+
+	vars := templateVars{
+		SessionID:    input.SessionID,
+		SessionURL:   url,
+		AlertType:    input.AlertType,
+		ChainID:      input.ChainID,
+		Status:       "in_progress",
+		DashboardURL: dashboardURL,
+	}
+	if fields := templateFieldsBlock(tmpl, vars); fields != nil {
+		blocks = append(blocks, fields)
+	}
+	if oc := onCallBlock(input.OnCall); oc != nil {
+		blocks = append(blocks, oc)
+	}
+
+	btn := goslack.NewButtonBlockElement("", "", goslack.NewTextBlockObject(goslack.PlainTextType, "View in Dashboard", false, false))
+	btn.URL = url
+	elements := append([]goslack.BlockElement{btn}, templateButtons(tmpl, vars)...)
+	blocks = append(blocks, goslack.NewActionBlock("", elements...))
+
+	return blocks
+}
+
 // BuildTerminalMessage creates Block Kit blocks for a terminal session notification.
-func BuildTerminalMessage(input SessionCompletedInput, dashboardURL string) []goslack.Block {
+// tmpl may be nil (no chain-specific fields or buttons).
+func BuildTerminalMessage(input SessionCompletedInput, dashboardURL string, tmpl *config.SlackTemplateConfig) []goslack.Block {
 	emoji := statusEmoji[input.Status]
 	if emoji == "" {
 		emoji = ":question:"
@@ -59,7 +141,7 @@ func BuildTerminalMessage(input SessionCompletedInput, dashboardURL string) []go
 		}
 
 		if content != "" {
-			headerText := fmt.Sprintf("%s *%s*", emoji, label)
+			headerText := fmt.Sprintf("%s *%s*", emoji, label) + severitySuffix(input.Severity)
 			blocks = append(blocks, goslack.NewSectionBlock(
 				goslack.NewTextBlockObject(goslack.MarkdownType, headerText, false, false),
 				nil, nil,
@@ -68,8 +150,14 @@ func BuildTerminalMessage(input SessionCompletedInput, dashboardURL string) []go
 				goslack.NewTextBlockObject(goslack.MarkdownType, truncateForSlack(content), false, false),
 				nil, nil,
 			))
+			if input.TechnicalSummary != "" {
+				blocks = append(blocks, goslack.NewSectionBlock(
+					goslack.NewTextBlockObject(goslack.MarkdownType, "*Technical summary:*\n"+truncateForSlack(input.TechnicalSummary), false, false),
+					nil, nil,
+				))
+			}
 		} else {
-			headerText := fmt.Sprintf("%s *%s*", emoji, label)
+			headerText := fmt.Sprintf("%s *%s*", emoji, label) + severitySuffix(input.Severity)
 			blocks = append(blocks, goslack.NewSectionBlock(
 				goslack.NewTextBlockObject(goslack.MarkdownType, headerText, false, false),
 				nil, nil,
@@ -87,6 +175,22 @@ func BuildTerminalMessage(input SessionCompletedInput, dashboardURL string) []go
 	}
 
 	url := sessionURL(input.SessionID, dashboardURL)
+	vars := templateVars{
+		SessionID:    input.SessionID,
+		SessionURL:   url,
+		AlertType:    input.AlertType,
+		ChainID:      input.ChainID,
+		Status:       input.Status,
+		Severity:     input.Severity,
+		DashboardURL: dashboardURL,
+	}
+	if fields := templateFieldsBlock(tmpl, vars); fields != nil {
+		blocks = append(blocks, fields)
+	}
+	if oc := onCallBlock(input.OnCall); oc != nil {
+		blocks = append(blocks, oc)
+	}
+
 	buttonText := "View Full Analysis"
 	if input.Status != "completed" {
 		buttonText = "View Details"
@@ -94,11 +198,173 @@ func BuildTerminalMessage(input SessionCompletedInput, dashboardURL string) []go
 
 	btn := goslack.NewButtonBlockElement("", "", goslack.NewTextBlockObject(goslack.PlainTextType, buttonText, false, false))
 	btn.URL = url
-	blocks = append(blocks, goslack.NewActionBlock("", btn))
+	elements := append([]goslack.BlockElement{btn}, templateButtons(tmpl, vars)...)
+	blocks = append(blocks, goslack.NewActionBlock("", elements...))
 
 	return blocks
 }
 
+// BuildReportMessage creates Block Kit blocks for a periodic activity report.
+func BuildReportMessage(input ReportInput) []goslack.Block {
+	blocks := []goslack.Block{
+		goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, ":bar_chart: *"+input.Title+"*", false, false),
+			nil, nil,
+		),
+	}
+	if input.Narrative != "" {
+		blocks = append(blocks, goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, truncateForSlack(input.Narrative), false, false),
+			nil, nil,
+		))
+	}
+	if input.Summary != "" {
+		blocks = append(blocks, goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, truncateForSlack(input.Summary), false, false),
+			nil, nil,
+		))
+	}
+	return blocks
+}
+
+// BuildSavedViewMatchMessage creates Block Kit blocks for a saved view match.
+func BuildSavedViewMatchMessage(input SavedViewMatchInput, dashboardURL string) []goslack.Block {
+	text := fmt.Sprintf(":mag: New session matches saved view *%s*\n*Alert type:* %s  *Chain:* %s  *Status:* %s\n<%s|View in Dashboard>",
+		input.ViewName, input.AlertType, input.ChainID, input.Status, sessionURL(input.SessionID, dashboardURL))
+
+	return []goslack.Block{
+		goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false),
+			nil, nil,
+		),
+	}
+}
+
+// BuildMilestoneMessage creates Block Kit blocks for an intermediate
+// milestone notification.
+func BuildMilestoneMessage(input MilestoneInput, dashboardURL string) []goslack.Block {
+	link := fmt.Sprintf("<%s|View in Dashboard>", sessionURL(input.SessionID, dashboardURL))
+
+	var text string
+	switch input.Event {
+	case "first_tool_call":
+		text = fmt.Sprintf(":mag: *Investigation underway* — %s ran its first tool `%s`\n%s", input.AgentName, input.ToolName, link)
+	case "stage_completed":
+		text = fmt.Sprintf(":heavy_check_mark: *Stage completed:* %s\n%s", input.StageName, link)
+	case "final_analysis":
+		text = fmt.Sprintf(":memo: *Final analysis available* — executive summary in progress\n%s", link)
+	default:
+		text = fmt.Sprintf(":information_source: *Milestone:* %s\n%s", input.Event, link)
+	}
+
+	blocks := []goslack.Block{
+		goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false),
+			nil, nil,
+		),
+	}
+	if input.FinalAnalysis != "" {
+		blocks = append(blocks, goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, truncateForSlack(input.FinalAnalysis), false, false),
+			nil, nil,
+		))
+	}
+	return blocks
+}
+
+// BuildOrphanRecoveredMessage creates Block Kit blocks for an orphan recovery.
+func BuildOrphanRecoveredMessage(input OrphanRecoveredInput, dashboardURL string) []goslack.Block {
+	action := "failed as timed out"
+	if input.Requeued {
+		action = "requeued"
+	}
+	text := fmt.Sprintf(":rotating_light: *Orphaned session %s* — pod `%s` stopped heartbeating (last heartbeat %s)\n*Alert type:* %s\n%s\n<%s|View in Dashboard>",
+		action, input.PodID, input.LastHeartbeat, input.AlertType, input.Reason, sessionURL(input.SessionID, dashboardURL))
+
+	return []goslack.Block{
+		goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false),
+			nil, nil,
+		),
+	}
+}
+
+// templateVars holds the values substituted for {placeholder} references
+// in chain Slack templates (see config.SlackTemplatePlaceholders).
+type templateVars struct {
+	SessionID    string
+	SessionURL   string
+	AlertType    string
+	ChainID      string
+	Status       string
+	Severity     string
+	DashboardURL string
+}
+
+var placeholderRe = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+
+// render substitutes placeholders in s. Unknown placeholders are left as-is
+// (config validation rejects them at load time).
+func (v templateVars) render(s string) string {
+	values := map[string]string{
+		"session_id":    v.SessionID,
+		"session_url":   v.SessionURL,
+		"alert_type":    v.AlertType,
+		"chain_id":      v.ChainID,
+		"status":        v.Status,
+		"severity":      v.Severity,
+		"dashboard_url": v.DashboardURL,
+	}
+	return placeholderRe.ReplaceAllStringFunc(s, func(m string) string {
+		name := placeholderRe.FindStringSubmatch(m)[1]
+		if val, ok := values[name]; ok {
+			return val
+		}
+		return m
+	})
+}
+
+// templateFieldsBlock renders template fields as a two-column section.
+// Fields whose value renders empty are omitted. Returns nil when there is
+// nothing to show.
+func templateFieldsBlock(tmpl *config.SlackTemplateConfig, vars templateVars) goslack.Block {
+	if tmpl == nil {
+		return nil
+	}
+	var fields []*goslack.TextBlockObject
+	for _, f := range tmpl.Fields {
+		value := strings.TrimSpace(vars.render(f.Value))
+		if value == "" {
+			continue
+		}
+		text := fmt.Sprintf("*%s*\n%s", f.Label, value)
+		fields = append(fields, goslack.NewTextBlockObject(goslack.MarkdownType, text, false, false))
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return goslack.NewSectionBlock(nil, fields, nil)
+}
+
+// templateButtons renders template link buttons. Buttons whose URL renders
+// empty are omitted.
+func templateButtons(tmpl *config.SlackTemplateConfig, vars templateVars) []goslack.BlockElement {
+	if tmpl == nil {
+		return nil
+	}
+	var elements []goslack.BlockElement
+	for _, b := range tmpl.Buttons {
+		url := strings.TrimSpace(vars.render(b.URL))
+		if url == "" {
+			continue
+		}
+		btn := goslack.NewButtonBlockElement("", "", goslack.NewTextBlockObject(goslack.PlainTextType, b.Text, false, false))
+		btn.URL = url
+		elements = append(elements, btn)
+	}
+	return elements
+}
+
 func truncateForSlack(text string) string {
 	runes := []rune(text)
 	if len(runes) <= maxBlockTextLength {
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"unicode/utf8"
 
+	"github.com/codeready-toolchain/tarsy/pkg/config"
 	goslack "github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,7 +29,7 @@ func TestBuildTerminalMessage_Completed(t *testing.T) {
 		Status:           "completed",
 		ExecutiveSummary: "The pod crashed due to OOM.",
 	}
-	blocks := BuildTerminalMessage(input, "https://dash.example.com")
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
 
 	require.GreaterOrEqual(t, len(blocks), 3)
 
@@ -47,13 +48,32 @@ func TestBuildTerminalMessage_Completed(t *testing.T) {
 	assert.Contains(t, btn.URL, "https://dash.example.com/sessions/sess-1")
 }
 
+func TestBuildTerminalMessage_CompletedWithTechnicalSummary(t *testing.T) {
+	input := SessionCompletedInput{
+		SessionID:        "sess-1",
+		Status:           "completed",
+		ExecutiveSummary: "The pod crashed due to OOM.",
+		TechnicalSummary: "## Root Cause\nMemory limit of 256Mi exceeded.",
+	}
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
+
+	require.GreaterOrEqual(t, len(blocks), 4)
+	content := blocks[1].(*goslack.SectionBlock)
+	assert.Contains(t, content.Text.Text, "The pod crashed due to OOM.")
+	technical := blocks[2].(*goslack.SectionBlock)
+	assert.Contains(t, technical.Text.Text, "*Technical summary:*")
+	assert.Contains(t, technical.Text.Text, "Memory limit of 256Mi exceeded.")
+	_, ok := blocks[3].(*goslack.ActionBlock)
+	assert.True(t, ok)
+}
+
 func TestBuildTerminalMessage_CompletedFallbackToFinalAnalysis(t *testing.T) {
 	input := SessionCompletedInput{
 		SessionID:     "sess-2",
 		Status:        "completed",
 		FinalAnalysis: "Fallback analysis content.",
 	}
-	blocks := BuildTerminalMessage(input, "https://dash.example.com")
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
 
 	require.GreaterOrEqual(t, len(blocks), 3)
 	content := blocks[1].(*goslack.SectionBlock)
@@ -65,7 +85,7 @@ func TestBuildTerminalMessage_CompletedNoContent(t *testing.T) {
 		SessionID: "sess-3",
 		Status:    "completed",
 	}
-	blocks := BuildTerminalMessage(input, "https://dash.example.com")
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
 
 	require.Len(t, blocks, 2)
 	header := blocks[0].(*goslack.SectionBlock)
@@ -78,7 +98,7 @@ func TestBuildTerminalMessage_Failed(t *testing.T) {
 		Status:       "failed",
 		ErrorMessage: "timeout waiting for LLM",
 	}
-	blocks := BuildTerminalMessage(input, "https://dash.example.com")
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
 
 	require.GreaterOrEqual(t, len(blocks), 2)
 
@@ -97,7 +117,7 @@ func TestBuildTerminalMessage_TimedOut(t *testing.T) {
 		SessionID: "sess-5",
 		Status:    "timed_out",
 	}
-	blocks := BuildTerminalMessage(input, "https://dash.example.com")
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
 
 	header := blocks[0].(*goslack.SectionBlock)
 	assert.Contains(t, header.Text.Text, ":hourglass:")
@@ -109,7 +129,7 @@ func TestBuildTerminalMessage_Cancelled(t *testing.T) {
 		SessionID: "sess-6",
 		Status:    "cancelled",
 	}
-	blocks := BuildTerminalMessage(input, "https://dash.example.com")
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
 
 	header := blocks[0].(*goslack.SectionBlock)
 	assert.Contains(t, header.Text.Text, ":no_entry_sign:")
@@ -144,3 +164,210 @@ func TestTruncateForSlack(t *testing.T) {
 		assert.Equal(t, maxBlockTextLength, utf8.RuneCountInString(prefix))
 	})
 }
+
+func TestBuildTerminalMessage_SeverityBadge(t *testing.T) {
+	input := SessionCompletedInput{
+		SessionID:        "sess-7",
+		Status:           "completed",
+		ExecutiveSummary: "Payment API down.",
+		Severity:         "critical",
+	}
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
+
+	header := blocks[0].(*goslack.SectionBlock)
+	assert.Contains(t, header.Text.Text, ":rotating_light:")
+	assert.Contains(t, header.Text.Text, "Severity: *CRITICAL*")
+}
+
+func TestBuildTerminalMessage_NoSeverityBadgeWhenUnclassified(t *testing.T) {
+	input := SessionCompletedInput{
+		SessionID:        "sess-8",
+		Status:           "completed",
+		ExecutiveSummary: "Summary.",
+	}
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
+
+	header := blocks[0].(*goslack.SectionBlock)
+	assert.NotContains(t, header.Text.Text, "Severity")
+}
+
+func TestBuildTerminalMessage_Template(t *testing.T) {
+	tmpl := &config.SlackTemplateConfig{
+		Fields: []config.SlackTemplateField{
+			{Label: "Alert", Value: "{alert_type}"},
+			{Label: "Severity", Value: "{severity}"},
+		},
+		Buttons: []config.SlackTemplateButton{
+			{Text: "Runbook", URL: "https://wiki.example.com/{chain_id}?s={session_id}"},
+		},
+	}
+	input := SessionCompletedInput{
+		SessionID:        "sess-9",
+		AlertType:        "PodCrash",
+		ChainID:          "k8s",
+		Status:           "completed",
+		ExecutiveSummary: "Summary.",
+	}
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", tmpl)
+
+	require.Len(t, blocks, 4)
+	fields := blocks[2].(*goslack.SectionBlock)
+	require.Len(t, fields.Fields, 1, "empty severity field is omitted")
+	assert.Equal(t, "*Alert*\nPodCrash", fields.Fields[0].Text)
+
+	action := blocks[3].(*goslack.ActionBlock)
+	require.Len(t, action.Elements.ElementSet, 2)
+	runbook := action.Elements.ElementSet[1].(*goslack.ButtonBlockElement)
+	assert.Equal(t, "Runbook", runbook.Text.Text)
+	assert.Equal(t, "https://wiki.example.com/k8s?s=sess-9", runbook.URL)
+}
+
+func TestBuildStatusRootMessage(t *testing.T) {
+	input := SessionStartedInput{SessionID: "sess-10", AlertType: "PodCrash"}
+	blocks := BuildStatusRootMessage(input, "https://dash.example.com", nil)
+
+	require.Len(t, blocks, 2)
+	section := blocks[0].(*goslack.SectionBlock)
+	assert.Contains(t, section.Text.Text, "Processing started")
+	assert.Contains(t, section.Text.Text, "`PodCrash`")
+
+	action := blocks[1].(*goslack.ActionBlock)
+	btn := action.Elements.ElementSet[0].(*goslack.ButtonBlockElement)
+	assert.Equal(t, "https://dash.example.com/sessions/sess-10", btn.URL)
+}
+
+func TestBuildTerminalMessage_OnCall(t *testing.T) {
+	input := SessionCompletedInput{
+		SessionID:        "sess-11",
+		Status:           "completed",
+		ExecutiveSummary: "Disk full.",
+		OnCall:           &OnCallInput{Service: "payments", Team: "Payments SRE", Name: "Ada", Email: "ada@example.com"},
+	}
+	blocks := BuildTerminalMessage(input, "https://dash.example.com", nil)
+
+	require.Len(t, blocks, 4)
+	ctxBlock := blocks[2].(*goslack.ContextBlock)
+	text := ctxBlock.ContextElements.Elements[0].(*goslack.TextBlockObject).Text
+	assert.Equal(t, ":telephone_receiver: On-call for *payments* (Payments SRE): Ada <mailto:ada@example.com|ada@example.com>", text)
+	assert.IsType(t, &goslack.ActionBlock{}, blocks[3])
+
+	// Unknown on-call adds no block
+	input.OnCall = &OnCallInput{Service: "payments"}
+	assert.Len(t, BuildTerminalMessage(input, "https://dash.example.com", nil), 3)
+}
+
+func TestBuildReportMessage(t *testing.T) {
+	t.Run("title, narrative, and summary", func(t *testing.T) {
+		blocks := BuildReportMessage(ReportInput{
+			Title:     "Daily activity report — 2024-06-01",
+			Narrative: "A quiet day.",
+			Summary:   "*Sessions:* 4",
+		})
+
+		require.Len(t, blocks, 3)
+		assert.Contains(t, blocks[0].(*goslack.SectionBlock).Text.Text, "Daily activity report — 2024-06-01")
+		assert.Equal(t, "A quiet day.", blocks[1].(*goslack.SectionBlock).Text.Text)
+		assert.Equal(t, "*Sessions:* 4", blocks[2].(*goslack.SectionBlock).Text.Text)
+	})
+
+	t.Run("omits empty narrative", func(t *testing.T) {
+		blocks := BuildReportMessage(ReportInput{Title: "Weekly activity report", Summary: "*Sessions:* 0"})
+		require.Len(t, blocks, 2)
+		assert.Equal(t, "*Sessions:* 0", blocks[1].(*goslack.SectionBlock).Text.Text)
+	})
+}
+
+func TestBuildSavedViewMatchMessage(t *testing.T) {
+	blocks := BuildSavedViewMatchMessage(SavedViewMatchInput{
+		ViewName:  "failed kube chains",
+		SessionID: "sess-1",
+		AlertType: "PodCrashLooping",
+		ChainID:   "k8s",
+		Status:    "failed",
+	}, "https://tarsy.example.com")
+
+	require.Len(t, blocks, 1)
+	text := blocks[0].(*goslack.SectionBlock).Text.Text
+	assert.Contains(t, text, "*failed kube chains*")
+	assert.Contains(t, text, "PodCrashLooping")
+	assert.Contains(t, text, "*Status:* failed")
+	assert.Contains(t, text, "https://tarsy.example.com/sessions/sess-1")
+}
+
+func TestBuildMilestoneMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      MilestoneInput
+		wantText   string
+		wantBlocks int
+	}{
+		{
+			name:       "first tool call",
+			input:      MilestoneInput{SessionID: "sess-1", Event: "first_tool_call", AgentName: "KubernetesAgent", ToolName: "kubernetes-server.pods_list"},
+			wantText:   "KubernetesAgent ran its first tool `kubernetes-server.pods_list`",
+			wantBlocks: 1,
+		},
+		{
+			name:       "stage completed",
+			input:      MilestoneInput{SessionID: "sess-1", Event: "stage_completed", StageName: "investigate"},
+			wantText:   "*Stage completed:* investigate",
+			wantBlocks: 1,
+		},
+		{
+			name:       "final analysis includes the analysis",
+			input:      MilestoneInput{SessionID: "sess-1", Event: "final_analysis", FinalAnalysis: "Memory limit too low."},
+			wantText:   "*Final analysis available*",
+			wantBlocks: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks := BuildMilestoneMessage(tt.input, "https://tarsy.example.com")
+			require.Len(t, blocks, tt.wantBlocks)
+			text := blocks[0].(*goslack.SectionBlock).Text.Text
+			assert.Contains(t, text, tt.wantText)
+			assert.Contains(t, text, "https://tarsy.example.com/sessions/sess-1")
+		})
+	}
+}
+
+func TestBuildOrphanRecoveredMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		requeued bool
+		wantText string
+	}{
+		{name: "failed", requeued: false, wantText: "*Orphaned session failed as timed out*"},
+		{name: "requeued", requeued: true, wantText: "*Orphaned session requeued*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks := BuildOrphanRecoveredMessage(OrphanRecoveredInput{
+				SessionID:     "sess-1",
+				AlertType:     "PodCrashLooping",
+				PodID:         "tarsy-0",
+				LastHeartbeat: "2026-01-01T00:00:00Z",
+				Requeued:      tt.requeued,
+				Reason:        "Orphaned: no heartbeat from pod tarsy-0",
+			}, "https://tarsy.example.com")
+			require.Len(t, blocks, 1)
+			text := blocks[0].(*goslack.SectionBlock).Text.Text
+			assert.Contains(t, text, tt.wantText)
+			assert.Contains(t, text, "pod `tarsy-0`")
+			assert.Contains(t, text, "https://tarsy.example.com/sessions/sess-1")
+		})
+	}
+}
+
+func TestTemplateVars_RendersAllPlaceholders(t *testing.T) {
+	vars := templateVars{
+		SessionID: "a", SessionURL: "b", AlertType: "c", ChainID: "d",
+		Status: "e", Severity: "f", DashboardURL: "g",
+	}
+	for _, name := range config.SlackTemplatePlaceholders {
+		rendered := vars.render("{" + name + "}")
+		assert.Len(t, rendered, 1, "placeholder %s not rendered", name)
+	}
+}
@@ -2,8 +2,12 @@ package slack
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	goslack "github.com/slack-go/slack"
 )
 
 // ServiceConfig holds the parameters needed to construct a Service.
@@ -11,33 +15,98 @@ type ServiceConfig struct {
 	Token        string
 	Channel      string
 	DashboardURL string
+
+	// ThreadStatusUpdates posts a top-level start message for sessions
+	// without a fingerprint so the terminal notification replies in its thread.
+	ThreadStatusUpdates bool
+	RateLimitPerMinute  int                                    // 0 = unlimited
+	DedupWindow         time.Duration                          // 0 = no dedup
+	Templates           map[string]*config.SlackTemplateConfig // chain ID → message template
 }
 
 // SessionStartedInput contains data for a session start notification.
 type SessionStartedInput struct {
 	SessionID               string
 	AlertType               string
+	ChainID                 string
 	SlackMessageFingerprint string
+	OnCall                  *OnCallInput // nil = on-call unknown
+}
+
+// OnCallInput identifies the on-call engineer of the alert's service.
+type OnCallInput struct {
+	Service string
+	Team    string
+	Name    string
+	Email   string
 }
 
 // SessionCompletedInput contains data for a terminal session notification.
 type SessionCompletedInput struct {
 	SessionID               string
 	AlertType               string
+	ChainID                 string
 	Status                  string // completed, failed, timed_out, cancelled
 	ExecutiveSummary        string
+	TechnicalSummary        string // Chains with technical_summary enabled (empty otherwise)
 	FinalAnalysis           string
 	ErrorMessage            string
 	SlackMessageFingerprint string
-	ThreadTS                string // Cached from start notification
+	ThreadTS                string       // Cached from start notification
+	Severity                string       // critical, high, medium, low (empty if unclassified)
+	Channel                 string       // Severity-routed channel (empty = default channel)
+	OnCall                  *OnCallInput // nil = on-call unknown
+}
+
+// ReportInput contains data for a periodic activity report message.
+type ReportInput struct {
+	Channel   string // Target channel (empty = default channel)
+	Title     string // Header line, e.g. "Daily activity report — 2024-06-01"
+	Summary   string // Pre-rendered mrkdwn statistics
+	Narrative string // LLM narrative (empty = omitted)
+}
+
+// SavedViewMatchInput contains data for a saved view match notification.
+type SavedViewMatchInput struct {
+	Channel   string // Subscribed channel (empty = default channel)
+	ViewName  string
+	SessionID string
+	AlertType string
+	ChainID   string
+	Status    string // session status at the matching transition
+}
+
+// MilestoneInput contains data for an intermediate milestone notification.
+type MilestoneInput struct {
+	SessionID     string
+	ThreadTS      string // Session status thread (empty = top-level in default channel)
+	Event         string // first_tool_call, stage_completed, final_analysis
+	AgentName     string // first_tool_call only
+	ToolName      string // first_tool_call only
+	StageName     string // stage_completed only
+	FinalAnalysis string // final_analysis only
+}
+
+// OrphanRecoveredInput contains data for an orphan recovery notification.
+type OrphanRecoveredInput struct {
+	SessionID     string
+	AlertType     string
+	PodID         string // Pod that stopped heartbeating
+	LastHeartbeat string // RFC3339, or "unknown"
+	Requeued      bool   // false = failed as timed_out
+	Reason        string
 }
 
 // Service handles Slack notification delivery.
 // Nil-safe: all methods are no-ops when service is nil.
 type Service struct {
-	client       *Client
-	dashboardURL string
-	logger       *slog.Logger
+	client              *Client
+	dashboardURL        string
+	threadStatusUpdates bool
+	templates           map[string]*config.SlackTemplateConfig
+	throttle            *throttle
+	dedup               *dedupCache
+	logger              *slog.Logger
 }
 
 // NewService creates a new Slack notification service.
@@ -46,36 +115,60 @@ func NewService(cfg ServiceConfig) *Service {
 	if cfg.Token == "" || cfg.Channel == "" {
 		return nil
 	}
-	return &Service{
-		client:       NewClient(cfg.Token, cfg.Channel),
-		dashboardURL: cfg.DashboardURL,
-		logger:       slog.Default().With("component", "slack-service"),
-	}
+	return newService(NewClient(cfg.Token, cfg.Channel), cfg)
 }
 
 // NewServiceWithClient creates a Service backed by a pre-built Client.
 // Useful for testing with a mock API server.
 func NewServiceWithClient(client *Client, dashboardURL string) *Service {
+	return newService(client, ServiceConfig{DashboardURL: dashboardURL})
+}
+
+func newService(client *Client, cfg ServiceConfig) *Service {
 	return &Service{
-		client:       client,
-		dashboardURL: dashboardURL,
-		logger:       slog.Default().With("component", "slack-service"),
+		client:              client,
+		dashboardURL:        cfg.DashboardURL,
+		threadStatusUpdates: cfg.ThreadStatusUpdates,
+		templates:           cfg.Templates,
+		throttle:            newThrottle(cfg.RateLimitPerMinute),
+		dedup:               newDedupCache(cfg.DedupWindow),
+		logger:              slog.Default().With("component", "slack-service"),
 	}
 }
 
 // NotifySessionStarted sends a "processing started" notification.
-// Only sends if fingerprint is present (Slack-originated alerts).
-// Returns resolved threadTS for reuse by terminal notification.
+// Slack-originated alerts (fingerprint present) get a reply in the original
+// thread. Other sessions get a top-level message only when status threading
+// is enabled. Returns the resolved threadTS for reuse by terminal notification.
 // Fail-open: errors are logged, never returned.
 func (s *Service) NotifySessionStarted(ctx context.Context, input SessionStartedInput) string {
 	if s == nil {
 		return ""
 	}
 
-	if input.SlackMessageFingerprint == "" {
+	if input.SlackMessageFingerprint == "" && !s.threadStatusUpdates {
 		return ""
 	}
 
+	dedupKey := "started:" + input.SessionID
+	if e, dup := s.dedup.lookup(dedupKey); dup {
+		s.logger.Debug("Skipping duplicate Slack start notification", "session_id", input.SessionID)
+		return e.threadTS
+	}
+
+	if input.SlackMessageFingerprint == "" {
+		blocks := BuildStatusRootMessage(input, s.dashboardURL, s.templates[input.ChainID])
+		ts, err := s.post(ctx, s.client.ChannelID(), blocks, "", 5*time.Second)
+		if err != nil {
+			s.logger.Error("Failed to send Slack start notification",
+				"session_id", input.SessionID,
+				"error", err)
+			return ""
+		}
+		s.dedup.record(dedupKey, ts)
+		return ts
+	}
+
 	lookupCtx, lookupCancel := context.WithTimeout(ctx, 5*time.Second)
 	defer lookupCancel()
 
@@ -88,10 +181,15 @@ func (s *Service) NotifySessionStarted(ctx context.Context, input SessionStarted
 	}
 
 	blocks := BuildStartedMessage(input.SessionID, s.dashboardURL)
-	if err := s.client.PostMessage(ctx, blocks, threadTS, 5*time.Second); err != nil {
+	if oc := onCallBlock(input.OnCall); oc != nil {
+		blocks = append(blocks, oc)
+	}
+	if _, err := s.post(ctx, s.client.ChannelID(), blocks, threadTS, 5*time.Second); err != nil {
 		s.logger.Error("Failed to send Slack start notification",
 			"session_id", input.SessionID,
 			"error", err)
+	} else {
+		s.dedup.record(dedupKey, threadTS)
 	}
 
 	return threadTS
@@ -104,6 +202,14 @@ func (s *Service) NotifySessionCompleted(ctx context.Context, input SessionCompl
 		return
 	}
 
+	dedupKey := "terminal:" + input.SessionID + ":" + input.Status
+	if _, dup := s.dedup.lookup(dedupKey); dup {
+		s.logger.Debug("Skipping duplicate Slack terminal notification",
+			"session_id", input.SessionID,
+			"status", input.Status)
+		return
+	}
+
 	threadTS := input.ThreadTS
 	if threadTS == "" && input.SlackMessageFingerprint != "" {
 		lookupCtx, lookupCancel := context.WithTimeout(ctx, 5*time.Second)
@@ -119,11 +225,139 @@ func (s *Service) NotifySessionCompleted(ctx context.Context, input SessionCompl
 		}
 	}
 
-	blocks := BuildTerminalMessage(input, s.dashboardURL)
-	if err := s.client.PostMessage(ctx, blocks, threadTS, 10*time.Second); err != nil {
+	blocks := BuildTerminalMessage(input, s.dashboardURL, s.templates[input.ChainID])
+	sent := false
+
+	// Severity-routed channel: post a top-level message there, and still reply
+	// in the originating thread (if any) so the Slack conversation is closed out.
+	if input.Channel != "" && input.Channel != s.client.ChannelID() {
+		if _, err := s.post(ctx, input.Channel, blocks, "", 10*time.Second); err != nil {
+			s.logger.Error("Failed to send routed Slack notification",
+				"session_id", input.SessionID,
+				"status", input.Status,
+				"channel", input.Channel,
+				"error", err)
+		} else {
+			sent = true
+		}
+		if threadTS == "" {
+			if sent {
+				s.dedup.record(dedupKey, "")
+			}
+			return
+		}
+	}
+
+	if _, err := s.post(ctx, s.client.ChannelID(), blocks, threadTS, 10*time.Second); err != nil {
 		s.logger.Error("Failed to send Slack notification",
 			"session_id", input.SessionID,
 			"status", input.Status,
 			"error", err)
+	} else {
+		sent = true
+	}
+	if sent {
+		s.dedup.record(dedupKey, threadTS)
+	}
+}
+
+// NotifyReport posts a periodic activity report as a top-level message.
+// Fail-open: errors are logged, never returned.
+func (s *Service) NotifyReport(ctx context.Context, input ReportInput) {
+	if s == nil {
+		return
+	}
+
+	channel := input.Channel
+	if channel == "" {
+		channel = s.client.ChannelID()
+	}
+	if _, err := s.post(ctx, channel, BuildReportMessage(input), "", 10*time.Second); err != nil {
+		s.logger.Error("Failed to send Slack activity report",
+			"channel", channel,
+			"title", input.Title,
+			"error", err)
+	}
+}
+
+// NotifySavedViewMatch posts a top-level message announcing that a session
+// matched a subscribed saved view.
+// Fail-open: errors are logged, never returned.
+func (s *Service) NotifySavedViewMatch(ctx context.Context, input SavedViewMatchInput) {
+	if s == nil {
+		return
+	}
+
+	channel := input.Channel
+	if channel == "" {
+		channel = s.client.ChannelID()
+	}
+	if _, err := s.post(ctx, channel, BuildSavedViewMatchMessage(input, s.dashboardURL), "", 10*time.Second); err != nil {
+		s.logger.Error("Failed to send Slack saved view notification",
+			"session_id", input.SessionID,
+			"channel", channel,
+			"view", input.ViewName,
+			"error", err)
+	}
+}
+
+// NotifyMilestone posts an intermediate milestone, replying in the session's
+// status thread when there is one.
+// Fail-open: errors are logged, never returned.
+func (s *Service) NotifyMilestone(ctx context.Context, input MilestoneInput) {
+	if s == nil {
+		return
+	}
+
+	dedupKey := "milestone:" + input.SessionID + ":" + input.Event + ":" + input.StageName
+	if _, dup := s.dedup.lookup(dedupKey); dup {
+		return
+	}
+
+	if _, err := s.post(ctx, s.client.ChannelID(), BuildMilestoneMessage(input, s.dashboardURL), input.ThreadTS, 10*time.Second); err != nil {
+		s.logger.Error("Failed to send Slack milestone notification",
+			"session_id", input.SessionID,
+			"event", input.Event,
+			"error", err)
+		return
+	}
+	s.dedup.record(dedupKey, input.ThreadTS)
+}
+
+// NotifyOrphanRecovered posts a top-level message announcing that a session
+// was recovered from a worker that stopped heartbeating.
+// Fail-open: errors are logged, never returned.
+func (s *Service) NotifyOrphanRecovered(ctx context.Context, input OrphanRecoveredInput) {
+	if s == nil {
+		return
+	}
+
+	if _, err := s.post(ctx, s.client.ChannelID(), BuildOrphanRecoveredMessage(input, s.dashboardURL), "", 10*time.Second); err != nil {
+		s.logger.Error("Failed to send Slack orphan recovery notification",
+			"session_id", input.SessionID,
+			"pod_id", input.PodID,
+			"error", err)
+	}
+}
+
+// post sends a message through the throttle. If Slack still answers with a
+// rate-limit error, it retries once after the advertised Retry-After delay.
+func (s *Service) post(ctx context.Context, channelID string, blocks []goslack.Block, threadTS string, timeout time.Duration) (string, error) {
+	if err := s.throttle.wait(ctx); err != nil {
+		return "", fmt.Errorf("rate limit wait: %w", err)
+	}
+
+	ts, err := s.client.PostMessageToChannel(ctx, channelID, blocks, threadTS, timeout)
+	delay, limited := retryAfter(err)
+	if !limited {
+		return ts, err
+	}
+
+	s.logger.Warn("Slack rate limited, retrying", "channel", channelID, "retry_after", delay)
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		return "", err
 	}
+	return s.client.PostMessageToChannel(ctx, channelID, blocks, threadTS, timeout)
 }
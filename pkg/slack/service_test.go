@@ -2,9 +2,16 @@ package slack
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestService_NilReceiver(t *testing.T) {
@@ -25,6 +32,14 @@ func TestService_NilReceiver(t *testing.T) {
 			Status:    "completed",
 		})
 	})
+
+	t.Run("NotifyReport is no-op", func(_ *testing.T) {
+		s.NotifyReport(context.Background(), ReportInput{Title: "Daily activity report"})
+	})
+
+	t.Run("NotifyMilestone is no-op", func(_ *testing.T) {
+		s.NotifyMilestone(context.Background(), MilestoneInput{SessionID: "sess-1", Event: "final_analysis"})
+	})
 }
 
 func TestNewService(t *testing.T) {
@@ -61,3 +76,187 @@ func TestService_NotifySessionStarted_NoFingerprint(t *testing.T) {
 	})
 	assert.Empty(t, result, "should skip when no fingerprint")
 }
+
+// newMockSlackAPI returns a test server that records the channel and thread_ts
+// of every chat.postMessage call.
+func newMockSlackAPI(t *testing.T) (*httptest.Server, *[]postedMessage) {
+	t.Helper()
+	var posted []postedMessage
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if strings.HasSuffix(r.URL.Path, "chat.postMessage") {
+			mu.Lock()
+			posted = append(posted, postedMessage{channel: r.FormValue("channel"), threadTS: r.FormValue("thread_ts")})
+			mu.Unlock()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C","ts":"1.0"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &posted
+}
+
+type postedMessage struct {
+	channel  string
+	threadTS string
+}
+
+func TestService_NotifySessionCompleted_Routing(t *testing.T) {
+	t.Run("routed channel without thread posts only to routed channel", func(t *testing.T) {
+		srv, posted := newMockSlackAPI(t)
+		svc := NewServiceWithClient(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), "https://example.com")
+
+		svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{
+			SessionID: "sess-1",
+			Status:    "completed",
+			Severity:  "critical",
+			Channel:   "C-oncall",
+		})
+
+		require.Len(t, *posted, 1)
+		assert.Equal(t, postedMessage{channel: "C-oncall"}, (*posted)[0])
+	})
+
+	t.Run("routed channel with thread also replies in original thread", func(t *testing.T) {
+		srv, posted := newMockSlackAPI(t)
+		svc := NewServiceWithClient(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), "https://example.com")
+
+		svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{
+			SessionID: "sess-2",
+			Status:    "completed",
+			Channel:   "C-oncall",
+			ThreadTS:  "123.456",
+		})
+
+		require.Len(t, *posted, 2)
+		assert.Equal(t, postedMessage{channel: "C-oncall"}, (*posted)[0])
+		assert.Equal(t, postedMessage{channel: "C-default", threadTS: "123.456"}, (*posted)[1])
+	})
+
+	t.Run("no routed channel posts to default channel", func(t *testing.T) {
+		srv, posted := newMockSlackAPI(t)
+		svc := NewServiceWithClient(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), "https://example.com")
+
+		svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{
+			SessionID: "sess-3",
+			Status:    "failed",
+		})
+
+		require.Len(t, *posted, 1)
+		assert.Equal(t, postedMessage{channel: "C-default"}, (*posted)[0])
+	})
+}
+
+func TestService_NotifyReport(t *testing.T) {
+	t.Run("posts to configured channel", func(t *testing.T) {
+		srv, posted := newMockSlackAPI(t)
+		svc := NewServiceWithClient(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), "https://example.com")
+
+		svc.NotifyReport(context.Background(), ReportInput{Channel: "C-reports", Title: "Daily activity report"})
+
+		require.Len(t, *posted, 1)
+		assert.Equal(t, postedMessage{channel: "C-reports"}, (*posted)[0])
+	})
+
+	t.Run("falls back to default channel", func(t *testing.T) {
+		srv, posted := newMockSlackAPI(t)
+		svc := NewServiceWithClient(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), "https://example.com")
+
+		svc.NotifyReport(context.Background(), ReportInput{Title: "Weekly activity report"})
+
+		require.Len(t, *posted, 1)
+		assert.Equal(t, postedMessage{channel: "C-default"}, (*posted)[0])
+	})
+}
+
+func TestService_NotifyMilestone(t *testing.T) {
+	srv, posted := newMockSlackAPI(t)
+	svc := newService(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), ServiceConfig{
+		DashboardURL: "https://example.com",
+		DedupWindow:  time.Minute,
+	})
+
+	svc.NotifyMilestone(context.Background(), MilestoneInput{SessionID: "sess-1", ThreadTS: "1.0", Event: "stage_completed", StageName: "investigate"})
+	svc.NotifyMilestone(context.Background(), MilestoneInput{SessionID: "sess-1", ThreadTS: "1.0", Event: "stage_completed", StageName: "investigate"})
+	svc.NotifyMilestone(context.Background(), MilestoneInput{SessionID: "sess-1", ThreadTS: "1.0", Event: "stage_completed", StageName: "remediate"})
+	svc.NotifyMilestone(context.Background(), MilestoneInput{SessionID: "sess-2", Event: "final_analysis"})
+
+	require.Len(t, *posted, 3, "duplicate stage milestone is suppressed")
+	assert.Equal(t, postedMessage{channel: "C-default", threadTS: "1.0"}, (*posted)[0])
+	assert.Equal(t, postedMessage{channel: "C-default"}, (*posted)[2])
+}
+
+func TestService_StatusThreading(t *testing.T) {
+	t.Run("disabled: no start message without fingerprint", func(t *testing.T) {
+		srv, posted := newMockSlackAPI(t)
+		svc := NewServiceWithClient(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), "https://example.com")
+
+		threadTS := svc.NotifySessionStarted(context.Background(), SessionStartedInput{SessionID: "sess-1"})
+
+		assert.Empty(t, threadTS)
+		assert.Empty(t, *posted)
+	})
+
+	t.Run("enabled: start message becomes thread root for terminal reply", func(t *testing.T) {
+		srv, posted := newMockSlackAPI(t)
+		svc := newService(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), ServiceConfig{
+			DashboardURL:        "https://example.com",
+			ThreadStatusUpdates: true,
+		})
+
+		threadTS := svc.NotifySessionStarted(context.Background(), SessionStartedInput{SessionID: "sess-1", AlertType: "PodCrash"})
+		require.Equal(t, "1.0", threadTS)
+
+		svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{
+			SessionID: "sess-1",
+			Status:    "completed",
+			ThreadTS:  threadTS,
+		})
+
+		require.Len(t, *posted, 2)
+		assert.Equal(t, postedMessage{channel: "C-default"}, (*posted)[0])
+		assert.Equal(t, postedMessage{channel: "C-default", threadTS: "1.0"}, (*posted)[1])
+	})
+}
+
+func TestService_Dedup(t *testing.T) {
+	srv, posted := newMockSlackAPI(t)
+	svc := newService(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), ServiceConfig{
+		DashboardURL:        "https://example.com",
+		ThreadStatusUpdates: true,
+		DedupWindow:         time.Minute,
+	})
+
+	first := svc.NotifySessionStarted(context.Background(), SessionStartedInput{SessionID: "sess-1"})
+	second := svc.NotifySessionStarted(context.Background(), SessionStartedInput{SessionID: "sess-1"})
+	assert.Equal(t, first, second, "duplicate start returns the cached thread")
+
+	input := SessionCompletedInput{SessionID: "sess-1", Status: "failed", ThreadTS: first}
+	svc.NotifySessionCompleted(context.Background(), input)
+	svc.NotifySessionCompleted(context.Background(), input)
+
+	input.Status = "completed"
+	svc.NotifySessionCompleted(context.Background(), input)
+
+	assert.Len(t, *posted, 3, "one start, one failed, one completed")
+}
+
+func TestService_RetriesAfterRateLimit(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C","ts":"1.0"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	svc := NewServiceWithClient(NewClientWithAPIURL("xoxb-test", "C-default", srv.URL+"/"), "https://example.com")
+	svc.NotifySessionCompleted(context.Background(), SessionCompletedInput{SessionID: "sess-1", Status: "completed"})
+
+	assert.Equal(t, int32(2), calls.Load())
+}
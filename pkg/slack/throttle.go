@@ -0,0 +1,97 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	goslack "github.com/slack-go/slack"
+	"golang.org/x/time/rate"
+)
+
+// throttle spaces out chat.postMessage calls so a burst of sessions
+// completing at once does not trip Slack's per-channel rate limit.
+// A nil throttle never waits.
+type throttle struct {
+	limiter *rate.Limiter
+}
+
+// newThrottle returns nil when perMinute is 0 (unlimited).
+func newThrottle(perMinute int) *throttle {
+	if perMinute <= 0 {
+		return nil
+	}
+	// Allow a short burst (up to 5 messages) before spacing kicks in.
+	burst := min(perMinute, 5)
+	return &throttle{limiter: rate.NewLimiter(rate.Limit(float64(perMinute)/60), burst)}
+}
+
+// wait blocks until a message may be sent or ctx is done.
+func (t *throttle) wait(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	return t.limiter.Wait(ctx)
+}
+
+// retryAfter reports how long Slack asked us to back off, if err is a
+// rate-limit response.
+func retryAfter(err error) (time.Duration, bool) {
+	var rl *goslack.RateLimitedError
+	if errors.As(err, &rl) {
+		return rl.RetryAfter, true
+	}
+	return 0, false
+}
+
+// dedupCache remembers recently sent notifications so retried or recovered
+// sessions do not post the same status twice. A nil cache never suppresses.
+type dedupCache struct {
+	window  time.Duration
+	mu      sync.Mutex
+	entries map[string]dedupEntry
+}
+
+type dedupEntry struct {
+	sentAt   time.Time
+	threadTS string
+}
+
+// newDedupCache returns nil when window is 0 (dedup disabled).
+func newDedupCache(window time.Duration) *dedupCache {
+	if window <= 0 {
+		return nil
+	}
+	return &dedupCache{window: window, entries: make(map[string]dedupEntry)}
+}
+
+// lookup returns the entry for key if it was recorded within the window.
+// Expired entries are pruned on every call.
+func (d *dedupCache) lookup(key string) (dedupEntry, bool) {
+	if d == nil {
+		return dedupEntry{}, false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	for k, e := range d.entries {
+		if now.Sub(e.sentAt) > d.window {
+			delete(d.entries, k)
+		}
+	}
+	e, ok := d.entries[key]
+	return e, ok
+}
+
+// record marks key as sent. threadTS is returned by later lookups so a
+// suppressed start notification still yields the thread to reply in.
+func (d *dedupCache) record(key, threadTS string) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries[key] = dedupEntry{sentAt: time.Now(), threadTS: threadTS}
+}
@@ -0,0 +1,58 @@
+package slack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottle(t *testing.T) {
+	t.Run("zero rate is unlimited", func(t *testing.T) {
+		th := newThrottle(0)
+		assert.Nil(t, th)
+		assert.NoError(t, th.wait(context.Background()))
+	})
+
+	t.Run("burst passes then waits", func(t *testing.T) {
+		th := newThrottle(2) // burst 2, then one token every 30s
+		require.NoError(t, th.wait(context.Background()))
+		require.NoError(t, th.wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		assert.Error(t, th.wait(ctx), "third message must wait past the deadline")
+	})
+}
+
+func TestDedupCache(t *testing.T) {
+	t.Run("nil cache never suppresses", func(t *testing.T) {
+		d := newDedupCache(0)
+		assert.Nil(t, d)
+		d.record("k", "ts")
+		_, ok := d.lookup("k")
+		assert.False(t, ok)
+	})
+
+	t.Run("records and returns thread ts", func(t *testing.T) {
+		d := newDedupCache(time.Minute)
+		_, ok := d.lookup("k")
+		assert.False(t, ok)
+
+		d.record("k", "123.456")
+		e, ok := d.lookup("k")
+		require.True(t, ok)
+		assert.Equal(t, "123.456", e.threadTS)
+	})
+
+	t.Run("expired entries are pruned", func(t *testing.T) {
+		d := newDedupCache(time.Minute)
+		d.entries["old"] = dedupEntry{sentAt: time.Now().Add(-2 * time.Minute)}
+
+		_, ok := d.lookup("old")
+		assert.False(t, ok)
+		assert.Empty(t, d.entries)
+	})
+}
@@ -0,0 +1,328 @@
+package tokenizer
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// SentencePiece model types (TrainerSpec.ModelType).
+const (
+	spModelUnigram = 1
+	spModelBPE     = 2
+)
+
+// SentencePiece piece types (SentencePiece.Type) that text can encode to.
+const (
+	spPieceNormal      = 1
+	spPieceUserDefined = 4
+)
+
+// spaceSymbol replaces spaces in SentencePiece pieces.
+const spaceSymbol = "▁"
+
+// sentencePiece counts tokens with a SentencePiece model (unigram or BPE).
+// Normalization is limited to whitespace handling; the model's precompiled
+// Unicode normalization (NFKC) is not applied, which only matters for
+// compatibility characters.
+type sentencePiece struct {
+	name         string
+	modelType    int
+	scores       map[string]float32
+	maxRunes     int
+	unkScore     float32
+	byteFallback bool
+
+	addDummyPrefix         bool
+	removeExtraWhitespaces bool
+	escapeWhitespaces      bool
+}
+
+// LoadSentencePiece reads a SentencePiece model file (the serialized
+// ModelProto, e.g. tokenizer.model).
+func LoadSentencePiece(path string) (Tokenizer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	sp := &sentencePiece{
+		name:                   "sentencepiece:" + filepath.Base(path),
+		modelType:              spModelUnigram,
+		scores:                 make(map[string]float32),
+		addDummyPrefix:         true,
+		removeExtraWhitespaces: true,
+		escapeWhitespaces:      true,
+	}
+	if err := sp.parseModel(data); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(sp.scores) == 0 {
+		return nil, fmt.Errorf("%s: no pieces", path)
+	}
+	if sp.modelType != spModelUnigram && sp.modelType != spModelBPE {
+		return nil, fmt.Errorf("%s: unsupported model type %d (only unigram and BPE)", path, sp.modelType)
+	}
+
+	minScore := float32(math.MaxFloat32)
+	for piece, score := range sp.scores {
+		minScore = min(minScore, score)
+		sp.maxRunes = max(sp.maxRunes, utf8.RuneCountInString(piece))
+	}
+	sp.unkScore = minScore - 10 // as SentencePiece penalizes unknown characters
+	return sp, nil
+}
+
+// parseModel decodes the ModelProto fields the tokenizer needs:
+// pieces (1), trainer_spec (2) and normalizer_spec (3).
+func (sp *sentencePiece) parseModel(data []byte) error {
+	return walkFields(data, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+		if typ != protowire.BytesType {
+			return nil
+		}
+		switch num {
+		case 1:
+			return sp.parsePiece(v)
+		case 2:
+			return walkFields(v, func(num protowire.Number, typ protowire.Type, _ []byte, n uint64) error {
+				switch {
+				case num == 3 && typ == protowire.VarintType:
+					sp.modelType = int(n)
+				case num == 35 && typ == protowire.VarintType:
+					sp.byteFallback = n != 0
+				}
+				return nil
+			})
+		case 3:
+			return walkFields(v, func(num protowire.Number, typ protowire.Type, _ []byte, n uint64) error {
+				if typ != protowire.VarintType {
+					return nil
+				}
+				switch num {
+				case 3:
+					sp.addDummyPrefix = n != 0
+				case 4:
+					sp.removeExtraWhitespaces = n != 0
+				case 5:
+					sp.escapeWhitespaces = n != 0
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// parsePiece decodes a SentencePiece message: piece (1), score (2), type (3).
+func (sp *sentencePiece) parsePiece(data []byte) error {
+	var piece string
+	var score float32
+	pieceType := uint64(spPieceNormal)
+	err := walkFields(data, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			piece = string(v)
+		case num == 2 && typ == protowire.Fixed32Type:
+			score = math.Float32frombits(uint32(n))
+		case num == 3 && typ == protowire.VarintType:
+			pieceType = n
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Byte pieces (<0xNN>) are counted through byteFallback; control and
+	// unknown pieces never appear in encoded text.
+	if piece != "" && (pieceType == spPieceNormal || pieceType == spPieceUserDefined) {
+		sp.scores[piece] = score
+	}
+	return nil
+}
+
+// walkFields calls fn for every field of a protobuf message. Bytes fields
+// pass their payload in v; varint and fixed fields their value in n.
+func walkFields(data []byte, fn func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error) error {
+	for len(data) > 0 {
+		num, typ, tagLen := protowire.ConsumeTag(data)
+		if tagLen < 0 {
+			return fmt.Errorf("invalid model: %w", protowire.ParseError(tagLen))
+		}
+		data = data[tagLen:]
+
+		var v []byte
+		var n uint64
+		var valLen int
+		switch typ {
+		case protowire.BytesType:
+			v, valLen = protowire.ConsumeBytes(data)
+		case protowire.VarintType:
+			n, valLen = protowire.ConsumeVarint(data)
+		case protowire.Fixed32Type:
+			var n32 uint32
+			n32, valLen = protowire.ConsumeFixed32(data)
+			n = uint64(n32)
+		default:
+			valLen = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if valLen < 0 {
+			return fmt.Errorf("invalid model: %w", protowire.ParseError(valLen))
+		}
+		data = data[valLen:]
+		if err := fn(num, typ, v, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sp *sentencePiece) Name() string { return sp.name }
+
+func (sp *sentencePiece) Count(text string) int {
+	text = sp.normalize(text)
+	n := 0
+	for len(text) > 0 {
+		word := nextWord(text)
+		text = text[len(word):]
+		for len(word) > maxPieceLen {
+			cut := maxPieceLen
+			for cut > 1 && !utf8.RuneStart(word[cut]) {
+				cut--
+			}
+			n += sp.countWord(word[:cut])
+			word = word[cut:]
+		}
+		n += sp.countWord(word)
+	}
+	return n
+}
+
+// normalize applies the model's whitespace handling: collapse and trim
+// spaces, escape them as ▁, and prefix the text with ▁.
+func (sp *sentencePiece) normalize(text string) string {
+	if sp.removeExtraWhitespaces {
+		text = strings.Join(strings.FieldsFunc(text, func(r rune) bool { return r == ' ' }), " ")
+	}
+	if text == "" {
+		return ""
+	}
+	if sp.addDummyPrefix {
+		text = " " + text
+	}
+	if sp.escapeWhitespaces {
+		text = strings.ReplaceAll(text, " ", spaceSymbol)
+	}
+	return text
+}
+
+// nextWord returns the first word of normalized text: pieces never span a
+// ▁ that is not their first character, so words are encoded independently.
+func nextWord(text string) string {
+	if i := strings.Index(text[1:], spaceSymbol); i >= 0 {
+		return text[:i+1]
+	}
+	return text
+}
+
+func (sp *sentencePiece) countWord(word string) int {
+	if sp.modelType == spModelBPE {
+		return sp.countBPE(word)
+	}
+	return sp.countUnigram(word)
+}
+
+// countUnigram finds the highest-scoring segmentation (Viterbi) and returns
+// its token count.
+func (sp *sentencePiece) countUnigram(word string) int {
+	offsets := make([]int, 0, len(word)+1)
+	for i := range word {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(word))
+	n := len(offsets) - 1
+
+	type node struct {
+		score  float32
+		tokens int
+		ok     bool
+	}
+	best := make([]node, n+1)
+	best[0].ok = true
+	for i := 0; i < n; i++ {
+		if !best[i].ok {
+			continue
+		}
+		matchedChar := false
+		for j := i + 1; j <= n && j-i <= sp.maxRunes; j++ {
+			score, ok := sp.scores[word[offsets[i]:offsets[j]]]
+			if !ok {
+				continue
+			}
+			if j == i+1 {
+				matchedChar = true
+			}
+			cand := node{score: best[i].score + score, tokens: best[i].tokens + 1, ok: true}
+			if !best[j].ok || cand.score > best[j].score {
+				best[j] = cand
+			}
+		}
+		if !matchedChar {
+			cand := node{
+				score:  best[i].score + sp.unkScore,
+				tokens: best[i].tokens + sp.unknownTokens(word[offsets[i]:offsets[i+1]]),
+				ok:     true,
+			}
+			if !best[i+1].ok || cand.score > best[i+1].score {
+				best[i+1] = cand
+			}
+		}
+	}
+	return best[n].tokens
+}
+
+// countBPE merges the adjacent pair forming the highest-scoring piece until
+// no pair forms a piece, and returns the number of symbols left.
+func (sp *sentencePiece) countBPE(word string) int {
+	var symbols []string
+	for len(word) > 0 {
+		_, size := utf8.DecodeRuneInString(word)
+		symbols = append(symbols, word[:size])
+		word = word[size:]
+	}
+	for len(symbols) > 1 {
+		best, bestScore := -1, float32(-math.MaxFloat32)
+		for i := 0; i+1 < len(symbols); i++ {
+			if score, ok := sp.scores[symbols[i]+symbols[i+1]]; ok && (best < 0 || score > bestScore) {
+				best, bestScore = i, score
+			}
+		}
+		if best < 0 {
+			break
+		}
+		symbols[best] += symbols[best+1]
+		symbols = append(symbols[:best+1], symbols[best+2:]...)
+	}
+	n := 0
+	for _, s := range symbols {
+		if _, ok := sp.scores[s]; ok {
+			n++
+		} else {
+			n += sp.unknownTokens(s)
+		}
+	}
+	return n
+}
+
+// unknownTokens is the token count of a character missing from the
+// vocabulary: one byte piece per UTF-8 byte with byte fallback, otherwise
+// a single unknown token.
+func (sp *sentencePiece) unknownTokens(char string) int {
+	if sp.byteFallback {
+		return len(char)
+	}
+	return 1
+}
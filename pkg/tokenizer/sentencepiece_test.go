@@ -0,0 +1,130 @@
+package tokenizer
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type testPiece struct {
+	piece string
+	score float32
+	typ   uint64
+}
+
+// writeSentencePiece serializes a minimal ModelProto.
+func writeSentencePiece(t *testing.T, modelType uint64, byteFallback bool, pieces ...testPiece) string {
+	t.Helper()
+	var model []byte
+	for _, p := range pieces {
+		var msg []byte
+		msg = protowire.AppendTag(msg, 1, protowire.BytesType)
+		msg = protowire.AppendString(msg, p.piece)
+		msg = protowire.AppendTag(msg, 2, protowire.Fixed32Type)
+		msg = protowire.AppendFixed32(msg, math.Float32bits(p.score))
+		if p.typ != 0 {
+			msg = protowire.AppendTag(msg, 3, protowire.VarintType)
+			msg = protowire.AppendVarint(msg, p.typ)
+		}
+		model = protowire.AppendTag(model, 1, protowire.BytesType)
+		model = protowire.AppendBytes(model, msg)
+	}
+
+	var trainer []byte
+	trainer = protowire.AppendTag(trainer, 3, protowire.VarintType)
+	trainer = protowire.AppendVarint(trainer, modelType)
+	trainer = protowire.AppendTag(trainer, 35, protowire.VarintType)
+	trainer = protowire.AppendVarint(trainer, protowire.EncodeBool(byteFallback))
+	model = protowire.AppendTag(model, 2, protowire.BytesType)
+	model = protowire.AppendBytes(model, trainer)
+
+	path := filepath.Join(t.TempDir(), "tokenizer.model")
+	require.NoError(t, os.WriteFile(path, model, 0o600))
+	return path
+}
+
+func TestLoadSentencePiece_Unigram(t *testing.T) {
+	pieces := []testPiece{
+		{piece: "<unk>", typ: 2},
+		{piece: "<s>", typ: 3},
+		{piece: "<0xC3>", typ: 6},
+		{piece: "▁hello", score: -1},
+		{piece: "▁hell", score: -2},
+		{piece: "▁", score: -5},
+		{piece: "h", score: -5},
+		{piece: "e", score: -5},
+		{piece: "l", score: -5},
+		{piece: "o", score: -5},
+	}
+
+	tok, err := LoadSentencePiece(writeSentencePiece(t, spModelUnigram, true, pieces...))
+	require.NoError(t, err)
+	assert.Equal(t, "sentencepiece:tokenizer.model", tok.Name())
+
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"   ", 0},
+		{"hello", 1},
+		{"hello  hello ", 2},
+		{"helloo", 2}, // ▁hello + o
+		{"hellö", 3},  // ▁hell + ö as two byte pieces
+		{"oh", 3},     // ▁ + o + h
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, tok.Count(tt.text))
+		})
+	}
+
+	t.Run("without byte fallback unknown characters are one token", func(t *testing.T) {
+		tok, err := LoadSentencePiece(writeSentencePiece(t, spModelUnigram, false, pieces...))
+		require.NoError(t, err)
+		assert.Equal(t, 2, tok.Count("hellö"))
+	})
+}
+
+func TestLoadSentencePiece_BPE(t *testing.T) {
+	tok, err := LoadSentencePiece(writeSentencePiece(t, spModelBPE, true,
+		testPiece{piece: "▁h", score: -1},
+		testPiece{piece: "ll", score: -1.5},
+		testPiece{piece: "▁he", score: -2},
+		testPiece{piece: "▁hell", score: -3},
+		testPiece{piece: "▁", score: -10},
+		testPiece{piece: "h", score: -10},
+		testPiece{piece: "e", score: -10},
+		testPiece{piece: "l", score: -10},
+		testPiece{piece: "o", score: -10},
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, tok.Count("hello"))       // ▁hell + o
+	assert.Equal(t, 4, tok.Count("hello hello")) // words are merged separately
+	assert.Equal(t, 3, tok.Count("hellé"))       // ▁hell + é as two byte pieces
+	assert.Equal(t, 4, tok.Count("oh h"))        // ▁ + o + h, then ▁h
+}
+
+func TestLoadSentencePiece_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadSentencePiece(filepath.Join(dir, "missing.model"))
+	assert.Error(t, err)
+
+	garbage := filepath.Join(dir, "garbage.model")
+	require.NoError(t, os.WriteFile(garbage, []byte{0x0a, 0xff}, 0o600))
+	_, err = LoadSentencePiece(garbage)
+	assert.ErrorContains(t, err, "invalid model")
+
+	_, err = LoadSentencePiece(writeSentencePiece(t, 3, false, testPiece{piece: "▁a"}))
+	assert.ErrorContains(t, err, "unsupported model type 3")
+
+	_, err = LoadSentencePiece(writeSentencePiece(t, spModelUnigram, false, testPiece{piece: "<unk>", typ: 2}))
+	assert.ErrorContains(t, err, "no pieces")
+}
@@ -0,0 +1,193 @@
+package tokenizer
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bpe is a byte-level BPE tokenizer using tiktoken merge ranks: the lower a
+// byte sequence's rank, the earlier it was merged during training.
+type bpe struct {
+	name  string
+	ranks map[string]int
+}
+
+// LoadTiktoken reads a tiktoken rank file: one "<base64 token> <rank>" per
+// line, as published for cl100k_base and o200k_base. Special tokens are not
+// part of these files and are not counted.
+func LoadTiktoken(path string) (Tokenizer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ranks := make(map[string]int)
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		tokenB64, rankStr, ok := strings.Cut(text, " ")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected \"<base64 token> <rank>\"", path, line)
+		}
+		token, err := base64.StdEncoding.DecodeString(tokenB64)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid token: %w", path, line, err)
+		}
+		rank, err := strconv.Atoi(rankStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid rank: %w", path, line, err)
+		}
+		ranks[string(token)] = rank
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(ranks) == 0 {
+		return nil, fmt.Errorf("%s: no tokens", path)
+	}
+	return &bpe{name: "tiktoken:" + filepath.Base(path), ranks: ranks}, nil
+}
+
+func (t *bpe) Name() string { return t.name }
+
+// maxPieceLen bounds the merge loop, which is quadratic in the piece length.
+// Longer pieces (base64 blobs, long identifiers) are counted in chunks, which
+// may count a token or two more per chunk than whole-piece encoding.
+const maxPieceLen = 512
+
+func (t *bpe) Count(text string) int {
+	n := 0
+	for len(text) > 0 {
+		piece := nextPiece(text)
+		text = text[len(piece):]
+		for len(piece) > maxPieceLen {
+			cut := maxPieceLen
+			for cut > 1 && !utf8.RuneStart(piece[cut]) {
+				cut--
+			}
+			n += t.countPiece(piece[:cut])
+			piece = piece[cut:]
+		}
+		n += t.countPiece(piece)
+	}
+	return n
+}
+
+// countPiece returns the number of tokens of one pre-tokenized piece by
+// repeatedly merging the adjacent pair with the lowest rank.
+func (t *bpe) countPiece(piece string) int {
+	if _, ok := t.ranks[piece]; ok {
+		return 1
+	}
+	// bounds[i] is the start offset of the i-th part; the last entry is len(piece).
+	bounds := make([]int, len(piece)+1)
+	for i := range bounds {
+		bounds[i] = i
+	}
+	for len(bounds) > 2 {
+		best, bestRank := -1, math.MaxInt
+		for i := 0; i+2 < len(bounds); i++ {
+			if rank, ok := t.ranks[piece[bounds[i]:bounds[i+2]]]; ok && rank < bestRank {
+				best, bestRank = i, rank
+			}
+		}
+		if best < 0 {
+			break
+		}
+		bounds = append(bounds[:best+1], bounds[best+2:]...)
+	}
+	return len(bounds) - 1
+}
+
+// nextPiece returns the first piece of text under the pre-tokenization rules
+// of cl100k_base and o200k_base, approximated without regex lookaheads:
+// contractions, letter runs with one leading non-letter, up to three digits,
+// punctuation runs with an optional leading space, newlines, and whitespace.
+func nextPiece(text string) string {
+	r, size := utf8.DecodeRuneInString(text)
+
+	// 's 't 're 've 'm 'll 'd (case-insensitive)
+	if r == '\'' && len(text) > 1 {
+		for _, c := range []string{"s", "t", "m", "d", "re", "ve", "ll"} {
+			if len(text) > len(c) && strings.EqualFold(text[1:1+len(c)], c) {
+				return text[:1+len(c)]
+			}
+		}
+	}
+
+	// [^\r\n\p{L}\p{N}]?\p{L}+
+	if unicode.IsLetter(r) {
+		return text[:size+runLen(text[size:], unicode.IsLetter)]
+	}
+	if r != '\r' && r != '\n' && !unicode.IsNumber(r) {
+		if next, _ := utf8.DecodeRuneInString(text[size:]); unicode.IsLetter(next) {
+			return text[:size+runLen(text[size:], unicode.IsLetter)]
+		}
+	}
+
+	// \p{N}{1,3}
+	if unicode.IsNumber(r) {
+		end := size
+		for i := 1; i < 3 && end < len(text); i++ {
+			next, n := utf8.DecodeRuneInString(text[end:])
+			if !unicode.IsNumber(next) {
+				break
+			}
+			end += n
+		}
+		return text[:end]
+	}
+
+	// ' ?[^\s\p{L}\p{N}]+[\r\n]*'
+	start := 0
+	if r == ' ' {
+		if next, _ := utf8.DecodeRuneInString(text[size:]); isPunct(next) {
+			start = size
+		}
+	}
+	if first, _ := utf8.DecodeRuneInString(text[start:]); start > 0 || isPunct(first) {
+		end := start + runLen(text[start:], isPunct)
+		end += runLen(text[end:], func(r rune) bool { return r == '\r' || r == '\n' })
+		return text[:end]
+	}
+
+	// Whitespace: \s*[\r\n]+ | \s+(?!\S) | \s+
+	ws := runLen(text, unicode.IsSpace)
+	if i := strings.LastIndexAny(text[:ws], "\r\n"); i >= 0 {
+		return text[:i+1]
+	}
+	if ws < len(text) {
+		if _, last := utf8.DecodeLastRuneInString(text[:ws]); ws > last {
+			return text[:ws-last] // leave the last space to prefix the next word
+		}
+	}
+	return text[:ws]
+}
+
+// runLen returns the byte length of the leading run of runes matching f.
+func runLen(s string, f func(rune) bool) int {
+	for i, r := range s {
+		if !f(r) {
+			return i
+		}
+	}
+	return len(s)
+}
+
+func isPunct(r rune) bool {
+	return !unicode.IsSpace(r) && !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
@@ -0,0 +1,106 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTiktoken writes a rank file with all single bytes followed by merges.
+func writeTiktoken(t *testing.T, merges ...string) string {
+	t.Helper()
+	var b strings.Builder
+	for i := 0; i < 256; i++ {
+		fmt.Fprintf(&b, "%s %d\n", base64.StdEncoding.EncodeToString([]byte{byte(i)}), i)
+	}
+	for i, m := range merges {
+		fmt.Fprintf(&b, "%s %d\n", base64.StdEncoding.EncodeToString([]byte(m)), 256+i)
+	}
+	path := filepath.Join(t.TempDir(), "test.tiktoken")
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0o600))
+	return path
+}
+
+func TestLoadTiktoken(t *testing.T) {
+	tok, err := LoadTiktoken(writeTiktoken(t, "he", "ll", "hell", " w", " wo", " wor"))
+	require.NoError(t, err)
+	assert.Equal(t, "tiktoken:test.tiktoken", tok.Name())
+
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"hello", 2},          // hell + o
+		{"hello world", 5},    // hell + o + " wor" + l + d
+		{"123456", 6},         // two 3-digit pieces, no digit merges
+		{"héllo", 5},          // h + é (2 bytes) + ll + o
+		{"hello\n\nhello", 6}, // hell o \n\n hell o
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, tok.Count(tt.text))
+		})
+	}
+
+	t.Run("long pieces are counted in chunks", func(t *testing.T) {
+		assert.Equal(t, 2000, tok.Count(strings.Repeat("x", 2000)))
+	})
+}
+
+func TestLoadTiktoken_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := LoadTiktoken(filepath.Join(dir, "missing.tiktoken"))
+	assert.Error(t, err)
+
+	bad := filepath.Join(dir, "bad.tiktoken")
+	require.NoError(t, os.WriteFile(bad, []byte("aGVsbG8=\n"), 0o600))
+	_, err = LoadTiktoken(bad)
+	assert.ErrorContains(t, err, "bad.tiktoken:1")
+
+	empty := filepath.Join(dir, "empty.tiktoken")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	_, err = LoadTiktoken(empty)
+	assert.ErrorContains(t, err, "no tokens")
+}
+
+func TestNextPiece(t *testing.T) {
+	split := func(text string) []string {
+		var pieces []string
+		for len(text) > 0 {
+			p := nextPiece(text)
+			pieces = append(pieces, p)
+			text = text[len(p):]
+		}
+		return pieces
+	}
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"Hello world", []string{"Hello", " world"}},
+		{"don't", []string{"don", "'t"}},
+		{"WE'LL", []string{"WE", "'LL"}},
+		{"1234567", []string{"123", "456", "7"}},
+		{"foo!!\nbar", []string{"foo", "!!\n", "bar"}},
+		{"a ...b", []string{"a", " ...", "b"}},
+		{"x.y", []string{"x", ".y"}},
+		{"a   b", []string{"a", "  ", " b"}},
+		{"a  \n  b", []string{"a", "  \n", " ", " b"}},
+		{"end  ", []string{"end", "  "}},
+		{"kubectl get pods -n prod", []string{"kubectl", " get", " pods", " -", "n", " prod"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, split(tt.text))
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Package tokenizer counts tokens the way a provider's model does, so
+// summarization thresholds and prompt budgets are based on real counts
+// rather than a characters-per-token estimate.
+//
+// Each LLM provider picks its tokenizer in config (config.TokenizerConfig):
+// the ~4 chars/token heuristic (default), a tiktoken-compatible byte-level
+// BPE rank file, or a SentencePiece model. Vocabulary files are loaded once
+// per process and shared between providers that reference the same file.
+package tokenizer
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// Tokenizer counts the tokens of a text.
+type Tokenizer interface {
+	// Name identifies the tokenizer in logs and interaction metadata,
+	// e.g. "heuristic" or "tiktoken:o200k_base.tiktoken".
+	Name() string
+
+	// Count returns the number of tokens text encodes to.
+	Count(text string) int
+}
+
+// charsPerToken is the approximate number of characters per token for
+// English text, used by the heuristic tokenizer.
+const charsPerToken = 4
+
+// Heuristic estimates ~4 characters (bytes) per token. It overestimates
+// multi-byte UTF-8 content, which errs on the safe side for thresholds.
+var Heuristic Tokenizer = heuristic{}
+
+type heuristic struct{}
+
+func (heuristic) Name() string { return string(config.TokenizerTypeHeuristic) }
+
+func (heuristic) Count(text string) int {
+	if len(text) == 0 {
+		return 0
+	}
+	return (len(text) + charsPerToken - 1) / charsPerToken // Round up
+}
+
+// loaded caches vocabulary-backed tokenizers by TokenizerConfig.
+var loaded sync.Map // config.TokenizerConfig → *loadResult
+
+type loadResult struct {
+	once sync.Once
+	tok  Tokenizer
+	err  error
+}
+
+// Load returns the tokenizer described by cfg; nil cfg is the heuristic.
+// Vocabulary files are read on first use and cached for the process.
+func Load(cfg *config.TokenizerConfig) (Tokenizer, error) {
+	if cfg == nil || cfg.Type == config.TokenizerTypeHeuristic {
+		return Heuristic, nil
+	}
+	v, _ := loaded.LoadOrStore(*cfg, &loadResult{})
+	r := v.(*loadResult)
+	r.once.Do(func() {
+		var tok Tokenizer
+		switch cfg.Type {
+		case config.TokenizerTypeTiktoken:
+			tok, r.err = LoadTiktoken(cfg.File)
+		case config.TokenizerTypeSentencePiece:
+			tok, r.err = LoadSentencePiece(cfg.File)
+		default:
+			r.err = fmt.Errorf("unsupported tokenizer type %q", cfg.Type)
+		}
+		if r.err == nil {
+			r.tok = newCached(tok)
+		}
+	})
+	return r.tok, r.err
+}
+
+// ForProvider returns the tokenizer of an LLM provider, falling back to the
+// heuristic when its vocabulary cannot be loaded. Preload at startup
+// surfaces load errors before any session runs.
+func ForProvider(p *config.LLMProviderConfig) Tokenizer {
+	if p == nil {
+		return Heuristic
+	}
+	tok, err := Load(p.Tokenizer)
+	if err != nil {
+		slog.Warn("Failed to load tokenizer, using heuristic token counts",
+			"model", p.Model, "tokenizer", p.Tokenizer.Type, "file", p.Tokenizer.File, "error", err)
+		return Heuristic
+	}
+	return tok
+}
+
+// Preload loads the tokenizers of all providers, returning the first error.
+func Preload(providers map[string]*config.LLMProviderConfig) error {
+	for name, p := range providers {
+		if _, err := Load(p.Tokenizer); err != nil {
+			return fmt.Errorf("llm provider %q: failed to load tokenizer: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Texts at least this long are memoized: conversations are recounted on
+// every LLM call, and vocabulary tokenizers are far slower than hashing.
+// The cache holds at most cacheMaxBytes of text.
+const (
+	cacheMinLen   = 256
+	cacheMaxBytes = 64 << 20
+)
+
+// cached memoizes counts of long texts. The cache is reset when full rather
+// than evicting entries one by one; within an execution the same messages
+// are counted repeatedly, so it refills with the live conversation.
+type cached struct {
+	Tokenizer
+	mu     sync.Mutex
+	counts map[string]int
+	size   int
+}
+
+func newCached(tok Tokenizer) *cached {
+	return &cached{Tokenizer: tok, counts: make(map[string]int)}
+}
+
+func (c *cached) Count(text string) int {
+	if len(text) < cacheMinLen || len(text) > cacheMaxBytes {
+		return c.Tokenizer.Count(text)
+	}
+	c.mu.Lock()
+	n, ok := c.counts[text]
+	c.mu.Unlock()
+	if ok {
+		return n
+	}
+	n = c.Tokenizer.Count(text)
+	c.mu.Lock()
+	if c.size+len(text) > cacheMaxBytes {
+		clear(c.counts)
+		c.size = 0
+	}
+	if _, ok := c.counts[text]; !ok {
+		c.counts[text] = n
+		c.size += len(text)
+	}
+	c.mu.Unlock()
+	return n
+}
@@ -0,0 +1,92 @@
+package tokenizer
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeuristic(t *testing.T) {
+	assert.Equal(t, "heuristic", Heuristic.Name())
+	assert.Equal(t, 0, Heuristic.Count(""))
+	assert.Equal(t, 1, Heuristic.Count("abc"))
+	assert.Equal(t, 1, Heuristic.Count("abcd"))
+	assert.Equal(t, 2, Heuristic.Count("abcde"))
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("nil and heuristic configs", func(t *testing.T) {
+		tok, err := Load(nil)
+		require.NoError(t, err)
+		assert.Equal(t, Heuristic, tok)
+
+		tok, err = Load(&config.TokenizerConfig{Type: config.TokenizerTypeHeuristic})
+		require.NoError(t, err)
+		assert.Equal(t, Heuristic, tok)
+	})
+
+	t.Run("vocabulary is loaded once", func(t *testing.T) {
+		cfg := &config.TokenizerConfig{Type: config.TokenizerTypeTiktoken, File: writeTiktoken(t, "ab")}
+		first, err := Load(cfg)
+		require.NoError(t, err)
+		second, err := Load(&config.TokenizerConfig{Type: cfg.Type, File: cfg.File})
+		require.NoError(t, err)
+		assert.Same(t, first, second)
+		assert.Equal(t, "tiktoken:test.tiktoken", first.Name())
+		assert.Equal(t, 1, first.Count("ab"))
+	})
+
+	t.Run("load errors", func(t *testing.T) {
+		_, err := Load(&config.TokenizerConfig{
+			Type: config.TokenizerTypeSentencePiece,
+			File: filepath.Join(t.TempDir(), "missing.model"),
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestForProvider(t *testing.T) {
+	assert.Equal(t, Heuristic, ForProvider(nil))
+	assert.Equal(t, Heuristic, ForProvider(&config.LLMProviderConfig{Model: "gpt-5"}))
+
+	broken := &config.LLMProviderConfig{
+		Model:     "gpt-5",
+		Tokenizer: &config.TokenizerConfig{Type: config.TokenizerTypeTiktoken, File: filepath.Join(t.TempDir(), "missing")},
+	}
+	assert.Equal(t, Heuristic, ForProvider(broken), "falls back to the heuristic")
+
+	ok := &config.LLMProviderConfig{
+		Model:     "gpt-5",
+		Tokenizer: &config.TokenizerConfig{Type: config.TokenizerTypeTiktoken, File: writeTiktoken(t)},
+	}
+	assert.Equal(t, 3, ForProvider(ok).Count("abc"))
+
+	err := Preload(map[string]*config.LLMProviderConfig{"ok": ok, "broken": broken})
+	assert.ErrorContains(t, err, `llm provider "broken"`)
+	assert.NoError(t, Preload(map[string]*config.LLMProviderConfig{"ok": ok}))
+}
+
+func TestCached(t *testing.T) {
+	calls := 0
+	c := newCached(countFunc(func(text string) int {
+		calls++
+		return len(text)
+	}))
+
+	short := "short"
+	long := strings.Repeat("x", cacheMinLen)
+	for range 3 {
+		assert.Equal(t, len(short), c.Count(short))
+		assert.Equal(t, len(long), c.Count(long))
+	}
+	assert.Equal(t, 4, calls, "short texts are recounted, long ones memoized")
+}
+
+type countFunc func(string) int
+
+func (f countFunc) Name() string          { return "func" }
+func (f countFunc) Count(text string) int { return f(text) }
@@ -0,0 +1,145 @@
+package transcription
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+const googleBaseURL = "https://generativelanguage.googleapis.com/v1beta"
+
+// googleTranscribePrompt asks for a verbatim transcript only, so the result
+// can be used as the user's message as-is.
+const googleTranscribePrompt = "Transcribe this voice note verbatim. " +
+	"Reply with the transcript only, without commentary, timestamps, or speaker labels."
+
+// GoogleTranscriber asks a Gemini model to transcribe audio passed inline.
+type GoogleTranscriber struct {
+	model     string
+	apiKeyEnv string
+	language  string
+	baseURL   string
+	client    *http.Client
+}
+
+// NewGoogleTranscriber creates a transcriber backed by the Google Generative
+// Language API.
+func NewGoogleTranscriber(cfg *config.TranscriptionConfig) (*GoogleTranscriber, error) {
+	if cfg.APIKeyEnv == "" {
+		return nil, fmt.Errorf("google transcriber: api_key_env is required")
+	}
+	base := cfg.BaseURL
+	if base == "" {
+		base = googleBaseURL
+	}
+	return &GoogleTranscriber{
+		model:     cfg.Model,
+		apiKeyEnv: cfg.APIKeyEnv,
+		language:  cfg.Language,
+		baseURL:   strings.TrimRight(base, "/"),
+		client:    &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+// Transcribe sends audio with a transcription prompt and returns the reply.
+func (t *GoogleTranscriber) Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	apiKey := os.Getenv(t.apiKeyEnv)
+	if apiKey == "" {
+		return "", fmt.Errorf("google transcriber: env var %s is not set", t.apiKeyEnv)
+	}
+
+	// Gemini rejects MIME parameters such as ";codecs=opus"
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return "", fmt.Errorf("google transcriber: invalid mime type %q: %w", mimeType, err)
+	}
+	prompt := googleTranscribePrompt
+	if t.language != "" {
+		prompt += " The speaker uses language code " + t.language + "."
+	}
+
+	reqBody := googleGenerateRequest{
+		Contents: []googleContent{{
+			Role: "user",
+			Parts: []googlePart{
+				{Text: prompt},
+				{InlineData: &googleBlob{MimeType: mediaType, Data: audio}},
+			},
+		}},
+	}
+
+	bodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("google transcriber: marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/models/%s:generateContent?key=%s", t.baseURL, t.model, apiKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return "", fmt.Errorf("google transcriber: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("google transcriber: request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("google transcriber: read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("google transcriber: status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result googleGenerateResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", fmt.Errorf("google transcriber: unmarshal response: %w", err)
+	}
+	if len(result.Candidates) == 0 {
+		return "", fmt.Errorf("google transcriber: empty response")
+	}
+
+	var text strings.Builder
+	for _, p := range result.Candidates[0].Content.Parts {
+		text.WriteString(p.Text)
+	}
+	return strings.TrimSpace(text.String()), nil
+}
+
+type googleGenerateRequest struct {
+	Contents []googleContent `json:"contents"`
+}
+
+type googleContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []googlePart `json:"parts"`
+}
+
+type googlePart struct {
+	Text       string      `json:"text,omitempty"`
+	InlineData *googleBlob `json:"inlineData,omitempty"`
+}
+
+// googleBlob is inline media; Data is base64-encoded in JSON.
+type googleBlob struct {
+	MimeType string `json:"mimeType"`
+	Data     []byte `json:"data"`
+}
+
+type googleGenerateResponse struct {
+	Candidates []struct {
+		Content googleContent `json:"content"`
+	} `json:"candidates"`
+}
@@ -0,0 +1,114 @@
+package transcription
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"strings"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/codeready-toolchain/tarsy/pkg/models"
+)
+
+const openaiBaseURL = "https://api.openai.com/v1"
+
+// OpenAITranscriber calls an OpenAI-compatible audio transcriptions API.
+type OpenAITranscriber struct {
+	model     string
+	apiKeyEnv string
+	language  string
+	baseURL   string
+	client    *http.Client
+}
+
+// NewOpenAITranscriber creates a transcriber backed by the OpenAI audio
+// transcriptions API, or a compatible server at cfg.BaseURL.
+func NewOpenAITranscriber(cfg *config.TranscriptionConfig) (*OpenAITranscriber, error) {
+	if cfg.APIKeyEnv == "" {
+		return nil, fmt.Errorf("openai transcriber: api_key_env is required")
+	}
+	base := cfg.BaseURL
+	if base == "" {
+		base = openaiBaseURL
+	}
+	return &OpenAITranscriber{
+		model:     cfg.Model,
+		apiKeyEnv: cfg.APIKeyEnv,
+		language:  cfg.Language,
+		baseURL:   strings.TrimRight(base, "/"),
+		client:    &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+// Transcribe uploads audio as multipart form data and returns the text.
+func (t *OpenAITranscriber) Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error) {
+	apiKey := os.Getenv(t.apiKeyEnv)
+	if apiKey == "" {
+		return "", fmt.Errorf("openai transcriber: env var %s is not set", t.apiKeyEnv)
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	// The API detects the format from the file name, not the part's Content-Type
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="file"; filename="voice-note`+models.VoiceNoteExtension(mimeType)+`"`)
+	h.Set("Content-Type", mimeType)
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return "", fmt.Errorf("openai transcriber: build request: %w", err)
+	}
+	if _, err := part.Write(audio); err != nil {
+		return "", fmt.Errorf("openai transcriber: build request: %w", err)
+	}
+	fields := map[string]string{"model": t.model, "response_format": "json"}
+	if t.language != "" {
+		fields["language"] = t.language
+	}
+	for name, value := range fields {
+		if err := w.WriteField(name, value); err != nil {
+			return "", fmt.Errorf("openai transcriber: build request: %w", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("openai transcriber: build request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.baseURL+"/audio/transcriptions", &body)
+	if err != nil {
+		return "", fmt.Errorf("openai transcriber: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("openai transcriber: request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("openai transcriber: read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("openai transcriber: status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result openaiTranscriptionResponse
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return "", fmt.Errorf("openai transcriber: unmarshal response: %w", err)
+	}
+
+	return strings.TrimSpace(result.Text), nil
+}
+
+type openaiTranscriptionResponse struct {
+	Text string `json:"text"`
+}
@@ -0,0 +1,29 @@
+// Package transcription turns chat voice notes into text through a
+// configurable speech-to-text provider.
+package transcription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+)
+
+// Transcriber converts recorded speech to text.
+type Transcriber interface {
+	// Transcribe returns the transcript of audio, whose format is given by
+	// mimeType (one accepted by models.ValidateVoiceNote).
+	Transcribe(ctx context.Context, audio []byte, mimeType string) (string, error)
+}
+
+// New creates a Transcriber for the configured provider.
+func New(cfg *config.TranscriptionConfig) (Transcriber, error) {
+	switch cfg.Provider {
+	case config.TranscriptionProviderOpenAI:
+		return NewOpenAITranscriber(cfg)
+	case config.TranscriptionProviderGoogle:
+		return NewGoogleTranscriber(cfg)
+	default:
+		return nil, fmt.Errorf("unknown transcription provider: %s", cfg.Provider)
+	}
+}
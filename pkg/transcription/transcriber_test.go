@@ -0,0 +1,142 @@
+package transcription
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/codeready-toolchain/tarsy/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	cfg := config.DefaultTranscriptionConfig()
+	tr, err := New(cfg)
+	require.NoError(t, err)
+	assert.IsType(t, &OpenAITranscriber{}, tr)
+
+	cfg.Provider = config.TranscriptionProviderGoogle
+	tr, err = New(cfg)
+	require.NoError(t, err)
+	assert.IsType(t, &GoogleTranscriber{}, tr)
+
+	cfg.Provider = "bogus"
+	_, err = New(cfg)
+	assert.ErrorContains(t, err, "unknown transcription provider")
+}
+
+func TestOpenAITranscriber_RequestConstruction(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/audio/transcriptions", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "whisper-1", r.FormValue("model"))
+		assert.Equal(t, "en", r.FormValue("language"))
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		assert.Equal(t, "voice-note.webm", header.Filename)
+		data, _ := io.ReadAll(file)
+		assert.Equal(t, "audio-bytes", string(data))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"text": " Check the payments pods first. "}`))
+	}))
+	defer srv.Close()
+
+	t.Setenv("TEST_OPENAI_KEY", "test-key")
+
+	tr, err := NewOpenAITranscriber(&config.TranscriptionConfig{
+		Model:     "whisper-1",
+		APIKeyEnv: "TEST_OPENAI_KEY",
+		BaseURL:   srv.URL + "/",
+		Language:  "en",
+		Timeout:   5 * time.Second,
+	})
+	require.NoError(t, err)
+
+	text, err := tr.Transcribe(t.Context(), []byte("audio-bytes"), "audio/webm;codecs=opus")
+	require.NoError(t, err)
+	assert.Equal(t, "Check the payments pods first.", text)
+}
+
+func TestOpenAITranscriber_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error":{"message":"Invalid file format."}}`, http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	t.Setenv("TEST_OPENAI_KEY", "test-key")
+
+	tr, err := NewOpenAITranscriber(&config.TranscriptionConfig{Model: "whisper-1", APIKeyEnv: "TEST_OPENAI_KEY", BaseURL: srv.URL})
+	require.NoError(t, err)
+
+	_, err = tr.Transcribe(t.Context(), []byte("audio-bytes"), "audio/webm")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 400")
+	assert.Contains(t, err.Error(), "Invalid file format")
+}
+
+func TestOpenAITranscriber_MissingKey(t *testing.T) {
+	tr, err := NewOpenAITranscriber(&config.TranscriptionConfig{Model: "whisper-1", APIKeyEnv: "TEST_UNSET_TRANSCRIPTION_KEY"})
+	require.NoError(t, err)
+
+	_, err = tr.Transcribe(t.Context(), []byte("audio-bytes"), "audio/webm")
+	assert.ErrorContains(t, err, "env var TEST_UNSET_TRANSCRIPTION_KEY is not set")
+}
+
+func TestGoogleTranscriber_RequestConstruction(t *testing.T) {
+	var captured googleGenerateRequest
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/models/gemini-2.5-flash:generateContent", r.URL.Path)
+		assert.Contains(t, r.URL.RawQuery, "key=test-key")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&captured))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"candidates":[{"content":{"parts":[{"text":"Rolled back "},{"text":"deploy 1234.\n"}]}}]}`))
+	}))
+	defer srv.Close()
+
+	t.Setenv("TEST_GOOGLE_KEY", "test-key")
+
+	tr, err := NewGoogleTranscriber(&config.TranscriptionConfig{
+		Model:     "gemini-2.5-flash",
+		APIKeyEnv: "TEST_GOOGLE_KEY",
+		BaseURL:   srv.URL,
+	})
+	require.NoError(t, err)
+
+	text, err := tr.Transcribe(t.Context(), []byte("audio-bytes"), "audio/ogg; codecs=opus")
+	require.NoError(t, err)
+	assert.Equal(t, "Rolled back deploy 1234.", text)
+
+	require.Len(t, captured.Contents, 1)
+	parts := captured.Contents[0].Parts
+	require.Len(t, parts, 2)
+	assert.Contains(t, parts[0].Text, "Transcribe this voice note")
+	require.NotNil(t, parts[1].InlineData)
+	assert.Equal(t, "audio/ogg", parts[1].InlineData.MimeType)
+	assert.Equal(t, []byte("audio-bytes"), parts[1].InlineData.Data)
+}
+
+func TestGoogleTranscriber_EmptyResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"candidates":[]}`))
+	}))
+	defer srv.Close()
+
+	t.Setenv("TEST_GOOGLE_KEY", "test-key")
+
+	tr, err := NewGoogleTranscriber(&config.TranscriptionConfig{Model: "gemini-2.5-flash", APIKeyEnv: "TEST_GOOGLE_KEY", BaseURL: srv.URL})
+	require.NoError(t, err)
+
+	_, err = tr.Transcribe(t.Context(), []byte("audio-bytes"), "audio/ogg")
+	assert.ErrorContains(t, err, "empty response")
+}
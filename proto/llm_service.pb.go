@@ -307,7 +307,10 @@ type ConversationMessage struct {
 	ToolCallId string `protobuf:"bytes,4,opt,name=tool_call_id,json=toolCallId,proto3" json:"tool_call_id,omitempty"`
 	// For tool result messages (role = "tool").
 	// The name of the tool that was called.
-	ToolName      string `protobuf:"bytes,5,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	ToolName string `protobuf:"bytes,5,opt,name=tool_name,json=toolName,proto3" json:"tool_name,omitempty"`
+	// For user messages: images sent alongside the text
+	// (only to providers whose model accepts image input).
+	Images        []*ImagePart `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -377,6 +380,13 @@ func (x *ConversationMessage) GetToolName() string {
 	return ""
 }
 
+func (x *ConversationMessage) GetImages() []*ImagePart {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
 // ToolDefinition describes a tool available to the LLM.
 // Names use canonical "server.tool" format (e.g., "kubernetes-server.resources_get").
 // Python providers convert to/from provider-specific formats as needed.
@@ -1058,6 +1068,7 @@ type LLMConfig struct {
 	Project             string                 `protobuf:"bytes,8,opt,name=project,proto3" json:"project,omitempty"`                                                                                                       // GCP project (for VertexAI)
 	Location            string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`                                                                                                     // GCP location (for VertexAI)
 	Backend             string                 `protobuf:"bytes,10,opt,name=backend,proto3" json:"backend,omitempty"`                                                                                                      // Provider backend: "google-native", "langchain" (default)
+	Generation          *GenerationParams      `protobuf:"bytes,11,opt,name=generation,proto3" json:"generation,omitempty"`                                                                                                // Sampling overrides (unset = provider default)
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -1162,6 +1173,136 @@ func (x *LLMConfig) GetBackend() string {
 	return ""
 }
 
+func (x *LLMConfig) GetGeneration() *GenerationParams {
+	if x != nil {
+		return x.Generation
+	}
+	return nil
+}
+
+// GenerationParams tunes sampling for a single call. Each field is applied
+// only when set; providers ignore parameters the model does not support.
+type GenerationParams struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Temperature     *float64               `protobuf:"fixed64,1,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	TopP            *float64               `protobuf:"fixed64,2,opt,name=top_p,json=topP,proto3,oneof" json:"top_p,omitempty"`
+	MaxOutputTokens *int32                 `protobuf:"varint,3,opt,name=max_output_tokens,json=maxOutputTokens,proto3,oneof" json:"max_output_tokens,omitempty"`
+	ReasoningEffort string                 `protobuf:"bytes,4,opt,name=reasoning_effort,json=reasoningEffort,proto3" json:"reasoning_effort,omitempty"` // "low", "medium", "high" (empty = provider default)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerationParams) Reset() {
+	*x = GenerationParams{}
+	mi := &file_proto_llm_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerationParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerationParams) ProtoMessage() {}
+
+func (x *GenerationParams) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_llm_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerationParams.ProtoReflect.Descriptor instead.
+func (*GenerationParams) Descriptor() ([]byte, []int) {
+	return file_proto_llm_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GenerationParams) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *GenerationParams) GetTopP() float64 {
+	if x != nil && x.TopP != nil {
+		return *x.TopP
+	}
+	return 0
+}
+
+func (x *GenerationParams) GetMaxOutputTokens() int32 {
+	if x != nil && x.MaxOutputTokens != nil {
+		return *x.MaxOutputTokens
+	}
+	return 0
+}
+
+func (x *GenerationParams) GetReasoningEffort() string {
+	if x != nil {
+		return x.ReasoningEffort
+	}
+	return ""
+}
+
+// ImagePart is an image attached to a message (e.g. a dashboard screenshot).
+type ImagePart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MimeType      string                 `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // "image/png", "image/jpeg", "image/gif", "image/webp"
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`                         // Raw image bytes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImagePart) Reset() {
+	*x = ImagePart{}
+	mi := &file_proto_llm_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImagePart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePart) ProtoMessage() {}
+
+func (x *ImagePart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_llm_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePart.ProtoReflect.Descriptor instead.
+func (*ImagePart) Descriptor() ([]byte, []int) {
+	return file_proto_llm_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImagePart) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ImagePart) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_proto_llm_service_proto protoreflect.FileDescriptor
 
 const file_proto_llm_service_proto_rawDesc = "" +
@@ -1187,7 +1328,7 @@ const file_proto_llm_service_proto_rawDesc = "" +
 	"\tgrounding\x18\a \x01(\v2\x16.llm.v1.GroundingDeltaH\x00R\tgrounding\x12\x19\n" +
 	"\bis_final\x18\n" +
 	" \x01(\bR\aisFinalB\t\n" +
-	"\acontent\"\xb3\x01\n" +
+	"\acontent\"\xde\x01\n" +
 	"\x13ConversationMessage\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12/\n" +
@@ -1195,7 +1336,8 @@ const file_proto_llm_service_proto_rawDesc = "" +
 	"tool_calls\x18\x03 \x03(\v2\x10.llm.v1.ToolCallR\ttoolCalls\x12 \n" +
 	"\ftool_call_id\x18\x04 \x01(\tR\n" +
 	"toolCallId\x12\x1b\n" +
-	"\ttool_name\x18\x05 \x01(\tR\btoolName\"s\n" +
+	"\ttool_name\x18\x05 \x01(\tR\btoolName\x12)\n" +
+	"\x06images\x18\x06 \x03(\v2\x11.llm.v1.ImagePartR\x06images\"s\n" +
 	"\x0eToolDefinition\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12+\n" +
@@ -1237,7 +1379,7 @@ const file_proto_llm_service_proto_rawDesc = "" +
 	"\tErrorInfo\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1c\n" +
-	"\tretryable\x18\x03 \x01(\bR\tretryable\"\xad\x03\n" +
+	"\tretryable\x18\x03 \x01(\bR\tretryable\"\xe7\x03\n" +
 	"\tLLMConfig\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
 	"\x05model\x18\x02 \x01(\tR\x05model\x12\x1e\n" +
@@ -1249,10 +1391,24 @@ const file_proto_llm_service_proto_rawDesc = "" +
 	"\aproject\x18\b \x01(\tR\aproject\x12\x1a\n" +
 	"\blocation\x18\t \x01(\tR\blocation\x12\x18\n" +
 	"\abackend\x18\n" +
-	" \x01(\tR\abackend\x1a>\n" +
+	" \x01(\tR\abackend\x128\n" +
+	"\n" +
+	"generation\x18\v \x01(\v2\x18.llm.v1.GenerationParamsR\n" +
+	"generation\x1a>\n" +
 	"\x10NativeToolsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x012M\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xdf\x01\n" +
+	"\x10GenerationParams\x12%\n" +
+	"\vtemperature\x18\x01 \x01(\x01H\x00R\vtemperature\x88\x01\x01\x12\x18\n" +
+	"\x05top_p\x18\x02 \x01(\x01H\x01R\x04topP\x88\x01\x01\x12/\n" +
+	"\x11max_output_tokens\x18\x03 \x01(\x05H\x02R\x0fmaxOutputTokens\x88\x01\x01\x12)\n" +
+	"\x10reasoning_effort\x18\x04 \x01(\tR\x0freasoningEffortB\x0e\n" +
+	"\f_temperatureB\b\n" +
+	"\x06_top_pB\x14\n" +
+	"\x12_max_output_tokens\"<\n" +
+	"\tImagePart\x12\x1b\n" +
+	"\tmime_type\x18\x01 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data2M\n" +
 	"\n" +
 	"LLMService\x12?\n" +
 	"\bGenerate\x12\x17.llm.v1.GenerateRequest\x1a\x18.llm.v1.GenerateResponse0\x01B2Z0github.com/codeready-toolchain/tarsy/proto;llmv1b\x06proto3"
@@ -1269,7 +1425,7 @@ func file_proto_llm_service_proto_rawDescGZIP() []byte {
 	return file_proto_llm_service_proto_rawDescData
 }
 
-var file_proto_llm_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_llm_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_proto_llm_service_proto_goTypes = []any{
 	(*GenerateRequest)(nil),     // 0: llm.v1.GenerateRequest
 	(*GenerateResponse)(nil),    // 1: llm.v1.GenerateResponse
@@ -1286,7 +1442,9 @@ var file_proto_llm_service_proto_goTypes = []any{
 	(*UsageInfo)(nil),           // 12: llm.v1.UsageInfo
 	(*ErrorInfo)(nil),           // 13: llm.v1.ErrorInfo
 	(*LLMConfig)(nil),           // 14: llm.v1.LLMConfig
-	nil,                         // 15: llm.v1.LLMConfig.NativeToolsEntry
+	(*GenerationParams)(nil),    // 15: llm.v1.GenerationParams
+	(*ImagePart)(nil),           // 16: llm.v1.ImagePart
+	nil,                         // 17: llm.v1.LLMConfig.NativeToolsEntry
 }
 var file_proto_llm_service_proto_depIdxs = []int32{
 	2,  // 0: llm.v1.GenerateRequest.messages:type_name -> llm.v1.ConversationMessage
@@ -1300,16 +1458,18 @@ var file_proto_llm_service_proto_depIdxs = []int32{
 	8,  // 8: llm.v1.GenerateResponse.code_execution:type_name -> llm.v1.CodeExecutionDelta
 	9,  // 9: llm.v1.GenerateResponse.grounding:type_name -> llm.v1.GroundingDelta
 	4,  // 10: llm.v1.ConversationMessage.tool_calls:type_name -> llm.v1.ToolCall
-	10, // 11: llm.v1.GroundingDelta.grounding_chunks:type_name -> llm.v1.GroundingChunkInfo
-	11, // 12: llm.v1.GroundingDelta.grounding_supports:type_name -> llm.v1.GroundingSupport
-	15, // 13: llm.v1.LLMConfig.native_tools:type_name -> llm.v1.LLMConfig.NativeToolsEntry
-	0,  // 14: llm.v1.LLMService.Generate:input_type -> llm.v1.GenerateRequest
-	1,  // 15: llm.v1.LLMService.Generate:output_type -> llm.v1.GenerateResponse
-	15, // [15:16] is the sub-list for method output_type
-	14, // [14:15] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	16, // 11: llm.v1.ConversationMessage.images:type_name -> llm.v1.ImagePart
+	10, // 12: llm.v1.GroundingDelta.grounding_chunks:type_name -> llm.v1.GroundingChunkInfo
+	11, // 13: llm.v1.GroundingDelta.grounding_supports:type_name -> llm.v1.GroundingSupport
+	17, // 14: llm.v1.LLMConfig.native_tools:type_name -> llm.v1.LLMConfig.NativeToolsEntry
+	15, // 15: llm.v1.LLMConfig.generation:type_name -> llm.v1.GenerationParams
+	0,  // 16: llm.v1.LLMService.Generate:input_type -> llm.v1.GenerateRequest
+	1,  // 17: llm.v1.LLMService.Generate:output_type -> llm.v1.GenerateResponse
+	17, // [17:18] is the sub-list for method output_type
+	16, // [16:17] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_proto_llm_service_proto_init() }
@@ -1326,13 +1486,14 @@ func file_proto_llm_service_proto_init() {
 		(*GenerateResponse_CodeExecution)(nil),
 		(*GenerateResponse_Grounding)(nil),
 	}
+	file_proto_llm_service_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_llm_service_proto_rawDesc), len(file_proto_llm_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
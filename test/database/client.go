@@ -16,7 +16,7 @@ import (
 // In CI (when CI_DATABASE_URL is set): connects to external PostgreSQL service container.
 // In local dev: spins up a testcontainer with PostgreSQL.
 // The container/connection is automatically cleaned up when the test ends.
-func NewTestClient(t *testing.T) *database.Client {
+func NewTestClient(t testing.TB) *database.Client {
 	ctx := context.Background()
 
 	// Use shared test database setup
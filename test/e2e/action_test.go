@@ -203,25 +203,29 @@ func TestE2E_ActionChain(t *testing.T) {
 	AssertEventsInOrder(t, ws.Events(), testdata.ActionChainExpectedEvents)
 
 	// ── execution.progress phase assertions ──
-	// Investigation stage should publish "investigating", action stage should publish "remediating".
+	// Both stages publish the standard "analyzing" phase with a percent estimate.
 	investigationStageID := stages[0].ID
 	remediationStageID := stages[1].ID
-	var sawInvestigating, sawRemediating bool
+	var sawInvestigation, sawRemediation bool
 	for _, ev := range ws.Events() {
 		if ev.Type != "execution.progress" {
 			continue
 		}
 		phase, _ := ev.Parsed["phase"].(string)
 		stgID, _ := ev.Parsed["stage_id"].(string)
-		if stgID == investigationStageID && phase == "investigating" {
-			sawInvestigating = true
+		_, hasPercent := ev.Parsed["percent"].(float64)
+		if phase != "analyzing" || !hasPercent {
+			continue
+		}
+		if stgID == investigationStageID {
+			sawInvestigation = true
 		}
-		if stgID == remediationStageID && phase == "remediating" {
-			sawRemediating = true
+		if stgID == remediationStageID {
+			sawRemediation = true
 		}
 	}
-	assert.True(t, sawInvestigating, "should see 'investigating' progress for investigation stage")
-	assert.True(t, sawRemediating, "should see 'remediating' progress for action stage")
+	assert.True(t, sawInvestigation, "should see 'analyzing' progress for investigation stage")
+	assert.True(t, sawRemediation, "should see 'analyzing' progress for action stage")
 
 	// ── Golden file assertions ──
 	traceList := app.GetTraceList(t, sessionID)
@@ -33,7 +33,9 @@ var (
 	unixTSRe          = regexp.MustCompile(`"(created_at|updated_at|started_at|completed_at|timestamp)":\s*\d{10,13}`)
 	dbEventIDRe       = regexp.MustCompile(`"db_event_id":\s*\d+`)
 	connIDRe          = regexp.MustCompile(`"connection_id":\s*"[^"]*"`)
+	requestIDRe       = regexp.MustCompile(`"request_id":\s*"[^"]*"`)
 	durationMsRe      = regexp.MustCompile(`"duration_ms":\s*\d+`)
+	promptSizeRe      = regexp.MustCompile(`"(prompt_chars|tool_result_chars|prompt_tokens|tool_result_tokens)":\s*\d+`)
 	currentTimeLineRe = regexp.MustCompile(`Current time: [^\n]+`)
 	memoryAgeRe       = regexp.MustCompile(`(learned|updated) (?:just now|\d+ \w+ ago)`)
 	memoryScoreRe     = regexp.MustCompile(`, score: -?\d+\.\d+`)
@@ -168,9 +170,18 @@ func (n *Normalizer) Normalize(data string) string {
 	// 14. Replace connection_id.
 	data = connIDRe.ReplaceAllString(data, `"connection_id": "{CONN_ID}"`)
 
-	// 15. Replace duration_ms (non-deterministic timing).
+	// 15. Replace request_id (generated per API call).
+	data = requestIDRe.ReplaceAllString(data, `"request_id": "{REQUEST_ID}"`)
+
+	// 16. Replace duration_ms (non-deterministic timing).
 	data = durationMsRe.ReplaceAllString(data, `"duration_ms": {DURATION_MS}`)
 
+	// 17. Replace prompt sizes and token counts (the prompt embeds the current time and memory ages).
+	data = promptSizeRe.ReplaceAllStringFunc(data, func(match string) string {
+		key := promptSizeRe.FindStringSubmatch(match)[1]
+		return fmt.Sprintf(`"%s": {%s}`, key, strings.ToUpper(key))
+	})
+
 	return data
 }
 
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/codeready-toolchain/tarsy/ent"
+	_ "github.com/codeready-toolchain/tarsy/ent/runtime" // Register schema defaults and hooks
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
@@ -34,7 +35,7 @@ var (
 // - CI: Connects to external PostgreSQL service container
 // - Local: Uses a shared testcontainer (started once per package)
 // Returns the ent client and database connection for wrapping by the caller.
-func SetupTestDatabase(t *testing.T) (*ent.Client, *stdsql.DB) {
+func SetupTestDatabase(t testing.TB) (*ent.Client, *stdsql.DB) {
 	ctx := context.Background()
 
 	// Get connection string (from CI env var or shared container)
@@ -92,13 +93,13 @@ func SetupTestDatabase(t *testing.T) (*ent.Client, *stdsql.DB) {
 // GetBaseConnectionString returns the base PostgreSQL connection string
 // (without schema search_path). Used by integration tests that need a raw
 // connection string for dedicated connections, e.g. NotifyListener's pgx.Conn.
-func GetBaseConnectionString(t *testing.T) string {
+func GetBaseConnectionString(t testing.TB) string {
 	return getOrCreateSharedDatabase(t)
 }
 
 // getOrCreateSharedDatabase returns a connection string to the shared database.
 // In CI, uses CI_DATABASE_URL. In local dev, creates a shared testcontainer once.
-func getOrCreateSharedDatabase(t *testing.T) string {
+func getOrCreateSharedDatabase(t testing.TB) string {
 	// Check if we're in CI with an external database
 	if ciDatabaseURL := os.Getenv("CI_DATABASE_URL"); ciDatabaseURL != "" {
 		t.Log("Using external PostgreSQL from CI_DATABASE_URL")
@@ -159,7 +160,7 @@ func getOrCreateSharedDatabase(t *testing.T) string {
 
 // GenerateSchemaName creates a unique, PostgreSQL-safe schema name for the test.
 // Format: test_<sanitized_test_name>_<random_hex>
-func GenerateSchemaName(t *testing.T) string {
+func GenerateSchemaName(t testing.TB) string {
 	// Get test name and sanitize it (lowercase, replace invalid chars with _)
 	testName := strings.ToLower(t.Name())
 	testName = strings.Map(func(r rune) rune {
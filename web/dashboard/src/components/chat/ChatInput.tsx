@@ -1,12 +1,14 @@
 /**
  * ChatInput — multiline text input with send/cancel buttons and character counter.
+ * When voice notes are enabled, a microphone button records a voice note
+ * that is sent (with any typed text) when recording stops.
  *
  * Visual layer copied from old dashboard (ChatInput.tsx).
  * Data layer: onSendMessage and onCancelExecution are void callbacks;
  * error handling lives in the parent (ChatPanel / SessionDetailPage).
  */
 
-import { useState } from 'react';
+import { useState, useRef, useEffect } from 'react';
 import {
   Box,
   TextField,
@@ -15,11 +17,27 @@ import {
   Tooltip,
   Typography,
 } from '@mui/material';
-import { Send, Stop, Warning } from '@mui/icons-material';
+import { Send, Stop, Warning, Mic, StopCircle } from '@mui/icons-material';
 import { MAX_MESSAGE_LENGTH, WARNING_THRESHOLD } from '../../constants/chat.ts';
+import type { VoiceNoteUpload } from '../../types/api.ts';
+
+/** Recording stops (and the voice note is sent) after this long. */
+const MAX_RECORDING_MS = 2 * 60 * 1000;
+
+/** Base64-encode a recorded blob for the JSON request body. */
+function blobToBase64(blob: Blob): Promise<string> {
+  return new Promise((resolve, reject) => {
+    const reader = new FileReader();
+    reader.onload = () => resolve(String(reader.result).split(',')[1] ?? '');
+    reader.onerror = () => reject(reader.error);
+    reader.readAsDataURL(blob);
+  });
+}
 
 interface ChatInputProps {
-  onSendMessage: (content: string) => void;
+  onSendMessage: (content: string, voiceNote?: VoiceNoteUpload) => void;
+  /** Show the microphone button (server has transcription configured) */
+  voiceNotesEnabled?: boolean;
   onCancelExecution?: () => void;
   disabled?: boolean;
   sendingMessage?: boolean;
@@ -33,6 +51,7 @@ interface ChatInputProps {
 
 export default function ChatInput({
   onSendMessage,
+  voiceNotesEnabled = false,
   onCancelExecution,
   disabled,
   sendingMessage = false,
@@ -42,11 +61,69 @@ export default function ChatInput({
   placeholder: placeholderProp,
 }: ChatInputProps) {
   const [content, setContent] = useState('');
+  const [recording, setRecording] = useState(false);
+  const [micError, setMicError] = useState<string | null>(null);
+  const recorderRef = useRef<MediaRecorder | null>(null);
+  const discardRecordingRef = useRef(false);
+  // Read when recording stops, so the voice note carries the latest typed text
+  const contentRef = useRef(content);
+
+  useEffect(() => {
+    contentRef.current = content;
+  }, [content]);
+
+  // Release the microphone without sending if unmounted mid-recording
+  useEffect(() => () => {
+    discardRecordingRef.current = true;
+    recorderRef.current?.stop();
+  }, []);
 
   const isDisabled = disabled || sendingMessage;
   const isOverLimit = content.length > MAX_MESSAGE_LENGTH;
   const isNearLimit = content.length >= WARNING_THRESHOLD && content.length <= MAX_MESSAGE_LENGTH;
-  const canSend = !!content.trim() && !isDisabled && !isOverLimit;
+  const canSend = !!content.trim() && !isDisabled && !isOverLimit && !recording;
+  const canRecord =
+    voiceNotesEnabled && typeof MediaRecorder !== 'undefined' && !!navigator.mediaDevices?.getUserMedia;
+
+  const startRecording = async () => {
+    setMicError(null);
+    let stream: MediaStream;
+    try {
+      stream = await navigator.mediaDevices.getUserMedia({ audio: true });
+    } catch {
+      setMicError('Microphone access was denied');
+      return;
+    }
+    const recorder = new MediaRecorder(stream);
+    const chunks: Blob[] = [];
+    const timer = setTimeout(() => recorder.stop(), MAX_RECORDING_MS);
+    recorder.ondataavailable = (e) => {
+      if (e.data.size > 0) chunks.push(e.data);
+    };
+    recorder.onstop = async () => {
+      clearTimeout(timer);
+      stream.getTracks().forEach((track) => track.stop());
+      recorderRef.current = null;
+      if (discardRecordingRef.current || chunks.length === 0) return;
+      setRecording(false);
+      const blob = new Blob(chunks, { type: recorder.mimeType });
+      try {
+        const data = await blobToBase64(blob);
+        onSendMessage(contentRef.current.trim(), { mime_type: blob.type, data });
+        setContent('');
+      } catch {
+        setMicError('Failed to read the recording');
+      }
+    };
+    discardRecordingRef.current = false;
+    recorderRef.current = recorder;
+    recorder.start();
+    setRecording(true);
+  };
+
+  const stopRecording = () => {
+    recorderRef.current?.stop();
+  };
 
   const handleSend = () => {
     if (!canSend) return;
@@ -120,6 +197,21 @@ export default function ChatInput({
           }}
         />
 
+        {canRecord && !(canCancel && (sendingMessage || canceling)) && (
+          <Tooltip title={recording ? 'Stop and send voice note' : 'Record a voice note'}>
+            <span>
+              <IconButton
+                color={recording ? 'error' : 'default'}
+                onClick={recording ? stopRecording : startRecording}
+                disabled={isDisabled && !recording}
+                sx={{ transition: 'all 0.2s', '&:hover': { transform: 'scale(1.1)' } }}
+              >
+                {recording ? <StopCircle /> : <Mic />}
+              </IconButton>
+            </span>
+          </Tooltip>
+        )}
+
         {/* Show Stop button when processing and can cancel, otherwise Send button */}
         {canCancel && (sendingMessage || canceling) ? (
           <Tooltip title={canceling ? 'Stopping...' : 'Stop processing'}>
@@ -201,6 +293,18 @@ export default function ChatInput({
         </Box>
       )}
 
+      {/* Recording status and microphone errors */}
+      {(recording || micError) && (
+        <Box sx={{ px: { xs: 1, sm: 2 }, pt: 0.5 }}>
+          <Typography
+            variant="caption"
+            sx={{ color: micError ? 'error.main' : 'text.secondary', fontSize: '0.75rem' }}
+          >
+            {micError ?? 'Recording voice note... click stop to send it'}
+          </Typography>
+        </Box>
+      )}
+
       {/* Subtle status message when processing */}
       {sendingMessage && (
         <Box sx={{ px: { xs: 1, sm: 2 }, pb: 1, pt: 0.5 }}>
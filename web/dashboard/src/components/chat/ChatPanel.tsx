@@ -16,16 +16,21 @@ import {
 } from '@mui/material';
 import { Forum } from '@mui/icons-material';
 import ChatInput from './ChatInput.tsx';
+import type { VoiceNoteUpload } from '../../types/api.ts';
 
 interface ChatPanelProps {
   isAvailable: boolean;
   chatExists: boolean;
-  onSendMessage: (content: string) => void;
+  onSendMessage: (content: string, voiceNote?: VoiceNoteUpload) => void;
+  /** Show the voice note (record) button */
+  voiceNotesEnabled?: boolean;
   onCancelExecution: () => void;
   sendingMessage?: boolean;
   chatStageInProgress?: boolean;
   canCancel?: boolean;
   canceling?: boolean;
+  /** Place of the queued follow-up question in the chat queue (null = none). */
+  queuePosition?: number | null;
   error?: string | null;
   onClearError?: () => void;
 }
@@ -34,11 +39,13 @@ const ChatPanel = forwardRef<HTMLDivElement, ChatPanelProps>(function ChatPanel(
   isAvailable,
   chatExists,
   onSendMessage,
+  voiceNotesEnabled = false,
   onCancelExecution,
   sendingMessage = false,
   chatStageInProgress = false,
   canCancel = false,
   canceling = false,
+  queuePosition = null,
   error,
   onClearError,
 }, ref) {
@@ -61,6 +68,14 @@ const ChatPanel = forwardRef<HTMLDivElement, ChatPanelProps>(function ChatPanel(
         </Alert>
       )}
 
+      {queuePosition !== null && (
+        <Alert severity="info" sx={{ m: 1.5, mb: 0 }}>
+          <Typography variant="body2">
+            Your question is queued (position {queuePosition}) and will be answered as soon as possible.
+          </Typography>
+        </Alert>
+      )}
+
       {inputDisabled && (
         <Box
           sx={(theme) => ({
@@ -94,6 +109,7 @@ const ChatPanel = forwardRef<HTMLDivElement, ChatPanelProps>(function ChatPanel(
         <Box sx={{ flex: 1, minWidth: 0 }}>
           <ChatInput
             onSendMessage={onSendMessage}
+            voiceNotesEnabled={voiceNotesEnabled}
             onCancelExecution={onCancelExecution}
             disabled={inputDisabled}
             sendingMessage={inputDisabled}
@@ -66,7 +66,7 @@ export default function ProgressIndicator({
     ? (status === SESSION_STATUS.CANCELLING || status === SESSION_STATUS.PENDING ? 'warning.main' : 'primary.main')
     : status === SESSION_STATUS.COMPLETED ? 'success.main'
       : (status === SESSION_STATUS.FAILED || status === SESSION_STATUS.TIMED_OUT) ? 'error.main'
-        : status === SESSION_STATUS.CANCELLED ? 'text.disabled'
+        : (status === SESSION_STATUS.CANCELLED || status === SESSION_STATUS.AUTO_CANCELLED) ? 'text.disabled'
           : 'text.secondary';
 
   // --- Inline variant: compact "50s" text for embedding in title rows ---
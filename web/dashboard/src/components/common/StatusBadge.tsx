@@ -47,6 +47,8 @@ function getStatusConfig(status: string): StatusConfig {
       return { color: 'error', icon: <ErrorIcon sx={{ fontSize: 16 }} />, label: 'Failed' };
     case SESSION_STATUS.CANCELLED:
       return { color: 'default', icon: <Cancel sx={{ fontSize: 16 }} />, label: 'Cancelled' };
+    case SESSION_STATUS.AUTO_CANCELLED:
+      return { color: 'default', icon: <Cancel sx={{ fontSize: 16 }} />, label: 'Auto-cancelled' };
     case SESSION_STATUS.TIMED_OUT:
       return { color: 'error', icon: <AccessAlarm sx={{ fontSize: 16 }} />, label: 'Timed Out' };
     default:
@@ -77,7 +79,7 @@ export function StatusBadge({ status, size = 'small' }: StatusBadgeProps) {
   };
 
   const cancelledSx =
-    status === SESSION_STATUS.CANCELLED
+    status === SESSION_STATUS.CANCELLED || status === SESSION_STATUS.AUTO_CANCELLED
       ? {
           fontWeight: 600,
           backgroundColor: 'grey.600',
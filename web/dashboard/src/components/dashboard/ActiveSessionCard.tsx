@@ -30,7 +30,7 @@ import {
   OpenInNew,
 } from '@mui/icons-material';
 import { useNavigate, useHref } from 'react-router-dom';
-import { liveDuration } from '../../utils/format.ts';
+import { formatDurationMs, formatEstimatedCostUsd, liveDuration } from '../../utils/format.ts';
 import { sessionDetailPath } from '../../constants/routes.ts';
 import { SESSION_STATUS } from '../../constants/sessionStatus.ts';
 import type { ActiveSessionItem } from '../../types/session.ts';
@@ -114,6 +114,7 @@ export function ActiveSessionCard({ session, progress }: ActiveSessionCardProps)
   const currentIndex = progress?.current_stage_index ?? session.current_stage_index ?? 0;
   const stageName = progress?.current_stage_name ?? 'starting';
   const statusText = progress?.status_text ?? '';
+  const forecast = progress?.forecast;
 
   const statusConfig = getStatusChipConfig(session.status);
   const isActive = session.status === SESSION_STATUS.IN_PROGRESS;
@@ -241,6 +242,16 @@ export function ActiveSessionCard({ session, progress }: ActiveSessionCardProps)
             {statusText}
           </Typography>
         )}
+
+        {/* Budget forecast from similar completed sessions */}
+        {forecast && forecast.sample_size > 0 && (
+          <Typography variant="caption" color="text.secondary" sx={{ display: 'block' }}>
+            Usually ~{formatDurationMs(forecast.estimated_duration_seconds * 1000)}
+            {forecast.estimated_cost_usd != null &&
+              ` · ~${formatEstimatedCostUsd(forecast.estimated_cost_usd)}`}
+            {` (${forecast.sample_size} similar sessions)`}
+          </Typography>
+        )}
       </CardContent>
     </Card>
   );
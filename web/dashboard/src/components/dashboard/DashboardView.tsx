@@ -498,7 +498,14 @@ export function DashboardView() {
       // session.progress → update local progress map
       if (type === EVENT_SESSION_PROGRESS) {
         const payload = data as unknown as SessionProgressPayload;
-        setProgressData((prev) => ({ ...prev, [payload.session_id]: payload }));
+        // Later progress events don't repeat the start-of-session forecast
+        setProgressData((prev) => ({
+          ...prev,
+          [payload.session_id]: {
+            ...payload,
+            forecast: payload.forecast ?? prev[payload.session_id]?.forecast,
+          },
+        }));
         return;
       }
 
@@ -16,10 +16,12 @@ import {
 } from '@mui/material';
 import {
   SmsOutlined as ChatIcon,
+  AltRoute,
   CallSplit,
   FindInPage,
   Hub,
   SwapHoriz,
+  TrendingDown,
   BuildOutlined,
 } from '@mui/icons-material';
 import { useNavigate } from 'react-router-dom';
@@ -83,7 +85,7 @@ export function SessionListItem({
         </Box>
       </TableCell>
 
-      {/* Session indicators: parallel, sub-agents, fallback, chat */}
+      {/* Session indicators: parallel, sub-agents, fallback, degraded, chat */}
       <TableCell sx={{ width: 130, textAlign: 'right', px: 0.5 }}>
         <Box sx={{ display: 'flex', justifyContent: 'flex-end', gap: 0.5 }}>
           {session.has_parallel_stages && (
@@ -134,6 +136,28 @@ export function SessionListItem({
               />
             </Tooltip>
           )}
+          {session.fallback_chain && (
+            <Tooltip title={`No chain handles alert type "${session.alert_type ?? ''}" — ran the fallback chain ${session.chain_id}`}>
+              <Chip
+                icon={<AltRoute sx={{ fontSize: '0.875rem' }} />}
+                size="small"
+                color="warning"
+                variant="outlined"
+                sx={iconOnlyChipSx}
+              />
+            </Tooltip>
+          )}
+          {session.degraded_reason && (
+            <Tooltip title={`Degraded: ${session.degraded_reason}`}>
+              <Chip
+                icon={<TrendingDown sx={{ fontSize: '0.875rem' }} />}
+                size="small"
+                color="warning"
+                variant="outlined"
+                sx={iconOnlyChipSx}
+              />
+            </Tooltip>
+          )}
           {session.chat_message_count > 0 && (
             <Tooltip
               title={`Follow-up chat active (${session.chat_message_count} message${session.chat_message_count !== 1 ? 's' : ''})`}
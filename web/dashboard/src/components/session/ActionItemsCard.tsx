@@ -0,0 +1,187 @@
+import { useEffect, useState } from 'react';
+import {
+  Paper,
+  Typography,
+  Box,
+  Chip,
+  Checkbox,
+  IconButton,
+  Alert,
+  Button,
+  TextField,
+  Tooltip,
+} from '@mui/material';
+import { TaskAlt, Delete } from '@mui/icons-material';
+import { alpha } from '@mui/material/styles';
+
+import {
+  getSessionActionItems,
+  createActionItem,
+  updateActionItem,
+  deleteActionItem,
+  handleAPIError,
+} from '../../services/api.ts';
+import { timeAgo, formatTimestamp } from '../../utils/format.ts';
+import type { ActionItem } from '../../types/api.ts';
+
+interface ActionItemsCardProps {
+  sessionId: string;
+}
+
+/**
+ * Follow-up action items of a session: remediation recommendations
+ * extracted from the final analysis plus items added by hand, with
+ * open/done tracking.
+ */
+export default function ActionItemsCard({ sessionId }: ActionItemsCardProps) {
+  const [items, setItems] = useState<ActionItem[] | null>(null);
+  const [error, setError] = useState<string | null>(null);
+  const [draft, setDraft] = useState('');
+  const [adding, setAdding] = useState(false);
+
+  useEffect(() => {
+    let cancelled = false;
+    getSessionActionItems(sessionId)
+      .then((data) => { if (!cancelled) setItems(data); })
+      .catch((err) => { if (!cancelled) setError(handleAPIError(err)); });
+    return () => { cancelled = true; };
+  }, [sessionId]);
+
+  const replace = (updated: ActionItem) =>
+    setItems((prev) => prev?.map((i) => (i.id === updated.id ? updated : i)) ?? null);
+
+  const toggle = async (item: ActionItem) => {
+    setError(null);
+    try {
+      replace(await updateActionItem(item.id, { status: item.status === 'done' ? 'open' : 'done' }));
+    } catch (err) {
+      setError(handleAPIError(err));
+    }
+  };
+
+  const remove = async (item: ActionItem) => {
+    setError(null);
+    try {
+      await deleteActionItem(item.id);
+      setItems((prev) => prev?.filter((i) => i.id !== item.id) ?? null);
+    } catch (err) {
+      setError(handleAPIError(err));
+    }
+  };
+
+  const add = async () => {
+    const title = draft.trim();
+    if (!title) return;
+    setAdding(true);
+    setError(null);
+    try {
+      const created = await createActionItem(sessionId, { title });
+      setItems((prev) => [...(prev ?? []), created]);
+      setDraft('');
+    } catch (err) {
+      setError(handleAPIError(err));
+    } finally {
+      setAdding(false);
+    }
+  };
+
+  const open = items?.filter((i) => i.status === 'open').length ?? 0;
+
+  return (
+    <Paper sx={{ p: 2.5 }}>
+      <Box sx={{ display: 'flex', alignItems: 'center', gap: 1.5, mb: 1.5 }}>
+        <Box
+          sx={{
+            width: 40,
+            height: 40,
+            borderRadius: '50%',
+            bgcolor: (theme) => alpha(theme.palette.success.main, 0.15),
+            border: '2px solid',
+            borderColor: 'success.main',
+            display: 'flex',
+            alignItems: 'center',
+            justifyContent: 'center',
+            flexShrink: 0,
+          }}
+        >
+          <TaskAlt sx={{ fontSize: 24, color: 'success.main' }} />
+        </Box>
+        <Typography variant="h6">Action Items</Typography>
+        {items && items.length > 0 && (
+          <Chip
+            label={`${open} open`}
+            size="small"
+            variant="outlined"
+            sx={{ ml: 0.5, height: 20, fontSize: '0.75rem' }}
+          />
+        )}
+      </Box>
+
+      {error && <Alert severity="error" sx={{ mb: 1 }}>{error}</Alert>}
+
+      {items?.length === 0 && (
+        <Typography variant="body2" color="text.secondary">
+          No follow-up action items yet.
+        </Typography>
+      )}
+
+      {items?.map((item) => (
+        <Box key={item.id} sx={{ display: 'flex', alignItems: 'flex-start', gap: 1, py: 0.5 }}>
+          <Checkbox
+            size="small"
+            checked={item.status === 'done'}
+            onChange={() => void toggle(item)}
+            inputProps={{ 'aria-label': `Mark "${item.title}" ${item.status === 'done' ? 'open' : 'done'}` }}
+            sx={{ p: 0.5 }}
+          />
+          <Box sx={{ flex: 1, minWidth: 0 }}>
+            <Typography
+              variant="body2"
+              sx={{ fontWeight: 600, textDecoration: item.status === 'done' ? 'line-through' : 'none' }}
+            >
+              {item.title}
+            </Typography>
+            {item.details && (
+              <Typography variant="body2" color="text.secondary">
+                {item.details}
+              </Typography>
+            )}
+            <Typography variant="caption" color="text.secondary" component="div">
+              {[
+                item.source === 'extracted' ? 'From the analysis' : item.created_by && `Added by ${item.created_by}`,
+                item.assignee && `Assigned to ${item.assignee}`,
+                item.completed_by && `Done by ${item.completed_by}`,
+              ].filter(Boolean).join(' · ')}
+              {item.completed_at && (
+                <Tooltip title={formatTimestamp(item.completed_at, 'absolute')} arrow>
+                  <span> · {timeAgo(item.completed_at)}</span>
+                </Tooltip>
+              )}
+            </Typography>
+          </Box>
+          <Tooltip title="Delete">
+            <IconButton size="small" onClick={() => void remove(item)} aria-label={`Delete "${item.title}"`}>
+              <Delete fontSize="small" />
+            </IconButton>
+          </Tooltip>
+        </Box>
+      ))}
+
+      <Box sx={{ display: 'flex', gap: 1, mt: 1.5 }}>
+        <TextField
+          size="small"
+          fullWidth
+          value={draft}
+          onChange={(e) => setDraft(e.target.value)}
+          onKeyDown={(e) => { if (e.key === 'Enter') void add(); }}
+          placeholder="Add a follow-up action item…"
+          disabled={adding}
+          slotProps={{ htmlInput: { maxLength: 200 } }}
+        />
+        <Button size="small" variant="outlined" onClick={() => void add()} disabled={adding || !draft.trim()}>
+          Add
+        </Button>
+      </Box>
+    </Paper>
+  );
+}
@@ -18,6 +18,8 @@ import {
   AccountTree,
   Forum,
   SwapHoriz,
+  Star,
+  StarBorder,
 } from '@mui/icons-material';
 import type { FlowItem, StageGroup } from '../../utils/timelineParser';
 import type { StageOverview } from '../../types/session';
@@ -29,6 +31,8 @@ import {
   isFlowItemTerminal,
   flowItemsToPlainText,
   countProviderFallbacks,
+  countHighlights,
+  filterHighlightedFlowItems,
 } from '../../utils/timelineParser';
 import { TIMELINE_EVENT_TYPES, STAGE_TYPE, COLLAPSIBLE_STAGE_TYPES } from '../../constants/eventTypes';
 import StageSeparator from '../timeline/StageSeparator';
@@ -70,6 +74,8 @@ interface ConversationTimelineProps {
   streamingEvents?: Map<string, StreamingItem & { stageId?: string; executionId?: string }>;
   /** Per-agent progress statuses */
   agentProgressStatuses?: Map<string, string>;
+  /** Per-execution percent-complete estimates (agents and sub-agents) */
+  agentProgressPercents?: Map<string, number>;
   /** Real-time execution statuses from execution.status WS events (executionId → {status, stageId, agentIndex}) */
   executionStatuses?: Map<string, { status: string; stageId: string; agentIndex: number }>;
   /** Sub-agent streaming events (events with parent_execution_id) */
@@ -123,6 +129,7 @@ export default function ConversationTimeline({
   scoringStatus,
   streamingEvents,
   agentProgressStatuses,
+  agentProgressPercents,
   executionStatuses,
   subAgentStreamingEvents,
   subAgentExecutionStatuses,
@@ -170,6 +177,13 @@ export default function ConversationTimeline({
   // --- Auto-collapse system ---
   const [expandAllReasoning, setExpandAllReasoning] = useState(false);
   const [expandAllToolCalls, setExpandAllToolCalls] = useState(false);
+  // Highlights-only: condensed view of key findings, errors and warnings
+  const [highlightsOnly, setHighlightsOnly] = useState(false);
+  const highlightCount = useMemo(() => countHighlights(items), [items]);
+  const visibleItems = useMemo(
+    () => (highlightsOnly ? filterHighlightedFlowItems(items) : items),
+    [highlightsOnly, items],
+  );
   // Manual overrides: items the user has explicitly toggled
   const [manualOverrides, setManualOverrides] = useState<Set<string>>(new Set());
 
@@ -281,7 +295,7 @@ export default function ConversationTimeline({
   // separators are visible immediately, and the ProcessingIndicator appears
   // under the correct stage instead of the previous one.
   const stageGroups = useMemo(() => {
-    const groupsFromItems = groupFlowItemsByStage(items, stages);
+    const groupsFromItems = groupFlowItemsByStage(visibleItems, stages);
     const existingStageIds = new Set(groupsFromItems.map(g => g.stageId).filter(Boolean));
 
     const emptyGroups: StageGroup[] = [];
@@ -302,7 +316,7 @@ export default function ConversationTimeline({
 
     if (emptyGroups.length === 0) return groupsFromItems;
     return [...groupsFromItems, ...emptyGroups].sort((a, b) => a.stageIndex - b.stageIndex);
-  }, [items, stages]);
+  }, [visibleItems, stages]);
 
   // --- Copy ---
   const plainText = useMemo(() => flowItemsToPlainText(items), [items]);
@@ -404,6 +418,18 @@ export default function ConversationTimeline({
     return status;
   }, [progressStatus, scoringStatus, scoringInProgress, chatStageInProgress, isActive, selectedAgentExecutionId, agentProgressStatuses, executionStatuses, stages]);
 
+  // Percent estimate for the agent whose status the indicator shows: the
+  // selected agent, or the only running agent. Unknown for scoring and when
+  // several agents run without a selection.
+  const displayPercent = useMemo(() => {
+    if (scoringInProgress || !agentProgressPercents || !agentProgressStatuses) return undefined;
+    if (selectedAgentExecutionId) return agentProgressPercents.get(selectedAgentExecutionId);
+    if (agentProgressStatuses.size === 1) {
+      return agentProgressPercents.get(agentProgressStatuses.keys().next().value as string);
+    }
+    return undefined;
+  }, [scoringInProgress, selectedAgentExecutionId, agentProgressStatuses, agentProgressPercents]);
+
   if (items.length === 0 && (!streamingEvents || streamingEvents.size === 0)) {
     // Session is active but no timeline items have arrived yet — show the
     // same pulsing ring spinner used by SessionDetailPage so there is no
@@ -559,6 +585,21 @@ export default function ConversationTimeline({
                   </Button>
                 </Tooltip>
               )}
+              <Tooltip title={highlightCount > 0 ? 'Show only key findings, errors and warnings' : 'No highlights yet'}>
+                <span>
+                  <Button
+                    variant={highlightsOnly ? 'contained' : 'outlined'}
+                    size="small"
+                    disableElevation
+                    disabled={highlightCount === 0 && !highlightsOnly}
+                    startIcon={highlightsOnly ? <Star /> : <StarBorder />}
+                    onClick={() => setHighlightsOnly((v) => !v)}
+                    sx={{ textTransform: 'none', whiteSpace: 'nowrap' }}
+                  >
+                    {`Highlights${highlightCount > 0 ? ` (${highlightCount})` : ''}`}
+                  </Button>
+                </span>
+              </Tooltip>
               <Button
                 variant="outlined"
                 size="small"
@@ -640,6 +681,7 @@ export default function ConversationTimeline({
                   expandAllToolCalls={expandAllToolCalls}
                   isItemCollapsible={isItemCollapsible}
                   agentProgressStatuses={agentProgressStatuses}
+                  agentProgressPercents={agentProgressPercents}
                   executionStatuses={executionStatuses}
                   subAgentStreamingEvents={subAgentStreamingEvents}
                   subAgentExecutionStatuses={subAgentExecutionStatuses}
@@ -662,7 +704,7 @@ export default function ConversationTimeline({
         ))}
 
         {/* Processing indicator */}
-        {showProcessingIndicator && <ProcessingIndicator message={displayStatus} />}
+        {showProcessingIndicator && <ProcessingIndicator message={displayStatus} percent={displayPercent} />}
       </Box>
       </Collapse>
     </Card>
@@ -17,6 +17,8 @@ import { getRatingConfig } from '../../constants/ratingConfig';
 interface FinalAnalysisCardProps {
   analysis: string | null;
   summary: string | null;
+  /** Technical summary with next steps (chains with technical_summary enabled) */
+  technicalSummary?: string | null;
   sessionStatus: string;
   errorMessage: string | null;
   /** Increment to collapse the card externally (e.g. Jump to Chat) */
@@ -44,6 +46,8 @@ function generateFakeAnalysis(status: string, errorMessage?: string | null): str
   switch (status) {
     case SESSION_STATUS.CANCELLED:
       return '# Session Cancelled\n\n**Status:** Session was terminated before the AI could complete its analysis.\n\nThis analysis session was cancelled before completion. No final analysis is available.\n\nIf you need to investigate this alert, please submit a new analysis session.';
+    case SESSION_STATUS.AUTO_CANCELLED:
+      return `# Session Auto-cancelled\n\nThis session was cancelled automatically while still queued, before any investigation started.\n\n**Reason:** ${errorMessage || '_No reason recorded_'}\n\nIf the alert is still relevant, please submit a new analysis session.`;
     case SESSION_STATUS.FAILED:
       return `# Session Failed\n\nThis analysis session failed before completion.\n\n**Error Details:**\n${errorMessage ? `\`\`\`\n${errorMessage}\n\`\`\`` : '_No error details available_'}\n\nPlease review the session logs or submit a new analysis session.`;
     case SESSION_STATUS.COMPLETED:
@@ -59,7 +63,7 @@ function generateFakeAnalysis(status: string, errorMessage?: string | null): str
  * Supports counter-based expand/collapse from parent.
  */
 const FinalAnalysisCard = forwardRef<HTMLDivElement, FinalAnalysisCardProps>(
-  ({ analysis, summary, sessionStatus, errorMessage, collapseCounter = 0, expandCounter = 0, sessionId, latestScore, scoringStatus, qualityRating, reviewStatus, onReviewClick }, ref) => {
+  ({ analysis, summary, technicalSummary, sessionStatus, errorMessage, collapseCounter = 0, expandCounter = 0, sessionId, latestScore, scoringStatus, qualityRating, reviewStatus, onReviewClick }, ref) => {
     const navigate = useNavigate();
     const [analysisExpanded, setAnalysisExpanded] = useState(false);
     const [prevAnalysis, setPrevAnalysis] = useState<string | null>(null);
@@ -104,6 +108,7 @@ const FinalAnalysisCard = forwardRef<HTMLDivElement, FinalAnalysisCardProps>(
     const getCombinedDocument = () => {
       let doc = '';
       if (summary) doc += `# Executive Summary\n\n${summary}\n\n`;
+      if (technicalSummary) doc += `# Technical Summary\n\n${technicalSummary}\n\n`;
       if (displayAnalysis) {
         if (summary) doc += '# Full Detailed Analysis\n\n';
         doc += displayAnalysis;
@@ -240,8 +245,22 @@ const FinalAnalysisCard = forwardRef<HTMLDivElement, FinalAnalysisCardProps>(
             </Box>
           )}
 
-          {/* Collapsible full analysis */}
+          {/* Collapsible technical summary and full analysis */}
           <Collapse in={analysisExpanded} timeout={400}>
+            {technicalSummary && (
+              <Paper variant="outlined" sx={{ p: 3, mt: 2 }} data-technical-summary>
+                <Box sx={{ display: 'flex', alignItems: 'center', justifyContent: 'space-between', mb: 1 }}>
+                  <Typography variant="subtitle2" sx={{ fontWeight: 700, textTransform: 'uppercase', letterSpacing: 0.5, fontSize: '0.8rem' }}>
+                    Technical Summary
+                  </Typography>
+                  <CopyButton text={technicalSummary} variant="icon" size="small" tooltip="Copy technical summary" />
+                </Box>
+                <ReactMarkdown remarkPlugins={remarkPlugins} urlTransform={defaultUrlTransform} components={finalAnswerMarkdownComponents}>
+                  {technicalSummary}
+                </ReactMarkdown>
+              </Paper>
+            )}
+
             {summary && displayAnalysis && (
               <Box sx={{ display: 'flex', alignItems: 'center', gap: 2, mt: 3, mb: 2, color: 'text.secondary' }}>
                 <Box sx={{ flex: 1, height: '1px', bgcolor: 'divider' }} />
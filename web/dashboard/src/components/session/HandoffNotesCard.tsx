@@ -0,0 +1,275 @@
+import { useState } from 'react';
+import axios from 'axios';
+import {
+  Paper,
+  Typography,
+  Box,
+  Chip,
+  IconButton,
+  Collapse,
+  Alert,
+  Button,
+  TextField,
+  Tooltip,
+  CircularProgress,
+} from '@mui/material';
+import { EditNote, Edit, History } from '@mui/icons-material';
+import { alpha } from '@mui/material/styles';
+import ReactMarkdown, { defaultUrlTransform } from 'react-markdown';
+
+import {
+  getHandoffNotes,
+  getHandoffNoteRevisions,
+  updateHandoffNotes,
+  handleAPIError,
+} from '../../services/api.ts';
+import { remarkPlugins, finalAnswerMarkdownComponents } from '../../utils/markdownComponents';
+import { timeAgo, formatTimestamp } from '../../utils/format.ts';
+import type { HandoffNotes, HandoffNoteRevision } from '../../types/api.ts';
+
+interface HandoffNotesCardProps {
+  sessionId: string;
+  notes: string | null | undefined;
+  revision: number | undefined;
+  updatedBy: string | null | undefined;
+  updatedAt: string | null | undefined;
+}
+
+const CONFLICT_MESSAGE =
+  'Someone else saved these notes while you were editing. Your text is kept below — reload to see their version, then re-apply your changes.';
+
+/**
+ * Operator-written handoff notes (markdown) with revision history, so
+ * on-call handoff context lives with the investigation.
+ */
+export default function HandoffNotesCard({ sessionId, notes, revision, updatedBy, updatedAt }: HandoffNotesCardProps) {
+  const [current, setCurrent] = useState<HandoffNotes>({
+    session_id: sessionId,
+    content: notes ?? '',
+    revision: revision ?? 0,
+    updated_by: updatedBy ?? null,
+    updated_at: updatedAt ?? null,
+  });
+  const [editing, setEditing] = useState(false);
+  const [draft, setDraft] = useState('');
+  const [saving, setSaving] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+  const [conflict, setConflict] = useState(false);
+  const [historyOpen, setHistoryOpen] = useState(false);
+  const [revisions, setRevisions] = useState<HandoffNoteRevision[] | null>(null);
+  const [historyError, setHistoryError] = useState<string | null>(null);
+
+  // Adopt newer revisions from the session detail (e.g. after a refetch).
+  const [prevRevision, setPrevRevision] = useState(revision ?? 0);
+  if ((revision ?? 0) !== prevRevision) {
+    setPrevRevision(revision ?? 0);
+    if ((revision ?? 0) > current.revision) {
+      setCurrent({
+        session_id: sessionId,
+        content: notes ?? '',
+        revision: revision ?? 0,
+        updated_by: updatedBy ?? null,
+        updated_at: updatedAt ?? null,
+      });
+      setRevisions(null);
+    }
+  }
+
+  const loadRevisions = async () => {
+    setHistoryError(null);
+    try {
+      const data = await getHandoffNoteRevisions(sessionId);
+      setRevisions(data.revisions);
+    } catch (err) {
+      setHistoryError(handleAPIError(err));
+    }
+  };
+
+  const toggleHistory = () => {
+    const open = !historyOpen;
+    setHistoryOpen(open);
+    if (open && revisions === null) void loadRevisions();
+  };
+
+  const startEditing = () => {
+    setDraft(current.content);
+    setError(null);
+    setConflict(false);
+    setEditing(true);
+  };
+
+  const save = async () => {
+    setSaving(true);
+    setError(null);
+    try {
+      const saved = await updateHandoffNotes(sessionId, draft, current.revision);
+      setCurrent(saved);
+      setEditing(false);
+      setConflict(false);
+      setRevisions(null);
+      if (historyOpen) void loadRevisions();
+    } catch (err) {
+      if (axios.isAxiosError(err) && err.response?.status === 409) {
+        setConflict(true);
+        setError(CONFLICT_MESSAGE);
+      } else {
+        setError(handleAPIError(err));
+      }
+    } finally {
+      setSaving(false);
+    }
+  };
+
+  const reload = async () => {
+    try {
+      setCurrent(await getHandoffNotes(sessionId));
+      setConflict(false);
+      setError(null);
+      setRevisions(null);
+      if (historyOpen) void loadRevisions();
+    } catch (err) {
+      setError(handleAPIError(err));
+    }
+  };
+
+  return (
+    <Paper sx={{ p: 2.5 }}>
+      <Box sx={{ display: 'flex', alignItems: 'center', justifyContent: 'space-between', mb: 1.5 }}>
+        <Box sx={{ display: 'flex', alignItems: 'center', gap: 1.5 }}>
+          <Box
+            sx={{
+              width: 40,
+              height: 40,
+              borderRadius: '50%',
+              bgcolor: (theme) => alpha(theme.palette.secondary.main, 0.15),
+              border: '2px solid',
+              borderColor: 'secondary.main',
+              display: 'flex',
+              alignItems: 'center',
+              justifyContent: 'center',
+              flexShrink: 0,
+            }}
+          >
+            <EditNote sx={{ fontSize: 24, color: 'secondary.main' }} />
+          </Box>
+          <Typography variant="h6">Handoff Notes</Typography>
+          {current.revision > 0 && (
+            <Chip
+              label={`rev ${current.revision}`}
+              size="small"
+              variant="outlined"
+              sx={{ ml: 0.5, height: 20, fontSize: '0.75rem' }}
+            />
+          )}
+        </Box>
+        <Box sx={{ display: 'flex', gap: 0.5 }}>
+          {current.revision > 0 && (
+            <Tooltip title={historyOpen ? 'Hide history' : 'Show history'}>
+              <IconButton size="small" onClick={toggleHistory} aria-label="Toggle handoff notes history">
+                <History fontSize="small" />
+              </IconButton>
+            </Tooltip>
+          )}
+          {!editing && (
+            <Tooltip title="Edit notes">
+              <IconButton size="small" onClick={startEditing} aria-label="Edit handoff notes">
+                <Edit fontSize="small" />
+              </IconButton>
+            </Tooltip>
+          )}
+        </Box>
+      </Box>
+
+      {editing ? (
+        <Box sx={{ display: 'flex', flexDirection: 'column', gap: 1 }}>
+          {error && (
+            <Alert
+              severity={conflict ? 'warning' : 'error'}
+              action={conflict ? <Button color="inherit" size="small" onClick={() => void reload()}>Reload</Button> : undefined}
+            >
+              {error}
+            </Alert>
+          )}
+          <TextField
+            multiline
+            minRows={6}
+            fullWidth
+            value={draft}
+            onChange={(e) => setDraft(e.target.value)}
+            placeholder="What was done, what is still open, who to contact…"
+            helperText="Markdown supported. Every save is kept in the history."
+            disabled={saving}
+          />
+          <Box sx={{ display: 'flex', justifyContent: 'flex-end', gap: 1 }}>
+            <Button size="small" onClick={() => setEditing(false)} disabled={saving}>
+              Cancel
+            </Button>
+            <Button
+              size="small"
+              variant="contained"
+              onClick={() => void save()}
+              disabled={saving || conflict}
+              startIcon={saving ? <CircularProgress size={14} color="inherit" /> : undefined}
+            >
+              Save
+            </Button>
+          </Box>
+        </Box>
+      ) : current.content ? (
+        <Box>
+          <ReactMarkdown
+            remarkPlugins={remarkPlugins}
+            urlTransform={defaultUrlTransform}
+            components={finalAnswerMarkdownComponents}
+          >
+            {current.content}
+          </ReactMarkdown>
+          <Attribution author={current.updated_by} at={current.updated_at} label="Last edited" />
+        </Box>
+      ) : (
+        <Typography variant="body2" color="text.secondary">
+          No handoff notes yet. Add context for whoever picks this up next.
+        </Typography>
+      )}
+
+      <Collapse in={historyOpen} timeout={300} unmountOnExit>
+        <Box sx={{ mt: 2, pt: 1.5, borderTop: 1, borderColor: 'divider', display: 'flex', flexDirection: 'column', gap: 1.5 }}>
+          {historyError && <Alert severity="warning">{historyError}</Alert>}
+          {!historyError && revisions === null && <CircularProgress size={20} sx={{ alignSelf: 'center' }} />}
+          {revisions?.map((rev) => (
+            <Box key={rev.id}>
+              <Box sx={{ display: 'flex', alignItems: 'center', gap: 1 }}>
+                <Typography variant="body2" sx={{ fontWeight: 600 }}>
+                  Revision {rev.revision}
+                </Typography>
+                <Attribution author={rev.author} at={rev.created_at} />
+              </Box>
+              <Typography
+                variant="body2"
+                color={rev.content ? 'text.secondary' : 'text.disabled'}
+                sx={{ whiteSpace: 'pre-wrap', fontStyle: rev.content ? 'normal' : 'italic', mt: 0.5 }}
+              >
+                {rev.content || 'Notes cleared'}
+              </Typography>
+            </Box>
+          ))}
+        </Box>
+      </Collapse>
+    </Paper>
+  );
+}
+
+function Attribution({ author, at, label }: { author: string | null; at: string | null; label?: string }) {
+  if (!author && !at) return null;
+  const text = [label, author && `by ${author}`].filter(Boolean).join(' ');
+  return (
+    <Typography variant="caption" color="text.secondary" component="div" sx={{ mt: label ? 1 : 0 }}>
+      {text}
+      {at && (
+        <Tooltip title={formatTimestamp(at, 'absolute')} arrow>
+          <span>{text ? ' · ' : ''}{timeAgo(at)}</span>
+        </Tooltip>
+      )}
+    </Typography>
+  );
+}
@@ -98,7 +98,7 @@ function isSimpleValue(value: unknown): boolean {
   return false;
 }
 
-const TERMINAL_STATUSES = new Set(['completed', 'failed', 'cancelled', 'timed_out']);
+const TERMINAL_STATUSES = new Set(['completed', 'failed', 'cancelled', 'timed_out', 'auto_cancelled']);
 
 /**
  * Render a single alert field value based on its type.
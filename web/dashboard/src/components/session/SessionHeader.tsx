@@ -1,5 +1,5 @@
 import { useState, useCallback } from 'react';
-import { useNavigate } from 'react-router-dom';
+import { useNavigate, Link as RouterLink } from 'react-router-dom';
 import {
   Paper,
   Box,
@@ -22,6 +22,8 @@ import {
   GradingOutlined,
   ExpandMore,
   SubjectRounded,
+  PictureAsPdfOutlined,
+  ContentCopyOutlined,
 } from '@mui/icons-material';
 import CopyButton from '../shared/CopyButton';
 import { AlertDataContent } from './OriginalAlertCard';
@@ -29,7 +31,7 @@ import { StatusBadge } from '../common/StatusBadge';
 import ProgressIndicator from '../common/ProgressIndicator';
 import { formatTimestamp, formatTokensCompact } from '../../utils/format';
 import EstimatedCostDisplay from '../shared/EstimatedCostDisplay';
-import { cancelSession, triggerScoring, handleAPIError } from '../../services/api';
+import { cancelSession, duplicateSession, triggerScoring, handleAPIError, sessionReportUrl } from '../../services/api';
 import {
   SESSION_STATUS,
   SCORING_STATUS,
@@ -39,7 +41,7 @@ import {
   ACTIVE_STATUSES,
 } from '../../constants/sessionStatus';
 import type { SessionDetailResponse } from '../../types/session';
-import { ROUTES } from '../../constants/routes';
+import { ROUTES, sessionDetailPath } from '../../constants/routes';
 
 // --- Breathing glow for active sessions ---
 const breathingGlowSx = {
@@ -145,6 +147,23 @@ export default function SessionHeader({
     });
   }, [navigate, session]);
 
+  // Duplicate for what-if comparison (same alert, chain and providers)
+  const [isDuplicating, setIsDuplicating] = useState(false);
+  const [duplicateError, setDuplicateError] = useState<string | null>(null);
+
+  const handleDuplicate = useCallback(async () => {
+    setIsDuplicating(true);
+    setDuplicateError(null);
+    try {
+      const resp = await duplicateSession(session.id);
+      navigate(sessionDetailPath(resp.session_id));
+    } catch (error) {
+      setDuplicateError(handleAPIError(error));
+    } finally {
+      setIsDuplicating(false);
+    }
+  }, [navigate, session.id]);
+
   // Scoring
   const [scoringTriggered, setScoringTriggered] = useState(false);
   const [scoringError, setScoringError] = useState<string | null>(null);
@@ -264,6 +283,36 @@ export default function SessionHeader({
               </Tooltip>
             )}
 
+            {isTerminal && (
+              <Tooltip title={duplicateError || 'Duplicate session (re-run the same alert for comparison)'}>
+                <span>
+                  <IconButton
+                    size="small"
+                    onClick={handleDuplicate}
+                    disabled={isDuplicating}
+                    sx={{ color: duplicateError ? 'error.main' : 'text.secondary', '&:hover': { bgcolor: 'action.hover' } }}
+                  >
+                    {isDuplicating
+                      ? <CircularProgress size={18} color="inherit" />
+                      : <ContentCopyOutlined sx={{ fontSize: '1.2rem' }} />}
+                  </IconButton>
+                </span>
+              </Tooltip>
+            )}
+
+            {isTerminal && (
+              <Tooltip title="Download PDF report">
+                <IconButton
+                  size="small"
+                  component="a"
+                  href={sessionReportUrl(session.id, 'pdf')}
+                  sx={{ color: 'text.secondary', '&:hover': { bgcolor: 'action.hover' } }}
+                >
+                  <PictureAsPdfOutlined sx={{ fontSize: '1.2rem' }} />
+                </IconButton>
+              </Tooltip>
+            )}
+
             {session.status === SESSION_STATUS.COMPLETED && session.latest_score == null &&
               (!session.scoring_status || session.scoring_status === 'not_scored') && !showScoringInProgress && (
               <Tooltip title={scoringError || 'Score session'}>
@@ -303,6 +352,60 @@ export default function SessionHeader({
               </Tooltip>
             </>
           )}
+          {session.duplicated_from && (
+            <>
+              <Typography variant="body2" color="text.secondary">·</Typography>
+              <Typography variant="body2" color="text.secondary">
+                Duplicate of{' '}
+                <RouterLink
+                  to={sessionDetailPath(session.duplicated_from)}
+                  style={{ color: 'inherit', textDecoration: 'underline', fontFamily: 'monospace', fontSize: '0.85em' }}
+                >
+                  {session.duplicated_from.substring(0, 8)}
+                </RouterLink>
+                {session.llm_provider && <> on <strong>{session.llm_provider}</strong></>}
+              </Typography>
+            </>
+          )}
+          {session.fallback_chain && (
+            <>
+              <Typography variant="body2" color="text.secondary">·</Typography>
+              <Tooltip title="No chain handles this alert type; the session ran the fallback chain of its namespace">
+                <Typography variant="body2" color="warning.main">
+                  <strong>Fallback chain</strong>
+                </Typography>
+              </Tooltip>
+            </>
+          )}
+          {session.degraded_reason && (
+            <>
+              <Typography variant="body2" color="text.secondary">·</Typography>
+              <Tooltip title={session.degraded_reason}>
+                <Typography variant="body2" color="warning.main">
+                  <strong>Degraded</strong> (triage only)
+                </Typography>
+              </Tooltip>
+            </>
+          )}
+          {session.on_call && (
+            <>
+              <Typography variant="body2" color="text.secondary">·</Typography>
+              <Tooltip
+                title={session.on_call.error
+                  ? `On-call lookup failed: ${session.on_call.error}`
+                  : [
+                      session.on_call.team,
+                      session.on_call.email,
+                      session.on_call.until && `Shift ends ${formatTimestamp(session.on_call.until, 'absolute')}`,
+                    ].filter(Boolean).join(' · ') || session.on_call.service}
+              >
+                <Typography variant="body2" color="text.secondary">
+                  On-call ({session.on_call.service}):{' '}
+                  <strong>{session.on_call.name || (session.on_call.error ? 'unknown' : 'nobody')}</strong>
+                </Typography>
+              </Tooltip>
+            </>
+          )}
           {session.runbook_url && (() => {
             let isSafeUrl = false;
             try {
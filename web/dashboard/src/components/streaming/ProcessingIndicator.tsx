@@ -1,19 +1,23 @@
-import { Box, Typography } from '@mui/material';
+import { Box, LinearProgress, Typography } from '@mui/material';
 import { useColorScheme } from '@mui/material/styles';
 
 interface ProcessingIndicatorProps {
   message?: string;
   centered?: boolean;
+  /** Estimated completion 0-100 from execution.progress; omitted when unknown. */
+  percent?: number;
 }
 
 /**
  * ProcessingIndicator Component
  * Animated bouncing dots with shimmer text effect.
  * Shown at the bottom of the timeline when a session is being processed.
+ * When a percent estimate is known, a determinate progress bar follows the text.
  */
 export default function ProcessingIndicator({
   message = 'Processing...',
   centered = false,
+  percent,
 }: ProcessingIndicatorProps) {
   const { mode, systemMode } = useColorScheme();
   const isDark = mode === 'dark' || (mode === 'system' && systemMode === 'dark');
@@ -83,6 +87,18 @@ export default function ProcessingIndicator({
       >
         {message}
       </Typography>
+      {percent !== undefined && (
+        <Box sx={{ display: 'flex', alignItems: 'center', gap: 1, width: 160 }}>
+          <LinearProgress
+            variant="determinate"
+            value={percent}
+            sx={{ flex: 1, height: 4, borderRadius: 2 }}
+          />
+          <Typography variant="caption" color="text.secondary" sx={{ minWidth: 28 }}>
+            {percent}%
+          </Typography>
+        </Box>
+      )}
     </Box>
   );
 }
@@ -0,0 +1,215 @@
+/**
+ * MCP Tool Usage View — per-server tool catalog with call statistics over a
+ * trailing window, so operators can spot unused tools and dead servers.
+ *
+ * Uses GET /api/v1/catalog/mcp-tools.
+ */
+
+import { useState, useEffect, useCallback } from 'react';
+import Alert from '@mui/material/Alert';
+import Box from '@mui/material/Box';
+import Chip from '@mui/material/Chip';
+import CircularProgress from '@mui/material/CircularProgress';
+import IconButton from '@mui/material/IconButton';
+import MenuItem from '@mui/material/MenuItem';
+import Paper from '@mui/material/Paper';
+import Select from '@mui/material/Select';
+import Table from '@mui/material/Table';
+import TableBody from '@mui/material/TableBody';
+import TableCell from '@mui/material/TableCell';
+import TableHead from '@mui/material/TableHead';
+import TableRow from '@mui/material/TableRow';
+import Tooltip from '@mui/material/Tooltip';
+import Typography from '@mui/material/Typography';
+import { Refresh as RefreshIcon } from '@mui/icons-material';
+import { getCatalogMCPTools } from '../../services/api.ts';
+import { formatDurationMs, timeAgo } from '../../utils/format.ts';
+import type { CatalogMCPToolServer } from '../../types/api.ts';
+
+const WINDOW_OPTIONS = [7, 30, 90] as const;
+
+function formatBytes(bytes: number | null): string {
+  if (bytes == null) return '—';
+  if (bytes < 1024) return `${Math.round(bytes)} B`;
+  if (bytes < 1024 * 1024) return `${(bytes / 1024).toFixed(1)} KB`;
+  return `${(bytes / (1024 * 1024)).toFixed(1)} MB`;
+}
+
+function formatLatency(ms: number | null): string {
+  if (ms == null) return '—';
+  // formatDurationMs collapses sub-second values; tool calls are usually that fast.
+  if (ms < 1000) return `${Math.round(ms)} ms`;
+  return formatDurationMs(ms);
+}
+
+function formatRate(rate: number | null): string {
+  return rate == null ? '—' : `${(rate * 100).toFixed(1)}%`;
+}
+
+interface MCPToolUsageViewProps {
+  /** When false, skip fetching (e.g. while another System Status tab is active). */
+  active?: boolean;
+}
+
+export function MCPToolUsageView({ active = true }: MCPToolUsageViewProps) {
+  const [days, setDays] = useState<number>(30);
+  const [servers, setServers] = useState<CatalogMCPToolServer[]>([]);
+  const [loading, setLoading] = useState(true);
+  const [error, setError] = useState<string | null>(null);
+
+  const fetchTools = useCallback(async () => {
+    setLoading(true);
+    try {
+      const data = await getCatalogMCPTools(days);
+      setServers(data.servers);
+      setError(null);
+    } catch (err) {
+      setError('Failed to load MCP tool usage');
+      console.error('Failed to fetch MCP tool usage:', err);
+    } finally {
+      setLoading(false);
+    }
+  }, [days]);
+
+  useEffect(() => {
+    if (!active) {
+      return;
+    }
+    (async () => {
+      await fetchTools();
+    })();
+  }, [fetchTools, active]);
+
+  if (loading && servers.length === 0) {
+    return (
+      <Box sx={{ display: 'flex', justifyContent: 'center', py: 6 }}>
+        <CircularProgress />
+      </Box>
+    );
+  }
+
+  if (error && servers.length === 0) {
+    return (
+      <Alert
+        severity="error"
+        action={
+          <IconButton size="small" onClick={fetchTools} aria-label="Retry">
+            <RefreshIcon />
+          </IconButton>
+        }
+      >
+        {error}
+      </Alert>
+    );
+  }
+
+  return (
+    <Box>
+      {/* Header row */}
+      <Box sx={{ display: 'flex', alignItems: 'center', justifyContent: 'space-between', mb: 2 }}>
+        <Typography variant="h6">MCP Tool Usage</Typography>
+        <Box sx={{ display: 'flex', alignItems: 'center', gap: 1 }}>
+          <Select
+            size="small"
+            value={days}
+            onChange={(e) => setDays(Number(e.target.value))}
+            aria-label="Usage window"
+          >
+            {WINDOW_OPTIONS.map((d) => (
+              <MenuItem key={d} value={d}>
+                Last {d} days
+              </MenuItem>
+            ))}
+          </Select>
+          <Tooltip title="Refresh">
+            <IconButton onClick={fetchTools} size="small">
+              <RefreshIcon />
+            </IconButton>
+          </Tooltip>
+        </Box>
+      </Box>
+
+      {servers.length === 0 && (
+        <Paper sx={{ p: 4, textAlign: 'center' }}>
+          <Typography color="text.secondary">No MCP servers configured</Typography>
+        </Paper>
+      )}
+
+      {servers.map((server) => {
+        const unusedCount = server.tools.filter((t) => t.usage.calls === 0).length;
+        return (
+          <Paper key={server.id} sx={{ mb: 2, overflow: 'hidden' }}>
+            {/* Server header */}
+            <Box sx={{ display: 'flex', alignItems: 'center', gap: 1.5, px: 2, py: 1.5 }}>
+              {server.healthy != null && (
+                <Chip
+                  label={server.healthy ? 'Healthy' : 'Unhealthy'}
+                  color={server.healthy ? 'success' : 'error'}
+                  size="small"
+                />
+              )}
+              <Typography variant="subtitle1" sx={{ fontWeight: 600 }}>
+                {server.id}
+              </Typography>
+              <Chip label={`${server.total_calls} calls`} size="small" variant="outlined" />
+              {server.total_calls === 0 && (
+                <Chip label={`Unused in last ${days} days`} size="small" color="warning" variant="outlined" />
+              )}
+              {server.total_calls > 0 && unusedCount > 0 && (
+                <Chip label={`${unusedCount} unused tools`} size="small" variant="outlined" />
+              )}
+              {!server.tools_discovered && (
+                <Typography variant="body2" color="text.secondary">
+                  Tool list not discovered
+                </Typography>
+              )}
+            </Box>
+
+            {server.tools.length > 0 && (
+              <Box sx={{ px: 2, pb: 2 }}>
+                <Table size="small">
+                  <TableHead>
+                    <TableRow>
+                      <TableCell>Tool</TableCell>
+                      <TableCell align="right">Calls</TableCell>
+                      <TableCell align="right">Sessions</TableCell>
+                      <TableCell align="right">Error rate</TableCell>
+                      <TableCell align="right">Avg latency</TableCell>
+                      <TableCell align="right">Avg result</TableCell>
+                      <TableCell>Last used</TableCell>
+                    </TableRow>
+                  </TableHead>
+                  <TableBody>
+                    {server.tools.map((tool) => (
+                      <TableRow
+                        key={tool.name}
+                        sx={{ opacity: tool.usage.calls === 0 ? 0.6 : 1 }}
+                      >
+                        <TableCell sx={{ fontFamily: 'monospace', fontWeight: 500, whiteSpace: 'nowrap' }}>
+                          <Tooltip title={tool.description || ''} placement="top-start">
+                            <span>{tool.name}</span>
+                          </Tooltip>
+                          {!tool.discovered && (
+                            <Chip label="no longer advertised" size="small" sx={{ ml: 1 }} />
+                          )}
+                        </TableCell>
+                        <TableCell align="right">{tool.usage.calls}</TableCell>
+                        <TableCell align="right">{tool.usage.sessions}</TableCell>
+                        <TableCell align="right">{formatRate(tool.usage.error_rate)}</TableCell>
+                        <TableCell align="right">{formatLatency(tool.usage.avg_duration_ms)}</TableCell>
+                        <TableCell align="right">{formatBytes(tool.usage.avg_result_bytes)}</TableCell>
+                        <TableCell sx={{ color: 'text.secondary', whiteSpace: 'nowrap' }}>
+                          {tool.usage.last_used_at ? timeAgo(tool.usage.last_used_at) : 'Never'}
+                        </TableCell>
+                      </TableRow>
+                    ))}
+                  </TableBody>
+                </Table>
+              </Box>
+            )}
+          </Paper>
+        );
+      })}
+    </Box>
+  );
+}
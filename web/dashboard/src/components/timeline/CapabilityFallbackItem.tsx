@@ -0,0 +1,58 @@
+import { memo } from 'react';
+import { Box, Chip, Typography, alpha } from '@mui/material';
+import { Tune } from '@mui/icons-material';
+import { highlightSearchTermNodes } from '../../utils/search';
+import type { FlowItem } from '../../utils/timelineParser';
+
+interface CapabilityFallbackItemProps {
+  item: FlowItem;
+  searchTerm?: string;
+}
+
+/**
+ * CapabilityFallbackItem — banner for a fallback applied when the agent's
+ * requested features exceed the model's capabilities (another provider,
+ * no tools, or no stream timeouts).
+ */
+function CapabilityFallbackItem({ item, searchTerm }: CapabilityFallbackItemProps) {
+  const meta = item.metadata || {};
+  const provider = typeof meta.provider === 'string' ? meta.provider : '';
+  const toolsDisabled = meta.tools_disabled === true;
+
+  return (
+    <Box data-flow-item-id={item.id} sx={{ my: 1, display: 'flex', alignItems: 'stretch' }}>
+      <Box sx={(theme) => ({ width: 4, bgcolor: theme.palette.warning.main, borderRadius: 1, flexShrink: 0 })} />
+      <Box
+        sx={(theme) => ({
+          flex: 1,
+          minWidth: 0,
+          px: 1.5,
+          py: 0.75,
+          bgcolor: alpha(theme.palette.warning.main, 0.06),
+          borderTopRightRadius: 4,
+          borderBottomRightRadius: 4,
+        })}
+      >
+        <Box sx={{ display: 'flex', alignItems: 'center', gap: 1, flexWrap: 'wrap' }}>
+          <Tune sx={{ fontSize: 18, color: 'warning.main' }} />
+          <Typography variant="caption" sx={{ fontWeight: 800, color: 'warning.main', fontSize: '0.75rem', letterSpacing: 0.3, textTransform: 'uppercase' }}>
+            Capability Fallback
+          </Typography>
+          {provider && (
+            <Typography variant="caption" sx={{ fontFamily: 'monospace', fontSize: '0.75rem', color: 'text.primary', fontWeight: 600 }}>
+              {provider}
+            </Typography>
+          )}
+          {toolsDisabled && (
+            <Chip label="no tools" size="small" variant="outlined" color="warning" sx={{ height: 18, fontSize: '0.6rem' }} />
+          )}
+        </Box>
+        <Typography variant="body2" color="text.secondary" sx={{ mt: 0.5, fontSize: '0.8rem', whiteSpace: 'pre-wrap', wordBreak: 'break-word' }}>
+          {searchTerm ? highlightSearchTermNodes(item.content, searchTerm) : item.content}
+        </Typography>
+      </Box>
+    </Box>
+  );
+}
+
+export default memo(CapabilityFallbackItem);
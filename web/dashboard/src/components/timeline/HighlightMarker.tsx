@@ -0,0 +1,48 @@
+import type { ReactNode } from 'react';
+import { Box, Tooltip, alpha } from '@mui/material';
+import { Star, ErrorOutline, WarningAmber } from '@mui/icons-material';
+import { HIGHLIGHT } from '../../utils/timelineParser';
+
+interface HighlightMarkerProps {
+  highlight: string;
+  children: ReactNode;
+}
+
+const HIGHLIGHT_STYLE: Record<string, { label: string; color: 'primary' | 'error' | 'warning'; Icon: typeof Star }> = {
+  [HIGHLIGHT.KEY_FINDING]: { label: 'Key finding', color: 'primary', Icon: Star },
+  [HIGHLIGHT.ERROR]: { label: 'Error', color: 'error', Icon: ErrorOutline },
+  [HIGHLIGHT.WARNING]: { label: 'Warning', color: 'warning', Icon: WarningAmber },
+};
+
+/**
+ * HighlightMarker — gutter marker for highlighted timeline items (key
+ * findings flagged by the agent, failed tool calls).
+ */
+function HighlightMarker({ highlight, children }: HighlightMarkerProps) {
+  const style = HIGHLIGHT_STYLE[highlight];
+  if (!style) return <>{children}</>;
+  const { label, color, Icon } = style;
+
+  return (
+    <Box sx={{ display: 'flex', alignItems: 'stretch', gap: 1 }}>
+      <Tooltip title={label}>
+        <Box
+          sx={(theme) => ({
+            width: 20,
+            flexShrink: 0,
+            display: 'flex',
+            flexDirection: 'column',
+            alignItems: 'center',
+            pt: 1.5,
+            borderLeft: `2px solid ${alpha(theme.palette[color].main, 0.5)}`,
+          })}
+        >
+          <Icon sx={{ fontSize: 16, color: `${color}.main` }} />
+        </Box>
+      </Tooltip>
+      <Box sx={{ flex: 1, minWidth: 0 }}>{children}</Box>
+    </Box>
+  );
+}
+
+export default HighlightMarker;
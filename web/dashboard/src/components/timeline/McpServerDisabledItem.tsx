@@ -0,0 +1,60 @@
+import { memo } from 'react';
+import { Box, Typography, alpha } from '@mui/material';
+import { PowerOff } from '@mui/icons-material';
+import { highlightSearchTermNodes } from '../../utils/search';
+import type { FlowItem } from '../../utils/timelineParser';
+
+interface McpServerDisabledItemProps {
+  item: FlowItem;
+  searchTerm?: string;
+}
+
+/**
+ * McpServerDisabledItem — banner for an MCP server an operator disabled for
+ * the rest of the session (API or /disable-mcp chat command).
+ */
+function McpServerDisabledItem({ item, searchTerm }: McpServerDisabledItemProps) {
+  const meta = item.metadata || {};
+  const serverId = typeof meta.server_name === 'string' ? meta.server_name : '?';
+  const author = typeof meta.author === 'string' ? meta.author : '';
+  const reason = typeof meta.reason === 'string' ? meta.reason : '';
+
+  return (
+    <Box data-flow-item-id={item.id} sx={{ my: 1, display: 'flex', alignItems: 'stretch' }}>
+      <Box sx={(theme) => ({ width: 4, bgcolor: theme.palette.error.main, borderRadius: 1, flexShrink: 0 })} />
+      <Box
+        sx={(theme) => ({
+          flex: 1,
+          minWidth: 0,
+          px: 1.5,
+          py: 0.75,
+          bgcolor: alpha(theme.palette.error.main, 0.06),
+          borderTopRightRadius: 4,
+          borderBottomRightRadius: 4,
+        })}
+      >
+        <Box sx={{ display: 'flex', alignItems: 'center', gap: 1, flexWrap: 'wrap' }}>
+          <PowerOff sx={{ fontSize: 18, color: 'error.main' }} />
+          <Typography variant="caption" sx={{ fontWeight: 800, color: 'error.main', fontSize: '0.75rem', letterSpacing: 0.3, textTransform: 'uppercase' }}>
+            MCP Server Disabled
+          </Typography>
+          <Typography variant="caption" sx={{ fontFamily: 'monospace', fontSize: '0.75rem', color: 'text.primary', fontWeight: 600 }}>
+            {searchTerm ? highlightSearchTermNodes(serverId, searchTerm) : serverId}
+          </Typography>
+          {author && (
+            <Typography variant="caption" color="text.secondary" sx={{ fontSize: '0.7rem' }}>
+              by {author}
+            </Typography>
+          )}
+        </Box>
+        {reason && (
+          <Typography variant="body2" color="text.secondary" sx={{ mt: 0.5, fontSize: '0.8rem', whiteSpace: 'pre-wrap', wordBreak: 'break-word' }}>
+            {searchTerm ? highlightSearchTermNodes(reason, searchTerm) : reason}
+          </Typography>
+        )}
+      </Box>
+    </Box>
+  );
+}
+
+export default memo(McpServerDisabledItem);
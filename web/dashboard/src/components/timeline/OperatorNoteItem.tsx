@@ -0,0 +1,76 @@
+import { memo, useMemo } from 'react';
+import { Box, Typography, alpha } from '@mui/material';
+import { Campaign } from '@mui/icons-material';
+import ReactMarkdown from 'react-markdown';
+import { remarkPlugins, thoughtMarkdownComponents } from '../../utils/markdownComponents';
+import { rehypeSearchHighlight } from '../../utils/rehypeSearchHighlight';
+import type { FlowItem } from '../../utils/timelineParser';
+
+interface OperatorNoteItemProps {
+  item: FlowItem;
+  searchTerm?: string;
+}
+
+/**
+ * OperatorNoteItem - a note an operator pushed into the running session.
+ * The agent receives it at its next iteration or stage boundary.
+ */
+function OperatorNoteItem({ item, searchTerm }: OperatorNoteItemProps) {
+  const author = (item.metadata?.author as string) || 'Operator';
+  const rehypePlugins = useMemo(
+    () => { const p = rehypeSearchHighlight(searchTerm || ''); return p ? [p] : []; },
+    [searchTerm],
+  );
+
+  return (
+    <Box data-flow-item-id={item.id} sx={{ mb: 1.5, position: 'relative' }}>
+      <Box
+        sx={(theme) => ({
+          position: 'absolute', left: 0, top: 8,
+          width: 28, height: 28, borderRadius: '50%',
+          bgcolor: alpha(theme.palette.warning.main, 0.15),
+          border: '2px solid',
+          borderColor: 'warning.main',
+          display: 'flex',
+          alignItems: 'center', justifyContent: 'center', zIndex: 1,
+        })}
+      >
+        <Campaign sx={{ fontSize: 18, color: 'warning.main' }} />
+      </Box>
+
+      <Box
+        sx={(theme) => ({
+          ml: 4, my: 1, mr: 1, pt: 0.75, px: 1.5, pb: 1.5, borderRadius: 1.5,
+          bgcolor: alpha(theme.palette.warning.main, 0.06),
+          border: '1px solid',
+          borderColor: alpha(theme.palette.warning.main, 0.4),
+        })}
+      >
+        <Typography
+          variant="caption"
+          sx={{
+            fontWeight: 600, fontSize: '0.7rem', color: 'warning.main',
+            mb: 0.75, display: 'block', textTransform: 'uppercase', letterSpacing: 0.3,
+          }}
+        >
+          Operator note · {author}
+        </Typography>
+        <Box sx={{
+          fontSize: '0.95rem', lineHeight: 1.6, color: 'text.primary',
+          '& p:first-of-type': { mt: 0 },
+          '& p:last-of-type': { mb: 0 },
+        }}>
+          <ReactMarkdown
+            remarkPlugins={remarkPlugins}
+            rehypePlugins={rehypePlugins}
+            components={thoughtMarkdownComponents}
+          >
+            {item.content}
+          </ReactMarkdown>
+        </Box>
+      </Box>
+    </Box>
+  );
+}
+
+export default memo(OperatorNoteItem);
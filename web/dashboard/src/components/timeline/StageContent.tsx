@@ -1,5 +1,5 @@
 import React, { useState, useMemo, useRef } from 'react';
-import { Box, Typography, Chip, Alert, Collapse, Tooltip, alpha } from '@mui/material';
+import { Box, Typography, Chip, Alert, Collapse, LinearProgress, Tooltip, alpha } from '@mui/material';
 import {
   CheckCircle,
   Error as ErrorIcon,
@@ -39,6 +39,8 @@ interface StageContentProps {
   isItemCollapsible?: (item: FlowItem) => boolean;
   // Per-agent progress
   agentProgressStatuses?: Map<string, string>;
+  /** Per-execution percent-complete estimates (agents and sub-agents) */
+  agentProgressPercents?: Map<string, number>;
   /** Real-time execution statuses from execution.status WS events (executionId → {status, stageId}).
    *  Higher priority than REST ExecutionOverview for immediate UI updates.
    *  stageId is used to filter out executions belonging to other stages.
@@ -263,6 +265,7 @@ const StageContent: React.FC<StageContentProps> = ({
   expandAllToolCalls = false,
   isItemCollapsible,
   agentProgressStatuses = new Map(),
+  agentProgressPercents,
   executionStatuses,
   subAgentStreamingEvents,
   subAgentExecutionStatuses,
@@ -512,6 +515,7 @@ const StageContent: React.FC<StageContentProps> = ({
         streamingEvents={subAgentStreamingByExec.get(subExecId)}
         executionStatus={subAgentExecutionStatuses?.get(subExecId)}
         progressStatus={subAgentProgressStatuses?.get(subExecId)}
+        progressPercent={agentProgressPercents?.get(subExecId)}
         fallbackAgentName={fallback.name}
         shouldAutoCollapse={shouldAutoCollapse}
         onToggleItemExpansion={onToggleItemExpansion}
@@ -677,6 +681,7 @@ const StageContent: React.FC<StageContentProps> = ({
           const progressStatus = agentProgressStatuses.get(execution.executionId);
           const isTerminalProgress = !progressStatus
             || TERMINAL_EXECUTION_STATUSES.has(cardEffectiveStatus);
+          const progressPercent = agentProgressPercents?.get(execution.executionId);
           const tokenData = eo
             ? { input_tokens: eo.input_tokens, output_tokens: eo.output_tokens, total_tokens: eo.total_tokens }
             : deriveTokenData(execution.items);
@@ -783,6 +788,17 @@ const StageContent: React.FC<StageContentProps> = ({
                   return null;
                 })()}
               </Box>
+              {/* Row 3: percent-complete estimate while the agent runs */}
+              {progressPercent !== undefined && !TERMINAL_EXECUTION_STATUSES.has(cardEffectiveStatus) && (
+                <Tooltip title={`~${progressPercent}% complete`}>
+                  <LinearProgress
+                    variant="determinate"
+                    value={progressPercent}
+                    color="secondary"
+                    sx={{ mt: 0.75, height: 3, borderRadius: 1.5 }}
+                  />
+                </Tooltip>
+              )}
             </Box>
           );
         })}
@@ -22,7 +22,8 @@ function getStageTypeIcon(stageType: string | undefined) {
   switch (stageType) {
     case STAGE_TYPE.SYNTHESIS: return <MergeType sx={sx} />;
     case STAGE_TYPE.CHAT: return <SmsOutlined sx={sx} />;
-    case STAGE_TYPE.EXEC_SUMMARY: return <AutoAwesome sx={sx} />;
+    case STAGE_TYPE.EXEC_SUMMARY:
+    case STAGE_TYPE.TECHNICAL_SUMMARY: return <AutoAwesome sx={sx} />;
     case STAGE_TYPE.ACTION: return <BuildOutlined sx={sx} />;
     case STAGE_TYPE.SCORING: return <Box component="span" sx={{ fontSize: 14 }} aria-hidden>🧠</Box>;
     default: return <Search sx={sx} />;
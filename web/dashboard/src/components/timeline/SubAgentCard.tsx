@@ -36,6 +36,8 @@ interface SubAgentCardProps {
   streamingEvents?: Array<[string, StreamingItem]>;
   executionStatus?: { status: string; stageId: string; agentIndex: number };
   progressStatus?: string;
+  /** Percent-complete estimate from execution.progress (undefined when unknown) */
+  progressPercent?: number;
   fallbackAgentName?: string;
   shouldAutoCollapse?: (item: FlowItem) => boolean;
   onToggleItemExpansion?: (itemId: string) => void;
@@ -53,6 +55,7 @@ const SubAgentCard: React.FC<SubAgentCardProps> = ({
   streamingEvents = [],
   executionStatus,
   progressStatus,
+  progressPercent,
   fallbackAgentName,
   shouldAutoCollapse,
   onToggleItemExpansion,
@@ -226,7 +229,7 @@ const SubAgentCard: React.FC<SubAgentCardProps> = ({
             ))}
 
             {isRunning && (
-              <ProcessingIndicator message={progressStatus || 'Processing...'} />
+              <ProcessingIndicator message={progressStatus || 'Processing...'} percent={progressPercent} />
             )}
 
             {!hasContent && !isRunning && (
@@ -1,15 +1,19 @@
 import { memo, useCallback } from 'react';
-import { FLOW_ITEM, type FlowItem } from '../../utils/timelineParser';
+import { FLOW_ITEM, needsHighlightMarker, type FlowItem } from '../../utils/timelineParser';
 import ThinkingItem from './ThinkingItem';
 import ResponseItem from './ResponseItem';
 import ToolCallItem from './ToolCallItem';
 import ToolSummaryItem from './ToolSummaryItem';
 import UserQuestionItem from './UserQuestionItem';
+import OperatorNoteItem from './OperatorNoteItem';
+import CapabilityFallbackItem from './CapabilityFallbackItem';
+import McpServerDisabledItem from './McpServerDisabledItem';
 import NativeToolItem from './NativeToolItem';
 import ErrorItem from './ErrorItem';
 import ProviderFallbackItem from './ProviderFallbackItem';
 import SkillLoadedItem from './SkillLoadedItem';
 import MemoryInjectedItem from './MemoryInjectedItem';
+import HighlightMarker from './HighlightMarker';
 
 interface TimelineItemProps {
   item: FlowItem;
@@ -24,7 +28,8 @@ interface TimelineItemProps {
 
 /**
  * TimelineItem - router component that dispatches to the appropriate renderer
- * based on FlowItem.type.
+ * based on FlowItem.type. Highlighted items that don't stand out on their own
+ * (e.g. a tool call flagged as a key finding) get a HighlightMarker.
  */
 function TimelineItem({
   item,
@@ -45,87 +50,103 @@ function TimelineItem({
     return null;
   }
 
-  switch (item.type) {
-    case FLOW_ITEM.THINKING:
-      return (
-        <ThinkingItem
-          item={item}
-          isAutoCollapsed={isAutoCollapsed}
-          onToggleAutoCollapse={handleToggle}
-          expandAll={expandAll}
-          isCollapsible={isCollapsible}
-          searchTerm={searchTerm}
-        />
-      );
-
-    case FLOW_ITEM.RESPONSE:
-      return (
-        <ResponseItem
-          item={item}
-          isAutoCollapsed={isAutoCollapsed}
-          onToggleAutoCollapse={handleToggle}
-          expandAll={expandAll}
-          isCollapsible={isCollapsible}
-          searchTerm={searchTerm}
-        />
-      );
-
-    case FLOW_ITEM.FINAL_ANALYSIS:
-    case FLOW_ITEM.EXECUTIVE_SUMMARY:
-      return (
-        <ResponseItem
-          item={item}
-          isAutoCollapsed={isAutoCollapsed}
-          onToggleAutoCollapse={handleToggle}
-          expandAll={expandAll}
-          isCollapsible={isCollapsible}
-          searchTerm={searchTerm}
-          stageType={stageType}
-        />
-      );
-
-    case FLOW_ITEM.TOOL_CALL:
-      return <ToolCallItem item={item} expandAll={expandAllToolCalls} searchTerm={searchTerm} />;
-
-    case FLOW_ITEM.TOOL_SUMMARY:
-      return (
-        <ToolSummaryItem
-          item={item}
-          isAutoCollapsed={isAutoCollapsed}
-          onToggleAutoCollapse={handleToggle}
-          expandAll={expandAll}
-          isCollapsible={isCollapsible}
-          searchTerm={searchTerm}
-        />
-      );
-
-    case FLOW_ITEM.USER_QUESTION:
-      return <UserQuestionItem item={item} searchTerm={searchTerm} />;
-
-    case FLOW_ITEM.CODE_EXECUTION:
-    case FLOW_ITEM.SEARCH_RESULT:
-    case FLOW_ITEM.URL_CONTEXT:
-      return <NativeToolItem item={item} searchTerm={searchTerm} />;
-
-    case FLOW_ITEM.ERROR:
-      return <ErrorItem item={item} searchTerm={searchTerm} />;
-
-    case FLOW_ITEM.PROVIDER_FALLBACK:
-      return <ProviderFallbackItem item={item} searchTerm={searchTerm} />;
-
-    case FLOW_ITEM.SKILL_LOADED:
-      return <SkillLoadedItem item={item} expandAll={expandAllToolCalls} searchTerm={searchTerm} />;
-
-    case FLOW_ITEM.MEMORY_INJECTED:
-      return <MemoryInjectedItem item={item} expandAll={expandAllToolCalls} searchTerm={searchTerm} />;
-
-    case FLOW_ITEM.STAGE_SEPARATOR:
-      // Stage separators are handled by the ConversationTimeline container
-      return null;
-
-    default:
-      return null;
+  const rendered = (() => {
+    switch (item.type) {
+      case FLOW_ITEM.THINKING:
+        return (
+          <ThinkingItem
+            item={item}
+            isAutoCollapsed={isAutoCollapsed}
+            onToggleAutoCollapse={handleToggle}
+            expandAll={expandAll}
+            isCollapsible={isCollapsible}
+            searchTerm={searchTerm}
+          />
+        );
+
+      case FLOW_ITEM.RESPONSE:
+        return (
+          <ResponseItem
+            item={item}
+            isAutoCollapsed={isAutoCollapsed}
+            onToggleAutoCollapse={handleToggle}
+            expandAll={expandAll}
+            isCollapsible={isCollapsible}
+            searchTerm={searchTerm}
+          />
+        );
+
+      case FLOW_ITEM.FINAL_ANALYSIS:
+      case FLOW_ITEM.EXECUTIVE_SUMMARY:
+        return (
+          <ResponseItem
+            item={item}
+            isAutoCollapsed={isAutoCollapsed}
+            onToggleAutoCollapse={handleToggle}
+            expandAll={expandAll}
+            isCollapsible={isCollapsible}
+            searchTerm={searchTerm}
+            stageType={stageType}
+          />
+        );
+
+      case FLOW_ITEM.TOOL_CALL:
+        return <ToolCallItem item={item} expandAll={expandAllToolCalls} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.TOOL_SUMMARY:
+        return (
+          <ToolSummaryItem
+            item={item}
+            isAutoCollapsed={isAutoCollapsed}
+            onToggleAutoCollapse={handleToggle}
+            expandAll={expandAll}
+            isCollapsible={isCollapsible}
+            searchTerm={searchTerm}
+          />
+        );
+
+      case FLOW_ITEM.USER_QUESTION:
+        return <UserQuestionItem item={item} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.OPERATOR_NOTE:
+        return <OperatorNoteItem item={item} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.MCP_SERVER_DISABLED:
+        return <McpServerDisabledItem item={item} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.CAPABILITY_FALLBACK:
+        return <CapabilityFallbackItem item={item} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.CODE_EXECUTION:
+      case FLOW_ITEM.SEARCH_RESULT:
+      case FLOW_ITEM.URL_CONTEXT:
+        return <NativeToolItem item={item} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.ERROR:
+        return <ErrorItem item={item} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.PROVIDER_FALLBACK:
+        return <ProviderFallbackItem item={item} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.SKILL_LOADED:
+        return <SkillLoadedItem item={item} expandAll={expandAllToolCalls} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.MEMORY_INJECTED:
+        return <MemoryInjectedItem item={item} expandAll={expandAllToolCalls} searchTerm={searchTerm} />;
+
+      case FLOW_ITEM.STAGE_SEPARATOR:
+        // Stage separators are handled by the ConversationTimeline container
+        return null;
+
+      default:
+        return null;
+    }
+  })();
+
+  if (rendered && needsHighlightMarker(item)) {
+    return <HighlightMarker highlight={item.highlight!}>{rendered}</HighlightMarker>;
   }
+  return rendered;
 }
 
 export default memo(TimelineItem);
@@ -2,9 +2,11 @@ import { memo, useMemo } from 'react';
 import { Box, Typography, alpha } from '@mui/material';
 import { AccountCircle, Assignment } from '@mui/icons-material';
 import ReactMarkdown from 'react-markdown';
+import { useParams } from 'react-router-dom';
 import { remarkPlugins, thoughtMarkdownComponents } from '../../utils/markdownComponents';
 import { rehypeSearchHighlight } from '../../utils/rehypeSearchHighlight';
 import CopyButton from '../shared/CopyButton';
+import { sessionVoiceNoteUrl } from '../../services/api';
 import type { FlowItem } from '../../utils/timelineParser';
 
 interface UserQuestionItemProps {
@@ -19,6 +21,8 @@ function UserQuestionItem({ item, searchTerm }: UserQuestionItemProps) {
   const isTask = author === 'Task';
   const Icon = isTask ? Assignment : AccountCircle;
   const accentColor = isTask ? 'secondary.main' : 'primary.main';
+  const voiceNoteId = item.metadata?.voice_note_id as string | undefined;
+  const { id: sessionId } = useParams<{ id: string }>();
   const rehypePlugins = useMemo(
     () => { const p = rehypeSearchHighlight(searchTerm || ''); return p ? [p] : []; },
     [searchTerm],
@@ -77,6 +81,11 @@ function UserQuestionItem({ item, searchTerm }: UserQuestionItemProps) {
             {item.content}
           </ReactMarkdown>
         </Box>
+        {voiceNoteId && sessionId && (
+          <Box sx={{ mt: 1 }}>
+            <audio controls preload="none" src={sessionVoiceNoteUrl(sessionId, voiceNoteId)} style={{ width: '100%', maxWidth: 360 }} />
+          </Box>
+        )}
       </Box>
     </Box>
   );
@@ -7,6 +7,13 @@ export interface FinalAnalysisPresentation {
   color: string;
 }
 
+/** Label suffix per forced_reason; unknown reasons fall back to max iterations. */
+const FORCED_REASON_SUFFIX: Record<string, string> = {
+  max_iterations: ' (⚠️Max Iterations)',
+  time_budget: ' (⚠️Time Budget)',
+  max_tool_calls: ' (⚠️Max Tool Calls)',
+};
+
 /**
  * Returns context-aware label, emoji, and color for a final_analysis timeline event.
  * Handles synthesis (from metadata), then stage type (chat, action), defaulting to
@@ -22,7 +29,10 @@ export function getFinalAnalysisPresentation(
     return { label: 'SYNTHESIS', emoji: '🔀', color: 'success.main' };
   }
 
-  const suffix = isForcedConclusion ? ' (⚠️Max Iterations)' : '';
+  let suffix = '';
+  if (isForcedConclusion) {
+    suffix = FORCED_REASON_SUFFIX[metadata?.forced_reason as string] ?? ' (⚠️Max Iterations)';
+  }
   switch (stageType) {
     case STAGE_TYPE.CHAT:
       return { label: `ANSWER${suffix}`, emoji: '🎯', color: 'success.main' };
@@ -237,7 +237,7 @@ export default function InteractionCard({ interaction, sessionId }: InteractionC
               </Alert>
             )}
             {detail && interaction.kind === 'llm' && (
-              <LLMInteractionDetail detail={detail as LLMInteractionDetailResponse} />
+              <LLMInteractionDetail detail={detail as LLMInteractionDetailResponse} sessionId={sessionId} />
             )}
             {detail && interaction.kind === 'mcp' && (
               <MCPInteractionDetail detail={detail as MCPInteractionDetailResponse} />
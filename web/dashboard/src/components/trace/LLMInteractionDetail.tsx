@@ -1,8 +1,8 @@
 /**
  * LLMInteractionDetail — full expanded view of an LLM interaction.
  *
- * Shows conversation messages (system/user/assistant/tool), thinking content,
- * model info, token usage, raw request/response metadata, and copy buttons.
+ * Shows conversation messages (system/user/assistant/tool) with their image
+ * attachments, thinking content, model info, token usage, raw request/response metadata, and copy buttons.
  *
  * Visual pattern from old dashboard's InteractionDetails.tsx (renderLLMDetails),
  * data layer rewritten for LLMInteractionDetailResponse.
@@ -25,10 +25,12 @@ import CopyButton from '../shared/CopyButton';
 import JsonDisplay from '../shared/JsonDisplay';
 import TokenUsageDisplay from '../shared/TokenUsageDisplay';
 import NativeToolsDisplay from './NativeToolsDisplay';
+import { sessionImageUrl } from '../../services/api';
 import { getInteractionTypeLabel, formatLLMDetailForCopy, serializeMessageContent } from './traceHelpers';
 
 interface LLMInteractionDetailProps {
   detail: LLMInteractionDetailResponse;
+  sessionId: string;
 }
 
 /** Get role-specific styling for conversation messages. */
@@ -52,7 +54,15 @@ function getMessageStyle(role: string) {
 }
 
 /** Render a single conversation message. */
-function ConversationMessageView({ message, index }: { message: ConversationMessage; index: number }) {
+function ConversationMessageView({
+  message,
+  index,
+  sessionId,
+}: {
+  message: ConversationMessage;
+  index: number;
+  sessionId: string;
+}) {
   const style = getMessageStyle(message.role);
   const content = serializeMessageContent(message.content);
 
@@ -106,6 +116,33 @@ function ConversationMessageView({ message, index }: { message: ConversationMess
       >
         {content}
       </Typography>
+      {/* Image attachments (alert screenshots, chat uploads) */}
+      {message.images && message.images.length > 0 && (
+        <Box sx={{ display: 'flex', flexWrap: 'wrap', gap: 1, mt: 1 }}>
+          {message.images.map((img) => {
+            const url = sessionImageUrl(sessionId, img.id);
+            return (
+              <Box
+                key={img.id}
+                component="a"
+                href={url}
+                target="_blank"
+                rel="noopener noreferrer"
+                title={img.name || img.id}
+                sx={{ display: 'block', border: 1, borderColor: 'divider', borderRadius: 1, overflow: 'hidden' }}
+              >
+                <Box
+                  component="img"
+                  src={url}
+                  alt={img.name || 'attached image'}
+                  loading="lazy"
+                  sx={{ display: 'block', maxWidth: 320, maxHeight: 240, objectFit: 'contain' }}
+                />
+              </Box>
+            );
+          })}
+        </Box>
+      )}
       {/* Tool calls within assistant messages */}
       {message.tool_calls && message.tool_calls.length > 0 && (
         <Box sx={{ mt: 1, pl: 2, borderLeft: '2px solid', borderColor: 'warning.main' }}>
@@ -138,7 +175,11 @@ function ConversationMessageView({ message, index }: { message: ConversationMess
   );
 }
 
-function LLMInteractionDetail({ detail }: LLMInteractionDetailProps) {
+function LLMInteractionDetail({ detail, sessionId }: LLMInteractionDetailProps) {
+  const rawGuardrails = detail.llm_request?.['chat_guardrails'];
+  const chatGuardrails = Array.isArray(rawGuardrails)
+    ? rawGuardrails.filter((r): r is string => typeof r === 'string')
+    : [];
   const rawCopyText = (() => {
     let text = '';
     for (const msg of detail.conversation ?? []) {
@@ -212,7 +253,7 @@ function LLMInteractionDetail({ detail }: LLMInteractionDetailProps) {
             </Box>
             <Stack spacing={2}>
               {detail.conversation.map((message, index) => (
-                <ConversationMessageView key={index} message={message} index={index} />
+                <ConversationMessageView key={index} message={message} index={index} sessionId={sessionId} />
               ))}
             </Stack>
           </Box>
@@ -300,6 +341,22 @@ function LLMInteractionDetail({ detail }: LLMInteractionDetailProps) {
         {/* Native Tools (enabled config + usage) */}
         <NativeToolsDisplay detail={detail} variant="detailed" />
 
+        {/* Chat guardrails merged into the system prompt (chain + session) */}
+        {chatGuardrails.length > 0 && (
+          <Box sx={{ p: 1.5, border: 1, borderColor: 'divider', borderRadius: 1 }}>
+            <Typography variant="caption" sx={{ fontWeight: 600, textTransform: 'uppercase' }}>
+              Chat Guardrails
+            </Typography>
+            <Box component="ul" sx={{ m: 0, mt: 0.5, pl: 2.5 }}>
+              {chatGuardrails.map((rule, i) => (
+                <Typography key={i} component="li" variant="body2">
+                  {rule}
+                </Typography>
+              ))}
+            </Box>
+          </Box>
+        )}
+
         {/* Response Metadata (grounding details etc.) */}
         {detail.response_metadata && Object.keys(detail.response_metadata).length > 0 && (
           <Box>
@@ -9,6 +9,8 @@ export const EVENT_SESSION_STATUS = 'session.status' as const;
 export const EVENT_STAGE_STATUS = 'stage.status' as const;
 export const EVENT_CHAT_CREATED = 'chat.created' as const;
 export const EVENT_INTERACTION_CREATED = 'interaction.created' as const;
+export const EVENT_SUBAGENT_DISPATCHED = 'subagent.dispatched' as const;
+export const EVENT_SUBAGENT_STATUS = 'subagent.status' as const;
 
 // Transient event types (NOTIFY only, no DB)
 export const EVENT_STREAM_CHUNK = 'stream.chunk' as const;
@@ -17,11 +19,15 @@ export const EVENT_EXECUTION_PROGRESS = 'execution.progress' as const;
 export const EVENT_EXECUTION_STATUS = 'execution.status' as const;
 export const EVENT_SESSION_SCORE_UPDATED = 'session.score_updated' as const;
 export const EVENT_REVIEW_STATUS = 'review.status' as const;
+export const EVENT_SAVED_VIEW_MATCHED = 'saved_view.matched' as const;
+export const EVENT_SESSION_ORPHAN_RECOVERED = 'session.orphan_recovered' as const;
+export const EVENT_CHAT_QUEUE_POSITION = 'chat.queue_position' as const;
 
 // Server → client control events
 export const EVENT_CONNECTION_ESTABLISHED = 'connection.established' as const;
 export const EVENT_CATCHUP_OVERFLOW = 'catchup.overflow' as const;
 export const EVENT_PONG = 'pong' as const;
+export const EVENT_CONNECTION_GOING_AWAY = 'connection.going_away' as const;
 
 // Stage status values
 export const STAGE_STATUS_STARTED = 'started' as const;
@@ -30,27 +36,27 @@ export const STAGE_STATUS_FAILED = 'failed' as const;
 export const STAGE_STATUS_TIMED_OUT = 'timed_out' as const;
 export const STAGE_STATUS_CANCELLED = 'cancelled' as const;
 
-// Progress phase values
-export const PROGRESS_PHASE_INVESTIGATING = 'investigating' as const;
-export const PROGRESS_PHASE_REMEDIATING = 'remediating' as const;
-export const PROGRESS_PHASE_GATHERING_INFO = 'gathering_info' as const;
-export const PROGRESS_PHASE_DISTILLING = 'distilling' as const;
-export const PROGRESS_PHASE_CONCLUDING = 'concluding' as const;
+// Progress phase values — the standard phases every controller reports
+export const PROGRESS_PHASE_ANALYZING = 'analyzing' as const;
+export const PROGRESS_PHASE_CALLING_TOOLS = 'calling_tools' as const;
+export const PROGRESS_PHASE_WAITING_SUB_AGENTS = 'waiting_sub_agents' as const;
 export const PROGRESS_PHASE_SYNTHESIZING = 'synthesizing' as const;
 export const PROGRESS_PHASE_FINALIZING = 'finalizing' as const;
+export const PROGRESS_PHASE_WAITING_REMOTE = 'waiting_remote' as const;
+export const PROGRESS_PHASE_TIME_WARNING = 'time_warning' as const;
 
 /**
  * Human-readable status messages for each progress phase.
  * Used for the session-level progress indicator.
  */
 export const PHASE_STATUS_MESSAGE: Record<string, string> = {
-  [PROGRESS_PHASE_INVESTIGATING]: 'Investigating...',
-  [PROGRESS_PHASE_REMEDIATING]: 'Remediating...',
-  [PROGRESS_PHASE_GATHERING_INFO]: 'Gathering...',
-  [PROGRESS_PHASE_DISTILLING]: 'Distilling...',
-  [PROGRESS_PHASE_CONCLUDING]: 'Concluding...',
+  [PROGRESS_PHASE_ANALYZING]: 'Analyzing...',
+  [PROGRESS_PHASE_CALLING_TOOLS]: 'Calling tools...',
+  [PROGRESS_PHASE_WAITING_SUB_AGENTS]: 'Waiting for sub-agents...',
   [PROGRESS_PHASE_SYNTHESIZING]: 'Synthesizing...',
   [PROGRESS_PHASE_FINALIZING]: 'Finalizing...',
+  [PROGRESS_PHASE_WAITING_REMOTE]: 'Waiting for remote instance...',
+  [PROGRESS_PHASE_TIME_WARNING]: 'Running long...',
 };
 
 // Stage type values
@@ -59,6 +65,7 @@ export const STAGE_TYPE = {
   SYNTHESIS: 'synthesis',
   CHAT: 'chat',
   EXEC_SUMMARY: 'exec_summary',
+  TECHNICAL_SUMMARY: 'technical_summary',
   SCORING: 'scoring',
   ACTION: 'action',
 } as const;
@@ -69,6 +76,7 @@ export type StageType = (typeof STAGE_TYPE)[keyof typeof STAGE_TYPE];
 export const COLLAPSIBLE_STAGE_TYPES: ReadonlySet<string> = new Set<string>([
   STAGE_TYPE.SYNTHESIS,
   STAGE_TYPE.EXEC_SUMMARY,
+  STAGE_TYPE.TECHNICAL_SUMMARY,
   STAGE_TYPE.ACTION,
   STAGE_TYPE.SCORING,
 ]);
@@ -89,6 +97,9 @@ export const TIMELINE_EVENT_TYPES = {
   PROVIDER_FALLBACK: 'provider_fallback',
   SKILL_LOADED: 'skill_loaded',
   MEMORY_INJECTED: 'memory_injected',
+  OPERATOR_NOTE: 'operator_note',
+  MCP_SERVER_DISABLED: 'mcp_server_disabled',
+  CAPABILITY_FALLBACK: 'capability_fallback',
   ERROR: 'error',
 } as const;
 
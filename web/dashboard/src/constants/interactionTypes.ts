@@ -10,11 +10,18 @@ export const LLM_INTERACTION_TYPE = {
   SUMMARIZATION: 'summarization',
   FINAL_ANALYSIS: 'final_analysis',
   EXECUTIVE_SUMMARY: 'executive_summary',
+  TECHNICAL_SUMMARY: 'technical_summary',
   CHAT_RESPONSE: 'chat_response',
   SYNTHESIS: 'synthesis',
   FORCED_CONCLUSION: 'forced_conclusion',
   SCORING: 'scoring',
   MEMORY_EXTRACTION: 'memory_extraction',
+  OUTCOME_CLASSIFICATION: 'outcome_classification',
+  ACTION_ITEM_EXTRACTION: 'action_item_extraction',
+  RECURRENCE_COMPARISON: 'recurrence_comparison',
+  FANOUT_SYNTHESIS: 'fanout_synthesis',
+  NOISE_TRIAGE: 'noise_triage',
+  MODEL_ROUTING: 'model_routing',
 } as const;
 
 export type LLMInteractionType =
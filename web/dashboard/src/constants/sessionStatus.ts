@@ -10,6 +10,7 @@ export const SESSION_STATUS = {
   FAILED: 'failed',
   CANCELLED: 'cancelled',
   TIMED_OUT: 'timed_out',
+  AUTO_CANCELLED: 'auto_cancelled',
 } as const;
 
 /**
@@ -75,6 +76,7 @@ export const TERMINAL_STATUSES = new Set<SessionStatus>([
   SESSION_STATUS.FAILED,
   SESSION_STATUS.CANCELLED,
   SESSION_STATUS.TIMED_OUT,
+  SESSION_STATUS.AUTO_CANCELLED,
 ]);
 
 /** Active statuses — session is still processing. */
@@ -110,6 +112,8 @@ export function getStatusDisplayName(status: SessionStatus): string {
       return 'Cancelled';
     case SESSION_STATUS.TIMED_OUT:
       return 'Timed Out';
+    case SESSION_STATUS.AUTO_CANCELLED:
+      return 'Auto-cancelled';
   }
 }
 
@@ -129,6 +133,7 @@ export function getStatusColor(
     case SESSION_STATUS.PENDING:
       return 'warning';
     case SESSION_STATUS.CANCELLED:
+    case SESSION_STATUS.AUTO_CANCELLED:
       return 'default';
   }
 }
@@ -1,16 +1,17 @@
 /**
  * useChatState — state management for the follow-up chat lifecycle.
  *
- * Manages: sendingMessage, canceling, chatStageId, error.
+ * Manages: sendingMessage, canceling, chatStageId, queuePosition, error.
  * Does NOT subscribe to WebSocket events — SessionDetailPage drives state
- * transitions by calling onStageStarted / onStageTerminal from its
- * centralized WS handler.
+ * transitions by calling onStageStarted / onStageTerminal / onQueuePosition
+ * from its centralized WS handler.
  */
 
 import { useState, useCallback, useRef, useEffect, useMemo } from 'react';
 import { sendChatMessage, cancelSession, handleAPIError } from '../services/api.ts';
 import { TIMELINE_EVENT_TYPES } from '../constants/eventTypes.ts';
 import type { TimelineEvent } from '../types/session.ts';
+import type { VoiceNoteUpload } from '../types/api.ts';
 
 /** Safety timeout (ms) to clear sendingMessage if WS event never arrives. */
 const SENDING_TIMEOUT_MS = 30_000;
@@ -22,6 +23,8 @@ export interface ChatState {
   sendingMessage: boolean;
   canceling: boolean;
   chatStageId: string | null;
+  /** 1-based place of the queued message in the chat queue (null = none queued). */
+  queuePosition: number | null;
   error: string | null;
 }
 
@@ -32,10 +35,11 @@ export interface SendMessageResult {
 }
 
 export interface UseChatStateReturn extends ChatState {
-  sendMessage: (content: string) => Promise<SendMessageResult | null>;
+  sendMessage: (content: string, voiceNote?: VoiceNoteUpload) => Promise<SendMessageResult | null>;
   cancelExecution: () => Promise<void>;
   onStageStarted: (stageId: string) => void;
   onStageTerminal: () => void;
+  onQueuePosition: (position: number) => void;
   clearError: () => void;
 }
 
@@ -43,6 +47,7 @@ export function useChatState(sessionId: string): UseChatStateReturn {
   const [sendingMessage, setSendingMessage] = useState(false);
   const [canceling, setCanceling] = useState(false);
   const [chatStageId, setChatStageId] = useState<string | null>(null);
+  const [queuePosition, setQueuePosition] = useState<number | null>(null);
   const [error, setError] = useState<string | null>(null);
 
   const sendingTimeoutRef = useRef<ReturnType<typeof setTimeout> | null>(null);
@@ -82,12 +87,31 @@ export function useChatState(sessionId: string): UseChatStateReturn {
     }
   }, []);
 
-  const sendMessage = useCallback(async (content: string): Promise<SendMessageResult | null> => {
+  const sendMessage = useCallback(async (
+    content: string,
+    voiceNote?: VoiceNoteUpload,
+  ): Promise<SendMessageResult | null> => {
     setError(null);
     setSendingMessage(true);
 
     try {
-      const response = await sendChatMessage(sessionId, content);
+      const response = await sendChatMessage(sessionId, content, voiceNote);
+
+      // The /disable-mcp command is applied without an agent turn; its
+      // timeline event arrives over the WebSocket.
+      if (response.disabled_mcp_server) {
+        setSendingMessage(false);
+        return null;
+      }
+
+      // Queued behind the response being generated (or until the server
+      // has capacity): its position updates, stage and user_question event
+      // arrive over the WebSocket.
+      if (response.queued) {
+        setSendingMessage(false);
+        setQueuePosition(response.queue_position ?? 1);
+        return null;
+      }
 
       setChatStageId(response.stage_id);
       // Sync ref immediately so a fast WS stage.status event (arriving
@@ -116,8 +140,9 @@ export function useChatState(sessionId: string): UseChatStateReturn {
         sequence_number: 0,
         event_type: TIMELINE_EVENT_TYPES.USER_QUESTION,
         status: 'completed',
-        content,
-        metadata: null,
+        // Voice notes: the server returns the question with its transcript
+        content: response.content ?? content,
+        metadata: response.voice_note_id ? { voice_note_id: response.voice_note_id } : null,
         created_at: now,
         updated_at: now,
       };
@@ -172,6 +197,12 @@ export function useChatState(sessionId: string): UseChatStateReturn {
     clearCancelTimeout();
   }, [clearSendingTimeout, clearCancelTimeout]);
 
+  // Called by SessionDetailPage WS handler when chat.queue_position arrives
+  // for this session; 0 means the message left the queue.
+  const onQueuePosition = useCallback((position: number) => {
+    setQueuePosition(position > 0 ? position : null);
+  }, []);
+
   const clearError = useCallback(() => {
     setError(null);
   }, []);
@@ -180,11 +211,13 @@ export function useChatState(sessionId: string): UseChatStateReturn {
     sendingMessage,
     canceling,
     chatStageId,
+    queuePosition,
     error,
     sendMessage,
     cancelExecution,
     onStageStarted,
     onStageTerminal,
+    onQueuePosition,
     clearError,
-  }), [sendingMessage, canceling, chatStageId, error, sendMessage, cancelExecution, onStageStarted, onStageTerminal, clearError]);
+  }), [sendingMessage, canceling, chatStageId, queuePosition, error, sendMessage, cancelExecution, onStageStarted, onStageTerminal, onQueuePosition, clearError]);
 }
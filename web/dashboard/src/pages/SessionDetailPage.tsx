@@ -43,9 +43,10 @@ import { useChatState } from '../hooks/useChatState.ts';
 import { getSession, getTimeline, updateReview, handleAPIError } from '../services/api.ts';
 import { websocketService } from '../services/websocket.ts';
 import { REVIEW_ACTION, REVIEW_MODAL_MODE, REVIEW_SELECTION, getReviewModalMode } from '../types/api.ts';
-import type { ReviewModalMode, ReviewSelection } from '../types/api.ts';
+import type { ReviewModalMode, ReviewSelection, VoiceNoteUpload } from '../types/api.ts';
 
 import { parseTimelineToFlow } from '../utils/timelineParser.ts';
+import { formatTimestamp } from '../utils/format.ts';
 import type { FlowItem } from '../utils/timelineParser.ts';
 import type { SessionDetailResponse, TimelineEvent, StageOverview } from '../types/session.ts';
 import type { StreamingItem } from '../components/streaming/StreamingContentRenderer.tsx';
@@ -58,7 +59,10 @@ import type {
   SessionProgressPayload,
   ExecutionProgressPayload,
   ExecutionStatusPayload,
+  SubAgentDispatchedPayload,
+  SubAgentStatusPayload,
   ChatCreatedPayload,
+  ChatQueuePositionPayload,
   SessionScoreUpdatedPayload,
 } from '../types/events.ts';
 
@@ -71,9 +75,12 @@ import {
   EVENT_SESSION_PROGRESS,
   EVENT_EXECUTION_PROGRESS,
   EVENT_EXECUTION_STATUS,
+  EVENT_SUBAGENT_DISPATCHED,
+  EVENT_SUBAGENT_STATUS,
   EVENT_SESSION_SCORE_UPDATED,
   EVENT_CATCHUP_OVERFLOW,
   EVENT_CHAT_CREATED,
+  EVENT_CHAT_QUEUE_POSITION,
   EVENT_REVIEW_STATUS,
   TIMELINE_STATUS,
   TIMELINE_EVENT_TYPES,
@@ -97,6 +104,8 @@ import {
 const SessionHeader = lazy(() => import('../components/session/SessionHeader.tsx'));
 const FinalAnalysisCard = lazy(() => import('../components/session/FinalAnalysisCard.tsx'));
 const ReviewActivityCard = lazy(() => import('../components/session/ReviewActivityCard.tsx'));
+const HandoffNotesCard = lazy(() => import('../components/session/HandoffNotesCard.tsx'));
+const ActionItemsCard = lazy(() => import('../components/session/ActionItemsCard.tsx'));
 const ExtractedLearningsCard = lazy(() => import('../components/session/ExtractedLearningsCard.tsx'));
 const ConversationTimeline = lazy(() => import('../components/session/ConversationTimeline.tsx'));
 const ChatPanel = lazy(() => import('../components/chat/ChatPanel.tsx'));
@@ -198,6 +207,11 @@ export function SessionDetailPage() {
   const [agentProgressStatuses, setAgentProgressStatuses] = useState<Map<string, string>>(
     () => new Map(),
   );
+  // Latest percent-complete estimate per execution (agents and sub-agents).
+  // execution.progress events without a percent keep the previous estimate.
+  const [agentProgressPercents, setAgentProgressPercents] = useState<Map<string, number>>(
+    () => new Map(),
+  );
   // Real-time execution status from execution.status WS events (executionId → {status, stageId, agentIndex}).
   // Higher priority than REST ExecutionOverview for immediate UI updates.
   // stageId is included so StageContent can filter out executions from other stages,
@@ -564,6 +578,7 @@ export function SessionDetailPage() {
     streamingMetaRef.current.clear();
     setProgressStatus('Processing...');
     setAgentProgressStatuses(new Map());
+    setAgentProgressPercents(new Map());
     setExecutionStatuses(new Map());
     setSubAgentStreamingEvents(new Map());
     setSubAgentExecutionStatuses(new Map());
@@ -753,6 +768,7 @@ export function SessionDetailPage() {
               status: payload.status,
               content: payload.content,
               metadata: payload.metadata || null,
+              highlight: payload.highlight || null,
               created_at: payload.timestamp,
               updated_at: payload.timestamp,
             };
@@ -836,6 +852,7 @@ export function SessionDetailPage() {
                   metadata: (next[index].metadata || payload.metadata)
                     ? { ...(next[index].metadata || {}), ...(payload.metadata || {}) }
                     : null,
+                  highlight: payload.highlight || next[index].highlight,
                   updated_at: payload.timestamp,
                 };
                 return next;
@@ -857,6 +874,7 @@ export function SessionDetailPage() {
                   status: payload.status,
                   content: payload.content,
                   metadata: mergedMetadata,
+                  highlight: payload.highlight || null,
                   created_at: meta?.createdAt ?? payload.timestamp,
                   updated_at: payload.timestamp,
                 },
@@ -1021,6 +1039,7 @@ export function SessionDetailPage() {
           // starts, the previous parallel execution state is no longer relevant.
           if (payload.status === EXECUTION_STATUS.STARTED) {
             setAgentProgressStatuses(new Map());
+            setAgentProgressPercents(new Map());
             setExecutionStatuses(new Map());
             setSubAgentStreamingEvents(new Map());
             setSubAgentExecutionStatuses(new Map());
@@ -1064,7 +1083,7 @@ export function SessionDetailPage() {
         // --- execution.progress ---
         if (eventType === EVENT_EXECUTION_PROGRESS) {
           const payload = data as unknown as ExecutionProgressPayload;
-          // Map phase to clean display message (e.g. "Investigating...", "Distilling...")
+          // Map phase to clean display message (e.g. "Analyzing...", "Calling tools...")
           // Fall back to raw message if
           // the phase isn't in the map (shouldn't happen, but defensive).
           const phaseMessage = PHASE_STATUS_MESSAGE[payload.phase] || payload.message;
@@ -1081,6 +1100,14 @@ export function SessionDetailPage() {
               return next;
             });
           }
+          if (typeof payload.percent === 'number' && payload.execution_id) {
+            const percent = payload.percent;
+            setAgentProgressPercents((prev) => {
+              const next = new Map(prev);
+              next.set(payload.execution_id, percent);
+              return next;
+            });
+          }
           // Do NOT update session-level progressStatus here.
           // Per-agent progress must stay isolated in agentProgressStatuses so that
           // the "Waiting for other agents..." check in ConversationTimeline works
@@ -1111,6 +1138,21 @@ export function SessionDetailPage() {
           return;
         }
 
+        // --- subagent.dispatched / subagent.status ---
+        // Orchestration tree lifecycle: a sub-agent card appears as soon as it
+        // is dispatched and settles on its terminal status. Both events are
+        // persisted, so catch-up replays them after a reconnect.
+        if (eventType === EVENT_SUBAGENT_DISPATCHED || eventType === EVENT_SUBAGENT_STATUS) {
+          const payload = data as unknown as SubAgentDispatchedPayload | SubAgentStatusPayload;
+          const status = payload.type === EVENT_SUBAGENT_STATUS ? payload.status : 'active';
+          setSubAgentExecutionStatuses((prev) => {
+            const next = new Map(prev);
+            next.set(payload.execution_id, { status, stageId: payload.stage_id, agentIndex: payload.agent_index });
+            return next;
+          });
+          return;
+        }
+
         // --- chat.created ---
         // Update session with the new chat_id so ChatPanel knows a chat exists.
         if (eventType === EVENT_CHAT_CREATED) {
@@ -1121,6 +1163,14 @@ export function SessionDetailPage() {
           });
           return;
         }
+
+        // --- chat.queue_position ---
+        // Show where a queued follow-up question stands until it starts.
+        if (eventType === EVENT_CHAT_QUEUE_POSITION) {
+          const payload = data as unknown as ChatQueuePositionPayload;
+          chatState.onQueuePosition(payload.position);
+          return;
+        }
     };
 
     const flushWsEvents = () => {
@@ -1225,7 +1275,7 @@ export function SessionDetailPage() {
       for (const t of collapseTimersRef.current) clearTimeout(t);
       collapseTimersRef.current.clear();
     };
-  }, [id, loadData, refetchTimelineDebounced, applyFreshTimeline, flushPendingChunks, chatState.onStageStarted, chatState.onStageTerminal]);
+  }, [id, loadData, refetchTimelineDebounced, applyFreshTimeline, flushPendingChunks, chatState.onStageStarted, chatState.onStageTerminal, chatState.onQueuePosition]);
 
   // ────────────────────────────────────────────────────────────
   // Auto-scroll lifecycle
@@ -1365,8 +1415,8 @@ export function SessionDetailPage() {
     : false;
   const chatStageInProgress = !!chatState.chatStageId && !chatState.sendingMessage;
 
-  const handleSendMessage = useCallback(async (content: string) => {
-    const result = await chatState.sendMessage(content);
+  const handleSendMessage = useCallback(async (content: string, voiceNote?: VoiceNoteUpload) => {
+    const result = await chatState.sendMessage(content, voiceNote);
     if (result) {
       // Sync ref immediately so the WS handler can match fast stage.status
       // events arriving before React re-renders the effect that syncs it.
@@ -1640,6 +1690,31 @@ export function SessionDetailPage() {
               />
             </Suspense>
 
+            {/* Alert resolved by its source while the session was queued or running */}
+            {session.alert_resolved_at && (
+              <Alert severity="success" variant="outlined">
+                <Typography variant="body2">
+                  The alert source reported this alert resolved {formatTimestamp(session.alert_resolved_at, 'short')}
+                  {session.alert_resolution ? ` (${session.alert_resolution})` : ''}.
+                  {session.status === SESSION_STATUS.IN_PROGRESS && ' The investigation is informed at its next stage.'}
+                </Typography>
+              </Alert>
+            )}
+
+            {/* Who cancelled the session and why */}
+            {session.cancel_initiator && (
+              <Alert severity="info" variant="outlined">
+                <Typography variant="body2">
+                  {session.cancel_initiator === 'user'
+                    ? `Cancelled by ${session.cancelled_by || 'a user'}`
+                    : session.cancel_initiator === 'pending_ttl'
+                      ? 'Cancelled by the pending TTL policy'
+                      : 'Cancelled by the alert resolution webhook'}
+                  {session.cancel_reason ? `: ${session.cancel_reason}` : ''}.
+                </Typography>
+              </Alert>
+            )}
+
             {/* Conversation Timeline */}
             {(session.stages && session.stages.length > 0) || streamingEvents.size > 0 ? (
               <Suspense fallback={<TimelineSkeleton />}>
@@ -1651,6 +1726,7 @@ export function SessionDetailPage() {
                   scoringStatus={scoringStatus}
                   streamingEvents={streamingEvents}
                   agentProgressStatuses={agentProgressStatuses}
+                  agentProgressPercents={agentProgressPercents}
                   executionStatuses={executionStatuses}
                   subAgentStreamingEvents={subAgentStreamingEvents}
                   subAgentExecutionStatuses={subAgentExecutionStatuses}
@@ -1689,6 +1765,13 @@ export function SessionDetailPage() {
                   This session was cancelled before processing started.
                 </Typography>
               </Alert>
+            ) : session.status === SESSION_STATUS.AUTO_CANCELLED ? (
+              <Alert severity="info" sx={{ mb: 2 }}>
+                <Typography variant="body2">
+                  This session was auto-cancelled while queued
+                  {session.error_message ? `: ${session.error_message.replace(/^Auto-cancelled: /, '')}` : '.'}
+                </Typography>
+              </Alert>
             ) : (
               <Alert severity="error" sx={{ mb: 2 }}>
                 <Typography variant="h6" gutterBottom>
@@ -1710,11 +1793,13 @@ export function SessionDetailPage() {
                   isAvailable={isChatAvailable}
                   chatExists={!!session.chat_id}
                   onSendMessage={handleSendMessage}
+                  voiceNotesEnabled={session.voice_notes_enabled}
                   onCancelExecution={handleCancelChat}
                   sendingMessage={chatState.sendingMessage}
                   chatStageInProgress={chatStageInProgress}
                   canCancel={!!chatState.chatStageId}
                   canceling={chatState.canceling}
+                  queuePosition={chatState.queuePosition}
                   error={chatState.error}
                   onClearError={chatState.clearError}
                 />
@@ -1727,6 +1812,7 @@ export function SessionDetailPage() {
                 ref={finalAnalysisRef}
                 analysis={session.final_analysis}
                 summary={session.executive_summary}
+                technicalSummary={session.technical_summary}
                 sessionStatus={session.status}
                 errorMessage={session.error_message}
                 expandCounter={expandCounter}
@@ -1740,6 +1826,22 @@ export function SessionDetailPage() {
               />
             </Suspense>
 
+            {/* Operator handoff notes */}
+            <Suspense fallback={null}>
+              <HandoffNotesCard
+                sessionId={session.id}
+                notes={session.handoff_notes}
+                revision={session.handoff_notes_revision}
+                updatedBy={session.handoff_notes_updated_by}
+                updatedAt={session.handoff_notes_updated_at}
+              />
+            </Suspense>
+
+            {/* Follow-up action items */}
+            <Suspense fallback={null}>
+              <ActionItemsCard sessionId={session.id} />
+            </Suspense>
+
             {/* Review activity audit log */}
             {session.review_status && (
               <Suspense fallback={null}>
@@ -2,7 +2,7 @@
  * System Status page — MCP health and effective configuration.
  *
  * Layout follows SubmitAlertPage pattern: SharedHeader + content + VersionFooter.
- * Tabs: MCP Health (polled) | Configuration (fetch once).
+ * Tabs: MCP Health (polled) | Tool Usage (fetch on open) | Configuration (fetch once).
  */
 
 import { useState } from 'react';
@@ -15,9 +15,10 @@ import { SharedHeader } from '../components/layout/SharedHeader.tsx';
 import { VersionFooter } from '../components/layout/VersionFooter.tsx';
 import { FloatingSubmitAlertFab } from '../components/common/FloatingSubmitAlertFab.tsx';
 import { MCPServerStatusView } from '../components/system/MCPServerStatusView.tsx';
+import { MCPToolUsageView } from '../components/system/MCPToolUsageView.tsx';
 import { ConfigViewer } from '../components/system/ConfigViewer.tsx';
 
-type SystemTab = 'mcp' | 'config';
+type SystemTab = 'mcp' | 'tools' | 'config';
 
 export function SystemStatusPage() {
   const [tab, setTab] = useState<SystemTab>('mcp');
@@ -34,6 +35,7 @@ export function SystemStatusPage() {
           sx={{ mb: 3, borderBottom: 1, borderColor: 'divider' }}
         >
           <Tab label="MCP Health" value="mcp" id="system-tab-mcp" aria-controls="system-tabpanel-mcp" />
+          <Tab label="Tool Usage" value="tools" id="system-tab-tools" aria-controls="system-tabpanel-tools" />
           <Tab
             label="Configuration"
             value="config"
@@ -51,6 +53,15 @@ export function SystemStatusPage() {
           <MCPServerStatusView pollingEnabled={tab === 'mcp'} />
         </Box>
 
+        <Box
+          role="tabpanel"
+          hidden={tab !== 'tools'}
+          id="system-tabpanel-tools"
+          aria-labelledby="system-tab-tools"
+        >
+          <MCPToolUsageView active={tab === 'tools'} />
+        </Box>
+
         <Box
           role="tabpanel"
           hidden={tab !== 'config'}
@@ -1,7 +1,8 @@
 /**
  * Usage page — fleet dig-in over a date window.
  *
- * Fetches GET /api/v1/usage/summary for server aggregates (totals, breakdowns, top-20).
+ * Fetches GET /api/v1/usage/summary for server aggregates (totals, breakdowns, top-20)
+ * and GET /api/v1/usage/owners for the per-owner (chargeback) breakdown.
  * Date presets are Usage-oriented (7d / 30d / MTD / last calendar month); default 30d.
  */
 
@@ -47,13 +48,13 @@ import {
   formatAppliedRange,
 } from '../components/dashboard/TimeRangeModal.tsx';
 import EstimatedCostDisplay from '../components/shared/EstimatedCostDisplay.tsx';
-import { getFilterOptions, getUsageSummary, handleAPIError } from '../services/api.ts';
+import { getFilterOptions, getUsageByOwner, getUsageSummary, handleAPIError } from '../services/api.ts';
 import { websocketService } from '../services/websocket.ts';
 import { EVENT_SESSION_STATUS } from '../constants/eventTypes.ts';
 import { isTerminalStatus, type SessionStatus } from '../constants/sessionStatus.ts';
 import { formatEstimatedCostUsd, formatTimestamp, formatTokens } from '../utils/format.ts';
 import { sessionDetailPath } from '../constants/routes.ts';
-import type { UsageRankBy, UsageSummaryResponse } from '../types/api.ts';
+import type { UsageOwnerBreakdown, UsageRankBy, UsageSummaryResponse } from '../types/api.ts';
 import type { SessionStatusPayload } from '../types/events.ts';
 
 /** Throttle for re-fetching the summary in response to WebSocket session events —
@@ -95,6 +96,7 @@ export function UsagePage() {
   const [chainIdOptions, setChainIdOptions] = useState<string[]>([]);
 
   const [summary, setSummary] = useState<UsageSummaryResponse | null>(null);
+  const [owners, setOwners] = useState<UsageOwnerBreakdown[]>([]);
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState<string | null>(null);
 
@@ -120,18 +122,23 @@ export function UsagePage() {
     setLoading(true);
     setError(null);
     try {
-      const data = await getUsageSummary({
+      const params = {
         start_date: startDate.toISOString(),
         end_date: endDate.toISOString(),
         alert_type: alertType || undefined,
         chain_id: chainId || undefined,
-        rank_by: rankBy,
-      });
+      };
+      const [data, byOwner] = await Promise.all([
+        getUsageSummary({ ...params, rank_by: rankBy }),
+        getUsageByOwner(params),
+      ]);
       setSummary(data);
+      setOwners(byOwner.owners);
       return true;
     } catch (err) {
       setError(handleAPIError(err));
       setSummary(null);
+      setOwners([]);
       return false;
     } finally {
       setLoading(false);
@@ -414,6 +421,60 @@ export function UsagePage() {
                 </BreakdownTable>
               </Box>
 
+              {/* By owner — chargeback/showback */}
+              <BreakdownTable
+                title="By owner"
+                columns={
+                  costEnabled
+                    ? [
+                        { label: 'Owner' },
+                        { label: 'Sessions', align: 'right' },
+                        { label: 'Tokens', align: 'right' },
+                        { label: 'Est. cost', align: 'right' },
+                      ]
+                    : [{ label: 'Owner' }, { label: 'Sessions', align: 'right' }, { label: 'Tokens', align: 'right' }]
+                }
+                empty={owners.length === 0}
+              >
+                {owners.map((row) => (
+                  <TableRow key={row.owner || '(unattributed)'} hover>
+                    <TableCell>{row.owner || 'Unattributed'}</TableCell>
+                    <TableCell align="right">{row.session_count}</TableCell>
+                    <TableCell align="right">{formatTokens(row.total_tokens)}</TableCell>
+                    {costEnabled && (
+                      <TableCell align="right">{approxCostUsd(row.estimated_cost_usd)}</TableCell>
+                    )}
+                  </TableRow>
+                ))}
+              </BreakdownTable>
+
+              {/* By outcome — how investigations ended */}
+              <BreakdownTable
+                title="By outcome"
+                columns={
+                  costEnabled
+                    ? [
+                        { label: 'Outcome' },
+                        { label: 'Sessions', align: 'right' },
+                        { label: 'Tokens', align: 'right' },
+                        { label: 'Est. cost', align: 'right' },
+                      ]
+                    : [{ label: 'Outcome' }, { label: 'Sessions', align: 'right' }, { label: 'Tokens', align: 'right' }]
+                }
+                empty={(summary.by_outcome ?? []).length === 0}
+              >
+                {(summary.by_outcome ?? []).map((row) => (
+                  <TableRow key={row.outcome || '(unclassified)'} hover>
+                    <TableCell>{row.outcome ? row.outcome.replace(/_/g, ' ') : 'Unclassified'}</TableCell>
+                    <TableCell align="right">{row.session_count}</TableCell>
+                    <TableCell align="right">{formatTokens(row.total_tokens)}</TableCell>
+                    {costEnabled && (
+                      <TableCell align="right">{approxCostUsd(row.estimated_cost_usd)}</TableCell>
+                    )}
+                  </TableRow>
+                ))}
+              </BreakdownTable>
+
               {/* Top sessions */}
               <BreakdownTable
                 title={`Top sessions (${summary.top_sessions.length})`}
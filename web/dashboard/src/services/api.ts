@@ -5,6 +5,8 @@
  * - Base URL from environment config
  * - Retry on temporary errors (502/503/504, network errors)
  * - 401 → auth redirect
+ * - Read-after-write: GETs right after submit/cancel/chat carry the write's
+ *   consistency token, so they don't show a status from before the action
  * - All endpoint methods typed
  */
 
@@ -12,12 +14,20 @@ import axios, { type AxiosInstance, type AxiosError } from 'axios';
 import { urls } from '../config/env.ts';
 import { authService } from './auth.ts';
 import type {
+  ProblemDetails,
   DashboardListResponse,
   DashboardListParams,
   SubmitAlertRequest,
   AlertResponse,
   CancelResponse,
+  DuplicateSessionRequest,
+  DuplicateSessionResponse,
   SendChatMessageResponse,
+  VoiceNoteUpload,
+  OperatorNote,
+  HandoffNotes,
+  HandoffNoteRevisionsResponse,
+  DisabledMCPServer,
   SessionScoreResponse,
   ScoreSessionResponse,
   TriageGroup,
@@ -26,11 +36,27 @@ import type {
   UpdateReviewRequest,
   UpdateReviewResponse,
   ReviewActivityResponse,
+  UsageOwnersResponse,
   UsageSummaryParams,
   UsageSummaryResponse,
+  CatalogChainsResponse,
+  CatalogAgentsResponse,
+  CatalogMCPServersResponse,
+  CatalogMCPToolsResponse,
+  UserProfileResponse,
+  UpdateUserProfileRequest,
+  SavedView,
+  SavedViewListResponse,
+  CreateSavedViewRequest,
+  UpdateSavedViewRequest,
+  ActionItem,
+  ActionItemListResponse,
+  CreateActionItemRequest,
+  UpdateActionItemRequest,
 } from '../types/api.ts';
 import type {
   SessionDetailResponse,
+  SessionGroupResponse,
   SessionSummaryResponse,
   ActiveSessionsResponse,
   TimelineEvent,
@@ -40,6 +66,7 @@ import type {
   TraceListResponse,
   LLMInteractionDetailResponse,
   MCPInteractionDetailResponse,
+  ContextGrowthResponse,
 } from '../types/trace.ts';
 import type {
   HealthResponse,
@@ -65,6 +92,33 @@ const client: AxiosInstance = axios.create({
   },
 });
 
+// ────────────────────────────────────────────────────────────
+// Read-after-write consistency
+// ────────────────────────────────────────────────────────────
+
+/** How long GETs keep presenting the last write's token (server waits ≤ 5s per read). */
+const CONSISTENCY_WINDOW_MS = 10_000;
+
+let consistency: { token: string; expiresAt: number } | null = null;
+
+/** Remember the consistency token returned by a mutating call. */
+function rememberConsistencyToken(token: string | undefined): void {
+  if (token) {
+    consistency = { token, expiresAt: Date.now() + CONSISTENCY_WINDOW_MS };
+  }
+}
+
+// Request interceptor: GETs present the last write's token while it's fresh
+client.interceptors.request.use((config) => {
+  if (consistency && consistency.expiresAt < Date.now()) {
+    consistency = null;
+  }
+  if (consistency && (config.method ?? 'get').toLowerCase() === 'get') {
+    config.headers.set('X-Consistency-Token', consistency.token);
+  }
+  return config;
+});
+
 // Response interceptor: handle 401 → auth redirect
 client.interceptors.response.use(
   (response) => response,
@@ -122,8 +176,14 @@ function isTemporaryError(error: unknown): boolean {
 /** User-facing error message from an API error. */
 export function handleAPIError(error: unknown): string {
   if (axios.isAxiosError(error)) {
-    if (error.response?.data?.message) {
-      return error.response.data.message;
+    // Problem responses (RFC 7807) carry the message in detail; validation
+    // problems list every invalid field there as "field: message; ...".
+    const data = error.response?.data as (Partial<ProblemDetails> & { message?: string }) | undefined;
+    if (data?.detail) {
+      return data.detail;
+    }
+    if (data?.message) {
+      return data.message;
     }
     if (error.response?.status) {
       return `Request failed with status ${error.response.status}`;
@@ -149,6 +209,44 @@ export async function getUsageSummary(params: UsageSummaryParams): Promise<Usage
   return response.data;
 }
 
+/** Per-owner usage for chargeback; rank_by is ignored by the endpoint. */
+export async function getUsageByOwner(params: UsageSummaryParams): Promise<UsageOwnersResponse> {
+  const response = await retryOnTemporaryError(() =>
+    client.get<UsageOwnersResponse>('/api/v1/usage/owners', { params }),
+  );
+  return response.data;
+}
+
+// --- Catalog ---
+
+export async function getCatalogChains(days?: number): Promise<CatalogChainsResponse> {
+  const response = await retryOnTemporaryError(() =>
+    client.get<CatalogChainsResponse>('/api/v1/catalog/chains', { params: { days } }),
+  );
+  return response.data;
+}
+
+export async function getCatalogAgents(days?: number): Promise<CatalogAgentsResponse> {
+  const response = await retryOnTemporaryError(() =>
+    client.get<CatalogAgentsResponse>('/api/v1/catalog/agents', { params: { days } }),
+  );
+  return response.data;
+}
+
+export async function getCatalogMCPServers(days?: number): Promise<CatalogMCPServersResponse> {
+  const response = await retryOnTemporaryError(() =>
+    client.get<CatalogMCPServersResponse>('/api/v1/catalog/mcp-servers', { params: { days } }),
+  );
+  return response.data;
+}
+
+export async function getCatalogMCPTools(days?: number, server?: string): Promise<CatalogMCPToolsResponse> {
+  const response = await retryOnTemporaryError(() =>
+    client.get<CatalogMCPToolsResponse>('/api/v1/catalog/mcp-tools', { params: { days, server } }),
+  );
+  return response.data;
+}
+
 export async function getActiveSessions(): Promise<ActiveSessionsResponse> {
   const response = await retryOnTemporaryError(() =>
     client.get<ActiveSessionsResponse>('/api/v1/sessions/active'),
@@ -161,6 +259,25 @@ export async function getSession(id: string): Promise<SessionDetailResponse> {
   return response.data;
 }
 
+export async function getSessionGroup(id: string): Promise<SessionGroupResponse> {
+  const response = await client.get<SessionGroupResponse>(`/api/v1/session-groups/${id}`);
+  return response.data;
+}
+
+/**
+ * URL of the rendered session report. Opened directly by the browser
+ * (not via axios) so PDFs download with the server-provided filename.
+ */
+export function sessionReportUrl(id: string, format: 'html' | 'pdf' | 'markdown' = 'pdf'): string {
+  const query = format === 'pdf' ? 'format=pdf&download=true' : `format=${format}`;
+  return `${urls.api.base}/api/v1/sessions/${id}/report?${query}`;
+}
+
+/** URL of an image attached to the session's alert or chat messages. */
+export function sessionImageUrl(sessionId: string, imageId: string): string {
+  return `${urls.api.base}/api/v1/sessions/${sessionId}/images/${imageId}`;
+}
+
 export async function getSessionSummary(id: string): Promise<SessionSummaryResponse> {
   const response = await client.get<SessionSummaryResponse>(`/api/v1/sessions/${id}/summary`);
   return response.data;
@@ -173,17 +290,95 @@ export async function getTimeline(id: string): Promise<TimelineEvent[]> {
 
 export async function cancelSession(id: string): Promise<CancelResponse> {
   const response = await client.post<CancelResponse>(`/api/v1/sessions/${id}/cancel`);
+  rememberConsistencyToken(response.data.consistency_token);
+  return response.data;
+}
+
+export async function duplicateSession(
+  id: string,
+  overrides: DuplicateSessionRequest = {},
+): Promise<DuplicateSessionResponse> {
+  const response = await client.post<DuplicateSessionResponse>(`/api/v1/sessions/${id}/duplicate`, overrides);
+  rememberConsistencyToken(response.data.consistency_token);
   return response.data;
 }
 
 export async function sendChatMessage(
   sessionId: string,
   content: string,
+  voiceNote?: VoiceNoteUpload,
 ): Promise<SendChatMessageResponse> {
   const response = await client.post<SendChatMessageResponse>(
     `/api/v1/sessions/${sessionId}/chat/messages`,
-    { content },
+    { content, voice_note: voiceNote },
   );
+  rememberConsistencyToken(response.data.consistency_token);
+  return response.data;
+}
+
+/** URL of the original audio of a chat question sent as a voice note. */
+export function sessionVoiceNoteUrl(sessionId: string, voiceNoteId: string): string {
+  return `${urls.api.base}/api/v1/sessions/${sessionId}/voice-notes/${voiceNoteId}`;
+}
+
+// --- Operator notes ---
+
+export async function addOperatorNote(sessionId: string, content: string): Promise<OperatorNote> {
+  const response = await client.post<OperatorNote>(`/api/v1/sessions/${sessionId}/notes`, { content });
+  return response.data;
+}
+
+export async function getOperatorNotes(sessionId: string): Promise<OperatorNote[]> {
+  const response = await client.get<OperatorNote[]>(`/api/v1/sessions/${sessionId}/notes`);
+  return response.data;
+}
+
+// --- Handoff notes ---
+
+export async function getHandoffNotes(sessionId: string): Promise<HandoffNotes> {
+  const response = await client.get<HandoffNotes>(`/api/v1/sessions/${sessionId}/handoff-notes`);
+  return response.data;
+}
+
+/**
+ * Saves a session's handoff notes. Rejected with 409 when baseRevision is
+ * no longer the current revision (someone else saved in between).
+ */
+export async function updateHandoffNotes(
+  sessionId: string,
+  content: string,
+  baseRevision: number,
+): Promise<HandoffNotes> {
+  const response = await client.put<HandoffNotes>(`/api/v1/sessions/${sessionId}/handoff-notes`, {
+    content,
+    base_revision: baseRevision,
+  });
+  return response.data;
+}
+
+export async function getHandoffNoteRevisions(sessionId: string): Promise<HandoffNoteRevisionsResponse> {
+  const response = await client.get<HandoffNoteRevisionsResponse>(
+    `/api/v1/sessions/${sessionId}/handoff-notes/revisions`,
+  );
+  return response.data;
+}
+
+// --- MCP server kill switch ---
+
+export async function disableSessionMCPServer(
+  sessionId: string,
+  serverId: string,
+  reason?: string,
+): Promise<DisabledMCPServer> {
+  const response = await client.post<DisabledMCPServer>(
+    `/api/v1/sessions/${sessionId}/disabled-mcp-servers`,
+    { server_id: serverId, reason },
+  );
+  return response.data;
+}
+
+export async function getDisabledMCPServers(sessionId: string): Promise<DisabledMCPServer[]> {
+  const response = await client.get<DisabledMCPServer[]>(`/api/v1/sessions/${sessionId}/disabled-mcp-servers`);
   return response.data;
 }
 
@@ -211,6 +406,60 @@ export async function getReviewActivity(sessionId: string): Promise<ReviewActivi
   return response.data;
 }
 
+// --- User profile ---
+
+export async function getProfile(): Promise<UserProfileResponse> {
+  const response = await client.get<UserProfileResponse>('/api/v1/profile');
+  return response.data;
+}
+
+export async function updateProfile(req: UpdateUserProfileRequest): Promise<UserProfileResponse> {
+  const response = await client.patch<UserProfileResponse>('/api/v1/profile', req);
+  return response.data;
+}
+
+// --- Saved views ---
+
+export async function getSavedViews(): Promise<SavedView[]> {
+  const response = await client.get<SavedViewListResponse>('/api/v1/saved-views');
+  return response.data.views;
+}
+
+export async function createSavedView(req: CreateSavedViewRequest): Promise<SavedView> {
+  const response = await client.post<SavedView>('/api/v1/saved-views', req);
+  return response.data;
+}
+
+export async function updateSavedView(id: string, req: UpdateSavedViewRequest): Promise<SavedView> {
+  const response = await client.patch<SavedView>(`/api/v1/saved-views/${id}`, req);
+  return response.data;
+}
+
+export async function deleteSavedView(id: string): Promise<void> {
+  await client.delete(`/api/v1/saved-views/${id}`);
+}
+
+// --- Action items ---
+
+export async function getSessionActionItems(sessionId: string): Promise<ActionItem[]> {
+  const response = await client.get<ActionItemListResponse>(`/api/v1/sessions/${sessionId}/action-items`);
+  return response.data.action_items;
+}
+
+export async function createActionItem(sessionId: string, req: CreateActionItemRequest): Promise<ActionItem> {
+  const response = await client.post<ActionItem>(`/api/v1/sessions/${sessionId}/action-items`, req);
+  return response.data;
+}
+
+export async function updateActionItem(id: string, req: UpdateActionItemRequest): Promise<ActionItem> {
+  const response = await client.patch<ActionItem>(`/api/v1/action-items/${id}`, req);
+  return response.data;
+}
+
+export async function deleteActionItem(id: string): Promise<void> {
+  await client.delete(`/api/v1/action-items/${id}`);
+}
+
 // --- Scoring ---
 
 export async function getScore(sessionId: string): Promise<SessionScoreResponse> {
@@ -250,6 +499,16 @@ export async function getMCPInteraction(
   return response.data;
 }
 
+export async function getExecutionContextGrowth(
+  sessionId: string,
+  executionId: string,
+): Promise<ContextGrowthResponse> {
+  const response = await client.get<ContextGrowthResponse>(
+    `/api/v1/sessions/${sessionId}/trace/executions/${executionId}/context`,
+  );
+  return response.data;
+}
+
 // --- Filters ---
 
 export async function getFilterOptions(): Promise<FilterOptionsResponse> {
@@ -320,6 +579,7 @@ export async function getRunbooks(): Promise<string[]> {
 
 export async function submitAlert(data: SubmitAlertRequest): Promise<AlertResponse> {
   const response = await client.post<AlertResponse>('/api/v1/alerts', data);
+  rememberConsistencyToken(response.data.consistency_token);
   return response.data;
 }
 
@@ -8,10 +8,13 @@
  * - Reconnect with exponential backoff (200ms → 3s cap, never give up)
  * - Keepalive ping/pong (20s interval, 10s pong timeout)
  * - catchup.overflow handling (signals full REST reload needed)
+ * - connection.going_away (pod shutdown): reconnect after the server's
+ *   retry_after_ms instead of backing off; channels resume from the last
+ *   event IDs tracked here, so the resume token is not needed
  */
 
 import { urls } from '../config/env.ts';
-import { EVENT_PONG, EVENT_CATCHUP_OVERFLOW } from '../constants/eventTypes.ts';
+import { EVENT_PONG, EVENT_CATCHUP_OVERFLOW, EVENT_CONNECTION_GOING_AWAY } from '../constants/eventTypes.ts';
 
 type EventHandler = (data: Record<string, unknown>) => void;
 
@@ -26,6 +29,8 @@ class WebSocketService {
   private reconnectAttempts = 0;
   private reconnectTimeout: ReturnType<typeof setTimeout> | null = null;
   private isConnecting = false;
+  // Reconnect delay requested by a shutting-down server (connection.going_away)
+  private retryAfterMs: number | null = null;
 
   // Channel subscriptions
   private channels: Map<string, SubscribedChannel> = new Map();
@@ -73,6 +78,10 @@ class WebSocketService {
             this.handlePong();
             return;
           }
+          if (data.type === EVENT_CONNECTION_GOING_AWAY) {
+            this.retryAfterMs = typeof data.retry_after_ms === 'number' ? data.retry_after_ms : null;
+            return;
+          }
           this.handleEvent(data);
         } catch {
           // Ignore malformed messages
@@ -281,6 +290,16 @@ class WebSocketService {
   // ────────────────────────────────────────────────────────────
 
   private scheduleReconnect(): void {
+    if (this.retryAfterMs !== null) {
+      // The server is shutting down, not failing: reconnect when it suggests
+      const delay = this.retryAfterMs;
+      this.retryAfterMs = null;
+      this.reconnectAttempts = 0;
+      this.reconnectTimeout = setTimeout(() => {
+        this.connect();
+      }, delay);
+      return;
+    }
     this.reconnectAttempts++;
     // Exponential backoff: 200ms, 400ms, 800ms, 1.6s, capped at 3s
     const delay = Math.min(200 * Math.pow(2, this.reconnectAttempts - 1), 3000);
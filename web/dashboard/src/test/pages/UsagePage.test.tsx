@@ -5,6 +5,7 @@ import type { UsageSummaryResponse } from '../../types/api';
 
 vi.mock('../../services/api.ts', () => ({
   getUsageSummary: vi.fn(),
+  getUsageByOwner: vi.fn(),
   getFilterOptions: vi.fn(),
   handleAPIError: (err: unknown) =>
     err instanceof Error ? err.message : 'An unexpected error occurred',
@@ -50,10 +51,11 @@ vi.mock('../../services/websocket.ts', () => ({
   },
 }));
 
-import { getFilterOptions, getUsageSummary } from '../../services/api';
+import { getFilterOptions, getUsageByOwner, getUsageSummary } from '../../services/api';
 import { UsagePage } from '../../pages/UsagePage';
 
 const mockGetUsageSummary = vi.mocked(getUsageSummary);
+const mockGetUsageByOwner = vi.mocked(getUsageByOwner);
 const mockGetFilterOptions = vi.mocked(getFilterOptions);
 
 function makeSummary(overrides: Partial<UsageSummaryResponse> = {}): UsageSummaryResponse {
@@ -84,6 +86,7 @@ function makeSummary(overrides: Partial<UsageSummaryResponse> = {}): UsageSummar
     ],
     by_alert_type: [{ alert_type: 'kubernetes', total_tokens: 150, estimated_cost_usd: 1.23 }],
     by_chain: [{ chain_id: 'default', total_tokens: 150, estimated_cost_usd: 1.23 }],
+    by_outcome: [{ outcome: 'mitigated', session_count: 1, total_tokens: 150, estimated_cost_usd: 1.23 }],
     top_sessions: [
       {
         session_id: 'abcdef12-3456-7890-abcd-ef1234567890',
@@ -114,6 +117,11 @@ beforeEach(() => {
     chain_ids: ['default'],
     statuses: [],
   });
+  mockGetUsageByOwner.mockResolvedValue({
+    cost_estimation_enabled: true,
+    window: { start: '2026-01-01T00:00:00Z', end: '2026-01-31T00:00:00Z' },
+    owners: [],
+  });
 });
 
 describe('UsagePage', () => {
@@ -144,6 +152,45 @@ describe('UsagePage', () => {
     );
   });
 
+  it('shows usage by owner', async () => {
+    mockGetUsageSummary.mockResolvedValue(makeSummary());
+    mockGetUsageByOwner.mockResolvedValue({
+      cost_estimation_enabled: true,
+      window: { start: '2026-01-01T00:00:00Z', end: '2026-01-31T00:00:00Z' },
+      owners: [
+        { owner: 'payments-sre', session_count: 7, input_tokens: 900, output_tokens: 100, total_tokens: 1000, estimated_cost_usd: 4.56 },
+        { owner: '', session_count: 2, input_tokens: 0, output_tokens: 0, total_tokens: 0, estimated_cost_usd: 0 },
+      ],
+    });
+
+    renderUsagePage();
+
+    expect(await screen.findByText('By owner')).toBeInTheDocument();
+    const row = screen.getByText('payments-sre').closest('tr')!;
+    expect(within(row).getByText('7')).toBeInTheDocument();
+    expect(within(row).getByText('~$4.56')).toBeInTheDocument();
+    expect(screen.getByText('Unattributed')).toBeInTheDocument();
+    expect(mockGetUsageByOwner.mock.calls[0][0]).not.toHaveProperty('rank_by');
+  });
+
+  it('shows usage by outcome', async () => {
+    mockGetUsageSummary.mockResolvedValue(
+      makeSummary({
+        by_outcome: [
+          { outcome: 'needs_human', session_count: 3, total_tokens: 900, estimated_cost_usd: 2.5 },
+          { outcome: '', session_count: 1, total_tokens: 100, estimated_cost_usd: 0.1 },
+        ],
+      }),
+    );
+
+    renderUsagePage();
+
+    expect(await screen.findByText('By outcome')).toBeInTheDocument();
+    const row = screen.getByText('needs human').closest('tr')!;
+    expect(within(row).getByText('3')).toBeInTheDocument();
+    expect(screen.getByText('Unclassified')).toBeInTheDocument();
+  });
+
   it('re-fetches when rank_by changes', async () => {
     const user = userEvent.setup();
     mockGetUsageSummary.mockResolvedValue(makeSummary());
@@ -254,6 +301,7 @@ describe('UsagePage', () => {
         ],
         by_alert_type: [{ alert_type: 'kubernetes', total_tokens: 150 }],
         by_chain: [{ chain_id: 'default', total_tokens: 150 }],
+        by_outcome: [{ outcome: 'mitigated', session_count: 1, total_tokens: 150 }],
         top_sessions: [
           {
             session_id: 'abcdef12-3456-7890-abcd-ef1234567890',
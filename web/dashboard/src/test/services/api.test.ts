@@ -16,6 +16,7 @@ vi.mock('axios', () => {
     get: vi.fn(),
     post: vi.fn(),
     interceptors: {
+      request: { use: vi.fn() },
       response: { use: vi.fn() },
     },
   };
@@ -47,6 +48,7 @@ import {
   getSessions,
   getActiveSessions,
   getSession,
+  getSessionGroup,
   cancelSession,
   submitAlert,
   getHealth,
@@ -54,6 +56,11 @@ import {
   getSystemConfig,
   getSystemConfigSkill,
   getUsageSummary,
+  getUsageByOwner,
+  getCatalogChains,
+  getCatalogAgents,
+  getCatalogMCPServers,
+  getCatalogMCPTools,
 } from '../../services/api';
 
 function getMockClient() {
@@ -85,6 +92,34 @@ describe('handleAPIError', () => {
     expect(handleAPIError(error)).toBe('Invalid input');
   });
 
+  it('extracts detail from problem responses', () => {
+    const error: Record<string, unknown> = {
+      isAxiosError: true,
+      response: { status: 404, data: { type: 'about:blank', title: 'Not Found', status: 404, detail: 'session not found' } },
+    };
+    expect(handleAPIError(error)).toBe('session not found');
+  });
+
+  it('lists invalid fields of validation problems', () => {
+    const error: Record<string, unknown> = {
+      isAxiosError: true,
+      response: {
+        status: 400,
+        data: {
+          type: 'urn:tarsy:problem:validation',
+          title: 'Bad Request',
+          status: 400,
+          detail: 'data: required; runbook: invalid URL',
+          errors: [
+            { field: 'data', message: 'required' },
+            { field: 'runbook', message: 'invalid URL' },
+          ],
+        },
+      },
+    };
+    expect(handleAPIError(error)).toBe('data: required; runbook: invalid URL');
+  });
+
   it('returns status-based message when no data message', () => {
     const error: Record<string, unknown> = {
       isAxiosError: true,
@@ -153,6 +188,15 @@ describe('API methods', () => {
     });
   });
 
+  describe('getSessionGroup', () => {
+    it('calls correct endpoint with id', async () => {
+      client.get.mockResolvedValue({ data: { id: 'g1', sessions: [] } });
+      const result = await getSessionGroup('g1');
+      expect(client.get).toHaveBeenCalledWith('/api/v1/session-groups/g1');
+      expect(result.id).toBe('g1');
+    });
+  });
+
   describe('cancelSession', () => {
     it('calls correct endpoint', async () => {
       client.post.mockResolvedValue({ data: { session_id: 's1', message: 'cancelling' } });
@@ -171,6 +215,22 @@ describe('API methods', () => {
     });
   });
 
+  describe('consistency tokens', () => {
+    it('GETs present the token of the last write', async () => {
+      client.post.mockResolvedValue({ data: { session_id: 's1', message: 'cancelling', consistency_token: 'tok-1' } });
+      await cancelSession('s1');
+
+      const intercept = client.interceptors.request.use.mock.calls[0][0];
+      const headers = { set: vi.fn() };
+      intercept({ method: 'get', headers });
+      expect(headers.set).toHaveBeenCalledWith('X-Consistency-Token', 'tok-1');
+
+      const postHeaders = { set: vi.fn() };
+      intercept({ method: 'post', headers: postHeaders });
+      expect(postHeaders.set).not.toHaveBeenCalled();
+    });
+  });
+
   describe('getHealth', () => {
     it('calls health endpoint', async () => {
       client.get.mockResolvedValue({ data: { status: 'healthy', version: 'v1' } });
@@ -225,6 +285,7 @@ describe('API methods', () => {
         by_model: [],
         by_alert_type: [],
         by_chain: [],
+        by_outcome: [],
         top_sessions: [],
       };
       client.get.mockResolvedValue({ data });
@@ -239,4 +300,57 @@ describe('API methods', () => {
       expect(result).toEqual(data);
     });
   });
+
+  describe('getUsageByOwner', () => {
+    it('calls usage owners endpoint with params', async () => {
+      const data = {
+        cost_estimation_enabled: false,
+        window: { start: '2026-01-01T00:00:00Z', end: '2026-01-31T00:00:00Z' },
+        owners: [{ owner: 'payments-sre', session_count: 1, input_tokens: 1, output_tokens: 2, total_tokens: 3 }],
+      };
+      client.get.mockResolvedValue({ data });
+      const params = { start_date: '2026-01-01T00:00:00Z', end_date: '2026-01-31T00:00:00Z' };
+      const result = await getUsageByOwner(params);
+      expect(client.get).toHaveBeenCalledWith('/api/v1/usage/owners', { params });
+      expect(result).toEqual(data);
+    });
+  });
+
+  describe('catalog', () => {
+    const window = { start: '2026-01-01T00:00:00Z', end: '2026-01-31T00:00:00Z' };
+
+    it('getCatalogChains passes the days window', async () => {
+      const data = { window, chains: [] };
+      client.get.mockResolvedValue({ data });
+      const result = await getCatalogChains(7);
+      expect(client.get).toHaveBeenCalledWith('/api/v1/catalog/chains', { params: { days: 7 } });
+      expect(result).toEqual(data);
+    });
+
+    it('getCatalogAgents calls the agents endpoint', async () => {
+      const data = { window, agents: [] };
+      client.get.mockResolvedValue({ data });
+      const result = await getCatalogAgents();
+      expect(client.get).toHaveBeenCalledWith('/api/v1/catalog/agents', { params: { days: undefined } });
+      expect(result).toEqual(data);
+    });
+
+    it('getCatalogMCPServers calls the mcp-servers endpoint', async () => {
+      const data = { window, mcp_servers: [] };
+      client.get.mockResolvedValue({ data });
+      const result = await getCatalogMCPServers(30);
+      expect(client.get).toHaveBeenCalledWith('/api/v1/catalog/mcp-servers', { params: { days: 30 } });
+      expect(result).toEqual(data);
+    });
+
+    it('getCatalogMCPTools passes the window and server filter', async () => {
+      const data = { window, servers: [] };
+      client.get.mockResolvedValue({ data });
+      const result = await getCatalogMCPTools(7, 'kubernetes-server');
+      expect(client.get).toHaveBeenCalledWith('/api/v1/catalog/mcp-tools', {
+        params: { days: 7, server: 'kubernetes-server' },
+      });
+      expect(result).toEqual(data);
+    });
+  });
 });
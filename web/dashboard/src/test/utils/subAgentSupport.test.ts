@@ -139,7 +139,7 @@ describe('Type contracts: parent_execution_id field', () => {
       stage_id: 'stage-1',
       execution_id: 'exec-1',
       parent_execution_id: 'parent-1',
-      phase: 'investigating',
+      phase: 'analyzing',
       message: 'Investigating...',
       timestamp: '2025-01-15T10:00:00Z',
     };
@@ -3,7 +3,8 @@
  *
  * Covers: parseTimelineToFlow, groupFlowItemsByStage, getTimelineStats,
  *         flowItemsToPlainText, isFlowItemCollapsible, isFlowItemTerminal,
- *         groupByExecutionId, and the internal filterDuplicatedItems logic.
+ *         groupByExecutionId, highlight helpers, and the internal
+ *         filterDuplicatedItems logic.
  */
 
 import type { TimelineEvent, StageOverview } from '../../types/session';
@@ -15,7 +16,11 @@ import {
   isFlowItemCollapsible,
   isFlowItemTerminal,
   groupByExecutionId,
+  filterHighlightedFlowItems,
+  countHighlights,
+  needsHighlightMarker,
   FLOW_ITEM,
+  HIGHLIGHT,
   type FlowItem,
 } from '../../utils/timelineParser';
 import { TIMELINE_EVENT_TYPES, TIMELINE_STATUS } from '../../constants/eventTypes';
@@ -72,6 +77,9 @@ describe('parseTimelineToFlow', () => {
       [TIMELINE_EVENT_TYPES.MCP_TOOL_SUMMARY, FLOW_ITEM.TOOL_SUMMARY],
       [TIMELINE_EVENT_TYPES.FINAL_ANALYSIS, FLOW_ITEM.FINAL_ANALYSIS],
       [TIMELINE_EVENT_TYPES.USER_QUESTION, FLOW_ITEM.USER_QUESTION],
+      [TIMELINE_EVENT_TYPES.OPERATOR_NOTE, FLOW_ITEM.OPERATOR_NOTE],
+      [TIMELINE_EVENT_TYPES.MCP_SERVER_DISABLED, FLOW_ITEM.MCP_SERVER_DISABLED],
+      [TIMELINE_EVENT_TYPES.CAPABILITY_FALLBACK, FLOW_ITEM.CAPABILITY_FALLBACK],
       [TIMELINE_EVENT_TYPES.CODE_EXECUTION, FLOW_ITEM.CODE_EXECUTION],
       [TIMELINE_EVENT_TYPES.GOOGLE_SEARCH_RESULT, FLOW_ITEM.SEARCH_RESULT],
       [TIMELINE_EVENT_TYPES.URL_CONTEXT_RESULT, FLOW_ITEM.URL_CONTEXT],
@@ -625,3 +633,38 @@ describe('groupByExecutionId', () => {
     expect(map.get('__default__')).toHaveLength(1);
   });
 });
+
+// ---------------------------------------------------------------------------
+// Highlight helpers
+// ---------------------------------------------------------------------------
+
+describe('highlight helpers', () => {
+  const items: FlowItem[] = [
+    { id: 'sep', type: FLOW_ITEM.STAGE_SEPARATOR, stageId: 'stage-1', content: 'Investigation', status: '', timestamp: '', sequenceNumber: 0 },
+    { id: '1', type: FLOW_ITEM.THINKING, content: '', status: '', timestamp: '', sequenceNumber: 1 },
+    { id: '2', type: FLOW_ITEM.TOOL_CALL, content: '', status: '', timestamp: '', sequenceNumber: 2, highlight: HIGHLIGHT.KEY_FINDING },
+    { id: '3', type: FLOW_ITEM.TOOL_CALL, content: '', status: '', timestamp: '', sequenceNumber: 3, highlight: HIGHLIGHT.WARNING },
+    { id: '4', type: FLOW_ITEM.FINAL_ANALYSIS, content: '', status: '', timestamp: '', sequenceNumber: 4, highlight: HIGHLIGHT.KEY_FINDING },
+  ];
+
+  it('keeps highlighted items and stage separators', () => {
+    expect(filterHighlightedFlowItems(items).map((i) => i.id)).toEqual(['sep', '2', '3', '4']);
+  });
+
+  it('counts highlighted items', () => {
+    expect(countHighlights(items)).toBe(3);
+  });
+
+  it('marks only items whose type does not convey the highlight', () => {
+    expect(items.map(needsHighlightMarker)).toEqual([false, false, true, true, false]);
+  });
+
+  it('copies highlight from the timeline event', () => {
+    const flow = parseTimelineToFlow(
+      [makeEvent({ id: 'e1', event_type: TIMELINE_EVENT_TYPES.LLM_TOOL_CALL, highlight: HIGHLIGHT.KEY_FINDING })],
+      [makeStage({ id: 'stage-1' })],
+    );
+    const toolCall = flow.find((i) => i.id === 'e1');
+    expect(toolCall?.highlight).toBe(HIGHLIGHT.KEY_FINDING);
+  });
+});
@@ -2,9 +2,26 @@
  * API request/response wrapper types.
  */
 
-import type { CostCompleteness, DashboardSessionItem } from './session.ts';
+import type { AlertInstructions, CostCompleteness, DashboardSessionItem, SessionOutcome } from './session.ts';
 import type { MCPSelectionConfig } from './system.ts';
 
+/** One invalid field of a rejected request. */
+export interface ProblemFieldError {
+  field: string;
+  message: string;
+}
+
+/** RFC 7807 body of every API error response. */
+export interface ProblemDetails {
+  type: string;
+  title: string;
+  status: number;
+  detail?: string;
+  instance?: string;
+  request_id?: string;
+  errors?: ProblemFieldError[];
+}
+
 /** Pagination info in list responses. */
 export interface PaginationInfo {
   page: number;
@@ -29,6 +46,7 @@ export interface UsageSummaryParams {
   end_date: string;
   alert_type?: string;
   chain_id?: string;
+  outcome?: SessionOutcome;
   rank_by?: UsageRankBy;
 }
 
@@ -73,6 +91,14 @@ export interface UsageChainBreakdown {
   estimated_cost_usd?: number | null;
 }
 
+/** Per-outcome rollup within a usage window. Outcome "" collects unclassified sessions. */
+export interface UsageOutcomeBreakdown {
+  outcome: SessionOutcome | '';
+  session_count: number;
+  total_tokens: number;
+  estimated_cost_usd?: number | null;
+}
+
 /** One of the capped top sessions in a usage window. */
 export interface UsageTopSession {
   session_id: string;
@@ -93,9 +119,136 @@ export interface UsageSummaryResponse {
   by_model: UsageModelBreakdown[];
   by_alert_type: UsageAlertBreakdown[];
   by_chain: UsageChainBreakdown[];
+  by_outcome: UsageOutcomeBreakdown[];
   top_sessions: UsageTopSession[];
 }
 
+/**
+ * Per-owner rollup within a usage window. Sessions count toward their chain's
+ * owner; tokens and cost toward the owner of the agent that made each call.
+ * Owner "" collects unattributed usage.
+ */
+export interface UsageOwnerBreakdown {
+  owner: string;
+  session_count: number;
+  input_tokens: number;
+  output_tokens: number;
+  total_tokens: number;
+  estimated_cost_usd?: number | null;
+  unpriced_interaction_count?: number | null;
+}
+
+/** Response from GET /api/v1/usage/owners. */
+export interface UsageOwnersResponse {
+  cost_estimation_enabled: boolean;
+  window: UsageWindow;
+  owners: UsageOwnerBreakdown[];
+}
+
+/** Trailing-window usage for a catalog entry (pkg/models/catalog.go). */
+export interface CatalogUsage {
+  sessions: number;
+  /** Agent executions or MCP tool calls; omitted for chains. */
+  invocations?: number;
+  success_rate: number | null;
+  avg_cost_usd?: number | null;
+  last_used_at?: string;
+}
+
+export interface CatalogResolvedAgent {
+  name: string;
+  type?: string;
+  llm_provider?: string;
+  llm_backend?: string;
+  max_iterations?: number;
+  mcp_servers?: string[];
+  resolve_error?: string;
+  capability_warnings?: string[];
+}
+
+export interface CatalogChain {
+  chain_id: string;
+  description?: string;
+  alert_types: string[];
+  stages: { name: string; agents: CatalogResolvedAgent[] }[];
+  sub_agents?: string[];
+  mcp_servers: string[];
+  usage: CatalogUsage;
+}
+
+export interface CatalogAgent {
+  name: string;
+  type?: string;
+  description?: string;
+  llm_backend: string;
+  max_iterations: number;
+  mcp_servers?: string[];
+  chains: string[];
+  alert_types: string[];
+  usage: CatalogUsage;
+}
+
+export interface CatalogMCPServer {
+  id: string;
+  transport_type: string;
+  agents: string[];
+  chains: string[];
+  alert_types: string[];
+  usage: CatalogUsage;
+}
+
+/** Response from GET /api/v1/catalog/chains. */
+export interface CatalogChainsResponse {
+  window: UsageWindow;
+  chains: CatalogChain[];
+}
+
+/** Response from GET /api/v1/catalog/agents. */
+export interface CatalogAgentsResponse {
+  window: UsageWindow;
+  agents: CatalogAgent[];
+}
+
+/** Response from GET /api/v1/catalog/mcp-servers. */
+export interface CatalogMCPServersResponse {
+  window: UsageWindow;
+  mcp_servers: CatalogMCPServer[];
+}
+
+/** Trailing-window usage for one MCP tool (pkg/models/catalog.go MCPToolUsage). */
+export interface MCPToolUsage {
+  calls: number;
+  sessions: number;
+  error_rate: number | null;
+  avg_duration_ms: number | null;
+  avg_result_bytes: number | null;
+  last_used_at?: string;
+}
+
+export interface CatalogMCPTool {
+  name: string;
+  description?: string;
+  input_schema?: unknown;
+  /** False for tools called in the window but no longer advertised by the server. */
+  discovered: boolean;
+  usage: MCPToolUsage;
+}
+
+export interface CatalogMCPToolServer {
+  id: string;
+  /** Null when health monitoring hasn't checked the server. */
+  healthy: boolean | null;
+  tools_discovered: boolean;
+  total_calls: number;
+  tools: CatalogMCPTool[];
+}
+
+/** Response from GET /api/v1/catalog/mcp-tools. */
+export interface CatalogMCPToolsResponse {
+  window: UsageWindow;
+  servers: CatalogMCPToolServer[];
+}
+
 /** Query parameters for the dashboard session list. */
 export interface DashboardListParams {
   page?: number;
@@ -109,6 +262,7 @@ export interface DashboardListParams {
   start_date?: string;
   end_date?: string;
   scoring_status?: string;
+  outcome?: string; // comma-separated SessionOutcome values
 }
 
 /**
@@ -118,6 +272,9 @@ export interface DashboardListParams {
  * - `alert_type`: optional, Go resolves chain from this (Go: json:"alert_type")
  * - `runbook`: optional runbook URL (Go: json:"runbook")
  * - `mcp`: optional MCP selection override (Go: json:"mcp")
+ * - `alert_key`: optional source-system alert ID for the resolution webhook (Go: json:"alert_key")
+ * - `instructions`: optional extra guidance appended to agent prompts (Go: json:"instructions")
+ * - `output_language`: optional language for analyses and summaries (Go: json:"output_language")
  * Note: `author` is extracted from X-Forwarded-User header, not request body.
  */
 export interface SubmitAlertRequest {
@@ -126,19 +283,44 @@ export interface SubmitAlertRequest {
   runbook?: string;
   mcp?: MCPSelectionConfig;
   slack_message_fingerprint?: string;
+  alert_key?: string;
+  instructions?: AlertInstructions;
+  output_language?: string;
 }
 
 /** Alert submission response. */
 export interface AlertResponse {
   session_id: string;
+  /** Set when the alert fanned out to sibling chains. */
+  group_id?: string;
   status: string;
   message: string;
+  /** Read-after-write token; sent on later GETs (X-Consistency-Token). */
+  consistency_token: string;
 }
 
 /** Cancel session response. */
+/** Overrides for POST /api/v1/sessions/:id/duplicate; omitted fields keep the original's. */
+export interface DuplicateSessionRequest {
+  chain_id?: string;
+  llm_provider?: string;
+  mcp?: MCPSelectionConfig;
+}
+
+export interface DuplicateSessionResponse {
+  session_id: string;
+  duplicated_from: string;
+  chain_id: string;
+  status: string;
+  /** Read-after-write token; sent on later GETs (X-Consistency-Token). */
+  consistency_token: string;
+}
+
 export interface CancelResponse {
   session_id: string;
   message: string;
+  /** Read-after-write token; sent on later GETs (X-Consistency-Token). */
+  consistency_token: string;
 }
 
 /** Full score details from GET /sessions/:id/score. */
@@ -285,6 +467,13 @@ export interface ReviewActivityResponse {
 /** Chat message request. */
 export interface SendChatMessageRequest {
   content: string;
+  voice_note?: VoiceNoteUpload;
+}
+
+/** Voice note sent with a chat message; data is base64-encoded audio. */
+export interface VoiceNoteUpload {
+  mime_type: string;
+  data: string;
 }
 
 /** Chat message response (202 Accepted). */
@@ -292,4 +481,176 @@ export interface SendChatMessageResponse {
   chat_id: string;
   message_id: string;
   stage_id: string;
+  /** Question as sent to the agent, including the transcript (voice notes only). */
+  content?: string;
+  voice_note_id?: string;
+  /** Read-after-write token; sent on later GETs (X-Consistency-Token). */
+  consistency_token?: string;
+  /**
+   * Set (with an empty stage_id) when a response was still being generated
+   * or the server was at its chat capacity: the message starts once its turn
+   * comes. queue_position is its 1-based place in the queue; later changes
+   * arrive as chat.queue_position events.
+   */
+  queued?: boolean;
+  queue_position?: number;
+  /** Set (with empty IDs) when the message was the /disable-mcp command. */
+  disabled_mcp_server?: DisabledMCPServer;
+}
+
+/** MCP server disabled for the rest of a session (kill switch). */
+export interface DisabledMCPServer {
+  event_id: string;
+  session_id: string;
+  server_id: string;
+  reason?: string;
+  author: string;
+  created_at: string;
+}
+
+/** Operator note pushed into a queued or running session. */
+export interface OperatorNote {
+  event_id: string;
+  session_id: string;
+  stage_id?: string;
+  content: string;
+  author: string;
+  created_at: string;
+}
+
+/** Current handoff notes of a session (GET/PUT /sessions/:id/handoff-notes). */
+export interface HandoffNotes {
+  session_id: string;
+  content: string;
+  revision: number; // 0 = never edited
+  updated_by: string | null;
+  updated_at: string | null;
+}
+
+/** One saved revision of a session's handoff notes. */
+export interface HandoffNoteRevision {
+  id: string;
+  revision: number;
+  content: string;
+  author: string;
+  created_at: string;
+}
+
+/** Response of GET /sessions/:id/handoff-notes/revisions, newest first. */
+export interface HandoffNoteRevisionsResponse {
+  revisions: HandoffNoteRevision[];
+}
+
+/** Session notification toggles stored in the user's profile. */
+export interface NotificationPreferences {
+  session_completed: boolean;
+  session_failed: boolean;
+  review_assigned: boolean;
+  only_mine: boolean;
+}
+
+/** Per-user dashboard preferences. */
+export interface UserPreferences {
+  notifications: NotificationPreferences;
+  default_filters?: Record<string, string>;
+  favorite_chains?: string[];
+}
+
+/** Response from GET/PATCH /api/v1/profile. */
+export interface UserProfileResponse {
+  subject: string;
+  display_name: string | null;
+  email: string | null;
+  preferences: UserPreferences;
+  /** WebSocket channel for personal notifications (saved view matches). */
+  channel: string;
+  created_at: string;
+  updated_at: string;
+}
+
+/** Partial update for PATCH /api/v1/profile. Omitted fields are unchanged. */
+export interface UpdateUserProfileRequest {
+  display_name?: string;
+  notifications?: NotificationPreferences;
+  default_filters?: Record<string, string>;
+  favorite_chains?: string[];
+}
+
+/** Saved session filter; field names match GET /sessions query params. */
+export interface SavedViewFilter {
+  status?: string;
+  alert_type?: string;
+  chain_id?: string;
+  search?: string;
+  /** Go duration (e.g. "24h"); the dashboard translates it to start_date. */
+  created_within?: string;
+}
+
+/** A user's saved view with its notification subscriptions. */
+export interface SavedView {
+  id: string;
+  name: string;
+  filter: SavedViewFilter;
+  notify: boolean;
+  slack_channel: string | null;
+  created_at: string;
+  updated_at: string;
+}
+
+/** Response from GET /api/v1/saved-views. */
+export interface SavedViewListResponse {
+  views: SavedView[];
+}
+
+/** Body for POST /api/v1/saved-views. */
+export interface CreateSavedViewRequest {
+  name: string;
+  filter: SavedViewFilter;
+  notify?: boolean;
+  slack_channel?: string;
+}
+
+/** Partial update for PATCH /api/v1/saved-views/:id. Omitted fields are unchanged. */
+export interface UpdateSavedViewRequest {
+  name?: string;
+  filter?: SavedViewFilter;
+  notify?: boolean;
+  slack_channel?: string;
+}
+
+/** Follow-up action item of a session (extracted from the final analysis or added by hand). */
+export interface ActionItem {
+  id: string;
+  session_id: string;
+  title: string;
+  details: string | null;
+  status: 'open' | 'done';
+  source: 'extracted' | 'manual';
+  assignee: string | null;
+  completed_at: string | null;
+  completed_by: string | null;
+  created_by: string | null;
+  created_at: string;
+  updated_at: string;
+}
+
+/** Response from GET /api/v1/action-items and GET /api/v1/sessions/:id/action-items. */
+export interface ActionItemListResponse {
+  action_items: ActionItem[];
+  total: number;
+}
+
+/** Body for POST /api/v1/sessions/:id/action-items. */
+export interface CreateActionItemRequest {
+  title: string;
+  details?: string;
+  assignee?: string;
+}
+
+/** Partial update for PATCH /api/v1/action-items/:id. Omitted fields are unchanged. */
+export interface UpdateActionItemRequest {
+  title?: string;
+  details?: string;
+  status?: 'open' | 'done';
+  assignee?: string;
 }
@@ -14,6 +14,7 @@ export interface TimelineCreatedPayload {
   status: string;
   content: string;
   metadata?: Record<string, unknown>;
+  highlight?: string;
   sequence_number: number;
   timestamp: string;
 }
@@ -28,6 +29,7 @@ export interface TimelineCompletedPayload {
   content: string;
   status: string;
   metadata?: Record<string, unknown>;
+  highlight?: string;
   timestamp: string;
 }
 
@@ -47,6 +49,11 @@ export interface SessionStatusPayload {
   session_id: string;
   status: string;
   timestamp: string;
+  cancel_initiator?: string;
+  cancel_reason?: string;
+  cancelled_by?: string;
+  chain_id?: string;
+  alert_type?: string;
 }
 
 /** stage.status payload. */
@@ -71,6 +78,21 @@ export interface ChatCreatedPayload {
   timestamp: string;
 }
 
+/**
+ * chat.queue_position payload — a queued chat message's place in the pod's
+ * chat queue. position 0 means it left the queue (started or discarded).
+ */
+export interface ChatQueuePositionPayload {
+  type: 'chat.queue_position';
+  session_id: string;
+  chat_id: string;
+  message_id: string;
+  position: number;
+  queue_size: number;
+  reason: 'chat_busy' | 'at_capacity' | 'started' | 'discarded';
+  timestamp: string;
+}
+
 /** interaction.created payload (for trace view live updates). */
 export interface InteractionCreatedPayload {
   type: 'interaction.created';
@@ -91,9 +113,20 @@ export interface SessionProgressPayload {
   total_stages: number;
   active_executions: number;
   status_text: string;
+  /** Budget forecast, sent on the progress event published at session start. */
+  forecast?: ForecastSummary;
   timestamp: string;
 }
 
+/** History-based budget forecast of a session's chain. */
+export interface ForecastSummary {
+  basis: 'alert_type' | 'chain' | 'none';
+  sample_size: number;
+  estimated_tokens: number;
+  estimated_cost_usd?: number;
+  estimated_duration_seconds: number;
+}
+
 /** execution.progress transient payload (for per-agent progress). */
 export interface ExecutionProgressPayload {
   type: 'execution.progress';
@@ -103,6 +136,8 @@ export interface ExecutionProgressPayload {
   parent_execution_id?: string;
   phase: string;
   message: string;
+  /** Estimated completion 0-100; absent when unknown (keep the previous estimate). */
+  percent?: number;
   timestamp: string;
 }
 
@@ -119,6 +154,35 @@ export interface ExecutionStatusPayload {
   timestamp: string;
 }
 
+/** subagent.dispatched payload (an orchestrator dispatched a sub-agent). */
+export interface SubAgentDispatchedPayload {
+  type: 'subagent.dispatched';
+  session_id: string;
+  stage_id: string;
+  execution_id: string;
+  parent_execution_id: string;
+  agent_name: string;
+  agent_index: number;
+  task: string;
+  timestamp: string;
+}
+
+/** subagent.status payload (a sub-agent reached a terminal status). */
+export interface SubAgentStatusPayload {
+  type: 'subagent.status';
+  session_id: string;
+  stage_id: string;
+  execution_id: string;
+  parent_execution_id: string;
+  agent_name: string;
+  agent_index: number;
+  status: string;
+  error_message?: string;
+  duration_ms: number;
+  total_tokens: number;
+  timestamp: string;
+}
+
 /** review.status payload. */
 export interface ReviewStatusPayload {
   type: 'review.status';
@@ -140,6 +204,29 @@ export interface SessionScoreUpdatedPayload {
   timestamp: string;
 }
 
+/** saved_view.matched payload (published to the user's personal channel). */
+export interface SavedViewMatchedPayload {
+  type: 'saved_view.matched';
+  session_id: string;
+  view_id: string;
+  view_name: string;
+  status: string;
+  alert_type: string;
+  chain_id: string;
+  timestamp: string;
+}
+
+/** session.orphan_recovered payload (a session's worker stopped heartbeating). */
+export interface SessionOrphanRecoveredPayload {
+  type: 'session.orphan_recovered';
+  session_id: string;
+  pod_id: string;
+  last_heartbeat_at?: string;
+  recovery: 'requeued' | 'failed';
+  reason: string;
+  timestamp: string;
+}
+
 /** Union of all possible WebSocket event payloads. */
 export type WebSocketEvent =
   | TimelineCreatedPayload
@@ -148,9 +235,12 @@ export type WebSocketEvent =
   | SessionStatusPayload
   | StageStatusPayload
   | ChatCreatedPayload
+  | ChatQueuePositionPayload
   | InteractionCreatedPayload
   | SessionProgressPayload
   | ExecutionProgressPayload
   | ExecutionStatusPayload
   | ReviewStatusPayload
-  | SessionScoreUpdatedPayload;
+  | SessionScoreUpdatedPayload
+  | SavedViewMatchedPayload
+  | SessionOrphanRecoveredPayload;
@@ -2,9 +2,19 @@
  * Session-related types derived from Go models (pkg/models/session.go).
  */
 
+import type { MessageImage } from './trace';
+
 /** Cost completeness for session / execution aggregates. */
 export type CostCompleteness = 'complete' | 'partial' | 'none';
 
+/** Outcome taxonomy label of a completed session (system.outcome_classification). */
+export type SessionOutcome =
+  | 'root_cause_identified'
+  | 'mitigated'
+  | 'needs_human'
+  | 'false_positive'
+  | 'inconclusive';
+
 /** Single session in the dashboard list with pre-computed stats. */
 export interface DashboardSessionItem {
   id: string;
@@ -18,6 +28,7 @@ export interface DashboardSessionItem {
   duration_ms: number | null;
   error_message: string | null;
   executive_summary: string | null;
+  outcome?: SessionOutcome | null;
   llm_interaction_count: number;
   mcp_interaction_count: number;
   input_tokens: number;
@@ -33,6 +44,8 @@ export interface DashboardSessionItem {
   actions_executed: boolean | null;
   chat_message_count: number;
   provider_fallback_count: number;
+  degraded_reason?: string | null;
+  fallback_chain?: boolean;
   current_stage_index: number | null;
   current_stage_id: string | null;
   matched_in_content: boolean;
@@ -72,7 +85,92 @@ export interface QueuedSessionItem {
   queue_position: number;
 }
 
+/**
+ * Per-alert agent guidance. `stages` is keyed by chain stage name, `agents`
+ * by configured agent name. Go: models.AlertInstructions.
+ */
+export interface AlertInstructions {
+  global?: string;
+  stages?: Record<string, string>;
+  agents?: Record<string, string>;
+  /** Guardrail for follow-up chat, added to the chain's chat.guardrails. */
+  chat?: string;
+}
+
+/** Provider tier chosen by the complexity router. Go: schema.ModelRoutingDecision. */
+export interface ModelRoutingDecision {
+  tier?: 'fast' | 'strong';
+  provider?: string;
+  score?: number;
+  reason?: string;
+  classifier_provider: string;
+  error?: string;
+}
+
+/** Stage another TARSy instance delegated to this session. Go: schema.FederationOrigin. */
+export interface FederationOrigin {
+  source_url?: string;
+  session_id: string;
+  stage_name: string;
+  context?: string;
+}
+
+/** Cluster context an alert targets (system.targets). Go: schema.AlertTarget. */
+export interface AlertTarget {
+  cluster: string;
+  region?: string;
+  namespace?: string;
+}
+
+/** On-call engineer of the alert's service at session start (system.on_call). Go: schema.OnCallContact. */
+export interface OnCallContact {
+  service: string;
+  team?: string;
+  provider: string;
+  schedule_id: string;
+  name?: string;
+  email?: string;
+  until?: string;
+  resolved_at: string;
+  error?: string;
+}
+
+/** One sibling session of a multi-chain fan-out. Go: models.SessionGroupMember. */
+export interface SessionGroupMember {
+  id: string;
+  chain_id: string;
+  status: string;
+  executive_summary: string | null;
+  severity: string | null;
+  error_message: string | null;
+  started_at: string | null;
+  completed_at: string | null;
+}
+
+/** Combined status of a multi-chain fan-out. Go: models.SessionGroupResponse. */
+export interface SessionGroupResponse {
+  id: string;
+  alert_type: string;
+  primary_chain_id: string;
+  status: 'pending' | 'in_progress' | 'completed' | 'partial' | 'failed';
+  created_at: string;
+  sessions: SessionGroupMember[];
+  synthesis_status: 'pending' | 'in_progress' | 'completed' | 'failed' | 'skipped';
+  summary: string | null;
+  summary_error: string | null;
+  synthesized_at: string | null;
+}
+
 /** Enriched session detail response. */
+/** Completion callback delivery state (secret is never returned). */
+export interface CallbackDelivery {
+  url: string;
+  status: 'pending' | 'delivered' | 'failed';
+  attempts: number;
+  last_error?: string | null;
+  delivered_at?: string | null;
+}
+
 export interface SessionDetailResponse {
   // Core fields
   id: string;
@@ -80,14 +178,32 @@ export interface SessionDetailResponse {
   alert_type: string | null;
   status: string;
   chain_id: string;
+  owner?: string | null;
   author: string | null;
   error_message: string | null;
   final_analysis: string | null;
   executive_summary: string | null;
   executive_summary_error: string | null;
+  executive_summary_template?: string;
+  technical_summary?: string;
+  technical_summary_error?: string;
+  outcome?: SessionOutcome | null;
+  outcome_reason?: string;
+  outcome_source?: 'agent' | 'classifier' | 'noise_triage';
   runbook_url: string | null;
   slack_message_fingerprint?: string | null;
+  request_id?: string | null;
+  alert_key?: string | null;
+  alert_resolved_at?: string | null;
+  alert_resolution?: string | null;
+  cancel_initiator?: 'user' | 'pending_ttl' | 'alert_resolved' | null;
+  cancel_reason?: string | null;
+  cancelled_by?: string | null;
   mcp_selection?: Record<string, unknown>;
+  alert_instructions?: AlertInstructions;
+  alert_images?: MessageImage[];
+  output_language?: string | null;
+  callback?: CallbackDelivery;
 
   // Timestamps
   created_at: string;
@@ -97,6 +213,7 @@ export interface SessionDetailResponse {
   // Computed fields
   duration_ms: number | null;
   chat_enabled: boolean;
+  voice_notes_enabled?: boolean;
   chat_id: string | null;
   chat_message_count: number;
   total_stages: number;
@@ -105,6 +222,15 @@ export interface SessionDetailResponse {
   has_parallel_stages: boolean;
   has_action_stages: boolean;
   actions_executed: boolean | null;
+  degraded_reason?: string | null;
+  fallback_chain?: boolean;
+  model_routing?: ModelRoutingDecision | null;
+  federation_origin?: FederationOrigin | null;
+  target?: AlertTarget | null;
+  on_call?: OnCallContact | null;
+  group_id?: string | null;
+  duplicated_from?: string | null;
+  llm_provider?: string | null;
   input_tokens: number;
   output_tokens: number;
   total_tokens: number;
@@ -132,6 +258,12 @@ export interface SessionDetailResponse {
   feedback_edited_by?: string | null;
   feedback_edited_at?: string | null;
 
+  // Handoff notes (history at GET /sessions/:id/handoff-notes/revisions)
+  handoff_notes?: string | null;
+  handoff_notes_revision?: number;
+  handoff_notes_updated_by?: string | null;
+  handoff_notes_updated_at?: string | null;
+
   // Stage list
   stages: StageOverview[];
 }
@@ -176,6 +308,7 @@ export interface ExecutionOverview {
   fallback_reason?: string | null;
   fallback_error_code?: string | null;
   fallback_attempt?: number | null;
+  loop_detected?: boolean;
   sub_agents?: ExecutionOverview[];
 }
 
@@ -242,6 +375,8 @@ export interface TimelineEvent {
   status: string;
   content: string;
   metadata: Record<string, unknown> | null;
+  /** Highlight marker: 'key_finding', 'error' or 'warning'; absent when not highlighted. */
+  highlight?: string | null;
   created_at: string;
   updated_at: string;
 }
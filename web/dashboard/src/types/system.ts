@@ -165,6 +165,7 @@ export interface SanitizedTransport {
   verify_ssl?: boolean | null;
   timeout?: number;
   env_keys?: string[];
+  header_keys?: string[];
   bearer_token_set: boolean;
 }
 
@@ -188,12 +189,15 @@ export interface AgentConfigView {
   orchestrator?: OrchestratorView | null;
   skills?: string[] | null;
   required_skills?: string[];
+  extends?: string;
+  mixins?: string[];
 }
 
 export interface OrchestratorView {
   max_concurrent_agents?: number | null;
   agent_timeout?: string | null;
   max_budget?: string | null;
+  max_tokens?: number | null;
 }
 
 export interface FallbackProviderView {
@@ -238,6 +242,10 @@ export interface StageView {
     llm_backend?: string;
     llm_provider?: string;
   } | null;
+  remote?: {
+    instance: string;
+    alert_type?: string;
+  } | null;
 }
 
 export interface ChatView {
@@ -259,19 +267,38 @@ export interface ScoringView {
   max_iterations?: number | null;
 }
 
+export interface SlackTemplateView {
+  fields?: { label: string; value: string }[];
+  buttons?: { text: string; url: string }[];
+}
+
 export interface ChainConfigView {
   alert_types: string[];
+  /** Base chain this chain inherits unset fields from (already applied). */
+  extends?: string;
   description?: string;
   stages: StageView[];
   chat?: ChatView | null;
   scoring?: ScoringView | null;
+  slack?: SlackTemplateView | null;
+  email?: { recipients: string[]; schedule: string } | null;
   llm_provider?: string;
   executive_summary_provider?: string;
+  executive_summary?: {
+    enabled: boolean;
+    audience?: string;
+    style?: string;
+    max_lines: number;
+    custom_prompt?: boolean;
+    template_version: string;
+  } | null;
+  technical_summary?: { enabled: boolean; llm_provider?: string } | null;
   llm_backend?: string;
   fallback_providers?: FallbackProviderView[];
   max_iterations?: number | null;
   mcp_servers?: string[];
   sub_agents?: SubAgentView[];
+  fan_out?: { chains: string[]; llm_provider?: string } | null;
 }
 
 export interface LLMProviderConfigView {
@@ -284,6 +311,17 @@ export interface LLMProviderConfigView {
   base_url?: string;
   max_tool_result_tokens: number;
   native_tools?: Record<string, boolean>;
+  capabilities: CapabilitiesView;
+  tokenizer?: { type: string; file?: string } | null;
+}
+
+/** Effective capability matrix of an LLM provider. */
+export interface CapabilitiesView {
+  function_calling: boolean;
+  streaming: boolean;
+  structured_output: boolean;
+  vision: boolean;
+  context_window?: number;
 }
 
 export interface SkillMetaView {
@@ -321,17 +359,49 @@ export interface QueueView {
   max_concurrent_sessions: number;
   poll_interval: string;
   poll_interval_jitter: string;
+  max_poll_interval: string;
+  claim_batch_size: number;
   session_timeout: string;
   graceful_shutdown_timeout: string;
   scoring_shutdown_timeout: string;
   orphan_detection_interval: string;
   orphan_threshold: string;
   heartbeat_interval: string;
+  orphan_recovery: string;
+  orphan_max_requeues: number;
 }
 
 export interface SystemSettingsView {
   github?: { token_env?: string } | null;
-  slack?: { enabled: boolean; token_env?: string; channel?: string } | null;
+  slack?: {
+    enabled: boolean;
+    token_env?: string;
+    channel?: string;
+    thread_status_updates: boolean;
+    rate_limit_per_minute: number;
+    dedup_window: string;
+  } | null;
+  email?: {
+    enabled: boolean;
+    smtp_host?: string;
+    smtp_port: number;
+    username?: string;
+    password_env?: string;
+    from?: string;
+    starttls: boolean;
+    digest_hour: number;
+  } | null;
+  reports?: {
+    enabled: boolean;
+    periods: string[];
+    hour: number;
+    weekday: string;
+    narrative: boolean;
+    llm_provider?: string;
+    slack_channel?: string;
+    email_recipients?: string[];
+    webhook_url?: string;
+  } | null;
   runbooks?: {
     repo_url?: string;
     cache_ttl?: string;
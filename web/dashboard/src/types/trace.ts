@@ -21,6 +21,8 @@ export interface TraceStageGroup {
 export interface TraceExecutionGroup {
   execution_id: string;
   agent_name: string;
+  tool_call_count?: number;
+  loop_detected?: boolean;
   llm_interactions: LLMInteractionListItem[];
   mcp_interactions: MCPInteractionListItem[];
   sub_agents?: TraceExecutionGroup[];
@@ -64,6 +66,7 @@ export interface LLMInteractionDetailResponse {
   llm_request: Record<string, unknown>;
   llm_response: Record<string, unknown>;
   response_metadata?: Record<string, unknown>;
+  request_id?: string;
   created_at: string;
   conversation: ConversationMessage[];
 }
@@ -75,6 +78,15 @@ export interface ConversationMessage {
   tool_calls?: MessageToolCall[];
   tool_call_id?: string;
   tool_name?: string;
+  images?: MessageImage[];
+}
+
+/** Image attached to a user message (served by sessionImageUrl). */
+export interface MessageImage {
+  id: string;
+  mime_type: string;
+  name?: string;
+  size_bytes: number;
 }
 
 /** Tool call within a message. */
@@ -101,5 +113,43 @@ export interface MCPInteractionDetailResponse {
   available_tools?: ToolListEntry[];
   duration_ms?: number;
   error_message?: string;
+  request_id?: string;
+  created_at: string;
+}
+
+/** Tool result message as it entered the conversation (after summarization). */
+export interface ContextToolResult {
+  message_id: string;
+  tool_name?: string;
+  tool_call_id?: string;
+  chars: number;
+  estimated_tokens: number;
+  /** First LLM call whose prompt included this result. */
+  interaction_id?: string;
+}
+
+/** Prompt budget of a single LLM interaction. */
+export interface ContextGrowthIteration {
+  interaction_id: string;
+  interaction_type: string;
+  iteration?: number;
+  messages_count?: number;
+  prompt_chars?: number;
+  tool_result_chars?: number;
+  /** Counted by `tokenizer` before the call. */
+  prompt_tokens?: number;
+  tool_result_tokens?: number;
+  tokenizer?: string;
+  input_tokens?: number;
+  output_tokens?: number;
+  input_tokens_delta?: number;
+  added_tool_results: ContextToolResult[];
   created_at: string;
 }
+
+/** Per-execution context growth report. */
+export interface ContextGrowthResponse {
+  execution_id: string;
+  iterations: ContextGrowthIteration[];
+  largest_tool_results: ContextToolResult[];
+}
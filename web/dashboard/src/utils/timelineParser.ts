@@ -26,6 +26,9 @@ export const FLOW_ITEM = {
   PROVIDER_FALLBACK: 'provider_fallback',
   SKILL_LOADED: 'skill_loaded',
   MEMORY_INJECTED: 'memory_injected',
+  OPERATOR_NOTE: 'operator_note',
+  MCP_SERVER_DISABLED: 'mcp_server_disabled',
+  CAPABILITY_FALLBACK: 'capability_fallback',
   STAGE_SEPARATOR: 'stage_separator',
 } as const;
 
@@ -43,6 +46,8 @@ export interface FlowItem {
   timestamp: string;
   sequenceNumber: number;
   isParallelStage?: boolean;
+  /** Highlight marker from the backend: 'key_finding', 'error' or 'warning'. */
+  highlight?: string;
 }
 
 export interface StageGroup {
@@ -89,6 +94,9 @@ const EVENT_TYPE_MAP: Record<string, FlowItemType> = {
   [TIMELINE_EVENT_TYPES.PROVIDER_FALLBACK]: FLOW_ITEM.PROVIDER_FALLBACK,
   [TIMELINE_EVENT_TYPES.SKILL_LOADED]: FLOW_ITEM.SKILL_LOADED,
   [TIMELINE_EVENT_TYPES.MEMORY_INJECTED]: FLOW_ITEM.MEMORY_INJECTED,
+  [TIMELINE_EVENT_TYPES.OPERATOR_NOTE]: FLOW_ITEM.OPERATOR_NOTE,
+  [TIMELINE_EVENT_TYPES.MCP_SERVER_DISABLED]: FLOW_ITEM.MCP_SERVER_DISABLED,
+  [TIMELINE_EVENT_TYPES.CAPABILITY_FALLBACK]: FLOW_ITEM.CAPABILITY_FALLBACK,
   [TIMELINE_EVENT_TYPES.ERROR]: FLOW_ITEM.ERROR,
 };
 
@@ -140,6 +148,7 @@ function eventToFlowItem(event: TimelineEvent, stageMap: Map<string, StageOvervi
     timestamp: event.created_at,
     sequenceNumber: event.sequence_number,
     isParallelStage: isParallel || undefined,
+    highlight: event.highlight || undefined,
   };
 }
 
@@ -405,6 +414,50 @@ export function countProviderFallbacks(items: FlowItem[]): number {
   return count;
 }
 
+// --- Highlight helpers ---
+
+/** Constants for FlowItem.highlight (mirrors the backend highlight enum). */
+export const HIGHLIGHT = {
+  KEY_FINDING: 'key_finding',
+  ERROR: 'error',
+  WARNING: 'warning',
+} as const;
+
+/** Types whose own rendering already conveys their highlight. */
+const SELF_HIGHLIGHTING_TYPES: Set<FlowItemType> = new Set([
+  FLOW_ITEM.ERROR,
+  FLOW_ITEM.FINAL_ANALYSIS,
+  FLOW_ITEM.EXECUTIVE_SUMMARY,
+  FLOW_ITEM.PROVIDER_FALLBACK,
+  FLOW_ITEM.MCP_SERVER_DISABLED,
+  FLOW_ITEM.CAPABILITY_FALLBACK,
+]);
+
+/**
+ * Whether a highlighted FlowItem needs an explicit marker (e.g. a tool call
+ * flagged as a key finding), as opposed to types that are distinctive anyway.
+ */
+export function needsHighlightMarker(item: FlowItem): boolean {
+  return !!item.highlight && !SELF_HIGHLIGHTING_TYPES.has(item.type);
+}
+
+/**
+ * Keep only highlighted items plus stage separators, for the condensed
+ * "highlights only" view of long investigations.
+ */
+export function filterHighlightedFlowItems(items: FlowItem[]): FlowItem[] {
+  return items.filter((item) => item.type === FLOW_ITEM.STAGE_SEPARATOR || !!item.highlight);
+}
+
+/** Count highlighted items (stage separators excluded). */
+export function countHighlights(items: FlowItem[]): number {
+  let count = 0;
+  for (const item of items) {
+    if (item.highlight && item.type !== FLOW_ITEM.STAGE_SEPARATOR) count++;
+  }
+  return count;
+}
+
 // --- Collapse helpers ---
 
 /** Types that support auto-collapse. */
@@ -461,6 +514,15 @@ export function flowItemsToPlainText(items: FlowItem[]): string {
       case FLOW_ITEM.USER_QUESTION:
         lines.push(`[User Question]\n${item.content}\n`);
         break;
+      case FLOW_ITEM.OPERATOR_NOTE:
+        lines.push(`[Operator Note]\n${item.content}\n`);
+        break;
+      case FLOW_ITEM.MCP_SERVER_DISABLED:
+        lines.push(`[MCP Server Disabled]\n${item.content}\n`);
+        break;
+      case FLOW_ITEM.CAPABILITY_FALLBACK:
+        lines.push(`[Capability Fallback]\n${item.content}\n`);
+        break;
       case FLOW_ITEM.ERROR:
         lines.push(`[Error]\n${item.content}\n`);
         break;